@@ -224,7 +224,7 @@ func (mr *MilvusRoles) setupLogger() {
 func setupPrometheusHTTPServer(r *metrics.MilvusRegistry) {
 	http.Register(&http.Handler{
 		Path:    "/metrics",
-		Handler: promhttp.HandlerFor(r, promhttp.HandlerOpts{}),
+		Handler: promhttp.HandlerFor(r, promhttp.HandlerOpts{EnableOpenMetrics: true}),
 	})
 	http.Register(&http.Handler{
 		Path:    "/metrics_default",
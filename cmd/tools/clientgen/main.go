@@ -0,0 +1,252 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// clientgen generates the grpc clients of components under internal/distributed from the component
+// interfaces in internal/types and the grpc service clients generated from the protos.
+//
+// For each method of the component interface which has a grpc counterpart, the generated method clones
+// the request, fills its msg base if any, and calls the service by wrapGrpcCall, which retries and traces
+// the call. Methods written by hand in the client package are not generated, so a client could still
+// customize some of the calls.
+//
+// Run it from the repo root with `make generate-clients`, which regenerates the mocks as well.
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+const (
+	generatedFile = "client_gen.go"
+	modulePath    = "github.com/milvus-io/milvus"
+)
+
+// service describes how to generate the client of a component.
+type service struct {
+	name       string       // service name, e.g. DataNode
+	component  reflect.Type // component interface in internal/types
+	grpcClient reflect.Type // grpc client generated from the proto
+	dir        string       // client package dir
+	// targetID is the expression of the target node id filled in the msg base, empty if not filled.
+	targetID string
+}
+
+var services = []service{
+	{
+		name:       "DataNode",
+		component:  reflect.TypeOf((*types.DataNode)(nil)).Elem(),
+		grpcClient: reflect.TypeOf((*datapb.DataNodeClient)(nil)).Elem(),
+		dir:        "internal/distributed/datanode/client",
+	},
+	{
+		name:       "QueryNode",
+		component:  reflect.TypeOf((*types.QueryNode)(nil)).Elem(),
+		grpcClient: reflect.TypeOf((*querypb.QueryNodeClient)(nil)).Elem(),
+		dir:        "internal/distributed/querynode/client",
+	},
+	{
+		name:       "IndexNode",
+		component:  reflect.TypeOf((*types.IndexNode)(nil)).Elem(),
+		grpcClient: reflect.TypeOf((*indexpb.IndexNodeClient)(nil)).Elem(),
+		dir:        "internal/distributed/indexnode/client",
+	},
+	{
+		name:       "Proxy",
+		component:  reflect.TypeOf((*types.Proxy)(nil)).Elem(),
+		grpcClient: reflect.TypeOf((*proxypb.ProxyClient)(nil)).Elem(),
+		dir:        "internal/distributed/proxy/client",
+		targetID:   "c.grpcClient.GetNodeID()",
+	},
+	{
+		name:       "DataCoord",
+		component:  reflect.TypeOf((*types.DataCoord)(nil)).Elem(),
+		grpcClient: reflect.TypeOf((*datapb.DataCoordClient)(nil)).Elem(),
+		dir:        "internal/distributed/datacoord/client",
+		targetID:   "c.grpcClient.GetNodeID()",
+	},
+	{
+		name:       "QueryCoord",
+		component:  reflect.TypeOf((*types.QueryCoord)(nil)).Elem(),
+		grpcClient: reflect.TypeOf((*querypb.QueryCoordClient)(nil)).Elem(),
+		dir:        "internal/distributed/querycoord/client",
+		targetID:   "c.grpcClient.GetNodeID()",
+	},
+	{
+		name:       "RootCoord",
+		component:  reflect.TypeOf((*types.RootCoord)(nil)).Elem(),
+		grpcClient: reflect.TypeOf((*rootcoordpb.RootCoordClient)(nil)).Elem(),
+		dir:        "internal/distributed/rootcoord/client",
+		targetID:   "c.grpcClient.GetNodeID()",
+	},
+}
+
+var (
+	contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+	errorType   = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+func main() {
+	root := flag.String("root", ".", "root dir of the repo")
+	flag.Parse()
+
+	for _, s := range services {
+		src, err := generate(*root, s)
+		if err == nil {
+			err = os.WriteFile(filepath.Join(*root, s.dir, generatedFile), src, 0o644)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to generate client of %s: %v\n", s.name, err)
+			os.Exit(1)
+		}
+	}
+}
+
+// generate returns the source of the generated client of the service.
+func generate(root string, s service) ([]byte, error) {
+	pkgName, handwritten, err := parseClientPackage(filepath.Join(root, s.dir))
+	if err != nil {
+		return nil, err
+	}
+
+	imports := map[string]struct{}{
+		s.grpcClient.PkgPath(): {},
+	}
+	body := &bytes.Buffer{}
+	for i := 0; i < s.component.NumMethod(); i++ {
+		method := s.component.Method(i)
+		if _, ok := handwritten[method.Name]; ok {
+			continue
+		}
+		grpcMethod, ok := s.grpcClient.MethodByName(method.Name)
+		if !ok || !isUnaryCall(method.Type, grpcMethod.Type) {
+			continue
+		}
+		req, resp := method.Type.In(1), method.Type.Out(0)
+		imports[req.Elem().PkgPath()] = struct{}{}
+		imports[resp.Elem().PkgPath()] = struct{}{}
+
+		fmt.Fprintf(body, "\n// %s calls %s of %s.\n", method.Name, method.Name, s.name)
+		fmt.Fprintf(body, "func (c *Client) %s(ctx context.Context, req %s) (%s, error) {\n",
+			method.Name, typeName(req), typeName(resp))
+		if _, ok := req.MethodByName("GetBase"); ok {
+			for _, pkg := range []string{"pkg/util/commonpbutil", "pkg/util/paramtable", "pkg/util/typeutil"} {
+				imports[path.Join(modulePath, pkg)] = struct{}{}
+			}
+			fmt.Fprintf(body, "\treq = typeutil.Clone(req)\n")
+			fmt.Fprintf(body, "\tcommonpbutil.UpdateMsgBase(\n\t\treq.GetBase(),\n")
+			if s.targetID == "" {
+				fmt.Fprintf(body, "\t\tcommonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID()))\n")
+			} else {
+				fmt.Fprintf(body, "\t\tcommonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(%s)),\n\t)\n", s.targetID)
+			}
+		}
+		fmt.Fprintf(body, "\treturn wrapGrpcCall(ctx, c, func(client %s) (%s, error) {\n", typeName(s.grpcClient), typeName(resp))
+		fmt.Fprintf(body, "\t\treturn client.%s(ctx, req)\n\t})\n}\n", method.Name)
+	}
+
+	out := &bytes.Buffer{}
+	fmt.Fprintf(out, "// Code generated by clientgen. DO NOT EDIT.\n\npackage %s\n\nimport (\n\t\"context\"\n\n", pkgName)
+	paths := make([]string, 0, len(imports))
+	for pkg := range imports {
+		paths = append(paths, pkg)
+	}
+	sort.Strings(paths)
+	for _, pkg := range paths {
+		fmt.Fprintf(out, "\t%q\n", pkg)
+	}
+	fmt.Fprintf(out, ")\n")
+	out.Write(body.Bytes())
+
+	return format.Source(out.Bytes())
+}
+
+// parseClientPackage returns the package name and the methods of Client written by hand.
+func parseClientPackage(dir string) (string, map[string]struct{}, error) {
+	pkgs, err := parser.ParseDir(token.NewFileSet(), dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go") && info.Name() != generatedFile
+	}, 0)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(pkgs) != 1 {
+		return "", nil, fmt.Errorf("expect one package in %s, got %d", dir, len(pkgs))
+	}
+
+	var pkgName string
+	methods := make(map[string]struct{})
+	for name, pkg := range pkgs {
+		pkgName = name
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				fn, ok := decl.(*ast.FuncDecl)
+				if !ok || fn.Recv == nil || len(fn.Recv.List) != 1 {
+					continue
+				}
+				if star, ok := fn.Recv.List[0].Type.(*ast.StarExpr); ok {
+					if ident, ok := star.X.(*ast.Ident); ok && ident.Name == "Client" {
+						methods[fn.Name.Name] = struct{}{}
+					}
+				}
+			}
+		}
+	}
+	return pkgName, methods, nil
+}
+
+// isUnaryCall checks whether the component method is
+// func(context.Context, *Req) (*Resp, error), which is served by the grpc method
+// func(context.Context, *Req, ...grpc.CallOption) (*Resp, error).
+func isUnaryCall(method, grpcMethod reflect.Type) bool {
+	if method.NumIn() != 2 || method.NumOut() != 2 || grpcMethod.NumIn() != 3 || grpcMethod.NumOut() != 2 {
+		return false
+	}
+	if method.In(0) != contextType || method.Out(1) != errorType {
+		return false
+	}
+	req, resp := method.In(1), method.Out(0)
+	if req.Kind() != reflect.Pointer || resp.Kind() != reflect.Pointer {
+		return false
+	}
+	return grpcMethod.In(1) == req && grpcMethod.Out(0) == resp
+}
+
+// typeName returns the name of the type qualified by its package name.
+func typeName(t reflect.Type) string {
+	if t.Kind() == reflect.Pointer {
+		return "*" + typeName(t.Elem())
+	}
+	return path.Base(t.PkgPath()) + "." + t.Name()
+}
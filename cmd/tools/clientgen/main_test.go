@@ -0,0 +1,40 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGeneratedClientsUpToDate fails if the component interfaces changed without regenerating the clients.
+func TestGeneratedClientsUpToDate(t *testing.T) {
+	root := filepath.Join("..", "..", "..")
+	for _, s := range services {
+		t.Run(s.name, func(t *testing.T) {
+			expected, err := generate(root, s)
+			require.NoError(t, err)
+			actual, err := os.ReadFile(filepath.Join(root, s.dir, generatedFile))
+			require.NoError(t, err)
+			assert.Equal(t, string(expected), string(actual), "run `make generate-clients` to regenerate the clients")
+		})
+	}
+}
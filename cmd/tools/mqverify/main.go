@@ -0,0 +1,113 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// mqverify consumes the physical channel of a vchannel and verifies the ordering assumptions of milvus:
+// timeticks are monotonic, messages are delivered between the timeticks covering their ts,
+// and no message is delivered twice. It exits with code 1 if any violation is found.
+//
+// The mq is configured by milvus.yaml. The embedded mq (rocksmq, natsmq) of standalone mode is opened
+// from its data directory, so the standalone which owns it must be stopped before verifying.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/util/dependency"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+var (
+	vchannel   = flag.String("vchannel", "", "VChannel to verify, like by-dev-rootcoord-dml_0_443v0")
+	standalone = flag.Bool("standalone", false, "Use the mq of standalone mode")
+	duration   = flag.Duration("duration", time.Minute, "Stop verifying after the duration")
+	maxMsgs    = flag.Int("max", 0, "Stop verifying after consuming the number of messages, 0 means unlimited")
+	latest     = flag.Bool("latest", false, "Consume from the latest position instead of the earliest one")
+)
+
+func main() {
+	flag.Parse()
+	if *vchannel == "" {
+		fmt.Println("usage: mqverify -vchannel by-dev-rootcoord-dml_0_443v0 [-standalone] [-duration 1m] [-max 0] [-latest]")
+		os.Exit(2)
+	}
+	if !verify() {
+		os.Exit(1)
+	}
+}
+
+// verify returns false if any violation is found.
+func verify() bool {
+	paramtable.Init()
+	factory := dependency.NewFactory(*standalone)
+	factory.Init(paramtable.Get())
+
+	ctx, cancel := context.WithTimeout(context.Background(), *duration)
+	defer cancel()
+
+	stream, err := factory.NewMsgStream(ctx)
+	if err != nil {
+		log.Fatal("failed to create msgstream", zap.Error(err))
+	}
+	defer stream.Close()
+
+	pchannel := funcutil.ToPhysicalChannel(*vchannel)
+	subName := fmt.Sprintf("mq-verify-%d", time.Now().UnixNano())
+	position := mqwrapper.SubscriptionPositionEarliest
+	if *latest {
+		position = mqwrapper.SubscriptionPositionLatest
+	}
+	stream.AsConsumer([]string{pchannel}, subName, position)
+	defer func() {
+		// the subscription is useless after verifying.
+		if err := factory.NewMsgStreamDisposer(context.Background())([]string{pchannel}, subName); err != nil {
+			log.Warn("failed to remove subscription", zap.String("subName", subName), zap.Error(err))
+		}
+	}()
+
+	verifier := msgstream.NewOrderVerifier(*vchannel)
+	consumed := 0
+loop:
+	for {
+		select {
+		case <-ctx.Done():
+			break loop
+		case pack, ok := <-stream.Chan():
+			if !ok {
+				break loop
+			}
+			verifier.Verify(pack)
+			consumed += len(pack.Msgs)
+			if *maxMsgs > 0 && consumed >= *maxMsgs {
+				break loop
+			}
+		}
+	}
+
+	report := verifier.Report()
+	fmt.Printf("pchannel: %s, consumed messages: %d\n", pchannel, consumed)
+	fmt.Print(report.String())
+	return report.OK()
+}
@@ -48,3 +48,4 @@ const int DEFAULT_CPU_NUM = 1;
 
 constexpr const char* RADIUS = knowhere::meta::RADIUS;
 constexpr const char* RANGE_FILTER = knowhere::meta::RANGE_FILTER;
+constexpr const char* EXACT_SEARCH = "exact_search";
@@ -27,6 +27,9 @@ struct SearchInfo {
     FieldId field_id_;
     MetricType metric_type_;
     knowhere::Json search_params_;
+    // bypass the vector indexes and search the raw vectors by brute force,
+    // which gives the ground truth to estimate the recall of the indexes
+    bool exact_search_ = false;
 };
 
 using SearchInfoPtr = std::shared_ptr<SearchInfo>;
@@ -131,6 +131,11 @@ PostfixMatch(const std::string_view str, const std::string_view postfix) {
     return true;
 }
 
+inline bool
+InnerMatch(const std::string_view str, const std::string_view substr) {
+    return str.find(substr) != std::string_view::npos;
+}
+
 inline int64_t
 upper_align(int64_t value, int64_t align) {
     Assert(align > 0);
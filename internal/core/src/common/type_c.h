@@ -85,8 +85,11 @@ typedef struct CStorageConfig {
     const char* storage_type;
     const char* iam_endpoint;
     const char* log_level;
+    const char* role_arn;
+    const char* sts_endpoint;
     bool useSSL;
     bool useIAM;
+    int64_t session_duration;
 } CStorageConfig;
 
 typedef struct CTraceConfig {
@@ -17,6 +17,7 @@
 #include <string>
 #include "index/ScalarIndexSort.h"
 #include "index/StringIndexMarisa.h"
+#include "index/StringIndexNGram.h"
 #include "index/BoolIndex.h"
 
 namespace milvus::index {
@@ -38,6 +39,9 @@ template <>
 inline ScalarIndexPtr<std::string>
 IndexFactory::CreateScalarIndex(const IndexType& index_type,
                                 storage::FileManagerImplPtr file_manager) {
+    if (index_type == NGRAM) {
+        return CreateStringIndexNGram(file_manager);
+    }
 #if defined(__linux__) || defined(__APPLE__)
     return CreateStringIndexMarisa(file_manager);
 #else
@@ -26,6 +26,7 @@ constexpr const char* LOWER_BOUND_INCLUSIVE = "lower_bound_inclusive";
 constexpr const char* UPPER_BOUND_VALUE = "upper_bound_value";
 constexpr const char* UPPER_BOUND_INCLUSIVE = "upper_bound_inclusive";
 constexpr const char* PREFIX_VALUE = "prefix_value";
+constexpr const char* MATCH_VALUE = "match_value";
 // below configurations will be persistent, do not edit them.
 constexpr const char* MARISA_TRIE_INDEX = "marisa_trie_index";
 constexpr const char* MARISA_STR_IDS = "marisa_trie_str_ids";
@@ -36,6 +37,7 @@ constexpr const char* METRIC_TYPE = "metric_type";
 // scalar index type
 constexpr const char* ASCENDING_SORT = "STL_SORT";
 constexpr const char* MARISA_TRIE = "Trie";
+constexpr const char* NGRAM = "NGRAM";
 
 // index meta
 constexpr const char* COLLECTION_ID = "collection_id";
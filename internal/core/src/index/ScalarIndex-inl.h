@@ -18,6 +18,7 @@
 #include <string>
 #include <vector>
 
+#include "common/Utils.h"
 #include "index/Meta.h"
 #include "knowhere/dataset.h"
 
@@ -60,8 +61,27 @@ ScalarIndex<T>::Query(const DatasetPtr& dataset) {
             return NotIn(n, reinterpret_cast<const T*>(values));
         }
 
-        case OpType::PrefixMatch:
         case OpType::PostfixMatch:
+        case OpType::Match: {
+            if constexpr (std::is_same_v<T, std::string>) {
+                // indexes without gram support fall back to scanning the
+                // stored values, NGRAM index overrides this path.
+                auto value = dataset->Get<std::string>(MATCH_VALUE);
+                auto cnt = Count();
+                TargetBitmap bitset(cnt);
+                for (int64_t i = 0; i < cnt; ++i) {
+                    auto str = Reverse_Lookup(i);
+                    bitset[i] = op == OpType::Match
+                                    ? milvus::InnerMatch(str, value)
+                                    : milvus::PostfixMatch(str, value);
+                }
+                return bitset;
+            }
+            throw std::invalid_argument(std::string(
+                "unsupported operator type: " + std::to_string(op)));
+        }
+
+        case OpType::PrefixMatch:
         default:
             throw std::invalid_argument(std::string(
                 "unsupported operator type: " + std::to_string(op)));
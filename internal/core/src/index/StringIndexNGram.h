@@ -0,0 +1,174 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#pragma once
+
+#include <algorithm>
+#include <iterator>
+#include <memory>
+#include <string>
+#include <string_view>
+#include <unordered_map>
+#include <vector>
+
+#include "common/Utils.h"
+#include "index/Meta.h"
+#include "index/StringIndexSort.h"
+
+namespace milvus::index {
+
+// length of the grams kept in the posting lists, trigram.
+constexpr size_t NGRAM_SIZE = 3;
+
+// StringIndexNGram keeps the sorted storage of StringIndexSort and builds a
+// gram -> rows posting list on top of it, so that substring and postfix
+// matches (LIKE '%foo%', LIKE '%foo') only verify candidate rows instead of
+// scanning the whole column. Only the sorted data is serialized, posting
+// lists are rebuilt after build and load.
+class StringIndexNGram : public StringIndexSort {
+ public:
+    explicit StringIndexNGram(
+        storage::FileManagerImplPtr file_manager = nullptr)
+        : StringIndexSort(file_manager) {
+    }
+
+    void
+    Build(size_t n, const std::string* values) override {
+        StringIndexSort::Build(n, values);
+        BuildGrams();
+    }
+
+    void
+    Build(const Config& config = {}) override {
+        StringIndexSort::Build(config);
+        BuildGrams();
+    }
+
+    void
+    Load(const BinarySet& index_binary, const Config& config = {}) override {
+        StringIndexSort::Load(index_binary, config);
+        BuildGrams();
+    }
+
+    void
+    Load(const Config& config = {}) override {
+        StringIndexSort::Load(config);
+        BuildGrams();
+    }
+
+    const TargetBitmap
+    Query(const DatasetPtr& dataset) override {
+        auto op = dataset->Get<OpType>(OPERATOR_TYPE);
+        if (op == OpType::Match) {
+            auto substr = dataset->Get<std::string>(MATCH_VALUE);
+            return InnerMatch(substr);
+        }
+        if (op == OpType::PostfixMatch) {
+            auto postfix = dataset->Get<std::string>(MATCH_VALUE);
+            return PostfixMatch(postfix);
+        }
+        return StringIndexSort::Query(dataset);
+    }
+
+    const TargetBitmap
+    InnerMatch(std::string_view substr) {
+        return MatchCandidates(substr, [&](std::string_view str) {
+            return milvus::InnerMatch(str, substr);
+        });
+    }
+
+    const TargetBitmap
+    PostfixMatch(std::string_view postfix) {
+        return MatchCandidates(postfix, [&](std::string_view str) {
+            return milvus::PostfixMatch(str, postfix);
+        });
+    }
+
+ private:
+    template <typename Pred>
+    const TargetBitmap
+    MatchCandidates(std::string_view value, Pred pred) {
+        const auto& data = GetData();
+        TargetBitmap bitset(data.size());
+
+        // too short to be covered by a gram, verify every row.
+        if (value.size() < NGRAM_SIZE) {
+            for (const auto& item : data) {
+                if (pred(item.a_)) {
+                    bitset[item.idx_] = true;
+                }
+            }
+            return bitset;
+        }
+
+        std::vector<int32_t> candidates;
+        for (size_t i = 0; i + NGRAM_SIZE <= value.size(); ++i) {
+            auto it = grams_.find(std::string(value.substr(i, NGRAM_SIZE)));
+            if (it == grams_.end()) {
+                return bitset;
+            }
+            if (i == 0) {
+                candidates = it->second;
+                continue;
+            }
+            std::vector<int32_t> merged;
+            std::set_intersection(candidates.begin(),
+                                  candidates.end(),
+                                  it->second.begin(),
+                                  it->second.end(),
+                                  std::back_inserter(merged));
+            candidates.swap(merged);
+            if (candidates.empty()) {
+                return bitset;
+            }
+        }
+
+        // grams only prune, the order of the grams still has to be checked.
+        for (auto pos : candidates) {
+            if (pred(data[pos].a_)) {
+                bitset[data[pos].idx_] = true;
+            }
+        }
+        return bitset;
+    }
+
+    void
+    BuildGrams() {
+        grams_.clear();
+        const auto& data = GetData();
+        for (int32_t pos = 0; pos < static_cast<int32_t>(data.size()); ++pos) {
+            std::string_view str = data[pos].a_;
+            for (size_t i = 0; i + NGRAM_SIZE <= str.size(); ++i) {
+                auto& postings =
+                    grams_[std::string(str.substr(i, NGRAM_SIZE))];
+                if (postings.empty() || postings.back() != pos) {
+                    postings.push_back(pos);
+                }
+            }
+        }
+    }
+
+ private:
+    // gram -> positions in the sorted data, ascending.
+    std::unordered_map<std::string, std::vector<int32_t>> grams_;
+};
+using StringIndexNGramPtr = std::unique_ptr<StringIndexNGram>;
+
+inline StringIndexNGramPtr
+CreateStringIndexNGram(storage::FileManagerImplPtr file_manager = nullptr) {
+    return std::make_unique<StringIndexNGram>(file_manager);
+}
+}  // namespace milvus::index
@@ -27,6 +27,11 @@ namespace milvus::index {
 // TODO: should inherit from StringIndex?
 class StringIndexSort : public ScalarIndexSort<std::string> {
  public:
+    explicit StringIndexSort(
+        storage::FileManagerImplPtr file_manager = nullptr)
+        : ScalarIndexSort<std::string>(file_manager) {
+    }
+
     const TargetBitmap
     Query(const DatasetPtr& dataset) override {
         auto op = dataset->Get<OpType>(OPERATOR_TYPE);
@@ -295,6 +295,10 @@ NewBuildIndexInfo(CBuildIndexInfo* c_build_index_info,
             std::string(c_storage_config.storage_type);
         storage_config.iam_endpoint =
             std::string(c_storage_config.iam_endpoint);
+        storage_config.role_arn = std::string(c_storage_config.role_arn);
+        storage_config.sts_endpoint =
+            std::string(c_storage_config.sts_endpoint);
+        storage_config.session_duration = c_storage_config.session_duration;
         storage_config.useSSL = c_storage_config.useSSL;
         storage_config.useIAM = c_storage_config.useIAM;
 
@@ -17,6 +17,7 @@
 #include <string>
 
 #include "ExprImpl.h"
+#include "common/Consts.h"
 #include "common/VectorTrait.h"
 #include "exceptions/EasyAssert.h"
 #include "generated/ExtractInfoExprVisitor.h"
@@ -183,6 +184,11 @@ ProtoParser::PlanNodeFromProto(const planpb::PlanNode& plan_node_proto) {
     search_info.topk_ = query_info_proto.topk();
     search_info.round_decimal_ = query_info_proto.round_decimal();
     search_info.search_params_ = json::parse(query_info_proto.search_params());
+    if (search_info.search_params_.contains(EXACT_SEARCH)) {
+        search_info.exact_search_ =
+            search_info.search_params_[EXACT_SEARCH].get<bool>();
+        search_info.search_params_.erase(EXACT_SEARCH);
+    }
 
     auto plan_node = [&]() -> std::unique_ptr<VectorPlanNode> {
         if (anns_proto.is_binary()) {
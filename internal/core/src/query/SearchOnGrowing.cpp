@@ -87,7 +87,8 @@ SearchOnGrowing(const segcore::SegmentGrowingImpl& segment,
     dataset::SearchDataset search_dataset{
         metric_type, num_queries, topk, round_decimal, dim, query_data};
 
-    if (segment.get_indexing_record().SyncDataWithIndex(field.get_id())) {
+    if (!info.exact_search_ &&
+        segment.get_indexing_record().SyncDataWithIndex(field.get_id())) {
         FloatSegmentIndexSearch(segment,
                                 info,
                                 query_data,
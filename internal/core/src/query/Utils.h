@@ -33,6 +33,8 @@ Match<std::string>(const std::string& str, const std::string& val, OpType op) {
             return PrefixMatch(str, val);
         case OpType::PostfixMatch:
             return PostfixMatch(str, val);
+        case OpType::Match:
+            return InnerMatch(str, val);
         default:
             PanicInfo("not supported");
     }
@@ -48,6 +50,8 @@ Match<std::string_view>(const std::string_view& str,
             return PrefixMatch(str, val);
         case OpType::PostfixMatch:
             return PostfixMatch(str, val);
+        case OpType::Match:
+            return InnerMatch(str, val);
         default:
             PanicInfo("not supported");
     }
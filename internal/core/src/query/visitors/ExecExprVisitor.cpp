@@ -425,7 +425,19 @@ ExecExprVisitor::ExecUnaryRangeVisitorDispatcherImpl(UnaryRangeExpr& expr_raw)
             };
             return ExecRangeVisitorImpl<T>(field_id, index_func, elem_func);
         }
-        // TODO: PostfixMatch
+        case OpType::PostfixMatch:
+        case OpType::Match: {
+            auto index_func = [&](Index* index) {
+                auto dataset = std::make_unique<Dataset>();
+                dataset->Set(milvus::index::OPERATOR_TYPE, op);
+                dataset->Set(milvus::index::MATCH_VALUE, val);
+                return index->Query(std::move(dataset));
+            };
+            auto elem_func = [&](MayConstRef<T> x) {
+                return Match(x, val, op);
+            };
+            return ExecRangeVisitorImpl<T>(field_id, index_func, elem_func);
+        }
         default: {
             PanicInfo("unsupported range node");
         }
@@ -574,14 +586,15 @@ ExecExprVisitor::ExecUnaryRangeVisitorDispatcherJson(UnaryRangeExpr& expr_raw)
             return ExecRangeVisitorImpl<milvus::Json>(
                 field_id, index_func, elem_func);
         }
-        case OpType::PrefixMatch: {
+        case OpType::PrefixMatch:
+        case OpType::PostfixMatch:
+        case OpType::Match: {
             auto elem_func = [&](const milvus::Json& json) {
                 UnaryRangeJSONCompare(Match(ExprValueType(x.value()), val, op));
             };
             return ExecRangeVisitorImpl<milvus::Json>(
                 field_id, index_func, elem_func);
         }
-        // TODO: PostfixMatch
         default: {
             PanicInfo("unsupported range node");
         }
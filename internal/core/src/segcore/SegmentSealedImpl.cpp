@@ -17,6 +17,7 @@
 #include <cstdint>
 #include <filesystem>
 #include <memory>
+#include <numeric>
 #include <string>
 #include <string_view>
 #include <vector>
@@ -557,7 +558,37 @@ SegmentSealedImpl::vector_search(SearchInfo& search_info,
 
     AssertInfo(field_meta.is_vector(),
                "The meta type of vector field is not vector type");
-    if (get_bit(index_ready_bitset_, field_id)) {
+    if (search_info.exact_search_ && get_bit(index_ready_bitset_, field_id) &&
+        !get_bit(field_data_ready_bitset_, field_id)) {
+        // the raw vectors are dropped once the index is loaded,
+        // fetch them back from the index if it keeps the raw data
+        auto field_indexing = vector_indexings_.get_field_indexing(field_id);
+        auto vec_index =
+            dynamic_cast<index::VectorIndex*>(field_indexing->indexing_.get());
+        if (vec_index->HasRawData()) {
+            AssertInfo(row_count_opt_.has_value(), "Can't get row count value");
+            auto row_count = row_count_opt_.value();
+            std::vector<int64_t> offsets(row_count);
+            std::iota(offsets.begin(), offsets.end(), 0);
+            auto ids_ds = GenIdsDataset(row_count, offsets.data());
+            auto vec_data = vec_index->GetVector(ids_ds);
+            query::SearchOnSealed(*schema_,
+                                  vec_data.data(),
+                                  search_info,
+                                  query_data,
+                                  query_count,
+                                  row_count,
+                                  bitset,
+                                  output);
+            return;
+        }
+        LOG_SEGCORE_WARNING_ << "exact search falls back to the index of field "
+                             << field_id.get()
+                             << " since the index keeps no raw data";
+    }
+    if (get_bit(index_ready_bitset_, field_id) &&
+        !(search_info.exact_search_ &&
+          get_bit(field_data_ready_bitset_, field_id))) {
         AssertInfo(vector_indexings_.is_ready(field_id),
                    "vector indexes isn't ready for field " +
                        std::to_string(field_id.get()));
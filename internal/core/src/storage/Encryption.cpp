@@ -0,0 +1,149 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include "storage/Encryption.h"
+
+#include <cstring>
+#include <mutex>
+
+#include <openssl/evp.h>
+#include <openssl/hmac.h>
+
+#include "exceptions/EasyAssert.h"
+
+namespace milvus::storage {
+
+namespace {
+constexpr char kMagic[] = "\x00MVSENC\x01";
+constexpr int64_t kMagicSize = 8;
+constexpr int64_t kAADSize = kMagicSize + 8 + 8;
+constexpr int64_t kNonceSize = 12;
+constexpr int64_t kTagSize = 16;
+constexpr int64_t kHeaderSize = kAADSize + kNonceSize;
+constexpr int64_t kMasterKeySize = 32;
+
+int64_t
+ReadInt64(const uint8_t* data) {
+    // little endian, in line with the binlogs
+    uint64_t value = 0;
+    for (int i = 7; i >= 0; i--) {
+        value = (value << 8) | data[i];
+    }
+    return static_cast<int64_t>(value);
+}
+}  // namespace
+
+void
+EncryptionKeyring::Init(const std::string& encoded_master_key) {
+    std::unique_lock lck(mutex_);
+    master_key_.clear();
+    if (encoded_master_key.empty()) {
+        return;
+    }
+    std::vector<uint8_t> decoded(encoded_master_key.size());
+    auto size = EVP_DecodeBlock(
+        decoded.data(),
+        reinterpret_cast<const uint8_t*>(encoded_master_key.data()),
+        encoded_master_key.size());
+    AssertInfo(size >= 0, "invalid encryption master key");
+    // EVP_DecodeBlock keeps the padding as zero bytes
+    auto padding = 0;
+    for (auto it = encoded_master_key.rbegin();
+         it != encoded_master_key.rend() && *it == '=';
+         ++it) {
+        padding++;
+    }
+    size -= padding;
+    AssertInfo(size == kMasterKeySize,
+               "invalid encryption master key, expected " +
+                   std::to_string(kMasterKeySize) + " bytes, got " +
+                   std::to_string(size));
+    master_key_.assign(decoded.begin(), decoded.begin() + size);
+}
+
+bool
+EncryptionKeyring::IsEncrypted(const uint8_t* data, int64_t size) {
+    return size >= kAADSize && std::memcmp(data, kMagic, kMagicSize) == 0;
+}
+
+std::vector<uint8_t>
+EncryptionKeyring::DeriveKey(int64_t collection_id, int64_t key_version) {
+    std::shared_lock lck(mutex_);
+    AssertInfo(!master_key_.empty(),
+               "encryption master key is not configured");
+    auto info =
+        std::to_string(collection_id) + "/" + std::to_string(key_version);
+    std::vector<uint8_t> key(EVP_MAX_MD_SIZE);
+    unsigned int key_size = 0;
+    auto ret = HMAC(EVP_sha256(),
+                    master_key_.data(),
+                    master_key_.size(),
+                    reinterpret_cast<const uint8_t*>(info.data()),
+                    info.size(),
+                    key.data(),
+                    &key_size);
+    AssertInfo(ret != nullptr, "failed to derive the encryption key");
+    key.resize(key_size);
+    return key;
+}
+
+std::vector<uint8_t>
+EncryptionKeyring::Decrypt(const uint8_t* data, int64_t size) {
+    AssertInfo(IsEncrypted(data, size), "object is not encrypted");
+    AssertInfo(size >= kHeaderSize + kTagSize, "encrypted object is truncated");
+    auto collection_id = ReadInt64(data + kMagicSize);
+    auto key_version = ReadInt64(data + kMagicSize + 8);
+    auto key = DeriveKey(collection_id, key_version);
+
+    auto ciphertext = data + kHeaderSize;
+    auto ciphertext_size = size - kHeaderSize - kTagSize;
+    std::vector<uint8_t> plaintext(ciphertext_size);
+
+    std::unique_ptr<EVP_CIPHER_CTX, decltype(&EVP_CIPHER_CTX_free)> ctx(
+        EVP_CIPHER_CTX_new(), EVP_CIPHER_CTX_free);
+    AssertInfo(ctx != nullptr, "failed to create the cipher context");
+    auto ok =
+        EVP_DecryptInit_ex(
+            ctx.get(), EVP_aes_256_gcm(), nullptr, nullptr, nullptr) == 1 &&
+        EVP_CIPHER_CTX_ctrl(
+            ctx.get(), EVP_CTRL_GCM_SET_IVLEN, kNonceSize, nullptr) == 1 &&
+        EVP_DecryptInit_ex(
+            ctx.get(), nullptr, nullptr, key.data(), data + kAADSize) == 1;
+    AssertInfo(ok, "failed to init the cipher");
+
+    int len = 0;
+    ok = EVP_DecryptUpdate(ctx.get(), nullptr, &len, data, kAADSize) == 1 &&
+         EVP_DecryptUpdate(ctx.get(),
+                           plaintext.data(),
+                           &len,
+                           ciphertext,
+                           ciphertext_size) == 1 &&
+         EVP_CIPHER_CTX_ctrl(ctx.get(),
+                             EVP_CTRL_GCM_SET_TAG,
+                             kTagSize,
+                             const_cast<uint8_t*>(ciphertext + ciphertext_size)) ==
+             1;
+    AssertInfo(ok, "failed to decrypt the object");
+    int final_len = 0;
+    AssertInfo(
+        EVP_DecryptFinal_ex(ctx.get(), plaintext.data() + len, &final_len) == 1,
+        "failed to decrypt object of collection " +
+            std::to_string(collection_id) + " with key version " +
+            std::to_string(key_version));
+    return plaintext;
+}
+
+}  // namespace milvus::storage
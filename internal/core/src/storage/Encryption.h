@@ -0,0 +1,66 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#pragma once
+
+#include <cstdint>
+#include <memory>
+#include <shared_mutex>
+#include <string>
+#include <vector>
+
+namespace milvus::storage {
+
+// The binlogs of the collections with encryption enabled are encrypted by the Go ChunkManager,
+// see internal/storage/encryption.go for the layout:
+// | magic (8 bytes) | collection ID (int64) | key version (int64) | nonce (12 bytes) | AES-256-GCM ciphertext and tag |
+// segcore decrypts them with the same master key when loading the segments and building the indexes.
+class EncryptionKeyring {
+ public:
+    static EncryptionKeyring&
+    GetInstance() {
+        static EncryptionKeyring instance;
+        return instance;
+    }
+
+    EncryptionKeyring(const EncryptionKeyring&) = delete;
+    EncryptionKeyring&
+    operator=(const EncryptionKeyring&) = delete;
+
+    // Init sets the base64 encoded 32 bytes master key, empty means the encryption is disabled
+    void
+    Init(const std::string& encoded_master_key);
+
+    static bool
+    IsEncrypted(const uint8_t* data, int64_t size);
+
+    // Decrypt returns the plain text of the encrypted object,
+    // throws if the master key isn't configured or the object is corrupted
+    std::vector<uint8_t>
+    Decrypt(const uint8_t* data, int64_t size);
+
+ private:
+    EncryptionKeyring() = default;
+
+    std::vector<uint8_t>
+    DeriveKey(int64_t collection_id, int64_t key_version);
+
+ private:
+    std::shared_mutex mutex_;
+    std::vector<uint8_t> master_key_;
+};
+
+}  // namespace milvus::storage
@@ -19,6 +19,7 @@
 #include <aws/core/auth/AWSCredentialsProviderChain.h>
 #include <aws/core/auth/STSCredentialsProvider.h>
 #include <aws/core/utils/logging/ConsoleLogSystem.h>
+#include <aws/identity-management/auth/STSAssumeRoleCredentialsProvider.h>
 #include <aws/s3/model/CreateBucketRequest.h>
 #include <aws/s3/model/DeleteBucketRequest.h>
 #include <aws/s3/model/DeleteObjectRequest.h>
@@ -27,6 +28,7 @@
 #include <aws/s3/model/HeadObjectRequest.h>
 #include <aws/s3/model/ListObjectsRequest.h>
 #include <aws/s3/model/PutObjectRequest.h>
+#include <aws/sts/STSClient.h>
 
 #include "storage/MinioChunkManager.h"
 #include "storage/AliyunSTSClient.h"
@@ -161,7 +163,9 @@ void
 MinioChunkManager::BuildS3Client(
     const StorageConfig& storage_config,
     const Aws::Client::ClientConfiguration& config) {
-    if (storage_config.useIAM) {
+    if (!storage_config.role_arn.empty()) {
+        BuildAssumeRoleClient(storage_config, config);
+    } else if (storage_config.useIAM) {
         auto provider =
             std::make_shared<Aws::Auth::DefaultAWSCredentialsProviderChain>();
         auto aws_credentials = provider->GetAWSCredentials();
@@ -200,6 +204,49 @@ MinioChunkManager::BuildAccessKeyClient(
         false);
 }
 
+void
+MinioChunkManager::BuildAssumeRoleClient(
+    const StorageConfig& storage_config,
+    const Aws::Client::ClientConfiguration& config) {
+    AssertInfo(!storage_config.sts_endpoint.empty(),
+               "if assume role, sts endpoint should not be empty");
+    AssertInfo(!storage_config.access_key_id.empty(),
+               "if assume role, access key should not be empty");
+    AssertInfo(!storage_config.access_key_value.empty(),
+               "if assume role, access value should not be empty");
+
+    // the access key is only used to assume the role, the credentials of
+    // the role are refreshed by the provider before they expire
+    Aws::Client::ClientConfiguration sts_config = config;
+    sts_config.endpointOverride =
+        ConvertToAwsString(storage_config.sts_endpoint);
+    auto sts_client = Aws::MakeShared<Aws::STS::STSClient>(
+        "MilvusSTSClient",
+        Aws::Auth::AWSCredentials(
+            ConvertToAwsString(storage_config.access_key_id),
+            ConvertToAwsString(storage_config.access_key_value)),
+        sts_config);
+    auto session_duration = storage_config.session_duration > 0
+                                ? storage_config.session_duration
+                                : DEFAULT_SESSION_DURATION_SECONDS;
+    auto provider =
+        Aws::MakeShared<Aws::Auth::STSAssumeRoleCredentialsProvider>(
+            "MilvusSTSAssumeRoleCredentialsProvider",
+            ConvertToAwsString(storage_config.role_arn),
+            Aws::String("milvus"),
+            Aws::String(),
+            static_cast<int>(session_duration),
+            sts_client);
+
+    client_ = std::make_shared<Aws::S3::S3Client>(
+        provider,
+        config,
+        Aws::Client::AWSAuthV4Signer::PayloadSigningPolicy::Never,
+        false);
+    LOG_SEGCORE_INFO_ << "assume role " << storage_config.role_arn
+                      << " through " << storage_config.sts_endpoint;
+}
+
 void
 MinioChunkManager::BuildAliyunCloudClient(
     const StorageConfig& storage_config,
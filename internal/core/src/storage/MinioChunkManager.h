@@ -45,6 +45,9 @@ namespace milvus::storage {
 
 enum class RemoteStorageType { S3 = 0, GOOGLE_CLOUD = 1, ALIYUN_CLOUD = 2 };
 
+// the default duration of the sessions of the assumed roles
+constexpr int64_t DEFAULT_SESSION_DURATION_SECONDS = 3600;
+
 /**
  * @brief user defined aws logger, redirect aws log to segcore log
  */
@@ -188,6 +191,9 @@ class MinioChunkManager : public ChunkManager {
     void
     BuildAccessKeyClient(const StorageConfig& storage_config,
                          const Aws::Client::ClientConfiguration& config);
+    void
+    BuildAssumeRoleClient(const StorageConfig& storage_config,
+                          const Aws::Client::ClientConfiguration& config);
 
     Aws::SDKOptions sdk_options_;
     static std::atomic<size_t> init_count_;
@@ -90,6 +90,10 @@ struct StorageConfig {
     std::string storage_type = "minio";
     std::string iam_endpoint = "";
     std::string log_level = "error";
+    // assume the role through STS if set, with the access key as the source credentials
+    std::string role_arn = "";
+    std::string sts_endpoint = "";
+    int64_t session_duration = 0;
     bool useSSL = false;
     bool useIAM = false;
 };
@@ -15,6 +15,7 @@
 // limitations under the License.
 
 #include "storage/Util.h"
+#include <cstring>
 #include <memory>
 #include "arrow/array/builder_binary.h"
 #include "arrow/type_fwd.h"
@@ -24,6 +25,7 @@
 #include "storage/FieldDataInterface.h"
 #include "storage/ThreadPool.h"
 #include "storage/LocalChunkManager.h"
+#include "storage/Encryption.h"
 #include "storage/MinioChunkManager.h"
 #include "storage/MemFileManagerImpl.h"
 #include "storage/DiskFileManagerImpl.h"
@@ -353,6 +355,13 @@ DownloadAndDecodeRemoteFile(ChunkManager* chunk_manager,
     auto buf = std::shared_ptr<uint8_t[]>(new uint8_t[fileSize]);
     chunk_manager->Read(file, buf.get(), fileSize);
 
+    if (EncryptionKeyring::IsEncrypted(buf.get(), fileSize)) {
+        auto plaintext =
+            EncryptionKeyring::GetInstance().Decrypt(buf.get(), fileSize);
+        fileSize = plaintext.size();
+        buf = std::shared_ptr<uint8_t[]>(new uint8_t[fileSize]);
+        std::memcpy(buf.get(), plaintext.data(), fileSize);
+    }
     return DeserializeFileData(buf, fileSize);
 }
 
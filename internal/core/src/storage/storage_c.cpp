@@ -18,6 +18,7 @@
 #include "common/CGoHelper.h"
 #include "storage/RemoteChunkManagerSingleton.h"
 #include "storage/LocalChunkManagerSingleton.h"
+#include "storage/Encryption.h"
 
 CStatus
 GetLocalUsedSize(const char* c_dir, int64_t* size) {
@@ -65,6 +66,10 @@ InitRemoteChunkManagerSingleton(CStorageConfig c_storage_config) {
         storage_config.iam_endpoint =
             std::string(c_storage_config.iam_endpoint);
         storage_config.log_level = std::string(c_storage_config.log_level);
+        storage_config.role_arn = std::string(c_storage_config.role_arn);
+        storage_config.sts_endpoint =
+            std::string(c_storage_config.sts_endpoint);
+        storage_config.session_duration = c_storage_config.session_duration;
         storage_config.useSSL = c_storage_config.useSSL;
         storage_config.useIAM = c_storage_config.useIAM;
         milvus::storage::RemoteChunkManagerSingleton::GetInstance().Init(
@@ -80,3 +85,14 @@ void
 CleanRemoteChunkManagerSingleton() {
     milvus::storage::RemoteChunkManagerSingleton::GetInstance().Release();
 }
+
+CStatus
+InitEncryptionKeyring(const char* c_master_key) {
+    try {
+        milvus::storage::EncryptionKeyring::GetInstance().Init(
+            std::string(c_master_key));
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(UnexpectedError, e.what());
+    }
+}
@@ -33,6 +33,9 @@ InitRemoteChunkManagerSingleton(CStorageConfig c_storage_config);
 void
 CleanRemoteChunkManagerSingleton();
 
+CStatus
+InitEncryptionKeyring(const char* master_key);
+
 #ifdef __cplusplus
 };
 #endif
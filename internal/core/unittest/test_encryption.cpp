@@ -0,0 +1,63 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License
+
+#include <gtest/gtest.h>
+#include <string>
+#include <vector>
+
+#include "storage/Encryption.h"
+
+using milvus::storage::EncryptionKeyring;
+
+// encrypted by the Go EncryptedChunkManager with the master key below,
+// collection 7, key version 3, see internal/storage/encryption.go
+static const std::vector<uint8_t> kEncryptedObject = {
+    0x00, 0x4d, 0x56, 0x53, 0x45, 0x4e, 0x43, 0x01, 0x07, 0x00, 0x00,
+    0x00, 0x00, 0x00, 0x00, 0x00, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00,
+    0x00, 0x00, 0x52, 0x00, 0x65, 0x0d, 0xa2, 0x3b, 0xce, 0xa0, 0x9e,
+    0xa7, 0x04, 0x88, 0x3f, 0xcd, 0xa3, 0x3e, 0xd7, 0xa2, 0xfe, 0xd6,
+    0xec, 0x0b, 0xfc, 0xa0, 0xc2, 0x47, 0x01, 0xbc, 0x17, 0xc6, 0xe2,
+    0xd0, 0x17, 0xa9, 0x3e, 0x41, 0x59, 0x6e, 0x75, 0xa8, 0xa9};
+
+static const char* kMasterKey = "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY=";
+
+TEST(Encryption, Decrypt) {
+    auto& keyring = EncryptionKeyring::GetInstance();
+    keyring.Init(kMasterKey);
+
+    EXPECT_TRUE(EncryptionKeyring::IsEncrypted(kEncryptedObject.data(),
+                                               kEncryptedObject.size()));
+    auto plaintext =
+        keyring.Decrypt(kEncryptedObject.data(), kEncryptedObject.size());
+    EXPECT_EQ(std::string(plaintext.begin(), plaintext.end()),
+              "hello segcore");
+
+    // tampered
+    auto tampered = kEncryptedObject;
+    tampered.back() ^= 0xff;
+    EXPECT_ANY_THROW(keyring.Decrypt(tampered.data(), tampered.size()));
+
+    // plain text
+    std::string plain = "plain text";
+    EXPECT_FALSE(EncryptionKeyring::IsEncrypted(
+        reinterpret_cast<const uint8_t*>(plain.data()), plain.size()));
+
+    keyring.Init("");
+}
+
+TEST(Encryption, MasterKey) {
+    auto& keyring = EncryptionKeyring::GetInstance();
+    EXPECT_ANY_THROW(keyring.Init("c2hvcnQ="));
+    // not configured
+    keyring.Init("");
+    EXPECT_ANY_THROW(
+        keyring.Decrypt(kEncryptedObject.data(), kEncryptedObject.size()));
+}
@@ -16,6 +16,7 @@
 
 #define private public
 #include "index/StringIndexMarisa.h"
+#include "index/StringIndexNGram.h"
 
 #include "index/IndexFactory.h"
 #include "test_utils/indexbuilder_test_utils.h"
@@ -338,3 +339,66 @@ TEST_F(StringIndexMarisaTest, BaseIndexCodec) {
         }
     }
 }
+
+class StringIndexNGramTest : public ::testing::Test {
+    void
+    SetUp() override {
+        strs = {"milvus", "vector", "database", "vectordb", "mi", ""};
+    }
+
+ protected:
+    std::vector<std::string> strs;
+};
+
+TEST_F(StringIndexNGramTest, InnerMatch) {
+    auto index = milvus::index::CreateStringIndexNGram();
+    index->Build(strs.size(), strs.data());
+
+    auto bitset = index->InnerMatch("ecto");
+    ASSERT_EQ(bitset.size(), strs.size());
+    ASSERT_EQ(Count(bitset), 2u);
+    ASSERT_TRUE(bitset[1]);
+    ASSERT_TRUE(bitset[3]);
+
+    // shorter than a gram, verified on every row.
+    bitset = index->InnerMatch("mi");
+    ASSERT_EQ(Count(bitset), 2u);
+    ASSERT_TRUE(bitset[0]);
+    ASSERT_TRUE(bitset[4]);
+
+    bitset = index->InnerMatch("vectorx");
+    ASSERT_TRUE(BitSetNone(bitset));
+}
+
+TEST_F(StringIndexNGramTest, PostfixMatch) {
+    auto index = milvus::index::CreateStringIndexNGram();
+    index->Build(strs.size(), strs.data());
+
+    auto bitset = index->PostfixMatch("db");
+    ASSERT_EQ(Count(bitset), 1u);
+    ASSERT_TRUE(bitset[3]);
+
+    bitset = index->PostfixMatch("base");
+    ASSERT_EQ(Count(bitset), 1u);
+    ASSERT_TRUE(bitset[2]);
+}
+
+TEST_F(StringIndexNGramTest, Codec) {
+    auto index = milvus::index::CreateStringIndexNGram();
+    index->Build(strs.size(), strs.data());
+
+    auto copy_index = milvus::index::CreateStringIndexNGram();
+    auto binary_set = index->Serialize(nullptr);
+    copy_index->Load(binary_set);
+
+    auto ds = std::make_shared<knowhere::DataSet>();
+    ds->Set<milvus::OpType>(milvus::index::OPERATOR_TYPE,
+                            milvus::OpType::Match);
+    ds->Set<std::string>(milvus::index::MATCH_VALUE, "tab");
+    auto bitset = copy_index->Query(ds);
+    ASSERT_EQ(Count(bitset), 1u);
+    ASSERT_TRUE(bitset[2]);
+
+    bitset = copy_index->PrefixMatch("vec");
+    ASSERT_EQ(Count(bitset), 2u);
+}
@@ -82,6 +82,8 @@ delete_cstorage_config(CStorageConfig config) {
     delete[] config.root_path;
     delete[] config.storage_type;
     delete[] config.iam_endpoint;
+    delete[] config.role_arn;
+    delete[] config.sts_endpoint;
 }
 
 class TestConfigWrapper {
@@ -145,8 +147,11 @@ class TestConfigWrapper {
         config_.root_path = new char[rootPath.length() + 1];
         config_.storage_type = new char[storage_type.length() + 1];
         config_.iam_endpoint = new char[iamEndPoint.length() + 1];
+        config_.role_arn = new char[1];
+        config_.sts_endpoint = new char[1];
         config_.useSSL = useSSL;
         config_.useIAM = useIam;
+        config_.session_duration = 0;
 
         strcpy(const_cast<char*>(config_.address), endpoint.c_str());
         strcpy(const_cast<char*>(config_.bucket_name), bucketName.c_str());
@@ -156,6 +161,8 @@ class TestConfigWrapper {
         strcpy(const_cast<char*>(config_.root_path), rootPath.c_str());
         strcpy(const_cast<char*>(config_.storage_type), storage_type.c_str());
         strcpy(const_cast<char*>(config_.iam_endpoint), iamEndPoint.c_str());
+        strcpy(const_cast<char*>(config_.role_arn), "");
+        strcpy(const_cast<char*>(config_.sts_endpoint), "");
     }
 
  private:
@@ -0,0 +1,157 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// auditLogPrefix is the prefix of the audit events in the meta kv,
+// the events are keyed by the recording time in nanoseconds, so the keys are ordered by time.
+const auditLogPrefix = "datacoord-audit"
+
+// auditLog is an append-only log of the cluster changes made by DataCoord,
+// e.g. channel assignments, DataNode registrations, compaction plans and GC deletions,
+// which helps to find out what happened in an incident.
+// The oldest events are removed once the log exceeds dataCoord.audit.maxEntries.
+// A nil auditLog records nothing.
+type auditLog struct {
+	mu     sync.Mutex
+	kv     kv.MetaKv
+	keys   []string // ordered keys of the events
+	lastTs int64
+}
+
+func newAuditLog(kv kv.MetaKv) (*auditLog, error) {
+	keys, _, err := kv.LoadWithPrefix(auditLogPrefix + "/")
+	if err != nil {
+		return nil, err
+	}
+	l := &auditLog{
+		kv:   kv,
+		keys: make([]string, 0, len(keys)),
+	}
+	for _, key := range keys {
+		// the loaded keys may carry the root path of the kv
+		l.keys = append(l.keys, auditLogKey(path.Base(key)))
+	}
+	sort.Strings(l.keys)
+	l.rotate()
+	return l, nil
+}
+
+func auditLogKey(suffix string) string {
+	return path.Join(auditLogPrefix, suffix)
+}
+
+// Record appends the event to the log, the failure is only logged as the audit log never blocks the cluster changes.
+func (l *auditLog) Record(event *datapb.AuditEvent) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	ts := now.UnixNano()
+	// keep the keys unique and increasing
+	if ts <= l.lastTs {
+		ts = l.lastTs + 1
+	}
+	if event.GetTimestamp() == 0 {
+		event.Timestamp = now.UnixMilli()
+	}
+	value, err := proto.Marshal(event)
+	if err != nil {
+		log.Warn("failed to marshal audit event", zap.String("type", event.GetType().String()), zap.Error(err))
+		return
+	}
+	key := auditLogKey(fmt.Sprintf("%020d", ts))
+	if err := l.kv.Save(key, string(value)); err != nil {
+		log.Warn("failed to record audit event", zap.String("type", event.GetType().String()), zap.Error(err))
+		return
+	}
+	l.lastTs = ts
+	l.keys = append(l.keys, key)
+	l.rotate()
+}
+
+// rotate removes the oldest events beyond the limit, must be called with the lock held.
+func (l *auditLog) rotate() {
+	maxEntries := Params.DataCoordCfg.AuditMaxEntries.GetAsInt()
+	if maxEntries <= 0 || len(l.keys) <= maxEntries {
+		return
+	}
+	removals := l.keys[:len(l.keys)-maxEntries]
+	if err := l.kv.MultiRemove(removals); err != nil {
+		log.Warn("failed to rotate audit log", zap.Int("removals", len(removals)), zap.Error(err))
+		return
+	}
+	l.keys = append(make([]string, 0, maxEntries), l.keys[len(l.keys)-maxEntries:]...)
+}
+
+// List returns the events matching the request, ordered by time.
+// Only the latest events are returned if the request has a limit.
+func (l *auditLog) List(req *datapb.GetAuditEventsRequest) ([]*datapb.AuditEvent, error) {
+	if l == nil {
+		return nil, nil
+	}
+	_, values, err := l.kv.LoadWithPrefix(auditLogPrefix + "/")
+	if err != nil {
+		return nil, err
+	}
+	types := typeutil.NewSet(req.GetTypes()...)
+	events := make([]*datapb.AuditEvent, 0)
+	for _, value := range values {
+		event := &datapb.AuditEvent{}
+		if err := proto.Unmarshal([]byte(value), event); err != nil {
+			return nil, err
+		}
+		if matchAuditEvent(event, req, types) {
+			events = append(events, event)
+		}
+	}
+	if limit := int(req.GetLimit()); limit > 0 && len(events) > limit {
+		events = events[len(events)-limit:]
+	}
+	return events, nil
+}
+
+func matchAuditEvent(event *datapb.AuditEvent, req *datapb.GetAuditEventsRequest, types typeutil.Set[datapb.AuditEventType]) bool {
+	if len(types) > 0 && !types.Contain(event.GetType()) {
+		return false
+	}
+	if req.GetStartTime() > 0 && event.GetTimestamp() < req.GetStartTime() {
+		return false
+	}
+	if req.GetEndTime() > 0 && event.GetTimestamp() > req.GetEndTime() {
+		return false
+	}
+	return req.GetCollectionID() == 0 || event.GetCollectionID() == req.GetCollectionID()
+}
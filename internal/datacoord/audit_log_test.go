@@ -0,0 +1,101 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestAuditLog(t *testing.T) {
+	paramtable.Get().Save(Params.DataCoordCfg.AuditMaxEntries.Key, "3")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.AuditMaxEntries.Key)
+
+	kv := NewMetaMemoryKV()
+	l, err := newAuditLog(kv)
+	require.NoError(t, err)
+
+	l.Record(&datapb.AuditEvent{Type: datapb.AuditEventType_NodeRegistered, NodeID: 1})
+	l.Record(&datapb.AuditEvent{Type: datapb.AuditEventType_ChannelAssigned, NodeID: 1, CollectionID: 100, ChannelName: "ch1"})
+	l.Record(&datapb.AuditEvent{Type: datapb.AuditEventType_CompactionPlanned, NodeID: 1, CollectionID: 200, PlanID: 10})
+	l.Record(&datapb.AuditEvent{Type: datapb.AuditEventType_SegmentRecycled, CollectionID: 100, SegmentIDs: []int64{1}})
+
+	eventTypes := func(events []*datapb.AuditEvent) []datapb.AuditEventType {
+		return lo.Map(events, func(event *datapb.AuditEvent, _ int) datapb.AuditEventType { return event.GetType() })
+	}
+
+	t.Run("rotation", func(t *testing.T) {
+		events, err := l.List(&datapb.GetAuditEventsRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, []datapb.AuditEventType{
+			datapb.AuditEventType_ChannelAssigned,
+			datapb.AuditEventType_CompactionPlanned,
+			datapb.AuditEventType_SegmentRecycled,
+		}, eventTypes(events))
+		for _, event := range events {
+			assert.NotZero(t, event.GetTimestamp())
+		}
+	})
+
+	t.Run("filter", func(t *testing.T) {
+		events, err := l.List(&datapb.GetAuditEventsRequest{CollectionID: 100})
+		require.NoError(t, err)
+		assert.Equal(t, []datapb.AuditEventType{
+			datapb.AuditEventType_ChannelAssigned,
+			datapb.AuditEventType_SegmentRecycled,
+		}, eventTypes(events))
+
+		events, err = l.List(&datapb.GetAuditEventsRequest{Types: []datapb.AuditEventType{datapb.AuditEventType_CompactionPlanned}})
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.EqualValues(t, 10, events[0].GetPlanID())
+
+		events, err = l.List(&datapb.GetAuditEventsRequest{Limit: 1})
+		require.NoError(t, err)
+		assert.Equal(t, []datapb.AuditEventType{datapb.AuditEventType_SegmentRecycled}, eventTypes(events))
+
+		events, err = l.List(&datapb.GetAuditEventsRequest{StartTime: events[0].GetTimestamp() + 1})
+		require.NoError(t, err)
+		assert.Empty(t, events)
+	})
+
+	t.Run("reload", func(t *testing.T) {
+		paramtable.Get().Save(Params.DataCoordCfg.AuditMaxEntries.Key, "2")
+		reloaded, err := newAuditLog(kv)
+		require.NoError(t, err)
+		events, err := reloaded.List(&datapb.GetAuditEventsRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, []datapb.AuditEventType{
+			datapb.AuditEventType_CompactionPlanned,
+			datapb.AuditEventType_SegmentRecycled,
+		}, eventTypes(events))
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		var l *auditLog
+		l.Record(&datapb.AuditEvent{})
+		events, err := l.List(&datapb.GetAuditEventsRequest{})
+		assert.NoError(t, err)
+		assert.Empty(t, events)
+	})
+}
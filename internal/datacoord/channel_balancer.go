@@ -0,0 +1,76 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// ChannelBalancer periodically checks the channel count skew across DataNodes,
+// and releases channels from the most loaded DataNodes with the balance policy of ChannelManager.
+// The released channels are reassigned once the DataNodes ack the releases,
+// so channels get balanced incrementally without waiting for DataNodes to register or unregister.
+type ChannelBalancer struct {
+	manager *ChannelManager
+}
+
+func newChannelBalancer(manager *ChannelManager) *ChannelBalancer {
+	return &ChannelBalancer{manager: manager}
+}
+
+// run checks the balance every ChannelBalanceInterval until ctx is done.
+func (b *ChannelBalancer) run(ctx context.Context) {
+	ticker := time.NewTicker(Params.DataCoordCfg.ChannelBalanceInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("background checking channels loop quit")
+			return
+		case <-ticker.C:
+			b.balance()
+		}
+	}
+}
+
+// balance releases the channels picked by the balance policy, and returns the release operations.
+// It's skipped if the channel manager is not silent, i.e. there are channels being watched or released.
+func (b *ChannelBalancer) balance() ChannelOpSet {
+	c := b.manager
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.isSilent() {
+		log.Info("ChannelManager is not silent, skip channel balance this round")
+		return nil
+	}
+	toReleases := c.balancePolicy(c.schedulableStore(), time.Now())
+	if len(toReleases) == 0 {
+		return nil
+	}
+	log.Info("channel balancer releases channels", zap.Array("toReleases", toReleases))
+	if err := c.updateWithTimer(toReleases, datapb.ChannelWatchState_ToRelease, balancePolicyName); err != nil {
+		log.Warn("channel store update error", zap.Error(err))
+		return nil
+	}
+	return toReleases
+}
@@ -0,0 +1,87 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+func TestChannelBalancer(t *testing.T) {
+	watchkv := getWatchKV(t)
+	defer func() {
+		watchkv.RemoveWithPrefix("")
+		watchkv.Close()
+	}()
+	Params.Save(Params.DataCoordCfg.ChannelBalanceSilentDuration.Key, "0")
+	defer Params.Reset(Params.DataCoordCfg.ChannelBalanceSilentDuration.Key)
+
+	chManager, err := NewChannelManager(watchkv, newMockHandler(), withBgChecker())
+	require.NoError(t, err)
+	assert.NotNil(t, chManager.bgChecker)
+	balancer := newChannelBalancer(chManager)
+
+	chManager.store.Add(1)
+	chManager.store.Add(2)
+	channelNames := make([]string, 0)
+	for i := 0; i < 4; i++ {
+		name := fmt.Sprintf("channel-balancer-%d", i)
+		err = chManager.updateWithTimer(getReleaseOp(1, &channel{Name: name, CollectionID: 1}),
+			datapb.ChannelWatchState_WatchSuccess, assignPolicyName)
+		require.NoError(t, err)
+		channelNames = append(channelNames, name)
+	}
+	chManager.stateTimer.removeTimers(channelNames)
+	require.True(t, chManager.isSilent())
+
+	toReleases := balancer.balance()
+	require.Equal(t, 1, len(toReleases))
+	assert.EqualValues(t, 1, toReleases[0].NodeID)
+	require.Equal(t, 1, len(toReleases[0].Channels))
+	released := toReleases[0].Channels[0].Name
+	assert.Equal(t, "channel-balancer-3", released)
+	history := chManager.getHistory(released)
+	require.NotEmpty(t, history)
+	assert.Equal(t, datapb.ChannelWatchState_ToRelease, history[len(history)-1].GetState())
+	assert.Equal(t, balancePolicyName, history[len(history)-1].GetPolicy())
+
+	// skip balance until the release is done
+	assert.Empty(t, balancer.balance())
+	chManager.stateTimer.removeTimers([]string{released})
+
+	t.Run("quit", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+		go func() {
+			balancer.run(ctx)
+			close(done)
+		}()
+		cancel()
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("channel balancer doesn't quit")
+		}
+	})
+}
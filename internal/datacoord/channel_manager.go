@@ -19,9 +19,12 @@ package datacoord
 import (
 	"context"
 	"fmt"
+	"path"
+	"sort"
 	"sync"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	v3rpc "go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/zap"
@@ -30,12 +33,15 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/metastore"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/logutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
 // ChannelManager manages the allocation and the balance between channels and data nodes.
@@ -44,6 +50,7 @@ type ChannelManager struct {
 	mu               sync.RWMutex
 	h                Handler
 	store            RWChannelStore
+	kv               kv.TxnKV // the kv of the channel watch infos, for migrating the legacy ones
 	factory          ChannelPolicyFactory
 	registerPolicy   RegisterPolicy
 	deregisterPolicy DeregisterPolicy
@@ -58,6 +65,54 @@ type ChannelManager struct {
 	stateTimer   *channelStateTimer
 
 	lastActiveTimestamp time.Time
+
+	history map[string][]*datapb.ChannelWatchStateTransition // channel name -> latest watch state transitions
+	// cordoned nodes get no new channels, the mark is persisted by the catalog if set,
+	// and cleared once the node is uncordoned or deleted
+	cordoned map[int64]struct{}
+	catalog  metastore.DataCoordCatalog
+	// node id -> the latest drain of the node
+	drains map[int64]*drainTask
+	// channel name -> handoff from a deleted node, waiting for another node to watch the channel
+	handoffs map[string]*channelHandoff
+	// consecutive watch failures and quarantined channels
+	retries *channelRetryTracker
+
+	auditLog *auditLog
+}
+
+// channelHandoff is a channel moved away from a deleted node.
+// The subscription of the deleted node is kept until another node watches the channel successfully,
+// so that the MQ won't drop the messages after the channel checkpoint for having no subscription.
+// Handoffs are not persisted, the subscriptions of the pending ones are left if datacoord restarts.
+type channelHandoff struct {
+	fromNodeID UniqueID
+	channel    *channel
+}
+
+// channelHistoryPrefix is the prefix of the watch state transitions of the channels in the kv.
+const channelHistoryPrefix = "datacoord-channel-history"
+
+// Names of the policies recorded in channel watch infos and watch state transitions.
+const (
+	registerPolicyName   = "register"
+	deregisterPolicyName = "deregister"
+	assignPolicyName     = "assign"
+	reassignPolicyName   = "reassign"
+	balancePolicyName    = "balance"
+	releasePolicyName    = "release"
+	drainPolicyName      = "drain"
+	timeoutPolicyName    = "timeout"
+)
+
+// drainCheckInterval is the interval to check whether a draining channel is watched by another node.
+var drainCheckInterval = time.Second
+
+// drainTask is a node draining in background, the state is guarded by the lock of the channel manager.
+type drainTask struct {
+	cancel context.CancelFunc
+	state  datapb.DrainState
+	reason string
 }
 
 type channel struct {
@@ -93,7 +148,15 @@ func withStateChecker() ChannelManagerOpt {
 }
 
 func withBgChecker() ChannelManagerOpt {
-	return func(c *ChannelManager) { c.bgChecker = c.bgCheckChannelsWork }
+	return func(c *ChannelManager) { c.bgChecker = newChannelBalancer(c).run }
+}
+
+func withAuditLog(l *auditLog) ChannelManagerOpt {
+	return func(c *ChannelManager) { c.auditLog = l }
+}
+
+func withCatalog(catalog metastore.DataCoordCatalog) ChannelManagerOpt {
+	return func(c *ChannelManager) { c.catalog = catalog }
 }
 
 // NewChannelManager creates and returns a new ChannelManager instance.
@@ -107,17 +170,33 @@ func NewChannelManager(
 		h:          h,
 		factory:    NewChannelPolicyFactoryV1(kv),
 		store:      NewChannelStore(kv),
+		kv:         kv,
 		stateTimer: newChannelStateTimer(kv),
+		history:    make(map[string][]*datapb.ChannelWatchStateTransition),
+		cordoned:   make(map[int64]struct{}),
+		drains:     make(map[int64]*drainTask),
+		handoffs:   make(map[string]*channelHandoff),
+		retries:    newChannelRetryTracker(),
 	}
 
 	if err := c.store.Reload(); err != nil {
 		return nil, err
 	}
 
+	if err := c.loadHistories(); err != nil {
+		return nil, err
+	}
+
 	for _, opt := range options {
 		opt(c)
 	}
 
+	cordoned, err := c.loadCordoned()
+	if err != nil {
+		return nil, err
+	}
+	c.cordoned = cordoned
+
 	c.registerPolicy = c.factory.NewRegisterPolicy()
 	c.deregisterPolicy = c.factory.NewDeregisterPolicy()
 	c.assignPolicy = c.factory.NewAssignPolicy()
@@ -209,6 +288,18 @@ func (c *ChannelManager) checkOldNodes(nodes []UniqueID) error {
 		nodeWatchInfos[nodeID] = watchInfos
 	}
 
+	c.mu.Lock()
+	for _, watchInfos := range nodeWatchInfos {
+		for _, info := range watchInfos {
+			// the histories were kept in the watch infos by the former versions
+			channelName := info.GetVchan().GetChannelName()
+			if _, ok := c.history[channelName]; !ok && len(info.GetHistory()) > 0 {
+				c.history[channelName] = info.GetHistory()
+			}
+		}
+	}
+	c.mu.Unlock()
+
 	for nodeID, watchInfos := range nodeWatchInfos {
 		for _, info := range watchInfos {
 			channelName := info.GetVchan().GetChannelName()
@@ -266,30 +357,6 @@ func (c *ChannelManager) unwatchDroppedChannels() {
 	}
 }
 
-func (c *ChannelManager) bgCheckChannelsWork(ctx context.Context) {
-	ticker := time.NewTicker(Params.DataCoordCfg.ChannelBalanceInterval.GetAsDuration(time.Second))
-	defer ticker.Stop()
-	for {
-		select {
-		case <-ctx.Done():
-			log.Info("background checking channels loop quit")
-			return
-		case <-ticker.C:
-			c.mu.Lock()
-			if !c.isSilent() {
-				log.Info("ChannelManager is not silent, skip channel balance this round")
-			} else {
-				toReleases := c.balancePolicy(c.store, time.Now())
-				log.Info("channel manager bg check balance", zap.Array("toReleases", toReleases))
-				if err := c.updateWithTimer(toReleases, datapb.ChannelWatchState_ToRelease); err != nil {
-					log.Warn("channel store update error", zap.Error(err))
-				}
-			}
-			c.mu.Unlock()
-		}
-	}
-}
-
 // getOldOnlines returns a list of old online node ids in `old` and in `curr`.
 func (c *ChannelManager) getOldOnlines(curr []int64, old []int64) []int64 {
 	mcurr := make(map[int64]struct{})
@@ -342,7 +409,7 @@ func (c *ChannelManager) AddNode(nodeID int64) error {
 
 	c.store.Add(nodeID)
 
-	updates := c.registerPolicy(c.store, nodeID)
+	updates := c.registerPolicy(c.schedulableStore(), nodeID)
 	if len(updates) <= 0 {
 		log.Info("register node with no reassignment", zap.Int64("registered node", nodeID))
 		return nil
@@ -361,7 +428,7 @@ func (c *ChannelManager) AddNode(nodeID int64) error {
 		}
 	}
 
-	return c.updateWithTimer(updates, state)
+	return c.updateWithTimer(updates, state, registerPolicyName)
 }
 
 // DeleteNode deletes the node from the cluster.
@@ -375,13 +442,13 @@ func (c *ChannelManager) DeleteNode(nodeID int64) error {
 		return nil
 	}
 
-	c.unsubAttempt(nodeChannelInfo)
-
-	updates := c.deregisterPolicy(c.store, nodeID)
+	c.uncordon(nodeID)
+	updates := c.deregisterPolicy(c.schedulableStore(), nodeID)
 	log.Info("deregister node",
 		zap.Int64("nodeID", nodeID),
 		zap.Array("updates", updates))
 	if len(updates) <= 0 {
+		c.unsubAttempt(nodeChannelInfo)
 		return nil
 	}
 
@@ -400,15 +467,45 @@ func (c *ChannelManager) DeleteNode(nodeID int64) error {
 		zap.Strings("channels", chNames), zap.Int64("nodeID", nodeID))
 	c.stateTimer.removeTimers(chNames)
 
-	if err := c.updateWithTimer(updates, datapb.ChannelWatchState_ToWatch); err != nil {
+	if err := c.updateWithTimer(updates, datapb.ChannelWatchState_ToWatch, deregisterPolicyName); err != nil {
 		return err
 	}
+	// the subscriptions of the deleted node are dropped after the channels are watched by other nodes
+	for _, ch := range channels {
+		c.handoffs[ch.Name] = &channelHandoff{fromNodeID: nodeID, channel: ch}
+	}
 
 	// No channels will be return
 	_, err := c.store.Delete(nodeID)
 	return err
 }
 
+// finishHandoff drops the subscription of the deleted node once the channel is watched by another node.
+func (c *ChannelManager) finishHandoff(channelName string, nodeID UniqueID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	handoff, ok := c.handoffs[channelName]
+	if !ok || handoff.fromNodeID == nodeID {
+		return
+	}
+	log.Info("channel handoff finished, drop the subscription of the deleted node",
+		zap.String("channelName", channelName),
+		zap.Int64("from", handoff.fromNodeID),
+		zap.Int64("to", nodeID))
+	c.cancelHandoff(channelName)
+}
+
+// cancelHandoff drops the subscription of the deleted node without waiting for the channel to be watched.
+func (c *ChannelManager) cancelHandoff(channelName string) {
+	handoff, ok := c.handoffs[channelName]
+	if !ok {
+		return
+	}
+	delete(c.handoffs, channelName)
+	c.unsubAttempt(&NodeChannelInfo{NodeID: handoff.fromNodeID, Channels: []*channel{handoff.channel}})
+}
+
 // unsubAttempt attempts to unsubscribe node-channel info from the channel.
 func (c *ChannelManager) unsubAttempt(ncInfo *NodeChannelInfo) {
 	if ncInfo == nil {
@@ -434,7 +531,7 @@ func (c *ChannelManager) Watch(ch *channel) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	updates := c.assignPolicy(c.store, []*channel{ch})
+	updates := c.assignPolicy(c.schedulableStore(), []*channel{ch})
 	if len(updates) == 0 {
 		return nil
 	}
@@ -442,7 +539,7 @@ func (c *ChannelManager) Watch(ch *channel) error {
 		zap.String("channel", ch.String()),
 		zap.Array("updates", updates))
 
-	err := c.updateWithTimer(updates, datapb.ChannelWatchState_ToWatch)
+	err := c.updateWithTimer(updates, datapb.ChannelWatchState_ToWatch, assignPolicyName)
 	if err != nil {
 		log.Warn("fail to update channel watch info with ToWatch state",
 			zap.String("channel", ch.String()), zap.Array("updates", updates), zap.Error(err))
@@ -465,7 +562,7 @@ func (c *ChannelManager) fillChannelWatchInfo(op *ChannelOp) {
 }
 
 // fillChannelWatchInfoWithState updates the channel op by filling in channel watch info.
-func (c *ChannelManager) fillChannelWatchInfoWithState(op *ChannelOp, state datapb.ChannelWatchState) []string {
+func (c *ChannelManager) fillChannelWatchInfoWithState(op *ChannelOp, state datapb.ChannelWatchState, policy string) []string {
 	var channelsWithTimer = []string{}
 	startTs := time.Now().Unix()
 	checkInterval := Params.DataCoordCfg.WatchTimeoutInterval.GetAsDuration(time.Second)
@@ -476,6 +573,7 @@ func (c *ChannelManager) fillChannelWatchInfoWithState(op *ChannelOp, state data
 			StartTs: startTs,
 			State:   state,
 			Schema:  ch.Schema,
+			Policy:  policy,
 		}
 
 		// Only set timer for watchInfo not from bufferID
@@ -489,6 +587,183 @@ func (c *ChannelManager) fillChannelWatchInfoWithState(op *ChannelOp, state data
 	return channelsWithTimer
 }
 
+// appendTransition returns the history of the channel appended with a watch state transition,
+// only the latest `dataCoord.channel.watchHistorySize` transitions are kept.
+// The recorded history is not modified until the returned one is persisted by saveHistories.
+// The caller shall hold the lock.
+func (c *ChannelManager) appendTransition(channelName string, nodeID UniqueID, state datapb.ChannelWatchState, policy string) []*datapb.ChannelWatchStateTransition {
+	limit := Params.DataCoordCfg.ChannelWatchHistorySize.GetAsInt()
+	if limit <= 0 {
+		return nil
+	}
+
+	history := append(c.getHistory(channelName), &datapb.ChannelWatchStateTransition{
+		State:     state,
+		NodeID:    nodeID,
+		Timestamp: time.Now().Unix(),
+		Policy:    policy,
+	})
+	if len(history) > limit {
+		history = history[len(history)-limit:]
+	}
+	return history
+}
+
+// buildChannelHistoryKey returns the key of the watch state transitions of the channel.
+// The histories are kept apart from the watch infos, so that recording a transition
+// never rewrites the watch info which the DataNode is watching.
+func buildChannelHistoryKey(channelName string) string {
+	return path.Join(channelHistoryPrefix, channelName)
+}
+
+// loadHistories loads the persisted watch state transitions of the channels.
+func (c *ChannelManager) loadHistories() error {
+	keys, values, err := c.kv.LoadWithPrefix(channelHistoryPrefix + delimiter)
+	if err != nil {
+		return err
+	}
+	for i, key := range keys {
+		// only the history of the watch info is kept
+		info := &datapb.ChannelWatchInfo{}
+		if err := proto.Unmarshal([]byte(values[i]), info); err != nil {
+			return err
+		}
+		c.history[path.Base(key)] = info.GetHistory()
+	}
+	return nil
+}
+
+// saveHistories persists the watch state transitions of the channels, and records them once persisted.
+// The caller shall hold the lock.
+func (c *ChannelManager) saveHistories(histories map[string][]*datapb.ChannelWatchStateTransition) error {
+	if len(histories) == 0 {
+		return nil
+	}
+	kvs := make(map[string]string, len(histories))
+	for channelName, history := range histories {
+		value, err := proto.Marshal(&datapb.ChannelWatchInfo{History: history})
+		if err != nil {
+			return err
+		}
+		kvs[buildChannelHistoryKey(channelName)] = string(value)
+	}
+	if err := c.kv.MultiSave(kvs); err != nil {
+		return err
+	}
+	for channelName, history := range histories {
+		c.history[channelName] = history
+	}
+	return nil
+}
+
+// recordHistories records the watch state transitions of the persisted channel operations.
+// The caller shall hold the lock.
+func (c *ChannelManager) recordHistories(updates ChannelOpSet) {
+	histories := make(map[string][]*datapb.ChannelWatchStateTransition)
+	for _, op := range updates {
+		if op.Type != Add {
+			continue
+		}
+		for i, ch := range op.Channels {
+			if i >= len(op.ChannelWatchInfos) {
+				continue
+			}
+			info := op.ChannelWatchInfos[i]
+			if history := c.appendTransition(ch.Name, op.NodeID, info.GetState(), info.GetPolicy()); len(history) > 0 {
+				histories[ch.Name] = history
+			}
+		}
+	}
+	if err := c.saveHistories(histories); err != nil {
+		log.Warn("fail to persist the watch state transitions", zap.Array("updates", updates), zap.Error(err))
+	}
+}
+
+// removeHistory removes the watch state transitions of the channel.
+// The caller shall hold the lock.
+func (c *ChannelManager) removeHistory(channelName string) {
+	if err := c.kv.Remove(buildChannelHistoryKey(channelName)); err != nil {
+		log.Warn("fail to remove the watch state transitions", zap.String("channelName", channelName), zap.Error(err))
+	}
+	delete(c.history, channelName)
+}
+
+// getHistory returns a copy of the watch state transitions of the channel.
+// The caller shall hold the lock.
+func (c *ChannelManager) getHistory(channelName string) []*datapb.ChannelWatchStateTransition {
+	history := c.history[channelName]
+	if len(history) == 0 {
+		return nil
+	}
+	return append(make([]*datapb.ChannelWatchStateTransition, 0, len(history)), history...)
+}
+
+// GetChannelWatchHistory returns the node currently holding the channel
+// and the latest watch state transitions of the channel.
+func (c *ChannelManager) GetChannelWatchHistory(channelName string) (UniqueID, []*datapb.ChannelWatchStateTransition, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	nodeID, ch := c.findChannel(channelName)
+	history := c.getHistory(channelName)
+	if ch == nil && len(history) == 0 {
+		return 0, nil, merr.WrapErrChannelNotFound(channelName)
+	}
+	return nodeID, history, nil
+}
+
+// GetChannelWatchStates returns the watch states of the channels of the collection,
+// all the channels are returned if collectionID is 0.
+func (c *ChannelManager) GetChannelWatchStates(collectionID UniqueID) ([]*datapb.ChannelWatchStateInfo, error) {
+	c.mu.RLock()
+	nodeChannels := make(map[int64][]*channel)
+	for _, info := range c.store.GetChannels() {
+		for _, ch := range info.Channels {
+			if collectionID == 0 || ch.CollectionID == collectionID {
+				nodeChannels[info.NodeID] = append(nodeChannels[info.NodeID], ch)
+			}
+		}
+	}
+	c.mu.RUnlock()
+
+	states := make([]*datapb.ChannelWatchStateInfo, 0)
+	for nodeID, channels := range nodeChannels {
+		watchInfos, err := c.stateTimer.loadAllChannels(nodeID)
+		if err != nil {
+			log.Warn("failed to load channel watch infos", zap.Int64("nodeID", nodeID), zap.Error(err))
+			return nil, err
+		}
+		infoMap := make(map[string]*datapb.ChannelWatchInfo, len(watchInfos))
+		for _, info := range watchInfos {
+			infoMap[info.GetVchan().GetChannelName()] = info
+		}
+
+		for _, ch := range channels {
+			state := &datapb.ChannelWatchStateInfo{
+				ChannelName:  ch.Name,
+				CollectionID: ch.CollectionID,
+				NodeID:       nodeID,
+			}
+			if nodeID == bufferID {
+				state.NodeID = 0
+			}
+			if info, ok := infoMap[ch.Name]; ok {
+				state.State = info.GetState()
+				state.StartPosition = info.GetVchan().GetSeekPosition()
+				state.WatchTs = info.GetStartTs()
+				state.Progress = info.GetProgress()
+				state.Policy = info.GetPolicy()
+			}
+			states = append(states, state)
+		}
+	}
+
+	sort.Slice(states, func(i, j int) bool {
+		return states[i].GetChannelName() < states[j].GetChannelName()
+	})
+	return states, nil
+}
+
 // GetChannels gets channels info of registered nodes.
 func (c *ChannelManager) GetChannels() []*NodeChannelInfo {
 	c.mu.RLock()
@@ -572,6 +847,11 @@ func (c *ChannelManager) remove(nodeID int64, ch *channel) error {
 	if err := c.store.Update(op); err != nil {
 		return err
 	}
+	c.recordAudit(op, "channel removed")
+	c.removeHistory(ch.Name)
+	c.retries.reset(ch.Name)
+	// the channel is gone, no node will watch it anymore
+	c.cancelHandoff(ch.Name)
 	return nil
 }
 
@@ -605,11 +885,11 @@ type ackEvent struct {
 	nodeID      UniqueID
 }
 
-func (c *ChannelManager) updateWithTimer(updates ChannelOpSet, state datapb.ChannelWatchState) error {
+func (c *ChannelManager) updateWithTimer(updates ChannelOpSet, state datapb.ChannelWatchState, policy string) error {
 	var channelsWithTimer = []string{}
 	for _, op := range updates {
 		if op.Type == Add {
-			channelsWithTimer = append(channelsWithTimer, c.fillChannelWatchInfoWithState(op, state)...)
+			channelsWithTimer = append(channelsWithTimer, c.fillChannelWatchInfoWithState(op, state, policy)...)
 		}
 	}
 
@@ -617,13 +897,36 @@ func (c *ChannelManager) updateWithTimer(updates ChannelOpSet, state datapb.Chan
 	if err != nil {
 		log.Warn("fail to update", zap.Array("updates", updates), zap.Error(err))
 		c.stateTimer.removeTimers(channelsWithTimer)
+	} else {
+		c.recordHistories(updates)
+		c.recordAudit(updates, fmt.Sprintf("policy: %s, state: %s", policy, state.String()))
 	}
 	c.lastActiveTimestamp = time.Now()
 	return err
 }
 
+// recordAudit records the applied channel operations in the audit log.
+func (c *ChannelManager) recordAudit(updates ChannelOpSet, detail string) {
+	for _, op := range updates {
+		eventType := datapb.AuditEventType_ChannelAssigned
+		if op.Type == Delete {
+			eventType = datapb.AuditEventType_ChannelReleased
+		}
+		for _, ch := range op.Channels {
+			c.auditLog.Record(&datapb.AuditEvent{
+				Type:         eventType,
+				NodeID:       op.NodeID,
+				CollectionID: ch.CollectionID,
+				ChannelName:  ch.Name,
+				Detail:       detail,
+			})
+		}
+	}
+}
+
 func (c *ChannelManager) processAck(e *ackEvent) {
 	c.stateTimer.stopIfExist(e)
+	c.recordAck(e)
 
 	switch e.ackType {
 	case invalidAck:
@@ -631,33 +934,148 @@ func (c *ChannelManager) processAck(e *ackEvent) {
 
 	case watchSuccessAck:
 		log.Info("datanode successfully watched channel", zap.Int64("nodeID", e.nodeID), zap.String("channelName", e.channelName))
+		c.retries.reset(e.channelName)
+		c.finishHandoff(e.channelName, e.nodeID)
 	case watchFailAck, watchTimeoutAck: // failure acks from toWatch
+		delay, quarantined := c.retries.onFailure(e.channelName, e.nodeID)
+		if quarantined {
+			log.Warn("datanode watch channel failed or timeout, channel quarantined for exhausting the retry budget",
+				zap.Int64("nodeID", e.nodeID), zap.String("channel", e.channelName))
+			return
+		}
 		log.Warn("datanode watch channel failed or timeout, will release", zap.Int64("nodeID", e.nodeID),
-			zap.String("channel", e.channelName))
-		err := c.Release(e.nodeID, e.channelName)
-		if err != nil {
-			log.Warn("fail to set channels to release for watch failure ACKs",
-				zap.Int64("nodeID", e.nodeID), zap.String("channelName", e.channelName), zap.Error(err))
+			zap.String("channel", e.channelName), zap.Duration("backoff", delay))
+		if delay > 0 {
+			time.AfterFunc(delay, func() { c.releaseForRetry(e.nodeID, e.channelName) })
+			return
 		}
+		c.releaseForRetry(e.nodeID, e.channelName)
 	case releaseFailAck, releaseTimeoutAck: // failure acks from toRelease
 		// Cleanup, Delete and Reassign
 		log.Warn("datanode release channel failed or timeout, will cleanup and reassign", zap.Int64("nodeID", e.nodeID),
 			zap.String("channel", e.channelName))
-		err := c.CleanupAndReassign(e.nodeID, e.channelName)
-		if err != nil {
-			log.Warn("fail to clean and reassign channels for release failure ACKs",
-				zap.Int64("nodeID", e.nodeID), zap.String("channelName", e.channelName), zap.Error(err))
-		}
+		c.reassignWithRetry(e.nodeID, e.channelName, true)
 
 	case releaseSuccessAck:
 		// Delete and Reassign
 		log.Info("datanode release channel successfully, will reassign", zap.Int64("nodeID", e.nodeID),
 			zap.String("channel", e.channelName))
-		err := c.Reassign(e.nodeID, e.channelName)
-		if err != nil {
-			log.Warn("fail to response to release success ACK",
-				zap.Int64("nodeID", e.nodeID), zap.String("channelName", e.channelName), zap.Error(err))
+		c.reassignWithRetry(e.nodeID, e.channelName, false)
+	}
+}
+
+// reassignWithRetry reassigns the released channel, cleans up the subscription of the node first if cleanup is true.
+// A failed reassignment is retried with backoff until the channel is quarantined.
+func (c *ChannelManager) reassignWithRetry(nodeID UniqueID, channelName string, cleanup bool) {
+	var err error
+	if cleanup {
+		err = c.CleanupAndReassign(nodeID, channelName)
+	} else {
+		err = c.Reassign(nodeID, channelName)
+	}
+	if err == nil {
+		metrics.DataCoordChannelReassignCount.WithLabelValues(metrics.SuccessLabel).Inc()
+		return
+	}
+	metrics.DataCoordChannelReassignCount.WithLabelValues(metrics.FailLabel).Inc()
+
+	delay, quarantined := c.retries.onFailure(channelName, nodeID)
+	if quarantined {
+		log.Warn("fail to reassign channel, channel quarantined for exhausting the retry budget",
+			zap.Int64("nodeID", nodeID), zap.String("channelName", channelName), zap.Error(err))
+		return
+	}
+	log.Warn("fail to reassign channel, will retry", zap.Int64("nodeID", nodeID),
+		zap.String("channelName", channelName), zap.Duration("backoff", delay), zap.Error(err))
+	time.AfterFunc(delay, func() {
+		if c.ctx != nil && c.ctx.Err() != nil {
+			return
+		}
+		c.mu.RLock()
+		ch := c.getChannelByNodeAndName(nodeID, channelName)
+		c.mu.RUnlock()
+		if ch == nil {
+			// the channel is removed or reassigned by others meanwhile
+			return
 		}
+		c.reassignWithRetry(nodeID, channelName, cleanup)
+	})
+}
+
+// releaseForRetry releases the channel failed to be watched, so that it's reassigned and watched again.
+func (c *ChannelManager) releaseForRetry(nodeID UniqueID, channelName string) {
+	err := c.Release(nodeID, channelName)
+	if err != nil {
+		log.Warn("fail to set channels to release for watch failure ACKs",
+			zap.Int64("nodeID", nodeID), zap.String("channelName", channelName), zap.Error(err))
+	}
+}
+
+// GetQuarantinedChannels returns the names of the channels quarantined for exhausting the watch retry budget.
+func (c *ChannelManager) GetQuarantinedChannels() []string {
+	return c.retries.listQuarantined()
+}
+
+// Unquarantine clears the watch failures of the quarantined channel and releases it to be watched again.
+func (c *ChannelManager) Unquarantine(channelName string) error {
+	if !c.retries.isQuarantined(channelName) {
+		return merr.WrapErrChannelNotFound(channelName, "channel not quarantined")
+	}
+
+	c.mu.RLock()
+	nodeID, ch := c.findChannel(channelName)
+	c.mu.RUnlock()
+	if ch == nil {
+		return merr.WrapErrChannelNotFound(channelName)
+	}
+
+	c.retries.reset(channelName)
+	log.Info("channel unquarantined, will release", zap.Int64("nodeID", nodeID), zap.String("channelName", channelName))
+	return c.Release(nodeID, channelName)
+}
+
+// ackTransition returns the watch state transition reported by the ack type.
+func ackTransition(t ackType) (datapb.ChannelWatchState, string, bool) {
+	switch t {
+	case watchSuccessAck:
+		return datapb.ChannelWatchState_WatchSuccess, "", true
+	case watchFailAck:
+		return datapb.ChannelWatchState_WatchFailure, "", true
+	case watchTimeoutAck:
+		return datapb.ChannelWatchState_WatchFailure, timeoutPolicyName, true
+	case releaseSuccessAck:
+		return datapb.ChannelWatchState_ReleaseSuccess, "", true
+	case releaseFailAck:
+		return datapb.ChannelWatchState_ReleaseFailure, "", true
+	case releaseTimeoutAck:
+		return datapb.ChannelWatchState_ReleaseFailure, timeoutPolicyName, true
+	default:
+		return 0, "", false
+	}
+}
+
+// recordAck records the watch state transition reported by an ack event.
+func (c *ChannelManager) recordAck(e *ackEvent) {
+	state, policy, ok := ackTransition(e.ackType)
+	if !ok {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	history := c.appendTransition(e.channelName, e.nodeID, state, policy)
+	if len(history) == 0 {
+		// history disabled
+		return
+	}
+	if ch := c.getChannelByNodeAndName(e.nodeID, e.channelName); ch == nil {
+		log.Info("channel is not on the node anymore, skip recording the ack",
+			zap.Int64("nodeID", e.nodeID), zap.String("channelName", e.channelName))
+		return
+	}
+	if err := c.saveHistories(map[string][]*datapb.ChannelWatchStateTransition{e.channelName: history}); err != nil {
+		log.Warn("fail to persist the watch state transition of the ack",
+			zap.Int64("nodeID", e.nodeID), zap.String("channelName", e.channelName), zap.Error(err))
 	}
 }
 
@@ -754,7 +1172,7 @@ func (c *ChannelManager) Release(nodeID UniqueID, channelName string) error {
 	}
 
 	toReleaseUpdates := getReleaseOp(nodeID, toReleaseChannel)
-	err := c.updateWithTimer(toReleaseUpdates, datapb.ChannelWatchState_ToRelease)
+	err := c.updateWithTimer(toReleaseUpdates, datapb.ChannelWatchState_ToRelease, releasePolicyName)
 	if err != nil {
 		log.Warn("fail to update to release with timer", zap.Array("to release updates", toReleaseUpdates))
 	}
@@ -786,7 +1204,7 @@ func (c *ChannelManager) Reassign(originNodeID UniqueID, channelName string) err
 	}
 
 	// Reassign policy won't choose the original node when a reassigning a channel.
-	updates := c.reassignPolicy(c.store, []*NodeChannelInfo{reallocates})
+	updates := c.reassignPolicy(c.schedulableStore(), []*NodeChannelInfo{reallocates})
 	if len(updates) <= 0 {
 		// Skip the remove if reassign to the original node.
 		log.Warn("failed to reassign channel to other nodes, assigning to the original DataNode",
@@ -798,7 +1216,7 @@ func (c *ChannelManager) Reassign(originNodeID UniqueID, channelName string) err
 	log.Info("channel manager reassigning channels",
 		zap.Int64("old node ID", originNodeID),
 		zap.Array("updates", updates))
-	return c.updateWithTimer(updates, datapb.ChannelWatchState_ToWatch)
+	return c.updateWithTimer(updates, datapb.ChannelWatchState_ToWatch, reassignPolicyName)
 }
 
 // CleanupAndReassign tries to clean up datanode's subscription, and then reassigns the channel to another DataNode.
@@ -836,7 +1254,7 @@ func (c *ChannelManager) CleanupAndReassign(nodeID UniqueID, channelName string)
 	}
 
 	// Reassign policy won't choose the original node when a reassigning a channel.
-	updates := c.reassignPolicy(c.store, []*NodeChannelInfo{reallocates})
+	updates := c.reassignPolicy(c.schedulableStore(), []*NodeChannelInfo{reallocates})
 	if len(updates) <= 0 {
 		// Skip the remove if reassign to the original node.
 		log.Warn("failed to reassign channel to other nodes, add channel to the original node",
@@ -848,7 +1266,268 @@ func (c *ChannelManager) CleanupAndReassign(nodeID UniqueID, channelName string)
 	log.Info("channel manager reassigning channels",
 		zap.Int64("old nodeID", nodeID),
 		zap.Array("updates", updates))
-	return c.updateWithTimer(updates, datapb.ChannelWatchState_ToWatch)
+	return c.updateWithTimer(updates, datapb.ChannelWatchState_ToWatch, reassignPolicyName)
+}
+
+// Cordon marks the node unschedulable, no channel will be assigned to it since then.
+// The channels already watched by the node are kept until the node is drained or deleted.
+func (c *ChannelManager) Cordon(nodeID UniqueID) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.cordon(nodeID)
+}
+
+// cordon marks the node unschedulable, the caller shall hold the lock.
+func (c *ChannelManager) cordon(nodeID UniqueID) error {
+	if c.store.GetNode(nodeID) == nil {
+		return merr.WrapErrNodeNotFound(nodeID)
+	}
+	if _, ok := c.cordoned[nodeID]; ok {
+		return nil
+	}
+	if c.catalog != nil {
+		if err := c.catalog.SaveCordonedNode(c.ctx, nodeID); err != nil {
+			return err
+		}
+	}
+	c.cordoned[nodeID] = struct{}{}
+	log.Info("datanode cordoned", zap.Int64("nodeID", nodeID))
+	return nil
+}
+
+// Uncordon marks the node schedulable again and stops draining it,
+// the channels already migrated are not moved back.
+func (c *ChannelManager) Uncordon(nodeID UniqueID) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.cordoned[nodeID]; !ok {
+		return nil
+	}
+	if c.catalog != nil {
+		if err := c.catalog.DropCordonedNode(c.ctx, nodeID); err != nil {
+			return err
+		}
+	}
+	delete(c.cordoned, nodeID)
+	c.uncordon(nodeID)
+	log.Info("datanode uncordoned", zap.Int64("nodeID", nodeID))
+	return nil
+}
+
+// uncordon clears the mark and the drain of the node, the caller shall hold the lock.
+// Failures of removing the persisted mark are ignored, the mark will be cleared when the node is deleted again.
+func (c *ChannelManager) uncordon(nodeID UniqueID) {
+	if _, ok := c.cordoned[nodeID]; ok && c.catalog != nil {
+		if err := c.catalog.DropCordonedNode(c.ctx, nodeID); err != nil {
+			log.Warn("failed to remove the cordon mark of datanode", zap.Int64("nodeID", nodeID), zap.Error(err))
+		}
+	}
+	delete(c.cordoned, nodeID)
+	if task, ok := c.drains[nodeID]; ok {
+		task.cancel()
+		delete(c.drains, nodeID)
+	}
+}
+
+// loadCordoned loads the cordon marks persisted by the catalog.
+func (c *ChannelManager) loadCordoned() (map[int64]struct{}, error) {
+	cordoned := make(map[int64]struct{})
+	if c.catalog == nil {
+		return cordoned, nil
+	}
+	nodeIDs, err := c.catalog.ListCordonedNodes(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, nodeID := range nodeIDs {
+		cordoned[nodeID] = struct{}{}
+	}
+	return cordoned, nil
+}
+
+// IsCordoned returns whether the node is cordoned.
+func (c *ChannelManager) IsCordoned(nodeID UniqueID) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	_, ok := c.cordoned[nodeID]
+	return ok
+}
+
+// Drain cordons the node and migrates its channels to other nodes one by one in background,
+// the next channel is released only after the previous one is watched by another node,
+// so that a rolling restart won't cause a channel reassignment storm.
+// The progress is reported by GetDrainState, draining a node being drained is a no-op.
+func (c *ChannelManager) Drain(ctx context.Context, nodeID UniqueID) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.cordon(nodeID); err != nil {
+		return err
+	}
+	if task, ok := c.drains[nodeID]; ok && task.state == datapb.DrainState_Draining {
+		return nil
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	task := &drainTask{cancel: cancel, state: datapb.DrainState_Draining}
+	c.drains[nodeID] = task
+	log.Info("start to drain datanode", zap.Int64("nodeID", nodeID))
+	go c.drain(ctx, nodeID, task)
+	return nil
+}
+
+func (c *ChannelManager) drain(ctx context.Context, nodeID UniqueID, task *drainTask) {
+	defer task.cancel()
+	err := c.migrateChannels(ctx, nodeID)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err != nil {
+		log.Warn("failed to drain datanode", zap.Int64("nodeID", nodeID), zap.Error(err))
+		task.state = datapb.DrainState_DrainFailed
+		task.reason = err.Error()
+		return
+	}
+	log.Info("datanode drained", zap.Int64("nodeID", nodeID))
+	task.state = datapb.DrainState_Drained
+}
+
+// migrateChannels migrates the channels of the node one by one until the node has no channel left.
+func (c *ChannelManager) migrateChannels(ctx context.Context, nodeID UniqueID) error {
+	for {
+		channelName, err := c.releaseForDrain(nodeID)
+		if err != nil {
+			return err
+		}
+		if channelName == "" {
+			return nil
+		}
+		if err := c.waitChannelMigrated(ctx, nodeID, channelName); err != nil {
+			log.Warn("failed to wait channel migrated", zap.Int64("nodeID", nodeID),
+				zap.String("channelName", channelName), zap.Error(err))
+			return err
+		}
+	}
+}
+
+// GetDrainState returns the state of the latest drain of the node, whether the node is cordoned,
+// the number of channels left on the node and the failure reason if the drain failed.
+func (c *ChannelManager) GetDrainState(nodeID UniqueID) (datapb.DrainState, bool, int, string) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	_, cordoned := c.cordoned[nodeID]
+	remaining := 0
+	if info := c.store.GetNode(nodeID); info != nil {
+		remaining = len(info.Channels)
+	}
+	task, ok := c.drains[nodeID]
+	if !ok {
+		return datapb.DrainState_DrainNone, cordoned, remaining, ""
+	}
+	return task.state, cordoned, remaining, task.reason
+}
+
+// releaseForDrain releases one channel of the node, returns empty channel name if the node has no channel left.
+func (c *ChannelManager) releaseForDrain(nodeID UniqueID) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	info := c.store.GetNode(nodeID)
+	if info == nil || len(info.Channels) == 0 {
+		return "", nil
+	}
+	if len(c.schedulableStore().GetNodes()) == 0 {
+		return "", merr.WrapErrNodeLack(1, 0, "no schedulable datanode to take over the channels")
+	}
+
+	ch := info.Channels[0]
+	log.Info("draining channel from datanode", zap.Int64("nodeID", nodeID), zap.String("channelName", ch.Name))
+	if err := c.updateWithTimer(getReleaseOp(nodeID, ch), datapb.ChannelWatchState_ToRelease, drainPolicyName); err != nil {
+		return "", err
+	}
+	return ch.Name, nil
+}
+
+func (c *ChannelManager) waitChannelMigrated(ctx context.Context, nodeID UniqueID, channelName string) error {
+	ticker := time.NewTicker(drainCheckInterval)
+	defer ticker.Stop()
+	for {
+		if c.isChannelMigrated(nodeID, channelName) {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// isChannelMigrated checks whether the channel is moved out of the node and watched by another node.
+func (c *ChannelManager) isChannelMigrated(nodeID UniqueID, channelName string) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	watcher, ch := c.findChannel(channelName)
+	if ch == nil {
+		// channel dropped or in buffer
+		return true
+	}
+	if watcher == nodeID {
+		return false
+	}
+	history := c.history[channelName]
+	if len(history) == 0 {
+		// history disabled
+		return true
+	}
+	last := history[len(history)-1]
+	return last.GetNodeID() == watcher && last.GetState() == datapb.ChannelWatchState_WatchSuccess
+}
+
+// schedulableStore returns a view of the channel store which hides the cordoned nodes from the policies.
+// The caller shall hold the lock.
+func (c *ChannelManager) schedulableStore() ROChannelStore {
+	if len(c.cordoned) == 0 {
+		return c.store
+	}
+	return &schedulableChannelStore{
+		ROChannelStore: c.store,
+		cordoned:       c.cordoned,
+	}
+}
+
+// schedulableChannelStore is a ROChannelStore without the cordoned nodes.
+type schedulableChannelStore struct {
+	ROChannelStore
+	cordoned map[int64]struct{}
+}
+
+// GetNodesChannels returns the channels assigned to schedulable nodes.
+func (s *schedulableChannelStore) GetNodesChannels() []*NodeChannelInfo {
+	infos := s.ROChannelStore.GetNodesChannels()
+	ret := make([]*NodeChannelInfo, 0, len(infos))
+	for _, info := range infos {
+		if _, ok := s.cordoned[info.NodeID]; !ok {
+			ret = append(ret, info)
+		}
+	}
+	return ret
+}
+
+// GetNodes returns the ids of schedulable nodes.
+func (s *schedulableChannelStore) GetNodes() []int64 {
+	nodes := s.ROChannelStore.GetNodes()
+	ret := make([]int64, 0, len(nodes))
+	for _, nodeID := range nodes {
+		if _, ok := s.cordoned[nodeID]; !ok {
+			ret = append(ret, nodeID)
+		}
+	}
+	return ret
 }
 
 func (c *ChannelManager) getChannelByNodeAndName(nodeID UniqueID, channelName string) *channel {
@@ -67,7 +67,7 @@ func (f *ChannelPolicyFactoryV1) NewReassignPolicy() ChannelReassignPolicy {
 }
 
 func (f *ChannelPolicyFactoryV1) NewBalancePolicy() BalanceChannelPolicy {
-	return AvgBalanceChannelPolicy
+	return SkewBalanceChannelPolicy
 }
 
 // ConsistentHashChannelPolicyFactory use consistent hash to determine channel assignment
@@ -106,3 +106,81 @@ func (f *ConsistentHashChannelPolicyFactory) NewReassignPolicy() ChannelReassign
 func (f *ConsistentHashChannelPolicyFactory) NewBalancePolicy() BalanceChannelPolicy {
 	return EmptyBalancePolicy
 }
+
+// WeightedConsistentHashChannelPolicyFactory uses consistent hash weighted by DataNode capacity to determine channel assignment,
+// each DataNode has virtual nodes in the hash ring in proportion to its capacity, so that small DataNodes get fewer channels.
+type WeightedConsistentHashChannelPolicyFactory struct {
+	hashring    *consistent.Consistent
+	getCapacity NodeCapacityGetter
+}
+
+// NewWeightedConsistentHashChannelPolicyFactory creates a new weighted consistent hash policy factory instance
+func NewWeightedConsistentHashChannelPolicyFactory(hashring *consistent.Consistent, getCapacity NodeCapacityGetter) *WeightedConsistentHashChannelPolicyFactory {
+	return &WeightedConsistentHashChannelPolicyFactory{
+		hashring:    hashring,
+		getCapacity: getCapacity,
+	}
+}
+
+// NewRegisterPolicy creates a new register policy
+func (f *WeightedConsistentHashChannelPolicyFactory) NewRegisterPolicy() RegisterPolicy {
+	return WeightedConsistentHashRegisterPolicy(f.hashring, f.getCapacity)
+}
+
+// NewDeregisterPolicy creates a new deregister policy
+func (f *WeightedConsistentHashChannelPolicyFactory) NewDeregisterPolicy() DeregisterPolicy {
+	return WeightedConsistentHashDeregisterPolicy(f.hashring, f.getCapacity)
+}
+
+// NewAssignPolicy creates a new assign policy
+func (f *WeightedConsistentHashChannelPolicyFactory) NewAssignPolicy() ChannelAssignPolicy {
+	return WeightedConsistentHashChannelAssignPolicy(f.hashring, f.getCapacity)
+}
+
+// NewReassignPolicy creates a new reassign policy
+func (f *WeightedConsistentHashChannelPolicyFactory) NewReassignPolicy() ChannelReassignPolicy {
+	return EmptyReassignPolicy
+}
+
+// NewBalancePolicy creates a new balance policy
+func (f *WeightedConsistentHashChannelPolicyFactory) NewBalancePolicy() BalanceChannelPolicy {
+	return EmptyBalancePolicy
+}
+
+// ZoneAwareChannelPolicyFactory creates policies which spread channels of the same collection across failure domains,
+// so that losing one zone does not take down all channels of a collection.
+type ZoneAwareChannelPolicyFactory struct {
+	getZone NodeZoneGetter
+}
+
+// NewZoneAwareChannelPolicyFactory creates a new zone aware policy factory instance
+func NewZoneAwareChannelPolicyFactory(getZone NodeZoneGetter) *ZoneAwareChannelPolicyFactory {
+	return &ZoneAwareChannelPolicyFactory{
+		getZone: getZone,
+	}
+}
+
+// NewRegisterPolicy creates a new register policy
+func (f *ZoneAwareChannelPolicyFactory) NewRegisterPolicy() RegisterPolicy {
+	return AvgAssignRegisterPolicy
+}
+
+// NewDeregisterPolicy creates a new deregister policy
+func (f *ZoneAwareChannelPolicyFactory) NewDeregisterPolicy() DeregisterPolicy {
+	return ZoneAwareDeregisterPolicy(f.getZone)
+}
+
+// NewAssignPolicy creates a new assign policy
+func (f *ZoneAwareChannelPolicyFactory) NewAssignPolicy() ChannelAssignPolicy {
+	return ZoneAwareAssignPolicy(f.getZone)
+}
+
+// NewReassignPolicy creates a new reassign policy
+func (f *ZoneAwareChannelPolicyFactory) NewReassignPolicy() ChannelReassignPolicy {
+	return ZoneAwareReassignPolicy(f.getZone)
+}
+
+// NewBalancePolicy creates a new balance policy
+func (f *ZoneAwareChannelPolicyFactory) NewBalancePolicy() BalanceChannelPolicy {
+	return SkewBalanceChannelPolicy
+}
@@ -24,14 +24,19 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cockroachdb/errors"
 	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/kv/mocks"
+	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
 // waitAndStore simulates DataNode's action
@@ -592,6 +597,111 @@ func TestChannelManager(t *testing.T) {
 
 		chs := chManager.store.GetBufferChannelInfo()
 		assert.Equal(t, 2, len(chs.Channels))
+
+		// the channels are handed off until they are watched by other nodes
+		require.Equal(t, 2, len(chManager.handoffs))
+		assert.EqualValues(t, 1, chManager.handoffs["channel-1"].fromNodeID)
+		chManager.processAck(&ackEvent{watchSuccessAck, "channel-1", 1})
+		assert.Contains(t, chManager.handoffs, "channel-1")
+		chManager.processAck(&ackEvent{watchSuccessAck, "channel-1", 2})
+		assert.NotContains(t, chManager.handoffs, "channel-1")
+
+		err = chManager.RemoveChannel("channel-2")
+		assert.NoError(t, err)
+		assert.Empty(t, chManager.handoffs)
+	})
+
+	t.Run("test Cordon and Drain", func(t *testing.T) {
+		defer watchkv.RemoveWithPrefix("")
+		var (
+			collectionID                  = UniqueID(7)
+			drainNode, liveNode, cordoned = UniqueID(201), UniqueID(202), UniqueID(203)
+			channelName                   = "drain-chan"
+		)
+		interval := drainCheckInterval
+		drainCheckInterval = 10 * time.Millisecond
+		defer func() { drainCheckInterval = interval }()
+
+		ctx, cancel := context.WithCancel(context.TODO())
+		defer cancel()
+		catalog := datacoord.NewCatalog(watchkv, "", "")
+		chManager, err := NewChannelManager(watchkv, newMockHandler(), withCatalog(catalog))
+		require.NoError(t, err)
+		go chManager.watchChannelStatesLoop(ctx, common.LatestRevision)
+
+		chManager.store = &ChannelStore{
+			store: watchkv,
+			channelsInfo: map[int64]*NodeChannelInfo{
+				drainNode: {drainNode, []*channel{{Name: channelName, CollectionID: collectionID}}},
+				liveNode:  {liveNode, []*channel{}},
+				cordoned:  {cordoned, []*channel{}},
+			},
+		}
+
+		err = chManager.Cordon(999)
+		assert.ErrorIs(t, err, merr.ErrNodeNotFound)
+		err = chManager.Cordon(cordoned)
+		assert.NoError(t, err)
+		assert.True(t, chManager.IsCordoned(cordoned))
+
+		// the marks are persisted
+		reloaded, err := NewChannelManager(watchkv, newMockHandler(), withCatalog(catalog))
+		require.NoError(t, err)
+		assert.True(t, reloaded.IsCordoned(cordoned))
+
+		err = chManager.Drain(ctx, drainNode)
+		assert.NoError(t, err)
+		state, isCordoned, remaining, _ := chManager.GetDrainState(drainNode)
+		assert.Equal(t, datapb.DrainState_Draining, state)
+		assert.True(t, isCordoned)
+		assert.Equal(t, 1, remaining)
+
+		// the channel is migrated to the only schedulable node
+		waitAndStore(t, watchkv, path.Join(prefix, strconv.FormatInt(drainNode, 10), channelName),
+			datapb.ChannelWatchState_ToRelease, datapb.ChannelWatchState_ReleaseSuccess)
+		waitAndStore(t, watchkv, path.Join(prefix, strconv.FormatInt(liveNode, 10), channelName),
+			datapb.ChannelWatchState_ToWatch, datapb.ChannelWatchState_WatchSuccess)
+		assert.Eventually(t, func() bool {
+			state, _, remaining, _ := chManager.GetDrainState(drainNode)
+			return state == datapb.DrainState_Drained && remaining == 0
+		}, time.Second, 10*time.Millisecond)
+		assert.True(t, chManager.IsCordoned(drainNode))
+		assert.True(t, chManager.Match(liveNode, channelName))
+		chManager.stateTimer.removeTimers([]string{channelName})
+
+		// new channels are not assigned to cordoned nodes
+		err = chManager.Watch(&channel{Name: "drain-new-chan", CollectionID: collectionID})
+		assert.NoError(t, err)
+		assert.True(t, chManager.Match(liveNode, "drain-new-chan"))
+		chManager.stateTimer.removeTimers([]string{"drain-new-chan"})
+
+		// no schedulable node left
+		err = chManager.Cordon(liveNode)
+		assert.NoError(t, err)
+		err = chManager.Drain(ctx, liveNode)
+		assert.NoError(t, err)
+		assert.Eventually(t, func() bool {
+			state, _, _, reason := chManager.GetDrainState(liveNode)
+			return state == datapb.DrainState_DrainFailed && reason != ""
+		}, time.Second, 10*time.Millisecond)
+
+		// uncordon clears the mark and the drain
+		err = chManager.Uncordon(liveNode)
+		assert.NoError(t, err)
+		state, isCordoned, _, _ = chManager.GetDrainState(liveNode)
+		assert.Equal(t, datapb.DrainState_DrainNone, state)
+		assert.False(t, isCordoned)
+		err = chManager.Uncordon(liveNode)
+		assert.NoError(t, err)
+
+		// the mark is cleared once the node is deleted
+		err = chManager.DeleteNode(drainNode)
+		assert.NoError(t, err)
+		assert.False(t, chManager.IsCordoned(drainNode))
+
+		nodeIDs, err := catalog.ListCordonedNodes(ctx)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []int64{cordoned}, nodeIDs)
 	})
 
 	t.Run("test CleanupAndReassign", func(t *testing.T) {
@@ -703,11 +813,12 @@ func TestChannelManager(t *testing.T) {
 			t.Run(test.description, func(t *testing.T) {
 				ops := getReleaseOp(nodeID, &channel{Name: channelName, CollectionID: collectionID})
 				for _, op := range ops {
-					chs := chManager.fillChannelWatchInfoWithState(op, test.inState)
+					chs := chManager.fillChannelWatchInfoWithState(op, test.inState, releasePolicyName)
 					assert.Equal(t, 1, len(chs))
 					assert.Equal(t, channelName, chs[0])
 					assert.Equal(t, 1, len(op.ChannelWatchInfos))
 					assert.Equal(t, test.inState, op.ChannelWatchInfos[0].GetState())
+					assert.Equal(t, releasePolicyName, op.ChannelWatchInfos[0].GetPolicy())
 
 					chManager.stateTimer.removeTimers(chs)
 				}
@@ -728,10 +839,155 @@ func TestChannelManager(t *testing.T) {
 
 		opSet := getReleaseOp(nodeID, &channel{Name: channelName, CollectionID: collectionID})
 
-		chManager.updateWithTimer(opSet, datapb.ChannelWatchState_ToWatch)
+		chManager.updateWithTimer(opSet, datapb.ChannelWatchState_ToWatch, assignPolicyName)
+		chManager.stateTimer.removeTimers([]string{channelName})
+
+		waitAndCheckState(t, watchkv, datapb.ChannelWatchState_ToWatch, nodeID, channelName, collectionID)
+	})
+
+	t.Run("test GetChannelWatchHistory", func(t *testing.T) {
+		var (
+			nodeID       = UniqueID(113)
+			collectionID = UniqueID(3)
+			channelName  = "get-channel-watch-history"
+		)
+
+		chManager, err := NewChannelManager(watchkv, newMockHandler())
+		require.NoError(t, err)
+		chManager.store.Add(nodeID)
+
+		_, _, err = chManager.GetChannelWatchHistory(channelName)
+		assert.ErrorIs(t, err, merr.ErrChannelNotFound)
+
+		Params.Save(Params.DataCoordCfg.ChannelWatchHistorySize.Key, "2")
+		defer Params.Reset(Params.DataCoordCfg.ChannelWatchHistorySize.Key)
+
+		opSet := getReleaseOp(nodeID, &channel{Name: channelName, CollectionID: collectionID})
+		err = chManager.updateWithTimer(opSet, datapb.ChannelWatchState_ToWatch, assignPolicyName)
+		require.NoError(t, err)
+		chManager.stateTimer.removeTimers([]string{channelName})
+		chManager.recordAck(&ackEvent{watchTimeoutAck, channelName, nodeID})
+		chManager.recordAck(&ackEvent{watchSuccessAck, channelName, nodeID})
+
+		gotNodeID, history, err := chManager.GetChannelWatchHistory(channelName)
+		assert.NoError(t, err)
+		assert.Equal(t, nodeID, gotNodeID)
+		require.Equal(t, 2, len(history))
+		assert.Equal(t, datapb.ChannelWatchState_WatchFailure, history[0].GetState())
+		assert.Equal(t, timeoutPolicyName, history[0].GetPolicy())
+		assert.Equal(t, datapb.ChannelWatchState_WatchSuccess, history[1].GetState())
+
+		// the transitions are persisted apart from the watch info, which is left untouched
+		waitAndCheckState(t, watchkv, datapb.ChannelWatchState_ToWatch, nodeID, channelName, collectionID)
+		reloaded, err := NewChannelManager(watchkv, newMockHandler())
+		require.NoError(t, err)
+		_, reloadedHistory, err := reloaded.GetChannelWatchHistory(channelName)
+		assert.NoError(t, err)
+		require.Equal(t, len(history), len(reloadedHistory))
+		for i := range history {
+			assert.Equal(t, history[i].GetState(), reloadedHistory[i].GetState())
+			assert.Equal(t, history[i].GetPolicy(), reloadedHistory[i].GetPolicy())
+		}
+
+		// the acks of the channels not on the node are not recorded
+		chManager.recordAck(&ackEvent{releaseSuccessAck, channelName, nodeID + 1})
+		_, history, err = chManager.GetChannelWatchHistory(channelName)
+		assert.NoError(t, err)
+		assert.Equal(t, datapb.ChannelWatchState_WatchSuccess, history[len(history)-1].GetState())
+	})
+
+	t.Run("test watch retry budget", func(t *testing.T) {
+		var (
+			nodeID       = UniqueID(115)
+			collectionID = UniqueID(32)
+			channelName  = "watch-retry-budget"
+		)
+
+		chManager, err := NewChannelManager(watchkv, newMockHandler())
+		require.NoError(t, err)
+		chManager.store.Add(nodeID)
+
+		Params.Save(Params.DataCoordCfg.ChannelWatchRetryBudget.Key, "1")
+		defer Params.Reset(Params.DataCoordCfg.ChannelWatchRetryBudget.Key)
+
+		err = chManager.updateWithTimer(getReleaseOp(nodeID, &channel{Name: channelName, CollectionID: collectionID}),
+			datapb.ChannelWatchState_ToWatch, assignPolicyName)
+		require.NoError(t, err)
+		chManager.stateTimer.removeTimers([]string{channelName})
+
+		// the first failure is retried immediately
+		chManager.processAck(&ackEvent{watchFailAck, channelName, nodeID})
 		chManager.stateTimer.removeTimers([]string{channelName})
+		waitAndCheckState(t, watchkv, datapb.ChannelWatchState_ToRelease, nodeID, channelName, collectionID)
+		assert.Empty(t, chManager.GetQuarantinedChannels())
 
+		// the channel exhausting the budget is quarantined instead of released
+		err = chManager.updateWithTimer(getReleaseOp(nodeID, &channel{Name: channelName, CollectionID: collectionID}),
+			datapb.ChannelWatchState_ToWatch, assignPolicyName)
+		require.NoError(t, err)
+		chManager.stateTimer.removeTimers([]string{channelName})
+		chManager.processAck(&ackEvent{watchTimeoutAck, channelName, nodeID})
+		assert.Equal(t, []string{channelName}, chManager.GetQuarantinedChannels())
 		waitAndCheckState(t, watchkv, datapb.ChannelWatchState_ToWatch, nodeID, channelName, collectionID)
+
+		err = chManager.Unquarantine("not-quarantined")
+		assert.ErrorIs(t, err, merr.ErrChannelNotFound)
+		err = chManager.Unquarantine(channelName)
+		assert.NoError(t, err)
+		chManager.stateTimer.removeTimers([]string{channelName})
+		assert.Empty(t, chManager.GetQuarantinedChannels())
+		waitAndCheckState(t, watchkv, datapb.ChannelWatchState_ToRelease, nodeID, channelName, collectionID)
+
+		// a successful watch resets the failures
+		chManager.processAck(&ackEvent{watchFailAck, channelName, nodeID})
+		chManager.stateTimer.removeTimers([]string{channelName})
+		chManager.processAck(&ackEvent{watchSuccessAck, channelName, nodeID})
+		chManager.processAck(&ackEvent{watchFailAck, channelName, nodeID})
+		chManager.stateTimer.removeTimers([]string{channelName})
+		assert.Empty(t, chManager.GetQuarantinedChannels())
+	})
+
+	t.Run("test GetChannelWatchStates", func(t *testing.T) {
+		var (
+			nodeID       = UniqueID(114)
+			collectionID = UniqueID(31)
+		)
+
+		chManager, err := NewChannelManager(watchkv, newMockHandler())
+		require.NoError(t, err)
+		chManager.store.Add(nodeID)
+
+		states, err := chManager.GetChannelWatchStates(collectionID)
+		assert.NoError(t, err)
+		assert.Empty(t, states)
+
+		err = chManager.updateWithTimer(getReleaseOp(nodeID, &channel{Name: "get-watch-states-1", CollectionID: collectionID}),
+			datapb.ChannelWatchState_ToWatch, assignPolicyName)
+		require.NoError(t, err)
+		chManager.stateTimer.removeTimers([]string{"get-watch-states-1"})
+		err = chManager.updateWithTimer(getReleaseOp(bufferID, &channel{Name: "get-watch-states-0", CollectionID: collectionID}),
+			datapb.ChannelWatchState_ToWatch, assignPolicyName)
+		require.NoError(t, err)
+		err = chManager.updateWithTimer(getReleaseOp(nodeID, &channel{Name: "get-watch-states-other", CollectionID: collectionID + 1}),
+			datapb.ChannelWatchState_ToWatch, assignPolicyName)
+		require.NoError(t, err)
+		chManager.stateTimer.removeTimers([]string{"get-watch-states-other"})
+
+		states, err = chManager.GetChannelWatchStates(collectionID)
+		assert.NoError(t, err)
+		require.Equal(t, 2, len(states))
+		assert.Equal(t, "get-watch-states-0", states[0].GetChannelName())
+		assert.EqualValues(t, 0, states[0].GetNodeID())
+		assert.Equal(t, "get-watch-states-1", states[1].GetChannelName())
+		assert.Equal(t, nodeID, states[1].GetNodeID())
+		assert.Equal(t, collectionID, states[1].GetCollectionID())
+		assert.Equal(t, datapb.ChannelWatchState_ToWatch, states[1].GetState())
+		assert.Equal(t, assignPolicyName, states[1].GetPolicy())
+		assert.NotZero(t, states[1].GetWatchTs())
+
+		states, err = chManager.GetChannelWatchStates(0)
+		assert.NoError(t, err)
+		assert.GreaterOrEqual(t, len(states), 3)
 	})
 
 	t.Run("test background check silent", func(t *testing.T) {
@@ -1156,3 +1412,28 @@ func TestChannelManager_HelperFunc(t *testing.T) {
 
 	})
 }
+
+func TestChannelManager_ReassignWithRetry(t *testing.T) {
+	Params.Save(Params.DataCoordCfg.ChannelWatchRetryBudget.Key, "1")
+	defer Params.Reset(Params.DataCoordCfg.ChannelWatchRetryBudget.Key)
+
+	var (
+		nodeID      = UniqueID(1)
+		channelName = "reassign-retry"
+	)
+	metakv := mocks.NewWatchKV(t)
+	metakv.EXPECT().LoadWithPrefix(mock.Anything).Return(nil, nil, nil)
+	metakv.EXPECT().MultiSaveAndRemove(mock.Anything, mock.Anything).Return(errors.New("mock"))
+	chManager, err := NewChannelManager(metakv, newMockHandler())
+	require.NoError(t, err)
+	store := chManager.store.(*ChannelStore)
+	store.channelsInfo[nodeID] = &NodeChannelInfo{NodeID: nodeID, Channels: []*channel{{Name: channelName, CollectionID: 1}}}
+
+	// the first failure is retried immediately, the second one exhausts the budget
+	chManager.reassignWithRetry(nodeID, channelName, false)
+	assert.Eventually(t, func() bool {
+		return len(chManager.GetQuarantinedChannels()) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{channelName}, chManager.GetQuarantinedChannels())
+	metakv.AssertNumberOfCalls(t, "MultiSaveAndRemove", 2)
+}
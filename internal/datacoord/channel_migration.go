@@ -0,0 +1,102 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// isLegacyWatchInfo returns true if the watch info is written by an older version,
+// i.e. it has the deprecated Complete state or the segment infos instead of the segment IDs.
+func isLegacyWatchInfo(info *datapb.ChannelWatchInfo) bool {
+	vchan := info.GetVchan()
+	return info.GetState() == datapb.ChannelWatchState_Complete ||
+		len(vchan.GetFlushedSegments()) > 0 ||
+		len(vchan.GetUnflushedSegments()) > 0 ||
+		len(vchan.GetDroppedSegments()) > 0
+}
+
+// isRunningWatchState returns true if the DataNode is handling the watch info of the state.
+func isRunningWatchState(state datapb.ChannelWatchState) bool {
+	return state == datapb.ChannelWatchState_ToWatch ||
+		state == datapb.ChannelWatchState_ToRelease ||
+		state == datapb.ChannelWatchState_Uncomplete
+}
+
+// upgradeWatchInfo converts the legacy watch info into the newest schema in place.
+func upgradeWatchInfo(info *datapb.ChannelWatchInfo) {
+	if info.GetState() == datapb.ChannelWatchState_Complete {
+		info.State = datapb.ChannelWatchState_WatchSuccess
+	}
+	reviseVChannelInfo(info.GetVchan())
+}
+
+// MigrateWatchInfos rewrites the legacy channel watch infos into the newest schema in one transaction,
+// returns the keys migrated and the keys skipped.
+// The watch infos in running states are skipped since the DataNodes may update them meanwhile,
+// and at most maxOperationsPerTxn keys are migrated at a time, so the skipped keys need another migration later.
+// The channel manager is locked during the migration, no watch info is written by DataCoord meanwhile.
+// Nothing is written if dryRun is true.
+func (c *ChannelManager) MigrateWatchInfos(dryRun bool) ([]string, []string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	keys, values, err := c.kv.LoadWithPrefix(Params.CommonCfg.DataCoordWatchSubPath.GetValue())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	saves := make(map[string]string)
+	migrated := make([]string, 0)
+	skipped := make([]string, 0)
+	for i, key := range keys {
+		info := &datapb.ChannelWatchInfo{}
+		if err := proto.Unmarshal([]byte(values[i]), info); err != nil {
+			return nil, nil, fmt.Errorf("fail to parse ChannelWatchInfo, key: %s, err: %w", key, err)
+		}
+		if !isLegacyWatchInfo(info) {
+			continue
+		}
+		if isRunningWatchState(info.GetState()) || len(saves) >= maxOperationsPerTxn {
+			skipped = append(skipped, key)
+			continue
+		}
+
+		upgradeWatchInfo(info)
+		bs, err := proto.Marshal(info)
+		if err != nil {
+			return nil, nil, err
+		}
+		saves[key] = string(bs)
+		migrated = append(migrated, key)
+	}
+
+	if !dryRun && len(saves) > 0 {
+		if err := c.kv.MultiSave(saves); err != nil {
+			return nil, nil, err
+		}
+	}
+	log.Info("channel watch infos migrated", zap.Bool("dryRun", dryRun),
+		zap.Strings("migrated", migrated), zap.Strings("skipped", skipped))
+	return migrated, skipped, nil
+}
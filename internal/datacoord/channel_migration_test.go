@@ -0,0 +1,105 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"path"
+	"strconv"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+func TestChannelManager_MigrateWatchInfos(t *testing.T) {
+	watchkv := getWatchKV(t)
+	defer func() {
+		watchkv.RemoveWithPrefix("")
+		watchkv.Close()
+	}()
+
+	prefix := Params.CommonCfg.DataCoordWatchSubPath.GetValue()
+	nodeID := UniqueID(1)
+	save := func(channelName string, info *datapb.ChannelWatchInfo) string {
+		key := path.Join(prefix, strconv.FormatInt(nodeID, 10), channelName)
+		bs, err := proto.Marshal(info)
+		require.NoError(t, err)
+		require.NoError(t, watchkv.Save(key, string(bs)))
+		return key
+	}
+	load := func(key string) *datapb.ChannelWatchInfo {
+		value, err := watchkv.Load(key)
+		require.NoError(t, err)
+		info := &datapb.ChannelWatchInfo{}
+		require.NoError(t, proto.Unmarshal([]byte(value), info))
+		return info
+	}
+
+	legacyKey := save("ch-legacy", &datapb.ChannelWatchInfo{
+		Vchan: &datapb.VchannelInfo{
+			ChannelName:     "ch-legacy",
+			FlushedSegments: []*datapb.SegmentInfo{{ID: 1}, {ID: 2}},
+			DroppedSegments: []*datapb.SegmentInfo{{ID: 3}},
+		},
+		State: datapb.ChannelWatchState_Complete,
+	})
+	runningKey := save("ch-running", &datapb.ChannelWatchInfo{
+		Vchan: &datapb.VchannelInfo{
+			ChannelName:       "ch-running",
+			UnflushedSegments: []*datapb.SegmentInfo{{ID: 4}},
+		},
+		State: datapb.ChannelWatchState_ToWatch,
+	})
+	save("ch-new", &datapb.ChannelWatchInfo{
+		Vchan: &datapb.VchannelInfo{ChannelName: "ch-new", FlushedSegmentIds: []int64{5}},
+		State: datapb.ChannelWatchState_WatchSuccess,
+	})
+
+	chManager, err := NewChannelManager(watchkv, newMockHandler())
+	require.NoError(t, err)
+
+	t.Run("dry run", func(t *testing.T) {
+		migrated, skipped, err := chManager.MigrateWatchInfos(true)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{legacyKey}, migrated)
+		assert.Equal(t, []string{runningKey}, skipped)
+		assert.Equal(t, datapb.ChannelWatchState_Complete, load(legacyKey).GetState())
+	})
+
+	t.Run("migrate", func(t *testing.T) {
+		migrated, skipped, err := chManager.MigrateWatchInfos(false)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{legacyKey}, migrated)
+		assert.Equal(t, []string{runningKey}, skipped)
+
+		info := load(legacyKey)
+		assert.Equal(t, datapb.ChannelWatchState_WatchSuccess, info.GetState())
+		assert.Empty(t, info.GetVchan().GetFlushedSegments())
+		assert.Empty(t, info.GetVchan().GetDroppedSegments())
+		assert.Equal(t, []int64{1, 2}, info.GetVchan().GetFlushedSegmentIds())
+		assert.Equal(t, []int64{3}, info.GetVchan().GetDroppedSegmentIds())
+		assert.Equal(t, datapb.ChannelWatchState_ToWatch, load(runningKey).GetState())
+
+		// migrated only once
+		migrated, _, err = chManager.MigrateWatchInfos(false)
+		assert.NoError(t, err)
+		assert.Empty(t, migrated)
+	})
+}
@@ -0,0 +1,112 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/pkg/metrics"
+)
+
+// channelRetryTracker counts the consecutive failed watches and reassignments of channels.
+// A channel failing more attempts than the retry budget is quarantined,
+// it's no longer released and reassigned until it's unquarantined manually.
+// The counts are not persisted, they are reset if datacoord restarts.
+type channelRetryTracker struct {
+	mu          sync.Mutex
+	failures    map[string]int
+	quarantined map[string]UniqueID // channel name -> node ID the channel failed on last time
+}
+
+func newChannelRetryTracker() *channelRetryTracker {
+	return &channelRetryTracker{
+		failures:    make(map[string]int),
+		quarantined: make(map[string]UniqueID),
+	}
+}
+
+// onFailure records a failed watch or reassignment of the channel on the node,
+// returns the delay before retrying, or quarantined true if the retry budget is exhausted.
+func (t *channelRetryTracker) onFailure(channelName string, nodeID UniqueID) (time.Duration, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.failures[channelName]++
+	failures := t.failures[channelName]
+	budget := Params.DataCoordCfg.ChannelWatchRetryBudget.GetAsInt()
+	if budget > 0 && failures > budget {
+		t.quarantined[channelName] = nodeID
+		metrics.DataCoordQuarantinedChannelNum.WithLabelValues().Set(float64(len(t.quarantined)))
+		return 0, true
+	}
+	return watchRetryBackoff(failures), false
+}
+
+// reset clears the failures of the channel, and unquarantines it.
+func (t *channelRetryTracker) reset(channelName string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delete(t.failures, channelName)
+	delete(t.quarantined, channelName)
+	metrics.DataCoordQuarantinedChannelNum.WithLabelValues().Set(float64(len(t.quarantined)))
+}
+
+func (t *channelRetryTracker) isQuarantined(channelName string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	_, ok := t.quarantined[channelName]
+	return ok
+}
+
+// listQuarantined returns the names of the quarantined channels in order.
+func (t *channelRetryTracker) listQuarantined() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	ret := make([]string, 0, len(t.quarantined))
+	for channelName := range t.quarantined {
+		ret = append(ret, channelName)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// watchRetryBackoff returns the delay before retrying the watch after the given number of consecutive failures.
+// The first retry is immediate, then the delay doubles from the base up to the max,
+// with a random jitter in [delay/2, delay] so that the retries of many channels are spread out.
+func watchRetryBackoff(failures int) time.Duration {
+	base := Params.DataCoordCfg.ChannelWatchRetryBackoffBase.GetAsDuration(time.Second)
+	maxDelay := Params.DataCoordCfg.ChannelWatchRetryBackoffMax.GetAsDuration(time.Second)
+	if failures <= 1 || base <= 0 {
+		return 0
+	}
+
+	delay := base
+	for i := 2; i < failures && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+	half := int64(delay / 2)
+	return time.Duration(half + rand.Int63n(half+1))
+}
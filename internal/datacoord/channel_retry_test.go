@@ -0,0 +1,72 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWatchRetryBackoff(t *testing.T) {
+	Params.Save(Params.DataCoordCfg.ChannelWatchRetryBackoffBase.Key, "1")
+	defer Params.Reset(Params.DataCoordCfg.ChannelWatchRetryBackoffBase.Key)
+	Params.Save(Params.DataCoordCfg.ChannelWatchRetryBackoffMax.Key, "4")
+	defer Params.Reset(Params.DataCoordCfg.ChannelWatchRetryBackoffMax.Key)
+
+	assert.Equal(t, time.Duration(0), watchRetryBackoff(1))
+	for failures, delay := range map[int]time.Duration{
+		2:  time.Second,
+		3:  2 * time.Second,
+		4:  4 * time.Second,
+		10: 4 * time.Second,
+	} {
+		got := watchRetryBackoff(failures)
+		assert.GreaterOrEqual(t, got, delay/2)
+		assert.LessOrEqual(t, got, delay)
+	}
+
+	Params.Save(Params.DataCoordCfg.ChannelWatchRetryBackoffBase.Key, "0")
+	assert.Equal(t, time.Duration(0), watchRetryBackoff(5))
+}
+
+func TestChannelRetryTracker(t *testing.T) {
+	Params.Save(Params.DataCoordCfg.ChannelWatchRetryBudget.Key, "2")
+	defer Params.Reset(Params.DataCoordCfg.ChannelWatchRetryBudget.Key)
+
+	tracker := newChannelRetryTracker()
+	for i := 0; i < 2; i++ {
+		_, quarantined := tracker.onFailure("ch-1", 1)
+		assert.False(t, quarantined)
+	}
+	_, quarantined := tracker.onFailure("ch-1", 1)
+	assert.True(t, quarantined)
+	assert.True(t, tracker.isQuarantined("ch-1"))
+	assert.Equal(t, []string{"ch-1"}, tracker.listQuarantined())
+
+	tracker.reset("ch-1")
+	assert.False(t, tracker.isQuarantined("ch-1"))
+	assert.Empty(t, tracker.listQuarantined())
+
+	// no limit
+	Params.Save(Params.DataCoordCfg.ChannelWatchRetryBudget.Key, "0")
+	for i := 0; i < 20; i++ {
+		_, quarantined = tracker.onFailure("ch-2", 1)
+		assert.False(t, quarantined)
+	}
+}
@@ -0,0 +1,284 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// cloneCheckInterval is the interval to check whether the sealed segments to clone are flushed.
+var cloneCheckInterval = time.Second
+
+// cloneJobManager tracks the clone jobs by the target collection.
+// The jobs are persisted in the catalog with their progress, every change is persisted before it's applied in memory,
+// the job interrupted by datacoord restarting is resumed, see resumeCloneJobs.
+type cloneJobManager struct {
+	mu      sync.RWMutex
+	catalog metastore.DataCoordCatalog
+	jobs    map[UniqueID]*datapb.CloneJob
+}
+
+func newCloneJobManager(ctx context.Context, catalog metastore.DataCoordCatalog) (*cloneJobManager, error) {
+	m := &cloneJobManager{
+		catalog: catalog,
+		jobs:    make(map[UniqueID]*datapb.CloneJob),
+	}
+	jobs, err := catalog.ListCloneJobs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, job := range jobs {
+		m.jobs[job.GetRequest().GetTargetCollectionID()] = job
+	}
+	return m, nil
+}
+
+// add registers a job cloning the segments into the target collection,
+// fails if another job is cloning into the same collection.
+func (m *cloneJobManager) add(ctx context.Context, req *datapb.CloneSegmentsRequest, segmentIDs []UniqueID) (*datapb.CloneJob, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if job, ok := m.jobs[req.GetTargetCollectionID()]; ok && job.GetState() == datapb.CloneState_Cloning {
+		return nil, merr.WrapErrParameterInvalid("no clone in progress", "cloning", "the target collection is being cloned into")
+	}
+	state := datapb.CloneState_Cloning
+	if len(segmentIDs) == 0 {
+		state = datapb.CloneState_CloneCompleted
+	}
+	job := &datapb.CloneJob{
+		Request:    req,
+		SegmentIDs: segmentIDs,
+		State:      state,
+	}
+	if err := m.catalog.SaveCloneJob(ctx, job); err != nil {
+		return nil, err
+	}
+	m.jobs[req.GetTargetCollectionID()] = job
+	return proto.Clone(job).(*datapb.CloneJob), nil
+}
+
+// update applies the change to the job of the target collection once it's persisted.
+func (m *cloneJobManager) update(ctx context.Context, targetCollectionID UniqueID, fn func(job *datapb.CloneJob)) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	job, ok := m.jobs[targetCollectionID]
+	if !ok {
+		return merr.WrapErrCollectionNotFound(targetCollectionID, "no clone job")
+	}
+	job = proto.Clone(job).(*datapb.CloneJob)
+	fn(job)
+	if err := m.catalog.SaveCloneJob(ctx, job); err != nil {
+		return err
+	}
+	m.jobs[targetCollectionID] = job
+	return nil
+}
+
+// onCloning records the ID allocated for the next segment to clone.
+func (m *cloneJobManager) onCloning(ctx context.Context, targetCollectionID UniqueID, segmentID UniqueID) error {
+	return m.update(ctx, targetCollectionID, func(job *datapb.CloneJob) {
+		job.CloningSegmentID = segmentID
+	})
+}
+
+func (m *cloneJobManager) onCloned(ctx context.Context, targetCollectionID UniqueID) error {
+	return m.update(ctx, targetCollectionID, func(job *datapb.CloneJob) {
+		job.Cloned++
+		job.CloningSegmentID = 0
+		if job.GetCloned() == int64(len(job.GetSegmentIDs())) {
+			job.State = datapb.CloneState_CloneCompleted
+		}
+	})
+}
+
+func (m *cloneJobManager) onFailed(ctx context.Context, targetCollectionID UniqueID, err error) error {
+	return m.update(ctx, targetCollectionID, func(job *datapb.CloneJob) {
+		job.State = datapb.CloneState_CloneFailed
+		job.Reason = err.Error()
+	})
+}
+
+// remove drops the job of the target collection, which is dropped.
+func (m *cloneJobManager) remove(ctx context.Context, targetCollectionID UniqueID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.catalog.DropCloneJob(ctx, targetCollectionID); err != nil {
+		return err
+	}
+	delete(m.jobs, targetCollectionID)
+	return nil
+}
+
+// listJobs returns all the jobs, including the finished ones.
+func (m *cloneJobManager) listJobs() []*datapb.CloneJob {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return lo.MapToSlice(m.jobs, func(_ UniqueID, job *datapb.CloneJob) *datapb.CloneJob {
+		return proto.Clone(job).(*datapb.CloneJob)
+	})
+}
+
+// getState returns the state of the latest job cloning into the target collection.
+func (m *cloneJobManager) getState(targetCollectionID UniqueID) *datapb.GetCloneSegmentsStateResponse {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	job, ok := m.jobs[targetCollectionID]
+	if !ok {
+		return &datapb.GetCloneSegmentsStateResponse{
+			Status: merr.Status(nil),
+			State:  datapb.CloneState_CloneNone,
+		}
+	}
+	return &datapb.GetCloneSegmentsStateResponse{
+		Status:         merr.Status(nil),
+		State:          job.GetState(),
+		TotalSegments:  int64(len(job.GetSegmentIDs())),
+		ClonedSegments: job.GetCloned(),
+		Reason:         job.GetReason(),
+	}
+}
+
+// resumeCloneJobs resumes the jobs interrupted by datacoord restarting,
+// and removes the jobs whose target collections have been dropped.
+func (s *Server) resumeCloneJobs(ctx context.Context) {
+	for _, job := range s.cloneJobs.listJobs() {
+		targetCollectionID := job.GetRequest().GetTargetCollectionID()
+		log := log.Ctx(ctx).With(zap.Int64("targetCollectionID", targetCollectionID))
+		has, err := s.broker.HasCollection(ctx, targetCollectionID)
+		if err != nil {
+			// keep the job, it's checked again after the next restart.
+			log.Warn("failed to check the target collection of clone job", zap.Error(err))
+		} else if !has {
+			if err := s.cloneJobs.remove(ctx, targetCollectionID); err != nil {
+				log.Warn("failed to remove the clone job of dropped collection", zap.Error(err))
+			} else {
+				log.Info("clone job of dropped collection removed")
+			}
+			continue
+		}
+		if job.GetState() == datapb.CloneState_Cloning {
+			log.Info("resume clone job", zap.Int64("cloned", job.GetCloned()), zap.Int("segmentNum", len(job.GetSegmentIDs())))
+			s.serverLoopWg.Add(1)
+			go s.runCloneJob(ctx, job)
+		}
+	}
+}
+
+// runCloneJob clones the segments one by one from the progress of the job,
+// the sealed ones are cloned once they are flushed.
+// The job interrupted by the context is not failed, but resumed after restarting.
+func (s *Server) runCloneJob(ctx context.Context, job *datapb.CloneJob) {
+	defer s.serverLoopWg.Done()
+	req := job.GetRequest()
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64("targetCollectionID", req.GetTargetCollectionID()),
+	)
+
+	fail := func(err error) {
+		if ctx.Err() != nil {
+			log.Info("clone job interrupted", zap.Error(err))
+			return
+		}
+		if err := s.cloneJobs.onFailed(ctx, req.GetTargetCollectionID(), err); err != nil {
+			log.Warn("failed to save the failure of clone job", zap.Error(err))
+		}
+	}
+
+	log.Info("start to clone segments", zap.Int("segmentNum", len(job.GetSegmentIDs())), zap.Int64("cloned", job.GetCloned()))
+	cloningID := job.GetCloningSegmentID()
+	for _, segmentID := range job.GetSegmentIDs()[job.GetCloned():] {
+		// the segment had been added before the job was interrupted
+		if cloningID == 0 || s.meta.GetSegment(cloningID) == nil {
+			clonedID, err := s.cloneFlushedSegment(ctx, segmentID, req, cloningID)
+			if err != nil {
+				log.Warn("failed to clone segment", zap.Int64("segmentID", segmentID), zap.Error(err))
+				fail(err)
+				return
+			}
+			cloningID = clonedID
+		}
+		if err := s.cloneJobs.onCloned(ctx, req.GetTargetCollectionID()); err != nil {
+			log.Warn("failed to save the progress of clone job", zap.Int64("segmentID", segmentID), zap.Error(err))
+			fail(err)
+			return
+		}
+		log.Info("segment cloned", zap.Int64("segmentID", segmentID), zap.Int64("clonedSegmentID", cloningID))
+		cloningID = 0
+	}
+	log.Info("segments cloned")
+}
+
+// cloneFlushedSegment clones the segment once it's flushed, returns the new segment ID.
+// The ID allocated for the interrupted clone is reused if any, otherwise a new one is allocated and recorded
+// before copying the binlogs.
+func (s *Server) cloneFlushedSegment(ctx context.Context, segmentID UniqueID, req *datapb.CloneSegmentsRequest, clonedID UniqueID) (UniqueID, error) {
+	segment, err := s.waitSegmentFlushed(ctx, segmentID)
+	if err != nil {
+		return 0, err
+	}
+	if clonedID == 0 {
+		if clonedID, err = s.allocator.allocID(ctx); err != nil {
+			return 0, err
+		}
+		if err := s.cloneJobs.onCloning(ctx, req.GetTargetCollectionID(), clonedID); err != nil {
+			return 0, err
+		}
+	}
+	if err := s.cloneSegment(ctx, segment, req, clonedID); err != nil {
+		return 0, err
+	}
+	return clonedID, nil
+}
+
+// waitSegmentFlushed waits until the segment is flushed, the segment compacted meanwhile fails the clone,
+// since its data has been moved into another segment.
+func (s *Server) waitSegmentFlushed(ctx context.Context, segmentID UniqueID) (*SegmentInfo, error) {
+	ticker := time.NewTicker(cloneCheckInterval)
+	defer ticker.Stop()
+	for {
+		segment := s.meta.GetHealthySegment(segmentID)
+		if segment == nil {
+			return nil, merr.WrapErrSegmentNotFound(segmentID, "segment compacted or dropped during cloning")
+		}
+		if segment.GetState() == commonpb.SegmentState_Flushed {
+			return segment, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,104 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+func TestCloneJobManager(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("list failed", func(t *testing.T) {
+		catalog := mocks.NewDataCoordCatalog(t)
+		catalog.EXPECT().ListCloneJobs(mock.Anything).Return(nil, errors.New("mock"))
+		_, err := newCloneJobManager(ctx, catalog)
+		assert.Error(t, err)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		catalog := mocks.NewDataCoordCatalog(t)
+		catalog.EXPECT().ListCloneJobs(mock.Anything).Return([]*datapb.CloneJob{{
+			Request:    &datapb.CloneSegmentsRequest{CollectionID: 100, TargetCollectionID: 200},
+			SegmentIDs: []int64{1, 2},
+			Cloned:     1,
+			State:      datapb.CloneState_Cloning,
+		}}, nil)
+		var saved *datapb.CloneJob
+		saveErr := errors.New("mock")
+		catalog.EXPECT().SaveCloneJob(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, job *datapb.CloneJob) error {
+			if saveErr != nil {
+				return saveErr
+			}
+			saved = job
+			return nil
+		})
+		m, err := newCloneJobManager(ctx, catalog)
+		require.NoError(t, err)
+
+		// the reloaded job is still cloning
+		state := m.getState(200)
+		assert.Equal(t, datapb.CloneState_Cloning, state.GetState())
+		assert.EqualValues(t, 2, state.GetTotalSegments())
+		assert.EqualValues(t, 1, state.GetClonedSegments())
+		_, err = m.add(ctx, &datapb.CloneSegmentsRequest{TargetCollectionID: 200}, []int64{3})
+		assert.Error(t, err)
+
+		// the progress is not changed if failed to persist
+		assert.Error(t, m.onCloning(ctx, 200, 1000))
+		assert.Error(t, m.onCloned(ctx, 200))
+		assert.EqualValues(t, 1, m.getState(200).GetClonedSegments())
+
+		saveErr = nil
+		assert.NoError(t, m.onCloning(ctx, 200, 1000))
+		assert.EqualValues(t, 1000, saved.GetCloningSegmentID())
+		assert.NoError(t, m.onCloned(ctx, 200))
+		assert.EqualValues(t, 0, saved.GetCloningSegmentID())
+		assert.EqualValues(t, 2, saved.GetCloned())
+		assert.Equal(t, datapb.CloneState_CloneCompleted, saved.GetState())
+		assert.Equal(t, datapb.CloneState_CloneCompleted, m.getState(200).GetState())
+
+		// a new job replaces the finished one
+		job, err := m.add(ctx, &datapb.CloneSegmentsRequest{TargetCollectionID: 200}, []int64{3})
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{3}, job.GetSegmentIDs())
+		assert.NoError(t, m.onFailed(ctx, 200, errors.New("mock")))
+		state = m.getState(200)
+		assert.Equal(t, datapb.CloneState_CloneFailed, state.GetState())
+		assert.Equal(t, "mock", state.GetReason())
+
+		// nothing to clone
+		job, err = m.add(ctx, &datapb.CloneSegmentsRequest{TargetCollectionID: 300}, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, datapb.CloneState_CloneCompleted, job.GetState())
+		assert.Len(t, m.listJobs(), 2)
+		assert.Error(t, m.onCloned(ctx, 400))
+
+		catalog.EXPECT().DropCloneJob(mock.Anything, int64(300)).Return(nil)
+		assert.NoError(t, m.remove(ctx, 300))
+		assert.Equal(t, datapb.CloneState_CloneNone, m.getState(300).GetState())
+	})
+}
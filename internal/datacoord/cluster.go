@@ -33,6 +33,7 @@ import (
 type Cluster struct {
 	sessionManager *SessionManager
 	channelManager *ChannelManager
+	flushThrottle  *flushThrottle
 }
 
 // NewCluster creates a new cluster
@@ -40,6 +41,7 @@ func NewCluster(sessionManager *SessionManager, channelManager *ChannelManager)
 	c := &Cluster{
 		sessionManager: sessionManager,
 		channelManager: channelManager,
+		flushThrottle:  newFlushThrottle(),
 	}
 
 	return c
@@ -63,12 +65,28 @@ func (c *Cluster) Register(node *NodeInfo) error {
 	return c.channelManager.AddNode(node.NodeID)
 }
 
-// UnRegister removes a node from cluster
+// UnRegister removes a node from cluster, its channels are handed off to other nodes,
+// and its subscriptions are kept until the channels are watched by the other nodes
 func (c *Cluster) UnRegister(node *NodeInfo) error {
 	c.sessionManager.DeleteSession(node)
 	return c.channelManager.DeleteNode(node.NodeID)
 }
 
+// Cordon stops assigning new channels to the node
+func (c *Cluster) Cordon(nodeID int64) error {
+	return c.channelManager.Cordon(nodeID)
+}
+
+// Uncordon makes the node schedulable again and stops draining it
+func (c *Cluster) Uncordon(nodeID int64) error {
+	return c.channelManager.Uncordon(nodeID)
+}
+
+// Drain cordons the node and migrates its channels to other nodes gradually in background
+func (c *Cluster) Drain(ctx context.Context, nodeID int64) error {
+	return c.channelManager.Drain(ctx, nodeID)
+}
+
 // Watch tries to add a channel in datanode cluster
 func (c *Cluster) Watch(ch string, collectionID UniqueID) error {
 	return c.channelManager.Watch(&channel{Name: ch, CollectionID: collectionID})
@@ -76,6 +94,7 @@ func (c *Cluster) Watch(ch string, collectionID UniqueID) error {
 
 // Flush sends flush requests to dataNodes specified
 // which also according to channels where segments are assigned to.
+// ErrServiceRequestLimitExceeded is returned if the flushes of the collection are throttled.
 func (c *Cluster) Flush(ctx context.Context, nodeID int64, channel string,
 	segments []*datapb.SegmentInfo) error {
 	if !c.channelManager.Match(nodeID, channel) {
@@ -102,7 +121,14 @@ func (c *Cluster) Flush(ctx context.Context, nodeID int64, channel string,
 		SegmentIDs:   lo.Map(segments, getSegmentID),
 	}
 
-	c.sessionManager.Flush(ctx, nodeID, req)
+	release, err := c.flushThrottle.acquire(ch.CollectionID)
+	if err != nil {
+		return err
+	}
+	go func() {
+		defer release()
+		c.sessionManager.execFlush(ctx, nodeID, req)
+	}()
 	return nil
 }
 
@@ -111,15 +137,41 @@ func (c *Cluster) Import(ctx context.Context, nodeID int64, it *datapb.ImportTas
 	c.sessionManager.Import(ctx, nodeID, it)
 }
 
-// ReCollectSegmentStats triggers a ReCollectSegmentStats call from session manager.
-func (c *Cluster) ReCollectSegmentStats(ctx context.Context) error {
-	for _, node := range c.sessionManager.getLiveNodeIDs() {
-		err := c.sessionManager.ReCollectSegmentStats(ctx, node)
+// ReCollectSegmentStats triggers a ReCollectSegmentStats call from session manager,
+// the DataNodes are filtered by the collection and the channel they watch if set,
+// returns the result of each DataNode asked.
+func (c *Cluster) ReCollectSegmentStats(ctx context.Context, collectionID UniqueID, channelName string) ([]*datapb.ReCollectSegmentStatsResult, error) {
+	var nodes []int64
+	switch {
+	case channelName != "":
+		nodeID, err := c.channelManager.FindWatcher(channelName)
 		if err != nil {
-			return err
+			return nil, err
 		}
+		nodes = []int64{nodeID}
+	case collectionID != 0:
+		for _, info := range c.channelManager.GetChannels() {
+			if lo.ContainsBy(info.Channels, func(ch *channel) bool { return ch.CollectionID == collectionID }) {
+				nodes = append(nodes, info.NodeID)
+			}
+		}
+	default:
+		nodes = c.sessionManager.getLiveNodeIDs()
 	}
-	return nil
+
+	results := make([]*datapb.ReCollectSegmentStatsResult, 0, len(nodes))
+	for _, nodeID := range nodes {
+		result := &datapb.ReCollectSegmentStatsResult{NodeID: nodeID}
+		segmentIDs, err := c.sessionManager.ReCollectSegmentStats(ctx, nodeID, collectionID, channelName)
+		if err != nil {
+			result.Reason = err.Error()
+		} else {
+			result.Success = true
+			result.SegmentIDs = segmentIDs
+		}
+		results = append(results, result)
+	}
+	return results, nil
 }
 
 // GetSessions returns all sessions
@@ -750,10 +750,26 @@ func TestCluster_ReCollectSegmentStats(t *testing.T) {
 		err = cluster.Watch("chan-1", 1)
 		assert.NoError(t, err)
 
-		assert.NotPanics(t, func() {
-			cluster.ReCollectSegmentStats(ctx)
-		})
-		time.Sleep(500 * time.Millisecond)
+		results, err := cluster.ReCollectSegmentStats(ctx, 0, "")
+		assert.NoError(t, err)
+		assert.Len(t, results, 1)
+		assert.EqualValues(t, 1, results[0].GetNodeID())
+		assert.True(t, results[0].GetSuccess())
+
+		// scoped by the collection
+		results, err = cluster.ReCollectSegmentStats(ctx, 1, "")
+		assert.NoError(t, err)
+		assert.Len(t, results, 1)
+		results, err = cluster.ReCollectSegmentStats(ctx, 2, "")
+		assert.NoError(t, err)
+		assert.Empty(t, results)
+
+		// scoped by the channel
+		results, err = cluster.ReCollectSegmentStats(ctx, 0, "chan-1")
+		assert.NoError(t, err)
+		assert.Len(t, results, 1)
+		_, err = cluster.ReCollectSegmentStats(ctx, 0, "chan-2")
+		assert.Error(t, err)
 	})
 
 	t.Run("recollect failed", func(t *testing.T) {
@@ -776,9 +792,10 @@ func TestCluster_ReCollectSegmentStats(t *testing.T) {
 		err = cluster.Watch("chan-1", 1)
 		assert.NoError(t, err)
 
-		assert.NotPanics(t, func() {
-			cluster.ReCollectSegmentStats(ctx)
-		})
-		time.Sleep(500 * time.Millisecond)
+		results, err := cluster.ReCollectSegmentStats(ctx, 0, "")
+		assert.NoError(t, err)
+		assert.Len(t, results, 1)
+		assert.False(t, results[0].GetSuccess())
+		assert.NotEmpty(t, results[0].GetReason())
 	})
 }
@@ -24,12 +24,17 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
 // TODO this num should be determined by resources of datanode, for now, we set to a fixed value for simple
@@ -51,6 +56,8 @@ type compactionPlanContext interface {
 	isFull() bool
 	// get compaction tasks by signal id
 	getCompactionTasksBySignalID(signalID int64) []*compactionTask
+	// cancelCompaction cancels the unfinished tasks of the signal, and returns the cancelled tasks
+	cancelCompaction(signalID int64) []*compactionTask
 }
 
 type compactionTaskState int8
@@ -61,6 +68,7 @@ const (
 	completed
 	failed
 	timeout
+	cancelled
 )
 
 var (
@@ -103,7 +111,9 @@ type compactionPlanHandler struct {
 	wg               sync.WaitGroup
 	flushCh          chan UniqueID
 	//segRefer         *SegmentReferenceManager
-	parallelCh map[int64]chan struct{}
+	scheduler  compactionScheduler
+	auditLog   *auditLog
+	queryCoord types.QueryCoord // notified of the committed compactions, nil if disabled
 }
 
 func newCompactionPlanHandler(sessions *SessionManager, cm *ChannelManager, meta *meta,
@@ -116,7 +126,6 @@ func newCompactionPlanHandler(sessions *SessionManager, cm *ChannelManager, meta
 		allocator: allocator,
 		flushCh:   flush,
 		//segRefer:   segRefer,
-		parallelCh: make(map[int64]chan struct{}),
 	}
 }
 
@@ -144,6 +153,8 @@ func (c *compactionPlanHandler) start() {
 				}
 				cancel()
 				_ = c.updateCompaction(ts)
+				// the budget may be changed, try to schedule the pending tasks
+				c.schedule()
 			}
 		}
 	}()
@@ -184,32 +195,101 @@ func (c *compactionPlanHandler) execCompactionPlan(signal *compactionSignal, pla
 	c.plans[plan.PlanID] = task
 	c.executingTaskNum++
 
-	go func() {
-		log.Info("acquire queue", zap.Int64("nodeID", nodeID), zap.Int64("planID", plan.GetPlanID()))
-		c.acquireQueue(nodeID)
-
-		ts, err := c.allocator.allocTimestamp(context.TODO())
-		if err != nil {
-			log.Warn("Alloc start time for CompactionPlan failed", zap.Int64("planID", plan.GetPlanID()))
-			// update plan ts to TIMEOUT ts
-			c.updateTask(plan.PlanID, setState(executing), setStartTime(tsTimeout))
-			return
+	log.Info("enqueue compaction", zap.Int64("nodeID", nodeID), zap.Int64("planID", plan.GetPlanID()))
+	c.recordAudit(signal, plan, nodeID)
+	c.scheduler.enqueue(task)
+	c.schedule()
+	return nil
+}
+
+// recordAudit records the compaction plan in the audit log.
+func (c *compactionPlanHandler) recordAudit(signal *compactionSignal, plan *datapb.CompactionPlan, nodeID int64) {
+	if c.auditLog == nil {
+		return
+	}
+	segmentIDs := lo.Map(plan.GetSegmentBinlogs(), func(binlogs *datapb.CompactionSegmentBinlogs, _ int) int64 {
+		return binlogs.GetSegmentID()
+	})
+	var collectionID UniqueID
+	var force bool
+	if signal != nil {
+		collectionID, force = signal.collectionID, signal.isForce
+	}
+	if collectionID == 0 && len(segmentIDs) > 0 {
+		// the global signals are not bound to a collection
+		if segment := c.meta.GetSegment(segmentIDs[0]); segment != nil {
+			collectionID = segment.GetCollectionID()
 		}
-		c.updateTask(plan.PlanID, setStartTime(ts))
-		err = c.sessions.Compaction(nodeID, plan)
-		c.updateTask(plan.PlanID, setState(executing))
-		if err != nil {
-			log.Warn("try to Compaction but DataNode rejected",
-				zap.Int64("targetNodeID", nodeID),
-				zap.Int64("planID", plan.GetPlanID()),
-			)
-			// do nothing here, prevent double release, see issue#21014
-			// release queue will be done in `updateCompaction`
-			return
+	}
+	c.auditLog.Record(&datapb.AuditEvent{
+		Type:         datapb.AuditEventType_CompactionPlanned,
+		NodeID:       nodeID,
+		CollectionID: collectionID,
+		ChannelName:  plan.GetChannel(),
+		SegmentIDs:   segmentIDs,
+		PlanID:       plan.GetPlanID(),
+		Detail:       fmt.Sprintf("type: %s, force: %t", plan.GetType().String(), force),
+	})
+}
+
+// schedule submits the tasks picked by the scheduler to DataNodes.
+func (c *compactionPlanHandler) schedule() {
+	for _, task := range c.scheduler.schedule() {
+		go c.submit(task)
+	}
+}
+
+// submit submits the plan to the DataNode, the bandwidth reserved by the plan is released
+// once the plan finishes, fails or is cancelled.
+func (c *compactionPlanHandler) submit(task *compactionTask) {
+	plan, nodeID := task.plan, task.dataNodeID
+	if c.getCompaction(plan.GetPlanID()).state == cancelled {
+		log.Info("compaction cancelled before execution", zap.Int64("planID", plan.GetPlanID()))
+		c.releaseBandwidth(plan.GetPlanID())
+		return
+	}
+
+	ts, err := c.allocator.allocTimestamp(context.TODO())
+	if err != nil {
+		log.Warn("Alloc start time for CompactionPlan failed", zap.Int64("planID", plan.GetPlanID()))
+		// update plan ts to TIMEOUT ts
+		if !c.toExecuting(plan.PlanID, setStartTime(tsTimeout)) {
+			c.releaseBandwidth(plan.GetPlanID())
 		}
-		log.Info("start compaction", zap.Int64("nodeID", nodeID), zap.Int64("planID", plan.GetPlanID()))
-	}()
-	return nil
+		return
+	}
+	c.updateTask(plan.PlanID, setStartTime(ts))
+	err = c.sessions.Compaction(nodeID, plan)
+	if !c.toExecuting(plan.PlanID) {
+		// the plan is cancelled while submitting it, interrupt it on DataNode
+		log.Info("compaction cancelled during submission", zap.Int64("planID", plan.GetPlanID()))
+		if err == nil {
+			_ = c.sessions.CancelCompactionPlans(nodeID, []int64{plan.GetPlanID()})
+		}
+		c.releaseBandwidth(plan.GetPlanID())
+		return
+	}
+	if err != nil {
+		log.Warn("try to Compaction but DataNode rejected",
+			zap.Int64("targetNodeID", nodeID),
+			zap.Int64("planID", plan.GetPlanID()),
+		)
+		// do nothing here, prevent double release, see issue#21014
+		// release bandwidth will be done in `updateCompaction`
+		return
+	}
+	log.Info("start compaction", zap.Int64("nodeID", nodeID), zap.Int64("planID", plan.GetPlanID()))
+}
+
+// toExecuting set the task state to executing unless it's cancelled, returns false if the task is cancelled.
+func (c *compactionPlanHandler) toExecuting(planID int64, opts ...compactionTaskOpt) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.plans[planID].state == cancelled {
+		return false
+	}
+	c.plans[planID] = c.plans[planID].shadowClone(append(opts, setState(executing))...)
+	return true
 }
 
 func (c *compactionPlanHandler) setSegmentsCompacting(plan *datapb.CompactionPlan, compacting bool) {
@@ -232,7 +312,7 @@ func (c *compactionPlanHandler) completeCompaction(result *datapb.CompactionResu
 
 	plan := c.plans[planID].plan
 	switch plan.GetType() {
-	case datapb.CompactionType_MergeCompaction, datapb.CompactionType_MixCompaction:
+	case datapb.CompactionType_MergeCompaction, datapb.CompactionType_MixCompaction, datapb.CompactionType_ExternalConversion:
 		if err := c.handleMergeCompactionResult(plan, result); err != nil {
 			return err
 		}
@@ -242,13 +322,13 @@ func (c *compactionPlanHandler) completeCompaction(result *datapb.CompactionResu
 	c.plans[planID] = c.plans[planID].shadowClone(setState(completed), setResult(result))
 	c.executingTaskNum--
 	if c.plans[planID].plan.GetType() == datapb.CompactionType_MergeCompaction ||
-		c.plans[planID].plan.GetType() == datapb.CompactionType_MixCompaction {
+		c.plans[planID].plan.GetType() == datapb.CompactionType_MixCompaction ||
+		c.plans[planID].plan.GetType() == datapb.CompactionType_ExternalConversion {
 		c.flushCh <- result.GetSegmentID()
 	}
 	// TODO: when to clean task list
 
-	nodeID := c.plans[planID].dataNodeID
-	c.releaseQueue(nodeID)
+	c.releaseBandwidth(planID)
 
 	metrics.DataCoordCompactedSegmentSize.WithLabelValues().Observe(float64(getCompactedSegmentSize(result)))
 	return nil
@@ -274,6 +354,8 @@ func (c *compactionPlanHandler) handleMergeCompactionResult(plan *datapb.Compact
 		CompactedFrom: newSegment.GetCompactionFrom(),
 		NumOfRows:     newSegment.GetNumOfRows(),
 		StatsLogs:     newSegment.GetStatslogs(),
+		ChannelName:   plan.GetChannel(),
+		PartitionId:   newSegment.GetPartitionID(),
 	}
 
 	log.Info("handleCompactionResult: syncing segments with node", zap.Int64("nodeID", nodeID))
@@ -284,11 +366,46 @@ func (c *compactionPlanHandler) handleMergeCompactionResult(plan *datapb.Compact
 	}
 	// Apply metrics after successful meta update.
 	metricMutation.commit()
+	c.notifyCompaction(newSegment)
 
 	log.Info("handleCompactionResult: success to handle merge compaction result")
 	return nil
 }
 
+// notifyCompaction notifies QueryCoord of the committed compaction asynchronously,
+// so that QueryNodes load the compacted segment before the old segments are released.
+// The notification is best-effort, QueryCoord still finds the compacted segment by the periodical target update.
+func (c *compactionPlanHandler) notifyCompaction(segment *SegmentInfo) {
+	if c.queryCoord == nil || segment.GetNumOfRows() == 0 ||
+		!Params.DataCoordCfg.CompactionNotifyQueryCoord.GetAsBool() {
+		return
+	}
+	req := &querypb.NotifyCompactionRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		CollectionID:  segment.GetCollectionID(),
+		PartitionID:   segment.GetPartitionID(),
+		Channel:       segment.GetInsertChannel(),
+		CompactedFrom: segment.GetCompactionFrom(),
+		CompactedTo:   segment.GetID(),
+	}
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		ctx, cancel := context.WithTimeout(context.Background(), brokerRPCTimeout)
+		defer cancel()
+		status, err := c.queryCoord.NotifyCompaction(ctx, req)
+		if err = VerifyResponse(status, err); err != nil {
+			log.Warn("failed to notify QueryCoord of the compaction",
+				zap.Int64("collectionID", req.GetCollectionID()),
+				zap.Int64s("compactedFrom", req.GetCompactedFrom()),
+				zap.Int64("compactedTo", req.GetCompactedTo()),
+				zap.Error(err))
+		}
+	}()
+}
+
 // getCompaction return compaction task. If planId does not exist, return nil.
 func (c *compactionPlanHandler) getCompaction(planID int64) *compactionTask {
 	c.mu.RLock()
@@ -331,6 +448,7 @@ func (c *compactionPlanHandler) updateCompaction(ts Timestamp) error {
 				zap.Uint64("now", ts),
 			)
 			c.plans[planID] = c.plans[planID].shadowClone(setState(timeout))
+			c.releaseBandwidth(planID)
 			continue
 		}
 
@@ -338,7 +456,7 @@ func (c *compactionPlanHandler) updateCompaction(ts Timestamp) error {
 		c.plans[planID] = c.plans[planID].shadowClone(setState(failed))
 		c.setSegmentsCompacting(task.plan, false)
 		c.executingTaskNum--
-		c.releaseQueue(task.dataNodeID)
+		c.releaseBandwidth(planID)
 	}
 
 	return nil
@@ -350,35 +468,16 @@ func (c *compactionPlanHandler) isTimeout(now Timestamp, start Timestamp, timeou
 	return int32(ts.Sub(startTime).Seconds()) >= timeout
 }
 
-func (c *compactionPlanHandler) acquireQueue(nodeID int64) {
-	c.mu.Lock()
-	_, ok := c.parallelCh[nodeID]
-	if !ok {
-		c.parallelCh[nodeID] = make(chan struct{}, calculateParallel())
-	}
-	c.mu.Unlock()
-
-	c.mu.RLock()
-	ch := c.parallelCh[nodeID]
-	c.mu.RUnlock()
-	ch <- struct{}{}
-}
-
-func (c *compactionPlanHandler) releaseQueue(nodeID int64) {
-	log.Info("try to release queue", zap.Int64("nodeID", nodeID))
-	ch, ok := c.parallelCh[nodeID]
-	if !ok {
-		return
-	}
-	<-ch
+// releaseBandwidth releases the bandwidth reserved by the plan, and schedules the pending tasks.
+func (c *compactionPlanHandler) releaseBandwidth(planID int64) {
+	log.Info("try to release compaction bandwidth", zap.Int64("planID", planID))
+	c.scheduler.release(planID)
+	c.schedule()
 }
 
-// isFull return true if the task pool is full
+// isFull return true if the bandwidth budget is exhausted
 func (c *compactionPlanHandler) isFull() bool {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-
-	return c.executingTaskNum >= Params.DataCoordCfg.CompactionMaxParallelTasks.GetAsInt()
+	return c.scheduler.isFull()
 }
 
 func (c *compactionPlanHandler) getExecutingCompactions() []*compactionTask {
@@ -412,6 +511,42 @@ func (c *compactionPlanHandler) getCompactionTasksBySignalID(signalID int64) []*
 	return tasks
 }
 
+// cancelCompaction cancels the pipelining and executing tasks of the signal, the executing plans are interrupted
+// on DataNodes, and the compacting segments are released so that they could be compacted again.
+func (c *compactionPlanHandler) cancelCompaction(signalID int64) []*compactionTask {
+	c.mu.Lock()
+	var tasks []*compactionTask
+	node2Plans := make(map[int64][]int64)
+	for planID, task := range c.plans {
+		if task.triggerInfo.id != signalID || (task.state != pipelining && task.state != executing) {
+			continue
+		}
+		if task.state == executing {
+			node2Plans[task.dataNodeID] = append(node2Plans[task.dataNodeID], planID)
+			c.scheduler.release(planID)
+		} else {
+			// the pipelining task being submitted releases its bandwidth by itself
+			c.scheduler.remove(planID)
+		}
+		c.plans[planID] = task.shadowClone(setState(cancelled))
+		c.setSegmentsCompacting(task.plan, false)
+		c.executingTaskNum--
+		tasks = append(tasks, c.plans[planID])
+	}
+	c.mu.Unlock()
+	c.schedule()
+
+	for nodeID, planIDs := range node2Plans {
+		// the results of the cancelled plans are ignored even if DataNode fails to interrupt them
+		if err := c.sessions.CancelCompactionPlans(nodeID, planIDs); err != nil {
+			log.Warn("failed to interrupt cancelled compaction plans", zap.Int64("signalID", signalID),
+				zap.Int64("nodeID", nodeID), zap.Int64s("planIDs", planIDs), zap.Error(err))
+		}
+	}
+	log.Info("compaction cancelled", zap.Int64("signalID", signalID), zap.Int("cancelledPlans", len(tasks)))
+	return tasks
+}
+
 type compactionTaskOpt func(task *compactionTask)
 
 func setState(state compactionTaskState) compactionTaskOpt {
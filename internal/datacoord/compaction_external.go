@@ -0,0 +1,120 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// externalConversionTrigger periodically converts the segments registered on the external parquet files into binlogs.
+// The registered segments are neither loaded nor indexed, each of them is converted once its import job is flushed,
+// by a compaction plan replacing it with a new segment, which is indexed and loaded as the other compacted segments.
+// The conversion runs even if the compaction is disabled, the registered data is not queryable until it's converted.
+type externalConversionTrigger struct {
+	meta      *meta
+	allocator allocator
+	handler   compactionPlanContext
+}
+
+func newExternalConversionTrigger(meta *meta, allocator allocator, handler compactionPlanContext) *externalConversionTrigger {
+	return &externalConversionTrigger{meta: meta, allocator: allocator, handler: handler}
+}
+
+func (s *Server) startExternalConversionLoop(ctx context.Context) {
+	trigger := newExternalConversionTrigger(s.meta, s.allocator, s.compactionHandler)
+	s.serverLoopWg.Add(1)
+	go func() {
+		defer s.serverLoopWg.Done()
+		trigger.loop(ctx)
+	}()
+}
+
+func (t *externalConversionTrigger) loop(ctx context.Context) {
+	ticker := time.NewTicker(Params.DataCoordCfg.ExternalConversionInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("external conversion loop quit")
+			return
+		case <-ticker.C:
+			t.trigger(ctx)
+		}
+	}
+}
+
+// trigger submits a conversion plan for each registered segment, the segments are converted in the following rounds if the compaction task pool is full.
+func (t *externalConversionTrigger) trigger(ctx context.Context) {
+	segments := t.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return segment.GetExternalSource().GetRegistered() && segment.GetState() == commonpb.SegmentState_Flushed &&
+			!segment.isCompacting
+	})
+	for _, segment := range segments {
+		if t.handler.isFull() {
+			log.Ctx(ctx).Info("compaction task pool is full, convert the external segments later")
+			return
+		}
+		log := log.Ctx(ctx).With(zap.Int64("collectionID", segment.GetCollectionID()),
+			zap.Int64("segmentID", segment.GetID()),
+			zap.Strings("files", segment.GetExternalSource().GetFiles()))
+		plan, err := t.buildPlan(ctx, segment)
+		if err != nil {
+			log.Warn("failed to build the plan to convert the external segment", zap.Error(err))
+			continue
+		}
+		signal := &compactionSignal{
+			id:           plan.GetPlanID(),
+			collectionID: segment.GetCollectionID(),
+			partitionID:  segment.GetPartitionID(),
+			segmentID:    segment.GetID(),
+			channel:      segment.GetInsertChannel(),
+		}
+		if err := t.handler.execCompactionPlan(signal, plan); err != nil {
+			log.Warn("failed to convert the external segment", zap.Error(err))
+			continue
+		}
+		log.Info("external segment conversion submitted", zap.Int64("planID", plan.GetPlanID()))
+	}
+}
+
+func (t *externalConversionTrigger) buildPlan(ctx context.Context, segment *SegmentInfo) (*datapb.CompactionPlan, error) {
+	planID, err := t.allocator.allocID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &datapb.CompactionPlan{
+		PlanID: planID,
+		SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{{
+			SegmentID:      segment.GetID(),
+			InsertChannel:  segment.GetInsertChannel(),
+			PartitionID:    segment.GetPartitionID(),
+			ExternalSource: segment.GetExternalSource(),
+		}},
+		TimeoutInSeconds:     int32(Params.DataCoordCfg.CompactionTimeoutInSeconds.GetAsInt()),
+		Type:                 datapb.CompactionType_ExternalConversion,
+		Channel:              segment.GetInsertChannel(),
+		TotalRows:            segment.GetNumOfRows(),
+		EncryptionKeyVersion: t.meta.GetEncryptionKeyVersion(segment.GetCollectionID()),
+	}, nil
+}
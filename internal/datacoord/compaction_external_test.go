@@ -0,0 +1,121 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+func TestExternalConversionTrigger(t *testing.T) {
+	ctx := context.Background()
+	meta, err := newMemoryMeta()
+	require.NoError(t, err)
+
+	addSegment := func(id UniqueID, collectionID UniqueID, registered bool) {
+		require.NoError(t, meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+			ID:            id,
+			CollectionID:  collectionID,
+			PartitionID:   10,
+			InsertChannel: "ch1",
+			State:         commonpb.SegmentState_Flushed,
+			NumOfRows:     100,
+			IsImporting:   true,
+			ExternalSource: &datapb.ExternalSource{
+				Files:      []string{"a.parquet"},
+				ShardNum:   2,
+				ShardIndex: 1,
+				Registered: registered,
+			},
+		})))
+	}
+	addSegment(100, 1, true)
+	// the import job is not completed yet
+	addSegment(101, 1, false)
+	// the collection without index is converted as well
+	addSegment(102, 2, true)
+
+	handler := NewMockCompactionPlanContext(t)
+	handler.EXPECT().isFull().Return(false)
+	var plans []*datapb.CompactionPlan
+	handler.EXPECT().execCompactionPlan(mock.Anything, mock.Anything).Run(
+		func(signal *compactionSignal, plan *datapb.CompactionPlan) {
+			plans = append(plans, plan)
+			meta.SetSegmentCompacting(plan.GetSegmentBinlogs()[0].GetSegmentID(), true)
+		}).Return(nil)
+	trigger := newExternalConversionTrigger(meta, newMockAllocator(), handler)
+
+	trigger.trigger(ctx)
+	require.Len(t, plans, 2)
+	plan, ok := lo.Find(plans, func(plan *datapb.CompactionPlan) bool {
+		return plan.GetSegmentBinlogs()[0].GetSegmentID() == 100
+	})
+	require.True(t, ok)
+	assert.Equal(t, datapb.CompactionType_ExternalConversion, plan.GetType())
+	assert.Equal(t, "ch1", plan.GetChannel())
+	assert.Equal(t, int64(100), plan.GetTotalRows())
+	require.Len(t, plan.GetSegmentBinlogs(), 1)
+	assert.Equal(t, int64(100), plan.GetSegmentBinlogs()[0].GetSegmentID())
+	assert.Equal(t, int64(10), plan.GetSegmentBinlogs()[0].GetPartitionID())
+	assert.Equal(t, int32(1), plan.GetSegmentBinlogs()[0].GetExternalSource().GetShardIndex())
+
+	// the converting segments are not submitted again
+	trigger.trigger(ctx)
+	assert.Len(t, plans, 2)
+}
+
+func TestMeta_RegisterExternalSegment(t *testing.T) {
+	meta, err := newMemoryMeta()
+	require.NoError(t, err)
+	require.NoError(t, meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+		ID:            100,
+		CollectionID:  1,
+		PartitionID:   10,
+		InsertChannel: "ch1",
+		State:         commonpb.SegmentState_Growing,
+		IsImporting:   true,
+	})))
+
+	source := &datapb.ExternalSource{Files: []string{"a.parquet"}, ShardNum: 2, ShardIndex: 1}
+	require.NoError(t, meta.SetExternalSource(100, source, 50))
+	assert.Error(t, meta.SetExternalSource(101, source, 50))
+
+	// the rows are not counted from the binlogs
+	require.NoError(t, meta.UpdateFlushSegmentsInfo(100, true, false, true, nil, nil, nil, nil, nil, 0))
+	segment := meta.GetSegment(100)
+	assert.Equal(t, commonpb.SegmentState_Flushing, segment.GetState())
+	assert.Equal(t, int64(50), segment.GetNumOfRows())
+
+	// the segment stays importing until it's converted
+	require.NoError(t, meta.UnsetIsImporting(100))
+	segment = meta.GetSegment(100)
+	assert.True(t, segment.GetIsImporting())
+	assert.True(t, segment.GetExternalSource().GetRegistered())
+	assert.Equal(t, []int64{1}, meta.GetExternalCollections())
+
+	// the converted segment is dropped
+	require.NoError(t, meta.SetState(100, commonpb.SegmentState_Dropped))
+	assert.Empty(t, meta.GetExternalCollections())
+}
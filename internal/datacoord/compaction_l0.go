@@ -0,0 +1,386 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
+)
+
+// levelZeroCompactionTrigger periodically merges the L0 segments of each channel and partition into the flushed segments.
+// The DataNodes write the deletes on the flushed segments of a channel into L0 segments once, instead of into the deltalogs
+// of every flushed segment which may contain the deleted entities, the deletes are routed to those segments here by
+// their bloom filters in batches. The deletes on the growing segments are written into their own deltalogs by the DataNodes.
+type levelZeroCompactionTrigger struct {
+	meta      *meta
+	allocator allocator
+}
+
+func newLevelZeroCompactionTrigger(meta *meta, allocator allocator) *levelZeroCompactionTrigger {
+	return &levelZeroCompactionTrigger{meta: meta, allocator: allocator}
+}
+
+func (s *Server) startLevelZeroCompactionLoop(ctx context.Context) {
+	if !Params.DataCoordCfg.EnableCompaction.GetAsBool() {
+		return
+	}
+	trigger := newLevelZeroCompactionTrigger(s.meta, s.allocator)
+	s.serverLoopWg.Add(1)
+	go func() {
+		defer s.serverLoopWg.Done()
+		trigger.loop(ctx)
+	}()
+}
+
+func (t *levelZeroCompactionTrigger) loop(ctx context.Context) {
+	ticker := time.NewTicker(Params.DataCoordCfg.LevelZeroCompactionInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("L0 compaction loop quit")
+			return
+		case <-ticker.C:
+			t.trigger(ctx)
+		}
+	}
+}
+
+// trigger merges the flushed L0 segments of each channel and partition.
+func (t *levelZeroCompactionTrigger) trigger(ctx context.Context) {
+	segments := t.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return segment.GetLevel() == datapb.SegmentLevel_L0 && segment.GetState() == commonpb.SegmentState_Flushed
+	})
+	groups := lo.GroupBy(segments, func(segment *SegmentInfo) string {
+		return fmt.Sprintf("%s-%d", segment.GetInsertChannel(), segment.GetPartitionID())
+	})
+	for _, group := range groups {
+		log := log.Ctx(ctx).With(zap.String("channel", group[0].GetInsertChannel()),
+			zap.Int64("partitionID", group[0].GetPartitionID()),
+			zap.Int64s("l0SegmentIDs", lo.Map(group, func(segment *SegmentInfo, _ int) int64 { return segment.GetID() })))
+		targetIDs, merged, err := t.merge(ctx, group)
+		if err != nil {
+			log.Warn("failed to merge L0 segments", zap.Error(err))
+			continue
+		}
+		if !merged {
+			log.Info("L0 segments wait for the segments to merge into to be compacted")
+			continue
+		}
+		metrics.DataCoordMergedL0SegmentCount.Add(float64(len(group)))
+		log.Info("L0 segments merged", zap.Int64s("targetSegmentIDs", targetIDs))
+	}
+}
+
+// merge writes the deletes of the L0 segments of a channel and partition into the deltalogs of the flushed segments
+// which may contain the deleted entities, and marks the L0 segments dropped. The deltalogs are written without
+// holding the meta lock, then the meta is updated only if the segments merged into are unchanged meanwhile.
+// It returns the IDs of the segments merged into, and merged false if any of them is being compacted,
+// the merge is retried later in that case.
+func (t *levelZeroCompactionTrigger) merge(ctx context.Context, l0Segments []*SegmentInfo) ([]UniqueID, bool, error) {
+	targets, ok := t.meta.selectLevelZeroTargets(l0Segments)
+	if !ok {
+		return nil, false, nil
+	}
+	deletes, err := t.loadDeletes(ctx, l0Segments)
+	if err != nil {
+		return nil, false, err
+	}
+
+	deltalogs := make(map[UniqueID]*datapb.FieldBinlog)
+	for _, segment := range targets {
+		deltalog, err := t.writeDeltalog(ctx, segment, deletes)
+		if err != nil {
+			return nil, false, err
+		}
+		if deltalog != nil {
+			deltalogs[segment.GetID()] = deltalog
+		}
+	}
+	if err := t.meta.completeLevelZeroMerge(l0Segments, targets, deltalogs); err != nil {
+		return nil, false, err
+	}
+	return lo.Keys(deltalogs), true, nil
+}
+
+// loadDeletes reads the deletes of the L0 segments.
+func (t *levelZeroCompactionTrigger) loadDeletes(ctx context.Context, l0Segments []*SegmentInfo) (*storage.DeleteData, error) {
+	var paths []string
+	for _, segment := range l0Segments {
+		for _, fieldBinlog := range segment.GetDeltalogs() {
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				paths = append(paths, binlog.GetLogPath())
+			}
+		}
+	}
+	if len(paths) == 0 {
+		return &storage.DeleteData{}, nil
+	}
+	values, err := t.meta.chunkManager.MultiRead(ctx, paths)
+	if err != nil {
+		return nil, err
+	}
+	blobs := lo.Map(values, func(value []byte, _ int) *storage.Blob { return &storage.Blob{Value: value} })
+	_, _, deletes, err := storage.NewDeleteCodec().Deserialize(blobs)
+	return deletes, err
+}
+
+// writeDeltalog writes the deletes which may apply to the segment into a new deltalog of it,
+// i.e. the deletes after the segment started whose primary keys hit its bloom filters.
+// It returns nil if none of the deletes applies to the segment.
+func (t *levelZeroCompactionTrigger) writeDeltalog(ctx context.Context, segment *SegmentInfo, deletes *storage.DeleteData) (*datapb.FieldBinlog, error) {
+	stats, err := t.loadPkStats(ctx, segment)
+	if err != nil {
+		return nil, err
+	}
+	startTs := segment.GetStartPosition().GetTimestamp()
+	data := &storage.DeleteData{}
+	for i, pk := range deletes.Pks {
+		if deletes.Tss[i] < startTs {
+			// the deletes before the segment started could not apply to its entities
+			continue
+		}
+		// no stats means the primary keys of the segment are unknown
+		if len(stats) > 0 && !lo.ContainsBy(stats, func(stat *storage.PkStatistics) bool { return stat.PkExist(pk) }) {
+			continue
+		}
+		data.Append(pk, deletes.Tss[i])
+	}
+	if data.RowCount == 0 {
+		return nil, nil
+	}
+
+	blob, err := storage.NewDeleteCodec().Serialize(segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID(), data)
+	if err != nil {
+		return nil, err
+	}
+	logID, err := t.allocator.allocID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	logPath := metautil.BuildDeltaLogPath(t.meta.chunkManager.RootPath(), segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID(), logID)
+	if err := t.meta.chunkManager.Write(ctx, logPath, blob.GetValue()); err != nil {
+		return nil, err
+	}
+	return &datapb.FieldBinlog{Binlogs: []*datapb.Binlog{{
+		EntriesNum:    data.RowCount,
+		TimestampFrom: lo.Min(data.Tss),
+		TimestampTo:   lo.Max(data.Tss),
+		LogPath:       logPath,
+		LogSize:       int64(len(blob.GetValue())),
+		LogID:         logID,
+	}}}, nil
+}
+
+// loadPkStats loads the primary key bloom filters of the segment from its statslogs.
+func (t *levelZeroCompactionTrigger) loadPkStats(ctx context.Context, segment *SegmentInfo) ([]*storage.PkStatistics, error) {
+	var paths []string
+	compound := false
+Loop:
+	for _, fieldBinlog := range segment.GetStatslogs() {
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			// the compound stats log holds all the stats of the segment
+			if _, logIdx := path.Split(binlog.GetLogPath()); logIdx == storage.CompoundStatsType.LogIdx() {
+				paths = []string{binlog.GetLogPath()}
+				compound = true
+				break Loop
+			}
+			paths = append(paths, binlog.GetLogPath())
+		}
+	}
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	values, err := t.meta.chunkManager.MultiRead(ctx, paths)
+	if err != nil {
+		return nil, err
+	}
+	blobs := lo.Map(values, func(value []byte, _ int) *storage.Blob { return &storage.Blob{Value: value} })
+
+	var stats []*storage.PrimaryKeyStats
+	if compound {
+		stats, err = storage.DeserializeStatsList(blobs[0])
+	} else {
+		stats, err = storage.DeserializeStats(blobs)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return lo.Map(stats, func(stat *storage.PrimaryKeyStats, _ int) *storage.PkStatistics {
+		return &storage.PkStatistics{PkFilter: stat.BF, MinPK: stat.MinPk, MaxPK: stat.MaxPk}
+	}), nil
+}
+
+// addLevelZeroSegment adds the flushed L0 segment holding the deltalogs of the request,
+// the segment is written by the DataNode once, so nothing is done if it exists already.
+func (s *Server) addLevelZeroSegment(req *datapb.SaveBinlogPathsRequest) error {
+	segment := &datapb.SegmentInfo{
+		ID:            req.GetSegmentID(),
+		CollectionID:  req.GetCollectionID(),
+		PartitionID:   req.GetPartitionID(),
+		InsertChannel: req.GetChannel(),
+		State:         commonpb.SegmentState_Flushed,
+		Level:         datapb.SegmentLevel_L0,
+		Deltalogs:     req.GetDeltalogs(),
+	}
+	for _, cp := range req.GetCheckPoints() {
+		if cp.GetSegmentID() == req.GetSegmentID() {
+			segment.DmlPosition = cp.GetPosition()
+		}
+	}
+	for _, pos := range req.GetStartPositions() {
+		if pos.GetSegmentID() == req.GetSegmentID() {
+			segment.StartPosition = pos.GetStartPosition()
+		}
+	}
+	return s.meta.AddSegment(NewSegmentInfo(segment))
+}
+
+// selectLevelZeroTargets returns the flushed segments the L0 segments of a channel and partition are merged into,
+// i.e. the segments started before the deletes, ok false if any of them is being compacted.
+// The segments not flushed yet are skipped, the DataNodes write the deletes on them into their own deltalogs.
+func (m *meta) selectLevelZeroTargets(l0Segments []*SegmentInfo) ([]*SegmentInfo, bool) {
+	m.RLock()
+	defer m.RUnlock()
+
+	first := l0Segments[0]
+	var deleteTs Timestamp
+	for _, segment := range l0Segments {
+		if ts := segment.GetDmlPosition().GetTimestamp(); ts > deleteTs {
+			deleteTs = ts
+		}
+	}
+
+	var targets []*SegmentInfo
+	for _, segment := range m.segments.GetSegments() {
+		if !isLevelZeroTarget(segment, first) {
+			continue
+		}
+		if segment.GetStartPosition() != nil && segment.GetStartPosition().GetTimestamp() > deleteTs {
+			// all entities of the segment are inserted after the deletes
+			continue
+		}
+		if segment.isCompacting {
+			return nil, false
+		}
+		targets = append(targets, segment)
+	}
+	return targets, true
+}
+
+// holdCheckpointForLevelZero returns the position the QueryNodes read the deletes of the channel since,
+// which is the channel checkpoint held back to the earliest start position of the L0 segments not merged yet.
+// The DataNodes advance the checkpoint past the deletes once the L0 segments are synced,
+// but the deletes are not in the deltalogs of the flushed segments until merged.
+func (m *meta) holdCheckpointForLevelZero(vChannel string, checkpoint *msgpb.MsgPosition) *msgpb.MsgPosition {
+	if checkpoint == nil {
+		return nil
+	}
+	m.RLock()
+	defer m.RUnlock()
+
+	position := checkpoint
+	for _, segment := range m.segments.GetSegments() {
+		if segment.GetInsertChannel() != vChannel ||
+			segment.GetLevel() != datapb.SegmentLevel_L0 ||
+			segment.GetState() != commonpb.SegmentState_Flushed ||
+			segment.GetStartPosition() == nil {
+			continue
+		}
+		if segment.GetStartPosition().GetTimestamp() < position.GetTimestamp() {
+			position = segment.GetStartPosition()
+		}
+	}
+	return position
+}
+
+// isLevelZeroTarget returns whether the deletes of the L0 segment may be merged into the segment.
+func isLevelZeroTarget(segment *SegmentInfo, l0Segment *SegmentInfo) bool {
+	return segment.GetCollectionID() == l0Segment.GetCollectionID() &&
+		segment.GetInsertChannel() == l0Segment.GetInsertChannel() &&
+		// the deletes without partition specified are buffered into the L0 segment of the invalid partition
+		(l0Segment.GetPartitionID() == common.InvalidPartitionID || segment.GetPartitionID() == l0Segment.GetPartitionID()) &&
+		segment.GetLevel() != datapb.SegmentLevel_L0 &&
+		segment.GetState() == commonpb.SegmentState_Flushed &&
+		!segment.GetIsImporting()
+}
+
+// completeLevelZeroMerge appends the deltalogs written for the segments merged into, and marks the L0 segments dropped.
+// It fails if any of the segments merged into is dropped or being compacted since selected, the merge is retried then,
+// and the deltalogs written are removed by the garbage collector.
+func (m *meta) completeLevelZeroMerge(l0Segments []*SegmentInfo, targets []*SegmentInfo, deltalogs map[UniqueID]*datapb.FieldBinlog) error {
+	m.Lock()
+	defer m.Unlock()
+
+	metricMutation := &segMetricMutation{
+		stateChange: make(map[string]int),
+	}
+	modSegments := make([]*SegmentInfo, 0, len(deltalogs)+len(l0Segments))
+	increments := make([]metastore.BinlogsIncrement, 0, len(deltalogs))
+	for _, target := range targets {
+		segment := m.segments.GetSegment(target.GetID())
+		if segment == nil || segment.GetState() != commonpb.SegmentState_Flushed || segment.isCompacting {
+			return merr.WrapErrSegmentNotFound(target.GetID(), "segment to merge L0 segments into changed")
+		}
+		deltalog, ok := deltalogs[segment.GetID()]
+		if !ok {
+			continue
+		}
+		cloned := segment.Clone()
+		cloned.Deltalogs = append(cloned.Deltalogs, deltalog)
+		modSegments = append(modSegments, cloned)
+		increments = append(increments, metastore.BinlogsIncrement{Segment: cloned.SegmentInfo})
+	}
+
+	droppedAt := uint64(time.Now().UnixNano())
+	for _, l0Segment := range l0Segments {
+		segment := m.segments.GetSegment(l0Segment.GetID())
+		if segment == nil || segment.GetState() != commonpb.SegmentState_Flushed {
+			return merr.WrapErrSegmentNotFound(l0Segment.GetID(), "L0 segment merged already")
+		}
+		cloned := segment.Clone()
+		updateSegStateAndPrepareMetrics(cloned, commonpb.SegmentState_Dropped, metricMutation)
+		cloned.DroppedAt = droppedAt
+		modSegments = append(modSegments, cloned)
+	}
+
+	modInfos := lo.Map(modSegments, func(segment *SegmentInfo, _ int) *datapb.SegmentInfo { return segment.SegmentInfo })
+	if err := m.catalog.AlterSegments(m.ctx, modInfos, increments...); err != nil {
+		return err
+	}
+	metricMutation.commit()
+	for _, segment := range modSegments {
+		m.segments.SetSegment(segment.GetID(), segment)
+	}
+	return nil
+}
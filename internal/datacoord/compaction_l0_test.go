@@ -0,0 +1,208 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
+)
+
+func TestLevelZeroCompactionTrigger_Merge(t *testing.T) {
+	ctx := context.Background()
+	meta, err := newMemoryMeta()
+	require.NoError(t, err)
+	meta.chunkManager = storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	rootPath := meta.chunkManager.RootPath()
+	trigger := newLevelZeroCompactionTrigger(meta, newMockAllocator())
+
+	deletes := &storage.DeleteData{}
+	deletes.Append(storage.NewInt64PrimaryKey(1), 150)
+	deletes.Append(storage.NewInt64PrimaryKey(2), 200)
+	deletes.Append(storage.NewInt64PrimaryKey(3), 200)
+	blob, err := storage.NewDeleteCodec().Serialize(1, 10, 100, deletes)
+	require.NoError(t, err)
+	deltalogPath := metautil.BuildDeltaLogPath(rootPath, 1, 10, 100, 1000)
+	require.NoError(t, meta.chunkManager.Write(ctx, deltalogPath, blob.GetValue()))
+	l0Segment := &datapb.SegmentInfo{
+		ID:            100,
+		CollectionID:  1,
+		PartitionID:   10,
+		InsertChannel: "ch1",
+		State:         commonpb.SegmentState_Flushed,
+		Level:         datapb.SegmentLevel_L0,
+		DmlPosition:   &msgpb.MsgPosition{Timestamp: 200},
+		Deltalogs: []*datapb.FieldBinlog{
+			{Binlogs: []*datapb.Binlog{{LogID: 1000, LogPath: deltalogPath, TimestampTo: 200}}},
+		},
+	}
+	require.NoError(t, meta.AddSegment(NewSegmentInfo(l0Segment)))
+
+	addSegment := func(id UniqueID, partitionID UniqueID, state commonpb.SegmentState, startTs Timestamp, pks ...int64) {
+		segment := &datapb.SegmentInfo{
+			ID:            id,
+			CollectionID:  1,
+			PartitionID:   partitionID,
+			InsertChannel: "ch1",
+			State:         state,
+			StartPosition: &msgpb.MsgPosition{Timestamp: startTs},
+		}
+		if len(pks) > 0 {
+			stats := storage.NewPrimaryKeyStats(101, int64(schemapb.DataType_Int64), int64(len(pks)))
+			for _, pk := range pks {
+				stats.Update(storage.NewInt64PrimaryKey(pk))
+			}
+			writer := &storage.StatsWriter{}
+			require.NoError(t, writer.Generate(stats))
+			statslogPath := metautil.BuildStatsLogPath(rootPath, 1, partitionID, id, 101, 2000)
+			require.NoError(t, meta.chunkManager.Write(ctx, statslogPath, writer.GetBuffer()))
+			segment.Statslogs = []*datapb.FieldBinlog{
+				{FieldID: 101, Binlogs: []*datapb.Binlog{{LogID: 2000, LogPath: statslogPath}}},
+			}
+		}
+		require.NoError(t, meta.AddSegment(NewSegmentInfo(segment)))
+	}
+	addSegment(1, 10, commonpb.SegmentState_Flushed, 100, 1, 2)
+	// inserted after the deletes
+	addSegment(2, 10, commonpb.SegmentState_Flushed, 300)
+	// other partition
+	addSegment(3, 11, commonpb.SegmentState_Flushed, 100)
+	// the DataNode writes the deletes on the growing segments into their own deltalogs
+	addSegment(4, 10, commonpb.SegmentState_Growing, 100)
+	// no stats, started after the first delete
+	addSegment(5, 10, commonpb.SegmentState_Flushed, 160)
+	// no key deleted
+	addSegment(6, 10, commonpb.SegmentState_Flushed, 100, 10)
+
+	readDeletes := func(t *testing.T, segmentID UniqueID) []int64 {
+		deltalogs := meta.GetSegment(segmentID).GetDeltalogs()
+		require.Equal(t, 1, len(deltalogs))
+		binlog := deltalogs[0].GetBinlogs()[0]
+		assert.Equal(t, metautil.BuildDeltaLogPath(rootPath, 1, 10, segmentID, binlog.GetLogID()), binlog.GetLogPath())
+		value, err := meta.chunkManager.Read(ctx, binlog.GetLogPath())
+		require.NoError(t, err)
+		_, _, data, err := storage.NewDeleteCodec().Deserialize([]*storage.Blob{{Value: value}})
+		require.NoError(t, err)
+		assert.EqualValues(t, len(data.Pks), binlog.GetEntriesNum())
+		pks := make([]int64, 0, len(data.Pks))
+		for _, pk := range data.Pks {
+			pks = append(pks, pk.GetValue().(int64))
+		}
+		return pks
+	}
+
+	l0Segments := []*SegmentInfo{meta.GetSegment(100)}
+	t.Run("wait for compaction", func(t *testing.T) {
+		meta.SetSegmentCompacting(1, true)
+		defer meta.SetSegmentCompacting(1, false)
+		targetIDs, merged, err := trigger.merge(ctx, l0Segments)
+		assert.NoError(t, err)
+		assert.False(t, merged)
+		assert.Empty(t, targetIDs)
+		assert.Equal(t, commonpb.SegmentState_Flushed, meta.GetSegment(100).GetState())
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		targetIDs, merged, err := trigger.merge(ctx, l0Segments)
+		assert.NoError(t, err)
+		assert.True(t, merged)
+		assert.ElementsMatch(t, []UniqueID{1, 5}, targetIDs)
+
+		assert.ElementsMatch(t, []int64{1, 2}, readDeletes(t, 1))
+		assert.ElementsMatch(t, []int64{2, 3}, readDeletes(t, 5))
+		for _, id := range []UniqueID{2, 3, 4, 6} {
+			assert.Empty(t, meta.GetSegment(id).GetDeltalogs())
+		}
+		assert.Equal(t, commonpb.SegmentState_Dropped, meta.GetSegment(100).GetState())
+	})
+
+	t.Run("merged already", func(t *testing.T) {
+		_, _, err := trigger.merge(ctx, l0Segments)
+		assert.Error(t, err)
+		assert.Equal(t, 1, len(meta.GetSegment(1).GetDeltalogs()))
+	})
+}
+
+func TestLevelZeroCompactionTrigger_LoadBeforeMerge(t *testing.T) {
+	ctx := context.Background()
+	svr := newTestServer(t, nil)
+	defer closeTestServer(t, svr)
+	svr.meta.chunkManager = storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	svr.meta.AddCollection(&collectionInfo{ID: 1, Schema: newTestSchema()})
+	trigger := newLevelZeroCompactionTrigger(svr.meta, newMockAllocator())
+
+	require.NoError(t, svr.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+		ID:            1,
+		CollectionID:  1,
+		PartitionID:   10,
+		InsertChannel: "ch1",
+		State:         commonpb.SegmentState_Flushed,
+		NumOfRows:     1,
+		StartPosition: &msgpb.MsgPosition{ChannelName: "ch1", Timestamp: 50},
+		DmlPosition:   &msgpb.MsgPosition{ChannelName: "ch1", Timestamp: 100},
+	})))
+	require.NoError(t, svr.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+		ID:            100,
+		CollectionID:  1,
+		PartitionID:   10,
+		InsertChannel: "ch1",
+		State:         commonpb.SegmentState_Flushed,
+		Level:         datapb.SegmentLevel_L0,
+		StartPosition: &msgpb.MsgPosition{ChannelName: "ch1", MsgID: []byte{1}, Timestamp: 150},
+		DmlPosition:   &msgpb.MsgPosition{ChannelName: "ch1", Timestamp: 200},
+	})))
+	// the DataNode advances the checkpoint past the deletes once the L0 segment is synced
+	require.NoError(t, svr.meta.UpdateChannelCheckpoint("ch1", &msgpb.MsgPosition{ChannelName: "ch1", Timestamp: 300}))
+
+	load := func(t *testing.T) (*msgpb.MsgPosition, *datapb.VchannelInfo) {
+		resp, err := svr.GetSegmentInfo(ctx, &datapb.GetSegmentInfoRequest{SegmentIDs: []int64{1}})
+		require.NoError(t, err)
+		require.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		vchan := svr.handler.GetQueryVChanPositions(&channel{Name: "ch1", CollectionID: 1}, allPartitionID)
+		assert.NotContains(t, vchan.GetFlushedSegmentIds(), int64(100))
+		return resp.GetChannelCheckpoint()["ch1"], vchan
+	}
+
+	t.Run("before merge", func(t *testing.T) {
+		checkpoint, vchan := load(t)
+		// the deletes of the L0 segment are read from the channel
+		assert.EqualValues(t, 150, checkpoint.GetTimestamp())
+		assert.EqualValues(t, 150, vchan.GetSeekPosition().GetTimestamp())
+		assert.Equal(t, []byte{1}, vchan.GetSeekPosition().GetMsgID())
+		// the DataNode still seeks from the checkpoint
+		assert.EqualValues(t, 300, svr.handler.GetDataVChanPositions(&channel{Name: "ch1", CollectionID: 1}, allPartitionID).GetSeekPosition().GetTimestamp())
+	})
+
+	t.Run("after merge", func(t *testing.T) {
+		_, merged, err := trigger.merge(ctx, []*SegmentInfo{svr.meta.GetSegment(100)})
+		require.NoError(t, err)
+		require.True(t, merged)
+
+		checkpoint, vchan := load(t)
+		assert.EqualValues(t, 300, checkpoint.GetTimestamp())
+		assert.EqualValues(t, 300, vchan.GetSeekPosition().GetTimestamp())
+	})
+}
@@ -0,0 +1,209 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"math"
+	"sort"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// compactionCost is the estimated cost and benefit of a compaction plan.
+type compactionCost struct {
+	// bandwidth is the disk/network bandwidth in bytes per second required to read and write
+	// the segments of the plan within its timeout
+	bandwidth float64
+	// reclaim is the size in bytes expected to be reclaimed by the plan
+	reclaim float64
+}
+
+// estimateCompactionCost estimates the cost of the plan from its binlogs. The rows deleted by the deltalogs
+// are not written again, so the expected size of the output is the live part of the insert binlogs.
+func estimateCompactionCost(plan *datapb.CompactionPlan) compactionCost {
+	var readBytes, writeBytes, reclaim float64
+	for _, segment := range plan.GetSegmentBinlogs() {
+		var insertBytes, deltaBytes, rows, deletedRows int64
+		for i, fieldBinlog := range segment.GetFieldBinlogs() {
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				insertBytes += binlog.GetLogSize()
+				// all fields have the same rows, count the first one only
+				if i == 0 {
+					rows += binlog.GetEntriesNum()
+				}
+			}
+		}
+		for _, fieldBinlog := range segment.GetDeltalogs() {
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				deltaBytes += binlog.GetLogSize()
+				deletedRows += binlog.GetEntriesNum()
+			}
+		}
+		for _, fieldBinlog := range segment.GetField2StatslogPaths() {
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				readBytes += float64(binlog.GetLogSize())
+			}
+		}
+
+		deletedRatio := 0.0
+		if rows > 0 {
+			deletedRatio = math.Min(1, float64(deletedRows)/float64(rows))
+		}
+		readBytes += float64(insertBytes + deltaBytes)
+		writeBytes += float64(insertBytes) * (1 - deletedRatio)
+		reclaim += float64(insertBytes)*deletedRatio + float64(deltaBytes)
+	}
+
+	timeout := plan.GetTimeoutInSeconds()
+	if timeout <= 0 {
+		timeout = Params.DataCoordCfg.CompactionTimeoutInSeconds.GetAsInt32()
+	}
+	return compactionCost{
+		bandwidth: (readBytes + writeBytes) / float64(timeout),
+		reclaim:   reclaim,
+	}
+}
+
+type scheduledCompaction struct {
+	task *compactionTask
+	cost compactionCost
+}
+
+// compactionScheduler schedules the compaction tasks under a cluster-wide disk/network bandwidth budget.
+// Each running task reserves the bandwidth it requires until it finishes, and the pending tasks expected
+// to reclaim more space are scheduled first. Besides the budget, the tasks running on a DataNode
+// are limited by the worker parallelism of the DataNode.
+// The zero value is an empty scheduler ready to use.
+type compactionScheduler struct {
+	mu        sync.Mutex
+	pending   []*scheduledCompaction
+	running   map[int64]*scheduledCompaction // planID -> task
+	nodeTasks map[int64]int                  // nodeID -> number of running tasks
+	reserved  float64                        // the bandwidth reserved by the running tasks
+}
+
+func (s *compactionScheduler) budget() float64 {
+	return Params.DataCoordCfg.CompactionIOBandwidthBudget.GetAsFloat() * 1024 * 1024
+}
+
+// enqueue adds the task to the pending queue, which is ordered by the expected reclaim.
+func (s *compactionScheduler) enqueue(task *compactionTask) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := &scheduledCompaction{task: task, cost: estimateCompactionCost(task.plan)}
+	idx := sort.Search(len(s.pending), func(i int) bool {
+		return s.pending[i].cost.reclaim < item.cost.reclaim
+	})
+	s.pending = append(s.pending, nil)
+	copy(s.pending[idx+1:], s.pending[idx:])
+	s.pending[idx] = item
+}
+
+// remove removes the task from the pending queue, returns false if the task is not pending.
+func (s *compactionScheduler) remove(planID int64) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, item := range s.pending {
+		if item.task.plan.GetPlanID() == planID {
+			s.pending = append(s.pending[:i], s.pending[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// schedule picks the pending tasks to run and reserves the bandwidth for them.
+// The tasks are picked in order, and the picking stops once the budget is exhausted, so that a large task
+// reclaiming much space is not starved by the smaller ones behind it. A task is always allowed to run
+// if nothing is running, even if it requires more bandwidth than the budget.
+func (s *compactionScheduler) schedule() []*compactionTask {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.running == nil {
+		s.running = make(map[int64]*scheduledCompaction)
+		s.nodeTasks = make(map[int64]int)
+	}
+
+	budget := s.budget()
+	maxParallel := Params.DataCoordCfg.CompactionMaxParallelTasks.GetAsInt()
+	parallel := calculateParallel()
+	var picked []*compactionTask
+	remaining := s.pending[:0]
+	for i, item := range s.pending {
+		if len(s.running) >= maxParallel {
+			remaining = append(remaining, s.pending[i:]...)
+			break
+		}
+		nodeID := item.task.dataNodeID
+		if s.nodeTasks[nodeID] >= parallel {
+			remaining = append(remaining, item)
+			continue
+		}
+		if len(s.running) > 0 && s.reserved+item.cost.bandwidth > budget {
+			remaining = append(remaining, s.pending[i:]...)
+			break
+		}
+		s.running[item.task.plan.GetPlanID()] = item
+		s.nodeTasks[nodeID]++
+		s.reserved += item.cost.bandwidth
+		picked = append(picked, item.task)
+	}
+	s.pending = remaining
+
+	if len(picked) > 0 {
+		log.Info("schedule compaction tasks", zap.Int("picked", len(picked)), zap.Int("pending", len(s.pending)),
+			zap.Float64("reservedBandwidth", s.reserved), zap.Float64("budget", budget))
+	}
+	return picked
+}
+
+// release releases the bandwidth reserved by the task, it's a no-op if the task is not running.
+func (s *compactionScheduler) release(planID int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item, ok := s.running[planID]
+	if !ok {
+		return
+	}
+	delete(s.running, planID)
+	s.nodeTasks[item.task.dataNodeID]--
+	s.reserved -= item.cost.bandwidth
+	if len(s.running) == 0 {
+		// clear the accumulated float error
+		s.reserved = 0
+	}
+}
+
+// isFull returns true if the bandwidth required by the running and pending tasks exceeds the budget.
+func (s *compactionScheduler) isFull() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	required := s.reserved
+	for _, item := range s.pending {
+		required += item.cost.bandwidth
+	}
+	return required >= s.budget()
+}
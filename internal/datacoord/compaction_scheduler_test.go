@@ -0,0 +1,172 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+const mb = 1024 * 1024
+
+// newScheduledTask creates a task on the node, whose segment has insert binlogs of insertMB
+// and a deleted ratio of deletedRatio, the plan is expected to finish in 1 second.
+func newScheduledTask(planID, nodeID int64, insertMB int64, deletedRatio float64) *compactionTask {
+	return &compactionTask{
+		dataNodeID: nodeID,
+		plan: &datapb.CompactionPlan{
+			PlanID:           planID,
+			TimeoutInSeconds: 1,
+			SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{{
+				SegmentID: planID,
+				FieldBinlogs: []*datapb.FieldBinlog{{
+					Binlogs: []*datapb.Binlog{{LogSize: insertMB * mb, EntriesNum: 100}},
+				}},
+				Deltalogs: []*datapb.FieldBinlog{{
+					Binlogs: []*datapb.Binlog{{EntriesNum: int64(100 * deletedRatio)}},
+				}},
+			}},
+		},
+	}
+}
+
+func Test_estimateCompactionCost(t *testing.T) {
+	plan := &datapb.CompactionPlan{
+		TimeoutInSeconds: 10,
+		SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{
+			{
+				FieldBinlogs: []*datapb.FieldBinlog{
+					{FieldID: 100, Binlogs: []*datapb.Binlog{{LogSize: 60, EntriesNum: 100}, {LogSize: 20, EntriesNum: 100}}},
+					{FieldID: 101, Binlogs: []*datapb.Binlog{{LogSize: 10, EntriesNum: 100}, {LogSize: 10, EntriesNum: 100}}},
+				},
+				Field2StatslogPaths: []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{LogSize: 5}}}},
+				Deltalogs:           []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{LogSize: 5, EntriesNum: 50}}}},
+			},
+			{
+				FieldBinlogs: []*datapb.FieldBinlog{
+					{FieldID: 100, Binlogs: []*datapb.Binlog{{LogSize: 100, EntriesNum: 100}}},
+				},
+			},
+		},
+	}
+	cost := estimateCompactionCost(plan)
+	// read 100+5+5+100, write 100*0.75+100
+	assert.InDelta(t, (210.0+175.0)/10, cost.bandwidth, 1e-9)
+	// reclaim 100*0.25+5
+	assert.InDelta(t, 30.0, cost.reclaim, 1e-9)
+
+	// the deleted rows exceeding the rows
+	plan = &datapb.CompactionPlan{
+		TimeoutInSeconds: 1,
+		SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{{
+			FieldBinlogs: []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{LogSize: 100, EntriesNum: 10}}}},
+			Deltalogs:    []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{EntriesNum: 20}}}},
+		}},
+	}
+	cost = estimateCompactionCost(plan)
+	assert.InDelta(t, 100.0, cost.bandwidth, 1e-9)
+	assert.InDelta(t, 100.0, cost.reclaim, 1e-9)
+}
+
+func Test_compactionScheduler(t *testing.T) {
+	paramtable.Get().Save(Params.DataCoordCfg.CompactionIOBandwidthBudget.Key, "100")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.CompactionIOBandwidthBudget.Key)
+	paramtable.Get().Save(Params.DataCoordCfg.CompactionWorkerParalleTasks.Key, "2")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.CompactionWorkerParalleTasks.Key)
+
+	planIDs := func(tasks []*compactionTask) []int64 {
+		return lo.Map(tasks, func(t *compactionTask, _ int) int64 { return t.plan.GetPlanID() })
+	}
+
+	t.Run("prioritized by reclaim", func(t *testing.T) {
+		s := &compactionScheduler{}
+		// bandwidth 20, 20, 15, 10
+		s.enqueue(newScheduledTask(1, 1, 10, 0))
+		s.enqueue(newScheduledTask(2, 2, 10, 0))
+		s.enqueue(newScheduledTask(3, 3, 10, 0.5))
+		s.enqueue(newScheduledTask(4, 4, 10, 1))
+		assert.Equal(t, []int64{4, 3, 1, 2}, planIDs(s.schedule()))
+		assert.Empty(t, s.pending)
+		assert.InDelta(t, 65.0*mb, s.reserved, 1e-3)
+	})
+
+	t.Run("budget", func(t *testing.T) {
+		s := &compactionScheduler{}
+		// bandwidth 80, 60, 20
+		s.enqueue(newScheduledTask(1, 1, 40, 0))
+		s.enqueue(newScheduledTask(2, 2, 40, 0.5))
+		s.enqueue(newScheduledTask(3, 3, 10, 0))
+		assert.True(t, s.isFull())
+
+		// plan 3 fits the budget, but it's not picked before plan 1
+		assert.Equal(t, []int64{2}, planIDs(s.schedule()))
+		assert.Equal(t, []int64{1, 3}, planIDs(lo.Map(s.pending, func(item *scheduledCompaction, _ int) *compactionTask { return item.task })))
+
+		s.release(2)
+		assert.Equal(t, []int64{1, 3}, planIDs(s.schedule()))
+		s.release(1)
+		s.release(1)
+		s.release(3)
+		assert.Zero(t, s.reserved)
+		assert.False(t, s.isFull())
+
+		// a task exceeding the budget is scheduled if nothing is running
+		s.enqueue(newScheduledTask(4, 1, 100, 0))
+		assert.Equal(t, []int64{4}, planIDs(s.schedule()))
+	})
+
+	t.Run("node parallel", func(t *testing.T) {
+		s := &compactionScheduler{}
+		s.enqueue(newScheduledTask(1, 1, 1, 0.3))
+		s.enqueue(newScheduledTask(2, 1, 1, 0.2))
+		s.enqueue(newScheduledTask(3, 1, 1, 0.1))
+		s.enqueue(newScheduledTask(4, 2, 1, 0))
+		assert.Equal(t, []int64{1, 2, 4}, planIDs(s.schedule()))
+		assert.Empty(t, s.schedule())
+
+		s.release(1)
+		assert.Equal(t, []int64{3}, planIDs(s.schedule()))
+	})
+
+	t.Run("max parallel", func(t *testing.T) {
+		paramtable.Get().Save(Params.DataCoordCfg.CompactionMaxParallelTasks.Key, "2")
+		defer paramtable.Get().Reset(Params.DataCoordCfg.CompactionMaxParallelTasks.Key)
+
+		s := &compactionScheduler{}
+		s.enqueue(newScheduledTask(1, 1, 1, 0.3))
+		s.enqueue(newScheduledTask(2, 2, 1, 0.2))
+		s.enqueue(newScheduledTask(3, 3, 1, 0.1))
+		assert.Equal(t, []int64{1, 2}, planIDs(s.schedule()))
+		assert.Empty(t, s.schedule())
+
+		s.release(2)
+		assert.Equal(t, []int64{3}, planIDs(s.schedule()))
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		s := &compactionScheduler{}
+		s.enqueue(newScheduledTask(1, 1, 1, 0))
+		assert.False(t, s.remove(2))
+		assert.True(t, s.remove(1))
+		assert.Empty(t, s.schedule())
+	})
+}
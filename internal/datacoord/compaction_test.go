@@ -24,6 +24,7 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -33,7 +34,9 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
 	"github.com/milvus-io/milvus/internal/mocks"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metautil"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
@@ -165,11 +168,10 @@ func Test_compactionPlanHandler_execCompactionPlan(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			c := &compactionPlanHandler{
-				plans:      tt.fields.plans,
-				sessions:   tt.fields.sessions,
-				chManager:  tt.fields.chManager,
-				parallelCh: make(map[int64]chan struct{}),
-				allocator:  tt.fields.allocatorFactory(),
+				plans:     tt.fields.plans,
+				sessions:  tt.fields.sessions,
+				chManager: tt.fields.chManager,
+				allocator: tt.fields.allocatorFactory(),
 			}
 			Params.Save(Params.DataCoordCfg.CompactionCheckIntervalInSeconds.Key, "1")
 			c.start()
@@ -187,7 +189,9 @@ func Test_compactionPlanHandler_execCompactionPlan(t *testing.T) {
 						func() bool {
 							c.mu.RLock()
 							defer c.mu.RUnlock()
-							return c.executingTaskNum == 0 && len(c.parallelCh[1]) == 0
+							c.scheduler.mu.Lock()
+							defer c.scheduler.mu.Unlock()
+							return c.executingTaskNum == 0 && len(c.scheduler.running) == 0
 						},
 						5*time.Second, 100*time.Millisecond)
 				}
@@ -202,6 +206,8 @@ func Test_compactionPlanHandler_execWithParallels(t *testing.T) {
 	mockDataNode := &mocks.MockDataNode{}
 	paramtable.Get().Save(Params.DataCoordCfg.CompactionCheckIntervalInSeconds.Key, "1")
 	defer paramtable.Get().Reset(Params.DataCoordCfg.CompactionCheckIntervalInSeconds.Key)
+	paramtable.Get().Save(Params.DataCoordCfg.CompactionWorkerParalleTasks.Key, "2")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.CompactionWorkerParalleTasks.Key)
 	c := &compactionPlanHandler{
 		plans: map[int64]*compactionTask{},
 		sessions: &SessionManager{
@@ -221,8 +227,7 @@ func Test_compactionPlanHandler_execWithParallels(t *testing.T) {
 				},
 			},
 		},
-		parallelCh: make(map[int64]chan struct{}),
-		allocator:  newMockAllocator(),
+		allocator: newMockAllocator(),
 	}
 
 	signal := &compactionSignal{id: 100}
@@ -230,8 +235,6 @@ func Test_compactionPlanHandler_execWithParallels(t *testing.T) {
 	plan2 := &datapb.CompactionPlan{PlanID: 2, Channel: "ch1", Type: datapb.CompactionType_MergeCompaction}
 	plan3 := &datapb.CompactionPlan{PlanID: 3, Channel: "ch1", Type: datapb.CompactionType_MergeCompaction}
 
-	c.parallelCh[1] = make(chan struct{}, 2)
-
 	var mut sync.RWMutex
 	called := 0
 
@@ -240,16 +243,22 @@ func Test_compactionPlanHandler_execWithParallels(t *testing.T) {
 		defer mut.Unlock()
 		called++
 	}).Return(&commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil).Times(3)
-	go func() {
-		c.execCompactionPlan(signal, plan1)
-		c.execCompactionPlan(signal, plan2)
-		c.execCompactionPlan(signal, plan3)
-	}()
+	c.execCompactionPlan(signal, plan1)
+	c.execCompactionPlan(signal, plan2)
+	c.execCompactionPlan(signal, plan3)
 
-	// wait for dispatch signal
-	<-c.parallelCh[1]
-	<-c.parallelCh[1]
-	<-c.parallelCh[1]
+	// only 2 plans are executed on the DataNode in parallel
+	assert.Eventually(t, func() bool {
+		mut.RLock()
+		defer mut.RUnlock()
+		return called == 2
+	}, time.Second, time.Millisecond*10)
+	c.scheduler.mu.Lock()
+	assert.Equal(t, 1, len(c.scheduler.pending))
+	c.scheduler.mu.Unlock()
+
+	// the pending plan is executed once a plan finishes
+	c.releaseBandwidth(plan1.GetPlanID())
 
 	// wait for compaction called
 	assert.Eventually(t, func() bool {
@@ -349,28 +358,34 @@ func TestCompactionPlanHandler_handleMergeCompactionResult(t *testing.T) {
 	metakv.EXPECT().HasPrefix(mock.Anything).Return(false, nil).Maybe()
 	errMeta := &meta{
 		catalog: &datacoord.Catalog{MetaKv: metakv},
-		segments: &SegmentsInfo{
-			map[int64]*SegmentInfo{
-				seg1.ID: {SegmentInfo: seg1},
-				seg2.ID: {SegmentInfo: seg2},
-			},
-		},
+		segments: buildSegmentsInfo(map[int64]*SegmentInfo{
+			seg1.ID: {SegmentInfo: seg1},
+			seg2.ID: {SegmentInfo: seg2},
+		}),
 	}
 
 	meta := &meta{
 		catalog: &datacoord.Catalog{MetaKv: NewMetaMemoryKV()},
-		segments: &SegmentsInfo{
-			map[int64]*SegmentInfo{
-				seg1.ID: {SegmentInfo: seg1},
-				seg2.ID: {SegmentInfo: seg2},
-			},
-		},
+		segments: buildSegmentsInfo(map[int64]*SegmentInfo{
+			seg1.ID: {SegmentInfo: seg1},
+			seg2.ID: {SegmentInfo: seg2},
+		}),
 	}
 
+	// only the compacted segment with rows is notified
+	queryCoord := mocks.NewMockQueryCoord(t)
+	queryCoord.EXPECT().NotifyCompaction(mock.Anything, mock.Anything).
+		Run(func(ctx context.Context, req *querypb.NotifyCompactionRequest) {
+			assert.EqualValues(t, 3, req.GetCompactedTo())
+			assert.ElementsMatch(t, []UniqueID{1, 2}, req.GetCompactedFrom())
+		}).
+		Return(merr.Status(nil), nil).Once()
+
 	c := &compactionPlanHandler{
-		plans:    plans,
-		sessions: sessions,
-		meta:     meta,
+		plans:      plans,
+		sessions:   sessions,
+		meta:       meta,
+		queryCoord: queryCoord,
 	}
 
 	c2 := &compactionPlanHandler{
@@ -422,6 +437,7 @@ func TestCompactionPlanHandler_handleMergeCompactionResult(t *testing.T) {
 	call = mockDataNode.EXPECT().SyncSegments(mock.Anything, mock.Anything).Run(func(ctx context.Context, req *datapb.SyncSegmentsRequest) {}).Return(&commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError}, nil)
 	err = c.handleMergeCompactionResult(plan, compactionResult2)
 	assert.Error(t, err)
+	c.wg.Wait()
 }
 
 func TestCompactionPlanHandler_completeCompaction(t *testing.T) {
@@ -500,12 +516,10 @@ func TestCompactionPlanHandler_completeCompaction(t *testing.T) {
 
 		meta := &meta{
 			catalog: &datacoord.Catalog{MetaKv: NewMetaMemoryKV()},
-			segments: &SegmentsInfo{
-				map[int64]*SegmentInfo{
-					seg1.ID: {SegmentInfo: seg1},
-					seg2.ID: {SegmentInfo: seg2},
-				},
-			},
+			segments: buildSegmentsInfo(map[int64]*SegmentInfo{
+				seg1.ID: {SegmentInfo: seg1},
+				seg2.ID: {SegmentInfo: seg2},
+			}),
 		}
 		compactionResult := datapb.CompactionResult{
 			PlanID:              1,
@@ -592,12 +606,10 @@ func TestCompactionPlanHandler_completeCompaction(t *testing.T) {
 
 		meta := &meta{
 			catalog: &datacoord.Catalog{MetaKv: NewMetaMemoryKV()},
-			segments: &SegmentsInfo{
-				map[int64]*SegmentInfo{
-					seg1.ID: {SegmentInfo: seg1},
-					seg2.ID: {SegmentInfo: seg2},
-				},
-			},
+			segments: buildSegmentsInfo(map[int64]*SegmentInfo{
+				seg1.ID: {SegmentInfo: seg1},
+				seg2.ID: {SegmentInfo: seg2},
+			}),
 		}
 
 		meta.AddSegment(NewSegmentInfo(seg1))
@@ -749,11 +761,9 @@ func Test_compactionPlanHandler_updateCompaction(t *testing.T) {
 					},
 				},
 				meta: &meta{
-					segments: &SegmentsInfo{
-						map[int64]*SegmentInfo{
-							1: {SegmentInfo: &datapb.SegmentInfo{ID: 1}},
-						},
-					},
+					segments: buildSegmentsInfo(map[int64]*SegmentInfo{
+						1: {SegmentInfo: &datapb.SegmentInfo{ID: 1}},
+					}),
 				},
 				sessions: &SessionManager{
 					sessions: struct {
@@ -788,6 +798,10 @@ func Test_compactionPlanHandler_updateCompaction(t *testing.T) {
 				sessions: tt.fields.sessions,
 				meta:     tt.fields.meta,
 			}
+			for _, id := range append(tt.timeout, tt.failed...) {
+				c.scheduler.enqueue(c.plans[id])
+			}
+			assert.Len(t, c.scheduler.schedule(), len(tt.timeout)+len(tt.failed))
 
 			err := c.updateCompaction(tt.args.ts)
 			assert.Equal(t, tt.wantErr, err != nil)
@@ -795,12 +809,15 @@ func Test_compactionPlanHandler_updateCompaction(t *testing.T) {
 			for _, id := range tt.timeout {
 				task := c.getCompaction(id)
 				assert.Equal(t, timeout, task.state)
+				assert.NotContains(t, c.scheduler.running, id)
 			}
 
 			for _, id := range tt.failed {
 				task := c.getCompaction(id)
 				assert.Equal(t, failed, task.state)
+				assert.NotContains(t, c.scheduler.running, id)
 			}
+			assert.Zero(t, c.scheduler.reserved)
 
 			for _, id := range tt.unexpired {
 				task := c.getCompaction(id)
@@ -833,13 +850,12 @@ func Test_newCompactionPlanHandler(t *testing.T) {
 				nil,
 			},
 			&compactionPlanHandler{
-				plans:      map[int64]*compactionTask{},
-				sessions:   &SessionManager{},
-				chManager:  &ChannelManager{},
-				meta:       &meta{},
-				allocator:  newMockAllocator(),
-				flushCh:    nil,
-				parallelCh: make(map[int64]chan struct{}),
+				plans:     map[int64]*compactionTask{},
+				sessions:  &SessionManager{},
+				chManager: &ChannelManager{},
+				meta:      &meta{},
+				allocator: newMockAllocator(),
+				flushCh:   nil,
 			},
 		},
 	}
@@ -911,6 +927,87 @@ func Test_getCompactionTasksBySignalID(t *testing.T) {
 	}
 }
 
+func Test_compactionPlanHandler_cancelCompaction(t *testing.T) {
+	nodeCli := &mockDataNodeClient{}
+	c := &compactionPlanHandler{
+		plans: map[int64]*compactionTask{
+			1: {
+				triggerInfo: &compactionSignal{id: 1},
+				state:       executing,
+				dataNodeID:  1,
+				plan: &datapb.CompactionPlan{
+					PlanID:         1,
+					SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{{SegmentID: 1}},
+				},
+			},
+			2: {
+				triggerInfo: &compactionSignal{id: 1},
+				state:       pipelining,
+				dataNodeID:  1,
+				plan: &datapb.CompactionPlan{
+					PlanID:         2,
+					SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{{SegmentID: 2}},
+				},
+			},
+			3: {
+				triggerInfo: &compactionSignal{id: 1},
+				state:       completed,
+				dataNodeID:  1,
+				plan:        &datapb.CompactionPlan{PlanID: 3},
+			},
+			4: {
+				triggerInfo: &compactionSignal{id: 2},
+				state:       executing,
+				dataNodeID:  1,
+				plan:        &datapb.CompactionPlan{PlanID: 4},
+			},
+		},
+		meta: &meta{
+			segments: buildSegmentsInfo(map[int64]*SegmentInfo{
+				1: {SegmentInfo: &datapb.SegmentInfo{ID: 1}, isCompacting: true},
+				2: {SegmentInfo: &datapb.SegmentInfo{ID: 2}, isCompacting: true},
+			}),
+		},
+		sessions: &SessionManager{
+			sessions: struct {
+				sync.RWMutex
+				data map[int64]*Session
+			}{
+				data: map[int64]*Session{
+					1: {client: nodeCli},
+				},
+			},
+		},
+		executingTaskNum: 3,
+	}
+	// plans 1 and 4 are executing, plan 2 is pending since the DataNode is full
+	paramtable.Get().Save(Params.DataCoordCfg.CompactionWorkerParalleTasks.Key, "2")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.CompactionWorkerParalleTasks.Key)
+	c.scheduler.enqueue(c.plans[1])
+	c.scheduler.enqueue(c.plans[4])
+	assert.Equal(t, 2, len(c.scheduler.schedule()))
+	c.scheduler.enqueue(c.plans[2])
+	assert.Empty(t, c.scheduler.schedule())
+
+	tasks := c.cancelCompaction(1)
+	assert.ElementsMatch(t, []int64{1, 2}, lo.Map(tasks, func(t *compactionTask, _ int) int64 { return t.plan.GetPlanID() }))
+	assert.Equal(t, cancelled, c.getCompaction(1).state)
+	assert.Equal(t, cancelled, c.getCompaction(2).state)
+	assert.Equal(t, completed, c.getCompaction(3).state)
+	assert.Equal(t, executing, c.getCompaction(4).state)
+	assert.Equal(t, 1, c.executingTaskNum)
+	// the pending plan is removed from the queue
+	assert.Equal(t, 1, len(c.scheduler.running))
+	assert.Empty(t, c.scheduler.pending)
+	assert.ElementsMatch(t, []int64{1}, nodeCli.cancelledPlans)
+	assert.False(t, c.meta.GetSegment(1).isCompacting)
+	assert.False(t, c.meta.GetSegment(2).isCompacting)
+
+	// the cancelled plans are not cancelled again
+	assert.Empty(t, c.cancelCompaction(1))
+	assert.Equal(t, 1, c.executingTaskNum)
+}
+
 func getFieldBinlogPaths(id int64, paths ...string) *datapb.FieldBinlog {
 	l := &datapb.FieldBinlog{
 		FieldID: id,
@@ -30,6 +30,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/featureflag"
 	"github.com/milvus-io/milvus/pkg/util/indexparamcheck"
 	"github.com/milvus-io/milvus/pkg/util/logutil"
 )
@@ -38,6 +39,8 @@ type compactTime struct {
 	travelTime    Timestamp
 	expireTime    Timestamp
 	collectionTTL time.Duration
+	// segments encrypted with an older key version are rewritten with the current one
+	encryptionKeyVersion int64
 }
 
 type trigger interface {
@@ -49,6 +52,8 @@ type trigger interface {
 	triggerSingleCompaction(collectionID, partitionID, segmentID int64, channel string) error
 	// forceTriggerCompaction force to start a compaction
 	forceTriggerCompaction(collectionID int64) (UniqueID, error)
+	// forceTriggerCompactionWithMode force to start a compaction on the segments selected by the mode
+	forceTriggerCompactionWithMode(collectionID int64, mode datapb.CompactionMode) (UniqueID, error)
 }
 
 type compactionSignal struct {
@@ -59,6 +64,8 @@ type compactionSignal struct {
 	partitionID  UniqueID
 	segmentID    UniqueID
 	channel      string
+	// mode selects the segments to compact for a forced global signal
+	mode datapb.CompactionMode
 }
 
 var _ trigger = (*compactionTrigger)(nil)
@@ -77,6 +84,7 @@ type compactionTrigger struct {
 	//indexCoord                   types.IndexCoord
 	estimateNonDiskSegmentPolicy calUpperLimitPolicy
 	estimateDiskSegmentPolicy    calUpperLimitPolicy
+	maintenance                  *maintenanceManager
 	// A sloopy hack, so we can test with different segment row count without worrying that
 	// they are re-calculated in every compaction.
 	testingOnly bool
@@ -89,6 +97,7 @@ func newCompactionTrigger(
 	//segRefer *SegmentReferenceManager,
 	//indexCoord types.IndexCoord,
 	handler Handler,
+	maintenance *maintenanceManager,
 ) *compactionTrigger {
 	return &compactionTrigger{
 		meta:              meta,
@@ -100,6 +109,7 @@ func newCompactionTrigger(
 		estimateDiskSegmentPolicy:    calBySchemaPolicyWithDiskIndex,
 		estimateNonDiskSegmentPolicy: calBySchemaPolicy,
 		handler:                      handler,
+		maintenance:                  maintenance,
 	}
 }
 
@@ -198,6 +208,8 @@ func (t *compactionTrigger) getCompactTime(ts Timestamp, coll *collectionInfo) (
 		return nil, err
 	}
 
+	keyVersion := t.meta.GetEncryptionKeyVersion(coll.ID)
+
 	pts, _ := tsoutil.ParseTS(ts)
 	ttRetention := pts.Add(Params.CommonCfg.RetentionDuration.GetAsDuration(time.Second) * -1)
 	ttRetentionLogic := tsoutil.ComposeTS(ttRetention.UnixNano()/int64(time.Millisecond), 0)
@@ -205,11 +217,11 @@ func (t *compactionTrigger) getCompactTime(ts Timestamp, coll *collectionInfo) (
 	if collectionTTL > 0 {
 		ttexpired := pts.Add(-collectionTTL)
 		ttexpiredLogic := tsoutil.ComposeTS(ttexpired.UnixNano()/int64(time.Millisecond), 0)
-		return &compactTime{ttRetentionLogic, ttexpiredLogic, collectionTTL}, nil
+		return &compactTime{ttRetentionLogic, ttexpiredLogic, collectionTTL, keyVersion}, nil
 	}
 
 	// no expiration time
-	return &compactTime{ttRetentionLogic, 0, 0}, nil
+	return &compactTime{ttRetentionLogic, 0, 0, keyVersion}, nil
 }
 
 // triggerCompaction trigger a compaction if any compaction condition satisfy.
@@ -255,6 +267,12 @@ func (t *compactionTrigger) triggerSingleCompaction(collectionID, partitionID, s
 // forceTriggerCompaction force to start a compaction
 // invoked by user `ManualCompaction` operation
 func (t *compactionTrigger) forceTriggerCompaction(collectionID int64) (UniqueID, error) {
+	return t.forceTriggerCompactionWithMode(collectionID, datapb.CompactionMode_FullCompaction)
+}
+
+// forceTriggerCompactionWithMode force to start a compaction on the segments selected by the mode
+// invoked by user `ManualCompactionWithMode` operation
+func (t *compactionTrigger) forceTriggerCompactionWithMode(collectionID int64, mode datapb.CompactionMode) (UniqueID, error) {
 	id, err := t.allocSignalID()
 	if err != nil {
 		return -1, err
@@ -264,6 +282,7 @@ func (t *compactionTrigger) forceTriggerCompaction(collectionID int64) (UniqueID
 		isForce:      true,
 		isGlobal:     true,
 		collectionID: collectionID,
+		mode:         mode,
 	}
 	t.handleGlobalSignal(signal)
 	return id, nil
@@ -344,6 +363,7 @@ func (t *compactionTrigger) handleGlobalSignal(signal *compactionSignal) {
 		return (signal.collectionID == 0 || segment.CollectionID == signal.collectionID) &&
 			isSegmentHealthy(segment) &&
 			isFlush(segment) &&
+			segment.GetLevel() != datapb.SegmentLevel_L0 && // L0 segments are merged by the L0 compaction
 			!segment.isCompacting && // not compacting now
 			!segment.GetIsImporting() // not importing now
 	}) // m is list of chanPartSegments, which is channel-partition organized segments
@@ -393,6 +413,13 @@ func (t *compactionTrigger) handleGlobalSignal(signal *compactionSignal) {
 			return
 		}
 
+		if !t.maintenance.CompactionAllowed(group.collectionID, signal.isForce) {
+			log.RatedInfo(20, "collection compaction paused or out of schedule",
+				zap.Int64("collectionID", group.collectionID),
+			)
+			continue
+		}
+
 		ct, err := t.getCompactTime(ts, coll)
 		if err != nil {
 			log.Warn("get compact time failed, skip to handle compaction",
@@ -402,7 +429,17 @@ func (t *compactionTrigger) handleGlobalSignal(signal *compactionSignal) {
 			return
 		}
 
-		plans := t.generatePlans(group.segments, signal.isForce, isDiskIndex, ct)
+		var plans []*datapb.CompactionPlan
+		switch signal.mode {
+		case datapb.CompactionMode_MergeSmallSegments:
+			plans = t.generatePlans(lo.Filter(group.segments, func(segment *SegmentInfo, _ int) bool {
+				return t.isSmallSegment(segment)
+			}), false, isDiskIndex, ct)
+		case datapb.CompactionMode_PurgeDeletes:
+			plans = t.generatePurgePlans(group.segments, ct)
+		default:
+			plans = t.generatePlans(group.segments, signal.isForce, isDiskIndex, ct)
+		}
 		for _, plan := range plans {
 			segIDs := fetchSegIDs(plan.GetSegmentBinlogs())
 
@@ -503,6 +540,13 @@ func (t *compactionTrigger) handleSignal(signal *compactionSignal) {
 		return
 	}
 
+	if !t.maintenance.CompactionAllowed(collectionID, signal.isForce) {
+		log.RatedInfo(20, "collection compaction paused or out of schedule",
+			zap.Int64("collectionID", collectionID),
+		)
+		return
+	}
+
 	ct, err := t.getCompactTime(ts, coll)
 	if err != nil {
 		log.Warn("get compact time failed, skip to handle compaction", zap.Int64("collectionID", segment.GetCollectionID()),
@@ -559,10 +603,11 @@ func (t *compactionTrigger) generatePlans(segments []*SegmentInfo, force bool, i
 		}
 	}
 	var plans []*datapb.CompactionPlan
-	// sort segment from large to small
+	// sort segment from high score to low, segments with more deletes go before the ones of the same size
 	sort.Slice(prioritizedCandidates, func(i, j int) bool {
-		if prioritizedCandidates[i].GetNumOfRows() != prioritizedCandidates[j].GetNumOfRows() {
-			return prioritizedCandidates[i].GetNumOfRows() > prioritizedCandidates[j].GetNumOfRows()
+		scoreI, scoreJ := compactionScore(prioritizedCandidates[i]), compactionScore(prioritizedCandidates[j])
+		if scoreI != scoreJ {
+			return scoreI > scoreJ
 		}
 		return prioritizedCandidates[i].GetID() < prioritizedCandidates[j].GetID()
 	})
@@ -718,12 +763,46 @@ func (t *compactionTrigger) generatePlans(segments []*SegmentInfo, force bool, i
 	return plans
 }
 
+// generatePurgePlans generates a single compaction plan for each segment with deltalogs,
+// which rewrites the segment without the deleted entities.
+func (t *compactionTrigger) generatePurgePlans(segments []*SegmentInfo, compactTime *compactTime) []*datapb.CompactionPlan {
+	var plans []*datapb.CompactionPlan
+	for _, segment := range segments {
+		var deltaLog int
+		for _, deltaLogs := range segment.GetDeltalogs() {
+			deltaLog += len(deltaLogs.GetBinlogs())
+		}
+		if deltaLog == 0 {
+			continue
+		}
+		plan := segmentsToPlan([]*SegmentInfo{segment.ShadowClone()}, compactTime)
+		log.Info("generate a plan to purge deletes", zap.Int64("segmentID", segment.GetID()), zap.Int("delta logs", deltaLog))
+		plans = append(plans, plan)
+	}
+	return plans
+}
+
+// deleteRatioScoreFlag gates weighting the compaction candidates by the deleted-row ratio.
+var deleteRatioScoreFlag = featureflag.Register("compactionDeleteRatioScore",
+	"Prioritize the compaction candidates with more deleted rows", true)
+
+// compactionScore scores a compaction candidate by its rows weighted by the deleted-row ratio.
+func compactionScore(segment *SegmentInfo) float64 {
+	if !deleteRatioScoreFlag.IsEnabledForCollection(segment.GetCollectionID()) {
+		return float64(segment.GetNumOfRows())
+	}
+	weight := Params.DataCoordCfg.SingleCompactionDeleteRatioWeight.GetAsFloat()
+	return float64(segment.GetNumOfRows()) * (1 + weight*segment.getDeletedRowRatio())
+}
+
 func segmentsToPlan(segments []*SegmentInfo, compactTime *compactTime) *datapb.CompactionPlan {
 	plan := &datapb.CompactionPlan{
 		Timetravel:    compactTime.travelTime,
 		Type:          datapb.CompactionType_MixCompaction,
 		Channel:       segments[0].GetInsertChannel(),
 		CollectionTtl: compactTime.collectionTTL.Nanoseconds(),
+		// the compacted segment is re-encrypted with the current key
+		EncryptionKeyVersion: compactTime.encryptionKeyVersion,
 	}
 
 	for _, s := range segments {
@@ -781,6 +860,7 @@ func (t *compactionTrigger) getCandidateSegments(channel string, partitionID Uni
 	for _, s := range segments {
 		if !isSegmentHealthy(s) ||
 			!isFlush(s) ||
+			s.GetLevel() == datapb.SegmentLevel_L0 ||
 			s.GetInsertChannel() != channel ||
 			s.GetPartitionID() != partitionID ||
 			s.isCompacting ||
@@ -858,6 +938,13 @@ func (t *compactionTrigger) ShouldDoSingleCompaction(segment *SegmentInfo, isDis
 		}
 	}
 
+	// re-encrypt the segment lazily after the key of collection is rotated
+	if segment.GetEncryptionKeyVersion() < compactTime.encryptionKeyVersion {
+		log.Info("segment is encrypted with an outdated key, trigger compaction", zap.Int64("segmentID", segment.ID),
+			zap.Int64("keyVersion", segment.GetEncryptionKeyVersion()), zap.Int64("currentKeyVersion", compactTime.encryptionKeyVersion))
+		return true
+	}
+
 	var deltaLog int
 	for _, deltaLogs := range segment.GetDeltalogs() {
 		deltaLog += len(deltaLogs.GetBinlogs())
@@ -23,6 +23,7 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -70,6 +71,10 @@ func (h *spyCompactionHandler) getCompactionTasksBySignalID(signalID int64) []*c
 	panic("not implemented") // TODO: Implement
 }
 
+func (h *spyCompactionHandler) cancelCompaction(signalID int64) []*compactionTask {
+	panic("not implemented") // TODO: Implement
+}
+
 func (h *spyCompactionHandler) start() {}
 
 func (h *spyCompactionHandler) stop() {}
@@ -106,131 +111,129 @@ func Test_compactionTrigger_force(t *testing.T) {
 			"test force compaction",
 			fields{
 				&meta{
-					segments: &SegmentsInfo{
-						map[int64]*SegmentInfo{
-							1: {
-								SegmentInfo: &datapb.SegmentInfo{
-									ID:             1,
-									CollectionID:   2,
-									PartitionID:    1,
-									LastExpireTime: 100,
-									NumOfRows:      100,
-									MaxRowNum:      300,
-									InsertChannel:  "ch1",
-									State:          commonpb.SegmentState_Flushed,
-									Binlogs: []*datapb.FieldBinlog{
-										{
-											Binlogs: []*datapb.Binlog{
-												{EntriesNum: 5, LogPath: "log1"},
-											},
+					segments: buildSegmentsInfo(map[int64]*SegmentInfo{
+						1: {
+							SegmentInfo: &datapb.SegmentInfo{
+								ID:             1,
+								CollectionID:   2,
+								PartitionID:    1,
+								LastExpireTime: 100,
+								NumOfRows:      100,
+								MaxRowNum:      300,
+								InsertChannel:  "ch1",
+								State:          commonpb.SegmentState_Flushed,
+								Binlogs: []*datapb.FieldBinlog{
+									{
+										Binlogs: []*datapb.Binlog{
+											{EntriesNum: 5, LogPath: "log1"},
 										},
 									},
-									Deltalogs: []*datapb.FieldBinlog{
-										{
-											Binlogs: []*datapb.Binlog{
-												{EntriesNum: 5, LogPath: "deltalog1"},
-											},
+								},
+								Deltalogs: []*datapb.FieldBinlog{
+									{
+										Binlogs: []*datapb.Binlog{
+											{EntriesNum: 5, LogPath: "deltalog1"},
 										},
 									},
 								},
-								segmentIndexes: map[UniqueID]*model.SegmentIndex{
-									indexID: {
-										SegmentID:     1,
-										CollectionID:  2,
-										PartitionID:   1,
-										NumRows:       100,
-										IndexID:       indexID,
-										BuildID:       1,
-										NodeID:        0,
-										IndexVersion:  1,
-										IndexState:    commonpb.IndexState_Finished,
-										FailReason:    "",
-										IsDeleted:     false,
-										CreateTime:    0,
-										IndexFileKeys: nil,
-										IndexSize:     0,
-										WriteHandoff:  false,
-									},
+							},
+							segmentIndexes: map[UniqueID]*model.SegmentIndex{
+								indexID: {
+									SegmentID:     1,
+									CollectionID:  2,
+									PartitionID:   1,
+									NumRows:       100,
+									IndexID:       indexID,
+									BuildID:       1,
+									NodeID:        0,
+									IndexVersion:  1,
+									IndexState:    commonpb.IndexState_Finished,
+									FailReason:    "",
+									IsDeleted:     false,
+									CreateTime:    0,
+									IndexFileKeys: nil,
+									IndexSize:     0,
+									WriteHandoff:  false,
 								},
 							},
-							2: {
-								SegmentInfo: &datapb.SegmentInfo{
-									ID:             2,
-									CollectionID:   2,
-									PartitionID:    1,
-									LastExpireTime: 100,
-									NumOfRows:      100,
-									MaxRowNum:      300,
-									InsertChannel:  "ch1",
-									State:          commonpb.SegmentState_Flushed,
-									Binlogs: []*datapb.FieldBinlog{
-										{
-											Binlogs: []*datapb.Binlog{
-												{EntriesNum: 5, LogPath: "log2"},
-											},
-										},
-									},
-									Deltalogs: []*datapb.FieldBinlog{
-										{
-											Binlogs: []*datapb.Binlog{
-												{EntriesNum: 5, LogPath: "deltalog2"},
-											},
+						},
+						2: {
+							SegmentInfo: &datapb.SegmentInfo{
+								ID:             2,
+								CollectionID:   2,
+								PartitionID:    1,
+								LastExpireTime: 100,
+								NumOfRows:      100,
+								MaxRowNum:      300,
+								InsertChannel:  "ch1",
+								State:          commonpb.SegmentState_Flushed,
+								Binlogs: []*datapb.FieldBinlog{
+									{
+										Binlogs: []*datapb.Binlog{
+											{EntriesNum: 5, LogPath: "log2"},
 										},
 									},
 								},
-								segmentIndexes: map[UniqueID]*model.SegmentIndex{
-									indexID: {
-										SegmentID:     2,
-										CollectionID:  2,
-										PartitionID:   1,
-										NumRows:       100,
-										IndexID:       indexID,
-										BuildID:       2,
-										NodeID:        0,
-										IndexVersion:  1,
-										IndexState:    commonpb.IndexState_Finished,
-										FailReason:    "",
-										IsDeleted:     false,
-										CreateTime:    0,
-										IndexFileKeys: nil,
-										IndexSize:     0,
-										WriteHandoff:  false,
+								Deltalogs: []*datapb.FieldBinlog{
+									{
+										Binlogs: []*datapb.Binlog{
+											{EntriesNum: 5, LogPath: "deltalog2"},
+										},
 									},
 								},
 							},
-							3: {
-								SegmentInfo: &datapb.SegmentInfo{
-									ID:             3,
-									CollectionID:   1111,
-									PartitionID:    1,
-									LastExpireTime: 100,
-									NumOfRows:      100,
-									MaxRowNum:      300,
-									InsertChannel:  "ch1",
-									State:          commonpb.SegmentState_Flushed,
+							segmentIndexes: map[UniqueID]*model.SegmentIndex{
+								indexID: {
+									SegmentID:     2,
+									CollectionID:  2,
+									PartitionID:   1,
+									NumRows:       100,
+									IndexID:       indexID,
+									BuildID:       2,
+									NodeID:        0,
+									IndexVersion:  1,
+									IndexState:    commonpb.IndexState_Finished,
+									FailReason:    "",
+									IsDeleted:     false,
+									CreateTime:    0,
+									IndexFileKeys: nil,
+									IndexSize:     0,
+									WriteHandoff:  false,
 								},
-								segmentIndexes: map[UniqueID]*model.SegmentIndex{
-									indexID: {
-										SegmentID:     3,
-										CollectionID:  1111,
-										PartitionID:   1,
-										NumRows:       100,
-										IndexID:       indexID,
-										BuildID:       3,
-										NodeID:        0,
-										IndexVersion:  1,
-										IndexState:    commonpb.IndexState_Finished,
-										FailReason:    "",
-										IsDeleted:     false,
-										CreateTime:    0,
-										IndexFileKeys: nil,
-										IndexSize:     0,
-										WriteHandoff:  false,
-									},
+							},
+						},
+						3: {
+							SegmentInfo: &datapb.SegmentInfo{
+								ID:             3,
+								CollectionID:   1111,
+								PartitionID:    1,
+								LastExpireTime: 100,
+								NumOfRows:      100,
+								MaxRowNum:      300,
+								InsertChannel:  "ch1",
+								State:          commonpb.SegmentState_Flushed,
+							},
+							segmentIndexes: map[UniqueID]*model.SegmentIndex{
+								indexID: {
+									SegmentID:     3,
+									CollectionID:  1111,
+									PartitionID:   1,
+									NumRows:       100,
+									IndexID:       indexID,
+									BuildID:       3,
+									NodeID:        0,
+									IndexVersion:  1,
+									IndexState:    commonpb.IndexState_Finished,
+									FailReason:    "",
+									IsDeleted:     false,
+									CreateTime:    0,
+									IndexFileKeys: nil,
+									IndexSize:     0,
+									WriteHandoff:  false,
 								},
 							},
 						},
-					},
+					}),
 					collections: map[int64]*collectionInfo{
 						2: {
 							ID: 2,
@@ -634,9 +637,7 @@ func Test_compactionTrigger_force_maxSegmentLimit(t *testing.T) {
 	}
 	Params.Init()
 	vecFieldID := int64(201)
-	segmentInfos := &SegmentsInfo{
-		segments: make(map[UniqueID]*SegmentInfo),
-	}
+	segmentInfos := buildSegmentsInfo(make(map[UniqueID]*SegmentInfo))
 	for i := UniqueID(0); i < 50; i++ {
 		info := &SegmentInfo{
 			SegmentInfo: &datapb.SegmentInfo{
@@ -677,7 +678,7 @@ func Test_compactionTrigger_force_maxSegmentLimit(t *testing.T) {
 				},
 			},
 		}
-		segmentInfos.segments[i] = info
+		segmentInfos.SetSegment(i, info)
 	}
 
 	tests := []struct {
@@ -855,57 +856,55 @@ func Test_compactionTrigger_noplan(t *testing.T) {
 			fields{
 				&meta{
 					// 4 segment
-					segments: &SegmentsInfo{
-						map[int64]*SegmentInfo{
-							1: {
-								SegmentInfo: &datapb.SegmentInfo{
-									ID:             1,
-									CollectionID:   2,
-									PartitionID:    1,
-									LastExpireTime: 100,
-									NumOfRows:      200,
-									MaxRowNum:      300,
-									InsertChannel:  "ch1",
-									State:          commonpb.SegmentState_Flushed,
-									Binlogs: []*datapb.FieldBinlog{
-										{
-											Binlogs: []*datapb.Binlog{
-												{EntriesNum: 5, LogPath: "log1", LogSize: 100},
-											},
+					segments: buildSegmentsInfo(map[int64]*SegmentInfo{
+						1: {
+							SegmentInfo: &datapb.SegmentInfo{
+								ID:             1,
+								CollectionID:   2,
+								PartitionID:    1,
+								LastExpireTime: 100,
+								NumOfRows:      200,
+								MaxRowNum:      300,
+								InsertChannel:  "ch1",
+								State:          commonpb.SegmentState_Flushed,
+								Binlogs: []*datapb.FieldBinlog{
+									{
+										Binlogs: []*datapb.Binlog{
+											{EntriesNum: 5, LogPath: "log1", LogSize: 100},
 										},
 									},
 								},
-								lastFlushTime: time.Now(),
 							},
-							2: {
-								SegmentInfo: &datapb.SegmentInfo{
-									ID:             2,
-									CollectionID:   2,
-									PartitionID:    1,
-									LastExpireTime: 100,
-									NumOfRows:      200,
-									MaxRowNum:      300,
-									InsertChannel:  "ch1",
-									State:          commonpb.SegmentState_Flushed,
-									Binlogs: []*datapb.FieldBinlog{
-										{
-											Binlogs: []*datapb.Binlog{
-												{EntriesNum: 5, LogPath: "log2", LogSize: Params.DataCoordCfg.SegmentMaxSize.GetAsInt64()*1024*1024 - 1},
-											},
+							lastFlushTime: time.Now(),
+						},
+						2: {
+							SegmentInfo: &datapb.SegmentInfo{
+								ID:             2,
+								CollectionID:   2,
+								PartitionID:    1,
+								LastExpireTime: 100,
+								NumOfRows:      200,
+								MaxRowNum:      300,
+								InsertChannel:  "ch1",
+								State:          commonpb.SegmentState_Flushed,
+								Binlogs: []*datapb.FieldBinlog{
+									{
+										Binlogs: []*datapb.Binlog{
+											{EntriesNum: 5, LogPath: "log2", LogSize: Params.DataCoordCfg.SegmentMaxSize.GetAsInt64()*1024*1024 - 1},
 										},
 									},
-									Deltalogs: []*datapb.FieldBinlog{
-										{
-											Binlogs: []*datapb.Binlog{
-												{EntriesNum: 5, LogPath: "deltalog2"},
-											},
+								},
+								Deltalogs: []*datapb.FieldBinlog{
+									{
+										Binlogs: []*datapb.Binlog{
+											{EntriesNum: 5, LogPath: "deltalog2"},
 										},
 									},
 								},
-								lastFlushTime: time.Now(),
 							},
+							lastFlushTime: time.Now(),
 						},
-					},
+					}),
 					collections: map[int64]*collectionInfo{
 						2: {
 							ID: 2,
@@ -1039,50 +1038,48 @@ func Test_compactionTrigger_PrioritizedCandi(t *testing.T) {
 			fields{
 				&meta{
 					// 4 small segments
-					segments: &SegmentsInfo{
-						map[int64]*SegmentInfo{
-							1: {
-								SegmentInfo:    genSeg(1, 20),
-								lastFlushTime:  time.Now().Add(-100 * time.Minute),
-								segmentIndexes: genSegIndex(1, indexID, 20),
-							},
-							2: {
-								SegmentInfo:    genSeg(2, 20),
-								lastFlushTime:  time.Now(),
-								segmentIndexes: genSegIndex(2, indexID, 20),
-							},
-							3: {
-								SegmentInfo:    genSeg(3, 20),
-								lastFlushTime:  time.Now(),
-								segmentIndexes: genSegIndex(3, indexID, 20),
-							},
-							4: {
-								SegmentInfo:    genSeg(4, 20),
-								lastFlushTime:  time.Now(),
-								segmentIndexes: genSegIndex(4, indexID, 20),
-							},
-							5: {
-								SegmentInfo:    genSeg(5, 20),
-								lastFlushTime:  time.Now(),
-								segmentIndexes: genSegIndex(5, indexID, 20),
-							},
-							6: {
-								SegmentInfo:    genSeg(6, 20),
-								lastFlushTime:  time.Now(),
-								segmentIndexes: genSegIndex(6, indexID, 20),
-							},
-							7: {
-								SegmentInfo:    genSeg(7, 20),
-								lastFlushTime:  time.Now(),
-								segmentIndexes: genSegIndex(7, indexID, 20),
-							},
-							8: {
-								SegmentInfo:    genSeg(8, 20),
-								lastFlushTime:  time.Now(),
-								segmentIndexes: genSegIndex(8, indexID, 20),
-							},
+					segments: buildSegmentsInfo(map[int64]*SegmentInfo{
+						1: {
+							SegmentInfo:    genSeg(1, 20),
+							lastFlushTime:  time.Now().Add(-100 * time.Minute),
+							segmentIndexes: genSegIndex(1, indexID, 20),
 						},
-					},
+						2: {
+							SegmentInfo:    genSeg(2, 20),
+							lastFlushTime:  time.Now(),
+							segmentIndexes: genSegIndex(2, indexID, 20),
+						},
+						3: {
+							SegmentInfo:    genSeg(3, 20),
+							lastFlushTime:  time.Now(),
+							segmentIndexes: genSegIndex(3, indexID, 20),
+						},
+						4: {
+							SegmentInfo:    genSeg(4, 20),
+							lastFlushTime:  time.Now(),
+							segmentIndexes: genSegIndex(4, indexID, 20),
+						},
+						5: {
+							SegmentInfo:    genSeg(5, 20),
+							lastFlushTime:  time.Now(),
+							segmentIndexes: genSegIndex(5, indexID, 20),
+						},
+						6: {
+							SegmentInfo:    genSeg(6, 20),
+							lastFlushTime:  time.Now(),
+							segmentIndexes: genSegIndex(6, indexID, 20),
+						},
+						7: {
+							SegmentInfo:    genSeg(7, 20),
+							lastFlushTime:  time.Now(),
+							segmentIndexes: genSegIndex(7, indexID, 20),
+						},
+						8: {
+							SegmentInfo:    genSeg(8, 20),
+							lastFlushTime:  time.Now(),
+							segmentIndexes: genSegIndex(8, indexID, 20),
+						},
+					}),
 					collections: map[int64]*collectionInfo{
 						2: {
 							ID: 2,
@@ -1230,45 +1227,43 @@ func Test_compactionTrigger_SmallCandi(t *testing.T) {
 			fields{
 				&meta{
 					// 4 small segments
-					segments: &SegmentsInfo{
-						map[int64]*SegmentInfo{
-							1: {
-								SegmentInfo:    genSeg(1, 20),
-								lastFlushTime:  time.Now().Add(-100 * time.Minute),
-								segmentIndexes: genSegIndex(1, indexID, 20),
-							},
-							2: {
-								SegmentInfo:    genSeg(2, 20),
-								lastFlushTime:  time.Now(),
-								segmentIndexes: genSegIndex(2, indexID, 20),
-							},
-							3: {
-								SegmentInfo:    genSeg(3, 20),
-								lastFlushTime:  time.Now(),
-								segmentIndexes: genSegIndex(3, indexID, 20),
-							},
-							4: {
-								SegmentInfo:    genSeg(4, 20),
-								lastFlushTime:  time.Now(),
-								segmentIndexes: genSegIndex(4, indexID, 20),
-							},
-							5: {
-								SegmentInfo:    genSeg(5, 20),
-								lastFlushTime:  time.Now(),
-								segmentIndexes: genSegIndex(5, indexID, 20),
-							},
-							6: {
-								SegmentInfo:    genSeg(6, 20),
-								lastFlushTime:  time.Now(),
-								segmentIndexes: genSegIndex(6, indexID, 20),
-							},
-							7: {
-								SegmentInfo:    genSeg(7, 20),
-								lastFlushTime:  time.Now(),
-								segmentIndexes: genSegIndex(7, indexID, 20),
-							},
+					segments: buildSegmentsInfo(map[int64]*SegmentInfo{
+						1: {
+							SegmentInfo:    genSeg(1, 20),
+							lastFlushTime:  time.Now().Add(-100 * time.Minute),
+							segmentIndexes: genSegIndex(1, indexID, 20),
 						},
-					},
+						2: {
+							SegmentInfo:    genSeg(2, 20),
+							lastFlushTime:  time.Now(),
+							segmentIndexes: genSegIndex(2, indexID, 20),
+						},
+						3: {
+							SegmentInfo:    genSeg(3, 20),
+							lastFlushTime:  time.Now(),
+							segmentIndexes: genSegIndex(3, indexID, 20),
+						},
+						4: {
+							SegmentInfo:    genSeg(4, 20),
+							lastFlushTime:  time.Now(),
+							segmentIndexes: genSegIndex(4, indexID, 20),
+						},
+						5: {
+							SegmentInfo:    genSeg(5, 20),
+							lastFlushTime:  time.Now(),
+							segmentIndexes: genSegIndex(5, indexID, 20),
+						},
+						6: {
+							SegmentInfo:    genSeg(6, 20),
+							lastFlushTime:  time.Now(),
+							segmentIndexes: genSegIndex(6, indexID, 20),
+						},
+						7: {
+							SegmentInfo:    genSeg(7, 20),
+							lastFlushTime:  time.Now(),
+							segmentIndexes: genSegIndex(7, indexID, 20),
+						},
+					}),
 					collections: map[int64]*collectionInfo{
 						2: {
 							ID: 2,
@@ -1413,40 +1408,38 @@ func Test_compactionTrigger_SqueezeNonPlannedSegs(t *testing.T) {
 			fields{
 				&meta{
 					// 4 small segments
-					segments: &SegmentsInfo{
-						map[int64]*SegmentInfo{
-							1: {
-								SegmentInfo:    genSeg(1, 60),
-								lastFlushTime:  time.Now().Add(-100 * time.Minute),
-								segmentIndexes: genSegIndex(1, indexID, 20),
-							},
-							2: {
-								SegmentInfo:    genSeg(2, 60),
-								lastFlushTime:  time.Now(),
-								segmentIndexes: genSegIndex(2, indexID, 20),
-							},
-							3: {
-								SegmentInfo:    genSeg(3, 60),
-								lastFlushTime:  time.Now(),
-								segmentIndexes: genSegIndex(3, indexID, 20),
-							},
-							4: {
-								SegmentInfo:    genSeg(4, 60),
-								lastFlushTime:  time.Now(),
-								segmentIndexes: genSegIndex(4, indexID, 20),
-							},
-							5: {
-								SegmentInfo:    genSeg(5, 26),
-								lastFlushTime:  time.Now(),
-								segmentIndexes: genSegIndex(5, indexID, 20),
-							},
-							6: {
-								SegmentInfo:    genSeg(6, 26),
-								lastFlushTime:  time.Now(),
-								segmentIndexes: genSegIndex(6, indexID, 20),
-							},
+					segments: buildSegmentsInfo(map[int64]*SegmentInfo{
+						1: {
+							SegmentInfo:    genSeg(1, 60),
+							lastFlushTime:  time.Now().Add(-100 * time.Minute),
+							segmentIndexes: genSegIndex(1, indexID, 20),
 						},
-					},
+						2: {
+							SegmentInfo:    genSeg(2, 60),
+							lastFlushTime:  time.Now(),
+							segmentIndexes: genSegIndex(2, indexID, 20),
+						},
+						3: {
+							SegmentInfo:    genSeg(3, 60),
+							lastFlushTime:  time.Now(),
+							segmentIndexes: genSegIndex(3, indexID, 20),
+						},
+						4: {
+							SegmentInfo:    genSeg(4, 60),
+							lastFlushTime:  time.Now(),
+							segmentIndexes: genSegIndex(4, indexID, 20),
+						},
+						5: {
+							SegmentInfo:    genSeg(5, 26),
+							lastFlushTime:  time.Now(),
+							segmentIndexes: genSegIndex(5, indexID, 20),
+						},
+						6: {
+							SegmentInfo:    genSeg(6, 26),
+							lastFlushTime:  time.Now(),
+							segmentIndexes: genSegIndex(6, indexID, 20),
+						},
+					}),
 					collections: map[int64]*collectionInfo{
 						2: {
 							ID: 2,
@@ -1544,9 +1537,7 @@ func Test_compactionTrigger_noplan_random_size(t *testing.T) {
 	}
 	Params.Init()
 
-	segmentInfos := &SegmentsInfo{
-		segments: make(map[UniqueID]*SegmentInfo),
-	}
+	segmentInfos := buildSegmentsInfo(make(map[UniqueID]*SegmentInfo))
 
 	size := []int64{
 		510, 500, 480, 300, 250, 200, 128, 128, 128, 127,
@@ -1591,7 +1582,7 @@ func Test_compactionTrigger_noplan_random_size(t *testing.T) {
 				},
 			},
 		}
-		segmentInfos.segments[i] = info
+		segmentInfos.SetSegment(i, info)
 	}
 
 	tests := []struct {
@@ -1716,7 +1707,7 @@ func Test_compactionTrigger_noplan_random_size(t *testing.T) {
 func Test_compactionTrigger_shouldDoSingleCompaction(t *testing.T) {
 	Params.Init()
 
-	trigger := newCompactionTrigger(&meta{}, &compactionPlanHandler{}, newMockAllocator(), newMockHandler())
+	trigger := newCompactionTrigger(&meta{}, &compactionPlanHandler{}, newMockAllocator(), newMockHandler(), nil)
 
 	// Test too many deltalogs.
 	var binlogs []*datapb.FieldBinlog
@@ -1858,6 +1849,25 @@ func Test_compactionTrigger_shouldDoSingleCompaction(t *testing.T) {
 	// deltalog is large enough, should do compaction
 	couldDo = trigger.ShouldDoSingleCompaction(info3, false, &compactTime{travelTime: 800, expireTime: 0})
 	assert.True(t, couldDo)
+
+	// segment encrypted with an outdated key should be rewritten
+	info4 := &SegmentInfo{
+		SegmentInfo: &datapb.SegmentInfo{
+			ID:                   1,
+			CollectionID:         2,
+			PartitionID:          1,
+			LastExpireTime:       600,
+			NumOfRows:            100,
+			MaxRowNum:            300,
+			InsertChannel:        "ch1",
+			State:                commonpb.SegmentState_Flushed,
+			EncryptionKeyVersion: 1,
+		},
+	}
+	couldDo = trigger.ShouldDoSingleCompaction(info4, false, &compactTime{travelTime: 200, encryptionKeyVersion: 1})
+	assert.False(t, couldDo)
+	couldDo = trigger.ShouldDoSingleCompaction(info4, false, &compactTime{travelTime: 200, encryptionKeyVersion: 2})
+	assert.True(t, couldDo)
 }
 
 func Test_newCompactionTrigger(t *testing.T) {
@@ -1881,7 +1891,7 @@ func Test_newCompactionTrigger(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := newCompactionTrigger(tt.args.meta, tt.args.compactionHandler, tt.args.allocator, newMockHandler())
+			got := newCompactionTrigger(tt.args.meta, tt.args.compactionHandler, tt.args.allocator, newMockHandler(), nil)
 			assert.Equal(t, tt.args.meta, got.meta)
 			assert.Equal(t, tt.args.compactionHandler, got.compactionHandler)
 			assert.Equal(t, tt.args.allocator, got.allocator)
@@ -1890,7 +1900,7 @@ func Test_newCompactionTrigger(t *testing.T) {
 }
 
 func Test_handleSignal(t *testing.T) {
-	got := newCompactionTrigger(&meta{segments: NewSegmentsInfo()}, &compactionPlanHandler{}, newMockAllocator(), newMockHandler())
+	got := newCompactionTrigger(&meta{segments: NewSegmentsInfo()}, &compactionPlanHandler{}, newMockAllocator(), newMockHandler(), nil)
 	signal := &compactionSignal{
 		segmentID: 1,
 	}
@@ -1899,13 +1909,98 @@ func Test_handleSignal(t *testing.T) {
 	})
 }
 
+func Test_generatePurgePlans(t *testing.T) {
+	got := newCompactionTrigger(&meta{segments: NewSegmentsInfo()}, &compactionPlanHandler{}, newMockAllocator(), newMockHandler(), nil)
+	segments := []*SegmentInfo{
+		{
+			SegmentInfo: &datapb.SegmentInfo{
+				ID:        1,
+				NumOfRows: 100,
+				Deltalogs: []*datapb.FieldBinlog{
+					{Binlogs: []*datapb.Binlog{{EntriesNum: 5, LogPath: "deltalog1"}}},
+				},
+			},
+		},
+		{
+			SegmentInfo: &datapb.SegmentInfo{
+				ID:        2,
+				NumOfRows: 100,
+			},
+		},
+	}
+	plans := got.generatePurgePlans(segments, &compactTime{travelTime: 100})
+	assert.Equal(t, 1, len(plans))
+	assert.Equal(t, 1, len(plans[0].GetSegmentBinlogs()))
+	assert.EqualValues(t, 1, plans[0].GetSegmentBinlogs()[0].GetSegmentID())
+}
+
+func Test_generatePlansByDeletedRowRatio(t *testing.T) {
+	got := newCompactionTrigger(&meta{segments: NewSegmentsInfo()}, &compactionPlanHandler{}, newMockAllocator(), newMockHandler(), nil)
+	segments := []*SegmentInfo{
+		{
+			SegmentInfo: &datapb.SegmentInfo{
+				ID:            1,
+				NumOfRows:     100,
+				MaxRowNum:     100,
+				InsertChannel: "ch1",
+			},
+		},
+		{
+			SegmentInfo: &datapb.SegmentInfo{
+				ID:            2,
+				NumOfRows:     100,
+				MaxRowNum:     100,
+				InsertChannel: "ch1",
+				Deltalogs: []*datapb.FieldBinlog{
+					{Binlogs: []*datapb.Binlog{{EntriesNum: 30}, {EntriesNum: 10}}},
+				},
+			},
+		},
+		{
+			SegmentInfo: &datapb.SegmentInfo{
+				ID:            3,
+				NumOfRows:     100,
+				MaxRowNum:     100,
+				InsertChannel: "ch1",
+				Deltalogs: []*datapb.FieldBinlog{
+					{Binlogs: []*datapb.Binlog{{EntriesNum: 150}}},
+				},
+			},
+		},
+	}
+	assert.Equal(t, 0.0, segments[0].getDeletedRowRatio())
+	assert.EqualValues(t, 40, segments[1].getDeletedRows())
+	assert.Equal(t, 0.4, segments[1].getDeletedRowRatio())
+	// the deleted rows may exceed the rows due to the false positives of bloom filter
+	assert.Equal(t, 1.0, segments[2].getDeletedRowRatio())
+
+	planSegments := func(plans []*datapb.CompactionPlan) []int64 {
+		return lo.Map(plans, func(plan *datapb.CompactionPlan, _ int) int64 {
+			return plan.GetSegmentBinlogs()[0].GetSegmentID()
+		})
+	}
+	plans := got.generatePlans(segments, true, false, &compactTime{})
+	assert.Equal(t, []int64{3, 2, 1}, planSegments(plans))
+
+	// gated off by the feature flag
+	paramtable.Get().Save("featureFlags.compactionDeleteRatioScore.enabled", "false")
+	plans = got.generatePlans(segments, true, false, &compactTime{})
+	assert.Equal(t, []int64{1, 2, 3}, planSegments(plans))
+	paramtable.Get().Reset("featureFlags.compactionDeleteRatioScore.enabled")
+
+	paramtable.Get().Save(Params.DataCoordCfg.SingleCompactionDeleteRatioWeight.Key, "0")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.SingleCompactionDeleteRatioWeight.Key)
+	plans = got.generatePlans(segments, true, false, &compactTime{})
+	assert.Equal(t, []int64{1, 2, 3}, planSegments(plans))
+}
+
 func Test_allocTs(t *testing.T) {
-	got := newCompactionTrigger(&meta{segments: NewSegmentsInfo()}, &compactionPlanHandler{}, newMockAllocator(), newMockHandler())
+	got := newCompactionTrigger(&meta{segments: NewSegmentsInfo()}, &compactionPlanHandler{}, newMockAllocator(), newMockHandler(), nil)
 	ts, err := got.allocTs()
 	assert.NoError(t, err)
 	assert.True(t, ts > 0)
 
-	got = newCompactionTrigger(&meta{segments: NewSegmentsInfo()}, &compactionPlanHandler{}, &FailsAllocator{}, newMockHandler())
+	got = newCompactionTrigger(&meta{segments: NewSegmentsInfo()}, &compactionPlanHandler{}, &FailsAllocator{}, newMockHandler(), nil)
 	ts, err = got.allocTs()
 	assert.Error(t, err)
 	assert.Equal(t, uint64(0), ts)
@@ -1937,7 +2032,7 @@ func Test_getCompactTime(t *testing.T) {
 			&Server{
 				meta: m,
 			},
-		})
+		}, nil)
 	coll := &collectionInfo{
 		ID:         1,
 		Schema:     newTestSchema(),
@@ -2014,40 +2109,38 @@ func (s *CompactionTriggerSuite) SetupTest() {
 	s.indexID = 300
 	s.vecFieldID = 400
 	s.channel = "dml_0_100v0"
-	s.meta = &meta{segments: &SegmentsInfo{
-		map[int64]*SegmentInfo{
-			1: {
-				SegmentInfo:    s.genSeg(1, 60),
-				lastFlushTime:  time.Now().Add(-100 * time.Minute),
-				segmentIndexes: s.genSegIndex(1, indexID, 60),
-			},
-			2: {
-				SegmentInfo:    s.genSeg(2, 60),
-				lastFlushTime:  time.Now(),
-				segmentIndexes: s.genSegIndex(2, indexID, 60),
-			},
-			3: {
-				SegmentInfo:    s.genSeg(3, 60),
-				lastFlushTime:  time.Now(),
-				segmentIndexes: s.genSegIndex(3, indexID, 60),
-			},
-			4: {
-				SegmentInfo:    s.genSeg(4, 60),
-				lastFlushTime:  time.Now(),
-				segmentIndexes: s.genSegIndex(4, indexID, 60),
-			},
-			5: {
-				SegmentInfo:    s.genSeg(5, 26),
-				lastFlushTime:  time.Now(),
-				segmentIndexes: s.genSegIndex(5, indexID, 26),
-			},
-			6: {
-				SegmentInfo:    s.genSeg(6, 26),
-				lastFlushTime:  time.Now(),
-				segmentIndexes: s.genSegIndex(6, indexID, 26),
-			},
+	s.meta = &meta{segments: buildSegmentsInfo(map[int64]*SegmentInfo{
+		1: {
+			SegmentInfo:    s.genSeg(1, 60),
+			lastFlushTime:  time.Now().Add(-100 * time.Minute),
+			segmentIndexes: s.genSegIndex(1, indexID, 60),
+		},
+		2: {
+			SegmentInfo:    s.genSeg(2, 60),
+			lastFlushTime:  time.Now(),
+			segmentIndexes: s.genSegIndex(2, indexID, 60),
+		},
+		3: {
+			SegmentInfo:    s.genSeg(3, 60),
+			lastFlushTime:  time.Now(),
+			segmentIndexes: s.genSegIndex(3, indexID, 60),
+		},
+		4: {
+			SegmentInfo:    s.genSeg(4, 60),
+			lastFlushTime:  time.Now(),
+			segmentIndexes: s.genSegIndex(4, indexID, 60),
+		},
+		5: {
+			SegmentInfo:    s.genSeg(5, 26),
+			lastFlushTime:  time.Now(),
+			segmentIndexes: s.genSegIndex(5, indexID, 26),
+		},
+		6: {
+			SegmentInfo:    s.genSeg(6, 26),
+			lastFlushTime:  time.Now(),
+			segmentIndexes: s.genSegIndex(6, indexID, 26),
 		},
-	},
+	}),
 		collections: map[int64]*collectionInfo{
 			s.collectionID: {
 				ID: s.collectionID,
@@ -2092,6 +2185,7 @@ func (s *CompactionTriggerSuite) SetupTest() {
 		s.compactionHandler,
 		s.allocator,
 		s.handler,
+		nil,
 	)
 	s.tr.testingOnly = true
 }
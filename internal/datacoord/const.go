@@ -16,6 +16,8 @@
 
 package datacoord
 
+import "github.com/milvus-io/milvus/pkg/util/indexparamcheck"
+
 // segment reference lock
 const (
 	// segmentReferPrefix is the prefix of the segment reference lock path
@@ -32,3 +34,11 @@ const (
 	diskAnnIndex = "DISKANN"
 	invalidIndex = "invalid"
 )
+
+// alterableIndexParams are the index params which only matter when building the index,
+// altering them rebuilds the segment indexes in the background without reloading the collection.
+var alterableIndexParams = map[string]struct{}{
+	indexparamcheck.EFConstruction: {},
+	"refine":                       {},
+	"refine_type":                  {},
+}
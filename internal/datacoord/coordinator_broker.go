@@ -39,6 +39,8 @@ type Broker interface {
 	ShowCollections(ctx context.Context, dbName string) (*milvuspb.ShowCollectionsResponse, error)
 	ListDatabases(ctx context.Context) (*milvuspb.ListDatabasesResponse, error)
 	HasCollection(ctx context.Context, collectionID int64) (bool, error)
+	DescribeCollectionByName(ctx context.Context, dbName string, collectionName string) (*milvuspb.DescribeCollectionResponse, error)
+	ShowPartitionNames(ctx context.Context, collectionID int64) (map[string]int64, error)
 }
 
 type CoordinatorBroker struct {
@@ -94,6 +96,55 @@ func (b *CoordinatorBroker) ShowPartitionsInternal(ctx context.Context, collecti
 	return resp.PartitionIDs, nil
 }
 
+// DescribeCollectionByName describes the collection by its name in the database,
+// it's only used to find the collection of the same name in another cluster.
+func (b *CoordinatorBroker) DescribeCollectionByName(ctx context.Context, dbName string, collectionName string) (*milvuspb.DescribeCollectionResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, brokerRPCTimeout)
+	defer cancel()
+	resp, err := b.rootCoord.DescribeCollectionInternal(ctx, &milvuspb.DescribeCollectionRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_DescribeCollection),
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		DbName:         dbName,
+		CollectionName: collectionName,
+	})
+	if err = VerifyResponse(resp, err); err != nil {
+		log.Warn("DescribeCollectionByName failed",
+			zap.String("dbName", dbName),
+			zap.String("collectionName", collectionName),
+			zap.Error(err))
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// ShowPartitionNames returns the partition IDs of the collection by their names.
+func (b *CoordinatorBroker) ShowPartitionNames(ctx context.Context, collectionID int64) (map[string]int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, brokerRPCTimeout)
+	defer cancel()
+	resp, err := b.rootCoord.ShowPartitionsInternal(ctx, &milvuspb.ShowPartitionsRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_ShowPartitions),
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		CollectionID: collectionID,
+	})
+	if err = VerifyResponse(resp, err); err != nil {
+		log.Warn("ShowPartitionNames failed",
+			zap.Int64("collectionID", collectionID),
+			zap.Error(err))
+		return nil, err
+	}
+
+	ret := make(map[string]int64, len(resp.GetPartitionNames()))
+	for i, name := range resp.GetPartitionNames() {
+		ret[name] = resp.GetPartitionIDs()[i]
+	}
+	return ret, nil
+}
+
 func (b *CoordinatorBroker) ShowCollections(ctx context.Context, dbName string) (*milvuspb.ShowCollectionsResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, brokerRPCTimeout)
 	defer cancel()
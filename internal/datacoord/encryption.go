@@ -0,0 +1,126 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// initialEncryptionKeyVersion is the key version of a collection whose key is never rotated.
+const initialEncryptionKeyVersion = 1
+
+// encryptionStatus is the progress of re-encrypting a collection with its current key.
+type encryptionStatus struct {
+	enabled          bool
+	keyVersion       int64
+	totalRows        int64
+	rowsOnCurrentKey int64
+}
+
+// progress returns the percentage of the rows encrypted with the current key.
+func (s *encryptionStatus) progress() float64 {
+	if s.totalRows == 0 {
+		return 100
+	}
+	return float64(s.rowsOnCurrentKey) * 100 / float64(s.totalRows)
+}
+
+// GetEncryptionKeyVersion returns the version of the key that new segments of the collection are encrypted with,
+// 0 means the collection doesn't require encrypted storage.
+func (m *meta) GetEncryptionKeyVersion(collectionID UniqueID) int64 {
+	m.RLock()
+	defer m.RUnlock()
+	return m.getEncryptionKeyVersionUnsafe(collectionID)
+}
+
+func (m *meta) getEncryptionKeyVersionUnsafe(collectionID UniqueID) int64 {
+	collection, ok := m.collections[collectionID]
+	if !ok {
+		return 0
+	}
+	enabled, err := getCollectionEncryptionEnabled(collection.Properties)
+	if err != nil {
+		log.Warn("invalid encryption property of collection, treat as not encrypted",
+			zap.Int64("collectionID", collectionID), zap.Error(err))
+		return 0
+	}
+	if !enabled {
+		return 0
+	}
+	if key, ok := m.encryptionKeys[collectionID]; ok {
+		return key.GetKeyVersion()
+	}
+	return initialEncryptionKeyVersion
+}
+
+// RotateEncryptionKey switches the collection to a new key version and returns it.
+// Existing segments are not touched, they are re-encrypted with the new key when they are compacted.
+func (m *meta) RotateEncryptionKey(ctx context.Context, collectionID UniqueID) (int64, error) {
+	m.Lock()
+	defer m.Unlock()
+	current := m.getEncryptionKeyVersionUnsafe(collectionID)
+	if current == 0 {
+		return 0, merr.WrapErrParameterInvalid("encrypted collection", "unencrypted collection",
+			fmt.Sprintf("collection %d doesn't require encrypted storage", collectionID))
+	}
+
+	key := &datapb.EncryptionKeyInfo{
+		CollectionID: collectionID,
+		KeyVersion:   current + 1,
+	}
+	if err := m.catalog.SaveEncryptionKey(ctx, key); err != nil {
+		log.Warn("meta update: failed to save encryption key", zap.Int64("collectionID", collectionID), zap.Error(err))
+		return 0, err
+	}
+	if m.encryptionKeys == nil {
+		m.encryptionKeys = make(map[UniqueID]*datapb.EncryptionKeyInfo)
+	}
+	m.encryptionKeys[collectionID] = key
+	log.Info("meta update: rotate encryption key", zap.Int64("collectionID", collectionID),
+		zap.Int64("keyVersion", key.GetKeyVersion()))
+	return key.GetKeyVersion(), nil
+}
+
+// GetEncryptionStatus returns how many rows of the collection are encrypted with the current key.
+func (m *meta) GetEncryptionStatus(collectionID UniqueID) *encryptionStatus {
+	m.RLock()
+	defer m.RUnlock()
+	status := &encryptionStatus{
+		keyVersion: m.getEncryptionKeyVersionUnsafe(collectionID),
+	}
+	status.enabled = status.keyVersion > 0
+	if !status.enabled {
+		return status
+	}
+	for _, segment := range m.segments.GetSegments() {
+		if segment.GetCollectionID() != collectionID || !isSegmentHealthy(segment) {
+			continue
+		}
+		status.totalRows += segment.GetNumOfRows()
+		if segment.GetEncryptionKeyVersion() == status.keyVersion {
+			status.rowsOnCurrentKey += segment.GetNumOfRows()
+		}
+	}
+	return status
+}
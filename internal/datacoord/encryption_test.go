@@ -0,0 +1,156 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+func TestMeta_EncryptionKey(t *testing.T) {
+	m, err := newMemoryMeta()
+	require.NoError(t, err)
+	m.AddCollection(&collectionInfo{ID: 1, Properties: map[string]string{common.CollectionEncryptionKey: "true"}})
+	m.AddCollection(&collectionInfo{ID: 2})
+	m.AddCollection(&collectionInfo{ID: 3, Properties: map[string]string{common.CollectionEncryptionKey: "invalid"}})
+
+	assert.EqualValues(t, initialEncryptionKeyVersion, m.GetEncryptionKeyVersion(1))
+	assert.EqualValues(t, 0, m.GetEncryptionKeyVersion(2))
+	assert.EqualValues(t, 0, m.GetEncryptionKeyVersion(3))
+	assert.EqualValues(t, 0, m.GetEncryptionKeyVersion(4))
+
+	_, err = m.RotateEncryptionKey(context.TODO(), 2)
+	assert.Error(t, err)
+
+	keyVersion, err := m.RotateEncryptionKey(context.TODO(), 1)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, keyVersion)
+	assert.EqualValues(t, 2, m.GetEncryptionKeyVersion(1))
+
+	// the rotated key is persisted
+	reloaded, err := newMeta(context.TODO(), m.catalog, nil)
+	require.NoError(t, err)
+	reloaded.AddCollection(&collectionInfo{ID: 1, Properties: map[string]string{common.CollectionEncryptionKey: "true"}})
+	assert.EqualValues(t, 2, reloaded.GetEncryptionKeyVersion(1))
+
+	t.Run("save failed", func(t *testing.T) {
+		catalog := mocks.NewDataCoordCatalog(t)
+		catalog.EXPECT().SaveEncryptionKey(mock.Anything, mock.Anything).Return(errors.New("mock error"))
+		m := &meta{
+			catalog:     catalog,
+			collections: map[UniqueID]*collectionInfo{1: {ID: 1, Properties: map[string]string{common.CollectionEncryptionKey: "true"}}},
+		}
+		_, err := m.RotateEncryptionKey(context.TODO(), 1)
+		assert.Error(t, err)
+		assert.EqualValues(t, initialEncryptionKeyVersion, m.GetEncryptionKeyVersion(1))
+	})
+}
+
+func TestMeta_GetEncryptionStatus(t *testing.T) {
+	m, err := newMemoryMeta()
+	require.NoError(t, err)
+	m.AddCollection(&collectionInfo{ID: 1, Properties: map[string]string{common.CollectionEncryptionKey: "true"}})
+	m.AddCollection(&collectionInfo{ID: 2})
+
+	status := m.GetEncryptionStatus(2)
+	assert.False(t, status.enabled)
+
+	status = m.GetEncryptionStatus(1)
+	assert.True(t, status.enabled)
+	assert.EqualValues(t, 0, status.totalRows)
+	assert.EqualValues(t, 100, status.progress())
+
+	segments := []*datapb.SegmentInfo{
+		{ID: 1, CollectionID: 1, NumOfRows: 100, State: commonpb.SegmentState_Flushed, EncryptionKeyVersion: 1},
+		{ID: 2, CollectionID: 1, NumOfRows: 300, State: commonpb.SegmentState_Flushed, EncryptionKeyVersion: 1},
+		{ID: 3, CollectionID: 1, NumOfRows: 500, State: commonpb.SegmentState_Dropped, EncryptionKeyVersion: 1},
+		{ID: 4, CollectionID: 2, NumOfRows: 500, State: commonpb.SegmentState_Flushed},
+	}
+	for _, segment := range segments {
+		require.NoError(t, m.AddSegment(NewSegmentInfo(segment)))
+	}
+	status = m.GetEncryptionStatus(1)
+	assert.EqualValues(t, 400, status.totalRows)
+	assert.EqualValues(t, 400, status.rowsOnCurrentKey)
+
+	_, err = m.RotateEncryptionKey(context.TODO(), 1)
+	require.NoError(t, err)
+	require.NoError(t, m.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+		ID: 5, CollectionID: 1, NumOfRows: 100, State: commonpb.SegmentState_Growing, EncryptionKeyVersion: 2,
+	})))
+	status = m.GetEncryptionStatus(1)
+	assert.EqualValues(t, 2, status.keyVersion)
+	assert.EqualValues(t, 500, status.totalRows)
+	assert.EqualValues(t, 100, status.rowsOnCurrentKey)
+	assert.EqualValues(t, 20, status.progress())
+}
+
+func TestServer_EncryptionKey(t *testing.T) {
+	t.Run("closed server", func(t *testing.T) {
+		s := &Server{}
+		s.stateCode.Store(commonpb.StateCode_Initializing)
+		rotateResp, err := s.RotateEncryptionKey(context.TODO(), &datapb.RotateEncryptionKeyRequest{CollectionID: 1})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, rotateResp.GetStatus().GetErrorCode())
+
+		statusResp, err := s.GetEncryptionStatus(context.TODO(), &datapb.GetEncryptionStatusRequest{CollectionID: 1})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, statusResp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		m, err := newMemoryMeta()
+		require.NoError(t, err)
+		m.AddCollection(&collectionInfo{ID: 1, Properties: map[string]string{common.CollectionEncryptionKey: "true"}})
+		m.AddCollection(&collectionInfo{ID: 2})
+		require.NoError(t, m.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+			ID: 1, CollectionID: 1, NumOfRows: 100, State: commonpb.SegmentState_Flushed, EncryptionKeyVersion: 1,
+		})))
+
+		s := &Server{meta: m}
+		s.handler = newServerHandler(s)
+		s.stateCode.Store(commonpb.StateCode_Healthy)
+
+		rotateResp, err := s.RotateEncryptionKey(context.TODO(), &datapb.RotateEncryptionKeyRequest{CollectionID: 2})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, rotateResp.GetStatus().GetErrorCode())
+
+		rotateResp, err = s.RotateEncryptionKey(context.TODO(), &datapb.RotateEncryptionKeyRequest{CollectionID: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rotateResp.GetStatus().GetErrorCode())
+		assert.EqualValues(t, 2, rotateResp.GetKeyVersion())
+
+		statusResp, err := s.GetEncryptionStatus(context.TODO(), &datapb.GetEncryptionStatusRequest{CollectionID: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, statusResp.GetStatus().GetErrorCode())
+		assert.True(t, statusResp.GetEnabled())
+		assert.EqualValues(t, 2, statusResp.GetKeyVersion())
+		assert.EqualValues(t, 100, statusResp.GetTotalRows())
+		assert.EqualValues(t, 0, statusResp.GetRowsOnCurrentKey())
+		assert.EqualValues(t, 0, statusResp.GetProgress())
+	})
+}
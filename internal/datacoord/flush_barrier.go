@@ -0,0 +1,120 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// flushBarrierCheckInterval is the interval to check the progress of the flush barrier.
+var flushBarrierCheckInterval = 500 * time.Millisecond
+
+// flushAndSeal flushes all the channels of the collection up to barrierTs, and returns
+// the flushed segments which contain all the data of the collection before barrierTs,
+// together with the checkpoints of the channels. It blocks until the ctx is done.
+//
+// The segments may contain the data after barrierTs, the readers shall skip the entities
+// with timestamps later than barrierTs to get a consistent snapshot.
+func (s *Server) flushAndSeal(ctx context.Context, collectionID UniqueID, barrierTs Timestamp) ([]UniqueID, []*msgpb.MsgPosition, error) {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", collectionID), zap.Uint64("barrierTs", barrierTs))
+	coll, err := s.broker.DescribeCollectionInternal(ctx, collectionID)
+	if err != nil {
+		return nil, nil, err
+	}
+	channels := coll.GetVirtualChannelNames()
+
+	ticker := time.NewTicker(flushBarrierCheckInterval)
+	defer ticker.Stop()
+	for {
+		segmentIDs, checkpoints, done, err := s.checkFlushBarrier(ctx, collectionID, channels, barrierTs)
+		if err != nil {
+			return nil, nil, err
+		}
+		if done {
+			log.Info("flush barrier reached", zap.Int64s("segmentIDs", segmentIDs))
+			return segmentIDs, checkpoints, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, nil, merr.WrapErrServiceInternal("flush barrier not reached", ctx.Err().Error())
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkFlushBarrier seals the segments before the barrier once all the channels are consumed up to the barrier,
+// done is true when all the segments before the barrier are flushed.
+func (s *Server) checkFlushBarrier(ctx context.Context, collectionID UniqueID, channels []string, barrierTs Timestamp) ([]UniqueID, []*msgpb.MsgPosition, bool, error) {
+	checkpoints := make([]*msgpb.MsgPosition, 0, len(channels))
+	for _, channel := range channels {
+		cp := s.meta.GetChannelCheckpoint(channel)
+		// the data before the barrier may be not consumed yet
+		if cp == nil || cp.GetTimestamp() < barrierTs {
+			return nil, nil, false, nil
+		}
+		checkpoints = append(checkpoints, cp)
+	}
+
+	segmentIDs := make([]UniqueID, 0)
+	growings := make([]UniqueID, 0)
+	flushed := true
+	for _, segment := range s.meta.GetSegmentsOfCollection(collectionID) {
+		if !isSegmentHealthy(segment) || segment.GetIsImporting() || segment.GetNumOfRows() == 0 ||
+			segment.GetStartPosition().GetTimestamp() > barrierTs {
+			continue
+		}
+		segmentIDs = append(segmentIDs, segment.GetID())
+		switch segment.GetState() {
+		case commonpb.SegmentState_Flushed:
+		case commonpb.SegmentState_Growing:
+			growings = append(growings, segment.GetID())
+			flushed = false
+		default:
+			flushed = false
+		}
+	}
+	if len(growings) > 0 {
+		if _, err := s.segmentManager.SealAllSegments(ctx, collectionID, growings, false); err != nil {
+			return nil, nil, false, err
+		}
+	}
+	return segmentIDs, checkpoints, flushed, nil
+}
+
+// checkBarrierTs returns the latest timestamp if the barrier is not set,
+// the barrier in the future is rejected as it may never be reached.
+func (s *Server) checkBarrierTs(ctx context.Context, barrierTs Timestamp) (Timestamp, error) {
+	ts, err := s.allocator.allocTimestamp(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if barrierTs == 0 {
+		return ts, nil
+	}
+	if barrierTs > ts {
+		return 0, merr.WrapErrParameterInvalidRange(Timestamp(0), ts, barrierTs, "barrier timestamp is in the future")
+	}
+	return barrierTs, nil
+}
@@ -0,0 +1,85 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/ratelimitutil"
+)
+
+// flushThrottle limits the concurrent flushes and the flush rate of each collection,
+// so that a heavy collection doesn't occupy all the flush workers of DataNodes.
+type flushThrottle struct {
+	mu       sync.Mutex
+	inflight map[UniqueID]int                    // collection id -> number of flushes in flight
+	limiters map[UniqueID]*ratelimitutil.Limiter // collection id -> flush rate limiter
+}
+
+func newFlushThrottle() *flushThrottle {
+	return &flushThrottle{
+		inflight: make(map[UniqueID]int),
+		limiters: make(map[UniqueID]*ratelimitutil.Limiter),
+	}
+}
+
+// acquire returns the func to call when the flush is done if the collection is allowed to flush now,
+// otherwise returns ErrServiceRequestLimitExceeded.
+func (t *flushThrottle) acquire(collectionID UniqueID) (func(), error) {
+	maxConcurrent := Params.DataCoordCfg.FlushMaxConcurrentPerCollection.GetAsInt()
+	maxQPS := Params.DataCoordCfg.FlushMaxQPSPerCollection.GetAsFloat()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if maxConcurrent > 0 && t.inflight[collectionID] >= maxConcurrent {
+		return nil, merr.WrapErrServiceRequestLimitExceeded(int32(maxConcurrent),
+			fmt.Sprintf("too many concurrent flushes of collection %d", collectionID))
+	}
+	if maxQPS > 0 {
+		limiter, ok := t.limiters[collectionID]
+		if !ok {
+			limiter = ratelimitutil.NewLimiter(ratelimitutil.Limit(maxQPS), maxQPS)
+			t.limiters[collectionID] = limiter
+		} else if limiter.Limit() != ratelimitutil.Limit(maxQPS) {
+			limiter.SetLimit(ratelimitutil.Limit(maxQPS))
+		}
+		if !limiter.AllowN(time.Now(), 1) {
+			return nil, merr.WrapErrServiceRequestLimitExceeded(int32(maxQPS),
+				fmt.Sprintf("flush rate of collection %d exceeds the limit", collectionID))
+		}
+	} else {
+		delete(t.limiters, collectionID)
+	}
+
+	t.inflight[collectionID]++
+	var once sync.Once
+	return func() {
+		once.Do(func() { t.release(collectionID) })
+	}, nil
+}
+
+func (t *flushThrottle) release(collectionID UniqueID) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.inflight[collectionID]--
+	if t.inflight[collectionID] <= 0 {
+		delete(t.inflight, collectionID)
+	}
+}
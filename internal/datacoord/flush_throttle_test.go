@@ -0,0 +1,80 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+func TestFlushThrottle(t *testing.T) {
+	t.Run("no limit", func(t *testing.T) {
+		throttle := newFlushThrottle()
+		for i := 0; i < 100; i++ {
+			_, err := throttle.acquire(1)
+			assert.NoError(t, err)
+		}
+	})
+
+	t.Run("max concurrent", func(t *testing.T) {
+		Params.Save(Params.DataCoordCfg.FlushMaxConcurrentPerCollection.Key, "2")
+		defer Params.Reset(Params.DataCoordCfg.FlushMaxConcurrentPerCollection.Key)
+
+		throttle := newFlushThrottle()
+		release1, err := throttle.acquire(1)
+		require.NoError(t, err)
+		_, err = throttle.acquire(1)
+		require.NoError(t, err)
+		_, err = throttle.acquire(1)
+		assert.ErrorIs(t, err, merr.ErrServiceRequestLimitExceeded)
+		// other collections are not affected
+		_, err = throttle.acquire(2)
+		assert.NoError(t, err)
+
+		release1()
+		// release is idempotent
+		release1()
+		assert.Equal(t, 1, throttle.inflight[1])
+		_, err = throttle.acquire(1)
+		assert.NoError(t, err)
+	})
+
+	t.Run("max qps", func(t *testing.T) {
+		Params.Save(Params.DataCoordCfg.FlushMaxQPSPerCollection.Key, "0.001")
+		defer Params.Reset(Params.DataCoordCfg.FlushMaxQPSPerCollection.Key)
+
+		throttle := newFlushThrottle()
+		release, err := throttle.acquire(1)
+		require.NoError(t, err)
+		release()
+		_, err = throttle.acquire(1)
+		assert.ErrorIs(t, err, merr.ErrServiceRequestLimitExceeded)
+		assert.Empty(t, throttle.inflight)
+		_, err = throttle.acquire(2)
+		assert.NoError(t, err)
+
+		// the limit is refreshed
+		Params.Save(Params.DataCoordCfg.FlushMaxQPSPerCollection.Key, "0")
+		_, err = throttle.acquire(1)
+		assert.NoError(t, err)
+		assert.NotContains(t, throttle.limiters, int64(1))
+	})
+}
@@ -18,8 +18,10 @@ package datacoord
 
 import (
 	"context"
+	"fmt"
 	"path"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -51,6 +53,9 @@ type GcOption struct {
 	checkInterval    time.Duration        // each interval
 	missingTolerance time.Duration        // key missing in meta tolerance time
 	dropTolerance    time.Duration        // dropped segment related key tolerance time
+	maintenance      *maintenanceManager  // gc pausing and schedule
+	audit            *auditLog            // records the recycled segments and removed orphan files
+	broker           Broker               // checks the dropped collections and partitions, whose values stored aside are removed
 }
 
 // garbageCollector handles garbage files in object storage
@@ -60,6 +65,10 @@ type garbageCollector struct {
 	meta    *meta
 	handler Handler
 
+	status gcStatus
+	// held by a run, pausing waits on it for the in-flight run to stop
+	runCh chan struct{}
+
 	startOnce sync.Once
 	stopOnce  sync.Once
 	wg        sync.WaitGroup
@@ -74,6 +83,7 @@ func newGarbageCollector(meta *meta, handler Handler, opt GcOption) *garbageColl
 		meta:    meta,
 		handler: handler,
 		option:  opt,
+		runCh:   make(chan struct{}, 1),
 		closeCh: make(chan struct{}),
 	}
 }
@@ -100,11 +110,11 @@ func (gc *garbageCollector) work() {
 	for {
 		select {
 		case <-ticker.C:
-			gc.clearEtcd()
-			gc.recycleUnusedIndexes()
-			gc.recycleUnusedSegIndexes()
-			gc.scan()
-			gc.recycleUnusedIndexFiles()
+			if !gc.option.maintenance.GCAllowed(0) {
+				log.RatedInfo(60, "garbage collection paused or out of schedule")
+				continue
+			}
+			gc.run()
 		case <-gc.closeCh:
 			log.Warn("garbage collector quit")
 			return
@@ -112,6 +122,56 @@ func (gc *garbageCollector) work() {
 	}
 }
 
+// run performs a round of garbage collection, the remaining phases are skipped once the gc is paused.
+func (gc *garbageCollector) run() {
+	gc.runCh <- struct{}{}
+	defer func() { <-gc.runCh }()
+
+	phases := []struct {
+		phase datapb.GcPhase
+		fn    func()
+	}{
+		{datapb.GcPhase_GcRecyclingSegments, gc.clearEtcd},
+		{datapb.GcPhase_GcRecyclingIndexes, func() {
+			gc.recycleUnusedIndexes()
+			gc.recycleUnusedSegIndexes()
+		}},
+		{datapb.GcPhase_GcScanningOrphans, func() {
+			gc.scan()
+			gc.recycleSidePayloads()
+		}},
+		{datapb.GcPhase_GcRecyclingIndexFiles, gc.recycleUnusedIndexFiles},
+	}
+	gc.status.startRun()
+	interrupted := false
+	for _, p := range phases {
+		if gc.isPaused() {
+			interrupted = true
+			break
+		}
+		gc.status.enterPhase(p.phase)
+		p.fn()
+	}
+	gc.status.finishRun(interrupted || gc.isPaused())
+}
+
+// isPaused returns whether the gc is paused by the global maintenance policy,
+// the in-flight run stops once paused.
+func (gc *garbageCollector) isPaused() bool {
+	return gc.option.maintenance.GCPaused()
+}
+
+// waitForRun waits for the in-flight run to stop.
+func (gc *garbageCollector) waitForRun(ctx context.Context) error {
+	select {
+	case gc.runCh <- struct{}{}:
+		<-gc.runCh
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 func (gc *garbageCollector) close() {
 	gc.stopOnce.Do(func() {
 		close(gc.closeCh)
@@ -125,6 +185,47 @@ func (gc *garbageCollector) scan() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
+	// ignore the listing error since the listed files could be cleaned up anyway
+	orphans, _ := gc.listOrphanFiles(ctx)
+	gc.status.addPendingFiles(len(orphans))
+	var removedKeys []string
+	removed := 0
+	for _, infoKey := range orphans {
+		if gc.isPaused() {
+			log.Info("garbage collection paused, stop removing orphan files")
+			break
+		}
+		// keep the orphan files of the collections whose gc is paused
+		if collectionID, err := storage.ParseCollectionIDByBinlog(gc.option.cli.RootPath(), infoKey); err == nil &&
+			!gc.option.maintenance.GCAllowed(collectionID) {
+			continue
+		}
+		// ignore error since it could be cleaned up next time
+		removedKeys = append(removedKeys, infoKey)
+		err := gc.option.cli.Remove(ctx, infoKey)
+		gc.status.recordRemoval(err)
+		if err != nil {
+			log.Error("failed to remove object",
+				zap.String("infoKey", infoKey),
+				zap.Error(err))
+			continue
+		}
+		removed++
+	}
+	log.Info("scan file to do garbage collection",
+		zap.Int("orphans", len(orphans)),
+		zap.Strings("removedKeys", removedKeys))
+	if removed > 0 {
+		gc.option.audit.Record(&datapb.AuditEvent{
+			Type:   datapb.AuditEventType_OrphanFilesRemoved,
+			Detail: fmt.Sprintf("removed %d of %d orphan files", removed, len(orphans)),
+		})
+	}
+}
+
+// listOrphanFiles lists the binlog files not referenced by the meta, whose last modified time exceeds
+// the missing tolerance. The error of listing any prefix is returned after all the prefixes are walked.
+func (gc *garbageCollector) listOrphanFiles(ctx context.Context) ([]string, error) {
 	var (
 		total   = 0
 		valid   = 0
@@ -146,7 +247,8 @@ func (gc *garbageCollector) scan() {
 	prefixes = append(prefixes, path.Join(gc.option.cli.RootPath(), insertLogPrefix))
 	prefixes = append(prefixes, path.Join(gc.option.cli.RootPath(), statsLogPrefix))
 	prefixes = append(prefixes, path.Join(gc.option.cli.RootPath(), deltaLogPrefix))
-	var removedKeys []string
+	var orphans []string
+	var listErr error
 
 	for _, prefix := range prefixes {
 		startTs := time.Now()
@@ -156,6 +258,7 @@ func (gc *garbageCollector) scan() {
 				zap.String("prefix", prefix),
 				zap.String("error", err.Error()),
 			)
+			listErr = err
 		}
 		log.Info("gc scan finish list object", zap.String("prefix", prefix), zap.Duration("time spent", time.Since(startTs)), zap.Int("keys", len(infoKeys)))
 		for i, infoKey := range infoKeys {
@@ -183,26 +286,200 @@ func (gc *garbageCollector) scan() {
 
 			// not found in meta, check last modified time exceeds tolerance duration
 			if time.Since(modTimes[i]) > gc.option.missingTolerance {
-				// ignore error since it could be cleaned up next time
-				removedKeys = append(removedKeys, infoKey)
-				err = gc.option.cli.Remove(ctx, infoKey)
-				if err != nil {
-					missing++
-					log.Error("failed to remove object",
-						zap.String("infoKey", infoKey),
-						zap.Error(err))
-				}
+				orphans = append(orphans, infoKey)
 			}
 		}
 	}
-	log.Info("scan file to do garbage collection",
+	log.Info("list orphan files",
 		zap.Int("total", total),
 		zap.Int("valid", valid),
 		zap.Int("missing", missing),
-		zap.Strings("removedKeys", removedKeys))
+		zap.Int("orphans", len(orphans)))
+	return orphans, listErr
 }
 
 func (gc *garbageCollector) clearEtcd() {
+	segments := gc.recyclableDroppedSegments()
+	for _, segment := range segments {
+		gc.status.addPendingFiles(len(getLogs(segment)))
+	}
+	partitions := make(map[int64]typeutil.UniqueSet)
+	for _, segment := range segments {
+		if gc.isPaused() {
+			log.Info("garbage collection paused, stop recycling dropped segments")
+			return
+		}
+		segInsertChannel := segment.GetInsertChannel()
+		logs := getLogs(segment)
+		log.Info("GC segment", zap.Int64("segmentID", segment.GetID()))
+		// the row IDs are read from the binlogs, keep them until the values stored aside are removed
+		if !gc.removeSidePayloads(segment, partitions) {
+			continue
+		}
+		if gc.removeLogs(logs) {
+			if err := gc.meta.DropSegment(segment.GetID()); err != nil {
+				log.Warn("failed to drop segment", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+			} else {
+				gc.status.recordRecycledSegment()
+				gc.option.audit.Record(&datapb.AuditEvent{
+					Type:         datapb.AuditEventType_SegmentRecycled,
+					CollectionID: segment.GetCollectionID(),
+					ChannelName:  segInsertChannel,
+					SegmentIDs:   []int64{segment.GetID()},
+					Detail:       fmt.Sprintf("removed %d binlog files", len(logs)),
+				})
+			}
+		}
+		if segList := gc.meta.GetSegmentsByChannel(segInsertChannel); len(segList) == 0 &&
+			!gc.meta.catalog.ChannelExists(context.Background(), segInsertChannel) {
+			log.Info("empty channel found during gc, manually cleanup channel checkpoints", zap.String("vChannel", segInsertChannel))
+
+			if err := gc.meta.DropChannelCheckpoint(segInsertChannel); err != nil {
+				// Fail-open as there's nothing to do.
+				log.Warn("failed to drop channel check point during segment garbage collection", zap.String("vchannel", segInsertChannel), zap.Error(err))
+			}
+		}
+	}
+}
+
+// removeSidePayloads removes the values stored aside of the rows of the dropped segment if its partition is dropped.
+// The rows of the segments dropped by compaction live on in the compacted segments, and the values of the deleted rows
+// are removed by the compaction, the ones of the dropped collections are removed by recycleSidePayloads.
+func (gc *garbageCollector) removeSidePayloads(segment *SegmentInfo, partitions map[int64]typeutil.UniqueSet) bool {
+	if gc.option.broker == nil {
+		return true
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	log := log.With(zap.Int64("collectionID", segment.GetCollectionID()),
+		zap.Int64("partitionID", segment.GetPartitionID()), zap.Int64("segmentID", segment.GetID()))
+
+	coll, err := gc.handler.GetCollection(ctx, segment.GetCollectionID())
+	if err != nil || coll == nil {
+		// the collection is dropped
+		return true
+	}
+	var fieldIDs []int64
+	for _, field := range coll.Schema.GetFields() {
+		if typeutil.IsSidePayloadField(field) {
+			fieldIDs = append(fieldIDs, field.GetFieldID())
+		}
+	}
+	if len(fieldIDs) == 0 {
+		return true
+	}
+
+	partitionIDs, ok := partitions[segment.GetCollectionID()]
+	if !ok {
+		ids, err := gc.option.broker.ShowPartitionsInternal(ctx, segment.GetCollectionID())
+		if err != nil {
+			log.Warn("failed to show partitions, retry the dropped segment later", zap.Error(err))
+			return false
+		}
+		partitionIDs = typeutil.NewUniqueSet(ids...)
+		partitions[segment.GetCollectionID()] = partitionIDs
+	}
+	if partitionIDs.Contain(segment.GetPartitionID()) {
+		return true
+	}
+
+	var keys []string
+	for _, fieldBinlog := range segment.GetBinlogs() {
+		if fieldBinlog.GetFieldID() != common.RowIDField {
+			continue
+		}
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			rowIDs, err := gc.readRowIDs(ctx, binlog.GetLogPath())
+			if err != nil {
+				log.Warn("failed to read row IDs, retry the dropped segment later", zap.String("logPath", binlog.GetLogPath()), zap.Error(err))
+				return false
+			}
+			for _, rowID := range rowIDs {
+				for _, fieldID := range fieldIDs {
+					keys = append(keys, metautil.BuildSidePayloadPath(gc.option.cli.RootPath(), segment.GetCollectionID(), fieldID, rowID))
+				}
+			}
+		}
+	}
+	if len(keys) == 0 {
+		return true
+	}
+	if err := gc.option.cli.MultiRemove(ctx, keys); err != nil {
+		log.Warn("failed to remove values stored aside, retry the dropped segment later", zap.Error(err))
+		return false
+	}
+	log.Info("values stored aside of the dropped partition removed", zap.Int("count", len(keys)))
+	return true
+}
+
+func (gc *garbageCollector) readRowIDs(ctx context.Context, logPath string) ([]int64, error) {
+	data, err := gc.option.cli.Read(ctx, logPath)
+	if err != nil {
+		return nil, err
+	}
+	reader, err := storage.NewBinlogReader(data)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	var rowIDs []int64
+	for {
+		eventReader, err := reader.NextEventReader()
+		if err != nil {
+			return nil, err
+		}
+		if eventReader == nil {
+			return rowIDs, nil
+		}
+		ids, err := eventReader.GetInt64FromPayload()
+		if err != nil {
+			return nil, err
+		}
+		rowIDs = append(rowIDs, ids...)
+	}
+}
+
+// recycleSidePayloads removes the values stored aside of the dropped collections.
+func (gc *garbageCollector) recycleSidePayloads() {
+	if gc.option.broker == nil {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	prefix := path.Join(gc.option.cli.RootPath(), common.SidePayloadPath) + "/"
+	keys, _, err := gc.option.cli.ListWithPrefix(ctx, prefix, false)
+	if err != nil {
+		log.Warn("failed to list values stored aside", zap.String("prefix", prefix), zap.Error(err))
+		return
+	}
+	for _, key := range keys {
+		if gc.isPaused() {
+			log.Info("garbage collection paused, stop recycling values stored aside")
+			return
+		}
+		collectionID, err := strconv.ParseInt(path.Base(key), 10, 64)
+		if err != nil {
+			continue
+		}
+		if !gc.option.maintenance.GCAllowed(collectionID) {
+			continue
+		}
+		has, err := gc.option.broker.HasCollection(ctx, collectionID)
+		if err != nil || has {
+			continue
+		}
+		if err := gc.option.cli.RemoveWithPrefix(ctx, metautil.BuildSidePayloadPrefix(gc.option.cli.RootPath(), collectionID)); err != nil {
+			log.Warn("failed to remove values stored aside of dropped collection", zap.Int64("collectionID", collectionID), zap.Error(err))
+			continue
+		}
+		log.Info("values stored aside of dropped collection removed", zap.Int64("collectionID", collectionID))
+	}
+}
+
+// recyclableDroppedSegments returns the dropped segments whose files and meta could be recycled, ordered by ID.
+func (gc *garbageCollector) recyclableDroppedSegments() []*SegmentInfo {
 	all := gc.meta.SelectSegments(func(si *SegmentInfo) bool { return true })
 	drops := make(map[int64]*SegmentInfo, 0)
 	compactTo := make(map[int64]*SegmentInfo)
@@ -242,6 +519,7 @@ func (gc *garbageCollector) clearEtcd() {
 		return dropIDs[i] < dropIDs[j]
 	})
 
+	var recyclable []*SegmentInfo
 	for _, segmentID := range dropIDs {
 		segment, ok := drops[segmentID]
 		if !ok {
@@ -255,6 +533,9 @@ func (gc *garbageCollector) clearEtcd() {
 		if !isCompacted && !gc.isExpire(segment.GetDroppedAt()) {
 			continue
 		}
+		if !gc.option.maintenance.GCAllowed(segment.GetCollectionID()) {
+			continue
+		}
 		segInsertChannel := segment.GetInsertChannel()
 		// Ignore segments from potentially dropped collection. Check if collection is to be dropped by checking if channel is dropped.
 		// We do this because collection meta drop relies on all segment being GCed.
@@ -276,23 +557,46 @@ func (gc *garbageCollector) clearEtcd() {
 					zap.Int64("segmentID", to.GetID()))
 			continue
 		}
-		logs := getLogs(segment)
-		log.Info("GC segment", zap.Int64("segmentID", segment.GetID()))
-		if gc.removeLogs(logs) {
-			if err := gc.meta.DropSegment(segment.GetID()); err != nil {
-				log.Warn("failed to drop segment", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
-			}
+		recyclable = append(recyclable, segment)
+	}
+	return recyclable
+}
+
+// dryRun reports the orphan files and the dropped segments which would be recycled by the garbage collection
+// with the current meta, without deleting anything.
+func (gc *garbageCollector) dryRun(ctx context.Context) (*datapb.GcDryRunResponse, error) {
+	resp := &datapb.GcDryRunResponse{}
+	orphans, err := gc.listOrphanFiles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, infoKey := range orphans {
+		size, err := gc.option.cli.Size(ctx, infoKey)
+		if err != nil {
+			// the file may be removed after listing
+			log.Warn("failed to get size of orphan file", zap.String("infoKey", infoKey), zap.Error(err))
+			continue
 		}
-		if segList := gc.meta.GetSegmentsByChannel(segInsertChannel); len(segList) == 0 &&
-			!gc.meta.catalog.ChannelExists(context.Background(), segInsertChannel) {
-			log.Info("empty channel found during gc, manually cleanup channel checkpoints", zap.String("vChannel", segInsertChannel))
+		resp.OrphanFiles = append(resp.OrphanFiles, &datapb.GcOrphanFile{Path: infoKey, Size: size})
+		resp.ReclaimableBytes += size
+	}
 
-			if err := gc.meta.DropChannelCheckpoint(segInsertChannel); err != nil {
-				// Fail-open as there's nothing to do.
-				log.Warn("failed to drop channel check point during segment garbage collection", zap.String("vchannel", segInsertChannel), zap.Error(err))
-			}
+	for _, segment := range gc.recyclableDroppedSegments() {
+		dropped := &datapb.GcDroppedSegment{
+			SegmentID:    segment.GetID(),
+			CollectionID: segment.GetCollectionID(),
+			PartitionID:  segment.GetPartitionID(),
+		}
+		for _, l := range getLogs(segment) {
+			dropped.Files = append(dropped.Files, l.GetLogPath())
+			dropped.Size += l.GetLogSize()
 		}
+		resp.DroppedSegments = append(resp.DroppedSegments, dropped)
+		resp.ReclaimableBytes += dropped.Size
 	}
+	log.Info("gc dry run", zap.Int("orphanFiles", len(resp.OrphanFiles)),
+		zap.Int("droppedSegments", len(resp.DroppedSegments)), zap.Int64("reclaimableBytes", resp.ReclaimableBytes))
+	return resp, nil
 }
 
 func (gc *garbageCollector) isExpire(dropts Timestamp) bool {
@@ -326,13 +630,17 @@ func (gc *garbageCollector) removeLogs(logs []*datapb.Binlog) bool {
 			switch err.(type) {
 			case minio.ErrorResponse:
 				errResp := minio.ToErrorResponse(err)
-				if errResp.Code != "" && errResp.Code != "NoSuchKey" {
+				if errResp.Code == "" || errResp.Code == "NoSuchKey" {
+					// removed already
+					err = nil
+				} else {
 					delFlag = false
 				}
 			default:
 				delFlag = false
 			}
 		}
+		gc.status.recordRemoval(err)
 	}
 	return delFlag
 }
@@ -341,6 +649,9 @@ func (gc *garbageCollector) recycleUnusedIndexes() {
 	log.Info("start recycleUnusedIndexes")
 	deletedIndexes := gc.meta.GetDeletedIndexes()
 	for _, index := range deletedIndexes {
+		if !gc.option.maintenance.GCAllowed(index.CollectionID) {
+			continue
+		}
 		if err := gc.meta.RemoveIndex(index.CollectionID, index.IndexID); err != nil {
 			log.Warn("remove index on collection fail", zap.Int64("collectionID", index.CollectionID),
 				zap.Int64("indexID", index.IndexID), zap.Error(err))
@@ -352,7 +663,11 @@ func (gc *garbageCollector) recycleUnusedIndexes() {
 func (gc *garbageCollector) recycleUnusedSegIndexes() {
 	segIndexes := gc.meta.GetAllSegIndexes()
 	for _, segIdx := range segIndexes {
-		if gc.meta.GetSegment(segIdx.SegmentID) == nil || !gc.meta.IsIndexExist(segIdx.CollectionID, segIdx.IndexID) {
+		if !gc.option.maintenance.GCAllowed(segIdx.CollectionID) {
+			continue
+		}
+		if gc.meta.GetSegment(segIdx.SegmentID) == nil || !gc.meta.IsIndexExist(segIdx.CollectionID, segIdx.IndexID) ||
+			gc.meta.IsReplacedSegmentIndex(segIdx) {
 			if err := gc.meta.RemoveSegmentIndex(segIdx.CollectionID, segIdx.PartitionID, segIdx.SegmentID, segIdx.IndexID, segIdx.BuildID); err != nil {
 				log.Warn("delete index meta from etcd failed, wait to retry", zap.Int64("buildID", segIdx.BuildID),
 					zap.Int64("segmentID", segIdx.SegmentID), zap.Int64("nodeID", segIdx.NodeID), zap.Error(err))
@@ -407,6 +722,9 @@ func (gc *garbageCollector) recycleUnusedIndexFiles() {
 				zap.Int64("buildID", buildID), zap.String("prefix", key))
 			continue
 		}
+		if !gc.option.maintenance.GCAllowed(segIdx.CollectionID) {
+			continue
+		}
 		filesMap := make(map[string]struct{})
 		for _, fileID := range segIdx.IndexFileKeys {
 			filepath := metautil.BuildSegmentIndexFilePath(gc.option.cli.RootPath(), segIdx.BuildID, segIdx.IndexVersion,
@@ -424,7 +742,9 @@ func (gc *garbageCollector) recycleUnusedIndexFiles() {
 		deletedFilesNum := 0
 		for _, file := range files {
 			if _, ok := filesMap[file]; !ok {
-				if err = gc.option.cli.Remove(ctx, file); err != nil {
+				err = gc.option.cli.Remove(ctx, file)
+				gc.status.recordRemoval(err)
+				if err != nil {
 					log.Warn("garbageCollector recycleUnusedIndexFiles remove file failed",
 						zap.Int64("buildID", buildID), zap.String("file", file), zap.Error(err))
 					continue
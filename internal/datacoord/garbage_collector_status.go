@@ -0,0 +1,110 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+// gcStatus is the in-flight status of the garbage collector and the statistics of its runs,
+// the pausing is held by the maintenance policies instead.
+// The zero value is an idle garbage collector.
+type gcStatus struct {
+	mu           sync.RWMutex
+	phase        datapb.GcPhase
+	pendingFiles int64
+	currentRun   *datapb.GcRunStats
+	lastRun      *datapb.GcRunStats
+}
+
+func (s *gcStatus) startRun() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.currentRun = &datapb.GcRunStats{StartTime: time.Now().UnixMilli()}
+}
+
+func (s *gcStatus) finishRun(interrupted bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.currentRun == nil {
+		return
+	}
+	s.currentRun.EndTime = time.Now().UnixMilli()
+	s.currentRun.Interrupted = interrupted
+	s.lastRun = s.currentRun
+	s.currentRun = nil
+	s.phase = datapb.GcPhase_GcIdle
+	s.pendingFiles = 0
+}
+
+// enterPhase switches to the phase, the pending files are reset since they belong to the previous phase.
+func (s *gcStatus) enterPhase(phase datapb.GcPhase) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.phase = phase
+	s.pendingFiles = 0
+}
+
+func (s *gcStatus) addPendingFiles(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pendingFiles += int64(n)
+}
+
+// recordRemoval records the result of removing a pending file.
+func (s *gcStatus) recordRemoval(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.pendingFiles > 0 {
+		s.pendingFiles--
+	}
+	if s.currentRun == nil {
+		return
+	}
+	if err != nil {
+		s.currentRun.FailedFiles++
+	} else {
+		s.currentRun.RemovedFiles++
+	}
+}
+
+func (s *gcStatus) recordRecycledSegment() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.currentRun != nil {
+		s.currentRun.RecycledSegments++
+	}
+}
+
+// fill fills the status into the response.
+func (s *gcStatus) fill(resp *datapb.GetGCStatusResponse) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	resp.Phase = s.phase
+	resp.PendingFiles = s.pendingFiles
+	if s.currentRun != nil {
+		resp.CurrentRun = proto.Clone(s.currentRun).(*datapb.GcRunStats)
+	}
+	if s.lastRun != nil {
+		resp.LastRun = proto.Clone(s.lastRun).(*datapb.GcRunStats)
+	}
+}
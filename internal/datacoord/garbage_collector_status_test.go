@@ -0,0 +1,64 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+func Test_gcStatus(t *testing.T) {
+	t.Run("run stats", func(t *testing.T) {
+		s := &gcStatus{}
+		// no run in flight
+		s.recordRemoval(nil)
+		s.recordRecycledSegment()
+
+		s.startRun()
+		s.enterPhase(datapb.GcPhase_GcRecyclingSegments)
+		s.addPendingFiles(3)
+		s.recordRemoval(nil)
+		s.recordRemoval(errors.New("mock"))
+		s.recordRecycledSegment()
+
+		resp := &datapb.GetGCStatusResponse{}
+		s.fill(resp)
+		assert.Equal(t, datapb.GcPhase_GcRecyclingSegments, resp.GetPhase())
+		assert.EqualValues(t, 1, resp.GetPendingFiles())
+		assert.EqualValues(t, 1, resp.GetCurrentRun().GetRemovedFiles())
+		assert.EqualValues(t, 1, resp.GetCurrentRun().GetFailedFiles())
+		assert.EqualValues(t, 1, resp.GetCurrentRun().GetRecycledSegments())
+		assert.Nil(t, resp.GetLastRun())
+
+		s.enterPhase(datapb.GcPhase_GcScanningOrphans)
+		s.fill(resp)
+		assert.Zero(t, resp.GetPendingFiles())
+
+		s.finishRun(true)
+		resp = &datapb.GetGCStatusResponse{}
+		s.fill(resp)
+		assert.Equal(t, datapb.GcPhase_GcIdle, resp.GetPhase())
+		assert.Nil(t, resp.GetCurrentRun())
+		assert.True(t, resp.GetLastRun().GetInterrupted())
+		assert.EqualValues(t, 1, resp.GetLastRun().GetRemovedFiles())
+		assert.GreaterOrEqual(t, resp.GetLastRun().GetEndTime(), resp.GetLastRun().GetStartTime())
+	})
+}
@@ -34,6 +34,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	kvmocks "github.com/milvus-io/milvus/internal/kv/mocks"
@@ -45,7 +46,10 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
 )
 
 func Test_garbageCollector_basic(t *testing.T) {
@@ -418,6 +422,20 @@ func TestGarbageCollector_recycleUnusedIndexes(t *testing.T) {
 		}
 		gc.recycleUnusedIndexes()
 	})
+
+	t.Run("paused", func(t *testing.T) {
+		// no index is dropped from the catalog
+		catalog := catalogmocks.NewDataCoordCatalog(t)
+		gc := &garbageCollector{
+			option: GcOption{
+				maintenance: &maintenanceManager{policies: map[UniqueID]*maintenancePolicy{
+					100: {MaintenancePolicy: &datapb.MaintenancePolicy{CollectionID: 100, GcPaused: true}},
+				}},
+			},
+			meta: createMetaForRecycleUnusedIndexes(catalog),
+		}
+		gc.recycleUnusedIndexes()
+	})
 }
 
 func createMetaForRecycleUnusedSegIndexes(catalog metastore.DataCoordCatalog) *meta {
@@ -434,61 +452,59 @@ func createMetaForRecycleUnusedSegIndexes(catalog metastore.DataCoordCatalog) *m
 		ctx:         ctx,
 		catalog:     catalog,
 		collections: nil,
-		segments: &SegmentsInfo{
-			segments: map[UniqueID]*SegmentInfo{
-				segID: {
-					SegmentInfo: &datapb.SegmentInfo{
-						ID:            segID,
+		segments: buildSegmentsInfo(map[UniqueID]*SegmentInfo{
+			segID: {
+				SegmentInfo: &datapb.SegmentInfo{
+					ID:            segID,
+					CollectionID:  collID,
+					PartitionID:   partID,
+					InsertChannel: "",
+					NumOfRows:     1026,
+					State:         commonpb.SegmentState_Flushed,
+				},
+				segmentIndexes: map[UniqueID]*model.SegmentIndex{
+					indexID: {
+						SegmentID:     segID,
 						CollectionID:  collID,
 						PartitionID:   partID,
-						InsertChannel: "",
-						NumOfRows:     1026,
-						State:         commonpb.SegmentState_Flushed,
-					},
-					segmentIndexes: map[UniqueID]*model.SegmentIndex{
-						indexID: {
-							SegmentID:     segID,
-							CollectionID:  collID,
-							PartitionID:   partID,
-							NumRows:       1026,
-							IndexID:       indexID,
-							BuildID:       buildID,
-							NodeID:        1,
-							IndexVersion:  1,
-							IndexState:    commonpb.IndexState_Finished,
-							FailReason:    "",
-							IsDeleted:     false,
-							CreateTime:    10,
-							IndexFileKeys: []string{"file1", "file2"},
-							IndexSize:     0,
-							WriteHandoff:  false,
-						},
+						NumRows:       1026,
+						IndexID:       indexID,
+						BuildID:       buildID,
+						NodeID:        1,
+						IndexVersion:  1,
+						IndexState:    commonpb.IndexState_Finished,
+						FailReason:    "",
+						IsDeleted:     false,
+						CreateTime:    10,
+						IndexFileKeys: []string{"file1", "file2"},
+						IndexSize:     0,
+						WriteHandoff:  false,
 					},
 				},
-				segID + 1: {
-					SegmentInfo: nil,
-					segmentIndexes: map[UniqueID]*model.SegmentIndex{
-						indexID: {
-							SegmentID:     segID + 1,
-							CollectionID:  collID,
-							PartitionID:   partID,
-							NumRows:       1026,
-							IndexID:       indexID,
-							BuildID:       buildID + 1,
-							NodeID:        1,
-							IndexVersion:  1,
-							IndexState:    commonpb.IndexState_Finished,
-							FailReason:    "",
-							IsDeleted:     false,
-							CreateTime:    10,
-							IndexFileKeys: []string{"file1", "file2"},
-							IndexSize:     0,
-							WriteHandoff:  false,
-						},
+			},
+			segID + 1: {
+				SegmentInfo: nil,
+				segmentIndexes: map[UniqueID]*model.SegmentIndex{
+					indexID: {
+						SegmentID:     segID + 1,
+						CollectionID:  collID,
+						PartitionID:   partID,
+						NumRows:       1026,
+						IndexID:       indexID,
+						BuildID:       buildID + 1,
+						NodeID:        1,
+						IndexVersion:  1,
+						IndexState:    commonpb.IndexState_Finished,
+						FailReason:    "",
+						IsDeleted:     false,
+						CreateTime:    10,
+						IndexFileKeys: []string{"file1", "file2"},
+						IndexSize:     0,
+						WriteHandoff:  false,
 					},
 				},
 			},
-		},
+		}),
 		channelCPs:   nil,
 		chunkManager: nil,
 		indexes:      map[UniqueID]map[UniqueID]*model.Index{},
@@ -561,6 +577,20 @@ func TestGarbageCollector_recycleUnusedSegIndexes(t *testing.T) {
 		}
 		gc.recycleUnusedSegIndexes()
 	})
+
+	t.Run("paused", func(t *testing.T) {
+		// no segment index is dropped from the catalog
+		catalog := catalogmocks.NewDataCoordCatalog(t)
+		gc := &garbageCollector{
+			option: GcOption{
+				maintenance: &maintenanceManager{policies: map[UniqueID]*maintenancePolicy{
+					100: {MaintenancePolicy: &datapb.MaintenancePolicy{CollectionID: 100, GcPaused: true}},
+				}},
+			},
+			meta: createMetaForRecycleUnusedSegIndexes(catalog),
+		}
+		gc.recycleUnusedSegIndexes()
+	})
 }
 
 func createMetaTableForRecycleUnusedIndexFiles(catalog *datacoord.Catalog) *meta {
@@ -578,68 +608,66 @@ func createMetaTableForRecycleUnusedIndexFiles(catalog *datacoord.Catalog) *meta
 		ctx:         ctx,
 		catalog:     catalog,
 		collections: nil,
-		segments: &SegmentsInfo{
-			segments: map[UniqueID]*SegmentInfo{
-				segID: {
-					SegmentInfo: &datapb.SegmentInfo{
-						ID:            segID,
+		segments: buildSegmentsInfo(map[UniqueID]*SegmentInfo{
+			segID: {
+				SegmentInfo: &datapb.SegmentInfo{
+					ID:            segID,
+					CollectionID:  collID,
+					PartitionID:   partID,
+					InsertChannel: "",
+					NumOfRows:     1026,
+					State:         commonpb.SegmentState_Flushed,
+				},
+				segmentIndexes: map[UniqueID]*model.SegmentIndex{
+					indexID: {
+						SegmentID:     segID,
 						CollectionID:  collID,
 						PartitionID:   partID,
-						InsertChannel: "",
-						NumOfRows:     1026,
-						State:         commonpb.SegmentState_Flushed,
-					},
-					segmentIndexes: map[UniqueID]*model.SegmentIndex{
-						indexID: {
-							SegmentID:     segID,
-							CollectionID:  collID,
-							PartitionID:   partID,
-							NumRows:       1026,
-							IndexID:       indexID,
-							BuildID:       buildID,
-							NodeID:        1,
-							IndexVersion:  1,
-							IndexState:    commonpb.IndexState_Finished,
-							FailReason:    "",
-							IsDeleted:     false,
-							CreateTime:    10,
-							IndexFileKeys: []string{"file1", "file2"},
-							IndexSize:     0,
-							WriteHandoff:  false,
-						},
+						NumRows:       1026,
+						IndexID:       indexID,
+						BuildID:       buildID,
+						NodeID:        1,
+						IndexVersion:  1,
+						IndexState:    commonpb.IndexState_Finished,
+						FailReason:    "",
+						IsDeleted:     false,
+						CreateTime:    10,
+						IndexFileKeys: []string{"file1", "file2"},
+						IndexSize:     0,
+						WriteHandoff:  false,
 					},
 				},
-				segID + 1: {
-					SegmentInfo: &datapb.SegmentInfo{
-						ID:            segID + 1,
+			},
+			segID + 1: {
+				SegmentInfo: &datapb.SegmentInfo{
+					ID:            segID + 1,
+					CollectionID:  collID,
+					PartitionID:   partID,
+					InsertChannel: "",
+					NumOfRows:     1026,
+					State:         commonpb.SegmentState_Flushed,
+				},
+				segmentIndexes: map[UniqueID]*model.SegmentIndex{
+					indexID: {
+						SegmentID:     segID + 1,
 						CollectionID:  collID,
 						PartitionID:   partID,
-						InsertChannel: "",
-						NumOfRows:     1026,
-						State:         commonpb.SegmentState_Flushed,
-					},
-					segmentIndexes: map[UniqueID]*model.SegmentIndex{
-						indexID: {
-							SegmentID:     segID + 1,
-							CollectionID:  collID,
-							PartitionID:   partID,
-							NumRows:       1026,
-							IndexID:       indexID,
-							BuildID:       buildID + 1,
-							NodeID:        1,
-							IndexVersion:  1,
-							IndexState:    commonpb.IndexState_InProgress,
-							FailReason:    "",
-							IsDeleted:     false,
-							CreateTime:    10,
-							IndexFileKeys: nil,
-							IndexSize:     0,
-							WriteHandoff:  false,
-						},
+						NumRows:       1026,
+						IndexID:       indexID,
+						BuildID:       buildID + 1,
+						NodeID:        1,
+						IndexVersion:  1,
+						IndexState:    commonpb.IndexState_InProgress,
+						FailReason:    "",
+						IsDeleted:     false,
+						CreateTime:    10,
+						IndexFileKeys: nil,
+						IndexSize:     0,
+						WriteHandoff:  false,
 					},
 				},
 			},
-		},
+		}),
 		indexes: map[UniqueID]map[UniqueID]*model.Index{
 			collID: {
 				indexID: {
@@ -786,131 +814,129 @@ func TestGarbageCollector_clearETCD(t *testing.T) {
 				Timestamp: 1000,
 			},
 		},
-		segments: &SegmentsInfo{
-			map[UniqueID]*SegmentInfo{
-				segID: {
-					SegmentInfo: &datapb.SegmentInfo{
-						ID:            segID,
+		segments: buildSegmentsInfo(map[UniqueID]*SegmentInfo{
+			segID: {
+				SegmentInfo: &datapb.SegmentInfo{
+					ID:            segID,
+					CollectionID:  collID,
+					PartitionID:   partID,
+					InsertChannel: "",
+					NumOfRows:     5000,
+					State:         commonpb.SegmentState_Dropped,
+					MaxRowNum:     65536,
+					DroppedAt:     0,
+				},
+				segmentIndexes: map[UniqueID]*model.SegmentIndex{
+					indexID: {
+						SegmentID:     segID,
 						CollectionID:  collID,
 						PartitionID:   partID,
-						InsertChannel: "",
-						NumOfRows:     5000,
-						State:         commonpb.SegmentState_Dropped,
-						MaxRowNum:     65536,
-						DroppedAt:     0,
-					},
-					segmentIndexes: map[UniqueID]*model.SegmentIndex{
-						indexID: {
-							SegmentID:     segID,
-							CollectionID:  collID,
-							PartitionID:   partID,
-							NumRows:       5000,
-							IndexID:       indexID,
-							BuildID:       buildID,
-							NodeID:        0,
-							IndexVersion:  1,
-							IndexState:    commonpb.IndexState_Finished,
-							FailReason:    "",
-							IsDeleted:     false,
-							CreateTime:    0,
-							IndexFileKeys: []string{"file1", "file2"},
-							IndexSize:     1024,
-							WriteHandoff:  false,
-						},
+						NumRows:       5000,
+						IndexID:       indexID,
+						BuildID:       buildID,
+						NodeID:        0,
+						IndexVersion:  1,
+						IndexState:    commonpb.IndexState_Finished,
+						FailReason:    "",
+						IsDeleted:     false,
+						CreateTime:    0,
+						IndexFileKeys: []string{"file1", "file2"},
+						IndexSize:     1024,
+						WriteHandoff:  false,
 					},
 				},
-				segID + 1: {
-					SegmentInfo: &datapb.SegmentInfo{
-						ID:            segID + 1,
+			},
+			segID + 1: {
+				SegmentInfo: &datapb.SegmentInfo{
+					ID:            segID + 1,
+					CollectionID:  collID,
+					PartitionID:   partID,
+					InsertChannel: "",
+					NumOfRows:     5000,
+					State:         commonpb.SegmentState_Dropped,
+					MaxRowNum:     65536,
+					DroppedAt:     0,
+				},
+				segmentIndexes: map[UniqueID]*model.SegmentIndex{
+					indexID: {
+						SegmentID:     segID + 1,
 						CollectionID:  collID,
 						PartitionID:   partID,
-						InsertChannel: "",
-						NumOfRows:     5000,
-						State:         commonpb.SegmentState_Dropped,
-						MaxRowNum:     65536,
-						DroppedAt:     0,
-					},
-					segmentIndexes: map[UniqueID]*model.SegmentIndex{
-						indexID: {
-							SegmentID:     segID + 1,
-							CollectionID:  collID,
-							PartitionID:   partID,
-							NumRows:       5000,
-							IndexID:       indexID,
-							BuildID:       buildID + 1,
-							NodeID:        0,
-							IndexVersion:  1,
-							IndexState:    commonpb.IndexState_Finished,
-							FailReason:    "",
-							IsDeleted:     false,
-							CreateTime:    0,
-							IndexFileKeys: []string{"file3", "file4"},
-							IndexSize:     1024,
-							WriteHandoff:  false,
-						},
+						NumRows:       5000,
+						IndexID:       indexID,
+						BuildID:       buildID + 1,
+						NodeID:        0,
+						IndexVersion:  1,
+						IndexState:    commonpb.IndexState_Finished,
+						FailReason:    "",
+						IsDeleted:     false,
+						CreateTime:    0,
+						IndexFileKeys: []string{"file3", "file4"},
+						IndexSize:     1024,
+						WriteHandoff:  false,
 					},
 				},
-				segID + 2: {
-					SegmentInfo: &datapb.SegmentInfo{
-						ID:             segID + 2,
-						CollectionID:   collID,
-						PartitionID:    partID,
-						InsertChannel:  "",
-						NumOfRows:      10000,
-						State:          commonpb.SegmentState_Dropped,
-						MaxRowNum:      65536,
-						DroppedAt:      10,
-						CompactionFrom: []int64{segID, segID + 1},
-					},
-					segmentIndexes: map[UniqueID]*model.SegmentIndex{},
+			},
+			segID + 2: {
+				SegmentInfo: &datapb.SegmentInfo{
+					ID:             segID + 2,
+					CollectionID:   collID,
+					PartitionID:    partID,
+					InsertChannel:  "",
+					NumOfRows:      10000,
+					State:          commonpb.SegmentState_Dropped,
+					MaxRowNum:      65536,
+					DroppedAt:      10,
+					CompactionFrom: []int64{segID, segID + 1},
 				},
-				segID + 3: {
-					SegmentInfo: &datapb.SegmentInfo{
-						ID:             segID + 3,
-						CollectionID:   collID,
-						PartitionID:    partID,
-						InsertChannel:  "",
-						NumOfRows:      2000,
-						State:          commonpb.SegmentState_Dropped,
-						MaxRowNum:      65536,
-						DroppedAt:      10,
-						CompactionFrom: nil,
-					},
-					segmentIndexes: map[UniqueID]*model.SegmentIndex{},
+				segmentIndexes: map[UniqueID]*model.SegmentIndex{},
+			},
+			segID + 3: {
+				SegmentInfo: &datapb.SegmentInfo{
+					ID:             segID + 3,
+					CollectionID:   collID,
+					PartitionID:    partID,
+					InsertChannel:  "",
+					NumOfRows:      2000,
+					State:          commonpb.SegmentState_Dropped,
+					MaxRowNum:      65536,
+					DroppedAt:      10,
+					CompactionFrom: nil,
 				},
-				segID + 4: {
-					SegmentInfo: &datapb.SegmentInfo{
-						ID:             segID + 4,
-						CollectionID:   collID,
-						PartitionID:    partID,
-						InsertChannel:  "",
-						NumOfRows:      12000,
-						State:          commonpb.SegmentState_Flushed,
-						MaxRowNum:      65536,
-						DroppedAt:      10,
-						CompactionFrom: []int64{segID + 2, segID + 3},
-					},
-					segmentIndexes: map[UniqueID]*model.SegmentIndex{},
+				segmentIndexes: map[UniqueID]*model.SegmentIndex{},
+			},
+			segID + 4: {
+				SegmentInfo: &datapb.SegmentInfo{
+					ID:             segID + 4,
+					CollectionID:   collID,
+					PartitionID:    partID,
+					InsertChannel:  "",
+					NumOfRows:      12000,
+					State:          commonpb.SegmentState_Flushed,
+					MaxRowNum:      65536,
+					DroppedAt:      10,
+					CompactionFrom: []int64{segID + 2, segID + 3},
 				},
-				// before channel cp,
-				segID + 5: {
-					SegmentInfo: &datapb.SegmentInfo{
-						ID:             segID + 5,
-						CollectionID:   collID,
-						PartitionID:    partID,
-						InsertChannel:  "dmlChannel",
-						NumOfRows:      2000,
-						State:          commonpb.SegmentState_Dropped,
-						MaxRowNum:      65535,
-						DroppedAt:      0,
-						CompactionFrom: nil,
-						DmlPosition: &msgpb.MsgPosition{
-							Timestamp: 1200,
-						},
+				segmentIndexes: map[UniqueID]*model.SegmentIndex{},
+			},
+			// before channel cp,
+			segID + 5: {
+				SegmentInfo: &datapb.SegmentInfo{
+					ID:             segID + 5,
+					CollectionID:   collID,
+					PartitionID:    partID,
+					InsertChannel:  "dmlChannel",
+					NumOfRows:      2000,
+					State:          commonpb.SegmentState_Dropped,
+					MaxRowNum:      65535,
+					DroppedAt:      0,
+					CompactionFrom: nil,
+					DmlPosition: &msgpb.MsgPosition{
+						Timestamp: 1200,
 					},
 				},
 			},
-		},
+		}),
 		buildID2SegmentIndex: map[UniqueID]*model.SegmentIndex{
 			buildID: {
 				SegmentID:     segID,
@@ -1058,3 +1084,205 @@ func TestGarbageCollector_clearETCD(t *testing.T) {
 	assert.Nil(t, segF)
 
 }
+
+func TestGarbageCollector_dryRun(t *testing.T) {
+	meta, err := newMemoryMeta()
+	require.NoError(t, err)
+
+	referenced := metautil.BuildInsertLogPath("root", 10, 100, 1, 1000, 1)
+	orphan := metautil.BuildInsertLogPath("root", 10, 100, 3, 1000, 1)
+	segment := buildSegment(10, 100, 1, "ch", false)
+	segment.State = commonpb.SegmentState_Flushed
+	segment.Binlogs = []*datapb.FieldBinlog{getFieldBinlogPaths(1000, referenced)}
+	require.NoError(t, meta.AddSegment(segment))
+
+	dropped := buildSegment(10, 100, 2, "ch", false)
+	dropped.State = commonpb.SegmentState_Dropped
+	dropped.Binlogs = []*datapb.FieldBinlog{{FieldID: 1000, Binlogs: []*datapb.Binlog{{LogPath: "dropped/insert", LogSize: 10}}}}
+	dropped.Statslogs = []*datapb.FieldBinlog{{FieldID: 1000, Binlogs: []*datapb.Binlog{{LogPath: "dropped/stats", LogSize: 5}}}}
+	require.NoError(t, meta.AddSegment(dropped))
+
+	t.Run("normal case", func(t *testing.T) {
+		// no file is removed
+		cm := mocks.NewChunkManager(t)
+		cm.EXPECT().RootPath().Return("root")
+		cm.EXPECT().ListWithPrefix(mock.Anything, path.Join("root", insertLogPrefix), true).
+			Return([]string{referenced, orphan}, []time.Time{time.Now().Add(-time.Hour), time.Now().Add(-time.Hour)}, nil)
+		cm.EXPECT().ListWithPrefix(mock.Anything, mock.Anything, true).Return(nil, nil, nil)
+		cm.EXPECT().Size(mock.Anything, orphan).Return(100, nil)
+
+		gc := newGarbageCollector(meta, newMockHandler(), GcOption{
+			cli:              cm,
+			missingTolerance: time.Minute,
+			dropTolerance:    time.Minute,
+		})
+		resp, err := gc.dryRun(context.TODO())
+		assert.NoError(t, err)
+		assert.Len(t, resp.GetOrphanFiles(), 1)
+		assert.Equal(t, orphan, resp.GetOrphanFiles()[0].GetPath())
+		assert.EqualValues(t, 100, resp.GetOrphanFiles()[0].GetSize())
+		assert.Len(t, resp.GetDroppedSegments(), 1)
+		assert.EqualValues(t, 2, resp.GetDroppedSegments()[0].GetSegmentID())
+		assert.ElementsMatch(t, []string{"dropped/insert", "dropped/stats"}, resp.GetDroppedSegments()[0].GetFiles())
+		assert.EqualValues(t, 15, resp.GetDroppedSegments()[0].GetSize())
+		assert.EqualValues(t, 115, resp.GetReclaimableBytes())
+		assert.NotNil(t, meta.GetSegment(2))
+	})
+
+	t.Run("list fail", func(t *testing.T) {
+		cm := mocks.NewChunkManager(t)
+		cm.EXPECT().RootPath().Return("root")
+		cm.EXPECT().ListWithPrefix(mock.Anything, mock.Anything, true).Return(nil, nil, errors.New("mock"))
+
+		gc := newGarbageCollector(meta, newMockHandler(), GcOption{
+			cli:              cm,
+			missingTolerance: time.Minute,
+			dropTolerance:    time.Minute,
+		})
+		_, err := gc.dryRun(context.TODO())
+		assert.Error(t, err)
+	})
+}
+
+func TestGarbageCollector_pause(t *testing.T) {
+	meta, err := newMemoryMeta()
+	require.NoError(t, err)
+	dropped := buildSegment(10, 100, 1, "ch", false)
+	dropped.State = commonpb.SegmentState_Dropped
+	dropped.Binlogs = []*datapb.FieldBinlog{{FieldID: 1000, Binlogs: []*datapb.Binlog{{LogPath: "dropped/insert1"}, {LogPath: "dropped/insert2"}}}}
+	require.NoError(t, meta.AddSegment(dropped))
+
+	ctx := context.Background()
+	catalog := mocks.NewDataCoordCatalog(t)
+	catalog.EXPECT().ListMaintenancePolicies(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().SaveMaintenancePolicy(mock.Anything, mock.Anything).Return(nil)
+	catalog.EXPECT().DropMaintenancePolicy(mock.Anything, mock.Anything).Return(nil)
+	maintenance, err := newMaintenanceManager(ctx, catalog)
+	require.NoError(t, err)
+
+	cm := mocks.NewChunkManager(t)
+	gc := newGarbageCollector(meta, newMockHandler(), GcOption{
+		cli:           cm,
+		dropTolerance: time.Minute,
+		maintenance:   maintenance,
+	})
+
+	t.Run("interrupted run", func(t *testing.T) {
+		// paused while recycling the dropped segment, the following phases are skipped
+		cm.EXPECT().Remove(mock.Anything, mock.Anything).Run(func(ctx context.Context, filePath string) {
+			maintenance.PauseGC(ctx, time.Time{})
+		}).Return(nil).Twice()
+		gc.run()
+		defer maintenance.ResumeGC(ctx)
+
+		assert.True(t, gc.isPaused())
+		resp := &datapb.GetGCStatusResponse{}
+		gc.status.fill(resp)
+		assert.Equal(t, datapb.GcPhase_GcIdle, resp.GetPhase())
+		assert.True(t, resp.GetLastRun().GetInterrupted())
+		assert.EqualValues(t, 2, resp.GetLastRun().GetRemovedFiles())
+		assert.EqualValues(t, 1, resp.GetLastRun().GetRecycledSegments())
+		assert.Nil(t, meta.GetSegment(dropped.GetID()))
+	})
+
+	t.Run("wait for in-flight run", func(t *testing.T) {
+		// a run in flight
+		gc.runCh <- struct{}{}
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		assert.ErrorIs(t, gc.waitForRun(ctx), context.DeadlineExceeded)
+
+		<-gc.runCh
+		assert.NoError(t, gc.waitForRun(context.Background()))
+	})
+}
+
+func TestGarbageCollector_sidePayloads(t *testing.T) {
+	ctx := context.Background()
+	cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	root := cm.RootPath()
+
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "text", DataType: schemapb.DataType_VarChar, TypeParams: []*commonpb.KeyValuePair{
+				{Key: common.SidePayloadKey, Value: "true"},
+			}},
+		},
+	}
+	writeRowIDs := func(partitionID, segmentID int64, rowIDs ...int64) []*datapb.FieldBinlog {
+		w := storage.NewInsertBinlogWriter(schemapb.DataType_Int64, 10, partitionID, segmentID, common.RowIDField)
+		defer w.Close()
+		evt, err := w.NextInsertEventWriter()
+		require.NoError(t, err)
+		evt.SetEventTimestamp(100, 200)
+		w.SetEventTimeStamp(100, 200)
+		require.NoError(t, evt.AddInt64ToPayload(rowIDs))
+		w.AddExtra("original_size", strconv.Itoa(len(rowIDs)*8))
+		require.NoError(t, w.Finish())
+		buf, err := w.GetBuffer()
+		require.NoError(t, err)
+		logPath := metautil.BuildInsertLogPath(root, 10, partitionID, segmentID, common.RowIDField, 1)
+		require.NoError(t, cm.Write(ctx, logPath, buf))
+		return []*datapb.FieldBinlog{getFieldBinlogPaths(common.RowIDField, logPath)}
+	}
+	sidePayloads := []string{metautil.BuildSidePayloadPath(root, 20, 101, 1)}
+	for rowID := int64(1); rowID <= 4; rowID++ {
+		sidePayloads = append(sidePayloads, metautil.BuildSidePayloadPath(root, 10, 101, rowID))
+	}
+	for _, key := range sidePayloads {
+		require.NoError(t, cm.Write(ctx, key, []byte("value")))
+	}
+
+	meta, err := newMemoryMeta()
+	require.NoError(t, err)
+	meta.AddCollection(&collectionInfo{ID: 10, Schema: schema, Partitions: []int64{100, 200}})
+	// the segment of the dropped partition
+	dropped := buildSegment(10, 200, 1, "ch", false)
+	dropped.State = commonpb.SegmentState_Dropped
+	dropped.Binlogs = writeRowIDs(200, 1, 1, 2)
+	require.NoError(t, meta.AddSegment(dropped))
+	// the segment of the existing partition, whose rows may live on in the compacted segment
+	kept := buildSegment(10, 100, 2, "ch", false)
+	kept.State = commonpb.SegmentState_Dropped
+	kept.Binlogs = writeRowIDs(100, 2, 3, 4)
+	require.NoError(t, meta.AddSegment(kept))
+
+	rc := mocks.NewRootCoord(t)
+	rc.EXPECT().ShowPartitionsInternal(mock.Anything, mock.Anything).Return(&milvuspb.ShowPartitionsResponse{
+		Status:       merr.Status(nil),
+		PartitionIDs: []int64{100},
+	}, nil)
+	rc.EXPECT().DescribeCollection(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, req *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error) {
+			if req.GetCollectionID() == 10 {
+				return &milvuspb.DescribeCollectionResponse{Status: merr.Status(nil), CollectionID: 10}, nil
+			}
+			return &milvuspb.DescribeCollectionResponse{
+				Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_CollectionNotExists},
+			}, nil
+		})
+
+	gc := newGarbageCollector(meta, newMockHandlerWithMeta(meta), GcOption{
+		cli:    cm,
+		broker: NewCoordinatorBroker(rc),
+	})
+
+	exist := func(key string) bool {
+		ok, err := cm.Exist(ctx, key)
+		require.NoError(t, err)
+		return ok
+	}
+
+	gc.clearEtcd()
+	assert.Nil(t, meta.GetSegment(1))
+	assert.Nil(t, meta.GetSegment(2))
+	assert.False(t, exist(metautil.BuildSidePayloadPath(root, 10, 101, 1)))
+	assert.False(t, exist(metautil.BuildSidePayloadPath(root, 10, 101, 2)))
+	assert.True(t, exist(metautil.BuildSidePayloadPath(root, 10, 101, 3)))
+	assert.True(t, exist(metautil.BuildSidePayloadPath(root, 10, 101, 4)))
+
+	gc.recycleSidePayloads()
+	assert.True(t, exist(metautil.BuildSidePayloadPath(root, 10, 101, 3)))
+	assert.False(t, exist(metautil.BuildSidePayloadPath(root, 20, 101, 1)))
+}
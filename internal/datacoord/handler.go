@@ -78,6 +78,11 @@ func (h *ServerHandler) GetDataVChanPositions(channel *channel, partitionID Uniq
 			// Skip bulk insert segments.
 			continue
 		}
+		if s.GetLevel() == datapb.SegmentLevel_L0 {
+			// Skip L0 segments, they're merged by DataCoord once synced, and the DataNode only
+			// buffers the deletes after the channel checkpoint.
+			continue
+		}
 
 		if s.GetState() == commonpb.SegmentState_Dropped {
 			droppedIDs.Insert(s.GetID())
@@ -137,6 +142,11 @@ func (h *ServerHandler) GetQueryVChanPositions(channel *channel, partitionIDs ..
 			// Skip bulk insert segments.
 			continue
 		}
+		if s.GetLevel() == datapb.SegmentLevel_L0 {
+			// Skip L0 segments, the seek position is held until they're merged,
+			// so that their deletes are read from the channel.
+			continue
+		}
 		segmentInfos[s.GetID()] = s
 		switch {
 		case s.GetState() == commonpb.SegmentState_Dropped:
@@ -205,7 +215,7 @@ func (h *ServerHandler) GetQueryVChanPositions(channel *channel, partitionIDs ..
 	return &datapb.VchannelInfo{
 		CollectionID:        channel.CollectionID,
 		ChannelName:         channel.Name,
-		SeekPosition:        h.GetChannelSeekPosition(channel, partitionIDs...),
+		SeekPosition:        h.s.meta.holdCheckpointForLevelZero(channel.Name, h.GetChannelSeekPosition(channel, partitionIDs...)),
 		FlushedSegmentIds:   indexedIDs.Collect(),
 		UnflushedSegmentIds: growingIDs.Collect(),
 		DroppedSegmentIds:   droppedIDs.Collect(),
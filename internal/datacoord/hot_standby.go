@@ -0,0 +1,424 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util"
+)
+
+// hotStandbyCatchUpTimeout is the max time to wait for the changes made by the lost active datacoord,
+// the whole meta is reloaded if the changes are not caught up in time.
+var hotStandbyCatchUpTimeout = 5 * time.Second
+
+// segmentKey locates the meta of a segment in etcd.
+type segmentKey struct {
+	collectionID UniqueID
+	partitionID  UniqueID
+}
+
+// standbyChanges are the changes in etcd not synced by the hot standby yet.
+type standbyChanges struct {
+	segments    map[UniqueID]segmentKey // segment id -> key of the segment
+	checkpoints bool
+	channels    bool
+	indexes     bool
+	all         bool // the changes can only be synced by reloading the whole meta
+}
+
+func newStandbyChanges() *standbyChanges {
+	return &standbyChanges{segments: make(map[UniqueID]segmentKey)}
+}
+
+func (c *standbyChanges) empty() bool {
+	return len(c.segments) == 0 && !c.checkpoints && !c.channels && !c.indexes && !c.all
+}
+
+// record classifies the change of the key, which is relative to the meta root path.
+func (c *standbyChanges) record(key string) {
+	switch {
+	case strings.HasPrefix(key, datacoord.SegmentPrefix+"/"):
+		c.recordSegment(key, datacoord.SegmentPrefix)
+	case strings.HasPrefix(key, datacoord.SegmentBinlogPathPrefix+"/"):
+		c.recordSegment(key, datacoord.SegmentBinlogPathPrefix)
+	case strings.HasPrefix(key, datacoord.SegmentDeltalogPathPrefix+"/"):
+		c.recordSegment(key, datacoord.SegmentDeltalogPathPrefix)
+	case strings.HasPrefix(key, datacoord.SegmentStatslogPathPrefix+"/"):
+		c.recordSegment(key, datacoord.SegmentStatslogPathPrefix)
+	case strings.HasPrefix(key, datacoord.ChannelCheckpointPrefix+"/"):
+		c.checkpoints = true
+	case strings.HasPrefix(key, Params.CommonCfg.DataCoordWatchSubPath.GetValue()+"/"),
+		strings.HasPrefix(key, datacoord.CordonedNodePrefix+"/"):
+		c.channels = true
+	case strings.HasPrefix(key, util.FieldIndexPrefix+"/"),
+		strings.HasPrefix(key, util.SegmentIndexPrefix+"/"):
+		c.indexes = true
+	case strings.HasPrefix(key, datacoord.MetaPrefix+"/"):
+		c.all = true
+	}
+}
+
+// recordSegment records the segment of the key organized as [prefix]/{collection}/{partition}/{segment}/...
+func (c *standbyChanges) recordSegment(key string, prefix string) {
+	ids := strings.Split(strings.TrimPrefix(key, prefix+"/"), "/")
+	if len(ids) < 3 {
+		c.all = true
+		return
+	}
+	var parsed [3]int64
+	for i := range parsed {
+		id, err := strconv.ParseInt(ids[i], 10, 64)
+		if err != nil {
+			log.Warn("hot standby failed to parse segment key, will reload the whole meta", zap.String("key", key))
+			c.all = true
+			return
+		}
+		parsed[i] = id
+	}
+	c.segments[parsed[2]] = segmentKey{collectionID: parsed[0], partitionID: parsed[1]}
+}
+
+// hotStandby keeps the meta and channel assignments of a standby datacoord synced from etcd,
+// by watching the changes made by the active datacoord,
+// so that the standby takes over in seconds rather than loading everything from scratch.
+type hotStandby struct {
+	s            *Server
+	chunkManager storage.ChunkManager
+	metaRootPath string
+
+	// ctx is shared by the watch and the progress requests, etcd notifies the progress to the watches with the same ctx
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu      sync.Mutex
+	changes *standbyChanges
+	watched int64 // the changes before the revision are received
+}
+
+func newHotStandby(s *Server) *hotStandby {
+	return &hotStandby{
+		s:            s,
+		metaRootPath: Params.EtcdCfg.MetaRootPath.GetValue(),
+		changes:      newStandbyChanges(),
+	}
+}
+
+// warmUp loads the meta and channel assignments, then keeps them synced in background.
+func (h *hotStandby) warmUp() error {
+	// the changes during loading are applied again, which is harmless
+	revision, err := h.currentRevision(h.s.ctx)
+	if err != nil {
+		return err
+	}
+	h.chunkManager, err = h.s.newChunkManagerFactory()
+	if err != nil {
+		return err
+	}
+	if err := h.s.initMeta(h.chunkManager); err != nil {
+		return err
+	}
+	h.s.handler = newServerHandler(h.s)
+	if err := h.s.initCluster(); err != nil {
+		return err
+	}
+
+	h.ctx, h.cancel = context.WithCancel(h.s.ctx)
+	h.wg.Add(1)
+	go h.syncLoop(revision)
+	log.Info("DataCoord hot standby warmed up", zap.Int64("revision", revision))
+	return nil
+}
+
+// activate catches up the changes made by the lost active datacoord and stops syncing.
+func (h *hotStandby) activate() error {
+	if err := h.catchUp(); err != nil {
+		log.Warn("hot standby failed to catch up the changes, will reload the whole meta", zap.Error(err))
+		h.mu.Lock()
+		h.changes.all = true
+		h.mu.Unlock()
+	}
+	h.stop()
+	return h.sync()
+}
+
+func (h *hotStandby) stop() {
+	if h.cancel != nil {
+		h.cancel()
+		h.wg.Wait()
+	}
+}
+
+func (h *hotStandby) currentRevision(ctx context.Context) (int64, error) {
+	resp, err := h.s.etcdCli.Get(ctx, h.metaRootPath, clientv3.WithCountOnly())
+	if err != nil {
+		return 0, err
+	}
+	return resp.Header.Revision, nil
+}
+
+// catchUp waits until all the changes before now are received.
+func (h *hotStandby) catchUp() error {
+	ctx, cancel := context.WithTimeout(h.s.ctx, hotStandbyCatchUpTimeout)
+	defer cancel()
+	revision, err := h.currentRevision(ctx)
+	if err != nil {
+		return err
+	}
+	ticker := time.NewTicker(50 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		h.mu.Lock()
+		watched := h.watched
+		h.mu.Unlock()
+		if watched >= revision {
+			return nil
+		}
+		// ask etcd to notify the latest revision if no change comes
+		if err := h.s.etcdCli.RequestProgress(h.ctx); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (h *hotStandby) watch(revision int64) clientv3.WatchChan {
+	return h.s.etcdCli.Watch(h.ctx, h.metaRootPath+"/",
+		clientv3.WithPrefix(), clientv3.WithRev(revision+1), clientv3.WithProgressNotify())
+}
+
+func (h *hotStandby) syncLoop(revision int64) {
+	defer h.wg.Done()
+	ticker := time.NewTicker(Params.DataCoordCfg.HotStandbySyncInterval.GetAsDuration(time.Millisecond))
+	defer ticker.Stop()
+
+	watchCh := h.watch(revision)
+	for {
+		select {
+		case <-h.ctx.Done():
+			log.Info("hot standby sync loop quit")
+			return
+		case <-ticker.C:
+			if err := h.sync(); err != nil {
+				log.Warn("hot standby failed to sync meta, will retry", zap.Error(err))
+			}
+		case resp, ok := <-watchCh:
+			if ok && resp.Err() == nil {
+				h.receive(resp)
+				continue
+			}
+			// the history may be compacted, reload the whole meta and watch from now on
+			log.Warn("hot standby watch failed, will reload the whole meta", zap.Error(resp.Err()))
+			revision, err := h.currentRevision(h.ctx)
+			if err != nil {
+				log.Warn("hot standby failed to get etcd revision", zap.Error(err))
+				revision = resp.Header.Revision
+			}
+			h.mu.Lock()
+			h.changes.all = true
+			h.mu.Unlock()
+			watchCh = h.watch(revision)
+		}
+	}
+}
+
+func (h *hotStandby) receive(resp clientv3.WatchResponse) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if resp.IsProgressNotify() {
+		h.watched = resp.Header.Revision
+		return
+	}
+	for _, event := range resp.Events {
+		h.changes.record(strings.TrimPrefix(string(event.Kv.Key), h.metaRootPath+"/"))
+		if event.Kv.ModRevision > h.watched {
+			h.watched = event.Kv.ModRevision
+		}
+	}
+}
+
+// sync applies the received changes, the changes failed to apply are kept for the next sync.
+func (h *hotStandby) sync() error {
+	h.mu.Lock()
+	changes := h.changes
+	h.changes = newStandbyChanges()
+	h.mu.Unlock()
+	if changes.empty() {
+		return nil
+	}
+
+	err := h.apply(changes)
+	if err != nil {
+		h.mu.Lock()
+		h.changes.merge(changes)
+		h.mu.Unlock()
+	}
+	return err
+}
+
+func (h *hotStandby) apply(changes *standbyChanges) error {
+	if changes.all {
+		return h.reload()
+	}
+	for segmentID, key := range changes.segments {
+		if err := h.s.meta.reloadSegment(key.collectionID, key.partitionID, segmentID); err != nil {
+			return err
+		}
+		delete(changes.segments, segmentID)
+	}
+	if changes.checkpoints {
+		if err := h.s.meta.reloadChannelCheckpoints(); err != nil {
+			return err
+		}
+		changes.checkpoints = false
+	}
+	if changes.indexes {
+		if err := h.s.meta.reloadIndexes(); err != nil {
+			return err
+		}
+		changes.indexes = false
+	}
+	if changes.channels {
+		return h.s.channelManager.reload()
+	}
+	return nil
+}
+
+// reload loads the whole meta and channel assignments, the current ones keep serving until the new ones are loaded.
+func (h *hotStandby) reload() error {
+	catalog := h.s.meta.catalog
+	m, err := newMeta(h.s.ctx, catalog, h.chunkManager)
+	if err != nil {
+		return err
+	}
+	maintenanceManager, err := newMaintenanceManager(h.s.ctx, catalog)
+	if err != nil {
+		return err
+	}
+	if err := h.s.channelManager.reload(); err != nil {
+		return err
+	}
+	h.s.meta = m
+	h.s.maintenanceManager = maintenanceManager
+	return nil
+}
+
+// merge adds the changes not applied back.
+func (c *standbyChanges) merge(other *standbyChanges) {
+	for segmentID, key := range other.segments {
+		c.segments[segmentID] = key
+	}
+	c.checkpoints = c.checkpoints || other.checkpoints
+	c.channels = c.channels || other.channels
+	c.indexes = c.indexes || other.indexes
+	c.all = c.all || other.all
+}
+
+// reloadSegment syncs the segment from the catalog, the segment is removed if it's gone.
+func (m *meta) reloadSegment(collectionID, partitionID, segmentID UniqueID) error {
+	info, err := m.catalog.GetSegment(m.ctx, collectionID, partitionID, segmentID)
+	if err != nil {
+		return err
+	}
+	m.Lock()
+	defer m.Unlock()
+	if info == nil {
+		m.segments.DropSegment(segmentID)
+		return nil
+	}
+	segment := NewSegmentInfo(info)
+	if old := m.segments.GetSegment(segmentID); old != nil {
+		segment.segmentIndexes = old.segmentIndexes
+	}
+	m.segments.SetSegment(segmentID, segment)
+	return nil
+}
+
+// reloadChannelCheckpoints syncs the channel checkpoints from the catalog.
+func (m *meta) reloadChannelCheckpoints() error {
+	channelCPs, err := m.catalog.ListChannelCheckpoint(m.ctx)
+	if err != nil {
+		return err
+	}
+	m.Lock()
+	defer m.Unlock()
+	m.channelCPs = channelCPs
+	for vChannel, pos := range channelCPs {
+		pos.ChannelName = vChannel
+	}
+	return nil
+}
+
+// reloadIndexes syncs the field indexes and segment indexes from the catalog.
+func (m *meta) reloadIndexes() error {
+	fieldIndexes, err := m.catalog.ListIndexes(m.ctx)
+	if err != nil {
+		return err
+	}
+	segmentIndexes, err := m.catalog.ListSegmentIndexes(m.ctx)
+	if err != nil {
+		return err
+	}
+	m.Lock()
+	defer m.Unlock()
+	m.indexes = make(map[UniqueID]map[UniqueID]*model.Index)
+	m.buildID2SegmentIndex = make(map[UniqueID]*model.SegmentIndex)
+	for _, segment := range m.segments.segments {
+		segment.segmentIndexes = make(map[UniqueID]*model.SegmentIndex)
+	}
+	for _, fieldIndex := range fieldIndexes {
+		m.updateCollectionIndex(fieldIndex)
+	}
+	for _, segIdx := range segmentIndexes {
+		m.updateSegmentIndex(segIdx)
+	}
+	return nil
+}
+
+// reload replaces the channel store with the one reloaded from the kv of the channel manager,
+// which goes through the dedicated watch client if enabled, and reloads the cordon marks from the catalog.
+func (c *ChannelManager) reload() error {
+	store := NewChannelStore(c.kv)
+	if err := store.Reload(); err != nil {
+		return err
+	}
+	cordoned, err := c.loadCordoned()
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.store = store
+	c.cordoned = cordoned
+	return nil
+}
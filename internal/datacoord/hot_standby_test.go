@@ -0,0 +1,123 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+func TestStandbyChanges_Record(t *testing.T) {
+	changes := newStandbyChanges()
+	assert.True(t, changes.empty())
+
+	changes.record("datacoord-meta/s/1/2/3")
+	changes.record("datacoord-meta/binlog/1/2/4/100")
+	changes.record("datacoord-meta/deltalog/1/2/5/100")
+	changes.record("datacoord-meta/statslog/1/2/6/100")
+	assert.Equal(t, map[UniqueID]segmentKey{
+		3: {collectionID: 1, partitionID: 2},
+		4: {collectionID: 1, partitionID: 2},
+		5: {collectionID: 1, partitionID: 2},
+		6: {collectionID: 1, partitionID: 2},
+	}, changes.segments)
+	assert.False(t, changes.checkpoints)
+	assert.False(t, changes.channels)
+	assert.False(t, changes.all)
+
+	changes.record("datacoord-meta/channel-cp/ch1")
+	assert.True(t, changes.checkpoints)
+	changes.record(Params.CommonCfg.DataCoordWatchSubPath.GetValue() + "/1/ch1")
+	assert.True(t, changes.channels)
+	changes.record("root-coord/collection/1")
+	assert.False(t, changes.all)
+
+	changes.record("datacoord-meta/s/1/2/abc")
+	assert.True(t, changes.all)
+	changes = newStandbyChanges()
+	changes.record("field-index/1/2")
+	assert.True(t, changes.indexes)
+	changes.record("segment-index/1/2/3/4")
+	assert.False(t, changes.all)
+	changes = newStandbyChanges()
+	changes.record("datacoord-meta/channel-removal/ch1")
+	assert.True(t, changes.all)
+	assert.False(t, changes.empty())
+}
+
+func TestHotStandby_Apply(t *testing.T) {
+	active, err := newMemoryMeta()
+	require.NoError(t, err)
+	standby, err := newMeta(context.TODO(), active.catalog, nil)
+	require.NoError(t, err)
+	h := newHotStandby(&Server{meta: standby})
+
+	segment := &datapb.SegmentInfo{ID: 3, CollectionID: 1, PartitionID: 2, InsertChannel: "ch1", State: commonpb.SegmentState_Growing}
+	require.NoError(t, active.AddSegment(NewSegmentInfo(segment)))
+	require.NoError(t, active.UpdateChannelCheckpoint("ch1", &msgpb.MsgPosition{ChannelName: "ch1", Timestamp: 100}))
+	standby.segments.SetSegmentIndex(3, &model.SegmentIndex{SegmentID: 3, IndexID: 10})
+
+	h.changes.record("datacoord-meta/s/1/2/3")
+	h.changes.record("datacoord-meta/channel-cp/ch1")
+	require.NoError(t, h.sync())
+	assert.True(t, h.changes.empty())
+	synced := standby.GetSegment(3)
+	require.NotNil(t, synced)
+	assert.Equal(t, "ch1", synced.GetInsertChannel())
+	// the index meta is synced separately
+	assert.Contains(t, synced.segmentIndexes, UniqueID(10))
+	assert.EqualValues(t, 100, standby.GetChannelCheckpoint("ch1").GetTimestamp())
+
+	// only the index meta is reloaded on index changes
+	require.NoError(t, active.CreateIndex(&model.Index{CollectionID: 1, FieldID: 100, IndexID: 11, IndexName: "idx"}))
+	require.NoError(t, active.catalog.CreateSegmentIndex(context.TODO(), &model.SegmentIndex{SegmentID: 3, CollectionID: 1, PartitionID: 2, IndexID: 11, BuildID: 1000}))
+	h.changes.record("field-index/1/11")
+	h.changes.record("segment-index/1/2/3/1000")
+	require.NoError(t, h.sync())
+	assert.True(t, h.changes.empty())
+	assert.Contains(t, standby.indexes[1], UniqueID(11))
+	synced = standby.GetSegment(3)
+	assert.Contains(t, synced.segmentIndexes, UniqueID(11))
+	assert.NotContains(t, synced.segmentIndexes, UniqueID(10))
+	assert.Contains(t, standby.buildID2SegmentIndex, UniqueID(1000))
+
+	require.NoError(t, active.DropSegment(3))
+	h.changes.record("datacoord-meta/s/1/2/3")
+	require.NoError(t, h.sync())
+	assert.Nil(t, standby.GetSegment(3))
+
+	t.Run("failed to apply", func(t *testing.T) {
+		catalog := mocks.NewDataCoordCatalog(t)
+		catalog.EXPECT().GetSegment(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("mock error"))
+		h := newHotStandby(&Server{meta: &meta{ctx: context.TODO(), catalog: catalog, segments: NewSegmentsInfo()}})
+		h.changes.record("datacoord-meta/s/1/2/3")
+		assert.Error(t, h.sync())
+		// the changes are kept for the next sync
+		assert.Equal(t, map[UniqueID]segmentKey{3: {collectionID: 1, partitionID: 2}}, h.changes.segments)
+	})
+}
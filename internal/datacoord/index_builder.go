@@ -32,6 +32,8 @@ import (
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/indexparams"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 type indexTaskState int32
@@ -125,6 +127,14 @@ func (ib *indexBuilder) reloadFromKV() {
 			}
 		}
 	}
+	// the rebuilding segment indexes are not attached to the segments until finished
+	for _, segIndex := range ib.meta.GetRebuildingSegmentIndexes() {
+		if segIndex.IndexState == commonpb.IndexState_Unissued {
+			ib.tasks[segIndex.BuildID] = indexTaskInit
+		} else if segIndex.IndexState == commonpb.IndexState_InProgress {
+			ib.tasks[segIndex.BuildID] = indexTaskInProgress
+		}
+	}
 }
 
 // notify is an unblocked notify function
@@ -218,7 +228,8 @@ func (ib *indexBuilder) process(buildID UniqueID) bool {
 	switch state {
 	case indexTaskInit:
 		segment := ib.meta.GetSegment(meta.SegmentID)
-		if !isSegmentHealthy(segment) || !ib.meta.IsIndexExist(meta.CollectionID, meta.IndexID) {
+		if !isSegmentHealthy(segment) || !ib.meta.IsIndexExist(meta.CollectionID, meta.IndexID) ||
+			(meta.IsRebuild && !ib.meta.IsIndexAltering(meta.CollectionID, meta.IndexID)) {
 			log.Ctx(ib.ctx).Info("task is no need to build index, remove it", zap.Int64("buildID", buildID))
 			if err := ib.meta.DeleteTask(buildID); err != nil {
 				log.Ctx(ib.ctx).Warn("IndexCoord delete index failed", zap.Int64("buildID", buildID), zap.Error(err))
@@ -227,7 +238,7 @@ func (ib *indexBuilder) process(buildID UniqueID) bool {
 			deleteFunc(buildID)
 			return true
 		}
-		indexParams := ib.meta.GetIndexParams(meta.CollectionID, meta.IndexID)
+		indexParams := ib.meta.GetBuildIndexParams(meta.CollectionID, meta.IndexID)
 		if isFlatIndex(getIndexType(indexParams)) || meta.NumRows < Params.DataCoordCfg.MinSegmentNumRowsToEnableIndex.GetAsInt64() {
 			log.Ctx(ib.ctx).Debug("segment does not need index really", zap.Int64("buildID", buildID),
 				zap.Int64("segmentID", meta.SegmentID), zap.Int64("num rows", meta.NumRows))
@@ -244,9 +255,12 @@ func (ib *indexBuilder) process(buildID UniqueID) bool {
 			updateStateFunc(buildID, indexTaskDone)
 			return true
 		}
+		typeParams := ib.meta.GetTypeParams(meta.CollectionID, meta.IndexID)
 		// peek client
-		// if all IndexNodes are executing task, wait for one of them to finish the task.
-		nodeID, client := ib.nodeManager.PeekClient(meta)
+		// if all IndexNodes are executing task or don't have enough disk space to build the disk index,
+		// wait for one of them to finish the task.
+		diskBuildSize := indexparams.EstimateDiskBuildSize(indexParams, typeParams, meta.NumRows)
+		nodeID, client := ib.nodeManager.PeekClient(meta, diskBuildSize)
 		if client == nil {
 			log.Ctx(ib.ctx).WithRateGroup("dc.indexBuilder", 1, 60).RatedInfo(5, "index builder peek client error, there is no available")
 			return false
@@ -268,8 +282,6 @@ func (ib *indexBuilder) process(buildID UniqueID) bool {
 			}
 		}
 
-		typeParams := ib.meta.GetTypeParams(meta.CollectionID, meta.IndexID)
-
 		var storageConfig *indexpb.StorageConfig
 		if Params.CommonCfg.StorageType.GetValue() == "local" {
 			storageConfig = &indexpb.StorageConfig{
@@ -277,16 +289,21 @@ func (ib *indexBuilder) process(buildID UniqueID) bool {
 				StorageType: Params.CommonCfg.StorageType.GetValue(),
 			}
 		} else {
+			// the index is built with the credentials scoped to IndexNode
+			cred := Params.MinioCfg.GetRoleCredential(typeutil.IndexNodeRole)
 			storageConfig = &indexpb.StorageConfig{
 				Address:         Params.MinioCfg.Address.GetValue(),
-				AccessKeyID:     Params.MinioCfg.AccessKeyID.GetValue(),
-				SecretAccessKey: Params.MinioCfg.SecretAccessKey.GetValue(),
+				AccessKeyID:     cred.AccessKeyID,
+				SecretAccessKey: cred.SecretAccessKey,
 				UseSSL:          Params.MinioCfg.UseSSL.GetAsBool(),
 				BucketName:      Params.MinioCfg.BucketName.GetValue(),
 				RootPath:        Params.MinioCfg.RootPath.GetValue(),
 				UseIAM:          Params.MinioCfg.UseIAM.GetAsBool(),
 				IAMEndpoint:     Params.MinioCfg.IAMEndpoint.GetValue(),
 				StorageType:     Params.CommonCfg.StorageType.GetValue(),
+				RoleArn:         cred.RoleARN,
+				StsEndpoint:     Params.MinioCfg.STSEndpoint.GetValue(),
+				SessionDuration: int64(cred.SessionDuration.Seconds()),
 			}
 		}
 		req := &indexpb.CreateJobRequest{
@@ -78,340 +78,338 @@ func createMetaTable(catalog metastore.DataCoordCatalog) *meta {
 				},
 			},
 		},
-		segments: &SegmentsInfo{
-			segments: map[UniqueID]*SegmentInfo{
-				segID: {
-					SegmentInfo: &datapb.SegmentInfo{
-						ID:             segID,
-						CollectionID:   collID,
-						PartitionID:    partID,
-						InsertChannel:  "",
-						NumOfRows:      1025,
-						State:          commonpb.SegmentState_Flushed,
-						MaxRowNum:      65536,
-						LastExpireTime: 10,
-					},
-					segmentIndexes: map[UniqueID]*model.SegmentIndex{
-						indexID: {
-							SegmentID:     segID,
-							CollectionID:  collID,
-							PartitionID:   partID,
-							NumRows:       1025,
-							IndexID:       indexID,
-							BuildID:       buildID,
-							NodeID:        0,
-							IndexVersion:  0,
-							IndexState:    commonpb.IndexState_Unissued,
-							FailReason:    "",
-							IsDeleted:     false,
-							CreateTime:    0,
-							IndexFileKeys: nil,
-							IndexSize:     0,
-						},
-					},
+		segments: buildSegmentsInfo(map[UniqueID]*SegmentInfo{
+			segID: {
+				SegmentInfo: &datapb.SegmentInfo{
+					ID:             segID,
+					CollectionID:   collID,
+					PartitionID:    partID,
+					InsertChannel:  "",
+					NumOfRows:      1025,
+					State:          commonpb.SegmentState_Flushed,
+					MaxRowNum:      65536,
+					LastExpireTime: 10,
 				},
-				segID + 1: {
-					SegmentInfo: &datapb.SegmentInfo{
-						ID:             segID + 1,
-						CollectionID:   collID,
-						PartitionID:    partID,
-						InsertChannel:  "",
-						NumOfRows:      1026,
-						State:          commonpb.SegmentState_Flushed,
-						MaxRowNum:      65536,
-						LastExpireTime: 10,
-					},
-					segmentIndexes: map[UniqueID]*model.SegmentIndex{
-						indexID: {
-							SegmentID:     segID + 1,
-							CollectionID:  collID,
-							PartitionID:   partID,
-							NumRows:       1026,
-							IndexID:       indexID,
-							BuildID:       buildID + 1,
-							NodeID:        nodeID,
-							IndexVersion:  1,
-							IndexState:    commonpb.IndexState_InProgress,
-							FailReason:    "",
-							IsDeleted:     false,
-							CreateTime:    1111,
-							IndexFileKeys: nil,
-							IndexSize:     0,
-						},
+				segmentIndexes: map[UniqueID]*model.SegmentIndex{
+					indexID: {
+						SegmentID:     segID,
+						CollectionID:  collID,
+						PartitionID:   partID,
+						NumRows:       1025,
+						IndexID:       indexID,
+						BuildID:       buildID,
+						NodeID:        0,
+						IndexVersion:  0,
+						IndexState:    commonpb.IndexState_Unissued,
+						FailReason:    "",
+						IsDeleted:     false,
+						CreateTime:    0,
+						IndexFileKeys: nil,
+						IndexSize:     0,
 					},
 				},
-				segID + 2: {
-					SegmentInfo: &datapb.SegmentInfo{
-						ID:             segID + 2,
-						CollectionID:   collID,
-						PartitionID:    partID,
-						InsertChannel:  "",
-						NumOfRows:      1026,
-						State:          commonpb.SegmentState_Flushed,
-						MaxRowNum:      65536,
-						LastExpireTime: 10,
-					},
-					segmentIndexes: map[UniqueID]*model.SegmentIndex{
-						indexID: {
-							SegmentID:     segID + 2,
-							CollectionID:  collID,
-							PartitionID:   partID,
-							NumRows:       1026,
-							IndexID:       indexID,
-							BuildID:       buildID + 2,
-							NodeID:        nodeID,
-							IndexVersion:  1,
-							IndexState:    commonpb.IndexState_InProgress,
-							FailReason:    "",
-							IsDeleted:     true,
-							CreateTime:    1111,
-							IndexFileKeys: nil,
-							IndexSize:     0,
-						},
-					},
+			},
+			segID + 1: {
+				SegmentInfo: &datapb.SegmentInfo{
+					ID:             segID + 1,
+					CollectionID:   collID,
+					PartitionID:    partID,
+					InsertChannel:  "",
+					NumOfRows:      1026,
+					State:          commonpb.SegmentState_Flushed,
+					MaxRowNum:      65536,
+					LastExpireTime: 10,
 				},
-				segID + 3: {
-					SegmentInfo: &datapb.SegmentInfo{
-						ID:             segID + 3,
-						CollectionID:   collID,
-						PartitionID:    partID,
-						InsertChannel:  "",
-						NumOfRows:      500,
-						State:          commonpb.SegmentState_Flushed,
-						MaxRowNum:      65536,
-						LastExpireTime: 10,
-					},
-					segmentIndexes: map[UniqueID]*model.SegmentIndex{
-						indexID: {
-							SegmentID:     segID + 3,
-							CollectionID:  collID,
-							PartitionID:   partID,
-							NumRows:       500,
-							IndexID:       indexID,
-							BuildID:       buildID + 3,
-							NodeID:        0,
-							IndexVersion:  0,
-							IndexState:    commonpb.IndexState_Unissued,
-							FailReason:    "",
-							IsDeleted:     false,
-							CreateTime:    1111,
-							IndexFileKeys: nil,
-							IndexSize:     0,
-						},
+				segmentIndexes: map[UniqueID]*model.SegmentIndex{
+					indexID: {
+						SegmentID:     segID + 1,
+						CollectionID:  collID,
+						PartitionID:   partID,
+						NumRows:       1026,
+						IndexID:       indexID,
+						BuildID:       buildID + 1,
+						NodeID:        nodeID,
+						IndexVersion:  1,
+						IndexState:    commonpb.IndexState_InProgress,
+						FailReason:    "",
+						IsDeleted:     false,
+						CreateTime:    1111,
+						IndexFileKeys: nil,
+						IndexSize:     0,
 					},
 				},
-				segID + 4: {
-					SegmentInfo: &datapb.SegmentInfo{
-						ID:             segID + 4,
-						CollectionID:   collID,
-						PartitionID:    partID,
-						InsertChannel:  "",
-						NumOfRows:      1026,
-						State:          commonpb.SegmentState_Flushed,
-						MaxRowNum:      65536,
-						LastExpireTime: 10,
-					},
-					segmentIndexes: map[UniqueID]*model.SegmentIndex{
-						indexID: {
-							SegmentID:     segID + 4,
-							CollectionID:  collID,
-							PartitionID:   partID,
-							NumRows:       1026,
-							IndexID:       indexID,
-							BuildID:       buildID + 4,
-							NodeID:        nodeID,
-							IndexVersion:  1,
-							IndexState:    commonpb.IndexState_Finished,
-							FailReason:    "",
-							IsDeleted:     false,
-							CreateTime:    1111,
-							IndexFileKeys: nil,
-							IndexSize:     0,
-						},
-					},
+			},
+			segID + 2: {
+				SegmentInfo: &datapb.SegmentInfo{
+					ID:             segID + 2,
+					CollectionID:   collID,
+					PartitionID:    partID,
+					InsertChannel:  "",
+					NumOfRows:      1026,
+					State:          commonpb.SegmentState_Flushed,
+					MaxRowNum:      65536,
+					LastExpireTime: 10,
 				},
-				segID + 5: {
-					SegmentInfo: &datapb.SegmentInfo{
-						ID:             segID + 5,
-						CollectionID:   collID,
-						PartitionID:    partID,
-						InsertChannel:  "",
-						NumOfRows:      1026,
-						State:          commonpb.SegmentState_Flushed,
-						MaxRowNum:      65536,
-						LastExpireTime: 10,
-					},
-					segmentIndexes: map[UniqueID]*model.SegmentIndex{
-						indexID: {
-							SegmentID:     segID + 5,
-							CollectionID:  collID,
-							PartitionID:   partID,
-							NumRows:       1026,
-							IndexID:       indexID,
-							BuildID:       buildID + 5,
-							NodeID:        0,
-							IndexVersion:  1,
-							IndexState:    commonpb.IndexState_Finished,
-							FailReason:    "",
-							IsDeleted:     false,
-							CreateTime:    1111,
-							IndexFileKeys: nil,
-							IndexSize:     0,
-						},
+				segmentIndexes: map[UniqueID]*model.SegmentIndex{
+					indexID: {
+						SegmentID:     segID + 2,
+						CollectionID:  collID,
+						PartitionID:   partID,
+						NumRows:       1026,
+						IndexID:       indexID,
+						BuildID:       buildID + 2,
+						NodeID:        nodeID,
+						IndexVersion:  1,
+						IndexState:    commonpb.IndexState_InProgress,
+						FailReason:    "",
+						IsDeleted:     true,
+						CreateTime:    1111,
+						IndexFileKeys: nil,
+						IndexSize:     0,
 					},
 				},
-				segID + 6: {
-					SegmentInfo: &datapb.SegmentInfo{
-						ID:             segID + 6,
-						CollectionID:   collID,
-						PartitionID:    partID,
-						InsertChannel:  "",
-						NumOfRows:      1026,
-						State:          commonpb.SegmentState_Flushed,
-						MaxRowNum:      65536,
-						LastExpireTime: 10,
-					},
-					segmentIndexes: map[UniqueID]*model.SegmentIndex{
-						indexID: {
-							SegmentID:     segID + 6,
-							CollectionID:  collID,
-							PartitionID:   partID,
-							NumRows:       1026,
-							IndexID:       indexID,
-							BuildID:       buildID + 6,
-							NodeID:        0,
-							IndexVersion:  1,
-							IndexState:    commonpb.IndexState_Finished,
-							FailReason:    "",
-							IsDeleted:     false,
-							CreateTime:    1111,
-							IndexFileKeys: nil,
-							IndexSize:     0,
-						},
-					},
+			},
+			segID + 3: {
+				SegmentInfo: &datapb.SegmentInfo{
+					ID:             segID + 3,
+					CollectionID:   collID,
+					PartitionID:    partID,
+					InsertChannel:  "",
+					NumOfRows:      500,
+					State:          commonpb.SegmentState_Flushed,
+					MaxRowNum:      65536,
+					LastExpireTime: 10,
 				},
-				segID + 7: {
-					SegmentInfo: &datapb.SegmentInfo{
-						ID:             segID + 7,
-						CollectionID:   collID,
-						PartitionID:    partID,
-						InsertChannel:  "",
-						NumOfRows:      1026,
-						State:          commonpb.SegmentState_Flushed,
-						MaxRowNum:      65536,
-						LastExpireTime: 10,
+				segmentIndexes: map[UniqueID]*model.SegmentIndex{
+					indexID: {
+						SegmentID:     segID + 3,
+						CollectionID:  collID,
+						PartitionID:   partID,
+						NumRows:       500,
+						IndexID:       indexID,
+						BuildID:       buildID + 3,
+						NodeID:        0,
+						IndexVersion:  0,
+						IndexState:    commonpb.IndexState_Unissued,
+						FailReason:    "",
+						IsDeleted:     false,
+						CreateTime:    1111,
+						IndexFileKeys: nil,
+						IndexSize:     0,
 					},
-					segmentIndexes: map[UniqueID]*model.SegmentIndex{
-						indexID: {
-							SegmentID:     segID + 7,
-							CollectionID:  collID,
-							PartitionID:   partID,
-							NumRows:       1026,
-							IndexID:       indexID,
-							BuildID:       buildID + 7,
-							NodeID:        0,
-							IndexVersion:  1,
-							IndexState:    commonpb.IndexState_Failed,
-							FailReason:    "error",
-							IsDeleted:     false,
-							CreateTime:    1111,
-							IndexFileKeys: nil,
-							IndexSize:     0,
-						},
+				},
+			},
+			segID + 4: {
+				SegmentInfo: &datapb.SegmentInfo{
+					ID:             segID + 4,
+					CollectionID:   collID,
+					PartitionID:    partID,
+					InsertChannel:  "",
+					NumOfRows:      1026,
+					State:          commonpb.SegmentState_Flushed,
+					MaxRowNum:      65536,
+					LastExpireTime: 10,
+				},
+				segmentIndexes: map[UniqueID]*model.SegmentIndex{
+					indexID: {
+						SegmentID:     segID + 4,
+						CollectionID:  collID,
+						PartitionID:   partID,
+						NumRows:       1026,
+						IndexID:       indexID,
+						BuildID:       buildID + 4,
+						NodeID:        nodeID,
+						IndexVersion:  1,
+						IndexState:    commonpb.IndexState_Finished,
+						FailReason:    "",
+						IsDeleted:     false,
+						CreateTime:    1111,
+						IndexFileKeys: nil,
+						IndexSize:     0,
 					},
 				},
-				segID + 8: {
-					SegmentInfo: &datapb.SegmentInfo{
-						ID:             segID + 8,
-						CollectionID:   collID,
-						PartitionID:    partID,
-						InsertChannel:  "",
-						NumOfRows:      1026,
-						State:          commonpb.SegmentState_Flushed,
-						MaxRowNum:      65536,
-						LastExpireTime: 10,
+			},
+			segID + 5: {
+				SegmentInfo: &datapb.SegmentInfo{
+					ID:             segID + 5,
+					CollectionID:   collID,
+					PartitionID:    partID,
+					InsertChannel:  "",
+					NumOfRows:      1026,
+					State:          commonpb.SegmentState_Flushed,
+					MaxRowNum:      65536,
+					LastExpireTime: 10,
+				},
+				segmentIndexes: map[UniqueID]*model.SegmentIndex{
+					indexID: {
+						SegmentID:     segID + 5,
+						CollectionID:  collID,
+						PartitionID:   partID,
+						NumRows:       1026,
+						IndexID:       indexID,
+						BuildID:       buildID + 5,
+						NodeID:        0,
+						IndexVersion:  1,
+						IndexState:    commonpb.IndexState_Finished,
+						FailReason:    "",
+						IsDeleted:     false,
+						CreateTime:    1111,
+						IndexFileKeys: nil,
+						IndexSize:     0,
 					},
-					segmentIndexes: map[UniqueID]*model.SegmentIndex{
-						indexID: {
-							SegmentID:     segID + 8,
-							CollectionID:  collID,
-							PartitionID:   partID,
-							NumRows:       1026,
-							IndexID:       indexID,
-							BuildID:       buildID + 8,
-							NodeID:        nodeID + 1,
-							IndexVersion:  1,
-							IndexState:    commonpb.IndexState_InProgress,
-							FailReason:    "",
-							IsDeleted:     false,
-							CreateTime:    1111,
-							IndexFileKeys: nil,
-							IndexSize:     0,
-						},
+				},
+			},
+			segID + 6: {
+				SegmentInfo: &datapb.SegmentInfo{
+					ID:             segID + 6,
+					CollectionID:   collID,
+					PartitionID:    partID,
+					InsertChannel:  "",
+					NumOfRows:      1026,
+					State:          commonpb.SegmentState_Flushed,
+					MaxRowNum:      65536,
+					LastExpireTime: 10,
+				},
+				segmentIndexes: map[UniqueID]*model.SegmentIndex{
+					indexID: {
+						SegmentID:     segID + 6,
+						CollectionID:  collID,
+						PartitionID:   partID,
+						NumRows:       1026,
+						IndexID:       indexID,
+						BuildID:       buildID + 6,
+						NodeID:        0,
+						IndexVersion:  1,
+						IndexState:    commonpb.IndexState_Finished,
+						FailReason:    "",
+						IsDeleted:     false,
+						CreateTime:    1111,
+						IndexFileKeys: nil,
+						IndexSize:     0,
 					},
 				},
-				segID + 9: {
-					SegmentInfo: &datapb.SegmentInfo{
-						ID:             segID + 9,
-						CollectionID:   collID,
-						PartitionID:    partID,
-						InsertChannel:  "",
-						NumOfRows:      500,
-						State:          commonpb.SegmentState_Flushed,
-						MaxRowNum:      65536,
-						LastExpireTime: 10,
+			},
+			segID + 7: {
+				SegmentInfo: &datapb.SegmentInfo{
+					ID:             segID + 7,
+					CollectionID:   collID,
+					PartitionID:    partID,
+					InsertChannel:  "",
+					NumOfRows:      1026,
+					State:          commonpb.SegmentState_Flushed,
+					MaxRowNum:      65536,
+					LastExpireTime: 10,
+				},
+				segmentIndexes: map[UniqueID]*model.SegmentIndex{
+					indexID: {
+						SegmentID:     segID + 7,
+						CollectionID:  collID,
+						PartitionID:   partID,
+						NumRows:       1026,
+						IndexID:       indexID,
+						BuildID:       buildID + 7,
+						NodeID:        0,
+						IndexVersion:  1,
+						IndexState:    commonpb.IndexState_Failed,
+						FailReason:    "error",
+						IsDeleted:     false,
+						CreateTime:    1111,
+						IndexFileKeys: nil,
+						IndexSize:     0,
 					},
-					segmentIndexes: map[UniqueID]*model.SegmentIndex{
-						indexID: {
-							SegmentID:     segID + 9,
-							CollectionID:  collID,
-							PartitionID:   partID,
-							NumRows:       500,
-							IndexID:       indexID,
-							BuildID:       buildID + 9,
-							NodeID:        0,
-							IndexVersion:  0,
-							IndexState:    commonpb.IndexState_Unissued,
-							FailReason:    "",
-							IsDeleted:     false,
-							CreateTime:    1111,
-							IndexFileKeys: nil,
-							IndexSize:     0,
-						},
+				},
+			},
+			segID + 8: {
+				SegmentInfo: &datapb.SegmentInfo{
+					ID:             segID + 8,
+					CollectionID:   collID,
+					PartitionID:    partID,
+					InsertChannel:  "",
+					NumOfRows:      1026,
+					State:          commonpb.SegmentState_Flushed,
+					MaxRowNum:      65536,
+					LastExpireTime: 10,
+				},
+				segmentIndexes: map[UniqueID]*model.SegmentIndex{
+					indexID: {
+						SegmentID:     segID + 8,
+						CollectionID:  collID,
+						PartitionID:   partID,
+						NumRows:       1026,
+						IndexID:       indexID,
+						BuildID:       buildID + 8,
+						NodeID:        nodeID + 1,
+						IndexVersion:  1,
+						IndexState:    commonpb.IndexState_InProgress,
+						FailReason:    "",
+						IsDeleted:     false,
+						CreateTime:    1111,
+						IndexFileKeys: nil,
+						IndexSize:     0,
 					},
 				},
-				segID + 10: {
-					SegmentInfo: &datapb.SegmentInfo{
-						ID:             segID + 10,
-						CollectionID:   collID,
-						PartitionID:    partID,
-						InsertChannel:  "",
-						NumOfRows:      500,
-						State:          commonpb.SegmentState_Flushed,
-						MaxRowNum:      65536,
-						LastExpireTime: 10,
+			},
+			segID + 9: {
+				SegmentInfo: &datapb.SegmentInfo{
+					ID:             segID + 9,
+					CollectionID:   collID,
+					PartitionID:    partID,
+					InsertChannel:  "",
+					NumOfRows:      500,
+					State:          commonpb.SegmentState_Flushed,
+					MaxRowNum:      65536,
+					LastExpireTime: 10,
+				},
+				segmentIndexes: map[UniqueID]*model.SegmentIndex{
+					indexID: {
+						SegmentID:     segID + 9,
+						CollectionID:  collID,
+						PartitionID:   partID,
+						NumRows:       500,
+						IndexID:       indexID,
+						BuildID:       buildID + 9,
+						NodeID:        0,
+						IndexVersion:  0,
+						IndexState:    commonpb.IndexState_Unissued,
+						FailReason:    "",
+						IsDeleted:     false,
+						CreateTime:    1111,
+						IndexFileKeys: nil,
+						IndexSize:     0,
 					},
-					segmentIndexes: map[UniqueID]*model.SegmentIndex{
-						indexID: {
-							SegmentID:     segID + 10,
-							CollectionID:  collID,
-							PartitionID:   partID,
-							NumRows:       500,
-							IndexID:       indexID,
-							BuildID:       buildID + 10,
-							NodeID:        nodeID,
-							IndexVersion:  0,
-							IndexState:    commonpb.IndexState_Unissued,
-							FailReason:    "",
-							IsDeleted:     false,
-							CreateTime:    1111,
-							IndexFileKeys: nil,
-							IndexSize:     0,
-						},
+				},
+			},
+			segID + 10: {
+				SegmentInfo: &datapb.SegmentInfo{
+					ID:             segID + 10,
+					CollectionID:   collID,
+					PartitionID:    partID,
+					InsertChannel:  "",
+					NumOfRows:      500,
+					State:          commonpb.SegmentState_Flushed,
+					MaxRowNum:      65536,
+					LastExpireTime: 10,
+				},
+				segmentIndexes: map[UniqueID]*model.SegmentIndex{
+					indexID: {
+						SegmentID:     segID + 10,
+						CollectionID:  collID,
+						PartitionID:   partID,
+						NumRows:       500,
+						IndexID:       indexID,
+						BuildID:       buildID + 10,
+						NodeID:        nodeID,
+						IndexVersion:  0,
+						IndexState:    commonpb.IndexState_Unissued,
+						FailReason:    "",
+						IsDeleted:     false,
+						CreateTime:    1111,
+						IndexFileKeys: nil,
+						IndexSize:     0,
 					},
 				},
 			},
-		},
+		}),
 		buildID2SegmentIndex: map[UniqueID]*model.SegmentIndex{
 			buildID: {
 				SegmentID:     segID,
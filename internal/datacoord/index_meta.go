@@ -30,6 +30,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
@@ -41,8 +42,18 @@ func (m *meta) updateCollectionIndex(index *model.Index) {
 }
 
 func (m *meta) updateSegmentIndex(segIdx *model.SegmentIndex) {
-	m.segments.SetSegmentIndex(segIdx.SegmentID, segIdx)
 	m.buildID2SegmentIndex[segIdx.BuildID] = segIdx
+	// the rebuilding segment index does not serve until it replaces the serving one.
+	if segIdx.IsRebuild {
+		return
+	}
+	// the segment index has been replaced by the rebuilt one, which keeps serving.
+	if segment := m.segments.GetSegment(segIdx.SegmentID); segIdx.IsDeleted && segment != nil {
+		if serving, ok := segment.segmentIndexes[segIdx.IndexID]; ok && serving.BuildID != segIdx.BuildID && !serving.IsDeleted {
+			return
+		}
+	}
+	m.segments.SetSegmentIndex(segIdx.SegmentID, segIdx)
 }
 
 func (m *meta) alterSegmentIndexes(segIdxes []*model.SegmentIndex) error {
@@ -449,6 +460,36 @@ func (m *meta) GetIndexParams(collID, indexID UniqueID) []*commonpb.KeyValuePair
 	return indexParams
 }
 
+// GetBuildIndexParams returns the index params to build the segment index with,
+// which are the pending ones if the index is being altered. The load policy only matters to QueryNode,
+// so it's excluded.
+func (m *meta) GetBuildIndexParams(collID, indexID UniqueID) []*commonpb.KeyValuePair {
+	m.RLock()
+	defer m.RUnlock()
+
+	fieldIndexes, ok := m.indexes[collID]
+	if !ok {
+		return nil
+	}
+	index, ok := fieldIndexes[indexID]
+	if !ok {
+		return nil
+	}
+	params := index.IndexParams
+	if len(index.PendingIndexParams) > 0 {
+		params = index.PendingIndexParams
+	}
+	indexParams := make([]*commonpb.KeyValuePair, 0, len(params))
+	for _, param := range params {
+		if param.GetKey() == common.IndexLoadPolicyKey {
+			continue
+		}
+		indexParams = append(indexParams, proto.Clone(param).(*commonpb.KeyValuePair))
+	}
+
+	return indexParams
+}
+
 func (m *meta) GetTypeParams(collID, indexID UniqueID) []*commonpb.KeyValuePair {
 	m.RLock()
 	defer m.RUnlock()
@@ -531,6 +572,10 @@ func (m *meta) FinishTask(taskInfo *indexpb.IndexTaskInfo) error {
 		segIdx.IndexFileKeys = common.CloneStringList(taskInfo.IndexFileKeys)
 		segIdx.FailReason = taskInfo.FailReason
 		segIdx.IndexSize = taskInfo.SerializedSize
+		segIdx.IndexEngineVersion = taskInfo.IndexEngineVersion
+		if segIdx.IsRebuild && !segIdx.IsDeleted {
+			return m.swapSegmentIndex(segIdx)
+		}
 		return m.alterSegmentIndexes([]*model.SegmentIndex{segIdx})
 	}
 
@@ -540,6 +585,9 @@ func (m *meta) FinishTask(taskInfo *indexpb.IndexTaskInfo) error {
 
 	log.Info("finish index task success", zap.Int64("buildID", taskInfo.BuildID),
 		zap.String("state", taskInfo.GetState().String()), zap.String("fail reason", taskInfo.GetFailReason()))
+	if segIdx.IsRebuild {
+		m.tryPromoteIndexParams(segIdx.CollectionID, segIdx.IndexID)
+	}
 	m.updateIndexTasksMetrics()
 	metrics.FlushedSegmentFileNum.WithLabelValues(metrics.IndexFileLabel).Observe(float64(len(taskInfo.IndexFileKeys)))
 	return nil
@@ -565,6 +613,9 @@ func (m *meta) DeleteTask(buildID int64) error {
 	}
 
 	log.Info("delete index task success", zap.Int64("buildID", buildID))
+	if segIdx.IsRebuild {
+		m.tryPromoteIndexParams(segIdx.CollectionID, segIdx.IndexID)
+	}
 	m.updateIndexTasksMetrics()
 	return nil
 }
@@ -619,7 +670,12 @@ func (m *meta) RemoveSegmentIndex(collID, partID, segID, indexID, buildID Unique
 		return err
 	}
 
-	m.segments.DropSegmentIndex(segID, indexID)
+	// the segment index may have been replaced by the rebuilt one, which is still serving.
+	if segment := m.segments.GetSegment(segID); segment != nil {
+		if serving, ok := segment.segmentIndexes[indexID]; ok && serving.BuildID == buildID {
+			m.segments.DropSegmentIndex(segID, indexID)
+		}
+	}
 	delete(m.buildID2SegmentIndex, buildID)
 	m.updateIndexTasksMetrics()
 	return nil
@@ -711,3 +767,137 @@ func (m *meta) GetMetasByNodeID(nodeID UniqueID) []*model.SegmentIndex {
 	}
 	return metas
 }
+
+// AlterIndexParams starts to roll out the index params, the segment indexes built with
+// the old ones shall have been added as rebuild tasks before.
+func (m *meta) AlterIndexParams(collID, indexID UniqueID, indexParams, userIndexParams []*commonpb.KeyValuePair) error {
+	m.Lock()
+	defer m.Unlock()
+
+	index, ok := m.indexes[collID][indexID]
+	if !ok || index.IsDeleted {
+		return merr.WrapErrIndexNotFound(fmt.Sprintf("collectionID: %d, indexID: %d", collID, indexID))
+	}
+	clonedIndex := model.CloneIndex(index)
+	clonedIndex.PendingIndexParams = indexParams
+	clonedIndex.UserIndexParams = userIndexParams
+	if err := m.catalog.AlterIndexes(m.ctx, []*model.Index{clonedIndex}); err != nil {
+		log.Warn("meta update: alter index params fail", zap.Int64("collectionID", collID),
+			zap.Int64("indexID", indexID), zap.Error(err))
+		return err
+	}
+	m.updateCollectionIndex(clonedIndex)
+	log.Info("meta update: alter index params success", zap.Int64("collectionID", collID),
+		zap.Int64("indexID", indexID), zap.Any("indexParams", indexParams))
+
+	m.tryPromoteIndexParams(collID, indexID)
+	return nil
+}
+
+// IsIndexAltering returns true if the index params are being rolled out.
+func (m *meta) IsIndexAltering(collID, indexID UniqueID) bool {
+	m.RLock()
+	defer m.RUnlock()
+
+	index, ok := m.indexes[collID][indexID]
+	return ok && !index.IsDeleted && len(index.PendingIndexParams) > 0
+}
+
+// PromoteAlteredIndexes promotes the pending index params of the indexes which have been rebuilt.
+func (m *meta) PromoteAlteredIndexes() {
+	m.Lock()
+	defer m.Unlock()
+
+	for collID, fieldIndexes := range m.indexes {
+		for indexID, index := range fieldIndexes {
+			if len(index.PendingIndexParams) > 0 {
+				m.tryPromoteIndexParams(collID, indexID)
+			}
+		}
+	}
+}
+
+// GetRebuildingSegmentIndexes returns the segment indexes being rebuilt with the pending index params.
+func (m *meta) GetRebuildingSegmentIndexes() []*model.SegmentIndex {
+	m.RLock()
+	defer m.RUnlock()
+
+	segIndexes := make([]*model.SegmentIndex, 0)
+	for _, segIdx := range m.buildID2SegmentIndex {
+		if segIdx.IsRebuild && !segIdx.IsDeleted {
+			segIndexes = append(segIndexes, model.CloneSegmentIndex(segIdx))
+		}
+	}
+	return segIndexes
+}
+
+// IsReplacedSegmentIndex returns true if the segment index is no longer the serving one of its segment,
+// which is either replaced by the rebuilt one or a dropped rebuild task.
+func (m *meta) IsReplacedSegmentIndex(segIdx *model.SegmentIndex) bool {
+	m.RLock()
+	defer m.RUnlock()
+
+	if !segIdx.IsDeleted {
+		return false
+	}
+	segment := m.segments.GetSegment(segIdx.SegmentID)
+	if segment == nil {
+		return false
+	}
+	serving, ok := segment.segmentIndexes[segIdx.IndexID]
+	return ok && serving.BuildID != segIdx.BuildID
+}
+
+// swapSegmentIndex replaces the serving segment index with the rebuilt one atomically,
+// the rebuilt one is dropped if failed to build and the serving one keeps serving.
+func (m *meta) swapSegmentIndex(rebuilt *model.SegmentIndex) error {
+	if rebuilt.IndexState != commonpb.IndexState_Finished {
+		log.Warn("failed to rebuild segment index, keep the serving one",
+			zap.Int64("segmentID", rebuilt.SegmentID), zap.Int64("buildID", rebuilt.BuildID),
+			zap.String("state", rebuilt.IndexState.String()), zap.String("failReason", rebuilt.FailReason))
+		rebuilt.IsDeleted = true
+		return m.alterSegmentIndexes([]*model.SegmentIndex{rebuilt})
+	}
+
+	segIdxes := []*model.SegmentIndex{rebuilt}
+	if segment := m.segments.GetSegment(rebuilt.SegmentID); segment != nil {
+		if serving, ok := segment.segmentIndexes[rebuilt.IndexID]; ok && serving.BuildID != rebuilt.BuildID {
+			replaced := model.CloneSegmentIndex(serving)
+			replaced.IsDeleted = true
+			segIdxes = append(segIdxes, replaced)
+		}
+	}
+	rebuilt.IsRebuild = false
+	if err := m.alterSegmentIndexes(segIdxes); err != nil {
+		return err
+	}
+	log.Info("meta update: swap segment index success", zap.Int64("segmentID", rebuilt.SegmentID),
+		zap.Int64("indexID", rebuilt.IndexID), zap.Int64("buildID", rebuilt.BuildID))
+	return nil
+}
+
+// tryPromoteIndexParams replaces the index params with the pending ones once there is no rebuild task left.
+func (m *meta) tryPromoteIndexParams(collID, indexID UniqueID) {
+	index, ok := m.indexes[collID][indexID]
+	if !ok || index.IsDeleted || len(index.PendingIndexParams) == 0 {
+		return
+	}
+	for _, segIdx := range m.buildID2SegmentIndex {
+		if segIdx.IsRebuild && !segIdx.IsDeleted && segIdx.CollectionID == collID && segIdx.IndexID == indexID {
+			return
+		}
+	}
+
+	clonedIndex := model.CloneIndex(index)
+	clonedIndex.IndexParams = clonedIndex.PendingIndexParams
+	clonedIndex.PendingIndexParams = nil
+	if err := m.catalog.AlterIndexes(m.ctx, []*model.Index{clonedIndex}); err != nil {
+		// retry by PromoteAlteredIndexes later.
+		log.Warn("meta update: promote index params fail", zap.Int64("collectionID", collID),
+			zap.Int64("indexID", indexID), zap.Error(err))
+		return
+	}
+	m.updateCollectionIndex(clonedIndex)
+	log.Info("meta update: promote index params success", zap.Int64("collectionID", collID),
+		zap.Int64("indexID", indexID), zap.Any("indexParams", clonedIndex.IndexParams))
+}
@@ -323,19 +323,17 @@ func TestMeta_AddSegmentIndex(t *testing.T) {
 		catalog:              ec,
 		indexes:              make(map[UniqueID]map[UniqueID]*model.Index),
 		buildID2SegmentIndex: make(map[UniqueID]*model.SegmentIndex),
-		segments: &SegmentsInfo{
-			segments: map[UniqueID]*SegmentInfo{
-				1: {
-					SegmentInfo:     nil,
-					segmentIndexes:  map[UniqueID]*model.SegmentIndex{},
-					currRows:        0,
-					allocations:     nil,
-					lastFlushTime:   time.Time{},
-					isCompacting:    false,
-					lastWrittenTime: time.Time{},
-				},
+		segments: buildSegmentsInfo(map[UniqueID]*SegmentInfo{
+			1: {
+				SegmentInfo:     nil,
+				segmentIndexes:  map[UniqueID]*model.SegmentIndex{},
+				currRows:        0,
+				allocations:     nil,
+				lastFlushTime:   time.Time{},
+				isCompacting:    false,
+				lastWrittenTime: time.Time{},
 			},
-		},
+		}),
 	}
 
 	segmentIndex := &model.SegmentIndex{
@@ -460,19 +458,17 @@ func TestMeta_GetSegmentIndexState(t *testing.T) {
 		catalog:              &datacoord.Catalog{MetaKv: metakv},
 		indexes:              map[UniqueID]map[UniqueID]*model.Index{},
 		buildID2SegmentIndex: make(map[UniqueID]*model.SegmentIndex),
-		segments: &SegmentsInfo{
-			segments: map[UniqueID]*SegmentInfo{
-				segID: {
-					SegmentInfo:     nil,
-					segmentIndexes:  map[UniqueID]*model.SegmentIndex{},
-					currRows:        0,
-					allocations:     nil,
-					lastFlushTime:   time.Time{},
-					isCompacting:    false,
-					lastWrittenTime: time.Time{},
-				},
+		segments: buildSegmentsInfo(map[UniqueID]*SegmentInfo{
+			segID: {
+				SegmentInfo:     nil,
+				segmentIndexes:  map[UniqueID]*model.SegmentIndex{},
+				currRows:        0,
+				allocations:     nil,
+				lastFlushTime:   time.Time{},
+				isCompacting:    false,
+				lastWrittenTime: time.Time{},
 			},
-		},
+		}),
 	}
 
 	t.Run("segment has no index", func(t *testing.T) {
@@ -579,32 +575,30 @@ func TestMeta_GetSegmentIndexStateOnField(t *testing.T) {
 		ctx:         context.Background(),
 		catalog:     nil,
 		collections: nil,
-		segments: &SegmentsInfo{
-			segments: map[UniqueID]*SegmentInfo{
-				segID: {
-					SegmentInfo: &datapb.SegmentInfo{},
-					segmentIndexes: map[UniqueID]*model.SegmentIndex{
-						indexID: {
-							SegmentID:     segID,
-							CollectionID:  collID,
-							PartitionID:   partID,
-							NumRows:       1025,
-							IndexID:       indexID,
-							BuildID:       buildID,
-							NodeID:        nodeID,
-							IndexVersion:  1,
-							IndexState:    commonpb.IndexState_Finished,
-							FailReason:    "",
-							IsDeleted:     false,
-							CreateTime:    10,
-							IndexFileKeys: nil,
-							IndexSize:     0,
-							WriteHandoff:  false,
-						},
+		segments: buildSegmentsInfo(map[UniqueID]*SegmentInfo{
+			segID: {
+				SegmentInfo: &datapb.SegmentInfo{},
+				segmentIndexes: map[UniqueID]*model.SegmentIndex{
+					indexID: {
+						SegmentID:     segID,
+						CollectionID:  collID,
+						PartitionID:   partID,
+						NumRows:       1025,
+						IndexID:       indexID,
+						BuildID:       buildID,
+						NodeID:        nodeID,
+						IndexVersion:  1,
+						IndexState:    commonpb.IndexState_Finished,
+						FailReason:    "",
+						IsDeleted:     false,
+						CreateTime:    10,
+						IndexFileKeys: nil,
+						IndexSize:     0,
+						WriteHandoff:  false,
 					},
 				},
 			},
-		},
+		}),
 		channelCPs:   nil,
 		chunkManager: nil,
 		indexes: map[UniqueID]map[UniqueID]*model.Index{
@@ -747,19 +741,17 @@ func TestMeta_GetSegmentIndexes(t *testing.T) {
 	t.Run("no index exist", func(t *testing.T) {
 		m = &meta{
 			RWMutex: sync.RWMutex{},
-			segments: &SegmentsInfo{
-				segments: map[UniqueID]*SegmentInfo{
-					segID: {
-						SegmentInfo: &datapb.SegmentInfo{
-							ID:           segID,
-							CollectionID: collID,
-							PartitionID:  partID,
-							NumOfRows:    0,
-							State:        commonpb.SegmentState_Flushed,
-						},
+			segments: buildSegmentsInfo(map[UniqueID]*SegmentInfo{
+				segID: {
+					SegmentInfo: &datapb.SegmentInfo{
+						ID:           segID,
+						CollectionID: collID,
+						PartitionID:  partID,
+						NumOfRows:    0,
+						State:        commonpb.SegmentState_Flushed,
 					},
 				},
-			},
+			}),
 			indexes:              nil,
 			buildID2SegmentIndex: nil,
 		}
@@ -1019,39 +1011,37 @@ func updateSegmentIndexMeta(t *testing.T) *meta {
 
 	return &meta{
 		catalog: sc,
-		segments: &SegmentsInfo{
-			segments: map[UniqueID]*SegmentInfo{
-				segID: {
-					SegmentInfo: &datapb.SegmentInfo{
-						ID:            segID,
+		segments: buildSegmentsInfo(map[UniqueID]*SegmentInfo{
+			segID: {
+				SegmentInfo: &datapb.SegmentInfo{
+					ID:            segID,
+					CollectionID:  collID,
+					PartitionID:   partID,
+					InsertChannel: "",
+					NumOfRows:     1025,
+					State:         commonpb.SegmentState_Flushed,
+				},
+				segmentIndexes: map[UniqueID]*model.SegmentIndex{
+					indexID: {
+						SegmentID:     segID,
 						CollectionID:  collID,
 						PartitionID:   partID,
-						InsertChannel: "",
-						NumOfRows:     1025,
-						State:         commonpb.SegmentState_Flushed,
-					},
-					segmentIndexes: map[UniqueID]*model.SegmentIndex{
-						indexID: {
-							SegmentID:     segID,
-							CollectionID:  collID,
-							PartitionID:   partID,
-							NumRows:       1025,
-							IndexID:       indexID,
-							BuildID:       buildID,
-							NodeID:        0,
-							IndexVersion:  0,
-							IndexState:    commonpb.IndexState_Unissued,
-							FailReason:    "",
-							IsDeleted:     false,
-							CreateTime:    0,
-							IndexFileKeys: nil,
-							IndexSize:     0,
-							WriteHandoff:  false,
-						},
+						NumRows:       1025,
+						IndexID:       indexID,
+						BuildID:       buildID,
+						NodeID:        0,
+						IndexVersion:  0,
+						IndexState:    commonpb.IndexState_Unissued,
+						FailReason:    "",
+						IsDeleted:     false,
+						CreateTime:    0,
+						IndexFileKeys: nil,
+						IndexSize:     0,
+						WriteHandoff:  false,
 					},
 				},
 			},
-		},
+		}),
 		indexes: map[UniqueID]map[UniqueID]*model.Index{
 			collID: {
 				indexID: {
@@ -1121,13 +1111,15 @@ func TestMeta_FinishTask(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		err := m.FinishTask(&indexpb.IndexTaskInfo{
-			BuildID:        buildID,
-			State:          commonpb.IndexState_Finished,
-			IndexFileKeys:  []string{"file1", "file2"},
-			SerializedSize: 1024,
-			FailReason:     "",
+			BuildID:            buildID,
+			State:              commonpb.IndexState_Finished,
+			IndexFileKeys:      []string{"file1", "file2"},
+			SerializedSize:     1024,
+			FailReason:         "",
+			IndexEngineVersion: common.CurrentIndexEngineVersion,
 		})
 		assert.NoError(t, err)
+		assert.Equal(t, common.CurrentIndexEngineVersion, m.buildID2SegmentIndex[buildID].IndexEngineVersion)
 	})
 
 	t.Run("fail", func(t *testing.T) {
@@ -1159,6 +1151,64 @@ func TestMeta_FinishTask(t *testing.T) {
 	})
 }
 
+func TestMeta_RebuildSegmentIndex(t *testing.T) {
+	m := updateSegmentIndexMeta(t)
+	catalog := catalogmocks.NewDataCoordCatalog(t)
+	catalog.On("AlterSegmentIndexes", mock.Anything, mock.Anything).Return(nil)
+	catalog.On("AlterIndexes", mock.Anything, mock.Anything).Return(nil)
+	catalog.On("DropSegmentIndex", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	m.catalog = catalog
+
+	pendingParams := []*commonpb.KeyValuePair{{Key: "efConstruction", Value: "300"}}
+	m.indexes[collID][indexID].PendingIndexParams = pendingParams
+	rebuild := &model.SegmentIndex{
+		SegmentID:    segID,
+		CollectionID: collID,
+		PartitionID:  partID,
+		NumRows:      1025,
+		IndexID:      indexID,
+		BuildID:      buildID + 10,
+		IndexState:   commonpb.IndexState_InProgress,
+		IsRebuild:    true,
+	}
+	m.updateSegmentIndex(rebuild)
+	assert.Equal(t, buildID, m.GetSegmentIndexes(segID)[0].BuildID)
+	assert.Equal(t, pendingParams, m.GetBuildIndexParams(collID, indexID))
+	// the load policy is not used to build the index
+	m.indexes[collID][indexID].PendingIndexParams = append([]*commonpb.KeyValuePair{
+		{Key: common.IndexLoadPolicyKey, Value: common.IndexLoadPolicyLazy},
+	}, pendingParams...)
+	assert.Equal(t, pendingParams, m.GetBuildIndexParams(collID, indexID))
+	m.indexes[collID][indexID].PendingIndexParams = pendingParams
+
+	t.Run("rebuild failed", func(t *testing.T) {
+		err := m.FinishTask(&indexpb.IndexTaskInfo{
+			BuildID:    rebuild.BuildID,
+			State:      commonpb.IndexState_Failed,
+			FailReason: "mock failed",
+		})
+		assert.NoError(t, err)
+
+		// the serving segment index is kept, and the params are promoted since there is no rebuild task left.
+		assert.Equal(t, buildID, m.GetSegmentIndexes(segID)[0].BuildID)
+		assert.Empty(t, m.GetRebuildingSegmentIndexes())
+		assert.False(t, m.IsIndexAltering(collID, indexID))
+		assert.Equal(t, pendingParams, m.GetIndexParams(collID, indexID))
+
+		failed, ok := m.GetIndexJob(rebuild.BuildID)
+		assert.True(t, ok)
+		assert.True(t, m.IsReplacedSegmentIndex(failed))
+	})
+
+	t.Run("remove failed rebuild", func(t *testing.T) {
+		err := m.RemoveSegmentIndex(collID, partID, segID, indexID, rebuild.BuildID)
+		assert.NoError(t, err)
+		_, ok := m.GetIndexJob(rebuild.BuildID)
+		assert.False(t, ok)
+		assert.Equal(t, buildID, m.GetSegmentIndexes(segID)[0].BuildID)
+	})
+}
+
 func TestMeta_BuildIndex(t *testing.T) {
 	m := updateSegmentIndexMeta(t)
 	ec := catalogmocks.NewDataCoordCatalog(t)
@@ -1186,40 +1236,38 @@ func TestMeta_BuildIndex(t *testing.T) {
 
 func TestMeta_GetHasUnindexTaskSegments(t *testing.T) {
 	m := &meta{
-		segments: &SegmentsInfo{
-			segments: map[UniqueID]*SegmentInfo{
-				segID: {
-					SegmentInfo: &datapb.SegmentInfo{
-						ID:            segID,
-						CollectionID:  collID,
-						PartitionID:   partID,
-						InsertChannel: "",
-						NumOfRows:     1025,
-						State:         commonpb.SegmentState_Flushed,
-					},
+		segments: buildSegmentsInfo(map[UniqueID]*SegmentInfo{
+			segID: {
+				SegmentInfo: &datapb.SegmentInfo{
+					ID:            segID,
+					CollectionID:  collID,
+					PartitionID:   partID,
+					InsertChannel: "",
+					NumOfRows:     1025,
+					State:         commonpb.SegmentState_Flushed,
 				},
-				segID + 1: {
-					SegmentInfo: &datapb.SegmentInfo{
-						ID:            segID + 1,
-						CollectionID:  collID,
-						PartitionID:   partID,
-						InsertChannel: "",
-						NumOfRows:     1025,
-						State:         commonpb.SegmentState_Growing,
-					},
+			},
+			segID + 1: {
+				SegmentInfo: &datapb.SegmentInfo{
+					ID:            segID + 1,
+					CollectionID:  collID,
+					PartitionID:   partID,
+					InsertChannel: "",
+					NumOfRows:     1025,
+					State:         commonpb.SegmentState_Growing,
 				},
-				segID + 2: {
-					SegmentInfo: &datapb.SegmentInfo{
-						ID:            segID + 2,
-						CollectionID:  collID,
-						PartitionID:   partID,
-						InsertChannel: "",
-						NumOfRows:     1025,
-						State:         commonpb.SegmentState_Dropped,
-					},
+			},
+			segID + 2: {
+				SegmentInfo: &datapb.SegmentInfo{
+					ID:            segID + 2,
+					CollectionID:  collID,
+					PartitionID:   partID,
+					InsertChannel: "",
+					NumOfRows:     1025,
+					State:         commonpb.SegmentState_Dropped,
 				},
 			},
-		},
+		}),
 		indexes: map[UniqueID]map[UniqueID]*model.Index{
 			collID: {
 				indexID: {
@@ -1249,9 +1297,7 @@ func TestMeta_GetHasUnindexTaskSegments(t *testing.T) {
 // see also: https://github.com/milvus-io/milvus/issues/21660
 func TestUpdateSegmentIndexNotExists(t *testing.T) {
 	m := &meta{
-		segments: &SegmentsInfo{
-			segments: map[UniqueID]*SegmentInfo{},
-		},
+		segments:             buildSegmentsInfo(map[UniqueID]*SegmentInfo{}),
 		indexes:              map[UniqueID]map[UniqueID]*model.Index{},
 		buildID2SegmentIndex: make(map[UniqueID]*model.SegmentIndex),
 	}
@@ -19,15 +19,19 @@ package datacoord
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"time"
 
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/indexparamcheck"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metautil"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -74,6 +78,14 @@ func (s *Server) createIndexForSegment(segment *SegmentInfo, indexID UniqueID) e
 }
 
 func (s *Server) createIndexesForSegment(segment *SegmentInfo) error {
+	if segment.GetLevel() == datapb.SegmentLevel_L0 {
+		// L0 segments have only deltalogs
+		return nil
+	}
+	if segment.GetExternalSource() != nil {
+		// the segments registered on the external files are indexed once converted into binlogs
+		return nil
+	}
 	indexes := s.meta.GetIndexesForCollection(segment.CollectionID, "")
 	for _, index := range indexes {
 		if _, ok := segment.segmentIndexes[index.IndexID]; !ok {
@@ -99,6 +111,7 @@ func (s *Server) createIndexForSegmentLoop(ctx context.Context) {
 			log.Warn("DataCoord context done, exit...")
 			return
 		case <-ticker.C:
+			s.meta.PromoteAlteredIndexes()
 			segments := s.meta.GetHasUnindexTaskSegments()
 			for _, segment := range segments {
 				if err := s.createIndexesForSegment(segment); err != nil {
@@ -154,6 +167,9 @@ func (s *Server) CreateIndex(ctx context.Context, req *indexpb.CreateIndexReques
 		errResp.Reason = msgDataCoordIsUnhealthy(paramtable.GetNodeID())
 		return errResp, nil
 	}
+	if err := s.metaStoreMonitor.CheckWritable(); err != nil {
+		return merr.Status(err), nil
+	}
 	metrics.IndexRequestCounter.WithLabelValues(metrics.TotalLabel).Inc()
 
 	indexID, err := s.meta.CanCreateIndex(req)
@@ -379,6 +395,18 @@ func (s *Server) countIndexedRows(indexInfo *indexpb.IndexInfo, segments []*Segm
 // completeIndexInfo get the index row count and index task state
 // if realTime, calculate current statistics
 // if not realTime, which means get info of the prior `CreateIndex` action, skip segments created after index's create time
+// getSegmentIndexEngineVersions returns the index engine versions of the finished segment indexes,
+// which tells the segments to rebuild before downgrading QueryNodes.
+func getSegmentIndexEngineVersions(indexID UniqueID, segments []*SegmentInfo) map[int64]int32 {
+	versions := make(map[int64]int32)
+	for _, seg := range segments {
+		if segIdx, ok := seg.segmentIndexes[indexID]; ok && segIdx.IndexState == commonpb.IndexState_Finished {
+			versions[seg.GetID()] = segIdx.IndexEngineVersion
+		}
+	}
+	return versions
+}
+
 func (s *Server) completeIndexInfo(indexInfo *indexpb.IndexInfo, index *model.Index, segments []*SegmentInfo, realTime bool, ts Timestamp) {
 	var (
 		cntNone          = 0
@@ -570,12 +598,14 @@ func (s *Server) DescribeIndex(ctx context.Context, req *indexpb.DescribeIndexRe
 			IndexStateFailReason: "",
 			IsAutoIndex:          index.IsAutoIndex,
 			UserIndexParams:      index.UserIndexParams,
+			PendingIndexParams:   index.PendingIndexParams,
 		}
 		createTs := index.CreateTime
 		if req.GetTimestamp() != 0 {
 			createTs = req.GetTimestamp()
 		}
 		s.completeIndexInfo(indexInfo, index, segments, false, createTs)
+		indexInfo.SegmentIndexEngineVersions = getSegmentIndexEngineVersions(index.IndexID, segments)
 		indexInfos = append(indexInfos, indexInfo)
 	}
 	log.Info("DescribeIndex success", zap.String("indexName", req.GetIndexName()))
@@ -633,6 +663,7 @@ func (s *Server) GetIndexStatistics(ctx context.Context, req *indexpb.GetIndexSt
 			IndexStateFailReason: "",
 			IsAutoIndex:          index.IsAutoIndex,
 			UserIndexParams:      index.UserIndexParams,
+			PendingIndexParams:   index.PendingIndexParams,
 		}
 		s.completeIndexInfo(indexInfo, index, segments, true, index.CreateTime)
 		indexInfos = append(indexInfos, indexInfo)
@@ -667,6 +698,9 @@ func (s *Server) DropIndex(ctx context.Context, req *indexpb.DropIndexRequest) (
 		errResp.Reason = msgDataCoordIsUnhealthy(paramtable.GetNodeID())
 		return errResp, nil
 	}
+	if err := s.metaStoreMonitor.CheckWritable(); err != nil {
+		return merr.Status(err), nil
+	}
 
 	ret := &commonpb.Status{
 		ErrorCode: commonpb.ErrorCode_Success,
@@ -704,6 +738,130 @@ func (s *Server) DropIndex(ctx context.Context, req *indexpb.DropIndexRequest) (
 	return ret, nil
 }
 
+// AlterIndex alters the index params which only matter when building the index, e.g. efConstruction of HNSW.
+// The segment indexes built with the old params are rebuilt in the background alongside the serving ones,
+// and each of them replaces the serving one of its segment atomically once finished. The altered params
+// take effect on the index after all the segment indexes are rebuilt.
+func (s *Server) AlterIndex(ctx context.Context, req *indexpb.AlterIndexRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.String("indexName", req.GetIndexName()),
+	)
+	log.Info("receive AlterIndex request", zap.Any("params", req.GetParams()))
+	if s.isClosed() {
+		log.Warn(msgDataCoordIsUnhealthy(paramtable.GetNodeID()))
+		return merr.Status(merr.WrapErrServiceUnavailable("Datacoord not ready")), nil
+	}
+	if err := s.metaStoreMonitor.CheckWritable(); err != nil {
+		return merr.Status(err), nil
+	}
+
+	indexes := s.meta.GetIndexesForCollection(req.GetCollectionID(), req.GetIndexName())
+	if len(indexes) == 0 {
+		err := merr.WrapErrIndexNotFound(fmt.Sprintf("collectionID: %d, indexName: %s", req.GetCollectionID(), req.GetIndexName()))
+		log.Warn("AlterIndex fail", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	if len(indexes) > 1 {
+		log.Warn(msgAmbiguousIndexName())
+		return merr.Status(merr.WrapErrParameterInvalid("index name", "empty", msgAmbiguousIndexName())), nil
+	}
+	index := indexes[0]
+	if len(index.PendingIndexParams) > 0 {
+		err := merr.WrapErrServiceInternal("the index is being altered, please retry after it is done")
+		log.Warn("AlterIndex fail", zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	indexParams, err := checkAlteredIndexParams(index, req.GetParams())
+	if err != nil {
+		log.Warn("AlterIndex fail", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	if reflect.DeepEqual(funcutil.KeyValuePair2Map(index.IndexParams), funcutil.KeyValuePair2Map(indexParams)) {
+		log.Info("index params not changed, skip altering")
+		return merr.Status(nil), nil
+	}
+	userIndexParams := index.UserIndexParams
+	if !index.IsAutoIndex {
+		userIndexParams = mergeIndexParams(userIndexParams, req.GetParams())
+	}
+
+	// add the rebuild tasks before starting to roll out the params,
+	// so that the params are never promoted before the segment indexes are rebuilt.
+	buildIDs := make([]UniqueID, 0)
+	segments := s.meta.SelectSegments(func(info *SegmentInfo) bool {
+		return isFlush(info) && info.CollectionID == req.GetCollectionID()
+	})
+	for _, segment := range segments {
+		segIdx, ok := segment.segmentIndexes[index.IndexID]
+		// the unissued segment index is built with the altered params, and the failed one is never served.
+		if !ok || segIdx.IsDeleted || segIdx.IndexState == commonpb.IndexState_Unissued ||
+			segIdx.IndexState == commonpb.IndexState_Failed {
+			continue
+		}
+		buildID, err := s.allocator.allocID(ctx)
+		if err != nil {
+			log.Warn("failed to alloc buildID", zap.Error(err))
+			return merr.Status(err), nil
+		}
+		rebuild := &model.SegmentIndex{
+			SegmentID:    segment.ID,
+			CollectionID: segment.CollectionID,
+			PartitionID:  segment.PartitionID,
+			NumRows:      segment.NumOfRows,
+			IndexID:      index.IndexID,
+			BuildID:      buildID,
+			CreateTime:   segIdx.CreateTime,
+			IsRebuild:    true,
+		}
+		if err := s.meta.AddSegmentIndex(rebuild); err != nil {
+			log.Warn("failed to add rebuild task", zap.Int64("segmentID", segment.ID), zap.Error(err))
+			return merr.Status(err), nil
+		}
+		buildIDs = append(buildIDs, buildID)
+	}
+
+	if err := s.meta.AlterIndexParams(index.CollectionID, index.IndexID, indexParams, userIndexParams); err != nil {
+		log.Warn("AlterIndex fail", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	for _, buildID := range buildIDs {
+		s.indexBuilder.enqueue(buildID)
+	}
+
+	log.Info("AlterIndex successfully", zap.Int64("indexID", index.IndexID),
+		zap.Any("indexParams", indexParams), zap.Int("rebuildNum", len(buildIDs)))
+	return merr.Status(nil), nil
+}
+
+// checkAlteredIndexParams checks the altered params and returns the index params after altered.
+func checkAlteredIndexParams(index *model.Index, altered []*commonpb.KeyValuePair) ([]*commonpb.KeyValuePair, error) {
+	if len(altered) == 0 {
+		return nil, merr.WrapErrParameterInvalid("index params to alter", "empty")
+	}
+	for _, param := range altered {
+		if _, ok := alterableIndexParams[param.GetKey()]; !ok {
+			return nil, merr.WrapErrParameterInvalid("alterable index param", param.GetKey(),
+				"only the params which matter when building the index can be altered")
+		}
+	}
+	indexParams := mergeIndexParams(index.IndexParams, altered)
+
+	checker, err := indexparamcheck.GetIndexCheckerMgrInstance().GetChecker(getIndexType(indexParams))
+	if err != nil {
+		return nil, err
+	}
+	trainParams := funcutil.KeyValuePair2Map(index.TypeParams)
+	for key, value := range funcutil.KeyValuePair2Map(indexParams) {
+		trainParams[key] = value
+	}
+	if err := checker.CheckTrain(trainParams); err != nil {
+		return nil, merr.WrapErrParameterInvalid("valid index params", fmt.Sprint(altered), err.Error())
+	}
+	return indexParams, nil
+}
+
 // GetIndexInfos gets the index file paths for segment from DataCoord.
 func (s *Server) GetIndexInfos(ctx context.Context, req *indexpb.GetIndexInfoRequest) (*indexpb.GetIndexInfoResponse, error) {
 	log := log.Ctx(ctx).With(
@@ -747,16 +905,17 @@ func (s *Server) GetIndexInfos(ctx context.Context, req *indexpb.GetIndexInfoReq
 					indexParams = append(indexParams, s.meta.GetTypeParams(segIdx.CollectionID, segIdx.IndexID)...)
 					ret.SegmentInfo[segID].IndexInfos = append(ret.SegmentInfo[segID].IndexInfos,
 						&indexpb.IndexFilePathInfo{
-							SegmentID:      segID,
-							FieldID:        s.meta.GetFieldIDByIndexID(segIdx.CollectionID, segIdx.IndexID),
-							IndexID:        segIdx.IndexID,
-							BuildID:        segIdx.BuildID,
-							IndexName:      s.meta.GetIndexNameByID(segIdx.CollectionID, segIdx.IndexID),
-							IndexParams:    indexParams,
-							IndexFilePaths: indexFilePaths,
-							SerializedSize: segIdx.IndexSize,
-							IndexVersion:   segIdx.IndexVersion,
-							NumRows:        segIdx.NumRows,
+							SegmentID:          segID,
+							FieldID:            s.meta.GetFieldIDByIndexID(segIdx.CollectionID, segIdx.IndexID),
+							IndexID:            segIdx.IndexID,
+							BuildID:            segIdx.BuildID,
+							IndexName:          s.meta.GetIndexNameByID(segIdx.CollectionID, segIdx.IndexID),
+							IndexParams:        indexParams,
+							IndexFilePaths:     indexFilePaths,
+							SerializedSize:     segIdx.IndexSize,
+							IndexVersion:       segIdx.IndexVersion,
+							NumRows:            segIdx.NumRows,
+							IndexEngineVersion: segIdx.IndexEngineVersion,
 						})
 				}
 			}
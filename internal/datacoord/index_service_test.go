@@ -37,6 +37,7 @@ import (
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
 func TestServerId(t *testing.T) {
@@ -221,7 +222,7 @@ func TestServer_GetIndexState(t *testing.T) {
 				},
 			},
 		},
-		segments: &SegmentsInfo{map[UniqueID]*SegmentInfo{
+		segments: buildSegmentsInfo(map[UniqueID]*SegmentInfo{
 			segID: {
 				SegmentInfo: &datapb.SegmentInfo{
 					ID:             segID,
@@ -243,7 +244,7 @@ func TestServer_GetIndexState(t *testing.T) {
 				isCompacting:    false,
 				lastWrittenTime: time.Time{},
 			},
-		}},
+		}),
 	}
 
 	t.Run("index state is unissued", func(t *testing.T) {
@@ -272,7 +273,7 @@ func TestServer_GetIndexState(t *testing.T) {
 				},
 			},
 		},
-		segments: &SegmentsInfo{map[UniqueID]*SegmentInfo{
+		segments: buildSegmentsInfo(map[UniqueID]*SegmentInfo{
 			segID: {
 				SegmentInfo: &datapb.SegmentInfo{
 					ID:             segID,
@@ -312,7 +313,7 @@ func TestServer_GetIndexState(t *testing.T) {
 				isCompacting:    false,
 				lastWrittenTime: time.Time{},
 			},
-		}},
+		}),
 	}
 
 	t.Run("index state is node", func(t *testing.T) {
@@ -374,7 +375,7 @@ func TestServer_GetSegmentIndexState(t *testing.T) {
 		meta: &meta{
 			catalog:  &datacoord.Catalog{MetaKv: mocks.NewMetaKv(t)},
 			indexes:  map[UniqueID]map[UniqueID]*model.Index{},
-			segments: &SegmentsInfo{map[UniqueID]*SegmentInfo{}},
+			segments: buildSegmentsInfo(map[UniqueID]*SegmentInfo{}),
 		},
 		allocator:       newMockAllocator(),
 		notifyIndexChan: make(chan UniqueID, 1),
@@ -410,7 +411,7 @@ func TestServer_GetSegmentIndexState(t *testing.T) {
 				UserIndexParams: nil,
 			},
 		}
-		s.meta.segments.segments[segID] = &SegmentInfo{
+		s.meta.segments.SetSegment(segID, &SegmentInfo{
 			SegmentInfo: nil,
 			segmentIndexes: map[UniqueID]*model.SegmentIndex{
 				indexID: {
@@ -436,7 +437,7 @@ func TestServer_GetSegmentIndexState(t *testing.T) {
 			lastFlushTime:   time.Time{},
 			isCompacting:    false,
 			lastWrittenTime: time.Time{},
-		}
+		})
 
 		resp, err := s.GetSegmentIndexState(ctx, req)
 		assert.NoError(t, err)
@@ -444,7 +445,7 @@ func TestServer_GetSegmentIndexState(t *testing.T) {
 	})
 
 	t.Run("finish", func(t *testing.T) {
-		s.meta.segments.segments[segID] = &SegmentInfo{
+		s.meta.segments.SetSegment(segID, &SegmentInfo{
 			SegmentInfo: nil,
 			segmentIndexes: map[UniqueID]*model.SegmentIndex{
 				indexID: {
@@ -470,7 +471,7 @@ func TestServer_GetSegmentIndexState(t *testing.T) {
 			lastFlushTime:   time.Time{},
 			isCompacting:    false,
 			lastWrittenTime: time.Time{},
-		}
+		})
 		resp, err := s.GetSegmentIndexState(ctx, req)
 		assert.NoError(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
@@ -509,7 +510,7 @@ func TestServer_GetIndexBuildProgress(t *testing.T) {
 		meta: &meta{
 			catalog:  &datacoord.Catalog{MetaKv: mocks.NewMetaKv(t)},
 			indexes:  map[UniqueID]map[UniqueID]*model.Index{},
-			segments: &SegmentsInfo{map[UniqueID]*SegmentInfo{}},
+			segments: buildSegmentsInfo(map[UniqueID]*SegmentInfo{}),
 		},
 		allocator:       newMockAllocator(),
 		notifyIndexChan: make(chan UniqueID, 1),
@@ -544,31 +545,29 @@ func TestServer_GetIndexBuildProgress(t *testing.T) {
 				UserIndexParams: nil,
 			},
 		}
-		s.meta.segments = &SegmentsInfo{
-			segments: map[UniqueID]*SegmentInfo{
-				segID: {
-					SegmentInfo: &datapb.SegmentInfo{
-						ID:             segID,
-						CollectionID:   collID,
-						PartitionID:    partID,
-						InsertChannel:  "",
-						NumOfRows:      10250,
-						State:          commonpb.SegmentState_Flushed,
-						MaxRowNum:      65536,
-						LastExpireTime: createTS,
-						StartPosition: &msgpb.MsgPosition{
-							Timestamp: createTS,
-						},
+		s.meta.segments = buildSegmentsInfo(map[UniqueID]*SegmentInfo{
+			segID: {
+				SegmentInfo: &datapb.SegmentInfo{
+					ID:             segID,
+					CollectionID:   collID,
+					PartitionID:    partID,
+					InsertChannel:  "",
+					NumOfRows:      10250,
+					State:          commonpb.SegmentState_Flushed,
+					MaxRowNum:      65536,
+					LastExpireTime: createTS,
+					StartPosition: &msgpb.MsgPosition{
+						Timestamp: createTS,
 					},
-					segmentIndexes:  nil,
-					currRows:        10250,
-					allocations:     nil,
-					lastFlushTime:   time.Time{},
-					isCompacting:    false,
-					lastWrittenTime: time.Time{},
 				},
+				segmentIndexes:  nil,
+				currRows:        10250,
+				allocations:     nil,
+				lastFlushTime:   time.Time{},
+				isCompacting:    false,
+				lastWrittenTime: time.Time{},
 			},
-		}
+		})
 
 		resp, err := s.GetIndexBuildProgress(ctx, req)
 		assert.NoError(t, err)
@@ -578,49 +577,47 @@ func TestServer_GetIndexBuildProgress(t *testing.T) {
 	})
 
 	t.Run("finish", func(t *testing.T) {
-		s.meta.segments = &SegmentsInfo{
-			segments: map[UniqueID]*SegmentInfo{
-				segID: {
-					SegmentInfo: &datapb.SegmentInfo{
-						ID:             segID,
-						CollectionID:   collID,
-						PartitionID:    partID,
-						InsertChannel:  "",
-						NumOfRows:      10250,
-						State:          commonpb.SegmentState_Flushed,
-						MaxRowNum:      65536,
-						LastExpireTime: createTS,
-						StartPosition: &msgpb.MsgPosition{
-							Timestamp: createTS,
-						},
+		s.meta.segments = buildSegmentsInfo(map[UniqueID]*SegmentInfo{
+			segID: {
+				SegmentInfo: &datapb.SegmentInfo{
+					ID:             segID,
+					CollectionID:   collID,
+					PartitionID:    partID,
+					InsertChannel:  "",
+					NumOfRows:      10250,
+					State:          commonpb.SegmentState_Flushed,
+					MaxRowNum:      65536,
+					LastExpireTime: createTS,
+					StartPosition: &msgpb.MsgPosition{
+						Timestamp: createTS,
 					},
-					segmentIndexes: map[UniqueID]*model.SegmentIndex{
-						indexID: {
-							SegmentID:     segID,
-							CollectionID:  collID,
-							PartitionID:   partID,
-							NumRows:       10250,
-							IndexID:       indexID,
-							BuildID:       10,
-							NodeID:        0,
-							IndexVersion:  1,
-							IndexState:    commonpb.IndexState_Finished,
-							FailReason:    "",
-							IsDeleted:     false,
-							CreateTime:    createTS,
-							IndexFileKeys: []string{"file1", "file2"},
-							IndexSize:     0,
-							WriteHandoff:  false,
-						},
+				},
+				segmentIndexes: map[UniqueID]*model.SegmentIndex{
+					indexID: {
+						SegmentID:     segID,
+						CollectionID:  collID,
+						PartitionID:   partID,
+						NumRows:       10250,
+						IndexID:       indexID,
+						BuildID:       10,
+						NodeID:        0,
+						IndexVersion:  1,
+						IndexState:    commonpb.IndexState_Finished,
+						FailReason:    "",
+						IsDeleted:     false,
+						CreateTime:    createTS,
+						IndexFileKeys: []string{"file1", "file2"},
+						IndexSize:     0,
+						WriteHandoff:  false,
 					},
-					currRows:        10250,
-					allocations:     nil,
-					lastFlushTime:   time.Time{},
-					isCompacting:    false,
-					lastWrittenTime: time.Time{},
 				},
+				currRows:        10250,
+				allocations:     nil,
+				lastFlushTime:   time.Time{},
+				isCompacting:    false,
+				lastWrittenTime: time.Time{},
 			},
-		}
+		})
 
 		resp, err := s.GetIndexBuildProgress(ctx, req)
 		assert.NoError(t, err)
@@ -792,7 +789,7 @@ func TestServer_DescribeIndex(t *testing.T) {
 					},
 				},
 			},
-			segments: &SegmentsInfo{map[UniqueID]*SegmentInfo{
+			segments: buildSegmentsInfo(map[UniqueID]*SegmentInfo{
 				invalidSegID: {
 					SegmentInfo: &datapb.SegmentInfo{
 						ID:             invalidSegID,
@@ -825,21 +822,22 @@ func TestServer_DescribeIndex(t *testing.T) {
 					},
 					segmentIndexes: map[UniqueID]*model.SegmentIndex{
 						indexID: {
-							SegmentID:     segID,
-							CollectionID:  collID,
-							PartitionID:   partID,
-							NumRows:       10000,
-							IndexID:       indexID,
-							BuildID:       buildID,
-							NodeID:        0,
-							IndexVersion:  1,
-							IndexState:    commonpb.IndexState_Finished,
-							FailReason:    "",
-							IsDeleted:     false,
-							CreateTime:    createTS,
-							IndexFileKeys: nil,
-							IndexSize:     0,
-							WriteHandoff:  false,
+							SegmentID:          segID,
+							CollectionID:       collID,
+							PartitionID:        partID,
+							NumRows:            10000,
+							IndexID:            indexID,
+							BuildID:            buildID,
+							NodeID:             0,
+							IndexVersion:       1,
+							IndexState:         commonpb.IndexState_Finished,
+							FailReason:         "",
+							IsDeleted:          false,
+							CreateTime:         createTS,
+							IndexFileKeys:      nil,
+							IndexSize:          0,
+							WriteHandoff:       false,
+							IndexEngineVersion: 1,
 						},
 						indexID + 1: {
 							SegmentID:     segID,
@@ -988,7 +986,7 @@ func TestServer_DescribeIndex(t *testing.T) {
 						},
 					},
 				},
-			}},
+			}),
 		},
 		allocator:       newMockAllocator(),
 		notifyIndexChan: make(chan UniqueID, 1),
@@ -1008,6 +1006,11 @@ func TestServer_DescribeIndex(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
 		assert.Equal(t, 5, len(resp.GetIndexInfos()))
+		for _, info := range resp.GetIndexInfos() {
+			if info.GetIndexID() == indexID {
+				assert.EqualValues(t, 1, info.GetSegmentIndexEngineVersions()[segID])
+			}
+		}
 	})
 
 	t.Run("describe after drop index", func(t *testing.T) {
@@ -1153,7 +1156,7 @@ func TestServer_GetIndexStatistics(t *testing.T) {
 					},
 				},
 			},
-			segments: &SegmentsInfo{map[UniqueID]*SegmentInfo{
+			segments: buildSegmentsInfo(map[UniqueID]*SegmentInfo{
 				invalidSegID: {
 					SegmentInfo: &datapb.SegmentInfo{
 						ID:             segID,
@@ -1270,7 +1273,7 @@ func TestServer_GetIndexStatistics(t *testing.T) {
 						},
 					},
 				},
-			}},
+			}),
 		},
 		allocator:       newMockAllocator(),
 		notifyIndexChan: make(chan UniqueID, 1),
@@ -1419,7 +1422,7 @@ func TestServer_DropIndex(t *testing.T) {
 					},
 				},
 			},
-			segments: &SegmentsInfo{map[UniqueID]*SegmentInfo{
+			segments: buildSegmentsInfo(map[UniqueID]*SegmentInfo{
 				segID: {
 					SegmentInfo: &datapb.SegmentInfo{
 						ID:             segID,
@@ -1432,7 +1435,7 @@ func TestServer_DropIndex(t *testing.T) {
 					},
 					segmentIndexes: nil,
 				},
-			}},
+			}),
 		},
 		allocator:       newMockAllocator(),
 		notifyIndexChan: make(chan UniqueID, 1),
@@ -1503,6 +1506,221 @@ func TestServer_DropIndex(t *testing.T) {
 	})
 }
 
+func TestServer_AlterIndex(t *testing.T) {
+	var (
+		collID     = UniqueID(1)
+		partID     = UniqueID(2)
+		fieldID    = UniqueID(10)
+		indexID    = UniqueID(100)
+		segID      = UniqueID(1000)
+		buildID    = UniqueID(10000)
+		indexName  = "default_idx"
+		typeParams = []*commonpb.KeyValuePair{
+			{
+				Key:   common.DimKey,
+				Value: "128",
+			},
+		}
+		indexParams = []*commonpb.KeyValuePair{
+			{
+				Key:   common.IndexTypeKey,
+				Value: "HNSW",
+			},
+			{
+				Key:   common.MetricTypeKey,
+				Value: "L2",
+			},
+			{
+				Key:   "M",
+				Value: "16",
+			},
+			{
+				Key:   "efConstruction",
+				Value: "200",
+			},
+		}
+		ctx = context.Background()
+		req = &indexpb.AlterIndexRequest{
+			CollectionID: collID,
+			IndexName:    indexName,
+			Params: []*commonpb.KeyValuePair{
+				{
+					Key:   "efConstruction",
+					Value: "300",
+				},
+			},
+		}
+	)
+
+	catalog := catalogmocks.NewDataCoordCatalog(t)
+	catalog.On("AlterIndexes", mock.Anything, mock.Anything).Return(nil)
+	catalog.On("CreateSegmentIndex", mock.Anything, mock.Anything).Return(nil)
+	catalog.On("AlterSegmentIndexes", mock.Anything, mock.Anything).Return(nil)
+
+	servingSegIdx := &model.SegmentIndex{
+		SegmentID:    segID,
+		CollectionID: collID,
+		PartitionID:  partID,
+		NumRows:      10000,
+		IndexID:      indexID,
+		BuildID:      buildID,
+		IndexState:   commonpb.IndexState_Finished,
+	}
+	unissuedSegIdx := &model.SegmentIndex{
+		SegmentID:    segID + 1,
+		CollectionID: collID,
+		PartitionID:  partID,
+		NumRows:      10000,
+		IndexID:      indexID,
+		BuildID:      buildID + 1,
+		IndexState:   commonpb.IndexState_Unissued,
+	}
+	s := &Server{
+		meta: &meta{
+			catalog: catalog,
+			indexes: map[UniqueID]map[UniqueID]*model.Index{
+				collID: {
+					indexID: {
+						CollectionID:    collID,
+						FieldID:         fieldID,
+						IndexID:         indexID,
+						IndexName:       indexName,
+						TypeParams:      typeParams,
+						IndexParams:     indexParams,
+						UserIndexParams: indexParams,
+					},
+				},
+			},
+			segments: buildSegmentsInfo(map[UniqueID]*SegmentInfo{
+				segID: {
+					SegmentInfo: &datapb.SegmentInfo{
+						ID:           segID,
+						CollectionID: collID,
+						PartitionID:  partID,
+						NumOfRows:    10000,
+						State:        commonpb.SegmentState_Flushed,
+					},
+					segmentIndexes: map[UniqueID]*model.SegmentIndex{
+						indexID: servingSegIdx,
+					},
+				},
+				segID + 1: {
+					SegmentInfo: &datapb.SegmentInfo{
+						ID:           segID + 1,
+						CollectionID: collID,
+						PartitionID:  partID,
+						NumOfRows:    10000,
+						State:        commonpb.SegmentState_Flushed,
+					},
+					segmentIndexes: map[UniqueID]*model.SegmentIndex{
+						indexID: unissuedSegIdx,
+					},
+				},
+			}),
+			buildID2SegmentIndex: map[UniqueID]*model.SegmentIndex{
+				buildID:     servingSegIdx,
+				buildID + 1: unissuedSegIdx,
+			},
+		},
+		allocator: newMockAllocator(),
+		indexBuilder: &indexBuilder{
+			tasks:      make(map[int64]indexTaskState),
+			notifyChan: make(chan struct{}, 1),
+		},
+	}
+
+	t.Run("server not available", func(t *testing.T) {
+		s.stateCode.Store(commonpb.StateCode_Initializing)
+		resp, err := s.AlterIndex(ctx, req)
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrServiceUnavailable)
+	})
+
+	s.stateCode.Store(commonpb.StateCode_Healthy)
+
+	t.Run("index not exist", func(t *testing.T) {
+		resp, err := s.AlterIndex(ctx, &indexpb.AlterIndexRequest{
+			CollectionID: collID,
+			IndexName:    "not_exist",
+			Params:       req.GetParams(),
+		})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrIndexNotFound)
+	})
+
+	t.Run("param not alterable", func(t *testing.T) {
+		resp, err := s.AlterIndex(ctx, &indexpb.AlterIndexRequest{
+			CollectionID: collID,
+			IndexName:    indexName,
+			Params:       []*commonpb.KeyValuePair{{Key: "M", Value: "32"}},
+		})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrParameterInvalid)
+	})
+
+	t.Run("param out of range", func(t *testing.T) {
+		resp, err := s.AlterIndex(ctx, &indexpb.AlterIndexRequest{
+			CollectionID: collID,
+			IndexName:    indexName,
+			Params:       []*commonpb.KeyValuePair{{Key: "efConstruction", Value: "1"}},
+		})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrParameterInvalid)
+	})
+
+	t.Run("param not changed", func(t *testing.T) {
+		resp, err := s.AlterIndex(ctx, &indexpb.AlterIndexRequest{
+			CollectionID: collID,
+			IndexName:    indexName,
+			Params:       []*commonpb.KeyValuePair{{Key: "efConstruction", Value: "200"}},
+		})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp))
+		assert.False(t, s.meta.IsIndexAltering(collID, indexID))
+	})
+
+	t.Run("success", func(t *testing.T) {
+		resp, err := s.AlterIndex(ctx, req)
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp))
+		assert.True(t, s.meta.IsIndexAltering(collID, indexID))
+
+		// only the built segment index is rebuilt, the unissued one is built with the altered params.
+		rebuilds := s.meta.GetRebuildingSegmentIndexes()
+		assert.Len(t, rebuilds, 1)
+		rebuild := rebuilds[0]
+		assert.Equal(t, segID, rebuild.SegmentID)
+		assert.Contains(t, s.indexBuilder.tasks, rebuild.BuildID)
+		assert.Contains(t, s.meta.GetBuildIndexParams(collID, indexID), &commonpb.KeyValuePair{Key: "efConstruction", Value: "300"})
+		assert.Contains(t, s.meta.GetIndexParams(collID, indexID), &commonpb.KeyValuePair{Key: "efConstruction", Value: "200"})
+		// the serving segment index is not changed until the rebuild finished.
+		assert.Equal(t, buildID, s.meta.GetSegmentIndexes(segID)[0].BuildID)
+
+		resp, err = s.AlterIndex(ctx, req)
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(resp))
+
+		err = s.meta.FinishTask(&indexpb.IndexTaskInfo{
+			BuildID:       rebuild.BuildID,
+			State:         commonpb.IndexState_Finished,
+			IndexFileKeys: []string{"file1"},
+		})
+		assert.NoError(t, err)
+		segIdxes := s.meta.GetSegmentIndexes(segID)
+		assert.Len(t, segIdxes, 1)
+		assert.Equal(t, rebuild.BuildID, segIdxes[0].BuildID)
+		assert.False(t, segIdxes[0].IsRebuild)
+
+		replaced, ok := s.meta.GetIndexJob(buildID)
+		assert.True(t, ok)
+		assert.True(t, replaced.IsDeleted)
+		assert.True(t, s.meta.IsReplacedSegmentIndex(replaced))
+
+		assert.False(t, s.meta.IsIndexAltering(collID, indexID))
+		assert.Contains(t, s.meta.GetIndexParams(collID, indexID), &commonpb.KeyValuePair{Key: "efConstruction", Value: "300"})
+	})
+}
+
 func TestServer_GetIndexInfos(t *testing.T) {
 	var (
 		collID     = UniqueID(1)
@@ -1558,40 +1776,38 @@ func TestServer_GetIndexInfos(t *testing.T) {
 					},
 				},
 			},
-			segments: &SegmentsInfo{
-				map[UniqueID]*SegmentInfo{
-					segID: {
-						SegmentInfo: &datapb.SegmentInfo{
-							ID:             segID,
-							CollectionID:   collID,
-							PartitionID:    partID,
-							NumOfRows:      10000,
-							State:          commonpb.SegmentState_Flushed,
-							MaxRowNum:      65536,
-							LastExpireTime: createTS,
-						},
-						segmentIndexes: map[UniqueID]*model.SegmentIndex{
-							indexID: {
-								SegmentID:     segID,
-								CollectionID:  collID,
-								PartitionID:   partID,
-								NumRows:       10000,
-								IndexID:       indexID,
-								BuildID:       buildID,
-								NodeID:        0,
-								IndexVersion:  1,
-								IndexState:    commonpb.IndexState_Finished,
-								FailReason:    "",
-								IsDeleted:     false,
-								CreateTime:    createTS,
-								IndexFileKeys: nil,
-								IndexSize:     0,
-								WriteHandoff:  false,
-							},
+			segments: buildSegmentsInfo(map[UniqueID]*SegmentInfo{
+				segID: {
+					SegmentInfo: &datapb.SegmentInfo{
+						ID:             segID,
+						CollectionID:   collID,
+						PartitionID:    partID,
+						NumOfRows:      10000,
+						State:          commonpb.SegmentState_Flushed,
+						MaxRowNum:      65536,
+						LastExpireTime: createTS,
+					},
+					segmentIndexes: map[UniqueID]*model.SegmentIndex{
+						indexID: {
+							SegmentID:     segID,
+							CollectionID:  collID,
+							PartitionID:   partID,
+							NumRows:       10000,
+							IndexID:       indexID,
+							BuildID:       buildID,
+							NodeID:        0,
+							IndexVersion:  1,
+							IndexState:    commonpb.IndexState_Finished,
+							FailReason:    "",
+							IsDeleted:     false,
+							CreateTime:    createTS,
+							IndexFileKeys: nil,
+							IndexSize:     0,
+							WriteHandoff:  false,
 						},
 					},
 				},
-			},
+			}),
 			chunkManager: cli,
 		},
 		allocator:       newMockAllocator(),
@@ -96,8 +96,9 @@ func (nm *IndexNodeManager) AddNode(nodeID UniqueID, address string) error {
 	return nil
 }
 
-// PeekClient peeks the client with the least load.
-func (nm *IndexNodeManager) PeekClient(meta *model.SegmentIndex) (UniqueID, types.IndexNode) {
+// PeekClient peeks the client with the least load,
+// the client must have diskBuildSize of local disk space left if the index is built on disk.
+func (nm *IndexNodeManager) PeekClient(meta *model.SegmentIndex, diskBuildSize int64) (UniqueID, types.IndexNode) {
 	allClients := nm.GetAllClients()
 	if len(allClients) == 0 {
 		log.Error("there is no IndexNode online")
@@ -128,6 +129,11 @@ func (nm *IndexNodeManager) PeekClient(meta *model.SegmentIndex) (UniqueID, type
 					zap.String("reason", resp.Status.Reason))
 				return
 			}
+			if resp.TaskSlots > 0 && resp.GetAvailableDiskSize() < diskBuildSize {
+				log.RatedInfo(5, "IndexNode doesn't have enough disk space to build the index", zap.Int64("nodeID", nodeID),
+					zap.Int64("required", diskBuildSize), zap.Int64("available", resp.GetAvailableDiskSize()))
+				return
+			}
 			if resp.TaskSlots > 0 {
 				nodeMutex.Lock()
 				defer nodeMutex.Unlock()
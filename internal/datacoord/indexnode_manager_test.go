@@ -33,7 +33,7 @@ import (
 
 func TestIndexNodeManager_AddNode(t *testing.T) {
 	nm := NewNodeManager(context.Background(), defaultIndexNodeCreatorFunc)
-	nodeID, client := nm.PeekClient(&model.SegmentIndex{})
+	nodeID, client := nm.PeekClient(&model.SegmentIndex{}, 0)
 	assert.Equal(t, int64(-1), nodeID)
 	assert.Nil(t, client)
 
@@ -144,10 +144,42 @@ func TestIndexNodeManager_PeekClient(t *testing.T) {
 			},
 		}
 
-		nodeID, client := nm.PeekClient(&model.SegmentIndex{})
+		nodeID, client := nm.PeekClient(&model.SegmentIndex{}, 0)
 		assert.NotNil(t, client)
 		assert.Contains(t, []UniqueID{8, 9}, nodeID)
 	})
+
+	t.Run("disk space", func(t *testing.T) {
+		newMock := func(availableDiskSize int64) *indexnode.Mock {
+			return &indexnode.Mock{
+				CallGetJobStats: func(ctx context.Context, req *indexpb.GetJobStatsRequest) (*indexpb.GetJobStatsResponse, error) {
+					return &indexpb.GetJobStatsResponse{
+						TaskSlots:         1,
+						EnableDisk:        true,
+						AvailableDiskSize: availableDiskSize,
+						Status: &commonpb.Status{
+							ErrorCode: commonpb.ErrorCode_Success,
+						},
+					}, nil
+				},
+			}
+		}
+		nm := &IndexNodeManager{
+			ctx: context.TODO(),
+			nodeClients: map[UniqueID]types.IndexNode{
+				1: newMock(100),
+				2: newMock(1000),
+			},
+		}
+
+		nodeID, client := nm.PeekClient(&model.SegmentIndex{}, 500)
+		assert.NotNil(t, client)
+		assert.Equal(t, UniqueID(2), nodeID)
+
+		nodeID, client = nm.PeekClient(&model.SegmentIndex{}, 2000)
+		assert.Nil(t, client)
+		assert.Equal(t, UniqueID(0), nodeID)
+	})
 }
 
 func TestIndexNodeManager_ClientSupportDisk(t *testing.T) {
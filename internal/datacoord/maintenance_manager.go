@@ -0,0 +1,384 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// maintenanceManager holds the policies which pause or schedule compaction and gc,
+// the global policy is stored with collection id 0.
+// A nil maintenanceManager allows everything.
+type maintenanceManager struct {
+	mu       sync.RWMutex
+	catalog  metastore.DataCoordCatalog
+	policies map[UniqueID]*maintenancePolicy
+}
+
+type maintenancePolicy struct {
+	*datapb.MaintenancePolicy
+	compactionWindows []timeWindow
+	gcWindows         []timeWindow
+}
+
+func newMaintenanceManager(ctx context.Context, catalog metastore.DataCoordCatalog) (*maintenanceManager, error) {
+	m := &maintenanceManager{
+		catalog:  catalog,
+		policies: make(map[UniqueID]*maintenancePolicy),
+	}
+	policies, err := catalog.ListMaintenancePolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range policies {
+		policy, err := parseMaintenancePolicy(p)
+		if err != nil {
+			// keep datacoord available, the policy could be overwritten later.
+			log.Warn("skip invalid maintenance policy", zap.Int64("collectionID", p.GetCollectionID()), zap.Error(err))
+			continue
+		}
+		m.policies[p.GetCollectionID()] = policy
+	}
+	return m, nil
+}
+
+func parseMaintenancePolicy(p *datapb.MaintenancePolicy) (*maintenancePolicy, error) {
+	compactionWindows, err := parseSchedule(p.GetCompactionSchedule())
+	if err != nil {
+		return nil, fmt.Errorf("invalid compaction schedule: %w", err)
+	}
+	gcWindows, err := parseSchedule(p.GetGcSchedule())
+	if err != nil {
+		return nil, fmt.Errorf("invalid gc schedule: %w", err)
+	}
+	return &maintenancePolicy{
+		MaintenancePolicy: p,
+		compactionWindows: compactionWindows,
+		gcWindows:         gcWindows,
+	}, nil
+}
+
+// SetPolicy validates and persists the policy, the policy is removed if it pauses or schedules nothing.
+func (m *maintenanceManager) SetPolicy(ctx context.Context, p *datapb.MaintenancePolicy) error {
+	policy, err := parseMaintenancePolicy(p)
+	if err != nil {
+		return merr.WrapErrParameterInvalid("valid maintenance policy", p.String(), err.Error())
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.setPolicyLocked(ctx, policy)
+}
+
+func (m *maintenanceManager) setPolicyLocked(ctx context.Context, policy *maintenancePolicy) error {
+	p := policy.MaintenancePolicy
+	if !p.GetCompactionPaused() && !p.GetGcPaused() && len(policy.compactionWindows) == 0 && len(policy.gcWindows) == 0 {
+		if err := m.catalog.DropMaintenancePolicy(ctx, p.GetCollectionID()); err != nil {
+			return err
+		}
+		delete(m.policies, p.GetCollectionID())
+		return nil
+	}
+	if err := m.catalog.SaveMaintenancePolicy(ctx, p); err != nil {
+		return err
+	}
+	m.policies[p.GetCollectionID()] = policy
+	return nil
+}
+
+// PauseGC pauses the gc of all the collections by the global policy, until resumeTime if it's not zero.
+func (m *maintenanceManager) PauseGC(ctx context.Context, resumeTime time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	policy := m.globalPolicyLocked()
+	policy.GcPaused = true
+	policy.GcResumeTime = 0
+	if !resumeTime.IsZero() {
+		policy.GcResumeTime = resumeTime.UnixMilli()
+	}
+	return m.setPolicyLocked(ctx, policy)
+}
+
+// ResumeGC resumes the gc paused by the global policy, the gc schedule is kept.
+func (m *maintenanceManager) ResumeGC(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	policy := m.globalPolicyLocked()
+	policy.GcPaused = false
+	policy.GcResumeTime = 0
+	return m.setPolicyLocked(ctx, policy)
+}
+
+// globalPolicyLocked returns a copy of the global policy to modify.
+func (m *maintenanceManager) globalPolicyLocked() *maintenancePolicy {
+	p, ok := m.policies[0]
+	if !ok {
+		return &maintenancePolicy{MaintenancePolicy: &datapb.MaintenancePolicy{}}
+	}
+	return &maintenancePolicy{
+		MaintenancePolicy: proto.Clone(p.MaintenancePolicy).(*datapb.MaintenancePolicy),
+		compactionWindows: p.compactionWindows,
+		gcWindows:         p.gcWindows,
+	}
+}
+
+// GCPaused returns whether the gc is paused by the global policy.
+func (m *maintenanceManager) GCPaused() bool {
+	if m == nil {
+		return false
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	p, ok := m.policies[0]
+	return ok && p.gcPaused(time.Now())
+}
+
+// fillGCStatus fills the gc pausing and schedule of the policies into the response.
+func (m *maintenanceManager) fillGCStatus(resp *datapb.GetGCStatusResponse) {
+	if m == nil {
+		return
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	now := time.Now()
+	for id, p := range m.policies {
+		if id == 0 {
+			resp.Paused = p.gcPaused(now)
+			if resp.Paused {
+				resp.ResumeTime = p.GetGcResumeTime()
+			}
+			resp.OutOfSchedule = !inWindows(p.gcWindows, now)
+			continue
+		}
+		if p.gcPaused(now) {
+			resp.PausedCollectionIDs = append(resp.PausedCollectionIDs, id)
+		}
+	}
+	sort.Slice(resp.PausedCollectionIDs, func(i, j int) bool {
+		return resp.PausedCollectionIDs[i] < resp.PausedCollectionIDs[j]
+	})
+}
+
+// gcPaused returns whether the gc is paused at the moment, the pausing expires at the resume time.
+func (p *maintenancePolicy) gcPaused(now time.Time) bool {
+	return p.GetGcPaused() && (p.GetGcResumeTime() == 0 || now.UnixMilli() < p.GetGcResumeTime())
+}
+
+// ListPolicies returns all the policies ordered by collection id, the global one comes first.
+func (m *maintenanceManager) ListPolicies() []*datapb.MaintenancePolicy {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	policies := make([]*datapb.MaintenancePolicy, 0, len(m.policies))
+	for _, p := range m.policies {
+		policies = append(policies, proto.Clone(p.MaintenancePolicy).(*datapb.MaintenancePolicy))
+	}
+	sort.Slice(policies, func(i, j int) bool {
+		return policies[i].GetCollectionID() < policies[j].GetCollectionID()
+	})
+	return policies
+}
+
+// CompactionAllowed returns whether compaction of the collection is allowed at the moment,
+// manual compaction ignores the schedule but is still blocked by pausing.
+func (m *maintenanceManager) CompactionAllowed(collectionID UniqueID, manual bool) bool {
+	if m == nil {
+		return true
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	now := time.Now()
+	for _, id := range []UniqueID{0, collectionID} {
+		p, ok := m.policies[id]
+		if !ok {
+			continue
+		}
+		if p.GetCompactionPaused() {
+			return false
+		}
+		if !manual && !inWindows(p.compactionWindows, now) {
+			return false
+		}
+	}
+	return true
+}
+
+// GCAllowed returns whether the garbage of the collection could be collected at the moment,
+// pass 0 to check the global policy only.
+func (m *maintenanceManager) GCAllowed(collectionID UniqueID) bool {
+	if m == nil {
+		return true
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	now := time.Now()
+	for _, id := range []UniqueID{0, collectionID} {
+		p, ok := m.policies[id]
+		if !ok {
+			continue
+		}
+		if p.gcPaused(now) || !inWindows(p.gcWindows, now) {
+			return false
+		}
+	}
+	return true
+}
+
+const minutesPerDay = 24 * 60
+
+// timeWindow is a daily time range on some weekdays,
+// the window ends on the next day if end is less than start.
+type timeWindow struct {
+	weekdays uint8 // bitmap of time.Weekday
+	start    int   // minutes of the day
+	end      int
+}
+
+var weekdayNames = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// parseSchedule parses schedule like "Mon-Fri 22:00-06:00;Sat-Sun 00:00-24:00",
+// returns nil for empty schedule which means no restriction.
+func parseSchedule(schedule string) ([]timeWindow, error) {
+	var windows []timeWindow
+	for _, item := range strings.Split(schedule, ";") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		window := timeWindow{weekdays: 0x7f}
+		fields := strings.Fields(item)
+		switch len(fields) {
+		case 1:
+		case 2:
+			weekdays, err := parseWeekdays(fields[0])
+			if err != nil {
+				return nil, err
+			}
+			window.weekdays = weekdays
+		default:
+			return nil, fmt.Errorf("invalid window %q", item)
+		}
+
+		start, end, found := strings.Cut(fields[len(fields)-1], "-")
+		if !found {
+			return nil, fmt.Errorf("invalid time range %q", fields[len(fields)-1])
+		}
+		var err error
+		if window.start, err = parseMinuteOfDay(start); err != nil {
+			return nil, err
+		}
+		if window.end, err = parseMinuteOfDay(end); err != nil {
+			return nil, err
+		}
+		if window.start == window.end || window.start == minutesPerDay {
+			return nil, fmt.Errorf("invalid time range %q", fields[len(fields)-1])
+		}
+		windows = append(windows, window)
+	}
+	return windows, nil
+}
+
+func parseWeekdays(s string) (uint8, error) {
+	var weekdays uint8
+	for _, item := range strings.Split(s, ",") {
+		from, to, isRange := strings.Cut(strings.ToLower(item), "-")
+		first, ok := weekdayNames[from]
+		if !ok {
+			return 0, fmt.Errorf("invalid weekday %q", from)
+		}
+		last := first
+		if isRange {
+			if last, ok = weekdayNames[to]; !ok {
+				return 0, fmt.Errorf("invalid weekday %q", to)
+			}
+		}
+		for day := first; ; day = (day + 1) % 7 {
+			weekdays |= 1 << day
+			if day == last {
+				break
+			}
+		}
+	}
+	return weekdays, nil
+}
+
+func parseMinuteOfDay(s string) (int, error) {
+	hour, minute, found := strings.Cut(s, ":")
+	if !found {
+		return 0, fmt.Errorf("invalid time %q, should be HH:MM", s)
+	}
+	h, err := strconv.Atoi(hour)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q, should be HH:MM", s)
+	}
+	m, err := strconv.Atoi(minute)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q, should be HH:MM", s)
+	}
+	minutes := h*60 + m
+	if h < 0 || m < 0 || m >= 60 || minutes > minutesPerDay {
+		return 0, fmt.Errorf("invalid time %q, should be HH:MM", s)
+	}
+	return minutes, nil
+}
+
+// inWindows returns true if t is in any of the windows, or windows is empty.
+func inWindows(windows []timeWindow, t time.Time) bool {
+	if len(windows) == 0 {
+		return true
+	}
+	minute := t.Hour()*60 + t.Minute()
+	today := t.Weekday()
+	yesterday := (today + 6) % 7
+	for _, w := range windows {
+		if w.start < w.end {
+			if w.weekdays&(1<<today) != 0 && minute >= w.start && minute < w.end {
+				return true
+			}
+			continue
+		}
+		// the window crosses midnight, it belongs to the weekday it starts.
+		if w.weekdays&(1<<today) != 0 && minute >= w.start {
+			return true
+		}
+		if w.weekdays&(1<<yesterday) != 0 && minute < w.end {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,238 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+func TestParseSchedule(t *testing.T) {
+	windows, err := parseSchedule("")
+	assert.NoError(t, err)
+	assert.Empty(t, windows)
+
+	windows, err = parseSchedule("Mon-Fri 22:00-06:00; sat,Sun 00:00-24:00")
+	assert.NoError(t, err)
+	assert.Equal(t, []timeWindow{
+		{weekdays: 0x3e, start: 22 * 60, end: 6 * 60},
+		{weekdays: 0x41, start: 0, end: minutesPerDay},
+	}, windows)
+
+	windows, err = parseSchedule("Fri-Mon 01:30-02:00")
+	assert.NoError(t, err)
+	assert.Equal(t, uint8(0x63), windows[0].weekdays)
+
+	for _, schedule := range []string{
+		"22:00",
+		"Mon 22:00-22:00",
+		"Someday 01:00-02:00",
+		"Mon-Someday 01:00-02:00",
+		"Mon Tue 01:00-02:00",
+		"25:00-02:00",
+		"01:60-02:00",
+		"24:00-02:00",
+		"0100-0200",
+	} {
+		_, err := parseSchedule(schedule)
+		assert.Error(t, err, schedule)
+	}
+}
+
+func TestInWindows(t *testing.T) {
+	// 2023-09-04 is Monday
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2023, 9, 4+day, hour, minute, 0, 0, time.Local)
+	}
+	assert.True(t, inWindows(nil, at(0, 12, 0)))
+
+	windows, err := parseSchedule("Mon-Fri 22:00-06:00")
+	assert.NoError(t, err)
+	assert.False(t, inWindows(windows, at(0, 5, 0)))
+	assert.True(t, inWindows(windows, at(0, 22, 0)))
+	assert.True(t, inWindows(windows, at(1, 5, 59)))
+	assert.False(t, inWindows(windows, at(1, 6, 0)))
+	assert.True(t, inWindows(windows, at(5, 3, 0)))
+	assert.False(t, inWindows(windows, at(5, 23, 0)))
+
+	windows, err = parseSchedule("12:00-13:00;Sun 00:00-24:00")
+	assert.NoError(t, err)
+	assert.True(t, inWindows(windows, at(2, 12, 30)))
+	assert.False(t, inWindows(windows, at(2, 13, 0)))
+	assert.True(t, inWindows(windows, at(6, 23, 59)))
+}
+
+func TestMaintenanceManager(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("nil manager", func(t *testing.T) {
+		var m *maintenanceManager
+		assert.True(t, m.CompactionAllowed(1, false))
+		assert.True(t, m.GCAllowed(1))
+	})
+
+	t.Run("load failed", func(t *testing.T) {
+		catalog := mocks.NewDataCoordCatalog(t)
+		catalog.EXPECT().ListMaintenancePolicies(mock.Anything).Return(nil, errors.New("mock"))
+		_, err := newMaintenanceManager(ctx, catalog)
+		assert.Error(t, err)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		catalog := mocks.NewDataCoordCatalog(t)
+		catalog.EXPECT().ListMaintenancePolicies(mock.Anything).Return([]*datapb.MaintenancePolicy{
+			{CollectionID: 1, CompactionPaused: true},
+			{CollectionID: 2, GcSchedule: "invalid"},
+		}, nil)
+		catalog.EXPECT().SaveMaintenancePolicy(mock.Anything, mock.Anything).Return(nil)
+		catalog.EXPECT().DropMaintenancePolicy(mock.Anything, mock.Anything).Return(nil)
+
+		m, err := newMaintenanceManager(ctx, catalog)
+		assert.NoError(t, err)
+		assert.Len(t, m.ListPolicies(), 1)
+		assert.False(t, m.CompactionAllowed(1, false))
+		assert.False(t, m.CompactionAllowed(1, true))
+		assert.True(t, m.GCAllowed(1))
+		assert.True(t, m.CompactionAllowed(2, false))
+
+		// the global policy applies to all collections
+		err = m.SetPolicy(ctx, &datapb.MaintenancePolicy{GcPaused: true})
+		assert.NoError(t, err)
+		assert.False(t, m.GCAllowed(0))
+		assert.False(t, m.GCAllowed(2))
+		assert.True(t, m.CompactionAllowed(2, false))
+
+		// an empty window set which never matches now
+		now := time.Now()
+		start := (now.Hour()*60 + now.Minute() + 120) % minutesPerDay
+		schedule := formatMinute(start) + "-" + formatMinute((start+1)%minutesPerDay)
+		err = m.SetPolicy(ctx, &datapb.MaintenancePolicy{CollectionID: 2, CompactionSchedule: schedule})
+		assert.NoError(t, err)
+		assert.False(t, m.CompactionAllowed(2, false))
+		assert.True(t, m.CompactionAllowed(2, true))
+
+		err = m.SetPolicy(ctx, &datapb.MaintenancePolicy{CollectionID: 2, CompactionSchedule: "invalid"})
+		assert.Error(t, err)
+
+		policies := m.ListPolicies()
+		assert.Len(t, policies, 3)
+		assert.EqualValues(t, 0, policies[0].GetCollectionID())
+		assert.EqualValues(t, 2, policies[2].GetCollectionID())
+
+		// resume everything
+		err = m.SetPolicy(ctx, &datapb.MaintenancePolicy{})
+		assert.NoError(t, err)
+		assert.True(t, m.GCAllowed(2))
+		assert.Len(t, m.ListPolicies(), 2)
+	})
+
+	t.Run("catalog failed", func(t *testing.T) {
+		catalog := mocks.NewDataCoordCatalog(t)
+		catalog.EXPECT().ListMaintenancePolicies(mock.Anything).Return(nil, nil)
+		catalog.EXPECT().SaveMaintenancePolicy(mock.Anything, mock.Anything).Return(errors.New("mock"))
+		catalog.EXPECT().DropMaintenancePolicy(mock.Anything, mock.Anything).Return(errors.New("mock"))
+
+		m, err := newMaintenanceManager(ctx, catalog)
+		assert.NoError(t, err)
+		assert.Error(t, m.SetPolicy(ctx, &datapb.MaintenancePolicy{GcPaused: true}))
+		assert.Error(t, m.SetPolicy(ctx, &datapb.MaintenancePolicy{}))
+		assert.Empty(t, m.ListPolicies())
+	})
+}
+
+func TestMaintenanceManager_PauseGC(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("normal case", func(t *testing.T) {
+		var saved *datapb.MaintenancePolicy
+		catalog := mocks.NewDataCoordCatalog(t)
+		catalog.EXPECT().ListMaintenancePolicies(mock.Anything).Return([]*datapb.MaintenancePolicy{
+			{CollectionID: 1, GcPaused: true},
+		}, nil)
+		catalog.EXPECT().SaveMaintenancePolicy(mock.Anything, mock.Anything).Run(func(ctx context.Context, policy *datapb.MaintenancePolicy) {
+			saved = policy
+		}).Return(nil)
+		catalog.EXPECT().DropMaintenancePolicy(mock.Anything, int64(0)).Return(nil)
+
+		m, err := newMaintenanceManager(ctx, catalog)
+		assert.NoError(t, err)
+		assert.False(t, m.GCPaused())
+
+		// the pausing is persisted in the global policy, and keeps the schedule
+		now := time.Now()
+		start := (now.Hour()*60 + now.Minute() + 120) % minutesPerDay
+		schedule := formatMinute(start) + "-" + formatMinute((start+1)%minutesPerDay)
+		assert.NoError(t, m.SetPolicy(ctx, &datapb.MaintenancePolicy{GcSchedule: schedule}))
+		resumeTime := now.Add(time.Hour)
+		assert.NoError(t, m.PauseGC(ctx, resumeTime))
+		assert.True(t, saved.GetGcPaused())
+		assert.Equal(t, resumeTime.UnixMilli(), saved.GetGcResumeTime())
+		assert.Equal(t, schedule, saved.GetGcSchedule())
+		assert.True(t, m.GCPaused())
+
+		resp := &datapb.GetGCStatusResponse{}
+		m.fillGCStatus(resp)
+		assert.True(t, resp.GetPaused())
+		assert.Equal(t, resumeTime.UnixMilli(), resp.GetResumeTime())
+		assert.True(t, resp.GetOutOfSchedule())
+		assert.Equal(t, []int64{1}, resp.GetPausedCollectionIDs())
+
+		// the pausing expires at the resume time
+		assert.NoError(t, m.PauseGC(ctx, now.Add(-time.Second)))
+		assert.False(t, m.GCPaused())
+
+		assert.NoError(t, m.PauseGC(ctx, time.Time{}))
+		assert.True(t, m.GCPaused())
+		assert.Zero(t, saved.GetGcResumeTime())
+
+		assert.NoError(t, m.ResumeGC(ctx))
+		assert.False(t, m.GCPaused())
+		assert.False(t, saved.GetGcPaused())
+		assert.Equal(t, schedule, saved.GetGcSchedule())
+
+		// the global policy is removed once it pauses or schedules nothing
+		assert.NoError(t, m.SetPolicy(ctx, &datapb.MaintenancePolicy{}))
+		assert.NoError(t, m.ResumeGC(ctx))
+		resp = &datapb.GetGCStatusResponse{}
+		m.fillGCStatus(resp)
+		assert.False(t, resp.GetPaused())
+		assert.False(t, resp.GetOutOfSchedule())
+	})
+
+	t.Run("catalog failed", func(t *testing.T) {
+		catalog := mocks.NewDataCoordCatalog(t)
+		catalog.EXPECT().ListMaintenancePolicies(mock.Anything).Return(nil, nil)
+		catalog.EXPECT().SaveMaintenancePolicy(mock.Anything, mock.Anything).Return(errors.New("mock"))
+
+		m, err := newMaintenanceManager(ctx, catalog)
+		assert.NoError(t, err)
+		assert.Error(t, m.PauseGC(ctx, time.Time{}))
+		assert.False(t, m.GCPaused())
+	})
+}
+
+func formatMinute(minute int) string {
+	return time.Date(0, 1, 1, minute/60, minute%60, 0, 0, time.Local).Format("15:04")
+}
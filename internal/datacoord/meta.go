@@ -61,6 +61,10 @@ type meta struct {
 	// buildID2Meta records the meta information of the segment
 	// buildID -> segmentIndex
 	buildID2SegmentIndex map[UniqueID]*model.SegmentIndex
+
+	// encryptionKeys records the current key of the collections which require encrypted storage
+	// collID -> key info, the key version is 1 if the key is never rotated
+	encryptionKeys map[UniqueID]*datapb.EncryptionKeyInfo
 }
 
 // A local cache of segment metric update. Must call commit() to take effect.
@@ -90,6 +94,7 @@ func newMeta(ctx context.Context, catalog metastore.DataCoordCatalog, chunkManag
 		chunkManager:         chunkManager,
 		indexes:              make(map[UniqueID]map[UniqueID]*model.Index),
 		buildID2SegmentIndex: make(map[UniqueID]*model.SegmentIndex),
+		encryptionKeys:       make(map[UniqueID]*datapb.EncryptionKeyInfo),
 	}
 	err := mt.reloadFromKV()
 	if err != nil {
@@ -149,6 +154,7 @@ func (m *meta) reloadFromKV() error {
 		// for 2.2.2 issue https://github.com/milvus-io/milvus/issues/22181
 		pos.ChannelName = vChannel
 		m.channelCPs[vChannel] = pos
+		setChannelCheckpointMetrics(vChannel, pos)
 	}
 
 	// load field indexes
@@ -169,6 +175,15 @@ func (m *meta) reloadFromKV() error {
 		m.updateSegmentIndex(segIdx)
 		metrics.FlushedSegmentFileNum.WithLabelValues(metrics.IndexFileLabel).Observe(float64(len(segIdx.IndexFileKeys)))
 	}
+
+	encryptionKeys, err := m.catalog.ListEncryptionKeys(m.ctx)
+	if err != nil {
+		log.Error("DataCoord meta reloadFromKV load encryption keys fail", zap.Error(err))
+		return err
+	}
+	for _, key := range encryptionKeys {
+		m.encryptionKeys[key.GetCollectionID()] = key
+	}
 	log.Info("DataCoord meta reloadFromKV done", zap.Duration("duration", record.ElapseSpan()))
 	return nil
 }
@@ -288,6 +303,20 @@ func (m *meta) GetCollectionBinlogSize() (int64, map[UniqueID]int64) {
 	return total, collectionBinlogSize
 }
 
+// GetExternalCollections returns the collections having the segments registered on the external files,
+// the registered segments are dropped once they're converted into binlogs.
+func (m *meta) GetExternalCollections() []UniqueID {
+	m.RLock()
+	defer m.RUnlock()
+	collections := typeutil.NewUniqueSet()
+	for _, segment := range m.segments.GetSegments() {
+		if isSegmentHealthy(segment) && segment.GetExternalSource() != nil {
+			collections.Insert(segment.GetCollectionID())
+		}
+	}
+	return collections.Collect()
+}
+
 // AddSegment records segment info, persisting info into kv store
 func (m *meta) AddSegment(segment *SegmentInfo) error {
 	log.Debug("meta update: adding segment", zap.Int64("segmentID", segment.GetID()))
@@ -329,6 +358,57 @@ func (m *meta) DropSegment(segmentID UniqueID) error {
 	return nil
 }
 
+// DropSegmentsInTimeRange marks the flushed segments of the partition as dropped if their insert timestamps
+// are all within [startTs, endTs], the segments of all partitions are matched if partitionID is negative.
+// The segments being compacted or imported are skipped. It returns the dropped segments.
+func (m *meta) DropSegmentsInTimeRange(collectionID, partitionID UniqueID, startTs, endTs Timestamp) ([]*SegmentInfo, error) {
+	log := log.With(zap.Int64("collectionID", collectionID),
+		zap.Int64("partitionID", partitionID),
+		zap.Uint64("startTs", startTs),
+		zap.Uint64("endTs", endTs))
+	log.Debug("meta update: dropping segments in time range")
+	m.Lock()
+	defer m.Unlock()
+
+	metricMutation := &segMetricMutation{
+		stateChange: make(map[string]int),
+	}
+	droppedAt := uint64(time.Now().UnixNano())
+	var modSegments []*SegmentInfo
+	for _, segment := range m.segments.GetSegments() {
+		if segment.GetCollectionID() != collectionID ||
+			(partitionID >= 0 && segment.GetPartitionID() != partitionID) ||
+			segment.GetState() != commonpb.SegmentState_Flushed ||
+			segment.isCompacting || segment.GetIsImporting() {
+			continue
+		}
+		tsFrom, tsTo, ok := getInsertTimeRange(segment)
+		if !ok || tsFrom < startTs || tsTo > endTs {
+			continue
+		}
+		cloned := segment.Clone()
+		updateSegStateAndPrepareMetrics(cloned, commonpb.SegmentState_Dropped, metricMutation)
+		cloned.DroppedAt = droppedAt
+		modSegments = append(modSegments, cloned)
+	}
+	if len(modSegments) == 0 {
+		return nil, nil
+	}
+
+	modInfos := lo.Map(modSegments, func(segment *SegmentInfo, _ int) *datapb.SegmentInfo { return segment.SegmentInfo })
+	if err := m.catalog.AlterSegments(m.ctx, modInfos); err != nil {
+		log.Warn("meta update: dropping segments in time range failed", zap.Error(err))
+		return nil, err
+	}
+	metricMutation.commit()
+	for _, segment := range modSegments {
+		m.segments.SetSegment(segment.GetID(), segment)
+	}
+	log.Info("meta update: dropping segments in time range - complete",
+		zap.Int64s("segmentIDs", lo.Map(modSegments, func(segment *SegmentInfo, _ int) int64 { return segment.GetID() })))
+	return modSegments, nil
+}
+
 // GetHealthySegment returns segment info with provided id
 // if not segment is found, nil will be returned
 func (m *meta) GetHealthySegment(segID UniqueID) *SegmentInfo {
@@ -401,31 +481,68 @@ func (m *meta) SetState(segmentID UniqueID, targetState commonpb.SegmentState) e
 	return nil
 }
 
-// UnsetIsImporting removes the `isImporting` flag of a segment.
-func (m *meta) UnsetIsImporting(segmentID UniqueID) error {
-	log.Debug("meta update: unsetting isImport state of segment",
-		zap.Int64("segmentID", segmentID))
+// UnsetIsImporting removes the `isImporting` flag of the given segments, the segments registered on the external files
+// are marked registered instead, which are converted into binlogs later.
+// The segments are persisted together, none of them is changed if any segment is not found or the persisting fails.
+func (m *meta) UnsetIsImporting(segmentIDs ...UniqueID) error {
+	log.Debug("meta update: unsetting isImport state of segments",
+		zap.Int64s("segmentIDs", segmentIDs))
 	m.Lock()
 	defer m.Unlock()
-	curSegInfo := m.segments.GetSegment(segmentID)
-	if curSegInfo == nil {
-		return fmt.Errorf("segment not found %d", segmentID)
+	modSegments := make([]*datapb.SegmentInfo, 0, len(segmentIDs))
+	clonedSegments := make(map[UniqueID]*SegmentInfo, len(segmentIDs))
+	for _, segmentID := range segmentIDs {
+		curSegInfo := m.segments.GetSegment(segmentID)
+		if curSegInfo == nil {
+			return fmt.Errorf("segment not found %d", segmentID)
+		}
+		clonedSegment := curSegInfo.Clone()
+		if clonedSegment.GetExternalSource() != nil {
+			// the segment registered on the external files stays invisible until it's converted into binlogs
+			clonedSegment.ExternalSource.Registered = true
+		} else {
+			clonedSegment.IsImporting = false
+		}
+		clonedSegments[segmentID] = clonedSegment
+		if isSegmentHealthy(clonedSegment) {
+			modSegments = append(modSegments, clonedSegment.SegmentInfo)
+		}
 	}
 	// Persist segment updates first.
-	clonedSegment := curSegInfo.Clone()
-	clonedSegment.IsImporting = false
-	if isSegmentHealthy(clonedSegment) {
-		if err := m.catalog.AlterSegments(m.ctx, []*datapb.SegmentInfo{clonedSegment.SegmentInfo}); err != nil {
-			log.Warn("meta update: unsetting isImport state of segment - failed to unset segment isImporting state",
-				zap.Int64("segmentID", segmentID),
-				zap.Error(err))
-			return err
-		}
+	if err := m.catalog.AlterSegments(m.ctx, modSegments); err != nil {
+		log.Warn("meta update: unsetting isImport state of segments - failed to unset segment isImporting state",
+			zap.Int64s("segmentIDs", segmentIDs),
+			zap.Error(err))
+		return err
 	}
 	// Update in-memory meta.
-	m.segments.SetIsImporting(segmentID, false)
-	log.Info("meta update: unsetting isImport state of segment - complete",
-		zap.Int64("segmentID", segmentID))
+	for segmentID, segment := range clonedSegments {
+		m.segments.SetSegment(segmentID, segment)
+	}
+	log.Info("meta update: unsetting isImport state of segments - complete",
+		zap.Int64s("segmentIDs", segmentIDs))
+	return nil
+}
+
+// SetExternalSource registers the importing segment on the external files, the rows of the segment are counted from the files.
+func (m *meta) SetExternalSource(segmentID UniqueID, source *datapb.ExternalSource, numRows int64) error {
+	m.Lock()
+	defer m.Unlock()
+	segment := m.segments.GetSegment(segmentID)
+	if segment == nil || !isSegmentHealthy(segment) {
+		return fmt.Errorf("segment not found %d", segmentID)
+	}
+	cloned := segment.Clone()
+	cloned.ExternalSource = source
+	cloned.NumOfRows = numRows
+	if err := m.catalog.AlterSegments(m.ctx, []*datapb.SegmentInfo{cloned.SegmentInfo}); err != nil {
+		log.Warn("meta update: failed to set external source of segment",
+			zap.Int64("segmentID", segmentID), zap.Error(err))
+		return err
+	}
+	m.segments.SetSegment(segmentID, cloned)
+	log.Info("meta update: segment registered on external files", zap.Int64("segmentID", segmentID),
+		zap.Strings("files", source.GetFiles()), zap.Int64("numRows", numRows))
 	return nil
 }
 
@@ -440,6 +557,7 @@ func (m *meta) UpdateFlushSegmentsInfo(
 	binlogs, statslogs, deltalogs []*datapb.FieldBinlog,
 	checkpoints []*datapb.CheckPoint,
 	startPositions []*datapb.SegmentStartPosition,
+	encryptionKeyVersion int64,
 ) error {
 	log.Debug("meta update: update flush segments info",
 		zap.Int64("segmentId", segmentID),
@@ -450,7 +568,8 @@ func (m *meta) UpdateFlushSegmentsInfo(
 		zap.Bool("dropped", dropped),
 		zap.Any("check points", checkpoints),
 		zap.Any("start position", startPositions),
-		zap.Bool("importing", importing))
+		zap.Bool("importing", importing),
+		zap.Int64("encryption key version", encryptionKeyVersion))
 	m.Lock()
 	defer m.Unlock()
 
@@ -479,6 +598,15 @@ func (m *meta) UpdateFlushSegmentsInfo(
 		clonedSegment.DroppedAt = uint64(time.Now().UnixNano())
 		modSegments[segmentID] = clonedSegment
 	}
+	// the segment is on the lowest key version its binlogs are encrypted with,
+	// the key version allocated with the segment is replaced by the one of its first binlogs
+	if len(binlogs)+len(statslogs)+len(deltalogs) > 0 {
+		noLogs := len(segment.GetBinlogs())+len(segment.GetStatslogs())+len(segment.GetDeltalogs()) == 0
+		if noLogs || encryptionKeyVersion < clonedSegment.GetEncryptionKeyVersion() {
+			clonedSegment.EncryptionKeyVersion = encryptionKeyVersion
+			modSegments[segmentID] = clonedSegment
+		}
+	}
 	// TODO add diff encoding and compression
 	currBinlogs := clonedSegment.GetBinlogs()
 	var getFieldBinlogs = func(id UniqueID, binlogs []*datapb.FieldBinlog) *datapb.FieldBinlog {
@@ -546,14 +674,17 @@ func (m *meta) UpdateFlushSegmentsInfo(
 
 	if importing {
 		s := clonedSegment
-		count := segmentutil.CalcRowCountFromBinLog(s.SegmentInfo)
-		if count != segment.currRows {
-			log.Info("check point reported inconsistent with bin log row count",
-				zap.Int64("segmentID", segment.GetID()),
-				zap.Int64("current rows (wrong)", segment.currRows),
-				zap.Int64("segment bin log row count (correct)", count))
+		// the segment registered on the external files has no binlogs, its rows are counted from the files
+		if s.GetExternalSource() == nil {
+			count := segmentutil.CalcRowCountFromBinLog(s.SegmentInfo)
+			if count != segment.currRows {
+				log.Info("check point reported inconsistent with bin log row count",
+					zap.Int64("segmentID", segment.GetID()),
+					zap.Int64("current rows (wrong)", segment.currRows),
+					zap.Int64("segment bin log row count (correct)", count))
+			}
+			s.NumOfRows = count
 		}
-		s.NumOfRows = count
 		modSegments[segmentID] = s
 	} else {
 		for _, cp := range checkpoints {
@@ -629,10 +760,7 @@ func (m *meta) UpdateDropChannelSegmentInfo(channel string, segments []*SegmentI
 		}
 	}
 	// set existed segments of channel to Dropped
-	for _, seg := range m.segments.segments {
-		if seg.InsertChannel != channel {
-			continue
-		}
+	for _, seg := range m.segments.GetSegmentsByChannel(channel) {
 		_, ok := modSegments[seg.ID]
 		// seg inf mod segments are all in dropped state
 		if !ok {
@@ -754,14 +882,28 @@ func (m *meta) batchSaveDropSegments(channel string, modSegments map[int64]*Segm
 	return nil
 }
 
+// GetUnflushedRowsOfChannel returns the number of rows in the growing, sealed and flushing segments of the channel.
+func (m *meta) GetUnflushedRowsOfChannel(dmlCh string) int64 {
+	m.RLock()
+	defer m.RUnlock()
+	var rows int64
+	for _, segment := range m.segments.GetSegmentsByChannel(dmlCh) {
+		switch segment.GetState() {
+		case commonpb.SegmentState_Growing, commonpb.SegmentState_Sealed, commonpb.SegmentState_Flushing:
+			rows += segment.GetNumOfRows()
+		}
+	}
+	return rows
+}
+
 // GetSegmentsByChannel returns all segment info which insert channel equals provided `dmlCh`
 func (m *meta) GetSegmentsByChannel(dmlCh string) []*SegmentInfo {
 	m.RLock()
 	defer m.RUnlock()
 	infos := make([]*SegmentInfo, 0)
-	segments := m.segments.GetSegments()
+	segments := m.segments.GetSegmentsByChannel(dmlCh)
 	for _, segment := range segments {
-		if !isSegmentHealthy(segment) || segment.InsertChannel != dmlCh {
+		if !isSegmentHealthy(segment) {
 			continue
 		}
 		infos = append(infos, segment)
@@ -820,7 +962,7 @@ func (m *meta) GetSegmentsIDOfPartition(collectionID, partitionID UniqueID) []Un
 	m.RLock()
 	defer m.RUnlock()
 	ret := make([]UniqueID, 0)
-	segments := m.segments.GetSegments()
+	segments := m.segments.GetSegmentsByPartition(partitionID)
 	for _, segment := range segments {
 		if isSegmentHealthy(segment) && segment.CollectionID == collectionID && segment.PartitionID == partitionID {
 			ret = append(ret, segment.ID)
@@ -834,7 +976,7 @@ func (m *meta) GetSegmentsIDOfPartitionWithDropped(collectionID, partitionID Uni
 	m.RLock()
 	defer m.RUnlock()
 	ret := make([]UniqueID, 0)
-	segments := m.segments.GetSegments()
+	segments := m.segments.GetSegmentsByPartition(partitionID)
 	for _, segment := range segments {
 		if segment != nil &&
 			segment.GetState() != commonpb.SegmentState_SegmentStateNone &&
@@ -852,7 +994,7 @@ func (m *meta) GetNumRowsOfPartition(collectionID UniqueID, partitionID UniqueID
 	m.RLock()
 	defer m.RUnlock()
 	var ret int64
-	segments := m.segments.GetSegments()
+	segments := m.segments.GetSegmentsByPartition(partitionID)
 	for _, segment := range segments {
 		if isSegmentHealthy(segment) && segment.CollectionID == collectionID && segment.PartitionID == partitionID {
 			ret += segment.NumOfRows
@@ -865,28 +1007,14 @@ func (m *meta) GetNumRowsOfPartition(collectionID UniqueID, partitionID UniqueID
 func (m *meta) GetUnFlushedSegments() []*SegmentInfo {
 	m.RLock()
 	defer m.RUnlock()
-	ret := make([]*SegmentInfo, 0)
-	segments := m.segments.GetSegments()
-	for _, segment := range segments {
-		if segment.State == commonpb.SegmentState_Growing || segment.State == commonpb.SegmentState_Sealed {
-			ret = append(ret, segment)
-		}
-	}
-	return ret
+	return m.segments.GetSegmentsByState(commonpb.SegmentState_Growing, commonpb.SegmentState_Sealed)
 }
 
 // GetFlushingSegments get all segments which state is `Flushing`
 func (m *meta) GetFlushingSegments() []*SegmentInfo {
 	m.RLock()
 	defer m.RUnlock()
-	ret := make([]*SegmentInfo, 0)
-	segments := m.segments.GetSegments()
-	for _, info := range segments {
-		if info.State == commonpb.SegmentState_Flushing {
-			ret = append(ret, info)
-		}
-	}
-	return ret
+	return m.segments.GetSegmentsByState(commonpb.SegmentState_Flushing)
 }
 
 // SelectSegments select segments with selector
@@ -1032,20 +1160,21 @@ func (m *meta) PrepareCompleteCompactionMutation(compactionLogs []*datapb.Compac
 	}
 
 	segmentInfo := &datapb.SegmentInfo{
-		ID:                  result.GetSegmentID(),
-		CollectionID:        modSegments[0].CollectionID,
-		PartitionID:         modSegments[0].PartitionID,
-		InsertChannel:       modSegments[0].InsertChannel,
-		NumOfRows:           result.NumOfRows,
-		State:               commonpb.SegmentState_Flushing,
-		MaxRowNum:           modSegments[0].MaxRowNum,
-		Binlogs:             result.GetInsertLogs(),
-		Statslogs:           result.GetField2StatslogPaths(),
-		Deltalogs:           deltalogs,
-		StartPosition:       startPosition,
-		DmlPosition:         dmlPosition,
-		CreatedByCompaction: true,
-		CompactionFrom:      compactionFrom,
+		ID:                   result.GetSegmentID(),
+		CollectionID:         modSegments[0].CollectionID,
+		PartitionID:          modSegments[0].PartitionID,
+		InsertChannel:        modSegments[0].InsertChannel,
+		NumOfRows:            result.NumOfRows,
+		State:                commonpb.SegmentState_Flushing,
+		MaxRowNum:            modSegments[0].MaxRowNum,
+		Binlogs:              result.GetInsertLogs(),
+		Statslogs:            result.GetField2StatslogPaths(),
+		Deltalogs:            deltalogs,
+		StartPosition:        startPosition,
+		DmlPosition:          dmlPosition,
+		CreatedByCompaction:  true,
+		CompactionFrom:       compactionFrom,
+		EncryptionKeyVersion: result.GetEncryptionKeyVersion(),
 	}
 	segment := NewSegmentInfo(segmentInfo)
 	metricMutation.addNewSeg(segment.GetState(), segment.GetNumOfRows())
@@ -1225,6 +1354,23 @@ func isSegmentHealthy(segment *SegmentInfo) bool {
 		segment.GetState() != commonpb.SegmentState_Dropped
 }
 
+// getInsertTimeRange returns the min and max timestamps of the insert binlogs of the segment,
+// ok is false if the segment has no insert binlogs.
+func getInsertTimeRange(segment *SegmentInfo) (tsFrom, tsTo Timestamp, ok bool) {
+	for _, fieldBinlog := range segment.GetBinlogs() {
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			if !ok || binlog.GetTimestampFrom() < tsFrom {
+				tsFrom = binlog.GetTimestampFrom()
+			}
+			if !ok || binlog.GetTimestampTo() > tsTo {
+				tsTo = binlog.GetTimestampTo()
+			}
+			ok = true
+		}
+	}
+	return tsFrom, tsTo, ok
+}
+
 func (m *meta) HasSegments(segIDs []UniqueID) (bool, error) {
 	m.RLock()
 	defer m.RUnlock()
@@ -1267,6 +1413,7 @@ func (m *meta) UpdateChannelCheckpoint(vChannel string, pos *msgpb.MsgPosition)
 			return err
 		}
 		m.channelCPs[vChannel] = pos
+		setChannelCheckpointMetrics(vChannel, pos)
 		ts, _ := tsoutil.ParseTS(pos.Timestamp)
 		log.Info("UpdateChannelCheckpoint done",
 			zap.String("vChannel", vChannel),
@@ -1277,6 +1424,11 @@ func (m *meta) UpdateChannelCheckpoint(vChannel string, pos *msgpb.MsgPosition)
 	return nil
 }
 
+func setChannelCheckpointMetrics(vChannel string, pos *msgpb.MsgPosition) {
+	physical, _ := tsoutil.ParseTS(pos.GetTimestamp())
+	metrics.DataCoordChannelCheckpointTime.WithLabelValues(vChannel).Set(float64(physical.Unix()))
+}
+
 func (m *meta) GetChannelCheckpoint(vChannel string) *msgpb.MsgPosition {
 	m.RLock()
 	defer m.RUnlock()
@@ -1294,6 +1446,7 @@ func (m *meta) DropChannelCheckpoint(vChannel string) error {
 		return err
 	}
 	delete(m.channelCPs, vChannel)
+	metrics.CleanupDataCoordChannelMetrics(vChannel)
 	log.Debug("DropChannelCheckpoint done", zap.String("vChannel", vChannel))
 	return nil
 }
@@ -95,6 +95,27 @@ func TestMetaReloadFromKV(t *testing.T) {
 		assert.Error(t, err)
 	})
 
+	t.Run("ListEncryptionKeys fails", func(t *testing.T) {
+		catalog := mocks.NewDataCoordCatalog(t)
+		catalog.On("ListSegments",
+			mock.Anything,
+		).Return([]*datapb.SegmentInfo{}, nil)
+		catalog.On("ListChannelCheckpoint",
+			mock.Anything,
+		).Return(map[string]*msgpb.MsgPosition{}, nil)
+		catalog.On("ListIndexes",
+			mock.Anything,
+		).Return([]*model.Index{}, nil)
+		catalog.On("ListSegmentIndexes",
+			mock.Anything,
+		).Return([]*model.SegmentIndex{}, nil)
+		catalog.On("ListEncryptionKeys",
+			mock.Anything,
+		).Return(nil, errors.New("error"))
+		_, err := newMeta(context.TODO(), catalog, nil)
+		assert.Error(t, err)
+	})
+
 	t.Run("ok", func(t *testing.T) {
 		catalog := mocks.NewDataCoordCatalog(t)
 		catalog.On("ListSegments",
@@ -138,8 +159,18 @@ func TestMetaReloadFromKV(t *testing.T) {
 			},
 		}, nil)
 
-		_, err := newMeta(context.TODO(), catalog, nil)
+		catalog.On("ListEncryptionKeys",
+			mock.Anything,
+		).Return([]*datapb.EncryptionKeyInfo{
+			{
+				CollectionID: 1,
+				KeyVersion:   2,
+			},
+		}, nil)
+
+		m, err := newMeta(context.TODO(), catalog, nil)
 		assert.NoError(t, err)
+		assert.EqualValues(t, 2, m.encryptionKeys[1].GetKeyVersion())
 	})
 }
 
@@ -447,7 +478,7 @@ func TestUpdateFlushSegmentsInfo(t *testing.T) {
 		err = meta.UpdateFlushSegmentsInfo(1, true, false, true, []*datapb.FieldBinlog{getFieldBinlogPathsWithEntry(1, 10, getInsertLogPath("binlog1", 1))},
 			[]*datapb.FieldBinlog{getFieldBinlogPaths(1, getStatsLogPath("statslog1", 1))},
 			[]*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{EntriesNum: 1, TimestampFrom: 100, TimestampTo: 200, LogSize: 1000, LogPath: getDeltaLogPath("deltalog1", 1)}}}},
-			[]*datapb.CheckPoint{{SegmentID: 1, NumOfRows: 10}}, []*datapb.SegmentStartPosition{{SegmentID: 1, StartPosition: &msgpb.MsgPosition{MsgID: []byte{1, 2, 3}}}})
+			[]*datapb.CheckPoint{{SegmentID: 1, NumOfRows: 10}}, []*datapb.SegmentStartPosition{{SegmentID: 1, StartPosition: &msgpb.MsgPosition{MsgID: []byte{1, 2, 3}}}}, 0)
 		assert.NoError(t, err)
 
 		updated := meta.GetHealthySegment(1)
@@ -475,8 +506,35 @@ func TestUpdateFlushSegmentsInfo(t *testing.T) {
 		meta, err := newMemoryMeta()
 		assert.NoError(t, err)
 
-		err = meta.UpdateFlushSegmentsInfo(1, false, false, false, nil, nil, nil, nil, nil)
+		err = meta.UpdateFlushSegmentsInfo(1, false, false, false, nil, nil, nil, nil, nil, 0)
+		assert.NoError(t, err)
+	})
+
+	t.Run("update encryption key version", func(t *testing.T) {
+		meta, err := newMemoryMeta()
+		assert.NoError(t, err)
+
+		segment1 := &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{ID: 1, State: commonpb.SegmentState_Growing, EncryptionKeyVersion: 1}}
+		err = meta.AddSegment(segment1)
+		assert.NoError(t, err)
+
+		// the first binlogs decide the key version
+		err = meta.UpdateFlushSegmentsInfo(1, false, false, false, []*datapb.FieldBinlog{getFieldBinlogPathsWithEntry(1, 10, getInsertLogPath("binlog1", 1))},
+			nil, nil, nil, nil, 2)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 2, meta.GetHealthySegment(1).GetEncryptionKeyVersion())
+
+		// the newer key version doesn't cover the binlogs written before
+		err = meta.UpdateFlushSegmentsInfo(1, false, false, false, []*datapb.FieldBinlog{getFieldBinlogPathsWithEntry(1, 10, getInsertLogPath("binlog2", 1))},
+			nil, nil, nil, nil, 3)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 2, meta.GetHealthySegment(1).GetEncryptionKeyVersion())
+
+		// the binlogs in plain text
+		err = meta.UpdateFlushSegmentsInfo(1, false, false, false, []*datapb.FieldBinlog{getFieldBinlogPathsWithEntry(1, 10, getInsertLogPath("binlog3", 1))},
+			nil, nil, nil, nil, 0)
 		assert.NoError(t, err)
+		assert.EqualValues(t, 0, meta.GetHealthySegment(1).GetEncryptionKeyVersion())
 	})
 
 	t.Run("update checkpoints and start position of non existed segment", func(t *testing.T) {
@@ -489,7 +547,7 @@ func TestUpdateFlushSegmentsInfo(t *testing.T) {
 
 		err = meta.UpdateFlushSegmentsInfo(1, false, false, false, nil, nil, nil, []*datapb.CheckPoint{{SegmentID: 2, NumOfRows: 10}},
 
-			[]*datapb.SegmentStartPosition{{SegmentID: 2, StartPosition: &msgpb.MsgPosition{MsgID: []byte{1, 2, 3}}}})
+			[]*datapb.SegmentStartPosition{{SegmentID: 2, StartPosition: &msgpb.MsgPosition{MsgID: []byte{1, 2, 3}}}}, 0)
 		assert.NoError(t, err)
 		assert.Nil(t, meta.GetHealthySegment(2))
 	})
@@ -516,7 +574,7 @@ func TestUpdateFlushSegmentsInfo(t *testing.T) {
 		err = meta.UpdateFlushSegmentsInfo(1, true, false, false, []*datapb.FieldBinlog{getFieldBinlogPaths(1, getInsertLogPath("binlog", 1))},
 			[]*datapb.FieldBinlog{getFieldBinlogPaths(1, getInsertLogPath("statslog", 1))},
 			[]*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{EntriesNum: 1, TimestampFrom: 100, TimestampTo: 200, LogSize: 1000, LogPath: getDeltaLogPath("deltalog", 1)}}}},
-			[]*datapb.CheckPoint{{SegmentID: 1, NumOfRows: 10}}, []*datapb.SegmentStartPosition{{SegmentID: 1, StartPosition: &msgpb.MsgPosition{MsgID: []byte{1, 2, 3}}}})
+			[]*datapb.CheckPoint{{SegmentID: 1, NumOfRows: 10}}, []*datapb.SegmentStartPosition{{SegmentID: 1, StartPosition: &msgpb.MsgPosition{MsgID: []byte{1, 2, 3}}}}, 0)
 		assert.Error(t, err)
 		assert.Equal(t, "mocked fail", err.Error())
 		segmentInfo = meta.GetHealthySegment(1)
@@ -558,14 +616,14 @@ func TestMeta_alterMetaStore(t *testing.T) {
 
 	m := &meta{
 		catalog: &datacoord.Catalog{MetaKv: NewMetaMemoryKV()},
-		segments: &SegmentsInfo{map[int64]*SegmentInfo{
+		segments: buildSegmentsInfo(map[int64]*SegmentInfo{
 			1: {SegmentInfo: &datapb.SegmentInfo{
 				ID:        1,
 				Binlogs:   []*datapb.FieldBinlog{getFieldBinlogPaths(1, "log1", "log2")},
 				Statslogs: []*datapb.FieldBinlog{getFieldBinlogPaths(1, "statlog1", "statlog2")},
 				Deltalogs: []*datapb.FieldBinlog{getFieldBinlogPaths(0, "deltalog1", "deltalog2")},
 			}},
-		}},
+		}),
 	}
 
 	err := m.alterMetaStoreAfterCompaction(&SegmentInfo{SegmentInfo: newSeg}, lo.Map(toAlter, func(t *datapb.SegmentInfo, _ int) *SegmentInfo {
@@ -575,30 +633,28 @@ func TestMeta_alterMetaStore(t *testing.T) {
 }
 
 func TestMeta_PrepareCompleteCompactionMutation(t *testing.T) {
-	prepareSegments := &SegmentsInfo{
-		map[UniqueID]*SegmentInfo{
-			1: {SegmentInfo: &datapb.SegmentInfo{
-				ID:           1,
-				CollectionID: 100,
-				PartitionID:  10,
-				State:        commonpb.SegmentState_Flushed,
-				Binlogs:      []*datapb.FieldBinlog{getFieldBinlogPaths(1, "log1", "log2")},
-				Statslogs:    []*datapb.FieldBinlog{getFieldBinlogPaths(1, "statlog1", "statlog2")},
-				Deltalogs:    []*datapb.FieldBinlog{getFieldBinlogPaths(0, "deltalog1", "deltalog2")},
-				NumOfRows:    1,
-			}},
-			2: {SegmentInfo: &datapb.SegmentInfo{
-				ID:           2,
-				CollectionID: 100,
-				PartitionID:  10,
-				State:        commonpb.SegmentState_Flushed,
-				Binlogs:      []*datapb.FieldBinlog{getFieldBinlogPaths(1, "log3", "log4")},
-				Statslogs:    []*datapb.FieldBinlog{getFieldBinlogPaths(1, "statlog3", "statlog4")},
-				Deltalogs:    []*datapb.FieldBinlog{getFieldBinlogPaths(0, "deltalog3", "deltalog4")},
-				NumOfRows:    1,
-			}},
-		},
-	}
+	prepareSegments := buildSegmentsInfo(map[UniqueID]*SegmentInfo{
+		1: {SegmentInfo: &datapb.SegmentInfo{
+			ID:           1,
+			CollectionID: 100,
+			PartitionID:  10,
+			State:        commonpb.SegmentState_Flushed,
+			Binlogs:      []*datapb.FieldBinlog{getFieldBinlogPaths(1, "log1", "log2")},
+			Statslogs:    []*datapb.FieldBinlog{getFieldBinlogPaths(1, "statlog1", "statlog2")},
+			Deltalogs:    []*datapb.FieldBinlog{getFieldBinlogPaths(0, "deltalog1", "deltalog2")},
+			NumOfRows:    1,
+		}},
+		2: {SegmentInfo: &datapb.SegmentInfo{
+			ID:           2,
+			CollectionID: 100,
+			PartitionID:  10,
+			State:        commonpb.SegmentState_Flushed,
+			Binlogs:      []*datapb.FieldBinlog{getFieldBinlogPaths(1, "log3", "log4")},
+			Statslogs:    []*datapb.FieldBinlog{getFieldBinlogPaths(1, "statlog3", "statlog4")},
+			Deltalogs:    []*datapb.FieldBinlog{getFieldBinlogPaths(0, "deltalog3", "deltalog4")},
+			NumOfRows:    1,
+		}},
+	})
 
 	m := &meta{
 		catalog:  &datacoord.Catalog{MetaKv: NewMetaMemoryKV()},
@@ -626,6 +682,8 @@ func TestMeta_PrepareCompleteCompactionMutation(t *testing.T) {
 		Field2StatslogPaths: []*datapb.FieldBinlog{getFieldBinlogPaths(1, "statlog5")},
 		Deltalogs:           []*datapb.FieldBinlog{getFieldBinlogPaths(0, "deltalog5")},
 		NumOfRows:           2,
+
+		EncryptionKeyVersion: 2,
 	}
 	beforeCompact, afterCompact, newSegment, metricMutation, err := m.PrepareCompleteCompactionMutation(inCompactionLogs, inCompactionResult)
 	assert.NoError(t, err)
@@ -657,6 +715,7 @@ func TestMeta_PrepareCompleteCompactionMutation(t *testing.T) {
 	assert.EqualValues(t, inCompactionResult.GetInsertLogs(), newSegment.GetBinlogs())
 	assert.EqualValues(t, inCompactionResult.GetField2StatslogPaths(), newSegment.GetStatslogs())
 	assert.EqualValues(t, inCompactionResult.GetDeltalogs(), newSegment.GetDeltalogs())
+	assert.EqualValues(t, 2, newSegment.GetEncryptionKeyVersion())
 	assert.NotZero(t, newSegment.lastFlushTime)
 }
 
@@ -678,17 +737,15 @@ func Test_meta_SetSegmentCompacting(t *testing.T) {
 			"test set segment compacting",
 			fields{
 				NewMetaMemoryKV(),
-				&SegmentsInfo{
-					map[int64]*SegmentInfo{
-						1: {
-							SegmentInfo: &datapb.SegmentInfo{
-								ID:    1,
-								State: commonpb.SegmentState_Flushed,
-							},
-							isCompacting: false,
+				buildSegmentsInfo(map[int64]*SegmentInfo{
+					1: {
+						SegmentInfo: &datapb.SegmentInfo{
+							ID:    1,
+							State: commonpb.SegmentState_Flushed,
 						},
+						isCompacting: false,
 					},
-				},
+				}),
 			},
 			args{
 				segmentID:  1,
@@ -727,17 +784,15 @@ func Test_meta_SetSegmentImporting(t *testing.T) {
 			"test set segment importing",
 			fields{
 				NewMetaMemoryKV(),
-				&SegmentsInfo{
-					map[int64]*SegmentInfo{
-						1: {
-							SegmentInfo: &datapb.SegmentInfo{
-								ID:          1,
-								State:       commonpb.SegmentState_Flushed,
-								IsImporting: false,
-							},
+				buildSegmentsInfo(map[int64]*SegmentInfo{
+					1: {
+						SegmentInfo: &datapb.SegmentInfo{
+							ID:          1,
+							State:       commonpb.SegmentState_Flushed,
+							IsImporting: false,
 						},
 					},
-				},
+				}),
 			},
 			args{
 				segmentID: 1,
@@ -774,31 +829,29 @@ func Test_meta_GetSegmentsOfCollection(t *testing.T) {
 		{
 			"test get segments",
 			fields{
-				&SegmentsInfo{
-					map[int64]*SegmentInfo{
-						1: {
-							SegmentInfo: &datapb.SegmentInfo{
-								ID:           1,
-								CollectionID: 1,
-								State:        commonpb.SegmentState_Flushed,
-							},
+				buildSegmentsInfo(map[int64]*SegmentInfo{
+					1: {
+						SegmentInfo: &datapb.SegmentInfo{
+							ID:           1,
+							CollectionID: 1,
+							State:        commonpb.SegmentState_Flushed,
 						},
-						2: {
-							SegmentInfo: &datapb.SegmentInfo{
-								ID:           2,
-								CollectionID: 1,
-								State:        commonpb.SegmentState_Growing,
-							},
+					},
+					2: {
+						SegmentInfo: &datapb.SegmentInfo{
+							ID:           2,
+							CollectionID: 1,
+							State:        commonpb.SegmentState_Growing,
 						},
-						3: {
-							SegmentInfo: &datapb.SegmentInfo{
-								ID:           3,
-								CollectionID: 2,
-								State:        commonpb.SegmentState_Flushed,
-							},
+					},
+					3: {
+						SegmentInfo: &datapb.SegmentInfo{
+							ID:           3,
+							CollectionID: 2,
+							State:        commonpb.SegmentState_Flushed,
 						},
 					},
-				},
+				}),
 			},
 			args{
 				collectionID: 1,
@@ -834,16 +887,14 @@ func Test_meta_GetSegmentsOfCollection(t *testing.T) {
 
 func TestMeta_HasSegments(t *testing.T) {
 	m := &meta{
-		segments: &SegmentsInfo{
-			segments: map[UniqueID]*SegmentInfo{
-				1: {
-					SegmentInfo: &datapb.SegmentInfo{
-						ID: 1,
-					},
-					currRows: 100,
+		segments: buildSegmentsInfo(map[UniqueID]*SegmentInfo{
+			1: {
+				SegmentInfo: &datapb.SegmentInfo{
+					ID: 1,
 				},
+				currRows: 100,
 			},
-		},
+		}),
 	}
 
 	has, err := m.HasSegments([]UniqueID{1})
@@ -857,22 +908,20 @@ func TestMeta_HasSegments(t *testing.T) {
 
 func TestMeta_GetAllSegments(t *testing.T) {
 	m := &meta{
-		segments: &SegmentsInfo{
-			segments: map[UniqueID]*SegmentInfo{
-				1: {
-					SegmentInfo: &datapb.SegmentInfo{
-						ID:    1,
-						State: commonpb.SegmentState_Growing,
-					},
+		segments: buildSegmentsInfo(map[UniqueID]*SegmentInfo{
+			1: {
+				SegmentInfo: &datapb.SegmentInfo{
+					ID:    1,
+					State: commonpb.SegmentState_Growing,
 				},
-				2: {
-					SegmentInfo: &datapb.SegmentInfo{
-						ID:    2,
-						State: commonpb.SegmentState_Dropped,
-					},
+			},
+			2: {
+				SegmentInfo: &datapb.SegmentInfo{
+					ID:    2,
+					State: commonpb.SegmentState_Dropped,
 				},
 			},
-		},
+		}),
 	}
 
 	seg1 := m.GetHealthySegment(1)
@@ -950,6 +999,41 @@ func TestChannelCP(t *testing.T) {
 	})
 }
 
+func TestMeta_GetUnflushedRowsOfChannel(t *testing.T) {
+	meta, err := newMemoryMeta()
+	require.NoError(t, err)
+
+	states := []commonpb.SegmentState{
+		commonpb.SegmentState_Growing,
+		commonpb.SegmentState_Sealed,
+		commonpb.SegmentState_Flushing,
+		commonpb.SegmentState_Flushed,
+		commonpb.SegmentState_Dropped,
+	}
+	for i, state := range states {
+		err = meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+			ID:            UniqueID(i + 1),
+			CollectionID:  1,
+			InsertChannel: "ch1",
+			State:         state,
+			NumOfRows:     10,
+		}))
+		require.NoError(t, err)
+	}
+	err = meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+		ID:            10,
+		CollectionID:  1,
+		InsertChannel: "ch2",
+		State:         commonpb.SegmentState_Growing,
+		NumOfRows:     100,
+	}))
+	require.NoError(t, err)
+
+	assert.EqualValues(t, 30, meta.GetUnflushedRowsOfChannel("ch1"))
+	assert.EqualValues(t, 100, meta.GetUnflushedRowsOfChannel("ch2"))
+	assert.EqualValues(t, 0, meta.GetUnflushedRowsOfChannel("ch3"))
+}
+
 func Test_meta_GcConfirm(t *testing.T) {
 	m := &meta{}
 	catalog := mocks.NewDataCoordCatalog(t)
@@ -963,3 +1047,32 @@ func Test_meta_GcConfirm(t *testing.T) {
 
 	assert.False(t, m.GcConfirm(context.TODO(), 100, 10000))
 }
+
+func TestSegmentsInfo_SecondaryIndexes(t *testing.T) {
+	segments := NewSegmentsInfo()
+	segmentIDs := func(infos []*SegmentInfo) []UniqueID {
+		return lo.Map(infos, func(info *SegmentInfo, _ int) UniqueID { return info.GetID() })
+	}
+	segments.SetSegment(1, NewSegmentInfo(&datapb.SegmentInfo{ID: 1, PartitionID: 10, InsertChannel: "ch1", State: commonpb.SegmentState_Growing}))
+	segments.SetSegment(2, NewSegmentInfo(&datapb.SegmentInfo{ID: 2, PartitionID: 10, InsertChannel: "ch2", State: commonpb.SegmentState_Flushed}))
+	segments.SetSegment(3, NewSegmentInfo(&datapb.SegmentInfo{ID: 3, PartitionID: 11, InsertChannel: "ch1", State: commonpb.SegmentState_Growing}))
+
+	assert.ElementsMatch(t, []UniqueID{1, 3}, segmentIDs(segments.GetSegmentsByChannel("ch1")))
+	assert.ElementsMatch(t, []UniqueID{1, 2}, segmentIDs(segments.GetSegmentsByPartition(10)))
+	assert.ElementsMatch(t, []UniqueID{1, 3}, segmentIDs(segments.GetSegmentsByState(commonpb.SegmentState_Growing)))
+	assert.ElementsMatch(t, []UniqueID{1, 2, 3}, segmentIDs(segments.GetSegmentsByState(commonpb.SegmentState_Growing, commonpb.SegmentState_Flushed)))
+	assert.Empty(t, segments.GetSegmentsByChannel("ch3"))
+
+	// the state change moves the segment between the state indexes
+	segments.SetState(1, commonpb.SegmentState_Sealed)
+	assert.ElementsMatch(t, []UniqueID{3}, segmentIDs(segments.GetSegmentsByState(commonpb.SegmentState_Growing)))
+	assert.ElementsMatch(t, []UniqueID{1}, segmentIDs(segments.GetSegmentsByState(commonpb.SegmentState_Sealed)))
+	assert.ElementsMatch(t, []UniqueID{1, 3}, segmentIDs(segments.GetSegmentsByChannel("ch1")))
+
+	segments.DropSegment(3)
+	assert.ElementsMatch(t, []UniqueID{1}, segmentIDs(segments.GetSegmentsByChannel("ch1")))
+	assert.Empty(t, segments.GetSegmentsByPartition(11))
+	assert.Empty(t, segments.GetSegmentsByState(commonpb.SegmentState_Growing))
+	_, ok := segments.partition2Segments[11]
+	assert.False(t, ok)
+}
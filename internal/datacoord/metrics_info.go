@@ -18,8 +18,10 @@ package datacoord
 
 import (
 	"context"
+	"sort"
 
 	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 
 	"github.com/milvus-io/milvus/internal/types"
 
@@ -27,8 +29,10 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/hardware"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
@@ -41,6 +45,7 @@ func (s *Server) getQuotaMetrics() *metricsinfo.DataCoordQuotaMetrics {
 	return &metricsinfo.DataCoordQuotaMetrics{
 		TotalBinlogSize:      total,
 		CollectionBinlogSize: colSizes,
+		ExternalCollections:  s.meta.GetExternalCollections(),
 	}
 }
 
@@ -185,6 +190,69 @@ func (s *Server) getDataNodeMetrics(ctx context.Context, req *milvuspb.GetMetric
 	return infos, nil
 }
 
+// getShardWriteStats returns the write rates of the vchannels of the collection,
+// which are reported in the metrics of the DataNodes watching the vchannels.
+func (s *Server) getShardWriteStats(ctx context.Context, collectionID UniqueID) ([]*datapb.ShardWriteStats, error) {
+	stats := make(map[string]*datapb.ShardWriteStats)
+	nodeIDs := typeutil.NewUniqueSet()
+	for _, info := range s.channelManager.GetChannels() {
+		for _, ch := range info.Channels {
+			if ch.CollectionID != collectionID {
+				continue
+			}
+			stats[ch.Name] = &datapb.ShardWriteStats{
+				ChannelName: ch.Name,
+				NodeID:      info.NodeID,
+			}
+			nodeIDs.Insert(info.NodeID)
+		}
+	}
+	// the channels waiting for a DataNode have no write rate
+	if buffer := s.channelManager.GetBufferChannels(); buffer != nil {
+		for _, ch := range buffer.Channels {
+			if ch.CollectionID == collectionID {
+				stats[ch.Name] = &datapb.ShardWriteStats{ChannelName: ch.Name}
+			}
+		}
+	}
+	if len(stats) == 0 {
+		return nil, merr.WrapErrCollectionNotFound(collectionID, "no channel watched")
+	}
+
+	req, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.SystemInfoMetrics)
+	if err != nil {
+		return nil, err
+	}
+	for _, session := range s.cluster.GetSessions() {
+		if !nodeIDs.Contain(session.info.NodeID) {
+			continue
+		}
+		infos, err := s.getDataNodeMetrics(ctx, req, session)
+		if err != nil || infos.HasError || infos.QuotaMetrics == nil {
+			log.Warn("failed to get the write rates of DataNode",
+				zap.Int64("nodeID", session.info.NodeID),
+				zap.String("reason", infos.ErrorReason),
+				zap.Error(err))
+			continue
+		}
+		for _, rate := range infos.QuotaMetrics.ChannelRates {
+			shard, ok := stats[rate.Channel]
+			if !ok || shard.GetNodeID() != session.info.NodeID {
+				continue
+			}
+			shard.InsertRowsRate = rate.InsertRowsRate
+			shard.InsertBytesRate = rate.InsertBytesRate
+			shard.DeleteRowsRate = rate.DeleteRowsRate
+			shard.DeleteBytesRate = rate.DeleteBytesRate
+			shard.Available = true
+		}
+	}
+
+	shards := lo.Values(stats)
+	sort.Slice(shards, func(i, j int) bool { return shards[i].GetChannelName() < shards[j].GetChannelName() })
+	return shards, nil
+}
+
 func (s *Server) getIndexNodeMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest, node types.IndexNode) (metricsinfo.IndexNodeInfos, error) {
 	infos := metricsinfo.IndexNodeInfos{
 		BaseComponentInfos: metricsinfo.BaseComponentInfos{
@@ -159,6 +159,7 @@ type mockDataNodeClient struct {
 	compactionStateResp  *datapb.CompactionStateResponse
 	addImportSegmentResp *datapb.AddImportSegmentResponse
 	compactionResp       *commonpb.Status
+	cancelledPlans       []int64
 }
 
 func newMockDataNodeClient(id int64, ch chan interface{}) (*mockDataNodeClient, error) {
@@ -299,10 +300,23 @@ func (c *mockDataNodeClient) AddImportSegment(ctx context.Context, req *datapb.A
 	return c.addImportSegmentResp, nil
 }
 
+func (c *mockDataNodeClient) DumpDispatcherPositions(ctx context.Context, req *internalpb.DumpDispatcherPositionsRequest) (*internalpb.DumpDispatcherPositionsResponse, error) {
+	return &internalpb.DumpDispatcherPositionsResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}, nil
+}
+
+func (c *mockDataNodeClient) SeekDispatcher(ctx context.Context, req *internalpb.SeekDispatcherRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
 func (c *mockDataNodeClient) SyncSegments(ctx context.Context, req *datapb.SyncSegmentsRequest) (*commonpb.Status, error) {
 	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
 }
 
+func (c *mockDataNodeClient) CancelCompactionPlans(ctx context.Context, req *datapb.CancelCompactionPlansRequest) (*commonpb.Status, error) {
+	c.cancelledPlans = append(c.cancelledPlans, req.GetPlanIDs()...)
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
 func (c *mockDataNodeClient) Stop() error {
 	c.state = commonpb.StateCode_Abnormal
 	return nil
@@ -318,6 +332,18 @@ func (m *mockRootCoordService) RenameCollection(ctx context.Context, req *milvus
 	panic("implement me")
 }
 
+func (m *mockRootCoordService) CloneCollection(ctx context.Context, req *rootcoordpb.CloneCollectionRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordService) GetCapacityReport(ctx context.Context, req *rootcoordpb.GetCapacityReportRequest) (*rootcoordpb.GetCapacityReportResponse, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordService) GetCollectionStatsHistory(ctx context.Context, req *rootcoordpb.GetCollectionStatsHistoryRequest) (*rootcoordpb.GetCollectionStatsHistoryResponse, error) {
+	panic("implement me")
+}
+
 func (m *mockRootCoordService) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	panic("implement me")
 }
@@ -697,6 +723,16 @@ func (h *mockCompactionHandler) getCompactionTasksBySignalID(signalID int64) []*
 	panic("not implemented")
 }
 
+// cancel the unfinished compaction tasks by signal id
+func (h *mockCompactionHandler) cancelCompaction(signalID int64) []*compactionTask {
+	if f, ok := h.methods["cancelCompaction"]; ok {
+		if ff, ok := f.(func(signalID int64) []*compactionTask); ok {
+			return ff(signalID)
+		}
+	}
+	panic("not implemented")
+}
+
 type mockCompactionTrigger struct {
 	methods map[string]interface{}
 }
@@ -731,6 +767,16 @@ func (t *mockCompactionTrigger) forceTriggerCompaction(collectionID int64) (Uniq
 	panic("not implemented")
 }
 
+// forceTriggerCompactionWithMode force to start a compaction on the segments selected by the mode
+func (t *mockCompactionTrigger) forceTriggerCompactionWithMode(collectionID int64, mode datapb.CompactionMode) (UniqueID, error) {
+	if f, ok := t.methods["forceTriggerCompactionWithMode"]; ok {
+		if ff, ok := f.(func(collectionID int64, mode datapb.CompactionMode) (UniqueID, error)); ok {
+			return ff(collectionID, mode)
+		}
+	}
+	panic("not implemented")
+}
+
 func (t *mockCompactionTrigger) start() {
 	if f, ok := t.methods["start"]; ok {
 		if ff, ok := f.(func()); ok {
@@ -846,3 +892,11 @@ func newMockHandlerWithMeta(meta *meta) *mockHandler {
 		meta: meta,
 	}
 }
+
+func buildSegmentsInfo(segments map[UniqueID]*SegmentInfo) *SegmentsInfo {
+	segmentsInfo := NewSegmentsInfo()
+	for segmentID, segment := range segments {
+		segmentsInfo.SetSegment(segmentID, segment)
+	}
+	return segmentsInfo
+}
@@ -18,9 +18,11 @@ package datacoord
 
 import (
 	"context"
+	"fmt"
 	"math"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/milvus-io/milvus/pkg/log"
@@ -112,8 +114,12 @@ func filterNode(infos []*NodeChannelInfo, nodeID int64) []*NodeChannelInfo {
 
 // ConsistentHashRegisterPolicy use a consistent hash to maintain the mapping
 func ConsistentHashRegisterPolicy(hashRing *consistent.Consistent) RegisterPolicy {
+	return consistentHashRegisterPolicy(hashRing, formatNodeIDs)
+}
+
+func consistentHashRegisterPolicy(hashRing *consistent.Consistent, members hashRingMembers) RegisterPolicy {
 	return func(store ROChannelStore, nodeID int64) ChannelOpSet {
-		elems := formatNodeIDs(store.GetNodes())
+		elems := members(store.GetNodes())
 		hashRing.Set(elems)
 
 		releases := make(map[int64][]*channel)
@@ -159,7 +165,18 @@ func formatNodeID(nodeID int64) string {
 	return strconv.FormatInt(nodeID, 10)
 }
 
+// formatVirtualNodeID formats the i-th virtual node of a weighted node, the 0-th one is the node itself.
+func formatVirtualNodeID(nodeID int64, i int) string {
+	if i == 0 {
+		return formatNodeID(nodeID)
+	}
+	return fmt.Sprintf("%d%s%d", nodeID, virtualNodeSeparator, i)
+}
+
 func deformatNodeID(node string) (int64, error) {
+	if idx := strings.Index(node, virtualNodeSeparator); idx >= 0 {
+		node = node[:idx]
+	}
 	return strconv.ParseInt(node, 10, 64)
 }
 
@@ -201,8 +218,12 @@ func AverageAssignPolicy(store ROChannelStore, channels []*channel) ChannelOpSet
 
 // ConsistentHashChannelAssignPolicy use a consistent hash algorithm to determine channel assignment
 func ConsistentHashChannelAssignPolicy(hashRing *consistent.Consistent) ChannelAssignPolicy {
+	return consistentHashChannelAssignPolicy(hashRing, formatNodeIDs)
+}
+
+func consistentHashChannelAssignPolicy(hashRing *consistent.Consistent, members hashRingMembers) ChannelAssignPolicy {
 	return func(store ROChannelStore, channels []*channel) ChannelOpSet {
-		hashRing.Set(formatNodeIDs(store.GetNodes()))
+		hashRing.Set(members(store.GetNodes()))
 
 		filteredChannels := filterChannels(store, channels)
 		if len(filteredChannels) == 0 {
@@ -315,8 +336,12 @@ func AvgAssignUnregisteredChannels(store ROChannelStore, nodeID int64) ChannelOp
 
 // ConsistentHashDeregisterPolicy return a DeregisterPolicy that uses consistent hash
 func ConsistentHashDeregisterPolicy(hashRing *consistent.Consistent) DeregisterPolicy {
+	return consistentHashDeregisterPolicy(hashRing, formatNodeIDs)
+}
+
+func consistentHashDeregisterPolicy(hashRing *consistent.Consistent, members hashRingMembers) DeregisterPolicy {
 	return func(store ROChannelStore, nodeID int64) ChannelOpSet {
-		hashRing.Set(formatNodeIDsWithFilter(store.GetNodes(), nodeID))
+		hashRing.Set(members(filterNodeIDs(store.GetNodes(), nodeID)))
 		channels := store.GetNodesChannels()
 		opSet := ChannelOpSet{}
 		var deletedInfo *NodeChannelInfo
@@ -385,6 +410,57 @@ func AvgBalanceChannelPolicy(store ROChannelStore, ts time.Time) ChannelOpSet {
 	return channelOps
 }
 
+// SkewBalanceChannelPolicy releases channels from the nodes with the most channels,
+// until the difference of channel counts between nodes is no more than the skew threshold.
+// At most ChannelBalanceMaxPerRound channels are released in one round, so that the balance is incremental.
+// The released channels are expected to be reassigned to the nodes with the least channels.
+func SkewBalanceChannelPolicy(store ROChannelStore, ts time.Time) ChannelOpSet {
+	threshold := Params.DataCoordCfg.ChannelBalanceSkewThreshold.GetAsInt()
+	if threshold < 1 {
+		threshold = 1
+	}
+	maxReleases := Params.DataCoordCfg.ChannelBalanceMaxPerRound.GetAsInt()
+
+	nodeChannels := store.GetNodesChannels()
+	if len(nodeChannels) < 2 {
+		return nil
+	}
+	// copy the channel lists, since they are changed in the simulation of releases
+	nodes := make([]*NodeChannelInfo, 0, len(nodeChannels))
+	for _, info := range nodeChannels {
+		channels := make([]*channel, len(info.Channels))
+		copy(channels, info.Channels)
+		sort.Slice(channels, func(i, j int) bool { return channels[i].Name < channels[j].Name })
+		nodes = append(nodes, &NodeChannelInfo{NodeID: info.NodeID, Channels: channels})
+	}
+
+	releases := make(map[int64][]*channel)
+	for released := 0; maxReleases <= 0 || released < maxReleases; released++ {
+		sort.Slice(nodes, func(i, j int) bool {
+			if len(nodes[i].Channels) != len(nodes[j].Channels) {
+				return len(nodes[i].Channels) > len(nodes[j].Channels)
+			}
+			return nodes[i].NodeID < nodes[j].NodeID
+		})
+		most, least := nodes[0], nodes[len(nodes)-1]
+		if len(most.Channels)-len(least.Channels) <= threshold {
+			break
+		}
+		ch := most.Channels[len(most.Channels)-1]
+		most.Channels = most.Channels[:len(most.Channels)-1]
+		least.Channels = append(least.Channels, ch)
+		releases[most.NodeID] = append(releases[most.NodeID], ch)
+	}
+
+	opSet := make(ChannelOpSet, 0, len(releases))
+	for _, node := range nodes {
+		if channels, ok := releases[node.NodeID]; ok {
+			opSet.Add(node.NodeID, channels)
+		}
+	}
+	return opSet
+}
+
 // ChannelReassignPolicy is a policy for reassigning channels
 type ChannelReassignPolicy func(store ROChannelStore, reassigns []*NodeChannelInfo) ChannelOpSet
 
@@ -614,13 +690,237 @@ func formatNodeIDs(ids []int64) []string {
 	return formatted
 }
 
-func formatNodeIDsWithFilter(ids []int64, filter int64) []string {
-	formatted := make([]string, 0, len(ids))
+func filterNodeIDs(ids []int64, filter int64) []int64 {
+	filtered := make([]int64, 0, len(ids))
 	for _, id := range ids {
 		if id == filter {
 			continue
 		}
-		formatted = append(formatted, formatNodeID(id))
+		filtered = append(filtered, id)
+	}
+	return filtered
+}
+
+// hashRingMembers returns the members of the hash ring for the nodes.
+type hashRingMembers func(nodeIDs []int64) []string
+
+const (
+	// virtualNodeSeparator separates the node id and the index of its virtual node in hash ring members.
+	virtualNodeSeparator = "#"
+	// maxNodeWeight is the number of virtual nodes of the DataNodes with the most capacity.
+	maxNodeWeight = 10
+)
+
+// NodeCapacity is the resource capacity of a DataNode, zero means unknown.
+type NodeCapacity struct {
+	CPUNum     int
+	MemorySize uint64
+}
+
+// NodeCapacityGetter returns the capacity of the DataNode.
+type NodeCapacityGetter func(nodeID int64) NodeCapacity
+
+// weightedHashRingMembers returns hash ring members where each node has virtual nodes in proportion to its capacity.
+func weightedHashRingMembers(getCapacity NodeCapacityGetter) hashRingMembers {
+	return func(nodeIDs []int64) []string {
+		capacities := make([]NodeCapacity, 0, len(nodeIDs))
+		maxCapacity := NodeCapacity{}
+		for _, id := range nodeIDs {
+			capacity := getCapacity(id)
+			capacities = append(capacities, capacity)
+			if capacity.CPUNum > maxCapacity.CPUNum {
+				maxCapacity.CPUNum = capacity.CPUNum
+			}
+			if capacity.MemorySize > maxCapacity.MemorySize {
+				maxCapacity.MemorySize = capacity.MemorySize
+			}
+		}
+
+		members := make([]string, 0, len(nodeIDs)*maxNodeWeight)
+		for i, id := range nodeIDs {
+			weight := nodeWeight(capacities[i], maxCapacity)
+			for j := 0; j < weight; j++ {
+				members = append(members, formatVirtualNodeID(id, j))
+			}
+		}
+		return members
+	}
+}
+
+// nodeWeight returns the number of virtual nodes of the DataNode, which is decided by its scarcest resource
+// compared with the largest node. The unknown resources are ignored, so the nodes not reporting capacity get the max weight.
+func nodeWeight(capacity NodeCapacity, maxCapacity NodeCapacity) int {
+	ratio := 1.0
+	if capacity.CPUNum > 0 && maxCapacity.CPUNum > 0 {
+		ratio = math.Min(ratio, float64(capacity.CPUNum)/float64(maxCapacity.CPUNum))
+	}
+	if capacity.MemorySize > 0 && maxCapacity.MemorySize > 0 {
+		ratio = math.Min(ratio, float64(capacity.MemorySize)/float64(maxCapacity.MemorySize))
+	}
+	weight := int(math.Round(ratio * maxNodeWeight))
+	if weight < 1 {
+		weight = 1
+	}
+	return weight
+}
+
+// WeightedConsistentHashRegisterPolicy is the ConsistentHashRegisterPolicy weighted by DataNode capacity.
+func WeightedConsistentHashRegisterPolicy(hashRing *consistent.Consistent, getCapacity NodeCapacityGetter) RegisterPolicy {
+	return consistentHashRegisterPolicy(hashRing, weightedHashRingMembers(getCapacity))
+}
+
+// WeightedConsistentHashChannelAssignPolicy is the ConsistentHashChannelAssignPolicy weighted by DataNode capacity.
+func WeightedConsistentHashChannelAssignPolicy(hashRing *consistent.Consistent, getCapacity NodeCapacityGetter) ChannelAssignPolicy {
+	return consistentHashChannelAssignPolicy(hashRing, weightedHashRingMembers(getCapacity))
+}
+
+// WeightedConsistentHashDeregisterPolicy is the ConsistentHashDeregisterPolicy weighted by DataNode capacity.
+func WeightedConsistentHashDeregisterPolicy(hashRing *consistent.Consistent, getCapacity NodeCapacityGetter) DeregisterPolicy {
+	return consistentHashDeregisterPolicy(hashRing, weightedHashRingMembers(getCapacity))
+}
+
+// NodeZoneGetter returns the failure domain, such as zone or rack, of the DataNode.
+type NodeZoneGetter func(nodeID int64) string
+
+// zoneAwareAssigner picks target nodes for channels. Channels of the same collection are spread
+// across failure domains first, then the node with the fewest channels in the domain is picked.
+type zoneAwareAssigner struct {
+	nodes []int64
+	zones map[int64]string
+	// node id -> channel num
+	channelNum map[int64]int
+	// zone -> collection id -> channel num
+	collectionNum map[string]map[int64]int
+}
+
+func newZoneAwareAssigner(getZone NodeZoneGetter, nodes []*NodeChannelInfo) *zoneAwareAssigner {
+	a := &zoneAwareAssigner{
+		nodes:         make([]int64, 0, len(nodes)),
+		zones:         make(map[int64]string, len(nodes)),
+		channelNum:    make(map[int64]int, len(nodes)),
+		collectionNum: make(map[string]map[int64]int),
+	}
+	for _, node := range nodes {
+		a.nodes = append(a.nodes, node.NodeID)
+		a.zones[node.NodeID] = getZone(node.NodeID)
+		for _, ch := range node.Channels {
+			a.add(node.NodeID, ch)
+		}
+	}
+	sort.Slice(a.nodes, func(i, j int) bool { return a.nodes[i] < a.nodes[j] })
+	return a
+}
+
+func (a *zoneAwareAssigner) add(nodeID int64, ch *channel) {
+	zone := a.zones[nodeID]
+	if _, ok := a.collectionNum[zone]; !ok {
+		a.collectionNum[zone] = make(map[int64]int)
+	}
+	a.collectionNum[zone][ch.CollectionID]++
+	a.channelNum[nodeID]++
+}
+
+// pick returns the target node of the channel and records the assignment.
+func (a *zoneAwareAssigner) pick(ch *channel) int64 {
+	target := a.nodes[0]
+	for _, nodeID := range a.nodes[1:] {
+		targetCollectionNum := a.collectionNum[a.zones[target]][ch.CollectionID]
+		collectionNum := a.collectionNum[a.zones[nodeID]][ch.CollectionID]
+		if collectionNum < targetCollectionNum ||
+			(collectionNum == targetCollectionNum && a.channelNum[nodeID] < a.channelNum[target]) {
+			target = nodeID
+		}
+	}
+	a.add(target, ch)
+	return target
+}
+
+func (a *zoneAwareAssigner) assign(channels []*channel) ChannelOpSet {
+	sort.Slice(channels, func(i, j int) bool { return channels[i].Name < channels[j].Name })
+	updates := make(map[int64][]*channel)
+	for _, ch := range channels {
+		target := a.pick(ch)
+		updates[target] = append(updates[target], ch)
+	}
+
+	opSet := ChannelOpSet{}
+	for id, chs := range updates {
+		opSet.Add(id, chs)
+	}
+	return opSet
+}
+
+// ZoneAwareAssignPolicy returns a ChannelAssignPolicy which spreads channels of the same collection across failure domains
+func ZoneAwareAssignPolicy(getZone NodeZoneGetter) ChannelAssignPolicy {
+	return func(store ROChannelStore, channels []*channel) ChannelOpSet {
+		newChannels := filterChannels(store, channels)
+		if len(newChannels) == 0 {
+			return nil
+		}
+
+		allDataNodes := store.GetNodesChannels()
+		// If no datanode alive, save channels in buffer
+		if len(allDataNodes) == 0 {
+			opSet := ChannelOpSet{}
+			opSet.Add(bufferID, channels)
+			return opSet
+		}
+		return newZoneAwareAssigner(getZone, allDataNodes).assign(newChannels)
+	}
+}
+
+// ZoneAwareDeregisterPolicy returns a DeregisterPolicy which assigns the unregistered channels across failure domains
+func ZoneAwareDeregisterPolicy(getZone NodeZoneGetter) DeregisterPolicy {
+	return func(store ROChannelStore, nodeID int64) ChannelOpSet {
+		allNodes := store.GetNodesChannels()
+		avaNodes := make([]*NodeChannelInfo, 0, len(allNodes))
+		unregisteredChannels := make([]*channel, 0)
+		opSet := ChannelOpSet{}
+
+		for _, c := range allNodes {
+			if c.NodeID == nodeID {
+				opSet.Delete(nodeID, c.Channels)
+				unregisteredChannels = append(unregisteredChannels, c.Channels...)
+				continue
+			}
+			avaNodes = append(avaNodes, c)
+		}
+
+		if len(avaNodes) == 0 {
+			opSet.Add(bufferID, unregisteredChannels)
+			return opSet
+		}
+		return append(opSet, newZoneAwareAssigner(getZone, avaNodes).assign(unregisteredChannels)...)
+	}
+}
+
+// ZoneAwareReassignPolicy returns a ChannelReassignPolicy which reassigns channels across failure domains
+func ZoneAwareReassignPolicy(getZone NodeZoneGetter) ChannelReassignPolicy {
+	return func(store ROChannelStore, reassigns []*NodeChannelInfo) ChannelOpSet {
+		filterMap := make(map[int64]struct{})
+		for _, reassign := range reassigns {
+			filterMap[reassign.NodeID] = struct{}{}
+		}
+		allNodes := store.GetNodesChannels()
+		avaNodes := make([]*NodeChannelInfo, 0, len(allNodes))
+		for _, node := range allNodes {
+			if _, ok := filterMap[node.NodeID]; ok {
+				continue
+			}
+			avaNodes = append(avaNodes, node)
+		}
+		opSet := ChannelOpSet{}
+		if len(avaNodes) == 0 {
+			// if no node is left, do not reassign
+			log.Warn("there is no available nodes when reassigning, return")
+			return opSet
+		}
+
+		toReassign := make([]*channel, 0)
+		for _, reassign := range reassigns {
+			opSet.Delete(reassign.NodeID, reassign.Channels)
+			toReassign = append(toReassign, reassign.Channels...)
+		}
+		return append(opSet, newZoneAwareAssigner(getZone, avaNodes).assign(toReassign)...)
 	}
-	return formatted
 }
@@ -17,6 +17,7 @@
 package datacoord
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -631,6 +632,62 @@ func TestAvgBalanceChannelPolicy(t *testing.T) {
 	}
 }
 
+func TestSkewBalanceChannelPolicy(t *testing.T) {
+	newStore := func(counts map[int64]int) ROChannelStore {
+		infos := make(map[int64]*NodeChannelInfo)
+		for nodeID, count := range counts {
+			channels := make([]*channel, 0, count)
+			for i := 0; i < count; i++ {
+				channels = append(channels, &channel{Name: fmt.Sprintf("chan-%d-%d", nodeID, i), CollectionID: 1})
+			}
+			infos[nodeID] = &NodeChannelInfo{NodeID: nodeID, Channels: channels}
+		}
+		return &ChannelStore{memkv.NewMemoryKV(), infos}
+	}
+	releasedCount := func(opSet ChannelOpSet) map[int64]int {
+		counts := make(map[int64]int)
+		for _, op := range opSet {
+			assert.Equal(t, Add, op.Type)
+			counts[op.NodeID] += len(op.Channels)
+		}
+		return counts
+	}
+
+	t.Run("only one node", func(t *testing.T) {
+		assert.Empty(t, SkewBalanceChannelPolicy(newStore(map[int64]int{1: 4}), time.Now()))
+	})
+
+	t.Run("skew within threshold", func(t *testing.T) {
+		assert.Empty(t, SkewBalanceChannelPolicy(newStore(map[int64]int{1: 3, 2: 1, 3: 2}), time.Now()))
+	})
+
+	t.Run("incremental", func(t *testing.T) {
+		opSet := SkewBalanceChannelPolicy(newStore(map[int64]int{1: 6, 2: 0}), time.Now())
+		assert.Equal(t, map[int64]int{1: 1}, releasedCount(opSet))
+		assert.Equal(t, "chan-1-5", opSet[0].Channels[0].Name)
+	})
+
+	t.Run("more channels per round", func(t *testing.T) {
+		Params.Save(Params.DataCoordCfg.ChannelBalanceMaxPerRound.Key, "0")
+		defer Params.Reset(Params.DataCoordCfg.ChannelBalanceMaxPerRound.Key)
+
+		opSet := SkewBalanceChannelPolicy(newStore(map[int64]int{1: 6, 2: 5, 3: 0}), time.Now())
+		assert.Equal(t, map[int64]int{1: 2, 2: 1}, releasedCount(opSet))
+	})
+
+	t.Run("lower threshold", func(t *testing.T) {
+		Params.Save(Params.DataCoordCfg.ChannelBalanceSkewThreshold.Key, "0")
+		defer Params.Reset(Params.DataCoordCfg.ChannelBalanceSkewThreshold.Key)
+		Params.Save(Params.DataCoordCfg.ChannelBalanceMaxPerRound.Key, "0")
+		defer Params.Reset(Params.DataCoordCfg.ChannelBalanceMaxPerRound.Key)
+
+		// a threshold lower than 1 is treated as 1, otherwise channels are moved back and forth
+		assert.Empty(t, SkewBalanceChannelPolicy(newStore(map[int64]int{1: 2, 2: 1}), time.Now()))
+		opSet := SkewBalanceChannelPolicy(newStore(map[int64]int{1: 2, 2: 0}), time.Now())
+		assert.Equal(t, map[int64]int{1: 1}, releasedCount(opSet))
+	})
+}
+
 func TestAvgAssignRegisterPolicy(t *testing.T) {
 	type args struct {
 		store  ROChannelStore
@@ -743,3 +800,165 @@ func TestAvgAssignRegisterPolicy(t *testing.T) {
 		})
 	}
 }
+
+func TestZoneAwarePolicy(t *testing.T) {
+	zones := map[int64]string{1: "az1", 2: "az1", 3: "az2"}
+	getZone := func(nodeID int64) string { return zones[nodeID] }
+
+	t.Run("assign to empty cluster", func(t *testing.T) {
+		store := &ChannelStore{memkv.NewMemoryKV(), map[int64]*NodeChannelInfo{}}
+		got := ZoneAwareAssignPolicy(getZone)(store, []*channel{{Name: "chan1", CollectionID: 1}})
+		assert.EqualValues(t, ChannelOpSet{{Add, bufferID, []*channel{{Name: "chan1", CollectionID: 1}}, nil}}, got)
+	})
+
+	t.Run("assign across zones", func(t *testing.T) {
+		store := &ChannelStore{
+			memkv.NewMemoryKV(),
+			map[int64]*NodeChannelInfo{
+				1: {1, []*channel{{Name: "chan1", CollectionID: 1}}},
+				2: {2, []*channel{}},
+				3: {3, []*channel{}},
+			},
+		}
+		got := ZoneAwareAssignPolicy(getZone)(store, []*channel{
+			{Name: "chan1", CollectionID: 1},
+			{Name: "chan2", CollectionID: 1},
+			{Name: "chan3", CollectionID: 1},
+		})
+		assert.ElementsMatch(t, ChannelOpSet{
+			{Add, 3, []*channel{{Name: "chan2", CollectionID: 1}}, nil},
+			{Add, 2, []*channel{{Name: "chan3", CollectionID: 1}}, nil},
+		}, got)
+	})
+
+	t.Run("deregister across zones", func(t *testing.T) {
+		store := &ChannelStore{
+			memkv.NewMemoryKV(),
+			map[int64]*NodeChannelInfo{
+				1: {1, []*channel{{Name: "chan1", CollectionID: 1}}},
+				2: {2, []*channel{{Name: "chan2", CollectionID: 1}, {Name: "chan3", CollectionID: 2}}},
+				3: {3, []*channel{}},
+			},
+		}
+		got := ZoneAwareDeregisterPolicy(getZone)(store, 2)
+		assert.ElementsMatch(t, ChannelOpSet{
+			{Delete, 2, []*channel{{Name: "chan2", CollectionID: 1}, {Name: "chan3", CollectionID: 2}}, nil},
+			{Add, 3, []*channel{{Name: "chan2", CollectionID: 1}}, nil},
+			{Add, 1, []*channel{{Name: "chan3", CollectionID: 2}}, nil},
+		}, got)
+	})
+
+	t.Run("deregister the last node", func(t *testing.T) {
+		store := &ChannelStore{
+			memkv.NewMemoryKV(),
+			map[int64]*NodeChannelInfo{
+				1: {1, []*channel{{Name: "chan1", CollectionID: 1}}},
+			},
+		}
+		got := ZoneAwareDeregisterPolicy(getZone)(store, 1)
+		assert.EqualValues(t, ChannelOpSet{
+			{Delete, 1, []*channel{{Name: "chan1", CollectionID: 1}}, nil},
+			{Add, bufferID, []*channel{{Name: "chan1", CollectionID: 1}}, nil},
+		}, got)
+	})
+
+	t.Run("reassign across zones", func(t *testing.T) {
+		store := &ChannelStore{
+			memkv.NewMemoryKV(),
+			map[int64]*NodeChannelInfo{
+				1: {1, []*channel{{Name: "chan1", CollectionID: 1}}},
+				2: {2, []*channel{{Name: "chan2", CollectionID: 1}}},
+				3: {3, []*channel{}},
+			},
+		}
+		got := ZoneAwareReassignPolicy(getZone)(store, []*NodeChannelInfo{
+			{2, []*channel{{Name: "chan2", CollectionID: 1}}},
+		})
+		assert.EqualValues(t, ChannelOpSet{
+			{Delete, 2, []*channel{{Name: "chan2", CollectionID: 1}}, nil},
+			{Add, 3, []*channel{{Name: "chan2", CollectionID: 1}}, nil},
+		}, got)
+
+		// no node left
+		got = ZoneAwareReassignPolicy(getZone)(store, []*NodeChannelInfo{
+			{1, []*channel{{Name: "chan1", CollectionID: 1}}},
+			{2, []*channel{{Name: "chan2", CollectionID: 1}}},
+			{3, []*channel{}},
+		})
+		assert.Empty(t, got)
+	})
+}
+
+func TestWeightedConsistentHashPolicy(t *testing.T) {
+	capacities := map[int64]NodeCapacity{
+		1: {CPUNum: 16, MemorySize: 64 << 30},
+		2: {CPUNum: 4, MemorySize: 64 << 30},
+		3: {CPUNum: 16, MemorySize: 16 << 30},
+	}
+	getCapacity := func(nodeID int64) NodeCapacity {
+		return capacities[nodeID]
+	}
+
+	t.Run("node weight", func(t *testing.T) {
+		maxCapacity := NodeCapacity{CPUNum: 16, MemorySize: 64 << 30}
+		assert.Equal(t, maxNodeWeight, nodeWeight(capacities[1], maxCapacity))
+		assert.Equal(t, 3, nodeWeight(capacities[2], maxCapacity))
+		assert.Equal(t, 3, nodeWeight(capacities[3], maxCapacity))
+		assert.Equal(t, 1, nodeWeight(NodeCapacity{CPUNum: 1}, maxCapacity))
+		// unknown capacity gets the max weight
+		assert.Equal(t, maxNodeWeight, nodeWeight(NodeCapacity{}, maxCapacity))
+	})
+
+	t.Run("ring members", func(t *testing.T) {
+		members := weightedHashRingMembers(getCapacity)([]int64{1, 2, 4})
+		assert.Equal(t, maxNodeWeight+3+maxNodeWeight, len(members))
+		assert.Contains(t, members, "1")
+		assert.Contains(t, members, "2#2")
+		assert.NotContains(t, members, "2#3")
+
+		for _, member := range members {
+			id, err := deformatNodeID(member)
+			assert.NoError(t, err)
+			assert.Contains(t, []int64{1, 2, 4}, id)
+		}
+	})
+
+	t.Run("assign by capacity", func(t *testing.T) {
+		store := &ChannelStore{
+			store:        memkv.NewMemoryKV(),
+			channelsInfo: map[int64]*NodeChannelInfo{1: {1, nil}, 2: {2, nil}},
+		}
+		channels := make([]*channel, 0, 200)
+		for i := 0; i < 200; i++ {
+			channels = append(channels, &channel{Name: fmt.Sprintf("chan%d", i), CollectionID: 1})
+		}
+
+		policy := WeightedConsistentHashChannelAssignPolicy(consistent.New(), getCapacity)
+		updates := policy(store, channels)
+		assigned := make(map[int64]int)
+		for _, op := range updates {
+			assert.Equal(t, Add, op.Type)
+			assigned[op.NodeID] += len(op.Channels)
+		}
+		assert.Equal(t, 200, assigned[1]+assigned[2])
+		assert.Greater(t, assigned[1], 2*assigned[2])
+	})
+
+	t.Run("deregister", func(t *testing.T) {
+		channels := []*channel{
+			{Name: "chan1", CollectionID: 1},
+			{Name: "chan2", CollectionID: 1},
+		}
+		store := &ChannelStore{
+			store:        memkv.NewMemoryKV(),
+			channelsInfo: map[int64]*NodeChannelInfo{1: {1, channels}, 2: {2, nil}},
+		}
+		policy := WeightedConsistentHashDeregisterPolicy(consistent.New(), getCapacity)
+		updates := policy(store, 1)
+		assert.Equal(t, 2, len(updates))
+		assert.EqualValues(t, &ChannelOp{Type: Delete, NodeID: 1, Channels: channels}, updates[0])
+		assert.Equal(t, Add, updates[1].Type)
+		assert.EqualValues(t, 2, updates[1].NodeID)
+		assert.ElementsMatch(t, channels, updates[1].Channels)
+	})
+}
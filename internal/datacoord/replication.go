@@ -0,0 +1,531 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	// replicationStagingDir is the dir under the root path keeping the binlogs shipped from the primary cluster,
+	// until they're moved to the replica segments.
+	replicationStagingDir = "replication_staging"
+	// shippedSegmentPrefix records the number of deltalogs shipped of the segments, in the primary cluster.
+	shippedSegmentPrefix = "datacoord-replication/shipped"
+	// replicaSegmentPrefix maps the segment IDs of the primary cluster to the replica segment IDs, in the standby cluster.
+	replicaSegmentPrefix = "datacoord-replication/replica"
+)
+
+func shippedSegmentKey(segmentID UniqueID) string {
+	return path.Join(shippedSegmentPrefix, strconv.FormatInt(segmentID, 10))
+}
+
+func replicaSegmentKey(sourceSegmentID UniqueID) string {
+	return path.Join(replicaSegmentPrefix, strconv.FormatInt(sourceSegmentID, 10))
+}
+
+// relativeLogPath returns the log path relative to the root path.
+func relativeLogPath(rootPath string, logPath string) (string, error) {
+	if rootPath == "" {
+		return logPath, nil
+	}
+	if !strings.HasPrefix(logPath, rootPath+"/") {
+		return "", fmt.Errorf("log path %s is not under the root path %s", logPath, rootPath)
+	}
+	return strings.TrimPrefix(logPath, rootPath+"/"), nil
+}
+
+// replicationStagingPath returns the path in the staging area to keep the binlog shipped from the primary cluster,
+// the log path must be relative and inside the root path.
+func replicationStagingPath(rootPath string, logPath string) (string, error) {
+	cleaned := path.Clean(logPath)
+	if logPath == "" || path.IsAbs(cleaned) || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", merr.WrapErrParameterInvalid("relative log path", logPath)
+	}
+	return path.Join(rootPath, replicationStagingDir, cleaned), nil
+}
+
+// replicaLogPath converts the relative log path of the source segment into the log path of its replica.
+func replicaLogPath(rootPath string, logType string, logPath string, source, replica *datapb.SegmentInfo) (string, error) {
+	srcPrefix := path.Join(logType, metautil.JoinIDPath(source.GetCollectionID(), source.GetPartitionID(), source.GetID()))
+	if !strings.HasPrefix(logPath, srcPrefix+"/") {
+		return "", fmt.Errorf("unexpected log path %s of segment %d", logPath, source.GetID())
+	}
+	dstPrefix := path.Join(rootPath, logType, metautil.JoinIDPath(replica.GetCollectionID(), replica.GetPartitionID(), replica.GetID()))
+	return dstPrefix + strings.TrimPrefix(logPath, srcPrefix), nil
+}
+
+func countBinlogs(fieldBinlogs []*datapb.FieldBinlog) int {
+	num := 0
+	for _, fieldBinlog := range fieldBinlogs {
+		num += len(fieldBinlog.GetBinlogs())
+	}
+	return num
+}
+
+// replicationCollection locates the segments of a collection in the standby cluster, by names.
+type replicationCollection struct {
+	dbName         string
+	collectionName string
+	channels       []string
+	partitions     map[UniqueID]string // partition ID -> partition name
+}
+
+// replicationCoordinator ships the flushed segments to the DataCoord of a standby cluster periodically,
+// the binlogs are shipped one by one, then the segment meta.
+// The standby cluster must have the collections and partitions of the same names,
+// and the collections of the same shard numbers.
+// A segment is shipped again if deltalogs are added to it after it's shipped,
+// only the deltalogs are shipped this time.
+type replicationCoordinator struct {
+	meta   *meta
+	broker Broker
+	kv     kv.BaseKV
+	client datapb.DataCoordReplicationClient
+
+	shipped map[UniqueID]int // segment ID -> number of deltalogs shipped
+}
+
+func newReplicationCoordinator(meta *meta, broker Broker, kv kv.BaseKV, client datapb.DataCoordReplicationClient) (*replicationCoordinator, error) {
+	c := &replicationCoordinator{
+		meta:    meta,
+		broker:  broker,
+		kv:      kv,
+		client:  client,
+		shipped: make(map[UniqueID]int),
+	}
+	keys, values, err := kv.LoadWithPrefix(shippedSegmentPrefix)
+	if err != nil {
+		return nil, err
+	}
+	for i := range keys {
+		segmentID, err := strconv.ParseInt(path.Base(keys[i]), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		num, err := strconv.Atoi(values[i])
+		if err != nil {
+			return nil, err
+		}
+		c.shipped[segmentID] = num
+	}
+	return c, nil
+}
+
+// startReplicationLoop ships the flushed segments to the standby cluster if the replication is enabled.
+func (s *Server) startReplicationLoop(ctx context.Context) {
+	if !Params.DataCoordCfg.ReplicationEnable.GetAsBool() {
+		return
+	}
+	address := Params.DataCoordCfg.ReplicationRemoteAddress.GetValue()
+	conn, err := grpc.DialContext(ctx, address, grpc.WithInsecure(),
+		grpc.WithDefaultCallOptions(grpc.MaxCallSendMsgSize(math.MaxInt32)))
+	if err != nil {
+		log.Warn("failed to dial the standby DataCoord, replication is disabled", zap.String("address", address), zap.Error(err))
+		return
+	}
+	c, err := newReplicationCoordinator(s.meta, s.broker, s.kvClient, datapb.NewDataCoordReplicationClient(conn))
+	if err != nil {
+		log.Warn("failed to load the replication progress, replication is disabled", zap.Error(err))
+		conn.Close()
+		return
+	}
+
+	s.serverLoopWg.Add(1)
+	go func() {
+		defer s.serverLoopWg.Done()
+		defer conn.Close()
+		c.loop(ctx, address)
+	}()
+}
+
+func (c *replicationCoordinator) loop(ctx context.Context, address string) {
+	log.Info("replication loop started", zap.String("address", address))
+	ticker := time.NewTicker(Params.DataCoordCfg.ReplicationInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("replication loop quit")
+			return
+		case <-ticker.C:
+			c.replicate(ctx)
+		}
+	}
+}
+
+// replicate ships the segments flushed or with new deltalogs since the last round in the order of segment IDs,
+// so that a compacted segment is shipped after the segments it's compacted from.
+func (c *replicationCoordinator) replicate(ctx context.Context) {
+	log := log.Ctx(ctx).WithRateGroup("dc.replication", 1, 60)
+	segments := c.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		// the deltas of L0 segments are shipped with the segments they're merged into
+		return segment.GetState() == commonpb.SegmentState_Flushed && !segment.GetIsImporting() &&
+			segment.GetLevel() != datapb.SegmentLevel_L0
+	})
+	sort.Slice(segments, func(i, j int) bool {
+		return segments[i].GetID() < segments[j].GetID()
+	})
+
+	flushed := typeutil.NewUniqueSet()
+	collections := make(map[UniqueID]*replicationCollection)
+	for _, segment := range segments {
+		flushed.Insert(segment.GetID())
+		deltalogNum := countBinlogs(segment.GetDeltalogs())
+		shipped, ok := c.shipped[segment.GetID()]
+		if ok && shipped >= deltalogNum {
+			continue
+		}
+		if err := c.ship(ctx, segment, ok, collections); err != nil {
+			log.RatedWarn(60, "failed to ship segment to the standby cluster, will retry",
+				zap.Int64("collectionID", segment.GetCollectionID()),
+				zap.Int64("segmentID", segment.GetID()),
+				zap.Error(err))
+			metrics.DataCoordReplicatedSegmentCount.WithLabelValues(metrics.FailLabel).Inc()
+			continue
+		}
+		if err := c.kv.Save(shippedSegmentKey(segment.GetID()), strconv.Itoa(deltalogNum)); err != nil {
+			log.Warn("failed to save the replication progress", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+			continue
+		}
+		c.shipped[segment.GetID()] = deltalogNum
+		metrics.DataCoordReplicatedSegmentCount.WithLabelValues(metrics.SuccessLabel).Inc()
+	}
+
+	// forget the segments no longer flushed, e.g. compacted or dropped
+	for segmentID := range c.shipped {
+		if flushed.Contain(segmentID) {
+			continue
+		}
+		if err := c.kv.Remove(shippedSegmentKey(segmentID)); err != nil {
+			log.Warn("failed to remove the replication progress", zap.Int64("segmentID", segmentID), zap.Error(err))
+			continue
+		}
+		delete(c.shipped, segmentID)
+	}
+}
+
+// describe returns the names of the collection and its partitions, the results are cached in the round.
+func (c *replicationCoordinator) describe(ctx context.Context, collectionID UniqueID, cache map[UniqueID]*replicationCollection) (*replicationCollection, error) {
+	if collection, ok := cache[collectionID]; ok {
+		return collection, nil
+	}
+	resp, err := c.broker.DescribeCollectionInternal(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	partitions, err := c.broker.ShowPartitionNames(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	collection := &replicationCollection{
+		dbName:         resp.GetDbName(),
+		collectionName: resp.GetCollectionName(),
+		channels:       resp.GetVirtualChannelNames(),
+		partitions:     make(map[UniqueID]string, len(partitions)),
+	}
+	if collection.collectionName == "" {
+		collection.collectionName = resp.GetSchema().GetName()
+	}
+	for name, partitionID := range partitions {
+		collection.partitions[partitionID] = name
+	}
+	cache[collectionID] = collection
+	return collection, nil
+}
+
+// ship ships the binlogs then the meta of the segment, only the deltalogs are shipped if it's shipped before.
+func (c *replicationCoordinator) ship(ctx context.Context, segment *SegmentInfo, deltalogsOnly bool, cache map[UniqueID]*replicationCollection) error {
+	collection, err := c.describe(ctx, segment.GetCollectionID(), cache)
+	if err != nil {
+		return err
+	}
+	partitionName, ok := collection.partitions[segment.GetPartitionID()]
+	if !ok {
+		return merr.WrapErrPartitionNotFound(segment.GetPartitionID())
+	}
+	shardIndex := -1
+	for i, channel := range collection.channels {
+		if channel == segment.GetInsertChannel() {
+			shardIndex = i
+			break
+		}
+	}
+	if shardIndex < 0 {
+		return merr.WrapErrChannelNotFound(segment.GetInsertChannel(), "not a channel of the collection")
+	}
+
+	rootPath := c.meta.chunkManager.RootPath()
+	shipped := proto.Clone(segment.SegmentInfo).(*datapb.SegmentInfo)
+	shipLogs := func(fieldBinlogs []*datapb.FieldBinlog, upload bool) error {
+		for _, fieldBinlog := range fieldBinlogs {
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				logPath, err := relativeLogPath(rootPath, binlog.GetLogPath())
+				if err != nil {
+					return err
+				}
+				if upload {
+					data, err := c.meta.chunkManager.Read(ctx, binlog.GetLogPath())
+					if err != nil {
+						return err
+					}
+					status, err := c.client.ReplicateBinlog(ctx, &datapb.ReplicateBinlogRequest{
+						Base:    commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID())),
+						LogPath: logPath,
+						Data:    data,
+					})
+					if err := VerifyResponse(status, err); err != nil {
+						return err
+					}
+					metrics.DataCoordReplicatedBinlogSize.Add(float64(len(data)))
+				}
+				binlog.LogPath = logPath
+			}
+		}
+		return nil
+	}
+	if err := shipLogs(shipped.GetBinlogs(), !deltalogsOnly); err != nil {
+		return err
+	}
+	if err := shipLogs(shipped.GetStatslogs(), !deltalogsOnly); err != nil {
+		return err
+	}
+	if err := shipLogs(shipped.GetDeltalogs(), true); err != nil {
+		return err
+	}
+
+	resp, err := c.client.ReplicateSegment(ctx, &datapb.ReplicateSegmentRequest{
+		Base:           commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID())),
+		DbName:         collection.dbName,
+		CollectionName: collection.collectionName,
+		PartitionName:  partitionName,
+		ShardIndex:     int32(shardIndex),
+		Segment:        shipped,
+	})
+	return VerifyResponse(resp, err)
+}
+
+// replicateSegment adds the replica of the segment shipped from the primary cluster,
+// or appends the new deltalogs if the replica exists, returns the replica segment ID.
+func (s *Server) replicateSegment(ctx context.Context, req *datapb.ReplicateSegmentRequest) (UniqueID, error) {
+	source := req.GetSegment()
+	replicaID, err := s.loadReplicaSegmentID(source.GetID())
+	if err != nil {
+		return 0, err
+	}
+	if replicaID != 0 {
+		replica := s.meta.GetHealthySegment(replicaID)
+		if replica == nil {
+			// the replica is dropped in the standby cluster, there is nothing to append to
+			log.Ctx(ctx).Warn("replica segment not found", zap.Int64("segmentID", replicaID))
+			s.removeStagingLogs(ctx, source)
+			return replicaID, nil
+		}
+		return replicaID, s.appendReplicaDeltalogs(ctx, source, replica)
+	}
+
+	collection, err := s.broker.DescribeCollectionByName(ctx, req.GetDbName(), req.GetCollectionName())
+	if err != nil {
+		return 0, err
+	}
+	channels := collection.GetVirtualChannelNames()
+	if req.GetShardIndex() < 0 || int(req.GetShardIndex()) >= len(channels) {
+		return 0, merr.WrapErrParameterInvalidRange(0, len(channels)-1, int(req.GetShardIndex()),
+			"shard index out of the shards of the standby collection")
+	}
+	partitions, err := s.broker.ShowPartitionNames(ctx, collection.GetCollectionID())
+	if err != nil {
+		return 0, err
+	}
+	partitionID, ok := partitions[req.GetPartitionName()]
+	if !ok {
+		return 0, merr.WrapErrPartitionNotFound(req.GetPartitionName(), "no partition of the same name in the standby collection")
+	}
+	segmentID, err := s.allocator.allocID(ctx)
+	if err != nil {
+		return 0, err
+	}
+	ts, err := s.allocator.allocTimestamp(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	replica := &datapb.SegmentInfo{
+		ID:             segmentID,
+		CollectionID:   collection.GetCollectionID(),
+		PartitionID:    partitionID,
+		InsertChannel:  channels[req.GetShardIndex()],
+		NumOfRows:      source.GetNumOfRows(),
+		State:          commonpb.SegmentState_Flushed,
+		MaxRowNum:      source.GetMaxRowNum(),
+		LastExpireTime: ts,
+	}
+	if replica.Binlogs, err = s.moveStagingLogs(ctx, common.SegmentInsertLogPath, source, replica, source.GetBinlogs(), nil); err != nil {
+		return 0, err
+	}
+	if replica.Statslogs, err = s.moveStagingLogs(ctx, common.SegmentStatslogPath, source, replica, source.GetStatslogs(), nil); err != nil {
+		return 0, err
+	}
+	if replica.Deltalogs, err = s.moveStagingLogs(ctx, common.SegmentDeltaLogPath, source, replica, source.GetDeltalogs(), nil); err != nil {
+		return 0, err
+	}
+	if err := s.addCopiedSegment(ctx, replica, ts); err != nil {
+		return 0, err
+	}
+	if err := s.kvClient.Save(replicaSegmentKey(source.GetID()), strconv.FormatInt(replica.GetID(), 10)); err != nil {
+		return 0, err
+	}
+	s.removeStagingLogs(ctx, source)
+	s.dropCompactedReplicas(ctx, source.GetCompactionFrom())
+	return replica.GetID(), nil
+}
+
+// loadReplicaSegmentID returns the replica segment ID of the source segment, 0 if it's not replicated yet.
+func (s *Server) loadReplicaSegmentID(sourceSegmentID UniqueID) (UniqueID, error) {
+	value, err := s.kvClient.Load(replicaSegmentKey(sourceSegmentID))
+	if common.IsKeyNotExistError(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(value, 10, 64)
+}
+
+// moveStagingLogs copies the replicated binlogs from the staging area to the replica segment,
+// the binlogs already in the replica are skipped, returns the binlogs copied.
+func (s *Server) moveStagingLogs(ctx context.Context, logType string, source, replica *datapb.SegmentInfo,
+	fieldBinlogs []*datapb.FieldBinlog, existing typeutil.Set[string],
+) ([]*datapb.FieldBinlog, error) {
+	rootPath := s.meta.chunkManager.RootPath()
+	ret := make([]*datapb.FieldBinlog, 0, len(fieldBinlogs))
+	for _, fieldBinlog := range fieldBinlogs {
+		fieldBinlog = proto.Clone(fieldBinlog).(*datapb.FieldBinlog)
+		binlogs := make([]*datapb.Binlog, 0, len(fieldBinlog.GetBinlogs()))
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			logPath, err := replicaLogPath(rootPath, logType, binlog.GetLogPath(), source, replica)
+			if err != nil {
+				return nil, err
+			}
+			if existing.Contain(logPath) {
+				continue
+			}
+			stagingPath, err := replicationStagingPath(rootPath, binlog.GetLogPath())
+			if err != nil {
+				return nil, err
+			}
+			if err := storage.CopyFile(ctx, s.meta.chunkManager, stagingPath, logPath); err != nil {
+				return nil, err
+			}
+			binlog.LogPath = logPath
+			binlogs = append(binlogs, binlog)
+		}
+		if len(binlogs) > 0 {
+			fieldBinlog.Binlogs = binlogs
+			ret = append(ret, fieldBinlog)
+		}
+	}
+	return ret, nil
+}
+
+// appendReplicaDeltalogs appends the deltalogs of the source segment not in the replica yet.
+func (s *Server) appendReplicaDeltalogs(ctx context.Context, source *datapb.SegmentInfo, replica *SegmentInfo) error {
+	existing := typeutil.NewSet[string]()
+	for _, fieldBinlog := range replica.GetDeltalogs() {
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			existing.Insert(binlog.GetLogPath())
+		}
+	}
+	deltalogs, err := s.moveStagingLogs(ctx, common.SegmentDeltaLogPath, source, replica.SegmentInfo, source.GetDeltalogs(), existing)
+	if err != nil {
+		return err
+	}
+	if len(deltalogs) > 0 {
+		if err := s.meta.UpdateFlushSegmentsInfo(replica.GetID(), false, false, false, nil, nil, deltalogs, nil, nil, replica.GetEncryptionKeyVersion()); err != nil {
+			return err
+		}
+	}
+	s.removeStagingLogs(ctx, source)
+	return nil
+}
+
+// removeStagingLogs removes the binlogs of the source segment in the staging area, failures are only logged,
+// the binlogs left are overwritten if the segment is shipped again.
+func (s *Server) removeStagingLogs(ctx context.Context, source *datapb.SegmentInfo) {
+	rootPath := s.meta.chunkManager.RootPath()
+	var stagingPaths []string
+	for _, fieldBinlogs := range [][]*datapb.FieldBinlog{source.GetBinlogs(), source.GetStatslogs(), source.GetDeltalogs()} {
+		for _, fieldBinlog := range fieldBinlogs {
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				if stagingPath, err := replicationStagingPath(rootPath, binlog.GetLogPath()); err == nil {
+					stagingPaths = append(stagingPaths, stagingPath)
+				}
+			}
+		}
+	}
+	if err := s.meta.chunkManager.MultiRemove(ctx, stagingPaths); err != nil {
+		log.Ctx(ctx).Warn("failed to remove the replicated binlogs in staging area",
+			zap.Int64("sourceSegmentID", source.GetID()), zap.Error(err))
+	}
+}
+
+// dropCompactedReplicas drops the replicas of the segments compacted into a replicated one,
+// the replicas not found are ignored.
+func (s *Server) dropCompactedReplicas(ctx context.Context, sourceSegmentIDs []UniqueID) {
+	for _, sourceSegmentID := range sourceSegmentIDs {
+		log := log.Ctx(ctx).With(zap.Int64("sourceSegmentID", sourceSegmentID))
+		replicaID, err := s.loadReplicaSegmentID(sourceSegmentID)
+		if err != nil {
+			log.Warn("failed to load replica segment", zap.Error(err))
+			continue
+		}
+		if replicaID == 0 {
+			continue
+		}
+		if err := s.meta.SetState(replicaID, commonpb.SegmentState_Dropped); err != nil {
+			log.Warn("failed to drop compacted replica segment", zap.Int64("segmentID", replicaID), zap.Error(err))
+			continue
+		}
+		if err := s.kvClient.Remove(replicaSegmentKey(sourceSegmentID)); err != nil {
+			log.Warn("failed to remove the replica segment mapping", zap.Error(err))
+		}
+	}
+}
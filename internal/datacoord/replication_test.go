@@ -0,0 +1,181 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
+)
+
+type mockReplicationClient struct {
+	binlogs  []*datapb.ReplicateBinlogRequest
+	segments []*datapb.ReplicateSegmentRequest
+	err      error
+}
+
+func (c *mockReplicationClient) ReplicateBinlog(ctx context.Context, req *datapb.ReplicateBinlogRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	c.binlogs = append(c.binlogs, req)
+	return merr.Status(nil), nil
+}
+
+func (c *mockReplicationClient) ReplicateSegment(ctx context.Context, req *datapb.ReplicateSegmentRequest, opts ...grpc.CallOption) (*datapb.ReplicateSegmentResponse, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	c.segments = append(c.segments, req)
+	return &datapb.ReplicateSegmentResponse{Status: merr.Status(nil), SegmentID: req.GetSegment().GetID() + 1000}, nil
+}
+
+func TestReplicationPaths(t *testing.T) {
+	logPath, err := relativeLogPath("files", "files/insert_log/1/2/3/4/5")
+	assert.NoError(t, err)
+	assert.Equal(t, "insert_log/1/2/3/4/5", logPath)
+	_, err = relativeLogPath("files", "other/insert_log/1/2/3/4/5")
+	assert.Error(t, err)
+	logPath, err = relativeLogPath("", "insert_log/1/2/3/4/5")
+	assert.NoError(t, err)
+	assert.Equal(t, "insert_log/1/2/3/4/5", logPath)
+
+	stagingPath, err := replicationStagingPath("files", "insert_log/1/2/3/4/5")
+	assert.NoError(t, err)
+	assert.Equal(t, "files/replication_staging/insert_log/1/2/3/4/5", stagingPath)
+	for _, logPath := range []string{"", "/insert_log/1", "..", "../insert_log/1", "insert_log/../../1"} {
+		_, err = replicationStagingPath("files", logPath)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid, logPath)
+	}
+
+	source := &datapb.SegmentInfo{ID: 3, CollectionID: 1, PartitionID: 2}
+	replica := &datapb.SegmentInfo{ID: 30, CollectionID: 10, PartitionID: 20}
+	logPath, err = replicaLogPath("files", common.SegmentInsertLogPath, "insert_log/1/2/3/4/5", source, replica)
+	assert.NoError(t, err)
+	assert.Equal(t, "files/insert_log/10/20/30/4/5", logPath)
+	_, err = replicaLogPath("files", common.SegmentInsertLogPath, "insert_log/1/2/4/4/5", source, replica)
+	assert.Error(t, err)
+}
+
+func TestReplicationCoordinator(t *testing.T) {
+	ctx := context.Background()
+	meta, err := newMemoryMeta()
+	require.NoError(t, err)
+	meta.chunkManager = storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	rootPath := meta.chunkManager.RootPath()
+	broker := NewCoordinatorBroker(newMockRootCoordService())
+	kv := NewMetaMemoryKV()
+
+	// the mocked RootCoord has collection "test" of channel "vchan1" and partition "_default" of ID 0
+	binlogPath := metautil.BuildInsertLogPath(rootPath, 1314, 0, 1, 101, 1)
+	deltalogPath := metautil.BuildDeltaLogPath(rootPath, 1314, 0, 1, 2)
+	require.NoError(t, meta.chunkManager.Write(ctx, binlogPath, []byte("binlog")))
+	require.NoError(t, meta.chunkManager.Write(ctx, deltalogPath, []byte("deltalog")))
+	err = meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+		ID:            1,
+		CollectionID:  1314,
+		PartitionID:   0,
+		InsertChannel: "vchan1",
+		NumOfRows:     10,
+		State:         commonpb.SegmentState_Flushed,
+		Binlogs: []*datapb.FieldBinlog{
+			{FieldID: 101, Binlogs: []*datapb.Binlog{{LogPath: binlogPath, EntriesNum: 10}}},
+		},
+		Deltalogs: []*datapb.FieldBinlog{
+			{Binlogs: []*datapb.Binlog{{LogPath: deltalogPath, EntriesNum: 1}}},
+		},
+	}))
+	require.NoError(t, err)
+	// growing segment isn't shipped
+	require.NoError(t, meta.AddSegment(buildSegment(1314, 0, 2, "vchan1", false)))
+
+	client := &mockReplicationClient{err: errors.New("mock")}
+	c, err := newReplicationCoordinator(meta, broker, kv, client)
+	require.NoError(t, err)
+
+	t.Run("ship failed", func(t *testing.T) {
+		c.replicate(ctx)
+		assert.Empty(t, c.shipped)
+		client.err = nil
+	})
+
+	t.Run("ship segment", func(t *testing.T) {
+		c.replicate(ctx)
+		require.Equal(t, 2, len(client.binlogs))
+		assert.Equal(t, "insert_log/1314/0/1/101/1", client.binlogs[0].GetLogPath())
+		assert.Equal(t, []byte("binlog"), client.binlogs[0].GetData())
+		assert.Equal(t, "delta_log/1314/0/1/2", client.binlogs[1].GetLogPath())
+
+		require.Equal(t, 1, len(client.segments))
+		req := client.segments[0]
+		assert.Equal(t, "test", req.GetCollectionName())
+		assert.Equal(t, "_default", req.GetPartitionName())
+		assert.EqualValues(t, 0, req.GetShardIndex())
+		assert.EqualValues(t, 1, req.GetSegment().GetID())
+		assert.Equal(t, "insert_log/1314/0/1/101/1", req.GetSegment().GetBinlogs()[0].GetBinlogs()[0].GetLogPath())
+		// the meta isn't changed
+		assert.Equal(t, binlogPath, meta.GetSegment(1).GetBinlogs()[0].GetBinlogs()[0].GetLogPath())
+		assert.Equal(t, map[UniqueID]int{1: 1}, c.shipped)
+
+		// shipped only once
+		c.replicate(ctx)
+		assert.Equal(t, 1, len(client.segments))
+	})
+
+	t.Run("ship new deltalogs", func(t *testing.T) {
+		newDeltalogPath := metautil.BuildDeltaLogPath(rootPath, 1314, 0, 1, 3)
+		require.NoError(t, meta.chunkManager.Write(ctx, newDeltalogPath, []byte("deltalog")))
+		err := meta.UpdateFlushSegmentsInfo(1, false, false, false, nil, nil, []*datapb.FieldBinlog{
+			{Binlogs: []*datapb.Binlog{{LogPath: newDeltalogPath, EntriesNum: 1}}},
+		}, nil, nil, 0)
+		require.NoError(t, err)
+
+		client.binlogs = nil
+		c.replicate(ctx)
+		require.Equal(t, 2, len(client.binlogs))
+		assert.Equal(t, "delta_log/1314/0/1/2", client.binlogs[0].GetLogPath())
+		assert.Equal(t, "delta_log/1314/0/1/3", client.binlogs[1].GetLogPath())
+		assert.Equal(t, 2, len(client.segments))
+		assert.Equal(t, map[UniqueID]int{1: 2}, c.shipped)
+	})
+
+	t.Run("reload progress", func(t *testing.T) {
+		reloaded, err := newReplicationCoordinator(meta, broker, kv, client)
+		require.NoError(t, err)
+		assert.Equal(t, c.shipped, reloaded.shipped)
+	})
+
+	t.Run("forget dropped segment", func(t *testing.T) {
+		require.NoError(t, meta.SetState(1, commonpb.SegmentState_Dropped))
+		c.replicate(ctx)
+		assert.Empty(t, c.shipped)
+		keys, _, err := kv.LoadWithPrefix(shippedSegmentPrefix)
+		assert.NoError(t, err)
+		assert.Empty(t, keys)
+	})
+}
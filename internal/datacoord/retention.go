@@ -0,0 +1,169 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+)
+
+// retentionManager enforces the TTL of the collections, see common.CollectionTTLConfigKey.
+// The segments whose entities are all expired are dropped as a whole,
+// the partially expired segments are left to the compactions, which purge the expired entities by the insert timestamp.
+type retentionManager struct {
+	meta    *meta
+	handler Handler
+}
+
+func newRetentionManager(meta *meta, handler Handler) *retentionManager {
+	return &retentionManager{
+		meta:    meta,
+		handler: handler,
+	}
+}
+
+func (s *Server) startRetentionLoop(ctx context.Context) {
+	if !Params.DataCoordCfg.EnableCompaction.GetAsBool() {
+		return
+	}
+	manager := newRetentionManager(s.meta, s.handler)
+	s.serverLoopWg.Add(1)
+	go func() {
+		defer s.serverLoopWg.Done()
+		manager.loop(ctx)
+	}()
+}
+
+func (m *retentionManager) loop(ctx context.Context) {
+	ticker := time.NewTicker(Params.DataCoordCfg.RetentionCheckInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("retention loop quit")
+			return
+		case <-ticker.C:
+			m.enforce(ctx, tsoutil.ComposeTSByTime(time.Now(), 0))
+		}
+	}
+}
+
+// enforce drops the expired segments of each collection with TTL at the timestamp.
+func (m *retentionManager) enforce(ctx context.Context, ts Timestamp) {
+	segments := m.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return isSegmentHealthy(segment) && segment.GetLevel() != datapb.SegmentLevel_L0
+	})
+	collectionIDs := lo.Uniq(lo.Map(segments, func(segment *SegmentInfo, _ int) int64 { return segment.GetCollectionID() }))
+	for _, collectionID := range collectionIDs {
+		log := log.With(zap.Int64("collectionID", collectionID))
+		coll, err := m.handler.GetCollection(ctx, collectionID)
+		if err != nil || coll == nil {
+			log.Warn("failed to get collection for retention", zap.Error(err))
+			continue
+		}
+		ttl, err := getCollectionTTL(coll.Properties)
+		if err != nil {
+			log.Warn("invalid collection ttl", zap.Error(err))
+			continue
+		}
+		if ttl <= 0 {
+			continue
+		}
+
+		pts, _ := tsoutil.ParseTS(ts)
+		expireTs := tsoutil.ComposeTSByTime(pts.Add(-ttl), 0)
+		dropped, err := m.meta.dropExpiredSegments(collectionID, expireTs)
+		if err != nil {
+			log.Warn("failed to drop expired segments", zap.Error(err))
+			continue
+		}
+		if len(dropped) == 0 {
+			continue
+		}
+		var rows int64
+		for _, segment := range dropped {
+			rows += segment.GetNumOfRows()
+		}
+		metrics.DataCoordRetentionPurgedRowCount.WithLabelValues(fmt.Sprint(collectionID)).Add(float64(rows))
+		log.Info("expired segments dropped", zap.Duration("ttl", ttl), zap.Int64("purgedRows", rows),
+			zap.Int64s("segmentIDs", lo.Map(dropped, func(segment *SegmentInfo, _ int) int64 { return segment.GetID() })))
+	}
+}
+
+// isSegmentExpired returns true if all the entities of the flushed segment are inserted before the expire timestamp.
+func isSegmentExpired(segment *SegmentInfo, expireTs Timestamp) bool {
+	if segment.GetState() != commonpb.SegmentState_Flushed || segment.GetNumOfRows() == 0 || len(segment.GetBinlogs()) == 0 {
+		return false
+	}
+	for _, fieldBinlog := range segment.GetBinlogs() {
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			// the binlogs written by the older versions have no time range
+			if binlog.GetTimestampTo() == 0 || binlog.GetTimestampTo() >= expireTs {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// dropExpiredSegments marks the expired segments of the collection dropped, returns the dropped segments.
+// The segments in compaction or importing are skipped.
+func (m *meta) dropExpiredSegments(collectionID UniqueID, expireTs Timestamp) ([]*SegmentInfo, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	metricMutation := &segMetricMutation{
+		stateChange: make(map[string]int),
+	}
+	droppedAt := uint64(time.Now().UnixNano())
+	dropped := make([]*SegmentInfo, 0)
+	for _, segment := range m.segments.GetSegments() {
+		if segment.GetCollectionID() != collectionID ||
+			segment.GetLevel() == datapb.SegmentLevel_L0 ||
+			segment.isCompacting || segment.GetIsImporting() ||
+			!isSegmentExpired(segment, expireTs) {
+			continue
+		}
+		cloned := segment.Clone()
+		updateSegStateAndPrepareMetrics(cloned, commonpb.SegmentState_Dropped, metricMutation)
+		cloned.DroppedAt = droppedAt
+		dropped = append(dropped, cloned)
+	}
+	if len(dropped) == 0 {
+		return nil, nil
+	}
+
+	modInfos := lo.Map(dropped, func(segment *SegmentInfo, _ int) *datapb.SegmentInfo { return segment.SegmentInfo })
+	if err := m.catalog.AlterSegments(m.ctx, modInfos); err != nil {
+		return nil, err
+	}
+	metricMutation.commit()
+	for _, segment := range dropped {
+		m.segments.SetSegment(segment.GetID(), segment)
+	}
+	return dropped, nil
+}
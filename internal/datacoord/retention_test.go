@@ -0,0 +1,87 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+)
+
+func TestIsSegmentExpired(t *testing.T) {
+	newSegment := func(state commonpb.SegmentState, timestampTo ...Timestamp) *SegmentInfo {
+		binlogs := make([]*datapb.Binlog, 0, len(timestampTo))
+		for _, ts := range timestampTo {
+			binlogs = append(binlogs, &datapb.Binlog{TimestampTo: ts})
+		}
+		return NewSegmentInfo(&datapb.SegmentInfo{
+			State:     state,
+			NumOfRows: 10,
+			Binlogs:   []*datapb.FieldBinlog{{FieldID: 1, Binlogs: binlogs}},
+		})
+	}
+
+	assert.True(t, isSegmentExpired(newSegment(commonpb.SegmentState_Flushed, 10, 20), 100))
+	assert.False(t, isSegmentExpired(newSegment(commonpb.SegmentState_Flushed, 10, 200), 100))
+	assert.False(t, isSegmentExpired(newSegment(commonpb.SegmentState_Growing, 10, 20), 100))
+	// no time range
+	assert.False(t, isSegmentExpired(newSegment(commonpb.SegmentState_Flushed, 0), 100))
+	assert.False(t, isSegmentExpired(NewSegmentInfo(&datapb.SegmentInfo{State: commonpb.SegmentState_Flushed}), 100))
+}
+
+func TestRetentionManager(t *testing.T) {
+	meta, err := newMemoryMeta()
+	require.NoError(t, err)
+	meta.AddCollection(&collectionInfo{ID: 1, Properties: map[string]string{common.CollectionTTLConfigKey: "3600"}})
+	meta.AddCollection(&collectionInfo{ID: 2})
+
+	now := time.Now()
+	addSegment := func(id, collectionID UniqueID, inserted time.Time, compacting bool) {
+		require.NoError(t, meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+			ID:           id,
+			CollectionID: collectionID,
+			State:        commonpb.SegmentState_Flushed,
+			NumOfRows:    10,
+			Binlogs: []*datapb.FieldBinlog{
+				{FieldID: 1, Binlogs: []*datapb.Binlog{{TimestampTo: tsoutil.ComposeTSByTime(inserted, 0)}}},
+			},
+		})))
+		meta.SetSegmentCompacting(id, compacting)
+	}
+	addSegment(1, 1, now.Add(-2*time.Hour), false)
+	addSegment(2, 1, now.Add(-time.Minute), false)
+	addSegment(3, 1, now.Add(-2*time.Hour), true)
+	// no ttl
+	addSegment(4, 2, now.Add(-2*time.Hour), false)
+
+	manager := newRetentionManager(meta, newMockHandlerWithMeta(meta))
+	manager.enforce(context.Background(), tsoutil.ComposeTSByTime(now, 0))
+
+	assert.Equal(t, commonpb.SegmentState_Dropped, meta.GetSegment(1).GetState())
+	assert.NotZero(t, meta.GetSegment(1).GetDroppedAt())
+	assert.Equal(t, commonpb.SegmentState_Flushed, meta.GetSegment(2).GetState())
+	assert.Equal(t, commonpb.SegmentState_Flushed, meta.GetSegment(3).GetState())
+	assert.Equal(t, commonpb.SegmentState_Flushed, meta.GetSegment(4).GetState())
+}
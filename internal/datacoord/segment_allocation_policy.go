@@ -18,6 +18,7 @@ package datacoord
 
 import (
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/cockroachdb/errors"
@@ -25,9 +26,82 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
+const (
+	defaultSegmentAllocPolicyName   = "default"
+	timeBasedSegmentAllocPolicyName = "timeBased"
+)
+
+// SegmentAllocPolicy provides the policies used by the SegmentManager to allocate and seal the segments,
+// the implementation is selected by dataCoord.segment.allocPolicy.
+type SegmentAllocPolicy interface {
+	// Name returns the name to select the policy by config.
+	Name() string
+	// EstimatePolicy returns the policy to estimate the max number of rows of a segment.
+	EstimatePolicy() calUpperLimitPolicy
+	// AllocatePolicy returns the policy to allocate the rows among the growing segments of a channel.
+	AllocatePolicy() AllocatePolicy
+	// SegmentSealPolicies returns the policies to seal a growing segment, any of them sealing the segment seals it.
+	SegmentSealPolicies() []segmentSealPolicy
+	// ChannelSealPolicies returns the policies to seal the growing segments of a channel.
+	ChannelSealPolicies() []channelSealPolicy
+}
+
+// newSegmentAllocPolicy returns the SegmentAllocPolicy of the name, the name is case-insensitive.
+func newSegmentAllocPolicy(name string) (SegmentAllocPolicy, error) {
+	switch strings.ToLower(name) {
+	case strings.ToLower(defaultSegmentAllocPolicyName):
+		return defaultSegmentAllocPolicy{}, nil
+	case strings.ToLower(timeBasedSegmentAllocPolicyName):
+		return timeBasedSegmentAllocPolicy{}, nil
+	}
+	return nil, merr.WrapErrParameterInvalid(defaultSegmentAllocPolicyName+" or "+timeBasedSegmentAllocPolicyName,
+		name, "unknown segment alloc policy")
+}
+
+// defaultSegmentAllocPolicy allocates the rows greedily, and seals the segments by the binlog number,
+// the lifetime, the size and the idle time.
+type defaultSegmentAllocPolicy struct{}
+
+func (defaultSegmentAllocPolicy) Name() string {
+	return defaultSegmentAllocPolicyName
+}
+
+func (defaultSegmentAllocPolicy) EstimatePolicy() calUpperLimitPolicy {
+	return defaultCalUpperLimitPolicy()
+}
+
+func (defaultSegmentAllocPolicy) AllocatePolicy() AllocatePolicy {
+	return defaultAllocatePolicy()
+}
+
+func (defaultSegmentAllocPolicy) SegmentSealPolicies() []segmentSealPolicy {
+	return defaultSegmentSealPolicy()
+}
+
+func (defaultSegmentAllocPolicy) ChannelSealPolicies() []channelSealPolicy {
+	// no default channel seal policy
+	return []channelSealPolicy{}
+}
+
+// timeBasedSegmentAllocPolicy seals the growing segments older than dataCoord.segment.maxAge
+// besides the default policies.
+type timeBasedSegmentAllocPolicy struct {
+	defaultSegmentAllocPolicy
+}
+
+func (timeBasedSegmentAllocPolicy) Name() string {
+	return timeBasedSegmentAllocPolicyName
+}
+
+func (p timeBasedSegmentAllocPolicy) SegmentSealPolicies() []segmentSealPolicy {
+	return append(p.defaultSegmentAllocPolicy.SegmentSealPolicies(),
+		sealByAgePolicy(Params.DataCoordCfg.SegmentMaxAge.GetAsDuration(time.Second)))
+}
+
 type calUpperLimitPolicy func(schema *schemapb.CollectionSchema) (int, error)
 
 func calBySchemaPolicy(schema *schemapb.CollectionSchema) (int, error) {
@@ -155,6 +229,47 @@ func sealLongTimeIdlePolicy(idleTimeTolerance time.Duration, minSizeToSealIdleSe
 	}
 }
 
+// sealByAgePolicy seal segment if its first record is older than maxAge, no matter how small it is.
+// serve for the low-throughput collections:
+// The growing segments of them keep accepting a few records, so they never reach the seal proportion,
+// and their lastExpireTime keeps being refreshed by the allocations, the lifetime policy doesn't seal them either.
+// The tiny growing segments accumulate and slow down the search, this policy seals them periodically,
+// and leaves the small sealed segments to the compaction.
+func sealByAgePolicy(maxAge time.Duration) segmentSealPolicy {
+	return func(segment *SegmentInfo, ts Timestamp) bool {
+		if segment.GetStartPosition() == nil {
+			return false
+		}
+		pts, _ := tsoutil.ParseTS(ts)
+		spts, _ := tsoutil.ParseTS(segment.GetStartPosition().GetTimestamp())
+		return pts.Sub(spts) >= maxAge
+	}
+}
+
+// sealByTimeBoundaryPolicy seal segment if a time boundary has passed since its first record,
+// the boundaries are the multiples of the seal interval of the collection since the Unix epoch, e.g. every hour.
+// serve for the TTL expiry and the time-range drops:
+// Each sealed segment holds the records of one time range only, so the expired ranges map onto whole segments,
+// which could be dropped without rewriting.
+func sealByTimeBoundaryPolicy(meta *meta) segmentSealPolicy {
+	return func(segment *SegmentInfo, ts Timestamp) bool {
+		if segment.GetStartPosition() == nil {
+			return false
+		}
+		collection := meta.GetCollection(segment.GetCollectionID())
+		if collection == nil {
+			return false
+		}
+		interval, err := getCollectionSealInterval(collection.Properties)
+		if err != nil || interval <= 0 {
+			return false
+		}
+		pts, _ := tsoutil.ParseTS(ts)
+		spts, _ := tsoutil.ParseTS(segment.GetStartPosition().GetTimestamp())
+		return pts.UnixNano()/int64(interval) != spts.UnixNano()/int64(interval)
+	}
+}
+
 // channelSealPolicy seal policy applies to channel
 type channelSealPolicy func(string, []*SegmentInfo, Timestamp) []*SegmentInfo
 
@@ -22,10 +22,13 @@ import (
 	"time"
 
 	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 )
@@ -192,3 +195,86 @@ func Test_sealLongTimeIdlePolicy(t *testing.T) {
 	seg3 := &SegmentInfo{lastWrittenTime: getZeroTime(), currRows: 1000, SegmentInfo: &datapb.SegmentInfo{MaxRowNum: 10000}}
 	assert.True(t, policy(seg3, 100))
 }
+
+func Test_sealByAgePolicy(t *testing.T) {
+	policy := sealByAgePolicy(time.Hour)
+	now := time.Now()
+	ts := tsoutil.ComposeTSByTime(now, 0)
+
+	// no record
+	assert.False(t, policy(&SegmentInfo{SegmentInfo: &datapb.SegmentInfo{}}, ts))
+	seg := &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{
+		StartPosition: &msgpb.MsgPosition{Timestamp: tsoutil.ComposeTSByTime(now.Add(-time.Minute), 0)},
+	}}
+	assert.False(t, policy(seg, ts))
+	seg.StartPosition.Timestamp = tsoutil.ComposeTSByTime(now.Add(-2*time.Hour), 0)
+	assert.True(t, policy(seg, ts))
+}
+
+func Test_sealByTimeBoundaryPolicy(t *testing.T) {
+	meta, err := newMemoryMeta()
+	assert.NoError(t, err)
+	meta.AddCollection(&collectionInfo{ID: 1})
+	meta.AddCollection(&collectionInfo{ID: 2, Properties: map[string]string{common.CollectionSealIntervalKey: "3600"}})
+	meta.AddCollection(&collectionInfo{ID: 3, Properties: map[string]string{common.CollectionSealIntervalKey: "invalid"}})
+	policy := sealByTimeBoundaryPolicy(meta)
+
+	boundary := time.Date(2023, 8, 1, 10, 0, 0, 0, time.UTC)
+	newSegment := func(collectionID UniqueID, start time.Time) *SegmentInfo {
+		return &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{
+			CollectionID:  collectionID,
+			StartPosition: &msgpb.MsgPosition{Timestamp: tsoutil.ComposeTSByTime(start, 0)},
+		}}
+	}
+	beforeBoundary := tsoutil.ComposeTSByTime(boundary.Add(-time.Second), 0)
+	afterBoundary := tsoutil.ComposeTSByTime(boundary.Add(time.Second), 0)
+
+	// disabled by default
+	assert.False(t, policy(newSegment(1, boundary.Add(-time.Minute)), afterBoundary))
+	assert.False(t, policy(newSegment(3, boundary.Add(-time.Minute)), afterBoundary))
+	// no record
+	assert.False(t, policy(&SegmentInfo{SegmentInfo: &datapb.SegmentInfo{CollectionID: 2}}, afterBoundary))
+	// unknown collection
+	assert.False(t, policy(newSegment(4, boundary.Add(-time.Minute)), afterBoundary))
+
+	assert.False(t, policy(newSegment(2, boundary.Add(-time.Minute)), beforeBoundary))
+	assert.True(t, policy(newSegment(2, boundary.Add(-time.Minute)), afterBoundary))
+	assert.False(t, policy(newSegment(2, boundary), afterBoundary))
+
+	// the global interval applies to the collections without the property
+	paramtable.Get().Save(Params.DataCoordCfg.SegmentSealInterval.Key, "60")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.SegmentSealInterval.Key)
+	assert.True(t, policy(newSegment(1, boundary.Add(-time.Minute)), afterBoundary))
+}
+
+func TestSegmentAllocPolicy(t *testing.T) {
+	policy, err := newSegmentAllocPolicy("default")
+	assert.NoError(t, err)
+	assert.Equal(t, defaultSegmentAllocPolicyName, policy.Name())
+	defaultSealPolicies := policy.SegmentSealPolicies()
+	assert.NotNil(t, policy.EstimatePolicy())
+	assert.NotNil(t, policy.AllocatePolicy())
+	assert.Empty(t, policy.ChannelSealPolicies())
+
+	policy, err = newSegmentAllocPolicy("TimeBased")
+	assert.NoError(t, err)
+	assert.Equal(t, timeBasedSegmentAllocPolicyName, policy.Name())
+	assert.Equal(t, len(defaultSealPolicies)+1, len(policy.SegmentSealPolicies()))
+
+	_, err = newSegmentAllocPolicy("unknown")
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+	// the segment manager fails to create with an unknown policy
+	paramtable.Get().Save(Params.DataCoordCfg.SegmentAllocPolicy.Key, "unknown")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.SegmentAllocPolicy.Key)
+	meta, err := newMemoryMeta()
+	assert.NoError(t, err)
+	_, err = newSegmentManager(meta, newMockAllocator())
+	assert.Error(t, err)
+
+	paramtable.Get().Save(Params.DataCoordCfg.SegmentAllocPolicy.Key, timeBasedSegmentAllocPolicyName)
+	manager, err := newSegmentManager(meta, newMockAllocator())
+	assert.NoError(t, err)
+	// plus the time boundary policy
+	assert.Equal(t, len(defaultSealPolicies)+2, len(manager.segmentSealPolicies))
+}
@@ -17,6 +17,7 @@
 package datacoord
 
 import (
+	"math"
 	"time"
 
 	"github.com/golang/protobuf/proto"
@@ -28,11 +29,18 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
-// SegmentsInfo wraps a map, which maintains ID to SegmentInfo relation
+// SegmentsInfo wraps a map, which maintains ID to SegmentInfo relation,
+// and the secondary indexes of the segments by channel, partition and state,
+// which are kept consistent with the map by SetSegment and DropSegment.
+// The stored SegmentInfo must not be modified in place, the setters replace it with a modified clone.
 type SegmentsInfo struct {
-	segments map[UniqueID]*SegmentInfo
+	segments           map[UniqueID]*SegmentInfo
+	channel2Segments   map[string]typeutil.UniqueSet
+	partition2Segments map[UniqueID]typeutil.UniqueSet
+	state2Segments     map[commonpb.SegmentState]typeutil.UniqueSet
 }
 
 // SegmentInfo wraps datapb.SegmentInfo and patches some extra info on it
@@ -67,7 +75,12 @@ func NewSegmentInfo(info *datapb.SegmentInfo) *SegmentInfo {
 // NewSegmentsInfo creates a `SegmentsInfo` instance, which makes sure internal map is initialized
 // note that no mutex is wrapped so external concurrent control is needed
 func NewSegmentsInfo() *SegmentsInfo {
-	return &SegmentsInfo{segments: make(map[UniqueID]*SegmentInfo)}
+	return &SegmentsInfo{
+		segments:           make(map[UniqueID]*SegmentInfo),
+		channel2Segments:   make(map[string]typeutil.UniqueSet),
+		partition2Segments: make(map[UniqueID]typeutil.UniqueSet),
+		state2Segments:     make(map[commonpb.SegmentState]typeutil.UniqueSet),
+	}
 }
 
 // GetSegment returns SegmentInfo
@@ -89,15 +102,84 @@ func (s *SegmentsInfo) GetSegments() []*SegmentInfo {
 	return segments
 }
 
+// GetSegmentsByChannel returns the segments of the channel, including the unhealthy ones
+func (s *SegmentsInfo) GetSegmentsByChannel(channel string) []*SegmentInfo {
+	return s.getSegmentsByIDs(s.channel2Segments[channel])
+}
+
+// GetSegmentsByPartition returns the segments of the partition, including the unhealthy ones
+func (s *SegmentsInfo) GetSegmentsByPartition(partitionID UniqueID) []*SegmentInfo {
+	return s.getSegmentsByIDs(s.partition2Segments[partitionID])
+}
+
+// GetSegmentsByState returns the segments in any of the states
+func (s *SegmentsInfo) GetSegmentsByState(states ...commonpb.SegmentState) []*SegmentInfo {
+	segments := make([]*SegmentInfo, 0)
+	for _, state := range states {
+		segments = append(segments, s.getSegmentsByIDs(s.state2Segments[state])...)
+	}
+	return segments
+}
+
+func (s *SegmentsInfo) getSegmentsByIDs(segmentIDs typeutil.UniqueSet) []*SegmentInfo {
+	segments := make([]*SegmentInfo, 0, len(segmentIDs))
+	for segmentID := range segmentIDs {
+		segments = append(segments, s.segments[segmentID])
+	}
+	return segments
+}
+
 // DropSegment deletes provided segmentID
 // no extra method is taken when segmentID not exists
 func (s *SegmentsInfo) DropSegment(segmentID UniqueID) {
-	delete(s.segments, segmentID)
+	if segment, ok := s.segments[segmentID]; ok {
+		s.removeIndexes(segmentID, segment)
+		delete(s.segments, segmentID)
+	}
 }
 
 // SetSegment sets SegmentInfo with segmentID, perform overwrite if already exists
+// all the writes of the segments go through it to keep the secondary indexes updated
 func (s *SegmentsInfo) SetSegment(segmentID UniqueID, segment *SegmentInfo) {
+	if old, ok := s.segments[segmentID]; ok {
+		s.removeIndexes(segmentID, old)
+	}
 	s.segments[segmentID] = segment
+	s.addIndexes(segmentID, segment)
+}
+
+func (s *SegmentsInfo) addIndexes(segmentID UniqueID, segment *SegmentInfo) {
+	insert := func(set typeutil.UniqueSet) typeutil.UniqueSet {
+		if set == nil {
+			set = typeutil.NewUniqueSet()
+		}
+		set.Insert(segmentID)
+		return set
+	}
+	s.channel2Segments[segment.GetInsertChannel()] = insert(s.channel2Segments[segment.GetInsertChannel()])
+	s.partition2Segments[segment.GetPartitionID()] = insert(s.partition2Segments[segment.GetPartitionID()])
+	s.state2Segments[segment.GetState()] = insert(s.state2Segments[segment.GetState()])
+}
+
+func (s *SegmentsInfo) removeIndexes(segmentID UniqueID, segment *SegmentInfo) {
+	if set, ok := s.channel2Segments[segment.GetInsertChannel()]; ok {
+		set.Remove(segmentID)
+		if set.Len() == 0 {
+			delete(s.channel2Segments, segment.GetInsertChannel())
+		}
+	}
+	if set, ok := s.partition2Segments[segment.GetPartitionID()]; ok {
+		set.Remove(segmentID)
+		if set.Len() == 0 {
+			delete(s.partition2Segments, segment.GetPartitionID())
+		}
+	}
+	if set, ok := s.state2Segments[segment.GetState()]; ok {
+		set.Remove(segmentID)
+		if set.Len() == 0 {
+			delete(s.state2Segments, segment.GetState())
+		}
+	}
 }
 
 // SetSegmentIndex sets SegmentIndex with segmentID, perform overwrite if already exists
@@ -115,7 +197,7 @@ func (s *SegmentsInfo) SetSegmentIndex(segmentID UniqueID, segIndex *model.Segme
 		segment.segmentIndexes = make(map[UniqueID]*model.SegmentIndex)
 	}
 	segment.segmentIndexes[segIndex.IndexID] = segIndex
-	s.segments[segmentID] = segment
+	s.SetSegment(segmentID, segment)
 }
 
 func (s *SegmentsInfo) DropSegmentIndex(segmentID UniqueID, indexID UniqueID) {
@@ -128,7 +210,7 @@ func (s *SegmentsInfo) DropSegmentIndex(segmentID UniqueID, indexID UniqueID) {
 // if SegmentInfo not found, do nothing
 func (s *SegmentsInfo) SetRowCount(segmentID UniqueID, rowCount int64) {
 	if segment, ok := s.segments[segmentID]; ok {
-		s.segments[segmentID] = segment.Clone(SetRowCount(rowCount))
+		s.SetSegment(segmentID, segment.Clone(SetRowCount(rowCount)))
 	}
 }
 
@@ -136,14 +218,14 @@ func (s *SegmentsInfo) SetRowCount(segmentID UniqueID, rowCount int64) {
 // if SegmentInfo not found, do nothing
 func (s *SegmentsInfo) SetState(segmentID UniqueID, state commonpb.SegmentState) {
 	if segment, ok := s.segments[segmentID]; ok {
-		s.segments[segmentID] = segment.Clone(SetState(state))
+		s.SetSegment(segmentID, segment.Clone(SetState(state)))
 	}
 }
 
 // SetIsImporting sets the import status for a segment.
 func (s *SegmentsInfo) SetIsImporting(segmentID UniqueID, isImporting bool) {
 	if segment, ok := s.segments[segmentID]; ok {
-		s.segments[segmentID] = segment.Clone(SetIsImporting(isImporting))
+		s.SetSegment(segmentID, segment.Clone(SetIsImporting(isImporting)))
 	}
 }
 
@@ -151,7 +233,7 @@ func (s *SegmentsInfo) SetIsImporting(segmentID UniqueID, isImporting bool) {
 // if SegmentInfo not found, do nothing
 func (s *SegmentsInfo) SetDmlPosition(segmentID UniqueID, pos *msgpb.MsgPosition) {
 	if segment, ok := s.segments[segmentID]; ok {
-		s.segments[segmentID] = segment.Clone(SetDmlPosition(pos))
+		s.SetSegment(segmentID, segment.Clone(SetDmlPosition(pos)))
 	}
 }
 
@@ -159,7 +241,7 @@ func (s *SegmentsInfo) SetDmlPosition(segmentID UniqueID, pos *msgpb.MsgPosition
 // if SegmentInfo not found, do nothing
 func (s *SegmentsInfo) SetStartPosition(segmentID UniqueID, pos *msgpb.MsgPosition) {
 	if segment, ok := s.segments[segmentID]; ok {
-		s.segments[segmentID] = segment.Clone(SetStartPosition(pos))
+		s.SetSegment(segmentID, segment.Clone(SetStartPosition(pos)))
 	}
 }
 
@@ -168,7 +250,7 @@ func (s *SegmentsInfo) SetStartPosition(segmentID UniqueID, pos *msgpb.MsgPositi
 // uses `ShadowClone` since internal SegmentInfo is not changed
 func (s *SegmentsInfo) SetAllocations(segmentID UniqueID, allocations []*Allocation) {
 	if segment, ok := s.segments[segmentID]; ok {
-		s.segments[segmentID] = segment.ShadowClone(SetAllocations(allocations))
+		s.SetSegment(segmentID, segment.ShadowClone(SetAllocations(allocations)))
 	}
 }
 
@@ -177,7 +259,7 @@ func (s *SegmentsInfo) SetAllocations(segmentID UniqueID, allocations []*Allocat
 // uses `Clone` since internal SegmentInfo's LastExpireTime is changed
 func (s *SegmentsInfo) AddAllocation(segmentID UniqueID, allocation *Allocation) {
 	if segment, ok := s.segments[segmentID]; ok {
-		s.segments[segmentID] = segment.Clone(AddAllocation(allocation))
+		s.SetSegment(segmentID, segment.Clone(AddAllocation(allocation)))
 	}
 }
 
@@ -186,7 +268,7 @@ func (s *SegmentsInfo) AddAllocation(segmentID UniqueID, allocation *Allocation)
 // uses `ShadowClone` since internal SegmentInfo is not changed
 func (s *SegmentsInfo) SetCurrentRows(segmentID UniqueID, rows int64) {
 	if segment, ok := s.segments[segmentID]; ok {
-		s.segments[segmentID] = segment.ShadowClone(SetCurrentRows(rows))
+		s.SetSegment(segmentID, segment.ShadowClone(SetCurrentRows(rows)))
 	}
 }
 
@@ -195,7 +277,7 @@ func (s *SegmentsInfo) SetCurrentRows(segmentID UniqueID, rows int64) {
 // uses `Clone` since internal SegmentInfo's Binlogs is changed
 func (s *SegmentsInfo) SetBinlogs(segmentID UniqueID, binlogs []*datapb.FieldBinlog) {
 	if segment, ok := s.segments[segmentID]; ok {
-		s.segments[segmentID] = segment.Clone(SetBinlogs(binlogs))
+		s.SetSegment(segmentID, segment.Clone(SetBinlogs(binlogs)))
 	}
 }
 
@@ -204,7 +286,7 @@ func (s *SegmentsInfo) SetBinlogs(segmentID UniqueID, binlogs []*datapb.FieldBin
 // uses `ShadowClone` since internal SegmentInfo is not changed
 func (s *SegmentsInfo) SetFlushTime(segmentID UniqueID, t time.Time) {
 	if segment, ok := s.segments[segmentID]; ok {
-		s.segments[segmentID] = segment.ShadowClone(SetFlushTime(t))
+		s.SetSegment(segmentID, segment.ShadowClone(SetFlushTime(t)))
 	}
 }
 
@@ -213,14 +295,14 @@ func (s *SegmentsInfo) SetFlushTime(segmentID UniqueID, t time.Time) {
 // uses `Clone` since internal SegmentInfo's Binlogs is changed
 func (s *SegmentsInfo) AddSegmentBinlogs(segmentID UniqueID, field2Binlogs map[UniqueID][]*datapb.Binlog) {
 	if segment, ok := s.segments[segmentID]; ok {
-		s.segments[segmentID] = segment.Clone(addSegmentBinlogs(field2Binlogs))
+		s.SetSegment(segmentID, segment.Clone(addSegmentBinlogs(field2Binlogs)))
 	}
 }
 
 // SetIsCompacting sets compaction status for segment
 func (s *SegmentsInfo) SetIsCompacting(segmentID UniqueID, isCompacting bool) {
 	if segment, ok := s.segments[segmentID]; ok {
-		s.segments[segmentID] = segment.ShadowClone(SetIsCompacting(isCompacting))
+		s.SetSegment(segmentID, segment.ShadowClone(SetIsCompacting(isCompacting)))
 	}
 }
 
@@ -409,5 +491,26 @@ func (s *SegmentInfo) getSegmentSize() int64 {
 	return s.size.Load()
 }
 
+// getDeletedRows returns the number of deletes applied to the segment, the deletes are routed to the segment
+// by its pk bloom filter stats and recorded as the entries of its deltalogs.
+func (s *SegmentInfo) getDeletedRows() int64 {
+	var deletedRows int64
+	for _, deltaLogs := range s.GetDeltalogs() {
+		for _, l := range deltaLogs.GetBinlogs() {
+			deletedRows += l.GetEntriesNum()
+		}
+	}
+	return deletedRows
+}
+
+// getDeletedRowRatio returns the ratio of the deleted rows to all rows of the segment, in [0, 1].
+// The deleted rows may exceed the rows of the segment since the bloom filter has false positives.
+func (s *SegmentInfo) getDeletedRowRatio() float64 {
+	if s.GetNumOfRows() <= 0 {
+		return 0
+	}
+	return math.Min(1, float64(s.getDeletedRows())/float64(s.GetNumOfRows()))
+}
+
 // SegmentInfoSelector is the function type to select SegmentInfo from meta
 type SegmentInfoSelector func(*SegmentInfo) bool
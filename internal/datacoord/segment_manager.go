@@ -30,6 +30,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/retry"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
@@ -170,6 +171,16 @@ func withChannelSealPolices(policies ...channelSealPolicy) allocOption {
 	})
 }
 
+// get allocOption with all the policies provided by the SegmentAllocPolicy
+func withSegmentAllocPolicy(policy SegmentAllocPolicy) allocOption {
+	return allocFunc(func(manager *SegmentManager) {
+		manager.estimatePolicy = policy.EstimatePolicy()
+		manager.allocPolicy = policy.AllocatePolicy()
+		manager.segmentSealPolicies = policy.SegmentSealPolicies()
+		manager.channelSealPolicies = policy.ChannelSealPolicies()
+	})
+}
+
 // get allocOption with flushPolicy
 func withFlushPolicy(policy flushPolicy) allocOption {
 	return allocFunc(func(manager *SegmentManager) { manager.flushPolicy = policy })
@@ -198,17 +209,22 @@ func defaultFlushPolicy() flushPolicy {
 
 // newSegmentManager should be the only way to retrieve SegmentManager.
 func newSegmentManager(meta *meta, allocator allocator, opts ...allocOption) (*SegmentManager, error) {
-	manager := &SegmentManager{
-		meta:                meta,
-		allocator:           allocator,
-		helper:              defaultAllocHelper(),
-		segments:            make([]UniqueID, 0),
-		estimatePolicy:      defaultCalUpperLimitPolicy(),
-		allocPolicy:         defaultAllocatePolicy(),
-		segmentSealPolicies: defaultSegmentSealPolicy(), // default only segment size policy
-		channelSealPolicies: []channelSealPolicy{},      // no default channel seal policy
-		flushPolicy:         defaultFlushPolicy(),
+	policy, err := newSegmentAllocPolicy(Params.DataCoordCfg.SegmentAllocPolicy.GetValue())
+	if err != nil {
+		return nil, err
 	}
+	log.Info("create segment manager", zap.String("allocPolicy", policy.Name()))
+	manager := &SegmentManager{
+		meta:        meta,
+		allocator:   allocator,
+		helper:      defaultAllocHelper(),
+		segments:    make([]UniqueID, 0),
+		flushPolicy: defaultFlushPolicy(),
+	}
+	// the options override the policies provided by the SegmentAllocPolicy
+	withSegmentAllocPolicy(policy).apply(manager)
+	// the time boundaries are configured per collection, which the SegmentAllocPolicy doesn't know
+	manager.segmentSealPolicies = append(manager.segmentSealPolicies, sealByTimeBoundaryPolicy(meta))
 	for _, opt := range opts {
 		opt.apply(manager)
 	}
@@ -285,6 +301,11 @@ func (s *SegmentManager) AllocSegment(ctx context.Context, collectionID UniqueID
 	}
 	newSegmentAllocations, existedSegmentAllocations := s.allocPolicy(segments,
 		requestRows, int64(maxCountPerSegment))
+	if len(newSegmentAllocations) > 0 {
+		if err := s.sealForNewSegments(collectionID, channelName, len(newSegmentAllocations)); err != nil {
+			return nil, err
+		}
+	}
 
 	// create new segments and add allocations
 	expireTs, err := s.genExpireTs(ctx, false)
@@ -314,6 +335,45 @@ func (s *SegmentManager) AllocSegment(ctx context.Context, collectionID UniqueID
 	return allocations, nil
 }
 
+// sealForNewSegments seals the oldest growing segments of the channel to open the new ones,
+// if the growing segments would exceed the limit of the channel.
+func (s *SegmentManager) sealForNewSegments(collectionID UniqueID, channelName string, newNum int) error {
+	collection := s.meta.GetCollection(collectionID)
+	if collection == nil {
+		return merr.WrapErrCollectionNotFound(collectionID)
+	}
+	limit, err := getCollectionMaxGrowingSegments(collection.Properties)
+	if err != nil {
+		return err
+	}
+	if limit <= 0 {
+		return nil
+	}
+	if newNum > limit {
+		return merr.WrapErrServiceRequestLimitExceeded(int32(limit),
+			fmt.Sprintf("%d new growing segments required on channel %s", newNum, channelName))
+	}
+
+	growings := make([]*SegmentInfo, 0)
+	for _, segmentID := range s.segments {
+		segment := s.meta.GetHealthySegment(segmentID)
+		if segment != nil && segment.GetInsertChannel() == channelName && isGrowing(segment) {
+			growings = append(growings, segment)
+		}
+	}
+	for _, segment := range getChannelOpenSegCapacityPolicy(limit-newNum)(channelName, growings, 0) {
+		if err := s.meta.SetState(segment.GetID(), commonpb.SegmentState_Sealed); err != nil {
+			return err
+		}
+		log.Info("seal growing segment to open new ones, as the channel reaches the growing segment limit",
+			zap.Int64("collectionID", collectionID),
+			zap.String("channel", channelName),
+			zap.Int64("segmentID", segment.GetID()),
+			zap.Int("limit", limit))
+	}
+	return nil
+}
+
 // allocSegmentForImport allocates one segment allocation for bulk insert.
 func (s *SegmentManager) allocSegmentForImport(ctx context.Context, collectionID UniqueID,
 	partitionID UniqueID, channelName string, requestRows int64, importTaskID int64) (*Allocation, error) {
@@ -392,6 +452,8 @@ func (s *SegmentManager) openNewSegment(ctx context.Context, collectionID Unique
 		State:          segmentState,
 		MaxRowNum:      int64(maxNumOfRows),
 		LastExpireTime: 0,
+
+		EncryptionKeyVersion: s.meta.GetEncryptionKeyVersion(collectionID),
 	}
 	if segmentState == commonpb.SegmentState_Importing {
 		segmentInfo.IsImporting = true
@@ -33,7 +33,9 @@ import (
 	mockkv "github.com/milvus-io/milvus/internal/kv/mocks"
 	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metautil"
 )
 
@@ -142,6 +144,57 @@ func TestAllocSegment(t *testing.T) {
 	})
 }
 
+func TestAllocSegmentWithMaxGrowingPerChannel(t *testing.T) {
+	ctx := context.Background()
+	Params.Init()
+	mockAllocator := newMockAllocator()
+	meta, err := newMemoryMeta()
+	assert.NoError(t, err)
+	segmentManager, _ := newSegmentManager(meta, mockAllocator)
+
+	schema := newTestSchema()
+	collID, err := mockAllocator.allocID(ctx)
+	assert.NoError(t, err)
+	meta.AddCollection(&collectionInfo{ID: collID, Schema: schema, Properties: map[string]string{
+		common.CollectionMaxGrowingSegmentsKey: "2",
+	}})
+
+	// each partition opens a growing segment on the channel
+	segmentIDs := make([]int64, 0)
+	for _, partitionID := range []int64{100, 101, 102} {
+		allocations, err := segmentManager.AllocSegment(ctx, collID, partitionID, "c1", 100)
+		assert.NoError(t, err)
+		assert.Len(t, allocations, 1)
+		segmentIDs = append(segmentIDs, allocations[0].SegmentID)
+	}
+	// the oldest growing segment is sealed before opening the third one
+	assert.Equal(t, commonpb.SegmentState_Sealed, meta.GetSegment(segmentIDs[0]).GetState())
+	assert.Equal(t, commonpb.SegmentState_Growing, meta.GetSegment(segmentIDs[1]).GetState())
+	assert.Equal(t, commonpb.SegmentState_Growing, meta.GetSegment(segmentIDs[2]).GetState())
+
+	// allocating on the existing growing segment seals nothing
+	allocations, err := segmentManager.AllocSegment(ctx, collID, 101, "c1", 100)
+	assert.NoError(t, err)
+	assert.Equal(t, segmentIDs[1], allocations[0].SegmentID)
+	assert.Equal(t, commonpb.SegmentState_Growing, meta.GetSegment(segmentIDs[2]).GetState())
+
+	// the other channels are limited separately
+	_, err = segmentManager.AllocSegment(ctx, collID, 100, "c2", 100)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.SegmentState_Growing, meta.GetSegment(segmentIDs[2]).GetState())
+
+	// the global limit applies to the collection without the property
+	Params.Save(Params.DataCoordCfg.SegmentMaxGrowingPerChannel.Key, "1")
+	defer Params.Reset(Params.DataCoordCfg.SegmentMaxGrowingPerChannel.Key)
+	collID2, err := mockAllocator.allocID(ctx)
+	assert.NoError(t, err)
+	meta.AddCollection(&collectionInfo{ID: collID2, Schema: schema})
+	maxRows, err := segmentManager.estimateMaxNumOfRows(collID2)
+	assert.NoError(t, err)
+	_, err = segmentManager.AllocSegment(ctx, collID2, 100, "c3", int64(maxRows)*2)
+	assert.ErrorIs(t, err, merr.ErrServiceRequestLimitExceeded)
+}
+
 func TestLastExpireReset(t *testing.T) {
 	//set up meta on dc
 	ctx := context.Background()
@@ -811,24 +864,22 @@ func TestSegmentManager_DropSegmentsOfChannel(t *testing.T) {
 			"test drop segments",
 			fields{
 				meta: &meta{
-					segments: &SegmentsInfo{
-						segments: map[int64]*SegmentInfo{
-							1: {
-								SegmentInfo: &datapb.SegmentInfo{
-									ID:            1,
-									InsertChannel: "ch1",
-									State:         commonpb.SegmentState_Flushed,
-								},
+					segments: buildSegmentsInfo(map[int64]*SegmentInfo{
+						1: {
+							SegmentInfo: &datapb.SegmentInfo{
+								ID:            1,
+								InsertChannel: "ch1",
+								State:         commonpb.SegmentState_Flushed,
 							},
-							2: {
-								SegmentInfo: &datapb.SegmentInfo{
-									ID:            2,
-									InsertChannel: "ch2",
-									State:         commonpb.SegmentState_Flushed,
-								},
+						},
+						2: {
+							SegmentInfo: &datapb.SegmentInfo{
+								ID:            2,
+								InsertChannel: "ch2",
+								State:         commonpb.SegmentState_Flushed,
 							},
 						},
-					},
+					}),
 				},
 				segments: []UniqueID{1, 2},
 			},
@@ -841,24 +892,22 @@ func TestSegmentManager_DropSegmentsOfChannel(t *testing.T) {
 			"test drop segments with dropped segment",
 			fields{
 				meta: &meta{
-					segments: &SegmentsInfo{
-						segments: map[int64]*SegmentInfo{
-							1: {
-								SegmentInfo: &datapb.SegmentInfo{
-									ID:            1,
-									InsertChannel: "ch1",
-									State:         commonpb.SegmentState_Dropped,
-								},
+					segments: buildSegmentsInfo(map[int64]*SegmentInfo{
+						1: {
+							SegmentInfo: &datapb.SegmentInfo{
+								ID:            1,
+								InsertChannel: "ch1",
+								State:         commonpb.SegmentState_Dropped,
 							},
-							2: {
-								SegmentInfo: &datapb.SegmentInfo{
-									ID:            2,
-									InsertChannel: "ch2",
-									State:         commonpb.SegmentState_Growing,
-								},
+						},
+						2: {
+							SegmentInfo: &datapb.SegmentInfo{
+								ID:            2,
+								InsertChannel: "ch2",
+								State:         commonpb.SegmentState_Growing,
 							},
 						},
-					},
+					}),
 				},
 				segments: []UniqueID{1, 2, 3},
 			},
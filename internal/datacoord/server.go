@@ -30,10 +30,12 @@ import (
 	"github.com/cockroachdb/errors"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/zap"
+	"stathat.com/c/consistent"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	datanodeclient "github.com/milvus-io/milvus/internal/distributed/datanode/client"
 	indexnodeclient "github.com/milvus-io/milvus/internal/distributed/indexnode/client"
+	querycoordclient "github.com/milvus-io/milvus/internal/distributed/querycoord/client"
 	rootcoordclient "github.com/milvus-io/milvus/internal/distributed/rootcoord/client"
 	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
@@ -41,7 +43,9 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/configutil"
 	"github.com/milvus-io/milvus/internal/util/dependency"
+	"github.com/milvus-io/milvus/internal/util/metastoreutil"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
@@ -85,6 +89,8 @@ type indexNodeCreatorFunc func(ctx context.Context, addr string) (types.IndexNod
 
 type rootCoordCreatorFunc func(ctx context.Context, metaRootPath string, etcdClient *clientv3.Client) (types.RootCoord, error)
 
+type queryCoordCreatorFunc func(ctx context.Context, metaRootPath string, etcdClient *clientv3.Client) (types.QueryCoord, error)
+
 // makes sure Server implements `DataCoord`
 var _ types.DataCoord = (*Server)(nil)
 
@@ -104,6 +110,7 @@ type Server struct {
 	etcdCli          *clientv3.Client
 	address          string
 	kvClient         kv.WatchKV
+	watchEtcdCli     *clientv3.Client // dedicated client of the channel watches, nil if disabled
 	meta             *meta
 	segmentManager   Manager
 	allocator        allocator
@@ -111,10 +118,18 @@ type Server struct {
 	sessionManager   *SessionManager
 	channelManager   *ChannelManager
 	rootCoordClient  types.RootCoord
+	queryCoordClient types.QueryCoord // notified of the committed compactions, nil if disabled
 	garbageCollector *garbageCollector
 	gcOpt            GcOption
 	handler          Handler
 
+	nodeConfigManager *configutil.NodeConfigManager
+
+	maintenanceManager *maintenanceManager
+	auditLog           *auditLog
+	storageReconciler  *storageReconciler
+	cloneJobs          *cloneJobManager
+
 	compactionTrigger trigger
 	compactionHandler compactionPlanContext
 
@@ -133,10 +148,12 @@ type Server struct {
 
 	enableActiveStandBy bool
 	activateFunc        func() error
+	hotStandby          *hotStandby
 
 	dataNodeCreator        dataNodeCreatorFunc
 	indexNodeCreator       indexNodeCreatorFunc
 	rootCoordClientCreator rootCoordCreatorFunc
+	queryCoordCreator      queryCoordCreatorFunc
 	//indexCoord             types.IndexCoord
 
 	//segReferManager  *SegmentReferenceManager
@@ -145,6 +162,9 @@ type Server struct {
 
 	// manage ways that data coord access other coord
 	broker Broker
+
+	// metaStoreMonitor switches datacoord into read only mode when the meta store is degraded
+	metaStoreMonitor *metastoreutil.HealthMonitor
 }
 
 // ServerHelper datacoord server injection helper
@@ -168,6 +188,13 @@ func WithRootCoordCreator(creator rootCoordCreatorFunc) Option {
 	}
 }
 
+// WithQueryCoordCreator returns an `Option` setting QueryCoord creator with provided parameter
+func WithQueryCoordCreator(creator queryCoordCreatorFunc) Option {
+	return func(svr *Server) {
+		svr.queryCoordCreator = creator
+	}
+}
+
 // WithServerHelper returns an `Option` setting ServerHelp with provided parameter
 func WithServerHelper(helper ServerHelper) Option {
 	return func(svr *Server) {
@@ -209,6 +236,7 @@ func CreateServer(ctx context.Context, factory dependency.Factory, opts ...Optio
 		dataNodeCreator:        defaultDataNodeCreatorFunc,
 		indexNodeCreator:       defaultIndexNodeCreatorFunc,
 		rootCoordClientCreator: defaultRootCoordCreatorFunc,
+		queryCoordCreator:      defaultQueryCoordCreatorFunc,
 		helper:                 defaultServerHelper(),
 		metricsCacheManager:    metricsinfo.NewMetricsCacheManager(),
 		enableActiveStandBy:    Params.DataCoordCfg.EnableActiveStandby.GetAsBool(),
@@ -232,6 +260,10 @@ func defaultRootCoordCreatorFunc(ctx context.Context, metaRootPath string, clien
 	return rootcoordclient.NewClient(ctx, metaRootPath, client)
 }
 
+func defaultQueryCoordCreatorFunc(ctx context.Context, metaRootPath string, client *clientv3.Client) (types.QueryCoord, error) {
+	return querycoordclient.NewClient(ctx, metaRootPath, client)
+}
+
 // QuitSignal returns signal when server quits
 func (s *Server) QuitSignal() <-chan struct{} {
 	return s.quitCh
@@ -299,6 +331,12 @@ func (s *Server) Init() error {
 	if s.enableActiveStandBy {
 		s.activateFunc = func() error {
 			log.Info("DataCoord switch from standby to active, activating")
+			if s.hotStandby != nil {
+				if err := s.hotStandby.activate(); err != nil {
+					log.Error("DataCoord hot standby failed to catch up", zap.Error(err))
+					return err
+				}
+			}
 			if err := s.initDataCoord(); err != nil {
 				log.Error("DataCoord init failed", zap.Error(err))
 				return err
@@ -307,6 +345,13 @@ func (s *Server) Init() error {
 			log.Info("DataCoord startup success")
 			return nil
 		}
+		if Params.DataCoordCfg.EnableHotStandby.GetAsBool() {
+			s.hotStandby = newHotStandby(s)
+			if err := s.hotStandby.warmUp(); err != nil {
+				log.Error("DataCoord hot standby failed to warm up", zap.Error(err))
+				return err
+			}
+		}
 		s.stateCode.Store(commonpb.StateCode_StandBy)
 		log.Info("DataCoord enter standby mode successfully")
 		return nil
@@ -324,6 +369,10 @@ func (s *Server) initDataCoord() error {
 
 	s.broker = NewCoordinatorBroker(s.rootCoordClient)
 
+	if err = s.initQueryCoordClient(); err != nil {
+		return err
+	}
+
 	storageCli, err := s.newChunkManagerFactory()
 	if err != nil {
 		return err
@@ -333,6 +382,14 @@ func (s *Server) initDataCoord() error {
 		return err
 	}
 
+	if err = s.initCloneJobManager(); err != nil {
+		return err
+	}
+
+	if err = s.initAuditLog(); err != nil {
+		return err
+	}
+
 	s.handler = newServerHandler(s)
 
 	if err = s.initCluster(); err != nil {
@@ -350,8 +407,9 @@ func (s *Server) initDataCoord() error {
 		return err
 	}
 
+	// the compaction handler also executes the conversion of the external segments, which isn't optional.
+	s.createCompactionHandler()
 	if Params.DataCoordCfg.EnableCompaction.GetAsBool() {
-		s.createCompactionHandler()
 		s.createCompactionTrigger()
 	}
 
@@ -360,7 +418,9 @@ func (s *Server) initDataCoord() error {
 	}
 
 	s.initGarbageCollection(storageCli)
+	s.storageReconciler = newStorageReconciler(s.meta, storageCli)
 	s.initIndexBuilder(storageCli)
+	s.metaStoreMonitor = metastoreutil.NewHealthMonitor(s.etcdCli, typeutil.DataCoordRole)
 
 	s.serverLoopCtx, s.serverLoopCancel = context.WithCancel(s.ctx)
 
@@ -384,11 +444,12 @@ func (s *Server) Start() error {
 }
 
 func (s *Server) startDataCoord() {
+	s.compactionHandler.start()
 	if Params.DataCoordCfg.EnableCompaction.GetAsBool() {
-		s.compactionHandler.start()
 		s.compactionTrigger.start()
 	}
 	s.startServerLoop()
+	s.metaStoreMonitor.Start()
 	// DataCoord (re)starts successfully and starts to collection segment stats
 	// data from all DataNode.
 	// This will prevent DataCoord from missing out any important segment stats
@@ -404,12 +465,35 @@ func (s *Server) initCluster() error {
 	}
 
 	var err error
-	s.channelManager, err = NewChannelManager(s.kvClient, s.handler, withMsgstreamFactory(s.factory),
-		withStateChecker(), withBgChecker())
+	s.sessionManager = NewSessionManager(withSessionCreator(s.dataNodeCreator))
+	opts := []ChannelManagerOpt{withMsgstreamFactory(s.factory), withStateChecker(), withBgChecker()}
+	if zoneLabel := Params.DataCoordCfg.ChannelZoneLabel.GetValue(); zoneLabel != "" {
+		log.Info("DataCoord spreads channels across failure domains", zap.String("zoneLabel", zoneLabel))
+		opts = append(opts, withFactory(NewZoneAwareChannelPolicyFactory(func(nodeID int64) string {
+			return s.sessionManager.GetNodeLabel(nodeID, zoneLabel)
+		})))
+	} else if Params.DataCoordCfg.ChannelCapacityWeighted.GetAsBool() {
+		log.Info("DataCoord assigns channels by consistent hashing weighted by DataNode capacity")
+		opts = append(opts, withFactory(NewWeightedConsistentHashChannelPolicyFactory(consistent.New(), s.sessionManager.GetNodeCapacity)))
+	}
+	if s.auditLog != nil {
+		opts = append(opts, withAuditLog(s.auditLog))
+	}
+	if s.meta != nil {
+		opts = append(opts, withCatalog(s.meta.catalog))
+	}
+	// the channel watches go through the dedicated client if enabled, so their bursts never starve the session
+	watchKV := s.kvClient
+	if s.watchEtcdCli, err = etcdkv.NewWatchClient(&Params.EtcdCfg); err != nil {
+		return err
+	}
+	if s.watchEtcdCli != nil {
+		watchKV = etcdkv.NewEtcdKV(s.watchEtcdCli, Params.EtcdCfg.MetaRootPath.GetValue())
+	}
+	s.channelManager, err = NewChannelManager(watchKV, s.handler, opts...)
 	if err != nil {
 		return err
 	}
-	s.sessionManager = NewSessionManager(withSessionCreator(s.dataNodeCreator))
 	s.cluster = NewCluster(s.sessionManager, s.channelManager)
 	return nil
 }
@@ -436,7 +520,10 @@ func (s *Server) SetIndexNodeCreator(f func(context.Context, string) (types.Inde
 }
 
 func (s *Server) createCompactionHandler() {
-	s.compactionHandler = newCompactionPlanHandler(s.sessionManager, s.channelManager, s.meta, s.allocator, s.flushCh)
+	handler := newCompactionPlanHandler(s.sessionManager, s.channelManager, s.meta, s.allocator, s.flushCh)
+	handler.auditLog = s.auditLog
+	handler.queryCoord = s.queryCoordClient
+	s.compactionHandler = handler
 }
 
 func (s *Server) stopCompactionHandler() {
@@ -444,7 +531,7 @@ func (s *Server) stopCompactionHandler() {
 }
 
 func (s *Server) createCompactionTrigger() {
-	s.compactionTrigger = newCompactionTrigger(s.meta, s.compactionHandler, s.allocator, s.handler)
+	s.compactionTrigger = newCompactionTrigger(s.meta, s.compactionHandler, s.allocator, s.handler, s.maintenanceManager)
 }
 
 func (s *Server) stopCompactionTrigger() {
@@ -468,6 +555,9 @@ func (s *Server) initGarbageCollection(cli storage.ChunkManager) {
 		checkInterval:    Params.DataCoordCfg.GCInterval.GetAsDuration(time.Second),
 		missingTolerance: Params.DataCoordCfg.GCMissingTolerance.GetAsDuration(time.Second),
 		dropTolerance:    Params.DataCoordCfg.GCDropTolerance.GetAsDuration(time.Second),
+		maintenance:      s.maintenanceManager,
+		audit:            s.auditLog,
+		broker:           s.broker,
 	})
 }
 
@@ -485,6 +575,11 @@ func (s *Server) initServiceDiscovery() error {
 		info := &NodeInfo{
 			NodeID:  session.ServerID,
 			Address: session.Address,
+			Labels:  session.ServerLabels,
+			Capacity: NodeCapacity{
+				CPUNum:     session.CPUNum,
+				MemorySize: session.MemorySize,
+			},
 		}
 		datanodes = append(datanodes, info)
 	}
@@ -537,6 +632,8 @@ func (s *Server) initMeta(chunkManager storage.ChunkManager) error {
 	etcdKV := etcdkv.NewEtcdKV(s.etcdCli, Params.EtcdCfg.MetaRootPath.GetValue())
 
 	s.kvClient = etcdKV
+	// node config overrides live along with the cluster wide configs under the root path
+	s.nodeConfigManager = configutil.NewNodeConfigManager(etcdkv.NewEtcdKV(s.etcdCli, Params.EtcdCfg.RootPath.GetValue()))
 	reloadEtcdFn := func() error {
 		var err error
 		catalog := datacoord.NewCatalog(etcdKV, chunkManager.RootPath(), Params.EtcdCfg.MetaRootPath.GetValue())
@@ -544,11 +641,33 @@ func (s *Server) initMeta(chunkManager storage.ChunkManager) error {
 		if err != nil {
 			return err
 		}
+		s.maintenanceManager, err = newMaintenanceManager(s.ctx, catalog)
+		if err != nil {
+			return err
+		}
 		return nil
 	}
 	return retry.Do(s.ctx, reloadEtcdFn, retry.Attempts(connEtcdMaxRetryTime))
 }
 
+func (s *Server) initCloneJobManager() error {
+	if s.cloneJobs != nil {
+		return nil
+	}
+	var err error
+	s.cloneJobs, err = newCloneJobManager(s.ctx, s.meta.catalog)
+	return err
+}
+
+func (s *Server) initAuditLog() error {
+	if s.auditLog != nil || s.kvClient == nil {
+		return nil
+	}
+	var err error
+	s.auditLog, err = newAuditLog(s.kvClient)
+	return err
+}
+
 func (s *Server) initIndexBuilder(manager storage.ChunkManager) {
 	if s.indexBuilder == nil {
 		s.indexBuilder = newIndexBuilder(s.ctx, s.meta, s.indexNodeManager, manager)
@@ -568,8 +687,15 @@ func (s *Server) startServerLoop() {
 		s.startDataNodeTtLoop(s.serverLoopCtx)
 	}
 	s.startWatchService(s.serverLoopCtx)
+	s.startDataNodeProbeLoop(s.serverLoopCtx)
 	s.startFlushLoop(s.serverLoopCtx)
 	s.startIndexService(s.serverLoopCtx)
+	s.startReplicationLoop(s.serverLoopCtx)
+	s.startLevelZeroCompactionLoop(s.serverLoopCtx)
+	s.startExternalConversionLoop(s.serverLoopCtx)
+	s.startRetentionLoop(s.serverLoopCtx)
+	s.startStorageReconcileLoop(s.serverLoopCtx)
+	s.resumeCloneJobs(s.serverLoopCtx)
 	s.garbageCollector.start()
 }
 
@@ -669,6 +795,7 @@ func (s *Server) handleTimetickMessage(ctx context.Context, ttMsg *msgstream.Dat
 		Set(float64(sub))
 
 	s.updateSegmentStatistics(ttMsg.GetSegmentsStats())
+	s.updateChannelMetrics(ch, ts)
 
 	if err := s.segmentManager.ExpireAllocations(ch, ts); err != nil {
 		return fmt.Errorf("expire allocations: %w", err)
@@ -695,6 +822,11 @@ func (s *Server) handleTimetickMessage(ctx context.Context, ttMsg *msgstream.Dat
 		finfo = append(finfo, info.SegmentInfo)
 	}
 	err = s.cluster.Flush(s.ctx, ttMsg.GetBase().GetSourceID(), ch, finfo)
+	if errors.Is(err, merr.ErrServiceRequestLimitExceeded) {
+		// the segments are still flushable, they are retried after the flush interval
+		log.Info("flush is throttled", zap.String("channel", ch), zap.Error(err))
+		return nil
+	}
 	if err != nil {
 		log.Warn("failed to handle flush", zap.Any("source", ttMsg.GetBase().GetSourceID()), zap.Error(err))
 		return err
@@ -703,6 +835,13 @@ func (s *Server) handleTimetickMessage(ctx context.Context, ttMsg *msgstream.Dat
 	return nil
 }
 
+// updateChannelMetrics updates the consume lag and the unflushed rows of the vchannel,
+// the pchannel head is taken as now since the timetick keeps it up to date.
+func (s *Server) updateChannelMetrics(ch string, ts Timestamp) {
+	metrics.DataCoordChannelConsumeLag.WithLabelValues(ch).Set(float64(tsoutil.SubByNow(ts)))
+	metrics.DataCoordChannelUnflushedRows.WithLabelValues(ch).Set(float64(s.meta.GetUnflushedRowsOfChannel(ch)))
+}
+
 func (s *Server) updateSegmentStatistics(stats []*commonpb.SegmentStats) {
 	for _, stat := range stats {
 		segment := s.meta.GetSegment(stat.GetSegmentID())
@@ -767,6 +906,52 @@ func (s *Server) stopServiceWatch() {
 	}
 }
 
+// startDataNodeProbeLoop probes the liveness of the DataNodes periodically, the unreachable DataNodes are evicted
+// and their channels are reassigned, without waiting for their etcd sessions to expire.
+func (s *Server) startDataNodeProbeLoop(ctx context.Context) {
+	interval := Params.DataCoordCfg.SessionProbeInterval.GetAsDuration(time.Second)
+	if interval <= 0 {
+		log.Info("datanode liveness probing is disabled")
+		return
+	}
+	s.serverLoopWg.Add(1)
+	go func() {
+		defer logutil.LogPanic()
+		defer s.serverLoopWg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info("datanode probe loop shutdown")
+				return
+			case <-ticker.C:
+				for _, node := range s.sessionManager.ProbeSessions(ctx) {
+					s.evictDataNode(node)
+				}
+			}
+		}
+	}()
+}
+
+// evictDataNode removes the unreachable DataNode from the cluster, its channels are handed off to other nodes.
+// The node rejoins once it registers its session again.
+func (s *Server) evictDataNode(node *NodeInfo) {
+	log.Warn("evict unreachable datanode",
+		zap.Int64("nodeID", node.NodeID),
+		zap.String("address", node.Address))
+	if err := s.cluster.UnRegister(node); err != nil {
+		log.Warn("failed to evict datanode", zap.Int64("nodeID", node.NodeID), zap.Error(err))
+		return
+	}
+	s.auditLog.Record(&datapb.AuditEvent{
+		Type:   datapb.AuditEventType_NodeUnregistered,
+		NodeID: node.NodeID,
+		Detail: "evicted for unreachable, address: " + node.Address,
+	})
+	s.metricsCacheManager.InvalidateSystemInfoMetrics()
+}
+
 // watchService watches services.
 func (s *Server) watchService(ctx context.Context) {
 	defer logutil.LogPanic()
@@ -821,6 +1006,11 @@ func (s *Server) handleSessionEvent(ctx context.Context, role string, event *ses
 		node := &NodeInfo{
 			NodeID:  event.Session.ServerID,
 			Address: event.Session.Address,
+			Labels:  event.Session.ServerLabels,
+			Capacity: NodeCapacity{
+				CPUNum:     event.Session.CPUNum,
+				MemorySize: event.Session.MemorySize,
+			},
 		}
 		switch event.EventType {
 		case sessionutil.SessionAddEvent:
@@ -831,6 +1021,11 @@ func (s *Server) handleSessionEvent(ctx context.Context, role string, event *ses
 				log.Warn("failed to register node", zap.Int64("id", node.NodeID), zap.String("address", node.Address), zap.Error(err))
 				return err
 			}
+			s.auditLog.Record(&datapb.AuditEvent{
+				Type:   datapb.AuditEventType_NodeRegistered,
+				NodeID: node.NodeID,
+				Detail: "address: " + node.Address,
+			})
 			s.metricsCacheManager.InvalidateSystemInfoMetrics()
 		case sessionutil.SessionDelEvent:
 			log.Info("received datanode unregister",
@@ -840,6 +1035,11 @@ func (s *Server) handleSessionEvent(ctx context.Context, role string, event *ses
 				log.Warn("failed to deregister node", zap.Int64("id", node.NodeID), zap.String("address", node.Address), zap.Error(err))
 				return err
 			}
+			s.auditLog.Record(&datapb.AuditEvent{
+				Type:   datapb.AuditEventType_NodeUnregistered,
+				NodeID: node.NodeID,
+				Detail: "address: " + node.Address,
+			})
 			s.metricsCacheManager.InvalidateSystemInfoMetrics()
 		default:
 			log.Warn("receive unknown service event type",
@@ -960,12 +1160,33 @@ func (s *Server) initRootCoordClient() error {
 	return s.rootCoordClient.Start()
 }
 
+// initQueryCoordClient creates the client to notify QueryCoord of the committed compactions,
+// QueryCoord depends on DataCoord, so the client resolves the address of QueryCoord lazily on each call.
+func (s *Server) initQueryCoordClient() error {
+	if !Params.DataCoordCfg.CompactionNotifyQueryCoord.GetAsBool() {
+		return nil
+	}
+	var err error
+	if s.queryCoordClient == nil {
+		if s.queryCoordClient, err = s.queryCoordCreator(s.ctx, Params.EtcdCfg.MetaRootPath.GetValue(), s.etcdCli); err != nil {
+			return err
+		}
+	}
+	if err = s.queryCoordClient.Init(); err != nil {
+		return err
+	}
+	return s.queryCoordClient.Start()
+}
+
 // Stop do the Server finalize processes
 // it checks the server status is healthy, if not, just quit
 // if Server is healthy, set server state to stopped, release etcd session,
 //
 //	stop message stream client and stop server loops
 func (s *Server) Stop() error {
+	if s.hotStandby != nil {
+		s.hotStandby.stop()
+	}
 	if !s.stateCode.CompareAndSwap(commonpb.StateCode_Healthy, commonpb.StateCode_Abnormal) {
 		return nil
 	}
@@ -976,13 +1197,22 @@ func (s *Server) Stop() error {
 
 	if Params.DataCoordCfg.EnableCompaction.GetAsBool() {
 		s.stopCompactionTrigger()
-		s.stopCompactionHandler()
 	}
+	s.stopCompactionHandler()
 	s.indexBuilder.Stop()
+	if s.queryCoordClient != nil {
+		s.queryCoordClient.Stop()
+	}
+	if s.metaStoreMonitor != nil {
+		s.metaStoreMonitor.Stop()
+	}
 
 	if s.session != nil {
 		s.session.Stop()
 	}
+	if s.watchEtcdCli != nil {
+		s.watchEtcdCli.Close()
+	}
 
 	return nil
 }
@@ -1052,10 +1282,15 @@ func (s *Server) reCollectSegmentStats(ctx context.Context) {
 		zap.Int64s("DataNode IDs", nodes))
 
 	reCollectFunc := func() error {
-		err := s.cluster.ReCollectSegmentStats(ctx)
+		results, err := s.cluster.ReCollectSegmentStats(ctx, 0, "")
 		if err != nil {
 			return err
 		}
+		for _, result := range results {
+			if !result.GetSuccess() {
+				return fmt.Errorf("failed to re-collect segment stats from DataNode %d: %s", result.GetNodeID(), result.GetReason())
+			}
+		}
 		return nil
 	}
 
@@ -31,6 +31,7 @@ import (
 	"time"
 
 	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -706,6 +707,78 @@ func TestGetSegmentInfo(t *testing.T) {
 		assert.Equal(t, mockPChannel, resp.ChannelCheckpoint[mockVChannel].ChannelName)
 		assert.Equal(t, Timestamp(1000), resp.ChannelCheckpoint[mockVChannel].Timestamp)
 	})
+
+	t.Run("list collection segments", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		states := []commonpb.SegmentState{
+			commonpb.SegmentState_Flushed,
+			commonpb.SegmentState_Growing,
+			commonpb.SegmentState_Flushed,
+			commonpb.SegmentState_Dropped,
+			commonpb.SegmentState_Flushed,
+			commonpb.SegmentState_Sealed,
+		}
+		for i, state := range states {
+			err := svr.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+				ID:           int64(10 - i),
+				CollectionID: 1,
+				State:        state,
+				NumOfRows:    100,
+				Binlogs:      []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []*datapb.Binlog{{EntriesNum: 100}}}},
+			}))
+			assert.NoError(t, err)
+		}
+		err := svr.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{ID: 11, CollectionID: 2, State: commonpb.SegmentState_Flushed}))
+		assert.NoError(t, err)
+
+		segmentIDs := func(infos []*datapb.SegmentInfo) []int64 {
+			return lo.Map(infos, func(info *datapb.SegmentInfo, _ int) int64 { return info.GetID() })
+		}
+
+		resp, err := svr.GetSegmentInfo(svr.ctx, &datapb.GetSegmentInfoRequest{CollectionID: 1})
+		assert.NoError(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, []int64{5, 6, 8, 9, 10}, segmentIDs(resp.GetInfos()))
+		assert.False(t, resp.GetHasMore())
+
+		req := &datapb.GetSegmentInfoRequest{
+			CollectionID: 1,
+			States:       []commonpb.SegmentState{commonpb.SegmentState_Flushed, commonpb.SegmentState_Sealed},
+			Limit:        2,
+			OutputFields: []string{"ID", "num_of_rows", "state"},
+		}
+		resp, err = svr.GetSegmentInfo(svr.ctx, req)
+		assert.NoError(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, []int64{5, 6}, segmentIDs(resp.GetInfos()))
+		assert.True(t, resp.GetHasMore())
+		for _, info := range resp.GetInfos() {
+			assert.EqualValues(t, 100, info.GetNumOfRows())
+			assert.Zero(t, info.GetCollectionID())
+			assert.Empty(t, info.GetBinlogs())
+		}
+
+		req.AfterSegmentID = 6
+		resp, err = svr.GetSegmentInfo(svr.ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{8, 10}, segmentIDs(resp.GetInfos()))
+		assert.False(t, resp.GetHasMore())
+
+		// the states filter applies to the given segments too
+		resp, err = svr.GetSegmentInfo(svr.ctx, &datapb.GetSegmentInfoRequest{
+			SegmentIDs: []int64{9, 10},
+			States:     []commonpb.SegmentState{commonpb.SegmentState_Flushed},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{10}, segmentIDs(resp.GetInfos()))
+
+		req.OutputFields = []string{"unknown"}
+		resp, err = svr.GetSegmentInfo(svr.ctx, req)
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrParameterInvalid)
+	})
 }
 
 func TestGetComponentStates(t *testing.T) {
@@ -1536,6 +1609,79 @@ func TestSaveBinlogPaths(t *testing.T) {
 		assert.EqualValues(t, resp.ErrorCode, commonpb.ErrorCode_SegmentNotFound)
 	})
 
+	t.Run("SaveL0Segment", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		svr.meta.AddCollection(&collectionInfo{
+			ID: 0,
+		})
+
+		err := svr.channelManager.AddNode(0)
+		assert.NoError(t, err)
+		err = svr.channelManager.Watch(&channel{Name: "ch1", CollectionID: 0})
+		assert.NoError(t, err)
+
+		ctx := context.Background()
+		req := &datapb.SaveBinlogPathsRequest{
+			Base: &commonpb.MsgBase{
+				Timestamp: uint64(time.Now().Unix()),
+			},
+			SegmentID:    1,
+			CollectionID: 0,
+			PartitionID:  1,
+			Channel:      "ch1",
+			SegLevel:     datapb.SegmentLevel_L0,
+			Deltalogs: []*datapb.FieldBinlog{
+				{
+					Binlogs: []*datapb.Binlog{
+						{
+							LogPath:     "/by-dev/test/0/1/1/1/Allo1",
+							EntriesNum:  5,
+							TimestampTo: 100,
+						},
+					},
+				},
+			},
+			CheckPoints: []*datapb.CheckPoint{
+				{
+					SegmentID: 1,
+					Position: &msgpb.MsgPosition{
+						ChannelName: "ch1",
+						MsgID:       []byte{1, 2, 3},
+						Timestamp:   100,
+					},
+				},
+			},
+			Flushed: true,
+		}
+		resp, err := svr.SaveBinlogPaths(ctx, req)
+		assert.NoError(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		segment := svr.meta.GetSegment(1)
+		assert.NotNil(t, segment)
+		assert.Equal(t, datapb.SegmentLevel_L0, segment.GetLevel())
+		assert.Equal(t, commonpb.SegmentState_Flushed, segment.GetState())
+		assert.EqualValues(t, 1, segment.GetPartitionID())
+		assert.EqualValues(t, 100, segment.GetDmlPosition().GetTimestamp())
+		assert.Equal(t, 1, len(segment.GetDeltalogs()))
+
+		// saved only once
+		resp, err = svr.SaveBinlogPaths(ctx, req)
+		assert.NoError(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+		assert.Equal(t, 1, len(svr.meta.GetSegment(1).GetDeltalogs()))
+
+		// the dropped L0 segment isn't added
+		req.SegmentID = 2
+		req.Dropped = true
+		resp, err = svr.SaveBinlogPaths(ctx, req)
+		assert.NoError(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+		assert.Nil(t, svr.meta.GetSegment(2))
+	})
+
 	t.Run("with channel not matched", func(t *testing.T) {
 		svr := newTestServer(t, nil)
 		defer closeTestServer(t, svr)
@@ -3129,6 +3275,166 @@ func TestManualCompaction(t *testing.T) {
 	})
 }
 
+func TestManualCompactionWithMode(t *testing.T) {
+	paramtable.Get().Save(Params.DataCoordCfg.EnableCompaction.Key, "true")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.EnableCompaction.Key)
+	t.Run("test manual compaction with mode successfully", func(t *testing.T) {
+		svr := &Server{allocator: &MockAllocator{}}
+		svr.stateCode.Store(commonpb.StateCode_Healthy)
+		var triggeredMode datapb.CompactionMode
+		svr.compactionTrigger = &mockCompactionTrigger{
+			methods: map[string]interface{}{
+				"forceTriggerCompactionWithMode": func(collectionID int64, mode datapb.CompactionMode) (UniqueID, error) {
+					triggeredMode = mode
+					return 1, nil
+				},
+			},
+		}
+
+		resp, err := svr.ManualCompactionWithMode(context.TODO(), &datapb.ManualCompactionWithModeRequest{
+			CollectionID: 1,
+			Mode:         datapb.CompactionMode_PurgeDeletes,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.EqualValues(t, 1, resp.GetCompactionID())
+		assert.Equal(t, datapb.CompactionMode_PurgeDeletes, triggeredMode)
+	})
+
+	t.Run("test manual compaction with invalid mode", func(t *testing.T) {
+		svr := &Server{allocator: &MockAllocator{}}
+		svr.stateCode.Store(commonpb.StateCode_Healthy)
+
+		resp, err := svr.ManualCompactionWithMode(context.TODO(), &datapb.ManualCompactionWithModeRequest{
+			CollectionID: 1,
+			Mode:         datapb.CompactionMode(100),
+		})
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(resp.GetStatus()))
+	})
+
+	t.Run("test manual compaction with mode failure", func(t *testing.T) {
+		svr := &Server{allocator: &MockAllocator{}}
+		svr.stateCode.Store(commonpb.StateCode_Healthy)
+		svr.compactionTrigger = &mockCompactionTrigger{
+			methods: map[string]interface{}{
+				"forceTriggerCompactionWithMode": func(collectionID int64, mode datapb.CompactionMode) (UniqueID, error) {
+					return 0, errors.New("mock error")
+				},
+			},
+		}
+
+		resp, err := svr.ManualCompactionWithMode(context.TODO(), &datapb.ManualCompactionWithModeRequest{
+			CollectionID: 1,
+			Mode:         datapb.CompactionMode_MergeSmallSegments,
+		})
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(resp.GetStatus()))
+	})
+
+	t.Run("test manual compaction with mode on closed server", func(t *testing.T) {
+		svr := &Server{}
+		svr.stateCode.Store(commonpb.StateCode_Abnormal)
+
+		resp, err := svr.ManualCompactionWithMode(context.TODO(), &datapb.ManualCompactionWithModeRequest{
+			CollectionID: 1,
+		})
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(resp.GetStatus()))
+	})
+}
+
+func TestGetCompactionProgress(t *testing.T) {
+	paramtable.Get().Save(Params.DataCoordCfg.EnableCompaction.Key, "true")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.EnableCompaction.Key)
+	t.Run("test get compaction progress successfully", func(t *testing.T) {
+		svr := &Server{}
+		svr.stateCode.Store(commonpb.StateCode_Healthy)
+		svr.compactionHandler = &mockCompactionHandler{
+			methods: map[string]interface{}{
+				"getCompactionTasksBySignalID": func(signalID int64) []*compactionTask {
+					return []*compactionTask{
+						{state: executing},
+						{state: completed},
+						{state: completed},
+						{state: cancelled},
+					}
+				},
+			},
+		}
+
+		resp, err := svr.GetCompactionProgress(context.TODO(), &datapb.GetCompactionProgressRequest{CompactionID: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, commonpb.CompactionState_Executing, resp.GetState())
+		assert.EqualValues(t, 4, resp.GetTotalPlanNo())
+		assert.EqualValues(t, 1, resp.GetExecutingPlanNo())
+		assert.EqualValues(t, 2, resp.GetCompletedPlanNo())
+		assert.EqualValues(t, 1, resp.GetCancelledPlanNo())
+		assert.Equal(t, 0.75, resp.GetProgress())
+	})
+
+	t.Run("test get compaction progress with closed server", func(t *testing.T) {
+		svr := &Server{}
+		svr.stateCode.Store(commonpb.StateCode_Abnormal)
+
+		resp, err := svr.GetCompactionProgress(context.TODO(), &datapb.GetCompactionProgressRequest{CompactionID: 1})
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(resp.GetStatus()))
+	})
+}
+
+func TestCancelCompaction(t *testing.T) {
+	paramtable.Get().Save(Params.DataCoordCfg.EnableCompaction.Key, "true")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.EnableCompaction.Key)
+	t.Run("test cancel compaction successfully", func(t *testing.T) {
+		svr := &Server{}
+		svr.stateCode.Store(commonpb.StateCode_Healthy)
+		var cancelledSignal int64
+		svr.compactionHandler = &mockCompactionHandler{
+			methods: map[string]interface{}{
+				"getCompactionTasksBySignalID": func(signalID int64) []*compactionTask {
+					return []*compactionTask{{state: executing, plan: &datapb.CompactionPlan{PlanID: 1}}}
+				},
+				"cancelCompaction": func(signalID int64) []*compactionTask {
+					cancelledSignal = signalID
+					return []*compactionTask{{state: cancelled, plan: &datapb.CompactionPlan{PlanID: 1}}}
+				},
+			},
+		}
+
+		status, err := svr.CancelCompaction(context.TODO(), &datapb.CancelCompactionRequest{CompactionID: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+		assert.EqualValues(t, 1, cancelledSignal)
+	})
+
+	t.Run("test cancel compaction not found", func(t *testing.T) {
+		svr := &Server{}
+		svr.stateCode.Store(commonpb.StateCode_Healthy)
+		svr.compactionHandler = &mockCompactionHandler{
+			methods: map[string]interface{}{
+				"getCompactionTasksBySignalID": func(signalID int64) []*compactionTask {
+					return nil
+				},
+			},
+		}
+
+		status, err := svr.CancelCompaction(context.TODO(), &datapb.CancelCompactionRequest{CompactionID: 1})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(status), merr.ErrParameterInvalid)
+	})
+
+	t.Run("test cancel compaction with closed server", func(t *testing.T) {
+		svr := &Server{}
+		svr.stateCode.Store(commonpb.StateCode_Abnormal)
+
+		status, err := svr.CancelCompaction(context.TODO(), &datapb.CancelCompactionRequest{CompactionID: 1})
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(status))
+	})
+}
+
 func TestGetCompactionStateWithPlans(t *testing.T) {
 	t.Run("test get compaction state successfully", func(t *testing.T) {
 		svr := &Server{}
@@ -3324,6 +3630,48 @@ func TestHandleSessionEvent(t *testing.T) {
 	})
 }
 
+func TestEvictUnreachableDataNode(t *testing.T) {
+	kv := getWatchKV(t)
+	defer func() {
+		kv.RemoveWithPrefix("")
+		kv.Close()
+	}()
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	paramtable.Get().Save(Params.DataCoordCfg.SessionProbeFailureThreshold.Key, "2")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.SessionProbeFailureThreshold.Key)
+
+	sessionManager := NewSessionManager(withSessionCreator(func(ctx context.Context, addr string) (types.DataNode, error) {
+		if addr == "unreachable" {
+			return nil, errors.New("connection refused")
+		}
+		return newMockDataNodeClient(1, nil)
+	}))
+	channelManager, err := NewChannelManager(kv, newMockHandler(), withFactory(&mockPolicyFactory{}))
+	assert.NoError(t, err)
+	cluster := NewCluster(sessionManager, channelManager)
+	err = cluster.Startup(ctx, []*NodeInfo{
+		{NodeID: 1, Address: "reachable"},
+		{NodeID: 2, Address: "unreachable"},
+	})
+	assert.NoError(t, err)
+	defer cluster.Close()
+
+	svr := newTestServer(t, nil, WithCluster(cluster))
+	defer closeTestServer(t, svr)
+
+	// evicted only after failing the probes in a row
+	assert.Empty(t, sessionManager.ProbeSessions(ctx))
+	unreachable := sessionManager.ProbeSessions(ctx)
+	assert.Len(t, unreachable, 1)
+	assert.EqualValues(t, 2, unreachable[0].NodeID)
+
+	svr.evictDataNode(unreachable[0])
+	assert.ElementsMatch(t, []int64{1}, sessionManager.getLiveNodeIDs())
+	assert.Nil(t, channelManager.store.GetNode(2))
+}
+
 type rootCoordSegFlushComplete struct {
 	mockRootCoordService
 	flag bool
@@ -3369,22 +3717,20 @@ func TestGetFlushState(t *testing.T) {
 	t.Run("get flush state with all flushed segments", func(t *testing.T) {
 		svr := &Server{
 			meta: &meta{
-				segments: &SegmentsInfo{
-					segments: map[int64]*SegmentInfo{
-						1: {
-							SegmentInfo: &datapb.SegmentInfo{
-								ID:    1,
-								State: commonpb.SegmentState_Flushed,
-							},
+				segments: buildSegmentsInfo(map[int64]*SegmentInfo{
+					1: {
+						SegmentInfo: &datapb.SegmentInfo{
+							ID:    1,
+							State: commonpb.SegmentState_Flushed,
 						},
-						2: {
-							SegmentInfo: &datapb.SegmentInfo{
-								ID:    2,
-								State: commonpb.SegmentState_Flushed,
-							},
+					},
+					2: {
+						SegmentInfo: &datapb.SegmentInfo{
+							ID:    2,
+							State: commonpb.SegmentState_Flushed,
 						},
 					},
-				},
+				}),
 			},
 		}
 		svr.stateCode.Store(commonpb.StateCode_Healthy)
@@ -3399,22 +3745,20 @@ func TestGetFlushState(t *testing.T) {
 	t.Run("get flush state with unflushed segments", func(t *testing.T) {
 		svr := &Server{
 			meta: &meta{
-				segments: &SegmentsInfo{
-					segments: map[int64]*SegmentInfo{
-						1: {
-							SegmentInfo: &datapb.SegmentInfo{
-								ID:    1,
-								State: commonpb.SegmentState_Flushed,
-							},
+				segments: buildSegmentsInfo(map[int64]*SegmentInfo{
+					1: {
+						SegmentInfo: &datapb.SegmentInfo{
+							ID:    1,
+							State: commonpb.SegmentState_Flushed,
 						},
-						2: {
-							SegmentInfo: &datapb.SegmentInfo{
-								ID:    2,
-								State: commonpb.SegmentState_Sealed,
-							},
+					},
+					2: {
+						SegmentInfo: &datapb.SegmentInfo{
+							ID:    2,
+							State: commonpb.SegmentState_Sealed,
 						},
 					},
-				},
+				}),
 			},
 		}
 		svr.stateCode.Store(commonpb.StateCode_Healthy)
@@ -3430,22 +3774,20 @@ func TestGetFlushState(t *testing.T) {
 	t.Run("get flush state with compacted segments", func(t *testing.T) {
 		svr := &Server{
 			meta: &meta{
-				segments: &SegmentsInfo{
-					segments: map[int64]*SegmentInfo{
-						1: {
-							SegmentInfo: &datapb.SegmentInfo{
-								ID:    1,
-								State: commonpb.SegmentState_Flushed,
-							},
+				segments: buildSegmentsInfo(map[int64]*SegmentInfo{
+					1: {
+						SegmentInfo: &datapb.SegmentInfo{
+							ID:    1,
+							State: commonpb.SegmentState_Flushed,
 						},
-						2: {
-							SegmentInfo: &datapb.SegmentInfo{
-								ID:    2,
-								State: commonpb.SegmentState_Dropped,
-							},
+					},
+					2: {
+						SegmentInfo: &datapb.SegmentInfo{
+							ID:    2,
+							State: commonpb.SegmentState_Dropped,
 						},
 					},
-				},
+				}),
 			},
 		}
 		svr.stateCode.Store(commonpb.StateCode_Healthy)
@@ -3893,6 +4235,28 @@ func TestDataCoord_UnsetIsImportingState(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
 	})
+
+	t.Run("all or nothing", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		seg := buildSegment(100, 100, 100, "ch1", true)
+		svr.meta.AddSegment(seg)
+
+		// none of the segments is unset if one of them does not exist.
+		status, err := svr.UnsetIsImportingState(context.Background(), &datapb.UnsetIsImportingStateRequest{
+			SegmentIds: []int64{100, 999},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+		assert.True(t, svr.meta.GetSegment(100).GetIsImporting())
+
+		status, err = svr.UnsetIsImportingState(context.Background(), &datapb.UnsetIsImportingStateRequest{
+			SegmentIds: []int64{100},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+		assert.False(t, svr.meta.GetSegment(100).GetIsImporting())
+	})
 }
 
 func TestDataCoordServer_UpdateChannelCheckpoint(t *testing.T) {
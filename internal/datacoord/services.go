@@ -18,11 +18,18 @@ package datacoord
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/rand"
+	"path"
+	"sort"
 	"strconv"
+	"strings"
 	"sync"
+	"time"
 
+	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
 	"github.com/samber/lo"
 	"go.opentelemetry.io/otel"
 	"go.uber.org/zap"
@@ -33,15 +40,19 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/segmentutil"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/util/errorutil"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
 	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/ratelimitutil"
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
@@ -360,9 +371,14 @@ func (s *Server) GetSegmentInfo(ctx context.Context, req *datapb.GetSegmentInfoR
 		resp.Status.Reason = serverNotServingErrMsg
 		return resp, nil
 	}
-	infos := make([]*datapb.SegmentInfo, 0, len(req.GetSegmentIDs()))
+	segmentIDs := req.GetSegmentIDs()
+	if len(segmentIDs) == 0 && req.GetCollectionID() != 0 {
+		segmentIDs, resp.HasMore = s.listSegmentIDs(req)
+	}
+	states := typeutil.NewSet(req.GetStates()...)
+	infos := make([]*datapb.SegmentInfo, 0, len(segmentIDs))
 	channelCPs := make(map[string]*msgpb.MsgPosition)
-	for _, id := range req.SegmentIDs {
+	for _, id := range segmentIDs {
 		var info *SegmentInfo
 		if req.IncludeUnHealthy {
 			info = s.meta.GetSegment(id)
@@ -372,6 +388,9 @@ func (s *Server) GetSegmentInfo(ctx context.Context, req *datapb.GetSegmentInfoR
 				resp.Status.Reason = msgSegmentNotFound(id)
 				return resp, nil
 			}
+			if states.Len() > 0 && !states.Contain(info.GetState()) {
+				continue
+			}
 
 			child := s.meta.GetCompactionTo(id)
 			clonedInfo := info.Clone()
@@ -387,13 +406,27 @@ func (s *Server) GetSegmentInfo(ctx context.Context, req *datapb.GetSegmentInfoR
 				resp.Status.Reason = msgSegmentNotFound(id)
 				return resp, nil
 			}
+			if states.Len() > 0 && !states.Contain(info.GetState()) {
+				continue
+			}
 			clonedInfo := info.Clone()
 			segmentutil.ReCalcRowCount(info.SegmentInfo, clonedInfo.SegmentInfo)
 			infos = append(infos, clonedInfo.SegmentInfo)
 		}
 		vchannel := info.InsertChannel
 		if _, ok := channelCPs[vchannel]; vchannel != "" && !ok {
-			channelCPs[vchannel] = s.meta.GetChannelCheckpoint(vchannel)
+			// the QueryNodes read the deletes of the loaded segments since the checkpoint
+			channelCPs[vchannel] = s.meta.holdCheckpointForLevelZero(vchannel, s.meta.GetChannelCheckpoint(vchannel))
+		}
+	}
+	if len(req.GetOutputFields()) > 0 {
+		for i, info := range infos {
+			selected, err := selectSegmentInfoFields(info, req.GetOutputFields())
+			if err != nil {
+				resp.Status = merr.Status(err)
+				return resp, nil
+			}
+			infos[i] = selected
 		}
 	}
 	resp.Status.ErrorCode = commonpb.ErrorCode_Success
@@ -402,6 +435,24 @@ func (s *Server) GetSegmentInfo(ctx context.Context, req *datapb.GetSegmentInfoR
 	return resp, nil
 }
 
+// listSegmentIDs lists the IDs of the collection segments in the requested states, ordered by segment ID.
+// At most limit segments after the cursor are listed, and the second return value is true if more are left.
+func (s *Server) listSegmentIDs(req *datapb.GetSegmentInfoRequest) ([]UniqueID, bool) {
+	states := typeutil.NewSet(req.GetStates()...)
+	segments := s.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return segment.GetCollectionID() == req.GetCollectionID() &&
+			segment.GetID() > req.GetAfterSegmentID() &&
+			(req.GetIncludeUnHealthy() || isSegmentHealthy(segment)) &&
+			(states.Len() == 0 || states.Contain(segment.GetState()))
+	})
+	segmentIDs := lo.Map(segments, func(segment *SegmentInfo, _ int) UniqueID { return segment.GetID() })
+	sort.Slice(segmentIDs, func(i, j int) bool { return segmentIDs[i] < segmentIDs[j] })
+	if limit := int(req.GetLimit()); limit > 0 && len(segmentIDs) > limit {
+		return segmentIDs[:limit], true
+	}
+	return segmentIDs, false
+}
+
 // SaveBinlogPaths updates segment related binlog path
 // works for Checkpoints and Flush
 func (s *Server) SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPathsRequest) (*commonpb.Status, error) {
@@ -443,6 +494,20 @@ func (s *Server) SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPath
 	segmentID := req.GetSegmentID()
 	segment := s.meta.GetSegment(segmentID)
 
+	if req.GetSegLevel() == datapb.SegmentLevel_L0 {
+		// the deletes of a dropped L0 segment are dropped along with the collection or partition
+		if segment == nil && !req.GetDropped() {
+			if err := s.addLevelZeroSegment(req); err != nil {
+				log.Error("failed to add L0 segment", zap.Error(err))
+				resp.Reason = err.Error()
+				return resp, nil
+			}
+			log.Info("L0 segment added", zap.Int64("partitionID", req.GetPartitionID()))
+		}
+		resp.ErrorCode = commonpb.ErrorCode_Success
+		return resp, nil
+	}
+
 	if segment == nil {
 		log.Error("failed to get segment")
 		failResponseWithCode(resp, commonpb.ErrorCode_SegmentNotFound, fmt.Sprintf("failed to get segment %d", segmentID))
@@ -473,7 +538,8 @@ func (s *Server) SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPath
 		req.GetField2StatslogPaths(),
 		req.GetDeltalogs(),
 		req.GetCheckPoints(),
-		req.GetStartPositions())
+		req.GetStartPositions(),
+		req.GetEncryptionKeyVersion())
 	if err != nil {
 		log.Error("save binlog and checkpoints failed", zap.Error(err))
 		resp.Reason = err.Error()
@@ -513,6 +579,10 @@ func (s *Server) DropVirtualChannel(ctx context.Context, req *datapb.DropVirtual
 		resp.Status.Reason = serverNotServingErrMsg
 		return resp, nil
 	}
+	if err := s.metaStoreMonitor.CheckWritable(); err != nil {
+		resp.Status = merr.Status(err)
+		return resp, nil
+	}
 
 	channel := req.GetChannelName()
 	log.Info("receive DropVirtualChannel request",
@@ -1062,12 +1132,21 @@ func (s *Server) ManualCompaction(ctx context.Context, req *milvuspb.ManualCompa
 		resp.Status.Reason = msgDataCoordIsUnhealthy(paramtable.GetNodeID())
 		return resp, nil
 	}
+	if err := s.metaStoreMonitor.CheckWritable(); err != nil {
+		resp.Status = merr.Status(err)
+		return resp, nil
+	}
 
 	if !Params.DataCoordCfg.EnableCompaction.GetAsBool() {
 		resp.Status.Reason = "compaction disabled"
 		return resp, nil
 	}
 
+	if !s.maintenanceManager.CompactionAllowed(req.GetCollectionID(), true) {
+		resp.Status.Reason = "compaction paused"
+		return resp, nil
+	}
+
 	id, err := s.compactionTrigger.forceTriggerCompaction(req.CollectionID)
 	if err != nil {
 		log.Error("failed to trigger manual compaction", zap.Error(err))
@@ -1081,6 +1160,60 @@ func (s *Server) ManualCompaction(ctx context.Context, req *milvuspb.ManualCompa
 	return resp, nil
 }
 
+// ManualCompactionWithMode triggers a compaction for a collection on the segments selected by the mode,
+// the returned compaction id could be polled by GetCompactionState.
+func (s *Server) ManualCompactionWithMode(ctx context.Context, req *datapb.ManualCompactionWithModeRequest) (*datapb.ManualCompactionWithModeResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.String("mode", req.GetMode().String()),
+	)
+	log.Info("received manual compaction with mode")
+
+	if s.isClosed() {
+		log.Warn("failed to execute manual compaction on closed server")
+		return &datapb.ManualCompactionWithModeResponse{
+			Status: merr.Status(merr.WrapErrServiceUnavailable("Datacoord not ready")),
+		}, nil
+	}
+	if err := s.metaStoreMonitor.CheckWritable(); err != nil {
+		return &datapb.ManualCompactionWithModeResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	if _, ok := datapb.CompactionMode_name[int32(req.GetMode())]; !ok {
+		return &datapb.ManualCompactionWithModeResponse{
+			Status: merr.Status(merr.WrapErrParameterInvalid("valid compaction mode", req.GetMode().String())),
+		}, nil
+	}
+
+	if !Params.DataCoordCfg.EnableCompaction.GetAsBool() {
+		return &datapb.ManualCompactionWithModeResponse{
+			Status: merr.Status(merr.WrapErrServiceUnavailable("compaction disabled")),
+		}, nil
+	}
+
+	if !s.maintenanceManager.CompactionAllowed(req.GetCollectionID(), true) {
+		return &datapb.ManualCompactionWithModeResponse{
+			Status: merr.Status(merr.WrapErrServiceUnavailable("compaction paused")),
+		}, nil
+	}
+
+	id, err := s.compactionTrigger.forceTriggerCompactionWithMode(req.GetCollectionID(), req.GetMode())
+	if err != nil {
+		log.Error("failed to trigger manual compaction", zap.Error(err))
+		return &datapb.ManualCompactionWithModeResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	log.Info("success to trigger manual compaction", zap.Int64("compactionID", id))
+	return &datapb.ManualCompactionWithModeResponse{
+		Status:       merr.Status(nil),
+		CompactionID: id,
+	}, nil
+}
+
 // GetCompactionState gets the state of a compaction
 func (s *Server) GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error) {
 	log := log.Ctx(ctx).With(
@@ -1165,6 +1298,77 @@ func (s *Server) GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.
 	return resp, nil
 }
 
+// GetCompactionProgress returns the progress of a compaction by the numbers of plans in each state
+func (s *Server) GetCompactionProgress(ctx context.Context, req *datapb.GetCompactionProgressRequest) (*datapb.GetCompactionProgressResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("compactionID", req.GetCompactionID()),
+	)
+	log.Info("received get compaction progress request")
+
+	if s.isClosed() {
+		log.Warn("failed to get compaction progress on closed server")
+		return &datapb.GetCompactionProgressResponse{
+			Status: merr.Status(merr.WrapErrServiceUnavailable("Datacoord not ready")),
+		}, nil
+	}
+
+	if !Params.DataCoordCfg.EnableCompaction.GetAsBool() {
+		return &datapb.GetCompactionProgressResponse{
+			Status: merr.Status(merr.WrapErrServiceUnavailable("compaction disabled")),
+		}, nil
+	}
+
+	tasks := s.compactionHandler.getCompactionTasksBySignalID(req.GetCompactionID())
+	state, executingCnt, completedCnt, failedCnt, timeoutCnt := getCompactionState(tasks)
+	cancelledCnt := lo.CountBy(tasks, func(t *compactionTask) bool { return t.state == cancelled })
+
+	progress := float64(1)
+	if len(tasks) > 0 {
+		progress = float64(len(tasks)-executingCnt) / float64(len(tasks))
+	}
+	log.Info("success to get compaction progress", zap.Any("state", state), zap.Int("total", len(tasks)),
+		zap.Int("executing", executingCnt), zap.Int("cancelled", cancelledCnt), zap.Float64("progress", progress))
+	return &datapb.GetCompactionProgressResponse{
+		Status:          merr.Status(nil),
+		State:           state,
+		TotalPlanNo:     int64(len(tasks)),
+		ExecutingPlanNo: int64(executingCnt),
+		CompletedPlanNo: int64(completedCnt),
+		FailedPlanNo:    int64(failedCnt),
+		TimeoutPlanNo:   int64(timeoutCnt),
+		CancelledPlanNo: int64(cancelledCnt),
+		Progress:        progress,
+	}, nil
+}
+
+// CancelCompaction cancels the unfinished plans of a compaction, the executing plans are interrupted on DataNodes
+func (s *Server) CancelCompaction(ctx context.Context, req *datapb.CancelCompactionRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("compactionID", req.GetCompactionID()),
+	)
+	log.Info("received cancel compaction request")
+
+	if s.isClosed() {
+		log.Warn("failed to cancel compaction on closed server")
+		return merr.Status(merr.WrapErrServiceUnavailable("Datacoord not ready")), nil
+	}
+
+	if !Params.DataCoordCfg.EnableCompaction.GetAsBool() {
+		return merr.Status(merr.WrapErrServiceUnavailable("compaction disabled")), nil
+	}
+
+	if len(s.compactionHandler.getCompactionTasksBySignalID(req.GetCompactionID())) == 0 {
+		log.Warn("compaction to cancel not found")
+		return merr.Status(merr.WrapErrParameterInvalid("existing compaction ID", strconv.FormatInt(req.GetCompactionID(), 10))), nil
+	}
+
+	tasks := s.compactionHandler.cancelCompaction(req.GetCompactionID())
+	log.Info("success to cancel compaction", zap.Int64s("plans", lo.Map(tasks, func(t *compactionTask, _ int) int64 {
+		return t.plan.GetPlanID()
+	})))
+	return merr.Status(nil), nil
+}
+
 func getCompactionMergeInfo(task *compactionTask) *milvuspb.CompactionMergeInfo {
 	segments := task.plan.GetSegmentBinlogs()
 	var sources []int64
@@ -1224,6 +1428,10 @@ func (s *Server) WatchChannels(ctx context.Context, req *datapb.WatchChannelsReq
 		resp.Status.Reason = msgDataCoordIsUnhealthy(paramtable.GetNodeID())
 		return resp, nil
 	}
+	if err := s.metaStoreMonitor.CheckWritable(); err != nil {
+		resp.Status = merr.Status(err)
+		return resp, nil
+	}
 	for _, channelName := range req.GetChannelNames() {
 		ch := &channel{
 			Name:           channelName,
@@ -1345,6 +1553,10 @@ func (s *Server) Import(ctx context.Context, itr *datapb.ImportTaskRequest) (*da
 		resp.Status.Reason = msgDataCoordIsUnhealthy(paramtable.GetNodeID())
 		return resp, nil
 	}
+	if err := s.metaStoreMonitor.CheckWritable(); err != nil {
+		resp.Status = merr.Status(err)
+		return resp, nil
+	}
 
 	nodes := s.sessionManager.getLiveNodeIDs()
 	if len(nodes) == 0 {
@@ -1457,6 +1669,7 @@ func (s *Server) handleRPCTimetickMessage(ctx context.Context, ttMsg *msgpb.Data
 	}
 
 	s.updateSegmentStatistics(ttMsg.GetSegmentsStats())
+	s.updateChannelMetrics(ch, ts)
 
 	if err := s.segmentManager.ExpireAllocations(ch, ts); err != nil {
 		return fmt.Errorf("expire allocations: %w", err)
@@ -1483,6 +1696,11 @@ func (s *Server) handleRPCTimetickMessage(ctx context.Context, ttMsg *msgpb.Data
 		finfo = append(finfo, info.SegmentInfo)
 	}
 	err = s.cluster.Flush(s.ctx, ttMsg.GetBase().GetSourceID(), ch, finfo)
+	if errors.Is(err, merr.ErrServiceRequestLimitExceeded) {
+		// the segments are still flushable, they are retried after the flush interval
+		log.Info("flush is throttled", zap.String("channel", ch), zap.Error(err))
+		return nil
+	}
 	if err != nil {
 		log.Warn("failed to handle flush", zap.Any("source", ttMsg.GetBase().GetSourceID()), zap.Error(err))
 		return err
@@ -1526,6 +1744,23 @@ func (s *Server) SaveImportSegment(ctx context.Context, req *datapb.SaveImportSe
 		errResp.Reason = msgDataCoordIsUnhealthy(paramtable.GetNodeID())
 		return errResp, nil
 	}
+	// The segment registered on the external files has no binlogs to add into the flow graph,
+	// it's flushed directly and added into the flow graph once it's converted into binlogs.
+	if req.GetExternalSource() != nil {
+		if err := s.meta.SetExternalSource(req.GetSegmentId(), req.GetExternalSource(), req.GetRowNum()); err != nil {
+			log.Error("failed to register the segment on external files", zap.Error(err))
+			errResp.Reason = err.Error()
+			return errResp, nil
+		}
+		req.SaveBinlogPathReq.Flushed = true
+		rsp, err := s.SaveBinlogPaths(context.Background(), req.GetSaveBinlogPathReq())
+		if err := VerifyResponse(rsp, err); err != nil {
+			log.Error("failed to SaveBinlogPaths", zap.Error(err))
+			errResp.Reason = err.Error()
+			return errResp, nil
+		}
+		return merr.Status(nil), nil
+	}
 	// Look for the DataNode that watches the channel.
 	ok, nodeID := s.channelManager.getNodeIDByChannelName(req.GetChannelName())
 	if !ok {
@@ -1583,23 +1818,18 @@ func (s *Server) SaveImportSegment(ctx context.Context, req *datapb.SaveImportSe
 }
 
 // UnsetIsImportingState unsets the isImporting states of the given segments.
-// An error status will be returned and error will be logged, if we failed to update *all* segments.
+// An error status will be returned and error will be logged, if we failed to update any segment, none of the segments
+// is updated in this case.
 func (s *Server) UnsetIsImportingState(ctx context.Context, req *datapb.UnsetIsImportingStateRequest) (*commonpb.Status, error) {
 	log := log.Ctx(ctx)
 	log.Info("unsetting isImport state of segments",
 		zap.Int64s("segments", req.GetSegmentIds()))
-	var reportErr error
-	for _, segID := range req.GetSegmentIds() {
-		if err := s.meta.UnsetIsImporting(segID); err != nil {
-			// Fail-open.
-			log.Error("failed to unset segment is importing state", zap.Int64("segmentID", segID))
-			reportErr = err
-		}
-	}
-	if reportErr != nil {
+	// The segments of an import job become visible together, none of them is unset if any of them fails.
+	if err := s.meta.UnsetIsImporting(req.GetSegmentIds()...); err != nil {
+		log.Error("failed to unset segments is importing state", zap.Int64s("segmentIDs", req.GetSegmentIds()), zap.Error(err))
 		return &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_UnexpectedError,
-			Reason:    reportErr.Error(),
+			Reason:    err.Error(),
 		}, nil
 	}
 	return &commonpb.Status{
@@ -1731,3 +1961,903 @@ func (s *Server) GcConfirm(ctx context.Context, request *datapb.GcConfirmRequest
 	resp.Status.ErrorCode = commonpb.ErrorCode_Success
 	return resp, nil
 }
+
+// GcDryRun reports the orphan files and the dropped segments to be recycled by the garbage collection,
+// and the bytes to reclaim, without deleting anything.
+func (s *Server) GcDryRun(ctx context.Context, req *datapb.GcDryRunRequest) (*datapb.GcDryRunResponse, error) {
+	log := log.Ctx(ctx)
+	if s.isClosed() {
+		log.Warn("failed to dry run gc on closed server")
+		return &datapb.GcDryRunResponse{
+			Status: merr.Status(merr.WrapErrServiceUnavailable("Datacoord not ready")),
+		}, nil
+	}
+	if s.garbageCollector.option.cli == nil {
+		return &datapb.GcDryRunResponse{
+			Status: merr.Status(merr.WrapErrServiceUnavailable("object storage of gc not provided")),
+		}, nil
+	}
+
+	resp, err := s.garbageCollector.dryRun(ctx)
+	if err != nil {
+		log.Warn("failed to dry run gc", zap.Error(err))
+		return &datapb.GcDryRunResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	resp.Status = merr.Status(nil)
+	return resp, nil
+}
+
+// PauseGC pauses the garbage collection for pause_seconds, or until resumed if pause_seconds is 0.
+// The pausing is persisted in the global maintenance policy, so it survives the restart of datacoord.
+// It returns after the in-flight run of the garbage collection stops, and the pause still takes effect
+// if it fails to wait for the run.
+func (s *Server) PauseGC(ctx context.Context, req *datapb.PauseGCRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(zap.Int64("pauseSeconds", req.GetPauseSeconds()))
+	if s.isClosed() {
+		log.Warn("failed to pause gc on closed server")
+		return merr.Status(merr.WrapErrServiceUnavailable("Datacoord not ready")), nil
+	}
+	if req.GetPauseSeconds() < 0 {
+		return merr.Status(merr.WrapErrParameterInvalid[int64](0, req.GetPauseSeconds(), "pause seconds must not be negative")), nil
+	}
+
+	log.Info("receive pause gc request")
+	var resumeTime time.Time
+	if req.GetPauseSeconds() > 0 {
+		resumeTime = time.Now().Add(time.Duration(req.GetPauseSeconds()) * time.Second)
+	}
+	if err := s.maintenanceManager.PauseGC(ctx, resumeTime); err != nil {
+		log.Warn("failed to pause gc", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	if err := s.garbageCollector.waitForRun(ctx); err != nil {
+		log.Warn("gc paused, but failed to wait for the in-flight run", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	log.Info("gc paused")
+	return merr.Status(nil), nil
+}
+
+// ResumeGC resumes the garbage collection paused by the global maintenance policy.
+func (s *Server) ResumeGC(ctx context.Context, req *datapb.ResumeGCRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx)
+	if s.isClosed() {
+		log.Warn("failed to resume gc on closed server")
+		return merr.Status(merr.WrapErrServiceUnavailable("Datacoord not ready")), nil
+	}
+
+	if err := s.maintenanceManager.ResumeGC(ctx); err != nil {
+		log.Warn("failed to resume gc", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	log.Info("gc resumed")
+	return merr.Status(nil), nil
+}
+
+// GetGCStatus returns the pausing and schedule of the garbage collection by the maintenance policies,
+// the phase of the in-flight run and the statistics of the runs.
+func (s *Server) GetGCStatus(ctx context.Context, req *datapb.GetGCStatusRequest) (*datapb.GetGCStatusResponse, error) {
+	if s.isClosed() {
+		log.Ctx(ctx).Warn("failed to get gc status on closed server")
+		return &datapb.GetGCStatusResponse{
+			Status: merr.Status(merr.WrapErrServiceUnavailable("Datacoord not ready")),
+		}, nil
+	}
+
+	resp := &datapb.GetGCStatusResponse{
+		Status: merr.Status(nil),
+	}
+	s.garbageCollector.status.fill(resp)
+	s.maintenanceManager.fillGCStatus(resp)
+	return resp, nil
+}
+
+// DropSegmentsByTimeRange drops the flushed segments of the partition whose insert timestamps are all within
+// [start_ts, end_ts], the binlogs of the dropped segments are recycled by the garbage collector after the drop tolerance.
+func (s *Server) DropSegmentsByTimeRange(ctx context.Context, req *datapb.DropSegmentsByTimeRangeRequest) (*datapb.DropSegmentsByTimeRangeResponse, error) {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64("partitionID", req.GetPartitionID()),
+		zap.Uint64("startTs", req.GetStartTs()),
+		zap.Uint64("endTs", req.GetEndTs()))
+	if s.isClosed() {
+		log.Warn("failed to drop segments by time range on closed server")
+		return &datapb.DropSegmentsByTimeRangeResponse{
+			Status: merr.Status(merr.WrapErrServiceUnavailable("Datacoord not ready")),
+		}, nil
+	}
+	if req.GetStartTs() > req.GetEndTs() {
+		return &datapb.DropSegmentsByTimeRangeResponse{
+			Status: merr.Status(merr.WrapErrParameterInvalidRange(uint64(0), req.GetEndTs(), req.GetStartTs(), "start ts must not be greater than end ts")),
+		}, nil
+	}
+
+	log.Info("receive drop segments by time range request")
+	segments, err := s.meta.DropSegmentsInTimeRange(req.GetCollectionID(), req.GetPartitionID(), req.GetStartTs(), req.GetEndTs())
+	if err != nil {
+		log.Warn("failed to drop segments by time range", zap.Error(err))
+		return &datapb.DropSegmentsByTimeRangeResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	resp := &datapb.DropSegmentsByTimeRangeResponse{
+		Status:     merr.Status(nil),
+		SegmentIDs: make([]int64, 0, len(segments)),
+	}
+	for _, segment := range segments {
+		resp.SegmentIDs = append(resp.SegmentIDs, segment.GetID())
+		resp.NumRows += segment.GetNumOfRows()
+	}
+	log.Info("segments dropped by time range", zap.Int64s("segmentIDs", resp.GetSegmentIDs()), zap.Int64("numRows", resp.GetNumRows()))
+	return resp, nil
+}
+
+// GetTopologySnapshot returns the JSON serialized snapshot of the DataNodes, their channels and the buffer channels,
+// so that the external tooling doesn't need to parse the channel watch infos in etcd.
+func (s *Server) GetTopologySnapshot(ctx context.Context, req *datapb.GetTopologySnapshotRequest) (*datapb.GetTopologySnapshotResponse, error) {
+	log := log.Ctx(ctx)
+	if s.isClosed() {
+		log.Warn("failed to get topology snapshot on closed server")
+		return &datapb.GetTopologySnapshotResponse{
+			Status: merr.Status(merr.WrapErrServiceUnavailable("Datacoord not ready")),
+		}, nil
+	}
+
+	states, err := s.channelManager.GetChannelWatchStates(0)
+	if err != nil {
+		log.Warn("failed to get channel watch states", zap.Error(err))
+		return &datapb.GetTopologySnapshotResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	snapshot := buildTopologySnapshot(s.sessionManager.GetSessions(), states, s.meta.SelectSegments(isSegmentHealthy), s.channelManager.IsCordoned)
+	bs, err := json.Marshal(snapshot)
+	if err != nil {
+		log.Warn("failed to marshal topology snapshot", zap.Error(err))
+		return &datapb.GetTopologySnapshotResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	return &datapb.GetTopologySnapshotResponse{
+		Status:   merr.Status(nil),
+		Snapshot: string(bs),
+	}, nil
+}
+
+// RetryQuarantinedChannels clears the failures of the quarantined channels and releases them to be watched again,
+// all the quarantined channels are retried if no channel is specified.
+func (s *Server) RetryQuarantinedChannels(ctx context.Context, req *datapb.RetryQuarantinedChannelsRequest) (*datapb.RetryQuarantinedChannelsResponse, error) {
+	log := log.Ctx(ctx).With(zap.Strings("channels", req.GetChannelNames()))
+	if s.isClosed() {
+		log.Warn("failed to retry quarantined channels on closed server")
+		return &datapb.RetryQuarantinedChannelsResponse{
+			Status: merr.Status(merr.WrapErrServiceUnavailable("Datacoord not ready")),
+		}, nil
+	}
+
+	channelNames := req.GetChannelNames()
+	if len(channelNames) == 0 {
+		channelNames = s.channelManager.GetQuarantinedChannels()
+	}
+	retried := make([]string, 0, len(channelNames))
+	for _, channelName := range channelNames {
+		if err := s.channelManager.Unquarantine(channelName); err != nil {
+			log.Warn("failed to retry quarantined channel", zap.String("channel", channelName), zap.Error(err))
+			return &datapb.RetryQuarantinedChannelsResponse{
+				Status:       merr.Status(err),
+				ChannelNames: retried,
+			}, nil
+		}
+		retried = append(retried, channelName)
+	}
+
+	log.Info("quarantined channels retried", zap.Strings("retried", retried))
+	return &datapb.RetryQuarantinedChannelsResponse{
+		Status:       merr.Status(nil),
+		ChannelNames: retried,
+	}, nil
+}
+
+// MigrateChannelWatchInfos rewrites the legacy channel watch infos into the newest ChannelWatchInfo schema,
+// so that the upgrade doesn't need to edit the watch infos in etcd manually.
+func (s *Server) MigrateChannelWatchInfos(ctx context.Context, req *datapb.MigrateChannelWatchInfosRequest) (*datapb.MigrateChannelWatchInfosResponse, error) {
+	log := log.Ctx(ctx).With(zap.Bool("dryRun", req.GetDryRun()))
+	if s.isClosed() {
+		log.Warn("failed to migrate channel watch infos on closed server")
+		return &datapb.MigrateChannelWatchInfosResponse{
+			Status: merr.Status(merr.WrapErrServiceUnavailable("Datacoord not ready")),
+		}, nil
+	}
+
+	migrated, skipped, err := s.channelManager.MigrateWatchInfos(req.GetDryRun())
+	if err != nil {
+		log.Warn("failed to migrate channel watch infos", zap.Error(err))
+		return &datapb.MigrateChannelWatchInfosResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	return &datapb.MigrateChannelWatchInfosResponse{
+		Status:       merr.Status(nil),
+		MigratedKeys: migrated,
+		SkippedKeys:  skipped,
+	}, nil
+}
+
+// ReCollectSegmentStats asks the DataNodes to resend the stats of the unflushed segments,
+// scoped by the collection and the channel if set, and returns the result of each DataNode,
+// so that the caller is able to verify the stats are actually re-collected.
+func (s *Server) ReCollectSegmentStats(ctx context.Context, req *datapb.ReCollectSegmentStatsRequest) (*datapb.ReCollectSegmentStatsResponse, error) {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", req.GetCollectionID()), zap.String("channel", req.GetChannelName()))
+	if s.isClosed() {
+		log.Warn("failed to re-collect segment stats on closed server")
+		return &datapb.ReCollectSegmentStatsResponse{
+			Status: merr.Status(merr.WrapErrServiceUnavailable("Datacoord not ready")),
+		}, nil
+	}
+
+	results, err := s.cluster.ReCollectSegmentStats(ctx, req.GetCollectionID(), req.GetChannelName())
+	if err != nil {
+		log.Warn("failed to re-collect segment stats", zap.Error(err))
+		return &datapb.ReCollectSegmentStatsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	failed := lo.Filter(results, func(result *datapb.ReCollectSegmentStatsResult, _ int) bool { return !result.GetSuccess() })
+	log.Info("re-collect segment stats done", zap.Int("nodeNum", len(results)), zap.Int("failedNodeNum", len(failed)))
+	return &datapb.ReCollectSegmentStatsResponse{
+		Status:  merr.Status(nil),
+		Results: results,
+	}, nil
+}
+
+// GetShardWriteStats returns the write rates of the vchannels of the collection,
+// which tells whether the partition key or the primary key hashing distributes the writes evenly across the shards.
+func (s *Server) GetShardWriteStats(ctx context.Context, req *datapb.GetShardWriteStatsRequest) (*datapb.GetShardWriteStatsResponse, error) {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", req.GetCollectionID()))
+	if s.isClosed() {
+		log.Warn("failed to get shard write stats on closed server")
+		return &datapb.GetShardWriteStatsResponse{
+			Status: merr.Status(merr.WrapErrServiceUnavailable("Datacoord not ready")),
+		}, nil
+	}
+
+	shards, err := s.getShardWriteStats(ctx, req.GetCollectionID())
+	if err != nil {
+		log.Warn("failed to get shard write stats", zap.Error(err))
+		return &datapb.GetShardWriteStatsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	return &datapb.GetShardWriteStatsResponse{
+		Status:        merr.Status(nil),
+		Shards:        shards,
+		WindowSeconds: int64(ratelimitutil.DefaultAvgDuration.Seconds()),
+	}, nil
+}
+
+// FlushAndSeal forces all the channels of the collection to flush up to the barrier timestamp,
+// and returns the flushed segments, which gives the backup tools a consistent cut of the collection.
+// The latest timestamp is used as the barrier if not set.
+func (s *Server) FlushAndSeal(ctx context.Context, req *datapb.FlushAndSealRequest) (*datapb.FlushAndSealResponse, error) {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", req.GetCollectionID()), zap.Uint64("barrierTs", req.GetBarrierTs()))
+	if s.isClosed() {
+		log.Warn("failed to flush and seal on closed server")
+		return &datapb.FlushAndSealResponse{
+			Status: merr.Status(merr.WrapErrServiceUnavailable("Datacoord not ready")),
+		}, nil
+	}
+
+	barrierTs, err := s.checkBarrierTs(ctx, req.GetBarrierTs())
+	if err != nil {
+		log.Warn("invalid flush barrier", zap.Error(err))
+		return &datapb.FlushAndSealResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	log.Info("receive flush and seal request", zap.Uint64("barrier", barrierTs))
+	segmentIDs, checkpoints, err := s.flushAndSeal(ctx, req.GetCollectionID(), barrierTs)
+	if err != nil {
+		log.Warn("failed to flush and seal", zap.Error(err))
+		return &datapb.FlushAndSealResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	return &datapb.FlushAndSealResponse{
+		Status:             merr.Status(nil),
+		BarrierTs:          barrierTs,
+		SegmentIDs:         segmentIDs,
+		ChannelCheckpoints: checkpoints,
+	}, nil
+}
+
+// GetStorageConsistencyReport returns the report of the last reconciliation between the segment meta and the object storage,
+// including the binlogs missing in storage and the orphaned ones, see dataCoord.storageReconcile.
+func (s *Server) GetStorageConsistencyReport(ctx context.Context, req *datapb.GetStorageConsistencyReportRequest) (*datapb.GetStorageConsistencyReportResponse, error) {
+	if s.isClosed() {
+		log.Ctx(ctx).Warn("failed to get storage consistency report on closed server")
+		return &datapb.GetStorageConsistencyReportResponse{
+			Status: merr.Status(merr.WrapErrServiceUnavailable("Datacoord not ready")),
+		}, nil
+	}
+
+	resp := &datapb.GetStorageConsistencyReportResponse{
+		Status: merr.Status(nil),
+	}
+	s.storageReconciler.fill(resp, req.GetCollectionID())
+	return resp, nil
+}
+
+// GetAuditEvents returns the events recorded in the audit log for post-incident analysis.
+func (s *Server) GetAuditEvents(ctx context.Context, req *datapb.GetAuditEventsRequest) (*datapb.GetAuditEventsResponse, error) {
+	log := log.Ctx(ctx)
+	if s.isClosed() {
+		log.Warn("failed to get audit events on closed server")
+		return &datapb.GetAuditEventsResponse{
+			Status: merr.Status(merr.WrapErrServiceUnavailable("Datacoord not ready")),
+		}, nil
+	}
+
+	events, err := s.auditLog.List(req)
+	if err != nil {
+		log.Warn("failed to list audit events", zap.Error(err))
+		return &datapb.GetAuditEventsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	return &datapb.GetAuditEventsResponse{
+		Status: merr.Status(nil),
+		Events: events,
+	}, nil
+}
+
+// GetChannelWatchHistory returns the latest watch state transitions of the channel,
+// which helps to debug channels oscillating between DataNodes.
+func (s *Server) GetChannelWatchHistory(ctx context.Context, req *datapb.GetChannelWatchHistoryRequest) (*datapb.GetChannelWatchHistoryResponse, error) {
+	log := log.Ctx(ctx).With(zap.String("channel", req.GetChannelName()))
+	if s.isClosed() {
+		log.Warn("failed to get channel watch history on closed server")
+		return &datapb.GetChannelWatchHistoryResponse{
+			Status: merr.Status(merr.WrapErrServiceUnavailable("Datacoord not ready")),
+		}, nil
+	}
+
+	nodeID, history, err := s.channelManager.GetChannelWatchHistory(req.GetChannelName())
+	if err != nil {
+		log.Warn("failed to get channel watch history", zap.Error(err))
+		return &datapb.GetChannelWatchHistoryResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	return &datapb.GetChannelWatchHistoryResponse{
+		Status:  merr.Status(nil),
+		NodeID:  nodeID,
+		History: history,
+	}, nil
+}
+
+// GetChannelWatchStates returns the watch state, assigned DataNode and start position of the channels of a collection.
+func (s *Server) GetChannelWatchStates(ctx context.Context, req *datapb.GetChannelWatchStatesRequest) (*datapb.GetChannelWatchStatesResponse, error) {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", req.GetCollectionID()))
+	if s.isClosed() {
+		log.Warn("failed to get channel watch states on closed server")
+		return &datapb.GetChannelWatchStatesResponse{
+			Status: merr.Status(merr.WrapErrServiceUnavailable("Datacoord not ready")),
+		}, nil
+	}
+
+	states, err := s.channelManager.GetChannelWatchStates(req.GetCollectionID())
+	if err != nil {
+		log.Warn("failed to get channel watch states", zap.Error(err))
+		return &datapb.GetChannelWatchStatesResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	return &datapb.GetChannelWatchStatesResponse{
+		Status: merr.Status(nil),
+		States: states,
+	}, nil
+}
+
+// SetNodeConfigs persists the config overrides scoped to a node,
+// the node picks them up via its config watch mechanism without restarting.
+func (s *Server) SetNodeConfigs(ctx context.Context, req *datapb.SetNodeConfigsRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(zap.Int64("nodeID", req.GetNodeID()))
+	if s.isClosed() {
+		log.Warn("failed to set node configs on closed server")
+		return merr.Status(merr.WrapErrServiceUnavailable("Datacoord not ready")), nil
+	}
+
+	configs := funcutil.KeyValuePair2Map(req.GetConfigs())
+	if err := s.nodeConfigManager.Set(req.GetNodeID(), configs); err != nil {
+		log.Warn("failed to set node configs", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	log.Info("set node configs", zap.Any("configs", configs))
+	return merr.Status(nil), nil
+}
+
+// ListNodeConfigs lists the config overrides scoped to a node.
+func (s *Server) ListNodeConfigs(ctx context.Context, req *datapb.ListNodeConfigsRequest) (*datapb.ListNodeConfigsResponse, error) {
+	log := log.Ctx(ctx).With(zap.Int64("nodeID", req.GetNodeID()))
+	if s.isClosed() {
+		log.Warn("failed to list node configs on closed server")
+		return &datapb.ListNodeConfigsResponse{
+			Status: merr.Status(merr.WrapErrServiceUnavailable("Datacoord not ready")),
+		}, nil
+	}
+
+	configs, err := s.nodeConfigManager.List(req.GetNodeID())
+	if err != nil {
+		log.Warn("failed to list node configs", zap.Error(err))
+		return &datapb.ListNodeConfigsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	return &datapb.ListNodeConfigsResponse{
+		Status:  merr.Status(nil),
+		Configs: funcutil.Map2KeyValuePair(configs),
+	}, nil
+}
+
+// ClearNodeConfigs clears the given config overrides scoped to a node, or all of them if no key provided.
+func (s *Server) ClearNodeConfigs(ctx context.Context, req *datapb.ClearNodeConfigsRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(zap.Int64("nodeID", req.GetNodeID()), zap.Strings("keys", req.GetKeys()))
+	if s.isClosed() {
+		log.Warn("failed to clear node configs on closed server")
+		return merr.Status(merr.WrapErrServiceUnavailable("Datacoord not ready")), nil
+	}
+
+	if err := s.nodeConfigManager.Clear(req.GetNodeID(), req.GetKeys()...); err != nil {
+		log.Warn("failed to clear node configs", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	log.Info("cleared node configs")
+	return merr.Status(nil), nil
+}
+
+// CloneSegments clones the segments of a collection into another one, which is created by RootCoord
+// with the same schema, the index definitions are cloned as well and the indexes are rebuilt in background.
+// The growing segments are sealed first, then the segments are cloned in background once flushed,
+// the progress is reported by GetCloneSegmentsState.
+// The binlogs are copied inside the object storage, the data isn't re-ingested.
+func (s *Server) CloneSegments(ctx context.Context, req *datapb.CloneSegmentsRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64("targetCollectionID", req.GetTargetCollectionID()),
+	)
+	if s.isClosed() {
+		log.Warn("failed to clone segments on closed server")
+		return merr.Status(merr.WrapErrServiceUnavailable("Datacoord not ready")), nil
+	}
+	if err := s.metaStoreMonitor.CheckWritable(); err != nil {
+		return merr.Status(err), nil
+	}
+
+	segments, err := s.selectSegmentsToClone(ctx, req)
+	if err != nil {
+		log.Warn("failed to select segments to clone", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	for _, segment := range segments {
+		if _, ok := req.GetPartitionIDs()[segment.GetPartitionID()]; !ok {
+			return merr.Status(merr.WrapErrPartitionNotFound(segment.GetPartitionID(), "no target partition to clone into")), nil
+		}
+		if _, ok := req.GetChannels()[segment.GetInsertChannel()]; !ok {
+			return merr.Status(merr.WrapErrChannelNotFound(segment.GetInsertChannel(), "no target channel to clone into")), nil
+		}
+	}
+
+	if err := s.cloneIndexes(ctx, req); err != nil {
+		log.Warn("failed to clone indexes", zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	segmentIDs := lo.Map(segments, func(segment *SegmentInfo, _ int) UniqueID { return segment.GetID() })
+	job, err := s.cloneJobs.add(ctx, req, segmentIDs)
+	if err != nil {
+		log.Warn("failed to add clone job", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	// the job outlives the request
+	s.serverLoopWg.Add(1)
+	go s.runCloneJob(s.serverLoopCtx, job)
+	return merr.Status(nil), nil
+}
+
+// selectSegmentsToClone returns the selected segments if specified, which must be flushed,
+// otherwise seals the growing segments and returns all the segments of the collection.
+func (s *Server) selectSegmentsToClone(ctx context.Context, req *datapb.CloneSegmentsRequest) ([]*SegmentInfo, error) {
+	if len(req.GetSegmentIDs()) > 0 {
+		segmentIDs := typeutil.NewUniqueSet(req.GetSegmentIDs()...)
+		segments := s.meta.SelectSegments(func(segment *SegmentInfo) bool {
+			return segment.GetCollectionID() == req.GetCollectionID() &&
+				segment.GetState() == commonpb.SegmentState_Flushed &&
+				!segment.GetIsImporting() &&
+				segmentIDs.Contain(segment.GetID())
+		})
+		if len(segments) != segmentIDs.Len() {
+			for _, segment := range segments {
+				segmentIDs.Remove(segment.GetID())
+			}
+			return nil, merr.WrapErrSegmentNotFound(segmentIDs.Collect()[0], "segment to clone is not flushed")
+		}
+		return segments, nil
+	}
+
+	sealed, err := s.segmentManager.SealAllSegments(ctx, req.GetCollectionID(), nil, false)
+	if err != nil {
+		return nil, err
+	}
+	log.Ctx(ctx).Info("sealed the segments to clone", zap.Int64("collectionID", req.GetCollectionID()), zap.Int64s("segmentIDs", sealed))
+	return s.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return segment.GetCollectionID() == req.GetCollectionID() &&
+			(segment.GetState() == commonpb.SegmentState_Flushed ||
+				segment.GetState() == commonpb.SegmentState_Flushing ||
+				segment.GetState() == commonpb.SegmentState_Sealed) &&
+			!segment.GetIsImporting()
+	}), nil
+}
+
+// GetCloneSegmentsState returns the progress of cloning the segments into the target collection.
+func (s *Server) GetCloneSegmentsState(ctx context.Context, req *datapb.GetCloneSegmentsStateRequest) (*datapb.GetCloneSegmentsStateResponse, error) {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", req.GetCollectionID()))
+	if s.isClosed() {
+		log.Warn("failed to get clone state on closed server")
+		return &datapb.GetCloneSegmentsStateResponse{
+			Status: merr.Status(merr.WrapErrServiceUnavailable("Datacoord not ready")),
+		}, nil
+	}
+	return s.cloneJobs.getState(req.GetCollectionID()), nil
+}
+
+// cloneIndexes creates the indexes of the target collection, the same as the source collection.
+func (s *Server) cloneIndexes(ctx context.Context, req *datapb.CloneSegmentsRequest) error {
+	skipFieldIDs := typeutil.NewUniqueSet(req.GetSkipIndexFieldIDs()...)
+	for _, index := range s.meta.GetIndexesForCollection(req.GetCollectionID(), "") {
+		if skipFieldIDs.Contain(index.FieldID) {
+			continue
+		}
+		if len(s.meta.GetIndexesForCollection(req.GetTargetCollectionID(), index.IndexName)) > 0 {
+			continue
+		}
+		indexID, err := s.allocator.allocID(ctx)
+		if err != nil {
+			return err
+		}
+		index.CollectionID = req.GetTargetCollectionID()
+		index.IndexID = indexID
+		index.CreateTime = req.GetBase().GetTimestamp()
+		if err := s.meta.CreateIndex(index); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cloneSegment copies the binlogs of the segment to a new segment of the given ID in the target collection,
+// and adds the new segment to the DataNode watching the target channel.
+func (s *Server) cloneSegment(ctx context.Context, segment *SegmentInfo, req *datapb.CloneSegmentsRequest, segmentID UniqueID) error {
+	partitionID, ok := req.GetPartitionIDs()[segment.GetPartitionID()]
+	if !ok {
+		return merr.WrapErrPartitionNotFound(segment.GetPartitionID(), "no target partition to clone into")
+	}
+	channel, ok := req.GetChannels()[segment.GetInsertChannel()]
+	if !ok {
+		return merr.WrapErrChannelNotFound(segment.GetInsertChannel(), "no target channel to clone into")
+	}
+	var err error
+
+	cloned := &datapb.SegmentInfo{
+		ID:             segmentID,
+		CollectionID:   req.GetTargetCollectionID(),
+		PartitionID:    partitionID,
+		InsertChannel:  channel,
+		NumOfRows:      segment.GetNumOfRows(),
+		State:          commonpb.SegmentState_Flushed,
+		MaxRowNum:      segment.GetMaxRowNum(),
+		LastExpireTime: req.GetBase().GetTimestamp(),
+	}
+	cloneLogs := func(logType string, fieldBinlogs []*datapb.FieldBinlog) ([]*datapb.FieldBinlog, error) {
+		rootPath := s.meta.chunkManager.RootPath()
+		srcPrefix := path.Join(rootPath, logType, metautil.JoinIDPath(segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID()))
+		dstPrefix := path.Join(rootPath, logType, metautil.JoinIDPath(cloned.GetCollectionID(), cloned.GetPartitionID(), cloned.GetID()))
+		ret := make([]*datapb.FieldBinlog, 0, len(fieldBinlogs))
+		for _, fieldBinlog := range fieldBinlogs {
+			fieldBinlog = proto.Clone(fieldBinlog).(*datapb.FieldBinlog)
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				if !strings.HasPrefix(binlog.GetLogPath(), srcPrefix) {
+					return nil, fmt.Errorf("unexpected log path %s of segment %d", binlog.GetLogPath(), segment.GetID())
+				}
+				logPath := dstPrefix + strings.TrimPrefix(binlog.GetLogPath(), srcPrefix)
+				if err := storage.CopyFile(ctx, s.meta.chunkManager, binlog.GetLogPath(), logPath); err != nil {
+					return nil, err
+				}
+				binlog.LogPath = logPath
+			}
+			ret = append(ret, fieldBinlog)
+		}
+		return ret, nil
+	}
+	if cloned.Binlogs, err = cloneLogs(common.SegmentInsertLogPath, segment.GetBinlogs()); err != nil {
+		return err
+	}
+	if cloned.Statslogs, err = cloneLogs(common.SegmentStatslogPath, segment.GetStatslogs()); err != nil {
+		return err
+	}
+	if cloned.Deltalogs, err = cloneLogs(common.SegmentDeltaLogPath, segment.GetDeltalogs()); err != nil {
+		return err
+	}
+
+	return s.addCopiedSegment(ctx, cloned, req.GetBase().GetTimestamp())
+}
+
+// addCopiedSegment adds the flushed segment whose binlogs are copied in place to the DataNode watching its channel,
+// so that the DataNode applies the following deletes to it, then adds it into meta and builds its indexes.
+func (s *Server) addCopiedSegment(ctx context.Context, segment *datapb.SegmentInfo, ts Timestamp) error {
+	channel := segment.GetInsertChannel()
+	ok, nodeID := s.channelManager.getNodeIDByChannelName(channel)
+	if !ok {
+		return merr.WrapErrChannelNotFound(channel, "no DataNode watching the channel")
+	}
+	cli, err := s.sessionManager.getClient(ctx, nodeID)
+	if err != nil {
+		return err
+	}
+	resp, err := cli.AddImportSegment(ctx, &datapb.AddImportSegmentRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithTimeStamp(ts),
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		SegmentId:    segment.GetID(),
+		ChannelName:  channel,
+		CollectionId: segment.GetCollectionID(),
+		PartitionId:  segment.GetPartitionID(),
+		RowNum:       segment.GetNumOfRows(),
+		StatsLog:     segment.GetStatslogs(),
+	})
+	if err := VerifyResponse(resp.GetStatus(), err); err != nil {
+		return err
+	}
+	position := &msgpb.MsgPosition{
+		ChannelName: channel,
+		MsgID:       resp.GetChannelPos(),
+		Timestamp:   ts,
+	}
+	segment.StartPosition = position
+	segment.DmlPosition = position
+
+	if err := s.meta.AddSegment(NewSegmentInfo(segment)); err != nil {
+		return err
+	}
+	s.buildIndexCh <- segment.GetID()
+	return nil
+}
+
+// SetMaintenancePolicy pauses/resumes or schedules the compaction and gc globally or of a collection.
+func (s *Server) SetMaintenancePolicy(ctx context.Context, req *datapb.SetMaintenancePolicyRequest) (*commonpb.Status, error) {
+	policy := req.GetPolicy()
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", policy.GetCollectionID()),
+		zap.Bool("compactionPaused", policy.GetCompactionPaused()),
+		zap.Bool("gcPaused", policy.GetGcPaused()),
+		zap.String("compactionSchedule", policy.GetCompactionSchedule()),
+		zap.String("gcSchedule", policy.GetGcSchedule()))
+	if s.isClosed() {
+		log.Warn("failed to set maintenance policy on closed server")
+		return merr.Status(merr.WrapErrServiceUnavailable("Datacoord not ready")), nil
+	}
+	if policy == nil {
+		return merr.Status(merr.WrapErrParameterInvalid("maintenance policy", "nil")), nil
+	}
+
+	if err := s.maintenanceManager.SetPolicy(ctx, policy); err != nil {
+		log.Warn("failed to set maintenance policy", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	log.Info("maintenance policy set")
+	return merr.Status(nil), nil
+}
+
+// ListMaintenancePolicies lists the maintenance policies, the global one is of collection id 0.
+func (s *Server) ListMaintenancePolicies(ctx context.Context, req *datapb.ListMaintenancePoliciesRequest) (*datapb.ListMaintenancePoliciesResponse, error) {
+	if s.isClosed() {
+		log.Ctx(ctx).Warn("failed to list maintenance policies on closed server")
+		return &datapb.ListMaintenancePoliciesResponse{
+			Status: merr.Status(merr.WrapErrServiceUnavailable("Datacoord not ready")),
+		}, nil
+	}
+	return &datapb.ListMaintenancePoliciesResponse{
+		Status:   merr.Status(nil),
+		Policies: s.maintenanceManager.ListPolicies(),
+	}, nil
+}
+
+// CordonDataNode stops assigning new channels to the DataNode, the channels already on it are kept.
+func (s *Server) CordonDataNode(ctx context.Context, req *datapb.CordonDataNodeRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(zap.Int64("nodeID", req.GetNodeID()))
+	if s.isClosed() {
+		log.Warn("failed to cordon datanode on closed server")
+		return merr.Status(merr.WrapErrServiceUnavailable("Datacoord not ready")), nil
+	}
+
+	if err := s.cluster.Cordon(req.GetNodeID()); err != nil {
+		log.Warn("failed to cordon datanode", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	return merr.Status(nil), nil
+}
+
+// UncordonDataNode makes the DataNode schedulable again and stops draining it.
+func (s *Server) UncordonDataNode(ctx context.Context, req *datapb.UncordonDataNodeRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(zap.Int64("nodeID", req.GetNodeID()))
+	if s.isClosed() {
+		log.Warn("failed to uncordon datanode on closed server")
+		return merr.Status(merr.WrapErrServiceUnavailable("Datacoord not ready")), nil
+	}
+
+	if err := s.cluster.Uncordon(req.GetNodeID()); err != nil {
+		log.Warn("failed to uncordon datanode", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	return merr.Status(nil), nil
+}
+
+// DrainDataNode cordons the DataNode and migrates its channels to other DataNodes one by one in background,
+// the DataNode could be stopped safely once GetDataNodeDrainState reports it drained.
+func (s *Server) DrainDataNode(ctx context.Context, req *datapb.DrainDataNodeRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(zap.Int64("nodeID", req.GetNodeID()))
+	if s.isClosed() {
+		log.Warn("failed to drain datanode on closed server")
+		return merr.Status(merr.WrapErrServiceUnavailable("Datacoord not ready")), nil
+	}
+
+	// the drain outlives the request
+	if err := s.cluster.Drain(s.ctx, req.GetNodeID()); err != nil {
+		log.Warn("failed to drain datanode", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	return merr.Status(nil), nil
+}
+
+// GetDataNodeDrainState returns the progress of draining the DataNode.
+func (s *Server) GetDataNodeDrainState(ctx context.Context, req *datapb.GetDataNodeDrainStateRequest) (*datapb.GetDataNodeDrainStateResponse, error) {
+	log := log.Ctx(ctx).With(zap.Int64("nodeID", req.GetNodeID()))
+	if s.isClosed() {
+		log.Warn("failed to get drain state of datanode on closed server")
+		return &datapb.GetDataNodeDrainStateResponse{
+			Status: merr.Status(merr.WrapErrServiceUnavailable("Datacoord not ready")),
+		}, nil
+	}
+
+	state, cordoned, remaining, reason := s.channelManager.GetDrainState(req.GetNodeID())
+	return &datapb.GetDataNodeDrainStateResponse{
+		Status:            merr.Status(nil),
+		State:             state,
+		Cordoned:          cordoned,
+		RemainingChannels: int64(remaining),
+		Reason:            reason,
+	}, nil
+}
+
+// RotateEncryptionKey switches an encrypted collection to a new key version.
+// New segments are written with the new key at once, the existing ones are re-encrypted lazily by compaction.
+func (s *Server) RotateEncryptionKey(ctx context.Context, req *datapb.RotateEncryptionKeyRequest) (*datapb.RotateEncryptionKeyResponse, error) {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", req.GetCollectionID()))
+	if s.isClosed() {
+		log.Warn("failed to rotate encryption key on closed server")
+		return &datapb.RotateEncryptionKeyResponse{
+			Status: merr.Status(merr.WrapErrServiceUnavailable("Datacoord not ready")),
+		}, nil
+	}
+
+	if _, err := s.handler.GetCollection(ctx, req.GetCollectionID()); err != nil {
+		log.Warn("failed to get collection", zap.Error(err))
+		return &datapb.RotateEncryptionKeyResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	keyVersion, err := s.meta.RotateEncryptionKey(ctx, req.GetCollectionID())
+	if err != nil {
+		log.Warn("failed to rotate encryption key", zap.Error(err))
+		return &datapb.RotateEncryptionKeyResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	log.Info("encryption key rotated", zap.Int64("keyVersion", keyVersion))
+	return &datapb.RotateEncryptionKeyResponse{
+		Status:     merr.Status(nil),
+		KeyVersion: keyVersion,
+	}, nil
+}
+
+// GetEncryptionStatus returns the current key version of a collection, and the percentage of its rows encrypted with it.
+func (s *Server) GetEncryptionStatus(ctx context.Context, req *datapb.GetEncryptionStatusRequest) (*datapb.GetEncryptionStatusResponse, error) {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", req.GetCollectionID()))
+	if s.isClosed() {
+		log.Warn("failed to get encryption status on closed server")
+		return &datapb.GetEncryptionStatusResponse{
+			Status: merr.Status(merr.WrapErrServiceUnavailable("Datacoord not ready")),
+		}, nil
+	}
+
+	if _, err := s.handler.GetCollection(ctx, req.GetCollectionID()); err != nil {
+		log.Warn("failed to get collection", zap.Error(err))
+		return &datapb.GetEncryptionStatusResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	status := s.meta.GetEncryptionStatus(req.GetCollectionID())
+	return &datapb.GetEncryptionStatusResponse{
+		Status:           merr.Status(nil),
+		Enabled:          status.enabled,
+		KeyVersion:       status.keyVersion,
+		TotalRows:        status.totalRows,
+		RowsOnCurrentKey: status.rowsOnCurrentKey,
+		Progress:         status.progress(),
+	}, nil
+}
+
+// ReplicateBinlog writes a binlog shipped from the primary cluster into the staging area,
+// the binlog is moved to the replica segment once the segment is replicated.
+func (s *Server) ReplicateBinlog(ctx context.Context, req *datapb.ReplicateBinlogRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(zap.String("logPath", req.GetLogPath()))
+	if s.isClosed() {
+		log.Warn("failed to replicate binlog on closed server")
+		return merr.Status(merr.WrapErrServiceUnavailable("Datacoord not ready")), nil
+	}
+
+	stagingPath, err := replicationStagingPath(s.meta.chunkManager.RootPath(), req.GetLogPath())
+	if err != nil {
+		return merr.Status(err), nil
+	}
+	if err := s.meta.chunkManager.Write(ctx, stagingPath, req.GetData()); err != nil {
+		log.Warn("failed to write replicated binlog", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	return merr.Status(nil), nil
+}
+
+// ReplicateSegment adds the replica of a segment shipped from the primary cluster, whose binlogs are replicated already.
+// The new deltalogs are appended if the segment is replicated before,
+// and the replicas of the segments it's compacted from are dropped.
+func (s *Server) ReplicateSegment(ctx context.Context, req *datapb.ReplicateSegmentRequest) (*datapb.ReplicateSegmentResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.String("dbName", req.GetDbName()),
+		zap.String("collectionName", req.GetCollectionName()),
+		zap.Int64("sourceSegmentID", req.GetSegment().GetID()),
+	)
+	if s.isClosed() {
+		log.Warn("failed to replicate segment on closed server")
+		return &datapb.ReplicateSegmentResponse{
+			Status: merr.Status(merr.WrapErrServiceUnavailable("Datacoord not ready")),
+		}, nil
+	}
+	if err := s.metaStoreMonitor.CheckWritable(); err != nil {
+		return &datapb.ReplicateSegmentResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	if req.GetSegment() == nil {
+		return &datapb.ReplicateSegmentResponse{
+			Status: merr.Status(merr.WrapErrParameterInvalid("segment", "nil")),
+		}, nil
+	}
+
+	segmentID, err := s.replicateSegment(ctx, req)
+	if err != nil {
+		log.Warn("failed to replicate segment", zap.Error(err))
+		return &datapb.ReplicateSegmentResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	log.Info("segment replicated", zap.Int64("segmentID", segmentID))
+	return &datapb.ReplicateSegmentResponse{
+		Status:    merr.Status(nil),
+		SegmentID: segmentID,
+	}, nil
+}
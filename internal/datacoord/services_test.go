@@ -2,21 +2,32 @@ package datacoord
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"math"
+	"path"
 	"testing"
+	"time"
 
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	clientv3 "go.etcd.io/etcd/client/v3"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/mocks"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/configutil"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metautil"
 )
 
@@ -97,6 +108,1068 @@ func TestServer_GcConfirm(t *testing.T) {
 	})
 }
 
+func TestServer_GcDryRun(t *testing.T) {
+	t.Run("closed server", func(t *testing.T) {
+		s := &Server{}
+		s.stateCode.Store(commonpb.StateCode_Initializing)
+		resp, err := s.GcDryRun(context.TODO(), &datapb.GcDryRunRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrServiceUnavailable)
+	})
+
+	t.Run("storage not provided", func(t *testing.T) {
+		s := &Server{garbageCollector: &garbageCollector{}}
+		s.stateCode.Store(commonpb.StateCode_Healthy)
+		resp, err := s.GcDryRun(context.TODO(), &datapb.GcDryRunRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrServiceUnavailable)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		m, err := newMemoryMeta()
+		require.NoError(t, err)
+		cm := mocks.NewChunkManager(t)
+		cm.EXPECT().RootPath().Return("root")
+		cm.EXPECT().ListWithPrefix(mock.Anything, mock.Anything, true).Return(nil, nil, nil)
+
+		s := &Server{garbageCollector: newGarbageCollector(m, newMockHandler(), GcOption{cli: cm})}
+		s.stateCode.Store(commonpb.StateCode_Healthy)
+		resp, err := s.GcDryRun(context.TODO(), &datapb.GcDryRunRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Empty(t, resp.GetOrphanFiles())
+		assert.Empty(t, resp.GetDroppedSegments())
+	})
+}
+
+func TestServer_PauseGC(t *testing.T) {
+	t.Run("closed server", func(t *testing.T) {
+		s := &Server{}
+		s.stateCode.Store(commonpb.StateCode_Initializing)
+		status, err := s.PauseGC(context.TODO(), &datapb.PauseGCRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(status), merr.ErrServiceUnavailable)
+		status, err = s.ResumeGC(context.TODO(), &datapb.ResumeGCRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(status), merr.ErrServiceUnavailable)
+		resp, err := s.GetGCStatus(context.TODO(), &datapb.GetGCStatusRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrServiceUnavailable)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		m, err := newMemoryMeta()
+		require.NoError(t, err)
+		catalog := mocks.NewDataCoordCatalog(t)
+		catalog.EXPECT().ListMaintenancePolicies(mock.Anything).Return(nil, nil)
+		catalog.EXPECT().SaveMaintenancePolicy(mock.Anything, mock.Anything).Return(nil)
+		catalog.EXPECT().DropMaintenancePolicy(mock.Anything, mock.Anything).Return(nil)
+		maintenance, err := newMaintenanceManager(context.TODO(), catalog)
+		require.NoError(t, err)
+		s := &Server{
+			garbageCollector:   newGarbageCollector(m, newMockHandler(), GcOption{maintenance: maintenance}),
+			maintenanceManager: maintenance,
+		}
+		s.stateCode.Store(commonpb.StateCode_Healthy)
+
+		status, err := s.PauseGC(context.TODO(), &datapb.PauseGCRequest{PauseSeconds: -1})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(status), merr.ErrParameterInvalid)
+
+		status, err = s.PauseGC(context.TODO(), &datapb.PauseGCRequest{PauseSeconds: 60})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+		resp, err := s.GetGCStatus(context.TODO(), &datapb.GetGCStatusRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.True(t, resp.GetPaused())
+		assert.NotZero(t, resp.GetResumeTime())
+		// the pausing is persisted in the global policy
+		policies := maintenance.ListPolicies()
+		assert.Len(t, policies, 1)
+		assert.True(t, policies[0].GetGcPaused())
+
+		status, err = s.ResumeGC(context.TODO(), &datapb.ResumeGCRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+		resp, err = s.GetGCStatus(context.TODO(), &datapb.GetGCStatusRequest{})
+		assert.NoError(t, err)
+		assert.False(t, resp.GetPaused())
+	})
+}
+
+func TestServer_DropSegmentsByTimeRange(t *testing.T) {
+	t.Run("closed server", func(t *testing.T) {
+		s := &Server{}
+		s.stateCode.Store(commonpb.StateCode_Initializing)
+		resp, err := s.DropSegmentsByTimeRange(context.TODO(), &datapb.DropSegmentsByTimeRangeRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrServiceUnavailable)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		m, err := newMemoryMeta()
+		require.NoError(t, err)
+		s := &Server{meta: m}
+		s.stateCode.Store(commonpb.StateCode_Healthy)
+
+		addSegment := func(id, partitionID int64, state commonpb.SegmentState, tsFrom, tsTo uint64) {
+			segment := &datapb.SegmentInfo{
+				ID:           id,
+				CollectionID: 1,
+				PartitionID:  partitionID,
+				State:        state,
+			}
+			if tsTo > 0 {
+				segment.NumOfRows = 100
+				segment.Binlogs = []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []*datapb.Binlog{
+					{EntriesNum: 50, TimestampFrom: tsFrom, TimestampTo: (tsFrom + tsTo) / 2},
+					{EntriesNum: 50, TimestampFrom: (tsFrom + tsTo) / 2, TimestampTo: tsTo},
+				}}}
+			}
+			require.NoError(t, m.AddSegment(NewSegmentInfo(segment)))
+		}
+		addSegment(1, 10, commonpb.SegmentState_Flushed, 100, 200)
+		addSegment(2, 10, commonpb.SegmentState_Flushed, 250, 400)
+		addSegment(3, 11, commonpb.SegmentState_Flushed, 100, 200)
+		addSegment(4, 10, commonpb.SegmentState_Growing, 100, 200)
+		addSegment(5, 10, commonpb.SegmentState_Flushed, 0, 0)
+		addSegment(6, 10, commonpb.SegmentState_Flushed, 100, 200)
+		m.SetSegmentCompacting(6, true)
+
+		resp, err := s.DropSegmentsByTimeRange(context.TODO(), &datapb.DropSegmentsByTimeRangeRequest{
+			CollectionID: 1,
+			PartitionID:  10,
+			StartTs:      300,
+			EndTs:        100,
+		})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrParameterInvalid)
+
+		resp, err = s.DropSegmentsByTimeRange(context.TODO(), &datapb.DropSegmentsByTimeRangeRequest{
+			CollectionID: 1,
+			PartitionID:  10,
+			StartTs:      100,
+			EndTs:        300,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, []int64{1}, resp.GetSegmentIDs())
+		assert.EqualValues(t, 100, resp.GetNumRows())
+		segment := m.GetSegment(1)
+		assert.Equal(t, commonpb.SegmentState_Dropped, segment.GetState())
+		assert.NotZero(t, segment.GetDroppedAt())
+
+		// all partitions
+		resp, err = s.DropSegmentsByTimeRange(context.TODO(), &datapb.DropSegmentsByTimeRangeRequest{
+			CollectionID: 1,
+			PartitionID:  -1,
+			StartTs:      100,
+			EndTs:        300,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{3}, resp.GetSegmentIDs())
+		for _, id := range []int64{2, 4, 5, 6} {
+			assert.NotNil(t, m.GetHealthySegment(id))
+		}
+	})
+}
+
+func TestServer_GetChannelWatchHistory(t *testing.T) {
+	t.Run("closed server", func(t *testing.T) {
+		s := &Server{}
+		s.stateCode.Store(commonpb.StateCode_Initializing)
+		resp, err := s.GetChannelWatchHistory(context.TODO(), &datapb.GetChannelWatchHistoryRequest{ChannelName: "ch1"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		resp, err := svr.GetChannelWatchHistory(context.TODO(), &datapb.GetChannelWatchHistoryRequest{ChannelName: "ch1"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+
+		err = svr.channelManager.Watch(&channel{Name: "ch1", CollectionID: 0})
+		assert.NoError(t, err)
+
+		resp, err = svr.GetChannelWatchHistory(context.TODO(), &datapb.GetChannelWatchHistoryRequest{ChannelName: "ch1"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, 1, len(resp.GetHistory()))
+		assert.Equal(t, datapb.ChannelWatchState_ToWatch, resp.GetHistory()[0].GetState())
+		assert.Equal(t, assignPolicyName, resp.GetHistory()[0].GetPolicy())
+	})
+}
+
+func TestServer_GetChannelWatchStates(t *testing.T) {
+	t.Run("closed server", func(t *testing.T) {
+		s := &Server{}
+		s.stateCode.Store(commonpb.StateCode_Initializing)
+		resp, err := s.GetChannelWatchStates(context.TODO(), &datapb.GetChannelWatchStatesRequest{CollectionID: 1})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		resp, err := svr.GetChannelWatchStates(context.TODO(), &datapb.GetChannelWatchStatesRequest{CollectionID: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Empty(t, resp.GetStates())
+
+		err = svr.channelManager.Watch(&channel{Name: "ch1", CollectionID: 1})
+		assert.NoError(t, err)
+
+		resp, err = svr.GetChannelWatchStates(context.TODO(), &datapb.GetChannelWatchStatesRequest{CollectionID: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		require.Equal(t, 1, len(resp.GetStates()))
+		assert.Equal(t, "ch1", resp.GetStates()[0].GetChannelName())
+		assert.Equal(t, datapb.ChannelWatchState_ToWatch, resp.GetStates()[0].GetState())
+	})
+}
+
+func TestServer_RetryQuarantinedChannels(t *testing.T) {
+	t.Run("closed server", func(t *testing.T) {
+		s := &Server{}
+		s.stateCode.Store(commonpb.StateCode_Initializing)
+		resp, err := s.RetryQuarantinedChannels(context.TODO(), &datapb.RetryQuarantinedChannelsRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		// nothing quarantined
+		resp, err := svr.RetryQuarantinedChannels(context.TODO(), &datapb.RetryQuarantinedChannelsRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Empty(t, resp.GetChannelNames())
+
+		resp, err = svr.RetryQuarantinedChannels(context.TODO(), &datapb.RetryQuarantinedChannelsRequest{
+			ChannelNames: []string{"ch1"},
+		})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrChannelNotFound)
+	})
+}
+
+func TestServer_ReCollectSegmentStats(t *testing.T) {
+	t.Run("closed server", func(t *testing.T) {
+		s := &Server{}
+		s.stateCode.Store(commonpb.StateCode_Initializing)
+		resp, err := s.ReCollectSegmentStats(context.TODO(), &datapb.ReCollectSegmentStatsRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		resp, err := svr.ReCollectSegmentStats(context.TODO(), &datapb.ReCollectSegmentStatsRequest{CollectionID: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Empty(t, resp.GetResults())
+
+		// the channel isn't watched by any DataNode
+		resp, err = svr.ReCollectSegmentStats(context.TODO(), &datapb.ReCollectSegmentStatsRequest{ChannelName: "ch1"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+}
+
+func TestServer_GetShardWriteStats(t *testing.T) {
+	t.Run("closed server", func(t *testing.T) {
+		s := &Server{}
+		s.stateCode.Store(commonpb.StateCode_Initializing)
+		resp, err := s.GetShardWriteStats(context.TODO(), &datapb.GetShardWriteStatsRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		// no channel watched
+		resp, err := svr.GetShardWriteStats(context.TODO(), &datapb.GetShardWriteStatsRequest{CollectionID: 1})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+
+		require.NoError(t, svr.channelManager.Watch(&channel{Name: "ch2", CollectionID: 1}))
+		require.NoError(t, svr.channelManager.Watch(&channel{Name: "ch1", CollectionID: 1}))
+		require.NoError(t, svr.channelManager.Watch(&channel{Name: "ch3", CollectionID: 2}))
+		resp, err = svr.GetShardWriteStats(context.TODO(), &datapb.GetShardWriteStatsRequest{CollectionID: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.NotZero(t, resp.GetWindowSeconds())
+		require.Len(t, resp.GetShards(), 2)
+		assert.Equal(t, "ch1", resp.GetShards()[0].GetChannelName())
+		assert.Equal(t, "ch2", resp.GetShards()[1].GetChannelName())
+		// no DataNode watches the channels
+		assert.False(t, resp.GetShards()[0].GetAvailable())
+	})
+}
+
+func TestServer_FlushAndSeal(t *testing.T) {
+	t.Run("closed server", func(t *testing.T) {
+		s := &Server{}
+		s.stateCode.Store(commonpb.StateCode_Initializing)
+		resp, err := s.FlushAndSeal(context.TODO(), &datapb.FlushAndSealRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		flushBarrierCheckInterval = 10 * time.Millisecond
+		defer func() { flushBarrierCheckInterval = 500 * time.Millisecond }()
+
+		// barrier in the future
+		resp, err := svr.FlushAndSeal(context.TODO(), &datapb.FlushAndSealRequest{CollectionID: 1, BarrierTs: math.MaxUint64})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrParameterInvalid)
+
+		addSegment := func(id UniqueID, state commonpb.SegmentState, startTs Timestamp, rows int64) {
+			err := svr.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+				ID:            id,
+				CollectionID:  1,
+				InsertChannel: "vchan1",
+				State:         state,
+				NumOfRows:     rows,
+				StartPosition: &msgpb.MsgPosition{ChannelName: "vchan1", Timestamp: startTs},
+			}))
+			require.NoError(t, err)
+		}
+		addSegment(1, commonpb.SegmentState_Growing, 10, 10)
+		addSegment(2, commonpb.SegmentState_Flushed, 20, 10)
+		// after the barrier
+		addSegment(3, commonpb.SegmentState_Growing, 200, 10)
+		// empty
+		addSegment(4, commonpb.SegmentState_Growing, 30, 0)
+
+		// the channel is not consumed up to the barrier
+		ctx, cancel := context.WithTimeout(context.TODO(), 100*time.Millisecond)
+		defer cancel()
+		resp, err = svr.FlushAndSeal(ctx, &datapb.FlushAndSealRequest{CollectionID: 1, BarrierTs: 100})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, commonpb.SegmentState_Growing, svr.meta.GetSegment(1).GetState())
+
+		// the segments before the barrier are sealed, but not flushed yet
+		require.NoError(t, svr.meta.UpdateChannelCheckpoint("vchan1", &msgpb.MsgPosition{ChannelName: "vchan1", Timestamp: 100}))
+		ctx, cancel = context.WithTimeout(context.TODO(), 100*time.Millisecond)
+		defer cancel()
+		resp, err = svr.FlushAndSeal(ctx, &datapb.FlushAndSealRequest{CollectionID: 1, BarrierTs: 100})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, commonpb.SegmentState_Sealed, svr.meta.GetSegment(1).GetState())
+		assert.Equal(t, commonpb.SegmentState_Growing, svr.meta.GetSegment(3).GetState())
+		assert.Equal(t, commonpb.SegmentState_Growing, svr.meta.GetSegment(4).GetState())
+
+		require.NoError(t, svr.meta.SetState(1, commonpb.SegmentState_Flushed))
+		resp, err = svr.FlushAndSeal(context.TODO(), &datapb.FlushAndSealRequest{CollectionID: 1, BarrierTs: 100})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.EqualValues(t, 100, resp.GetBarrierTs())
+		assert.ElementsMatch(t, []int64{1, 2}, resp.GetSegmentIDs())
+		require.Len(t, resp.GetChannelCheckpoints(), 1)
+		assert.Equal(t, "vchan1", resp.GetChannelCheckpoints()[0].GetChannelName())
+	})
+}
+
+func TestServer_GetAuditEvents(t *testing.T) {
+	t.Run("closed server", func(t *testing.T) {
+		s := &Server{}
+		s.stateCode.Store(commonpb.StateCode_Initializing)
+		resp, err := s.GetAuditEvents(context.TODO(), &datapb.GetAuditEventsRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		err := svr.channelManager.Watch(&channel{Name: "ch1", CollectionID: 1})
+		require.NoError(t, err)
+		resp, err := svr.GetAuditEvents(context.TODO(), &datapb.GetAuditEventsRequest{
+			Types:        []datapb.AuditEventType{datapb.AuditEventType_ChannelAssigned},
+			CollectionID: 1,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		require.NotEmpty(t, resp.GetEvents())
+		assert.Equal(t, "ch1", resp.GetEvents()[len(resp.GetEvents())-1].GetChannelName())
+	})
+}
+
+func TestServer_GetTopologySnapshot(t *testing.T) {
+	t.Run("closed server", func(t *testing.T) {
+		s := &Server{}
+		s.stateCode.Store(commonpb.StateCode_Initializing)
+		resp, err := s.GetTopologySnapshot(context.TODO(), &datapb.GetTopologySnapshotRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		err := svr.channelManager.Watch(&channel{Name: "ch1", CollectionID: 1})
+		require.NoError(t, err)
+		err = svr.meta.AddSegment(buildSegment(1, 10, 1000, "ch1", false))
+		require.NoError(t, err)
+		err = svr.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+			ID:            1001,
+			CollectionID:  1,
+			PartitionID:   10,
+			InsertChannel: "ch1",
+			State:         commonpb.SegmentState_Dropped,
+		}))
+		require.NoError(t, err)
+
+		resp, err := svr.GetTopologySnapshot(context.TODO(), &datapb.GetTopologySnapshotRequest{})
+		assert.NoError(t, err)
+		require.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+
+		snapshot := &TopologySnapshot{}
+		require.NoError(t, json.Unmarshal([]byte(resp.GetSnapshot()), snapshot))
+		channels := snapshot.BufferChannels
+		for _, node := range snapshot.DataNodes {
+			channels = append(channels, node.Channels...)
+		}
+		require.Equal(t, 1, len(channels))
+		assert.Equal(t, "ch1", channels[0].Name)
+		assert.EqualValues(t, 1, channels[0].CollectionID)
+		assert.Equal(t, datapb.ChannelWatchState_ToWatch.String(), channels[0].WatchState)
+		// the dropped segments are not counted
+		assert.Equal(t, map[string]int{commonpb.SegmentState_Growing.String(): 1}, channels[0].SegmentCounts)
+	})
+}
+
+func TestBuildTopologySnapshot(t *testing.T) {
+	sessions := []*Session{
+		NewSession(&NodeInfo{NodeID: 2, Address: "dn2"}, nil),
+		NewSession(&NodeInfo{NodeID: 1, Address: "dn1", Labels: map[string]string{"zone": "az1"}}, nil),
+	}
+	states := []*datapb.ChannelWatchStateInfo{
+		{ChannelName: "ch1", CollectionID: 100, NodeID: 1, State: datapb.ChannelWatchState_WatchSuccess},
+		{ChannelName: "ch2", CollectionID: 100, NodeID: 0, State: datapb.ChannelWatchState_ToWatch},
+		{ChannelName: "ch3", CollectionID: 100, NodeID: 1, State: datapb.ChannelWatchState_ToRelease},
+	}
+	segments := []*SegmentInfo{
+		buildSegment(100, 10, 1, "ch1", false),
+		buildSegment(100, 10, 2, "ch3", false),
+		NewSegmentInfo(&datapb.SegmentInfo{ID: 3, CollectionID: 100, InsertChannel: "ch1", State: commonpb.SegmentState_Flushed}),
+	}
+	snapshot := buildTopologySnapshot(sessions, states, segments, func(nodeID int64) bool { return nodeID == 2 })
+
+	require.Equal(t, 2, len(snapshot.DataNodes))
+	node1, node2 := snapshot.DataNodes[0], snapshot.DataNodes[1]
+	assert.EqualValues(t, 1, node1.NodeID)
+	assert.Equal(t, "dn1", node1.Address)
+	assert.Equal(t, "az1", node1.Labels["zone"])
+	assert.False(t, node1.Cordoned)
+	assert.Equal(t, 3, node1.SegmentCount)
+	require.Equal(t, 2, len(node1.Channels))
+	assert.Equal(t, "ch1", node1.Channels[0].Name)
+	assert.Equal(t, map[string]int{"Growing": 1, "Flushed": 1}, node1.Channels[0].SegmentCounts)
+	assert.Equal(t, "ch3", node1.Channels[1].Name)
+
+	assert.EqualValues(t, 2, node2.NodeID)
+	assert.True(t, node2.Cordoned)
+	assert.Empty(t, node2.Channels)
+
+	require.Equal(t, 1, len(snapshot.BufferChannels))
+	assert.Equal(t, "ch2", snapshot.BufferChannels[0].Name)
+	assert.Equal(t, "ToWatch", snapshot.BufferChannels[0].WatchState)
+}
+
+func TestServer_NodeConfigs(t *testing.T) {
+	t.Run("closed server", func(t *testing.T) {
+		s := &Server{}
+		s.stateCode.Store(commonpb.StateCode_Initializing)
+		status, err := s.SetNodeConfigs(context.TODO(), &datapb.SetNodeConfigsRequest{NodeID: 1})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+		resp, err := s.ListNodeConfigs(context.TODO(), &datapb.ListNodeConfigsRequest{NodeID: 1})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+
+		status, err = s.ClearNodeConfigs(context.TODO(), &datapb.ClearNodeConfigsRequest{NodeID: 1})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		s := &Server{}
+		s.stateCode.Store(commonpb.StateCode_Healthy)
+		s.nodeConfigManager = configutil.NewNodeConfigManager(memkv.NewMemoryKV())
+
+		status, err := s.SetNodeConfigs(context.TODO(), &datapb.SetNodeConfigsRequest{
+			NodeID: 1,
+			Configs: []*commonpb.KeyValuePair{
+				{Key: "dataNode.compaction.concurrency", Value: "1"},
+				{Key: "dataNode.dataSync.maxParallel", Value: "2"},
+			},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+		resp, err := s.ListNodeConfigs(context.TODO(), &datapb.ListNodeConfigsRequest{NodeID: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, 2, len(resp.GetConfigs()))
+
+		status, err = s.ClearNodeConfigs(context.TODO(), &datapb.ClearNodeConfigsRequest{NodeID: 1, Keys: []string{"dataNode.dataSync.maxParallel"}})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+		resp, err = s.ListNodeConfigs(context.TODO(), &datapb.ListNodeConfigsRequest{NodeID: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, []*commonpb.KeyValuePair{{Key: "dataNode.compaction.concurrency", Value: "1"}}, resp.GetConfigs())
+
+		status, err = s.SetNodeConfigs(context.TODO(), &datapb.SetNodeConfigsRequest{
+			NodeID:  1,
+			Configs: []*commonpb.KeyValuePair{{Key: "", Value: "1"}},
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	})
+}
+
+func TestServer_CordonAndDrainDataNode(t *testing.T) {
+	t.Run("closed server", func(t *testing.T) {
+		s := &Server{}
+		s.stateCode.Store(commonpb.StateCode_Initializing)
+		status, err := s.CordonDataNode(context.TODO(), &datapb.CordonDataNodeRequest{NodeID: 1})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+		status, err = s.DrainDataNode(context.TODO(), &datapb.DrainDataNodeRequest{NodeID: 1})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+		status, err = s.UncordonDataNode(context.TODO(), &datapb.UncordonDataNodeRequest{NodeID: 1})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+		resp, err := s.GetDataNodeDrainState(context.TODO(), &datapb.GetDataNodeDrainStateRequest{NodeID: 1})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		watchkv := getWatchKV(t)
+		defer func() {
+			watchkv.RemoveWithPrefix("")
+			watchkv.Close()
+		}()
+		catalog := mocks.NewDataCoordCatalog(t)
+		catalog.EXPECT().ListCordonedNodes(mock.Anything).Return(nil, nil)
+		catalog.EXPECT().SaveCordonedNode(mock.Anything, int64(1)).Return(nil)
+		catalog.EXPECT().DropCordonedNode(mock.Anything, int64(1)).Return(nil)
+		chManager, err := NewChannelManager(watchkv, newMockHandler(), withCatalog(catalog))
+		require.NoError(t, err)
+		chManager.store.Add(1)
+
+		s := &Server{ctx: context.TODO(), cluster: NewCluster(NewSessionManager(), chManager), channelManager: chManager}
+		s.stateCode.Store(commonpb.StateCode_Healthy)
+
+		status, err := s.CordonDataNode(context.TODO(), &datapb.CordonDataNodeRequest{NodeID: 2})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+		status, err = s.CordonDataNode(context.TODO(), &datapb.CordonDataNodeRequest{NodeID: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+		assert.True(t, chManager.IsCordoned(1))
+
+		// no channel to migrate
+		status, err = s.DrainDataNode(context.TODO(), &datapb.DrainDataNodeRequest{NodeID: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+		assert.Eventually(t, func() bool {
+			resp, err := s.GetDataNodeDrainState(context.TODO(), &datapb.GetDataNodeDrainStateRequest{NodeID: 1})
+			return err == nil && resp.GetState() == datapb.DrainState_Drained && resp.GetCordoned()
+		}, time.Second, 10*time.Millisecond)
+
+		status, err = s.UncordonDataNode(context.TODO(), &datapb.UncordonDataNodeRequest{NodeID: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+		resp, err := s.GetDataNodeDrainState(context.TODO(), &datapb.GetDataNodeDrainStateRequest{NodeID: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, datapb.DrainState_DrainNone, resp.GetState())
+		assert.False(t, resp.GetCordoned())
+	})
+}
+
+func TestServer_MaintenancePolicies(t *testing.T) {
+	t.Run("closed server", func(t *testing.T) {
+		s := &Server{}
+		s.stateCode.Store(commonpb.StateCode_Initializing)
+		status, err := s.SetMaintenancePolicy(context.TODO(), &datapb.SetMaintenancePolicyRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+		resp, err := s.ListMaintenancePolicies(context.TODO(), &datapb.ListMaintenancePoliciesRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		var err error
+		s := &Server{}
+		s.stateCode.Store(commonpb.StateCode_Healthy)
+		s.maintenanceManager, err = newMaintenanceManager(context.TODO(), datacoord.NewCatalog(NewMetaMemoryKV(), "", ""))
+		require.NoError(t, err)
+
+		status, err := s.SetMaintenancePolicy(context.TODO(), &datapb.SetMaintenancePolicyRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+		status, err = s.SetMaintenancePolicy(context.TODO(), &datapb.SetMaintenancePolicyRequest{
+			Policy: &datapb.MaintenancePolicy{CollectionID: 1, GcSchedule: "Mon 25:00-26:00"},
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+		status, err = s.SetMaintenancePolicy(context.TODO(), &datapb.SetMaintenancePolicyRequest{
+			Policy: &datapb.MaintenancePolicy{CollectionID: 1, CompactionPaused: true, GcSchedule: "Sat-Sun 00:00-24:00"},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+		resp, err := s.ListMaintenancePolicies(context.TODO(), &datapb.ListMaintenancePoliciesRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, 1, len(resp.GetPolicies()))
+		assert.True(t, resp.GetPolicies()[0].GetCompactionPaused())
+
+		// the policy is reloaded from the catalog
+		m, err := newMaintenanceManager(context.TODO(), s.maintenanceManager.catalog)
+		assert.NoError(t, err)
+		assert.False(t, m.CompactionAllowed(1, true))
+
+		Params.Save(Params.DataCoordCfg.EnableCompaction.Key, "true")
+		defer Params.Reset(Params.DataCoordCfg.EnableCompaction.Key)
+		compactionResp, err := s.ManualCompaction(context.TODO(), &milvuspb.ManualCompactionRequest{CollectionID: 1})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, compactionResp.GetStatus().GetErrorCode())
+		assert.Equal(t, "compaction paused", compactionResp.GetStatus().GetReason())
+	})
+}
+
+func TestServer_CloneSegments(t *testing.T) {
+	t.Run("closed server", func(t *testing.T) {
+		s := &Server{}
+		s.stateCode.Store(commonpb.StateCode_Initializing)
+		status, err := s.CloneSegments(context.TODO(), &datapb.CloneSegmentsRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+		resp, err := s.GetCloneSegmentsState(context.TODO(), &datapb.GetCloneSegmentsStateRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	interval := cloneCheckInterval
+	cloneCheckInterval = 10 * time.Millisecond
+	defer func() { cloneCheckInterval = interval }()
+
+	waitCloneState := func(t *testing.T, svr *Server, state datapb.CloneState) *datapb.GetCloneSegmentsStateResponse {
+		var resp *datapb.GetCloneSegmentsStateResponse
+		assert.Eventually(t, func() bool {
+			var err error
+			resp, err = svr.GetCloneSegmentsState(context.TODO(), &datapb.GetCloneSegmentsStateRequest{CollectionID: 200})
+			return err == nil && resp.GetState() == state
+		}, 10*time.Second, 10*time.Millisecond)
+		return resp
+	}
+
+	prepare := func(t *testing.T, svr *Server) (string, string) {
+		ctx := context.Background()
+		cm := svr.meta.chunkManager
+		binlogPath := metautil.BuildInsertLogPath(cm.RootPath(), 100, 10, 1000, 101, 1)
+		deltalogPath := metautil.BuildDeltaLogPath(cm.RootPath(), 100, 10, 1000, 2)
+		require.NoError(t, cm.Write(ctx, binlogPath, []byte("binlog")))
+		require.NoError(t, cm.Write(ctx, deltalogPath, []byte("deltalog")))
+		t.Cleanup(func() {
+			cm.MultiRemove(ctx, []string{binlogPath, deltalogPath})
+		})
+
+		err := svr.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+			ID:            1000,
+			CollectionID:  100,
+			PartitionID:   10,
+			InsertChannel: "ch1",
+			NumOfRows:     10,
+			State:         commonpb.SegmentState_Flushed,
+			Binlogs: []*datapb.FieldBinlog{
+				{FieldID: 101, Binlogs: []*datapb.Binlog{{LogPath: binlogPath, EntriesNum: 10}}},
+			},
+			Deltalogs: []*datapb.FieldBinlog{
+				{Binlogs: []*datapb.Binlog{{LogPath: deltalogPath, EntriesNum: 1}}},
+			},
+		}))
+		require.NoError(t, err)
+		// growing segment isn't cloned
+		err = svr.meta.AddSegment(buildSegment(100, 10, 1001, "ch1", false))
+		require.NoError(t, err)
+		err = svr.meta.CreateIndex(&model.Index{
+			CollectionID: 100,
+			FieldID:      101,
+			IndexID:      1,
+			IndexName:    "idx",
+		})
+		require.NoError(t, err)
+
+		svr.sessionManager.AddSession(&NodeInfo{
+			NodeID:  110,
+			Address: "localhost:8080",
+		})
+		require.NoError(t, svr.channelManager.AddNode(110))
+		require.NoError(t, svr.channelManager.Watch(&channel{Name: "ch2", CollectionID: 200}))
+		return binlogPath, deltalogPath
+	}
+
+	t.Run("normal case", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		prepare(t, svr)
+		// the sealed segment is cloned once flushed
+		sealed := buildSegment(100, 10, 1002, "ch1", false)
+		sealed.State = commonpb.SegmentState_Sealed
+		require.NoError(t, svr.meta.AddSegment(sealed))
+
+		ctx := context.Background()
+		status, err := svr.CloneSegments(ctx, &datapb.CloneSegmentsRequest{
+			Base:               &commonpb.MsgBase{Timestamp: 100},
+			CollectionID:       100,
+			TargetCollectionID: 200,
+			PartitionIDs:       map[int64]int64{10: 20},
+			Channels:           map[string]string{"ch1": "ch2"},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+		resp, err := svr.GetCloneSegmentsState(ctx, &datapb.GetCloneSegmentsStateRequest{CollectionID: 200})
+		assert.NoError(t, err)
+		assert.Equal(t, datapb.CloneState_Cloning, resp.GetState())
+		assert.EqualValues(t, 2, resp.GetTotalSegments())
+		// another clone into the same collection is rejected
+		status, err = svr.CloneSegments(ctx, &datapb.CloneSegmentsRequest{
+			Base:               &commonpb.MsgBase{Timestamp: 100},
+			CollectionID:       100,
+			TargetCollectionID: 200,
+			PartitionIDs:       map[int64]int64{10: 20},
+			Channels:           map[string]string{"ch1": "ch2"},
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+		require.NoError(t, svr.meta.SetState(1002, commonpb.SegmentState_Flushed))
+		resp = waitCloneState(t, svr, datapb.CloneState_CloneCompleted)
+		assert.EqualValues(t, 2, resp.GetClonedSegments())
+
+		cloned := svr.meta.SelectSegments(func(segment *SegmentInfo) bool {
+			return segment.GetCollectionID() == 200
+		})
+		require.Equal(t, 2, len(cloned))
+		segment := cloned[0]
+		if segment.GetNumOfRows() == 0 {
+			segment = cloned[1]
+		}
+		defer svr.meta.chunkManager.RemoveWithPrefix(ctx, path.Join(svr.meta.chunkManager.RootPath(), common.SegmentInsertLogPath, "200"))
+		defer svr.meta.chunkManager.RemoveWithPrefix(ctx, path.Join(svr.meta.chunkManager.RootPath(), common.SegmentDeltaLogPath, "200"))
+		assert.EqualValues(t, 20, segment.GetPartitionID())
+		assert.Equal(t, "ch2", segment.GetInsertChannel())
+		assert.Equal(t, commonpb.SegmentState_Flushed, segment.GetState())
+		assert.EqualValues(t, 10, segment.GetNumOfRows())
+		assert.EqualValues(t, 100, segment.GetDmlPosition().GetTimestamp())
+
+		binlogPath := metautil.BuildInsertLogPath(svr.meta.chunkManager.RootPath(), 200, 20, segment.GetID(), 101, 1)
+		assert.Equal(t, binlogPath, segment.GetBinlogs()[0].GetBinlogs()[0].GetLogPath())
+		content, err := svr.meta.chunkManager.Read(ctx, binlogPath)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("binlog"), content)
+		deltalogPath := metautil.BuildDeltaLogPath(svr.meta.chunkManager.RootPath(), 200, 20, segment.GetID(), 2)
+		assert.Equal(t, deltalogPath, segment.GetDeltalogs()[0].GetBinlogs()[0].GetLogPath())
+		content, err = svr.meta.chunkManager.Read(ctx, deltalogPath)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("deltalog"), content)
+
+		indexes := svr.meta.GetIndexesForCollection(200, "")
+		assert.Equal(t, 1, len(indexes))
+		assert.Equal(t, "idx", indexes[0].IndexName)
+		assert.NotEqual(t, int64(1), indexes[0].IndexID)
+	})
+
+	t.Run("no target partition", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		prepare(t, svr)
+
+		status, err := svr.CloneSegments(context.TODO(), &datapb.CloneSegmentsRequest{
+			Base:               &commonpb.MsgBase{Timestamp: 100},
+			CollectionID:       100,
+			TargetCollectionID: 200,
+			Channels:           map[string]string{"ch1": "ch2"},
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	})
+
+	t.Run("no DataNode watching target channel", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		prepare(t, svr)
+		defer svr.meta.chunkManager.RemoveWithPrefix(context.TODO(), path.Join(svr.meta.chunkManager.RootPath(), common.SegmentInsertLogPath, "200"))
+		defer svr.meta.chunkManager.RemoveWithPrefix(context.TODO(), path.Join(svr.meta.chunkManager.RootPath(), common.SegmentDeltaLogPath, "200"))
+
+		status, err := svr.CloneSegments(context.TODO(), &datapb.CloneSegmentsRequest{
+			Base:               &commonpb.MsgBase{Timestamp: 100},
+			CollectionID:       100,
+			TargetCollectionID: 200,
+			PartitionIDs:       map[int64]int64{10: 20},
+			Channels:           map[string]string{"ch1": "ch3"},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+		resp := waitCloneState(t, svr, datapb.CloneState_CloneFailed)
+		assert.NotEmpty(t, resp.GetReason())
+	})
+
+	t.Run("resume interrupted job", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		prepare(t, svr)
+		ctx := context.Background()
+
+		req := &datapb.CloneSegmentsRequest{
+			Base:               &commonpb.MsgBase{Timestamp: 100},
+			CollectionID:       100,
+			TargetCollectionID: 200,
+			PartitionIDs:       map[int64]int64{10: 20},
+			Channels:           map[string]string{"ch1": "ch2"},
+		}
+		_, err := svr.cloneJobs.add(ctx, req, []int64{1000})
+		require.NoError(t, err)
+		// the segment had been added before the job was interrupted
+		require.NoError(t, svr.cloneJobs.onCloning(ctx, 200, 5000))
+		require.NoError(t, svr.meta.AddSegment(buildSegment(200, 20, 5000, "ch2", false)))
+		// the finished job of the dropped collection
+		_, err = svr.cloneJobs.add(ctx, &datapb.CloneSegmentsRequest{TargetCollectionID: -1}, nil)
+		require.NoError(t, err)
+
+		// the jobs are reloaded after restarting
+		svr.cloneJobs, err = newCloneJobManager(ctx, svr.meta.catalog)
+		require.NoError(t, err)
+		svr.resumeCloneJobs(ctx)
+		resp := waitCloneState(t, svr, datapb.CloneState_CloneCompleted)
+		assert.EqualValues(t, 1, resp.GetClonedSegments())
+		cloned := svr.meta.SelectSegments(func(segment *SegmentInfo) bool {
+			return segment.GetCollectionID() == 200
+		})
+		assert.Equal(t, 1, len(cloned))
+
+		resp, err = svr.GetCloneSegmentsState(ctx, &datapb.GetCloneSegmentsStateRequest{CollectionID: -1})
+		assert.NoError(t, err)
+		assert.Equal(t, datapb.CloneState_CloneNone, resp.GetState())
+		jobs, err := svr.meta.catalog.ListCloneJobs(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(jobs))
+		assert.Equal(t, datapb.CloneState_CloneCompleted, jobs[0].GetState())
+		require.NoError(t, svr.cloneJobs.remove(ctx, 200))
+	})
+
+	t.Run("selected segments without index", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		prepare(t, svr)
+		defer svr.meta.chunkManager.RemoveWithPrefix(context.TODO(), path.Join(svr.meta.chunkManager.RootPath(), common.SegmentInsertLogPath, "200"))
+		defer svr.meta.chunkManager.RemoveWithPrefix(context.TODO(), path.Join(svr.meta.chunkManager.RootPath(), common.SegmentDeltaLogPath, "200"))
+
+		req := &datapb.CloneSegmentsRequest{
+			Base:               &commonpb.MsgBase{Timestamp: 100},
+			CollectionID:       100,
+			TargetCollectionID: 200,
+			PartitionIDs:       map[int64]int64{10: 20},
+			Channels:           map[string]string{"ch1": "ch2"},
+			SegmentIDs:         []int64{1000, 1001},
+			SkipIndexFieldIDs:  []int64{101},
+		}
+		// the growing segment can't be cloned
+		status, err := svr.CloneSegments(context.TODO(), req)
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(status), merr.ErrSegmentNotFound)
+
+		req.SegmentIDs = []int64{1000}
+		status, err = svr.CloneSegments(context.TODO(), req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+		waitCloneState(t, svr, datapb.CloneState_CloneCompleted)
+		cloned := svr.meta.SelectSegments(func(segment *SegmentInfo) bool {
+			return segment.GetCollectionID() == 200
+		})
+		assert.Equal(t, 1, len(cloned))
+		assert.Empty(t, svr.meta.GetIndexesForCollection(200, ""))
+	})
+}
+
+func TestServer_ReplicateSegment(t *testing.T) {
+	t.Run("closed server", func(t *testing.T) {
+		s := &Server{}
+		s.stateCode.Store(commonpb.StateCode_Initializing)
+		status, err := s.ReplicateBinlog(context.TODO(), &datapb.ReplicateBinlogRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(status), merr.ErrServiceUnavailable)
+		resp, err := s.ReplicateSegment(context.TODO(), &datapb.ReplicateSegmentRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrServiceUnavailable)
+	})
+
+	svr := newTestServer(t, nil)
+	defer closeTestServer(t, svr)
+	ctx := context.Background()
+	cm := svr.meta.chunkManager
+	defer cm.RemoveWithPrefix(ctx, path.Join(cm.RootPath(), common.SegmentInsertLogPath, "1314"))
+	defer cm.RemoveWithPrefix(ctx, path.Join(cm.RootPath(), common.SegmentDeltaLogPath, "1314"))
+	defer cm.RemoveWithPrefix(ctx, path.Join(cm.RootPath(), replicationStagingDir))
+
+	// the mocked RootCoord has collection "test" of channel "vchan1" and partition "_default" of ID 0
+	svr.sessionManager.AddSession(&NodeInfo{
+		NodeID:  110,
+		Address: "localhost:8080",
+	})
+	require.NoError(t, svr.channelManager.AddNode(110))
+	require.NoError(t, svr.channelManager.Watch(&channel{Name: "vchan1", CollectionID: 1314}))
+
+	replicate := func(source *datapb.SegmentInfo, shardIndex int32) *datapb.ReplicateSegmentResponse {
+		for _, fieldBinlogs := range [][]*datapb.FieldBinlog{source.GetBinlogs(), source.GetDeltalogs()} {
+			for _, fieldBinlog := range fieldBinlogs {
+				for _, binlog := range fieldBinlog.GetBinlogs() {
+					status, err := svr.ReplicateBinlog(ctx, &datapb.ReplicateBinlogRequest{
+						LogPath: binlog.GetLogPath(),
+						Data:    []byte(binlog.GetLogPath()),
+					})
+					require.NoError(t, err)
+					require.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+				}
+			}
+		}
+		resp, err := svr.ReplicateSegment(ctx, &datapb.ReplicateSegmentRequest{
+			CollectionName: "test",
+			PartitionName:  "_default",
+			ShardIndex:     shardIndex,
+			Segment:        source,
+		})
+		require.NoError(t, err)
+		return resp
+	}
+	source := &datapb.SegmentInfo{
+		ID:           1000,
+		CollectionID: 100,
+		PartitionID:  10,
+		NumOfRows:    10,
+		State:        commonpb.SegmentState_Flushed,
+		Binlogs: []*datapb.FieldBinlog{
+			{FieldID: 101, Binlogs: []*datapb.Binlog{{LogPath: "insert_log/100/10/1000/101/1", EntriesNum: 10}}},
+		},
+		Deltalogs: []*datapb.FieldBinlog{
+			{Binlogs: []*datapb.Binlog{{LogPath: "delta_log/100/10/1000/2", EntriesNum: 1}}},
+		},
+	}
+
+	t.Run("invalid log path", func(t *testing.T) {
+		status, err := svr.ReplicateBinlog(ctx, &datapb.ReplicateBinlogRequest{LogPath: "../insert_log/1"})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(status), merr.ErrParameterInvalid)
+	})
+
+	t.Run("shard mismatch", func(t *testing.T) {
+		resp := replicate(source, 1)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrParameterInvalid)
+	})
+
+	var replicaID int64
+	t.Run("replicate segment", func(t *testing.T) {
+		resp := replicate(source, 0)
+		require.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		replicaID = resp.GetSegmentID()
+
+		replica := svr.meta.GetHealthySegment(replicaID)
+		require.NotNil(t, replica)
+		assert.EqualValues(t, 1314, replica.GetCollectionID())
+		assert.EqualValues(t, 0, replica.GetPartitionID())
+		assert.Equal(t, "vchan1", replica.GetInsertChannel())
+		assert.Equal(t, commonpb.SegmentState_Flushed, replica.GetState())
+		assert.EqualValues(t, 10, replica.GetNumOfRows())
+
+		binlogPath := metautil.BuildInsertLogPath(cm.RootPath(), 1314, 0, replicaID, 101, 1)
+		assert.Equal(t, binlogPath, replica.GetBinlogs()[0].GetBinlogs()[0].GetLogPath())
+		content, err := cm.Read(ctx, binlogPath)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("insert_log/100/10/1000/101/1"), content)
+		// the staging binlogs are removed
+		exist, err := cm.Exist(ctx, path.Join(cm.RootPath(), replicationStagingDir, "insert_log/100/10/1000/101/1"))
+		assert.NoError(t, err)
+		assert.False(t, exist)
+	})
+
+	t.Run("append deltalogs", func(t *testing.T) {
+		source.Deltalogs[0].Binlogs = append(source.Deltalogs[0].Binlogs,
+			&datapb.Binlog{LogPath: "delta_log/100/10/1000/3", EntriesNum: 1})
+		resp := replicate(&datapb.SegmentInfo{
+			ID:           source.GetID(),
+			CollectionID: source.GetCollectionID(),
+			PartitionID:  source.GetPartitionID(),
+			Deltalogs:    source.GetDeltalogs(),
+		}, 0)
+		require.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, replicaID, resp.GetSegmentID())
+
+		replica := svr.meta.GetHealthySegment(replicaID)
+		require.Equal(t, 1, len(replica.GetDeltalogs()))
+		assert.Equal(t, []string{
+			metautil.BuildDeltaLogPath(cm.RootPath(), 1314, 0, replicaID, 2),
+			metautil.BuildDeltaLogPath(cm.RootPath(), 1314, 0, replicaID, 3),
+		}, lo.Map(replica.GetDeltalogs()[0].GetBinlogs(), func(binlog *datapb.Binlog, _ int) string { return binlog.GetLogPath() }))
+	})
+
+	t.Run("drop compacted replicas", func(t *testing.T) {
+		resp := replicate(&datapb.SegmentInfo{
+			ID:             1001,
+			CollectionID:   100,
+			PartitionID:    10,
+			NumOfRows:      9,
+			State:          commonpb.SegmentState_Flushed,
+			CompactionFrom: []int64{1000},
+			Binlogs: []*datapb.FieldBinlog{
+				{FieldID: 101, Binlogs: []*datapb.Binlog{{LogPath: "insert_log/100/10/1001/101/4", EntriesNum: 9}}},
+			},
+		}, 0)
+		require.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.NotNil(t, svr.meta.GetHealthySegment(resp.GetSegmentID()))
+		assert.Nil(t, svr.meta.GetHealthySegment(replicaID))
+		replicaID, err := svr.loadReplicaSegmentID(1000)
+		assert.NoError(t, err)
+		assert.Zero(t, replicaID)
+	})
+}
+
 func TestGetRecoveryInfoV2(t *testing.T) {
 
 	t.Run("test get recovery info with no segments", func(t *testing.T) {
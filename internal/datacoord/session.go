@@ -30,8 +30,10 @@ var errDisposed = errors.New("client is disposed")
 
 // NodeInfo contains node base info
 type NodeInfo struct {
-	NodeID  int64
-	Address string
+	NodeID   int64
+	Address  string
+	Labels   map[string]string
+	Capacity NodeCapacity
 }
 
 // Session contains session info of a node
@@ -41,6 +43,8 @@ type Session struct {
 	client        types.DataNode
 	clientCreator dataNodeCreatorFunc
 	isDisposed    bool
+	// the number of consecutive failed liveness probes
+	probeFailures int
 }
 
 // NewSession creates a new session
@@ -93,3 +97,16 @@ func (n *Session) Dispose() {
 	}
 	n.isDisposed = true
 }
+
+// recordProbe records the result of a liveness probe, returns the number of consecutive failed probes.
+func (n *Session) recordProbe(err error) int {
+	n.Lock()
+	defer n.Unlock()
+
+	if err != nil {
+		n.probeFailures++
+	} else {
+		n.probeFailures = 0
+	}
+	return n.probeFailures
+}
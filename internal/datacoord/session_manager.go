@@ -29,6 +29,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/retry"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
@@ -125,6 +126,28 @@ func (c *SessionManager) GetSessions() []*Session {
 	return ret
 }
 
+// GetNodeLabel returns the value of label `key` of the DataNode, empty string if the node or label is not found.
+func (c *SessionManager) GetNodeLabel(nodeID int64, key string) string {
+	c.sessions.RLock()
+	defer c.sessions.RUnlock()
+
+	if session, ok := c.sessions.data[nodeID]; ok {
+		return session.info.Labels[key]
+	}
+	return ""
+}
+
+// GetNodeCapacity returns the capacity of the DataNode, zero value if the node is not found.
+func (c *SessionManager) GetNodeCapacity(nodeID int64) NodeCapacity {
+	c.sessions.RLock()
+	defer c.sessions.RUnlock()
+
+	if session, ok := c.sessions.data[nodeID]; ok {
+		return session.info.Capacity
+	}
+	return NodeCapacity{}
+}
+
 // Flush is a grpc interface. It will send req to nodeID asynchronously
 func (c *SessionManager) Flush(ctx context.Context, nodeID int64, req *datapb.FlushSegmentsRequest) {
 	go c.execFlush(ctx, nodeID, req)
@@ -167,6 +190,32 @@ func (c *SessionManager) Compaction(nodeID int64, plan *datapb.CompactionPlan) e
 	return nil
 }
 
+// CancelCompactionPlans is a grpc interface. It will interrupt the compaction plans executing on DataNode
+// with provided `nodeID` synchronously.
+func (c *SessionManager) CancelCompactionPlans(nodeID int64, planIDs []int64) error {
+	ctx, cancel := context.WithTimeout(context.Background(), Params.DataCoordCfg.CompactionRPCTimeout.GetAsDuration(time.Second))
+	defer cancel()
+	cli, err := c.getClient(ctx, nodeID)
+	if err != nil {
+		log.Warn("failed to get client", zap.Int64("nodeID", nodeID), zap.Error(err))
+		return err
+	}
+
+	resp, err := cli.CancelCompactionPlans(ctx, &datapb.CancelCompactionPlansRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		PlanIDs: planIDs,
+	})
+	if err := VerifyResponse(resp, err); err != nil {
+		log.Warn("failed to cancel compaction plans", zap.Int64("node", nodeID), zap.Int64s("planIDs", planIDs), zap.Error(err))
+		return err
+	}
+
+	log.Info("success to cancel compaction plans", zap.Int64("node", nodeID), zap.Int64s("planIDs", planIDs))
+	return nil
+}
+
 // SyncSegments is a grpc interface. It will send request to DataNode with provided `nodeID` synchronously.
 func (c *SessionManager) SyncSegments(nodeID int64, req *datapb.SyncSegmentsRequest) error {
 	log := log.With(
@@ -226,11 +275,12 @@ func (c *SessionManager) execImport(ctx context.Context, nodeID int64, itr *data
 }
 
 // ReCollectSegmentStats collects segment stats info from DataNodes, after DataCoord reboots.
-func (c *SessionManager) ReCollectSegmentStats(ctx context.Context, nodeID int64) error {
+// Returns the segments whose stats are resent.
+func (c *SessionManager) ReCollectSegmentStats(ctx context.Context, nodeID int64, collectionID UniqueID, channel string) ([]int64, error) {
 	cli, err := c.getClient(ctx, nodeID)
 	if err != nil {
 		log.Warn("failed to get dataNode client", zap.Int64("DataNode ID", nodeID), zap.Error(err))
-		return err
+		return nil, err
 	}
 	ctx, cancel := context.WithTimeout(ctx, reCollectTimeout)
 	defer cancel()
@@ -239,16 +289,18 @@ func (c *SessionManager) ReCollectSegmentStats(ctx context.Context, nodeID int64
 			commonpbutil.WithMsgType(commonpb.MsgType_ResendSegmentStats),
 			commonpbutil.WithSourceID(paramtable.GetNodeID()),
 		),
+		CollectionID: collectionID,
+		ChannelName:  channel,
 	})
 	if err := VerifyResponse(resp, err); err != nil {
 		log.Warn("re-collect segment stats call failed",
 			zap.Int64("DataNode ID", nodeID), zap.Error(err))
-		return err
+		return nil, err
 	}
 	log.Info("re-collect segment stats call succeeded",
 		zap.Int64("DataNode ID", nodeID),
 		zap.Int64s("segment stat collected", resp.GetSegResent()))
-	return nil
+	return resp.GetSegResent(), nil
 }
 
 func (c *SessionManager) GetCompactionState() map[int64]*datapb.CompactionStateResult {
@@ -300,6 +352,56 @@ func (c *SessionManager) GetCompactionState() map[int64]*datapb.CompactionStateR
 	return rst
 }
 
+// ProbeSessions probes the liveness of the DataNodes by their gRPC sessions concurrently,
+// returns the nodes which failed `dataCoord.session.probeFailureThreshold` probes in a row.
+func (c *SessionManager) ProbeSessions(ctx context.Context) []*NodeInfo {
+	threshold := Params.DataCoordCfg.SessionProbeFailureThreshold.GetAsInt()
+	var (
+		mu          sync.Mutex
+		wg          sync.WaitGroup
+		unreachable []*NodeInfo
+	)
+	for _, session := range c.GetSessions() {
+		session := session
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := c.probe(ctx, session)
+			failures := session.recordProbe(err)
+			if err == nil {
+				return
+			}
+			log.Warn("failed to probe datanode",
+				zap.Int64("nodeID", session.info.NodeID),
+				zap.String("address", session.info.Address),
+				zap.Int("failures", failures),
+				zap.Error(err))
+			if failures >= threshold {
+				mu.Lock()
+				unreachable = append(unreachable, session.info)
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	return unreachable
+}
+
+func (c *SessionManager) probe(ctx context.Context, session *Session) error {
+	ctx, cancel := context.WithTimeout(ctx, Params.DataCoordCfg.SessionProbeTimeout.GetAsDuration(time.Second))
+	defer cancel()
+
+	cli, err := session.GetOrCreateClient(ctx)
+	if err != nil {
+		return err
+	}
+	resp, err := cli.GetComponentStates(ctx)
+	if err != nil {
+		return err
+	}
+	return merr.Error(resp.GetStatus())
+}
+
 func (c *SessionManager) getClient(ctx context.Context, nodeID int64) (types.DataNode, error) {
 	c.sessions.RLock()
 	session, ok := c.sessions.data[nodeID]
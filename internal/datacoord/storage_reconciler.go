@@ -0,0 +1,263 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// storageReconciler compares the binlogs referenced by the segment meta with the objects in storage in both directions,
+// it reports the files of the healthy segments missing in storage, which fail the loading of the segments,
+// and the files referenced by no segment beyond the gc missing tolerance, which the garbage collector fails to recycle.
+// It repairs nothing, the divergence is exposed by the report and metrics only.
+type storageReconciler struct {
+	meta             *meta
+	cli              storage.ChunkManager
+	missingTolerance time.Duration
+
+	mu     sync.RWMutex
+	report *storageReport // the report of the last finished reconciliation
+}
+
+type storageReport struct {
+	startTime time.Time
+	endTime   time.Time
+	scanned   int64
+
+	maxReported int
+	missingNum  map[int64]int64 // CollectionID -> the number of missing files
+	orphanedNum map[int64]int64 // CollectionID -> the number of orphaned files
+	missing     []*datapb.InconsistentFile
+	orphaned    []*datapb.InconsistentFile
+}
+
+func newStorageReconciler(meta *meta, cli storage.ChunkManager) *storageReconciler {
+	return &storageReconciler{
+		meta:             meta,
+		cli:              cli,
+		missingTolerance: Params.DataCoordCfg.GCMissingTolerance.GetAsDuration(time.Second),
+	}
+}
+
+func (s *Server) startStorageReconcileLoop(ctx context.Context) {
+	if !Params.DataCoordCfg.StorageReconcileEnable.GetAsBool() {
+		return
+	}
+	s.serverLoopWg.Add(1)
+	go func() {
+		defer s.serverLoopWg.Done()
+		s.storageReconciler.loop(ctx)
+	}()
+}
+
+func (r *storageReconciler) loop(ctx context.Context) {
+	ticker := time.NewTicker(Params.DataCoordCfg.StorageReconcileInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("storage reconcile loop quit")
+			return
+		case <-ticker.C:
+			if err := r.reconcile(ctx); err != nil {
+				log.Warn("failed to reconcile segment meta with object storage", zap.Error(err))
+			}
+		}
+	}
+}
+
+// reconcile walks the binlog prefixes of the object storage and compares the objects with the segment meta.
+// The meta is taken before the walk, so the files written after it are never reported missing,
+// and they're too new to be reported orphaned.
+func (r *storageReconciler) reconcile(ctx context.Context) error {
+	report := &storageReport{
+		startTime:   time.Now(),
+		maxReported: Params.DataCoordCfg.StorageReconcileMaxReportedFiles.GetAsInt(),
+		missingNum:  make(map[int64]int64),
+		orphanedNum: make(map[int64]int64),
+	}
+
+	segments := make(map[int64]*SegmentInfo)
+	referenced := make(map[string]*SegmentInfo)
+	for _, segment := range r.meta.GetAllSegmentsUnsafe() {
+		segments[segment.GetID()] = segment
+		for _, binlog := range getLogs(segment) {
+			referenced[binlog.GetLogPath()] = segment
+		}
+	}
+
+	rootPath := r.cli.RootPath()
+	listed := typeutil.NewSet[string]()
+	for _, prefix := range []string{insertLogPrefix, statsLogPrefix, deltaLogPrefix} {
+		keys, modTimes, err := r.cli.ListWithPrefix(ctx, path.Join(rootPath, prefix), true)
+		if err != nil {
+			// the files not listed would be reported missing
+			metrics.DataCoordStorageReconcileCount.WithLabelValues(metrics.FailLabel).Inc()
+			return errors.Wrapf(err, "failed to list files with prefix %s", prefix)
+		}
+		for i, key := range keys {
+			report.scanned++
+			listed.Insert(key)
+			if _, ok := referenced[key]; ok {
+				continue
+			}
+			collectionID, partitionID, segmentID, err := parseBinlogKey(rootPath, key)
+			if err != nil {
+				log.Warn("skip the file of unknown path", zap.String("key", key), zap.Error(err))
+				continue
+			}
+			// the stats logs of existing segments are kept by the garbage collector
+			if _, ok := segments[segmentID]; ok && prefix == statsLogPrefix {
+				continue
+			}
+			if time.Since(modTimes[i]) > r.missingTolerance {
+				report.addOrphaned(&datapb.InconsistentFile{
+					Path:         key,
+					CollectionID: collectionID,
+					PartitionID:  partitionID,
+					SegmentID:    segmentID,
+					ModifiedTime: modTimes[i].UnixMilli(),
+				})
+			}
+		}
+	}
+
+	paths := make([]string, 0, len(referenced))
+	for key, segment := range referenced {
+		// the files of dropped segments are being recycled
+		if isSegmentHealthy(segment) && !listed.Contain(key) {
+			paths = append(paths, key)
+		}
+	}
+	sort.Strings(paths)
+	for _, key := range paths {
+		segment := referenced[key]
+		report.addMissing(&datapb.InconsistentFile{
+			Path:         key,
+			CollectionID: segment.GetCollectionID(),
+			PartitionID:  segment.GetPartitionID(),
+			SegmentID:    segment.GetID(),
+		})
+	}
+	report.endTime = time.Now()
+
+	missing, orphaned := report.count(0)
+	log.Info("reconciled segment meta with object storage",
+		zap.Int64("scanned", report.scanned),
+		zap.Int64("missing", missing),
+		zap.Int64("orphaned", orphaned),
+		zap.Duration("timeCost", report.endTime.Sub(report.startTime)))
+	metrics.DataCoordInconsistentFileNum.WithLabelValues(metrics.MissingFileLabel).Set(float64(missing))
+	metrics.DataCoordInconsistentFileNum.WithLabelValues(metrics.OrphanedFileLabel).Set(float64(orphaned))
+	metrics.DataCoordStorageReconcileCount.WithLabelValues(metrics.SuccessLabel).Inc()
+
+	r.mu.Lock()
+	r.report = report
+	r.mu.Unlock()
+	return nil
+}
+
+// fill fills the response with the last report, only the files of the collection are filled if collectionID is not 0.
+func (r *storageReconciler) fill(resp *datapb.GetStorageConsistencyReportResponse, collectionID int64) {
+	r.mu.RLock()
+	report := r.report
+	r.mu.RUnlock()
+	if report == nil {
+		return
+	}
+
+	resp.StartTime = report.startTime.UnixMilli()
+	resp.EndTime = report.endTime.UnixMilli()
+	resp.ScannedFiles = report.scanned
+	resp.MissingNum, resp.OrphanedNum = report.count(collectionID)
+	filter := func(files []*datapb.InconsistentFile) []*datapb.InconsistentFile {
+		if collectionID == 0 {
+			return files
+		}
+		ret := make([]*datapb.InconsistentFile, 0)
+		for _, file := range files {
+			if file.GetCollectionID() == collectionID {
+				ret = append(ret, file)
+			}
+		}
+		return ret
+	}
+	resp.MissingFiles = filter(report.missing)
+	resp.OrphanedFiles = filter(report.orphaned)
+}
+
+func (report *storageReport) addMissing(file *datapb.InconsistentFile) {
+	report.missingNum[file.GetCollectionID()]++
+	if len(report.missing) < report.maxReported {
+		report.missing = append(report.missing, file)
+	}
+}
+
+func (report *storageReport) addOrphaned(file *datapb.InconsistentFile) {
+	report.orphanedNum[file.GetCollectionID()]++
+	if len(report.orphaned) < report.maxReported {
+		report.orphaned = append(report.orphaned, file)
+	}
+}
+
+// count returns the numbers of the missing and orphaned files of the collection, all the collections if 0.
+func (report *storageReport) count(collectionID int64) (missing int64, orphaned int64) {
+	if collectionID != 0 {
+		return report.missingNum[collectionID], report.orphanedNum[collectionID]
+	}
+	for _, num := range report.missingNum {
+		missing += num
+	}
+	for _, num := range report.orphanedNum {
+		orphaned += num
+	}
+	return missing, orphaned
+}
+
+// parseBinlogKey parses the IDs from the binlog path "[log_type]/collID/partID/segID/...".
+func parseBinlogKey(rootPath, key string) (collectionID, partitionID, segmentID int64, err error) {
+	if !strings.HasPrefix(key, rootPath) {
+		return 0, 0, 0, errors.Newf("path %s does not contain root path %s", key, rootPath)
+	}
+	parts := strings.Split(strings.TrimLeft(key[len(rootPath):], "/"), "/")
+	if len(parts) < 5 {
+		return 0, 0, 0, errors.Newf("%s is not a valid binlog path", key)
+	}
+	ids := make([]int64, 3)
+	for i := range ids {
+		ids[i], err = strconv.ParseInt(parts[i+1], 10, 64)
+		if err != nil {
+			return 0, 0, 0, err
+		}
+	}
+	return ids[0], ids[1], ids[2], nil
+}
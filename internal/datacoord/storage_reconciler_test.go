@@ -0,0 +1,151 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestStorageReconciler(t *testing.T) {
+	meta, err := newMemoryMeta()
+	require.NoError(t, err)
+
+	flushed := buildSegment(100, 10, 1, "ch1", false)
+	flushed.State = commonpb.SegmentState_Flushed
+	flushed.Binlogs = []*datapb.FieldBinlog{{FieldID: 101, Binlogs: []*datapb.Binlog{
+		{LogPath: "files/insert_log/100/10/1/101/1"},
+		{LogPath: "files/insert_log/100/10/1/101/2"},
+	}}}
+	flushed.Statslogs = []*datapb.FieldBinlog{{FieldID: 101, Binlogs: []*datapb.Binlog{{LogPath: "files/stats_log/100/10/1/101/3"}}}}
+	flushed.Deltalogs = []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{LogPath: "files/delta_log/100/10/1/4"}}}}
+	require.NoError(t, meta.AddSegment(flushed))
+	// the files of the dropped segments are being recycled
+	dropped := buildSegment(200, 20, 2, "ch2", false)
+	dropped.State = commonpb.SegmentState_Dropped
+	dropped.Binlogs = []*datapb.FieldBinlog{{FieldID: 101, Binlogs: []*datapb.Binlog{{LogPath: "files/insert_log/200/20/2/101/5"}}}}
+	require.NoError(t, meta.AddSegment(dropped))
+
+	old := time.Now().Add(-2 * time.Hour)
+	cm := mocks.NewChunkManager(t)
+	cm.EXPECT().RootPath().Return("files")
+	r := newStorageReconciler(meta, cm)
+	r.missingTolerance = time.Hour
+
+	t.Run("list failure", func(t *testing.T) {
+		cm.EXPECT().ListWithPrefix(mock.Anything, "files/insert_log", true).Return(nil, nil, errors.New("mock")).Once()
+		assert.Error(t, r.reconcile(context.Background()))
+
+		resp := &datapb.GetStorageConsistencyReportResponse{}
+		r.fill(resp, 0)
+		assert.Zero(t, resp.GetEndTime())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		cm.EXPECT().ListWithPrefix(mock.Anything, "files/insert_log", true).Return([]string{
+			"files/insert_log/100/10/1/101/1",
+			"files/insert_log/100/10/9/101/6",
+			"files/insert_log/100/10/9/101/7",
+			"files/insert_log/bad",
+			"files/insert_log/200/20/9/101/8",
+		}, []time.Time{old, old, time.Now(), old, old}, nil).Once()
+		cm.EXPECT().ListWithPrefix(mock.Anything, "files/stats_log", true).Return([]string{
+			"files/stats_log/100/10/1/101/3",
+			"files/stats_log/100/10/1/101/9",
+		}, []time.Time{old, old}, nil).Once()
+		cm.EXPECT().ListWithPrefix(mock.Anything, "files/delta_log", true).Return([]string{
+			"files/delta_log/100/10/1/4",
+		}, []time.Time{old}, nil).Once()
+		require.NoError(t, r.reconcile(context.Background()))
+
+		resp := &datapb.GetStorageConsistencyReportResponse{}
+		r.fill(resp, 0)
+		assert.NotZero(t, resp.GetEndTime())
+		assert.EqualValues(t, 8, resp.GetScannedFiles())
+		assert.EqualValues(t, 1, resp.GetMissingNum())
+		assert.EqualValues(t, 2, resp.GetOrphanedNum())
+		require.Len(t, resp.GetMissingFiles(), 1)
+		missing := resp.GetMissingFiles()[0]
+		assert.Equal(t, "files/insert_log/100/10/1/101/2", missing.GetPath())
+		assert.EqualValues(t, 100, missing.GetCollectionID())
+		assert.EqualValues(t, 10, missing.GetPartitionID())
+		assert.EqualValues(t, 1, missing.GetSegmentID())
+		assert.ElementsMatch(t, []string{"files/insert_log/100/10/9/101/6", "files/insert_log/200/20/9/101/8"},
+			lo.Map(resp.GetOrphanedFiles(), func(file *datapb.InconsistentFile, _ int) string { return file.GetPath() }))
+
+		resp = &datapb.GetStorageConsistencyReportResponse{}
+		r.fill(resp, 200)
+		assert.EqualValues(t, 0, resp.GetMissingNum())
+		assert.EqualValues(t, 1, resp.GetOrphanedNum())
+		assert.Empty(t, resp.GetMissingFiles())
+		require.Len(t, resp.GetOrphanedFiles(), 1)
+		assert.EqualValues(t, 9, resp.GetOrphanedFiles()[0].GetSegmentID())
+		assert.Equal(t, old.UnixMilli(), resp.GetOrphanedFiles()[0].GetModifiedTime())
+	})
+
+	t.Run("max reported files", func(t *testing.T) {
+		paramtable.Get().Save(Params.DataCoordCfg.StorageReconcileMaxReportedFiles.Key, "1")
+		defer paramtable.Get().Reset(Params.DataCoordCfg.StorageReconcileMaxReportedFiles.Key)
+
+		cm.EXPECT().ListWithPrefix(mock.Anything, "files/insert_log", true).Return([]string{
+			"files/insert_log/100/10/9/101/6",
+			"files/insert_log/100/10/9/101/7",
+		}, []time.Time{old, old}, nil).Once()
+		cm.EXPECT().ListWithPrefix(mock.Anything, mock.Anything, true).Return(nil, nil, nil).Twice()
+		require.NoError(t, r.reconcile(context.Background()))
+
+		resp := &datapb.GetStorageConsistencyReportResponse{}
+		r.fill(resp, 0)
+		// all the files of the flushed segment are missing
+		assert.EqualValues(t, 4, resp.GetMissingNum())
+		assert.EqualValues(t, 2, resp.GetOrphanedNum())
+		assert.Len(t, resp.GetMissingFiles(), 1)
+		assert.Len(t, resp.GetOrphanedFiles(), 1)
+	})
+}
+
+func TestServer_GetStorageConsistencyReport(t *testing.T) {
+	t.Run("closed server", func(t *testing.T) {
+		s := &Server{}
+		s.stateCode.Store(commonpb.StateCode_Initializing)
+		resp, err := s.GetStorageConsistencyReport(context.TODO(), &datapb.GetStorageConsistencyReportRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("no report yet", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		resp, err := svr.GetStorageConsistencyReport(context.TODO(), &datapb.GetStorageConsistencyReportRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Zero(t, resp.GetEndTime())
+	})
+}
@@ -0,0 +1,130 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"sort"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+// TopologySnapshot is the snapshot of the DataNodes and the channels assigned to them,
+// serialized as JSON for the external tooling.
+type TopologySnapshot struct {
+	// unix timestamp in seconds when the snapshot is taken
+	Timestamp      int64               `json:"timestamp"`
+	DataNodes      []*DataNodeTopology `json:"data_nodes"`
+	BufferChannels []*ChannelTopology  `json:"buffer_channels"`
+}
+
+// DataNodeTopology is the DataNode and the channels watched by it.
+type DataNodeTopology struct {
+	NodeID   int64              `json:"node_id"`
+	Address  string             `json:"address"`
+	Labels   map[string]string  `json:"labels,omitempty"`
+	Cordoned bool               `json:"cordoned"`
+	Channels []*ChannelTopology `json:"channels"`
+	// the number of segments of the channels watched by the DataNode
+	SegmentCount int `json:"segment_count"`
+}
+
+// ChannelTopology is the watch state and the segments of a channel.
+type ChannelTopology struct {
+	Name         string `json:"name"`
+	CollectionID int64  `json:"collection_id"`
+	WatchState   string `json:"watch_state"`
+	// unix timestamp in seconds when the channel was assigned last time
+	WatchTs  int64  `json:"watch_ts"`
+	Progress int32  `json:"progress"`
+	Policy   string `json:"policy,omitempty"`
+	// the number of the segments of the channel by segment state
+	SegmentCounts map[string]int `json:"segment_counts"`
+}
+
+// buildTopologySnapshot takes the snapshot of the DataNodes registered and the channels assigned,
+// the DataNodes are sorted by node ID, and the channels by name.
+func buildTopologySnapshot(sessions []*Session, states []*datapb.ChannelWatchStateInfo,
+	segments []*SegmentInfo, isCordoned func(nodeID int64) bool,
+) *TopologySnapshot {
+	segmentCounts := make(map[string]map[string]int)
+	for _, segment := range segments {
+		counts, ok := segmentCounts[segment.GetInsertChannel()]
+		if !ok {
+			counts = make(map[string]int)
+			segmentCounts[segment.GetInsertChannel()] = counts
+		}
+		counts[segment.GetState().String()]++
+	}
+
+	nodes := make(map[int64]*DataNodeTopology)
+	getNode := func(nodeID int64) *DataNodeTopology {
+		node, ok := nodes[nodeID]
+		if !ok {
+			node = &DataNodeTopology{
+				NodeID:   nodeID,
+				Cordoned: isCordoned(nodeID),
+				Channels: make([]*ChannelTopology, 0),
+			}
+			nodes[nodeID] = node
+		}
+		return node
+	}
+	for _, session := range sessions {
+		node := getNode(session.info.NodeID)
+		node.Address = session.info.Address
+		node.Labels = session.info.Labels
+	}
+
+	snapshot := &TopologySnapshot{
+		Timestamp:      time.Now().Unix(),
+		DataNodes:      make([]*DataNodeTopology, 0, len(nodes)),
+		BufferChannels: make([]*ChannelTopology, 0),
+	}
+	for _, state := range states {
+		ch := &ChannelTopology{
+			Name:          state.GetChannelName(),
+			CollectionID:  state.GetCollectionID(),
+			WatchState:    state.GetState().String(),
+			WatchTs:       state.GetWatchTs(),
+			Progress:      state.GetProgress(),
+			Policy:        state.GetPolicy(),
+			SegmentCounts: segmentCounts[state.GetChannelName()],
+		}
+		if ch.SegmentCounts == nil {
+			ch.SegmentCounts = make(map[string]int)
+		}
+		// the channels in buffer are reported with node ID 0
+		if state.GetNodeID() == 0 {
+			snapshot.BufferChannels = append(snapshot.BufferChannels, ch)
+			continue
+		}
+		node := getNode(state.GetNodeID())
+		node.Channels = append(node.Channels, ch)
+		for _, count := range ch.SegmentCounts {
+			node.SegmentCount += count
+		}
+	}
+
+	for _, node := range nodes {
+		snapshot.DataNodes = append(snapshot.DataNodes, node)
+	}
+	sort.Slice(snapshot.DataNodes, func(i, j int) bool {
+		return snapshot.DataNodes[i].NodeID < snapshot.DataNodes[j].NodeID
+	})
+	return snapshot
+}
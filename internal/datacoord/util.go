@@ -23,14 +23,18 @@ import (
 	"time"
 
 	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
 // Response response interface for verification
@@ -94,10 +98,10 @@ func GetCompactTime(ctx context.Context, allocator allocator) (*compactTime, err
 	if Params.CommonCfg.EntityExpirationTTL.GetAsInt() > 0 {
 		ttexpired := pts.Add(-1 * Params.CommonCfg.EntityExpirationTTL.GetAsDuration(time.Second))
 		ttexpiredLogic := tsoutil.ComposeTS(ttexpired.UnixNano()/int64(time.Millisecond), 0)
-		return &compactTime{ttRetentionLogic, ttexpiredLogic, Params.CommonCfg.EntityExpirationTTL.GetAsDuration(time.Second)}, nil
+		return &compactTime{ttRetentionLogic, ttexpiredLogic, Params.CommonCfg.EntityExpirationTTL.GetAsDuration(time.Second), 0}, nil
 	}
 	// no expiration time
-	return &compactTime{ttRetentionLogic, 0, 0}, nil
+	return &compactTime{ttRetentionLogic, 0, 0, 0}, nil
 }
 
 func FilterInIndexedSegments(handler Handler, mt *meta, segments ...*SegmentInfo) []*SegmentInfo {
@@ -165,6 +169,31 @@ func getCollectionTTL(properties map[string]string) (time.Duration, error) {
 	return Params.CommonCfg.EntityExpirationTTL.GetAsDuration(time.Second), nil
 }
 
+// getCollectionSealInterval returns the interval of the time boundaries to seal the growing segments on
+// if collection's seal interval is specified, or return the global seal interval.
+func getCollectionSealInterval(properties map[string]string) (time.Duration, error) {
+	v, ok := properties[common.CollectionSealIntervalKey]
+	if ok {
+		interval, err := strconv.Atoi(v)
+		if err != nil {
+			return -1, err
+		}
+		return time.Duration(interval) * time.Second, nil
+	}
+
+	return Params.DataCoordCfg.SegmentSealInterval.GetAsDuration(time.Second), nil
+}
+
+// getCollectionMaxGrowingSegments returns the max number of growing segments of each channel
+// if collection's limit is specified, or return the global limit, 0 means no limit.
+func getCollectionMaxGrowingSegments(properties map[string]string) (int, error) {
+	v, ok := properties[common.CollectionMaxGrowingSegmentsKey]
+	if ok {
+		return strconv.Atoi(v)
+	}
+	return Params.DataCoordCfg.SegmentMaxGrowingPerChannel.GetAsInt(), nil
+}
+
 func getCompactedSegmentSize(s *datapb.CompactionResult) int64 {
 	var segmentSize int64
 
@@ -205,6 +234,15 @@ func getCollectionAutoCompactionEnabled(properties map[string]string) (bool, err
 	return Params.DataCoordCfg.EnableAutoCompaction.GetAsBool(), nil
 }
 
+// getCollectionEncryptionEnabled returns whether the collection requires encrypted storage, false if not set.
+func getCollectionEncryptionEnabled(properties map[string]string) (bool, error) {
+	v, ok := properties[common.CollectionEncryptionKey]
+	if ok {
+		return strconv.ParseBool(v)
+	}
+	return false, nil
+}
+
 func getIndexType(indexParams []*commonpb.KeyValuePair) string {
 	for _, param := range indexParams {
 		if param.Key == common.IndexTypeKey {
@@ -218,6 +256,27 @@ func isFlatIndex(indexType string) bool {
 	return indexType == flatIndex || indexType == binFlatIndex
 }
 
+// mergeIndexParams overwrites the params with the altered ones, the params not exist are appended.
+func mergeIndexParams(params []*commonpb.KeyValuePair, altered []*commonpb.KeyValuePair) []*commonpb.KeyValuePair {
+	merged := make([]*commonpb.KeyValuePair, 0, len(params)+len(altered))
+	alteredMap := funcutil.KeyValuePair2Map(altered)
+	for _, param := range params {
+		if value, ok := alteredMap[param.GetKey()]; ok {
+			merged = append(merged, &commonpb.KeyValuePair{Key: param.GetKey(), Value: value})
+			delete(alteredMap, param.GetKey())
+			continue
+		}
+		merged = append(merged, &commonpb.KeyValuePair{Key: param.GetKey(), Value: param.GetValue()})
+	}
+	for _, param := range altered {
+		if value, ok := alteredMap[param.GetKey()]; ok {
+			merged = append(merged, &commonpb.KeyValuePair{Key: param.GetKey(), Value: value})
+			delete(alteredMap, param.GetKey())
+		}
+	}
+	return merged
+}
+
 func parseBuildIDFromFilePath(key string) (UniqueID, error) {
 	ss := strings.Split(key, "/")
 	if strings.HasSuffix(key, "/") {
@@ -225,3 +284,21 @@ func parseBuildIDFromFilePath(key string) (UniqueID, error) {
 	}
 	return strconv.ParseInt(ss[len(ss)-1], 10, 64)
 }
+
+// selectSegmentInfoFields returns a SegmentInfo with only the fields named by their proto names,
+// it's used to drop the binlogs from the responses listing a large number of segments.
+func selectSegmentInfoFields(info *datapb.SegmentInfo, fields []string) (*datapb.SegmentInfo, error) {
+	src := proto.MessageReflect(info)
+	selected := &datapb.SegmentInfo{}
+	dst := proto.MessageReflect(selected)
+	for _, field := range fields {
+		fd := src.Descriptor().Fields().ByName(protoreflect.Name(field))
+		if fd == nil {
+			return nil, merr.WrapErrParameterInvalid("SegmentInfo field", field, "unknown output field")
+		}
+		if src.Has(fd) {
+			dst.Set(fd, src.Get(fd))
+		}
+	}
+	return selected, nil
+}
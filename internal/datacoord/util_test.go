@@ -137,7 +137,7 @@ func (suite *UtilSuite) TestGetCompactTime() {
 		{
 			"test get timetravel",
 			args{&fixedTSOAllocator{fixedTime: tFixed}},
-			&compactTime{tsoutil.ComposeTS(tBefore.UnixNano()/int64(time.Millisecond), 0), 0, 0},
+			&compactTime{tsoutil.ComposeTS(tBefore.UnixNano()/int64(time.Millisecond), 0), 0, 0, 0},
 			false,
 		},
 	}
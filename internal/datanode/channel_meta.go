@@ -81,6 +81,8 @@ type Channel interface {
 	listCompactedSegmentIDs() map[UniqueID][]UniqueID
 	listSegmentIDsToSync(ts Timestamp) []UniqueID
 	setSegmentLastSyncTs(segID UniqueID, ts Timestamp)
+	updateSegmentEncryptionKeyVersion(segID UniqueID, keyVersion int64)
+	getSegmentEncryptionKeyVersion(segID UniqueID) int64
 
 	updateSegmentRowNumber(segID UniqueID, numRows int64)
 	updateSegmentMemorySize(segID UniqueID, memorySize int64)
@@ -135,6 +137,7 @@ type addSegmentReq struct {
 	binLogs                    []*datapb.FieldBinlog
 	recoverTs                  Timestamp
 	importing                  bool
+	level                      datapb.SegmentLevel
 }
 
 var _ Channel = &ChannelMeta{}
@@ -216,11 +219,13 @@ func (c *ChannelMeta) addSegment(req addSegmentReq) error {
 		zap.Any("endPosition", req.endPos),
 		zap.Uint64("recoverTs", req.recoverTs),
 		zap.Bool("importing", req.importing),
+		zap.String("level", req.level.String()),
 	)
 	seg := &Segment{
 		collectionID:     req.collID,
 		partitionID:      req.partitionID,
 		segmentID:        req.segID,
+		level:            req.level,
 		numRows:          req.numOfRows, // 0 if segType == NEW
 		historyInsertBuf: make([]*BufferData, 0),
 		historyDeleteBuf: make([]*DelDataBuf, 0),
@@ -228,13 +233,15 @@ func (c *ChannelMeta) addSegment(req addSegmentReq) error {
 		lastSyncTs:       tsoutil.GetCurrentTime(),
 	}
 	seg.setType(req.segType)
-	// Set up pk stats
-	err := c.InitPKstats(context.TODO(), seg, req.statsBinLogs, req.recoverTs)
-	if err != nil {
-		log.Error("failed to init bloom filter",
-			zap.Int64("segmentID", req.segID),
-			zap.Error(err))
-		return err
+	// Set up pk stats, L0 segments hold only the deletes
+	if req.level != datapb.SegmentLevel_L0 {
+		err := c.InitPKstats(context.TODO(), seg, req.statsBinLogs, req.recoverTs)
+		if err != nil {
+			log.Error("failed to init bloom filter",
+				zap.Int64("segmentID", req.segID),
+				zap.Error(err))
+			return err
+		}
 	}
 
 	c.segMu.Lock()
@@ -298,6 +305,31 @@ func (c *ChannelMeta) setSegmentLastSyncTs(segID UniqueID, ts Timestamp) {
 	}
 }
 
+// updateSegmentEncryptionKeyVersion records the key version which the binlogs of the segment are encrypted with,
+// the lowest one is kept since the binlogs written before are not re-encrypted.
+func (c *ChannelMeta) updateSegmentEncryptionKeyVersion(segID UniqueID, keyVersion int64) {
+	c.segMu.Lock()
+	defer c.segMu.Unlock()
+	seg, ok := c.segments[segID]
+	if !ok {
+		return
+	}
+	if !seg.encryptionKeySet || keyVersion < seg.encryptionKeyVersion {
+		seg.encryptionKeyVersion = keyVersion
+		seg.encryptionKeySet = true
+	}
+}
+
+// getSegmentEncryptionKeyVersion returns the lowest key version of the binlogs of the segment flushed by this node.
+func (c *ChannelMeta) getSegmentEncryptionKeyVersion(segID UniqueID) int64 {
+	c.segMu.RLock()
+	defer c.segMu.RUnlock()
+	if seg, ok := c.segments[segID]; ok {
+		return seg.encryptionKeyVersion
+	}
+	return 0
+}
+
 // filterSegments return segments with same partitionID for all segments
 // get all segments
 func (c *ChannelMeta) filterSegments(partitionID UniqueID) []*Segment {
@@ -306,6 +338,10 @@ func (c *ChannelMeta) filterSegments(partitionID UniqueID) []*Segment {
 
 	var results []*Segment
 	for _, seg := range c.segments {
+		if seg.level == datapb.SegmentLevel_L0 {
+			// L0 segments hold only the deletes
+			continue
+		}
 		if seg.isValid() &&
 			partitionID == common.InvalidPartitionID || seg.partitionID == partitionID {
 			results = append(results, seg)
@@ -512,7 +548,8 @@ func (c *ChannelMeta) listNewSegmentsStartPositions() []*datapb.SegmentStartPosi
 
 	var result []*datapb.SegmentStartPosition
 	for id, seg := range c.segments {
-		if seg.getType() == datapb.SegmentType_New {
+		// L0 segments are added to DataCoord by their own SaveBinlogPaths request
+		if seg.getType() == datapb.SegmentType_New && seg.level != datapb.SegmentLevel_L0 {
 			result = append(result, &datapb.SegmentStartPosition{
 				SegmentID:     id,
 				StartPosition: seg.startPos,
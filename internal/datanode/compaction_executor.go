@@ -115,6 +115,17 @@ func (c *compactionExecutor) stopTask(planID UniqueID) {
 	}
 }
 
+// cancelTasks interrupts the executing tasks and discards the results of the completed ones.
+func (c *compactionExecutor) cancelTasks(planIDs []UniqueID) {
+	for _, planID := range planIDs {
+		c.stopTask(planID)
+		if _, ok := c.completed.Get(planID); ok {
+			log.Info("discard the result of cancelled compaction", zap.Int64("planID", planID))
+			c.injectDone(planID, true)
+		}
+	}
+}
+
 func (c *compactionExecutor) channelValidateForCompaction(vChannelName string) bool {
 	// if vchannel marked dropped, compaction should not proceed
 	return !c.dropped.Contain(vChannelName)
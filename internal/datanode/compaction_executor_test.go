@@ -115,6 +115,28 @@ func TestCompactionExecutor(t *testing.T) {
 		}
 	})
 
+	t.Run("test cancel tasks", func(t *testing.T) {
+		ex := newCompactionExecutor()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go ex.start(ctx)
+		mc := newMockCompactor(true)
+		mc.alwaysWorking = true
+
+		ex.execute(mc)
+		ex.completed.Insert(2, &datapb.CompactionResult{PlanID: 2})
+
+		ex.cancelTasks([]UniqueID{mc.getPlanID(), 2})
+
+		select {
+		case <-mc.ctx.Done():
+		default:
+			t.FailNow()
+		}
+		assert.False(t, ex.executing.Contain(mc.getPlanID()))
+		assert.False(t, ex.completed.Contain(2))
+	})
+
 }
 
 func newMockCompactor(isvalid bool) *mockCompactor {
@@ -306,6 +306,9 @@ func (t *compactionTask) merge(
 
 		statField2Path = make(map[UniqueID]*datapb.FieldBinlog)
 		statPaths      = make([]*datapb.FieldBinlog, 0)
+
+		sidePayloadFields []UniqueID // the fields whose oversized values are stored aside
+		droppedRowIDs     []int64    // the row IDs of the deleted and expired entities
 	)
 
 	isDeletedValue := func(v *storage.Value) bool {
@@ -350,6 +353,9 @@ func (t *compactionTask) merge(
 		if fs.GetIsPrimaryKey() && fs.GetFieldID() >= 100 && typeutil.IsPrimaryFieldType(fs.GetDataType()) {
 			pkField = fs
 		}
+		if typeutil.IsSidePayloadField(fs) {
+			sidePayloadFields = append(sidePayloadFields, fs.GetFieldID())
+		}
 	}
 
 	if pkField == nil {
@@ -389,6 +395,11 @@ func (t *compactionTask) merge(
 	stats := storage.NewPrimaryKeyStats(pkID, int64(pkType), oldRowNums)
 
 	for _, path := range unMergedInsertlogs {
+		// stop merging once the task is cancelled or timeout
+		if !funcutil.CheckCtxValid(ctxTimeout) {
+			log.Warn("compaction interrupted", zap.Strings("path", path))
+			return nil, nil, 0, errContext
+		}
 		downloadStart := time.Now()
 		data, err := t.download(ctxTimeout, path)
 		if err != nil {
@@ -411,6 +422,9 @@ func (t *compactionTask) merge(
 			}
 
 			if isDeletedValue(v) {
+				if len(sidePayloadFields) > 0 {
+					droppedRowIDs = append(droppedRowIDs, v.ID)
+				}
 				continue
 			}
 
@@ -418,6 +432,9 @@ func (t *compactionTask) merge(
 			// Filtering expired entity
 			if t.isExpiredEntity(ts, currentTs) {
 				expired++
+				if len(sidePayloadFields) > 0 {
+					droppedRowIDs = append(droppedRowIDs, v.ID)
+				}
 				continue
 			}
 
@@ -471,6 +488,12 @@ func (t *compactionTask) merge(
 		numBinlogs += len(inPaths)
 	}
 
+	// the dropped entities are gone once the merged segment is uploaded, and so are their values stored aside
+	if err := t.removeSidePayloads(ctxTimeout, meta.GetID(), sidePayloadFields, droppedRowIDs); err != nil {
+		log.Warn("failed to remove values stored aside of dropped entities", zap.Error(err))
+		return nil, nil, 0, err
+	}
+
 	for _, path := range insertField2Path {
 		insertPaths = append(insertPaths, path)
 	}
@@ -479,6 +502,7 @@ func (t *compactionTask) merge(
 		statPaths = append(statPaths, path)
 	}
 
+	metrics.DataNodeCompactionExpiredRowCount.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), fmt.Sprint(meta.GetID())).Add(float64(expired))
 	log.Info("merge end", zap.Int64("remaining insert numRows", numRows),
 		zap.Int64("expired entities", expired), zap.Int("binlog file number", numBinlogs),
 		zap.Float64("download insert log elapse in ms", nano2Milli(downloadTimeCost)),
@@ -488,6 +512,20 @@ func (t *compactionTask) merge(
 	return insertPaths, statPaths, numRows, nil
 }
 
+// removeSidePayloads removes the values stored aside of the rows of the fields, the missing ones are skipped
+func (t *compactionTask) removeSidePayloads(ctx context.Context, collectionID UniqueID, fieldIDs []UniqueID, rowIDs []int64) error {
+	if t.chunkManager == nil || len(fieldIDs) == 0 || len(rowIDs) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(fieldIDs)*len(rowIDs))
+	for _, rowID := range rowIDs {
+		for _, fieldID := range fieldIDs {
+			keys = append(keys, metautil.BuildSidePayloadPath(t.chunkManager.RootPath(), collectionID, fieldID, rowID))
+		}
+	}
+	return t.chunkManager.MultiRemove(ctx, keys)
+}
+
 func (t *compactionTask) compact() (*datapb.CompactionResult, error) {
 	compactStart := time.Now()
 	if ok := funcutil.CheckCtxValid(t.ctx); !ok {
@@ -511,6 +549,9 @@ func (t *compactionTask) compact() (*datapb.CompactionResult, error) {
 		log.Warn("compact wrong, there's no segments in segment binlogs")
 		return nil, errIllegalCompactionPlan
 
+	case t.plan.GetType() == datapb.CompactionType_ExternalConversion:
+		return t.convertExternal(ctxTimeout, durInQueue)
+
 	case t.plan.GetType() == datapb.CompactionType_MergeCompaction || t.plan.GetType() == datapb.CompactionType_MixCompaction:
 		targetSegID, err = t.AllocOne()
 		if err != nil {
@@ -531,10 +572,18 @@ func (t *compactionTask) compact() (*datapb.CompactionResult, error) {
 		return nil, err
 	}
 
+	// the compacted segment is written with the key version of the plan, which re-encrypts the segments on the old keys
+	if err := checkEncryptionSupported(t.chunkManager, meta.GetID(), t.plan.GetEncryptionKeyVersion()); err != nil {
+		log.Warn("compact wrong", zap.Int64("planID", t.plan.GetPlanID()), zap.Error(err))
+		return nil, err
+	}
+	ctxTimeout = storage.WithEncryptionKey(ctxTimeout, meta.GetID(), t.plan.GetEncryptionKeyVersion())
+
 	// Inject to stop flush
 	injectStart := time.Now()
 	ti := newTaskInjection(len(segIDs), func(pack *segmentFlushPack) {
 		collectionID := meta.GetID()
+		writeCtx := storage.WithEncryptionKey(t.ctx, collectionID, t.plan.GetEncryptionKeyVersion())
 		pack.segmentID = targetSegID
 		for _, insertLog := range pack.insertLogs {
 			splits := strings.Split(insertLog.LogPath, "/")
@@ -559,7 +608,7 @@ func (t *compactionTask) compact() (*datapb.CompactionResult, error) {
 				pack.err = err
 				return
 			}
-			err = t.chunkManager.Write(t.ctx, blobPath, blob)
+			err = t.chunkManager.Write(writeCtx, blobPath, blob)
 			if err != nil {
 				pack.err = err
 				return
@@ -585,7 +634,7 @@ func (t *compactionTask) compact() (*datapb.CompactionResult, error) {
 				pack.err = err
 				return
 			}
-			err = t.chunkManager.Write(t.ctx, blobPath, blob)
+			err = t.chunkManager.Write(writeCtx, blobPath, blob)
 			if err != nil {
 				pack.err = err
 				return
@@ -617,7 +666,7 @@ func (t *compactionTask) compact() (*datapb.CompactionResult, error) {
 				pack.err = err
 				return
 			}
-			err = t.chunkManager.Write(t.ctx, blobPath, blob)
+			err = t.chunkManager.Write(writeCtx, blobPath, blob)
 			if err != nil {
 				pack.err = err
 				return
@@ -741,6 +790,8 @@ func (t *compactionTask) compact() (*datapb.CompactionResult, error) {
 		Deltalogs:           deltaInfo,
 		NumOfRows:           numRows,
 		Channel:             t.plan.GetChannel(),
+
+		EncryptionKeyVersion: t.plan.GetEncryptionKeyVersion(),
 	}
 
 	t.inject = ti
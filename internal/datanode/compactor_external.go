@@ -0,0 +1,144 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/importutil"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// convertExternal converts the segment registered on the external parquet files into binlogs of a new segment.
+// Only the rows of the files hashed to the shard of the registered segment are written, all with the start time
+// of the plan, so the registered segment is replaced by the new one as a compaction.
+func (t *compactionTask) convertExternal(ctx context.Context, durInQueue time.Duration) (*datapb.CompactionResult, error) {
+	log := log.Ctx(ctx).With(zap.Int64("planID", t.plan.GetPlanID()))
+	if len(t.plan.GetSegmentBinlogs()) != 1 {
+		log.Warn("convert external segment wrong, the plan should contain exactly one segment")
+		return nil, errIllegalCompactionPlan
+	}
+	segment := t.plan.GetSegmentBinlogs()[0]
+	source := segment.GetExternalSource()
+	if source.GetShardNum() <= 0 || len(source.GetFiles()) == 0 {
+		log.Warn("convert external segment wrong, invalid external source", zap.Any("source", source))
+		return nil, errIllegalCompactionPlan
+	}
+	log = log.With(zap.Int64("segmentID", segment.GetSegmentID()), zap.Strings("files", source.GetFiles()),
+		zap.Int32("shardIndex", source.GetShardIndex()))
+
+	collID := t.getCollectionID()
+	partID := segment.GetPartitionID()
+	schema, err := t.getCollectionSchema(collID, 0)
+	if err != nil {
+		log.Warn("convert external segment wrong", zap.Error(err))
+		return nil, err
+	}
+	if err := checkEncryptionSupported(t.chunkManager, collID, t.plan.GetEncryptionKeyVersion()); err != nil {
+		log.Warn("convert external segment wrong", zap.Error(err))
+		return nil, err
+	}
+	ctx = storage.WithEncryptionKey(ctx, collID, t.plan.GetEncryptionKeyVersion())
+
+	targetSegID, err := t.AllocOne()
+	if err != nil {
+		log.Warn("convert external segment wrong", zap.Error(err))
+		return nil, err
+	}
+
+	collectionInfo, err := importutil.NewCollectionInfo(schema, source.GetShardNum(), []int64{partID})
+	if err != nil {
+		log.Warn("convert external segment wrong", zap.Error(err))
+		return nil, err
+	}
+
+	var numRows int64
+	insertLogs := make(map[UniqueID]*datapb.FieldBinlog)
+	statsLogs := make(map[UniqueID]*datapb.FieldBinlog)
+	flushFunc := func(fields importutil.BlockData, shardID int, partitionID int64) error {
+		if shardID != int(source.GetShardIndex()) {
+			return nil
+		}
+		var rowNum int
+		for _, field := range fields {
+			rowNum = field.RowNum()
+			break
+		}
+		if rowNum <= 0 {
+			return nil
+		}
+		inserts, stats, err := writeInsertBinlogs(ctx, t.Allocator, t.chunkManager, rowNum, schema, t.plan.GetStartTime(),
+			fields, targetSegID, collID, partID)
+		if err != nil {
+			return err
+		}
+		mergeFieldBinlogs(insertLogs, inserts)
+		mergeFieldBinlogs(statsLogs, stats)
+		numRows += int64(rowNum)
+		return nil
+	}
+
+	for _, filePath := range source.GetFiles() {
+		parser, err := importutil.NewParquetParser(ctx, collectionInfo, t.GetIDAlloactor(), importutil.SingleBlockSize,
+			t.chunkManager, flushFunc, nil)
+		if err != nil {
+			log.Warn("convert external segment wrong", zap.Error(err))
+			return nil, err
+		}
+		if err := parser.Parse(filePath); err != nil {
+			log.Warn("convert external segment wrong, failed to parse the file", zap.String("filePath", filePath), zap.Error(err))
+			return nil, fmt.Errorf("failed to convert the external file '%s', error: %w", filePath, err)
+		}
+	}
+
+	pack := &datapb.CompactionResult{
+		PlanID:              t.plan.GetPlanID(),
+		SegmentID:           targetSegID,
+		InsertLogs:          lo.Values(insertLogs),
+		Field2StatslogPaths: lo.Values(statsLogs),
+		NumOfRows:           numRows,
+		Channel:             t.plan.GetChannel(),
+
+		EncryptionKeyVersion: t.plan.GetEncryptionKeyVersion(),
+	}
+	log.Info("external segment converted", zap.Int64("targetSegmentID", targetSegID), zap.Int64("numRows", numRows),
+		zap.Int64("expectedRows", t.plan.GetTotalRows()))
+
+	metrics.DataNodeCompactionLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Observe(float64(t.tr.ElapseSpan().Milliseconds()))
+	metrics.DataNodeCompactionLatencyInQueue.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Observe(float64(durInQueue.Milliseconds()))
+	return pack, nil
+}
+
+// mergeFieldBinlogs appends the binlogs into the binlogs of the same fields
+func mergeFieldBinlogs(target map[UniqueID]*datapb.FieldBinlog, binlogs []*datapb.FieldBinlog) {
+	for _, fieldBinlog := range binlogs {
+		if existed, ok := target[fieldBinlog.GetFieldID()]; ok {
+			existed.Binlogs = append(existed.Binlogs, fieldBinlog.GetBinlogs()...)
+			continue
+		}
+		target[fieldBinlog.GetFieldID()] = fieldBinlog
+	}
+}
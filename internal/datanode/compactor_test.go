@@ -40,8 +40,10 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/timerecord"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 var compactTestDir = "/tmp/milvus_test/compact"
@@ -324,6 +326,57 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 			assert.Equal(t, 1, len(inPaths[0].GetBinlogs()))
 			assert.Equal(t, 1, len(statsPaths))
 		})
+		t.Run("Merge removes side payloads of dropped rows", func(t *testing.T) {
+			mockbIO := &binlogIO{cm, alloc}
+			paramtable.Get().Save(Params.CommonCfg.EntityExpirationTTL.Key, "0")
+			iData := genInsertDataWithExpiredTS()
+			meta := NewMetaFactory().GetCollectionMeta(1, "test", schemapb.DataType_Int64)
+			for _, field := range meta.GetSchema().GetFields() {
+				if field.GetDataType() == schemapb.DataType_VarChar {
+					field.TypeParams = append(field.TypeParams, &commonpb.KeyValuePair{Key: common.SidePayloadKey, Value: "true"})
+				}
+			}
+
+			var allPaths [][]string
+			inpath, err := mockbIO.uploadInsertLog(context.Background(), 1, 0, iData, meta)
+			assert.NoError(t, err)
+			for idx := 0; idx < len(inpath[0].GetBinlogs()); idx++ {
+				var ps []string
+				for _, path := range inpath {
+					ps = append(ps, path.GetBinlogs()[idx].GetLogPath())
+				}
+				allPaths = append(allPaths, ps)
+			}
+
+			// the rows 11 and 22 carry the pk 1 and 2
+			deleted := metautil.BuildSidePayloadPath(cm.RootPath(), 1, 109, 11)
+			kept := metautil.BuildSidePayloadPath(cm.RootPath(), 1, 109, 22)
+			assert.NoError(t, cm.MultiWrite(context.Background(), map[string][]byte{deleted: []byte("a"), kept: []byte("b")}))
+			defer cm.MultiRemove(context.Background(), []string{deleted, kept})
+
+			dm := map[interface{}]Timestamp{
+				int64(1): typeutil.MaxTimestamp,
+			}
+
+			ct := &compactionTask{
+				Channel: channel, downloader: mockbIO, uploader: mockbIO, done: make(chan struct{}, 1),
+				plan: &datapb.CompactionPlan{
+					SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{
+						{SegmentID: 1}},
+				},
+				chunkManager: cm,
+			}
+			_, _, numOfRow, err := ct.merge(context.Background(), allPaths, 2, 0, meta, dm)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(1), numOfRow)
+
+			exist, err := cm.Exist(context.Background(), deleted)
+			assert.NoError(t, err)
+			assert.False(t, exist)
+			exist, err = cm.Exist(context.Background(), kept)
+			assert.NoError(t, err)
+			assert.True(t, exist)
+		})
 		t.Run("Merge without expiration2", func(t *testing.T) {
 			mockbIO := &binlogIO{cm, alloc}
 			paramtable.Get().Save(Params.CommonCfg.EntityExpirationTTL.Key, "0")
@@ -696,6 +749,11 @@ func TestCompactorInterfaceMethods(t *testing.T) {
 		_, err = emptyTask.compact()
 		assert.Error(t, err)
 
+		// the external source is required to convert
+		plan.Type = datapb.CompactionType_ExternalConversion
+		_, err = emptyTask.compact()
+		assert.ErrorIs(t, err, errIllegalCompactionPlan)
+
 		emptyTask.complete()
 		emptyTask.stop()
 	})
@@ -980,7 +1038,7 @@ type mockFlushManager struct {
 
 var _ flushManager = (*mockFlushManager)(nil)
 
-func (mfm *mockFlushManager) flushBufferData(data *BufferData, segmentID UniqueID, flushed bool, dropped bool, pos *msgpb.MsgPosition) (*storage.PrimaryKeyStats, error) {
+func (mfm *mockFlushManager) flushBufferData(ctx context.Context, data *BufferData, segmentID UniqueID, flushed bool, dropped bool, pos *msgpb.MsgPosition) (*storage.PrimaryKeyStats, error) {
 	if mfm.returnError {
 		return nil, fmt.Errorf("mock error")
 	}
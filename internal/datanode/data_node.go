@@ -118,6 +118,7 @@ type DataNode struct {
 	sessionMu    sync.Mutex // to fix data race
 	session      *sessionutil.Session
 	watchKv      kv.WatchKV
+	watchEtcdCli *clientv3.Client // dedicated client of the channel watches, nil if disabled
 	chunkManager storage.ChunkManager
 	allocator    allocator.Allocator
 
@@ -519,7 +520,17 @@ func (node *DataNode) Start() error {
 		}
 
 		connectEtcdFn := func() error {
-			etcdKV := etcdkv.NewEtcdKV(node.etcdCli, Params.EtcdCfg.MetaRootPath.GetValue())
+			// the channel watches go through the dedicated client if enabled, so their bursts never starve the session
+			watchCli, err := etcdkv.NewWatchClient(&Params.EtcdCfg)
+			if err != nil {
+				return err
+			}
+			if watchCli == nil {
+				watchCli = node.etcdCli
+			} else {
+				node.watchEtcdCli = watchCli
+			}
+			etcdKV := etcdkv.NewEtcdKV(watchCli, Params.EtcdCfg.MetaRootPath.GetValue())
 			node.watchKv = etcdKV
 			return nil
 		}
@@ -609,6 +620,10 @@ func (node *DataNode) Stop() error {
 		}
 
 		node.wg.Wait()
+
+		if node.watchEtcdCli != nil {
+			node.watchEtcdCli.Close()
+		}
 	})
 	return nil
 }
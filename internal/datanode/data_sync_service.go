@@ -67,6 +67,7 @@ type dataSyncService struct {
 	stopOnce       sync.Once
 	flushListener  chan *segmentFlushPack // chan to listen flush event
 	timetickSender *timeTickSender        // reference to timeTickSender
+	ttProgress     *timeTickProgress      // the latest timetick went through the flowgraph
 }
 
 func newDataSyncService(ctx context.Context,
@@ -118,6 +119,7 @@ func newDataSyncService(ctx context.Context,
 		compactor:        compactor,
 		serverID:         serverID,
 		timetickSender:   timetickSender,
+		ttProgress:       newTimeTickProgress(),
 	}
 
 	if err := service.initNodes(vchan, tickler); err != nil {
@@ -184,8 +186,10 @@ func (dsService *dataSyncService) clearGlobalFlushingCache() {
 func (dsService *dataSyncService) initNodes(vchanInfo *datapb.VchannelInfo, tickler *tickler) error {
 	dsService.fg = flowgraph.NewTimeTickedFlowGraph(dsService.ctx)
 	// initialize flush manager for DataSync Service
-	dsService.flushManager = NewRendezvousFlushManager(dsService.idAllocator, dsService.chunkManager, dsService.channel,
+	fm := NewRendezvousFlushManager(dsService.idAllocator, dsService.chunkManager, dsService.channel,
 		flushNotifyFunc(dsService, retry.Attempts(50)), dropVirtualChannelFunc(dsService))
+	fm.encryptionKeys = newEncryptionKeyResolver(dsService.dataCoord, dsService.chunkManager, dsService.collectionID)
+	dsService.flushManager = fm
 
 	log.Info("begin to init data sync service", zap.Int64("collection", vchanInfo.CollectionID),
 		zap.String("Chan", vchanInfo.ChannelName),
@@ -342,7 +346,7 @@ func (dsService *dataSyncService) initNodes(vchanInfo *datapb.VchannelInfo, tick
 	}
 
 	var ttNode Node
-	ttNode, err = newTTNode(c, dsService.dataCoord)
+	ttNode, err = newTTNode(c, dsService.dataCoord, dsService.ttProgress)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,111 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// encryptionKeyCacheTTL is how long the key version of a collection is cached,
+// the binlogs flushed within it after a key rotation are still encrypted with the previous key,
+// which are re-encrypted by compaction later like the others.
+const encryptionKeyCacheTTL = time.Minute
+
+// encryptionKeyResolver resolves the key version to encrypt the binlogs of a collection with.
+type encryptionKeyResolver struct {
+	dataCoord    types.DataCoord
+	chunkManager storage.ChunkManager
+	collectionID UniqueID
+
+	mu        sync.Mutex
+	version   int64
+	expiredAt time.Time
+}
+
+func newEncryptionKeyResolver(dataCoord types.DataCoord, cm storage.ChunkManager, collectionID UniqueID) *encryptionKeyResolver {
+	return &encryptionKeyResolver{
+		dataCoord:    dataCoord,
+		chunkManager: cm,
+		collectionID: collectionID,
+	}
+}
+
+// resolve returns the current key version of the collection, 0 means the binlogs are written in plain text.
+func (r *encryptionKeyResolver) resolve(ctx context.Context) (int64, error) {
+	if r == nil {
+		return 0, nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if time.Now().Before(r.expiredAt) {
+		return r.version, nil
+	}
+	version, err := getEncryptionKeyVersion(ctx, r.dataCoord, r.collectionID)
+	if err != nil {
+		return 0, err
+	}
+	if err := checkEncryptionSupported(r.chunkManager, r.collectionID, version); err != nil {
+		return 0, err
+	}
+	r.version = version
+	r.expiredAt = time.Now().Add(encryptionKeyCacheTTL)
+	return version, nil
+}
+
+func getEncryptionKeyVersion(ctx context.Context, dataCoord types.DataCoord, collectionID UniqueID) (int64, error) {
+	resp, err := dataCoord.GetEncryptionStatus(ctx, &datapb.GetEncryptionStatusRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		CollectionID: collectionID,
+	})
+	if err != nil {
+		log.Warn("failed to get encryption status", zap.Int64("collectionID", collectionID), zap.Error(err))
+		return 0, err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		err := merr.Error(resp.GetStatus())
+		log.Warn("failed to get encryption status", zap.Int64("collectionID", collectionID), zap.Error(err))
+		return 0, err
+	}
+	if !resp.GetEnabled() {
+		return 0, nil
+	}
+	return resp.GetKeyVersion(), nil
+}
+
+// checkEncryptionSupported refuses to write the binlogs of the collection requiring encryption in plain text.
+func checkEncryptionSupported(cm storage.ChunkManager, collectionID UniqueID, keyVersion int64) error {
+	if keyVersion > 0 && !storage.SupportEncryption(cm) {
+		return fmt.Errorf("collection %d requires encrypted storage: %w", collectionID, storage.ErrEncryptionNotConfigured)
+	}
+	return nil
+}
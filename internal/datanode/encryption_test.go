@@ -0,0 +1,113 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+func TestEncryptionKeyResolver(t *testing.T) {
+	ctx := context.Background()
+	plainCM := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	keyring, err := storage.NewKeyring(base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef")))
+	require.NoError(t, err)
+	encryptedCM := storage.NewEncryptedChunkManager(plainCM, keyring)
+
+	t.Run("nil resolver", func(t *testing.T) {
+		var r *encryptionKeyResolver
+		version, err := r.resolve(ctx)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 0, version)
+	})
+
+	t.Run("cached", func(t *testing.T) {
+		dc := mocks.NewMockDataCoord(t)
+		dc.EXPECT().GetEncryptionStatus(mock.Anything, mock.Anything).Return(&datapb.GetEncryptionStatusResponse{
+			Status:     &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			Enabled:    true,
+			KeyVersion: 2,
+		}, nil).Once()
+		r := newEncryptionKeyResolver(dc, encryptedCM, 1)
+		for i := 0; i < 3; i++ {
+			version, err := r.resolve(ctx)
+			assert.NoError(t, err)
+			assert.EqualValues(t, 2, version)
+		}
+	})
+
+	t.Run("not enabled", func(t *testing.T) {
+		dc := mocks.NewMockDataCoord(t)
+		dc.EXPECT().GetEncryptionStatus(mock.Anything, mock.Anything).Return(&datapb.GetEncryptionStatusResponse{
+			Status:     &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			KeyVersion: 0,
+		}, nil)
+		version, err := newEncryptionKeyResolver(dc, plainCM, 1).resolve(ctx)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 0, version)
+	})
+
+	t.Run("master key not configured", func(t *testing.T) {
+		dc := mocks.NewMockDataCoord(t)
+		dc.EXPECT().GetEncryptionStatus(mock.Anything, mock.Anything).Return(&datapb.GetEncryptionStatusResponse{
+			Status:     &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			Enabled:    true,
+			KeyVersion: 1,
+		}, nil)
+		_, err := newEncryptionKeyResolver(dc, plainCM, 1).resolve(ctx)
+		assert.ErrorIs(t, err, storage.ErrEncryptionNotConfigured)
+	})
+
+	t.Run("datacoord failed", func(t *testing.T) {
+		dc := mocks.NewMockDataCoord(t)
+		dc.EXPECT().GetEncryptionStatus(mock.Anything, mock.Anything).Return(nil, errors.New("mock")).Once()
+		dc.EXPECT().GetEncryptionStatus(mock.Anything, mock.Anything).Return(&datapb.GetEncryptionStatusResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "mock"},
+		}, nil).Once()
+		r := newEncryptionKeyResolver(dc, encryptedCM, 1)
+		_, err := r.resolve(ctx)
+		assert.Error(t, err)
+		_, err = r.resolve(ctx)
+		assert.Error(t, err)
+	})
+}
+
+func TestChannelMeta_SegmentEncryptionKeyVersion(t *testing.T) {
+	channel := &ChannelMeta{segments: map[UniqueID]*Segment{1: {segmentID: 1}}}
+	assert.EqualValues(t, 0, channel.getSegmentEncryptionKeyVersion(1))
+
+	channel.updateSegmentEncryptionKeyVersion(1, 3)
+	assert.EqualValues(t, 3, channel.getSegmentEncryptionKeyVersion(1))
+	// the binlogs encrypted with the lower version are still there
+	channel.updateSegmentEncryptionKeyVersion(1, 2)
+	channel.updateSegmentEncryptionKeyVersion(1, 4)
+	assert.EqualValues(t, 2, channel.getSegmentEncryptionKeyVersion(1))
+
+	channel.updateSegmentEncryptionKeyVersion(2, 1)
+	assert.EqualValues(t, 0, channel.getSegmentEncryptionKeyVersion(2))
+}
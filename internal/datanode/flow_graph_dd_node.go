@@ -177,6 +177,7 @@ func (ddn *ddNode) Operate(in []Msg) []Msg {
 				log.Warn("filter invalid insert message, collection mis-match",
 					zap.Int64("Get collID", imsg.CollectionID),
 					zap.Int64("Expected collID", ddn.collectionID))
+				msgstream.RecycleMsg(imsg)
 				continue
 			}
 
@@ -186,10 +187,13 @@ func (ddn *ddNode) Operate(in []Msg) []Msg {
 					zap.Uint64("message timestamp", msg.EndTs()),
 					zap.String("segment's vChannel", imsg.GetShardName()),
 					zap.String("current vChannel", ddn.vChannelName))
+				msgstream.RecycleMsg(imsg)
 				continue
 			}
 
 			rateCol.Add(metricsinfo.InsertConsumeThroughput, float64(proto.Size(&imsg.InsertRequest)))
+			rateCol.addChannelWrite(ddn.vChannelName, channelInsertRowsLabel, channelInsertBytesLabel,
+				float64(imsg.NRows()), float64(proto.Size(&imsg.InsertRequest)))
 
 			metrics.DataNodeConsumeBytesCount.
 				WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.InsertLabel).
@@ -216,9 +220,12 @@ func (ddn *ddNode) Operate(in []Msg) []Msg {
 				log.Warn("filter invalid DeleteMsg, collection mis-match",
 					zap.Int64("Get collID", dmsg.CollectionID),
 					zap.Int64("Expected collID", ddn.collectionID))
+				msgstream.RecycleMsg(dmsg)
 				continue
 			}
 			rateCol.Add(metricsinfo.DeleteConsumeThroughput, float64(proto.Size(&dmsg.DeleteRequest)))
+			rateCol.addChannelWrite(ddn.vChannelName, channelDeleteRowsLabel, channelDeleteBytesLabel,
+				float64(dmsg.GetNumRows()), float64(proto.Size(&dmsg.DeleteRequest)))
 
 			metrics.DataNodeConsumeBytesCount.
 				WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.DeleteLabel).
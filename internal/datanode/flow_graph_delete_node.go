@@ -25,6 +25,8 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus/internal/datanode/allocator"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
@@ -41,6 +43,10 @@ type deleteNode struct {
 	delBufferManager *DeltaBufferManager // manager of delete msg
 	channel          Channel
 	flushManager     flushManager
+	allocator        allocator.Allocator
+
+	// the L0 segment of each partition, which the deletes on the flushed segments are buffered into
+	levelZeroSegments map[UniqueID]UniqueID
 
 	clearSignal chan<- string
 }
@@ -99,7 +105,7 @@ func (dn *deleteNode) Operate(in []Msg) []Msg {
 		log.Debug("Buffer delete request in DataNode", zap.String("traceID", traceID))
 		tmpSegIDs, err := dn.bufferDeleteMsg(msg, fgMsg.timeRange, fgMsg.startPositions[0], fgMsg.endPositions[0])
 		if err != nil {
-			// error occurs only when deleteMsg is misaligned, should not happen
+			// error occurs only when deleteMsg is misaligned or the L0 segment fails to be added, should not happen
 			err = fmt.Errorf("buffer delete msg failed, err = %s", err)
 			log.Error(err.Error())
 			panic(err)
@@ -111,6 +117,10 @@ func (dn *deleteNode) Operate(in []Msg) []Msg {
 	if len(fgMsg.deleteMessages) != 0 {
 		dn.showDelBuf(segIDs.Collect(), fgMsg.timeRange.timestampMax)
 	}
+	// the delete messages are copied into the buffer, recycle them to reuse the memory
+	for _, msg := range fgMsg.deleteMessages {
+		msgstream.RecycleMsg(msg)
+	}
 
 	// process flush messages
 	if len(fgMsg.segmentsToSync) > 0 {
@@ -119,6 +129,7 @@ func (dn *deleteNode) Operate(in []Msg) []Msg {
 			zap.String("vChannelName", dn.channelName),
 			zap.Time("posTime", tsoutil.PhysicalTime(fgMsg.endPositions[0].Timestamp)))
 		for _, segmentToFlush := range fgMsg.segmentsToSync {
+			dn.rotateLevelZeroSegment(segmentToFlush)
 			buf, ok := dn.delBufferManager.Load(segmentToFlush)
 			if !ok {
 				// no related delta data to flush, send empty buf to complete flush life-cycle
@@ -157,6 +168,11 @@ func (dn *deleteNode) bufferDeleteMsg(msg *msgstream.DeleteMsg, tr TimeRange, st
 
 	primaryKeys := storage.ParseIDs2PrimaryKeys(msg.PrimaryKeys)
 	segIDToPks, segIDToTss := dn.filterSegmentByPK(msg.PartitionID, primaryKeys, msg.Timestamps)
+	if Params.DataNodeCfg.LevelZeroSegmentEnable.GetAsBool() {
+		if err := dn.routeToLevelZero(msg.PartitionID, segIDToPks, segIDToTss, startPos, endPos); err != nil {
+			return nil, err
+		}
+	}
 
 	segIDs := make([]UniqueID, 0, len(segIDToPks))
 	for segID, pks := range segIDToPks {
@@ -193,6 +209,90 @@ func (dn *deleteNode) filterSegmentByPK(partID UniqueID, pks []primaryKey, tss [
 	return segID2Pks, segID2Tss
 }
 
+// routeToLevelZero moves the deletes on the flushed segments to the L0 segment of the partition,
+// so that they're written once, instead of into the deltalogs of every flushed segment which may contain the keys.
+// The deletes on the growing segments are kept, since DataCoord merges the L0 segments into the flushed segments only.
+func (dn *deleteNode) routeToLevelZero(partitionID UniqueID, segIDToPks map[UniqueID][]primaryKey, segIDToTss map[UniqueID][]uint64,
+	startPos, endPos *msgpb.MsgPosition) error {
+	type deleteKey struct {
+		pk interface{}
+		ts Timestamp
+	}
+	var (
+		pks     []primaryKey
+		tss     []uint64
+		deleted = make(map[deleteKey]struct{})
+	)
+	for segID, segPks := range segIDToPks {
+		segment := dn.channel.getSegment(segID)
+		if segment == nil || segment.getType() != datapb.SegmentType_Flushed {
+			continue
+		}
+		segTss := segIDToTss[segID]
+		if len(segPks) != len(segTss) {
+			return fmt.Errorf("primary keys and timestamp's element num mis-match, segmentID = %d", segID)
+		}
+		for i, pk := range segPks {
+			key := deleteKey{pk: pk.GetValue(), ts: segTss[i]}
+			if _, ok := deleted[key]; ok {
+				continue
+			}
+			deleted[key] = struct{}{}
+			pks = append(pks, pk)
+			tss = append(tss, segTss[i])
+		}
+		delete(segIDToPks, segID)
+		delete(segIDToTss, segID)
+	}
+	if len(pks) == 0 {
+		return nil
+	}
+
+	segID, err := dn.levelZeroSegment(partitionID, startPos, endPos)
+	if err != nil {
+		return err
+	}
+	segIDToPks[segID] = pks
+	segIDToTss[segID] = tss
+	return nil
+}
+
+// levelZeroSegment returns the L0 segment of the partition, adds one if there isn't.
+func (dn *deleteNode) levelZeroSegment(partitionID UniqueID, startPos, endPos *msgpb.MsgPosition) (UniqueID, error) {
+	if segID, ok := dn.levelZeroSegments[partitionID]; ok {
+		return segID, nil
+	}
+	segID, err := dn.allocator.AllocOne()
+	if err != nil {
+		return 0, err
+	}
+	err = dn.channel.addSegment(addSegmentReq{
+		segType:     datapb.SegmentType_New,
+		segID:       segID,
+		collID:      dn.channel.getCollectionID(),
+		partitionID: partitionID,
+		startPos:    startPos,
+		endPos:      endPos,
+		level:       datapb.SegmentLevel_L0,
+	})
+	if err != nil {
+		return 0, err
+	}
+	dn.levelZeroSegments[partitionID] = segID
+	return segID, nil
+}
+
+// rotateLevelZeroSegment stops buffering deletes into the L0 segment once it's synced,
+// DataCoord adds an L0 segment by its only SaveBinlogPaths request, the following deletes go to a new one.
+func (dn *deleteNode) rotateLevelZeroSegment(segID UniqueID) {
+	for partitionID, id := range dn.levelZeroSegments {
+		if id == segID {
+			delete(dn.levelZeroSegments, partitionID)
+			return
+		}
+	}
+}
+
 func newDeleteNode(ctx context.Context, fm flushManager, manager *DeltaBufferManager, sig chan<- string, config *nodeConfig) (*deleteNode, error) {
 	baseNode := BaseNode{}
 	baseNode.SetMaxQueueLength(config.maxQueueLength)
@@ -205,6 +305,9 @@ func newDeleteNode(ctx context.Context, fm flushManager, manager *DeltaBufferMan
 		channel:          config.channel,
 		channelName:      config.vChannelName,
 		flushManager:     fm,
+		allocator:        config.allocator,
 		clearSignal:      sig,
+
+		levelZeroSegments: make(map[UniqueID]UniqueID),
 	}, nil
 }
@@ -23,6 +23,7 @@ import (
 	"time"
 
 	bloom "github.com/bits-and-blooms/bloom/v3"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -541,3 +542,64 @@ func TestFlowGraphDeleteNode_showDelBuf(t *testing.T) {
 
 	delNode.showDelBuf([]UniqueID{111, 112, 113}, 100)
 }
+
+func TestFlowGraphDeleteNode_levelZero(t *testing.T) {
+	paramtable.Get().Save(Params.DataNodeCfg.LevelZeroSegmentEnable.Key, "true")
+	defer paramtable.Get().Reset(Params.DataNodeCfg.LevelZeroSegmentEnable.Key)
+
+	chanName := "datanode-test-FlowGraphDeletenode-levelZero"
+	segIDs := []int64{11, 22, 33, 44, 55}
+	pks := []primaryKey{
+		newInt64PrimaryKey(3),
+		newInt64PrimaryKey(17),
+		newInt64PrimaryKey(44),
+		newInt64PrimaryKey(190),
+		newInt64PrimaryKey(425),
+	}
+	channel := genMockChannel(segIDs, pks, chanName)
+	alloc := allocator.NewMockAllocator(t)
+	alloc.EXPECT().AllocOne().Return(100, nil).Once()
+	alloc.EXPECT().AllocOne().Return(101, nil).Once()
+	c := &nodeConfig{
+		channel:      channel,
+		vChannelName: chanName,
+		allocator:    alloc,
+	}
+	delBufManager := &DeltaBufferManager{
+		channel:    channel,
+		delBufHeap: &PriorityQueue{},
+	}
+	dn, err := newDeleteNode(context.Background(), nil, delBufManager, make(chan string, 1), c)
+	require.NoError(t, err)
+
+	msg := genFlowGraphDeleteMsg(pks, chanName)
+	deleteMsg := msg.deleteMessages[0]
+	deleteMsg.PartitionID = 0
+	buffered, err := dn.bufferDeleteMsg(deleteMsg, msg.timeRange, msg.startPositions[0], msg.endPositions[0])
+	require.NoError(t, err)
+	// the deletes on the flushed segments are buffered into the L0 segment once
+	assert.ElementsMatch(t, []int64{11, 22, 33, 100}, buffered)
+	assert.EqualValues(t, 2, delBufManager.GetEntriesNum(100))
+	assert.Zero(t, delBufManager.GetEntriesNum(44))
+	assert.Zero(t, delBufManager.GetEntriesNum(55))
+	l0Segment := channel.getSegment(100)
+	require.NotNil(t, l0Segment)
+	assert.True(t, l0Segment.isLevelZero())
+	assert.EqualValues(t, 0, l0Segment.partitionID)
+	assert.NotContains(t, channel.filterSegments(0), l0Segment)
+	assert.Empty(t, lo.Filter(channel.listNewSegmentsStartPositions(), func(pos *datapb.SegmentStartPosition, _ int) bool {
+		return pos.GetSegmentID() == 100
+	}))
+
+	// the following deletes are buffered into the same L0 segment until it's synced
+	buffered, err = dn.bufferDeleteMsg(deleteMsg, msg.timeRange, msg.startPositions[0], msg.endPositions[0])
+	require.NoError(t, err)
+	assert.Contains(t, buffered, int64(100))
+	assert.EqualValues(t, 4, delBufManager.GetEntriesNum(100))
+
+	dn.rotateLevelZeroSegment(100)
+	buffered, err = dn.bufferDeleteMsg(deleteMsg, msg.timeRange, msg.startPositions[0], msg.endPositions[0])
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []int64{11, 22, 33, 101}, buffered)
+	assert.EqualValues(t, 2, delBufManager.GetEntriesNum(101))
+}
@@ -209,6 +209,11 @@ func (ibNode *insertBufferNode) Operate(in []Msg) []Msg {
 
 	ibNode.WriteTimeTick(fgMsg.timeRange.timestampMax, seg2Upload)
 
+	// the insert messages are copied into the buffer, recycle them to reuse the memory
+	for _, msg := range fgMsg.insertMessages {
+		msgstream.RecycleMsg(msg)
+	}
+
 	res := flowGraphMsg{
 		deleteMessages: fgMsg.deleteMessages,
 		timeRange:      fgMsg.timeRange,
@@ -485,10 +490,11 @@ func (ibNode *insertBufferNode) Sync(fgMsg *flowGraphMsg, seg2Upload []UniqueID,
 		log.Info("insertBufferNode syncing BufferData")
 		// use the flushed pk stats to take current stat
 		var pkStats *storage.PrimaryKeyStats
+		traceCtx, sp := startFlushTracer(ibNode.ctx, fgMsg.insertMessages, task.segmentID)
 		// TODO, this has to be async flush, no need to block here.
 		err := retry.Do(ibNode.ctx, func() error {
 			var err error
-			pkStats, err = ibNode.flushManager.flushBufferData(task.buffer,
+			pkStats, err = ibNode.flushManager.flushBufferData(traceCtx, task.buffer,
 				task.segmentID,
 				task.flushed,
 				task.dropped,
@@ -498,6 +504,7 @@ func (ibNode *insertBufferNode) Sync(fgMsg *flowGraphMsg, seg2Upload []UniqueID,
 			}
 			return nil
 		}, getFlowGraphRetryOpt())
+		sp.End()
 		if err != nil {
 			metrics.DataNodeFlushBufferCount.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.FailLabel).Inc()
 			metrics.DataNodeFlushBufferCount.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.TotalLabel).Inc()
@@ -36,6 +36,8 @@ import (
 
 type flowgraphManager struct {
 	flowgraphs *typeutil.ConcurrentMap[string, *dataSyncService]
+	// vchannel -> last alert time, only accessed by the loop of start
+	stalledChannels map[string]time.Time
 
 	closeCh   chan struct{}
 	closeOnce sync.Once
@@ -57,6 +59,7 @@ func (fm *flowgraphManager) start() {
 			return
 		case <-ticker.C:
 			fm.execute(hardware.GetMemoryCount())
+			fm.checkTimeTickStall()
 		}
 	}
 }
@@ -120,6 +123,7 @@ func (fm *flowgraphManager) addAndStart(dn *DataNode, vchan *datapb.VchannelInfo
 		log.Warn("fail to create new datasyncservice", zap.Error(err))
 		return err
 	}
+	rateCol.registerFlowGraphChannel(vchan.GetChannelName())
 	dataSyncService.start()
 	fm.flowgraphs.Insert(vchan.GetChannelName(), dataSyncService)
 
@@ -177,9 +181,14 @@ func (fm *flowgraphManager) getChannel(segID UniqueID) (Channel, error) {
 // resendTT loops through flow graphs, looks for segments that are not flushed,
 // and sends them to that flow graph's `resendTTCh` channel so stats of
 // these segments will be resent.
-func (fm *flowgraphManager) resendTT() []UniqueID {
+// resendTT resends the stats of the unflushed segments of the flowgraphs,
+// filtered by the collection and the channel if they are set.
+func (fm *flowgraphManager) resendTT(collectionID UniqueID, channel string) []UniqueID {
 	var unFlushedSegments []UniqueID
 	fm.flowgraphs.Range(func(key string, fg *dataSyncService) bool {
+		if (collectionID != 0 && fg.collectionID != collectionID) || (channel != "" && key != channel) {
+			return true
+		}
 		segIDs := fg.channel.listNotFlushedSegmentIDs()
 		if len(segIDs) > 0 {
 			log.Info("un-flushed segments found, stats will be resend",
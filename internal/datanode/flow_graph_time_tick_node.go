@@ -49,6 +49,7 @@ type ttNode struct {
 	channel        Channel
 	lastUpdateTime time.Time
 	dataCoord      types.DataCoord
+	progress       *timeTickProgress
 }
 
 // Name returns node name, implementing flowgraph.Node
@@ -81,6 +82,7 @@ func (ttn *ttNode) Operate(in []Msg) []Msg {
 		return in
 	}
 
+	ttn.progress.update(fgMsg.timeRange.timestampMax)
 	curTs, _ := tsoutil.ParseTS(fgMsg.timeRange.timestampMax)
 	if curTs.Sub(ttn.lastUpdateTime) >= updateChanCPInterval {
 		ttn.updateChannelCP(fgMsg.endPositions[0])
@@ -119,7 +121,7 @@ func (ttn *ttNode) updateChannelCP(ttPos *msgpb.MsgPosition) {
 		zap.Time("cpTime", channelCPTs))
 }
 
-func newTTNode(config *nodeConfig, dc types.DataCoord) (*ttNode, error) {
+func newTTNode(config *nodeConfig, dc types.DataCoord, progress *timeTickProgress) (*ttNode, error) {
 	baseNode := BaseNode{}
 	baseNode.SetMaxQueueLength(Params.DataNodeCfg.FlowGraphMaxQueueLength.GetAsInt32())
 	baseNode.SetMaxParallelism(Params.DataNodeCfg.FlowGraphMaxParallelism.GetAsInt32())
@@ -130,6 +132,7 @@ func newTTNode(config *nodeConfig, dc types.DataCoord) (*ttNode, error) {
 		channel:        config.channel,
 		lastUpdateTime: time.Time{}, // set to Zero to update channel checkpoint immediately after fg started
 		dataCoord:      dc,
+		progress:       progress,
 	}
 
 	return tt, nil
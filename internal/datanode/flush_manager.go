@@ -49,7 +49,7 @@ import (
 // flushManager defines a flush manager signature
 type flushManager interface {
 	// notify flush manager insert buffer data
-	flushBufferData(data *BufferData, segmentID UniqueID, flushed bool, dropped bool, pos *msgpb.MsgPosition) (*storage.PrimaryKeyStats, error)
+	flushBufferData(ctx context.Context, data *BufferData, segmentID UniqueID, flushed bool, dropped bool, pos *msgpb.MsgPosition) (*storage.PrimaryKeyStats, error)
 	// notify flush manager del buffer data
 	flushDelData(data *DelDataBuf, segmentID UniqueID, pos *msgpb.MsgPosition) error
 	// isFull return true if the task pool is full
@@ -277,6 +277,9 @@ type rendezvousFlushManager struct {
 
 	dropping    atomic.Bool
 	dropHandler dropHandler
+
+	// resolves the key version to encrypt the binlogs with, nil means in plain text
+	encryptionKeys *encryptionKeyResolver
 }
 
 // getFlushQueue gets or creates an orderFlushQueue for segment id if not found
@@ -430,7 +433,7 @@ func (m *rendezvousFlushManager) isFull() bool {
 
 // flushBufferData notifies flush manager insert buffer data.
 // This method will be retired on errors. Final errors will be propagated upstream and logged.
-func (m *rendezvousFlushManager) flushBufferData(data *BufferData, segmentID UniqueID, flushed bool, dropped bool, pos *msgpb.MsgPosition) (*storage.PrimaryKeyStats, error) {
+func (m *rendezvousFlushManager) flushBufferData(ctx context.Context, data *BufferData, segmentID UniqueID, flushed bool, dropped bool, pos *msgpb.MsgPosition) (*storage.PrimaryKeyStats, error) {
 	field2Insert := make(map[UniqueID]*datapb.Binlog)
 	field2Stats := make(map[UniqueID]*datapb.Binlog)
 	kvs := make(map[string][]byte)
@@ -441,6 +444,10 @@ func (m *rendezvousFlushManager) flushBufferData(data *BufferData, segmentID Uni
 	if err != nil {
 		return nil, err
 	}
+	keyVersion, err := m.encryptionKeys.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
 	inCodec := storage.NewInsertCodecWithSchema(meta)
 	// build bin log blob
 	binLogBlobs, fieldMemorySize, err := m.serializeBinLog(segmentID, partID, data, inCodec)
@@ -516,9 +523,15 @@ func (m *rendezvousFlushManager) flushBufferData(data *BufferData, segmentID Uni
 		}
 	}
 
+	if len(kvs) > 0 {
+		m.updateSegmentEncryptionKeyVersion(segmentID, keyVersion)
+	}
 	m.handleInsertTask(segmentID, &flushBufferInsertTask{
 		ChunkManager: m.ChunkManager,
 		data:         kvs,
+		traceCtx:     ctx,
+		collectionID: collID,
+		keyVersion:   keyVersion,
 	}, field2Insert, field2Stats, flushed, dropped, pos)
 
 	metrics.DataNodeEncodeBufferLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Observe(float64(tr.ElapseSpan().Milliseconds()))
@@ -539,6 +552,10 @@ func (m *rendezvousFlushManager) flushDelData(data *DelDataBuf, segmentID Unique
 	if err != nil {
 		return err
 	}
+	keyVersion, err := m.encryptionKeys.resolve(context.Background())
+	if err != nil {
+		return err
+	}
 
 	delCodec := storage.NewDeleteCodec()
 
@@ -559,9 +576,12 @@ func (m *rendezvousFlushManager) flushDelData(data *DelDataBuf, segmentID Unique
 	data.LogSize = int64(len(blob.Value))
 	data.LogPath = blobPath
 	log.Info("delete blob path", zap.String("path", blobPath))
+	m.updateSegmentEncryptionKeyVersion(segmentID, keyVersion)
 	m.handleDeleteTask(segmentID, &flushBufferDeleteTask{
 		ChunkManager: m.ChunkManager,
 		data:         kvs,
+		collectionID: collID,
+		keyVersion:   keyVersion,
 	}, data, pos)
 	return nil
 }
@@ -661,16 +681,22 @@ func (m *rendezvousFlushManager) close() {
 type flushBufferInsertTask struct {
 	storage.ChunkManager
 	data map[string][]byte
+	// the trace of the flush, attached to the flush latency as an exemplar
+	traceCtx context.Context
+
+	collectionID UniqueID
+	keyVersion   int64 // the key version to encrypt the binlogs with, 0 means in plain text
 }
 
 // flushInsertData implements flushInsertTask
 func (t *flushBufferInsertTask) flushInsertData() error {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
+	ctx = storage.WithEncryptionKey(ctx, t.collectionID, t.keyVersion)
 	if t.ChunkManager != nil && len(t.data) > 0 {
 		tr := timerecord.NewTimeRecorder("insertData")
 		err := t.MultiWrite(ctx, t.data)
-		metrics.DataNodeSave2StorageLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.InsertLabel).Observe(float64(tr.ElapseSpan().Milliseconds()))
+		metrics.ObserveWithTrace(t.traceCtx, metrics.DataNodeSave2StorageLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.InsertLabel), float64(tr.ElapseSpan().Milliseconds()))
 		if err == nil {
 			for _, d := range t.data {
 				metrics.DataNodeFlushedSize.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.InsertLabel).Add(float64(len(d)))
@@ -684,12 +710,16 @@ func (t *flushBufferInsertTask) flushInsertData() error {
 type flushBufferDeleteTask struct {
 	storage.ChunkManager
 	data map[string][]byte
+
+	collectionID UniqueID
+	keyVersion   int64 // the key version to encrypt the deltalogs with, 0 means in plain text
 }
 
 // flushDeleteData implements flushDeleteTask
 func (t *flushBufferDeleteTask) flushDeleteData() error {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
+	ctx = storage.WithEncryptionKey(ctx, t.collectionID, t.keyVersion)
 	if len(t.data) > 0 && t.ChunkManager != nil {
 		tr := timerecord.NewTimeRecorder("deleteData")
 		err := t.MultiWrite(ctx, t.data)
@@ -904,6 +934,20 @@ func flushNotifyFunc(dsService *dataSyncService, opts ...retry.Option) notifyMet
 			Flushed:        pack.flushed,
 			Dropped:        pack.dropped,
 			Channel:        dsService.vchannelName,
+
+			EncryptionKeyVersion: dsService.channel.getSegmentEncryptionKeyVersion(pack.segmentID),
+		}
+		levelZero := dsService.channel.getSegment(pack.segmentID)
+		if levelZero.isLevelZero() {
+			// the L0 segment is added to DataCoord by this request
+			req.SegLevel = datapb.SegmentLevel_L0
+			req.PartitionID = levelZero.partitionID
+			req.StartPositions = append(req.StartPositions, &datapb.SegmentStartPosition{
+				SegmentID:     pack.segmentID,
+				StartPosition: levelZero.startPos,
+			})
+		} else {
+			levelZero = nil
 		}
 		err := retry.Do(context.Background(), func() error {
 			rsp, err := dsService.dataCoord.SaveBinlogPaths(context.Background(), req)
@@ -944,7 +988,8 @@ func flushNotifyFunc(dsService *dataSyncService, opts ...retry.Option) notifyMet
 			// TODO change to graceful stop
 			panic(err)
 		}
-		if pack.dropped {
+		if pack.dropped || levelZero != nil {
+			// the L0 segment is synced only once
 			dsService.channel.removeSegments(pack.segmentID)
 		} else if pack.flushed {
 			dsService.channel.segmentFlushed(pack.segmentID)
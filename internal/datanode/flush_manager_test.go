@@ -201,7 +201,7 @@ func TestRendezvousFlushManager(t *testing.T) {
 		})
 		assert.NoError(t, err)
 
-		_, err = m.flushBufferData(nil, testSeg.segmentID, true, false, &msgpb.MsgPosition{
+		_, err = m.flushBufferData(context.TODO(), nil, testSeg.segmentID, true, false, &msgpb.MsgPosition{
 			MsgID: ids[i],
 		})
 		assert.NoError(t, err)
@@ -266,7 +266,7 @@ func TestRendezvousFlushManager_Inject(t *testing.T) {
 		})
 		assert.NoError(t, err)
 
-		_, err = m.flushBufferData(nil, 1, true, false, &msgpb.MsgPosition{
+		_, err = m.flushBufferData(context.TODO(), nil, 1, true, false, &msgpb.MsgPosition{
 			MsgID: ids[i],
 		})
 		assert.NoError(t, err)
@@ -277,12 +277,12 @@ func TestRendezvousFlushManager_Inject(t *testing.T) {
 	rand.Read(id)
 	id2 := make([]byte, 10)
 	rand.Read(id2)
-	_, err := m.flushBufferData(nil, 2, true, false, &msgpb.MsgPosition{
+	_, err := m.flushBufferData(context.TODO(), nil, 2, true, false, &msgpb.MsgPosition{
 		MsgID: id,
 	})
 
 	assert.NoError(t, err)
-	_, err = m.flushBufferData(nil, 3, true, false, &msgpb.MsgPosition{
+	_, err = m.flushBufferData(context.TODO(), nil, 3, true, false, &msgpb.MsgPosition{
 		MsgID: id2,
 	})
 	assert.NoError(t, err)
@@ -310,7 +310,7 @@ func TestRendezvousFlushManager_Inject(t *testing.T) {
 
 	rand.Read(id)
 
-	_, err = m.flushBufferData(nil, 2, false, false, &msgpb.MsgPosition{
+	_, err = m.flushBufferData(context.TODO(), nil, 2, false, false, &msgpb.MsgPosition{
 		MsgID: id,
 	})
 	assert.NoError(t, err)
@@ -414,7 +414,7 @@ func TestRendezvousFlushManager_waitForAllFlushQueue(t *testing.T) {
 	mut.RUnlock()
 
 	for i := 0; i < size/2; i++ {
-		_, err := m.flushBufferData(nil, 1, true, false, &msgpb.MsgPosition{
+		_, err := m.flushBufferData(context.TODO(), nil, 1, true, false, &msgpb.MsgPosition{
 			MsgID: ids[i],
 		})
 		assert.NoError(t, err)
@@ -425,7 +425,7 @@ func TestRendezvousFlushManager_waitForAllFlushQueue(t *testing.T) {
 	mut.RUnlock()
 
 	for i := size / 2; i < size; i++ {
-		_, err := m.flushBufferData(nil, 1, true, false, &msgpb.MsgPosition{
+		_, err := m.flushBufferData(context.TODO(), nil, 1, true, false, &msgpb.MsgPosition{
 			MsgID: ids[i],
 		})
 		assert.NoError(t, err)
@@ -484,7 +484,7 @@ func TestRendezvousFlushManager_dropMode(t *testing.T) {
 		})
 
 		halfMsgID := []byte{1, 1, 1}
-		_, err := m.flushBufferData(nil, -1, true, false, &msgpb.MsgPosition{
+		_, err := m.flushBufferData(context.TODO(), nil, -1, true, false, &msgpb.MsgPosition{
 			MsgID: halfMsgID,
 		})
 		assert.NoError(t, err)
@@ -497,7 +497,7 @@ func TestRendezvousFlushManager_dropMode(t *testing.T) {
 		assert.NoError(t, err)
 
 		for target := range targets {
-			_, err := m.flushBufferData(nil, target, true, false, &msgpb.MsgPosition{
+			_, err := m.flushBufferData(context.TODO(), nil, target, true, false, &msgpb.MsgPosition{
 				MsgID: []byte{byte(target)},
 			})
 			assert.NoError(t, err)
@@ -560,7 +560,7 @@ func TestRendezvousFlushManager_dropMode(t *testing.T) {
 
 		//flush failed segment before start drop mode
 		halfMsgID := []byte{1, 1, 1}
-		_, err := m.flushBufferData(nil, -1, true, false, &msgpb.MsgPosition{
+		_, err := m.flushBufferData(context.TODO(), nil, -1, true, false, &msgpb.MsgPosition{
 			MsgID: halfMsgID,
 		})
 		assert.NoError(t, err)
@@ -584,7 +584,7 @@ func TestRendezvousFlushManager_dropMode(t *testing.T) {
 		assert.NoError(t, err)
 
 		for i := 1; i < 11; i++ {
-			_, err = m.flushBufferData(nil, int64(i), true, false, &msgpb.MsgPosition{
+			_, err = m.flushBufferData(context.TODO(), nil, int64(i), true, false, &msgpb.MsgPosition{
 				MsgID: []byte{byte(i)},
 			})
 			assert.NoError(t, err)
@@ -642,7 +642,7 @@ func TestRendezvousFlushManager_close(t *testing.T) {
 		})
 		assert.NoError(t, err)
 
-		_, err = m.flushBufferData(nil, 1, true, false, &msgpb.MsgPosition{
+		_, err = m.flushBufferData(context.TODO(), nil, 1, true, false, &msgpb.MsgPosition{
 			MsgID: ids[i],
 		})
 		assert.NoError(t, err)
@@ -58,6 +58,17 @@ func (node *DataNode) getQuotaMetrics() (*metricsinfo.DataNodeQuotaMetrics, erro
 
 		return collectionSet.Collect()
 	}
+	channelRates := make([]metricsinfo.ChannelWriteRate, 0)
+	node.flowgraphManager.flowgraphs.Range(func(channel string, _ *dataSyncService) bool {
+		rate, err := rateCol.getChannelWriteRate(channel, ratelimitutil.DefaultAvgDuration)
+		if err != nil {
+			// the flow graph is being added or released
+			return true
+		}
+		channelRates = append(channelRates, rate)
+		return true
+	})
+
 	minFGChannel, minFGTt := rateCol.getMinFlowGraphTt()
 	return &metricsinfo.DataNodeQuotaMetrics{
 		Hms: metricsinfo.HardwareMetrics{},
@@ -71,6 +82,7 @@ func (node *DataNode) getQuotaMetrics() (*metricsinfo.DataNodeQuotaMetrics, erro
 			NodeID:        node.GetSession().ServerID,
 			CollectionIDs: getAllCollections(),
 		},
+		ChannelRates: channelRates,
 	}, nil
 }
 
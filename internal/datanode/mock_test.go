@@ -382,6 +382,14 @@ func (ds *DataCoordFactory) GetSegmentInfo(ctx context.Context, req *datapb.GetS
 	}, nil
 }
 
+func (ds *DataCoordFactory) GetEncryptionStatus(ctx context.Context, req *datapb.GetEncryptionStatusRequest) (*datapb.GetEncryptionStatusResponse, error) {
+	return &datapb.GetEncryptionStatusResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+	}, nil
+}
+
 func (mf *MetaFactory) GetCollectionMeta(collectionID UniqueID, collectionName string, pkDataType schemapb.DataType) *etcdpb.CollectionMeta {
 	sch := schemapb.CollectionSchema{
 		Name:        collectionName,
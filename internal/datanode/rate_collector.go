@@ -18,7 +18,9 @@ package datanode
 
 import (
 	"sync"
+	"time"
 
+	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/util/ratelimitutil"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
@@ -55,6 +57,52 @@ func newRateCollector() (*rateCollector, error) {
 	}, nil
 }
 
+// Labels of the write rates of each flow graph channel.
+const (
+	channelInsertRowsLabel  = "ChannelInsertRows"
+	channelInsertBytesLabel = "ChannelInsertBytes"
+	channelDeleteRowsLabel  = "ChannelDeleteRows"
+	channelDeleteBytesLabel = "ChannelDeleteBytes"
+)
+
+var channelRateLabels = []string{channelInsertRowsLabel, channelInsertBytesLabel, channelDeleteRowsLabel, channelDeleteBytesLabel}
+
+func channelRateLabel(label string, channel string) string {
+	return label + "-" + channel
+}
+
+// registerFlowGraphChannel registers the write rates of the flow graph channel.
+func (r *rateCollector) registerFlowGraphChannel(channel string) {
+	for _, label := range channelRateLabels {
+		r.Register(channelRateLabel(label, channel))
+	}
+}
+
+// addChannelWrite adds the rows and bytes written to the flow graph channel.
+func (r *rateCollector) addChannelWrite(channel string, rowsLabel, bytesLabel string, rows, bytes float64) {
+	r.Add(channelRateLabel(rowsLabel, channel), rows)
+	r.Add(channelRateLabel(bytesLabel, channel), bytes)
+}
+
+// getChannelWriteRate returns the average write rates of the flow graph channel over the duration.
+func (r *rateCollector) getChannelWriteRate(channel string, duration time.Duration) (metricsinfo.ChannelWriteRate, error) {
+	rates := make([]float64, 0, len(channelRateLabels))
+	for _, label := range channelRateLabels {
+		rate, err := r.Rate(channelRateLabel(label, channel), duration)
+		if err != nil {
+			return metricsinfo.ChannelWriteRate{}, err
+		}
+		rates = append(rates, rate)
+	}
+	return metricsinfo.ChannelWriteRate{
+		Channel:         channel,
+		InsertRowsRate:  rates[0],
+		InsertBytesRate: rates[1],
+		DeleteRowsRate:  rates[2],
+		DeleteBytesRate: rates[3],
+	}, nil
+}
+
 // updateFlowGraphTt updates rateCollector's flow graph time tick.
 func (r *rateCollector) updateFlowGraphTt(channel string, t Timestamp) {
 	r.flowGraphTtMu.Lock()
@@ -67,6 +115,9 @@ func (r *rateCollector) removeFlowGraphChannel(channel string) {
 	r.flowGraphTtMu.Lock()
 	defer r.flowGraphTtMu.Unlock()
 	delete(r.flowGraphTt, channel)
+	for _, label := range channelRateLabels {
+		r.Deregister(channelRateLabel(label, channel))
+	}
 }
 
 // getMinFlowGraphTt returns the vchannel and minimal time tick of flow graphs.
@@ -21,6 +21,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/milvus-io/milvus/pkg/util/ratelimitutil"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
@@ -39,4 +40,31 @@ func TestRateCollector(t *testing.T) {
 		assert.Equal(t, "channel3", c)
 		assert.Equal(t, Timestamp(50), minTt)
 	})
+
+	t.Run("test channel write rate", func(t *testing.T) {
+		collector, err := newRateCollector()
+		assert.NoError(t, err)
+
+		_, err = collector.getChannelWriteRate("channel1", ratelimitutil.DefaultAvgDuration)
+		assert.Error(t, err)
+
+		collector.registerFlowGraphChannel("channel1")
+		collector.addChannelWrite("channel1", channelInsertRowsLabel, channelInsertBytesLabel, 30, 300)
+		collector.addChannelWrite("channel1", channelDeleteRowsLabel, channelDeleteBytesLabel, 3, 30)
+		// not registered
+		collector.addChannelWrite("channel2", channelInsertRowsLabel, channelInsertBytesLabel, 30, 300)
+
+		seconds := ratelimitutil.DefaultAvgDuration.Seconds()
+		rate, err := collector.getChannelWriteRate("channel1", ratelimitutil.DefaultAvgDuration)
+		assert.NoError(t, err)
+		assert.Equal(t, "channel1", rate.Channel)
+		assert.InDelta(t, 30/seconds, rate.InsertRowsRate, 1e-9)
+		assert.InDelta(t, 300/seconds, rate.InsertBytesRate, 1e-9)
+		assert.InDelta(t, 3/seconds, rate.DeleteRowsRate, 1e-9)
+		assert.InDelta(t, 30/seconds, rate.DeleteBytesRate, 1e-9)
+
+		collector.removeFlowGraphChannel("channel1")
+		_, err = collector.getChannelWriteRate("channel1", ratelimitutil.DefaultAvgDuration)
+		assert.Error(t, err)
+	})
 }
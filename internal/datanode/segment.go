@@ -41,6 +41,7 @@ type Segment struct {
 	partitionID  UniqueID
 	segmentID    UniqueID
 	sType        atomic.Value // datapb.SegmentType
+	level        datapb.SegmentLevel
 
 	numRows     int64
 	memorySize  int64
@@ -60,6 +61,15 @@ type Segment struct {
 	lazyLoading atomic.Value
 	syncing     atomic.Value
 	released    atomic.Value
+
+	// the lowest key version the binlogs flushed by this node are encrypted with, see encryptionKeyResolver
+	encryptionKeyVersion int64
+	encryptionKeySet     bool
+}
+
+// isLevelZero returns whether the segment is an L0 segment holding only the deletes.
+func (s *Segment) isLevelZero() bool {
+	return s != nil && s.level == datapb.SegmentLevel_L0
 }
 
 func (s *Segment) isSyncing() bool {
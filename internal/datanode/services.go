@@ -34,11 +34,13 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/datanode/allocator"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/importutil"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
@@ -167,8 +169,10 @@ func (node *DataNode) FlushSegments(ctx context.Context, req *datapb.FlushSegmen
 // It returns a list of segments to be sent.
 func (node *DataNode) ResendSegmentStats(ctx context.Context, req *datapb.ResendSegmentStatsRequest) (*datapb.ResendSegmentStatsResponse, error) {
 	log.Info("start resending segment stats, if any",
-		zap.Int64("DataNode ID", paramtable.GetNodeID()))
-	segResent := node.flowgraphManager.resendTT()
+		zap.Int64("DataNode ID", paramtable.GetNodeID()),
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.String("channel", req.GetChannelName()))
+	segResent := node.flowgraphManager.resendTT(req.GetCollectionID(), req.GetChannelName())
 	log.Info("found segment(s) with stats to resend",
 		zap.Int64s("segment IDs", segResent))
 	return &datapb.ResendSegmentStatsResponse{
@@ -369,13 +373,23 @@ func (node *DataNode) SyncSegments(ctx context.Context, req *datapb.SyncSegments
 		oneSegment = fromSegment
 		break
 	}
-	if oneSegment == 0 {
-		log.Ctx(ctx).Warn("no valid segment, maybe the request is a retry")
-		return merr.Status(nil), nil
+
+	var collID, partID UniqueID
+	if oneSegment != 0 {
+		// oneSegment is definitely in the channel, guaranteed by the check before.
+		collID, partID, _ = channel.getCollectionAndPartitionID(oneSegment)
+	} else {
+		// the segments converted from the external files are never in the flow graphs,
+		// the converted segment is added to the channel of the request
+		ds, ok := node.flowgraphManager.getFlowgraphService(req.GetChannelName())
+		if !ok || ds.channel.hasSegment(req.GetCompactedTo(), true) {
+			log.Ctx(ctx).Warn("no valid segment, maybe the request is a retry")
+			return merr.Status(nil), nil
+		}
+		channel = ds.channel
+		collID, partID = channel.getCollectionID(), req.GetPartitionId()
 	}
 
-	// oneSegment is definitely in the channel, guaranteed by the check before.
-	collID, partID, _ := channel.getCollectionAndPartitionID(oneSegment)
 	targetSeg := &Segment{
 		collectionID: collID,
 		partitionID:  partID,
@@ -396,6 +410,21 @@ func (node *DataNode) SyncSegments(ctx context.Context, req *datapb.SyncSegments
 	return merr.Status(nil), nil
 }
 
+// CancelCompactionPlans called by DataCoord, interrupts the executing compaction plans
+// and discards the results of the completed ones
+func (node *DataNode) CancelCompactionPlans(ctx context.Context, req *datapb.CancelCompactionPlansRequest) (*commonpb.Status, error) {
+	log.Ctx(ctx).Info("DataNode receives CancelCompactionPlans", zap.Int64s("planIDs", req.GetPlanIDs()))
+
+	if !node.isHealthy() {
+		err := merr.WrapErrServiceNotReady(node.GetStateCode().String())
+		log.Warn("DataNode.CancelCompactionPlans failed", zap.Int64("nodeId", paramtable.GetNodeID()), zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	node.compactionExecutor.cancelTasks(req.GetPlanIDs())
+	return merr.Status(nil), nil
+}
+
 // Import data files(json, numpy, etc.) on MinIO/S3 storage, read and parse them into sealed segments
 func (node *DataNode) Import(ctx context.Context, req *datapb.ImportTaskRequest) (*commonpb.Status, error) {
 	logFields := []zap.Field{
@@ -494,23 +523,37 @@ func (node *DataNode) Import(ctx context.Context, req *datapb.ImportTaskRequest)
 		return returnFailFunc("invalid collection info to import", err)
 	}
 
+	keyVersion, err := getEncryptionKeyVersion(newCtx, node.dataCoord, req.GetImportTask().GetCollectionId())
+	if err != nil {
+		return returnFailFunc("failed to get the encryption key version", err)
+	}
+	if err := checkEncryptionSupported(node.chunkManager, req.GetImportTask().GetCollectionId(), keyVersion); err != nil {
+		return returnFailFunc("failed to encrypt the imported data", err)
+	}
+
 	// parse files and generate segments
 	segmentSize := Params.DataCoordCfg.SegmentMaxSize.GetAsInt64() * 1024 * 1024
 	importWrapper := importutil.NewImportWrapper(newCtx, collectionInfo, segmentSize, node.allocator.GetIDAlloactor(),
 		node.chunkManager, importResult, reportFunc)
 	importWrapper.SetCallbackFunctions(assignSegmentFunc(node, req),
-		createBinLogsFunc(node, req, colInfo.GetSchema(), ts),
-		saveSegmentFunc(node, req, importResult, ts))
+		createBinLogsFunc(node, req, colInfo.GetSchema(), ts, keyVersion),
+		saveSegmentFunc(node, req, importResult, ts, keyVersion))
+	importWrapper.SetRegisterExternalFunc(registerExternalFunc(node, req, importResult, ts))
 	// todo: pass tsStart and tsStart after import_wrapper support
 	tsStart, tsEnd, err := importutil.ParseTSFromOptions(req.GetImportTask().GetInfos())
 	isBackup := importutil.IsBackup(req.GetImportTask().GetInfos())
 	if err != nil {
 		return returnFailFunc("failed to parse timestamp from import options", err)
 	}
+	maxRejectedRows, err := importutil.ParseMaxRejectedRows(req.GetImportTask().GetInfos())
+	if err != nil {
+		return returnFailFunc("failed to parse max rejected rows from import options", err)
+	}
 	logFields = append(logFields, zap.Uint64("start_ts", tsStart), zap.Uint64("end_ts", tsEnd))
 	log.Info("import time range", logFields...)
 	err = importWrapper.Import(req.GetImportTask().GetFiles(),
-		importutil.ImportOptions{OnlyValidate: false, TsStartPoint: tsStart, TsEndPoint: tsEnd, IsBackup: isBackup})
+		importutil.ImportOptions{OnlyValidate: false, TsStartPoint: tsStart, TsEndPoint: tsEnd, IsBackup: isBackup,
+			MaxRejectedRows: maxRejectedRows, External: importutil.IsExternal(req.GetImportTask().GetInfos())})
 	if err != nil {
 		return returnFailFunc("failed to import files", err)
 	}
@@ -657,6 +700,83 @@ func (node *DataNode) AddImportSegment(ctx context.Context, req *datapb.AddImpor
 	}, nil
 }
 
+// DumpDispatcherPositions returns the latest positions dispatched to the given vchannels,
+// or all the vchannels watched by the DataNode if none given, admin only.
+func (node *DataNode) DumpDispatcherPositions(ctx context.Context, req *internalpb.DumpDispatcherPositionsRequest) (*internalpb.DumpDispatcherPositionsResponse, error) {
+	log := log.Ctx(ctx).With(zap.Strings("vchannels", req.GetVchannels()))
+	if !node.isHealthy() {
+		err := merr.WrapErrServiceNotReady(node.GetStateCode().String())
+		log.Warn("failed to dump dispatcher positions", zap.Error(err))
+		return &internalpb.DumpDispatcherPositionsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	if err := funcutil.CheckAdminPrivilege(ctx, node.rootCoord); err != nil {
+		log.Warn("failed to dump dispatcher positions", zap.Error(err))
+		return &internalpb.DumpDispatcherPositionsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	vchannels := req.GetVchannels()
+	if len(vchannels) == 0 {
+		node.flowgraphManager.flowgraphs.Range(func(vchannel string, _ *dataSyncService) bool {
+			vchannels = append(vchannels, vchannel)
+			return true
+		})
+	}
+	positions := make([]*internalpb.DispatcherPosition, 0, len(vchannels))
+	for _, vchannel := range vchannels {
+		state := node.dispClient.GetState(vchannel)
+		if state == nil {
+			err := merr.WrapErrChannelNotFound(vchannel, "not consumed by the dispatcher")
+			log.Warn("failed to dump dispatcher positions", zap.Error(err))
+			return &internalpb.DumpDispatcherPositionsResponse{
+				Status: merr.Status(err),
+			}, nil
+		}
+		positions = append(positions, &internalpb.DispatcherPosition{
+			Vchannel: vchannel,
+			Pchannel: state.PChannel,
+			Position: state.Position,
+			IsMain:   state.IsMain,
+			CurTs:    state.CurTs,
+		})
+	}
+	return &internalpb.DumpDispatcherPositionsResponse{
+		Status:    merr.Status(nil),
+		Positions: positions,
+	}, nil
+}
+
+// SeekDispatcher makes the watched vchannel consume from the given position, admin only.
+// It's used to recover the vchannel whose checkpoint is wrong, the data may be replayed or skipped.
+func (node *DataNode) SeekDispatcher(ctx context.Context, req *internalpb.SeekDispatcherRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(zap.String("vchannel", req.GetVchannel()),
+		zap.Uint64("ts", req.GetPosition().GetTimestamp()))
+	if !node.isHealthy() {
+		err := merr.WrapErrServiceNotReady(node.GetStateCode().String())
+		log.Warn("failed to seek dispatcher", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	if err := funcutil.CheckAdminPrivilege(ctx, node.rootCoord); err != nil {
+		log.Warn("failed to seek dispatcher", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	if !node.flowgraphManager.exist(req.GetVchannel()) {
+		err := merr.WrapErrChannelNotFound(req.GetVchannel())
+		log.Warn("failed to seek dispatcher", zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	log.Warn("seek dispatcher by admin, the data may be replayed or skipped")
+	if err := node.dispClient.Seek(req.GetVchannel(), req.GetPosition()); err != nil {
+		log.Warn("failed to seek dispatcher", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	return merr.Status(nil), nil
+}
+
 func assignSegmentFunc(node *DataNode, req *datapb.ImportTaskRequest) importutil.AssignSegmentFunc {
 	return func(shardID int, partID int64) (int64, string, error) {
 		chNames := req.GetImportTask().GetChannelNames()
@@ -718,7 +838,7 @@ func assignSegmentFunc(node *DataNode, req *datapb.ImportTaskRequest) importutil
 	}
 }
 
-func createBinLogsFunc(node *DataNode, req *datapb.ImportTaskRequest, schema *schemapb.CollectionSchema, ts Timestamp) importutil.CreateBinlogsFunc {
+func createBinLogsFunc(node *DataNode, req *datapb.ImportTaskRequest, schema *schemapb.CollectionSchema, ts Timestamp, keyVersion int64) importutil.CreateBinlogsFunc {
 	return func(fields importutil.BlockData, segmentID int64, partID int64) ([]*datapb.FieldBinlog, []*datapb.FieldBinlog, error) {
 		var rowNum int
 		for _, field := range fields {
@@ -743,7 +863,7 @@ func createBinLogsFunc(node *DataNode, req *datapb.ImportTaskRequest, schema *sc
 		logFields = append(logFields, zap.Int("row count", rowNum))
 
 		colID := req.GetImportTask().GetCollectionId()
-		fieldInsert, fieldStats, err := createBinLogs(rowNum, schema, ts, fields, node, segmentID, colID, partID)
+		fieldInsert, fieldStats, err := createBinLogs(rowNum, schema, ts, fields, node, segmentID, colID, partID, keyVersion)
 		if err != nil {
 			logFields = append(logFields, zap.Any("err", err))
 			log.Error("failed to create binlogs", logFields...)
@@ -757,7 +877,7 @@ func createBinLogsFunc(node *DataNode, req *datapb.ImportTaskRequest, schema *sc
 	}
 }
 
-func saveSegmentFunc(node *DataNode, req *datapb.ImportTaskRequest, res *rootcoordpb.ImportResult, ts Timestamp) importutil.SaveSegmentFunc {
+func saveSegmentFunc(node *DataNode, req *datapb.ImportTaskRequest, res *rootcoordpb.ImportResult, ts Timestamp, keyVersion int64) importutil.SaveSegmentFunc {
 	importTaskID := req.GetImportTask().GetTaskId()
 	return func(fieldsInsert []*datapb.FieldBinlog, fieldsStats []*datapb.FieldBinlog, segmentID int64,
 		targetChName string, rowCount int64, partID int64) error {
@@ -771,52 +891,41 @@ func saveSegmentFunc(node *DataNode, req *datapb.ImportTaskRequest, res *rootcoo
 		}
 		log.Info("adding segment to the correct DataNode flow graph and saving binlog paths", logFields...)
 
-		err := retry.Do(context.Background(), func() error {
-			// Ask DataCoord to save binlog path and add segment to the corresponding DataNode flow graph.
-			resp, err := node.dataCoord.SaveImportSegment(context.Background(), &datapb.SaveImportSegmentRequest{
+		err := saveImportSegment(node, &datapb.SaveImportSegmentRequest{
+			Base: commonpbutil.NewMsgBase(
+				commonpbutil.WithTimeStamp(ts), // Pass current timestamp downstream.
+				commonpbutil.WithSourceID(paramtable.GetNodeID()),
+			),
+			SegmentId:    segmentID,
+			ChannelName:  targetChName,
+			CollectionId: req.GetImportTask().GetCollectionId(),
+			PartitionId:  partID,
+			RowNum:       rowCount,
+			SaveBinlogPathReq: &datapb.SaveBinlogPathsRequest{
 				Base: commonpbutil.NewMsgBase(
-					commonpbutil.WithTimeStamp(ts), // Pass current timestamp downstream.
+					commonpbutil.WithMsgType(0),
+					commonpbutil.WithMsgID(0),
+					commonpbutil.WithTimeStamp(ts),
 					commonpbutil.WithSourceID(paramtable.GetNodeID()),
 				),
-				SegmentId:    segmentID,
-				ChannelName:  targetChName,
-				CollectionId: req.GetImportTask().GetCollectionId(),
-				PartitionId:  partID,
-				RowNum:       rowCount,
-				SaveBinlogPathReq: &datapb.SaveBinlogPathsRequest{
-					Base: commonpbutil.NewMsgBase(
-						commonpbutil.WithMsgType(0),
-						commonpbutil.WithMsgID(0),
-						commonpbutil.WithTimeStamp(ts),
-						commonpbutil.WithSourceID(paramtable.GetNodeID()),
-					),
-					SegmentID:           segmentID,
-					CollectionID:        req.GetImportTask().GetCollectionId(),
-					Field2BinlogPaths:   fieldsInsert,
-					Field2StatslogPaths: fieldsStats,
-					// Set start positions of a SaveBinlogPathRequest explicitly.
-					StartPositions: []*datapb.SegmentStartPosition{
-						{
-							StartPosition: &msgpb.MsgPosition{
-								ChannelName: targetChName,
-								Timestamp:   ts,
-							},
-							SegmentID: segmentID,
+				SegmentID:           segmentID,
+				CollectionID:        req.GetImportTask().GetCollectionId(),
+				Field2BinlogPaths:   fieldsInsert,
+				Field2StatslogPaths: fieldsStats,
+				// Set start positions of a SaveBinlogPathRequest explicitly.
+				StartPositions: []*datapb.SegmentStartPosition{
+					{
+						StartPosition: &msgpb.MsgPosition{
+							ChannelName: targetChName,
+							Timestamp:   ts,
 						},
+						SegmentID: segmentID,
 					},
-					Importing: true,
 				},
-			})
-			// Only retrying when DataCoord is unhealthy or err != nil, otherwise return immediately.
-			if err != nil {
-				return fmt.Errorf(err.Error())
-			}
-			if resp.ErrorCode != commonpb.ErrorCode_Success && resp.ErrorCode != commonpb.ErrorCode_NotReadyServe {
-				return retry.Unrecoverable(fmt.Errorf("failed to save import segment, reason = %s", resp.Reason))
-			} else if resp.ErrorCode == commonpb.ErrorCode_NotReadyServe {
-				return fmt.Errorf("failed to save import segment: %s", resp.GetReason())
-			}
-			return nil
+				Importing: true,
+
+				EncryptionKeyVersion: keyVersion,
+			},
 		})
 		if err != nil {
 			log.Warn("failed to save import segment", zap.Error(err))
@@ -829,6 +938,71 @@ func saveSegmentFunc(node *DataNode, req *datapb.ImportTaskRequest, res *rootcoo
 	}
 }
 
+// registerExternalFunc saves the segments registered on the external files, the segments have no binlogs and
+// are not added to the flow graphs until they are converted into binlogs by DataCoord.
+func registerExternalFunc(node *DataNode, req *datapb.ImportTaskRequest, res *rootcoordpb.ImportResult, ts Timestamp) importutil.RegisterExternalFunc {
+	importTaskID := req.GetImportTask().GetTaskId()
+	return func(segmentID int64, targetChName string, rowCount int64, partID int64, source *datapb.ExternalSource) error {
+		logFields := []zap.Field{
+			zap.Int64("task ID", importTaskID),
+			zap.Int64("partitionID", partID),
+			zap.Int64("segmentID", segmentID),
+			zap.String("target channel name", targetChName),
+			zap.Int64("row count", rowCount),
+			zap.Strings("files", source.GetFiles()),
+			zap.Int32("shard index", source.GetShardIndex()),
+		}
+		log.Info("registering segment on the external files", logFields...)
+
+		err := saveImportSegment(node, &datapb.SaveImportSegmentRequest{
+			Base: commonpbutil.NewMsgBase(
+				commonpbutil.WithTimeStamp(ts),
+				commonpbutil.WithSourceID(paramtable.GetNodeID()),
+			),
+			SegmentId:    segmentID,
+			ChannelName:  targetChName,
+			CollectionId: req.GetImportTask().GetCollectionId(),
+			PartitionId:  partID,
+			RowNum:       rowCount,
+			SaveBinlogPathReq: &datapb.SaveBinlogPathsRequest{
+				Base: commonpbutil.NewMsgBase(
+					commonpbutil.WithTimeStamp(ts),
+					commonpbutil.WithSourceID(paramtable.GetNodeID()),
+				),
+				SegmentID:    segmentID,
+				CollectionID: req.GetImportTask().GetCollectionId(),
+				Importing:    true,
+			},
+			ExternalSource: source,
+		})
+		if err != nil {
+			log.Warn("failed to register external segment", zap.Error(err))
+			return err
+		}
+		log.Info("external segment registered", logFields...)
+		res.Segments = append(res.Segments, segmentID)
+		res.RowCount += rowCount
+		return nil
+	}
+}
+
+// saveImportSegment asks DataCoord to save the binlog paths of the import segment and add it to the corresponding
+// DataNode flow graph, retries only if DataCoord is unhealthy or unreachable.
+func saveImportSegment(node *DataNode, req *datapb.SaveImportSegmentRequest) error {
+	return retry.Do(context.Background(), func() error {
+		resp, err := node.dataCoord.SaveImportSegment(context.Background(), req)
+		if err != nil {
+			return fmt.Errorf(err.Error())
+		}
+		if resp.ErrorCode != commonpb.ErrorCode_Success && resp.ErrorCode != commonpb.ErrorCode_NotReadyServe {
+			return retry.Unrecoverable(fmt.Errorf("failed to save import segment, reason = %s", resp.Reason))
+		} else if resp.ErrorCode == commonpb.ErrorCode_NotReadyServe {
+			return fmt.Errorf("failed to save import segment: %s", resp.GetReason())
+		}
+		return nil
+	})
+}
+
 func composeAssignSegmentIDRequest(rowNum int, shardID int, chNames []string,
 	collID int64, partID int64) *datapb.AssignSegmentIDRequest {
 	// use the first field's row count as segment row count
@@ -852,18 +1026,11 @@ func composeAssignSegmentIDRequest(rowNum int, shardID int, chNames []string,
 }
 
 func createBinLogs(rowNum int, schema *schemapb.CollectionSchema, ts Timestamp,
-	fields map[storage.FieldID]storage.FieldData, node *DataNode, segmentID, colID, partID UniqueID) ([]*datapb.FieldBinlog, []*datapb.FieldBinlog, error) {
+	fields map[storage.FieldID]storage.FieldData, node *DataNode, segmentID, colID, partID UniqueID, keyVersion int64) ([]*datapb.FieldBinlog, []*datapb.FieldBinlog, error) {
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
-
-	tsFieldData := make([]int64, rowNum)
-	for i := range tsFieldData {
-		tsFieldData[i] = int64(ts)
-	}
-	fields[common.TimeStampField] = &storage.Int64FieldData{
-		Data: tsFieldData,
-	}
+	ctx = storage.WithEncryptionKey(ctx, colID, keyVersion)
 
 	if status, _ := node.dataCoord.UpdateSegmentStatistics(context.TODO(), &datapb.UpdateSegmentStatisticsRequest{
 		Stats: []*commonpb.SegmentStats{{
@@ -874,6 +1041,22 @@ func createBinLogs(rowNum int, schema *schemapb.CollectionSchema, ts Timestamp,
 		return nil, nil, fmt.Errorf(status.GetReason())
 	}
 
+	return writeInsertBinlogs(ctx, node.allocator, node.chunkManager, rowNum, schema, ts, fields, segmentID, colID, partID)
+}
+
+// writeInsertBinlogs writes the rows into the insert binlogs and the pk stats binlog of the segment, all the rows are
+// written with the timestamp ts. The binlogs are encrypted with the key carried by ctx if any.
+func writeInsertBinlogs(ctx context.Context, alloc allocator.Allocator, cm storage.ChunkManager, rowNum int,
+	schema *schemapb.CollectionSchema, ts Timestamp, fields map[storage.FieldID]storage.FieldData,
+	segmentID, colID, partID UniqueID) ([]*datapb.FieldBinlog, []*datapb.FieldBinlog, error) {
+	tsFieldData := make([]int64, rowNum)
+	for i := range tsFieldData {
+		tsFieldData[i] = int64(ts)
+	}
+	fields[common.TimeStampField] = &storage.Int64FieldData{
+		Data: tsFieldData,
+	}
+
 	data := BufferData{buffer: &InsertData{
 		Data: fields,
 	}}
@@ -889,7 +1072,7 @@ func createBinLogs(rowNum int, schema *schemapb.CollectionSchema, ts Timestamp,
 		return nil, nil, err
 	}
 
-	start, _, err := node.allocator.Alloc(uint32(len(binLogs)))
+	start, _, err := alloc.Alloc(uint32(len(binLogs)))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -908,7 +1091,7 @@ func createBinLogs(rowNum int, schema *schemapb.CollectionSchema, ts Timestamp,
 
 		k := metautil.JoinIDPath(colID, partID, segmentID, fieldID, logidx)
 
-		key := path.Join(node.chunkManager.RootPath(), common.SegmentInsertLogPath, k)
+		key := path.Join(cm.RootPath(), common.SegmentInsertLogPath, k)
 		kvs[key] = blob.Value[:]
 		field2Insert[fieldID] = &datapb.Binlog{
 			EntriesNum:    data.size,
@@ -938,7 +1121,7 @@ func createBinLogs(rowNum int, schema *schemapb.CollectionSchema, ts Timestamp,
 	// no error raise if alloc=false
 	k := metautil.JoinIDPath(colID, partID, segmentID, fieldID, logidx)
 
-	key := path.Join(node.chunkManager.RootPath(), common.SegmentStatslogPath, k)
+	key := path.Join(cm.RootPath(), common.SegmentStatslogPath, k)
 	kvs[key] = statsBinLog.Value
 	field2Stats[fieldID] = &datapb.Binlog{
 		EntriesNum:    data.size,
@@ -948,7 +1131,7 @@ func createBinLogs(rowNum int, schema *schemapb.CollectionSchema, ts Timestamp,
 		LogSize:       int64(len(statsBinLog.Value)),
 	}
 
-	err = node.chunkManager.MultiWrite(ctx, kvs)
+	err = cm.MultiWrite(ctx, kvs)
 	if err != nil {
 		return nil, nil, err
 	}
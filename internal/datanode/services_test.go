@@ -24,6 +24,7 @@ import (
 	"sync"
 	"testing"
 
+	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	clientv3 "go.etcd.io/etcd/client/v3"
@@ -43,6 +44,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/mq/msgdispatcher"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
 	"github.com/milvus-io/milvus/pkg/util/merr"
@@ -744,6 +746,29 @@ func (s *DataNodeServicesSuite) TestSyncSegments() {
 		s.Assert().False(fg.channel.hasSegment(req.CompactedFrom[0], true))
 		s.Assert().False(fg.channel.hasSegment(req.CompactedFrom[1], true))
 	})
+
+	s.Run("converted external segment", func() {
+		// the external segment is not in the channel, the converted one is added to the channel of the request
+		req := &datapb.SyncSegmentsRequest{
+			CompactedFrom: []int64{500},
+			CompactedTo:   501,
+			NumOfRows:     100,
+			ChannelName:   chanName,
+			PartitionId:   10,
+		}
+		status, err := s.node.SyncSegments(s.ctx, req)
+		s.Assert().NoError(err)
+		s.Assert().True(merr.Ok(status))
+		s.Assert().True(fg.channel.hasSegment(req.CompactedTo, true))
+		_, partID, err := fg.channel.getCollectionAndPartitionID(req.CompactedTo)
+		s.Assert().NoError(err)
+		s.Assert().Equal(int64(10), partID)
+
+		// retry
+		status, err = s.node.SyncSegments(s.ctx, req)
+		s.Assert().NoError(err)
+		s.Assert().True(merr.Ok(status))
+	})
 }
 
 func (s *DataNodeServicesSuite) TestResendSegmentStats() {
@@ -807,3 +832,85 @@ func (s *DataNodeServicesSuite) TestResendSegmentStats() {
 	s.Assert().True(merr.Ok(resp.GetStatus()))
 	s.Assert().ElementsMatch([]UniqueID{0, 1, 2}, resp.GetSegResent())
 }
+
+func (s *DataNodeServicesSuite) TestDispatcherPositions() {
+	vchannel := "fake-by-dev-rootcoord-dml-channel-test-DispatcherPositions"
+	dispClient := msgdispatcher.NewMockClient(s.T())
+	s.node.dispClient = dispClient
+	s.node.flowgraphManager.flowgraphs.Insert(vchannel, &dataSyncService{})
+	defer s.node.flowgraphManager.flowgraphs.GetAndRemove(vchannel)
+
+	s.Run("dump", func() {
+		pos := &msgpb.MsgPosition{ChannelName: vchannel, MsgID: []byte{1}, Timestamp: 100}
+		dispClient.EXPECT().GetState(vchannel).Return(&msgdispatcher.DispatcherState{
+			PChannel: "fake-by-dev-rootcoord-dml",
+			IsMain:   true,
+			CurTs:    200,
+			Position: pos,
+		}).Once()
+		resp, err := s.node.DumpDispatcherPositions(s.ctx, &internalpb.DumpDispatcherPositionsRequest{})
+		s.NoError(err)
+		s.True(merr.Ok(resp.GetStatus()))
+		s.Require().Len(resp.GetPositions(), 1)
+		s.Equal(vchannel, resp.GetPositions()[0].GetVchannel())
+		s.Equal(pos, resp.GetPositions()[0].GetPosition())
+		s.EqualValues(200, resp.GetPositions()[0].GetCurTs())
+
+		dispClient.EXPECT().GetState("not-exist").Return(nil).Once()
+		resp, err = s.node.DumpDispatcherPositions(s.ctx, &internalpb.DumpDispatcherPositionsRequest{
+			Vchannels: []string{"not-exist"},
+		})
+		s.NoError(err)
+		s.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrChannelNotFound)
+	})
+
+	s.Run("seek", func() {
+		pos := &msgpb.MsgPosition{ChannelName: vchannel, MsgID: []byte{1}, Timestamp: 100}
+		dispClient.EXPECT().Seek(vchannel, pos).Return(nil).Once()
+		status, err := s.node.SeekDispatcher(s.ctx, &internalpb.SeekDispatcherRequest{
+			Vchannel: vchannel,
+			Position: pos,
+		})
+		s.NoError(err)
+		s.True(merr.Ok(status))
+
+		dispClient.EXPECT().Seek(vchannel, pos).Return(errors.New("mock error")).Once()
+		status, err = s.node.SeekDispatcher(s.ctx, &internalpb.SeekDispatcherRequest{
+			Vchannel: vchannel,
+			Position: pos,
+		})
+		s.NoError(err)
+		s.False(merr.Ok(status))
+
+		status, err = s.node.SeekDispatcher(s.ctx, &internalpb.SeekDispatcherRequest{
+			Vchannel: "not-exist",
+			Position: pos,
+		})
+		s.NoError(err)
+		s.ErrorIs(merr.Error(status), merr.ErrChannelNotFound)
+	})
+
+	s.Run("not admin", func() {
+		paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "true")
+		defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+
+		resp, err := s.node.DumpDispatcherPositions(s.ctx, &internalpb.DumpDispatcherPositionsRequest{})
+		s.NoError(err)
+		s.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrServicePermissionDenied)
+		status, err := s.node.SeekDispatcher(s.ctx, &internalpb.SeekDispatcherRequest{Vchannel: vchannel})
+		s.NoError(err)
+		s.ErrorIs(merr.Error(status), merr.ErrServicePermissionDenied)
+	})
+
+	s.Run("unhealthy", func() {
+		s.node.UpdateStateCode(commonpb.StateCode_Abnormal)
+		defer s.node.UpdateStateCode(commonpb.StateCode_Healthy)
+
+		resp, err := s.node.DumpDispatcherPositions(s.ctx, &internalpb.DumpDispatcherPositionsRequest{})
+		s.NoError(err)
+		s.False(merr.Ok(resp.GetStatus()))
+		status, err := s.node.SeekDispatcher(s.ctx, &internalpb.SeekDispatcherRequest{Vchannel: vchannel})
+		s.NoError(err)
+		s.False(merr.Ok(status))
+	})
+}
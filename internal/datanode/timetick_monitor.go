@@ -0,0 +1,118 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+)
+
+// timeTickProgress tracks the latest timetick went through the flowgraph of a vchannel,
+// to detect the vchannels whose timetick stalls due to a stuck producer or consumer.
+type timeTickProgress struct {
+	mu         sync.RWMutex
+	ts         Timestamp
+	updateTime time.Time // the time the timetick advanced last time
+}
+
+func newTimeTickProgress() *timeTickProgress {
+	return &timeTickProgress{updateTime: time.Now()}
+}
+
+func (p *timeTickProgress) update(ts Timestamp) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if ts > p.ts {
+		p.ts = ts
+		p.updateTime = time.Now()
+	}
+}
+
+// get returns the latest timetick and the time it advanced.
+func (p *timeTickProgress) get() (Timestamp, time.Time) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.ts, p.updateTime
+}
+
+// checkTimeTickStall alerts the vchannels whose timetick hasn't advanced for the stall threshold,
+// with the diagnostics of the flowgraph attached. A stalled vchannel is alerted again
+// every threshold period until it recovers.
+func (fm *flowgraphManager) checkTimeTickStall() {
+	threshold := Params.DataNodeCfg.TimeTickStallThreshold.GetAsDuration(time.Second)
+	stalled := make(map[string]time.Time)
+	if threshold > 0 {
+		fm.flowgraphs.Range(func(vchannel string, ds *dataSyncService) bool {
+			if ds.ttProgress == nil {
+				return true
+			}
+			ts, updateTime := ds.ttProgress.get()
+			if time.Since(updateTime) < threshold {
+				return true
+			}
+			alertTime, ok := fm.stalledChannels[vchannel]
+			if !ok || time.Since(alertTime) >= threshold {
+				fields := []zap.Field{
+					zap.String("vchannel", vchannel),
+					zap.Int64("collectionID", ds.collectionID),
+					zap.Time("timetick", tsoutil.PhysicalTime(ts)),
+					zap.Duration("stalledFor", time.Since(updateTime)),
+				}
+				log.Warn("timetick of vchannel stalled", append(fields, ds.diagnose()...)...)
+				alertTime = time.Now()
+			}
+			stalled[vchannel] = alertTime
+			return true
+		})
+	}
+
+	for vchannel := range fm.stalledChannels {
+		if _, ok := stalled[vchannel]; !ok {
+			log.Info("timetick of vchannel recovered from stall", zap.String("vchannel", vchannel))
+		}
+	}
+	fm.stalledChannels = stalled
+	metrics.DataNodeStalledVChannelNum.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Set(float64(len(stalled)))
+}
+
+// diagnose collects the state of the flowgraph and its buffers, to locate where the timetick stalls.
+func (dsService *dataSyncService) diagnose() []zap.Field {
+	var fields []zap.Field
+	if dsService.dispClient != nil {
+		fields = append(fields, zap.Any("dispatcher", dsService.dispClient.GetState(dsService.vchannelName)))
+	}
+	if dsService.fg != nil {
+		fields = append(fields, zap.Any("flowgraphQueueLengths", dsService.fg.QueueLengths()))
+	}
+	if dsService.channel != nil {
+		fields = append(fields,
+			zap.Int64("insertBufferSize", dsService.channel.getTotalMemorySize()),
+			zap.Int("notFlushedSegmentNum", len(dsService.channel.listNotFlushedSegmentIDs())))
+	}
+	if dsService.delBufferManager != nil {
+		fields = append(fields, zap.Int64("deleteBufferSize", dsService.delBufferManager.usedMemory.Load()))
+	}
+	return fields
+}
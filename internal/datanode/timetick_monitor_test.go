@@ -0,0 +1,85 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/mq/msgdispatcher"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestTimeTickProgress(t *testing.T) {
+	p := newTimeTickProgress()
+	ts, updateTime := p.get()
+	assert.Zero(t, ts)
+	assert.WithinDuration(t, time.Now(), updateTime, time.Second)
+
+	p.update(100)
+	ts, updateTime = p.get()
+	assert.EqualValues(t, 100, ts)
+
+	// the timetick doesn't go backwards
+	p.update(50)
+	ts, updateTime2 := p.get()
+	assert.EqualValues(t, 100, ts)
+	assert.Equal(t, updateTime, updateTime2)
+}
+
+func TestFlowGraphManager_checkTimeTickStall(t *testing.T) {
+	paramtable.Get().Save(Params.DataNodeCfg.TimeTickStallThreshold.Key, "1")
+	defer paramtable.Get().Reset(Params.DataNodeCfg.TimeTickStallThreshold.Key)
+
+	const vchannel = "by-dev-rootcoord-dml-0_100v0"
+	dispClient := msgdispatcher.NewMockClient(t)
+	dispClient.EXPECT().GetState(vchannel).Return(&msgdispatcher.DispatcherState{PChannel: "by-dev-rootcoord-dml-0"}).Once()
+
+	progress := newTimeTickProgress()
+	fm := newFlowgraphManager()
+	fm.flowgraphs.Insert(vchannel, &dataSyncService{
+		collectionID: 100,
+		vchannelName: vchannel,
+		dispClient:   dispClient,
+		ttProgress:   progress,
+	})
+	fm.flowgraphs.Insert("no-progress", &dataSyncService{})
+
+	fm.checkTimeTickStall()
+	assert.Empty(t, fm.stalledChannels)
+
+	// stalled, alerted once within the threshold
+	progress.updateTime = time.Now().Add(-2 * time.Second)
+	fm.checkTimeTickStall()
+	assert.Contains(t, fm.stalledChannels, vchannel)
+	alertTime := fm.stalledChannels[vchannel]
+	fm.checkTimeTickStall()
+	assert.Equal(t, alertTime, fm.stalledChannels[vchannel])
+
+	// recovered
+	progress.update(100)
+	fm.checkTimeTickStall()
+	assert.Empty(t, fm.stalledChannels)
+
+	// disabled
+	paramtable.Get().Save(Params.DataNodeCfg.TimeTickStallThreshold.Key, "0")
+	progress.updateTime = time.Now().Add(-2 * time.Second)
+	fm.checkTimeTickStall()
+	assert.Empty(t, fm.stalledChannels)
+}
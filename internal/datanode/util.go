@@ -22,6 +22,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -57,6 +58,24 @@ func startTracer(msg msgstream.TsMsg, name string) (context.Context, trace.Span)
 	return otel.Tracer(typeutil.DataNodeRole).Start(ctx, name)
 }
 
+// startFlushTracer starts a span of its own for flushing the buffer of the segment,
+// which links to the traces of all the insert messages buffered instead of joining any of them.
+func startFlushTracer(ctx context.Context, msgs []*msgstream.InsertMsg, segmentID UniqueID) (context.Context, trace.Span) {
+	links := make([]trace.Link, 0, len(msgs))
+	for _, msg := range msgs {
+		if msg.TraceCtx() == nil {
+			continue
+		}
+		if spanCtx := trace.SpanContextFromContext(msg.TraceCtx()); spanCtx.IsValid() {
+			links = append(links, trace.Link{SpanContext: spanCtx})
+		}
+	}
+	return otel.Tracer(typeutil.DataNodeRole).Start(ctx, "Flush",
+		trace.WithNewRoot(),
+		trace.WithLinks(links...),
+		trace.WithAttributes(attribute.Int64("segmentID", segmentID)))
+}
+
 func boolToInt(value bool) int {
 	if value {
 		return 1
@@ -30,7 +30,6 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
-	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/pkg/log"
@@ -63,17 +62,21 @@ func NewClient(ctx context.Context, metaRoot string, etcdCli *clientv3.Client) (
 	clientParams := &Params.DataCoordGrpcClientCfg
 	client := &Client{
 		grpcClient: &grpcclient.ClientBase[datapb.DataCoordClient]{
-			ClientMaxRecvSize:      clientParams.ClientMaxRecvSize.GetAsInt(),
-			ClientMaxSendSize:      clientParams.ClientMaxSendSize.GetAsInt(),
-			DialTimeout:            clientParams.DialTimeout.GetAsDuration(time.Millisecond),
-			KeepAliveTime:          clientParams.KeepAliveTime.GetAsDuration(time.Millisecond),
-			KeepAliveTimeout:       clientParams.KeepAliveTimeout.GetAsDuration(time.Millisecond),
-			RetryServiceNameConfig: "milvus.proto.data.DataCoord",
-			MaxAttempts:            clientParams.MaxAttempts.GetAsInt(),
-			InitialBackoff:         float32(clientParams.InitialBackoff.GetAsFloat()),
-			MaxBackoff:             float32(clientParams.MaxBackoff.GetAsFloat()),
-			BackoffMultiplier:      float32(clientParams.BackoffMultiplier.GetAsFloat()),
-			CompressionEnabled:     clientParams.CompressionEnabled.GetAsBool(),
+			ClientMaxRecvSize:        clientParams.ClientMaxRecvSize.GetAsInt(),
+			ClientMaxSendSize:        clientParams.ClientMaxSendSize.GetAsInt(),
+			DialTimeout:              clientParams.DialTimeout.GetAsDuration(time.Millisecond),
+			KeepAliveTime:            clientParams.KeepAliveTime.GetAsDuration(time.Millisecond),
+			KeepAliveTimeout:         clientParams.KeepAliveTimeout.GetAsDuration(time.Millisecond),
+			RetryServiceNameConfig:   "milvus.proto.data.DataCoord",
+			MaxAttempts:              clientParams.MaxAttempts.GetAsInt(),
+			InitialBackoff:           float32(clientParams.InitialBackoff.GetAsFloat()),
+			MaxBackoff:               float32(clientParams.MaxBackoff.GetAsFloat()),
+			BackoffMultiplier:        float32(clientParams.BackoffMultiplier.GetAsFloat()),
+			CompressionEnabled:       clientParams.CompressionEnabled.GetAsBool(),
+			SelectiveCompression:     clientParams.SelectiveCompressionEnabled.GetAsBool(),
+			CompressionAlgorithm:     clientParams.SelectiveCompressionAlgorithm.GetValue(),
+			CompressionMethods:       clientParams.SelectiveCompressionMethods.GetAsStrings(),
+			CompressionSizeThreshold: clientParams.SelectiveCompressionSizeThreshold.GetAsInt(),
 		},
 		sess: sess,
 	}
@@ -159,122 +162,6 @@ func (c *Client) GetStatisticsChannel(ctx context.Context) (*milvuspb.StringResp
 	})
 }
 
-// Flush flushes a collection's data
-func (c *Client) Flush(ctx context.Context, req *datapb.FlushRequest) (*datapb.FlushResponse, error) {
-	req = typeutil.Clone(req)
-	commonpbutil.UpdateMsgBase(
-		req.GetBase(),
-		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
-	)
-	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.FlushResponse, error) {
-		return client.Flush(ctx, req)
-	})
-}
-
-// AssignSegmentID applies allocations for specified Coolection/Partition and related Channel Name(Virtial Channel)
-//
-// ctx is the context to control request deadline and cancellation
-// req contains the requester's info(id and role) and the list of Assignment Request,
-// which contains the specified collection, partitaion id, the related VChannel Name and row count it needs
-//
-// response struct `AssignSegmentIDResponse` contains the assignment result for each request
-// error is returned only when some communication issue occurs
-// if some error occurs in the process of `AssignSegmentID`, it will be recorded and returned in `Status` field of response
-//
-// `AssignSegmentID` will applies current configured allocation policies for each request
-// if the VChannel is newly used, `WatchDmlChannels` will be invoked to notify a `DataNode`(selected by policy) to watch it
-// if there is anything make the allocation impossible, the response will not contain the corresponding result
-func (c *Client) AssignSegmentID(ctx context.Context, req *datapb.AssignSegmentIDRequest) (*datapb.AssignSegmentIDResponse, error) {
-	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.AssignSegmentIDResponse, error) {
-		return client.AssignSegmentID(ctx, req)
-	})
-}
-
-// GetSegmentStates requests segment state information
-//
-// ctx is the context to control request deadline and cancellation
-// req contains the list of segment id to query
-//
-// response struct `GetSegmentStatesResponse` contains the list of each state query result
-//
-//	when the segment is not found, the state entry will has the field `Status`  to identify failure
-//	otherwise the Segment State and Start position information will be returned
-//
-// error is returned only when some communication issue occurs
-func (c *Client) GetSegmentStates(ctx context.Context, req *datapb.GetSegmentStatesRequest) (*datapb.GetSegmentStatesResponse, error) {
-	req = typeutil.Clone(req)
-	commonpbutil.UpdateMsgBase(
-		req.GetBase(),
-		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
-	)
-	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.GetSegmentStatesResponse, error) {
-		return client.GetSegmentStates(ctx, req)
-	})
-}
-
-// GetInsertBinlogPaths requests binlog paths for specified segment
-//
-// ctx is the context to control request deadline and cancellation
-// req contains the segment id to query
-//
-// response struct `GetInsertBinlogPathsResponse` contains the fields list
-//
-//	and corresponding binlog path list
-//
-// error is returned only when some communication issue occurs
-func (c *Client) GetInsertBinlogPaths(ctx context.Context, req *datapb.GetInsertBinlogPathsRequest) (*datapb.GetInsertBinlogPathsResponse, error) {
-	req = typeutil.Clone(req)
-	commonpbutil.UpdateMsgBase(
-		req.GetBase(),
-		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
-	)
-	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.GetInsertBinlogPathsResponse, error) {
-		return client.GetInsertBinlogPaths(ctx, req)
-	})
-}
-
-// GetCollectionStatistics requests collection statistics
-//
-// ctx is the context to control request deadline and cancellation
-// req contains the collection id to query
-//
-// response struct `GetCollectionStatisticsResponse` contains the key-value list fields returning related data
-//
-//	only row count for now
-//
-// error is returned only when some communication issue occurs
-func (c *Client) GetCollectionStatistics(ctx context.Context, req *datapb.GetCollectionStatisticsRequest) (*datapb.GetCollectionStatisticsResponse, error) {
-	req = typeutil.Clone(req)
-	commonpbutil.UpdateMsgBase(
-		req.GetBase(),
-		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
-	)
-	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.GetCollectionStatisticsResponse, error) {
-		return client.GetCollectionStatistics(ctx, req)
-	})
-}
-
-// GetPartitionStatistics requests partition statistics
-//
-// ctx is the context to control request deadline and cancellation
-// req contains the collection and partition id to query
-//
-// response struct `GetPartitionStatisticsResponse` contains the key-value list fields returning related data
-//
-//	only row count for now
-//
-// error is returned only when some communication issue occurs
-func (c *Client) GetPartitionStatistics(ctx context.Context, req *datapb.GetPartitionStatisticsRequest) (*datapb.GetPartitionStatisticsResponse, error) {
-	req = typeutil.Clone(req)
-	commonpbutil.UpdateMsgBase(
-		req.GetBase(),
-		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
-	)
-	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.GetPartitionStatisticsResponse, error) {
-		return client.GetPartitionStatistics(ctx, req)
-	})
-}
-
 // GetSegmentInfoChannel DEPRECATED
 // legacy api to get SegmentInfo Channel name
 func (c *Client) GetSegmentInfoChannel(ctx context.Context) (*milvuspb.StringResponse, error) {
@@ -283,68 +170,6 @@ func (c *Client) GetSegmentInfoChannel(ctx context.Context) (*milvuspb.StringRes
 	})
 }
 
-// GetSegmentInfo requests segment info
-//
-// ctx is the context to control request deadline and cancellation
-// req contains the list of segment ids to query
-//
-// response struct `GetSegmentInfoResponse` contains the list of segment info
-// error is returned only when some communication issue occurs
-func (c *Client) GetSegmentInfo(ctx context.Context, req *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error) {
-	req = typeutil.Clone(req)
-	commonpbutil.UpdateMsgBase(
-		req.GetBase(),
-		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
-	)
-	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.GetSegmentInfoResponse, error) {
-		return client.GetSegmentInfo(ctx, req)
-	})
-}
-
-// SaveBinlogPaths updates segments binlogs(including insert binlogs, stats logs and delta logs)
-//
-//	and related message stream positions
-//
-// ctx is the context to control request deadline and cancellation
-// req contains the collection/partition id to query
-//
-// response status contains the status/error code and failing reason if any
-// error is returned only when some communication issue occurs
-//
-// there is a constraint that the `SaveBinlogPaths` requests of same segment shall be passed in sequence
-//
-//		the root reason is each `SaveBinlogPaths` will overwrite the checkpoint position
-//	 if the constraint is broken, the checkpoint position will not be monotonically increasing and the integrity will be compromised
-func (c *Client) SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPathsRequest) (*commonpb.Status, error) {
-	// use Call here on purpose
-	req = typeutil.Clone(req)
-	commonpbutil.UpdateMsgBase(
-		req.GetBase(),
-		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
-	)
-	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*commonpb.Status, error) {
-		return client.SaveBinlogPaths(ctx, req)
-	})
-}
-
-// GetRecoveryInfo request segment recovery info of collection/partition
-//
-// ctx is the context to control request deadline and cancellation
-// req contains the collection/partition id to query
-//
-// response struct `GetRecoveryInfoResponse` contains the list of segments info and corresponding vchannel info
-// error is returned only when some communication issue occurs
-func (c *Client) GetRecoveryInfo(ctx context.Context, req *datapb.GetRecoveryInfoRequest) (*datapb.GetRecoveryInfoResponse, error) {
-	req = typeutil.Clone(req)
-	commonpbutil.UpdateMsgBase(
-		req.GetBase(),
-		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
-	)
-	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.GetRecoveryInfoResponse, error) {
-		return client.GetRecoveryInfo(ctx, req)
-	})
-}
-
 // GetRecoveryInfoV2 request segment recovery info of collection/partitions
 //
 // ctx is the context to control request deadline and cancellation
@@ -363,104 +188,6 @@ func (c *Client) GetRecoveryInfoV2(ctx context.Context, req *datapb.GetRecoveryI
 	})
 }
 
-// GetFlushedSegments returns flushed segment list of requested collection/parition
-//
-// ctx is the context to control request deadline and cancellation
-// req contains the collection/partition id to query
-//
-//	when partition is lesser or equal to 0, all flushed segments of collection will be returned
-//
-// response struct `GetFlushedSegmentsResponse` contains flushed segment id list
-// error is returned only when some communication issue occurs
-func (c *Client) GetFlushedSegments(ctx context.Context, req *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error) {
-	req = typeutil.Clone(req)
-	commonpbutil.UpdateMsgBase(
-		req.GetBase(),
-		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
-	)
-	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.GetFlushedSegmentsResponse, error) {
-		return client.GetFlushedSegments(ctx, req)
-	})
-}
-
-// GetSegmentsByStates returns segment list of requested collection/partition and segment states
-//
-// ctx is the context to control request deadline and cancellation
-// req contains the collection/partition id and segment states to query
-// when partition is lesser or equal to 0, all segments of collection will be returned
-//
-// response struct `GetSegmentsByStatesResponse` contains segment id list
-// error is returned only when some communication issue occurs
-func (c *Client) GetSegmentsByStates(ctx context.Context, req *datapb.GetSegmentsByStatesRequest) (*datapb.GetSegmentsByStatesResponse, error) {
-	req = typeutil.Clone(req)
-	commonpbutil.UpdateMsgBase(
-		req.GetBase(),
-		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
-	)
-	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.GetSegmentsByStatesResponse, error) {
-		return client.GetSegmentsByStates(ctx, req)
-	})
-}
-
-// ShowConfigurations gets specified configurations para of DataCoord
-func (c *Client) ShowConfigurations(ctx context.Context, req *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error) {
-	req = typeutil.Clone(req)
-	commonpbutil.UpdateMsgBase(
-		req.GetBase(),
-		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
-	)
-	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*internalpb.ShowConfigurationsResponse, error) {
-		return client.ShowConfigurations(ctx, req)
-	})
-}
-
-// GetMetrics gets all metrics of datacoord
-func (c *Client) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
-	req = typeutil.Clone(req)
-	commonpbutil.UpdateMsgBase(
-		req.GetBase(),
-		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
-	)
-	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*milvuspb.GetMetricsResponse, error) {
-		return client.GetMetrics(ctx, req)
-	})
-}
-
-// ManualCompaction triggers a compaction for a collection
-func (c *Client) ManualCompaction(ctx context.Context, req *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error) {
-	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*milvuspb.ManualCompactionResponse, error) {
-		return client.ManualCompaction(ctx, req)
-	})
-}
-
-// GetCompactionState gets the state of a compaction
-func (c *Client) GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error) {
-	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*milvuspb.GetCompactionStateResponse, error) {
-		return client.GetCompactionState(ctx, req)
-	})
-}
-
-// GetCompactionStateWithPlans gets the state of a compaction by plan
-func (c *Client) GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error) {
-	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*milvuspb.GetCompactionPlansResponse, error) {
-		return client.GetCompactionStateWithPlans(ctx, req)
-	})
-}
-
-// WatchChannels notifies DataCoord to watch vchannels of a collection
-func (c *Client) WatchChannels(ctx context.Context, req *datapb.WatchChannelsRequest) (*datapb.WatchChannelsResponse, error) {
-	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.WatchChannelsResponse, error) {
-		return client.WatchChannels(ctx, req)
-	})
-}
-
-// GetFlushState gets the flush state of multiple segments
-func (c *Client) GetFlushState(ctx context.Context, req *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error) {
-	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*milvuspb.GetFlushStateResponse, error) {
-		return client.GetFlushState(ctx, req)
-	})
-}
-
 // GetFlushAllState checks if all DML messages before `FlushAllTs` have been flushed.
 func (c *Client) GetFlushAllState(ctx context.Context, req *milvuspb.GetFlushAllStateRequest) (*milvuspb.GetFlushAllStateResponse, error) {
 	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*milvuspb.GetFlushAllStateResponse, error) {
@@ -468,173 +195,116 @@ func (c *Client) GetFlushAllState(ctx context.Context, req *milvuspb.GetFlushAll
 	})
 }
 
-// DropVirtualChannel drops virtual channel in datacoord.
-func (c *Client) DropVirtualChannel(ctx context.Context, req *datapb.DropVirtualChannelRequest) (*datapb.DropVirtualChannelResponse, error) {
+// BroadcastAlteredCollection is the DataCoord client side code for BroadcastAlteredCollection call.
+func (c *Client) BroadcastAlteredCollection(ctx context.Context, req *datapb.AlterCollectionRequest) (*commonpb.Status, error) {
 	req = typeutil.Clone(req)
-	commonpbutil.UpdateMsgBase(
-		req.GetBase(),
-		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
-	)
-	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.DropVirtualChannelResponse, error) {
-		return client.DropVirtualChannel(ctx, req)
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*commonpb.Status, error) {
+		return client.BroadcastAlteredCollection(ctx, req)
 	})
 }
 
-// SetSegmentState sets the state of a given segment.
-func (c *Client) SetSegmentState(ctx context.Context, req *datapb.SetSegmentStateRequest) (*datapb.SetSegmentStateResponse, error) {
-	req = typeutil.Clone(req)
-	commonpbutil.UpdateMsgBase(
-		req.GetBase(),
-		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
-	)
-	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.SetSegmentStateResponse, error) {
-		return client.SetSegmentState(ctx, req)
+// GetChannelWatchHistory gets the recent watch state transitions of a channel.
+func (c *Client) GetChannelWatchHistory(ctx context.Context, req *datapb.GetChannelWatchHistoryRequest) (*datapb.GetChannelWatchHistoryResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.GetChannelWatchHistoryResponse, error) {
+		return client.GetChannelWatchHistory(ctx, req)
 	})
 }
 
-// Import data files(json, numpy, etc.) on MinIO/S3 storage, read and parse them into sealed segments
-func (c *Client) Import(ctx context.Context, req *datapb.ImportTaskRequest) (*datapb.ImportTaskResponse, error) {
-	req = typeutil.Clone(req)
-	commonpbutil.UpdateMsgBase(
-		req.GetBase(),
-		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
-	)
-	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.ImportTaskResponse, error) {
-		return client.Import(ctx, req)
+// GetChannelWatchStates gets the watch states of the channels of a collection.
+func (c *Client) GetChannelWatchStates(ctx context.Context, req *datapb.GetChannelWatchStatesRequest) (*datapb.GetChannelWatchStatesResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.GetChannelWatchStatesResponse, error) {
+		return client.GetChannelWatchStates(ctx, req)
 	})
 }
 
-// UpdateSegmentStatistics is the client side caller of UpdateSegmentStatistics.
-func (c *Client) UpdateSegmentStatistics(ctx context.Context, req *datapb.UpdateSegmentStatisticsRequest) (*commonpb.Status, error) {
-	req = typeutil.Clone(req)
-	commonpbutil.UpdateMsgBase(
-		req.GetBase(),
-		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
-	)
+// SetNodeConfigs sets the config overrides scoped to a node.
+func (c *Client) SetNodeConfigs(ctx context.Context, req *datapb.SetNodeConfigsRequest) (*commonpb.Status, error) {
 	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*commonpb.Status, error) {
-		return client.UpdateSegmentStatistics(ctx, req)
+		return client.SetNodeConfigs(ctx, req)
 	})
 }
 
-// UpdateChannelCheckpoint updates channel checkpoint in dataCoord.
-func (c *Client) UpdateChannelCheckpoint(ctx context.Context, req *datapb.UpdateChannelCheckpointRequest) (*commonpb.Status, error) {
-	req = typeutil.Clone(req)
-	commonpbutil.UpdateMsgBase(
-		req.GetBase(),
-		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
-	)
-	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*commonpb.Status, error) {
-		return client.UpdateChannelCheckpoint(ctx, req)
+// ListNodeConfigs lists the config overrides scoped to a node.
+func (c *Client) ListNodeConfigs(ctx context.Context, req *datapb.ListNodeConfigsRequest) (*datapb.ListNodeConfigsResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.ListNodeConfigsResponse, error) {
+		return client.ListNodeConfigs(ctx, req)
 	})
 }
 
-// SaveImportSegment is the DataCoord client side code for SaveImportSegment call.
-func (c *Client) SaveImportSegment(ctx context.Context, req *datapb.SaveImportSegmentRequest) (*commonpb.Status, error) {
-	req = typeutil.Clone(req)
-	commonpbutil.UpdateMsgBase(
-		req.GetBase(),
-		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
-	)
+// ClearNodeConfigs clears the config overrides scoped to a node.
+func (c *Client) ClearNodeConfigs(ctx context.Context, req *datapb.ClearNodeConfigsRequest) (*commonpb.Status, error) {
 	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*commonpb.Status, error) {
-		return client.SaveImportSegment(ctx, req)
+		return client.ClearNodeConfigs(ctx, req)
 	})
 }
 
-func (c *Client) UnsetIsImportingState(ctx context.Context, req *datapb.UnsetIsImportingStateRequest) (*commonpb.Status, error) {
-	req = typeutil.Clone(req)
-	commonpbutil.UpdateMsgBase(
-		req.GetBase(),
-		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
-	)
+// SetMaintenancePolicy pauses or schedules compaction and gc globally or of a collection.
+func (c *Client) SetMaintenancePolicy(ctx context.Context, req *datapb.SetMaintenancePolicyRequest) (*commonpb.Status, error) {
 	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*commonpb.Status, error) {
-		return client.UnsetIsImportingState(ctx, req)
+		return client.SetMaintenancePolicy(ctx, req)
 	})
 }
 
-func (c *Client) MarkSegmentsDropped(ctx context.Context, req *datapb.MarkSegmentsDroppedRequest) (*commonpb.Status, error) {
-	req = typeutil.Clone(req)
-	commonpbutil.UpdateMsgBase(
-		req.GetBase(),
-		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
-	)
-	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*commonpb.Status, error) {
-		return client.MarkSegmentsDropped(ctx, req)
+// ListMaintenancePolicies lists the maintenance policies of compaction and gc.
+func (c *Client) ListMaintenancePolicies(ctx context.Context, req *datapb.ListMaintenancePoliciesRequest) (*datapb.ListMaintenancePoliciesResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.ListMaintenancePoliciesResponse, error) {
+		return client.ListMaintenancePolicies(ctx, req)
 	})
 }
 
-// BroadcastAlteredCollection is the DataCoord client side code for BroadcastAlteredCollection call.
-func (c *Client) BroadcastAlteredCollection(ctx context.Context, req *datapb.AlterCollectionRequest) (*commonpb.Status, error) {
-	req = typeutil.Clone(req)
+// CordonDataNode stops assigning new channels to the DataNode.
+func (c *Client) CordonDataNode(ctx context.Context, req *datapb.CordonDataNodeRequest) (*commonpb.Status, error) {
 	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*commonpb.Status, error) {
-		return client.BroadcastAlteredCollection(ctx, req)
-	})
-}
-
-func (c *Client) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
-	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*milvuspb.CheckHealthResponse, error) {
-		return client.CheckHealth(ctx, req)
-	})
-}
-
-func (c *Client) GcConfirm(ctx context.Context, req *datapb.GcConfirmRequest) (*datapb.GcConfirmResponse, error) {
-	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.GcConfirmResponse, error) {
-		return client.GcConfirm(ctx, req)
+		return client.CordonDataNode(ctx, req)
 	})
 }
 
-// CreateIndex sends the build index request to IndexCoord.
-func (c *Client) CreateIndex(ctx context.Context, req *indexpb.CreateIndexRequest) (*commonpb.Status, error) {
+// DrainDataNode cordons the DataNode and migrates its channels to other DataNodes gradually in background.
+func (c *Client) DrainDataNode(ctx context.Context, req *datapb.DrainDataNodeRequest) (*commonpb.Status, error) {
 	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*commonpb.Status, error) {
-		return client.CreateIndex(ctx, req)
+		return client.DrainDataNode(ctx, req)
 	})
 }
 
-// GetIndexState gets the index states from IndexCoord.
-func (c *Client) GetIndexState(ctx context.Context, req *indexpb.GetIndexStateRequest) (*indexpb.GetIndexStateResponse, error) {
-	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*indexpb.GetIndexStateResponse, error) {
-		return client.GetIndexState(ctx, req)
-	})
-}
-
-// GetSegmentIndexState gets the index states from IndexCoord.
-func (c *Client) GetSegmentIndexState(ctx context.Context, req *indexpb.GetSegmentIndexStateRequest) (*indexpb.GetSegmentIndexStateResponse, error) {
-	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*indexpb.GetSegmentIndexStateResponse, error) {
-		return client.GetSegmentIndexState(ctx, req)
+// UncordonDataNode makes the DataNode schedulable again and stops draining it.
+func (c *Client) UncordonDataNode(ctx context.Context, req *datapb.UncordonDataNodeRequest) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*commonpb.Status, error) {
+		return client.UncordonDataNode(ctx, req)
 	})
 }
 
-// GetIndexInfos gets the index file paths from IndexCoord.
-func (c *Client) GetIndexInfos(ctx context.Context, req *indexpb.GetIndexInfoRequest) (*indexpb.GetIndexInfoResponse, error) {
-	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*indexpb.GetIndexInfoResponse, error) {
-		return client.GetIndexInfos(ctx, req)
+// GetDataNodeDrainState returns the progress of draining the DataNode.
+func (c *Client) GetDataNodeDrainState(ctx context.Context, req *datapb.GetDataNodeDrainStateRequest) (*datapb.GetDataNodeDrainStateResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.GetDataNodeDrainStateResponse, error) {
+		return client.GetDataNodeDrainState(ctx, req)
 	})
 }
 
-// DescribeIndex describe the index info of the collection.
-func (c *Client) DescribeIndex(ctx context.Context, req *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error) {
-	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*indexpb.DescribeIndexResponse, error) {
-		return client.DescribeIndex(ctx, req)
+// RotateEncryptionKey switches an encrypted collection to a new key.
+func (c *Client) RotateEncryptionKey(ctx context.Context, req *datapb.RotateEncryptionKeyRequest) (*datapb.RotateEncryptionKeyResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.RotateEncryptionKeyResponse, error) {
+		return client.RotateEncryptionKey(ctx, req)
 	})
 }
 
-// GetIndexStatistics get the statistics of the index.
-func (c *Client) GetIndexStatistics(ctx context.Context, req *indexpb.GetIndexStatisticsRequest) (*indexpb.GetIndexStatisticsResponse, error) {
-	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*indexpb.GetIndexStatisticsResponse, error) {
-		return client.GetIndexStatistics(ctx, req)
+// GetEncryptionStatus returns the percentage of the data of a collection encrypted with the current key.
+func (c *Client) GetEncryptionStatus(ctx context.Context, req *datapb.GetEncryptionStatusRequest) (*datapb.GetEncryptionStatusResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.GetEncryptionStatusResponse, error) {
+		return client.GetEncryptionStatus(ctx, req)
 	})
 }
 
-// GetIndexBuildProgress describe the progress of the index.
-func (c *Client) GetIndexBuildProgress(ctx context.Context, req *indexpb.GetIndexBuildProgressRequest) (*indexpb.GetIndexBuildProgressResponse, error) {
-	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*indexpb.GetIndexBuildProgressResponse, error) {
-		return client.GetIndexBuildProgress(ctx, req)
+// CloneSegments sends the clone segments request to DataCoord.
+func (c *Client) CloneSegments(ctx context.Context, req *datapb.CloneSegmentsRequest) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*commonpb.Status, error) {
+		return client.CloneSegments(ctx, req)
 	})
 }
 
-// DropIndex sends the drop index request to IndexCoord.
-func (c *Client) DropIndex(ctx context.Context, req *indexpb.DropIndexRequest) (*commonpb.Status, error) {
-	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*commonpb.Status, error) {
-		return client.DropIndex(ctx, req)
+// GetCloneSegmentsState sends the get clone segments state request to DataCoord.
+func (c *Client) GetCloneSegmentsState(ctx context.Context, req *datapb.GetCloneSegmentsStateRequest) (*datapb.GetCloneSegmentsStateResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.GetCloneSegmentsStateResponse, error) {
+		return client.GetCloneSegmentsState(ctx, req)
 	})
 }
 
@@ -181,6 +181,19 @@ func Test_NewClient(t *testing.T) {
 		r34, err := client.DropIndex(ctx, nil)
 		retCheck(retNotNil, r34, err)
 
+		{
+			ret, err := client.AlterIndex(ctx, nil)
+			retCheck(retNotNil, ret, err)
+		}
+		{
+			ret, err := client.CloneSegments(ctx, nil)
+			retCheck(retNotNil, ret, err)
+		}
+		{
+			ret, err := client.GetCloneSegmentsState(ctx, nil)
+			retCheck(retNotNil, ret, err)
+		}
+
 		r35, err := client.GetIndexState(ctx, nil)
 		retCheck(retNotNil, r35, err)
 
@@ -209,6 +222,71 @@ func Test_NewClient(t *testing.T) {
 			retCheck(retNotNil, ret, err)
 		}
 
+		{
+			ret, err := client.GetChannelWatchHistory(ctx, nil)
+			retCheck(retNotNil, ret, err)
+		}
+
+		{
+			ret, err := client.GetChannelWatchStates(ctx, nil)
+			retCheck(retNotNil, ret, err)
+		}
+
+		{
+			ret, err := client.SetNodeConfigs(ctx, nil)
+			retCheck(retNotNil, ret, err)
+		}
+
+		{
+			ret, err := client.ListNodeConfigs(ctx, nil)
+			retCheck(retNotNil, ret, err)
+		}
+
+		{
+			ret, err := client.ClearNodeConfigs(ctx, nil)
+			retCheck(retNotNil, ret, err)
+		}
+
+		{
+			ret, err := client.SetMaintenancePolicy(ctx, nil)
+			retCheck(retNotNil, ret, err)
+		}
+
+		{
+			ret, err := client.ListMaintenancePolicies(ctx, nil)
+			retCheck(retNotNil, ret, err)
+		}
+
+		{
+			ret, err := client.CordonDataNode(ctx, nil)
+			retCheck(retNotNil, ret, err)
+		}
+
+		{
+			ret, err := client.DrainDataNode(ctx, nil)
+			retCheck(retNotNil, ret, err)
+		}
+
+		{
+			ret, err := client.UncordonDataNode(ctx, nil)
+			retCheck(retNotNil, ret, err)
+		}
+
+		{
+			ret, err := client.GetDataNodeDrainState(ctx, nil)
+			retCheck(retNotNil, ret, err)
+		}
+
+		{
+			ret, err := client.RotateEncryptionKey(ctx, nil)
+			retCheck(retNotNil, ret, err)
+		}
+
+		{
+			ret, err := client.GetEncryptionStatus(ctx, nil)
+			retCheck(retNotNil, ret, err)
+		}
+
 		r40, err := client.GetRecoveryInfoV2(ctx, nil)
 		retCheck(retNotNil, r40, err)
 
@@ -162,6 +162,7 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 		)))
 	indexpb.RegisterIndexCoordServer(s.grpcServer, s)
 	datapb.RegisterDataCoordServer(s.grpcServer, s)
+	datapb.RegisterDataCoordReplicationServer(s.grpcServer, s)
 	go funcutil.CheckGrpcReady(ctx, s.grpcErrChan)
 	if err := s.grpcServer.Serve(lis); err != nil {
 		s.grpcErrChan <- err
@@ -318,6 +319,21 @@ func (s *Server) ManualCompaction(ctx context.Context, req *milvuspb.ManualCompa
 	return s.dataCoord.ManualCompaction(ctx, req)
 }
 
+// ManualCompactionWithMode triggers a compaction for a collection on the segments selected by the mode
+func (s *Server) ManualCompactionWithMode(ctx context.Context, req *datapb.ManualCompactionWithModeRequest) (*datapb.ManualCompactionWithModeResponse, error) {
+	return s.dataCoord.ManualCompactionWithMode(ctx, req)
+}
+
+// GetCompactionProgress gets the progress of a compaction by the plans finished
+func (s *Server) GetCompactionProgress(ctx context.Context, req *datapb.GetCompactionProgressRequest) (*datapb.GetCompactionProgressResponse, error) {
+	return s.dataCoord.GetCompactionProgress(ctx, req)
+}
+
+// CancelCompaction cancels the unfinished plans of a compaction
+func (s *Server) CancelCompaction(ctx context.Context, req *datapb.CancelCompactionRequest) (*commonpb.Status, error) {
+	return s.dataCoord.CancelCompaction(ctx, req)
+}
+
 // GetCompactionState gets the state of a compaction
 func (s *Server) GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error) {
 	return s.dataCoord.GetCompactionState(ctx, req)
@@ -396,6 +412,136 @@ func (s *Server) GcConfirm(ctx context.Context, request *datapb.GcConfirmRequest
 	return s.dataCoord.GcConfirm(ctx, request)
 }
 
+// GcDryRun reports the files and segments to be recycled by the garbage collection without deleting anything.
+func (s *Server) GcDryRun(ctx context.Context, req *datapb.GcDryRunRequest) (*datapb.GcDryRunResponse, error) {
+	return s.dataCoord.GcDryRun(ctx, req)
+}
+
+// PauseGC pauses the garbage collector, and returns after the in-flight removal stops.
+func (s *Server) PauseGC(ctx context.Context, req *datapb.PauseGCRequest) (*commonpb.Status, error) {
+	return s.dataCoord.PauseGC(ctx, req)
+}
+
+// ResumeGC resumes the garbage collector paused by PauseGC.
+func (s *Server) ResumeGC(ctx context.Context, req *datapb.ResumeGCRequest) (*commonpb.Status, error) {
+	return s.dataCoord.ResumeGC(ctx, req)
+}
+
+// GetGCStatus returns the current phase, the pending files and the run statistics of the garbage collector.
+func (s *Server) GetGCStatus(ctx context.Context, req *datapb.GetGCStatusRequest) (*datapb.GetGCStatusResponse, error) {
+	return s.dataCoord.GetGCStatus(ctx, req)
+}
+
+// DropSegmentsByTimeRange drops the flushed segments of a partition whose insert timestamps are within the time range.
+func (s *Server) DropSegmentsByTimeRange(ctx context.Context, req *datapb.DropSegmentsByTimeRangeRequest) (*datapb.DropSegmentsByTimeRangeResponse, error) {
+	return s.dataCoord.DropSegmentsByTimeRange(ctx, req)
+}
+
+// GetTopologySnapshot gets the JSON serialized snapshot of the DataNodes, their channels and the buffer channels.
+func (s *Server) GetTopologySnapshot(ctx context.Context, req *datapb.GetTopologySnapshotRequest) (*datapb.GetTopologySnapshotResponse, error) {
+	return s.dataCoord.GetTopologySnapshot(ctx, req)
+}
+
+// RetryQuarantinedChannels releases the quarantined channels to be watched again.
+func (s *Server) RetryQuarantinedChannels(ctx context.Context, req *datapb.RetryQuarantinedChannelsRequest) (*datapb.RetryQuarantinedChannelsResponse, error) {
+	return s.dataCoord.RetryQuarantinedChannels(ctx, req)
+}
+
+// MigrateChannelWatchInfos rewrites the legacy channel watch infos into the newest schema.
+func (s *Server) MigrateChannelWatchInfos(ctx context.Context, req *datapb.MigrateChannelWatchInfosRequest) (*datapb.MigrateChannelWatchInfosResponse, error) {
+	return s.dataCoord.MigrateChannelWatchInfos(ctx, req)
+}
+
+// ReCollectSegmentStats asks the DataNodes to resend the segment stats.
+func (s *Server) ReCollectSegmentStats(ctx context.Context, req *datapb.ReCollectSegmentStatsRequest) (*datapb.ReCollectSegmentStatsResponse, error) {
+	return s.dataCoord.ReCollectSegmentStats(ctx, req)
+}
+
+// FlushAndSeal flushes the collection up to the barrier timestamp.
+func (s *Server) FlushAndSeal(ctx context.Context, req *datapb.FlushAndSealRequest) (*datapb.FlushAndSealResponse, error) {
+	return s.dataCoord.FlushAndSeal(ctx, req)
+}
+
+// GetStorageConsistencyReport gets the report of the last reconciliation between the segment meta and the object storage.
+func (s *Server) GetStorageConsistencyReport(ctx context.Context, req *datapb.GetStorageConsistencyReportRequest) (*datapb.GetStorageConsistencyReportResponse, error) {
+	return s.dataCoord.GetStorageConsistencyReport(ctx, req)
+}
+
+// GetShardWriteStats gets the write rates of the vchannels of the collection.
+func (s *Server) GetShardWriteStats(ctx context.Context, req *datapb.GetShardWriteStatsRequest) (*datapb.GetShardWriteStatsResponse, error) {
+	return s.dataCoord.GetShardWriteStats(ctx, req)
+}
+
+// GetAuditEvents gets the events recorded in the audit log.
+func (s *Server) GetAuditEvents(ctx context.Context, req *datapb.GetAuditEventsRequest) (*datapb.GetAuditEventsResponse, error) {
+	return s.dataCoord.GetAuditEvents(ctx, req)
+}
+
+// GetChannelWatchHistory gets the recent watch state transitions of a channel.
+func (s *Server) GetChannelWatchHistory(ctx context.Context, req *datapb.GetChannelWatchHistoryRequest) (*datapb.GetChannelWatchHistoryResponse, error) {
+	return s.dataCoord.GetChannelWatchHistory(ctx, req)
+}
+
+// GetChannelWatchStates gets the watch states of the channels of a collection.
+func (s *Server) GetChannelWatchStates(ctx context.Context, req *datapb.GetChannelWatchStatesRequest) (*datapb.GetChannelWatchStatesResponse, error) {
+	return s.dataCoord.GetChannelWatchStates(ctx, req)
+}
+
+// SetNodeConfigs sets the config overrides scoped to a node.
+func (s *Server) SetNodeConfigs(ctx context.Context, req *datapb.SetNodeConfigsRequest) (*commonpb.Status, error) {
+	return s.dataCoord.SetNodeConfigs(ctx, req)
+}
+
+// ListNodeConfigs lists the config overrides scoped to a node.
+func (s *Server) ListNodeConfigs(ctx context.Context, req *datapb.ListNodeConfigsRequest) (*datapb.ListNodeConfigsResponse, error) {
+	return s.dataCoord.ListNodeConfigs(ctx, req)
+}
+
+// ClearNodeConfigs clears the config overrides scoped to a node.
+func (s *Server) ClearNodeConfigs(ctx context.Context, req *datapb.ClearNodeConfigsRequest) (*commonpb.Status, error) {
+	return s.dataCoord.ClearNodeConfigs(ctx, req)
+}
+
+// SetMaintenancePolicy pauses or schedules compaction and gc globally or of a collection.
+func (s *Server) SetMaintenancePolicy(ctx context.Context, req *datapb.SetMaintenancePolicyRequest) (*commonpb.Status, error) {
+	return s.dataCoord.SetMaintenancePolicy(ctx, req)
+}
+
+// ListMaintenancePolicies lists the maintenance policies of compaction and gc.
+func (s *Server) ListMaintenancePolicies(ctx context.Context, req *datapb.ListMaintenancePoliciesRequest) (*datapb.ListMaintenancePoliciesResponse, error) {
+	return s.dataCoord.ListMaintenancePolicies(ctx, req)
+}
+
+// CordonDataNode stops assigning new channels to the DataNode.
+func (s *Server) CordonDataNode(ctx context.Context, req *datapb.CordonDataNodeRequest) (*commonpb.Status, error) {
+	return s.dataCoord.CordonDataNode(ctx, req)
+}
+
+// DrainDataNode cordons the DataNode and migrates its channels to other DataNodes gradually in background.
+func (s *Server) DrainDataNode(ctx context.Context, req *datapb.DrainDataNodeRequest) (*commonpb.Status, error) {
+	return s.dataCoord.DrainDataNode(ctx, req)
+}
+
+// UncordonDataNode makes the DataNode schedulable again and stops draining it.
+func (s *Server) UncordonDataNode(ctx context.Context, req *datapb.UncordonDataNodeRequest) (*commonpb.Status, error) {
+	return s.dataCoord.UncordonDataNode(ctx, req)
+}
+
+// GetDataNodeDrainState returns the progress of draining the DataNode.
+func (s *Server) GetDataNodeDrainState(ctx context.Context, req *datapb.GetDataNodeDrainStateRequest) (*datapb.GetDataNodeDrainStateResponse, error) {
+	return s.dataCoord.GetDataNodeDrainState(ctx, req)
+}
+
+// RotateEncryptionKey switches an encrypted collection to a new key.
+func (s *Server) RotateEncryptionKey(ctx context.Context, req *datapb.RotateEncryptionKeyRequest) (*datapb.RotateEncryptionKeyResponse, error) {
+	return s.dataCoord.RotateEncryptionKey(ctx, req)
+}
+
+// GetEncryptionStatus returns the percentage of the data of a collection encrypted with the current key.
+func (s *Server) GetEncryptionStatus(ctx context.Context, req *datapb.GetEncryptionStatusRequest) (*datapb.GetEncryptionStatusResponse, error) {
+	return s.dataCoord.GetEncryptionStatus(ctx, req)
+}
+
 // CreateIndex sends the build index request to DataCoord.
 func (s *Server) CreateIndex(ctx context.Context, req *indexpb.CreateIndexRequest) (*commonpb.Status, error) {
 	return s.dataCoord.CreateIndex(ctx, req)
@@ -431,6 +577,31 @@ func (s *Server) DropIndex(ctx context.Context, request *indexpb.DropIndexReques
 	return s.dataCoord.DropIndex(ctx, request)
 }
 
+// AlterIndex sends the alter index request to DataCoord.
+func (s *Server) AlterIndex(ctx context.Context, request *indexpb.AlterIndexRequest) (*commonpb.Status, error) {
+	return s.dataCoord.AlterIndex(ctx, request)
+}
+
+// CloneSegments sends the clone segments request to DataCoord.
+func (s *Server) CloneSegments(ctx context.Context, request *datapb.CloneSegmentsRequest) (*commonpb.Status, error) {
+	return s.dataCoord.CloneSegments(ctx, request)
+}
+
+// GetCloneSegmentsState sends the get clone segments state request to DataCoord.
+func (s *Server) GetCloneSegmentsState(ctx context.Context, request *datapb.GetCloneSegmentsStateRequest) (*datapb.GetCloneSegmentsStateResponse, error) {
+	return s.dataCoord.GetCloneSegmentsState(ctx, request)
+}
+
+// ReplicateBinlog sends the replicate binlog request to DataCoord.
+func (s *Server) ReplicateBinlog(ctx context.Context, request *datapb.ReplicateBinlogRequest) (*commonpb.Status, error) {
+	return s.dataCoord.ReplicateBinlog(ctx, request)
+}
+
+// ReplicateSegment sends the replicate segment request to DataCoord.
+func (s *Server) ReplicateSegment(ctx context.Context, request *datapb.ReplicateSegmentRequest) (*datapb.ReplicateSegmentResponse, error) {
+	return s.dataCoord.ReplicateSegment(ctx, request)
+}
+
 // Deprecated: use DescribeIndex instead
 func (s *Server) GetIndexBuildProgress(ctx context.Context, req *indexpb.GetIndexBuildProgressRequest) (*indexpb.GetIndexBuildProgressResponse, error) {
 	return s.dataCoord.GetIndexBuildProgress(ctx, req)
@@ -71,11 +71,23 @@ type MockDataCoord struct {
 	unsetIsImportingStateResp *commonpb.Status
 	markSegmentsDroppedResp   *commonpb.Status
 	broadCastResp             *commonpb.Status
+	channelWatchHistoryResp   *datapb.GetChannelWatchHistoryResponse
+	channelWatchStatesResp    *datapb.GetChannelWatchStatesResponse
+	listNodeConfigsResp       *datapb.ListNodeConfigsResponse
+	listMaintenancePolicyResp *datapb.ListMaintenancePoliciesResponse
+	rotateEncryptionKeyResp   *datapb.RotateEncryptionKeyResponse
+	getEncryptionStatusResp   *datapb.GetEncryptionStatusResponse
+	getDataNodeDrainStateResp *datapb.GetDataNodeDrainStateResponse
 
 	createIndexResp           *commonpb.Status
 	describeIndexResp         *indexpb.DescribeIndexResponse
 	getIndexStatisticsResp    *indexpb.GetIndexStatisticsResponse
 	dropIndexResp             *commonpb.Status
+	alterIndexResp            *commonpb.Status
+	cloneSegmentsResp         *commonpb.Status
+	getCloneSegmentsStateResp *datapb.GetCloneSegmentsStateResponse
+	replicateBinlogResp       *commonpb.Status
+	replicateSegmentResp      *datapb.ReplicateSegmentResponse
 	getIndexStateResp         *indexpb.GetIndexStateResponse
 	getIndexBuildProgressResp *indexpb.GetIndexBuildProgressResponse
 	getSegmentIndexStateResp  *indexpb.GetSegmentIndexStateResponse
@@ -251,6 +263,58 @@ func (m *MockDataCoord) CheckHealth(ctx context.Context, req *milvuspb.CheckHeal
 	}, nil
 }
 
+func (m *MockDataCoord) GetChannelWatchHistory(ctx context.Context, req *datapb.GetChannelWatchHistoryRequest) (*datapb.GetChannelWatchHistoryResponse, error) {
+	return m.channelWatchHistoryResp, m.err
+}
+
+func (m *MockDataCoord) GetChannelWatchStates(ctx context.Context, req *datapb.GetChannelWatchStatesRequest) (*datapb.GetChannelWatchStatesResponse, error) {
+	return m.channelWatchStatesResp, m.err
+}
+
+func (m *MockDataCoord) SetNodeConfigs(ctx context.Context, req *datapb.SetNodeConfigsRequest) (*commonpb.Status, error) {
+	return m.status, m.err
+}
+
+func (m *MockDataCoord) ListNodeConfigs(ctx context.Context, req *datapb.ListNodeConfigsRequest) (*datapb.ListNodeConfigsResponse, error) {
+	return m.listNodeConfigsResp, m.err
+}
+
+func (m *MockDataCoord) ClearNodeConfigs(ctx context.Context, req *datapb.ClearNodeConfigsRequest) (*commonpb.Status, error) {
+	return m.status, m.err
+}
+
+func (m *MockDataCoord) SetMaintenancePolicy(ctx context.Context, req *datapb.SetMaintenancePolicyRequest) (*commonpb.Status, error) {
+	return m.status, m.err
+}
+
+func (m *MockDataCoord) ListMaintenancePolicies(ctx context.Context, req *datapb.ListMaintenancePoliciesRequest) (*datapb.ListMaintenancePoliciesResponse, error) {
+	return m.listMaintenancePolicyResp, m.err
+}
+
+func (m *MockDataCoord) CordonDataNode(ctx context.Context, req *datapb.CordonDataNodeRequest) (*commonpb.Status, error) {
+	return m.status, m.err
+}
+
+func (m *MockDataCoord) DrainDataNode(ctx context.Context, req *datapb.DrainDataNodeRequest) (*commonpb.Status, error) {
+	return m.status, m.err
+}
+
+func (m *MockDataCoord) UncordonDataNode(ctx context.Context, req *datapb.UncordonDataNodeRequest) (*commonpb.Status, error) {
+	return m.status, m.err
+}
+
+func (m *MockDataCoord) GetDataNodeDrainState(ctx context.Context, req *datapb.GetDataNodeDrainStateRequest) (*datapb.GetDataNodeDrainStateResponse, error) {
+	return m.getDataNodeDrainStateResp, m.err
+}
+
+func (m *MockDataCoord) RotateEncryptionKey(ctx context.Context, req *datapb.RotateEncryptionKeyRequest) (*datapb.RotateEncryptionKeyResponse, error) {
+	return m.rotateEncryptionKeyResp, m.err
+}
+
+func (m *MockDataCoord) GetEncryptionStatus(ctx context.Context, req *datapb.GetEncryptionStatusRequest) (*datapb.GetEncryptionStatusResponse, error) {
+	return m.getEncryptionStatusResp, m.err
+}
+
 func (m *MockDataCoord) CreateIndex(ctx context.Context, req *indexpb.CreateIndexRequest) (*commonpb.Status, error) {
 	return m.createIndexResp, m.err
 }
@@ -283,6 +347,26 @@ func (m *MockDataCoord) DropIndex(ctx context.Context, req *indexpb.DropIndexReq
 	return m.dropIndexResp, m.err
 }
 
+func (m *MockDataCoord) AlterIndex(ctx context.Context, req *indexpb.AlterIndexRequest) (*commonpb.Status, error) {
+	return m.alterIndexResp, m.err
+}
+
+func (m *MockDataCoord) CloneSegments(ctx context.Context, req *datapb.CloneSegmentsRequest) (*commonpb.Status, error) {
+	return m.cloneSegmentsResp, m.err
+}
+
+func (m *MockDataCoord) GetCloneSegmentsState(ctx context.Context, req *datapb.GetCloneSegmentsStateRequest) (*datapb.GetCloneSegmentsStateResponse, error) {
+	return m.getCloneSegmentsStateResp, m.err
+}
+
+func (m *MockDataCoord) ReplicateBinlog(ctx context.Context, req *datapb.ReplicateBinlogRequest) (*commonpb.Status, error) {
+	return m.replicateBinlogResp, m.err
+}
+
+func (m *MockDataCoord) ReplicateSegment(ctx context.Context, req *datapb.ReplicateSegmentRequest) (*datapb.ReplicateSegmentResponse, error) {
+	return m.replicateSegmentResp, m.err
+}
+
 func Test_NewServer(t *testing.T) {
 	paramtable.Init()
 	ctx := context.Background()
@@ -597,6 +681,96 @@ func Test_NewServer(t *testing.T) {
 		assert.Equal(t, true, ret.IsHealthy)
 	})
 
+	t.Run("GetChannelWatchHistory", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			channelWatchHistoryResp: &datapb.GetChannelWatchHistoryResponse{},
+		}
+		ret, err := server.GetChannelWatchHistory(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, ret)
+	})
+
+	t.Run("GetChannelWatchStates", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			channelWatchStatesResp: &datapb.GetChannelWatchStatesResponse{},
+		}
+		ret, err := server.GetChannelWatchStates(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, ret)
+	})
+
+	t.Run("node configs", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			status:              &commonpb.Status{},
+			listNodeConfigsResp: &datapb.ListNodeConfigsResponse{},
+		}
+		status, err := server.SetNodeConfigs(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, status)
+
+		resp, err := server.ListNodeConfigs(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, resp)
+
+		status, err = server.ClearNodeConfigs(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, status)
+	})
+
+	t.Run("maintenance policies", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			status:                    &commonpb.Status{},
+			listMaintenancePolicyResp: &datapb.ListMaintenancePoliciesResponse{},
+		}
+		status, err := server.SetMaintenancePolicy(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, status)
+
+		resp, err := server.ListMaintenancePolicies(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("cordon and drain datanode", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			status: &commonpb.Status{},
+		}
+		status, err := server.CordonDataNode(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, status)
+
+		status, err = server.DrainDataNode(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, status)
+
+		status, err = server.UncordonDataNode(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, status)
+	})
+
+	t.Run("GetDataNodeDrainState", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			getDataNodeDrainStateResp: &datapb.GetDataNodeDrainStateResponse{},
+		}
+		resp, err := server.GetDataNodeDrainState(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("encryption key", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			rotateEncryptionKeyResp: &datapb.RotateEncryptionKeyResponse{},
+			getEncryptionStatusResp: &datapb.GetEncryptionStatusResponse{},
+		}
+		rotateResp, err := server.RotateEncryptionKey(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, rotateResp)
+
+		statusResp, err := server.GetEncryptionStatus(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, statusResp)
+	})
+
 	t.Run("CreateIndex", func(t *testing.T) {
 		server.dataCoord = &MockDataCoord{
 			createIndexResp: &commonpb.Status{},
@@ -633,6 +807,51 @@ func Test_NewServer(t *testing.T) {
 		assert.NotNil(t, ret)
 	})
 
+	t.Run("AlterIndex", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			alterIndexResp: &commonpb.Status{},
+		}
+		ret, err := server.AlterIndex(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, ret)
+	})
+
+	t.Run("CloneSegments", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			cloneSegmentsResp: &commonpb.Status{},
+		}
+		ret, err := server.CloneSegments(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, ret)
+	})
+
+	t.Run("GetCloneSegmentsState", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			getCloneSegmentsStateResp: &datapb.GetCloneSegmentsStateResponse{},
+		}
+		ret, err := server.GetCloneSegmentsState(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, ret)
+	})
+
+	t.Run("ReplicateBinlog", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			replicateBinlogResp: &commonpb.Status{},
+		}
+		ret, err := server.ReplicateBinlog(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, ret)
+	})
+
+	t.Run("ReplicateSegment", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			replicateSegmentResp: &datapb.ReplicateSegmentResponse{},
+		}
+		ret, err := server.ReplicateSegment(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, ret)
+	})
+
 	t.Run("GetIndexState", func(t *testing.T) {
 		server.dataCoord = &MockDataCoord{
 			getIndexStateResp: &indexpb.GetIndexStateResponse{},
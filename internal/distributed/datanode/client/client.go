@@ -21,12 +21,10 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/util/grpcclient"
-	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
@@ -50,17 +48,21 @@ func NewClient(ctx context.Context, addr string) (*Client, error) {
 	client := &Client{
 		addr: addr,
 		grpcClient: &grpcclient.ClientBase[datapb.DataNodeClient]{
-			ClientMaxRecvSize:      clientParams.ClientMaxRecvSize.GetAsInt(),
-			ClientMaxSendSize:      clientParams.ClientMaxSendSize.GetAsInt(),
-			DialTimeout:            clientParams.DialTimeout.GetAsDuration(time.Millisecond),
-			KeepAliveTime:          clientParams.KeepAliveTime.GetAsDuration(time.Millisecond),
-			KeepAliveTimeout:       clientParams.KeepAliveTimeout.GetAsDuration(time.Millisecond),
-			RetryServiceNameConfig: "milvus.proto.data.DataNode",
-			MaxAttempts:            clientParams.MaxAttempts.GetAsInt(),
-			InitialBackoff:         float32(clientParams.InitialBackoff.GetAsFloat()),
-			MaxBackoff:             float32(clientParams.MaxBackoff.GetAsFloat()),
-			BackoffMultiplier:      float32(clientParams.BackoffMultiplier.GetAsFloat()),
-			CompressionEnabled:     clientParams.CompressionEnabled.GetAsBool(),
+			ClientMaxRecvSize:        clientParams.ClientMaxRecvSize.GetAsInt(),
+			ClientMaxSendSize:        clientParams.ClientMaxSendSize.GetAsInt(),
+			DialTimeout:              clientParams.DialTimeout.GetAsDuration(time.Millisecond),
+			KeepAliveTime:            clientParams.KeepAliveTime.GetAsDuration(time.Millisecond),
+			KeepAliveTimeout:         clientParams.KeepAliveTimeout.GetAsDuration(time.Millisecond),
+			RetryServiceNameConfig:   "milvus.proto.data.DataNode",
+			MaxAttempts:              clientParams.MaxAttempts.GetAsInt(),
+			InitialBackoff:           float32(clientParams.InitialBackoff.GetAsFloat()),
+			MaxBackoff:               float32(clientParams.MaxBackoff.GetAsFloat()),
+			BackoffMultiplier:        float32(clientParams.BackoffMultiplier.GetAsFloat()),
+			CompressionEnabled:       clientParams.CompressionEnabled.GetAsBool(),
+			SelectiveCompression:     clientParams.SelectiveCompressionEnabled.GetAsBool(),
+			CompressionAlgorithm:     clientParams.SelectiveCompressionAlgorithm.GetValue(),
+			CompressionMethods:       clientParams.SelectiveCompressionMethods.GetAsStrings(),
+			CompressionSizeThreshold: clientParams.SelectiveCompressionSizeThreshold.GetAsInt(),
 		},
 	}
 	client.grpcClient.SetRole(typeutil.DataNodeRole)
@@ -127,115 +129,3 @@ func (c *Client) GetStatisticsChannel(ctx context.Context) (*milvuspb.StringResp
 		return client.GetStatisticsChannel(ctx, &internalpb.GetStatisticsChannelRequest{})
 	})
 }
-
-// Deprecated
-// WatchDmChannels create consumers on dmChannels to reveive Incremental data
-func (c *Client) WatchDmChannels(ctx context.Context, req *datapb.WatchDmChannelsRequest) (*commonpb.Status, error) {
-	req = typeutil.Clone(req)
-	commonpbutil.UpdateMsgBase(
-		req.GetBase(),
-		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID()))
-	return wrapGrpcCall(ctx, c, func(client datapb.DataNodeClient) (*commonpb.Status, error) {
-		return client.WatchDmChannels(ctx, req)
-	})
-}
-
-// FlushSegments notifies DataNode to flush the segments req provids. The flush tasks are async to this
-//
-//	rpc, DataNode will flush the segments in the background.
-//
-// Return UnexpectedError code in status:
-//
-//	If DataNode isn't in HEALTHY: states not HEALTHY or dynamic checks not HEALTHY
-//	If DataNode doesn't find the correspounding segmentID in its memeory replica
-//
-// Return Success code in status and trigers background flush:
-//
-//	Log an info log if a segment is under flushing
-func (c *Client) FlushSegments(ctx context.Context, req *datapb.FlushSegmentsRequest) (*commonpb.Status, error) {
-	req = typeutil.Clone(req)
-	commonpbutil.UpdateMsgBase(
-		req.GetBase(),
-		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID()))
-	return wrapGrpcCall(ctx, c, func(client datapb.DataNodeClient) (*commonpb.Status, error) {
-		return client.FlushSegments(ctx, req)
-	})
-}
-
-// ShowConfigurations gets specified configurations para of DataNode
-func (c *Client) ShowConfigurations(ctx context.Context, req *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error) {
-	req = typeutil.Clone(req)
-	commonpbutil.UpdateMsgBase(
-		req.GetBase(),
-		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID()))
-	return wrapGrpcCall(ctx, c, func(client datapb.DataNodeClient) (*internalpb.ShowConfigurationsResponse, error) {
-		return client.ShowConfigurations(ctx, req)
-	})
-}
-
-// GetMetrics returns metrics
-func (c *Client) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
-	req = typeutil.Clone(req)
-	commonpbutil.UpdateMsgBase(
-		req.GetBase(),
-		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID()))
-	return wrapGrpcCall(ctx, c, func(client datapb.DataNodeClient) (*milvuspb.GetMetricsResponse, error) {
-		return client.GetMetrics(ctx, req)
-	})
-}
-
-// Compaction return compaction by given plan
-func (c *Client) Compaction(ctx context.Context, req *datapb.CompactionPlan) (*commonpb.Status, error) {
-	return wrapGrpcCall(ctx, c, func(client datapb.DataNodeClient) (*commonpb.Status, error) {
-		return client.Compaction(ctx, req)
-	})
-}
-
-func (c *Client) GetCompactionState(ctx context.Context, req *datapb.CompactionStateRequest) (*datapb.CompactionStateResponse, error) {
-	req = typeutil.Clone(req)
-	commonpbutil.UpdateMsgBase(
-		req.GetBase(),
-		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID()))
-	return wrapGrpcCall(ctx, c, func(client datapb.DataNodeClient) (*datapb.CompactionStateResponse, error) {
-		return client.GetCompactionState(ctx, req)
-	})
-}
-
-// Import data files(json, numpy, etc.) on MinIO/S3 storage, read and parse them into sealed segments
-func (c *Client) Import(ctx context.Context, req *datapb.ImportTaskRequest) (*commonpb.Status, error) {
-	req = typeutil.Clone(req)
-	commonpbutil.UpdateMsgBase(
-		req.GetBase(),
-		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID()))
-	return wrapGrpcCall(ctx, c, func(client datapb.DataNodeClient) (*commonpb.Status, error) {
-		return client.Import(ctx, req)
-	})
-}
-
-func (c *Client) ResendSegmentStats(ctx context.Context, req *datapb.ResendSegmentStatsRequest) (*datapb.ResendSegmentStatsResponse, error) {
-	req = typeutil.Clone(req)
-	commonpbutil.UpdateMsgBase(
-		req.GetBase(),
-		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID()))
-	return wrapGrpcCall(ctx, c, func(client datapb.DataNodeClient) (*datapb.ResendSegmentStatsResponse, error) {
-		return client.ResendSegmentStats(ctx, req)
-	})
-}
-
-// AddImportSegment is the DataNode client side code for AddImportSegment call.
-func (c *Client) AddImportSegment(ctx context.Context, req *datapb.AddImportSegmentRequest) (*datapb.AddImportSegmentResponse, error) {
-	req = typeutil.Clone(req)
-	commonpbutil.UpdateMsgBase(
-		req.GetBase(),
-		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID()))
-	return wrapGrpcCall(ctx, c, func(client datapb.DataNodeClient) (*datapb.AddImportSegmentResponse, error) {
-		return client.AddImportSegment(ctx, req)
-	})
-}
-
-// SyncSegments is the DataNode client side code for SyncSegments call.
-func (c *Client) SyncSegments(ctx context.Context, req *datapb.SyncSegmentsRequest) (*commonpb.Status, error) {
-	return wrapGrpcCall(ctx, c, func(client datapb.DataNodeClient) (*commonpb.Status, error) {
-		return client.SyncSegments(ctx, req)
-	})
-}
@@ -93,6 +93,12 @@ func Test_NewClient(t *testing.T) {
 
 		r11, err := client.GetCompactionState(ctx, nil)
 		retCheck(retNotNil, r11, err)
+
+		r12, err := client.DumpDispatcherPositions(ctx, nil)
+		retCheck(retNotNil, r12, err)
+
+		r13, err := client.SeekDispatcher(ctx, nil)
+		retCheck(retNotNil, r13, err)
 	}
 
 	client.grpcClient = &mock.GRPCClientBase[datapb.DataNodeClient]{
@@ -382,6 +382,19 @@ func (s *Server) AddImportSegment(ctx context.Context, request *datapb.AddImport
 	return s.datanode.AddImportSegment(ctx, request)
 }
 
+func (s *Server) DumpDispatcherPositions(ctx context.Context, request *internalpb.DumpDispatcherPositionsRequest) (*internalpb.DumpDispatcherPositionsResponse, error) {
+	return s.datanode.DumpDispatcherPositions(ctx, request)
+}
+
+func (s *Server) SeekDispatcher(ctx context.Context, request *internalpb.SeekDispatcherRequest) (*commonpb.Status, error) {
+	return s.datanode.SeekDispatcher(ctx, request)
+}
+
 func (s *Server) SyncSegments(ctx context.Context, request *datapb.SyncSegmentsRequest) (*commonpb.Status, error) {
 	return s.datanode.SyncSegments(ctx, request)
 }
+
+// CancelCompactionPlans interrupts the executing compaction plans
+func (s *Server) CancelCompactionPlans(ctx context.Context, request *datapb.CancelCompactionPlansRequest) (*commonpb.Status, error) {
+	return s.datanode.CancelCompactionPlans(ctx, request)
+}
@@ -144,10 +144,22 @@ func (m *MockDataNode) AddImportSegment(ctx context.Context, req *datapb.AddImpo
 	return m.addImportSegmentResp, m.err
 }
 
+func (m *MockDataNode) DumpDispatcherPositions(ctx context.Context, req *internalpb.DumpDispatcherPositionsRequest) (*internalpb.DumpDispatcherPositionsResponse, error) {
+	return &internalpb.DumpDispatcherPositionsResponse{Status: m.status}, m.err
+}
+
+func (m *MockDataNode) SeekDispatcher(ctx context.Context, req *internalpb.SeekDispatcherRequest) (*commonpb.Status, error) {
+	return m.status, m.err
+}
+
 func (m *MockDataNode) SyncSegments(ctx context.Context, req *datapb.SyncSegmentsRequest) (*commonpb.Status, error) {
 	return m.status, m.err
 }
 
+func (m *MockDataNode) CancelCompactionPlans(ctx context.Context, req *datapb.CancelCompactionPlansRequest) (*commonpb.Status, error) {
+	return m.status, m.err
+}
+
 // /////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 type mockDataCoord struct {
 	types.DataCoord
@@ -325,6 +337,24 @@ func Test_NewServer(t *testing.T) {
 		assert.NotNil(t, resp)
 	})
 
+	t.Run("dump dispatcher positions", func(t *testing.T) {
+		server.datanode = &MockDataNode{
+			status: &commonpb.Status{},
+		}
+		resp, err := server.DumpDispatcherPositions(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("seek dispatcher", func(t *testing.T) {
+		server.datanode = &MockDataNode{
+			status: &commonpb.Status{},
+		}
+		resp, err := server.SeekDispatcher(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, resp)
+	})
+
 	err = server.Stop()
 	assert.NoError(t, err)
 }
@@ -24,11 +24,9 @@ import (
 	"github.com/milvus-io/milvus/internal/util/grpcclient"
 	"google.golang.org/grpc"
 
-	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
-	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
@@ -51,17 +49,21 @@ func NewClient(ctx context.Context, addr string, encryption bool) (*Client, erro
 	client := &Client{
 		addr: addr,
 		grpcClient: &grpcclient.ClientBase[indexpb.IndexNodeClient]{
-			ClientMaxRecvSize:      clientParams.ClientMaxRecvSize.GetAsInt(),
-			ClientMaxSendSize:      clientParams.ClientMaxSendSize.GetAsInt(),
-			DialTimeout:            clientParams.DialTimeout.GetAsDuration(time.Millisecond),
-			KeepAliveTime:          clientParams.KeepAliveTime.GetAsDuration(time.Millisecond),
-			KeepAliveTimeout:       clientParams.KeepAliveTimeout.GetAsDuration(time.Millisecond),
-			RetryServiceNameConfig: "milvus.proto.index.IndexNode",
-			MaxAttempts:            clientParams.MaxAttempts.GetAsInt(),
-			InitialBackoff:         float32(clientParams.InitialBackoff.GetAsFloat()),
-			MaxBackoff:             float32(clientParams.MaxBackoff.GetAsFloat()),
-			BackoffMultiplier:      float32(clientParams.BackoffMultiplier.GetAsFloat()),
-			CompressionEnabled:     clientParams.CompressionEnabled.GetAsBool(),
+			ClientMaxRecvSize:        clientParams.ClientMaxRecvSize.GetAsInt(),
+			ClientMaxSendSize:        clientParams.ClientMaxSendSize.GetAsInt(),
+			DialTimeout:              clientParams.DialTimeout.GetAsDuration(time.Millisecond),
+			KeepAliveTime:            clientParams.KeepAliveTime.GetAsDuration(time.Millisecond),
+			KeepAliveTimeout:         clientParams.KeepAliveTimeout.GetAsDuration(time.Millisecond),
+			RetryServiceNameConfig:   "milvus.proto.index.IndexNode",
+			MaxAttempts:              clientParams.MaxAttempts.GetAsInt(),
+			InitialBackoff:           float32(clientParams.InitialBackoff.GetAsFloat()),
+			MaxBackoff:               float32(clientParams.MaxBackoff.GetAsFloat()),
+			BackoffMultiplier:        float32(clientParams.BackoffMultiplier.GetAsFloat()),
+			CompressionEnabled:       clientParams.CompressionEnabled.GetAsBool(),
+			SelectiveCompression:     clientParams.SelectiveCompressionEnabled.GetAsBool(),
+			CompressionAlgorithm:     clientParams.SelectiveCompressionAlgorithm.GetValue(),
+			CompressionMethods:       clientParams.SelectiveCompressionMethods.GetAsStrings(),
+			CompressionSizeThreshold: clientParams.SelectiveCompressionSizeThreshold.GetAsInt(),
 		},
 	}
 	client.grpcClient.SetRole(typeutil.IndexNodeRole)
@@ -126,53 +128,3 @@ func (c *Client) GetStatisticsChannel(ctx context.Context) (*milvuspb.StringResp
 		return client.GetStatisticsChannel(ctx, &internalpb.GetStatisticsChannelRequest{})
 	})
 }
-
-// CreateJob sends the build index request to IndexNode.
-func (c *Client) CreateJob(ctx context.Context, req *indexpb.CreateJobRequest) (*commonpb.Status, error) {
-	return wrapGrpcCall(ctx, c, func(client indexpb.IndexNodeClient) (*commonpb.Status, error) {
-		return client.CreateJob(ctx, req)
-	})
-}
-
-// QueryJobs query the task info of the index task.
-func (c *Client) QueryJobs(ctx context.Context, req *indexpb.QueryJobsRequest) (*indexpb.QueryJobsResponse, error) {
-	return wrapGrpcCall(ctx, c, func(client indexpb.IndexNodeClient) (*indexpb.QueryJobsResponse, error) {
-		return client.QueryJobs(ctx, req)
-	})
-}
-
-// DropJobs query the task info of the index task.
-func (c *Client) DropJobs(ctx context.Context, req *indexpb.DropJobsRequest) (*commonpb.Status, error) {
-	return wrapGrpcCall(ctx, c, func(client indexpb.IndexNodeClient) (*commonpb.Status, error) {
-		return client.DropJobs(ctx, req)
-	})
-}
-
-// GetJobStats query the task info of the index task.
-func (c *Client) GetJobStats(ctx context.Context, req *indexpb.GetJobStatsRequest) (*indexpb.GetJobStatsResponse, error) {
-	return wrapGrpcCall(ctx, c, func(client indexpb.IndexNodeClient) (*indexpb.GetJobStatsResponse, error) {
-		return client.GetJobStats(ctx, req)
-	})
-}
-
-// ShowConfigurations gets specified configurations para of IndexNode
-func (c *Client) ShowConfigurations(ctx context.Context, req *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error) {
-	req = typeutil.Clone(req)
-	commonpbutil.UpdateMsgBase(
-		req.GetBase(),
-		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID()))
-	return wrapGrpcCall(ctx, c, func(client indexpb.IndexNodeClient) (*internalpb.ShowConfigurationsResponse, error) {
-		return client.ShowConfigurations(ctx, req)
-	})
-}
-
-// GetMetrics gets the metrics info of IndexNode.
-func (c *Client) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
-	req = typeutil.Clone(req)
-	commonpbutil.UpdateMsgBase(
-		req.GetBase(),
-		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID()))
-	return wrapGrpcCall(ctx, c, func(client indexpb.IndexNodeClient) (*milvuspb.GetMetricsResponse, error) {
-		return client.GetMetrics(ctx, req)
-	})
-}
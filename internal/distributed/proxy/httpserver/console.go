@@ -0,0 +1,247 @@
+package httpserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+)
+
+// ConsolePath is the path of the embedded web console.
+const ConsolePath = "/console"
+
+// consoleOverview is what the console shows, collected from the existing ShowCollections and GetMetrics APIs.
+type consoleOverview struct {
+	Collections []consoleCollection         `json:"collections"`
+	ChannelLags []consoleChannelLag         `json:"channel_lags"`
+	SlowQueries []metricsinfo.SlowQueryInfo `json:"slow_queries"`
+}
+
+type consoleCollection struct {
+	Database         string `json:"database"`
+	Name             string `json:"name"`
+	ID               int64  `json:"id"`
+	Loaded           bool   `json:"loaded"`
+	LoadedPercentage int64  `json:"loaded_percentage"`
+}
+
+// consoleChannelLag is the lag of the slowest flow graph on a node.
+type consoleChannelLag struct {
+	NodeID  int64  `json:"node_id"`
+	Role    string `json:"role"`
+	Channel string `json:"channel"`
+	LagMs   int64  `json:"lag_ms"`
+}
+
+// consoleNodeInfos picks the fields the console needs from the infos of any component.
+type consoleNodeInfos struct {
+	metricsinfo.BaseComponentInfos
+	QuotaMetrics *struct {
+		Fgm metricsinfo.FlowGraphMetric
+	} `json:"quota_metrics"`
+	SlowQueries []metricsinfo.SlowQueryInfo `json:"slow_queries"`
+}
+
+type consoleTopology struct {
+	NodesInfo []struct {
+		Infos json.RawMessage `json:"infos"`
+	} `json:"nodes_info"`
+}
+
+// RegisterConsoleTo registers the embedded web console to given router
+func (h *Handlers) RegisterConsoleTo(router gin.IRouter) {
+	router.GET(ConsolePath, func(c *gin.Context) {
+		c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(consolePage))
+	})
+	router.GET(ConsolePath+"/overview", wrapHandler(h.handleConsoleOverview))
+}
+
+func (h *Handlers) handleConsoleOverview(c *gin.Context) (interface{}, error) {
+	overview := &consoleOverview{
+		Collections: make([]consoleCollection, 0),
+		ChannelLags: make([]consoleChannelLag, 0),
+		SlowQueries: make([]metricsinfo.SlowQueryInfo, 0),
+	}
+
+	dbResp, err := h.proxy.ListDatabases(c, &milvuspb.ListDatabasesRequest{})
+	if err != nil {
+		return nil, err
+	}
+	if err := merr.Error(dbResp.GetStatus()); err != nil {
+		return nil, err
+	}
+	for _, db := range dbResp.GetDbNames() {
+		collections, err := h.consoleCollections(c, db)
+		if err != nil {
+			return nil, err
+		}
+		overview.Collections = append(overview.Collections, collections...)
+	}
+
+	req, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.SystemInfoMetrics)
+	if err != nil {
+		return nil, err
+	}
+	metricsResp, err := h.proxy.GetMetrics(c, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := merr.Error(metricsResp.GetStatus()); err != nil {
+		return nil, err
+	}
+	topology := &consoleTopology{}
+	if err := json.Unmarshal([]byte(metricsResp.GetResponse()), topology); err != nil {
+		return nil, fmt.Errorf("failed to parse system topology: %w", err)
+	}
+	now := time.Now()
+	for _, node := range topology.NodesInfo {
+		infos := &consoleNodeInfos{}
+		if err := json.Unmarshal(node.Infos, infos); err != nil || infos.HasError {
+			continue
+		}
+		overview.SlowQueries = append(overview.SlowQueries, infos.SlowQueries...)
+		if infos.QuotaMetrics == nil {
+			continue
+		}
+		fgm := infos.QuotaMetrics.Fgm
+		if fgm.NumFlowGraph == 0 || fgm.MinFlowGraphChannel == "" {
+			continue
+		}
+		tt, _ := tsoutil.ParseTS(fgm.MinFlowGraphTt)
+		overview.ChannelLags = append(overview.ChannelLags, consoleChannelLag{
+			NodeID:  infos.ID,
+			Role:    infos.Type,
+			Channel: fgm.MinFlowGraphChannel,
+			LagMs:   now.Sub(tt).Milliseconds(),
+		})
+	}
+	sort.Slice(overview.ChannelLags, func(i, j int) bool {
+		return overview.ChannelLags[i].LagMs > overview.ChannelLags[j].LagMs
+	})
+	sort.Slice(overview.SlowQueries, func(i, j int) bool {
+		return overview.SlowQueries[i].StartTime > overview.SlowQueries[j].StartTime
+	})
+	return overview, nil
+}
+
+// consoleCollections lists the collections of the database with their load state.
+func (h *Handlers) consoleCollections(c *gin.Context, db string) ([]consoleCollection, error) {
+	resp, err := h.proxy.ShowCollections(c, &milvuspb.ShowCollectionsRequest{DbName: db, Type: milvuspb.ShowType_All})
+	if err != nil {
+		return nil, err
+	}
+	if err := merr.Error(resp.GetStatus()); err != nil {
+		return nil, err
+	}
+	loadedResp, err := h.proxy.ShowCollections(c, &milvuspb.ShowCollectionsRequest{DbName: db, Type: milvuspb.ShowType_InMemory})
+	if err != nil {
+		return nil, err
+	}
+	if err := merr.Error(loadedResp.GetStatus()); err != nil {
+		return nil, err
+	}
+	loaded := make(map[int64]int64, len(loadedResp.GetCollectionIds()))
+	for i, id := range loadedResp.GetCollectionIds() {
+		if i < len(loadedResp.GetInMemoryPercentages()) {
+			loaded[id] = loadedResp.GetInMemoryPercentages()[i]
+		}
+	}
+
+	collections := make([]consoleCollection, 0, len(resp.GetCollectionIds()))
+	for i, id := range resp.GetCollectionIds() {
+		percentage, ok := loaded[id]
+		collections = append(collections, consoleCollection{
+			Database:         db,
+			Name:             resp.GetCollectionNames()[i],
+			ID:               id,
+			Loaded:           ok,
+			LoadedPercentage: percentage,
+		})
+	}
+	return collections, nil
+}
+
+// consolePage renders the overview, it has no external dependency so that it works in offline deployments.
+const consolePage = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Milvus Console</title>
+<style>
+body { font-family: sans-serif; margin: 24px; color: #222; }
+table { border-collapse: collapse; margin-bottom: 24px; min-width: 480px; }
+th, td { border: 1px solid #ccc; padding: 4px 12px; text-align: left; }
+th { background: #f0f0f0; }
+#error { color: #c00; }
+</style>
+</head>
+<body>
+<h1>Milvus Console</h1>
+<div id="error"></div>
+<h2>Collections</h2>
+<table id="collections"></table>
+<h2>Channel Lag</h2>
+<table id="channel_lags"></table>
+<h2>Recent Slow Queries</h2>
+<table id="slow_queries"></table>
+<script>
+function render(id, columns, rows) {
+  const table = document.getElementById(id);
+  table.innerHTML = "";
+  const head = table.insertRow();
+  columns.forEach(function (c) {
+    const th = document.createElement("th");
+    th.textContent = c[0];
+    head.appendChild(th);
+  });
+  (rows || []).forEach(function (row) {
+    const tr = table.insertRow();
+    columns.forEach(function (c) {
+      tr.insertCell().textContent = c[1](row);
+    });
+  });
+}
+function refresh() {
+  fetch("` + ConsolePath + `/overview", {headers: {"Accept": "application/json"}})
+    .then(function (resp) { return resp.json(); })
+    .then(function (data) {
+      if (data.error_code) {
+        throw new Error(data.reason);
+      }
+      document.getElementById("error").textContent = "";
+      render("collections", [
+        ["Database", function (r) { return r.database; }],
+        ["Name", function (r) { return r.name; }],
+        ["ID", function (r) { return r.id; }],
+        ["Load State", function (r) { return r.loaded ? (r.loaded_percentage >= 100 ? "Loaded" : "Loading " + r.loaded_percentage + "%") : "Released"; }]
+      ], data.collections);
+      render("channel_lags", [
+        ["Node", function (r) { return r.role + "-" + r.node_id; }],
+        ["Channel", function (r) { return r.channel; }],
+        ["Lag (ms)", function (r) { return r.lag_ms; }]
+      ], data.channel_lags);
+      render("slow_queries", [
+        ["Start Time", function (r) { return r.start_time; }],
+        ["Method", function (r) { return r.method; }],
+        ["Database", function (r) { return r.database; }],
+        ["Collection", function (r) { return r.collection; }],
+        ["Duration (ms)", function (r) { return r.duration_ms; }]
+      ], data.slow_queries);
+    })
+    .catch(function (err) {
+      document.getElementById("error").textContent = "failed to refresh: " + err.message;
+    });
+}
+refresh();
+setInterval(refresh, 5000);
+</script>
+</body>
+</html>
+`
@@ -0,0 +1,102 @@
+package httpserver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+)
+
+type consoleMockProxy struct {
+	mockProxyComponent
+	topology string
+}
+
+func (m *consoleMockProxy) ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	return &milvuspb.ListDatabasesResponse{Status: &commonpb.Status{}, DbNames: []string{"default"}}, nil
+}
+
+func (m *consoleMockProxy) ShowCollections(ctx context.Context, request *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error) {
+	if request.GetType() == milvuspb.ShowType_InMemory {
+		return &milvuspb.ShowCollectionsResponse{
+			Status:              &commonpb.Status{},
+			CollectionNames:     []string{"c2"},
+			CollectionIds:       []int64{2},
+			InMemoryPercentages: []int64{50},
+		}, nil
+	}
+	return &milvuspb.ShowCollectionsResponse{
+		Status:          &commonpb.Status{},
+		CollectionNames: []string{"c1", "c2"},
+		CollectionIds:   []int64{1, 2},
+	}, nil
+}
+
+func (m *consoleMockProxy) GetMetrics(ctx context.Context, request *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
+	return &milvuspb.GetMetricsResponse{Status: &commonpb.Status{}, Response: m.topology}, nil
+}
+
+func TestConsole(t *testing.T) {
+	tt := tsoutil.ComposeTSByTime(time.Now().Add(-time.Minute), 0)
+	topology := metricsinfo.SystemTopology{
+		NodesInfo: []metricsinfo.SystemTopologyNode{
+			{Infos: &metricsinfo.QueryNodeInfos{
+				BaseComponentInfos: metricsinfo.BaseComponentInfos{ID: 1, Type: "querynode"},
+				QuotaMetrics: &metricsinfo.QueryNodeQuotaMetrics{Fgm: metricsinfo.FlowGraphMetric{
+					MinFlowGraphChannel: "dml_0", MinFlowGraphTt: tt, NumFlowGraph: 1,
+				}},
+			}},
+			{Infos: &metricsinfo.ProxyInfos{
+				BaseComponentInfos: metricsinfo.BaseComponentInfos{ID: 2, Type: "proxy"},
+				SlowQueries: []metricsinfo.SlowQueryInfo{
+					{Method: "Search", Collection: "c1", StartTime: "2023-01-01T00:00:00Z", DurationMs: 6000},
+					{Method: "Query", Collection: "c2", StartTime: "2023-01-02T00:00:00Z", DurationMs: 7000},
+				},
+			}},
+			{Infos: &metricsinfo.QueryNodeInfos{
+				BaseComponentInfos: metricsinfo.BaseComponentInfos{ID: 3, HasError: true},
+			}},
+		},
+	}
+	raw, err := json.Marshal(topology)
+	require.NoError(t, err)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	NewHandlers(&consoleMockProxy{topology: string(raw)}).RegisterConsoleTo(router)
+
+	req := httptest.NewRequest(http.MethodGet, ConsolePath, nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "Milvus Console")
+
+	req = httptest.NewRequest(http.MethodGet, ConsolePath+"/overview", nil)
+	req.Header.Set("Accept", "application/json")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	overview := &consoleOverview{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), overview))
+
+	assert.Equal(t, []consoleCollection{
+		{Database: "default", Name: "c1", ID: 1},
+		{Database: "default", Name: "c2", ID: 2, Loaded: true, LoadedPercentage: 50},
+	}, overview.Collections)
+	require.Equal(t, 1, len(overview.ChannelLags))
+	assert.Equal(t, "dml_0", overview.ChannelLags[0].Channel)
+	assert.GreaterOrEqual(t, overview.ChannelLags[0].LagMs, time.Minute.Milliseconds())
+	require.Equal(t, 2, len(overview.SlowQueries))
+	assert.Equal(t, "Query", overview.SlowQueries[0].Method)
+}
@@ -6,6 +6,9 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/types"
 )
 
@@ -34,6 +37,8 @@ func (h *Handlers) RegisterRoutesTo(router gin.IRouter) {
 	router.DELETE("/collection/load", wrapHandler(h.handleReleaseCollection))
 	router.GET("/collection/statistics", wrapHandler(h.handleGetCollectionStatistics))
 	router.GET("/collections", wrapHandler(h.handleShowCollections))
+	router.POST("/collection/clone", wrapHandler(h.handleCloneCollection))
+	router.GET("/collection/clone/state", wrapHandler(h.handleGetCloneCollectionState))
 
 	router.POST("/partition", wrapHandler(h.handleCreatePartition))
 	router.DELETE("/partition", wrapHandler(h.handleDropPartition))
@@ -52,6 +57,9 @@ func (h *Handlers) RegisterRoutesTo(router gin.IRouter) {
 	router.GET("/index/state", wrapHandler(h.handleGetIndexState))
 	router.GET("/index/progress", wrapHandler(h.handleGetIndexBuildProgress))
 	router.DELETE("/index", wrapHandler(h.handleDropIndex))
+	router.POST("/index/evaluation", wrapHandler(h.handleEvaluateIndex))
+	router.GET("/index/evaluation", wrapHandler(h.handleGetIndexEvaluation))
+	router.PATCH("/index", wrapHandler(h.handleAlterIndex))
 
 	router.POST("/entities", wrapHandler(h.handleInsert))
 	router.DELETE("/entities", wrapHandler(h.handleDelete))
@@ -145,6 +153,24 @@ func (h *Handlers) handleDescribeCollection(c *gin.Context) (interface{}, error)
 	return h.proxy.DescribeCollection(c, &req)
 }
 
+func (h *Handlers) handleCloneCollection(c *gin.Context) (interface{}, error) {
+	req := rootcoordpb.CloneCollectionRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.CloneCollection(c, &req)
+}
+
+func (h *Handlers) handleGetCloneCollectionState(c *gin.Context) (interface{}, error) {
+	req := datapb.GetCloneSegmentsStateRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.GetCloneCollectionState(c, &req)
+}
+
 func (h *Handlers) handleLoadCollection(c *gin.Context) (interface{}, error) {
 	req := milvuspb.LoadCollectionRequest{}
 	err := shouldBind(c, &req)
@@ -316,6 +342,33 @@ func (h *Handlers) handleDropIndex(c *gin.Context) (interface{}, error) {
 	return h.proxy.DropIndex(c, &req)
 }
 
+func (h *Handlers) handleAlterIndex(c *gin.Context) (interface{}, error) {
+	req := proxypb.AlterIndexRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.AlterIndex(c, &req)
+}
+
+func (h *Handlers) handleEvaluateIndex(c *gin.Context) (interface{}, error) {
+	req := proxypb.EvaluateIndexRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.EvaluateIndex(c, &req)
+}
+
+func (h *Handlers) handleGetIndexEvaluation(c *gin.Context) (interface{}, error) {
+	req := proxypb.GetIndexEvaluationRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.GetIndexEvaluation(c, &req)
+}
+
 func (h *Handlers) handleInsert(c *gin.Context) (interface{}, error) {
 	wrappedReq := WrappedInsertRequest{}
 	err := shouldBind(c, &wrappedReq)
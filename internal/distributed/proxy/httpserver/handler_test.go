@@ -16,6 +16,8 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/stretchr/testify/assert"
 )
@@ -65,6 +67,14 @@ func (m *mockProxyComponent) CreateCollection(ctx context.Context, request *milv
 	return testStatus, nil
 }
 
+func (m *mockProxyComponent) CloneCollection(ctx context.Context, request *rootcoordpb.CloneCollectionRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
+func (m *mockProxyComponent) GetCloneCollectionState(ctx context.Context, request *datapb.GetCloneSegmentsStateRequest) (*datapb.GetCloneSegmentsStateResponse, error) {
+	return &datapb.GetCloneSegmentsStateResponse{Status: testStatus}, nil
+}
+
 func (m *mockProxyComponent) DropCollection(ctx context.Context, request *milvuspb.DropCollectionRequest) (*commonpb.Status, error) {
 	return testStatus, nil
 }
@@ -391,6 +401,14 @@ func TestHandlers(t *testing.T) {
 			http.MethodGet, "/collections", emptyBody,
 			http.StatusOK, &milvuspb.ShowCollectionsResponse{Status: testStatus},
 		},
+		{
+			http.MethodPost, "/collection/clone", emptyBody,
+			http.StatusOK, testStatus,
+		},
+		{
+			http.MethodGet, "/collection/clone/state", emptyBody,
+			http.StatusOK, &datapb.GetCloneSegmentsStateResponse{Status: testStatus},
+		},
 		{
 			http.MethodPost, "/partition", emptyBody,
 			http.StatusOK, testStatus,
@@ -64,6 +64,7 @@ import (
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
 )
 
@@ -119,7 +120,11 @@ func (s *Server) registerHTTPServer() {
 	}
 	ginHandler := gin.Default()
 	apiv1 := ginHandler.Group(apiPathPrefix)
-	httpserver.NewHandlers(s.proxy).RegisterRoutesTo(apiv1)
+	handlers := httpserver.NewHandlers(s.proxy)
+	handlers.RegisterRoutesTo(apiv1)
+	if proxy.Params.HTTPCfg.EnableConsole.GetAsBool() {
+		handlers.RegisterConsoleTo(ginHandler)
+	}
 	http.Handle("/", ginHandler)
 }
 
@@ -176,7 +181,9 @@ func (s *Server) startExternalGrpc(grpcPort int, errChan chan error) {
 			proxy.UnaryServerHookInterceptor(),
 			proxy.UnaryServerInterceptor(proxy.PrivilegeInterceptor),
 			logutil.UnaryTraceLoggerInterceptor,
+			proxy.FederationInterceptor(s.proxy.GetFederation()),
 			proxy.RateLimitInterceptor(limiter),
+			proxy.ConcurrencyLimitInterceptor(),
 			accesslog.UnaryAccessLoggerInterceptor,
 			proxy.KeepActiveInterceptor,
 		)),
@@ -222,6 +229,9 @@ func (s *Server) startExternalGrpc(grpcPort int, errChan chan error) {
 	s.grpcExternalServer = grpc.NewServer(grpcOpts...)
 	milvuspb.RegisterMilvusServiceServer(s.grpcExternalServer, s)
 	grpc_health_v1.RegisterHealthServer(s.grpcExternalServer, s)
+	if paramtable.Get().ProxyCfg.EnableGrpcReflection.GetAsBool() {
+		reflection.Register(s.grpcExternalServer)
+	}
 	errChan <- nil
 
 	log.Debug("create Proxy grpc server",
@@ -436,12 +446,17 @@ func (s *Server) init() error {
 	}
 	log.Debug("init QueryCoord client for Proxy done")
 
-	log.Debug("Proxy wait for QueryCoord to be healthy")
-	if err := componentutil.WaitForComponentHealthy(s.ctx, s.queryCoordClient, "QueryCoord", 1000000, time.Millisecond*200); err != nil {
-		log.Warn("Proxy failed to wait for QueryCoord to be healthy", zap.Error(err))
+	readyPhase, err := componentutil.ParseReadinessPhase(paramtable.Get().ProxyCfg.QueryCoordReadyPhase.GetValue())
+	if err != nil {
+		log.Warn("invalid readiness phase of QueryCoord to wait for", zap.Error(err))
+		return err
+	}
+	log.Debug("Proxy wait for QueryCoord to be ready", zap.Stringer("phase", readyPhase))
+	if err := componentutil.WaitForComponentPhase(s.ctx, s.queryCoordClient, "QueryCoord", readyPhase, 1000000, time.Millisecond*200); err != nil {
+		log.Warn("Proxy failed to wait for QueryCoord to be ready", zap.Error(err))
 		return err
 	}
-	log.Debug("Proxy wait for QueryCoord to be healthy done")
+	log.Debug("Proxy wait for QueryCoord to be ready done")
 
 	log.Debug("set QueryCoord client for Proxy")
 	s.proxy.SetQueryCoordClient(s.queryCoordClient)
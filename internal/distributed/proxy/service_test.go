@@ -306,6 +306,18 @@ func (m *MockRootCoord) RenameCollection(ctx context.Context, req *milvuspb.Rena
 	return nil, nil
 }
 
+func (m *MockRootCoord) CloneCollection(ctx context.Context, req *rootcoordpb.CloneCollectionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) GetCapacityReport(ctx context.Context, req *rootcoordpb.GetCapacityReportRequest) (*rootcoordpb.GetCapacityReportResponse, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) GetCollectionStatsHistory(ctx context.Context, req *rootcoordpb.GetCollectionStatsHistoryRequest) (*rootcoordpb.GetCollectionStatsHistoryResponse, error) {
+	return nil, nil
+}
+
 // /////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 type MockDataCoord struct {
 	MockBase
@@ -416,6 +428,18 @@ func (m *MockDataCoord) ManualCompaction(ctx context.Context, req *milvuspb.Manu
 	return nil, nil
 }
 
+func (m *MockDataCoord) ManualCompactionWithMode(ctx context.Context, req *datapb.ManualCompactionWithModeRequest) (*datapb.ManualCompactionWithModeResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) GetCompactionProgress(ctx context.Context, req *datapb.GetCompactionProgressRequest) (*datapb.GetCompactionProgressResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) CancelCompaction(ctx context.Context, req *datapb.CancelCompactionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 func (m *MockDataCoord) GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error) {
 	return nil, nil
 }
@@ -468,6 +492,110 @@ func (m *MockDataCoord) GcConfirm(ctx context.Context, req *datapb.GcConfirmRequ
 	return nil, nil
 }
 
+func (m *MockDataCoord) GcDryRun(ctx context.Context, req *datapb.GcDryRunRequest) (*datapb.GcDryRunResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) PauseGC(ctx context.Context, req *datapb.PauseGCRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) ResumeGC(ctx context.Context, req *datapb.ResumeGCRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) GetGCStatus(ctx context.Context, req *datapb.GetGCStatusRequest) (*datapb.GetGCStatusResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) DropSegmentsByTimeRange(ctx context.Context, req *datapb.DropSegmentsByTimeRangeRequest) (*datapb.DropSegmentsByTimeRangeResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) GetTopologySnapshot(ctx context.Context, req *datapb.GetTopologySnapshotRequest) (*datapb.GetTopologySnapshotResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) RetryQuarantinedChannels(ctx context.Context, req *datapb.RetryQuarantinedChannelsRequest) (*datapb.RetryQuarantinedChannelsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) MigrateChannelWatchInfos(ctx context.Context, req *datapb.MigrateChannelWatchInfosRequest) (*datapb.MigrateChannelWatchInfosResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) ReCollectSegmentStats(ctx context.Context, req *datapb.ReCollectSegmentStatsRequest) (*datapb.ReCollectSegmentStatsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) FlushAndSeal(ctx context.Context, req *datapb.FlushAndSealRequest) (*datapb.FlushAndSealResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) GetStorageConsistencyReport(ctx context.Context, req *datapb.GetStorageConsistencyReportRequest) (*datapb.GetStorageConsistencyReportResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) GetShardWriteStats(ctx context.Context, req *datapb.GetShardWriteStatsRequest) (*datapb.GetShardWriteStatsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) GetAuditEvents(ctx context.Context, req *datapb.GetAuditEventsRequest) (*datapb.GetAuditEventsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) GetChannelWatchHistory(ctx context.Context, req *datapb.GetChannelWatchHistoryRequest) (*datapb.GetChannelWatchHistoryResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) GetChannelWatchStates(ctx context.Context, req *datapb.GetChannelWatchStatesRequest) (*datapb.GetChannelWatchStatesResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) SetNodeConfigs(ctx context.Context, req *datapb.SetNodeConfigsRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) ListNodeConfigs(ctx context.Context, req *datapb.ListNodeConfigsRequest) (*datapb.ListNodeConfigsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) ClearNodeConfigs(ctx context.Context, req *datapb.ClearNodeConfigsRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) SetMaintenancePolicy(ctx context.Context, req *datapb.SetMaintenancePolicyRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) ListMaintenancePolicies(ctx context.Context, req *datapb.ListMaintenancePoliciesRequest) (*datapb.ListMaintenancePoliciesResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) CordonDataNode(ctx context.Context, req *datapb.CordonDataNodeRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) DrainDataNode(ctx context.Context, req *datapb.DrainDataNodeRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) UncordonDataNode(ctx context.Context, req *datapb.UncordonDataNodeRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) GetDataNodeDrainState(ctx context.Context, req *datapb.GetDataNodeDrainStateRequest) (*datapb.GetDataNodeDrainStateResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) RotateEncryptionKey(ctx context.Context, req *datapb.RotateEncryptionKeyRequest) (*datapb.RotateEncryptionKeyResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) GetEncryptionStatus(ctx context.Context, req *datapb.GetEncryptionStatusRequest) (*datapb.GetEncryptionStatusResponse, error) {
+	return nil, nil
+}
+
 func (m *MockDataCoord) CreateIndex(ctx context.Context, req *indexpb.CreateIndexRequest) (*commonpb.Status, error) {
 	return nil, nil
 }
@@ -476,6 +604,18 @@ func (m *MockDataCoord) DropIndex(ctx context.Context, req *indexpb.DropIndexReq
 	return nil, nil
 }
 
+func (m *MockDataCoord) AlterIndex(ctx context.Context, req *indexpb.AlterIndexRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) CloneSegments(ctx context.Context, req *datapb.CloneSegmentsRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) GetCloneSegmentsState(ctx context.Context, req *datapb.GetCloneSegmentsStateRequest) (*datapb.GetCloneSegmentsStateResponse, error) {
+	return nil, nil
+}
+
 func (m *MockDataCoord) GetIndexState(ctx context.Context, req *indexpb.GetIndexStateRequest) (*indexpb.GetIndexStateResponse, error) {
 	return nil, nil
 }
@@ -747,6 +887,10 @@ func (m *MockProxy) GetRateLimiter() (types.Limiter, error) {
 	return nil, nil
 }
 
+func (m *MockProxy) GetFederation() types.Federation {
+	return nil
+}
+
 func (m *MockProxy) UpdateStateCode(stateCode commonpb.StateCode) {
 
 }
@@ -885,6 +1029,26 @@ func (m *MockProxy) RenameCollection(ctx context.Context, req *milvuspb.RenameCo
 	return nil, nil
 }
 
+func (m *MockProxy) CloneCollection(ctx context.Context, req *rootcoordpb.CloneCollectionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) GetCloneCollectionState(ctx context.Context, req *datapb.GetCloneSegmentsStateRequest) (*datapb.GetCloneSegmentsStateResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) EvaluateIndex(ctx context.Context, req *proxypb.EvaluateIndexRequest) (*proxypb.EvaluateIndexResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) GetIndexEvaluation(ctx context.Context, req *proxypb.GetIndexEvaluationRequest) (*proxypb.GetIndexEvaluationResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) AlterIndex(ctx context.Context, req *proxypb.AlterIndexRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) Connect(ctx context.Context, req *milvuspb.ConnectRequest) (*milvuspb.ConnectResponse, error) {
 	return nil, nil
 }
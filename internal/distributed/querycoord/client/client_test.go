@@ -170,6 +170,33 @@ func Test_NewClient(t *testing.T) {
 
 		r27, err := client.DescribeResourceGroup(ctx, nil)
 		retCheck(retNotNil, r27, err)
+
+		r28, err := client.ExtendLoadTimeout(ctx, nil)
+		retCheck(retNotNil, r28, err)
+
+		r29, err := client.ListCheckers(ctx, nil)
+		retCheck(retNotNil, r29, err)
+
+		r30, err := client.ActivateChecker(ctx, nil)
+		retCheck(retNotNil, r30, err)
+
+		r31, err := client.DeactivateChecker(ctx, nil)
+		retCheck(retNotNil, r31, err)
+
+		r32, err := client.DryRunCheckers(ctx, nil)
+		retCheck(retNotNil, r32, err)
+
+		r33, err := client.NotifyCompaction(ctx, nil)
+		retCheck(retNotNil, r33, err)
+
+		r34, err := client.TriggerBalance(ctx, nil)
+		retCheck(retNotNil, r34, err)
+
+		r35, err := client.SuspendCollectionBalance(ctx, nil)
+		retCheck(retNotNil, r35, err)
+
+		r36, err := client.ListDivergedLeaders(ctx, nil)
+		retCheck(retNotNil, r36, err)
 	}
 
 	client.grpcClient = &mock.GRPCClientBase[querypb.QueryCoordClient]{
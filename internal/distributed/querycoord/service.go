@@ -396,3 +396,53 @@ func (s *Server) ListResourceGroups(ctx context.Context, req *milvuspb.ListResou
 func (s *Server) DescribeResourceGroup(ctx context.Context, req *querypb.DescribeResourceGroupRequest) (*querypb.DescribeResourceGroupResponse, error) {
 	return s.queryCoord.DescribeResourceGroup(ctx, req)
 }
+
+// ExtendLoadTimeout extends the load timeout of a loading collection.
+func (s *Server) ExtendLoadTimeout(ctx context.Context, req *querypb.ExtendLoadTimeoutRequest) (*commonpb.Status, error) {
+	return s.queryCoord.ExtendLoadTimeout(ctx, req)
+}
+
+// ListCheckers lists the checkers of QueryCoord.
+func (s *Server) ListCheckers(ctx context.Context, req *querypb.ListCheckersRequest) (*querypb.ListCheckersResponse, error) {
+	return s.queryCoord.ListCheckers(ctx, req)
+}
+
+// ActivateChecker activates the checker of QueryCoord.
+func (s *Server) ActivateChecker(ctx context.Context, req *querypb.ActivateCheckerRequest) (*commonpb.Status, error) {
+	return s.queryCoord.ActivateChecker(ctx, req)
+}
+
+// DeactivateChecker deactivates the checker of QueryCoord.
+func (s *Server) DeactivateChecker(ctx context.Context, req *querypb.DeactivateCheckerRequest) (*commonpb.Status, error) {
+	return s.queryCoord.DeactivateChecker(ctx, req)
+}
+
+// DryRunCheckers runs the checkers of QueryCoord without submitting the tasks.
+func (s *Server) DryRunCheckers(ctx context.Context, req *querypb.DryRunCheckersRequest) (*querypb.DryRunCheckersResponse, error) {
+	return s.queryCoord.DryRunCheckers(ctx, req)
+}
+
+// TriggerBalance runs the balance of QueryCoord at once for the given scope.
+func (s *Server) TriggerBalance(ctx context.Context, req *querypb.TriggerBalanceRequest) (*querypb.TriggerBalanceResponse, error) {
+	return s.queryCoord.TriggerBalance(ctx, req)
+}
+
+// SuspendCollectionBalance suspends or resumes the balance of the collection.
+func (s *Server) SuspendCollectionBalance(ctx context.Context, req *querypb.SuspendCollectionBalanceRequest) (*commonpb.Status, error) {
+	return s.queryCoord.SuspendCollectionBalance(ctx, req)
+}
+
+// WatchLoadProgress streams the load progress events of QueryCoord.
+func (s *Server) WatchLoadProgress(req *querypb.WatchLoadProgressRequest, stream querypb.QueryCoord_WatchLoadProgressServer) error {
+	return s.queryCoord.WatchLoadProgress(req, stream)
+}
+
+// ListDivergedLeaders lists the shard leaders out of sync longer than the threshold.
+func (s *Server) ListDivergedLeaders(ctx context.Context, req *querypb.ListDivergedLeadersRequest) (*querypb.ListDivergedLeadersResponse, error) {
+	return s.queryCoord.ListDivergedLeaders(ctx, req)
+}
+
+// NotifyCompaction notifies QueryCoord the compacted segment to load.
+func (s *Server) NotifyCompaction(ctx context.Context, req *querypb.NotifyCompactionRequest) (*commonpb.Status, error) {
+	return s.queryCoord.NotifyCompaction(ctx, req)
+}
@@ -313,6 +313,75 @@ func Test_NewServer(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 	})
 
+	t.Run("ExtendLoadTimeout", func(t *testing.T) {
+		mqc.EXPECT().ExtendLoadTimeout(mock.Anything, mock.Anything).Return(successStatus, nil)
+		resp, err := server.ExtendLoadTimeout(ctx, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	t.Run("ListCheckers", func(t *testing.T) {
+		mqc.EXPECT().ListCheckers(mock.Anything, mock.Anything).Return(&querypb.ListCheckersResponse{Status: successStatus}, nil)
+		resp, err := server.ListCheckers(ctx, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("ActivateChecker", func(t *testing.T) {
+		mqc.EXPECT().ActivateChecker(mock.Anything, mock.Anything).Return(successStatus, nil)
+		resp, err := server.ActivateChecker(ctx, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	t.Run("DeactivateChecker", func(t *testing.T) {
+		mqc.EXPECT().DeactivateChecker(mock.Anything, mock.Anything).Return(successStatus, nil)
+		resp, err := server.DeactivateChecker(ctx, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	t.Run("DryRunCheckers", func(t *testing.T) {
+		mqc.EXPECT().DryRunCheckers(mock.Anything, mock.Anything).Return(&querypb.DryRunCheckersResponse{Status: successStatus}, nil)
+		resp, err := server.DryRunCheckers(ctx, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("TriggerBalance", func(t *testing.T) {
+		mqc.EXPECT().TriggerBalance(mock.Anything, mock.Anything).Return(&querypb.TriggerBalanceResponse{Status: successStatus}, nil)
+		resp, err := server.TriggerBalance(ctx, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("SuspendCollectionBalance", func(t *testing.T) {
+		mqc.EXPECT().SuspendCollectionBalance(mock.Anything, mock.Anything).Return(successStatus, nil)
+		resp, err := server.SuspendCollectionBalance(ctx, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("WatchLoadProgress", func(t *testing.T) {
+		mqc.EXPECT().WatchLoadProgress(mock.Anything, mock.Anything).Return(nil)
+		err := server.WatchLoadProgress(&querypb.WatchLoadProgressRequest{}, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("ListDivergedLeaders", func(t *testing.T) {
+		mqc.EXPECT().ListDivergedLeaders(mock.Anything, mock.Anything).Return(&querypb.ListDivergedLeadersResponse{Status: successStatus}, nil)
+		resp, err := server.ListDivergedLeaders(ctx, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("NotifyCompaction", func(t *testing.T) {
+		mqc.EXPECT().NotifyCompaction(mock.Anything, mock.Anything).Return(successStatus, nil)
+		resp, err := server.NotifyCompaction(ctx, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
 	err = server.Stop()
 	assert.NoError(t, err)
 }
@@ -113,6 +113,12 @@ func Test_NewClient(t *testing.T) {
 
 		r20, err := client.SearchSegments(ctx, nil)
 		retCheck(retNotNil, r20, err)
+
+		r21, err := client.DumpDispatcherPositions(ctx, nil)
+		retCheck(retNotNil, r21, err)
+
+		r22, err := client.SeekDispatcher(ctx, nil)
+		retCheck(retNotNil, r22, err)
 	}
 
 	client.grpcClient = &mock.GRPCClientBase[querypb.QueryNodeClient]{
@@ -36,6 +36,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	rcc "github.com/milvus-io/milvus/internal/distributed/rootcoord/client"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	qn "github.com/milvus-io/milvus/internal/querynodev2"
@@ -63,6 +64,9 @@ type Server struct {
 	grpcServer *grpc.Server
 
 	etcdCli *clientv3.Client
+
+	rootCoord          types.RootCoord
+	newRootCoordClient func(string, *clientv3.Client) (types.RootCoord, error)
 }
 
 func (s *Server) GetStatistics(ctx context.Context, request *querypb.GetStatisticsRequest) (*internalpb.GetStatisticsResponse, error) {
@@ -78,6 +82,9 @@ func NewServer(ctx context.Context, factory dependency.Factory) (*Server, error)
 		cancel:      cancel,
 		querynode:   qn.NewQueryNode(ctx, factory),
 		grpcErrChan: make(chan error),
+		newRootCoordClient: func(etcdMetaRoot string, client *clientv3.Client) (types.RootCoord, error) {
+			return rcc.NewClient(ctx1, etcdMetaRoot, client)
+		},
 	}
 	return s, nil
 }
@@ -110,6 +117,27 @@ func (s *Server) init() error {
 	s.SetEtcdClient(etcdCli)
 	s.querynode.SetAddress(Params.GetAddress())
 	log.Debug("QueryNode connect to etcd successfully")
+
+	// the RootCoord client is used by the admin APIs only, QueryNode doesn't wait for RootCoord to be healthy
+	if s.newRootCoordClient != nil {
+		rootCoordClient, err := s.newRootCoordClient(etcdConfig.MetaRootPath.GetValue(), etcdCli)
+		if err != nil {
+			log.Error("failed to create new RootCoord client", zap.Error(err))
+			return err
+		}
+		if err = rootCoordClient.Init(); err != nil {
+			log.Error("failed to init RootCoord client", zap.Error(err))
+			return err
+		}
+		if err = rootCoordClient.Start(); err != nil {
+			log.Error("failed to start RootCoord client", zap.Error(err))
+			return err
+		}
+		if err = s.querynode.SetRootCoord(rootCoordClient); err != nil {
+			return err
+		}
+		s.rootCoord = rootCoordClient
+	}
 	s.wg.Add(1)
 	go s.startGrpcLoop(Params.Port.GetAsInt())
 	// wait for grpc server loop start
@@ -225,6 +253,11 @@ func (s *Server) Stop() error {
 	if err != nil {
 		return err
 	}
+	if s.rootCoord != nil {
+		if err := s.rootCoord.Stop(); err != nil {
+			log.Warn("failed to stop RootCoord client", zap.Error(err))
+		}
+	}
 	if s.etcdCli != nil {
 		defer s.etcdCli.Close()
 	}
@@ -349,3 +382,13 @@ func (s *Server) SyncDistribution(ctx context.Context, req *querypb.SyncDistribu
 func (s *Server) Delete(ctx context.Context, req *querypb.DeleteRequest) (*commonpb.Status, error) {
 	return s.querynode.Delete(ctx, req)
 }
+
+// DumpDispatcherPositions returns the latest positions dispatched to the vchannels.
+func (s *Server) DumpDispatcherPositions(ctx context.Context, req *internalpb.DumpDispatcherPositionsRequest) (*internalpb.DumpDispatcherPositionsResponse, error) {
+	return s.querynode.DumpDispatcherPositions(ctx, req)
+}
+
+// SeekDispatcher makes the vchannel consume from the given position.
+func (s *Server) SeekDispatcher(ctx context.Context, req *internalpb.SeekDispatcherRequest) (*commonpb.Status, error) {
+	return s.querynode.SeekDispatcher(ctx, req)
+}
@@ -90,10 +90,14 @@ func Test_NewServer(t *testing.T) {
 	mockQN.EXPECT().Stop().Return(nil).Maybe()
 	mockQN.EXPECT().Register().Return(nil).Maybe()
 	mockQN.EXPECT().SetEtcdClient(mock.Anything).Maybe()
+	mockQN.EXPECT().SetRootCoord(mock.Anything).Return(nil).Maybe()
 	mockQN.EXPECT().SetAddress(mock.Anything).Maybe()
 	mockQN.EXPECT().UpdateStateCode(mock.Anything).Maybe()
 	mockQN.EXPECT().Init().Return(nil).Maybe()
 	server.querynode = mockQN
+	server.newRootCoordClient = func(string, *clientv3.Client) (types.RootCoord, error) {
+		return &MockRootCoord{}, nil
+	}
 
 	t.Run("Run", func(t *testing.T) {
 		err = server.Run()
@@ -251,6 +255,24 @@ func Test_NewServer(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
 	})
 
+	t.Run("DumpDispatcherPositions", func(t *testing.T) {
+		mockQN.EXPECT().DumpDispatcherPositions(mock.Anything, mock.Anything).Return(&internalpb.DumpDispatcherPositionsResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		}, nil)
+		req := &internalpb.DumpDispatcherPositionsRequest{}
+		resp, err := server.DumpDispatcherPositions(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("SeekDispatcher", func(t *testing.T) {
+		mockQN.EXPECT().SeekDispatcher(mock.Anything, mock.Anything).Return(&commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil)
+		req := &internalpb.SeekDispatcherRequest{}
+		resp, err := server.SeekDispatcher(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
 	err = server.Stop()
 	assert.NoError(t, err)
 }
@@ -266,10 +288,14 @@ func Test_Run(t *testing.T) {
 	mockQN.EXPECT().Stop().Return(errors.New("Failed")).Maybe()
 	mockQN.EXPECT().Register().Return(errors.New("Failed")).Maybe()
 	mockQN.EXPECT().SetEtcdClient(mock.Anything).Maybe()
+	mockQN.EXPECT().SetRootCoord(mock.Anything).Return(nil).Maybe()
 	mockQN.EXPECT().SetAddress(mock.Anything).Maybe()
 	mockQN.EXPECT().UpdateStateCode(mock.Anything).Maybe()
 	mockQN.EXPECT().Init().Return(nil).Maybe()
 	server.querynode = mockQN
+	server.newRootCoordClient = func(string, *clientv3.Client) (types.RootCoord, error) {
+		return &MockRootCoord{}, nil
+	}
 	err = server.Run()
 	assert.Error(t, err)
 
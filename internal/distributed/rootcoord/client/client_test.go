@@ -260,6 +260,18 @@ func Test_NewClient(t *testing.T) {
 			r, err := client.ListDatabases(ctx, nil)
 			retCheck(retNotNil, r, err)
 		}
+		{
+			r, err := client.CloneCollection(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.GetCapacityReport(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.GetCollectionStatsHistory(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
 	}
 
 	client.grpcClient = &mock.GRPCClientBase[rootcoordpb.RootCoordClient]{
@@ -474,6 +486,18 @@ func Test_NewClient(t *testing.T) {
 		rTimeout, err := client.ListDatabases(shortCtx, nil)
 		retCheck(rTimeout, err)
 	}
+	{
+		rTimeout, err := client.CloneCollection(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.GetCapacityReport(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.GetCollectionStatsHistory(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
 	// clean up
 	err = client.Stop()
 	assert.NoError(t, err)
@@ -499,3 +499,15 @@ func (s *Server) AlterCollection(ctx context.Context, request *milvuspb.AlterCol
 func (s *Server) RenameCollection(ctx context.Context, request *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
 	return s.rootCoord.RenameCollection(ctx, request)
 }
+
+func (s *Server) CloneCollection(ctx context.Context, request *rootcoordpb.CloneCollectionRequest) (*commonpb.Status, error) {
+	return s.rootCoord.CloneCollection(ctx, request)
+}
+
+func (s *Server) GetCapacityReport(ctx context.Context, request *rootcoordpb.GetCapacityReportRequest) (*rootcoordpb.GetCapacityReportResponse, error) {
+	return s.rootCoord.GetCapacityReport(ctx, request)
+}
+
+func (s *Server) GetCollectionStatsHistory(ctx context.Context, request *rootcoordpb.GetCollectionStatsHistoryRequest) (*rootcoordpb.GetCollectionStatsHistoryResponse, error) {
+	return s.rootCoord.GetCollectionStatsHistory(ctx, request)
+}
@@ -33,6 +33,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/rootcoord"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
@@ -61,6 +62,18 @@ func (m *mockCore) RenameCollection(ctx context.Context, request *milvuspb.Renam
 	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
 }
 
+func (m *mockCore) CloneCollection(ctx context.Context, request *rootcoordpb.CloneCollectionRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (m *mockCore) GetCapacityReport(ctx context.Context, request *rootcoordpb.GetCapacityReportRequest) (*rootcoordpb.GetCapacityReportResponse, error) {
+	return &rootcoordpb.GetCapacityReportResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}, nil
+}
+
+func (m *mockCore) GetCollectionStatsHistory(ctx context.Context, request *rootcoordpb.GetCollectionStatsHistoryRequest) (*rootcoordpb.GetCollectionStatsHistoryResponse, error) {
+	return &rootcoordpb.GetCollectionStatsHistoryResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}, nil
+}
+
 func (m *mockCore) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	return &milvuspb.CheckHealthResponse{
 		IsHealthy: true,
@@ -209,6 +222,24 @@ func TestRun(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("CloneCollection", func(t *testing.T) {
+		ret, err := svr.CloneCollection(ctx, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, ret.GetErrorCode())
+	})
+
+	t.Run("GetCapacityReport", func(t *testing.T) {
+		ret, err := svr.GetCapacityReport(ctx, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, ret.GetStatus().GetErrorCode())
+	})
+
+	t.Run("GetCollectionStatsHistory", func(t *testing.T) {
+		ret, err := svr.GetCollectionStatsHistory(ctx, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, ret.GetStatus().GetErrorCode())
+	})
+
 	t.Run("CreateDatabase", func(t *testing.T) {
 		ret, err := svr.CreateDatabase(ctx, nil)
 		assert.Nil(t, err)
@@ -24,3 +24,6 @@ const LogLevelRouterPath = "/log/level"
 
 // EventLogRouterPath is path for eventlog control.
 const EventLogRouterPath = "/eventlog"
+
+// FeatureFlagRouterPath is path for introspecting the feature flags.
+const FeatureFlagRouterPath = "/featureflags"
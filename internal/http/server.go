@@ -28,6 +28,7 @@ import (
 	"github.com/milvus-io/milvus/internal/http/healthz"
 	"github.com/milvus-io/milvus/pkg/eventlog"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/featureflag"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
@@ -58,6 +59,11 @@ func registerDefaults() {
 		Path:    EventLogRouterPath,
 		Handler: eventlog.Handler(),
 	})
+
+	Register(&Handler{
+		Path:    FeatureFlagRouterPath,
+		Handler: featureflag.Handler(),
+	})
 }
 
 func Register(h *Handler) {
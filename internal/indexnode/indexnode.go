@@ -143,7 +143,7 @@ func (i *IndexNode) Register() error {
 	return nil
 }
 
-func (i *IndexNode) initSegcore() {
+func (i *IndexNode) initSegcore() error {
 	cGlogConf := C.CString(path.Join(Params.BaseTable.GetConfigDir(), paramtable.DefaultGlogConf))
 	C.IndexBuilderInit(cGlogConf)
 	C.free(unsafe.Pointer(cGlogConf))
@@ -168,6 +168,7 @@ func (i *IndexNode) initSegcore() {
 
 	localDataRootPath := filepath.Join(Params.LocalStorageCfg.Path.GetValue(), typeutil.IndexNodeRole)
 	initcore.InitLocalChunkManager(localDataRootPath)
+	return initcore.InitEncryption(Params)
 }
 
 func (i *IndexNode) CloseSegcore() {
@@ -205,7 +206,11 @@ func (i *IndexNode) Init() error {
 
 		log.Info("IndexNode NewMinIOKV succeeded")
 
-		i.initSegcore()
+		if err := i.initSegcore(); err != nil {
+			log.Error("IndexNode init segcore failed", zap.Error(err))
+			initErr = err
+			return
+		}
 	})
 
 	log.Info("Init IndexNode finished", zap.Error(initErr))
@@ -165,6 +165,9 @@ func (i *IndexNode) QueryJobs(ctx context.Context, req *indexpb.QueryJobsRequest
 			ret.IndexInfos[i].IndexFileKeys = info.fileKeys
 			ret.IndexInfos[i].SerializedSize = info.serializedSize
 			ret.IndexInfos[i].FailReason = info.failReason
+			if info.state == commonpb.IndexState_Finished {
+				ret.IndexInfos[i].IndexEngineVersion = common.CurrentIndexEngineVersion
+			}
 			log.RatedDebug(5, "querying index build task",
 				zap.Int64("IndexBuildID", buildID), zap.String("state", info.state.String()),
 				zap.String("fail reason", info.failReason))
@@ -225,18 +228,24 @@ func (i *IndexNode) GetJobStats(ctx context.Context, req *indexpb.GetJobStatsReq
 	if i.sched.buildParallel > unissued+active {
 		slots = i.sched.buildParallel - unissued - active
 	}
-	log.Ctx(ctx).Info("Get Index Job Stats", zap.Int("Unissued", unissued), zap.Int("Active", active), zap.Int("Slot", slots))
+	var availableDiskSize int64
+	if Params.IndexNodeCfg.EnableDisk.GetAsBool() {
+		availableDiskSize = i.sched.diskQuota.available()
+	}
+	log.Ctx(ctx).Info("Get Index Job Stats", zap.Int("Unissued", unissued), zap.Int("Active", active), zap.Int("Slot", slots),
+		zap.Int64("AvailableDiskSize", availableDiskSize))
 	return &indexpb.GetJobStatsResponse{
 		Status: &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_Success,
 			Reason:    "",
 		},
-		TotalJobNum:      int64(active) + int64(unissued),
-		InProgressJobNum: int64(active),
-		EnqueueJobNum:    int64(unissued),
-		TaskSlots:        int64(slots),
-		JobInfos:         jobInfos,
-		EnableDisk:       Params.IndexNodeCfg.EnableDisk.GetAsBool(),
+		TotalJobNum:       int64(active) + int64(unissued),
+		InProgressJobNum:  int64(active),
+		EnqueueJobNum:     int64(unissued),
+		TaskSlots:         int64(slots),
+		JobInfos:          jobInfos,
+		EnableDisk:        Params.IndexNodeCfg.EnableDisk.GetAsBool(),
+		AvailableDiskSize: availableDiskSize,
 	}, nil
 }
 
@@ -45,8 +45,7 @@ import (
 )
 
 var (
-	errCancel      = fmt.Errorf("canceled")
-	diskUsageRatio = 4.0
+	errCancel = fmt.Errorf("canceled")
 )
 
 type Blob = storage.Blob
@@ -102,9 +101,15 @@ type indexBuildTask struct {
 	queueDur       time.Duration
 	statistic      indexpb.JobInfo
 	node           *IndexNode
+	// the local disk space reserved to build the disk index
+	diskBuildSize int64
 }
 
 func (it *indexBuildTask) Reset() {
+	if it.diskBuildSize > 0 && it.node != nil {
+		it.node.sched.diskQuota.release(it.diskBuildSize)
+		it.diskBuildSize = 0
+	}
 	it.ident = ""
 	it.cancel = nil
 	it.ctx = nil
@@ -144,6 +149,17 @@ func (it *indexBuildTask) OnEnqueue(ctx context.Context) error {
 	it.tr.RecordSpan()
 	it.statistic.StartTime = time.Now().UnixMicro()
 	it.statistic.PodID = it.node.GetNodeID()
+	if diskBuildSize := indexparams.EstimateDiskBuildSize(it.req.GetIndexParams(), it.req.GetTypeParams(), it.req.GetNumRows()); diskBuildSize > 0 {
+		if !Params.IndexNodeCfg.EnableDisk.GetAsBool() {
+			log.Ctx(ctx).Warn("IndexNode don't support build disk index", zap.Int64("buildID", it.BuildID))
+			return errors.New("index node don't support build disk index")
+		}
+		if err := it.node.sched.diskQuota.reserve(diskBuildSize); err != nil {
+			log.Ctx(ctx).Warn("IndexNode failed to reserve disk space to build disk index", zap.Int64("buildID", it.BuildID), zap.Error(err))
+			return err
+		}
+		it.diskBuildSize = diskBuildSize
+	}
 	log.Ctx(ctx).Info("IndexNode IndexBuilderTask Enqueue", zap.Int64("buildID", it.BuildID), zap.Int64("segmentID", it.segmentID))
 	return nil
 }
@@ -260,26 +276,12 @@ func (it *indexBuildTask) BuildIndex(ctx context.Context) error {
 			return errors.New("index node don't support build disk index")
 		}
 
-		// check load size and size of field data
-		localUsedSize, err := indexcgowrapper.GetLocalUsedSize(paramtable.Get().LocalStorageCfg.Path.GetValue())
-		if err != nil {
-			log.Ctx(ctx).Warn("IndexNode get local used size failed")
-			return err
-		}
+		// the local disk space has been reserved on enqueue
 		fieldDataSize, err := estimateFieldDataSize(it.statistic.Dim, it.req.GetNumRows(), it.fieldType)
 		if err != nil {
-			log.Ctx(ctx).Warn("IndexNode get local used size failed")
+			log.Ctx(ctx).Warn("IndexNode estimate field data size failed")
 			return err
 		}
-		usedLocalSizeWhenBuild := int64(float64(fieldDataSize)*diskUsageRatio) + localUsedSize
-		maxUsedLocalSize := int64(Params.IndexNodeCfg.DiskCapacityLimit.GetAsFloat() * Params.IndexNodeCfg.MaxDiskUsagePercentage.GetAsFloat())
-
-		if usedLocalSizeWhenBuild > maxUsedLocalSize {
-			log.Ctx(ctx).Warn("IndexNode don't has enough disk size to build disk ann index",
-				zap.Int64("usedLocalSizeWhenBuild", usedLocalSizeWhenBuild),
-				zap.Int64("maxUsedLocalSize", maxUsedLocalSize))
-			return errors.New("index node don't has enough disk size to build disk ann index")
-		}
 
 		err = indexparams.SetDiskIndexBuildParams(it.newIndexParams, int64(fieldDataSize))
 		if err != nil {
@@ -28,6 +28,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/util/indexcgowrapper"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -134,7 +135,12 @@ func (queue *IndexTaskQueue) Enqueue(t task) error {
 	if err != nil {
 		return err
 	}
-	return queue.addUnissuedTask(t)
+	if err := queue.addUnissuedTask(t); err != nil {
+		// release the resources reserved on enqueue
+		t.Reset()
+		return err
+	}
+	return nil
 }
 
 func (queue *IndexTaskQueue) GetTaskNum() (int, int) {
@@ -165,11 +171,74 @@ func NewIndexBuildTaskQueue(sched *TaskScheduler) *IndexTaskQueue {
 	}
 }
 
+// diskBuildQuota admits the disk index builds by the local disk space they take.
+// The space is reserved once a build is enqueued and released after its local files are cleaned,
+// since the files are written gradually during the build and the local used size alone overcommits the concurrent builds.
+type diskBuildQuota struct {
+	mu sync.Mutex
+	// the local used size is refreshed once a build releases its space,
+	// it includes the files of the running builds which are also reserved, the overlap only makes the quota conservative.
+	localUsed int64
+	reserved  int64
+}
+
+func (q *diskBuildQuota) limit() int64 {
+	return int64(Params.IndexNodeCfg.DiskCapacityLimit.GetAsFloat() * Params.IndexNodeCfg.MaxDiskUsagePercentage.GetAsFloat())
+}
+
+func (q *diskBuildQuota) refreshLocked() {
+	localUsed, err := indexcgowrapper.GetLocalUsedSize(paramtable.Get().LocalStorageCfg.Path.GetValue())
+	if err != nil {
+		log.Warn("IndexNode get local used size failed", zap.Error(err))
+		return
+	}
+	q.localUsed = localUsed
+}
+
+func (q *diskBuildQuota) refresh() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.refreshLocked()
+}
+
+func (q *diskBuildQuota) availableLocked() int64 {
+	available := q.limit() - q.localUsed - q.reserved
+	if available < 0 {
+		return 0
+	}
+	return available
+}
+
+// available returns the local disk space left to build the disk indexes.
+func (q *diskBuildQuota) available() int64 {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.availableLocked()
+}
+
+func (q *diskBuildQuota) reserve(size int64) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if available := q.availableLocked(); size > available {
+		return fmt.Errorf("index node doesn't have enough disk space to build disk ann index, required: %d, available: %d", size, available)
+	}
+	q.reserved += size
+	return nil
+}
+
+func (q *diskBuildQuota) release(size int64) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.reserved -= size
+	q.refreshLocked()
+}
+
 // TaskScheduler is a scheduler of indexing tasks.
 type TaskScheduler struct {
 	IndexBuildQueue TaskQueue
 
 	buildParallel int
+	diskQuota     *diskBuildQuota
 	wg            sync.WaitGroup
 	ctx           context.Context
 	cancel        context.CancelFunc
@@ -182,6 +251,7 @@ func NewTaskScheduler(ctx context.Context) *TaskScheduler {
 		ctx:           ctx1,
 		cancel:        cancel,
 		buildParallel: Params.IndexNodeCfg.BuildParallel.GetAsInt(),
+		diskQuota:     &diskBuildQuota{},
 	}
 	s.IndexBuildQueue = NewIndexBuildTaskQueue(s)
 
@@ -262,6 +332,9 @@ func (sched *TaskScheduler) indexBuildLoop() {
 
 // Start stats the task scheduler of indexing tasks.
 func (sched *TaskScheduler) Start() error {
+	if Params.IndexNodeCfg.EnableDisk.GetAsBool() {
+		sched.diskQuota.refresh()
+	}
 	sched.wg.Add(1)
 	go sched.indexBuildLoop()
 	return nil
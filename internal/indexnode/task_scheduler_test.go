@@ -203,3 +203,22 @@ func TestIndexTaskScheduler(t *testing.T) {
 		assert.Equal(t, task.GetState(), commonpb.IndexState_Finished)
 	}
 }
+
+func TestDiskBuildQuota(t *testing.T) {
+	Params.Init()
+
+	quota := &diskBuildQuota{}
+	limit := quota.limit()
+	assert.Equal(t, limit, quota.available())
+
+	assert.NoError(t, quota.reserve(limit/2))
+	assert.Equal(t, limit-limit/2, quota.available())
+	assert.Error(t, quota.reserve(limit))
+
+	quota.mu.Lock()
+	quota.reserved -= limit / 2
+	quota.localUsed = limit + 1
+	quota.mu.Unlock()
+	assert.Equal(t, int64(0), quota.available())
+	assert.Error(t, quota.reserve(1))
+}
@@ -17,11 +17,15 @@
 package etcdkv
 
 import (
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.etcd.io/etcd/server/v3/embed"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
@@ -71,3 +75,31 @@ func NewWatchKVFactory(rootPath string, etcdCfg *paramtable.EtcdConfig) (kv.Watc
 func NewMetaKvFactory(rootPath string, etcdCfg *paramtable.EtcdConfig) (kv.MetaKv, error) {
 	return NewWatchKVFactory(rootPath, etcdCfg)
 }
+
+// watchClientSubsystem is the subsystem label of the metrics of the dedicated watch client.
+const watchClientSubsystem = "watch"
+
+// NewWatchClient creates the dedicated etcd client for the watch-heavy subsystems if etcd.watchClient.enabled.
+// The client has its own connection and rate limit, so the bursts of the watches never starve
+// the session keepalives on the coordination client.
+// Returns nil if the dedicated client is disabled or etcd is embedded, the caller shall fall back to the coordination client.
+func NewWatchClient(etcdCfg *paramtable.EtcdConfig) (*clientv3.Client, error) {
+	if !etcdCfg.WatchClientEnabled.GetAsBool() || etcdCfg.UseEmbedEtcd.GetAsBool() {
+		return nil, nil
+	}
+	observe := func(wait time.Duration) {
+		metrics.MetaClientQueueLatency.WithLabelValues(watchClientSubsystem).Observe(float64(wait.Milliseconds()))
+	}
+	log.Info("create dedicated etcd watch client",
+		zap.Float64("rateLimit", etcdCfg.WatchClientRateLimit.GetAsFloat()),
+		zap.Int("burst", etcdCfg.WatchClientBurst.GetAsInt()))
+	return etcd.GetEtcdClient(
+		false,
+		etcdCfg.EtcdUseSSL.GetAsBool(),
+		etcdCfg.Endpoints.GetAsStrings(),
+		etcdCfg.EtcdTLSCert.GetValue(),
+		etcdCfg.EtcdTLSKey.GetValue(),
+		etcdCfg.EtcdTLSCACert.GetValue(),
+		etcdCfg.EtcdTLSMinVersion.GetValue(),
+		etcd.RateLimitDialOptions(etcdCfg.WatchClientRateLimit.GetAsFloat(), etcdCfg.WatchClientBurst.GetAsInt(), observe)...)
+}
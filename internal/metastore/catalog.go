@@ -109,6 +109,8 @@ type BinlogsIncrement struct {
 //go:generate mockery --name=DataCoordCatalog --with-expecter
 type DataCoordCatalog interface {
 	ListSegments(ctx context.Context) ([]*datapb.SegmentInfo, error)
+	// GetSegment loads the segment with its binlogs, nil is returned if the segment doesn't exist.
+	GetSegment(ctx context.Context, collectionID, partitionID, segmentID typeutil.UniqueID) (*datapb.SegmentInfo, error)
 	AddSegment(ctx context.Context, segment *datapb.SegmentInfo) error
 	// TODO Remove this later, we should update flush segments info for each segment separately, so far we still need transaction
 	AlterSegments(ctx context.Context, newSegments []*datapb.SegmentInfo, binlogs ...BinlogsIncrement) error
@@ -125,6 +127,21 @@ type DataCoordCatalog interface {
 	SaveChannelCheckpoint(ctx context.Context, vChannel string, pos *msgpb.MsgPosition) error
 	DropChannelCheckpoint(ctx context.Context, vChannel string) error
 
+	ListMaintenancePolicies(ctx context.Context) ([]*datapb.MaintenancePolicy, error)
+	SaveMaintenancePolicy(ctx context.Context, policy *datapb.MaintenancePolicy) error
+	DropMaintenancePolicy(ctx context.Context, collectionID typeutil.UniqueID) error
+
+	ListCordonedNodes(ctx context.Context) ([]typeutil.UniqueID, error)
+	SaveCordonedNode(ctx context.Context, nodeID typeutil.UniqueID) error
+	DropCordonedNode(ctx context.Context, nodeID typeutil.UniqueID) error
+
+	ListEncryptionKeys(ctx context.Context) ([]*datapb.EncryptionKeyInfo, error)
+	SaveEncryptionKey(ctx context.Context, key *datapb.EncryptionKeyInfo) error
+
+	ListCloneJobs(ctx context.Context) ([]*datapb.CloneJob, error)
+	SaveCloneJob(ctx context.Context, job *datapb.CloneJob) error
+	DropCloneJob(ctx context.Context, targetCollectionID typeutil.UniqueID) error
+
 	CreateIndex(ctx context.Context, index *model.Index) error
 	ListIndexes(ctx context.Context) ([]*model.Index, error)
 	AlterIndexes(ctx context.Context, newIndexes []*model.Index) error
@@ -152,4 +169,6 @@ type QueryCoordCatalog interface {
 	SaveResourceGroup(rgs ...*querypb.ResourceGroup) error
 	RemoveResourceGroup(rgName string) error
 	GetResourceGroups() ([]*querypb.ResourceGroup, error)
+	SaveCheckerActivation(checker string, activated bool) error
+	GetCheckerActivations() (map[string]bool, error)
 }
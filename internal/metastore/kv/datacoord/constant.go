@@ -24,6 +24,10 @@ const (
 	SegmentStatslogPathPrefix = MetaPrefix + "/statslog"
 	ChannelRemovePrefix       = MetaPrefix + "/channel-removal"
 	ChannelCheckpointPrefix   = MetaPrefix + "/channel-cp"
+	MaintenancePolicyPrefix   = MetaPrefix + "/maintenance-policy"
+	EncryptionKeyPrefix       = MetaPrefix + "/encryption-key"
+	CordonedNodePrefix        = MetaPrefix + "/cordoned-node"
+	CloneJobPrefix            = MetaPrefix + "/clone-job"
 
 	NonRemoveFlagTomestone = "non-removed"
 	RemoveFlagTomestone    = "removed"
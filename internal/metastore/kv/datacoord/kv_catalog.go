@@ -37,6 +37,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/segmentutil"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util"
@@ -227,6 +228,46 @@ func (kc *Catalog) applyBinlogInfo(segments []*datapb.SegmentInfo, insertLogs, d
 	}
 }
 
+func (kc *Catalog) GetSegment(ctx context.Context, collectionID, partitionID, segmentID typeutil.UniqueID) (*datapb.SegmentInfo, error) {
+	value, err := kc.MetaKv.Load(buildSegmentPath(collectionID, partitionID, segmentID))
+	if common.IsKeyNotExistError(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	segment := &datapb.SegmentInfo{}
+	if err := proto.Unmarshal([]byte(value), segment); err != nil {
+		return nil, err
+	}
+
+	prefixes := map[storage.BinlogType]string{
+		storage.InsertBinlog: buildFieldBinlogPathPrefix(collectionID, partitionID, segmentID),
+		storage.DeleteBinlog: buildFieldDeltalogPathPrefix(collectionID, partitionID, segmentID),
+		storage.StatsBinlog:  buildFieldStatslogPathPrefix(collectionID, partitionID, segmentID),
+	}
+	logs := make(map[storage.BinlogType]map[typeutil.UniqueID][]*datapb.FieldBinlog, len(prefixes))
+	for binlogType, prefix := range prefixes {
+		// the trailing slash prevents matching the binlogs of the segments whose ids share the prefix
+		_, values, err := kc.MetaKv.LoadWithPrefix(prefix + "/")
+		if err != nil {
+			return nil, err
+		}
+		binlogs := make([]*datapb.FieldBinlog, 0, len(values))
+		for _, value := range values {
+			fieldBinlog := &datapb.FieldBinlog{}
+			if err := proto.Unmarshal([]byte(value), fieldBinlog); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal datapb.FieldBinlog: %d, err:%w", fieldBinlog.FieldID, err)
+			}
+			fillLogPathByLogID(kc.ChunkManagerRootPath, binlogType, collectionID, partitionID, segmentID, fieldBinlog)
+			binlogs = append(binlogs, fieldBinlog)
+		}
+		logs[binlogType] = map[typeutil.UniqueID][]*datapb.FieldBinlog{segmentID: binlogs}
+	}
+	kc.applyBinlogInfo([]*datapb.SegmentInfo{segment}, logs[storage.InsertBinlog], logs[storage.DeleteBinlog], logs[storage.StatsBinlog])
+	return segment, nil
+}
+
 func (kc *Catalog) AddSegment(ctx context.Context, segment *datapb.SegmentInfo) error {
 	kvs, err := buildSegmentAndBinlogsKvs(segment)
 	if err != nil {
@@ -528,6 +569,121 @@ func (kc *Catalog) DropChannelCheckpoint(ctx context.Context, vChannel string) e
 	return kc.MetaKv.Remove(k)
 }
 
+func (kc *Catalog) ListMaintenancePolicies(ctx context.Context) ([]*datapb.MaintenancePolicy, error) {
+	_, values, err := kc.MetaKv.LoadWithPrefix(MaintenancePolicyPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	policies := make([]*datapb.MaintenancePolicy, 0, len(values))
+	for _, value := range values {
+		policy := &datapb.MaintenancePolicy{}
+		if err := proto.Unmarshal([]byte(value), policy); err != nil {
+			log.Error("unmarshal maintenance policy failed", zap.Error(err))
+			return nil, err
+		}
+		policies = append(policies, policy)
+	}
+	return policies, nil
+}
+
+func (kc *Catalog) SaveMaintenancePolicy(ctx context.Context, policy *datapb.MaintenancePolicy) error {
+	k := buildMaintenancePolicyKey(policy.GetCollectionID())
+	v, err := proto.Marshal(policy)
+	if err != nil {
+		return err
+	}
+	return kc.MetaKv.Save(k, string(v))
+}
+
+func (kc *Catalog) DropMaintenancePolicy(ctx context.Context, collectionID typeutil.UniqueID) error {
+	return kc.MetaKv.Remove(buildMaintenancePolicyKey(collectionID))
+}
+
+func (kc *Catalog) ListCordonedNodes(ctx context.Context) ([]typeutil.UniqueID, error) {
+	keys, _, err := kc.MetaKv.LoadWithPrefix(CordonedNodePrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeIDs := make([]typeutil.UniqueID, 0, len(keys))
+	for _, key := range keys {
+		nodeID, err := strconv.ParseInt(path.Base(key), 10, 64)
+		if err != nil {
+			log.Error("parse cordoned node key failed", zap.String("key", key), zap.Error(err))
+			return nil, err
+		}
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+	return nodeIDs, nil
+}
+
+func (kc *Catalog) SaveCordonedNode(ctx context.Context, nodeID typeutil.UniqueID) error {
+	return kc.MetaKv.Save(buildCordonedNodeKey(nodeID), strconv.FormatInt(nodeID, 10))
+}
+
+func (kc *Catalog) DropCordonedNode(ctx context.Context, nodeID typeutil.UniqueID) error {
+	return kc.MetaKv.Remove(buildCordonedNodeKey(nodeID))
+}
+
+func (kc *Catalog) ListEncryptionKeys(ctx context.Context) ([]*datapb.EncryptionKeyInfo, error) {
+	_, values, err := kc.MetaKv.LoadWithPrefix(EncryptionKeyPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]*datapb.EncryptionKeyInfo, 0, len(values))
+	for _, value := range values {
+		key := &datapb.EncryptionKeyInfo{}
+		if err := proto.Unmarshal([]byte(value), key); err != nil {
+			log.Error("unmarshal encryption key info failed", zap.Error(err))
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func (kc *Catalog) SaveEncryptionKey(ctx context.Context, key *datapb.EncryptionKeyInfo) error {
+	k := buildEncryptionKeyKey(key.GetCollectionID())
+	v, err := proto.Marshal(key)
+	if err != nil {
+		return err
+	}
+	return kc.MetaKv.Save(k, string(v))
+}
+
+func (kc *Catalog) ListCloneJobs(ctx context.Context) ([]*datapb.CloneJob, error) {
+	_, values, err := kc.MetaKv.LoadWithPrefix(CloneJobPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := make([]*datapb.CloneJob, 0, len(values))
+	for _, value := range values {
+		job := &datapb.CloneJob{}
+		if err := proto.Unmarshal([]byte(value), job); err != nil {
+			log.Error("unmarshal clone job failed", zap.Error(err))
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+func (kc *Catalog) SaveCloneJob(ctx context.Context, job *datapb.CloneJob) error {
+	k := buildCloneJobKey(job.GetRequest().GetTargetCollectionID())
+	v, err := proto.Marshal(job)
+	if err != nil {
+		return err
+	}
+	return kc.MetaKv.Save(k, string(v))
+}
+
+func (kc *Catalog) DropCloneJob(ctx context.Context, targetCollectionID typeutil.UniqueID) error {
+	return kc.MetaKv.Remove(buildCloneJobKey(targetCollectionID))
+}
+
 func (kc *Catalog) getBinlogsWithPrefix(binlogType storage.BinlogType, collectionID, partitionID,
 	segmentID typeutil.UniqueID) ([]string, []string, error) {
 	var binlogPrefix string
@@ -992,6 +1148,22 @@ func buildChannelCPKey(vChannel string) string {
 	return fmt.Sprintf("%s/%s", ChannelCheckpointPrefix, vChannel)
 }
 
+func buildMaintenancePolicyKey(collectionID typeutil.UniqueID) string {
+	return fmt.Sprintf("%s/%d", MaintenancePolicyPrefix, collectionID)
+}
+
+func buildCordonedNodeKey(nodeID typeutil.UniqueID) string {
+	return fmt.Sprintf("%s/%d", CordonedNodePrefix, nodeID)
+}
+
+func buildEncryptionKeyKey(collectionID typeutil.UniqueID) string {
+	return fmt.Sprintf("%s/%d", EncryptionKeyPrefix, collectionID)
+}
+
+func buildCloneJobKey(targetCollectionID typeutil.UniqueID) string {
+	return fmt.Sprintf("%s/%d", CloneJobPrefix, targetCollectionID)
+}
+
 func BuildIndexKey(collectionID, indexID int64) string {
 	return fmt.Sprintf("%s/%d/%d", util.FieldIndexPrefix, collectionID, indexID)
 }
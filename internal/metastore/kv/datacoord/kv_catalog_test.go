@@ -40,6 +40,7 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
 	"github.com/milvus-io/milvus/pkg/util/metautil"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -271,6 +272,65 @@ func Test_ListSegments(t *testing.T) {
 	})
 }
 
+func Test_GetSegment(t *testing.T) {
+	savedKvs := make(map[string]string)
+	metakv := mocks.NewMetaKv(t)
+	metakv.EXPECT().MultiSave(mock.Anything).RunAndReturn(func(m map[string]string) error {
+		maps.Copy(savedKvs, m)
+		return nil
+	})
+	metakv.EXPECT().Load(mock.Anything).RunAndReturn(func(key string) (string, error) {
+		if value, ok := savedKvs[key]; ok {
+			return value, nil
+		}
+		return "", common.NewKeyNotExistError(key)
+	}).Maybe()
+	metakv.EXPECT().LoadWithPrefix(mock.Anything).RunAndReturn(func(prefix string) ([]string, []string, error) {
+		keys, values := make([]string, 0), make([]string, 0)
+		for key, value := range savedKvs {
+			if strings.HasPrefix(key, prefix) {
+				keys = append(keys, key)
+				values = append(values, value)
+			}
+		}
+		return keys, values, nil
+	}).Maybe()
+
+	catalog := NewCatalog(metakv, rootPath, "")
+	assert.NoError(t, catalog.AddSegment(context.TODO(), segment1))
+	segment2 := &datapb.SegmentInfo{
+		ID:           segmentID2,
+		CollectionID: collectionID,
+		PartitionID:  partitionID,
+		NumOfRows:    100,
+		State:        commonpb.SegmentState_Flushed,
+		Binlogs:      getlogs(binlogPath2),
+		Deltalogs:    getlogs(deltalogPath2),
+		Statslogs:    getlogs(statslogPath2),
+	}
+	assert.NoError(t, catalog.AddSegment(context.TODO(), segment2))
+
+	segment, err := catalog.GetSegment(context.TODO(), collectionID, partitionID, segmentID)
+	assert.NoError(t, err)
+	assert.Equal(t, segmentID, segment.GetID())
+	// the binlogs of segment 11 are not mixed into segment 1
+	assert.Equal(t, 1, len(segment.GetBinlogs()))
+	assert.Equal(t, binlogPath, segment.GetBinlogs()[0].GetBinlogs()[0].GetLogPath())
+	assert.Equal(t, 1, len(segment.GetDeltalogs()))
+	assert.Equal(t, deltalogPath, segment.GetDeltalogs()[0].GetBinlogs()[0].GetLogPath())
+	assert.Equal(t, 1, len(segment.GetStatslogs()))
+	assert.Equal(t, statslogPath, segment.GetStatslogs()[0].GetBinlogs()[0].GetLogPath())
+
+	segment, err = catalog.GetSegment(context.TODO(), collectionID, partitionID, 100)
+	assert.NoError(t, err)
+	assert.Nil(t, segment)
+
+	failKv := mocks.NewMetaKv(t)
+	failKv.EXPECT().Load(mock.Anything).Return("", errors.New("error"))
+	_, err = NewCatalog(failKv, rootPath, "").GetSegment(context.TODO(), collectionID, partitionID, segmentID)
+	assert.Error(t, err)
+}
+
 func Test_AddSegments(t *testing.T) {
 	t.Run("generate binlog kvs failed", func(t *testing.T) {
 		metakv := mocks.NewMetaKv(t)
@@ -614,6 +674,182 @@ func TestChannelCP(t *testing.T) {
 	})
 }
 
+func TestCatalog_MaintenancePolicy(t *testing.T) {
+	policy := &datapb.MaintenancePolicy{CollectionID: 100, CompactionPaused: true, GcSchedule: "22:00-06:00"}
+	v, err := proto.Marshal(policy)
+	assert.NoError(t, err)
+
+	t.Run("SaveMaintenancePolicy", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().Save(buildMaintenancePolicyKey(100), string(v)).Return(nil)
+		catalog := NewCatalog(txn, rootPath, "")
+		err := catalog.SaveMaintenancePolicy(context.TODO(), policy)
+		assert.NoError(t, err)
+	})
+
+	t.Run("ListMaintenancePolicies", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().LoadWithPrefix(MaintenancePolicyPrefix).Return([]string{buildMaintenancePolicyKey(100)}, []string{string(v)}, nil)
+		catalog := NewCatalog(txn, rootPath, "")
+		res, err := catalog.ListMaintenancePolicies(context.TODO())
+		assert.NoError(t, err)
+		assert.Len(t, res, 1)
+		assert.True(t, proto.Equal(policy, res[0]))
+	})
+
+	t.Run("ListMaintenancePolicies failed", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().LoadWithPrefix(mock.Anything).Return(nil, nil, errors.New("mock error"))
+		catalog := NewCatalog(txn, rootPath, "")
+		_, err := catalog.ListMaintenancePolicies(context.TODO())
+		assert.Error(t, err)
+
+		txn = mocks.NewMetaKv(t)
+		txn.EXPECT().LoadWithPrefix(mock.Anything).Return([]string{"key"}, []string{"invalid"}, nil)
+		catalog = NewCatalog(txn, rootPath, "")
+		_, err = catalog.ListMaintenancePolicies(context.TODO())
+		assert.Error(t, err)
+	})
+
+	t.Run("DropMaintenancePolicy", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().Remove(buildMaintenancePolicyKey(100)).Return(nil)
+		catalog := NewCatalog(txn, rootPath, "")
+		err := catalog.DropMaintenancePolicy(context.TODO(), 100)
+		assert.NoError(t, err)
+	})
+}
+
+func TestCatalog_CordonedNode(t *testing.T) {
+	t.Run("SaveCordonedNode", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().Save(buildCordonedNodeKey(1), "1").Return(nil)
+		catalog := NewCatalog(txn, rootPath, "")
+		err := catalog.SaveCordonedNode(context.TODO(), 1)
+		assert.NoError(t, err)
+	})
+
+	t.Run("ListCordonedNodes", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().LoadWithPrefix(CordonedNodePrefix).Return([]string{buildCordonedNodeKey(1), buildCordonedNodeKey(2)}, []string{"1", "2"}, nil)
+		catalog := NewCatalog(txn, rootPath, "")
+		res, err := catalog.ListCordonedNodes(context.TODO())
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []int64{1, 2}, res)
+	})
+
+	t.Run("ListCordonedNodes failed", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().LoadWithPrefix(mock.Anything).Return(nil, nil, errors.New("mock error"))
+		catalog := NewCatalog(txn, rootPath, "")
+		_, err := catalog.ListCordonedNodes(context.TODO())
+		assert.Error(t, err)
+
+		txn = mocks.NewMetaKv(t)
+		txn.EXPECT().LoadWithPrefix(mock.Anything).Return([]string{CordonedNodePrefix + "/invalid"}, []string{""}, nil)
+		catalog = NewCatalog(txn, rootPath, "")
+		_, err = catalog.ListCordonedNodes(context.TODO())
+		assert.Error(t, err)
+	})
+
+	t.Run("DropCordonedNode", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().Remove(buildCordonedNodeKey(1)).Return(nil)
+		catalog := NewCatalog(txn, rootPath, "")
+		err := catalog.DropCordonedNode(context.TODO(), 1)
+		assert.NoError(t, err)
+	})
+}
+
+func TestCatalog_EncryptionKey(t *testing.T) {
+	key := &datapb.EncryptionKeyInfo{CollectionID: 100, KeyVersion: 2}
+	v, err := proto.Marshal(key)
+	assert.NoError(t, err)
+
+	t.Run("SaveEncryptionKey", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().Save(buildEncryptionKeyKey(100), string(v)).Return(nil)
+		catalog := NewCatalog(txn, rootPath, "")
+		err := catalog.SaveEncryptionKey(context.TODO(), key)
+		assert.NoError(t, err)
+	})
+
+	t.Run("ListEncryptionKeys", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().LoadWithPrefix(EncryptionKeyPrefix).Return([]string{buildEncryptionKeyKey(100)}, []string{string(v)}, nil)
+		catalog := NewCatalog(txn, rootPath, "")
+		res, err := catalog.ListEncryptionKeys(context.TODO())
+		assert.NoError(t, err)
+		assert.Len(t, res, 1)
+		assert.True(t, proto.Equal(key, res[0]))
+	})
+
+	t.Run("ListEncryptionKeys failed", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().LoadWithPrefix(mock.Anything).Return(nil, nil, errors.New("mock error"))
+		catalog := NewCatalog(txn, rootPath, "")
+		_, err := catalog.ListEncryptionKeys(context.TODO())
+		assert.Error(t, err)
+
+		txn = mocks.NewMetaKv(t)
+		txn.EXPECT().LoadWithPrefix(mock.Anything).Return([]string{"key"}, []string{"invalid"}, nil)
+		catalog = NewCatalog(txn, rootPath, "")
+		_, err = catalog.ListEncryptionKeys(context.TODO())
+		assert.Error(t, err)
+	})
+}
+
+func TestCatalog_CloneJob(t *testing.T) {
+	job := &datapb.CloneJob{
+		Request:    &datapb.CloneSegmentsRequest{CollectionID: 100, TargetCollectionID: 200},
+		SegmentIDs: []int64{1, 2},
+		Cloned:     1,
+		State:      datapb.CloneState_Cloning,
+	}
+	v, err := proto.Marshal(job)
+	assert.NoError(t, err)
+
+	t.Run("SaveCloneJob", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().Save(buildCloneJobKey(200), string(v)).Return(nil)
+		catalog := NewCatalog(txn, rootPath, "")
+		err := catalog.SaveCloneJob(context.TODO(), job)
+		assert.NoError(t, err)
+	})
+
+	t.Run("ListCloneJobs", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().LoadWithPrefix(CloneJobPrefix).Return([]string{buildCloneJobKey(200)}, []string{string(v)}, nil)
+		catalog := NewCatalog(txn, rootPath, "")
+		res, err := catalog.ListCloneJobs(context.TODO())
+		assert.NoError(t, err)
+		assert.Len(t, res, 1)
+		assert.True(t, proto.Equal(job, res[0]))
+	})
+
+	t.Run("ListCloneJobs failed", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().LoadWithPrefix(mock.Anything).Return(nil, nil, errors.New("mock error"))
+		catalog := NewCatalog(txn, rootPath, "")
+		_, err := catalog.ListCloneJobs(context.TODO())
+		assert.Error(t, err)
+
+		txn = mocks.NewMetaKv(t)
+		txn.EXPECT().LoadWithPrefix(mock.Anything).Return([]string{"key"}, []string{"invalid"}, nil)
+		catalog = NewCatalog(txn, rootPath, "")
+		_, err = catalog.ListCloneJobs(context.TODO())
+		assert.Error(t, err)
+	})
+
+	t.Run("DropCloneJob", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().Remove(buildCloneJobKey(200)).Return(nil)
+		catalog := NewCatalog(txn, rootPath, "")
+		err := catalog.DropCloneJob(context.TODO(), 200)
+		assert.NoError(t, err)
+	})
+}
+
 func Test_MarkChannelDeleted_SaveError(t *testing.T) {
 	txn := mocks.NewMetaKv(t)
 	txn.EXPECT().
@@ -2,6 +2,8 @@ package querycoord
 
 import (
 	"fmt"
+	"path"
+	"strconv"
 
 	"github.com/cockroachdb/errors"
 	"github.com/golang/protobuf/proto"
@@ -23,6 +25,7 @@ const (
 	CollectionMetaPrefixV1   = "queryCoord-collectionMeta"
 	ReplicaMetaPrefixV1      = "queryCoord-ReplicaMeta"
 	ResourceGroupPrefix      = "queryCoord-ResourceGroup"
+	CheckerActivationPrefix  = "querycoord-checker-activation"
 )
 
 type Catalog struct {
@@ -96,6 +99,26 @@ func (s Catalog) RemoveResourceGroup(rgName string) error {
 	return s.cli.Remove(key)
 }
 
+func (s Catalog) SaveCheckerActivation(checker string, activated bool) error {
+	return s.cli.Save(encodeCheckerActivationKey(checker), strconv.FormatBool(activated))
+}
+
+func (s Catalog) GetCheckerActivations() (map[string]bool, error) {
+	keys, values, err := s.cli.LoadWithPrefix(CheckerActivationPrefix)
+	if err != nil {
+		return nil, err
+	}
+	ret := make(map[string]bool, len(keys))
+	for i, key := range keys {
+		activated, err := strconv.ParseBool(values[i])
+		if err != nil {
+			return nil, err
+		}
+		ret[path.Base(key)] = activated
+	}
+	return ret, nil
+}
+
 func (s Catalog) GetCollections() ([]*querypb.CollectionLoadInfo, error) {
 	_, values, err := s.cli.LoadWithPrefix(CollectionLoadInfoPrefix)
 	if err != nil {
@@ -254,3 +277,7 @@ func encodeCollectionReplicaKey(collection int64) string {
 func encodeResourceGroupKey(rgName string) string {
 	return fmt.Sprintf("%s/%s", ResourceGroupPrefix, rgName)
 }
+
+func encodeCheckerActivationKey(checker string) string {
+	return fmt.Sprintf("%s/%s", CheckerActivationPrefix, checker)
+}
@@ -181,6 +181,16 @@ func (suite *CatalogTestSuite) TestResourceGroup() {
 	suite.Equal([]int64{4, 5}, groups[1].GetNodes())
 }
 
+func (suite *CatalogTestSuite) TestCheckerActivation() {
+	suite.NoError(suite.catalog.SaveCheckerActivation("balance_checker", false))
+	suite.NoError(suite.catalog.SaveCheckerActivation("segment_checker", false))
+	suite.NoError(suite.catalog.SaveCheckerActivation("segment_checker", true))
+
+	activations, err := suite.catalog.GetCheckerActivations()
+	suite.NoError(err)
+	suite.Equal(map[string]bool{"balance_checker": false, "segment_checker": true}, activations)
+}
+
 func (suite *CatalogTestSuite) TestLoadRelease() {
 	// TODO(sunby): add ut
 }
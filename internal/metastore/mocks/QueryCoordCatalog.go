@@ -20,6 +20,59 @@ func (_m *QueryCoordCatalog) EXPECT() *QueryCoordCatalog_Expecter {
 	return &QueryCoordCatalog_Expecter{mock: &_m.Mock}
 }
 
+// GetCheckerActivations provides a mock function with given fields:
+func (_m *QueryCoordCatalog) GetCheckerActivations() (map[string]bool, error) {
+	ret := _m.Called()
+
+	var r0 map[string]bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (map[string]bool, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() map[string]bool); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]bool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QueryCoordCatalog_GetCheckerActivations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCheckerActivations'
+type QueryCoordCatalog_GetCheckerActivations_Call struct {
+	*mock.Call
+}
+
+// GetCheckerActivations is a helper method to define mock.On call
+func (_e *QueryCoordCatalog_Expecter) GetCheckerActivations() *QueryCoordCatalog_GetCheckerActivations_Call {
+	return &QueryCoordCatalog_GetCheckerActivations_Call{Call: _e.mock.On("GetCheckerActivations")}
+}
+
+func (_c *QueryCoordCatalog_GetCheckerActivations_Call) Run(run func()) *QueryCoordCatalog_GetCheckerActivations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *QueryCoordCatalog_GetCheckerActivations_Call) Return(_a0 map[string]bool, _a1 error) *QueryCoordCatalog_GetCheckerActivations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *QueryCoordCatalog_GetCheckerActivations_Call) RunAndReturn(run func() (map[string]bool, error)) *QueryCoordCatalog_GetCheckerActivations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetCollections provides a mock function with given fields:
 func (_m *QueryCoordCatalog) GetCollections() ([]*querypb.CollectionLoadInfo, error) {
 	ret := _m.Called()
@@ -458,6 +511,49 @@ func (_c *QueryCoordCatalog_RemoveResourceGroup_Call) RunAndReturn(run func(stri
 	return _c
 }
 
+// SaveCheckerActivation provides a mock function with given fields: checker, activated
+func (_m *QueryCoordCatalog) SaveCheckerActivation(checker string, activated bool) error {
+	ret := _m.Called(checker, activated)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, bool) error); ok {
+		r0 = rf(checker, activated)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// QueryCoordCatalog_SaveCheckerActivation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveCheckerActivation'
+type QueryCoordCatalog_SaveCheckerActivation_Call struct {
+	*mock.Call
+}
+
+// SaveCheckerActivation is a helper method to define mock.On call
+//   - checker string
+//   - activated bool
+func (_e *QueryCoordCatalog_Expecter) SaveCheckerActivation(checker interface{}, activated interface{}) *QueryCoordCatalog_SaveCheckerActivation_Call {
+	return &QueryCoordCatalog_SaveCheckerActivation_Call{Call: _e.mock.On("SaveCheckerActivation", checker, activated)}
+}
+
+func (_c *QueryCoordCatalog_SaveCheckerActivation_Call) Run(run func(checker string, activated bool)) *QueryCoordCatalog_SaveCheckerActivation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(bool))
+	})
+	return _c
+}
+
+func (_c *QueryCoordCatalog_SaveCheckerActivation_Call) Return(_a0 error) *QueryCoordCatalog_SaveCheckerActivation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *QueryCoordCatalog_SaveCheckerActivation_Call) RunAndReturn(run func(string, bool) error) *QueryCoordCatalog_SaveCheckerActivation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SaveCollection provides a mock function with given fields: collection, partitions
 func (_m *QueryCoordCatalog) SaveCollection(collection *querypb.CollectionLoadInfo, partitions ...*querypb.PartitionLoadInfo) error {
 	_va := make([]interface{}, len(partitions))
@@ -18,6 +18,9 @@ type Index struct {
 	IndexParams     []*commonpb.KeyValuePair
 	IsAutoIndex     bool
 	UserIndexParams []*commonpb.KeyValuePair
+	// PendingIndexParams are the index params being rolled out by AlterIndex,
+	// they replace IndexParams once all the segment indexes are rebuilt.
+	PendingIndexParams []*commonpb.KeyValuePair
 }
 
 func UnmarshalIndexModel(indexInfo *indexpb.FieldIndex) *Index {
@@ -26,16 +29,17 @@ func UnmarshalIndexModel(indexInfo *indexpb.FieldIndex) *Index {
 	}
 
 	return &Index{
-		CollectionID:    indexInfo.IndexInfo.GetCollectionID(),
-		FieldID:         indexInfo.IndexInfo.GetFieldID(),
-		IndexID:         indexInfo.IndexInfo.GetIndexID(),
-		IndexName:       indexInfo.IndexInfo.GetIndexName(),
-		IsDeleted:       indexInfo.GetDeleted(),
-		CreateTime:      indexInfo.CreateTime,
-		TypeParams:      indexInfo.IndexInfo.GetTypeParams(),
-		IndexParams:     indexInfo.IndexInfo.GetIndexParams(),
-		IsAutoIndex:     indexInfo.IndexInfo.GetIsAutoIndex(),
-		UserIndexParams: indexInfo.IndexInfo.GetUserIndexParams(),
+		CollectionID:       indexInfo.IndexInfo.GetCollectionID(),
+		FieldID:            indexInfo.IndexInfo.GetFieldID(),
+		IndexID:            indexInfo.IndexInfo.GetIndexID(),
+		IndexName:          indexInfo.IndexInfo.GetIndexName(),
+		IsDeleted:          indexInfo.GetDeleted(),
+		CreateTime:         indexInfo.CreateTime,
+		TypeParams:         indexInfo.IndexInfo.GetTypeParams(),
+		IndexParams:        indexInfo.IndexInfo.GetIndexParams(),
+		IsAutoIndex:        indexInfo.IndexInfo.GetIsAutoIndex(),
+		UserIndexParams:    indexInfo.IndexInfo.GetUserIndexParams(),
+		PendingIndexParams: indexInfo.IndexInfo.GetPendingIndexParams(),
 	}
 }
 
@@ -46,14 +50,15 @@ func MarshalIndexModel(index *Index) *indexpb.FieldIndex {
 
 	return &indexpb.FieldIndex{
 		IndexInfo: &indexpb.IndexInfo{
-			CollectionID:    index.CollectionID,
-			FieldID:         index.FieldID,
-			IndexName:       index.IndexName,
-			IndexID:         index.IndexID,
-			TypeParams:      index.TypeParams,
-			IndexParams:     index.IndexParams,
-			IsAutoIndex:     index.IsAutoIndex,
-			UserIndexParams: index.UserIndexParams,
+			CollectionID:       index.CollectionID,
+			FieldID:            index.FieldID,
+			IndexName:          index.IndexName,
+			IndexID:            index.IndexID,
+			TypeParams:         index.TypeParams,
+			IndexParams:        index.IndexParams,
+			IsAutoIndex:        index.IsAutoIndex,
+			UserIndexParams:    index.UserIndexParams,
+			PendingIndexParams: index.PendingIndexParams,
 		},
 		Deleted:    index.IsDeleted,
 		CreateTime: index.CreateTime,
@@ -133,5 +138,11 @@ func CloneIndex(index *Index) *Index {
 	for i, param := range index.UserIndexParams {
 		clonedIndex.UserIndexParams[i] = proto.Clone(param).(*commonpb.KeyValuePair)
 	}
+	if len(index.PendingIndexParams) > 0 {
+		clonedIndex.PendingIndexParams = make([]*commonpb.KeyValuePair, len(index.PendingIndexParams))
+		for i, param := range index.PendingIndexParams {
+			clonedIndex.PendingIndexParams[i] = proto.Clone(param).(*commonpb.KeyValuePair)
+		}
+	}
 	return clonedIndex
 }
@@ -23,6 +23,11 @@ type SegmentIndex struct {
 	IndexSize     uint64
 	// deprecated
 	WriteHandoff bool
+	// IsRebuild marks the segment index rebuilt with the pending index params,
+	// it replaces the serving one of the segment once finished.
+	IsRebuild bool
+	// IndexEngineVersion is the version of the index file format, 0 if unknown.
+	IndexEngineVersion int32
 }
 
 func UnmarshalSegmentIndexModel(segIndex *indexpb.SegmentIndex) *SegmentIndex {
@@ -31,21 +36,23 @@ func UnmarshalSegmentIndexModel(segIndex *indexpb.SegmentIndex) *SegmentIndex {
 	}
 
 	return &SegmentIndex{
-		SegmentID:     segIndex.SegmentID,
-		CollectionID:  segIndex.CollectionID,
-		PartitionID:   segIndex.PartitionID,
-		NumRows:       segIndex.NumRows,
-		IndexID:       segIndex.IndexID,
-		BuildID:       segIndex.BuildID,
-		NodeID:        segIndex.NodeID,
-		IndexState:    segIndex.State,
-		FailReason:    segIndex.FailReason,
-		IndexVersion:  segIndex.IndexVersion,
-		IsDeleted:     segIndex.Deleted,
-		CreateTime:    segIndex.CreateTime,
-		IndexFileKeys: common.CloneStringList(segIndex.IndexFileKeys),
-		IndexSize:     segIndex.SerializeSize,
-		WriteHandoff:  segIndex.WriteHandoff,
+		SegmentID:          segIndex.SegmentID,
+		CollectionID:       segIndex.CollectionID,
+		PartitionID:        segIndex.PartitionID,
+		NumRows:            segIndex.NumRows,
+		IndexID:            segIndex.IndexID,
+		BuildID:            segIndex.BuildID,
+		NodeID:             segIndex.NodeID,
+		IndexState:         segIndex.State,
+		FailReason:         segIndex.FailReason,
+		IndexVersion:       segIndex.IndexVersion,
+		IsDeleted:          segIndex.Deleted,
+		CreateTime:         segIndex.CreateTime,
+		IndexFileKeys:      common.CloneStringList(segIndex.IndexFileKeys),
+		IndexSize:          segIndex.SerializeSize,
+		WriteHandoff:       segIndex.WriteHandoff,
+		IsRebuild:          segIndex.Rebuild,
+		IndexEngineVersion: segIndex.IndexEngineVersion,
 	}
 }
 
@@ -55,40 +62,44 @@ func MarshalSegmentIndexModel(segIdx *SegmentIndex) *indexpb.SegmentIndex {
 	}
 
 	return &indexpb.SegmentIndex{
-		CollectionID:  segIdx.CollectionID,
-		PartitionID:   segIdx.PartitionID,
-		SegmentID:     segIdx.SegmentID,
-		NumRows:       segIdx.NumRows,
-		IndexID:       segIdx.IndexID,
-		BuildID:       segIdx.BuildID,
-		NodeID:        segIdx.NodeID,
-		State:         segIdx.IndexState,
-		FailReason:    segIdx.FailReason,
-		IndexVersion:  segIdx.IndexVersion,
-		IndexFileKeys: common.CloneStringList(segIdx.IndexFileKeys),
-		Deleted:       segIdx.IsDeleted,
-		CreateTime:    segIdx.CreateTime,
-		SerializeSize: segIdx.IndexSize,
-		WriteHandoff:  segIdx.WriteHandoff,
+		CollectionID:       segIdx.CollectionID,
+		PartitionID:        segIdx.PartitionID,
+		SegmentID:          segIdx.SegmentID,
+		NumRows:            segIdx.NumRows,
+		IndexID:            segIdx.IndexID,
+		BuildID:            segIdx.BuildID,
+		NodeID:             segIdx.NodeID,
+		State:              segIdx.IndexState,
+		FailReason:         segIdx.FailReason,
+		IndexVersion:       segIdx.IndexVersion,
+		IndexFileKeys:      common.CloneStringList(segIdx.IndexFileKeys),
+		Deleted:            segIdx.IsDeleted,
+		CreateTime:         segIdx.CreateTime,
+		SerializeSize:      segIdx.IndexSize,
+		WriteHandoff:       segIdx.WriteHandoff,
+		Rebuild:            segIdx.IsRebuild,
+		IndexEngineVersion: segIdx.IndexEngineVersion,
 	}
 }
 
 func CloneSegmentIndex(segIndex *SegmentIndex) *SegmentIndex {
 	return &SegmentIndex{
-		SegmentID:     segIndex.SegmentID,
-		CollectionID:  segIndex.CollectionID,
-		PartitionID:   segIndex.PartitionID,
-		NumRows:       segIndex.NumRows,
-		IndexID:       segIndex.IndexID,
-		BuildID:       segIndex.BuildID,
-		NodeID:        segIndex.NodeID,
-		IndexState:    segIndex.IndexState,
-		FailReason:    segIndex.FailReason,
-		IndexVersion:  segIndex.IndexVersion,
-		IsDeleted:     segIndex.IsDeleted,
-		CreateTime:    segIndex.CreateTime,
-		IndexFileKeys: common.CloneStringList(segIndex.IndexFileKeys),
-		IndexSize:     segIndex.IndexSize,
-		WriteHandoff:  segIndex.WriteHandoff,
+		SegmentID:          segIndex.SegmentID,
+		CollectionID:       segIndex.CollectionID,
+		PartitionID:        segIndex.PartitionID,
+		NumRows:            segIndex.NumRows,
+		IndexID:            segIndex.IndexID,
+		BuildID:            segIndex.BuildID,
+		NodeID:             segIndex.NodeID,
+		IndexState:         segIndex.IndexState,
+		FailReason:         segIndex.FailReason,
+		IndexVersion:       segIndex.IndexVersion,
+		IsDeleted:          segIndex.IsDeleted,
+		CreateTime:         segIndex.CreateTime,
+		IndexFileKeys:      common.CloneStringList(segIndex.IndexFileKeys),
+		IndexSize:          segIndex.IndexSize,
+		WriteHandoff:       segIndex.WriteHandoff,
+		IsRebuild:          segIndex.IsRebuild,
+		IndexEngineVersion: segIndex.IndexEngineVersion,
 	}
 }
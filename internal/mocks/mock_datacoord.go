@@ -34,6 +34,61 @@ func (_m *MockDataCoord) EXPECT() *MockDataCoord_Expecter {
 	return &MockDataCoord_Expecter{mock: &_m.Mock}
 }
 
+// AlterIndex provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) AlterIndex(ctx context.Context, req *indexpb.AlterIndexRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.AlterIndexRequest) (*commonpb.Status, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.AlterIndexRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.AlterIndexRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_AlterIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterIndex'
+type MockDataCoord_AlterIndex_Call struct {
+	*mock.Call
+}
+
+// AlterIndex is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *indexpb.AlterIndexRequest
+func (_e *MockDataCoord_Expecter) AlterIndex(ctx interface{}, req interface{}) *MockDataCoord_AlterIndex_Call {
+	return &MockDataCoord_AlterIndex_Call{Call: _e.mock.On("AlterIndex", ctx, req)}
+}
+
+func (_c *MockDataCoord_AlterIndex_Call) Run(run func(ctx context.Context, req *indexpb.AlterIndexRequest)) *MockDataCoord_AlterIndex_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*indexpb.AlterIndexRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_AlterIndex_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_AlterIndex_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_AlterIndex_Call) RunAndReturn(run func(context.Context, *indexpb.AlterIndexRequest) (*commonpb.Status, error)) *MockDataCoord_AlterIndex_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AssignSegmentID provides a mock function with given fields: ctx, req
 func (_m *MockDataCoord) AssignSegmentID(ctx context.Context, req *datapb.AssignSegmentIDRequest) (*datapb.AssignSegmentIDResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -144,24 +199,24 @@ func (_c *MockDataCoord_BroadcastAlteredCollection_Call) RunAndReturn(run func(c
 	return _c
 }
 
-// CheckHealth provides a mock function with given fields: ctx, req
-func (_m *MockDataCoord) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
+// CancelCompaction provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) CancelCompaction(ctx context.Context, req *datapb.CancelCompactionRequest) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, req)
 
-	var r0 *milvuspb.CheckHealthResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CancelCompactionRequest) (*commonpb.Status, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CheckHealthRequest) *milvuspb.CheckHealthResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CancelCompactionRequest) *commonpb.Status); ok {
 		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.CheckHealthResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.CheckHealthRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.CancelCompactionRequest) error); ok {
 		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -170,53 +225,53 @@ func (_m *MockDataCoord) CheckHealth(ctx context.Context, req *milvuspb.CheckHea
 	return r0, r1
 }
 
-// MockDataCoord_CheckHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckHealth'
-type MockDataCoord_CheckHealth_Call struct {
+// MockDataCoord_CancelCompaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelCompaction'
+type MockDataCoord_CancelCompaction_Call struct {
 	*mock.Call
 }
 
-// CheckHealth is a helper method to define mock.On call
+// CancelCompaction is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *milvuspb.CheckHealthRequest
-func (_e *MockDataCoord_Expecter) CheckHealth(ctx interface{}, req interface{}) *MockDataCoord_CheckHealth_Call {
-	return &MockDataCoord_CheckHealth_Call{Call: _e.mock.On("CheckHealth", ctx, req)}
+//   - req *datapb.CancelCompactionRequest
+func (_e *MockDataCoord_Expecter) CancelCompaction(ctx interface{}, req interface{}) *MockDataCoord_CancelCompaction_Call {
+	return &MockDataCoord_CancelCompaction_Call{Call: _e.mock.On("CancelCompaction", ctx, req)}
 }
 
-func (_c *MockDataCoord_CheckHealth_Call) Run(run func(ctx context.Context, req *milvuspb.CheckHealthRequest)) *MockDataCoord_CheckHealth_Call {
+func (_c *MockDataCoord_CancelCompaction_Call) Run(run func(ctx context.Context, req *datapb.CancelCompactionRequest)) *MockDataCoord_CancelCompaction_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*milvuspb.CheckHealthRequest))
+		run(args[0].(context.Context), args[1].(*datapb.CancelCompactionRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_CheckHealth_Call) Return(_a0 *milvuspb.CheckHealthResponse, _a1 error) *MockDataCoord_CheckHealth_Call {
+func (_c *MockDataCoord_CancelCompaction_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_CancelCompaction_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_CheckHealth_Call) RunAndReturn(run func(context.Context, *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error)) *MockDataCoord_CheckHealth_Call {
+func (_c *MockDataCoord_CancelCompaction_Call) RunAndReturn(run func(context.Context, *datapb.CancelCompactionRequest) (*commonpb.Status, error)) *MockDataCoord_CancelCompaction_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateIndex provides a mock function with given fields: ctx, req
-func (_m *MockDataCoord) CreateIndex(ctx context.Context, req *indexpb.CreateIndexRequest) (*commonpb.Status, error) {
+// CheckHealth provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	ret := _m.Called(ctx, req)
 
-	var r0 *commonpb.Status
+	var r0 *milvuspb.CheckHealthResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.CreateIndexRequest) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.CreateIndexRequest) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CheckHealthRequest) *milvuspb.CheckHealthResponse); ok {
 		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*commonpb.Status)
+			r0 = ret.Get(0).(*milvuspb.CheckHealthResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.CreateIndexRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.CheckHealthRequest) error); ok {
 		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -225,53 +280,53 @@ func (_m *MockDataCoord) CreateIndex(ctx context.Context, req *indexpb.CreateInd
 	return r0, r1
 }
 
-// MockDataCoord_CreateIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateIndex'
-type MockDataCoord_CreateIndex_Call struct {
+// MockDataCoord_CheckHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckHealth'
+type MockDataCoord_CheckHealth_Call struct {
 	*mock.Call
 }
 
-// CreateIndex is a helper method to define mock.On call
+// CheckHealth is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *indexpb.CreateIndexRequest
-func (_e *MockDataCoord_Expecter) CreateIndex(ctx interface{}, req interface{}) *MockDataCoord_CreateIndex_Call {
-	return &MockDataCoord_CreateIndex_Call{Call: _e.mock.On("CreateIndex", ctx, req)}
+//   - req *milvuspb.CheckHealthRequest
+func (_e *MockDataCoord_Expecter) CheckHealth(ctx interface{}, req interface{}) *MockDataCoord_CheckHealth_Call {
+	return &MockDataCoord_CheckHealth_Call{Call: _e.mock.On("CheckHealth", ctx, req)}
 }
 
-func (_c *MockDataCoord_CreateIndex_Call) Run(run func(ctx context.Context, req *indexpb.CreateIndexRequest)) *MockDataCoord_CreateIndex_Call {
+func (_c *MockDataCoord_CheckHealth_Call) Run(run func(ctx context.Context, req *milvuspb.CheckHealthRequest)) *MockDataCoord_CheckHealth_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*indexpb.CreateIndexRequest))
+		run(args[0].(context.Context), args[1].(*milvuspb.CheckHealthRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_CreateIndex_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_CreateIndex_Call {
+func (_c *MockDataCoord_CheckHealth_Call) Return(_a0 *milvuspb.CheckHealthResponse, _a1 error) *MockDataCoord_CheckHealth_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_CreateIndex_Call) RunAndReturn(run func(context.Context, *indexpb.CreateIndexRequest) (*commonpb.Status, error)) *MockDataCoord_CreateIndex_Call {
+func (_c *MockDataCoord_CheckHealth_Call) RunAndReturn(run func(context.Context, *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error)) *MockDataCoord_CheckHealth_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DescribeIndex provides a mock function with given fields: ctx, req
-func (_m *MockDataCoord) DescribeIndex(ctx context.Context, req *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error) {
+// ClearNodeConfigs provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) ClearNodeConfigs(ctx context.Context, req *datapb.ClearNodeConfigsRequest) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, req)
 
-	var r0 *indexpb.DescribeIndexResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ClearNodeConfigsRequest) (*commonpb.Status, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.DescribeIndexRequest) *indexpb.DescribeIndexResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ClearNodeConfigsRequest) *commonpb.Status); ok {
 		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*indexpb.DescribeIndexResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.DescribeIndexRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ClearNodeConfigsRequest) error); ok {
 		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -280,45 +335,45 @@ func (_m *MockDataCoord) DescribeIndex(ctx context.Context, req *indexpb.Describ
 	return r0, r1
 }
 
-// MockDataCoord_DescribeIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DescribeIndex'
-type MockDataCoord_DescribeIndex_Call struct {
+// MockDataCoord_ClearNodeConfigs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClearNodeConfigs'
+type MockDataCoord_ClearNodeConfigs_Call struct {
 	*mock.Call
 }
 
-// DescribeIndex is a helper method to define mock.On call
+// ClearNodeConfigs is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *indexpb.DescribeIndexRequest
-func (_e *MockDataCoord_Expecter) DescribeIndex(ctx interface{}, req interface{}) *MockDataCoord_DescribeIndex_Call {
-	return &MockDataCoord_DescribeIndex_Call{Call: _e.mock.On("DescribeIndex", ctx, req)}
+//   - req *datapb.ClearNodeConfigsRequest
+func (_e *MockDataCoord_Expecter) ClearNodeConfigs(ctx interface{}, req interface{}) *MockDataCoord_ClearNodeConfigs_Call {
+	return &MockDataCoord_ClearNodeConfigs_Call{Call: _e.mock.On("ClearNodeConfigs", ctx, req)}
 }
 
-func (_c *MockDataCoord_DescribeIndex_Call) Run(run func(ctx context.Context, req *indexpb.DescribeIndexRequest)) *MockDataCoord_DescribeIndex_Call {
+func (_c *MockDataCoord_ClearNodeConfigs_Call) Run(run func(ctx context.Context, req *datapb.ClearNodeConfigsRequest)) *MockDataCoord_ClearNodeConfigs_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*indexpb.DescribeIndexRequest))
+		run(args[0].(context.Context), args[1].(*datapb.ClearNodeConfigsRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_DescribeIndex_Call) Return(_a0 *indexpb.DescribeIndexResponse, _a1 error) *MockDataCoord_DescribeIndex_Call {
+func (_c *MockDataCoord_ClearNodeConfigs_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_ClearNodeConfigs_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_DescribeIndex_Call) RunAndReturn(run func(context.Context, *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error)) *MockDataCoord_DescribeIndex_Call {
+func (_c *MockDataCoord_ClearNodeConfigs_Call) RunAndReturn(run func(context.Context, *datapb.ClearNodeConfigsRequest) (*commonpb.Status, error)) *MockDataCoord_ClearNodeConfigs_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DropIndex provides a mock function with given fields: ctx, req
-func (_m *MockDataCoord) DropIndex(ctx context.Context, req *indexpb.DropIndexRequest) (*commonpb.Status, error) {
+// CloneSegments provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) CloneSegments(ctx context.Context, req *datapb.CloneSegmentsRequest) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, req)
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.DropIndexRequest) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CloneSegmentsRequest) (*commonpb.Status, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.DropIndexRequest) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CloneSegmentsRequest) *commonpb.Status); ok {
 		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
@@ -326,7 +381,7 @@ func (_m *MockDataCoord) DropIndex(ctx context.Context, req *indexpb.DropIndexRe
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.DropIndexRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.CloneSegmentsRequest) error); ok {
 		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -335,53 +390,53 @@ func (_m *MockDataCoord) DropIndex(ctx context.Context, req *indexpb.DropIndexRe
 	return r0, r1
 }
 
-// MockDataCoord_DropIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropIndex'
-type MockDataCoord_DropIndex_Call struct {
+// MockDataCoord_CloneSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloneSegments'
+type MockDataCoord_CloneSegments_Call struct {
 	*mock.Call
 }
 
-// DropIndex is a helper method to define mock.On call
+// CloneSegments is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *indexpb.DropIndexRequest
-func (_e *MockDataCoord_Expecter) DropIndex(ctx interface{}, req interface{}) *MockDataCoord_DropIndex_Call {
-	return &MockDataCoord_DropIndex_Call{Call: _e.mock.On("DropIndex", ctx, req)}
+//   - req *datapb.CloneSegmentsRequest
+func (_e *MockDataCoord_Expecter) CloneSegments(ctx interface{}, req interface{}) *MockDataCoord_CloneSegments_Call {
+	return &MockDataCoord_CloneSegments_Call{Call: _e.mock.On("CloneSegments", ctx, req)}
 }
 
-func (_c *MockDataCoord_DropIndex_Call) Run(run func(ctx context.Context, req *indexpb.DropIndexRequest)) *MockDataCoord_DropIndex_Call {
+func (_c *MockDataCoord_CloneSegments_Call) Run(run func(ctx context.Context, req *datapb.CloneSegmentsRequest)) *MockDataCoord_CloneSegments_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*indexpb.DropIndexRequest))
+		run(args[0].(context.Context), args[1].(*datapb.CloneSegmentsRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_DropIndex_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_DropIndex_Call {
+func (_c *MockDataCoord_CloneSegments_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_CloneSegments_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_DropIndex_Call) RunAndReturn(run func(context.Context, *indexpb.DropIndexRequest) (*commonpb.Status, error)) *MockDataCoord_DropIndex_Call {
+func (_c *MockDataCoord_CloneSegments_Call) RunAndReturn(run func(context.Context, *datapb.CloneSegmentsRequest) (*commonpb.Status, error)) *MockDataCoord_CloneSegments_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DropVirtualChannel provides a mock function with given fields: ctx, req
-func (_m *MockDataCoord) DropVirtualChannel(ctx context.Context, req *datapb.DropVirtualChannelRequest) (*datapb.DropVirtualChannelResponse, error) {
+// CordonDataNode provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) CordonDataNode(ctx context.Context, req *datapb.CordonDataNodeRequest) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, req)
 
-	var r0 *datapb.DropVirtualChannelResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.DropVirtualChannelRequest) (*datapb.DropVirtualChannelResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CordonDataNodeRequest) (*commonpb.Status, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.DropVirtualChannelRequest) *datapb.DropVirtualChannelResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CordonDataNodeRequest) *commonpb.Status); ok {
 		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datapb.DropVirtualChannelResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.DropVirtualChannelRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.CordonDataNodeRequest) error); ok {
 		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -390,53 +445,53 @@ func (_m *MockDataCoord) DropVirtualChannel(ctx context.Context, req *datapb.Dro
 	return r0, r1
 }
 
-// MockDataCoord_DropVirtualChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropVirtualChannel'
-type MockDataCoord_DropVirtualChannel_Call struct {
+// MockDataCoord_CordonDataNode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CordonDataNode'
+type MockDataCoord_CordonDataNode_Call struct {
 	*mock.Call
 }
 
-// DropVirtualChannel is a helper method to define mock.On call
+// CordonDataNode is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *datapb.DropVirtualChannelRequest
-func (_e *MockDataCoord_Expecter) DropVirtualChannel(ctx interface{}, req interface{}) *MockDataCoord_DropVirtualChannel_Call {
-	return &MockDataCoord_DropVirtualChannel_Call{Call: _e.mock.On("DropVirtualChannel", ctx, req)}
+//   - req *datapb.CordonDataNodeRequest
+func (_e *MockDataCoord_Expecter) CordonDataNode(ctx interface{}, req interface{}) *MockDataCoord_CordonDataNode_Call {
+	return &MockDataCoord_CordonDataNode_Call{Call: _e.mock.On("CordonDataNode", ctx, req)}
 }
 
-func (_c *MockDataCoord_DropVirtualChannel_Call) Run(run func(ctx context.Context, req *datapb.DropVirtualChannelRequest)) *MockDataCoord_DropVirtualChannel_Call {
+func (_c *MockDataCoord_CordonDataNode_Call) Run(run func(ctx context.Context, req *datapb.CordonDataNodeRequest)) *MockDataCoord_CordonDataNode_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*datapb.DropVirtualChannelRequest))
+		run(args[0].(context.Context), args[1].(*datapb.CordonDataNodeRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_DropVirtualChannel_Call) Return(_a0 *datapb.DropVirtualChannelResponse, _a1 error) *MockDataCoord_DropVirtualChannel_Call {
+func (_c *MockDataCoord_CordonDataNode_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_CordonDataNode_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_DropVirtualChannel_Call) RunAndReturn(run func(context.Context, *datapb.DropVirtualChannelRequest) (*datapb.DropVirtualChannelResponse, error)) *MockDataCoord_DropVirtualChannel_Call {
+func (_c *MockDataCoord_CordonDataNode_Call) RunAndReturn(run func(context.Context, *datapb.CordonDataNodeRequest) (*commonpb.Status, error)) *MockDataCoord_CordonDataNode_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Flush provides a mock function with given fields: ctx, req
-func (_m *MockDataCoord) Flush(ctx context.Context, req *datapb.FlushRequest) (*datapb.FlushResponse, error) {
+// CreateIndex provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) CreateIndex(ctx context.Context, req *indexpb.CreateIndexRequest) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, req)
 
-	var r0 *datapb.FlushResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.FlushRequest) (*datapb.FlushResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.CreateIndexRequest) (*commonpb.Status, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.FlushRequest) *datapb.FlushResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.CreateIndexRequest) *commonpb.Status); ok {
 		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datapb.FlushResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.FlushRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.CreateIndexRequest) error); ok {
 		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -445,54 +500,54 @@ func (_m *MockDataCoord) Flush(ctx context.Context, req *datapb.FlushRequest) (*
 	return r0, r1
 }
 
-// MockDataCoord_Flush_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Flush'
-type MockDataCoord_Flush_Call struct {
+// MockDataCoord_CreateIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateIndex'
+type MockDataCoord_CreateIndex_Call struct {
 	*mock.Call
 }
 
-// Flush is a helper method to define mock.On call
+// CreateIndex is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *datapb.FlushRequest
-func (_e *MockDataCoord_Expecter) Flush(ctx interface{}, req interface{}) *MockDataCoord_Flush_Call {
-	return &MockDataCoord_Flush_Call{Call: _e.mock.On("Flush", ctx, req)}
+//   - req *indexpb.CreateIndexRequest
+func (_e *MockDataCoord_Expecter) CreateIndex(ctx interface{}, req interface{}) *MockDataCoord_CreateIndex_Call {
+	return &MockDataCoord_CreateIndex_Call{Call: _e.mock.On("CreateIndex", ctx, req)}
 }
 
-func (_c *MockDataCoord_Flush_Call) Run(run func(ctx context.Context, req *datapb.FlushRequest)) *MockDataCoord_Flush_Call {
+func (_c *MockDataCoord_CreateIndex_Call) Run(run func(ctx context.Context, req *indexpb.CreateIndexRequest)) *MockDataCoord_CreateIndex_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*datapb.FlushRequest))
+		run(args[0].(context.Context), args[1].(*indexpb.CreateIndexRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_Flush_Call) Return(_a0 *datapb.FlushResponse, _a1 error) *MockDataCoord_Flush_Call {
+func (_c *MockDataCoord_CreateIndex_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_CreateIndex_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_Flush_Call) RunAndReturn(run func(context.Context, *datapb.FlushRequest) (*datapb.FlushResponse, error)) *MockDataCoord_Flush_Call {
+func (_c *MockDataCoord_CreateIndex_Call) RunAndReturn(run func(context.Context, *indexpb.CreateIndexRequest) (*commonpb.Status, error)) *MockDataCoord_CreateIndex_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GcConfirm provides a mock function with given fields: ctx, request
-func (_m *MockDataCoord) GcConfirm(ctx context.Context, request *datapb.GcConfirmRequest) (*datapb.GcConfirmResponse, error) {
-	ret := _m.Called(ctx, request)
+// DescribeIndex provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) DescribeIndex(ctx context.Context, req *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error) {
+	ret := _m.Called(ctx, req)
 
-	var r0 *datapb.GcConfirmResponse
+	var r0 *indexpb.DescribeIndexResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GcConfirmRequest) (*datapb.GcConfirmResponse, error)); ok {
-		return rf(ctx, request)
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error)); ok {
+		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GcConfirmRequest) *datapb.GcConfirmResponse); ok {
-		r0 = rf(ctx, request)
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.DescribeIndexRequest) *indexpb.DescribeIndexResponse); ok {
+		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datapb.GcConfirmResponse)
+			r0 = ret.Get(0).(*indexpb.DescribeIndexResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GcConfirmRequest) error); ok {
-		r1 = rf(ctx, request)
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.DescribeIndexRequest) error); ok {
+		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -500,53 +555,53 @@ func (_m *MockDataCoord) GcConfirm(ctx context.Context, request *datapb.GcConfir
 	return r0, r1
 }
 
-// MockDataCoord_GcConfirm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GcConfirm'
-type MockDataCoord_GcConfirm_Call struct {
+// MockDataCoord_DescribeIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DescribeIndex'
+type MockDataCoord_DescribeIndex_Call struct {
 	*mock.Call
 }
 
-// GcConfirm is a helper method to define mock.On call
+// DescribeIndex is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *datapb.GcConfirmRequest
-func (_e *MockDataCoord_Expecter) GcConfirm(ctx interface{}, request interface{}) *MockDataCoord_GcConfirm_Call {
-	return &MockDataCoord_GcConfirm_Call{Call: _e.mock.On("GcConfirm", ctx, request)}
+//   - req *indexpb.DescribeIndexRequest
+func (_e *MockDataCoord_Expecter) DescribeIndex(ctx interface{}, req interface{}) *MockDataCoord_DescribeIndex_Call {
+	return &MockDataCoord_DescribeIndex_Call{Call: _e.mock.On("DescribeIndex", ctx, req)}
 }
 
-func (_c *MockDataCoord_GcConfirm_Call) Run(run func(ctx context.Context, request *datapb.GcConfirmRequest)) *MockDataCoord_GcConfirm_Call {
+func (_c *MockDataCoord_DescribeIndex_Call) Run(run func(ctx context.Context, req *indexpb.DescribeIndexRequest)) *MockDataCoord_DescribeIndex_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*datapb.GcConfirmRequest))
+		run(args[0].(context.Context), args[1].(*indexpb.DescribeIndexRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GcConfirm_Call) Return(_a0 *datapb.GcConfirmResponse, _a1 error) *MockDataCoord_GcConfirm_Call {
+func (_c *MockDataCoord_DescribeIndex_Call) Return(_a0 *indexpb.DescribeIndexResponse, _a1 error) *MockDataCoord_DescribeIndex_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GcConfirm_Call) RunAndReturn(run func(context.Context, *datapb.GcConfirmRequest) (*datapb.GcConfirmResponse, error)) *MockDataCoord_GcConfirm_Call {
+func (_c *MockDataCoord_DescribeIndex_Call) RunAndReturn(run func(context.Context, *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error)) *MockDataCoord_DescribeIndex_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetCollectionStatistics provides a mock function with given fields: ctx, req
-func (_m *MockDataCoord) GetCollectionStatistics(ctx context.Context, req *datapb.GetCollectionStatisticsRequest) (*datapb.GetCollectionStatisticsResponse, error) {
+// DrainDataNode provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) DrainDataNode(ctx context.Context, req *datapb.DrainDataNodeRequest) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, req)
 
-	var r0 *datapb.GetCollectionStatisticsResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetCollectionStatisticsRequest) (*datapb.GetCollectionStatisticsResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.DrainDataNodeRequest) (*commonpb.Status, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetCollectionStatisticsRequest) *datapb.GetCollectionStatisticsResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.DrainDataNodeRequest) *commonpb.Status); ok {
 		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datapb.GetCollectionStatisticsResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetCollectionStatisticsRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.DrainDataNodeRequest) error); ok {
 		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -555,53 +610,53 @@ func (_m *MockDataCoord) GetCollectionStatistics(ctx context.Context, req *datap
 	return r0, r1
 }
 
-// MockDataCoord_GetCollectionStatistics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCollectionStatistics'
-type MockDataCoord_GetCollectionStatistics_Call struct {
+// MockDataCoord_DrainDataNode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DrainDataNode'
+type MockDataCoord_DrainDataNode_Call struct {
 	*mock.Call
 }
 
-// GetCollectionStatistics is a helper method to define mock.On call
+// DrainDataNode is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *datapb.GetCollectionStatisticsRequest
-func (_e *MockDataCoord_Expecter) GetCollectionStatistics(ctx interface{}, req interface{}) *MockDataCoord_GetCollectionStatistics_Call {
-	return &MockDataCoord_GetCollectionStatistics_Call{Call: _e.mock.On("GetCollectionStatistics", ctx, req)}
+//   - req *datapb.DrainDataNodeRequest
+func (_e *MockDataCoord_Expecter) DrainDataNode(ctx interface{}, req interface{}) *MockDataCoord_DrainDataNode_Call {
+	return &MockDataCoord_DrainDataNode_Call{Call: _e.mock.On("DrainDataNode", ctx, req)}
 }
 
-func (_c *MockDataCoord_GetCollectionStatistics_Call) Run(run func(ctx context.Context, req *datapb.GetCollectionStatisticsRequest)) *MockDataCoord_GetCollectionStatistics_Call {
+func (_c *MockDataCoord_DrainDataNode_Call) Run(run func(ctx context.Context, req *datapb.DrainDataNodeRequest)) *MockDataCoord_DrainDataNode_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*datapb.GetCollectionStatisticsRequest))
+		run(args[0].(context.Context), args[1].(*datapb.DrainDataNodeRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetCollectionStatistics_Call) Return(_a0 *datapb.GetCollectionStatisticsResponse, _a1 error) *MockDataCoord_GetCollectionStatistics_Call {
+func (_c *MockDataCoord_DrainDataNode_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_DrainDataNode_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetCollectionStatistics_Call) RunAndReturn(run func(context.Context, *datapb.GetCollectionStatisticsRequest) (*datapb.GetCollectionStatisticsResponse, error)) *MockDataCoord_GetCollectionStatistics_Call {
+func (_c *MockDataCoord_DrainDataNode_Call) RunAndReturn(run func(context.Context, *datapb.DrainDataNodeRequest) (*commonpb.Status, error)) *MockDataCoord_DrainDataNode_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetCompactionState provides a mock function with given fields: ctx, req
-func (_m *MockDataCoord) GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error) {
+// DropIndex provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) DropIndex(ctx context.Context, req *indexpb.DropIndexRequest) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, req)
 
-	var r0 *milvuspb.GetCompactionStateResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.DropIndexRequest) (*commonpb.Status, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetCompactionStateRequest) *milvuspb.GetCompactionStateResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.DropIndexRequest) *commonpb.Status); ok {
 		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.GetCompactionStateResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetCompactionStateRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.DropIndexRequest) error); ok {
 		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -610,53 +665,53 @@ func (_m *MockDataCoord) GetCompactionState(ctx context.Context, req *milvuspb.G
 	return r0, r1
 }
 
-// MockDataCoord_GetCompactionState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCompactionState'
-type MockDataCoord_GetCompactionState_Call struct {
+// MockDataCoord_DropIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropIndex'
+type MockDataCoord_DropIndex_Call struct {
 	*mock.Call
 }
 
-// GetCompactionState is a helper method to define mock.On call
+// DropIndex is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *milvuspb.GetCompactionStateRequest
-func (_e *MockDataCoord_Expecter) GetCompactionState(ctx interface{}, req interface{}) *MockDataCoord_GetCompactionState_Call {
-	return &MockDataCoord_GetCompactionState_Call{Call: _e.mock.On("GetCompactionState", ctx, req)}
+//   - req *indexpb.DropIndexRequest
+func (_e *MockDataCoord_Expecter) DropIndex(ctx interface{}, req interface{}) *MockDataCoord_DropIndex_Call {
+	return &MockDataCoord_DropIndex_Call{Call: _e.mock.On("DropIndex", ctx, req)}
 }
 
-func (_c *MockDataCoord_GetCompactionState_Call) Run(run func(ctx context.Context, req *milvuspb.GetCompactionStateRequest)) *MockDataCoord_GetCompactionState_Call {
+func (_c *MockDataCoord_DropIndex_Call) Run(run func(ctx context.Context, req *indexpb.DropIndexRequest)) *MockDataCoord_DropIndex_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*milvuspb.GetCompactionStateRequest))
+		run(args[0].(context.Context), args[1].(*indexpb.DropIndexRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetCompactionState_Call) Return(_a0 *milvuspb.GetCompactionStateResponse, _a1 error) *MockDataCoord_GetCompactionState_Call {
+func (_c *MockDataCoord_DropIndex_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_DropIndex_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetCompactionState_Call) RunAndReturn(run func(context.Context, *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error)) *MockDataCoord_GetCompactionState_Call {
+func (_c *MockDataCoord_DropIndex_Call) RunAndReturn(run func(context.Context, *indexpb.DropIndexRequest) (*commonpb.Status, error)) *MockDataCoord_DropIndex_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetCompactionStateWithPlans provides a mock function with given fields: ctx, req
-func (_m *MockDataCoord) GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error) {
+// DropSegmentsByTimeRange provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) DropSegmentsByTimeRange(ctx context.Context, req *datapb.DropSegmentsByTimeRangeRequest) (*datapb.DropSegmentsByTimeRangeResponse, error) {
 	ret := _m.Called(ctx, req)
 
-	var r0 *milvuspb.GetCompactionPlansResponse
+	var r0 *datapb.DropSegmentsByTimeRangeResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.DropSegmentsByTimeRangeRequest) (*datapb.DropSegmentsByTimeRangeResponse, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetCompactionPlansRequest) *milvuspb.GetCompactionPlansResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.DropSegmentsByTimeRangeRequest) *datapb.DropSegmentsByTimeRangeResponse); ok {
 		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.GetCompactionPlansResponse)
+			r0 = ret.Get(0).(*datapb.DropSegmentsByTimeRangeResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetCompactionPlansRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.DropSegmentsByTimeRangeRequest) error); ok {
 		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -665,54 +720,54 @@ func (_m *MockDataCoord) GetCompactionStateWithPlans(ctx context.Context, req *m
 	return r0, r1
 }
 
-// MockDataCoord_GetCompactionStateWithPlans_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCompactionStateWithPlans'
-type MockDataCoord_GetCompactionStateWithPlans_Call struct {
+// MockDataCoord_DropSegmentsByTimeRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropSegmentsByTimeRange'
+type MockDataCoord_DropSegmentsByTimeRange_Call struct {
 	*mock.Call
 }
 
-// GetCompactionStateWithPlans is a helper method to define mock.On call
+// DropSegmentsByTimeRange is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *milvuspb.GetCompactionPlansRequest
-func (_e *MockDataCoord_Expecter) GetCompactionStateWithPlans(ctx interface{}, req interface{}) *MockDataCoord_GetCompactionStateWithPlans_Call {
-	return &MockDataCoord_GetCompactionStateWithPlans_Call{Call: _e.mock.On("GetCompactionStateWithPlans", ctx, req)}
+//   - req *datapb.DropSegmentsByTimeRangeRequest
+func (_e *MockDataCoord_Expecter) DropSegmentsByTimeRange(ctx interface{}, req interface{}) *MockDataCoord_DropSegmentsByTimeRange_Call {
+	return &MockDataCoord_DropSegmentsByTimeRange_Call{Call: _e.mock.On("DropSegmentsByTimeRange", ctx, req)}
 }
 
-func (_c *MockDataCoord_GetCompactionStateWithPlans_Call) Run(run func(ctx context.Context, req *milvuspb.GetCompactionPlansRequest)) *MockDataCoord_GetCompactionStateWithPlans_Call {
+func (_c *MockDataCoord_DropSegmentsByTimeRange_Call) Run(run func(ctx context.Context, req *datapb.DropSegmentsByTimeRangeRequest)) *MockDataCoord_DropSegmentsByTimeRange_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*milvuspb.GetCompactionPlansRequest))
+		run(args[0].(context.Context), args[1].(*datapb.DropSegmentsByTimeRangeRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetCompactionStateWithPlans_Call) Return(_a0 *milvuspb.GetCompactionPlansResponse, _a1 error) *MockDataCoord_GetCompactionStateWithPlans_Call {
+func (_c *MockDataCoord_DropSegmentsByTimeRange_Call) Return(_a0 *datapb.DropSegmentsByTimeRangeResponse, _a1 error) *MockDataCoord_DropSegmentsByTimeRange_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetCompactionStateWithPlans_Call) RunAndReturn(run func(context.Context, *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error)) *MockDataCoord_GetCompactionStateWithPlans_Call {
+func (_c *MockDataCoord_DropSegmentsByTimeRange_Call) RunAndReturn(run func(context.Context, *datapb.DropSegmentsByTimeRangeRequest) (*datapb.DropSegmentsByTimeRangeResponse, error)) *MockDataCoord_DropSegmentsByTimeRange_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetComponentStates provides a mock function with given fields: ctx
-func (_m *MockDataCoord) GetComponentStates(ctx context.Context) (*milvuspb.ComponentStates, error) {
-	ret := _m.Called(ctx)
+// DropVirtualChannel provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) DropVirtualChannel(ctx context.Context, req *datapb.DropVirtualChannelRequest) (*datapb.DropVirtualChannelResponse, error) {
+	ret := _m.Called(ctx, req)
 
-	var r0 *milvuspb.ComponentStates
+	var r0 *datapb.DropVirtualChannelResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (*milvuspb.ComponentStates, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.DropVirtualChannelRequest) (*datapb.DropVirtualChannelResponse, error)); ok {
+		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) *milvuspb.ComponentStates); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.DropVirtualChannelRequest) *datapb.DropVirtualChannelResponse); ok {
+		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.ComponentStates)
+			r0 = ret.Get(0).(*datapb.DropVirtualChannelResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.DropVirtualChannelRequest) error); ok {
+		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -720,52 +775,53 @@ func (_m *MockDataCoord) GetComponentStates(ctx context.Context) (*milvuspb.Comp
 	return r0, r1
 }
 
-// MockDataCoord_GetComponentStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetComponentStates'
-type MockDataCoord_GetComponentStates_Call struct {
+// MockDataCoord_DropVirtualChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropVirtualChannel'
+type MockDataCoord_DropVirtualChannel_Call struct {
 	*mock.Call
 }
 
-// GetComponentStates is a helper method to define mock.On call
+// DropVirtualChannel is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockDataCoord_Expecter) GetComponentStates(ctx interface{}) *MockDataCoord_GetComponentStates_Call {
-	return &MockDataCoord_GetComponentStates_Call{Call: _e.mock.On("GetComponentStates", ctx)}
+//   - req *datapb.DropVirtualChannelRequest
+func (_e *MockDataCoord_Expecter) DropVirtualChannel(ctx interface{}, req interface{}) *MockDataCoord_DropVirtualChannel_Call {
+	return &MockDataCoord_DropVirtualChannel_Call{Call: _e.mock.On("DropVirtualChannel", ctx, req)}
 }
 
-func (_c *MockDataCoord_GetComponentStates_Call) Run(run func(ctx context.Context)) *MockDataCoord_GetComponentStates_Call {
+func (_c *MockDataCoord_DropVirtualChannel_Call) Run(run func(ctx context.Context, req *datapb.DropVirtualChannelRequest)) *MockDataCoord_DropVirtualChannel_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(*datapb.DropVirtualChannelRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetComponentStates_Call) Return(_a0 *milvuspb.ComponentStates, _a1 error) *MockDataCoord_GetComponentStates_Call {
+func (_c *MockDataCoord_DropVirtualChannel_Call) Return(_a0 *datapb.DropVirtualChannelResponse, _a1 error) *MockDataCoord_DropVirtualChannel_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetComponentStates_Call) RunAndReturn(run func(context.Context) (*milvuspb.ComponentStates, error)) *MockDataCoord_GetComponentStates_Call {
+func (_c *MockDataCoord_DropVirtualChannel_Call) RunAndReturn(run func(context.Context, *datapb.DropVirtualChannelRequest) (*datapb.DropVirtualChannelResponse, error)) *MockDataCoord_DropVirtualChannel_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetFlushAllState provides a mock function with given fields: ctx, req
-func (_m *MockDataCoord) GetFlushAllState(ctx context.Context, req *milvuspb.GetFlushAllStateRequest) (*milvuspb.GetFlushAllStateResponse, error) {
+// Flush provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) Flush(ctx context.Context, req *datapb.FlushRequest) (*datapb.FlushResponse, error) {
 	ret := _m.Called(ctx, req)
 
-	var r0 *milvuspb.GetFlushAllStateResponse
+	var r0 *datapb.FlushResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetFlushAllStateRequest) (*milvuspb.GetFlushAllStateResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.FlushRequest) (*datapb.FlushResponse, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetFlushAllStateRequest) *milvuspb.GetFlushAllStateResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.FlushRequest) *datapb.FlushResponse); ok {
 		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.GetFlushAllStateResponse)
+			r0 = ret.Get(0).(*datapb.FlushResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetFlushAllStateRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.FlushRequest) error); ok {
 		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -774,53 +830,53 @@ func (_m *MockDataCoord) GetFlushAllState(ctx context.Context, req *milvuspb.Get
 	return r0, r1
 }
 
-// MockDataCoord_GetFlushAllState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlushAllState'
-type MockDataCoord_GetFlushAllState_Call struct {
+// MockDataCoord_Flush_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Flush'
+type MockDataCoord_Flush_Call struct {
 	*mock.Call
 }
 
-// GetFlushAllState is a helper method to define mock.On call
+// Flush is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *milvuspb.GetFlushAllStateRequest
-func (_e *MockDataCoord_Expecter) GetFlushAllState(ctx interface{}, req interface{}) *MockDataCoord_GetFlushAllState_Call {
-	return &MockDataCoord_GetFlushAllState_Call{Call: _e.mock.On("GetFlushAllState", ctx, req)}
+//   - req *datapb.FlushRequest
+func (_e *MockDataCoord_Expecter) Flush(ctx interface{}, req interface{}) *MockDataCoord_Flush_Call {
+	return &MockDataCoord_Flush_Call{Call: _e.mock.On("Flush", ctx, req)}
 }
 
-func (_c *MockDataCoord_GetFlushAllState_Call) Run(run func(ctx context.Context, req *milvuspb.GetFlushAllStateRequest)) *MockDataCoord_GetFlushAllState_Call {
+func (_c *MockDataCoord_Flush_Call) Run(run func(ctx context.Context, req *datapb.FlushRequest)) *MockDataCoord_Flush_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*milvuspb.GetFlushAllStateRequest))
+		run(args[0].(context.Context), args[1].(*datapb.FlushRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetFlushAllState_Call) Return(_a0 *milvuspb.GetFlushAllStateResponse, _a1 error) *MockDataCoord_GetFlushAllState_Call {
+func (_c *MockDataCoord_Flush_Call) Return(_a0 *datapb.FlushResponse, _a1 error) *MockDataCoord_Flush_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetFlushAllState_Call) RunAndReturn(run func(context.Context, *milvuspb.GetFlushAllStateRequest) (*milvuspb.GetFlushAllStateResponse, error)) *MockDataCoord_GetFlushAllState_Call {
+func (_c *MockDataCoord_Flush_Call) RunAndReturn(run func(context.Context, *datapb.FlushRequest) (*datapb.FlushResponse, error)) *MockDataCoord_Flush_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetFlushState provides a mock function with given fields: ctx, req
-func (_m *MockDataCoord) GetFlushState(ctx context.Context, req *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error) {
+// FlushAndSeal provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) FlushAndSeal(ctx context.Context, req *datapb.FlushAndSealRequest) (*datapb.FlushAndSealResponse, error) {
 	ret := _m.Called(ctx, req)
 
-	var r0 *milvuspb.GetFlushStateResponse
+	var r0 *datapb.FlushAndSealResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.FlushAndSealRequest) (*datapb.FlushAndSealResponse, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetFlushStateRequest) *milvuspb.GetFlushStateResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.FlushAndSealRequest) *datapb.FlushAndSealResponse); ok {
 		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.GetFlushStateResponse)
+			r0 = ret.Get(0).(*datapb.FlushAndSealResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetFlushStateRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.FlushAndSealRequest) error); ok {
 		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -829,54 +885,54 @@ func (_m *MockDataCoord) GetFlushState(ctx context.Context, req *milvuspb.GetFlu
 	return r0, r1
 }
 
-// MockDataCoord_GetFlushState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlushState'
-type MockDataCoord_GetFlushState_Call struct {
+// MockDataCoord_FlushAndSeal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FlushAndSeal'
+type MockDataCoord_FlushAndSeal_Call struct {
 	*mock.Call
 }
 
-// GetFlushState is a helper method to define mock.On call
+// FlushAndSeal is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *milvuspb.GetFlushStateRequest
-func (_e *MockDataCoord_Expecter) GetFlushState(ctx interface{}, req interface{}) *MockDataCoord_GetFlushState_Call {
-	return &MockDataCoord_GetFlushState_Call{Call: _e.mock.On("GetFlushState", ctx, req)}
+//   - req *datapb.FlushAndSealRequest
+func (_e *MockDataCoord_Expecter) FlushAndSeal(ctx interface{}, req interface{}) *MockDataCoord_FlushAndSeal_Call {
+	return &MockDataCoord_FlushAndSeal_Call{Call: _e.mock.On("FlushAndSeal", ctx, req)}
 }
 
-func (_c *MockDataCoord_GetFlushState_Call) Run(run func(ctx context.Context, req *milvuspb.GetFlushStateRequest)) *MockDataCoord_GetFlushState_Call {
+func (_c *MockDataCoord_FlushAndSeal_Call) Run(run func(ctx context.Context, req *datapb.FlushAndSealRequest)) *MockDataCoord_FlushAndSeal_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*milvuspb.GetFlushStateRequest))
+		run(args[0].(context.Context), args[1].(*datapb.FlushAndSealRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetFlushState_Call) Return(_a0 *milvuspb.GetFlushStateResponse, _a1 error) *MockDataCoord_GetFlushState_Call {
+func (_c *MockDataCoord_FlushAndSeal_Call) Return(_a0 *datapb.FlushAndSealResponse, _a1 error) *MockDataCoord_FlushAndSeal_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetFlushState_Call) RunAndReturn(run func(context.Context, *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error)) *MockDataCoord_GetFlushState_Call {
+func (_c *MockDataCoord_FlushAndSeal_Call) RunAndReturn(run func(context.Context, *datapb.FlushAndSealRequest) (*datapb.FlushAndSealResponse, error)) *MockDataCoord_FlushAndSeal_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetFlushedSegments provides a mock function with given fields: ctx, req
-func (_m *MockDataCoord) GetFlushedSegments(ctx context.Context, req *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error) {
-	ret := _m.Called(ctx, req)
+// GcConfirm provides a mock function with given fields: ctx, request
+func (_m *MockDataCoord) GcConfirm(ctx context.Context, request *datapb.GcConfirmRequest) (*datapb.GcConfirmResponse, error) {
+	ret := _m.Called(ctx, request)
 
-	var r0 *datapb.GetFlushedSegmentsResponse
+	var r0 *datapb.GcConfirmResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error)); ok {
-		return rf(ctx, req)
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GcConfirmRequest) (*datapb.GcConfirmResponse, error)); ok {
+		return rf(ctx, request)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetFlushedSegmentsRequest) *datapb.GetFlushedSegmentsResponse); ok {
-		r0 = rf(ctx, req)
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GcConfirmRequest) *datapb.GcConfirmResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datapb.GetFlushedSegmentsResponse)
+			r0 = ret.Get(0).(*datapb.GcConfirmResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetFlushedSegmentsRequest) error); ok {
-		r1 = rf(ctx, req)
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GcConfirmRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -884,53 +940,53 @@ func (_m *MockDataCoord) GetFlushedSegments(ctx context.Context, req *datapb.Get
 	return r0, r1
 }
 
-// MockDataCoord_GetFlushedSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlushedSegments'
-type MockDataCoord_GetFlushedSegments_Call struct {
+// MockDataCoord_GcConfirm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GcConfirm'
+type MockDataCoord_GcConfirm_Call struct {
 	*mock.Call
 }
 
-// GetFlushedSegments is a helper method to define mock.On call
+// GcConfirm is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *datapb.GetFlushedSegmentsRequest
-func (_e *MockDataCoord_Expecter) GetFlushedSegments(ctx interface{}, req interface{}) *MockDataCoord_GetFlushedSegments_Call {
-	return &MockDataCoord_GetFlushedSegments_Call{Call: _e.mock.On("GetFlushedSegments", ctx, req)}
+//   - request *datapb.GcConfirmRequest
+func (_e *MockDataCoord_Expecter) GcConfirm(ctx interface{}, request interface{}) *MockDataCoord_GcConfirm_Call {
+	return &MockDataCoord_GcConfirm_Call{Call: _e.mock.On("GcConfirm", ctx, request)}
 }
 
-func (_c *MockDataCoord_GetFlushedSegments_Call) Run(run func(ctx context.Context, req *datapb.GetFlushedSegmentsRequest)) *MockDataCoord_GetFlushedSegments_Call {
+func (_c *MockDataCoord_GcConfirm_Call) Run(run func(ctx context.Context, request *datapb.GcConfirmRequest)) *MockDataCoord_GcConfirm_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*datapb.GetFlushedSegmentsRequest))
+		run(args[0].(context.Context), args[1].(*datapb.GcConfirmRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetFlushedSegments_Call) Return(_a0 *datapb.GetFlushedSegmentsResponse, _a1 error) *MockDataCoord_GetFlushedSegments_Call {
+func (_c *MockDataCoord_GcConfirm_Call) Return(_a0 *datapb.GcConfirmResponse, _a1 error) *MockDataCoord_GcConfirm_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetFlushedSegments_Call) RunAndReturn(run func(context.Context, *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error)) *MockDataCoord_GetFlushedSegments_Call {
+func (_c *MockDataCoord_GcConfirm_Call) RunAndReturn(run func(context.Context, *datapb.GcConfirmRequest) (*datapb.GcConfirmResponse, error)) *MockDataCoord_GcConfirm_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetIndexBuildProgress provides a mock function with given fields: ctx, req
-func (_m *MockDataCoord) GetIndexBuildProgress(ctx context.Context, req *indexpb.GetIndexBuildProgressRequest) (*indexpb.GetIndexBuildProgressResponse, error) {
+// GcDryRun provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) GcDryRun(ctx context.Context, req *datapb.GcDryRunRequest) (*datapb.GcDryRunResponse, error) {
 	ret := _m.Called(ctx, req)
 
-	var r0 *indexpb.GetIndexBuildProgressResponse
+	var r0 *datapb.GcDryRunResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexBuildProgressRequest) (*indexpb.GetIndexBuildProgressResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GcDryRunRequest) (*datapb.GcDryRunResponse, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexBuildProgressRequest) *indexpb.GetIndexBuildProgressResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GcDryRunRequest) *datapb.GcDryRunResponse); ok {
 		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*indexpb.GetIndexBuildProgressResponse)
+			r0 = ret.Get(0).(*datapb.GcDryRunResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetIndexBuildProgressRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GcDryRunRequest) error); ok {
 		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -939,53 +995,53 @@ func (_m *MockDataCoord) GetIndexBuildProgress(ctx context.Context, req *indexpb
 	return r0, r1
 }
 
-// MockDataCoord_GetIndexBuildProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIndexBuildProgress'
-type MockDataCoord_GetIndexBuildProgress_Call struct {
+// MockDataCoord_GcDryRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GcDryRun'
+type MockDataCoord_GcDryRun_Call struct {
 	*mock.Call
 }
 
-// GetIndexBuildProgress is a helper method to define mock.On call
+// GcDryRun is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *indexpb.GetIndexBuildProgressRequest
-func (_e *MockDataCoord_Expecter) GetIndexBuildProgress(ctx interface{}, req interface{}) *MockDataCoord_GetIndexBuildProgress_Call {
-	return &MockDataCoord_GetIndexBuildProgress_Call{Call: _e.mock.On("GetIndexBuildProgress", ctx, req)}
+//   - req *datapb.GcDryRunRequest
+func (_e *MockDataCoord_Expecter) GcDryRun(ctx interface{}, req interface{}) *MockDataCoord_GcDryRun_Call {
+	return &MockDataCoord_GcDryRun_Call{Call: _e.mock.On("GcDryRun", ctx, req)}
 }
 
-func (_c *MockDataCoord_GetIndexBuildProgress_Call) Run(run func(ctx context.Context, req *indexpb.GetIndexBuildProgressRequest)) *MockDataCoord_GetIndexBuildProgress_Call {
+func (_c *MockDataCoord_GcDryRun_Call) Run(run func(ctx context.Context, req *datapb.GcDryRunRequest)) *MockDataCoord_GcDryRun_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*indexpb.GetIndexBuildProgressRequest))
+		run(args[0].(context.Context), args[1].(*datapb.GcDryRunRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetIndexBuildProgress_Call) Return(_a0 *indexpb.GetIndexBuildProgressResponse, _a1 error) *MockDataCoord_GetIndexBuildProgress_Call {
+func (_c *MockDataCoord_GcDryRun_Call) Return(_a0 *datapb.GcDryRunResponse, _a1 error) *MockDataCoord_GcDryRun_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetIndexBuildProgress_Call) RunAndReturn(run func(context.Context, *indexpb.GetIndexBuildProgressRequest) (*indexpb.GetIndexBuildProgressResponse, error)) *MockDataCoord_GetIndexBuildProgress_Call {
+func (_c *MockDataCoord_GcDryRun_Call) RunAndReturn(run func(context.Context, *datapb.GcDryRunRequest) (*datapb.GcDryRunResponse, error)) *MockDataCoord_GcDryRun_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetIndexInfos provides a mock function with given fields: ctx, req
-func (_m *MockDataCoord) GetIndexInfos(ctx context.Context, req *indexpb.GetIndexInfoRequest) (*indexpb.GetIndexInfoResponse, error) {
+// GetAuditEvents provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) GetAuditEvents(ctx context.Context, req *datapb.GetAuditEventsRequest) (*datapb.GetAuditEventsResponse, error) {
 	ret := _m.Called(ctx, req)
 
-	var r0 *indexpb.GetIndexInfoResponse
+	var r0 *datapb.GetAuditEventsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexInfoRequest) (*indexpb.GetIndexInfoResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetAuditEventsRequest) (*datapb.GetAuditEventsResponse, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexInfoRequest) *indexpb.GetIndexInfoResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetAuditEventsRequest) *datapb.GetAuditEventsResponse); ok {
 		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*indexpb.GetIndexInfoResponse)
+			r0 = ret.Get(0).(*datapb.GetAuditEventsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetIndexInfoRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetAuditEventsRequest) error); ok {
 		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -994,53 +1050,53 @@ func (_m *MockDataCoord) GetIndexInfos(ctx context.Context, req *indexpb.GetInde
 	return r0, r1
 }
 
-// MockDataCoord_GetIndexInfos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIndexInfos'
-type MockDataCoord_GetIndexInfos_Call struct {
+// MockDataCoord_GetAuditEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAuditEvents'
+type MockDataCoord_GetAuditEvents_Call struct {
 	*mock.Call
 }
 
-// GetIndexInfos is a helper method to define mock.On call
+// GetAuditEvents is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *indexpb.GetIndexInfoRequest
-func (_e *MockDataCoord_Expecter) GetIndexInfos(ctx interface{}, req interface{}) *MockDataCoord_GetIndexInfos_Call {
-	return &MockDataCoord_GetIndexInfos_Call{Call: _e.mock.On("GetIndexInfos", ctx, req)}
+//   - req *datapb.GetAuditEventsRequest
+func (_e *MockDataCoord_Expecter) GetAuditEvents(ctx interface{}, req interface{}) *MockDataCoord_GetAuditEvents_Call {
+	return &MockDataCoord_GetAuditEvents_Call{Call: _e.mock.On("GetAuditEvents", ctx, req)}
 }
 
-func (_c *MockDataCoord_GetIndexInfos_Call) Run(run func(ctx context.Context, req *indexpb.GetIndexInfoRequest)) *MockDataCoord_GetIndexInfos_Call {
+func (_c *MockDataCoord_GetAuditEvents_Call) Run(run func(ctx context.Context, req *datapb.GetAuditEventsRequest)) *MockDataCoord_GetAuditEvents_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*indexpb.GetIndexInfoRequest))
+		run(args[0].(context.Context), args[1].(*datapb.GetAuditEventsRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetIndexInfos_Call) Return(_a0 *indexpb.GetIndexInfoResponse, _a1 error) *MockDataCoord_GetIndexInfos_Call {
+func (_c *MockDataCoord_GetAuditEvents_Call) Return(_a0 *datapb.GetAuditEventsResponse, _a1 error) *MockDataCoord_GetAuditEvents_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetIndexInfos_Call) RunAndReturn(run func(context.Context, *indexpb.GetIndexInfoRequest) (*indexpb.GetIndexInfoResponse, error)) *MockDataCoord_GetIndexInfos_Call {
+func (_c *MockDataCoord_GetAuditEvents_Call) RunAndReturn(run func(context.Context, *datapb.GetAuditEventsRequest) (*datapb.GetAuditEventsResponse, error)) *MockDataCoord_GetAuditEvents_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetIndexState provides a mock function with given fields: ctx, req
-func (_m *MockDataCoord) GetIndexState(ctx context.Context, req *indexpb.GetIndexStateRequest) (*indexpb.GetIndexStateResponse, error) {
+// GetChannelWatchHistory provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) GetChannelWatchHistory(ctx context.Context, req *datapb.GetChannelWatchHistoryRequest) (*datapb.GetChannelWatchHistoryResponse, error) {
 	ret := _m.Called(ctx, req)
 
-	var r0 *indexpb.GetIndexStateResponse
+	var r0 *datapb.GetChannelWatchHistoryResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexStateRequest) (*indexpb.GetIndexStateResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetChannelWatchHistoryRequest) (*datapb.GetChannelWatchHistoryResponse, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexStateRequest) *indexpb.GetIndexStateResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetChannelWatchHistoryRequest) *datapb.GetChannelWatchHistoryResponse); ok {
 		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*indexpb.GetIndexStateResponse)
+			r0 = ret.Get(0).(*datapb.GetChannelWatchHistoryResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetIndexStateRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetChannelWatchHistoryRequest) error); ok {
 		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -1049,53 +1105,53 @@ func (_m *MockDataCoord) GetIndexState(ctx context.Context, req *indexpb.GetInde
 	return r0, r1
 }
 
-// MockDataCoord_GetIndexState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIndexState'
-type MockDataCoord_GetIndexState_Call struct {
+// MockDataCoord_GetChannelWatchHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetChannelWatchHistory'
+type MockDataCoord_GetChannelWatchHistory_Call struct {
 	*mock.Call
 }
 
-// GetIndexState is a helper method to define mock.On call
+// GetChannelWatchHistory is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *indexpb.GetIndexStateRequest
-func (_e *MockDataCoord_Expecter) GetIndexState(ctx interface{}, req interface{}) *MockDataCoord_GetIndexState_Call {
-	return &MockDataCoord_GetIndexState_Call{Call: _e.mock.On("GetIndexState", ctx, req)}
+//   - req *datapb.GetChannelWatchHistoryRequest
+func (_e *MockDataCoord_Expecter) GetChannelWatchHistory(ctx interface{}, req interface{}) *MockDataCoord_GetChannelWatchHistory_Call {
+	return &MockDataCoord_GetChannelWatchHistory_Call{Call: _e.mock.On("GetChannelWatchHistory", ctx, req)}
 }
 
-func (_c *MockDataCoord_GetIndexState_Call) Run(run func(ctx context.Context, req *indexpb.GetIndexStateRequest)) *MockDataCoord_GetIndexState_Call {
+func (_c *MockDataCoord_GetChannelWatchHistory_Call) Run(run func(ctx context.Context, req *datapb.GetChannelWatchHistoryRequest)) *MockDataCoord_GetChannelWatchHistory_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*indexpb.GetIndexStateRequest))
+		run(args[0].(context.Context), args[1].(*datapb.GetChannelWatchHistoryRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetIndexState_Call) Return(_a0 *indexpb.GetIndexStateResponse, _a1 error) *MockDataCoord_GetIndexState_Call {
+func (_c *MockDataCoord_GetChannelWatchHistory_Call) Return(_a0 *datapb.GetChannelWatchHistoryResponse, _a1 error) *MockDataCoord_GetChannelWatchHistory_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetIndexState_Call) RunAndReturn(run func(context.Context, *indexpb.GetIndexStateRequest) (*indexpb.GetIndexStateResponse, error)) *MockDataCoord_GetIndexState_Call {
+func (_c *MockDataCoord_GetChannelWatchHistory_Call) RunAndReturn(run func(context.Context, *datapb.GetChannelWatchHistoryRequest) (*datapb.GetChannelWatchHistoryResponse, error)) *MockDataCoord_GetChannelWatchHistory_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetIndexStatistics provides a mock function with given fields: ctx, req
-func (_m *MockDataCoord) GetIndexStatistics(ctx context.Context, req *indexpb.GetIndexStatisticsRequest) (*indexpb.GetIndexStatisticsResponse, error) {
+// GetChannelWatchStates provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) GetChannelWatchStates(ctx context.Context, req *datapb.GetChannelWatchStatesRequest) (*datapb.GetChannelWatchStatesResponse, error) {
 	ret := _m.Called(ctx, req)
 
-	var r0 *indexpb.GetIndexStatisticsResponse
+	var r0 *datapb.GetChannelWatchStatesResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexStatisticsRequest) (*indexpb.GetIndexStatisticsResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetChannelWatchStatesRequest) (*datapb.GetChannelWatchStatesResponse, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexStatisticsRequest) *indexpb.GetIndexStatisticsResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetChannelWatchStatesRequest) *datapb.GetChannelWatchStatesResponse); ok {
 		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*indexpb.GetIndexStatisticsResponse)
+			r0 = ret.Get(0).(*datapb.GetChannelWatchStatesResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetIndexStatisticsRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetChannelWatchStatesRequest) error); ok {
 		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -1104,53 +1160,53 @@ func (_m *MockDataCoord) GetIndexStatistics(ctx context.Context, req *indexpb.Ge
 	return r0, r1
 }
 
-// MockDataCoord_GetIndexStatistics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIndexStatistics'
-type MockDataCoord_GetIndexStatistics_Call struct {
+// MockDataCoord_GetChannelWatchStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetChannelWatchStates'
+type MockDataCoord_GetChannelWatchStates_Call struct {
 	*mock.Call
 }
 
-// GetIndexStatistics is a helper method to define mock.On call
+// GetChannelWatchStates is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *indexpb.GetIndexStatisticsRequest
-func (_e *MockDataCoord_Expecter) GetIndexStatistics(ctx interface{}, req interface{}) *MockDataCoord_GetIndexStatistics_Call {
-	return &MockDataCoord_GetIndexStatistics_Call{Call: _e.mock.On("GetIndexStatistics", ctx, req)}
+//   - req *datapb.GetChannelWatchStatesRequest
+func (_e *MockDataCoord_Expecter) GetChannelWatchStates(ctx interface{}, req interface{}) *MockDataCoord_GetChannelWatchStates_Call {
+	return &MockDataCoord_GetChannelWatchStates_Call{Call: _e.mock.On("GetChannelWatchStates", ctx, req)}
 }
 
-func (_c *MockDataCoord_GetIndexStatistics_Call) Run(run func(ctx context.Context, req *indexpb.GetIndexStatisticsRequest)) *MockDataCoord_GetIndexStatistics_Call {
+func (_c *MockDataCoord_GetChannelWatchStates_Call) Run(run func(ctx context.Context, req *datapb.GetChannelWatchStatesRequest)) *MockDataCoord_GetChannelWatchStates_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*indexpb.GetIndexStatisticsRequest))
+		run(args[0].(context.Context), args[1].(*datapb.GetChannelWatchStatesRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetIndexStatistics_Call) Return(_a0 *indexpb.GetIndexStatisticsResponse, _a1 error) *MockDataCoord_GetIndexStatistics_Call {
+func (_c *MockDataCoord_GetChannelWatchStates_Call) Return(_a0 *datapb.GetChannelWatchStatesResponse, _a1 error) *MockDataCoord_GetChannelWatchStates_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetIndexStatistics_Call) RunAndReturn(run func(context.Context, *indexpb.GetIndexStatisticsRequest) (*indexpb.GetIndexStatisticsResponse, error)) *MockDataCoord_GetIndexStatistics_Call {
+func (_c *MockDataCoord_GetChannelWatchStates_Call) RunAndReturn(run func(context.Context, *datapb.GetChannelWatchStatesRequest) (*datapb.GetChannelWatchStatesResponse, error)) *MockDataCoord_GetChannelWatchStates_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetInsertBinlogPaths provides a mock function with given fields: ctx, req
-func (_m *MockDataCoord) GetInsertBinlogPaths(ctx context.Context, req *datapb.GetInsertBinlogPathsRequest) (*datapb.GetInsertBinlogPathsResponse, error) {
+// GetCloneSegmentsState provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) GetCloneSegmentsState(ctx context.Context, req *datapb.GetCloneSegmentsStateRequest) (*datapb.GetCloneSegmentsStateResponse, error) {
 	ret := _m.Called(ctx, req)
 
-	var r0 *datapb.GetInsertBinlogPathsResponse
+	var r0 *datapb.GetCloneSegmentsStateResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetInsertBinlogPathsRequest) (*datapb.GetInsertBinlogPathsResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetCloneSegmentsStateRequest) (*datapb.GetCloneSegmentsStateResponse, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetInsertBinlogPathsRequest) *datapb.GetInsertBinlogPathsResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetCloneSegmentsStateRequest) *datapb.GetCloneSegmentsStateResponse); ok {
 		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datapb.GetInsertBinlogPathsResponse)
+			r0 = ret.Get(0).(*datapb.GetCloneSegmentsStateResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetInsertBinlogPathsRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetCloneSegmentsStateRequest) error); ok {
 		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -1159,53 +1215,1535 @@ func (_m *MockDataCoord) GetInsertBinlogPaths(ctx context.Context, req *datapb.G
 	return r0, r1
 }
 
-// MockDataCoord_GetInsertBinlogPaths_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInsertBinlogPaths'
-type MockDataCoord_GetInsertBinlogPaths_Call struct {
+// MockDataCoord_GetCloneSegmentsState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCloneSegmentsState'
+type MockDataCoord_GetCloneSegmentsState_Call struct {
 	*mock.Call
 }
 
-// GetInsertBinlogPaths is a helper method to define mock.On call
+// GetCloneSegmentsState is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *datapb.GetInsertBinlogPathsRequest
-func (_e *MockDataCoord_Expecter) GetInsertBinlogPaths(ctx interface{}, req interface{}) *MockDataCoord_GetInsertBinlogPaths_Call {
-	return &MockDataCoord_GetInsertBinlogPaths_Call{Call: _e.mock.On("GetInsertBinlogPaths", ctx, req)}
+//   - req *datapb.GetCloneSegmentsStateRequest
+func (_e *MockDataCoord_Expecter) GetCloneSegmentsState(ctx interface{}, req interface{}) *MockDataCoord_GetCloneSegmentsState_Call {
+	return &MockDataCoord_GetCloneSegmentsState_Call{Call: _e.mock.On("GetCloneSegmentsState", ctx, req)}
 }
 
-func (_c *MockDataCoord_GetInsertBinlogPaths_Call) Run(run func(ctx context.Context, req *datapb.GetInsertBinlogPathsRequest)) *MockDataCoord_GetInsertBinlogPaths_Call {
+func (_c *MockDataCoord_GetCloneSegmentsState_Call) Run(run func(ctx context.Context, req *datapb.GetCloneSegmentsStateRequest)) *MockDataCoord_GetCloneSegmentsState_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*datapb.GetInsertBinlogPathsRequest))
+		run(args[0].(context.Context), args[1].(*datapb.GetCloneSegmentsStateRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetInsertBinlogPaths_Call) Return(_a0 *datapb.GetInsertBinlogPathsResponse, _a1 error) *MockDataCoord_GetInsertBinlogPaths_Call {
+func (_c *MockDataCoord_GetCloneSegmentsState_Call) Return(_a0 *datapb.GetCloneSegmentsStateResponse, _a1 error) *MockDataCoord_GetCloneSegmentsState_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetInsertBinlogPaths_Call) RunAndReturn(run func(context.Context, *datapb.GetInsertBinlogPathsRequest) (*datapb.GetInsertBinlogPathsResponse, error)) *MockDataCoord_GetInsertBinlogPaths_Call {
+func (_c *MockDataCoord_GetCloneSegmentsState_Call) RunAndReturn(run func(context.Context, *datapb.GetCloneSegmentsStateRequest) (*datapb.GetCloneSegmentsStateResponse, error)) *MockDataCoord_GetCloneSegmentsState_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetMetrics provides a mock function with given fields: ctx, req
+// GetCollectionStatistics provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) GetCollectionStatistics(ctx context.Context, req *datapb.GetCollectionStatisticsRequest) (*datapb.GetCollectionStatisticsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *datapb.GetCollectionStatisticsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetCollectionStatisticsRequest) (*datapb.GetCollectionStatisticsResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetCollectionStatisticsRequest) *datapb.GetCollectionStatisticsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetCollectionStatisticsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetCollectionStatisticsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetCollectionStatistics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCollectionStatistics'
+type MockDataCoord_GetCollectionStatistics_Call struct {
+	*mock.Call
+}
+
+// GetCollectionStatistics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *datapb.GetCollectionStatisticsRequest
+func (_e *MockDataCoord_Expecter) GetCollectionStatistics(ctx interface{}, req interface{}) *MockDataCoord_GetCollectionStatistics_Call {
+	return &MockDataCoord_GetCollectionStatistics_Call{Call: _e.mock.On("GetCollectionStatistics", ctx, req)}
+}
+
+func (_c *MockDataCoord_GetCollectionStatistics_Call) Run(run func(ctx context.Context, req *datapb.GetCollectionStatisticsRequest)) *MockDataCoord_GetCollectionStatistics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.GetCollectionStatisticsRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetCollectionStatistics_Call) Return(_a0 *datapb.GetCollectionStatisticsResponse, _a1 error) *MockDataCoord_GetCollectionStatistics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetCollectionStatistics_Call) RunAndReturn(run func(context.Context, *datapb.GetCollectionStatisticsRequest) (*datapb.GetCollectionStatisticsResponse, error)) *MockDataCoord_GetCollectionStatistics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCompactionProgress provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) GetCompactionProgress(ctx context.Context, req *datapb.GetCompactionProgressRequest) (*datapb.GetCompactionProgressResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *datapb.GetCompactionProgressResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetCompactionProgressRequest) (*datapb.GetCompactionProgressResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetCompactionProgressRequest) *datapb.GetCompactionProgressResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetCompactionProgressResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetCompactionProgressRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetCompactionProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCompactionProgress'
+type MockDataCoord_GetCompactionProgress_Call struct {
+	*mock.Call
+}
+
+// GetCompactionProgress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *datapb.GetCompactionProgressRequest
+func (_e *MockDataCoord_Expecter) GetCompactionProgress(ctx interface{}, req interface{}) *MockDataCoord_GetCompactionProgress_Call {
+	return &MockDataCoord_GetCompactionProgress_Call{Call: _e.mock.On("GetCompactionProgress", ctx, req)}
+}
+
+func (_c *MockDataCoord_GetCompactionProgress_Call) Run(run func(ctx context.Context, req *datapb.GetCompactionProgressRequest)) *MockDataCoord_GetCompactionProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.GetCompactionProgressRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetCompactionProgress_Call) Return(_a0 *datapb.GetCompactionProgressResponse, _a1 error) *MockDataCoord_GetCompactionProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetCompactionProgress_Call) RunAndReturn(run func(context.Context, *datapb.GetCompactionProgressRequest) (*datapb.GetCompactionProgressResponse, error)) *MockDataCoord_GetCompactionProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCompactionState provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *milvuspb.GetCompactionStateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetCompactionStateRequest) *milvuspb.GetCompactionStateResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.GetCompactionStateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetCompactionStateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetCompactionState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCompactionState'
+type MockDataCoord_GetCompactionState_Call struct {
+	*mock.Call
+}
+
+// GetCompactionState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *milvuspb.GetCompactionStateRequest
+func (_e *MockDataCoord_Expecter) GetCompactionState(ctx interface{}, req interface{}) *MockDataCoord_GetCompactionState_Call {
+	return &MockDataCoord_GetCompactionState_Call{Call: _e.mock.On("GetCompactionState", ctx, req)}
+}
+
+func (_c *MockDataCoord_GetCompactionState_Call) Run(run func(ctx context.Context, req *milvuspb.GetCompactionStateRequest)) *MockDataCoord_GetCompactionState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.GetCompactionStateRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetCompactionState_Call) Return(_a0 *milvuspb.GetCompactionStateResponse, _a1 error) *MockDataCoord_GetCompactionState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetCompactionState_Call) RunAndReturn(run func(context.Context, *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error)) *MockDataCoord_GetCompactionState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCompactionStateWithPlans provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *milvuspb.GetCompactionPlansResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetCompactionPlansRequest) *milvuspb.GetCompactionPlansResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.GetCompactionPlansResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetCompactionPlansRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetCompactionStateWithPlans_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCompactionStateWithPlans'
+type MockDataCoord_GetCompactionStateWithPlans_Call struct {
+	*mock.Call
+}
+
+// GetCompactionStateWithPlans is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *milvuspb.GetCompactionPlansRequest
+func (_e *MockDataCoord_Expecter) GetCompactionStateWithPlans(ctx interface{}, req interface{}) *MockDataCoord_GetCompactionStateWithPlans_Call {
+	return &MockDataCoord_GetCompactionStateWithPlans_Call{Call: _e.mock.On("GetCompactionStateWithPlans", ctx, req)}
+}
+
+func (_c *MockDataCoord_GetCompactionStateWithPlans_Call) Run(run func(ctx context.Context, req *milvuspb.GetCompactionPlansRequest)) *MockDataCoord_GetCompactionStateWithPlans_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.GetCompactionPlansRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetCompactionStateWithPlans_Call) Return(_a0 *milvuspb.GetCompactionPlansResponse, _a1 error) *MockDataCoord_GetCompactionStateWithPlans_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetCompactionStateWithPlans_Call) RunAndReturn(run func(context.Context, *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error)) *MockDataCoord_GetCompactionStateWithPlans_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetComponentStates provides a mock function with given fields: ctx
+func (_m *MockDataCoord) GetComponentStates(ctx context.Context) (*milvuspb.ComponentStates, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *milvuspb.ComponentStates
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*milvuspb.ComponentStates, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *milvuspb.ComponentStates); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.ComponentStates)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetComponentStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetComponentStates'
+type MockDataCoord_GetComponentStates_Call struct {
+	*mock.Call
+}
+
+// GetComponentStates is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockDataCoord_Expecter) GetComponentStates(ctx interface{}) *MockDataCoord_GetComponentStates_Call {
+	return &MockDataCoord_GetComponentStates_Call{Call: _e.mock.On("GetComponentStates", ctx)}
+}
+
+func (_c *MockDataCoord_GetComponentStates_Call) Run(run func(ctx context.Context)) *MockDataCoord_GetComponentStates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetComponentStates_Call) Return(_a0 *milvuspb.ComponentStates, _a1 error) *MockDataCoord_GetComponentStates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetComponentStates_Call) RunAndReturn(run func(context.Context) (*milvuspb.ComponentStates, error)) *MockDataCoord_GetComponentStates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDataNodeDrainState provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) GetDataNodeDrainState(ctx context.Context, req *datapb.GetDataNodeDrainStateRequest) (*datapb.GetDataNodeDrainStateResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *datapb.GetDataNodeDrainStateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetDataNodeDrainStateRequest) (*datapb.GetDataNodeDrainStateResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetDataNodeDrainStateRequest) *datapb.GetDataNodeDrainStateResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetDataNodeDrainStateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetDataNodeDrainStateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetDataNodeDrainState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDataNodeDrainState'
+type MockDataCoord_GetDataNodeDrainState_Call struct {
+	*mock.Call
+}
+
+// GetDataNodeDrainState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *datapb.GetDataNodeDrainStateRequest
+func (_e *MockDataCoord_Expecter) GetDataNodeDrainState(ctx interface{}, req interface{}) *MockDataCoord_GetDataNodeDrainState_Call {
+	return &MockDataCoord_GetDataNodeDrainState_Call{Call: _e.mock.On("GetDataNodeDrainState", ctx, req)}
+}
+
+func (_c *MockDataCoord_GetDataNodeDrainState_Call) Run(run func(ctx context.Context, req *datapb.GetDataNodeDrainStateRequest)) *MockDataCoord_GetDataNodeDrainState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.GetDataNodeDrainStateRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetDataNodeDrainState_Call) Return(_a0 *datapb.GetDataNodeDrainStateResponse, _a1 error) *MockDataCoord_GetDataNodeDrainState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetDataNodeDrainState_Call) RunAndReturn(run func(context.Context, *datapb.GetDataNodeDrainStateRequest) (*datapb.GetDataNodeDrainStateResponse, error)) *MockDataCoord_GetDataNodeDrainState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEncryptionStatus provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) GetEncryptionStatus(ctx context.Context, req *datapb.GetEncryptionStatusRequest) (*datapb.GetEncryptionStatusResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *datapb.GetEncryptionStatusResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetEncryptionStatusRequest) (*datapb.GetEncryptionStatusResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetEncryptionStatusRequest) *datapb.GetEncryptionStatusResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetEncryptionStatusResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetEncryptionStatusRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetEncryptionStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEncryptionStatus'
+type MockDataCoord_GetEncryptionStatus_Call struct {
+	*mock.Call
+}
+
+// GetEncryptionStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *datapb.GetEncryptionStatusRequest
+func (_e *MockDataCoord_Expecter) GetEncryptionStatus(ctx interface{}, req interface{}) *MockDataCoord_GetEncryptionStatus_Call {
+	return &MockDataCoord_GetEncryptionStatus_Call{Call: _e.mock.On("GetEncryptionStatus", ctx, req)}
+}
+
+func (_c *MockDataCoord_GetEncryptionStatus_Call) Run(run func(ctx context.Context, req *datapb.GetEncryptionStatusRequest)) *MockDataCoord_GetEncryptionStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.GetEncryptionStatusRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetEncryptionStatus_Call) Return(_a0 *datapb.GetEncryptionStatusResponse, _a1 error) *MockDataCoord_GetEncryptionStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetEncryptionStatus_Call) RunAndReturn(run func(context.Context, *datapb.GetEncryptionStatusRequest) (*datapb.GetEncryptionStatusResponse, error)) *MockDataCoord_GetEncryptionStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFlushAllState provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) GetFlushAllState(ctx context.Context, req *milvuspb.GetFlushAllStateRequest) (*milvuspb.GetFlushAllStateResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *milvuspb.GetFlushAllStateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetFlushAllStateRequest) (*milvuspb.GetFlushAllStateResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetFlushAllStateRequest) *milvuspb.GetFlushAllStateResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.GetFlushAllStateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetFlushAllStateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetFlushAllState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlushAllState'
+type MockDataCoord_GetFlushAllState_Call struct {
+	*mock.Call
+}
+
+// GetFlushAllState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *milvuspb.GetFlushAllStateRequest
+func (_e *MockDataCoord_Expecter) GetFlushAllState(ctx interface{}, req interface{}) *MockDataCoord_GetFlushAllState_Call {
+	return &MockDataCoord_GetFlushAllState_Call{Call: _e.mock.On("GetFlushAllState", ctx, req)}
+}
+
+func (_c *MockDataCoord_GetFlushAllState_Call) Run(run func(ctx context.Context, req *milvuspb.GetFlushAllStateRequest)) *MockDataCoord_GetFlushAllState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.GetFlushAllStateRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetFlushAllState_Call) Return(_a0 *milvuspb.GetFlushAllStateResponse, _a1 error) *MockDataCoord_GetFlushAllState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetFlushAllState_Call) RunAndReturn(run func(context.Context, *milvuspb.GetFlushAllStateRequest) (*milvuspb.GetFlushAllStateResponse, error)) *MockDataCoord_GetFlushAllState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFlushState provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) GetFlushState(ctx context.Context, req *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *milvuspb.GetFlushStateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetFlushStateRequest) *milvuspb.GetFlushStateResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.GetFlushStateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetFlushStateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetFlushState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlushState'
+type MockDataCoord_GetFlushState_Call struct {
+	*mock.Call
+}
+
+// GetFlushState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *milvuspb.GetFlushStateRequest
+func (_e *MockDataCoord_Expecter) GetFlushState(ctx interface{}, req interface{}) *MockDataCoord_GetFlushState_Call {
+	return &MockDataCoord_GetFlushState_Call{Call: _e.mock.On("GetFlushState", ctx, req)}
+}
+
+func (_c *MockDataCoord_GetFlushState_Call) Run(run func(ctx context.Context, req *milvuspb.GetFlushStateRequest)) *MockDataCoord_GetFlushState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.GetFlushStateRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetFlushState_Call) Return(_a0 *milvuspb.GetFlushStateResponse, _a1 error) *MockDataCoord_GetFlushState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetFlushState_Call) RunAndReturn(run func(context.Context, *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error)) *MockDataCoord_GetFlushState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFlushedSegments provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) GetFlushedSegments(ctx context.Context, req *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *datapb.GetFlushedSegmentsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetFlushedSegmentsRequest) *datapb.GetFlushedSegmentsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetFlushedSegmentsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetFlushedSegmentsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetFlushedSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlushedSegments'
+type MockDataCoord_GetFlushedSegments_Call struct {
+	*mock.Call
+}
+
+// GetFlushedSegments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *datapb.GetFlushedSegmentsRequest
+func (_e *MockDataCoord_Expecter) GetFlushedSegments(ctx interface{}, req interface{}) *MockDataCoord_GetFlushedSegments_Call {
+	return &MockDataCoord_GetFlushedSegments_Call{Call: _e.mock.On("GetFlushedSegments", ctx, req)}
+}
+
+func (_c *MockDataCoord_GetFlushedSegments_Call) Run(run func(ctx context.Context, req *datapb.GetFlushedSegmentsRequest)) *MockDataCoord_GetFlushedSegments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.GetFlushedSegmentsRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetFlushedSegments_Call) Return(_a0 *datapb.GetFlushedSegmentsResponse, _a1 error) *MockDataCoord_GetFlushedSegments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetFlushedSegments_Call) RunAndReturn(run func(context.Context, *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error)) *MockDataCoord_GetFlushedSegments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGCStatus provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) GetGCStatus(ctx context.Context, req *datapb.GetGCStatusRequest) (*datapb.GetGCStatusResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *datapb.GetGCStatusResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetGCStatusRequest) (*datapb.GetGCStatusResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetGCStatusRequest) *datapb.GetGCStatusResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetGCStatusResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetGCStatusRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetGCStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGCStatus'
+type MockDataCoord_GetGCStatus_Call struct {
+	*mock.Call
+}
+
+// GetGCStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *datapb.GetGCStatusRequest
+func (_e *MockDataCoord_Expecter) GetGCStatus(ctx interface{}, req interface{}) *MockDataCoord_GetGCStatus_Call {
+	return &MockDataCoord_GetGCStatus_Call{Call: _e.mock.On("GetGCStatus", ctx, req)}
+}
+
+func (_c *MockDataCoord_GetGCStatus_Call) Run(run func(ctx context.Context, req *datapb.GetGCStatusRequest)) *MockDataCoord_GetGCStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.GetGCStatusRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetGCStatus_Call) Return(_a0 *datapb.GetGCStatusResponse, _a1 error) *MockDataCoord_GetGCStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetGCStatus_Call) RunAndReturn(run func(context.Context, *datapb.GetGCStatusRequest) (*datapb.GetGCStatusResponse, error)) *MockDataCoord_GetGCStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetIndexBuildProgress provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) GetIndexBuildProgress(ctx context.Context, req *indexpb.GetIndexBuildProgressRequest) (*indexpb.GetIndexBuildProgressResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *indexpb.GetIndexBuildProgressResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexBuildProgressRequest) (*indexpb.GetIndexBuildProgressResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexBuildProgressRequest) *indexpb.GetIndexBuildProgressResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*indexpb.GetIndexBuildProgressResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetIndexBuildProgressRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetIndexBuildProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIndexBuildProgress'
+type MockDataCoord_GetIndexBuildProgress_Call struct {
+	*mock.Call
+}
+
+// GetIndexBuildProgress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *indexpb.GetIndexBuildProgressRequest
+func (_e *MockDataCoord_Expecter) GetIndexBuildProgress(ctx interface{}, req interface{}) *MockDataCoord_GetIndexBuildProgress_Call {
+	return &MockDataCoord_GetIndexBuildProgress_Call{Call: _e.mock.On("GetIndexBuildProgress", ctx, req)}
+}
+
+func (_c *MockDataCoord_GetIndexBuildProgress_Call) Run(run func(ctx context.Context, req *indexpb.GetIndexBuildProgressRequest)) *MockDataCoord_GetIndexBuildProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*indexpb.GetIndexBuildProgressRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetIndexBuildProgress_Call) Return(_a0 *indexpb.GetIndexBuildProgressResponse, _a1 error) *MockDataCoord_GetIndexBuildProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetIndexBuildProgress_Call) RunAndReturn(run func(context.Context, *indexpb.GetIndexBuildProgressRequest) (*indexpb.GetIndexBuildProgressResponse, error)) *MockDataCoord_GetIndexBuildProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetIndexInfos provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) GetIndexInfos(ctx context.Context, req *indexpb.GetIndexInfoRequest) (*indexpb.GetIndexInfoResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *indexpb.GetIndexInfoResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexInfoRequest) (*indexpb.GetIndexInfoResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexInfoRequest) *indexpb.GetIndexInfoResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*indexpb.GetIndexInfoResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetIndexInfoRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetIndexInfos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIndexInfos'
+type MockDataCoord_GetIndexInfos_Call struct {
+	*mock.Call
+}
+
+// GetIndexInfos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *indexpb.GetIndexInfoRequest
+func (_e *MockDataCoord_Expecter) GetIndexInfos(ctx interface{}, req interface{}) *MockDataCoord_GetIndexInfos_Call {
+	return &MockDataCoord_GetIndexInfos_Call{Call: _e.mock.On("GetIndexInfos", ctx, req)}
+}
+
+func (_c *MockDataCoord_GetIndexInfos_Call) Run(run func(ctx context.Context, req *indexpb.GetIndexInfoRequest)) *MockDataCoord_GetIndexInfos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*indexpb.GetIndexInfoRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetIndexInfos_Call) Return(_a0 *indexpb.GetIndexInfoResponse, _a1 error) *MockDataCoord_GetIndexInfos_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetIndexInfos_Call) RunAndReturn(run func(context.Context, *indexpb.GetIndexInfoRequest) (*indexpb.GetIndexInfoResponse, error)) *MockDataCoord_GetIndexInfos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetIndexState provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) GetIndexState(ctx context.Context, req *indexpb.GetIndexStateRequest) (*indexpb.GetIndexStateResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *indexpb.GetIndexStateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexStateRequest) (*indexpb.GetIndexStateResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexStateRequest) *indexpb.GetIndexStateResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*indexpb.GetIndexStateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetIndexStateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetIndexState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIndexState'
+type MockDataCoord_GetIndexState_Call struct {
+	*mock.Call
+}
+
+// GetIndexState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *indexpb.GetIndexStateRequest
+func (_e *MockDataCoord_Expecter) GetIndexState(ctx interface{}, req interface{}) *MockDataCoord_GetIndexState_Call {
+	return &MockDataCoord_GetIndexState_Call{Call: _e.mock.On("GetIndexState", ctx, req)}
+}
+
+func (_c *MockDataCoord_GetIndexState_Call) Run(run func(ctx context.Context, req *indexpb.GetIndexStateRequest)) *MockDataCoord_GetIndexState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*indexpb.GetIndexStateRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetIndexState_Call) Return(_a0 *indexpb.GetIndexStateResponse, _a1 error) *MockDataCoord_GetIndexState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetIndexState_Call) RunAndReturn(run func(context.Context, *indexpb.GetIndexStateRequest) (*indexpb.GetIndexStateResponse, error)) *MockDataCoord_GetIndexState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetIndexStatistics provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) GetIndexStatistics(ctx context.Context, req *indexpb.GetIndexStatisticsRequest) (*indexpb.GetIndexStatisticsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *indexpb.GetIndexStatisticsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexStatisticsRequest) (*indexpb.GetIndexStatisticsResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexStatisticsRequest) *indexpb.GetIndexStatisticsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*indexpb.GetIndexStatisticsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetIndexStatisticsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetIndexStatistics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIndexStatistics'
+type MockDataCoord_GetIndexStatistics_Call struct {
+	*mock.Call
+}
+
+// GetIndexStatistics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *indexpb.GetIndexStatisticsRequest
+func (_e *MockDataCoord_Expecter) GetIndexStatistics(ctx interface{}, req interface{}) *MockDataCoord_GetIndexStatistics_Call {
+	return &MockDataCoord_GetIndexStatistics_Call{Call: _e.mock.On("GetIndexStatistics", ctx, req)}
+}
+
+func (_c *MockDataCoord_GetIndexStatistics_Call) Run(run func(ctx context.Context, req *indexpb.GetIndexStatisticsRequest)) *MockDataCoord_GetIndexStatistics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*indexpb.GetIndexStatisticsRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetIndexStatistics_Call) Return(_a0 *indexpb.GetIndexStatisticsResponse, _a1 error) *MockDataCoord_GetIndexStatistics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetIndexStatistics_Call) RunAndReturn(run func(context.Context, *indexpb.GetIndexStatisticsRequest) (*indexpb.GetIndexStatisticsResponse, error)) *MockDataCoord_GetIndexStatistics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetInsertBinlogPaths provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) GetInsertBinlogPaths(ctx context.Context, req *datapb.GetInsertBinlogPathsRequest) (*datapb.GetInsertBinlogPathsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *datapb.GetInsertBinlogPathsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetInsertBinlogPathsRequest) (*datapb.GetInsertBinlogPathsResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetInsertBinlogPathsRequest) *datapb.GetInsertBinlogPathsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetInsertBinlogPathsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetInsertBinlogPathsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetInsertBinlogPaths_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInsertBinlogPaths'
+type MockDataCoord_GetInsertBinlogPaths_Call struct {
+	*mock.Call
+}
+
+// GetInsertBinlogPaths is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *datapb.GetInsertBinlogPathsRequest
+func (_e *MockDataCoord_Expecter) GetInsertBinlogPaths(ctx interface{}, req interface{}) *MockDataCoord_GetInsertBinlogPaths_Call {
+	return &MockDataCoord_GetInsertBinlogPaths_Call{Call: _e.mock.On("GetInsertBinlogPaths", ctx, req)}
+}
+
+func (_c *MockDataCoord_GetInsertBinlogPaths_Call) Run(run func(ctx context.Context, req *datapb.GetInsertBinlogPathsRequest)) *MockDataCoord_GetInsertBinlogPaths_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.GetInsertBinlogPathsRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetInsertBinlogPaths_Call) Return(_a0 *datapb.GetInsertBinlogPathsResponse, _a1 error) *MockDataCoord_GetInsertBinlogPaths_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetInsertBinlogPaths_Call) RunAndReturn(run func(context.Context, *datapb.GetInsertBinlogPathsRequest) (*datapb.GetInsertBinlogPathsResponse, error)) *MockDataCoord_GetInsertBinlogPaths_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMetrics provides a mock function with given fields: ctx, req
 func (_m *MockDataCoord) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	ret := _m.Called(ctx, req)
 
-	var r0 *milvuspb.GetMetricsResponse
+	var r0 *milvuspb.GetMetricsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetMetricsRequest) *milvuspb.GetMetricsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.GetMetricsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetMetricsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetMetrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMetrics'
+type MockDataCoord_GetMetrics_Call struct {
+	*mock.Call
+}
+
+// GetMetrics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *milvuspb.GetMetricsRequest
+func (_e *MockDataCoord_Expecter) GetMetrics(ctx interface{}, req interface{}) *MockDataCoord_GetMetrics_Call {
+	return &MockDataCoord_GetMetrics_Call{Call: _e.mock.On("GetMetrics", ctx, req)}
+}
+
+func (_c *MockDataCoord_GetMetrics_Call) Run(run func(ctx context.Context, req *milvuspb.GetMetricsRequest)) *MockDataCoord_GetMetrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.GetMetricsRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetMetrics_Call) Return(_a0 *milvuspb.GetMetricsResponse, _a1 error) *MockDataCoord_GetMetrics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetMetrics_Call) RunAndReturn(run func(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)) *MockDataCoord_GetMetrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPartitionStatistics provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) GetPartitionStatistics(ctx context.Context, req *datapb.GetPartitionStatisticsRequest) (*datapb.GetPartitionStatisticsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *datapb.GetPartitionStatisticsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetPartitionStatisticsRequest) (*datapb.GetPartitionStatisticsResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetPartitionStatisticsRequest) *datapb.GetPartitionStatisticsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetPartitionStatisticsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetPartitionStatisticsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetPartitionStatistics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPartitionStatistics'
+type MockDataCoord_GetPartitionStatistics_Call struct {
+	*mock.Call
+}
+
+// GetPartitionStatistics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *datapb.GetPartitionStatisticsRequest
+func (_e *MockDataCoord_Expecter) GetPartitionStatistics(ctx interface{}, req interface{}) *MockDataCoord_GetPartitionStatistics_Call {
+	return &MockDataCoord_GetPartitionStatistics_Call{Call: _e.mock.On("GetPartitionStatistics", ctx, req)}
+}
+
+func (_c *MockDataCoord_GetPartitionStatistics_Call) Run(run func(ctx context.Context, req *datapb.GetPartitionStatisticsRequest)) *MockDataCoord_GetPartitionStatistics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.GetPartitionStatisticsRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetPartitionStatistics_Call) Return(_a0 *datapb.GetPartitionStatisticsResponse, _a1 error) *MockDataCoord_GetPartitionStatistics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetPartitionStatistics_Call) RunAndReturn(run func(context.Context, *datapb.GetPartitionStatisticsRequest) (*datapb.GetPartitionStatisticsResponse, error)) *MockDataCoord_GetPartitionStatistics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRecoveryInfo provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) GetRecoveryInfo(ctx context.Context, req *datapb.GetRecoveryInfoRequest) (*datapb.GetRecoveryInfoResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *datapb.GetRecoveryInfoResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetRecoveryInfoRequest) (*datapb.GetRecoveryInfoResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetRecoveryInfoRequest) *datapb.GetRecoveryInfoResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetRecoveryInfoResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetRecoveryInfoRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetRecoveryInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecoveryInfo'
+type MockDataCoord_GetRecoveryInfo_Call struct {
+	*mock.Call
+}
+
+// GetRecoveryInfo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *datapb.GetRecoveryInfoRequest
+func (_e *MockDataCoord_Expecter) GetRecoveryInfo(ctx interface{}, req interface{}) *MockDataCoord_GetRecoveryInfo_Call {
+	return &MockDataCoord_GetRecoveryInfo_Call{Call: _e.mock.On("GetRecoveryInfo", ctx, req)}
+}
+
+func (_c *MockDataCoord_GetRecoveryInfo_Call) Run(run func(ctx context.Context, req *datapb.GetRecoveryInfoRequest)) *MockDataCoord_GetRecoveryInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.GetRecoveryInfoRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetRecoveryInfo_Call) Return(_a0 *datapb.GetRecoveryInfoResponse, _a1 error) *MockDataCoord_GetRecoveryInfo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetRecoveryInfo_Call) RunAndReturn(run func(context.Context, *datapb.GetRecoveryInfoRequest) (*datapb.GetRecoveryInfoResponse, error)) *MockDataCoord_GetRecoveryInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRecoveryInfoV2 provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) GetRecoveryInfoV2(ctx context.Context, req *datapb.GetRecoveryInfoRequestV2) (*datapb.GetRecoveryInfoResponseV2, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *datapb.GetRecoveryInfoResponseV2
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetRecoveryInfoRequestV2) (*datapb.GetRecoveryInfoResponseV2, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetRecoveryInfoRequestV2) *datapb.GetRecoveryInfoResponseV2); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetRecoveryInfoResponseV2)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetRecoveryInfoRequestV2) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetRecoveryInfoV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecoveryInfoV2'
+type MockDataCoord_GetRecoveryInfoV2_Call struct {
+	*mock.Call
+}
+
+// GetRecoveryInfoV2 is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *datapb.GetRecoveryInfoRequestV2
+func (_e *MockDataCoord_Expecter) GetRecoveryInfoV2(ctx interface{}, req interface{}) *MockDataCoord_GetRecoveryInfoV2_Call {
+	return &MockDataCoord_GetRecoveryInfoV2_Call{Call: _e.mock.On("GetRecoveryInfoV2", ctx, req)}
+}
+
+func (_c *MockDataCoord_GetRecoveryInfoV2_Call) Run(run func(ctx context.Context, req *datapb.GetRecoveryInfoRequestV2)) *MockDataCoord_GetRecoveryInfoV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.GetRecoveryInfoRequestV2))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetRecoveryInfoV2_Call) Return(_a0 *datapb.GetRecoveryInfoResponseV2, _a1 error) *MockDataCoord_GetRecoveryInfoV2_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetRecoveryInfoV2_Call) RunAndReturn(run func(context.Context, *datapb.GetRecoveryInfoRequestV2) (*datapb.GetRecoveryInfoResponseV2, error)) *MockDataCoord_GetRecoveryInfoV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSegmentIndexState provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) GetSegmentIndexState(ctx context.Context, req *indexpb.GetSegmentIndexStateRequest) (*indexpb.GetSegmentIndexStateResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *indexpb.GetSegmentIndexStateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetSegmentIndexStateRequest) (*indexpb.GetSegmentIndexStateResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetSegmentIndexStateRequest) *indexpb.GetSegmentIndexStateResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*indexpb.GetSegmentIndexStateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetSegmentIndexStateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetSegmentIndexState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSegmentIndexState'
+type MockDataCoord_GetSegmentIndexState_Call struct {
+	*mock.Call
+}
+
+// GetSegmentIndexState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *indexpb.GetSegmentIndexStateRequest
+func (_e *MockDataCoord_Expecter) GetSegmentIndexState(ctx interface{}, req interface{}) *MockDataCoord_GetSegmentIndexState_Call {
+	return &MockDataCoord_GetSegmentIndexState_Call{Call: _e.mock.On("GetSegmentIndexState", ctx, req)}
+}
+
+func (_c *MockDataCoord_GetSegmentIndexState_Call) Run(run func(ctx context.Context, req *indexpb.GetSegmentIndexStateRequest)) *MockDataCoord_GetSegmentIndexState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*indexpb.GetSegmentIndexStateRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetSegmentIndexState_Call) Return(_a0 *indexpb.GetSegmentIndexStateResponse, _a1 error) *MockDataCoord_GetSegmentIndexState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetSegmentIndexState_Call) RunAndReturn(run func(context.Context, *indexpb.GetSegmentIndexStateRequest) (*indexpb.GetSegmentIndexStateResponse, error)) *MockDataCoord_GetSegmentIndexState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSegmentInfo provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) GetSegmentInfo(ctx context.Context, req *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *datapb.GetSegmentInfoResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentInfoRequest) *datapb.GetSegmentInfoResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetSegmentInfoResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetSegmentInfoRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetSegmentInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSegmentInfo'
+type MockDataCoord_GetSegmentInfo_Call struct {
+	*mock.Call
+}
+
+// GetSegmentInfo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *datapb.GetSegmentInfoRequest
+func (_e *MockDataCoord_Expecter) GetSegmentInfo(ctx interface{}, req interface{}) *MockDataCoord_GetSegmentInfo_Call {
+	return &MockDataCoord_GetSegmentInfo_Call{Call: _e.mock.On("GetSegmentInfo", ctx, req)}
+}
+
+func (_c *MockDataCoord_GetSegmentInfo_Call) Run(run func(ctx context.Context, req *datapb.GetSegmentInfoRequest)) *MockDataCoord_GetSegmentInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.GetSegmentInfoRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetSegmentInfo_Call) Return(_a0 *datapb.GetSegmentInfoResponse, _a1 error) *MockDataCoord_GetSegmentInfo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetSegmentInfo_Call) RunAndReturn(run func(context.Context, *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error)) *MockDataCoord_GetSegmentInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSegmentInfoChannel provides a mock function with given fields: ctx
+func (_m *MockDataCoord) GetSegmentInfoChannel(ctx context.Context) (*milvuspb.StringResponse, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *milvuspb.StringResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*milvuspb.StringResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *milvuspb.StringResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.StringResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetSegmentInfoChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSegmentInfoChannel'
+type MockDataCoord_GetSegmentInfoChannel_Call struct {
+	*mock.Call
+}
+
+// GetSegmentInfoChannel is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockDataCoord_Expecter) GetSegmentInfoChannel(ctx interface{}) *MockDataCoord_GetSegmentInfoChannel_Call {
+	return &MockDataCoord_GetSegmentInfoChannel_Call{Call: _e.mock.On("GetSegmentInfoChannel", ctx)}
+}
+
+func (_c *MockDataCoord_GetSegmentInfoChannel_Call) Run(run func(ctx context.Context)) *MockDataCoord_GetSegmentInfoChannel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetSegmentInfoChannel_Call) Return(_a0 *milvuspb.StringResponse, _a1 error) *MockDataCoord_GetSegmentInfoChannel_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetSegmentInfoChannel_Call) RunAndReturn(run func(context.Context) (*milvuspb.StringResponse, error)) *MockDataCoord_GetSegmentInfoChannel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSegmentStates provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) GetSegmentStates(ctx context.Context, req *datapb.GetSegmentStatesRequest) (*datapb.GetSegmentStatesResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *datapb.GetSegmentStatesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentStatesRequest) (*datapb.GetSegmentStatesResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentStatesRequest) *datapb.GetSegmentStatesResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetSegmentStatesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetSegmentStatesRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetSegmentStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSegmentStates'
+type MockDataCoord_GetSegmentStates_Call struct {
+	*mock.Call
+}
+
+// GetSegmentStates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *datapb.GetSegmentStatesRequest
+func (_e *MockDataCoord_Expecter) GetSegmentStates(ctx interface{}, req interface{}) *MockDataCoord_GetSegmentStates_Call {
+	return &MockDataCoord_GetSegmentStates_Call{Call: _e.mock.On("GetSegmentStates", ctx, req)}
+}
+
+func (_c *MockDataCoord_GetSegmentStates_Call) Run(run func(ctx context.Context, req *datapb.GetSegmentStatesRequest)) *MockDataCoord_GetSegmentStates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.GetSegmentStatesRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetSegmentStates_Call) Return(_a0 *datapb.GetSegmentStatesResponse, _a1 error) *MockDataCoord_GetSegmentStates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetSegmentStates_Call) RunAndReturn(run func(context.Context, *datapb.GetSegmentStatesRequest) (*datapb.GetSegmentStatesResponse, error)) *MockDataCoord_GetSegmentStates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSegmentsByStates provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) GetSegmentsByStates(ctx context.Context, req *datapb.GetSegmentsByStatesRequest) (*datapb.GetSegmentsByStatesResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *datapb.GetSegmentsByStatesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentsByStatesRequest) (*datapb.GetSegmentsByStatesResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentsByStatesRequest) *datapb.GetSegmentsByStatesResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetSegmentsByStatesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetSegmentsByStatesRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetSegmentsByStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSegmentsByStates'
+type MockDataCoord_GetSegmentsByStates_Call struct {
+	*mock.Call
+}
+
+// GetSegmentsByStates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *datapb.GetSegmentsByStatesRequest
+func (_e *MockDataCoord_Expecter) GetSegmentsByStates(ctx interface{}, req interface{}) *MockDataCoord_GetSegmentsByStates_Call {
+	return &MockDataCoord_GetSegmentsByStates_Call{Call: _e.mock.On("GetSegmentsByStates", ctx, req)}
+}
+
+func (_c *MockDataCoord_GetSegmentsByStates_Call) Run(run func(ctx context.Context, req *datapb.GetSegmentsByStatesRequest)) *MockDataCoord_GetSegmentsByStates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.GetSegmentsByStatesRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetSegmentsByStates_Call) Return(_a0 *datapb.GetSegmentsByStatesResponse, _a1 error) *MockDataCoord_GetSegmentsByStates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetSegmentsByStates_Call) RunAndReturn(run func(context.Context, *datapb.GetSegmentsByStatesRequest) (*datapb.GetSegmentsByStatesResponse, error)) *MockDataCoord_GetSegmentsByStates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetShardWriteStats provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) GetShardWriteStats(ctx context.Context, req *datapb.GetShardWriteStatsRequest) (*datapb.GetShardWriteStatsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *datapb.GetShardWriteStatsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetShardWriteStatsRequest) (*datapb.GetShardWriteStatsResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetShardWriteStatsRequest) *datapb.GetShardWriteStatsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetShardWriteStatsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetShardWriteStatsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetShardWriteStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetShardWriteStats'
+type MockDataCoord_GetShardWriteStats_Call struct {
+	*mock.Call
+}
+
+// GetShardWriteStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *datapb.GetShardWriteStatsRequest
+func (_e *MockDataCoord_Expecter) GetShardWriteStats(ctx interface{}, req interface{}) *MockDataCoord_GetShardWriteStats_Call {
+	return &MockDataCoord_GetShardWriteStats_Call{Call: _e.mock.On("GetShardWriteStats", ctx, req)}
+}
+
+func (_c *MockDataCoord_GetShardWriteStats_Call) Run(run func(ctx context.Context, req *datapb.GetShardWriteStatsRequest)) *MockDataCoord_GetShardWriteStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.GetShardWriteStatsRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetShardWriteStats_Call) Return(_a0 *datapb.GetShardWriteStatsResponse, _a1 error) *MockDataCoord_GetShardWriteStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetShardWriteStats_Call) RunAndReturn(run func(context.Context, *datapb.GetShardWriteStatsRequest) (*datapb.GetShardWriteStatsResponse, error)) *MockDataCoord_GetShardWriteStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStatisticsChannel provides a mock function with given fields: ctx
+func (_m *MockDataCoord) GetStatisticsChannel(ctx context.Context) (*milvuspb.StringResponse, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *milvuspb.StringResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*milvuspb.StringResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *milvuspb.StringResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.StringResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetStatisticsChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatisticsChannel'
+type MockDataCoord_GetStatisticsChannel_Call struct {
+	*mock.Call
+}
+
+// GetStatisticsChannel is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockDataCoord_Expecter) GetStatisticsChannel(ctx interface{}) *MockDataCoord_GetStatisticsChannel_Call {
+	return &MockDataCoord_GetStatisticsChannel_Call{Call: _e.mock.On("GetStatisticsChannel", ctx)}
+}
+
+func (_c *MockDataCoord_GetStatisticsChannel_Call) Run(run func(ctx context.Context)) *MockDataCoord_GetStatisticsChannel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetStatisticsChannel_Call) Return(_a0 *milvuspb.StringResponse, _a1 error) *MockDataCoord_GetStatisticsChannel_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetStatisticsChannel_Call) RunAndReturn(run func(context.Context) (*milvuspb.StringResponse, error)) *MockDataCoord_GetStatisticsChannel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStorageConsistencyReport provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) GetStorageConsistencyReport(ctx context.Context, req *datapb.GetStorageConsistencyReportRequest) (*datapb.GetStorageConsistencyReportResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *datapb.GetStorageConsistencyReportResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetStorageConsistencyReportRequest) (*datapb.GetStorageConsistencyReportResponse, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetMetricsRequest) *milvuspb.GetMetricsResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetStorageConsistencyReportRequest) *datapb.GetStorageConsistencyReportResponse); ok {
 		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.GetMetricsResponse)
+			r0 = ret.Get(0).(*datapb.GetStorageConsistencyReportResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetMetricsRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetStorageConsistencyReportRequest) error); ok {
 		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -1214,53 +2752,107 @@ func (_m *MockDataCoord) GetMetrics(ctx context.Context, req *milvuspb.GetMetric
 	return r0, r1
 }
 
-// MockDataCoord_GetMetrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMetrics'
-type MockDataCoord_GetMetrics_Call struct {
+// MockDataCoord_GetStorageConsistencyReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStorageConsistencyReport'
+type MockDataCoord_GetStorageConsistencyReport_Call struct {
 	*mock.Call
 }
 
-// GetMetrics is a helper method to define mock.On call
+// GetStorageConsistencyReport is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *milvuspb.GetMetricsRequest
-func (_e *MockDataCoord_Expecter) GetMetrics(ctx interface{}, req interface{}) *MockDataCoord_GetMetrics_Call {
-	return &MockDataCoord_GetMetrics_Call{Call: _e.mock.On("GetMetrics", ctx, req)}
+//   - req *datapb.GetStorageConsistencyReportRequest
+func (_e *MockDataCoord_Expecter) GetStorageConsistencyReport(ctx interface{}, req interface{}) *MockDataCoord_GetStorageConsistencyReport_Call {
+	return &MockDataCoord_GetStorageConsistencyReport_Call{Call: _e.mock.On("GetStorageConsistencyReport", ctx, req)}
 }
 
-func (_c *MockDataCoord_GetMetrics_Call) Run(run func(ctx context.Context, req *milvuspb.GetMetricsRequest)) *MockDataCoord_GetMetrics_Call {
+func (_c *MockDataCoord_GetStorageConsistencyReport_Call) Run(run func(ctx context.Context, req *datapb.GetStorageConsistencyReportRequest)) *MockDataCoord_GetStorageConsistencyReport_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*milvuspb.GetMetricsRequest))
+		run(args[0].(context.Context), args[1].(*datapb.GetStorageConsistencyReportRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetMetrics_Call) Return(_a0 *milvuspb.GetMetricsResponse, _a1 error) *MockDataCoord_GetMetrics_Call {
+func (_c *MockDataCoord_GetStorageConsistencyReport_Call) Return(_a0 *datapb.GetStorageConsistencyReportResponse, _a1 error) *MockDataCoord_GetStorageConsistencyReport_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetMetrics_Call) RunAndReturn(run func(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)) *MockDataCoord_GetMetrics_Call {
+func (_c *MockDataCoord_GetStorageConsistencyReport_Call) RunAndReturn(run func(context.Context, *datapb.GetStorageConsistencyReportRequest) (*datapb.GetStorageConsistencyReportResponse, error)) *MockDataCoord_GetStorageConsistencyReport_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPartitionStatistics provides a mock function with given fields: ctx, req
-func (_m *MockDataCoord) GetPartitionStatistics(ctx context.Context, req *datapb.GetPartitionStatisticsRequest) (*datapb.GetPartitionStatisticsResponse, error) {
+// GetTimeTickChannel provides a mock function with given fields: ctx
+func (_m *MockDataCoord) GetTimeTickChannel(ctx context.Context) (*milvuspb.StringResponse, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *milvuspb.StringResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*milvuspb.StringResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *milvuspb.StringResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.StringResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetTimeTickChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTimeTickChannel'
+type MockDataCoord_GetTimeTickChannel_Call struct {
+	*mock.Call
+}
+
+// GetTimeTickChannel is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockDataCoord_Expecter) GetTimeTickChannel(ctx interface{}) *MockDataCoord_GetTimeTickChannel_Call {
+	return &MockDataCoord_GetTimeTickChannel_Call{Call: _e.mock.On("GetTimeTickChannel", ctx)}
+}
+
+func (_c *MockDataCoord_GetTimeTickChannel_Call) Run(run func(ctx context.Context)) *MockDataCoord_GetTimeTickChannel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetTimeTickChannel_Call) Return(_a0 *milvuspb.StringResponse, _a1 error) *MockDataCoord_GetTimeTickChannel_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetTimeTickChannel_Call) RunAndReturn(run func(context.Context) (*milvuspb.StringResponse, error)) *MockDataCoord_GetTimeTickChannel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTopologySnapshot provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) GetTopologySnapshot(ctx context.Context, req *datapb.GetTopologySnapshotRequest) (*datapb.GetTopologySnapshotResponse, error) {
 	ret := _m.Called(ctx, req)
 
-	var r0 *datapb.GetPartitionStatisticsResponse
+	var r0 *datapb.GetTopologySnapshotResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetPartitionStatisticsRequest) (*datapb.GetPartitionStatisticsResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetTopologySnapshotRequest) (*datapb.GetTopologySnapshotResponse, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetPartitionStatisticsRequest) *datapb.GetPartitionStatisticsResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetTopologySnapshotRequest) *datapb.GetTopologySnapshotResponse); ok {
 		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datapb.GetPartitionStatisticsResponse)
+			r0 = ret.Get(0).(*datapb.GetTopologySnapshotResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetPartitionStatisticsRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetTopologySnapshotRequest) error); ok {
 		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -1269,53 +2861,149 @@ func (_m *MockDataCoord) GetPartitionStatistics(ctx context.Context, req *datapb
 	return r0, r1
 }
 
-// MockDataCoord_GetPartitionStatistics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPartitionStatistics'
-type MockDataCoord_GetPartitionStatistics_Call struct {
+// MockDataCoord_GetTopologySnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTopologySnapshot'
+type MockDataCoord_GetTopologySnapshot_Call struct {
 	*mock.Call
 }
 
-// GetPartitionStatistics is a helper method to define mock.On call
+// GetTopologySnapshot is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *datapb.GetPartitionStatisticsRequest
-func (_e *MockDataCoord_Expecter) GetPartitionStatistics(ctx interface{}, req interface{}) *MockDataCoord_GetPartitionStatistics_Call {
-	return &MockDataCoord_GetPartitionStatistics_Call{Call: _e.mock.On("GetPartitionStatistics", ctx, req)}
+//   - req *datapb.GetTopologySnapshotRequest
+func (_e *MockDataCoord_Expecter) GetTopologySnapshot(ctx interface{}, req interface{}) *MockDataCoord_GetTopologySnapshot_Call {
+	return &MockDataCoord_GetTopologySnapshot_Call{Call: _e.mock.On("GetTopologySnapshot", ctx, req)}
 }
 
-func (_c *MockDataCoord_GetPartitionStatistics_Call) Run(run func(ctx context.Context, req *datapb.GetPartitionStatisticsRequest)) *MockDataCoord_GetPartitionStatistics_Call {
+func (_c *MockDataCoord_GetTopologySnapshot_Call) Run(run func(ctx context.Context, req *datapb.GetTopologySnapshotRequest)) *MockDataCoord_GetTopologySnapshot_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*datapb.GetPartitionStatisticsRequest))
+		run(args[0].(context.Context), args[1].(*datapb.GetTopologySnapshotRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetTopologySnapshot_Call) Return(_a0 *datapb.GetTopologySnapshotResponse, _a1 error) *MockDataCoord_GetTopologySnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetTopologySnapshot_Call) RunAndReturn(run func(context.Context, *datapb.GetTopologySnapshotRequest) (*datapb.GetTopologySnapshotResponse, error)) *MockDataCoord_GetTopologySnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Import provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) Import(ctx context.Context, req *datapb.ImportTaskRequest) (*datapb.ImportTaskResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *datapb.ImportTaskResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ImportTaskRequest) (*datapb.ImportTaskResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ImportTaskRequest) *datapb.ImportTaskResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.ImportTaskResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ImportTaskRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_Import_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Import'
+type MockDataCoord_Import_Call struct {
+	*mock.Call
+}
+
+// Import is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *datapb.ImportTaskRequest
+func (_e *MockDataCoord_Expecter) Import(ctx interface{}, req interface{}) *MockDataCoord_Import_Call {
+	return &MockDataCoord_Import_Call{Call: _e.mock.On("Import", ctx, req)}
+}
+
+func (_c *MockDataCoord_Import_Call) Run(run func(ctx context.Context, req *datapb.ImportTaskRequest)) *MockDataCoord_Import_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.ImportTaskRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_Import_Call) Return(_a0 *datapb.ImportTaskResponse, _a1 error) *MockDataCoord_Import_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_Import_Call) RunAndReturn(run func(context.Context, *datapb.ImportTaskRequest) (*datapb.ImportTaskResponse, error)) *MockDataCoord_Import_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Init provides a mock function with given fields:
+func (_m *MockDataCoord) Init() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDataCoord_Init_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Init'
+type MockDataCoord_Init_Call struct {
+	*mock.Call
+}
+
+// Init is a helper method to define mock.On call
+func (_e *MockDataCoord_Expecter) Init() *MockDataCoord_Init_Call {
+	return &MockDataCoord_Init_Call{Call: _e.mock.On("Init")}
+}
+
+func (_c *MockDataCoord_Init_Call) Run(run func()) *MockDataCoord_Init_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetPartitionStatistics_Call) Return(_a0 *datapb.GetPartitionStatisticsResponse, _a1 error) *MockDataCoord_GetPartitionStatistics_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockDataCoord_Init_Call) Return(_a0 error) *MockDataCoord_Init_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockDataCoord_GetPartitionStatistics_Call) RunAndReturn(run func(context.Context, *datapb.GetPartitionStatisticsRequest) (*datapb.GetPartitionStatisticsResponse, error)) *MockDataCoord_GetPartitionStatistics_Call {
+func (_c *MockDataCoord_Init_Call) RunAndReturn(run func() error) *MockDataCoord_Init_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRecoveryInfo provides a mock function with given fields: ctx, req
-func (_m *MockDataCoord) GetRecoveryInfo(ctx context.Context, req *datapb.GetRecoveryInfoRequest) (*datapb.GetRecoveryInfoResponse, error) {
+// ListMaintenancePolicies provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) ListMaintenancePolicies(ctx context.Context, req *datapb.ListMaintenancePoliciesRequest) (*datapb.ListMaintenancePoliciesResponse, error) {
 	ret := _m.Called(ctx, req)
 
-	var r0 *datapb.GetRecoveryInfoResponse
+	var r0 *datapb.ListMaintenancePoliciesResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetRecoveryInfoRequest) (*datapb.GetRecoveryInfoResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListMaintenancePoliciesRequest) (*datapb.ListMaintenancePoliciesResponse, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetRecoveryInfoRequest) *datapb.GetRecoveryInfoResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListMaintenancePoliciesRequest) *datapb.ListMaintenancePoliciesResponse); ok {
 		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datapb.GetRecoveryInfoResponse)
+			r0 = ret.Get(0).(*datapb.ListMaintenancePoliciesResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetRecoveryInfoRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ListMaintenancePoliciesRequest) error); ok {
 		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -1324,53 +3012,53 @@ func (_m *MockDataCoord) GetRecoveryInfo(ctx context.Context, req *datapb.GetRec
 	return r0, r1
 }
 
-// MockDataCoord_GetRecoveryInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecoveryInfo'
-type MockDataCoord_GetRecoveryInfo_Call struct {
+// MockDataCoord_ListMaintenancePolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMaintenancePolicies'
+type MockDataCoord_ListMaintenancePolicies_Call struct {
 	*mock.Call
 }
 
-// GetRecoveryInfo is a helper method to define mock.On call
+// ListMaintenancePolicies is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *datapb.GetRecoveryInfoRequest
-func (_e *MockDataCoord_Expecter) GetRecoveryInfo(ctx interface{}, req interface{}) *MockDataCoord_GetRecoveryInfo_Call {
-	return &MockDataCoord_GetRecoveryInfo_Call{Call: _e.mock.On("GetRecoveryInfo", ctx, req)}
+//   - req *datapb.ListMaintenancePoliciesRequest
+func (_e *MockDataCoord_Expecter) ListMaintenancePolicies(ctx interface{}, req interface{}) *MockDataCoord_ListMaintenancePolicies_Call {
+	return &MockDataCoord_ListMaintenancePolicies_Call{Call: _e.mock.On("ListMaintenancePolicies", ctx, req)}
 }
 
-func (_c *MockDataCoord_GetRecoveryInfo_Call) Run(run func(ctx context.Context, req *datapb.GetRecoveryInfoRequest)) *MockDataCoord_GetRecoveryInfo_Call {
+func (_c *MockDataCoord_ListMaintenancePolicies_Call) Run(run func(ctx context.Context, req *datapb.ListMaintenancePoliciesRequest)) *MockDataCoord_ListMaintenancePolicies_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*datapb.GetRecoveryInfoRequest))
+		run(args[0].(context.Context), args[1].(*datapb.ListMaintenancePoliciesRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetRecoveryInfo_Call) Return(_a0 *datapb.GetRecoveryInfoResponse, _a1 error) *MockDataCoord_GetRecoveryInfo_Call {
+func (_c *MockDataCoord_ListMaintenancePolicies_Call) Return(_a0 *datapb.ListMaintenancePoliciesResponse, _a1 error) *MockDataCoord_ListMaintenancePolicies_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetRecoveryInfo_Call) RunAndReturn(run func(context.Context, *datapb.GetRecoveryInfoRequest) (*datapb.GetRecoveryInfoResponse, error)) *MockDataCoord_GetRecoveryInfo_Call {
+func (_c *MockDataCoord_ListMaintenancePolicies_Call) RunAndReturn(run func(context.Context, *datapb.ListMaintenancePoliciesRequest) (*datapb.ListMaintenancePoliciesResponse, error)) *MockDataCoord_ListMaintenancePolicies_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRecoveryInfoV2 provides a mock function with given fields: ctx, req
-func (_m *MockDataCoord) GetRecoveryInfoV2(ctx context.Context, req *datapb.GetRecoveryInfoRequestV2) (*datapb.GetRecoveryInfoResponseV2, error) {
+// ListNodeConfigs provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) ListNodeConfigs(ctx context.Context, req *datapb.ListNodeConfigsRequest) (*datapb.ListNodeConfigsResponse, error) {
 	ret := _m.Called(ctx, req)
 
-	var r0 *datapb.GetRecoveryInfoResponseV2
+	var r0 *datapb.ListNodeConfigsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetRecoveryInfoRequestV2) (*datapb.GetRecoveryInfoResponseV2, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListNodeConfigsRequest) (*datapb.ListNodeConfigsResponse, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetRecoveryInfoRequestV2) *datapb.GetRecoveryInfoResponseV2); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListNodeConfigsRequest) *datapb.ListNodeConfigsResponse); ok {
 		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datapb.GetRecoveryInfoResponseV2)
+			r0 = ret.Get(0).(*datapb.ListNodeConfigsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetRecoveryInfoRequestV2) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ListNodeConfigsRequest) error); ok {
 		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -1379,53 +3067,53 @@ func (_m *MockDataCoord) GetRecoveryInfoV2(ctx context.Context, req *datapb.GetR
 	return r0, r1
 }
 
-// MockDataCoord_GetRecoveryInfoV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecoveryInfoV2'
-type MockDataCoord_GetRecoveryInfoV2_Call struct {
+// MockDataCoord_ListNodeConfigs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListNodeConfigs'
+type MockDataCoord_ListNodeConfigs_Call struct {
 	*mock.Call
 }
 
-// GetRecoveryInfoV2 is a helper method to define mock.On call
+// ListNodeConfigs is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *datapb.GetRecoveryInfoRequestV2
-func (_e *MockDataCoord_Expecter) GetRecoveryInfoV2(ctx interface{}, req interface{}) *MockDataCoord_GetRecoveryInfoV2_Call {
-	return &MockDataCoord_GetRecoveryInfoV2_Call{Call: _e.mock.On("GetRecoveryInfoV2", ctx, req)}
+//   - req *datapb.ListNodeConfigsRequest
+func (_e *MockDataCoord_Expecter) ListNodeConfigs(ctx interface{}, req interface{}) *MockDataCoord_ListNodeConfigs_Call {
+	return &MockDataCoord_ListNodeConfigs_Call{Call: _e.mock.On("ListNodeConfigs", ctx, req)}
 }
 
-func (_c *MockDataCoord_GetRecoveryInfoV2_Call) Run(run func(ctx context.Context, req *datapb.GetRecoveryInfoRequestV2)) *MockDataCoord_GetRecoveryInfoV2_Call {
+func (_c *MockDataCoord_ListNodeConfigs_Call) Run(run func(ctx context.Context, req *datapb.ListNodeConfigsRequest)) *MockDataCoord_ListNodeConfigs_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*datapb.GetRecoveryInfoRequestV2))
+		run(args[0].(context.Context), args[1].(*datapb.ListNodeConfigsRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetRecoveryInfoV2_Call) Return(_a0 *datapb.GetRecoveryInfoResponseV2, _a1 error) *MockDataCoord_GetRecoveryInfoV2_Call {
+func (_c *MockDataCoord_ListNodeConfigs_Call) Return(_a0 *datapb.ListNodeConfigsResponse, _a1 error) *MockDataCoord_ListNodeConfigs_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetRecoveryInfoV2_Call) RunAndReturn(run func(context.Context, *datapb.GetRecoveryInfoRequestV2) (*datapb.GetRecoveryInfoResponseV2, error)) *MockDataCoord_GetRecoveryInfoV2_Call {
+func (_c *MockDataCoord_ListNodeConfigs_Call) RunAndReturn(run func(context.Context, *datapb.ListNodeConfigsRequest) (*datapb.ListNodeConfigsResponse, error)) *MockDataCoord_ListNodeConfigs_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSegmentIndexState provides a mock function with given fields: ctx, req
-func (_m *MockDataCoord) GetSegmentIndexState(ctx context.Context, req *indexpb.GetSegmentIndexStateRequest) (*indexpb.GetSegmentIndexStateResponse, error) {
+// ManualCompaction provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) ManualCompaction(ctx context.Context, req *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error) {
 	ret := _m.Called(ctx, req)
 
-	var r0 *indexpb.GetSegmentIndexStateResponse
+	var r0 *milvuspb.ManualCompactionResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetSegmentIndexStateRequest) (*indexpb.GetSegmentIndexStateResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetSegmentIndexStateRequest) *indexpb.GetSegmentIndexStateResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ManualCompactionRequest) *milvuspb.ManualCompactionResponse); ok {
 		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*indexpb.GetSegmentIndexStateResponse)
+			r0 = ret.Get(0).(*milvuspb.ManualCompactionResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetSegmentIndexStateRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.ManualCompactionRequest) error); ok {
 		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -1434,53 +3122,53 @@ func (_m *MockDataCoord) GetSegmentIndexState(ctx context.Context, req *indexpb.
 	return r0, r1
 }
 
-// MockDataCoord_GetSegmentIndexState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSegmentIndexState'
-type MockDataCoord_GetSegmentIndexState_Call struct {
+// MockDataCoord_ManualCompaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ManualCompaction'
+type MockDataCoord_ManualCompaction_Call struct {
 	*mock.Call
 }
 
-// GetSegmentIndexState is a helper method to define mock.On call
+// ManualCompaction is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *indexpb.GetSegmentIndexStateRequest
-func (_e *MockDataCoord_Expecter) GetSegmentIndexState(ctx interface{}, req interface{}) *MockDataCoord_GetSegmentIndexState_Call {
-	return &MockDataCoord_GetSegmentIndexState_Call{Call: _e.mock.On("GetSegmentIndexState", ctx, req)}
+//   - req *milvuspb.ManualCompactionRequest
+func (_e *MockDataCoord_Expecter) ManualCompaction(ctx interface{}, req interface{}) *MockDataCoord_ManualCompaction_Call {
+	return &MockDataCoord_ManualCompaction_Call{Call: _e.mock.On("ManualCompaction", ctx, req)}
 }
 
-func (_c *MockDataCoord_GetSegmentIndexState_Call) Run(run func(ctx context.Context, req *indexpb.GetSegmentIndexStateRequest)) *MockDataCoord_GetSegmentIndexState_Call {
+func (_c *MockDataCoord_ManualCompaction_Call) Run(run func(ctx context.Context, req *milvuspb.ManualCompactionRequest)) *MockDataCoord_ManualCompaction_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*indexpb.GetSegmentIndexStateRequest))
+		run(args[0].(context.Context), args[1].(*milvuspb.ManualCompactionRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetSegmentIndexState_Call) Return(_a0 *indexpb.GetSegmentIndexStateResponse, _a1 error) *MockDataCoord_GetSegmentIndexState_Call {
+func (_c *MockDataCoord_ManualCompaction_Call) Return(_a0 *milvuspb.ManualCompactionResponse, _a1 error) *MockDataCoord_ManualCompaction_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetSegmentIndexState_Call) RunAndReturn(run func(context.Context, *indexpb.GetSegmentIndexStateRequest) (*indexpb.GetSegmentIndexStateResponse, error)) *MockDataCoord_GetSegmentIndexState_Call {
+func (_c *MockDataCoord_ManualCompaction_Call) RunAndReturn(run func(context.Context, *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error)) *MockDataCoord_ManualCompaction_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSegmentInfo provides a mock function with given fields: ctx, req
-func (_m *MockDataCoord) GetSegmentInfo(ctx context.Context, req *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error) {
+// ManualCompactionWithMode provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) ManualCompactionWithMode(ctx context.Context, req *datapb.ManualCompactionWithModeRequest) (*datapb.ManualCompactionWithModeResponse, error) {
 	ret := _m.Called(ctx, req)
 
-	var r0 *datapb.GetSegmentInfoResponse
+	var r0 *datapb.ManualCompactionWithModeResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ManualCompactionWithModeRequest) (*datapb.ManualCompactionWithModeResponse, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentInfoRequest) *datapb.GetSegmentInfoResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ManualCompactionWithModeRequest) *datapb.ManualCompactionWithModeResponse); ok {
 		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datapb.GetSegmentInfoResponse)
+			r0 = ret.Get(0).(*datapb.ManualCompactionWithModeResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetSegmentInfoRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ManualCompactionWithModeRequest) error); ok {
 		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -1489,54 +3177,54 @@ func (_m *MockDataCoord) GetSegmentInfo(ctx context.Context, req *datapb.GetSegm
 	return r0, r1
 }
 
-// MockDataCoord_GetSegmentInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSegmentInfo'
-type MockDataCoord_GetSegmentInfo_Call struct {
+// MockDataCoord_ManualCompactionWithMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ManualCompactionWithMode'
+type MockDataCoord_ManualCompactionWithMode_Call struct {
 	*mock.Call
 }
 
-// GetSegmentInfo is a helper method to define mock.On call
+// ManualCompactionWithMode is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *datapb.GetSegmentInfoRequest
-func (_e *MockDataCoord_Expecter) GetSegmentInfo(ctx interface{}, req interface{}) *MockDataCoord_GetSegmentInfo_Call {
-	return &MockDataCoord_GetSegmentInfo_Call{Call: _e.mock.On("GetSegmentInfo", ctx, req)}
+//   - req *datapb.ManualCompactionWithModeRequest
+func (_e *MockDataCoord_Expecter) ManualCompactionWithMode(ctx interface{}, req interface{}) *MockDataCoord_ManualCompactionWithMode_Call {
+	return &MockDataCoord_ManualCompactionWithMode_Call{Call: _e.mock.On("ManualCompactionWithMode", ctx, req)}
 }
 
-func (_c *MockDataCoord_GetSegmentInfo_Call) Run(run func(ctx context.Context, req *datapb.GetSegmentInfoRequest)) *MockDataCoord_GetSegmentInfo_Call {
+func (_c *MockDataCoord_ManualCompactionWithMode_Call) Run(run func(ctx context.Context, req *datapb.ManualCompactionWithModeRequest)) *MockDataCoord_ManualCompactionWithMode_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*datapb.GetSegmentInfoRequest))
+		run(args[0].(context.Context), args[1].(*datapb.ManualCompactionWithModeRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetSegmentInfo_Call) Return(_a0 *datapb.GetSegmentInfoResponse, _a1 error) *MockDataCoord_GetSegmentInfo_Call {
+func (_c *MockDataCoord_ManualCompactionWithMode_Call) Return(_a0 *datapb.ManualCompactionWithModeResponse, _a1 error) *MockDataCoord_ManualCompactionWithMode_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetSegmentInfo_Call) RunAndReturn(run func(context.Context, *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error)) *MockDataCoord_GetSegmentInfo_Call {
+func (_c *MockDataCoord_ManualCompactionWithMode_Call) RunAndReturn(run func(context.Context, *datapb.ManualCompactionWithModeRequest) (*datapb.ManualCompactionWithModeResponse, error)) *MockDataCoord_ManualCompactionWithMode_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSegmentInfoChannel provides a mock function with given fields: ctx
-func (_m *MockDataCoord) GetSegmentInfoChannel(ctx context.Context) (*milvuspb.StringResponse, error) {
-	ret := _m.Called(ctx)
+// MarkSegmentsDropped provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) MarkSegmentsDropped(ctx context.Context, req *datapb.MarkSegmentsDroppedRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
 
-	var r0 *milvuspb.StringResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (*milvuspb.StringResponse, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.MarkSegmentsDroppedRequest) (*commonpb.Status, error)); ok {
+		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) *milvuspb.StringResponse); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.MarkSegmentsDroppedRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.StringResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.MarkSegmentsDroppedRequest) error); ok {
+		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1544,52 +3232,53 @@ func (_m *MockDataCoord) GetSegmentInfoChannel(ctx context.Context) (*milvuspb.S
 	return r0, r1
 }
 
-// MockDataCoord_GetSegmentInfoChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSegmentInfoChannel'
-type MockDataCoord_GetSegmentInfoChannel_Call struct {
+// MockDataCoord_MarkSegmentsDropped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkSegmentsDropped'
+type MockDataCoord_MarkSegmentsDropped_Call struct {
 	*mock.Call
 }
 
-// GetSegmentInfoChannel is a helper method to define mock.On call
+// MarkSegmentsDropped is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockDataCoord_Expecter) GetSegmentInfoChannel(ctx interface{}) *MockDataCoord_GetSegmentInfoChannel_Call {
-	return &MockDataCoord_GetSegmentInfoChannel_Call{Call: _e.mock.On("GetSegmentInfoChannel", ctx)}
+//   - req *datapb.MarkSegmentsDroppedRequest
+func (_e *MockDataCoord_Expecter) MarkSegmentsDropped(ctx interface{}, req interface{}) *MockDataCoord_MarkSegmentsDropped_Call {
+	return &MockDataCoord_MarkSegmentsDropped_Call{Call: _e.mock.On("MarkSegmentsDropped", ctx, req)}
 }
 
-func (_c *MockDataCoord_GetSegmentInfoChannel_Call) Run(run func(ctx context.Context)) *MockDataCoord_GetSegmentInfoChannel_Call {
+func (_c *MockDataCoord_MarkSegmentsDropped_Call) Run(run func(ctx context.Context, req *datapb.MarkSegmentsDroppedRequest)) *MockDataCoord_MarkSegmentsDropped_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(*datapb.MarkSegmentsDroppedRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetSegmentInfoChannel_Call) Return(_a0 *milvuspb.StringResponse, _a1 error) *MockDataCoord_GetSegmentInfoChannel_Call {
+func (_c *MockDataCoord_MarkSegmentsDropped_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_MarkSegmentsDropped_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetSegmentInfoChannel_Call) RunAndReturn(run func(context.Context) (*milvuspb.StringResponse, error)) *MockDataCoord_GetSegmentInfoChannel_Call {
+func (_c *MockDataCoord_MarkSegmentsDropped_Call) RunAndReturn(run func(context.Context, *datapb.MarkSegmentsDroppedRequest) (*commonpb.Status, error)) *MockDataCoord_MarkSegmentsDropped_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSegmentStates provides a mock function with given fields: ctx, req
-func (_m *MockDataCoord) GetSegmentStates(ctx context.Context, req *datapb.GetSegmentStatesRequest) (*datapb.GetSegmentStatesResponse, error) {
+// MigrateChannelWatchInfos provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) MigrateChannelWatchInfos(ctx context.Context, req *datapb.MigrateChannelWatchInfosRequest) (*datapb.MigrateChannelWatchInfosResponse, error) {
 	ret := _m.Called(ctx, req)
 
-	var r0 *datapb.GetSegmentStatesResponse
+	var r0 *datapb.MigrateChannelWatchInfosResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentStatesRequest) (*datapb.GetSegmentStatesResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.MigrateChannelWatchInfosRequest) (*datapb.MigrateChannelWatchInfosResponse, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentStatesRequest) *datapb.GetSegmentStatesResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.MigrateChannelWatchInfosRequest) *datapb.MigrateChannelWatchInfosResponse); ok {
 		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datapb.GetSegmentStatesResponse)
+			r0 = ret.Get(0).(*datapb.MigrateChannelWatchInfosResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetSegmentStatesRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.MigrateChannelWatchInfosRequest) error); ok {
 		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -1598,53 +3287,53 @@ func (_m *MockDataCoord) GetSegmentStates(ctx context.Context, req *datapb.GetSe
 	return r0, r1
 }
 
-// MockDataCoord_GetSegmentStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSegmentStates'
-type MockDataCoord_GetSegmentStates_Call struct {
+// MockDataCoord_MigrateChannelWatchInfos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MigrateChannelWatchInfos'
+type MockDataCoord_MigrateChannelWatchInfos_Call struct {
 	*mock.Call
 }
 
-// GetSegmentStates is a helper method to define mock.On call
+// MigrateChannelWatchInfos is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *datapb.GetSegmentStatesRequest
-func (_e *MockDataCoord_Expecter) GetSegmentStates(ctx interface{}, req interface{}) *MockDataCoord_GetSegmentStates_Call {
-	return &MockDataCoord_GetSegmentStates_Call{Call: _e.mock.On("GetSegmentStates", ctx, req)}
+//   - req *datapb.MigrateChannelWatchInfosRequest
+func (_e *MockDataCoord_Expecter) MigrateChannelWatchInfos(ctx interface{}, req interface{}) *MockDataCoord_MigrateChannelWatchInfos_Call {
+	return &MockDataCoord_MigrateChannelWatchInfos_Call{Call: _e.mock.On("MigrateChannelWatchInfos", ctx, req)}
 }
 
-func (_c *MockDataCoord_GetSegmentStates_Call) Run(run func(ctx context.Context, req *datapb.GetSegmentStatesRequest)) *MockDataCoord_GetSegmentStates_Call {
+func (_c *MockDataCoord_MigrateChannelWatchInfos_Call) Run(run func(ctx context.Context, req *datapb.MigrateChannelWatchInfosRequest)) *MockDataCoord_MigrateChannelWatchInfos_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*datapb.GetSegmentStatesRequest))
+		run(args[0].(context.Context), args[1].(*datapb.MigrateChannelWatchInfosRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetSegmentStates_Call) Return(_a0 *datapb.GetSegmentStatesResponse, _a1 error) *MockDataCoord_GetSegmentStates_Call {
+func (_c *MockDataCoord_MigrateChannelWatchInfos_Call) Return(_a0 *datapb.MigrateChannelWatchInfosResponse, _a1 error) *MockDataCoord_MigrateChannelWatchInfos_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetSegmentStates_Call) RunAndReturn(run func(context.Context, *datapb.GetSegmentStatesRequest) (*datapb.GetSegmentStatesResponse, error)) *MockDataCoord_GetSegmentStates_Call {
+func (_c *MockDataCoord_MigrateChannelWatchInfos_Call) RunAndReturn(run func(context.Context, *datapb.MigrateChannelWatchInfosRequest) (*datapb.MigrateChannelWatchInfosResponse, error)) *MockDataCoord_MigrateChannelWatchInfos_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSegmentsByStates provides a mock function with given fields: ctx, req
-func (_m *MockDataCoord) GetSegmentsByStates(ctx context.Context, req *datapb.GetSegmentsByStatesRequest) (*datapb.GetSegmentsByStatesResponse, error) {
+// PauseGC provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) PauseGC(ctx context.Context, req *datapb.PauseGCRequest) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, req)
 
-	var r0 *datapb.GetSegmentsByStatesResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentsByStatesRequest) (*datapb.GetSegmentsByStatesResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.PauseGCRequest) (*commonpb.Status, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentsByStatesRequest) *datapb.GetSegmentsByStatesResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.PauseGCRequest) *commonpb.Status); ok {
 		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datapb.GetSegmentsByStatesResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetSegmentsByStatesRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.PauseGCRequest) error); ok {
 		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -1653,54 +3342,54 @@ func (_m *MockDataCoord) GetSegmentsByStates(ctx context.Context, req *datapb.Ge
 	return r0, r1
 }
 
-// MockDataCoord_GetSegmentsByStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSegmentsByStates'
-type MockDataCoord_GetSegmentsByStates_Call struct {
+// MockDataCoord_PauseGC_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseGC'
+type MockDataCoord_PauseGC_Call struct {
 	*mock.Call
 }
 
-// GetSegmentsByStates is a helper method to define mock.On call
+// PauseGC is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *datapb.GetSegmentsByStatesRequest
-func (_e *MockDataCoord_Expecter) GetSegmentsByStates(ctx interface{}, req interface{}) *MockDataCoord_GetSegmentsByStates_Call {
-	return &MockDataCoord_GetSegmentsByStates_Call{Call: _e.mock.On("GetSegmentsByStates", ctx, req)}
+//   - req *datapb.PauseGCRequest
+func (_e *MockDataCoord_Expecter) PauseGC(ctx interface{}, req interface{}) *MockDataCoord_PauseGC_Call {
+	return &MockDataCoord_PauseGC_Call{Call: _e.mock.On("PauseGC", ctx, req)}
 }
 
-func (_c *MockDataCoord_GetSegmentsByStates_Call) Run(run func(ctx context.Context, req *datapb.GetSegmentsByStatesRequest)) *MockDataCoord_GetSegmentsByStates_Call {
+func (_c *MockDataCoord_PauseGC_Call) Run(run func(ctx context.Context, req *datapb.PauseGCRequest)) *MockDataCoord_PauseGC_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*datapb.GetSegmentsByStatesRequest))
+		run(args[0].(context.Context), args[1].(*datapb.PauseGCRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetSegmentsByStates_Call) Return(_a0 *datapb.GetSegmentsByStatesResponse, _a1 error) *MockDataCoord_GetSegmentsByStates_Call {
+func (_c *MockDataCoord_PauseGC_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_PauseGC_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetSegmentsByStates_Call) RunAndReturn(run func(context.Context, *datapb.GetSegmentsByStatesRequest) (*datapb.GetSegmentsByStatesResponse, error)) *MockDataCoord_GetSegmentsByStates_Call {
+func (_c *MockDataCoord_PauseGC_Call) RunAndReturn(run func(context.Context, *datapb.PauseGCRequest) (*commonpb.Status, error)) *MockDataCoord_PauseGC_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetStatisticsChannel provides a mock function with given fields: ctx
-func (_m *MockDataCoord) GetStatisticsChannel(ctx context.Context) (*milvuspb.StringResponse, error) {
-	ret := _m.Called(ctx)
+// ReCollectSegmentStats provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) ReCollectSegmentStats(ctx context.Context, req *datapb.ReCollectSegmentStatsRequest) (*datapb.ReCollectSegmentStatsResponse, error) {
+	ret := _m.Called(ctx, req)
 
-	var r0 *milvuspb.StringResponse
+	var r0 *datapb.ReCollectSegmentStatsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (*milvuspb.StringResponse, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ReCollectSegmentStatsRequest) (*datapb.ReCollectSegmentStatsResponse, error)); ok {
+		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) *milvuspb.StringResponse); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ReCollectSegmentStatsRequest) *datapb.ReCollectSegmentStatsResponse); ok {
+		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.StringResponse)
+			r0 = ret.Get(0).(*datapb.ReCollectSegmentStatsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ReCollectSegmentStatsRequest) error); ok {
+		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1708,106 +3397,94 @@ func (_m *MockDataCoord) GetStatisticsChannel(ctx context.Context) (*milvuspb.St
 	return r0, r1
 }
 
-// MockDataCoord_GetStatisticsChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatisticsChannel'
-type MockDataCoord_GetStatisticsChannel_Call struct {
+// MockDataCoord_ReCollectSegmentStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReCollectSegmentStats'
+type MockDataCoord_ReCollectSegmentStats_Call struct {
 	*mock.Call
 }
 
-// GetStatisticsChannel is a helper method to define mock.On call
+// ReCollectSegmentStats is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockDataCoord_Expecter) GetStatisticsChannel(ctx interface{}) *MockDataCoord_GetStatisticsChannel_Call {
-	return &MockDataCoord_GetStatisticsChannel_Call{Call: _e.mock.On("GetStatisticsChannel", ctx)}
+//   - req *datapb.ReCollectSegmentStatsRequest
+func (_e *MockDataCoord_Expecter) ReCollectSegmentStats(ctx interface{}, req interface{}) *MockDataCoord_ReCollectSegmentStats_Call {
+	return &MockDataCoord_ReCollectSegmentStats_Call{Call: _e.mock.On("ReCollectSegmentStats", ctx, req)}
 }
 
-func (_c *MockDataCoord_GetStatisticsChannel_Call) Run(run func(ctx context.Context)) *MockDataCoord_GetStatisticsChannel_Call {
+func (_c *MockDataCoord_ReCollectSegmentStats_Call) Run(run func(ctx context.Context, req *datapb.ReCollectSegmentStatsRequest)) *MockDataCoord_ReCollectSegmentStats_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(*datapb.ReCollectSegmentStatsRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetStatisticsChannel_Call) Return(_a0 *milvuspb.StringResponse, _a1 error) *MockDataCoord_GetStatisticsChannel_Call {
+func (_c *MockDataCoord_ReCollectSegmentStats_Call) Return(_a0 *datapb.ReCollectSegmentStatsResponse, _a1 error) *MockDataCoord_ReCollectSegmentStats_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetStatisticsChannel_Call) RunAndReturn(run func(context.Context) (*milvuspb.StringResponse, error)) *MockDataCoord_GetStatisticsChannel_Call {
+func (_c *MockDataCoord_ReCollectSegmentStats_Call) RunAndReturn(run func(context.Context, *datapb.ReCollectSegmentStatsRequest) (*datapb.ReCollectSegmentStatsResponse, error)) *MockDataCoord_ReCollectSegmentStats_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTimeTickChannel provides a mock function with given fields: ctx
-func (_m *MockDataCoord) GetTimeTickChannel(ctx context.Context) (*milvuspb.StringResponse, error) {
-	ret := _m.Called(ctx)
-
-	var r0 *milvuspb.StringResponse
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (*milvuspb.StringResponse, error)); ok {
-		return rf(ctx)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context) *milvuspb.StringResponse); ok {
-		r0 = rf(ctx)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.StringResponse)
-		}
-	}
+// Register provides a mock function with given fields:
+func (_m *MockDataCoord) Register() error {
+	ret := _m.Called()
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockDataCoord_GetTimeTickChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTimeTickChannel'
-type MockDataCoord_GetTimeTickChannel_Call struct {
+// MockDataCoord_Register_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Register'
+type MockDataCoord_Register_Call struct {
 	*mock.Call
 }
 
-// GetTimeTickChannel is a helper method to define mock.On call
-//   - ctx context.Context
-func (_e *MockDataCoord_Expecter) GetTimeTickChannel(ctx interface{}) *MockDataCoord_GetTimeTickChannel_Call {
-	return &MockDataCoord_GetTimeTickChannel_Call{Call: _e.mock.On("GetTimeTickChannel", ctx)}
+// Register is a helper method to define mock.On call
+func (_e *MockDataCoord_Expecter) Register() *MockDataCoord_Register_Call {
+	return &MockDataCoord_Register_Call{Call: _e.mock.On("Register")}
 }
 
-func (_c *MockDataCoord_GetTimeTickChannel_Call) Run(run func(ctx context.Context)) *MockDataCoord_GetTimeTickChannel_Call {
+func (_c *MockDataCoord_Register_Call) Run(run func()) *MockDataCoord_Register_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run()
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetTimeTickChannel_Call) Return(_a0 *milvuspb.StringResponse, _a1 error) *MockDataCoord_GetTimeTickChannel_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockDataCoord_Register_Call) Return(_a0 error) *MockDataCoord_Register_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockDataCoord_GetTimeTickChannel_Call) RunAndReturn(run func(context.Context) (*milvuspb.StringResponse, error)) *MockDataCoord_GetTimeTickChannel_Call {
+func (_c *MockDataCoord_Register_Call) RunAndReturn(run func() error) *MockDataCoord_Register_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Import provides a mock function with given fields: ctx, req
-func (_m *MockDataCoord) Import(ctx context.Context, req *datapb.ImportTaskRequest) (*datapb.ImportTaskResponse, error) {
+// ReplicateBinlog provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) ReplicateBinlog(ctx context.Context, req *datapb.ReplicateBinlogRequest) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, req)
 
-	var r0 *datapb.ImportTaskResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ImportTaskRequest) (*datapb.ImportTaskResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ReplicateBinlogRequest) (*commonpb.Status, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ImportTaskRequest) *datapb.ImportTaskResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ReplicateBinlogRequest) *commonpb.Status); ok {
 		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datapb.ImportTaskResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ImportTaskRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ReplicateBinlogRequest) error); ok {
 		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -1816,94 +3493,108 @@ func (_m *MockDataCoord) Import(ctx context.Context, req *datapb.ImportTaskReque
 	return r0, r1
 }
 
-// MockDataCoord_Import_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Import'
-type MockDataCoord_Import_Call struct {
+// MockDataCoord_ReplicateBinlog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplicateBinlog'
+type MockDataCoord_ReplicateBinlog_Call struct {
 	*mock.Call
 }
 
-// Import is a helper method to define mock.On call
+// ReplicateBinlog is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *datapb.ImportTaskRequest
-func (_e *MockDataCoord_Expecter) Import(ctx interface{}, req interface{}) *MockDataCoord_Import_Call {
-	return &MockDataCoord_Import_Call{Call: _e.mock.On("Import", ctx, req)}
+//   - req *datapb.ReplicateBinlogRequest
+func (_e *MockDataCoord_Expecter) ReplicateBinlog(ctx interface{}, req interface{}) *MockDataCoord_ReplicateBinlog_Call {
+	return &MockDataCoord_ReplicateBinlog_Call{Call: _e.mock.On("ReplicateBinlog", ctx, req)}
 }
 
-func (_c *MockDataCoord_Import_Call) Run(run func(ctx context.Context, req *datapb.ImportTaskRequest)) *MockDataCoord_Import_Call {
+func (_c *MockDataCoord_ReplicateBinlog_Call) Run(run func(ctx context.Context, req *datapb.ReplicateBinlogRequest)) *MockDataCoord_ReplicateBinlog_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*datapb.ImportTaskRequest))
+		run(args[0].(context.Context), args[1].(*datapb.ReplicateBinlogRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_Import_Call) Return(_a0 *datapb.ImportTaskResponse, _a1 error) *MockDataCoord_Import_Call {
+func (_c *MockDataCoord_ReplicateBinlog_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_ReplicateBinlog_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_Import_Call) RunAndReturn(run func(context.Context, *datapb.ImportTaskRequest) (*datapb.ImportTaskResponse, error)) *MockDataCoord_Import_Call {
+func (_c *MockDataCoord_ReplicateBinlog_Call) RunAndReturn(run func(context.Context, *datapb.ReplicateBinlogRequest) (*commonpb.Status, error)) *MockDataCoord_ReplicateBinlog_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Init provides a mock function with given fields:
-func (_m *MockDataCoord) Init() error {
-	ret := _m.Called()
+// ReplicateSegment provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) ReplicateSegment(ctx context.Context, req *datapb.ReplicateSegmentRequest) (*datapb.ReplicateSegmentResponse, error) {
+	ret := _m.Called(ctx, req)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func() error); ok {
-		r0 = rf()
+	var r0 *datapb.ReplicateSegmentResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ReplicateSegmentRequest) (*datapb.ReplicateSegmentResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ReplicateSegmentRequest) *datapb.ReplicateSegmentResponse); ok {
+		r0 = rf(ctx, req)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.ReplicateSegmentResponse)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ReplicateSegmentRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockDataCoord_Init_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Init'
-type MockDataCoord_Init_Call struct {
+// MockDataCoord_ReplicateSegment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplicateSegment'
+type MockDataCoord_ReplicateSegment_Call struct {
 	*mock.Call
 }
 
-// Init is a helper method to define mock.On call
-func (_e *MockDataCoord_Expecter) Init() *MockDataCoord_Init_Call {
-	return &MockDataCoord_Init_Call{Call: _e.mock.On("Init")}
+// ReplicateSegment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *datapb.ReplicateSegmentRequest
+func (_e *MockDataCoord_Expecter) ReplicateSegment(ctx interface{}, req interface{}) *MockDataCoord_ReplicateSegment_Call {
+	return &MockDataCoord_ReplicateSegment_Call{Call: _e.mock.On("ReplicateSegment", ctx, req)}
 }
 
-func (_c *MockDataCoord_Init_Call) Run(run func()) *MockDataCoord_Init_Call {
+func (_c *MockDataCoord_ReplicateSegment_Call) Run(run func(ctx context.Context, req *datapb.ReplicateSegmentRequest)) *MockDataCoord_ReplicateSegment_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(context.Context), args[1].(*datapb.ReplicateSegmentRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_Init_Call) Return(_a0 error) *MockDataCoord_Init_Call {
-	_c.Call.Return(_a0)
+func (_c *MockDataCoord_ReplicateSegment_Call) Return(_a0 *datapb.ReplicateSegmentResponse, _a1 error) *MockDataCoord_ReplicateSegment_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_Init_Call) RunAndReturn(run func() error) *MockDataCoord_Init_Call {
+func (_c *MockDataCoord_ReplicateSegment_Call) RunAndReturn(run func(context.Context, *datapb.ReplicateSegmentRequest) (*datapb.ReplicateSegmentResponse, error)) *MockDataCoord_ReplicateSegment_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ManualCompaction provides a mock function with given fields: ctx, req
-func (_m *MockDataCoord) ManualCompaction(ctx context.Context, req *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error) {
+// ReportDataNodeTtMsgs provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) ReportDataNodeTtMsgs(ctx context.Context, req *datapb.ReportDataNodeTtMsgsRequest) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, req)
 
-	var r0 *milvuspb.ManualCompactionResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ReportDataNodeTtMsgsRequest) (*commonpb.Status, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ManualCompactionRequest) *milvuspb.ManualCompactionResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ReportDataNodeTtMsgsRequest) *commonpb.Status); ok {
 		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.ManualCompactionResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.ManualCompactionRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ReportDataNodeTtMsgsRequest) error); ok {
 		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -1912,45 +3603,45 @@ func (_m *MockDataCoord) ManualCompaction(ctx context.Context, req *milvuspb.Man
 	return r0, r1
 }
 
-// MockDataCoord_ManualCompaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ManualCompaction'
-type MockDataCoord_ManualCompaction_Call struct {
+// MockDataCoord_ReportDataNodeTtMsgs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportDataNodeTtMsgs'
+type MockDataCoord_ReportDataNodeTtMsgs_Call struct {
 	*mock.Call
 }
 
-// ManualCompaction is a helper method to define mock.On call
+// ReportDataNodeTtMsgs is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *milvuspb.ManualCompactionRequest
-func (_e *MockDataCoord_Expecter) ManualCompaction(ctx interface{}, req interface{}) *MockDataCoord_ManualCompaction_Call {
-	return &MockDataCoord_ManualCompaction_Call{Call: _e.mock.On("ManualCompaction", ctx, req)}
+//   - req *datapb.ReportDataNodeTtMsgsRequest
+func (_e *MockDataCoord_Expecter) ReportDataNodeTtMsgs(ctx interface{}, req interface{}) *MockDataCoord_ReportDataNodeTtMsgs_Call {
+	return &MockDataCoord_ReportDataNodeTtMsgs_Call{Call: _e.mock.On("ReportDataNodeTtMsgs", ctx, req)}
 }
 
-func (_c *MockDataCoord_ManualCompaction_Call) Run(run func(ctx context.Context, req *milvuspb.ManualCompactionRequest)) *MockDataCoord_ManualCompaction_Call {
+func (_c *MockDataCoord_ReportDataNodeTtMsgs_Call) Run(run func(ctx context.Context, req *datapb.ReportDataNodeTtMsgsRequest)) *MockDataCoord_ReportDataNodeTtMsgs_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*milvuspb.ManualCompactionRequest))
+		run(args[0].(context.Context), args[1].(*datapb.ReportDataNodeTtMsgsRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_ManualCompaction_Call) Return(_a0 *milvuspb.ManualCompactionResponse, _a1 error) *MockDataCoord_ManualCompaction_Call {
+func (_c *MockDataCoord_ReportDataNodeTtMsgs_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_ReportDataNodeTtMsgs_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_ManualCompaction_Call) RunAndReturn(run func(context.Context, *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error)) *MockDataCoord_ManualCompaction_Call {
+func (_c *MockDataCoord_ReportDataNodeTtMsgs_Call) RunAndReturn(run func(context.Context, *datapb.ReportDataNodeTtMsgsRequest) (*commonpb.Status, error)) *MockDataCoord_ReportDataNodeTtMsgs_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// MarkSegmentsDropped provides a mock function with given fields: ctx, req
-func (_m *MockDataCoord) MarkSegmentsDropped(ctx context.Context, req *datapb.MarkSegmentsDroppedRequest) (*commonpb.Status, error) {
+// ResumeGC provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) ResumeGC(ctx context.Context, req *datapb.ResumeGCRequest) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, req)
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.MarkSegmentsDroppedRequest) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ResumeGCRequest) (*commonpb.Status, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.MarkSegmentsDroppedRequest) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ResumeGCRequest) *commonpb.Status); ok {
 		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
@@ -1958,7 +3649,7 @@ func (_m *MockDataCoord) MarkSegmentsDropped(ctx context.Context, req *datapb.Ma
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.MarkSegmentsDroppedRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ResumeGCRequest) error); ok {
 		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -1967,94 +3658,108 @@ func (_m *MockDataCoord) MarkSegmentsDropped(ctx context.Context, req *datapb.Ma
 	return r0, r1
 }
 
-// MockDataCoord_MarkSegmentsDropped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkSegmentsDropped'
-type MockDataCoord_MarkSegmentsDropped_Call struct {
+// MockDataCoord_ResumeGC_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeGC'
+type MockDataCoord_ResumeGC_Call struct {
 	*mock.Call
 }
 
-// MarkSegmentsDropped is a helper method to define mock.On call
+// ResumeGC is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *datapb.MarkSegmentsDroppedRequest
-func (_e *MockDataCoord_Expecter) MarkSegmentsDropped(ctx interface{}, req interface{}) *MockDataCoord_MarkSegmentsDropped_Call {
-	return &MockDataCoord_MarkSegmentsDropped_Call{Call: _e.mock.On("MarkSegmentsDropped", ctx, req)}
+//   - req *datapb.ResumeGCRequest
+func (_e *MockDataCoord_Expecter) ResumeGC(ctx interface{}, req interface{}) *MockDataCoord_ResumeGC_Call {
+	return &MockDataCoord_ResumeGC_Call{Call: _e.mock.On("ResumeGC", ctx, req)}
 }
 
-func (_c *MockDataCoord_MarkSegmentsDropped_Call) Run(run func(ctx context.Context, req *datapb.MarkSegmentsDroppedRequest)) *MockDataCoord_MarkSegmentsDropped_Call {
+func (_c *MockDataCoord_ResumeGC_Call) Run(run func(ctx context.Context, req *datapb.ResumeGCRequest)) *MockDataCoord_ResumeGC_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*datapb.MarkSegmentsDroppedRequest))
+		run(args[0].(context.Context), args[1].(*datapb.ResumeGCRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_MarkSegmentsDropped_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_MarkSegmentsDropped_Call {
+func (_c *MockDataCoord_ResumeGC_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_ResumeGC_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_MarkSegmentsDropped_Call) RunAndReturn(run func(context.Context, *datapb.MarkSegmentsDroppedRequest) (*commonpb.Status, error)) *MockDataCoord_MarkSegmentsDropped_Call {
+func (_c *MockDataCoord_ResumeGC_Call) RunAndReturn(run func(context.Context, *datapb.ResumeGCRequest) (*commonpb.Status, error)) *MockDataCoord_ResumeGC_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Register provides a mock function with given fields:
-func (_m *MockDataCoord) Register() error {
-	ret := _m.Called()
+// RetryQuarantinedChannels provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) RetryQuarantinedChannels(ctx context.Context, req *datapb.RetryQuarantinedChannelsRequest) (*datapb.RetryQuarantinedChannelsResponse, error) {
+	ret := _m.Called(ctx, req)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func() error); ok {
-		r0 = rf()
+	var r0 *datapb.RetryQuarantinedChannelsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.RetryQuarantinedChannelsRequest) (*datapb.RetryQuarantinedChannelsResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.RetryQuarantinedChannelsRequest) *datapb.RetryQuarantinedChannelsResponse); ok {
+		r0 = rf(ctx, req)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.RetryQuarantinedChannelsResponse)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.RetryQuarantinedChannelsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockDataCoord_Register_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Register'
-type MockDataCoord_Register_Call struct {
+// MockDataCoord_RetryQuarantinedChannels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetryQuarantinedChannels'
+type MockDataCoord_RetryQuarantinedChannels_Call struct {
 	*mock.Call
 }
 
-// Register is a helper method to define mock.On call
-func (_e *MockDataCoord_Expecter) Register() *MockDataCoord_Register_Call {
-	return &MockDataCoord_Register_Call{Call: _e.mock.On("Register")}
+// RetryQuarantinedChannels is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *datapb.RetryQuarantinedChannelsRequest
+func (_e *MockDataCoord_Expecter) RetryQuarantinedChannels(ctx interface{}, req interface{}) *MockDataCoord_RetryQuarantinedChannels_Call {
+	return &MockDataCoord_RetryQuarantinedChannels_Call{Call: _e.mock.On("RetryQuarantinedChannels", ctx, req)}
 }
 
-func (_c *MockDataCoord_Register_Call) Run(run func()) *MockDataCoord_Register_Call {
+func (_c *MockDataCoord_RetryQuarantinedChannels_Call) Run(run func(ctx context.Context, req *datapb.RetryQuarantinedChannelsRequest)) *MockDataCoord_RetryQuarantinedChannels_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(context.Context), args[1].(*datapb.RetryQuarantinedChannelsRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_Register_Call) Return(_a0 error) *MockDataCoord_Register_Call {
-	_c.Call.Return(_a0)
+func (_c *MockDataCoord_RetryQuarantinedChannels_Call) Return(_a0 *datapb.RetryQuarantinedChannelsResponse, _a1 error) *MockDataCoord_RetryQuarantinedChannels_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_Register_Call) RunAndReturn(run func() error) *MockDataCoord_Register_Call {
+func (_c *MockDataCoord_RetryQuarantinedChannels_Call) RunAndReturn(run func(context.Context, *datapb.RetryQuarantinedChannelsRequest) (*datapb.RetryQuarantinedChannelsResponse, error)) *MockDataCoord_RetryQuarantinedChannels_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ReportDataNodeTtMsgs provides a mock function with given fields: ctx, req
-func (_m *MockDataCoord) ReportDataNodeTtMsgs(ctx context.Context, req *datapb.ReportDataNodeTtMsgsRequest) (*commonpb.Status, error) {
+// RotateEncryptionKey provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) RotateEncryptionKey(ctx context.Context, req *datapb.RotateEncryptionKeyRequest) (*datapb.RotateEncryptionKeyResponse, error) {
 	ret := _m.Called(ctx, req)
 
-	var r0 *commonpb.Status
+	var r0 *datapb.RotateEncryptionKeyResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ReportDataNodeTtMsgsRequest) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.RotateEncryptionKeyRequest) (*datapb.RotateEncryptionKeyResponse, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ReportDataNodeTtMsgsRequest) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.RotateEncryptionKeyRequest) *datapb.RotateEncryptionKeyResponse); ok {
 		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*commonpb.Status)
+			r0 = ret.Get(0).(*datapb.RotateEncryptionKeyResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ReportDataNodeTtMsgsRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.RotateEncryptionKeyRequest) error); ok {
 		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -2063,31 +3768,31 @@ func (_m *MockDataCoord) ReportDataNodeTtMsgs(ctx context.Context, req *datapb.R
 	return r0, r1
 }
 
-// MockDataCoord_ReportDataNodeTtMsgs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportDataNodeTtMsgs'
-type MockDataCoord_ReportDataNodeTtMsgs_Call struct {
+// MockDataCoord_RotateEncryptionKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateEncryptionKey'
+type MockDataCoord_RotateEncryptionKey_Call struct {
 	*mock.Call
 }
 
-// ReportDataNodeTtMsgs is a helper method to define mock.On call
+// RotateEncryptionKey is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *datapb.ReportDataNodeTtMsgsRequest
-func (_e *MockDataCoord_Expecter) ReportDataNodeTtMsgs(ctx interface{}, req interface{}) *MockDataCoord_ReportDataNodeTtMsgs_Call {
-	return &MockDataCoord_ReportDataNodeTtMsgs_Call{Call: _e.mock.On("ReportDataNodeTtMsgs", ctx, req)}
+//   - req *datapb.RotateEncryptionKeyRequest
+func (_e *MockDataCoord_Expecter) RotateEncryptionKey(ctx interface{}, req interface{}) *MockDataCoord_RotateEncryptionKey_Call {
+	return &MockDataCoord_RotateEncryptionKey_Call{Call: _e.mock.On("RotateEncryptionKey", ctx, req)}
 }
 
-func (_c *MockDataCoord_ReportDataNodeTtMsgs_Call) Run(run func(ctx context.Context, req *datapb.ReportDataNodeTtMsgsRequest)) *MockDataCoord_ReportDataNodeTtMsgs_Call {
+func (_c *MockDataCoord_RotateEncryptionKey_Call) Run(run func(ctx context.Context, req *datapb.RotateEncryptionKeyRequest)) *MockDataCoord_RotateEncryptionKey_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*datapb.ReportDataNodeTtMsgsRequest))
+		run(args[0].(context.Context), args[1].(*datapb.RotateEncryptionKeyRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_ReportDataNodeTtMsgs_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_ReportDataNodeTtMsgs_Call {
+func (_c *MockDataCoord_RotateEncryptionKey_Call) Return(_a0 *datapb.RotateEncryptionKeyResponse, _a1 error) *MockDataCoord_RotateEncryptionKey_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_ReportDataNodeTtMsgs_Call) RunAndReturn(run func(context.Context, *datapb.ReportDataNodeTtMsgsRequest) (*commonpb.Status, error)) *MockDataCoord_ReportDataNodeTtMsgs_Call {
+func (_c *MockDataCoord_RotateEncryptionKey_Call) RunAndReturn(run func(context.Context, *datapb.RotateEncryptionKeyRequest) (*datapb.RotateEncryptionKeyResponse, error)) *MockDataCoord_RotateEncryptionKey_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -2334,6 +4039,116 @@ func (_c *MockDataCoord_SetIndexNodeCreator_Call) RunAndReturn(run func(func(con
 	return _c
 }
 
+// SetMaintenancePolicy provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) SetMaintenancePolicy(ctx context.Context, req *datapb.SetMaintenancePolicyRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.SetMaintenancePolicyRequest) (*commonpb.Status, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.SetMaintenancePolicyRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.SetMaintenancePolicyRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_SetMaintenancePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMaintenancePolicy'
+type MockDataCoord_SetMaintenancePolicy_Call struct {
+	*mock.Call
+}
+
+// SetMaintenancePolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *datapb.SetMaintenancePolicyRequest
+func (_e *MockDataCoord_Expecter) SetMaintenancePolicy(ctx interface{}, req interface{}) *MockDataCoord_SetMaintenancePolicy_Call {
+	return &MockDataCoord_SetMaintenancePolicy_Call{Call: _e.mock.On("SetMaintenancePolicy", ctx, req)}
+}
+
+func (_c *MockDataCoord_SetMaintenancePolicy_Call) Run(run func(ctx context.Context, req *datapb.SetMaintenancePolicyRequest)) *MockDataCoord_SetMaintenancePolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.SetMaintenancePolicyRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_SetMaintenancePolicy_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_SetMaintenancePolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_SetMaintenancePolicy_Call) RunAndReturn(run func(context.Context, *datapb.SetMaintenancePolicyRequest) (*commonpb.Status, error)) *MockDataCoord_SetMaintenancePolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetNodeConfigs provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) SetNodeConfigs(ctx context.Context, req *datapb.SetNodeConfigsRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.SetNodeConfigsRequest) (*commonpb.Status, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.SetNodeConfigsRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.SetNodeConfigsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_SetNodeConfigs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetNodeConfigs'
+type MockDataCoord_SetNodeConfigs_Call struct {
+	*mock.Call
+}
+
+// SetNodeConfigs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *datapb.SetNodeConfigsRequest
+func (_e *MockDataCoord_Expecter) SetNodeConfigs(ctx interface{}, req interface{}) *MockDataCoord_SetNodeConfigs_Call {
+	return &MockDataCoord_SetNodeConfigs_Call{Call: _e.mock.On("SetNodeConfigs", ctx, req)}
+}
+
+func (_c *MockDataCoord_SetNodeConfigs_Call) Run(run func(ctx context.Context, req *datapb.SetNodeConfigsRequest)) *MockDataCoord_SetNodeConfigs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.SetNodeConfigsRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_SetNodeConfigs_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_SetNodeConfigs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_SetNodeConfigs_Call) RunAndReturn(run func(context.Context, *datapb.SetNodeConfigsRequest) (*commonpb.Status, error)) *MockDataCoord_SetNodeConfigs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetRootCoord provides a mock function with given fields: rootCoord
 func (_m *MockDataCoord) SetRootCoord(rootCoord types.RootCoord) {
 	_m.Called(rootCoord)
@@ -2559,6 +4374,61 @@ func (_c *MockDataCoord_Stop_Call) RunAndReturn(run func() error) *MockDataCoord
 	return _c
 }
 
+// UncordonDataNode provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) UncordonDataNode(ctx context.Context, req *datapb.UncordonDataNodeRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.UncordonDataNodeRequest) (*commonpb.Status, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.UncordonDataNodeRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.UncordonDataNodeRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_UncordonDataNode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UncordonDataNode'
+type MockDataCoord_UncordonDataNode_Call struct {
+	*mock.Call
+}
+
+// UncordonDataNode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *datapb.UncordonDataNodeRequest
+func (_e *MockDataCoord_Expecter) UncordonDataNode(ctx interface{}, req interface{}) *MockDataCoord_UncordonDataNode_Call {
+	return &MockDataCoord_UncordonDataNode_Call{Call: _e.mock.On("UncordonDataNode", ctx, req)}
+}
+
+func (_c *MockDataCoord_UncordonDataNode_Call) Run(run func(ctx context.Context, req *datapb.UncordonDataNodeRequest)) *MockDataCoord_UncordonDataNode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.UncordonDataNodeRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_UncordonDataNode_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_UncordonDataNode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_UncordonDataNode_Call) RunAndReturn(run func(context.Context, *datapb.UncordonDataNodeRequest) (*commonpb.Status, error)) *MockDataCoord_UncordonDataNode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UnsetIsImportingState provides a mock function with given fields: ctx, req
 func (_m *MockDataCoord) UnsetIsImportingState(ctx context.Context, req *datapb.UnsetIsImportingStateRequest) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, req)
@@ -604,6 +604,92 @@ func (_c *DataCoordCatalog_DropChannelCheckpoint_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// DropCloneJob provides a mock function with given fields: ctx, targetCollectionID
+func (_m *DataCoordCatalog) DropCloneJob(ctx context.Context, targetCollectionID int64) error {
+	ret := _m.Called(ctx, targetCollectionID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, targetCollectionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DataCoordCatalog_DropCloneJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropCloneJob'
+type DataCoordCatalog_DropCloneJob_Call struct {
+	*mock.Call
+}
+
+// DropCloneJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - targetCollectionID int64
+func (_e *DataCoordCatalog_Expecter) DropCloneJob(ctx interface{}, targetCollectionID interface{}) *DataCoordCatalog_DropCloneJob_Call {
+	return &DataCoordCatalog_DropCloneJob_Call{Call: _e.mock.On("DropCloneJob", ctx, targetCollectionID)}
+}
+
+func (_c *DataCoordCatalog_DropCloneJob_Call) Run(run func(ctx context.Context, targetCollectionID int64)) *DataCoordCatalog_DropCloneJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_DropCloneJob_Call) Return(_a0 error) *DataCoordCatalog_DropCloneJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DataCoordCatalog_DropCloneJob_Call) RunAndReturn(run func(context.Context, int64) error) *DataCoordCatalog_DropCloneJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DropCordonedNode provides a mock function with given fields: ctx, nodeID
+func (_m *DataCoordCatalog) DropCordonedNode(ctx context.Context, nodeID int64) error {
+	ret := _m.Called(ctx, nodeID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, nodeID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DataCoordCatalog_DropCordonedNode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropCordonedNode'
+type DataCoordCatalog_DropCordonedNode_Call struct {
+	*mock.Call
+}
+
+// DropCordonedNode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - nodeID int64
+func (_e *DataCoordCatalog_Expecter) DropCordonedNode(ctx interface{}, nodeID interface{}) *DataCoordCatalog_DropCordonedNode_Call {
+	return &DataCoordCatalog_DropCordonedNode_Call{Call: _e.mock.On("DropCordonedNode", ctx, nodeID)}
+}
+
+func (_c *DataCoordCatalog_DropCordonedNode_Call) Run(run func(ctx context.Context, nodeID int64)) *DataCoordCatalog_DropCordonedNode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_DropCordonedNode_Call) Return(_a0 error) *DataCoordCatalog_DropCordonedNode_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DataCoordCatalog_DropCordonedNode_Call) RunAndReturn(run func(context.Context, int64) error) *DataCoordCatalog_DropCordonedNode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropIndex provides a mock function with given fields: ctx, collID, dropIdxID
 func (_m *DataCoordCatalog) DropIndex(ctx context.Context, collID int64, dropIdxID int64) error {
 	ret := _m.Called(ctx, collID, dropIdxID)
@@ -648,6 +734,49 @@ func (_c *DataCoordCatalog_DropIndex_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// DropMaintenancePolicy provides a mock function with given fields: ctx, collectionID
+func (_m *DataCoordCatalog) DropMaintenancePolicy(ctx context.Context, collectionID int64) error {
+	ret := _m.Called(ctx, collectionID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, collectionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DataCoordCatalog_DropMaintenancePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropMaintenancePolicy'
+type DataCoordCatalog_DropMaintenancePolicy_Call struct {
+	*mock.Call
+}
+
+// DropMaintenancePolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collectionID int64
+func (_e *DataCoordCatalog_Expecter) DropMaintenancePolicy(ctx interface{}, collectionID interface{}) *DataCoordCatalog_DropMaintenancePolicy_Call {
+	return &DataCoordCatalog_DropMaintenancePolicy_Call{Call: _e.mock.On("DropMaintenancePolicy", ctx, collectionID)}
+}
+
+func (_c *DataCoordCatalog_DropMaintenancePolicy_Call) Run(run func(ctx context.Context, collectionID int64)) *DataCoordCatalog_DropMaintenancePolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_DropMaintenancePolicy_Call) Return(_a0 error) *DataCoordCatalog_DropMaintenancePolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DataCoordCatalog_DropMaintenancePolicy_Call) RunAndReturn(run func(context.Context, int64) error) *DataCoordCatalog_DropMaintenancePolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropSegment provides a mock function with given fields: ctx, segment
 func (_m *DataCoordCatalog) DropSegment(ctx context.Context, segment *datapb.SegmentInfo) error {
 	ret := _m.Called(ctx, segment)
@@ -781,6 +910,63 @@ func (_c *DataCoordCatalog_GcConfirm_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// GetSegment provides a mock function with given fields: ctx, collectionID, partitionID, segmentID
+func (_m *DataCoordCatalog) GetSegment(ctx context.Context, collectionID int64, partitionID int64, segmentID int64) (*datapb.SegmentInfo, error) {
+	ret := _m.Called(ctx, collectionID, partitionID, segmentID)
+
+	var r0 *datapb.SegmentInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) (*datapb.SegmentInfo, error)); ok {
+		return rf(ctx, collectionID, partitionID, segmentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) *datapb.SegmentInfo); ok {
+		r0 = rf(ctx, collectionID, partitionID, segmentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.SegmentInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, int64) error); ok {
+		r1 = rf(ctx, collectionID, partitionID, segmentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataCoordCatalog_GetSegment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSegment'
+type DataCoordCatalog_GetSegment_Call struct {
+	*mock.Call
+}
+
+// GetSegment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collectionID int64
+//   - partitionID int64
+//   - segmentID int64
+func (_e *DataCoordCatalog_Expecter) GetSegment(ctx interface{}, collectionID interface{}, partitionID interface{}, segmentID interface{}) *DataCoordCatalog_GetSegment_Call {
+	return &DataCoordCatalog_GetSegment_Call{Call: _e.mock.On("GetSegment", ctx, collectionID, partitionID, segmentID)}
+}
+
+func (_c *DataCoordCatalog_GetSegment_Call) Run(run func(ctx context.Context, collectionID int64, partitionID int64, segmentID int64)) *DataCoordCatalog_GetSegment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_GetSegment_Call) Return(_a0 *datapb.SegmentInfo, _a1 error) *DataCoordCatalog_GetSegment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DataCoordCatalog_GetSegment_Call) RunAndReturn(run func(context.Context, int64, int64, int64) (*datapb.SegmentInfo, error)) *DataCoordCatalog_GetSegment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListChannelCheckpoint provides a mock function with given fields: ctx
 func (_m *DataCoordCatalog) ListChannelCheckpoint(ctx context.Context) (map[string]*msgpb.MsgPosition, error) {
 	ret := _m.Called(ctx)
@@ -835,6 +1021,168 @@ func (_c *DataCoordCatalog_ListChannelCheckpoint_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// ListCloneJobs provides a mock function with given fields: ctx
+func (_m *DataCoordCatalog) ListCloneJobs(ctx context.Context) ([]*datapb.CloneJob, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*datapb.CloneJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*datapb.CloneJob, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*datapb.CloneJob); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*datapb.CloneJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataCoordCatalog_ListCloneJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCloneJobs'
+type DataCoordCatalog_ListCloneJobs_Call struct {
+	*mock.Call
+}
+
+// ListCloneJobs is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DataCoordCatalog_Expecter) ListCloneJobs(ctx interface{}) *DataCoordCatalog_ListCloneJobs_Call {
+	return &DataCoordCatalog_ListCloneJobs_Call{Call: _e.mock.On("ListCloneJobs", ctx)}
+}
+
+func (_c *DataCoordCatalog_ListCloneJobs_Call) Run(run func(ctx context.Context)) *DataCoordCatalog_ListCloneJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListCloneJobs_Call) Return(_a0 []*datapb.CloneJob, _a1 error) *DataCoordCatalog_ListCloneJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListCloneJobs_Call) RunAndReturn(run func(context.Context) ([]*datapb.CloneJob, error)) *DataCoordCatalog_ListCloneJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCordonedNodes provides a mock function with given fields: ctx
+func (_m *DataCoordCatalog) ListCordonedNodes(ctx context.Context) ([]int64, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []int64); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataCoordCatalog_ListCordonedNodes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCordonedNodes'
+type DataCoordCatalog_ListCordonedNodes_Call struct {
+	*mock.Call
+}
+
+// ListCordonedNodes is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DataCoordCatalog_Expecter) ListCordonedNodes(ctx interface{}) *DataCoordCatalog_ListCordonedNodes_Call {
+	return &DataCoordCatalog_ListCordonedNodes_Call{Call: _e.mock.On("ListCordonedNodes", ctx)}
+}
+
+func (_c *DataCoordCatalog_ListCordonedNodes_Call) Run(run func(ctx context.Context)) *DataCoordCatalog_ListCordonedNodes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListCordonedNodes_Call) Return(_a0 []int64, _a1 error) *DataCoordCatalog_ListCordonedNodes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListCordonedNodes_Call) RunAndReturn(run func(context.Context) ([]int64, error)) *DataCoordCatalog_ListCordonedNodes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEncryptionKeys provides a mock function with given fields: ctx
+func (_m *DataCoordCatalog) ListEncryptionKeys(ctx context.Context) ([]*datapb.EncryptionKeyInfo, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*datapb.EncryptionKeyInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*datapb.EncryptionKeyInfo, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*datapb.EncryptionKeyInfo); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*datapb.EncryptionKeyInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataCoordCatalog_ListEncryptionKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEncryptionKeys'
+type DataCoordCatalog_ListEncryptionKeys_Call struct {
+	*mock.Call
+}
+
+// ListEncryptionKeys is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DataCoordCatalog_Expecter) ListEncryptionKeys(ctx interface{}) *DataCoordCatalog_ListEncryptionKeys_Call {
+	return &DataCoordCatalog_ListEncryptionKeys_Call{Call: _e.mock.On("ListEncryptionKeys", ctx)}
+}
+
+func (_c *DataCoordCatalog_ListEncryptionKeys_Call) Run(run func(ctx context.Context)) *DataCoordCatalog_ListEncryptionKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListEncryptionKeys_Call) Return(_a0 []*datapb.EncryptionKeyInfo, _a1 error) *DataCoordCatalog_ListEncryptionKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListEncryptionKeys_Call) RunAndReturn(run func(context.Context) ([]*datapb.EncryptionKeyInfo, error)) *DataCoordCatalog_ListEncryptionKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListIndexes provides a mock function with given fields: ctx
 func (_m *DataCoordCatalog) ListIndexes(ctx context.Context) ([]*model.Index, error) {
 	ret := _m.Called(ctx)
@@ -889,6 +1237,60 @@ func (_c *DataCoordCatalog_ListIndexes_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// ListMaintenancePolicies provides a mock function with given fields: ctx
+func (_m *DataCoordCatalog) ListMaintenancePolicies(ctx context.Context) ([]*datapb.MaintenancePolicy, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*datapb.MaintenancePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*datapb.MaintenancePolicy, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*datapb.MaintenancePolicy); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*datapb.MaintenancePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataCoordCatalog_ListMaintenancePolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMaintenancePolicies'
+type DataCoordCatalog_ListMaintenancePolicies_Call struct {
+	*mock.Call
+}
+
+// ListMaintenancePolicies is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DataCoordCatalog_Expecter) ListMaintenancePolicies(ctx interface{}) *DataCoordCatalog_ListMaintenancePolicies_Call {
+	return &DataCoordCatalog_ListMaintenancePolicies_Call{Call: _e.mock.On("ListMaintenancePolicies", ctx)}
+}
+
+func (_c *DataCoordCatalog_ListMaintenancePolicies_Call) Run(run func(ctx context.Context)) *DataCoordCatalog_ListMaintenancePolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListMaintenancePolicies_Call) Return(_a0 []*datapb.MaintenancePolicy, _a1 error) *DataCoordCatalog_ListMaintenancePolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListMaintenancePolicies_Call) RunAndReturn(run func(context.Context) ([]*datapb.MaintenancePolicy, error)) *DataCoordCatalog_ListMaintenancePolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListSegmentIndexes provides a mock function with given fields: ctx
 func (_m *DataCoordCatalog) ListSegmentIndexes(ctx context.Context) ([]*model.SegmentIndex, error) {
 	ret := _m.Called(ctx)
@@ -1127,6 +1529,92 @@ func (_c *DataCoordCatalog_SaveChannelCheckpoint_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// SaveCloneJob provides a mock function with given fields: ctx, job
+func (_m *DataCoordCatalog) SaveCloneJob(ctx context.Context, job *datapb.CloneJob) error {
+	ret := _m.Called(ctx, job)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CloneJob) error); ok {
+		r0 = rf(ctx, job)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DataCoordCatalog_SaveCloneJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveCloneJob'
+type DataCoordCatalog_SaveCloneJob_Call struct {
+	*mock.Call
+}
+
+// SaveCloneJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - job *datapb.CloneJob
+func (_e *DataCoordCatalog_Expecter) SaveCloneJob(ctx interface{}, job interface{}) *DataCoordCatalog_SaveCloneJob_Call {
+	return &DataCoordCatalog_SaveCloneJob_Call{Call: _e.mock.On("SaveCloneJob", ctx, job)}
+}
+
+func (_c *DataCoordCatalog_SaveCloneJob_Call) Run(run func(ctx context.Context, job *datapb.CloneJob)) *DataCoordCatalog_SaveCloneJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.CloneJob))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_SaveCloneJob_Call) Return(_a0 error) *DataCoordCatalog_SaveCloneJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DataCoordCatalog_SaveCloneJob_Call) RunAndReturn(run func(context.Context, *datapb.CloneJob) error) *DataCoordCatalog_SaveCloneJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveCordonedNode provides a mock function with given fields: ctx, nodeID
+func (_m *DataCoordCatalog) SaveCordonedNode(ctx context.Context, nodeID int64) error {
+	ret := _m.Called(ctx, nodeID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, nodeID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DataCoordCatalog_SaveCordonedNode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveCordonedNode'
+type DataCoordCatalog_SaveCordonedNode_Call struct {
+	*mock.Call
+}
+
+// SaveCordonedNode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - nodeID int64
+func (_e *DataCoordCatalog_Expecter) SaveCordonedNode(ctx interface{}, nodeID interface{}) *DataCoordCatalog_SaveCordonedNode_Call {
+	return &DataCoordCatalog_SaveCordonedNode_Call{Call: _e.mock.On("SaveCordonedNode", ctx, nodeID)}
+}
+
+func (_c *DataCoordCatalog_SaveCordonedNode_Call) Run(run func(ctx context.Context, nodeID int64)) *DataCoordCatalog_SaveCordonedNode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_SaveCordonedNode_Call) Return(_a0 error) *DataCoordCatalog_SaveCordonedNode_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DataCoordCatalog_SaveCordonedNode_Call) RunAndReturn(run func(context.Context, int64) error) *DataCoordCatalog_SaveCordonedNode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SaveDroppedSegmentsInBatch provides a mock function with given fields: ctx, segments
 func (_m *DataCoordCatalog) SaveDroppedSegmentsInBatch(ctx context.Context, segments []*datapb.SegmentInfo) error {
 	ret := _m.Called(ctx, segments)
@@ -1170,6 +1658,92 @@ func (_c *DataCoordCatalog_SaveDroppedSegmentsInBatch_Call) RunAndReturn(run fun
 	return _c
 }
 
+// SaveEncryptionKey provides a mock function with given fields: ctx, key
+func (_m *DataCoordCatalog) SaveEncryptionKey(ctx context.Context, key *datapb.EncryptionKeyInfo) error {
+	ret := _m.Called(ctx, key)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.EncryptionKeyInfo) error); ok {
+		r0 = rf(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DataCoordCatalog_SaveEncryptionKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveEncryptionKey'
+type DataCoordCatalog_SaveEncryptionKey_Call struct {
+	*mock.Call
+}
+
+// SaveEncryptionKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key *datapb.EncryptionKeyInfo
+func (_e *DataCoordCatalog_Expecter) SaveEncryptionKey(ctx interface{}, key interface{}) *DataCoordCatalog_SaveEncryptionKey_Call {
+	return &DataCoordCatalog_SaveEncryptionKey_Call{Call: _e.mock.On("SaveEncryptionKey", ctx, key)}
+}
+
+func (_c *DataCoordCatalog_SaveEncryptionKey_Call) Run(run func(ctx context.Context, key *datapb.EncryptionKeyInfo)) *DataCoordCatalog_SaveEncryptionKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.EncryptionKeyInfo))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_SaveEncryptionKey_Call) Return(_a0 error) *DataCoordCatalog_SaveEncryptionKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DataCoordCatalog_SaveEncryptionKey_Call) RunAndReturn(run func(context.Context, *datapb.EncryptionKeyInfo) error) *DataCoordCatalog_SaveEncryptionKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveMaintenancePolicy provides a mock function with given fields: ctx, policy
+func (_m *DataCoordCatalog) SaveMaintenancePolicy(ctx context.Context, policy *datapb.MaintenancePolicy) error {
+	ret := _m.Called(ctx, policy)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.MaintenancePolicy) error); ok {
+		r0 = rf(ctx, policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DataCoordCatalog_SaveMaintenancePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveMaintenancePolicy'
+type DataCoordCatalog_SaveMaintenancePolicy_Call struct {
+	*mock.Call
+}
+
+// SaveMaintenancePolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policy *datapb.MaintenancePolicy
+func (_e *DataCoordCatalog_Expecter) SaveMaintenancePolicy(ctx interface{}, policy interface{}) *DataCoordCatalog_SaveMaintenancePolicy_Call {
+	return &DataCoordCatalog_SaveMaintenancePolicy_Call{Call: _e.mock.On("SaveMaintenancePolicy", ctx, policy)}
+}
+
+func (_c *DataCoordCatalog_SaveMaintenancePolicy_Call) Run(run func(ctx context.Context, policy *datapb.MaintenancePolicy)) *DataCoordCatalog_SaveMaintenancePolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.MaintenancePolicy))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_SaveMaintenancePolicy_Call) Return(_a0 error) *DataCoordCatalog_SaveMaintenancePolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DataCoordCatalog_SaveMaintenancePolicy_Call) RunAndReturn(run func(context.Context, *datapb.MaintenancePolicy) error) *DataCoordCatalog_SaveMaintenancePolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ShouldDropChannel provides a mock function with given fields: ctx, channel
 func (_m *DataCoordCatalog) ShouldDropChannel(ctx context.Context, channel string) bool {
 	ret := _m.Called(ctx, channel)
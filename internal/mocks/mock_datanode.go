@@ -87,6 +87,61 @@ func (_c *MockDataNode_AddImportSegment_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// CancelCompactionPlans provides a mock function with given fields: ctx, req
+func (_m *MockDataNode) CancelCompactionPlans(ctx context.Context, req *datapb.CancelCompactionPlansRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CancelCompactionPlansRequest) (*commonpb.Status, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CancelCompactionPlansRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.CancelCompactionPlansRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataNode_CancelCompactionPlans_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelCompactionPlans'
+type MockDataNode_CancelCompactionPlans_Call struct {
+	*mock.Call
+}
+
+// CancelCompactionPlans is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *datapb.CancelCompactionPlansRequest
+func (_e *MockDataNode_Expecter) CancelCompactionPlans(ctx interface{}, req interface{}) *MockDataNode_CancelCompactionPlans_Call {
+	return &MockDataNode_CancelCompactionPlans_Call{Call: _e.mock.On("CancelCompactionPlans", ctx, req)}
+}
+
+func (_c *MockDataNode_CancelCompactionPlans_Call) Run(run func(ctx context.Context, req *datapb.CancelCompactionPlansRequest)) *MockDataNode_CancelCompactionPlans_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.CancelCompactionPlansRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataNode_CancelCompactionPlans_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataNode_CancelCompactionPlans_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataNode_CancelCompactionPlans_Call) RunAndReturn(run func(context.Context, *datapb.CancelCompactionPlansRequest) (*commonpb.Status, error)) *MockDataNode_CancelCompactionPlans_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Compaction provides a mock function with given fields: ctx, req
 func (_m *MockDataNode) Compaction(ctx context.Context, req *datapb.CompactionPlan) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, req)
@@ -142,6 +197,61 @@ func (_c *MockDataNode_Compaction_Call) RunAndReturn(run func(context.Context, *
 	return _c
 }
 
+// DumpDispatcherPositions provides a mock function with given fields: ctx, req
+func (_m *MockDataNode) DumpDispatcherPositions(ctx context.Context, req *internalpb.DumpDispatcherPositionsRequest) (*internalpb.DumpDispatcherPositionsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *internalpb.DumpDispatcherPositionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DumpDispatcherPositionsRequest) (*internalpb.DumpDispatcherPositionsResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DumpDispatcherPositionsRequest) *internalpb.DumpDispatcherPositionsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.DumpDispatcherPositionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.DumpDispatcherPositionsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataNode_DumpDispatcherPositions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DumpDispatcherPositions'
+type MockDataNode_DumpDispatcherPositions_Call struct {
+	*mock.Call
+}
+
+// DumpDispatcherPositions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *internalpb.DumpDispatcherPositionsRequest
+func (_e *MockDataNode_Expecter) DumpDispatcherPositions(ctx interface{}, req interface{}) *MockDataNode_DumpDispatcherPositions_Call {
+	return &MockDataNode_DumpDispatcherPositions_Call{Call: _e.mock.On("DumpDispatcherPositions", ctx, req)}
+}
+
+func (_c *MockDataNode_DumpDispatcherPositions_Call) Run(run func(ctx context.Context, req *internalpb.DumpDispatcherPositionsRequest)) *MockDataNode_DumpDispatcherPositions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.DumpDispatcherPositionsRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataNode_DumpDispatcherPositions_Call) Return(_a0 *internalpb.DumpDispatcherPositionsResponse, _a1 error) *MockDataNode_DumpDispatcherPositions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataNode_DumpDispatcherPositions_Call) RunAndReturn(run func(context.Context, *internalpb.DumpDispatcherPositionsRequest) (*internalpb.DumpDispatcherPositionsResponse, error)) *MockDataNode_DumpDispatcherPositions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FlushSegments provides a mock function with given fields: ctx, req
 func (_m *MockDataNode) FlushSegments(ctx context.Context, req *datapb.FlushSegmentsRequest) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, req)
@@ -689,6 +799,61 @@ func (_c *MockDataNode_ResendSegmentStats_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// SeekDispatcher provides a mock function with given fields: ctx, req
+func (_m *MockDataNode) SeekDispatcher(ctx context.Context, req *internalpb.SeekDispatcherRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.SeekDispatcherRequest) (*commonpb.Status, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.SeekDispatcherRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.SeekDispatcherRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataNode_SeekDispatcher_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SeekDispatcher'
+type MockDataNode_SeekDispatcher_Call struct {
+	*mock.Call
+}
+
+// SeekDispatcher is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *internalpb.SeekDispatcherRequest
+func (_e *MockDataNode_Expecter) SeekDispatcher(ctx interface{}, req interface{}) *MockDataNode_SeekDispatcher_Call {
+	return &MockDataNode_SeekDispatcher_Call{Call: _e.mock.On("SeekDispatcher", ctx, req)}
+}
+
+func (_c *MockDataNode_SeekDispatcher_Call) Run(run func(ctx context.Context, req *internalpb.SeekDispatcherRequest)) *MockDataNode_SeekDispatcher_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.SeekDispatcherRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataNode_SeekDispatcher_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataNode_SeekDispatcher_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataNode_SeekDispatcher_Call) RunAndReturn(run func(context.Context, *internalpb.SeekDispatcherRequest) (*commonpb.Status, error)) *MockDataNode_SeekDispatcher_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetAddress provides a mock function with given fields: address
 func (_m *MockDataNode) SetAddress(address string) {
 	_m.Called(address)
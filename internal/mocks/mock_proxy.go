@@ -8,6 +8,8 @@ import (
 	commonpb "github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	clientv3 "go.etcd.io/etcd/client/v3"
 
+	datapb "github.com/milvus-io/milvus/internal/proto/datapb"
+
 	internalpb "github.com/milvus-io/milvus/internal/proto/internalpb"
 
 	milvuspb "github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
@@ -16,6 +18,8 @@ import (
 
 	proxypb "github.com/milvus-io/milvus/internal/proto/proxypb"
 
+	rootcoordpb "github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+
 	types "github.com/milvus-io/milvus/internal/types"
 )
 
@@ -173,6 +177,61 @@ func (_c *MockProxy_AlterCollection_Call) Return(_a0 *commonpb.Status, _a1 error
 	return _c
 }
 
+// AlterIndex provides a mock function with given fields: ctx, req
+func (_m *MockProxy) AlterIndex(ctx context.Context, req *proxypb.AlterIndexRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.AlterIndexRequest) (*commonpb.Status, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.AlterIndexRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.AlterIndexRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_AlterIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterIndex'
+type MockProxy_AlterIndex_Call struct {
+	*mock.Call
+}
+
+// AlterIndex is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *proxypb.AlterIndexRequest
+func (_e *MockProxy_Expecter) AlterIndex(ctx interface{}, req interface{}) *MockProxy_AlterIndex_Call {
+	return &MockProxy_AlterIndex_Call{Call: _e.mock.On("AlterIndex", ctx, req)}
+}
+
+func (_c *MockProxy_AlterIndex_Call) Run(run func(ctx context.Context, req *proxypb.AlterIndexRequest)) *MockProxy_AlterIndex_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.AlterIndexRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_AlterIndex_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_AlterIndex_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_AlterIndex_Call) RunAndReturn(run func(context.Context, *proxypb.AlterIndexRequest) (*commonpb.Status, error)) *MockProxy_AlterIndex_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CalcDistance provides a mock function with given fields: ctx, request
 func (_m *MockProxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDistanceRequest) (*milvuspb.CalcDistanceResults, error) {
 	ret := _m.Called(ctx, request)
@@ -267,6 +326,61 @@ func (_c *MockProxy_CheckHealth_Call) Return(_a0 *milvuspb.CheckHealthResponse,
 	return _c
 }
 
+// CloneCollection provides a mock function with given fields: ctx, req
+func (_m *MockProxy) CloneCollection(ctx context.Context, req *rootcoordpb.CloneCollectionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.CloneCollectionRequest) (*commonpb.Status, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.CloneCollectionRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.CloneCollectionRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_CloneCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloneCollection'
+type MockProxy_CloneCollection_Call struct {
+	*mock.Call
+}
+
+// CloneCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *rootcoordpb.CloneCollectionRequest
+func (_e *MockProxy_Expecter) CloneCollection(ctx interface{}, req interface{}) *MockProxy_CloneCollection_Call {
+	return &MockProxy_CloneCollection_Call{Call: _e.mock.On("CloneCollection", ctx, req)}
+}
+
+func (_c *MockProxy_CloneCollection_Call) Run(run func(ctx context.Context, req *rootcoordpb.CloneCollectionRequest)) *MockProxy_CloneCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.CloneCollectionRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_CloneCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_CloneCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_CloneCollection_Call) RunAndReturn(run func(context.Context, *rootcoordpb.CloneCollectionRequest) (*commonpb.Status, error)) *MockProxy_CloneCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Connect provides a mock function with given fields: ctx, req
 func (_m *MockProxy) Connect(ctx context.Context, req *milvuspb.ConnectRequest) (*milvuspb.ConnectResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -1301,6 +1415,61 @@ func (_c *MockProxy_Dummy_Call) Return(_a0 *milvuspb.DummyResponse, _a1 error) *
 	return _c
 }
 
+// EvaluateIndex provides a mock function with given fields: ctx, req
+func (_m *MockProxy) EvaluateIndex(ctx context.Context, req *proxypb.EvaluateIndexRequest) (*proxypb.EvaluateIndexResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *proxypb.EvaluateIndexResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.EvaluateIndexRequest) (*proxypb.EvaluateIndexResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.EvaluateIndexRequest) *proxypb.EvaluateIndexResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.EvaluateIndexResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.EvaluateIndexRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_EvaluateIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EvaluateIndex'
+type MockProxy_EvaluateIndex_Call struct {
+	*mock.Call
+}
+
+// EvaluateIndex is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *proxypb.EvaluateIndexRequest
+func (_e *MockProxy_Expecter) EvaluateIndex(ctx interface{}, req interface{}) *MockProxy_EvaluateIndex_Call {
+	return &MockProxy_EvaluateIndex_Call{Call: _e.mock.On("EvaluateIndex", ctx, req)}
+}
+
+func (_c *MockProxy_EvaluateIndex_Call) Run(run func(ctx context.Context, req *proxypb.EvaluateIndexRequest)) *MockProxy_EvaluateIndex_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.EvaluateIndexRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_EvaluateIndex_Call) Return(_a0 *proxypb.EvaluateIndexResponse, _a1 error) *MockProxy_EvaluateIndex_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_EvaluateIndex_Call) RunAndReturn(run func(context.Context, *proxypb.EvaluateIndexRequest) (*proxypb.EvaluateIndexResponse, error)) *MockProxy_EvaluateIndex_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Flush provides a mock function with given fields: ctx, request
 func (_m *MockProxy) Flush(ctx context.Context, request *milvuspb.FlushRequest) (*milvuspb.FlushResponse, error) {
 	ret := _m.Called(ctx, request)
@@ -1431,6 +1600,53 @@ func (_c *MockProxy_GetAddress_Call) Return(_a0 string) *MockProxy_GetAddress_Ca
 	return _c
 }
 
+// GetCloneCollectionState provides a mock function with given fields: ctx, req
+func (_m *MockProxy) GetCloneCollectionState(ctx context.Context, req *datapb.GetCloneSegmentsStateRequest) (*datapb.GetCloneSegmentsStateResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *datapb.GetCloneSegmentsStateResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetCloneSegmentsStateRequest) *datapb.GetCloneSegmentsStateResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetCloneSegmentsStateResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetCloneSegmentsStateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_GetCloneCollectionState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCloneCollectionState'
+type MockProxy_GetCloneCollectionState_Call struct {
+	*mock.Call
+}
+
+// GetCloneCollectionState is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *datapb.GetCloneSegmentsStateRequest
+func (_e *MockProxy_Expecter) GetCloneCollectionState(ctx interface{}, req interface{}) *MockProxy_GetCloneCollectionState_Call {
+	return &MockProxy_GetCloneCollectionState_Call{Call: _e.mock.On("GetCloneCollectionState", ctx, req)}
+}
+
+func (_c *MockProxy_GetCloneCollectionState_Call) Run(run func(ctx context.Context, req *datapb.GetCloneSegmentsStateRequest)) *MockProxy_GetCloneCollectionState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.GetCloneSegmentsStateRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_GetCloneCollectionState_Call) Return(_a0 *datapb.GetCloneSegmentsStateResponse, _a1 error) *MockProxy_GetCloneCollectionState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // GetCollectionStatistics provides a mock function with given fields: ctx, request
 func (_m *MockProxy) GetCollectionStatistics(ctx context.Context, request *milvuspb.GetCollectionStatisticsRequest) (*milvuspb.GetCollectionStatisticsResponse, error) {
 	ret := _m.Called(ctx, request)
@@ -1853,6 +2069,61 @@ func (_c *MockProxy_GetIndexBuildProgress_Call) Return(_a0 *milvuspb.GetIndexBui
 	return _c
 }
 
+// GetIndexEvaluation provides a mock function with given fields: ctx, req
+func (_m *MockProxy) GetIndexEvaluation(ctx context.Context, req *proxypb.GetIndexEvaluationRequest) (*proxypb.GetIndexEvaluationResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *proxypb.GetIndexEvaluationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.GetIndexEvaluationRequest) (*proxypb.GetIndexEvaluationResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.GetIndexEvaluationRequest) *proxypb.GetIndexEvaluationResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.GetIndexEvaluationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.GetIndexEvaluationRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_GetIndexEvaluation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIndexEvaluation'
+type MockProxy_GetIndexEvaluation_Call struct {
+	*mock.Call
+}
+
+// GetIndexEvaluation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *proxypb.GetIndexEvaluationRequest
+func (_e *MockProxy_Expecter) GetIndexEvaluation(ctx interface{}, req interface{}) *MockProxy_GetIndexEvaluation_Call {
+	return &MockProxy_GetIndexEvaluation_Call{Call: _e.mock.On("GetIndexEvaluation", ctx, req)}
+}
+
+func (_c *MockProxy_GetIndexEvaluation_Call) Run(run func(ctx context.Context, req *proxypb.GetIndexEvaluationRequest)) *MockProxy_GetIndexEvaluation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.GetIndexEvaluationRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_GetIndexEvaluation_Call) Return(_a0 *proxypb.GetIndexEvaluationResponse, _a1 error) *MockProxy_GetIndexEvaluation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_GetIndexEvaluation_Call) RunAndReturn(run func(context.Context, *proxypb.GetIndexEvaluationRequest) (*proxypb.GetIndexEvaluationResponse, error)) *MockProxy_GetIndexEvaluation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetIndexState provides a mock function with given fields: ctx, request
 func (_m *MockProxy) GetIndexState(ctx context.Context, request *milvuspb.GetIndexStateRequest) (*milvuspb.GetIndexStateResponse, error) {
 	ret := _m.Called(ctx, request)
@@ -2321,6 +2592,44 @@ func (_c *MockProxy_GetRateLimiter_Call) Return(_a0 types.Limiter, _a1 error) *M
 	return _c
 }
 
+// GetFederation provides a mock function with given fields:
+func (_m *MockProxy) GetFederation() types.Federation {
+	ret := _m.Called()
+
+	var r0 types.Federation
+	if rf, ok := ret.Get(0).(func() types.Federation); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.Federation)
+		}
+	}
+
+	return r0
+}
+
+// MockProxy_GetFederation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFederation'
+type MockProxy_GetFederation_Call struct {
+	*mock.Call
+}
+
+// GetFederation is a helper method to define mock.On call
+func (_e *MockProxy_Expecter) GetFederation() *MockProxy_GetFederation_Call {
+	return &MockProxy_GetFederation_Call{Call: _e.mock.On("GetFederation")}
+}
+
+func (_c *MockProxy_GetFederation_Call) Run(run func()) *MockProxy_GetFederation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockProxy_GetFederation_Call) Return(_a0 types.Federation) *MockProxy_GetFederation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
 // GetReplicas provides a mock function with given fields: ctx, req
 func (_m *MockProxy) GetReplicas(ctx context.Context, req *milvuspb.GetReplicasRequest) (*milvuspb.GetReplicasResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -32,6 +32,61 @@ func (_m *MockQueryCoord) EXPECT() *MockQueryCoord_Expecter {
 	return &MockQueryCoord_Expecter{mock: &_m.Mock}
 }
 
+// ActivateChecker provides a mock function with given fields: ctx, req
+func (_m *MockQueryCoord) ActivateChecker(ctx context.Context, req *querypb.ActivateCheckerRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ActivateCheckerRequest) (*commonpb.Status, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ActivateCheckerRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ActivateCheckerRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_ActivateChecker_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ActivateChecker'
+type MockQueryCoord_ActivateChecker_Call struct {
+	*mock.Call
+}
+
+// ActivateChecker is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *querypb.ActivateCheckerRequest
+func (_e *MockQueryCoord_Expecter) ActivateChecker(ctx interface{}, req interface{}) *MockQueryCoord_ActivateChecker_Call {
+	return &MockQueryCoord_ActivateChecker_Call{Call: _e.mock.On("ActivateChecker", ctx, req)}
+}
+
+func (_c *MockQueryCoord_ActivateChecker_Call) Run(run func(ctx context.Context, req *querypb.ActivateCheckerRequest)) *MockQueryCoord_ActivateChecker_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.ActivateCheckerRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_ActivateChecker_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_ActivateChecker_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_ActivateChecker_Call) RunAndReturn(run func(context.Context, *querypb.ActivateCheckerRequest) (*commonpb.Status, error)) *MockQueryCoord_ActivateChecker_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CheckHealth provides a mock function with given fields: ctx, req
 func (_m *MockQueryCoord) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -142,6 +197,61 @@ func (_c *MockQueryCoord_CreateResourceGroup_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// DeactivateChecker provides a mock function with given fields: ctx, req
+func (_m *MockQueryCoord) DeactivateChecker(ctx context.Context, req *querypb.DeactivateCheckerRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.DeactivateCheckerRequest) (*commonpb.Status, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.DeactivateCheckerRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.DeactivateCheckerRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_DeactivateChecker_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeactivateChecker'
+type MockQueryCoord_DeactivateChecker_Call struct {
+	*mock.Call
+}
+
+// DeactivateChecker is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *querypb.DeactivateCheckerRequest
+func (_e *MockQueryCoord_Expecter) DeactivateChecker(ctx interface{}, req interface{}) *MockQueryCoord_DeactivateChecker_Call {
+	return &MockQueryCoord_DeactivateChecker_Call{Call: _e.mock.On("DeactivateChecker", ctx, req)}
+}
+
+func (_c *MockQueryCoord_DeactivateChecker_Call) Run(run func(ctx context.Context, req *querypb.DeactivateCheckerRequest)) *MockQueryCoord_DeactivateChecker_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.DeactivateCheckerRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_DeactivateChecker_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_DeactivateChecker_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_DeactivateChecker_Call) RunAndReturn(run func(context.Context, *querypb.DeactivateCheckerRequest) (*commonpb.Status, error)) *MockQueryCoord_DeactivateChecker_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DescribeResourceGroup provides a mock function with given fields: ctx, req
 func (_m *MockQueryCoord) DescribeResourceGroup(ctx context.Context, req *querypb.DescribeResourceGroupRequest) (*querypb.DescribeResourceGroupResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -252,6 +362,116 @@ func (_c *MockQueryCoord_DropResourceGroup_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// DryRunCheckers provides a mock function with given fields: ctx, req
+func (_m *MockQueryCoord) DryRunCheckers(ctx context.Context, req *querypb.DryRunCheckersRequest) (*querypb.DryRunCheckersResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *querypb.DryRunCheckersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.DryRunCheckersRequest) (*querypb.DryRunCheckersResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.DryRunCheckersRequest) *querypb.DryRunCheckersResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.DryRunCheckersResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.DryRunCheckersRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_DryRunCheckers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DryRunCheckers'
+type MockQueryCoord_DryRunCheckers_Call struct {
+	*mock.Call
+}
+
+// DryRunCheckers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *querypb.DryRunCheckersRequest
+func (_e *MockQueryCoord_Expecter) DryRunCheckers(ctx interface{}, req interface{}) *MockQueryCoord_DryRunCheckers_Call {
+	return &MockQueryCoord_DryRunCheckers_Call{Call: _e.mock.On("DryRunCheckers", ctx, req)}
+}
+
+func (_c *MockQueryCoord_DryRunCheckers_Call) Run(run func(ctx context.Context, req *querypb.DryRunCheckersRequest)) *MockQueryCoord_DryRunCheckers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.DryRunCheckersRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_DryRunCheckers_Call) Return(_a0 *querypb.DryRunCheckersResponse, _a1 error) *MockQueryCoord_DryRunCheckers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_DryRunCheckers_Call) RunAndReturn(run func(context.Context, *querypb.DryRunCheckersRequest) (*querypb.DryRunCheckersResponse, error)) *MockQueryCoord_DryRunCheckers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExtendLoadTimeout provides a mock function with given fields: ctx, req
+func (_m *MockQueryCoord) ExtendLoadTimeout(ctx context.Context, req *querypb.ExtendLoadTimeoutRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ExtendLoadTimeoutRequest) (*commonpb.Status, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ExtendLoadTimeoutRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ExtendLoadTimeoutRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_ExtendLoadTimeout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExtendLoadTimeout'
+type MockQueryCoord_ExtendLoadTimeout_Call struct {
+	*mock.Call
+}
+
+// ExtendLoadTimeout is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *querypb.ExtendLoadTimeoutRequest
+func (_e *MockQueryCoord_Expecter) ExtendLoadTimeout(ctx interface{}, req interface{}) *MockQueryCoord_ExtendLoadTimeout_Call {
+	return &MockQueryCoord_ExtendLoadTimeout_Call{Call: _e.mock.On("ExtendLoadTimeout", ctx, req)}
+}
+
+func (_c *MockQueryCoord_ExtendLoadTimeout_Call) Run(run func(ctx context.Context, req *querypb.ExtendLoadTimeoutRequest)) *MockQueryCoord_ExtendLoadTimeout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.ExtendLoadTimeoutRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_ExtendLoadTimeout_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_ExtendLoadTimeout_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_ExtendLoadTimeout_Call) RunAndReturn(run func(context.Context, *querypb.ExtendLoadTimeoutRequest) (*commonpb.Status, error)) *MockQueryCoord_ExtendLoadTimeout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetComponentStates provides a mock function with given fields: ctx
 func (_m *MockQueryCoord) GetComponentStates(ctx context.Context) (*milvuspb.ComponentStates, error) {
 	ret := _m.Called(ctx)
@@ -730,6 +950,116 @@ func (_c *MockQueryCoord_Init_Call) RunAndReturn(run func() error) *MockQueryCoo
 	return _c
 }
 
+// ListCheckers provides a mock function with given fields: ctx, req
+func (_m *MockQueryCoord) ListCheckers(ctx context.Context, req *querypb.ListCheckersRequest) (*querypb.ListCheckersResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *querypb.ListCheckersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ListCheckersRequest) (*querypb.ListCheckersResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ListCheckersRequest) *querypb.ListCheckersResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.ListCheckersResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ListCheckersRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_ListCheckers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCheckers'
+type MockQueryCoord_ListCheckers_Call struct {
+	*mock.Call
+}
+
+// ListCheckers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *querypb.ListCheckersRequest
+func (_e *MockQueryCoord_Expecter) ListCheckers(ctx interface{}, req interface{}) *MockQueryCoord_ListCheckers_Call {
+	return &MockQueryCoord_ListCheckers_Call{Call: _e.mock.On("ListCheckers", ctx, req)}
+}
+
+func (_c *MockQueryCoord_ListCheckers_Call) Run(run func(ctx context.Context, req *querypb.ListCheckersRequest)) *MockQueryCoord_ListCheckers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.ListCheckersRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_ListCheckers_Call) Return(_a0 *querypb.ListCheckersResponse, _a1 error) *MockQueryCoord_ListCheckers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_ListCheckers_Call) RunAndReturn(run func(context.Context, *querypb.ListCheckersRequest) (*querypb.ListCheckersResponse, error)) *MockQueryCoord_ListCheckers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDivergedLeaders provides a mock function with given fields: ctx, req
+func (_m *MockQueryCoord) ListDivergedLeaders(ctx context.Context, req *querypb.ListDivergedLeadersRequest) (*querypb.ListDivergedLeadersResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *querypb.ListDivergedLeadersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ListDivergedLeadersRequest) (*querypb.ListDivergedLeadersResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ListDivergedLeadersRequest) *querypb.ListDivergedLeadersResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.ListDivergedLeadersResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ListDivergedLeadersRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_ListDivergedLeaders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDivergedLeaders'
+type MockQueryCoord_ListDivergedLeaders_Call struct {
+	*mock.Call
+}
+
+// ListDivergedLeaders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *querypb.ListDivergedLeadersRequest
+func (_e *MockQueryCoord_Expecter) ListDivergedLeaders(ctx interface{}, req interface{}) *MockQueryCoord_ListDivergedLeaders_Call {
+	return &MockQueryCoord_ListDivergedLeaders_Call{Call: _e.mock.On("ListDivergedLeaders", ctx, req)}
+}
+
+func (_c *MockQueryCoord_ListDivergedLeaders_Call) Run(run func(ctx context.Context, req *querypb.ListDivergedLeadersRequest)) *MockQueryCoord_ListDivergedLeaders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.ListDivergedLeadersRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_ListDivergedLeaders_Call) Return(_a0 *querypb.ListDivergedLeadersResponse, _a1 error) *MockQueryCoord_ListDivergedLeaders_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_ListDivergedLeaders_Call) RunAndReturn(run func(context.Context, *querypb.ListDivergedLeadersRequest) (*querypb.ListDivergedLeadersResponse, error)) *MockQueryCoord_ListDivergedLeaders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListResourceGroups provides a mock function with given fields: ctx, req
 func (_m *MockQueryCoord) ListResourceGroups(ctx context.Context, req *milvuspb.ListResourceGroupsRequest) (*milvuspb.ListResourceGroupsResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -950,6 +1280,61 @@ func (_c *MockQueryCoord_LoadPartitions_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// NotifyCompaction provides a mock function with given fields: ctx, req
+func (_m *MockQueryCoord) NotifyCompaction(ctx context.Context, req *querypb.NotifyCompactionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.NotifyCompactionRequest) (*commonpb.Status, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.NotifyCompactionRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.NotifyCompactionRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_NotifyCompaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyCompaction'
+type MockQueryCoord_NotifyCompaction_Call struct {
+	*mock.Call
+}
+
+// NotifyCompaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *querypb.NotifyCompactionRequest
+func (_e *MockQueryCoord_Expecter) NotifyCompaction(ctx interface{}, req interface{}) *MockQueryCoord_NotifyCompaction_Call {
+	return &MockQueryCoord_NotifyCompaction_Call{Call: _e.mock.On("NotifyCompaction", ctx, req)}
+}
+
+func (_c *MockQueryCoord_NotifyCompaction_Call) Run(run func(ctx context.Context, req *querypb.NotifyCompactionRequest)) *MockQueryCoord_NotifyCompaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.NotifyCompactionRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_NotifyCompaction_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_NotifyCompaction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_NotifyCompaction_Call) RunAndReturn(run func(context.Context, *querypb.NotifyCompactionRequest) (*commonpb.Status, error)) *MockQueryCoord_NotifyCompaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Register provides a mock function with given fields:
 func (_m *MockQueryCoord) Register() error {
 	ret := _m.Called()
@@ -1531,6 +1916,61 @@ func (_c *MockQueryCoord_Stop_Call) RunAndReturn(run func() error) *MockQueryCoo
 	return _c
 }
 
+// SuspendCollectionBalance provides a mock function with given fields: ctx, req
+func (_m *MockQueryCoord) SuspendCollectionBalance(ctx context.Context, req *querypb.SuspendCollectionBalanceRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.SuspendCollectionBalanceRequest) (*commonpb.Status, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.SuspendCollectionBalanceRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.SuspendCollectionBalanceRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_SuspendCollectionBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SuspendCollectionBalance'
+type MockQueryCoord_SuspendCollectionBalance_Call struct {
+	*mock.Call
+}
+
+// SuspendCollectionBalance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *querypb.SuspendCollectionBalanceRequest
+func (_e *MockQueryCoord_Expecter) SuspendCollectionBalance(ctx interface{}, req interface{}) *MockQueryCoord_SuspendCollectionBalance_Call {
+	return &MockQueryCoord_SuspendCollectionBalance_Call{Call: _e.mock.On("SuspendCollectionBalance", ctx, req)}
+}
+
+func (_c *MockQueryCoord_SuspendCollectionBalance_Call) Run(run func(ctx context.Context, req *querypb.SuspendCollectionBalanceRequest)) *MockQueryCoord_SuspendCollectionBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.SuspendCollectionBalanceRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_SuspendCollectionBalance_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_SuspendCollectionBalance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_SuspendCollectionBalance_Call) RunAndReturn(run func(context.Context, *querypb.SuspendCollectionBalanceRequest) (*commonpb.Status, error)) *MockQueryCoord_SuspendCollectionBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SyncNewCreatedPartition provides a mock function with given fields: ctx, req
 func (_m *MockQueryCoord) SyncNewCreatedPartition(ctx context.Context, req *querypb.SyncNewCreatedPartitionRequest) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, req)
@@ -1696,6 +2136,61 @@ func (_c *MockQueryCoord_TransferReplica_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// TriggerBalance provides a mock function with given fields: ctx, req
+func (_m *MockQueryCoord) TriggerBalance(ctx context.Context, req *querypb.TriggerBalanceRequest) (*querypb.TriggerBalanceResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *querypb.TriggerBalanceResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.TriggerBalanceRequest) (*querypb.TriggerBalanceResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.TriggerBalanceRequest) *querypb.TriggerBalanceResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.TriggerBalanceResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.TriggerBalanceRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_TriggerBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TriggerBalance'
+type MockQueryCoord_TriggerBalance_Call struct {
+	*mock.Call
+}
+
+// TriggerBalance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *querypb.TriggerBalanceRequest
+func (_e *MockQueryCoord_Expecter) TriggerBalance(ctx interface{}, req interface{}) *MockQueryCoord_TriggerBalance_Call {
+	return &MockQueryCoord_TriggerBalance_Call{Call: _e.mock.On("TriggerBalance", ctx, req)}
+}
+
+func (_c *MockQueryCoord_TriggerBalance_Call) Run(run func(ctx context.Context, req *querypb.TriggerBalanceRequest)) *MockQueryCoord_TriggerBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.TriggerBalanceRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_TriggerBalance_Call) Return(_a0 *querypb.TriggerBalanceResponse, _a1 error) *MockQueryCoord_TriggerBalance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_TriggerBalance_Call) RunAndReturn(run func(context.Context, *querypb.TriggerBalanceRequest) (*querypb.TriggerBalanceResponse, error)) *MockQueryCoord_TriggerBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateStateCode provides a mock function with given fields: stateCode
 func (_m *MockQueryCoord) UpdateStateCode(stateCode commonpb.StateCode) {
 	_m.Called(stateCode)
@@ -1729,6 +2224,49 @@ func (_c *MockQueryCoord_UpdateStateCode_Call) RunAndReturn(run func(commonpb.St
 	return _c
 }
 
+// WatchLoadProgress provides a mock function with given fields: req, stream
+func (_m *MockQueryCoord) WatchLoadProgress(req *querypb.WatchLoadProgressRequest, stream querypb.QueryCoord_WatchLoadProgressServer) error {
+	ret := _m.Called(req, stream)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*querypb.WatchLoadProgressRequest, querypb.QueryCoord_WatchLoadProgressServer) error); ok {
+		r0 = rf(req, stream)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQueryCoord_WatchLoadProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchLoadProgress'
+type MockQueryCoord_WatchLoadProgress_Call struct {
+	*mock.Call
+}
+
+// WatchLoadProgress is a helper method to define mock.On call
+//   - req *querypb.WatchLoadProgressRequest
+//   - stream querypb.QueryCoord_WatchLoadProgressServer
+func (_e *MockQueryCoord_Expecter) WatchLoadProgress(req interface{}, stream interface{}) *MockQueryCoord_WatchLoadProgress_Call {
+	return &MockQueryCoord_WatchLoadProgress_Call{Call: _e.mock.On("WatchLoadProgress", req, stream)}
+}
+
+func (_c *MockQueryCoord_WatchLoadProgress_Call) Run(run func(req *querypb.WatchLoadProgressRequest, stream querypb.QueryCoord_WatchLoadProgressServer)) *MockQueryCoord_WatchLoadProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*querypb.WatchLoadProgressRequest), args[1].(querypb.QueryCoord_WatchLoadProgressServer))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_WatchLoadProgress_Call) Return(_a0 error) *MockQueryCoord_WatchLoadProgress_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQueryCoord_WatchLoadProgress_Call) RunAndReturn(run func(*querypb.WatchLoadProgressRequest, querypb.QueryCoord_WatchLoadProgressServer) error) *MockQueryCoord_WatchLoadProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockQueryCoord creates a new instance of MockQueryCoord. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockQueryCoord(t interface {
@@ -15,6 +15,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	querypb "github.com/milvus-io/milvus/internal/proto/querypb"
+
+	types "github.com/milvus-io/milvus/internal/types"
 )
 
 // MockQueryNode is an autogenerated mock type for the QueryNodeComponent type
@@ -85,6 +87,61 @@ func (_c *MockQueryNode_Delete_Call) RunAndReturn(run func(context.Context, *que
 	return _c
 }
 
+// DumpDispatcherPositions provides a mock function with given fields: ctx, req
+func (_m *MockQueryNode) DumpDispatcherPositions(ctx context.Context, req *internalpb.DumpDispatcherPositionsRequest) (*internalpb.DumpDispatcherPositionsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *internalpb.DumpDispatcherPositionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DumpDispatcherPositionsRequest) (*internalpb.DumpDispatcherPositionsResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DumpDispatcherPositionsRequest) *internalpb.DumpDispatcherPositionsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.DumpDispatcherPositionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.DumpDispatcherPositionsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryNode_DumpDispatcherPositions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DumpDispatcherPositions'
+type MockQueryNode_DumpDispatcherPositions_Call struct {
+	*mock.Call
+}
+
+// DumpDispatcherPositions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *internalpb.DumpDispatcherPositionsRequest
+func (_e *MockQueryNode_Expecter) DumpDispatcherPositions(ctx interface{}, req interface{}) *MockQueryNode_DumpDispatcherPositions_Call {
+	return &MockQueryNode_DumpDispatcherPositions_Call{Call: _e.mock.On("DumpDispatcherPositions", ctx, req)}
+}
+
+func (_c *MockQueryNode_DumpDispatcherPositions_Call) Run(run func(ctx context.Context, req *internalpb.DumpDispatcherPositionsRequest)) *MockQueryNode_DumpDispatcherPositions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.DumpDispatcherPositionsRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryNode_DumpDispatcherPositions_Call) Return(_a0 *internalpb.DumpDispatcherPositionsResponse, _a1 error) *MockQueryNode_DumpDispatcherPositions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryNode_DumpDispatcherPositions_Call) RunAndReturn(run func(context.Context, *internalpb.DumpDispatcherPositionsRequest) (*internalpb.DumpDispatcherPositionsResponse, error)) *MockQueryNode_DumpDispatcherPositions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAddress provides a mock function with given fields:
 func (_m *MockQueryNode) GetAddress() string {
 	ret := _m.Called()
@@ -1085,6 +1142,61 @@ func (_c *MockQueryNode_SearchSegments_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// SeekDispatcher provides a mock function with given fields: ctx, req
+func (_m *MockQueryNode) SeekDispatcher(ctx context.Context, req *internalpb.SeekDispatcherRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.SeekDispatcherRequest) (*commonpb.Status, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.SeekDispatcherRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.SeekDispatcherRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryNode_SeekDispatcher_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SeekDispatcher'
+type MockQueryNode_SeekDispatcher_Call struct {
+	*mock.Call
+}
+
+// SeekDispatcher is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *internalpb.SeekDispatcherRequest
+func (_e *MockQueryNode_Expecter) SeekDispatcher(ctx interface{}, req interface{}) *MockQueryNode_SeekDispatcher_Call {
+	return &MockQueryNode_SeekDispatcher_Call{Call: _e.mock.On("SeekDispatcher", ctx, req)}
+}
+
+func (_c *MockQueryNode_SeekDispatcher_Call) Run(run func(ctx context.Context, req *internalpb.SeekDispatcherRequest)) *MockQueryNode_SeekDispatcher_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.SeekDispatcherRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryNode_SeekDispatcher_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryNode_SeekDispatcher_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryNode_SeekDispatcher_Call) RunAndReturn(run func(context.Context, *internalpb.SeekDispatcherRequest) (*commonpb.Status, error)) *MockQueryNode_SeekDispatcher_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetAddress provides a mock function with given fields: address
 func (_m *MockQueryNode) SetAddress(address string) {
 	_m.Called(address)
@@ -1151,6 +1263,48 @@ func (_c *MockQueryNode_SetEtcdClient_Call) RunAndReturn(run func(*clientv3.Clie
 	return _c
 }
 
+// SetRootCoord provides a mock function with given fields: rootCoord
+func (_m *MockQueryNode) SetRootCoord(rootCoord types.RootCoord) error {
+	ret := _m.Called(rootCoord)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.RootCoord) error); ok {
+		r0 = rf(rootCoord)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQueryNode_SetRootCoord_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRootCoord'
+type MockQueryNode_SetRootCoord_Call struct {
+	*mock.Call
+}
+
+// SetRootCoord is a helper method to define mock.On call
+//   - rootCoord types.RootCoord
+func (_e *MockQueryNode_Expecter) SetRootCoord(rootCoord interface{}) *MockQueryNode_SetRootCoord_Call {
+	return &MockQueryNode_SetRootCoord_Call{Call: _e.mock.On("SetRootCoord", rootCoord)}
+}
+
+func (_c *MockQueryNode_SetRootCoord_Call) Run(run func(rootCoord types.RootCoord)) *MockQueryNode_SetRootCoord_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(types.RootCoord))
+	})
+	return _c
+}
+
+func (_c *MockQueryNode_SetRootCoord_Call) Return(_a0 error) *MockQueryNode_SetRootCoord_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQueryNode_SetRootCoord_Call) RunAndReturn(run func(types.RootCoord) error) *MockQueryNode_SetRootCoord_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ShowConfigurations provides a mock function with given fields: ctx, req
 func (_m *MockQueryNode) ShowConfigurations(ctx context.Context, req *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -306,6 +306,61 @@ func (_c *RootCoord_CheckHealth_Call) RunAndReturn(run func(context.Context, *mi
 	return _c
 }
 
+// CloneCollection provides a mock function with given fields: ctx, req
+func (_m *RootCoord) CloneCollection(ctx context.Context, req *rootcoordpb.CloneCollectionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.CloneCollectionRequest) (*commonpb.Status, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.CloneCollectionRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.CloneCollectionRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_CloneCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloneCollection'
+type RootCoord_CloneCollection_Call struct {
+	*mock.Call
+}
+
+// CloneCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *rootcoordpb.CloneCollectionRequest
+func (_e *RootCoord_Expecter) CloneCollection(ctx interface{}, req interface{}) *RootCoord_CloneCollection_Call {
+	return &RootCoord_CloneCollection_Call{Call: _e.mock.On("CloneCollection", ctx, req)}
+}
+
+func (_c *RootCoord_CloneCollection_Call) Run(run func(ctx context.Context, req *rootcoordpb.CloneCollectionRequest)) *RootCoord_CloneCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.CloneCollectionRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_CloneCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_CloneCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_CloneCollection_Call) RunAndReturn(run func(context.Context, *rootcoordpb.CloneCollectionRequest) (*commonpb.Status, error)) *RootCoord_CloneCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateAlias provides a mock function with given fields: ctx, req
 func (_m *RootCoord) CreateAlias(ctx context.Context, req *milvuspb.CreateAliasRequest) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, req)
@@ -1076,6 +1131,116 @@ func (_c *RootCoord_DropRole_Call) RunAndReturn(run func(context.Context, *milvu
 	return _c
 }
 
+// GetCapacityReport provides a mock function with given fields: ctx, req
+func (_m *RootCoord) GetCapacityReport(ctx context.Context, req *rootcoordpb.GetCapacityReportRequest) (*rootcoordpb.GetCapacityReportResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *rootcoordpb.GetCapacityReportResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.GetCapacityReportRequest) (*rootcoordpb.GetCapacityReportResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.GetCapacityReportRequest) *rootcoordpb.GetCapacityReportResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootcoordpb.GetCapacityReportResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.GetCapacityReportRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_GetCapacityReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCapacityReport'
+type RootCoord_GetCapacityReport_Call struct {
+	*mock.Call
+}
+
+// GetCapacityReport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *rootcoordpb.GetCapacityReportRequest
+func (_e *RootCoord_Expecter) GetCapacityReport(ctx interface{}, req interface{}) *RootCoord_GetCapacityReport_Call {
+	return &RootCoord_GetCapacityReport_Call{Call: _e.mock.On("GetCapacityReport", ctx, req)}
+}
+
+func (_c *RootCoord_GetCapacityReport_Call) Run(run func(ctx context.Context, req *rootcoordpb.GetCapacityReportRequest)) *RootCoord_GetCapacityReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.GetCapacityReportRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_GetCapacityReport_Call) Return(_a0 *rootcoordpb.GetCapacityReportResponse, _a1 error) *RootCoord_GetCapacityReport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_GetCapacityReport_Call) RunAndReturn(run func(context.Context, *rootcoordpb.GetCapacityReportRequest) (*rootcoordpb.GetCapacityReportResponse, error)) *RootCoord_GetCapacityReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCollectionStatsHistory provides a mock function with given fields: ctx, req
+func (_m *RootCoord) GetCollectionStatsHistory(ctx context.Context, req *rootcoordpb.GetCollectionStatsHistoryRequest) (*rootcoordpb.GetCollectionStatsHistoryResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *rootcoordpb.GetCollectionStatsHistoryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.GetCollectionStatsHistoryRequest) (*rootcoordpb.GetCollectionStatsHistoryResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.GetCollectionStatsHistoryRequest) *rootcoordpb.GetCollectionStatsHistoryResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootcoordpb.GetCollectionStatsHistoryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.GetCollectionStatsHistoryRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_GetCollectionStatsHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCollectionStatsHistory'
+type RootCoord_GetCollectionStatsHistory_Call struct {
+	*mock.Call
+}
+
+// GetCollectionStatsHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *rootcoordpb.GetCollectionStatsHistoryRequest
+func (_e *RootCoord_Expecter) GetCollectionStatsHistory(ctx interface{}, req interface{}) *RootCoord_GetCollectionStatsHistory_Call {
+	return &RootCoord_GetCollectionStatsHistory_Call{Call: _e.mock.On("GetCollectionStatsHistory", ctx, req)}
+}
+
+func (_c *RootCoord_GetCollectionStatsHistory_Call) Run(run func(ctx context.Context, req *rootcoordpb.GetCollectionStatsHistoryRequest)) *RootCoord_GetCollectionStatsHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.GetCollectionStatsHistoryRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_GetCollectionStatsHistory_Call) Return(_a0 *rootcoordpb.GetCollectionStatsHistoryResponse, _a1 error) *RootCoord_GetCollectionStatsHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_GetCollectionStatsHistory_Call) RunAndReturn(run func(context.Context, *rootcoordpb.GetCollectionStatsHistoryRequest) (*rootcoordpb.GetCollectionStatsHistoryResponse, error)) *RootCoord_GetCollectionStatsHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetComponentStates provides a mock function with given fields: ctx
 func (_m *RootCoord) GetComponentStates(ctx context.Context) (*milvuspb.ComponentStates, error) {
 	ret := _m.Called(ctx)
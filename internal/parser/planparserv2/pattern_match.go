@@ -47,6 +47,17 @@ func findLastNotOfWildcards(pattern string) int {
 	return loc
 }
 
+// findFirstNotOfWildcards find the first location not of leading wildcards.
+func findFirstNotOfWildcards(pattern string) int {
+	loc := 0
+	for ; loc < len(pattern); loc++ {
+		if _, ok := wildcards[pattern[loc]]; !ok {
+			break
+		}
+	}
+	return loc
+}
+
 // translatePatternMatch translates pattern to related op type and operand.
 func translatePatternMatch(pattern string) (op planpb.OpType, operand string, err error) {
 	l := len(pattern)
@@ -57,19 +68,28 @@ func translatePatternMatch(pattern string) (op planpb.OpType, operand string, er
 		return planpb.OpType_PrefixMatch, "", nil
 	}
 
-	exist := hasWildcards(pattern[:loc+1])
-	if loc >= l-1 && !exist {
+	start := findFirstNotOfWildcards(pattern)
+	exist := hasWildcards(pattern[start : loc+1])
+	if exist {
+		return planpb.OpType_Invalid, "", fmt.Errorf(
+			"unsupported pattern: %s, "+
+				"only prefix match like %s, postfix match like %s, inner match like %s "+
+				"and equal match like %s(no wildcards) are supported",
+			pattern, "ab%", "%ab", "%ab%", "ab")
+	}
+
+	switch {
+	case start == 0 && loc >= l-1:
 		// equal match.
 		return planpb.OpType_Equal, pattern, nil
-	}
-	if !exist {
+	case start == 0:
 		// prefix match.
 		return planpb.OpType_PrefixMatch, pattern[:loc+1], nil
+	case loc >= l-1:
+		// postfix match.
+		return planpb.OpType_PostfixMatch, pattern[start:], nil
+	default:
+		// inner match, accelerated by NGRAM index if there is one.
+		return planpb.OpType_Match, pattern[start : loc+1], nil
 	}
-
-	return planpb.OpType_Invalid, "", fmt.Errorf(
-		"unsupported pattern: %s, "+
-			"only prefix pattern match like %s "+
-			"and equal match like %s(no wildcards) are supported",
-		pattern, "ab%", "ab")
 }
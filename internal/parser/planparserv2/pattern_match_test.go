@@ -115,6 +115,24 @@ func Test_translatePatternMatch(t *testing.T) {
 			wantOperand: "",
 			wantErr:     false,
 		},
+		{
+			args:        args{pattern: "%suffix"},
+			wantOp:      planpb.OpType_PostfixMatch,
+			wantOperand: "suffix",
+			wantErr:     false,
+		},
+		{
+			args:        args{pattern: "%%inner%"},
+			wantOp:      planpb.OpType_Match,
+			wantOperand: "inner",
+			wantErr:     false,
+		},
+		{
+			args:        args{pattern: "%a%b%"},
+			wantOp:      planpb.OpType_Invalid,
+			wantOperand: "",
+			wantErr:     true,
+		},
 		{
 			args:        args{pattern: "prefix%suffix"},
 			wantOp:      planpb.OpType_Invalid,
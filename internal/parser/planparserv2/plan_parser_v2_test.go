@@ -136,6 +136,8 @@ func TestExpr_Like(t *testing.T) {
 	exprStrs := []string{
 		`VarCharField like "prefix%"`,
 		`VarCharField like "equal"`,
+		`VarCharField like "%postfix"`,
+		`VarCharField like "%inner%"`,
 		`JSONField["A"] like "name*"`,
 		`$meta["A"] like "name*"`,
 	}
@@ -61,6 +61,37 @@ func (SegmentType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_82cd95f524594f49, []int{0}
 }
 
+type SegmentLevel int32
+
+const (
+	// the segments written before the levels are introduced
+	SegmentLevel_Legacy SegmentLevel = 0
+	// the delete-only segments holding the deltas of a channel, merged into the sealed segments by the L0 compaction
+	SegmentLevel_L0 SegmentLevel = 1
+	// the normal segments
+	SegmentLevel_L1 SegmentLevel = 2
+)
+
+var SegmentLevel_name = map[int32]string{
+	0: "Legacy",
+	1: "L0",
+	2: "L1",
+}
+
+var SegmentLevel_value = map[string]int32{
+	"Legacy": 0,
+	"L0":     1,
+	"L1":     2,
+}
+
+func (x SegmentLevel) String() string {
+	return proto.EnumName(SegmentLevel_name, int32(x))
+}
+
+func (SegmentLevel) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{1}
+}
+
 type ChannelWatchState int32
 
 const (
@@ -101,7 +132,7 @@ func (x ChannelWatchState) String() string {
 }
 
 func (ChannelWatchState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{1}
+	return fileDescriptor_82cd95f524594f49, []int{2}
 }
 
 type CompactionType int32
@@ -110,18 +141,22 @@ const (
 	CompactionType_UndefinedCompaction CompactionType = 0
 	CompactionType_MergeCompaction     CompactionType = 2
 	CompactionType_MixCompaction       CompactionType = 3
+	// converts the segment registered on external files into binlogs
+	CompactionType_ExternalConversion CompactionType = 4
 )
 
 var CompactionType_name = map[int32]string{
 	0: "UndefinedCompaction",
 	2: "MergeCompaction",
 	3: "MixCompaction",
+	4: "ExternalConversion",
 }
 
 var CompactionType_value = map[string]int32{
 	"UndefinedCompaction": 0,
 	"MergeCompaction":     2,
 	"MixCompaction":       3,
+	"ExternalConversion":  4,
 }
 
 func (x CompactionType) String() string {
@@ -129,7 +164,179 @@ func (x CompactionType) String() string {
 }
 
 func (CompactionType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{2}
+	return fileDescriptor_82cd95f524594f49, []int{3}
+}
+
+type CloneState int32
+
+const (
+	CloneState_CloneNone      CloneState = 0
+	CloneState_Cloning        CloneState = 1
+	CloneState_CloneCompleted CloneState = 2
+	CloneState_CloneFailed    CloneState = 3
+)
+
+var CloneState_name = map[int32]string{
+	0: "CloneNone",
+	1: "Cloning",
+	2: "CloneCompleted",
+	3: "CloneFailed",
+}
+
+var CloneState_value = map[string]int32{
+	"CloneNone":      0,
+	"Cloning":        1,
+	"CloneCompleted": 2,
+	"CloneFailed":    3,
+}
+
+func (x CloneState) String() string {
+	return proto.EnumName(CloneState_name, int32(x))
+}
+
+func (CloneState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{4}
+}
+
+type DrainState int32
+
+const (
+	DrainState_DrainNone   DrainState = 0
+	DrainState_Draining    DrainState = 1
+	DrainState_Drained     DrainState = 2
+	DrainState_DrainFailed DrainState = 3
+)
+
+var DrainState_name = map[int32]string{
+	0: "DrainNone",
+	1: "Draining",
+	2: "Drained",
+	3: "DrainFailed",
+}
+
+var DrainState_value = map[string]int32{
+	"DrainNone":   0,
+	"Draining":    1,
+	"Drained":     2,
+	"DrainFailed": 3,
+}
+
+func (x DrainState) String() string {
+	return proto.EnumName(DrainState_name, int32(x))
+}
+
+func (DrainState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{5}
+}
+
+// CompactionMode selects the segments a manual compaction works on.
+type CompactionMode int32
+
+const (
+	// compact all the flushed segments, the same as ManualCompaction
+	CompactionMode_FullCompaction CompactionMode = 0
+	// only merge the small segments together
+	CompactionMode_MergeSmallSegments CompactionMode = 1
+	// rewrite the segments with deltalogs one by one to purge the deleted entities
+	CompactionMode_PurgeDeletes CompactionMode = 2
+)
+
+var CompactionMode_name = map[int32]string{
+	0: "FullCompaction",
+	1: "MergeSmallSegments",
+	2: "PurgeDeletes",
+}
+
+var CompactionMode_value = map[string]int32{
+	"FullCompaction":     0,
+	"MergeSmallSegments": 1,
+	"PurgeDeletes":       2,
+}
+
+func (x CompactionMode) String() string {
+	return proto.EnumName(CompactionMode_name, int32(x))
+}
+
+func (CompactionMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{6}
+}
+
+// GcPhase is the step the garbage collector is working on.
+type GcPhase int32
+
+const (
+	GcPhase_GcIdle                GcPhase = 0
+	GcPhase_GcRecyclingSegments   GcPhase = 1
+	GcPhase_GcRecyclingIndexes    GcPhase = 2
+	GcPhase_GcScanningOrphans     GcPhase = 3
+	GcPhase_GcRecyclingIndexFiles GcPhase = 4
+)
+
+var GcPhase_name = map[int32]string{
+	0: "GcIdle",
+	1: "GcRecyclingSegments",
+	2: "GcRecyclingIndexes",
+	3: "GcScanningOrphans",
+	4: "GcRecyclingIndexFiles",
+}
+
+var GcPhase_value = map[string]int32{
+	"GcIdle":                0,
+	"GcRecyclingSegments":   1,
+	"GcRecyclingIndexes":    2,
+	"GcScanningOrphans":     3,
+	"GcRecyclingIndexFiles": 4,
+}
+
+func (x GcPhase) String() string {
+	return proto.EnumName(GcPhase_name, int32(x))
+}
+
+func (GcPhase) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{7}
+}
+
+type AuditEventType int32
+
+const (
+	AuditEventType_AuditEventNone     AuditEventType = 0
+	AuditEventType_ChannelAssigned    AuditEventType = 1
+	AuditEventType_ChannelReleased    AuditEventType = 2
+	AuditEventType_NodeRegistered     AuditEventType = 3
+	AuditEventType_NodeUnregistered   AuditEventType = 4
+	AuditEventType_CompactionPlanned  AuditEventType = 5
+	AuditEventType_SegmentRecycled    AuditEventType = 6
+	AuditEventType_OrphanFilesRemoved AuditEventType = 7
+)
+
+var AuditEventType_name = map[int32]string{
+	0: "AuditEventNone",
+	1: "ChannelAssigned",
+	2: "ChannelReleased",
+	3: "NodeRegistered",
+	4: "NodeUnregistered",
+	5: "CompactionPlanned",
+	6: "SegmentRecycled",
+	7: "OrphanFilesRemoved",
+}
+
+var AuditEventType_value = map[string]int32{
+	"AuditEventNone":     0,
+	"ChannelAssigned":    1,
+	"ChannelReleased":    2,
+	"NodeRegistered":     3,
+	"NodeUnregistered":   4,
+	"CompactionPlanned":  5,
+	"SegmentRecycled":    6,
+	"OrphanFilesRemoved": 7,
+}
+
+func (x AuditEventType) String() string {
+	return proto.EnumName(AuditEventType_name, int32(x))
+}
+
+func (AuditEventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{8}
 }
 
 // TODO: import google/protobuf/empty.proto
@@ -748,12 +955,22 @@ func (m *GetSegmentStatesResponse) GetStates() []*SegmentStateInfo {
 }
 
 type GetSegmentInfoRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	SegmentIDs           []int64           `protobuf:"varint,2,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
-	IncludeUnHealthy     bool              `protobuf:"varint,3,opt,name=includeUnHealthy,proto3" json:"includeUnHealthy,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Base             *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	SegmentIDs       []int64           `protobuf:"varint,2,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	IncludeUnHealthy bool              `protobuf:"varint,3,opt,name=includeUnHealthy,proto3" json:"includeUnHealthy,omitempty"`
+	// list the segments of the collection ordered by segment ID if segmentIDs is empty
+	CollectionID int64 `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// only return the segments in these states, all states if empty
+	States []commonpb.SegmentState `protobuf:"varint,5,rep,packed,name=states,proto3,enum=milvus.proto.common.SegmentState" json:"states,omitempty"`
+	// max number of segments listed from the collection, no limit if not positive
+	Limit int64 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	// list the segments whose ID is greater than it, used as the cursor of the next page
+	AfterSegmentID int64 `protobuf:"varint,7,opt,name=after_segmentID,json=afterSegmentID,proto3" json:"after_segmentID,omitempty"`
+	// the SegmentInfo fields returned, all fields if empty
+	OutputFields         []string `protobuf:"bytes,8,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *GetSegmentInfoRequest) Reset()         { *m = GetSegmentInfoRequest{} }
@@ -802,13 +1019,50 @@ func (m *GetSegmentInfoRequest) GetIncludeUnHealthy() bool {
 	return false
 }
 
+func (m *GetSegmentInfoRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *GetSegmentInfoRequest) GetStates() []commonpb.SegmentState {
+	if m != nil {
+		return m.States
+	}
+	return nil
+}
+
+func (m *GetSegmentInfoRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetSegmentInfoRequest) GetAfterSegmentID() int64 {
+	if m != nil {
+		return m.AfterSegmentID
+	}
+	return 0
+}
+
+func (m *GetSegmentInfoRequest) GetOutputFields() []string {
+	if m != nil {
+		return m.OutputFields
+	}
+	return nil
+}
+
 type GetSegmentInfoResponse struct {
-	Status               *commonpb.Status              `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Infos                []*SegmentInfo                `protobuf:"bytes,2,rep,name=infos,proto3" json:"infos,omitempty"`
-	ChannelCheckpoint    map[string]*msgpb.MsgPosition `protobuf:"bytes,3,rep,name=channel_checkpoint,json=channelCheckpoint,proto3" json:"channel_checkpoint,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
-	XXX_unrecognized     []byte                        `json:"-"`
-	XXX_sizecache        int32                         `json:"-"`
+	Status            *commonpb.Status              `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Infos             []*SegmentInfo                `protobuf:"bytes,2,rep,name=infos,proto3" json:"infos,omitempty"`
+	ChannelCheckpoint map[string]*msgpb.MsgPosition `protobuf:"bytes,3,rep,name=channel_checkpoint,json=channelCheckpoint,proto3" json:"channel_checkpoint,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// more segments are left to list after the last returned one
+	HasMore              bool     `protobuf:"varint,4,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *GetSegmentInfoResponse) Reset()         { *m = GetSegmentInfoResponse{} }
@@ -857,6 +1111,13 @@ func (m *GetSegmentInfoResponse) GetChannelCheckpoint() map[string]*msgpb.MsgPos
 	return nil
 }
 
+func (m *GetSegmentInfoResponse) GetHasMore() bool {
+	if m != nil {
+		return m.HasMore
+	}
+	return false
+}
+
 type GetInsertBinlogPathsRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	SegmentID            int64             `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
@@ -1500,11 +1761,16 @@ type SegmentInfo struct {
 	// A flag indicating if:
 	// (1) this segment is created by bulk insert, and
 	// (2) the bulk insert task that creates this segment has not yet reached `ImportCompleted` state.
-	IsImporting          bool     `protobuf:"varint,17,opt,name=is_importing,json=isImporting,proto3" json:"is_importing,omitempty"`
-	IsFake               bool     `protobuf:"varint,18,opt,name=is_fake,json=isFake,proto3" json:"is_fake,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	IsImporting bool `protobuf:"varint,17,opt,name=is_importing,json=isImporting,proto3" json:"is_importing,omitempty"`
+	IsFake      bool `protobuf:"varint,18,opt,name=is_fake,json=isFake,proto3" json:"is_fake,omitempty"`
+	// version of the collection key which the binlogs are encrypted with, 0 means not encrypted
+	EncryptionKeyVersion int64        `protobuf:"varint,19,opt,name=encryption_key_version,json=encryptionKeyVersion,proto3" json:"encryption_key_version,omitempty"`
+	Level                SegmentLevel `protobuf:"varint,20,opt,name=level,proto3,enum=milvus.proto.data.SegmentLevel" json:"level,omitempty"`
+	// the external files the segment is registered on, which are converted into binlogs lazily
+	ExternalSource       *ExternalSource `protobuf:"bytes,21,opt,name=external_source,json=externalSource,proto3" json:"external_source,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *SegmentInfo) Reset()         { *m = SegmentInfo{} }
@@ -1658,6 +1924,93 @@ func (m *SegmentInfo) GetIsFake() bool {
 	return false
 }
 
+func (m *SegmentInfo) GetEncryptionKeyVersion() int64 {
+	if m != nil {
+		return m.EncryptionKeyVersion
+	}
+	return 0
+}
+
+func (m *SegmentInfo) GetLevel() SegmentLevel {
+	if m != nil {
+		return m.Level
+	}
+	return SegmentLevel_Legacy
+}
+
+func (m *SegmentInfo) GetExternalSource() *ExternalSource {
+	if m != nil {
+		return m.ExternalSource
+	}
+	return nil
+}
+
+// ExternalSource describes the external parquet files a segment is registered on,
+// only the rows hashed to the shard of the segment belong to it.
+type ExternalSource struct {
+	Files      []string `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	ShardNum   int32    `protobuf:"varint,2,opt,name=shard_num,json=shardNum,proto3" json:"shard_num,omitempty"`
+	ShardIndex int32    `protobuf:"varint,3,opt,name=shard_index,json=shardIndex,proto3" json:"shard_index,omitempty"`
+	// the import job registering the segment is completed, so the segment could be converted
+	Registered           bool     `protobuf:"varint,4,opt,name=registered,proto3" json:"registered,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExternalSource) Reset()         { *m = ExternalSource{} }
+func (m *ExternalSource) String() string { return proto.CompactTextString(m) }
+func (*ExternalSource) ProtoMessage()    {}
+func (*ExternalSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{24}
+}
+
+func (m *ExternalSource) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExternalSource.Unmarshal(m, b)
+}
+func (m *ExternalSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExternalSource.Marshal(b, m, deterministic)
+}
+func (m *ExternalSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExternalSource.Merge(m, src)
+}
+func (m *ExternalSource) XXX_Size() int {
+	return xxx_messageInfo_ExternalSource.Size(m)
+}
+func (m *ExternalSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExternalSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExternalSource proto.InternalMessageInfo
+
+func (m *ExternalSource) GetFiles() []string {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+func (m *ExternalSource) GetShardNum() int32 {
+	if m != nil {
+		return m.ShardNum
+	}
+	return 0
+}
+
+func (m *ExternalSource) GetShardIndex() int32 {
+	if m != nil {
+		return m.ShardIndex
+	}
+	return 0
+}
+
+func (m *ExternalSource) GetRegistered() bool {
+	if m != nil {
+		return m.Registered
+	}
+	return false
+}
+
 type SegmentStartPosition struct {
 	StartPosition        *msgpb.MsgPosition `protobuf:"bytes,1,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
 	SegmentID            int64              `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
@@ -1670,7 +2023,7 @@ func (m *SegmentStartPosition) Reset()         { *m = SegmentStartPosition{} }
 func (m *SegmentStartPosition) String() string { return proto.CompactTextString(m) }
 func (*SegmentStartPosition) ProtoMessage()    {}
 func (*SegmentStartPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{24}
+	return fileDescriptor_82cd95f524594f49, []int{25}
 }
 
 func (m *SegmentStartPosition) XXX_Unmarshal(b []byte) error {
@@ -1706,28 +2059,33 @@ func (m *SegmentStartPosition) GetSegmentID() int64 {
 }
 
 type SaveBinlogPathsRequest struct {
-	Base                 *commonpb.MsgBase       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	SegmentID            int64                   `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	CollectionID         int64                   `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	Field2BinlogPaths    []*FieldBinlog          `protobuf:"bytes,4,rep,name=field2BinlogPaths,proto3" json:"field2BinlogPaths,omitempty"`
-	CheckPoints          []*CheckPoint           `protobuf:"bytes,5,rep,name=checkPoints,proto3" json:"checkPoints,omitempty"`
-	StartPositions       []*SegmentStartPosition `protobuf:"bytes,6,rep,name=start_positions,json=startPositions,proto3" json:"start_positions,omitempty"`
-	Flushed              bool                    `protobuf:"varint,7,opt,name=flushed,proto3" json:"flushed,omitempty"`
-	Field2StatslogPaths  []*FieldBinlog          `protobuf:"bytes,8,rep,name=field2StatslogPaths,proto3" json:"field2StatslogPaths,omitempty"`
-	Deltalogs            []*FieldBinlog          `protobuf:"bytes,9,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
-	Dropped              bool                    `protobuf:"varint,10,opt,name=dropped,proto3" json:"dropped,omitempty"`
-	Importing            bool                    `protobuf:"varint,11,opt,name=importing,proto3" json:"importing,omitempty"`
-	Channel              string                  `protobuf:"bytes,12,opt,name=channel,proto3" json:"channel,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_unrecognized     []byte                  `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+	Base                *commonpb.MsgBase       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	SegmentID           int64                   `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	CollectionID        int64                   `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Field2BinlogPaths   []*FieldBinlog          `protobuf:"bytes,4,rep,name=field2BinlogPaths,proto3" json:"field2BinlogPaths,omitempty"`
+	CheckPoints         []*CheckPoint           `protobuf:"bytes,5,rep,name=checkPoints,proto3" json:"checkPoints,omitempty"`
+	StartPositions      []*SegmentStartPosition `protobuf:"bytes,6,rep,name=start_positions,json=startPositions,proto3" json:"start_positions,omitempty"`
+	Flushed             bool                    `protobuf:"varint,7,opt,name=flushed,proto3" json:"flushed,omitempty"`
+	Field2StatslogPaths []*FieldBinlog          `protobuf:"bytes,8,rep,name=field2StatslogPaths,proto3" json:"field2StatslogPaths,omitempty"`
+	Deltalogs           []*FieldBinlog          `protobuf:"bytes,9,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	Dropped             bool                    `protobuf:"varint,10,opt,name=dropped,proto3" json:"dropped,omitempty"`
+	Importing           bool                    `protobuf:"varint,11,opt,name=importing,proto3" json:"importing,omitempty"`
+	Channel             string                  `protobuf:"bytes,12,opt,name=channel,proto3" json:"channel,omitempty"`
+	// the L0 segment is created by its first SaveBinlogPaths request
+	SegLevel    SegmentLevel `protobuf:"varint,13,opt,name=seg_level,json=segLevel,proto3,enum=milvus.proto.data.SegmentLevel" json:"seg_level,omitempty"`
+	PartitionID int64        `protobuf:"varint,14,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	// the key version which the binlogs are encrypted with, 0 means in plain text
+	EncryptionKeyVersion int64    `protobuf:"varint,15,opt,name=encryption_key_version,json=encryptionKeyVersion,proto3" json:"encryption_key_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SaveBinlogPathsRequest) Reset()         { *m = SaveBinlogPathsRequest{} }
 func (m *SaveBinlogPathsRequest) String() string { return proto.CompactTextString(m) }
 func (*SaveBinlogPathsRequest) ProtoMessage()    {}
 func (*SaveBinlogPathsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{25}
+	return fileDescriptor_82cd95f524594f49, []int{26}
 }
 
 func (m *SaveBinlogPathsRequest) XXX_Unmarshal(b []byte) error {
@@ -1832,6 +2190,27 @@ func (m *SaveBinlogPathsRequest) GetChannel() string {
 	return ""
 }
 
+func (m *SaveBinlogPathsRequest) GetSegLevel() SegmentLevel {
+	if m != nil {
+		return m.SegLevel
+	}
+	return SegmentLevel_Legacy
+}
+
+func (m *SaveBinlogPathsRequest) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *SaveBinlogPathsRequest) GetEncryptionKeyVersion() int64 {
+	if m != nil {
+		return m.EncryptionKeyVersion
+	}
+	return 0
+}
+
 type CheckPoint struct {
 	SegmentID            int64              `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
 	Position             *msgpb.MsgPosition `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
@@ -1845,7 +2224,7 @@ func (m *CheckPoint) Reset()         { *m = CheckPoint{} }
 func (m *CheckPoint) String() string { return proto.CompactTextString(m) }
 func (*CheckPoint) ProtoMessage()    {}
 func (*CheckPoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{26}
+	return fileDescriptor_82cd95f524594f49, []int{27}
 }
 
 func (m *CheckPoint) XXX_Unmarshal(b []byte) error {
@@ -1902,7 +2281,7 @@ func (m *DeltaLogInfo) Reset()         { *m = DeltaLogInfo{} }
 func (m *DeltaLogInfo) String() string { return proto.CompactTextString(m) }
 func (*DeltaLogInfo) ProtoMessage()    {}
 func (*DeltaLogInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{27}
+	return fileDescriptor_82cd95f524594f49, []int{28}
 }
 
 func (m *DeltaLogInfo) XXX_Unmarshal(b []byte) error {
@@ -1971,7 +2350,7 @@ func (m *ChannelStatus) Reset()         { *m = ChannelStatus{} }
 func (m *ChannelStatus) String() string { return proto.CompactTextString(m) }
 func (*ChannelStatus) ProtoMessage()    {}
 func (*ChannelStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{28}
+	return fileDescriptor_82cd95f524594f49, []int{29}
 }
 
 func (m *ChannelStatus) XXX_Unmarshal(b []byte) error {
@@ -2026,7 +2405,7 @@ func (m *DataNodeInfo) Reset()         { *m = DataNodeInfo{} }
 func (m *DataNodeInfo) String() string { return proto.CompactTextString(m) }
 func (*DataNodeInfo) ProtoMessage()    {}
 func (*DataNodeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{29}
+	return fileDescriptor_82cd95f524594f49, []int{30}
 }
 
 func (m *DataNodeInfo) XXX_Unmarshal(b []byte) error {
@@ -2084,7 +2463,7 @@ func (m *SegmentBinlogs) Reset()         { *m = SegmentBinlogs{} }
 func (m *SegmentBinlogs) String() string { return proto.CompactTextString(m) }
 func (*SegmentBinlogs) ProtoMessage()    {}
 func (*SegmentBinlogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{30}
+	return fileDescriptor_82cd95f524594f49, []int{31}
 }
 
 func (m *SegmentBinlogs) XXX_Unmarshal(b []byte) error {
@@ -2159,7 +2538,7 @@ func (m *FieldBinlog) Reset()         { *m = FieldBinlog{} }
 func (m *FieldBinlog) String() string { return proto.CompactTextString(m) }
 func (*FieldBinlog) ProtoMessage()    {}
 func (*FieldBinlog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{31}
+	return fileDescriptor_82cd95f524594f49, []int{32}
 }
 
 func (m *FieldBinlog) XXX_Unmarshal(b []byte) error {
@@ -2211,7 +2590,7 @@ func (m *Binlog) Reset()         { *m = Binlog{} }
 func (m *Binlog) String() string { return proto.CompactTextString(m) }
 func (*Binlog) ProtoMessage()    {}
 func (*Binlog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{32}
+	return fileDescriptor_82cd95f524594f49, []int{33}
 }
 
 func (m *Binlog) XXX_Unmarshal(b []byte) error {
@@ -2287,7 +2666,7 @@ func (m *GetRecoveryInfoResponse) Reset()         { *m = GetRecoveryInfoResponse
 func (m *GetRecoveryInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRecoveryInfoResponse) ProtoMessage()    {}
 func (*GetRecoveryInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{33}
+	return fileDescriptor_82cd95f524594f49, []int{34}
 }
 
 func (m *GetRecoveryInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -2342,7 +2721,7 @@ func (m *GetRecoveryInfoRequest) Reset()         { *m = GetRecoveryInfoRequest{}
 func (m *GetRecoveryInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRecoveryInfoRequest) ProtoMessage()    {}
 func (*GetRecoveryInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{34}
+	return fileDescriptor_82cd95f524594f49, []int{35}
 }
 
 func (m *GetRecoveryInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -2397,7 +2776,7 @@ func (m *GetRecoveryInfoResponseV2) Reset()         { *m = GetRecoveryInfoRespon
 func (m *GetRecoveryInfoResponseV2) String() string { return proto.CompactTextString(m) }
 func (*GetRecoveryInfoResponseV2) ProtoMessage()    {}
 func (*GetRecoveryInfoResponseV2) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{35}
+	return fileDescriptor_82cd95f524594f49, []int{36}
 }
 
 func (m *GetRecoveryInfoResponseV2) XXX_Unmarshal(b []byte) error {
@@ -2452,7 +2831,7 @@ func (m *GetRecoveryInfoRequestV2) Reset()         { *m = GetRecoveryInfoRequest
 func (m *GetRecoveryInfoRequestV2) String() string { return proto.CompactTextString(m) }
 func (*GetRecoveryInfoRequestV2) ProtoMessage()    {}
 func (*GetRecoveryInfoRequestV2) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{36}
+	return fileDescriptor_82cd95f524594f49, []int{37}
 }
 
 func (m *GetRecoveryInfoRequestV2) XXX_Unmarshal(b []byte) error {
@@ -2508,7 +2887,7 @@ func (m *GetSegmentsByStatesRequest) Reset()         { *m = GetSegmentsByStatesR
 func (m *GetSegmentsByStatesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetSegmentsByStatesRequest) ProtoMessage()    {}
 func (*GetSegmentsByStatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{37}
+	return fileDescriptor_82cd95f524594f49, []int{38}
 }
 
 func (m *GetSegmentsByStatesRequest) XXX_Unmarshal(b []byte) error {
@@ -2569,7 +2948,7 @@ func (m *GetSegmentsByStatesResponse) Reset()         { *m = GetSegmentsByStates
 func (m *GetSegmentsByStatesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetSegmentsByStatesResponse) ProtoMessage()    {}
 func (*GetSegmentsByStatesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{38}
+	return fileDescriptor_82cd95f524594f49, []int{39}
 }
 
 func (m *GetSegmentsByStatesResponse) XXX_Unmarshal(b []byte) error {
@@ -2618,7 +2997,7 @@ func (m *GetFlushedSegmentsRequest) Reset()         { *m = GetFlushedSegmentsReq
 func (m *GetFlushedSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetFlushedSegmentsRequest) ProtoMessage()    {}
 func (*GetFlushedSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{39}
+	return fileDescriptor_82cd95f524594f49, []int{40}
 }
 
 func (m *GetFlushedSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -2679,7 +3058,7 @@ func (m *GetFlushedSegmentsResponse) Reset()         { *m = GetFlushedSegmentsRe
 func (m *GetFlushedSegmentsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetFlushedSegmentsResponse) ProtoMessage()    {}
 func (*GetFlushedSegmentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{40}
+	return fileDescriptor_82cd95f524594f49, []int{41}
 }
 
 func (m *GetFlushedSegmentsResponse) XXX_Unmarshal(b []byte) error {
@@ -2726,7 +3105,7 @@ func (m *SegmentFlushCompletedMsg) Reset()         { *m = SegmentFlushCompletedM
 func (m *SegmentFlushCompletedMsg) String() string { return proto.CompactTextString(m) }
 func (*SegmentFlushCompletedMsg) ProtoMessage()    {}
 func (*SegmentFlushCompletedMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{41}
+	return fileDescriptor_82cd95f524594f49, []int{42}
 }
 
 func (m *SegmentFlushCompletedMsg) XXX_Unmarshal(b []byte) error {
@@ -2771,17 +3150,21 @@ type ChannelWatchInfo struct {
 	// the schema of the collection to watch, to avoid get schema rpc issues.
 	Schema *schemapb.CollectionSchema `protobuf:"bytes,5,opt,name=schema,proto3" json:"schema,omitempty"`
 	// watch progress
-	Progress             int32    `protobuf:"varint,6,opt,name=progress,proto3" json:"progress,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Progress int32 `protobuf:"varint,6,opt,name=progress,proto3" json:"progress,omitempty"`
+	// the policy which assigned the channel to the current node
+	Policy string `protobuf:"bytes,7,opt,name=policy,proto3" json:"policy,omitempty"`
+	// the last K state transitions of the channel, oldest first
+	History              []*ChannelWatchStateTransition `protobuf:"bytes,8,rep,name=history,proto3" json:"history,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
 }
 
 func (m *ChannelWatchInfo) Reset()         { *m = ChannelWatchInfo{} }
 func (m *ChannelWatchInfo) String() string { return proto.CompactTextString(m) }
 func (*ChannelWatchInfo) ProtoMessage()    {}
 func (*ChannelWatchInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{42}
+	return fileDescriptor_82cd95f524594f49, []int{43}
 }
 
 func (m *ChannelWatchInfo) XXX_Unmarshal(b []byte) error {
@@ -2844,6 +3227,20 @@ func (m *ChannelWatchInfo) GetProgress() int32 {
 	return 0
 }
 
+func (m *ChannelWatchInfo) GetPolicy() string {
+	if m != nil {
+		return m.Policy
+	}
+	return ""
+}
+
+func (m *ChannelWatchInfo) GetHistory() []*ChannelWatchStateTransition {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
 type CompactionStateRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
@@ -2855,7 +3252,7 @@ func (m *CompactionStateRequest) Reset()         { *m = CompactionStateRequest{}
 func (m *CompactionStateRequest) String() string { return proto.CompactTextString(m) }
 func (*CompactionStateRequest) ProtoMessage()    {}
 func (*CompactionStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{43}
+	return fileDescriptor_82cd95f524594f49, []int{44}
 }
 
 func (m *CompactionStateRequest) XXX_Unmarshal(b []byte) error {
@@ -2884,21 +3281,24 @@ func (m *CompactionStateRequest) GetBase() *commonpb.MsgBase {
 }
 
 type SyncSegmentsRequest struct {
-	PlanID               int64          `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
-	CompactedTo          int64          `protobuf:"varint,2,opt,name=compacted_to,json=compactedTo,proto3" json:"compacted_to,omitempty"`
-	NumOfRows            int64          `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
-	CompactedFrom        []int64        `protobuf:"varint,4,rep,packed,name=compacted_from,json=compactedFrom,proto3" json:"compacted_from,omitempty"`
-	StatsLogs            []*FieldBinlog `protobuf:"bytes,5,rep,name=stats_logs,json=statsLogs,proto3" json:"stats_logs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	PlanID        int64          `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
+	CompactedTo   int64          `protobuf:"varint,2,opt,name=compacted_to,json=compactedTo,proto3" json:"compacted_to,omitempty"`
+	NumOfRows     int64          `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	CompactedFrom []int64        `protobuf:"varint,4,rep,packed,name=compacted_from,json=compactedFrom,proto3" json:"compacted_from,omitempty"`
+	StatsLogs     []*FieldBinlog `protobuf:"bytes,5,rep,name=stats_logs,json=statsLogs,proto3" json:"stats_logs,omitempty"`
+	// the channel and partition of the compacted segment, the segments compacted from may be not in the channel
+	ChannelName          string   `protobuf:"bytes,6,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
+	PartitionId          int64    `protobuf:"varint,7,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SyncSegmentsRequest) Reset()         { *m = SyncSegmentsRequest{} }
 func (m *SyncSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*SyncSegmentsRequest) ProtoMessage()    {}
 func (*SyncSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{44}
+	return fileDescriptor_82cd95f524594f49, []int{45}
 }
 
 func (m *SyncSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -2954,22 +3354,38 @@ func (m *SyncSegmentsRequest) GetStatsLogs() []*FieldBinlog {
 	return nil
 }
 
+func (m *SyncSegmentsRequest) GetChannelName() string {
+	if m != nil {
+		return m.ChannelName
+	}
+	return ""
+}
+
+func (m *SyncSegmentsRequest) GetPartitionId() int64 {
+	if m != nil {
+		return m.PartitionId
+	}
+	return 0
+}
+
 type CompactionSegmentBinlogs struct {
-	SegmentID            int64          `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	FieldBinlogs         []*FieldBinlog `protobuf:"bytes,2,rep,name=fieldBinlogs,proto3" json:"fieldBinlogs,omitempty"`
-	Field2StatslogPaths  []*FieldBinlog `protobuf:"bytes,3,rep,name=field2StatslogPaths,proto3" json:"field2StatslogPaths,omitempty"`
-	Deltalogs            []*FieldBinlog `protobuf:"bytes,4,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
-	InsertChannel        string         `protobuf:"bytes,5,opt,name=insert_channel,json=insertChannel,proto3" json:"insert_channel,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	SegmentID            int64           `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	FieldBinlogs         []*FieldBinlog  `protobuf:"bytes,2,rep,name=fieldBinlogs,proto3" json:"fieldBinlogs,omitempty"`
+	Field2StatslogPaths  []*FieldBinlog  `protobuf:"bytes,3,rep,name=field2StatslogPaths,proto3" json:"field2StatslogPaths,omitempty"`
+	Deltalogs            []*FieldBinlog  `protobuf:"bytes,4,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	InsertChannel        string          `protobuf:"bytes,5,opt,name=insert_channel,json=insertChannel,proto3" json:"insert_channel,omitempty"`
+	PartitionID          int64           `protobuf:"varint,6,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	ExternalSource       *ExternalSource `protobuf:"bytes,7,opt,name=external_source,json=externalSource,proto3" json:"external_source,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *CompactionSegmentBinlogs) Reset()         { *m = CompactionSegmentBinlogs{} }
 func (m *CompactionSegmentBinlogs) String() string { return proto.CompactTextString(m) }
 func (*CompactionSegmentBinlogs) ProtoMessage()    {}
 func (*CompactionSegmentBinlogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{45}
+	return fileDescriptor_82cd95f524594f49, []int{46}
 }
 
 func (m *CompactionSegmentBinlogs) XXX_Unmarshal(b []byte) error {
@@ -3025,26 +3441,42 @@ func (m *CompactionSegmentBinlogs) GetInsertChannel() string {
 	return ""
 }
 
+func (m *CompactionSegmentBinlogs) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *CompactionSegmentBinlogs) GetExternalSource() *ExternalSource {
+	if m != nil {
+		return m.ExternalSource
+	}
+	return nil
+}
+
 type CompactionPlan struct {
-	PlanID               int64                       `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
-	SegmentBinlogs       []*CompactionSegmentBinlogs `protobuf:"bytes,2,rep,name=segmentBinlogs,proto3" json:"segmentBinlogs,omitempty"`
-	StartTime            uint64                      `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	TimeoutInSeconds     int32                       `protobuf:"varint,4,opt,name=timeout_in_seconds,json=timeoutInSeconds,proto3" json:"timeout_in_seconds,omitempty"`
-	Type                 CompactionType              `protobuf:"varint,5,opt,name=type,proto3,enum=milvus.proto.data.CompactionType" json:"type,omitempty"`
-	Timetravel           uint64                      `protobuf:"varint,6,opt,name=timetravel,proto3" json:"timetravel,omitempty"`
-	Channel              string                      `protobuf:"bytes,7,opt,name=channel,proto3" json:"channel,omitempty"`
-	CollectionTtl        int64                       `protobuf:"varint,8,opt,name=collection_ttl,json=collectionTtl,proto3" json:"collection_ttl,omitempty"`
-	TotalRows            int64                       `protobuf:"varint,9,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+	PlanID           int64                       `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
+	SegmentBinlogs   []*CompactionSegmentBinlogs `protobuf:"bytes,2,rep,name=segmentBinlogs,proto3" json:"segmentBinlogs,omitempty"`
+	StartTime        uint64                      `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	TimeoutInSeconds int32                       `protobuf:"varint,4,opt,name=timeout_in_seconds,json=timeoutInSeconds,proto3" json:"timeout_in_seconds,omitempty"`
+	Type             CompactionType              `protobuf:"varint,5,opt,name=type,proto3,enum=milvus.proto.data.CompactionType" json:"type,omitempty"`
+	Timetravel       uint64                      `protobuf:"varint,6,opt,name=timetravel,proto3" json:"timetravel,omitempty"`
+	Channel          string                      `protobuf:"bytes,7,opt,name=channel,proto3" json:"channel,omitempty"`
+	CollectionTtl    int64                       `protobuf:"varint,8,opt,name=collection_ttl,json=collectionTtl,proto3" json:"collection_ttl,omitempty"`
+	TotalRows        int64                       `protobuf:"varint,9,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	// the key version to encrypt the compacted segment with, 0 means in plain text
+	EncryptionKeyVersion int64    `protobuf:"varint,10,opt,name=encryption_key_version,json=encryptionKeyVersion,proto3" json:"encryption_key_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CompactionPlan) Reset()         { *m = CompactionPlan{} }
 func (m *CompactionPlan) String() string { return proto.CompactTextString(m) }
 func (*CompactionPlan) ProtoMessage()    {}
 func (*CompactionPlan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{46}
+	return fileDescriptor_82cd95f524594f49, []int{47}
 }
 
 func (m *CompactionPlan) XXX_Unmarshal(b []byte) error {
@@ -3128,24 +3560,33 @@ func (m *CompactionPlan) GetTotalRows() int64 {
 	return 0
 }
 
+func (m *CompactionPlan) GetEncryptionKeyVersion() int64 {
+	if m != nil {
+		return m.EncryptionKeyVersion
+	}
+	return 0
+}
+
 type CompactionResult struct {
-	PlanID               int64          `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
-	SegmentID            int64          `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	NumOfRows            int64          `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
-	InsertLogs           []*FieldBinlog `protobuf:"bytes,4,rep,name=insert_logs,json=insertLogs,proto3" json:"insert_logs,omitempty"`
-	Field2StatslogPaths  []*FieldBinlog `protobuf:"bytes,5,rep,name=field2StatslogPaths,proto3" json:"field2StatslogPaths,omitempty"`
-	Deltalogs            []*FieldBinlog `protobuf:"bytes,6,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
-	Channel              string         `protobuf:"bytes,7,opt,name=channel,proto3" json:"channel,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	PlanID              int64          `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
+	SegmentID           int64          `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	NumOfRows           int64          `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	InsertLogs          []*FieldBinlog `protobuf:"bytes,4,rep,name=insert_logs,json=insertLogs,proto3" json:"insert_logs,omitempty"`
+	Field2StatslogPaths []*FieldBinlog `protobuf:"bytes,5,rep,name=field2StatslogPaths,proto3" json:"field2StatslogPaths,omitempty"`
+	Deltalogs           []*FieldBinlog `protobuf:"bytes,6,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	Channel             string         `protobuf:"bytes,7,opt,name=channel,proto3" json:"channel,omitempty"`
+	// the key version which the binlogs are encrypted with, 0 means in plain text
+	EncryptionKeyVersion int64    `protobuf:"varint,8,opt,name=encryption_key_version,json=encryptionKeyVersion,proto3" json:"encryption_key_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CompactionResult) Reset()         { *m = CompactionResult{} }
 func (m *CompactionResult) String() string { return proto.CompactTextString(m) }
 func (*CompactionResult) ProtoMessage()    {}
 func (*CompactionResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{47}
+	return fileDescriptor_82cd95f524594f49, []int{48}
 }
 
 func (m *CompactionResult) XXX_Unmarshal(b []byte) error {
@@ -3215,6 +3656,13 @@ func (m *CompactionResult) GetChannel() string {
 	return ""
 }
 
+func (m *CompactionResult) GetEncryptionKeyVersion() int64 {
+	if m != nil {
+		return m.EncryptionKeyVersion
+	}
+	return 0
+}
+
 type CompactionStateResult struct {
 	PlanID               int64                    `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
 	State                commonpb.CompactionState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.CompactionState" json:"state,omitempty"`
@@ -3228,7 +3676,7 @@ func (m *CompactionStateResult) Reset()         { *m = CompactionStateResult{} }
 func (m *CompactionStateResult) String() string { return proto.CompactTextString(m) }
 func (*CompactionStateResult) ProtoMessage()    {}
 func (*CompactionStateResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{48}
+	return fileDescriptor_82cd95f524594f49, []int{49}
 }
 
 func (m *CompactionStateResult) XXX_Unmarshal(b []byte) error {
@@ -3282,7 +3730,7 @@ func (m *CompactionStateResponse) Reset()         { *m = CompactionStateResponse
 func (m *CompactionStateResponse) String() string { return proto.CompactTextString(m) }
 func (*CompactionStateResponse) ProtoMessage()    {}
 func (*CompactionStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{49}
+	return fileDescriptor_82cd95f524594f49, []int{50}
 }
 
 func (m *CompactionStateResponse) XXX_Unmarshal(b []byte) error {
@@ -3330,7 +3778,7 @@ func (m *SegmentFieldBinlogMeta) Reset()         { *m = SegmentFieldBinlogMeta{}
 func (m *SegmentFieldBinlogMeta) String() string { return proto.CompactTextString(m) }
 func (*SegmentFieldBinlogMeta) ProtoMessage()    {}
 func (*SegmentFieldBinlogMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{50}
+	return fileDescriptor_82cd95f524594f49, []int{51}
 }
 
 func (m *SegmentFieldBinlogMeta) XXX_Unmarshal(b []byte) error {
@@ -3379,7 +3827,7 @@ func (m *WatchChannelsRequest) Reset()         { *m = WatchChannelsRequest{} }
 func (m *WatchChannelsRequest) String() string { return proto.CompactTextString(m) }
 func (*WatchChannelsRequest) ProtoMessage()    {}
 func (*WatchChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{51}
+	return fileDescriptor_82cd95f524594f49, []int{52}
 }
 
 func (m *WatchChannelsRequest) XXX_Unmarshal(b []byte) error {
@@ -3439,7 +3887,7 @@ func (m *WatchChannelsResponse) Reset()         { *m = WatchChannelsResponse{} }
 func (m *WatchChannelsResponse) String() string { return proto.CompactTextString(m) }
 func (*WatchChannelsResponse) ProtoMessage()    {}
 func (*WatchChannelsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{52}
+	return fileDescriptor_82cd95f524594f49, []int{53}
 }
 
 func (m *WatchChannelsResponse) XXX_Unmarshal(b []byte) error {
@@ -3480,7 +3928,7 @@ func (m *SetSegmentStateRequest) Reset()         { *m = SetSegmentStateRequest{}
 func (m *SetSegmentStateRequest) String() string { return proto.CompactTextString(m) }
 func (*SetSegmentStateRequest) ProtoMessage()    {}
 func (*SetSegmentStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{53}
+	return fileDescriptor_82cd95f524594f49, []int{54}
 }
 
 func (m *SetSegmentStateRequest) XXX_Unmarshal(b []byte) error {
@@ -3533,7 +3981,7 @@ func (m *SetSegmentStateResponse) Reset()         { *m = SetSegmentStateResponse
 func (m *SetSegmentStateResponse) String() string { return proto.CompactTextString(m) }
 func (*SetSegmentStateResponse) ProtoMessage()    {}
 func (*SetSegmentStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{54}
+	return fileDescriptor_82cd95f524594f49, []int{55}
 }
 
 func (m *SetSegmentStateResponse) XXX_Unmarshal(b []byte) error {
@@ -3574,7 +4022,7 @@ func (m *DropVirtualChannelRequest) Reset()         { *m = DropVirtualChannelReq
 func (m *DropVirtualChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*DropVirtualChannelRequest) ProtoMessage()    {}
 func (*DropVirtualChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{55}
+	return fileDescriptor_82cd95f524594f49, []int{56}
 }
 
 func (m *DropVirtualChannelRequest) XXX_Unmarshal(b []byte) error {
@@ -3634,7 +4082,7 @@ func (m *DropVirtualChannelSegment) Reset()         { *m = DropVirtualChannelSeg
 func (m *DropVirtualChannelSegment) String() string { return proto.CompactTextString(m) }
 func (*DropVirtualChannelSegment) ProtoMessage()    {}
 func (*DropVirtualChannelSegment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{56}
+	return fileDescriptor_82cd95f524594f49, []int{57}
 }
 
 func (m *DropVirtualChannelSegment) XXX_Unmarshal(b []byte) error {
@@ -3722,7 +4170,7 @@ func (m *DropVirtualChannelResponse) Reset()         { *m = DropVirtualChannelRe
 func (m *DropVirtualChannelResponse) String() string { return proto.CompactTextString(m) }
 func (*DropVirtualChannelResponse) ProtoMessage()    {}
 func (*DropVirtualChannelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{57}
+	return fileDescriptor_82cd95f524594f49, []int{58}
 }
 
 func (m *DropVirtualChannelResponse) XXX_Unmarshal(b []byte) error {
@@ -3769,7 +4217,7 @@ func (m *ImportTask) Reset()         { *m = ImportTask{} }
 func (m *ImportTask) String() string { return proto.CompactTextString(m) }
 func (*ImportTask) ProtoMessage()    {}
 func (*ImportTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{58}
+	return fileDescriptor_82cd95f524594f49, []int{59}
 }
 
 func (m *ImportTask) XXX_Unmarshal(b []byte) error {
@@ -3868,7 +4316,7 @@ func (m *ImportTaskState) Reset()         { *m = ImportTaskState{} }
 func (m *ImportTaskState) String() string { return proto.CompactTextString(m) }
 func (*ImportTaskState) ProtoMessage()    {}
 func (*ImportTaskState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{59}
+	return fileDescriptor_82cd95f524594f49, []int{60}
 }
 
 func (m *ImportTaskState) XXX_Unmarshal(b []byte) error {
@@ -3941,6 +4389,11 @@ type ImportTaskInfo struct {
 	Infos                []*commonpb.KeyValuePair `protobuf:"bytes,14,rep,name=infos,proto3" json:"infos,omitempty"`
 	StartTs              int64                    `protobuf:"varint,15,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
 	DatabaseName         string                   `protobuf:"bytes,16,opt,name=database_name,json=databaseName,proto3" json:"database_name,omitempty"`
+	JobId                int64                    `protobuf:"varint,17,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	RetryCount           int64                    `protobuf:"varint,18,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+	Checkpoint           *ImportCheckpoint        `protobuf:"bytes,19,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	AttemptCheckpoint    *ImportCheckpoint        `protobuf:"bytes,20,opt,name=attempt_checkpoint,json=attemptCheckpoint,proto3" json:"attempt_checkpoint,omitempty"`
+	DiscardedSegments    []int64                  `protobuf:"varint,21,rep,packed,name=discarded_segments,json=discardedSegments,proto3" json:"discarded_segments,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -3950,7 +4403,7 @@ func (m *ImportTaskInfo) Reset()         { *m = ImportTaskInfo{} }
 func (m *ImportTaskInfo) String() string { return proto.CompactTextString(m) }
 func (*ImportTaskInfo) ProtoMessage()    {}
 func (*ImportTaskInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{60}
+	return fileDescriptor_82cd95f524594f49, []int{61}
 }
 
 func (m *ImportTaskInfo) XXX_Unmarshal(b []byte) error {
@@ -4084,6 +4537,106 @@ func (m *ImportTaskInfo) GetDatabaseName() string {
 	return ""
 }
 
+func (m *ImportTaskInfo) GetJobId() int64 {
+	if m != nil {
+		return m.JobId
+	}
+	return 0
+}
+
+func (m *ImportTaskInfo) GetRetryCount() int64 {
+	if m != nil {
+		return m.RetryCount
+	}
+	return 0
+}
+
+func (m *ImportTaskInfo) GetCheckpoint() *ImportCheckpoint {
+	if m != nil {
+		return m.Checkpoint
+	}
+	return nil
+}
+
+func (m *ImportTaskInfo) GetAttemptCheckpoint() *ImportCheckpoint {
+	if m != nil {
+		return m.AttemptCheckpoint
+	}
+	return nil
+}
+
+func (m *ImportTaskInfo) GetDiscardedSegments() []int64 {
+	if m != nil {
+		return m.DiscardedSegments
+	}
+	return nil
+}
+
+// ImportCheckpoint is the progress of an import task committed at file boundaries, a retried task resumes from
+// the checkpoint instead of importing the completed files again.
+type ImportCheckpoint struct {
+	Files                []string `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	Segments             []int64  `protobuf:"varint,2,rep,packed,name=segments,proto3" json:"segments,omitempty"`
+	RowCount             int64    `protobuf:"varint,3,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	AutoIds              []int64  `protobuf:"varint,4,rep,packed,name=auto_ids,json=autoIds,proto3" json:"auto_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportCheckpoint) Reset()         { *m = ImportCheckpoint{} }
+func (m *ImportCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*ImportCheckpoint) ProtoMessage()    {}
+func (*ImportCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{62}
+}
+
+func (m *ImportCheckpoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportCheckpoint.Unmarshal(m, b)
+}
+func (m *ImportCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportCheckpoint.Marshal(b, m, deterministic)
+}
+func (m *ImportCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportCheckpoint.Merge(m, src)
+}
+func (m *ImportCheckpoint) XXX_Size() int {
+	return xxx_messageInfo_ImportCheckpoint.Size(m)
+}
+func (m *ImportCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportCheckpoint proto.InternalMessageInfo
+
+func (m *ImportCheckpoint) GetFiles() []string {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+func (m *ImportCheckpoint) GetSegments() []int64 {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+func (m *ImportCheckpoint) GetRowCount() int64 {
+	if m != nil {
+		return m.RowCount
+	}
+	return 0
+}
+
+func (m *ImportCheckpoint) GetAutoIds() []int64 {
+	if m != nil {
+		return m.AutoIds
+	}
+	return nil
+}
+
 type ImportTaskResponse struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	DatanodeId           int64            `protobuf:"varint,2,opt,name=datanode_id,json=datanodeId,proto3" json:"datanode_id,omitempty"`
@@ -4096,7 +4649,7 @@ func (m *ImportTaskResponse) Reset()         { *m = ImportTaskResponse{} }
 func (m *ImportTaskResponse) String() string { return proto.CompactTextString(m) }
 func (*ImportTaskResponse) ProtoMessage()    {}
 func (*ImportTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{61}
+	return fileDescriptor_82cd95f524594f49, []int{63}
 }
 
 func (m *ImportTaskResponse) XXX_Unmarshal(b []byte) error {
@@ -4144,7 +4697,7 @@ func (m *ImportTaskRequest) Reset()         { *m = ImportTaskRequest{} }
 func (m *ImportTaskRequest) String() string { return proto.CompactTextString(m) }
 func (*ImportTaskRequest) ProtoMessage()    {}
 func (*ImportTaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{62}
+	return fileDescriptor_82cd95f524594f49, []int{64}
 }
 
 func (m *ImportTaskRequest) XXX_Unmarshal(b []byte) error {
@@ -4198,7 +4751,7 @@ func (m *UpdateSegmentStatisticsRequest) Reset()         { *m = UpdateSegmentSta
 func (m *UpdateSegmentStatisticsRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateSegmentStatisticsRequest) ProtoMessage()    {}
 func (*UpdateSegmentStatisticsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{63}
+	return fileDescriptor_82cd95f524594f49, []int{65}
 }
 
 func (m *UpdateSegmentStatisticsRequest) XXX_Unmarshal(b []byte) error {
@@ -4246,7 +4799,7 @@ func (m *UpdateChannelCheckpointRequest) Reset()         { *m = UpdateChannelChe
 func (m *UpdateChannelCheckpointRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateChannelCheckpointRequest) ProtoMessage()    {}
 func (*UpdateChannelCheckpointRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{64}
+	return fileDescriptor_82cd95f524594f49, []int{66}
 }
 
 func (m *UpdateChannelCheckpointRequest) XXX_Unmarshal(b []byte) error {
@@ -4289,17 +4842,21 @@ func (m *UpdateChannelCheckpointRequest) GetPosition() *msgpb.MsgPosition {
 }
 
 type ResendSegmentStatsRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// resend the stats of the segments of the collection only, 0 for all collections
+	CollectionID int64 `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// resend the stats of the segments of the channel only, empty for all channels
+	ChannelName          string   `protobuf:"bytes,3,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ResendSegmentStatsRequest) Reset()         { *m = ResendSegmentStatsRequest{} }
 func (m *ResendSegmentStatsRequest) String() string { return proto.CompactTextString(m) }
 func (*ResendSegmentStatsRequest) ProtoMessage()    {}
 func (*ResendSegmentStatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{65}
+	return fileDescriptor_82cd95f524594f49, []int{67}
 }
 
 func (m *ResendSegmentStatsRequest) XXX_Unmarshal(b []byte) error {
@@ -4327,6 +4884,20 @@ func (m *ResendSegmentStatsRequest) GetBase() *commonpb.MsgBase {
 	return nil
 }
 
+func (m *ResendSegmentStatsRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ResendSegmentStatsRequest) GetChannelName() string {
+	if m != nil {
+		return m.ChannelName
+	}
+	return ""
+}
+
 type ResendSegmentStatsResponse struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	SegResent            []int64          `protobuf:"varint,2,rep,packed,name=seg_resent,json=segResent,proto3" json:"seg_resent,omitempty"`
@@ -4339,7 +4910,7 @@ func (m *ResendSegmentStatsResponse) Reset()         { *m = ResendSegmentStatsRe
 func (m *ResendSegmentStatsResponse) String() string { return proto.CompactTextString(m) }
 func (*ResendSegmentStatsResponse) ProtoMessage()    {}
 func (*ResendSegmentStatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{66}
+	return fileDescriptor_82cd95f524594f49, []int{68}
 }
 
 func (m *ResendSegmentStatsResponse) XXX_Unmarshal(b []byte) error {
@@ -4391,7 +4962,7 @@ func (m *AddImportSegmentRequest) Reset()         { *m = AddImportSegmentRequest
 func (m *AddImportSegmentRequest) String() string { return proto.CompactTextString(m) }
 func (*AddImportSegmentRequest) ProtoMessage()    {}
 func (*AddImportSegmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{67}
+	return fileDescriptor_82cd95f524594f49, []int{69}
 }
 
 func (m *AddImportSegmentRequest) XXX_Unmarshal(b []byte) error {
@@ -4473,7 +5044,7 @@ func (m *AddImportSegmentResponse) Reset()         { *m = AddImportSegmentRespon
 func (m *AddImportSegmentResponse) String() string { return proto.CompactTextString(m) }
 func (*AddImportSegmentResponse) ProtoMessage()    {}
 func (*AddImportSegmentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{68}
+	return fileDescriptor_82cd95f524594f49, []int{70}
 }
 
 func (m *AddImportSegmentResponse) XXX_Unmarshal(b []byte) error {
@@ -4509,24 +5080,26 @@ func (m *AddImportSegmentResponse) GetChannelPos() []byte {
 }
 
 type SaveImportSegmentRequest struct {
-	Base                 *commonpb.MsgBase       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	SegmentId            int64                   `protobuf:"varint,2,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
-	ChannelName          string                  `protobuf:"bytes,3,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
-	CollectionId         int64                   `protobuf:"varint,4,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
-	PartitionId          int64                   `protobuf:"varint,5,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"`
-	RowNum               int64                   `protobuf:"varint,6,opt,name=row_num,json=rowNum,proto3" json:"row_num,omitempty"`
-	SaveBinlogPathReq    *SaveBinlogPathsRequest `protobuf:"bytes,7,opt,name=save_binlog_path_req,json=saveBinlogPathReq,proto3" json:"save_binlog_path_req,omitempty"`
-	DmlPositionId        []byte                  `protobuf:"bytes,8,opt,name=dml_position_id,json=dmlPositionId,proto3" json:"dml_position_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_unrecognized     []byte                  `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+	Base              *commonpb.MsgBase       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	SegmentId         int64                   `protobuf:"varint,2,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
+	ChannelName       string                  `protobuf:"bytes,3,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
+	CollectionId      int64                   `protobuf:"varint,4,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	PartitionId       int64                   `protobuf:"varint,5,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"`
+	RowNum            int64                   `protobuf:"varint,6,opt,name=row_num,json=rowNum,proto3" json:"row_num,omitempty"`
+	SaveBinlogPathReq *SaveBinlogPathsRequest `protobuf:"bytes,7,opt,name=save_binlog_path_req,json=saveBinlogPathReq,proto3" json:"save_binlog_path_req,omitempty"`
+	DmlPositionId     []byte                  `protobuf:"bytes,8,opt,name=dml_position_id,json=dmlPositionId,proto3" json:"dml_position_id,omitempty"`
+	// the segment is registered on the external files without binlogs
+	ExternalSource       *ExternalSource `protobuf:"bytes,9,opt,name=external_source,json=externalSource,proto3" json:"external_source,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *SaveImportSegmentRequest) Reset()         { *m = SaveImportSegmentRequest{} }
 func (m *SaveImportSegmentRequest) String() string { return proto.CompactTextString(m) }
 func (*SaveImportSegmentRequest) ProtoMessage()    {}
 func (*SaveImportSegmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{69}
+	return fileDescriptor_82cd95f524594f49, []int{71}
 }
 
 func (m *SaveImportSegmentRequest) XXX_Unmarshal(b []byte) error {
@@ -4603,6 +5176,13 @@ func (m *SaveImportSegmentRequest) GetDmlPositionId() []byte {
 	return nil
 }
 
+func (m *SaveImportSegmentRequest) GetExternalSource() *ExternalSource {
+	if m != nil {
+		return m.ExternalSource
+	}
+	return nil
+}
+
 type UnsetIsImportingStateRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	SegmentIds           []int64           `protobuf:"varint,2,rep,packed,name=segment_ids,json=segmentIds,proto3" json:"segment_ids,omitempty"`
@@ -4615,7 +5195,7 @@ func (m *UnsetIsImportingStateRequest) Reset()         { *m = UnsetIsImportingSt
 func (m *UnsetIsImportingStateRequest) String() string { return proto.CompactTextString(m) }
 func (*UnsetIsImportingStateRequest) ProtoMessage()    {}
 func (*UnsetIsImportingStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{70}
+	return fileDescriptor_82cd95f524594f49, []int{72}
 }
 
 func (m *UnsetIsImportingStateRequest) XXX_Unmarshal(b []byte) error {
@@ -4662,7 +5242,7 @@ func (m *MarkSegmentsDroppedRequest) Reset()         { *m = MarkSegmentsDroppedR
 func (m *MarkSegmentsDroppedRequest) String() string { return proto.CompactTextString(m) }
 func (*MarkSegmentsDroppedRequest) ProtoMessage()    {}
 func (*MarkSegmentsDroppedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{71}
+	return fileDescriptor_82cd95f524594f49, []int{73}
 }
 
 func (m *MarkSegmentsDroppedRequest) XXX_Unmarshal(b []byte) error {
@@ -4710,7 +5290,7 @@ func (m *SegmentReferenceLock) Reset()         { *m = SegmentReferenceLock{} }
 func (m *SegmentReferenceLock) String() string { return proto.CompactTextString(m) }
 func (*SegmentReferenceLock) ProtoMessage()    {}
 func (*SegmentReferenceLock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{72}
+	return fileDescriptor_82cd95f524594f49, []int{74}
 }
 
 func (m *SegmentReferenceLock) XXX_Unmarshal(b []byte) error {
@@ -4767,7 +5347,7 @@ func (m *AlterCollectionRequest) Reset()         { *m = AlterCollectionRequest{}
 func (m *AlterCollectionRequest) String() string { return proto.CompactTextString(m) }
 func (*AlterCollectionRequest) ProtoMessage()    {}
 func (*AlterCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{73}
+	return fileDescriptor_82cd95f524594f49, []int{75}
 }
 
 func (m *AlterCollectionRequest) XXX_Unmarshal(b []byte) error {
@@ -4835,7 +5415,7 @@ func (m *GcConfirmRequest) Reset()         { *m = GcConfirmRequest{} }
 func (m *GcConfirmRequest) String() string { return proto.CompactTextString(m) }
 func (*GcConfirmRequest) ProtoMessage()    {}
 func (*GcConfirmRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{74}
+	return fileDescriptor_82cd95f524594f49, []int{76}
 }
 
 func (m *GcConfirmRequest) XXX_Unmarshal(b []byte) error {
@@ -4882,7 +5462,7 @@ func (m *GcConfirmResponse) Reset()         { *m = GcConfirmResponse{} }
 func (m *GcConfirmResponse) String() string { return proto.CompactTextString(m) }
 func (*GcConfirmResponse) ProtoMessage()    {}
 func (*GcConfirmResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{75}
+	return fileDescriptor_82cd95f524594f49, []int{77}
 }
 
 func (m *GcConfirmResponse) XXX_Unmarshal(b []byte) error {
@@ -4929,7 +5509,7 @@ func (m *ReportDataNodeTtMsgsRequest) Reset()         { *m = ReportDataNodeTtMsg
 func (m *ReportDataNodeTtMsgsRequest) String() string { return proto.CompactTextString(m) }
 func (*ReportDataNodeTtMsgsRequest) ProtoMessage()    {}
 func (*ReportDataNodeTtMsgsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{76}
+	return fileDescriptor_82cd95f524594f49, []int{78}
 }
 
 func (m *ReportDataNodeTtMsgsRequest) XXX_Unmarshal(b []byte) error {
@@ -4964,1843 +5544,7224 @@ func (m *ReportDataNodeTtMsgsRequest) GetMsgs() []*msgpb.DataNodeTtMsg {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("milvus.proto.data.SegmentType", SegmentType_name, SegmentType_value)
-	proto.RegisterEnum("milvus.proto.data.ChannelWatchState", ChannelWatchState_name, ChannelWatchState_value)
-	proto.RegisterEnum("milvus.proto.data.CompactionType", CompactionType_name, CompactionType_value)
-	proto.RegisterType((*Empty)(nil), "milvus.proto.data.Empty")
-	proto.RegisterType((*FlushRequest)(nil), "milvus.proto.data.FlushRequest")
-	proto.RegisterType((*FlushResponse)(nil), "milvus.proto.data.FlushResponse")
-	proto.RegisterType((*SegmentIDRequest)(nil), "milvus.proto.data.SegmentIDRequest")
-	proto.RegisterType((*AssignSegmentIDRequest)(nil), "milvus.proto.data.AssignSegmentIDRequest")
-	proto.RegisterType((*SegmentIDAssignment)(nil), "milvus.proto.data.SegmentIDAssignment")
-	proto.RegisterType((*AssignSegmentIDResponse)(nil), "milvus.proto.data.AssignSegmentIDResponse")
-	proto.RegisterType((*GetSegmentStatesRequest)(nil), "milvus.proto.data.GetSegmentStatesRequest")
-	proto.RegisterType((*SegmentStateInfo)(nil), "milvus.proto.data.SegmentStateInfo")
-	proto.RegisterType((*GetSegmentStatesResponse)(nil), "milvus.proto.data.GetSegmentStatesResponse")
-	proto.RegisterType((*GetSegmentInfoRequest)(nil), "milvus.proto.data.GetSegmentInfoRequest")
-	proto.RegisterType((*GetSegmentInfoResponse)(nil), "milvus.proto.data.GetSegmentInfoResponse")
-	proto.RegisterMapType((map[string]*msgpb.MsgPosition)(nil), "milvus.proto.data.GetSegmentInfoResponse.ChannelCheckpointEntry")
-	proto.RegisterType((*GetInsertBinlogPathsRequest)(nil), "milvus.proto.data.GetInsertBinlogPathsRequest")
-	proto.RegisterType((*GetInsertBinlogPathsResponse)(nil), "milvus.proto.data.GetInsertBinlogPathsResponse")
-	proto.RegisterType((*GetCollectionStatisticsRequest)(nil), "milvus.proto.data.GetCollectionStatisticsRequest")
-	proto.RegisterType((*GetCollectionStatisticsResponse)(nil), "milvus.proto.data.GetCollectionStatisticsResponse")
-	proto.RegisterType((*GetPartitionStatisticsRequest)(nil), "milvus.proto.data.GetPartitionStatisticsRequest")
-	proto.RegisterType((*GetPartitionStatisticsResponse)(nil), "milvus.proto.data.GetPartitionStatisticsResponse")
-	proto.RegisterType((*GetSegmentInfoChannelRequest)(nil), "milvus.proto.data.GetSegmentInfoChannelRequest")
-	proto.RegisterType((*VchannelInfo)(nil), "milvus.proto.data.VchannelInfo")
-	proto.RegisterType((*WatchDmChannelsRequest)(nil), "milvus.proto.data.WatchDmChannelsRequest")
-	proto.RegisterType((*FlushSegmentsRequest)(nil), "milvus.proto.data.FlushSegmentsRequest")
-	proto.RegisterType((*SegmentMsg)(nil), "milvus.proto.data.SegmentMsg")
-	proto.RegisterType((*SegmentInfo)(nil), "milvus.proto.data.SegmentInfo")
-	proto.RegisterType((*SegmentStartPosition)(nil), "milvus.proto.data.SegmentStartPosition")
-	proto.RegisterType((*SaveBinlogPathsRequest)(nil), "milvus.proto.data.SaveBinlogPathsRequest")
-	proto.RegisterType((*CheckPoint)(nil), "milvus.proto.data.CheckPoint")
-	proto.RegisterType((*DeltaLogInfo)(nil), "milvus.proto.data.DeltaLogInfo")
-	proto.RegisterType((*ChannelStatus)(nil), "milvus.proto.data.ChannelStatus")
-	proto.RegisterType((*DataNodeInfo)(nil), "milvus.proto.data.DataNodeInfo")
-	proto.RegisterType((*SegmentBinlogs)(nil), "milvus.proto.data.SegmentBinlogs")
-	proto.RegisterType((*FieldBinlog)(nil), "milvus.proto.data.FieldBinlog")
-	proto.RegisterType((*Binlog)(nil), "milvus.proto.data.Binlog")
-	proto.RegisterType((*GetRecoveryInfoResponse)(nil), "milvus.proto.data.GetRecoveryInfoResponse")
-	proto.RegisterType((*GetRecoveryInfoRequest)(nil), "milvus.proto.data.GetRecoveryInfoRequest")
-	proto.RegisterType((*GetRecoveryInfoResponseV2)(nil), "milvus.proto.data.GetRecoveryInfoResponseV2")
-	proto.RegisterType((*GetRecoveryInfoRequestV2)(nil), "milvus.proto.data.GetRecoveryInfoRequestV2")
-	proto.RegisterType((*GetSegmentsByStatesRequest)(nil), "milvus.proto.data.GetSegmentsByStatesRequest")
-	proto.RegisterType((*GetSegmentsByStatesResponse)(nil), "milvus.proto.data.GetSegmentsByStatesResponse")
-	proto.RegisterType((*GetFlushedSegmentsRequest)(nil), "milvus.proto.data.GetFlushedSegmentsRequest")
-	proto.RegisterType((*GetFlushedSegmentsResponse)(nil), "milvus.proto.data.GetFlushedSegmentsResponse")
-	proto.RegisterType((*SegmentFlushCompletedMsg)(nil), "milvus.proto.data.SegmentFlushCompletedMsg")
-	proto.RegisterType((*ChannelWatchInfo)(nil), "milvus.proto.data.ChannelWatchInfo")
-	proto.RegisterType((*CompactionStateRequest)(nil), "milvus.proto.data.CompactionStateRequest")
-	proto.RegisterType((*SyncSegmentsRequest)(nil), "milvus.proto.data.SyncSegmentsRequest")
-	proto.RegisterType((*CompactionSegmentBinlogs)(nil), "milvus.proto.data.CompactionSegmentBinlogs")
-	proto.RegisterType((*CompactionPlan)(nil), "milvus.proto.data.CompactionPlan")
-	proto.RegisterType((*CompactionResult)(nil), "milvus.proto.data.CompactionResult")
-	proto.RegisterType((*CompactionStateResult)(nil), "milvus.proto.data.CompactionStateResult")
-	proto.RegisterType((*CompactionStateResponse)(nil), "milvus.proto.data.CompactionStateResponse")
-	proto.RegisterType((*SegmentFieldBinlogMeta)(nil), "milvus.proto.data.SegmentFieldBinlogMeta")
-	proto.RegisterType((*WatchChannelsRequest)(nil), "milvus.proto.data.WatchChannelsRequest")
-	proto.RegisterType((*WatchChannelsResponse)(nil), "milvus.proto.data.WatchChannelsResponse")
-	proto.RegisterType((*SetSegmentStateRequest)(nil), "milvus.proto.data.SetSegmentStateRequest")
-	proto.RegisterType((*SetSegmentStateResponse)(nil), "milvus.proto.data.SetSegmentStateResponse")
-	proto.RegisterType((*DropVirtualChannelRequest)(nil), "milvus.proto.data.DropVirtualChannelRequest")
-	proto.RegisterType((*DropVirtualChannelSegment)(nil), "milvus.proto.data.DropVirtualChannelSegment")
-	proto.RegisterType((*DropVirtualChannelResponse)(nil), "milvus.proto.data.DropVirtualChannelResponse")
-	proto.RegisterType((*ImportTask)(nil), "milvus.proto.data.ImportTask")
-	proto.RegisterType((*ImportTaskState)(nil), "milvus.proto.data.ImportTaskState")
-	proto.RegisterType((*ImportTaskInfo)(nil), "milvus.proto.data.ImportTaskInfo")
-	proto.RegisterType((*ImportTaskResponse)(nil), "milvus.proto.data.ImportTaskResponse")
-	proto.RegisterType((*ImportTaskRequest)(nil), "milvus.proto.data.ImportTaskRequest")
-	proto.RegisterType((*UpdateSegmentStatisticsRequest)(nil), "milvus.proto.data.UpdateSegmentStatisticsRequest")
-	proto.RegisterType((*UpdateChannelCheckpointRequest)(nil), "milvus.proto.data.UpdateChannelCheckpointRequest")
-	proto.RegisterType((*ResendSegmentStatsRequest)(nil), "milvus.proto.data.ResendSegmentStatsRequest")
-	proto.RegisterType((*ResendSegmentStatsResponse)(nil), "milvus.proto.data.ResendSegmentStatsResponse")
-	proto.RegisterType((*AddImportSegmentRequest)(nil), "milvus.proto.data.AddImportSegmentRequest")
-	proto.RegisterType((*AddImportSegmentResponse)(nil), "milvus.proto.data.AddImportSegmentResponse")
-	proto.RegisterType((*SaveImportSegmentRequest)(nil), "milvus.proto.data.SaveImportSegmentRequest")
-	proto.RegisterType((*UnsetIsImportingStateRequest)(nil), "milvus.proto.data.UnsetIsImportingStateRequest")
-	proto.RegisterType((*MarkSegmentsDroppedRequest)(nil), "milvus.proto.data.MarkSegmentsDroppedRequest")
-	proto.RegisterType((*SegmentReferenceLock)(nil), "milvus.proto.data.SegmentReferenceLock")
-	proto.RegisterType((*AlterCollectionRequest)(nil), "milvus.proto.data.AlterCollectionRequest")
-	proto.RegisterType((*GcConfirmRequest)(nil), "milvus.proto.data.GcConfirmRequest")
-	proto.RegisterType((*GcConfirmResponse)(nil), "milvus.proto.data.GcConfirmResponse")
-	proto.RegisterType((*ReportDataNodeTtMsgsRequest)(nil), "milvus.proto.data.ReportDataNodeTtMsgsRequest")
+type ChannelWatchStateTransition struct {
+	State  ChannelWatchState `protobuf:"varint,1,opt,name=state,proto3,enum=milvus.proto.data.ChannelWatchState" json:"state,omitempty"`
+	NodeID int64             `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	// unix timestamp in seconds when the transition happened
+	Timestamp            int64    `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Policy               string   `protobuf:"bytes,4,opt,name=policy,proto3" json:"policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("data_coord.proto", fileDescriptor_82cd95f524594f49) }
-
-var fileDescriptor_82cd95f524594f49 = []byte{
-	// 4793 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x3c, 0xdd, 0x8f, 0x1c, 0x47,
-	0x5e, 0xee, 0xf9, 0xda, 0x99, 0xdf, 0xcc, 0xce, 0xce, 0x96, 0x9d, 0xf5, 0x78, 0x1c, 0x7f, 0x5c,
-	0xdb, 0x4e, 0x36, 0x4e, 0x62, 0x27, 0x6b, 0x4e, 0x97, 0x4b, 0x2e, 0xb9, 0xf3, 0xee, 0xc6, 0xce,
-	0x80, 0xd7, 0xb7, 0xd7, 0xbb, 0x76, 0x50, 0x0e, 0x69, 0xd4, 0x9e, 0xae, 0x9d, 0xed, 0xdb, 0x9e,
-	0xee, 0x71, 0x77, 0xcf, 0xae, 0x37, 0x48, 0x10, 0xe0, 0x40, 0xe2, 0x43, 0x80, 0x10, 0x48, 0xf0,
-	0x76, 0xe2, 0x01, 0x1d, 0xa0, 0x7b, 0x02, 0x1e, 0x40, 0x48, 0xf7, 0x1a, 0xc4, 0x03, 0x42, 0x48,
-	0x08, 0x1e, 0x78, 0x45, 0xf0, 0xcc, 0x3f, 0x80, 0xea, 0xa3, 0xab, 0xbf, 0xaa, 0x7b, 0x7a, 0x77,
-	0xec, 0x58, 0x82, 0xb7, 0xa9, 0xea, 0x5f, 0x55, 0xfd, 0xea, 0x57, 0xbf, 0xef, 0x5f, 0xd5, 0x40,
-	0xc7, 0xd0, 0x7d, 0x7d, 0x30, 0x74, 0x1c, 0xd7, 0xb8, 0x35, 0x71, 0x1d, 0xdf, 0x41, 0xcb, 0x63,
-	0xd3, 0x3a, 0x9c, 0x7a, 0xac, 0x75, 0x8b, 0x7c, 0xee, 0xb5, 0x86, 0xce, 0x78, 0xec, 0xd8, 0xac,
-	0xab, 0xd7, 0x36, 0x6d, 0x1f, 0xbb, 0xb6, 0x6e, 0xf1, 0x76, 0x2b, 0x3a, 0xa0, 0xd7, 0xf2, 0x86,
-	0xfb, 0x78, 0xac, 0xf3, 0x56, 0x63, 0xec, 0x8d, 0xf8, 0xcf, 0x65, 0xd3, 0x36, 0xf0, 0xb3, 0xe8,
-	0x52, 0xea, 0x02, 0x54, 0x3f, 0x1e, 0x4f, 0xfc, 0x63, 0xf5, 0xaf, 0x15, 0x68, 0xdd, 0xb3, 0xa6,
-	0xde, 0xbe, 0x86, 0x9f, 0x4e, 0xb1, 0xe7, 0xa3, 0x77, 0xa0, 0xf2, 0x44, 0xf7, 0x70, 0x57, 0xb9,
-	0xaa, 0xac, 0x36, 0xd7, 0x5e, 0xbd, 0x15, 0xc3, 0x89, 0x63, 0xb3, 0xe5, 0x8d, 0xd6, 0x75, 0x0f,
-	0x6b, 0x14, 0x12, 0x21, 0xa8, 0x18, 0x4f, 0xfa, 0x9b, 0xdd, 0xd2, 0x55, 0x65, 0xb5, 0xac, 0xd1,
-	0xdf, 0xe8, 0x32, 0x80, 0x87, 0x47, 0x63, 0x6c, 0xfb, 0xfd, 0x4d, 0xaf, 0x5b, 0xbe, 0x5a, 0x5e,
-	0x2d, 0x6b, 0x91, 0x1e, 0xa4, 0x42, 0x6b, 0xe8, 0x58, 0x16, 0x1e, 0xfa, 0xa6, 0x63, 0xf7, 0x37,
-	0xbb, 0x15, 0x3a, 0x36, 0xd6, 0x87, 0x7a, 0x50, 0x37, 0xbd, 0xfe, 0x78, 0xe2, 0xb8, 0x7e, 0xb7,
-	0x7a, 0x55, 0x59, 0xad, 0x6b, 0xa2, 0xad, 0xfe, 0xa7, 0x02, 0x8b, 0x1c, 0x6d, 0x6f, 0xe2, 0xd8,
-	0x1e, 0x46, 0x77, 0xa0, 0xe6, 0xf9, 0xba, 0x3f, 0xf5, 0x38, 0xe6, 0x17, 0xa5, 0x98, 0xef, 0x50,
-	0x10, 0x8d, 0x83, 0x4a, 0x51, 0x4f, 0xa2, 0x56, 0x96, 0xa0, 0x16, 0xdf, 0x5e, 0x25, 0xb5, 0xbd,
-	0x55, 0x58, 0xda, 0x23, 0xd8, 0xed, 0x84, 0x40, 0x55, 0x0a, 0x94, 0xec, 0x26, 0x33, 0xf9, 0xe6,
-	0x18, 0x7f, 0x77, 0x6f, 0x07, 0xeb, 0x56, 0xb7, 0x46, 0xd7, 0x8a, 0xf4, 0xa8, 0xff, 0xac, 0x40,
-	0x47, 0x80, 0x07, 0x67, 0x74, 0x0e, 0xaa, 0x43, 0x67, 0x6a, 0xfb, 0x74, 0xab, 0x8b, 0x1a, 0x6b,
-	0xa0, 0xaf, 0x41, 0x6b, 0xb8, 0xaf, 0xdb, 0x36, 0xb6, 0x06, 0xb6, 0x3e, 0xc6, 0x74, 0x53, 0x0d,
-	0xad, 0xc9, 0xfb, 0x1e, 0xea, 0x63, 0x5c, 0x68, 0x6f, 0x57, 0xa1, 0x39, 0xd1, 0x5d, 0xdf, 0x8c,
-	0x9d, 0x4c, 0xb4, 0x2b, 0xef, 0x60, 0xc8, 0x0a, 0x26, 0xfd, 0xb5, 0xab, 0x7b, 0x07, 0xfd, 0x4d,
-	0xbe, 0xa3, 0x58, 0x9f, 0xfa, 0x23, 0x05, 0x56, 0xee, 0x7a, 0x9e, 0x39, 0xb2, 0x53, 0x3b, 0x5b,
-	0x81, 0x9a, 0xed, 0x18, 0xb8, 0xbf, 0x49, 0xb7, 0x56, 0xd6, 0x78, 0x0b, 0x5d, 0x84, 0xc6, 0x04,
-	0x63, 0x77, 0xe0, 0x3a, 0x56, 0xb0, 0xb1, 0x3a, 0xe9, 0xd0, 0x1c, 0x0b, 0xa3, 0xef, 0xc1, 0xb2,
-	0x97, 0x98, 0x88, 0xf1, 0x5c, 0x73, 0xed, 0xda, 0xad, 0x94, 0x4c, 0xdd, 0x4a, 0x2e, 0xaa, 0xa5,
-	0x47, 0xab, 0x5f, 0x94, 0xe0, 0xac, 0x80, 0x63, 0xb8, 0x92, 0xdf, 0x84, 0xf2, 0x1e, 0x1e, 0x09,
-	0xf4, 0x58, 0xa3, 0x08, 0xe5, 0xc5, 0x91, 0x95, 0xa3, 0x47, 0x56, 0x44, 0x0c, 0x12, 0xe7, 0x51,
-	0x4d, 0x9f, 0xc7, 0x15, 0x68, 0xe2, 0x67, 0x13, 0xd3, 0xc5, 0x03, 0xc2, 0x38, 0x94, 0xe4, 0x15,
-	0x0d, 0x58, 0xd7, 0xae, 0x39, 0x8e, 0xca, 0xc6, 0x42, 0x61, 0xd9, 0x50, 0xff, 0x54, 0x81, 0xf3,
-	0xa9, 0x53, 0xe2, 0xc2, 0xa6, 0x41, 0x87, 0xee, 0x3c, 0xa4, 0x0c, 0x11, 0x3b, 0x42, 0xf0, 0xd7,
-	0xf2, 0x08, 0x1e, 0x82, 0x6b, 0xa9, 0xf1, 0x11, 0x24, 0x4b, 0xc5, 0x91, 0x3c, 0x80, 0xf3, 0xf7,
-	0xb1, 0xcf, 0x17, 0x20, 0xdf, 0xb0, 0x77, 0x7a, 0x45, 0x16, 0x97, 0xea, 0x52, 0x52, 0xaa, 0xd5,
-	0x3f, 0x2b, 0x09, 0x59, 0xa4, 0x4b, 0xf5, 0xed, 0x3d, 0x07, 0xbd, 0x0a, 0x0d, 0x01, 0xc2, 0xb9,
-	0x22, 0xec, 0x40, 0xdf, 0x80, 0x2a, 0xc1, 0x94, 0xb1, 0x44, 0x7b, 0xed, 0x6b, 0xf2, 0x3d, 0x45,
-	0xe6, 0xd4, 0x18, 0x3c, 0xda, 0x84, 0xb6, 0xe7, 0xeb, 0xae, 0x3f, 0x98, 0x38, 0x1e, 0x3d, 0x67,
-	0xca, 0x38, 0xcd, 0xb5, 0x4b, 0xf1, 0x19, 0x88, 0x92, 0xdf, 0xf2, 0x46, 0xdb, 0x1c, 0x48, 0x5b,
-	0xa4, 0x83, 0x82, 0x26, 0xfa, 0x0e, 0xb4, 0xb0, 0x6d, 0x84, 0x73, 0x54, 0x8a, 0xcc, 0xd1, 0xc4,
-	0xb6, 0x21, 0x66, 0x08, 0x4f, 0xa5, 0x5a, 0xfc, 0x54, 0x7e, 0x47, 0x81, 0x6e, 0xfa, 0x58, 0xe6,
-	0x51, 0xd4, 0x1f, 0xb0, 0x41, 0x98, 0x1d, 0x4b, 0xae, 0x5c, 0x8b, 0xa3, 0xd1, 0xf8, 0x10, 0xf5,
-	0x8f, 0x14, 0x78, 0x25, 0x44, 0x87, 0x7e, 0x7a, 0x51, 0x3c, 0x82, 0x6e, 0x42, 0xc7, 0xb4, 0x87,
-	0xd6, 0xd4, 0xc0, 0x8f, 0xec, 0x4f, 0xb0, 0x6e, 0xf9, 0xfb, 0xc7, 0xf4, 0xe4, 0xea, 0x5a, 0xaa,
-	0x5f, 0xfd, 0xf7, 0x12, 0xac, 0x24, 0xf1, 0x9a, 0x87, 0x48, 0x3f, 0x03, 0x55, 0xd3, 0xde, 0x73,
-	0x02, 0x1a, 0x5d, 0xce, 0x11, 0x45, 0xb2, 0x16, 0x03, 0x46, 0x0e, 0xa0, 0x40, 0x79, 0x0d, 0xf7,
-	0xf1, 0xf0, 0x60, 0xe2, 0x98, 0x54, 0x4d, 0x91, 0x29, 0xbe, 0x23, 0x99, 0x42, 0x8e, 0xf1, 0xad,
-	0x0d, 0x36, 0xc7, 0x86, 0x98, 0xe2, 0x63, 0xdb, 0x77, 0x8f, 0xb5, 0xe5, 0x61, 0xb2, 0xbf, 0x37,
-	0x84, 0x15, 0x39, 0x30, 0xea, 0x40, 0xf9, 0x00, 0x1f, 0xd3, 0x2d, 0x37, 0x34, 0xf2, 0x13, 0xdd,
-	0x81, 0xea, 0xa1, 0x6e, 0x4d, 0x31, 0xd7, 0x09, 0x33, 0x38, 0x97, 0xc1, 0xbe, 0x5f, 0x7a, 0x4f,
-	0x51, 0xc7, 0x70, 0xf1, 0x3e, 0xf6, 0xfb, 0xb6, 0x87, 0x5d, 0x7f, 0xdd, 0xb4, 0x2d, 0x67, 0xb4,
-	0xad, 0xfb, 0xfb, 0x73, 0x28, 0x87, 0x98, 0x9c, 0x97, 0x12, 0x72, 0xae, 0xfe, 0x58, 0x81, 0x57,
-	0xe5, 0xeb, 0xf1, 0x03, 0xed, 0x41, 0x7d, 0xcf, 0xc4, 0x96, 0x41, 0xb8, 0x46, 0xa1, 0x5c, 0x23,
-	0xda, 0x44, 0x49, 0x4c, 0x08, 0x30, 0x3f, 0xb7, 0x84, 0x92, 0x10, 0x3e, 0xdf, 0x8e, 0xef, 0x9a,
-	0xf6, 0xe8, 0x81, 0xe9, 0xf9, 0x1a, 0x83, 0x8f, 0x70, 0x49, 0xb9, 0xb8, 0x70, 0xfe, 0x96, 0x02,
-	0x97, 0xef, 0x63, 0x7f, 0x43, 0xd8, 0x18, 0xf2, 0xdd, 0xf4, 0x7c, 0x73, 0xe8, 0x3d, 0x5f, 0x1f,
-	0xb0, 0x80, 0xb3, 0xa1, 0xfe, 0x9e, 0x02, 0x57, 0x32, 0x91, 0xe1, 0xa4, 0xe3, 0x3a, 0x34, 0xb0,
-	0x30, 0x72, 0x1d, 0xfa, 0x73, 0xf8, 0xf8, 0x31, 0x39, 0xfc, 0x6d, 0xdd, 0x74, 0x99, 0x0e, 0x3d,
-	0xa5, 0x45, 0xf9, 0x89, 0x02, 0x97, 0xee, 0x63, 0x7f, 0x3b, 0xb0, 0xaf, 0x2f, 0x91, 0x3a, 0x04,
-	0x26, 0x62, 0xe7, 0x03, 0x47, 0x33, 0xd6, 0xa7, 0xfe, 0x2e, 0x3b, 0x4e, 0x29, 0xbe, 0x2f, 0x85,
-	0x80, 0x97, 0xa9, 0x24, 0x44, 0x54, 0x04, 0x17, 0x76, 0x4e, 0x3e, 0xf5, 0x87, 0x55, 0x68, 0x3d,
-	0xe6, 0x5a, 0x81, 0x5a, 0xd0, 0x24, 0x25, 0x14, 0xb9, 0x13, 0x14, 0xf1, 0xa6, 0x64, 0x0e, 0xd6,
-	0x3a, 0x2c, 0x7a, 0x18, 0x1f, 0x9c, 0xd0, 0x5e, 0xb6, 0xc8, 0x18, 0x61, 0xec, 0x1e, 0xc0, 0xf2,
-	0xd4, 0xa6, 0x1e, 0x3a, 0x36, 0xf8, 0x06, 0x18, 0xd1, 0x67, 0x2b, 0xd3, 0xf4, 0x40, 0xf4, 0x09,
-	0x0f, 0x02, 0x22, 0x73, 0x55, 0x0b, 0xcd, 0x95, 0x1c, 0x86, 0xfa, 0xd0, 0x31, 0x5c, 0x67, 0x32,
-	0xc1, 0xc6, 0xc0, 0x0b, 0xa6, 0xaa, 0x15, 0x9b, 0x8a, 0x8f, 0x13, 0x53, 0xbd, 0x03, 0x67, 0x93,
-	0x98, 0xf6, 0x0d, 0xe2, 0x17, 0x12, 0xce, 0x92, 0x7d, 0x42, 0x6f, 0xc1, 0x72, 0x1a, 0xbe, 0x4e,
-	0xe1, 0xd3, 0x1f, 0xd0, 0xdb, 0x80, 0x12, 0xa8, 0x12, 0xf0, 0x06, 0x03, 0x8f, 0x23, 0xc3, 0xc1,
-	0x69, 0x70, 0x1a, 0x07, 0x07, 0x06, 0xce, 0xbf, 0x44, 0xc0, 0xfb, 0xc4, 0xba, 0xc6, 0xc0, 0xbd,
-	0x6e, 0xb3, 0x18, 0x21, 0xe2, 0x93, 0x79, 0xea, 0x6f, 0x2a, 0xb0, 0xf2, 0xa9, 0xee, 0x0f, 0xf7,
-	0x37, 0xc7, 0x9c, 0x41, 0xe7, 0x10, 0xf0, 0x0f, 0xa1, 0x71, 0xc8, 0x99, 0x31, 0xd0, 0xe2, 0x57,
-	0x24, 0x08, 0x45, 0xd9, 0x5e, 0x0b, 0x47, 0x90, 0x80, 0xe8, 0xdc, 0xbd, 0x48, 0x60, 0xf8, 0x12,
-	0x54, 0xcd, 0x8c, 0x88, 0x56, 0x7d, 0x06, 0xc0, 0x91, 0xdb, 0xf2, 0x46, 0xa7, 0xc0, 0xeb, 0x3d,
-	0x58, 0xe0, 0xb3, 0x71, 0x5d, 0x32, 0xeb, 0xc0, 0x02, 0x70, 0xf5, 0x47, 0x35, 0x68, 0x46, 0x3e,
-	0xa0, 0x36, 0x94, 0x84, 0x92, 0x28, 0x49, 0x76, 0x57, 0x9a, 0x1d, 0x43, 0x95, 0xd3, 0x31, 0xd4,
-	0x0d, 0x68, 0x9b, 0xd4, 0x78, 0x0f, 0xf8, 0xa9, 0x50, 0x5f, 0xb9, 0xa1, 0x2d, 0xb2, 0x5e, 0xce,
-	0x22, 0xe8, 0x32, 0x34, 0xed, 0xe9, 0x78, 0xe0, 0xec, 0x0d, 0x5c, 0xe7, 0xc8, 0xe3, 0xc1, 0x58,
-	0xc3, 0x9e, 0x8e, 0xbf, 0xbb, 0xa7, 0x39, 0x47, 0x5e, 0xe8, 0xef, 0xd7, 0x4e, 0xe8, 0xef, 0x5f,
-	0x86, 0xe6, 0x58, 0x7f, 0x46, 0x66, 0x1d, 0xd8, 0xd3, 0x31, 0x8d, 0xd3, 0xca, 0x5a, 0x63, 0xac,
-	0x3f, 0xd3, 0x9c, 0xa3, 0x87, 0xd3, 0x31, 0x5a, 0x85, 0x8e, 0xa5, 0x7b, 0xfe, 0x20, 0x1a, 0xe8,
-	0xd5, 0x69, 0xa0, 0xd7, 0x26, 0xfd, 0x1f, 0x87, 0xc1, 0x5e, 0x3a, 0x72, 0x68, 0x9c, 0x2e, 0x72,
-	0x30, 0xc6, 0x56, 0x38, 0x07, 0x14, 0x8a, 0x1c, 0x8c, 0xb1, 0x25, 0x66, 0x78, 0x0f, 0x16, 0x9e,
-	0x50, 0x47, 0x28, 0x4f, 0x44, 0xef, 0x11, 0x1f, 0x88, 0xf9, 0x4b, 0x5a, 0x00, 0x8e, 0xbe, 0x05,
-	0x0d, 0x6a, 0x7f, 0xe8, 0xd8, 0x56, 0xa1, 0xb1, 0xe1, 0x00, 0x32, 0xda, 0xc0, 0x96, 0xaf, 0xd3,
-	0xd1, 0x8b, 0xc5, 0x46, 0x8b, 0x01, 0x44, 0x3f, 0x0e, 0x5d, 0xac, 0xfb, 0xd8, 0x58, 0x3f, 0xde,
-	0x70, 0xc6, 0x13, 0x9d, 0xb2, 0x50, 0xb7, 0x4d, 0x5d, 0x78, 0xd9, 0x27, 0xf4, 0x1a, 0xb4, 0x87,
-	0xa2, 0x75, 0xcf, 0x75, 0xc6, 0xdd, 0x25, 0x2a, 0x3d, 0x89, 0x5e, 0x74, 0x09, 0x20, 0xd0, 0x8c,
-	0xba, 0xdf, 0xed, 0xd0, 0xb3, 0x6b, 0xf0, 0x9e, 0xbb, 0x34, 0x7b, 0x63, 0x7a, 0x03, 0x96, 0x27,
-	0x31, 0xed, 0x51, 0x77, 0x99, 0xae, 0xd8, 0x0c, 0x12, 0x2b, 0xa6, 0x3d, 0x42, 0xe7, 0x61, 0xc1,
-	0xf4, 0x06, 0x7b, 0xfa, 0x01, 0xee, 0x22, 0xfa, 0xb5, 0x66, 0x7a, 0xf7, 0xf4, 0x03, 0xac, 0x7e,
-	0x0e, 0xe7, 0x42, 0x9e, 0x8a, 0x1c, 0x62, 0x9a, 0x15, 0x94, 0x53, 0xb0, 0x42, 0xbe, 0xe7, 0xfb,
-	0xdf, 0x15, 0x58, 0xd9, 0xd1, 0x0f, 0xf1, 0x8b, 0x77, 0xb2, 0x0b, 0xe9, 0xb1, 0x07, 0xb0, 0x4c,
-	0xfd, 0xea, 0xb5, 0x08, 0x3e, 0x39, 0x26, 0x3c, 0xca, 0x05, 0xe9, 0x81, 0xe8, 0xdb, 0xc4, 0xed,
-	0xc0, 0xc3, 0x83, 0x6d, 0x12, 0xa3, 0x04, 0xe6, 0xfb, 0x92, 0x64, 0x9e, 0x0d, 0x01, 0xa5, 0x45,
-	0x47, 0xa0, 0x6d, 0x58, 0x8a, 0x9f, 0x40, 0x60, 0xb8, 0x5f, 0xcf, 0x0d, 0x60, 0x43, 0xea, 0x6b,
-	0xed, 0xd8, 0x61, 0x78, 0xa8, 0x0b, 0x0b, 0xdc, 0xea, 0x52, 0x25, 0x51, 0xd7, 0x82, 0x26, 0xda,
-	0x86, 0xb3, 0x6c, 0x07, 0x3b, 0x5c, 0x16, 0xd8, 0xe6, 0xeb, 0x85, 0x36, 0x2f, 0x1b, 0x1a, 0x17,
-	0xa5, 0xc6, 0x49, 0x45, 0xa9, 0x0b, 0x0b, 0x9c, 0xbd, 0xa9, 0xf6, 0xa8, 0x6b, 0x41, 0x93, 0x1c,
-	0x73, 0xc8, 0xe8, 0x4d, 0xfa, 0x2d, 0xec, 0x20, 0xe3, 0x02, 0x1d, 0xdc, 0xa2, 0x3a, 0x38, 0x68,
-	0xaa, 0xbf, 0xae, 0x00, 0x84, 0x94, 0x9e, 0x91, 0x7a, 0xf9, 0x26, 0xd4, 0x05, 0xdb, 0x17, 0x8a,
-	0x1e, 0x05, 0x78, 0x52, 0xcb, 0x97, 0x13, 0x5a, 0x5e, 0xfd, 0x47, 0x05, 0x5a, 0x9b, 0x64, 0x9f,
-	0x0f, 0x9c, 0x11, 0xb5, 0x49, 0x37, 0xa0, 0xed, 0xe2, 0xa1, 0xe3, 0x1a, 0x03, 0x6c, 0xfb, 0xae,
-	0x89, 0x59, 0xd8, 0x5e, 0xd1, 0x16, 0x59, 0xef, 0xc7, 0xac, 0x93, 0x80, 0x11, 0xc5, 0xed, 0xf9,
-	0xfa, 0x78, 0x32, 0xd8, 0x23, 0xaa, 0xa2, 0xc4, 0xc0, 0x44, 0x2f, 0xd5, 0x14, 0x5f, 0x83, 0x56,
-	0x08, 0xe6, 0x3b, 0x74, 0xfd, 0x8a, 0xd6, 0x14, 0x7d, 0xbb, 0x0e, 0xba, 0x0e, 0x6d, 0x4a, 0xe8,
-	0x81, 0xe5, 0x8c, 0x06, 0x24, 0x18, 0xe4, 0xe6, 0xaa, 0x65, 0x70, 0xb4, 0xc8, 0x01, 0xc6, 0xa1,
-	0x3c, 0xf3, 0x73, 0xcc, 0x0d, 0x96, 0x80, 0xda, 0x31, 0x3f, 0xc7, 0xea, 0xaf, 0x29, 0xb0, 0xc8,
-	0xed, 0xdb, 0x8e, 0x48, 0x8b, 0xd3, 0x3c, 0x26, 0x0b, 0xc4, 0xe9, 0x6f, 0xf4, 0x7e, 0x3c, 0x93,
-	0x75, 0x5d, 0x2a, 0x04, 0x74, 0x12, 0xea, 0x55, 0xc5, 0x8c, 0x5b, 0x91, 0x48, 0xf0, 0x0b, 0x42,
-	0x53, 0xdd, 0xd7, 0x1f, 0x3a, 0x06, 0x4b, 0xac, 0x75, 0x61, 0x41, 0x37, 0x0c, 0x17, 0x7b, 0x1e,
-	0xc7, 0x23, 0x68, 0x92, 0x2f, 0x87, 0xd8, 0xf5, 0x82, 0x83, 0x2d, 0x6b, 0x41, 0x13, 0x7d, 0x0b,
-	0xea, 0xc2, 0x0d, 0x63, 0x19, 0x8c, 0xab, 0xd9, 0x78, 0xf2, 0xb8, 0x45, 0x8c, 0x50, 0xff, 0xa6,
-	0x04, 0x6d, 0x2e, 0x83, 0xeb, 0xdc, 0x14, 0xe5, 0xb3, 0xd8, 0x3a, 0xb4, 0xf6, 0x42, 0xde, 0xcf,
-	0xcb, 0xbb, 0x44, 0x45, 0x24, 0x36, 0x66, 0x16, 0xaf, 0xc5, 0x8d, 0x61, 0x65, 0x2e, 0x63, 0x58,
-	0x3d, 0xa9, 0x04, 0xa7, 0x9d, 0xa2, 0x9a, 0xc4, 0x29, 0x52, 0x7f, 0x01, 0x9a, 0x91, 0x09, 0xa8,
-	0x86, 0x62, 0xa9, 0x0d, 0x4e, 0xb1, 0xa0, 0x89, 0xee, 0x84, 0x2e, 0x01, 0x23, 0xd5, 0x05, 0x09,
-	0x2e, 0x09, 0x6f, 0x40, 0xfd, 0xa9, 0x02, 0x35, 0x3e, 0xf3, 0x15, 0x68, 0x72, 0xf9, 0xa2, 0x4e,
-	0x12, 0x9b, 0x1d, 0x78, 0x17, 0xf1, 0x92, 0x9e, 0x9f, 0x80, 0x5d, 0x80, 0x7a, 0x42, 0xb4, 0x16,
-	0xb8, 0x5a, 0x0c, 0x3e, 0x45, 0xe4, 0x89, 0x7c, 0x22, 0xa2, 0x84, 0xce, 0x41, 0xd5, 0x72, 0x46,
-	0xa2, 0xec, 0xc1, 0x1a, 0xea, 0x97, 0x0a, 0xcd, 0x52, 0x6b, 0x78, 0xe8, 0x1c, 0x62, 0xf7, 0x78,
-	0xfe, 0x44, 0xdf, 0x07, 0x11, 0x36, 0x2f, 0x18, 0x6d, 0x88, 0x01, 0xe8, 0x83, 0xf0, 0x10, 0xca,
-	0xb2, 0x7c, 0x40, 0xd4, 0x14, 0x71, 0x26, 0x0d, 0x0f, 0xe3, 0xf7, 0x15, 0x9a, 0xb2, 0x8c, 0x6f,
-	0xe5, 0xb4, 0xd6, 0xfe, 0xb9, 0x78, 0xee, 0xea, 0x3f, 0x28, 0x70, 0x21, 0x83, 0xba, 0x8f, 0xd7,
-	0x5e, 0x02, 0x7d, 0xdf, 0x87, 0xba, 0x88, 0x4d, 0xcb, 0x85, 0x62, 0x53, 0x01, 0xaf, 0xfe, 0x21,
-	0x4b, 0x9c, 0x4b, 0xc8, 0xfb, 0x78, 0xed, 0x05, 0x11, 0x38, 0x99, 0x63, 0x2a, 0x4b, 0x72, 0x4c,
-	0xff, 0xa4, 0x40, 0x2f, 0xcc, 0xe9, 0x78, 0xeb, 0xc7, 0xf3, 0x56, 0x5a, 0x9e, 0x4f, 0xcc, 0xf6,
-	0x4d, 0x51, 0x14, 0x20, 0x7a, 0xb1, 0x50, 0xb4, 0x15, 0x94, 0x04, 0x6c, 0x9a, 0x1e, 0x4e, 0x6f,
-	0x68, 0x1e, 0xa9, 0xec, 0x45, 0x0e, 0x9e, 0x15, 0x06, 0xc2, 0x83, 0xfd, 0x29, 0x63, 0xd2, 0x7b,
-	0xf1, 0xc4, 0xce, 0xcb, 0x26, 0x60, 0xb4, 0x58, 0xb1, 0xcf, 0x8b, 0x15, 0x95, 0x44, 0xb1, 0x82,
-	0xf7, 0xab, 0x63, 0xca, 0x02, 0xa9, 0x0d, 0xbc, 0x28, 0x82, 0xfd, 0x86, 0x02, 0x5d, 0xbe, 0x0a,
-	0x5d, 0x93, 0x04, 0x5c, 0x16, 0xf6, 0xb1, 0xf1, 0x55, 0xa7, 0x1f, 0xfe, 0xb8, 0x04, 0x9d, 0xa8,
-	0x63, 0x43, 0x7d, 0x93, 0xaf, 0x43, 0x95, 0x66, 0x6f, 0x38, 0x06, 0x33, 0xb5, 0x03, 0x83, 0x26,
-	0x96, 0x91, 0x7a, 0xf3, 0xbb, 0x5e, 0xe0, 0xb8, 0xf0, 0x66, 0xe8, 0x5d, 0x95, 0x4f, 0xee, 0x5d,
-	0xbd, 0x0a, 0x0d, 0x62, 0xb9, 0x9c, 0x29, 0x99, 0x97, 0x55, 0x90, 0xc3, 0x0e, 0xf4, 0x21, 0xd4,
-	0xd8, 0xbd, 0x10, 0x5e, 0xc0, 0xbb, 0x11, 0x9f, 0x9a, 0xdf, 0x19, 0x89, 0x24, 0xe0, 0x69, 0x87,
-	0xc6, 0x07, 0x91, 0x33, 0x9a, 0xb8, 0xce, 0x88, 0xba, 0x61, 0xc4, 0xa8, 0x55, 0x35, 0xd1, 0x56,
-	0x7f, 0x16, 0x56, 0xc2, 0x38, 0x98, 0xa1, 0x74, 0x5a, 0x86, 0x56, 0xff, 0x55, 0x81, 0xb3, 0x3b,
-	0xc7, 0xf6, 0x30, 0x29, 0x1a, 0x2b, 0x50, 0x9b, 0x58, 0x7a, 0x98, 0x16, 0xe6, 0x2d, 0x5a, 0x72,
-	0x67, 0x6b, 0x63, 0x83, 0x98, 0x70, 0x46, 0xcf, 0xa6, 0xe8, 0xdb, 0x75, 0x66, 0x7a, 0x56, 0x37,
-	0x44, 0xe0, 0x8e, 0x0d, 0xe6, 0x2c, 0xb0, 0xb4, 0xd7, 0xa2, 0xe8, 0xa5, 0xce, 0xc2, 0x87, 0x00,
-	0xd4, 0x9f, 0x1a, 0x9c, 0xc4, 0x87, 0xa2, 0x23, 0x1e, 0x10, 0x8b, 0xf9, 0x57, 0x25, 0xe8, 0x46,
-	0xa8, 0xf4, 0x55, 0xbb, 0x97, 0x19, 0x41, 0x61, 0xf9, 0x39, 0x05, 0x85, 0x95, 0xf9, 0x5d, 0xca,
-	0xaa, 0xcc, 0xa5, 0xfc, 0x95, 0x32, 0xb4, 0x43, 0xaa, 0x6d, 0x5b, 0xba, 0x9d, 0xc9, 0x09, 0x3b,
-	0xd0, 0xf6, 0x62, 0x54, 0xe5, 0x74, 0x7a, 0x53, 0x26, 0x43, 0x19, 0x07, 0xa1, 0x25, 0xa6, 0x40,
-	0x97, 0xe8, 0xa1, 0xbb, 0x3e, 0x4b, 0xb4, 0x31, 0xff, 0xb0, 0xc1, 0x84, 0xd5, 0x1c, 0x63, 0xf4,
-	0x16, 0x20, 0x2e, 0x61, 0x03, 0xd3, 0x1e, 0x78, 0x78, 0xe8, 0xd8, 0x06, 0x93, 0xbd, 0xaa, 0xd6,
-	0xe1, 0x5f, 0xfa, 0xf6, 0x0e, 0xeb, 0x47, 0x5f, 0x87, 0x8a, 0x7f, 0x3c, 0x61, 0xce, 0x62, 0x5b,
-	0xea, 0x6e, 0x85, 0x78, 0xed, 0x1e, 0x4f, 0xb0, 0x46, 0xc1, 0x83, 0xab, 0x41, 0xbe, 0xab, 0x1f,
-	0x72, 0xcf, 0xbb, 0xa2, 0x45, 0x7a, 0xa2, 0x71, 0xf2, 0x42, 0x2c, 0x4e, 0x66, 0x9c, 0x1d, 0x08,
-	0xf4, 0xc0, 0xf7, 0x2d, 0x9a, 0x2a, 0xa4, 0x9c, 0x1d, 0xf4, 0xee, 0xfa, 0x16, 0xd9, 0xa4, 0xef,
-	0xf8, 0xba, 0xc5, 0xe4, 0xa3, 0xc1, 0x35, 0x07, 0xe9, 0xa1, 0x51, 0xee, 0xbf, 0x10, 0xcd, 0x27,
-	0x10, 0xd3, 0xb0, 0x37, 0xb5, 0xb2, 0xe5, 0x31, 0x3f, 0x73, 0x33, 0x4b, 0x14, 0xbf, 0x0d, 0x4d,
-	0xce, 0x15, 0x27, 0xe0, 0x2a, 0x60, 0x43, 0x1e, 0xe4, 0xb0, 0x79, 0xf5, 0x39, 0xb1, 0x79, 0xed,
-	0x14, 0xb9, 0x0f, 0xf9, 0xd9, 0xa8, 0x3f, 0x56, 0xe0, 0x95, 0x94, 0xd6, 0xcc, 0x25, 0x6d, 0x7e,
-	0xe4, 0xcd, 0xb5, 0x69, 0x72, 0x4a, 0x6e, 0x1b, 0x3e, 0x80, 0x9a, 0x4b, 0x67, 0xe7, 0xe5, 0xb0,
-	0x6b, 0xb9, 0xcc, 0xc7, 0x10, 0xd1, 0xf8, 0x10, 0xf5, 0x0f, 0x14, 0x38, 0x9f, 0x46, 0x75, 0x0e,
-	0x83, 0xbf, 0x0e, 0x0b, 0x6c, 0xea, 0x40, 0x46, 0x57, 0xf3, 0x65, 0x34, 0x24, 0x8e, 0x16, 0x0c,
-	0x54, 0x77, 0x60, 0x25, 0xf0, 0x0b, 0x42, 0xd2, 0x6f, 0x61, 0x5f, 0xcf, 0x89, 0x3b, 0xaf, 0x40,
-	0x93, 0x05, 0x30, 0x2c, 0x9e, 0x63, 0xd5, 0x43, 0x78, 0x22, 0x12, 0x7d, 0xea, 0x7f, 0x29, 0x70,
-	0x8e, 0x1a, 0xd6, 0x64, 0x29, 0xa8, 0x48, 0x6d, 0x52, 0x15, 0xb7, 0xbf, 0x1e, 0xea, 0x63, 0x7e,
-	0x43, 0xa5, 0xa1, 0xc5, 0xfa, 0x50, 0x3f, 0x9d, 0x07, 0x94, 0xe6, 0x27, 0xc2, 0x62, 0xec, 0xa6,
-	0xee, 0xeb, 0xb4, 0x16, 0x9b, 0x4c, 0x00, 0x86, 0x06, 0xbd, 0x72, 0x0a, 0x83, 0xae, 0x3e, 0x80,
-	0x57, 0x12, 0x3b, 0x9d, 0xe3, 0x44, 0xd5, 0x3f, 0x57, 0xc8, 0x71, 0xc4, 0x6e, 0xfa, 0x9c, 0xde,
-	0xa9, 0xbd, 0x24, 0x6a, 0x50, 0x03, 0xd3, 0x48, 0x2a, 0x11, 0x03, 0x7d, 0x04, 0x0d, 0x1b, 0x1f,
-	0x0d, 0xa2, 0x7e, 0x52, 0x01, 0x8f, 0xbf, 0x6e, 0xe3, 0x23, 0xfa, 0x4b, 0x7d, 0x08, 0xe7, 0x53,
-	0xa8, 0xce, 0xb3, 0xf7, 0xbf, 0x53, 0xe0, 0xc2, 0xa6, 0xeb, 0x4c, 0x1e, 0x9b, 0xae, 0x3f, 0xd5,
-	0xad, 0x78, 0x99, 0xfb, 0x14, 0xdb, 0x2f, 0x70, 0x8b, 0xf0, 0x93, 0x54, 0x6c, 0xf9, 0x96, 0x44,
-	0x82, 0xd2, 0x48, 0xf1, 0x4d, 0x47, 0xfc, 0xeb, 0xff, 0x28, 0xcb, 0x90, 0xe7, 0x70, 0x33, 0xfc,
-	0x92, 0x22, 0xc1, 0x87, 0x34, 0x0f, 0x5f, 0x3e, 0x6d, 0x1e, 0x3e, 0x43, 0xbd, 0x57, 0x9e, 0x93,
-	0x7a, 0x3f, 0x71, 0x62, 0x6c, 0x03, 0xe2, 0x35, 0x12, 0x6a, 0x9d, 0x4f, 0x5a, 0x57, 0xf9, 0x10,
-	0x20, 0x2c, 0x15, 0xf0, 0x9b, 0x99, 0x33, 0x66, 0x88, 0x0c, 0x20, 0x67, 0x24, 0x0c, 0x28, 0xb7,
-	0xef, 0x91, 0x14, 0xf5, 0xf7, 0xa0, 0x27, 0xe3, 0xcd, 0x79, 0xf8, 0xfd, 0xdf, 0x4a, 0x00, 0x7d,
-	0x71, 0x8f, 0xf7, 0x74, 0x16, 0xe0, 0x1a, 0x44, 0x7c, 0x90, 0x50, 0xca, 0xa3, 0xbc, 0x63, 0x10,
-	0x41, 0x10, 0x51, 0x2a, 0x81, 0x49, 0x45, 0xae, 0x06, 0x9d, 0x27, 0x22, 0x2b, 0x8c, 0x15, 0x92,
-	0x4a, 0xf7, 0x22, 0x34, 0x5c, 0xe7, 0x68, 0x40, 0x84, 0xcb, 0x08, 0x2e, 0x2a, 0xbb, 0xce, 0x11,
-	0x11, 0x39, 0x03, 0x9d, 0x87, 0x05, 0x5f, 0xf7, 0x0e, 0xc8, 0xfc, 0x2c, 0x59, 0x57, 0x23, 0xcd,
-	0xbe, 0x81, 0xce, 0x41, 0x75, 0xcf, 0xb4, 0x30, 0xbb, 0x13, 0xd1, 0xd0, 0x58, 0x03, 0x7d, 0x23,
-	0xb8, 0x5b, 0x57, 0x2f, 0x7c, 0x87, 0x86, 0x5d, 0xaf, 0xbb, 0x06, 0x8b, 0x84, 0x93, 0x08, 0x12,
-	0x4c, 0xac, 0x3b, 0x3c, 0x51, 0xcf, 0x3b, 0x09, 0xaa, 0xea, 0x97, 0x0a, 0x2c, 0x85, 0xa4, 0xa5,
-	0xba, 0x89, 0xa8, 0x3b, 0xaa, 0xea, 0x36, 0x1c, 0x83, 0x69, 0x91, 0x76, 0x86, 0xb1, 0x60, 0x03,
-	0x99, 0x42, 0x0b, 0x87, 0xe4, 0x45, 0xd7, 0x64, 0xf3, 0x84, 0x32, 0xa6, 0x11, 0xe4, 0x7b, 0x6a,
-	0xae, 0x73, 0xd4, 0x37, 0x04, 0xc9, 0xd8, 0x55, 0x65, 0x16, 0x4b, 0x12, 0x92, 0x6d, 0xd0, 0xdb,
-	0xca, 0xd7, 0x60, 0x11, 0xbb, 0xae, 0xe3, 0x0e, 0xc6, 0xd8, 0xf3, 0xf4, 0x11, 0xe6, 0xae, 0x7b,
-	0x8b, 0x76, 0x6e, 0xb1, 0x3e, 0xf5, 0xef, 0x2b, 0xd0, 0x0e, 0xb7, 0x12, 0x54, 0xec, 0x4d, 0x23,
-	0xa8, 0xd8, 0x9b, 0xe4, 0x7c, 0xc1, 0x65, 0x5a, 0x52, 0x70, 0xc0, 0x7a, 0xa9, 0xab, 0x68, 0x0d,
-	0xde, 0xdb, 0x37, 0x88, 0xc5, 0x26, 0x04, 0xb2, 0x1d, 0x03, 0x87, 0x1c, 0x00, 0x41, 0x17, 0x67,
-	0x80, 0x18, 0x23, 0x55, 0x0a, 0x30, 0x52, 0xb5, 0x00, 0x23, 0xd5, 0x24, 0x8c, 0xb4, 0x02, 0xb5,
-	0x27, 0xd3, 0xe1, 0x01, 0xf6, 0xb9, 0x33, 0xc7, 0x5b, 0x71, 0x06, 0xab, 0x27, 0x18, 0x4c, 0xf0,
-	0x51, 0x23, 0xca, 0x47, 0x17, 0xa1, 0xc1, 0x8a, 0xc8, 0x03, 0xdf, 0xa3, 0x65, 0xb1, 0xb2, 0x56,
-	0x67, 0x1d, 0xbb, 0x1e, 0x7a, 0x2f, 0xf0, 0xf4, 0x9a, 0x54, 0xa2, 0x54, 0x89, 0x42, 0x4a, 0x70,
-	0x49, 0xe0, 0xe7, 0xbd, 0x0e, 0x4b, 0x11, 0x72, 0x50, 0x3e, 0x63, 0xb5, 0xb3, 0x48, 0x20, 0x40,
-	0x2d, 0xc8, 0x0d, 0x68, 0x87, 0x24, 0xa1, 0x70, 0x8b, 0x2c, 0xfe, 0x12, 0xbd, 0x14, 0x4c, 0xb0,
-	0x7b, 0xfb, 0x84, 0xec, 0x7e, 0x01, 0xea, 0x3c, 0x70, 0xf2, 0xba, 0x4b, 0xf1, 0x1c, 0x47, 0x21,
-	0x49, 0xf8, 0x01, 0xa0, 0x70, 0x8b, 0xf3, 0x79, 0x9b, 0x09, 0x1e, 0x2a, 0x25, 0x79, 0x48, 0xfd,
-	0x0b, 0x05, 0x96, 0xa3, 0x8b, 0x9d, 0xd6, 0x70, 0x7f, 0x04, 0x4d, 0x56, 0xbd, 0x1c, 0x10, 0x15,
-	0x22, 0x2f, 0x36, 0x26, 0x0e, 0x4f, 0x83, 0xf0, 0x45, 0x04, 0x21, 0xcc, 0x91, 0xe3, 0x1e, 0x98,
-	0xf6, 0x68, 0x40, 0x30, 0x13, 0x39, 0x58, 0xde, 0xf9, 0x90, 0xf4, 0xa9, 0xbf, 0xad, 0xc0, 0xe5,
-	0x47, 0x13, 0x43, 0xf7, 0x71, 0xc4, 0x83, 0x99, 0xf7, 0x62, 0xa2, 0xb8, 0x19, 0x58, 0xca, 0x39,
-	0xe6, 0xc8, 0x7a, 0x1e, 0xbf, 0x19, 0x48, 0xfc, 0x3e, 0x8e, 0x4d, 0xea, 0x2a, 0xef, 0xe9, 0xb1,
-	0xe9, 0x41, 0xfd, 0x90, 0x4f, 0x17, 0xbc, 0xf1, 0x08, 0xda, 0xb1, 0x6a, 0x6e, 0xf9, 0x44, 0xd5,
-	0x5c, 0x75, 0x0b, 0x2e, 0x68, 0xd8, 0xc3, 0xb6, 0x11, 0xdb, 0xc8, 0xa9, 0x33, 0x55, 0x13, 0xe8,
-	0xc9, 0xa6, 0x9b, 0x87, 0x53, 0x99, 0xe3, 0x3b, 0x70, 0xc9, 0xb4, 0x3e, 0x57, 0xd6, 0xc4, 0xdf,
-	0xa2, 0xeb, 0xf8, 0xea, 0x5f, 0x96, 0xe0, 0xfc, 0x5d, 0xc3, 0xe0, 0x7a, 0x9e, 0xbb, 0x72, 0x2f,
-	0xca, 0xcb, 0x4e, 0x7a, 0xa1, 0xe5, 0xb4, 0x17, 0xfa, 0xbc, 0x74, 0x2f, 0xb7, 0x42, 0xf6, 0x74,
-	0x1c, 0x98, 0x60, 0x97, 0x5d, 0x76, 0xfa, 0x80, 0xd7, 0x3c, 0x07, 0x96, 0x33, 0xa2, 0x66, 0x78,
-	0xb6, 0x73, 0x56, 0x0f, 0x32, 0x6e, 0xea, 0x04, 0xba, 0x69, 0x62, 0xcd, 0xa9, 0x47, 0x02, 0x8a,
-	0x4c, 0x1c, 0x96, 0xb9, 0x6d, 0x11, 0x4f, 0x8c, 0x76, 0x6d, 0x3b, 0x9e, 0xfa, 0x3f, 0x25, 0xe8,
-	0xee, 0xe8, 0x87, 0xf8, 0xff, 0xcf, 0x01, 0x7d, 0x06, 0xe7, 0x3c, 0xfd, 0x10, 0x0f, 0x22, 0x51,
-	0xf5, 0xc0, 0xc5, 0x4f, 0xb9, 0x13, 0xfb, 0x86, 0x2c, 0xb7, 0x2e, 0xbd, 0x22, 0xa4, 0x2d, 0x7b,
-	0xb1, 0x7e, 0x0d, 0x3f, 0x45, 0xaf, 0xc1, 0x52, 0xf4, 0xe6, 0x19, 0x41, 0xad, 0x4e, 0x49, 0xbe,
-	0x18, 0xb9, 0x5d, 0xd6, 0x37, 0xd4, 0xa7, 0xf0, 0xea, 0x23, 0xdb, 0xc3, 0x7e, 0x3f, 0xbc, 0x21,
-	0x35, 0x67, 0xfc, 0x79, 0x05, 0x9a, 0x21, 0xe1, 0x53, 0x8f, 0x3b, 0x0c, 0x4f, 0x75, 0xa0, 0xb7,
-	0xa5, 0xbb, 0x07, 0x41, 0x8e, 0x7a, 0x93, 0x5d, 0x67, 0x79, 0x81, 0x0b, 0xee, 0x89, 0x8b, 0x5d,
-	0x1a, 0xde, 0xc3, 0x2e, 0xb6, 0x87, 0xf8, 0x81, 0x33, 0x3c, 0x20, 0x0e, 0x89, 0xcf, 0xde, 0xd7,
-	0x29, 0x11, 0xdf, 0x75, 0x33, 0xf2, 0x7c, 0xae, 0x14, 0x7b, 0x3e, 0x37, 0xe3, 0x39, 0xa6, 0xfa,
-	0x93, 0x12, 0xac, 0xdc, 0xb5, 0x7c, 0xec, 0x86, 0x69, 0x83, 0x93, 0x64, 0x40, 0xc2, 0x94, 0x44,
-	0xe9, 0x34, 0x35, 0x86, 0x02, 0x25, 0x48, 0x59, 0x02, 0xa5, 0x72, 0xca, 0x04, 0xca, 0x5d, 0x80,
-	0x89, 0xeb, 0x4c, 0xb0, 0xeb, 0x9b, 0x38, 0x88, 0xfd, 0x0a, 0x38, 0x38, 0x91, 0x41, 0xea, 0x67,
-	0xd0, 0xb9, 0x3f, 0xdc, 0x70, 0xec, 0x3d, 0xd3, 0x1d, 0x07, 0x84, 0x4a, 0x09, 0x9d, 0x52, 0x40,
-	0xe8, 0x4a, 0x29, 0xa1, 0x53, 0x4d, 0x58, 0x8e, 0xcc, 0x3d, 0xa7, 0xe2, 0x1a, 0x0d, 0x07, 0x7b,
-	0xa6, 0x6d, 0xd2, 0xeb, 0x62, 0x25, 0xea, 0xa0, 0xc2, 0x68, 0x78, 0x8f, 0xf7, 0xa8, 0x3f, 0x54,
-	0xe0, 0xa2, 0x86, 0x89, 0xf0, 0x04, 0x37, 0x6f, 0x76, 0xfd, 0x2d, 0x6f, 0x34, 0x87, 0x43, 0x71,
-	0x07, 0x2a, 0x63, 0x6f, 0x94, 0x51, 0x35, 0x27, 0x26, 0x3a, 0xb6, 0x90, 0x46, 0x81, 0x6f, 0x7e,
-	0x24, 0x2e, 0xf8, 0xee, 0x1e, 0x4f, 0x30, 0x5a, 0x80, 0xf2, 0x43, 0x7c, 0xd4, 0x39, 0x83, 0x00,
-	0x6a, 0x0f, 0x1d, 0x77, 0xac, 0x5b, 0x1d, 0x05, 0x35, 0x61, 0x81, 0xd7, 0x1e, 0x3b, 0x25, 0xb4,
-	0x08, 0x8d, 0x8d, 0xa0, 0x46, 0xd3, 0x29, 0xdf, 0xfc, 0x13, 0x05, 0x96, 0x53, 0xd5, 0x31, 0xd4,
-	0x06, 0x78, 0x64, 0x0f, 0x79, 0xd9, 0xb0, 0x73, 0x06, 0xb5, 0xa0, 0x1e, 0x14, 0x11, 0xd9, 0x7c,
-	0xbb, 0x0e, 0x85, 0xee, 0x94, 0x50, 0x07, 0x5a, 0x6c, 0xe0, 0x74, 0x38, 0xc4, 0x9e, 0xd7, 0x29,
-	0x8b, 0x9e, 0x7b, 0xba, 0x69, 0x4d, 0x5d, 0xdc, 0xa9, 0x90, 0x35, 0x77, 0x1d, 0x0d, 0x5b, 0x58,
-	0xf7, 0x70, 0xa7, 0x8a, 0x10, 0xb4, 0x79, 0x23, 0x18, 0x54, 0x8b, 0xf4, 0x05, 0xc3, 0x16, 0x6e,
-	0x7e, 0x1a, 0xad, 0x63, 0xd0, 0xed, 0x9d, 0x87, 0xb3, 0x8f, 0x6c, 0x03, 0xef, 0x99, 0x36, 0x36,
-	0xc2, 0x4f, 0x9d, 0x33, 0xe8, 0x2c, 0x2c, 0x6d, 0x61, 0x77, 0x84, 0x23, 0x9d, 0x25, 0xb4, 0x0c,
-	0x8b, 0x5b, 0xe6, 0xb3, 0x48, 0x57, 0x59, 0xad, 0xd4, 0x95, 0x8e, 0xb2, 0xf6, 0xb7, 0x37, 0xa0,
-	0x41, 0x88, 0xb9, 0xe1, 0x38, 0xae, 0x81, 0x2c, 0x40, 0xf4, 0x19, 0xcd, 0x78, 0xe2, 0xd8, 0xe2,
-	0xc9, 0x1d, 0xba, 0x95, 0xa0, 0x3f, 0x6b, 0xa4, 0x01, 0xf9, 0x79, 0xf7, 0xae, 0x4b, 0xe1, 0x13,
-	0xc0, 0xea, 0x19, 0x34, 0xa6, 0xab, 0xed, 0x9a, 0x63, 0xbc, 0x6b, 0x0e, 0x0f, 0x02, 0x17, 0xed,
-	0x9d, 0x8c, 0x77, 0x4b, 0x69, 0xd0, 0x60, 0xbd, 0x6b, 0xd2, 0xf5, 0xd8, 0x3b, 0xa7, 0x80, 0xf5,
-	0xd5, 0x33, 0xe8, 0x29, 0x9c, 0xbb, 0x8f, 0x23, 0xfe, 0x6e, 0xb0, 0xe0, 0x5a, 0xf6, 0x82, 0x29,
-	0xe0, 0x13, 0x2e, 0xf9, 0x00, 0xaa, 0x94, 0xdd, 0x90, 0xac, 0xb4, 0x1b, 0x7d, 0x2f, 0xdf, 0xbb,
-	0x9a, 0x0d, 0x20, 0x66, 0xfb, 0x01, 0x2c, 0x25, 0x5e, 0xd2, 0x22, 0x99, 0x8d, 0x94, 0xbf, 0x89,
-	0xee, 0xdd, 0x2c, 0x02, 0x2a, 0xd6, 0x1a, 0x41, 0x3b, 0xfe, 0xfc, 0x06, 0xad, 0x16, 0x78, 0xc4,
-	0xc7, 0x56, 0x7a, 0xa3, 0xf0, 0x73, 0x3f, 0xca, 0x04, 0x9d, 0xe4, 0x1b, 0x4f, 0x74, 0x33, 0x77,
-	0x82, 0x38, 0xb3, 0xbd, 0x59, 0x08, 0x56, 0x2c, 0x77, 0x4c, 0x99, 0x20, 0xf5, 0xc0, 0x2e, 0xc9,
-	0xe3, 0xc1, 0x34, 0x59, 0x2f, 0xff, 0x7a, 0xb7, 0x0b, 0xc3, 0x8b, 0xa5, 0x7f, 0x95, 0xdd, 0xdf,
-	0x92, 0x3d, 0x52, 0x43, 0xef, 0xca, 0xa7, 0xcb, 0x79, 0x5d, 0xd7, 0x5b, 0x3b, 0xc9, 0x10, 0x81,
-	0xc4, 0x2f, 0xd3, 0x8b, 0x57, 0x92, 0x67, 0x5e, 0x49, 0xb9, 0x0b, 0xe6, 0xcb, 0x7e, 0xc1, 0xd6,
-	0x7b, 0xf7, 0x04, 0x23, 0x04, 0x02, 0x4e, 0xf2, 0x11, 0x6d, 0x20, 0x86, 0xb7, 0x67, 0x72, 0xcd,
-	0xe9, 0x64, 0xf0, 0xfb, 0xb0, 0x94, 0xf0, 0x1a, 0x51, 0x71, 0xcf, 0xb2, 0x97, 0x67, 0x21, 0x99,
-	0x48, 0x26, 0x2e, 0x5a, 0xa1, 0x0c, 0xee, 0x97, 0x5c, 0xc6, 0xea, 0xdd, 0x2c, 0x02, 0x2a, 0x36,
-	0x32, 0x81, 0xe5, 0xc4, 0xc7, 0xc7, 0x6b, 0xe8, 0xcd, 0xc2, 0xab, 0x3d, 0x5e, 0xeb, 0xbd, 0x55,
-	0x7c, 0xbd, 0xc7, 0x6b, 0xea, 0x19, 0xe4, 0x51, 0x05, 0x9d, 0xb8, 0xac, 0x83, 0x32, 0x66, 0x91,
-	0x5f, 0x4a, 0xea, 0xbd, 0x5d, 0x10, 0x5a, 0x6c, 0xf3, 0x10, 0xce, 0x4a, 0xee, 0x54, 0xa1, 0xb7,
-	0x73, 0xd9, 0x23, 0x79, 0x99, 0xac, 0x77, 0xab, 0x28, 0x78, 0xc4, 0x3c, 0x74, 0x02, 0xbc, 0xee,
-	0x5a, 0x16, 0x33, 0xfe, 0x6f, 0x65, 0x59, 0xbe, 0x18, 0x58, 0xc6, 0x56, 0x33, 0xa1, 0xc5, 0x92,
-	0xbf, 0x08, 0x68, 0x67, 0xdf, 0x39, 0xa2, 0x5e, 0xda, 0x68, 0xea, 0xea, 0xcc, 0xb1, 0xcc, 0x32,
-	0x80, 0x69, 0xd0, 0x0c, 0x41, 0xcc, 0x1d, 0x21, 0x16, 0x1f, 0x00, 0xdc, 0xc7, 0xfe, 0x16, 0xf6,
-	0x5d, 0x22, 0xfd, 0xaf, 0x65, 0xe1, 0xce, 0x01, 0x82, 0xa5, 0x5e, 0x9f, 0x09, 0x17, 0x25, 0xe8,
-	0x96, 0x6e, 0x4f, 0x75, 0x2b, 0xf2, 0xca, 0x45, 0x4e, 0xd0, 0x24, 0x58, 0x3e, 0x41, 0xd3, 0xd0,
-	0x62, 0xc9, 0x23, 0xe1, 0xbf, 0x44, 0x4a, 0xbf, 0xf9, 0xfe, 0x4b, 0xfa, 0xda, 0x51, 0x52, 0xb7,
-	0xe7, 0xc0, 0x8b, 0x85, 0xbf, 0x50, 0xe8, 0x4d, 0xc0, 0x04, 0xc0, 0xa7, 0xa6, 0xbf, 0xbf, 0x6d,
-	0xe9, 0xb6, 0x57, 0x04, 0x05, 0x0a, 0x78, 0x02, 0x14, 0x38, 0xbc, 0x40, 0xc1, 0x80, 0xc5, 0x58,
-	0x45, 0x16, 0xc9, 0xde, 0x86, 0xc8, 0xaa, 0xd3, 0xbd, 0xd5, 0xd9, 0x80, 0x62, 0x95, 0x7d, 0x58,
-	0x0c, 0x18, 0x9a, 0x11, 0xf7, 0x8d, 0x5c, 0xa6, 0x8f, 0xd1, 0xf5, 0x66, 0x11, 0x50, 0xb1, 0x92,
-	0x07, 0x28, 0x5d, 0x7a, 0x42, 0xc5, 0x0a, 0x95, 0x79, 0xca, 0x27, 0xbb, 0x9e, 0xc5, 0xf4, 0x79,
-	0xa2, 0xb8, 0x2b, 0x37, 0x16, 0xd2, 0x5a, 0xb5, 0x54, 0x9f, 0x67, 0xd4, 0x8a, 0xd5, 0x33, 0xe8,
-	0x53, 0xa8, 0xf1, 0x7f, 0xbb, 0xb9, 0x9e, 0x9f, 0xe4, 0xe5, 0xb3, 0xdf, 0x98, 0x01, 0x25, 0x26,
-	0x3e, 0x80, 0xf3, 0x19, 0x29, 0x5e, 0xa9, 0x9f, 0x91, 0x9f, 0x0e, 0x9e, 0x65, 0x01, 0xc5, 0x62,
-	0xa9, 0x0c, 0x6e, 0xce, 0x62, 0x59, 0xd9, 0xde, 0x59, 0x8b, 0x0d, 0x60, 0x39, 0x95, 0x21, 0x93,
-	0x9a, 0xc0, 0xac, 0x3c, 0xda, 0xac, 0x05, 0x46, 0xf0, 0x8a, 0x34, 0x1b, 0x24, 0xf5, 0x4e, 0xf2,
-	0xf2, 0x46, 0xb3, 0x16, 0x1a, 0xc2, 0x59, 0x49, 0x0e, 0x48, 0x6a, 0xe5, 0xb2, 0x73, 0x45, 0xb3,
-	0x16, 0xd9, 0x83, 0xde, 0xba, 0xeb, 0xe8, 0xc6, 0x50, 0xf7, 0x7c, 0x9a, 0x97, 0x21, 0xa1, 0x62,
-	0xe0, 0x1e, 0xca, 0x63, 0x07, 0x69, 0xf6, 0x66, 0xd6, 0x3a, 0x4f, 0xa0, 0x49, 0x8f, 0x92, 0xfd,
-	0x23, 0x09, 0x92, 0xdb, 0x88, 0x08, 0x44, 0x86, 0xe2, 0x91, 0x01, 0x0a, 0xa6, 0xde, 0x85, 0xe6,
-	0x06, 0x2d, 0x70, 0xf5, 0x6d, 0x03, 0x3f, 0x4b, 0xda, 0x2b, 0xfa, 0x2c, 0xfb, 0x56, 0x04, 0xa0,
-	0x30, 0x85, 0x16, 0xa9, 0xd7, 0x6e, 0xe0, 0x67, 0xec, 0x9c, 0x57, 0x65, 0xf3, 0xc6, 0x40, 0x32,
-	0xa2, 0x1c, 0x29, 0x64, 0xc4, 0xd2, 0x9f, 0x8b, 0xfa, 0xb2, 0x62, 0xb9, 0xdb, 0x19, 0x93, 0xa4,
-	0x20, 0x83, 0x55, 0xdf, 0x29, 0x3e, 0x20, 0x6a, 0x19, 0x02, 0xbc, 0xfa, 0xb4, 0xba, 0xf6, 0x7a,
-	0x1e, 0xea, 0x51, 0x07, 0x75, 0x75, 0x36, 0xa0, 0x58, 0x65, 0x1b, 0x1a, 0x84, 0x3b, 0xd9, 0xf1,
-	0x5c, 0x97, 0x0d, 0x14, 0x9f, 0x8b, 0x1f, 0xce, 0x26, 0xf6, 0x86, 0xae, 0xf9, 0x84, 0x1f, 0xba,
-	0x14, 0x9d, 0x18, 0x48, 0xee, 0xe1, 0x24, 0x20, 0x05, 0xe6, 0x53, 0xea, 0x35, 0x08, 0xd2, 0x71,
-	0x55, 0xf9, 0xf6, 0xac, 0xf3, 0x8d, 0xab, 0xc9, 0x5b, 0x45, 0xc1, 0xc5, 0xb2, 0xbf, 0x44, 0x23,
-	0x21, 0xfa, 0x7d, 0x7d, 0x6a, 0x5a, 0xc6, 0x36, 0xbf, 0x10, 0x8d, 0xde, 0xc9, 0x9b, 0x2a, 0x06,
-	0x9a, 0xe9, 0x00, 0xe6, 0x8c, 0x10, 0xeb, 0xff, 0x3c, 0x34, 0x44, 0x86, 0x10, 0xc9, 0x6e, 0xf4,
-	0x25, 0x73, 0x93, 0xbd, 0xeb, 0xf9, 0x40, 0x62, 0x66, 0x0c, 0xe7, 0x64, 0xf9, 0x40, 0x69, 0x90,
-	0x9d, 0x93, 0x38, 0x9c, 0xc1, 0x1f, 0x6b, 0x5f, 0x36, 0xa0, 0x1e, 0x0c, 0xfc, 0x8a, 0x53, 0x57,
-	0x2f, 0x21, 0x97, 0xf4, 0x7d, 0x58, 0x4a, 0xfc, 0xd1, 0x84, 0x54, 0x83, 0xcb, 0xff, 0x8c, 0x62,
-	0x96, 0xa8, 0x7d, 0xca, 0xff, 0x07, 0x51, 0x04, 0x79, 0xaf, 0x67, 0xe5, 0xa3, 0x92, 0xf1, 0xdd,
-	0x8c, 0x89, 0xff, 0x6f, 0x87, 0x38, 0x0f, 0x01, 0x22, 0xc1, 0x4d, 0xfe, 0x95, 0x6c, 0xe2, 0xaf,
-	0xcf, 0xa2, 0xd6, 0x58, 0x1a, 0xbf, 0xbc, 0x51, 0xe4, 0x7a, 0x6b, 0xb6, 0x07, 0x9a, 0x1d, 0xb5,
-	0x3c, 0x82, 0x56, 0xf4, 0xb1, 0x04, 0x92, 0xfe, 0xeb, 0x5e, 0xfa, 0x35, 0xc5, 0xac, 0x5d, 0x6c,
-	0x9d, 0xd0, 0xb1, 0x9d, 0x31, 0x9d, 0x07, 0x28, 0x5d, 0x29, 0x97, 0x06, 0x02, 0x99, 0xf5, 0x79,
-	0x69, 0x20, 0x90, 0x5d, 0x7e, 0x67, 0x69, 0xc9, 0x64, 0xf9, 0x57, 0x9a, 0x96, 0xcc, 0x28, 0xa8,
-	0x4b, 0xd3, 0x92, 0x59, 0xf5, 0x64, 0xf5, 0xcc, 0xfa, 0x9d, 0xcf, 0xde, 0x1d, 0x99, 0xfe, 0xfe,
-	0xf4, 0x09, 0xd9, 0xfd, 0x6d, 0x36, 0xf4, 0x6d, 0xd3, 0xe1, 0xbf, 0x6e, 0x07, 0xec, 0x7e, 0x9b,
-	0xce, 0x76, 0x9b, 0xcc, 0x36, 0x79, 0xf2, 0xa4, 0x46, 0x5b, 0x77, 0xfe, 0x37, 0x00, 0x00, 0xff,
-	0xff, 0x92, 0xb1, 0xb4, 0x00, 0x03, 0x56, 0x00, 0x00,
+func (m *ChannelWatchStateTransition) Reset()         { *m = ChannelWatchStateTransition{} }
+func (m *ChannelWatchStateTransition) String() string { return proto.CompactTextString(m) }
+func (*ChannelWatchStateTransition) ProtoMessage()    {}
+func (*ChannelWatchStateTransition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{79}
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// DataCoordClient is the client API for DataCoord service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type DataCoordClient interface {
-	GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error)
-	GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
-	GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
-	Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error)
-	AssignSegmentID(ctx context.Context, in *AssignSegmentIDRequest, opts ...grpc.CallOption) (*AssignSegmentIDResponse, error)
-	GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error)
-	GetSegmentStates(ctx context.Context, in *GetSegmentStatesRequest, opts ...grpc.CallOption) (*GetSegmentStatesResponse, error)
-	GetInsertBinlogPaths(ctx context.Context, in *GetInsertBinlogPathsRequest, opts ...grpc.CallOption) (*GetInsertBinlogPathsResponse, error)
-	GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error)
-	GetPartitionStatistics(ctx context.Context, in *GetPartitionStatisticsRequest, opts ...grpc.CallOption) (*GetPartitionStatisticsResponse, error)
-	GetSegmentInfoChannel(ctx context.Context, in *GetSegmentInfoChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
-	SaveBinlogPaths(ctx context.Context, in *SaveBinlogPathsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	GetRecoveryInfo(ctx context.Context, in *GetRecoveryInfoRequest, opts ...grpc.CallOption) (*GetRecoveryInfoResponse, error)
-	GetRecoveryInfoV2(ctx context.Context, in *GetRecoveryInfoRequestV2, opts ...grpc.CallOption) (*GetRecoveryInfoResponseV2, error)
-	GetFlushedSegments(ctx context.Context, in *GetFlushedSegmentsRequest, opts ...grpc.CallOption) (*GetFlushedSegmentsResponse, error)
-	GetSegmentsByStates(ctx context.Context, in *GetSegmentsByStatesRequest, opts ...grpc.CallOption) (*GetSegmentsByStatesResponse, error)
-	GetFlushAllState(ctx context.Context, in *milvuspb.GetFlushAllStateRequest, opts ...grpc.CallOption) (*milvuspb.GetFlushAllStateResponse, error)
-	ShowConfigurations(ctx context.Context, in *internalpb.ShowConfigurationsRequest, opts ...grpc.CallOption) (*internalpb.ShowConfigurationsResponse, error)
-	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
-	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
-	ManualCompaction(ctx context.Context, in *milvuspb.ManualCompactionRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error)
-	GetCompactionState(ctx context.Context, in *milvuspb.GetCompactionStateRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionStateResponse, error)
-	GetCompactionStateWithPlans(ctx context.Context, in *milvuspb.GetCompactionPlansRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionPlansResponse, error)
-	WatchChannels(ctx context.Context, in *WatchChannelsRequest, opts ...grpc.CallOption) (*WatchChannelsResponse, error)
-	GetFlushState(ctx context.Context, in *milvuspb.GetFlushStateRequest, opts ...grpc.CallOption) (*milvuspb.GetFlushStateResponse, error)
-	DropVirtualChannel(ctx context.Context, in *DropVirtualChannelRequest, opts ...grpc.CallOption) (*DropVirtualChannelResponse, error)
-	SetSegmentState(ctx context.Context, in *SetSegmentStateRequest, opts ...grpc.CallOption) (*SetSegmentStateResponse, error)
-	// https://wiki.lfaidata.foundation/display/MIL/MEP+24+--+Support+bulk+load
-	Import(ctx context.Context, in *ImportTaskRequest, opts ...grpc.CallOption) (*ImportTaskResponse, error)
-	UpdateSegmentStatistics(ctx context.Context, in *UpdateSegmentStatisticsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	UpdateChannelCheckpoint(ctx context.Context, in *UpdateChannelCheckpointRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	SaveImportSegment(ctx context.Context, in *SaveImportSegmentRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	UnsetIsImportingState(ctx context.Context, in *UnsetIsImportingStateRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	MarkSegmentsDropped(ctx context.Context, in *MarkSegmentsDroppedRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	BroadcastAlteredCollection(ctx context.Context, in *AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error)
-	CreateIndex(ctx context.Context, in *indexpb.CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	// Deprecated: use DescribeIndex instead
-	GetIndexState(ctx context.Context, in *indexpb.GetIndexStateRequest, opts ...grpc.CallOption) (*indexpb.GetIndexStateResponse, error)
-	GetSegmentIndexState(ctx context.Context, in *indexpb.GetSegmentIndexStateRequest, opts ...grpc.CallOption) (*indexpb.GetSegmentIndexStateResponse, error)
-	GetIndexInfos(ctx context.Context, in *indexpb.GetIndexInfoRequest, opts ...grpc.CallOption) (*indexpb.GetIndexInfoResponse, error)
-	DropIndex(ctx context.Context, in *indexpb.DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	DescribeIndex(ctx context.Context, in *indexpb.DescribeIndexRequest, opts ...grpc.CallOption) (*indexpb.DescribeIndexResponse, error)
-	GetIndexStatistics(ctx context.Context, in *indexpb.GetIndexStatisticsRequest, opts ...grpc.CallOption) (*indexpb.GetIndexStatisticsResponse, error)
-	// Deprecated: use DescribeIndex instead
-	GetIndexBuildProgress(ctx context.Context, in *indexpb.GetIndexBuildProgressRequest, opts ...grpc.CallOption) (*indexpb.GetIndexBuildProgressResponse, error)
-	GcConfirm(ctx context.Context, in *GcConfirmRequest, opts ...grpc.CallOption) (*GcConfirmResponse, error)
-	ReportDataNodeTtMsgs(ctx context.Context, in *ReportDataNodeTtMsgsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+func (m *ChannelWatchStateTransition) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelWatchStateTransition.Unmarshal(m, b)
 }
-
-type dataCoordClient struct {
-	cc *grpc.ClientConn
+func (m *ChannelWatchStateTransition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelWatchStateTransition.Marshal(b, m, deterministic)
 }
-
-func NewDataCoordClient(cc *grpc.ClientConn) DataCoordClient {
-	return &dataCoordClient{cc}
+func (m *ChannelWatchStateTransition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelWatchStateTransition.Merge(m, src)
 }
-
-func (c *dataCoordClient) GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error) {
-	out := new(milvuspb.ComponentStates)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetComponentStates", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ChannelWatchStateTransition) XXX_Size() int {
+	return xxx_messageInfo_ChannelWatchStateTransition.Size(m)
 }
-
-func (c *dataCoordClient) GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
-	out := new(milvuspb.StringResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetTimeTickChannel", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ChannelWatchStateTransition) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelWatchStateTransition.DiscardUnknown(m)
 }
 
-func (c *dataCoordClient) GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
-	out := new(milvuspb.StringResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetStatisticsChannel", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ChannelWatchStateTransition proto.InternalMessageInfo
+
+func (m *ChannelWatchStateTransition) GetState() ChannelWatchState {
+	if m != nil {
+		return m.State
 	}
-	return out, nil
+	return ChannelWatchState_Uncomplete
 }
 
-func (c *dataCoordClient) Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error) {
-	out := new(FlushResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/Flush", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ChannelWatchStateTransition) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *dataCoordClient) AssignSegmentID(ctx context.Context, in *AssignSegmentIDRequest, opts ...grpc.CallOption) (*AssignSegmentIDResponse, error) {
-	out := new(AssignSegmentIDResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/AssignSegmentID", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ChannelWatchStateTransition) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
 	}
-	return out, nil
+	return 0
 }
 
-func (c *dataCoordClient) GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error) {
-	out := new(GetSegmentInfoResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ChannelWatchStateTransition) GetPolicy() string {
+	if m != nil {
+		return m.Policy
 	}
-	return out, nil
+	return ""
 }
 
-func (c *dataCoordClient) GetSegmentStates(ctx context.Context, in *GetSegmentStatesRequest, opts ...grpc.CallOption) (*GetSegmentStatesResponse, error) {
-	out := new(GetSegmentStatesResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentStates", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type GetChannelWatchHistoryRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	ChannelName          string            `protobuf:"bytes,2,opt,name=channelName,proto3" json:"channelName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (c *dataCoordClient) GetInsertBinlogPaths(ctx context.Context, in *GetInsertBinlogPathsRequest, opts ...grpc.CallOption) (*GetInsertBinlogPathsResponse, error) {
-	out := new(GetInsertBinlogPathsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetInsertBinlogPaths", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetChannelWatchHistoryRequest) Reset()         { *m = GetChannelWatchHistoryRequest{} }
+func (m *GetChannelWatchHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetChannelWatchHistoryRequest) ProtoMessage()    {}
+func (*GetChannelWatchHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{80}
 }
 
-func (c *dataCoordClient) GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error) {
-	out := new(GetCollectionStatisticsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetCollectionStatistics", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetChannelWatchHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetChannelWatchHistoryRequest.Unmarshal(m, b)
+}
+func (m *GetChannelWatchHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetChannelWatchHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *GetChannelWatchHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetChannelWatchHistoryRequest.Merge(m, src)
+}
+func (m *GetChannelWatchHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_GetChannelWatchHistoryRequest.Size(m)
+}
+func (m *GetChannelWatchHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetChannelWatchHistoryRequest.DiscardUnknown(m)
 }
 
-func (c *dataCoordClient) GetPartitionStatistics(ctx context.Context, in *GetPartitionStatisticsRequest, opts ...grpc.CallOption) (*GetPartitionStatisticsResponse, error) {
-	out := new(GetPartitionStatisticsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetPartitionStatistics", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetChannelWatchHistoryRequest proto.InternalMessageInfo
+
+func (m *GetChannelWatchHistoryRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
 	}
-	return out, nil
+	return nil
 }
 
-func (c *dataCoordClient) GetSegmentInfoChannel(ctx context.Context, in *GetSegmentInfoChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
-	out := new(milvuspb.StringResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentInfoChannel", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetChannelWatchHistoryRequest) GetChannelName() string {
+	if m != nil {
+		return m.ChannelName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *dataCoordClient) SaveBinlogPaths(ctx context.Context, in *SaveBinlogPathsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/SaveBinlogPaths", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type GetChannelWatchHistoryResponse struct {
+	Status               *commonpb.Status               `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	NodeID               int64                          `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	History              []*ChannelWatchStateTransition `protobuf:"bytes,3,rep,name=history,proto3" json:"history,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
 }
 
-func (c *dataCoordClient) GetRecoveryInfo(ctx context.Context, in *GetRecoveryInfoRequest, opts ...grpc.CallOption) (*GetRecoveryInfoResponse, error) {
-	out := new(GetRecoveryInfoResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetRecoveryInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetChannelWatchHistoryResponse) Reset()         { *m = GetChannelWatchHistoryResponse{} }
+func (m *GetChannelWatchHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetChannelWatchHistoryResponse) ProtoMessage()    {}
+func (*GetChannelWatchHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{81}
 }
 
-func (c *dataCoordClient) GetRecoveryInfoV2(ctx context.Context, in *GetRecoveryInfoRequestV2, opts ...grpc.CallOption) (*GetRecoveryInfoResponseV2, error) {
-	out := new(GetRecoveryInfoResponseV2)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetRecoveryInfoV2", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetChannelWatchHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetChannelWatchHistoryResponse.Unmarshal(m, b)
+}
+func (m *GetChannelWatchHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetChannelWatchHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *GetChannelWatchHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetChannelWatchHistoryResponse.Merge(m, src)
+}
+func (m *GetChannelWatchHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_GetChannelWatchHistoryResponse.Size(m)
+}
+func (m *GetChannelWatchHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetChannelWatchHistoryResponse.DiscardUnknown(m)
 }
 
-func (c *dataCoordClient) GetFlushedSegments(ctx context.Context, in *GetFlushedSegmentsRequest, opts ...grpc.CallOption) (*GetFlushedSegmentsResponse, error) {
-	out := new(GetFlushedSegmentsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetFlushedSegments", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetChannelWatchHistoryResponse proto.InternalMessageInfo
+
+func (m *GetChannelWatchHistoryResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
 	}
-	return out, nil
+	return nil
 }
 
-func (c *dataCoordClient) GetSegmentsByStates(ctx context.Context, in *GetSegmentsByStatesRequest, opts ...grpc.CallOption) (*GetSegmentsByStatesResponse, error) {
-	out := new(GetSegmentsByStatesResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentsByStates", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetChannelWatchHistoryResponse) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *dataCoordClient) GetFlushAllState(ctx context.Context, in *milvuspb.GetFlushAllStateRequest, opts ...grpc.CallOption) (*milvuspb.GetFlushAllStateResponse, error) {
-	out := new(milvuspb.GetFlushAllStateResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetFlushAllState", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetChannelWatchHistoryResponse) GetHistory() []*ChannelWatchStateTransition {
+	if m != nil {
+		return m.History
 	}
-	return out, nil
+	return nil
 }
 
-func (c *dataCoordClient) ShowConfigurations(ctx context.Context, in *internalpb.ShowConfigurationsRequest, opts ...grpc.CallOption) (*internalpb.ShowConfigurationsResponse, error) {
-	out := new(internalpb.ShowConfigurationsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ShowConfigurations", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type SetNodeConfigsRequest struct {
+	Base                 *commonpb.MsgBase        `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	NodeID               int64                    `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	Configs              []*commonpb.KeyValuePair `protobuf:"bytes,3,rep,name=configs,proto3" json:"configs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (c *dataCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
-	out := new(milvuspb.GetMetricsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetMetrics", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *SetNodeConfigsRequest) Reset()         { *m = SetNodeConfigsRequest{} }
+func (m *SetNodeConfigsRequest) String() string { return proto.CompactTextString(m) }
+func (*SetNodeConfigsRequest) ProtoMessage()    {}
+func (*SetNodeConfigsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{82}
 }
 
-func (c *dataCoordClient) ManualCompaction(ctx context.Context, in *milvuspb.ManualCompactionRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error) {
-	out := new(milvuspb.ManualCompactionResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ManualCompaction", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *SetNodeConfigsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetNodeConfigsRequest.Unmarshal(m, b)
+}
+func (m *SetNodeConfigsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetNodeConfigsRequest.Marshal(b, m, deterministic)
+}
+func (m *SetNodeConfigsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetNodeConfigsRequest.Merge(m, src)
+}
+func (m *SetNodeConfigsRequest) XXX_Size() int {
+	return xxx_messageInfo_SetNodeConfigsRequest.Size(m)
+}
+func (m *SetNodeConfigsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetNodeConfigsRequest.DiscardUnknown(m)
 }
 
-func (c *dataCoordClient) GetCompactionState(ctx context.Context, in *milvuspb.GetCompactionStateRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionStateResponse, error) {
-	out := new(milvuspb.GetCompactionStateResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetCompactionState", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SetNodeConfigsRequest proto.InternalMessageInfo
+
+func (m *SetNodeConfigsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
 	}
-	return out, nil
+	return nil
 }
 
-func (c *dataCoordClient) GetCompactionStateWithPlans(ctx context.Context, in *milvuspb.GetCompactionPlansRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionPlansResponse, error) {
-	out := new(milvuspb.GetCompactionPlansResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetCompactionStateWithPlans", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SetNodeConfigsRequest) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *dataCoordClient) WatchChannels(ctx context.Context, in *WatchChannelsRequest, opts ...grpc.CallOption) (*WatchChannelsResponse, error) {
-	out := new(WatchChannelsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/WatchChannels", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SetNodeConfigsRequest) GetConfigs() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Configs
 	}
-	return out, nil
+	return nil
 }
 
-func (c *dataCoordClient) GetFlushState(ctx context.Context, in *milvuspb.GetFlushStateRequest, opts ...grpc.CallOption) (*milvuspb.GetFlushStateResponse, error) {
-	out := new(milvuspb.GetFlushStateResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetFlushState", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type ListNodeConfigsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	NodeID               int64             `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (c *dataCoordClient) DropVirtualChannel(ctx context.Context, in *DropVirtualChannelRequest, opts ...grpc.CallOption) (*DropVirtualChannelResponse, error) {
-	out := new(DropVirtualChannelResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/DropVirtualChannel", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ListNodeConfigsRequest) Reset()         { *m = ListNodeConfigsRequest{} }
+func (m *ListNodeConfigsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListNodeConfigsRequest) ProtoMessage()    {}
+func (*ListNodeConfigsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{83}
 }
 
-func (c *dataCoordClient) SetSegmentState(ctx context.Context, in *SetSegmentStateRequest, opts ...grpc.CallOption) (*SetSegmentStateResponse, error) {
-	out := new(SetSegmentStateResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/SetSegmentState", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ListNodeConfigsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListNodeConfigsRequest.Unmarshal(m, b)
+}
+func (m *ListNodeConfigsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListNodeConfigsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListNodeConfigsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListNodeConfigsRequest.Merge(m, src)
+}
+func (m *ListNodeConfigsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListNodeConfigsRequest.Size(m)
+}
+func (m *ListNodeConfigsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListNodeConfigsRequest.DiscardUnknown(m)
 }
 
-func (c *dataCoordClient) Import(ctx context.Context, in *ImportTaskRequest, opts ...grpc.CallOption) (*ImportTaskResponse, error) {
-	out := new(ImportTaskResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/Import", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ListNodeConfigsRequest proto.InternalMessageInfo
+
+func (m *ListNodeConfigsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
 	}
-	return out, nil
+	return nil
 }
 
-func (c *dataCoordClient) UpdateSegmentStatistics(ctx context.Context, in *UpdateSegmentStatisticsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/UpdateSegmentStatistics", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListNodeConfigsRequest) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *dataCoordClient) UpdateChannelCheckpoint(ctx context.Context, in *UpdateChannelCheckpointRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/UpdateChannelCheckpoint", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type ListNodeConfigsResponse struct {
+	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Configs              []*commonpb.KeyValuePair `protobuf:"bytes,2,rep,name=configs,proto3" json:"configs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (c *dataCoordClient) SaveImportSegment(ctx context.Context, in *SaveImportSegmentRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/SaveImportSegment", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ListNodeConfigsResponse) Reset()         { *m = ListNodeConfigsResponse{} }
+func (m *ListNodeConfigsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListNodeConfigsResponse) ProtoMessage()    {}
+func (*ListNodeConfigsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{84}
 }
 
-func (c *dataCoordClient) UnsetIsImportingState(ctx context.Context, in *UnsetIsImportingStateRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/UnsetIsImportingState", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ListNodeConfigsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListNodeConfigsResponse.Unmarshal(m, b)
+}
+func (m *ListNodeConfigsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListNodeConfigsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListNodeConfigsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListNodeConfigsResponse.Merge(m, src)
+}
+func (m *ListNodeConfigsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListNodeConfigsResponse.Size(m)
+}
+func (m *ListNodeConfigsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListNodeConfigsResponse.DiscardUnknown(m)
 }
 
-func (c *dataCoordClient) MarkSegmentsDropped(ctx context.Context, in *MarkSegmentsDroppedRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/MarkSegmentsDropped", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ListNodeConfigsResponse proto.InternalMessageInfo
+
+func (m *ListNodeConfigsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
 	}
-	return out, nil
+	return nil
 }
 
-func (c *dataCoordClient) BroadcastAlteredCollection(ctx context.Context, in *AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/BroadcastAlteredCollection", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListNodeConfigsResponse) GetConfigs() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Configs
 	}
-	return out, nil
+	return nil
 }
 
-func (c *dataCoordClient) CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error) {
-	out := new(milvuspb.CheckHealthResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/CheckHealth", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type ClearNodeConfigsRequest struct {
+	Base   *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	NodeID int64             `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	// keys to clear, clear all the overrides of the node if empty
+	Keys                 []string `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *dataCoordClient) CreateIndex(ctx context.Context, in *indexpb.CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/CreateIndex", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ClearNodeConfigsRequest) Reset()         { *m = ClearNodeConfigsRequest{} }
+func (m *ClearNodeConfigsRequest) String() string { return proto.CompactTextString(m) }
+func (*ClearNodeConfigsRequest) ProtoMessage()    {}
+func (*ClearNodeConfigsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{85}
 }
 
-func (c *dataCoordClient) GetIndexState(ctx context.Context, in *indexpb.GetIndexStateRequest, opts ...grpc.CallOption) (*indexpb.GetIndexStateResponse, error) {
-	out := new(indexpb.GetIndexStateResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetIndexState", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ClearNodeConfigsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClearNodeConfigsRequest.Unmarshal(m, b)
+}
+func (m *ClearNodeConfigsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClearNodeConfigsRequest.Marshal(b, m, deterministic)
+}
+func (m *ClearNodeConfigsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClearNodeConfigsRequest.Merge(m, src)
+}
+func (m *ClearNodeConfigsRequest) XXX_Size() int {
+	return xxx_messageInfo_ClearNodeConfigsRequest.Size(m)
+}
+func (m *ClearNodeConfigsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClearNodeConfigsRequest.DiscardUnknown(m)
 }
 
-func (c *dataCoordClient) GetSegmentIndexState(ctx context.Context, in *indexpb.GetSegmentIndexStateRequest, opts ...grpc.CallOption) (*indexpb.GetSegmentIndexStateResponse, error) {
-	out := new(indexpb.GetSegmentIndexStateResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentIndexState", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ClearNodeConfigsRequest proto.InternalMessageInfo
+
+func (m *ClearNodeConfigsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
 	}
-	return out, nil
+	return nil
 }
 
-func (c *dataCoordClient) GetIndexInfos(ctx context.Context, in *indexpb.GetIndexInfoRequest, opts ...grpc.CallOption) (*indexpb.GetIndexInfoResponse, error) {
-	out := new(indexpb.GetIndexInfoResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetIndexInfos", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ClearNodeConfigsRequest) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *dataCoordClient) DropIndex(ctx context.Context, in *indexpb.DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/DropIndex", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ClearNodeConfigsRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
 	}
-	return out, nil
+	return nil
 }
 
-func (c *dataCoordClient) DescribeIndex(ctx context.Context, in *indexpb.DescribeIndexRequest, opts ...grpc.CallOption) (*indexpb.DescribeIndexResponse, error) {
-	out := new(indexpb.DescribeIndexResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/DescribeIndex", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type CloneSegmentsRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// source collection
+	CollectionID       int64 `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	TargetCollectionID int64 `protobuf:"varint,3,opt,name=target_collectionID,json=targetCollectionID,proto3" json:"target_collectionID,omitempty"`
+	// source partition ID -> target partition ID
+	PartitionIDs map[int64]int64 `protobuf:"bytes,4,rep,name=partitionIDs,proto3" json:"partitionIDs,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// source vchannel -> target vchannel
+	Channels map[string]string `protobuf:"bytes,5,rep,name=channels,proto3" json:"channels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// the source segments to clone, which must be flushed,
+	// all the segments are cloned if empty, the growing ones are sealed and cloned once flushed
+	SegmentIDs []int64 `protobuf:"varint,6,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	// the indexes of these fields are not cloned
+	SkipIndexFieldIDs    []int64  `protobuf:"varint,7,rep,packed,name=skip_index_fieldIDs,json=skipIndexFieldIDs,proto3" json:"skip_index_fieldIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *dataCoordClient) GetIndexStatistics(ctx context.Context, in *indexpb.GetIndexStatisticsRequest, opts ...grpc.CallOption) (*indexpb.GetIndexStatisticsResponse, error) {
-	out := new(indexpb.GetIndexStatisticsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetIndexStatistics", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *CloneSegmentsRequest) Reset()         { *m = CloneSegmentsRequest{} }
+func (m *CloneSegmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*CloneSegmentsRequest) ProtoMessage()    {}
+func (*CloneSegmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{86}
 }
 
-func (c *dataCoordClient) GetIndexBuildProgress(ctx context.Context, in *indexpb.GetIndexBuildProgressRequest, opts ...grpc.CallOption) (*indexpb.GetIndexBuildProgressResponse, error) {
-	out := new(indexpb.GetIndexBuildProgressResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetIndexBuildProgress", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CloneSegmentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloneSegmentsRequest.Unmarshal(m, b)
+}
+func (m *CloneSegmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloneSegmentsRequest.Marshal(b, m, deterministic)
+}
+func (m *CloneSegmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloneSegmentsRequest.Merge(m, src)
+}
+func (m *CloneSegmentsRequest) XXX_Size() int {
+	return xxx_messageInfo_CloneSegmentsRequest.Size(m)
+}
+func (m *CloneSegmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloneSegmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloneSegmentsRequest proto.InternalMessageInfo
+
+func (m *CloneSegmentsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
 	}
-	return out, nil
+	return nil
 }
 
-func (c *dataCoordClient) GcConfirm(ctx context.Context, in *GcConfirmRequest, opts ...grpc.CallOption) (*GcConfirmResponse, error) {
-	out := new(GcConfirmResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GcConfirm", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CloneSegmentsRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *dataCoordClient) ReportDataNodeTtMsgs(ctx context.Context, in *ReportDataNodeTtMsgsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ReportDataNodeTtMsgs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CloneSegmentsRequest) GetTargetCollectionID() int64 {
+	if m != nil {
+		return m.TargetCollectionID
 	}
-	return out, nil
+	return 0
 }
 
-// DataCoordServer is the server API for DataCoord service.
-type DataCoordServer interface {
-	GetComponentStates(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)
-	GetTimeTickChannel(context.Context, *internalpb.GetTimeTickChannelRequest) (*milvuspb.StringResponse, error)
-	GetStatisticsChannel(context.Context, *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error)
-	Flush(context.Context, *FlushRequest) (*FlushResponse, error)
-	AssignSegmentID(context.Context, *AssignSegmentIDRequest) (*AssignSegmentIDResponse, error)
-	GetSegmentInfo(context.Context, *GetSegmentInfoRequest) (*GetSegmentInfoResponse, error)
-	GetSegmentStates(context.Context, *GetSegmentStatesRequest) (*GetSegmentStatesResponse, error)
-	GetInsertBinlogPaths(context.Context, *GetInsertBinlogPathsRequest) (*GetInsertBinlogPathsResponse, error)
-	GetCollectionStatistics(context.Context, *GetCollectionStatisticsRequest) (*GetCollectionStatisticsResponse, error)
-	GetPartitionStatistics(context.Context, *GetPartitionStatisticsRequest) (*GetPartitionStatisticsResponse, error)
-	GetSegmentInfoChannel(context.Context, *GetSegmentInfoChannelRequest) (*milvuspb.StringResponse, error)
-	SaveBinlogPaths(context.Context, *SaveBinlogPathsRequest) (*commonpb.Status, error)
-	GetRecoveryInfo(context.Context, *GetRecoveryInfoRequest) (*GetRecoveryInfoResponse, error)
-	GetRecoveryInfoV2(context.Context, *GetRecoveryInfoRequestV2) (*GetRecoveryInfoResponseV2, error)
-	GetFlushedSegments(context.Context, *GetFlushedSegmentsRequest) (*GetFlushedSegmentsResponse, error)
-	GetSegmentsByStates(context.Context, *GetSegmentsByStatesRequest) (*GetSegmentsByStatesResponse, error)
-	GetFlushAllState(context.Context, *milvuspb.GetFlushAllStateRequest) (*milvuspb.GetFlushAllStateResponse, error)
-	ShowConfigurations(context.Context, *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error)
-	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
-	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
-	ManualCompaction(context.Context, *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error)
-	GetCompactionState(context.Context, *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error)
-	GetCompactionStateWithPlans(context.Context, *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error)
-	WatchChannels(context.Context, *WatchChannelsRequest) (*WatchChannelsResponse, error)
-	GetFlushState(context.Context, *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error)
-	DropVirtualChannel(context.Context, *DropVirtualChannelRequest) (*DropVirtualChannelResponse, error)
-	SetSegmentState(context.Context, *SetSegmentStateRequest) (*SetSegmentStateResponse, error)
-	// https://wiki.lfaidata.foundation/display/MIL/MEP+24+--+Support+bulk+load
-	Import(context.Context, *ImportTaskRequest) (*ImportTaskResponse, error)
-	UpdateSegmentStatistics(context.Context, *UpdateSegmentStatisticsRequest) (*commonpb.Status, error)
-	UpdateChannelCheckpoint(context.Context, *UpdateChannelCheckpointRequest) (*commonpb.Status, error)
-	SaveImportSegment(context.Context, *SaveImportSegmentRequest) (*commonpb.Status, error)
-	UnsetIsImportingState(context.Context, *UnsetIsImportingStateRequest) (*commonpb.Status, error)
-	MarkSegmentsDropped(context.Context, *MarkSegmentsDroppedRequest) (*commonpb.Status, error)
-	BroadcastAlteredCollection(context.Context, *AlterCollectionRequest) (*commonpb.Status, error)
-	CheckHealth(context.Context, *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error)
-	CreateIndex(context.Context, *indexpb.CreateIndexRequest) (*commonpb.Status, error)
-	// Deprecated: use DescribeIndex instead
-	GetIndexState(context.Context, *indexpb.GetIndexStateRequest) (*indexpb.GetIndexStateResponse, error)
-	GetSegmentIndexState(context.Context, *indexpb.GetSegmentIndexStateRequest) (*indexpb.GetSegmentIndexStateResponse, error)
-	GetIndexInfos(context.Context, *indexpb.GetIndexInfoRequest) (*indexpb.GetIndexInfoResponse, error)
-	DropIndex(context.Context, *indexpb.DropIndexRequest) (*commonpb.Status, error)
-	DescribeIndex(context.Context, *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error)
-	GetIndexStatistics(context.Context, *indexpb.GetIndexStatisticsRequest) (*indexpb.GetIndexStatisticsResponse, error)
-	// Deprecated: use DescribeIndex instead
-	GetIndexBuildProgress(context.Context, *indexpb.GetIndexBuildProgressRequest) (*indexpb.GetIndexBuildProgressResponse, error)
-	GcConfirm(context.Context, *GcConfirmRequest) (*GcConfirmResponse, error)
-	ReportDataNodeTtMsgs(context.Context, *ReportDataNodeTtMsgsRequest) (*commonpb.Status, error)
+func (m *CloneSegmentsRequest) GetPartitionIDs() map[int64]int64 {
+	if m != nil {
+		return m.PartitionIDs
+	}
+	return nil
 }
 
-// UnimplementedDataCoordServer can be embedded to have forward compatible implementations.
-type UnimplementedDataCoordServer struct {
+func (m *CloneSegmentsRequest) GetChannels() map[string]string {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
 }
 
-func (*UnimplementedDataCoordServer) GetComponentStates(ctx context.Context, req *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetComponentStates not implemented")
+func (m *CloneSegmentsRequest) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
 }
-func (*UnimplementedDataCoordServer) GetTimeTickChannel(ctx context.Context, req *internalpb.GetTimeTickChannelRequest) (*milvuspb.StringResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTimeTickChannel not implemented")
+
+func (m *CloneSegmentsRequest) GetSkipIndexFieldIDs() []int64 {
+	if m != nil {
+		return m.SkipIndexFieldIDs
+	}
+	return nil
 }
-func (*UnimplementedDataCoordServer) GetStatisticsChannel(ctx context.Context, req *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetStatisticsChannel not implemented")
+
+// CloneJob records the progress of cloning the segments into the target collection,
+// the job interrupted by datacoord restarting is resumed from it.
+type CloneJob struct {
+	Request *CloneSegmentsRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// the source segments to clone, which are cloned in order
+	SegmentIDs []int64 `protobuf:"varint,2,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	// the number of the source segments cloned
+	Cloned int64 `protobuf:"varint,3,opt,name=cloned,proto3" json:"cloned,omitempty"`
+	// the ID allocated for the segment being cloned before copying its binlogs,
+	// the segment is not cloned again if it had been added before the job was interrupted
+	CloningSegmentID     int64      `protobuf:"varint,4,opt,name=cloning_segmentID,json=cloningSegmentID,proto3" json:"cloning_segmentID,omitempty"`
+	State                CloneState `protobuf:"varint,5,opt,name=state,proto3,enum=milvus.proto.data.CloneState" json:"state,omitempty"`
+	Reason               string     `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
-func (*UnimplementedDataCoordServer) Flush(ctx context.Context, req *FlushRequest) (*FlushResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Flush not implemented")
+
+func (m *CloneJob) Reset()         { *m = CloneJob{} }
+func (m *CloneJob) String() string { return proto.CompactTextString(m) }
+func (*CloneJob) ProtoMessage()    {}
+func (*CloneJob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{87}
 }
-func (*UnimplementedDataCoordServer) AssignSegmentID(ctx context.Context, req *AssignSegmentIDRequest) (*AssignSegmentIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AssignSegmentID not implemented")
+
+func (m *CloneJob) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloneJob.Unmarshal(m, b)
 }
-func (*UnimplementedDataCoordServer) GetSegmentInfo(ctx context.Context, req *GetSegmentInfoRequest) (*GetSegmentInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentInfo not implemented")
+func (m *CloneJob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloneJob.Marshal(b, m, deterministic)
 }
-func (*UnimplementedDataCoordServer) GetSegmentStates(ctx context.Context, req *GetSegmentStatesRequest) (*GetSegmentStatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentStates not implemented")
+func (m *CloneJob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloneJob.Merge(m, src)
 }
-func (*UnimplementedDataCoordServer) GetInsertBinlogPaths(ctx context.Context, req *GetInsertBinlogPathsRequest) (*GetInsertBinlogPathsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetInsertBinlogPaths not implemented")
+func (m *CloneJob) XXX_Size() int {
+	return xxx_messageInfo_CloneJob.Size(m)
 }
-func (*UnimplementedDataCoordServer) GetCollectionStatistics(ctx context.Context, req *GetCollectionStatisticsRequest) (*GetCollectionStatisticsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionStatistics not implemented")
+func (m *CloneJob) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloneJob.DiscardUnknown(m)
 }
-func (*UnimplementedDataCoordServer) GetPartitionStatistics(ctx context.Context, req *GetPartitionStatisticsRequest) (*GetPartitionStatisticsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPartitionStatistics not implemented")
+
+var xxx_messageInfo_CloneJob proto.InternalMessageInfo
+
+func (m *CloneJob) GetRequest() *CloneSegmentsRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
 }
-func (*UnimplementedDataCoordServer) GetSegmentInfoChannel(ctx context.Context, req *GetSegmentInfoChannelRequest) (*milvuspb.StringResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentInfoChannel not implemented")
+
+func (m *CloneJob) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
 }
-func (*UnimplementedDataCoordServer) SaveBinlogPaths(ctx context.Context, req *SaveBinlogPathsRequest) (*commonpb.Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SaveBinlogPaths not implemented")
+
+func (m *CloneJob) GetCloned() int64 {
+	if m != nil {
+		return m.Cloned
+	}
+	return 0
 }
-func (*UnimplementedDataCoordServer) GetRecoveryInfo(ctx context.Context, req *GetRecoveryInfoRequest) (*GetRecoveryInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRecoveryInfo not implemented")
+
+func (m *CloneJob) GetCloningSegmentID() int64 {
+	if m != nil {
+		return m.CloningSegmentID
+	}
+	return 0
 }
-func (*UnimplementedDataCoordServer) GetRecoveryInfoV2(ctx context.Context, req *GetRecoveryInfoRequestV2) (*GetRecoveryInfoResponseV2, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRecoveryInfoV2 not implemented")
+
+func (m *CloneJob) GetState() CloneState {
+	if m != nil {
+		return m.State
+	}
+	return CloneState_CloneNone
 }
-func (*UnimplementedDataCoordServer) GetFlushedSegments(ctx context.Context, req *GetFlushedSegmentsRequest) (*GetFlushedSegmentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetFlushedSegments not implemented")
+
+func (m *CloneJob) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
-func (*UnimplementedDataCoordServer) GetSegmentsByStates(ctx context.Context, req *GetSegmentsByStatesRequest) (*GetSegmentsByStatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentsByStates not implemented")
+
+type GetCloneSegmentsStateRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// the target collection of the clone
+	CollectionID int64 `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// proxy resolves the target collection by name if the collectionID is not set
+	DbName               string   `protobuf:"bytes,3,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string   `protobuf:"bytes,4,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (*UnimplementedDataCoordServer) GetFlushAllState(ctx context.Context, req *milvuspb.GetFlushAllStateRequest) (*milvuspb.GetFlushAllStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetFlushAllState not implemented")
+
+func (m *GetCloneSegmentsStateRequest) Reset()         { *m = GetCloneSegmentsStateRequest{} }
+func (m *GetCloneSegmentsStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCloneSegmentsStateRequest) ProtoMessage()    {}
+func (*GetCloneSegmentsStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{88}
 }
-func (*UnimplementedDataCoordServer) ShowConfigurations(ctx context.Context, req *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ShowConfigurations not implemented")
+
+func (m *GetCloneSegmentsStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCloneSegmentsStateRequest.Unmarshal(m, b)
 }
-func (*UnimplementedDataCoordServer) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
+func (m *GetCloneSegmentsStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCloneSegmentsStateRequest.Marshal(b, m, deterministic)
 }
-func (*UnimplementedDataCoordServer) ManualCompaction(ctx context.Context, req *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ManualCompaction not implemented")
+func (m *GetCloneSegmentsStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCloneSegmentsStateRequest.Merge(m, src)
 }
-func (*UnimplementedDataCoordServer) GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCompactionState not implemented")
+func (m *GetCloneSegmentsStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCloneSegmentsStateRequest.Size(m)
 }
-func (*UnimplementedDataCoordServer) GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCompactionStateWithPlans not implemented")
+func (m *GetCloneSegmentsStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCloneSegmentsStateRequest.DiscardUnknown(m)
 }
-func (*UnimplementedDataCoordServer) WatchChannels(ctx context.Context, req *WatchChannelsRequest) (*WatchChannelsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WatchChannels not implemented")
+
+var xxx_messageInfo_GetCloneSegmentsStateRequest proto.InternalMessageInfo
+
+func (m *GetCloneSegmentsStateRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
 }
-func (*UnimplementedDataCoordServer) GetFlushState(ctx context.Context, req *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetFlushState not implemented")
+
+func (m *GetCloneSegmentsStateRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
 }
-func (*UnimplementedDataCoordServer) DropVirtualChannel(ctx context.Context, req *DropVirtualChannelRequest) (*DropVirtualChannelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DropVirtualChannel not implemented")
+
+func (m *GetCloneSegmentsStateRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
 }
-func (*UnimplementedDataCoordServer) SetSegmentState(ctx context.Context, req *SetSegmentStateRequest) (*SetSegmentStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetSegmentState not implemented")
+
+func (m *GetCloneSegmentsStateRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
 }
-func (*UnimplementedDataCoordServer) Import(ctx context.Context, req *ImportTaskRequest) (*ImportTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Import not implemented")
+
+type GetCloneSegmentsStateResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	State                CloneState       `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.data.CloneState" json:"state,omitempty"`
+	TotalSegments        int64            `protobuf:"varint,3,opt,name=total_segments,json=totalSegments,proto3" json:"total_segments,omitempty"`
+	ClonedSegments       int64            `protobuf:"varint,4,opt,name=cloned_segments,json=clonedSegments,proto3" json:"cloned_segments,omitempty"`
+	Reason               string           `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
-func (*UnimplementedDataCoordServer) UpdateSegmentStatistics(ctx context.Context, req *UpdateSegmentStatisticsRequest) (*commonpb.Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateSegmentStatistics not implemented")
+
+func (m *GetCloneSegmentsStateResponse) Reset()         { *m = GetCloneSegmentsStateResponse{} }
+func (m *GetCloneSegmentsStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCloneSegmentsStateResponse) ProtoMessage()    {}
+func (*GetCloneSegmentsStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{89}
 }
-func (*UnimplementedDataCoordServer) UpdateChannelCheckpoint(ctx context.Context, req *UpdateChannelCheckpointRequest) (*commonpb.Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateChannelCheckpoint not implemented")
+
+func (m *GetCloneSegmentsStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCloneSegmentsStateResponse.Unmarshal(m, b)
 }
-func (*UnimplementedDataCoordServer) SaveImportSegment(ctx context.Context, req *SaveImportSegmentRequest) (*commonpb.Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SaveImportSegment not implemented")
+func (m *GetCloneSegmentsStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCloneSegmentsStateResponse.Marshal(b, m, deterministic)
 }
-func (*UnimplementedDataCoordServer) UnsetIsImportingState(ctx context.Context, req *UnsetIsImportingStateRequest) (*commonpb.Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnsetIsImportingState not implemented")
+func (m *GetCloneSegmentsStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCloneSegmentsStateResponse.Merge(m, src)
 }
-func (*UnimplementedDataCoordServer) MarkSegmentsDropped(ctx context.Context, req *MarkSegmentsDroppedRequest) (*commonpb.Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MarkSegmentsDropped not implemented")
+func (m *GetCloneSegmentsStateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCloneSegmentsStateResponse.Size(m)
 }
-func (*UnimplementedDataCoordServer) BroadcastAlteredCollection(ctx context.Context, req *AlterCollectionRequest) (*commonpb.Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BroadcastAlteredCollection not implemented")
+func (m *GetCloneSegmentsStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCloneSegmentsStateResponse.DiscardUnknown(m)
 }
-func (*UnimplementedDataCoordServer) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckHealth not implemented")
+
+var xxx_messageInfo_GetCloneSegmentsStateResponse proto.InternalMessageInfo
+
+func (m *GetCloneSegmentsStateResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
 }
-func (*UnimplementedDataCoordServer) CreateIndex(ctx context.Context, req *indexpb.CreateIndexRequest) (*commonpb.Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateIndex not implemented")
+
+func (m *GetCloneSegmentsStateResponse) GetState() CloneState {
+	if m != nil {
+		return m.State
+	}
+	return CloneState_CloneNone
 }
-func (*UnimplementedDataCoordServer) GetIndexState(ctx context.Context, req *indexpb.GetIndexStateRequest) (*indexpb.GetIndexStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetIndexState not implemented")
+
+func (m *GetCloneSegmentsStateResponse) GetTotalSegments() int64 {
+	if m != nil {
+		return m.TotalSegments
+	}
+	return 0
 }
-func (*UnimplementedDataCoordServer) GetSegmentIndexState(ctx context.Context, req *indexpb.GetSegmentIndexStateRequest) (*indexpb.GetSegmentIndexStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentIndexState not implemented")
+
+func (m *GetCloneSegmentsStateResponse) GetClonedSegments() int64 {
+	if m != nil {
+		return m.ClonedSegments
+	}
+	return 0
 }
-func (*UnimplementedDataCoordServer) GetIndexInfos(ctx context.Context, req *indexpb.GetIndexInfoRequest) (*indexpb.GetIndexInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetIndexInfos not implemented")
+
+func (m *GetCloneSegmentsStateResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
-func (*UnimplementedDataCoordServer) DropIndex(ctx context.Context, req *indexpb.DropIndexRequest) (*commonpb.Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DropIndex not implemented")
+
+// MaintenancePolicy controls when datacoord triggers compaction and gc automatically.
+type MaintenancePolicy struct {
+	// 0 means the global policy, which applies to all the collections
+	CollectionID     int64 `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	CompactionPaused bool  `protobuf:"varint,2,opt,name=compaction_paused,json=compactionPaused,proto3" json:"compaction_paused,omitempty"`
+	GcPaused         bool  `protobuf:"varint,3,opt,name=gc_paused,json=gcPaused,proto3" json:"gc_paused,omitempty"`
+	// windows in datacoord local time when compaction/gc is allowed, always allowed if empty.
+	// windows are separated by ";", each is "[weekdays ]HH:MM-HH:MM", like "Mon-Fri 22:00-06:00;Sat-Sun 00:00-24:00"
+	CompactionSchedule string `protobuf:"bytes,4,opt,name=compaction_schedule,json=compactionSchedule,proto3" json:"compaction_schedule,omitempty"`
+	GcSchedule         string `protobuf:"bytes,5,opt,name=gc_schedule,json=gcSchedule,proto3" json:"gc_schedule,omitempty"`
+	// unix time in milliseconds the gc pausing expires, 0 if paused until resumed
+	GcResumeTime         int64    `protobuf:"varint,6,opt,name=gc_resume_time,json=gcResumeTime,proto3" json:"gc_resume_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (*UnimplementedDataCoordServer) DescribeIndex(ctx context.Context, req *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DescribeIndex not implemented")
+
+func (m *MaintenancePolicy) Reset()         { *m = MaintenancePolicy{} }
+func (m *MaintenancePolicy) String() string { return proto.CompactTextString(m) }
+func (*MaintenancePolicy) ProtoMessage()    {}
+func (*MaintenancePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{90}
 }
-func (*UnimplementedDataCoordServer) GetIndexStatistics(ctx context.Context, req *indexpb.GetIndexStatisticsRequest) (*indexpb.GetIndexStatisticsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetIndexStatistics not implemented")
+
+func (m *MaintenancePolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MaintenancePolicy.Unmarshal(m, b)
 }
-func (*UnimplementedDataCoordServer) GetIndexBuildProgress(ctx context.Context, req *indexpb.GetIndexBuildProgressRequest) (*indexpb.GetIndexBuildProgressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetIndexBuildProgress not implemented")
+func (m *MaintenancePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MaintenancePolicy.Marshal(b, m, deterministic)
 }
-func (*UnimplementedDataCoordServer) GcConfirm(ctx context.Context, req *GcConfirmRequest) (*GcConfirmResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GcConfirm not implemented")
+func (m *MaintenancePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenancePolicy.Merge(m, src)
 }
-func (*UnimplementedDataCoordServer) ReportDataNodeTtMsgs(ctx context.Context, req *ReportDataNodeTtMsgsRequest) (*commonpb.Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReportDataNodeTtMsgs not implemented")
+func (m *MaintenancePolicy) XXX_Size() int {
+	return xxx_messageInfo_MaintenancePolicy.Size(m)
+}
+func (m *MaintenancePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenancePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenancePolicy proto.InternalMessageInfo
+
+func (m *MaintenancePolicy) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *MaintenancePolicy) GetCompactionPaused() bool {
+	if m != nil {
+		return m.CompactionPaused
+	}
+	return false
+}
+
+func (m *MaintenancePolicy) GetGcPaused() bool {
+	if m != nil {
+		return m.GcPaused
+	}
+	return false
+}
+
+func (m *MaintenancePolicy) GetCompactionSchedule() string {
+	if m != nil {
+		return m.CompactionSchedule
+	}
+	return ""
+}
+
+func (m *MaintenancePolicy) GetGcSchedule() string {
+	if m != nil {
+		return m.GcSchedule
+	}
+	return ""
+}
+
+func (m *MaintenancePolicy) GetGcResumeTime() int64 {
+	if m != nil {
+		return m.GcResumeTime
+	}
+	return 0
+}
+
+type SetMaintenancePolicyRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// the policy is removed if nothing is paused or scheduled
+	Policy               *MaintenancePolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *SetMaintenancePolicyRequest) Reset()         { *m = SetMaintenancePolicyRequest{} }
+func (m *SetMaintenancePolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*SetMaintenancePolicyRequest) ProtoMessage()    {}
+func (*SetMaintenancePolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{91}
+}
+
+func (m *SetMaintenancePolicyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetMaintenancePolicyRequest.Unmarshal(m, b)
+}
+func (m *SetMaintenancePolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetMaintenancePolicyRequest.Marshal(b, m, deterministic)
+}
+func (m *SetMaintenancePolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetMaintenancePolicyRequest.Merge(m, src)
+}
+func (m *SetMaintenancePolicyRequest) XXX_Size() int {
+	return xxx_messageInfo_SetMaintenancePolicyRequest.Size(m)
+}
+func (m *SetMaintenancePolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetMaintenancePolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetMaintenancePolicyRequest proto.InternalMessageInfo
+
+func (m *SetMaintenancePolicyRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *SetMaintenancePolicyRequest) GetPolicy() *MaintenancePolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return nil
+}
+
+type ListMaintenancePoliciesRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListMaintenancePoliciesRequest) Reset()         { *m = ListMaintenancePoliciesRequest{} }
+func (m *ListMaintenancePoliciesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListMaintenancePoliciesRequest) ProtoMessage()    {}
+func (*ListMaintenancePoliciesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{92}
+}
+
+func (m *ListMaintenancePoliciesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListMaintenancePoliciesRequest.Unmarshal(m, b)
+}
+func (m *ListMaintenancePoliciesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListMaintenancePoliciesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListMaintenancePoliciesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMaintenancePoliciesRequest.Merge(m, src)
+}
+func (m *ListMaintenancePoliciesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListMaintenancePoliciesRequest.Size(m)
+}
+func (m *ListMaintenancePoliciesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMaintenancePoliciesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListMaintenancePoliciesRequest proto.InternalMessageInfo
+
+func (m *ListMaintenancePoliciesRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type ListMaintenancePoliciesResponse struct {
+	Status               *commonpb.Status     `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Policies             []*MaintenancePolicy `protobuf:"bytes,2,rep,name=policies,proto3" json:"policies,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ListMaintenancePoliciesResponse) Reset()         { *m = ListMaintenancePoliciesResponse{} }
+func (m *ListMaintenancePoliciesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListMaintenancePoliciesResponse) ProtoMessage()    {}
+func (*ListMaintenancePoliciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{93}
+}
+
+func (m *ListMaintenancePoliciesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListMaintenancePoliciesResponse.Unmarshal(m, b)
+}
+func (m *ListMaintenancePoliciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListMaintenancePoliciesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListMaintenancePoliciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMaintenancePoliciesResponse.Merge(m, src)
+}
+func (m *ListMaintenancePoliciesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListMaintenancePoliciesResponse.Size(m)
+}
+func (m *ListMaintenancePoliciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMaintenancePoliciesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListMaintenancePoliciesResponse proto.InternalMessageInfo
+
+func (m *ListMaintenancePoliciesResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListMaintenancePoliciesResponse) GetPolicies() []*MaintenancePolicy {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
+}
+
+type CordonDataNodeRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	NodeID               int64             `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CordonDataNodeRequest) Reset()         { *m = CordonDataNodeRequest{} }
+func (m *CordonDataNodeRequest) String() string { return proto.CompactTextString(m) }
+func (*CordonDataNodeRequest) ProtoMessage()    {}
+func (*CordonDataNodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{94}
+}
+
+func (m *CordonDataNodeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CordonDataNodeRequest.Unmarshal(m, b)
+}
+func (m *CordonDataNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CordonDataNodeRequest.Marshal(b, m, deterministic)
+}
+func (m *CordonDataNodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CordonDataNodeRequest.Merge(m, src)
+}
+func (m *CordonDataNodeRequest) XXX_Size() int {
+	return xxx_messageInfo_CordonDataNodeRequest.Size(m)
+}
+func (m *CordonDataNodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CordonDataNodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CordonDataNodeRequest proto.InternalMessageInfo
+
+func (m *CordonDataNodeRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CordonDataNodeRequest) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+// DrainDataNodeRequest cordons the datanode and migrates its channels to other datanodes in background,
+// the progress is queried by GetDataNodeDrainState.
+type DrainDataNodeRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	NodeID               int64             `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DrainDataNodeRequest) Reset()         { *m = DrainDataNodeRequest{} }
+func (m *DrainDataNodeRequest) String() string { return proto.CompactTextString(m) }
+func (*DrainDataNodeRequest) ProtoMessage()    {}
+func (*DrainDataNodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{95}
+}
+
+func (m *DrainDataNodeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DrainDataNodeRequest.Unmarshal(m, b)
+}
+func (m *DrainDataNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DrainDataNodeRequest.Marshal(b, m, deterministic)
+}
+func (m *DrainDataNodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrainDataNodeRequest.Merge(m, src)
+}
+func (m *DrainDataNodeRequest) XXX_Size() int {
+	return xxx_messageInfo_DrainDataNodeRequest.Size(m)
+}
+func (m *DrainDataNodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrainDataNodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DrainDataNodeRequest proto.InternalMessageInfo
+
+func (m *DrainDataNodeRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DrainDataNodeRequest) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+// UncordonDataNodeRequest makes the datanode schedulable again and stops draining it.
+type UncordonDataNodeRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	NodeID               int64             `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *UncordonDataNodeRequest) Reset()         { *m = UncordonDataNodeRequest{} }
+func (m *UncordonDataNodeRequest) String() string { return proto.CompactTextString(m) }
+func (*UncordonDataNodeRequest) ProtoMessage()    {}
+func (*UncordonDataNodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{96}
+}
+
+func (m *UncordonDataNodeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UncordonDataNodeRequest.Unmarshal(m, b)
+}
+func (m *UncordonDataNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UncordonDataNodeRequest.Marshal(b, m, deterministic)
+}
+func (m *UncordonDataNodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UncordonDataNodeRequest.Merge(m, src)
+}
+func (m *UncordonDataNodeRequest) XXX_Size() int {
+	return xxx_messageInfo_UncordonDataNodeRequest.Size(m)
+}
+func (m *UncordonDataNodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UncordonDataNodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UncordonDataNodeRequest proto.InternalMessageInfo
+
+func (m *UncordonDataNodeRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *UncordonDataNodeRequest) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+type GetDataNodeDrainStateRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	NodeID               int64             `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetDataNodeDrainStateRequest) Reset()         { *m = GetDataNodeDrainStateRequest{} }
+func (m *GetDataNodeDrainStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDataNodeDrainStateRequest) ProtoMessage()    {}
+func (*GetDataNodeDrainStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{97}
+}
+
+func (m *GetDataNodeDrainStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDataNodeDrainStateRequest.Unmarshal(m, b)
+}
+func (m *GetDataNodeDrainStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDataNodeDrainStateRequest.Marshal(b, m, deterministic)
+}
+func (m *GetDataNodeDrainStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDataNodeDrainStateRequest.Merge(m, src)
+}
+func (m *GetDataNodeDrainStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDataNodeDrainStateRequest.Size(m)
+}
+func (m *GetDataNodeDrainStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDataNodeDrainStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDataNodeDrainStateRequest proto.InternalMessageInfo
+
+func (m *GetDataNodeDrainStateRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetDataNodeDrainStateRequest) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+type GetDataNodeDrainStateResponse struct {
+	Status   *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	State    DrainState       `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.data.DrainState" json:"state,omitempty"`
+	Cordoned bool             `protobuf:"varint,3,opt,name=cordoned,proto3" json:"cordoned,omitempty"`
+	// the number of channels still watched by the datanode
+	RemainingChannels    int64    `protobuf:"varint,4,opt,name=remaining_channels,json=remainingChannels,proto3" json:"remaining_channels,omitempty"`
+	Reason               string   `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDataNodeDrainStateResponse) Reset()         { *m = GetDataNodeDrainStateResponse{} }
+func (m *GetDataNodeDrainStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDataNodeDrainStateResponse) ProtoMessage()    {}
+func (*GetDataNodeDrainStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{98}
+}
+
+func (m *GetDataNodeDrainStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDataNodeDrainStateResponse.Unmarshal(m, b)
+}
+func (m *GetDataNodeDrainStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDataNodeDrainStateResponse.Marshal(b, m, deterministic)
+}
+func (m *GetDataNodeDrainStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDataNodeDrainStateResponse.Merge(m, src)
+}
+func (m *GetDataNodeDrainStateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDataNodeDrainStateResponse.Size(m)
+}
+func (m *GetDataNodeDrainStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDataNodeDrainStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDataNodeDrainStateResponse proto.InternalMessageInfo
+
+func (m *GetDataNodeDrainStateResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetDataNodeDrainStateResponse) GetState() DrainState {
+	if m != nil {
+		return m.State
+	}
+	return DrainState_DrainNone
+}
+
+func (m *GetDataNodeDrainStateResponse) GetCordoned() bool {
+	if m != nil {
+		return m.Cordoned
+	}
+	return false
+}
+
+func (m *GetDataNodeDrainStateResponse) GetRemainingChannels() int64 {
+	if m != nil {
+		return m.RemainingChannels
+	}
+	return 0
+}
+
+func (m *GetDataNodeDrainStateResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// EncryptionKeyInfo records the current key version of a collection which requires encrypted storage.
+type EncryptionKeyInfo struct {
+	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	KeyVersion           int64    `protobuf:"varint,2,opt,name=key_version,json=keyVersion,proto3" json:"key_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EncryptionKeyInfo) Reset()         { *m = EncryptionKeyInfo{} }
+func (m *EncryptionKeyInfo) String() string { return proto.CompactTextString(m) }
+func (*EncryptionKeyInfo) ProtoMessage()    {}
+func (*EncryptionKeyInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{99}
+}
+
+func (m *EncryptionKeyInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EncryptionKeyInfo.Unmarshal(m, b)
+}
+func (m *EncryptionKeyInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EncryptionKeyInfo.Marshal(b, m, deterministic)
+}
+func (m *EncryptionKeyInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncryptionKeyInfo.Merge(m, src)
+}
+func (m *EncryptionKeyInfo) XXX_Size() int {
+	return xxx_messageInfo_EncryptionKeyInfo.Size(m)
+}
+func (m *EncryptionKeyInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncryptionKeyInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EncryptionKeyInfo proto.InternalMessageInfo
+
+func (m *EncryptionKeyInfo) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *EncryptionKeyInfo) GetKeyVersion() int64 {
+	if m != nil {
+		return m.KeyVersion
+	}
+	return 0
+}
+
+type RotateEncryptionKeyRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *RotateEncryptionKeyRequest) Reset()         { *m = RotateEncryptionKeyRequest{} }
+func (m *RotateEncryptionKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*RotateEncryptionKeyRequest) ProtoMessage()    {}
+func (*RotateEncryptionKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{100}
+}
+
+func (m *RotateEncryptionKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RotateEncryptionKeyRequest.Unmarshal(m, b)
+}
+func (m *RotateEncryptionKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RotateEncryptionKeyRequest.Marshal(b, m, deterministic)
+}
+func (m *RotateEncryptionKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateEncryptionKeyRequest.Merge(m, src)
+}
+func (m *RotateEncryptionKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_RotateEncryptionKeyRequest.Size(m)
+}
+func (m *RotateEncryptionKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateEncryptionKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotateEncryptionKeyRequest proto.InternalMessageInfo
+
+func (m *RotateEncryptionKeyRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *RotateEncryptionKeyRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+type RotateEncryptionKeyResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// the new key version, segments are re-encrypted with it lazily by compaction
+	KeyVersion           int64    `protobuf:"varint,2,opt,name=key_version,json=keyVersion,proto3" json:"key_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RotateEncryptionKeyResponse) Reset()         { *m = RotateEncryptionKeyResponse{} }
+func (m *RotateEncryptionKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*RotateEncryptionKeyResponse) ProtoMessage()    {}
+func (*RotateEncryptionKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{101}
+}
+
+func (m *RotateEncryptionKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RotateEncryptionKeyResponse.Unmarshal(m, b)
+}
+func (m *RotateEncryptionKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RotateEncryptionKeyResponse.Marshal(b, m, deterministic)
+}
+func (m *RotateEncryptionKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateEncryptionKeyResponse.Merge(m, src)
+}
+func (m *RotateEncryptionKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_RotateEncryptionKeyResponse.Size(m)
+}
+func (m *RotateEncryptionKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateEncryptionKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotateEncryptionKeyResponse proto.InternalMessageInfo
+
+func (m *RotateEncryptionKeyResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *RotateEncryptionKeyResponse) GetKeyVersion() int64 {
+	if m != nil {
+		return m.KeyVersion
+	}
+	return 0
+}
+
+type GetEncryptionStatusRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetEncryptionStatusRequest) Reset()         { *m = GetEncryptionStatusRequest{} }
+func (m *GetEncryptionStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*GetEncryptionStatusRequest) ProtoMessage()    {}
+func (*GetEncryptionStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{102}
+}
+
+func (m *GetEncryptionStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetEncryptionStatusRequest.Unmarshal(m, b)
+}
+func (m *GetEncryptionStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetEncryptionStatusRequest.Marshal(b, m, deterministic)
+}
+func (m *GetEncryptionStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEncryptionStatusRequest.Merge(m, src)
+}
+func (m *GetEncryptionStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_GetEncryptionStatusRequest.Size(m)
+}
+func (m *GetEncryptionStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEncryptionStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEncryptionStatusRequest proto.InternalMessageInfo
+
+func (m *GetEncryptionStatusRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetEncryptionStatusRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+type GetEncryptionStatusResponse struct {
+	Status           *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Enabled          bool             `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	KeyVersion       int64            `protobuf:"varint,3,opt,name=key_version,json=keyVersion,proto3" json:"key_version,omitempty"`
+	TotalRows        int64            `protobuf:"varint,4,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	RowsOnCurrentKey int64            `protobuf:"varint,5,opt,name=rows_on_current_key,json=rowsOnCurrentKey,proto3" json:"rows_on_current_key,omitempty"`
+	// percentage of the rows encrypted with the current key
+	Progress             float64  `protobuf:"fixed64,6,opt,name=progress,proto3" json:"progress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetEncryptionStatusResponse) Reset()         { *m = GetEncryptionStatusResponse{} }
+func (m *GetEncryptionStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*GetEncryptionStatusResponse) ProtoMessage()    {}
+func (*GetEncryptionStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{103}
+}
+
+func (m *GetEncryptionStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetEncryptionStatusResponse.Unmarshal(m, b)
+}
+func (m *GetEncryptionStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetEncryptionStatusResponse.Marshal(b, m, deterministic)
+}
+func (m *GetEncryptionStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEncryptionStatusResponse.Merge(m, src)
+}
+func (m *GetEncryptionStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_GetEncryptionStatusResponse.Size(m)
+}
+func (m *GetEncryptionStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEncryptionStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEncryptionStatusResponse proto.InternalMessageInfo
+
+func (m *GetEncryptionStatusResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetEncryptionStatusResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *GetEncryptionStatusResponse) GetKeyVersion() int64 {
+	if m != nil {
+		return m.KeyVersion
+	}
+	return 0
+}
+
+func (m *GetEncryptionStatusResponse) GetTotalRows() int64 {
+	if m != nil {
+		return m.TotalRows
+	}
+	return 0
+}
+
+func (m *GetEncryptionStatusResponse) GetRowsOnCurrentKey() int64 {
+	if m != nil {
+		return m.RowsOnCurrentKey
+	}
+	return 0
+}
+
+func (m *GetEncryptionStatusResponse) GetProgress() float64 {
+	if m != nil {
+		return m.Progress
+	}
+	return 0
+}
+
+type ChannelWatchStateInfo struct {
+	ChannelName  string `protobuf:"bytes,1,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
+	CollectionID int64  `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// the DataNode the channel is assigned to, 0 if the channel is waiting for an available DataNode
+	NodeID int64             `protobuf:"varint,3,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	State  ChannelWatchState `protobuf:"varint,4,opt,name=state,proto3,enum=milvus.proto.data.ChannelWatchState" json:"state,omitempty"`
+	// the position the DataNode starts to consume the channel from
+	StartPosition *msgpb.MsgPosition `protobuf:"bytes,5,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
+	// unix timestamp in seconds when the channel was assigned to the DataNode last time
+	WatchTs              int64    `protobuf:"varint,6,opt,name=watch_ts,json=watchTs,proto3" json:"watch_ts,omitempty"`
+	Progress             int32    `protobuf:"varint,7,opt,name=progress,proto3" json:"progress,omitempty"`
+	Policy               string   `protobuf:"bytes,8,opt,name=policy,proto3" json:"policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelWatchStateInfo) Reset()         { *m = ChannelWatchStateInfo{} }
+func (m *ChannelWatchStateInfo) String() string { return proto.CompactTextString(m) }
+func (*ChannelWatchStateInfo) ProtoMessage()    {}
+func (*ChannelWatchStateInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{104}
+}
+
+func (m *ChannelWatchStateInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelWatchStateInfo.Unmarshal(m, b)
+}
+func (m *ChannelWatchStateInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelWatchStateInfo.Marshal(b, m, deterministic)
+}
+func (m *ChannelWatchStateInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelWatchStateInfo.Merge(m, src)
+}
+func (m *ChannelWatchStateInfo) XXX_Size() int {
+	return xxx_messageInfo_ChannelWatchStateInfo.Size(m)
+}
+func (m *ChannelWatchStateInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelWatchStateInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelWatchStateInfo proto.InternalMessageInfo
+
+func (m *ChannelWatchStateInfo) GetChannelName() string {
+	if m != nil {
+		return m.ChannelName
+	}
+	return ""
+}
+
+func (m *ChannelWatchStateInfo) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ChannelWatchStateInfo) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *ChannelWatchStateInfo) GetState() ChannelWatchState {
+	if m != nil {
+		return m.State
+	}
+	return ChannelWatchState_Uncomplete
+}
+
+func (m *ChannelWatchStateInfo) GetStartPosition() *msgpb.MsgPosition {
+	if m != nil {
+		return m.StartPosition
+	}
+	return nil
+}
+
+func (m *ChannelWatchStateInfo) GetWatchTs() int64 {
+	if m != nil {
+		return m.WatchTs
+	}
+	return 0
+}
+
+func (m *ChannelWatchStateInfo) GetProgress() int32 {
+	if m != nil {
+		return m.Progress
+	}
+	return 0
+}
+
+func (m *ChannelWatchStateInfo) GetPolicy() string {
+	if m != nil {
+		return m.Policy
+	}
+	return ""
+}
+
+type GetChannelWatchStatesRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// 0 means all the collections
+	CollectionID         int64    `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetChannelWatchStatesRequest) Reset()         { *m = GetChannelWatchStatesRequest{} }
+func (m *GetChannelWatchStatesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetChannelWatchStatesRequest) ProtoMessage()    {}
+func (*GetChannelWatchStatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{105}
+}
+
+func (m *GetChannelWatchStatesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetChannelWatchStatesRequest.Unmarshal(m, b)
+}
+func (m *GetChannelWatchStatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetChannelWatchStatesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetChannelWatchStatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetChannelWatchStatesRequest.Merge(m, src)
+}
+func (m *GetChannelWatchStatesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetChannelWatchStatesRequest.Size(m)
+}
+func (m *GetChannelWatchStatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetChannelWatchStatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetChannelWatchStatesRequest proto.InternalMessageInfo
+
+func (m *GetChannelWatchStatesRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetChannelWatchStatesRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+type GetChannelWatchStatesResponse struct {
+	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	States               []*ChannelWatchStateInfo `protobuf:"bytes,2,rep,name=states,proto3" json:"states,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *GetChannelWatchStatesResponse) Reset()         { *m = GetChannelWatchStatesResponse{} }
+func (m *GetChannelWatchStatesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetChannelWatchStatesResponse) ProtoMessage()    {}
+func (*GetChannelWatchStatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{106}
+}
+
+func (m *GetChannelWatchStatesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetChannelWatchStatesResponse.Unmarshal(m, b)
+}
+func (m *GetChannelWatchStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetChannelWatchStatesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetChannelWatchStatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetChannelWatchStatesResponse.Merge(m, src)
+}
+func (m *GetChannelWatchStatesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetChannelWatchStatesResponse.Size(m)
+}
+func (m *GetChannelWatchStatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetChannelWatchStatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetChannelWatchStatesResponse proto.InternalMessageInfo
+
+func (m *GetChannelWatchStatesResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetChannelWatchStatesResponse) GetStates() []*ChannelWatchStateInfo {
+	if m != nil {
+		return m.States
+	}
+	return nil
+}
+
+type ManualCompactionWithModeRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Mode                 CompactionMode    `protobuf:"varint,3,opt,name=mode,proto3,enum=milvus.proto.data.CompactionMode" json:"mode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ManualCompactionWithModeRequest) Reset()         { *m = ManualCompactionWithModeRequest{} }
+func (m *ManualCompactionWithModeRequest) String() string { return proto.CompactTextString(m) }
+func (*ManualCompactionWithModeRequest) ProtoMessage()    {}
+func (*ManualCompactionWithModeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{107}
+}
+
+func (m *ManualCompactionWithModeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ManualCompactionWithModeRequest.Unmarshal(m, b)
+}
+func (m *ManualCompactionWithModeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ManualCompactionWithModeRequest.Marshal(b, m, deterministic)
+}
+func (m *ManualCompactionWithModeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManualCompactionWithModeRequest.Merge(m, src)
+}
+func (m *ManualCompactionWithModeRequest) XXX_Size() int {
+	return xxx_messageInfo_ManualCompactionWithModeRequest.Size(m)
+}
+func (m *ManualCompactionWithModeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManualCompactionWithModeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManualCompactionWithModeRequest proto.InternalMessageInfo
+
+func (m *ManualCompactionWithModeRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ManualCompactionWithModeRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ManualCompactionWithModeRequest) GetMode() CompactionMode {
+	if m != nil {
+		return m.Mode
+	}
+	return CompactionMode_FullCompaction
+}
+
+type ManualCompactionWithModeResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// the id to poll the compaction state by GetCompactionState and GetCompactionStateWithPlans
+	CompactionID         int64    `protobuf:"varint,2,opt,name=compactionID,proto3" json:"compactionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ManualCompactionWithModeResponse) Reset()         { *m = ManualCompactionWithModeResponse{} }
+func (m *ManualCompactionWithModeResponse) String() string { return proto.CompactTextString(m) }
+func (*ManualCompactionWithModeResponse) ProtoMessage()    {}
+func (*ManualCompactionWithModeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{108}
+}
+
+func (m *ManualCompactionWithModeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ManualCompactionWithModeResponse.Unmarshal(m, b)
+}
+func (m *ManualCompactionWithModeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ManualCompactionWithModeResponse.Marshal(b, m, deterministic)
+}
+func (m *ManualCompactionWithModeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManualCompactionWithModeResponse.Merge(m, src)
+}
+func (m *ManualCompactionWithModeResponse) XXX_Size() int {
+	return xxx_messageInfo_ManualCompactionWithModeResponse.Size(m)
+}
+func (m *ManualCompactionWithModeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManualCompactionWithModeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManualCompactionWithModeResponse proto.InternalMessageInfo
+
+func (m *ManualCompactionWithModeResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ManualCompactionWithModeResponse) GetCompactionID() int64 {
+	if m != nil {
+		return m.CompactionID
+	}
+	return 0
+}
+
+type GetCompactionProgressRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CompactionID         int64             `protobuf:"varint,2,opt,name=compactionID,proto3" json:"compactionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetCompactionProgressRequest) Reset()         { *m = GetCompactionProgressRequest{} }
+func (m *GetCompactionProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCompactionProgressRequest) ProtoMessage()    {}
+func (*GetCompactionProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{109}
+}
+
+func (m *GetCompactionProgressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCompactionProgressRequest.Unmarshal(m, b)
+}
+func (m *GetCompactionProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCompactionProgressRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCompactionProgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCompactionProgressRequest.Merge(m, src)
+}
+func (m *GetCompactionProgressRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCompactionProgressRequest.Size(m)
+}
+func (m *GetCompactionProgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCompactionProgressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCompactionProgressRequest proto.InternalMessageInfo
+
+func (m *GetCompactionProgressRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetCompactionProgressRequest) GetCompactionID() int64 {
+	if m != nil {
+		return m.CompactionID
+	}
+	return 0
+}
+
+type GetCompactionProgressResponse struct {
+	Status          *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	State           commonpb.CompactionState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.CompactionState" json:"state,omitempty"`
+	TotalPlanNo     int64                    `protobuf:"varint,3,opt,name=totalPlanNo,proto3" json:"totalPlanNo,omitempty"`
+	ExecutingPlanNo int64                    `protobuf:"varint,4,opt,name=executingPlanNo,proto3" json:"executingPlanNo,omitempty"`
+	CompletedPlanNo int64                    `protobuf:"varint,5,opt,name=completedPlanNo,proto3" json:"completedPlanNo,omitempty"`
+	FailedPlanNo    int64                    `protobuf:"varint,6,opt,name=failedPlanNo,proto3" json:"failedPlanNo,omitempty"`
+	TimeoutPlanNo   int64                    `protobuf:"varint,7,opt,name=timeoutPlanNo,proto3" json:"timeoutPlanNo,omitempty"`
+	CancelledPlanNo int64                    `protobuf:"varint,8,opt,name=cancelledPlanNo,proto3" json:"cancelledPlanNo,omitempty"`
+	// the ratio of the finished plans, in [0, 1]
+	Progress             float64  `protobuf:"fixed64,9,opt,name=progress,proto3" json:"progress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCompactionProgressResponse) Reset()         { *m = GetCompactionProgressResponse{} }
+func (m *GetCompactionProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCompactionProgressResponse) ProtoMessage()    {}
+func (*GetCompactionProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{110}
+}
+
+func (m *GetCompactionProgressResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCompactionProgressResponse.Unmarshal(m, b)
+}
+func (m *GetCompactionProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCompactionProgressResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCompactionProgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCompactionProgressResponse.Merge(m, src)
+}
+func (m *GetCompactionProgressResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCompactionProgressResponse.Size(m)
+}
+func (m *GetCompactionProgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCompactionProgressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCompactionProgressResponse proto.InternalMessageInfo
+
+func (m *GetCompactionProgressResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetCompactionProgressResponse) GetState() commonpb.CompactionState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.CompactionState_UndefiedState
+}
+
+func (m *GetCompactionProgressResponse) GetTotalPlanNo() int64 {
+	if m != nil {
+		return m.TotalPlanNo
+	}
+	return 0
+}
+
+func (m *GetCompactionProgressResponse) GetExecutingPlanNo() int64 {
+	if m != nil {
+		return m.ExecutingPlanNo
+	}
+	return 0
+}
+
+func (m *GetCompactionProgressResponse) GetCompletedPlanNo() int64 {
+	if m != nil {
+		return m.CompletedPlanNo
+	}
+	return 0
+}
+
+func (m *GetCompactionProgressResponse) GetFailedPlanNo() int64 {
+	if m != nil {
+		return m.FailedPlanNo
+	}
+	return 0
+}
+
+func (m *GetCompactionProgressResponse) GetTimeoutPlanNo() int64 {
+	if m != nil {
+		return m.TimeoutPlanNo
+	}
+	return 0
+}
+
+func (m *GetCompactionProgressResponse) GetCancelledPlanNo() int64 {
+	if m != nil {
+		return m.CancelledPlanNo
+	}
+	return 0
+}
+
+func (m *GetCompactionProgressResponse) GetProgress() float64 {
+	if m != nil {
+		return m.Progress
+	}
+	return 0
+}
+
+type CancelCompactionRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CompactionID         int64             `protobuf:"varint,2,opt,name=compactionID,proto3" json:"compactionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CancelCompactionRequest) Reset()         { *m = CancelCompactionRequest{} }
+func (m *CancelCompactionRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelCompactionRequest) ProtoMessage()    {}
+func (*CancelCompactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{111}
+}
+
+func (m *CancelCompactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelCompactionRequest.Unmarshal(m, b)
+}
+func (m *CancelCompactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelCompactionRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelCompactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelCompactionRequest.Merge(m, src)
+}
+func (m *CancelCompactionRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelCompactionRequest.Size(m)
+}
+func (m *CancelCompactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelCompactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelCompactionRequest proto.InternalMessageInfo
+
+func (m *CancelCompactionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CancelCompactionRequest) GetCompactionID() int64 {
+	if m != nil {
+		return m.CompactionID
+	}
+	return 0
+}
+
+type CancelCompactionPlansRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	PlanIDs              []int64           `protobuf:"varint,2,rep,packed,name=planIDs,proto3" json:"planIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CancelCompactionPlansRequest) Reset()         { *m = CancelCompactionPlansRequest{} }
+func (m *CancelCompactionPlansRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelCompactionPlansRequest) ProtoMessage()    {}
+func (*CancelCompactionPlansRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{112}
+}
+
+func (m *CancelCompactionPlansRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelCompactionPlansRequest.Unmarshal(m, b)
+}
+func (m *CancelCompactionPlansRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelCompactionPlansRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelCompactionPlansRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelCompactionPlansRequest.Merge(m, src)
+}
+func (m *CancelCompactionPlansRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelCompactionPlansRequest.Size(m)
+}
+func (m *CancelCompactionPlansRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelCompactionPlansRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelCompactionPlansRequest proto.InternalMessageInfo
+
+func (m *CancelCompactionPlansRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CancelCompactionPlansRequest) GetPlanIDs() []int64 {
+	if m != nil {
+		return m.PlanIDs
+	}
+	return nil
+}
+
+type GcDryRunRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GcDryRunRequest) Reset()         { *m = GcDryRunRequest{} }
+func (m *GcDryRunRequest) String() string { return proto.CompactTextString(m) }
+func (*GcDryRunRequest) ProtoMessage()    {}
+func (*GcDryRunRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{113}
+}
+
+func (m *GcDryRunRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GcDryRunRequest.Unmarshal(m, b)
+}
+func (m *GcDryRunRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GcDryRunRequest.Marshal(b, m, deterministic)
+}
+func (m *GcDryRunRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GcDryRunRequest.Merge(m, src)
+}
+func (m *GcDryRunRequest) XXX_Size() int {
+	return xxx_messageInfo_GcDryRunRequest.Size(m)
+}
+func (m *GcDryRunRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GcDryRunRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GcDryRunRequest proto.InternalMessageInfo
+
+func (m *GcDryRunRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+// GcOrphanFile is a binlog file in object storage which is not referenced by the meta.
+type GcOrphanFile struct {
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Size                 int64    `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GcOrphanFile) Reset()         { *m = GcOrphanFile{} }
+func (m *GcOrphanFile) String() string { return proto.CompactTextString(m) }
+func (*GcOrphanFile) ProtoMessage()    {}
+func (*GcOrphanFile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{114}
+}
+
+func (m *GcOrphanFile) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GcOrphanFile.Unmarshal(m, b)
+}
+func (m *GcOrphanFile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GcOrphanFile.Marshal(b, m, deterministic)
+}
+func (m *GcOrphanFile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GcOrphanFile.Merge(m, src)
+}
+func (m *GcOrphanFile) XXX_Size() int {
+	return xxx_messageInfo_GcOrphanFile.Size(m)
+}
+func (m *GcOrphanFile) XXX_DiscardUnknown() {
+	xxx_messageInfo_GcOrphanFile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GcOrphanFile proto.InternalMessageInfo
+
+func (m *GcOrphanFile) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *GcOrphanFile) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+// GcDroppedSegment is a dropped segment whose files and meta are ready to be recycled.
+type GcDroppedSegment struct {
+	SegmentID            int64    `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	CollectionID         int64    `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID          int64    `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	Files                []string `protobuf:"bytes,4,rep,name=files,proto3" json:"files,omitempty"`
+	Size                 int64    `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GcDroppedSegment) Reset()         { *m = GcDroppedSegment{} }
+func (m *GcDroppedSegment) String() string { return proto.CompactTextString(m) }
+func (*GcDroppedSegment) ProtoMessage()    {}
+func (*GcDroppedSegment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{115}
+}
+
+func (m *GcDroppedSegment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GcDroppedSegment.Unmarshal(m, b)
+}
+func (m *GcDroppedSegment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GcDroppedSegment.Marshal(b, m, deterministic)
+}
+func (m *GcDroppedSegment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GcDroppedSegment.Merge(m, src)
+}
+func (m *GcDroppedSegment) XXX_Size() int {
+	return xxx_messageInfo_GcDroppedSegment.Size(m)
+}
+func (m *GcDroppedSegment) XXX_DiscardUnknown() {
+	xxx_messageInfo_GcDroppedSegment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GcDroppedSegment proto.InternalMessageInfo
+
+func (m *GcDroppedSegment) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *GcDroppedSegment) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *GcDroppedSegment) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *GcDroppedSegment) GetFiles() []string {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+func (m *GcDroppedSegment) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+type GcDryRunResponse struct {
+	Status          *commonpb.Status    `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	OrphanFiles     []*GcOrphanFile     `protobuf:"bytes,2,rep,name=orphan_files,json=orphanFiles,proto3" json:"orphan_files,omitempty"`
+	DroppedSegments []*GcDroppedSegment `protobuf:"bytes,3,rep,name=dropped_segments,json=droppedSegments,proto3" json:"dropped_segments,omitempty"`
+	// the total size of the orphan files and the files of the dropped segments
+	ReclaimableBytes     int64    `protobuf:"varint,4,opt,name=reclaimable_bytes,json=reclaimableBytes,proto3" json:"reclaimable_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GcDryRunResponse) Reset()         { *m = GcDryRunResponse{} }
+func (m *GcDryRunResponse) String() string { return proto.CompactTextString(m) }
+func (*GcDryRunResponse) ProtoMessage()    {}
+func (*GcDryRunResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{116}
+}
+
+func (m *GcDryRunResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GcDryRunResponse.Unmarshal(m, b)
+}
+func (m *GcDryRunResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GcDryRunResponse.Marshal(b, m, deterministic)
+}
+func (m *GcDryRunResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GcDryRunResponse.Merge(m, src)
+}
+func (m *GcDryRunResponse) XXX_Size() int {
+	return xxx_messageInfo_GcDryRunResponse.Size(m)
+}
+func (m *GcDryRunResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GcDryRunResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GcDryRunResponse proto.InternalMessageInfo
+
+func (m *GcDryRunResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GcDryRunResponse) GetOrphanFiles() []*GcOrphanFile {
+	if m != nil {
+		return m.OrphanFiles
+	}
+	return nil
+}
+
+func (m *GcDryRunResponse) GetDroppedSegments() []*GcDroppedSegment {
+	if m != nil {
+		return m.DroppedSegments
+	}
+	return nil
+}
+
+func (m *GcDryRunResponse) GetReclaimableBytes() int64 {
+	if m != nil {
+		return m.ReclaimableBytes
+	}
+	return 0
+}
+
+type PauseGCRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// the gc resumes automatically after the pause, 0 means pausing until ResumeGC is called
+	PauseSeconds         int64    `protobuf:"varint,2,opt,name=pause_seconds,json=pauseSeconds,proto3" json:"pause_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PauseGCRequest) Reset()         { *m = PauseGCRequest{} }
+func (m *PauseGCRequest) String() string { return proto.CompactTextString(m) }
+func (*PauseGCRequest) ProtoMessage()    {}
+func (*PauseGCRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{117}
+}
+
+func (m *PauseGCRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PauseGCRequest.Unmarshal(m, b)
+}
+func (m *PauseGCRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PauseGCRequest.Marshal(b, m, deterministic)
+}
+func (m *PauseGCRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseGCRequest.Merge(m, src)
+}
+func (m *PauseGCRequest) XXX_Size() int {
+	return xxx_messageInfo_PauseGCRequest.Size(m)
+}
+func (m *PauseGCRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseGCRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseGCRequest proto.InternalMessageInfo
+
+func (m *PauseGCRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *PauseGCRequest) GetPauseSeconds() int64 {
+	if m != nil {
+		return m.PauseSeconds
+	}
+	return 0
+}
+
+type ResumeGCRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ResumeGCRequest) Reset()         { *m = ResumeGCRequest{} }
+func (m *ResumeGCRequest) String() string { return proto.CompactTextString(m) }
+func (*ResumeGCRequest) ProtoMessage()    {}
+func (*ResumeGCRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{118}
+}
+
+func (m *ResumeGCRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResumeGCRequest.Unmarshal(m, b)
+}
+func (m *ResumeGCRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResumeGCRequest.Marshal(b, m, deterministic)
+}
+func (m *ResumeGCRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeGCRequest.Merge(m, src)
+}
+func (m *ResumeGCRequest) XXX_Size() int {
+	return xxx_messageInfo_ResumeGCRequest.Size(m)
+}
+func (m *ResumeGCRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeGCRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeGCRequest proto.InternalMessageInfo
+
+func (m *ResumeGCRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type GetGCStatusRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetGCStatusRequest) Reset()         { *m = GetGCStatusRequest{} }
+func (m *GetGCStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*GetGCStatusRequest) ProtoMessage()    {}
+func (*GetGCStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{119}
+}
+
+func (m *GetGCStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetGCStatusRequest.Unmarshal(m, b)
+}
+func (m *GetGCStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetGCStatusRequest.Marshal(b, m, deterministic)
+}
+func (m *GetGCStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGCStatusRequest.Merge(m, src)
+}
+func (m *GetGCStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_GetGCStatusRequest.Size(m)
+}
+func (m *GetGCStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGCStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetGCStatusRequest proto.InternalMessageInfo
+
+func (m *GetGCStatusRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+// GcRunStats is the statistics of a run of the garbage collector.
+type GcRunStats struct {
+	// unix time in milliseconds
+	StartTime        int64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime          int64 `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	RecycledSegments int64 `protobuf:"varint,3,opt,name=recycled_segments,json=recycledSegments,proto3" json:"recycled_segments,omitempty"`
+	RemovedFiles     int64 `protobuf:"varint,4,opt,name=removed_files,json=removedFiles,proto3" json:"removed_files,omitempty"`
+	FailedFiles      int64 `protobuf:"varint,5,opt,name=failed_files,json=failedFiles,proto3" json:"failed_files,omitempty"`
+	// whether the run is interrupted by pausing
+	Interrupted          bool     `protobuf:"varint,6,opt,name=interrupted,proto3" json:"interrupted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GcRunStats) Reset()         { *m = GcRunStats{} }
+func (m *GcRunStats) String() string { return proto.CompactTextString(m) }
+func (*GcRunStats) ProtoMessage()    {}
+func (*GcRunStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{120}
+}
+
+func (m *GcRunStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GcRunStats.Unmarshal(m, b)
+}
+func (m *GcRunStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GcRunStats.Marshal(b, m, deterministic)
+}
+func (m *GcRunStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GcRunStats.Merge(m, src)
+}
+func (m *GcRunStats) XXX_Size() int {
+	return xxx_messageInfo_GcRunStats.Size(m)
+}
+func (m *GcRunStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_GcRunStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GcRunStats proto.InternalMessageInfo
+
+func (m *GcRunStats) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *GcRunStats) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *GcRunStats) GetRecycledSegments() int64 {
+	if m != nil {
+		return m.RecycledSegments
+	}
+	return 0
+}
+
+func (m *GcRunStats) GetRemovedFiles() int64 {
+	if m != nil {
+		return m.RemovedFiles
+	}
+	return 0
+}
+
+func (m *GcRunStats) GetFailedFiles() int64 {
+	if m != nil {
+		return m.FailedFiles
+	}
+	return 0
+}
+
+func (m *GcRunStats) GetInterrupted() bool {
+	if m != nil {
+		return m.Interrupted
+	}
+	return false
+}
+
+type GetGCStatusResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// whether the gc is paused by the global maintenance policy, set by PauseGC or SetMaintenancePolicy
+	Paused bool `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	// unix time in milliseconds the gc resumes automatically, 0 if paused until resumed
+	ResumeTime int64   `protobuf:"varint,3,opt,name=resume_time,json=resumeTime,proto3" json:"resume_time,omitempty"`
+	Phase      GcPhase `protobuf:"varint,4,opt,name=phase,proto3,enum=milvus.proto.data.GcPhase" json:"phase,omitempty"`
+	// the number of files found to remove in the current phase but not removed yet
+	PendingFiles int64       `protobuf:"varint,5,opt,name=pending_files,json=pendingFiles,proto3" json:"pending_files,omitempty"`
+	CurrentRun   *GcRunStats `protobuf:"bytes,6,opt,name=current_run,json=currentRun,proto3" json:"current_run,omitempty"`
+	LastRun      *GcRunStats `protobuf:"bytes,7,opt,name=last_run,json=lastRun,proto3" json:"last_run,omitempty"`
+	// whether the gc is out of the schedule of the global maintenance policy
+	OutOfSchedule bool `protobuf:"varint,8,opt,name=out_of_schedule,json=outOfSchedule,proto3" json:"out_of_schedule,omitempty"`
+	// the collections whose gc is paused by their own maintenance policies
+	PausedCollectionIDs  []int64  `protobuf:"varint,9,rep,packed,name=paused_collectionIDs,json=pausedCollectionIDs,proto3" json:"paused_collectionIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetGCStatusResponse) Reset()         { *m = GetGCStatusResponse{} }
+func (m *GetGCStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*GetGCStatusResponse) ProtoMessage()    {}
+func (*GetGCStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{121}
+}
+
+func (m *GetGCStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetGCStatusResponse.Unmarshal(m, b)
+}
+func (m *GetGCStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetGCStatusResponse.Marshal(b, m, deterministic)
+}
+func (m *GetGCStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGCStatusResponse.Merge(m, src)
+}
+func (m *GetGCStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_GetGCStatusResponse.Size(m)
+}
+func (m *GetGCStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGCStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetGCStatusResponse proto.InternalMessageInfo
+
+func (m *GetGCStatusResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetGCStatusResponse) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *GetGCStatusResponse) GetResumeTime() int64 {
+	if m != nil {
+		return m.ResumeTime
+	}
+	return 0
+}
+
+func (m *GetGCStatusResponse) GetPhase() GcPhase {
+	if m != nil {
+		return m.Phase
+	}
+	return GcPhase_GcIdle
+}
+
+func (m *GetGCStatusResponse) GetPendingFiles() int64 {
+	if m != nil {
+		return m.PendingFiles
+	}
+	return 0
+}
+
+func (m *GetGCStatusResponse) GetCurrentRun() *GcRunStats {
+	if m != nil {
+		return m.CurrentRun
+	}
+	return nil
+}
+
+func (m *GetGCStatusResponse) GetLastRun() *GcRunStats {
+	if m != nil {
+		return m.LastRun
+	}
+	return nil
+}
+
+func (m *GetGCStatusResponse) GetOutOfSchedule() bool {
+	if m != nil {
+		return m.OutOfSchedule
+	}
+	return false
+}
+
+func (m *GetGCStatusResponse) GetPausedCollectionIDs() []int64 {
+	if m != nil {
+		return m.PausedCollectionIDs
+	}
+	return nil
+}
+
+type DropSegmentsByTimeRangeRequest struct {
+	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// the segments of all partitions are dropped if it's negative
+	PartitionID int64 `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	// the flushed segments whose insert timestamps are all within [start_ts, end_ts] are dropped
+	StartTs              uint64   `protobuf:"varint,4,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
+	EndTs                uint64   `protobuf:"varint,5,opt,name=end_ts,json=endTs,proto3" json:"end_ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DropSegmentsByTimeRangeRequest) Reset()         { *m = DropSegmentsByTimeRangeRequest{} }
+func (m *DropSegmentsByTimeRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*DropSegmentsByTimeRangeRequest) ProtoMessage()    {}
+func (*DropSegmentsByTimeRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{122}
+}
+
+func (m *DropSegmentsByTimeRangeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropSegmentsByTimeRangeRequest.Unmarshal(m, b)
+}
+func (m *DropSegmentsByTimeRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropSegmentsByTimeRangeRequest.Marshal(b, m, deterministic)
+}
+func (m *DropSegmentsByTimeRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropSegmentsByTimeRangeRequest.Merge(m, src)
+}
+func (m *DropSegmentsByTimeRangeRequest) XXX_Size() int {
+	return xxx_messageInfo_DropSegmentsByTimeRangeRequest.Size(m)
+}
+func (m *DropSegmentsByTimeRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropSegmentsByTimeRangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DropSegmentsByTimeRangeRequest proto.InternalMessageInfo
+
+func (m *DropSegmentsByTimeRangeRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DropSegmentsByTimeRangeRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *DropSegmentsByTimeRangeRequest) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *DropSegmentsByTimeRangeRequest) GetStartTs() uint64 {
+	if m != nil {
+		return m.StartTs
+	}
+	return 0
+}
+
+func (m *DropSegmentsByTimeRangeRequest) GetEndTs() uint64 {
+	if m != nil {
+		return m.EndTs
+	}
+	return 0
+}
+
+type DropSegmentsByTimeRangeResponse struct {
+	Status     *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	SegmentIDs []int64          `protobuf:"varint,2,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	// the total number of rows of the dropped segments
+	NumRows              int64    `protobuf:"varint,3,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DropSegmentsByTimeRangeResponse) Reset()         { *m = DropSegmentsByTimeRangeResponse{} }
+func (m *DropSegmentsByTimeRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*DropSegmentsByTimeRangeResponse) ProtoMessage()    {}
+func (*DropSegmentsByTimeRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{123}
+}
+
+func (m *DropSegmentsByTimeRangeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropSegmentsByTimeRangeResponse.Unmarshal(m, b)
+}
+func (m *DropSegmentsByTimeRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropSegmentsByTimeRangeResponse.Marshal(b, m, deterministic)
+}
+func (m *DropSegmentsByTimeRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropSegmentsByTimeRangeResponse.Merge(m, src)
+}
+func (m *DropSegmentsByTimeRangeResponse) XXX_Size() int {
+	return xxx_messageInfo_DropSegmentsByTimeRangeResponse.Size(m)
+}
+func (m *DropSegmentsByTimeRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropSegmentsByTimeRangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DropSegmentsByTimeRangeResponse proto.InternalMessageInfo
+
+func (m *DropSegmentsByTimeRangeResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *DropSegmentsByTimeRangeResponse) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+func (m *DropSegmentsByTimeRangeResponse) GetNumRows() int64 {
+	if m != nil {
+		return m.NumRows
+	}
+	return 0
+}
+
+type GetTopologySnapshotRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetTopologySnapshotRequest) Reset()         { *m = GetTopologySnapshotRequest{} }
+func (m *GetTopologySnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTopologySnapshotRequest) ProtoMessage()    {}
+func (*GetTopologySnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{124}
+}
+
+func (m *GetTopologySnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTopologySnapshotRequest.Unmarshal(m, b)
+}
+func (m *GetTopologySnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTopologySnapshotRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTopologySnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTopologySnapshotRequest.Merge(m, src)
+}
+func (m *GetTopologySnapshotRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTopologySnapshotRequest.Size(m)
+}
+func (m *GetTopologySnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTopologySnapshotRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTopologySnapshotRequest proto.InternalMessageInfo
+
+func (m *GetTopologySnapshotRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type GetTopologySnapshotResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// the JSON serialized snapshot of the DataNodes, their channels and the buffer channels
+	Snapshot             string   `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTopologySnapshotResponse) Reset()         { *m = GetTopologySnapshotResponse{} }
+func (m *GetTopologySnapshotResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTopologySnapshotResponse) ProtoMessage()    {}
+func (*GetTopologySnapshotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{125}
+}
+
+func (m *GetTopologySnapshotResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTopologySnapshotResponse.Unmarshal(m, b)
+}
+func (m *GetTopologySnapshotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTopologySnapshotResponse.Marshal(b, m, deterministic)
+}
+func (m *GetTopologySnapshotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTopologySnapshotResponse.Merge(m, src)
+}
+func (m *GetTopologySnapshotResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTopologySnapshotResponse.Size(m)
+}
+func (m *GetTopologySnapshotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTopologySnapshotResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTopologySnapshotResponse proto.InternalMessageInfo
+
+func (m *GetTopologySnapshotResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetTopologySnapshotResponse) GetSnapshot() string {
+	if m != nil {
+		return m.Snapshot
+	}
+	return ""
+}
+
+// RetryQuarantinedChannelsRequest retries the channels quarantined for exhausting the watch retry budget.
+type RetryQuarantinedChannelsRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// empty means all the quarantined channels
+	ChannelNames         []string `protobuf:"bytes,2,rep,name=channel_names,json=channelNames,proto3" json:"channel_names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RetryQuarantinedChannelsRequest) Reset()         { *m = RetryQuarantinedChannelsRequest{} }
+func (m *RetryQuarantinedChannelsRequest) String() string { return proto.CompactTextString(m) }
+func (*RetryQuarantinedChannelsRequest) ProtoMessage()    {}
+func (*RetryQuarantinedChannelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{126}
+}
+
+func (m *RetryQuarantinedChannelsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetryQuarantinedChannelsRequest.Unmarshal(m, b)
+}
+func (m *RetryQuarantinedChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetryQuarantinedChannelsRequest.Marshal(b, m, deterministic)
+}
+func (m *RetryQuarantinedChannelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryQuarantinedChannelsRequest.Merge(m, src)
+}
+func (m *RetryQuarantinedChannelsRequest) XXX_Size() int {
+	return xxx_messageInfo_RetryQuarantinedChannelsRequest.Size(m)
+}
+func (m *RetryQuarantinedChannelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryQuarantinedChannelsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryQuarantinedChannelsRequest proto.InternalMessageInfo
+
+func (m *RetryQuarantinedChannelsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *RetryQuarantinedChannelsRequest) GetChannelNames() []string {
+	if m != nil {
+		return m.ChannelNames
+	}
+	return nil
+}
+
+type RetryQuarantinedChannelsResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// the channels released to be watched again
+	ChannelNames         []string `protobuf:"bytes,2,rep,name=channel_names,json=channelNames,proto3" json:"channel_names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RetryQuarantinedChannelsResponse) Reset()         { *m = RetryQuarantinedChannelsResponse{} }
+func (m *RetryQuarantinedChannelsResponse) String() string { return proto.CompactTextString(m) }
+func (*RetryQuarantinedChannelsResponse) ProtoMessage()    {}
+func (*RetryQuarantinedChannelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{127}
+}
+
+func (m *RetryQuarantinedChannelsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetryQuarantinedChannelsResponse.Unmarshal(m, b)
+}
+func (m *RetryQuarantinedChannelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetryQuarantinedChannelsResponse.Marshal(b, m, deterministic)
+}
+func (m *RetryQuarantinedChannelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryQuarantinedChannelsResponse.Merge(m, src)
+}
+func (m *RetryQuarantinedChannelsResponse) XXX_Size() int {
+	return xxx_messageInfo_RetryQuarantinedChannelsResponse.Size(m)
+}
+func (m *RetryQuarantinedChannelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryQuarantinedChannelsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryQuarantinedChannelsResponse proto.InternalMessageInfo
+
+func (m *RetryQuarantinedChannelsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *RetryQuarantinedChannelsResponse) GetChannelNames() []string {
+	if m != nil {
+		return m.ChannelNames
+	}
+	return nil
+}
+
+// MigrateChannelWatchInfosRequest rewrites the legacy channel watch infos into the newest ChannelWatchInfo schema.
+type MigrateChannelWatchInfosRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// report the keys to migrate without writing them
+	DryRun               bool     `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MigrateChannelWatchInfosRequest) Reset()         { *m = MigrateChannelWatchInfosRequest{} }
+func (m *MigrateChannelWatchInfosRequest) String() string { return proto.CompactTextString(m) }
+func (*MigrateChannelWatchInfosRequest) ProtoMessage()    {}
+func (*MigrateChannelWatchInfosRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{128}
+}
+
+func (m *MigrateChannelWatchInfosRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MigrateChannelWatchInfosRequest.Unmarshal(m, b)
+}
+func (m *MigrateChannelWatchInfosRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MigrateChannelWatchInfosRequest.Marshal(b, m, deterministic)
+}
+func (m *MigrateChannelWatchInfosRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrateChannelWatchInfosRequest.Merge(m, src)
+}
+func (m *MigrateChannelWatchInfosRequest) XXX_Size() int {
+	return xxx_messageInfo_MigrateChannelWatchInfosRequest.Size(m)
+}
+func (m *MigrateChannelWatchInfosRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrateChannelWatchInfosRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrateChannelWatchInfosRequest proto.InternalMessageInfo
+
+func (m *MigrateChannelWatchInfosRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *MigrateChannelWatchInfosRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type MigrateChannelWatchInfosResponse struct {
+	Status       *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	MigratedKeys []string         `protobuf:"bytes,2,rep,name=migrated_keys,json=migratedKeys,proto3" json:"migrated_keys,omitempty"`
+	// the legacy watch infos being handled by the DataNodes or exceeding the transaction limit, migrate them later
+	SkippedKeys          []string `protobuf:"bytes,3,rep,name=skipped_keys,json=skippedKeys,proto3" json:"skipped_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MigrateChannelWatchInfosResponse) Reset()         { *m = MigrateChannelWatchInfosResponse{} }
+func (m *MigrateChannelWatchInfosResponse) String() string { return proto.CompactTextString(m) }
+func (*MigrateChannelWatchInfosResponse) ProtoMessage()    {}
+func (*MigrateChannelWatchInfosResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{129}
+}
+
+func (m *MigrateChannelWatchInfosResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MigrateChannelWatchInfosResponse.Unmarshal(m, b)
+}
+func (m *MigrateChannelWatchInfosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MigrateChannelWatchInfosResponse.Marshal(b, m, deterministic)
+}
+func (m *MigrateChannelWatchInfosResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrateChannelWatchInfosResponse.Merge(m, src)
+}
+func (m *MigrateChannelWatchInfosResponse) XXX_Size() int {
+	return xxx_messageInfo_MigrateChannelWatchInfosResponse.Size(m)
+}
+func (m *MigrateChannelWatchInfosResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrateChannelWatchInfosResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrateChannelWatchInfosResponse proto.InternalMessageInfo
+
+func (m *MigrateChannelWatchInfosResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *MigrateChannelWatchInfosResponse) GetMigratedKeys() []string {
+	if m != nil {
+		return m.MigratedKeys
+	}
+	return nil
+}
+
+func (m *MigrateChannelWatchInfosResponse) GetSkippedKeys() []string {
+	if m != nil {
+		return m.SkippedKeys
+	}
+	return nil
+}
+
+// ReCollectSegmentStatsRequest asks the DataNodes to resend the stats of the unflushed segments.
+type ReCollectSegmentStatsRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// 0 for all collections
+	CollectionID int64 `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// empty for all channels, only the DataNode watching the channel is asked if set
+	ChannelName          string   `protobuf:"bytes,3,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReCollectSegmentStatsRequest) Reset()         { *m = ReCollectSegmentStatsRequest{} }
+func (m *ReCollectSegmentStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*ReCollectSegmentStatsRequest) ProtoMessage()    {}
+func (*ReCollectSegmentStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{130}
+}
+
+func (m *ReCollectSegmentStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReCollectSegmentStatsRequest.Unmarshal(m, b)
+}
+func (m *ReCollectSegmentStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReCollectSegmentStatsRequest.Marshal(b, m, deterministic)
+}
+func (m *ReCollectSegmentStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReCollectSegmentStatsRequest.Merge(m, src)
+}
+func (m *ReCollectSegmentStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_ReCollectSegmentStatsRequest.Size(m)
+}
+func (m *ReCollectSegmentStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReCollectSegmentStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReCollectSegmentStatsRequest proto.InternalMessageInfo
+
+func (m *ReCollectSegmentStatsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ReCollectSegmentStatsRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ReCollectSegmentStatsRequest) GetChannelName() string {
+	if m != nil {
+		return m.ChannelName
+	}
+	return ""
+}
+
+type ReCollectSegmentStatsResult struct {
+	NodeID  int64  `protobuf:"varint,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	Success bool   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Reason  string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// the segments whose stats are resent
+	SegmentIDs           []int64  `protobuf:"varint,4,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReCollectSegmentStatsResult) Reset()         { *m = ReCollectSegmentStatsResult{} }
+func (m *ReCollectSegmentStatsResult) String() string { return proto.CompactTextString(m) }
+func (*ReCollectSegmentStatsResult) ProtoMessage()    {}
+func (*ReCollectSegmentStatsResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{131}
+}
+
+func (m *ReCollectSegmentStatsResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReCollectSegmentStatsResult.Unmarshal(m, b)
+}
+func (m *ReCollectSegmentStatsResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReCollectSegmentStatsResult.Marshal(b, m, deterministic)
+}
+func (m *ReCollectSegmentStatsResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReCollectSegmentStatsResult.Merge(m, src)
+}
+func (m *ReCollectSegmentStatsResult) XXX_Size() int {
+	return xxx_messageInfo_ReCollectSegmentStatsResult.Size(m)
+}
+func (m *ReCollectSegmentStatsResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReCollectSegmentStatsResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReCollectSegmentStatsResult proto.InternalMessageInfo
+
+func (m *ReCollectSegmentStatsResult) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *ReCollectSegmentStatsResult) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *ReCollectSegmentStatsResult) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ReCollectSegmentStatsResult) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+type ReCollectSegmentStatsResponse struct {
+	Status               *commonpb.Status               `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Results              []*ReCollectSegmentStatsResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *ReCollectSegmentStatsResponse) Reset()         { *m = ReCollectSegmentStatsResponse{} }
+func (m *ReCollectSegmentStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*ReCollectSegmentStatsResponse) ProtoMessage()    {}
+func (*ReCollectSegmentStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{132}
+}
+
+func (m *ReCollectSegmentStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReCollectSegmentStatsResponse.Unmarshal(m, b)
+}
+func (m *ReCollectSegmentStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReCollectSegmentStatsResponse.Marshal(b, m, deterministic)
+}
+func (m *ReCollectSegmentStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReCollectSegmentStatsResponse.Merge(m, src)
+}
+func (m *ReCollectSegmentStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_ReCollectSegmentStatsResponse.Size(m)
+}
+func (m *ReCollectSegmentStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReCollectSegmentStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReCollectSegmentStatsResponse proto.InternalMessageInfo
+
+func (m *ReCollectSegmentStatsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ReCollectSegmentStatsResponse) GetResults() []*ReCollectSegmentStatsResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// AuditEvent is an entry of the append-only audit log of DataCoord, for post-incident analysis.
+type AuditEvent struct {
+	// unix time in milliseconds
+	Timestamp            int64          `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Type                 AuditEventType `protobuf:"varint,2,opt,name=type,proto3,enum=milvus.proto.data.AuditEventType" json:"type,omitempty"`
+	NodeID               int64          `protobuf:"varint,3,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	CollectionID         int64          `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	ChannelName          string         `protobuf:"bytes,5,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
+	SegmentIDs           []int64        `protobuf:"varint,6,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	PlanID               int64          `protobuf:"varint,7,opt,name=planID,proto3" json:"planID,omitempty"`
+	Detail               string         `protobuf:"bytes,8,opt,name=detail,proto3" json:"detail,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *AuditEvent) Reset()         { *m = AuditEvent{} }
+func (m *AuditEvent) String() string { return proto.CompactTextString(m) }
+func (*AuditEvent) ProtoMessage()    {}
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{133}
+}
+
+func (m *AuditEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditEvent.Unmarshal(m, b)
+}
+func (m *AuditEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditEvent.Marshal(b, m, deterministic)
+}
+func (m *AuditEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditEvent.Merge(m, src)
+}
+func (m *AuditEvent) XXX_Size() int {
+	return xxx_messageInfo_AuditEvent.Size(m)
+}
+func (m *AuditEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditEvent proto.InternalMessageInfo
+
+func (m *AuditEvent) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *AuditEvent) GetType() AuditEventType {
+	if m != nil {
+		return m.Type
+	}
+	return AuditEventType_AuditEventNone
+}
+
+func (m *AuditEvent) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *AuditEvent) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *AuditEvent) GetChannelName() string {
+	if m != nil {
+		return m.ChannelName
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+func (m *AuditEvent) GetPlanID() int64 {
+	if m != nil {
+		return m.PlanID
+	}
+	return 0
+}
+
+func (m *AuditEvent) GetDetail() string {
+	if m != nil {
+		return m.Detail
+	}
+	return ""
+}
+
+type GetAuditEventsRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// all types if empty
+	Types []AuditEventType `protobuf:"varint,2,rep,packed,name=types,proto3,enum=milvus.proto.data.AuditEventType" json:"types,omitempty"`
+	// unix time in milliseconds, 0 for unbounded
+	StartTime int64 `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   int64 `protobuf:"varint,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// 0 for all collections
+	CollectionID int64 `protobuf:"varint,5,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// return the latest events only if set
+	Limit                int64    `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAuditEventsRequest) Reset()         { *m = GetAuditEventsRequest{} }
+func (m *GetAuditEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAuditEventsRequest) ProtoMessage()    {}
+func (*GetAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{134}
+}
+
+func (m *GetAuditEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAuditEventsRequest.Unmarshal(m, b)
+}
+func (m *GetAuditEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAuditEventsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetAuditEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAuditEventsRequest.Merge(m, src)
+}
+func (m *GetAuditEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAuditEventsRequest.Size(m)
+}
+func (m *GetAuditEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAuditEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAuditEventsRequest proto.InternalMessageInfo
+
+func (m *GetAuditEventsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetAuditEventsRequest) GetTypes() []AuditEventType {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
+func (m *GetAuditEventsRequest) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *GetAuditEventsRequest) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *GetAuditEventsRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *GetAuditEventsRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type GetAuditEventsResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// ordered by time
+	Events               []*AuditEvent `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetAuditEventsResponse) Reset()         { *m = GetAuditEventsResponse{} }
+func (m *GetAuditEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAuditEventsResponse) ProtoMessage()    {}
+func (*GetAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{135}
+}
+
+func (m *GetAuditEventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAuditEventsResponse.Unmarshal(m, b)
+}
+func (m *GetAuditEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAuditEventsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetAuditEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAuditEventsResponse.Merge(m, src)
+}
+func (m *GetAuditEventsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetAuditEventsResponse.Size(m)
+}
+func (m *GetAuditEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAuditEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAuditEventsResponse proto.InternalMessageInfo
+
+func (m *GetAuditEventsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetAuditEventsResponse) GetEvents() []*AuditEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type GetShardWriteStatsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetShardWriteStatsRequest) Reset()         { *m = GetShardWriteStatsRequest{} }
+func (m *GetShardWriteStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetShardWriteStatsRequest) ProtoMessage()    {}
+func (*GetShardWriteStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{136}
+}
+
+func (m *GetShardWriteStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetShardWriteStatsRequest.Unmarshal(m, b)
+}
+func (m *GetShardWriteStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetShardWriteStatsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetShardWriteStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetShardWriteStatsRequest.Merge(m, src)
+}
+func (m *GetShardWriteStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetShardWriteStatsRequest.Size(m)
+}
+func (m *GetShardWriteStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetShardWriteStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetShardWriteStatsRequest proto.InternalMessageInfo
+
+func (m *GetShardWriteStatsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetShardWriteStatsRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+// ShardWriteStats is the write rate of a vchannel consumed by the DataNode, averaged over the window.
+type ShardWriteStats struct {
+	ChannelName     string  `protobuf:"bytes,1,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
+	NodeID          int64   `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	InsertRowsRate  float64 `protobuf:"fixed64,3,opt,name=insert_rows_rate,json=insertRowsRate,proto3" json:"insert_rows_rate,omitempty"`
+	InsertBytesRate float64 `protobuf:"fixed64,4,opt,name=insert_bytes_rate,json=insertBytesRate,proto3" json:"insert_bytes_rate,omitempty"`
+	DeleteRowsRate  float64 `protobuf:"fixed64,5,opt,name=delete_rows_rate,json=deleteRowsRate,proto3" json:"delete_rows_rate,omitempty"`
+	DeleteBytesRate float64 `protobuf:"fixed64,6,opt,name=delete_bytes_rate,json=deleteBytesRate,proto3" json:"delete_bytes_rate,omitempty"`
+	// false if the DataNode watching the channel didn't report the rate
+	Available            bool     `protobuf:"varint,7,opt,name=available,proto3" json:"available,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShardWriteStats) Reset()         { *m = ShardWriteStats{} }
+func (m *ShardWriteStats) String() string { return proto.CompactTextString(m) }
+func (*ShardWriteStats) ProtoMessage()    {}
+func (*ShardWriteStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{137}
+}
+
+func (m *ShardWriteStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShardWriteStats.Unmarshal(m, b)
+}
+func (m *ShardWriteStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShardWriteStats.Marshal(b, m, deterministic)
+}
+func (m *ShardWriteStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardWriteStats.Merge(m, src)
+}
+func (m *ShardWriteStats) XXX_Size() int {
+	return xxx_messageInfo_ShardWriteStats.Size(m)
+}
+func (m *ShardWriteStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardWriteStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardWriteStats proto.InternalMessageInfo
+
+func (m *ShardWriteStats) GetChannelName() string {
+	if m != nil {
+		return m.ChannelName
+	}
+	return ""
+}
+
+func (m *ShardWriteStats) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *ShardWriteStats) GetInsertRowsRate() float64 {
+	if m != nil {
+		return m.InsertRowsRate
+	}
+	return 0
+}
+
+func (m *ShardWriteStats) GetInsertBytesRate() float64 {
+	if m != nil {
+		return m.InsertBytesRate
+	}
+	return 0
+}
+
+func (m *ShardWriteStats) GetDeleteRowsRate() float64 {
+	if m != nil {
+		return m.DeleteRowsRate
+	}
+	return 0
+}
+
+func (m *ShardWriteStats) GetDeleteBytesRate() float64 {
+	if m != nil {
+		return m.DeleteBytesRate
+	}
+	return 0
+}
+
+func (m *ShardWriteStats) GetAvailable() bool {
+	if m != nil {
+		return m.Available
+	}
+	return false
+}
+
+type GetShardWriteStatsResponse struct {
+	Status *commonpb.Status   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Shards []*ShardWriteStats `protobuf:"bytes,2,rep,name=shards,proto3" json:"shards,omitempty"`
+	// the rates are per second averaged over the window
+	WindowSeconds        int64    `protobuf:"varint,3,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetShardWriteStatsResponse) Reset()         { *m = GetShardWriteStatsResponse{} }
+func (m *GetShardWriteStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetShardWriteStatsResponse) ProtoMessage()    {}
+func (*GetShardWriteStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{138}
+}
+
+func (m *GetShardWriteStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetShardWriteStatsResponse.Unmarshal(m, b)
+}
+func (m *GetShardWriteStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetShardWriteStatsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetShardWriteStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetShardWriteStatsResponse.Merge(m, src)
+}
+func (m *GetShardWriteStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetShardWriteStatsResponse.Size(m)
+}
+func (m *GetShardWriteStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetShardWriteStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetShardWriteStatsResponse proto.InternalMessageInfo
+
+func (m *GetShardWriteStatsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetShardWriteStatsResponse) GetShards() []*ShardWriteStats {
+	if m != nil {
+		return m.Shards
+	}
+	return nil
+}
+
+func (m *GetShardWriteStatsResponse) GetWindowSeconds() int64 {
+	if m != nil {
+		return m.WindowSeconds
+	}
+	return 0
+}
+
+type FlushAndSealRequest struct {
+	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// the latest timestamp if not set
+	BarrierTs            uint64   `protobuf:"varint,3,opt,name=barrierTs,proto3" json:"barrierTs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FlushAndSealRequest) Reset()         { *m = FlushAndSealRequest{} }
+func (m *FlushAndSealRequest) String() string { return proto.CompactTextString(m) }
+func (*FlushAndSealRequest) ProtoMessage()    {}
+func (*FlushAndSealRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{139}
+}
+
+func (m *FlushAndSealRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FlushAndSealRequest.Unmarshal(m, b)
+}
+func (m *FlushAndSealRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FlushAndSealRequest.Marshal(b, m, deterministic)
+}
+func (m *FlushAndSealRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlushAndSealRequest.Merge(m, src)
+}
+func (m *FlushAndSealRequest) XXX_Size() int {
+	return xxx_messageInfo_FlushAndSealRequest.Size(m)
+}
+func (m *FlushAndSealRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlushAndSealRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlushAndSealRequest proto.InternalMessageInfo
+
+func (m *FlushAndSealRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *FlushAndSealRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *FlushAndSealRequest) GetBarrierTs() uint64 {
+	if m != nil {
+		return m.BarrierTs
+	}
+	return 0
+}
+
+type FlushAndSealResponse struct {
+	Status    *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	BarrierTs uint64           `protobuf:"varint,2,opt,name=barrierTs,proto3" json:"barrierTs,omitempty"`
+	// the flushed segments containing all the data of the collection before the barrier,
+	// the data after the barrier may be included, which shall be filtered out by the timestamp
+	SegmentIDs           []int64              `protobuf:"varint,3,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	ChannelCheckpoints   []*msgpb.MsgPosition `protobuf:"bytes,4,rep,name=channel_checkpoints,json=channelCheckpoints,proto3" json:"channel_checkpoints,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *FlushAndSealResponse) Reset()         { *m = FlushAndSealResponse{} }
+func (m *FlushAndSealResponse) String() string { return proto.CompactTextString(m) }
+func (*FlushAndSealResponse) ProtoMessage()    {}
+func (*FlushAndSealResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{140}
+}
+
+func (m *FlushAndSealResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FlushAndSealResponse.Unmarshal(m, b)
+}
+func (m *FlushAndSealResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FlushAndSealResponse.Marshal(b, m, deterministic)
+}
+func (m *FlushAndSealResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlushAndSealResponse.Merge(m, src)
+}
+func (m *FlushAndSealResponse) XXX_Size() int {
+	return xxx_messageInfo_FlushAndSealResponse.Size(m)
+}
+func (m *FlushAndSealResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlushAndSealResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlushAndSealResponse proto.InternalMessageInfo
+
+func (m *FlushAndSealResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *FlushAndSealResponse) GetBarrierTs() uint64 {
+	if m != nil {
+		return m.BarrierTs
+	}
+	return 0
+}
+
+func (m *FlushAndSealResponse) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+func (m *FlushAndSealResponse) GetChannelCheckpoints() []*msgpb.MsgPosition {
+	if m != nil {
+		return m.ChannelCheckpoints
+	}
+	return nil
+}
+
+type GetStorageConsistencyReportRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// the files of all the collections are reported if 0
+	CollectionID         int64    `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetStorageConsistencyReportRequest) Reset()         { *m = GetStorageConsistencyReportRequest{} }
+func (m *GetStorageConsistencyReportRequest) String() string { return proto.CompactTextString(m) }
+func (*GetStorageConsistencyReportRequest) ProtoMessage()    {}
+func (*GetStorageConsistencyReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{141}
+}
+
+func (m *GetStorageConsistencyReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStorageConsistencyReportRequest.Unmarshal(m, b)
+}
+func (m *GetStorageConsistencyReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStorageConsistencyReportRequest.Marshal(b, m, deterministic)
+}
+func (m *GetStorageConsistencyReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStorageConsistencyReportRequest.Merge(m, src)
+}
+func (m *GetStorageConsistencyReportRequest) XXX_Size() int {
+	return xxx_messageInfo_GetStorageConsistencyReportRequest.Size(m)
+}
+func (m *GetStorageConsistencyReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStorageConsistencyReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStorageConsistencyReportRequest proto.InternalMessageInfo
+
+func (m *GetStorageConsistencyReportRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetStorageConsistencyReportRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+// InconsistentFile is a binlog file referenced by the segment meta but missing in the object storage,
+// or an orphaned one in the object storage referenced by no segment beyond the gc missing tolerance.
+type InconsistentFile struct {
+	Path         string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	CollectionID int64  `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID  int64  `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	SegmentID    int64  `protobuf:"varint,4,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	// unix time in milliseconds the orphaned file was last modified, 0 for the missing ones
+	ModifiedTime         int64    `protobuf:"varint,5,opt,name=modified_time,json=modifiedTime,proto3" json:"modified_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InconsistentFile) Reset()         { *m = InconsistentFile{} }
+func (m *InconsistentFile) String() string { return proto.CompactTextString(m) }
+func (*InconsistentFile) ProtoMessage()    {}
+func (*InconsistentFile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{142}
+}
+
+func (m *InconsistentFile) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InconsistentFile.Unmarshal(m, b)
+}
+func (m *InconsistentFile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InconsistentFile.Marshal(b, m, deterministic)
+}
+func (m *InconsistentFile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InconsistentFile.Merge(m, src)
+}
+func (m *InconsistentFile) XXX_Size() int {
+	return xxx_messageInfo_InconsistentFile.Size(m)
+}
+func (m *InconsistentFile) XXX_DiscardUnknown() {
+	xxx_messageInfo_InconsistentFile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InconsistentFile proto.InternalMessageInfo
+
+func (m *InconsistentFile) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *InconsistentFile) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *InconsistentFile) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *InconsistentFile) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *InconsistentFile) GetModifiedTime() int64 {
+	if m != nil {
+		return m.ModifiedTime
+	}
+	return 0
+}
+
+type GetStorageConsistencyReportResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// unix time in milliseconds of the last reconciliation, 0 if none finished yet
+	StartTime    int64 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime      int64 `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	ScannedFiles int64 `protobuf:"varint,4,opt,name=scanned_files,json=scannedFiles,proto3" json:"scanned_files,omitempty"`
+	MissingNum   int64 `protobuf:"varint,5,opt,name=missing_num,json=missingNum,proto3" json:"missing_num,omitempty"`
+	OrphanedNum  int64 `protobuf:"varint,6,opt,name=orphaned_num,json=orphanedNum,proto3" json:"orphaned_num,omitempty"`
+	// at most dataCoord.storageReconcile.maxReportedFiles files of each kind
+	MissingFiles         []*InconsistentFile `protobuf:"bytes,7,rep,name=missing_files,json=missingFiles,proto3" json:"missing_files,omitempty"`
+	OrphanedFiles        []*InconsistentFile `protobuf:"bytes,8,rep,name=orphaned_files,json=orphanedFiles,proto3" json:"orphaned_files,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *GetStorageConsistencyReportResponse) Reset()         { *m = GetStorageConsistencyReportResponse{} }
+func (m *GetStorageConsistencyReportResponse) String() string { return proto.CompactTextString(m) }
+func (*GetStorageConsistencyReportResponse) ProtoMessage()    {}
+func (*GetStorageConsistencyReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{143}
+}
+
+func (m *GetStorageConsistencyReportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStorageConsistencyReportResponse.Unmarshal(m, b)
+}
+func (m *GetStorageConsistencyReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStorageConsistencyReportResponse.Marshal(b, m, deterministic)
+}
+func (m *GetStorageConsistencyReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStorageConsistencyReportResponse.Merge(m, src)
+}
+func (m *GetStorageConsistencyReportResponse) XXX_Size() int {
+	return xxx_messageInfo_GetStorageConsistencyReportResponse.Size(m)
+}
+func (m *GetStorageConsistencyReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStorageConsistencyReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStorageConsistencyReportResponse proto.InternalMessageInfo
+
+func (m *GetStorageConsistencyReportResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetStorageConsistencyReportResponse) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *GetStorageConsistencyReportResponse) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *GetStorageConsistencyReportResponse) GetScannedFiles() int64 {
+	if m != nil {
+		return m.ScannedFiles
+	}
+	return 0
+}
+
+func (m *GetStorageConsistencyReportResponse) GetMissingNum() int64 {
+	if m != nil {
+		return m.MissingNum
+	}
+	return 0
+}
+
+func (m *GetStorageConsistencyReportResponse) GetOrphanedNum() int64 {
+	if m != nil {
+		return m.OrphanedNum
+	}
+	return 0
+}
+
+func (m *GetStorageConsistencyReportResponse) GetMissingFiles() []*InconsistentFile {
+	if m != nil {
+		return m.MissingFiles
+	}
+	return nil
+}
+
+func (m *GetStorageConsistencyReportResponse) GetOrphanedFiles() []*InconsistentFile {
+	if m != nil {
+		return m.OrphanedFiles
+	}
+	return nil
+}
+
+type ReplicateBinlogRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// the log path relative to the root path of the primary cluster
+	LogPath              string   `protobuf:"bytes,2,opt,name=log_path,json=logPath,proto3" json:"log_path,omitempty"`
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplicateBinlogRequest) Reset()         { *m = ReplicateBinlogRequest{} }
+func (m *ReplicateBinlogRequest) String() string { return proto.CompactTextString(m) }
+func (*ReplicateBinlogRequest) ProtoMessage()    {}
+func (*ReplicateBinlogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{144}
+}
+
+func (m *ReplicateBinlogRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplicateBinlogRequest.Unmarshal(m, b)
+}
+func (m *ReplicateBinlogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplicateBinlogRequest.Marshal(b, m, deterministic)
+}
+func (m *ReplicateBinlogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicateBinlogRequest.Merge(m, src)
+}
+func (m *ReplicateBinlogRequest) XXX_Size() int {
+	return xxx_messageInfo_ReplicateBinlogRequest.Size(m)
+}
+func (m *ReplicateBinlogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicateBinlogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicateBinlogRequest proto.InternalMessageInfo
+
+func (m *ReplicateBinlogRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ReplicateBinlogRequest) GetLogPath() string {
+	if m != nil {
+		return m.LogPath
+	}
+	return ""
+}
+
+func (m *ReplicateBinlogRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type ReplicateSegmentRequest struct {
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionName  string            `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	// the index of the segment's insert channel among the virtual channels of the collection
+	ShardIndex int32 `protobuf:"varint,5,opt,name=shard_index,json=shardIndex,proto3" json:"shard_index,omitempty"`
+	// the segment of the primary cluster, whose log paths are relative to the root path
+	Segment              *SegmentInfo `protobuf:"bytes,6,opt,name=segment,proto3" json:"segment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ReplicateSegmentRequest) Reset()         { *m = ReplicateSegmentRequest{} }
+func (m *ReplicateSegmentRequest) String() string { return proto.CompactTextString(m) }
+func (*ReplicateSegmentRequest) ProtoMessage()    {}
+func (*ReplicateSegmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{145}
+}
+
+func (m *ReplicateSegmentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplicateSegmentRequest.Unmarshal(m, b)
+}
+func (m *ReplicateSegmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplicateSegmentRequest.Marshal(b, m, deterministic)
+}
+func (m *ReplicateSegmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicateSegmentRequest.Merge(m, src)
+}
+func (m *ReplicateSegmentRequest) XXX_Size() int {
+	return xxx_messageInfo_ReplicateSegmentRequest.Size(m)
+}
+func (m *ReplicateSegmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicateSegmentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicateSegmentRequest proto.InternalMessageInfo
+
+func (m *ReplicateSegmentRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ReplicateSegmentRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *ReplicateSegmentRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *ReplicateSegmentRequest) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
+}
+
+func (m *ReplicateSegmentRequest) GetShardIndex() int32 {
+	if m != nil {
+		return m.ShardIndex
+	}
+	return 0
+}
+
+func (m *ReplicateSegmentRequest) GetSegment() *SegmentInfo {
+	if m != nil {
+		return m.Segment
+	}
+	return nil
+}
+
+type ReplicateSegmentResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// the ID of the replica segment in the standby cluster
+	SegmentID            int64    `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplicateSegmentResponse) Reset()         { *m = ReplicateSegmentResponse{} }
+func (m *ReplicateSegmentResponse) String() string { return proto.CompactTextString(m) }
+func (*ReplicateSegmentResponse) ProtoMessage()    {}
+func (*ReplicateSegmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{146}
+}
+
+func (m *ReplicateSegmentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplicateSegmentResponse.Unmarshal(m, b)
+}
+func (m *ReplicateSegmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplicateSegmentResponse.Marshal(b, m, deterministic)
+}
+func (m *ReplicateSegmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicateSegmentResponse.Merge(m, src)
+}
+func (m *ReplicateSegmentResponse) XXX_Size() int {
+	return xxx_messageInfo_ReplicateSegmentResponse.Size(m)
+}
+func (m *ReplicateSegmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicateSegmentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicateSegmentResponse proto.InternalMessageInfo
+
+func (m *ReplicateSegmentResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ReplicateSegmentResponse) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("milvus.proto.data.SegmentType", SegmentType_name, SegmentType_value)
+	proto.RegisterEnum("milvus.proto.data.SegmentLevel", SegmentLevel_name, SegmentLevel_value)
+	proto.RegisterEnum("milvus.proto.data.ChannelWatchState", ChannelWatchState_name, ChannelWatchState_value)
+	proto.RegisterEnum("milvus.proto.data.CompactionType", CompactionType_name, CompactionType_value)
+	proto.RegisterEnum("milvus.proto.data.CloneState", CloneState_name, CloneState_value)
+	proto.RegisterEnum("milvus.proto.data.DrainState", DrainState_name, DrainState_value)
+	proto.RegisterEnum("milvus.proto.data.CompactionMode", CompactionMode_name, CompactionMode_value)
+	proto.RegisterEnum("milvus.proto.data.GcPhase", GcPhase_name, GcPhase_value)
+	proto.RegisterEnum("milvus.proto.data.AuditEventType", AuditEventType_name, AuditEventType_value)
+	proto.RegisterType((*Empty)(nil), "milvus.proto.data.Empty")
+	proto.RegisterType((*FlushRequest)(nil), "milvus.proto.data.FlushRequest")
+	proto.RegisterType((*FlushResponse)(nil), "milvus.proto.data.FlushResponse")
+	proto.RegisterType((*SegmentIDRequest)(nil), "milvus.proto.data.SegmentIDRequest")
+	proto.RegisterType((*AssignSegmentIDRequest)(nil), "milvus.proto.data.AssignSegmentIDRequest")
+	proto.RegisterType((*SegmentIDAssignment)(nil), "milvus.proto.data.SegmentIDAssignment")
+	proto.RegisterType((*AssignSegmentIDResponse)(nil), "milvus.proto.data.AssignSegmentIDResponse")
+	proto.RegisterType((*GetSegmentStatesRequest)(nil), "milvus.proto.data.GetSegmentStatesRequest")
+	proto.RegisterType((*SegmentStateInfo)(nil), "milvus.proto.data.SegmentStateInfo")
+	proto.RegisterType((*GetSegmentStatesResponse)(nil), "milvus.proto.data.GetSegmentStatesResponse")
+	proto.RegisterType((*GetSegmentInfoRequest)(nil), "milvus.proto.data.GetSegmentInfoRequest")
+	proto.RegisterType((*GetSegmentInfoResponse)(nil), "milvus.proto.data.GetSegmentInfoResponse")
+	proto.RegisterMapType((map[string]*msgpb.MsgPosition)(nil), "milvus.proto.data.GetSegmentInfoResponse.ChannelCheckpointEntry")
+	proto.RegisterType((*GetInsertBinlogPathsRequest)(nil), "milvus.proto.data.GetInsertBinlogPathsRequest")
+	proto.RegisterType((*GetInsertBinlogPathsResponse)(nil), "milvus.proto.data.GetInsertBinlogPathsResponse")
+	proto.RegisterType((*GetCollectionStatisticsRequest)(nil), "milvus.proto.data.GetCollectionStatisticsRequest")
+	proto.RegisterType((*GetCollectionStatisticsResponse)(nil), "milvus.proto.data.GetCollectionStatisticsResponse")
+	proto.RegisterType((*GetPartitionStatisticsRequest)(nil), "milvus.proto.data.GetPartitionStatisticsRequest")
+	proto.RegisterType((*GetPartitionStatisticsResponse)(nil), "milvus.proto.data.GetPartitionStatisticsResponse")
+	proto.RegisterType((*GetSegmentInfoChannelRequest)(nil), "milvus.proto.data.GetSegmentInfoChannelRequest")
+	proto.RegisterType((*VchannelInfo)(nil), "milvus.proto.data.VchannelInfo")
+	proto.RegisterType((*WatchDmChannelsRequest)(nil), "milvus.proto.data.WatchDmChannelsRequest")
+	proto.RegisterType((*FlushSegmentsRequest)(nil), "milvus.proto.data.FlushSegmentsRequest")
+	proto.RegisterType((*SegmentMsg)(nil), "milvus.proto.data.SegmentMsg")
+	proto.RegisterType((*SegmentInfo)(nil), "milvus.proto.data.SegmentInfo")
+	proto.RegisterType((*ExternalSource)(nil), "milvus.proto.data.ExternalSource")
+	proto.RegisterType((*SegmentStartPosition)(nil), "milvus.proto.data.SegmentStartPosition")
+	proto.RegisterType((*SaveBinlogPathsRequest)(nil), "milvus.proto.data.SaveBinlogPathsRequest")
+	proto.RegisterType((*CheckPoint)(nil), "milvus.proto.data.CheckPoint")
+	proto.RegisterType((*DeltaLogInfo)(nil), "milvus.proto.data.DeltaLogInfo")
+	proto.RegisterType((*ChannelStatus)(nil), "milvus.proto.data.ChannelStatus")
+	proto.RegisterType((*DataNodeInfo)(nil), "milvus.proto.data.DataNodeInfo")
+	proto.RegisterType((*SegmentBinlogs)(nil), "milvus.proto.data.SegmentBinlogs")
+	proto.RegisterType((*FieldBinlog)(nil), "milvus.proto.data.FieldBinlog")
+	proto.RegisterType((*Binlog)(nil), "milvus.proto.data.Binlog")
+	proto.RegisterType((*GetRecoveryInfoResponse)(nil), "milvus.proto.data.GetRecoveryInfoResponse")
+	proto.RegisterType((*GetRecoveryInfoRequest)(nil), "milvus.proto.data.GetRecoveryInfoRequest")
+	proto.RegisterType((*GetRecoveryInfoResponseV2)(nil), "milvus.proto.data.GetRecoveryInfoResponseV2")
+	proto.RegisterType((*GetRecoveryInfoRequestV2)(nil), "milvus.proto.data.GetRecoveryInfoRequestV2")
+	proto.RegisterType((*GetSegmentsByStatesRequest)(nil), "milvus.proto.data.GetSegmentsByStatesRequest")
+	proto.RegisterType((*GetSegmentsByStatesResponse)(nil), "milvus.proto.data.GetSegmentsByStatesResponse")
+	proto.RegisterType((*GetFlushedSegmentsRequest)(nil), "milvus.proto.data.GetFlushedSegmentsRequest")
+	proto.RegisterType((*GetFlushedSegmentsResponse)(nil), "milvus.proto.data.GetFlushedSegmentsResponse")
+	proto.RegisterType((*SegmentFlushCompletedMsg)(nil), "milvus.proto.data.SegmentFlushCompletedMsg")
+	proto.RegisterType((*ChannelWatchInfo)(nil), "milvus.proto.data.ChannelWatchInfo")
+	proto.RegisterType((*CompactionStateRequest)(nil), "milvus.proto.data.CompactionStateRequest")
+	proto.RegisterType((*SyncSegmentsRequest)(nil), "milvus.proto.data.SyncSegmentsRequest")
+	proto.RegisterType((*CompactionSegmentBinlogs)(nil), "milvus.proto.data.CompactionSegmentBinlogs")
+	proto.RegisterType((*CompactionPlan)(nil), "milvus.proto.data.CompactionPlan")
+	proto.RegisterType((*CompactionResult)(nil), "milvus.proto.data.CompactionResult")
+	proto.RegisterType((*CompactionStateResult)(nil), "milvus.proto.data.CompactionStateResult")
+	proto.RegisterType((*CompactionStateResponse)(nil), "milvus.proto.data.CompactionStateResponse")
+	proto.RegisterType((*SegmentFieldBinlogMeta)(nil), "milvus.proto.data.SegmentFieldBinlogMeta")
+	proto.RegisterType((*WatchChannelsRequest)(nil), "milvus.proto.data.WatchChannelsRequest")
+	proto.RegisterType((*WatchChannelsResponse)(nil), "milvus.proto.data.WatchChannelsResponse")
+	proto.RegisterType((*SetSegmentStateRequest)(nil), "milvus.proto.data.SetSegmentStateRequest")
+	proto.RegisterType((*SetSegmentStateResponse)(nil), "milvus.proto.data.SetSegmentStateResponse")
+	proto.RegisterType((*DropVirtualChannelRequest)(nil), "milvus.proto.data.DropVirtualChannelRequest")
+	proto.RegisterType((*DropVirtualChannelSegment)(nil), "milvus.proto.data.DropVirtualChannelSegment")
+	proto.RegisterType((*DropVirtualChannelResponse)(nil), "milvus.proto.data.DropVirtualChannelResponse")
+	proto.RegisterType((*ImportTask)(nil), "milvus.proto.data.ImportTask")
+	proto.RegisterType((*ImportTaskState)(nil), "milvus.proto.data.ImportTaskState")
+	proto.RegisterType((*ImportTaskInfo)(nil), "milvus.proto.data.ImportTaskInfo")
+	proto.RegisterType((*ImportCheckpoint)(nil), "milvus.proto.data.ImportCheckpoint")
+	proto.RegisterType((*ImportTaskResponse)(nil), "milvus.proto.data.ImportTaskResponse")
+	proto.RegisterType((*ImportTaskRequest)(nil), "milvus.proto.data.ImportTaskRequest")
+	proto.RegisterType((*UpdateSegmentStatisticsRequest)(nil), "milvus.proto.data.UpdateSegmentStatisticsRequest")
+	proto.RegisterType((*UpdateChannelCheckpointRequest)(nil), "milvus.proto.data.UpdateChannelCheckpointRequest")
+	proto.RegisterType((*ResendSegmentStatsRequest)(nil), "milvus.proto.data.ResendSegmentStatsRequest")
+	proto.RegisterType((*ResendSegmentStatsResponse)(nil), "milvus.proto.data.ResendSegmentStatsResponse")
+	proto.RegisterType((*AddImportSegmentRequest)(nil), "milvus.proto.data.AddImportSegmentRequest")
+	proto.RegisterType((*AddImportSegmentResponse)(nil), "milvus.proto.data.AddImportSegmentResponse")
+	proto.RegisterType((*SaveImportSegmentRequest)(nil), "milvus.proto.data.SaveImportSegmentRequest")
+	proto.RegisterType((*UnsetIsImportingStateRequest)(nil), "milvus.proto.data.UnsetIsImportingStateRequest")
+	proto.RegisterType((*MarkSegmentsDroppedRequest)(nil), "milvus.proto.data.MarkSegmentsDroppedRequest")
+	proto.RegisterType((*SegmentReferenceLock)(nil), "milvus.proto.data.SegmentReferenceLock")
+	proto.RegisterType((*AlterCollectionRequest)(nil), "milvus.proto.data.AlterCollectionRequest")
+	proto.RegisterType((*GcConfirmRequest)(nil), "milvus.proto.data.GcConfirmRequest")
+	proto.RegisterType((*GcConfirmResponse)(nil), "milvus.proto.data.GcConfirmResponse")
+	proto.RegisterType((*ReportDataNodeTtMsgsRequest)(nil), "milvus.proto.data.ReportDataNodeTtMsgsRequest")
+	proto.RegisterType((*ChannelWatchStateTransition)(nil), "milvus.proto.data.ChannelWatchStateTransition")
+	proto.RegisterType((*GetChannelWatchHistoryRequest)(nil), "milvus.proto.data.GetChannelWatchHistoryRequest")
+	proto.RegisterType((*GetChannelWatchHistoryResponse)(nil), "milvus.proto.data.GetChannelWatchHistoryResponse")
+	proto.RegisterType((*SetNodeConfigsRequest)(nil), "milvus.proto.data.SetNodeConfigsRequest")
+	proto.RegisterType((*ListNodeConfigsRequest)(nil), "milvus.proto.data.ListNodeConfigsRequest")
+	proto.RegisterType((*ListNodeConfigsResponse)(nil), "milvus.proto.data.ListNodeConfigsResponse")
+	proto.RegisterType((*ClearNodeConfigsRequest)(nil), "milvus.proto.data.ClearNodeConfigsRequest")
+	proto.RegisterType((*CloneSegmentsRequest)(nil), "milvus.proto.data.CloneSegmentsRequest")
+	proto.RegisterMapType((map[string]string)(nil), "milvus.proto.data.CloneSegmentsRequest.ChannelsEntry")
+	proto.RegisterMapType((map[int64]int64)(nil), "milvus.proto.data.CloneSegmentsRequest.PartitionIDsEntry")
+	proto.RegisterType((*CloneJob)(nil), "milvus.proto.data.CloneJob")
+	proto.RegisterType((*GetCloneSegmentsStateRequest)(nil), "milvus.proto.data.GetCloneSegmentsStateRequest")
+	proto.RegisterType((*GetCloneSegmentsStateResponse)(nil), "milvus.proto.data.GetCloneSegmentsStateResponse")
+	proto.RegisterType((*MaintenancePolicy)(nil), "milvus.proto.data.MaintenancePolicy")
+	proto.RegisterType((*SetMaintenancePolicyRequest)(nil), "milvus.proto.data.SetMaintenancePolicyRequest")
+	proto.RegisterType((*ListMaintenancePoliciesRequest)(nil), "milvus.proto.data.ListMaintenancePoliciesRequest")
+	proto.RegisterType((*ListMaintenancePoliciesResponse)(nil), "milvus.proto.data.ListMaintenancePoliciesResponse")
+	proto.RegisterType((*CordonDataNodeRequest)(nil), "milvus.proto.data.CordonDataNodeRequest")
+	proto.RegisterType((*DrainDataNodeRequest)(nil), "milvus.proto.data.DrainDataNodeRequest")
+	proto.RegisterType((*UncordonDataNodeRequest)(nil), "milvus.proto.data.UncordonDataNodeRequest")
+	proto.RegisterType((*GetDataNodeDrainStateRequest)(nil), "milvus.proto.data.GetDataNodeDrainStateRequest")
+	proto.RegisterType((*GetDataNodeDrainStateResponse)(nil), "milvus.proto.data.GetDataNodeDrainStateResponse")
+	proto.RegisterType((*EncryptionKeyInfo)(nil), "milvus.proto.data.EncryptionKeyInfo")
+	proto.RegisterType((*RotateEncryptionKeyRequest)(nil), "milvus.proto.data.RotateEncryptionKeyRequest")
+	proto.RegisterType((*RotateEncryptionKeyResponse)(nil), "milvus.proto.data.RotateEncryptionKeyResponse")
+	proto.RegisterType((*GetEncryptionStatusRequest)(nil), "milvus.proto.data.GetEncryptionStatusRequest")
+	proto.RegisterType((*GetEncryptionStatusResponse)(nil), "milvus.proto.data.GetEncryptionStatusResponse")
+	proto.RegisterType((*ChannelWatchStateInfo)(nil), "milvus.proto.data.ChannelWatchStateInfo")
+	proto.RegisterType((*GetChannelWatchStatesRequest)(nil), "milvus.proto.data.GetChannelWatchStatesRequest")
+	proto.RegisterType((*GetChannelWatchStatesResponse)(nil), "milvus.proto.data.GetChannelWatchStatesResponse")
+	proto.RegisterType((*ManualCompactionWithModeRequest)(nil), "milvus.proto.data.ManualCompactionWithModeRequest")
+	proto.RegisterType((*ManualCompactionWithModeResponse)(nil), "milvus.proto.data.ManualCompactionWithModeResponse")
+	proto.RegisterType((*GetCompactionProgressRequest)(nil), "milvus.proto.data.GetCompactionProgressRequest")
+	proto.RegisterType((*GetCompactionProgressResponse)(nil), "milvus.proto.data.GetCompactionProgressResponse")
+	proto.RegisterType((*CancelCompactionRequest)(nil), "milvus.proto.data.CancelCompactionRequest")
+	proto.RegisterType((*CancelCompactionPlansRequest)(nil), "milvus.proto.data.CancelCompactionPlansRequest")
+	proto.RegisterType((*GcDryRunRequest)(nil), "milvus.proto.data.GcDryRunRequest")
+	proto.RegisterType((*GcOrphanFile)(nil), "milvus.proto.data.GcOrphanFile")
+	proto.RegisterType((*GcDroppedSegment)(nil), "milvus.proto.data.GcDroppedSegment")
+	proto.RegisterType((*GcDryRunResponse)(nil), "milvus.proto.data.GcDryRunResponse")
+	proto.RegisterType((*PauseGCRequest)(nil), "milvus.proto.data.PauseGCRequest")
+	proto.RegisterType((*ResumeGCRequest)(nil), "milvus.proto.data.ResumeGCRequest")
+	proto.RegisterType((*GetGCStatusRequest)(nil), "milvus.proto.data.GetGCStatusRequest")
+	proto.RegisterType((*GcRunStats)(nil), "milvus.proto.data.GcRunStats")
+	proto.RegisterType((*GetGCStatusResponse)(nil), "milvus.proto.data.GetGCStatusResponse")
+	proto.RegisterType((*DropSegmentsByTimeRangeRequest)(nil), "milvus.proto.data.DropSegmentsByTimeRangeRequest")
+	proto.RegisterType((*DropSegmentsByTimeRangeResponse)(nil), "milvus.proto.data.DropSegmentsByTimeRangeResponse")
+	proto.RegisterType((*GetTopologySnapshotRequest)(nil), "milvus.proto.data.GetTopologySnapshotRequest")
+	proto.RegisterType((*GetTopologySnapshotResponse)(nil), "milvus.proto.data.GetTopologySnapshotResponse")
+	proto.RegisterType((*RetryQuarantinedChannelsRequest)(nil), "milvus.proto.data.RetryQuarantinedChannelsRequest")
+	proto.RegisterType((*RetryQuarantinedChannelsResponse)(nil), "milvus.proto.data.RetryQuarantinedChannelsResponse")
+	proto.RegisterType((*MigrateChannelWatchInfosRequest)(nil), "milvus.proto.data.MigrateChannelWatchInfosRequest")
+	proto.RegisterType((*MigrateChannelWatchInfosResponse)(nil), "milvus.proto.data.MigrateChannelWatchInfosResponse")
+	proto.RegisterType((*ReCollectSegmentStatsRequest)(nil), "milvus.proto.data.ReCollectSegmentStatsRequest")
+	proto.RegisterType((*ReCollectSegmentStatsResult)(nil), "milvus.proto.data.ReCollectSegmentStatsResult")
+	proto.RegisterType((*ReCollectSegmentStatsResponse)(nil), "milvus.proto.data.ReCollectSegmentStatsResponse")
+	proto.RegisterType((*AuditEvent)(nil), "milvus.proto.data.AuditEvent")
+	proto.RegisterType((*GetAuditEventsRequest)(nil), "milvus.proto.data.GetAuditEventsRequest")
+	proto.RegisterType((*GetAuditEventsResponse)(nil), "milvus.proto.data.GetAuditEventsResponse")
+	proto.RegisterType((*GetShardWriteStatsRequest)(nil), "milvus.proto.data.GetShardWriteStatsRequest")
+	proto.RegisterType((*ShardWriteStats)(nil), "milvus.proto.data.ShardWriteStats")
+	proto.RegisterType((*GetShardWriteStatsResponse)(nil), "milvus.proto.data.GetShardWriteStatsResponse")
+	proto.RegisterType((*FlushAndSealRequest)(nil), "milvus.proto.data.FlushAndSealRequest")
+	proto.RegisterType((*FlushAndSealResponse)(nil), "milvus.proto.data.FlushAndSealResponse")
+	proto.RegisterType((*GetStorageConsistencyReportRequest)(nil), "milvus.proto.data.GetStorageConsistencyReportRequest")
+	proto.RegisterType((*InconsistentFile)(nil), "milvus.proto.data.InconsistentFile")
+	proto.RegisterType((*GetStorageConsistencyReportResponse)(nil), "milvus.proto.data.GetStorageConsistencyReportResponse")
+	proto.RegisterType((*ReplicateBinlogRequest)(nil), "milvus.proto.data.ReplicateBinlogRequest")
+	proto.RegisterType((*ReplicateSegmentRequest)(nil), "milvus.proto.data.ReplicateSegmentRequest")
+	proto.RegisterType((*ReplicateSegmentResponse)(nil), "milvus.proto.data.ReplicateSegmentResponse")
+}
+
+func init() { proto.RegisterFile("data_coord.proto", fileDescriptor_82cd95f524594f49) }
+
+var fileDescriptor_82cd95f524594f49 = []byte{
+	// 8484 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7d, 0x5b, 0x70, 0x24, 0x59,
+	0x76, 0x50, 0x67, 0xbd, 0xeb, 0x94, 0x54, 0x2a, 0x5d, 0xa9, 0x25, 0x75, 0x75, 0x4f, 0x77, 0x4f,
+	0xf6, 0xf4, 0x8c, 0xa6, 0x67, 0xa6, 0xbb, 0x47, 0xed, 0xb1, 0x67, 0x77, 0xf6, 0xd5, 0x2d, 0x4d,
+	0x6b, 0xb4, 0xdb, 0xea, 0xd1, 0xa6, 0xd4, 0x3d, 0x66, 0x97, 0xa5, 0x48, 0x55, 0x5e, 0x95, 0x72,
+	0x54, 0x95, 0x59, 0x93, 0x99, 0x25, 0xb5, 0xd6, 0x1b, 0xb0, 0x61, 0xd6, 0xc0, 0xda, 0x60, 0x1b,
+	0x70, 0x2c, 0x10, 0x81, 0x8d, 0xe1, 0x03, 0x0c, 0x84, 0xf1, 0x8f, 0xc1, 0x11, 0x84, 0x23, 0xf6,
+	0x13, 0x03, 0x1f, 0x04, 0x7f, 0xf6, 0x87, 0x7f, 0xf8, 0x20, 0xf8, 0xe0, 0x87, 0x08, 0x02, 0x02,
+	0x7e, 0x96, 0xb8, 0x8f, 0xbc, 0x79, 0x33, 0xf3, 0x66, 0x55, 0x4a, 0xd5, 0xda, 0x21, 0xf0, 0x97,
+	0x94, 0xf7, 0x9e, 0x7b, 0xee, 0xeb, 0x9c, 0x73, 0xcf, 0x3d, 0xf7, 0x9c, 0x53, 0xd0, 0xb2, 0xcc,
+	0xc0, 0xec, 0x74, 0x5d, 0xd7, 0xb3, 0xee, 0x0e, 0x3d, 0x37, 0x70, 0xd1, 0xfc, 0xc0, 0xee, 0x1f,
+	0x8f, 0x7c, 0xf6, 0x75, 0x97, 0x54, 0xb7, 0x67, 0xba, 0xee, 0x60, 0xe0, 0x3a, 0xac, 0xa8, 0xdd,
+	0xb4, 0x9d, 0x00, 0x7b, 0x8e, 0xd9, 0xe7, 0xdf, 0x33, 0x72, 0x83, 0xf6, 0x8c, 0xdf, 0x3d, 0xc4,
+	0x03, 0x93, 0x7f, 0xd5, 0x07, 0x7e, 0x8f, 0xff, 0x3b, 0x6f, 0x3b, 0x16, 0x7e, 0x21, 0x77, 0xa5,
+	0x57, 0xa1, 0xfc, 0xe1, 0x60, 0x18, 0x9c, 0xea, 0xbf, 0xaf, 0xc1, 0xcc, 0xe3, 0xfe, 0xc8, 0x3f,
+	0x34, 0xf0, 0x67, 0x23, 0xec, 0x07, 0xe8, 0x3e, 0x94, 0xf6, 0x4d, 0x1f, 0xaf, 0x68, 0x37, 0xb5,
+	0xd5, 0xc6, 0xda, 0xb5, 0xbb, 0xb1, 0x31, 0xf1, 0xd1, 0x6c, 0xfb, 0xbd, 0x47, 0xa6, 0x8f, 0x0d,
+	0x0a, 0x89, 0x10, 0x94, 0xac, 0xfd, 0xad, 0x8d, 0x95, 0xc2, 0x4d, 0x6d, 0xb5, 0x68, 0xd0, 0xff,
+	0xd1, 0x75, 0x00, 0x1f, 0xf7, 0x06, 0xd8, 0x09, 0xb6, 0x36, 0xfc, 0x95, 0xe2, 0xcd, 0xe2, 0x6a,
+	0xd1, 0x90, 0x4a, 0x90, 0x0e, 0x33, 0x5d, 0xb7, 0xdf, 0xc7, 0xdd, 0xc0, 0x76, 0x9d, 0xad, 0x8d,
+	0x95, 0x12, 0x6d, 0x1b, 0x2b, 0x43, 0x6d, 0xa8, 0xd9, 0xfe, 0xd6, 0x60, 0xe8, 0x7a, 0xc1, 0x4a,
+	0xf9, 0xa6, 0xb6, 0x5a, 0x33, 0xc4, 0xb7, 0xfe, 0x5f, 0x34, 0x98, 0xe5, 0xc3, 0xf6, 0x87, 0xae,
+	0xe3, 0x63, 0xf4, 0x00, 0x2a, 0x7e, 0x60, 0x06, 0x23, 0x9f, 0x8f, 0xfc, 0xaa, 0x72, 0xe4, 0xbb,
+	0x14, 0xc4, 0xe0, 0xa0, 0xca, 0xa1, 0x27, 0x87, 0x56, 0x54, 0x0c, 0x2d, 0x3e, 0xbd, 0x52, 0x6a,
+	0x7a, 0xab, 0x30, 0x77, 0x40, 0x46, 0xb7, 0x1b, 0x01, 0x95, 0x29, 0x50, 0xb2, 0x98, 0x60, 0x0a,
+	0xec, 0x01, 0xfe, 0xf8, 0x60, 0x17, 0x9b, 0xfd, 0x95, 0x0a, 0xed, 0x4b, 0x2a, 0xd1, 0xff, 0x93,
+	0x06, 0x2d, 0x01, 0x1e, 0xee, 0xd1, 0x22, 0x94, 0xbb, 0xee, 0xc8, 0x09, 0xe8, 0x54, 0x67, 0x0d,
+	0xf6, 0x81, 0x5e, 0x85, 0x99, 0xee, 0xa1, 0xe9, 0x38, 0xb8, 0xdf, 0x71, 0xcc, 0x01, 0xa6, 0x93,
+	0xaa, 0x1b, 0x0d, 0x5e, 0xf6, 0xd4, 0x1c, 0xe0, 0x5c, 0x73, 0xbb, 0x09, 0x8d, 0xa1, 0xe9, 0x05,
+	0x76, 0x6c, 0x67, 0xe4, 0xa2, 0x71, 0x1b, 0x43, 0x7a, 0xb0, 0xe9, 0x7f, 0x7b, 0xa6, 0x7f, 0xb4,
+	0xb5, 0xc1, 0x67, 0x14, 0x2b, 0xd3, 0x7f, 0x5b, 0x83, 0xa5, 0x87, 0xbe, 0x6f, 0xf7, 0x9c, 0xd4,
+	0xcc, 0x96, 0xa0, 0xe2, 0xb8, 0x16, 0xde, 0xda, 0xa0, 0x53, 0x2b, 0x1a, 0xfc, 0x0b, 0x5d, 0x85,
+	0xfa, 0x10, 0x63, 0xaf, 0xe3, 0xb9, 0xfd, 0x70, 0x62, 0x35, 0x52, 0x60, 0xb8, 0x7d, 0x8c, 0xbe,
+	0x09, 0xf3, 0x7e, 0x02, 0x11, 0xa3, 0xb9, 0xc6, 0xda, 0xad, 0xbb, 0x29, 0x9e, 0xba, 0x9b, 0xec,
+	0xd4, 0x48, 0xb7, 0xd6, 0xbf, 0x5f, 0x80, 0x05, 0x01, 0xc7, 0xc6, 0x4a, 0xfe, 0x27, 0x2b, 0xef,
+	0xe3, 0x9e, 0x18, 0x1e, 0xfb, 0xc8, 0xb3, 0xf2, 0x62, 0xcb, 0x8a, 0xf2, 0x96, 0xe5, 0x61, 0x83,
+	0xc4, 0x7e, 0x94, 0xd3, 0xfb, 0x71, 0x03, 0x1a, 0xf8, 0xc5, 0xd0, 0xf6, 0x70, 0x87, 0x10, 0x0e,
+	0x5d, 0xf2, 0x92, 0x01, 0xac, 0x68, 0xcf, 0x1e, 0xc8, 0xbc, 0x51, 0xcd, 0xcd, 0x1b, 0xfa, 0x3f,
+	0xd6, 0x60, 0x39, 0xb5, 0x4b, 0x9c, 0xd9, 0x0c, 0x68, 0xd1, 0x99, 0x47, 0x2b, 0x43, 0xd8, 0x8e,
+	0x2c, 0xf8, 0xeb, 0xe3, 0x16, 0x3c, 0x02, 0x37, 0x52, 0xed, 0xa5, 0x41, 0x16, 0xf2, 0x0f, 0xf2,
+	0x08, 0x96, 0x37, 0x71, 0xc0, 0x3b, 0x20, 0x75, 0xd8, 0x3f, 0xbf, 0x20, 0x8b, 0x73, 0x75, 0x21,
+	0xc9, 0xd5, 0xfa, 0x3f, 0x29, 0x08, 0x5e, 0xa4, 0x5d, 0x6d, 0x39, 0x07, 0x2e, 0xba, 0x06, 0x75,
+	0x01, 0xc2, 0xa9, 0x22, 0x2a, 0x40, 0x3f, 0x07, 0x65, 0x32, 0x52, 0x46, 0x12, 0xcd, 0xb5, 0x57,
+	0xd5, 0x73, 0x92, 0x70, 0x1a, 0x0c, 0x1e, 0x6d, 0x40, 0xd3, 0x0f, 0x4c, 0x2f, 0xe8, 0x0c, 0x5d,
+	0x9f, 0xee, 0x33, 0x25, 0x9c, 0xc6, 0xda, 0x2b, 0x71, 0x0c, 0x44, 0xc8, 0x6f, 0xfb, 0xbd, 0x1d,
+	0x0e, 0x64, 0xcc, 0xd2, 0x46, 0xe1, 0x27, 0xfa, 0x1a, 0xcc, 0x60, 0xc7, 0x8a, 0x70, 0x94, 0xf2,
+	0xe0, 0x68, 0x60, 0xc7, 0x12, 0x18, 0xa2, 0x5d, 0x29, 0xe7, 0xdf, 0x95, 0xbf, 0xa1, 0xc1, 0x4a,
+	0x7a, 0x5b, 0xa6, 0x11, 0xd4, 0x1f, 0xb0, 0x46, 0x98, 0x6d, 0xcb, 0x58, 0xbe, 0x16, 0x5b, 0x63,
+	0xf0, 0x26, 0xfa, 0x1f, 0x17, 0xe0, 0x72, 0x34, 0x1c, 0x5a, 0x75, 0x51, 0x34, 0x82, 0xee, 0x40,
+	0xcb, 0x76, 0xba, 0xfd, 0x91, 0x85, 0x9f, 0x39, 0x1f, 0x61, 0xb3, 0x1f, 0x1c, 0x9e, 0xd2, 0x9d,
+	0xab, 0x19, 0xa9, 0xf2, 0x5c, 0xdc, 0xff, 0x05, 0x31, 0x71, 0x72, 0x80, 0xe4, 0xa2, 0x20, 0xde,
+	0x80, 0x88, 0x9c, 0xbe, 0x3d, 0xb0, 0x03, 0x2e, 0x83, 0xd9, 0x07, 0x7a, 0x03, 0xe6, 0xcc, 0x83,
+	0x00, 0x7b, 0x9d, 0x88, 0x6a, 0xab, 0xb4, 0xbe, 0x49, 0x8b, 0x05, 0xaf, 0xa2, 0x5b, 0x30, 0xeb,
+	0x8e, 0x82, 0xe1, 0x28, 0xe8, 0x1c, 0xd8, 0xb8, 0x6f, 0xf9, 0x2b, 0xb5, 0x9b, 0xc5, 0xd5, 0xba,
+	0x31, 0xc3, 0x0a, 0x1f, 0xd3, 0x32, 0xfd, 0x7f, 0x14, 0x60, 0x29, 0xb9, 0xb4, 0xd3, 0xec, 0xf3,
+	0xcf, 0x40, 0xd9, 0x76, 0x0e, 0xdc, 0x70, 0x9b, 0xaf, 0x8f, 0x91, 0x26, 0xa4, 0x2f, 0x06, 0x8c,
+	0x5c, 0x40, 0xa1, 0xfc, 0xed, 0x1e, 0xe2, 0xee, 0xd1, 0xd0, 0xb5, 0xa9, 0xa4, 0x25, 0x28, 0xbe,
+	0xa6, 0x40, 0xa1, 0x1e, 0xf1, 0xdd, 0x75, 0x86, 0x63, 0x5d, 0xa0, 0xf8, 0xd0, 0x09, 0xbc, 0x53,
+	0x63, 0xbe, 0x9b, 0x2c, 0x47, 0x57, 0xa0, 0x76, 0x68, 0xfa, 0x9d, 0x81, 0xeb, 0x61, 0xba, 0x6b,
+	0x35, 0xa3, 0x7a, 0x68, 0xfa, 0xdb, 0xae, 0x87, 0xdb, 0x5d, 0x58, 0x52, 0xe3, 0x41, 0x2d, 0x28,
+	0x1e, 0xe1, 0x53, 0xba, 0x1a, 0x75, 0x83, 0xfc, 0x8b, 0x1e, 0x40, 0xf9, 0xd8, 0xec, 0x8f, 0x30,
+	0x97, 0x78, 0x13, 0xf8, 0x92, 0xc1, 0x7e, 0xb1, 0xf0, 0xbe, 0xa6, 0x0f, 0xe0, 0xea, 0x26, 0x0e,
+	0xb6, 0x1c, 0x1f, 0x7b, 0xc1, 0x23, 0xdb, 0xe9, 0xbb, 0xbd, 0x1d, 0x33, 0x38, 0x9c, 0x42, 0xf4,
+	0xc5, 0xa4, 0x58, 0x21, 0x21, 0xc5, 0xf4, 0xdf, 0xd1, 0xe0, 0x9a, 0xba, 0x3f, 0xbe, 0xd7, 0x6d,
+	0xa8, 0x51, 0x22, 0x21, 0x3c, 0xa1, 0x51, 0x9e, 0x10, 0xdf, 0x44, 0x04, 0x0e, 0x09, 0x30, 0xdf,
+	0xd2, 0x04, 0x01, 0x0b, 0x8d, 0x76, 0x37, 0xf0, 0x6c, 0xa7, 0xf7, 0xc4, 0xf6, 0x03, 0x83, 0xc1,
+	0x4b, 0x04, 0x54, 0xcc, 0x2f, 0x7a, 0x7e, 0x59, 0x83, 0xeb, 0x9b, 0x38, 0x58, 0x17, 0x3c, 0x44,
+	0xea, 0x6d, 0x3f, 0xb0, 0xbb, 0xfe, 0xcb, 0xd5, 0x70, 0x73, 0xa8, 0x52, 0xfa, 0xaf, 0x69, 0x70,
+	0x23, 0x73, 0x30, 0x7c, 0xe9, 0xf8, 0x09, 0x11, 0x9e, 0x9f, 0x6a, 0xfe, 0xfe, 0x06, 0x3e, 0x7d,
+	0x4e, 0x36, 0x7f, 0xc7, 0xb4, 0x3d, 0x76, 0x42, 0x9c, 0xf3, 0xbc, 0xfc, 0x5d, 0x0d, 0x5e, 0xd9,
+	0xc4, 0xc1, 0x4e, 0xa8, 0x3d, 0x7c, 0x8e, 0xab, 0x43, 0x60, 0x24, 0x2d, 0x26, 0x54, 0xa3, 0x63,
+	0x65, 0xfa, 0xaf, 0xb2, 0xed, 0x54, 0x8e, 0xf7, 0x73, 0x59, 0xc0, 0xeb, 0x94, 0x13, 0x24, 0xe9,
+	0xc1, 0x99, 0x9d, 0x2f, 0x9f, 0xfe, 0x83, 0x32, 0xcc, 0x3c, 0xe7, 0x02, 0x83, 0xea, 0x07, 0xc9,
+	0x95, 0xd0, 0xd4, 0x2a, 0x9e, 0xa4, 0x2b, 0xaa, 0xd4, 0xc7, 0x47, 0x30, 0xeb, 0x63, 0x7c, 0x74,
+	0x46, 0x6d, 0x60, 0x86, 0xb4, 0x11, 0x47, 0xf9, 0x13, 0x98, 0x1f, 0x39, 0xf4, 0xfe, 0x81, 0x2d,
+	0x3e, 0x01, 0xb6, 0xe8, 0x93, 0xe5, 0x6c, 0xba, 0x21, 0xfa, 0x88, 0x5f, 0x71, 0x24, 0x5c, 0xe5,
+	0x5c, 0xb8, 0x92, 0xcd, 0xd0, 0x16, 0xb4, 0x2c, 0xcf, 0x1d, 0x0e, 0xb1, 0x15, 0x9e, 0x49, 0xfe,
+	0x4a, 0x25, 0x1f, 0x2a, 0xde, 0x4e, 0xa0, 0xba, 0x0f, 0x0b, 0xc9, 0x91, 0x6e, 0x59, 0x44, 0xeb,
+	0x25, 0x94, 0xa5, 0xaa, 0x42, 0x6f, 0xc3, 0x7c, 0x1a, 0xbe, 0x46, 0xe1, 0xd3, 0x15, 0xe8, 0x1d,
+	0x40, 0x89, 0xa1, 0x12, 0xf0, 0x3a, 0x03, 0x8f, 0x0f, 0x86, 0x83, 0xd3, 0xab, 0x77, 0x1c, 0x1c,
+	0x18, 0x38, 0xaf, 0x91, 0xc0, 0xb7, 0x88, 0xee, 0x10, 0x03, 0xf7, 0x57, 0x1a, 0xf9, 0x16, 0x22,
+	0x8e, 0xcc, 0xd7, 0x7f, 0xa8, 0xc1, 0xd2, 0x27, 0x66, 0xd0, 0x3d, 0xdc, 0x18, 0x70, 0x02, 0x9d,
+	0x82, 0xc1, 0xbf, 0x0c, 0xf5, 0x63, 0x4e, 0x8c, 0xa1, 0x14, 0xbf, 0xa1, 0x18, 0x90, 0x4c, 0xf6,
+	0x46, 0xd4, 0x82, 0x5c, 0xf7, 0x16, 0x1f, 0x4b, 0xd7, 0xde, 0xcf, 0x41, 0xd4, 0x4c, 0xb8, 0xaf,
+	0xeb, 0x2f, 0x00, 0xf8, 0xe0, 0xb6, 0xfd, 0xde, 0x39, 0xc6, 0xf5, 0x3e, 0x54, 0x39, 0x36, 0x2e,
+	0x4b, 0x26, 0x6d, 0x58, 0x08, 0xae, 0xff, 0x49, 0x15, 0x1a, 0x52, 0x05, 0x6a, 0x42, 0x41, 0x08,
+	0x89, 0x82, 0x62, 0x76, 0x85, 0xc9, 0x37, 0xc4, 0x62, 0xfa, 0x86, 0x78, 0x1b, 0x9a, 0x36, 0x3d,
+	0xbc, 0x3b, 0x7c, 0x57, 0xa8, 0xd6, 0x52, 0x37, 0x66, 0x59, 0x29, 0x27, 0x11, 0x74, 0x1d, 0x1a,
+	0xce, 0x68, 0xd0, 0x71, 0x0f, 0x3a, 0x9e, 0x7b, 0xe2, 0xf3, 0xab, 0x66, 0xdd, 0x19, 0x0d, 0x3e,
+	0x3e, 0x30, 0xdc, 0x13, 0x3f, 0xba, 0xcd, 0x54, 0xce, 0x78, 0x9b, 0xb9, 0x0e, 0x8d, 0x81, 0xf9,
+	0x82, 0x60, 0xed, 0x38, 0xa3, 0x01, 0x57, 0x38, 0xeb, 0x03, 0xf3, 0x85, 0xe1, 0x9e, 0x3c, 0x1d,
+	0x0d, 0xd0, 0x2a, 0xb4, 0xfa, 0xa6, 0x1f, 0x74, 0xe4, 0x6b, 0x6c, 0x8d, 0x5e, 0x63, 0x9b, 0xa4,
+	0xfc, 0xc3, 0xe8, 0x2a, 0x9b, 0xbe, 0x17, 0xd5, 0xcf, 0x77, 0x2f, 0xb2, 0x06, 0xfd, 0x08, 0x07,
+	0xe4, 0xba, 0x17, 0x59, 0x83, 0xbe, 0xc0, 0xf0, 0x3e, 0x54, 0xf7, 0xa9, 0x22, 0x34, 0x8e, 0x45,
+	0xa9, 0x92, 0xcc, 0xf4, 0x25, 0x23, 0x04, 0x47, 0x5f, 0x82, 0x3a, 0x3d, 0x7f, 0x68, 0xdb, 0x99,
+	0x5c, 0x6d, 0xa3, 0x06, 0xa4, 0xb5, 0x85, 0xfb, 0x81, 0x49, 0x5b, 0xcf, 0xe6, 0x6b, 0x2d, 0x1a,
+	0x10, 0xf9, 0xd8, 0xf5, 0xb0, 0x19, 0x60, 0xeb, 0xd1, 0xe9, 0xba, 0x3b, 0x18, 0x9a, 0x94, 0x84,
+	0x56, 0x9a, 0x54, 0x85, 0x55, 0x55, 0xa1, 0xd7, 0xa1, 0xd9, 0x15, 0x5f, 0x8f, 0x3d, 0x77, 0xb0,
+	0x32, 0x47, 0xb9, 0x27, 0x51, 0x8a, 0x5e, 0x01, 0x08, 0x25, 0xa3, 0x19, 0xac, 0xb4, 0xe8, 0xde,
+	0xd5, 0x79, 0xc9, 0x43, 0x6a, 0x9b, 0xb2, 0xfd, 0x0e, 0xb3, 0x02, 0xd9, 0x4e, 0x6f, 0x65, 0x9e,
+	0xf6, 0xd8, 0x08, 0xcd, 0x46, 0xb6, 0xd3, 0x43, 0xcb, 0x50, 0xb5, 0xfd, 0xce, 0x81, 0x79, 0x84,
+	0x57, 0x10, 0xad, 0xad, 0xd8, 0xfe, 0x63, 0xf3, 0x08, 0xa3, 0x9f, 0x81, 0x25, 0xec, 0x74, 0xbd,
+	0xd3, 0x21, 0xe9, 0xac, 0x73, 0x84, 0x4f, 0x3b, 0xc7, 0xd8, 0xf3, 0xc9, 0xb8, 0x17, 0x28, 0x1d,
+	0x2d, 0x46, 0xb5, 0xe4, 0x98, 0x67, 0x75, 0xe8, 0x3d, 0x28, 0xf7, 0xf1, 0x31, 0xee, 0xaf, 0x2c,
+	0x52, 0x5a, 0xbd, 0x91, 0xcd, 0x90, 0x4f, 0x08, 0x98, 0xc1, 0xa0, 0xd1, 0xd7, 0x61, 0x0e, 0xbf,
+	0x60, 0x2a, 0x69, 0xc7, 0x77, 0x47, 0x5e, 0x17, 0xaf, 0x5c, 0xa6, 0xc4, 0xf1, 0xaa, 0x02, 0xc1,
+	0x87, 0x1c, 0x72, 0x97, 0x02, 0x1a, 0x4d, 0x1c, 0xfb, 0xd6, 0x7f, 0xa0, 0x41, 0x33, 0x0e, 0x42,
+	0xee, 0x64, 0x07, 0x76, 0x1f, 0x33, 0x65, 0xa5, 0x6e, 0xb0, 0x0f, 0x74, 0x15, 0xea, 0xfe, 0xa1,
+	0xe9, 0x59, 0x94, 0x39, 0x08, 0x87, 0x97, 0x8d, 0x1a, 0x2d, 0x20, 0xbc, 0x71, 0x03, 0x1a, 0xac,
+	0x92, 0xca, 0x78, 0xca, 0xdd, 0x65, 0x03, 0x68, 0xd1, 0x16, 0x29, 0x21, 0xc2, 0xcd, 0xc3, 0x3d,
+	0xdb, 0x0f, 0xb0, 0x87, 0x2d, 0x7e, 0x1d, 0x91, 0x4a, 0xf4, 0xef, 0xc2, 0x62, 0xc4, 0x93, 0x12,
+	0x13, 0xa4, 0x59, 0x49, 0x3b, 0x07, 0x2b, 0x8d, 0xbf, 0x39, 0xfc, 0xa4, 0x0c, 0x4b, 0xbb, 0xe6,
+	0x31, 0xbe, 0xf8, 0x4b, 0x4a, 0xae, 0x73, 0xe0, 0x09, 0xcc, 0xd3, 0x7b, 0xc9, 0x9a, 0x34, 0x9e,
+	0x31, 0x2a, 0x90, 0xcc, 0x45, 0xe9, 0x86, 0xe8, 0xab, 0x44, 0x6d, 0xc3, 0xdd, 0xa3, 0x1d, 0x72,
+	0xc7, 0x0b, 0xd5, 0x9f, 0x57, 0x14, 0x78, 0xd6, 0x05, 0x94, 0x21, 0xb7, 0x40, 0x3b, 0x30, 0x17,
+	0xdf, 0x81, 0x50, 0xf1, 0x79, 0x63, 0xac, 0x79, 0x23, 0x5a, 0x7d, 0xa3, 0x19, 0xdb, 0x0c, 0x1f,
+	0xad, 0x40, 0x95, 0x6b, 0x2d, 0x54, 0xc8, 0xd6, 0x8c, 0xf0, 0x13, 0xed, 0xc0, 0x02, 0x9b, 0xc1,
+	0x2e, 0x97, 0x25, 0x6c, 0xf2, 0xb5, 0x5c, 0x93, 0x57, 0x35, 0x8d, 0x8b, 0xa2, 0xfa, 0x59, 0x45,
+	0xd1, 0x0a, 0x54, 0xb9, 0x78, 0xa0, 0xd2, 0xb7, 0x66, 0x84, 0x9f, 0x64, 0x9b, 0x23, 0x41, 0xd1,
+	0xa0, 0x75, 0x51, 0x01, 0x69, 0x17, 0x9e, 0x61, 0x33, 0xf4, 0x0c, 0x0b, 0x3f, 0xa9, 0x60, 0xc5,
+	0xbd, 0x0e, 0xe3, 0xfa, 0xd9, 0x7c, 0x5c, 0x5f, 0xf3, 0x71, 0x8f, 0xfe, 0x97, 0x3c, 0x44, 0x9b,
+	0xe9, 0x43, 0x34, 0x5b, 0x0e, 0xcd, 0x65, 0xcb, 0x21, 0xfd, 0x97, 0x34, 0x80, 0x68, 0xff, 0x27,
+	0x98, 0x0b, 0xbf, 0x00, 0x35, 0xc1, 0x8c, 0xb9, 0x6c, 0x02, 0x02, 0x3c, 0x79, 0x76, 0x17, 0x13,
+	0x67, 0xb7, 0xfe, 0x1f, 0x34, 0x98, 0xd9, 0x20, 0xab, 0xff, 0xc4, 0xed, 0x51, 0x4d, 0xe3, 0x36,
+	0x34, 0x3d, 0xdc, 0x75, 0x3d, 0xab, 0x83, 0x9d, 0xc0, 0xb3, 0x31, 0xb3, 0xd3, 0x94, 0x8c, 0x59,
+	0x56, 0xfa, 0x21, 0x2b, 0x24, 0x60, 0xe4, 0x38, 0xf6, 0x03, 0x73, 0x30, 0xec, 0x1c, 0x90, 0x03,
+	0xa0, 0xc0, 0xc0, 0x44, 0x29, 0x95, 0xff, 0xaf, 0xc2, 0x4c, 0x04, 0x16, 0xb8, 0xb4, 0xff, 0x92,
+	0xd1, 0x10, 0x65, 0x7b, 0x2e, 0x7a, 0x0d, 0x9a, 0x74, 0xfb, 0x3b, 0x7d, 0xb7, 0xd7, 0x21, 0x57,
+	0x7c, 0xae, 0x84, 0xcc, 0x58, 0x7c, 0x58, 0x84, 0xac, 0xe2, 0x50, 0xbe, 0xfd, 0x5d, 0xcc, 0xd5,
+	0x10, 0x01, 0xb5, 0x6b, 0x7f, 0x17, 0xeb, 0x7f, 0x45, 0x83, 0x59, 0xae, 0xb5, 0xec, 0x8a, 0xa7,
+	0x1c, 0x6a, 0x7b, 0x67, 0xe6, 0x15, 0xfa, 0x3f, 0xfa, 0x62, 0xdc, 0xfa, 0xfa, 0x9a, 0x92, 0x35,
+	0x29, 0x12, 0xaa, 0x2b, 0xc7, 0x54, 0x96, 0x3c, 0xf7, 0xfb, 0xef, 0x93, 0x35, 0x35, 0x03, 0xf3,
+	0xa9, 0x6b, 0x31, 0x63, 0xf0, 0x0a, 0x54, 0x4d, 0xcb, 0xf2, 0xb0, 0xef, 0xf3, 0x71, 0x84, 0x9f,
+	0xa4, 0x26, 0xa4, 0x16, 0x26, 0xb9, 0xc2, 0x4f, 0xf4, 0x25, 0xa8, 0x09, 0xe5, 0x9a, 0x99, 0xac,
+	0x6e, 0x66, 0x8f, 0x93, 0xdf, 0x46, 0x45, 0x0b, 0xfd, 0x5f, 0x15, 0xa0, 0xc9, 0x29, 0xfa, 0x11,
+	0x57, 0x30, 0xc6, 0x93, 0xd8, 0x23, 0x98, 0x39, 0x88, 0x38, 0x72, 0x9c, 0xa1, 0x4d, 0x66, 0xdc,
+	0x58, 0x9b, 0x49, 0xb4, 0x16, 0x57, 0x71, 0x4a, 0x53, 0xa9, 0x38, 0xe5, 0xb3, 0xca, 0x95, 0xb4,
+	0xaa, 0x5b, 0x51, 0xa8, 0xba, 0xfa, 0x9f, 0x87, 0x86, 0x84, 0x80, 0xca, 0x4d, 0x66, 0xb0, 0xe2,
+	0x2b, 0x16, 0x7e, 0xa2, 0x07, 0x91, 0xa2, 0xc7, 0x96, 0xea, 0x8a, 0x62, 0x2c, 0x09, 0x1d, 0x4f,
+	0xff, 0xb1, 0x06, 0x15, 0x8e, 0xf9, 0x06, 0x34, 0x38, 0x7f, 0xd1, 0xd3, 0x9d, 0x61, 0x07, 0x5e,
+	0x44, 0xce, 0xf7, 0x97, 0xc7, 0x60, 0x57, 0xa0, 0x96, 0x60, 0xad, 0x2a, 0x17, 0xd6, 0x61, 0x95,
+	0xc4, 0x4f, 0xa4, 0x8a, 0xb0, 0x12, 0x35, 0x13, 0xbb, 0x3d, 0xf1, 0x54, 0xc7, 0x3e, 0xf4, 0x3f,
+	0xd2, 0xe8, 0xcb, 0x8a, 0x81, 0xbb, 0xee, 0x31, 0xf6, 0x4e, 0xa7, 0xb7, 0xec, 0x7e, 0x20, 0x91,
+	0x79, 0xce, 0x3b, 0xa4, 0x68, 0x80, 0x3e, 0x88, 0x36, 0xa1, 0xa8, 0xb2, 0xf2, 0xc8, 0x82, 0x9d,
+	0x13, 0x69, 0xb4, 0x19, 0xbf, 0xae, 0x51, 0x1b, 0x75, 0x7c, 0x2a, 0xe7, 0xd5, 0x41, 0x5e, 0xca,
+	0x7d, 0x4c, 0xff, 0x77, 0x1a, 0x5c, 0xc9, 0x58, 0xdd, 0xe7, 0x6b, 0x9f, 0xc3, 0xfa, 0x7e, 0x11,
+	0x6a, 0xc2, 0xe2, 0x50, 0xcc, 0x65, 0x71, 0x10, 0xf0, 0xfa, 0x6f, 0xb0, 0xc7, 0x1e, 0xc5, 0xf2,
+	0x3e, 0x5f, 0xbb, 0xa0, 0x05, 0x4e, 0x5a, 0x0e, 0x8b, 0x0a, 0xcb, 0xe1, 0x7f, 0xd4, 0xa0, 0x1d,
+	0x59, 0xea, 0xfc, 0x47, 0xa7, 0xd3, 0xbe, 0x0e, 0xbe, 0x9c, 0x9b, 0x78, 0xf4, 0x9e, 0x53, 0x3a,
+	0xe3, 0x7b, 0x8e, 0xee, 0x50, 0xa3, 0x7f, 0x7a, 0x42, 0xd3, 0x70, 0x65, 0x5b, 0xda, 0x78, 0xf6,
+	0x98, 0x15, 0x6d, 0xec, 0x8f, 0x19, 0x91, 0x3e, 0x8e, 0x9b, 0xeb, 0x3e, 0xef, 0x05, 0x94, 0x1f,
+	0xd8, 0x0e, 0xf9, 0x03, 0x5b, 0x29, 0xf1, 0xc0, 0xc6, 0xcb, 0xf5, 0x01, 0x25, 0x81, 0xd4, 0x04,
+	0x2e, 0x6a, 0xc1, 0xfe, 0xaa, 0x06, 0x2b, 0xbc, 0x17, 0xda, 0x27, 0xb9, 0x46, 0xf7, 0x71, 0x80,
+	0xad, 0x9f, 0xb6, 0x51, 0xe9, 0xff, 0x14, 0xa0, 0x25, 0x2b, 0x36, 0x54, 0x37, 0x79, 0x0f, 0xca,
+	0xd4, 0x26, 0xc7, 0x47, 0x30, 0x51, 0x3a, 0x30, 0x68, 0x72, 0x32, 0xd2, 0x3b, 0xc6, 0x9e, 0x1f,
+	0x2a, 0x2e, 0xfc, 0x33, 0xd2, 0xae, 0x8a, 0x67, 0xd7, 0xae, 0xae, 0x41, 0x9d, 0x9c, 0x5c, 0xee,
+	0x88, 0xe0, 0x65, 0xef, 0x9e, 0x51, 0x01, 0xfa, 0x32, 0x54, 0x98, 0x2f, 0x13, 0x7f, 0x74, 0xbe,
+	0x1d, 0x47, 0xcd, 0xfd, 0x9c, 0xa4, 0x67, 0x15, 0x5a, 0x60, 0xf0, 0x46, 0x64, 0x8f, 0x86, 0x9e,
+	0xdb, 0xa3, 0x6a, 0x58, 0x85, 0xdd, 0xa6, 0xc3, 0x6f, 0xb4, 0x04, 0x95, 0xa1, 0xdb, 0xb7, 0xbb,
+	0xa7, 0xf4, 0x7e, 0x54, 0x37, 0xf8, 0x17, 0xfa, 0x08, 0xaa, 0x87, 0xb6, 0x1f, 0xb8, 0xde, 0x29,
+	0xbf, 0x12, 0xdd, 0xcd, 0x33, 0x9d, 0x3d, 0xcf, 0x74, 0xb8, 0x26, 0x1e, 0x36, 0xd7, 0xbf, 0x0e,
+	0x4b, 0x91, 0xfd, 0x84, 0x4d, 0xfa, 0xbc, 0x2c, 0xa3, 0xff, 0x83, 0x02, 0x2c, 0xec, 0x9e, 0x3a,
+	0xdd, 0x24, 0xf3, 0x91, 0x59, 0xf4, 0xcd, 0xe8, 0x39, 0x81, 0x7f, 0x51, 0x47, 0x14, 0xd6, 0x37,
+	0xb6, 0x88, 0x92, 0xc0, 0x76, 0xac, 0x21, 0xca, 0xf6, 0xdc, 0x89, 0xba, 0xdb, 0x6d, 0x61, 0xf0,
+	0xc1, 0x16, 0x53, 0x47, 0x98, 0xb9, 0x74, 0x56, 0x94, 0x52, 0x75, 0xe4, 0xcb, 0x00, 0x54, 0x63,
+	0xeb, 0x9c, 0x45, 0x4b, 0xa3, 0x2d, 0x9e, 0x10, 0x2d, 0x2d, 0xe9, 0x31, 0x53, 0x49, 0x3f, 0x79,
+	0xbc, 0x2a, 0x09, 0xf9, 0x8e, 0x6d, 0x71, 0xa3, 0xa1, 0x24, 0x0b, 0x2c, 0xfd, 0x1f, 0x15, 0x61,
+	0x45, 0x5a, 0xeb, 0x9f, 0xb6, 0x1a, 0x9c, 0x71, 0xa5, 0x2e, 0xbe, 0xa4, 0x2b, 0x75, 0x69, 0x7a,
+	0xd5, 0xb7, 0xac, 0xb2, 0xf2, 0x26, 0x64, 0x6c, 0x25, 0x2d, 0x63, 0x15, 0x46, 0xb0, 0xea, 0x79,
+	0x8d, 0x60, 0x7f, 0x50, 0x84, 0x66, 0xb4, 0x47, 0x3b, 0x7d, 0xd3, 0xc9, 0xa4, 0xde, 0x5d, 0x68,
+	0xfa, 0xb1, 0x3d, 0xe4, 0xbb, 0xf2, 0x96, 0x8a, 0x15, 0x33, 0xb6, 0xdd, 0x48, 0xa0, 0x40, 0xaf,
+	0x50, 0x42, 0xf5, 0x02, 0x66, 0x54, 0x66, 0x5a, 0x73, 0x9d, 0x89, 0x30, 0x7b, 0x80, 0xd1, 0xdb,
+	0x80, 0xb8, 0xdc, 0xe9, 0xd8, 0x4e, 0xc7, 0xc7, 0x5d, 0xd7, 0xb1, 0x98, 0x44, 0x2a, 0x1b, 0x2d,
+	0x5e, 0xb3, 0xe5, 0xec, 0xb2, 0x72, 0xf4, 0x1e, 0x94, 0x82, 0xd3, 0x21, 0x53, 0xa1, 0x9b, 0xca,
+	0xd5, 0x88, 0xc6, 0xb5, 0x77, 0x3a, 0xc4, 0x06, 0x05, 0x0f, 0x9d, 0xfc, 0x02, 0xcf, 0x3c, 0xe6,
+	0xf7, 0x91, 0x92, 0x21, 0x95, 0xc8, 0x36, 0x8d, 0x6a, 0xdc, 0xa6, 0x41, 0xb9, 0x31, 0x14, 0x73,
+	0x9d, 0x20, 0xe8, 0x53, 0xb3, 0x38, 0xe5, 0xc6, 0xb0, 0x74, 0x2f, 0xe8, 0x93, 0x49, 0x06, 0x6e,
+	0x60, 0xf6, 0x19, 0x4f, 0xd7, 0xb9, 0x3c, 0x25, 0x25, 0x94, 0xa7, 0xb3, 0x2d, 0x17, 0x30, 0xc6,
+	0x72, 0xf1, 0xc3, 0x22, 0xb4, 0xa2, 0xe9, 0x18, 0xd8, 0x1f, 0xf5, 0xb3, 0x25, 0xcf, 0x78, 0xdb,
+	0xdc, 0x24, 0xa1, 0xf3, 0x55, 0x68, 0x70, 0xca, 0x3d, 0x03, 0xe5, 0x03, 0x6b, 0xf2, 0x64, 0x0c,
+	0x2b, 0x96, 0x5f, 0x12, 0x2b, 0x56, 0xce, 0x61, 0xdd, 0xca, 0xd8, 0xd1, 0xec, 0xbd, 0xa8, 0x8d,
+	0xd9, 0x8b, 0xdf, 0xd1, 0xe0, 0x72, 0xea, 0x54, 0x19, 0xbb, 0x21, 0xe3, 0x6d, 0x1f, 0xfc, 0xb4,
+	0x49, 0xa2, 0xe4, 0xa7, 0xf3, 0x07, 0x50, 0xf1, 0x28, 0x76, 0xfe, 0xcc, 0x7c, 0x6b, 0x2c, 0xa1,
+	0xb3, 0x81, 0x18, 0xbc, 0x89, 0xfe, 0xb7, 0x35, 0x58, 0x4e, 0x0f, 0x75, 0x0a, 0x95, 0xeb, 0x11,
+	0x54, 0x19, 0xea, 0x50, 0x1e, 0xac, 0x8e, 0x97, 0x07, 0xd1, 0xe2, 0x18, 0x61, 0x43, 0x7d, 0x17,
+	0x96, 0x42, 0xcd, 0x2c, 0xda, 0xb0, 0x6d, 0x1c, 0x98, 0x63, 0x6e, 0xfe, 0x37, 0xa0, 0xc1, 0xae,
+	0x90, 0xec, 0x46, 0xcd, 0x5e, 0xe5, 0x61, 0x5f, 0x18, 0x80, 0xf5, 0xff, 0xaa, 0xc1, 0x22, 0xd5,
+	0x05, 0x92, 0x4f, 0xac, 0x79, 0xde, 0xfc, 0x75, 0x71, 0x02, 0x92, 0xd3, 0x8e, 0x4d, 0xad, 0x6e,
+	0xc4, 0xca, 0xd0, 0x56, 0xda, 0x3e, 0xac, 0xb4, 0x10, 0x45, 0x4e, 0x0e, 0x1b, 0x66, 0x60, 0x52,
+	0x1f, 0x87, 0xa4, 0x61, 0x38, 0x52, 0xa9, 0x4a, 0xe7, 0x50, 0xa9, 0xf4, 0x27, 0x70, 0x39, 0x31,
+	0xd3, 0x29, 0x76, 0x54, 0xff, 0x67, 0x1a, 0xd9, 0x8e, 0x98, 0x7f, 0xe0, 0xf9, 0xaf, 0x15, 0xaf,
+	0x88, 0xb7, 0x5d, 0xa2, 0x25, 0x24, 0x44, 0x8f, 0x85, 0xbe, 0x02, 0x75, 0x07, 0x9f, 0x74, 0x64,
+	0x4d, 0x35, 0xc7, 0x9d, 0xab, 0xe6, 0xe0, 0x13, 0xfa, 0x9f, 0xfe, 0x14, 0x96, 0x53, 0x43, 0x9d,
+	0x66, 0xee, 0xff, 0x46, 0x83, 0x2b, 0x1b, 0x9e, 0x3b, 0x7c, 0x6e, 0x7b, 0xc1, 0xc8, 0xec, 0xc7,
+	0xdd, 0x47, 0xce, 0x31, 0xfd, 0x1c, 0xbe, 0xc7, 0x1f, 0xa5, 0x6e, 0xf7, 0x6f, 0x2b, 0x38, 0x28,
+	0x3d, 0x28, 0x3e, 0x69, 0xe9, 0x86, 0xf3, 0xa7, 0x45, 0xd5, 0xe0, 0x39, 0xdc, 0x04, 0x8d, 0x2b,
+	0xcf, 0xf5, 0x4f, 0xf9, 0x3e, 0x53, 0x3c, 0xef, 0xfb, 0x4c, 0xc6, 0xa1, 0x50, 0x7a, 0x49, 0x87,
+	0xc2, 0x99, 0x4d, 0x93, 0xeb, 0x10, 0x7f, 0x3b, 0xa3, 0x9a, 0xc0, 0x59, 0xdf, 0xdb, 0xbe, 0x0c,
+	0x10, 0x3d, 0x21, 0x71, 0xb5, 0x6c, 0x02, 0x06, 0xa9, 0x01, 0xd9, 0x23, 0x71, 0xec, 0xf2, 0x13,
+	0x47, 0x7a, 0x24, 0xf8, 0x26, 0xb4, 0x55, 0xb4, 0x39, 0x0d, 0xbd, 0xff, 0x71, 0x01, 0x60, 0x4b,
+	0x78, 0xff, 0x9f, 0xef, 0x04, 0xb8, 0x05, 0x92, 0xbe, 0x13, 0x71, 0xb9, 0x4c, 0x3b, 0x56, 0xea,
+	0xbe, 0x90, 0xb2, 0x1d, 0x58, 0x14, 0x8f, 0xc4, 0x2b, 0x8c, 0x14, 0x92, 0x42, 0xf7, 0x2a, 0xd4,
+	0x3d, 0xf7, 0xa4, 0x43, 0x98, 0xcb, 0x0a, 0xc3, 0x1b, 0x3c, 0xf7, 0x84, 0xb0, 0x9c, 0x85, 0x96,
+	0xa1, 0x1a, 0x98, 0xfe, 0x11, 0xc1, 0xcf, 0xf4, 0xe6, 0x0a, 0xf9, 0xdc, 0xb2, 0xa2, 0x87, 0xdd,
+	0xaa, 0xfc, 0xb0, 0xfb, 0x73, 0xa1, 0x3b, 0x6b, 0x2d, 0xb7, 0x6f, 0x1a, 0xf3, 0x68, 0xbd, 0x05,
+	0xb3, 0x84, 0x92, 0xc8, 0x20, 0x18, 0x5b, 0xb7, 0xf8, 0x53, 0x09, 0x2f, 0x24, 0x43, 0xd5, 0xff,
+	0x48, 0x83, 0xb9, 0x68, 0x69, 0xa9, 0x6c, 0x22, 0xe2, 0x8e, 0x8a, 0xba, 0x75, 0xd7, 0x62, 0x52,
+	0xa4, 0x99, 0x71, 0x58, 0xb0, 0x86, 0x4c, 0xa0, 0x45, 0x4d, 0xc6, 0xd9, 0x37, 0xc8, 0xe4, 0xc9,
+	0xca, 0xd8, 0x56, 0x68, 0x71, 0xab, 0x78, 0xee, 0xc9, 0x96, 0x25, 0x96, 0x8c, 0x05, 0x38, 0xb0,
+	0xdb, 0x3c, 0x59, 0xb2, 0x75, 0x1a, 0xe3, 0x70, 0x0b, 0x66, 0xb1, 0xe7, 0xb9, 0x5e, 0x67, 0x80,
+	0x7d, 0xdf, 0xec, 0x61, 0x7e, 0x29, 0x99, 0xa1, 0x85, 0xdb, 0xac, 0x4c, 0xff, 0xc3, 0x0a, 0x34,
+	0xa3, 0xa9, 0x84, 0x9e, 0x30, 0xb6, 0x15, 0x7a, 0xc2, 0xd8, 0x64, 0x7f, 0xc1, 0x63, 0x52, 0x52,
+	0x50, 0xc0, 0xa3, 0xc2, 0x8a, 0x66, 0xd4, 0x79, 0xe9, 0x96, 0x45, 0x4e, 0x6c, 0xb2, 0x40, 0x8e,
+	0x6b, 0xe1, 0x88, 0x02, 0x20, 0x2c, 0xe2, 0x04, 0x10, 0x23, 0xa4, 0x52, 0x0e, 0x42, 0x2a, 0xe7,
+	0x20, 0xa4, 0x8a, 0x82, 0x90, 0x96, 0xa0, 0xb2, 0x3f, 0xea, 0x1e, 0xe1, 0x20, 0x34, 0x35, 0xb0,
+	0xaf, 0x38, 0x81, 0xd5, 0x12, 0x04, 0x26, 0xe8, 0xa8, 0x9e, 0x70, 0x10, 0x60, 0xce, 0x19, 0x9d,
+	0xc0, 0xe7, 0x3a, 0x7b, 0x8d, 0x15, 0xec, 0xf9, 0xe8, 0xfd, 0x50, 0xd3, 0x6b, 0x50, 0x8e, 0xd2,
+	0x15, 0x02, 0x29, 0x41, 0x25, 0xa1, 0x9e, 0xf7, 0x06, 0xcc, 0x49, 0xcb, 0x41, 0xe9, 0x8c, 0xbd,
+	0xa9, 0x4a, 0x97, 0x0e, 0x7a, 0x82, 0xdc, 0x86, 0x66, 0xb4, 0x24, 0x14, 0x6e, 0x96, 0xdd, 0x2c,
+	0x45, 0x29, 0x05, 0x13, 0xe4, 0xde, 0x3c, 0x23, 0xb9, 0x5f, 0x81, 0x1a, 0xbf, 0xa4, 0xf9, 0xfc,
+	0x31, 0x55, 0x58, 0x99, 0xf2, 0x70, 0x02, 0xba, 0x0c, 0x95, 0x4f, 0xdd, 0x7d, 0xb2, 0x59, 0xf3,
+	0xec, 0x11, 0xe3, 0x53, 0x77, 0x9f, 0xd1, 0x83, 0x87, 0x03, 0xef, 0x94, 0x53, 0x26, 0x62, 0xf4,
+	0x40, 0x8b, 0x18, 0x6d, 0xae, 0x73, 0x61, 0xca, 0x1c, 0xc6, 0x17, 0x32, 0x95, 0x5d, 0xb6, 0x7e,
+	0x91, 0x43, 0xb7, 0x21, 0x35, 0x43, 0x06, 0x20, 0x33, 0x08, 0xf0, 0x60, 0x18, 0xc8, 0xde, 0xe7,
+	0x8b, 0xf9, 0x91, 0xcd, 0xf3, 0xe6, 0x92, 0x83, 0xf9, 0x3b, 0x80, 0x2c, 0xdb, 0xef, 0x9a, 0x9e,
+	0x25, 0x3b, 0x03, 0x5e, 0xe6, 0x8e, 0x86, 0x61, 0x8d, 0x70, 0xf7, 0xfb, 0x1e, 0xb4, 0x92, 0x58,
+	0x33, 0x5c, 0x4d, 0xc6, 0xf1, 0x77, 0x8c, 0x8d, 0x8b, 0x09, 0x36, 0xbe, 0x02, 0x35, 0x73, 0x14,
+	0xb8, 0x94, 0xfb, 0x99, 0x45, 0xa8, 0x4a, 0xbe, 0xb7, 0x2c, 0x5f, 0xff, 0x14, 0x50, 0x44, 0x60,
+	0xd3, 0xe9, 0xfa, 0x09, 0x0e, 0x2e, 0x24, 0x39, 0x58, 0xff, 0xe7, 0x1a, 0xcc, 0xcb, 0x9d, 0x9d,
+	0x57, 0x6d, 0xfa, 0x0a, 0x34, 0x98, 0x4f, 0x41, 0x87, 0x08, 0x70, 0xf5, 0x63, 0x7b, 0x82, 0x75,
+	0x0c, 0x88, 0xa2, 0xd8, 0x08, 0x59, 0x9e, 0xb8, 0xde, 0x91, 0xed, 0xf4, 0x3a, 0x64, 0x64, 0xe2,
+	0x0d, 0x82, 0x17, 0x3e, 0x25, 0x65, 0xfa, 0xaf, 0x68, 0x70, 0xfd, 0xd9, 0xd0, 0x32, 0x03, 0x2c,
+	0xe9, 0x8f, 0xd3, 0xba, 0x5b, 0x0b, 0x7f, 0xe7, 0xc2, 0x18, 0x26, 0x93, 0xfa, 0xf3, 0xb9, 0xbf,
+	0x33, 0xd1, 0xba, 0xf9, 0x68, 0x52, 0x01, 0x0a, 0xe7, 0x1f, 0x4d, 0x1b, 0x6a, 0xc7, 0x1c, 0x5d,
+	0x18, 0x97, 0x17, 0x7e, 0xc7, 0xbc, 0x19, 0x8a, 0x67, 0xf2, 0x66, 0xd0, 0x7f, 0x43, 0x83, 0x2b,
+	0x06, 0xf6, 0xb1, 0x63, 0xc5, 0x66, 0x72, 0xa1, 0x6f, 0x0f, 0x49, 0x4d, 0xba, 0x98, 0xd2, 0xa4,
+	0xf5, 0x21, 0xb4, 0x55, 0xa3, 0x9a, 0x86, 0xe2, 0xd9, 0xf5, 0xa5, 0xe3, 0x11, 0xb4, 0x01, 0x67,
+	0x49, 0xa2, 0x35, 0xd3, 0x7e, 0x02, 0xfd, 0x5f, 0x14, 0x60, 0xf9, 0xa1, 0x65, 0xf1, 0xd3, 0x9a,
+	0x2b, 0xe4, 0x17, 0x75, 0x57, 0x9a, 0xbc, 0x02, 0x2f, 0xed, 0x04, 0xe5, 0xba, 0x84, 0x33, 0x1a,
+	0x84, 0x8a, 0x94, 0xc7, 0x5c, 0x41, 0x3f, 0xe0, 0xbe, 0x03, 0x9d, 0xbe, 0xdb, 0xa3, 0xca, 0xd4,
+	0x64, 0x15, 0xbb, 0x16, 0xda, 0x95, 0xf5, 0x21, 0xac, 0xa4, 0x17, 0x6b, 0x4a, 0x79, 0x14, 0xae,
+	0xc8, 0xd0, 0x65, 0x2f, 0x20, 0x33, 0x44, 0xf8, 0xd3, 0xa2, 0x1d, 0xd7, 0xd7, 0x7f, 0x5c, 0x84,
+	0x95, 0x5d, 0xf3, 0x18, 0xff, 0xd9, 0xd9, 0xa0, 0x6f, 0xc1, 0xa2, 0x6f, 0x1e, 0xe3, 0x8e, 0x64,
+	0x1b, 0xe9, 0x78, 0xf8, 0x33, 0x7e, 0x15, 0x79, 0x53, 0xf5, 0x46, 0xa5, 0x74, 0x00, 0x34, 0xe6,
+	0xfd, 0x58, 0xb9, 0x81, 0x3f, 0x43, 0xaf, 0xc3, 0x9c, 0xec, 0x97, 0x4b, 0x86, 0x56, 0xa3, 0x4b,
+	0x3e, 0x2b, 0xf9, 0xde, 0x6e, 0x59, 0x2a, 0x03, 0x75, 0xfd, 0xbc, 0x06, 0xea, 0xcf, 0xe0, 0xda,
+	0x33, 0xc7, 0xc7, 0xc1, 0x56, 0xe4, 0x8b, 0x3a, 0xa5, 0x45, 0xe2, 0x06, 0x34, 0xa2, 0x4d, 0x4c,
+	0x05, 0x09, 0x5a, 0xbe, 0xee, 0x42, 0x7b, 0xdb, 0xf4, 0x8e, 0xc2, 0xe3, 0x7b, 0x83, 0x39, 0xbe,
+	0x5d, 0x60, 0x87, 0x07, 0xc2, 0x05, 0xd4, 0xc0, 0x07, 0xd8, 0xc3, 0x4e, 0x17, 0x3f, 0x71, 0xbb,
+	0x47, 0x44, 0x45, 0x0d, 0x58, 0x9c, 0xb6, 0x26, 0xdd, 0x66, 0x36, 0xa4, 0x30, 0xec, 0x42, 0x2c,
+	0x0c, 0x7b, 0x42, 0x58, 0xbf, 0xfe, 0xbb, 0x05, 0x58, 0x7a, 0xd8, 0x0f, 0xb0, 0x17, 0x19, 0x92,
+	0xce, 0x62, 0x13, 0x8b, 0x8c, 0x54, 0x85, 0xf3, 0xbc, 0xfb, 0xe5, 0x70, 0x0b, 0x50, 0x99, 0xd4,
+	0x4a, 0xe7, 0x34, 0xa9, 0x3d, 0x04, 0x18, 0x7a, 0xee, 0x10, 0x7b, 0x81, 0x8d, 0x43, 0x6b, 0x40,
+	0x0e, 0x95, 0x57, 0x6a, 0xa4, 0x7f, 0x0b, 0x5a, 0x9b, 0xdd, 0x75, 0xd7, 0x39, 0xb0, 0xbd, 0x41,
+	0xb8, 0x50, 0x29, 0x06, 0xd6, 0x72, 0x30, 0x70, 0x21, 0xfd, 0x38, 0x66, 0xc3, 0xbc, 0x84, 0x7b,
+	0x4a, 0x21, 0xd8, 0xeb, 0x76, 0x0e, 0x6c, 0xc7, 0xa6, 0x8e, 0xa5, 0x05, 0xe6, 0x61, 0xdc, 0xeb,
+	0x3e, 0xe6, 0x25, 0xfa, 0x0f, 0x34, 0xb8, 0x6a, 0x60, 0xc2, 0x3c, 0xa1, 0x37, 0xdc, 0x5e, 0xb0,
+	0xed, 0xf7, 0xa6, 0x38, 0xaf, 0x1f, 0x40, 0x69, 0xe0, 0xf7, 0x32, 0x3c, 0x59, 0x88, 0xda, 0x10,
+	0xeb, 0xc8, 0xa0, 0xc0, 0xfa, 0x3f, 0xd5, 0xe0, 0xea, 0x98, 0x27, 0xda, 0xc8, 0x24, 0xae, 0x9d,
+	0xfd, 0xc1, 0x3a, 0x8b, 0x23, 0xf8, 0x43, 0x36, 0x75, 0xc1, 0x0a, 0xdf, 0x35, 0x44, 0x81, 0xf4,
+	0xda, 0x5c, 0x92, 0x5f, 0x9b, 0x75, 0x9f, 0x46, 0xe1, 0xc9, 0x9d, 0x7d, 0xc4, 0x5e, 0x8f, 0xcf,
+	0xbf, 0x62, 0x13, 0x63, 0xc8, 0xf4, 0x3f, 0xe0, 0xa1, 0x91, 0xaa, 0x5e, 0xa7, 0x21, 0x8f, 0xac,
+	0xa5, 0x91, 0x9e, 0xd4, 0x8b, 0xd3, 0x3d, 0xa9, 0xff, 0x96, 0x06, 0x97, 0x77, 0x71, 0x40, 0xf6,
+	0x9b, 0x12, 0xf4, 0x34, 0x94, 0x95, 0x35, 0xda, 0x0f, 0xa0, 0xda, 0x65, 0xb8, 0xd5, 0x2e, 0x66,
+	0x2a, 0x56, 0x0e, 0x5b, 0xe8, 0xfb, 0xb0, 0xf4, 0xc4, 0xf6, 0x2f, 0x74, 0x80, 0xe4, 0x32, 0xb1,
+	0x9c, 0xea, 0x64, 0x3a, 0x8f, 0x3c, 0x31, 0xe3, 0xc2, 0x99, 0x67, 0x7c, 0x02, 0xcb, 0xeb, 0x7d,
+	0x6c, 0x7a, 0x17, 0xba, 0x27, 0x08, 0x4a, 0x47, 0xf8, 0x94, 0x6d, 0x48, 0xdd, 0xa0, 0xff, 0xeb,
+	0xbf, 0x59, 0x82, 0xc5, 0xf5, 0xbe, 0xeb, 0xe0, 0x9f, 0x8e, 0x43, 0xd2, 0x3d, 0x58, 0x08, 0x4c,
+	0xaf, 0x87, 0x83, 0x8e, 0xc2, 0x1b, 0x18, 0xb1, 0xaa, 0x75, 0xb9, 0xc1, 0x77, 0x14, 0x51, 0xad,
+	0x8d, 0xb5, 0x2f, 0xa8, 0x48, 0x5f, 0x31, 0x8b, 0xbb, 0x3b, 0x52, 0x5b, 0x16, 0x7e, 0x1e, 0x3f,
+	0xbf, 0xbe, 0x29, 0xb9, 0xf9, 0xb1, 0x23, 0xe7, 0xbd, 0xbc, 0xa8, 0xc3, 0xb7, 0x1b, 0x86, 0x36,
+	0x72, 0xfe, 0x8b, 0x1f, 0xea, 0x95, 0x54, 0x4a, 0x83, 0xbb, 0xb0, 0xe0, 0x1f, 0xd9, 0x43, 0x16,
+	0x7f, 0xd2, 0x11, 0x71, 0xde, 0x2c, 0xa8, 0x72, 0x9e, 0x54, 0xd1, 0x38, 0x94, 0xc7, 0xbc, 0xa2,
+	0xfd, 0x55, 0x98, 0x4f, 0xcd, 0x42, 0x0e, 0x7e, 0x2f, 0xb2, 0xe0, 0xf7, 0x45, 0x39, 0xf8, 0xbd,
+	0x28, 0x45, 0xb7, 0xb7, 0x3f, 0x10, 0xbe, 0xdd, 0x7e, 0x56, 0xe4, 0x7c, 0xac, 0x71, 0x5d, 0x0e,
+	0x8d, 0xff, 0x89, 0x06, 0x35, 0x3a, 0xfd, 0xaf, 0xbb, 0xfb, 0xe8, 0x21, 0x54, 0xb9, 0x75, 0x90,
+	0x93, 0xc5, 0x1b, 0x39, 0x17, 0xcb, 0x08, 0xdb, 0x4d, 0x4c, 0xf8, 0xb0, 0x04, 0x95, 0x2e, 0x41,
+	0x10, 0x9a, 0x1b, 0xf9, 0x17, 0x7a, 0x0b, 0xe6, 0xc9, 0x7f, 0xb6, 0xd3, 0x93, 0x32, 0x2d, 0x30,
+	0x5d, 0xbc, 0xc5, 0x2b, 0xa2, 0x5c, 0x0b, 0x0f, 0xc2, 0x93, 0x89, 0x39, 0x16, 0xbc, 0x92, 0x39,
+	0xca, 0xc4, 0x91, 0xe4, 0x61, 0xd3, 0xe7, 0xef, 0x08, 0x75, 0x83, 0x7f, 0xe9, 0xff, 0x9a, 0x45,
+	0xeb, 0xc7, 0xa6, 0x35, 0xa5, 0x46, 0x9b, 0x87, 0x53, 0x96, 0xa1, 0x6a, 0xed, 0xcb, 0xd7, 0x92,
+	0x8a, 0xb5, 0x4f, 0x6f, 0x24, 0x0a, 0x2b, 0x63, 0x49, 0x65, 0x65, 0xd4, 0xff, 0x3b, 0x0b, 0x4e,
+	0x57, 0x0d, 0x7c, 0x1a, 0x39, 0xf7, 0x20, 0xfe, 0x12, 0x9e, 0x6f, 0x71, 0x6f, 0x43, 0x93, 0x79,
+	0x54, 0x48, 0x2f, 0x67, 0xd4, 0xf1, 0x82, 0x96, 0x8a, 0x80, 0x63, 0x32, 0x37, 0xba, 0xdf, 0x11,
+	0x1c, 0xdb, 0xe3, 0x26, 0x2b, 0x16, 0x80, 0xd1, 0x66, 0x95, 0x63, 0x9b, 0xf5, 0xbf, 0x35, 0x98,
+	0xdf, 0x36, 0x6d, 0x27, 0xc0, 0x8e, 0xe9, 0x74, 0xf1, 0x0e, 0xf3, 0x46, 0xcb, 0xa3, 0x2c, 0x13,
+	0x02, 0x13, 0x8f, 0xde, 0x9d, 0xa1, 0x39, 0xf2, 0x85, 0x6e, 0xd6, 0x8a, 0x2a, 0x76, 0x68, 0x39,
+	0xba, 0x0a, 0xf5, 0x5e, 0x37, 0x04, 0x62, 0xf9, 0x48, 0x6a, 0xbd, 0x2e, 0xaf, 0xbc, 0x07, 0x0b,
+	0x12, 0x26, 0xa2, 0x4c, 0x5b, 0xa3, 0x7e, 0xb8, 0x49, 0x28, 0xaa, 0xda, 0xe5, 0x35, 0x5c, 0x21,
+	0x14, 0x80, 0x6c, 0x46, 0xd0, 0xeb, 0x0a, 0x80, 0xd7, 0xa0, 0xd9, 0xeb, 0x76, 0x3c, 0xec, 0x8f,
+	0x06, 0x52, 0x52, 0xa2, 0xa2, 0x31, 0xd3, 0xeb, 0x1a, 0xb4, 0x70, 0xcf, 0x1e, 0x60, 0xfd, 0x6f,
+	0x6a, 0x70, 0x75, 0x17, 0x07, 0xa9, 0xe9, 0x9f, 0x9f, 0x4e, 0xbf, 0x24, 0xf4, 0x2d, 0x76, 0x81,
+	0x50, 0xa9, 0x78, 0xe9, 0xee, 0x42, 0xad, 0xcc, 0x80, 0xeb, 0xe4, 0x80, 0x4d, 0x02, 0xd8, 0x53,
+	0x78, 0x0d, 0xeb, 0x7f, 0x4f, 0x83, 0x1b, 0x99, 0x48, 0xa7, 0xa1, 0xea, 0xaf, 0x41, 0x6d, 0xc8,
+	0x11, 0xf1, 0xe3, 0x3b, 0xdf, 0x64, 0x45, 0x2b, 0xdd, 0x84, 0xcb, 0xeb, 0xae, 0x67, 0xb9, 0x4e,
+	0xa8, 0x4b, 0xbf, 0x7c, 0x9d, 0xe5, 0x2f, 0xc2, 0xe2, 0x86, 0x67, 0xda, 0x17, 0xd8, 0x43, 0x17,
+	0x96, 0x9f, 0x39, 0xdd, 0x0b, 0x9e, 0xc6, 0x21, 0x15, 0xa8, 0x21, 0x7e, 0x3a, 0xa3, 0x29, 0x05,
+	0x6a, 0x56, 0x4f, 0xff, 0x8d, 0x89, 0x40, 0x55, 0x57, 0x17, 0x2c, 0x02, 0xa5, 0xae, 0xb8, 0x08,
+	0x6c, 0x43, 0x8d, 0x2d, 0x6c, 0x24, 0x32, 0xc2, 0x6f, 0xf4, 0x0e, 0x20, 0x0f, 0x0f, 0x4c, 0x9b,
+	0x9e, 0x6f, 0x42, 0x21, 0x61, 0xa2, 0x6f, 0x5e, 0xd4, 0x84, 0xa7, 0x78, 0xa6, 0xf4, 0xfb, 0x79,
+	0x98, 0xff, 0x50, 0x76, 0x77, 0xca, 0x9d, 0x31, 0xe3, 0x06, 0x34, 0x64, 0xd7, 0x29, 0xfe, 0x4e,
+	0x70, 0x14, 0x39, 0x4c, 0x79, 0xd0, 0x36, 0x5c, 0x32, 0x8d, 0x18, 0xfe, 0x0b, 0x3d, 0x01, 0x75,
+	0x1f, 0xae, 0x2a, 0xfb, 0x9c, 0xf2, 0xee, 0x3d, 0x71, 0xa2, 0x9b, 0x38, 0x88, 0x7a, 0xe4, 0xed,
+	0x2f, 0x74, 0xa2, 0xff, 0x4b, 0xa3, 0xa1, 0x08, 0xe9, 0x4e, 0xa7, 0x99, 0xe9, 0x0a, 0x54, 0xb1,
+	0x63, 0xee, 0xf7, 0xc5, 0x29, 0x16, 0x7e, 0x26, 0xd7, 0xa0, 0x98, 0x5c, 0x83, 0x84, 0xfb, 0x63,
+	0x29, 0xe9, 0xfe, 0xf8, 0x0e, 0x2c, 0x90, 0x8a, 0x8e, 0xeb, 0x74, 0xba, 0x23, 0xcf, 0xc3, 0x4e,
+	0xd0, 0x21, 0xea, 0x24, 0x33, 0x7a, 0xb6, 0x48, 0xd5, 0xc7, 0xce, 0x3a, 0xab, 0xf8, 0x06, 0x3e,
+	0x4d, 0xb9, 0x8f, 0x6b, 0x91, 0xfb, 0xb8, 0xfe, 0x6f, 0x0b, 0x70, 0x39, 0x75, 0x65, 0xa5, 0x54,
+	0x9b, 0x34, 0xcd, 0x6a, 0x93, 0xb3, 0x2f, 0xaa, 0xb4, 0xa8, 0x48, 0x30, 0x14, 0x63, 0x57, 0x21,
+	0x61, 0xbb, 0x28, 0x9d, 0xdd, 0x76, 0x91, 0x0e, 0xf4, 0x2e, 0x9f, 0xc3, 0xf1, 0xe4, 0x0a, 0xd4,
+	0x4e, 0x08, 0xea, 0x4e, 0xe0, 0xf3, 0xd3, 0xbc, 0x4a, 0xbf, 0xf7, 0xfc, 0xd8, 0x8a, 0x55, 0x33,
+	0x1d, 0xee, 0x6b, 0x31, 0x13, 0x48, 0xc0, 0x94, 0xd4, 0xe4, 0x98, 0x2f, 0x98, 0x72, 0x7f, 0xa4,
+	0xa5, 0x2c, 0x2f, 0x2f, 0x23, 0x8c, 0xe6, 0x6b, 0x89, 0xf4, 0x74, 0xab, 0x79, 0xb6, 0x27, 0x96,
+	0xa3, 0xee, 0x5f, 0x6a, 0x70, 0x63, 0xdb, 0x74, 0x46, 0x66, 0x3f, 0xf2, 0x64, 0xfc, 0xc4, 0x0e,
+	0x0e, 0xb7, 0xa7, 0x3a, 0xd0, 0xf2, 0x50, 0xdc, 0x7b, 0x50, 0x1a, 0xb8, 0x56, 0x86, 0x6f, 0x5c,
+	0xc2, 0xb7, 0x92, 0x8e, 0x86, 0x82, 0xeb, 0xbf, 0x00, 0x37, 0xb3, 0xc7, 0x3b, 0xcd, 0x5a, 0xea,
+	0x22, 0x86, 0x21, 0x31, 0xe6, 0xa8, 0x2c, 0x24, 0x9e, 0x48, 0xcb, 0xe5, 0xd4, 0x36, 0xe5, 0x4a,
+	0x4d, 0xe8, 0xf5, 0x47, 0x45, 0x46, 0x3c, 0x8a, 0x6e, 0xa7, 0x99, 0xf0, 0x34, 0x9e, 0xba, 0x37,
+	0xa1, 0x41, 0xe5, 0xdc, 0x4e, 0xdf, 0x74, 0x9e, 0xba, 0xa1, 0xcf, 0x93, 0x54, 0x84, 0x56, 0x61,
+	0x0e, 0xbf, 0xc0, 0xdd, 0x51, 0x60, 0x3b, 0x3d, 0x0e, 0xc5, 0x04, 0x64, 0xb2, 0x98, 0x40, 0x76,
+	0xc3, 0x88, 0x25, 0x0e, 0xc9, 0x44, 0x64, 0xb2, 0x98, 0x2c, 0xd6, 0x81, 0x69, 0xf7, 0x05, 0x18,
+	0x57, 0xee, 0xe5, 0x32, 0xf4, 0x1a, 0xcc, 0x72, 0xf7, 0x79, 0x0e, 0x54, 0xe5, 0xf7, 0x27, 0xb9,
+	0x90, 0xf6, 0x49, 0x94, 0xd3, 0x7e, 0x84, 0xac, 0xc6, 0xfb, 0x8c, 0x17, 0xc7, 0x64, 0x4c, 0x3d,
+	0x21, 0x95, 0x5d, 0x58, 0x5e, 0xa7, 0xe0, 0xb2, 0x53, 0xf2, 0x45, 0x52, 0xc2, 0xa7, 0x70, 0x2d,
+	0xd9, 0x21, 0x19, 0xe6, 0x14, 0xf4, 0xb7, 0x02, 0x55, 0xe6, 0xb8, 0x1d, 0xda, 0x18, 0xc2, 0x4f,
+	0x7d, 0x1d, 0xe6, 0x36, 0xbb, 0x1b, 0xde, 0xa9, 0x31, 0x3a, 0xff, 0xa4, 0xf4, 0x9f, 0x85, 0x99,
+	0xcd, 0xee, 0xc7, 0xde, 0xf0, 0xd0, 0x74, 0x1e, 0xdb, 0x7d, 0x9a, 0x48, 0x89, 0x3a, 0x35, 0xf3,
+	0x68, 0x79, 0xf2, 0x3f, 0x29, 0xa3, 0xf1, 0xc1, 0x3c, 0xb9, 0x12, 0xf9, 0x5f, 0xff, 0x6d, 0x0d,
+	0x5a, 0xa4, 0x77, 0x39, 0xb3, 0xd5, 0x4b, 0xf0, 0xf3, 0x9c, 0x1c, 0xe6, 0x27, 0xbc, 0x57, 0x4a,
+	0xb2, 0xf7, 0x4a, 0x38, 0xc4, 0xb2, 0x34, 0xc4, 0xbf, 0x5e, 0x60, 0x43, 0x64, 0x0b, 0x34, 0x9d,
+	0xa3, 0xf9, 0x8c, 0x4b, 0x97, 0xa8, 0xc3, 0xba, 0xce, 0x0e, 0xa3, 0x95, 0xd7, 0xd2, 0x68, 0xb8,
+	0xe2, 0x7f, 0x1f, 0x3d, 0x55, 0x24, 0x33, 0xcb, 0x4e, 0x45, 0x9c, 0x5c, 0xda, 0x74, 0x46, 0xb3,
+	0xb7, 0x60, 0xde, 0xc3, 0xdd, 0xbe, 0x69, 0x0f, 0x88, 0x2e, 0xd4, 0xd9, 0x3f, 0x65, 0xa1, 0xa3,
+	0x4c, 0x73, 0x89, 0x2a, 0x1e, 0x91, 0x72, 0xbd, 0x07, 0x4d, 0x7a, 0xa5, 0xdf, 0x5c, 0x3f, 0x3f,
+	0x21, 0xde, 0x82, 0x59, 0x6a, 0x26, 0x10, 0xb1, 0x30, 0x7c, 0xff, 0x68, 0x21, 0x8f, 0x83, 0x21,
+	0x34, 0xc9, 0xee, 0xf1, 0x53, 0xf4, 0xa4, 0x3f, 0x06, 0xb4, 0x89, 0x83, 0xcd, 0xf5, 0x29, 0x35,
+	0x56, 0xfd, 0x4f, 0x35, 0x80, 0xcd, 0xae, 0x31, 0xa2, 0x92, 0x31, 0x19, 0xf0, 0x13, 0x92, 0xa7,
+	0x08, 0xf8, 0xb9, 0x02, 0x35, 0xec, 0x58, 0xac, 0x92, 0x07, 0x34, 0x62, 0xc7, 0xa2, 0x55, 0x6c,
+	0xad, 0x4f, 0xbb, 0xfd, 0xf8, 0xe6, 0x85, 0x6b, 0x4d, 0x2b, 0xc4, 0xc6, 0xdc, 0x82, 0x59, 0x0f,
+	0x0f, 0xdc, 0x63, 0x6c, 0x75, 0x42, 0x42, 0xa5, 0xeb, 0xc4, 0x0b, 0x19, 0x35, 0xbc, 0x1a, 0x0a,
+	0x4a, 0x0e, 0xc3, 0xdf, 0xd9, 0x59, 0x19, 0x03, 0xb9, 0x09, 0x0d, 0x9a, 0x03, 0xd3, 0x1b, 0x0d,
+	0x03, 0xcc, 0xbc, 0x4a, 0x6b, 0x86, 0x5c, 0xa4, 0xff, 0x5e, 0x11, 0x16, 0x62, 0x0b, 0x35, 0xe5,
+	0x63, 0x4d, 0xcc, 0x54, 0xc4, 0xbf, 0x98, 0xab, 0x5c, 0x64, 0xae, 0x29, 0x86, 0xae, 0x72, 0xa1,
+	0xb1, 0x06, 0xdd, 0x87, 0xf2, 0xf0, 0x90, 0x6c, 0x0c, 0x53, 0x40, 0xdb, 0x4a, 0x6a, 0xde, 0x21,
+	0x10, 0x06, 0x03, 0xa4, 0x94, 0x84, 0x1d, 0x8b, 0xdc, 0x10, 0xe5, 0xd9, 0xcf, 0xf0, 0x42, 0x36,
+	0xfd, 0xaf, 0x40, 0x23, 0xd4, 0xc9, 0xbd, 0x51, 0x86, 0x47, 0x34, 0x47, 0x1e, 0xee, 0xb0, 0x01,
+	0xbc, 0x85, 0x31, 0x72, 0xd0, 0xfb, 0x50, 0xa3, 0x99, 0xc3, 0x48, 0xe3, 0x6a, 0x9e, 0xc6, 0x55,
+	0x02, 0x4e, 0x5a, 0xbe, 0x0e, 0x73, 0xee, 0x28, 0xe8, 0xb8, 0x07, 0x91, 0x21, 0x8b, 0x39, 0x63,
+	0xce, 0xba, 0xa3, 0xe0, 0xe3, 0x03, 0x61, 0xcb, 0x7a, 0x17, 0x16, 0xd9, 0x1a, 0xc5, 0x5e, 0x00,
+	0xc2, 0xac, 0x7f, 0x0b, 0xac, 0x4e, 0x7e, 0x02, 0xf0, 0xf5, 0x7f, 0xaf, 0xc1, 0x75, 0xc2, 0xd8,
+	0x51, 0xac, 0x36, 0x59, 0x42, 0xc3, 0x74, 0x7a, 0xf8, 0xf3, 0x0e, 0x9f, 0x96, 0x3d, 0x2d, 0x4b,
+	0x34, 0x10, 0x4d, 0x78, 0x5a, 0x5e, 0x86, 0x0a, 0xe5, 0x0c, 0xb6, 0x51, 0x25, 0xa3, 0x4c, 0xf8,
+	0xc2, 0xd7, 0xff, 0x96, 0x06, 0x37, 0x32, 0x27, 0x33, 0x0d, 0x29, 0x4e, 0xb2, 0xac, 0x5f, 0x81,
+	0x9a, 0x33, 0x1a, 0xc8, 0x11, 0x63, 0x55, 0x67, 0x34, 0xa0, 0x7e, 0xea, 0x4f, 0xe9, 0xa5, 0x77,
+	0xcf, 0x1d, 0xba, 0x7d, 0xb7, 0x77, 0xba, 0xeb, 0x98, 0x43, 0xff, 0xd0, 0x3d, 0xbf, 0xdb, 0x0d,
+	0x0f, 0xad, 0x4f, 0xe3, 0x9b, 0x36, 0x52, 0x9c, 0x23, 0x0a, 0x3d, 0xe3, 0xc2, 0x6f, 0xfd, 0x05,
+	0xdc, 0x30, 0x70, 0xe0, 0x9d, 0x7e, 0x73, 0x64, 0x7a, 0xa6, 0x13, 0xd8, 0x0e, 0xb6, 0xa6, 0x4f,
+	0xd3, 0x98, 0x72, 0x4a, 0x56, 0x84, 0x14, 0xe9, 0xdf, 0x83, 0x9b, 0xd9, 0x3d, 0x4f, 0x33, 0xdd,
+	0x5c, 0xbd, 0xf7, 0xe1, 0xc6, 0xb6, 0xdd, 0xf3, 0x22, 0x17, 0x44, 0x11, 0x9e, 0x3e, 0xc5, 0xbc,
+	0x97, 0xa1, 0x6a, 0x79, 0xa7, 0x54, 0x02, 0x70, 0x99, 0x66, 0x51, 0x65, 0x40, 0xff, 0x87, 0x1a,
+	0xdc, 0xcc, 0xee, 0x6e, 0xca, 0xc9, 0x0e, 0x18, 0x62, 0xab, 0x43, 0x5f, 0x28, 0xf9, 0x64, 0xc3,
+	0xc2, 0x6f, 0xe0, 0x53, 0x2a, 0xfc, 0xfd, 0x23, 0x9b, 0xaa, 0x02, 0xd2, 0x2b, 0x66, 0x83, 0x97,
+	0x11, 0x10, 0xfd, 0xef, 0x6a, 0x70, 0xcd, 0xc0, 0x5c, 0x78, 0xfc, 0x3f, 0xe5, 0xe9, 0xf8, 0xd7,
+	0xa8, 0x4b, 0x87, 0x72, 0x64, 0x61, 0xd8, 0xa1, 0xf2, 0x87, 0x1a, 0x56, 0xa0, 0xea, 0x8f, 0xba,
+	0x5d, 0xa2, 0xa5, 0x73, 0x2b, 0x0e, 0xff, 0x94, 0x6c, 0x80, 0x45, 0xd9, 0x06, 0x38, 0x31, 0x37,
+	0xe7, 0x6f, 0x69, 0xf0, 0x4a, 0xd6, 0x48, 0xa6, 0xd8, 0xc2, 0x8f, 0x92, 0x51, 0x85, 0x2a, 0xef,
+	0x84, 0x31, 0x2b, 0x10, 0xc5, 0x16, 0xfe, 0x7a, 0x01, 0xe0, 0xe1, 0xc8, 0xb2, 0x83, 0x0f, 0x8f,
+	0xb9, 0x76, 0x1c, 0x79, 0x84, 0x68, 0x49, 0x8f, 0x90, 0x30, 0x82, 0xb8, 0x90, 0x79, 0xdb, 0x8e,
+	0x50, 0x49, 0x11, 0xc4, 0x59, 0x66, 0xa1, 0x3c, 0x29, 0xe4, 0x93, 0xbb, 0x5d, 0x4e, 0x5b, 0xa6,
+	0x26, 0x3d, 0x01, 0x47, 0x41, 0xa6, 0xd5, 0x58, 0x90, 0xe9, 0x12, 0x54, 0x2c, 0x1c, 0x98, 0x76,
+	0x3f, 0x34, 0xee, 0xb0, 0x2f, 0xfd, 0x7f, 0x6a, 0x34, 0xe3, 0x7e, 0x34, 0x95, 0xe9, 0xfc, 0x9d,
+	0xc9, 0x12, 0xb0, 0x6d, 0xca, 0xb5, 0x64, 0x0c, 0x5e, 0x11, 0xf9, 0x9d, 0xa9, 0x08, 0x96, 0xe2,
+	0x8a, 0x60, 0x72, 0x55, 0xcb, 0x8a, 0x55, 0x55, 0x66, 0xd7, 0xd7, 0x7f, 0xc0, 0x72, 0x0d, 0xc5,
+	0x26, 0x3e, 0x0d, 0x95, 0xbe, 0x07, 0x15, 0x7c, 0x2c, 0x9c, 0xf5, 0xd5, 0xca, 0x4d, 0xd4, 0x99,
+	0xc1, 0x81, 0xf5, 0xcf, 0x68, 0xe6, 0x96, 0xdd, 0x43, 0xd3, 0xb3, 0x3e, 0xf1, 0xec, 0x00, 0x5f,
+	0xbc, 0x4c, 0xd1, 0x7f, 0x54, 0x80, 0xb9, 0x44, 0x87, 0x79, 0x6c, 0xa2, 0x59, 0xae, 0x1f, 0xab,
+	0xd0, 0xe2, 0x11, 0xe1, 0xd4, 0x74, 0xeb, 0x85, 0xd1, 0x9b, 0x9a, 0xc1, 0x73, 0x1c, 0x10, 0x3d,
+	0xc0, 0x30, 0x03, 0x8c, 0xee, 0xc0, 0x3c, 0x87, 0xa4, 0x97, 0x23, 0x06, 0x5a, 0xa2, 0xa0, 0x73,
+	0xac, 0x82, 0x5e, 0x8e, 0x28, 0xec, 0x2a, 0xb4, 0x2c, 0xdc, 0xc7, 0x01, 0x96, 0xb0, 0x96, 0x19,
+	0x56, 0x56, 0x2e, 0x63, 0xe5, 0x90, 0x12, 0x56, 0x66, 0x0d, 0x9e, 0x63, 0x15, 0x11, 0xd6, 0x6b,
+	0x50, 0x37, 0x8f, 0x4d, 0xbb, 0x4f, 0x2e, 0x62, 0x3c, 0xed, 0x62, 0x54, 0xa0, 0xff, 0x3e, 0x4f,
+	0x44, 0x94, 0xdc, 0x8c, 0xe9, 0x4c, 0x46, 0x15, 0x9a, 0x00, 0x34, 0x24, 0x0b, 0x55, 0xcc, 0x4f,
+	0xb2, 0x43, 0xde, 0x02, 0xdd, 0x86, 0xe6, 0x89, 0xed, 0x58, 0xee, 0x89, 0xb8, 0xe1, 0xf1, 0x97,
+	0x6d, 0x56, 0x1a, 0x5e, 0xf1, 0x7e, 0xa8, 0xc1, 0x02, 0xcd, 0x62, 0xf3, 0xd0, 0xb1, 0x76, 0xb1,
+	0xd9, 0xbf, 0xd8, 0x13, 0xe9, 0x1a, 0xd4, 0xf7, 0x4d, 0xcf, 0xb3, 0xb1, 0xb7, 0xe7, 0x87, 0x49,
+	0x1a, 0x44, 0x81, 0xfe, 0x27, 0x61, 0x06, 0x69, 0x31, 0x96, 0x69, 0x16, 0x2f, 0xd6, 0x57, 0x21,
+	0xd1, 0xd7, 0xc4, 0x5f, 0xae, 0x7a, 0x0a, 0x0b, 0xe9, 0xdf, 0x9a, 0x08, 0xdd, 0x7c, 0x26, 0x58,
+	0xd4, 0x51, 0xea, 0x97, 0x24, 0x7c, 0xfd, 0xbb, 0xa0, 0x13, 0xea, 0x08, 0x5c, 0xcf, 0xec, 0xe1,
+	0x75, 0xd7, 0xf1, 0x6d, 0x3f, 0xc0, 0x4e, 0xf7, 0x94, 0xf9, 0x53, 0x5e, 0x2c, 0xcf, 0xfe, 0x9e,
+	0x06, 0xad, 0x2d, 0xa7, 0x1b, 0x76, 0x1a, 0x64, 0x9a, 0x86, 0x5e, 0xce, 0xdd, 0x23, 0x66, 0x37,
+	0x2a, 0x25, 0xed, 0x46, 0x44, 0xa7, 0x72, 0x2d, 0xfb, 0xc0, 0xc6, 0x5c, 0x28, 0x73, 0xa9, 0x1b,
+	0x16, 0x52, 0x97, 0x81, 0xbf, 0x53, 0x84, 0x5b, 0x63, 0x97, 0x6b, 0xda, 0x50, 0x8c, 0xe8, 0xc0,
+	0x28, 0x8c, 0x3b, 0x30, 0x8a, 0xf1, 0x03, 0xe3, 0x16, 0xcc, 0xfa, 0x5d, 0xb2, 0xb5, 0x09, 0x63,
+	0x00, 0x2f, 0x64, 0x57, 0xdd, 0x1b, 0xd0, 0x18, 0xd8, 0xbe, 0x4f, 0x43, 0x86, 0x46, 0x03, 0x3e,
+	0x3d, 0xe0, 0x45, 0x4f, 0x47, 0x34, 0xc5, 0x1f, 0x33, 0x25, 0x61, 0x4b, 0xf2, 0xbb, 0x6f, 0x84,
+	0x65, 0x04, 0xe4, 0x23, 0xa2, 0x78, 0x32, 0x1c, 0x51, 0xb8, 0x69, 0x46, 0x98, 0x59, 0x62, 0x63,
+	0x89, 0x76, 0x4a, 0x5b, 0xb2, 0xd1, 0x7c, 0x1d, 0x9a, 0xa2, 0x33, 0x86, 0xaa, 0x96, 0x1f, 0xd5,
+	0x6c, 0xd8, 0x94, 0xe2, 0xd2, 0x4f, 0x61, 0xc9, 0xc0, 0xc3, 0xbe, 0xdd, 0x35, 0x03, 0xee, 0xd0,
+	0x7f, 0x7e, 0xba, 0x95, 0x93, 0x18, 0x16, 0xe2, 0x49, 0x0c, 0x11, 0x94, 0xc8, 0x70, 0xe8, 0xe2,
+	0xcf, 0x18, 0xf4, 0x7f, 0xfd, 0xd7, 0x0a, 0xb0, 0x2c, 0xfa, 0x9e, 0x3a, 0xfc, 0x42, 0xf2, 0x61,
+	0x2a, 0x4c, 0xf2, 0x61, 0x2a, 0xe6, 0x8c, 0x94, 0x2c, 0xa9, 0x22, 0x25, 0x13, 0x49, 0x9d, 0xcb,
+	0xa9, 0xa4, 0xce, 0x52, 0xf2, 0xaf, 0xca, 0xd9, 0x92, 0x7f, 0x0d, 0x60, 0x25, 0xbd, 0x20, 0x53,
+	0xca, 0xcb, 0xec, 0xd4, 0x2e, 0x77, 0xbe, 0x22, 0xf2, 0xd7, 0x13, 0xdd, 0x0b, 0x55, 0xa1, 0xf8,
+	0x14, 0x9f, 0xb4, 0x2e, 0x21, 0x80, 0xca, 0x53, 0xd7, 0x1b, 0x98, 0xfd, 0x96, 0x86, 0x1a, 0x50,
+	0xe5, 0x49, 0xd8, 0x5a, 0x05, 0x34, 0x0b, 0xf5, 0xf5, 0x30, 0x95, 0x54, 0xab, 0x78, 0xe7, 0x0e,
+	0xcc, 0xc8, 0x19, 0x79, 0x49, 0xbb, 0x27, 0xb8, 0x67, 0x76, 0x4f, 0x5b, 0x97, 0x50, 0x05, 0x0a,
+	0x4f, 0xee, 0xb7, 0x34, 0xfa, 0xf7, 0xdd, 0x56, 0xe1, 0xce, 0xdf, 0xd7, 0x60, 0x3e, 0xf5, 0x8c,
+	0x86, 0x9a, 0x00, 0xcf, 0x9c, 0xf0, 0x89, 0xa2, 0x75, 0x09, 0xcd, 0x40, 0x2d, 0xcc, 0xbc, 0xc6,
+	0xfa, 0xde, 0x73, 0x29, 0x74, 0xab, 0x80, 0x5a, 0x30, 0xc3, 0x1a, 0xb2, 0x3b, 0x49, 0xab, 0x28,
+	0x4a, 0x1e, 0x9b, 0x76, 0x7f, 0xe4, 0xe1, 0x56, 0x89, 0x8c, 0x6f, 0xcf, 0x35, 0x70, 0x1f, 0x9b,
+	0x3e, 0x6e, 0x95, 0x11, 0x82, 0x26, 0xff, 0x08, 0x1b, 0x55, 0xa4, 0xb2, 0xb0, 0x59, 0xf5, 0x8e,
+	0x2b, 0xa7, 0x39, 0xa2, 0x4b, 0xb1, 0x0c, 0x0b, 0xcf, 0x1c, 0x0b, 0x1f, 0xd0, 0x3b, 0xb6, 0xa8,
+	0x6a, 0x5d, 0x42, 0x0b, 0x30, 0xb7, 0x8d, 0x3d, 0x22, 0xbe, 0x44, 0x61, 0x01, 0xcd, 0xc3, 0xec,
+	0xb6, 0xfd, 0x42, 0x2a, 0x2a, 0xa2, 0x25, 0x40, 0x61, 0xec, 0xca, 0xba, 0xeb, 0xf0, 0xe7, 0xef,
+	0x56, 0x49, 0x2f, 0xd5, 0xb4, 0x96, 0x76, 0x67, 0x1b, 0x20, 0x72, 0x5b, 0xa3, 0xab, 0x4a, 0xbe,
+	0x9e, 0xba, 0x0e, 0x59, 0x83, 0x06, 0x54, 0xd7, 0x99, 0x93, 0x61, 0x4b, 0x23, 0xc3, 0xa5, 0x75,
+	0x22, 0x1f, 0x5d, 0xab, 0x80, 0xe6, 0xa0, 0x41, 0xcb, 0x1e, 0x53, 0x53, 0x64, 0xab, 0x78, 0x67,
+	0x13, 0x20, 0x72, 0x01, 0x21, 0xe8, 0xe8, 0x17, 0x47, 0x37, 0x03, 0x35, 0xfa, 0xc9, 0xf0, 0x35,
+	0xa0, 0x4a, 0xbf, 0x42, 0x44, 0xf4, 0x43, 0x20, 0x7a, 0x2a, 0x2f, 0xc4, 0xb6, 0x6b, 0x91, 0x13,
+	0xa5, 0xf9, 0x78, 0xd4, 0xef, 0xc7, 0xd6, 0x60, 0x09, 0x10, 0x5d, 0x83, 0xdd, 0x81, 0xd9, 0x17,
+	0xee, 0x74, 0x2d, 0x8d, 0xec, 0xc7, 0xce, 0xc8, 0xeb, 0xe1, 0x0d, 0xaa, 0x63, 0xf9, 0xad, 0xc2,
+	0x9d, 0x17, 0x50, 0xe5, 0x86, 0x45, 0x42, 0x1b, 0x9b, 0xdd, 0x2d, 0xab, 0x4f, 0x86, 0xb4, 0x0c,
+	0x0b, 0x9b, 0x5d, 0x83, 0x5a, 0x65, 0x23, 0x57, 0x4a, 0x82, 0x61, 0x09, 0x90, 0x54, 0x41, 0xb9,
+	0x89, 0xe0, 0x41, 0x97, 0x61, 0x7e, 0xb3, 0xbb, 0x4b, 0x04, 0xb2, 0xed, 0xf4, 0x98, 0xf9, 0x9e,
+	0x10, 0xc0, 0x15, 0xb8, 0x9c, 0x04, 0xa7, 0x42, 0xad, 0x55, 0xba, 0xf3, 0x87, 0x1a, 0x34, 0xe3,
+	0x57, 0x0b, 0x32, 0x95, 0xa8, 0x84, 0x2f, 0xce, 0x02, 0xcc, 0x71, 0xa2, 0x64, 0x3f, 0x67, 0x87,
+	0xad, 0x96, 0x26, 0x15, 0x72, 0x4a, 0x21, 0x6b, 0x85, 0xa0, 0xc9, 0x1c, 0x94, 0xc2, 0xdc, 0xec,
+	0xad, 0x22, 0x5a, 0x84, 0x16, 0x29, 0x7b, 0xe6, 0x44, 0x19, 0xdb, 0x5b, 0x25, 0x32, 0xd8, 0xf8,
+	0xd3, 0x12, 0xc1, 0x5a, 0x26, 0x58, 0x05, 0x4b, 0x33, 0x7b, 0x74, 0xab, 0x42, 0x26, 0x1c, 0xbd,
+	0x46, 0xf8, 0x06, 0xb3, 0x3f, 0xb7, 0xaa, 0x6b, 0xbf, 0xf2, 0x10, 0xea, 0x1b, 0x66, 0x60, 0xae,
+	0xbb, 0xae, 0x67, 0xa1, 0x3e, 0xb5, 0xb6, 0x13, 0xa4, 0xae, 0x23, 0x7e, 0x94, 0x0d, 0x25, 0x2e,
+	0xbd, 0xfc, 0x23, 0x0d, 0xc8, 0x45, 0x6a, 0xfb, 0x35, 0x25, 0x7c, 0x02, 0x58, 0xbf, 0x84, 0x06,
+	0xb4, 0x37, 0x72, 0x36, 0xee, 0xd9, 0xdd, 0xa3, 0x30, 0x20, 0xf4, 0x7e, 0xc6, 0x6f, 0x3f, 0xa5,
+	0x41, 0xc3, 0xfe, 0x6e, 0x29, 0xfb, 0x63, 0xbf, 0x15, 0x15, 0x4a, 0x35, 0xfd, 0x12, 0xfa, 0x0c,
+	0x16, 0xa9, 0x56, 0x10, 0x46, 0xd7, 0x86, 0x1d, 0xae, 0x65, 0x77, 0x98, 0x02, 0x3e, 0x63, 0x97,
+	0x4f, 0xa0, 0x4c, 0x65, 0x1a, 0x52, 0xbd, 0x0f, 0xc9, 0xbf, 0xa8, 0xda, 0xbe, 0x99, 0x0d, 0x20,
+	0xb0, 0x7d, 0x0a, 0x73, 0x89, 0xdf, 0x5a, 0x44, 0xaa, 0x48, 0x3a, 0xf5, 0xaf, 0x66, 0xb6, 0xef,
+	0xe4, 0x01, 0x15, 0x7d, 0xf5, 0xa0, 0x19, 0xff, 0x09, 0x23, 0xb4, 0x9a, 0xe3, 0x37, 0xd2, 0x58,
+	0x4f, 0x6f, 0xe6, 0xfe, 0x35, 0x35, 0x4a, 0x04, 0xad, 0xe4, 0xaf, 0x00, 0xa2, 0x3b, 0x63, 0x11,
+	0xc4, 0x89, 0xed, 0xad, 0x5c, 0xb0, 0xa2, 0xbb, 0x53, 0x4a, 0x04, 0xa9, 0x1f, 0x29, 0x43, 0x77,
+	0xd5, 0x68, 0xb2, 0x7e, 0x3d, 0xad, 0x7d, 0x2f, 0x37, 0xbc, 0xe8, 0xfa, 0x17, 0x59, 0xb6, 0x64,
+	0xd5, 0x0f, 0x7d, 0xa1, 0x77, 0xd5, 0xe8, 0xc6, 0xfc, 0x42, 0x59, 0x7b, 0xed, 0x2c, 0x4d, 0xc4,
+	0x20, 0xfe, 0x32, 0x35, 0x3d, 0x28, 0x7e, 0x2a, 0x2b, 0xc9, 0x77, 0x21, 0xbe, 0xec, 0x5f, 0x01,
+	0x6b, 0xbf, 0x7b, 0x86, 0x16, 0x62, 0x00, 0x6e, 0xf2, 0x67, 0x16, 0x43, 0x36, 0xbc, 0x37, 0x91,
+	0x6a, 0xce, 0xc7, 0x83, 0xdf, 0x86, 0xb9, 0x44, 0x6c, 0x29, 0xca, 0x1f, 0x7f, 0xda, 0x1e, 0xa7,
+	0xfc, 0x30, 0x96, 0x4c, 0xa4, 0x35, 0x46, 0x19, 0xd4, 0xaf, 0x48, 0x7d, 0xdc, 0xbe, 0x93, 0x07,
+	0x54, 0x4c, 0x64, 0x08, 0xf3, 0x89, 0xca, 0xe7, 0x6b, 0xe8, 0xad, 0xdc, 0xbd, 0x3d, 0x5f, 0x6b,
+	0xbf, 0x9d, 0xbf, 0xbf, 0xe7, 0x6b, 0xfa, 0x25, 0xe4, 0x53, 0x01, 0x9d, 0x48, 0x8d, 0x8b, 0x32,
+	0xb0, 0xa8, 0x53, 0x00, 0xb7, 0xdf, 0xc9, 0x09, 0x2d, 0xa6, 0x79, 0x4c, 0x1f, 0x32, 0x93, 0x19,
+	0x8c, 0xd1, 0x3b, 0x63, 0xc9, 0x23, 0x99, 0xba, 0xb9, 0x7d, 0x37, 0x2f, 0xb8, 0x74, 0x3c, 0xb4,
+	0xc2, 0x71, 0x3d, 0xec, 0xf7, 0x99, 0x86, 0xf3, 0x76, 0xd6, 0xc9, 0x17, 0x03, 0xcb, 0x98, 0x6a,
+	0x26, 0xb4, 0xe8, 0xf2, 0x17, 0x00, 0xed, 0x1e, 0xba, 0x27, 0x2c, 0x34, 0x6a, 0xe4, 0x99, 0x2c,
+	0x64, 0x34, 0xeb, 0x00, 0x4c, 0x83, 0x66, 0x30, 0xe2, 0xd8, 0x16, 0xa2, 0xf3, 0x0e, 0xc0, 0x26,
+	0x0e, 0xb6, 0x71, 0xe0, 0x11, 0xee, 0x7f, 0x3d, 0x6b, 0xec, 0x1c, 0x20, 0xec, 0xea, 0x8d, 0x89,
+	0x70, 0xf2, 0x82, 0x26, 0x9d, 0xbf, 0x32, 0x16, 0x34, 0x09, 0x36, 0x7e, 0x41, 0xd3, 0xd0, 0xa2,
+	0xcb, 0x13, 0xa1, 0xbf, 0x48, 0x6e, 0x50, 0xe3, 0xf5, 0x97, 0x74, 0x0a, 0xde, 0xa4, 0x6c, 0x1f,
+	0x03, 0x2f, 0x3a, 0xfe, 0x3e, 0x73, 0x76, 0x4d, 0x00, 0x7c, 0x62, 0x07, 0x87, 0xd4, 0xe5, 0x27,
+	0xcf, 0x10, 0x64, 0xdf, 0xa0, 0x3c, 0x43, 0xe0, 0xf0, 0x62, 0x08, 0x16, 0xcc, 0xc6, 0xb2, 0xef,
+	0x21, 0x55, 0x18, 0x93, 0x2a, 0x13, 0x61, 0x7b, 0x75, 0x32, 0xa0, 0xe8, 0xe5, 0x10, 0x66, 0x43,
+	0x82, 0x66, 0x8b, 0xfb, 0xe6, 0x58, 0xa2, 0x8f, 0xad, 0xeb, 0x9d, 0x3c, 0xa0, 0xa2, 0x27, 0x1f,
+	0x50, 0x3a, 0xcd, 0x18, 0xca, 0x97, 0x94, 0x6e, 0x9c, 0xf0, 0xc9, 0xce, 0x5d, 0xc6, 0xe4, 0x79,
+	0x22, 0x91, 0x9f, 0xfa, 0xb0, 0x50, 0xe6, 0x25, 0x54, 0xca, 0xf3, 0x8c, 0xbc, 0x80, 0xfa, 0x25,
+	0xf4, 0x09, 0x54, 0xf8, 0xef, 0xa1, 0xbf, 0x36, 0x3e, 0xa5, 0x0c, 0xc7, 0x7e, 0x7b, 0x02, 0x94,
+	0x40, 0x7c, 0x04, 0xcb, 0x19, 0x09, 0x65, 0x94, 0x7a, 0xc6, 0xf8, 0xe4, 0x33, 0x93, 0x4e, 0x40,
+	0xd1, 0x59, 0x2a, 0x5f, 0xcc, 0x98, 0xce, 0xb2, 0x72, 0xcb, 0x4c, 0xea, 0xac, 0x03, 0xf3, 0xa9,
+	0x3c, 0x1a, 0xca, 0x23, 0x30, 0x2b, 0xdb, 0xc6, 0xa4, 0x0e, 0x7a, 0x70, 0x59, 0x99, 0xe7, 0x41,
+	0xa9, 0x9d, 0x8c, 0xcb, 0x08, 0x31, 0xa9, 0xa3, 0x2e, 0x2c, 0x28, 0xb2, 0x3b, 0x28, 0x4f, 0xb9,
+	0xec, 0x2c, 0x10, 0x93, 0x3a, 0x39, 0x80, 0xf6, 0x23, 0xcf, 0x35, 0xad, 0xae, 0xe9, 0x07, 0x34,
+	0xe3, 0x82, 0xec, 0x83, 0xa2, 0xbe, 0x3b, 0x28, 0xf3, 0x32, 0x4c, 0xea, 0x67, 0x1f, 0x1a, 0x74,
+	0x2b, 0xd9, 0x6f, 0x56, 0x23, 0xf5, 0x19, 0x21, 0x41, 0x64, 0x08, 0x1e, 0x15, 0xa0, 0x20, 0xea,
+	0x3d, 0x68, 0xac, 0xd3, 0x64, 0x66, 0xcc, 0xf4, 0xf5, 0x7a, 0xf2, 0xc8, 0xb3, 0xf0, 0x8b, 0xbb,
+	0x12, 0x40, 0xee, 0x15, 0x9a, 0xa5, 0x5a, 0xbb, 0x85, 0x5f, 0xb0, 0x7d, 0x5e, 0x55, 0xe1, 0x8d,
+	0x81, 0x64, 0xdc, 0x72, 0x94, 0x90, 0xd2, 0x49, 0xbf, 0x28, 0xeb, 0xb2, 0xa2, 0xbb, 0x7b, 0x19,
+	0x48, 0x52, 0x90, 0x61, 0xaf, 0xf7, 0xf3, 0x37, 0x90, 0x4f, 0x86, 0x70, 0x5c, 0xd4, 0xad, 0x21,
+	0xb9, 0x41, 0xf1, 0xa1, 0xcb, 0x0a, 0xea, 0xea, 0x64, 0x40, 0xd1, 0xcb, 0x0e, 0xd4, 0x09, 0x75,
+	0xb2, 0xed, 0x79, 0x4d, 0xd5, 0x50, 0x54, 0xe7, 0xdf, 0x9c, 0x0d, 0xec, 0x77, 0x3d, 0x7b, 0x9f,
+	0x6f, 0xba, 0x72, 0x38, 0x31, 0x90, 0xb1, 0x9b, 0x93, 0x80, 0x14, 0x23, 0x1f, 0x51, 0xad, 0x41,
+	0x2c, 0x1d, 0x17, 0x95, 0xef, 0x4c, 0xda, 0xdf, 0xb8, 0x98, 0xbc, 0x9b, 0x17, 0x5c, 0x74, 0xfb,
+	0x97, 0xe8, 0x4d, 0x88, 0xd6, 0x3f, 0x1a, 0xd9, 0x7d, 0x2b, 0x74, 0x14, 0x47, 0xf7, 0xc7, 0xa1,
+	0x8a, 0x81, 0x66, 0x2a, 0x80, 0x63, 0x5a, 0x88, 0xfe, 0x7f, 0x1e, 0xea, 0x22, 0xf7, 0x07, 0x52,
+	0x3b, 0x9e, 0xc6, 0xb3, 0x8e, 0xb4, 0x5f, 0x1b, 0x0f, 0x24, 0x30, 0x63, 0x58, 0x54, 0x65, 0xfa,
+	0x40, 0x6a, 0xef, 0x89, 0xcc, 0x94, 0x20, 0x93, 0xe8, 0x83, 0xdd, 0x65, 0x15, 0xa9, 0x2a, 0xb2,
+	0xee, 0xb2, 0xd9, 0xb9, 0x34, 0xb2, 0xee, 0xb2, 0x63, 0xf2, 0x60, 0xe8, 0x97, 0xd0, 0x9f, 0x83,
+	0x66, 0x3c, 0xe3, 0x84, 0xd2, 0x48, 0xa2, 0x4c, 0x4a, 0x91, 0xe3, 0x62, 0x99, 0xc8, 0xe3, 0xa0,
+	0x94, 0xd7, 0xea, 0x84, 0x12, 0x4a, 0x45, 0x24, 0x23, 0x2d, 0x84, 0x7e, 0x09, 0x7d, 0x07, 0x5a,
+	0xc9, 0x34, 0x0d, 0x4a, 0x13, 0x4c, 0x46, 0x2e, 0x87, 0x49, 0x53, 0x31, 0x00, 0xe8, 0xb1, 0xc2,
+	0x78, 0xf8, 0xb6, 0x8a, 0x54, 0xa3, 0xfa, 0x9c, 0x38, 0x3f, 0x81, 0xd9, 0x58, 0x04, 0x38, 0xca,
+	0x1b, 0xb3, 0x3f, 0x09, 0xf1, 0xf7, 0x28, 0x53, 0xa6, 0xa3, 0xcb, 0xb3, 0xcc, 0x13, 0x99, 0x01,
+	0xf4, 0xed, 0xfb, 0xf9, 0x1b, 0xc8, 0x8c, 0xa3, 0x8a, 0x75, 0x56, 0x32, 0xce, 0x98, 0xa0, 0xe8,
+	0x49, 0x93, 0xfc, 0x45, 0x9e, 0x25, 0x44, 0x11, 0x6f, 0xac, 0x54, 0xda, 0xc6, 0x07, 0x3c, 0x2b,
+	0x2d, 0x51, 0x13, 0xc2, 0x99, 0x19, 0xf3, 0xc4, 0x23, 0x8b, 0x91, 0x3a, 0x65, 0xbb, 0x22, 0x6a,
+	0x37, 0x07, 0x75, 0xc4, 0x22, 0x8a, 0x95, 0xd4, 0xa1, 0x8a, 0x39, 0x9e, 0x84, 0xf8, 0x3b, 0xd0,
+	0x4a, 0x06, 0x12, 0x2b, 0x39, 0x25, 0x23, 0xda, 0x38, 0x1f, 0xf1, 0xa5, 0xe3, 0x7a, 0xb3, 0x88,
+	0x2f, 0x33, 0xd8, 0x38, 0x8b, 0xf8, 0xb2, 0x43, 0x86, 0x99, 0xe1, 0x45, 0x11, 0x99, 0xaa, 0x54,
+	0x49, 0xb3, 0xa3, 0x66, 0x95, 0x86, 0x97, 0x31, 0x01, 0xaf, 0xc2, 0xe0, 0x93, 0x8c, 0x13, 0xcd,
+	0x32, 0xf8, 0x64, 0x04, 0xb1, 0x66, 0x19, 0x7c, 0xb2, 0xc2, 0x4f, 0x23, 0x56, 0x4f, 0x45, 0xf9,
+	0x65, 0xb2, 0x7a, 0x56, 0x18, 0x62, 0xfb, 0x7e, 0xfe, 0x06, 0xa2, 0xf7, 0x5f, 0xd2, 0x60, 0x25,
+	0x2b, 0x36, 0x0e, 0xad, 0x29, 0xaf, 0x01, 0x63, 0x03, 0xff, 0xda, 0x0f, 0xce, 0xd4, 0x26, 0xb9,
+	0x0a, 0xa9, 0x70, 0xb5, 0xcc, 0x55, 0xc8, 0x8a, 0xa7, 0xcb, 0x5c, 0x85, 0xcc, 0x48, 0x38, 0x7e,
+	0xf4, 0x24, 0x62, 0xa4, 0xd4, 0x47, 0x8f, 0x3a, 0x72, 0x6b, 0x12, 0x43, 0x3d, 0x83, 0x5a, 0x18,
+	0xf5, 0x83, 0xf4, 0x8c, 0xd0, 0x1a, 0x29, 0x66, 0xaa, 0x7d, 0x6b, 0x2c, 0x8c, 0x18, 0xf5, 0x37,
+	0xa0, 0xca, 0x43, 0x68, 0x90, 0xca, 0xd3, 0x30, 0x1e, 0x5e, 0x33, 0x69, 0x8c, 0xdb, 0x50, 0x0b,
+	0xc3, 0x64, 0x94, 0x63, 0x4c, 0xc4, 0xd0, 0x4c, 0x42, 0xf7, 0x17, 0xa0, 0x21, 0xc5, 0x81, 0xa0,
+	0xdb, 0xea, 0x4d, 0x49, 0x04, 0xd4, 0xb4, 0x5f, 0x9f, 0x04, 0x16, 0x7b, 0xc5, 0xc8, 0xf0, 0xf4,
+	0x57, 0x9e, 0x1d, 0xe3, 0x43, 0x1c, 0x94, 0x67, 0xc7, 0x84, 0x40, 0x02, 0x21, 0x32, 0x92, 0xae,
+	0xf8, 0x59, 0x22, 0x23, 0x23, 0x04, 0x20, 0x4b, 0x64, 0x64, 0x79, 0xf8, 0x73, 0xa6, 0xcd, 0xf2,
+	0x8c, 0x57, 0x32, 0xed, 0x04, 0x07, 0x7e, 0x25, 0xd3, 0x4e, 0x72, 0xbd, 0x0f, 0x85, 0x47, 0x86,
+	0xd3, 0xba, 0x5a, 0x78, 0x8c, 0x77, 0xa8, 0x57, 0x0b, 0x8f, 0x09, 0x5e, 0xf1, 0x4c, 0x78, 0x28,
+	0xbd, 0x9f, 0x95, 0xc2, 0x63, 0x9c, 0x0f, 0xbb, 0x52, 0x78, 0x8c, 0x75, 0xe8, 0x16, 0x6f, 0x94,
+	0x92, 0x1b, 0x6d, 0xd6, 0x1b, 0x65, 0xda, 0xc5, 0x38, 0xeb, 0x8d, 0x52, 0xe1, 0x93, 0x2b, 0xde,
+	0x41, 0x92, 0x8e, 0xab, 0x19, 0xef, 0x20, 0x6a, 0x87, 0xda, 0xac, 0x77, 0x90, 0x0c, 0x8f, 0x4f,
+	0xfd, 0x12, 0x32, 0x61, 0x46, 0x76, 0x67, 0x44, 0xaf, 0x67, 0xbd, 0x10, 0xc7, 0x7d, 0x2f, 0xdb,
+	0x6f, 0x4c, 0x84, 0x13, 0x5d, 0xfc, 0x2a, 0xb3, 0x5a, 0x67, 0x39, 0xca, 0xa1, 0xf7, 0x32, 0xc6,
+	0x3c, 0xde, 0x0f, 0xb1, 0xfd, 0xb3, 0x67, 0x6d, 0x16, 0x0e, 0x68, 0xed, 0x3f, 0x6b, 0xb0, 0x28,
+	0xbc, 0x11, 0x42, 0x07, 0x25, 0x72, 0x26, 0x7c, 0x1b, 0xe6, 0x12, 0xce, 0x63, 0xca, 0xeb, 0x90,
+	0xda, 0xc1, 0x6c, 0x92, 0xc8, 0x1c, 0x40, 0x2b, 0xe9, 0x0c, 0xa5, 0x3c, 0x84, 0x32, 0x5c, 0xc8,
+	0x94, 0x4f, 0xd0, 0x59, 0xde, 0x55, 0xfa, 0xa5, 0xb5, 0xdf, 0x9c, 0x81, 0x9a, 0xd0, 0x1e, 0x7f,
+	0xba, 0x1e, 0x17, 0x9f, 0x83, 0x0b, 0xc4, 0xb7, 0x61, 0x8e, 0x8a, 0x8e, 0x8d, 0x81, 0x10, 0x94,
+	0x6f, 0x66, 0xbd, 0x37, 0x44, 0x30, 0xf9, 0x15, 0x7d, 0xf6, 0x76, 0x30, 0xee, 0x1a, 0x18, 0x83,
+	0xc8, 0x89, 0xf8, 0xff, 0xef, 0x97, 0xb9, 0xa7, 0x00, 0x92, 0xbe, 0x35, 0x3e, 0x9b, 0xc3, 0x4e,
+	0xdf, 0x74, 0x26, 0x33, 0x90, 0xea, 0xd9, 0xed, 0xcd, 0x3c, 0xbf, 0xc0, 0x95, 0x6d, 0xaf, 0xc8,
+	0x7e, 0x6c, 0x7b, 0x06, 0x33, 0xf2, 0xef, 0x5d, 0x2a, 0x25, 0xa3, 0xe2, 0x07, 0x31, 0x73, 0xd8,
+	0xfe, 0x95, 0xf1, 0xfa, 0xca, 0xc3, 0x6c, 0x5c, 0x64, 0xff, 0x64, 0x8d, 0xef, 0x6c, 0x0f, 0x3f,
+	0x13, 0xd0, 0xf9, 0x80, 0xd2, 0xf9, 0xe6, 0x95, 0xa7, 0x53, 0x66, 0xb2, 0x7c, 0xe5, 0xe9, 0x94,
+	0x9d, 0xc4, 0x9e, 0xc9, 0xcc, 0x64, 0x12, 0x75, 0xa5, 0xcc, 0xcc, 0x48, 0x4b, 0xaf, 0x94, 0x99,
+	0x59, 0x59, 0xd9, 0xf5, 0x4b, 0xe8, 0x97, 0x89, 0xd6, 0x39, 0x1a, 0x0c, 0x37, 0x6c, 0x7f, 0x48,
+	0x24, 0x05, 0xf6, 0xa2, 0x14, 0xcb, 0xef, 0x65, 0xf0, 0x58, 0x06, 0x7c, 0xc6, 0x29, 0x35, 0xb9,
+	0x99, 0x74, 0x69, 0x69, 0xee, 0x62, 0x7c, 0x14, 0x01, 0x25, 0x17, 0x3b, 0x62, 0xf3, 0x18, 0x58,
+	0xbe, 0xfd, 0x7c, 0xf4, 0xe0, 0x5b, 0xef, 0xf6, 0xec, 0xe0, 0x70, 0xb4, 0x4f, 0x6a, 0xee, 0x31,
+	0xd0, 0x77, 0x6c, 0x97, 0xff, 0x77, 0x2f, 0x44, 0x7e, 0x8f, 0xb6, 0xbe, 0x47, 0x56, 0x6e, 0xb8,
+	0xbf, 0x5f, 0xa1, 0x5f, 0x0f, 0xfe, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x48, 0x27, 0xf9,
+	0x31, 0x9c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DataCoordClient is the client API for DataCoord service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DataCoordClient interface {
+	GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error)
+	GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
+	GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
+	Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error)
+	AssignSegmentID(ctx context.Context, in *AssignSegmentIDRequest, opts ...grpc.CallOption) (*AssignSegmentIDResponse, error)
+	GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error)
+	GetSegmentStates(ctx context.Context, in *GetSegmentStatesRequest, opts ...grpc.CallOption) (*GetSegmentStatesResponse, error)
+	GetInsertBinlogPaths(ctx context.Context, in *GetInsertBinlogPathsRequest, opts ...grpc.CallOption) (*GetInsertBinlogPathsResponse, error)
+	GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error)
+	GetPartitionStatistics(ctx context.Context, in *GetPartitionStatisticsRequest, opts ...grpc.CallOption) (*GetPartitionStatisticsResponse, error)
+	GetSegmentInfoChannel(ctx context.Context, in *GetSegmentInfoChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
+	SaveBinlogPaths(ctx context.Context, in *SaveBinlogPathsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetRecoveryInfo(ctx context.Context, in *GetRecoveryInfoRequest, opts ...grpc.CallOption) (*GetRecoveryInfoResponse, error)
+	GetRecoveryInfoV2(ctx context.Context, in *GetRecoveryInfoRequestV2, opts ...grpc.CallOption) (*GetRecoveryInfoResponseV2, error)
+	GetFlushedSegments(ctx context.Context, in *GetFlushedSegmentsRequest, opts ...grpc.CallOption) (*GetFlushedSegmentsResponse, error)
+	GetSegmentsByStates(ctx context.Context, in *GetSegmentsByStatesRequest, opts ...grpc.CallOption) (*GetSegmentsByStatesResponse, error)
+	GetFlushAllState(ctx context.Context, in *milvuspb.GetFlushAllStateRequest, opts ...grpc.CallOption) (*milvuspb.GetFlushAllStateResponse, error)
+	ShowConfigurations(ctx context.Context, in *internalpb.ShowConfigurationsRequest, opts ...grpc.CallOption) (*internalpb.ShowConfigurationsResponse, error)
+	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
+	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
+	ManualCompaction(ctx context.Context, in *milvuspb.ManualCompactionRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error)
+	GetCompactionState(ctx context.Context, in *milvuspb.GetCompactionStateRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionStateResponse, error)
+	GetCompactionStateWithPlans(ctx context.Context, in *milvuspb.GetCompactionPlansRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionPlansResponse, error)
+	WatchChannels(ctx context.Context, in *WatchChannelsRequest, opts ...grpc.CallOption) (*WatchChannelsResponse, error)
+	GetFlushState(ctx context.Context, in *milvuspb.GetFlushStateRequest, opts ...grpc.CallOption) (*milvuspb.GetFlushStateResponse, error)
+	DropVirtualChannel(ctx context.Context, in *DropVirtualChannelRequest, opts ...grpc.CallOption) (*DropVirtualChannelResponse, error)
+	SetSegmentState(ctx context.Context, in *SetSegmentStateRequest, opts ...grpc.CallOption) (*SetSegmentStateResponse, error)
+	// https://wiki.lfaidata.foundation/display/MIL/MEP+24+--+Support+bulk+load
+	Import(ctx context.Context, in *ImportTaskRequest, opts ...grpc.CallOption) (*ImportTaskResponse, error)
+	UpdateSegmentStatistics(ctx context.Context, in *UpdateSegmentStatisticsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	UpdateChannelCheckpoint(ctx context.Context, in *UpdateChannelCheckpointRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	SaveImportSegment(ctx context.Context, in *SaveImportSegmentRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	UnsetIsImportingState(ctx context.Context, in *UnsetIsImportingStateRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	MarkSegmentsDropped(ctx context.Context, in *MarkSegmentsDroppedRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	BroadcastAlteredCollection(ctx context.Context, in *AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error)
+	CreateIndex(ctx context.Context, in *indexpb.CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	// Deprecated: use DescribeIndex instead
+	GetIndexState(ctx context.Context, in *indexpb.GetIndexStateRequest, opts ...grpc.CallOption) (*indexpb.GetIndexStateResponse, error)
+	GetSegmentIndexState(ctx context.Context, in *indexpb.GetSegmentIndexStateRequest, opts ...grpc.CallOption) (*indexpb.GetSegmentIndexStateResponse, error)
+	GetIndexInfos(ctx context.Context, in *indexpb.GetIndexInfoRequest, opts ...grpc.CallOption) (*indexpb.GetIndexInfoResponse, error)
+	DropIndex(ctx context.Context, in *indexpb.DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DescribeIndex(ctx context.Context, in *indexpb.DescribeIndexRequest, opts ...grpc.CallOption) (*indexpb.DescribeIndexResponse, error)
+	GetIndexStatistics(ctx context.Context, in *indexpb.GetIndexStatisticsRequest, opts ...grpc.CallOption) (*indexpb.GetIndexStatisticsResponse, error)
+	// Deprecated: use DescribeIndex instead
+	GetIndexBuildProgress(ctx context.Context, in *indexpb.GetIndexBuildProgressRequest, opts ...grpc.CallOption) (*indexpb.GetIndexBuildProgressResponse, error)
+	GcConfirm(ctx context.Context, in *GcConfirmRequest, opts ...grpc.CallOption) (*GcConfirmResponse, error)
+	ReportDataNodeTtMsgs(ctx context.Context, in *ReportDataNodeTtMsgsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetChannelWatchHistory(ctx context.Context, in *GetChannelWatchHistoryRequest, opts ...grpc.CallOption) (*GetChannelWatchHistoryResponse, error)
+	SetNodeConfigs(ctx context.Context, in *SetNodeConfigsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListNodeConfigs(ctx context.Context, in *ListNodeConfigsRequest, opts ...grpc.CallOption) (*ListNodeConfigsResponse, error)
+	ClearNodeConfigs(ctx context.Context, in *ClearNodeConfigsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	AlterIndex(ctx context.Context, in *indexpb.AlterIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	CloneSegments(ctx context.Context, in *CloneSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetCloneSegmentsState(ctx context.Context, in *GetCloneSegmentsStateRequest, opts ...grpc.CallOption) (*GetCloneSegmentsStateResponse, error)
+	SetMaintenancePolicy(ctx context.Context, in *SetMaintenancePolicyRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListMaintenancePolicies(ctx context.Context, in *ListMaintenancePoliciesRequest, opts ...grpc.CallOption) (*ListMaintenancePoliciesResponse, error)
+	CordonDataNode(ctx context.Context, in *CordonDataNodeRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DrainDataNode(ctx context.Context, in *DrainDataNodeRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	UncordonDataNode(ctx context.Context, in *UncordonDataNodeRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetDataNodeDrainState(ctx context.Context, in *GetDataNodeDrainStateRequest, opts ...grpc.CallOption) (*GetDataNodeDrainStateResponse, error)
+	RotateEncryptionKey(ctx context.Context, in *RotateEncryptionKeyRequest, opts ...grpc.CallOption) (*RotateEncryptionKeyResponse, error)
+	GetEncryptionStatus(ctx context.Context, in *GetEncryptionStatusRequest, opts ...grpc.CallOption) (*GetEncryptionStatusResponse, error)
+	GetChannelWatchStates(ctx context.Context, in *GetChannelWatchStatesRequest, opts ...grpc.CallOption) (*GetChannelWatchStatesResponse, error)
+	ManualCompactionWithMode(ctx context.Context, in *ManualCompactionWithModeRequest, opts ...grpc.CallOption) (*ManualCompactionWithModeResponse, error)
+	GetCompactionProgress(ctx context.Context, in *GetCompactionProgressRequest, opts ...grpc.CallOption) (*GetCompactionProgressResponse, error)
+	CancelCompaction(ctx context.Context, in *CancelCompactionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GcDryRun(ctx context.Context, in *GcDryRunRequest, opts ...grpc.CallOption) (*GcDryRunResponse, error)
+	PauseGC(ctx context.Context, in *PauseGCRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ResumeGC(ctx context.Context, in *ResumeGCRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetGCStatus(ctx context.Context, in *GetGCStatusRequest, opts ...grpc.CallOption) (*GetGCStatusResponse, error)
+	DropSegmentsByTimeRange(ctx context.Context, in *DropSegmentsByTimeRangeRequest, opts ...grpc.CallOption) (*DropSegmentsByTimeRangeResponse, error)
+	GetTopologySnapshot(ctx context.Context, in *GetTopologySnapshotRequest, opts ...grpc.CallOption) (*GetTopologySnapshotResponse, error)
+	RetryQuarantinedChannels(ctx context.Context, in *RetryQuarantinedChannelsRequest, opts ...grpc.CallOption) (*RetryQuarantinedChannelsResponse, error)
+	MigrateChannelWatchInfos(ctx context.Context, in *MigrateChannelWatchInfosRequest, opts ...grpc.CallOption) (*MigrateChannelWatchInfosResponse, error)
+	ReCollectSegmentStats(ctx context.Context, in *ReCollectSegmentStatsRequest, opts ...grpc.CallOption) (*ReCollectSegmentStatsResponse, error)
+	GetAuditEvents(ctx context.Context, in *GetAuditEventsRequest, opts ...grpc.CallOption) (*GetAuditEventsResponse, error)
+	GetShardWriteStats(ctx context.Context, in *GetShardWriteStatsRequest, opts ...grpc.CallOption) (*GetShardWriteStatsResponse, error)
+	FlushAndSeal(ctx context.Context, in *FlushAndSealRequest, opts ...grpc.CallOption) (*FlushAndSealResponse, error)
+	GetStorageConsistencyReport(ctx context.Context, in *GetStorageConsistencyReportRequest, opts ...grpc.CallOption) (*GetStorageConsistencyReportResponse, error)
+}
+
+type dataCoordClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDataCoordClient(cc *grpc.ClientConn) DataCoordClient {
+	return &dataCoordClient{cc}
+}
+
+func (c *dataCoordClient) GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error) {
+	out := new(milvuspb.ComponentStates)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetComponentStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+	out := new(milvuspb.StringResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetTimeTickChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+	out := new(milvuspb.StringResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetStatisticsChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error) {
+	out := new(FlushResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/Flush", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) AssignSegmentID(ctx context.Context, in *AssignSegmentIDRequest, opts ...grpc.CallOption) (*AssignSegmentIDResponse, error) {
+	out := new(AssignSegmentIDResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/AssignSegmentID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error) {
+	out := new(GetSegmentInfoResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetSegmentStates(ctx context.Context, in *GetSegmentStatesRequest, opts ...grpc.CallOption) (*GetSegmentStatesResponse, error) {
+	out := new(GetSegmentStatesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetInsertBinlogPaths(ctx context.Context, in *GetInsertBinlogPathsRequest, opts ...grpc.CallOption) (*GetInsertBinlogPathsResponse, error) {
+	out := new(GetInsertBinlogPathsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetInsertBinlogPaths", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error) {
+	out := new(GetCollectionStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetCollectionStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetPartitionStatistics(ctx context.Context, in *GetPartitionStatisticsRequest, opts ...grpc.CallOption) (*GetPartitionStatisticsResponse, error) {
+	out := new(GetPartitionStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetPartitionStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetSegmentInfoChannel(ctx context.Context, in *GetSegmentInfoChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+	out := new(milvuspb.StringResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentInfoChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) SaveBinlogPaths(ctx context.Context, in *SaveBinlogPathsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/SaveBinlogPaths", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetRecoveryInfo(ctx context.Context, in *GetRecoveryInfoRequest, opts ...grpc.CallOption) (*GetRecoveryInfoResponse, error) {
+	out := new(GetRecoveryInfoResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetRecoveryInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetRecoveryInfoV2(ctx context.Context, in *GetRecoveryInfoRequestV2, opts ...grpc.CallOption) (*GetRecoveryInfoResponseV2, error) {
+	out := new(GetRecoveryInfoResponseV2)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetRecoveryInfoV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetFlushedSegments(ctx context.Context, in *GetFlushedSegmentsRequest, opts ...grpc.CallOption) (*GetFlushedSegmentsResponse, error) {
+	out := new(GetFlushedSegmentsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetFlushedSegments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetSegmentsByStates(ctx context.Context, in *GetSegmentsByStatesRequest, opts ...grpc.CallOption) (*GetSegmentsByStatesResponse, error) {
+	out := new(GetSegmentsByStatesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentsByStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetFlushAllState(ctx context.Context, in *milvuspb.GetFlushAllStateRequest, opts ...grpc.CallOption) (*milvuspb.GetFlushAllStateResponse, error) {
+	out := new(milvuspb.GetFlushAllStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetFlushAllState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) ShowConfigurations(ctx context.Context, in *internalpb.ShowConfigurationsRequest, opts ...grpc.CallOption) (*internalpb.ShowConfigurationsResponse, error) {
+	out := new(internalpb.ShowConfigurationsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ShowConfigurations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
+	out := new(milvuspb.GetMetricsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) ManualCompaction(ctx context.Context, in *milvuspb.ManualCompactionRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error) {
+	out := new(milvuspb.ManualCompactionResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ManualCompaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetCompactionState(ctx context.Context, in *milvuspb.GetCompactionStateRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionStateResponse, error) {
+	out := new(milvuspb.GetCompactionStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetCompactionState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetCompactionStateWithPlans(ctx context.Context, in *milvuspb.GetCompactionPlansRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionPlansResponse, error) {
+	out := new(milvuspb.GetCompactionPlansResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetCompactionStateWithPlans", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) WatchChannels(ctx context.Context, in *WatchChannelsRequest, opts ...grpc.CallOption) (*WatchChannelsResponse, error) {
+	out := new(WatchChannelsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/WatchChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetFlushState(ctx context.Context, in *milvuspb.GetFlushStateRequest, opts ...grpc.CallOption) (*milvuspb.GetFlushStateResponse, error) {
+	out := new(milvuspb.GetFlushStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetFlushState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) DropVirtualChannel(ctx context.Context, in *DropVirtualChannelRequest, opts ...grpc.CallOption) (*DropVirtualChannelResponse, error) {
+	out := new(DropVirtualChannelResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/DropVirtualChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) SetSegmentState(ctx context.Context, in *SetSegmentStateRequest, opts ...grpc.CallOption) (*SetSegmentStateResponse, error) {
+	out := new(SetSegmentStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/SetSegmentState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) Import(ctx context.Context, in *ImportTaskRequest, opts ...grpc.CallOption) (*ImportTaskResponse, error) {
+	out := new(ImportTaskResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/Import", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) UpdateSegmentStatistics(ctx context.Context, in *UpdateSegmentStatisticsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/UpdateSegmentStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) UpdateChannelCheckpoint(ctx context.Context, in *UpdateChannelCheckpointRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/UpdateChannelCheckpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) SaveImportSegment(ctx context.Context, in *SaveImportSegmentRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/SaveImportSegment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) UnsetIsImportingState(ctx context.Context, in *UnsetIsImportingStateRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/UnsetIsImportingState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) MarkSegmentsDropped(ctx context.Context, in *MarkSegmentsDroppedRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/MarkSegmentsDropped", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) BroadcastAlteredCollection(ctx context.Context, in *AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/BroadcastAlteredCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error) {
+	out := new(milvuspb.CheckHealthResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/CheckHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) CreateIndex(ctx context.Context, in *indexpb.CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/CreateIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetIndexState(ctx context.Context, in *indexpb.GetIndexStateRequest, opts ...grpc.CallOption) (*indexpb.GetIndexStateResponse, error) {
+	out := new(indexpb.GetIndexStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetIndexState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetSegmentIndexState(ctx context.Context, in *indexpb.GetSegmentIndexStateRequest, opts ...grpc.CallOption) (*indexpb.GetSegmentIndexStateResponse, error) {
+	out := new(indexpb.GetSegmentIndexStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentIndexState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetIndexInfos(ctx context.Context, in *indexpb.GetIndexInfoRequest, opts ...grpc.CallOption) (*indexpb.GetIndexInfoResponse, error) {
+	out := new(indexpb.GetIndexInfoResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetIndexInfos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) DropIndex(ctx context.Context, in *indexpb.DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/DropIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) DescribeIndex(ctx context.Context, in *indexpb.DescribeIndexRequest, opts ...grpc.CallOption) (*indexpb.DescribeIndexResponse, error) {
+	out := new(indexpb.DescribeIndexResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/DescribeIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetIndexStatistics(ctx context.Context, in *indexpb.GetIndexStatisticsRequest, opts ...grpc.CallOption) (*indexpb.GetIndexStatisticsResponse, error) {
+	out := new(indexpb.GetIndexStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetIndexStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetIndexBuildProgress(ctx context.Context, in *indexpb.GetIndexBuildProgressRequest, opts ...grpc.CallOption) (*indexpb.GetIndexBuildProgressResponse, error) {
+	out := new(indexpb.GetIndexBuildProgressResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetIndexBuildProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GcConfirm(ctx context.Context, in *GcConfirmRequest, opts ...grpc.CallOption) (*GcConfirmResponse, error) {
+	out := new(GcConfirmResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GcConfirm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) ReportDataNodeTtMsgs(ctx context.Context, in *ReportDataNodeTtMsgsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ReportDataNodeTtMsgs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetChannelWatchHistory(ctx context.Context, in *GetChannelWatchHistoryRequest, opts ...grpc.CallOption) (*GetChannelWatchHistoryResponse, error) {
+	out := new(GetChannelWatchHistoryResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetChannelWatchHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) SetNodeConfigs(ctx context.Context, in *SetNodeConfigsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/SetNodeConfigs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) ListNodeConfigs(ctx context.Context, in *ListNodeConfigsRequest, opts ...grpc.CallOption) (*ListNodeConfigsResponse, error) {
+	out := new(ListNodeConfigsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ListNodeConfigs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) ClearNodeConfigs(ctx context.Context, in *ClearNodeConfigsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ClearNodeConfigs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) AlterIndex(ctx context.Context, in *indexpb.AlterIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/AlterIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) CloneSegments(ctx context.Context, in *CloneSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/CloneSegments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetCloneSegmentsState(ctx context.Context, in *GetCloneSegmentsStateRequest, opts ...grpc.CallOption) (*GetCloneSegmentsStateResponse, error) {
+	out := new(GetCloneSegmentsStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetCloneSegmentsState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) SetMaintenancePolicy(ctx context.Context, in *SetMaintenancePolicyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/SetMaintenancePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) ListMaintenancePolicies(ctx context.Context, in *ListMaintenancePoliciesRequest, opts ...grpc.CallOption) (*ListMaintenancePoliciesResponse, error) {
+	out := new(ListMaintenancePoliciesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ListMaintenancePolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) CordonDataNode(ctx context.Context, in *CordonDataNodeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/CordonDataNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) DrainDataNode(ctx context.Context, in *DrainDataNodeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/DrainDataNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) UncordonDataNode(ctx context.Context, in *UncordonDataNodeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/UncordonDataNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetDataNodeDrainState(ctx context.Context, in *GetDataNodeDrainStateRequest, opts ...grpc.CallOption) (*GetDataNodeDrainStateResponse, error) {
+	out := new(GetDataNodeDrainStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetDataNodeDrainState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) RotateEncryptionKey(ctx context.Context, in *RotateEncryptionKeyRequest, opts ...grpc.CallOption) (*RotateEncryptionKeyResponse, error) {
+	out := new(RotateEncryptionKeyResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/RotateEncryptionKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetEncryptionStatus(ctx context.Context, in *GetEncryptionStatusRequest, opts ...grpc.CallOption) (*GetEncryptionStatusResponse, error) {
+	out := new(GetEncryptionStatusResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetEncryptionStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetChannelWatchStates(ctx context.Context, in *GetChannelWatchStatesRequest, opts ...grpc.CallOption) (*GetChannelWatchStatesResponse, error) {
+	out := new(GetChannelWatchStatesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetChannelWatchStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) ManualCompactionWithMode(ctx context.Context, in *ManualCompactionWithModeRequest, opts ...grpc.CallOption) (*ManualCompactionWithModeResponse, error) {
+	out := new(ManualCompactionWithModeResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ManualCompactionWithMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetCompactionProgress(ctx context.Context, in *GetCompactionProgressRequest, opts ...grpc.CallOption) (*GetCompactionProgressResponse, error) {
+	out := new(GetCompactionProgressResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetCompactionProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) CancelCompaction(ctx context.Context, in *CancelCompactionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/CancelCompaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GcDryRun(ctx context.Context, in *GcDryRunRequest, opts ...grpc.CallOption) (*GcDryRunResponse, error) {
+	out := new(GcDryRunResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GcDryRun", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) PauseGC(ctx context.Context, in *PauseGCRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/PauseGC", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) ResumeGC(ctx context.Context, in *ResumeGCRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ResumeGC", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetGCStatus(ctx context.Context, in *GetGCStatusRequest, opts ...grpc.CallOption) (*GetGCStatusResponse, error) {
+	out := new(GetGCStatusResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetGCStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) DropSegmentsByTimeRange(ctx context.Context, in *DropSegmentsByTimeRangeRequest, opts ...grpc.CallOption) (*DropSegmentsByTimeRangeResponse, error) {
+	out := new(DropSegmentsByTimeRangeResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/DropSegmentsByTimeRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetTopologySnapshot(ctx context.Context, in *GetTopologySnapshotRequest, opts ...grpc.CallOption) (*GetTopologySnapshotResponse, error) {
+	out := new(GetTopologySnapshotResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetTopologySnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) RetryQuarantinedChannels(ctx context.Context, in *RetryQuarantinedChannelsRequest, opts ...grpc.CallOption) (*RetryQuarantinedChannelsResponse, error) {
+	out := new(RetryQuarantinedChannelsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/RetryQuarantinedChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) MigrateChannelWatchInfos(ctx context.Context, in *MigrateChannelWatchInfosRequest, opts ...grpc.CallOption) (*MigrateChannelWatchInfosResponse, error) {
+	out := new(MigrateChannelWatchInfosResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/MigrateChannelWatchInfos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) ReCollectSegmentStats(ctx context.Context, in *ReCollectSegmentStatsRequest, opts ...grpc.CallOption) (*ReCollectSegmentStatsResponse, error) {
+	out := new(ReCollectSegmentStatsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ReCollectSegmentStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetAuditEvents(ctx context.Context, in *GetAuditEventsRequest, opts ...grpc.CallOption) (*GetAuditEventsResponse, error) {
+	out := new(GetAuditEventsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetAuditEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetShardWriteStats(ctx context.Context, in *GetShardWriteStatsRequest, opts ...grpc.CallOption) (*GetShardWriteStatsResponse, error) {
+	out := new(GetShardWriteStatsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetShardWriteStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) FlushAndSeal(ctx context.Context, in *FlushAndSealRequest, opts ...grpc.CallOption) (*FlushAndSealResponse, error) {
+	out := new(FlushAndSealResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/FlushAndSeal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetStorageConsistencyReport(ctx context.Context, in *GetStorageConsistencyReportRequest, opts ...grpc.CallOption) (*GetStorageConsistencyReportResponse, error) {
+	out := new(GetStorageConsistencyReportResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetStorageConsistencyReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DataCoordServer is the server API for DataCoord service.
+type DataCoordServer interface {
+	GetComponentStates(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)
+	GetTimeTickChannel(context.Context, *internalpb.GetTimeTickChannelRequest) (*milvuspb.StringResponse, error)
+	GetStatisticsChannel(context.Context, *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error)
+	Flush(context.Context, *FlushRequest) (*FlushResponse, error)
+	AssignSegmentID(context.Context, *AssignSegmentIDRequest) (*AssignSegmentIDResponse, error)
+	GetSegmentInfo(context.Context, *GetSegmentInfoRequest) (*GetSegmentInfoResponse, error)
+	GetSegmentStates(context.Context, *GetSegmentStatesRequest) (*GetSegmentStatesResponse, error)
+	GetInsertBinlogPaths(context.Context, *GetInsertBinlogPathsRequest) (*GetInsertBinlogPathsResponse, error)
+	GetCollectionStatistics(context.Context, *GetCollectionStatisticsRequest) (*GetCollectionStatisticsResponse, error)
+	GetPartitionStatistics(context.Context, *GetPartitionStatisticsRequest) (*GetPartitionStatisticsResponse, error)
+	GetSegmentInfoChannel(context.Context, *GetSegmentInfoChannelRequest) (*milvuspb.StringResponse, error)
+	SaveBinlogPaths(context.Context, *SaveBinlogPathsRequest) (*commonpb.Status, error)
+	GetRecoveryInfo(context.Context, *GetRecoveryInfoRequest) (*GetRecoveryInfoResponse, error)
+	GetRecoveryInfoV2(context.Context, *GetRecoveryInfoRequestV2) (*GetRecoveryInfoResponseV2, error)
+	GetFlushedSegments(context.Context, *GetFlushedSegmentsRequest) (*GetFlushedSegmentsResponse, error)
+	GetSegmentsByStates(context.Context, *GetSegmentsByStatesRequest) (*GetSegmentsByStatesResponse, error)
+	GetFlushAllState(context.Context, *milvuspb.GetFlushAllStateRequest) (*milvuspb.GetFlushAllStateResponse, error)
+	ShowConfigurations(context.Context, *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error)
+	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
+	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
+	ManualCompaction(context.Context, *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error)
+	GetCompactionState(context.Context, *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error)
+	GetCompactionStateWithPlans(context.Context, *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error)
+	WatchChannels(context.Context, *WatchChannelsRequest) (*WatchChannelsResponse, error)
+	GetFlushState(context.Context, *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error)
+	DropVirtualChannel(context.Context, *DropVirtualChannelRequest) (*DropVirtualChannelResponse, error)
+	SetSegmentState(context.Context, *SetSegmentStateRequest) (*SetSegmentStateResponse, error)
+	// https://wiki.lfaidata.foundation/display/MIL/MEP+24+--+Support+bulk+load
+	Import(context.Context, *ImportTaskRequest) (*ImportTaskResponse, error)
+	UpdateSegmentStatistics(context.Context, *UpdateSegmentStatisticsRequest) (*commonpb.Status, error)
+	UpdateChannelCheckpoint(context.Context, *UpdateChannelCheckpointRequest) (*commonpb.Status, error)
+	SaveImportSegment(context.Context, *SaveImportSegmentRequest) (*commonpb.Status, error)
+	UnsetIsImportingState(context.Context, *UnsetIsImportingStateRequest) (*commonpb.Status, error)
+	MarkSegmentsDropped(context.Context, *MarkSegmentsDroppedRequest) (*commonpb.Status, error)
+	BroadcastAlteredCollection(context.Context, *AlterCollectionRequest) (*commonpb.Status, error)
+	CheckHealth(context.Context, *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error)
+	CreateIndex(context.Context, *indexpb.CreateIndexRequest) (*commonpb.Status, error)
+	// Deprecated: use DescribeIndex instead
+	GetIndexState(context.Context, *indexpb.GetIndexStateRequest) (*indexpb.GetIndexStateResponse, error)
+	GetSegmentIndexState(context.Context, *indexpb.GetSegmentIndexStateRequest) (*indexpb.GetSegmentIndexStateResponse, error)
+	GetIndexInfos(context.Context, *indexpb.GetIndexInfoRequest) (*indexpb.GetIndexInfoResponse, error)
+	DropIndex(context.Context, *indexpb.DropIndexRequest) (*commonpb.Status, error)
+	DescribeIndex(context.Context, *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error)
+	GetIndexStatistics(context.Context, *indexpb.GetIndexStatisticsRequest) (*indexpb.GetIndexStatisticsResponse, error)
+	// Deprecated: use DescribeIndex instead
+	GetIndexBuildProgress(context.Context, *indexpb.GetIndexBuildProgressRequest) (*indexpb.GetIndexBuildProgressResponse, error)
+	GcConfirm(context.Context, *GcConfirmRequest) (*GcConfirmResponse, error)
+	ReportDataNodeTtMsgs(context.Context, *ReportDataNodeTtMsgsRequest) (*commonpb.Status, error)
+	GetChannelWatchHistory(context.Context, *GetChannelWatchHistoryRequest) (*GetChannelWatchHistoryResponse, error)
+	SetNodeConfigs(context.Context, *SetNodeConfigsRequest) (*commonpb.Status, error)
+	ListNodeConfigs(context.Context, *ListNodeConfigsRequest) (*ListNodeConfigsResponse, error)
+	ClearNodeConfigs(context.Context, *ClearNodeConfigsRequest) (*commonpb.Status, error)
+	AlterIndex(context.Context, *indexpb.AlterIndexRequest) (*commonpb.Status, error)
+	CloneSegments(context.Context, *CloneSegmentsRequest) (*commonpb.Status, error)
+	GetCloneSegmentsState(context.Context, *GetCloneSegmentsStateRequest) (*GetCloneSegmentsStateResponse, error)
+	SetMaintenancePolicy(context.Context, *SetMaintenancePolicyRequest) (*commonpb.Status, error)
+	ListMaintenancePolicies(context.Context, *ListMaintenancePoliciesRequest) (*ListMaintenancePoliciesResponse, error)
+	CordonDataNode(context.Context, *CordonDataNodeRequest) (*commonpb.Status, error)
+	DrainDataNode(context.Context, *DrainDataNodeRequest) (*commonpb.Status, error)
+	UncordonDataNode(context.Context, *UncordonDataNodeRequest) (*commonpb.Status, error)
+	GetDataNodeDrainState(context.Context, *GetDataNodeDrainStateRequest) (*GetDataNodeDrainStateResponse, error)
+	RotateEncryptionKey(context.Context, *RotateEncryptionKeyRequest) (*RotateEncryptionKeyResponse, error)
+	GetEncryptionStatus(context.Context, *GetEncryptionStatusRequest) (*GetEncryptionStatusResponse, error)
+	GetChannelWatchStates(context.Context, *GetChannelWatchStatesRequest) (*GetChannelWatchStatesResponse, error)
+	ManualCompactionWithMode(context.Context, *ManualCompactionWithModeRequest) (*ManualCompactionWithModeResponse, error)
+	GetCompactionProgress(context.Context, *GetCompactionProgressRequest) (*GetCompactionProgressResponse, error)
+	CancelCompaction(context.Context, *CancelCompactionRequest) (*commonpb.Status, error)
+	GcDryRun(context.Context, *GcDryRunRequest) (*GcDryRunResponse, error)
+	PauseGC(context.Context, *PauseGCRequest) (*commonpb.Status, error)
+	ResumeGC(context.Context, *ResumeGCRequest) (*commonpb.Status, error)
+	GetGCStatus(context.Context, *GetGCStatusRequest) (*GetGCStatusResponse, error)
+	DropSegmentsByTimeRange(context.Context, *DropSegmentsByTimeRangeRequest) (*DropSegmentsByTimeRangeResponse, error)
+	GetTopologySnapshot(context.Context, *GetTopologySnapshotRequest) (*GetTopologySnapshotResponse, error)
+	RetryQuarantinedChannels(context.Context, *RetryQuarantinedChannelsRequest) (*RetryQuarantinedChannelsResponse, error)
+	MigrateChannelWatchInfos(context.Context, *MigrateChannelWatchInfosRequest) (*MigrateChannelWatchInfosResponse, error)
+	ReCollectSegmentStats(context.Context, *ReCollectSegmentStatsRequest) (*ReCollectSegmentStatsResponse, error)
+	GetAuditEvents(context.Context, *GetAuditEventsRequest) (*GetAuditEventsResponse, error)
+	GetShardWriteStats(context.Context, *GetShardWriteStatsRequest) (*GetShardWriteStatsResponse, error)
+	FlushAndSeal(context.Context, *FlushAndSealRequest) (*FlushAndSealResponse, error)
+	GetStorageConsistencyReport(context.Context, *GetStorageConsistencyReportRequest) (*GetStorageConsistencyReportResponse, error)
+}
+
+// UnimplementedDataCoordServer can be embedded to have forward compatible implementations.
+type UnimplementedDataCoordServer struct {
+}
+
+func (*UnimplementedDataCoordServer) GetComponentStates(ctx context.Context, req *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComponentStates not implemented")
+}
+func (*UnimplementedDataCoordServer) GetTimeTickChannel(ctx context.Context, req *internalpb.GetTimeTickChannelRequest) (*milvuspb.StringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTimeTickChannel not implemented")
+}
+func (*UnimplementedDataCoordServer) GetStatisticsChannel(ctx context.Context, req *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatisticsChannel not implemented")
+}
+func (*UnimplementedDataCoordServer) Flush(ctx context.Context, req *FlushRequest) (*FlushResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Flush not implemented")
+}
+func (*UnimplementedDataCoordServer) AssignSegmentID(ctx context.Context, req *AssignSegmentIDRequest) (*AssignSegmentIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignSegmentID not implemented")
+}
+func (*UnimplementedDataCoordServer) GetSegmentInfo(ctx context.Context, req *GetSegmentInfoRequest) (*GetSegmentInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentInfo not implemented")
+}
+func (*UnimplementedDataCoordServer) GetSegmentStates(ctx context.Context, req *GetSegmentStatesRequest) (*GetSegmentStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentStates not implemented")
+}
+func (*UnimplementedDataCoordServer) GetInsertBinlogPaths(ctx context.Context, req *GetInsertBinlogPathsRequest) (*GetInsertBinlogPathsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInsertBinlogPaths not implemented")
+}
+func (*UnimplementedDataCoordServer) GetCollectionStatistics(ctx context.Context, req *GetCollectionStatisticsRequest) (*GetCollectionStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionStatistics not implemented")
+}
+func (*UnimplementedDataCoordServer) GetPartitionStatistics(ctx context.Context, req *GetPartitionStatisticsRequest) (*GetPartitionStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPartitionStatistics not implemented")
+}
+func (*UnimplementedDataCoordServer) GetSegmentInfoChannel(ctx context.Context, req *GetSegmentInfoChannelRequest) (*milvuspb.StringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentInfoChannel not implemented")
+}
+func (*UnimplementedDataCoordServer) SaveBinlogPaths(ctx context.Context, req *SaveBinlogPathsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveBinlogPaths not implemented")
+}
+func (*UnimplementedDataCoordServer) GetRecoveryInfo(ctx context.Context, req *GetRecoveryInfoRequest) (*GetRecoveryInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecoveryInfo not implemented")
+}
+func (*UnimplementedDataCoordServer) GetRecoveryInfoV2(ctx context.Context, req *GetRecoveryInfoRequestV2) (*GetRecoveryInfoResponseV2, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecoveryInfoV2 not implemented")
+}
+func (*UnimplementedDataCoordServer) GetFlushedSegments(ctx context.Context, req *GetFlushedSegmentsRequest) (*GetFlushedSegmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFlushedSegments not implemented")
+}
+func (*UnimplementedDataCoordServer) GetSegmentsByStates(ctx context.Context, req *GetSegmentsByStatesRequest) (*GetSegmentsByStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentsByStates not implemented")
+}
+func (*UnimplementedDataCoordServer) GetFlushAllState(ctx context.Context, req *milvuspb.GetFlushAllStateRequest) (*milvuspb.GetFlushAllStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFlushAllState not implemented")
+}
+func (*UnimplementedDataCoordServer) ShowConfigurations(ctx context.Context, req *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShowConfigurations not implemented")
+}
+func (*UnimplementedDataCoordServer) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
+}
+func (*UnimplementedDataCoordServer) ManualCompaction(ctx context.Context, req *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ManualCompaction not implemented")
+}
+func (*UnimplementedDataCoordServer) GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompactionState not implemented")
+}
+func (*UnimplementedDataCoordServer) GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompactionStateWithPlans not implemented")
+}
+func (*UnimplementedDataCoordServer) WatchChannels(ctx context.Context, req *WatchChannelsRequest) (*WatchChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WatchChannels not implemented")
+}
+func (*UnimplementedDataCoordServer) GetFlushState(ctx context.Context, req *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFlushState not implemented")
+}
+func (*UnimplementedDataCoordServer) DropVirtualChannel(ctx context.Context, req *DropVirtualChannelRequest) (*DropVirtualChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropVirtualChannel not implemented")
+}
+func (*UnimplementedDataCoordServer) SetSegmentState(ctx context.Context, req *SetSegmentStateRequest) (*SetSegmentStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSegmentState not implemented")
+}
+func (*UnimplementedDataCoordServer) Import(ctx context.Context, req *ImportTaskRequest) (*ImportTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Import not implemented")
+}
+func (*UnimplementedDataCoordServer) UpdateSegmentStatistics(ctx context.Context, req *UpdateSegmentStatisticsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSegmentStatistics not implemented")
+}
+func (*UnimplementedDataCoordServer) UpdateChannelCheckpoint(ctx context.Context, req *UpdateChannelCheckpointRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChannelCheckpoint not implemented")
+}
+func (*UnimplementedDataCoordServer) SaveImportSegment(ctx context.Context, req *SaveImportSegmentRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveImportSegment not implemented")
+}
+func (*UnimplementedDataCoordServer) UnsetIsImportingState(ctx context.Context, req *UnsetIsImportingStateRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsetIsImportingState not implemented")
+}
+func (*UnimplementedDataCoordServer) MarkSegmentsDropped(ctx context.Context, req *MarkSegmentsDroppedRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkSegmentsDropped not implemented")
+}
+func (*UnimplementedDataCoordServer) BroadcastAlteredCollection(ctx context.Context, req *AlterCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BroadcastAlteredCollection not implemented")
+}
+func (*UnimplementedDataCoordServer) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckHealth not implemented")
+}
+func (*UnimplementedDataCoordServer) CreateIndex(ctx context.Context, req *indexpb.CreateIndexRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateIndex not implemented")
+}
+func (*UnimplementedDataCoordServer) GetIndexState(ctx context.Context, req *indexpb.GetIndexStateRequest) (*indexpb.GetIndexStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIndexState not implemented")
+}
+func (*UnimplementedDataCoordServer) GetSegmentIndexState(ctx context.Context, req *indexpb.GetSegmentIndexStateRequest) (*indexpb.GetSegmentIndexStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentIndexState not implemented")
+}
+func (*UnimplementedDataCoordServer) GetIndexInfos(ctx context.Context, req *indexpb.GetIndexInfoRequest) (*indexpb.GetIndexInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIndexInfos not implemented")
+}
+func (*UnimplementedDataCoordServer) DropIndex(ctx context.Context, req *indexpb.DropIndexRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropIndex not implemented")
+}
+func (*UnimplementedDataCoordServer) DescribeIndex(ctx context.Context, req *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeIndex not implemented")
+}
+func (*UnimplementedDataCoordServer) GetIndexStatistics(ctx context.Context, req *indexpb.GetIndexStatisticsRequest) (*indexpb.GetIndexStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIndexStatistics not implemented")
+}
+func (*UnimplementedDataCoordServer) GetIndexBuildProgress(ctx context.Context, req *indexpb.GetIndexBuildProgressRequest) (*indexpb.GetIndexBuildProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIndexBuildProgress not implemented")
+}
+func (*UnimplementedDataCoordServer) GcConfirm(ctx context.Context, req *GcConfirmRequest) (*GcConfirmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GcConfirm not implemented")
+}
+func (*UnimplementedDataCoordServer) ReportDataNodeTtMsgs(ctx context.Context, req *ReportDataNodeTtMsgsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportDataNodeTtMsgs not implemented")
+}
+func (*UnimplementedDataCoordServer) GetChannelWatchHistory(ctx context.Context, req *GetChannelWatchHistoryRequest) (*GetChannelWatchHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannelWatchHistory not implemented")
+}
+func (*UnimplementedDataCoordServer) SetNodeConfigs(ctx context.Context, req *SetNodeConfigsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNodeConfigs not implemented")
+}
+func (*UnimplementedDataCoordServer) ListNodeConfigs(ctx context.Context, req *ListNodeConfigsRequest) (*ListNodeConfigsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNodeConfigs not implemented")
+}
+func (*UnimplementedDataCoordServer) ClearNodeConfigs(ctx context.Context, req *ClearNodeConfigsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearNodeConfigs not implemented")
+}
+func (*UnimplementedDataCoordServer) AlterIndex(ctx context.Context, req *indexpb.AlterIndexRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AlterIndex not implemented")
+}
+func (*UnimplementedDataCoordServer) CloneSegments(ctx context.Context, req *CloneSegmentsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneSegments not implemented")
+}
+func (*UnimplementedDataCoordServer) GetCloneSegmentsState(ctx context.Context, req *GetCloneSegmentsStateRequest) (*GetCloneSegmentsStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCloneSegmentsState not implemented")
+}
+func (*UnimplementedDataCoordServer) SetMaintenancePolicy(ctx context.Context, req *SetMaintenancePolicyRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaintenancePolicy not implemented")
+}
+func (*UnimplementedDataCoordServer) ListMaintenancePolicies(ctx context.Context, req *ListMaintenancePoliciesRequest) (*ListMaintenancePoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMaintenancePolicies not implemented")
+}
+func (*UnimplementedDataCoordServer) CordonDataNode(ctx context.Context, req *CordonDataNodeRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CordonDataNode not implemented")
+}
+func (*UnimplementedDataCoordServer) DrainDataNode(ctx context.Context, req *DrainDataNodeRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrainDataNode not implemented")
+}
+func (*UnimplementedDataCoordServer) UncordonDataNode(ctx context.Context, req *UncordonDataNodeRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UncordonDataNode not implemented")
+}
+func (*UnimplementedDataCoordServer) GetDataNodeDrainState(ctx context.Context, req *GetDataNodeDrainStateRequest) (*GetDataNodeDrainStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataNodeDrainState not implemented")
+}
+func (*UnimplementedDataCoordServer) RotateEncryptionKey(ctx context.Context, req *RotateEncryptionKeyRequest) (*RotateEncryptionKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateEncryptionKey not implemented")
+}
+func (*UnimplementedDataCoordServer) GetEncryptionStatus(ctx context.Context, req *GetEncryptionStatusRequest) (*GetEncryptionStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEncryptionStatus not implemented")
+}
+func (*UnimplementedDataCoordServer) GetChannelWatchStates(ctx context.Context, req *GetChannelWatchStatesRequest) (*GetChannelWatchStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannelWatchStates not implemented")
+}
+func (*UnimplementedDataCoordServer) ManualCompactionWithMode(ctx context.Context, req *ManualCompactionWithModeRequest) (*ManualCompactionWithModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ManualCompactionWithMode not implemented")
+}
+func (*UnimplementedDataCoordServer) GetCompactionProgress(ctx context.Context, req *GetCompactionProgressRequest) (*GetCompactionProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompactionProgress not implemented")
+}
+func (*UnimplementedDataCoordServer) CancelCompaction(ctx context.Context, req *CancelCompactionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelCompaction not implemented")
+}
+func (*UnimplementedDataCoordServer) GcDryRun(ctx context.Context, req *GcDryRunRequest) (*GcDryRunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GcDryRun not implemented")
+}
+func (*UnimplementedDataCoordServer) PauseGC(ctx context.Context, req *PauseGCRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseGC not implemented")
+}
+func (*UnimplementedDataCoordServer) ResumeGC(ctx context.Context, req *ResumeGCRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeGC not implemented")
+}
+func (*UnimplementedDataCoordServer) GetGCStatus(ctx context.Context, req *GetGCStatusRequest) (*GetGCStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGCStatus not implemented")
+}
+func (*UnimplementedDataCoordServer) DropSegmentsByTimeRange(ctx context.Context, req *DropSegmentsByTimeRangeRequest) (*DropSegmentsByTimeRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropSegmentsByTimeRange not implemented")
+}
+func (*UnimplementedDataCoordServer) GetTopologySnapshot(ctx context.Context, req *GetTopologySnapshotRequest) (*GetTopologySnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopologySnapshot not implemented")
+}
+func (*UnimplementedDataCoordServer) RetryQuarantinedChannels(ctx context.Context, req *RetryQuarantinedChannelsRequest) (*RetryQuarantinedChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryQuarantinedChannels not implemented")
+}
+func (*UnimplementedDataCoordServer) MigrateChannelWatchInfos(ctx context.Context, req *MigrateChannelWatchInfosRequest) (*MigrateChannelWatchInfosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateChannelWatchInfos not implemented")
+}
+func (*UnimplementedDataCoordServer) ReCollectSegmentStats(ctx context.Context, req *ReCollectSegmentStatsRequest) (*ReCollectSegmentStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReCollectSegmentStats not implemented")
+}
+func (*UnimplementedDataCoordServer) GetAuditEvents(ctx context.Context, req *GetAuditEventsRequest) (*GetAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditEvents not implemented")
+}
+func (*UnimplementedDataCoordServer) GetShardWriteStats(ctx context.Context, req *GetShardWriteStatsRequest) (*GetShardWriteStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShardWriteStats not implemented")
+}
+func (*UnimplementedDataCoordServer) FlushAndSeal(ctx context.Context, req *FlushAndSealRequest) (*FlushAndSealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlushAndSeal not implemented")
+}
+func (*UnimplementedDataCoordServer) GetStorageConsistencyReport(ctx context.Context, req *GetStorageConsistencyReportRequest) (*GetStorageConsistencyReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStorageConsistencyReport not implemented")
+}
+
+func RegisterDataCoordServer(s *grpc.Server, srv DataCoordServer) {
+	s.RegisterService(&_DataCoord_serviceDesc, srv)
+}
+
+func _DataCoord_GetComponentStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.GetComponentStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetComponentStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetComponentStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetComponentStates(ctx, req.(*milvuspb.GetComponentStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetTimeTickChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.GetTimeTickChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetTimeTickChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetTimeTickChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetTimeTickChannel(ctx, req.(*internalpb.GetTimeTickChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetStatisticsChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.GetStatisticsChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetStatisticsChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetStatisticsChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetStatisticsChannel(ctx, req.(*internalpb.GetStatisticsChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_Flush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlushRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).Flush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/Flush",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).Flush(ctx, req.(*FlushRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_AssignSegmentID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignSegmentIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).AssignSegmentID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/AssignSegmentID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).AssignSegmentID(ctx, req.(*AssignSegmentIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetSegmentInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSegmentInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetSegmentInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetSegmentInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetSegmentInfo(ctx, req.(*GetSegmentInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetSegmentStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSegmentStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetSegmentStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetSegmentStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetSegmentStates(ctx, req.(*GetSegmentStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetInsertBinlogPaths_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInsertBinlogPathsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetInsertBinlogPaths(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetInsertBinlogPaths",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetInsertBinlogPaths(ctx, req.(*GetInsertBinlogPathsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetCollectionStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetCollectionStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetCollectionStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetCollectionStatistics(ctx, req.(*GetCollectionStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetPartitionStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPartitionStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetPartitionStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetPartitionStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetPartitionStatistics(ctx, req.(*GetPartitionStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetSegmentInfoChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSegmentInfoChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetSegmentInfoChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetSegmentInfoChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetSegmentInfoChannel(ctx, req.(*GetSegmentInfoChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_SaveBinlogPaths_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveBinlogPathsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).SaveBinlogPaths(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/SaveBinlogPaths",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).SaveBinlogPaths(ctx, req.(*SaveBinlogPathsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetRecoveryInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecoveryInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetRecoveryInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetRecoveryInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetRecoveryInfo(ctx, req.(*GetRecoveryInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetRecoveryInfoV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecoveryInfoRequestV2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetRecoveryInfoV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetRecoveryInfoV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetRecoveryInfoV2(ctx, req.(*GetRecoveryInfoRequestV2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetFlushedSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFlushedSegmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetFlushedSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetFlushedSegments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetFlushedSegments(ctx, req.(*GetFlushedSegmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetSegmentsByStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSegmentsByStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetSegmentsByStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetSegmentsByStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetSegmentsByStates(ctx, req.(*GetSegmentsByStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetFlushAllState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.GetFlushAllStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetFlushAllState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetFlushAllState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetFlushAllState(ctx, req.(*milvuspb.GetFlushAllStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_ShowConfigurations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.ShowConfigurationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).ShowConfigurations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/ShowConfigurations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).ShowConfigurations(ctx, req.(*internalpb.ShowConfigurationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.GetMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetMetrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetMetrics(ctx, req.(*milvuspb.GetMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_ManualCompaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.ManualCompactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).ManualCompaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/ManualCompaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).ManualCompaction(ctx, req.(*milvuspb.ManualCompactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetCompactionState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.GetCompactionStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetCompactionState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetCompactionState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetCompactionState(ctx, req.(*milvuspb.GetCompactionStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetCompactionStateWithPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.GetCompactionPlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetCompactionStateWithPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetCompactionStateWithPlans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetCompactionStateWithPlans(ctx, req.(*milvuspb.GetCompactionPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_WatchChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WatchChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).WatchChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/WatchChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).WatchChannels(ctx, req.(*WatchChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetFlushState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.GetFlushStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetFlushState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetFlushState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetFlushState(ctx, req.(*milvuspb.GetFlushStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_DropVirtualChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropVirtualChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).DropVirtualChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/DropVirtualChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).DropVirtualChannel(ctx, req.(*DropVirtualChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_SetSegmentState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSegmentStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).SetSegmentState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/SetSegmentState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).SetSegmentState(ctx, req.(*SetSegmentStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_Import_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).Import(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/Import",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).Import(ctx, req.(*ImportTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_UpdateSegmentStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSegmentStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).UpdateSegmentStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/UpdateSegmentStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).UpdateSegmentStatistics(ctx, req.(*UpdateSegmentStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_UpdateChannelCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChannelCheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).UpdateChannelCheckpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/UpdateChannelCheckpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).UpdateChannelCheckpoint(ctx, req.(*UpdateChannelCheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_SaveImportSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveImportSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).SaveImportSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/SaveImportSegment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).SaveImportSegment(ctx, req.(*SaveImportSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_UnsetIsImportingState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsetIsImportingStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).UnsetIsImportingState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/UnsetIsImportingState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).UnsetIsImportingState(ctx, req.(*UnsetIsImportingStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterDataCoordServer(s *grpc.Server, srv DataCoordServer) {
-	s.RegisterService(&_DataCoord_serviceDesc, srv)
+func _DataCoord_MarkSegmentsDropped_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkSegmentsDroppedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).MarkSegmentsDropped(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/MarkSegmentsDropped",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).MarkSegmentsDropped(ctx, req.(*MarkSegmentsDroppedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetComponentStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.GetComponentStatesRequest)
+func _DataCoord_BroadcastAlteredCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlterCollectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetComponentStates(ctx, in)
+		return srv.(DataCoordServer).BroadcastAlteredCollection(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetComponentStates",
+		FullMethod: "/milvus.proto.data.DataCoord/BroadcastAlteredCollection",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetComponentStates(ctx, req.(*milvuspb.GetComponentStatesRequest))
+		return srv.(DataCoordServer).BroadcastAlteredCollection(ctx, req.(*AlterCollectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetTimeTickChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(internalpb.GetTimeTickChannelRequest)
+func _DataCoord_CheckHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.CheckHealthRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetTimeTickChannel(ctx, in)
+		return srv.(DataCoordServer).CheckHealth(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetTimeTickChannel",
+		FullMethod: "/milvus.proto.data.DataCoord/CheckHealth",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetTimeTickChannel(ctx, req.(*internalpb.GetTimeTickChannelRequest))
+		return srv.(DataCoordServer).CheckHealth(ctx, req.(*milvuspb.CheckHealthRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetStatisticsChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(internalpb.GetStatisticsChannelRequest)
+func _DataCoord_CreateIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(indexpb.CreateIndexRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetStatisticsChannel(ctx, in)
+		return srv.(DataCoordServer).CreateIndex(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetStatisticsChannel",
+		FullMethod: "/milvus.proto.data.DataCoord/CreateIndex",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetStatisticsChannel(ctx, req.(*internalpb.GetStatisticsChannelRequest))
+		return srv.(DataCoordServer).CreateIndex(ctx, req.(*indexpb.CreateIndexRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_Flush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FlushRequest)
+func _DataCoord_GetIndexState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(indexpb.GetIndexStateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).Flush(ctx, in)
+		return srv.(DataCoordServer).GetIndexState(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/Flush",
+		FullMethod: "/milvus.proto.data.DataCoord/GetIndexState",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).Flush(ctx, req.(*FlushRequest))
+		return srv.(DataCoordServer).GetIndexState(ctx, req.(*indexpb.GetIndexStateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_AssignSegmentID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AssignSegmentIDRequest)
+func _DataCoord_GetSegmentIndexState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(indexpb.GetSegmentIndexStateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).AssignSegmentID(ctx, in)
+		return srv.(DataCoordServer).GetSegmentIndexState(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/AssignSegmentID",
+		FullMethod: "/milvus.proto.data.DataCoord/GetSegmentIndexState",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).AssignSegmentID(ctx, req.(*AssignSegmentIDRequest))
+		return srv.(DataCoordServer).GetSegmentIndexState(ctx, req.(*indexpb.GetSegmentIndexStateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetSegmentInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSegmentInfoRequest)
+func _DataCoord_GetIndexInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(indexpb.GetIndexInfoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetSegmentInfo(ctx, in)
+		return srv.(DataCoordServer).GetIndexInfos(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetSegmentInfo",
+		FullMethod: "/milvus.proto.data.DataCoord/GetIndexInfos",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetSegmentInfo(ctx, req.(*GetSegmentInfoRequest))
+		return srv.(DataCoordServer).GetIndexInfos(ctx, req.(*indexpb.GetIndexInfoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetSegmentStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSegmentStatesRequest)
+func _DataCoord_DropIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(indexpb.DropIndexRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetSegmentStates(ctx, in)
+		return srv.(DataCoordServer).DropIndex(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetSegmentStates",
+		FullMethod: "/milvus.proto.data.DataCoord/DropIndex",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetSegmentStates(ctx, req.(*GetSegmentStatesRequest))
+		return srv.(DataCoordServer).DropIndex(ctx, req.(*indexpb.DropIndexRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetInsertBinlogPaths_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetInsertBinlogPathsRequest)
+func _DataCoord_DescribeIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(indexpb.DescribeIndexRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetInsertBinlogPaths(ctx, in)
+		return srv.(DataCoordServer).DescribeIndex(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetInsertBinlogPaths",
+		FullMethod: "/milvus.proto.data.DataCoord/DescribeIndex",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetInsertBinlogPaths(ctx, req.(*GetInsertBinlogPathsRequest))
+		return srv.(DataCoordServer).DescribeIndex(ctx, req.(*indexpb.DescribeIndexRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetCollectionStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCollectionStatisticsRequest)
+func _DataCoord_GetIndexStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(indexpb.GetIndexStatisticsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetCollectionStatistics(ctx, in)
+		return srv.(DataCoordServer).GetIndexStatistics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetCollectionStatistics",
+		FullMethod: "/milvus.proto.data.DataCoord/GetIndexStatistics",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetCollectionStatistics(ctx, req.(*GetCollectionStatisticsRequest))
+		return srv.(DataCoordServer).GetIndexStatistics(ctx, req.(*indexpb.GetIndexStatisticsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetPartitionStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPartitionStatisticsRequest)
+func _DataCoord_GetIndexBuildProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(indexpb.GetIndexBuildProgressRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetPartitionStatistics(ctx, in)
+		return srv.(DataCoordServer).GetIndexBuildProgress(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetPartitionStatistics",
+		FullMethod: "/milvus.proto.data.DataCoord/GetIndexBuildProgress",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetPartitionStatistics(ctx, req.(*GetPartitionStatisticsRequest))
+		return srv.(DataCoordServer).GetIndexBuildProgress(ctx, req.(*indexpb.GetIndexBuildProgressRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetSegmentInfoChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSegmentInfoChannelRequest)
+func _DataCoord_GcConfirm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GcConfirmRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetSegmentInfoChannel(ctx, in)
+		return srv.(DataCoordServer).GcConfirm(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetSegmentInfoChannel",
+		FullMethod: "/milvus.proto.data.DataCoord/GcConfirm",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetSegmentInfoChannel(ctx, req.(*GetSegmentInfoChannelRequest))
+		return srv.(DataCoordServer).GcConfirm(ctx, req.(*GcConfirmRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_SaveBinlogPaths_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SaveBinlogPathsRequest)
+func _DataCoord_ReportDataNodeTtMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportDataNodeTtMsgsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).SaveBinlogPaths(ctx, in)
+		return srv.(DataCoordServer).ReportDataNodeTtMsgs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/SaveBinlogPaths",
+		FullMethod: "/milvus.proto.data.DataCoord/ReportDataNodeTtMsgs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).SaveBinlogPaths(ctx, req.(*SaveBinlogPathsRequest))
+		return srv.(DataCoordServer).ReportDataNodeTtMsgs(ctx, req.(*ReportDataNodeTtMsgsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetRecoveryInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRecoveryInfoRequest)
+func _DataCoord_GetChannelWatchHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChannelWatchHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetRecoveryInfo(ctx, in)
+		return srv.(DataCoordServer).GetChannelWatchHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetRecoveryInfo",
+		FullMethod: "/milvus.proto.data.DataCoord/GetChannelWatchHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetRecoveryInfo(ctx, req.(*GetRecoveryInfoRequest))
+		return srv.(DataCoordServer).GetChannelWatchHistory(ctx, req.(*GetChannelWatchHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetRecoveryInfoV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRecoveryInfoRequestV2)
+func _DataCoord_SetNodeConfigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNodeConfigsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetRecoveryInfoV2(ctx, in)
+		return srv.(DataCoordServer).SetNodeConfigs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetRecoveryInfoV2",
+		FullMethod: "/milvus.proto.data.DataCoord/SetNodeConfigs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetRecoveryInfoV2(ctx, req.(*GetRecoveryInfoRequestV2))
+		return srv.(DataCoordServer).SetNodeConfigs(ctx, req.(*SetNodeConfigsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetFlushedSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFlushedSegmentsRequest)
+func _DataCoord_ListNodeConfigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNodeConfigsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetFlushedSegments(ctx, in)
+		return srv.(DataCoordServer).ListNodeConfigs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetFlushedSegments",
+		FullMethod: "/milvus.proto.data.DataCoord/ListNodeConfigs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetFlushedSegments(ctx, req.(*GetFlushedSegmentsRequest))
+		return srv.(DataCoordServer).ListNodeConfigs(ctx, req.(*ListNodeConfigsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetSegmentsByStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSegmentsByStatesRequest)
+func _DataCoord_ClearNodeConfigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearNodeConfigsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetSegmentsByStates(ctx, in)
+		return srv.(DataCoordServer).ClearNodeConfigs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetSegmentsByStates",
+		FullMethod: "/milvus.proto.data.DataCoord/ClearNodeConfigs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetSegmentsByStates(ctx, req.(*GetSegmentsByStatesRequest))
+		return srv.(DataCoordServer).ClearNodeConfigs(ctx, req.(*ClearNodeConfigsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetFlushAllState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.GetFlushAllStateRequest)
+func _DataCoord_AlterIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(indexpb.AlterIndexRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetFlushAllState(ctx, in)
+		return srv.(DataCoordServer).AlterIndex(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetFlushAllState",
+		FullMethod: "/milvus.proto.data.DataCoord/AlterIndex",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetFlushAllState(ctx, req.(*milvuspb.GetFlushAllStateRequest))
+		return srv.(DataCoordServer).AlterIndex(ctx, req.(*indexpb.AlterIndexRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_ShowConfigurations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(internalpb.ShowConfigurationsRequest)
+func _DataCoord_CloneSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneSegmentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).ShowConfigurations(ctx, in)
+		return srv.(DataCoordServer).CloneSegments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/ShowConfigurations",
+		FullMethod: "/milvus.proto.data.DataCoord/CloneSegments",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).ShowConfigurations(ctx, req.(*internalpb.ShowConfigurationsRequest))
+		return srv.(DataCoordServer).CloneSegments(ctx, req.(*CloneSegmentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.GetMetricsRequest)
+func _DataCoord_GetCloneSegmentsState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCloneSegmentsStateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetMetrics(ctx, in)
+		return srv.(DataCoordServer).GetCloneSegmentsState(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetMetrics",
+		FullMethod: "/milvus.proto.data.DataCoord/GetCloneSegmentsState",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetMetrics(ctx, req.(*milvuspb.GetMetricsRequest))
+		return srv.(DataCoordServer).GetCloneSegmentsState(ctx, req.(*GetCloneSegmentsStateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_ManualCompaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.ManualCompactionRequest)
+func _DataCoord_SetMaintenancePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMaintenancePolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).ManualCompaction(ctx, in)
+		return srv.(DataCoordServer).SetMaintenancePolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/ManualCompaction",
+		FullMethod: "/milvus.proto.data.DataCoord/SetMaintenancePolicy",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).ManualCompaction(ctx, req.(*milvuspb.ManualCompactionRequest))
+		return srv.(DataCoordServer).SetMaintenancePolicy(ctx, req.(*SetMaintenancePolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetCompactionState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.GetCompactionStateRequest)
+func _DataCoord_ListMaintenancePolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMaintenancePoliciesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetCompactionState(ctx, in)
+		return srv.(DataCoordServer).ListMaintenancePolicies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetCompactionState",
+		FullMethod: "/milvus.proto.data.DataCoord/ListMaintenancePolicies",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetCompactionState(ctx, req.(*milvuspb.GetCompactionStateRequest))
+		return srv.(DataCoordServer).ListMaintenancePolicies(ctx, req.(*ListMaintenancePoliciesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetCompactionStateWithPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.GetCompactionPlansRequest)
+func _DataCoord_CordonDataNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CordonDataNodeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetCompactionStateWithPlans(ctx, in)
+		return srv.(DataCoordServer).CordonDataNode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetCompactionStateWithPlans",
+		FullMethod: "/milvus.proto.data.DataCoord/CordonDataNode",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetCompactionStateWithPlans(ctx, req.(*milvuspb.GetCompactionPlansRequest))
+		return srv.(DataCoordServer).CordonDataNode(ctx, req.(*CordonDataNodeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_WatchChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WatchChannelsRequest)
+func _DataCoord_DrainDataNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainDataNodeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).WatchChannels(ctx, in)
+		return srv.(DataCoordServer).DrainDataNode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/WatchChannels",
+		FullMethod: "/milvus.proto.data.DataCoord/DrainDataNode",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).WatchChannels(ctx, req.(*WatchChannelsRequest))
+		return srv.(DataCoordServer).DrainDataNode(ctx, req.(*DrainDataNodeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetFlushState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.GetFlushStateRequest)
+func _DataCoord_UncordonDataNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UncordonDataNodeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetFlushState(ctx, in)
+		return srv.(DataCoordServer).UncordonDataNode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetFlushState",
+		FullMethod: "/milvus.proto.data.DataCoord/UncordonDataNode",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetFlushState(ctx, req.(*milvuspb.GetFlushStateRequest))
+		return srv.(DataCoordServer).UncordonDataNode(ctx, req.(*UncordonDataNodeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_DropVirtualChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DropVirtualChannelRequest)
+func _DataCoord_GetDataNodeDrainState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataNodeDrainStateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).DropVirtualChannel(ctx, in)
+		return srv.(DataCoordServer).GetDataNodeDrainState(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/DropVirtualChannel",
+		FullMethod: "/milvus.proto.data.DataCoord/GetDataNodeDrainState",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).DropVirtualChannel(ctx, req.(*DropVirtualChannelRequest))
+		return srv.(DataCoordServer).GetDataNodeDrainState(ctx, req.(*GetDataNodeDrainStateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_SetSegmentState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetSegmentStateRequest)
+func _DataCoord_RotateEncryptionKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateEncryptionKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).SetSegmentState(ctx, in)
+		return srv.(DataCoordServer).RotateEncryptionKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/SetSegmentState",
+		FullMethod: "/milvus.proto.data.DataCoord/RotateEncryptionKey",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).SetSegmentState(ctx, req.(*SetSegmentStateRequest))
+		return srv.(DataCoordServer).RotateEncryptionKey(ctx, req.(*RotateEncryptionKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_Import_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ImportTaskRequest)
+func _DataCoord_GetEncryptionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEncryptionStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).Import(ctx, in)
+		return srv.(DataCoordServer).GetEncryptionStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/Import",
+		FullMethod: "/milvus.proto.data.DataCoord/GetEncryptionStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).Import(ctx, req.(*ImportTaskRequest))
+		return srv.(DataCoordServer).GetEncryptionStatus(ctx, req.(*GetEncryptionStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_UpdateSegmentStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateSegmentStatisticsRequest)
+func _DataCoord_GetChannelWatchStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChannelWatchStatesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).UpdateSegmentStatistics(ctx, in)
+		return srv.(DataCoordServer).GetChannelWatchStates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/UpdateSegmentStatistics",
+		FullMethod: "/milvus.proto.data.DataCoord/GetChannelWatchStates",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).UpdateSegmentStatistics(ctx, req.(*UpdateSegmentStatisticsRequest))
+		return srv.(DataCoordServer).GetChannelWatchStates(ctx, req.(*GetChannelWatchStatesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_UpdateChannelCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateChannelCheckpointRequest)
+func _DataCoord_ManualCompactionWithMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ManualCompactionWithModeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).UpdateChannelCheckpoint(ctx, in)
+		return srv.(DataCoordServer).ManualCompactionWithMode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/UpdateChannelCheckpoint",
+		FullMethod: "/milvus.proto.data.DataCoord/ManualCompactionWithMode",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).UpdateChannelCheckpoint(ctx, req.(*UpdateChannelCheckpointRequest))
+		return srv.(DataCoordServer).ManualCompactionWithMode(ctx, req.(*ManualCompactionWithModeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_SaveImportSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SaveImportSegmentRequest)
+func _DataCoord_GetCompactionProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompactionProgressRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).SaveImportSegment(ctx, in)
+		return srv.(DataCoordServer).GetCompactionProgress(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/SaveImportSegment",
+		FullMethod: "/milvus.proto.data.DataCoord/GetCompactionProgress",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).SaveImportSegment(ctx, req.(*SaveImportSegmentRequest))
+		return srv.(DataCoordServer).GetCompactionProgress(ctx, req.(*GetCompactionProgressRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_UnsetIsImportingState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnsetIsImportingStateRequest)
+func _DataCoord_CancelCompaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelCompactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).UnsetIsImportingState(ctx, in)
+		return srv.(DataCoordServer).CancelCompaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/UnsetIsImportingState",
+		FullMethod: "/milvus.proto.data.DataCoord/CancelCompaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).UnsetIsImportingState(ctx, req.(*UnsetIsImportingStateRequest))
+		return srv.(DataCoordServer).CancelCompaction(ctx, req.(*CancelCompactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_MarkSegmentsDropped_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MarkSegmentsDroppedRequest)
+func _DataCoord_GcDryRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GcDryRunRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).MarkSegmentsDropped(ctx, in)
+		return srv.(DataCoordServer).GcDryRun(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/MarkSegmentsDropped",
+		FullMethod: "/milvus.proto.data.DataCoord/GcDryRun",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).MarkSegmentsDropped(ctx, req.(*MarkSegmentsDroppedRequest))
+		return srv.(DataCoordServer).GcDryRun(ctx, req.(*GcDryRunRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_BroadcastAlteredCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AlterCollectionRequest)
+func _DataCoord_PauseGC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseGCRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).BroadcastAlteredCollection(ctx, in)
+		return srv.(DataCoordServer).PauseGC(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/BroadcastAlteredCollection",
+		FullMethod: "/milvus.proto.data.DataCoord/PauseGC",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).BroadcastAlteredCollection(ctx, req.(*AlterCollectionRequest))
+		return srv.(DataCoordServer).PauseGC(ctx, req.(*PauseGCRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_CheckHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.CheckHealthRequest)
+func _DataCoord_ResumeGC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeGCRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).CheckHealth(ctx, in)
+		return srv.(DataCoordServer).ResumeGC(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/CheckHealth",
+		FullMethod: "/milvus.proto.data.DataCoord/ResumeGC",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).CheckHealth(ctx, req.(*milvuspb.CheckHealthRequest))
+		return srv.(DataCoordServer).ResumeGC(ctx, req.(*ResumeGCRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_CreateIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(indexpb.CreateIndexRequest)
+func _DataCoord_GetGCStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGCStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).CreateIndex(ctx, in)
+		return srv.(DataCoordServer).GetGCStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/CreateIndex",
+		FullMethod: "/milvus.proto.data.DataCoord/GetGCStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).CreateIndex(ctx, req.(*indexpb.CreateIndexRequest))
+		return srv.(DataCoordServer).GetGCStatus(ctx, req.(*GetGCStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetIndexState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(indexpb.GetIndexStateRequest)
+func _DataCoord_DropSegmentsByTimeRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropSegmentsByTimeRangeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetIndexState(ctx, in)
+		return srv.(DataCoordServer).DropSegmentsByTimeRange(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetIndexState",
+		FullMethod: "/milvus.proto.data.DataCoord/DropSegmentsByTimeRange",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetIndexState(ctx, req.(*indexpb.GetIndexStateRequest))
+		return srv.(DataCoordServer).DropSegmentsByTimeRange(ctx, req.(*DropSegmentsByTimeRangeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetSegmentIndexState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(indexpb.GetSegmentIndexStateRequest)
+func _DataCoord_GetTopologySnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopologySnapshotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetSegmentIndexState(ctx, in)
+		return srv.(DataCoordServer).GetTopologySnapshot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetSegmentIndexState",
+		FullMethod: "/milvus.proto.data.DataCoord/GetTopologySnapshot",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetSegmentIndexState(ctx, req.(*indexpb.GetSegmentIndexStateRequest))
+		return srv.(DataCoordServer).GetTopologySnapshot(ctx, req.(*GetTopologySnapshotRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetIndexInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(indexpb.GetIndexInfoRequest)
+func _DataCoord_RetryQuarantinedChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryQuarantinedChannelsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetIndexInfos(ctx, in)
+		return srv.(DataCoordServer).RetryQuarantinedChannels(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetIndexInfos",
+		FullMethod: "/milvus.proto.data.DataCoord/RetryQuarantinedChannels",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetIndexInfos(ctx, req.(*indexpb.GetIndexInfoRequest))
+		return srv.(DataCoordServer).RetryQuarantinedChannels(ctx, req.(*RetryQuarantinedChannelsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_DropIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(indexpb.DropIndexRequest)
+func _DataCoord_MigrateChannelWatchInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateChannelWatchInfosRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).DropIndex(ctx, in)
+		return srv.(DataCoordServer).MigrateChannelWatchInfos(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/DropIndex",
+		FullMethod: "/milvus.proto.data.DataCoord/MigrateChannelWatchInfos",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).DropIndex(ctx, req.(*indexpb.DropIndexRequest))
+		return srv.(DataCoordServer).MigrateChannelWatchInfos(ctx, req.(*MigrateChannelWatchInfosRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_DescribeIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(indexpb.DescribeIndexRequest)
+func _DataCoord_ReCollectSegmentStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReCollectSegmentStatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).DescribeIndex(ctx, in)
+		return srv.(DataCoordServer).ReCollectSegmentStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/DescribeIndex",
+		FullMethod: "/milvus.proto.data.DataCoord/ReCollectSegmentStats",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).DescribeIndex(ctx, req.(*indexpb.DescribeIndexRequest))
+		return srv.(DataCoordServer).ReCollectSegmentStats(ctx, req.(*ReCollectSegmentStatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetIndexStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(indexpb.GetIndexStatisticsRequest)
+func _DataCoord_GetAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditEventsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetIndexStatistics(ctx, in)
+		return srv.(DataCoordServer).GetAuditEvents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetIndexStatistics",
+		FullMethod: "/milvus.proto.data.DataCoord/GetAuditEvents",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetIndexStatistics(ctx, req.(*indexpb.GetIndexStatisticsRequest))
+		return srv.(DataCoordServer).GetAuditEvents(ctx, req.(*GetAuditEventsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GetIndexBuildProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(indexpb.GetIndexBuildProgressRequest)
+func _DataCoord_GetShardWriteStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShardWriteStatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GetIndexBuildProgress(ctx, in)
+		return srv.(DataCoordServer).GetShardWriteStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GetIndexBuildProgress",
+		FullMethod: "/milvus.proto.data.DataCoord/GetShardWriteStats",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GetIndexBuildProgress(ctx, req.(*indexpb.GetIndexBuildProgressRequest))
+		return srv.(DataCoordServer).GetShardWriteStats(ctx, req.(*GetShardWriteStatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_GcConfirm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GcConfirmRequest)
+func _DataCoord_FlushAndSeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlushAndSealRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).GcConfirm(ctx, in)
+		return srv.(DataCoordServer).FlushAndSeal(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/GcConfirm",
+		FullMethod: "/milvus.proto.data.DataCoord/FlushAndSeal",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).GcConfirm(ctx, req.(*GcConfirmRequest))
+		return srv.(DataCoordServer).FlushAndSeal(ctx, req.(*FlushAndSealRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataCoord_ReportDataNodeTtMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReportDataNodeTtMsgsRequest)
+func _DataCoord_GetStorageConsistencyReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStorageConsistencyReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataCoordServer).ReportDataNodeTtMsgs(ctx, in)
+		return srv.(DataCoordServer).GetStorageConsistencyReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.data.DataCoord/ReportDataNodeTtMsgs",
+		FullMethod: "/milvus.proto.data.DataCoord/GetStorageConsistencyReport",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataCoordServer).ReportDataNodeTtMsgs(ctx, req.(*ReportDataNodeTtMsgsRequest))
+		return srv.(DataCoordServer).GetStorageConsistencyReport(ctx, req.(*GetStorageConsistencyReportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -6985,6 +12946,242 @@ var _DataCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReportDataNodeTtMsgs",
 			Handler:    _DataCoord_ReportDataNodeTtMsgs_Handler,
 		},
+		{
+			MethodName: "GetChannelWatchHistory",
+			Handler:    _DataCoord_GetChannelWatchHistory_Handler,
+		},
+		{
+			MethodName: "SetNodeConfigs",
+			Handler:    _DataCoord_SetNodeConfigs_Handler,
+		},
+		{
+			MethodName: "ListNodeConfigs",
+			Handler:    _DataCoord_ListNodeConfigs_Handler,
+		},
+		{
+			MethodName: "ClearNodeConfigs",
+			Handler:    _DataCoord_ClearNodeConfigs_Handler,
+		},
+		{
+			MethodName: "AlterIndex",
+			Handler:    _DataCoord_AlterIndex_Handler,
+		},
+		{
+			MethodName: "CloneSegments",
+			Handler:    _DataCoord_CloneSegments_Handler,
+		},
+		{
+			MethodName: "GetCloneSegmentsState",
+			Handler:    _DataCoord_GetCloneSegmentsState_Handler,
+		},
+		{
+			MethodName: "SetMaintenancePolicy",
+			Handler:    _DataCoord_SetMaintenancePolicy_Handler,
+		},
+		{
+			MethodName: "ListMaintenancePolicies",
+			Handler:    _DataCoord_ListMaintenancePolicies_Handler,
+		},
+		{
+			MethodName: "CordonDataNode",
+			Handler:    _DataCoord_CordonDataNode_Handler,
+		},
+		{
+			MethodName: "DrainDataNode",
+			Handler:    _DataCoord_DrainDataNode_Handler,
+		},
+		{
+			MethodName: "UncordonDataNode",
+			Handler:    _DataCoord_UncordonDataNode_Handler,
+		},
+		{
+			MethodName: "GetDataNodeDrainState",
+			Handler:    _DataCoord_GetDataNodeDrainState_Handler,
+		},
+		{
+			MethodName: "RotateEncryptionKey",
+			Handler:    _DataCoord_RotateEncryptionKey_Handler,
+		},
+		{
+			MethodName: "GetEncryptionStatus",
+			Handler:    _DataCoord_GetEncryptionStatus_Handler,
+		},
+		{
+			MethodName: "GetChannelWatchStates",
+			Handler:    _DataCoord_GetChannelWatchStates_Handler,
+		},
+		{
+			MethodName: "ManualCompactionWithMode",
+			Handler:    _DataCoord_ManualCompactionWithMode_Handler,
+		},
+		{
+			MethodName: "GetCompactionProgress",
+			Handler:    _DataCoord_GetCompactionProgress_Handler,
+		},
+		{
+			MethodName: "CancelCompaction",
+			Handler:    _DataCoord_CancelCompaction_Handler,
+		},
+		{
+			MethodName: "GcDryRun",
+			Handler:    _DataCoord_GcDryRun_Handler,
+		},
+		{
+			MethodName: "PauseGC",
+			Handler:    _DataCoord_PauseGC_Handler,
+		},
+		{
+			MethodName: "ResumeGC",
+			Handler:    _DataCoord_ResumeGC_Handler,
+		},
+		{
+			MethodName: "GetGCStatus",
+			Handler:    _DataCoord_GetGCStatus_Handler,
+		},
+		{
+			MethodName: "DropSegmentsByTimeRange",
+			Handler:    _DataCoord_DropSegmentsByTimeRange_Handler,
+		},
+		{
+			MethodName: "GetTopologySnapshot",
+			Handler:    _DataCoord_GetTopologySnapshot_Handler,
+		},
+		{
+			MethodName: "RetryQuarantinedChannels",
+			Handler:    _DataCoord_RetryQuarantinedChannels_Handler,
+		},
+		{
+			MethodName: "MigrateChannelWatchInfos",
+			Handler:    _DataCoord_MigrateChannelWatchInfos_Handler,
+		},
+		{
+			MethodName: "ReCollectSegmentStats",
+			Handler:    _DataCoord_ReCollectSegmentStats_Handler,
+		},
+		{
+			MethodName: "GetAuditEvents",
+			Handler:    _DataCoord_GetAuditEvents_Handler,
+		},
+		{
+			MethodName: "GetShardWriteStats",
+			Handler:    _DataCoord_GetShardWriteStats_Handler,
+		},
+		{
+			MethodName: "FlushAndSeal",
+			Handler:    _DataCoord_FlushAndSeal_Handler,
+		},
+		{
+			MethodName: "GetStorageConsistencyReport",
+			Handler:    _DataCoord_GetStorageConsistencyReport_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "data_coord.proto",
+}
+
+// DataCoordReplicationClient is the client API for DataCoordReplication service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DataCoordReplicationClient interface {
+	ReplicateBinlog(ctx context.Context, in *ReplicateBinlogRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ReplicateSegment(ctx context.Context, in *ReplicateSegmentRequest, opts ...grpc.CallOption) (*ReplicateSegmentResponse, error)
+}
+
+type dataCoordReplicationClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDataCoordReplicationClient(cc *grpc.ClientConn) DataCoordReplicationClient {
+	return &dataCoordReplicationClient{cc}
+}
+
+func (c *dataCoordReplicationClient) ReplicateBinlog(ctx context.Context, in *ReplicateBinlogRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoordReplication/ReplicateBinlog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordReplicationClient) ReplicateSegment(ctx context.Context, in *ReplicateSegmentRequest, opts ...grpc.CallOption) (*ReplicateSegmentResponse, error) {
+	out := new(ReplicateSegmentResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoordReplication/ReplicateSegment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DataCoordReplicationServer is the server API for DataCoordReplication service.
+type DataCoordReplicationServer interface {
+	ReplicateBinlog(context.Context, *ReplicateBinlogRequest) (*commonpb.Status, error)
+	ReplicateSegment(context.Context, *ReplicateSegmentRequest) (*ReplicateSegmentResponse, error)
+}
+
+// UnimplementedDataCoordReplicationServer can be embedded to have forward compatible implementations.
+type UnimplementedDataCoordReplicationServer struct {
+}
+
+func (*UnimplementedDataCoordReplicationServer) ReplicateBinlog(ctx context.Context, req *ReplicateBinlogRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplicateBinlog not implemented")
+}
+func (*UnimplementedDataCoordReplicationServer) ReplicateSegment(ctx context.Context, req *ReplicateSegmentRequest) (*ReplicateSegmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplicateSegment not implemented")
+}
+
+func RegisterDataCoordReplicationServer(s *grpc.Server, srv DataCoordReplicationServer) {
+	s.RegisterService(&_DataCoordReplication_serviceDesc, srv)
+}
+
+func _DataCoordReplication_ReplicateBinlog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplicateBinlogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordReplicationServer).ReplicateBinlog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoordReplication/ReplicateBinlog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordReplicationServer).ReplicateBinlog(ctx, req.(*ReplicateBinlogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoordReplication_ReplicateSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplicateSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordReplicationServer).ReplicateSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoordReplication/ReplicateSegment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordReplicationServer).ReplicateSegment(ctx, req.(*ReplicateSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DataCoordReplication_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "milvus.proto.data.DataCoordReplication",
+	HandlerType: (*DataCoordReplicationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ReplicateBinlog",
+			Handler:    _DataCoordReplication_ReplicateBinlog_Handler,
+		},
+		{
+			MethodName: "ReplicateSegment",
+			Handler:    _DataCoordReplication_ReplicateSegment_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "data_coord.proto",
@@ -7004,10 +13201,14 @@ type DataNodeClient interface {
 	Compaction(ctx context.Context, in *CompactionPlan, opts ...grpc.CallOption) (*commonpb.Status, error)
 	GetCompactionState(ctx context.Context, in *CompactionStateRequest, opts ...grpc.CallOption) (*CompactionStateResponse, error)
 	SyncSegments(ctx context.Context, in *SyncSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	CancelCompactionPlans(ctx context.Context, in *CancelCompactionPlansRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+24+--+Support+bulk+load
 	Import(ctx context.Context, in *ImportTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	ResendSegmentStats(ctx context.Context, in *ResendSegmentStatsRequest, opts ...grpc.CallOption) (*ResendSegmentStatsResponse, error)
 	AddImportSegment(ctx context.Context, in *AddImportSegmentRequest, opts ...grpc.CallOption) (*AddImportSegmentResponse, error)
+	// admin only, for recovering the channels whose checkpoints are wrong
+	DumpDispatcherPositions(ctx context.Context, in *internalpb.DumpDispatcherPositionsRequest, opts ...grpc.CallOption) (*internalpb.DumpDispatcherPositionsResponse, error)
+	SeekDispatcher(ctx context.Context, in *internalpb.SeekDispatcherRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 }
 
 type dataNodeClient struct {
@@ -7099,6 +13300,15 @@ func (c *dataNodeClient) SyncSegments(ctx context.Context, in *SyncSegmentsReque
 	return out, nil
 }
 
+func (c *dataNodeClient) CancelCompactionPlans(ctx context.Context, in *CancelCompactionPlansRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataNode/CancelCompactionPlans", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataNodeClient) Import(ctx context.Context, in *ImportTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
 	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataNode/Import", in, out, opts...)
@@ -7126,6 +13336,24 @@ func (c *dataNodeClient) AddImportSegment(ctx context.Context, in *AddImportSegm
 	return out, nil
 }
 
+func (c *dataNodeClient) DumpDispatcherPositions(ctx context.Context, in *internalpb.DumpDispatcherPositionsRequest, opts ...grpc.CallOption) (*internalpb.DumpDispatcherPositionsResponse, error) {
+	out := new(internalpb.DumpDispatcherPositionsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataNode/DumpDispatcherPositions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataNodeClient) SeekDispatcher(ctx context.Context, in *internalpb.SeekDispatcherRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataNode/SeekDispatcher", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DataNodeServer is the server API for DataNode service.
 type DataNodeServer interface {
 	GetComponentStates(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)
@@ -7138,10 +13366,14 @@ type DataNodeServer interface {
 	Compaction(context.Context, *CompactionPlan) (*commonpb.Status, error)
 	GetCompactionState(context.Context, *CompactionStateRequest) (*CompactionStateResponse, error)
 	SyncSegments(context.Context, *SyncSegmentsRequest) (*commonpb.Status, error)
+	CancelCompactionPlans(context.Context, *CancelCompactionPlansRequest) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+24+--+Support+bulk+load
 	Import(context.Context, *ImportTaskRequest) (*commonpb.Status, error)
 	ResendSegmentStats(context.Context, *ResendSegmentStatsRequest) (*ResendSegmentStatsResponse, error)
 	AddImportSegment(context.Context, *AddImportSegmentRequest) (*AddImportSegmentResponse, error)
+	// admin only, for recovering the channels whose checkpoints are wrong
+	DumpDispatcherPositions(context.Context, *internalpb.DumpDispatcherPositionsRequest) (*internalpb.DumpDispatcherPositionsResponse, error)
+	SeekDispatcher(context.Context, *internalpb.SeekDispatcherRequest) (*commonpb.Status, error)
 }
 
 // UnimplementedDataNodeServer can be embedded to have forward compatible implementations.
@@ -7175,6 +13407,9 @@ func (*UnimplementedDataNodeServer) GetCompactionState(ctx context.Context, req
 func (*UnimplementedDataNodeServer) SyncSegments(ctx context.Context, req *SyncSegmentsRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyncSegments not implemented")
 }
+func (*UnimplementedDataNodeServer) CancelCompactionPlans(ctx context.Context, req *CancelCompactionPlansRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelCompactionPlans not implemented")
+}
 func (*UnimplementedDataNodeServer) Import(ctx context.Context, req *ImportTaskRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Import not implemented")
 }
@@ -7184,6 +13419,12 @@ func (*UnimplementedDataNodeServer) ResendSegmentStats(ctx context.Context, req
 func (*UnimplementedDataNodeServer) AddImportSegment(ctx context.Context, req *AddImportSegmentRequest) (*AddImportSegmentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddImportSegment not implemented")
 }
+func (*UnimplementedDataNodeServer) DumpDispatcherPositions(ctx context.Context, req *internalpb.DumpDispatcherPositionsRequest) (*internalpb.DumpDispatcherPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DumpDispatcherPositions not implemented")
+}
+func (*UnimplementedDataNodeServer) SeekDispatcher(ctx context.Context, req *internalpb.SeekDispatcherRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SeekDispatcher not implemented")
+}
 
 func RegisterDataNodeServer(s *grpc.Server, srv DataNodeServer) {
 	s.RegisterService(&_DataNode_serviceDesc, srv)
@@ -7351,6 +13592,24 @@ func _DataNode_SyncSegments_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataNode_CancelCompactionPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelCompactionPlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataNodeServer).CancelCompactionPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataNode/CancelCompactionPlans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataNodeServer).CancelCompactionPlans(ctx, req.(*CancelCompactionPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DataNode_Import_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ImportTaskRequest)
 	if err := dec(in); err != nil {
@@ -7405,6 +13664,42 @@ func _DataNode_AddImportSegment_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataNode_DumpDispatcherPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.DumpDispatcherPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataNodeServer).DumpDispatcherPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataNode/DumpDispatcherPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataNodeServer).DumpDispatcherPositions(ctx, req.(*internalpb.DumpDispatcherPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataNode_SeekDispatcher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.SeekDispatcherRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataNodeServer).SeekDispatcher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataNode/SeekDispatcher",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataNodeServer).SeekDispatcher(ctx, req.(*internalpb.SeekDispatcherRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DataNode_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.data.DataNode",
 	HandlerType: (*DataNodeServer)(nil),
@@ -7445,6 +13740,10 @@ var _DataNode_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SyncSegments",
 			Handler:    _DataNode_SyncSegments_Handler,
 		},
+		{
+			MethodName: "CancelCompactionPlans",
+			Handler:    _DataNode_CancelCompactionPlans_Handler,
+		},
 		{
 			MethodName: "Import",
 			Handler:    _DataNode_Import_Handler,
@@ -7457,6 +13756,14 @@ var _DataNode_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddImportSegment",
 			Handler:    _DataNode_AddImportSegment_Handler,
 		},
+		{
+			MethodName: "DumpDispatcherPositions",
+			Handler:    _DataNode_DumpDispatcherPositions_Handler,
+		},
+		{
+			MethodName: "SeekDispatcher",
+			Handler:    _DataNode_SeekDispatcher_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "data_coord.proto",
@@ -44,9 +44,13 @@ type IndexInfo struct {
 	IsAutoIndex          bool                     `protobuf:"varint,11,opt,name=is_auto_index,json=isAutoIndex,proto3" json:"is_auto_index,omitempty"`
 	UserIndexParams      []*commonpb.KeyValuePair `protobuf:"bytes,12,rep,name=user_index_params,json=userIndexParams,proto3" json:"user_index_params,omitempty"`
 	PendingIndexRows     int64                    `protobuf:"varint,13,opt,name=pending_index_rows,json=pendingIndexRows,proto3" json:"pending_index_rows,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+	// the index params which are being rolled out by AlterIndex, empty if there is no alteration in progress
+	PendingIndexParams []*commonpb.KeyValuePair `protobuf:"bytes,14,rep,name=pending_index_params,json=pendingIndexParams,proto3" json:"pending_index_params,omitempty"`
+	// the index engine versions of the finished segment indexes, segmentID -> version, only filled by DescribeIndex
+	SegmentIndexEngineVersions map[int64]int32 `protobuf:"bytes,15,rep,name=segment_index_engine_versions,json=segmentIndexEngineVersions,proto3" json:"segment_index_engine_versions,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral       struct{}        `json:"-"`
+	XXX_unrecognized           []byte          `json:"-"`
+	XXX_sizecache              int32           `json:"-"`
 }
 
 func (m *IndexInfo) Reset()         { *m = IndexInfo{} }
@@ -165,6 +169,20 @@ func (m *IndexInfo) GetPendingIndexRows() int64 {
 	return 0
 }
 
+func (m *IndexInfo) GetPendingIndexParams() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.PendingIndexParams
+	}
+	return nil
+}
+
+func (m *IndexInfo) GetSegmentIndexEngineVersions() map[int64]int32 {
+	if m != nil {
+		return m.SegmentIndexEngineVersions
+	}
+	return nil
+}
+
 type FieldIndex struct {
 	IndexInfo            *IndexInfo `protobuf:"bytes,1,opt,name=index_info,json=indexInfo,proto3" json:"index_info,omitempty"`
 	Deleted              bool       `protobuf:"varint,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
@@ -221,24 +239,28 @@ func (m *FieldIndex) GetCreateTime() uint64 {
 }
 
 type SegmentIndex struct {
-	CollectionID         int64               `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	PartitionID          int64               `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
-	SegmentID            int64               `protobuf:"varint,3,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	NumRows              int64               `protobuf:"varint,4,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
-	IndexID              int64               `protobuf:"varint,5,opt,name=indexID,proto3" json:"indexID,omitempty"`
-	BuildID              int64               `protobuf:"varint,6,opt,name=buildID,proto3" json:"buildID,omitempty"`
-	NodeID               int64               `protobuf:"varint,7,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
-	IndexVersion         int64               `protobuf:"varint,8,opt,name=index_version,json=indexVersion,proto3" json:"index_version,omitempty"`
-	State                commonpb.IndexState `protobuf:"varint,9,opt,name=state,proto3,enum=milvus.proto.common.IndexState" json:"state,omitempty"`
-	FailReason           string              `protobuf:"bytes,10,opt,name=fail_reason,json=failReason,proto3" json:"fail_reason,omitempty"`
-	IndexFileKeys        []string            `protobuf:"bytes,11,rep,name=index_file_keys,json=indexFileKeys,proto3" json:"index_file_keys,omitempty"`
-	Deleted              bool                `protobuf:"varint,12,opt,name=deleted,proto3" json:"deleted,omitempty"`
-	CreateTime           uint64              `protobuf:"varint,13,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	SerializeSize        uint64              `protobuf:"varint,14,opt,name=serialize_size,json=serializeSize,proto3" json:"serialize_size,omitempty"`
-	WriteHandoff         bool                `protobuf:"varint,15,opt,name=write_handoff,json=writeHandoff,proto3" json:"write_handoff,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	CollectionID  int64               `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID   int64               `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	SegmentID     int64               `protobuf:"varint,3,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	NumRows       int64               `protobuf:"varint,4,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	IndexID       int64               `protobuf:"varint,5,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	BuildID       int64               `protobuf:"varint,6,opt,name=buildID,proto3" json:"buildID,omitempty"`
+	NodeID        int64               `protobuf:"varint,7,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	IndexVersion  int64               `protobuf:"varint,8,opt,name=index_version,json=indexVersion,proto3" json:"index_version,omitempty"`
+	State         commonpb.IndexState `protobuf:"varint,9,opt,name=state,proto3,enum=milvus.proto.common.IndexState" json:"state,omitempty"`
+	FailReason    string              `protobuf:"bytes,10,opt,name=fail_reason,json=failReason,proto3" json:"fail_reason,omitempty"`
+	IndexFileKeys []string            `protobuf:"bytes,11,rep,name=index_file_keys,json=indexFileKeys,proto3" json:"index_file_keys,omitempty"`
+	Deleted       bool                `protobuf:"varint,12,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	CreateTime    uint64              `protobuf:"varint,13,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	SerializeSize uint64              `protobuf:"varint,14,opt,name=serialize_size,json=serializeSize,proto3" json:"serialize_size,omitempty"`
+	WriteHandoff  bool                `protobuf:"varint,15,opt,name=write_handoff,json=writeHandoff,proto3" json:"write_handoff,omitempty"`
+	// the index is rebuilt with the pending index params alongside the serving one, and will replace it once finished
+	Rebuild bool `protobuf:"varint,16,opt,name=rebuild,proto3" json:"rebuild,omitempty"`
+	// the version of the index file format, 0 if the index was built before the version is recorded
+	IndexEngineVersion   int32    `protobuf:"varint,17,opt,name=index_engine_version,json=indexEngineVersion,proto3" json:"index_engine_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SegmentIndex) Reset()         { *m = SegmentIndex{} }
@@ -371,6 +393,20 @@ func (m *SegmentIndex) GetWriteHandoff() bool {
 	return false
 }
 
+func (m *SegmentIndex) GetRebuild() bool {
+	if m != nil {
+		return m.Rebuild
+	}
+	return false
+}
+
+func (m *SegmentIndex) GetIndexEngineVersion() int32 {
+	if m != nil {
+		return m.IndexEngineVersion
+	}
+	return 0
+}
+
 type RegisterNodeRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Address              *commonpb.Address `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
@@ -893,6 +929,7 @@ type IndexFilePathInfo struct {
 	SerializedSize       uint64                   `protobuf:"varint,8,opt,name=serialized_size,json=serializedSize,proto3" json:"serialized_size,omitempty"`
 	IndexVersion         int64                    `protobuf:"varint,9,opt,name=index_version,json=indexVersion,proto3" json:"index_version,omitempty"`
 	NumRows              int64                    `protobuf:"varint,10,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	IndexEngineVersion   int32                    `protobuf:"varint,11,opt,name=index_engine_version,json=indexEngineVersion,proto3" json:"index_engine_version,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -993,6 +1030,13 @@ func (m *IndexFilePathInfo) GetNumRows() int64 {
 	return 0
 }
 
+func (m *IndexFilePathInfo) GetIndexEngineVersion() int32 {
+	if m != nil {
+		return m.IndexEngineVersion
+	}
+	return 0
+}
+
 type SegmentInfo struct {
 	CollectionID         int64                `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	SegmentID            int64                `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
@@ -1379,15 +1423,19 @@ func (m *GetIndexBuildProgressResponse) GetPendingIndexRows() int64 {
 }
 
 type StorageConfig struct {
-	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	AccessKeyID          string   `protobuf:"bytes,2,opt,name=access_keyID,json=accessKeyID,proto3" json:"access_keyID,omitempty"`
-	SecretAccessKey      string   `protobuf:"bytes,3,opt,name=secret_access_key,json=secretAccessKey,proto3" json:"secret_access_key,omitempty"`
-	UseSSL               bool     `protobuf:"varint,4,opt,name=useSSL,proto3" json:"useSSL,omitempty"`
-	BucketName           string   `protobuf:"bytes,5,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
-	RootPath             string   `protobuf:"bytes,6,opt,name=root_path,json=rootPath,proto3" json:"root_path,omitempty"`
-	UseIAM               bool     `protobuf:"varint,7,opt,name=useIAM,proto3" json:"useIAM,omitempty"`
-	IAMEndpoint          string   `protobuf:"bytes,8,opt,name=IAMEndpoint,proto3" json:"IAMEndpoint,omitempty"`
-	StorageType          string   `protobuf:"bytes,9,opt,name=storage_type,json=storageType,proto3" json:"storage_type,omitempty"`
+	Address         string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	AccessKeyID     string `protobuf:"bytes,2,opt,name=access_keyID,json=accessKeyID,proto3" json:"access_keyID,omitempty"`
+	SecretAccessKey string `protobuf:"bytes,3,opt,name=secret_access_key,json=secretAccessKey,proto3" json:"secret_access_key,omitempty"`
+	UseSSL          bool   `protobuf:"varint,4,opt,name=useSSL,proto3" json:"useSSL,omitempty"`
+	BucketName      string `protobuf:"bytes,5,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	RootPath        string `protobuf:"bytes,6,opt,name=root_path,json=rootPath,proto3" json:"root_path,omitempty"`
+	UseIAM          bool   `protobuf:"varint,7,opt,name=useIAM,proto3" json:"useIAM,omitempty"`
+	IAMEndpoint     string `protobuf:"bytes,8,opt,name=IAMEndpoint,proto3" json:"IAMEndpoint,omitempty"`
+	StorageType     string `protobuf:"bytes,9,opt,name=storage_type,json=storageType,proto3" json:"storage_type,omitempty"`
+	// assume the role through STS with the access key if set
+	RoleArn              string   `protobuf:"bytes,10,opt,name=role_arn,json=roleArn,proto3" json:"role_arn,omitempty"`
+	StsEndpoint          string   `protobuf:"bytes,11,opt,name=sts_endpoint,json=stsEndpoint,proto3" json:"sts_endpoint,omitempty"`
+	SessionDuration      int64    `protobuf:"varint,12,opt,name=session_duration,json=sessionDuration,proto3" json:"session_duration,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1481,6 +1529,27 @@ func (m *StorageConfig) GetStorageType() string {
 	return ""
 }
 
+func (m *StorageConfig) GetRoleArn() string {
+	if m != nil {
+		return m.RoleArn
+	}
+	return ""
+}
+
+func (m *StorageConfig) GetStsEndpoint() string {
+	if m != nil {
+		return m.StsEndpoint
+	}
+	return ""
+}
+
+func (m *StorageConfig) GetSessionDuration() int64 {
+	if m != nil {
+		return m.SessionDuration
+	}
+	return 0
+}
+
 type CreateJobRequest struct {
 	ClusterID            string                   `protobuf:"bytes,1,opt,name=clusterID,proto3" json:"clusterID,omitempty"`
 	IndexFilePrefix      string                   `protobuf:"bytes,2,opt,name=index_file_prefix,json=indexFilePrefix,proto3" json:"index_file_prefix,omitempty"`
@@ -1653,6 +1722,7 @@ type IndexTaskInfo struct {
 	IndexFileKeys        []string            `protobuf:"bytes,3,rep,name=index_file_keys,json=indexFileKeys,proto3" json:"index_file_keys,omitempty"`
 	SerializedSize       uint64              `protobuf:"varint,4,opt,name=serialized_size,json=serializedSize,proto3" json:"serialized_size,omitempty"`
 	FailReason           string              `protobuf:"bytes,5,opt,name=fail_reason,json=failReason,proto3" json:"fail_reason,omitempty"`
+	IndexEngineVersion   int32               `protobuf:"varint,6,opt,name=index_engine_version,json=indexEngineVersion,proto3" json:"index_engine_version,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_unrecognized     []byte              `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
@@ -1718,6 +1788,13 @@ func (m *IndexTaskInfo) GetFailReason() string {
 	return ""
 }
 
+func (m *IndexTaskInfo) GetIndexEngineVersion() int32 {
+	if m != nil {
+		return m.IndexEngineVersion
+	}
+	return 0
+}
+
 type QueryJobsResponse struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	ClusterID            string           `protobuf:"bytes,2,opt,name=clusterID,proto3" json:"clusterID,omitempty"`
@@ -1931,16 +2008,18 @@ func (m *GetJobStatsRequest) XXX_DiscardUnknown() {
 var xxx_messageInfo_GetJobStatsRequest proto.InternalMessageInfo
 
 type GetJobStatsResponse struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	TotalJobNum          int64            `protobuf:"varint,2,opt,name=total_job_num,json=totalJobNum,proto3" json:"total_job_num,omitempty"`
-	InProgressJobNum     int64            `protobuf:"varint,3,opt,name=in_progress_job_num,json=inProgressJobNum,proto3" json:"in_progress_job_num,omitempty"`
-	EnqueueJobNum        int64            `protobuf:"varint,4,opt,name=enqueue_job_num,json=enqueueJobNum,proto3" json:"enqueue_job_num,omitempty"`
-	TaskSlots            int64            `protobuf:"varint,5,opt,name=task_slots,json=taskSlots,proto3" json:"task_slots,omitempty"`
-	JobInfos             []*JobInfo       `protobuf:"bytes,6,rep,name=job_infos,json=jobInfos,proto3" json:"job_infos,omitempty"`
-	EnableDisk           bool             `protobuf:"varint,7,opt,name=enable_disk,json=enableDisk,proto3" json:"enable_disk,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Status           *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	TotalJobNum      int64            `protobuf:"varint,2,opt,name=total_job_num,json=totalJobNum,proto3" json:"total_job_num,omitempty"`
+	InProgressJobNum int64            `protobuf:"varint,3,opt,name=in_progress_job_num,json=inProgressJobNum,proto3" json:"in_progress_job_num,omitempty"`
+	EnqueueJobNum    int64            `protobuf:"varint,4,opt,name=enqueue_job_num,json=enqueueJobNum,proto3" json:"enqueue_job_num,omitempty"`
+	TaskSlots        int64            `protobuf:"varint,5,opt,name=task_slots,json=taskSlots,proto3" json:"task_slots,omitempty"`
+	JobInfos         []*JobInfo       `protobuf:"bytes,6,rep,name=job_infos,json=jobInfos,proto3" json:"job_infos,omitempty"`
+	EnableDisk       bool             `protobuf:"varint,7,opt,name=enable_disk,json=enableDisk,proto3" json:"enable_disk,omitempty"`
+	// the local disk space left to build the disk indexes, excluding the space reserved by the queued and running builds
+	AvailableDiskSize    int64    `protobuf:"varint,8,opt,name=available_disk_size,json=availableDiskSize,proto3" json:"available_disk_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *GetJobStatsResponse) Reset()         { *m = GetJobStatsResponse{} }
@@ -2017,6 +2096,13 @@ func (m *GetJobStatsResponse) GetEnableDisk() bool {
 	return false
 }
 
+func (m *GetJobStatsResponse) GetAvailableDiskSize() int64 {
+	if m != nil {
+		return m.AvailableDiskSize
+	}
+	return 0
+}
+
 type GetIndexStatisticsRequest struct {
 	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	IndexName            string   `protobuf:"bytes,2,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
@@ -2111,8 +2197,65 @@ func (m *GetIndexStatisticsResponse) GetIndexInfos() []*IndexInfo {
 	return nil
 }
 
+type AlterIndexRequest struct {
+	CollectionID int64  `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	IndexName    string `protobuf:"bytes,2,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	// the index params to alter, only the params which do not change the index type are allowed
+	Params               []*commonpb.KeyValuePair `protobuf:"bytes,3,rep,name=params,proto3" json:"params,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *AlterIndexRequest) Reset()         { *m = AlterIndexRequest{} }
+func (m *AlterIndexRequest) String() string { return proto.CompactTextString(m) }
+func (*AlterIndexRequest) ProtoMessage()    {}
+func (*AlterIndexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{31}
+}
+
+func (m *AlterIndexRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AlterIndexRequest.Unmarshal(m, b)
+}
+func (m *AlterIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AlterIndexRequest.Marshal(b, m, deterministic)
+}
+func (m *AlterIndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterIndexRequest.Merge(m, src)
+}
+func (m *AlterIndexRequest) XXX_Size() int {
+	return xxx_messageInfo_AlterIndexRequest.Size(m)
+}
+func (m *AlterIndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterIndexRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterIndexRequest proto.InternalMessageInfo
+
+func (m *AlterIndexRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *AlterIndexRequest) GetIndexName() string {
+	if m != nil {
+		return m.IndexName
+	}
+	return ""
+}
+
+func (m *AlterIndexRequest) GetParams() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*IndexInfo)(nil), "milvus.proto.index.IndexInfo")
+	proto.RegisterMapType((map[int64]int32)(nil), "milvus.proto.index.IndexInfo.SegmentIndexEngineVersionsEntry")
 	proto.RegisterType((*FieldIndex)(nil), "milvus.proto.index.FieldIndex")
 	proto.RegisterType((*SegmentIndex)(nil), "milvus.proto.index.SegmentIndex")
 	proto.RegisterType((*RegisterNodeRequest)(nil), "milvus.proto.index.RegisterNodeRequest")
@@ -2144,154 +2287,167 @@ func init() {
 	proto.RegisterType((*GetJobStatsResponse)(nil), "milvus.proto.index.GetJobStatsResponse")
 	proto.RegisterType((*GetIndexStatisticsRequest)(nil), "milvus.proto.index.GetIndexStatisticsRequest")
 	proto.RegisterType((*GetIndexStatisticsResponse)(nil), "milvus.proto.index.GetIndexStatisticsResponse")
+	proto.RegisterType((*AlterIndexRequest)(nil), "milvus.proto.index.AlterIndexRequest")
 }
 
 func init() { proto.RegisterFile("index_coord.proto", fileDescriptor_f9e019eb3fda53c2) }
 
 var fileDescriptor_f9e019eb3fda53c2 = []byte{
-	// 2257 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4f, 0x8f, 0x1b, 0x49,
-	0x15, 0x4f, 0xbb, 0x3d, 0x33, 0xee, 0xd7, 0xf6, 0xfc, 0xa9, 0xcc, 0x82, 0xe3, 0x24, 0x64, 0xd2,
-	0xd9, 0x24, 0x03, 0x22, 0x93, 0x30, 0xcb, 0xa2, 0x05, 0x01, 0xd2, 0x64, 0x66, 0x93, 0x38, 0xd9,
-	0x44, 0x43, 0x3b, 0x5a, 0x89, 0x15, 0xc2, 0xb4, 0xdd, 0xe5, 0x99, 0xda, 0x69, 0x77, 0x39, 0x5d,
-	0xd5, 0x49, 0x26, 0x48, 0x08, 0x0e, 0x7b, 0x00, 0xad, 0x84, 0x40, 0x48, 0x7c, 0x01, 0x4e, 0xcb,
-	0x37, 0xe0, 0xc2, 0x85, 0x23, 0x27, 0xee, 0x5c, 0xf8, 0x12, 0x5c, 0x51, 0xfd, 0xe9, 0x76, 0x77,
-	0xbb, 0x3d, 0x76, 0x66, 0x06, 0x21, 0xb1, 0x37, 0xd7, 0xab, 0x57, 0x7f, 0xfa, 0xbd, 0xdf, 0x7b,
-	0xbf, 0xf7, 0xca, 0xb0, 0x46, 0x42, 0x1f, 0xbf, 0xee, 0xf6, 0x29, 0x8d, 0xfc, 0xad, 0x51, 0x44,
-	0x39, 0x45, 0x68, 0x48, 0x82, 0x97, 0x31, 0x53, 0xa3, 0x2d, 0x39, 0xdf, 0xaa, 0xf7, 0xe9, 0x70,
-	0x48, 0x43, 0x25, 0x6b, 0x2d, 0x93, 0x90, 0xe3, 0x28, 0xf4, 0x02, 0x3d, 0xae, 0x67, 0x57, 0x38,
-	0xff, 0xac, 0x82, 0xd5, 0x16, 0xab, 0xda, 0xe1, 0x80, 0x22, 0x07, 0xea, 0x7d, 0x1a, 0x04, 0xb8,
-	0xcf, 0x09, 0x0d, 0xdb, 0x7b, 0x4d, 0x63, 0xc3, 0xd8, 0x34, 0xdd, 0x9c, 0x0c, 0x35, 0x61, 0x69,
-	0x40, 0x70, 0xe0, 0xb7, 0xf7, 0x9a, 0x15, 0x39, 0x9d, 0x0c, 0xd1, 0x55, 0x00, 0x75, 0xc1, 0xd0,
-	0x1b, 0xe2, 0xa6, 0xb9, 0x61, 0x6c, 0x5a, 0xae, 0x25, 0x25, 0xcf, 0xbc, 0x21, 0x16, 0x0b, 0xe5,
-	0xa0, 0xbd, 0xd7, 0xac, 0xaa, 0x85, 0x7a, 0x88, 0xee, 0x83, 0xcd, 0x8f, 0x47, 0xb8, 0x3b, 0xf2,
-	0x22, 0x6f, 0xc8, 0x9a, 0x0b, 0x1b, 0xe6, 0xa6, 0xbd, 0x7d, 0x7d, 0x2b, 0xf7, 0x69, 0xfa, 0x9b,
-	0x9e, 0xe0, 0xe3, 0x8f, 0xbd, 0x20, 0xc6, 0xfb, 0x1e, 0x89, 0x5c, 0x10, 0xab, 0xf6, 0xe5, 0x22,
-	0xb4, 0x07, 0x75, 0x75, 0xb8, 0xde, 0x64, 0x71, 0xde, 0x4d, 0x6c, 0xb9, 0x4c, 0xef, 0x72, 0x5d,
-	0xef, 0x82, 0xfd, 0x6e, 0x44, 0x5f, 0xb1, 0xe6, 0x92, 0xbc, 0xa8, 0xad, 0x65, 0x2e, 0x7d, 0xc5,
-	0xc4, 0x57, 0x72, 0xca, 0xbd, 0x40, 0x29, 0xd4, 0xa4, 0x82, 0x25, 0x25, 0x72, 0xfa, 0x7d, 0x58,
-	0x60, 0xdc, 0xe3, 0xb8, 0x69, 0x6d, 0x18, 0x9b, 0xcb, 0xdb, 0xd7, 0x4a, 0x2f, 0x20, 0x2d, 0xde,
-	0x11, 0x6a, 0xae, 0xd2, 0x46, 0xef, 0xc3, 0x57, 0xd5, 0xf5, 0xe5, 0xb0, 0x3b, 0xf0, 0x48, 0xd0,
-	0x8d, 0xb0, 0xc7, 0x68, 0xd8, 0x04, 0x69, 0xc8, 0x75, 0x92, 0xae, 0x79, 0xe0, 0x91, 0xc0, 0x95,
-	0x73, 0xc8, 0x81, 0x06, 0x61, 0x5d, 0x2f, 0xe6, 0xb4, 0x2b, 0xe7, 0x9b, 0xf6, 0x86, 0xb1, 0x59,
-	0x73, 0x6d, 0xc2, 0x76, 0x62, 0x4e, 0xe5, 0x31, 0xe8, 0x29, 0xac, 0xc5, 0x0c, 0x47, 0xdd, 0x9c,
-	0x79, 0xea, 0xf3, 0x9a, 0x67, 0x45, 0xac, 0x6d, 0x67, 0x4c, 0xf4, 0x4d, 0x40, 0x23, 0x1c, 0xfa,
-	0x24, 0x3c, 0xd0, 0x3b, 0x4a, 0x3b, 0x34, 0xa4, 0x1d, 0x56, 0xf5, 0x8c, 0xd4, 0x17, 0xe6, 0x70,
-	0x3e, 0x33, 0x00, 0x1e, 0x48, 0x7c, 0xc8, 0xbb, 0x7c, 0x3f, 0x81, 0x08, 0x09, 0x07, 0x54, 0xc2,
-	0xcb, 0xde, 0xbe, 0xba, 0x35, 0x89, 0xe1, 0xad, 0x14, 0x93, 0x1a, 0x41, 0x12, 0x9e, 0x4d, 0x58,
-	0xf2, 0x71, 0x80, 0x39, 0xf6, 0x25, 0xf4, 0x6a, 0x6e, 0x32, 0x44, 0xd7, 0xc0, 0xee, 0x47, 0x58,
-	0x58, 0x8e, 0x13, 0x8d, 0xbd, 0xaa, 0x0b, 0x4a, 0xf4, 0x9c, 0x0c, 0xb1, 0xf3, 0x59, 0x15, 0xea,
-	0x1d, 0x7c, 0x30, 0xc4, 0x21, 0x57, 0x37, 0x99, 0x07, 0xea, 0x1b, 0x60, 0x8f, 0xbc, 0x88, 0x13,
-	0xad, 0xa2, 0xe0, 0x9e, 0x15, 0xa1, 0x2b, 0x60, 0x31, 0xbd, 0xeb, 0x9e, 0x3c, 0xd5, 0x74, 0xc7,
-	0x02, 0x74, 0x09, 0x6a, 0x61, 0x3c, 0x54, 0x06, 0xd2, 0x90, 0x0f, 0xe3, 0xa1, 0x84, 0x49, 0x26,
-	0x18, 0x16, 0xf2, 0xc1, 0xd0, 0x84, 0xa5, 0x5e, 0x4c, 0x64, 0x7c, 0x2d, 0xaa, 0x19, 0x3d, 0x44,
-	0x5f, 0x81, 0xc5, 0x90, 0xfa, 0xb8, 0xbd, 0xa7, 0x61, 0xa9, 0x47, 0xe8, 0x06, 0x34, 0x94, 0x51,
-	0x5f, 0xe2, 0x88, 0x11, 0x1a, 0x6a, 0x50, 0x2a, 0x24, 0x7f, 0xac, 0x64, 0xa7, 0xc5, 0xe5, 0x35,
-	0xb0, 0x27, 0xb1, 0x08, 0x83, 0x31, 0x02, 0x6f, 0xc1, 0x8a, 0x3a, 0x7c, 0x40, 0x02, 0xdc, 0x3d,
-	0xc2, 0xc7, 0xac, 0x69, 0x6f, 0x98, 0x9b, 0x96, 0xab, 0xee, 0xf4, 0x80, 0x04, 0xf8, 0x09, 0x3e,
-	0x66, 0x59, 0xdf, 0xd5, 0x4f, 0xf4, 0x5d, 0xa3, 0xe8, 0x3b, 0x74, 0x13, 0x96, 0x19, 0x8e, 0x88,
-	0x17, 0x90, 0x37, 0xb8, 0xcb, 0xc8, 0x1b, 0xdc, 0x5c, 0x96, 0x3a, 0x8d, 0x54, 0xda, 0x21, 0x6f,
-	0xb0, 0x30, 0xc3, 0xab, 0x88, 0x70, 0xdc, 0x3d, 0xf4, 0x42, 0x9f, 0x0e, 0x06, 0xcd, 0x15, 0x79,
-	0x4e, 0x5d, 0x0a, 0x1f, 0x29, 0x99, 0xf3, 0x47, 0x03, 0x2e, 0xba, 0xf8, 0x80, 0x30, 0x8e, 0xa3,
-	0x67, 0xd4, 0xc7, 0x2e, 0x7e, 0x11, 0x63, 0xc6, 0xd1, 0x3d, 0xa8, 0xf6, 0x3c, 0x86, 0x35, 0x24,
-	0xaf, 0x94, 0x5a, 0xe7, 0x29, 0x3b, 0xb8, 0xef, 0x31, 0xec, 0x4a, 0x4d, 0xf4, 0x1d, 0x58, 0xf2,
-	0x7c, 0x3f, 0xc2, 0x8c, 0x49, 0x60, 0x4c, 0x5b, 0xb4, 0xa3, 0x74, 0xdc, 0x44, 0x39, 0xe3, 0x45,
-	0x33, 0xeb, 0x45, 0xe7, 0xb7, 0x06, 0xac, 0xe7, 0x6f, 0xc6, 0x46, 0x34, 0x64, 0x18, 0xbd, 0x07,
-	0x8b, 0xc2, 0x17, 0x31, 0xd3, 0x97, 0xbb, 0x5c, 0x7a, 0x4e, 0x47, 0xaa, 0xb8, 0x5a, 0x55, 0xa4,
-	0x54, 0x12, 0x12, 0x9e, 0x84, 0xbb, 0xba, 0xe1, 0xf5, 0x62, 0xa4, 0x69, 0x62, 0x68, 0x87, 0x84,
-	0xab, 0xe8, 0x76, 0x81, 0xa4, 0xbf, 0x9d, 0x1f, 0xc3, 0xfa, 0x43, 0xcc, 0x33, 0x98, 0xd0, 0xb6,
-	0x9a, 0x27, 0x74, 0xf2, 0x5c, 0x50, 0x29, 0x70, 0x81, 0xf3, 0x27, 0x03, 0xde, 0x29, 0xec, 0x7d,
-	0x96, 0xaf, 0x4d, 0xc1, 0x5d, 0x39, 0x0b, 0xb8, 0xcd, 0x22, 0xb8, 0x9d, 0x5f, 0x1a, 0x70, 0xf9,
-	0x21, 0xe6, 0xd9, 0xc4, 0x71, 0xce, 0x96, 0x40, 0x5f, 0x03, 0x48, 0x13, 0x06, 0x6b, 0x9a, 0x1b,
-	0xe6, 0xa6, 0xe9, 0x66, 0x24, 0xce, 0xaf, 0x0d, 0x58, 0x9b, 0x38, 0x3f, 0x9f, 0x77, 0x8c, 0x62,
-	0xde, 0xf9, 0x6f, 0x99, 0xe3, 0xf7, 0x06, 0x5c, 0x29, 0x37, 0xc7, 0x59, 0x9c, 0xf7, 0x03, 0xb5,
-	0x08, 0x0b, 0x94, 0x0a, 0x52, 0xba, 0x59, 0xc6, 0x07, 0x93, 0x67, 0xea, 0x45, 0xce, 0xe7, 0x26,
-	0xa0, 0x5d, 0x99, 0x2c, 0x14, 0xeb, 0xbc, 0x85, 0x6b, 0x4e, 0x5d, 0xca, 0x14, 0x0a, 0x96, 0xea,
-	0x79, 0x14, 0x2c, 0x0b, 0xa7, 0x2a, 0x58, 0xae, 0x80, 0x25, 0xb2, 0x26, 0xe3, 0xde, 0x70, 0x24,
-	0xf9, 0xa2, 0xea, 0x8e, 0x05, 0x93, 0xe5, 0xc1, 0xd2, 0x9c, 0xe5, 0x41, 0xed, 0xb4, 0xe5, 0x81,
-	0xf3, 0x1a, 0x2e, 0x26, 0x81, 0x2d, 0xe9, 0xfb, 0x2d, 0xdc, 0x91, 0x0f, 0x85, 0x4a, 0x31, 0x14,
-	0x66, 0x38, 0xc5, 0xf9, 0x77, 0x05, 0xd6, 0xda, 0x09, 0xe7, 0xec, 0x7b, 0xfc, 0x50, 0xd6, 0x0c,
-	0x27, 0x47, 0xca, 0x74, 0x04, 0x64, 0x08, 0xda, 0x9c, 0x4a, 0xd0, 0xd5, 0x3c, 0x41, 0xe7, 0x2f,
-	0xb8, 0x50, 0x44, 0xcd, 0xf9, 0x94, 0xa8, 0x9b, 0xb0, 0x9a, 0x21, 0xdc, 0x91, 0xc7, 0x0f, 0x45,
-	0x99, 0x2a, 0x18, 0x77, 0x99, 0x64, 0xbf, 0x9e, 0xa1, 0xdb, 0xb0, 0x92, 0x32, 0xa4, 0xaf, 0x88,
-	0xb3, 0x26, 0x11, 0x32, 0xa6, 0x53, 0x3f, 0x61, 0xce, 0x7c, 0x01, 0x61, 0x95, 0x14, 0x10, 0xd9,
-	0x62, 0x06, 0x72, 0xc5, 0x8c, 0xf3, 0x17, 0x03, 0xec, 0x34, 0x40, 0xe7, 0x6c, 0x23, 0x72, 0x7e,
-	0xa9, 0x14, 0xfd, 0x72, 0x1d, 0xea, 0x38, 0xf4, 0x7a, 0x01, 0xd6, 0xb8, 0x35, 0x15, 0x6e, 0x95,
-	0x4c, 0xe1, 0xf6, 0x01, 0xd8, 0xe3, 0x52, 0x32, 0x89, 0xc1, 0x9b, 0x53, 0x6b, 0xc9, 0x2c, 0x28,
-	0x5c, 0x48, 0x6b, 0x4a, 0xe6, 0xfc, 0xa6, 0x32, 0xa6, 0x39, 0x85, 0xd8, 0xb3, 0x24, 0xb3, 0x9f,
-	0x40, 0x5d, 0x7f, 0x85, 0x2a, 0x71, 0x55, 0x4a, 0xfb, 0x6e, 0xd9, 0xb5, 0xca, 0x0e, 0xdd, 0xca,
-	0x98, 0xf1, 0xc3, 0x90, 0x47, 0xc7, 0xae, 0xcd, 0xc6, 0x92, 0x56, 0x17, 0x56, 0x8b, 0x0a, 0x68,
-	0x15, 0xcc, 0x23, 0x7c, 0xac, 0x6d, 0x2c, 0x7e, 0x8a, 0xf4, 0xff, 0x52, 0x60, 0x47, 0xb3, 0xfe,
-	0xb5, 0x13, 0xf3, 0xe9, 0x80, 0xba, 0x4a, 0xfb, 0x7b, 0x95, 0x0f, 0x0c, 0xe7, 0x0f, 0x06, 0xac,
-	0xee, 0x45, 0x74, 0xf4, 0xd6, 0xa9, 0xd4, 0x81, 0x7a, 0xa6, 0x2e, 0x4e, 0xa2, 0x37, 0x27, 0x9b,
-	0x95, 0x54, 0x2f, 0x41, 0xcd, 0x8f, 0xe8, 0xa8, 0xeb, 0x05, 0x81, 0x0c, 0x2c, 0x51, 0x22, 0x46,
-	0x74, 0xb4, 0x13, 0x04, 0xce, 0x2b, 0x58, 0xdf, 0xc3, 0xac, 0x1f, 0x91, 0xde, 0xdb, 0x27, 0xf9,
-	0x19, 0xfc, 0x9b, 0x4b, 0xa0, 0x66, 0x21, 0x81, 0x3a, 0x9f, 0x1b, 0xf0, 0x4e, 0xe1, 0xe4, 0xb3,
-	0xa0, 0xe3, 0x87, 0x79, 0xcc, 0x2a, 0x70, 0xcc, 0xe8, 0x7f, 0xb2, 0x58, 0xf5, 0x24, 0xff, 0xca,
-	0xb9, 0xfb, 0x22, 0xe7, 0xec, 0x47, 0xf4, 0x40, 0x56, 0x97, 0xe7, 0x57, 0x99, 0xfd, 0xcd, 0x80,
-	0xab, 0x53, 0xce, 0x38, 0xcb, 0x97, 0x17, 0x1b, 0xeb, 0xca, 0xac, 0xc6, 0xda, 0x2c, 0x36, 0xd6,
-	0xe5, 0x7d, 0x67, 0x75, 0x4a, 0xdf, 0xf9, 0xe7, 0x0a, 0x34, 0x3a, 0x9c, 0x46, 0xde, 0x01, 0xde,
-	0xa5, 0xe1, 0x80, 0x1c, 0x88, 0xb4, 0x9d, 0xd4, 0xeb, 0x86, 0xfc, 0xe8, 0xb4, 0x22, 0xbf, 0x0e,
-	0x75, 0xaf, 0xdf, 0xc7, 0x8c, 0x89, 0xf6, 0x45, 0x67, 0x23, 0xcb, 0xb5, 0x95, 0xec, 0x89, 0x10,
-	0xa1, 0x6f, 0xc0, 0x1a, 0xc3, 0xfd, 0x08, 0xf3, 0xee, 0x58, 0x53, 0x23, 0x78, 0x45, 0x4d, 0xec,
-	0x24, 0xda, 0xa2, 0xc0, 0x8f, 0x19, 0xee, 0x74, 0x3e, 0xd2, 0x28, 0xd6, 0x23, 0x51, 0x5e, 0xf5,
-	0xe2, 0xfe, 0x11, 0xe6, 0x59, 0x7a, 0x00, 0x25, 0x92, 0x50, 0xbc, 0x0c, 0x56, 0x44, 0x29, 0x97,
-	0x39, 0x5d, 0x72, 0xb9, 0xe5, 0xd6, 0x84, 0x40, 0xa4, 0x2d, 0xbd, 0x6b, 0x7b, 0xe7, 0xa9, 0xe6,
-	0x70, 0x3d, 0x12, 0x3d, 0x6a, 0x7b, 0xe7, 0xe9, 0x87, 0xa1, 0x3f, 0xa2, 0x24, 0xe4, 0x32, 0xc1,
-	0x5b, 0x6e, 0x56, 0x24, 0x3e, 0x8f, 0x29, 0x4b, 0x74, 0x45, 0xf9, 0x21, 0x93, 0xbb, 0xe5, 0xda,
-	0x5a, 0xf6, 0xfc, 0x78, 0x84, 0x9d, 0x7f, 0x99, 0xb0, 0xaa, 0x6a, 0xa8, 0xc7, 0xb4, 0x97, 0x80,
-	0xe9, 0x0a, 0x58, 0xfd, 0x20, 0x16, 0xed, 0x88, 0x46, 0x92, 0xe5, 0x8e, 0x05, 0xc2, 0x22, 0x59,
-	0x1a, 0x8a, 0xf0, 0x80, 0xbc, 0xd6, 0x96, 0x5b, 0x19, 0xf3, 0x90, 0x14, 0x67, 0x19, 0xd3, 0x9c,
-	0x60, 0x4c, 0xdf, 0xe3, 0x9e, 0xa6, 0xb1, 0xaa, 0xa4, 0x31, 0x4b, 0x48, 0x14, 0x83, 0x4d, 0x10,
-	0xd3, 0x42, 0x09, 0x31, 0x65, 0x98, 0x7a, 0x31, 0xcf, 0xd4, 0x79, 0xa8, 0x2f, 0x15, 0x43, 0xff,
-	0x11, 0x2c, 0x27, 0x86, 0xe9, 0x4b, 0x8c, 0x48, 0xeb, 0x95, 0xb4, 0x49, 0x32, 0x61, 0x66, 0xc1,
-	0xe4, 0x36, 0x58, 0x0e, 0x5b, 0x45, 0x66, 0xb7, 0x4e, 0xc5, 0xec, 0x85, 0xaa, 0x12, 0x4e, 0x53,
-	0x55, 0x66, 0x59, 0xda, 0xce, 0xb3, 0xf4, 0x47, 0xb0, 0xfa, 0xa3, 0x18, 0x47, 0xc7, 0x8f, 0x69,
-	0x8f, 0xcd, 0xe7, 0xe3, 0x16, 0xd4, 0xb4, 0xa3, 0x92, 0x84, 0x9e, 0x8e, 0x9d, 0x7f, 0x18, 0xd0,
-	0x90, 0xe1, 0xf6, 0xdc, 0x63, 0x47, 0xc9, 0xeb, 0x4c, 0xe2, 0x65, 0x23, 0xef, 0xe5, 0x53, 0xf6,
-	0x23, 0x25, 0x4f, 0x0b, 0x66, 0xd9, 0xd3, 0x42, 0x49, 0x9d, 0x53, 0x2d, 0xad, 0x73, 0x0a, 0x0d,
-	0xce, 0xc2, 0x44, 0x83, 0xf3, 0x85, 0x01, 0x6b, 0x19, 0x1b, 0x9d, 0x25, 0xe1, 0xe5, 0x2c, 0x5b,
-	0x29, 0x5a, 0xf6, 0x7e, 0x9e, 0x08, 0xcc, 0x32, 0x57, 0x67, 0x88, 0x20, 0xb1, 0x71, 0x8e, 0x0c,
-	0x9e, 0xc0, 0x8a, 0xa0, 0xea, 0xf3, 0x71, 0xe7, 0xdf, 0x0d, 0x58, 0x7a, 0x4c, 0x7b, 0xd2, 0x91,
-	0x59, 0x0c, 0x19, 0xf9, 0x67, 0xab, 0x55, 0x30, 0x7d, 0x32, 0xd4, 0xd9, 0x5b, 0xfc, 0x14, 0x31,
-	0xc6, 0xb8, 0x17, 0xf1, 0xf1, 0xc3, 0x9b, 0x28, 0xe4, 0x84, 0x44, 0xbe, 0xdd, 0x5c, 0x82, 0x1a,
-	0x0e, 0x7d, 0x35, 0xa9, 0xab, 0x65, 0x1c, 0xfa, 0x72, 0xea, 0x7c, 0x1a, 0xa0, 0x75, 0x58, 0x18,
-	0xd1, 0xf1, 0x63, 0x99, 0x1a, 0x38, 0xeb, 0x80, 0x1e, 0x62, 0xfe, 0x98, 0xf6, 0x84, 0x57, 0x12,
-	0xf3, 0x38, 0x7f, 0xad, 0xc8, 0xe6, 0x64, 0x2c, 0x3e, 0x8b, 0x83, 0x1d, 0x68, 0x28, 0xba, 0xfa,
-	0x94, 0xf6, 0xba, 0x61, 0x9c, 0x18, 0xc5, 0x96, 0xc2, 0xc7, 0xb4, 0xf7, 0x2c, 0x1e, 0xa2, 0x3b,
-	0x70, 0x91, 0x84, 0xdd, 0x91, 0x66, 0xd0, 0x54, 0x53, 0x59, 0x69, 0x95, 0x84, 0x09, 0xb7, 0x6a,
-	0xf5, 0x5b, 0xb0, 0x82, 0xc3, 0x17, 0x31, 0x8e, 0x71, 0xaa, 0xaa, 0x6c, 0xd6, 0xd0, 0x62, 0xad,
-	0x27, 0x98, 0xd2, 0x63, 0x47, 0x5d, 0x16, 0x50, 0xce, 0x74, 0x4e, 0xb4, 0x84, 0xa4, 0x23, 0x04,
-	0xe8, 0x03, 0xb0, 0xc4, 0x72, 0x05, 0x2d, 0xd5, 0x64, 0x5c, 0x2e, 0x83, 0x96, 0xf6, 0xb7, 0x5b,
-	0xfb, 0x54, 0xfd, 0x60, 0x22, 0x40, 0x74, 0xd9, 0xed, 0x13, 0x76, 0xa4, 0x99, 0x06, 0x94, 0x68,
-	0x8f, 0xb0, 0x23, 0xe7, 0xa7, 0x70, 0x29, 0xfb, 0x6c, 0x43, 0x18, 0x27, 0xfd, 0xf3, 0xac, 0x3e,
-	0x7e, 0x67, 0x40, 0xab, 0xec, 0x80, 0xff, 0x61, 0xd1, 0xb5, 0xfd, 0x2b, 0x1b, 0x40, 0xce, 0xec,
-	0x52, 0x1a, 0xf9, 0x28, 0x90, 0xd0, 0xda, 0xa5, 0xc3, 0x11, 0x0d, 0x71, 0xc8, 0x65, 0xc6, 0x62,
-	0x68, 0x2b, 0xbf, 0x9f, 0x1e, 0x4c, 0x2a, 0x6a, 0x5b, 0xb5, 0xde, 0x2d, 0xd5, 0x2f, 0x28, 0x3b,
-	0x17, 0xd0, 0x0b, 0xd9, 0x9c, 0x8c, 0x4d, 0xb1, 0x7b, 0xe8, 0x85, 0x21, 0x0e, 0xd0, 0xf6, 0x94,
-	0xa7, 0xbc, 0x32, 0xe5, 0xe4, 0xcc, 0x1b, 0xa5, 0x67, 0x76, 0x78, 0x44, 0xc2, 0x83, 0xc4, 0xc4,
-	0xce, 0x05, 0xf4, 0x1c, 0xec, 0xcc, 0x7b, 0x0a, 0xba, 0x55, 0x66, 0xa9, 0xc9, 0x07, 0x97, 0xd6,
-	0x49, 0xbe, 0x70, 0x2e, 0xa0, 0x01, 0x34, 0x72, 0x0f, 0x7e, 0x68, 0xf3, 0xa4, 0x9e, 0x28, 0xfb,
-	0xca, 0xd6, 0xfa, 0xfa, 0x1c, 0x9a, 0xe9, 0xed, 0x7f, 0xae, 0x0c, 0x36, 0xf1, 0x62, 0x76, 0x77,
-	0xca, 0x26, 0xd3, 0xde, 0xf6, 0x5a, 0xf7, 0xe6, 0x5f, 0x90, 0x1e, 0xee, 0x8f, 0x3f, 0x52, 0x05,
-	0xd4, 0xed, 0xd9, 0x8d, 0x9f, 0x3a, 0x6d, 0x73, 0xde, 0x0e, 0xd1, 0xb9, 0x80, 0xf6, 0xc1, 0x4a,
-	0x7b, 0x34, 0xf4, 0x6e, 0xd9, 0xc2, 0x62, 0x0b, 0x37, 0x87, 0x73, 0x72, 0x5d, 0x4e, 0xb9, 0x73,
-	0xca, 0x5a, 0xb0, 0x72, 0xe7, 0x94, 0xb6, 0x4c, 0xce, 0x05, 0x14, 0xcb, 0xd8, 0x29, 0x44, 0x37,
-	0xba, 0x33, 0xcb, 0xbf, 0xb9, 0x34, 0xd3, 0xda, 0x9a, 0x57, 0x3d, 0x3d, 0xf6, 0x17, 0xe3, 0xc7,
-	0xe6, 0x5c, 0x4b, 0x83, 0xee, 0x9d, 0xb4, 0x55, 0x59, 0x87, 0xd5, 0xfa, 0xd6, 0x5b, 0xac, 0xc8,
-	0x60, 0x12, 0x75, 0x0e, 0xe9, 0x2b, 0x55, 0x2c, 0xc6, 0x91, 0x27, 0x72, 0x61, 0xc9, 0xe1, 0x3a,
-	0x84, 0x27, 0x55, 0xa7, 0x1e, 0x7e, 0xc2, 0x8a, 0xf4, 0xf0, 0x2e, 0xc0, 0x43, 0xcc, 0x9f, 0x62,
-	0x1e, 0x09, 0x5b, 0xdf, 0x9a, 0x96, 0xa7, 0xb4, 0x42, 0x72, 0xd4, 0xed, 0x99, 0x7a, 0xe9, 0x01,
-	0x3d, 0xb0, 0x77, 0x0f, 0x71, 0xff, 0xe8, 0x11, 0xf6, 0x02, 0x7e, 0x88, 0xca, 0x57, 0x66, 0x34,
-	0xa6, 0x40, 0xbe, 0x4c, 0x31, 0x39, 0x63, 0xfb, 0x8b, 0x45, 0xfd, 0x37, 0xf5, 0x33, 0xea, 0xe3,
-	0xff, 0xff, 0x14, 0xbc, 0x0f, 0x56, 0xda, 0x8e, 0x95, 0x47, 0x78, 0xb1, 0x5b, 0x9b, 0x15, 0xe1,
-	0x9f, 0x80, 0x95, 0x16, 0xb6, 0xe5, 0x3b, 0x16, 0x7b, 0x83, 0xd6, 0xcd, 0x19, 0x5a, 0xe9, 0x6d,
-	0x9f, 0x41, 0x2d, 0x29, 0x44, 0xd1, 0x8d, 0x69, 0xe9, 0x28, 0xbb, 0xf3, 0x8c, 0xbb, 0xfe, 0x0c,
-	0xec, 0x4c, 0x95, 0x56, 0x4e, 0x40, 0x93, 0xd5, 0x5d, 0xeb, 0xf6, 0x4c, 0xbd, 0x2f, 0x47, 0x40,
-	0xde, 0xff, 0xf6, 0x27, 0xdb, 0x07, 0x84, 0x1f, 0xc6, 0x3d, 0x61, 0xd9, 0xbb, 0x4a, 0xf3, 0x0e,
-	0xa1, 0xfa, 0xd7, 0xdd, 0xe4, 0x96, 0x77, 0xe5, 0x4e, 0x77, 0xa5, 0x9d, 0x46, 0xbd, 0xde, 0xa2,
-	0x1c, 0xbe, 0xf7, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc2, 0x46, 0x93, 0xfc, 0x65, 0x22, 0x00,
-	0x00,
+	// 2454 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0xdd, 0x6e, 0x1b, 0xc7,
+	0x15, 0xf6, 0x6a, 0xf5, 0xc3, 0x3d, 0x4b, 0x4a, 0xd4, 0x58, 0x69, 0x69, 0xda, 0xae, 0xe5, 0x4d,
+	0x6c, 0x2b, 0x45, 0x23, 0xbb, 0x4a, 0x5d, 0x24, 0x45, 0x53, 0x40, 0x96, 0xfc, 0x43, 0x3b, 0x32,
+	0xd4, 0xa5, 0x11, 0xa0, 0x41, 0xd1, 0xed, 0x92, 0x3b, 0x94, 0x26, 0x5a, 0xee, 0xd0, 0x3b, 0x43,
+	0xdb, 0x72, 0x81, 0x22, 0x05, 0xd2, 0x8b, 0x16, 0x01, 0x8a, 0x14, 0x01, 0xfa, 0x02, 0xbd, 0x69,
+	0x1e, 0xa1, 0xd7, 0xbd, 0xec, 0x4d, 0x5f, 0xa1, 0x2f, 0xd0, 0x57, 0x28, 0xe6, 0x67, 0x97, 0xbb,
+	0xcb, 0xa5, 0x48, 0x4b, 0x2a, 0x0a, 0xb4, 0x77, 0x9c, 0xb3, 0xe7, 0xcc, 0x99, 0x39, 0x7f, 0xdf,
+	0x39, 0x03, 0xc2, 0x2a, 0x89, 0x02, 0xfc, 0xca, 0xeb, 0x52, 0x1a, 0x07, 0x9b, 0x83, 0x98, 0x72,
+	0x8a, 0x50, 0x9f, 0x84, 0x2f, 0x86, 0x4c, 0xad, 0x36, 0xe5, 0xf7, 0x66, 0xb5, 0x4b, 0xfb, 0x7d,
+	0x1a, 0x29, 0x5a, 0x73, 0x99, 0x44, 0x1c, 0xc7, 0x91, 0x1f, 0xea, 0x75, 0x35, 0x2b, 0xe1, 0x7c,
+	0xb1, 0x04, 0x56, 0x4b, 0x48, 0xb5, 0xa2, 0x1e, 0x45, 0x0e, 0x54, 0xbb, 0x34, 0x0c, 0x71, 0x97,
+	0x13, 0x1a, 0xb5, 0x76, 0x1b, 0xc6, 0xba, 0xb1, 0x61, 0xba, 0x39, 0x1a, 0x6a, 0xc0, 0x52, 0x8f,
+	0xe0, 0x30, 0x68, 0xed, 0x36, 0xe6, 0xe4, 0xe7, 0x64, 0x89, 0xae, 0x02, 0xa8, 0x03, 0x46, 0x7e,
+	0x1f, 0x37, 0xcc, 0x75, 0x63, 0xc3, 0x72, 0x2d, 0x49, 0x79, 0xea, 0xf7, 0xb1, 0x10, 0x94, 0x8b,
+	0xd6, 0x6e, 0x63, 0x5e, 0x09, 0xea, 0x25, 0xba, 0x07, 0x36, 0x3f, 0x1e, 0x60, 0x6f, 0xe0, 0xc7,
+	0x7e, 0x9f, 0x35, 0x16, 0xd6, 0xcd, 0x0d, 0x7b, 0xeb, 0xfa, 0x66, 0xee, 0x6a, 0xfa, 0x4e, 0x4f,
+	0xf0, 0xf1, 0x27, 0x7e, 0x38, 0xc4, 0xfb, 0x3e, 0x89, 0x5d, 0x10, 0x52, 0xfb, 0x52, 0x08, 0xed,
+	0x42, 0x55, 0x29, 0xd7, 0x9b, 0x2c, 0xce, 0xba, 0x89, 0x2d, 0xc5, 0xf4, 0x2e, 0xd7, 0xf5, 0x2e,
+	0x38, 0xf0, 0x62, 0xfa, 0x92, 0x35, 0x96, 0xe4, 0x41, 0x6d, 0x4d, 0x73, 0xe9, 0x4b, 0x26, 0x6e,
+	0xc9, 0x29, 0xf7, 0x43, 0xc5, 0x50, 0x91, 0x0c, 0x96, 0xa4, 0xc8, 0xcf, 0x77, 0x61, 0x81, 0x71,
+	0x9f, 0xe3, 0x86, 0xb5, 0x6e, 0x6c, 0x2c, 0x6f, 0x5d, 0x2b, 0x3d, 0x80, 0xb4, 0x78, 0x5b, 0xb0,
+	0xb9, 0x8a, 0x1b, 0xdd, 0x85, 0x6f, 0xab, 0xe3, 0xcb, 0xa5, 0xd7, 0xf3, 0x49, 0xe8, 0xc5, 0xd8,
+	0x67, 0x34, 0x6a, 0x80, 0x34, 0xe4, 0x1a, 0x49, 0x65, 0x1e, 0xf8, 0x24, 0x74, 0xe5, 0x37, 0xe4,
+	0x40, 0x8d, 0x30, 0xcf, 0x1f, 0x72, 0xea, 0xc9, 0xef, 0x0d, 0x7b, 0xdd, 0xd8, 0xa8, 0xb8, 0x36,
+	0x61, 0xdb, 0x43, 0x4e, 0xa5, 0x1a, 0xb4, 0x07, 0xab, 0x43, 0x86, 0x63, 0x2f, 0x67, 0x9e, 0xea,
+	0xac, 0xe6, 0x59, 0x11, 0xb2, 0xad, 0x8c, 0x89, 0xbe, 0x07, 0x68, 0x80, 0xa3, 0x80, 0x44, 0x07,
+	0x7a, 0x47, 0x69, 0x87, 0x9a, 0xb4, 0x43, 0x5d, 0x7f, 0x91, 0xfc, 0xd2, 0x1c, 0x6d, 0x58, 0xcb,
+	0x73, 0x6b, 0xfd, 0xcb, 0xb3, 0xea, 0x47, 0xd9, 0x2d, 0xf5, 0x11, 0x3e, 0x37, 0xe0, 0x2a, 0xc3,
+	0x07, 0x7d, 0x1c, 0x71, 0xbd, 0x2b, 0x8e, 0x0e, 0x48, 0x84, 0xbd, 0x17, 0x38, 0x66, 0x84, 0x46,
+	0xac, 0xb1, 0x22, 0xb7, 0xff, 0x68, 0x73, 0x3c, 0x3b, 0x36, 0xd3, 0x68, 0xdf, 0x6c, 0xab, 0x2d,
+	0x24, 0xe1, 0xbe, 0xdc, 0xe0, 0x13, 0x2d, 0x7f, 0x3f, 0xe2, 0xf1, 0xb1, 0xdb, 0x64, 0x13, 0x19,
+	0x9a, 0x7b, 0x70, 0x6d, 0x8a, 0x38, 0xaa, 0x83, 0x79, 0x84, 0x8f, 0x75, 0x0e, 0x89, 0x9f, 0x68,
+	0x0d, 0x16, 0x5e, 0x88, 0x8b, 0xc9, 0xc4, 0x59, 0x70, 0xd5, 0xe2, 0x47, 0x73, 0x1f, 0x18, 0xce,
+	0x6f, 0x0d, 0x80, 0x07, 0x32, 0x8d, 0xa4, 0xcb, 0x7e, 0x9c, 0x64, 0x12, 0x89, 0x7a, 0x54, 0xee,
+	0x60, 0x6f, 0x5d, 0x3d, 0xf1, 0x32, 0x3a, 0xd1, 0x64, 0x16, 0x37, 0x60, 0x29, 0xc0, 0x21, 0xe6,
+	0x38, 0x90, 0x8a, 0x2a, 0x6e, 0xb2, 0x44, 0xd7, 0xc0, 0xee, 0xc6, 0x58, 0x04, 0x18, 0x27, 0x3a,
+	0x45, 0xe7, 0x5d, 0x50, 0xa4, 0x67, 0xa4, 0x8f, 0x9d, 0x7f, 0xcc, 0x43, 0x35, 0x7b, 0xaf, 0x99,
+	0x2a, 0xc2, 0x3a, 0xd8, 0x03, 0x3f, 0xe6, 0x44, 0xb3, 0xa8, 0xaa, 0x90, 0x25, 0xa1, 0x2b, 0x60,
+	0x25, 0xb6, 0xdc, 0x95, 0x5a, 0x4d, 0x77, 0x44, 0x40, 0x97, 0xa0, 0x12, 0x0d, 0xfb, 0x2a, 0x8e,
+	0x74, 0x65, 0x88, 0x86, 0x7d, 0x19, 0x3e, 0x99, 0x9a, 0xb1, 0x90, 0xaf, 0x19, 0x0d, 0x58, 0xea,
+	0x0c, 0x89, 0x2c, 0x43, 0x8b, 0xea, 0x8b, 0x5e, 0xa2, 0x6f, 0xc1, 0x62, 0x44, 0x03, 0xdc, 0xda,
+	0xd5, 0xd9, 0xab, 0x57, 0xe8, 0x6d, 0xa8, 0x29, 0xa3, 0xea, 0x28, 0xd1, 0xb9, 0xab, 0x12, 0x5e,
+	0xbb, 0xee, 0xb4, 0xe9, 0x7b, 0x0d, 0xec, 0xf1, 0x94, 0x85, 0xde, 0x28, 0x51, 0x6f, 0xc2, 0x8a,
+	0x52, 0xde, 0x23, 0x21, 0xf6, 0x8e, 0xf0, 0x31, 0x6b, 0xd8, 0xeb, 0xe6, 0x86, 0xe5, 0xaa, 0x33,
+	0x3d, 0x20, 0x21, 0x7e, 0x82, 0x8f, 0x59, 0xd6, 0x77, 0xd5, 0x13, 0x7d, 0x57, 0x2b, 0xfa, 0x0e,
+	0xdd, 0x80, 0x65, 0x86, 0x63, 0xe2, 0x87, 0xe4, 0x35, 0xf6, 0x18, 0x79, 0x8d, 0x1b, 0xcb, 0x92,
+	0xa7, 0x96, 0x52, 0xdb, 0xe4, 0x35, 0x16, 0x66, 0x78, 0x19, 0x13, 0x8e, 0xbd, 0x43, 0x3f, 0x0a,
+	0x68, 0xaf, 0xd7, 0x58, 0x91, 0x7a, 0xaa, 0x92, 0xf8, 0x48, 0xd1, 0xc4, 0x31, 0x62, 0x2c, 0x0d,
+	0xda, 0xa8, 0xab, 0x63, 0xe8, 0x25, 0xba, 0x03, 0x6b, 0x65, 0x29, 0xd7, 0x58, 0x95, 0x21, 0x8d,
+	0xc8, 0x58, 0x36, 0x38, 0x7f, 0x32, 0xe0, 0xa2, 0x8b, 0x0f, 0x08, 0xe3, 0x38, 0x7e, 0x4a, 0x03,
+	0xec, 0xe2, 0xe7, 0x43, 0xcc, 0x38, 0xba, 0x03, 0xf3, 0x1d, 0x9f, 0x61, 0x1d, 0xde, 0x57, 0x4a,
+	0x2d, 0xbd, 0xc7, 0x0e, 0xee, 0xf9, 0x0c, 0xbb, 0x92, 0x13, 0xfd, 0x10, 0x96, 0xfc, 0x20, 0x88,
+	0x31, 0x63, 0x32, 0xc8, 0x26, 0x09, 0x6d, 0x2b, 0x1e, 0x37, 0x61, 0xce, 0x44, 0x84, 0x99, 0x8d,
+	0x08, 0xe7, 0x0f, 0x06, 0xac, 0xe5, 0x4f, 0xc6, 0x06, 0x34, 0x62, 0x18, 0xbd, 0x0f, 0x8b, 0xc2,
+	0xaf, 0x43, 0xa6, 0x0f, 0x77, 0xb9, 0x54, 0x4f, 0x5b, 0xb2, 0xb8, 0x9a, 0x55, 0xa0, 0x18, 0x89,
+	0x08, 0x4f, 0x2a, 0x9c, 0x3a, 0xe1, 0xf5, 0x62, 0xd6, 0x6a, 0x2c, 0x6e, 0x45, 0x84, 0xab, 0x6a,
+	0xe6, 0x02, 0x49, 0x7f, 0x3b, 0x3f, 0x83, 0xb5, 0x87, 0x98, 0x67, 0xe2, 0x4b, 0xdb, 0x6a, 0x96,
+	0x34, 0xcc, 0xc3, 0xef, 0x5c, 0x01, 0x7e, 0x9d, 0x3f, 0x1b, 0xf0, 0x56, 0x61, 0xef, 0xb3, 0xdc,
+	0x36, 0x4d, 0x94, 0xb9, 0xb3, 0x24, 0x8a, 0x59, 0x4c, 0x14, 0xe7, 0x73, 0x03, 0x2e, 0x3f, 0xc4,
+	0x3c, 0x5b, 0x84, 0xce, 0xd9, 0x12, 0xe8, 0x3b, 0x00, 0x69, 0xf1, 0x61, 0x0d, 0x73, 0xdd, 0xdc,
+	0x30, 0xdd, 0x0c, 0xc5, 0xf9, 0x9d, 0x01, 0xab, 0x63, 0xfa, 0xf3, 0x35, 0xcc, 0x28, 0xd6, 0xb0,
+	0xff, 0x94, 0x39, 0xfe, 0x68, 0xc0, 0x95, 0x72, 0x73, 0x9c, 0xc5, 0x79, 0x1f, 0x29, 0x21, 0x2c,
+	0xa2, 0x54, 0x00, 0xe5, 0x8d, 0x32, 0x6c, 0x19, 0xd7, 0xa9, 0x85, 0x9c, 0x2f, 0x4d, 0x40, 0x3b,
+	0xb2, 0xf0, 0x28, 0xa0, 0x7f, 0x03, 0xd7, 0x9c, 0xba, 0x7b, 0x2c, 0xf4, 0x88, 0xf3, 0xe7, 0xd1,
+	0x23, 0x2e, 0x9c, 0xaa, 0x47, 0xbc, 0x02, 0x96, 0xa8, 0xc0, 0x8c, 0xfb, 0xfd, 0x81, 0xc4, 0x9e,
+	0x79, 0x77, 0x44, 0x18, 0xef, 0xc8, 0x96, 0x66, 0xec, 0xc8, 0x2a, 0xa7, 0xed, 0xc8, 0x9c, 0x57,
+	0x70, 0x31, 0x49, 0x6c, 0xd9, 0x0a, 0xbc, 0x81, 0x3b, 0xf2, 0xa9, 0x30, 0x57, 0x4c, 0x85, 0x29,
+	0x4e, 0x71, 0xfe, 0x62, 0xc2, 0x6a, 0x2b, 0xc1, 0xaf, 0x7d, 0x9f, 0x1f, 0xca, 0xfe, 0xe3, 0xe4,
+	0x4c, 0x99, 0x1c, 0x01, 0x19, 0xb0, 0x37, 0x27, 0x82, 0xfd, 0x7c, 0x1e, 0xec, 0xf3, 0x07, 0x5c,
+	0x28, 0x46, 0xcd, 0xf9, 0x4c, 0x05, 0x1b, 0x50, 0xcf, 0x80, 0xf7, 0xc0, 0xe7, 0x87, 0x62, 0x32,
+	0x10, 0xe8, 0xbd, 0x4c, 0xb2, 0xb7, 0x67, 0xe8, 0x16, 0xac, 0xa4, 0x68, 0x1b, 0x28, 0x10, 0xae,
+	0xc8, 0x08, 0x19, 0x41, 0x73, 0x90, 0xa0, 0x70, 0xbe, 0x19, 0xb1, 0x4a, 0x9a, 0x91, 0x6c, 0x63,
+	0x04, 0xf9, 0xc6, 0x68, 0x12, 0x0c, 0xdb, 0x13, 0x61, 0xf8, 0xaf, 0x06, 0xd8, 0x69, 0x4a, 0xcf,
+	0x38, 0xeb, 0xe5, 0x3c, 0x39, 0x57, 0xf4, 0xe4, 0x75, 0xa8, 0xe2, 0xc8, 0xef, 0x84, 0x58, 0x47,
+	0xba, 0xa9, 0x22, 0x5d, 0xd1, 0x54, 0xa4, 0x3f, 0x00, 0x7b, 0xd4, 0xc8, 0x26, 0x59, 0x7b, 0x63,
+	0x62, 0x27, 0x9b, 0x0d, 0x23, 0x17, 0xd2, 0x8e, 0x96, 0x39, 0xbf, 0x9f, 0x1b, 0x01, 0xa3, 0x8a,
+	0xf1, 0xb3, 0x94, 0xbf, 0x9f, 0x43, 0x75, 0x34, 0x3e, 0xf4, 0xa8, 0x2e, 0x82, 0x1f, 0x96, 0x1d,
+	0xab, 0x4c, 0xe9, 0x66, 0xc6, 0x8c, 0x6a, 0x52, 0xb0, 0xd9, 0x88, 0xd2, 0xf4, 0xa0, 0x5e, 0x64,
+	0x28, 0x99, 0x05, 0xee, 0x66, 0x67, 0x01, 0xbb, 0x08, 0x18, 0x85, 0x0a, 0xdc, 0xa3, 0xd9, 0x61,
+	0xe1, 0x6b, 0x03, 0xea, 0xbb, 0x31, 0x1d, 0xbc, 0x71, 0xf1, 0x75, 0xa0, 0x9a, 0xe9, 0xca, 0x93,
+	0x7c, 0xcf, 0xd1, 0xa6, 0x95, 0xe1, 0x4b, 0x50, 0x09, 0x62, 0x3a, 0xf0, 0xfc, 0x30, 0x94, 0xa9,
+	0x28, 0x1a, 0xd4, 0x98, 0x0e, 0xb6, 0xc3, 0xd0, 0x79, 0x09, 0x6b, 0xbb, 0x98, 0x75, 0x63, 0xd2,
+	0x79, 0x73, 0x58, 0x98, 0x82, 0xd8, 0xb9, 0x92, 0x6b, 0x16, 0x4a, 0xae, 0xf3, 0xa5, 0x01, 0x6f,
+	0x15, 0x34, 0x9f, 0x25, 0x3a, 0x7e, 0x92, 0x8f, 0x59, 0x15, 0x1c, 0x53, 0xa6, 0xaf, 0x6c, 0xac,
+	0xfa, 0x12, 0xb1, 0xe5, 0xb7, 0x7b, 0xa2, 0x4a, 0xed, 0xc7, 0xf4, 0x40, 0xf6, 0xa3, 0xe7, 0xd7,
+	0xcb, 0xfd, 0xcd, 0x80, 0xab, 0x13, 0x74, 0x9c, 0xe5, 0xe6, 0xc5, 0xd7, 0x8f, 0xb9, 0x69, 0xaf,
+	0x1f, 0x66, 0xf1, 0xf5, 0xa3, 0xfc, 0x71, 0x60, 0xbe, 0xfc, 0x71, 0xc0, 0xf9, 0xda, 0x84, 0x5a,
+	0x9b, 0xd3, 0xd8, 0x3f, 0xc0, 0x3b, 0x34, 0xea, 0x91, 0x03, 0x51, 0xe8, 0x93, 0x0e, 0xdf, 0x90,
+	0x97, 0x4e, 0x7b, 0xf8, 0xeb, 0x50, 0xf5, 0xbb, 0x5d, 0xcc, 0x98, 0x18, 0x9e, 0x74, 0x35, 0xb2,
+	0x5c, 0x5b, 0xd1, 0x9e, 0x08, 0x12, 0xfa, 0x2e, 0xac, 0x32, 0xdc, 0x8d, 0x31, 0xf7, 0x46, 0x9c,
+	0x3a, 0x82, 0x57, 0xd4, 0x87, 0xed, 0x84, 0x5b, 0x8c, 0x04, 0x43, 0x86, 0xdb, 0xed, 0x8f, 0x75,
+	0x14, 0xeb, 0x95, 0x68, 0xc8, 0x3a, 0xc3, 0xee, 0x11, 0xe6, 0x59, 0x40, 0x01, 0x45, 0x92, 0xa1,
+	0x78, 0x19, 0xac, 0x98, 0x52, 0x2e, 0x51, 0x40, 0xa2, 0xbf, 0xe5, 0x56, 0x04, 0x41, 0x94, 0x2d,
+	0xbd, 0x6b, 0x6b, 0x7b, 0x4f, 0xa3, 0xbe, 0x5e, 0x89, 0x09, 0xb9, 0xb5, 0xbd, 0x77, 0x3f, 0x0a,
+	0x06, 0x94, 0x44, 0x5c, 0x42, 0x82, 0xe5, 0x66, 0x49, 0xe2, 0x7a, 0x4c, 0x59, 0xc2, 0x13, 0x0d,
+	0x8b, 0x84, 0x03, 0xcb, 0xb5, 0x35, 0xed, 0xd9, 0xf1, 0x40, 0xa6, 0x5e, 0x4c, 0x43, 0xec, 0xf9,
+	0x71, 0x32, 0x60, 0x2e, 0x89, 0xf5, 0x76, 0x1c, 0x29, 0x69, 0xe6, 0xe1, 0x44, 0x81, 0x9d, 0x48,
+	0xb3, 0x54, 0xc1, 0xbb, 0x50, 0x67, 0x98, 0x09, 0x24, 0xf0, 0x82, 0x61, 0xec, 0x8b, 0x38, 0x93,
+	0x13, 0xa6, 0x29, 0x6c, 0x23, 0xe9, 0xbb, 0x9a, 0xec, 0xfc, 0xd3, 0x84, 0xba, 0x6a, 0xef, 0x1e,
+	0xd3, 0x4e, 0x12, 0xb5, 0x57, 0xc0, 0xea, 0x86, 0x43, 0x31, 0x29, 0xe9, 0x90, 0xb5, 0xdc, 0x11,
+	0x41, 0x98, 0x3e, 0x8b, 0x90, 0x31, 0xee, 0x91, 0x57, 0xda, 0x45, 0x2b, 0x23, 0x88, 0x94, 0xe4,
+	0x2c, 0x98, 0x9b, 0x63, 0x60, 0x1e, 0xf8, 0xdc, 0xd7, 0x08, 0x3b, 0x2f, 0x11, 0xd6, 0x12, 0x14,
+	0x05, 0xae, 0x63, 0x98, 0xb9, 0x50, 0x82, 0x99, 0x99, 0x26, 0x62, 0x31, 0xdf, 0x44, 0xe4, 0x73,
+	0x6a, 0xa9, 0x58, 0x63, 0x1e, 0xc1, 0x72, 0xe2, 0x81, 0xae, 0x0c, 0x46, 0xe9, 0xa6, 0x92, 0x09,
+	0x4e, 0x56, 0xe6, 0x6c, 0xd4, 0xba, 0x35, 0x96, 0x0b, 0xe2, 0x62, 0xd3, 0x61, 0x9d, 0xaa, 0xe9,
+	0x28, 0x34, 0xbc, 0x70, 0x9a, 0x86, 0x37, 0xdb, 0x40, 0xd8, 0xb9, 0x06, 0xc2, 0xf9, 0x18, 0xea,
+	0x3f, 0x1d, 0xe2, 0xf8, 0xf8, 0x31, 0xed, 0xb0, 0xd9, 0x7c, 0xdc, 0x84, 0x8a, 0x76, 0x54, 0x82,
+	0x1c, 0xe9, 0xda, 0xf9, 0x62, 0x0e, 0x6a, 0x32, 0xaf, 0x9f, 0xf9, 0xec, 0x28, 0x79, 0x84, 0x4a,
+	0xbc, 0x6c, 0xe4, 0xbd, 0x7c, 0xca, 0x51, 0xa9, 0xe4, 0x05, 0xc5, 0x2c, 0x7b, 0x41, 0x29, 0x69,
+	0xc1, 0xe6, 0x4b, 0x5b, 0xb0, 0xc2, 0xec, 0xb5, 0x30, 0xf6, 0x66, 0x33, 0xa9, 0xc7, 0x5a, 0x9c,
+	0xd8, 0x63, 0x7d, 0x63, 0xc0, 0x6a, 0xc6, 0xaa, 0x67, 0xa9, 0xc5, 0x39, 0x5f, 0xcc, 0x15, 0x7d,
+	0x71, 0x2f, 0x8f, 0x51, 0x66, 0x59, 0x70, 0x64, 0x30, 0x2a, 0xf1, 0x4a, 0x0e, 0xa7, 0x9e, 0xc0,
+	0x8a, 0xe8, 0x22, 0xce, 0x27, 0x00, 0xfe, 0x6e, 0xc0, 0xd2, 0x63, 0xda, 0x91, 0xae, 0xcf, 0x46,
+	0x9d, 0x91, 0x6f, 0x5b, 0xeb, 0x60, 0x06, 0xa4, 0xaf, 0x81, 0x45, 0xfc, 0x14, 0x59, 0xc9, 0xb8,
+	0x1f, 0xf3, 0xd1, 0x8b, 0xa4, 0xe8, 0x31, 0x05, 0x45, 0x3e, 0x6a, 0x5d, 0x82, 0x0a, 0x8e, 0x02,
+	0xf5, 0x51, 0xb7, 0xfe, 0x38, 0x0a, 0xe4, 0xa7, 0xf3, 0x99, 0xe6, 0xd6, 0x60, 0x61, 0x40, 0x47,
+	0xaf, 0x88, 0x6a, 0xe1, 0xac, 0x01, 0x7a, 0x88, 0xf9, 0x63, 0xda, 0x11, 0x5e, 0x49, 0xcc, 0xe3,
+	0xfc, 0x6b, 0x4e, 0x4e, 0x5a, 0x23, 0xf2, 0x59, 0x1c, 0xec, 0x40, 0x4d, 0x21, 0xe9, 0x67, 0xb4,
+	0xe3, 0x45, 0xc3, 0xc4, 0x28, 0xb6, 0x24, 0x3e, 0xa6, 0x9d, 0xa7, 0xc3, 0x3e, 0x7a, 0x0f, 0x2e,
+	0x92, 0xc8, 0x1b, 0x68, 0x70, 0x4f, 0x39, 0x95, 0x95, 0xea, 0x24, 0x4a, 0x60, 0x5f, 0xb3, 0xdf,
+	0x84, 0x15, 0x1c, 0x3d, 0x1f, 0xe2, 0x21, 0x4e, 0x59, 0x95, 0xcd, 0x6a, 0x9a, 0xac, 0xf9, 0x04,
+	0x88, 0xfb, 0xec, 0xc8, 0x63, 0x21, 0xe5, 0x4c, 0x57, 0x51, 0x4b, 0x50, 0xda, 0x82, 0x80, 0x3e,
+	0x00, 0x4b, 0x88, 0xab, 0xd0, 0x52, 0x13, 0xd3, 0xe5, 0xb2, 0xd0, 0xd2, 0xfe, 0x76, 0x2b, 0x9f,
+	0xa9, 0x1f, 0x4c, 0xa4, 0x94, 0x9e, 0x08, 0x02, 0xc2, 0x8e, 0x34, 0x08, 0x82, 0x22, 0xed, 0x12,
+	0x76, 0x84, 0x36, 0xe1, 0xa2, 0xff, 0xc2, 0x27, 0x61, 0xca, 0x33, 0x9a, 0x91, 0x4c, 0x77, 0x35,
+	0xfd, 0x24, 0x78, 0x45, 0x8e, 0x3a, 0xbf, 0x80, 0x4b, 0xd9, 0x37, 0x2b, 0xc2, 0x38, 0xe9, 0x9e,
+	0x67, 0x23, 0xf5, 0x95, 0x01, 0xcd, 0x32, 0x05, 0xff, 0xcd, 0xfe, 0xf1, 0x2b, 0x03, 0x56, 0xb7,
+	0x43, 0xae, 0x67, 0xfc, 0x73, 0xec, 0xa2, 0x3f, 0x84, 0x45, 0x9d, 0x2a, 0xe6, 0xac, 0xa9, 0xa2,
+	0x05, 0xb6, 0x7e, 0x63, 0x03, 0xc8, 0xe3, 0xec, 0x50, 0x1a, 0x07, 0x28, 0x94, 0xe9, 0xb1, 0x43,
+	0xfb, 0x03, 0x1a, 0xe1, 0x88, 0xcb, 0x3a, 0xcd, 0xd0, 0x66, 0x7e, 0x3f, 0xbd, 0x18, 0x67, 0xd4,
+	0x57, 0x6a, 0xbe, 0x53, 0xca, 0x5f, 0x60, 0x76, 0x2e, 0xa0, 0xe7, 0x72, 0xf6, 0x1b, 0xb9, 0x67,
+	0xe7, 0xd0, 0x8f, 0x22, 0x1c, 0xa2, 0xad, 0x09, 0x6f, 0xab, 0x65, 0xcc, 0x89, 0xce, 0xb7, 0x4b,
+	0x75, 0xb6, 0x79, 0x4c, 0xa2, 0x83, 0xc4, 0xed, 0xce, 0x05, 0xf4, 0x0c, 0xec, 0xcc, 0x03, 0x17,
+	0xba, 0x59, 0xe6, 0xbd, 0xf1, 0x17, 0xb0, 0xe6, 0x49, 0xf1, 0xe1, 0x5c, 0x40, 0x3d, 0xa8, 0xe5,
+	0x5e, 0x60, 0xd1, 0xc6, 0x49, 0x23, 0x67, 0xf6, 0xd9, 0xb3, 0xf9, 0xee, 0x0c, 0x9c, 0xe9, 0xe9,
+	0x7f, 0xa5, 0x0c, 0x36, 0xf6, 0x84, 0x79, 0x7b, 0xc2, 0x26, 0x93, 0x1e, 0x5b, 0x9b, 0x77, 0x66,
+	0x17, 0x48, 0x95, 0x07, 0xa3, 0x4b, 0xaa, 0xa2, 0x70, 0x6b, 0xfa, 0x5c, 0xad, 0xb4, 0x6d, 0xcc,
+	0x3a, 0x80, 0x3b, 0x17, 0xd0, 0x3e, 0x58, 0xe9, 0x08, 0x8c, 0xde, 0x29, 0x13, 0x2c, 0x4e, 0xc8,
+	0x33, 0x38, 0x27, 0x37, 0x44, 0x96, 0x3b, 0xa7, 0x6c, 0xc2, 0x2d, 0x77, 0x4e, 0xe9, 0x44, 0xea,
+	0x5c, 0x40, 0x43, 0x99, 0x3b, 0x85, 0x8a, 0x83, 0xde, 0x9b, 0xe6, 0xdf, 0x5c, 0xe9, 0x6b, 0x6e,
+	0xce, 0xca, 0x9e, 0xaa, 0xfd, 0xf5, 0xe8, 0xf5, 0x3f, 0x37, 0x31, 0xa2, 0x3b, 0x27, 0x6d, 0x55,
+	0x36, 0xc0, 0x36, 0xbf, 0xff, 0x06, 0x12, 0x99, 0x98, 0x44, 0xed, 0x43, 0xfa, 0x52, 0xb5, 0xc8,
+	0x7a, 0xd2, 0x28, 0x51, 0xae, 0x53, 0x78, 0x9c, 0x75, 0xa2, 0xf2, 0x13, 0x24, 0x52, 0xe5, 0x1e,
+	0xc0, 0x43, 0xcc, 0xf7, 0x30, 0x8f, 0x85, 0xad, 0x6f, 0x4e, 0xaa, 0x53, 0x9a, 0x21, 0x51, 0x75,
+	0x6b, 0x2a, 0x5f, 0xaa, 0xa0, 0x03, 0xf6, 0xce, 0x21, 0xee, 0x1e, 0x3d, 0xc2, 0x7e, 0xc8, 0x0f,
+	0x51, 0xb9, 0x64, 0x86, 0x63, 0x42, 0xc8, 0x97, 0x31, 0x26, 0x3a, 0xb6, 0xbe, 0x59, 0xd4, 0x7f,
+	0xd5, 0x78, 0x4a, 0x03, 0xfc, 0xbf, 0x5f, 0x82, 0xf7, 0xc1, 0x4a, 0x87, 0xd0, 0xf2, 0x0c, 0x2f,
+	0xce, 0xa8, 0xd3, 0x32, 0xfc, 0x53, 0xb0, 0xd2, 0xe6, 0xbc, 0x7c, 0xc7, 0xe2, 0x44, 0xd4, 0xbc,
+	0x31, 0x85, 0x2b, 0x3d, 0xed, 0x53, 0xa8, 0x24, 0xcd, 0x34, 0x7a, 0x7b, 0x52, 0x39, 0xca, 0xee,
+	0x3c, 0xe5, 0xac, 0xbf, 0x04, 0x3b, 0xd3, 0x69, 0x96, 0x03, 0xd0, 0x78, 0x87, 0xda, 0xbc, 0x35,
+	0x95, 0xef, 0xff, 0x23, 0x21, 0xef, 0xfd, 0xe0, 0xd3, 0xad, 0x03, 0xc2, 0x0f, 0x87, 0x1d, 0x61,
+	0xd9, 0xdb, 0x8a, 0xf3, 0x3d, 0x42, 0xf5, 0xaf, 0xdb, 0xc9, 0x29, 0x6f, 0xcb, 0x9d, 0x6e, 0x4b,
+	0x3b, 0x0d, 0x3a, 0x9d, 0x45, 0xb9, 0x7c, 0xff, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x32, 0x9a,
+	0x96, 0x82, 0x69, 0x25, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
 	commonpb "github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	msgpb "github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	schemapb "github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	math "math"
 )
@@ -1116,9 +1117,11 @@ type RetrieveRequest struct {
 	IsCount                      bool              `protobuf:"varint,13,opt,name=is_count,json=isCount,proto3" json:"is_count,omitempty"`
 	IterationExtensionReduceRate int64             `protobuf:"varint,14,opt,name=iteration_extension_reduce_rate,json=iterationExtensionReduceRate,proto3" json:"iteration_extension_reduce_rate,omitempty"`
 	Username                     string            `protobuf:"bytes,15,opt,name=username,proto3" json:"username,omitempty"`
-	XXX_NoUnkeyedLiteral         struct{}          `json:"-"`
-	XXX_unrecognized             []byte            `json:"-"`
-	XXX_sizecache                int32             `json:"-"`
+	// duplicated primary keys are neither returned nor counted into the limit
+	Dedup                bool     `protobuf:"varint,16,opt,name=dedup,proto3" json:"dedup,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *RetrieveRequest) Reset()         { *m = RetrieveRequest{} }
@@ -1251,6 +1254,13 @@ func (m *RetrieveRequest) GetUsername() string {
 	return ""
 }
 
+func (m *RetrieveRequest) GetDedup() bool {
+	if m != nil {
+		return m.Dedup
+	}
+	return false
+}
+
 type RetrieveResults struct {
 	Base                      *commonpb.MsgBase     `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Status                    *commonpb.Status      `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
@@ -1925,6 +1935,228 @@ func (m *ShowConfigurationsResponse) GetConfiguations() []*commonpb.KeyValuePair
 	return nil
 }
 
+type DispatcherPosition struct {
+	Vchannel string `protobuf:"bytes,1,opt,name=vchannel,proto3" json:"vchannel,omitempty"`
+	Pchannel string `protobuf:"bytes,2,opt,name=pchannel,proto3" json:"pchannel,omitempty"`
+	// the end position of the latest pack dispatched to the vchannel
+	Position             *msgpb.MsgPosition `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
+	IsMain               bool               `protobuf:"varint,4,opt,name=is_main,json=isMain,proto3" json:"is_main,omitempty"`
+	CurTs                uint64             `protobuf:"varint,5,opt,name=cur_ts,json=curTs,proto3" json:"cur_ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *DispatcherPosition) Reset()         { *m = DispatcherPosition{} }
+func (m *DispatcherPosition) String() string { return proto.CompactTextString(m) }
+func (*DispatcherPosition) ProtoMessage()    {}
+func (*DispatcherPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{27}
+}
+
+func (m *DispatcherPosition) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DispatcherPosition.Unmarshal(m, b)
+}
+func (m *DispatcherPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DispatcherPosition.Marshal(b, m, deterministic)
+}
+func (m *DispatcherPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DispatcherPosition.Merge(m, src)
+}
+func (m *DispatcherPosition) XXX_Size() int {
+	return xxx_messageInfo_DispatcherPosition.Size(m)
+}
+func (m *DispatcherPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_DispatcherPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DispatcherPosition proto.InternalMessageInfo
+
+func (m *DispatcherPosition) GetVchannel() string {
+	if m != nil {
+		return m.Vchannel
+	}
+	return ""
+}
+
+func (m *DispatcherPosition) GetPchannel() string {
+	if m != nil {
+		return m.Pchannel
+	}
+	return ""
+}
+
+func (m *DispatcherPosition) GetPosition() *msgpb.MsgPosition {
+	if m != nil {
+		return m.Position
+	}
+	return nil
+}
+
+func (m *DispatcherPosition) GetIsMain() bool {
+	if m != nil {
+		return m.IsMain
+	}
+	return false
+}
+
+func (m *DispatcherPosition) GetCurTs() uint64 {
+	if m != nil {
+		return m.CurTs
+	}
+	return 0
+}
+
+type DumpDispatcherPositionsRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// dump all the vchannels consumed by the node if empty
+	Vchannels            []string `protobuf:"bytes,2,rep,name=vchannels,proto3" json:"vchannels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DumpDispatcherPositionsRequest) Reset()         { *m = DumpDispatcherPositionsRequest{} }
+func (m *DumpDispatcherPositionsRequest) String() string { return proto.CompactTextString(m) }
+func (*DumpDispatcherPositionsRequest) ProtoMessage()    {}
+func (*DumpDispatcherPositionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{28}
+}
+
+func (m *DumpDispatcherPositionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DumpDispatcherPositionsRequest.Unmarshal(m, b)
+}
+func (m *DumpDispatcherPositionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DumpDispatcherPositionsRequest.Marshal(b, m, deterministic)
+}
+func (m *DumpDispatcherPositionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DumpDispatcherPositionsRequest.Merge(m, src)
+}
+func (m *DumpDispatcherPositionsRequest) XXX_Size() int {
+	return xxx_messageInfo_DumpDispatcherPositionsRequest.Size(m)
+}
+func (m *DumpDispatcherPositionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DumpDispatcherPositionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DumpDispatcherPositionsRequest proto.InternalMessageInfo
+
+func (m *DumpDispatcherPositionsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DumpDispatcherPositionsRequest) GetVchannels() []string {
+	if m != nil {
+		return m.Vchannels
+	}
+	return nil
+}
+
+type DumpDispatcherPositionsResponse struct {
+	Status               *commonpb.Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Positions            []*DispatcherPosition `protobuf:"bytes,2,rep,name=positions,proto3" json:"positions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *DumpDispatcherPositionsResponse) Reset()         { *m = DumpDispatcherPositionsResponse{} }
+func (m *DumpDispatcherPositionsResponse) String() string { return proto.CompactTextString(m) }
+func (*DumpDispatcherPositionsResponse) ProtoMessage()    {}
+func (*DumpDispatcherPositionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{29}
+}
+
+func (m *DumpDispatcherPositionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DumpDispatcherPositionsResponse.Unmarshal(m, b)
+}
+func (m *DumpDispatcherPositionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DumpDispatcherPositionsResponse.Marshal(b, m, deterministic)
+}
+func (m *DumpDispatcherPositionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DumpDispatcherPositionsResponse.Merge(m, src)
+}
+func (m *DumpDispatcherPositionsResponse) XXX_Size() int {
+	return xxx_messageInfo_DumpDispatcherPositionsResponse.Size(m)
+}
+func (m *DumpDispatcherPositionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DumpDispatcherPositionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DumpDispatcherPositionsResponse proto.InternalMessageInfo
+
+func (m *DumpDispatcherPositionsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *DumpDispatcherPositionsResponse) GetPositions() []*DispatcherPosition {
+	if m != nil {
+		return m.Positions
+	}
+	return nil
+}
+
+type SeekDispatcherRequest struct {
+	Base                 *commonpb.MsgBase  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Vchannel             string             `protobuf:"bytes,2,opt,name=vchannel,proto3" json:"vchannel,omitempty"`
+	Position             *msgpb.MsgPosition `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *SeekDispatcherRequest) Reset()         { *m = SeekDispatcherRequest{} }
+func (m *SeekDispatcherRequest) String() string { return proto.CompactTextString(m) }
+func (*SeekDispatcherRequest) ProtoMessage()    {}
+func (*SeekDispatcherRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{30}
+}
+
+func (m *SeekDispatcherRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SeekDispatcherRequest.Unmarshal(m, b)
+}
+func (m *SeekDispatcherRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SeekDispatcherRequest.Marshal(b, m, deterministic)
+}
+func (m *SeekDispatcherRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SeekDispatcherRequest.Merge(m, src)
+}
+func (m *SeekDispatcherRequest) XXX_Size() int {
+	return xxx_messageInfo_SeekDispatcherRequest.Size(m)
+}
+func (m *SeekDispatcherRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SeekDispatcherRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SeekDispatcherRequest proto.InternalMessageInfo
+
+func (m *SeekDispatcherRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *SeekDispatcherRequest) GetVchannel() string {
+	if m != nil {
+		return m.Vchannel
+	}
+	return ""
+}
+
+func (m *SeekDispatcherRequest) GetPosition() *msgpb.MsgPosition {
+	if m != nil {
+		return m.Position
+	}
+	return nil
+}
+
 type Rate struct {
 	Rt                   RateType `protobuf:"varint,1,opt,name=rt,proto3,enum=milvus.proto.internal.RateType" json:"rt,omitempty"`
 	R                    float64  `protobuf:"fixed64,2,opt,name=r,proto3" json:"r,omitempty"`
@@ -1937,7 +2169,7 @@ func (m *Rate) Reset()         { *m = Rate{} }
 func (m *Rate) String() string { return proto.CompactTextString(m) }
 func (*Rate) ProtoMessage()    {}
 func (*Rate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{27}
+	return fileDescriptor_41f4a519b878ee3b, []int{31}
 }
 
 func (m *Rate) XXX_Unmarshal(b []byte) error {
@@ -2001,132 +2233,146 @@ func init() {
 	proto.RegisterType((*ListPolicyResponse)(nil), "milvus.proto.internal.ListPolicyResponse")
 	proto.RegisterType((*ShowConfigurationsRequest)(nil), "milvus.proto.internal.ShowConfigurationsRequest")
 	proto.RegisterType((*ShowConfigurationsResponse)(nil), "milvus.proto.internal.ShowConfigurationsResponse")
+	proto.RegisterType((*DispatcherPosition)(nil), "milvus.proto.internal.DispatcherPosition")
+	proto.RegisterType((*DumpDispatcherPositionsRequest)(nil), "milvus.proto.internal.DumpDispatcherPositionsRequest")
+	proto.RegisterType((*DumpDispatcherPositionsResponse)(nil), "milvus.proto.internal.DumpDispatcherPositionsResponse")
+	proto.RegisterType((*SeekDispatcherRequest)(nil), "milvus.proto.internal.SeekDispatcherRequest")
 	proto.RegisterType((*Rate)(nil), "milvus.proto.internal.Rate")
 }
 
 func init() { proto.RegisterFile("internal.proto", fileDescriptor_41f4a519b878ee3b) }
 
 var fileDescriptor_41f4a519b878ee3b = []byte{
-	// 1927 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x4b, 0x73, 0x1c, 0x49,
-	0x11, 0xa6, 0xe7, 0x3d, 0x39, 0x23, 0x69, 0x54, 0x96, 0x4d, 0xfb, 0xb1, 0x6b, 0x6d, 0x43, 0x80,
-	0x58, 0x62, 0xed, 0x45, 0x1b, 0xbb, 0xe6, 0x40, 0x40, 0xd8, 0x6a, 0xaf, 0x62, 0x62, 0xc7, 0x46,
-	0xee, 0x31, 0x1b, 0x01, 0x97, 0x8e, 0x9a, 0xe9, 0xd4, 0xa8, 0x70, 0xbf, 0x54, 0x55, 0x6d, 0x49,
-	0x3e, 0x73, 0x23, 0x82, 0x1b, 0x1c, 0x88, 0x80, 0x7f, 0xc0, 0x79, 0x83, 0x13, 0xff, 0x80, 0x13,
-	0xbf, 0x66, 0x4f, 0x44, 0x3d, 0x7a, 0x5e, 0x1a, 0x2b, 0x24, 0x99, 0xc7, 0xee, 0xad, 0x33, 0xf3,
-	0xab, 0xac, 0xaa, 0xcc, 0xac, 0xaf, 0xb2, 0x1a, 0xd6, 0x59, 0x2a, 0x91, 0xa7, 0x34, 0x7e, 0x90,
-	0xf3, 0x4c, 0x66, 0xe4, 0x66, 0xc2, 0xe2, 0xd7, 0x85, 0x30, 0xd2, 0x83, 0xd2, 0x78, 0xa7, 0x3b,
-	0xce, 0x92, 0x24, 0x4b, 0x8d, 0xfa, 0x4e, 0x57, 0x8c, 0x8f, 0x30, 0xa1, 0x46, 0xf2, 0xee, 0xc2,
-	0xed, 0x7d, 0x94, 0x2f, 0x59, 0x82, 0x2f, 0xd9, 0xf8, 0xd5, 0xde, 0x11, 0x4d, 0x53, 0x8c, 0x03,
-	0x3c, 0x2e, 0x50, 0x48, 0xef, 0x3d, 0xb8, 0xbb, 0x8f, 0x72, 0x28, 0xa9, 0x64, 0x42, 0xb2, 0xb1,
-	0x58, 0x32, 0xdf, 0x84, 0x1b, 0xfb, 0x28, 0xfd, 0x68, 0x49, 0xfd, 0x25, 0xb4, 0x9e, 0x67, 0x11,
-	0xf6, 0xd3, 0xc3, 0x8c, 0x7c, 0x06, 0x4d, 0x1a, 0x45, 0x1c, 0x85, 0x70, 0x9d, 0x6d, 0x67, 0xa7,
-	0xb3, 0x7b, 0xef, 0xc1, 0xc2, 0x1a, 0xed, 0xca, 0x1e, 0x1b, 0x4c, 0x50, 0x82, 0x09, 0x81, 0x1a,
-	0xcf, 0x62, 0x74, 0x2b, 0xdb, 0xce, 0x4e, 0x3b, 0xd0, 0xdf, 0xde, 0x6f, 0x01, 0xfa, 0x29, 0x93,
-	0x07, 0x94, 0xd3, 0x44, 0x90, 0x5b, 0xd0, 0x48, 0xd5, 0x2c, 0xbe, 0x76, 0x5c, 0x0d, 0xac, 0x44,
-	0x7c, 0xe8, 0x0a, 0x49, 0xb9, 0x0c, 0x73, 0x8d, 0x73, 0x2b, 0xdb, 0xd5, 0x9d, 0xce, 0xee, 0x07,
-	0x2b, 0xa7, 0xfd, 0x02, 0xcf, 0xbe, 0xa4, 0x71, 0x81, 0x07, 0x94, 0xf1, 0xa0, 0xa3, 0x87, 0x19,
-	0xef, 0xde, 0xaf, 0x01, 0x86, 0x92, 0xb3, 0x74, 0x32, 0x60, 0x42, 0xaa, 0xb9, 0x5e, 0x2b, 0x9c,
-	0xda, 0x44, 0x75, 0xa7, 0x1d, 0x58, 0x89, 0x7c, 0x02, 0x0d, 0x21, 0xa9, 0x2c, 0x84, 0x5e, 0x67,
-	0x67, 0xf7, 0xee, 0xca, 0x59, 0x86, 0x1a, 0x12, 0x58, 0xa8, 0xf7, 0xb7, 0x0a, 0x6c, 0x2d, 0x44,
-	0xd5, 0xc6, 0x8d, 0x7c, 0x0c, 0xb5, 0x11, 0x15, 0x78, 0x61, 0xa0, 0x9e, 0x89, 0xc9, 0x13, 0x2a,
-	0x30, 0xd0, 0x48, 0x15, 0xa5, 0x68, 0xd4, 0xf7, 0xf5, 0xec, 0xd5, 0x40, 0x7f, 0x13, 0x0f, 0xba,
-	0xe3, 0x2c, 0x8e, 0x71, 0x2c, 0x59, 0x96, 0xf6, 0x7d, 0xb7, 0xaa, 0x6d, 0x0b, 0x3a, 0x85, 0xc9,
-	0x29, 0x97, 0xcc, 0x88, 0xc2, 0xad, 0x6d, 0x57, 0x15, 0x66, 0x5e, 0x47, 0x7e, 0x04, 0x3d, 0xc9,
-	0xe9, 0x6b, 0x8c, 0x43, 0xc9, 0x12, 0x14, 0x92, 0x26, 0xb9, 0x5b, 0xdf, 0x76, 0x76, 0x6a, 0xc1,
-	0x86, 0xd1, 0xbf, 0x2c, 0xd5, 0xe4, 0x21, 0xdc, 0x98, 0x14, 0x94, 0xd3, 0x54, 0x22, 0xce, 0xa1,
-	0x1b, 0x1a, 0x4d, 0xa6, 0xa6, 0xd9, 0x80, 0x1f, 0xc3, 0xa6, 0x82, 0x65, 0x85, 0x9c, 0x83, 0x37,
-	0x35, 0xbc, 0x67, 0x0d, 0x53, 0xb0, 0xf7, 0x95, 0x03, 0x37, 0x97, 0xe2, 0x25, 0xf2, 0x2c, 0x15,
-	0x78, 0x8d, 0x80, 0x5d, 0x27, 0x61, 0xe4, 0x11, 0xd4, 0xd5, 0x97, 0x70, 0xab, 0x97, 0x2d, 0x25,
-	0x83, 0xf7, 0xfe, 0xea, 0x00, 0xd9, 0xe3, 0x48, 0x25, 0x3e, 0x8e, 0x19, 0x7d, 0x87, 0x3c, 0x7f,
-	0x17, 0x9a, 0xd1, 0x28, 0x4c, 0x69, 0x52, 0x1e, 0x88, 0x46, 0x34, 0x7a, 0x4e, 0x13, 0x24, 0x3f,
-	0x84, 0x8d, 0x59, 0x62, 0x0d, 0xa0, 0xaa, 0x01, 0xeb, 0x33, 0xb5, 0x06, 0x6e, 0x41, 0x9d, 0xaa,
-	0x35, 0xb8, 0x35, 0x6d, 0x36, 0x82, 0x27, 0xa0, 0xe7, 0xf3, 0x2c, 0xff, 0x6f, 0xad, 0x6e, 0x3a,
-	0x69, 0x75, 0x7e, 0xd2, 0xbf, 0x38, 0xb0, 0xf9, 0x38, 0x96, 0xc8, 0xbf, 0xa1, 0x41, 0xf9, 0x47,
-	0xa5, 0xcc, 0x5a, 0x3f, 0x8d, 0xf0, 0xf4, 0xff, 0xb9, 0xc0, 0xf7, 0x00, 0x0e, 0x19, 0xc6, 0x91,
-	0xc1, 0x98, 0x55, 0xb6, 0xb5, 0x46, 0x9b, 0xcb, 0xe3, 0x5f, 0xbf, 0xe0, 0xf8, 0x37, 0x56, 0x1c,
-	0x7f, 0x17, 0x9a, 0xda, 0x49, 0xdf, 0xd7, 0x87, 0xae, 0x1a, 0x94, 0xa2, 0x22, 0x4f, 0x3c, 0x95,
-	0x9c, 0x96, 0xe4, 0xd9, 0xba, 0x34, 0x79, 0xea, 0x61, 0x96, 0x3c, 0xff, 0x54, 0x87, 0xb5, 0x21,
-	0x52, 0x3e, 0x3e, 0xba, 0x7e, 0xf0, 0xb6, 0xa0, 0xce, 0xf1, 0x78, 0xca, 0x6d, 0x46, 0x98, 0xee,
-	0xb8, 0x7a, 0xc1, 0x8e, 0x6b, 0x97, 0x20, 0xbc, 0xfa, 0x0a, 0xc2, 0xeb, 0x41, 0x35, 0x12, 0xb1,
-	0x0e, 0x58, 0x3b, 0x50, 0x9f, 0x8a, 0xa6, 0xf2, 0x98, 0x8e, 0xf1, 0x28, 0x8b, 0x23, 0xe4, 0xe1,
-	0x84, 0x67, 0x85, 0xa1, 0xa9, 0x6e, 0xd0, 0x9b, 0x33, 0xec, 0x2b, 0x3d, 0x79, 0x04, 0xad, 0x48,
-	0xc4, 0xa1, 0x3c, 0xcb, 0xd1, 0x6d, 0x6d, 0x3b, 0x3b, 0xeb, 0x6f, 0xd9, 0xa6, 0x2f, 0xe2, 0x97,
-	0x67, 0x39, 0x06, 0xcd, 0xc8, 0x7c, 0x90, 0x8f, 0x61, 0x4b, 0x20, 0x67, 0x34, 0x66, 0x6f, 0x30,
-	0x0a, 0xf1, 0x34, 0xe7, 0x61, 0x1e, 0xd3, 0xd4, 0x6d, 0xeb, 0x89, 0xc8, 0xcc, 0xf6, 0xf4, 0x34,
-	0xe7, 0x07, 0x31, 0x4d, 0xc9, 0x0e, 0xf4, 0xb2, 0x42, 0xe6, 0x85, 0x0c, 0x75, 0xde, 0x44, 0xc8,
-	0x22, 0x17, 0xf4, 0x8e, 0xd6, 0x8d, 0xfe, 0x73, 0xad, 0xee, 0x47, 0x2b, 0x49, 0xbc, 0x73, 0x25,
-	0x12, 0xef, 0x5e, 0x8d, 0xc4, 0xd7, 0x56, 0x93, 0x38, 0x59, 0x87, 0x4a, 0x7a, 0xec, 0xae, 0xeb,
-	0xd4, 0x54, 0xd2, 0x63, 0x95, 0x48, 0x99, 0xe5, 0xaf, 0xdc, 0x0d, 0x93, 0x48, 0xf5, 0x4d, 0xde,
-	0x07, 0x48, 0x50, 0x72, 0x36, 0x56, 0x61, 0x71, 0x7b, 0x3a, 0x0f, 0x73, 0x1a, 0xf2, 0x7d, 0x58,
-	0x63, 0x93, 0x34, 0xe3, 0xb8, 0xcf, 0xb3, 0x13, 0x96, 0x4e, 0xdc, 0xcd, 0x6d, 0x67, 0xa7, 0x15,
-	0x2c, 0x2a, 0xc9, 0x1d, 0x68, 0x15, 0x42, 0xf5, 0x3d, 0x09, 0xba, 0x44, 0xfb, 0x98, 0xca, 0xde,
-	0x3f, 0x6b, 0xb3, 0xc2, 0x14, 0x45, 0x2c, 0xc5, 0xff, 0xea, 0x0a, 0x99, 0x56, 0x73, 0x75, 0xbe,
-	0x9a, 0xef, 0x43, 0xc7, 0x6c, 0xcf, 0x54, 0x4d, 0xed, 0xdc, 0x8e, 0xef, 0x43, 0x27, 0x2d, 0x92,
-	0xf0, 0xb8, 0x40, 0xce, 0x50, 0xd8, 0x73, 0x0e, 0x69, 0x91, 0xbc, 0x30, 0x1a, 0x72, 0x03, 0xea,
-	0x32, 0xcb, 0xc3, 0x57, 0xf6, 0x98, 0xab, 0x38, 0x7e, 0x41, 0x7e, 0x06, 0x77, 0x04, 0xd2, 0x18,
-	0xa3, 0x50, 0xe0, 0x24, 0xc1, 0x54, 0xf6, 0x7d, 0x11, 0x0a, 0xbd, 0x6d, 0x8c, 0xdc, 0xa6, 0x2e,
-	0x14, 0xd7, 0x20, 0x86, 0x53, 0xc0, 0xd0, 0xda, 0x55, 0x1d, 0x8c, 0x4d, 0x3f, 0xb7, 0x30, 0xac,
-	0xa5, 0x1b, 0x1f, 0x32, 0x33, 0x4d, 0x07, 0xfc, 0x14, 0xdc, 0x49, 0x9c, 0x8d, 0x68, 0x1c, 0x9e,
-	0x9b, 0xd5, 0x6d, 0xeb, 0xc9, 0x6e, 0x19, 0xfb, 0x70, 0x69, 0x4a, 0xb5, 0x3d, 0x11, 0xb3, 0x31,
-	0x46, 0xe1, 0x28, 0xce, 0x46, 0x2e, 0xe8, 0x82, 0x07, 0xa3, 0x7a, 0x12, 0x67, 0x23, 0x55, 0xe8,
-	0x16, 0xa0, 0xc2, 0x30, 0xce, 0x8a, 0x54, 0xea, 0xf2, 0xad, 0x06, 0xeb, 0x46, 0xff, 0xbc, 0x48,
-	0xf6, 0x94, 0x96, 0x7c, 0x0f, 0xd6, 0x2c, 0x32, 0x3b, 0x3c, 0x14, 0x28, 0x75, 0xdd, 0x56, 0x83,
-	0xae, 0x51, 0xfe, 0x52, 0xeb, 0xc8, 0x81, 0xe2, 0x5d, 0x21, 0x1f, 0x4f, 0x26, 0x1c, 0x27, 0x54,
-	0x9d, 0x7b, 0x5d, 0xaf, 0x9d, 0xdd, 0x1f, 0x3c, 0x58, 0xd9, 0x38, 0x3f, 0xd8, 0x5b, 0x44, 0x07,
-	0xcb, 0xc3, 0xbd, 0x63, 0xd8, 0x58, 0xc2, 0x28, 0xaa, 0xe1, 0xb6, 0x41, 0x51, 0xe5, 0x6f, 0xbb,
-	0xd3, 0x05, 0x1d, 0xd9, 0x86, 0x8e, 0x40, 0xfe, 0x9a, 0x8d, 0x0d, 0xc4, 0x50, 0xdc, 0xbc, 0x4a,
-	0x51, 0xb4, 0xcc, 0x24, 0x8d, 0x9f, 0xbf, 0xb0, 0x25, 0x53, 0x8a, 0xde, 0xbf, 0x6a, 0xb0, 0x11,
-	0xa8, 0x12, 0xc1, 0xd7, 0xf8, 0x6d, 0xa2, 0xd7, 0xb7, 0xd1, 0x5c, 0xe3, 0x4a, 0x34, 0xd7, 0xbc,
-	0x34, 0xcd, 0xb5, 0xae, 0x44, 0x73, 0xed, 0xab, 0xd1, 0x1c, 0xbc, 0x85, 0xe6, 0xb6, 0xa0, 0x1e,
-	0xb3, 0x84, 0x95, 0x55, 0x6a, 0x84, 0xf3, 0xc4, 0xd5, 0x5d, 0x45, 0x5c, 0xb7, 0xa1, 0xc5, 0x84,
-	0x2d, 0xf2, 0x35, 0x0d, 0x68, 0x32, 0x61, 0xaa, 0xfb, 0x29, 0xdc, 0x67, 0x12, 0xb9, 0x2e, 0xb0,
-	0x10, 0x4f, 0x25, 0xa6, 0x42, 0x7d, 0x71, 0x8c, 0x8a, 0x31, 0x86, 0x9c, 0x4a, 0xb4, 0xd4, 0x7a,
-	0x6f, 0x0a, 0x7b, 0x5a, 0xa2, 0x02, 0x0d, 0x0a, 0xa8, 0xc4, 0x05, 0x6a, 0xdc, 0x58, 0xa2, 0xc6,
-	0xaf, 0xab, 0xf3, 0x65, 0xf5, 0x0d, 0x20, 0xc7, 0x0f, 0xa1, 0xca, 0x22, 0xd3, 0x9a, 0x75, 0x76,
-	0xdd, 0x45, 0x3f, 0xf6, 0x05, 0xdb, 0xf7, 0x45, 0xa0, 0x40, 0xe4, 0x17, 0xd0, 0xb1, 0x25, 0x12,
-	0x51, 0x49, 0x75, 0xf9, 0x75, 0x76, 0xdf, 0x5f, 0x39, 0x46, 0xd7, 0x8c, 0x4f, 0x25, 0x0d, 0x4c,
-	0x6b, 0x25, 0xd4, 0x37, 0xf9, 0x39, 0xdc, 0x3d, 0x4f, 0x99, 0xdc, 0x86, 0x23, 0x72, 0x1b, 0xba,
-	0xea, 0x6e, 0x2f, 0x73, 0x66, 0x19, 0xaf, 0x88, 0xfc, 0x04, 0xb6, 0xe6, 0x48, 0x73, 0x36, 0xb0,
-	0xa9, 0x59, 0x73, 0x8e, 0x50, 0x67, 0x43, 0x2e, 0xa2, 0xcd, 0xd6, 0x85, 0xb4, 0xf9, 0x9f, 0xa7,
-	0xb1, 0xaf, 0x1d, 0x68, 0x0f, 0x32, 0x1a, 0xe9, 0x86, 0xf7, 0x1a, 0x69, 0xbf, 0x07, 0xed, 0xe9,
-	0xea, 0x2d, 0xa3, 0xcc, 0x14, 0xca, 0x3a, 0xed, 0x59, 0x6d, 0xa3, 0x3b, 0xd7, 0xc4, 0xce, 0x35,
-	0xa3, 0xb5, 0xc5, 0x66, 0xf4, 0x3e, 0x74, 0x98, 0x5a, 0x50, 0x98, 0x53, 0x79, 0x64, 0x48, 0xa5,
-	0x1d, 0x80, 0x56, 0x1d, 0x28, 0x8d, 0xea, 0x56, 0x4b, 0x80, 0xee, 0x56, 0x1b, 0x97, 0xee, 0x56,
-	0xad, 0x13, 0xdd, 0xad, 0xfe, 0xce, 0x01, 0xd0, 0x1b, 0x57, 0x65, 0x79, 0xde, 0xa9, 0x73, 0x1d,
-	0xa7, 0x8a, 0xed, 0xd4, 0x95, 0xc5, 0x31, 0xa6, 0x72, 0x96, 0x5b, 0x61, 0x83, 0x43, 0xd2, 0x22,
-	0x09, 0x8c, 0xc9, 0xe6, 0x55, 0x78, 0x7f, 0x70, 0x00, 0x74, 0x71, 0x9a, 0x65, 0x2c, 0xd3, 0xae,
-	0x73, 0x71, 0x1f, 0x5f, 0x59, 0x0c, 0xdd, 0x93, 0x32, 0x74, 0x17, 0x3c, 0x5c, 0xa7, 0xe5, 0x31,
-	0xdb, 0xbc, 0x8d, 0xae, 0xfe, 0xf6, 0xfe, 0xe8, 0x40, 0xd7, 0xae, 0xce, 0x2c, 0x69, 0x21, 0xcb,
-	0xce, 0x72, 0x96, 0x75, 0x33, 0x93, 0x64, 0xfc, 0x2c, 0x14, 0xec, 0x4d, 0x79, 0xa7, 0x81, 0x51,
-	0x0d, 0xd9, 0x1b, 0x54, 0xfc, 0xa6, 0x43, 0x92, 0x9d, 0x88, 0xf2, 0x4e, 0x53, 0x61, 0xc8, 0x4e,
-	0x84, 0xe2, 0x58, 0x8e, 0x63, 0x4c, 0x65, 0x7c, 0x16, 0x26, 0x59, 0xc4, 0x0e, 0x19, 0x46, 0xba,
-	0x1a, 0x5a, 0x41, 0xaf, 0x34, 0x3c, 0xb3, 0x7a, 0xef, 0x2b, 0xf5, 0xaa, 0x36, 0x07, 0xaa, 0xfc,
-	0x6d, 0xf5, 0x4c, 0x4c, 0xae, 0x51, 0xb5, 0x2a, 0xc4, 0xc6, 0x8f, 0x2a, 0x44, 0xf3, 0xa7, 0xa8,
-	0x1d, 0x2c, 0xe8, 0x54, 0x4f, 0x3a, 0x65, 0x7d, 0x13, 0xc7, 0x5a, 0x30, 0xa7, 0x51, 0x2b, 0x8f,
-	0xf0, 0x90, 0x16, 0xf1, 0xfc, 0xed, 0x50, 0x33, 0xb7, 0x83, 0x35, 0x2c, 0xfc, 0xc9, 0x58, 0xdf,
-	0xe3, 0x18, 0x61, 0x2a, 0x19, 0x8d, 0xf5, 0xff, 0xb1, 0x79, 0x4a, 0x76, 0x16, 0x29, 0x99, 0x7c,
-	0x04, 0x04, 0xd3, 0x31, 0x3f, 0xcb, 0x55, 0x05, 0xe5, 0x54, 0x88, 0x93, 0x8c, 0x47, 0xf6, 0x29,
-	0xb9, 0x39, 0xb5, 0x1c, 0x58, 0x03, 0xb9, 0x05, 0x0d, 0x89, 0x29, 0x4d, 0xa5, 0x3d, 0x63, 0x56,
-	0xb2, 0xf7, 0x8a, 0x28, 0x72, 0xe4, 0x36, 0xa6, 0x4d, 0x26, 0x86, 0x4a, 0x54, 0x0f, 0x51, 0x71,
-	0x44, 0x77, 0x3f, 0xfd, 0x6c, 0xe6, 0xbe, 0x6e, 0x1e, 0xa2, 0x46, 0x5d, 0xfa, 0xf6, 0x9e, 0xc2,
-	0xe6, 0x80, 0x09, 0x79, 0x90, 0xc5, 0x6c, 0x7c, 0x76, 0xed, 0xae, 0xc3, 0xfb, 0xbd, 0x03, 0x64,
-	0xde, 0x8f, 0xfd, 0x8f, 0x33, 0xbb, 0x35, 0x9c, 0xcb, 0xdf, 0x1a, 0x1f, 0x40, 0x37, 0xd7, 0x6e,
-	0x42, 0x96, 0x1e, 0x66, 0x65, 0xf6, 0x3a, 0x46, 0xa7, 0x62, 0x2b, 0xd4, 0xf3, 0x59, 0x05, 0x33,
-	0xe4, 0x59, 0x8c, 0x26, 0x79, 0xed, 0xa0, 0xad, 0x34, 0x81, 0x52, 0x78, 0x13, 0xb8, 0x3d, 0x3c,
-	0xca, 0x4e, 0xf6, 0xb2, 0xf4, 0x90, 0x4d, 0x0a, 0x73, 0x6d, 0xbe, 0xc3, 0xff, 0x08, 0x17, 0x9a,
-	0x39, 0x95, 0xea, 0x4c, 0xd9, 0x1c, 0x95, 0xa2, 0xf7, 0x67, 0x07, 0xee, 0xac, 0x9a, 0xe9, 0x5d,
-	0xb6, 0xbf, 0x0f, 0x6b, 0x63, 0xe3, 0xce, 0x78, 0xbb, 0xfc, 0x7f, 0xce, 0xc5, 0x71, 0xde, 0x53,
-	0xa8, 0xe9, 0xe6, 0xe0, 0x21, 0x54, 0xb8, 0xd4, 0x2b, 0x58, 0xdf, 0xbd, 0xff, 0x16, 0xa6, 0x50,
-	0x40, 0xfd, 0x78, 0xad, 0x70, 0x49, 0xba, 0xe0, 0x70, 0xbd, 0x53, 0x27, 0x70, 0xf8, 0x87, 0x7f,
-	0x77, 0xa0, 0x55, 0x9a, 0xc9, 0x26, 0xac, 0xf9, 0xfe, 0x60, 0x6f, 0xca, 0x55, 0xbd, 0xef, 0x90,
-	0x1e, 0x74, 0x7d, 0x7f, 0x70, 0x50, 0x76, 0x84, 0x3d, 0x87, 0x74, 0xa1, 0xe5, 0xfb, 0x03, 0x4d,
-	0x3e, 0xbd, 0x8a, 0x95, 0x3e, 0x8f, 0x0b, 0x71, 0xd4, 0xab, 0x4e, 0x1d, 0x24, 0x39, 0x35, 0x0e,
-	0x6a, 0x64, 0x0d, 0xda, 0xfe, 0xb3, 0x41, 0x3f, 0x15, 0xc8, 0x65, 0xaf, 0x6e, 0x45, 0x1f, 0x63,
-	0x94, 0xd8, 0x6b, 0x90, 0x0d, 0xe8, 0xf8, 0xcf, 0x06, 0x4f, 0x8a, 0xf8, 0x95, 0xba, 0xc7, 0x7a,
-	0x4d, 0x6d, 0x7f, 0x31, 0x30, 0x8f, 0x94, 0x5e, 0x4b, 0xbb, 0x7f, 0x31, 0x50, 0xcf, 0xa6, 0xb3,
-	0x5e, 0xdb, 0x0e, 0xfe, 0x55, 0xae, 0x7d, 0xc1, 0x93, 0x47, 0xbf, 0xf9, 0x74, 0xc2, 0xe4, 0x51,
-	0x31, 0x52, 0xf1, 0x7a, 0x68, 0xb6, 0xfe, 0x11, 0xcb, 0xec, 0xd7, 0xc3, 0x72, 0xfb, 0x0f, 0x75,
-	0x34, 0xa6, 0x62, 0x3e, 0x1a, 0x35, 0xb4, 0xe6, 0x93, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0xc1,
-	0x18, 0x48, 0x84, 0x88, 0x17, 0x00, 0x00,
+	// 2091 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x49, 0x6f, 0x1c, 0xc7,
+	0x15, 0x4e, 0xcf, 0x3e, 0x6f, 0x86, 0xe4, 0xb0, 0x4c, 0xd9, 0xad, 0x95, 0x74, 0x27, 0x48, 0x68,
+	0x07, 0x96, 0x1c, 0x1a, 0xb6, 0x12, 0x20, 0x48, 0x20, 0xb1, 0x65, 0x82, 0x30, 0xa9, 0x50, 0x3d,
+	0x8a, 0x81, 0xe4, 0xd2, 0xa8, 0xe9, 0x7e, 0x1c, 0x56, 0xd4, 0x1b, 0xab, 0xaa, 0x29, 0x51, 0xe7,
+	0xdc, 0x02, 0xe4, 0x96, 0x1c, 0x02, 0x64, 0xf9, 0x03, 0x39, 0x1b, 0x39, 0xe5, 0x1f, 0xe4, 0x87,
+	0xe4, 0x27, 0xf8, 0x14, 0xd4, 0xd2, 0xb3, 0x71, 0x01, 0x45, 0x65, 0x71, 0x6e, 0xf5, 0x96, 0x7a,
+	0x55, 0xf5, 0xde, 0x57, 0x5f, 0xbd, 0x6e, 0x58, 0x66, 0x99, 0x44, 0x9e, 0xd1, 0xe4, 0x7e, 0xc1,
+	0x73, 0x99, 0x93, 0x1b, 0x29, 0x4b, 0x4e, 0x4a, 0x61, 0xa4, 0xfb, 0x95, 0xf1, 0x56, 0x3f, 0xca,
+	0xd3, 0x34, 0xcf, 0x8c, 0xfa, 0x56, 0x5f, 0x44, 0x47, 0x98, 0x52, 0x2b, 0x75, 0x53, 0x31, 0x36,
+	0x43, 0xef, 0x36, 0xdc, 0xdc, 0x41, 0xf9, 0x9c, 0xa5, 0xf8, 0x9c, 0x45, 0x2f, 0xb6, 0x8f, 0x68,
+	0x96, 0x61, 0x12, 0xe0, 0x71, 0x89, 0x42, 0x7a, 0x77, 0xe1, 0xf6, 0x0e, 0xca, 0xa1, 0xa4, 0x92,
+	0x09, 0xc9, 0x22, 0xb1, 0x60, 0xbe, 0x01, 0xef, 0xec, 0xa0, 0xf4, 0xe3, 0x05, 0xf5, 0x97, 0xd0,
+	0x79, 0x9a, 0xc7, 0xb8, 0x9b, 0x1d, 0xe6, 0xe4, 0x33, 0x68, 0xd3, 0x38, 0xe6, 0x28, 0x84, 0xeb,
+	0x6c, 0x38, 0x9b, 0xbd, 0xad, 0x3b, 0xf7, 0xe7, 0xb6, 0x6b, 0x37, 0xf9, 0xc8, 0xf8, 0x04, 0x95,
+	0x33, 0x21, 0xd0, 0xe0, 0x79, 0x82, 0x6e, 0x6d, 0xc3, 0xd9, 0xec, 0x06, 0x7a, 0xec, 0xfd, 0x0a,
+	0x60, 0x37, 0x63, 0xf2, 0x80, 0x72, 0x9a, 0x0a, 0xf2, 0x2e, 0xb4, 0x32, 0xb5, 0x8a, 0xaf, 0x03,
+	0xd7, 0x03, 0x2b, 0x11, 0x1f, 0xfa, 0x42, 0x52, 0x2e, 0xc3, 0x42, 0xfb, 0xb9, 0xb5, 0x8d, 0xfa,
+	0x66, 0x6f, 0xeb, 0xfd, 0x73, 0x97, 0xfd, 0x02, 0x4f, 0xbf, 0xa4, 0x49, 0x89, 0x07, 0x94, 0xf1,
+	0xa0, 0xa7, 0xa7, 0x99, 0xe8, 0xde, 0x2f, 0x00, 0x86, 0x92, 0xb3, 0x6c, 0xbc, 0xc7, 0x84, 0x54,
+	0x6b, 0x9d, 0x28, 0x3f, 0x75, 0x88, 0xfa, 0x66, 0x37, 0xb0, 0x12, 0xf9, 0x04, 0x5a, 0x42, 0x52,
+	0x59, 0x0a, 0xbd, 0xcf, 0xde, 0xd6, 0xed, 0x73, 0x57, 0x19, 0x6a, 0x97, 0xc0, 0xba, 0x7a, 0x7f,
+	0xad, 0xc1, 0xda, 0x5c, 0x56, 0x6d, 0xde, 0xc8, 0xc7, 0xd0, 0x18, 0x51, 0x81, 0x97, 0x26, 0x6a,
+	0x5f, 0x8c, 0x1f, 0x53, 0x81, 0x81, 0xf6, 0x54, 0x59, 0x8a, 0x47, 0xbb, 0xbe, 0x5e, 0xbd, 0x1e,
+	0xe8, 0x31, 0xf1, 0xa0, 0x1f, 0xe5, 0x49, 0x82, 0x91, 0x64, 0x79, 0xb6, 0xeb, 0xbb, 0x75, 0x6d,
+	0x9b, 0xd3, 0x29, 0x9f, 0x82, 0x72, 0xc9, 0x8c, 0x28, 0xdc, 0xc6, 0x46, 0x5d, 0xf9, 0xcc, 0xea,
+	0xc8, 0x07, 0x30, 0x90, 0x9c, 0x9e, 0x60, 0x12, 0x4a, 0x96, 0xa2, 0x90, 0x34, 0x2d, 0xdc, 0xe6,
+	0x86, 0xb3, 0xd9, 0x08, 0x56, 0x8c, 0xfe, 0x79, 0xa5, 0x26, 0x0f, 0xe0, 0x9d, 0x71, 0x49, 0x39,
+	0xcd, 0x24, 0xe2, 0x8c, 0x77, 0x4b, 0x7b, 0x93, 0x89, 0x69, 0x3a, 0xe1, 0xfb, 0xb0, 0xaa, 0xdc,
+	0xf2, 0x52, 0xce, 0xb8, 0xb7, 0xb5, 0xfb, 0xc0, 0x1a, 0x26, 0xce, 0xde, 0x57, 0x0e, 0xdc, 0x58,
+	0xc8, 0x97, 0x28, 0xf2, 0x4c, 0xe0, 0x35, 0x12, 0x76, 0x9d, 0x82, 0x91, 0x87, 0xd0, 0x54, 0x23,
+	0xe1, 0xd6, 0xaf, 0x0a, 0x25, 0xe3, 0xef, 0xfd, 0xc9, 0x01, 0xb2, 0xcd, 0x91, 0x4a, 0x7c, 0x94,
+	0x30, 0xfa, 0x16, 0x75, 0x7e, 0x0f, 0xda, 0xf1, 0x28, 0xcc, 0x68, 0x5a, 0x5d, 0x88, 0x56, 0x3c,
+	0x7a, 0x4a, 0x53, 0x24, 0xdf, 0x83, 0x95, 0x69, 0x61, 0x8d, 0x43, 0x5d, 0x3b, 0x2c, 0x4f, 0xd5,
+	0xda, 0x71, 0x0d, 0x9a, 0x54, 0xed, 0xc1, 0x6d, 0x68, 0xb3, 0x11, 0x3c, 0x01, 0x03, 0x9f, 0xe7,
+	0xc5, 0x7f, 0x6a, 0x77, 0x93, 0x45, 0xeb, 0xb3, 0x8b, 0xfe, 0xd1, 0x81, 0xd5, 0x47, 0x89, 0x44,
+	0xfe, 0x0d, 0x4d, 0xca, 0xdf, 0x6b, 0x55, 0xd5, 0x76, 0xb3, 0x18, 0x5f, 0xfd, 0x2f, 0x37, 0x78,
+	0x17, 0xe0, 0x90, 0x61, 0x12, 0x1b, 0x1f, 0xb3, 0xcb, 0xae, 0xd6, 0x68, 0x73, 0x75, 0xfd, 0x9b,
+	0x97, 0x5c, 0xff, 0xd6, 0x39, 0xd7, 0xdf, 0x85, 0xb6, 0x0e, 0xb2, 0xeb, 0xeb, 0x4b, 0x57, 0x0f,
+	0x2a, 0x51, 0x91, 0x27, 0xbe, 0x92, 0x9c, 0x56, 0xe4, 0xd9, 0xb9, 0x32, 0x79, 0xea, 0x69, 0x96,
+	0x3c, 0x7f, 0xdf, 0x84, 0xa5, 0x21, 0x52, 0x1e, 0x1d, 0x5d, 0x3f, 0x79, 0x6b, 0xd0, 0xe4, 0x78,
+	0x3c, 0xe1, 0x36, 0x23, 0x4c, 0x4e, 0x5c, 0xbf, 0xe4, 0xc4, 0x8d, 0x2b, 0x10, 0x5e, 0xf3, 0x1c,
+	0xc2, 0x1b, 0x40, 0x3d, 0x16, 0x89, 0x4e, 0x58, 0x37, 0x50, 0x43, 0x45, 0x53, 0x45, 0x42, 0x23,
+	0x3c, 0xca, 0x93, 0x18, 0x79, 0x38, 0xe6, 0x79, 0x69, 0x68, 0xaa, 0x1f, 0x0c, 0x66, 0x0c, 0x3b,
+	0x4a, 0x4f, 0x1e, 0x42, 0x27, 0x16, 0x49, 0x28, 0x4f, 0x0b, 0x74, 0x3b, 0x1b, 0xce, 0xe6, 0xf2,
+	0x05, 0xc7, 0xf4, 0x45, 0xf2, 0xfc, 0xb4, 0xc0, 0xa0, 0x1d, 0x9b, 0x01, 0xf9, 0x18, 0xd6, 0x04,
+	0x72, 0x46, 0x13, 0xf6, 0x1a, 0xe3, 0x10, 0x5f, 0x15, 0x3c, 0x2c, 0x12, 0x9a, 0xb9, 0x5d, 0xbd,
+	0x10, 0x99, 0xda, 0x9e, 0xbc, 0x2a, 0xf8, 0x41, 0x42, 0x33, 0xb2, 0x09, 0x83, 0xbc, 0x94, 0x45,
+	0x29, 0x43, 0x5d, 0x37, 0x11, 0xb2, 0xd8, 0x05, 0x7d, 0xa2, 0x65, 0xa3, 0xff, 0x5c, 0xab, 0x77,
+	0xe3, 0x73, 0x49, 0xbc, 0xf7, 0x46, 0x24, 0xde, 0x7f, 0x33, 0x12, 0x5f, 0x3a, 0x9f, 0xc4, 0xc9,
+	0x32, 0xd4, 0xb2, 0x63, 0x77, 0x59, 0x97, 0xa6, 0x96, 0x1d, 0xab, 0x42, 0xca, 0xbc, 0x78, 0xe1,
+	0xae, 0x98, 0x42, 0xaa, 0x31, 0xb9, 0x07, 0x90, 0xa2, 0xe4, 0x2c, 0x52, 0x69, 0x71, 0x07, 0xba,
+	0x0e, 0x33, 0x1a, 0xf2, 0x1d, 0x58, 0x62, 0xe3, 0x2c, 0xe7, 0xb8, 0xc3, 0xf3, 0x97, 0x2c, 0x1b,
+	0xbb, 0xab, 0x1b, 0xce, 0x66, 0x27, 0x98, 0x57, 0x92, 0x5b, 0xd0, 0x29, 0x85, 0x6a, 0x81, 0x52,
+	0x74, 0x89, 0x8e, 0x31, 0x91, 0xbd, 0x7f, 0x34, 0xa6, 0xc0, 0x14, 0x65, 0x22, 0xc5, 0x7f, 0xeb,
+	0x09, 0x99, 0xa0, 0xb9, 0x3e, 0x8b, 0xe6, 0x75, 0xe8, 0x99, 0xe3, 0x19, 0xd4, 0x34, 0xce, 0x9c,
+	0x78, 0x1d, 0x7a, 0x59, 0x99, 0x86, 0xc7, 0x25, 0x72, 0x86, 0xc2, 0xde, 0x73, 0xc8, 0xca, 0xf4,
+	0x99, 0xd1, 0x90, 0x77, 0xa0, 0x29, 0xf3, 0x22, 0x7c, 0x61, 0xaf, 0xb9, 0xca, 0xe3, 0x17, 0xe4,
+	0xc7, 0x70, 0x4b, 0x20, 0x4d, 0x30, 0x0e, 0x05, 0x8e, 0x53, 0xcc, 0xe4, 0xae, 0x2f, 0x42, 0xa1,
+	0x8f, 0x8d, 0xb1, 0xdb, 0xd6, 0x40, 0x71, 0x8d, 0xc7, 0x70, 0xe2, 0x30, 0xb4, 0x76, 0x85, 0x83,
+	0xc8, 0xf4, 0x73, 0x73, 0xd3, 0x3a, 0xba, 0xf1, 0x21, 0x53, 0xd3, 0x64, 0xc2, 0x0f, 0xc1, 0x1d,
+	0x27, 0xf9, 0x88, 0x26, 0xe1, 0x99, 0x55, 0xdd, 0xae, 0x5e, 0xec, 0x5d, 0x63, 0x1f, 0x2e, 0x2c,
+	0xa9, 0x8e, 0x27, 0x12, 0x16, 0x61, 0x1c, 0x8e, 0x92, 0x7c, 0xe4, 0x82, 0x06, 0x3c, 0x18, 0xd5,
+	0xe3, 0x24, 0x1f, 0x29, 0xa0, 0x5b, 0x07, 0x95, 0x86, 0x28, 0x2f, 0x33, 0xa9, 0xe1, 0x5b, 0x0f,
+	0x96, 0x8d, 0xfe, 0x69, 0x99, 0x6e, 0x2b, 0x2d, 0xf9, 0x36, 0x2c, 0x59, 0xcf, 0xfc, 0xf0, 0x50,
+	0xa0, 0xd4, 0xb8, 0xad, 0x07, 0x7d, 0xa3, 0xfc, 0x99, 0xd6, 0x91, 0x03, 0xc5, 0xbb, 0x42, 0x3e,
+	0x1a, 0x8f, 0x39, 0x8e, 0xa9, 0xba, 0xf7, 0x1a, 0xaf, 0xbd, 0xad, 0xef, 0xde, 0x3f, 0xb7, 0x87,
+	0xbe, 0xbf, 0x3d, 0xef, 0x1d, 0x2c, 0x4e, 0xf7, 0x8e, 0x61, 0x65, 0xc1, 0x47, 0x51, 0x0d, 0xb7,
+	0x0d, 0x8a, 0x82, 0xbf, 0xed, 0x4e, 0xe7, 0x74, 0x64, 0x03, 0x7a, 0x02, 0xf9, 0x09, 0x8b, 0x8c,
+	0x8b, 0xa1, 0xb8, 0x59, 0x95, 0xa2, 0x68, 0x99, 0x4b, 0x9a, 0x3c, 0x7d, 0x66, 0x21, 0x53, 0x89,
+	0xde, 0x3f, 0x1b, 0xb0, 0x12, 0x28, 0x88, 0xe0, 0x09, 0xfe, 0x3f, 0xd1, 0xeb, 0x45, 0x34, 0xd7,
+	0x7a, 0x23, 0x9a, 0x6b, 0x5f, 0x99, 0xe6, 0x3a, 0x6f, 0x44, 0x73, 0xdd, 0x37, 0xa3, 0x39, 0xb8,
+	0x80, 0xe6, 0xd6, 0xa0, 0x99, 0xb0, 0x94, 0x55, 0x28, 0x35, 0xc2, 0x59, 0xe2, 0xea, 0x9f, 0x47,
+	0x5c, 0x37, 0xa1, 0xc3, 0x84, 0x05, 0xf9, 0x92, 0x76, 0x68, 0x33, 0x61, 0xd0, 0xfd, 0x04, 0xd6,
+	0x99, 0x44, 0xae, 0x01, 0x16, 0xe2, 0x2b, 0x89, 0x99, 0x50, 0x23, 0x8e, 0x71, 0x19, 0x61, 0xc8,
+	0xa9, 0x44, 0x4b, 0xad, 0x77, 0x26, 0x6e, 0x4f, 0x2a, 0xaf, 0x40, 0x3b, 0x05, 0x54, 0xe2, 0x1c,
+	0x35, 0xae, 0xcc, 0x53, 0xa3, 0xda, 0x79, 0x8c, 0x71, 0x59, 0x68, 0xde, 0xed, 0x04, 0x46, 0xf0,
+	0xbe, 0xae, 0xcf, 0x82, 0xed, 0x1b, 0x40, 0x99, 0x1f, 0x42, 0x9d, 0xc5, 0xa6, 0x61, 0xeb, 0x6d,
+	0xb9, 0xf3, 0x71, 0xec, 0x27, 0xee, 0xae, 0x2f, 0x02, 0xe5, 0x44, 0x7e, 0x0a, 0x3d, 0x0b, 0x9c,
+	0x98, 0x4a, 0xaa, 0x41, 0xd9, 0xdb, 0xba, 0x77, 0xee, 0x1c, 0x8d, 0x24, 0x9f, 0x4a, 0x1a, 0x98,
+	0x86, 0x4b, 0xa8, 0x31, 0xf9, 0x09, 0xdc, 0x3e, 0x4b, 0xa4, 0xdc, 0xa6, 0x23, 0x76, 0x5b, 0x1a,
+	0x8b, 0x37, 0x17, 0x99, 0xb4, 0xca, 0x57, 0x4c, 0x7e, 0x00, 0x6b, 0x33, 0x54, 0x3a, 0x9d, 0xd8,
+	0xd6, 0x5c, 0x3a, 0x43, 0xb3, 0xd3, 0x29, 0x97, 0x91, 0x69, 0xe7, 0x52, 0x32, 0xfd, 0xf7, 0x93,
+	0xdb, 0xd7, 0x0e, 0x74, 0xf7, 0x72, 0x1a, 0xeb, 0x36, 0xf8, 0x1a, 0x65, 0xbf, 0x03, 0xdd, 0xc9,
+	0xee, 0x2d, 0xcf, 0x4c, 0x15, 0xca, 0x3a, 0xe9, 0x64, 0x6d, 0xfb, 0x3b, 0xd3, 0xda, 0xce, 0xb4,
+	0xa8, 0x8d, 0xf9, 0x16, 0x75, 0x1d, 0x7a, 0x4c, 0x6d, 0x28, 0x2c, 0xa8, 0x3c, 0x32, 0x54, 0xd3,
+	0x0d, 0x40, 0xab, 0x0e, 0x94, 0x46, 0xf5, 0xb0, 0x95, 0x83, 0xee, 0x61, 0x5b, 0x57, 0xee, 0x61,
+	0x6d, 0x10, 0xdd, 0xc3, 0xfe, 0xda, 0x01, 0xd0, 0x07, 0x57, 0xb0, 0x3c, 0x1b, 0xd4, 0xb9, 0x4e,
+	0x50, 0xc5, 0x81, 0xea, 0x21, 0xe3, 0x98, 0x50, 0x39, 0xad, 0xad, 0xb0, 0xc9, 0x21, 0x59, 0x99,
+	0x06, 0xc6, 0x64, 0xeb, 0x2a, 0xbc, 0xdf, 0x3a, 0x00, 0x1a, 0x9c, 0x66, 0x1b, 0x8b, 0x64, 0xec,
+	0x5c, 0xde, 0xdd, 0xd7, 0xe6, 0x53, 0xf7, 0xb8, 0x4a, 0xdd, 0x25, 0x9f, 0xb3, 0x13, 0x78, 0x4c,
+	0x0f, 0x6f, 0xb3, 0xab, 0xc7, 0xde, 0xef, 0x1c, 0xe8, 0xdb, 0xdd, 0x99, 0x2d, 0xcd, 0x55, 0xd9,
+	0x59, 0xac, 0xb2, 0x6e, 0x71, 0xd2, 0x9c, 0x9f, 0x86, 0x82, 0xbd, 0xae, 0x5e, 0x3a, 0x30, 0xaa,
+	0x21, 0x7b, 0x8d, 0x8a, 0xf5, 0x74, 0x4a, 0xf2, 0x97, 0xa2, 0x7a, 0xe9, 0x54, 0x1a, 0xf2, 0x97,
+	0x42, 0x31, 0x2f, 0xc7, 0x08, 0x33, 0x99, 0x9c, 0x86, 0x69, 0x1e, 0xb3, 0x43, 0x86, 0xb1, 0x46,
+	0x43, 0x27, 0x18, 0x54, 0x86, 0x7d, 0xab, 0xf7, 0xbe, 0x52, 0xdf, 0xda, 0xe6, 0x42, 0x55, 0x3f,
+	0xb3, 0xf6, 0xc5, 0xf8, 0x1a, 0xa8, 0x55, 0x29, 0x36, 0x71, 0x14, 0x10, 0xcd, 0xff, 0xa3, 0x6e,
+	0x30, 0xa7, 0x53, 0x9d, 0xea, 0xe4, 0x2d, 0x30, 0x79, 0x6c, 0x04, 0x33, 0x1a, 0xb5, 0xf3, 0x18,
+	0x0f, 0x69, 0x99, 0xcc, 0xbe, 0x19, 0x0d, 0xf3, 0x66, 0x58, 0xc3, 0xdc, 0xff, 0x8d, 0xe5, 0x6d,
+	0x8e, 0x31, 0x66, 0x92, 0xd1, 0x44, 0xff, 0x35, 0x9b, 0x25, 0x6a, 0x67, 0x81, 0xa8, 0x3f, 0x02,
+	0x82, 0x59, 0xc4, 0x4f, 0x0b, 0x85, 0xa0, 0x82, 0x0a, 0xf1, 0x32, 0xe7, 0xb1, 0xfd, 0xc0, 0x5c,
+	0x9d, 0x58, 0x0e, 0xac, 0x81, 0xbc, 0x0b, 0x2d, 0x89, 0x19, 0xcd, 0xa4, 0xbd, 0x63, 0x56, 0xb2,
+	0xaf, 0x8d, 0x28, 0x0b, 0xe4, 0x36, 0xa7, 0x6d, 0x26, 0x86, 0x4a, 0x54, 0x9f, 0xa7, 0xe2, 0x88,
+	0x6e, 0x7d, 0xfa, 0xd9, 0x34, 0x7c, 0xd3, 0x7c, 0x9e, 0x1a, 0x75, 0x15, 0xdb, 0x7b, 0x02, 0xab,
+	0x7b, 0x4c, 0xc8, 0x83, 0x3c, 0x61, 0xd1, 0xe9, 0xb5, 0x7b, 0x11, 0xef, 0x37, 0x0e, 0x90, 0xd9,
+	0x38, 0xf6, 0xef, 0xce, 0xf4, 0xd5, 0x70, 0xae, 0xfe, 0x6a, 0xbc, 0x0f, 0xfd, 0x42, 0x87, 0x09,
+	0x59, 0x76, 0x98, 0x57, 0xd5, 0xeb, 0x19, 0x9d, 0xca, 0xad, 0x50, 0x1f, 0xd5, 0x2a, 0x99, 0x21,
+	0xcf, 0x13, 0x34, 0xc5, 0xeb, 0x06, 0x5d, 0xa5, 0x09, 0x94, 0xc2, 0x1b, 0xc3, 0xcd, 0xe1, 0x51,
+	0xfe, 0x72, 0x3b, 0xcf, 0x0e, 0xd9, 0xb8, 0x34, 0x8f, 0xe9, 0x5b, 0xfc, 0xa5, 0x70, 0xa1, 0x5d,
+	0x50, 0xa9, 0xee, 0x94, 0xad, 0x51, 0x25, 0x7a, 0x7f, 0x70, 0xe0, 0xd6, 0x79, 0x2b, 0xbd, 0xcd,
+	0xf1, 0x77, 0x60, 0x29, 0x32, 0xe1, 0x4c, 0xb4, 0xab, 0xff, 0xfd, 0x9c, 0x9f, 0xa7, 0xaf, 0x93,
+	0xcf, 0x44, 0x41, 0x65, 0x74, 0x84, 0xfc, 0x20, 0x17, 0xba, 0x8f, 0x53, 0xc0, 0x3c, 0xb1, 0x37,
+	0xa1, 0x02, 0x66, 0x25, 0x2b, 0x5b, 0x51, 0xd9, 0xcc, 0x51, 0x27, 0x32, 0xf9, 0x11, 0x74, 0x0a,
+	0x1b, 0x43, 0xe3, 0xb0, 0xb7, 0x75, 0x77, 0x7e, 0x4b, 0xa9, 0x18, 0xab, 0xc4, 0x55, 0x0b, 0x05,
+	0x13, 0x77, 0xf2, 0x1e, 0xb4, 0x99, 0x08, 0x53, 0xca, 0x32, 0x8b, 0xd3, 0x16, 0x13, 0xfb, 0x94,
+	0x65, 0xe4, 0x06, 0xb4, 0xa2, 0x92, 0x87, 0x52, 0xd8, 0xdf, 0x92, 0xcd, 0xa8, 0xe4, 0xcf, 0x85,
+	0x57, 0xc0, 0x3d, 0xbf, 0x4c, 0x8b, 0xb3, 0x9b, 0x7f, 0x8b, 0x22, 0xde, 0x81, 0x6e, 0x75, 0xcc,
+	0x0a, 0x52, 0x53, 0x85, 0xf7, 0x67, 0x07, 0xd6, 0x2f, 0x5c, 0xf2, 0xed, 0xaa, 0xd9, 0xad, 0xd2,
+	0x50, 0x55, 0xf2, 0x83, 0x0b, 0xd8, 0xfa, 0xec, 0xda, 0xc1, 0x74, 0xae, 0xf7, 0x17, 0x07, 0x6e,
+	0x0c, 0x11, 0x5f, 0x4c, 0xbd, 0xae, 0x9f, 0x8b, 0x59, 0x08, 0xd4, 0x16, 0x20, 0x70, 0xfd, 0x32,
+	0x7b, 0x4f, 0xa0, 0xa1, 0x7b, 0xd4, 0x07, 0x50, 0xe3, 0x52, 0x6f, 0x67, 0x79, 0x6b, 0xfd, 0x82,
+	0xc3, 0x2a, 0x47, 0xfd, 0x0f, 0xa5, 0xc6, 0x25, 0xe9, 0x83, 0xc3, 0xf5, 0x46, 0x9c, 0xc0, 0xe1,
+	0x1f, 0xfe, 0xcd, 0x81, 0x4e, 0x65, 0x26, 0xab, 0xb0, 0xe4, 0xfb, 0x7b, 0xdb, 0x93, 0xc7, 0x71,
+	0xf0, 0x2d, 0x32, 0x80, 0xbe, 0xef, 0xef, 0x1d, 0x54, 0x1f, 0x26, 0x03, 0x87, 0xf4, 0xa1, 0xe3,
+	0xfb, 0x7b, 0xfa, 0xb5, 0x1b, 0xd4, 0xac, 0xf4, 0x79, 0x52, 0x8a, 0xa3, 0x41, 0x7d, 0x12, 0x20,
+	0x2d, 0xa8, 0x09, 0xd0, 0x20, 0x4b, 0xd0, 0xf5, 0xf7, 0xf7, 0x76, 0x33, 0x81, 0x5c, 0x0e, 0x9a,
+	0x56, 0xf4, 0x31, 0x41, 0x89, 0x83, 0x16, 0x59, 0x81, 0x9e, 0xbf, 0xbf, 0xf7, 0xb8, 0x4c, 0x5e,
+	0xa8, 0xc6, 0x69, 0xd0, 0xd6, 0xf6, 0x67, 0x7b, 0xe6, 0x5b, 0x79, 0xd0, 0xd1, 0xe1, 0x9f, 0xed,
+	0xa9, 0xaf, 0xf7, 0xd3, 0x41, 0xd7, 0x4e, 0xfe, 0x79, 0xa1, 0x63, 0xc1, 0xe3, 0x87, 0xbf, 0xfc,
+	0x74, 0xcc, 0xe4, 0x51, 0x39, 0x52, 0x89, 0x7f, 0x60, 0x8e, 0xfe, 0x11, 0xcb, 0xed, 0xe8, 0x41,
+	0x75, 0xfc, 0x07, 0x3a, 0x1b, 0x13, 0xb1, 0x18, 0x8d, 0x5a, 0x5a, 0xf3, 0xc9, 0xbf, 0x02, 0x00,
+	0x00, 0xff, 0xff, 0xcc, 0x95, 0x26, 0x8e, 0x1a, 0x1a, 0x00, 0x00,
 }
@@ -27,17 +27,91 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+// MetaCacheChangeType is the kind of the meta change which invalidates the collection meta cache.
+type MetaCacheChangeType int32
+
+const (
+	MetaCacheChangeType_SchemaChange      MetaCacheChangeType = 0
+	MetaCacheChangeType_AliasChange       MetaCacheChangeType = 1
+	MetaCacheChangeType_PartitionChange   MetaCacheChangeType = 2
+	MetaCacheChangeType_CollectionDropped MetaCacheChangeType = 3
+)
+
+var MetaCacheChangeType_name = map[int32]string{
+	0: "SchemaChange",
+	1: "AliasChange",
+	2: "PartitionChange",
+	3: "CollectionDropped",
+}
+
+var MetaCacheChangeType_value = map[string]int32{
+	"SchemaChange":      0,
+	"AliasChange":       1,
+	"PartitionChange":   2,
+	"CollectionDropped": 3,
+}
+
+func (x MetaCacheChangeType) String() string {
+	return proto.EnumName(MetaCacheChangeType_name, int32(x))
+}
+
+func (MetaCacheChangeType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{0}
+}
+
+// IndexEvaluationState is the progress of an index evaluation.
+type IndexEvaluationState int32
+
+const (
+	IndexEvaluationState_IndexEvaluationPending   IndexEvaluationState = 0
+	IndexEvaluationState_IndexEvaluationBuilding  IndexEvaluationState = 1
+	IndexEvaluationState_IndexEvaluationLoading   IndexEvaluationState = 2
+	IndexEvaluationState_IndexEvaluationReplaying IndexEvaluationState = 3
+	IndexEvaluationState_IndexEvaluationCompleted IndexEvaluationState = 4
+	IndexEvaluationState_IndexEvaluationFailed    IndexEvaluationState = 5
+)
+
+var IndexEvaluationState_name = map[int32]string{
+	0: "IndexEvaluationPending",
+	1: "IndexEvaluationBuilding",
+	2: "IndexEvaluationLoading",
+	3: "IndexEvaluationReplaying",
+	4: "IndexEvaluationCompleted",
+	5: "IndexEvaluationFailed",
+}
+
+var IndexEvaluationState_value = map[string]int32{
+	"IndexEvaluationPending":   0,
+	"IndexEvaluationBuilding":  1,
+	"IndexEvaluationLoading":   2,
+	"IndexEvaluationReplaying": 3,
+	"IndexEvaluationCompleted": 4,
+	"IndexEvaluationFailed":    5,
+}
+
+func (x IndexEvaluationState) String() string {
+	return proto.EnumName(IndexEvaluationState_name, int32(x))
+}
+
+func (IndexEvaluationState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{1}
+}
+
 type InvalidateCollMetaCacheRequest struct {
 	// MsgType:
-	//  DropCollection    ->  {meta cache, dml channels}
-	//  Other             ->  {meta cache}
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	CollectionID         int64             `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	//
+	//	DropCollection    ->  {meta cache, dml channels}
+	//	Other             ->  {meta cache}
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	CollectionID   int64             `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// revision of the change, which is the ts of the DDL and increases with the changes
+	Revision             uint64              `protobuf:"varint,5,opt,name=revision,proto3" json:"revision,omitempty"`
+	ChangeType           MetaCacheChangeType `protobuf:"varint,6,opt,name=change_type,json=changeType,proto3,enum=milvus.proto.proxy.MetaCacheChangeType" json:"change_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *InvalidateCollMetaCacheRequest) Reset()         { *m = InvalidateCollMetaCacheRequest{} }
@@ -93,6 +167,20 @@ func (m *InvalidateCollMetaCacheRequest) GetCollectionID() int64 {
 	return 0
 }
 
+func (m *InvalidateCollMetaCacheRequest) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *InvalidateCollMetaCacheRequest) GetChangeType() MetaCacheChangeType {
+	if m != nil {
+		return m.ChangeType
+	}
+	return MetaCacheChangeType_SchemaChange
+}
+
 type InvalidateCredCacheRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Username             string            `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
@@ -447,7 +535,447 @@ func (m *ListClientInfosResponse) GetClientInfos() []*commonpb.ClientInfo {
 	return nil
 }
 
+// EvaluateIndexRequest evaluates a candidate index of the vector field against the current one,
+// the sampled segments are cloned and indexed aside, the source collection is never touched.
+type EvaluateIndexRequest struct {
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	FieldName      string            `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	// the params of the candidate index, the metric type of the current index is used if not set
+	IndexParams []*commonpb.KeyValuePair `protobuf:"bytes,5,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
+	// the search params overridden when the captured queries are replayed on the candidate index
+	SearchParams []*commonpb.KeyValuePair `protobuf:"bytes,6,rep,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
+	// the number of flushed segments sampled, all the flushed segments if not positive
+	SampleSegments int64 `protobuf:"varint,7,opt,name=sample_segments,json=sampleSegments,proto3" json:"sample_segments,omitempty"`
+	// the max number of captured queries replayed, all the captured queries if not positive
+	MaxQueries int64 `protobuf:"varint,8,opt,name=max_queries,json=maxQueries,proto3" json:"max_queries,omitempty"`
+	// the resource group the evaluation collections are loaded into, which shall be made of idle nodes
+	ResourceGroup        string   `protobuf:"bytes,9,opt,name=resource_group,json=resourceGroup,proto3" json:"resource_group,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvaluateIndexRequest) Reset()         { *m = EvaluateIndexRequest{} }
+func (m *EvaluateIndexRequest) String() string { return proto.CompactTextString(m) }
+func (*EvaluateIndexRequest) ProtoMessage()    {}
+func (*EvaluateIndexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{8}
+}
+
+func (m *EvaluateIndexRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvaluateIndexRequest.Unmarshal(m, b)
+}
+func (m *EvaluateIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvaluateIndexRequest.Marshal(b, m, deterministic)
+}
+func (m *EvaluateIndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvaluateIndexRequest.Merge(m, src)
+}
+func (m *EvaluateIndexRequest) XXX_Size() int {
+	return xxx_messageInfo_EvaluateIndexRequest.Size(m)
+}
+func (m *EvaluateIndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvaluateIndexRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvaluateIndexRequest proto.InternalMessageInfo
+
+func (m *EvaluateIndexRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *EvaluateIndexRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *EvaluateIndexRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *EvaluateIndexRequest) GetFieldName() string {
+	if m != nil {
+		return m.FieldName
+	}
+	return ""
+}
+
+func (m *EvaluateIndexRequest) GetIndexParams() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.IndexParams
+	}
+	return nil
+}
+
+func (m *EvaluateIndexRequest) GetSearchParams() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.SearchParams
+	}
+	return nil
+}
+
+func (m *EvaluateIndexRequest) GetSampleSegments() int64 {
+	if m != nil {
+		return m.SampleSegments
+	}
+	return 0
+}
+
+func (m *EvaluateIndexRequest) GetMaxQueries() int64 {
+	if m != nil {
+		return m.MaxQueries
+	}
+	return 0
+}
+
+func (m *EvaluateIndexRequest) GetResourceGroup() string {
+	if m != nil {
+		return m.ResourceGroup
+	}
+	return ""
+}
+
+type EvaluateIndexResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	EvaluationID         int64            `protobuf:"varint,2,opt,name=evaluationID,proto3" json:"evaluationID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *EvaluateIndexResponse) Reset()         { *m = EvaluateIndexResponse{} }
+func (m *EvaluateIndexResponse) String() string { return proto.CompactTextString(m) }
+func (*EvaluateIndexResponse) ProtoMessage()    {}
+func (*EvaluateIndexResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{9}
+}
+
+func (m *EvaluateIndexResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvaluateIndexResponse.Unmarshal(m, b)
+}
+func (m *EvaluateIndexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvaluateIndexResponse.Marshal(b, m, deterministic)
+}
+func (m *EvaluateIndexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvaluateIndexResponse.Merge(m, src)
+}
+func (m *EvaluateIndexResponse) XXX_Size() int {
+	return xxx_messageInfo_EvaluateIndexResponse.Size(m)
+}
+func (m *EvaluateIndexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvaluateIndexResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvaluateIndexResponse proto.InternalMessageInfo
+
+func (m *EvaluateIndexResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *EvaluateIndexResponse) GetEvaluationID() int64 {
+	if m != nil {
+		return m.EvaluationID
+	}
+	return 0
+}
+
+type GetIndexEvaluationRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	EvaluationID         int64             `protobuf:"varint,2,opt,name=evaluationID,proto3" json:"evaluationID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetIndexEvaluationRequest) Reset()         { *m = GetIndexEvaluationRequest{} }
+func (m *GetIndexEvaluationRequest) String() string { return proto.CompactTextString(m) }
+func (*GetIndexEvaluationRequest) ProtoMessage()    {}
+func (*GetIndexEvaluationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{10}
+}
+
+func (m *GetIndexEvaluationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetIndexEvaluationRequest.Unmarshal(m, b)
+}
+func (m *GetIndexEvaluationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetIndexEvaluationRequest.Marshal(b, m, deterministic)
+}
+func (m *GetIndexEvaluationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetIndexEvaluationRequest.Merge(m, src)
+}
+func (m *GetIndexEvaluationRequest) XXX_Size() int {
+	return xxx_messageInfo_GetIndexEvaluationRequest.Size(m)
+}
+func (m *GetIndexEvaluationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetIndexEvaluationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetIndexEvaluationRequest proto.InternalMessageInfo
+
+func (m *GetIndexEvaluationRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetIndexEvaluationRequest) GetEvaluationID() int64 {
+	if m != nil {
+		return m.EvaluationID
+	}
+	return 0
+}
+
+type IndexEvaluationMetrics struct {
+	// the average recall against the brute force search
+	Recall               float64  `protobuf:"fixed64,1,opt,name=recall,proto3" json:"recall,omitempty"`
+	AvgLatencyMs         float64  `protobuf:"fixed64,2,opt,name=avg_latency_ms,json=avgLatencyMs,proto3" json:"avg_latency_ms,omitempty"`
+	P99LatencyMs         float64  `protobuf:"fixed64,3,opt,name=p99_latency_ms,json=p99LatencyMs,proto3" json:"p99_latency_ms,omitempty"`
+	FailedQueries        int64    `protobuf:"varint,4,opt,name=failed_queries,json=failedQueries,proto3" json:"failed_queries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IndexEvaluationMetrics) Reset()         { *m = IndexEvaluationMetrics{} }
+func (m *IndexEvaluationMetrics) String() string { return proto.CompactTextString(m) }
+func (*IndexEvaluationMetrics) ProtoMessage()    {}
+func (*IndexEvaluationMetrics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{11}
+}
+
+func (m *IndexEvaluationMetrics) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndexEvaluationMetrics.Unmarshal(m, b)
+}
+func (m *IndexEvaluationMetrics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndexEvaluationMetrics.Marshal(b, m, deterministic)
+}
+func (m *IndexEvaluationMetrics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexEvaluationMetrics.Merge(m, src)
+}
+func (m *IndexEvaluationMetrics) XXX_Size() int {
+	return xxx_messageInfo_IndexEvaluationMetrics.Size(m)
+}
+func (m *IndexEvaluationMetrics) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexEvaluationMetrics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexEvaluationMetrics proto.InternalMessageInfo
+
+func (m *IndexEvaluationMetrics) GetRecall() float64 {
+	if m != nil {
+		return m.Recall
+	}
+	return 0
+}
+
+func (m *IndexEvaluationMetrics) GetAvgLatencyMs() float64 {
+	if m != nil {
+		return m.AvgLatencyMs
+	}
+	return 0
+}
+
+func (m *IndexEvaluationMetrics) GetP99LatencyMs() float64 {
+	if m != nil {
+		return m.P99LatencyMs
+	}
+	return 0
+}
+
+func (m *IndexEvaluationMetrics) GetFailedQueries() int64 {
+	if m != nil {
+		return m.FailedQueries
+	}
+	return 0
+}
+
+type GetIndexEvaluationResponse struct {
+	Status            *commonpb.Status     `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	State             IndexEvaluationState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.proxy.IndexEvaluationState" json:"state,omitempty"`
+	FailReason        string               `protobuf:"bytes,3,opt,name=fail_reason,json=failReason,proto3" json:"fail_reason,omitempty"`
+	SampledSegmentIDs []int64              `protobuf:"varint,4,rep,packed,name=sampled_segmentIDs,json=sampledSegmentIDs,proto3" json:"sampled_segmentIDs,omitempty"`
+	SampledRows       int64                `protobuf:"varint,5,opt,name=sampled_rows,json=sampledRows,proto3" json:"sampled_rows,omitempty"`
+	ReplayedQueries   int64                `protobuf:"varint,6,opt,name=replayed_queries,json=replayedQueries,proto3" json:"replayed_queries,omitempty"`
+	// the metrics of the current index
+	Baseline *IndexEvaluationMetrics `protobuf:"bytes,7,opt,name=baseline,proto3" json:"baseline,omitempty"`
+	// the metrics of the candidate index
+	Candidate            *IndexEvaluationMetrics `protobuf:"bytes,8,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *GetIndexEvaluationResponse) Reset()         { *m = GetIndexEvaluationResponse{} }
+func (m *GetIndexEvaluationResponse) String() string { return proto.CompactTextString(m) }
+func (*GetIndexEvaluationResponse) ProtoMessage()    {}
+func (*GetIndexEvaluationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{12}
+}
+
+func (m *GetIndexEvaluationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetIndexEvaluationResponse.Unmarshal(m, b)
+}
+func (m *GetIndexEvaluationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetIndexEvaluationResponse.Marshal(b, m, deterministic)
+}
+func (m *GetIndexEvaluationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetIndexEvaluationResponse.Merge(m, src)
+}
+func (m *GetIndexEvaluationResponse) XXX_Size() int {
+	return xxx_messageInfo_GetIndexEvaluationResponse.Size(m)
+}
+func (m *GetIndexEvaluationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetIndexEvaluationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetIndexEvaluationResponse proto.InternalMessageInfo
+
+func (m *GetIndexEvaluationResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetIndexEvaluationResponse) GetState() IndexEvaluationState {
+	if m != nil {
+		return m.State
+	}
+	return IndexEvaluationState_IndexEvaluationPending
+}
+
+func (m *GetIndexEvaluationResponse) GetFailReason() string {
+	if m != nil {
+		return m.FailReason
+	}
+	return ""
+}
+
+func (m *GetIndexEvaluationResponse) GetSampledSegmentIDs() []int64 {
+	if m != nil {
+		return m.SampledSegmentIDs
+	}
+	return nil
+}
+
+func (m *GetIndexEvaluationResponse) GetSampledRows() int64 {
+	if m != nil {
+		return m.SampledRows
+	}
+	return 0
+}
+
+func (m *GetIndexEvaluationResponse) GetReplayedQueries() int64 {
+	if m != nil {
+		return m.ReplayedQueries
+	}
+	return 0
+}
+
+func (m *GetIndexEvaluationResponse) GetBaseline() *IndexEvaluationMetrics {
+	if m != nil {
+		return m.Baseline
+	}
+	return nil
+}
+
+func (m *GetIndexEvaluationResponse) GetCandidate() *IndexEvaluationMetrics {
+	if m != nil {
+		return m.Candidate
+	}
+	return nil
+}
+
+// AlterIndexRequest alters the index params which only matter when building the index,
+// the segment indexes are rebuilt in background and loaded once rebuilt.
+type AlterIndexRequest struct {
+	Base                 *commonpb.MsgBase        `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string                   `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string                   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	IndexName            string                   `protobuf:"bytes,4,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	Params               []*commonpb.KeyValuePair `protobuf:"bytes,5,rep,name=params,proto3" json:"params,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *AlterIndexRequest) Reset()         { *m = AlterIndexRequest{} }
+func (m *AlterIndexRequest) String() string { return proto.CompactTextString(m) }
+func (*AlterIndexRequest) ProtoMessage()    {}
+func (*AlterIndexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{13}
+}
+
+func (m *AlterIndexRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AlterIndexRequest.Unmarshal(m, b)
+}
+func (m *AlterIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AlterIndexRequest.Marshal(b, m, deterministic)
+}
+func (m *AlterIndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterIndexRequest.Merge(m, src)
+}
+func (m *AlterIndexRequest) XXX_Size() int {
+	return xxx_messageInfo_AlterIndexRequest.Size(m)
+}
+func (m *AlterIndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterIndexRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterIndexRequest proto.InternalMessageInfo
+
+func (m *AlterIndexRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *AlterIndexRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *AlterIndexRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *AlterIndexRequest) GetIndexName() string {
+	if m != nil {
+		return m.IndexName
+	}
+	return ""
+}
+
+func (m *AlterIndexRequest) GetParams() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("milvus.proto.proxy.MetaCacheChangeType", MetaCacheChangeType_name, MetaCacheChangeType_value)
+	proto.RegisterEnum("milvus.proto.proxy.IndexEvaluationState", IndexEvaluationState_name, IndexEvaluationState_value)
 	proto.RegisterType((*InvalidateCollMetaCacheRequest)(nil), "milvus.proto.proxy.InvalidateCollMetaCacheRequest")
 	proto.RegisterType((*InvalidateCredCacheRequest)(nil), "milvus.proto.proxy.InvalidateCredCacheRequest")
 	proto.RegisterType((*UpdateCredCacheRequest)(nil), "milvus.proto.proxy.UpdateCredCacheRequest")
@@ -456,58 +984,102 @@ func init() {
 	proto.RegisterType((*SetRatesRequest)(nil), "milvus.proto.proxy.SetRatesRequest")
 	proto.RegisterType((*ListClientInfosRequest)(nil), "milvus.proto.proxy.ListClientInfosRequest")
 	proto.RegisterType((*ListClientInfosResponse)(nil), "milvus.proto.proxy.ListClientInfosResponse")
+	proto.RegisterType((*EvaluateIndexRequest)(nil), "milvus.proto.proxy.EvaluateIndexRequest")
+	proto.RegisterType((*EvaluateIndexResponse)(nil), "milvus.proto.proxy.EvaluateIndexResponse")
+	proto.RegisterType((*GetIndexEvaluationRequest)(nil), "milvus.proto.proxy.GetIndexEvaluationRequest")
+	proto.RegisterType((*IndexEvaluationMetrics)(nil), "milvus.proto.proxy.IndexEvaluationMetrics")
+	proto.RegisterType((*GetIndexEvaluationResponse)(nil), "milvus.proto.proxy.GetIndexEvaluationResponse")
+	proto.RegisterType((*AlterIndexRequest)(nil), "milvus.proto.proxy.AlterIndexRequest")
 }
 
 func init() { proto.RegisterFile("proxy.proto", fileDescriptor_700b50b08ed8dbaf) }
 
 var fileDescriptor_700b50b08ed8dbaf = []byte{
-	// 735 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xdd, 0x4e, 0x1a, 0x5b,
-	0x14, 0x76, 0x44, 0x38, 0x9e, 0x05, 0x81, 0x64, 0xc7, 0x83, 0x1c, 0x3c, 0x2a, 0x19, 0x4f, 0x2a,
-	0xb1, 0x29, 0x54, 0x34, 0x69, 0xaf, 0xc5, 0x86, 0xd8, 0x56, 0x63, 0x87, 0xf6, 0xa6, 0x37, 0x66,
-	0x33, 0xb3, 0x94, 0x6d, 0x86, 0xbd, 0xc7, 0xd9, 0x1b, 0x5b, 0x2e, 0x9a, 0x26, 0x7d, 0x84, 0xbe,
-	0x40, 0x5f, 0xa3, 0xcf, 0xd1, 0x27, 0x6a, 0xe6, 0x87, 0x81, 0xc1, 0xad, 0xb4, 0x9a, 0xde, 0xb1,
-	0xf6, 0x7c, 0x6b, 0x7d, 0xdf, 0xfa, 0xe1, 0x83, 0xbc, 0xe7, 0x8b, 0x8f, 0xa3, 0x86, 0xe7, 0x0b,
-	0x25, 0x08, 0x19, 0x30, 0xf7, 0x7a, 0x28, 0xa3, 0xa8, 0x11, 0x7e, 0xa9, 0x16, 0x6c, 0x31, 0x18,
-	0x08, 0x1e, 0xbd, 0x55, 0x8b, 0x8c, 0x2b, 0xf4, 0x39, 0x75, 0xe3, 0xb8, 0x30, 0x9d, 0x61, 0x7e,
-	0x37, 0x60, 0xe3, 0x88, 0x5f, 0x53, 0x97, 0x39, 0x54, 0x61, 0x5b, 0xb8, 0xee, 0x31, 0x2a, 0xda,
-	0xa6, 0x76, 0x1f, 0x2d, 0xbc, 0x1a, 0xa2, 0x54, 0xe4, 0x29, 0x2c, 0xf5, 0xa8, 0xc4, 0x8a, 0x51,
-	0x33, 0xea, 0xf9, 0xd6, 0x7f, 0x8d, 0x14, 0x63, 0x4c, 0x75, 0x2c, 0x2f, 0x0e, 0xa8, 0x44, 0x2b,
-	0x44, 0x92, 0x55, 0xf8, 0xcb, 0xe9, 0x9d, 0x71, 0x3a, 0xc0, 0xca, 0x62, 0xcd, 0xa8, 0xff, 0x6d,
-	0xe5, 0x9c, 0xde, 0x09, 0x1d, 0x20, 0xd9, 0x86, 0x92, 0x2d, 0x5c, 0x17, 0x6d, 0xc5, 0x04, 0x8f,
-	0x00, 0x99, 0x10, 0x50, 0x9c, 0x3c, 0x87, 0x40, 0x13, 0x0a, 0x93, 0x97, 0xa3, 0xc3, 0xca, 0x52,
-	0xcd, 0xa8, 0x67, 0xac, 0xd4, 0x9b, 0x79, 0x09, 0xd5, 0x29, 0xe5, 0x3e, 0x3a, 0x0f, 0x54, 0x5d,
-	0x85, 0xe5, 0xa1, 0x0c, 0x26, 0x95, 0xc8, 0x4e, 0x62, 0xf3, 0x8b, 0x01, 0xe5, 0x77, 0xde, 0x9f,
-	0x27, 0x0a, 0xbe, 0x79, 0x54, 0xca, 0x0f, 0xc2, 0x77, 0xe2, 0xd1, 0x24, 0xb1, 0xf9, 0x19, 0xd6,
-	0x2d, 0x3c, 0xf7, 0x51, 0xf6, 0x4f, 0x85, 0xcb, 0xec, 0xd1, 0x11, 0x3f, 0x17, 0x0f, 0x94, 0x52,
-	0x86, 0x9c, 0xf0, 0xde, 0x8e, 0xbc, 0x48, 0x48, 0xd6, 0x8a, 0x23, 0xb2, 0x02, 0x59, 0xe1, 0xbd,
-	0xc2, 0x51, 0xac, 0x21, 0x0a, 0xcc, 0x1f, 0x06, 0x14, 0xdb, 0xc9, 0x0a, 0x2c, 0xaa, 0x90, 0x6c,
-	0x00, 0x4c, 0x96, 0x12, 0x12, 0x67, 0xac, 0xa9, 0x17, 0xb2, 0x0b, 0x59, 0x9f, 0x2a, 0x94, 0x95,
-	0xc5, 0x5a, 0xa6, 0x9e, 0x6f, 0xad, 0xa5, 0x35, 0x25, 0xa7, 0x19, 0xd4, 0xb2, 0x22, 0x24, 0x79,
-	0x06, 0x39, 0xa9, 0xc2, 0x9c, 0x4c, 0x2d, 0x53, 0x2f, 0xb6, 0x36, 0xd3, 0x39, 0x71, 0xf0, 0x66,
-	0x28, 0x14, 0xed, 0x06, 0x38, 0x2b, 0x86, 0x93, 0x7d, 0xc8, 0xda, 0xc2, 0x41, 0x59, 0x59, 0x0a,
-	0xf3, 0x36, 0xb4, 0xfd, 0xbf, 0xf0, 0x7d, 0xe1, 0xb7, 0x85, 0x83, 0x56, 0x04, 0x36, 0x3f, 0x41,
-	0xa9, 0x8b, 0x2a, 0x10, 0x20, 0xef, 0x3f, 0xc7, 0xe7, 0xe9, 0x36, 0xcd, 0xc6, 0xcd, 0xbf, 0x65,
-	0x23, 0x3d, 0xb9, 0xb8, 0x5b, 0xf3, 0x25, 0x94, 0x5f, 0x33, 0xa9, 0xda, 0x2e, 0x43, 0xae, 0x82,
-	0x8d, 0xde, 0x5f, 0x85, 0xf9, 0xd5, 0x80, 0xd5, 0x1b, 0xc5, 0xa4, 0x27, 0xb8, 0x44, 0xb2, 0x17,
-	0x4d, 0x75, 0x28, 0xe3, 0x7a, 0x6b, 0xda, 0x7a, 0xdd, 0x10, 0x62, 0xc5, 0x50, 0x72, 0x00, 0x05,
-	0x3b, 0xac, 0x75, 0xc6, 0x82, 0x62, 0x71, 0x77, 0x9b, 0xda, 0xd4, 0x09, 0xa9, 0x95, 0xb7, 0x27,
-	0x02, 0x5a, 0xdf, 0x96, 0x21, 0x7b, 0x1a, 0x0c, 0x80, 0xb8, 0x40, 0x3a, 0xa8, 0xda, 0x62, 0xe0,
-	0x09, 0x8e, 0x5c, 0x75, 0xa3, 0xad, 0x35, 0xb4, 0xeb, 0xbd, 0x09, 0x8c, 0xc7, 0x52, 0xfd, 0x5f,
-	0x8b, 0x9f, 0x01, 0x9b, 0x0b, 0xe4, 0x0a, 0x56, 0x3a, 0x18, 0x86, 0x4c, 0x2a, 0x66, 0xcb, 0x76,
-	0x9f, 0x72, 0x8e, 0x2e, 0x69, 0xdd, 0x72, 0x82, 0x3a, 0xf0, 0x98, 0x73, 0x4b, 0xcb, 0xd9, 0x55,
-	0x3e, 0xe3, 0x17, 0xe3, 0x09, 0x9b, 0x0b, 0xc4, 0x87, 0xf5, 0xb4, 0x97, 0x46, 0xeb, 0x4e, 0x1c,
-	0x75, 0x96, 0x3b, 0xba, 0x8b, 0xbb, 0xed, 0xb7, 0x7a, 0xd7, 0xa2, 0xcc, 0x05, 0x42, 0xa1, 0xd0,
-	0x41, 0x75, 0xe8, 0x8c, 0xdb, 0xdb, 0xb9, 0xbd, 0xbd, 0x04, 0xf4, 0x9b, 0x6d, 0x5d, 0xc2, 0xbf,
-	0x69, 0xa3, 0x45, 0xae, 0x18, 0x75, 0xa3, 0x96, 0x1a, 0x73, 0x5a, 0x9a, 0xb1, 0xcb, 0x79, 0xed,
-	0xf4, 0xe0, 0x9f, 0x89, 0xcf, 0x4e, 0xf3, 0xec, 0xe8, 0x78, 0xf4, 0x96, 0x3c, 0x8f, 0xe3, 0x12,
-	0xca, 0x7a, 0x1f, 0x25, 0xbb, 0x3a, 0x92, 0x3b, 0x3d, 0x77, 0x1e, 0x97, 0x03, 0xa5, 0x0e, 0xaa,
-	0xf0, 0xfe, 0x8f, 0x51, 0xf9, 0xcc, 0x96, 0xe4, 0xd1, 0x6d, 0x07, 0x1f, 0x03, 0xc6, 0x95, 0xb7,
-	0xe7, 0xe2, 0x92, 0x0d, 0x9d, 0xc0, 0xf2, 0xd8, 0xc3, 0xc8, 0x96, 0xae, 0x87, 0x19, 0x87, 0x9b,
-	0xa7, 0xda, 0x85, 0xd2, 0x8c, 0x8f, 0xe8, 0xe7, 0xaf, 0x77, 0xae, 0xea, 0xe3, 0x5f, 0xc2, 0x8e,
-	0xd5, 0x1f, 0xec, 0xbf, 0x6f, 0x5d, 0x30, 0xd5, 0x1f, 0xf6, 0x02, 0x1d, 0xcd, 0x28, 0xf5, 0x09,
-	0x13, 0xf1, 0xaf, 0xe6, 0xf8, 0x84, 0x9b, 0x61, 0xb5, 0x66, 0x58, 0xcd, 0xeb, 0xf5, 0x72, 0x61,
-	0xb8, 0xf7, 0x33, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x7a, 0xad, 0xdf, 0x0f, 0x09, 0x00, 0x00,
+	// 1339 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcd, 0x72, 0x13, 0xc7,
+	0x16, 0xf6, 0x58, 0x96, 0xb0, 0x8f, 0x84, 0x64, 0x1a, 0xdb, 0x08, 0xf1, 0x67, 0x06, 0xb8, 0xd6,
+	0xf5, 0x2d, 0xe4, 0x8b, 0xa0, 0x2a, 0xf1, 0x26, 0x55, 0x58, 0x06, 0xe3, 0x80, 0x29, 0x33, 0x4a,
+	0xb2, 0xc8, 0x46, 0xd5, 0x9a, 0x39, 0x96, 0x9b, 0x1a, 0x4d, 0x8f, 0xbb, 0x5b, 0xc6, 0x5a, 0xa4,
+	0x52, 0x95, 0x47, 0xc8, 0x03, 0x24, 0x8b, 0x6c, 0xf2, 0x1a, 0xc9, 0x23, 0xe4, 0x0d, 0xf2, 0x26,
+	0xa9, 0xe9, 0x9e, 0x19, 0xfd, 0x78, 0xc0, 0x06, 0x57, 0x2a, 0xd9, 0x4d, 0x7f, 0xe7, 0x3b, 0x7f,
+	0x7d, 0x4e, 0x9f, 0xe9, 0x86, 0x62, 0x28, 0xf8, 0xc9, 0xb0, 0x11, 0x0a, 0xae, 0x38, 0x21, 0x7d,
+	0xe6, 0x1f, 0x0f, 0xa4, 0x59, 0x35, 0xb4, 0xa4, 0x56, 0x72, 0x79, 0xbf, 0xcf, 0x03, 0x83, 0xd5,
+	0xca, 0x2c, 0x50, 0x28, 0x02, 0xea, 0xc7, 0xeb, 0xd2, 0xb8, 0x86, 0xfd, 0xd3, 0x2c, 0xdc, 0xde,
+	0x0d, 0x8e, 0xa9, 0xcf, 0x3c, 0xaa, 0xb0, 0xc5, 0x7d, 0x7f, 0x0f, 0x15, 0x6d, 0x51, 0xf7, 0x10,
+	0x1d, 0x3c, 0x1a, 0xa0, 0x54, 0xe4, 0xff, 0x30, 0xd7, 0xa5, 0x12, 0xab, 0xd6, 0xaa, 0x55, 0x2f,
+	0x36, 0x6f, 0x36, 0x26, 0x3c, 0xc6, 0xae, 0xf6, 0x64, 0x6f, 0x8b, 0x4a, 0x74, 0x34, 0x93, 0x5c,
+	0x83, 0x4b, 0x5e, 0xb7, 0x13, 0xd0, 0x3e, 0x56, 0x67, 0x57, 0xad, 0xfa, 0x82, 0x53, 0xf0, 0xba,
+	0xaf, 0x69, 0x1f, 0xc9, 0x1a, 0x54, 0x5c, 0xee, 0xfb, 0xe8, 0x2a, 0xc6, 0x03, 0x43, 0xc8, 0x69,
+	0x42, 0x79, 0x04, 0x6b, 0xa2, 0x0d, 0xa5, 0x11, 0xb2, 0xbb, 0x5d, 0x9d, 0x5b, 0xb5, 0xea, 0x39,
+	0x67, 0x02, 0x23, 0x35, 0x98, 0x17, 0x78, 0xcc, 0x24, 0xe3, 0x41, 0x35, 0xbf, 0x6a, 0xd5, 0xe7,
+	0x9c, 0x74, 0x4d, 0x5e, 0x40, 0xd1, 0x3d, 0xa4, 0x41, 0x0f, 0x3b, 0x6a, 0x18, 0x62, 0xb5, 0xb0,
+	0x6a, 0xd5, 0xcb, 0xcd, 0xb5, 0xc6, 0xe9, 0xcd, 0x6a, 0xa4, 0xe9, 0xb6, 0x34, 0xff, 0xab, 0x61,
+	0x88, 0x0e, 0xb8, 0xe9, 0xb7, 0xfd, 0x16, 0x6a, 0x63, 0xfb, 0x23, 0xd0, 0xbb, 0xe0, 0xde, 0xd4,
+	0x60, 0x7e, 0x20, 0xa3, 0x7a, 0xa4, 0x9b, 0x93, 0xae, 0xed, 0x1f, 0x2c, 0x58, 0xf9, 0x3a, 0xfc,
+	0xfb, 0x1d, 0x45, 0xb2, 0x90, 0x4a, 0xf9, 0x8e, 0x0b, 0x2f, 0x2e, 0x40, 0xba, 0xb6, 0xbf, 0x87,
+	0x5b, 0x0e, 0x1e, 0x08, 0x94, 0x87, 0xfb, 0xdc, 0x67, 0xee, 0x70, 0x37, 0x38, 0xe0, 0x17, 0x0c,
+	0x65, 0x05, 0x0a, 0x3c, 0x8c, 0x76, 0x53, 0x07, 0x92, 0x77, 0xe2, 0x15, 0x59, 0x82, 0x3c, 0x0f,
+	0x5f, 0xe2, 0x30, 0x8e, 0xc1, 0x2c, 0xec, 0x3f, 0x2c, 0x28, 0xb7, 0xd2, 0x42, 0x3b, 0x54, 0x21,
+	0xb9, 0x0d, 0x30, 0x2a, 0xbd, 0x76, 0x9c, 0x73, 0xc6, 0x10, 0xf2, 0x08, 0xf2, 0x82, 0x2a, 0x94,
+	0xd5, 0xd9, 0xd5, 0x5c, 0xbd, 0xd8, 0xbc, 0x31, 0x19, 0x53, 0x7a, 0x00, 0x22, 0x5b, 0x8e, 0x61,
+	0x92, 0xcf, 0xa0, 0x20, 0x95, 0xd6, 0xc9, 0xad, 0xe6, 0xea, 0xe5, 0xe6, 0x9d, 0x49, 0x9d, 0x78,
+	0xf1, 0x66, 0xc0, 0x15, 0x6d, 0x47, 0x3c, 0x27, 0xa6, 0x93, 0x27, 0x90, 0x77, 0xb9, 0x87, 0xb2,
+	0x3a, 0xa7, 0xf5, 0x6e, 0x67, 0xe6, 0xff, 0x4c, 0x08, 0x2e, 0x5a, 0xdc, 0x43, 0xc7, 0x90, 0xed,
+	0xef, 0xa0, 0xd2, 0x46, 0x15, 0x05, 0x20, 0x3f, 0x7d, 0x1f, 0x3f, 0x9f, 0x4c, 0xd3, 0xce, 0xea,
+	0xe7, 0xc9, 0x9d, 0x8b, 0xb3, 0xb5, 0xbf, 0x84, 0x95, 0x57, 0x4c, 0xaa, 0x96, 0xcf, 0x30, 0x50,
+	0x51, 0x45, 0x3f, 0x3d, 0x0a, 0xfb, 0x47, 0x0b, 0xae, 0x9d, 0x32, 0x26, 0x43, 0x1e, 0x48, 0x24,
+	0x8f, 0xcd, 0xae, 0x0e, 0x64, 0x6c, 0xef, 0x46, 0xa6, 0xbd, 0xb6, 0xa6, 0x38, 0x31, 0x95, 0x6c,
+	0x41, 0xc9, 0xd5, 0xb6, 0x3a, 0x2c, 0x32, 0x16, 0x67, 0x77, 0x27, 0x53, 0x75, 0xe4, 0xd4, 0x29,
+	0xba, 0xa3, 0x00, 0xec, 0x5f, 0x73, 0xb0, 0xf4, 0xec, 0x98, 0xfa, 0x03, 0xaa, 0x70, 0x37, 0xf0,
+	0xf0, 0xe4, 0x9f, 0x9c, 0x5e, 0xb7, 0x00, 0x0e, 0x18, 0xfa, 0x9e, 0xe1, 0xcc, 0x69, 0xce, 0x82,
+	0x46, 0xb4, 0x78, 0x1b, 0x4a, 0x2c, 0x0a, 0xb1, 0x13, 0x52, 0x41, 0xfb, 0xb2, 0x9a, 0xd7, 0xf9,
+	0xde, 0xcd, 0x0c, 0xed, 0x25, 0x0e, 0xbf, 0xa1, 0xfe, 0x00, 0xf7, 0x29, 0x13, 0x4e, 0x51, 0xab,
+	0xed, 0x6b, 0x2d, 0xf2, 0x1c, 0x2e, 0x4b, 0xa4, 0xc2, 0x3d, 0x4c, 0xcc, 0x14, 0xce, 0x6b, 0xa6,
+	0x64, 0xf4, 0x62, 0x3b, 0x6b, 0x50, 0x91, 0xb4, 0x1f, 0xfa, 0xd8, 0x91, 0xd8, 0xeb, 0x63, 0xa0,
+	0x64, 0xf5, 0x92, 0x3e, 0x60, 0x65, 0x03, 0xb7, 0x63, 0x94, 0xdc, 0x81, 0x62, 0x9f, 0x9e, 0x74,
+	0x8e, 0x06, 0x28, 0x18, 0xca, 0xea, 0xbc, 0x39, 0x85, 0x7d, 0x7a, 0xf2, 0xc6, 0x20, 0xe4, 0x01,
+	0x94, 0x05, 0x4a, 0x3e, 0x10, 0x2e, 0x76, 0x7a, 0x82, 0x0f, 0xc2, 0xea, 0x82, 0x4e, 0xfd, 0x72,
+	0x82, 0xee, 0x44, 0xa0, 0x1d, 0xc2, 0xf2, 0x54, 0xa5, 0x2e, 0xd2, 0x3c, 0x36, 0x94, 0xd0, 0x58,
+	0x33, 0x7f, 0x8a, 0x59, 0xf3, 0xa7, 0x18, 0xc7, 0xec, 0x23, 0xb8, 0xbe, 0x83, 0x4a, 0x3b, 0x7b,
+	0x96, 0xe2, 0x9f, 0xde, 0x20, 0xe7, 0x71, 0xf9, 0x8b, 0x05, 0x2b, 0x53, 0x0e, 0xf7, 0x50, 0x09,
+	0xe6, 0xca, 0x68, 0x1a, 0x0a, 0x74, 0xa9, 0xef, 0x6b, 0x97, 0x96, 0x13, 0xaf, 0xc8, 0x7d, 0x28,
+	0xd3, 0xe3, 0x5e, 0xc7, 0xa7, 0x0a, 0x03, 0x77, 0xd8, 0xe9, 0x4b, 0x6d, 0xd8, 0x72, 0x4a, 0xf4,
+	0xb8, 0xf7, 0xca, 0x80, 0x7b, 0x32, 0x62, 0x85, 0x9b, 0x9b, 0xe3, 0xac, 0x9c, 0x61, 0x85, 0x9b,
+	0x9b, 0x23, 0xd6, 0x03, 0x28, 0x1f, 0x50, 0xe6, 0xa3, 0x97, 0x96, 0xcb, 0xfc, 0x41, 0x2f, 0x1b,
+	0x34, 0xae, 0x98, 0xfd, 0x5b, 0x0e, 0x6a, 0x59, 0x3b, 0x73, 0x91, 0x82, 0x7c, 0x01, 0x79, 0x3d,
+	0x29, 0x75, 0xf4, 0xe5, 0x66, 0x3d, 0x6b, 0x48, 0x4d, 0x39, 0x34, 0x03, 0xd6, 0xa8, 0x45, 0x6d,
+	0x16, 0x05, 0xd9, 0x11, 0x48, 0x25, 0x0f, 0xe2, 0x13, 0x06, 0x11, 0xe4, 0x68, 0x84, 0x3c, 0x04,
+	0x62, 0x3a, 0xd3, 0x4b, 0x3a, 0x76, 0x77, 0xdb, 0x4c, 0xe3, 0x9c, 0x73, 0x25, 0x96, 0xb4, 0x53,
+	0x01, 0xb9, 0x0b, 0xa5, 0x84, 0x2e, 0xf8, 0x3b, 0xa9, 0xaf, 0x0a, 0x39, 0xa7, 0x18, 0x63, 0x0e,
+	0x7f, 0x27, 0xc9, 0x7f, 0x61, 0x51, 0x60, 0xe8, 0xd3, 0xe1, 0xd8, 0x7e, 0x15, 0x34, 0xad, 0x92,
+	0xe0, 0x49, 0x8f, 0x3f, 0x87, 0xf9, 0xa8, 0x07, 0x7c, 0x16, 0xa0, 0x3e, 0x26, 0xc5, 0xe6, 0xfa,
+	0x39, 0x12, 0x8c, 0x4b, 0xef, 0xa4, 0xba, 0xe4, 0x05, 0x2c, 0xb8, 0x34, 0xf0, 0xf4, 0xad, 0x42,
+	0x1f, 0xa5, 0x8f, 0x33, 0x34, 0x52, 0xb6, 0xff, 0xb4, 0xe0, 0xca, 0x53, 0x5f, 0xa1, 0xf8, 0x37,
+	0x8c, 0x3d, 0x33, 0xd7, 0xc6, 0xc7, 0x9e, 0x46, 0xb4, 0x78, 0x13, 0x0a, 0x1f, 0x3b, 0xf0, 0x62,
+	0x85, 0x75, 0x0f, 0xae, 0x66, 0xdc, 0xd3, 0xc8, 0x22, 0x94, 0xda, 0xee, 0x21, 0xf6, 0xa9, 0xc1,
+	0x16, 0x67, 0x48, 0x05, 0x8a, 0x4f, 0x7d, 0x46, 0x65, 0x0c, 0x58, 0xe4, 0x2a, 0x54, 0xf6, 0xa9,
+	0x50, 0x2c, 0x0a, 0x32, 0x06, 0x67, 0xc9, 0x32, 0x5c, 0x19, 0xfd, 0x26, 0xb7, 0x05, 0x0f, 0x43,
+	0xf4, 0x16, 0x73, 0xeb, 0xbf, 0x5b, 0xb0, 0x94, 0xd5, 0x99, 0xa4, 0x76, 0xea, 0x2c, 0xef, 0x63,
+	0xe0, 0xb1, 0xa0, 0xb7, 0x38, 0x43, 0x6e, 0xc0, 0xb5, 0x29, 0xd9, 0xd6, 0x80, 0xf9, 0x5a, 0x68,
+	0x65, 0x28, 0xbe, 0xe2, 0x54, 0xcb, 0x66, 0xc9, 0x4d, 0xa8, 0x9e, 0x3a, 0x77, 0x51, 0xaf, 0x45,
+	0xd2, 0x5c, 0x86, 0xb4, 0xc5, 0xa3, 0x86, 0x55, 0xe8, 0x2d, 0xce, 0x91, 0xeb, 0xb0, 0x3c, 0x25,
+	0x7d, 0xae, 0xcf, 0xf5, 0x62, 0xbe, 0xf9, 0xf3, 0x3c, 0xe4, 0xf7, 0xa3, 0xd6, 0x21, 0x3e, 0x90,
+	0x1d, 0x54, 0x91, 0x1a, 0x0f, 0x30, 0x50, 0x6d, 0x73, 0x7d, 0x69, 0x64, 0xde, 0x73, 0x4e, 0x13,
+	0xe3, 0x46, 0xaa, 0xdd, 0xcf, 0xe4, 0x4f, 0x91, 0xed, 0x19, 0x72, 0x04, 0x4b, 0x3b, 0xa8, 0x97,
+	0x4c, 0x2a, 0xe6, 0xea, 0x0a, 0x04, 0xe8, 0x93, 0xe6, 0x7b, 0xee, 0x62, 0x59, 0xe4, 0xc4, 0xe7,
+	0xbd, 0x4c, 0x9f, 0x6d, 0x25, 0x58, 0xd0, 0x4b, 0x86, 0x93, 0x3d, 0x43, 0x04, 0xdc, 0x9a, 0x7c,
+	0xba, 0x98, 0x82, 0xa6, 0x9d, 0x32, 0xed, 0x3b, 0x39, 0x51, 0x1f, 0x7a, 0xed, 0xd4, 0x3e, 0x34,
+	0xe3, 0xec, 0x19, 0x42, 0xa1, 0xb4, 0x83, 0x6a, 0xdb, 0x4b, 0xd2, 0x5b, 0x7f, 0x7f, 0x7a, 0x29,
+	0xe9, 0x23, 0xd3, 0x7a, 0x0b, 0xd7, 0x27, 0x5f, 0x1c, 0x18, 0x28, 0x46, 0x7d, 0x93, 0x52, 0xe3,
+	0x8c, 0x94, 0xa6, 0xde, 0x0d, 0x67, 0xa5, 0xd3, 0x85, 0xe5, 0xd1, 0x83, 0x63, 0xdc, 0x4f, 0xe6,
+	0x30, 0xca, 0x7e, 0x9b, 0x9c, 0xe5, 0xe3, 0x2d, 0xac, 0x64, 0x3f, 0x28, 0xc8, 0xa3, 0x2c, 0x27,
+	0x1f, 0x7c, 0x7c, 0x9c, 0xe5, 0xcb, 0x83, 0xca, 0x0e, 0x2a, 0xdd, 0xff, 0xc9, 0xef, 0xf6, 0x3f,
+	0xef, 0x6b, 0xf8, 0x64, 0x96, 0xc6, 0x96, 0xd7, 0xce, 0xe4, 0xa5, 0x15, 0x7a, 0x0d, 0xf3, 0xc9,
+	0x65, 0x9e, 0xdc, 0xcb, 0xca, 0x61, 0xea, 0xaa, 0x7f, 0x56, 0xd4, 0x3e, 0x54, 0xa6, 0x2e, 0xd4,
+	0xd9, 0xfb, 0x9f, 0x7d, 0x85, 0xaf, 0xfd, 0xef, 0x5c, 0xdc, 0x24, 0xfa, 0xad, 0x27, 0xdf, 0x36,
+	0x7b, 0x4c, 0x1d, 0x0e, 0xba, 0x51, 0x1c, 0x1b, 0x46, 0xf5, 0x21, 0xe3, 0xf1, 0xd7, 0x46, 0xd2,
+	0xc2, 0x1b, 0xda, 0xda, 0x86, 0xb6, 0x16, 0x76, 0xbb, 0x05, 0xbd, 0x7c, 0xfc, 0x57, 0x00, 0x00,
+	0x00, 0xff, 0xff, 0x47, 0xa8, 0x6e, 0x83, 0x7e, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -251,7 +251,7 @@ func (SyncType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_aab7cc9a69ed26e8, []int{6}
 }
 
-//--------------------QueryCoord grpc request and response proto------------------
+// --------------------QueryCoord grpc request and response proto------------------
 type ShowCollectionsRequest struct {
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	// Not useful for now
@@ -1336,7 +1336,7 @@ func (m *SyncNewCreatedPartitionRequest) GetPartitionID() int64 {
 	return 0
 }
 
-//-----------------query node grpc request and response proto----------------
+// -----------------query node grpc request and response proto----------------
 type LoadMetaInfo struct {
 	LoadType             LoadType `protobuf:"varint,1,opt,name=load_type,json=loadType,proto3,enum=milvus.proto.query.LoadType" json:"load_type,omitempty"`
 	CollectionID         int64    `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
@@ -1416,6 +1416,7 @@ type WatchDmChannelsRequest struct {
 	OfflineNodeID        int64                `protobuf:"varint,11,opt,name=offlineNodeID,proto3" json:"offlineNodeID,omitempty"`
 	Version              int64                `protobuf:"varint,12,opt,name=version,proto3" json:"version,omitempty"`
 	IndexInfoList        []*indexpb.IndexInfo `protobuf:"bytes,13,rep,name=index_info_list,json=indexInfoList,proto3" json:"index_info_list,omitempty"`
+	FencingToken         *FencingToken        `protobuf:"bytes,14,opt,name=fencing_token,json=fencingToken,proto3" json:"fencing_token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -1537,11 +1538,19 @@ func (m *WatchDmChannelsRequest) GetIndexInfoList() []*indexpb.IndexInfo {
 	return nil
 }
 
+func (m *WatchDmChannelsRequest) GetFencingToken() *FencingToken {
+	if m != nil {
+		return m.FencingToken
+	}
+	return nil
+}
+
 type UnsubDmChannelRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	NodeID               int64             `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
 	CollectionID         int64             `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	ChannelName          string            `protobuf:"bytes,4,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
+	FencingToken         *FencingToken     `protobuf:"bytes,5,opt,name=fencing_token,json=fencingToken,proto3" json:"fencing_token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -1600,6 +1609,65 @@ func (m *UnsubDmChannelRequest) GetChannelName() string {
 	return ""
 }
 
+func (m *UnsubDmChannelRequest) GetFencingToken() *FencingToken {
+	if m != nil {
+		return m.FencingToken
+	}
+	return nil
+}
+
+// FencingToken orders the channel requests from QueryCoord, QueryNode rejects the requests
+// with a token older than the latest one it has seen for the channel,
+// so a delayed duplicate request could not resurrect a released delegator.
+type FencingToken struct {
+	// allocated by QueryCoord on activation, increases across the failovers
+	Term int64 `protobuf:"varint,1,opt,name=term,proto3" json:"term,omitempty"`
+	// increases within the term
+	Seq                  int64    `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FencingToken) Reset()         { *m = FencingToken{} }
+func (m *FencingToken) String() string { return proto.CompactTextString(m) }
+func (*FencingToken) ProtoMessage()    {}
+func (*FencingToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{20}
+}
+
+func (m *FencingToken) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FencingToken.Unmarshal(m, b)
+}
+func (m *FencingToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FencingToken.Marshal(b, m, deterministic)
+}
+func (m *FencingToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FencingToken.Merge(m, src)
+}
+func (m *FencingToken) XXX_Size() int {
+	return xxx_messageInfo_FencingToken.Size(m)
+}
+func (m *FencingToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_FencingToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FencingToken proto.InternalMessageInfo
+
+func (m *FencingToken) GetTerm() int64 {
+	if m != nil {
+		return m.Term
+	}
+	return 0
+}
+
+func (m *FencingToken) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
 type SegmentLoadInfo struct {
 	SegmentID            int64                 `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
 	PartitionID          int64                 `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
@@ -1626,7 +1694,7 @@ func (m *SegmentLoadInfo) Reset()         { *m = SegmentLoadInfo{} }
 func (m *SegmentLoadInfo) String() string { return proto.CompactTextString(m) }
 func (*SegmentLoadInfo) ProtoMessage()    {}
 func (*SegmentLoadInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{20}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{21}
 }
 
 func (m *SegmentLoadInfo) XXX_Unmarshal(b []byte) error {
@@ -1762,25 +1830,27 @@ func (m *SegmentLoadInfo) GetReadableVersion() int64 {
 type FieldIndexInfo struct {
 	FieldID int64 `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
 	// deprecated
-	EnableIndex          bool                     `protobuf:"varint,2,opt,name=enable_index,json=enableIndex,proto3" json:"enable_index,omitempty"`
-	IndexName            string                   `protobuf:"bytes,3,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	IndexID              int64                    `protobuf:"varint,4,opt,name=indexID,proto3" json:"indexID,omitempty"`
-	BuildID              int64                    `protobuf:"varint,5,opt,name=buildID,proto3" json:"buildID,omitempty"`
-	IndexParams          []*commonpb.KeyValuePair `protobuf:"bytes,6,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
-	IndexFilePaths       []string                 `protobuf:"bytes,7,rep,name=index_file_paths,json=indexFilePaths,proto3" json:"index_file_paths,omitempty"`
-	IndexSize            int64                    `protobuf:"varint,8,opt,name=index_size,json=indexSize,proto3" json:"index_size,omitempty"`
-	IndexVersion         int64                    `protobuf:"varint,9,opt,name=index_version,json=indexVersion,proto3" json:"index_version,omitempty"`
-	NumRows              int64                    `protobuf:"varint,10,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+	EnableIndex    bool                     `protobuf:"varint,2,opt,name=enable_index,json=enableIndex,proto3" json:"enable_index,omitempty"`
+	IndexName      string                   `protobuf:"bytes,3,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	IndexID        int64                    `protobuf:"varint,4,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	BuildID        int64                    `protobuf:"varint,5,opt,name=buildID,proto3" json:"buildID,omitempty"`
+	IndexParams    []*commonpb.KeyValuePair `protobuf:"bytes,6,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
+	IndexFilePaths []string                 `protobuf:"bytes,7,rep,name=index_file_paths,json=indexFilePaths,proto3" json:"index_file_paths,omitempty"`
+	IndexSize      int64                    `protobuf:"varint,8,opt,name=index_size,json=indexSize,proto3" json:"index_size,omitempty"`
+	IndexVersion   int64                    `protobuf:"varint,9,opt,name=index_version,json=indexVersion,proto3" json:"index_version,omitempty"`
+	NumRows        int64                    `protobuf:"varint,10,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	// the version of the index file format, the index is unloadable if it's newer than the version of the node
+	IndexEngineVersion   int32    `protobuf:"varint,11,opt,name=index_engine_version,json=indexEngineVersion,proto3" json:"index_engine_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *FieldIndexInfo) Reset()         { *m = FieldIndexInfo{} }
 func (m *FieldIndexInfo) String() string { return proto.CompactTextString(m) }
 func (*FieldIndexInfo) ProtoMessage()    {}
 func (*FieldIndexInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{21}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{22}
 }
 
 func (m *FieldIndexInfo) XXX_Unmarshal(b []byte) error {
@@ -1871,6 +1941,13 @@ func (m *FieldIndexInfo) GetNumRows() int64 {
 	return 0
 }
 
+func (m *FieldIndexInfo) GetIndexEngineVersion() int32 {
+	if m != nil {
+		return m.IndexEngineVersion
+	}
+	return 0
+}
+
 type LoadSegmentsRequest struct {
 	Base                 *commonpb.MsgBase          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DstNodeID            int64                      `protobuf:"varint,2,opt,name=dst_nodeID,json=dstNodeID,proto3" json:"dst_nodeID,omitempty"`
@@ -1894,7 +1971,7 @@ func (m *LoadSegmentsRequest) Reset()         { *m = LoadSegmentsRequest{} }
 func (m *LoadSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadSegmentsRequest) ProtoMessage()    {}
 func (*LoadSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{22}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{23}
 }
 
 func (m *LoadSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -2026,7 +2103,7 @@ func (m *ReleaseSegmentsRequest) Reset()         { *m = ReleaseSegmentsRequest{}
 func (m *ReleaseSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*ReleaseSegmentsRequest) ProtoMessage()    {}
 func (*ReleaseSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{23}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{24}
 }
 
 func (m *ReleaseSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -2126,7 +2203,7 @@ func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
 func (m *SearchRequest) String() string { return proto.CompactTextString(m) }
 func (*SearchRequest) ProtoMessage()    {}
 func (*SearchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{24}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{25}
 }
 
 func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
@@ -2204,7 +2281,7 @@ func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
 func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRequest) ProtoMessage()    {}
 func (*QueryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{25}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{26}
 }
 
 func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
@@ -2273,7 +2350,7 @@ func (m *SyncReplicaSegmentsRequest) Reset()         { *m = SyncReplicaSegmentsR
 func (m *SyncReplicaSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*SyncReplicaSegmentsRequest) ProtoMessage()    {}
 func (*SyncReplicaSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{26}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{27}
 }
 
 func (m *SyncReplicaSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -2329,7 +2406,7 @@ func (m *ReplicaSegmentsInfo) Reset()         { *m = ReplicaSegmentsInfo{} }
 func (m *ReplicaSegmentsInfo) String() string { return proto.CompactTextString(m) }
 func (*ReplicaSegmentsInfo) ProtoMessage()    {}
 func (*ReplicaSegmentsInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{27}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{28}
 }
 
 func (m *ReplicaSegmentsInfo) XXX_Unmarshal(b []byte) error {
@@ -2390,7 +2467,7 @@ func (m *GetLoadInfoRequest) Reset()         { *m = GetLoadInfoRequest{} }
 func (m *GetLoadInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetLoadInfoRequest) ProtoMessage()    {}
 func (*GetLoadInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{28}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{29}
 }
 
 func (m *GetLoadInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -2439,7 +2516,7 @@ func (m *GetLoadInfoResponse) Reset()         { *m = GetLoadInfoResponse{} }
 func (m *GetLoadInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetLoadInfoResponse) ProtoMessage()    {}
 func (*GetLoadInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{29}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{30}
 }
 
 func (m *GetLoadInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -2488,7 +2565,7 @@ func (m *GetLoadInfoResponse) GetPartitions() []int64 {
 	return nil
 }
 
-//----------------request auto triggered by QueryCoord-----------------
+// ----------------request auto triggered by QueryCoord-----------------
 type HandoffSegmentsRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	SegmentInfos         []*SegmentInfo    `protobuf:"bytes,2,rep,name=segmentInfos,proto3" json:"segmentInfos,omitempty"`
@@ -2502,7 +2579,7 @@ func (m *HandoffSegmentsRequest) Reset()         { *m = HandoffSegmentsRequest{}
 func (m *HandoffSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*HandoffSegmentsRequest) ProtoMessage()    {}
 func (*HandoffSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{30}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{31}
 }
 
 func (m *HandoffSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -2560,7 +2637,7 @@ func (m *LoadBalanceRequest) Reset()         { *m = LoadBalanceRequest{} }
 func (m *LoadBalanceRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadBalanceRequest) ProtoMessage()    {}
 func (*LoadBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{31}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{32}
 }
 
 func (m *LoadBalanceRequest) XXX_Unmarshal(b []byte) error {
@@ -2638,7 +2715,7 @@ func (m *DmChannelWatchInfo) Reset()         { *m = DmChannelWatchInfo{} }
 func (m *DmChannelWatchInfo) String() string { return proto.CompactTextString(m) }
 func (*DmChannelWatchInfo) ProtoMessage()    {}
 func (*DmChannelWatchInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{32}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{33}
 }
 
 func (m *DmChannelWatchInfo) XXX_Unmarshal(b []byte) error {
@@ -2709,7 +2786,7 @@ func (m *QueryChannelInfo) Reset()         { *m = QueryChannelInfo{} }
 func (m *QueryChannelInfo) String() string { return proto.CompactTextString(m) }
 func (*QueryChannelInfo) ProtoMessage()    {}
 func (*QueryChannelInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{33}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{34}
 }
 
 func (m *QueryChannelInfo) XXX_Unmarshal(b []byte) error {
@@ -2778,7 +2855,7 @@ func (m *PartitionStates) Reset()         { *m = PartitionStates{} }
 func (m *PartitionStates) String() string { return proto.CompactTextString(m) }
 func (*PartitionStates) ProtoMessage()    {}
 func (*PartitionStates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{34}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{35}
 }
 
 func (m *PartitionStates) XXX_Unmarshal(b []byte) error {
@@ -2848,7 +2925,7 @@ func (m *SegmentInfo) Reset()         { *m = SegmentInfo{} }
 func (m *SegmentInfo) String() string { return proto.CompactTextString(m) }
 func (*SegmentInfo) ProtoMessage()    {}
 func (*SegmentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{35}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{36}
 }
 
 func (m *SegmentInfo) XXX_Unmarshal(b []byte) error {
@@ -3007,7 +3084,7 @@ func (m *CollectionInfo) Reset()         { *m = CollectionInfo{} }
 func (m *CollectionInfo) String() string { return proto.CompactTextString(m) }
 func (*CollectionInfo) ProtoMessage()    {}
 func (*CollectionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{36}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{37}
 }
 
 func (m *CollectionInfo) XXX_Unmarshal(b []byte) error {
@@ -3103,7 +3180,7 @@ func (m *UnsubscribeChannels) Reset()         { *m = UnsubscribeChannels{} }
 func (m *UnsubscribeChannels) String() string { return proto.CompactTextString(m) }
 func (*UnsubscribeChannels) ProtoMessage()    {}
 func (*UnsubscribeChannels) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{37}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{38}
 }
 
 func (m *UnsubscribeChannels) XXX_Unmarshal(b []byte) error {
@@ -3150,7 +3227,7 @@ func (m *UnsubscribeChannelInfo) Reset()         { *m = UnsubscribeChannelInfo{}
 func (m *UnsubscribeChannelInfo) String() string { return proto.CompactTextString(m) }
 func (*UnsubscribeChannelInfo) ProtoMessage()    {}
 func (*UnsubscribeChannelInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{38}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{39}
 }
 
 func (m *UnsubscribeChannelInfo) XXX_Unmarshal(b []byte) error {
@@ -3185,7 +3262,7 @@ func (m *UnsubscribeChannelInfo) GetCollectionChannels() []*UnsubscribeChannels
 	return nil
 }
 
-//---- synchronize messages proto between QueryCoord and QueryNode -----
+// ---- synchronize messages proto between QueryCoord and QueryNode -----
 type SegmentChangeInfo struct {
 	OnlineNodeID         int64          `protobuf:"varint,1,opt,name=online_nodeID,json=onlineNodeID,proto3" json:"online_nodeID,omitempty"`
 	OnlineSegments       []*SegmentInfo `protobuf:"bytes,2,rep,name=online_segments,json=onlineSegments,proto3" json:"online_segments,omitempty"`
@@ -3200,7 +3277,7 @@ func (m *SegmentChangeInfo) Reset()         { *m = SegmentChangeInfo{} }
 func (m *SegmentChangeInfo) String() string { return proto.CompactTextString(m) }
 func (*SegmentChangeInfo) ProtoMessage()    {}
 func (*SegmentChangeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{39}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{40}
 }
 
 func (m *SegmentChangeInfo) XXX_Unmarshal(b []byte) error {
@@ -3261,7 +3338,7 @@ func (m *SealedSegmentsChangeInfo) Reset()         { *m = SealedSegmentsChangeIn
 func (m *SealedSegmentsChangeInfo) String() string { return proto.CompactTextString(m) }
 func (*SealedSegmentsChangeInfo) ProtoMessage()    {}
 func (*SealedSegmentsChangeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{40}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{41}
 }
 
 func (m *SealedSegmentsChangeInfo) XXX_Unmarshal(b []byte) error {
@@ -3308,7 +3385,7 @@ func (m *GetDataDistributionRequest) Reset()         { *m = GetDataDistributionR
 func (m *GetDataDistributionRequest) String() string { return proto.CompactTextString(m) }
 func (*GetDataDistributionRequest) ProtoMessage()    {}
 func (*GetDataDistributionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{41}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{42}
 }
 
 func (m *GetDataDistributionRequest) XXX_Unmarshal(b []byte) error {
@@ -3344,21 +3421,34 @@ func (m *GetDataDistributionRequest) GetCheckpoints() map[string]*msgpb.MsgPosit
 }
 
 type GetDataDistributionResponse struct {
-	Status               *commonpb.Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	NodeID               int64                 `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
-	Segments             []*SegmentVersionInfo `protobuf:"bytes,3,rep,name=segments,proto3" json:"segments,omitempty"`
-	Channels             []*ChannelVersionInfo `protobuf:"bytes,4,rep,name=channels,proto3" json:"channels,omitempty"`
-	LeaderViews          []*LeaderView         `protobuf:"bytes,5,rep,name=leader_views,json=leaderViews,proto3" json:"leader_views,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	Status      *commonpb.Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	NodeID      int64                 `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	Segments    []*SegmentVersionInfo `protobuf:"bytes,3,rep,name=segments,proto3" json:"segments,omitempty"`
+	Channels    []*ChannelVersionInfo `protobuf:"bytes,4,rep,name=channels,proto3" json:"channels,omitempty"`
+	LeaderViews []*LeaderView         `protobuf:"bytes,5,rep,name=leader_views,json=leaderViews,proto3" json:"leader_views,omitempty"`
+	// local disk space for segments and the space in use, in bytes,
+	// disk_committed is reserved by the segments being loaded.
+	DiskCapacity  int64 `protobuf:"varint,6,opt,name=disk_capacity,json=diskCapacity,proto3" json:"disk_capacity,omitempty"`
+	DiskUsed      int64 `protobuf:"varint,7,opt,name=disk_used,json=diskUsed,proto3" json:"disk_used,omitempty"`
+	DiskCommitted int64 `protobuf:"varint,8,opt,name=disk_committed,json=diskCommitted,proto3" json:"disk_committed,omitempty"`
+	// runtime load of the node for the load aware balancer,
+	// cpu_usage is in percentage, memory in bytes, search_nq_rate is the searched nq per second.
+	CpuUsage     float64 `protobuf:"fixed64,9,opt,name=cpu_usage,json=cpuUsage,proto3" json:"cpu_usage,omitempty"`
+	MemoryUsed   int64   `protobuf:"varint,10,opt,name=memory_used,json=memoryUsed,proto3" json:"memory_used,omitempty"`
+	MemoryTotal  int64   `protobuf:"varint,11,opt,name=memory_total,json=memoryTotal,proto3" json:"memory_total,omitempty"`
+	SearchNqRate float64 `protobuf:"fixed64,12,opt,name=search_nq_rate,json=searchNqRate,proto3" json:"search_nq_rate,omitempty"`
+	// average time in milliseconds the search requests wait in queue, for the replica auto-scaling of QueryCoord.
+	SearchQueueLatency   float64  `protobuf:"fixed64,13,opt,name=search_queue_latency,json=searchQueueLatency,proto3" json:"search_queue_latency,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *GetDataDistributionResponse) Reset()         { *m = GetDataDistributionResponse{} }
 func (m *GetDataDistributionResponse) String() string { return proto.CompactTextString(m) }
 func (*GetDataDistributionResponse) ProtoMessage()    {}
 func (*GetDataDistributionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{42}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{43}
 }
 
 func (m *GetDataDistributionResponse) XXX_Unmarshal(b []byte) error {
@@ -3414,6 +3504,62 @@ func (m *GetDataDistributionResponse) GetLeaderViews() []*LeaderView {
 	return nil
 }
 
+func (m *GetDataDistributionResponse) GetDiskCapacity() int64 {
+	if m != nil {
+		return m.DiskCapacity
+	}
+	return 0
+}
+
+func (m *GetDataDistributionResponse) GetDiskUsed() int64 {
+	if m != nil {
+		return m.DiskUsed
+	}
+	return 0
+}
+
+func (m *GetDataDistributionResponse) GetDiskCommitted() int64 {
+	if m != nil {
+		return m.DiskCommitted
+	}
+	return 0
+}
+
+func (m *GetDataDistributionResponse) GetCpuUsage() float64 {
+	if m != nil {
+		return m.CpuUsage
+	}
+	return 0
+}
+
+func (m *GetDataDistributionResponse) GetMemoryUsed() int64 {
+	if m != nil {
+		return m.MemoryUsed
+	}
+	return 0
+}
+
+func (m *GetDataDistributionResponse) GetMemoryTotal() int64 {
+	if m != nil {
+		return m.MemoryTotal
+	}
+	return 0
+}
+
+func (m *GetDataDistributionResponse) GetSearchNqRate() float64 {
+	if m != nil {
+		return m.SearchNqRate
+	}
+	return 0
+}
+
+func (m *GetDataDistributionResponse) GetSearchQueueLatency() float64 {
+	if m != nil {
+		return m.SearchQueueLatency
+	}
+	return 0
+}
+
 type LeaderView struct {
 	Collection           int64                        `protobuf:"varint,1,opt,name=collection,proto3" json:"collection,omitempty"`
 	Channel              string                       `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
@@ -3430,7 +3576,7 @@ func (m *LeaderView) Reset()         { *m = LeaderView{} }
 func (m *LeaderView) String() string { return proto.CompactTextString(m) }
 func (*LeaderView) ProtoMessage()    {}
 func (*LeaderView) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{43}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{44}
 }
 
 func (m *LeaderView) XXX_Unmarshal(b []byte) error {
@@ -3505,7 +3651,7 @@ func (m *SegmentDist) Reset()         { *m = SegmentDist{} }
 func (m *SegmentDist) String() string { return proto.CompactTextString(m) }
 func (*SegmentDist) ProtoMessage()    {}
 func (*SegmentDist) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{44}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{45}
 }
 
 func (m *SegmentDist) XXX_Unmarshal(b []byte) error {
@@ -3557,7 +3703,7 @@ func (m *SegmentVersionInfo) Reset()         { *m = SegmentVersionInfo{} }
 func (m *SegmentVersionInfo) String() string { return proto.CompactTextString(m) }
 func (*SegmentVersionInfo) ProtoMessage()    {}
 func (*SegmentVersionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{45}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{46}
 }
 
 func (m *SegmentVersionInfo) XXX_Unmarshal(b []byte) error {
@@ -3640,7 +3786,7 @@ func (m *ChannelVersionInfo) Reset()         { *m = ChannelVersionInfo{} }
 func (m *ChannelVersionInfo) String() string { return proto.CompactTextString(m) }
 func (*ChannelVersionInfo) ProtoMessage()    {}
 func (*ChannelVersionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{46}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{47}
 }
 
 func (m *ChannelVersionInfo) XXX_Unmarshal(b []byte) error {
@@ -3683,22 +3829,24 @@ func (m *ChannelVersionInfo) GetVersion() int64 {
 }
 
 type CollectionLoadInfo struct {
-	CollectionID         int64           `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	ReleasedPartitions   []int64         `protobuf:"varint,2,rep,packed,name=released_partitions,json=releasedPartitions,proto3" json:"released_partitions,omitempty"`
-	ReplicaNumber        int32           `protobuf:"varint,3,opt,name=replica_number,json=replicaNumber,proto3" json:"replica_number,omitempty"`
-	Status               LoadStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=milvus.proto.query.LoadStatus" json:"status,omitempty"`
-	FieldIndexID         map[int64]int64 `protobuf:"bytes,5,rep,name=field_indexID,json=fieldIndexID,proto3" json:"field_indexID,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	LoadType             LoadType        `protobuf:"varint,6,opt,name=load_type,json=loadType,proto3,enum=milvus.proto.query.LoadType" json:"load_type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	CollectionID       int64           `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	ReleasedPartitions []int64         `protobuf:"varint,2,rep,packed,name=released_partitions,json=releasedPartitions,proto3" json:"released_partitions,omitempty"`
+	ReplicaNumber      int32           `protobuf:"varint,3,opt,name=replica_number,json=replicaNumber,proto3" json:"replica_number,omitempty"`
+	Status             LoadStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=milvus.proto.query.LoadStatus" json:"status,omitempty"`
+	FieldIndexID       map[int64]int64 `protobuf:"bytes,5,rep,name=field_indexID,json=fieldIndexID,proto3" json:"field_indexID,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	LoadType           LoadType        `protobuf:"varint,6,opt,name=load_type,json=loadType,proto3,enum=milvus.proto.query.LoadType" json:"load_type,omitempty"`
+	// the segments and channels of the collection are not moved by the balance if true
+	BalanceSuspended     bool     `protobuf:"varint,7,opt,name=balance_suspended,json=balanceSuspended,proto3" json:"balance_suspended,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CollectionLoadInfo) Reset()         { *m = CollectionLoadInfo{} }
 func (m *CollectionLoadInfo) String() string { return proto.CompactTextString(m) }
 func (*CollectionLoadInfo) ProtoMessage()    {}
 func (*CollectionLoadInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{47}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{48}
 }
 
 func (m *CollectionLoadInfo) XXX_Unmarshal(b []byte) error {
@@ -3761,6 +3909,13 @@ func (m *CollectionLoadInfo) GetLoadType() LoadType {
 	return LoadType_UnKnownType
 }
 
+func (m *CollectionLoadInfo) GetBalanceSuspended() bool {
+	if m != nil {
+		return m.BalanceSuspended
+	}
+	return false
+}
+
 type PartitionLoadInfo struct {
 	CollectionID         int64           `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	PartitionID          int64           `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
@@ -3776,7 +3931,7 @@ func (m *PartitionLoadInfo) Reset()         { *m = PartitionLoadInfo{} }
 func (m *PartitionLoadInfo) String() string { return proto.CompactTextString(m) }
 func (*PartitionLoadInfo) ProtoMessage()    {}
 func (*PartitionLoadInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{48}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{49}
 }
 
 func (m *PartitionLoadInfo) XXX_Unmarshal(b []byte) error {
@@ -3833,20 +3988,22 @@ func (m *PartitionLoadInfo) GetFieldIndexID() map[int64]int64 {
 }
 
 type Replica struct {
-	ID                   int64    `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	CollectionID         int64    `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	Nodes                []int64  `protobuf:"varint,3,rep,packed,name=nodes,proto3" json:"nodes,omitempty"`
-	ResourceGroup        string   `protobuf:"bytes,4,opt,name=resource_group,json=resourceGroup,proto3" json:"resource_group,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ID            int64   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CollectionID  int64   `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Nodes         []int64 `protobuf:"varint,3,rep,packed,name=nodes,proto3" json:"nodes,omitempty"`
+	ResourceGroup string  `protobuf:"bytes,4,opt,name=resource_group,json=resourceGroup,proto3" json:"resource_group,omitempty"`
+	// the labels the nodes of the replica must match, from the placement rule of the collection
+	PlacementLabels      map[string]string `protobuf:"bytes,5,rep,name=placement_labels,json=placementLabels,proto3" json:"placement_labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *Replica) Reset()         { *m = Replica{} }
 func (m *Replica) String() string { return proto.CompactTextString(m) }
 func (*Replica) ProtoMessage()    {}
 func (*Replica) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{49}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{50}
 }
 
 func (m *Replica) XXX_Unmarshal(b []byte) error {
@@ -3895,6 +4052,13 @@ func (m *Replica) GetResourceGroup() string {
 	return ""
 }
 
+func (m *Replica) GetPlacementLabels() map[string]string {
+	if m != nil {
+		return m.PlacementLabels
+	}
+	return nil
+}
+
 type SyncAction struct {
 	Type                 SyncType         `protobuf:"varint,1,opt,name=type,proto3,enum=milvus.proto.query.SyncType" json:"type,omitempty"`
 	PartitionID          int64            `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
@@ -3915,7 +4079,7 @@ func (m *SyncAction) Reset()         { *m = SyncAction{} }
 func (m *SyncAction) String() string { return proto.CompactTextString(m) }
 func (*SyncAction) ProtoMessage()    {}
 func (*SyncAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{50}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{51}
 }
 
 func (m *SyncAction) XXX_Unmarshal(b []byte) error {
@@ -4007,24 +4171,26 @@ func (m *SyncAction) GetDroppedInTarget() []int64 {
 }
 
 type SyncDistributionRequest struct {
-	Base                 *commonpb.MsgBase          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	CollectionID         int64                      `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	Channel              string                     `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
-	Actions              []*SyncAction              `protobuf:"bytes,4,rep,name=actions,proto3" json:"actions,omitempty"`
-	Schema               *schemapb.CollectionSchema `protobuf:"bytes,5,opt,name=schema,proto3" json:"schema,omitempty"`
-	LoadMeta             *LoadMetaInfo              `protobuf:"bytes,6,opt,name=load_meta,json=loadMeta,proto3" json:"load_meta,omitempty"`
-	ReplicaID            int64                      `protobuf:"varint,7,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
-	Version              int64                      `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+	Base         *commonpb.MsgBase          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID int64                      `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Channel      string                     `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	Actions      []*SyncAction              `protobuf:"bytes,4,rep,name=actions,proto3" json:"actions,omitempty"`
+	Schema       *schemapb.CollectionSchema `protobuf:"bytes,5,opt,name=schema,proto3" json:"schema,omitempty"`
+	LoadMeta     *LoadMetaInfo              `protobuf:"bytes,6,opt,name=load_meta,json=loadMeta,proto3" json:"load_meta,omitempty"`
+	ReplicaID    int64                      `protobuf:"varint,7,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
+	Version      int64                      `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	// only the term is validated
+	FencingToken         *FencingToken `protobuf:"bytes,9,opt,name=fencing_token,json=fencingToken,proto3" json:"fencing_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *SyncDistributionRequest) Reset()         { *m = SyncDistributionRequest{} }
 func (m *SyncDistributionRequest) String() string { return proto.CompactTextString(m) }
 func (*SyncDistributionRequest) ProtoMessage()    {}
 func (*SyncDistributionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{51}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{52}
 }
 
 func (m *SyncDistributionRequest) XXX_Unmarshal(b []byte) error {
@@ -4101,6 +4267,13 @@ func (m *SyncDistributionRequest) GetVersion() int64 {
 	return 0
 }
 
+func (m *SyncDistributionRequest) GetFencingToken() *FencingToken {
+	if m != nil {
+		return m.FencingToken
+	}
+	return nil
+}
+
 type ResourceGroup struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Capacity             int32    `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
@@ -4114,7 +4287,7 @@ func (m *ResourceGroup) Reset()         { *m = ResourceGroup{} }
 func (m *ResourceGroup) String() string { return proto.CompactTextString(m) }
 func (*ResourceGroup) ProtoMessage()    {}
 func (*ResourceGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{52}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{53}
 }
 
 func (m *ResourceGroup) XXX_Unmarshal(b []byte) error {
@@ -4172,7 +4345,7 @@ func (m *TransferReplicaRequest) Reset()         { *m = TransferReplicaRequest{}
 func (m *TransferReplicaRequest) String() string { return proto.CompactTextString(m) }
 func (*TransferReplicaRequest) ProtoMessage()    {}
 func (*TransferReplicaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{53}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{54}
 }
 
 func (m *TransferReplicaRequest) XXX_Unmarshal(b []byte) error {
@@ -4240,7 +4413,7 @@ func (m *DescribeResourceGroupRequest) Reset()         { *m = DescribeResourceGr
 func (m *DescribeResourceGroupRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeResourceGroupRequest) ProtoMessage()    {}
 func (*DescribeResourceGroupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{54}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{55}
 }
 
 func (m *DescribeResourceGroupRequest) XXX_Unmarshal(b []byte) error {
@@ -4287,7 +4460,7 @@ func (m *DescribeResourceGroupResponse) Reset()         { *m = DescribeResourceG
 func (m *DescribeResourceGroupResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeResourceGroupResponse) ProtoMessage()    {}
 func (*DescribeResourceGroupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{55}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{56}
 }
 
 func (m *DescribeResourceGroupResponse) XXX_Unmarshal(b []byte) error {
@@ -4341,7 +4514,7 @@ func (m *ResourceGroupInfo) Reset()         { *m = ResourceGroupInfo{} }
 func (m *ResourceGroupInfo) String() string { return proto.CompactTextString(m) }
 func (*ResourceGroupInfo) ProtoMessage()    {}
 func (*ResourceGroupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{56}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{57}
 }
 
 func (m *ResourceGroupInfo) XXX_Unmarshal(b []byte) error {
@@ -4421,7 +4594,7 @@ func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()    {}
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{57}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{58}
 }
 
 func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
@@ -4491,59 +4664,1259 @@ func (m *DeleteRequest) GetTimestamps() []uint64 {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("milvus.proto.query.LoadScope", LoadScope_name, LoadScope_value)
-	proto.RegisterEnum("milvus.proto.query.DataScope", DataScope_name, DataScope_value)
-	proto.RegisterEnum("milvus.proto.query.PartitionState", PartitionState_name, PartitionState_value)
-	proto.RegisterEnum("milvus.proto.query.TriggerCondition", TriggerCondition_name, TriggerCondition_value)
-	proto.RegisterEnum("milvus.proto.query.LoadType", LoadType_name, LoadType_value)
-	proto.RegisterEnum("milvus.proto.query.LoadStatus", LoadStatus_name, LoadStatus_value)
-	proto.RegisterEnum("milvus.proto.query.SyncType", SyncType_name, SyncType_value)
-	proto.RegisterType((*ShowCollectionsRequest)(nil), "milvus.proto.query.ShowCollectionsRequest")
-	proto.RegisterType((*ShowCollectionsResponse)(nil), "milvus.proto.query.ShowCollectionsResponse")
-	proto.RegisterType((*ShowPartitionsRequest)(nil), "milvus.proto.query.ShowPartitionsRequest")
-	proto.RegisterType((*ShowPartitionsResponse)(nil), "milvus.proto.query.ShowPartitionsResponse")
-	proto.RegisterType((*LoadCollectionRequest)(nil), "milvus.proto.query.LoadCollectionRequest")
-	proto.RegisterMapType((map[int64]int64)(nil), "milvus.proto.query.LoadCollectionRequest.FieldIndexIDEntry")
-	proto.RegisterType((*ReleaseCollectionRequest)(nil), "milvus.proto.query.ReleaseCollectionRequest")
-	proto.RegisterType((*GetStatisticsRequest)(nil), "milvus.proto.query.GetStatisticsRequest")
-	proto.RegisterType((*LoadPartitionsRequest)(nil), "milvus.proto.query.LoadPartitionsRequest")
-	proto.RegisterMapType((map[int64]int64)(nil), "milvus.proto.query.LoadPartitionsRequest.FieldIndexIDEntry")
-	proto.RegisterType((*ReleasePartitionsRequest)(nil), "milvus.proto.query.ReleasePartitionsRequest")
-	proto.RegisterType((*GetPartitionStatesRequest)(nil), "milvus.proto.query.GetPartitionStatesRequest")
-	proto.RegisterType((*GetPartitionStatesResponse)(nil), "milvus.proto.query.GetPartitionStatesResponse")
-	proto.RegisterType((*GetSegmentInfoRequest)(nil), "milvus.proto.query.GetSegmentInfoRequest")
-	proto.RegisterType((*GetSegmentInfoResponse)(nil), "milvus.proto.query.GetSegmentInfoResponse")
-	proto.RegisterType((*GetShardLeadersRequest)(nil), "milvus.proto.query.GetShardLeadersRequest")
-	proto.RegisterType((*GetShardLeadersResponse)(nil), "milvus.proto.query.GetShardLeadersResponse")
-	proto.RegisterType((*ShardLeadersList)(nil), "milvus.proto.query.ShardLeadersList")
-	proto.RegisterType((*SyncNewCreatedPartitionRequest)(nil), "milvus.proto.query.SyncNewCreatedPartitionRequest")
-	proto.RegisterType((*LoadMetaInfo)(nil), "milvus.proto.query.LoadMetaInfo")
-	proto.RegisterType((*WatchDmChannelsRequest)(nil), "milvus.proto.query.WatchDmChannelsRequest")
-	proto.RegisterMapType((map[int64]*datapb.SegmentInfo)(nil), "milvus.proto.query.WatchDmChannelsRequest.SegmentInfosEntry")
-	proto.RegisterType((*UnsubDmChannelRequest)(nil), "milvus.proto.query.UnsubDmChannelRequest")
-	proto.RegisterType((*SegmentLoadInfo)(nil), "milvus.proto.query.SegmentLoadInfo")
-	proto.RegisterType((*FieldIndexInfo)(nil), "milvus.proto.query.FieldIndexInfo")
-	proto.RegisterType((*LoadSegmentsRequest)(nil), "milvus.proto.query.LoadSegmentsRequest")
-	proto.RegisterType((*ReleaseSegmentsRequest)(nil), "milvus.proto.query.ReleaseSegmentsRequest")
-	proto.RegisterType((*SearchRequest)(nil), "milvus.proto.query.SearchRequest")
-	proto.RegisterType((*QueryRequest)(nil), "milvus.proto.query.QueryRequest")
-	proto.RegisterType((*SyncReplicaSegmentsRequest)(nil), "milvus.proto.query.SyncReplicaSegmentsRequest")
-	proto.RegisterType((*ReplicaSegmentsInfo)(nil), "milvus.proto.query.ReplicaSegmentsInfo")
-	proto.RegisterType((*GetLoadInfoRequest)(nil), "milvus.proto.query.GetLoadInfoRequest")
-	proto.RegisterType((*GetLoadInfoResponse)(nil), "milvus.proto.query.GetLoadInfoResponse")
-	proto.RegisterType((*HandoffSegmentsRequest)(nil), "milvus.proto.query.HandoffSegmentsRequest")
-	proto.RegisterType((*LoadBalanceRequest)(nil), "milvus.proto.query.LoadBalanceRequest")
-	proto.RegisterType((*DmChannelWatchInfo)(nil), "milvus.proto.query.DmChannelWatchInfo")
-	proto.RegisterType((*QueryChannelInfo)(nil), "milvus.proto.query.QueryChannelInfo")
-	proto.RegisterType((*PartitionStates)(nil), "milvus.proto.query.PartitionStates")
-	proto.RegisterType((*SegmentInfo)(nil), "milvus.proto.query.SegmentInfo")
-	proto.RegisterType((*CollectionInfo)(nil), "milvus.proto.query.CollectionInfo")
-	proto.RegisterType((*UnsubscribeChannels)(nil), "milvus.proto.query.UnsubscribeChannels")
-	proto.RegisterType((*UnsubscribeChannelInfo)(nil), "milvus.proto.query.UnsubscribeChannelInfo")
-	proto.RegisterType((*SegmentChangeInfo)(nil), "milvus.proto.query.SegmentChangeInfo")
-	proto.RegisterType((*SealedSegmentsChangeInfo)(nil), "milvus.proto.query.SealedSegmentsChangeInfo")
-	proto.RegisterType((*GetDataDistributionRequest)(nil), "milvus.proto.query.GetDataDistributionRequest")
+type ExtendLoadTimeoutRequest struct {
+	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// the load timeout of the collection is extended by the seconds
+	ExtensionSeconds     int64    `protobuf:"varint,3,opt,name=extension_seconds,json=extensionSeconds,proto3" json:"extension_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExtendLoadTimeoutRequest) Reset()         { *m = ExtendLoadTimeoutRequest{} }
+func (m *ExtendLoadTimeoutRequest) String() string { return proto.CompactTextString(m) }
+func (*ExtendLoadTimeoutRequest) ProtoMessage()    {}
+func (*ExtendLoadTimeoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{59}
+}
+
+func (m *ExtendLoadTimeoutRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExtendLoadTimeoutRequest.Unmarshal(m, b)
+}
+func (m *ExtendLoadTimeoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExtendLoadTimeoutRequest.Marshal(b, m, deterministic)
+}
+func (m *ExtendLoadTimeoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtendLoadTimeoutRequest.Merge(m, src)
+}
+func (m *ExtendLoadTimeoutRequest) XXX_Size() int {
+	return xxx_messageInfo_ExtendLoadTimeoutRequest.Size(m)
+}
+func (m *ExtendLoadTimeoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtendLoadTimeoutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtendLoadTimeoutRequest proto.InternalMessageInfo
+
+func (m *ExtendLoadTimeoutRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ExtendLoadTimeoutRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ExtendLoadTimeoutRequest) GetExtensionSeconds() int64 {
+	if m != nil {
+		return m.ExtensionSeconds
+	}
+	return 0
+}
+
+type ListCheckersRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// all the checkers if empty
+	CheckerNames         []string `protobuf:"bytes,2,rep,name=checker_names,json=checkerNames,proto3" json:"checker_names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListCheckersRequest) Reset()         { *m = ListCheckersRequest{} }
+func (m *ListCheckersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCheckersRequest) ProtoMessage()    {}
+func (*ListCheckersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{60}
+}
+
+func (m *ListCheckersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCheckersRequest.Unmarshal(m, b)
+}
+func (m *ListCheckersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCheckersRequest.Marshal(b, m, deterministic)
+}
+func (m *ListCheckersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCheckersRequest.Merge(m, src)
+}
+func (m *ListCheckersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListCheckersRequest.Size(m)
+}
+func (m *ListCheckersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCheckersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCheckersRequest proto.InternalMessageInfo
+
+func (m *ListCheckersRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ListCheckersRequest) GetCheckerNames() []string {
+	if m != nil {
+		return m.CheckerNames
+	}
+	return nil
+}
+
+type CheckerInfo struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Desc                 string   `protobuf:"bytes,2,opt,name=desc,proto3" json:"desc,omitempty"`
+	Activated            bool     `protobuf:"varint,3,opt,name=activated,proto3" json:"activated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckerInfo) Reset()         { *m = CheckerInfo{} }
+func (m *CheckerInfo) String() string { return proto.CompactTextString(m) }
+func (*CheckerInfo) ProtoMessage()    {}
+func (*CheckerInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{61}
+}
+
+func (m *CheckerInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckerInfo.Unmarshal(m, b)
+}
+func (m *CheckerInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckerInfo.Marshal(b, m, deterministic)
+}
+func (m *CheckerInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckerInfo.Merge(m, src)
+}
+func (m *CheckerInfo) XXX_Size() int {
+	return xxx_messageInfo_CheckerInfo.Size(m)
+}
+func (m *CheckerInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckerInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckerInfo proto.InternalMessageInfo
+
+func (m *CheckerInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CheckerInfo) GetDesc() string {
+	if m != nil {
+		return m.Desc
+	}
+	return ""
+}
+
+func (m *CheckerInfo) GetActivated() bool {
+	if m != nil {
+		return m.Activated
+	}
+	return false
+}
+
+type ListCheckersResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	CheckerInfos         []*CheckerInfo   `protobuf:"bytes,2,rep,name=checker_infos,json=checkerInfos,proto3" json:"checker_infos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListCheckersResponse) Reset()         { *m = ListCheckersResponse{} }
+func (m *ListCheckersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListCheckersResponse) ProtoMessage()    {}
+func (*ListCheckersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{62}
+}
+
+func (m *ListCheckersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCheckersResponse.Unmarshal(m, b)
+}
+func (m *ListCheckersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCheckersResponse.Marshal(b, m, deterministic)
+}
+func (m *ListCheckersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCheckersResponse.Merge(m, src)
+}
+func (m *ListCheckersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListCheckersResponse.Size(m)
+}
+func (m *ListCheckersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCheckersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCheckersResponse proto.InternalMessageInfo
+
+func (m *ListCheckersResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListCheckersResponse) GetCheckerInfos() []*CheckerInfo {
+	if m != nil {
+		return m.CheckerInfos
+	}
+	return nil
+}
+
+type ActivateCheckerRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CheckerName          string            `protobuf:"bytes,2,opt,name=checker_name,json=checkerName,proto3" json:"checker_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ActivateCheckerRequest) Reset()         { *m = ActivateCheckerRequest{} }
+func (m *ActivateCheckerRequest) String() string { return proto.CompactTextString(m) }
+func (*ActivateCheckerRequest) ProtoMessage()    {}
+func (*ActivateCheckerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{63}
+}
+
+func (m *ActivateCheckerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActivateCheckerRequest.Unmarshal(m, b)
+}
+func (m *ActivateCheckerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActivateCheckerRequest.Marshal(b, m, deterministic)
+}
+func (m *ActivateCheckerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActivateCheckerRequest.Merge(m, src)
+}
+func (m *ActivateCheckerRequest) XXX_Size() int {
+	return xxx_messageInfo_ActivateCheckerRequest.Size(m)
+}
+func (m *ActivateCheckerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActivateCheckerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActivateCheckerRequest proto.InternalMessageInfo
+
+func (m *ActivateCheckerRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ActivateCheckerRequest) GetCheckerName() string {
+	if m != nil {
+		return m.CheckerName
+	}
+	return ""
+}
+
+type DeactivateCheckerRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CheckerName          string            `protobuf:"bytes,2,opt,name=checker_name,json=checkerName,proto3" json:"checker_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DeactivateCheckerRequest) Reset()         { *m = DeactivateCheckerRequest{} }
+func (m *DeactivateCheckerRequest) String() string { return proto.CompactTextString(m) }
+func (*DeactivateCheckerRequest) ProtoMessage()    {}
+func (*DeactivateCheckerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{64}
+}
+
+func (m *DeactivateCheckerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeactivateCheckerRequest.Unmarshal(m, b)
+}
+func (m *DeactivateCheckerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeactivateCheckerRequest.Marshal(b, m, deterministic)
+}
+func (m *DeactivateCheckerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeactivateCheckerRequest.Merge(m, src)
+}
+func (m *DeactivateCheckerRequest) XXX_Size() int {
+	return xxx_messageInfo_DeactivateCheckerRequest.Size(m)
+}
+func (m *DeactivateCheckerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeactivateCheckerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeactivateCheckerRequest proto.InternalMessageInfo
+
+func (m *DeactivateCheckerRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DeactivateCheckerRequest) GetCheckerName() string {
+	if m != nil {
+		return m.CheckerName
+	}
+	return ""
+}
+
+type DryRunCheckersRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// all the checkers if empty, including the deactivated ones
+	CheckerNames         []string `protobuf:"bytes,2,rep,name=checker_names,json=checkerNames,proto3" json:"checker_names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DryRunCheckersRequest) Reset()         { *m = DryRunCheckersRequest{} }
+func (m *DryRunCheckersRequest) String() string { return proto.CompactTextString(m) }
+func (*DryRunCheckersRequest) ProtoMessage()    {}
+func (*DryRunCheckersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{65}
+}
+
+func (m *DryRunCheckersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DryRunCheckersRequest.Unmarshal(m, b)
+}
+func (m *DryRunCheckersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DryRunCheckersRequest.Marshal(b, m, deterministic)
+}
+func (m *DryRunCheckersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DryRunCheckersRequest.Merge(m, src)
+}
+func (m *DryRunCheckersRequest) XXX_Size() int {
+	return xxx_messageInfo_DryRunCheckersRequest.Size(m)
+}
+func (m *DryRunCheckersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DryRunCheckersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DryRunCheckersRequest proto.InternalMessageInfo
+
+func (m *DryRunCheckersRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DryRunCheckersRequest) GetCheckerNames() []string {
+	if m != nil {
+		return m.CheckerNames
+	}
+	return nil
+}
+
+type TaskActionInfo struct {
+	// Grow, Reduce or Update
+	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	NodeID               int64    `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TaskActionInfo) Reset()         { *m = TaskActionInfo{} }
+func (m *TaskActionInfo) String() string { return proto.CompactTextString(m) }
+func (*TaskActionInfo) ProtoMessage()    {}
+func (*TaskActionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{66}
+}
+
+func (m *TaskActionInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TaskActionInfo.Unmarshal(m, b)
+}
+func (m *TaskActionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TaskActionInfo.Marshal(b, m, deterministic)
+}
+func (m *TaskActionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskActionInfo.Merge(m, src)
+}
+func (m *TaskActionInfo) XXX_Size() int {
+	return xxx_messageInfo_TaskActionInfo.Size(m)
+}
+func (m *TaskActionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskActionInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskActionInfo proto.InternalMessageInfo
+
+func (m *TaskActionInfo) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *TaskActionInfo) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+type CheckerTaskInfo struct {
+	CheckerName string `protobuf:"bytes,1,opt,name=checker_name,json=checkerName,proto3" json:"checker_name,omitempty"`
+	// Grow, Reduce, Move or Update
+	Type         string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	CollectionID int64  `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	ReplicaID    int64  `protobuf:"varint,4,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
+	// 0 for the channel tasks
+	SegmentID int64             `protobuf:"varint,5,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	Channel   string            `protobuf:"bytes,6,opt,name=channel,proto3" json:"channel,omitempty"`
+	Actions   []*TaskActionInfo `protobuf:"bytes,7,rep,name=actions,proto3" json:"actions,omitempty"`
+	Priority  string            `protobuf:"bytes,8,opt,name=priority,proto3" json:"priority,omitempty"`
+	Reason    string            `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+	// 0 if the task is not submitted to the scheduler
+	TaskID               int64    `protobuf:"varint,10,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckerTaskInfo) Reset()         { *m = CheckerTaskInfo{} }
+func (m *CheckerTaskInfo) String() string { return proto.CompactTextString(m) }
+func (*CheckerTaskInfo) ProtoMessage()    {}
+func (*CheckerTaskInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{67}
+}
+
+func (m *CheckerTaskInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckerTaskInfo.Unmarshal(m, b)
+}
+func (m *CheckerTaskInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckerTaskInfo.Marshal(b, m, deterministic)
+}
+func (m *CheckerTaskInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckerTaskInfo.Merge(m, src)
+}
+func (m *CheckerTaskInfo) XXX_Size() int {
+	return xxx_messageInfo_CheckerTaskInfo.Size(m)
+}
+func (m *CheckerTaskInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckerTaskInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckerTaskInfo proto.InternalMessageInfo
+
+func (m *CheckerTaskInfo) GetCheckerName() string {
+	if m != nil {
+		return m.CheckerName
+	}
+	return ""
+}
+
+func (m *CheckerTaskInfo) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *CheckerTaskInfo) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *CheckerTaskInfo) GetReplicaID() int64 {
+	if m != nil {
+		return m.ReplicaID
+	}
+	return 0
+}
+
+func (m *CheckerTaskInfo) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *CheckerTaskInfo) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *CheckerTaskInfo) GetActions() []*TaskActionInfo {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
+func (m *CheckerTaskInfo) GetPriority() string {
+	if m != nil {
+		return m.Priority
+	}
+	return ""
+}
+
+func (m *CheckerTaskInfo) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *CheckerTaskInfo) GetTaskID() int64 {
+	if m != nil {
+		return m.TaskID
+	}
+	return 0
+}
+
+type DryRunCheckersResponse struct {
+	Status               *commonpb.Status   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Tasks                []*CheckerTaskInfo `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *DryRunCheckersResponse) Reset()         { *m = DryRunCheckersResponse{} }
+func (m *DryRunCheckersResponse) String() string { return proto.CompactTextString(m) }
+func (*DryRunCheckersResponse) ProtoMessage()    {}
+func (*DryRunCheckersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{68}
+}
+
+func (m *DryRunCheckersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DryRunCheckersResponse.Unmarshal(m, b)
+}
+func (m *DryRunCheckersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DryRunCheckersResponse.Marshal(b, m, deterministic)
+}
+func (m *DryRunCheckersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DryRunCheckersResponse.Merge(m, src)
+}
+func (m *DryRunCheckersResponse) XXX_Size() int {
+	return xxx_messageInfo_DryRunCheckersResponse.Size(m)
+}
+func (m *DryRunCheckersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DryRunCheckersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DryRunCheckersResponse proto.InternalMessageInfo
+
+func (m *DryRunCheckersResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *DryRunCheckersResponse) GetTasks() []*CheckerTaskInfo {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
+
+type TriggerBalanceRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// all the loaded collections if 0
+	CollectionID int64 `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// only the plans moving from or to the node if not 0
+	NodeID               int64    `protobuf:"varint,3,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TriggerBalanceRequest) Reset()         { *m = TriggerBalanceRequest{} }
+func (m *TriggerBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*TriggerBalanceRequest) ProtoMessage()    {}
+func (*TriggerBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{69}
+}
+
+func (m *TriggerBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TriggerBalanceRequest.Unmarshal(m, b)
+}
+func (m *TriggerBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TriggerBalanceRequest.Marshal(b, m, deterministic)
+}
+func (m *TriggerBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerBalanceRequest.Merge(m, src)
+}
+func (m *TriggerBalanceRequest) XXX_Size() int {
+	return xxx_messageInfo_TriggerBalanceRequest.Size(m)
+}
+func (m *TriggerBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerBalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerBalanceRequest proto.InternalMessageInfo
+
+func (m *TriggerBalanceRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *TriggerBalanceRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *TriggerBalanceRequest) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+type TriggerBalanceResponse struct {
+	Status               *commonpb.Status   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Tasks                []*CheckerTaskInfo `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *TriggerBalanceResponse) Reset()         { *m = TriggerBalanceResponse{} }
+func (m *TriggerBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*TriggerBalanceResponse) ProtoMessage()    {}
+func (*TriggerBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{70}
+}
+
+func (m *TriggerBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TriggerBalanceResponse.Unmarshal(m, b)
+}
+func (m *TriggerBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TriggerBalanceResponse.Marshal(b, m, deterministic)
+}
+func (m *TriggerBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerBalanceResponse.Merge(m, src)
+}
+func (m *TriggerBalanceResponse) XXX_Size() int {
+	return xxx_messageInfo_TriggerBalanceResponse.Size(m)
+}
+func (m *TriggerBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerBalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerBalanceResponse proto.InternalMessageInfo
+
+func (m *TriggerBalanceResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *TriggerBalanceResponse) GetTasks() []*CheckerTaskInfo {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
+
+type SuspendCollectionBalanceRequest struct {
+	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// resume the balance if false
+	Suspend              bool     `protobuf:"varint,3,opt,name=suspend,proto3" json:"suspend,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SuspendCollectionBalanceRequest) Reset()         { *m = SuspendCollectionBalanceRequest{} }
+func (m *SuspendCollectionBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*SuspendCollectionBalanceRequest) ProtoMessage()    {}
+func (*SuspendCollectionBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{71}
+}
+
+func (m *SuspendCollectionBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SuspendCollectionBalanceRequest.Unmarshal(m, b)
+}
+func (m *SuspendCollectionBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SuspendCollectionBalanceRequest.Marshal(b, m, deterministic)
+}
+func (m *SuspendCollectionBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuspendCollectionBalanceRequest.Merge(m, src)
+}
+func (m *SuspendCollectionBalanceRequest) XXX_Size() int {
+	return xxx_messageInfo_SuspendCollectionBalanceRequest.Size(m)
+}
+func (m *SuspendCollectionBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuspendCollectionBalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuspendCollectionBalanceRequest proto.InternalMessageInfo
+
+func (m *SuspendCollectionBalanceRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *SuspendCollectionBalanceRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *SuspendCollectionBalanceRequest) GetSuspend() bool {
+	if m != nil {
+		return m.Suspend
+	}
+	return false
+}
+
+type WatchLoadProgressRequest struct {
+	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// the partitions of the load job, all the loaded partitions if empty
+	PartitionIDs []int64 `protobuf:"varint,3,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
+	// the interval in milliseconds between the events, 1000 if not positive
+	IntervalMs           int64    `protobuf:"varint,4,opt,name=interval_ms,json=intervalMs,proto3" json:"interval_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchLoadProgressRequest) Reset()         { *m = WatchLoadProgressRequest{} }
+func (m *WatchLoadProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchLoadProgressRequest) ProtoMessage()    {}
+func (*WatchLoadProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{72}
+}
+
+func (m *WatchLoadProgressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchLoadProgressRequest.Unmarshal(m, b)
+}
+func (m *WatchLoadProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchLoadProgressRequest.Marshal(b, m, deterministic)
+}
+func (m *WatchLoadProgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchLoadProgressRequest.Merge(m, src)
+}
+func (m *WatchLoadProgressRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchLoadProgressRequest.Size(m)
+}
+func (m *WatchLoadProgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchLoadProgressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchLoadProgressRequest proto.InternalMessageInfo
+
+func (m *WatchLoadProgressRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *WatchLoadProgressRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *WatchLoadProgressRequest) GetPartitionIDs() []int64 {
+	if m != nil {
+		return m.PartitionIDs
+	}
+	return nil
+}
+
+func (m *WatchLoadProgressRequest) GetIntervalMs() int64 {
+	if m != nil {
+		return m.IntervalMs
+	}
+	return 0
+}
+
+type NodeLoadProgress struct {
+	NodeID               int64    `protobuf:"varint,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	LoadedSegments       int64    `protobuf:"varint,2,opt,name=loaded_segments,json=loadedSegments,proto3" json:"loaded_segments,omitempty"`
+	LoadedChannels       int64    `protobuf:"varint,3,opt,name=loaded_channels,json=loadedChannels,proto3" json:"loaded_channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeLoadProgress) Reset()         { *m = NodeLoadProgress{} }
+func (m *NodeLoadProgress) String() string { return proto.CompactTextString(m) }
+func (*NodeLoadProgress) ProtoMessage()    {}
+func (*NodeLoadProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{73}
+}
+
+func (m *NodeLoadProgress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeLoadProgress.Unmarshal(m, b)
+}
+func (m *NodeLoadProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeLoadProgress.Marshal(b, m, deterministic)
+}
+func (m *NodeLoadProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeLoadProgress.Merge(m, src)
+}
+func (m *NodeLoadProgress) XXX_Size() int {
+	return xxx_messageInfo_NodeLoadProgress.Size(m)
+}
+func (m *NodeLoadProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeLoadProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeLoadProgress proto.InternalMessageInfo
+
+func (m *NodeLoadProgress) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *NodeLoadProgress) GetLoadedSegments() int64 {
+	if m != nil {
+		return m.LoadedSegments
+	}
+	return 0
+}
+
+func (m *NodeLoadProgress) GetLoadedChannels() int64 {
+	if m != nil {
+		return m.LoadedChannels
+	}
+	return 0
+}
+
+// LoadProgressEvent is the snapshot of the load progress of a collection or partitions,
+// the segments and channels are counted over all the replicas.
+type LoadProgressEvent struct {
+	Status         *commonpb.Status    `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	CollectionID   int64               `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Progress       int32               `protobuf:"varint,3,opt,name=progress,proto3" json:"progress,omitempty"`
+	LoadedSegments int64               `protobuf:"varint,4,opt,name=loaded_segments,json=loadedSegments,proto3" json:"loaded_segments,omitempty"`
+	TotalSegments  int64               `protobuf:"varint,5,opt,name=total_segments,json=totalSegments,proto3" json:"total_segments,omitempty"`
+	LoadedChannels int64               `protobuf:"varint,6,opt,name=loaded_channels,json=loadedChannels,proto3" json:"loaded_channels,omitempty"`
+	TotalChannels  int64               `protobuf:"varint,7,opt,name=total_channels,json=totalChannels,proto3" json:"total_channels,omitempty"`
+	Nodes          []*NodeLoadProgress `protobuf:"bytes,8,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	// unix time in milliseconds
+	Timestamp            int64    `protobuf:"varint,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LoadProgressEvent) Reset()         { *m = LoadProgressEvent{} }
+func (m *LoadProgressEvent) String() string { return proto.CompactTextString(m) }
+func (*LoadProgressEvent) ProtoMessage()    {}
+func (*LoadProgressEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{74}
+}
+
+func (m *LoadProgressEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoadProgressEvent.Unmarshal(m, b)
+}
+func (m *LoadProgressEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoadProgressEvent.Marshal(b, m, deterministic)
+}
+func (m *LoadProgressEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadProgressEvent.Merge(m, src)
+}
+func (m *LoadProgressEvent) XXX_Size() int {
+	return xxx_messageInfo_LoadProgressEvent.Size(m)
+}
+func (m *LoadProgressEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadProgressEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoadProgressEvent proto.InternalMessageInfo
+
+func (m *LoadProgressEvent) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *LoadProgressEvent) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *LoadProgressEvent) GetProgress() int32 {
+	if m != nil {
+		return m.Progress
+	}
+	return 0
+}
+
+func (m *LoadProgressEvent) GetLoadedSegments() int64 {
+	if m != nil {
+		return m.LoadedSegments
+	}
+	return 0
+}
+
+func (m *LoadProgressEvent) GetTotalSegments() int64 {
+	if m != nil {
+		return m.TotalSegments
+	}
+	return 0
+}
+
+func (m *LoadProgressEvent) GetLoadedChannels() int64 {
+	if m != nil {
+		return m.LoadedChannels
+	}
+	return 0
+}
+
+func (m *LoadProgressEvent) GetTotalChannels() int64 {
+	if m != nil {
+		return m.TotalChannels
+	}
+	return 0
+}
+
+func (m *LoadProgressEvent) GetNodes() []*NodeLoadProgress {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *LoadProgressEvent) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type ListDivergedLeadersRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// all the loaded collections if 0
+	CollectionID         int64    `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListDivergedLeadersRequest) Reset()         { *m = ListDivergedLeadersRequest{} }
+func (m *ListDivergedLeadersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDivergedLeadersRequest) ProtoMessage()    {}
+func (*ListDivergedLeadersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{75}
+}
+
+func (m *ListDivergedLeadersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDivergedLeadersRequest.Unmarshal(m, b)
+}
+func (m *ListDivergedLeadersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDivergedLeadersRequest.Marshal(b, m, deterministic)
+}
+func (m *ListDivergedLeadersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDivergedLeadersRequest.Merge(m, src)
+}
+func (m *ListDivergedLeadersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDivergedLeadersRequest.Size(m)
+}
+func (m *ListDivergedLeadersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDivergedLeadersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDivergedLeadersRequest proto.InternalMessageInfo
+
+func (m *ListDivergedLeadersRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ListDivergedLeadersRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+// DivergedLeaderInfo is a shard leader whose view has been out of sync
+// with the distribution or current target longer than the threshold.
+type DivergedLeaderInfo struct {
+	CollectionID int64  `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	ReplicaID    int64  `protobuf:"varint,2,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
+	Channel      string `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	LeaderID     int64  `protobuf:"varint,4,opt,name=leaderID,proto3" json:"leaderID,omitempty"`
+	Reason       string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// unix time in milliseconds when the divergence was first observed
+	DivergedSince        int64    `protobuf:"varint,6,opt,name=diverged_since,json=divergedSince,proto3" json:"diverged_since,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DivergedLeaderInfo) Reset()         { *m = DivergedLeaderInfo{} }
+func (m *DivergedLeaderInfo) String() string { return proto.CompactTextString(m) }
+func (*DivergedLeaderInfo) ProtoMessage()    {}
+func (*DivergedLeaderInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{76}
+}
+
+func (m *DivergedLeaderInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DivergedLeaderInfo.Unmarshal(m, b)
+}
+func (m *DivergedLeaderInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DivergedLeaderInfo.Marshal(b, m, deterministic)
+}
+func (m *DivergedLeaderInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DivergedLeaderInfo.Merge(m, src)
+}
+func (m *DivergedLeaderInfo) XXX_Size() int {
+	return xxx_messageInfo_DivergedLeaderInfo.Size(m)
+}
+func (m *DivergedLeaderInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DivergedLeaderInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DivergedLeaderInfo proto.InternalMessageInfo
+
+func (m *DivergedLeaderInfo) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *DivergedLeaderInfo) GetReplicaID() int64 {
+	if m != nil {
+		return m.ReplicaID
+	}
+	return 0
+}
+
+func (m *DivergedLeaderInfo) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *DivergedLeaderInfo) GetLeaderID() int64 {
+	if m != nil {
+		return m.LeaderID
+	}
+	return 0
+}
+
+func (m *DivergedLeaderInfo) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *DivergedLeaderInfo) GetDivergedSince() int64 {
+	if m != nil {
+		return m.DivergedSince
+	}
+	return 0
+}
+
+type ListDivergedLeadersResponse struct {
+	Status               *commonpb.Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Leaders              []*DivergedLeaderInfo `protobuf:"bytes,2,rep,name=leaders,proto3" json:"leaders,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ListDivergedLeadersResponse) Reset()         { *m = ListDivergedLeadersResponse{} }
+func (m *ListDivergedLeadersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDivergedLeadersResponse) ProtoMessage()    {}
+func (*ListDivergedLeadersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{77}
+}
+
+func (m *ListDivergedLeadersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDivergedLeadersResponse.Unmarshal(m, b)
+}
+func (m *ListDivergedLeadersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDivergedLeadersResponse.Marshal(b, m, deterministic)
+}
+func (m *ListDivergedLeadersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDivergedLeadersResponse.Merge(m, src)
+}
+func (m *ListDivergedLeadersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDivergedLeadersResponse.Size(m)
+}
+func (m *ListDivergedLeadersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDivergedLeadersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDivergedLeadersResponse proto.InternalMessageInfo
+
+func (m *ListDivergedLeadersResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListDivergedLeadersResponse) GetLeaders() []*DivergedLeaderInfo {
+	if m != nil {
+		return m.Leaders
+	}
+	return nil
+}
+
+type NotifyCompactionRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID          int64             `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	Channel              string            `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	CompactedFrom        []int64           `protobuf:"varint,5,rep,packed,name=compactedFrom,proto3" json:"compactedFrom,omitempty"`
+	CompactedTo          int64             `protobuf:"varint,6,opt,name=compactedTo,proto3" json:"compactedTo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *NotifyCompactionRequest) Reset()         { *m = NotifyCompactionRequest{} }
+func (m *NotifyCompactionRequest) String() string { return proto.CompactTextString(m) }
+func (*NotifyCompactionRequest) ProtoMessage()    {}
+func (*NotifyCompactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{78}
+}
+
+func (m *NotifyCompactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NotifyCompactionRequest.Unmarshal(m, b)
+}
+func (m *NotifyCompactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NotifyCompactionRequest.Marshal(b, m, deterministic)
+}
+func (m *NotifyCompactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotifyCompactionRequest.Merge(m, src)
+}
+func (m *NotifyCompactionRequest) XXX_Size() int {
+	return xxx_messageInfo_NotifyCompactionRequest.Size(m)
+}
+func (m *NotifyCompactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotifyCompactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NotifyCompactionRequest proto.InternalMessageInfo
+
+func (m *NotifyCompactionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *NotifyCompactionRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *NotifyCompactionRequest) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *NotifyCompactionRequest) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *NotifyCompactionRequest) GetCompactedFrom() []int64 {
+	if m != nil {
+		return m.CompactedFrom
+	}
+	return nil
+}
+
+func (m *NotifyCompactionRequest) GetCompactedTo() int64 {
+	if m != nil {
+		return m.CompactedTo
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("milvus.proto.query.LoadScope", LoadScope_name, LoadScope_value)
+	proto.RegisterEnum("milvus.proto.query.DataScope", DataScope_name, DataScope_value)
+	proto.RegisterEnum("milvus.proto.query.PartitionState", PartitionState_name, PartitionState_value)
+	proto.RegisterEnum("milvus.proto.query.TriggerCondition", TriggerCondition_name, TriggerCondition_value)
+	proto.RegisterEnum("milvus.proto.query.LoadType", LoadType_name, LoadType_value)
+	proto.RegisterEnum("milvus.proto.query.LoadStatus", LoadStatus_name, LoadStatus_value)
+	proto.RegisterEnum("milvus.proto.query.SyncType", SyncType_name, SyncType_value)
+	proto.RegisterType((*ShowCollectionsRequest)(nil), "milvus.proto.query.ShowCollectionsRequest")
+	proto.RegisterType((*ShowCollectionsResponse)(nil), "milvus.proto.query.ShowCollectionsResponse")
+	proto.RegisterType((*ShowPartitionsRequest)(nil), "milvus.proto.query.ShowPartitionsRequest")
+	proto.RegisterType((*ShowPartitionsResponse)(nil), "milvus.proto.query.ShowPartitionsResponse")
+	proto.RegisterType((*LoadCollectionRequest)(nil), "milvus.proto.query.LoadCollectionRequest")
+	proto.RegisterMapType((map[int64]int64)(nil), "milvus.proto.query.LoadCollectionRequest.FieldIndexIDEntry")
+	proto.RegisterType((*ReleaseCollectionRequest)(nil), "milvus.proto.query.ReleaseCollectionRequest")
+	proto.RegisterType((*GetStatisticsRequest)(nil), "milvus.proto.query.GetStatisticsRequest")
+	proto.RegisterType((*LoadPartitionsRequest)(nil), "milvus.proto.query.LoadPartitionsRequest")
+	proto.RegisterMapType((map[int64]int64)(nil), "milvus.proto.query.LoadPartitionsRequest.FieldIndexIDEntry")
+	proto.RegisterType((*ReleasePartitionsRequest)(nil), "milvus.proto.query.ReleasePartitionsRequest")
+	proto.RegisterType((*GetPartitionStatesRequest)(nil), "milvus.proto.query.GetPartitionStatesRequest")
+	proto.RegisterType((*GetPartitionStatesResponse)(nil), "milvus.proto.query.GetPartitionStatesResponse")
+	proto.RegisterType((*GetSegmentInfoRequest)(nil), "milvus.proto.query.GetSegmentInfoRequest")
+	proto.RegisterType((*GetSegmentInfoResponse)(nil), "milvus.proto.query.GetSegmentInfoResponse")
+	proto.RegisterType((*GetShardLeadersRequest)(nil), "milvus.proto.query.GetShardLeadersRequest")
+	proto.RegisterType((*GetShardLeadersResponse)(nil), "milvus.proto.query.GetShardLeadersResponse")
+	proto.RegisterType((*ShardLeadersList)(nil), "milvus.proto.query.ShardLeadersList")
+	proto.RegisterType((*SyncNewCreatedPartitionRequest)(nil), "milvus.proto.query.SyncNewCreatedPartitionRequest")
+	proto.RegisterType((*LoadMetaInfo)(nil), "milvus.proto.query.LoadMetaInfo")
+	proto.RegisterType((*WatchDmChannelsRequest)(nil), "milvus.proto.query.WatchDmChannelsRequest")
+	proto.RegisterMapType((map[int64]*datapb.SegmentInfo)(nil), "milvus.proto.query.WatchDmChannelsRequest.SegmentInfosEntry")
+	proto.RegisterType((*UnsubDmChannelRequest)(nil), "milvus.proto.query.UnsubDmChannelRequest")
+	proto.RegisterType((*FencingToken)(nil), "milvus.proto.query.FencingToken")
+	proto.RegisterType((*SegmentLoadInfo)(nil), "milvus.proto.query.SegmentLoadInfo")
+	proto.RegisterType((*FieldIndexInfo)(nil), "milvus.proto.query.FieldIndexInfo")
+	proto.RegisterType((*LoadSegmentsRequest)(nil), "milvus.proto.query.LoadSegmentsRequest")
+	proto.RegisterType((*ReleaseSegmentsRequest)(nil), "milvus.proto.query.ReleaseSegmentsRequest")
+	proto.RegisterType((*SearchRequest)(nil), "milvus.proto.query.SearchRequest")
+	proto.RegisterType((*QueryRequest)(nil), "milvus.proto.query.QueryRequest")
+	proto.RegisterType((*SyncReplicaSegmentsRequest)(nil), "milvus.proto.query.SyncReplicaSegmentsRequest")
+	proto.RegisterType((*ReplicaSegmentsInfo)(nil), "milvus.proto.query.ReplicaSegmentsInfo")
+	proto.RegisterType((*GetLoadInfoRequest)(nil), "milvus.proto.query.GetLoadInfoRequest")
+	proto.RegisterType((*GetLoadInfoResponse)(nil), "milvus.proto.query.GetLoadInfoResponse")
+	proto.RegisterType((*HandoffSegmentsRequest)(nil), "milvus.proto.query.HandoffSegmentsRequest")
+	proto.RegisterType((*LoadBalanceRequest)(nil), "milvus.proto.query.LoadBalanceRequest")
+	proto.RegisterType((*DmChannelWatchInfo)(nil), "milvus.proto.query.DmChannelWatchInfo")
+	proto.RegisterType((*QueryChannelInfo)(nil), "milvus.proto.query.QueryChannelInfo")
+	proto.RegisterType((*PartitionStates)(nil), "milvus.proto.query.PartitionStates")
+	proto.RegisterType((*SegmentInfo)(nil), "milvus.proto.query.SegmentInfo")
+	proto.RegisterType((*CollectionInfo)(nil), "milvus.proto.query.CollectionInfo")
+	proto.RegisterType((*UnsubscribeChannels)(nil), "milvus.proto.query.UnsubscribeChannels")
+	proto.RegisterType((*UnsubscribeChannelInfo)(nil), "milvus.proto.query.UnsubscribeChannelInfo")
+	proto.RegisterType((*SegmentChangeInfo)(nil), "milvus.proto.query.SegmentChangeInfo")
+	proto.RegisterType((*SealedSegmentsChangeInfo)(nil), "milvus.proto.query.SealedSegmentsChangeInfo")
+	proto.RegisterType((*GetDataDistributionRequest)(nil), "milvus.proto.query.GetDataDistributionRequest")
 	proto.RegisterMapType((map[string]*msgpb.MsgPosition)(nil), "milvus.proto.query.GetDataDistributionRequest.CheckpointsEntry")
 	proto.RegisterType((*GetDataDistributionResponse)(nil), "milvus.proto.query.GetDataDistributionResponse")
 	proto.RegisterType((*LeaderView)(nil), "milvus.proto.query.LeaderView")
@@ -4558,6 +5931,7 @@ func init() {
 	proto.RegisterType((*PartitionLoadInfo)(nil), "milvus.proto.query.PartitionLoadInfo")
 	proto.RegisterMapType((map[int64]int64)(nil), "milvus.proto.query.PartitionLoadInfo.FieldIndexIDEntry")
 	proto.RegisterType((*Replica)(nil), "milvus.proto.query.Replica")
+	proto.RegisterMapType((map[string]string)(nil), "milvus.proto.query.Replica.PlacementLabelsEntry")
 	proto.RegisterType((*SyncAction)(nil), "milvus.proto.query.SyncAction")
 	proto.RegisterType((*SyncDistributionRequest)(nil), "milvus.proto.query.SyncDistributionRequest")
 	proto.RegisterType((*ResourceGroup)(nil), "milvus.proto.query.ResourceGroup")
@@ -4569,311 +5943,401 @@ func init() {
 	proto.RegisterMapType((map[int64]int32)(nil), "milvus.proto.query.ResourceGroupInfo.NumLoadedReplicaEntry")
 	proto.RegisterMapType((map[int64]int32)(nil), "milvus.proto.query.ResourceGroupInfo.NumOutgoingNodeEntry")
 	proto.RegisterType((*DeleteRequest)(nil), "milvus.proto.query.DeleteRequest")
+	proto.RegisterType((*ExtendLoadTimeoutRequest)(nil), "milvus.proto.query.ExtendLoadTimeoutRequest")
+	proto.RegisterType((*ListCheckersRequest)(nil), "milvus.proto.query.ListCheckersRequest")
+	proto.RegisterType((*CheckerInfo)(nil), "milvus.proto.query.CheckerInfo")
+	proto.RegisterType((*ListCheckersResponse)(nil), "milvus.proto.query.ListCheckersResponse")
+	proto.RegisterType((*ActivateCheckerRequest)(nil), "milvus.proto.query.ActivateCheckerRequest")
+	proto.RegisterType((*DeactivateCheckerRequest)(nil), "milvus.proto.query.DeactivateCheckerRequest")
+	proto.RegisterType((*DryRunCheckersRequest)(nil), "milvus.proto.query.DryRunCheckersRequest")
+	proto.RegisterType((*TaskActionInfo)(nil), "milvus.proto.query.TaskActionInfo")
+	proto.RegisterType((*CheckerTaskInfo)(nil), "milvus.proto.query.CheckerTaskInfo")
+	proto.RegisterType((*DryRunCheckersResponse)(nil), "milvus.proto.query.DryRunCheckersResponse")
+	proto.RegisterType((*TriggerBalanceRequest)(nil), "milvus.proto.query.TriggerBalanceRequest")
+	proto.RegisterType((*TriggerBalanceResponse)(nil), "milvus.proto.query.TriggerBalanceResponse")
+	proto.RegisterType((*SuspendCollectionBalanceRequest)(nil), "milvus.proto.query.SuspendCollectionBalanceRequest")
+	proto.RegisterType((*WatchLoadProgressRequest)(nil), "milvus.proto.query.WatchLoadProgressRequest")
+	proto.RegisterType((*NodeLoadProgress)(nil), "milvus.proto.query.NodeLoadProgress")
+	proto.RegisterType((*LoadProgressEvent)(nil), "milvus.proto.query.LoadProgressEvent")
+	proto.RegisterType((*ListDivergedLeadersRequest)(nil), "milvus.proto.query.ListDivergedLeadersRequest")
+	proto.RegisterType((*DivergedLeaderInfo)(nil), "milvus.proto.query.DivergedLeaderInfo")
+	proto.RegisterType((*ListDivergedLeadersResponse)(nil), "milvus.proto.query.ListDivergedLeadersResponse")
+	proto.RegisterType((*NotifyCompactionRequest)(nil), "milvus.proto.query.NotifyCompactionRequest")
 }
 
 func init() { proto.RegisterFile("query_coord.proto", fileDescriptor_aab7cc9a69ed26e8) }
 
 var fileDescriptor_aab7cc9a69ed26e8 = []byte{
-	// 4769 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x4b, 0x73, 0x24, 0x47,
-	0x5a, 0xd3, 0x4f, 0x75, 0x7f, 0xfd, 0x2a, 0xa5, 0xa4, 0x99, 0xde, 0xde, 0x99, 0xb1, 0x5c, 0xe3,
-	0x87, 0x56, 0xb6, 0xa5, 0x59, 0xcd, 0xda, 0x3b, 0xbb, 0xb6, 0xc3, 0xcc, 0x48, 0x9e, 0xb1, 0xd6,
-	0x63, 0x59, 0x5b, 0xd2, 0xcc, 0x12, 0xc6, 0xbb, 0xed, 0x52, 0x57, 0xaa, 0x55, 0x31, 0xf5, 0xe8,
-	0xa9, 0xaa, 0x96, 0x46, 0x26, 0x82, 0xe0, 0xc0, 0x85, 0x85, 0x05, 0x02, 0x0e, 0x70, 0x20, 0x38,
-	0x40, 0x10, 0xb1, 0x10, 0x70, 0x21, 0x20, 0x82, 0x03, 0x07, 0x6e, 0x9c, 0x78, 0xdc, 0xf8, 0x03,
-	0x70, 0x23, 0x82, 0x0b, 0x1b, 0x84, 0x6f, 0x44, 0x3e, 0xea, 0x91, 0x55, 0xd9, 0xea, 0x92, 0x7a,
-	0xbc, 0xb6, 0x89, 0xbd, 0x75, 0x7d, 0xf9, 0xf8, 0xbe, 0xfc, 0xf2, 0x7b, 0x67, 0x66, 0xc3, 0xfc,
-	0x93, 0x31, 0xf6, 0x4e, 0xfb, 0x03, 0xd7, 0xf5, 0x8c, 0xb5, 0x91, 0xe7, 0x06, 0x2e, 0x42, 0xb6,
-	0x69, 0x1d, 0x8f, 0x7d, 0xf6, 0xb5, 0x46, 0xdb, 0x7b, 0xcd, 0x81, 0x6b, 0xdb, 0xae, 0xc3, 0x60,
-	0xbd, 0x66, 0xb2, 0x47, 0xaf, 0x6d, 0x3a, 0x01, 0xf6, 0x1c, 0xdd, 0x0a, 0x5b, 0xfd, 0xc1, 0x11,
-	0xb6, 0x75, 0xfe, 0x55, 0xb7, 0xfd, 0x21, 0xff, 0xa9, 0x18, 0x7a, 0xa0, 0x27, 0x51, 0xf5, 0xe6,
-	0x4d, 0xc7, 0xc0, 0x4f, 0x93, 0x20, 0xf5, 0x37, 0x0a, 0x70, 0x79, 0xef, 0xc8, 0x3d, 0xd9, 0x74,
-	0x2d, 0x0b, 0x0f, 0x02, 0xd3, 0x75, 0x7c, 0x0d, 0x3f, 0x19, 0x63, 0x3f, 0x40, 0x37, 0xa1, 0x7c,
-	0xa0, 0xfb, 0xb8, 0x5b, 0x58, 0x2e, 0xac, 0x34, 0x36, 0xae, 0xae, 0x09, 0x74, 0x72, 0x02, 0x3f,
-	0xf0, 0x87, 0x77, 0x75, 0x1f, 0x6b, 0xb4, 0x27, 0x42, 0x50, 0x36, 0x0e, 0xb6, 0xb7, 0xba, 0xc5,
-	0xe5, 0xc2, 0x4a, 0x49, 0xa3, 0xbf, 0xd1, 0x0b, 0xd0, 0x1a, 0x44, 0x73, 0x6f, 0x6f, 0xf9, 0xdd,
-	0xd2, 0x72, 0x69, 0xa5, 0xa4, 0x89, 0x40, 0xf5, 0xc7, 0x45, 0xb8, 0x92, 0x21, 0xc3, 0x1f, 0xb9,
-	0x8e, 0x8f, 0xd1, 0x2d, 0xa8, 0xfa, 0x81, 0x1e, 0x8c, 0x7d, 0x4e, 0xc9, 0xd7, 0xa5, 0x94, 0xec,
-	0xd1, 0x2e, 0x1a, 0xef, 0x9a, 0x45, 0x5b, 0x94, 0xa0, 0x45, 0xdf, 0x84, 0x45, 0xd3, 0xf9, 0x00,
-	0xdb, 0xae, 0x77, 0xda, 0x1f, 0x61, 0x6f, 0x80, 0x9d, 0x40, 0x1f, 0xe2, 0x90, 0xc6, 0x85, 0xb0,
-	0x6d, 0x37, 0x6e, 0x42, 0x6f, 0xc0, 0x15, 0xb6, 0x87, 0x3e, 0xf6, 0x8e, 0xcd, 0x01, 0xee, 0xeb,
-	0xc7, 0xba, 0x69, 0xe9, 0x07, 0x16, 0xee, 0x96, 0x97, 0x4b, 0x2b, 0x35, 0x6d, 0x89, 0x36, 0xef,
-	0xb1, 0xd6, 0x3b, 0x61, 0x23, 0xfa, 0x06, 0x28, 0x1e, 0x3e, 0xf4, 0xb0, 0x7f, 0xd4, 0x1f, 0x79,
-	0xee, 0xd0, 0xc3, 0xbe, 0xdf, 0xad, 0x50, 0x34, 0x1d, 0x0e, 0xdf, 0xe5, 0x60, 0xf5, 0xcf, 0x0b,
-	0xb0, 0x44, 0x98, 0xb1, 0xab, 0x7b, 0x81, 0xf9, 0x39, 0x6c, 0x89, 0x0a, 0xcd, 0x24, 0x1b, 0xba,
-	0x25, 0xda, 0x26, 0xc0, 0x48, 0x9f, 0x51, 0x88, 0x9e, 0xb0, 0xaf, 0x4c, 0x49, 0x15, 0x60, 0xea,
-	0xbf, 0x72, 0xd9, 0x49, 0xd2, 0x39, 0xcb, 0x9e, 0xa5, 0x71, 0x16, 0xb3, 0x38, 0x2f, 0xb2, 0x63,
-	0x32, 0xce, 0x97, 0xe5, 0x9c, 0xff, 0xe7, 0x12, 0x2c, 0x3d, 0x70, 0x75, 0x23, 0x16, 0xc3, 0x9f,
-	0x3f, 0xe7, 0xdf, 0x86, 0x2a, 0xd3, 0xe8, 0x6e, 0x99, 0xe2, 0x7a, 0x51, 0xc4, 0xc5, 0xb5, 0x3d,
-	0xa6, 0x70, 0x8f, 0x02, 0x34, 0x3e, 0x08, 0xbd, 0x08, 0x6d, 0x0f, 0x8f, 0x2c, 0x73, 0xa0, 0xf7,
-	0x9d, 0xb1, 0x7d, 0x80, 0xbd, 0x6e, 0x65, 0xb9, 0xb0, 0x52, 0xd1, 0x5a, 0x1c, 0xba, 0x43, 0x81,
-	0xe8, 0x13, 0x68, 0x1d, 0x9a, 0xd8, 0x32, 0xfa, 0xd4, 0x24, 0x6c, 0x6f, 0x75, 0xab, 0xcb, 0xa5,
-	0x95, 0xc6, 0xc6, 0x9b, 0x6b, 0x59, 0x6b, 0xb4, 0x26, 0xe5, 0xc8, 0xda, 0x3d, 0x32, 0x7c, 0x9b,
-	0x8d, 0x7e, 0xd7, 0x09, 0xbc, 0x53, 0xad, 0x79, 0x98, 0x00, 0xa1, 0x2e, 0xcc, 0x71, 0xf6, 0x76,
-	0xe7, 0x96, 0x0b, 0x2b, 0x35, 0x2d, 0xfc, 0x44, 0x2f, 0x43, 0xc7, 0xc3, 0xbe, 0x3b, 0xf6, 0x06,
-	0xb8, 0x3f, 0xf4, 0xdc, 0xf1, 0xc8, 0xef, 0xd6, 0x96, 0x4b, 0x2b, 0x75, 0xad, 0x1d, 0x82, 0xef,
-	0x53, 0x68, 0xef, 0x1d, 0x98, 0xcf, 0x60, 0x41, 0x0a, 0x94, 0x1e, 0xe3, 0x53, 0xba, 0x11, 0x25,
-	0x8d, 0xfc, 0x44, 0x8b, 0x50, 0x39, 0xd6, 0xad, 0x31, 0xe6, 0xac, 0x66, 0x1f, 0xdf, 0x2d, 0xde,
-	0x2e, 0xa8, 0x7f, 0x5c, 0x80, 0xae, 0x86, 0x2d, 0xac, 0xfb, 0xf8, 0x8b, 0xdc, 0xd2, 0xcb, 0x50,
-	0x75, 0x5c, 0x03, 0x6f, 0x6f, 0xd1, 0x2d, 0x2d, 0x69, 0xfc, 0x4b, 0xfd, 0xac, 0x00, 0x8b, 0xf7,
-	0x71, 0x40, 0xd4, 0xc0, 0xf4, 0x03, 0x73, 0x10, 0xe9, 0xf9, 0xdb, 0x50, 0xf2, 0xf0, 0x13, 0x4e,
-	0xd9, 0x2b, 0x22, 0x65, 0x91, 0xf9, 0x97, 0x8d, 0xd4, 0xc8, 0x38, 0xf4, 0x3c, 0x34, 0x0d, 0xdb,
-	0xea, 0x0f, 0x8e, 0x74, 0xc7, 0xc1, 0x16, 0x53, 0xa4, 0xba, 0xd6, 0x30, 0x6c, 0x6b, 0x93, 0x83,
-	0xd0, 0x75, 0x00, 0x1f, 0x0f, 0x6d, 0xec, 0x04, 0xb1, 0x4d, 0x4e, 0x40, 0xd0, 0x2a, 0xcc, 0x1f,
-	0x7a, 0xae, 0xdd, 0xf7, 0x8f, 0x74, 0xcf, 0xe8, 0x5b, 0x58, 0x37, 0xb0, 0x47, 0xa9, 0xaf, 0x69,
-	0x1d, 0xd2, 0xb0, 0x47, 0xe0, 0x0f, 0x28, 0x18, 0xdd, 0x82, 0x8a, 0x3f, 0x70, 0x47, 0x98, 0x4a,
-	0x5a, 0x7b, 0xe3, 0x9a, 0x4c, 0x86, 0xb6, 0xf4, 0x40, 0xdf, 0x23, 0x9d, 0x34, 0xd6, 0x57, 0xfd,
-	0xfb, 0x32, 0x53, 0xb5, 0x2f, 0xb9, 0x91, 0x4b, 0xa8, 0x63, 0xe5, 0xd9, 0xa8, 0x63, 0x35, 0x97,
-	0x3a, 0xce, 0x9d, 0xad, 0x8e, 0x19, 0xae, 0x9d, 0x47, 0x1d, 0x6b, 0x53, 0xd5, 0xb1, 0x2e, 0x53,
-	0x47, 0xf4, 0x2e, 0x74, 0x58, 0x00, 0x61, 0x3a, 0x87, 0x6e, 0xdf, 0x32, 0xfd, 0xa0, 0x0b, 0x94,
-	0xcc, 0x6b, 0x69, 0x09, 0x35, 0xf0, 0xd3, 0x35, 0x86, 0xd8, 0x39, 0x74, 0xb5, 0x96, 0x19, 0xfe,
-	0x7c, 0x60, 0xfa, 0xc1, 0xec, 0x5a, 0xfd, 0x8f, 0xb1, 0x56, 0x7f, 0xd9, 0xa5, 0x27, 0xd6, 0xfc,
-	0x8a, 0xa0, 0xf9, 0x7f, 0x51, 0x80, 0xaf, 0xdd, 0xc7, 0x41, 0x44, 0x3e, 0x51, 0x64, 0xfc, 0x25,
-	0x75, 0xf3, 0x7f, 0x5d, 0x80, 0x9e, 0x8c, 0xd6, 0x59, 0x5c, 0xfd, 0x47, 0x70, 0x39, 0xc2, 0xd1,
-	0x37, 0xb0, 0x3f, 0xf0, 0xcc, 0x11, 0xdd, 0x46, 0x6a, 0xab, 0x1a, 0x1b, 0x37, 0x64, 0x82, 0x9f,
-	0xa6, 0x60, 0x29, 0x9a, 0x62, 0x2b, 0x31, 0x83, 0xfa, 0x93, 0x02, 0x2c, 0x11, 0xdb, 0xc8, 0x8d,
-	0x19, 0x91, 0xc0, 0x0b, 0xf3, 0x55, 0x34, 0x93, 0xc5, 0x8c, 0x99, 0xcc, 0xc1, 0x63, 0x1a, 0x62,
-	0xa7, 0xe9, 0x99, 0x85, 0x77, 0xaf, 0x43, 0x85, 0x28, 0x60, 0xc8, 0xaa, 0xe7, 0x64, 0xac, 0x4a,
-	0x22, 0x63, 0xbd, 0x55, 0x87, 0x51, 0x11, 0xdb, 0xed, 0x19, 0xc4, 0x2d, 0xbd, 0xec, 0xa2, 0x64,
-	0xd9, 0xbf, 0x5d, 0x80, 0x2b, 0x19, 0x84, 0xb3, 0xac, 0xfb, 0x2d, 0xa8, 0x52, 0x6f, 0x14, 0x2e,
-	0xfc, 0x05, 0xe9, 0xc2, 0x13, 0xe8, 0x88, 0xb5, 0xd1, 0xf8, 0x18, 0xd5, 0x05, 0x25, 0xdd, 0x46,
-	0xfc, 0x24, 0xf7, 0x91, 0x7d, 0x47, 0xb7, 0x19, 0x03, 0xea, 0x5a, 0x83, 0xc3, 0x76, 0x74, 0x1b,
-	0xa3, 0xaf, 0x41, 0x8d, 0xa8, 0x6c, 0xdf, 0x34, 0xc2, 0xed, 0x9f, 0xa3, 0x2a, 0x6c, 0xf8, 0xe8,
-	0x1a, 0x00, 0x6d, 0xd2, 0x0d, 0xc3, 0x63, 0x2e, 0xb4, 0xae, 0xd5, 0x09, 0xe4, 0x0e, 0x01, 0xa8,
-	0x7f, 0x54, 0x80, 0xeb, 0x7b, 0xa7, 0xce, 0x60, 0x07, 0x9f, 0x6c, 0x7a, 0x58, 0x0f, 0x70, 0x6c,
-	0xb4, 0x3f, 0x57, 0xc6, 0xa3, 0x65, 0x68, 0x24, 0xf4, 0x97, 0x8b, 0x64, 0x12, 0xa4, 0xfe, 0x4d,
-	0x01, 0x9a, 0xc4, 0x8b, 0x7c, 0x80, 0x03, 0x9d, 0x88, 0x08, 0xfa, 0x0e, 0xd4, 0x2d, 0x57, 0x37,
-	0xfa, 0xc1, 0xe9, 0x88, 0x51, 0xd3, 0x4e, 0x53, 0x13, 0xbb, 0x9e, 0xfd, 0xd3, 0x11, 0xd6, 0x6a,
-	0x16, 0xff, 0x95, 0x8b, 0xa2, 0xb4, 0x95, 0x29, 0x49, 0x2c, 0xe5, 0x73, 0xd0, 0xb0, 0x71, 0xe0,
-	0x99, 0x03, 0x46, 0x44, 0x99, 0x6e, 0x05, 0x30, 0x10, 0x41, 0xa4, 0xfe, 0xa4, 0x0a, 0x97, 0x7f,
-	0xa0, 0x07, 0x83, 0xa3, 0x2d, 0x3b, 0x8c, 0x62, 0x2e, 0xce, 0xc7, 0xd8, 0x2e, 0x17, 0x93, 0x76,
-	0xf9, 0x99, 0xd9, 0xfd, 0x48, 0x47, 0x2b, 0x32, 0x1d, 0x25, 0x89, 0xf9, 0xda, 0x23, 0x2e, 0x66,
-	0x09, 0x1d, 0x4d, 0x04, 0x1b, 0xd5, 0x8b, 0x04, 0x1b, 0x9b, 0xd0, 0xc2, 0x4f, 0x07, 0xd6, 0x98,
-	0xc8, 0x2b, 0xc5, 0xce, 0xa2, 0x88, 0xeb, 0x12, 0xec, 0x49, 0x03, 0xd1, 0xe4, 0x83, 0xb6, 0x39,
-	0x0d, 0x4c, 0x16, 0x6c, 0x1c, 0xe8, 0x34, 0x54, 0x68, 0x6c, 0x2c, 0x4f, 0x92, 0x85, 0x50, 0x80,
-	0x98, 0x3c, 0x90, 0x2f, 0x74, 0x15, 0xea, 0x3c, 0xb4, 0xd9, 0xde, 0xea, 0xd6, 0x29, 0xfb, 0x62,
-	0x00, 0xd2, 0xa1, 0xc5, 0xad, 0x27, 0xa7, 0x90, 0x05, 0x10, 0x6f, 0xc9, 0x10, 0xc8, 0x37, 0x3b,
-	0x49, 0xb9, 0xcf, 0x03, 0x1d, 0x3f, 0x01, 0x22, 0x99, 0xbf, 0x7b, 0x78, 0x68, 0x99, 0x0e, 0xde,
-	0x61, 0x3b, 0xdc, 0xa0, 0x44, 0x88, 0x40, 0x12, 0x0e, 0x1d, 0x63, 0xcf, 0x37, 0x5d, 0xa7, 0xdb,
-	0xa4, 0xed, 0xe1, 0xa7, 0x2c, 0xca, 0x69, 0x5d, 0x20, 0xca, 0xe9, 0xc3, 0x7c, 0x86, 0x52, 0x49,
-	0x94, 0xf3, 0xad, 0x64, 0x94, 0x33, 0x7d, 0xab, 0x12, 0x51, 0xd0, 0x4f, 0x0b, 0xb0, 0xf4, 0xd0,
-	0xf1, 0xc7, 0x07, 0x11, 0x8b, 0xbe, 0x18, 0x75, 0x48, 0x1b, 0xd1, 0x72, 0xc6, 0x88, 0xaa, 0xff,
-	0x53, 0x81, 0x0e, 0x5f, 0x05, 0x91, 0x1a, 0x6a, 0x72, 0xae, 0x42, 0x3d, 0xf2, 0xa3, 0x9c, 0x21,
-	0x31, 0x20, 0x6d, 0xc3, 0x8a, 0x19, 0x1b, 0x96, 0x8b, 0xb4, 0x30, 0x2a, 0x2a, 0x27, 0xa2, 0xa2,
-	0x6b, 0x00, 0x87, 0xd6, 0xd8, 0x3f, 0xea, 0x07, 0xa6, 0x8d, 0x79, 0x54, 0x56, 0xa7, 0x90, 0x7d,
-	0xd3, 0xc6, 0xe8, 0x0e, 0x34, 0x0f, 0x4c, 0xc7, 0x72, 0x87, 0xfd, 0x91, 0x1e, 0x1c, 0xf9, 0x3c,
-	0x2d, 0x96, 0x6d, 0x0b, 0x8d, 0x61, 0xef, 0xd2, 0xbe, 0x5a, 0x83, 0x8d, 0xd9, 0x25, 0x43, 0xd0,
-	0x75, 0x68, 0x38, 0x63, 0xbb, 0xef, 0x1e, 0xf6, 0x3d, 0xf7, 0xc4, 0xa7, 0xc9, 0x6f, 0x49, 0xab,
-	0x3b, 0x63, 0xfb, 0xc3, 0x43, 0xcd, 0x3d, 0x21, 0x7e, 0xac, 0x4e, 0x3c, 0x9a, 0x6f, 0xb9, 0x43,
-	0x96, 0xf8, 0x4e, 0x9f, 0x3f, 0x1e, 0x40, 0x46, 0x1b, 0xd8, 0x0a, 0x74, 0x3a, 0xba, 0x9e, 0x6f,
-	0x74, 0x34, 0x00, 0xbd, 0x04, 0xed, 0x81, 0x6b, 0x8f, 0x74, 0xca, 0xa1, 0x7b, 0x9e, 0x6b, 0x53,
-	0x05, 0x2c, 0x69, 0x29, 0x28, 0xda, 0x84, 0x46, 0xac, 0x04, 0x7e, 0xb7, 0x41, 0xf1, 0xa8, 0x32,
-	0x2d, 0x4d, 0x84, 0xf2, 0x44, 0x40, 0x21, 0xd2, 0x02, 0x9f, 0x48, 0x46, 0xa8, 0xec, 0xbe, 0xf9,
-	0x29, 0xe6, 0x8a, 0xd6, 0xe0, 0xb0, 0x3d, 0xf3, 0x53, 0x4c, 0xd2, 0x23, 0xd3, 0xf1, 0xb1, 0x17,
-	0x84, 0xc9, 0x6a, 0xb7, 0x45, 0xc5, 0xa7, 0xc5, 0xa0, 0x5c, 0xb0, 0xd1, 0x16, 0xb4, 0xfd, 0x40,
-	0xf7, 0x82, 0xfe, 0xc8, 0xf5, 0xa9, 0x00, 0x74, 0xdb, 0x54, 0xb6, 0x53, 0x2a, 0x69, 0xfb, 0x43,
-	0x22, 0xd8, 0xbb, 0xbc, 0x93, 0xd6, 0xa2, 0x83, 0xc2, 0x4f, 0x32, 0x0b, 0xe5, 0x44, 0x3c, 0x4b,
-	0x27, 0xd7, 0x2c, 0x74, 0x50, 0x34, 0xcb, 0x0a, 0x49, 0x97, 0x74, 0x43, 0x3f, 0xb0, 0xf0, 0x23,
-	0x6e, 0x41, 0x14, 0xba, 0xb0, 0x34, 0x58, 0xfd, 0xef, 0x22, 0xb4, 0x45, 0xf6, 0x10, 0xb3, 0xc3,
-	0xb2, 0xb2, 0x50, 0xe6, 0xc3, 0x4f, 0xc2, 0x2c, 0xec, 0x90, 0xd1, 0x2c, 0x05, 0xa4, 0x22, 0x5f,
-	0xd3, 0x1a, 0x0c, 0x46, 0x27, 0x20, 0xa2, 0xcb, 0x36, 0x85, 0xea, 0x59, 0x89, 0x32, 0xaa, 0x4e,
-	0x21, 0x34, 0x54, 0xe9, 0xc2, 0x5c, 0x98, 0x3d, 0x32, 0x81, 0x0f, 0x3f, 0x49, 0xcb, 0xc1, 0xd8,
-	0xa4, 0x58, 0x99, 0xc0, 0x87, 0x9f, 0x68, 0x0b, 0x9a, 0x6c, 0xca, 0x91, 0xee, 0xe9, 0x76, 0x28,
-	0xee, 0xcf, 0x4b, 0x4d, 0xc6, 0xfb, 0xf8, 0xf4, 0x11, 0xb1, 0x3e, 0xbb, 0xba, 0xe9, 0x69, 0x4c,
-	0x3c, 0x76, 0xe9, 0x28, 0xb4, 0x02, 0x0a, 0x9b, 0xe5, 0xd0, 0xb4, 0x30, 0x57, 0x9c, 0x39, 0x96,
-	0x42, 0x52, 0xf8, 0x3d, 0xd3, 0xc2, 0x4c, 0x37, 0xa2, 0x25, 0x50, 0x81, 0xa8, 0x31, 0xd5, 0xa0,
-	0x10, 0x2a, 0x0e, 0x37, 0x80, 0x59, 0xd1, 0x7e, 0x68, 0x9b, 0x99, 0x03, 0x61, 0x34, 0x72, 0xb6,
-	0xd2, 0x90, 0x6c, 0x6c, 0x33, 0xe5, 0x02, 0xb6, 0x1c, 0x67, 0x6c, 0x13, 0xd5, 0x52, 0x7f, 0xbf,
-	0x02, 0x0b, 0xc4, 0xc2, 0x70, 0x63, 0x33, 0x43, 0x80, 0x70, 0x0d, 0xc0, 0xf0, 0x83, 0xbe, 0x60,
-	0x15, 0xeb, 0x86, 0x1f, 0x70, 0xf7, 0xf1, 0x9d, 0xd0, 0xbf, 0x97, 0x26, 0xa7, 0x2b, 0x29, 0x8b,
-	0x97, 0xf5, 0xf1, 0x17, 0xaa, 0xef, 0xdd, 0x80, 0x16, 0xcf, 0xd5, 0x85, 0xc4, 0xb2, 0xc9, 0x80,
-	0x3b, 0x72, 0xbb, 0x5d, 0x95, 0xd6, 0x19, 0x13, 0x7e, 0x7e, 0x6e, 0x36, 0x3f, 0x5f, 0x4b, 0xfb,
-	0xf9, 0x7b, 0xd0, 0x11, 0x55, 0x2d, 0xb4, 0x55, 0x53, 0x74, 0xad, 0x2d, 0xe8, 0x9a, 0x9f, 0x74,
-	0xd3, 0x20, 0xba, 0xe9, 0x1b, 0xd0, 0x72, 0x30, 0x36, 0xfa, 0x81, 0xa7, 0x3b, 0xfe, 0x21, 0xf6,
-	0xa8, 0x9b, 0xaf, 0x69, 0x4d, 0x02, 0xdc, 0xe7, 0x30, 0xf4, 0x16, 0x00, 0x5d, 0x23, 0x2b, 0x4f,
-	0x35, 0x27, 0x97, 0xa7, 0xa8, 0xd0, 0xd0, 0xf2, 0x14, 0x65, 0x0a, 0xfd, 0xf9, 0x8c, 0x22, 0x01,
-	0xf5, 0x5f, 0x8a, 0x70, 0x99, 0x97, 0x2b, 0x66, 0x97, 0xcb, 0x49, 0x9e, 0x3a, 0x74, 0x75, 0xa5,
-	0x33, 0x0a, 0x00, 0xe5, 0x1c, 0xc1, 0x6c, 0x45, 0x12, 0xcc, 0x8a, 0x49, 0x70, 0x35, 0x93, 0x04,
-	0x47, 0xf5, 0xbf, 0xb9, 0xfc, 0xf5, 0x3f, 0xb4, 0x08, 0x15, 0x9a, 0x99, 0x51, 0xd9, 0xa9, 0x6b,
-	0xec, 0x23, 0xd7, 0xae, 0xaa, 0x7f, 0x58, 0x84, 0xd6, 0x1e, 0xd6, 0xbd, 0xc1, 0x51, 0xc8, 0xc7,
-	0x37, 0x92, 0xf5, 0xd2, 0x17, 0x26, 0xd4, 0x4b, 0x85, 0x21, 0x5f, 0x99, 0x42, 0x29, 0x41, 0x10,
-	0xb8, 0x81, 0x1e, 0x51, 0xd9, 0x77, 0xc6, 0x36, 0x2f, 0x22, 0x76, 0x68, 0x03, 0x27, 0x75, 0x67,
-	0x6c, 0xab, 0xff, 0x55, 0x80, 0xe6, 0xf7, 0xc9, 0x34, 0x21, 0x63, 0x6e, 0x27, 0x19, 0xf3, 0xd2,
-	0x04, 0xc6, 0x68, 0x24, 0xc9, 0xc2, 0xc7, 0xf8, 0x2b, 0x57, 0x43, 0xfe, 0xa7, 0x02, 0xf4, 0x48,
-	0x8a, 0xad, 0x31, 0xbb, 0x33, 0xbb, 0x76, 0xdd, 0x80, 0xd6, 0xb1, 0x10, 0xcc, 0x16, 0xa9, 0x70,
-	0x36, 0x8f, 0x93, 0x25, 0x01, 0x0d, 0x94, 0xb0, 0xa4, 0xcb, 0x17, 0x1b, 0xba, 0x81, 0x97, 0x65,
-	0x54, 0xa7, 0x88, 0xa3, 0x16, 0xa2, 0xe3, 0x89, 0x40, 0xf5, 0x77, 0x0a, 0xb0, 0x20, 0xe9, 0x88,
-	0xae, 0xc0, 0x1c, 0x2f, 0x3f, 0xf0, 0x78, 0x81, 0xe9, 0xbb, 0x41, 0xb6, 0x27, 0x2e, 0xa0, 0x99,
-	0x46, 0x36, 0x42, 0x36, 0x48, 0x46, 0x1d, 0xe5, 0x5a, 0x46, 0x66, 0x7f, 0x0c, 0x1f, 0xf5, 0xa0,
-	0xc6, 0xad, 0x69, 0x98, 0xc4, 0x46, 0xdf, 0xea, 0x63, 0x40, 0xf7, 0x71, 0xec, 0xbb, 0x66, 0xe1,
-	0x68, 0x6c, 0x6f, 0x62, 0x42, 0x93, 0x46, 0xc8, 0x50, 0xff, 0xa3, 0x00, 0x0b, 0x02, 0xb6, 0x59,
-	0xca, 0x44, 0xb1, 0x7f, 0x2d, 0x5e, 0xc4, 0xbf, 0x0a, 0xa5, 0x90, 0xd2, 0xb9, 0x4a, 0x21, 0xd7,
-	0x01, 0x22, 0xfe, 0x87, 0x1c, 0x4d, 0x40, 0xd4, 0x7f, 0x28, 0xc0, 0xe5, 0xf7, 0x74, 0xc7, 0x70,
-	0x0f, 0x0f, 0x67, 0x17, 0xd5, 0x4d, 0x10, 0xd2, 0xde, 0xbc, 0xc5, 0x40, 0x31, 0x57, 0x7e, 0x05,
-	0xe6, 0x3d, 0xe6, 0x99, 0x0c, 0x51, 0x96, 0x4b, 0x9a, 0x12, 0x36, 0x44, 0x32, 0xfa, 0x57, 0x45,
-	0x40, 0x64, 0xd5, 0x77, 0x75, 0x4b, 0x77, 0x06, 0xf8, 0xe2, 0xa4, 0xbf, 0x08, 0x6d, 0x21, 0x84,
-	0x89, 0x0e, 0xe7, 0x93, 0x31, 0x8c, 0x8f, 0xde, 0x87, 0xf6, 0x01, 0x43, 0xd5, 0xf7, 0xb0, 0xee,
-	0xbb, 0x0e, 0xdf, 0x0e, 0x69, 0xdd, 0x6f, 0xdf, 0x33, 0x87, 0x43, 0xec, 0x6d, 0xba, 0x8e, 0xc1,
-	0xa3, 0xf6, 0x83, 0x90, 0x4c, 0x32, 0x94, 0x28, 0x43, 0x1c, 0xcf, 0x45, 0x9b, 0x13, 0x05, 0x74,
-	0x94, 0x15, 0x3e, 0xd6, 0xad, 0x98, 0x11, 0xb1, 0x37, 0x54, 0x58, 0xc3, 0xde, 0xe4, 0xb2, 0xaf,
-	0x24, 0xbe, 0x52, 0xff, 0xb6, 0x00, 0x28, 0x4a, 0xcd, 0x69, 0x2d, 0x83, 0x6a, 0x74, 0x7a, 0x68,
-	0x41, 0xe2, 0x94, 0xaf, 0x42, 0xdd, 0x08, 0x47, 0x72, 0x13, 0x14, 0x03, 0xa8, 0x8f, 0xa4, 0x44,
-	0xf7, 0x89, 0xe4, 0x61, 0x23, 0x4c, 0x7d, 0x19, 0xf0, 0x01, 0x85, 0x89, 0xe1, 0x59, 0x39, 0x1d,
-	0x9e, 0x25, 0xab, 0x9a, 0x15, 0xa1, 0xaa, 0xa9, 0xfe, 0xb4, 0x08, 0x0a, 0x75, 0x21, 0x9b, 0x71,
-	0x79, 0x2a, 0x17, 0xd1, 0x37, 0xa0, 0xc5, 0x2f, 0xb7, 0x08, 0x84, 0x37, 0x9f, 0x24, 0x26, 0x43,
-	0x37, 0x61, 0x91, 0x75, 0xf2, 0xb0, 0x3f, 0xb6, 0xe2, 0xac, 0x8f, 0x25, 0x33, 0xe8, 0x09, 0xf3,
-	0x5d, 0xa4, 0x29, 0x1c, 0xf1, 0x10, 0x2e, 0x0f, 0x2d, 0xf7, 0x40, 0xb7, 0xfa, 0xe2, 0xf6, 0xb0,
-	0x3d, 0xcc, 0x21, 0xf1, 0x8b, 0x6c, 0xf8, 0x5e, 0x72, 0x0f, 0x7d, 0x74, 0x17, 0x5a, 0x3e, 0xc6,
-	0x8f, 0xe3, 0x54, 0xb0, 0x92, 0x27, 0x15, 0x6c, 0x92, 0x31, 0xe1, 0x97, 0xfa, 0x27, 0x05, 0xe8,
-	0xa4, 0xce, 0x24, 0xd2, 0x85, 0x8b, 0x42, 0xb6, 0x70, 0x71, 0x1b, 0x2a, 0xc4, 0x52, 0x31, 0xdf,
-	0xd2, 0x96, 0x27, 0xd5, 0xe2, 0xac, 0x1a, 0x1b, 0x80, 0xd6, 0x61, 0x41, 0x72, 0xf7, 0x81, 0x6f,
-	0x3f, 0xca, 0x5e, 0x7d, 0x50, 0x7f, 0x56, 0x86, 0x46, 0x82, 0x15, 0x53, 0x6a, 0x2e, 0xcf, 0xa4,
-	0xb6, 0x3c, 0xe9, 0xac, 0x9b, 0x88, 0x9c, 0x8d, 0x6d, 0x96, 0xf7, 0xf1, 0x24, 0xd4, 0xc6, 0x36,
-	0xcd, 0xfa, 0x92, 0x09, 0x5d, 0x55, 0x48, 0xe8, 0x52, 0x29, 0xef, 0xdc, 0x19, 0x29, 0x6f, 0x4d,
-	0x4c, 0x79, 0x05, 0x15, 0xaa, 0xa7, 0x55, 0x28, 0x6f, 0x19, 0xe4, 0x26, 0x2c, 0x0c, 0x58, 0xed,
-	0xfe, 0xee, 0xe9, 0x66, 0xd4, 0xc4, 0x83, 0x52, 0x59, 0x13, 0xba, 0x17, 0x17, 0x38, 0xd9, 0x2e,
-	0xb3, 0xa4, 0x43, 0x9e, 0x51, 0xf3, 0xbd, 0x61, 0x9b, 0x1c, 0x5a, 0x66, 0xfa, 0x95, 0x2e, 0xc0,
-	0xb4, 0x2e, 0x54, 0x80, 0x79, 0x0e, 0x1a, 0x61, 0xa4, 0x42, 0x34, 0xbd, 0xcd, 0x8c, 0x5e, 0x68,
-	0x06, 0x0c, 0x5f, 0xb0, 0x03, 0x1d, 0xf1, 0x74, 0x23, 0x5d, 0x8f, 0x50, 0xb2, 0xf5, 0x88, 0x2b,
-	0x30, 0x67, 0xfa, 0xfd, 0x43, 0xfd, 0x31, 0xee, 0xce, 0xd3, 0xd6, 0xaa, 0xe9, 0xdf, 0xd3, 0x1f,
-	0x63, 0xf5, 0xdf, 0x4a, 0xd0, 0x8e, 0x1d, 0x6c, 0x6e, 0x0b, 0x92, 0xe7, 0xfe, 0xcf, 0x0e, 0x28,
-	0x71, 0xdc, 0x43, 0x39, 0x7c, 0x66, 0x0e, 0x9e, 0x3e, 0x32, 0xec, 0x8c, 0x52, 0xfa, 0x2a, 0xb8,
-	0xfb, 0xf2, 0xb9, 0xdc, 0xfd, 0x8c, 0x37, 0x03, 0x6e, 0xc1, 0x52, 0xe4, 0x7b, 0x85, 0x65, 0xb3,
-	0x04, 0x6b, 0x31, 0x6c, 0xdc, 0x4d, 0x2e, 0x7f, 0x82, 0x09, 0x98, 0x9b, 0x64, 0x02, 0xd2, 0x22,
-	0x50, 0xcb, 0x88, 0x40, 0xf6, 0x82, 0x42, 0x5d, 0x72, 0x41, 0x41, 0x7d, 0x08, 0x0b, 0xb4, 0xd8,
-	0xec, 0x0f, 0x3c, 0xf3, 0x00, 0x47, 0x29, 0x40, 0x9e, 0x6d, 0xed, 0x41, 0x2d, 0x95, 0x45, 0x44,
-	0xdf, 0xea, 0x8f, 0x0b, 0x70, 0x39, 0x3b, 0x2f, 0x95, 0x98, 0xd8, 0x90, 0x14, 0x04, 0x43, 0xf2,
-	0xcb, 0xb0, 0x90, 0x88, 0x28, 0x85, 0x99, 0x27, 0x44, 0xe0, 0x12, 0xc2, 0x35, 0x14, 0xcf, 0x11,
-	0xc2, 0xd4, 0x9f, 0x15, 0xa2, 0x9a, 0x3d, 0x81, 0x0d, 0xe9, 0x81, 0x08, 0xf1, 0x6b, 0xae, 0x63,
-	0x99, 0x4e, 0x54, 0x70, 0xe1, 0x6b, 0x64, 0x40, 0x5e, 0x70, 0x79, 0x0f, 0x3a, 0xbc, 0x53, 0xe4,
-	0x9e, 0x72, 0x06, 0x64, 0x6d, 0x36, 0x2e, 0x72, 0x4c, 0x2f, 0x42, 0x9b, 0x9f, 0x54, 0x84, 0xf8,
-	0x4a, 0xb2, 0xf3, 0x8b, 0xef, 0x81, 0x12, 0x76, 0x3b, 0xaf, 0x43, 0xec, 0xf0, 0x81, 0x51, 0x60,
-	0xf7, 0x9b, 0x05, 0xe8, 0x8a, 0xee, 0x31, 0xb1, 0xfc, 0xf3, 0x87, 0x77, 0x6f, 0x8a, 0xe7, 0xd3,
-	0x2f, 0x9e, 0x41, 0x4f, 0x8c, 0x27, 0x3c, 0xa5, 0xfe, 0xbd, 0x22, 0xbd, 0x6c, 0x40, 0x52, 0xbd,
-	0x2d, 0xd3, 0x0f, 0x3c, 0xf3, 0x60, 0x3c, 0xdb, 0x89, 0xa9, 0x0e, 0x8d, 0xc1, 0x11, 0x1e, 0x3c,
-	0x1e, 0xb9, 0x66, 0xbc, 0x2b, 0xef, 0xc8, 0x68, 0x9a, 0x8c, 0x76, 0x6d, 0x33, 0x9e, 0x81, 0x1d,
-	0x39, 0x25, 0xe7, 0xec, 0xfd, 0x10, 0x94, 0x74, 0x87, 0xe4, 0x49, 0x4f, 0x9d, 0x9d, 0xf4, 0xdc,
-	0x12, 0x4f, 0x7a, 0xa6, 0x44, 0x1a, 0x89, 0x83, 0x9e, 0xbf, 0x2b, 0xc2, 0xd7, 0xa5, 0xb4, 0xcd,
-	0x92, 0x25, 0x4d, 0xaa, 0x23, 0xdd, 0x85, 0x5a, 0x2a, 0xa9, 0x7d, 0xe9, 0x8c, 0xfd, 0xe3, 0x25,
-	0x59, 0x56, 0x1a, 0xf4, 0xe3, 0xd8, 0x2a, 0x56, 0xf8, 0xf2, 0xe4, 0x39, 0xb8, 0xde, 0x09, 0x73,
-	0x84, 0xe3, 0xd0, 0x1d, 0x68, 0xb2, 0x82, 0x41, 0xff, 0xd8, 0xc4, 0x27, 0xe1, 0x39, 0xea, 0x75,
-	0xa9, 0x69, 0xa6, 0xfd, 0x1e, 0x99, 0xf8, 0x44, 0x6b, 0x58, 0xd1, 0x6f, 0x5f, 0xfd, 0x83, 0x32,
-	0x40, 0xdc, 0x46, 0xb2, 0xb3, 0x58, 0xe7, 0xb9, 0x12, 0x27, 0x20, 0x24, 0x96, 0x10, 0x23, 0xd7,
-	0xf0, 0x13, 0x69, 0xf1, 0x39, 0x86, 0x61, 0xfa, 0x01, 0xe7, 0xcb, 0xfa, 0xd9, 0xb4, 0x84, 0x2c,
-	0x22, 0x5b, 0xc6, 0x65, 0xc6, 0x8f, 0x21, 0xe8, 0x35, 0x40, 0x43, 0xcf, 0x3d, 0x31, 0x9d, 0x61,
-	0x32, 0xdf, 0x60, 0x69, 0xc9, 0x3c, 0x6f, 0x49, 0x24, 0x1c, 0x3f, 0x02, 0x25, 0xd5, 0x3d, 0x64,
-	0xc9, 0xad, 0x29, 0x64, 0xdc, 0x17, 0xe6, 0xe2, 0xe2, 0xdb, 0x11, 0x31, 0xd0, 0x43, 0xd3, 0x7d,
-	0xdd, 0x1b, 0xe2, 0x70, 0x47, 0x79, 0x1c, 0x26, 0x02, 0x7b, 0x7d, 0x50, 0xd2, 0xab, 0x92, 0x1c,
-	0x69, 0xbe, 0x2e, 0x0a, 0xfa, 0x59, 0xf6, 0x88, 0x4c, 0x93, 0x10, 0xf5, 0x9e, 0x0e, 0x8b, 0x32,
-	0x7a, 0x25, 0x48, 0x2e, 0xac, 0x4d, 0xef, 0x44, 0x21, 0x31, 0xdd, 0x87, 0x49, 0x5e, 0x26, 0x51,
-	0x78, 0x2e, 0x0a, 0x85, 0x67, 0xf5, 0xd7, 0x4b, 0x80, 0xb2, 0xe2, 0x8f, 0xda, 0x50, 0x8c, 0x26,
-	0x29, 0x6e, 0x6f, 0xa5, 0xc4, 0xad, 0x98, 0x11, 0xb7, 0xab, 0x50, 0x8f, 0xbc, 0x3e, 0x37, 0xf1,
-	0x31, 0x20, 0x29, 0x8c, 0x65, 0x51, 0x18, 0x13, 0x84, 0x55, 0xc4, 0x8a, 0xf8, 0x4d, 0x58, 0xb4,
-	0x74, 0x3f, 0xe8, 0xb3, 0xc2, 0x7b, 0x60, 0xda, 0xd8, 0x0f, 0x74, 0x7b, 0x44, 0xb7, 0xb2, 0xac,
-	0x21, 0xd2, 0xb6, 0x45, 0x9a, 0xf6, 0xc3, 0x16, 0xb4, 0x1f, 0x46, 0xd7, 0xc4, 0xf6, 0xf2, 0xcb,
-	0x02, 0xaf, 0xe7, 0x53, 0xf7, 0xb8, 0xdc, 0xcd, 0x24, 0xaa, 0x1e, 0x85, 0x9d, 0xbd, 0x4f, 0xa0,
-	0x2d, 0x36, 0x4a, 0xb6, 0xef, 0xb6, 0xb8, 0x7d, 0x79, 0x02, 0xdb, 0xc4, 0x1e, 0x1e, 0x01, 0xca,
-	0x1a, 0x8f, 0x24, 0xcf, 0x0a, 0x22, 0xcf, 0xa6, 0xed, 0x45, 0x82, 0xa7, 0x25, 0x71, 0xb3, 0xff,
-	0xac, 0x04, 0x28, 0x8e, 0xe0, 0xa2, 0xc3, 0xeb, 0x3c, 0x61, 0xcf, 0x3a, 0x2c, 0x64, 0xe3, 0xbb,
-	0x30, 0xa8, 0x45, 0x99, 0xe8, 0x4e, 0x16, 0x89, 0x95, 0x64, 0x57, 0x45, 0xdf, 0x88, 0xcc, 0x3d,
-	0x0b, 0x57, 0xaf, 0x4f, 0x3c, 0xcf, 0x10, 0x2d, 0xfe, 0x0f, 0xd3, 0x57, 0x4c, 0x99, 0xfd, 0xb8,
-	0x2d, 0x35, 0xcd, 0x99, 0x25, 0x4f, 0xbd, 0x5f, 0x2a, 0x04, 0xd2, 0xd5, 0xf3, 0x04, 0xd2, 0xb3,
-	0x5f, 0x08, 0xfd, 0xf7, 0x22, 0xcc, 0x47, 0x8c, 0x3c, 0xd7, 0x26, 0x4d, 0xbf, 0x67, 0xf0, 0x39,
-	0xef, 0xca, 0xc7, 0xf2, 0x5d, 0xf9, 0xf6, 0x99, 0xc9, 0x4c, 0xde, 0x4d, 0x99, 0x9d, 0xb3, 0x9f,
-	0xc2, 0x1c, 0x2f, 0x4b, 0x67, 0x0c, 0x5c, 0x9e, 0x72, 0xc1, 0x22, 0x54, 0x88, 0x3d, 0x0d, 0x6b,
-	0x8a, 0xec, 0x83, 0xb1, 0x34, 0x79, 0xe1, 0x98, 0xdb, 0xb8, 0x96, 0x70, 0xdf, 0x58, 0xfd, 0xad,
-	0x12, 0xc0, 0xde, 0xa9, 0x33, 0xb8, 0xc3, 0x94, 0xf4, 0x26, 0x94, 0xa7, 0x5d, 0x4f, 0x23, 0xbd,
-	0xa9, 0x6c, 0xd1, 0x9e, 0x39, 0x36, 0x57, 0x28, 0x88, 0x94, 0xd2, 0x05, 0x91, 0x49, 0xa5, 0x8c,
-	0xc9, 0x26, 0xf8, 0xdb, 0x50, 0xa6, 0xa6, 0x94, 0xdd, 0xde, 0xca, 0x75, 0x2a, 0x4c, 0x07, 0xa0,
-	0x15, 0x08, 0x5d, 0xf2, 0xb6, 0xc3, 0x7c, 0x2e, 0x35, 0xc7, 0x25, 0x2d, 0x0d, 0x46, 0x2f, 0x41,
-	0x9b, 0x15, 0xc2, 0xa2, 0x8e, 0x2c, 0xa7, 0x4b, 0x41, 0xb3, 0x1e, 0xbd, 0x2e, 0xf1, 0xe8, 0x04,
-	0xaf, 0xe1, 0xb9, 0xa3, 0x51, 0x62, 0x3a, 0x56, 0x09, 0x49, 0x83, 0xd5, 0xcf, 0x8a, 0x70, 0x85,
-	0xf0, 0xf7, 0xd9, 0x44, 0xe5, 0x79, 0x84, 0x27, 0x61, 0xcf, 0x4b, 0xa2, 0x3d, 0xbf, 0x0d, 0x73,
-	0xac, 0xdc, 0x12, 0xc6, 0x97, 0xd7, 0x27, 0x49, 0x03, 0x93, 0x1d, 0x2d, 0xec, 0x3e, 0x6b, 0xce,
-	0x2e, 0x9c, 0x99, 0x57, 0x67, 0x3b, 0x33, 0x9f, 0x4b, 0x17, 0x65, 0x13, 0x62, 0x55, 0x13, 0xbd,
-	0xd0, 0x43, 0x68, 0x69, 0x49, 0xd5, 0x40, 0x08, 0xca, 0x89, 0x0b, 0xab, 0xf4, 0x37, 0x4d, 0xb3,
-	0xf5, 0x91, 0x3e, 0x30, 0x83, 0x53, 0xca, 0xce, 0x8a, 0x16, 0x7d, 0xcb, 0xf5, 0x50, 0xfd, 0xdf,
-	0x02, 0x5c, 0x0e, 0x0f, 0x55, 0xb9, 0x96, 0x5f, 0x7c, 0x47, 0x37, 0x60, 0x89, 0xab, 0x74, 0x4a,
-	0xb7, 0x59, 0x30, 0xbd, 0xc0, 0x60, 0xe2, 0x32, 0x36, 0x60, 0x29, 0xa0, 0xd2, 0x95, 0x1e, 0xc3,
-	0xf6, 0x7b, 0x81, 0x35, 0x8a, 0x63, 0xf2, 0x1c, 0x6a, 0x3f, 0xc7, 0x6e, 0x60, 0x71, 0xd6, 0x72,
-	0x25, 0x05, 0x67, 0x6c, 0xf3, 0x55, 0xaa, 0x27, 0x70, 0x95, 0x5d, 0x19, 0x3f, 0x10, 0x29, 0x9a,
-	0xe9, 0x4c, 0x43, 0xba, 0xee, 0x94, 0x4d, 0xfb, 0xd3, 0x02, 0x5c, 0x9b, 0x80, 0x79, 0x96, 0x6c,
-	0xee, 0x81, 0x14, 0xfb, 0x84, 0xdc, 0x5b, 0xc0, 0xcb, 0x2e, 0x2c, 0x88, 0x44, 0x7e, 0x56, 0x86,
-	0xf9, 0x4c, 0xa7, 0x73, 0xcb, 0xdc, 0xab, 0x80, 0xc8, 0x26, 0x44, 0xcf, 0x23, 0x69, 0x39, 0x83,
-	0x3b, 0x4f, 0xc5, 0x19, 0xdb, 0xd1, 0xd3, 0xc8, 0x1d, 0xd7, 0xc0, 0xc8, 0x64, 0xbd, 0xd9, 0x89,
-	0x46, 0xb4, 0x73, 0xe5, 0xc9, 0xaf, 0x60, 0x32, 0x04, 0xae, 0xed, 0x8c, 0x6d, 0x76, 0xf8, 0xc1,
-	0x77, 0x99, 0x39, 0x44, 0x82, 0x4a, 0x00, 0xa3, 0x43, 0x98, 0xa7, 0xf7, 0xf3, 0xc6, 0xc1, 0xd0,
-	0x25, 0x09, 0x15, 0xa5, 0x8b, 0xb9, 0xdd, 0xef, 0xe6, 0xc6, 0xf4, 0x21, 0x1f, 0x4d, 0x88, 0xe7,
-	0x39, 0x95, 0x23, 0x42, 0x43, 0x3c, 0xa6, 0x33, 0x70, 0xed, 0x08, 0x4f, 0xf5, 0x9c, 0x78, 0xb6,
-	0xf9, 0x68, 0x11, 0x4f, 0x12, 0xda, 0xdb, 0x84, 0x25, 0xe9, 0xd2, 0xa7, 0x39, 0xfa, 0x4a, 0x32,
-	0xf3, 0xba, 0x0b, 0x8b, 0xb2, 0x55, 0x5d, 0x60, 0x8e, 0x0c, 0xc5, 0xe7, 0x99, 0x43, 0xfd, 0xcb,
-	0x22, 0xb4, 0xb6, 0xb0, 0x85, 0x03, 0xfc, 0xf9, 0x9e, 0x39, 0x67, 0x0e, 0xd0, 0x4b, 0xd9, 0x03,
-	0xf4, 0xcc, 0x6d, 0x80, 0xb2, 0xe4, 0x36, 0xc0, 0xb5, 0xe8, 0x12, 0x04, 0x99, 0xa5, 0x22, 0xc6,
-	0x10, 0x06, 0x7a, 0x13, 0x9a, 0x23, 0xcf, 0xb4, 0x75, 0xef, 0xb4, 0xff, 0x18, 0x9f, 0xfa, 0xdc,
-	0x69, 0x74, 0xa5, 0x6e, 0x67, 0x7b, 0xcb, 0xd7, 0x1a, 0xbc, 0xf7, 0xfb, 0xf8, 0x94, 0x5e, 0xb0,
-	0x88, 0xd2, 0x38, 0x76, 0xa3, 0xae, 0xac, 0x25, 0x20, 0xab, 0xaf, 0x40, 0x3d, 0xba, 0xb8, 0x84,
-	0x6a, 0x50, 0xbe, 0x37, 0xb6, 0x2c, 0xe5, 0x12, 0xaa, 0x43, 0x85, 0x26, 0x7a, 0x4a, 0x81, 0xfc,
-	0xa4, 0xb1, 0x9f, 0x52, 0x5c, 0xfd, 0x25, 0xa8, 0x47, 0x17, 0x28, 0x50, 0x03, 0xe6, 0x1e, 0x3a,
-	0xef, 0x3b, 0xee, 0x89, 0xa3, 0x5c, 0x42, 0x73, 0x50, 0xba, 0x63, 0x59, 0x4a, 0x01, 0xb5, 0xa0,
-	0xbe, 0x17, 0x78, 0x58, 0x27, 0xdb, 0xa7, 0x14, 0x51, 0x1b, 0xe0, 0x3d, 0xd3, 0x0f, 0x5c, 0xcf,
-	0x1c, 0xe8, 0x96, 0x52, 0x5a, 0xfd, 0x14, 0xda, 0x62, 0x3d, 0x1d, 0x35, 0xa1, 0xb6, 0xe3, 0x06,
-	0xef, 0x3e, 0x35, 0xfd, 0x40, 0xb9, 0x44, 0xfa, 0xef, 0xb8, 0xc1, 0xae, 0x87, 0x7d, 0xec, 0x04,
-	0x4a, 0x01, 0x01, 0x54, 0x3f, 0x74, 0xb6, 0x4c, 0xff, 0xb1, 0x52, 0x44, 0x0b, 0xfc, 0xa8, 0x4c,
-	0xb7, 0xb6, 0x79, 0x91, 0x5a, 0x29, 0x91, 0xe1, 0xd1, 0x57, 0x19, 0x29, 0xd0, 0x8c, 0xba, 0xdc,
-	0xdf, 0x7d, 0xa8, 0x54, 0x18, 0xf5, 0xe4, 0x67, 0x75, 0xd5, 0x00, 0x25, 0x7d, 0xc4, 0x4b, 0xe6,
-	0x64, 0x8b, 0x88, 0x40, 0xca, 0x25, 0xb2, 0x32, 0x7e, 0xc6, 0xae, 0x14, 0x50, 0x07, 0x1a, 0x89,
-	0x13, 0x6b, 0xa5, 0x48, 0x00, 0xf7, 0xbd, 0xd1, 0x80, 0xcb, 0x16, 0x23, 0x81, 0x08, 0xea, 0x16,
-	0xe1, 0x44, 0x79, 0xf5, 0x2e, 0xd4, 0xc2, 0xfc, 0x84, 0x74, 0xe5, 0x2c, 0x22, 0x9f, 0xca, 0x25,
-	0x34, 0x0f, 0x2d, 0xe1, 0xe9, 0x9d, 0x52, 0x40, 0x08, 0xda, 0xe2, 0xe3, 0x58, 0xa5, 0xb8, 0xba,
-	0x01, 0x10, 0xc7, 0xf9, 0x84, 0x9c, 0x6d, 0xe7, 0x58, 0xb7, 0x4c, 0x83, 0xd1, 0x46, 0x9a, 0x08,
-	0x77, 0x29, 0x77, 0x98, 0xce, 0x2a, 0xc5, 0xd5, 0xb7, 0xa1, 0x16, 0xc6, 0xae, 0x04, 0xae, 0x61,
-	0xdb, 0x3d, 0xc6, 0x6c, 0x67, 0xf6, 0x70, 0xc0, 0xf6, 0xf1, 0x8e, 0x8d, 0x1d, 0x43, 0x29, 0x12,
-	0x32, 0x1e, 0x8e, 0x0c, 0x3d, 0x08, 0xaf, 0x99, 0x2a, 0xa5, 0x8d, 0xff, 0x5c, 0x00, 0x60, 0x67,
-	0xb6, 0xae, 0xeb, 0x19, 0xc8, 0xa2, 0x77, 0x37, 0x36, 0x5d, 0x7b, 0xe4, 0x3a, 0xe1, 0x81, 0x92,
-	0x8f, 0xd6, 0x52, 0x25, 0x12, 0xf6, 0x91, 0xed, 0xc8, 0x79, 0xd3, 0x7b, 0x41, 0xda, 0x3f, 0xd5,
-	0x59, 0xbd, 0x84, 0x6c, 0x8a, 0x6d, 0xdf, 0xb4, 0xf1, 0xbe, 0x39, 0x78, 0x1c, 0x1d, 0xf4, 0x4e,
-	0x7e, 0xb4, 0x9a, 0xea, 0x1a, 0xe2, 0xbb, 0x21, 0xc5, 0xb7, 0x17, 0x78, 0xa6, 0x33, 0x0c, 0xbd,
-	0xa3, 0x7a, 0x09, 0x3d, 0x49, 0x3d, 0x99, 0x0d, 0x11, 0x6e, 0xe4, 0x79, 0x25, 0x7b, 0x31, 0x94,
-	0x16, 0x74, 0x52, 0xff, 0x4d, 0x80, 0x56, 0xe5, 0x6f, 0x8f, 0x64, 0xff, 0xa3, 0xd0, 0x7b, 0x25,
-	0x57, 0xdf, 0x08, 0x9b, 0x09, 0x6d, 0xf1, 0x51, 0x3d, 0xfa, 0xc6, 0xa4, 0x09, 0x32, 0xaf, 0x1f,
-	0x7b, 0xab, 0x79, 0xba, 0x46, 0xa8, 0x3e, 0x62, 0xe2, 0x3b, 0x0d, 0x95, 0xf4, 0xc1, 0x69, 0xef,
-	0xac, 0xc0, 0x44, 0xbd, 0x84, 0x3e, 0x21, 0x31, 0x44, 0xea, 0x8d, 0x26, 0x7a, 0x55, 0xee, 0xf7,
-	0xe4, 0x4f, 0x39, 0xa7, 0x61, 0xf8, 0x28, 0xad, 0x7c, 0x93, 0xa9, 0xcf, 0x3c, 0xfe, 0xce, 0x4f,
-	0x7d, 0x62, 0xfa, 0xb3, 0xa8, 0x3f, 0x37, 0x06, 0x8b, 0xa5, 0x53, 0x92, 0xd7, 0x61, 0x69, 0x51,
-	0x8e, 0xb3, 0x99, 0xc9, 0x4f, 0xc9, 0xa6, 0x61, 0x1b, 0x53, 0x25, 0x4d, 0x5f, 0x56, 0x78, 0x6d,
-	0xc2, 0x31, 0x88, 0xfc, 0x59, 0x6a, 0x6f, 0x2d, 0x6f, 0xf7, 0xa4, 0x2c, 0x8b, 0x2f, 0x1f, 0xe5,
-	0x5b, 0x24, 0x7d, 0xad, 0x29, 0x97, 0x65, 0xf9, 0x43, 0x4a, 0xf5, 0x12, 0xda, 0x17, 0x4c, 0x3d,
-	0x7a, 0x69, 0x92, 0x28, 0x88, 0xb7, 0x97, 0xa6, 0xf1, 0xed, 0x57, 0x01, 0x31, 0x4d, 0x75, 0x0e,
-	0xcd, 0xe1, 0xd8, 0xd3, 0x99, 0x18, 0x4f, 0x32, 0x6e, 0xd9, 0xae, 0x21, 0x9a, 0x6f, 0x9e, 0x63,
-	0x44, 0xb4, 0xa4, 0x3e, 0xc0, 0x7d, 0x1c, 0x7c, 0x40, 0x9f, 0xc0, 0xf9, 0xe9, 0x15, 0xc5, 0xf6,
-	0x9b, 0x77, 0x08, 0x51, 0xbd, 0x3c, 0xb5, 0x5f, 0x84, 0xe0, 0x00, 0x1a, 0xf7, 0x49, 0x7e, 0x45,
-	0x63, 0x46, 0x1f, 0x4d, 0x1c, 0x19, 0xf6, 0x08, 0x51, 0xac, 0x4c, 0xef, 0x98, 0x34, 0x9e, 0xa9,
-	0x57, 0xa0, 0x68, 0xe2, 0xc6, 0x66, 0xdf, 0xa6, 0xca, 0x8d, 0xe7, 0x84, 0x67, 0xa5, 0x6c, 0x45,
-	0xf4, 0x28, 0xee, 0x3d, 0xac, 0x5b, 0xc1, 0xd1, 0x84, 0x15, 0x25, 0x7a, 0x9c, 0xbd, 0x22, 0xa1,
-	0x63, 0x84, 0x03, 0xc3, 0x02, 0xd3, 0x42, 0x31, 0x31, 0x5d, 0x97, 0x4f, 0x91, 0xed, 0x99, 0x53,
-	0xf4, 0x74, 0x98, 0xdf, 0xf2, 0xdc, 0x91, 0x88, 0xe4, 0x35, 0x29, 0x92, 0x4c, 0xbf, 0x9c, 0x28,
-	0x7e, 0x00, 0xcd, 0x30, 0xff, 0xa7, 0x19, 0x8b, 0x9c, 0x0b, 0xc9, 0x2e, 0x39, 0x27, 0xfe, 0x18,
-	0x3a, 0xa9, 0xc2, 0x82, 0x7c, 0xd3, 0xe5, 0xd5, 0x87, 0x69, 0xb3, 0x9f, 0x00, 0xa2, 0x4f, 0x7b,
-	0xc5, 0x7f, 0x27, 0x90, 0xc7, 0x37, 0xd9, 0x8e, 0x21, 0x92, 0xf5, 0xdc, 0xfd, 0xa3, 0x9d, 0xff,
-	0x35, 0x58, 0x92, 0x26, 0xef, 0x69, 0x83, 0xc0, 0xaf, 0x2b, 0x9f, 0x51, 0x61, 0x48, 0x1b, 0x84,
-	0x33, 0x47, 0x84, 0xf8, 0x37, 0x7e, 0x77, 0x1e, 0xea, 0x34, 0xce, 0xa3, 0xbb, 0xf5, 0x8b, 0x30,
-	0xef, 0xd9, 0x86, 0x79, 0x1f, 0x43, 0x27, 0xf5, 0xe4, 0x54, 0x2e, 0xb4, 0xf2, 0x77, 0xa9, 0x39,
-	0xa2, 0x15, 0xf1, 0xb5, 0xa6, 0xdc, 0x15, 0x4a, 0x5f, 0x74, 0x4e, 0x9b, 0xfb, 0x11, 0x7b, 0xce,
-	0x1d, 0x9d, 0xe6, 0xbe, 0x3c, 0xf1, 0xf0, 0x41, 0xbc, 0x76, 0xfc, 0xc5, 0x47, 0x41, 0x5f, 0xed,
-	0x08, 0xf4, 0x63, 0xe8, 0xa4, 0x1e, 0xf6, 0xc8, 0x25, 0x46, 0xfe, 0xfa, 0x67, 0xda, 0xec, 0x3f,
-	0xc7, 0xe0, 0xc9, 0x80, 0x05, 0xc9, 0x3b, 0x0a, 0xb4, 0x36, 0x29, 0x10, 0x95, 0x3f, 0xb8, 0x98,
-	0xbe, 0xa0, 0x96, 0xa0, 0xa6, 0x69, 0x7f, 0x13, 0x13, 0x99, 0xfe, 0x5b, 0xa3, 0xde, 0xab, 0xf9,
-	0xfe, 0x03, 0x29, 0x5a, 0xd0, 0x1e, 0x54, 0xd9, 0x73, 0x1f, 0xf4, 0xbc, 0xfc, 0x10, 0x26, 0xf1,
-	0x14, 0xa8, 0x37, 0xed, 0xc1, 0x90, 0x3f, 0xb6, 0x02, 0x42, 0xff, 0xaf, 0x40, 0x9b, 0x81, 0x22,
-	0x06, 0x3d, 0xc3, 0xc9, 0xf7, 0xa0, 0x42, 0x4d, 0x3b, 0x92, 0x1e, 0x28, 0x24, 0x1f, 0xf5, 0xf4,
-	0xa6, 0xbf, 0xe3, 0x89, 0x29, 0x6e, 0x7d, 0x9f, 0xfd, 0x1b, 0x1d, 0x27, 0xf8, 0x59, 0x4e, 0xfe,
-	0xff, 0x3b, 0x36, 0x7e, 0x4a, 0x9f, 0xa4, 0xa4, 0x2f, 0x5d, 0xa1, 0xb5, 0xf3, 0xdd, 0x1c, 0xeb,
-	0xad, 0xe7, 0xee, 0x1f, 0x61, 0xfe, 0x11, 0x28, 0xe9, 0x83, 0x36, 0xf4, 0xca, 0x24, 0x4d, 0x94,
-	0xe1, 0x9c, 0xa2, 0x86, 0xdf, 0x83, 0x2a, 0xab, 0xb0, 0xca, 0xc5, 0x57, 0xa8, 0xbe, 0x4e, 0x99,
-	0xeb, 0xee, 0xb7, 0x3e, 0xda, 0x18, 0x9a, 0xc1, 0xd1, 0xf8, 0x80, 0xb4, 0xac, 0xb3, 0xae, 0xaf,
-	0x99, 0x2e, 0xff, 0xb5, 0x1e, 0xee, 0xe5, 0x3a, 0x1d, 0xbd, 0x4e, 0x11, 0x8c, 0x0e, 0x0e, 0xaa,
-	0xf4, 0xf3, 0xd6, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xe8, 0x18, 0xc1, 0xb1, 0x0e, 0x53, 0x00,
-	0x00,
+	// 5904 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3d, 0x5d, 0x6f, 0x1c, 0xc9,
+	0x71, 0x9a, 0xfd, 0x20, 0x77, 0x6b, 0x3f, 0xb8, 0x6c, 0x92, 0xd2, 0x7a, 0x4f, 0xd2, 0xe9, 0x46,
+	0xa7, 0x3b, 0x5a, 0xba, 0xa3, 0x64, 0x9d, 0xef, 0x7c, 0xb6, 0xcf, 0xb0, 0x25, 0xae, 0xa4, 0xa3,
+	0x4f, 0x47, 0xcb, 0x43, 0xea, 0x1c, 0x9c, 0xcf, 0xde, 0x1b, 0xee, 0x34, 0xc9, 0x01, 0xe7, 0x63,
+	0x35, 0x33, 0x4b, 0x89, 0x4e, 0x10, 0x04, 0x41, 0x02, 0x38, 0xce, 0x07, 0xe2, 0x24, 0x40, 0x02,
+	0xe4, 0x03, 0x48, 0x80, 0x00, 0x49, 0x90, 0xbc, 0x04, 0x09, 0x90, 0x87, 0x3c, 0xf8, 0x2d, 0x0f,
+	0xf9, 0xf2, 0x43, 0x00, 0xff, 0x81, 0x3c, 0x06, 0xc8, 0x43, 0x62, 0x04, 0x4e, 0x5e, 0x82, 0xfe,
+	0x9a, 0x99, 0x9e, 0xe9, 0xe1, 0x2e, 0xb9, 0x3a, 0x9d, 0x1d, 0xe4, 0x6d, 0xa7, 0xa6, 0xbb, 0xab,
+	0xba, 0xba, 0xaa, 0xba, 0xaa, 0xba, 0xa6, 0x17, 0x16, 0x1f, 0x8d, 0x71, 0x70, 0x34, 0x18, 0xfa,
+	0x7e, 0x60, 0xad, 0x8d, 0x02, 0x3f, 0xf2, 0x11, 0x72, 0x6d, 0xe7, 0x70, 0x1c, 0xb2, 0xa7, 0x35,
+	0xfa, 0xbe, 0xd7, 0x1c, 0xfa, 0xae, 0xeb, 0x7b, 0x0c, 0xd6, 0x6b, 0xa6, 0x5b, 0xf4, 0xda, 0xb6,
+	0x17, 0xe1, 0xc0, 0x33, 0x1d, 0xf1, 0x36, 0x1c, 0xee, 0x63, 0xd7, 0xe4, 0x4f, 0x75, 0x37, 0xdc,
+	0xe3, 0x3f, 0x3b, 0x96, 0x19, 0x99, 0x69, 0x54, 0xbd, 0x45, 0xdb, 0xb3, 0xf0, 0x93, 0x34, 0x48,
+	0xff, 0x05, 0x0d, 0xce, 0x6e, 0xed, 0xfb, 0x8f, 0xd7, 0x7d, 0xc7, 0xc1, 0xc3, 0xc8, 0xf6, 0xbd,
+	0xd0, 0xc0, 0x8f, 0xc6, 0x38, 0x8c, 0xd0, 0x0d, 0xa8, 0xec, 0x98, 0x21, 0xee, 0x6a, 0x97, 0xb4,
+	0xd5, 0xc6, 0xcd, 0xf3, 0x6b, 0x12, 0x9d, 0x9c, 0xc0, 0x77, 0xc3, 0xbd, 0xdb, 0x66, 0x88, 0x0d,
+	0xda, 0x12, 0x21, 0xa8, 0x58, 0x3b, 0x1b, 0xfd, 0x6e, 0xe9, 0x92, 0xb6, 0x5a, 0x36, 0xe8, 0x6f,
+	0xf4, 0x22, 0xb4, 0x86, 0xf1, 0xd8, 0x1b, 0xfd, 0xb0, 0x5b, 0xbe, 0x54, 0x5e, 0x2d, 0x1b, 0x32,
+	0x50, 0xff, 0x4e, 0x09, 0xce, 0xe5, 0xc8, 0x08, 0x47, 0xbe, 0x17, 0x62, 0xf4, 0x1a, 0xcc, 0x85,
+	0x91, 0x19, 0x8d, 0x43, 0x4e, 0xc9, 0x73, 0x4a, 0x4a, 0xb6, 0x68, 0x13, 0x83, 0x37, 0xcd, 0xa3,
+	0x2d, 0x29, 0xd0, 0xa2, 0x4f, 0xc1, 0xb2, 0xed, 0xbd, 0x8b, 0x5d, 0x3f, 0x38, 0x1a, 0x8c, 0x70,
+	0x30, 0xc4, 0x5e, 0x64, 0xee, 0x61, 0x41, 0xe3, 0x92, 0x78, 0xf7, 0x20, 0x79, 0x85, 0xde, 0x80,
+	0x73, 0x6c, 0x0d, 0x43, 0x1c, 0x1c, 0xda, 0x43, 0x3c, 0x30, 0x0f, 0x4d, 0xdb, 0x31, 0x77, 0x1c,
+	0xdc, 0xad, 0x5c, 0x2a, 0xaf, 0xd6, 0x8c, 0x15, 0xfa, 0x7a, 0x8b, 0xbd, 0xbd, 0x25, 0x5e, 0xa2,
+	0x4f, 0x42, 0x27, 0xc0, 0xbb, 0x01, 0x0e, 0xf7, 0x07, 0xa3, 0xc0, 0xdf, 0x0b, 0x70, 0x18, 0x76,
+	0xab, 0x14, 0xcd, 0x02, 0x87, 0x3f, 0xe0, 0x60, 0xfd, 0x8f, 0x35, 0x58, 0x21, 0xcc, 0x78, 0x60,
+	0x06, 0x91, 0xfd, 0x11, 0x2c, 0x89, 0x0e, 0xcd, 0x34, 0x1b, 0xba, 0x65, 0xfa, 0x4e, 0x82, 0x91,
+	0x36, 0x23, 0x81, 0x9e, 0xb0, 0xaf, 0x42, 0x49, 0x95, 0x60, 0xfa, 0x3f, 0x73, 0xd9, 0x49, 0xd3,
+	0x39, 0xcb, 0x9a, 0x65, 0x71, 0x96, 0xf2, 0x38, 0x4f, 0xb3, 0x62, 0x2a, 0xce, 0x57, 0xd4, 0x9c,
+	0xff, 0xc7, 0x32, 0xac, 0xdc, 0xf7, 0x4d, 0x2b, 0x11, 0xc3, 0x67, 0xcf, 0xf9, 0x2f, 0xc0, 0x1c,
+	0xd3, 0xe8, 0x6e, 0x85, 0xe2, 0xba, 0x22, 0xe3, 0xe2, 0xda, 0x9e, 0x50, 0xb8, 0x45, 0x01, 0x06,
+	0xef, 0x84, 0xae, 0x40, 0x3b, 0xc0, 0x23, 0xc7, 0x1e, 0x9a, 0x03, 0x6f, 0xec, 0xee, 0xe0, 0xa0,
+	0x5b, 0xbd, 0xa4, 0xad, 0x56, 0x8d, 0x16, 0x87, 0x6e, 0x52, 0x20, 0xfa, 0x10, 0x5a, 0xbb, 0x36,
+	0x76, 0xac, 0x01, 0x35, 0x09, 0x1b, 0xfd, 0xee, 0xdc, 0xa5, 0xf2, 0x6a, 0xe3, 0xe6, 0xe7, 0xd7,
+	0xf2, 0xd6, 0x68, 0x4d, 0xc9, 0x91, 0xb5, 0xbb, 0xa4, 0xfb, 0x06, 0xeb, 0x7d, 0xc7, 0x8b, 0x82,
+	0x23, 0xa3, 0xb9, 0x9b, 0x02, 0xa1, 0x2e, 0xcc, 0x73, 0xf6, 0x76, 0xe7, 0x2f, 0x69, 0xab, 0x35,
+	0x43, 0x3c, 0xa2, 0x97, 0x61, 0x21, 0xc0, 0xa1, 0x3f, 0x0e, 0x86, 0x78, 0xb0, 0x17, 0xf8, 0xe3,
+	0x51, 0xd8, 0xad, 0x5d, 0x2a, 0xaf, 0xd6, 0x8d, 0xb6, 0x00, 0xdf, 0xa3, 0xd0, 0xde, 0x17, 0x61,
+	0x31, 0x87, 0x05, 0x75, 0xa0, 0x7c, 0x80, 0x8f, 0xe8, 0x42, 0x94, 0x0d, 0xf2, 0x13, 0x2d, 0x43,
+	0xf5, 0xd0, 0x74, 0xc6, 0x98, 0xb3, 0x9a, 0x3d, 0x7c, 0xae, 0xf4, 0xa6, 0xa6, 0xff, 0x9e, 0x06,
+	0x5d, 0x03, 0x3b, 0xd8, 0x0c, 0xf1, 0xc7, 0xb9, 0xa4, 0x67, 0x61, 0xce, 0xf3, 0x2d, 0xbc, 0xd1,
+	0xa7, 0x4b, 0x5a, 0x36, 0xf8, 0x93, 0xfe, 0x23, 0x0d, 0x96, 0xef, 0xe1, 0x88, 0xa8, 0x81, 0x1d,
+	0x46, 0xf6, 0x30, 0xd6, 0xf3, 0x2f, 0x40, 0x39, 0xc0, 0x8f, 0x38, 0x65, 0xd7, 0x64, 0xca, 0x62,
+	0xf3, 0xaf, 0xea, 0x69, 0x90, 0x7e, 0xe8, 0x05, 0x68, 0x5a, 0xae, 0x33, 0x18, 0xee, 0x9b, 0x9e,
+	0x87, 0x1d, 0xa6, 0x48, 0x75, 0xa3, 0x61, 0xb9, 0xce, 0x3a, 0x07, 0xa1, 0x8b, 0x00, 0x21, 0xde,
+	0x73, 0xb1, 0x17, 0x25, 0x36, 0x39, 0x05, 0x41, 0x57, 0x61, 0x71, 0x37, 0xf0, 0xdd, 0x41, 0xb8,
+	0x6f, 0x06, 0xd6, 0xc0, 0xc1, 0xa6, 0x85, 0x03, 0x4a, 0x7d, 0xcd, 0x58, 0x20, 0x2f, 0xb6, 0x08,
+	0xfc, 0x3e, 0x05, 0xa3, 0xd7, 0xa0, 0x1a, 0x0e, 0xfd, 0x11, 0xa6, 0x92, 0xd6, 0xbe, 0x79, 0x41,
+	0x25, 0x43, 0x7d, 0x33, 0x32, 0xb7, 0x48, 0x23, 0x83, 0xb5, 0xd5, 0xff, 0xa6, 0xc2, 0x54, 0xed,
+	0xc7, 0xdc, 0xc8, 0xa5, 0xd4, 0xb1, 0xfa, 0x74, 0xd4, 0x71, 0x6e, 0x2a, 0x75, 0x9c, 0x3f, 0x5e,
+	0x1d, 0x73, 0x5c, 0x3b, 0x89, 0x3a, 0xd6, 0x26, 0xaa, 0x63, 0x5d, 0xa5, 0x8e, 0xe8, 0x0e, 0x2c,
+	0x30, 0x07, 0xc2, 0xf6, 0x76, 0xfd, 0x81, 0x63, 0x87, 0x51, 0x17, 0x28, 0x99, 0x17, 0xb2, 0x12,
+	0x6a, 0xe1, 0x27, 0x6b, 0x0c, 0xb1, 0xb7, 0xeb, 0x1b, 0x2d, 0x5b, 0xfc, 0xbc, 0x6f, 0x87, 0xd1,
+	0xec, 0x5a, 0xfd, 0xbd, 0x44, 0xab, 0x7f, 0xdc, 0xa5, 0x27, 0xd1, 0xfc, 0xaa, 0xa4, 0xf9, 0x7f,
+	0xaa, 0xc1, 0x27, 0xee, 0xe1, 0x28, 0x26, 0x9f, 0x28, 0x32, 0xfe, 0x31, 0xdd, 0xe6, 0xff, 0x42,
+	0x83, 0x9e, 0x8a, 0xd6, 0x59, 0xb6, 0xfa, 0xf7, 0xe1, 0x6c, 0x8c, 0x63, 0x60, 0xe1, 0x70, 0x18,
+	0xd8, 0x23, 0xba, 0x8c, 0xd4, 0x56, 0x35, 0x6e, 0x5e, 0x56, 0x09, 0x7e, 0x96, 0x82, 0x95, 0x78,
+	0x88, 0x7e, 0x6a, 0x04, 0xfd, 0x57, 0x35, 0x58, 0x21, 0xb6, 0x91, 0x1b, 0x33, 0x22, 0x81, 0xa7,
+	0xe6, 0xab, 0x6c, 0x26, 0x4b, 0x39, 0x33, 0x39, 0x05, 0x8f, 0xa9, 0x8b, 0x9d, 0xa5, 0x67, 0x16,
+	0xde, 0xbd, 0x0e, 0x55, 0xa2, 0x80, 0x82, 0x55, 0xcf, 0xab, 0x58, 0x95, 0x46, 0xc6, 0x5a, 0xeb,
+	0x1e, 0xa3, 0x22, 0xb1, 0xdb, 0x33, 0x88, 0x5b, 0x76, 0xda, 0x25, 0xc5, 0xb4, 0x7f, 0x45, 0x83,
+	0x73, 0x39, 0x84, 0xb3, 0xcc, 0xfb, 0x2d, 0x98, 0xa3, 0xbb, 0x91, 0x98, 0xf8, 0x8b, 0xca, 0x89,
+	0xa7, 0xd0, 0x11, 0x6b, 0x63, 0xf0, 0x3e, 0xba, 0x0f, 0x9d, 0xec, 0x3b, 0xb2, 0x4f, 0xf2, 0x3d,
+	0x72, 0xe0, 0x99, 0x2e, 0x63, 0x40, 0xdd, 0x68, 0x70, 0xd8, 0xa6, 0xe9, 0x62, 0xf4, 0x09, 0xa8,
+	0x11, 0x95, 0x1d, 0xd8, 0x96, 0x58, 0xfe, 0x79, 0xaa, 0xc2, 0x56, 0x88, 0x2e, 0x00, 0xd0, 0x57,
+	0xa6, 0x65, 0x05, 0x6c, 0x0b, 0xad, 0x1b, 0x75, 0x02, 0xb9, 0x45, 0x00, 0xfa, 0xef, 0x68, 0x70,
+	0x71, 0xeb, 0xc8, 0x1b, 0x6e, 0xe2, 0xc7, 0xeb, 0x01, 0x36, 0x23, 0x9c, 0x18, 0xed, 0x8f, 0x94,
+	0xf1, 0xe8, 0x12, 0x34, 0x52, 0xfa, 0xcb, 0x45, 0x32, 0x0d, 0xd2, 0xff, 0x52, 0x83, 0x26, 0xd9,
+	0x45, 0xde, 0xc5, 0x91, 0x49, 0x44, 0x04, 0x7d, 0x16, 0xea, 0x8e, 0x6f, 0x5a, 0x83, 0xe8, 0x68,
+	0xc4, 0xa8, 0x69, 0x67, 0xa9, 0x49, 0xb6, 0x9e, 0xed, 0xa3, 0x11, 0x36, 0x6a, 0x0e, 0xff, 0x35,
+	0x15, 0x45, 0x59, 0x2b, 0x53, 0x56, 0x58, 0xca, 0xe7, 0xa1, 0xe1, 0xe2, 0x28, 0xb0, 0x87, 0x8c,
+	0x88, 0x0a, 0x5d, 0x0a, 0x60, 0x20, 0x82, 0x48, 0xff, 0x97, 0x39, 0x38, 0xfb, 0x35, 0x33, 0x1a,
+	0xee, 0xf7, 0x5d, 0xe1, 0xc5, 0x9c, 0x9e, 0x8f, 0x89, 0x5d, 0x2e, 0xa5, 0xed, 0xf2, 0x53, 0xb3,
+	0xfb, 0xb1, 0x8e, 0x56, 0x55, 0x3a, 0x4a, 0x02, 0xf3, 0xb5, 0xf7, 0xb8, 0x98, 0xa5, 0x74, 0x34,
+	0xe5, 0x6c, 0xcc, 0x9d, 0xc6, 0xd9, 0x58, 0x87, 0x16, 0x7e, 0x32, 0x74, 0xc6, 0x44, 0x5e, 0x29,
+	0x76, 0xe6, 0x45, 0x5c, 0x54, 0x60, 0x4f, 0x1b, 0x88, 0x26, 0xef, 0xb4, 0xc1, 0x69, 0x60, 0xb2,
+	0xe0, 0xe2, 0xc8, 0xa4, 0xae, 0x42, 0xe3, 0xe6, 0xa5, 0x22, 0x59, 0x10, 0x02, 0xc4, 0xe4, 0x81,
+	0x3c, 0xa1, 0xf3, 0x50, 0xe7, 0xae, 0xcd, 0x46, 0xbf, 0x5b, 0xa7, 0xec, 0x4b, 0x00, 0xc8, 0x84,
+	0x16, 0xb7, 0x9e, 0x9c, 0x42, 0xe6, 0x40, 0xbc, 0xa5, 0x42, 0xa0, 0x5e, 0xec, 0x34, 0xe5, 0x21,
+	0x77, 0x74, 0xc2, 0x14, 0x88, 0x44, 0xfe, 0xfe, 0xee, 0xae, 0x63, 0x7b, 0x78, 0x93, 0xad, 0x70,
+	0x83, 0x12, 0x21, 0x03, 0x89, 0x3b, 0x74, 0x88, 0x83, 0xd0, 0xf6, 0xbd, 0x6e, 0x93, 0xbe, 0x17,
+	0x8f, 0x2a, 0x2f, 0xa7, 0x75, 0x72, 0x2f, 0x07, 0xdd, 0x81, 0xd6, 0x2e, 0xf6, 0x86, 0xb6, 0xb7,
+	0x37, 0x88, 0xfc, 0x03, 0xec, 0x75, 0xdb, 0xc5, 0xac, 0xbc, 0xcb, 0x1a, 0x6e, 0x93, 0x76, 0x46,
+	0x73, 0x37, 0xf5, 0xd4, 0x1b, 0xc0, 0x62, 0x6e, 0xc2, 0x0a, 0x67, 0xe9, 0xd3, 0x69, 0x67, 0x69,
+	0xf2, 0x8a, 0xa7, 0x9c, 0xa9, 0x7f, 0xd7, 0x60, 0xe5, 0xa1, 0x17, 0x8e, 0x77, 0x62, 0x4e, 0x7f,
+	0x3c, 0x5a, 0x95, 0xb5, 0xc5, 0x95, 0xbc, 0x2d, 0xce, 0xb1, 0xb4, 0x7a, 0x1a, 0x96, 0xea, 0x9f,
+	0x86, 0x66, 0xfa, 0x2d, 0xf1, 0x9d, 0x22, 0x1c, 0xb8, 0x9c, 0x9d, 0xf4, 0x37, 0xe1, 0x70, 0x88,
+	0x1f, 0xf1, 0x69, 0x90, 0x9f, 0xfa, 0x7f, 0x56, 0x61, 0x81, 0xb3, 0x90, 0x48, 0x3e, 0x35, 0x9b,
+	0xe7, 0xa1, 0x1e, 0xfb, 0x02, 0xbc, 0x7b, 0x02, 0xc8, 0xda, 0xe1, 0x52, 0xce, 0x0e, 0x4f, 0xc5,
+	0x17, 0xe1, 0xd9, 0x55, 0x52, 0x9e, 0xdd, 0x05, 0x80, 0x5d, 0x67, 0x1c, 0xee, 0x0f, 0x22, 0xdb,
+	0xc5, 0xdc, 0xb3, 0xac, 0x53, 0xc8, 0xb6, 0xed, 0x62, 0x74, 0x0b, 0x9a, 0x3b, 0xb6, 0xe7, 0xf8,
+	0x7b, 0x83, 0x91, 0x19, 0xed, 0x87, 0x3c, 0xb4, 0x57, 0xc9, 0x04, 0xf5, 0xc3, 0x6f, 0xd3, 0xb6,
+	0x46, 0x83, 0xf5, 0x79, 0x40, 0xba, 0xa0, 0x8b, 0xd0, 0xf0, 0xc6, 0xee, 0xc0, 0xdf, 0x1d, 0x04,
+	0xfe, 0xe3, 0x90, 0x06, 0xf0, 0x65, 0xa3, 0xee, 0x8d, 0xdd, 0xaf, 0xec, 0x1a, 0xfe, 0x63, 0xb2,
+	0x17, 0xd7, 0xc9, 0xae, 0x1c, 0x3a, 0xfe, 0x1e, 0x0b, 0xde, 0x27, 0x8f, 0x9f, 0x74, 0x20, 0xbd,
+	0x2d, 0xec, 0x44, 0x26, 0xed, 0x5d, 0x9f, 0xae, 0x77, 0xdc, 0x01, 0xbd, 0x04, 0xed, 0xa1, 0xef,
+	0x8e, 0x4c, 0xca, 0xa1, 0xbb, 0x81, 0xef, 0x52, 0x23, 0x52, 0x36, 0x32, 0x50, 0xb4, 0x0e, 0x8d,
+	0x44, 0x91, 0xc3, 0x6e, 0x83, 0xe2, 0xd1, 0x95, 0xc2, 0x92, 0x84, 0x23, 0x44, 0x3b, 0x20, 0xd6,
+	0xe4, 0x90, 0x88, 0xa5, 0x30, 0x58, 0xa1, 0xfd, 0x2d, 0xcc, 0x8d, 0x45, 0x83, 0xc3, 0xb6, 0xec,
+	0x6f, 0x61, 0x12, 0xe2, 0xd9, 0x5e, 0x88, 0x83, 0x48, 0x04, 0xdc, 0xdd, 0x16, 0x95, 0xdd, 0x16,
+	0x83, 0x72, 0xad, 0x42, 0x7d, 0x68, 0x87, 0x91, 0x19, 0x44, 0x83, 0x91, 0x1f, 0x52, 0x01, 0xe0,
+	0x16, 0x21, 0x63, 0x56, 0xdc, 0x70, 0x8f, 0x68, 0xd5, 0x03, 0xde, 0xc8, 0x68, 0xd1, 0x4e, 0xe2,
+	0x91, 0x8c, 0x42, 0x39, 0x91, 0x8c, 0xb2, 0x30, 0xd5, 0x28, 0xb4, 0x53, 0x3c, 0xca, 0x2a, 0x09,
+	0xf9, 0x4c, 0xcb, 0xdc, 0x71, 0xf0, 0x7b, 0xdc, 0x0a, 0x76, 0xe8, 0xc4, 0xb2, 0x60, 0xfd, 0x0f,
+	0xcb, 0xd0, 0x96, 0xd9, 0x43, 0x4c, 0x27, 0x8b, 0x2c, 0x85, 0xcc, 0x8b, 0x47, 0xc2, 0x2c, 0xec,
+	0x91, 0xde, 0x2c, 0x8c, 0xa5, 0x22, 0x5f, 0x33, 0x1a, 0x0c, 0x46, 0x07, 0x20, 0xa2, 0xcb, 0x16,
+	0x85, 0x2a, 0x79, 0x99, 0x32, 0xaa, 0x4e, 0x21, 0x54, 0xc5, 0xbb, 0x30, 0x2f, 0x22, 0x60, 0x26,
+	0xf0, 0xe2, 0x91, 0xbc, 0xd9, 0x19, 0xdb, 0x14, 0x2b, 0x13, 0x78, 0xf1, 0x88, 0xfa, 0xd0, 0x64,
+	0x43, 0x8e, 0xcc, 0xc0, 0x74, 0x85, 0xb8, 0xbf, 0xa0, 0xb4, 0x57, 0xef, 0xe0, 0xa3, 0xf7, 0x88,
+	0xe9, 0x7b, 0x60, 0xda, 0x81, 0xc1, 0xc4, 0xe3, 0x01, 0xed, 0x85, 0x56, 0xa1, 0xc3, 0x46, 0xd9,
+	0xb5, 0x1d, 0xcc, 0x15, 0x67, 0x9e, 0x85, 0xc1, 0x14, 0x7e, 0xd7, 0x76, 0x30, 0xd3, 0x8d, 0x78,
+	0x0a, 0x54, 0x20, 0x6a, 0x4c, 0x35, 0x28, 0x84, 0x8a, 0xc3, 0x65, 0x60, 0x3b, 0xc1, 0x40, 0xec,
+	0x2f, 0x6c, 0x13, 0x64, 0x34, 0x72, 0xb6, 0x52, 0xb7, 0x72, 0xec, 0x32, 0xe5, 0x02, 0x36, 0x1d,
+	0x6f, 0xec, 0x52, 0xd5, 0xba, 0x01, 0xcb, 0xac, 0x3f, 0xf6, 0xf6, 0x6c, 0x0f, 0xc7, 0xc3, 0x34,
+	0x68, 0xde, 0x00, 0xd1, 0x77, 0x77, 0xe8, 0x2b, 0xb1, 0x46, 0xbf, 0x51, 0x85, 0x25, 0x62, 0x93,
+	0xb8, 0x79, 0x9a, 0xc1, 0x2d, 0xba, 0x00, 0x60, 0x85, 0xd1, 0x40, 0x32, 0xe2, 0x75, 0x2b, 0x8c,
+	0xf8, 0xa6, 0xf9, 0x59, 0xe1, 0xd5, 0x94, 0x8b, 0x83, 0xb4, 0x8c, 0x8d, 0xcc, 0x7b, 0x36, 0xa7,
+	0xca, 0x6a, 0x5e, 0x86, 0x16, 0xcf, 0x50, 0x48, 0xe1, 0x74, 0x93, 0x01, 0x37, 0xd5, 0xdb, 0xcc,
+	0x9c, 0x32, 0xbb, 0x9a, 0xf2, 0x6e, 0xe6, 0x67, 0xf3, 0x6e, 0x6a, 0x59, 0xef, 0xe6, 0x2e, 0x2c,
+	0xc8, 0xca, 0x29, 0xac, 0xdb, 0x04, 0xed, 0x6c, 0x4b, 0xda, 0x19, 0xa6, 0x9d, 0x13, 0x90, 0x9d,
+	0x93, 0xcb, 0xd0, 0xf2, 0x30, 0xb6, 0x06, 0x51, 0x60, 0x7a, 0xe1, 0x2e, 0x0e, 0xa8, 0x54, 0xd4,
+	0x8c, 0x26, 0x01, 0x6e, 0x73, 0x18, 0x7a, 0x0b, 0x80, 0xce, 0x91, 0x25, 0xe5, 0x9a, 0xc5, 0x49,
+	0x39, 0x2a, 0x34, 0x34, 0x29, 0x47, 0x99, 0x42, 0x7f, 0x3e, 0x25, 0xff, 0x47, 0xff, 0xa7, 0x12,
+	0x9c, 0xe5, 0x49, 0x9a, 0xd9, 0xe5, 0xb2, 0xc8, 0xb1, 0x10, 0x9b, 0x63, 0xf9, 0x98, 0xb4, 0x47,
+	0x65, 0x0a, 0x17, 0xbe, 0xaa, 0x70, 0xe1, 0xe5, 0xd0, 0x7f, 0x2e, 0x17, 0xfa, 0xc7, 0x59, 0xcf,
+	0xf9, 0xe9, 0xb3, 0x9e, 0x68, 0x19, 0xaa, 0x34, 0x1e, 0xa5, 0xb2, 0x53, 0x37, 0xd8, 0xc3, 0x54,
+	0xab, 0xaa, 0xff, 0x76, 0x09, 0x5a, 0x5b, 0xd8, 0x0c, 0x86, 0xfb, 0x82, 0x8f, 0x6f, 0xa4, 0xb3,
+	0xc4, 0x2f, 0x16, 0x64, 0x89, 0xa5, 0x2e, 0x3f, 0x31, 0xe9, 0x61, 0x82, 0x20, 0xf2, 0x23, 0x33,
+	0xa6, 0x72, 0xe0, 0x8d, 0x5d, 0x9e, 0x3a, 0x5d, 0xa0, 0x2f, 0x38, 0xa9, 0x9b, 0x63, 0x57, 0xff,
+	0x37, 0x0d, 0x9a, 0x5f, 0x25, 0xc3, 0x08, 0xc6, 0xbc, 0x99, 0x66, 0xcc, 0x4b, 0x05, 0x8c, 0x31,
+	0x48, 0x68, 0x89, 0x0f, 0xf1, 0x4f, 0x5c, 0xe6, 0xfc, 0xef, 0x34, 0xe8, 0x6d, 0x1d, 0x79, 0x43,
+	0x83, 0xd9, 0x9d, 0xd9, 0xb5, 0xeb, 0x32, 0xb4, 0x0e, 0x25, 0xdf, 0xbb, 0x44, 0x85, 0xb3, 0x79,
+	0x98, 0x76, 0xbe, 0x0d, 0xe8, 0x88, 0x44, 0x36, 0x9f, 0xac, 0xd8, 0x06, 0x5e, 0x56, 0x51, 0x9d,
+	0x21, 0x8e, 0x5a, 0x88, 0x85, 0x40, 0x06, 0xea, 0xbf, 0xa6, 0xc1, 0x92, 0xa2, 0x21, 0x3a, 0x07,
+	0xf3, 0x3c, 0xe9, 0xc2, 0x3d, 0x0c, 0xa6, 0xef, 0x16, 0x59, 0x9e, 0x24, 0x6d, 0x68, 0x5b, 0x79,
+	0x9f, 0xda, 0x42, 0xcf, 0x43, 0x23, 0x8e, 0x30, 0xad, 0xdc, 0xfa, 0x58, 0x21, 0xea, 0x41, 0x8d,
+	0x5b, 0x53, 0x11, 0xba, 0xc7, 0xcf, 0xfa, 0x01, 0xa0, 0x7b, 0x38, 0xd9, 0xbb, 0x66, 0xe1, 0x68,
+	0x62, 0x6f, 0x12, 0x42, 0xd3, 0x46, 0xc8, 0xd2, 0xff, 0x55, 0x83, 0x25, 0x09, 0xdb, 0x2c, 0xc9,
+	0xb1, 0x64, 0x7f, 0x2d, 0x9d, 0x66, 0x7f, 0x95, 0x12, 0x40, 0xe5, 0x13, 0x25, 0x80, 0x2e, 0x02,
+	0xc4, 0xfc, 0x17, 0x1c, 0x4d, 0x41, 0xf4, 0xbf, 0xd5, 0xe0, 0xec, 0xdb, 0xa6, 0x67, 0xf9, 0xbb,
+	0xbb, 0xb3, 0x8b, 0xea, 0x3a, 0x48, 0xc1, 0xfe, 0xb4, 0x29, 0x50, 0x39, 0x43, 0x70, 0x0d, 0x16,
+	0x03, 0xb6, 0x33, 0x59, 0xb2, 0x2c, 0x97, 0x8d, 0x8e, 0x78, 0x11, 0xcb, 0xe8, 0x9f, 0x97, 0x00,
+	0x91, 0x59, 0xdf, 0x36, 0x1d, 0xd3, 0x1b, 0xe2, 0xd3, 0x93, 0x7e, 0x05, 0xda, 0x92, 0x0b, 0x13,
+	0x97, 0x24, 0xa4, 0x7d, 0x98, 0x10, 0xbd, 0x03, 0xed, 0x1d, 0x86, 0x6a, 0x10, 0x60, 0x33, 0xf4,
+	0x3d, 0xbe, 0x1c, 0xca, 0x6c, 0xe7, 0x76, 0x60, 0xef, 0xed, 0xe1, 0x60, 0xdd, 0xf7, 0x2c, 0xee,
+	0xe7, 0xef, 0x08, 0x32, 0x49, 0x57, 0xa2, 0x0c, 0x89, 0x3f, 0x17, 0x2f, 0x4e, 0xec, 0xd0, 0x51,
+	0x56, 0x84, 0xd8, 0x74, 0x12, 0x46, 0x24, 0xbb, 0x61, 0x87, 0xbd, 0xd8, 0x2a, 0x4e, 0x76, 0x2b,
+	0xfc, 0x2b, 0xfd, 0xaf, 0x34, 0x40, 0x71, 0x26, 0x81, 0x66, 0x70, 0xa8, 0x46, 0x67, 0xbb, 0x6a,
+	0x8a, 0x4d, 0xf9, 0x3c, 0xd4, 0x2d, 0xd1, 0x93, 0x9b, 0xa0, 0x04, 0x40, 0xf7, 0x48, 0x4a, 0xf4,
+	0x80, 0x48, 0x1e, 0xb6, 0x44, 0xb0, 0xcc, 0x80, 0xf7, 0x29, 0x4c, 0x76, 0xcf, 0x2a, 0x59, 0xf7,
+	0x2c, 0x9d, 0xcb, 0xad, 0x4a, 0xb9, 0x5c, 0xfd, 0x4f, 0x4a, 0xd0, 0xa1, 0x5b, 0xc8, 0x7a, 0x92,
+	0x94, 0x9b, 0x8a, 0xe8, 0xcb, 0xd0, 0xe2, 0x25, 0x3d, 0x12, 0xe1, 0xcd, 0x47, 0xa9, 0xc1, 0x88,
+	0x4b, 0xcf, 0x1a, 0x05, 0x38, 0x1c, 0x3b, 0x49, 0x9c, 0xc8, 0xc2, 0x1f, 0xf4, 0x88, 0xed, 0x5d,
+	0xe4, 0x95, 0xe8, 0xf1, 0x10, 0xce, 0xee, 0x39, 0xfe, 0x8e, 0xe9, 0x0c, 0xe4, 0xe5, 0x61, 0x6b,
+	0x38, 0x85, 0xc4, 0x2f, 0xb3, 0xee, 0x5b, 0xe9, 0x35, 0x0c, 0xd1, 0x6d, 0x68, 0x85, 0x18, 0x1f,
+	0x24, 0xc1, 0x63, 0x75, 0x9a, 0xe0, 0xb1, 0x49, 0xfa, 0x88, 0x27, 0xfd, 0x0f, 0x34, 0x58, 0xc8,
+	0x9c, 0xc4, 0x64, 0x53, 0x1d, 0x5a, 0x3e, 0xd5, 0xf1, 0x26, 0x54, 0x89, 0xa5, 0x62, 0x7b, 0x4b,
+	0x5b, 0x1d, 0x86, 0xcb, 0xa3, 0x1a, 0xac, 0x03, 0xba, 0x0e, 0x4b, 0x8a, 0x8a, 0x0f, 0xbe, 0xfc,
+	0x28, 0x5f, 0xf0, 0xa1, 0xff, 0xb0, 0x02, 0x8d, 0x14, 0x2b, 0x26, 0x64, 0x69, 0x9e, 0x4a, 0x46,
+	0xbd, 0xe8, 0x84, 0x9f, 0x88, 0x9c, 0x8b, 0x5d, 0x16, 0x29, 0xf2, 0xb0, 0xd5, 0xc5, 0x2e, 0x8d,
+	0x13, 0xd3, 0x21, 0xe0, 0x9c, 0x1c, 0x02, 0xca, 0x41, 0xf2, 0xfc, 0x31, 0x41, 0x72, 0x4d, 0x0e,
+	0x92, 0x25, 0x15, 0xaa, 0x67, 0x55, 0x68, 0xda, 0xc4, 0xc9, 0x0d, 0x58, 0x1a, 0xb2, 0x13, 0x8b,
+	0xdb, 0x47, 0xeb, 0xf1, 0x2b, 0xee, 0x94, 0xaa, 0x5e, 0xa1, 0xbb, 0x49, 0x5a, 0x97, 0xad, 0x32,
+	0x0b, 0x3a, 0xd4, 0x31, 0x38, 0x5f, 0x1b, 0xb6, 0xc8, 0xc2, 0x32, 0xd3, 0xa7, 0x6c, 0xca, 0xa6,
+	0x75, 0xaa, 0x94, 0xcd, 0xf3, 0xd0, 0x10, 0x9e, 0x0a, 0xd1, 0xf4, 0x36, 0x33, 0x7a, 0xc2, 0x0c,
+	0x58, 0xa1, 0x64, 0x07, 0x16, 0xe4, 0x33, 0x9d, 0x6c, 0x06, 0xa3, 0x93, 0xcf, 0x60, 0x9c, 0x83,
+	0x79, 0x3b, 0x1c, 0xec, 0x9a, 0x07, 0xb8, 0xbb, 0x48, 0xdf, 0xce, 0xd9, 0xe1, 0x5d, 0xf3, 0x00,
+	0xeb, 0xdf, 0x2f, 0x43, 0x3b, 0xd9, 0x60, 0xa7, 0xb6, 0x20, 0xd3, 0x54, 0x3d, 0x6d, 0x42, 0x27,
+	0xf1, 0x7b, 0x28, 0x87, 0x8f, 0x8d, 0xc1, 0xb3, 0x07, 0xa5, 0x0b, 0xa3, 0x8c, 0xbe, 0x4a, 0xdb,
+	0x7d, 0xe5, 0x44, 0xdb, 0xfd, 0x8c, 0xf5, 0x10, 0xaf, 0xc1, 0x4a, 0xbc, 0xf7, 0x4a, 0xd3, 0x66,
+	0x01, 0xd6, 0xb2, 0x78, 0xf9, 0x20, 0x3d, 0xfd, 0x02, 0x13, 0x30, 0x5f, 0x64, 0x02, 0xb2, 0x22,
+	0x50, 0xcb, 0x89, 0x40, 0xbe, 0x2c, 0xa3, 0xae, 0x28, 0xcb, 0xd0, 0x1f, 0xc2, 0x12, 0xcd, 0x8d,
+	0x87, 0xc3, 0xc0, 0xde, 0xc1, 0x71, 0x08, 0x30, 0xcd, 0xb2, 0xf6, 0xa0, 0x96, 0x89, 0x22, 0xe2,
+	0x67, 0xfd, 0x3b, 0x1a, 0x9c, 0xcd, 0x8f, 0x4b, 0x25, 0x26, 0x31, 0x24, 0x9a, 0x64, 0x48, 0x7e,
+	0x0a, 0x96, 0x52, 0x1e, 0xa5, 0x34, 0x72, 0x81, 0x07, 0xae, 0x20, 0xdc, 0x40, 0xc9, 0x18, 0x02,
+	0xa6, 0xff, 0x50, 0x8b, 0x8f, 0x18, 0x08, 0x6c, 0x8f, 0x1e, 0x03, 0x91, 0x7d, 0xcd, 0xf7, 0x1c,
+	0xdb, 0x8b, 0x13, 0x2e, 0x7c, 0x8e, 0x0c, 0xc8, 0x13, 0x2e, 0x6f, 0xc3, 0x02, 0x6f, 0x14, 0x6f,
+	0x4f, 0x53, 0x3a, 0x64, 0x6d, 0xd6, 0x2f, 0xde, 0x98, 0xae, 0x40, 0x9b, 0x9f, 0xcf, 0x08, 0x7c,
+	0x65, 0xd5, 0xa9, 0xcd, 0x97, 0xa1, 0x23, 0x9a, 0x9d, 0x74, 0x43, 0x5c, 0xe0, 0x1d, 0x63, 0xc7,
+	0xee, 0x97, 0x34, 0xe8, 0xca, 0xdb, 0x63, 0x6a, 0xfa, 0x27, 0x77, 0xef, 0x3e, 0x2f, 0x9f, 0xca,
+	0x5f, 0x39, 0x86, 0x9e, 0x04, 0x8f, 0x38, 0x9b, 0xff, 0xf5, 0x12, 0x2d, 0xb1, 0x20, 0xa1, 0x5e,
+	0xdf, 0x0e, 0xa3, 0xc0, 0xde, 0x19, 0xcf, 0x76, 0x4e, 0x6c, 0x42, 0x63, 0xb8, 0x8f, 0x87, 0x07,
+	0x23, 0xdf, 0x4e, 0x56, 0xe5, 0x8b, 0x2a, 0x9a, 0x8a, 0xd1, 0xae, 0xad, 0x27, 0x23, 0xb0, 0x83,
+	0xb6, 0xf4, 0x98, 0xbd, 0x6f, 0x40, 0x27, 0xdb, 0x20, 0x7d, 0x30, 0x55, 0x67, 0x07, 0x53, 0xaf,
+	0xc9, 0x07, 0x53, 0x13, 0x3c, 0x8d, 0xd4, 0xb9, 0xd4, 0x0f, 0x2a, 0xf0, 0x9c, 0x92, 0xb6, 0x59,
+	0xa2, 0xa4, 0xa2, 0x3c, 0xd2, 0x6d, 0xa8, 0x65, 0x82, 0xda, 0x97, 0x8e, 0x59, 0x3f, 0x9e, 0x77,
+	0x65, 0xa9, 0xc1, 0x30, 0xf1, 0xad, 0x12, 0x85, 0xaf, 0x14, 0x8f, 0xc1, 0xf5, 0x4e, 0x1a, 0x43,
+	0xf4, 0x43, 0xb7, 0xa0, 0xc9, 0x12, 0x06, 0x83, 0x43, 0x1b, 0x3f, 0x16, 0xa7, 0xc7, 0x17, 0x95,
+	0xa6, 0x99, 0xb6, 0x7b, 0xcf, 0xc6, 0x8f, 0x8d, 0x86, 0x13, 0xff, 0x0e, 0x89, 0xe2, 0x5a, 0x76,
+	0x78, 0x30, 0x18, 0x9a, 0x23, 0x73, 0x68, 0x47, 0x47, 0xc2, 0x4b, 0x27, 0xc0, 0x75, 0x0e, 0x43,
+	0xcf, 0x41, 0x9d, 0x36, 0x1a, 0x87, 0xd8, 0xe2, 0x66, 0xb4, 0x46, 0x00, 0x0f, 0x43, 0x6c, 0x11,
+	0x5d, 0x64, 0x23, 0xf8, 0xae, 0x6b, 0x47, 0x11, 0xb6, 0xb8, 0x97, 0x41, 0xc7, 0x5d, 0x17, 0x40,
+	0x32, 0xc6, 0x70, 0x34, 0x1e, 0x8c, 0x43, 0x62, 0x8a, 0x89, 0xf5, 0xd4, 0x8c, 0xda, 0x70, 0x34,
+	0x7e, 0x18, 0x72, 0x03, 0xec, 0x32, 0x7b, 0x4d, 0x51, 0xb0, 0x2c, 0x26, 0x30, 0x10, 0x45, 0xf2,
+	0x02, 0x34, 0x79, 0x03, 0x9a, 0xcd, 0xe1, 0x87, 0xb4, 0xbc, 0xd3, 0x36, 0x01, 0xa1, 0x17, 0xa1,
+	0x1d, 0xd2, 0xe4, 0xd5, 0xc0, 0x7b, 0x34, 0x08, 0x84, 0x57, 0xa1, 0x11, 0x97, 0x81, 0x40, 0x37,
+	0x1f, 0x19, 0xc4, 0x65, 0xb8, 0x01, 0xcb, 0xbc, 0xd5, 0xa3, 0x31, 0x1e, 0xe3, 0x81, 0x63, 0x46,
+	0xd8, 0x1b, 0x1e, 0xd1, 0x33, 0x18, 0xcd, 0x40, 0xec, 0xdd, 0x57, 0xc9, 0xab, 0xfb, 0xec, 0x8d,
+	0xfe, 0x9b, 0x15, 0x80, 0x84, 0x7b, 0x24, 0x7e, 0x4d, 0xac, 0x22, 0x37, 0x73, 0x29, 0x08, 0xf1,
+	0xb6, 0x64, 0xdf, 0x5e, 0x3c, 0x22, 0x23, 0x39, 0x1b, 0xb2, 0xec, 0x30, 0xe2, 0x92, 0x73, 0xfd,
+	0xf8, 0xd5, 0x12, 0x42, 0x44, 0x84, 0x9a, 0x6b, 0x55, 0x98, 0x40, 0xd0, 0xab, 0x80, 0xf6, 0x02,
+	0xff, 0xb1, 0xed, 0xed, 0xa5, 0x23, 0x32, 0x16, 0xb8, 0x2d, 0xf2, 0x37, 0xa9, 0x90, 0xec, 0x9b,
+	0xd0, 0xc9, 0x34, 0x17, 0x42, 0xf3, 0xda, 0x04, 0x32, 0xee, 0x49, 0x63, 0x71, 0x05, 0x5f, 0x90,
+	0x31, 0xd0, 0xc3, 0xf4, 0x6d, 0x33, 0xd8, 0xc3, 0x42, 0xe6, 0xb9, 0x34, 0xc9, 0xc0, 0xde, 0x00,
+	0x3a, 0xd9, 0x59, 0x29, 0xce, 0xa8, 0x5f, 0x97, 0x4d, 0xc1, 0x71, 0x16, 0x9b, 0x0c, 0x93, 0x32,
+	0x06, 0x3d, 0x13, 0x96, 0x55, 0xf4, 0x2a, 0x90, 0x9c, 0xda, 0xde, 0x7c, 0x31, 0x0e, 0x1a, 0xe8,
+	0x3a, 0x14, 0xed, 0xc3, 0xa9, 0xd4, 0x7c, 0x49, 0x4a, 0xcd, 0xeb, 0x3f, 0x57, 0x06, 0x94, 0x37,
+	0x10, 0xa8, 0x0d, 0xa5, 0x78, 0x90, 0xd2, 0x46, 0x3f, 0x23, 0x6e, 0xa5, 0x9c, 0xb8, 0x9d, 0x87,
+	0x7a, 0xec, 0x17, 0xf1, 0x4d, 0x30, 0x01, 0xa4, 0x85, 0xb1, 0x22, 0x0b, 0x63, 0x8a, 0xb0, 0xaa,
+	0x7c, 0x66, 0x70, 0x03, 0x96, 0x1d, 0x33, 0x8c, 0x06, 0xec, 0x68, 0x22, 0xb2, 0x5d, 0x1c, 0x46,
+	0xa6, 0x3b, 0xa2, 0x4b, 0x59, 0x31, 0x10, 0x79, 0xd7, 0x27, 0xaf, 0xb6, 0xc5, 0x1b, 0xb4, 0x2d,
+	0xe2, 0x0f, 0xb2, 0x3b, 0xf1, 0x22, 0x92, 0xd7, 0xa7, 0x33, 0x88, 0xc9, 0x81, 0x00, 0x93, 0xa8,
+	0x7a, 0xec, 0x98, 0xf7, 0x3e, 0x84, 0xb6, 0xfc, 0x52, 0xb1, 0x7c, 0x6f, 0xca, 0xcb, 0x37, 0x8d,
+	0xeb, 0x9f, 0x5a, 0xc3, 0x7d, 0x40, 0x79, 0xf3, 0x9a, 0xe6, 0x99, 0x26, 0xf3, 0x6c, 0xd2, 0x5a,
+	0xa4, 0x78, 0x5a, 0x96, 0x17, 0xfb, 0xfb, 0x65, 0x40, 0x89, 0x8f, 0x1b, 0x17, 0x04, 0x4c, 0xe3,
+	0x18, 0x5e, 0x87, 0xa5, 0xbc, 0x07, 0x2c, 0xdc, 0x7e, 0x94, 0xf3, 0x7f, 0x55, 0xbe, 0x6a, 0x59,
+	0x55, 0x42, 0xfc, 0x46, 0xbc, 0x21, 0x32, 0x87, 0xfe, 0x62, 0xe1, 0x89, 0x8f, 0xbc, 0x27, 0x7e,
+	0x23, 0x5b, 0x7a, 0xcc, 0xec, 0xc7, 0x9b, 0xca, 0xcd, 0x2b, 0x37, 0xe5, 0x89, 0x75, 0xc7, 0x52,
+	0xa8, 0x31, 0x77, 0xa2, 0x50, 0xe3, 0x1a, 0x2c, 0x8a, 0x54, 0x58, 0x38, 0x0e, 0x47, 0xd8, 0xb3,
+	0xf8, 0x6e, 0x55, 0x33, 0x3a, 0xfc, 0xc5, 0x96, 0x80, 0xcf, 0x5e, 0x55, 0xfc, 0x83, 0x12, 0x2c,
+	0xc6, 0x5c, 0x3f, 0xd1, 0x8a, 0x4e, 0x2e, 0xf4, 0xf8, 0x88, 0x97, 0xf0, 0x03, 0xf5, 0x12, 0x7e,
+	0xe6, 0xd8, 0xd8, 0x70, 0xda, 0x15, 0x9c, 0x9d, 0xb3, 0xbf, 0x5b, 0x82, 0x79, 0x9e, 0xe6, 0xcf,
+	0x99, 0xc3, 0x69, 0xd2, 0x2f, 0xcb, 0x50, 0x25, 0xd6, 0x57, 0xe4, 0x68, 0xd9, 0x03, 0xe3, 0x69,
+	0xba, 0x6c, 0x9d, 0x5b, 0xc4, 0x96, 0x54, 0xb5, 0x8e, 0xbe, 0x0e, 0x9d, 0x91, 0x63, 0x0e, 0x31,
+	0xdd, 0xa6, 0x1d, 0x73, 0x87, 0xb8, 0x67, 0x8c, 0x3d, 0x37, 0x8e, 0x39, 0xb7, 0x58, 0x7b, 0x20,
+	0xfa, 0xdc, 0xa7, 0x5d, 0xf8, 0xf6, 0x38, 0x92, 0xa1, 0xbd, 0xdb, 0xb0, 0xac, 0x6a, 0xa8, 0xf0,
+	0x83, 0x25, 0xee, 0xd4, 0xd3, 0xdc, 0xf9, 0xe5, 0x32, 0xc0, 0xd6, 0x91, 0x37, 0xbc, 0xc5, 0x6c,
+	0xce, 0x0d, 0xa8, 0x4c, 0xaa, 0xc2, 0x24, 0xad, 0xa9, 0xaa, 0xd0, 0x96, 0x53, 0x88, 0x9f, 0x94,
+	0x01, 0x2b, 0x67, 0x33, 0x60, 0x45, 0xb9, 0xab, 0xe2, 0x1d, 0xe5, 0x33, 0x50, 0xa1, 0x3b, 0x03,
+	0x2b, 0x52, 0x9c, 0xaa, 0x0c, 0x80, 0x76, 0x40, 0xab, 0x20, 0x3c, 0x8c, 0x0d, 0x8f, 0xb9, 0x10,
+	0x74, 0x77, 0x29, 0x1b, 0x59, 0x30, 0x7a, 0x89, 0x3a, 0x7f, 0x0e, 0xb6, 0xe2, 0x86, 0x2c, 0x88,
+	0xcf, 0x40, 0xf3, 0x0e, 0x4a, 0x5d, 0xe1, 0xa0, 0x10, 0xbc, 0x56, 0xe0, 0x8f, 0x46, 0xa9, 0xe1,
+	0x58, 0xea, 0x2b, 0x0b, 0xd6, 0xbf, 0x57, 0x86, 0x73, 0x84, 0xbf, 0x4f, 0x27, 0x0c, 0x9b, 0x46,
+	0xba, 0x53, 0xdb, 0x53, 0x59, 0xde, 0x9e, 0xde, 0x84, 0x79, 0x96, 0x5f, 0x13, 0x01, 0xc5, 0xc5,
+	0x22, 0x69, 0x60, 0xb2, 0x63, 0x88, 0xe6, 0xb3, 0x26, 0x69, 0xa4, 0x22, 0x89, 0xb9, 0xd9, 0x8a,
+	0x24, 0xe6, 0xb3, 0x59, 0xf8, 0x94, 0x58, 0xd5, 0xb2, 0x95, 0x97, 0x99, 0xfa, 0xbe, 0xfa, 0xa9,
+	0xea, 0xfb, 0x1e, 0x42, 0xcb, 0x90, 0x4c, 0x00, 0x82, 0x4a, 0xaa, 0xbc, 0x9b, 0xfe, 0xa6, 0xe9,
+	0x19, 0x11, 0x21, 0x95, 0xa8, 0x2d, 0x8e, 0x9f, 0xd5, 0xf6, 0x46, 0xff, 0x2f, 0x0d, 0xce, 0x8a,
+	0xc3, 0x78, 0x6e, 0x25, 0x4e, 0x2f, 0x18, 0x37, 0x61, 0x85, 0x9b, 0xae, 0x8c, 0x0d, 0x63, 0xe6,
+	0x61, 0x89, 0xc1, 0xe4, 0x69, 0xdc, 0x84, 0x95, 0x88, 0x0a, 0x69, 0xb6, 0x0f, 0x13, 0x9b, 0x25,
+	0xf6, 0x52, 0xee, 0x33, 0x4d, 0x31, 0xc4, 0xf3, 0xac, 0xd6, 0x8f, 0xaf, 0x10, 0xd7, 0x75, 0xf0,
+	0xc6, 0x2e, 0x9f, 0xa5, 0xfe, 0x18, 0xce, 0xb3, 0x0f, 0x2c, 0x76, 0x64, 0x8a, 0x66, 0x3a, 0x0b,
+	0x53, 0xce, 0x5b, 0xb6, 0xdd, 0xfa, 0x1f, 0x69, 0x70, 0xa1, 0x00, 0xf3, 0x2c, 0x59, 0x80, 0xfb,
+	0x4a, 0xec, 0x05, 0x39, 0x1b, 0x09, 0x2f, 0x2b, 0x74, 0x91, 0x89, 0xfc, 0x51, 0x05, 0x16, 0x73,
+	0x8d, 0x4e, 0x2c, 0x73, 0xaf, 0x00, 0x22, 0x8b, 0x10, 0x7f, 0x4c, 0x4c, 0xd3, 0x60, 0xdc, 0x4b,
+	0xe8, 0x78, 0x63, 0x37, 0xfe, 0x90, 0x78, 0xd3, 0xb7, 0x30, 0xb2, 0x59, 0x6b, 0x76, 0x12, 0x16,
+	0xaf, 0x5c, 0xa5, 0xf8, 0x9b, 0xb1, 0x1c, 0x81, 0x6b, 0x9b, 0x63, 0x97, 0x1d, 0x9a, 0xf1, 0x55,
+	0x66, 0x3b, 0x1c, 0x41, 0x25, 0x81, 0xd1, 0x2e, 0x2c, 0xd2, 0x4a, 0xd0, 0x71, 0xb4, 0xe7, 0x13,
+	0xcd, 0xa4, 0x74, 0xb1, 0x0d, 0xf4, 0x73, 0x53, 0x63, 0xfa, 0x0a, 0xef, 0x4d, 0x88, 0xe7, 0x5b,
+	0xa9, 0x27, 0x43, 0x05, 0x1e, 0xdb, 0x1b, 0xfa, 0x6e, 0x8c, 0x67, 0xee, 0x84, 0x78, 0x36, 0x78,
+	0x6f, 0x19, 0x4f, 0x1a, 0xda, 0x5b, 0x87, 0x15, 0xe5, 0xd4, 0x27, 0x79, 0x34, 0xd5, 0x74, 0x3c,
+	0x7a, 0x1b, 0x96, 0x55, 0xb3, 0x3a, 0xc5, 0x18, 0x39, 0x8a, 0x4f, 0x32, 0x86, 0xfe, 0x67, 0x25,
+	0x68, 0xf5, 0xb1, 0x83, 0x23, 0xfc, 0xd1, 0xd6, 0x2a, 0xe4, 0x0a, 0x2f, 0xca, 0xf9, 0xc2, 0x8b,
+	0x5c, 0x15, 0x49, 0x45, 0x51, 0x45, 0x72, 0x21, 0x2e, 0x9e, 0x21, 0xa3, 0x54, 0x65, 0x57, 0xc4,
+	0x42, 0x9f, 0x87, 0xe6, 0x28, 0xb0, 0x5d, 0x33, 0x38, 0x1a, 0x1c, 0xe0, 0xa3, 0x90, 0xef, 0x3d,
+	0x5d, 0xe5, 0xee, 0xb5, 0xd1, 0x0f, 0x8d, 0x06, 0x6f, 0xfd, 0x0e, 0x3e, 0xa2, 0x85, 0x39, 0x71,
+	0x70, 0xcb, 0x6a, 0x37, 0x2b, 0x46, 0x0a, 0xa2, 0xff, 0xbe, 0x06, 0xdd, 0x3b, 0x4f, 0x22, 0xec,
+	0x59, 0x34, 0xd6, 0xb0, 0x5d, 0xec, 0x8f, 0xa3, 0x8f, 0x76, 0x6f, 0xbf, 0x06, 0x8b, 0x98, 0x60,
+	0x0c, 0xe9, 0xb9, 0x0d, 0x1e, 0xfa, 0x1e, 0x2d, 0x49, 0x21, 0x0d, 0x3b, 0xf1, 0x8b, 0x2d, 0x06,
+	0xd7, 0x1d, 0x58, 0xba, 0x6f, 0x87, 0x11, 0x4d, 0xaa, 0xce, 0xf4, 0x75, 0x16, 0x59, 0x51, 0x36,
+	0x08, 0x5d, 0x08, 0x71, 0xfe, 0xd0, 0xe4, 0x40, 0xb2, 0x10, 0xa1, 0xbe, 0x05, 0x0d, 0x8e, 0xa9,
+	0xd0, 0x5e, 0x21, 0xa8, 0x58, 0x38, 0x1c, 0x72, 0xdb, 0x4c, 0x7f, 0x93, 0xbd, 0x9d, 0x38, 0x19,
+	0x87, 0x66, 0xc4, 0x8f, 0xe0, 0x6b, 0x46, 0x02, 0xd0, 0xbf, 0xab, 0xc1, 0xb2, 0x3c, 0x87, 0x59,
+	0xec, 0x74, 0x3f, 0x99, 0xc7, 0xc4, 0x0f, 0xde, 0x52, 0x73, 0x89, 0x27, 0x4a, 0x8f, 0x03, 0x75,
+	0x17, 0xce, 0xde, 0xe2, 0x04, 0xf2, 0x46, 0xa7, 0xe7, 0x2c, 0xfd, 0x48, 0x21, 0xe1, 0x2c, 0xe7,
+	0x4c, 0x23, 0xc5, 0x58, 0xdd, 0x87, 0x6e, 0x1f, 0x9b, 0xcf, 0x10, 0xa1, 0x07, 0x2b, 0xfd, 0xe0,
+	0xc8, 0x18, 0x7b, 0xcf, 0x48, 0x70, 0xde, 0x82, 0xf6, 0xb6, 0x19, 0x1e, 0xdc, 0x4a, 0x4e, 0x39,
+	0x51, 0x2a, 0x64, 0xa9, 0xf3, 0xa0, 0xa4, 0x20, 0xd3, 0xae, 0xff, 0x43, 0x09, 0x16, 0x38, 0xa1,
+	0x64, 0x14, 0xda, 0x3f, 0x3b, 0x49, 0x2d, 0x37, 0xc9, 0x18, 0x45, 0x29, 0x85, 0x62, 0x9a, 0xaf,
+	0x27, 0x8e, 0x2f, 0x08, 0x91, 0xe2, 0xa2, 0x6a, 0x36, 0x2e, 0x4a, 0x39, 0xe6, 0x73, 0xb2, 0x63,
+	0xfe, 0x56, 0xe2, 0x98, 0xcf, 0x17, 0x1f, 0x51, 0xcb, 0x5c, 0x4a, 0x9c, 0xf3, 0x1e, 0xd4, 0x46,
+	0x81, 0xed, 0x07, 0xc4, 0x0d, 0x60, 0x65, 0xa0, 0xf1, 0x33, 0x61, 0x1b, 0xaf, 0xfa, 0x61, 0xa7,
+	0xf7, 0xfc, 0x89, 0xc0, 0x23, 0xc2, 0xae, 0x3e, 0x4f, 0xa5, 0xf3, 0x27, 0xfd, 0xdb, 0x1a, 0x9c,
+	0xcd, 0xae, 0xfe, 0x2c, 0x2a, 0xf7, 0x59, 0xa8, 0x92, 0x91, 0x8f, 0xfd, 0x0c, 0x37, 0xb3, 0x7c,
+	0x06, 0xeb, 0xa1, 0xff, 0xa2, 0x06, 0x2b, 0xbc, 0x1e, 0x69, 0xe6, 0x5a, 0xa9, 0x69, 0x6c, 0x6b,
+	0x22, 0x61, 0x65, 0x49, 0xc2, 0xbe, 0x4d, 0xfd, 0x74, 0x99, 0x8e, 0x8f, 0x89, 0x25, 0xdf, 0xd5,
+	0xe0, 0x79, 0x9e, 0xa1, 0x4a, 0x62, 0xad, 0x67, 0xc2, 0x9c, 0x2e, 0xcc, 0xf3, 0x94, 0x19, 0x37,
+	0xd2, 0xe2, 0x51, 0xff, 0x6b, 0x0d, 0xba, 0xb4, 0x2e, 0x8b, 0x5e, 0x23, 0xc0, 0x2f, 0x3e, 0xf9,
+	0x68, 0x89, 0x99, 0xf2, 0xf3, 0x4f, 0x5a, 0x99, 0x7b, 0x68, 0x3a, 0x03, 0x37, 0xe4, 0xaa, 0x0a,
+	0x02, 0xf4, 0x6e, 0xa8, 0xff, 0x0c, 0x74, 0x88, 0x8f, 0x94, 0xa6, 0xba, 0x30, 0x49, 0xff, 0x32,
+	0x2c, 0x70, 0xd7, 0x38, 0x75, 0x2e, 0x4d, 0x1a, 0xb4, 0x19, 0x38, 0x3e, 0xde, 0x48, 0x1a, 0xc6,
+	0x47, 0x77, 0xe5, 0x74, 0xc3, 0xf8, 0x90, 0xfc, 0xe7, 0xcb, 0xb0, 0x98, 0x46, 0x7d, 0xe7, 0x10,
+	0x7b, 0xd1, 0xa9, 0x6f, 0xb9, 0x99, 0xc8, 0x31, 0x6a, 0x22, 0xf8, 0x55, 0x35, 0x2c, 0x06, 0x88,
+	0x9f, 0x55, 0x93, 0xab, 0x28, 0x27, 0x77, 0x05, 0xda, 0xac, 0x84, 0x3a, 0x75, 0x32, 0x44, 0x73,
+	0x23, 0x14, 0x7a, 0x1c, 0x0f, 0xe6, 0x54, 0x3c, 0x48, 0xc6, 0x8b, 0xdb, 0xcd, 0xa7, 0xc6, 0x8b,
+	0x9b, 0x7d, 0x4e, 0x44, 0xcf, 0xb5, 0xe2, 0xaf, 0xb4, 0xb3, 0x2b, 0x29, 0x72, 0x7a, 0xe7, 0xa1,
+	0x9e, 0x9c, 0x4f, 0xf0, 0x8f, 0x47, 0x63, 0x80, 0x1e, 0x40, 0x8f, 0x38, 0x17, 0x7d, 0xfb, 0x10,
+	0x07, 0x7b, 0xf8, 0xd9, 0x7c, 0xc5, 0xfe, 0xf7, 0x1a, 0x20, 0x19, 0xe1, 0x49, 0xea, 0x19, 0x93,
+	0xbd, 0xa7, 0xa4, 0x48, 0x83, 0x14, 0xa4, 0x7d, 0x7a, 0x50, 0x63, 0x07, 0xba, 0xf1, 0x96, 0x15,
+	0x3f, 0xa7, 0xf6, 0x87, 0xaa, 0xb4, 0x3f, 0xd0, 0x33, 0x5b, 0x46, 0xe5, 0x20, 0xb4, 0xbd, 0x21,
+	0x16, 0x07, 0x75, 0x02, 0xba, 0x45, 0x80, 0xfa, 0x6f, 0x69, 0xf0, 0x9c, 0x92, 0x85, 0xb3, 0x18,
+	0xc8, 0x2f, 0xc1, 0x3c, 0xa3, 0x4f, 0x98, 0x48, 0xe5, 0xb9, 0x77, 0x9e, 0x89, 0x86, 0xe8, 0xa6,
+	0xff, 0x87, 0x06, 0xe7, 0x36, 0xfd, 0xc8, 0xde, 0x4d, 0xd5, 0x94, 0x7d, 0xcc, 0xdf, 0xc8, 0x1f,
+	0x73, 0xd2, 0x46, 0xaf, 0x16, 0xa3, 0x64, 0x62, 0x8b, 0x56, 0xd1, 0x55, 0xc5, 0xd5, 0x62, 0x29,
+	0x20, 0xc1, 0x10, 0x03, 0xb6, 0x7d, 0xbe, 0x1c, 0x69, 0xd0, 0xd5, 0x6b, 0x50, 0x8f, 0x3f, 0xc0,
+	0x41, 0x35, 0xa8, 0xdc, 0x1d, 0x3b, 0x4e, 0xe7, 0x0c, 0xaa, 0x43, 0x95, 0x1e, 0xc7, 0x75, 0x34,
+	0xf2, 0x93, 0x26, 0xdd, 0x3b, 0xa5, 0xab, 0x5f, 0x82, 0x7a, 0xfc, 0x21, 0x00, 0x6a, 0xc0, 0xfc,
+	0x43, 0xef, 0x1d, 0xcf, 0x7f, 0xec, 0x75, 0xce, 0xa0, 0x79, 0x28, 0xdf, 0x72, 0x9c, 0x8e, 0x86,
+	0x5a, 0x50, 0xdf, 0x8a, 0x02, 0x6c, 0x92, 0x70, 0xb2, 0x53, 0x42, 0x6d, 0x80, 0xb7, 0xed, 0x30,
+	0xf2, 0x03, 0x7b, 0x68, 0x3a, 0x9d, 0xf2, 0xd5, 0x6f, 0x41, 0x5b, 0xae, 0x0b, 0x43, 0x4d, 0xa8,
+	0x6d, 0xfa, 0xd1, 0x9d, 0x27, 0x76, 0x18, 0x75, 0xce, 0x90, 0xf6, 0x9b, 0x7e, 0xf4, 0x20, 0xc0,
+	0x21, 0xf6, 0xa2, 0x8e, 0x86, 0x00, 0xe6, 0xbe, 0xe2, 0xf5, 0xed, 0xf0, 0xa0, 0x53, 0x42, 0x4b,
+	0xbc, 0xe4, 0xd3, 0x74, 0x36, 0x78, 0xb1, 0x55, 0xa7, 0x4c, 0xba, 0xc7, 0x4f, 0x15, 0xd4, 0x81,
+	0x66, 0xdc, 0xe4, 0xde, 0x83, 0x87, 0x9d, 0x2a, 0xa3, 0x9e, 0xfc, 0x9c, 0xbb, 0x6a, 0x41, 0x27,
+	0x5b, 0xaa, 0x4c, 0xc6, 0x64, 0x93, 0x88, 0x41, 0x9d, 0x33, 0x64, 0x66, 0xbc, 0x56, 0xbc, 0xa3,
+	0xa1, 0x05, 0x68, 0xa4, 0x2a, 0xaf, 0x3b, 0x25, 0x02, 0xb8, 0x17, 0x8c, 0x86, 0x5c, 0x34, 0x18,
+	0x09, 0xc4, 0x94, 0xf4, 0x09, 0x27, 0x2a, 0x57, 0x6f, 0x43, 0x4d, 0x9c, 0x22, 0x91, 0xa6, 0x9c,
+	0x45, 0xe4, 0xb1, 0x73, 0x06, 0x2d, 0x42, 0x4b, 0xba, 0x38, 0xa7, 0xa3, 0x21, 0x04, 0x6d, 0xf9,
+	0x6a, 0xab, 0x4e, 0xe9, 0xea, 0x4d, 0x80, 0xe4, 0x80, 0x85, 0x90, 0xb3, 0xe1, 0x1d, 0x9a, 0x8e,
+	0x6d, 0x31, 0xda, 0xc8, 0x2b, 0xc2, 0x5d, 0xca, 0x1d, 0x96, 0x43, 0xe8, 0x94, 0xae, 0x7e, 0x01,
+	0x6a, 0x22, 0x25, 0x4f, 0xe0, 0x06, 0x76, 0xfd, 0x43, 0xcc, 0x56, 0x66, 0x0b, 0x47, 0x6c, 0x1d,
+	0x6f, 0xb9, 0xd8, 0xb3, 0x3a, 0x25, 0x42, 0xc6, 0xc3, 0x91, 0x65, 0x46, 0xe2, 0xe3, 0xbd, 0x4e,
+	0xf9, 0xe6, 0x7f, 0x3f, 0x07, 0xc0, 0x6a, 0x8f, 0x7d, 0x3f, 0xb0, 0x90, 0x43, 0xbf, 0x41, 0x20,
+	0x8a, 0xe0, 0x7b, 0xa2, 0x30, 0x32, 0x44, 0x6b, 0x99, 0x83, 0x6c, 0xf6, 0x90, 0x6f, 0xc8, 0x79,
+	0xd3, 0x7b, 0x51, 0xd9, 0x3e, 0xd3, 0x58, 0x3f, 0x83, 0x5c, 0x8a, 0x8d, 0x04, 0xc3, 0xdb, 0xf6,
+	0xf0, 0x20, 0x2e, 0x58, 0x2e, 0xbe, 0x72, 0x2a, 0xd3, 0x54, 0xe0, 0xbb, 0xac, 0xc4, 0xb7, 0x15,
+	0x05, 0xb6, 0xb7, 0x27, 0xcc, 0x8b, 0x7e, 0x06, 0x3d, 0xca, 0x5c, 0x78, 0x25, 0x10, 0xde, 0x9c,
+	0xe6, 0x8e, 0xab, 0xd3, 0xa1, 0x74, 0x60, 0x21, 0x73, 0xb3, 0x20, 0xba, 0xaa, 0xbe, 0x39, 0x44,
+	0x75, 0x0b, 0x62, 0xef, 0xda, 0x54, 0x6d, 0x63, 0x6c, 0x36, 0xb4, 0xe5, 0x2b, 0xf1, 0xd0, 0x27,
+	0x8b, 0x06, 0xc8, 0xdd, 0x5d, 0xd4, 0xbb, 0x3a, 0x4d, 0xd3, 0x18, 0xd5, 0xfb, 0x4c, 0x7c, 0x27,
+	0xa1, 0x52, 0x5e, 0x17, 0xd5, 0x3b, 0xce, 0xb2, 0xeb, 0x67, 0xd0, 0x87, 0xb0, 0x98, 0xbb, 0x61,
+	0x09, 0xbd, 0xa2, 0xce, 0xc3, 0xa9, 0x2f, 0x62, 0x9a, 0x84, 0xe1, 0xfd, 0xac, 0xf2, 0x15, 0x53,
+	0x9f, 0xbb, 0xba, 0x6d, 0x7a, 0xea, 0x53, 0xc3, 0x1f, 0x47, 0xfd, 0x89, 0x31, 0x38, 0xec, 0x94,
+	0x48, 0x71, 0xb7, 0x4b, 0x56, 0x94, 0x93, 0x43, 0x9a, 0xe2, 0x8b, 0x60, 0x26, 0x61, 0x1b, 0x53,
+	0x25, 0xcd, 0x16, 0xdd, 0xbf, 0x5a, 0x50, 0xce, 0xa7, 0xbe, 0x54, 0xaa, 0xb7, 0x36, 0x6d, 0xf3,
+	0xb4, 0x2c, 0xcb, 0xf7, 0x16, 0xa9, 0x97, 0x48, 0x79, 0xd7, 0x92, 0x5a, 0x96, 0xd5, 0xd7, 0x20,
+	0xe9, 0x67, 0xd0, 0xb6, 0x64, 0xea, 0xd1, 0x4b, 0x45, 0xa2, 0x20, 0x07, 0x4f, 0x93, 0xf8, 0xf6,
+	0xd3, 0x80, 0x98, 0xa6, 0x7a, 0xbb, 0xf6, 0xde, 0x38, 0x30, 0x99, 0x18, 0x17, 0x19, 0xb7, 0x7c,
+	0x53, 0x81, 0xe6, 0x53, 0x27, 0xe8, 0x11, 0x4f, 0x69, 0x00, 0x70, 0x0f, 0x47, 0xef, 0xd2, 0x0b,
+	0x6c, 0xc2, 0xec, 0x8c, 0x12, 0xfb, 0xcd, 0x1b, 0x08, 0x54, 0x2f, 0x4f, 0x6c, 0x17, 0x23, 0xd8,
+	0x81, 0xc6, 0x3d, 0x1c, 0xf1, 0x1c, 0x76, 0x88, 0x0a, 0x7b, 0x8a, 0x16, 0x02, 0xc5, 0xea, 0xe4,
+	0x86, 0x69, 0xe3, 0x99, 0xb9, 0xc3, 0x09, 0x15, 0x2e, 0x6c, 0xfe, 0x66, 0x29, 0xb5, 0xf1, 0x2c,
+	0xb8, 0x14, 0x8a, 0xcd, 0x88, 0x46, 0xd2, 0x6f, 0x63, 0xd3, 0x89, 0xf6, 0x0b, 0x66, 0x94, 0x6a,
+	0x71, 0xfc, 0x8c, 0xa4, 0x86, 0x31, 0x0e, 0x0c, 0x4b, 0x4c, 0x0b, 0xe5, 0x83, 0xb2, 0xeb, 0xea,
+	0x21, 0xf2, 0x2d, 0xa7, 0x14, 0x3d, 0x13, 0x16, 0xfb, 0x81, 0x3f, 0x92, 0x91, 0xbc, 0xaa, 0x44,
+	0x92, 0x6b, 0x37, 0x25, 0x8a, 0xaf, 0x41, 0x53, 0x9c, 0x47, 0xd2, 0x13, 0x14, 0x35, 0x17, 0xd2,
+	0x4d, 0xa6, 0x1c, 0xf8, 0x03, 0x58, 0xc8, 0x1c, 0x74, 0xaa, 0x17, 0x5d, 0x7d, 0x1a, 0x3a, 0x69,
+	0xf4, 0xc7, 0x80, 0xe8, 0xc5, 0x5c, 0xf2, 0xdd, 0x82, 0x6a, 0xff, 0x26, 0xdf, 0x50, 0x20, 0xb9,
+	0x3e, 0x75, 0xfb, 0x78, 0xe5, 0x7f, 0x16, 0x56, 0x94, 0x87, 0x89, 0x48, 0x59, 0x08, 0x72, 0xdc,
+	0x89, 0x67, 0xd6, 0x20, 0x1c, 0xdb, 0x23, 0xc6, 0xff, 0x21, 0x2c, 0xe6, 0x8e, 0x1f, 0xd4, 0xbb,
+	0x52, 0xd1, 0x29, 0xc5, 0x24, 0xd6, 0x0e, 0xa1, 0x99, 0xce, 0xbe, 0x23, 0xe5, 0x77, 0x01, 0x8a,
+	0x33, 0x86, 0xac, 0x02, 0xa9, 0x1a, 0xc6, 0xd3, 0xf8, 0x00, 0x16, 0x32, 0xf9, 0x74, 0xb5, 0x74,
+	0xa8, 0x93, 0xee, 0x53, 0x6c, 0xdd, 0xb9, 0xf4, 0xb9, 0x9a, 0x49, 0x45, 0x59, 0xf6, 0x49, 0x18,
+	0x6c, 0x68, 0xcb, 0x19, 0x53, 0xf5, 0xae, 0xa6, 0xcc, 0xa9, 0xab, 0x77, 0x35, 0x75, 0x02, 0x96,
+	0xa1, 0x92, 0x33, 0x91, 0x6a, 0x54, 0xca, 0xac, 0x69, 0xef, 0xea, 0x34, 0x4d, 0x63, 0x54, 0x1e,
+	0x74, 0x8b, 0x32, 0x8d, 0x48, 0x59, 0x0a, 0x3c, 0x21, 0x2f, 0x39, 0xd9, 0x01, 0x5a, 0xcc, 0x65,
+	0x11, 0xd5, 0xeb, 0x54, 0x94, 0x6c, 0xec, 0x5d, 0x29, 0xf4, 0x56, 0xd3, 0x49, 0x36, 0xfd, 0xcc,
+	0x0d, 0x0d, 0x3d, 0x61, 0x47, 0x63, 0x99, 0xb4, 0x05, 0x5a, 0x2b, 0x12, 0x5b, 0x75, 0x8a, 0xa8,
+	0x77, 0x7d, 0xea, 0xf6, 0x31, 0x5f, 0xbf, 0x09, 0x9d, 0x6c, 0x66, 0x02, 0x5d, 0x53, 0xa7, 0xb4,
+	0x94, 0xf9, 0x8b, 0x09, 0x7c, 0xbc, 0xf9, 0x3f, 0x08, 0xea, 0x34, 0xf8, 0xa3, 0x26, 0xfc, 0xff,
+	0x63, 0xbf, 0xa7, 0x1b, 0xfb, 0x7d, 0x00, 0x0b, 0x99, 0x5b, 0xe4, 0xd4, 0xb6, 0x4a, 0x7d, 0xd5,
+	0xdc, 0x14, 0x21, 0x8c, 0x7c, 0x73, 0x9a, 0x5a, 0xbd, 0x95, 0xb7, 0xab, 0x4d, 0x1a, 0xfb, 0x3d,
+	0x76, 0x43, 0x63, 0x92, 0xa5, 0x2d, 0x2c, 0x05, 0x95, 0xbf, 0xa9, 0xff, 0xf8, 0x43, 0xa3, 0x9f,
+	0xec, 0xb0, 0xf4, 0x03, 0x58, 0xc8, 0xdc, 0x5a, 0xa3, 0x96, 0x18, 0xf5, 0xd5, 0x36, 0x53, 0xec,
+	0x3d, 0xcf, 0x2a, 0xa2, 0xb2, 0x60, 0x49, 0x71, 0x49, 0x88, 0xda, 0x64, 0x16, 0xdf, 0x26, 0x32,
+	0x79, 0x42, 0x2d, 0x49, 0x4d, 0xd1, 0x6a, 0x11, 0x91, 0xd9, 0x9b, 0xca, 0x7b, 0xaf, 0x4c, 0x77,
+	0xad, 0x79, 0x3c, 0xa1, 0x2d, 0x98, 0x63, 0x77, 0xd9, 0xa0, 0x17, 0xd4, 0x05, 0xa7, 0xa9, 0x7b,
+	0x6e, 0x7a, 0x93, 0x6e, 0xc3, 0x09, 0xc7, 0x4e, 0x44, 0xe8, 0xff, 0x3a, 0xb4, 0x19, 0x28, 0x66,
+	0xd0, 0x53, 0x1c, 0x7c, 0x0b, 0xaa, 0xd4, 0xb4, 0x23, 0x65, 0x05, 0x63, 0xfa, 0xc6, 0x9a, 0xde,
+	0xe4, 0x4b, 0x6a, 0x12, 0x8a, 0x5b, 0x5f, 0x65, 0x7f, 0x30, 0xc1, 0x09, 0x7e, 0x9a, 0x83, 0xff,
+	0xdf, 0x0e, 0x98, 0x9f, 0xd0, 0xfb, 0x56, 0xb2, 0x5f, 0x14, 0xa2, 0xb5, 0x93, 0x7d, 0x16, 0xa9,
+	0xf6, 0x22, 0x8e, 0xf9, 0x54, 0x91, 0x79, 0x11, 0xd9, 0xa2, 0x62, 0xb5, 0x17, 0x51, 0x50, 0x7a,
+	0x3c, 0x49, 0x0d, 0xbf, 0x0c, 0x73, 0xac, 0x0c, 0x4c, 0x2d, 0xbe, 0x52, 0x89, 0xd8, 0xa4, 0xb1,
+	0xbe, 0xa3, 0xc1, 0xb9, 0xfe, 0xd8, 0x1d, 0xf5, 0xed, 0x70, 0x44, 0xb6, 0x45, 0x1c, 0x24, 0x17,
+	0x93, 0xbd, 0x5e, 0xb0, 0xae, 0x05, 0xed, 0x05, 0xc6, 0x37, 0x4e, 0xda, 0x2d, 0x66, 0xdc, 0x37,
+	0x88, 0x7e, 0xe2, 0x83, 0xa4, 0x11, 0x7a, 0xa5, 0x50, 0xf9, 0xd2, 0xcd, 0xa6, 0x9b, 0xeb, 0xed,
+	0x4f, 0xbf, 0x7f, 0x73, 0xcf, 0x8e, 0xf6, 0xc7, 0x3b, 0xe4, 0xcd, 0x75, 0xd6, 0xf4, 0x55, 0xdb,
+	0xe7, 0xbf, 0xae, 0x8b, 0xc1, 0xaf, 0xd3, 0xde, 0xd7, 0x29, 0x33, 0x47, 0x3b, 0x3b, 0x73, 0xf4,
+	0xf1, 0xb5, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x78, 0x9e, 0x07, 0xa1, 0xcd, 0x67, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -4914,6 +6378,16 @@ type QueryCoordClient interface {
 	TransferReplica(ctx context.Context, in *TransferReplicaRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	ListResourceGroups(ctx context.Context, in *milvuspb.ListResourceGroupsRequest, opts ...grpc.CallOption) (*milvuspb.ListResourceGroupsResponse, error)
 	DescribeResourceGroup(ctx context.Context, in *DescribeResourceGroupRequest, opts ...grpc.CallOption) (*DescribeResourceGroupResponse, error)
+	ExtendLoadTimeout(ctx context.Context, in *ExtendLoadTimeoutRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListCheckers(ctx context.Context, in *ListCheckersRequest, opts ...grpc.CallOption) (*ListCheckersResponse, error)
+	ActivateChecker(ctx context.Context, in *ActivateCheckerRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DeactivateChecker(ctx context.Context, in *DeactivateCheckerRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DryRunCheckers(ctx context.Context, in *DryRunCheckersRequest, opts ...grpc.CallOption) (*DryRunCheckersResponse, error)
+	TriggerBalance(ctx context.Context, in *TriggerBalanceRequest, opts ...grpc.CallOption) (*TriggerBalanceResponse, error)
+	SuspendCollectionBalance(ctx context.Context, in *SuspendCollectionBalanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	WatchLoadProgress(ctx context.Context, in *WatchLoadProgressRequest, opts ...grpc.CallOption) (QueryCoord_WatchLoadProgressClient, error)
+	ListDivergedLeaders(ctx context.Context, in *ListDivergedLeadersRequest, opts ...grpc.CallOption) (*ListDivergedLeadersResponse, error)
+	NotifyCompaction(ctx context.Context, in *NotifyCompactionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 }
 
 type queryCoordClient struct {
@@ -5032,108 +6506,221 @@ func (c *queryCoordClient) GetSegmentInfo(ctx context.Context, in *GetSegmentInf
 	return out, nil
 }
 
-func (c *queryCoordClient) LoadBalance(ctx context.Context, in *LoadBalanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/LoadBalance", in, out, opts...)
+func (c *queryCoordClient) LoadBalance(ctx context.Context, in *LoadBalanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/LoadBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) ShowConfigurations(ctx context.Context, in *internalpb.ShowConfigurationsRequest, opts ...grpc.CallOption) (*internalpb.ShowConfigurationsResponse, error) {
+	out := new(internalpb.ShowConfigurationsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/ShowConfigurations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
+	out := new(milvuspb.GetMetricsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) GetReplicas(ctx context.Context, in *milvuspb.GetReplicasRequest, opts ...grpc.CallOption) (*milvuspb.GetReplicasResponse, error) {
+	out := new(milvuspb.GetReplicasResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetReplicas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) GetShardLeaders(ctx context.Context, in *GetShardLeadersRequest, opts ...grpc.CallOption) (*GetShardLeadersResponse, error) {
+	out := new(GetShardLeadersResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetShardLeaders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error) {
+	out := new(milvuspb.CheckHealthResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/CheckHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) CreateResourceGroup(ctx context.Context, in *milvuspb.CreateResourceGroupRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/CreateResourceGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) DropResourceGroup(ctx context.Context, in *milvuspb.DropResourceGroupRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/DropResourceGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) TransferNode(ctx context.Context, in *milvuspb.TransferNodeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/TransferNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) TransferReplica(ctx context.Context, in *TransferReplicaRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/TransferReplica", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) ListResourceGroups(ctx context.Context, in *milvuspb.ListResourceGroupsRequest, opts ...grpc.CallOption) (*milvuspb.ListResourceGroupsResponse, error) {
+	out := new(milvuspb.ListResourceGroupsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/ListResourceGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) DescribeResourceGroup(ctx context.Context, in *DescribeResourceGroupRequest, opts ...grpc.CallOption) (*DescribeResourceGroupResponse, error) {
+	out := new(DescribeResourceGroupResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/DescribeResourceGroup", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *queryCoordClient) ShowConfigurations(ctx context.Context, in *internalpb.ShowConfigurationsRequest, opts ...grpc.CallOption) (*internalpb.ShowConfigurationsResponse, error) {
-	out := new(internalpb.ShowConfigurationsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/ShowConfigurations", in, out, opts...)
+func (c *queryCoordClient) ExtendLoadTimeout(ctx context.Context, in *ExtendLoadTimeoutRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/ExtendLoadTimeout", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *queryCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
-	out := new(milvuspb.GetMetricsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetMetrics", in, out, opts...)
+func (c *queryCoordClient) ListCheckers(ctx context.Context, in *ListCheckersRequest, opts ...grpc.CallOption) (*ListCheckersResponse, error) {
+	out := new(ListCheckersResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/ListCheckers", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *queryCoordClient) GetReplicas(ctx context.Context, in *milvuspb.GetReplicasRequest, opts ...grpc.CallOption) (*milvuspb.GetReplicasResponse, error) {
-	out := new(milvuspb.GetReplicasResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetReplicas", in, out, opts...)
+func (c *queryCoordClient) ActivateChecker(ctx context.Context, in *ActivateCheckerRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/ActivateChecker", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *queryCoordClient) GetShardLeaders(ctx context.Context, in *GetShardLeadersRequest, opts ...grpc.CallOption) (*GetShardLeadersResponse, error) {
-	out := new(GetShardLeadersResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetShardLeaders", in, out, opts...)
+func (c *queryCoordClient) DeactivateChecker(ctx context.Context, in *DeactivateCheckerRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/DeactivateChecker", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *queryCoordClient) CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error) {
-	out := new(milvuspb.CheckHealthResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/CheckHealth", in, out, opts...)
+func (c *queryCoordClient) DryRunCheckers(ctx context.Context, in *DryRunCheckersRequest, opts ...grpc.CallOption) (*DryRunCheckersResponse, error) {
+	out := new(DryRunCheckersResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/DryRunCheckers", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *queryCoordClient) CreateResourceGroup(ctx context.Context, in *milvuspb.CreateResourceGroupRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/CreateResourceGroup", in, out, opts...)
+func (c *queryCoordClient) TriggerBalance(ctx context.Context, in *TriggerBalanceRequest, opts ...grpc.CallOption) (*TriggerBalanceResponse, error) {
+	out := new(TriggerBalanceResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/TriggerBalance", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *queryCoordClient) DropResourceGroup(ctx context.Context, in *milvuspb.DropResourceGroupRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+func (c *queryCoordClient) SuspendCollectionBalance(ctx context.Context, in *SuspendCollectionBalanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/DropResourceGroup", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/SuspendCollectionBalance", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *queryCoordClient) TransferNode(ctx context.Context, in *milvuspb.TransferNodeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/TransferNode", in, out, opts...)
+func (c *queryCoordClient) WatchLoadProgress(ctx context.Context, in *WatchLoadProgressRequest, opts ...grpc.CallOption) (QueryCoord_WatchLoadProgressClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_QueryCoord_serviceDesc.Streams[0], "/milvus.proto.query.QueryCoord/WatchLoadProgress", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &queryCoordWatchLoadProgressClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *queryCoordClient) TransferReplica(ctx context.Context, in *TransferReplicaRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/TransferReplica", in, out, opts...)
-	if err != nil {
+type QueryCoord_WatchLoadProgressClient interface {
+	Recv() (*LoadProgressEvent, error)
+	grpc.ClientStream
+}
+
+type queryCoordWatchLoadProgressClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryCoordWatchLoadProgressClient) Recv() (*LoadProgressEvent, error) {
+	m := new(LoadProgressEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
-func (c *queryCoordClient) ListResourceGroups(ctx context.Context, in *milvuspb.ListResourceGroupsRequest, opts ...grpc.CallOption) (*milvuspb.ListResourceGroupsResponse, error) {
-	out := new(milvuspb.ListResourceGroupsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/ListResourceGroups", in, out, opts...)
+func (c *queryCoordClient) ListDivergedLeaders(ctx context.Context, in *ListDivergedLeadersRequest, opts ...grpc.CallOption) (*ListDivergedLeadersResponse, error) {
+	out := new(ListDivergedLeadersResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/ListDivergedLeaders", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *queryCoordClient) DescribeResourceGroup(ctx context.Context, in *DescribeResourceGroupRequest, opts ...grpc.CallOption) (*DescribeResourceGroupResponse, error) {
-	out := new(DescribeResourceGroupResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/DescribeResourceGroup", in, out, opts...)
+func (c *queryCoordClient) NotifyCompaction(ctx context.Context, in *NotifyCompactionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/NotifyCompaction", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5168,6 +6755,16 @@ type QueryCoordServer interface {
 	TransferReplica(context.Context, *TransferReplicaRequest) (*commonpb.Status, error)
 	ListResourceGroups(context.Context, *milvuspb.ListResourceGroupsRequest) (*milvuspb.ListResourceGroupsResponse, error)
 	DescribeResourceGroup(context.Context, *DescribeResourceGroupRequest) (*DescribeResourceGroupResponse, error)
+	ExtendLoadTimeout(context.Context, *ExtendLoadTimeoutRequest) (*commonpb.Status, error)
+	ListCheckers(context.Context, *ListCheckersRequest) (*ListCheckersResponse, error)
+	ActivateChecker(context.Context, *ActivateCheckerRequest) (*commonpb.Status, error)
+	DeactivateChecker(context.Context, *DeactivateCheckerRequest) (*commonpb.Status, error)
+	DryRunCheckers(context.Context, *DryRunCheckersRequest) (*DryRunCheckersResponse, error)
+	TriggerBalance(context.Context, *TriggerBalanceRequest) (*TriggerBalanceResponse, error)
+	SuspendCollectionBalance(context.Context, *SuspendCollectionBalanceRequest) (*commonpb.Status, error)
+	WatchLoadProgress(*WatchLoadProgressRequest, QueryCoord_WatchLoadProgressServer) error
+	ListDivergedLeaders(context.Context, *ListDivergedLeadersRequest) (*ListDivergedLeadersResponse, error)
+	NotifyCompaction(context.Context, *NotifyCompactionRequest) (*commonpb.Status, error)
 }
 
 // UnimplementedQueryCoordServer can be embedded to have forward compatible implementations.
@@ -5246,6 +6843,36 @@ func (*UnimplementedQueryCoordServer) ListResourceGroups(ctx context.Context, re
 func (*UnimplementedQueryCoordServer) DescribeResourceGroup(ctx context.Context, req *DescribeResourceGroupRequest) (*DescribeResourceGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeResourceGroup not implemented")
 }
+func (*UnimplementedQueryCoordServer) ExtendLoadTimeout(ctx context.Context, req *ExtendLoadTimeoutRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendLoadTimeout not implemented")
+}
+func (*UnimplementedQueryCoordServer) ListCheckers(ctx context.Context, req *ListCheckersRequest) (*ListCheckersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCheckers not implemented")
+}
+func (*UnimplementedQueryCoordServer) ActivateChecker(ctx context.Context, req *ActivateCheckerRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateChecker not implemented")
+}
+func (*UnimplementedQueryCoordServer) DeactivateChecker(ctx context.Context, req *DeactivateCheckerRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivateChecker not implemented")
+}
+func (*UnimplementedQueryCoordServer) DryRunCheckers(ctx context.Context, req *DryRunCheckersRequest) (*DryRunCheckersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DryRunCheckers not implemented")
+}
+func (*UnimplementedQueryCoordServer) TriggerBalance(ctx context.Context, req *TriggerBalanceRequest) (*TriggerBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerBalance not implemented")
+}
+func (*UnimplementedQueryCoordServer) SuspendCollectionBalance(ctx context.Context, req *SuspendCollectionBalanceRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendCollectionBalance not implemented")
+}
+func (*UnimplementedQueryCoordServer) WatchLoadProgress(req *WatchLoadProgressRequest, srv QueryCoord_WatchLoadProgressServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchLoadProgress not implemented")
+}
+func (*UnimplementedQueryCoordServer) ListDivergedLeaders(ctx context.Context, req *ListDivergedLeadersRequest) (*ListDivergedLeadersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDivergedLeaders not implemented")
+}
+func (*UnimplementedQueryCoordServer) NotifyCompaction(ctx context.Context, req *NotifyCompactionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NotifyCompaction not implemented")
+}
 
 func RegisterQueryCoordServer(s *grpc.Server, srv QueryCoordServer) {
 	s.RegisterService(&_QueryCoord_serviceDesc, srv)
@@ -5683,6 +7310,189 @@ func _QueryCoord_DescribeResourceGroup_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryCoord_ExtendLoadTimeout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendLoadTimeoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).ExtendLoadTimeout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/ExtendLoadTimeout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).ExtendLoadTimeout(ctx, req.(*ExtendLoadTimeoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_ListCheckers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCheckersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).ListCheckers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/ListCheckers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).ListCheckers(ctx, req.(*ListCheckersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_ActivateChecker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivateCheckerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).ActivateChecker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/ActivateChecker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).ActivateChecker(ctx, req.(*ActivateCheckerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_DeactivateChecker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivateCheckerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).DeactivateChecker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/DeactivateChecker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).DeactivateChecker(ctx, req.(*DeactivateCheckerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_DryRunCheckers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DryRunCheckersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).DryRunCheckers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/DryRunCheckers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).DryRunCheckers(ctx, req.(*DryRunCheckersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_TriggerBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).TriggerBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/TriggerBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).TriggerBalance(ctx, req.(*TriggerBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_SuspendCollectionBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendCollectionBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).SuspendCollectionBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/SuspendCollectionBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).SuspendCollectionBalance(ctx, req.(*SuspendCollectionBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_WatchLoadProgress_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchLoadProgressRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryCoordServer).WatchLoadProgress(m, &queryCoordWatchLoadProgressServer{stream})
+}
+
+type QueryCoord_WatchLoadProgressServer interface {
+	Send(*LoadProgressEvent) error
+	grpc.ServerStream
+}
+
+type queryCoordWatchLoadProgressServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryCoordWatchLoadProgressServer) Send(m *LoadProgressEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _QueryCoord_ListDivergedLeaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDivergedLeadersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).ListDivergedLeaders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/ListDivergedLeaders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).ListDivergedLeaders(ctx, req.(*ListDivergedLeadersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_NotifyCompaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotifyCompactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).NotifyCompaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/NotifyCompaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).NotifyCompaction(ctx, req.(*NotifyCompactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _QueryCoord_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.query.QueryCoord",
 	HandlerType: (*QueryCoordServer)(nil),
@@ -5783,8 +7593,50 @@ var _QueryCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DescribeResourceGroup",
 			Handler:    _QueryCoord_DescribeResourceGroup_Handler,
 		},
+		{
+			MethodName: "ExtendLoadTimeout",
+			Handler:    _QueryCoord_ExtendLoadTimeout_Handler,
+		},
+		{
+			MethodName: "ListCheckers",
+			Handler:    _QueryCoord_ListCheckers_Handler,
+		},
+		{
+			MethodName: "ActivateChecker",
+			Handler:    _QueryCoord_ActivateChecker_Handler,
+		},
+		{
+			MethodName: "DeactivateChecker",
+			Handler:    _QueryCoord_DeactivateChecker_Handler,
+		},
+		{
+			MethodName: "DryRunCheckers",
+			Handler:    _QueryCoord_DryRunCheckers_Handler,
+		},
+		{
+			MethodName: "TriggerBalance",
+			Handler:    _QueryCoord_TriggerBalance_Handler,
+		},
+		{
+			MethodName: "SuspendCollectionBalance",
+			Handler:    _QueryCoord_SuspendCollectionBalance_Handler,
+		},
+		{
+			MethodName: "ListDivergedLeaders",
+			Handler:    _QueryCoord_ListDivergedLeaders_Handler,
+		},
+		{
+			MethodName: "NotifyCompaction",
+			Handler:    _QueryCoord_NotifyCompaction_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchLoadProgress",
+			Handler:       _QueryCoord_WatchLoadProgress_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "query_coord.proto",
 }
 
@@ -5815,6 +7667,9 @@ type QueryNodeClient interface {
 	GetDataDistribution(ctx context.Context, in *GetDataDistributionRequest, opts ...grpc.CallOption) (*GetDataDistributionResponse, error)
 	SyncDistribution(ctx context.Context, in *SyncDistributionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	// admin only, for recovering the channels whose checkpoints are wrong
+	DumpDispatcherPositions(ctx context.Context, in *internalpb.DumpDispatcherPositionsRequest, opts ...grpc.CallOption) (*internalpb.DumpDispatcherPositionsResponse, error)
+	SeekDispatcher(ctx context.Context, in *internalpb.SeekDispatcherRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 }
 
 type queryNodeClient struct {
@@ -6023,6 +7878,24 @@ func (c *queryNodeClient) Delete(ctx context.Context, in *DeleteRequest, opts ..
 	return out, nil
 }
 
+func (c *queryNodeClient) DumpDispatcherPositions(ctx context.Context, in *internalpb.DumpDispatcherPositionsRequest, opts ...grpc.CallOption) (*internalpb.DumpDispatcherPositionsResponse, error) {
+	out := new(internalpb.DumpDispatcherPositionsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/DumpDispatcherPositions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryNodeClient) SeekDispatcher(ctx context.Context, in *internalpb.SeekDispatcherRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/SeekDispatcher", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryNodeServer is the server API for QueryNode service.
 type QueryNodeServer interface {
 	GetComponentStates(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)
@@ -6048,6 +7921,9 @@ type QueryNodeServer interface {
 	GetDataDistribution(context.Context, *GetDataDistributionRequest) (*GetDataDistributionResponse, error)
 	SyncDistribution(context.Context, *SyncDistributionRequest) (*commonpb.Status, error)
 	Delete(context.Context, *DeleteRequest) (*commonpb.Status, error)
+	// admin only, for recovering the channels whose checkpoints are wrong
+	DumpDispatcherPositions(context.Context, *internalpb.DumpDispatcherPositionsRequest) (*internalpb.DumpDispatcherPositionsResponse, error)
+	SeekDispatcher(context.Context, *internalpb.SeekDispatcherRequest) (*commonpb.Status, error)
 }
 
 // UnimplementedQueryNodeServer can be embedded to have forward compatible implementations.
@@ -6120,6 +7996,12 @@ func (*UnimplementedQueryNodeServer) SyncDistribution(ctx context.Context, req *
 func (*UnimplementedQueryNodeServer) Delete(ctx context.Context, req *DeleteRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (*UnimplementedQueryNodeServer) DumpDispatcherPositions(ctx context.Context, req *internalpb.DumpDispatcherPositionsRequest) (*internalpb.DumpDispatcherPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DumpDispatcherPositions not implemented")
+}
+func (*UnimplementedQueryNodeServer) SeekDispatcher(ctx context.Context, req *internalpb.SeekDispatcherRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SeekDispatcher not implemented")
+}
 
 func RegisterQueryNodeServer(s *grpc.Server, srv QueryNodeServer) {
 	s.RegisterService(&_QueryNode_serviceDesc, srv)
@@ -6521,6 +8403,42 @@ func _QueryNode_Delete_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryNode_DumpDispatcherPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.DumpDispatcherPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryNodeServer).DumpDispatcherPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryNode/DumpDispatcherPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryNodeServer).DumpDispatcherPositions(ctx, req.(*internalpb.DumpDispatcherPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryNode_SeekDispatcher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.SeekDispatcherRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryNodeServer).SeekDispatcher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryNode/SeekDispatcher",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryNodeServer).SeekDispatcher(ctx, req.(*internalpb.SeekDispatcherRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _QueryNode_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.query.QueryNode",
 	HandlerType: (*QueryNodeServer)(nil),
@@ -6613,6 +8531,14 @@ var _QueryNode_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _QueryNode_Delete_Handler,
 		},
+		{
+			MethodName: "DumpDispatcherPositions",
+			Handler:    _QueryNode_DumpDispatcherPositions_Handler,
+		},
+		{
+			MethodName: "SeekDispatcher",
+			Handler:    _QueryNode_SeekDispatcher_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "query_coord.proto",
@@ -242,6 +242,7 @@ type ImportResult struct {
 	AutoIds              []int64                  `protobuf:"varint,6,rep,packed,name=auto_ids,json=autoIds,proto3" json:"auto_ids,omitempty"`
 	RowCount             int64                    `protobuf:"varint,7,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
 	Infos                []*commonpb.KeyValuePair `protobuf:"bytes,8,rep,name=infos,proto3" json:"infos,omitempty"`
+	CompletedFiles       []string                 `protobuf:"bytes,9,rep,name=completed_files,json=completedFiles,proto3" json:"completed_files,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -328,6 +329,13 @@ func (m *ImportResult) GetInfos() []*commonpb.KeyValuePair {
 	return nil
 }
 
+func (m *ImportResult) GetCompletedFiles() []string {
+	if m != nil {
+		return m.CompletedFiles
+	}
+	return nil
+}
+
 type DescribeSegmentsRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
@@ -655,6 +663,619 @@ func (m *GetCredentialResponse) GetPassword() string {
 	return ""
 }
 
+type CloneCollectionRequest struct {
+	Base   *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// source collection
+	CollectionName    string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	NewCollectionName string `protobuf:"bytes,4,opt,name=new_collection_name,json=newCollectionName,proto3" json:"new_collection_name,omitempty"`
+	// the source segments to clone, which must be flushed,
+	// all the segments are cloned if empty, the growing ones are sealed and cloned once flushed
+	SegmentIDs []int64 `protobuf:"varint,5,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	// the indexes of these fields are not cloned
+	SkipIndexFields      []string `protobuf:"bytes,6,rep,name=skip_index_fields,json=skipIndexFields,proto3" json:"skip_index_fields,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CloneCollectionRequest) Reset()         { *m = CloneCollectionRequest{} }
+func (m *CloneCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*CloneCollectionRequest) ProtoMessage()    {}
+func (*CloneCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{11}
+}
+
+func (m *CloneCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloneCollectionRequest.Unmarshal(m, b)
+}
+func (m *CloneCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloneCollectionRequest.Marshal(b, m, deterministic)
+}
+func (m *CloneCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloneCollectionRequest.Merge(m, src)
+}
+func (m *CloneCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_CloneCollectionRequest.Size(m)
+}
+func (m *CloneCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloneCollectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloneCollectionRequest proto.InternalMessageInfo
+
+func (m *CloneCollectionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CloneCollectionRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *CloneCollectionRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *CloneCollectionRequest) GetNewCollectionName() string {
+	if m != nil {
+		return m.NewCollectionName
+	}
+	return ""
+}
+
+func (m *CloneCollectionRequest) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+func (m *CloneCollectionRequest) GetSkipIndexFields() []string {
+	if m != nil {
+		return m.SkipIndexFields
+	}
+	return nil
+}
+
+type GetCapacityReportRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetCapacityReportRequest) Reset()         { *m = GetCapacityReportRequest{} }
+func (m *GetCapacityReportRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCapacityReportRequest) ProtoMessage()    {}
+func (*GetCapacityReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{12}
+}
+
+func (m *GetCapacityReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCapacityReportRequest.Unmarshal(m, b)
+}
+func (m *GetCapacityReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCapacityReportRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCapacityReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCapacityReportRequest.Merge(m, src)
+}
+func (m *GetCapacityReportRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCapacityReportRequest.Size(m)
+}
+func (m *GetCapacityReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCapacityReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCapacityReportRequest proto.InternalMessageInfo
+
+func (m *GetCapacityReportRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type ResourceGroupCapacity struct {
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	NumNodes int64  `protobuf:"varint,2,opt,name=num_nodes,json=numNodes,proto3" json:"num_nodes,omitempty"`
+	// bytes of memory used and available on the query nodes of the resource group
+	MemoryUsed  uint64 `protobuf:"varint,3,opt,name=memory_used,json=memoryUsed,proto3" json:"memory_used,omitempty"`
+	MemoryTotal uint64 `protobuf:"varint,4,opt,name=memory_total,json=memoryTotal,proto3" json:"memory_total,omitempty"`
+	// projected by the growth of the recent days, -1 means the usage is not growing
+	DaysUntilFull        float64  `protobuf:"fixed64,5,opt,name=days_until_full,json=daysUntilFull,proto3" json:"days_until_full,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResourceGroupCapacity) Reset()         { *m = ResourceGroupCapacity{} }
+func (m *ResourceGroupCapacity) String() string { return proto.CompactTextString(m) }
+func (*ResourceGroupCapacity) ProtoMessage()    {}
+func (*ResourceGroupCapacity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{13}
+}
+
+func (m *ResourceGroupCapacity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResourceGroupCapacity.Unmarshal(m, b)
+}
+func (m *ResourceGroupCapacity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResourceGroupCapacity.Marshal(b, m, deterministic)
+}
+func (m *ResourceGroupCapacity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceGroupCapacity.Merge(m, src)
+}
+func (m *ResourceGroupCapacity) XXX_Size() int {
+	return xxx_messageInfo_ResourceGroupCapacity.Size(m)
+}
+func (m *ResourceGroupCapacity) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceGroupCapacity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceGroupCapacity proto.InternalMessageInfo
+
+func (m *ResourceGroupCapacity) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ResourceGroupCapacity) GetNumNodes() int64 {
+	if m != nil {
+		return m.NumNodes
+	}
+	return 0
+}
+
+func (m *ResourceGroupCapacity) GetMemoryUsed() uint64 {
+	if m != nil {
+		return m.MemoryUsed
+	}
+	return 0
+}
+
+func (m *ResourceGroupCapacity) GetMemoryTotal() uint64 {
+	if m != nil {
+		return m.MemoryTotal
+	}
+	return 0
+}
+
+func (m *ResourceGroupCapacity) GetDaysUntilFull() float64 {
+	if m != nil {
+		return m.DaysUntilFull
+	}
+	return 0
+}
+
+type NodeDiskCapacity struct {
+	NodeID    int64  `protobuf:"varint,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	Role      string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	DiskUsed  uint64 `protobuf:"varint,3,opt,name=disk_used,json=diskUsed,proto3" json:"disk_used,omitempty"`
+	DiskTotal uint64 `protobuf:"varint,4,opt,name=disk_total,json=diskTotal,proto3" json:"disk_total,omitempty"`
+	// projected by the growth of the recent days, -1 means the usage is not growing
+	DaysUntilFull        float64  `protobuf:"fixed64,5,opt,name=days_until_full,json=daysUntilFull,proto3" json:"days_until_full,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeDiskCapacity) Reset()         { *m = NodeDiskCapacity{} }
+func (m *NodeDiskCapacity) String() string { return proto.CompactTextString(m) }
+func (*NodeDiskCapacity) ProtoMessage()    {}
+func (*NodeDiskCapacity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{14}
+}
+
+func (m *NodeDiskCapacity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeDiskCapacity.Unmarshal(m, b)
+}
+func (m *NodeDiskCapacity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeDiskCapacity.Marshal(b, m, deterministic)
+}
+func (m *NodeDiskCapacity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeDiskCapacity.Merge(m, src)
+}
+func (m *NodeDiskCapacity) XXX_Size() int {
+	return xxx_messageInfo_NodeDiskCapacity.Size(m)
+}
+func (m *NodeDiskCapacity) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeDiskCapacity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeDiskCapacity proto.InternalMessageInfo
+
+func (m *NodeDiskCapacity) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *NodeDiskCapacity) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *NodeDiskCapacity) GetDiskUsed() uint64 {
+	if m != nil {
+		return m.DiskUsed
+	}
+	return 0
+}
+
+func (m *NodeDiskCapacity) GetDiskTotal() uint64 {
+	if m != nil {
+		return m.DiskTotal
+	}
+	return 0
+}
+
+func (m *NodeDiskCapacity) GetDaysUntilFull() float64 {
+	if m != nil {
+		return m.DaysUntilFull
+	}
+	return 0
+}
+
+type ChannelBacklog struct {
+	NodeID int64  `protobuf:"varint,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	Role   string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	// the slowest channel consumed by the node
+	ChannelName          string   `protobuf:"bytes,3,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
+	LagMs                int64    `protobuf:"varint,4,opt,name=lag_ms,json=lagMs,proto3" json:"lag_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelBacklog) Reset()         { *m = ChannelBacklog{} }
+func (m *ChannelBacklog) String() string { return proto.CompactTextString(m) }
+func (*ChannelBacklog) ProtoMessage()    {}
+func (*ChannelBacklog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{15}
+}
+
+func (m *ChannelBacklog) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelBacklog.Unmarshal(m, b)
+}
+func (m *ChannelBacklog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelBacklog.Marshal(b, m, deterministic)
+}
+func (m *ChannelBacklog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelBacklog.Merge(m, src)
+}
+func (m *ChannelBacklog) XXX_Size() int {
+	return xxx_messageInfo_ChannelBacklog.Size(m)
+}
+func (m *ChannelBacklog) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelBacklog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelBacklog proto.InternalMessageInfo
+
+func (m *ChannelBacklog) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *ChannelBacklog) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *ChannelBacklog) GetChannelName() string {
+	if m != nil {
+		return m.ChannelName
+	}
+	return ""
+}
+
+func (m *ChannelBacklog) GetLagMs() int64 {
+	if m != nil {
+		return m.LagMs
+	}
+	return 0
+}
+
+type GetCapacityReportResponse struct {
+	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ResourceGroups       []*ResourceGroupCapacity `protobuf:"bytes,2,rep,name=resource_groups,json=resourceGroups,proto3" json:"resource_groups,omitempty"`
+	NodeDisks            []*NodeDiskCapacity      `protobuf:"bytes,3,rep,name=node_disks,json=nodeDisks,proto3" json:"node_disks,omitempty"`
+	Backlogs             []*ChannelBacklog        `protobuf:"bytes,4,rep,name=backlogs,proto3" json:"backlogs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *GetCapacityReportResponse) Reset()         { *m = GetCapacityReportResponse{} }
+func (m *GetCapacityReportResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCapacityReportResponse) ProtoMessage()    {}
+func (*GetCapacityReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{16}
+}
+
+func (m *GetCapacityReportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCapacityReportResponse.Unmarshal(m, b)
+}
+func (m *GetCapacityReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCapacityReportResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCapacityReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCapacityReportResponse.Merge(m, src)
+}
+func (m *GetCapacityReportResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCapacityReportResponse.Size(m)
+}
+func (m *GetCapacityReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCapacityReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCapacityReportResponse proto.InternalMessageInfo
+
+func (m *GetCapacityReportResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetCapacityReportResponse) GetResourceGroups() []*ResourceGroupCapacity {
+	if m != nil {
+		return m.ResourceGroups
+	}
+	return nil
+}
+
+func (m *GetCapacityReportResponse) GetNodeDisks() []*NodeDiskCapacity {
+	if m != nil {
+		return m.NodeDisks
+	}
+	return nil
+}
+
+func (m *GetCapacityReportResponse) GetBacklogs() []*ChannelBacklog {
+	if m != nil {
+		return m.Backlogs
+	}
+	return nil
+}
+
+type GetCollectionStatsHistoryRequest struct {
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// the collection is looked up by name if the id is not set
+	CollectionID int64 `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// unix seconds of the time range, 0 means unbounded
+	StartTime            int64    `protobuf:"varint,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime              int64    `protobuf:"varint,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCollectionStatsHistoryRequest) Reset()         { *m = GetCollectionStatsHistoryRequest{} }
+func (m *GetCollectionStatsHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCollectionStatsHistoryRequest) ProtoMessage()    {}
+func (*GetCollectionStatsHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{17}
+}
+
+func (m *GetCollectionStatsHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCollectionStatsHistoryRequest.Unmarshal(m, b)
+}
+func (m *GetCollectionStatsHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCollectionStatsHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCollectionStatsHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCollectionStatsHistoryRequest.Merge(m, src)
+}
+func (m *GetCollectionStatsHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCollectionStatsHistoryRequest.Size(m)
+}
+func (m *GetCollectionStatsHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCollectionStatsHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCollectionStatsHistoryRequest proto.InternalMessageInfo
+
+func (m *GetCollectionStatsHistoryRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetCollectionStatsHistoryRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *GetCollectionStatsHistoryRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *GetCollectionStatsHistoryRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *GetCollectionStatsHistoryRequest) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *GetCollectionStatsHistoryRequest) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+// CollectionStatsSample is the statistics of a collection over the interval before the sample time.
+type CollectionStatsSample struct {
+	// unix seconds
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// searches and queries per second
+	Qps                  float64  `protobuf:"fixed64,2,opt,name=qps,proto3" json:"qps,omitempty"`
+	AvgLatencyMs         float64  `protobuf:"fixed64,3,opt,name=avg_latency_ms,json=avgLatencyMs,proto3" json:"avg_latency_ms,omitempty"`
+	RowsInserted         int64    `protobuf:"varint,4,opt,name=rows_inserted,json=rowsInserted,proto3" json:"rows_inserted,omitempty"`
+	RowsDeleted          int64    `protobuf:"varint,5,opt,name=rows_deleted,json=rowsDeleted,proto3" json:"rows_deleted,omitempty"`
+	BytesStored          int64    `protobuf:"varint,6,opt,name=bytes_stored,json=bytesStored,proto3" json:"bytes_stored,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CollectionStatsSample) Reset()         { *m = CollectionStatsSample{} }
+func (m *CollectionStatsSample) String() string { return proto.CompactTextString(m) }
+func (*CollectionStatsSample) ProtoMessage()    {}
+func (*CollectionStatsSample) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{18}
+}
+
+func (m *CollectionStatsSample) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CollectionStatsSample.Unmarshal(m, b)
+}
+func (m *CollectionStatsSample) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CollectionStatsSample.Marshal(b, m, deterministic)
+}
+func (m *CollectionStatsSample) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectionStatsSample.Merge(m, src)
+}
+func (m *CollectionStatsSample) XXX_Size() int {
+	return xxx_messageInfo_CollectionStatsSample.Size(m)
+}
+func (m *CollectionStatsSample) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectionStatsSample.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollectionStatsSample proto.InternalMessageInfo
+
+func (m *CollectionStatsSample) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *CollectionStatsSample) GetQps() float64 {
+	if m != nil {
+		return m.Qps
+	}
+	return 0
+}
+
+func (m *CollectionStatsSample) GetAvgLatencyMs() float64 {
+	if m != nil {
+		return m.AvgLatencyMs
+	}
+	return 0
+}
+
+func (m *CollectionStatsSample) GetRowsInserted() int64 {
+	if m != nil {
+		return m.RowsInserted
+	}
+	return 0
+}
+
+func (m *CollectionStatsSample) GetRowsDeleted() int64 {
+	if m != nil {
+		return m.RowsDeleted
+	}
+	return 0
+}
+
+func (m *CollectionStatsSample) GetBytesStored() int64 {
+	if m != nil {
+		return m.BytesStored
+	}
+	return 0
+}
+
+type GetCollectionStatsHistoryResponse struct {
+	Status       *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	CollectionID int64            `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// ordered by timestamp
+	Samples              []*CollectionStatsSample `protobuf:"bytes,3,rep,name=samples,proto3" json:"samples,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *GetCollectionStatsHistoryResponse) Reset()         { *m = GetCollectionStatsHistoryResponse{} }
+func (m *GetCollectionStatsHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCollectionStatsHistoryResponse) ProtoMessage()    {}
+func (*GetCollectionStatsHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{19}
+}
+
+func (m *GetCollectionStatsHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCollectionStatsHistoryResponse.Unmarshal(m, b)
+}
+func (m *GetCollectionStatsHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCollectionStatsHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCollectionStatsHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCollectionStatsHistoryResponse.Merge(m, src)
+}
+func (m *GetCollectionStatsHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCollectionStatsHistoryResponse.Size(m)
+}
+func (m *GetCollectionStatsHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCollectionStatsHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCollectionStatsHistoryResponse proto.InternalMessageInfo
+
+func (m *GetCollectionStatsHistoryResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetCollectionStatsHistoryResponse) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *GetCollectionStatsHistoryResponse) GetSamples() []*CollectionStatsSample {
+	if m != nil {
+		return m.Samples
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*AllocTimestampRequest)(nil), "milvus.proto.rootcoord.AllocTimestampRequest")
 	proto.RegisterType((*AllocTimestampResponse)(nil), "milvus.proto.rootcoord.AllocTimestampResponse")
@@ -669,113 +1290,163 @@ func init() {
 	proto.RegisterMapType((map[int64]*SegmentInfos)(nil), "milvus.proto.rootcoord.DescribeSegmentsResponse.SegmentInfosEntry")
 	proto.RegisterType((*GetCredentialRequest)(nil), "milvus.proto.rootcoord.GetCredentialRequest")
 	proto.RegisterType((*GetCredentialResponse)(nil), "milvus.proto.rootcoord.GetCredentialResponse")
+	proto.RegisterType((*CloneCollectionRequest)(nil), "milvus.proto.rootcoord.CloneCollectionRequest")
+	proto.RegisterType((*GetCapacityReportRequest)(nil), "milvus.proto.rootcoord.GetCapacityReportRequest")
+	proto.RegisterType((*ResourceGroupCapacity)(nil), "milvus.proto.rootcoord.ResourceGroupCapacity")
+	proto.RegisterType((*NodeDiskCapacity)(nil), "milvus.proto.rootcoord.NodeDiskCapacity")
+	proto.RegisterType((*ChannelBacklog)(nil), "milvus.proto.rootcoord.ChannelBacklog")
+	proto.RegisterType((*GetCapacityReportResponse)(nil), "milvus.proto.rootcoord.GetCapacityReportResponse")
+	proto.RegisterType((*GetCollectionStatsHistoryRequest)(nil), "milvus.proto.rootcoord.GetCollectionStatsHistoryRequest")
+	proto.RegisterType((*CollectionStatsSample)(nil), "milvus.proto.rootcoord.CollectionStatsSample")
+	proto.RegisterType((*GetCollectionStatsHistoryResponse)(nil), "milvus.proto.rootcoord.GetCollectionStatsHistoryResponse")
 }
 
 func init() { proto.RegisterFile("root_coord.proto", fileDescriptor_4513485a144f6b06) }
 
 var fileDescriptor_4513485a144f6b06 = []byte{
-	// 1616 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x6d, 0x93, 0xda, 0xb6,
-	0x16, 0x0e, 0x90, 0xdd, 0x85, 0x03, 0x0b, 0x1b, 0x4d, 0x5e, 0xb8, 0x24, 0xf7, 0x5e, 0x42, 0xf6,
-	0xde, 0x90, 0x37, 0x36, 0xdd, 0xcc, 0xa4, 0x69, 0xbe, 0x65, 0x21, 0xb3, 0x61, 0xda, 0x9d, 0x6c,
-	0x4d, 0xd2, 0xa6, 0x2f, 0x3b, 0x54, 0xd8, 0x0a, 0x78, 0xd6, 0x58, 0xc4, 0x12, 0xfb, 0x32, 0xfd,
-	0xd0, 0xe9, 0x4c, 0xbf, 0xf7, 0x3f, 0xb5, 0x3f, 0xa5, 0xff, 0xa1, 0x9f, 0x3b, 0xb2, 0x6c, 0x61,
-	0x1b, 0x1b, 0x4c, 0x92, 0xf6, 0x9b, 0x25, 0x3d, 0x7a, 0x9e, 0xa3, 0x73, 0x74, 0x8e, 0x24, 0xc3,
-	0x96, 0x43, 0x29, 0xef, 0xeb, 0x94, 0x3a, 0x46, 0x6b, 0xe2, 0x50, 0x4e, 0xd1, 0xd5, 0xb1, 0x69,
-	0x9d, 0x4c, 0x99, 0x6c, 0xb5, 0xc4, 0xb0, 0x3b, 0x5a, 0x2b, 0xe9, 0x74, 0x3c, 0xa6, 0xb6, 0xec,
-	0xaf, 0x95, 0x82, 0xa8, 0x5a, 0xd9, 0xb4, 0x39, 0x71, 0x6c, 0x6c, 0x79, 0xed, 0xe2, 0xc4, 0xa1,
-	0x67, 0xe7, 0x5e, 0xa3, 0x42, 0xb8, 0x6e, 0xf4, 0xc7, 0x84, 0x63, 0xd9, 0xd1, 0xe8, 0xc3, 0x95,
-	0x67, 0x96, 0x45, 0xf5, 0x57, 0xe6, 0x98, 0x30, 0x8e, 0xc7, 0x13, 0x8d, 0xbc, 0x9b, 0x12, 0xc6,
-	0xd1, 0x43, 0xb8, 0x38, 0xc0, 0x8c, 0x54, 0x33, 0xf5, 0x4c, 0xb3, 0xb8, 0x7b, 0xa3, 0x15, 0xb2,
-	0xc4, 0x93, 0x3f, 0x60, 0xc3, 0x3d, 0xcc, 0x88, 0xe6, 0x22, 0xd1, 0x65, 0x58, 0xd3, 0xe9, 0xd4,
-	0xe6, 0xd5, 0x5c, 0x3d, 0xd3, 0xdc, 0xd4, 0x64, 0xa3, 0xf1, 0x73, 0x06, 0xae, 0x46, 0x15, 0xd8,
-	0x84, 0xda, 0x8c, 0xa0, 0x47, 0xb0, 0xce, 0x38, 0xe6, 0x53, 0xe6, 0x89, 0x5c, 0x8f, 0x15, 0xe9,
-	0xb9, 0x10, 0xcd, 0x83, 0xa2, 0x1b, 0x50, 0xe0, 0x3e, 0x53, 0x35, 0x5b, 0xcf, 0x34, 0x2f, 0x6a,
-	0xb3, 0x8e, 0x04, 0x1b, 0xde, 0x40, 0xd9, 0x35, 0xa1, 0xdb, 0xf9, 0x08, 0xab, 0xcb, 0x06, 0x99,
-	0x2d, 0xa8, 0x28, 0xe6, 0x0f, 0x59, 0x55, 0x19, 0xb2, 0xdd, 0x8e, 0x4b, 0x9d, 0xd3, 0xb2, 0xdd,
-	0x4e, 0xc2, 0x3a, 0x7e, 0xcb, 0x42, 0xa9, 0x3b, 0x9e, 0x50, 0x87, 0x6b, 0x84, 0x4d, 0x2d, 0xfe,
-	0x7e, 0x5a, 0xd7, 0x60, 0x83, 0x63, 0x76, 0xdc, 0x37, 0x0d, 0x4f, 0x70, 0x5d, 0x34, 0xbb, 0x06,
-	0xfa, 0x2f, 0x14, 0x0d, 0xcc, 0xb1, 0x4d, 0x0d, 0x22, 0x06, 0x73, 0xee, 0x20, 0xf8, 0x5d, 0x5d,
-	0x03, 0x3d, 0x86, 0x35, 0xc1, 0x41, 0xaa, 0x17, 0xeb, 0x99, 0x66, 0x79, 0xb7, 0x1e, 0xab, 0x26,
-	0x0d, 0x14, 0x9a, 0x44, 0x93, 0x70, 0x54, 0x83, 0x3c, 0x23, 0xc3, 0x31, 0xb1, 0x39, 0xab, 0xae,
-	0xd5, 0x73, 0xcd, 0x9c, 0xa6, 0xda, 0xe8, 0x5f, 0x90, 0xc7, 0x53, 0x4e, 0xfb, 0xa6, 0xc1, 0xaa,
-	0xeb, 0xee, 0xd8, 0x86, 0x68, 0x77, 0x0d, 0x86, 0xae, 0x43, 0xc1, 0xa1, 0xa7, 0x7d, 0xe9, 0x88,
-	0x0d, 0xd7, 0x9a, 0xbc, 0x43, 0x4f, 0xdb, 0xa2, 0x8d, 0x3e, 0x85, 0x35, 0xd3, 0x7e, 0x4b, 0x59,
-	0x35, 0x5f, 0xcf, 0x35, 0x8b, 0xbb, 0x37, 0x63, 0x6d, 0xf9, 0x9c, 0x9c, 0x7f, 0x85, 0xad, 0x29,
-	0x39, 0xc4, 0xa6, 0xa3, 0x49, 0x7c, 0xe3, 0xd7, 0x0c, 0x5c, 0xeb, 0x10, 0xa6, 0x3b, 0xe6, 0x80,
-	0xf4, 0x3c, 0x2b, 0xde, 0x7f, 0x5b, 0x34, 0xa0, 0xa4, 0x53, 0xcb, 0x22, 0x3a, 0x37, 0xa9, 0xad,
-	0x42, 0x18, 0xea, 0x43, 0xff, 0x01, 0xf0, 0x96, 0xdb, 0xed, 0xb0, 0x6a, 0xce, 0x5d, 0x64, 0xa0,
-	0xa7, 0x31, 0x85, 0x8a, 0x67, 0x88, 0x20, 0xee, 0xda, 0x6f, 0xe9, 0x1c, 0x6d, 0x26, 0x86, 0xb6,
-	0x0e, 0xc5, 0x09, 0x76, 0xb8, 0x19, 0x52, 0x0e, 0x76, 0x89, 0x5c, 0x51, 0x32, 0x5e, 0x38, 0x67,
-	0x1d, 0x8d, 0x3f, 0xb2, 0x50, 0xf2, 0x74, 0x85, 0x26, 0x43, 0x1d, 0x28, 0x88, 0x35, 0xf5, 0x85,
-	0x9f, 0x3c, 0x17, 0xdc, 0x6e, 0xc5, 0x57, 0xa0, 0x56, 0xc4, 0x60, 0x2d, 0x3f, 0xf0, 0x4d, 0xef,
-	0x40, 0xd1, 0xb4, 0x0d, 0x72, 0xd6, 0x97, 0xe1, 0xc9, 0xba, 0xe1, 0xb9, 0x15, 0xe6, 0x11, 0x55,
-	0xa8, 0xa5, 0xb4, 0x0d, 0x72, 0xe6, 0x72, 0x80, 0xe9, 0x7f, 0x32, 0x44, 0xe0, 0x12, 0x39, 0xe3,
-	0x0e, 0xee, 0x07, 0xb9, 0x72, 0x2e, 0xd7, 0x67, 0x4b, 0x6c, 0x72, 0x09, 0x5a, 0xcf, 0xc5, 0x6c,
-	0xc5, 0xcd, 0x9e, 0xdb, 0xdc, 0x39, 0xd7, 0x2a, 0x24, 0xdc, 0x5b, 0xfb, 0x01, 0x2e, 0xc7, 0x01,
-	0xd1, 0x16, 0xe4, 0x8e, 0xc9, 0xb9, 0xe7, 0x76, 0xf1, 0x89, 0x76, 0x61, 0xed, 0x44, 0x6c, 0x25,
-	0xd7, 0xcf, 0x73, 0x7b, 0xc3, 0x5d, 0xd0, 0x6c, 0x25, 0x12, 0xfa, 0x34, 0xfb, 0x24, 0xd3, 0xf8,
-	0x3d, 0x0b, 0xd5, 0xf9, 0xed, 0xf6, 0x21, 0xb5, 0x22, 0xcd, 0x96, 0x1b, 0xc2, 0xa6, 0x17, 0xe8,
-	0x90, 0xeb, 0xf6, 0x92, 0x5c, 0x97, 0x64, 0x61, 0xc8, 0xa7, 0xd2, 0x87, 0x25, 0x16, 0xe8, 0xaa,
-	0x11, 0xb8, 0x34, 0x07, 0x89, 0xf1, 0xde, 0xd3, 0xb0, 0xf7, 0xb6, 0xd3, 0x84, 0x30, 0xe8, 0x45,
-	0x03, 0x2e, 0xef, 0x13, 0xde, 0x76, 0x88, 0x41, 0x6c, 0x6e, 0x62, 0xeb, 0xfd, 0x13, 0xb6, 0x06,
-	0xf9, 0x29, 0x13, 0xe7, 0xe3, 0x58, 0x1a, 0x53, 0xd0, 0x54, 0xbb, 0xf1, 0x4b, 0x06, 0xae, 0x44,
-	0x64, 0x3e, 0x24, 0x50, 0x0b, 0xa4, 0xc4, 0xd8, 0x04, 0x33, 0x76, 0x4a, 0x1d, 0x59, 0x68, 0x0b,
-	0x9a, 0x6a, 0xef, 0xfe, 0xb9, 0x0d, 0x05, 0x8d, 0x52, 0xde, 0x16, 0x2e, 0x41, 0x16, 0x20, 0x61,
-	0x13, 0x1d, 0x4f, 0xa8, 0x4d, 0x6c, 0x59, 0x58, 0x19, 0x6a, 0x85, 0x0d, 0xf0, 0x1a, 0xf3, 0x40,
-	0xcf, 0x51, 0xb5, 0xed, 0x58, 0x7c, 0x04, 0xdc, 0xb8, 0x80, 0xc6, 0xae, 0x9a, 0x38, 0xab, 0x5f,
-	0x99, 0xfa, 0x71, 0x7b, 0x84, 0x6d, 0x9b, 0x58, 0xe8, 0x61, 0x78, 0xb6, 0xba, 0x61, 0xcc, 0x43,
-	0x7d, 0xbd, 0x5b, 0xb1, 0x7a, 0x3d, 0xee, 0x98, 0xf6, 0xd0, 0xf7, 0x6a, 0xe3, 0x02, 0x7a, 0xe7,
-	0xc6, 0x55, 0xa8, 0x9b, 0x8c, 0x9b, 0x3a, 0xf3, 0x05, 0x77, 0x93, 0x05, 0xe7, 0xc0, 0x2b, 0x4a,
-	0xf6, 0x61, 0xab, 0xed, 0x10, 0xcc, 0x49, 0x5b, 0x25, 0x0c, 0xba, 0x1f, 0xef, 0x9d, 0x08, 0xcc,
-	0x17, 0x5a, 0x14, 0xfc, 0xc6, 0x05, 0xf4, 0x1d, 0x94, 0x3b, 0x0e, 0x9d, 0x04, 0xe8, 0xef, 0xc6,
-	0xd2, 0x87, 0x41, 0x29, 0xc9, 0xfb, 0xb0, 0xf9, 0x02, 0xb3, 0x00, 0xf7, 0x9d, 0x58, 0xee, 0x10,
-	0xc6, 0xa7, 0xbe, 0x19, 0x0b, 0xdd, 0xa3, 0xd4, 0x0a, 0xb8, 0xe7, 0x14, 0x90, 0x5f, 0x0c, 0x02,
-	0x2a, 0xf1, 0xdb, 0x6d, 0x1e, 0xe8, 0x4b, 0xed, 0xa4, 0xc6, 0x2b, 0xe1, 0x9f, 0xa0, 0x36, 0x3f,
-	0xde, 0xf5, 0x02, 0xff, 0x4f, 0x18, 0xf0, 0x1a, 0x8a, 0x32, 0xe2, 0xcf, 0x2c, 0x13, 0x33, 0x74,
-	0x7b, 0xc1, 0x9e, 0x70, 0x11, 0x29, 0x23, 0xf6, 0x25, 0x14, 0x44, 0xa4, 0x25, 0xe9, 0xff, 0x12,
-	0x77, 0xc2, 0x2a, 0x94, 0x3d, 0x80, 0x67, 0x16, 0x27, 0x8e, 0xe4, 0xfc, 0x7f, 0x2c, 0xe7, 0x0c,
-	0x90, 0x92, 0xd4, 0x86, 0x4a, 0x6f, 0x24, 0x6e, 0x57, 0xbe, 0x6b, 0x18, 0xba, 0x17, 0x9f, 0x51,
-	0x61, 0x94, 0x4f, 0x7f, 0x3f, 0x1d, 0x58, 0xb9, 0xfb, 0x48, 0x5c, 0x9d, 0x39, 0x71, 0x02, 0xbb,
-	0xec, 0x5e, 0xf2, 0x4a, 0x56, 0x4e, 0x94, 0x23, 0xa8, 0xc8, 0x58, 0x1d, 0xfa, 0x17, 0xa2, 0x04,
-	0xfa, 0x08, 0x2a, 0x25, 0xfd, 0x37, 0xb0, 0x29, 0xa2, 0x36, 0x23, 0xbf, 0x93, 0x18, 0xd9, 0x55,
-	0xa9, 0x8f, 0xa0, 0xf4, 0x02, 0xb3, 0x19, 0x73, 0x33, 0x29, 0xc3, 0xe7, 0x88, 0x53, 0x25, 0xf8,
-	0x31, 0x94, 0x45, 0x50, 0xd4, 0x64, 0x96, 0x50, 0x9e, 0xc2, 0x20, 0x5f, 0xe2, 0x5e, 0x2a, 0xac,
-	0x12, 0x63, 0x70, 0x35, 0x3c, 0xa6, 0x12, 0xfa, 0x6f, 0x14, 0x25, 0x50, 0x12, 0x63, 0xfe, 0x5d,
-	0x26, 0xc1, 0x81, 0x41, 0x88, 0x2f, 0x74, 0x27, 0x05, 0x32, 0x70, 0x76, 0x95, 0xc3, 0x0f, 0x5b,
-	0xf4, 0x20, 0xe9, 0x5a, 0x13, 0xfb, 0xc4, 0xae, 0xb5, 0xd2, 0xc2, 0x95, 0xe4, 0xf7, 0xb0, 0xe1,
-	0x3d, 0x37, 0xa3, 0x59, 0x1f, 0x99, 0xac, 0x5e, 0xba, 0xb5, 0xdb, 0x4b, 0x71, 0x8a, 0x1d, 0xc3,
-	0x95, 0xd7, 0x13, 0x43, 0x1c, 0x79, 0xf2, 0x60, 0xf5, 0x8f, 0xf6, 0xe8, 0xde, 0x56, 0xa7, 0x71,
-	0x04, 0x77, 0xc0, 0x86, 0xcb, 0xf6, 0xb6, 0x03, 0xff, 0xee, 0xda, 0x27, 0xd8, 0x32, 0x8d, 0xd0,
-	0xc9, 0x7a, 0x40, 0x38, 0x6e, 0x63, 0x7d, 0x44, 0xa2, 0x07, 0xbf, 0xfc, 0x77, 0x11, 0x9e, 0xa2,
-	0xc0, 0x29, 0xf3, 0xe9, 0x47, 0x40, 0xb2, 0x0a, 0xd9, 0x6f, 0xcd, 0xe1, 0xd4, 0xc1, 0x72, 0xd3,
-	0x27, 0x5d, 0x69, 0xe6, 0xa1, 0xbe, 0xcc, 0x27, 0x2b, 0xcc, 0x08, 0xdc, 0x36, 0x60, 0x9f, 0xf0,
-	0x03, 0xc2, 0x1d, 0x53, 0x4f, 0x2a, 0xd5, 0x33, 0x40, 0x42, 0xd0, 0x62, 0x70, 0x4a, 0xa0, 0x07,
-	0xeb, 0xf2, 0xc5, 0x8d, 0x1a, 0xb1, 0x93, 0xfc, 0xff, 0x05, 0x8b, 0xee, 0x48, 0xea, 0x9f, 0x42,
-	0xa0, 0x46, 0xec, 0x13, 0x1e, 0x78, 0xc9, 0x27, 0xa4, 0x6b, 0x18, 0xb4, 0x38, 0x5d, 0xa3, 0x58,
-	0x25, 0x66, 0x43, 0xe5, 0x0b, 0x93, 0x79, 0x83, 0xaf, 0x30, 0x3b, 0x4e, 0x3a, 0x78, 0x22, 0xa8,
-	0xc5, 0x07, 0xcf, 0x1c, 0x38, 0xe0, 0xb1, 0x92, 0x46, 0xc4, 0x80, 0xe7, 0xb7, 0xc4, 0xc7, 0x48,
-	0xf0, 0x57, 0xcb, 0xb2, 0x4d, 0xf6, 0x46, 0xdd, 0x2a, 0xd5, 0xe3, 0x21, 0x7a, 0xd8, 0xcf, 0xd2,
-	0x46, 0x41, 0xc4, 0x3b, 0x27, 0x05, 0xb3, 0x97, 0x95, 0x1f, 0x9b, 0xb9, 0x0f, 0x5b, 0x1d, 0x62,
-	0x91, 0x10, 0xf3, 0xfd, 0x84, 0x7b, 0x53, 0x18, 0x96, 0x32, 0xf3, 0x46, 0xb0, 0x29, 0xc2, 0x20,
-	0xe6, 0xbd, 0x66, 0xc4, 0x61, 0x09, 0x87, 0x64, 0x08, 0xe3, 0x53, 0xdf, 0x4d, 0x03, 0x0d, 0xec,
-	0xa1, 0xcd, 0xd0, 0xc3, 0x2d, 0xba, 0x8e, 0x59, 0x50, 0xe3, 0x9e, 0x91, 0xb5, 0x07, 0x29, 0xd1,
-	0x81, 0x3d, 0x04, 0x32, 0xdc, 0x1a, 0xb5, 0x48, 0x42, 0x5a, 0xcf, 0x00, 0x29, 0xdd, 0xf5, 0x12,
-	0xf2, 0xe2, 0xbe, 0xe0, 0x52, 0x6e, 0x27, 0x5e, 0x27, 0x56, 0x20, 0x3c, 0x82, 0xca, 0xcb, 0x09,
-	0x71, 0x30, 0x27, 0xc2, 0x5f, 0x2e, 0x6f, 0x7c, 0x66, 0x45, 0x50, 0xa9, 0xdf, 0x22, 0xd0, 0x23,
-	0xa2, 0x82, 0x2f, 0x70, 0xc2, 0x0c, 0xb0, 0xb8, 0xb6, 0x05, 0x71, 0xc1, 0xe2, 0x29, 0xfb, 0x85,
-	0x61, 0x0b, 0x05, 0x5c, 0xcb, 0x53, 0x08, 0x48, 0x5c, 0xf0, 0x2d, 0xe8, 0x2d, 0xfd, 0xd0, 0x31,
-	0x4f, 0x4c, 0x8b, 0x0c, 0x49, 0x42, 0x06, 0x44, 0x61, 0x29, 0x5d, 0x34, 0x80, 0xa2, 0x14, 0xde,
-	0x77, 0xb0, 0xcd, 0xd1, 0x22, 0xd3, 0x5c, 0x84, 0x4f, 0xdb, 0x5c, 0x0e, 0x54, 0x8b, 0xd0, 0x01,
-	0x44, 0x5a, 0x1c, 0x52, 0xcb, 0xd4, 0xcf, 0xa3, 0x97, 0x1d, 0x55, 0x1a, 0x66, 0x90, 0x84, 0xcb,
-	0x4e, 0x2c, 0x52, 0x89, 0x0c, 0xa0, 0xd8, 0x1e, 0x11, 0xfd, 0xf8, 0x05, 0xc1, 0x16, 0x1f, 0x25,
-	0x3d, 0x8e, 0x66, 0x88, 0xc5, 0x0b, 0x09, 0x01, 0x83, 0xd1, 0xd0, 0x88, 0x8d, 0xc7, 0xcb, 0x5f,
-	0xe6, 0x51, 0x58, 0xfa, 0x97, 0xb9, 0x4c, 0xca, 0x0e, 0xe6, 0xd8, 0xfd, 0x1b, 0x74, 0x77, 0x41,
-	0xe6, 0xfa, 0xa0, 0x94, 0xe4, 0x5f, 0x43, 0x49, 0xa4, 0xa7, 0xa2, 0x6e, 0x26, 0x66, 0xf0, 0x8a,
-	0xc4, 0x5e, 0x15, 0xf5, 0x67, 0x2d, 0xaa, 0xa2, 0x0a, 0xb3, 0xbc, 0x8a, 0x06, 0xa0, 0x7e, 0x00,
-	0xf6, 0x9e, 0x7c, 0xfb, 0x78, 0x68, 0xf2, 0xd1, 0x74, 0x20, 0x6c, 0xd8, 0x91, 0xe0, 0x07, 0x26,
-	0xf5, 0xbe, 0x76, 0xfc, 0x1d, 0xb2, 0xe3, 0x92, 0xed, 0xa8, 0x2a, 0x39, 0x19, 0x0c, 0xd6, 0xdd,
-	0xae, 0x47, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0xcc, 0x48, 0x0a, 0xdf, 0xc3, 0x1a, 0x00, 0x00,
+	// 2266 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xdb, 0x72, 0xdb, 0xc6,
+	0x19, 0x0e, 0x48, 0x9d, 0xf8, 0x93, 0x22, 0xe5, 0xad, 0x65, 0x33, 0x4c, 0xdc, 0xd2, 0xb0, 0x6b,
+	0xd3, 0x27, 0x3a, 0x55, 0x66, 0x52, 0x27, 0x77, 0x16, 0x19, 0xcb, 0x9c, 0x5a, 0x89, 0x0b, 0x59,
+	0x69, 0x7a, 0xf0, 0x20, 0x4b, 0x60, 0x45, 0x61, 0x04, 0x62, 0x69, 0xec, 0x52, 0x32, 0xdb, 0x8b,
+	0x4e, 0x67, 0x7a, 0xd1, 0xbb, 0xce, 0xf4, 0x11, 0xfa, 0x0c, 0xed, 0x13, 0x74, 0xfa, 0x0e, 0xbd,
+	0xef, 0x55, 0xa7, 0xb7, 0x7d, 0x80, 0xce, 0xee, 0x02, 0x20, 0x00, 0x02, 0x14, 0x24, 0x27, 0xed,
+	0x1d, 0xf1, 0xef, 0xb7, 0xdf, 0xff, 0xef, 0x7f, 0xda, 0x03, 0x61, 0xcb, 0xa7, 0x94, 0x9b, 0x16,
+	0xa5, 0xbe, 0xdd, 0x9d, 0xf8, 0x94, 0x53, 0x74, 0x6d, 0xec, 0xb8, 0xa7, 0x53, 0xa6, 0xbe, 0xba,
+	0x62, 0x58, 0x8e, 0xb6, 0x6a, 0x16, 0x1d, 0x8f, 0xa9, 0xa7, 0xe4, 0xad, 0x5a, 0x1c, 0xd5, 0xaa,
+	0x3b, 0x1e, 0x27, 0xbe, 0x87, 0xdd, 0xe0, 0xbb, 0x3a, 0xf1, 0xe9, 0xdb, 0x59, 0xf0, 0xd1, 0x20,
+	0xdc, 0xb2, 0xcd, 0x31, 0xe1, 0x58, 0x09, 0x74, 0x13, 0xb6, 0x9f, 0xba, 0x2e, 0xb5, 0x5e, 0x39,
+	0x63, 0xc2, 0x38, 0x1e, 0x4f, 0x0c, 0xf2, 0x66, 0x4a, 0x18, 0x47, 0x1f, 0xc1, 0xca, 0x10, 0x33,
+	0xd2, 0xd4, 0xda, 0x5a, 0xa7, 0xba, 0xf3, 0x61, 0x37, 0x61, 0x49, 0xa0, 0x7e, 0x9f, 0x8d, 0x76,
+	0x31, 0x23, 0x86, 0x44, 0xa2, 0xab, 0xb0, 0x6a, 0xd1, 0xa9, 0xc7, 0x9b, 0xe5, 0xb6, 0xd6, 0xd9,
+	0x34, 0xd4, 0x87, 0xfe, 0x3b, 0x0d, 0xae, 0xa5, 0x35, 0xb0, 0x09, 0xf5, 0x18, 0x41, 0x1f, 0xc3,
+	0x1a, 0xe3, 0x98, 0x4f, 0x59, 0xa0, 0xe4, 0x83, 0x4c, 0x25, 0x07, 0x12, 0x62, 0x04, 0x50, 0xf4,
+	0x21, 0x54, 0x78, 0xc8, 0xd4, 0x2c, 0xb5, 0xb5, 0xce, 0x8a, 0x31, 0x17, 0xe4, 0xd8, 0xf0, 0x35,
+	0xd4, 0xa5, 0x09, 0x83, 0xfe, 0xb7, 0xb0, 0xba, 0x52, 0x9c, 0xd9, 0x85, 0x46, 0xc4, 0xfc, 0x2e,
+	0xab, 0xaa, 0x43, 0x69, 0xd0, 0x97, 0xd4, 0x65, 0xa3, 0x34, 0xe8, 0xe7, 0xac, 0xe3, 0xdf, 0x25,
+	0xa8, 0x0d, 0xc6, 0x13, 0xea, 0x73, 0x83, 0xb0, 0xa9, 0xcb, 0x2f, 0xa7, 0xeb, 0x3a, 0xac, 0x73,
+	0xcc, 0x4e, 0x4c, 0xc7, 0x0e, 0x14, 0xae, 0x89, 0xcf, 0x81, 0x8d, 0x7e, 0x00, 0x55, 0x1b, 0x73,
+	0xec, 0x51, 0x9b, 0x88, 0xc1, 0xb2, 0x1c, 0x84, 0x50, 0x34, 0xb0, 0xd1, 0x27, 0xb0, 0x2a, 0x38,
+	0x48, 0x73, 0xa5, 0xad, 0x75, 0xea, 0x3b, 0xed, 0x4c, 0x6d, 0xca, 0x40, 0xa1, 0x93, 0x18, 0x0a,
+	0x8e, 0x5a, 0xb0, 0xc1, 0xc8, 0x68, 0x4c, 0x3c, 0xce, 0x9a, 0xab, 0xed, 0x72, 0xa7, 0x6c, 0x44,
+	0xdf, 0xe8, 0x7d, 0xd8, 0xc0, 0x53, 0x4e, 0x4d, 0xc7, 0x66, 0xcd, 0x35, 0x39, 0xb6, 0x2e, 0xbe,
+	0x07, 0x36, 0x43, 0x1f, 0x40, 0xc5, 0xa7, 0x67, 0xa6, 0x72, 0xc4, 0xba, 0xb4, 0x66, 0xc3, 0xa7,
+	0x67, 0x3d, 0xf1, 0x8d, 0x7e, 0x0c, 0xab, 0x8e, 0x77, 0x44, 0x59, 0x73, 0xa3, 0x5d, 0xee, 0x54,
+	0x77, 0x6e, 0x66, 0xda, 0xf2, 0x13, 0x32, 0xfb, 0x0a, 0xbb, 0x53, 0xf2, 0x12, 0x3b, 0xbe, 0xa1,
+	0xf0, 0xe8, 0x2e, 0x34, 0x2c, 0x3a, 0x9e, 0xb8, 0x84, 0x13, 0xdb, 0x3c, 0x72, 0x5c, 0xc2, 0x9a,
+	0x95, 0x76, 0xb9, 0x53, 0x31, 0xea, 0x91, 0xf8, 0x99, 0x90, 0xea, 0x7f, 0xd4, 0xe0, 0x7a, 0x9f,
+	0x30, 0xcb, 0x77, 0x86, 0xe4, 0x20, 0x30, 0xf7, 0xf2, 0xf9, 0xa3, 0x43, 0xcd, 0xa2, 0xae, 0x4b,
+	0x2c, 0xee, 0x50, 0x2f, 0x8a, 0x75, 0x42, 0x86, 0xbe, 0x0f, 0x10, 0xf8, 0x65, 0xd0, 0x67, 0xcd,
+	0xb2, 0xf4, 0x46, 0x4c, 0xa2, 0x4f, 0xa1, 0x11, 0x18, 0x22, 0x88, 0x07, 0xde, 0x11, 0x5d, 0xa0,
+	0xd5, 0x32, 0x68, 0xdb, 0x50, 0x9d, 0x60, 0x9f, 0x3b, 0x09, 0xcd, 0x71, 0x91, 0x28, 0xaa, 0x48,
+	0x4d, 0x10, 0xf7, 0xb9, 0x40, 0xff, 0x67, 0x09, 0x6a, 0x81, 0xde, 0x81, 0x74, 0x61, 0x1f, 0x2a,
+	0x62, 0x4d, 0xa6, 0x70, 0x68, 0xe0, 0x82, 0xbb, 0xdd, 0xec, 0x56, 0xd5, 0x4d, 0x19, 0x6c, 0x6c,
+	0x0c, 0x43, 0xd3, 0xfb, 0x50, 0x75, 0x3c, 0x9b, 0xbc, 0x35, 0x55, 0x1c, 0x4b, 0x32, 0x8e, 0xb7,
+	0x92, 0x3c, 0xa2, 0x5d, 0x75, 0x23, 0xdd, 0x36, 0x79, 0x2b, 0x39, 0xc0, 0x09, 0x7f, 0x32, 0x44,
+	0xe0, 0x0a, 0x79, 0xcb, 0x7d, 0x6c, 0xc6, 0xb9, 0xca, 0x92, 0xeb, 0xd3, 0x73, 0x6c, 0x92, 0x04,
+	0xdd, 0xcf, 0xc5, 0xec, 0x88, 0x9b, 0x7d, 0xee, 0x71, 0x7f, 0x66, 0x34, 0x48, 0x52, 0xda, 0xfa,
+	0x06, 0xae, 0x66, 0x01, 0xd1, 0x16, 0x94, 0x4f, 0xc8, 0x2c, 0x70, 0xbb, 0xf8, 0x89, 0x76, 0x60,
+	0xf5, 0x54, 0xe4, 0x9c, 0xf4, 0xf3, 0x42, 0x6e, 0xc8, 0x05, 0xcd, 0x57, 0xa2, 0xa0, 0x9f, 0x95,
+	0x9e, 0x68, 0xfa, 0xdf, 0x4a, 0xd0, 0x5c, 0x4c, 0xb7, 0x77, 0x69, 0x2a, 0x45, 0x52, 0x6e, 0x04,
+	0x9b, 0x41, 0xa0, 0x13, 0xae, 0xdb, 0xcd, 0x73, 0x5d, 0x9e, 0x85, 0x09, 0x9f, 0x2a, 0x1f, 0xd6,
+	0x58, 0x4c, 0xd4, 0x22, 0x70, 0x65, 0x01, 0x92, 0xe1, 0xbd, 0xcf, 0x92, 0xde, 0xbb, 0x5d, 0x24,
+	0x84, 0x71, 0x2f, 0xda, 0x70, 0x75, 0x8f, 0xf0, 0x9e, 0x4f, 0x6c, 0xe2, 0x71, 0x07, 0xbb, 0x97,
+	0x2f, 0xd8, 0x16, 0x6c, 0x4c, 0x99, 0xd8, 0x48, 0xc7, 0xca, 0x98, 0x8a, 0x11, 0x7d, 0xeb, 0xbf,
+	0xd7, 0x60, 0x3b, 0xa5, 0xe6, 0x5d, 0x02, 0xb5, 0x44, 0x95, 0x18, 0x9b, 0x60, 0xc6, 0xce, 0xa8,
+	0xaf, 0x3a, 0x72, 0xc5, 0x88, 0xbe, 0xf5, 0x3f, 0x94, 0xe0, 0x5a, 0xcf, 0xa5, 0x1e, 0xe9, 0x45,
+	0x21, 0xbd, 0xfc, 0x7a, 0xaf, 0xc3, 0xba, 0x3d, 0x34, 0x63, 0x36, 0xac, 0xd9, 0xc3, 0x2f, 0x84,
+	0x05, 0xb2, 0x61, 0x86, 0xfc, 0x0a, 0xa0, 0x0c, 0xa9, 0xcf, 0xc5, 0x12, 0xd8, 0x85, 0xef, 0x79,
+	0x44, 0xf4, 0xeb, 0x24, 0x78, 0x45, 0x82, 0xaf, 0x78, 0xe4, 0xac, 0x97, 0xc4, 0x27, 0xdb, 0xdd,
+	0x6a, 0xba, 0xdd, 0xa1, 0xfb, 0x70, 0x85, 0x9d, 0x38, 0x93, 0xa0, 0xb2, 0x8f, 0x1c, 0xe2, 0x06,
+	0x7b, 0x44, 0xc5, 0x68, 0x88, 0x01, 0x59, 0x46, 0xcf, 0xa4, 0x58, 0x7f, 0x01, 0x4d, 0x11, 0x10,
+	0x3c, 0xc1, 0x96, 0xc3, 0x67, 0x06, 0x51, 0x9b, 0xe4, 0x25, 0x7d, 0xa1, 0xff, 0x45, 0x83, 0x6d,
+	0x83, 0x30, 0x3a, 0xf5, 0x2d, 0xb2, 0xe7, 0xd3, 0xe9, 0x24, 0x24, 0x46, 0x08, 0x56, 0xe4, 0xa2,
+	0x34, 0xb9, 0x28, 0xf9, 0x5b, 0xec, 0x53, 0xde, 0x74, 0x6c, 0x8a, 0x4d, 0x92, 0x05, 0x45, 0xb6,
+	0xe1, 0x4d, 0xc7, 0x5f, 0x88, 0x6f, 0xb1, 0xa9, 0x8e, 0xc9, 0x98, 0xfa, 0x33, 0x73, 0xca, 0x88,
+	0x0a, 0xe1, 0x8a, 0x01, 0x4a, 0x74, 0xc8, 0x88, 0x8d, 0x6e, 0x42, 0x2d, 0x00, 0x70, 0xca, 0xb1,
+	0x2b, 0xdd, 0xb5, 0x62, 0x04, 0x93, 0x5e, 0x09, 0x11, 0xba, 0x03, 0x0d, 0x1b, 0xcf, 0x98, 0x39,
+	0xf5, 0xb8, 0xe3, 0x9a, 0x47, 0x53, 0xd7, 0x6d, 0xae, 0xb6, 0xb5, 0x8e, 0x66, 0x6c, 0x0a, 0xf1,
+	0xa1, 0x90, 0x3e, 0x9b, 0xba, 0xae, 0xfe, 0x67, 0x0d, 0xb6, 0x84, 0xd6, 0xbe, 0xc3, 0x4e, 0x22,
+	0x8b, 0xaf, 0xc1, 0x9a, 0xdc, 0xbe, 0xc3, 0xbd, 0x21, 0xf8, 0x12, 0x2b, 0xf1, 0xa9, 0x1b, 0x06,
+	0x5b, 0xfe, 0x16, 0x2b, 0xb1, 0x1d, 0x76, 0x12, 0x37, 0x75, 0x43, 0x08, 0xa4, 0xa1, 0x37, 0x00,
+	0xe4, 0x60, 0xdc, 0x4c, 0x09, 0xbf, 0x98, 0x91, 0xa7, 0x50, 0xef, 0x1d, 0x63, 0xcf, 0x23, 0xee,
+	0x2e, 0xb6, 0x4e, 0x5c, 0x3a, 0xba, 0x90, 0x85, 0x37, 0xa1, 0x66, 0xa9, 0xd9, 0xf1, 0x4c, 0xac,
+	0x06, 0x32, 0x99, 0x56, 0xdb, 0xb0, 0xe6, 0xe2, 0x91, 0x39, 0x66, 0xd2, 0xc6, 0xb2, 0xb1, 0xea,
+	0xe2, 0xd1, 0x3e, 0xd3, 0xff, 0x5a, 0x82, 0xf7, 0x33, 0x52, 0xe4, 0x5d, 0xea, 0xf6, 0x2b, 0x68,
+	0xf8, 0x41, 0x96, 0x98, 0x23, 0x91, 0x26, 0xe1, 0x2e, 0xf6, 0x28, 0xaf, 0x6d, 0x65, 0x26, 0x95,
+	0x51, 0xf7, 0xe3, 0x62, 0x86, 0xf6, 0x00, 0xe4, 0x21, 0x4c, 0x38, 0x37, 0xec, 0xc8, 0x9d, 0x3c,
+	0xca, 0x74, 0xc0, 0x8d, 0x8a, 0x17, 0x48, 0x18, 0xda, 0x85, 0x8d, 0xa1, 0x72, 0xb2, 0x70, 0x86,
+	0xa0, 0xb9, 0x93, 0x47, 0x93, 0x8c, 0x89, 0x11, 0xcd, 0xd3, 0xff, 0xa3, 0x41, 0x5b, 0xf8, 0x2d,
+	0xaa, 0x5d, 0xe1, 0x04, 0xf6, 0xdc, 0x61, 0x9c, 0xfa, 0xb3, 0xff, 0x67, 0xbb, 0x49, 0x6f, 0x6f,
+	0x2b, 0x19, 0xdb, 0xdb, 0x0d, 0x00, 0xc6, 0xb1, 0xcf, 0x4d, 0x71, 0x45, 0x90, 0xf9, 0x28, 0x4e,
+	0x36, 0x42, 0x22, 0xae, 0x23, 0xe2, 0xf0, 0x49, 0x3c, 0x5b, 0x0d, 0xae, 0xc9, 0xc1, 0x75, 0xe2,
+	0xd9, 0x62, 0x48, 0xff, 0x87, 0x06, 0xdb, 0xa9, 0x35, 0x1f, 0x60, 0x71, 0x3e, 0x4c, 0xde, 0x40,
+	0x54, 0xc6, 0xc6, 0x6e, 0x20, 0x5b, 0x50, 0x7e, 0x33, 0x51, 0x6d, 0x40, 0x33, 0xc4, 0x4f, 0x74,
+	0x1b, 0xea, 0xf8, 0x74, 0x64, 0xba, 0x98, 0x13, 0xcf, 0x9a, 0x89, 0xbc, 0x2c, 0xcb, 0xc1, 0x1a,
+	0x3e, 0x1d, 0xbd, 0x50, 0xc2, 0x7d, 0x86, 0x6e, 0xc1, 0xa6, 0x4f, 0xcf, 0x98, 0xe9, 0x78, 0x8c,
+	0xf8, 0x9c, 0xd8, 0xe1, 0x72, 0x84, 0x70, 0x10, 0xc8, 0x44, 0xf6, 0x4b, 0x90, 0x4d, 0xe4, 0x39,
+	0x35, 0x58, 0x50, 0x55, 0xc8, 0xfa, 0x4a, 0x24, 0x20, 0xc3, 0x19, 0x27, 0xcc, 0x14, 0xf1, 0x21,
+	0x76, 0xb0, 0xac, 0xaa, 0x94, 0x1d, 0x48, 0x91, 0xfe, 0x77, 0x0d, 0x6e, 0x2e, 0x89, 0xe8, 0x77,
+	0x7d, 0xe4, 0xd8, 0x83, 0x75, 0x26, 0x3d, 0x19, 0xa6, 0x76, 0x6e, 0xb5, 0x64, 0xfa, 0xdf, 0x08,
+	0x67, 0xef, 0xfc, 0xeb, 0x2e, 0x54, 0x0c, 0x4a, 0x79, 0x4f, 0x80, 0x91, 0x0b, 0x48, 0x2e, 0x6a,
+	0x3c, 0xa1, 0x1e, 0xf1, 0xd4, 0x05, 0x84, 0xa1, 0x6e, 0x92, 0x3b, 0xf8, 0x58, 0x04, 0x06, 0x89,
+	0xdc, 0xba, 0x9d, 0x89, 0x4f, 0x81, 0xf5, 0xf7, 0xd0, 0x58, 0x6a, 0x13, 0x99, 0xf2, 0xca, 0xb1,
+	0x4e, 0x82, 0xe2, 0x41, 0x1f, 0x25, 0x67, 0x47, 0x37, 0xf1, 0x45, 0x68, 0xa8, 0xef, 0x56, 0xa6,
+	0xbe, 0x03, 0xee, 0x3b, 0xde, 0x28, 0x0c, 0x85, 0xfe, 0x1e, 0x7a, 0x23, 0x8f, 0x35, 0x42, 0xbb,
+	0xc3, 0xb8, 0x63, 0xb1, 0x50, 0xe1, 0x4e, 0xbe, 0xc2, 0x05, 0xf0, 0x05, 0x55, 0x9a, 0xb0, 0xd5,
+	0xf3, 0x09, 0xe6, 0xb1, 0xc3, 0x05, 0x7a, 0x98, 0xed, 0x9d, 0x14, 0x2c, 0x54, 0xb4, 0x2c, 0x63,
+	0xf4, 0xf7, 0xd0, 0x2f, 0xa1, 0xde, 0xf7, 0xe9, 0x24, 0x46, 0x7f, 0x3f, 0x93, 0x3e, 0x09, 0x2a,
+	0x48, 0x6e, 0xc2, 0xe6, 0x73, 0xcc, 0x62, 0xdc, 0xf7, 0x32, 0xb9, 0x13, 0x98, 0x90, 0xfa, 0x66,
+	0x26, 0x74, 0x97, 0x52, 0x37, 0xe6, 0x9e, 0x33, 0x40, 0xe1, 0x59, 0x38, 0xa6, 0x25, 0x3b, 0xdd,
+	0x16, 0x81, 0xa1, 0xaa, 0xc7, 0x85, 0xf1, 0x91, 0xe2, 0xdf, 0x42, 0x6b, 0x71, 0x7c, 0x10, 0x04,
+	0xfe, 0x7f, 0x61, 0xc0, 0x21, 0x54, 0x55, 0xc4, 0x9f, 0xba, 0x0e, 0x66, 0xe8, 0xee, 0x92, 0x9c,
+	0x90, 0x88, 0x82, 0x11, 0xfb, 0x29, 0x54, 0x44, 0xa4, 0x15, 0xe9, 0x0f, 0x73, 0x33, 0xe1, 0x22,
+	0x94, 0x07, 0x00, 0x4f, 0x5d, 0x4e, 0x7c, 0xc5, 0x79, 0x27, 0x93, 0x73, 0x0e, 0x28, 0x48, 0xea,
+	0x41, 0xe3, 0xe0, 0x98, 0xc6, 0xce, 0xb2, 0x0c, 0x3d, 0xc8, 0xae, 0xa8, 0x24, 0x2a, 0xa4, 0x7f,
+	0x58, 0x0c, 0x1c, 0xb9, 0xfb, 0x35, 0x34, 0xa4, 0x8d, 0xb1, 0x2c, 0x7b, 0x90, 0xbf, 0x92, 0x0b,
+	0x17, 0xca, 0x6b, 0x68, 0xa8, 0x58, 0xbd, 0x0c, 0xdf, 0x03, 0x72, 0xe8, 0x53, 0xa8, 0x82, 0xf4,
+	0x3f, 0x87, 0x4d, 0x11, 0xb5, 0x39, 0xf9, 0xbd, 0xdc, 0xc8, 0x5e, 0x94, 0xfa, 0x35, 0xd4, 0x9e,
+	0x63, 0x36, 0x67, 0xee, 0xe4, 0x55, 0xf8, 0x02, 0x71, 0xa1, 0x02, 0x3f, 0x81, 0xba, 0x08, 0x4a,
+	0x34, 0x99, 0xe5, 0xb4, 0xa7, 0x24, 0x28, 0x54, 0xf1, 0xa0, 0x10, 0x36, 0x52, 0xc6, 0xe0, 0x5a,
+	0x72, 0x2c, 0x2a, 0xe8, 0xef, 0x50, 0x29, 0x81, 0x9a, 0x18, 0x0b, 0xaf, 0xf2, 0x39, 0x0e, 0x8c,
+	0x43, 0x42, 0x45, 0xf7, 0x0a, 0x20, 0x63, 0x7b, 0x57, 0x3d, 0xf9, 0x00, 0x8c, 0x72, 0x37, 0xfc,
+	0xcc, 0xa7, 0xe8, 0x56, 0xb7, 0x28, 0x3c, 0x52, 0xf9, 0x2b, 0x58, 0x0f, 0x9e, 0x65, 0xd1, 0x9d,
+	0xa5, 0x93, 0xa3, 0x17, 0xe1, 0xd6, 0xdd, 0x73, 0x71, 0x11, 0x3b, 0x86, 0xed, 0xc3, 0x89, 0x2d,
+	0xb6, 0x3c, 0xb5, 0xb1, 0x86, 0x5b, 0x7b, 0x3a, 0xb7, 0xa3, 0xdd, 0x38, 0x85, 0xdb, 0x67, 0xa3,
+	0xf3, 0x72, 0xdb, 0x87, 0x1b, 0x03, 0xef, 0x14, 0xbb, 0x8e, 0x9d, 0xd8, 0x59, 0xf7, 0x09, 0xc7,
+	0x3d, 0x6c, 0x1d, 0x93, 0xf4, 0xc6, 0xaf, 0xde, 0xf8, 0x93, 0x53, 0x22, 0x70, 0xc1, 0x7a, 0xfa,
+	0x0d, 0x20, 0xd5, 0x85, 0xbc, 0x23, 0x67, 0x34, 0xf5, 0xb1, 0x4a, 0xfa, 0xbc, 0x23, 0xcd, 0x22,
+	0x34, 0x54, 0xf3, 0xa3, 0x0b, 0xcc, 0x88, 0x9d, 0x36, 0x60, 0x8f, 0xf0, 0x7d, 0xc2, 0x7d, 0xc7,
+	0xca, 0x6b, 0xd5, 0x73, 0x40, 0x4e, 0xd0, 0x32, 0x70, 0x91, 0x82, 0x03, 0x58, 0x53, 0x2f, 0xd3,
+	0x48, 0xcf, 0x9c, 0x14, 0xbe, 0xab, 0x2f, 0x3b, 0x23, 0x45, 0x6f, 0xef, 0xb1, 0x1e, 0xb1, 0x47,
+	0x78, 0xec, 0xc5, 0x3b, 0xa7, 0x5c, 0x93, 0xa0, 0xe5, 0xe5, 0x9a, 0xc6, 0x46, 0xca, 0x3c, 0x68,
+	0xbc, 0x70, 0x58, 0x30, 0xf8, 0x0a, 0x8b, 0xfb, 0x5d, 0x36, 0x43, 0x0a, 0xb5, 0x7c, 0xe3, 0x59,
+	0x00, 0xc7, 0x3c, 0x56, 0x53, 0x97, 0xe4, 0xc0, 0x6f, 0xb9, 0x6f, 0x71, 0xf1, 0xbf, 0x24, 0xce,
+	0x4b, 0xb2, 0xaf, 0xa3, 0x53, 0x65, 0xf4, 0x76, 0x96, 0xde, 0xec, 0xe7, 0x65, 0x13, 0x41, 0x06,
+	0xde, 0x11, 0x2d, 0xc0, 0x1c, 0x54, 0xe5, 0xb7, 0xcd, 0x6c, 0xc2, 0x96, 0xba, 0x5d, 0xc5, 0x98,
+	0x1f, 0xe6, 0x9c, 0x9b, 0x92, 0xb0, 0x82, 0x95, 0x77, 0x0c, 0x9b, 0x22, 0x0c, 0x62, 0xde, 0x21,
+	0x23, 0x3e, 0xcb, 0xd9, 0x24, 0x13, 0x98, 0x90, 0xfa, 0x7e, 0x11, 0x68, 0x2c, 0x87, 0x36, 0x13,
+	0xef, 0x96, 0xe9, 0x75, 0xcc, 0x83, 0x9a, 0xf5, 0x8a, 0xda, 0x7a, 0x54, 0x10, 0x1d, 0xcb, 0x21,
+	0x50, 0xe1, 0x36, 0xa8, 0x4b, 0x72, 0xca, 0x7a, 0x0e, 0x28, 0xe8, 0xae, 0x2f, 0x61, 0x43, 0x9c,
+	0x17, 0x24, 0xe5, 0xed, 0xdc, 0xe3, 0xc4, 0x05, 0x08, 0x5f, 0x43, 0xe3, 0xcb, 0x09, 0xf1, 0x31,
+	0x27, 0xc2, 0x5f, 0x92, 0x37, 0xbb, 0xb2, 0x52, 0xa8, 0xc2, 0x77, 0x11, 0x38, 0x20, 0xa2, 0x83,
+	0x2f, 0x71, 0xc2, 0x1c, 0xb0, 0xbc, 0xb7, 0xc5, 0x71, 0xf1, 0xe6, 0xa9, 0xe4, 0xc2, 0xb0, 0xa5,
+	0x0a, 0xa4, 0xe5, 0x05, 0x14, 0x28, 0x5c, 0xfc, 0x2e, 0x18, 0x2c, 0xfd, 0xa5, 0xef, 0x9c, 0x3a,
+	0x2e, 0x19, 0x91, 0x9c, 0x0a, 0x48, 0xc3, 0x0a, 0xba, 0x68, 0x08, 0x55, 0xa5, 0x78, 0xcf, 0xc7,
+	0x1e, 0x47, 0xcb, 0x4c, 0x93, 0x88, 0x90, 0xb6, 0x73, 0x3e, 0x30, 0x5a, 0x84, 0x05, 0x20, 0xca,
+	0xe2, 0x25, 0x75, 0x1d, 0x6b, 0x96, 0x3e, 0xec, 0x44, 0xad, 0x61, 0x0e, 0xc9, 0x39, 0xec, 0x64,
+	0x22, 0x23, 0x25, 0x43, 0xa8, 0xf6, 0x8e, 0x89, 0x75, 0xf2, 0x9c, 0x60, 0x97, 0x1f, 0xe7, 0x5d,
+	0x8e, 0xe6, 0x88, 0xe5, 0x0b, 0x49, 0x00, 0xe3, 0xd1, 0x30, 0x88, 0x87, 0xc7, 0xe7, 0xdf, 0xcc,
+	0xd3, 0xb0, 0xe2, 0x37, 0x73, 0x55, 0x94, 0x7d, 0xcc, 0xb1, 0x7c, 0xad, 0xbb, 0xbf, 0xa4, 0x72,
+	0x43, 0x50, 0x41, 0xf2, 0x9f, 0x41, 0x4d, 0x94, 0x67, 0x44, 0xdd, 0xc9, 0xad, 0xe0, 0x0b, 0x12,
+	0x07, 0x5d, 0x34, 0x9c, 0xb5, 0xac, 0x8b, 0x46, 0x98, 0xf3, 0xbb, 0x68, 0x0c, 0x1a, 0x05, 0xe0,
+	0x1b, 0x68, 0xa4, 0xfe, 0x76, 0x41, 0xb9, 0x67, 0xd4, 0xec, 0xff, 0x67, 0xce, 0x5b, 0xcb, 0xaf,
+	0xe1, 0xca, 0xc2, 0x5b, 0x75, 0xfa, 0x28, 0x96, 0xec, 0xbe, 0x59, 0xff, 0x7c, 0xa4, 0x8f, 0x62,
+	0x4b, 0x67, 0x44, 0xab, 0xfb, 0x93, 0xa6, 0x1e, 0xca, 0x33, 0x9f, 0x07, 0xd1, 0x93, 0x65, 0x94,
+	0xcb, 0xde, 0x88, 0x5b, 0x9f, 0x5e, 0x62, 0x66, 0x68, 0xd4, 0xee, 0x93, 0x5f, 0x7c, 0x32, 0x72,
+	0xf8, 0xf1, 0x74, 0x28, 0x5c, 0xf5, 0x58, 0x11, 0x3d, 0x72, 0x68, 0xf0, 0xeb, 0x71, 0x58, 0x94,
+	0x8f, 0x25, 0xf7, 0xe3, 0x88, 0x7b, 0x32, 0x1c, 0xae, 0x49, 0xd1, 0xc7, 0xff, 0x0d, 0x00, 0x00,
+	0xff, 0xff, 0xdd, 0x1a, 0x2a, 0x9b, 0x5e, 0x23, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -793,28 +1464,28 @@ type RootCoordClient interface {
 	GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error)
 	GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
 	GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
-	//*
+	// *
 	// @brief This method is used to create collection
 	//
 	// @param CreateCollectionRequest, use to provide collection information to be created.
 	//
 	// @return Status
 	CreateCollection(ctx context.Context, in *milvuspb.CreateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	//*
+	// *
 	// @brief This method is used to delete collection.
 	//
 	// @param DropCollectionRequest, collection name is going to be deleted.
 	//
 	// @return Status
 	DropCollection(ctx context.Context, in *milvuspb.DropCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	//*
+	// *
 	// @brief This method is used to test collection existence.
 	//
 	// @param HasCollectionRequest, collection name is going to be tested.
 	//
 	// @return BoolResponse
 	HasCollection(ctx context.Context, in *milvuspb.HasCollectionRequest, opts ...grpc.CallOption) (*milvuspb.BoolResponse, error)
-	//*
+	// *
 	// @brief This method is used to get collection schema.
 	//
 	// @param DescribeCollectionRequest, target collection name.
@@ -825,28 +1496,28 @@ type RootCoordClient interface {
 	CreateAlias(ctx context.Context, in *milvuspb.CreateAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	DropAlias(ctx context.Context, in *milvuspb.DropAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	AlterAlias(ctx context.Context, in *milvuspb.AlterAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	//*
+	// *
 	// @brief This method is used to list all collections.
 	//
 	// @return StringListResponse, collection name list
 	ShowCollections(ctx context.Context, in *milvuspb.ShowCollectionsRequest, opts ...grpc.CallOption) (*milvuspb.ShowCollectionsResponse, error)
 	AlterCollection(ctx context.Context, in *milvuspb.AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	//*
+	// *
 	// @brief This method is used to create partition
 	//
 	// @return Status
 	CreatePartition(ctx context.Context, in *milvuspb.CreatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	//*
+	// *
 	// @brief This method is used to drop partition
 	//
 	// @return Status
 	DropPartition(ctx context.Context, in *milvuspb.DropPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	//*
+	// *
 	// @brief This method is used to test partition existence.
 	//
 	// @return BoolResponse
 	HasPartition(ctx context.Context, in *milvuspb.HasPartitionRequest, opts ...grpc.CallOption) (*milvuspb.BoolResponse, error)
-	//*
+	// *
 	// @brief This method is used to show partition information
 	//
 	// @param ShowPartitionRequest, target collection name.
@@ -854,7 +1525,7 @@ type RootCoordClient interface {
 	// @return StringListResponse
 	ShowPartitions(ctx context.Context, in *milvuspb.ShowPartitionsRequest, opts ...grpc.CallOption) (*milvuspb.ShowPartitionsResponse, error)
 	ShowPartitionsInternal(ctx context.Context, in *milvuspb.ShowPartitionsRequest, opts ...grpc.CallOption) (*milvuspb.ShowPartitionsResponse, error)
-	//    rpc DescribeSegment(milvus.DescribeSegmentRequest) returns (milvus.DescribeSegmentResponse) {}
+	// rpc DescribeSegment(milvus.DescribeSegmentRequest) returns (milvus.DescribeSegmentResponse) {}
 	ShowSegments(ctx context.Context, in *milvuspb.ShowSegmentsRequest, opts ...grpc.CallOption) (*milvuspb.ShowSegmentsResponse, error)
 	AllocTimestamp(ctx context.Context, in *AllocTimestampRequest, opts ...grpc.CallOption) (*AllocTimestampResponse, error)
 	AllocID(ctx context.Context, in *AllocIDRequest, opts ...grpc.CallOption) (*AllocIDResponse, error)
@@ -889,6 +1560,9 @@ type RootCoordClient interface {
 	CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequest, opts ...grpc.CallOption) (*milvuspb.ListDatabasesResponse, error)
+	CloneCollection(ctx context.Context, in *CloneCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetCapacityReport(ctx context.Context, in *GetCapacityReportRequest, opts ...grpc.CallOption) (*GetCapacityReportResponse, error)
+	GetCollectionStatsHistory(ctx context.Context, in *GetCollectionStatsHistoryRequest, opts ...grpc.CallOption) (*GetCollectionStatsHistoryResponse, error)
 }
 
 type rootCoordClient struct {
@@ -1322,33 +1996,60 @@ func (c *rootCoordClient) ListDatabases(ctx context.Context, in *milvuspb.ListDa
 	return out, nil
 }
 
+func (c *rootCoordClient) CloneCollection(ctx context.Context, in *CloneCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CloneCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) GetCapacityReport(ctx context.Context, in *GetCapacityReportRequest, opts ...grpc.CallOption) (*GetCapacityReportResponse, error) {
+	out := new(GetCapacityReportResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/GetCapacityReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) GetCollectionStatsHistory(ctx context.Context, in *GetCollectionStatsHistoryRequest, opts ...grpc.CallOption) (*GetCollectionStatsHistoryResponse, error) {
+	out := new(GetCollectionStatsHistoryResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/GetCollectionStatsHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RootCoordServer is the server API for RootCoord service.
 type RootCoordServer interface {
 	GetComponentStates(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)
 	GetTimeTickChannel(context.Context, *internalpb.GetTimeTickChannelRequest) (*milvuspb.StringResponse, error)
 	GetStatisticsChannel(context.Context, *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error)
-	//*
+	// *
 	// @brief This method is used to create collection
 	//
 	// @param CreateCollectionRequest, use to provide collection information to be created.
 	//
 	// @return Status
 	CreateCollection(context.Context, *milvuspb.CreateCollectionRequest) (*commonpb.Status, error)
-	//*
+	// *
 	// @brief This method is used to delete collection.
 	//
 	// @param DropCollectionRequest, collection name is going to be deleted.
 	//
 	// @return Status
 	DropCollection(context.Context, *milvuspb.DropCollectionRequest) (*commonpb.Status, error)
-	//*
+	// *
 	// @brief This method is used to test collection existence.
 	//
 	// @param HasCollectionRequest, collection name is going to be tested.
 	//
 	// @return BoolResponse
 	HasCollection(context.Context, *milvuspb.HasCollectionRequest) (*milvuspb.BoolResponse, error)
-	//*
+	// *
 	// @brief This method is used to get collection schema.
 	//
 	// @param DescribeCollectionRequest, target collection name.
@@ -1359,28 +2060,28 @@ type RootCoordServer interface {
 	CreateAlias(context.Context, *milvuspb.CreateAliasRequest) (*commonpb.Status, error)
 	DropAlias(context.Context, *milvuspb.DropAliasRequest) (*commonpb.Status, error)
 	AlterAlias(context.Context, *milvuspb.AlterAliasRequest) (*commonpb.Status, error)
-	//*
+	// *
 	// @brief This method is used to list all collections.
 	//
 	// @return StringListResponse, collection name list
 	ShowCollections(context.Context, *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error)
 	AlterCollection(context.Context, *milvuspb.AlterCollectionRequest) (*commonpb.Status, error)
-	//*
+	// *
 	// @brief This method is used to create partition
 	//
 	// @return Status
 	CreatePartition(context.Context, *milvuspb.CreatePartitionRequest) (*commonpb.Status, error)
-	//*
+	// *
 	// @brief This method is used to drop partition
 	//
 	// @return Status
 	DropPartition(context.Context, *milvuspb.DropPartitionRequest) (*commonpb.Status, error)
-	//*
+	// *
 	// @brief This method is used to test partition existence.
 	//
 	// @return BoolResponse
 	HasPartition(context.Context, *milvuspb.HasPartitionRequest) (*milvuspb.BoolResponse, error)
-	//*
+	// *
 	// @brief This method is used to show partition information
 	//
 	// @param ShowPartitionRequest, target collection name.
@@ -1388,7 +2089,7 @@ type RootCoordServer interface {
 	// @return StringListResponse
 	ShowPartitions(context.Context, *milvuspb.ShowPartitionsRequest) (*milvuspb.ShowPartitionsResponse, error)
 	ShowPartitionsInternal(context.Context, *milvuspb.ShowPartitionsRequest) (*milvuspb.ShowPartitionsResponse, error)
-	//    rpc DescribeSegment(milvus.DescribeSegmentRequest) returns (milvus.DescribeSegmentResponse) {}
+	// rpc DescribeSegment(milvus.DescribeSegmentRequest) returns (milvus.DescribeSegmentResponse) {}
 	ShowSegments(context.Context, *milvuspb.ShowSegmentsRequest) (*milvuspb.ShowSegmentsResponse, error)
 	AllocTimestamp(context.Context, *AllocTimestampRequest) (*AllocTimestampResponse, error)
 	AllocID(context.Context, *AllocIDRequest) (*AllocIDResponse, error)
@@ -1423,6 +2124,9 @@ type RootCoordServer interface {
 	CreateDatabase(context.Context, *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error)
 	DropDatabase(context.Context, *milvuspb.DropDatabaseRequest) (*commonpb.Status, error)
 	ListDatabases(context.Context, *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error)
+	CloneCollection(context.Context, *CloneCollectionRequest) (*commonpb.Status, error)
+	GetCapacityReport(context.Context, *GetCapacityReportRequest) (*GetCapacityReportResponse, error)
+	GetCollectionStatsHistory(context.Context, *GetCollectionStatsHistoryRequest) (*GetCollectionStatsHistoryResponse, error)
 }
 
 // UnimplementedRootCoordServer can be embedded to have forward compatible implementations.
@@ -1570,6 +2274,15 @@ func (*UnimplementedRootCoordServer) DropDatabase(ctx context.Context, req *milv
 func (*UnimplementedRootCoordServer) ListDatabases(ctx context.Context, req *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListDatabases not implemented")
 }
+func (*UnimplementedRootCoordServer) CloneCollection(ctx context.Context, req *CloneCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneCollection not implemented")
+}
+func (*UnimplementedRootCoordServer) GetCapacityReport(ctx context.Context, req *GetCapacityReportRequest) (*GetCapacityReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCapacityReport not implemented")
+}
+func (*UnimplementedRootCoordServer) GetCollectionStatsHistory(ctx context.Context, req *GetCollectionStatsHistoryRequest) (*GetCollectionStatsHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionStatsHistory not implemented")
+}
 
 func RegisterRootCoordServer(s *grpc.Server, srv RootCoordServer) {
 	s.RegisterService(&_RootCoord_serviceDesc, srv)
@@ -2421,6 +3134,60 @@ func _RootCoord_ListDatabases_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RootCoord_CloneCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).CloneCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/CloneCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).CloneCollection(ctx, req.(*CloneCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_GetCapacityReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCapacityReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).GetCapacityReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/GetCapacityReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).GetCapacityReport(ctx, req.(*GetCapacityReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_GetCollectionStatsHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionStatsHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).GetCollectionStatsHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/GetCollectionStatsHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).GetCollectionStatsHistory(ctx, req.(*GetCollectionStatsHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _RootCoord_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.rootcoord.RootCoord",
 	HandlerType: (*RootCoordServer)(nil),
@@ -2613,6 +3380,18 @@ var _RootCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListDatabases",
 			Handler:    _RootCoord_ListDatabases_Handler,
 		},
+		{
+			MethodName: "CloneCollection",
+			Handler:    _RootCoord_CloneCollection_Handler,
+		},
+		{
+			MethodName: "GetCapacityReport",
+			Handler:    _RootCoord_GetCapacityReport_Handler,
+		},
+		{
+			MethodName: "GetCollectionStatsHistory",
+			Handler:    _RootCoord_GetCollectionStatsHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "root_coord.proto",
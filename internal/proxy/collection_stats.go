@@ -0,0 +1,97 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
+)
+
+// collectionStatsRecorder accumulates the requests of each collection served by the proxy,
+// they're reported along with the proxy metrics, RootCoord samples them to maintain the statistics history.
+type collectionStatsRecorder struct {
+	mu    sync.Mutex
+	stats map[int64]*metricsinfo.CollectionRequestMetrics
+}
+
+func newCollectionStatsRecorder() *collectionStatsRecorder {
+	return &collectionStatsRecorder{
+		stats: make(map[int64]*metricsinfo.CollectionRequestMetrics),
+	}
+}
+
+func (r *collectionStatsRecorder) get(collectionID int64) *metricsinfo.CollectionRequestMetrics {
+	stats, ok := r.stats[collectionID]
+	if !ok {
+		stats = &metricsinfo.CollectionRequestMetrics{CollectionID: collectionID}
+		r.stats[collectionID] = stats
+	}
+	return stats
+}
+
+func (r *collectionStatsRecorder) recordSearch(collectionID int64, latency time.Duration) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stats := r.get(collectionID)
+	stats.SearchCount++
+	stats.ReadLatencyMs += latency.Milliseconds()
+}
+
+func (r *collectionStatsRecorder) recordQuery(collectionID int64, latency time.Duration) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stats := r.get(collectionID)
+	stats.QueryCount++
+	stats.ReadLatencyMs += latency.Milliseconds()
+}
+
+func (r *collectionStatsRecorder) recordWrite(collectionID int64, insertRows, deleteRows int64) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stats := r.get(collectionID)
+	stats.InsertRows += insertRows
+	stats.DeleteRows += deleteRows
+}
+
+// list returns the accumulated requests of the collections, ordered by collection id.
+func (r *collectionStatsRecorder) list() []metricsinfo.CollectionRequestMetrics {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ret := make([]metricsinfo.CollectionRequestMetrics, 0, len(r.stats))
+	for _, stats := range r.stats {
+		ret = append(ret, *stats)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].CollectionID < ret[j].CollectionID
+	})
+	return ret
+}
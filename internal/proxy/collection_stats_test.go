@@ -0,0 +1,48 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
+)
+
+func TestCollectionStatsRecorder(t *testing.T) {
+	var nilRecorder *collectionStatsRecorder
+	nilRecorder.recordSearch(1, time.Second)
+	nilRecorder.recordQuery(1, time.Second)
+	nilRecorder.recordWrite(1, 10, 0)
+	assert.Empty(t, nilRecorder.list())
+
+	r := newCollectionStatsRecorder()
+	assert.Empty(t, r.list())
+	r.recordSearch(2, 10*time.Millisecond)
+	r.recordSearch(2, 20*time.Millisecond)
+	r.recordQuery(2, 30*time.Millisecond)
+	r.recordWrite(2, 100, 0)
+	r.recordWrite(2, 5, 5)
+	r.recordWrite(1, 0, 3)
+
+	assert.Equal(t, []metricsinfo.CollectionRequestMetrics{
+		{CollectionID: 1, DeleteRows: 3},
+		{CollectionID: 2, SearchCount: 2, QueryCount: 1, ReadLatencyMs: 60, InsertRows: 105, DeleteRows: 5},
+	}, r.list())
+}
@@ -0,0 +1,123 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// methodLimiter caps the number of concurrent calls of a method,
+// the calls beyond the limit wait in the queue, and are rejected once the queue is full.
+type methodLimiter struct {
+	method         string
+	nodeID         string
+	tokens         chan struct{}
+	queued         atomic.Int32
+	maxQueueLength int32
+}
+
+func newMethodLimiter(method string, limit int, maxQueueLength int) *methodLimiter {
+	return &methodLimiter{
+		method:         method,
+		nodeID:         strconv.FormatInt(paramtable.GetNodeID(), 10),
+		tokens:         make(chan struct{}, limit),
+		maxQueueLength: int32(maxQueueLength),
+	}
+}
+
+func (l *methodLimiter) acquire(ctx context.Context) error {
+	select {
+	case l.tokens <- struct{}{}:
+		metrics.ProxyMethodInflightNum.WithLabelValues(l.nodeID, l.method).Inc()
+		return nil
+	default:
+	}
+
+	if l.queued.Inc() > l.maxQueueLength {
+		l.queued.Dec()
+		metrics.ProxyMethodRejectedCount.WithLabelValues(l.nodeID, l.method).Inc()
+		return status.Errorf(codes.ResourceExhausted,
+			"request is rejected by the concurrency limit of %s, please retry later", l.method)
+	}
+	metrics.ProxyMethodQueueLength.WithLabelValues(l.nodeID, l.method).Inc()
+	defer func() {
+		l.queued.Dec()
+		metrics.ProxyMethodQueueLength.WithLabelValues(l.nodeID, l.method).Dec()
+	}()
+
+	start := time.Now()
+	select {
+	case l.tokens <- struct{}{}:
+		metrics.ProxyMethodQueueLatency.WithLabelValues(l.nodeID, l.method).Observe(float64(time.Since(start).Milliseconds()))
+		metrics.ProxyMethodInflightNum.WithLabelValues(l.nodeID, l.method).Inc()
+		return nil
+	case <-ctx.Done():
+		return status.FromContextError(ctx.Err()).Err()
+	}
+}
+
+func (l *methodLimiter) release() {
+	<-l.tokens
+	metrics.ProxyMethodInflightNum.WithLabelValues(l.nodeID, l.method).Dec()
+}
+
+// newMethodLimiters parses the concurrency limits keyed by the method name,
+// the method names are matched case-insensitively.
+func newMethodLimiters(limits map[string]string, maxQueueLength int) map[string]*methodLimiter {
+	limiters := make(map[string]*methodLimiter)
+	for method, value := range limits {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit <= 0 {
+			log.Warn("ignore invalid method concurrency limit", zap.String("method", method), zap.String("limit", value))
+			continue
+		}
+		method = strings.ToLower(method)
+		limiters[method] = newMethodLimiter(method, limit, maxQueueLength)
+	}
+	return limiters
+}
+
+// ConcurrencyLimitInterceptor returns a new unary server interceptor that caps the concurrent calls per method,
+// which protects the coordinators from the retry storms on the expensive methods like Flush and CreateIndex.
+func ConcurrencyLimitInterceptor() grpc.UnaryServerInterceptor {
+	limiters := newMethodLimiters(Params.ProxyCfg.MethodConcurrencyLimits.GetAsJSONMap(), Params.ProxyCfg.MethodQueueLength.GetAsInt())
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		limiter, ok := limiters[strings.ToLower(path.Base(info.FullMethod))]
+		if !ok {
+			return handler(ctx, req)
+		}
+		if err := limiter.acquire(ctx); err != nil {
+			return nil, err
+		}
+		defer limiter.release()
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,115 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestNewMethodLimiters(t *testing.T) {
+	limiters := newMethodLimiters(map[string]string{
+		"Flush":       "2",
+		"createindex": "1",
+		"invalid":     "abc",
+		"zero":        "0",
+	}, 4)
+	assert.Equal(t, 2, len(limiters))
+	assert.Equal(t, 2, cap(limiters["flush"].tokens))
+	assert.Equal(t, 1, cap(limiters["createindex"].tokens))
+}
+
+func TestMethodLimiter(t *testing.T) {
+	limiter := newMethodLimiter("flush", 1, 1)
+	ctx := context.Background()
+	assert.NoError(t, limiter.acquire(ctx))
+
+	// waits in the queue
+	acquired := make(chan error, 1)
+	go func() {
+		acquired <- limiter.acquire(ctx)
+	}()
+	assert.Eventually(t, func() bool { return limiter.queued.Load() == 1 }, time.Second, 10*time.Millisecond)
+
+	// rejected since the queue is full
+	err := limiter.acquire(ctx)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	limiter.release()
+	assert.NoError(t, <-acquired)
+	assert.EqualValues(t, 0, limiter.queued.Load())
+
+	// canceled while waiting
+	cancelCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	err = limiter.acquire(cancelCtx)
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	limiter.release()
+}
+
+func TestConcurrencyLimitInterceptor(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(params.ProxyCfg.MethodConcurrencyLimits.Key, `{"Flush": 1}`)
+	params.Save(params.ProxyCfg.MethodQueueLength.Key, "0")
+	defer params.Reset(params.ProxyCfg.MethodConcurrencyLimits.Key)
+	defer params.Reset(params.ProxyCfg.MethodQueueLength.Key)
+
+	interceptor := ConcurrencyLimitInterceptor()
+	flushInfo := &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/Flush"}
+	searchInfo := &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/Search"}
+
+	running := make(chan struct{})
+	done := make(chan struct{})
+	blocking := func(ctx context.Context, req interface{}) (interface{}, error) {
+		close(running)
+		<-done
+		return "flushed", nil
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+
+	result := make(chan interface{}, 1)
+	go func() {
+		resp, _ := interceptor(context.Background(), nil, flushInfo, blocking)
+		result <- resp
+	}()
+	<-running
+
+	_, err := interceptor(context.Background(), nil, flushInfo, handler)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// other methods aren't limited
+	resp, err := interceptor(context.Background(), nil, searchInfo, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+
+	close(done)
+	assert.Equal(t, "flushed", <-result)
+	resp, err = interceptor(context.Background(), nil, flushInfo, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+}
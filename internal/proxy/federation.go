@@ -0,0 +1,500 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	// federationRoutePrefix is the meta path of the federation routes, relative to the meta root path.
+	// The key is {prefix}/{database} or {prefix}/{database}/{collection}, the value is the address of the target proxy.
+	federationRoutePrefix = "federation"
+	// federationForwardedKey marks the request forwarded by a federation proxy in the metadata with the forward token,
+	// the forwarded request is always served by the receiving cluster, so that misconfigured routes never loop.
+	federationForwardedKey = "federation-forwarded"
+
+	milvusServicePrefix = "/milvus.proto.milvus.MilvusService/"
+)
+
+// FederationInterceptor routes the requests of the databases or collections mapped to other Milvus clusters.
+func FederationInterceptor(f types.Federation) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if f == nil {
+			return handler(ctx, req)
+		}
+		return f.Intercept(ctx, info.FullMethod, req, handler)
+	}
+}
+
+// federationTarget is the proxy of a backend Milvus cluster.
+type federationTarget struct {
+	address string
+	conn    *grpc.ClientConn
+	client  milvuspb.MilvusServiceClient
+	healthy atomic.Bool
+}
+
+// federation routes the requests to the backend clusters by the routes in meta,
+// the routes of collections take priority over the routes of their databases.
+type federation struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu      sync.RWMutex
+	routes  map[string]string            // database or database/collection -> target address
+	targets map[string]*federationTarget // target address -> target
+
+	// dial creates the connection to the target proxy, replaced in unit tests
+	dial func(ctx context.Context, address string) (*grpc.ClientConn, error)
+}
+
+func newFederation(ctx context.Context) *federation {
+	ctx, cancel := context.WithCancel(ctx)
+	return &federation{
+		ctx:     ctx,
+		cancel:  cancel,
+		routes:  make(map[string]string),
+		targets: make(map[string]*federationTarget),
+		dial: func(ctx context.Context, address string) (*grpc.ClientConn, error) {
+			creds, err := federationCredentials()
+			if err != nil {
+				return nil, err
+			}
+			return grpc.DialContext(ctx, address, grpc.WithTransportCredentials(creds))
+		},
+	}
+}
+
+// federationCredentials returns the credentials to connect to the target proxies by the TLS config of the proxy,
+// the target proxies are expected to be configured in the same TLS mode.
+func federationCredentials() (credentials.TransportCredentials, error) {
+	cfg := &paramtable.Get().ProxyGrpcServerCfg
+	switch cfg.TLSMode.GetAsInt() {
+	case 1:
+		if cfg.CaPemPath.GetValue() == "" {
+			return credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12}), nil
+		}
+		return credentials.NewClientTLSFromFile(cfg.CaPemPath.GetValue(), "")
+	case 2:
+		cert, err := tls.LoadX509KeyPair(cfg.ServerPemPath.GetValue(), cfg.ServerKeyPath.GetValue())
+		if err != nil {
+			return nil, err
+		}
+		rootBuf, err := os.ReadFile(cfg.CaPemPath.GetValue())
+		if err != nil {
+			return nil, err
+		}
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(rootBuf) {
+			return nil, fmt.Errorf("fail to append ca to cert")
+		}
+		return credentials.NewTLS(&tls.Config{
+			Certificates: []tls.Certificate{cert},
+			RootCAs:      certPool,
+			MinVersion:   tls.VersionTLS13,
+		}), nil
+	default:
+		return insecure.NewCredentials(), nil
+	}
+}
+
+// Start reloads the routes from meta and checks the health of the targets periodically.
+func (f *federation) Start(etcdCli *clientv3.Client) {
+	prefix := path.Join(Params.EtcdCfg.MetaRootPath.GetValue(), federationRoutePrefix) + "/"
+	f.wg.Add(1)
+	go func() {
+		defer f.wg.Done()
+		ticker := time.NewTicker(Params.ProxyCfg.Federation.RefreshInterval.GetAsDuration(time.Second))
+		defer ticker.Stop()
+		for {
+			if Params.ProxyCfg.Federation.Enable.GetAsBool() {
+				if err := f.refresh(etcdCli, prefix); err != nil {
+					log.Warn("failed to refresh federation routes", zap.Error(err))
+				}
+			}
+			select {
+			case <-f.ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Close stops the refreshing and releases the connections to the targets.
+func (f *federation) Close() {
+	f.cancel()
+	f.wg.Wait()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, target := range f.targets {
+		f.removeTarget(target)
+	}
+}
+
+func (f *federation) refresh(etcdCli *clientv3.Client, prefix string) error {
+	ctx, cancel := context.WithTimeout(f.ctx, Params.ProxyCfg.Federation.HealthCheckTimeout.GetAsDuration(time.Second))
+	resp, err := etcdCli.Get(ctx, prefix, clientv3.WithPrefix())
+	cancel()
+	if err != nil {
+		return err
+	}
+	routes := make(map[string]string, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		key := strings.TrimPrefix(string(kv.Key), prefix)
+		address := strings.TrimSpace(string(kv.Value))
+		if key == "" || address == "" {
+			continue
+		}
+		routes[key] = address
+	}
+	if err := f.setRoutes(routes); err != nil {
+		return err
+	}
+	f.checkHealth()
+	return nil
+}
+
+// setRoutes replaces the routes, connects to the new targets and disconnects from the unused ones.
+func (f *federation) setRoutes(routes map[string]string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	used := typeutil.NewSet[string]()
+	for _, address := range routes {
+		used.Insert(address)
+		if _, ok := f.targets[address]; ok {
+			continue
+		}
+		conn, err := f.dial(f.ctx, address)
+		if err != nil {
+			return fmt.Errorf("failed to connect to federation target %s: %w", address, err)
+		}
+		f.targets[address] = &federationTarget{
+			address: address,
+			conn:    conn,
+			client:  milvuspb.NewMilvusServiceClient(conn),
+		}
+		log.Info("federation target added", zap.String("target", address))
+	}
+	for address, target := range f.targets {
+		if !used.Contain(address) {
+			f.removeTarget(target)
+		}
+	}
+	f.routes = routes
+	return nil
+}
+
+// removeTarget disconnects from the target, the caller shall hold the lock.
+func (f *federation) removeTarget(target *federationTarget) {
+	target.conn.Close()
+	delete(f.targets, target.address)
+	metrics.ProxyFederationTargetHealthy.DeleteLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), target.address)
+	log.Info("federation target removed", zap.String("target", target.address))
+}
+
+// checkHealth checks the health of the targets concurrently.
+func (f *federation) checkHealth() {
+	f.mu.RLock()
+	targets := make([]*federationTarget, 0, len(f.targets))
+	for _, target := range f.targets {
+		targets = append(targets, target)
+	}
+	f.mu.RUnlock()
+
+	nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
+	wg := sync.WaitGroup{}
+	for _, target := range targets {
+		wg.Add(1)
+		go func(target *federationTarget) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(f.ctx, Params.ProxyCfg.Federation.HealthCheckTimeout.GetAsDuration(time.Second))
+			defer cancel()
+			resp, err := target.client.CheckHealth(ctx, &milvuspb.CheckHealthRequest{})
+			if err == nil {
+				err = merr.Error(resp.GetStatus())
+			}
+			healthy := err == nil && resp.GetIsHealthy()
+			if target.healthy.Swap(healthy) != healthy {
+				log.Info("federation target health changed", zap.String("target", target.address),
+					zap.Bool("healthy", healthy), zap.Strings("reasons", resp.GetReasons()), zap.Error(err))
+			}
+			if healthy {
+				metrics.ProxyFederationTargetHealthy.WithLabelValues(nodeID, target.address).Set(1)
+			} else {
+				metrics.ProxyFederationTargetHealthy.WithLabelValues(nodeID, target.address).Set(0)
+			}
+		}(target)
+	}
+	wg.Wait()
+}
+
+// route returns the target serving the database or collection, nil if it's served by this cluster.
+func (f *federation) route(dbName, collectionName string) *federationTarget {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	address, ok := f.routes[path.Join(dbName, collectionName)]
+	if !ok {
+		address, ok = f.routes[dbName]
+	}
+	if !ok {
+		return nil
+	}
+	return f.targets[address]
+}
+
+// collectionRoutes returns the targets and their collections of the database which has collections routed.
+func (f *federation) collectionRoutes(dbName string) map[*federationTarget]typeutil.Set[string] {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	ret := make(map[*federationTarget]typeutil.Set[string])
+	for key, address := range f.routes {
+		db, collection, ok := strings.Cut(key, "/")
+		if !ok || db != dbName {
+			continue
+		}
+		target := f.targets[address]
+		if _, ok := ret[target]; !ok {
+			ret[target] = typeutil.NewSet[string]()
+		}
+		ret[target].Insert(collection)
+	}
+	return ret
+}
+
+// Intercept implements types.Federation.
+func (f *federation) Intercept(ctx context.Context, fullMethod string, req interface{},
+	handler func(ctx context.Context, req interface{}) (interface{}, error),
+) (interface{}, error) {
+	// the forwarded mark is never passed on, whether it's trusted or not
+	ctx, forwarded := federationForwarded(ctx)
+	if !Params.ProxyCfg.Federation.Enable.GetAsBool() || !strings.HasPrefix(fullMethod, milvusServicePrefix) || forwarded {
+		return handler(ctx, req)
+	}
+
+	dbName, collectionName := getRequestDatabaseAndCollection(ctx, req)
+	if target := f.route(dbName, collectionName); target != nil {
+		return f.forward(ctx, target, fullMethod, req)
+	}
+	if showReq, ok := req.(*milvuspb.ShowCollectionsRequest); ok {
+		return f.showCollections(ctx, fullMethod, showReq, handler)
+	}
+	return handler(ctx, req)
+}
+
+// forward sends the request to the target, the response is of the type the method returns.
+func (f *federation) forward(ctx context.Context, target *federationTarget, fullMethod string, req interface{}) (interface{}, error) {
+	if !target.healthy.Load() {
+		return nil, merr.WrapErrServiceUnavailable("federation target unhealthy", target.address)
+	}
+	resp, err := newMethodResponse(fullMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if ok {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+	md.Set(federationForwardedKey, Params.ProxyCfg.Federation.ForwardToken.GetValue())
+	if err := target.conn.Invoke(metadata.NewOutgoingContext(ctx, md), fullMethod, req, resp); err != nil {
+		log.Ctx(ctx).Warn("failed to forward request to federation target",
+			zap.String("method", fullMethod), zap.String("target", target.address), zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+// showCollections merges the collections of this cluster and the collections routed to the targets.
+// The routed collections shown by this cluster are replaced by the ones shown by their targets.
+func (f *federation) showCollections(ctx context.Context, fullMethod string, req *milvuspb.ShowCollectionsRequest,
+	handler func(ctx context.Context, req interface{}) (interface{}, error),
+) (interface{}, error) {
+	remotes := f.collectionRoutes(req.GetDbName())
+	if len(remotes) == 0 {
+		return handler(ctx, req)
+	}
+	routed := typeutil.NewSet[string]()
+	for _, collections := range remotes {
+		routed.Insert(collections.Collect()...)
+	}
+
+	// the collection names are specified to show the loading progress, they are split by clusters.
+	localReq := proto.Clone(req).(*milvuspb.ShowCollectionsRequest)
+	localReq.CollectionNames = filterCollectionNames(req.GetCollectionNames(), func(name string) bool { return !routed.Contain(name) })
+	merged := &milvuspb.ShowCollectionsResponse{Status: merr.Status(nil)}
+	if len(req.GetCollectionNames()) == 0 || len(localReq.GetCollectionNames()) > 0 {
+		resp, err := handler(ctx, localReq)
+		if err != nil {
+			return nil, err
+		}
+		local := resp.(*milvuspb.ShowCollectionsResponse)
+		if merr.Error(local.GetStatus()) != nil {
+			return local, nil
+		}
+		appendShowCollections(merged, local, func(name string) bool { return !routed.Contain(name) })
+	}
+
+	for target, collections := range remotes {
+		isRouted := func(name string) bool { return collections.Contain(name) }
+		remoteReq := proto.Clone(req).(*milvuspb.ShowCollectionsRequest)
+		remoteReq.CollectionNames = filterCollectionNames(req.GetCollectionNames(), isRouted)
+		if len(req.GetCollectionNames()) > 0 && len(remoteReq.GetCollectionNames()) == 0 {
+			continue
+		}
+		resp, err := f.forward(ctx, target, fullMethod, remoteReq)
+		if err != nil {
+			return nil, err
+		}
+		remote := resp.(*milvuspb.ShowCollectionsResponse)
+		if merr.Error(remote.GetStatus()) != nil {
+			return remote, nil
+		}
+		appendShowCollections(merged, remote, isRouted)
+	}
+	return merged, nil
+}
+
+func filterCollectionNames(names []string, filter func(name string) bool) []string {
+	ret := make([]string, 0, len(names))
+	for _, name := range names {
+		if filter(name) {
+			ret = append(ret, name)
+		}
+	}
+	return ret
+}
+
+// appendShowCollections appends the collections accepted by the filter from the response to the merged one.
+func appendShowCollections(merged, resp *milvuspb.ShowCollectionsResponse, filter func(name string) bool) {
+	for i, name := range resp.GetCollectionNames() {
+		if !filter(name) {
+			continue
+		}
+		merged.CollectionNames = append(merged.CollectionNames, name)
+		if i < len(resp.GetCollectionIds()) {
+			merged.CollectionIds = append(merged.CollectionIds, resp.GetCollectionIds()[i])
+		}
+		if i < len(resp.GetCreatedTimestamps()) {
+			merged.CreatedTimestamps = append(merged.CreatedTimestamps, resp.GetCreatedTimestamps()[i])
+		}
+		if i < len(resp.GetCreatedUtcTimestamps()) {
+			merged.CreatedUtcTimestamps = append(merged.CreatedUtcTimestamps, resp.GetCreatedUtcTimestamps()[i])
+		}
+		if i < len(resp.GetInMemoryPercentages()) {
+			merged.InMemoryPercentages = append(merged.InMemoryPercentages, resp.GetInMemoryPercentages()[i])
+		}
+		if i < len(resp.GetQueryServiceAvailable()) {
+			merged.QueryServiceAvailable = append(merged.QueryServiceAvailable, resp.GetQueryServiceAvailable()[i])
+		}
+	}
+}
+
+// federationForwarded strips the forwarded mark from the incoming metadata, and returns whether the request is
+// forwarded by another federation proxy. The mark is trusted only if it carries the configured forward token,
+// so that clients can't bypass the routes by setting it.
+func federationForwarded(ctx context.Context) (context.Context, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx, false
+	}
+	values := md.Get(federationForwardedKey)
+	if len(values) == 0 {
+		return ctx, false
+	}
+	md = md.Copy()
+	md.Delete(federationForwardedKey)
+	ctx = metadata.NewIncomingContext(ctx, md)
+
+	token := Params.ProxyCfg.Federation.ForwardToken.GetValue()
+	if token == "" || subtle.ConstantTimeCompare([]byte(values[0]), []byte(token)) != 1 {
+		log.Ctx(ctx).RatedWarn(60, "untrusted federation forwarded mark ignored")
+		return ctx, false
+	}
+	return ctx, true
+}
+
+// getRequestDatabaseAndCollection returns the database and the collection the request operates on,
+// the database of the context is used if the request doesn't specify one.
+func getRequestDatabaseAndCollection(ctx context.Context, req interface{}) (string, string) {
+	var dbName, collectionName string
+	if r, ok := req.(interface{ GetDbName() string }); ok {
+		dbName = r.GetDbName()
+	}
+	if dbName == "" {
+		dbName = GetCurDBNameFromContextOrDefault(ctx)
+	}
+	if r, ok := req.(interface{ GetCollectionName() string }); ok {
+		collectionName = r.GetCollectionName()
+	}
+	return dbName, collectionName
+}
+
+// newMethodResponse creates an empty response of the gRPC method by its descriptor.
+func newMethodResponse(fullMethod string) (interface{}, error) {
+	name := strings.ReplaceAll(strings.TrimPrefix(fullMethod, "/"), "/", ".")
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		return nil, err
+	}
+	method, ok := desc.(protoreflect.MethodDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a gRPC method", fullMethod)
+	}
+	msgType, err := protoregistry.GlobalTypes.FindMessageByName(method.Output().FullName())
+	if err != nil {
+		return nil, err
+	}
+	return proto.MessageV1(msgType.New()), nil
+}
@@ -0,0 +1,222 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// federationBackend is the proxy of a target cluster in tests.
+type federationBackend struct {
+	milvuspb.UnimplementedMilvusServiceServer
+	healthy   bool
+	forwarded bool
+}
+
+func (b *federationBackend) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
+	return &milvuspb.CheckHealthResponse{Status: merr.Status(nil), IsHealthy: b.healthy}, nil
+}
+
+func (b *federationBackend) HasCollection(ctx context.Context, req *milvuspb.HasCollectionRequest) (*milvuspb.BoolResponse, error) {
+	_, b.forwarded = federationForwarded(ctx)
+	return &milvuspb.BoolResponse{Status: merr.Status(nil), Value: true}, nil
+}
+
+func (b *federationBackend) ShowCollections(ctx context.Context, req *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error) {
+	return &milvuspb.ShowCollectionsResponse{
+		Status:          merr.Status(nil),
+		CollectionNames: []string{"coll2", "coll3"},
+		CollectionIds:   []int64{102, 103},
+	}, nil
+}
+
+func startFederationBackend(t *testing.T, backend *federationBackend) string {
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	milvuspb.RegisterMilvusServiceServer(server, backend)
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+	return lis.Addr().String()
+}
+
+func TestFederation(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(params.ProxyCfg.Federation.Enable.Key, "true")
+	defer params.Reset(params.ProxyCfg.Federation.Enable.Key)
+	params.Save(params.ProxyCfg.Federation.ForwardToken.Key, "token")
+	defer params.Reset(params.ProxyCfg.Federation.ForwardToken.Key)
+
+	backend := &federationBackend{healthy: true}
+	address := startFederationBackend(t, backend)
+
+	f := newFederation(context.Background())
+	defer f.Close()
+	err := f.setRoutes(map[string]string{
+		"db1":           address,
+		"default/coll2": address,
+	})
+	require.NoError(t, err)
+	f.checkHealth()
+
+	hasCollection := milvusServicePrefix + "HasCollection"
+	localHandler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &milvuspb.BoolResponse{Status: merr.Status(nil), Value: false}, nil
+	}
+
+	t.Run("route", func(t *testing.T) {
+		// routed by database
+		resp, err := f.Intercept(context.Background(), hasCollection,
+			&milvuspb.HasCollectionRequest{DbName: "db1", CollectionName: "coll1"}, localHandler)
+		assert.NoError(t, err)
+		assert.True(t, resp.(*milvuspb.BoolResponse).GetValue())
+		assert.True(t, backend.forwarded)
+
+		// routed by collection
+		resp, err = f.Intercept(context.Background(), hasCollection,
+			&milvuspb.HasCollectionRequest{CollectionName: "coll2"}, localHandler)
+		assert.NoError(t, err)
+		assert.True(t, resp.(*milvuspb.BoolResponse).GetValue())
+
+		// served by this cluster
+		resp, err = f.Intercept(context.Background(), hasCollection,
+			&milvuspb.HasCollectionRequest{CollectionName: "coll1"}, localHandler)
+		assert.NoError(t, err)
+		assert.False(t, resp.(*milvuspb.BoolResponse).GetValue())
+
+		// the forwarded request is never forwarded again
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(federationForwardedKey, "token"))
+		resp, err = f.Intercept(ctx, hasCollection,
+			&milvuspb.HasCollectionRequest{DbName: "db1", CollectionName: "coll1"}, localHandler)
+		assert.NoError(t, err)
+		assert.False(t, resp.(*milvuspb.BoolResponse).GetValue())
+
+		// the forwarded mark without the token is ignored and stripped
+		ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(federationForwardedKey, "true"))
+		resp, err = f.Intercept(ctx, hasCollection,
+			&milvuspb.HasCollectionRequest{DbName: "db1", CollectionName: "coll1"}, localHandler)
+		assert.NoError(t, err)
+		assert.True(t, resp.(*milvuspb.BoolResponse).GetValue())
+
+		_, err = f.Intercept(ctx, hasCollection, &milvuspb.HasCollectionRequest{CollectionName: "coll1"},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				md, _ := metadata.FromIncomingContext(ctx)
+				assert.Empty(t, md.Get(federationForwardedKey))
+				return localHandler(ctx, req)
+			})
+		assert.NoError(t, err)
+	})
+
+	t.Run("show collections", func(t *testing.T) {
+		resp, err := f.Intercept(context.Background(), milvusServicePrefix+"ShowCollections",
+			&milvuspb.ShowCollectionsRequest{DbName: "default"},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				return &milvuspb.ShowCollectionsResponse{
+					Status:          merr.Status(nil),
+					CollectionNames: []string{"coll1", "coll2"},
+					CollectionIds:   []int64{1, 2},
+				}, nil
+			})
+		assert.NoError(t, err)
+		show := resp.(*milvuspb.ShowCollectionsResponse)
+		assert.Equal(t, []string{"coll1", "coll2"}, show.GetCollectionNames())
+		assert.Equal(t, []int64{1, 102}, show.GetCollectionIds())
+	})
+
+	t.Run("unhealthy target", func(t *testing.T) {
+		backend.healthy = false
+		f.checkHealth()
+		defer func() {
+			backend.healthy = true
+			f.checkHealth()
+		}()
+
+		_, err := f.Intercept(context.Background(), hasCollection,
+			&milvuspb.HasCollectionRequest{DbName: "db1", CollectionName: "coll1"}, localHandler)
+		assert.ErrorIs(t, err, merr.ErrServiceUnavailable)
+	})
+
+	t.Run("remove routes", func(t *testing.T) {
+		err := f.setRoutes(map[string]string{})
+		assert.NoError(t, err)
+		assert.Empty(t, f.targets)
+		assert.Nil(t, f.route("db1", "coll1"))
+	})
+}
+
+func TestFederationForwarded(t *testing.T) {
+	paramtable.Init()
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(federationForwardedKey, ""))
+	// the mark is never trusted without the token configured
+	_, forwarded := federationForwarded(ctx)
+	assert.False(t, forwarded)
+
+	_, forwarded = federationForwarded(context.Background())
+	assert.False(t, forwarded)
+}
+
+func TestFederationCredentials(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	creds, err := federationCredentials()
+	assert.NoError(t, err)
+	assert.Equal(t, "insecure", creds.Info().SecurityProtocol)
+
+	params.Save(params.ProxyGrpcServerCfg.TLSMode.Key, "1")
+	defer params.Reset(params.ProxyGrpcServerCfg.TLSMode.Key)
+	creds, err = federationCredentials()
+	assert.NoError(t, err)
+	assert.Equal(t, "tls", creds.Info().SecurityProtocol)
+
+	params.Save(params.ProxyGrpcServerCfg.TLSMode.Key, "2")
+	params.Save(params.ProxyGrpcServerCfg.ServerPemPath.Key, "/not/exist.pem")
+	defer params.Reset(params.ProxyGrpcServerCfg.ServerPemPath.Key)
+	_, err = federationCredentials()
+	assert.Error(t, err)
+}
+
+func TestNewMethodResponse(t *testing.T) {
+	resp, err := newMethodResponse(milvusServicePrefix + "Search")
+	assert.NoError(t, err)
+	assert.IsType(t, &milvuspb.SearchResults{}, resp)
+
+	_, err = newMethodResponse(milvusServicePrefix + "Unknown")
+	assert.Error(t, err)
+}
+
+func TestGetRequestDatabaseAndCollection(t *testing.T) {
+	dbName, collectionName := getRequestDatabaseAndCollection(context.Background(),
+		&milvuspb.SearchRequest{DbName: "db", CollectionName: "coll"})
+	assert.Equal(t, "db", dbName)
+	assert.Equal(t, "coll", collectionName)
+
+	dbName, collectionName = getRequestDatabaseAndCollection(context.Background(), &milvuspb.ListDatabasesRequest{})
+	assert.Equal(t, GetCurDBNameFromContextOrDefault(context.Background()), dbName)
+	assert.Empty(t, collectionName)
+}
@@ -0,0 +1,185 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// minSuccessRate bounds the error penalty, so a node with 100% error rate still has a finite score
+const minSuccessRate = 0.01
+
+// leaderHealth keeps the smoothed latency and error rate of one shard leader
+type leaderHealth struct {
+	mu            sync.Mutex
+	latency       float64 // EWMA of request latency, in ms
+	errorRate     float64 // EWMA of request failures, in range [0, 1]
+	sampled       bool
+	excludedUntil time.Time
+
+	// total nq of requests which already send but response hasn't received
+	executingNQ atomic.Int64
+}
+
+// score returns the cost to route a request to the leader, lower is better
+func (h *leaderHealth) score() float64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	successRate := math.Max(1-h.errorRate, minSuccessRate)
+	return (1 + h.latency) * float64(1+h.executingNQ.Load()) / successRate
+}
+
+func (h *leaderHealth) isExcluded(now time.Time) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return now.Before(h.excludedUntil)
+}
+
+// HealthScoredBalancer picks the shard leader with the best health score,
+// which is computed from the EWMA of latency and error rate per leader.
+// Leaders whose error rate reaches the threshold are excluded for a while,
+// so that a degraded replica stops hurting tail latency before it is detected as down.
+type HealthScoredBalancer struct {
+	nodes *typeutil.ConcurrentMap[int64, *leaderHealth]
+}
+
+func NewHealthScoredBalancer() *HealthScoredBalancer {
+	return &HealthScoredBalancer{
+		nodes: typeutil.NewConcurrentMap[int64, *leaderHealth](),
+	}
+}
+
+func (b *HealthScoredBalancer) Start(ctx context.Context) {}
+
+func (b *HealthScoredBalancer) Close() {}
+
+func (b *HealthScoredBalancer) getOrCreate(node int64) *leaderHealth {
+	health, _ := b.nodes.GetOrInsert(node, &leaderHealth{})
+	return health
+}
+
+func (b *HealthScoredBalancer) SelectNode(ctx context.Context, availableNodes []int64, cost int64) (int64, error) {
+	log := log.Ctx(ctx).WithRateGroup("proxy.HealthScoredBalancer", 1, 60)
+	if len(availableNodes) == 0 {
+		return -1, merr.ErrNodeNotAvailable
+	}
+
+	rand.Shuffle(len(availableNodes), func(i, j int) {
+		availableNodes[i], availableNodes[j] = availableNodes[j], availableNodes[i]
+	})
+
+	now := time.Now()
+	targetNode, fallbackNode := int64(-1), int64(-1)
+	targetScore, fallbackScore := math.MaxFloat64, math.MaxFloat64
+	for _, node := range availableNodes {
+		health := b.getOrCreate(node)
+		score := health.score()
+		metrics.ProxyWorkLoadScore.WithLabelValues(strconv.FormatInt(node, 10)).Set(score)
+
+		if health.isExcluded(now) {
+			log.RatedWarn(5, "shard leader is temporarily excluded, skip it",
+				zap.Int64("nodeID", node))
+			if fallbackNode == -1 || score < fallbackScore {
+				fallbackNode, fallbackScore = node, score
+			}
+			continue
+		}
+
+		if targetNode == -1 || score < targetScore {
+			targetNode, targetScore = node, score
+		}
+	}
+
+	// all leaders are excluded, degraded is still better than unavailable
+	if targetNode == -1 {
+		targetNode = fallbackNode
+	}
+
+	health, _ := b.nodes.Get(targetNode)
+	health.executingNQ.Add(cost)
+	return targetNode, nil
+}
+
+// when task canceled, should reduce executing total nq cost
+func (b *HealthScoredBalancer) CancelWorkload(node int64, nq int64) {
+	health, ok := b.nodes.Get(node)
+	if ok {
+		health.executingNQ.Sub(nq)
+	}
+}
+
+func (b *HealthScoredBalancer) UpdateCostMetrics(node int64, cost *internalpb.CostAggregation) {}
+
+// ReportResult updates the latency and error rate EWMA of the node,
+// and excludes the node temporarily once its error rate reaches the threshold
+func (b *HealthScoredBalancer) ReportResult(node int64, latency time.Duration, err error) {
+	// request canceled by the caller says nothing about the node's health
+	if errors.Is(err, context.Canceled) {
+		return
+	}
+
+	alpha := Params.ProxyCfg.HealthScoredEWMAAlpha.GetAsFloat()
+	if alpha <= 0 || alpha > 1 {
+		alpha = 1
+	}
+	failure := 0.0
+	if err != nil {
+		failure = 1.0
+	}
+
+	health := b.getOrCreate(node)
+	health.mu.Lock()
+	defer health.mu.Unlock()
+
+	if !health.sampled {
+		health.latency = float64(latency.Milliseconds())
+		health.errorRate = failure
+		health.sampled = true
+	} else {
+		if err == nil {
+			health.latency = alpha*float64(latency.Milliseconds()) + (1-alpha)*health.latency
+		}
+		health.errorRate = alpha*failure + (1-alpha)*health.errorRate
+	}
+
+	now := time.Now()
+	if health.errorRate >= Params.ProxyCfg.HealthScoredErrorThreshold.GetAsFloat() && !now.Before(health.excludedUntil) {
+		excludeDuration := Params.ProxyCfg.HealthScoredExcludeDuration.GetAsDuration(time.Millisecond)
+		health.excludedUntil = now.Add(excludeDuration)
+		// reset the error rate, the node has to fail again to be excluded after the exclusion expires
+		health.errorRate = 0
+		log.Warn("shard leader error rate too high, exclude it temporarily",
+			zap.Int64("nodeID", node),
+			zap.Duration("excludeDuration", excludeDuration),
+			zap.Error(err))
+	}
+}
@@ -0,0 +1,139 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type HealthScoredBalancerSuite struct {
+	suite.Suite
+
+	balancer *HealthScoredBalancer
+}
+
+func (suite *HealthScoredBalancerSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (suite *HealthScoredBalancerSuite) SetupTest() {
+	suite.balancer = NewHealthScoredBalancer()
+	suite.balancer.Start(context.Background())
+}
+
+func (suite *HealthScoredBalancerSuite) TearDownTest() {
+	suite.balancer.Close()
+}
+
+func (suite *HealthScoredBalancerSuite) TestSelectNoNode() {
+	node, err := suite.balancer.SelectNode(context.TODO(), []int64{}, 1)
+	suite.ErrorIs(err, merr.ErrNodeNotAvailable)
+	suite.Equal(int64(-1), node)
+}
+
+func (suite *HealthScoredBalancerSuite) TestPreferLowLatency() {
+	suite.balancer.ReportResult(1, 100*time.Millisecond, nil)
+	suite.balancer.ReportResult(2, 10*time.Millisecond, nil)
+
+	for i := 0; i < 10; i++ {
+		node, err := suite.balancer.SelectNode(context.TODO(), []int64{1, 2}, 1)
+		suite.NoError(err)
+		suite.Equal(int64(2), node)
+		suite.balancer.CancelWorkload(node, 1)
+	}
+
+	health, ok := suite.balancer.nodes.Get(2)
+	suite.True(ok)
+	suite.Equal(int64(0), health.executingNQ.Load())
+}
+
+func (suite *HealthScoredBalancerSuite) TestExecutingWorkload() {
+	suite.balancer.ReportResult(1, 10*time.Millisecond, nil)
+	suite.balancer.ReportResult(2, 10*time.Millisecond, nil)
+
+	node1, err := suite.balancer.SelectNode(context.TODO(), []int64{1, 2}, 5)
+	suite.NoError(err)
+	node2, err := suite.balancer.SelectNode(context.TODO(), []int64{1, 2}, 5)
+	suite.NoError(err)
+	suite.NotEqual(node1, node2)
+}
+
+func (suite *HealthScoredBalancerSuite) TestErrorRatePenalty() {
+	paramtable.Get().Save(Params.ProxyCfg.HealthScoredErrorThreshold.Key, "2")
+	defer paramtable.Get().Reset(Params.ProxyCfg.HealthScoredErrorThreshold.Key)
+
+	suite.balancer.ReportResult(1, 10*time.Millisecond, nil)
+	suite.balancer.ReportResult(1, 10*time.Millisecond, errors.New("mock error"))
+	suite.balancer.ReportResult(1, 10*time.Millisecond, errors.New("mock error"))
+	suite.balancer.ReportResult(2, 15*time.Millisecond, nil)
+
+	node, err := suite.balancer.SelectNode(context.TODO(), []int64{1, 2}, 1)
+	suite.NoError(err)
+	suite.Equal(int64(2), node)
+}
+
+func (suite *HealthScoredBalancerSuite) TestExcludeFlappingNode() {
+	suite.balancer.ReportResult(1, time.Millisecond, errors.New("mock error"))
+	suite.balancer.ReportResult(2, 100*time.Millisecond, nil)
+
+	health, ok := suite.balancer.nodes.Get(1)
+	suite.True(ok)
+	suite.True(health.isExcluded(time.Now()))
+
+	for i := 0; i < 10; i++ {
+		node, err := suite.balancer.SelectNode(context.TODO(), []int64{1, 2}, 1)
+		suite.NoError(err)
+		suite.Equal(int64(2), node)
+		suite.balancer.CancelWorkload(node, 1)
+	}
+
+	// all nodes excluded, still pick one
+	suite.balancer.ReportResult(2, time.Millisecond, errors.New("mock error"))
+	suite.balancer.ReportResult(2, time.Millisecond, errors.New("mock error"))
+	health, ok = suite.balancer.nodes.Get(2)
+	suite.True(ok)
+	suite.True(health.isExcluded(time.Now()))
+	node, err := suite.balancer.SelectNode(context.TODO(), []int64{1, 2}, 1)
+	suite.NoError(err)
+	suite.Contains([]int64{1, 2}, node)
+
+	// exclusion expires
+	paramtable.Get().Save(Params.ProxyCfg.HealthScoredExcludeDuration.Key, "0")
+	defer paramtable.Get().Reset(Params.ProxyCfg.HealthScoredExcludeDuration.Key)
+	suite.balancer.ReportResult(3, time.Millisecond, errors.New("mock error"))
+	health, ok = suite.balancer.nodes.Get(3)
+	suite.True(ok)
+	suite.False(health.isExcluded(time.Now()))
+}
+
+func (suite *HealthScoredBalancerSuite) TestIgnoreCanceled() {
+	suite.balancer.ReportResult(1, time.Millisecond, context.Canceled)
+	_, ok := suite.balancer.nodes.Get(1)
+	suite.False(ok)
+}
+
+func TestHealthScoredBalancerSuite(t *testing.T) {
+	suite.Run(t, new(HealthScoredBalancerSuite))
+}
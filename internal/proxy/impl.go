@@ -36,9 +36,11 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/util/importutil"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
@@ -116,11 +118,25 @@ func (node *Proxy) InvalidateCollectionMetaCache(ctx context.Context, request *p
 
 	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-InvalidateCollectionMetaCache")
 	defer sp.End()
+
+	node.invalidateCollectionMetaCache(ctx, request)
+
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+// invalidateCollectionMetaCache applies the invalidation received by rpc or watched from meta store,
+// it's idempotent since an invalidation may be received from both.
+func (node *Proxy) invalidateCollectionMetaCache(ctx context.Context, request *proxypb.InvalidateCollMetaCacheRequest) {
 	log := log.Ctx(ctx).With(
 		zap.String("role", typeutil.ProxyRole),
 		zap.String("db", request.DbName),
 		zap.String("collectionName", request.CollectionName),
-		zap.Int64("collectionID", request.CollectionID))
+		zap.Int64("collectionID", request.CollectionID),
+		zap.Uint64("revision", request.GetRevision()),
+		zap.String("changeType", request.GetChangeType().String()))
 
 	log.Info("received request to invalidate collection meta cache")
 
@@ -146,11 +162,6 @@ func (node *Proxy) InvalidateCollectionMetaCache(ctx context.Context, request *p
 		}
 	}
 	log.Info("complete to invalidate collection meta cache")
-
-	return &commonpb.Status{
-		ErrorCode: commonpb.ErrorCode_Success,
-		Reason:    "",
-	}, nil
 }
 
 func (node *Proxy) CreateDatabase(ctx context.Context, request *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
@@ -2310,6 +2321,7 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 		segIDAssigner: node.segAssigner,
 		chMgr:         node.chMgr,
 		chTicker:      node.chTicker,
+		sidePayload:   node.sidePayload,
 	}
 
 	constructFailedResponse := func(err error) *milvuspb.MutationResult {
@@ -2369,6 +2381,7 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
 		metrics.SuccessLabel).Inc()
 	successCnt := it.result.InsertCnt - int64(len(it.result.ErrIndex))
+	node.collectionStats.recordWrite(it.insertMsg.GetCollectionID(), successCnt, 0)
 	metrics.ProxyInsertVectors.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Add(float64(successCnt))
 	metrics.ProxyMutationLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.InsertLabel).Observe(float64(tr.ElapseSpan().Milliseconds()))
 	metrics.ProxyCollectionMutationLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.InsertLabel, request.CollectionName).Observe(float64(tr.ElapseSpan().Milliseconds()))
@@ -2460,6 +2473,7 @@ func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest)
 
 	receiveSize := proto.Size(dt.deleteMsg)
 	rateCol.Add(internalpb.RateType_DMLDelete.String(), float64(receiveSize))
+	node.collectionStats.recordWrite(dt.collectionID, 0, dt.result.GetDeleteCnt())
 
 	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
 		metrics.SuccessLabel).Inc()
@@ -2520,6 +2534,7 @@ func (node *Proxy) Upsert(ctx context.Context, request *milvuspb.UpsertRequest)
 		segIDAssigner: node.segAssigner,
 		chMgr:         node.chMgr,
 		chTicker:      node.chTicker,
+		sidePayload:   node.sidePayload,
 	}
 
 	constructFailedResponse := func(err error, errCode commonpb.ErrorCode) *milvuspb.MutationResult {
@@ -2588,6 +2603,7 @@ func (node *Proxy) Upsert(ctx context.Context, request *milvuspb.UpsertRequest)
 	deleteReceiveSize := proto.Size(it.upsertMsg.DeleteMsg)
 
 	rateCol.Add(internalpb.RateType_DMLUpsert.String(), float64(insertReceiveSize+deleteReceiveSize))
+	node.collectionStats.recordWrite(it.collectionID, it.result.GetInsertCnt(), it.result.GetDeleteCnt())
 
 	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
 		metrics.SuccessLabel).Inc()
@@ -2616,6 +2632,9 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 			Status: unhealthyStatus(),
 		}, nil
 	}
+	node.mirror.MirrorSearch(ctx, request)
+	node.evaluator.RecordSearch(ctx, request)
+
 	method := "Search"
 	tr := timerecord.NewTimeRecorder(method)
 	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
@@ -2660,6 +2679,7 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 		span := tr.ElapseSpan()
 		if span >= SlowReadSpan {
 			log.Info(rpcSlow(method), zap.Duration("duration", span))
+			node.slowQueries.record(method, request.GetDbName(), request.GetCollectionName(), span)
 		}
 	}()
 
@@ -2687,7 +2707,11 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 		rpcEnqueued(method),
 		zap.Uint64("timestamp", qt.Base.Timestamp))
 
-	if err := qt.WaitToFinish(); err != nil {
+	err := qt.WaitToFinish()
+	if err == nil {
+		err = node.sidePayload.assemble(ctx, qt.GetCollectionID(), qt.schema, qt.result.GetResults().GetFieldsData())
+	}
+	if err != nil {
 		log.Warn(
 			rpcFailedToWaitToFinish(method),
 			zap.Error(err))
@@ -2713,15 +2737,21 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 		metrics.SuccessLabel).Inc()
 	metrics.ProxySearchVectors.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Add(float64(qt.result.GetResults().GetNumQueries()))
 	searchDur := tr.ElapseSpan().Milliseconds()
-	metrics.ProxySQLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10),
-		metrics.SearchLabel).Observe(float64(searchDur))
+	metrics.ObserveWithTrace(ctx, metrics.ProxySQLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10),
+		metrics.SearchLabel), float64(searchDur))
 	metrics.ProxyCollectionSQLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10),
 		metrics.SearchLabel, request.CollectionName).Observe(float64(searchDur))
+	node.collectionStats.recordSearch(qt.SearchRequest.GetCollectionID(), tr.ElapseSpan())
 	if qt.result != nil {
 		sentSize := proto.Size(qt.result)
 		metrics.ProxyReadReqSendBytes.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Add(float64(sentSize))
 		rateCol.Add(metricsinfo.ReadResultThroughput, float64(sentSize))
 	}
+	// the exact search reads the same snapshot as the origin one
+	if travelTs == 0 {
+		travelTs = qt.BeginTs()
+	}
+	node.recallSampler.Sample(ctx, request, qt.result, travelTs, qt.SearchRequest.GetGuaranteeTimestamp())
 	return qt.result, nil
 }
 
@@ -2817,6 +2847,7 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 			Status: unhealthyStatus(),
 		}, nil
 	}
+	node.mirror.MirrorQuery(ctx, request)
 
 	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-Query")
 	defer sp.End()
@@ -2858,6 +2889,7 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 				zap.Uint64("travel_timestamp", request.TravelTimestamp),
 				zap.Uint64("guarantee_timestamp", request.GuaranteeTimestamp),
 				zap.Duration("duration", span))
+			node.slowQueries.record(method, request.GetDbName(), request.GetCollectionName(), span)
 		}
 	}()
 
@@ -2887,7 +2919,11 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 
 	log.Debug(rpcEnqueued(method))
 
-	if err := qt.WaitToFinish(); err != nil {
+	err := qt.WaitToFinish()
+	if err == nil {
+		err = node.sidePayload.assemble(ctx, qt.CollectionID, qt.schema, qt.result.GetFieldsData())
+	}
+	if err != nil {
 		log.Warn(
 			rpcFailedToWaitToFinish(method),
 			zap.Error(err))
@@ -2915,6 +2951,7 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 		metrics.QueryLabel).Observe(float64(tr.ElapseSpan().Milliseconds()))
 	metrics.ProxyCollectionSQLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10),
 		metrics.QueryLabel, request.CollectionName).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	node.collectionStats.recordQuery(qt.GetCollectionID(), tr.ElapseSpan())
 	sentSize := proto.Size(qt.result)
 	rateCol.Add(metricsinfo.ReadResultThroughput, float64(sentSize))
 	metrics.ProxyReadReqSendBytes.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Add(float64(sentSize))
@@ -3276,53 +3313,54 @@ func (node *Proxy) GetPersistentSegmentInfo(ctx context.Context, req *milvuspb.G
 		return resp, nil
 	}
 
-	getSegmentsByStatesResponse, err := node.dataCoord.GetSegmentsByStates(ctx, &datapb.GetSegmentsByStatesRequest{
-		CollectionID: collectionID,
-		// -1 means list all partition segemnts
-		PartitionID: -1,
-		States:      []commonpb.SegmentState{commonpb.SegmentState_Flushing, commonpb.SegmentState_Flushed, commonpb.SegmentState_Sealed},
-	})
-	if err != nil {
-		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel).Inc()
-		resp.Status.Reason = fmt.Errorf("getSegmentsOfCollection, err:%w", err).Error()
-		return resp, nil
-	}
-
-	// get Segment info
-	infoResp, err := node.dataCoord.GetSegmentInfo(ctx, &datapb.GetSegmentInfoRequest{
-		Base: commonpbutil.NewMsgBase(
-			commonpbutil.WithMsgType(commonpb.MsgType_SegmentInfo),
-			commonpbutil.WithMsgID(0),
-			commonpbutil.WithSourceID(paramtable.GetNodeID()),
-		),
-		SegmentIDs: getSegmentsByStatesResponse.Segments,
-	})
-	if err != nil {
-		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
-			metrics.FailLabel).Inc()
-		log.Warn("GetPersistentSegmentInfo fail",
-			zap.Error(err))
-		resp.Status.Reason = fmt.Errorf("dataCoord:GetSegmentInfo, err:%w", err).Error()
-		return resp, nil
-	}
-	log.Debug("GetPersistentSegmentInfo",
-		zap.Int("len(infos)", len(infoResp.Infos)),
-		zap.Any("status", infoResp.Status))
-	if infoResp.Status.ErrorCode != commonpb.ErrorCode_Success {
-		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
-			metrics.FailLabel).Inc()
-		resp.Status.Reason = infoResp.Status.Reason
-		return resp, nil
-	}
-	persistentInfos := make([]*milvuspb.PersistentSegmentInfo, len(infoResp.Infos))
-	for i, info := range infoResp.Infos {
-		persistentInfos[i] = &milvuspb.PersistentSegmentInfo{
-			SegmentID:    info.ID,
-			CollectionID: info.CollectionID,
-			PartitionID:  info.PartitionID,
-			NumRows:      info.NumOfRows,
-			State:        info.State,
+	// list the segments page by page, with the states filtered and only the required fields returned by datacoord,
+	// the binlogs make the responses too large for the collections with a large number of segments
+	var persistentInfos []*milvuspb.PersistentSegmentInfo
+	var afterSegmentID int64
+	for {
+		infoResp, err := node.dataCoord.GetSegmentInfo(ctx, &datapb.GetSegmentInfoRequest{
+			Base: commonpbutil.NewMsgBase(
+				commonpbutil.WithMsgType(commonpb.MsgType_SegmentInfo),
+				commonpbutil.WithMsgID(0),
+				commonpbutil.WithSourceID(paramtable.GetNodeID()),
+			),
+			CollectionID:   collectionID,
+			States:         []commonpb.SegmentState{commonpb.SegmentState_Flushing, commonpb.SegmentState_Flushed, commonpb.SegmentState_Sealed},
+			Limit:          Params.ProxyCfg.SegmentInfoPageSize.GetAsInt64(),
+			AfterSegmentID: afterSegmentID,
+			OutputFields:   []string{"ID", "collectionID", "partitionID", "num_of_rows", "state"},
+		})
+		if err != nil {
+			metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+				metrics.FailLabel).Inc()
+			log.Warn("GetPersistentSegmentInfo fail",
+				zap.Error(err))
+			resp.Status.Reason = fmt.Errorf("dataCoord:GetSegmentInfo, err:%w", err).Error()
+			return resp, nil
+		}
+		log.Debug("GetPersistentSegmentInfo",
+			zap.Int("len(infos)", len(infoResp.Infos)),
+			zap.Bool("hasMore", infoResp.GetHasMore()),
+			zap.Any("status", infoResp.Status))
+		if infoResp.Status.ErrorCode != commonpb.ErrorCode_Success {
+			metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+				metrics.FailLabel).Inc()
+			resp.Status.Reason = infoResp.Status.Reason
+			return resp, nil
+		}
+		for _, info := range infoResp.Infos {
+			persistentInfos = append(persistentInfos, &milvuspb.PersistentSegmentInfo{
+				SegmentID:    info.ID,
+				CollectionID: info.CollectionID,
+				PartitionID:  info.PartitionID,
+				NumRows:      info.NumOfRows,
+				State:        info.State,
+			})
+		}
+		if !infoResp.GetHasMore() || len(infoResp.Infos) == 0 {
+			break
 		}
+		afterSegmentID = infoResp.Infos[len(infoResp.Infos)-1].GetID()
 	}
 	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
 		metrics.SuccessLabel).Inc()
@@ -4748,6 +4786,184 @@ func (node *Proxy) RenameCollection(ctx context.Context, req *milvuspb.RenameCol
 	return resp, nil
 }
 
+func (node *Proxy) CloneCollection(ctx context.Context, req *rootcoordpb.CloneCollectionRequest) (*commonpb.Status, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-CloneCollection")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", req.GetDbName()),
+		zap.String("collection", req.GetCollectionName()),
+		zap.String("newCollection", req.GetNewCollectionName()))
+
+	log.Info("received clone collection request")
+
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+
+	if err := validateCollectionName(req.GetNewCollectionName()); err != nil {
+		log.Warn("validate new collection name fail", zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalCollectionName,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	req.Base = commonpbutil.NewMsgBase(
+		commonpbutil.WithMsgType(commonpb.MsgType_CreateCollection),
+		commonpbutil.WithMsgID(0),
+		commonpbutil.WithSourceID(paramtable.GetNodeID()),
+	)
+	resp, err := node.rootCoord.CloneCollection(ctx, req)
+	if err != nil {
+		log.Warn("failed to clone collection", zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, err
+	}
+
+	return resp, nil
+}
+
+// GetCloneCollectionState returns the progress of cloning the segments into the new collection.
+func (node *Proxy) GetCloneCollectionState(ctx context.Context, req *datapb.GetCloneSegmentsStateRequest) (*datapb.GetCloneSegmentsStateResponse, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-GetCloneCollectionState")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", req.GetDbName()),
+		zap.String("collection", req.GetCollectionName()))
+
+	if !node.checkHealthy() {
+		return &datapb.GetCloneSegmentsStateResponse{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+
+	if req.GetCollectionID() == 0 {
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, req.GetDbName(), req.GetCollectionName())
+		if err != nil {
+			log.Warn("failed to get collection id", zap.Error(err))
+			return &datapb.GetCloneSegmentsStateResponse{
+				Status: merr.Status(err),
+			}, nil
+		}
+		req.CollectionID = collectionID
+	}
+	req.Base = commonpbutil.NewMsgBase(
+		commonpbutil.WithMsgID(0),
+		commonpbutil.WithSourceID(paramtable.GetNodeID()),
+	)
+	resp, err := node.dataCoord.GetCloneSegmentsState(ctx, req)
+	if err != nil {
+		log.Warn("failed to get clone collection state", zap.Error(err))
+		return &datapb.GetCloneSegmentsStateResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	return resp, nil
+}
+
+// EvaluateIndex starts to evaluate the candidate index of the vector field against the current one in background,
+// the captured search requests are replayed on the sampled segments indexed by both of them.
+func (node *Proxy) EvaluateIndex(ctx context.Context, req *proxypb.EvaluateIndexRequest) (*proxypb.EvaluateIndexResponse, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-EvaluateIndex")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", req.GetDbName()),
+		zap.String("collection", req.GetCollectionName()),
+		zap.String("field", req.GetFieldName()))
+
+	log.Info("received evaluate index request", zap.Any("indexParams", req.GetIndexParams()))
+
+	if !node.checkHealthy() {
+		return &proxypb.EvaluateIndexResponse{Status: unhealthyStatus()}, nil
+	}
+
+	if req.GetDbName() == "" {
+		req.DbName = GetCurDBNameFromContextOrDefault(ctx)
+	}
+	if _, err := globalMetaCache.GetCollectionID(ctx, req.GetDbName(), req.GetCollectionName()); err != nil {
+		log.Warn("failed to get collection", zap.Error(err))
+		return &proxypb.EvaluateIndexResponse{Status: merr.Status(err)}, nil
+	}
+	if req.GetResourceGroup() != "" {
+		if err := ValidateResourceGroupName(req.GetResourceGroup()); err != nil {
+			return &proxypb.EvaluateIndexResponse{Status: merr.Status(err)}, nil
+		}
+	}
+
+	evaluationID, err := node.evaluator.Submit(req)
+	if err != nil {
+		log.Warn("failed to evaluate index", zap.Error(err))
+		return &proxypb.EvaluateIndexResponse{Status: merr.Status(err)}, nil
+	}
+	log.Info("index evaluation submitted", zap.Int64("evaluationID", evaluationID))
+	return &proxypb.EvaluateIndexResponse{
+		Status:       merr.Status(nil),
+		EvaluationID: evaluationID,
+	}, nil
+}
+
+// GetIndexEvaluation returns the progress and the result of the index evaluation.
+func (node *Proxy) GetIndexEvaluation(ctx context.Context, req *proxypb.GetIndexEvaluationRequest) (*proxypb.GetIndexEvaluationResponse, error) {
+	if !node.checkHealthy() {
+		return &proxypb.GetIndexEvaluationResponse{Status: unhealthyStatus()}, nil
+	}
+
+	resp, ok := node.evaluator.Get(req.GetEvaluationID())
+	if !ok {
+		err := merr.WrapErrParameterInvalid("existing evaluation ID", fmt.Sprint(req.GetEvaluationID()), "index evaluation not found")
+		return &proxypb.GetIndexEvaluationResponse{Status: merr.Status(err)}, nil
+	}
+	resp.Status = merr.Status(nil)
+	return resp, nil
+}
+
+// AlterIndex alters the build-time params of the index, the segment indexes are rebuilt in background
+// alongside the serving ones, and QueryNodes load each of them once rebuilt.
+func (node *Proxy) AlterIndex(ctx context.Context, req *proxypb.AlterIndexRequest) (*commonpb.Status, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-AlterIndex")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", req.GetDbName()),
+		zap.String("collection", req.GetCollectionName()),
+		zap.String("index", req.GetIndexName()))
+
+	log.Info("received alter index request", zap.Any("params", req.GetParams()))
+
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+
+	if req.GetDbName() == "" {
+		req.DbName = GetCurDBNameFromContextOrDefault(ctx)
+	}
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, req.GetDbName(), req.GetCollectionName())
+	if err != nil {
+		log.Warn("failed to get collection", zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	resp, err := node.dataCoord.AlterIndex(ctx, &indexpb.AlterIndexRequest{
+		CollectionID: collectionID,
+		IndexName:    req.GetIndexName(),
+		Params:       req.GetParams(),
+	})
+	if err != nil {
+		log.Warn("failed to alter index", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	return resp, nil
+}
+
 func (node *Proxy) CreateResourceGroup(ctx context.Context, request *milvuspb.CreateResourceGroupRequest) (*commonpb.Status, error) {
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
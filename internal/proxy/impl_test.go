@@ -28,12 +28,14 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/mocks"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
@@ -209,6 +211,165 @@ func TestProxyRenameCollection(t *testing.T) {
 	})
 }
 
+func TestProxyCloneCollection(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		node := &Proxy{session: &sessionutil.Session{ServerID: 1}}
+		node.stateCode.Store(commonpb.StateCode_Abnormal)
+		ctx := context.Background()
+		resp, err := node.CloneCollection(ctx, &rootcoordpb.CloneCollectionRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetErrorCode())
+	})
+
+	t.Run("clone with illegal new collection name", func(t *testing.T) {
+		node := &Proxy{session: &sessionutil.Session{ServerID: 1}}
+		node.stateCode.Store(commonpb.StateCode_Healthy)
+		ctx := context.Background()
+		resp, err := node.CloneCollection(ctx, &rootcoordpb.CloneCollectionRequest{CollectionName: "src", NewCollectionName: "$#^%#&#$*!)#@!"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalCollectionName, resp.GetErrorCode())
+	})
+
+	t.Run("clone fail", func(t *testing.T) {
+		rc := mocks.NewRootCoord(t)
+		rc.On("CloneCollection", mock.Anything, mock.Anything).
+			Return(nil, errors.New("fail"))
+		node := &Proxy{
+			session:   &sessionutil.Session{ServerID: 1},
+			rootCoord: rc,
+		}
+		node.stateCode.Store(commonpb.StateCode_Healthy)
+		ctx := context.Background()
+
+		resp, err := node.CloneCollection(ctx, &rootcoordpb.CloneCollectionRequest{CollectionName: "src", NewCollectionName: "dst"})
+		assert.Error(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetErrorCode())
+	})
+
+	t.Run("clone ok", func(t *testing.T) {
+		rc := mocks.NewRootCoord(t)
+		rc.On("CloneCollection", mock.Anything, mock.Anything).
+			Return(&commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			}, nil)
+		node := &Proxy{
+			session:   &sessionutil.Session{ServerID: 1},
+			rootCoord: rc,
+		}
+		node.stateCode.Store(commonpb.StateCode_Healthy)
+		ctx := context.Background()
+
+		resp, err := node.CloneCollection(ctx, &rootcoordpb.CloneCollectionRequest{CollectionName: "src", NewCollectionName: "dst"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+}
+
+func TestProxyGetCloneCollectionState(t *testing.T) {
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+
+	t.Run("not healthy", func(t *testing.T) {
+		node := &Proxy{session: &sessionutil.Session{ServerID: 1}}
+		node.stateCode.Store(commonpb.StateCode_Abnormal)
+		resp, err := node.GetCloneCollectionState(context.Background(), &datapb.GetCloneSegmentsStateRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("collection not found", func(t *testing.T) {
+		mockCache := NewMockCache(t)
+		mockCache.On("GetCollectionID", mock.Anything, mock.Anything, "dst").
+			Return(UniqueID(0), merr.WrapErrCollectionNotFound("dst")).Once()
+		globalMetaCache = mockCache
+		node := &Proxy{session: &sessionutil.Session{ServerID: 1}}
+		node.stateCode.Store(commonpb.StateCode_Healthy)
+
+		resp, err := node.GetCloneCollectionState(context.Background(), &datapb.GetCloneSegmentsStateRequest{CollectionName: "dst"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("get state", func(t *testing.T) {
+		mockCache := NewMockCache(t)
+		mockCache.On("GetCollectionID", mock.Anything, mock.Anything, "dst").
+			Return(UniqueID(100), nil)
+		globalMetaCache = mockCache
+		dc := mocks.NewMockDataCoord(t)
+		dc.EXPECT().GetCloneSegmentsState(mock.Anything, mock.Anything).Call.Return(
+			func(ctx context.Context, req *datapb.GetCloneSegmentsStateRequest) *datapb.GetCloneSegmentsStateResponse {
+				assert.EqualValues(t, 100, req.GetCollectionID())
+				return &datapb.GetCloneSegmentsStateResponse{
+					Status: merr.Status(nil),
+					State:  datapb.CloneState_Cloning,
+				}
+			}, nil)
+		node := &Proxy{session: &sessionutil.Session{ServerID: 1}, dataCoord: dc}
+		node.stateCode.Store(commonpb.StateCode_Healthy)
+
+		resp, err := node.GetCloneCollectionState(context.Background(), &datapb.GetCloneSegmentsStateRequest{CollectionName: "dst"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, datapb.CloneState_Cloning, resp.GetState())
+	})
+}
+
+func TestProxyAlterIndex(t *testing.T) {
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+
+	t.Run("not healthy", func(t *testing.T) {
+		node := &Proxy{session: &sessionutil.Session{ServerID: 1}}
+		node.stateCode.Store(commonpb.StateCode_Abnormal)
+		resp, err := node.AlterIndex(context.Background(), &proxypb.AlterIndexRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetErrorCode())
+	})
+
+	t.Run("collection not found", func(t *testing.T) {
+		mockCache := NewMockCache(t)
+		mockCache.On("GetCollectionID", mock.Anything, mock.Anything, "coll").
+			Return(UniqueID(0), merr.WrapErrCollectionNotFound("coll")).Once()
+		globalMetaCache = mockCache
+		node := &Proxy{session: &sessionutil.Session{ServerID: 1}}
+		node.stateCode.Store(commonpb.StateCode_Healthy)
+
+		resp, err := node.AlterIndex(context.Background(), &proxypb.AlterIndexRequest{CollectionName: "coll"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("alter index", func(t *testing.T) {
+		mockCache := NewMockCache(t)
+		mockCache.On("GetCollectionID", mock.Anything, mock.Anything, "coll").
+			Return(UniqueID(100), nil)
+		globalMetaCache = mockCache
+		dc := mocks.NewMockDataCoord(t)
+		node := &Proxy{session: &sessionutil.Session{ServerID: 1}, dataCoord: dc}
+		node.stateCode.Store(commonpb.StateCode_Healthy)
+		req := &proxypb.AlterIndexRequest{
+			CollectionName: "coll",
+			IndexName:      "idx",
+			Params:         []*commonpb.KeyValuePair{{Key: "efConstruction", Value: "200"}},
+		}
+
+		dc.EXPECT().AlterIndex(mock.Anything, mock.Anything).Return(nil, errors.New("mock")).Once()
+		resp, err := node.AlterIndex(context.Background(), req)
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+
+		dc.EXPECT().AlterIndex(mock.Anything, mock.Anything).
+			Run(func(ctx context.Context, r *indexpb.AlterIndexRequest) {
+				assert.EqualValues(t, 100, r.GetCollectionID())
+				assert.Equal(t, "idx", r.GetIndexName())
+				assert.Equal(t, req.GetParams(), r.GetParams())
+			}).Return(merr.Status(nil), nil).Once()
+		resp, err = node.AlterIndex(context.Background(), req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+}
+
 func TestProxy_ResourceGroup(t *testing.T) {
 	factory := dependency.NewDefaultFactory(true)
 	ctx := context.Background()
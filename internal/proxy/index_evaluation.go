@@ -0,0 +1,672 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
+	"github.com/samber/lo"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	evaluationBaseline    = "baseline"
+	evaluationCandidate   = "candidate"
+	evaluationGroundTruth = "groundtruth"
+
+	evaluationCheckInterval  = time.Second
+	evaluationCleanupTimeout = time.Minute
+)
+
+// searchLog captures the sampled search requests of each collection, which are replayed to evaluate the candidate indexes.
+// Only the latest `proxy.indexEvaluation.searchLogCapacity` requests of a collection are kept.
+type searchLog struct {
+	mu       sync.Mutex
+	requests map[string][]*milvuspb.SearchRequest
+	next     map[string]int
+}
+
+func newSearchLog() *searchLog {
+	return &searchLog{
+		requests: make(map[string][]*milvuspb.SearchRequest),
+		next:     make(map[string]int),
+	}
+}
+
+func searchLogKey(dbName, collectionName string) string {
+	return dbName + "." + collectionName
+}
+
+// Record captures the search request if it is sampled, the mirrored and replayed requests are never captured.
+func (l *searchLog) Record(ctx context.Context, request *milvuspb.SearchRequest) {
+	if l == nil || isMirrored(ctx) {
+		return
+	}
+	cfg := &Params.ProxyCfg.IndexEvaluation
+	capacity := cfg.SearchLogCapacity.GetAsInt()
+	if capacity <= 0 || rand.Float64() >= cfg.SearchLogSampleRatio.GetAsFloat() {
+		return
+	}
+
+	captured := proto.Clone(request).(*milvuspb.SearchRequest)
+	if captured.GetDbName() == "" {
+		captured.DbName = GetCurDBNameFromContextOrDefault(ctx)
+	}
+	key := searchLogKey(captured.GetDbName(), captured.GetCollectionName())
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	requests := l.requests[key]
+	if len(requests) < capacity {
+		l.requests[key] = append(requests, captured)
+		return
+	}
+	// the capacity may be shrunk since the log was filled
+	next := l.next[key] % capacity
+	requests[next] = captured
+	l.requests[key] = requests[:capacity]
+	l.next[key] = (next + 1) % capacity
+}
+
+// List returns the captured search requests of the collection.
+func (l *searchLog) List(dbName, collectionName string) []*milvuspb.SearchRequest {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	requests := l.requests[searchLogKey(dbName, collectionName)]
+	return append([]*milvuspb.SearchRequest{}, requests...)
+}
+
+// evaluationTarget is the proxy the index evaluation is run on.
+type evaluationTarget interface {
+	GetPersistentSegmentInfo(ctx context.Context, req *milvuspb.GetPersistentSegmentInfoRequest) (*milvuspb.GetPersistentSegmentInfoResponse, error)
+	DescribeCollection(ctx context.Context, request *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error)
+	DescribeIndex(ctx context.Context, request *milvuspb.DescribeIndexRequest) (*milvuspb.DescribeIndexResponse, error)
+	CloneCollection(ctx context.Context, req *rootcoordpb.CloneCollectionRequest) (*commonpb.Status, error)
+	GetCloneCollectionState(ctx context.Context, req *datapb.GetCloneSegmentsStateRequest) (*datapb.GetCloneSegmentsStateResponse, error)
+	CreateIndex(ctx context.Context, request *milvuspb.CreateIndexRequest) (*commonpb.Status, error)
+	LoadCollection(ctx context.Context, request *milvuspb.LoadCollectionRequest) (*commonpb.Status, error)
+	GetLoadingProgress(ctx context.Context, request *milvuspb.GetLoadingProgressRequest) (*milvuspb.GetLoadingProgressResponse, error)
+	Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error)
+	ReleaseCollection(ctx context.Context, request *milvuspb.ReleaseCollectionRequest) (*commonpb.Status, error)
+	DropCollection(ctx context.Context, request *milvuspb.DropCollectionRequest) (*commonpb.Status, error)
+}
+
+// indexEvaluator evaluates the candidate indexes in background. For each evaluation,
+// the sampled flushed segments are cloned into three collections, which are indexed with the current index,
+// the candidate index and the brute force index respectively, then the captured search requests are replayed
+// on them to compare the recall and latency of the current index and the candidate one.
+// The evaluation collections are dropped once the evaluation is done, the evaluations are kept in memory only.
+type indexEvaluator struct {
+	ctx    context.Context
+	target evaluationTarget
+	log    *searchLog
+
+	nextID      atomic.Int64
+	mu          sync.RWMutex
+	evaluations map[int64]*proxypb.GetIndexEvaluationResponse
+	wg          sync.WaitGroup
+}
+
+func newIndexEvaluator(ctx context.Context, target evaluationTarget) *indexEvaluator {
+	return &indexEvaluator{
+		ctx:         ctx,
+		target:      target,
+		log:         newSearchLog(),
+		evaluations: make(map[int64]*proxypb.GetIndexEvaluationResponse),
+	}
+}
+
+// Close waits for the running evaluations, which are canceled along with the context of the evaluator.
+func (e *indexEvaluator) Close() {
+	e.wg.Wait()
+}
+
+// RecordSearch captures the search request to be replayed by the evaluations.
+func (e *indexEvaluator) RecordSearch(ctx context.Context, request *milvuspb.SearchRequest) {
+	if e == nil {
+		return
+	}
+	e.log.Record(ctx, request)
+}
+
+// Submit starts the evaluation in background and returns its ID.
+func (e *indexEvaluator) Submit(req *proxypb.EvaluateIndexRequest) (int64, error) {
+	if req.GetFieldName() == "" {
+		return 0, merr.WrapErrParameterInvalid("vector field name", "empty", "field name is required")
+	}
+	queries := lo.Filter(e.log.List(req.GetDbName(), req.GetCollectionName()), func(query *milvuspb.SearchRequest, _ int) bool {
+		annsField, _ := funcutil.GetAttrByKeyFromRepeatedKV(AnnsFieldKey, query.GetSearchParams())
+		return annsField == "" || annsField == req.GetFieldName()
+	})
+	if len(queries) == 0 {
+		return 0, merr.WrapErrParameterInvalid("captured searches", "none",
+			fmt.Sprintf("no search on field %s of collection %s captured, please set proxy.indexEvaluation.searchLogSampleRatio",
+				req.GetFieldName(), req.GetCollectionName()))
+	}
+	if maxQueries := int(req.GetMaxQueries()); maxQueries > 0 && len(queries) > maxQueries {
+		queries = queries[len(queries)-maxQueries:]
+	}
+
+	id := e.nextID.Inc()
+	e.update(id, func(evaluation *proxypb.GetIndexEvaluationResponse) {
+		evaluation.State = proxypb.IndexEvaluationState_IndexEvaluationPending
+	})
+
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		ctx, cancel := context.WithTimeout(e.ctx, Params.ProxyCfg.IndexEvaluation.Timeout.GetAsDuration(time.Second))
+		defer cancel()
+		if err := e.evaluate(ctx, id, req, queries); err != nil {
+			log.Warn("failed to evaluate index",
+				zap.Int64("evaluationID", id),
+				zap.String("collection", req.GetCollectionName()),
+				zap.String("field", req.GetFieldName()),
+				zap.Error(err))
+			e.update(id, func(evaluation *proxypb.GetIndexEvaluationResponse) {
+				evaluation.State = proxypb.IndexEvaluationState_IndexEvaluationFailed
+				evaluation.FailReason = err.Error()
+			})
+		}
+	}()
+	return id, nil
+}
+
+// Get returns the progress and the result of the evaluation.
+func (e *indexEvaluator) Get(id int64) (*proxypb.GetIndexEvaluationResponse, bool) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	evaluation, ok := e.evaluations[id]
+	if !ok {
+		return nil, false
+	}
+	return proto.Clone(evaluation).(*proxypb.GetIndexEvaluationResponse), true
+}
+
+func (e *indexEvaluator) update(id int64, fn func(evaluation *proxypb.GetIndexEvaluationResponse)) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	evaluation, ok := e.evaluations[id]
+	if !ok {
+		evaluation = &proxypb.GetIndexEvaluationResponse{}
+		e.evaluations[id] = evaluation
+	}
+	fn(evaluation)
+}
+
+func (e *indexEvaluator) evaluate(ctx context.Context, id int64, req *proxypb.EvaluateIndexRequest, queries []*milvuspb.SearchRequest) error {
+	log := log.With(zap.Int64("evaluationID", id),
+		zap.String("collection", req.GetCollectionName()),
+		zap.String("field", req.GetFieldName()))
+
+	segmentIDs, rows, err := e.sampleSegments(ctx, req)
+	if err != nil {
+		return err
+	}
+	field, metricType, err := e.describeField(ctx, req)
+	if err != nil {
+		return err
+	}
+	log.Info("start to evaluate index", zap.Int64s("segmentIDs", segmentIDs), zap.Int("queryNum", len(queries)))
+	e.update(id, func(evaluation *proxypb.GetIndexEvaluationResponse) {
+		evaluation.State = proxypb.IndexEvaluationState_IndexEvaluationBuilding
+		evaluation.SampledSegmentIDs = segmentIDs
+		evaluation.SampledRows = rows
+	})
+
+	collections := map[string]string{}
+	defer func() {
+		// the evaluation may be timeout, clean up with a fresh context
+		ctx, cancel := context.WithTimeout(e.ctx, evaluationCleanupTimeout)
+		defer cancel()
+		for _, collection := range collections {
+			e.dropCollection(ctx, req.GetDbName(), collection)
+		}
+	}()
+
+	// the ground truth is searched by the brute force index
+	truthIndex := []*commonpb.KeyValuePair{
+		{Key: common.IndexTypeKey, Value: "FLAT"},
+		{Key: common.MetricTypeKey, Value: metricType},
+	}
+	if field.GetDataType() == schemapb.DataType_BinaryVector {
+		truthIndex[0].Value = "BIN_FLAT"
+	}
+	candidateIndex := req.GetIndexParams()
+	if _, err := funcutil.GetAttrByKeyFromRepeatedKV(common.MetricTypeKey, candidateIndex); err != nil {
+		candidateIndex = append(candidateIndex, &commonpb.KeyValuePair{Key: common.MetricTypeKey, Value: metricType})
+	}
+	indexes := map[string][]*commonpb.KeyValuePair{
+		evaluationCandidate:   candidateIndex,
+		evaluationGroundTruth: truthIndex,
+	}
+	for _, role := range []string{evaluationBaseline, evaluationCandidate, evaluationGroundTruth} {
+		collection := fmt.Sprintf("%s_eval_%d_%s", req.GetCollectionName(), id, role)
+		cloneReq := &rootcoordpb.CloneCollectionRequest{
+			DbName:            req.GetDbName(),
+			CollectionName:    req.GetCollectionName(),
+			NewCollectionName: collection,
+			SegmentIDs:        segmentIDs,
+		}
+		if role != evaluationBaseline {
+			cloneReq.SkipIndexFields = []string{req.GetFieldName()}
+		}
+		if err := checkEvaluationCall(e.target.CloneCollection(ctx, cloneReq)); err != nil {
+			return errors.Wrapf(err, "failed to clone collection %s", collection)
+		}
+		collections[role] = collection
+		if err := e.waitCloned(ctx, req.GetDbName(), collection); err != nil {
+			return err
+		}
+
+		if params, ok := indexes[role]; ok {
+			status, err := e.target.CreateIndex(ctx, &milvuspb.CreateIndexRequest{
+				DbName:         req.GetDbName(),
+				CollectionName: collection,
+				FieldName:      req.GetFieldName(),
+				ExtraParams:    params,
+			})
+			if err := checkEvaluationCall(status, err); err != nil {
+				return errors.Wrapf(err, "failed to create %s index", role)
+			}
+		}
+	}
+	for _, collection := range collections {
+		if err := e.waitIndexBuilt(ctx, req.GetDbName(), collection); err != nil {
+			return err
+		}
+	}
+
+	e.update(id, func(evaluation *proxypb.GetIndexEvaluationResponse) {
+		evaluation.State = proxypb.IndexEvaluationState_IndexEvaluationLoading
+	})
+	for _, collection := range collections {
+		if err := e.loadCollection(ctx, req.GetDbName(), collection, req.GetResourceGroup()); err != nil {
+			return err
+		}
+	}
+
+	e.update(id, func(evaluation *proxypb.GetIndexEvaluationResponse) {
+		evaluation.State = proxypb.IndexEvaluationState_IndexEvaluationReplaying
+	})
+	baseline, candidate := e.replay(ctx, collections, req.GetSearchParams(), queries)
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	log.Info("index evaluated",
+		zap.Any("baseline", baseline),
+		zap.Any("candidate", candidate))
+	e.update(id, func(evaluation *proxypb.GetIndexEvaluationResponse) {
+		evaluation.State = proxypb.IndexEvaluationState_IndexEvaluationCompleted
+		evaluation.ReplayedQueries = int64(len(queries))
+		evaluation.Baseline = baseline
+		evaluation.Candidate = candidate
+	})
+	return nil
+}
+
+// sampleSegments samples the flushed segments of the collection randomly,
+// returns the sampled segment IDs and the number of rows of them.
+func (e *indexEvaluator) sampleSegments(ctx context.Context, req *proxypb.EvaluateIndexRequest) ([]int64, int64, error) {
+	resp, err := e.target.GetPersistentSegmentInfo(ctx, &milvuspb.GetPersistentSegmentInfoRequest{
+		DbName:         req.GetDbName(),
+		CollectionName: req.GetCollectionName(),
+	})
+	if err := checkEvaluationCall(resp.GetStatus(), err); err != nil {
+		return nil, 0, err
+	}
+	segments := lo.Filter(resp.GetInfos(), func(segment *milvuspb.PersistentSegmentInfo, _ int) bool {
+		return segment.GetState() == commonpb.SegmentState_Flushed
+	})
+	if len(segments) == 0 {
+		return nil, 0, merr.WrapErrParameterInvalid("flushed segments", "none", "no flushed segment to evaluate")
+	}
+	rand.Shuffle(len(segments), func(i, j int) {
+		segments[i], segments[j] = segments[j], segments[i]
+	})
+	if num := int(req.GetSampleSegments()); num > 0 && len(segments) > num {
+		segments = segments[:num]
+	}
+
+	var rows int64
+	segmentIDs := make([]int64, 0, len(segments))
+	for _, segment := range segments {
+		segmentIDs = append(segmentIDs, segment.GetSegmentID())
+		rows += segment.GetNumRows()
+	}
+	return segmentIDs, rows, nil
+}
+
+// describeField returns the schema of the evaluated field and the metric type of its current index.
+func (e *indexEvaluator) describeField(ctx context.Context, req *proxypb.EvaluateIndexRequest) (*schemapb.FieldSchema, string, error) {
+	collResp, err := e.target.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
+		DbName:         req.GetDbName(),
+		CollectionName: req.GetCollectionName(),
+	})
+	if err := checkEvaluationCall(collResp.GetStatus(), err); err != nil {
+		return nil, "", err
+	}
+	field, ok := lo.Find(collResp.GetSchema().GetFields(), func(field *schemapb.FieldSchema) bool {
+		return field.GetName() == req.GetFieldName()
+	})
+	if !ok {
+		return nil, "", merr.WrapErrFieldNotFound(req.GetFieldName())
+	}
+	if !typeutil.IsVectorType(field.GetDataType()) {
+		return nil, "", merr.WrapErrParameterInvalid("vector field", field.GetDataType().String(), "only the index of vector field can be evaluated")
+	}
+
+	indexResp, err := e.target.DescribeIndex(ctx, &milvuspb.DescribeIndexRequest{
+		DbName:         req.GetDbName(),
+		CollectionName: req.GetCollectionName(),
+		FieldName:      req.GetFieldName(),
+	})
+	if err := checkEvaluationCall(indexResp.GetStatus(), err); err != nil {
+		return nil, "", err
+	}
+	index, ok := lo.Find(indexResp.GetIndexDescriptions(), func(index *milvuspb.IndexDescription) bool {
+		return index.GetFieldName() == req.GetFieldName()
+	})
+	if !ok {
+		return nil, "", merr.WrapErrIndexNotFound(req.GetFieldName())
+	}
+	metricType, err := funcutil.GetAttrByKeyFromRepeatedKV(common.MetricTypeKey, index.GetParams())
+	if err != nil {
+		return nil, "", err
+	}
+	return field, metricType, nil
+}
+
+// waitCloned waits until the sampled segments are cloned into the collection.
+func (e *indexEvaluator) waitCloned(ctx context.Context, dbName, collection string) error {
+	ticker := time.NewTicker(evaluationCheckInterval)
+	defer ticker.Stop()
+	for {
+		resp, err := e.target.GetCloneCollectionState(ctx, &datapb.GetCloneSegmentsStateRequest{
+			DbName:         dbName,
+			CollectionName: collection,
+		})
+		if err := checkEvaluationCall(resp.GetStatus(), err); err != nil {
+			return err
+		}
+		switch resp.GetState() {
+		case datapb.CloneState_CloneCompleted:
+			return nil
+		case datapb.CloneState_CloneFailed:
+			return fmt.Errorf("failed to clone collection %s: %s", collection, resp.GetReason())
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *indexEvaluator) waitIndexBuilt(ctx context.Context, dbName, collection string) error {
+	ticker := time.NewTicker(evaluationCheckInterval)
+	defer ticker.Stop()
+	for {
+		resp, err := e.target.DescribeIndex(ctx, &milvuspb.DescribeIndexRequest{
+			DbName:         dbName,
+			CollectionName: collection,
+		})
+		if err := checkEvaluationCall(resp.GetStatus(), err); err != nil {
+			return err
+		}
+		built := true
+		for _, index := range resp.GetIndexDescriptions() {
+			switch index.GetState() {
+			case commonpb.IndexState_Failed:
+				return fmt.Errorf("failed to build index on field %s of collection %s: %s",
+					index.GetFieldName(), collection, index.GetIndexStateFailReason())
+			case commonpb.IndexState_Finished:
+			default:
+				built = false
+			}
+		}
+		if built {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *indexEvaluator) loadCollection(ctx context.Context, dbName, collection, resourceGroup string) error {
+	loadReq := &milvuspb.LoadCollectionRequest{
+		DbName:         dbName,
+		CollectionName: collection,
+		ReplicaNumber:  1,
+	}
+	if resourceGroup != "" {
+		loadReq.ResourceGroups = []string{resourceGroup}
+	}
+	if err := checkEvaluationCall(e.target.LoadCollection(ctx, loadReq)); err != nil {
+		return errors.Wrapf(err, "failed to load collection %s", collection)
+	}
+
+	ticker := time.NewTicker(evaluationCheckInterval)
+	defer ticker.Stop()
+	for {
+		resp, err := e.target.GetLoadingProgress(ctx, &milvuspb.GetLoadingProgressRequest{
+			DbName:         dbName,
+			CollectionName: collection,
+		})
+		if err := checkEvaluationCall(resp.GetStatus(), err); err != nil {
+			return err
+		}
+		if resp.GetProgress() >= 100 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *indexEvaluator) dropCollection(ctx context.Context, dbName, collection string) {
+	status, err := e.target.ReleaseCollection(ctx, &milvuspb.ReleaseCollectionRequest{
+		DbName:         dbName,
+		CollectionName: collection,
+	})
+	if err := checkEvaluationCall(status, err); err != nil {
+		log.Warn("failed to release evaluation collection", zap.String("collection", collection), zap.Error(err))
+	}
+	status, err = e.target.DropCollection(ctx, &milvuspb.DropCollectionRequest{
+		DbName:         dbName,
+		CollectionName: collection,
+	})
+	if err := checkEvaluationCall(status, err); err != nil {
+		log.Warn("failed to drop evaluation collection", zap.String("collection", collection), zap.Error(err))
+	}
+}
+
+// replay replays the queries on the evaluation collections one by one, returns the metrics of the current index
+// and the candidate one, the recall is measured against the results of the brute force search.
+func (e *indexEvaluator) replay(ctx context.Context, collections map[string]string, searchParams []*commonpb.KeyValuePair, queries []*milvuspb.SearchRequest) (*proxypb.IndexEvaluationMetrics, *proxypb.IndexEvaluationMetrics) {
+	// the replayed requests are neither captured nor mirrored
+	ctx = context.WithValue(ctx, mirroredKey{}, struct{}{})
+
+	search := func(query *milvuspb.SearchRequest, role string) (*schemapb.SearchResultData, time.Duration, error) {
+		request := proto.Clone(query).(*milvuspb.SearchRequest)
+		request.CollectionName = collections[role]
+		// the evaluation collections are never written, no need to wait for the guarantee timestamp
+		request.UseDefaultConsistency = false
+		request.ConsistencyLevel = commonpb.ConsistencyLevel_Eventually
+		request.GuaranteeTimestamp = 0
+		if role == evaluationCandidate {
+			request.SearchParams = overrideKeyValuePairs(request.GetSearchParams(), searchParams)
+		}
+		start := time.Now()
+		resp, err := e.target.Search(ctx, request)
+		if err := checkEvaluationCall(resp.GetStatus(), err); err != nil {
+			return nil, 0, err
+		}
+		return resp.GetResults(), time.Since(start), nil
+	}
+
+	baseline := &indexEvaluationRecorder{}
+	candidate := &indexEvaluationRecorder{}
+	for _, query := range queries {
+		if ctx.Err() != nil {
+			break
+		}
+		truth, _, err := search(query, evaluationGroundTruth)
+		if err != nil {
+			log.Warn("failed to search the ground truth", zap.Error(err))
+			continue
+		}
+		for role, recorder := range map[string]*indexEvaluationRecorder{evaluationBaseline: baseline, evaluationCandidate: candidate} {
+			result, latency, err := search(query, role)
+			if err != nil {
+				log.Warn("failed to replay the search", zap.String("role", role), zap.Error(err))
+				recorder.failed++
+				continue
+			}
+			recorder.latencies = append(recorder.latencies, latency)
+			recorder.recalls = append(recorder.recalls, computeRecalls(truth, result)...)
+		}
+	}
+	return baseline.metrics(), candidate.metrics()
+}
+
+// checkEvaluationCall returns the error of the call, or the error carried by the returned status.
+func checkEvaluationCall(status *commonpb.Status, err error) error {
+	if err != nil {
+		return err
+	}
+	return merr.Error(status)
+}
+
+// overrideKeyValuePairs returns the pairs with the values of the same keys replaced by the overrides.
+func overrideKeyValuePairs(pairs []*commonpb.KeyValuePair, overrides []*commonpb.KeyValuePair) []*commonpb.KeyValuePair {
+	overridden := typeutil.NewSet[string]()
+	for _, override := range overrides {
+		overridden.Insert(override.GetKey())
+	}
+	ret := lo.Filter(pairs, func(pair *commonpb.KeyValuePair, _ int) bool {
+		return !overridden.Contain(pair.GetKey())
+	})
+	return append(ret, overrides...)
+}
+
+type indexEvaluationRecorder struct {
+	recalls   []float64
+	latencies []time.Duration
+	failed    int64
+}
+
+func (r *indexEvaluationRecorder) metrics() *proxypb.IndexEvaluationMetrics {
+	metrics := &proxypb.IndexEvaluationMetrics{FailedQueries: r.failed}
+	if len(r.recalls) > 0 {
+		var sum float64
+		for _, recall := range r.recalls {
+			sum += recall
+		}
+		metrics.Recall = sum / float64(len(r.recalls))
+	}
+	if len(r.latencies) > 0 {
+		var sum time.Duration
+		for _, latency := range r.latencies {
+			sum += latency
+		}
+		metrics.AvgLatencyMs = float64(sum.Microseconds()) / 1000 / float64(len(r.latencies))
+		metrics.P99LatencyMs = float64(percentile(r.latencies, 0.99).Microseconds()) / 1000
+	}
+	return metrics
+}
+
+// percentile returns the p-th percentile of the durations by the nearest-rank method.
+func percentile(durations []time.Duration, p float64) time.Duration {
+	if len(durations) == 0 {
+		return 0
+	}
+	sorted := append([]time.Duration{}, durations...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	return sorted[lo.Clamp(rank, 0, len(sorted)-1)]
+}
+
+// computeRecalls returns the recall of each query of the result against the ground truth,
+// the queries without ground truth results are skipped.
+func computeRecalls(truth, result *schemapb.SearchResultData) []float64 {
+	truthIDs := splitSearchResultIDs(truth)
+	resultIDs := splitSearchResultIDs(result)
+	recalls := make([]float64, 0, len(truthIDs))
+	for i, ids := range truthIDs {
+		if len(ids) == 0 {
+			continue
+		}
+		expected := typeutil.NewSet[string](ids...)
+		hit := 0
+		if i < len(resultIDs) {
+			for _, id := range resultIDs[i] {
+				if expected.Contain(id) {
+					hit++
+				}
+			}
+		}
+		recalls = append(recalls, float64(hit)/float64(len(ids)))
+	}
+	return recalls
+}
+
+// splitSearchResultIDs splits the primary keys of the search result by query,
+// the keys are formatted as strings to compare the int64 and varchar keys alike.
+func splitSearchResultIDs(result *schemapb.SearchResultData) [][]string {
+	ret := make([][]string, 0, len(result.GetTopks()))
+	var offset int64
+	for _, topk := range result.GetTopks() {
+		ids := make([]string, 0, topk)
+		for i := offset; i < offset+topk; i++ {
+			ids = append(ids, fmt.Sprint(typeutil.GetPK(result.GetIds(), i)))
+		}
+		ret = append(ret, ids)
+		offset += topk
+	}
+	return ret
+}
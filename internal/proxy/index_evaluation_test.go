@@ -0,0 +1,247 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestSearchLog(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+
+	l := newSearchLog()
+	ctx := context.Background()
+	request := &milvuspb.SearchRequest{DbName: "db", CollectionName: "coll"}
+
+	// not sampled by default
+	l.Record(ctx, request)
+	assert.Empty(t, l.List("db", "coll"))
+
+	params.Save(params.ProxyCfg.IndexEvaluation.SearchLogSampleRatio.Key, "1")
+	defer params.Reset(params.ProxyCfg.IndexEvaluation.SearchLogSampleRatio.Key)
+	params.Save(params.ProxyCfg.IndexEvaluation.SearchLogCapacity.Key, "2")
+	defer params.Reset(params.ProxyCfg.IndexEvaluation.SearchLogCapacity.Key)
+
+	// the replayed requests are not captured
+	l.Record(context.WithValue(ctx, mirroredKey{}, struct{}{}), request)
+	assert.Empty(t, l.List("db", "coll"))
+
+	for _, dsl := range []string{"a", "b", "c"} {
+		l.Record(ctx, &milvuspb.SearchRequest{DbName: "db", CollectionName: "coll", Dsl: dsl})
+	}
+	captured := l.List("db", "coll")
+	assert.Len(t, captured, 2)
+	assert.ElementsMatch(t, []string{"b", "c"}, []string{captured[0].GetDsl(), captured[1].GetDsl()})
+
+	// the database is filled
+	l.Record(ctx, &milvuspb.SearchRequest{CollectionName: "coll"})
+	assert.Len(t, l.List(GetCurDBNameFromContextOrDefault(ctx), "coll"), 1)
+}
+
+func TestComputeRecalls(t *testing.T) {
+	truth := &schemapb.SearchResultData{
+		Topks: []int64{2, 2, 0},
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2, 3, 4}}},
+		},
+	}
+	result := &schemapb.SearchResultData{
+		Topks: []int64{2, 1, 0},
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{2, 5, 4}}},
+		},
+	}
+	// the query without ground truth is skipped
+	assert.Equal(t, []float64{0.5, 0.5}, computeRecalls(truth, result))
+	assert.Equal(t, []float64{0, 0}, computeRecalls(truth, &schemapb.SearchResultData{}))
+}
+
+func TestPercentile(t *testing.T) {
+	assert.Equal(t, time.Duration(0), percentile(nil, 0.99))
+
+	durations := make([]time.Duration, 0, 100)
+	for i := 100; i > 0; i-- {
+		durations = append(durations, time.Duration(i)*time.Millisecond)
+	}
+	assert.Equal(t, 99*time.Millisecond, percentile(durations, 0.99))
+	assert.Equal(t, 50*time.Millisecond, percentile(durations, 0.5))
+	assert.Equal(t, time.Millisecond, percentile(durations, 0))
+}
+
+func TestOverrideKeyValuePairs(t *testing.T) {
+	pairs := []*commonpb.KeyValuePair{
+		{Key: TopKKey, Value: "10"},
+		{Key: SearchParamsKey, Value: `{"nprobe": 8}`},
+	}
+	overridden := overrideKeyValuePairs(pairs, []*commonpb.KeyValuePair{{Key: SearchParamsKey, Value: `{"ef": 64}`}})
+	assert.Equal(t, []*commonpb.KeyValuePair{
+		{Key: TopKKey, Value: "10"},
+		{Key: SearchParamsKey, Value: `{"ef": 64}`},
+	}, overridden)
+}
+
+func TestIndexEvaluator(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(params.ProxyCfg.IndexEvaluation.SearchLogSampleRatio.Key, "1")
+	defer params.Reset(params.ProxyCfg.IndexEvaluation.SearchLogSampleRatio.Key)
+
+	ctx := context.Background()
+	target := mocks.NewMockProxy(t)
+	e := newIndexEvaluator(ctx, target)
+	defer e.Close()
+
+	req := &proxypb.EvaluateIndexRequest{
+		DbName:         "db",
+		CollectionName: "coll",
+		FieldName:      "vec",
+		IndexParams:    []*commonpb.KeyValuePair{{Key: common.IndexTypeKey, Value: "HNSW"}},
+		SearchParams:   []*commonpb.KeyValuePair{{Key: SearchParamsKey, Value: `{"ef": 64}`}},
+	}
+
+	t.Run("no captured search", func(t *testing.T) {
+		_, err := e.Submit(req)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+		e.RecordSearch(ctx, &milvuspb.SearchRequest{
+			DbName:         "db",
+			CollectionName: "coll",
+			SearchParams:   []*commonpb.KeyValuePair{{Key: AnnsFieldKey, Value: "other_vec"}},
+		})
+		_, err = e.Submit(req)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("evaluate", func(t *testing.T) {
+		e.RecordSearch(ctx, &milvuspb.SearchRequest{
+			DbName:         "db",
+			CollectionName: "coll",
+			SearchParams:   []*commonpb.KeyValuePair{{Key: AnnsFieldKey, Value: "vec"}},
+		})
+
+		target.EXPECT().GetPersistentSegmentInfo(mock.Anything, mock.Anything).Return(&milvuspb.GetPersistentSegmentInfoResponse{
+			Status: merr.Status(nil),
+			Infos: []*milvuspb.PersistentSegmentInfo{
+				{SegmentID: 1, NumRows: 100, State: commonpb.SegmentState_Flushed},
+				{SegmentID: 2, NumRows: 100, State: commonpb.SegmentState_Flushing},
+			},
+		}, nil)
+		target.EXPECT().DescribeCollection(mock.Anything, mock.Anything).Return(&milvuspb.DescribeCollectionResponse{
+			Status: merr.Status(nil),
+			Schema: &schemapb.CollectionSchema{
+				Fields: []*schemapb.FieldSchema{{Name: "vec", DataType: schemapb.DataType_FloatVector}},
+			},
+		}, nil)
+		target.EXPECT().DescribeIndex(mock.Anything, mock.Anything).Return(&milvuspb.DescribeIndexResponse{
+			Status: merr.Status(nil),
+			IndexDescriptions: []*milvuspb.IndexDescription{{
+				FieldName: "vec",
+				Params:    []*commonpb.KeyValuePair{{Key: common.MetricTypeKey, Value: "L2"}},
+				State:     commonpb.IndexState_Finished,
+			}},
+		}, nil)
+		var cloned []*rootcoordpb.CloneCollectionRequest
+		target.EXPECT().CloneCollection(mock.Anything, mock.Anything).Call.Return(
+			func(ctx context.Context, req *rootcoordpb.CloneCollectionRequest) *commonpb.Status {
+				cloned = append(cloned, req)
+				return merr.Status(nil)
+			}, nil)
+		target.EXPECT().GetCloneCollectionState(mock.Anything, mock.Anything).Return(&datapb.GetCloneSegmentsStateResponse{
+			Status: merr.Status(nil),
+			State:  datapb.CloneState_CloneCompleted,
+		}, nil)
+		indexes := make(map[string][]*commonpb.KeyValuePair)
+		target.EXPECT().CreateIndex(mock.Anything, mock.Anything).Call.Return(
+			func(ctx context.Context, req *milvuspb.CreateIndexRequest) *commonpb.Status {
+				indexes[req.GetCollectionName()] = req.GetExtraParams()
+				return merr.Status(nil)
+			}, nil)
+		target.EXPECT().LoadCollection(mock.Anything, mock.Anything).Return(merr.Status(nil), nil)
+		target.EXPECT().GetLoadingProgress(mock.Anything, mock.Anything).Return(&milvuspb.GetLoadingProgressResponse{
+			Status:   merr.Status(nil),
+			Progress: 100,
+		}, nil)
+		target.EXPECT().Search(mock.Anything, mock.Anything).Call.Return(
+			func(ctx context.Context, req *milvuspb.SearchRequest) *milvuspb.SearchResults {
+				assert.NotNil(t, ctx.Value(mirroredKey{}))
+				ids := []int64{1, 2}
+				if strings.HasSuffix(req.GetCollectionName(), evaluationCandidate) {
+					assert.Contains(t, req.GetSearchParams(), &commonpb.KeyValuePair{Key: SearchParamsKey, Value: `{"ef": 64}`})
+					ids = []int64{1, 3}
+				}
+				return &milvuspb.SearchResults{
+					Status: merr.Status(nil),
+					Results: &schemapb.SearchResultData{
+						Topks: []int64{2},
+						Ids:   &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}}},
+					},
+				}
+			}, nil)
+		target.EXPECT().ReleaseCollection(mock.Anything, mock.Anything).Return(merr.Status(nil), nil)
+		target.EXPECT().DropCollection(mock.Anything, mock.Anything).Return(merr.Status(nil), nil).Times(3)
+
+		id, err := e.Submit(req)
+		assert.NoError(t, err)
+		assert.Eventually(t, func() bool {
+			evaluation, ok := e.Get(id)
+			return ok && evaluation.GetState() == proxypb.IndexEvaluationState_IndexEvaluationCompleted
+		}, 10*time.Second, 10*time.Millisecond)
+		e.Close()
+
+		evaluation, _ := e.Get(id)
+		assert.Equal(t, []int64{1}, evaluation.GetSampledSegmentIDs())
+		assert.EqualValues(t, 100, evaluation.GetSampledRows())
+		assert.EqualValues(t, 1, evaluation.GetReplayedQueries())
+		assert.Equal(t, 1.0, evaluation.GetBaseline().GetRecall())
+		assert.Equal(t, 0.5, evaluation.GetCandidate().GetRecall())
+
+		assert.Len(t, cloned, 3)
+		for _, req := range cloned {
+			assert.Equal(t, []int64{1}, req.GetSegmentIDs())
+			if strings.HasSuffix(req.GetNewCollectionName(), evaluationBaseline) {
+				assert.Empty(t, req.GetSkipIndexFields())
+			} else {
+				assert.Equal(t, []string{"vec"}, req.GetSkipIndexFields())
+			}
+		}
+		assert.Contains(t, indexes["coll_eval_1_candidate"], &commonpb.KeyValuePair{Key: common.MetricTypeKey, Value: "L2"})
+		assert.Contains(t, indexes["coll_eval_1_groundtruth"], &commonpb.KeyValuePair{Key: common.IndexTypeKey, Value: "FLAT"})
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, ok := e.Get(100)
+		assert.False(t, ok)
+	})
+}
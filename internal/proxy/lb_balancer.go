@@ -18,6 +18,7 @@ package proxy
 
 import (
 	"context"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 )
@@ -26,6 +27,7 @@ type LBBalancer interface {
 	SelectNode(ctx context.Context, availableNodes []int64, nq int64) (int64, error)
 	CancelWorkload(node int64, nq int64)
 	UpdateCostMetrics(node int64, cost *internalpb.CostAggregation)
+	ReportResult(node int64, latency time.Duration, err error)
 	Start(ctx context.Context)
 	Close()
 }
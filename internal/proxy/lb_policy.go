@@ -17,6 +17,7 @@ package proxy
 
 import (
 	"context"
+	"time"
 
 	"github.com/samber/lo"
 	"go.uber.org/zap"
@@ -71,6 +72,9 @@ func NewLBPolicyImpl(clientMgr shardClientMgr) *LBPolicyImpl {
 	case "round_robin":
 		log.Info("use round_robin policy on replica selection")
 		balancer = NewRoundRobinBalancer()
+	case "health_scored":
+		log.Info("use health_scored policy on replica selection")
+		balancer = NewHealthScoredBalancer()
 	default:
 		log.Info("use look_aside policy on replica selection")
 		balancer = NewLookAsideBalancer(clientMgr)
@@ -160,13 +164,16 @@ func (lb *LBPolicyImpl) ExecuteWithRetry(ctx context.Context, workload ChannelWo
 				zap.Int64("nodeID", targetNode),
 				zap.Error(err))
 			excludeNodes.Insert(targetNode)
+			lb.balancer.ReportResult(targetNode, 0, err)
 
 			// cancel work load which assign to the target node
 			lb.balancer.CancelWorkload(targetNode, workload.nq)
 			return merr.WrapErrShardDelegatorAccessFailed(workload.channel, err.Error())
 		}
 
+		start := time.Now()
 		err = workload.exec(ctx, targetNode, client, workload.channel)
+		lb.balancer.ReportResult(targetNode, time.Since(start), err)
 		if err != nil {
 			log.Warn("query channel failed",
 				zap.Int64("nodeID", targetNode),
@@ -140,6 +140,9 @@ func (b *LookAsideBalancer) UpdateCostMetrics(node int64, cost *internalpb.CostA
 	b.metricsUpdateTs.Insert(node, time.Now().UnixMilli())
 }
 
+// ReportResult is a no-op, look aside balancer relies on the cost metrics and health check loop
+func (b *LookAsideBalancer) ReportResult(node int64, latency time.Duration, err error) {}
+
 // calculateScore compute the query node's workload score
 // https://www.usenix.org/conference/nsdi15/technical-sessions/presentation/suresh
 func (b *LookAsideBalancer) calculateScore(node int64, cost *internalpb.CostAggregation, executingNQ int64) float64 {
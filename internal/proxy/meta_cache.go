@@ -73,6 +73,7 @@ type Cache interface {
 	RemoveCollection(ctx context.Context, database, collectionName string)
 	RemoveCollectionsByID(ctx context.Context, collectionID UniqueID) []string
 	RemovePartition(ctx context.Context, database, collectionName string, partitionName string)
+	RemoveAllCollections(ctx context.Context)
 
 	// GetCredentialInfo operate credential cache
 	GetCredentialInfo(ctx context.Context, username string) (*internalpb.CredentialInfo, error)
@@ -97,6 +98,7 @@ type collectionInfo struct {
 	createdUtcTimestamp uint64
 	consistencyLevel    commonpb.ConsistencyLevel
 	database            string
+	properties          map[string]string
 }
 
 func (info *collectionInfo) isCollectionCached() bool {
@@ -179,6 +181,11 @@ type MetaCache struct {
 	credMut        sync.RWMutex
 	privilegeMut   sync.RWMutex
 	shardMgr       shardClientMgr
+
+	// invalidations counts the invalidations of collection meta, guarded by mu.
+	// The meta fetched from rootcoord is not cached if any invalidation happens during fetching,
+	// since it may be fetched before the change which the invalidation is for.
+	invalidations uint64
 }
 
 // globalMetaCache is singleton instance of Cache
@@ -233,6 +240,7 @@ func (m *MetaCache) GetCollectionID(ctx context.Context, database, collectionNam
 	if !ok || !collInfo.isCollectionCached() {
 		metrics.ProxyCacheStatsCounter.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), method, metrics.CacheMissLabel).Inc()
 		tr := timerecord.NewTimeRecorder("UpdateCache")
+		invalidations := m.invalidations
 		m.mu.RUnlock()
 		coll, err := m.describeCollection(ctx, database, collectionName, 0)
 		if err != nil {
@@ -241,9 +249,8 @@ func (m *MetaCache) GetCollectionID(ctx context.Context, database, collectionNam
 		m.mu.Lock()
 		defer m.mu.Unlock()
 
-		m.updateCollection(coll, database, collectionName)
+		collInfo = m.updateCollection(coll, database, collectionName, invalidations)
 		metrics.ProxyUpdateCacheLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
-		collInfo = m.collInfo[database][collectionName]
 		return collInfo.collID, nil
 	}
 	defer m.mu.RUnlock()
@@ -269,6 +276,7 @@ func (m *MetaCache) GetDatabaseAndCollectionName(ctx context.Context, collection
 	if collInfo == nil || !collInfo.isCollectionCached() {
 		metrics.ProxyCacheStatsCounter.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), method, metrics.CacheMissLabel).Inc()
 		tr := timerecord.NewTimeRecorder("UpdateCache")
+		invalidations := m.invalidations
 		m.mu.RUnlock()
 		coll, err := m.describeCollection(ctx, "", "", collectionID)
 		if err != nil {
@@ -277,7 +285,7 @@ func (m *MetaCache) GetDatabaseAndCollectionName(ctx context.Context, collection
 		m.mu.Lock()
 		defer m.mu.Unlock()
 
-		m.updateCollection(coll, coll.GetDbName(), coll.Schema.Name)
+		m.updateCollection(coll, coll.GetDbName(), coll.Schema.Name, invalidations)
 		metrics.ProxyUpdateCacheLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
 		return coll.GetDbName(), coll.Schema.Name, nil
 	}
@@ -298,6 +306,7 @@ func (m *MetaCache) GetCollectionInfo(ctx context.Context, database, collectionN
 	if dbOk {
 		collInfo, ok = db[collectionName]
 	}
+	invalidations := m.invalidations
 	m.mu.RUnlock()
 
 	method := "GetCollectionInfo"
@@ -309,8 +318,7 @@ func (m *MetaCache) GetCollectionInfo(ctx context.Context, database, collectionN
 			return nil, err
 		}
 		m.mu.Lock()
-		m.updateCollection(coll, database, collectionName)
-		collInfo = m.collInfo[database][collectionName]
+		collInfo = m.updateCollection(coll, database, collectionName, invalidations)
 		m.mu.Unlock()
 		metrics.ProxyUpdateCacheLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
 	}
@@ -333,6 +341,7 @@ func (m *MetaCache) GetCollectionSchema(ctx context.Context, database, collectio
 	if !ok || !collInfo.isCollectionCached() {
 		metrics.ProxyCacheStatsCounter.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), method, metrics.CacheMissLabel).Inc()
 		tr := timerecord.NewTimeRecorder("UpdateCache")
+		invalidations := m.invalidations
 		m.mu.RUnlock()
 		coll, err := m.describeCollection(ctx, database, collectionName, 0)
 		if err != nil {
@@ -344,8 +353,7 @@ func (m *MetaCache) GetCollectionSchema(ctx context.Context, database, collectio
 		m.mu.Lock()
 		defer m.mu.Unlock()
 
-		m.updateCollection(coll, database, collectionName)
-		collInfo = m.collInfo[database][collectionName]
+		collInfo = m.updateCollection(coll, database, collectionName, invalidations)
 		metrics.ProxyUpdateCacheLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
 		log.Debug("Reload collection from root coordinator ",
 			zap.String("collectionName", collectionName),
@@ -358,7 +366,20 @@ func (m *MetaCache) GetCollectionSchema(ctx context.Context, database, collectio
 	return collInfo.schema, nil
 }
 
-func (m *MetaCache) updateCollection(coll *milvuspb.DescribeCollectionResponse, database, collectionName string) {
+// updateCollection caches the described collection and returns its info,
+// the collection is not cached if the cache is invalidated after `invalidations` is read.
+func (m *MetaCache) updateCollection(coll *milvuspb.DescribeCollectionResponse, database, collectionName string, invalidations uint64) *collectionInfo {
+	if invalidations != m.invalidations {
+		return &collectionInfo{
+			collID:              coll.CollectionID,
+			schema:              coll.Schema,
+			createdTimestamp:    coll.CreatedTimestamp,
+			createdUtcTimestamp: coll.CreatedUtcTimestamp,
+			consistencyLevel:    coll.ConsistencyLevel,
+			properties:          funcutil.KeyValuePair2Map(coll.Properties),
+		}
+	}
+
 	_, dbOk := m.collInfo[database]
 	if !dbOk {
 		m.collInfo[database] = make(map[string]*collectionInfo)
@@ -373,6 +394,8 @@ func (m *MetaCache) updateCollection(coll *milvuspb.DescribeCollectionResponse,
 	m.collInfo[database][collectionName].createdTimestamp = coll.CreatedTimestamp
 	m.collInfo[database][collectionName].createdUtcTimestamp = coll.CreatedUtcTimestamp
 	m.collInfo[database][collectionName].consistencyLevel = coll.ConsistencyLevel
+	m.collInfo[database][collectionName].properties = funcutil.KeyValuePair2Map(coll.Properties)
+	return m.collInfo[database][collectionName]
 }
 
 func (m *MetaCache) GetPartitionID(ctx context.Context, database, collectionName string, partitionName string) (typeutil.UniqueID, error) {
@@ -407,6 +430,7 @@ func (m *MetaCache) GetPartitions(ctx context.Context, database, collectionName
 	if collInfo.partInfo == nil || len(collInfo.partInfo) == 0 {
 		tr := timerecord.NewTimeRecorder("UpdateCache")
 		metrics.ProxyCacheStatsCounter.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), method, metrics.CacheMissLabel).Inc()
+		invalidations := m.invalidations
 		m.mu.RUnlock()
 
 		partitions, err := m.showPartitions(ctx, database, collectionName)
@@ -417,14 +441,13 @@ func (m *MetaCache) GetPartitions(ctx context.Context, database, collectionName
 		m.mu.Lock()
 		defer m.mu.Unlock()
 
-		err = m.updatePartitions(partitions, database, collectionName)
+		partInfo, err := m.updatePartitions(partitions, database, collectionName, invalidations)
 		if err != nil {
 			return nil, err
 		}
 		metrics.ProxyUpdateCacheLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
 		log.Debug("proxy", zap.Any("GetPartitions:partitions after update", partitions), zap.String("collectionName", collectionName))
 		ret := make(map[string]typeutil.UniqueID)
-		partInfo := m.collInfo[database][collectionName].partInfo
 		for k, v := range partInfo {
 			ret[k] = v.partitionID
 		}
@@ -465,6 +488,7 @@ func (m *MetaCache) GetPartitionInfo(ctx context.Context, database, collectionNa
 
 	var partInfo *partitionInfo
 	partInfo, ok = collInfo.partInfo[partitionName]
+	invalidations := m.invalidations
 	m.mu.RUnlock()
 
 	method := "GetPartitionInfo"
@@ -478,13 +502,13 @@ func (m *MetaCache) GetPartitionInfo(ctx context.Context, database, collectionNa
 
 		m.mu.Lock()
 		defer m.mu.Unlock()
-		err = m.updatePartitions(partitions, database, collectionName)
+		partInfos, err := m.updatePartitions(partitions, database, collectionName, invalidations)
 		if err != nil {
 			return nil, err
 		}
 		metrics.ProxyUpdateCacheLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
 		log.Debug("proxy", zap.Any("GetPartitionID:partitions after update", partitions), zap.String("collectionName", collectionName))
-		partInfo, ok = m.collInfo[database][collectionName].partInfo[partitionName]
+		partInfo, ok = partInfos[partitionName]
 		if !ok {
 			return nil, merr.WrapErrPartitionNotFound(partitionName)
 		}
@@ -530,6 +554,7 @@ func (m *MetaCache) describeCollection(ctx context.Context, database, collection
 		CreatedUtcTimestamp:  coll.CreatedUtcTimestamp,
 		ConsistencyLevel:     coll.ConsistencyLevel,
 		DbName:               coll.GetDbName(),
+		Properties:           coll.Properties,
 	}
 	for _, field := range coll.Schema.Fields {
 		if field.FieldID >= common.StartOfUserFieldID {
@@ -564,7 +589,26 @@ func (m *MetaCache) showPartitions(ctx context.Context, dbName string, collectio
 	return partitions, nil
 }
 
-func (m *MetaCache) updatePartitions(partitions *milvuspb.ShowPartitionsResponse, database, collectionName string) error {
+// updatePartitions caches the partitions and returns the partition infos of the collection,
+// the partitions are not cached if the cache is invalidated after `invalidations` is read.
+func (m *MetaCache) updatePartitions(partitions *milvuspb.ShowPartitionsResponse, database, collectionName string, invalidations uint64) (map[string]*partitionInfo, error) {
+	// check partitionID, createdTimestamp and utcstamp has sam element numbers
+	if len(partitions.PartitionNames) != len(partitions.CreatedTimestamps) || len(partitions.PartitionNames) != len(partitions.CreatedUtcTimestamps) {
+		return nil, errors.New("partition names and timestamps number is not aligned, response " + partitions.String())
+	}
+
+	if invalidations != m.invalidations {
+		partInfo := make(map[string]*partitionInfo, len(partitions.PartitionIDs))
+		for i := 0; i < len(partitions.PartitionIDs); i++ {
+			partInfo[partitions.PartitionNames[i]] = &partitionInfo{
+				partitionID:         partitions.PartitionIDs[i],
+				createdTimestamp:    partitions.CreatedTimestamps[i],
+				createdUtcTimestamp: partitions.CreatedUtcTimestamps[i],
+			}
+		}
+		return partInfo, nil
+	}
+
 	_, dbOk := m.collInfo[database]
 	if !dbOk {
 		m.collInfo[database] = make(map[string]*collectionInfo)
@@ -581,11 +625,6 @@ func (m *MetaCache) updatePartitions(partitions *milvuspb.ShowPartitionsResponse
 		partInfo = map[string]*partitionInfo{}
 	}
 
-	// check partitionID, createdTimestamp and utcstamp has sam element numbers
-	if len(partitions.PartitionNames) != len(partitions.CreatedTimestamps) || len(partitions.PartitionNames) != len(partitions.CreatedUtcTimestamps) {
-		return errors.New("partition names and timestamps number is not aligned, response " + partitions.String())
-	}
-
 	for i := 0; i < len(partitions.PartitionIDs); i++ {
 		if _, ok := partInfo[partitions.PartitionNames[i]]; !ok {
 			partInfo[partitions.PartitionNames[i]] = &partitionInfo{
@@ -596,12 +635,13 @@ func (m *MetaCache) updatePartitions(partitions *milvuspb.ShowPartitionsResponse
 		}
 	}
 	m.collInfo[database][collectionName].partInfo = partInfo
-	return nil
+	return partInfo, nil
 }
 
 func (m *MetaCache) RemoveCollection(ctx context.Context, database, collectionName string) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
+	m.invalidations++
 	_, dbOk := m.collInfo[database]
 	if dbOk {
 		delete(m.collInfo[database], collectionName)
@@ -611,6 +651,7 @@ func (m *MetaCache) RemoveCollection(ctx context.Context, database, collectionNa
 func (m *MetaCache) RemoveCollectionsByID(ctx context.Context, collectionID UniqueID) []string {
 	m.mu.Lock()
 	defer m.mu.Unlock()
+	m.invalidations++
 	var collNames []string
 	for database, db := range m.collInfo {
 		for k, v := range db {
@@ -626,6 +667,7 @@ func (m *MetaCache) RemoveCollectionsByID(ctx context.Context, collectionID Uniq
 func (m *MetaCache) RemovePartition(ctx context.Context, database, collectionName, partitionName string) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
+	m.invalidations++
 
 	var ok bool
 
@@ -914,5 +956,15 @@ func (m *MetaCache) RefreshPolicyInfo(op typeutil.CacheOp) error {
 func (m *MetaCache) RemoveDatabase(ctx context.Context, database string) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
+	m.invalidations++
 	delete(m.collInfo, database)
 }
+
+// RemoveAllCollections removes the meta of all the collections,
+// it's used when some invalidations may be missed.
+func (m *MetaCache) RemoveAllCollections(ctx context.Context) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.invalidations++
+	m.collInfo = make(map[string]map[string]*collectionInfo)
+}
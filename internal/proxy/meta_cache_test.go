@@ -869,3 +869,39 @@ func TestGlobalMetaCache_ShuffleShardLeaders(t *testing.T) {
 	assert.Len(t, result["channel-1"], 3)
 	assert.Equal(t, int64(3), result["channel-1"][0].nodeID)
 }
+
+func TestMetaCache_UpdateAfterInvalidation(t *testing.T) {
+	ctx := context.Background()
+	cache, err := NewMetaCache(nil, nil, nil)
+	assert.NoError(t, err)
+
+	coll := &milvuspb.DescribeCollectionResponse{
+		CollectionID: 1,
+		Schema:       &schemapb.CollectionSchema{Name: "collection1"},
+	}
+	// the collection is invalidated while describing, the described meta may be stale
+	invalidations := cache.invalidations
+	cache.RemoveCollection(ctx, dbName, "collection1")
+	info := cache.updateCollection(coll, dbName, "collection1", invalidations)
+	assert.Equal(t, typeutil.UniqueID(1), info.collID)
+	assert.Empty(t, cache.collInfo[dbName])
+
+	info = cache.updateCollection(coll, dbName, "collection1", cache.invalidations)
+	assert.Same(t, info, cache.collInfo[dbName]["collection1"])
+
+	partitions := &milvuspb.ShowPartitionsResponse{
+		PartitionNames:       []string{"_default"},
+		PartitionIDs:         []int64{10},
+		CreatedTimestamps:    []uint64{100},
+		CreatedUtcTimestamps: []uint64{100},
+	}
+	invalidations = cache.invalidations
+	cache.RemovePartition(ctx, dbName, "collection1", "_default")
+	partInfo, err := cache.updatePartitions(partitions, dbName, "collection1", invalidations)
+	assert.NoError(t, err)
+	assert.Equal(t, typeutil.UniqueID(10), partInfo["_default"].partitionID)
+	assert.Empty(t, cache.collInfo[dbName]["collection1"].partInfo)
+
+	cache.RemoveAllCollections(ctx)
+	assert.Empty(t, cache.collInfo)
+}
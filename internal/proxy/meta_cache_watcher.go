@@ -0,0 +1,142 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// metaCacheWatchRetryInterval is the interval before watching again after the watch fails.
+const metaCacheWatchRetryInterval = time.Second
+
+// metaCacheWatcher applies the collection meta cache invalidations published by rootcoord into meta store,
+// so the invalidations are applied even if the rpc from rootcoord fails, e.g. the proxy is unreachable for a while.
+type metaCacheWatcher struct {
+	etcdCli    *clientv3.Client
+	prefix     string
+	invalidate func(ctx context.Context, req *proxypb.InvalidateCollMetaCacheRequest)
+	// reset drops all the cached collections, it's called when the invalidations may be missed
+	reset func(ctx context.Context)
+	// applied is the revision of the last applied event of each collection, keyed by metaCacheEventTarget
+	applied map[string]uint64
+}
+
+func newMetaCacheWatcher(etcdCli *clientv3.Client,
+	invalidate func(ctx context.Context, req *proxypb.InvalidateCollMetaCacheRequest),
+	reset func(ctx context.Context),
+) *metaCacheWatcher {
+	return &metaCacheWatcher{
+		etcdCli:    etcdCli,
+		prefix:     path.Join(Params.EtcdCfg.MetaRootPath.GetValue(), common.MetaCacheEventPrefix) + "/",
+		invalidate: invalidate,
+		reset:      reset,
+		applied:    make(map[string]uint64),
+	}
+}
+
+// metaCacheEventTarget returns the collection the event targets, in the same way as rootcoord keys the events.
+func metaCacheEventTarget(req *proxypb.InvalidateCollMetaCacheRequest) string {
+	target := req.GetCollectionName()
+	if req.GetCollectionID() != 0 {
+		target = strconv.FormatInt(req.GetCollectionID(), 10)
+	}
+	return path.Join(req.GetDbName(), target)
+}
+
+func (w *metaCacheWatcher) watchLoop(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	// the cache is empty when proxy starts, so watching from the latest revision is enough
+	rev, err := w.latestRevision(ctx)
+	for {
+		if err == nil {
+			watchCh := w.etcdCli.Watch(ctx, w.prefix, clientv3.WithPrefix(), clientv3.WithRev(rev))
+			rev = w.consume(ctx, watchCh, rev)
+		}
+		select {
+		case <-ctx.Done():
+			log.Info("meta cache watch loop quit")
+			return
+		case <-time.After(metaCacheWatchRetryInterval):
+		}
+		if err != nil || rev == 0 {
+			// the invalidations before the latest revision may be missed
+			w.reset(ctx)
+			w.applied = make(map[string]uint64)
+			rev, err = w.latestRevision(ctx)
+		}
+	}
+}
+
+// latestRevision returns the revision to watch from, which is next to the latest revision of meta store.
+func (w *metaCacheWatcher) latestRevision(ctx context.Context) (int64, error) {
+	resp, err := w.etcdCli.Get(ctx, w.prefix, clientv3.WithPrefix(), clientv3.WithCountOnly())
+	if err != nil {
+		log.Warn("failed to get the latest revision of meta cache events", zap.Error(err))
+		return 0, err
+	}
+	return resp.Header.GetRevision() + 1, nil
+}
+
+// consume applies the invalidations until the watch fails,
+// and returns the revision to watch from, 0 means the events are compacted.
+func (w *metaCacheWatcher) consume(ctx context.Context, watchCh clientv3.WatchChan, rev int64) int64 {
+	for resp := range watchCh {
+		if resp.CompactRevision != 0 {
+			log.Warn("meta cache events compacted", zap.Int64("revision", rev),
+				zap.Int64("compactRevision", resp.CompactRevision))
+			return 0
+		}
+		if err := resp.Err(); err != nil {
+			log.Warn("failed to watch meta cache events", zap.Int64("revision", rev), zap.Error(err))
+			return rev
+		}
+		for _, event := range resp.Events {
+			rev = event.Kv.ModRevision + 1
+			if event.Type != clientv3.EventTypePut {
+				continue
+			}
+			req := &proxypb.InvalidateCollMetaCacheRequest{}
+			if err := proto.Unmarshal(event.Kv.Value, req); err != nil {
+				log.Warn("skip malformed meta cache event", zap.String("key", string(event.Kv.Key)), zap.Error(err))
+				continue
+			}
+			// the events may be replayed out of order, e.g. rewatched from an earlier revision,
+			// the stale ones must not overwrite the newer invalidations
+			target := metaCacheEventTarget(req)
+			if applied, ok := w.applied[target]; ok && req.GetRevision() <= applied {
+				log.Info("skip stale meta cache event", zap.String("target", target),
+					zap.Uint64("revision", req.GetRevision()), zap.Uint64("applied", applied))
+				continue
+			}
+			w.invalidate(ctx, req)
+			w.applied[target] = req.GetRevision()
+		}
+	}
+	return rev
+}
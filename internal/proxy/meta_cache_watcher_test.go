@@ -0,0 +1,111 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+)
+
+func TestMetaCacheWatcher_Consume(t *testing.T) {
+	var invalidated []*proxypb.InvalidateCollMetaCacheRequest
+	w := newMetaCacheWatcher(nil, func(ctx context.Context, req *proxypb.InvalidateCollMetaCacheRequest) {
+		invalidated = append(invalidated, req)
+	}, func(ctx context.Context) {})
+
+	value, err := proto.Marshal(&proxypb.InvalidateCollMetaCacheRequest{
+		CollectionID: 100,
+		Revision:     1000,
+		ChangeType:   proxypb.MetaCacheChangeType_SchemaChange,
+	})
+	assert.NoError(t, err)
+
+	t.Run("apply events", func(t *testing.T) {
+		invalidated = nil
+		watchCh := make(chan clientv3.WatchResponse, 1)
+		watchCh <- clientv3.WatchResponse{Events: []*clientv3.Event{
+			{Type: mvccpb.PUT, Kv: &mvccpb.KeyValue{Key: []byte("event1"), Value: value, ModRevision: 10}},
+			{Type: mvccpb.PUT, Kv: &mvccpb.KeyValue{Key: []byte("event2"), Value: []byte("malformed"), ModRevision: 11}},
+			{Type: mvccpb.DELETE, Kv: &mvccpb.KeyValue{Key: []byte("event1"), ModRevision: 12}},
+		}}
+		close(watchCh)
+
+		rev := w.consume(context.Background(), watchCh, 5)
+		assert.EqualValues(t, 13, rev)
+		assert.Len(t, invalidated, 1)
+		assert.EqualValues(t, 100, invalidated[0].GetCollectionID())
+		assert.EqualValues(t, 1000, invalidated[0].GetRevision())
+	})
+
+	t.Run("drop stale events", func(t *testing.T) {
+		invalidated = nil
+		stale, err := proto.Marshal(&proxypb.InvalidateCollMetaCacheRequest{
+			CollectionID: 100,
+			Revision:     900,
+			ChangeType:   proxypb.MetaCacheChangeType_SchemaChange,
+		})
+		assert.NoError(t, err)
+		newer, err := proto.Marshal(&proxypb.InvalidateCollMetaCacheRequest{
+			CollectionID: 100,
+			Revision:     1100,
+			ChangeType:   proxypb.MetaCacheChangeType_PartitionChange,
+		})
+		assert.NoError(t, err)
+		watchCh := make(chan clientv3.WatchResponse, 1)
+		watchCh <- clientv3.WatchResponse{Events: []*clientv3.Event{
+			{Type: mvccpb.PUT, Kv: &mvccpb.KeyValue{Key: []byte("event3"), Value: stale, ModRevision: 13}},
+			{Type: mvccpb.PUT, Kv: &mvccpb.KeyValue{Key: []byte("event1"), Value: value, ModRevision: 14}},
+			{Type: mvccpb.PUT, Kv: &mvccpb.KeyValue{Key: []byte("event4"), Value: newer, ModRevision: 15}},
+		}}
+		close(watchCh)
+
+		rev := w.consume(context.Background(), watchCh, 13)
+		assert.EqualValues(t, 16, rev)
+		assert.Len(t, invalidated, 1)
+		assert.EqualValues(t, 1100, invalidated[0].GetRevision())
+	})
+
+	t.Run("compacted", func(t *testing.T) {
+		invalidated = nil
+		watchCh := make(chan clientv3.WatchResponse, 2)
+		watchCh <- clientv3.WatchResponse{CompactRevision: 8}
+		watchCh <- clientv3.WatchResponse{Events: []*clientv3.Event{
+			{Type: mvccpb.PUT, Kv: &mvccpb.KeyValue{Key: []byte("event1"), Value: value, ModRevision: 10}},
+		}}
+		close(watchCh)
+
+		rev := w.consume(context.Background(), watchCh, 5)
+		assert.EqualValues(t, 0, rev)
+		assert.Empty(t, invalidated)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		watchCh := make(chan clientv3.WatchResponse, 1)
+		watchCh <- clientv3.WatchResponse{Canceled: true}
+		close(watchCh)
+
+		rev := w.consume(context.Background(), watchCh, 5)
+		assert.EqualValues(t, 5, rev)
+	})
+}
@@ -81,6 +81,7 @@ func getProxyMetrics(ctx context.Context, request *milvuspb.GetMetricsRequest, n
 		DiskUsage:    hardware.GetDiskUsage(),
 	}
 	quotaMetrics.Hms = hardwareMetrics
+	quotaMetrics.Collections = node.collectionStats.list()
 
 	proxyRoleName := metricsinfo.ConstructComponentName(typeutil.ProxyRole, paramtable.GetNodeID())
 	proxyMetricInfo := metricsinfo.ProxyInfos{
@@ -99,6 +100,7 @@ func getProxyMetrics(ctx context.Context, request *milvuspb.GetMetricsRequest, n
 			DefaultIndexName:     Params.CommonCfg.DefaultIndexName.GetValue(),
 		},
 		QuotaMetrics: quotaMetrics,
+		SlowQueries:  node.slowQueries.list(),
 	}
 
 	resp, err := metricsinfo.MarshalComponentInfos(proxyMetricInfo)
@@ -158,6 +160,7 @@ func getSystemInfoMetrics(
 				DefaultPartitionName: Params.CommonCfg.DefaultPartitionName.GetValue(),
 				DefaultIndexName:     Params.CommonCfg.DefaultIndexName.GetValue(),
 			},
+			SlowQueries: node.slowQueries.list(),
 		},
 	}
 	metricsinfo.FillDeployMetricsWithEnv(&(proxyTopologyNode.Infos.(*metricsinfo.ProxyInfos).SystemInfo))
@@ -0,0 +1,239 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"math/rand"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/crypto"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// mirrorTarget is where the sampled search/query requests are mirrored to.
+type mirrorTarget interface {
+	Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error)
+	Query(ctx context.Context, request *milvuspb.QueryRequest) (*milvuspb.QueryResults, error)
+}
+
+// remoteMirrorTarget mirrors requests through the grpc server of a proxy.
+type remoteMirrorTarget struct {
+	client milvuspb.MilvusServiceClient
+}
+
+func (t *remoteMirrorTarget) Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+	return t.client.Search(ctx, request)
+}
+
+func (t *remoteMirrorTarget) Query(ctx context.Context, request *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+	return t.client.Query(ctx, request)
+}
+
+// mirroredKey marks the context of a request issued by the proxy itself, e.g. a mirrored or sampled one,
+// which shall not be mirrored again.
+type mirroredKey struct{}
+
+// mirroredHeader marks the request mirrored through the grpc server in the metadata, which shall not be mirrored again.
+const mirroredHeader = "mirrored"
+
+// requestMirror duplicates sampled search/query requests to a shadow collection or a shadow cluster.
+// Mirroring is fire-and-forget, the mirrored results are discarded and never affect the origin requests.
+type requestMirror struct {
+	ctx    context.Context
+	target mirrorTarget
+	local  bool // whether the target is the proxy itself
+	conn   *grpc.ClientConn
+	sem    chan struct{} // limits the in-flight mirrored requests
+
+	mu     sync.Mutex // guards closed and the additions of wg
+	closed bool
+	wg     sync.WaitGroup
+}
+
+// newRequestMirror creates a requestMirror, requests are mirrored to the shadow cluster
+// if `proxy.mirror.endpoint` is set, otherwise to the shadow collection via the grpc server of the proxy itself,
+// so that the mirrored requests pass the auth, privilege and rate limit interceptors like any other request.
+func newRequestMirror(ctx context.Context) (*requestMirror, error) {
+	endpoint := Params.ProxyCfg.Mirror.Endpoint.GetValue()
+	local := endpoint == ""
+	if local {
+		endpoint = paramtable.Get().ProxyGrpcServerCfg.GetAddress()
+	}
+	// the shadow cluster is expected to be configured in the same TLS mode as the federated ones
+	creds, err := federationCredentials()
+	if err != nil {
+		return nil, err
+	}
+	conn, err := grpc.DialContext(ctx, endpoint, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, err
+	}
+	m := newRequestMirrorWithTarget(ctx, &remoteMirrorTarget{client: milvuspb.NewMilvusServiceClient(conn)}, local)
+	m.conn = conn
+	return m, nil
+}
+
+func newRequestMirrorWithTarget(ctx context.Context, target mirrorTarget, local bool) *requestMirror {
+	return &requestMirror{
+		ctx:    ctx,
+		target: target,
+		local:  local,
+		sem:    make(chan struct{}, Params.ProxyCfg.Mirror.MaxConcurrency.GetAsInt()),
+	}
+}
+
+// Close waits for the in-flight mirrored requests and releases the connection to the shadow target,
+// no request is mirrored after closing.
+func (m *requestMirror) Close() {
+	m.mu.Lock()
+	m.closed = true
+	m.mu.Unlock()
+
+	m.wg.Wait()
+	if m.conn != nil {
+		m.conn.Close()
+	}
+}
+
+// sample decides whether the request shall be mirrored.
+func (m *requestMirror) sample(ctx context.Context) bool {
+	if m == nil || isMirrored(ctx) {
+		return false
+	}
+	cfg := &Params.ProxyCfg.Mirror
+	if !cfg.Enable.GetAsBool() {
+		return false
+	}
+	// mirroring to the origin collection of this cluster doubles the load only.
+	if m.local && cfg.CollectionSuffix.GetValue() == "" {
+		return false
+	}
+	return rand.Float64() < cfg.SampleRatio.GetAsFloat()
+}
+
+// isMirrored tells whether the request is issued by the proxy itself or mirrored from another request.
+func isMirrored(ctx context.Context) bool {
+	if ctx.Value(mirroredKey{}) != nil {
+		return true
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	return ok && len(md.Get(mirroredHeader)) > 0
+}
+
+// mirrorContext returns the context of the mirrored request, which is detached from the origin one
+// as the mirrored request outlives it. Only the database is carried over, the credentials of the origin request
+// are never passed on, the mirrored request is sent as the configured mirror user instead.
+func (m *requestMirror) mirrorContext(origin context.Context) context.Context {
+	md := metadata.Pairs(
+		mirroredHeader, "true",
+		strings.ToLower(util.HeaderDBName), GetCurDBNameFromContextOrDefault(origin),
+	)
+	cfg := &Params.ProxyCfg.Mirror
+	if username := cfg.Username.GetValue(); username != "" {
+		md.Set(strings.ToLower(util.HeaderAuthorize),
+			crypto.Base64Encode(username+util.CredentialSeperator+cfg.Password.GetValue()))
+	}
+	return metadata.NewOutgoingContext(m.ctx, md)
+}
+
+func (m *requestMirror) shadowCollection(collectionName string) string {
+	return collectionName + Params.ProxyCfg.Mirror.CollectionSuffix.GetValue()
+}
+
+// MirrorSearch mirrors the search request asynchronously if it is sampled.
+func (m *requestMirror) MirrorSearch(ctx context.Context, request *milvuspb.SearchRequest) {
+	if !m.sample(ctx) {
+		return
+	}
+	shadow := proto.Clone(request).(*milvuspb.SearchRequest)
+	shadow.CollectionName = m.shadowCollection(request.GetCollectionName())
+	m.mirror(ctx, metrics.SearchLabel, shadow.GetCollectionName(), func(ctx context.Context) error {
+		resp, err := m.target.Search(ctx, shadow)
+		if err != nil {
+			return err
+		}
+		return merr.Error(resp.GetStatus())
+	})
+}
+
+// MirrorQuery mirrors the query request asynchronously if it is sampled.
+func (m *requestMirror) MirrorQuery(ctx context.Context, request *milvuspb.QueryRequest) {
+	if !m.sample(ctx) {
+		return
+	}
+	shadow := proto.Clone(request).(*milvuspb.QueryRequest)
+	shadow.CollectionName = m.shadowCollection(request.GetCollectionName())
+	m.mirror(ctx, metrics.QueryLabel, shadow.GetCollectionName(), func(ctx context.Context) error {
+		resp, err := m.target.Query(ctx, shadow)
+		if err != nil {
+			return err
+		}
+		return merr.Error(resp.GetStatus())
+	})
+}
+
+func (m *requestMirror) mirror(origin context.Context, queryType string, collectionName string, fn func(ctx context.Context) error) {
+	nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
+	select {
+	case m.sem <- struct{}{}:
+	default:
+		// never block the origin request, drop the mirrored one if too many in flight.
+		metrics.ProxyMirrorRequestCount.WithLabelValues(nodeID, queryType, metrics.AbandonLabel).Inc()
+		return
+	}
+
+	m.mu.Lock()
+	if m.closed {
+		m.mu.Unlock()
+		<-m.sem
+		return
+	}
+	m.wg.Add(1)
+	m.mu.Unlock()
+
+	ctx := m.mirrorContext(origin)
+	go func() {
+		defer m.wg.Done()
+		defer func() { <-m.sem }()
+
+		ctx, cancel := context.WithTimeout(ctx, Params.ProxyCfg.Mirror.Timeout.GetAsDuration(time.Second))
+		defer cancel()
+		if err := fn(ctx); err != nil {
+			log.Warn("failed to mirror request",
+				zap.String("queryType", queryType),
+				zap.String("collection", collectionName),
+				zap.Error(err))
+			metrics.ProxyMirrorRequestCount.WithLabelValues(nodeID, queryType, metrics.FailLabel).Inc()
+			return
+		}
+		metrics.ProxyMirrorRequestCount.WithLabelValues(nodeID, queryType, metrics.SuccessLabel).Inc()
+	}()
+}
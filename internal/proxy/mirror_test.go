@@ -0,0 +1,221 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/atomic"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/util/crypto"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type mockMirrorTarget struct {
+	block       chan struct{}
+	searched    atomic.Int32
+	queried     atomic.Int32
+	collections chan string
+	mirrored    atomic.Bool
+	database    atomic.String
+	authorize   atomic.String
+}
+
+func newMockMirrorTarget() *mockMirrorTarget {
+	return &mockMirrorTarget{collections: make(chan string, 16)}
+}
+
+func (t *mockMirrorTarget) observe(ctx context.Context, collectionName string) {
+	if t.block != nil {
+		<-t.block
+	}
+	if md, ok := metadata.FromOutgoingContext(ctx); ok {
+		t.mirrored.Store(len(md.Get(mirroredHeader)) > 0)
+		if len(md.Get("dbname")) > 0 {
+			t.database.Store(md.Get("dbname")[0])
+		}
+		if len(md.Get("authorization")) > 0 {
+			t.authorize.Store(md.Get("authorization")[0])
+		}
+	}
+	t.collections <- collectionName
+}
+
+func (t *mockMirrorTarget) Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+	t.searched.Inc()
+	t.observe(ctx, request.GetCollectionName())
+	return &milvuspb.SearchResults{Status: merr.Status(nil)}, nil
+}
+
+func (t *mockMirrorTarget) Query(ctx context.Context, request *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+	t.queried.Inc()
+	t.observe(ctx, request.GetCollectionName())
+	return &milvuspb.QueryResults{Status: merr.Status(nil)}, nil
+}
+
+func saveMirrorParams(t *testing.T, kvs map[string]string) {
+	for k, v := range kvs {
+		paramtable.Get().Save(k, v)
+	}
+	t.Cleanup(func() {
+		for k := range kvs {
+			paramtable.Get().Reset(k)
+		}
+	})
+}
+
+func TestRequestMirror(t *testing.T) {
+	paramtable.Init()
+	cfg := &paramtable.Get().ProxyCfg.Mirror
+	ctx := context.Background()
+
+	t.Run("mirror to shadow collection", func(t *testing.T) {
+		saveMirrorParams(t, map[string]string{
+			cfg.Enable.Key:           "true",
+			cfg.SampleRatio.Key:      "1",
+			cfg.CollectionSuffix.Key: "_shadow",
+		})
+		target := newMockMirrorTarget()
+		m := newRequestMirrorWithTarget(ctx, target, true)
+
+		origin := metadata.NewIncomingContext(ctx, metadata.Pairs("dbname", "db1", "authorization", "origin"))
+		searchReq := &milvuspb.SearchRequest{CollectionName: "coll"}
+		m.MirrorSearch(origin, searchReq)
+		m.MirrorQuery(origin, &milvuspb.QueryRequest{CollectionName: "coll"})
+		m.Close()
+
+		assert.EqualValues(t, 1, target.searched.Load())
+		assert.EqualValues(t, 1, target.queried.Load())
+		assert.Equal(t, "coll_shadow", <-target.collections)
+		assert.Equal(t, "coll_shadow", <-target.collections)
+		// the origin request is untouched
+		assert.Equal(t, "coll", searchReq.GetCollectionName())
+		assert.True(t, target.mirrored.Load())
+		assert.Equal(t, "db1", target.database.Load())
+		// the credentials of the origin request are never passed on
+		assert.Equal(t, "", target.authorize.Load())
+	})
+
+	t.Run("mirror as the configured user", func(t *testing.T) {
+		saveMirrorParams(t, map[string]string{
+			cfg.Enable.Key:           "true",
+			cfg.SampleRatio.Key:      "1",
+			cfg.CollectionSuffix.Key: "_shadow",
+			cfg.Username.Key:         "shadow",
+			cfg.Password.Key:         "pwd",
+		})
+		target := newMockMirrorTarget()
+		m := newRequestMirrorWithTarget(ctx, target, true)
+		m.MirrorSearch(metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "origin")), &milvuspb.SearchRequest{CollectionName: "coll"})
+		m.Close()
+		assert.EqualValues(t, 1, target.searched.Load())
+		assert.Equal(t, crypto.Base64Encode("shadow:pwd"), target.authorize.Load())
+	})
+
+	t.Run("closed", func(t *testing.T) {
+		saveMirrorParams(t, map[string]string{
+			cfg.Enable.Key:           "true",
+			cfg.SampleRatio.Key:      "1",
+			cfg.CollectionSuffix.Key: "_shadow",
+		})
+		target := newMockMirrorTarget()
+		m := newRequestMirrorWithTarget(ctx, target, true)
+		m.Close()
+		m.MirrorSearch(ctx, &milvuspb.SearchRequest{CollectionName: "coll"})
+		assert.EqualValues(t, 0, target.searched.Load())
+	})
+
+	t.Run("not sampled", func(t *testing.T) {
+		saveMirrorParams(t, map[string]string{
+			cfg.Enable.Key:           "true",
+			cfg.SampleRatio.Key:      "0",
+			cfg.CollectionSuffix.Key: "_shadow",
+		})
+		target := newMockMirrorTarget()
+		m := newRequestMirrorWithTarget(ctx, target, true)
+		m.MirrorSearch(ctx, &milvuspb.SearchRequest{CollectionName: "coll"})
+		m.Close()
+		assert.EqualValues(t, 0, target.searched.Load())
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		saveMirrorParams(t, map[string]string{
+			cfg.Enable.Key:           "false",
+			cfg.SampleRatio.Key:      "1",
+			cfg.CollectionSuffix.Key: "_shadow",
+		})
+		target := newMockMirrorTarget()
+		m := newRequestMirrorWithTarget(ctx, target, true)
+		m.MirrorSearch(ctx, &milvuspb.SearchRequest{CollectionName: "coll"})
+		m.Close()
+		assert.EqualValues(t, 0, target.searched.Load())
+	})
+
+	t.Run("no shadow", func(t *testing.T) {
+		saveMirrorParams(t, map[string]string{
+			cfg.Enable.Key:      "true",
+			cfg.SampleRatio.Key: "1",
+		})
+		target := newMockMirrorTarget()
+		m := newRequestMirrorWithTarget(ctx, target, true)
+		m.MirrorSearch(ctx, &milvuspb.SearchRequest{CollectionName: "coll"})
+		m.Close()
+		assert.EqualValues(t, 0, target.searched.Load())
+	})
+
+	t.Run("mirrored request", func(t *testing.T) {
+		saveMirrorParams(t, map[string]string{
+			cfg.Enable.Key:           "true",
+			cfg.SampleRatio.Key:      "1",
+			cfg.CollectionSuffix.Key: "_shadow",
+		})
+		target := newMockMirrorTarget()
+		m := newRequestMirrorWithTarget(ctx, target, true)
+		m.MirrorSearch(context.WithValue(ctx, mirroredKey{}, struct{}{}), &milvuspb.SearchRequest{CollectionName: "coll"})
+		m.MirrorSearch(metadata.NewIncomingContext(ctx, metadata.Pairs(mirroredHeader, "true")), &milvuspb.SearchRequest{CollectionName: "coll"})
+		m.Close()
+		assert.EqualValues(t, 0, target.searched.Load())
+	})
+
+	t.Run("too many in flight", func(t *testing.T) {
+		saveMirrorParams(t, map[string]string{
+			cfg.Enable.Key:           "true",
+			cfg.SampleRatio.Key:      "1",
+			cfg.CollectionSuffix.Key: "_shadow",
+			cfg.MaxConcurrency.Key:   "1",
+		})
+		target := newMockMirrorTarget()
+		target.block = make(chan struct{})
+		m := newRequestMirrorWithTarget(ctx, target, true)
+		m.MirrorSearch(ctx, &milvuspb.SearchRequest{CollectionName: "coll"})
+		m.MirrorSearch(ctx, &milvuspb.SearchRequest{CollectionName: "coll"})
+		close(target.block)
+		m.Close()
+		assert.EqualValues(t, 1, target.searched.Load())
+	})
+
+	t.Run("nil mirror", func(t *testing.T) {
+		var m *requestMirror
+		m.MirrorSearch(ctx, &milvuspb.SearchRequest{CollectionName: "coll"})
+		m.MirrorQuery(ctx, &milvuspb.QueryRequest{CollectionName: "coll"})
+	})
+}
@@ -633,6 +633,34 @@ func (_c *MockCache_RefreshPolicyInfo_Call) Return(_a0 error) *MockCache_Refresh
 	return _c
 }
 
+// RemoveAllCollections provides a mock function with given fields: ctx
+func (_m *MockCache) RemoveAllCollections(ctx context.Context) {
+	_m.Called(ctx)
+}
+
+// MockCache_RemoveAllCollections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveAllCollections'
+type MockCache_RemoveAllCollections_Call struct {
+	*mock.Call
+}
+
+// RemoveAllCollections is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockCache_Expecter) RemoveAllCollections(ctx interface{}) *MockCache_RemoveAllCollections_Call {
+	return &MockCache_RemoveAllCollections_Call{Call: _e.mock.On("RemoveAllCollections", ctx)}
+}
+
+func (_c *MockCache_RemoveAllCollections_Call) Run(run func(ctx context.Context)) *MockCache_RemoveAllCollections_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockCache_RemoveAllCollections_Call) Return() *MockCache_RemoveAllCollections_Call {
+	_c.Call.Return()
+	return _c
+}
+
 // RemoveCollection provides a mock function with given fields: ctx, database, collectionName
 func (_m *MockCache) RemoveCollection(ctx context.Context, database string, collectionName string) {
 	_m.Called(ctx, database, collectionName)
@@ -7,6 +7,8 @@ import (
 
 	internalpb "github.com/milvus-io/milvus/internal/proto/internalpb"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // MockLBBalancer is an autogenerated mock type for the LBBalancer type
@@ -88,6 +90,45 @@ func (_c *MockLBBalancer_Close_Call) RunAndReturn(run func()) *MockLBBalancer_Cl
 	return _c
 }
 
+// ReportResult provides a mock function with given fields: node, latency, err
+func (_m *MockLBBalancer) ReportResult(node int64, latency time.Duration, err error) {
+	_m.Called(node, latency, err)
+}
+
+// MockLBBalancer_ReportResult_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportResult'
+type MockLBBalancer_ReportResult_Call struct {
+	*mock.Call
+}
+
+// ReportResult is a helper method to define mock.On call
+//   - node int64
+//   - latency time.Duration
+//   - err error
+func (_e *MockLBBalancer_Expecter) ReportResult(node interface{}, latency interface{}, err interface{}) *MockLBBalancer_ReportResult_Call {
+	return &MockLBBalancer_ReportResult_Call{Call: _e.mock.On("ReportResult", node, latency, err)}
+}
+
+func (_c *MockLBBalancer_ReportResult_Call) Run(run func(node int64, latency time.Duration, err error)) *MockLBBalancer_ReportResult_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var err error
+		if args[2] != nil {
+			err = args[2].(error)
+		}
+		run(args[0].(int64), args[1].(time.Duration), err)
+	})
+	return _c
+}
+
+func (_c *MockLBBalancer_ReportResult_Call) Return() *MockLBBalancer_ReportResult_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockLBBalancer_ReportResult_Call) RunAndReturn(run func(int64, time.Duration, error)) *MockLBBalancer_ReportResult_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SelectNode provides a mock function with given fields: ctx, availableNodes, nq
 func (_m *MockLBBalancer) SelectNode(ctx context.Context, availableNodes []int64, nq int64) (int64, error) {
 	ret := _m.Called(ctx, availableNodes, nq)
@@ -42,6 +42,7 @@ var QuotaErrorString = map[commonpb.ErrorCode]string{
 	commonpb.ErrorCode_MemoryQuotaExhausted: "memory quota exhausted, please allocate more resources",
 	commonpb.ErrorCode_DiskQuotaExhausted:   "disk quota exhausted, please allocate more resources",
 	commonpb.ErrorCode_TimeTickLongDelay:    "time tick long delay",
+	commonpb.ErrorCode_NotReadyServe:        "the segments registered on the external files are not converted yet",
 }
 
 func GetQuotaErrorString(errCode commonpb.ErrorCode) string {
@@ -112,6 +112,25 @@ type Proxy struct {
 
 	// for load balance in replicas
 	lbPolicy LBPolicy
+
+	// mirror sampled search/query requests for shadow testing
+	mirror *requestMirror
+
+	// evaluate candidate indexes by replaying the captured search requests
+	evaluator *indexEvaluator
+
+	// estimate the recall of sampled searches by re-executing them exactly
+	recallSampler *recallSampler
+
+	// route the mapped databases/collections to other clusters in federation mode
+	federation *federation
+
+	slowQueries *slowQueryRecorder
+
+	collectionStats *collectionStatsRecorder
+
+	// store the oversized varchar/JSON values aside, nil if disabled
+	sidePayload *sidePayloadStore
 }
 
 // NewProxy returns a Proxy struct.
@@ -130,6 +149,9 @@ func NewProxy(ctx context.Context, factory dependency.Factory) (*Proxy, error) {
 		shardMgr:         mgr,
 		multiRateLimiter: NewMultiRateLimiter(),
 		lbPolicy:         lbPolicy,
+		slowQueries:      newSlowQueryRecorder(),
+		collectionStats:  newCollectionStatsRecorder(),
+		federation:       newFederation(ctx1),
 	}
 	node.UpdateStateCode(commonpb.StateCode_Abnormal)
 	logutil.Logger(ctx).Debug("create a new Proxy instance", zap.Any("state", node.stateCode.Load()))
@@ -261,6 +283,28 @@ func (node *Proxy) Init() error {
 	}
 	log.Debug("init meta cache done", zap.String("role", typeutil.ProxyRole))
 
+	node.mirror, err = newRequestMirror(node.ctx)
+	if err != nil {
+		log.Warn("failed to create request mirror", zap.String("role", typeutil.ProxyRole), zap.Error(err))
+		return err
+	}
+	log.Debug("create request mirror done", zap.String("role", typeutil.ProxyRole))
+
+	node.evaluator = newIndexEvaluator(node.ctx, node)
+	node.recallSampler = newRecallSampler(node.ctx, node)
+
+	if Params.ProxyCfg.SidePayloadThreshold.GetAsInt() > 0 {
+		cm, err := node.factory.NewPersistentStorageChunkManager(node.ctx)
+		if err != nil {
+			log.Warn("failed to create chunk manager for side payload", zap.String("role", typeutil.ProxyRole), zap.Error(err))
+			return err
+		}
+		node.sidePayload = newSidePayloadStore(cm, node.dataCoord)
+		log.Debug("create side payload store done", zap.String("role", typeutil.ProxyRole))
+	}
+
+	node.federation.Start(node.etcdCli)
+
 	return nil
 }
 
@@ -373,6 +417,17 @@ func (node *Proxy) Start() error {
 
 	node.sendChannelsTimeTickLoop()
 
+	if node.etcdCli != nil && Params.ProxyCfg.WatchMetaCacheEvents.GetAsBool() {
+		watcher := newMetaCacheWatcher(node.etcdCli, node.invalidateCollectionMetaCache, func(ctx context.Context) {
+			if globalMetaCache != nil {
+				globalMetaCache.RemoveAllCollections(ctx)
+			}
+		})
+		node.wg.Add(1)
+		go watcher.watchLoop(node.ctx, &node.wg)
+		log.Debug("start meta cache watcher done", zap.String("role", typeutil.ProxyRole))
+	}
+
 	// Start callbacks
 	for _, cb := range node.startCallbacks {
 		cb()
@@ -433,6 +488,22 @@ func (node *Proxy) Stop() error {
 		node.lbPolicy.Close()
 	}
 
+	if node.mirror != nil {
+		node.mirror.Close()
+	}
+
+	if node.evaluator != nil {
+		node.evaluator.Close()
+	}
+
+	if node.recallSampler != nil {
+		node.recallSampler.Close()
+	}
+
+	if node.federation != nil {
+		node.federation.Close()
+	}
+
 	// https://github.com/milvus-io/milvus/issues/12282
 	node.UpdateStateCode(commonpb.StateCode_Abnormal)
 
@@ -488,6 +559,14 @@ func (node *Proxy) SetQueryNodeCreator(f func(ctx context.Context, addr string)
 	node.shardMgr.SetClientCreatorFunc(f)
 }
 
+// GetFederation returns the federation router in Proxy.
+func (node *Proxy) GetFederation() types.Federation {
+	if node.federation == nil {
+		return nil
+	}
+	return node.federation
+}
+
 // GetRateLimiter returns the rateLimiter in Proxy.
 func (node *Proxy) GetRateLimiter() (types.Limiter, error) {
 	if node.multiRateLimiter == nil {
@@ -0,0 +1,154 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"math/rand"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// recallSampler estimates the recall of the production searches. The sampled searches are re-executed
+// in background by the exact search, which bypasses the vector indexes and searches the raw vectors by brute force,
+// on the snapshot of the origin search. The shard leaders route each channel to a single replica,
+// so the exact search costs the same as the origin one without the index.
+// The recall of the origin results against the exact ones is exported per collection and vector field.
+type recallSampler struct {
+	ctx    context.Context
+	target mirrorTarget
+	sem    chan struct{} // limits the in-flight exact searches
+	wg     sync.WaitGroup
+}
+
+func newRecallSampler(ctx context.Context, target mirrorTarget) *recallSampler {
+	return &recallSampler{
+		ctx:    ctx,
+		target: target,
+		sem:    make(chan struct{}, Params.ProxyCfg.RecallSampling.MaxConcurrency.GetAsInt()),
+	}
+}
+
+// Close waits for the in-flight exact searches.
+func (s *recallSampler) Close() {
+	s.wg.Wait()
+}
+
+// sample decides whether the recall of the search shall be estimated.
+func (s *recallSampler) sample(ctx context.Context, request *milvuspb.SearchRequest) bool {
+	if s == nil || isMirrored(ctx) {
+		return false
+	}
+	// the exact searches, including the ones issued by users, are the ground truth already
+	if exact, err := funcutil.GetAttrByKeyFromRepeatedKV(ExactSearchKey, request.GetSearchParams()); err == nil {
+		if ok, _ := strconv.ParseBool(exact); ok {
+			return false
+		}
+	}
+	return rand.Float64() < Params.ProxyCfg.RecallSampling.SampleRatio.GetAsFloat()
+}
+
+// Sample re-executes the search exactly in background if it is sampled, and records the recall of the result.
+// The exact search reads the snapshot at travelTs, which is the begin timestamp of the origin search.
+func (s *recallSampler) Sample(ctx context.Context, request *milvuspb.SearchRequest, result *milvuspb.SearchResults, travelTs, guaranteeTs uint64) {
+	if !merr.Ok(result.GetStatus()) || result.GetResults().GetNumQueries() == 0 || !s.sample(ctx, request) {
+		return
+	}
+	nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
+	select {
+	case s.sem <- struct{}{}:
+	default:
+		// never pile up the exact searches, which are much more expensive than the origin ones
+		metrics.ProxyRecallSampleCount.WithLabelValues(nodeID, metrics.AbandonLabel).Inc()
+		return
+	}
+
+	exact := proto.Clone(request).(*milvuspb.SearchRequest)
+	exact.SearchParams = overrideKeyValuePairs(exact.GetSearchParams(), []*commonpb.KeyValuePair{
+		{Key: ExactSearchKey, Value: "true"},
+	})
+	exact.OutputFields = nil
+	exact.TravelTimestamp = travelTs
+	exact.GuaranteeTimestamp = guaranteeTs
+	exact.UseDefaultConsistency = false
+	exact.ConsistencyLevel = commonpb.ConsistencyLevel_Customized
+	if exact.GetDbName() == "" {
+		exact.DbName = GetCurDBNameFromContextOrDefault(ctx)
+	}
+	fieldName, _ := funcutil.GetAttrByKeyFromRepeatedKV(AnnsFieldKey, request.GetSearchParams())
+	origin := result.GetResults()
+
+	// the exact search outlives the origin one, so it's detached from the origin context,
+	// only the metadata (e.g. database and credentials) is carried over.
+	sampleCtx := context.WithValue(s.ctx, mirroredKey{}, struct{}{})
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		sampleCtx = metadata.NewIncomingContext(sampleCtx, md.Copy())
+	}
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer func() { <-s.sem }()
+
+		ctx, cancel := context.WithTimeout(sampleCtx, Params.ProxyCfg.RecallSampling.Timeout.GetAsDuration(time.Second))
+		defer cancel()
+		resp, err := s.target.Search(ctx, exact)
+		if err == nil {
+			err = merr.Error(resp.GetStatus())
+		}
+		if err != nil {
+			log.Warn("failed to search exactly for recall sampling",
+				zap.String("collection", exact.GetCollectionName()),
+				zap.Error(err))
+			metrics.ProxyRecallSampleCount.WithLabelValues(nodeID, metrics.FailLabel).Inc()
+			return
+		}
+		recall, ok := averageRecall(resp.GetResults(), origin)
+		if !ok {
+			return
+		}
+		metrics.ProxyRecallSampleCount.WithLabelValues(nodeID, metrics.SuccessLabel).Inc()
+		metrics.ProxySearchRecall.WithLabelValues(nodeID, exact.GetCollectionName(), fieldName).Observe(recall)
+	}()
+}
+
+// averageRecall returns the average recall of the queries, false if no query has the ground truth.
+func averageRecall(truth, result *schemapb.SearchResultData) (float64, bool) {
+	recalls := computeRecalls(truth, result)
+	if len(recalls) == 0 {
+		return 0, false
+	}
+	var sum float64
+	for _, recall := range recalls {
+		sum += recall
+	}
+	return sum / float64(len(recalls)), true
+}
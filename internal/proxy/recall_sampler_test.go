@@ -0,0 +1,153 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type mockExactSearchTarget struct {
+	mockMirrorTarget
+	requests chan *milvuspb.SearchRequest
+	truth    *schemapb.SearchResultData
+}
+
+func (t *mockExactSearchTarget) Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+	t.requests <- request
+	return &milvuspb.SearchResults{Status: merr.Status(nil), Results: t.truth}, nil
+}
+
+func TestRecallSampler(t *testing.T) {
+	paramtable.Init()
+	cfg := &paramtable.Get().ProxyCfg.RecallSampling
+	ctx := context.Background()
+
+	newResult := func(ids ...int64) *milvuspb.SearchResults {
+		return &milvuspb.SearchResults{
+			Status: merr.Status(nil),
+			Results: &schemapb.SearchResultData{
+				NumQueries: 1,
+				Topks:      []int64{int64(len(ids))},
+				Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}}},
+			},
+		}
+	}
+	request := &milvuspb.SearchRequest{
+		CollectionName: "coll",
+		OutputFields:   []string{"text"},
+		SearchParams:   []*commonpb.KeyValuePair{{Key: AnnsFieldKey, Value: "vec"}},
+	}
+
+	t.Run("sampled", func(t *testing.T) {
+		saveMirrorParams(t, map[string]string{cfg.SampleRatio.Key: "1"})
+		target := &mockExactSearchTarget{
+			requests: make(chan *milvuspb.SearchRequest, 1),
+			truth:    newResult(1, 2).GetResults(),
+		}
+		s := newRecallSampler(ctx, target)
+		s.Sample(ctx, request, newResult(1, 3), 100, 90)
+		s.Close()
+
+		exact := <-target.requests
+		assert.Equal(t, "coll", exact.GetCollectionName())
+		assert.Empty(t, exact.GetOutputFields())
+		assert.EqualValues(t, 100, exact.GetTravelTimestamp())
+		assert.EqualValues(t, 90, exact.GetGuaranteeTimestamp())
+		value, err := funcutil.GetAttrByKeyFromRepeatedKV(ExactSearchKey, exact.GetSearchParams())
+		assert.NoError(t, err)
+		assert.Equal(t, "true", value)
+		// the origin request is untouched
+		assert.Equal(t, []string{"text"}, request.GetOutputFields())
+		assert.Len(t, request.GetSearchParams(), 1)
+	})
+
+	t.Run("not sampled", func(t *testing.T) {
+		saveMirrorParams(t, map[string]string{cfg.SampleRatio.Key: "0"})
+		target := &mockExactSearchTarget{requests: make(chan *milvuspb.SearchRequest, 1)}
+		s := newRecallSampler(ctx, target)
+		s.Sample(ctx, request, newResult(1), 100, 90)
+		s.Close()
+		assert.Empty(t, target.requests)
+	})
+
+	t.Run("exact or failed search", func(t *testing.T) {
+		saveMirrorParams(t, map[string]string{cfg.SampleRatio.Key: "1"})
+		target := &mockExactSearchTarget{requests: make(chan *milvuspb.SearchRequest, 1)}
+		s := newRecallSampler(ctx, target)
+		exact := &milvuspb.SearchRequest{
+			CollectionName: "coll",
+			SearchParams:   []*commonpb.KeyValuePair{{Key: ExactSearchKey, Value: "true"}},
+		}
+		s.Sample(ctx, exact, newResult(1), 100, 90)
+		s.Sample(ctx, request, &milvuspb.SearchResults{Status: merr.Status(merr.ErrServiceNotReady)}, 100, 90)
+		s.Sample(context.WithValue(ctx, mirroredKey{}, struct{}{}), request, newResult(1), 100, 90)
+		s.Close()
+		assert.Empty(t, target.requests)
+	})
+}
+
+func TestAverageRecall(t *testing.T) {
+	truth := &schemapb.SearchResultData{
+		Topks: []int64{2, 2},
+		Ids:   &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2, 3, 4}}}},
+	}
+	result := &schemapb.SearchResultData{
+		Topks: []int64{2, 2},
+		Ids:   &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2, 3, 5}}}},
+	}
+	recall, ok := averageRecall(truth, result)
+	assert.True(t, ok)
+	assert.Equal(t, 0.75, recall)
+
+	_, ok = averageRecall(&schemapb.SearchResultData{}, result)
+	assert.False(t, ok)
+}
+
+func TestParseSearchInfo_ExactSearch(t *testing.T) {
+	params := []*commonpb.KeyValuePair{
+		{Key: TopKKey, Value: "10"},
+		{Key: SearchParamsKey, Value: `{"nprobe": 16}`},
+		{Key: ExactSearchKey, Value: "true"},
+	}
+	info, _, err := parseSearchInfo(params)
+	require.NoError(t, err)
+	searchParams := make(map[string]interface{})
+	require.NoError(t, json.Unmarshal([]byte(info.GetSearchParams()), &searchParams))
+	assert.Equal(t, true, searchParams[ExactSearchKey])
+	assert.EqualValues(t, 16, searchParams["nprobe"])
+
+	params[2].Value = "false"
+	info, _, err = parseSearchInfo(params)
+	require.NoError(t, err)
+	assert.Equal(t, `{"nprobe": 16}`, info.GetSearchParams())
+
+	params[2].Value = "invalid"
+	_, _, err = parseSearchInfo(params)
+	assert.Error(t, err)
+}
@@ -1178,6 +1178,18 @@ func (coord *RootCoordMock) RenameCollection(ctx context.Context, req *milvuspb.
 	return &commonpb.Status{}, nil
 }
 
+func (coord *RootCoordMock) CloneCollection(ctx context.Context, req *rootcoordpb.CloneCollectionRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
+func (coord *RootCoordMock) GetCapacityReport(ctx context.Context, req *rootcoordpb.GetCapacityReportRequest) (*rootcoordpb.GetCapacityReportResponse, error) {
+	return &rootcoordpb.GetCapacityReportResponse{Status: &commonpb.Status{}}, nil
+}
+
+func (coord *RootCoordMock) GetCollectionStatsHistory(ctx context.Context, req *rootcoordpb.GetCollectionStatsHistoryRequest) (*rootcoordpb.GetCollectionStatsHistoryResponse, error) {
+	return &rootcoordpb.GetCollectionStatsHistoryResponse{Status: &commonpb.Status{}}, nil
+}
+
 type DescribeCollectionFunc func(ctx context.Context, request *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error)
 
 type ShowPartitionsFunc func(ctx context.Context, request *milvuspb.ShowPartitionsRequest) (*milvuspb.ShowPartitionsResponse, error)
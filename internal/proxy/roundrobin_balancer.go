@@ -17,6 +17,7 @@ package proxy
 
 import (
 	"context"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/util/merr"
@@ -70,6 +71,8 @@ func (b *RoundRobinBalancer) CancelWorkload(node int64, nq int64) {
 
 func (b *RoundRobinBalancer) UpdateCostMetrics(node int64, cost *internalpb.CostAggregation) {}
 
+func (b *RoundRobinBalancer) ReportResult(node int64, latency time.Duration, err error) {}
+
 func (b *RoundRobinBalancer) Start(ctx context.Context) {}
 
 func (b *RoundRobinBalancer) Close() {}
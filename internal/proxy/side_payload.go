@@ -0,0 +1,421 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	// sidePayloadVarCharPrefix leads the references of the varchar values, the NUL byte keeps it from
+	// colliding with the ordinary texts
+	sidePayloadVarCharPrefix = "\x00side_payload:"
+	// sidePayloadJSONKey is the only key of the references of the JSON values
+	sidePayloadJSONKey = "$side_payload"
+)
+
+var sidePayloadJSONPrefix = []byte(`{"` + sidePayloadJSONKey + `":`)
+
+// sidePayloadStore stores the oversized values of the side payload fields as separate objects in the object storage.
+// The values are replaced by references in the inserted rows, so that a few huge documents don't blow
+// past the max payload size of the MQ or inflate the segment memory, the references are assembled back
+// in the query and search results transparently.
+//
+// A field opts in by the side_payload type param. Since the segments keep the references only, such field can't
+// be filtered or indexed, and the values which look like references are rejected on insertion.
+// The objects are removed by DataCoord once the collection is dropped or the segments are dropped without
+// compaction, and by the compaction for the deleted and expired rows.
+//
+// The objects of the collections requiring encrypted storage are encrypted with the current key of the collection,
+// they keep the key they are written with until they are rewritten, since compaction doesn't rewrite them.
+type sidePayloadStore struct {
+	cm        storage.ChunkManager
+	dataCoord types.DataCoord
+
+	keyMu       sync.Mutex
+	keyVersions map[int64]sidePayloadKeyVersion
+}
+
+type sidePayloadKeyVersion struct {
+	version   int64
+	expiredAt time.Time
+}
+
+// sidePayloadKeyVersionTTL is how long the key version of a collection is cached
+const sidePayloadKeyVersionTTL = time.Minute
+
+func newSidePayloadStore(cm storage.ChunkManager, dataCoord types.DataCoord) *sidePayloadStore {
+	return &sidePayloadStore{
+		cm:          cm,
+		dataCoord:   dataCoord,
+		keyVersions: make(map[int64]sidePayloadKeyVersion),
+	}
+}
+
+// encryptionKeyVersion returns the key version to encrypt the objects of the collection with, 0 means in plain text
+func (s *sidePayloadStore) encryptionKeyVersion(ctx context.Context, collectionID int64) (int64, error) {
+	if s.dataCoord == nil {
+		return 0, nil
+	}
+	s.keyMu.Lock()
+	defer s.keyMu.Unlock()
+	if cached, ok := s.keyVersions[collectionID]; ok && time.Now().Before(cached.expiredAt) {
+		return cached.version, nil
+	}
+	resp, err := s.dataCoord.GetEncryptionStatus(ctx, &datapb.GetEncryptionStatusRequest{
+		Base:         commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID())),
+		CollectionID: collectionID,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return 0, merr.Error(resp.GetStatus())
+	}
+	version := int64(0)
+	if resp.GetEnabled() {
+		version = resp.GetKeyVersion()
+	}
+	if version > 0 && !storage.SupportEncryption(s.cm) {
+		return 0, fmt.Errorf("collection %d requires encrypted storage: %w", collectionID, storage.ErrEncryptionNotConfigured)
+	}
+	s.keyVersions[collectionID] = sidePayloadKeyVersion{version: version, expiredAt: time.Now().Add(sidePayloadKeyVersionTTL)}
+	return version, nil
+}
+
+func (s *sidePayloadStore) key(collectionID, fieldID, rowID int64) string {
+	return metautil.BuildSidePayloadPath(s.cm.RootPath(), collectionID, fieldID, rowID)
+}
+
+// parseKey returns true if the key is the path of a row of the field, only such objects are read back
+func (s *sidePayloadStore) parseKey(collectionID, fieldID int64, key string) bool {
+	prefix := metautil.BuildSidePayloadPrefix(s.cm.RootPath(), collectionID, fieldID)
+	if !strings.HasPrefix(key, prefix) {
+		return false
+	}
+	rowID, err := strconv.ParseInt(strings.TrimPrefix(key, prefix), 10, 64)
+	return err == nil && s.key(collectionID, fieldID, rowID) == key
+}
+
+func sidePayloadJSONRef(key string) []byte {
+	ref, _ := json.Marshal(map[string]string{sidePayloadJSONKey: key})
+	return ref
+}
+
+// parseSidePayloadJSONRef returns the key of the object if the value is a reference
+func parseSidePayloadJSONRef(value []byte) (string, bool) {
+	if !bytes.HasPrefix(value, sidePayloadJSONPrefix) {
+		return "", false
+	}
+	ref := make(map[string]string)
+	if err := json.Unmarshal(value, &ref); err != nil || len(ref) != 1 {
+		return "", false
+	}
+	key, ok := ref[sidePayloadJSONKey]
+	return key, ok
+}
+
+// validateSidePayloadField checks the side payload type param of the field
+func validateSidePayloadField(field *schemapb.FieldSchema) error {
+	for _, param := range field.GetTypeParams() {
+		if param.GetKey() != common.SidePayloadKey {
+			continue
+		}
+		enabled, err := strconv.ParseBool(param.GetValue())
+		if err != nil {
+			return merr.WrapErrParameterInvalid("true or false", param.GetValue(), "invalid side_payload type param")
+		}
+		if !enabled {
+			return nil
+		}
+		if !typeutil.IsStringType(field.GetDataType()) && !typeutil.IsJSONType(field.GetDataType()) {
+			return merr.WrapErrParameterInvalid("varchar or JSON field", field.GetDataType().String(),
+				"side payload is only supported by varchar and JSON fields")
+		}
+		if field.GetIsPrimaryKey() || field.GetIsPartitionKey() || field.GetIsDynamic() {
+			return merr.WrapErrParameterInvalid("ordinary field", field.GetName(),
+				"side payload is not supported by primary key, partition key or dynamic field")
+		}
+	}
+	return nil
+}
+
+// checkSidePayloadValues rejects the values of the side payload fields which look like references,
+// they would be read as the references of other rows otherwise
+func checkSidePayloadValues(schema *schemapb.CollectionSchema, fieldsData []*schemapb.FieldData) error {
+	fields := make(map[int64]*schemapb.FieldSchema)
+	for _, field := range schema.GetFields() {
+		if typeutil.IsSidePayloadField(field) {
+			fields[field.GetFieldID()] = field
+		}
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+
+	for _, fieldData := range fieldsData {
+		field, ok := fields[fieldData.GetFieldId()]
+		if !ok {
+			continue
+		}
+		switch fieldData.GetType() {
+		case schemapb.DataType_VarChar, schemapb.DataType_String:
+			for _, value := range fieldData.GetScalars().GetStringData().GetData() {
+				if strings.HasPrefix(value, sidePayloadVarCharPrefix) {
+					return merr.WrapErrParameterInvalid("ordinary value", "side payload reference",
+						"invalid value of field "+field.GetName())
+				}
+			}
+		case schemapb.DataType_JSON:
+			for _, value := range fieldData.GetScalars().GetJsonData().GetData() {
+				if _, ok := parseSidePayloadJSONRef(value); ok {
+					return merr.WrapErrParameterInvalid("ordinary value", "side payload reference",
+						"invalid value of field "+field.GetName())
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// checkSidePayloadFilter rejects the plans filtering the side payload fields, whose segments keep the references only
+func checkSidePayloadFilter(schema *schemapb.CollectionSchema, plan *planpb.PlanNode) error {
+	fields := make(map[int64]*schemapb.FieldSchema)
+	for _, field := range schema.GetFields() {
+		if typeutil.IsSidePayloadField(field) {
+			fields[field.GetFieldID()] = field
+		}
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+
+	for _, fieldID := range collectPlanColumns(plan) {
+		if field, ok := fields[fieldID]; ok {
+			return merr.WrapErrParameterInvalid("filter without side payload fields", field.GetName(),
+				"the side payload field can't be filtered")
+		}
+	}
+	return nil
+}
+
+// collectPlanColumns returns the IDs of the fields referenced by the expressions of the plan
+func collectPlanColumns(plan *planpb.PlanNode) []int64 {
+	columnInfo := proto.MessageReflect(&planpb.ColumnInfo{}).Descriptor()
+	fieldIDDesc := columnInfo.Fields().ByName("field_id")
+
+	var fieldIDs []int64
+	var walk func(m protoreflect.Message)
+	walk = func(m protoreflect.Message) {
+		if m.Descriptor().FullName() == columnInfo.FullName() {
+			fieldIDs = append(fieldIDs, m.Get(fieldIDDesc).Int())
+			return
+		}
+		m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+			switch {
+			case fd.IsMap():
+			case fd.IsList():
+				if fd.Message() != nil {
+					for i := 0; i < v.List().Len(); i++ {
+						walk(v.List().Get(i).Message())
+					}
+				}
+			case fd.Message() != nil:
+				walk(v.Message())
+			}
+			return true
+		})
+	}
+	walk(proto.MessageReflect(plan))
+	return fieldIDs
+}
+
+// offload stores the values larger than the threshold aside, and replaces them by the references in place
+func (s *sidePayloadStore) offload(ctx context.Context, collectionID int64, schema *schemapb.CollectionSchema,
+	fieldsData []*schemapb.FieldData, rowIDs []int64) error {
+	if s == nil {
+		return nil
+	}
+	threshold := Params.ProxyCfg.SidePayloadThreshold.GetAsInt()
+	if threshold <= 0 {
+		return nil
+	}
+
+	fields := make(map[int64]*schemapb.FieldSchema)
+	for _, field := range schema.GetFields() {
+		if typeutil.IsSidePayloadField(field) {
+			fields[field.GetFieldID()] = field
+		}
+	}
+
+	contents := make(map[string][]byte)
+	for _, fieldData := range fieldsData {
+		field, ok := fields[fieldData.GetFieldId()]
+		if !ok {
+			continue
+		}
+
+		switch fieldData.GetType() {
+		case schemapb.DataType_VarChar, schemapb.DataType_String:
+			data := fieldData.GetScalars().GetStringData().GetData()
+			for i, value := range data {
+				if len(value) <= threshold || i >= len(rowIDs) {
+					continue
+				}
+				key := s.key(collectionID, field.GetFieldID(), rowIDs[i])
+				// the reference is useless if it isn't shorter than the value
+				if len(sidePayloadVarCharPrefix)+len(key) >= len(value) {
+					continue
+				}
+				contents[key] = []byte(value)
+				data[i] = sidePayloadVarCharPrefix + key
+			}
+		case schemapb.DataType_JSON:
+			data := fieldData.GetScalars().GetJsonData().GetData()
+			for i, value := range data {
+				if len(value) <= threshold || i >= len(rowIDs) {
+					continue
+				}
+				key := s.key(collectionID, field.GetFieldID(), rowIDs[i])
+				ref := sidePayloadJSONRef(key)
+				if len(ref) >= len(value) {
+					continue
+				}
+				contents[key] = value
+				data[i] = ref
+			}
+		}
+	}
+
+	if len(contents) == 0 {
+		return nil
+	}
+	keyVersion, err := s.encryptionKeyVersion(ctx, collectionID)
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to get the encryption key version", zap.Int64("collectionID", collectionID), zap.Error(err))
+		return err
+	}
+	if err := s.cm.MultiWrite(storage.WithEncryptionKey(ctx, collectionID, keyVersion), contents); err != nil {
+		log.Ctx(ctx).Warn("failed to store the oversized values aside", zap.Int64("collectionID", collectionID),
+			zap.Int("count", len(contents)), zap.Error(err))
+		return err
+	}
+	log.Ctx(ctx).Debug("oversized values stored aside", zap.Int64("collectionID", collectionID), zap.Int("count", len(contents)))
+	return nil
+}
+
+// assemble replaces the references in the results by the values stored aside,
+// only the objects of the side payload fields of the collection are read
+func (s *sidePayloadStore) assemble(ctx context.Context, collectionID int64, schema *schemapb.CollectionSchema,
+	fieldsData []*schemapb.FieldData) error {
+	if s == nil {
+		return nil
+	}
+
+	fields := typeutil.NewUniqueSet()
+	for _, field := range schema.GetFields() {
+		if typeutil.IsSidePayloadField(field) {
+			fields.Insert(field.GetFieldID())
+		}
+	}
+	if fields.Len() == 0 {
+		return nil
+	}
+
+	type position struct {
+		fieldData *schemapb.FieldData
+		offset    int
+	}
+	positions := make(map[string][]position)
+	addPosition := func(fieldData *schemapb.FieldData, offset int, key string) {
+		if !s.parseKey(collectionID, fieldData.GetFieldId(), key) {
+			log.Ctx(ctx).Warn("skip invalid side payload reference", zap.Int64("collectionID", collectionID),
+				zap.Int64("fieldID", fieldData.GetFieldId()), zap.String("key", key))
+			return
+		}
+		positions[key] = append(positions[key], position{fieldData, offset})
+	}
+	for _, fieldData := range fieldsData {
+		if !fields.Contain(fieldData.GetFieldId()) || fieldData.GetIsDynamic() {
+			continue
+		}
+		switch fieldData.GetType() {
+		case schemapb.DataType_VarChar, schemapb.DataType_String:
+			for i, value := range fieldData.GetScalars().GetStringData().GetData() {
+				if strings.HasPrefix(value, sidePayloadVarCharPrefix) {
+					addPosition(fieldData, i, strings.TrimPrefix(value, sidePayloadVarCharPrefix))
+				}
+			}
+		case schemapb.DataType_JSON:
+			for i, value := range fieldData.GetScalars().GetJsonData().GetData() {
+				if key, ok := parseSidePayloadJSONRef(value); ok {
+					addPosition(fieldData, i, key)
+				}
+			}
+		}
+	}
+
+	if len(positions) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(positions))
+	for key := range positions {
+		keys = append(keys, key)
+	}
+	values, err := s.cm.MultiRead(ctx, keys)
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to read the values stored aside", zap.Int("count", len(keys)), zap.Error(err))
+		return err
+	}
+
+	for i, key := range keys {
+		for _, pos := range positions[key] {
+			switch pos.fieldData.GetType() {
+			case schemapb.DataType_VarChar, schemapb.DataType_String:
+				pos.fieldData.GetScalars().GetStringData().GetData()[pos.offset] = string(values[i])
+			case schemapb.DataType_JSON:
+				pos.fieldData.GetScalars().GetJsonData().GetData()[pos.offset] = values[i]
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,227 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func newVarCharFieldData(fieldID int64, fieldName string, values ...string) *schemapb.FieldData {
+	return &schemapb.FieldData{
+		Type:      schemapb.DataType_VarChar,
+		FieldName: fieldName,
+		FieldId:   fieldID,
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_StringData{
+					StringData: &schemapb.StringArray{Data: values},
+				},
+			},
+		},
+	}
+}
+
+var sidePayloadParams = []*commonpb.KeyValuePair{{Key: common.SidePayloadKey, Value: "true"}}
+
+func TestSidePayloadStore(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_VarChar},
+			{FieldID: 101, Name: "text", DataType: schemapb.DataType_VarChar, TypeParams: sidePayloadParams},
+			{FieldID: 102, Name: "doc", DataType: schemapb.DataType_JSON, TypeParams: sidePayloadParams},
+			{FieldID: 103, Name: "other", DataType: schemapb.DataType_VarChar},
+		},
+	}
+	bigText := strings.Repeat("a", 1024)
+	bigDoc := []byte(`{"text": "` + strings.Repeat("b", 1024) + `"}`)
+	newFieldsData := func() []*schemapb.FieldData {
+		return []*schemapb.FieldData{
+			newVarCharFieldData(100, "pk", bigText, "pk2"),
+			newVarCharFieldData(101, "text", bigText, "small"),
+			{
+				Type:      schemapb.DataType_JSON,
+				FieldName: "doc",
+				FieldId:   102,
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_JsonData{
+							JsonData: &schemapb.JSONArray{Data: [][]byte{[]byte(`{}`), bigDoc}},
+						},
+					},
+				},
+			},
+			newVarCharFieldData(103, "other", bigText, "small"),
+		}
+	}
+
+	cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+
+	t.Run("nil store", func(t *testing.T) {
+		var store *sidePayloadStore
+		fieldsData := newFieldsData()
+		assert.NoError(t, store.offload(ctx, 1, schema, fieldsData, []int64{1, 2}))
+		assert.NoError(t, store.assemble(ctx, 1, schema, fieldsData))
+		assert.Equal(t, bigText, fieldsData[1].GetScalars().GetStringData().GetData()[0])
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		store := newSidePayloadStore(cm, nil)
+		fieldsData := newFieldsData()
+		assert.NoError(t, store.offload(ctx, 1, schema, fieldsData, []int64{1, 2}))
+		assert.Equal(t, bigText, fieldsData[1].GetScalars().GetStringData().GetData()[0])
+	})
+
+	t.Run("offload and assemble", func(t *testing.T) {
+		paramtable.Get().Save(Params.ProxyCfg.SidePayloadThreshold.Key, "256")
+		defer paramtable.Get().Reset(Params.ProxyCfg.SidePayloadThreshold.Key)
+
+		store := newSidePayloadStore(cm, nil)
+		fieldsData := newFieldsData()
+		assert.NoError(t, store.offload(ctx, 1, schema, fieldsData, []int64{1, 2}))
+
+		// only the side payload fields are stored aside
+		assert.Equal(t, bigText, fieldsData[0].GetScalars().GetStringData().GetData()[0])
+		assert.Equal(t, bigText, fieldsData[3].GetScalars().GetStringData().GetData()[0])
+		texts := fieldsData[1].GetScalars().GetStringData().GetData()
+		assert.True(t, strings.HasPrefix(texts[0], sidePayloadVarCharPrefix))
+		assert.Equal(t, "small", texts[1])
+		docs := fieldsData[2].GetScalars().GetJsonData().GetData()
+		assert.Equal(t, []byte(`{}`), docs[0])
+		key, ok := parseSidePayloadJSONRef(docs[1])
+		assert.True(t, ok)
+		assert.Equal(t, store.key(1, 102, 2), key)
+
+		assert.NoError(t, store.assemble(ctx, 1, schema, fieldsData))
+		assert.Equal(t, bigText, fieldsData[1].GetScalars().GetStringData().GetData()[0])
+		assert.Equal(t, bigDoc, fieldsData[2].GetScalars().GetJsonData().GetData()[1])
+
+		// the stored value is missing
+		err := cm.Remove(ctx, key)
+		assert.NoError(t, err)
+		docs[1] = sidePayloadJSONRef(key)
+		assert.Error(t, store.assemble(ctx, 1, schema, fieldsData))
+	})
+
+	t.Run("assemble only the references of the collection and field", func(t *testing.T) {
+		paramtable.Get().Save(Params.ProxyCfg.SidePayloadThreshold.Key, "256")
+		defer paramtable.Get().Reset(Params.ProxyCfg.SidePayloadThreshold.Key)
+
+		store := newSidePayloadStore(cm, nil)
+		fieldsData := newFieldsData()
+		assert.NoError(t, store.offload(ctx, 1, schema, fieldsData, []int64{1, 2}))
+		// a secret object outside of the side payload directories
+		secret := path.Join(cm.RootPath(), "secret")
+		assert.NoError(t, cm.Write(ctx, secret, []byte("secret")))
+
+		refs := []string{
+			sidePayloadVarCharPrefix + secret,
+			sidePayloadVarCharPrefix + store.key(2, 101, 1),
+			sidePayloadVarCharPrefix + store.key(1, 102, 1),
+			sidePayloadVarCharPrefix + store.key(1, 101, 1) + "/../../../../secret",
+		}
+		for _, ref := range refs {
+			results := []*schemapb.FieldData{newVarCharFieldData(101, "text", ref), newVarCharFieldData(103, "other", ref)}
+			assert.NoError(t, store.assemble(ctx, 1, schema, results))
+			assert.Equal(t, ref, results[0].GetScalars().GetStringData().GetData()[0])
+			assert.Equal(t, ref, results[1].GetScalars().GetStringData().GetData()[0])
+		}
+	})
+}
+
+func TestValidateSidePayloadField(t *testing.T) {
+	assert.NoError(t, validateSidePayloadField(&schemapb.FieldSchema{Name: "text", DataType: schemapb.DataType_VarChar}))
+	assert.NoError(t, validateSidePayloadField(&schemapb.FieldSchema{Name: "text", DataType: schemapb.DataType_VarChar, TypeParams: sidePayloadParams}))
+	assert.NoError(t, validateSidePayloadField(&schemapb.FieldSchema{Name: "doc", DataType: schemapb.DataType_JSON, TypeParams: sidePayloadParams}))
+	assert.NoError(t, validateSidePayloadField(&schemapb.FieldSchema{Name: "id", DataType: schemapb.DataType_Int64,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.SidePayloadKey, Value: "false"}}}))
+
+	assert.Error(t, validateSidePayloadField(&schemapb.FieldSchema{Name: "text", DataType: schemapb.DataType_VarChar,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.SidePayloadKey, Value: "yes please"}}}))
+	assert.Error(t, validateSidePayloadField(&schemapb.FieldSchema{Name: "id", DataType: schemapb.DataType_Int64, TypeParams: sidePayloadParams}))
+	assert.Error(t, validateSidePayloadField(&schemapb.FieldSchema{Name: "pk", DataType: schemapb.DataType_VarChar, IsPrimaryKey: true, TypeParams: sidePayloadParams}))
+	assert.Error(t, validateSidePayloadField(&schemapb.FieldSchema{Name: "key", DataType: schemapb.DataType_VarChar, IsPartitionKey: true, TypeParams: sidePayloadParams}))
+}
+
+func TestCheckSidePayloadValues(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 101, Name: "text", DataType: schemapb.DataType_VarChar, TypeParams: sidePayloadParams},
+			{FieldID: 102, Name: "doc", DataType: schemapb.DataType_JSON, TypeParams: sidePayloadParams},
+			{FieldID: 103, Name: "other", DataType: schemapb.DataType_VarChar},
+		},
+	}
+	newJSONFieldData := func(values ...[]byte) *schemapb.FieldData {
+		return &schemapb.FieldData{
+			Type:    schemapb.DataType_JSON,
+			FieldId: 102,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_JsonData{JsonData: &schemapb.JSONArray{Data: values}},
+				},
+			},
+		}
+	}
+
+	assert.NoError(t, checkSidePayloadValues(schema, []*schemapb.FieldData{
+		newVarCharFieldData(101, "text", "side_payload:a"),
+		newJSONFieldData([]byte(`{"$side_payload": "a", "b": 1}`)),
+		newVarCharFieldData(103, "other", sidePayloadVarCharPrefix+"a"),
+	}))
+	assert.Error(t, checkSidePayloadValues(schema, []*schemapb.FieldData{
+		newVarCharFieldData(101, "text", "a", sidePayloadVarCharPrefix+"a"),
+	}))
+	assert.Error(t, checkSidePayloadValues(schema, []*schemapb.FieldData{
+		newJSONFieldData([]byte(`{}`), sidePayloadJSONRef("a")),
+	}))
+}
+
+func TestCheckSidePayloadFilter(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "text", DataType: schemapb.DataType_VarChar, TypeParams: sidePayloadParams},
+			{FieldID: 102, Name: "doc", DataType: schemapb.DataType_JSON, TypeParams: sidePayloadParams},
+			{FieldID: 103, Name: "other", DataType: schemapb.DataType_VarChar, TypeParams: []*commonpb.KeyValuePair{{Key: common.MaxLengthKey, Value: "128"}}},
+		},
+	}
+
+	for _, expr := range []string{"pk > 1", `other == "a"`, ""} {
+		plan, err := planparserv2.CreateRetrievePlan(schema, expr)
+		assert.NoError(t, err)
+		assert.NoError(t, checkSidePayloadFilter(schema, plan), expr)
+	}
+	for _, expr := range []string{`text == "a"`, `pk > 1 && text like "a%"`, `doc["a"] == 1`, `not (pk in [1, 2] or doc["a"] > 1)`} {
+		plan, err := planparserv2.CreateRetrievePlan(schema, expr)
+		assert.NoError(t, err)
+		assert.Error(t, checkSidePayloadFilter(schema, plan), expr)
+	}
+}
@@ -0,0 +1,75 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
+)
+
+// maxRecentSlowQueries is the number of recent slow queries kept in the proxy.
+const maxRecentSlowQueries = 20
+
+// slowQueryRecorder keeps the recent slow queries in a ring, they're reported along with the proxy metrics.
+type slowQueryRecorder struct {
+	mu      sync.Mutex
+	queries []metricsinfo.SlowQueryInfo
+	next    int
+}
+
+func newSlowQueryRecorder() *slowQueryRecorder {
+	return &slowQueryRecorder{
+		queries: make([]metricsinfo.SlowQueryInfo, 0, maxRecentSlowQueries),
+	}
+}
+
+func (r *slowQueryRecorder) record(method, db, collection string, duration time.Duration) {
+	if r == nil {
+		return
+	}
+	query := metricsinfo.SlowQueryInfo{
+		Method:     method,
+		Database:   db,
+		Collection: collection,
+		StartTime:  time.Now().Add(-duration).Format(time.RFC3339),
+		DurationMs: duration.Milliseconds(),
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.queries) < maxRecentSlowQueries {
+		r.queries = append(r.queries, query)
+		return
+	}
+	r.queries[r.next] = query
+	r.next = (r.next + 1) % maxRecentSlowQueries
+}
+
+// list returns the recent slow queries, the latest first.
+func (r *slowQueryRecorder) list() []metricsinfo.SlowQueryInfo {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ret := make([]metricsinfo.SlowQueryInfo, 0, len(r.queries))
+	for i := len(r.queries) - 1; i >= 0; i-- {
+		ret = append(ret, r.queries[(r.next+i)%len(r.queries)])
+	}
+	return ret
+}
@@ -0,0 +1,43 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlowQueryRecorder(t *testing.T) {
+	var nilRecorder *slowQueryRecorder
+	nilRecorder.record("Search", "default", "c", time.Second)
+	assert.Empty(t, nilRecorder.list())
+
+	r := newSlowQueryRecorder()
+	assert.Empty(t, r.list())
+	for i := 0; i < maxRecentSlowQueries+5; i++ {
+		r.record("Search", "default", fmt.Sprint(i), time.Duration(i)*time.Second)
+	}
+	queries := r.list()
+	assert.Equal(t, maxRecentSlowQueries, len(queries))
+	// the latest first
+	assert.Equal(t, fmt.Sprint(maxRecentSlowQueries+4), queries[0].Collection)
+	assert.EqualValues(t, (maxRecentSlowQueries+4)*1000, queries[0].DurationMs)
+	assert.Equal(t, "5", queries[len(queries)-1].Collection)
+}
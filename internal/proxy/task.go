@@ -53,6 +53,8 @@ const (
 	RoundDecimalKey                 = "round_decimal"
 	OffsetKey                       = "offset"
 	LimitKey                        = "limit"
+	ExactSearchKey                  = "exact_search"
+	DedupKey                        = "dedup"
 
 	InsertTaskName                = "InsertTask"
 	CreateCollectionTaskName      = "CreateCollectionTask"
@@ -280,6 +282,9 @@ func (cct *createCollectionTask) PreExecute(ctx context.Context) error {
 				return err
 			}
 		}
+		if err := validateSidePayloadField(field); err != nil {
+			return err
+		}
 	}
 
 	if err := validateMultipleVectorFields(cct.schema); err != nil {
@@ -137,13 +137,32 @@ func (cit *createIndexTask) parseIndexParams() error {
 			indexParamsMap[kv.Key] = kv.Value
 		}
 	}
+
+	// the load policy doesn't affect how the index is built, keep it aside from the index type checking
+	loadPolicy, hasLoadPolicy := indexParamsMap[common.IndexLoadPolicyKey]
+	if hasLoadPolicy {
+		if loadPolicy != common.IndexLoadPolicyEager && loadPolicy != common.IndexLoadPolicyLazy {
+			return merr.WrapErrParameterInvalid("eager or lazy", loadPolicy, "invalid index load policy")
+		}
+		if loadPolicy == common.IndexLoadPolicyLazy && cit.fieldSchema.GetIsPrimaryKey() {
+			return merr.WrapErrParameterInvalid(common.IndexLoadPolicyEager, loadPolicy, "index of primary key must be loaded eagerly")
+		}
+		delete(indexParamsMap, common.IndexLoadPolicyKey)
+	}
+
 	if !isVecIndex {
+		// the segments keep the references of the values stored aside only
+		if typeutil.IsSidePayloadField(cit.fieldSchema) {
+			return merr.WrapErrParameterInvalid("field without side payload", cit.fieldSchema.GetName(),
+				"the side payload field can't be indexed")
+		}
 		specifyIndexType, exist := indexParamsMap[common.IndexTypeKey]
 		if cit.fieldSchema.DataType == schemapb.DataType_VarChar {
-			if exist && specifyIndexType != DefaultStringIndexType {
+			if !exist {
+				indexParamsMap[common.IndexTypeKey] = DefaultStringIndexType
+			} else if specifyIndexType != DefaultStringIndexType && specifyIndexType != indexparamcheck.IndexNGRAM {
 				return merr.WrapErrParameterInvalid(DefaultStringIndexType, specifyIndexType, "index type not match")
 			}
-			indexParamsMap[common.IndexTypeKey] = DefaultStringIndexType
 		} else {
 			if cit.fieldSchema.DataType == schemapb.DataType_JSON {
 				return merr.WrapErrParameterInvalid("not json field", "create index on json field", "create index on json field is not supported")
@@ -249,6 +268,9 @@ func (cit *createIndexTask) parseIndexParams() error {
 		typeParamsMap[pair.Key] = pair.Value
 	}
 
+	if hasLoadPolicy {
+		indexParamsMap[common.IndexLoadPolicyKey] = loadPolicy
+	}
 	for k, v := range indexParamsMap {
 		//Currently, it is required that type_params and index_params do not have same keys.
 		if k == DimKey || k == common.MaxLengthKey {
@@ -35,6 +35,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/config"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/indexparamcheck"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
@@ -595,6 +596,80 @@ func Test_parseIndexParams(t *testing.T) {
 		err = cit5.parseIndexParams()
 		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
 	})
+
+	t.Run("ngram index on varchar", func(t *testing.T) {
+		cit := &createIndexTask{
+			req: &milvuspb.CreateIndexRequest{
+				ExtraParams: []*commonpb.KeyValuePair{
+					{
+						Key:   common.IndexTypeKey,
+						Value: indexparamcheck.IndexNGRAM,
+					},
+				},
+			},
+			fieldSchema: &schemapb.FieldSchema{
+				FieldID:  101,
+				Name:     "FieldID",
+				DataType: schemapb.DataType_VarChar,
+			},
+		}
+		err := cit.parseIndexParams()
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []*commonpb.KeyValuePair{
+			{
+				Key:   common.IndexTypeKey,
+				Value: indexparamcheck.IndexNGRAM,
+			},
+		}, cit.newIndexParams)
+
+		cit.fieldSchema.DataType = schemapb.DataType_Int64
+		err = cit.parseIndexParams()
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("load policy", func(t *testing.T) {
+		newTask := func(loadPolicy string, isPrimaryKey bool) *createIndexTask {
+			return &createIndexTask{
+				req: &milvuspb.CreateIndexRequest{
+					ExtraParams: []*commonpb.KeyValuePair{
+						{
+							Key:   common.IndexLoadPolicyKey,
+							Value: loadPolicy,
+						},
+					},
+				},
+				fieldSchema: &schemapb.FieldSchema{
+					FieldID:      101,
+					Name:         "FieldID",
+					IsPrimaryKey: isPrimaryKey,
+					DataType:     schemapb.DataType_Int64,
+				},
+			}
+		}
+
+		cit := newTask(common.IndexLoadPolicyLazy, false)
+		err := cit.parseIndexParams()
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []*commonpb.KeyValuePair{
+			{
+				Key:   common.IndexTypeKey,
+				Value: DefaultIndexType,
+			},
+			{
+				Key:   common.IndexLoadPolicyKey,
+				Value: common.IndexLoadPolicyLazy,
+			},
+		}, cit.newIndexParams)
+
+		err = newTask("unknown", false).parseIndexParams()
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+		err = newTask(common.IndexLoadPolicyLazy, true).parseIndexParams()
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+		err = newTask(common.IndexLoadPolicyEager, true).parseIndexParams()
+		assert.NoError(t, err)
+	})
 }
 
 func Test_wrapUserIndexParams(t *testing.T) {
@@ -37,6 +37,7 @@ type insertTask struct {
 	pChannels     []pChan
 	schema        *schemapb.CollectionSchema
 	partitionKeys *schemapb.FieldData
+	sidePayload   *sidePayloadStore
 }
 
 // TraceCtx returns insertTask context
@@ -201,11 +202,27 @@ func (it *insertTask) PreExecute(ctx context.Context) error {
 		}
 	}
 
-	if err := newValidateUtil(withNANCheck(), withOverflowCheck(), withMaxLenCheck()).
+	collInfo, err := globalMetaCache.GetCollectionInfo(ctx, it.insertMsg.GetDbName(), collectionName)
+	if err != nil {
+		log.Warn("get collection info from global meta cache failed", zap.String("collectionName", collectionName), zap.Error(err))
+		return err
+	}
+	if err := newValidateUtil(withNANCheck(), withOverflowCheck(), withMaxLenCheck(), withUTF8Check(),
+		withValidationProfile(getValidationProfile(collInfo.properties), collectionName)).
 		Validate(it.insertMsg.GetFieldsData(), schema, it.insertMsg.NRows()); err != nil {
 		return err
 	}
 
+	if err := checkSidePayloadValues(schema, it.insertMsg.GetFieldsData()); err != nil {
+		return err
+	}
+
+	// store the oversized values aside after the validation, the stored rows carry the references
+	if err := it.sidePayload.offload(ctx, collInfo.collID, schema, it.insertMsg.GetFieldsData(), it.insertMsg.RowIDs); err != nil {
+		log.Warn("store oversized values aside failed", zap.Error(err))
+		return err
+	}
+
 	log.Debug("Proxy Insert PreExecute done")
 
 	return nil
@@ -66,6 +66,7 @@ type queryTask struct {
 type queryParams struct {
 	limit  int64
 	offset int64
+	dedup  bool
 }
 
 // translateToOutputFieldIDs translates output fields name to output fields id.
@@ -125,18 +126,28 @@ func filterSystemFields(outputFieldIDs []UniqueID) []UniqueID {
 	return filtered
 }
 
-// parseQueryParams get limit and offset from queryParamsPair, both are optional.
+// parseQueryParams get limit, offset and dedup from queryParamsPair, all are optional.
 func parseQueryParams(queryParamsPair []*commonpb.KeyValuePair) (*queryParams, error) {
 	var (
 		limit  int64
 		offset int64
+		dedup  bool
 		err    error
 	)
 
+	dedupStr, err := funcutil.GetAttrByKeyFromRepeatedKV(DedupKey, queryParamsPair)
+	// if dedup is provided
+	if err == nil {
+		dedup, err = strconv.ParseBool(dedupStr)
+		if err != nil {
+			return nil, fmt.Errorf("%s [%s] is invalid", DedupKey, dedupStr)
+		}
+	}
+
 	limitStr, err := funcutil.GetAttrByKeyFromRepeatedKV(LimitKey, queryParamsPair)
 	// if limit is not provided
 	if err != nil {
-		return &queryParams{limit: typeutil.Unlimited}, nil
+		return &queryParams{limit: typeutil.Unlimited, dedup: dedup}, nil
 	}
 	limit, err = strconv.ParseInt(limitStr, 0, 64)
 	if err != nil {
@@ -160,6 +171,7 @@ func parseQueryParams(queryParamsPair []*commonpb.KeyValuePair) (*queryParams, e
 	return &queryParams{
 		limit:  limit,
 		offset: offset,
+		dedup:  dedup,
 	}, nil
 }
 
@@ -183,6 +195,9 @@ func createCntPlan(expr string, schema *schemapb.CollectionSchema) (*planpb.Plan
 	if err != nil {
 		return nil, err
 	}
+	if err := checkSidePayloadFilter(schema, plan); err != nil {
+		return nil, err
+	}
 
 	plan.Node.(*planpb.PlanNode_Query).Query.IsCount = true
 
@@ -207,6 +222,9 @@ func (t *queryTask) createPlan(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	if err := checkSidePayloadFilter(schema, plan); err != nil {
+		return err
+	}
 
 	t.request.OutputFields, t.userOutputFields, err = translateOutputFields(t.request.OutputFields, schema, true)
 	if err != nil {
@@ -304,6 +322,7 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	}
 	t.queryParams = queryParams
 	t.RetrieveRequest.Limit = queryParams.limit + queryParams.offset
+	t.RetrieveRequest.Dedup = queryParams.dedup
 
 	schema, _ := globalMetaCache.GetCollectionSchema(ctx, t.request.GetDbName(), t.collectionName)
 	t.schema = schema
@@ -553,21 +572,31 @@ func reduceRetrieveResults(ctx context.Context, retrieveResults []*internalpb.Re
 	idSet := make(map[interface{}]struct{})
 	cursors := make([]int64, len(validRetrieveResults))
 
+	// with dedup, duplicates are not counted into offset and limit
+	dedup := queryParams != nil && queryParams.dedup
 	if queryParams != nil && queryParams.limit != typeutil.Unlimited {
 		loopEnd = int(queryParams.limit)
 
-		if queryParams.offset > 0 {
-			for i := int64(0); i < queryParams.offset; i++ {
-				sel := typeutil.SelectMinPK(validRetrieveResults, cursors)
-				if sel == -1 {
-					return ret, nil
+		for i := int64(0); i < queryParams.offset; {
+			sel := typeutil.SelectMinPK(validRetrieveResults, cursors)
+			if sel == -1 {
+				return ret, nil
+			}
+			if dedup {
+				pk := typeutil.GetPK(validRetrieveResults[sel].GetIds(), cursors[sel])
+				if _, ok := idSet[pk]; ok {
+					skipDupCnt++
+					cursors[sel]++
+					continue
 				}
-				cursors[sel]++
+				idSet[pk] = struct{}{}
 			}
+			i++
+			cursors[sel]++
 		}
 	}
 
-	for j := 0; j < loopEnd; j++ {
+	for j := 0; j < loopEnd; {
 		sel := typeutil.SelectMinPK(validRetrieveResults, cursors)
 		if sel == -1 {
 			break
@@ -577,9 +606,13 @@ func reduceRetrieveResults(ctx context.Context, retrieveResults []*internalpb.Re
 		if _, ok := idSet[pk]; !ok {
 			typeutil.AppendFieldData(ret.FieldsData, validRetrieveResults[sel].GetFieldsData(), cursors[sel])
 			idSet[pk] = struct{}{}
+			j++
 		} else {
 			// primary keys duplicate
 			skipDupCnt++
+			if !dedup {
+				j++
+			}
 		}
 		cursors[sel]++
 	}
@@ -573,6 +573,43 @@ func TestTaskQuery_functions(t *testing.T) {
 				}
 			})
 		})
+
+		t.Run("test duplicates", func(t *testing.T) {
+			// the same entities from growing and sealed segments
+			r1 := &internalpb.RetrieveResults{
+				Ids: &schemapb.IDs{
+					IdField: &schemapb.IDs_IntId{
+						IntId: &schemapb.LongArray{
+							Data: []int64{1, 2, 3},
+						},
+					},
+				},
+				FieldsData: []*schemapb.FieldData{getFieldData(Int64FieldName, Int64FieldID, schemapb.DataType_Int64, []int64{10, 20, 30}, 1)},
+			}
+			r2 := &internalpb.RetrieveResults{
+				Ids: &schemapb.IDs{
+					IdField: &schemapb.IDs_IntId{
+						IntId: &schemapb.LongArray{
+							Data: []int64{2, 3, 4},
+						},
+					},
+				},
+				FieldsData: []*schemapb.FieldData{getFieldData(Int64FieldName, Int64FieldID, schemapb.DataType_Int64, []int64{20, 30, 40}, 1)},
+			}
+
+			// duplicates are counted into offset and limit by default
+			result, err := reduceRetrieveResults(context.Background(), []*internalpb.RetrieveResults{r1, r2}, &queryParams{limit: 2, offset: 1})
+			assert.NoError(t, err)
+			assert.Equal(t, []int64{20}, result.GetFieldsData()[0].GetScalars().GetLongData().Data)
+
+			result, err = reduceRetrieveResults(context.Background(), []*internalpb.RetrieveResults{r1, r2}, &queryParams{limit: 2, offset: 1, dedup: true})
+			assert.NoError(t, err)
+			assert.Equal(t, []int64{20, 30}, result.GetFieldsData()[0].GetScalars().GetLongData().Data)
+
+			result, err = reduceRetrieveResults(context.Background(), []*internalpb.RetrieveResults{r1, r2}, &queryParams{limit: typeutil.Unlimited})
+			assert.NoError(t, err)
+			assert.Equal(t, []int64{10, 20, 30, 40}, result.GetFieldsData()[0].GetScalars().GetLongData().Data)
+		})
 	})
 }
 
@@ -2,6 +2,7 @@ package proxy
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math"
 	"regexp"
@@ -38,11 +39,9 @@ const (
 	SearchTaskName = "SearchTask"
 	SearchLevelKey = "level"
 
-	// requeryThreshold is the estimated threshold for the size of the search results.
-	// If the number of estimated search results exceeds this threshold,
-	// a second query request will be initiated to retrieve output fields data.
-	// In this case, the first search will not return any output field from QueryNodes.
-	requeryThreshold = 0.5 * 1024 * 1024
+	// varLengthFieldAvgSize is the average size in bytes of a VarChar, Array or JSON value assumed when
+	// estimating the result size, which is far less than the max length in most cases
+	varLengthFieldAvgSize = 64
 )
 
 type searchTask struct {
@@ -61,6 +60,7 @@ type searchTask struct {
 	userOutputFields []string
 
 	offset    int64
+	dedup     searchDedupMode
 	resultBuf *typeutil.ConcurrentSet[*internalpb.SearchResults]
 
 	qc   types.QueryCoord
@@ -161,6 +161,19 @@ func parseSearchInfo(searchParamsPair []*commonpb.KeyValuePair) (*planpb.QueryIn
 	if err != nil {
 		searchParamStr = ""
 	}
+	if exactStr, err := funcutil.GetAttrByKeyFromRepeatedKV(ExactSearchKey, searchParamsPair); err == nil {
+		exact, err := strconv.ParseBool(exactStr)
+		if err != nil {
+			return nil, 0, fmt.Errorf("%s [%s] is invalid", ExactSearchKey, exactStr)
+		}
+		if exact {
+			exactParamStr, err := withExactSearch(searchParamStr)
+			if err != nil {
+				return nil, 0, fmt.Errorf("%s [%s] is invalid, %w", SearchParamsKey, searchParamStr, err)
+			}
+			searchParamStr = exactParamStr
+		}
+	}
 	return &planpb.QueryInfo{
 		Topk:         queryTopK,
 		MetricType:   metricType,
@@ -169,6 +182,23 @@ func parseSearchInfo(searchParamsPair []*commonpb.KeyValuePair) (*planpb.QueryIn
 	}, offset, nil
 }
 
+// withExactSearch marks the search params to bypass the vector indexes,
+// so that the raw vectors are searched by brute force, see SearchInfo::exact_search_ of segcore.
+func withExactSearch(searchParamStr string) (string, error) {
+	params := make(map[string]interface{})
+	if searchParamStr != "" {
+		if err := json.Unmarshal([]byte(searchParamStr), &params); err != nil {
+			return "", err
+		}
+	}
+	params[ExactSearchKey] = true
+	bs, err := json.Marshal(params)
+	if err != nil {
+		return "", err
+	}
+	return string(bs), nil
+}
+
 func getOutputFieldIDs(schema *schemapb.CollectionSchema, outputFields []string) (outputFieldIDs []UniqueID, err error) {
 	outputFieldIDs = make([]UniqueID, 0, len(outputFields))
 	for _, name := range outputFields {
@@ -240,18 +270,32 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 	log.Debug("translate output fields",
 		zap.Strings("output fields", t.request.GetOutputFields()))
 
-	// fetch search_growing from search param
+	// fetch search_growing and dedup from search param,
+	// the keys are filtered out of a copy so that the params of the request aren't modified in place
 	var ignoreGrowing bool
-	for i, kv := range t.request.GetSearchParams() {
-		if kv.GetKey() == IgnoreGrowingKey {
+	searchParams := make([]*commonpb.KeyValuePair, 0, len(t.request.GetSearchParams()))
+	for _, kv := range t.request.GetSearchParams() {
+		switch kv.GetKey() {
+		case IgnoreGrowingKey:
 			ignoreGrowing, err = strconv.ParseBool(kv.GetValue())
 			if err != nil {
 				return errors.New("parse search growing failed")
 			}
-			t.request.SearchParams = append(t.request.GetSearchParams()[:i], t.request.GetSearchParams()[i+1:]...)
-			break
+		case DedupKey:
+			dedup, err := strconv.ParseBool(kv.GetValue())
+			if err != nil {
+				return errors.New("parse search dedup failed")
+			}
+			if dedup {
+				t.dedup = searchDedupAll
+			} else {
+				t.dedup = searchDedupNone
+			}
+		default:
+			searchParams = append(searchParams, kv)
 		}
 	}
+	t.request.SearchParams = searchParams
 	t.SearchRequest.IgnoreGrowing = ignoreGrowing
 
 	// Manually update nq if not set.
@@ -303,6 +347,9 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 		log.Debug("create query plan",
 			zap.String("dsl", t.request.Dsl), // may be very large if large term passed.
 			zap.String("anns field", annsField), zap.Any("query info", queryInfo))
+		if err := checkSidePayloadFilter(t.schema, plan); err != nil {
+			return err
+		}
 
 		if partitionKeyMode {
 			expr, err := ParseExprFromPlan(plan)
@@ -331,7 +378,10 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 			log.Warn("failed to estimate result size", zap.Error(err))
 			return err
 		}
-		if estimateSize >= requeryThreshold {
+		// If the estimated size of the output fields exceeds the threshold, a second query request
+		// will be initiated to retrieve output fields data only for the merged top-K results.
+		// In this case, the first search will not return any output field from QueryNodes.
+		if estimateSize > 0 && estimateSize >= Params.ProxyCfg.SearchLazyFetchThreshold.GetAsInt64() {
 			t.requery = true
 			plan.OutputFieldIds = nil
 		}
@@ -487,7 +537,7 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 		return err
 	}
 
-	t.result, err = reduceSearchResultData(ctx, validSearchResults, Nq, Topk, MetricType, primaryFieldSchema.DataType, t.offset)
+	t.result, err = reduceSearchResultData(ctx, validSearchResults, Nq, Topk, MetricType, primaryFieldSchema.DataType, t.offset, t.dedup)
 	if err != nil {
 		log.Warn("failed to reduce search results", zap.Error(err))
 		return err
@@ -551,26 +601,40 @@ func (t *searchTask) searchShard(ctx context.Context, nodeID int64, qn types.Que
 	return nil
 }
 
+// estimateResultSize estimates the size of the output fields returned by each shard.
 func (t *searchTask) estimateResultSize(nq int64, topK int64) (int64, error) {
-	vectorOutputFields := lo.Filter(t.schema.GetFields(), func(field *schemapb.FieldSchema, _ int) bool {
-		return lo.Contains(t.request.GetOutputFields(), field.GetName()) && typeutil.IsVectorType(field.GetDataType())
+	outputFields := lo.Filter(t.schema.GetFields(), func(field *schemapb.FieldSchema, _ int) bool {
+		return lo.Contains(t.request.GetOutputFields(), field.GetName())
 	})
 	// Currently, we get vectors by requery. Once we support getting vectors from search,
 	// searches with small result size could no longer need requery.
-	if len(vectorOutputFields) > 0 {
+	if lo.ContainsBy(outputFields, func(field *schemapb.FieldSchema) bool { return typeutil.IsVectorType(field.GetDataType()) }) {
 		return math.MaxInt64, nil
 	}
-	// If no vector field as output, no need to requery.
-	return 0, nil
-
-	//outputFields := lo.Filter(t.schema.GetFields(), func(field *schemapb.FieldSchema, _ int) bool {
-	//	return lo.Contains(t.request.GetOutputFields(), field.GetName())
-	//})
-	//sizePerRecord, err := typeutil.EstimateSizePerRecord(&schemapb.CollectionSchema{Fields: outputFields})
-	//if err != nil {
-	//	return 0, err
-	//}
-	//return int64(sizePerRecord) * nq * topK, nil
+	if Params.ProxyCfg.SearchLazyFetchThreshold.GetAsInt64() <= 0 {
+		return 0, nil
+	}
+
+	// The variable-length fields are estimated by the average size instead of the max length,
+	// otherwise searches outputting any VarChar or JSON field always requery.
+	sizePerRecord := 0
+	for _, field := range outputFields {
+		switch field.GetDataType() {
+		case schemapb.DataType_VarChar, schemapb.DataType_Array, schemapb.DataType_JSON:
+			size := varLengthFieldAvgSize
+			if maxLength, err := typeutil.GetAvgLengthOfVarLengthField(field); err == nil && maxLength < size {
+				size = maxLength
+			}
+			sizePerRecord += size
+		default:
+			size, err := typeutil.EstimateSizePerRecord(&schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{field}})
+			if err != nil {
+				return 0, err
+			}
+			sizePerRecord += size
+		}
+	}
+	return int64(sizePerRecord) * nq * topK, nil
 }
 
 func (t *searchTask) Requery() error {
@@ -761,7 +825,31 @@ func selectHighestScoreIndex(subSearchResultData []*schemapb.SearchResultData, s
 	return subSearchIdx, resultDataIdx
 }
 
-func reduceSearchResultData(ctx context.Context, subSearchResultData []*schemapb.SearchResultData, nq int64, topk int64, metricType string, pkType schemapb.DataType, offset int64) (*milvuspb.SearchResults, error) {
+// searchDedupMode decides how the hits with duplicated primary keys are reduced by proxy,
+// note that QueryNodes always remove the duplicates among the segments they search.
+type searchDedupMode int
+
+const (
+	// searchDedupLimit removes the duplicates from the kept results but counts them into offset, used if dedup isn't specified.
+	searchDedupLimit searchDedupMode = iota
+	// searchDedupAll removes the duplicates without counting them into offset or limit, used with dedup=true.
+	searchDedupAll
+	// searchDedupNone keeps the hits as they are returned by the shards, used with dedup=false.
+	searchDedupNone
+)
+
+func (m searchDedupMode) String() string {
+	switch m {
+	case searchDedupAll:
+		return "all"
+	case searchDedupNone:
+		return "none"
+	default:
+		return "limit"
+	}
+}
+
+func reduceSearchResultData(ctx context.Context, subSearchResultData []*schemapb.SearchResultData, nq int64, topk int64, metricType string, pkType schemapb.DataType, offset int64, dedup searchDedupMode) (*milvuspb.SearchResults, error) {
 	tr := timerecord.NewTimeRecorder("reduceSearchResultData")
 	defer func() {
 		tr.CtxElapse(ctx, "done")
@@ -773,6 +861,7 @@ func reduceSearchResultData(ctx context.Context, subSearchResultData []*schemapb
 		zap.Int64("nq", nq),
 		zap.Int64("offset", offset),
 		zap.Int64("limit", limit),
+		zap.Stringer("dedup", dedup),
 		zap.String("metricType", metricType))
 
 	ret := &milvuspb.SearchResults{
@@ -848,13 +937,23 @@ func reduceSearchResultData(ctx context.Context, subSearchResultData []*schemapb
 			idSet = make(map[interface{}]struct{})
 		)
 
-		// skip offset results
-		for k := int64(0); k < offset; k++ {
-			subSearchIdx, _ := selectHighestScoreIndex(subSearchResultData, subSearchNqOffset, cursors, i)
+		// skip offset results, with dedup duplicates are not counted so that they don't show up again in the kept results
+		for k := int64(0); k < offset; {
+			subSearchIdx, resultDataIdx := selectHighestScoreIndex(subSearchResultData, subSearchNqOffset, cursors, i)
 			if subSearchIdx == -1 {
 				break
 			}
 
+			if dedup == searchDedupAll {
+				id := typeutil.GetPK(subSearchResultData[subSearchIdx].GetIds(), resultDataIdx)
+				if _, ok := idSet[id]; ok {
+					skipDupCnt++
+					cursors[subSearchIdx]++
+					continue
+				}
+				idSet[id] = struct{}{}
+			}
+			k++
 			cursors[subSearchIdx]++
 		}
 
@@ -871,8 +970,8 @@ func reduceSearchResultData(ctx context.Context, subSearchResultData []*schemapb
 			id := typeutil.GetPK(subSearchResultData[subSearchIdx].GetIds(), resultDataIdx)
 			score := subSearchResultData[subSearchIdx].Scores[resultDataIdx]
 
-			// remove duplicates
-			if _, ok := idSet[id]; !ok {
+			// remove duplicates unless the raw hits are asked for
+			if _, ok := idSet[id]; !ok || dedup == searchDedupNone {
 				typeutil.AppendFieldData(ret.Results.FieldsData, subSearchResultData[subSearchIdx].FieldsData, resultDataIdx)
 				typeutil.AppendPKs(ret.Results.Ids, id)
 				ret.Results.Scores = append(ret.Results.Scores, score)
@@ -18,6 +18,7 @@ package proxy
 import (
 	"context"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"testing"
@@ -231,6 +232,29 @@ func TestSearchTask_PreExecute(t *testing.T) {
 		assert.Error(t, err)
 	})
 
+	t.Run("dedup param", func(t *testing.T) {
+		collName := "test_dedup_param" + funcutil.GenRandomStr()
+		createColl(t, collName, rc)
+
+		task := getSearchTask(t, collName)
+		params := append(getValidSearchParams(), &commonpb.KeyValuePair{Key: DedupKey, Value: "false"})
+		task.request.SearchParams = params
+		assert.NoError(t, task.PreExecute(ctx))
+		assert.Equal(t, searchDedupNone, task.dedup)
+		assert.False(t, task.SearchRequest.IgnoreGrowing)
+		// the params of the request are filtered on a copy
+		assert.Equal(t, IgnoreGrowingKey, params[len(params)-2].GetKey())
+		assert.Equal(t, DedupKey, params[len(params)-1].GetKey())
+		for _, kv := range task.request.GetSearchParams() {
+			assert.NotEqual(t, DedupKey, kv.GetKey())
+			assert.NotEqual(t, IgnoreGrowingKey, kv.GetKey())
+		}
+
+		task = getSearchTask(t, collName)
+		task.request.SearchParams = append(getValidSearchParams(), &commonpb.KeyValuePair{Key: DedupKey, Value: "invalid"})
+		assert.Error(t, task.PreExecute(ctx))
+	})
+
 	t.Run("search with timeout", func(t *testing.T) {
 		collName := "search_with_timeout" + funcutil.GenRandomStr()
 		createColl(t, collName, rc)
@@ -1441,7 +1465,7 @@ func TestTaskSearch_reduceSearchResultData(t *testing.T) {
 
 		for _, test := range tests {
 			t.Run(test.description, func(t *testing.T) {
-				reduced, err := reduceSearchResultData(context.TODO(), results, nq, topk, metric.L2, schemapb.DataType_Int64, test.offset)
+				reduced, err := reduceSearchResultData(context.TODO(), results, nq, topk, metric.L2, schemapb.DataType_Int64, test.offset, searchDedupLimit)
 				assert.NoError(t, err)
 				assert.Equal(t, test.outData, reduced.GetResults().GetIds().GetIntId().GetData())
 				assert.Equal(t, []int64{test.limit, test.limit}, reduced.GetResults().GetTopks())
@@ -1481,7 +1505,7 @@ func TestTaskSearch_reduceSearchResultData(t *testing.T) {
 
 		for _, test := range lessThanLimitTests {
 			t.Run(test.description, func(t *testing.T) {
-				reduced, err := reduceSearchResultData(context.TODO(), results, nq, topk, metric.L2, schemapb.DataType_Int64, test.offset)
+				reduced, err := reduceSearchResultData(context.TODO(), results, nq, topk, metric.L2, schemapb.DataType_Int64, test.offset, searchDedupLimit)
 				assert.NoError(t, err)
 				assert.Equal(t, test.outData, reduced.GetResults().GetIds().GetIntId().GetData())
 				assert.Equal(t, []int64{test.outLimit, test.outLimit}, reduced.GetResults().GetTopks())
@@ -1491,6 +1515,38 @@ func TestTaskSearch_reduceSearchResultData(t *testing.T) {
 		}
 	})
 
+	t.Run("Duplicates with offset", func(t *testing.T) {
+		// the same entities from growing and sealed segments
+		r1 := getSearchResultData(1, 4)
+		r1.Ids.IdField = &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{5, 4, 3, 1}}}
+		r1.Scores = []float32{5, 4, 3, 1}
+		r1.Topks = []int64{4}
+		r2 := getSearchResultData(1, 4)
+		r2.Ids.IdField = &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{5, 4, 2, 0}}}
+		r2.Scores = []float32{5, 4, 2, 0}
+		r2.Topks = []int64{4}
+
+		// the skipped duplicate is counted into the offset by default
+		reduced, err := reduceSearchResultData(context.TODO(), []*schemapb.SearchResultData{r1, r2}, 1, 4, metric.L2, schemapb.DataType_Int64, 1, searchDedupLimit)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{5, 4, 3}, reduced.GetResults().GetIds().GetIntId().GetData())
+		assert.Equal(t, []int64{3}, reduced.GetResults().GetTopks())
+		assert.InDeltaSlice(t, []float32{-5, -4, -3}, reduced.GetResults().GetScores(), 10e-8)
+
+		reduced, err = reduceSearchResultData(context.TODO(), []*schemapb.SearchResultData{r1, r2}, 1, 4, metric.L2, schemapb.DataType_Int64, 1, searchDedupAll)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{4, 3, 2}, reduced.GetResults().GetIds().GetIntId().GetData())
+		assert.Equal(t, []int64{3}, reduced.GetResults().GetTopks())
+		assert.InDeltaSlice(t, []float32{-4, -3, -2}, reduced.GetResults().GetScores(), 10e-8)
+
+		// the raw hits are returned without dedup
+		reduced, err = reduceSearchResultData(context.TODO(), []*schemapb.SearchResultData{r1, r2}, 1, 4, metric.L2, schemapb.DataType_Int64, 1, searchDedupNone)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{5, 4, 4}, reduced.GetResults().GetIds().GetIntId().GetData())
+		assert.Equal(t, []int64{3}, reduced.GetResults().GetTopks())
+		assert.InDeltaSlice(t, []float32{-5, -4, -4}, reduced.GetResults().GetScores(), 10e-8)
+	})
+
 	t.Run("Int64 ID", func(t *testing.T) {
 		resultData := []int64{50, 49, 48, 47, 46, 45, 44, 43, 42, 41}
 
@@ -1505,7 +1561,7 @@ func TestTaskSearch_reduceSearchResultData(t *testing.T) {
 			results = append(results, r)
 		}
 
-		reduced, err := reduceSearchResultData(context.TODO(), results, nq, topk, metric.L2, schemapb.DataType_Int64, 0)
+		reduced, err := reduceSearchResultData(context.TODO(), results, nq, topk, metric.L2, schemapb.DataType_Int64, 0, searchDedupLimit)
 
 		assert.NoError(t, err)
 		assert.Equal(t, resultData, reduced.GetResults().GetIds().GetIntId().GetData())
@@ -1532,7 +1588,7 @@ func TestTaskSearch_reduceSearchResultData(t *testing.T) {
 			results = append(results, r)
 		}
 
-		reduced, err := reduceSearchResultData(context.TODO(), results, nq, topk, metric.L2, schemapb.DataType_VarChar, 0)
+		reduced, err := reduceSearchResultData(context.TODO(), results, nq, topk, metric.L2, schemapb.DataType_VarChar, 0, searchDedupLimit)
 
 		assert.NoError(t, err)
 		assert.Equal(t, resultData, reduced.GetResults().GetIds().GetStrId().GetData())
@@ -2136,3 +2192,41 @@ func TestSearchTask_Requery(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestSearchTask_EstimateResultSize(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "128"}}},
+			{FieldID: 102, Name: "json", DataType: schemapb.DataType_JSON},
+			{FieldID: 103, Name: "short", DataType: schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.MaxLengthKey, Value: "16"}}},
+			{FieldID: 104, Name: "long", DataType: schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.MaxLengthKey, Value: "65535"}}},
+		},
+	}
+	estimate := func(outputFields ...string) int64 {
+		task := &searchTask{
+			schema:  schema,
+			request: &milvuspb.SearchRequest{OutputFields: outputFields},
+		}
+		size, err := task.estimateResultSize(10, 100)
+		assert.NoError(t, err)
+		return size
+	}
+
+	assert.EqualValues(t, 0, estimate())
+	assert.EqualValues(t, 8*10*100, estimate("pk"))
+	assert.EqualValues(t, varLengthFieldAvgSize*10*100, estimate("json"))
+	assert.EqualValues(t, 16*10*100, estimate("short"))
+	assert.EqualValues(t, (8+varLengthFieldAvgSize*2)*10*100, estimate("pk", "json", "long"))
+	// vectors are always fetched lazily
+	assert.EqualValues(t, math.MaxInt64, estimate("pk", "vec"))
+
+	params := paramtable.Get()
+	params.Save(params.ProxyCfg.SearchLazyFetchThreshold.Key, "0")
+	defer params.Reset(params.ProxyCfg.SearchLazyFetchThreshold.Key)
+	assert.EqualValues(t, 0, estimate("json"))
+	assert.EqualValues(t, math.MaxInt64, estimate("vec"))
+}
@@ -61,6 +61,7 @@ type upsertTask struct {
 	schema           *schemapb.CollectionSchema
 	partitionKeyMode bool
 	partitionKeys    *schemapb.FieldData
+	sidePayload      *sidePayloadStore
 }
 
 // TraceCtx returns upsertTask context
@@ -205,11 +206,28 @@ func (it *upsertTask) insertPreExecute(ctx context.Context) error {
 		}
 	}
 
-	if err := newValidateUtil(withNANCheck(), withOverflowCheck(), withMaxLenCheck()).
+	collInfo, err := globalMetaCache.GetCollectionInfo(ctx, it.req.GetDbName(), collectionName)
+	if err != nil {
+		log.Warn("get collection info from global meta cache failed", zap.String("collectionName", collectionName), zap.Error(err))
+		return err
+	}
+	if err := newValidateUtil(withNANCheck(), withOverflowCheck(), withMaxLenCheck(), withUTF8Check(),
+		withValidationProfile(getValidationProfile(collInfo.properties), collectionName)).
 		Validate(it.upsertMsg.InsertMsg.GetFieldsData(), it.schema, it.upsertMsg.InsertMsg.NRows()); err != nil {
 		return err
 	}
 
+	if err := checkSidePayloadValues(it.schema, it.upsertMsg.InsertMsg.GetFieldsData()); err != nil {
+		return err
+	}
+
+	// store the oversized values aside after the validation, the stored rows carry the references
+	if err := it.sidePayload.offload(ctx, collInfo.collID, it.schema, it.upsertMsg.InsertMsg.GetFieldsData(),
+		it.upsertMsg.InsertMsg.RowIDs); err != nil {
+		log.Warn("store oversized values aside failed", zap.Error(err))
+		return err
+	}
+
 	log.Debug("Proxy Upsert insertPreExecute done")
 
 	return nil
@@ -3,9 +3,14 @@ package proxy
 import (
 	"fmt"
 	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/parameterutil.go"
@@ -14,10 +19,36 @@ import (
 	"go.uber.org/zap"
 )
 
+// Validation profiles of the insert payload, selected by the collection property
+// common.CollectionValidationProfileKey or `proxy.insertValidation.profile`.
+const (
+	// validationProfileStrict rejects the request if any row is invalid.
+	validationProfileStrict = "strict"
+	// validationProfileLenient coerces the invalid values where possible:
+	// NaN/Inf in float vectors are replaced by 0, over-length varchars are truncated,
+	// and invalid UTF-8 sequences in varchars are replaced by U+FFFD.
+	// The vector dimension mismatches and the invalid JSONs are always rejected.
+	validationProfileLenient = "lenient"
+)
+
+// Checks labelling the counters of the rejected/coerced rows.
+const (
+	validationCheckDimension = "dimension"
+	validationCheckNaN       = "nan"
+	validationCheckMaxLength = "max_length"
+	validationCheckUTF8      = "utf8"
+)
+
 type validateUtil struct {
 	checkNAN      bool
 	checkMaxLen   bool
 	checkOverflow bool
+	checkUTF8     bool
+	// coerce the invalid values instead of rejecting the request, see validationProfileLenient
+	coerce bool
+	// the collection labelling the counters of the rejected/coerced rows, not counted if empty
+	collectionName string
+	numRows        uint64
 }
 
 type validateOption func(*validateUtil)
@@ -40,6 +71,33 @@ func withOverflowCheck() validateOption {
 	}
 }
 
+func withUTF8Check() validateOption {
+	return func(v *validateUtil) {
+		v.checkUTF8 = true
+	}
+}
+
+// withValidationProfile sets the validation profile, and counts the rejected/coerced rows of the collection.
+func withValidationProfile(profile string, collectionName string) validateOption {
+	return func(v *validateUtil) {
+		v.coerce = profile == validationProfileLenient
+		v.collectionName = collectionName
+	}
+}
+
+// getValidationProfile returns the validation profile of the collection,
+// the unknown profile is regarded as the strict one.
+func getValidationProfile(properties map[string]string) string {
+	profile, ok := properties[common.CollectionValidationProfileKey]
+	if !ok {
+		profile = paramtable.Get().ProxyCfg.InsertValidationProfile.GetValue()
+	}
+	if profile != validationProfileLenient {
+		return validationProfileStrict
+	}
+	return profile
+}
+
 func (v *validateUtil) apply(opts ...validateOption) {
 	for _, opt := range opts {
 		opt(v)
@@ -51,6 +109,7 @@ func (v *validateUtil) Validate(data []*schemapb.FieldData, schema *schemapb.Col
 	if err != nil {
 		return err
 	}
+	v.numRows = numRows
 
 	for _, field := range data {
 		fieldSchema, err := helper.GetFieldFromName(field.GetFieldName())
@@ -116,7 +175,7 @@ func (v *validateUtil) checkAligned(data []*schemapb.FieldData, schema *typeutil
 
 			n, err := funcutil.GetNumRowsOfFloatVectorField(field.GetVectors().GetFloatVector().GetData(), dim)
 			if err != nil {
-				return err
+				return v.reject(validationCheckDimension, err)
 			}
 
 			if n != numRows {
@@ -136,7 +195,7 @@ func (v *validateUtil) checkAligned(data []*schemapb.FieldData, schema *typeutil
 
 			n, err := funcutil.GetNumRowsOfBinaryVectorField(field.GetVectors().GetBinaryVector(), dim)
 			if err != nil {
-				return err
+				return v.reject(validationCheckDimension, err)
 			}
 
 			if n != numRows {
@@ -242,10 +301,32 @@ func (v *validateUtil) checkFloatVectorFieldData(field *schemapb.FieldData, fiel
 		return merr.WrapErrParameterInvalid("need float vector", "got nil", msg)
 	}
 
-	if v.checkNAN {
-		return typeutil.VerifyFloats32(floatArray)
+	if !v.checkNAN {
+		return nil
+	}
+	if !v.coerce {
+		if err := typeutil.VerifyFloats32(floatArray); err != nil {
+			return v.reject(validationCheckNaN, merr.WrapErrParameterInvalid("finite float", "NaN or Inf", err.Error()))
+		}
+		return nil
 	}
 
+	// the dimension is checked when checking the alignment
+	dim, err := typeutil.GetDim(fieldSchema)
+	if err != nil || dim <= 0 {
+		dim = 1
+	}
+	coerced, lastRow := 0, int64(-1)
+	for i, f := range floatArray {
+		if !math.IsNaN(float64(f)) && !math.IsInf(float64(f), 0) {
+			continue
+		}
+		floatArray[i] = 0
+		if row := int64(i) / dim; row != lastRow {
+			coerced, lastRow = coerced+1, row
+		}
+	}
+	v.count(validationCheckNaN, metrics.CoercedLabel, coerced)
 	return nil
 }
 
@@ -261,6 +342,12 @@ func (v *validateUtil) checkVarCharFieldData(field *schemapb.FieldData, fieldSch
 		return merr.WrapErrParameterInvalid("need string array", "got nil", msg)
 	}
 
+	if v.checkUTF8 {
+		if err := v.checkUTF8PerRow(strArr); err != nil {
+			return err
+		}
+	}
+
 	// fieldSchema autoID is true means that field is pk and primaryData is auto generated
 	// no need to do max length check
 	// ignore the parameter of MaxLength
@@ -270,12 +357,57 @@ func (v *validateUtil) checkVarCharFieldData(field *schemapb.FieldData, fieldSch
 		if err != nil {
 			return err
 		}
-		return verifyLengthPerRow(strArr, maxLength)
+		if !v.coerce {
+			if err := verifyLengthPerRow(strArr, maxLength); err != nil {
+				return v.reject(validationCheckMaxLength, err)
+			}
+			return nil
+		}
+		coerced := 0
+		for i, str := range strArr {
+			if int64(len(str)) > maxLength {
+				strArr[i] = truncateUTF8(str, int(maxLength))
+				coerced++
+			}
+		}
+		v.count(validationCheckMaxLength, metrics.CoercedLabel, coerced)
 	}
 
 	return nil
 }
 
+// checkUTF8PerRow rejects the invalid UTF-8 strings, or replaces the invalid sequences if coercing.
+func (v *validateUtil) checkUTF8PerRow(strArr []string) error {
+	coerced := 0
+	for i, str := range strArr {
+		if utf8.ValidString(str) {
+			continue
+		}
+		if !v.coerce {
+			msg := fmt.Sprintf("the %dth string is not valid UTF-8", i)
+			return v.reject(validationCheckUTF8, merr.WrapErrParameterInvalid("valid UTF-8 string", "invalid UTF-8 string", msg))
+		}
+		strArr[i] = strings.ToValidUTF8(str, string(utf8.RuneError))
+		coerced++
+	}
+	v.count(validationCheckUTF8, metrics.CoercedLabel, coerced)
+	return nil
+}
+
+// reject counts the rows of the request as rejected by the check, and returns the error.
+func (v *validateUtil) reject(check string, err error) error {
+	v.count(check, metrics.RejectedLabel, int(v.numRows))
+	return err
+}
+
+func (v *validateUtil) count(check string, status string, rows int) {
+	if v.collectionName == "" || rows == 0 {
+		return
+	}
+	metrics.ProxyInsertValidationRowCount.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10),
+		v.collectionName, check, status).Add(float64(rows))
+}
+
 func (v *validateUtil) checkJSONFieldData(field *schemapb.FieldData, fieldSchema *schemapb.FieldSchema) error {
 	jsonArray := field.GetScalars().GetJsonData().GetData()
 	if jsonArray == nil {
@@ -283,8 +415,20 @@ func (v *validateUtil) checkJSONFieldData(field *schemapb.FieldData, fieldSchema
 		return merr.WrapErrParameterInvalid("need string array", "got nil", msg)
 	}
 
+	// the invalid JSONs can't be coerced, they are always rejected
+	if v.checkUTF8 {
+		for i, json := range jsonArray {
+			if !utf8.Valid(json) {
+				msg := fmt.Sprintf("the %dth json is not valid UTF-8", i)
+				return v.reject(validationCheckUTF8, merr.WrapErrParameterInvalid("valid UTF-8 json", "invalid UTF-8 json", msg))
+			}
+		}
+	}
+
 	if v.checkMaxLen {
-		return verifyLengthPerRow(jsonArray, paramtable.Get().CommonCfg.JSONMaxLength.GetAsInt64())
+		if err := verifyLengthPerRow(jsonArray, paramtable.Get().CommonCfg.JSONMaxLength.GetAsInt64()); err != nil {
+			return v.reject(validationCheckMaxLength, err)
+		}
 	}
 
 	return nil
@@ -322,6 +466,18 @@ func verifyLengthPerRow[E interface{ ~string | ~[]byte }](strArr []E, maxLength
 	return nil
 }
 
+// truncateUTF8 truncates the string to at most maxLength bytes without splitting a UTF-8 character.
+func truncateUTF8(str string, maxLength int) string {
+	if len(str) <= maxLength {
+		return str
+	}
+	end := maxLength
+	for end > 0 && !utf8.RuneStart(str[end]) {
+		end--
+	}
+	return str[:end]
+}
+
 func verifyOverflowByRange(arr []int32, lb int64, ub int64) error {
 	for idx, e := range arr {
 		if lb > int64(e) || ub < int64(e) {
@@ -9,6 +9,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
@@ -2081,3 +2082,109 @@ func Test_validateUtil_checkIntegerFieldData(t *testing.T) {
 	})
 
 }
+
+func Test_validateUtil_profiles(t *testing.T) {
+	paramtable.Init()
+
+	newData := func() []*schemapb.FieldData {
+		return []*schemapb.FieldData{
+			{
+				FieldName: "vec",
+				Type:      schemapb.DataType_FloatVector,
+				Field: &schemapb.FieldData_Vectors{
+					Vectors: &schemapb.VectorField{
+						Dim: 2,
+						Data: &schemapb.VectorField_FloatVector{
+							FloatVector: &schemapb.FloatArray{
+								Data: []float32{float32(math.NaN()), float32(math.Inf(1)), 1, 2},
+							},
+						},
+					},
+				},
+			},
+			{
+				FieldName: "str",
+				Type:      schemapb.DataType_VarChar,
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_StringData{
+							StringData: &schemapb.StringArray{
+								Data: []string{"abc\xff", "你好世界"},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{
+				Name:       "vec",
+				DataType:   schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "2"}},
+			},
+			{
+				Name:       "str",
+				DataType:   schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.MaxLengthKey, Value: "7"}},
+			},
+		},
+	}
+	opts := []validateOption{withNANCheck(), withMaxLenCheck(), withUTF8Check()}
+
+	t.Run("strict", func(t *testing.T) {
+		v := newValidateUtil(append(opts, withValidationProfile(validationProfileStrict, "coll"))...)
+		err := v.Validate(newData(), schema, 2)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+		// invalid UTF-8
+		data := newData()
+		data[0].GetVectors().GetFloatVector().Data = []float32{1, 2, 3, 4}
+		err = v.Validate(data, schema, 2)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("lenient", func(t *testing.T) {
+		v := newValidateUtil(append(opts, withValidationProfile(validationProfileLenient, "coll"))...)
+		data := newData()
+		err := v.Validate(data, schema, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, []float32{0, 0, 1, 2}, data[0].GetVectors().GetFloatVector().GetData())
+		// the invalid byte is replaced, the over-length string is truncated without splitting a character
+		assert.Equal(t, []string{"abc\uFFFD", "你好"}, data[1].GetScalars().GetStringData().GetData())
+
+		// dimension mismatch is always rejected
+		data = newData()
+		data[0].GetVectors().GetFloatVector().Data = []float32{1, 2, 3}
+		err = v.Validate(data, schema, 2)
+		assert.Error(t, err)
+	})
+}
+
+func Test_getValidationProfile(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+
+	assert.Equal(t, validationProfileStrict, getValidationProfile(nil))
+	assert.Equal(t, validationProfileLenient, getValidationProfile(map[string]string{
+		common.CollectionValidationProfileKey: validationProfileLenient,
+	}))
+	assert.Equal(t, validationProfileStrict, getValidationProfile(map[string]string{
+		common.CollectionValidationProfileKey: "unknown",
+	}))
+
+	params.Save(params.ProxyCfg.InsertValidationProfile.Key, validationProfileLenient)
+	defer params.Reset(params.ProxyCfg.InsertValidationProfile.Key)
+	assert.Equal(t, validationProfileLenient, getValidationProfile(nil))
+	assert.Equal(t, validationProfileStrict, getValidationProfile(map[string]string{
+		common.CollectionValidationProfileKey: validationProfileStrict,
+	}))
+}
+
+func Test_truncateUTF8(t *testing.T) {
+	assert.Equal(t, "abc", truncateUTF8("abc", 5))
+	assert.Equal(t, "ab", truncateUTF8("abc", 2))
+	assert.Equal(t, "你", truncateUTF8("你好", 5))
+	assert.Equal(t, "", truncateUTF8("你好", 2))
+}
@@ -53,6 +53,7 @@ var (
 	RoundRobinBalancerName    = "RoundRobinBalancer"
 	RowCountBasedBalancerName = "RowCountBasedBalancer"
 	ScoreBasedBalancerName    = "ScoreBasedBalancer"
+	LoadAwareBalancerName     = "LoadAwareBalancer"
 )
 
 type Balance interface {
@@ -0,0 +1,73 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package balance
+
+import (
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/internal/querycoordv2/task"
+)
+
+// LoadAwareBalancer balances the segments by the actual load of the QueryNodes rather than the row count only.
+// The row count score of each node is scaled by its runtime load reported along with the distribution,
+// i.e. the cpu usage, the memory usage and the search rate relative to the average of the cluster,
+// so the busy nodes are assigned less segments and move segments out to the idle ones.
+// The nodes which don't report the runtime load are scored by the row count only.
+type LoadAwareBalancer struct {
+	*ScoreBasedBalancer
+}
+
+func NewLoadAwareBalancer(scheduler task.Scheduler,
+	nodeManager *session.NodeManager,
+	dist *meta.DistributionManager,
+	meta *meta.Meta,
+	targetMgr *meta.TargetManager) *LoadAwareBalancer {
+	b := &LoadAwareBalancer{
+		ScoreBasedBalancer: NewScoreBasedBalancer(scheduler, nodeManager, dist, meta, targetMgr),
+	}
+	b.nodeFactor = b.loadFactor
+	return b
+}
+
+// loadFactor returns 1 plus the weighted load of the node.
+func (b *LoadAwareBalancer) loadFactor(nodeID int64) float64 {
+	node := b.nodeManager.Get(nodeID)
+	if node == nil {
+		return 1
+	}
+	cfg := &params.Params.QueryCoordCfg
+	factor := 1 +
+		cfg.LoadAwareCPUWeight.GetAsFloat()*node.CPUUsage()/100 +
+		cfg.LoadAwareMemoryWeight.GetAsFloat()*node.MemoryUsage()
+	if avg := b.averageSearchNQRate(); avg > 0 {
+		factor += cfg.LoadAwareSearchRateWeight.GetAsFloat() * node.SearchNQRate() / avg
+	}
+	return factor
+}
+
+func (b *LoadAwareBalancer) averageSearchNQRate() float64 {
+	nodes := b.nodeManager.GetAll()
+	if len(nodes) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, node := range nodes {
+		sum += node.SearchNQRate()
+	}
+	return sum / float64(len(nodes))
+}
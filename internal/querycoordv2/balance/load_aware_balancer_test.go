@@ -0,0 +1,61 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package balance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+)
+
+func TestLoadAwareBalancer(t *testing.T) {
+	Params.Init()
+	nodeManager := session.NewNodeManager()
+	dist := meta.NewDistributionManager()
+	balancer := NewLoadAwareBalancer(nil, nodeManager, dist, nil, nil)
+
+	busy := session.NewNodeInfo(1, "localhost")
+	busy.UpdateStats(session.WithRuntimeLoad(100, 50, 100, 300))
+	idle := session.NewNodeInfo(2, "localhost")
+	idle.UpdateStats(session.WithRuntimeLoad(0, 0, 100, 100))
+	nodeManager.Add(busy)
+	nodeManager.Add(idle)
+
+	// 1 + cpu 1 + memory 0.5 + search rate 300/200
+	assert.Equal(t, 4.0, balancer.loadFactor(1))
+	// 1 + search rate 100/200
+	assert.Equal(t, 1.5, balancer.loadFactor(2))
+	// unknown node
+	assert.Equal(t, 1.0, balancer.loadFactor(3))
+
+	// the nodes serve the same rows, but the idle node takes all the new segments
+	dist.SegmentDistManager.Update(1, &meta.Segment{SegmentInfo: &datapb.SegmentInfo{ID: 1, CollectionID: 1, NumOfRows: 10}, Node: 1})
+	dist.SegmentDistManager.Update(2, &meta.Segment{SegmentInfo: &datapb.SegmentInfo{ID: 2, CollectionID: 1, NumOfRows: 10}, Node: 2})
+	plans := balancer.AssignSegment(1, []*meta.Segment{
+		{SegmentInfo: &datapb.SegmentInfo{ID: 3, CollectionID: 1, NumOfRows: 5}},
+		{SegmentInfo: &datapb.SegmentInfo{ID: 4, CollectionID: 1, NumOfRows: 5}},
+	}, []int64{1, 2})
+	assert.Len(t, plans, 2)
+	for _, plan := range plans {
+		assert.EqualValues(t, 2, plan.To)
+	}
+}
@@ -33,6 +33,8 @@ import (
 
 type ScoreBasedBalancer struct {
 	*RowCountBasedBalancer
+	// nodeFactor scales the scores of the node if set, e.g. by its runtime load
+	nodeFactor func(nodeID int64) float64
 }
 
 func NewScoreBasedBalancer(scheduler task.Scheduler,
@@ -72,8 +74,7 @@ func (b *ScoreBasedBalancer) AssignSegment(collectionID int64, segments []*meta.
 		plans = append(plans, plan)
 		// change node's priority and push back, should count for both collection factor and local factor
 		p := ni.getPriority()
-		ni.setPriority(p + int(s.GetNumOfRows()) +
-			int(float64(s.GetNumOfRows())*params.Params.QueryCoordCfg.GlobalRowCountFactor.GetAsFloat()))
+		ni.setPriority(p + b.segmentScore(ni.nodeID, s))
 		queue.push(ni)
 	}
 	return plans
@@ -102,8 +103,92 @@ func (b *ScoreBasedBalancer) calculatePriority(collectionID, nodeID int64) int {
 	for _, s := range collectionSegments {
 		collectionRowCount += int(s.GetNumOfRows())
 	}
-	return collectionRowCount + int(float64(rowCount)*
+	rowScore := collectionRowCount + int(float64(rowCount)*
 		params.Params.QueryCoordCfg.GlobalRowCountFactor.GetAsFloat())
+	return b.scale(nodeID, int(float64(rowScore)*params.Params.QueryCoordCfg.RowCountScoreWeight.GetAsFloat())+
+		b.channelScore(collectionID, nodeID))
+}
+
+// segmentScore returns the score the segment contributes to the node, which counts for both collection factor and local factor.
+func (b *ScoreBasedBalancer) segmentScore(nodeID int64, segment *meta.Segment) int {
+	rowScore := int(segment.GetNumOfRows()) + int(float64(segment.GetNumOfRows())*
+		params.Params.QueryCoordCfg.GlobalRowCountFactor.GetAsFloat())
+	return b.scale(nodeID, int(float64(rowScore)*params.Params.QueryCoordCfg.RowCountScoreWeight.GetAsFloat()))
+}
+
+// channelScore returns the score of the channels on the node, as the delegators serve the growing data and
+// forward the requests of the whole channels. Each channel is scored as the average row count per channel of
+// the collection, weighted by queryCoord.channelScoreWeight.
+func (b *ScoreBasedBalancer) channelScore(collectionID, nodeID int64) int {
+	weight := params.Params.QueryCoordCfg.ChannelScoreWeight.GetAsFloat()
+	if weight <= 0 {
+		return 0
+	}
+	channelNum := b.getChannelNum(nodeID)
+	if channelNum == 0 {
+		return 0
+	}
+
+	rowCount := int64(0)
+	for _, segment := range b.targetMgr.GetHistoricalSegmentsByCollection(collectionID, meta.CurrentTarget) {
+		rowCount += segment.GetNumOfRows()
+	}
+	rowsPerChannel := int64(1)
+	if channels := len(b.targetMgr.GetDmChannelsByCollection(collectionID, meta.CurrentTarget)); channels > 0 && rowCount > int64(channels) {
+		rowsPerChannel = rowCount / int64(channels)
+	}
+	return int(weight * float64(int64(channelNum)*rowsPerChannel))
+}
+
+// getChannelNum returns the number of the channels on the node, including the ones being assigned to it.
+func (b *ScoreBasedBalancer) getChannelNum(nodeID int64) int {
+	return len(b.dist.ChannelDistManager.GetByNode(nodeID)) + b.scheduler.GetNodeChannelDelta(nodeID)
+}
+
+// AssignChannel assigns each channel to the node with the least channels,
+// the node never exceeds queryCoord.maxChannelNumPerNode channels,
+// the channels are left unassigned if all the nodes reach the limit.
+func (b *ScoreBasedBalancer) AssignChannel(channels []*meta.DmChannel, nodes []int64) []ChannelAssignPlan {
+	maxChannelNum := params.Params.QueryCoordCfg.MaxChannelNumPerNode.GetAsInt()
+	if maxChannelNum <= 0 {
+		return b.RowCountBasedBalancer.AssignChannel(channels, nodes)
+	}
+
+	nodesInfo := b.getNodes(nodes)
+	channelNum := make(map[int64]int, len(nodesInfo))
+	for _, node := range nodesInfo {
+		channelNum[node.ID()] = b.getChannelNum(node.ID())
+	}
+	plans := make([]ChannelAssignPlan, 0, len(channels))
+	for _, channel := range channels {
+		candidates := lo.Filter(nodesInfo, func(node *session.NodeInfo, _ int) bool {
+			return channelNum[node.ID()] < maxChannelNum
+		})
+		if len(candidates) == 0 {
+			log.RatedWarn(10, "all nodes reach the max channel number, skip assigning the channels",
+				zap.Int("maxChannelNum", maxChannelNum),
+				zap.Int64s("nodes", nodes),
+				zap.String("channel", channel.GetChannelName()))
+			break
+		}
+		to := lo.MinBy(candidates, func(a, b *session.NodeInfo) bool {
+			return channelNum[a.ID()] < channelNum[b.ID()]
+		})
+		plans = append(plans, ChannelAssignPlan{
+			Channel: channel,
+			From:    -1,
+			To:      to.ID(),
+		})
+		channelNum[to.ID()]++
+	}
+	return plans
+}
+
+func (b *ScoreBasedBalancer) scale(nodeID int64, score int) int {
+	if b.nodeFactor == nil {
+		return score
+	}
+	return int(float64(score) * b.nodeFactor(nodeID))
 }
 
 func (b *ScoreBasedBalancer) BalanceReplica(replica *meta.Replica) ([]SegmentAssignPlan, []ChannelAssignPlan) {
@@ -187,6 +272,43 @@ func (b *ScoreBasedBalancer) BalanceReplica(replica *meta.Replica) ([]SegmentAss
 	return segmentPlans, channelPlans
 }
 
+// genChannelPlan moves the channels out of the offline nodes,
+// and the ones exceeding queryCoord.maxChannelNumPerNode out of the online nodes.
+func (b *ScoreBasedBalancer) genChannelPlan(replica *meta.Replica, onlineNodes []int64, offlineNodes []int64) []ChannelAssignPlan {
+	channelPlans := make([]ChannelAssignPlan, 0)
+	for _, nodeID := range offlineNodes {
+		dmChannels := b.dist.ChannelDistManager.GetByCollectionAndNode(replica.GetCollectionID(), nodeID)
+		plans := b.AssignChannel(dmChannels, onlineNodes)
+		for i := range plans {
+			plans[i].From = nodeID
+			plans[i].ReplicaID = replica.ID
+		}
+		channelPlans = append(channelPlans, plans...)
+	}
+
+	maxChannelNum := params.Params.QueryCoordCfg.MaxChannelNumPerNode.GetAsInt()
+	if maxChannelNum <= 0 || len(channelPlans) > 0 {
+		return channelPlans
+	}
+	for _, nodeID := range onlineNodes {
+		exceeded := b.getChannelNum(nodeID) - maxChannelNum
+		if exceeded <= 0 {
+			continue
+		}
+		dmChannels := b.dist.ChannelDistManager.GetByCollectionAndNode(replica.GetCollectionID(), nodeID)
+		if len(dmChannels) > exceeded {
+			dmChannels = dmChannels[:exceeded]
+		}
+		plans := b.AssignChannel(dmChannels, lo.Without(onlineNodes, nodeID))
+		for i := range plans {
+			plans[i].From = nodeID
+			plans[i].ReplicaID = replica.ID
+		}
+		channelPlans = append(channelPlans, plans...)
+	}
+	return channelPlans
+}
+
 func (b *ScoreBasedBalancer) getStoppedSegmentPlan(replica *meta.Replica, nodesSegments map[int64][]*meta.Segment, stoppingNodesSegments map[int64][]*meta.Segment) []SegmentAssignPlan {
 	segmentPlans := make([]SegmentAssignPlan, 0)
 	// generate candidates
@@ -222,8 +344,7 @@ func (b *ScoreBasedBalancer) getStoppedSegmentPlan(replica *meta.Replica, nodesS
 		segmentPlans = append(segmentPlans, plan)
 		// change node's priority and push back, should count for both collection factor and local factor
 		p := ni.getPriority()
-		ni.setPriority(p + int(s.GetNumOfRows()) + int(float64(s.GetNumOfRows())*
-			params.Params.QueryCoordCfg.GlobalRowCountFactor.GetAsFloat()))
+		ni.setPriority(p + b.segmentScore(ni.nodeID, s))
 		queue.push(ni)
 	}
 
@@ -272,10 +393,8 @@ func (b *ScoreBasedBalancer) getNormalSegmentPlan(replica *meta.Replica, nodesSe
 			break
 		}
 
-		nextFromPriority := fromPriority - int(targetSegmentToMove.GetNumOfRows()) - int(float64(targetSegmentToMove.GetNumOfRows())*
-			params.Params.QueryCoordCfg.GlobalRowCountFactor.GetAsFloat())
-		nextToPriority := toPriority + int(targetSegmentToMove.GetNumOfRows()) + int(float64(targetSegmentToMove.GetNumOfRows())*
-			params.Params.QueryCoordCfg.GlobalRowCountFactor.GetAsFloat())
+		nextFromPriority := fromPriority - b.segmentScore(fromNode.nodeID, targetSegmentToMove)
+		nextToPriority := toPriority + b.segmentScore(toNode.nodeID, targetSegmentToMove)
 
 		//still unbalanced after this balance plan is executed
 		if nextToPriority <= nextFromPriority {
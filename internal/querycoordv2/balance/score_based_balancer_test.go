@@ -32,6 +32,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/task"
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
 type ScoreBasedBalancerTestSuite struct {
@@ -575,6 +576,97 @@ func (suite *ScoreBasedBalancerTestSuite) TestStoppedBalance() {
 	}
 }
 
+func (suite *ScoreBasedBalancerTestSuite) TestAssignChannelWithMaxChannelNum() {
+	paramtable.Get().Save(Params.QueryCoordCfg.MaxChannelNumPerNode.Key, "2")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.MaxChannelNumPerNode.Key)
+	suite.mockScheduler.EXPECT().GetNodeChannelDelta(mock.Anything).Return(0).Maybe()
+
+	balancer := suite.balancer
+	balancer.dist.ChannelDistManager.Update(1,
+		meta.DmChannelFromVChannel(&datapb.VchannelInfo{CollectionID: 1, ChannelName: "channel-1"}),
+		meta.DmChannelFromVChannel(&datapb.VchannelInfo{CollectionID: 1, ChannelName: "channel-2"}),
+	)
+	balancer.dist.ChannelDistManager.Update(2,
+		meta.DmChannelFromVChannel(&datapb.VchannelInfo{CollectionID: 1, ChannelName: "channel-3"}),
+	)
+	for _, node := range []int64{1, 2, 3} {
+		balancer.nodeManager.Add(session.NewNodeInfo(node, "127.0.0.1:0"))
+	}
+
+	channels := make([]*meta.DmChannel, 0)
+	for _, name := range []string{"channel-4", "channel-5", "channel-6", "channel-7"} {
+		channels = append(channels, meta.DmChannelFromVChannel(&datapb.VchannelInfo{CollectionID: 2, ChannelName: name}))
+	}
+	plans := balancer.AssignChannel(channels, []int64{1, 2, 3})
+	// node 1 is full, node 2 accepts one and node 3 accepts two, the last channel is left unassigned
+	suite.Len(plans, 3)
+	assigned := make(map[int64]int)
+	for _, plan := range plans {
+		assigned[plan.To]++
+	}
+	suite.Equal(map[int64]int{2: 1, 3: 2}, assigned)
+}
+
+func (suite *ScoreBasedBalancerTestSuite) TestBalanceExceededChannels() {
+	paramtable.Get().Save(Params.QueryCoordCfg.MaxChannelNumPerNode.Key, "1")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.MaxChannelNumPerNode.Key)
+	suite.mockScheduler.EXPECT().GetNodeChannelDelta(mock.Anything).Return(0).Maybe()
+
+	balancer := suite.balancer
+	collectionID, replicaID := int64(1), int64(1)
+	nodes := []int64{1, 2}
+	collection := utils.CreateTestCollection(collectionID, int32(replicaID))
+	collection.LoadPercentage = 100
+	collection.Status = querypb.LoadStatus_Loaded
+	balancer.meta.CollectionManager.PutCollection(collection)
+	balancer.meta.ReplicaManager.Put(utils.CreateTestReplica(replicaID, collectionID, nodes))
+	for _, node := range nodes {
+		balancer.nodeManager.Add(session.NewNodeInfo(node, "127.0.0.1:0"))
+		balancer.meta.ResourceManager.AssignNode(meta.DefaultResourceGroupName, node)
+	}
+	balancer.dist.ChannelDistManager.Update(1,
+		meta.DmChannelFromVChannel(&datapb.VchannelInfo{CollectionID: collectionID, ChannelName: "channel-1"}),
+		meta.DmChannelFromVChannel(&datapb.VchannelInfo{CollectionID: collectionID, ChannelName: "channel-2"}),
+	)
+
+	segmentPlans, channelPlans := suite.getCollectionBalancePlans(balancer, collectionID)
+	suite.Empty(segmentPlans)
+	suite.Len(channelPlans, 1)
+	suite.EqualValues(1, channelPlans[0].From)
+	suite.EqualValues(2, channelPlans[0].To)
+	suite.Equal(replicaID, channelPlans[0].ReplicaID)
+}
+
+func (suite *ScoreBasedBalancerTestSuite) TestChannelScore() {
+	paramtable.Get().Save(Params.QueryCoordCfg.ChannelScoreWeight.Key, "1")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.ChannelScoreWeight.Key)
+	suite.mockScheduler.EXPECT().GetNodeChannelDelta(mock.Anything).Return(0).Maybe()
+
+	balancer := suite.balancer
+	collectionID := int64(1)
+	channels := []*datapb.VchannelInfo{
+		{CollectionID: collectionID, ChannelName: "channel-1"},
+		{CollectionID: collectionID, ChannelName: "channel-2"},
+	}
+	segments := []*datapb.SegmentInfo{
+		{ID: 1, PartitionID: 1, InsertChannel: "channel-1", NumOfRows: 40},
+		{ID: 2, PartitionID: 1, InsertChannel: "channel-2", NumOfRows: 60},
+	}
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, collectionID).Return(channels, segments, nil)
+	balancer.targetMgr.UpdateCollectionNextTargetWithPartitions(collectionID, 1)
+	balancer.targetMgr.UpdateCollectionCurrentTarget(collectionID, 1)
+
+	balancer.dist.ChannelDistManager.Update(1, meta.DmChannelFromVChannel(channels[0]))
+	balancer.dist.SegmentDistManager.Update(2, &meta.Segment{
+		SegmentInfo: &datapb.SegmentInfo{ID: 1, CollectionID: collectionID, NumOfRows: 30}, Node: 2,
+	})
+
+	// a channel is scored as the average row count per channel, 100 / 2
+	suite.Equal(50, balancer.calculatePriority(collectionID, 1))
+	// 30 rows of the collection plus 30 * globalRowCountFactor
+	suite.Equal(33, balancer.calculatePriority(collectionID, 2))
+}
+
 func TestScoreBasedBalancerSuite(t *testing.T) {
 	suite.Run(t, new(ScoreBasedBalancerTestSuite))
 }
@@ -70,7 +70,8 @@ func (b *BalanceChecker) replicasToBalance() []int64 {
 		return loadedCollections[i] < loadedCollections[j]
 	})
 
-	// balance collections influenced by stopping nodes
+	// balance collections influenced by stopping nodes,
+	// including the suspended ones, otherwise the stopping nodes would never be drained
 	stoppingReplicas := make([]int64, 0)
 	for _, cid := range loadedCollections {
 		replicas := b.meta.ReplicaManager.GetByCollection(cid)
@@ -102,6 +103,11 @@ func (b *BalanceChecker) replicasToBalance() []int64 {
 	normalReplicasToBalance := make([]int64, 0)
 	hasUnbalancedCollection := false
 	for _, cid := range loadedCollections {
+		if b.meta.IsBalanceSuspended(cid) {
+			log.RatedDebug(10, "balance of collection is suspended, skip balancing",
+				zap.Int64("collectionID", cid))
+			continue
+		}
 		if b.normalBalanceCollectionsCurrentRound.Contain(cid) {
 			log.Debug("ScoreBasedBalancer has balanced collection, skip balancing in this round",
 				zap.Int64("collectionID", cid))
@@ -141,17 +147,63 @@ func (b *BalanceChecker) balanceReplicas(replicaIDs []int64) ([]balance.SegmentA
 }
 
 func (b *BalanceChecker) Check(ctx context.Context) []task.Task {
-	ret := make([]task.Task, 0)
-
 	replicasToBalance := b.replicasToBalance()
 	segmentPlans, channelPlans := b.balanceReplicas(replicasToBalance)
+	return b.createTasks(ctx, segmentPlans, channelPlans)
+}
+
+// BalanceScope generates the balance tasks for the replicas of the given collection,
+// or all the loaded collections if collectionID is 0, regardless of the auto balance config.
+// The collections with the balance suspended are skipped.
+// Only the plans moving segments or channels from or to the given node are kept if nodeID is not 0.
+func (b *BalanceChecker) BalanceScope(ctx context.Context, collectionID, nodeID int64) []task.Task {
+	collections := []int64{collectionID}
+	if collectionID == 0 {
+		collections = lo.Filter(b.meta.GetAll(), func(cid int64, _ int) bool {
+			collection := b.meta.GetCollection(cid)
+			return collection != nil && collection.GetStatus() == querypb.LoadStatus_Loaded
+		})
+		sort.Slice(collections, func(i, j int) bool {
+			return collections[i] < collections[j]
+		})
+	}
+
+	replicas := make([]int64, 0)
+	for _, cid := range collections {
+		if b.meta.IsBalanceSuspended(cid) {
+			log.Info("balance of collection is suspended, skip balancing", zap.Int64("collectionID", cid))
+			continue
+		}
+		for _, replica := range b.meta.ReplicaManager.GetByCollection(cid) {
+			if nodeID == 0 || replica.Contains(nodeID) {
+				replicas = append(replicas, replica.GetID())
+			}
+		}
+	}
+
+	segmentPlans, channelPlans := b.balanceReplicas(replicas)
+	if nodeID != 0 {
+		segmentPlans = lo.Filter(segmentPlans, func(plan balance.SegmentAssignPlan, _ int) bool {
+			return plan.From == nodeID || plan.To == nodeID
+		})
+		channelPlans = lo.Filter(channelPlans, func(plan balance.ChannelAssignPlan, _ int) bool {
+			return plan.From == nodeID || plan.To == nodeID
+		})
+	}
+	return b.createTasks(ctx, segmentPlans, channelPlans)
+}
+
+func (b *BalanceChecker) createTasks(ctx context.Context, segmentPlans []balance.SegmentAssignPlan, channelPlans []balance.ChannelAssignPlan) []task.Task {
+	ret := make([]task.Task, 0)
 
 	tasks := balance.CreateSegmentTasksFromPlans(ctx, b.ID(), Params.QueryCoordCfg.SegmentTaskTimeout.GetAsDuration(time.Millisecond), segmentPlans)
 	task.SetPriority(task.TaskPriorityLow, tasks...)
+	task.SetClass(task.TaskClassBalance, tasks...)
 	task.SetReason("segment unbalanced", tasks...)
 	ret = append(ret, tasks...)
 
 	tasks = balance.CreateChannelTasksFromPlans(ctx, b.ID(), Params.QueryCoordCfg.ChannelTaskTimeout.GetAsDuration(time.Millisecond), channelPlans)
+	task.SetClass(task.TaskClassBalance, tasks...)
 	task.SetReason("channel unbalanced", tasks...)
 	ret = append(ret, tasks...)
 	return ret
@@ -129,6 +129,54 @@ func (suite *BalanceCheckerTestSuite) TestAutoBalanceConf() {
 	suite.Empty(replicasToBalance)
 }
 
+func (suite *BalanceCheckerTestSuite) TestSuspendedCollection() {
+	//set up nodes info
+	nodeID1, nodeID2 := 1, 2
+	suite.nodeMgr.Add(session.NewNodeInfo(int64(nodeID1), "localhost"))
+	suite.nodeMgr.Add(session.NewNodeInfo(int64(nodeID2), "localhost"))
+	suite.checker.meta.ResourceManager.AssignNode(meta.DefaultResourceGroupName, int64(nodeID1))
+	suite.checker.meta.ResourceManager.AssignNode(meta.DefaultResourceGroupName, int64(nodeID2))
+
+	// set collections meta
+	cid1, replicaID1 := 1, 1
+	collection1 := utils.CreateTestCollection(int64(cid1), int32(replicaID1))
+	collection1.Status = querypb.LoadStatus_Loaded
+	replica1 := utils.CreateTestReplica(int64(replicaID1), int64(cid1), []int64{int64(nodeID1), int64(nodeID2)})
+	suite.checker.meta.CollectionManager.PutCollection(collection1)
+	suite.checker.meta.ReplicaManager.Put(replica1)
+
+	cid2, replicaID2 := 2, 2
+	collection2 := utils.CreateTestCollection(int64(cid2), int32(replicaID2))
+	collection2.Status = querypb.LoadStatus_Loaded
+	replica2 := utils.CreateTestReplica(int64(replicaID2), int64(cid2), []int64{int64(nodeID1), int64(nodeID2)})
+	suite.checker.meta.CollectionManager.PutCollection(collection2)
+	suite.checker.meta.ReplicaManager.Put(replica2)
+
+	suite.NoError(suite.checker.meta.CollectionManager.SetBalanceSuspended(int64(cid1), true))
+
+	paramtable.Get().Save(Params.QueryCoordCfg.AutoBalance.Key, "true")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.AutoBalance.Key)
+	suite.scheduler.EXPECT().GetSegmentTaskNum().Maybe().Return(func() int {
+		return 0
+	})
+	// the suspended collection is skipped
+	idsToBalance := []int64{int64(replicaID2)}
+	replicasToBalance := suite.checker.replicasToBalance()
+	suite.ElementsMatch(idsToBalance, replicasToBalance)
+	//final round
+	replicasToBalance = suite.checker.replicasToBalance()
+	suite.Empty(replicasToBalance)
+
+	// the manual balance skips the suspended collection as well
+	tasks := suite.checker.BalanceScope(context.Background(), int64(cid1), 0)
+	suite.Empty(tasks)
+
+	// the stopping nodes are still drained
+	suite.nodeMgr.Stopping(int64(nodeID1))
+	replicasToBalance = suite.checker.replicasToBalance()
+	suite.ElementsMatch([]int64{int64(replicaID1), int64(replicaID2)}, replicasToBalance)
+}
+
 func (suite *BalanceCheckerTestSuite) TestBusyScheduler() {
 	//set up nodes info
 	nodeID1, nodeID2 := 1, 2
@@ -19,6 +19,8 @@ package checkers
 import (
 	"context"
 
+	"go.uber.org/atomic"
+
 	"github.com/milvus-io/milvus/internal/querycoordv2/task"
 )
 
@@ -27,10 +29,14 @@ type Checker interface {
 	SetID(id int64)
 	Description() string
 	Check(ctx context.Context) []task.Task
+	IsActive() bool
+	Activate()
+	Deactivate()
 }
 
 type baseChecker struct {
-	id int64
+	id          int64
+	deactivated atomic.Bool
 }
 
 func (checker *baseChecker) ID() int64 {
@@ -40,3 +46,16 @@ func (checker *baseChecker) ID() int64 {
 func (checker *baseChecker) SetID(id int64) {
 	checker.id = id
 }
+
+// IsActive returns whether the checker is activated, the checkers are activated by default.
+func (checker *baseChecker) IsActive() bool {
+	return !checker.deactivated.Load()
+}
+
+func (checker *baseChecker) Activate() {
+	checker.deactivated.Store(false)
+}
+
+func (checker *baseChecker) Deactivate() {
+	checker.deactivated.Store(true)
+}
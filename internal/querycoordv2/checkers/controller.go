@@ -18,15 +18,22 @@ package checkers
 
 import (
 	"context"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/querycoordv2/balance"
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
 	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
 	"github.com/milvus-io/milvus/internal/querycoordv2/task"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"go.uber.org/zap"
 )
 
@@ -39,6 +46,8 @@ var (
 	Channel_Checker = "channel_checker"
 	Balance_Checker = "balance_checker"
 	Index_Checker   = "index_checker"
+
+	TargetIndex_Checker = "target_index_checker"
 )
 
 type CheckerController struct {
@@ -50,6 +59,7 @@ type CheckerController struct {
 	broker         meta.Broker
 	nodeMgr        *session.NodeManager
 	balancer       balance.Balance
+	store          metastore.QueryCoordCatalog
 
 	scheduler task.Scheduler
 	checkers  map[string]Checker
@@ -65,15 +75,18 @@ func NewCheckerController(
 	nodeMgr *session.NodeManager,
 	scheduler task.Scheduler,
 	broker meta.Broker,
+	store metastore.QueryCoordCatalog,
 ) *CheckerController {
 
 	// CheckerController runs checkers with the order,
 	// the former checker has higher priority
 	checkers := map[string]Checker{
 		Channel_Checker: NewChannelChecker(meta, dist, targetMgr, balancer),
-		Segment_Checker: NewSegmentChecker(meta, dist, targetMgr, balancer, nodeMgr),
+		Segment_Checker: NewSegmentChecker(meta, dist, targetMgr, balancer, nodeMgr, broker),
 		Balance_Checker: NewBalanceChecker(meta, balancer, nodeMgr, scheduler),
 		Index_Checker:   NewIndexChecker(meta, dist, broker),
+
+		TargetIndex_Checker: NewTargetIndexChecker(meta, targetMgr, broker),
 	}
 
 	id := 0
@@ -96,7 +109,89 @@ func NewCheckerController(
 		scheduler:      scheduler,
 		checkers:       checkers,
 		broker:         broker,
+		store:          store,
+	}
+}
+
+// Recover restores the activations of the checkers persisted in meta.
+func (controller *CheckerController) Recover() error {
+	activations, err := controller.store.GetCheckerActivations()
+	if err != nil {
+		return err
+	}
+	for name, activated := range activations {
+		checker, ok := controller.checkers[name]
+		if !ok {
+			log.Warn("skip the activation of unknown checker", zap.String("checker", name))
+			continue
+		}
+		if activated {
+			checker.Activate()
+		} else {
+			checker.Deactivate()
+			log.Info("checker is deactivated", zap.String("checker", name))
+		}
 	}
+	return nil
+}
+
+func (controller *CheckerController) getChecker(name string) (Checker, error) {
+	checker, ok := controller.checkers[name]
+	if !ok {
+		names := lo.Keys(controller.checkers)
+		sort.Strings(names)
+		return nil, merr.WrapErrParameterInvalid("one of "+strings.Join(names, ", "), name, "checker not found")
+	}
+	return checker, nil
+}
+
+// ListCheckers returns the infos of the given checkers ordered by name, all the checkers if no name given.
+func (controller *CheckerController) ListCheckers(names ...string) ([]*querypb.CheckerInfo, error) {
+	if len(names) == 0 {
+		names = lo.Keys(controller.checkers)
+	}
+	sort.Strings(names)
+	infos := make([]*querypb.CheckerInfo, 0, len(names))
+	for _, name := range names {
+		checker, err := controller.getChecker(name)
+		if err != nil {
+			return nil, err
+		}
+		infos = append(infos, &querypb.CheckerInfo{
+			Name:      name,
+			Desc:      checker.Description(),
+			Activated: checker.IsActive(),
+		})
+	}
+	return infos, nil
+}
+
+// Activate activates the checker, the activation is persisted so that it survives the restarts.
+func (controller *CheckerController) Activate(name string) error {
+	return controller.setActivation(name, true)
+}
+
+// Deactivate deactivates the checker, which generates no tasks until activated,
+// the deactivation is persisted so that it survives the restarts.
+func (controller *CheckerController) Deactivate(name string) error {
+	return controller.setActivation(name, false)
+}
+
+func (controller *CheckerController) setActivation(name string, activated bool) error {
+	checker, err := controller.getChecker(name)
+	if err != nil {
+		return err
+	}
+	if err := controller.store.SaveCheckerActivation(name, activated); err != nil {
+		return err
+	}
+	if activated {
+		checker.Activate()
+	} else {
+		checker.Deactivate()
+	}
+	log.Info("checker activation changed", zap.String("checker", name), zap.Bool("activated", activated))
+	return nil
 }
 
 func (controller *CheckerController) Start(ctx context.Context) {
@@ -115,6 +210,8 @@ func getCheckerInterval(checkerType string) time.Duration {
 		return Params.QueryCoordCfg.BalanceCheckInterval.GetAsDuration(time.Millisecond)
 	case Index_Checker:
 		return Params.QueryCoordCfg.IndexCheckInterval.GetAsDuration(time.Millisecond)
+	case TargetIndex_Checker:
+		return Params.QueryCoordCfg.TargetIndexCheckInterval.GetAsDuration(time.Millisecond)
 	default:
 		return Params.QueryCoordCfg.CheckInterval.GetAsDuration(time.Millisecond)
 	}
@@ -164,9 +261,99 @@ func (controller *CheckerController) Check() {
 	}
 }
 
+// DryRun runs the given checkers, all the checkers if no name given, including the deactivated ones,
+// but returns the generated tasks instead of submitting them to the scheduler,
+// so that the operators could preview the actions to converge the cluster.
+// Note that the balance checker balances the collections one by one in rounds, the dry run takes a round as well.
+func (controller *CheckerController) DryRun(ctx context.Context, names ...string) ([]*querypb.CheckerTaskInfo, error) {
+	if len(names) == 0 {
+		names = lo.Keys(controller.checkers)
+	}
+	sort.Strings(names)
+	checkers := make([]Checker, 0, len(names))
+	for _, name := range names {
+		checker, err := controller.getChecker(name)
+		if err != nil {
+			return nil, err
+		}
+		checkers = append(checkers, checker)
+	}
+
+	infos := make([]*querypb.CheckerTaskInfo, 0)
+	for i, checker := range checkers {
+		for _, t := range checker.Check(ctx) {
+			infos = append(infos, newCheckerTaskInfo(names[i], t))
+			// release the resources of the task never scheduled
+			t.Cancel(nil)
+		}
+	}
+	return infos, nil
+}
+
+// IsTargetIndexReady returns whether the next target of the collection could be promoted
+// as for the indexes of its sealed segments, see TargetIndexChecker.
+func (controller *CheckerController) IsTargetIndexReady(collectionID int64) bool {
+	return controller.checkers[TargetIndex_Checker].(*TargetIndexChecker).IsTargetIndexReady(collectionID)
+}
+
+// TriggerBalance runs the balance checker at once for the given scope, even if it's deactivated,
+// submits the generated tasks to the scheduler and returns the submitted ones with the task IDs.
+func (controller *CheckerController) TriggerBalance(ctx context.Context, collectionID, nodeID int64) ([]*querypb.CheckerTaskInfo, error) {
+	checker, err := controller.getChecker(Balance_Checker)
+	if err != nil {
+		return nil, err
+	}
+	balanceChecker := checker.(*BalanceChecker)
+
+	infos := make([]*querypb.CheckerTaskInfo, 0)
+	for _, t := range balanceChecker.BalanceScope(ctx, collectionID, nodeID) {
+		err := controller.scheduler.Add(t)
+		if err != nil {
+			log.Warn("failed to add balance task",
+				zap.Int64("collectionID", t.CollectionID()),
+				zap.Int64("replicaID", t.ReplicaID()),
+				zap.Error(err))
+			t.Cancel(err)
+			continue
+		}
+		info := newCheckerTaskInfo(Balance_Checker, t)
+		info.TaskID = t.ID()
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+func newCheckerTaskInfo(checker string, t task.Task) *querypb.CheckerTaskInfo {
+	info := &querypb.CheckerTaskInfo{
+		CheckerName:  checker,
+		Type:         task.GetTaskType(t).String(),
+		CollectionID: t.CollectionID(),
+		ReplicaID:    t.ReplicaID(),
+		Priority:     t.Priority().String(),
+		Reason:       t.Reason(),
+	}
+	switch t := t.(type) {
+	case *task.SegmentTask:
+		info.SegmentID = t.SegmentID()
+		info.Channel = t.Shard()
+	case *task.ChannelTask:
+		info.Channel = t.Channel()
+	}
+	for _, action := range t.Actions() {
+		info.Actions = append(info.Actions, &querypb.TaskActionInfo{
+			Type:   action.Type().String(),
+			NodeID: action.Node(),
+		})
+	}
+	return info
+}
+
 // check is the real implementation of Check
 func (controller *CheckerController) check(ctx context.Context, checkerType string) {
 	checker := controller.checkers[checkerType]
+	if !checker.IsActive() {
+		return
+	}
 	tasks := checker.Check(ctx)
 
 	for _, task := range tasks {
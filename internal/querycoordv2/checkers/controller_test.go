@@ -32,6 +32,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/task"
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/atomic"
@@ -80,7 +81,7 @@ func (suite *CheckerControllerSuite) SetupTest() {
 
 	suite.balancer = balance.NewMockBalancer(suite.T())
 	suite.scheduler = task.NewMockScheduler(suite.T())
-	suite.controller = NewCheckerController(suite.meta, suite.dist, suite.targetManager, suite.balancer, suite.nodeMgr, suite.scheduler, suite.broker)
+	suite.controller = NewCheckerController(suite.meta, suite.dist, suite.targetManager, suite.balancer, suite.nodeMgr, suite.scheduler, suite.broker, store)
 }
 
 func (suite *CheckerControllerSuite) TestBasic() {
@@ -128,6 +129,70 @@ func (suite *CheckerControllerSuite) TestBasic() {
 	}, 5*time.Second, 1*time.Second)
 }
 
+func (suite *CheckerControllerSuite) TestActivation() {
+	suite.NoError(suite.controller.Deactivate(Segment_Checker))
+	suite.False(suite.controller.checkers[Segment_Checker].IsActive())
+	suite.True(suite.controller.checkers[Channel_Checker].IsActive())
+	suite.ErrorIs(suite.controller.Deactivate("unknown"), merr.ErrParameterInvalid)
+
+	// the deactivated checker generates no tasks
+	suite.meta.CollectionManager.PutCollection(utils.CreateTestCollection(1, 1))
+	suite.meta.ReplicaManager.Put(utils.CreateTestReplica(1, 1, []int64{1}))
+	suite.controller.check(context.Background(), Segment_Checker)
+
+	// recover the activations from meta
+	controller := NewCheckerController(suite.meta, suite.dist, suite.targetManager, suite.balancer, suite.nodeMgr, suite.scheduler, suite.broker, suite.controller.store)
+	suite.NoError(controller.Recover())
+	infos, err := controller.ListCheckers()
+	suite.NoError(err)
+	suite.Len(infos, 5)
+	for _, info := range infos {
+		suite.Equal(info.GetName() != Segment_Checker, info.GetActivated())
+	}
+
+	suite.NoError(controller.Activate(Segment_Checker))
+	suite.True(controller.checkers[Segment_Checker].IsActive())
+}
+
+func (suite *CheckerControllerSuite) TestDryRun() {
+	suite.meta.CollectionManager.PutCollection(utils.CreateTestCollection(1, 1))
+	suite.meta.ReplicaManager.Put(utils.CreateTestReplica(1, 1, []int64{1}))
+	suite.nodeMgr.Add(session.NewNodeInfo(1, "localhost"))
+	suite.meta.ResourceManager.AssignNode(meta.DefaultResourceGroupName, 1)
+
+	segments := []*datapb.SegmentInfo{
+		{
+			ID:            1,
+			PartitionID:   1,
+			InsertChannel: "test-insert-channel",
+		},
+	}
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, int64(1)).Return(nil, segments, nil)
+	suite.targetManager.UpdateCollectionNextTargetWithPartitions(int64(1), int64(1))
+	suite.dist.LeaderViewManager.Update(1, utils.CreateTestLeaderView(1, 1, "test-insert-channel", map[int64]int64{}, map[int64]*meta.Segment{}))
+
+	suite.balancer.EXPECT().AssignSegment(mock.Anything, mock.Anything, mock.Anything).
+		Return([]balance.SegmentAssignPlan{{Segment: &meta.Segment{SegmentInfo: segments[0]}, From: -1, To: 1}})
+
+	// the tasks are not submitted to the scheduler
+	infos, err := suite.controller.DryRun(context.Background(), Segment_Checker)
+	suite.NoError(err)
+	suite.Require().Len(infos, 1)
+	info := infos[0]
+	suite.Equal(Segment_Checker, info.GetCheckerName())
+	suite.Equal(task.TaskTypeGrow.String(), info.GetType())
+	suite.EqualValues(1, info.GetCollectionID())
+	suite.EqualValues(1, info.GetReplicaID())
+	suite.EqualValues(1, info.GetSegmentID())
+	suite.Equal("test-insert-channel", info.GetChannel())
+	suite.Require().Len(info.GetActions(), 1)
+	suite.Equal(task.ActionTypeGrow.String(), info.GetActions()[0].GetType())
+	suite.EqualValues(1, info.GetActions()[0].GetNodeID())
+
+	_, err = suite.controller.DryRun(context.Background(), "unknown")
+	suite.ErrorIs(err, merr.ErrParameterInvalid)
+}
+
 func TestCheckControllerSuite(t *testing.T) {
 	suite.Run(t, new(CheckerControllerSuite))
 }
@@ -20,6 +20,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
 	"github.com/milvus-io/milvus/internal/querycoordv2/params"
@@ -38,6 +39,17 @@ type IndexChecker struct {
 	meta   *meta.Meta
 	dist   *meta.DistributionManager
 	broker meta.Broker
+
+	// the cached index alteration states of the loaded collections,
+	// the checker runs only in a single goroutine, so it's not guarded.
+	alterStates map[int64]*indexAlterState
+}
+
+// indexAlterState is whether the indexes of a collection are being altered, which is refreshed at IndexAlterCheckInterval.
+type indexAlterState struct {
+	pending     bool // any index has pending index params to roll out
+	finished    bool // the alteration finished since the last refresh
+	refreshedAt time.Time
 }
 
 func NewIndexChecker(
@@ -46,9 +58,10 @@ func NewIndexChecker(
 	broker meta.Broker,
 ) *IndexChecker {
 	return &IndexChecker{
-		meta:   meta,
-		dist:   dist,
-		broker: broker,
+		meta:        meta,
+		dist:        dist,
+		broker:      broker,
+		alterStates: make(map[int64]*indexAlterState),
 	}
 }
 
@@ -66,16 +79,55 @@ func (c *IndexChecker) Check(ctx context.Context) []task.Task {
 			log.Warn("collection released during check index", zap.Int64("collection", collectionID))
 			continue
 		}
+		checkBuildID := c.checkIndexAltering(ctx, collectionID)
 		replicas := c.meta.ReplicaManager.GetByCollection(collectionID)
 		for _, replica := range replicas {
-			tasks = append(tasks, c.checkReplica(ctx, collection, replica)...)
+			tasks = append(tasks, c.checkReplica(ctx, collection, replica, checkBuildID)...)
+		}
+	}
+	// forget the released collections
+	for collectionID := range c.alterStates {
+		if !lo.Contains(collectionIDs, collectionID) {
+			delete(c.alterStates, collectionID)
 		}
 	}
 
 	return tasks
 }
 
-func (c *IndexChecker) checkReplica(ctx context.Context, collection *meta.Collection, replica *meta.Replica) []task.Task {
+// checkIndexAltering returns true if the build ids of the loaded segment indexes should be checked.
+// The serving segment indexes are swapped to the rebuilt ones while the index is being altered,
+// which keep the index id with new build ids. The build ids are checked once more after the alteration
+// finishes, for the segment indexes swapped right before it.
+// The pending index params are described at IndexAlterCheckInterval, the cached state is kept if it fails.
+func (c *IndexChecker) checkIndexAltering(ctx context.Context, collectionID int64) bool {
+	state, ok := c.alterStates[collectionID]
+	if !ok {
+		state = &indexAlterState{}
+		c.alterStates[collectionID] = state
+	}
+	interval := params.Params.QueryCoordCfg.IndexAlterCheckInterval.GetAsDuration(time.Millisecond)
+	if state.refreshedAt.IsZero() || time.Since(state.refreshedAt) >= interval {
+		indexes, err := c.broker.DescribeIndex(ctx, collectionID)
+		if err != nil {
+			log.Warn("failed to describe index, check the alteration later",
+				zap.Int64("collectionID", collectionID), zap.Error(err))
+		} else {
+			pending := lo.ContainsBy(indexes, func(index *indexpb.IndexInfo) bool {
+				return len(index.GetPendingIndexParams()) > 0
+			})
+			state.finished = state.pending && !pending
+			state.pending = pending
+			state.refreshedAt = time.Now()
+		}
+	}
+
+	checkBuildID := state.pending || state.finished
+	state.finished = false
+	return checkBuildID
+}
+
+func (c *IndexChecker) checkReplica(ctx context.Context, collection *meta.Collection, replica *meta.Replica, checkBuildID bool) []task.Task {
 	log := log.Ctx(ctx).With(
 		zap.Int64("collectionID", collection.GetCollectionID()),
 	)
@@ -107,6 +159,12 @@ func (c *IndexChecker) checkReplica(ctx context.Context, collection *meta.Collec
 			}
 		}
 	}
+	if checkBuildID {
+		segmentsToUpdate.Insert(c.checkRebuiltSegments(ctx, collection, segments, targets)...)
+		for _, segment := range segments {
+			idSegments[segment.GetID()] = segment
+		}
+	}
 
 	tasks = lo.FilterMap(segmentsToUpdate.Collect(), func(segmentID int64, _ int) (task.Task, bool) {
 		return c.createSegmentUpdateTask(ctx, idSegments[segmentID], replica)
@@ -130,6 +188,37 @@ func (c *IndexChecker) checkSegment(ctx context.Context, segment *meta.Segment,
 	return result
 }
 
+// checkRebuiltSegments returns the segments whose loaded index is not the serving one any more,
+// the segments missing index are skipped as they are updated anyway.
+func (c *IndexChecker) checkRebuiltSegments(ctx context.Context, collection *meta.Collection, segments []*meta.Segment, missing map[int64][]int64) []int64 {
+	segments = lo.Filter(segments, func(segment *meta.Segment, _ int) bool {
+		_, ok := missing[segment.GetID()]
+		return !ok
+	})
+	if len(segments) == 0 {
+		return nil
+	}
+	infos, err := c.broker.GetIndexInfos(ctx, collection.GetCollectionID(), lo.Map(segments, func(segment *meta.Segment, _ int) int64 {
+		return segment.GetID()
+	}))
+	if err != nil {
+		log.Warn("failed to get index infos of segments", zap.Int64("collectionID", collection.GetCollectionID()), zap.Error(err))
+		return nil
+	}
+
+	var result []int64
+	for _, segment := range segments {
+		for _, info := range infos[segment.GetID()] {
+			loaded, ok := segment.IndexInfo[info.GetFieldID()]
+			if ok && loaded.GetIndexID() == info.GetIndexID() && loaded.GetBuildID() != info.GetBuildID() {
+				result = append(result, segment.GetID())
+				break
+			}
+		}
+	}
+	return result
+}
+
 func (c *IndexChecker) getHistoricalSegmentsDist(replica *meta.Replica) []*meta.Segment {
 	var ret []*meta.Segment
 	for _, node := range replica.GetNodes() {
@@ -21,9 +21,11 @@ import (
 	"testing"
 
 	"github.com/cockroachdb/errors"
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
 	"github.com/milvus-io/milvus/internal/querycoordv2/params"
@@ -31,6 +33,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/task"
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 )
@@ -69,6 +72,7 @@ func (suite *IndexCheckerSuite) SetupTest() {
 	suite.meta = meta.NewMeta(idAllocator, store, suite.nodeMgr)
 	distManager := meta.NewDistributionManager()
 	suite.broker = meta.NewMockBroker(suite.T())
+	suite.broker.EXPECT().DescribeIndex(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
 
 	suite.checker = NewIndexChecker(suite.meta, distManager, suite.broker)
 }
@@ -186,6 +190,99 @@ func (suite *IndexCheckerSuite) TestGetIndexInfoFailed() {
 	suite.Require().Len(tasks, 0)
 }
 
+// prepareRebuiltSegments loads the segments 2 and 3 of collection 1, the index of segment 2 is rebuilt with a new build id
+func (suite *IndexCheckerSuite) prepareRebuiltSegments() (*meta.MockBroker, map[int64][]*querypb.FieldIndexInfo, []*indexpb.IndexInfo) {
+	checker := suite.checker
+	broker := meta.NewMockBroker(suite.T())
+	checker.broker = broker
+
+	// meta
+	coll := utils.CreateTestCollection(1, 1)
+	coll.FieldIndexID = map[int64]int64{101: 1000}
+	checker.meta.CollectionManager.PutCollection(coll)
+	checker.meta.ReplicaManager.Put(utils.CreateTestReplica(200, 1, []int64{1, 2}))
+	suite.nodeMgr.Add(session.NewNodeInfo(1, "localhost"))
+	suite.nodeMgr.Add(session.NewNodeInfo(2, "localhost"))
+	checker.meta.ResourceManager.AssignNode(meta.DefaultResourceGroupName, 1)
+	checker.meta.ResourceManager.AssignNode(meta.DefaultResourceGroupName, 2)
+
+	// dist
+	for _, segmentID := range []int64{2, 3} {
+		segment := utils.CreateTestSegment(1, 1, segmentID, 1, 1, "test-insert-channel")
+		segment.IndexInfo = map[int64]*querypb.FieldIndexInfo{
+			101: {FieldID: 101, IndexID: 1000, BuildID: segmentID * 10, EnableIndex: true},
+		}
+		checker.dist.SegmentDistManager.Update(1, segment)
+	}
+
+	// the index of segment 2 is rebuilt with a new build id
+	indexInfos := map[int64][]*querypb.FieldIndexInfo{
+		2: {{FieldID: 101, IndexID: 1000, BuildID: 21, EnableIndex: true}},
+		3: {{FieldID: 101, IndexID: 1000, BuildID: 30, EnableIndex: true}},
+	}
+	pending := []*indexpb.IndexInfo{{
+		IndexID:            1000,
+		PendingIndexParams: []*commonpb.KeyValuePair{{Key: "M", Value: "32"}},
+	}}
+	return broker, indexInfos, pending
+}
+
+func (suite *IndexCheckerSuite) TestIndexRebuilt() {
+	checker := suite.checker
+	broker, indexInfos, pending := suite.prepareRebuiltSegments()
+	// the alteration state is refreshed at every check
+	paramtable.Get().Save(params.Params.QueryCoordCfg.IndexAlterCheckInterval.Key, "0")
+	defer paramtable.Get().Reset(params.Params.QueryCoordCfg.IndexAlterCheckInterval.Key)
+
+	// build ids are not checked if the index is not altered
+	broker.EXPECT().DescribeIndex(mock.Anything, int64(1)).Return(nil, nil).Once()
+	suite.Empty(checker.Check(context.Background()))
+
+	broker.EXPECT().DescribeIndex(mock.Anything, int64(1)).Return(pending, nil).Once()
+	broker.EXPECT().GetIndexInfos(mock.Anything, int64(1), mock.Anything).Return(indexInfos, nil).Once()
+	tasks := checker.Check(context.Background())
+	suite.Require().Len(tasks, 1)
+	action, ok := tasks[0].Actions()[0].(*task.SegmentAction)
+	suite.Require().True(ok)
+	suite.Equal(task.ActionTypeUpdate, action.Type())
+	suite.EqualValues(2, action.SegmentID())
+
+	// checked once more after the alteration finishes
+	broker.EXPECT().DescribeIndex(mock.Anything, int64(1)).Return(nil, nil).Once()
+	broker.EXPECT().GetIndexInfos(mock.Anything, int64(1), mock.Anything).Return(indexInfos, nil).Once()
+	suite.Len(checker.Check(context.Background()), 1)
+
+	broker.EXPECT().DescribeIndex(mock.Anything, int64(1)).Return(nil, nil).Once()
+	suite.Empty(checker.Check(context.Background()))
+}
+
+func (suite *IndexCheckerSuite) TestIndexAlteringCached() {
+	checker := suite.checker
+	broker, indexInfos, pending := suite.prepareRebuiltSegments()
+
+	// the collection is not checked until the alteration state is described
+	broker.EXPECT().DescribeIndex(mock.Anything, int64(1)).Return(nil, errors.New("mocked error")).Once()
+	suite.Empty(checker.Check(context.Background()))
+
+	// the cached state is used until IndexAlterCheckInterval passes
+	broker.EXPECT().DescribeIndex(mock.Anything, int64(1)).Return(pending, nil).Once()
+	broker.EXPECT().GetIndexInfos(mock.Anything, int64(1), mock.Anything).Return(indexInfos, nil).Twice()
+	suite.Len(checker.Check(context.Background()), 1)
+	suite.Len(checker.Check(context.Background()), 1)
+
+	// the pending state is kept if the refresh fails
+	paramtable.Get().Save(params.Params.QueryCoordCfg.IndexAlterCheckInterval.Key, "0")
+	defer paramtable.Get().Reset(params.Params.QueryCoordCfg.IndexAlterCheckInterval.Key)
+	broker.EXPECT().DescribeIndex(mock.Anything, int64(1)).Return(nil, errors.New("mocked error")).Once()
+	broker.EXPECT().GetIndexInfos(mock.Anything, int64(1), mock.Anything).Return(indexInfos, nil).Once()
+	suite.Len(checker.Check(context.Background()), 1)
+
+	// the released collection is forgotten
+	suite.NoError(checker.meta.CollectionManager.RemoveCollection(1))
+	suite.Empty(checker.Check(context.Background()))
+	suite.Empty(checker.alterStates)
+}
+
 func TestIndexChecker(t *testing.T) {
 	suite.Run(t, new(IndexCheckerSuite))
 }
@@ -31,7 +31,10 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
 	"github.com/milvus-io/milvus/internal/querycoordv2/task"
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/indexparamcheck"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
@@ -42,6 +45,7 @@ type SegmentChecker struct {
 	targetMgr *meta.TargetManager
 	balancer  balance.Balance
 	nodeMgr   *session.NodeManager
+	broker    meta.Broker
 }
 
 func NewSegmentChecker(
@@ -50,6 +54,7 @@ func NewSegmentChecker(
 	targetMgr *meta.TargetManager,
 	balancer balance.Balance,
 	nodeMgr *session.NodeManager,
+	broker meta.Broker,
 ) *SegmentChecker {
 	return &SegmentChecker{
 		meta:      meta,
@@ -57,6 +62,7 @@ func NewSegmentChecker(
 		targetMgr: targetMgr,
 		balancer:  balancer,
 		nodeMgr:   nodeMgr,
+		broker:    broker,
 	}
 }
 
@@ -322,13 +328,109 @@ func (c *SegmentChecker) createSegmentLoadTasks(ctx context.Context, segments []
 		}
 		return !outboundNodes.Contain(node) && !stop
 	})
-	plans := c.balancer.AssignSegment(replica.CollectionID, packedSegments, availableNodes)
+	plans := c.assignSegments(ctx, replica.CollectionID, packedSegments, availableNodes)
 	for i := range plans {
 		plans[i].ReplicaID = replica.GetID()
 	}
 	return balance.CreateSegmentTasksFromPlans(ctx, c.ID(), Params.QueryCoordCfg.SegmentTaskTimeout.GetAsDuration(time.Millisecond), plans)
 }
 
+// assignSegments assigns the segments to the nodes,
+// the segment won't be assigned to the node whose disk would overflow after loading it,
+// and the nodes reaching the load watermarks are avoided if there are other ones.
+func (c *SegmentChecker) assignSegments(ctx context.Context, collectionID int64, segments []*meta.Segment, nodes []int64) []balance.SegmentAssignPlan {
+	underWatermark := lo.Filter(nodes, func(node int64, _ int) bool {
+		info := c.nodeMgr.Get(node)
+		return info != nil && !utils.ExceedLoadWatermark(info)
+	})
+	if len(underWatermark) > 0 {
+		nodes = underWatermark
+	}
+
+	// the node which doesn't report its disk capacity is unlimited
+	diskAvailable := make(map[int64]int64)
+	for _, node := range nodes {
+		info := c.nodeMgr.Get(node)
+		if info != nil && info.DiskCapacity() > 0 {
+			diskAvailable[node] = info.DiskCapacity() - info.DiskUsage()
+		}
+	}
+	if len(diskAvailable) == 0 {
+		return c.balancer.AssignSegment(collectionID, segments, nodes)
+	}
+	diskSizes := c.estimateSegmentDiskSize(ctx, collectionID, segments)
+	if len(diskSizes) == 0 {
+		return c.balancer.AssignSegment(collectionID, segments, nodes)
+	}
+
+	ret := make([]balance.SegmentAssignPlan, 0, len(segments))
+	for len(segments) > 0 && len(nodes) > 0 {
+		rejected := make([]*meta.Segment, 0)
+		fullNodes := typeutil.NewUniqueSet()
+		for _, plan := range c.balancer.AssignSegment(collectionID, segments, nodes) {
+			available, limited := diskAvailable[plan.To]
+			diskSize := diskSizes[plan.Segment.GetID()]
+			if !limited || diskSize <= available {
+				if limited {
+					diskAvailable[plan.To] = available - diskSize
+				}
+				ret = append(ret, plan)
+				continue
+			}
+			log.RatedInfo(10, "node has no sufficient disk to load segment, try other nodes",
+				zap.Int64("collectionID", collectionID),
+				zap.Int64("segmentID", plan.Segment.GetID()),
+				zap.Int64("nodeID", plan.To),
+				zap.Int64("diskSize", diskSize),
+				zap.Int64("diskAvailable", available))
+			rejected = append(rejected, plan.Segment)
+			fullNodes.Insert(plan.To)
+		}
+		// reassign the rejected segments to the rest nodes
+		segments = rejected
+		nodes = lo.Filter(nodes, func(node int64, _ int) bool {
+			return !fullNodes.Contain(node)
+		})
+	}
+	return ret
+}
+
+// estimateSegmentDiskSize estimates the local disk size of the segments to load, only the disk index (DiskANN)
+// occupies local disk. The index size is estimated with the raw data size of the indexed field,
+// as the index isn't known before loading.
+func (c *SegmentChecker) estimateSegmentDiskSize(ctx context.Context, collectionID int64, segments []*meta.Segment) map[int64]int64 {
+	indexes, err := c.broker.DescribeIndex(ctx, collectionID)
+	if err != nil {
+		log.Warn("failed to describe index, skip checking disk usage",
+			zap.Int64("collectionID", collectionID),
+			zap.Error(err))
+		return nil
+	}
+	diskIndexFields := typeutil.NewUniqueSet()
+	for _, index := range indexes {
+		indexType, err := funcutil.GetAttrByKeyFromRepeatedKV(common.IndexTypeKey, index.GetIndexParams())
+		if err == nil && indexType == indexparamcheck.IndexDISKANN {
+			diskIndexFields.Insert(index.GetFieldID())
+		}
+	}
+	if diskIndexFields.Len() == 0 {
+		return nil
+	}
+
+	ret := make(map[int64]int64, len(segments))
+	for _, segment := range segments {
+		for _, fieldBinlog := range segment.GetBinlogs() {
+			if !diskIndexFields.Contain(fieldBinlog.GetFieldID()) {
+				continue
+			}
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				ret[segment.GetID()] += binlog.GetLogSize()
+			}
+		}
+	}
+	return ret
+}
+
 func (c *SegmentChecker) createSegmentReduceTasks(ctx context.Context, segments []*meta.Segment, replicaID int64, scope querypb.DataScope) []task.Task {
 	ret := make([]task.Task, 0, len(segments))
 	for _, s := range segments {
@@ -24,18 +24,22 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/querycoordv2/balance"
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
 	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
 	"github.com/milvus-io/milvus/internal/querycoordv2/task"
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
+	"github.com/milvus-io/milvus/pkg/util/indexparamcheck"
 )
 
 type SegmentCheckerTestSuite struct {
@@ -75,7 +79,7 @@ func (suite *SegmentCheckerTestSuite) SetupTest() {
 	targetManager := meta.NewTargetManager(suite.broker, suite.meta)
 
 	balancer := suite.createMockBalancer()
-	suite.checker = NewSegmentChecker(suite.meta, distManager, targetManager, balancer, suite.nodeMgr)
+	suite.checker = NewSegmentChecker(suite.meta, distManager, targetManager, balancer, suite.nodeMgr, suite.broker)
 
 	suite.broker.EXPECT().GetPartitions(mock.Anything, int64(1)).Return([]int64{1}, nil).Maybe()
 }
@@ -139,6 +143,74 @@ func (suite *SegmentCheckerTestSuite) TestLoadSegments() {
 	suite.Equal(tasks[0].Priority(), task.TaskPriorityNormal)
 }
 
+func (suite *SegmentCheckerTestSuite) TestLoadSegmentsWithDiskLimit() {
+	checker := suite.checker
+	// set meta
+	checker.meta.CollectionManager.PutCollection(utils.CreateTestCollection(1, 1))
+	checker.meta.ReplicaManager.Put(utils.CreateTestReplica(1, 1, []int64{1, 2}))
+	node1 := session.NewNodeInfo(1, "localhost")
+	node1.UpdateStats(session.WithDiskUsage(100, 90))
+	node2 := session.NewNodeInfo(2, "localhost")
+	node2.UpdateStats(session.WithDiskUsage(100, 0))
+	suite.nodeMgr.Add(node1)
+	suite.nodeMgr.Add(node2)
+	checker.meta.ResourceManager.AssignNode(meta.DefaultResourceGroupName, 1)
+	checker.meta.ResourceManager.AssignNode(meta.DefaultResourceGroupName, 2)
+
+	// set target
+	binlogs := []*datapb.FieldBinlog{
+		{
+			FieldID: 101,
+			Binlogs: []*datapb.Binlog{{LogSize: 50}},
+		},
+	}
+	segments := []*datapb.SegmentInfo{
+		{
+			ID:            1,
+			PartitionID:   1,
+			InsertChannel: "test-insert-channel",
+			Binlogs:       binlogs,
+		},
+		{
+			ID:            2,
+			PartitionID:   1,
+			InsertChannel: "test-insert-channel",
+			Binlogs:       binlogs,
+		},
+	}
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, int64(1)).Return(
+		nil, segments, nil)
+	suite.broker.EXPECT().DescribeIndex(mock.Anything, int64(1)).Return([]*indexpb.IndexInfo{
+		{
+			FieldID: 101,
+			IndexParams: []*commonpb.KeyValuePair{
+				{Key: common.IndexTypeKey, Value: indexparamcheck.IndexDISKANN},
+			},
+		},
+	}, nil)
+	checker.targetMgr.UpdateCollectionNextTargetWithPartitions(int64(1), int64(1))
+
+	// set dist
+	checker.dist.ChannelDistManager.Update(2, utils.CreateTestChannel(1, 2, 1, "test-insert-channel"))
+	checker.dist.LeaderViewManager.Update(2, utils.CreateTestLeaderView(2, 1, "test-insert-channel", map[int64]int64{}, map[int64]*meta.Segment{}))
+
+	// node 1 has no sufficient disk, all segments are assigned to node 2
+	tasks := checker.Check(context.TODO())
+	suite.Len(tasks, 2)
+	for _, t := range tasks {
+		suite.Len(t.Actions(), 1)
+		action, ok := t.Actions()[0].(*task.SegmentAction)
+		suite.True(ok)
+		suite.Equal(task.ActionTypeGrow, action.Type())
+		suite.EqualValues(2, action.Node())
+	}
+
+	// no node has sufficient disk
+	node2.UpdateStats(session.WithDiskUsage(100, 60))
+	tasks = checker.Check(context.TODO())
+	suite.Len(tasks, 0)
+}
+
 func (suite *SegmentCheckerTestSuite) TestSkipCheckReplica() {
 	checker := suite.checker
 	// set meta
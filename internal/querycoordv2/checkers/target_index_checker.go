@@ -0,0 +1,210 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checkers
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/task"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+var _ Checker = (*TargetIndexChecker)(nil)
+
+// TargetIndexChecker checks whether the indexes of all the sealed segments in the next targets are ready,
+// the next target of a loaded collection is promoted only after that,
+// to avoid the brute-force searches on the segments without index after handoff.
+// It generates no tasks, and holds no target once deactivated.
+type TargetIndexChecker struct {
+	baseChecker
+	meta      *meta.Meta
+	targetMgr *meta.TargetManager
+	broker    meta.Broker
+
+	mu     sync.RWMutex
+	states map[int64]*targetIndexState // CollectionID -> state of the next target
+}
+
+type targetIndexState struct {
+	targetVersion int64
+	checkedAt     time.Time
+	since         time.Time // the time the next target is found
+	readySegments typeutil.UniqueSet
+	notReady      int
+}
+
+func NewTargetIndexChecker(
+	meta *meta.Meta,
+	targetMgr *meta.TargetManager,
+	broker meta.Broker,
+) *TargetIndexChecker {
+	return &TargetIndexChecker{
+		meta:      meta,
+		targetMgr: targetMgr,
+		broker:    broker,
+		states:    make(map[int64]*targetIndexState),
+	}
+}
+
+func (c *TargetIndexChecker) Description() string {
+	return "TargetIndexChecker checks whether the indexes of the sealed segments in the next targets are ready before promotion"
+}
+
+func (c *TargetIndexChecker) Check(ctx context.Context) []task.Task {
+	collectionIDs := typeutil.NewUniqueSet(c.meta.CollectionManager.GetAll()...)
+	for _, collectionID := range collectionIDs.Collect() {
+		c.checkCollection(ctx, collectionID)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for collectionID := range c.states {
+		if !collectionIDs.Contain(collectionID) {
+			delete(c.states, collectionID)
+		}
+	}
+	return nil
+}
+
+func (c *TargetIndexChecker) checkCollection(ctx context.Context, collectionID int64) {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", collectionID))
+
+	version := c.targetMgr.GetCollectionTargetVersion(collectionID, meta.NextTarget)
+	if version == 0 {
+		c.mu.Lock()
+		delete(c.states, collectionID)
+		c.mu.Unlock()
+		return
+	}
+	segments := c.targetMgr.GetHistoricalSegmentsByCollection(collectionID, meta.NextTarget)
+
+	c.mu.RLock()
+	state, ok := c.states[collectionID]
+	c.mu.RUnlock()
+	now := time.Now()
+	if !ok {
+		state = &targetIndexState{since: now, readySegments: typeutil.NewUniqueSet()}
+	}
+	next := &targetIndexState{
+		targetVersion: version,
+		checkedAt:     now,
+		since:         state.since,
+		readySegments: typeutil.NewUniqueSet(),
+	}
+	if state.targetVersion != version && state.notReady == 0 {
+		// the former target is ready or promoted, the wait starts over for the new one
+		next.since = now
+	}
+
+	indexes, err := c.broker.DescribeIndex(ctx, collectionID)
+	if err != nil && !errors.Is(err, merr.ErrIndexNotFound) {
+		log.Warn("failed to describe index", zap.Error(err))
+		return
+	}
+	for _, segment := range segments {
+		// the index is ready once built, skip the segments checked ready before
+		if state.readySegments.Contain(segment.GetID()) {
+			next.readySegments.Insert(segment.GetID())
+			continue
+		}
+		ready, err := c.isIndexReady(ctx, collectionID, len(indexes), segment)
+		if err != nil {
+			log.Warn("failed to get index info", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+			return
+		}
+		if ready {
+			next.readySegments.Insert(segment.GetID())
+		} else {
+			next.notReady++
+		}
+	}
+	if next.notReady > 0 {
+		log.RatedInfo(10, "indexes of the next target not ready",
+			zap.Int64("targetVersion", version),
+			zap.Int("notReadySegments", next.notReady),
+			zap.Duration("waited", now.Sub(next.since)))
+	}
+
+	c.mu.Lock()
+	c.states[collectionID] = next
+	c.mu.Unlock()
+}
+
+func (c *TargetIndexChecker) isIndexReady(ctx context.Context, collectionID int64, indexNum int, segment *datapb.SegmentInfo) (bool, error) {
+	// the small segments are never indexed
+	if indexNum == 0 ||
+		segment.GetNumOfRows() < Params.DataCoordCfg.MinSegmentNumRowsToEnableIndex.GetAsInt64() {
+		return true, nil
+	}
+	infos, err := c.broker.GetIndexInfo(ctx, collectionID, segment.GetID())
+	if errors.Is(err, merr.ErrIndexNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	built := 0
+	for _, info := range infos {
+		if info.GetEnableIndex() {
+			built++
+		}
+	}
+	return built >= indexNum, nil
+}
+
+// IsTargetIndexReady returns whether the next target of the collection could be promoted,
+// which is true if the checker is deactivated, the collection has no current target yet,
+// the indexes of all the sealed segments in the next target are ready,
+// or the next target has waited for the indexes longer than the timeout.
+func (c *TargetIndexChecker) IsTargetIndexReady(collectionID int64) bool {
+	if !c.IsActive() {
+		return true
+	}
+	// hold no loading collection, which is not serving yet
+	if c.targetMgr.GetCollectionTargetVersion(collectionID, meta.CurrentTarget) == 0 {
+		return true
+	}
+
+	c.mu.RLock()
+	state, ok := c.states[collectionID]
+	c.mu.RUnlock()
+	if !ok {
+		return false
+	}
+	if state.targetVersion == c.targetMgr.GetCollectionTargetVersion(collectionID, meta.NextTarget) &&
+		state.notReady == 0 {
+		return true
+	}
+	timeout := Params.QueryCoordCfg.TargetIndexWaitTimeout.GetAsDuration(time.Second)
+	if time.Since(state.since) > timeout {
+		log.RatedWarn(10, "promote the next target without indexes ready after timeout",
+			zap.Int64("collectionID", collectionID),
+			zap.Int("notReadySegments", state.notReady),
+			zap.Duration("timeout", timeout))
+		return true
+	}
+	return false
+}
@@ -0,0 +1,151 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checkers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/pkg/util/etcd"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type TargetIndexCheckerSuite struct {
+	suite.Suite
+	kv      kv.MetaKv
+	checker *TargetIndexChecker
+	broker  *meta.MockBroker
+}
+
+func (suite *TargetIndexCheckerSuite) SetupSuite() {
+	Params.Init()
+}
+
+func (suite *TargetIndexCheckerSuite) SetupTest() {
+	var err error
+	config := GenerateEtcdConfig()
+	cli, err := etcd.GetEtcdClient(
+		config.UseEmbedEtcd.GetAsBool(),
+		config.EtcdUseSSL.GetAsBool(),
+		config.Endpoints.GetAsStrings(),
+		config.EtcdTLSCert.GetValue(),
+		config.EtcdTLSKey.GetValue(),
+		config.EtcdTLSCACert.GetValue(),
+		config.EtcdTLSMinVersion.GetValue())
+	suite.Require().NoError(err)
+	suite.kv = etcdkv.NewEtcdKV(cli, config.MetaRootPath.GetValue())
+
+	// meta
+	store := querycoord.NewCatalog(suite.kv)
+	idAllocator := RandomIncrementIDAllocator()
+	m := meta.NewMeta(idAllocator, store, session.NewNodeManager())
+	suite.broker = meta.NewMockBroker(suite.T())
+	targetManager := meta.NewTargetManager(suite.broker, m)
+
+	suite.checker = NewTargetIndexChecker(m, targetManager, suite.broker)
+}
+
+func (suite *TargetIndexCheckerSuite) TearDownTest() {
+	suite.kv.Close()
+}
+
+// setTargets sets the current target with segment 1,
+// and the next target with the indexed segments 1 and 2, and the small segment 3
+func (suite *TargetIndexCheckerSuite) setTargets() {
+	checker := suite.checker
+	checker.meta.CollectionManager.PutCollection(utils.CreateTestCollection(1, 1))
+
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, int64(1)).Return(nil, []*datapb.SegmentInfo{
+		{ID: 1, PartitionID: 1, InsertChannel: "test-insert-channel", NumOfRows: 2048},
+	}, nil).Once()
+	checker.targetMgr.UpdateCollectionNextTargetWithPartitions(int64(1), int64(1))
+	// hold no loading collection
+	suite.True(checker.IsTargetIndexReady(1))
+	checker.targetMgr.UpdateCollectionCurrentTarget(1)
+
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, int64(1)).Return(nil, []*datapb.SegmentInfo{
+		{ID: 1, PartitionID: 1, InsertChannel: "test-insert-channel", NumOfRows: 2048},
+		{ID: 2, PartitionID: 1, InsertChannel: "test-insert-channel", NumOfRows: 2048},
+		{ID: 3, PartitionID: 1, InsertChannel: "test-insert-channel", NumOfRows: 10},
+	}, nil).Once()
+	checker.targetMgr.UpdateCollectionNextTargetWithPartitions(int64(1), int64(1))
+
+	suite.broker.EXPECT().DescribeIndex(mock.Anything, int64(1)).
+		Return([]*indexpb.IndexInfo{{FieldID: 101, IndexID: 1000}}, nil)
+}
+
+func (suite *TargetIndexCheckerSuite) TestIndexReady() {
+	checker := suite.checker
+	suite.setTargets()
+
+	// not checked yet
+	suite.False(checker.IsTargetIndexReady(1))
+
+	suite.broker.EXPECT().GetIndexInfo(mock.Anything, int64(1), int64(1)).
+		Return([]*querypb.FieldIndexInfo{{FieldID: 101, IndexID: 1000, EnableIndex: true}}, nil).Once()
+	suite.broker.EXPECT().GetIndexInfo(mock.Anything, int64(1), int64(2)).
+		Return([]*querypb.FieldIndexInfo{}, nil).Once()
+	suite.Empty(checker.Check(context.Background()))
+	suite.False(checker.IsTargetIndexReady(1))
+
+	// the deactivated checker holds no target
+	checker.Deactivate()
+	suite.True(checker.IsTargetIndexReady(1))
+	checker.Activate()
+
+	// segment 1 is not checked again
+	suite.broker.EXPECT().GetIndexInfo(mock.Anything, int64(1), int64(2)).
+		Return([]*querypb.FieldIndexInfo{{FieldID: 101, IndexID: 1000, EnableIndex: true}}, nil).Once()
+	suite.Empty(checker.Check(context.Background()))
+	suite.True(checker.IsTargetIndexReady(1))
+
+	// the states of released collections are removed
+	checker.meta.CollectionManager.RemoveCollection(1)
+	checker.Check(context.Background())
+	suite.NotContains(checker.states, int64(1))
+}
+
+func (suite *TargetIndexCheckerSuite) TestWaitTimeout() {
+	checker := suite.checker
+	suite.setTargets()
+
+	suite.broker.EXPECT().GetIndexInfo(mock.Anything, int64(1), mock.Anything).
+		Return([]*querypb.FieldIndexInfo{}, nil)
+	checker.Check(context.Background())
+	suite.False(checker.IsTargetIndexReady(1))
+
+	paramtable.Get().Save(Params.QueryCoordCfg.TargetIndexWaitTimeout.Key, "0")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.TargetIndexWaitTimeout.Key)
+	suite.True(checker.IsTargetIndexReady(1))
+}
+
+func TestTargetIndexChecker(t *testing.T) {
+	suite.Run(t, new(TargetIndexCheckerSuite))
+}
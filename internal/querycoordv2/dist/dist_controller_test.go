@@ -43,6 +43,7 @@ type DistControllerTestSuite struct {
 	controller    *ControllerImpl
 	mockCluster   *session.MockCluster
 	mockScheduler *task.MockScheduler
+	nodeManager   *session.NodeManager
 
 	kv     kv.MetaKv
 	meta   *meta.Meta
@@ -71,12 +72,12 @@ func (suite *DistControllerTestSuite) SetupTest() {
 	suite.meta = meta.NewMeta(idAllocator, store, session.NewNodeManager())
 
 	suite.mockCluster = session.NewMockCluster(suite.T())
-	nodeManager := session.NewNodeManager()
+	suite.nodeManager = session.NewNodeManager()
 	distManager := meta.NewDistributionManager()
 	suite.broker = meta.NewMockBroker(suite.T())
 	targetManager := meta.NewTargetManager(suite.broker, suite.meta)
 	suite.mockScheduler = task.NewMockScheduler(suite.T())
-	suite.controller = NewDistController(suite.mockCluster, nodeManager, distManager, targetManager, suite.mockScheduler)
+	suite.controller = NewDistController(suite.mockCluster, suite.nodeManager, distManager, targetManager, suite.mockScheduler)
 }
 
 func (suite *DistControllerTestSuite) TearDownSuite() {
@@ -166,6 +167,39 @@ func (suite *DistControllerTestSuite) TestSyncAll() {
 	)
 }
 
+func (suite *DistControllerTestSuite) TestDiskUsage() {
+	suite.nodeManager.Add(session.NewNodeInfo(1, "localhost"))
+	suite.mockCluster.EXPECT().GetDataDistribution(mock.Anything, mock.Anything, mock.Anything).Return(
+		&querypb.GetDataDistributionResponse{
+			Status:        merr.Status(nil),
+			NodeID:        1,
+			DiskCapacity:  100,
+			DiskUsed:      20,
+			DiskCommitted: 10,
+			CpuUsage:      50,
+			MemoryUsed:    25,
+			MemoryTotal:   100,
+			SearchNqRate:  1000,
+		},
+		nil,
+	)
+	suite.mockScheduler.EXPECT().Dispatch(int64(1)).Maybe()
+	suite.controller.StartDistInstance(context.TODO(), 1)
+	defer suite.controller.Remove(1)
+
+	node := suite.nodeManager.Get(1)
+	suite.Eventually(
+		func() bool {
+			return node.DiskCapacity() == 100 && node.DiskUsage() == 30
+		},
+		10*time.Second,
+		500*time.Millisecond,
+	)
+	suite.Equal(50.0, node.CPUUsage())
+	suite.Equal(0.25, node.MemoryUsage())
+	suite.Equal(1000.0, node.SearchNQRate())
+}
+
 func TestDistControllerSuite(t *testing.T) {
 	suite.Run(t, new(DistControllerTestSuite))
 }
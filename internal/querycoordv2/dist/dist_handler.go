@@ -95,6 +95,9 @@ func (dh *distHandler) handleDistResp(resp *querypb.GetDataDistributionResponse)
 		node.UpdateStats(
 			session.WithSegmentCnt(len(resp.GetSegments())),
 			session.WithChannelCnt(len(resp.GetChannels())),
+			session.WithDiskUsage(resp.GetDiskCapacity(), resp.GetDiskUsed()+resp.GetDiskCommitted()),
+			session.WithRuntimeLoad(resp.GetCpuUsage(), resp.GetMemoryUsed(), resp.GetMemoryTotal(), resp.GetSearchNqRate()),
+			session.WithSearchLatency(resp.GetSearchQueueLatency()),
 		)
 		if time.Since(node.LastHeartbeat()) > heartBeatLagBehindWarn {
 			log.Warn("node last heart beat time lag too behind", zap.Time("now", time.Now()),
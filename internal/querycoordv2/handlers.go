@@ -19,6 +19,7 @@ package querycoordv2
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -141,7 +142,7 @@ func (s *Server) balanceSegments(ctx context.Context, req *querypb.LoadBalanceRe
 			zap.Int64("destNodeID", plan.To),
 			zap.Int64("segmentID", plan.Segment.GetID()),
 		)
-		task, err := task.NewSegmentTask(ctx,
+		t, err := task.NewSegmentTask(ctx,
 			Params.QueryCoordCfg.SegmentTaskTimeout.GetAsDuration(time.Millisecond),
 			req.GetBase().GetMsgID(),
 			req.GetCollectionID(),
@@ -161,12 +162,13 @@ func (s *Server) balanceSegments(ctx context.Context, req *querypb.LoadBalanceRe
 			)
 			continue
 		}
-		err = s.taskScheduler.Add(task)
+		t.SetClass(task.TaskClassBalance)
+		err = s.taskScheduler.Add(t)
 		if err != nil {
-			task.Cancel(err)
+			t.Cancel(err)
 			return err
 		}
-		tasks = append(tasks, task)
+		tasks = append(tasks, t)
 	}
 	return task.Wait(ctx, Params.QueryCoordCfg.SegmentTaskTimeout.GetAsDuration(time.Millisecond), tasks...)
 }
@@ -270,6 +272,9 @@ func (s *Server) fillMetricsWithNodes(topo *metricsinfo.QueryClusterTopology, no
 			})
 			continue
 		}
+		if rg, err := s.meta.ResourceManager.FindResourceGroupByNode(infos.ID); err == nil {
+			infos.ResourceGroup = rg
+		}
 		topo.ConnectedNodes = append(topo.ConnectedNodes, infos)
 	}
 }
@@ -397,3 +402,87 @@ func filterDupLeaders(replicaManager *meta.ReplicaManager, leaders map[int64]*me
 	}
 	return result
 }
+
+// getLoadProgressEvent returns the snapshot of the load progress of the collection or the partitions,
+// counts the target segments and channels served by the delegators over all the replicas.
+func (s *Server) getLoadProgressEvent(collectionID int64, partitionIDs []int64) (*querypb.LoadProgressEvent, error) {
+	collection := s.meta.CollectionManager.GetCollection(collectionID)
+	if collection == nil {
+		return nil, merr.WrapErrCollectionNotLoaded(collectionID)
+	}
+
+	var progress int32
+	if len(partitionIDs) == 0 {
+		progress = s.meta.CollectionManager.CalculateLoadPercentage(collectionID)
+		if progress < 0 {
+			progress = 0
+		}
+		partitionIDs = lo.Map(s.meta.CollectionManager.GetPartitionsByCollection(collectionID), func(partition *meta.Partition, _ int) int64 {
+			return partition.GetPartitionID()
+		})
+	} else {
+		progress = 100
+		for _, partitionID := range partitionIDs {
+			percentage := s.meta.CollectionManager.GetPartitionLoadPercentage(partitionID)
+			if percentage < 0 {
+				return nil, merr.WrapErrPartitionNotLoaded(partitionID)
+			}
+			if percentage < progress {
+				progress = percentage
+			}
+		}
+	}
+
+	// the next target is cleared once it's updated to the current target
+	scope := meta.NextTarget
+	if len(s.targetMgr.GetDmChannelsByCollection(collectionID, scope)) == 0 {
+		scope = meta.CurrentTarget
+	}
+	channels := s.targetMgr.GetDmChannelsByCollection(collectionID, scope)
+	segments := make([]int64, 0)
+	for _, partitionID := range partitionIDs {
+		segments = append(segments, lo.Keys(s.targetMgr.GetHistoricalSegmentsByPartition(collectionID, partitionID, scope))...)
+	}
+
+	replicaNum := int64(collection.GetReplicaNumber())
+	event := &querypb.LoadProgressEvent{
+		Status:        merr.Status(nil),
+		CollectionID:  collectionID,
+		Progress:      progress,
+		TotalSegments: int64(len(segments)) * replicaNum,
+		TotalChannels: int64(len(channels)) * replicaNum,
+		Timestamp:     time.Now().UnixMilli(),
+	}
+	nodes := make(map[int64]*querypb.NodeLoadProgress)
+	getNode := func(nodeID int64) *querypb.NodeLoadProgress {
+		node, ok := nodes[nodeID]
+		if !ok {
+			node = &querypb.NodeLoadProgress{NodeID: nodeID}
+			nodes[nodeID] = node
+		}
+		return node
+	}
+	for _, channel := range channels {
+		dist := s.dist.LeaderViewManager.GetChannelDist(channel.GetChannelName())
+		for _, group := range utils.GroupNodesByReplica(s.meta.ReplicaManager, collectionID, dist) {
+			event.LoadedChannels++
+			for _, nodeID := range group {
+				getNode(nodeID).LoadedChannels++
+			}
+		}
+	}
+	for _, segmentID := range segments {
+		dist := s.dist.LeaderViewManager.GetSealedSegmentDist(segmentID)
+		for _, group := range utils.GroupNodesByReplica(s.meta.ReplicaManager, collectionID, dist) {
+			event.LoadedSegments++
+			for _, nodeID := range group {
+				getNode(nodeID).LoadedSegments++
+			}
+		}
+	}
+	event.Nodes = lo.Values(nodes)
+	sort.Slice(event.Nodes, func(i, j int) bool {
+		return event.Nodes[i].GetNodeID() < event.Nodes[j].GetNodeID()
+	})
+	return event, nil
+}
@@ -91,7 +91,7 @@ func (job *LoadCollectionJob) PreExecute() error {
 		return nil
 	}
 
-	if collection.GetReplicaNumber() != req.GetReplicaNumber() {
+	if !replicaNumberMatched(collection.GetReplicaNumber(), req.GetReplicaNumber()) {
 		msg := fmt.Sprintf("collection with different replica number %d existed, release this collection first before changing its replica number",
 			job.meta.GetReplicaNumber(req.GetCollectionID()),
 		)
@@ -147,7 +147,14 @@ func (job *LoadCollectionJob) Execute() error {
 	// 2. create replica if not exist
 	replicas := job.meta.ReplicaManager.GetByCollection(req.GetCollectionID())
 	if len(replicas) == 0 {
-		replicas, err = utils.SpawnReplicasWithRG(job.meta, req.GetCollectionID(), req.GetResourceGroups(), req.GetReplicaNumber())
+		var placementLabels map[string]string
+		placementLabels, err = getPlacementLabels(job.ctx, job.broker, req.GetCollectionID())
+		if err != nil {
+			msg := "failed to get placement labels of collection"
+			log.Error(msg, zap.Error(err))
+			return errors.Wrap(err, msg)
+		}
+		replicas, err = utils.SpawnReplicasWithRG(job.meta, req.GetCollectionID(), req.GetResourceGroups(), req.GetReplicaNumber(), placementLabels)
 		if err != nil {
 			msg := "failed to spawn replica for collection"
 			log.Error(msg, zap.Error(err))
@@ -271,7 +278,7 @@ func (job *LoadPartitionJob) PreExecute() error {
 		return nil
 	}
 
-	if collection.GetReplicaNumber() != req.GetReplicaNumber() {
+	if !replicaNumberMatched(collection.GetReplicaNumber(), req.GetReplicaNumber()) {
 		msg := "collection with different replica number existed, release this collection first before changing its replica number"
 		log.Warn(msg)
 		return merr.WrapErrParameterInvalid(collection.GetReplicaNumber(), req.GetReplicaNumber(), "can't change the replica number for loaded partitions")
@@ -322,7 +329,14 @@ func (job *LoadPartitionJob) Execute() error {
 	// 2. create replica if not exist
 	replicas := job.meta.ReplicaManager.GetByCollection(req.GetCollectionID())
 	if len(replicas) == 0 {
-		replicas, err = utils.SpawnReplicasWithRG(job.meta, req.GetCollectionID(), req.GetResourceGroups(), req.GetReplicaNumber())
+		var placementLabels map[string]string
+		placementLabels, err = getPlacementLabels(job.ctx, job.broker, req.GetCollectionID())
+		if err != nil {
+			msg := "failed to get placement labels of collection"
+			log.Error(msg, zap.Error(err))
+			return errors.Wrap(err, msg)
+		}
+		replicas, err = utils.SpawnReplicasWithRG(job.meta, req.GetCollectionID(), req.GetResourceGroups(), req.GetReplicaNumber(), placementLabels)
 		if err != nil {
 			msg := "failed to spawn replica for collection"
 			log.Error(msg, zap.Error(err))
@@ -23,6 +23,7 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
@@ -129,6 +130,8 @@ func (suite *JobSuite) SetupSuite() {
 		Return(nil, nil)
 	suite.broker.EXPECT().DescribeIndex(mock.Anything, mock.Anything).
 		Return(nil, nil)
+	suite.broker.EXPECT().GetCollectionProperties(mock.Anything, mock.Anything).
+		Return(nil, nil).Maybe()
 
 	suite.cluster = session.NewMockCluster(suite.T())
 	suite.cluster.EXPECT().
@@ -1531,3 +1534,19 @@ func (suite *JobSuite) assertPartitionReleased(collection int64, partitionIDs ..
 func TestJob(t *testing.T) {
 	suite.Run(t, new(JobSuite))
 }
+
+func TestParsePlacementLabels(t *testing.T) {
+	labels, err := parsePlacementLabels("disk=nvme, zone=us-east-1a")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"disk": "nvme", "zone": "us-east-1a"}, labels)
+
+	labels, err = parsePlacementLabels("")
+	assert.NoError(t, err)
+	assert.Nil(t, labels)
+
+	_, err = parsePlacementLabels("disk")
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+	_, err = parsePlacementLabels("=nvme")
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+}
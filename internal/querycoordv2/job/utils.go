@@ -18,6 +18,7 @@ package job
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/samber/lo"
@@ -28,7 +29,9 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/querycoordv2/checkers"
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/querycoordv2/params"
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
@@ -138,3 +141,51 @@ func releasePartitions(ctx context.Context,
 		}
 	}
 }
+
+// replicaNumberMatched returns whether the requested replica number matches the loaded one,
+// any number within the range of the replica auto-scaling matches if it's enabled,
+// as the replicas are added or removed automatically.
+func replicaNumberMatched(loaded, requested int32) bool {
+	if loaded == requested {
+		return true
+	}
+	if !params.Params.QueryCoordCfg.ReplicaAutoScaleEnabled.GetAsBool() {
+		return false
+	}
+	return requested >= params.Params.QueryCoordCfg.ReplicaAutoScaleMinReplicas.GetAsInt32() &&
+		requested <= params.Params.QueryCoordCfg.ReplicaAutoScaleMaxReplicas.GetAsInt32()
+}
+
+// getPlacementLabels returns the labels the nodes must match to load the collection,
+// which are parsed from the collection property like "disk=nvme,zone=us-east-1a".
+func getPlacementLabels(ctx context.Context, broker meta.Broker, collection int64) (map[string]string, error) {
+	properties, err := broker.GetCollectionProperties(ctx, collection)
+	if err != nil {
+		return nil, err
+	}
+	for _, kv := range properties {
+		if kv.GetKey() == common.CollectionPlacementLabelsKey {
+			return parsePlacementLabels(kv.GetValue())
+		}
+	}
+	return nil, nil
+}
+
+func parsePlacementLabels(value string) (map[string]string, error) {
+	labels := make(map[string]string)
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 || strings.TrimSpace(kv[0]) == "" {
+			return nil, merr.WrapErrParameterInvalid("key=value", pair, "invalid placement label")
+		}
+		labels[strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
+	}
+	if len(labels) == 0 {
+		return nil, nil
+	}
+	return labels, nil
+}
@@ -442,6 +442,52 @@ func (m *CollectionManager) putCollection(withSave bool, collection *Collection,
 	return nil
 }
 
+// UpdateReplicaNumber updates the replica number of the collection and its partitions,
+// e.g. the replicas are added or removed by the auto-scaling.
+func (m *CollectionManager) UpdateReplicaNumber(collectionID UniqueID, replicaNumber int32) error {
+	m.rwmutex.Lock()
+	defer m.rwmutex.Unlock()
+
+	collection, ok := m.collections[collectionID]
+	if !ok {
+		return merr.WrapErrCollectionNotFound(collectionID)
+	}
+	collection = collection.Clone()
+	collection.ReplicaNumber = replicaNumber
+	partitions := lo.Map(m.getPartitionsByCollection(collectionID), func(partition *Partition, _ int) *Partition {
+		partition = partition.Clone()
+		partition.ReplicaNumber = replicaNumber
+		return partition
+	})
+	return m.putCollection(true, collection, partitions...)
+}
+
+// SetBalanceSuspended suspends or resumes the balance of the collection, the suspension is persisted.
+func (m *CollectionManager) SetBalanceSuspended(collectionID UniqueID, suspended bool) error {
+	m.rwmutex.Lock()
+	defer m.rwmutex.Unlock()
+
+	collection, ok := m.collections[collectionID]
+	if !ok {
+		return merr.WrapErrCollectionNotFound(collectionID)
+	}
+	if collection.GetBalanceSuspended() == suspended {
+		return nil
+	}
+	collection = collection.Clone()
+	collection.BalanceSuspended = suspended
+	return m.putCollection(true, collection)
+}
+
+// IsBalanceSuspended returns whether the balance of the collection is suspended.
+func (m *CollectionManager) IsBalanceSuspended(collectionID UniqueID) bool {
+	m.rwmutex.RLock()
+	defer m.rwmutex.RUnlock()
+
+	collection, ok := m.collections[collectionID]
+	return ok && collection.GetBalanceSuspended()
+}
+
 func (m *CollectionManager) PutPartition(partitions ...*Partition) error {
 	m.rwmutex.Lock()
 	defer m.rwmutex.Unlock()
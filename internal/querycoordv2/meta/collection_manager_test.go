@@ -226,6 +226,46 @@ func (suite *CollectionManagerSuite) TestUpdate() {
 	}
 }
 
+func (suite *CollectionManagerSuite) TestUpdateReplicaNumber() {
+	mgr := suite.mgr
+
+	for _, collection := range suite.collections {
+		err := mgr.UpdateReplicaNumber(collection, 5)
+		suite.NoError(err)
+		suite.EqualValues(5, mgr.GetReplicaNumber(collection))
+		for _, partition := range mgr.GetPartitionsByCollection(collection) {
+			suite.EqualValues(5, partition.GetReplicaNumber())
+		}
+	}
+
+	err := mgr.UpdateReplicaNumber(999, 5)
+	suite.ErrorIs(err, merr.ErrCollectionNotFound)
+}
+
+func (suite *CollectionManagerSuite) TestSetBalanceSuspended() {
+	mgr := suite.mgr
+	collection := suite.collections[0]
+
+	suite.False(mgr.IsBalanceSuspended(collection))
+	suite.NoError(mgr.SetBalanceSuspended(collection, true))
+	suite.True(mgr.IsBalanceSuspended(collection))
+	suite.False(mgr.IsBalanceSuspended(suite.collections[1]))
+
+	// the suspension is persisted
+	infos, err := suite.catalog.GetCollections()
+	suite.NoError(err)
+	for _, info := range infos {
+		suite.Equal(info.GetCollectionID() == collection, info.GetBalanceSuspended())
+	}
+
+	suite.NoError(mgr.SetBalanceSuspended(collection, false))
+	suite.False(mgr.IsBalanceSuspended(collection))
+
+	err = mgr.SetBalanceSuspended(999, true)
+	suite.ErrorIs(err, merr.ErrCollectionNotFound)
+	suite.False(mgr.IsBalanceSuspended(999))
+}
+
 func (suite *CollectionManagerSuite) TestGetFieldIndex() {
 	mgr := suite.mgr
 	mgr.PutCollection(&Collection{
@@ -41,11 +41,13 @@ import (
 
 type Broker interface {
 	GetCollectionSchema(ctx context.Context, collectionID UniqueID) (*schemapb.CollectionSchema, error)
+	GetCollectionProperties(ctx context.Context, collectionID UniqueID) ([]*commonpb.KeyValuePair, error)
 	GetPartitions(ctx context.Context, collectionID UniqueID) ([]UniqueID, error)
 	GetRecoveryInfo(ctx context.Context, collectionID UniqueID, partitionID UniqueID) ([]*datapb.VchannelInfo, []*datapb.SegmentBinlogs, error)
 	DescribeIndex(ctx context.Context, collectionID UniqueID) ([]*indexpb.IndexInfo, error)
 	GetSegmentInfo(ctx context.Context, segmentID ...UniqueID) (*datapb.GetSegmentInfoResponse, error)
 	GetIndexInfo(ctx context.Context, collectionID UniqueID, segmentID UniqueID) ([]*querypb.FieldIndexInfo, error)
+	GetIndexInfos(ctx context.Context, collectionID UniqueID, segmentIDs []UniqueID) (map[UniqueID][]*querypb.FieldIndexInfo, error)
 	GetRecoveryInfoV2(ctx context.Context, collectionID UniqueID, partitionIDs ...UniqueID) ([]*datapb.VchannelInfo, []*datapb.SegmentInfo, error)
 }
 
@@ -64,6 +66,23 @@ func NewCoordinatorBroker(
 }
 
 func (broker *CoordinatorBroker) GetCollectionSchema(ctx context.Context, collectionID UniqueID) (*schemapb.CollectionSchema, error) {
+	resp, err := broker.describeCollection(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetSchema(), nil
+}
+
+// GetCollectionProperties returns the properties of the collection, such as the placement labels.
+func (broker *CoordinatorBroker) GetCollectionProperties(ctx context.Context, collectionID UniqueID) ([]*commonpb.KeyValuePair, error) {
+	resp, err := broker.describeCollection(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetProperties(), nil
+}
+
+func (broker *CoordinatorBroker) describeCollection(ctx context.Context, collectionID UniqueID) (*milvuspb.DescribeCollectionResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, paramtable.Get().QueryCoordCfg.BrokerTimeout.GetAsDuration(time.Millisecond))
 	defer cancel()
 
@@ -86,10 +105,10 @@ func (broker *CoordinatorBroker) GetCollectionSchema(ctx context.Context, collec
 
 	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
 		err = errors.New(resp.GetStatus().GetReason())
-		log.Error("failed to get collection schema", zap.Int64("collectionID", collectionID), zap.Error(err))
+		log.Error("failed to describe collection", zap.Int64("collectionID", collectionID), zap.Error(err))
 		return nil, err
 	}
-	return resp.GetSchema(), nil
+	return resp, nil
 }
 
 func (broker *CoordinatorBroker) GetPartitions(ctx context.Context, collectionID UniqueID) ([]UniqueID, error) {
@@ -220,23 +239,56 @@ func (broker *CoordinatorBroker) GetIndexInfo(ctx context.Context, collectionID
 		return nil, merr.WrapErrIndexNotFound()
 	}
 
-	indexes := make([]*querypb.FieldIndexInfo, 0)
+	return toFieldIndexInfos(segmentInfo), nil
+}
+
+// GetIndexInfos returns the index infos of the segments in a batch, the segments without any index are omitted.
+func (broker *CoordinatorBroker) GetIndexInfos(ctx context.Context, collectionID UniqueID, segmentIDs []UniqueID) (map[UniqueID][]*querypb.FieldIndexInfo, error) {
+	ctx, cancel := context.WithTimeout(ctx, paramtable.Get().QueryCoordCfg.BrokerTimeout.GetAsDuration(time.Millisecond))
+	defer cancel()
+
+	resp, err := broker.dataCoord.GetIndexInfos(ctx, &indexpb.GetIndexInfoRequest{
+		CollectionID: collectionID,
+		SegmentIDs:   segmentIDs,
+	})
+	if err == nil && resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		err = merr.Error(resp.GetStatus())
+	}
+	if err != nil {
+		log.Error("failed to get segment index infos",
+			zap.Int64("collection", collectionID),
+			zap.Int("segmentNum", len(segmentIDs)),
+			zap.Error(err))
+		return nil, err
+	}
+
+	indexes := make(map[UniqueID][]*querypb.FieldIndexInfo, len(resp.GetSegmentInfo()))
+	for segmentID, segmentInfo := range resp.GetSegmentInfo() {
+		if len(segmentInfo.GetIndexInfos()) > 0 {
+			indexes[segmentID] = toFieldIndexInfos(segmentInfo)
+		}
+	}
+	return indexes, nil
+}
+
+func toFieldIndexInfos(segmentInfo *indexpb.SegmentInfo) []*querypb.FieldIndexInfo {
+	indexes := make([]*querypb.FieldIndexInfo, 0, len(segmentInfo.GetIndexInfos()))
 	for _, info := range segmentInfo.GetIndexInfos() {
 		indexes = append(indexes, &querypb.FieldIndexInfo{
-			FieldID:        info.GetFieldID(),
-			EnableIndex:    true,
-			IndexName:      info.GetIndexName(),
-			IndexID:        info.GetIndexID(),
-			BuildID:        info.GetBuildID(),
-			IndexParams:    info.GetIndexParams(),
-			IndexFilePaths: info.GetIndexFilePaths(),
-			IndexSize:      int64(info.GetSerializedSize()),
-			IndexVersion:   info.GetIndexVersion(),
-			NumRows:        info.GetNumRows(),
+			FieldID:            info.GetFieldID(),
+			EnableIndex:        true,
+			IndexName:          info.GetIndexName(),
+			IndexID:            info.GetIndexID(),
+			BuildID:            info.GetBuildID(),
+			IndexParams:        info.GetIndexParams(),
+			IndexFilePaths:     info.GetIndexFilePaths(),
+			IndexSize:          int64(info.GetSerializedSize()),
+			IndexVersion:       info.GetIndexVersion(),
+			NumRows:            info.GetNumRows(),
+			IndexEngineVersion: info.GetIndexEngineVersion(),
 		})
 	}
-
-	return indexes, nil
+	return indexes
 }
 
 func (broker *CoordinatorBroker) DescribeIndex(ctx context.Context, collectionID UniqueID) ([]*indexpb.IndexInfo, error) {
@@ -76,6 +76,37 @@ func TestCoordinatorBroker_GetCollectionSchema(t *testing.T) {
 	})
 }
 
+func TestCoordinatorBroker_GetCollectionProperties(t *testing.T) {
+	t.Run("got error on DescribeCollection", func(t *testing.T) {
+		rootCoord := mocks.NewRootCoord(t)
+		rootCoord.On("DescribeCollection",
+			mock.Anything,
+			mock.Anything,
+		).Return(nil, errors.New("error mock DescribeCollection"))
+		ctx := context.Background()
+		broker := &CoordinatorBroker{rootCoord: rootCoord}
+		_, err := broker.GetCollectionProperties(ctx, 100)
+		assert.Error(t, err)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		rootCoord := mocks.NewRootCoord(t)
+		rootCoord.On("DescribeCollection",
+			mock.Anything,
+			mock.Anything,
+		).Return(&milvuspb.DescribeCollectionResponse{
+			Status:     &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			Properties: []*commonpb.KeyValuePair{{Key: "collection.placement.labels", Value: "disk=nvme"}},
+		}, nil)
+		ctx := context.Background()
+		broker := &CoordinatorBroker{rootCoord: rootCoord}
+		properties, err := broker.GetCollectionProperties(ctx, 100)
+		assert.NoError(t, err)
+		assert.Len(t, properties, 1)
+		assert.Equal(t, "disk=nvme", properties[0].GetValue())
+	})
+}
+
 func TestCoordinatorBroker_GetRecoveryInfo(t *testing.T) {
 	t.Run("normal case", func(t *testing.T) {
 		dc := mocks.NewMockDataCoord(t)
@@ -5,6 +5,8 @@ package meta
 import (
 	context "context"
 
+	commonpb "github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+
 	datapb "github.com/milvus-io/milvus/internal/proto/datapb"
 	indexpb "github.com/milvus-io/milvus/internal/proto/indexpb"
 
@@ -75,6 +77,53 @@ func (_c *MockBroker_DescribeIndex_Call) Return(_a0 []*indexpb.IndexInfo, _a1 er
 	return _c
 }
 
+// GetCollectionProperties provides a mock function with given fields: ctx, collectionID
+func (_m *MockBroker) GetCollectionProperties(ctx context.Context, collectionID int64) ([]*commonpb.KeyValuePair, error) {
+	ret := _m.Called(ctx, collectionID)
+
+	var r0 []*commonpb.KeyValuePair
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []*commonpb.KeyValuePair); ok {
+		r0 = rf(ctx, collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*commonpb.KeyValuePair)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBroker_GetCollectionProperties_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCollectionProperties'
+type MockBroker_GetCollectionProperties_Call struct {
+	*mock.Call
+}
+
+// GetCollectionProperties is a helper method to define mock.On call
+//  - ctx context.Context
+//  - collectionID int64
+func (_e *MockBroker_Expecter) GetCollectionProperties(ctx interface{}, collectionID interface{}) *MockBroker_GetCollectionProperties_Call {
+	return &MockBroker_GetCollectionProperties_Call{Call: _e.mock.On("GetCollectionProperties", ctx, collectionID)}
+}
+
+func (_c *MockBroker_GetCollectionProperties_Call) Run(run func(ctx context.Context, collectionID int64)) *MockBroker_GetCollectionProperties_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockBroker_GetCollectionProperties_Call) Return(_a0 []*commonpb.KeyValuePair, _a1 error) *MockBroker_GetCollectionProperties_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // GetCollectionSchema provides a mock function with given fields: ctx, collectionID
 func (_m *MockBroker) GetCollectionSchema(ctx context.Context, collectionID int64) (*schemapb.CollectionSchema, error) {
 	ret := _m.Called(ctx, collectionID)
@@ -170,6 +219,54 @@ func (_c *MockBroker_GetIndexInfo_Call) Return(_a0 []*querypb.FieldIndexInfo, _a
 	return _c
 }
 
+// GetIndexInfos provides a mock function with given fields: ctx, collectionID, segmentIDs
+func (_m *MockBroker) GetIndexInfos(ctx context.Context, collectionID int64, segmentIDs []int64) (map[int64][]*querypb.FieldIndexInfo, error) {
+	ret := _m.Called(ctx, collectionID, segmentIDs)
+
+	var r0 map[int64][]*querypb.FieldIndexInfo
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []int64) map[int64][]*querypb.FieldIndexInfo); ok {
+		r0 = rf(ctx, collectionID, segmentIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int64][]*querypb.FieldIndexInfo)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []int64) error); ok {
+		r1 = rf(ctx, collectionID, segmentIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBroker_GetIndexInfos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIndexInfos'
+type MockBroker_GetIndexInfos_Call struct {
+	*mock.Call
+}
+
+// GetIndexInfos is a helper method to define mock.On call
+//  - ctx context.Context
+//  - collectionID int64
+//  - segmentIDs []int64
+func (_e *MockBroker_Expecter) GetIndexInfos(ctx interface{}, collectionID interface{}, segmentIDs interface{}) *MockBroker_GetIndexInfos_Call {
+	return &MockBroker_GetIndexInfos_Call{Call: _e.mock.On("GetIndexInfos", ctx, collectionID, segmentIDs)}
+}
+
+func (_c *MockBroker_GetIndexInfos_Call) Run(run func(ctx context.Context, collectionID int64, segmentIDs []int64)) *MockBroker_GetIndexInfos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].([]int64))
+	})
+	return _c
+}
+
+func (_c *MockBroker_GetIndexInfos_Call) Return(_a0 map[int64][]*querypb.FieldIndexInfo, _a1 error) *MockBroker_GetIndexInfos_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // GetPartitions provides a mock function with given fields: ctx, collectionID
 func (_m *MockBroker) GetPartitions(ctx context.Context, collectionID int64) ([]int64, error) {
 	ret := _m.Called(ctx, collectionID)
@@ -224,6 +224,20 @@ func (m *ReplicaManager) RemoveCollection(collectionID UniqueID) error {
 	return nil
 }
 
+// RemoveReplica removes the given replica of the collection,
+// returns error if failed to remove replica from KV
+func (m *ReplicaManager) RemoveReplica(collectionID UniqueID, replicaID UniqueID) error {
+	m.rwmutex.Lock()
+	defer m.rwmutex.Unlock()
+
+	err := m.catalog.ReleaseReplica(collectionID, replicaID)
+	if err != nil {
+		return err
+	}
+	delete(m.replicas, replicaID)
+	return nil
+}
+
 func (m *ReplicaManager) GetByCollection(collectionID UniqueID) []*Replica {
 	m.rwmutex.RLock()
 	defer m.rwmutex.RUnlock()
@@ -149,6 +149,16 @@ func (suite *ReplicaManagerSuite) TestRecover() {
 func (suite *ReplicaManagerSuite) TestRemove() {
 	mgr := suite.mgr
 
+	// remove one replica of the collection with 3 replicas
+	replicas := mgr.GetByCollection(102)
+	suite.Len(replicas, 3)
+	err := mgr.RemoveReplica(102, replicas[0].GetID())
+	suite.NoError(err)
+	suite.Nil(mgr.Get(replicas[0].GetID()))
+	suite.Len(mgr.GetByCollection(102), 2)
+	mgr.Recover(suite.collections)
+	suite.Len(mgr.GetByCollection(102), 2)
+
 	for _, collection := range suite.collections {
 		err := mgr.RemoveCollection(collection)
 		suite.NoError(err)
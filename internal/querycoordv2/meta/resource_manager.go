@@ -58,12 +58,16 @@ var DefaultResourceGroupCapacity = 1000000
 type ResourceGroup struct {
 	nodes    UniqueSet
 	capacity int
+	// the nodes borrowed from other resource groups -> the lender,
+	// it's kept in memory only, the borrowed nodes are not returned after QueryCoord restarts.
+	borrowedNodes map[int64]string
 }
 
 func NewResourceGroup(capacity int) *ResourceGroup {
 	rg := &ResourceGroup{
-		nodes:    typeutil.NewUniqueSet(),
-		capacity: capacity,
+		nodes:         typeutil.NewUniqueSet(),
+		capacity:      capacity,
+		borrowedNodes: make(map[int64]string),
 	}
 
 	return rg
@@ -89,6 +93,7 @@ func (rg *ResourceGroup) unassignNode(id int64, deltaCapacity int) error {
 	}
 
 	rg.nodes.Remove(id)
+	delete(rg.borrowedNodes, id)
 	rg.capacity += deltaCapacity
 
 	return nil
@@ -332,7 +337,8 @@ func (rm *ResourceManager) GetNodes(rgName string) ([]int64, error) {
 	return rm.groups[rgName].GetNodes(), nil
 }
 
-// return all outbound node
+// return all outbound node, which is out of the resource group of the replica,
+// or doesn't match the placement labels of the replica
 func (rm *ResourceManager) CheckOutboundNodes(replica *Replica) typeutil.UniqueSet {
 	rm.rwmutex.RLock()
 	defer rm.rwmutex.RUnlock()
@@ -346,12 +352,26 @@ func (rm *ResourceManager) CheckOutboundNodes(replica *Replica) typeutil.UniqueS
 	for _, node := range replica.GetNodes() {
 		if !rg.containsNode(node) {
 			ret.Insert(node)
+			continue
+		}
+		// the offline nodes are removed from the replica by the node down handling
+		if nodeInfo := rm.nodeMgr.Get(node); nodeInfo != nil && !nodeInfo.MatchLabels(replica.GetPlacementLabels()) {
+			ret.Insert(node)
 		}
 	}
 
 	return ret
 }
 
+// MatchNodeLabels returns whether the node is online and has all the given labels.
+func (rm *ResourceManager) MatchNodeLabels(node int64, labels map[string]string) bool {
+	if len(labels) == 0 {
+		return true
+	}
+	nodeInfo := rm.nodeMgr.Get(node)
+	return nodeInfo != nil && nodeInfo.MatchLabels(labels)
+}
+
 // return outgoing node num on each rg from this replica
 func (rm *ResourceManager) GetOutgoingNodeNumByReplica(replica *Replica) map[string]int32 {
 	rm.rwmutex.RLock()
@@ -653,6 +673,100 @@ func (rm *ResourceManager) AutoRecoverResourceGroup(rgName string) ([]int64, err
 	return ret, nil
 }
 
+// BorrowNodes borrows nodes from the lender resource group for the resource group lack of nodes,
+// e.g. some nodes of it crashed, the borrowed nodes are returned by ReturnBorrowedNodes later.
+// The nodes borrowed by the lender itself are never lent again.
+func (rm *ResourceManager) BorrowNodes(rgName string, lender string) ([]int64, error) {
+	rm.rwmutex.Lock()
+	defer rm.rwmutex.Unlock()
+
+	if rm.groups[rgName] == nil {
+		return nil, merr.WrapErrResourceGroupNotFound(rgName)
+	}
+	if rm.groups[lender] == nil {
+		return nil, merr.WrapErrResourceGroupNotFound(lender)
+	}
+	if rgName == lender {
+		return nil, nil
+	}
+
+	rm.checkRGNodeStatus(lender)
+	rm.checkRGNodeStatus(rgName)
+	ret := make([]int64, 0)
+	lackNodesNum := rm.groups[rgName].LackOfNodes()
+	for _, node := range rm.groups[lender].GetNodes() {
+		if len(ret) >= lackNodesNum {
+			break
+		}
+		if _, ok := rm.groups[lender].borrowedNodes[node]; ok {
+			continue
+		}
+		rm.groups[lender].unassignNode(node, 0)
+		rm.groups[rgName].assignNode(node, 0)
+		rm.groups[rgName].borrowedNodes[node] = lender
+
+		log.Info("borrow node from lender rg to recover",
+			zap.String("targetRG", rgName),
+			zap.String("lenderRG", lender),
+			zap.Int64("nodeID", node),
+		)
+		ret = append(ret, node)
+	}
+
+	return ret, nil
+}
+
+// ReturnBorrowedNodes returns the borrowed nodes of the resource group to the lenders,
+// once the resource group has more nodes than its capacity, or there are free nodes in the default resource group to replace them,
+// e.g. the crashed nodes recovered and joined the default resource group.
+// It returns the free nodes moved to the resource group, and the returned nodes with their lenders.
+func (rm *ResourceManager) ReturnBorrowedNodes(rgName string) ([]int64, map[int64]string, error) {
+	rm.rwmutex.Lock()
+	defer rm.rwmutex.Unlock()
+
+	rg := rm.groups[rgName]
+	if rg == nil {
+		return nil, nil, merr.WrapErrResourceGroupNotFound(rgName)
+	}
+	if len(rg.borrowedNodes) == 0 {
+		return nil, nil, nil
+	}
+
+	rm.checkRGNodeStatus(DefaultResourceGroupName)
+	rm.checkRGNodeStatus(rgName)
+	filled := make([]int64, 0)
+	returned := make(map[int64]string)
+	freeNodes := rm.groups[DefaultResourceGroupName].GetNodes()
+	for node, lender := range rg.borrowedNodes {
+		if rg.LackOfNodes() >= 0 {
+			if len(freeNodes) == 0 {
+				break
+			}
+			free := freeNodes[0]
+			freeNodes = freeNodes[1:]
+			rm.groups[DefaultResourceGroupName].unassignNode(free, 0)
+			rg.assignNode(free, 0)
+			filled = append(filled, free)
+		}
+
+		if rm.groups[lender] == nil {
+			// the lender has been removed
+			lender = DefaultResourceGroupName
+		}
+		rg.unassignNode(node, 0)
+		rm.groups[lender].assignNode(node, 0)
+		returned[node] = lender
+
+		log.Info("return borrowed node to lender rg",
+			zap.String("sourceRG", rgName),
+			zap.String("lenderRG", lender),
+			zap.Int64("nodeID", node),
+		)
+	}
+
+	return filled, returned, nil
+}
+
 func (rm *ResourceManager) Recover() error {
 	rm.rwmutex.Lock()
 	defer rm.rwmutex.Unlock()
@@ -257,6 +257,17 @@ func (suite *ResourceManagerSuite) TestCheckOutboundNodes() {
 	outboundNodes := suite.manager.CheckOutboundNodes(replica)
 	suite.Len(outboundNodes, 1)
 	suite.True(outboundNodes.Contain(4))
+
+	// the nodes mismatching the placement labels are outbound
+	suite.manager.nodeMgr.Get(1).UpdateStats(session.WithLabels(map[string]string{"disk": "nvme", "zone": "az1"}))
+	suite.manager.nodeMgr.Get(2).UpdateStats(session.WithLabels(map[string]string{"disk": "ssd"}))
+	replica.PlacementLabels = map[string]string{"disk": "nvme"}
+	outboundNodes = suite.manager.CheckOutboundNodes(replica)
+	suite.ElementsMatch([]int64{2, 3, 4}, outboundNodes.Collect())
+	suite.True(suite.manager.MatchNodeLabels(1, replica.GetPlacementLabels()))
+	suite.False(suite.manager.MatchNodeLabels(2, replica.GetPlacementLabels()))
+	suite.False(suite.manager.MatchNodeLabels(4, replica.GetPlacementLabels()))
+	suite.True(suite.manager.MatchNodeLabels(4, nil))
 }
 
 func (suite *ResourceManagerSuite) TestCheckResourceGroup() {
@@ -345,6 +356,65 @@ func (suite *ResourceManagerSuite) TestAutoRecover() {
 	suite.Len(nodes, 0)
 }
 
+func (suite *ResourceManagerSuite) TestBorrowAndReturnNodes() {
+	for node := int64(1); node <= 4; node++ {
+		suite.manager.nodeMgr.Add(session.NewNodeInfo(node, "localhost"))
+	}
+	suite.NoError(suite.manager.AddResourceGroup("rg"))
+	suite.NoError(suite.manager.AddResourceGroup("overflow"))
+	suite.manager.AssignNode("rg", 1)
+	suite.manager.AssignNode("rg", 2)
+	suite.manager.AssignNode("overflow", 3)
+	suite.manager.AssignNode("overflow", 4)
+
+	// node 1 crashed, and no free node in the default rg
+	suite.manager.nodeMgr.Remove(1)
+	suite.Equal(1, suite.manager.CheckLackOfNode("rg"))
+	nodes, err := suite.manager.AutoRecoverResourceGroup("rg")
+	suite.NoError(err)
+	suite.Len(nodes, 0)
+
+	borrowed, err := suite.manager.BorrowNodes("rg", "overflow")
+	suite.NoError(err)
+	suite.Len(borrowed, 1)
+	suite.Equal(0, suite.manager.CheckLackOfNode("rg"))
+	suite.Equal(1, suite.manager.CheckLackOfNode("overflow"))
+	suite.True(suite.manager.ContainsNode("rg", borrowed[0]))
+
+	// no more node to borrow as the rg isn't lack of nodes
+	nodes, err = suite.manager.BorrowNodes("rg", "overflow")
+	suite.NoError(err)
+	suite.Len(nodes, 0)
+	_, err = suite.manager.BorrowNodes("rg", "not_exist")
+	suite.ErrorIs(err, merr.ErrResourceGroupNotFound)
+
+	// keep the borrowed node until there is a free node to replace it
+	filled, returned, err := suite.manager.ReturnBorrowedNodes("rg")
+	suite.NoError(err)
+	suite.Len(filled, 0)
+	suite.Len(returned, 0)
+
+	// node 1 recovered and joined the default rg
+	suite.manager.nodeMgr.Add(session.NewNodeInfo(1, "localhost"))
+	_, err = suite.manager.HandleNodeUp(1)
+	suite.NoError(err)
+	filled, returned, err = suite.manager.ReturnBorrowedNodes("rg")
+	suite.NoError(err)
+	suite.Equal([]int64{1}, filled)
+	suite.Equal(map[int64]string{borrowed[0]: "overflow"}, returned)
+	nodes, _ = suite.manager.GetNodes("rg")
+	suite.ElementsMatch([]int64{1, 2}, nodes)
+	nodes, _ = suite.manager.GetNodes("overflow")
+	suite.ElementsMatch([]int64{3, 4}, nodes)
+	suite.Equal(0, suite.manager.CheckLackOfNode("overflow"))
+
+	// nothing borrowed
+	filled, returned, err = suite.manager.ReturnBorrowedNodes("rg")
+	suite.NoError(err)
+	suite.Len(filled, 0)
+	suite.Len(returned, 0)
+}
+
 func (suite *ResourceManagerSuite) TestDefaultResourceGroup() {
 	for i := 0; i < 10; i++ {
 		suite.manager.nodeMgr.Add(session.NewNodeInfo(int64(i), "localhost"))
@@ -0,0 +1,180 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// targetCache caches the target pulled from DataCoord for the given partitions,
+// the segments changed since the pull are applied to it incrementally,
+// so the next target could be updated without pulling the full recovery info again.
+type targetCache struct {
+	target     *CollectionTarget
+	partitions typeutil.UniqueSet
+	changed    typeutil.UniqueSet
+	pulledAt   time.Time
+}
+
+func newTargetCache(target *CollectionTarget, partitionIDs ...int64) *targetCache {
+	return &targetCache{
+		target:     target,
+		partitions: typeutil.NewUniqueSet(partitionIDs...),
+		changed:    typeutil.NewUniqueSet(),
+		pulledAt:   time.Now(),
+	}
+}
+
+// match returns whether the cache could serve the target of the given partitions
+func (cache *targetCache) match(partitionIDs ...int64) bool {
+	if cache.partitions.Len() != len(partitionIDs) {
+		return false
+	}
+	for _, partitionID := range partitionIDs {
+		if !cache.partitions.Contain(partitionID) {
+			return false
+		}
+	}
+	return true
+}
+
+func (cache *targetCache) isExpired(ttl time.Duration) bool {
+	return time.Since(cache.pulledAt) > ttl
+}
+
+// applySegmentChanges fetches the changed segments from DataCoord, and applies them to the cached target,
+// a flushed segment is added only after its index is ready, and the segments it's compacted from are removed at the same time,
+// otherwise it's kept as changed and applied in the next round.
+func (cache *targetCache) applySegmentChanges(broker Broker, collectionID int64) error {
+	if cache.changed.Len() == 0 {
+		return nil
+	}
+
+	log := log.With(zap.Int64("collectionID", collectionID),
+		zap.Int64s("changedSegments", cache.changed.Collect()))
+
+	ctx := context.TODO()
+	resp, err := broker.GetSegmentInfo(ctx, cache.changed.Collect()...)
+	if err != nil {
+		return err
+	}
+	indexes, err := broker.DescribeIndex(ctx, collectionID)
+	if err != nil && !errors.Is(err, merr.ErrIndexNotFound) {
+		return err
+	}
+
+	isIndexReady := func(segment *datapb.SegmentInfo) (bool, error) {
+		if len(indexes) == 0 ||
+			segment.GetNumOfRows() < paramtable.Get().DataCoordCfg.MinSegmentNumRowsToEnableIndex.GetAsInt64() {
+			return true, nil
+		}
+		infos, err := broker.GetIndexInfo(ctx, collectionID, segment.GetID())
+		if errors.Is(err, merr.ErrIndexNotFound) {
+			return false, nil
+		} else if err != nil {
+			return false, err
+		}
+		return len(infos) >= len(indexes), nil
+	}
+
+	segments := make(map[int64]*datapb.SegmentInfo, len(cache.target.GetAllSegments()))
+	for id, segment := range cache.target.GetAllSegments() {
+		segments[id] = segment
+	}
+	channels := make(map[string]*DmChannel, len(cache.target.GetAllDmChannels()))
+	for name, channel := range cache.target.GetAllDmChannels() {
+		channels[name] = channel
+	}
+
+	pending := typeutil.NewUniqueSet()
+	for _, segment := range resp.GetInfos() {
+		// dropped segments are removed along with the segment they're compacted to,
+		// and growing segments are not a part of the historical target
+		if segment.GetState() != commonpb.SegmentState_Flushed {
+			continue
+		}
+		if _, ok := segments[segment.GetID()]; ok {
+			continue
+		}
+		// the deletes of L0 segments are read from the channel until they're merged,
+		// since DataCoord holds the checkpoint of the channel back
+		if segment.GetIsImporting() || segment.GetLevel() == datapb.SegmentLevel_L0 {
+			continue
+		}
+
+		ready, err := isIndexReady(segment)
+		if err != nil {
+			return err
+		}
+		if !ready {
+			pending.Insert(segment.GetID())
+			continue
+		}
+
+		if cache.partitions.Contain(segment.GetPartitionID()) {
+			segments[segment.GetID()] = segment
+		}
+		for _, from := range segment.GetCompactionFrom() {
+			delete(segments, from)
+		}
+		if channel, ok := channels[segment.GetInsertChannel()]; ok {
+			channels[segment.GetInsertChannel()] = applySegmentToChannel(channel, segment)
+		}
+	}
+
+	log.Debug("segment changes applied to target cache",
+		zap.Int64s("pendingSegments", pending.Collect()))
+	cache.target = NewCollectionTarget(segments, channels)
+	cache.changed = pending
+	return nil
+}
+
+// applySegmentToChannel moves the flushed segment and the segments it's compacted from into the flushed and dropped segment lists
+func applySegmentToChannel(channel *DmChannel, segment *datapb.SegmentInfo) *DmChannel {
+	compactedFrom := typeutil.NewUniqueSet(segment.GetCompactionFrom()...)
+	newChannel := channel.Clone()
+
+	unflushed := make([]int64, 0, len(newChannel.GetUnflushedSegmentIds()))
+	for _, id := range newChannel.GetUnflushedSegmentIds() {
+		if id != segment.GetID() && !compactedFrom.Contain(id) {
+			unflushed = append(unflushed, id)
+		}
+	}
+	flushed := make([]int64, 0, len(newChannel.GetFlushedSegmentIds())+1)
+	for _, id := range newChannel.GetFlushedSegmentIds() {
+		if id != segment.GetID() && !compactedFrom.Contain(id) {
+			flushed = append(flushed, id)
+		}
+	}
+	flushed = append(flushed, segment.GetID())
+
+	newChannel.UnflushedSegmentIds = unflushed
+	newChannel.FlushedSegmentIds = flushed
+	newChannel.DroppedSegmentIds = append(newChannel.DroppedSegmentIds, segment.GetCompactionFrom()...)
+	return newChannel
+}
@@ -19,11 +19,13 @@ package meta
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/retry"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 	"github.com/samber/lo"
@@ -48,6 +50,9 @@ type TargetManager struct {
 	// all remove segment/channel operation happens on Both current and next -> delete status should be consistent
 	current *target
 	next    *target
+
+	// collectionID -> target cache, next target is updated incrementally from it before it's expired
+	caches map[int64]*targetCache
 }
 
 func NewTargetManager(broker Broker, meta *Meta) *TargetManager {
@@ -56,6 +61,7 @@ func NewTargetManager(broker Broker, meta *Meta) *TargetManager {
 		meta:    meta,
 		current: newTarget(),
 		next:    newTarget(),
+		caches:  make(map[int64]*targetCache),
 	}
 }
 
@@ -124,7 +130,7 @@ func (mgr *TargetManager) updateCollectionNextTarget(collectionID int64, partiti
 		zap.Int64s("PartitionIDs", partitionIDs))
 
 	log.Debug("start to update next targets for collection")
-	newTarget, err := mgr.PullNextTarget(mgr.broker, collectionID, partitionIDs...)
+	newTarget, err := mgr.pullNextTargetWithCache(collectionID, partitionIDs...)
 	if err != nil {
 		log.Error("failed to get next targets for collection",
 			zap.Error(err))
@@ -140,6 +146,57 @@ func (mgr *TargetManager) updateCollectionNextTarget(collectionID int64, partiti
 	return nil
 }
 
+// pullNextTargetWithCache applies the notified segment changes to the cached target if it's not expired,
+// otherwise pulls the full next target from DataCoord and caches it
+func (mgr *TargetManager) pullNextTargetWithCache(collectionID int64, partitionIDs ...int64) (*CollectionTarget, error) {
+	ttl := paramtable.Get().QueryCoordCfg.TargetCacheTTL.GetAsDuration(time.Second)
+	if ttl <= 0 {
+		delete(mgr.caches, collectionID)
+		return mgr.PullNextTarget(mgr.broker, collectionID, partitionIDs...)
+	}
+
+	cache, ok := mgr.caches[collectionID]
+	if ok && cache.match(partitionIDs...) && !cache.isExpired(ttl) {
+		err := cache.applySegmentChanges(mgr.broker, collectionID)
+		if err == nil {
+			return NewCollectionTarget(cache.target.GetAllSegments(), cache.target.GetAllDmChannels()), nil
+		}
+		log.Warn("failed to apply segment changes to target cache, pull the full target",
+			zap.Int64("collectionID", collectionID),
+			zap.Error(err))
+	}
+	delete(mgr.caches, collectionID)
+
+	target, err := mgr.PullNextTarget(mgr.broker, collectionID, partitionIDs...)
+	if err != nil {
+		return nil, err
+	}
+	if len(partitionIDs) > 0 {
+		mgr.caches[collectionID] = newTargetCache(target, partitionIDs...)
+	}
+	return target, nil
+}
+
+// NotifySegmentsChanged records the changed segments of the collection,
+// they're applied to the cached target in the next update of the next target
+func (mgr *TargetManager) NotifySegmentsChanged(collectionID int64, segmentIDs ...int64) {
+	mgr.rwMutex.Lock()
+	defer mgr.rwMutex.Unlock()
+
+	if cache, ok := mgr.caches[collectionID]; ok {
+		cache.changed.Insert(segmentIDs...)
+	}
+}
+
+// InvalidateCache drops the cached target of the collection,
+// the next update of the next target pulls the full target from DataCoord
+func (mgr *TargetManager) InvalidateCache(collectionID int64) {
+	mgr.rwMutex.Lock()
+	defer mgr.rwMutex.Unlock()
+
+	delete(mgr.caches, collectionID)
+}
+
 func (mgr *TargetManager) PullNextTargetV1(broker Broker, collectionID int64, chosenPartitionIDs ...int64) (*CollectionTarget, error) {
 	channelInfos := make(map[string][]*datapb.VchannelInfo)
 	segments := make(map[int64]*datapb.SegmentInfo, 0)
@@ -274,6 +331,7 @@ func (mgr *TargetManager) RemoveCollection(collectionID int64) {
 
 	mgr.current.removeCollectionTarget(collectionID)
 	mgr.next.removeCollectionTarget(collectionID)
+	delete(mgr.caches, collectionID)
 }
 
 // RemovePartition removes all segment in the given partition,
@@ -287,6 +345,7 @@ func (mgr *TargetManager) RemovePartition(collectionID int64, partitionIDs ...in
 
 	log.Info("remove partition from targets")
 
+	delete(mgr.caches, collectionID)
 	partitionSet := typeutil.NewUniqueSet(partitionIDs...)
 
 	oldCurrentTarget := mgr.current.getCollectionTarget(collectionID)
@@ -27,14 +27,18 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
@@ -242,6 +246,77 @@ func (suite *TargetManagerSuite) TestUpdateNextTarget() {
 
 }
 
+func (suite *TargetManagerSuite) TestUpdateNextTargetWithCache() {
+	paramtable.Get().Save(Params.QueryCoordCfg.TargetCacheTTL.Key, "60")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.TargetCacheTTL.Key)
+
+	collectionID := int64(1003)
+	nextTargetChannels := []*datapb.VchannelInfo{
+		{
+			CollectionID:      collectionID,
+			ChannelName:       "channel-1",
+			FlushedSegmentIds: []int64{11, 12},
+		},
+	}
+	nextTargetSegments := []*datapb.SegmentInfo{
+		{
+			ID:            11,
+			PartitionID:   1,
+			InsertChannel: "channel-1",
+		},
+		{
+			ID:            12,
+			PartitionID:   1,
+			InsertChannel: "channel-1",
+		},
+	}
+
+	// pull full target only once, as no segment changed
+	suite.broker.ExpectedCalls = nil
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, collectionID).Return(nextTargetChannels, nextTargetSegments, nil).Once()
+	suite.NoError(suite.mgr.UpdateCollectionNextTargetWithPartitions(collectionID, int64(1)))
+	suite.NoError(suite.mgr.UpdateCollectionNextTargetWithPartitions(collectionID, int64(1)))
+	suite.assertSegments([]int64{11, 12}, suite.mgr.GetHistoricalSegmentsByCollection(collectionID, NextTarget))
+
+	// apply the compacted segment
+	suite.mgr.NotifySegmentsChanged(collectionID, 13, 11, 12)
+	suite.broker.EXPECT().GetSegmentInfo(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&datapb.GetSegmentInfoResponse{
+		Infos: []*datapb.SegmentInfo{
+			{ID: 11, PartitionID: 1, InsertChannel: "channel-1", State: commonpb.SegmentState_Dropped},
+			{ID: 12, PartitionID: 1, InsertChannel: "channel-1", State: commonpb.SegmentState_Dropped},
+			{ID: 13, PartitionID: 1, InsertChannel: "channel-1", State: commonpb.SegmentState_Flushed, CompactionFrom: []int64{11, 12}},
+		},
+	}, nil).Once()
+	suite.broker.EXPECT().DescribeIndex(mock.Anything, collectionID).Return(nil, nil).Once()
+	suite.NoError(suite.mgr.UpdateCollectionNextTargetWithPartitions(collectionID, int64(1)))
+	suite.assertSegments([]int64{13}, suite.mgr.GetHistoricalSegmentsByCollection(collectionID, NextTarget))
+	channel := suite.mgr.GetDmChannel(collectionID, "channel-1", NextTarget)
+	suite.ElementsMatch([]int64{13}, channel.GetFlushedSegmentIds())
+	suite.ElementsMatch([]int64{11, 12}, channel.GetDroppedSegmentIds())
+
+	// segment without index is kept pending
+	suite.mgr.NotifySegmentsChanged(collectionID, 14)
+	suite.broker.EXPECT().GetSegmentInfo(mock.Anything, int64(14)).Return(&datapb.GetSegmentInfoResponse{
+		Infos: []*datapb.SegmentInfo{
+			{ID: 14, PartitionID: 1, InsertChannel: "channel-1", State: commonpb.SegmentState_Flushed, NumOfRows: 100000},
+		},
+	}, nil).Twice()
+	suite.broker.EXPECT().DescribeIndex(mock.Anything, collectionID).Return([]*indexpb.IndexInfo{{FieldID: 101}}, nil).Twice()
+	suite.broker.EXPECT().GetIndexInfo(mock.Anything, collectionID, int64(14)).Return(nil, merr.WrapErrIndexNotFound()).Once()
+	suite.NoError(suite.mgr.UpdateCollectionNextTargetWithPartitions(collectionID, int64(1)))
+	suite.assertSegments([]int64{13}, suite.mgr.GetHistoricalSegmentsByCollection(collectionID, NextTarget))
+
+	suite.broker.EXPECT().GetIndexInfo(mock.Anything, collectionID, int64(14)).Return([]*querypb.FieldIndexInfo{{FieldID: 101}}, nil).Once()
+	suite.NoError(suite.mgr.UpdateCollectionNextTargetWithPartitions(collectionID, int64(1)))
+	suite.assertSegments([]int64{13, 14}, suite.mgr.GetHistoricalSegmentsByCollection(collectionID, NextTarget))
+
+	// pull full target after the cache invalidated
+	suite.mgr.InvalidateCache(collectionID)
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, collectionID).Return(nextTargetChannels, nextTargetSegments, nil).Once()
+	suite.NoError(suite.mgr.UpdateCollectionNextTargetWithPartitions(collectionID, int64(1)))
+	suite.assertSegments([]int64{11, 12}, suite.mgr.GetHistoricalSegmentsByCollection(collectionID, NextTarget))
+}
+
 func (suite *TargetManagerSuite) TestRemovePartition() {
 	collectionID := int64(1000)
 	suite.assertSegments(suite.getAllSegment(collectionID, suite.partitions[collectionID]), suite.mgr.GetHistoricalSegmentsByCollection(collectionID, NextTarget))
@@ -76,6 +76,53 @@ func (_c *MockQueryNodeServer_Delete_Call) Return(_a0 *commonpb.Status, _a1 erro
 	return _c
 }
 
+// DumpDispatcherPositions provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryNodeServer) DumpDispatcherPositions(_a0 context.Context, _a1 *internalpb.DumpDispatcherPositionsRequest) (*internalpb.DumpDispatcherPositionsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *internalpb.DumpDispatcherPositionsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DumpDispatcherPositionsRequest) *internalpb.DumpDispatcherPositionsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.DumpDispatcherPositionsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.DumpDispatcherPositionsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryNodeServer_DumpDispatcherPositions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DumpDispatcherPositions'
+type MockQueryNodeServer_DumpDispatcherPositions_Call struct {
+	*mock.Call
+}
+
+// DumpDispatcherPositions is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 *internalpb.DumpDispatcherPositionsRequest
+func (_e *MockQueryNodeServer_Expecter) DumpDispatcherPositions(_a0 interface{}, _a1 interface{}) *MockQueryNodeServer_DumpDispatcherPositions_Call {
+	return &MockQueryNodeServer_DumpDispatcherPositions_Call{Call: _e.mock.On("DumpDispatcherPositions", _a0, _a1)}
+}
+
+func (_c *MockQueryNodeServer_DumpDispatcherPositions_Call) Run(run func(_a0 context.Context, _a1 *internalpb.DumpDispatcherPositionsRequest)) *MockQueryNodeServer_DumpDispatcherPositions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.DumpDispatcherPositionsRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryNodeServer_DumpDispatcherPositions_Call) Return(_a0 *internalpb.DumpDispatcherPositionsResponse, _a1 error) *MockQueryNodeServer_DumpDispatcherPositions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // GetComponentStates provides a mock function with given fields: _a0, _a1
 func (_m *MockQueryNodeServer) GetComponentStates(_a0 context.Context, _a1 *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error) {
 	ret := _m.Called(_a0, _a1)
@@ -828,6 +875,53 @@ func (_c *MockQueryNodeServer_SearchSegments_Call) Return(_a0 *internalpb.Search
 	return _c
 }
 
+// SeekDispatcher provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryNodeServer) SeekDispatcher(_a0 context.Context, _a1 *internalpb.SeekDispatcherRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.SeekDispatcherRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.SeekDispatcherRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryNodeServer_SeekDispatcher_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SeekDispatcher'
+type MockQueryNodeServer_SeekDispatcher_Call struct {
+	*mock.Call
+}
+
+// SeekDispatcher is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 *internalpb.SeekDispatcherRequest
+func (_e *MockQueryNodeServer_Expecter) SeekDispatcher(_a0 interface{}, _a1 interface{}) *MockQueryNodeServer_SeekDispatcher_Call {
+	return &MockQueryNodeServer_SeekDispatcher_Call{Call: _e.mock.On("SeekDispatcher", _a0, _a1)}
+}
+
+func (_c *MockQueryNodeServer_SeekDispatcher_Call) Run(run func(_a0 context.Context, _a1 *internalpb.SeekDispatcherRequest)) *MockQueryNodeServer_SeekDispatcher_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.SeekDispatcherRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryNodeServer_SeekDispatcher_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryNodeServer_SeekDispatcher_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // ShowConfigurations provides a mock function with given fields: _a0, _a1
 func (_m *MockQueryNodeServer) ShowConfigurations(_a0 context.Context, _a1 *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -44,6 +44,9 @@ type CollectionObserver struct {
 	checkerController    *checkers.CheckerController
 	partitionLoadedCount map[int64]int
 
+	extensionMut          sync.Mutex
+	loadTimeoutExtensions map[int64]time.Duration // collectionID -> load timeout extended by users
+
 	stopOnce sync.Once
 }
 
@@ -56,14 +59,15 @@ func NewCollectionObserver(
 	checherController *checkers.CheckerController,
 ) *CollectionObserver {
 	return &CollectionObserver{
-		stopCh:               make(chan struct{}),
-		dist:                 dist,
-		meta:                 meta,
-		targetMgr:            targetMgr,
-		targetObserver:       targetObserver,
-		leaderObserver:       leaderObserver,
-		checkerController:    checherController,
-		partitionLoadedCount: make(map[int64]int),
+		stopCh:                make(chan struct{}),
+		dist:                  dist,
+		meta:                  meta,
+		targetMgr:             targetMgr,
+		targetObserver:        targetObserver,
+		leaderObserver:        leaderObserver,
+		checkerController:     checherController,
+		partitionLoadedCount:  make(map[int64]int),
+		loadTimeoutExtensions: make(map[int64]time.Duration),
 	}
 }
 
@@ -100,17 +104,97 @@ func (ob *CollectionObserver) Observe() {
 	ob.observeLoadStatus()
 }
 
+// ExtendLoadTimeout extends the load timeout of a loading collection, and returns the total extension.
+// The extension is discarded once the collection is not loading.
+func (ob *CollectionObserver) ExtendLoadTimeout(collectionID int64, extension time.Duration) time.Duration {
+	ob.extensionMut.Lock()
+	defer ob.extensionMut.Unlock()
+	ob.loadTimeoutExtensions[collectionID] += extension
+	return ob.loadTimeoutExtensions[collectionID]
+}
+
+func (ob *CollectionObserver) getLoadTimeoutExtension(collectionID int64) time.Duration {
+	ob.extensionMut.Lock()
+	defer ob.extensionMut.Unlock()
+	return ob.loadTimeoutExtensions[collectionID]
+}
+
+// removeLoadTimeoutExtensions removes the extensions of the collections not loading.
+func (ob *CollectionObserver) removeLoadTimeoutExtensions() {
+	ob.extensionMut.Lock()
+	defer ob.extensionMut.Unlock()
+	for collectionID := range ob.loadTimeoutExtensions {
+		collection := ob.meta.CollectionManager.GetCollection(collectionID)
+		if collection == nil || collection.GetStatus() != querypb.LoadStatus_Loading {
+			delete(ob.loadTimeoutExtensions, collectionID)
+		}
+	}
+}
+
+// loadTimeout returns how long the load of the collection could go without progress before it's canceled.
+// It's the configured load timeout, or the time expected to load the remaining data if longer,
+// plus the extension requested by users.
+func (ob *CollectionObserver) loadTimeout(collectionID int64, startedAt time.Time) time.Duration {
+	timeout := Params.QueryCoordCfg.LoadTimeoutSeconds.GetAsDuration(time.Second)
+	if expected := ob.expectedRemainingLoadTime(collectionID, startedAt); expected > timeout {
+		timeout = expected
+	}
+	return timeout + ob.getLoadTimeoutExtension(collectionID)
+}
+
+// expectedRemainingLoadTime estimates the time to load the remaining segments of the collection,
+// with the throughput observed since the load started,
+// or the expected throughput of the QueryNodes if no segment is loaded yet.
+func (ob *CollectionObserver) expectedRemainingLoadTime(collectionID int64, startedAt time.Time) time.Duration {
+	replicaNum := int64(ob.meta.GetReplicaNumber(collectionID))
+	totalBytes, loadedBytes := int64(0), int64(0)
+	for _, segment := range ob.targetMgr.GetHistoricalSegmentsByCollection(collectionID, meta.NextTarget) {
+		size := utils.CalculateSegmentInfoSize(segment)
+		loadedReplicas := utils.GroupNodesByReplica(ob.meta.ReplicaManager,
+			collectionID,
+			ob.dist.LeaderViewManager.GetSealedSegmentDist(segment.GetID()))
+		totalBytes += size * replicaNum
+		loadedBytes += size * int64(len(loadedReplicas))
+	}
+	remainingBytes := totalBytes - loadedBytes
+	if remainingBytes <= 0 {
+		return 0
+	}
+
+	// bytes per second
+	throughput := float64(0)
+	if elapsed := time.Since(startedAt).Seconds(); loadedBytes > 0 && elapsed > 0 {
+		throughput = float64(loadedBytes) / elapsed
+	} else {
+		nodeNum := 0
+		for _, replica := range ob.meta.ReplicaManager.GetByCollection(collectionID) {
+			nodeNum += len(replica.GetNodes())
+		}
+		throughput = Params.QueryCoordCfg.ExpectedLoadThroughputMB.GetAsFloat() * 1024 * 1024 * float64(nodeNum)
+	}
+	if throughput <= 0 {
+		return 0
+	}
+	return time.Duration(float64(remainingBytes) / throughput * float64(time.Second))
+}
+
 func (ob *CollectionObserver) observeTimeout() {
+	ob.removeLoadTimeoutExtensions()
+
 	collections := ob.meta.CollectionManager.GetAllCollections()
 	for _, collection := range collections {
-		if collection.GetStatus() != querypb.LoadStatus_Loading ||
-			time.Now().Before(collection.UpdatedAt.Add(Params.QueryCoordCfg.LoadTimeoutSeconds.GetAsDuration(time.Second))) {
+		if collection.GetStatus() != querypb.LoadStatus_Loading {
+			continue
+		}
+		timeout := ob.loadTimeout(collection.GetCollectionID(), collection.CreatedAt)
+		if time.Now().Before(collection.UpdatedAt.Add(timeout)) {
 			continue
 		}
 
 		log.Info("load collection timeout, cancel it",
 			zap.Int64("collectionID", collection.GetCollectionID()),
-			zap.Duration("loadTime", time.Since(collection.CreatedAt)))
+			zap.Duration("loadTime", time.Since(collection.CreatedAt)),
+			zap.Duration("timeout", timeout))
 		ob.meta.CollectionManager.RemoveCollection(collection.GetCollectionID())
 		ob.meta.ReplicaManager.RemoveCollection(collection.GetCollectionID())
 		ob.targetMgr.RemoveCollection(collection.GetCollectionID())
@@ -123,15 +207,19 @@ func (ob *CollectionObserver) observeTimeout() {
 	}
 	for collection, partitions := range partitions {
 		for _, partition := range partitions {
-			if partition.GetStatus() != querypb.LoadStatus_Loading ||
-				time.Now().Before(partition.UpdatedAt.Add(Params.QueryCoordCfg.LoadTimeoutSeconds.GetAsDuration(time.Second))) {
+			if partition.GetStatus() != querypb.LoadStatus_Loading {
+				continue
+			}
+			timeout := ob.loadTimeout(collection, partition.CreatedAt)
+			if time.Now().Before(partition.UpdatedAt.Add(timeout)) {
 				continue
 			}
 
 			log.Info("load partition timeout, cancel it",
 				zap.Int64("collectionID", collection),
 				zap.Int64("partitionID", partition.GetPartitionID()),
-				zap.Duration("loadTime", time.Since(partition.CreatedAt)))
+				zap.Duration("loadTime", time.Since(partition.CreatedAt)),
+				zap.Duration("timeout", timeout))
 			ob.meta.CollectionManager.RemovePartition(partition.GetPartitionID())
 			ob.targetMgr.RemovePartition(partition.GetCollectionID(), partition.GetPartitionID())
 		}
@@ -325,6 +325,67 @@ func (suite *CollectionObserverSuite) TestObservePartition() {
 	}, timeout*2, timeout/10)
 }
 
+func (suite *CollectionObserverSuite) TestLoadTimeout() {
+	paramtable.Get().Save(Params.QueryCoordCfg.LoadTimeoutSeconds.Key, "10")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.LoadTimeoutSeconds.Key)
+	paramtable.Get().Save(Params.QueryCoordCfg.ExpectedLoadThroughputMB.Key, "1")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.ExpectedLoadThroughputMB.Key)
+
+	// no data to load, use the configured timeout
+	suite.Equal(10*time.Second, suite.ob.loadTimeout(suite.collections[0], time.Now()))
+
+	const (
+		collectionID = int64(104)
+		partitionID  = int64(15)
+		segmentSize  = int64(30 * 1024 * 1024)
+	)
+	genSegment := func(segmentID int64) *datapb.SegmentInfo {
+		return &datapb.SegmentInfo{
+			ID:            segmentID,
+			CollectionID:  collectionID,
+			PartitionID:   partitionID,
+			InsertChannel: "104-dmc0",
+			Binlogs: []*datapb.FieldBinlog{
+				{FieldID: 101, Binlogs: []*datapb.Binlog{{LogSize: segmentSize}}},
+			},
+		}
+	}
+	suite.partitions[collectionID] = []int64{partitionID}
+	suite.channels[collectionID] = []*meta.DmChannel{
+		meta.DmChannelFromVChannel(&datapb.VchannelInfo{
+			CollectionID: collectionID,
+			ChannelName:  "104-dmc0",
+		}),
+	}
+	suite.segments[collectionID] = []*datapb.SegmentInfo{genSegment(3001), genSegment(3002)}
+	suite.loadTypes[collectionID] = querypb.LoadType_LoadCollection
+	suite.replicaNumber[collectionID] = 1
+	suite.broker.EXPECT().GetPartitions(mock.Anything, collectionID).Return(suite.partitions[collectionID], nil).Maybe()
+	suite.load(collectionID)
+
+	// 60MB to load by 3 nodes at the expected throughput 1MB/s
+	suite.Equal(20*time.Second, suite.ob.loadTimeout(collectionID, time.Now()))
+
+	// 30MB loaded in a minute, the remaining 30MB is expected to be loaded in another minute
+	suite.dist.LeaderViewManager.Update(1, &meta.LeaderView{
+		ID:           1,
+		CollectionID: collectionID,
+		Channel:      "104-dmc0",
+		Segments:     map[int64]*querypb.SegmentDist{3001: {NodeID: 1, Version: 0}},
+	})
+	startedAt := time.Now().Add(-time.Minute)
+	suite.InDelta(float64(time.Minute), float64(suite.ob.loadTimeout(collectionID, startedAt)), float64(time.Second))
+
+	// extended by users
+	suite.Equal(time.Minute, suite.ob.ExtendLoadTimeout(collectionID, time.Minute))
+	suite.InDelta(float64(2*time.Minute), float64(suite.ob.loadTimeout(collectionID, startedAt)), float64(time.Second))
+
+	// extension is discarded once the collection is not loading
+	suite.meta.CollectionManager.RemoveCollection(collectionID)
+	suite.ob.removeLoadTimeoutExtensions()
+	suite.Equal(time.Duration(0), suite.ob.getLoadTimeoutExtension(collectionID))
+}
+
 func (suite *CollectionObserverSuite) isCollectionLoaded(collection int64) bool {
 	exist := suite.meta.Exist(collection)
 	percentage := suite.meta.CalculateLoadPercentage(collection)
@@ -437,6 +498,7 @@ func (suite *CollectionObserverSuite) load(collection int64) {
 			ID:            segment.GetID(),
 			PartitionID:   segment.PartitionID,
 			InsertChannel: segment.GetInsertChannel(),
+			Binlogs:       segment.GetBinlogs(),
 		})
 	}
 
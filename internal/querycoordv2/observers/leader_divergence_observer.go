@@ -0,0 +1,254 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+)
+
+// DivergedLeader is a shard leader whose view is out of sync with the distribution or the current target.
+type DivergedLeader struct {
+	CollectionID int64
+	ReplicaID    int64
+	Channel      string
+	LeaderID     int64
+	Reason       string
+	Since        time.Time
+
+	remediated bool
+}
+
+// RemediationHook is called once a shard leader has been diverged longer than the threshold,
+// it's called again only after the leader recovers and diverges again.
+type RemediationHook func(leader DivergedLeader)
+
+type divergenceKey struct {
+	replicaID int64
+	channel   string
+}
+
+// LeaderDivergenceObserver compares the leader views with the distribution and the current target,
+// and reports the shard leaders which have been out of sync longer than queryCoord.leaderDivergence.threshold.
+// The leader observer syncs the leader views continuously, so a short divergence is expected,
+// a long one means the sync keeps failing or the QueryNode fails to apply it.
+type LeaderDivergenceObserver struct {
+	c      chan struct{}
+	wg     sync.WaitGroup
+	meta   *meta.Meta
+	dist   *meta.DistributionManager
+	target *meta.TargetManager
+
+	mu       sync.RWMutex
+	diverged map[divergenceKey]*DivergedLeader
+	hooks    []RemediationHook
+
+	stopOnce sync.Once
+}
+
+func NewLeaderDivergenceObserver(meta *meta.Meta, dist *meta.DistributionManager, target *meta.TargetManager) *LeaderDivergenceObserver {
+	return &LeaderDivergenceObserver{
+		c:        make(chan struct{}),
+		meta:     meta,
+		dist:     dist,
+		target:   target,
+		diverged: make(map[divergenceKey]*DivergedLeader),
+	}
+}
+
+// RegisterRemediationHook registers a hook to remedy the diverged leaders, it shall be called before Start.
+func (ob *LeaderDivergenceObserver) RegisterRemediationHook(hook RemediationHook) {
+	ob.mu.Lock()
+	defer ob.mu.Unlock()
+	ob.hooks = append(ob.hooks, hook)
+}
+
+func (ob *LeaderDivergenceObserver) Start(ctx context.Context) {
+	ob.wg.Add(1)
+	go ob.schedule(ctx)
+}
+
+func (ob *LeaderDivergenceObserver) Stop() {
+	ob.stopOnce.Do(func() {
+		close(ob.c)
+		ob.wg.Wait()
+	})
+}
+
+func (ob *LeaderDivergenceObserver) schedule(ctx context.Context) {
+	defer ob.wg.Done()
+	log.Info("Start leader divergence check loop")
+
+	ticker := time.NewTicker(params.Params.QueryCoordCfg.LeaderDivergenceCheckInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("Close leader divergence observer due to context canceled")
+			return
+		case <-ob.c:
+			log.Info("Close leader divergence observer")
+			return
+
+		case <-ticker.C:
+			ob.check()
+		}
+	}
+}
+
+// GetDivergedLeaders returns the shard leaders diverged longer than the threshold,
+// of the given collection, or all the collections if collectionID is 0.
+func (ob *LeaderDivergenceObserver) GetDivergedLeaders(collectionID int64) []DivergedLeader {
+	threshold := params.Params.QueryCoordCfg.LeaderDivergenceThreshold.GetAsDuration(time.Second)
+
+	ob.mu.RLock()
+	defer ob.mu.RUnlock()
+	ret := make([]DivergedLeader, 0)
+	for _, leader := range ob.diverged {
+		if (collectionID == 0 || leader.CollectionID == collectionID) && time.Since(leader.Since) >= threshold {
+			ret = append(ret, *leader)
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].ReplicaID != ret[j].ReplicaID {
+			return ret[i].ReplicaID < ret[j].ReplicaID
+		}
+		return ret[i].Channel < ret[j].Channel
+	})
+	return ret
+}
+
+func (ob *LeaderDivergenceObserver) check() {
+	threshold := params.Params.QueryCoordCfg.LeaderDivergenceThreshold.GetAsDuration(time.Second)
+	now := time.Now()
+
+	ob.mu.Lock()
+	seen := make(map[divergenceKey]struct{})
+	for _, collectionID := range ob.meta.CollectionManager.GetAll() {
+		for _, replica := range ob.meta.ReplicaManager.GetByCollection(collectionID) {
+			for channel := range ob.target.GetDmChannelsByCollection(collectionID, meta.CurrentTarget) {
+				leaderID, reason := ob.checkLeader(replica, channel)
+				if reason == "" {
+					continue
+				}
+				key := divergenceKey{replicaID: replica.GetID(), channel: channel}
+				seen[key] = struct{}{}
+				leader, ok := ob.diverged[key]
+				if !ok {
+					leader = &DivergedLeader{
+						CollectionID: collectionID,
+						ReplicaID:    replica.GetID(),
+						Channel:      channel,
+						Since:        now,
+					}
+					ob.diverged[key] = leader
+				}
+				leader.LeaderID = leaderID
+				leader.Reason = reason
+			}
+		}
+	}
+
+	// the leaders recovered or released
+	for key := range ob.diverged {
+		if _, ok := seen[key]; !ok {
+			delete(ob.diverged, key)
+		}
+	}
+
+	counts := make(map[int64]int)
+	toRemedy := make([]DivergedLeader, 0)
+	for _, leader := range ob.diverged {
+		if now.Sub(leader.Since) < threshold {
+			continue
+		}
+		counts[leader.CollectionID]++
+		if !leader.remediated {
+			log.Warn("shard leader diverged longer than threshold",
+				zap.Int64("collectionID", leader.CollectionID),
+				zap.Int64("replicaID", leader.ReplicaID),
+				zap.String("channel", leader.Channel),
+				zap.Int64("leaderID", leader.LeaderID),
+				zap.String("reason", leader.Reason),
+				zap.Time("since", leader.Since))
+			leader.remediated = true
+			toRemedy = append(toRemedy, *leader)
+		}
+	}
+	hooks := ob.hooks
+	ob.mu.Unlock()
+
+	metrics.QueryCoordDivergedLeaderNum.Reset()
+	for collectionID, count := range counts {
+		metrics.QueryCoordDivergedLeaderNum.WithLabelValues(strconv.FormatInt(collectionID, 10)).Set(float64(count))
+	}
+
+	for _, leader := range toRemedy {
+		for _, hook := range hooks {
+			hook(leader)
+		}
+	}
+}
+
+// checkLeader returns the leader of the channel in the replica, and the reason if its view diverges, empty if not
+func (ob *LeaderDivergenceObserver) checkLeader(replica *meta.Replica, channel string) (int64, string) {
+	leaderID, ok := ob.dist.ChannelDistManager.GetShardLeader(replica, channel)
+	if !ok {
+		return -1, "shard leader not found"
+	}
+	view := ob.dist.LeaderViewManager.GetLeaderShardView(leaderID, channel)
+	if view == nil {
+		return leaderID, "leader view not found"
+	}
+
+	targetVersion := ob.target.GetCollectionTargetVersion(replica.GetCollectionID(), meta.CurrentTarget)
+	if view.TargetVersion < targetVersion {
+		return leaderID, fmt.Sprintf("target version %d behind current target %d", view.TargetVersion, targetVersion)
+	}
+
+	for segmentID := range ob.target.GetHistoricalSegmentsByChannel(replica.GetCollectionID(), channel, meta.CurrentTarget) {
+		if _, ok := view.Segments[segmentID]; !ok {
+			return leaderID, fmt.Sprintf("segment %d in current target missing", segmentID)
+		}
+	}
+
+	dists := make(map[int64]map[int64]int64) // segmentID -> nodeID -> version
+	for _, segment := range ob.dist.SegmentDistManager.GetByShardWithReplica(channel, replica) {
+		if dists[segment.GetID()] == nil {
+			dists[segment.GetID()] = make(map[int64]int64)
+		}
+		dists[segment.GetID()][segment.Node] = segment.Version
+	}
+	for segmentID, dist := range view.Segments {
+		version, ok := dists[segmentID][dist.GetNodeID()]
+		if !ok || version != dist.GetVersion() {
+			return leaderID, fmt.Sprintf("segment %d on node %d not in distribution", segmentID, dist.GetNodeID())
+		}
+	}
+	return leaderID, ""
+}
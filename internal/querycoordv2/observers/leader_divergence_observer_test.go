@@ -0,0 +1,167 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/pkg/util/etcd"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type LeaderDivergenceObserverSuite struct {
+	suite.Suite
+
+	kv     kv.MetaKv
+	meta   *meta.Meta
+	dist   *meta.DistributionManager
+	target *meta.TargetManager
+	broker *meta.MockBroker
+
+	observer *LeaderDivergenceObserver
+}
+
+func (suite *LeaderDivergenceObserverSuite) SetupSuite() {
+	Params.Init()
+}
+
+func (suite *LeaderDivergenceObserverSuite) SetupTest() {
+	config := GenerateEtcdConfig()
+	cli, err := etcd.GetEtcdClient(
+		config.UseEmbedEtcd.GetAsBool(),
+		config.EtcdUseSSL.GetAsBool(),
+		config.Endpoints.GetAsStrings(),
+		config.EtcdTLSCert.GetValue(),
+		config.EtcdTLSKey.GetValue(),
+		config.EtcdTLSCACert.GetValue(),
+		config.EtcdTLSMinVersion.GetValue())
+	suite.Require().NoError(err)
+	suite.kv = etcdkv.NewEtcdKV(cli, config.MetaRootPath.GetValue())
+
+	store := querycoord.NewCatalog(suite.kv)
+	suite.meta = meta.NewMeta(RandomIncrementIDAllocator(), store, session.NewNodeManager())
+	suite.dist = meta.NewDistributionManager()
+	suite.broker = meta.NewMockBroker(suite.T())
+	suite.target = meta.NewTargetManager(suite.broker, suite.meta)
+	suite.observer = NewLeaderDivergenceObserver(suite.meta, suite.dist, suite.target)
+
+	suite.meta.CollectionManager.PutCollection(utils.CreateTestCollection(1, 1))
+	suite.meta.ReplicaManager.Put(utils.CreateTestReplica(1, 1, []int64{1, 2}))
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, int64(1)).Return(
+		[]*datapb.VchannelInfo{{CollectionID: 1, ChannelName: "test-insert-channel"}},
+		[]*datapb.SegmentInfo{{ID: 1, PartitionID: 1, InsertChannel: "test-insert-channel"}},
+		nil)
+	suite.target.UpdateCollectionNextTargetWithPartitions(1, 1)
+	suite.target.UpdateCollectionCurrentTarget(1)
+	suite.dist.SegmentDistManager.Update(1, utils.CreateTestSegment(1, 1, 1, 1, 1, "test-insert-channel"))
+	suite.dist.ChannelDistManager.Update(2, utils.CreateTestChannel(1, 2, 1, "test-insert-channel"))
+}
+
+func (suite *LeaderDivergenceObserverSuite) TearDownTest() {
+	suite.observer.Stop()
+	suite.kv.Close()
+}
+
+func (suite *LeaderDivergenceObserverSuite) TestDivergedLeader() {
+	paramtable.Get().Save(Params.QueryCoordCfg.LeaderDivergenceThreshold.Key, "0")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.LeaderDivergenceThreshold.Key)
+
+	remedied := make([]DivergedLeader, 0)
+	suite.observer.RegisterRemediationHook(func(leader DivergedLeader) {
+		remedied = append(remedied, leader)
+	})
+
+	// leader view not found
+	suite.observer.check()
+	leaders := suite.observer.GetDivergedLeaders(0)
+	suite.Len(leaders, 1)
+	suite.Equal(int64(2), leaders[0].LeaderID)
+	suite.Equal("leader view not found", leaders[0].Reason)
+	suite.Len(remedied, 1)
+
+	// segment missing in leader view, remedy only once
+	view := utils.CreateTestLeaderView(2, 1, "test-insert-channel", map[int64]int64{}, map[int64]*meta.Segment{})
+	view.TargetVersion = suite.target.GetCollectionTargetVersion(1, meta.CurrentTarget)
+	suite.dist.LeaderViewManager.Update(2, view)
+	suite.observer.check()
+	leaders = suite.observer.GetDivergedLeaders(1)
+	suite.Len(leaders, 1)
+	suite.Contains(leaders[0].Reason, "segment 1 in current target missing")
+	suite.Len(remedied, 1)
+
+	// segment version not in distribution
+	view = view.Clone()
+	view.Segments[1] = &querypb.SegmentDist{NodeID: 1, Version: 0}
+	suite.dist.LeaderViewManager.Update(2, view)
+	suite.observer.check()
+	leaders = suite.observer.GetDivergedLeaders(1)
+	suite.Len(leaders, 1)
+	suite.Contains(leaders[0].Reason, "not in distribution")
+
+	// other collection
+	suite.Len(suite.observer.GetDivergedLeaders(2), 0)
+
+	// in sync
+	view = view.Clone()
+	view.Segments[1] = &querypb.SegmentDist{NodeID: 1, Version: 1}
+	suite.dist.LeaderViewManager.Update(2, view)
+	suite.observer.check()
+	suite.Len(suite.observer.GetDivergedLeaders(0), 0)
+
+	// target version lagging, remedy again as it's a new divergence
+	view = view.Clone()
+	view.TargetVersion = 0
+	suite.dist.LeaderViewManager.Update(2, view)
+	suite.observer.check()
+	leaders = suite.observer.GetDivergedLeaders(0)
+	suite.Len(leaders, 1)
+	suite.Contains(leaders[0].Reason, "target version")
+	suite.Len(remedied, 2)
+}
+
+func (suite *LeaderDivergenceObserverSuite) TestThreshold() {
+	remedied := 0
+	suite.observer.RegisterRemediationHook(func(leader DivergedLeader) {
+		remedied++
+	})
+
+	suite.observer.check()
+	suite.Len(suite.observer.diverged, 1)
+	suite.Len(suite.observer.GetDivergedLeaders(0), 0)
+	suite.Equal(0, remedied)
+
+	// released
+	suite.meta.CollectionManager.RemoveCollection(1)
+	suite.observer.check()
+	suite.Len(suite.observer.diverged, 0)
+}
+
+func TestLeaderDivergenceObserver(t *testing.T) {
+	suite.Run(t, new(LeaderDivergenceObserverSuite))
+}
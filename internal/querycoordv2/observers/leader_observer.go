@@ -133,6 +133,18 @@ func (ob *LeaderObserver) CheckTargetVersion(collectionID int64) bool {
 	return <-notifier
 }
 
+// TriggerSync triggers a sync of the collection's leader views without waiting for the result,
+// it's dropped if there are too many manual checks pending.
+func (ob *LeaderObserver) TriggerSync(collectionID int64) {
+	select {
+	case ob.manualCheck <- checkRequest{
+		CollectionID: collectionID,
+		Notifier:     make(chan bool, 1),
+	}:
+	default:
+	}
+}
+
 func (o *LeaderObserver) checkNeedUpdateTargetVersion(leaderView *meta.LeaderView) *querypb.SyncAction {
 	targetVersion := o.target.GetCollectionTargetVersion(leaderView.CollectionID, meta.CurrentTarget)
 
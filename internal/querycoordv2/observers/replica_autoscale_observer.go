@@ -0,0 +1,329 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observers
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// ReplicaAutoScaleObserver adds or removes the replicas of the loaded collections by the search load,
+// within the range of queryCoord.replicaAutoScale.minReplicas and maxReplicas.
+//
+// The search load of a replica is the searched nq per second per node and the average search queue latency,
+// reported by its QueryNodes along with the distribution.
+// A new replica takes a node from the largest replica in the same resource group,
+// and a removed replica gives its nodes to the other replicas in the same resource group,
+// then the checkers load and release the segments and channels as they do for the replica changes by loading.
+type ReplicaAutoScaleObserver struct {
+	c       chan struct{}
+	wg      sync.WaitGroup
+	meta    *meta.Meta
+	distMgr *meta.DistributionManager
+	nodeMgr *session.NodeManager
+
+	lastScaled map[int64]time.Time // collectionID -> the last time its replicas were changed
+
+	stopOnce sync.Once
+}
+
+func NewReplicaAutoScaleObserver(meta *meta.Meta, distMgr *meta.DistributionManager, nodeMgr *session.NodeManager) *ReplicaAutoScaleObserver {
+	return &ReplicaAutoScaleObserver{
+		c:          make(chan struct{}),
+		meta:       meta,
+		distMgr:    distMgr,
+		nodeMgr:    nodeMgr,
+		lastScaled: make(map[int64]time.Time),
+	}
+}
+
+func (ob *ReplicaAutoScaleObserver) Start(ctx context.Context) {
+	ob.wg.Add(1)
+	go ob.schedule(ctx)
+}
+
+func (ob *ReplicaAutoScaleObserver) Stop() {
+	ob.stopOnce.Do(func() {
+		close(ob.c)
+		ob.wg.Wait()
+	})
+}
+
+func (ob *ReplicaAutoScaleObserver) schedule(ctx context.Context) {
+	defer ob.wg.Done()
+	log.Info("Start replica auto-scaling loop")
+
+	ticker := time.NewTicker(params.Params.QueryCoordCfg.ReplicaAutoScaleInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("Close replica auto-scaling observer due to context canceled")
+			return
+		case <-ob.c:
+			log.Info("Close replica auto-scaling observer")
+			return
+
+		case <-ticker.C:
+			if params.Params.QueryCoordCfg.ReplicaAutoScaleEnabled.GetAsBool() {
+				ob.checkReplicas()
+			}
+		}
+	}
+}
+
+func (ob *ReplicaAutoScaleObserver) checkReplicas() {
+	cooldown := params.Params.QueryCoordCfg.ReplicaAutoScaleCooldown.GetAsDuration(time.Second)
+	collections := ob.meta.CollectionManager.GetAll()
+	for _, collectionID := range collections {
+		ob.fillEmptyReplicas(collectionID)
+
+		if ob.meta.CollectionManager.CalculateLoadStatus(collectionID) != querypb.LoadStatus_Loaded ||
+			time.Since(ob.lastScaled[collectionID]) < cooldown {
+			continue
+		}
+		replicas := ob.meta.ReplicaManager.GetByCollection(collectionID)
+		nqRate, latency := ob.searchLoad(replicas)
+
+		var scaled bool
+		switch scaleDirection(len(replicas), nqRate, latency) {
+		case 1:
+			scaled = ob.scaleUp(collectionID, replicas, nqRate, latency)
+		case -1:
+			scaled = ob.scaleDown(collectionID, replicas, nqRate, latency)
+		}
+		if scaled {
+			ob.lastScaled[collectionID] = time.Now()
+		}
+	}
+
+	// clean up the released collections
+	for collectionID := range ob.lastScaled {
+		if !ob.meta.CollectionManager.Exist(collectionID) {
+			delete(ob.lastScaled, collectionID)
+		}
+	}
+}
+
+// searchLoad returns the average searched nq per second per node and the average search queue latency of the replicas.
+func (ob *ReplicaAutoScaleObserver) searchLoad(replicas []*meta.Replica) (nqRate float64, latency float64) {
+	var count int
+	for _, replica := range replicas {
+		nodes := replica.GetNodes()
+		if len(nodes) == 0 {
+			continue
+		}
+		var replicaRate, replicaLatency float64
+		for _, node := range nodes {
+			if info := ob.nodeMgr.Get(node); info != nil {
+				replicaRate += info.SearchNQRate()
+				replicaLatency += info.SearchLatency()
+			}
+		}
+		nqRate += replicaRate / float64(len(nodes))
+		latency += replicaLatency / float64(len(nodes))
+		count++
+	}
+	if count == 0 {
+		return 0, 0
+	}
+	return nqRate / float64(count), latency / float64(count)
+}
+
+// scaleDirection returns 1 to add a replica, -1 to remove one, 0 to keep the replicas.
+func scaleDirection(replicaNum int, nqRate float64, latency float64) int {
+	cfg := &params.Params.QueryCoordCfg
+	minReplicas := cfg.ReplicaAutoScaleMinReplicas.GetAsInt()
+	maxReplicas := cfg.ReplicaAutoScaleMaxReplicas.GetAsInt()
+	if maxReplicas < minReplicas {
+		maxReplicas = minReplicas
+	}
+	upNQRate := cfg.ReplicaAutoScaleUpNQRate.GetAsFloat()
+	upLatency := cfg.ReplicaAutoScaleUpLatency.GetAsFloat()
+
+	switch {
+	case replicaNum < minReplicas:
+		return 1
+	case replicaNum > maxReplicas:
+		return -1
+	case replicaNum < maxReplicas && (nqRate > upNQRate || latency > upLatency):
+		return 1
+	case replicaNum > minReplicas && nqRate < cfg.ReplicaAutoScaleDownNQRate.GetAsFloat() && latency <= upLatency &&
+		// the left replicas shall not be scaled up again at once
+		nqRate*float64(replicaNum)/float64(replicaNum-1) <= upNQRate:
+		return -1
+	}
+	return 0
+}
+
+func (ob *ReplicaAutoScaleObserver) scaleUp(collectionID int64, replicas []*meta.Replica, nqRate float64, latency float64) bool {
+	log := log.Ctx(context.Background()).WithRateGroup("qcv2.replicaAutoScaleObserver", 1, 60).With(
+		zap.Int64("collectionID", collectionID),
+		zap.Int("replicaNum", len(replicas)),
+		zap.Float64("nqRate", nqRate),
+		zap.Float64("latency", latency),
+	)
+	donor := largestReplica(replicas, "")
+	if donor == nil {
+		log.RatedInfo(10, "no replica has spare node to scale up")
+		return false
+	}
+	spawned, err := ob.meta.ReplicaManager.Spawn(collectionID, 1, donor.GetResourceGroup())
+	if err == nil {
+		spawned[0].PlacementLabels = donor.GetPlacementLabels()
+		err = ob.meta.ReplicaManager.Put(spawned...)
+	}
+	if err != nil {
+		log.Warn("failed to spawn replica", zap.Error(err))
+		return false
+	}
+	if err := ob.meta.CollectionManager.UpdateReplicaNumber(collectionID, int32(len(replicas)+1)); err != nil {
+		log.Warn("failed to update replica number", zap.Error(err))
+	}
+	log.Info("scale up replicas by search load",
+		zap.Int64("replicaID", spawned[0].GetID()),
+		zap.String("resourceGroup", spawned[0].GetResourceGroup()),
+	)
+	ob.fillEmptyReplicas(collectionID)
+	return true
+}
+
+func (ob *ReplicaAutoScaleObserver) scaleDown(collectionID int64, replicas []*meta.Replica, nqRate float64, latency float64) bool {
+	log := log.Ctx(context.Background()).WithRateGroup("qcv2.replicaAutoScaleObserver", 1, 60).With(
+		zap.Int64("collectionID", collectionID),
+		zap.Int("replicaNum", len(replicas)),
+		zap.Float64("nqRate", nqRate),
+		zap.Float64("latency", latency),
+	)
+	// remove the smallest replica which has other replicas in the same resource group to take over its nodes
+	sort.Slice(replicas, func(i, j int) bool {
+		if replicas[i].Len() != replicas[j].Len() {
+			return replicas[i].Len() < replicas[j].Len()
+		}
+		return replicas[i].GetID() > replicas[j].GetID()
+	})
+	var removed *meta.Replica
+	for _, replica := range replicas {
+		if len(ob.meta.ReplicaManager.GetByCollectionAndRG(collectionID, replica.GetResourceGroup())) > 1 {
+			removed = replica
+			break
+		}
+	}
+	if removed == nil {
+		log.RatedInfo(10, "no replica could be removed without losing its nodes")
+		return false
+	}
+
+	if err := ob.meta.ReplicaManager.RemoveReplica(collectionID, removed.GetID()); err != nil {
+		log.Warn("failed to remove replica", zap.Int64("replicaID", removed.GetID()), zap.Error(err))
+		return false
+	}
+	if err := ob.meta.CollectionManager.UpdateReplicaNumber(collectionID, int32(len(replicas)-1)); err != nil {
+		log.Warn("failed to update replica number", zap.Error(err))
+	}
+	log.Info("scale down replicas by search load",
+		zap.Int64("replicaID", removed.GetID()),
+		zap.String("resourceGroup", removed.GetResourceGroup()),
+		zap.Int64s("nodes", removed.GetNodes()),
+	)
+	left := ob.meta.ReplicaManager.GetByCollectionAndRG(collectionID, removed.GetResourceGroup())
+	for _, node := range removed.GetNodes() {
+		utils.AddNodesToReplicas(ob.meta, left, node)
+	}
+	return true
+}
+
+// fillEmptyReplicas moves a node from the largest replica in the same resource group to each empty replica.
+func (ob *ReplicaAutoScaleObserver) fillEmptyReplicas(collectionID int64) {
+	replicas := ob.meta.ReplicaManager.GetByCollection(collectionID)
+	for _, replica := range replicas {
+		if replica.Len() > 0 {
+			continue
+		}
+		donor := largestReplica(replicas, replica.GetResourceGroup())
+		if donor == nil {
+			continue
+		}
+		node := ob.lightestNode(collectionID, donor)
+		if err := ob.meta.ReplicaManager.RemoveNode(donor.GetID(), node); err != nil {
+			log.Warn("failed to remove node from replica",
+				zap.Int64("collectionID", collectionID),
+				zap.Int64("replicaID", donor.GetID()),
+				zap.Int64("nodeID", node),
+				zap.Error(err),
+			)
+			continue
+		}
+		if err := ob.meta.ReplicaManager.AddNode(replica.GetID(), node); err != nil {
+			log.Warn("failed to add node to replica",
+				zap.Int64("collectionID", collectionID),
+				zap.Int64("replicaID", replica.GetID()),
+				zap.Int64("nodeID", node),
+				zap.Error(err),
+			)
+			continue
+		}
+		log.Info("move node to the empty replica",
+			zap.Int64("collectionID", collectionID),
+			zap.Int64("from", donor.GetID()),
+			zap.Int64("to", replica.GetID()),
+			zap.Int64("nodeID", node),
+		)
+		// refresh the replicas as the donor has changed
+		replicas = ob.meta.ReplicaManager.GetByCollection(collectionID)
+	}
+}
+
+// lightestNode returns the node of the replica serving the least segments and channels of the collection.
+func (ob *ReplicaAutoScaleObserver) lightestNode(collectionID int64, replica *meta.Replica) int64 {
+	nodes := replica.GetNodes()
+	sort.Slice(nodes, func(i, j int) bool {
+		return ob.nodeLoad(collectionID, nodes[i]) < ob.nodeLoad(collectionID, nodes[j])
+	})
+	return nodes[0]
+}
+
+func (ob *ReplicaAutoScaleObserver) nodeLoad(collectionID, node int64) int {
+	return len(ob.distMgr.SegmentDistManager.GetByCollectionAndNode(collectionID, node)) +
+		len(ob.distMgr.ChannelDistManager.GetByCollectionAndNode(collectionID, node))
+}
+
+// largestReplica returns the replica with the most nodes which could spare one,
+// in the given resource group if it's not empty, nil if there is no such replica.
+func largestReplica(replicas []*meta.Replica, rgName string) *meta.Replica {
+	var largest *meta.Replica
+	for _, replica := range replicas {
+		if rgName != "" && replica.GetResourceGroup() != rgName {
+			continue
+		}
+		if replica.Len() > 1 && (largest == nil || replica.Len() > largest.Len()) {
+			largest = replica
+		}
+	}
+	return largest
+}
@@ -0,0 +1,167 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/pkg/util/etcd"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+type ReplicaAutoScaleObserverSuite struct {
+	suite.Suite
+
+	kv kv.MetaKv
+	//dependency
+	meta    *meta.Meta
+	distMgr *meta.DistributionManager
+
+	nodeMgr  *session.NodeManager
+	observer *ReplicaAutoScaleObserver
+
+	collectionID int64
+	partitionID  int64
+}
+
+func (suite *ReplicaAutoScaleObserverSuite) SetupSuite() {
+	paramtable.Init()
+	paramtable.Get().Save(Params.QueryCoordCfg.ReplicaAutoScaleCooldown.Key, "0")
+	paramtable.Get().Save(Params.QueryCoordCfg.ReplicaAutoScaleMaxReplicas.Key, "2")
+}
+
+func (suite *ReplicaAutoScaleObserverSuite) TearDownSuite() {
+	paramtable.Get().Reset(Params.QueryCoordCfg.ReplicaAutoScaleCooldown.Key)
+	paramtable.Get().Reset(Params.QueryCoordCfg.ReplicaAutoScaleMaxReplicas.Key)
+}
+
+func (suite *ReplicaAutoScaleObserverSuite) SetupTest() {
+	var err error
+	config := GenerateEtcdConfig()
+	cli, err := etcd.GetEtcdClient(
+		config.UseEmbedEtcd.GetAsBool(),
+		config.EtcdUseSSL.GetAsBool(),
+		config.Endpoints.GetAsStrings(),
+		config.EtcdTLSCert.GetValue(),
+		config.EtcdTLSKey.GetValue(),
+		config.EtcdTLSCACert.GetValue(),
+		config.EtcdTLSMinVersion.GetValue())
+	suite.Require().NoError(err)
+	suite.kv = etcdkv.NewEtcdKV(cli, config.MetaRootPath.GetValue())
+
+	// meta
+	store := querycoord.NewCatalog(suite.kv)
+	idAllocator := RandomIncrementIDAllocator()
+	suite.nodeMgr = session.NewNodeManager()
+	suite.meta = meta.NewMeta(idAllocator, store, suite.nodeMgr)
+
+	suite.distMgr = meta.NewDistributionManager()
+	suite.observer = NewReplicaAutoScaleObserver(suite.meta, suite.distMgr, suite.nodeMgr)
+	suite.collectionID = int64(1000)
+	suite.partitionID = int64(100)
+
+	for node := int64(1); node <= 3; node++ {
+		suite.nodeMgr.Add(session.NewNodeInfo(node, "localhost:8080"))
+		suite.meta.ResourceManager.AssignNode(meta.DefaultResourceGroupName, node)
+	}
+	partition := utils.CreateTestPartition(suite.collectionID, suite.partitionID)
+	partition.Status = querypb.LoadStatus_Loaded
+	err = suite.meta.CollectionManager.PutCollection(utils.CreateTestCollection(suite.collectionID, 1), partition)
+	suite.Require().NoError(err)
+	err = suite.meta.ReplicaManager.Put(meta.NewReplica(
+		&querypb.Replica{
+			ID:            10000,
+			CollectionID:  suite.collectionID,
+			ResourceGroup: meta.DefaultResourceGroupName,
+			Nodes:         []int64{1, 2, 3},
+		},
+		typeutil.NewUniqueSet(1, 2, 3),
+	))
+	suite.Require().NoError(err)
+}
+
+func (suite *ReplicaAutoScaleObserverSuite) TearDownTest() {
+	suite.kv.Close()
+}
+
+func (suite *ReplicaAutoScaleObserverSuite) setSearchLoad(nqRate float64, latency float64) {
+	for _, node := range suite.nodeMgr.GetAll() {
+		node.UpdateStats(
+			session.WithRuntimeLoad(0, 0, 0, nqRate),
+			session.WithSearchLatency(latency),
+		)
+	}
+}
+
+func (suite *ReplicaAutoScaleObserverSuite) TestScaleUpAndDown() {
+	// the node 1 serves the segments, so it's kept in the original replica
+	suite.distMgr.SegmentDistManager.Update(1, utils.CreateTestSegment(suite.collectionID, suite.partitionID, 1, 1, 1, "test-insert-channel"))
+
+	// normal load
+	suite.setSearchLoad(500, 10)
+	suite.observer.checkReplicas()
+	suite.Len(suite.meta.ReplicaManager.GetByCollection(suite.collectionID), 1)
+
+	// heavy load
+	suite.setSearchLoad(2000, 10)
+	suite.observer.checkReplicas()
+	replicas := suite.meta.ReplicaManager.GetByCollection(suite.collectionID)
+	suite.Len(replicas, 2)
+	suite.EqualValues(2, suite.meta.CollectionManager.GetReplicaNumber(suite.collectionID))
+	suite.EqualValues(2, suite.meta.CollectionManager.GetPartition(suite.partitionID).GetReplicaNumber())
+	suite.Len(suite.meta.ReplicaManager.Get(10000).GetNodes(), 2)
+	suite.True(suite.meta.ReplicaManager.Get(10000).Contains(1))
+
+	// the max replicas reached
+	suite.setSearchLoad(2000, 1000)
+	suite.observer.checkReplicas()
+	suite.Len(suite.meta.ReplicaManager.GetByCollection(suite.collectionID), 2)
+
+	// light load, the smaller replica is removed and its node goes back
+	suite.setSearchLoad(10, 10)
+	suite.observer.checkReplicas()
+	replicas = suite.meta.ReplicaManager.GetByCollection(suite.collectionID)
+	suite.Len(replicas, 1)
+	suite.EqualValues(10000, replicas[0].GetID())
+	suite.ElementsMatch([]int64{1, 2, 3}, replicas[0].GetNodes())
+	suite.EqualValues(1, suite.meta.CollectionManager.GetReplicaNumber(suite.collectionID))
+
+	// the min replicas reached
+	suite.observer.checkReplicas()
+	suite.Len(suite.meta.ReplicaManager.GetByCollection(suite.collectionID), 1)
+}
+
+func (suite *ReplicaAutoScaleObserverSuite) TestScaleUpByLatency() {
+	suite.setSearchLoad(10, 1000)
+	suite.observer.checkReplicas()
+	suite.Len(suite.meta.ReplicaManager.GetByCollection(suite.collectionID), 2)
+}
+
+func TestReplicaAutoScaleObserver(t *testing.T) {
+	suite.Run(t, new(ReplicaAutoScaleObserverSuite))
+}
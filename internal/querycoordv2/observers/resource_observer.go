@@ -83,6 +83,7 @@ func (ob *ResourceObserver) checkResourceGroup() {
 	rgNames := manager.ListResourceGroups()
 
 	enableRGAutoRecover := params.Params.QueryCoordCfg.EnableRGAutoRecover.GetAsBool()
+	overflowRG := params.Params.QueryCoordCfg.OverflowResourceGroup.GetValue()
 
 	for _, rgName := range rgNames {
 		if rgName == meta.DefaultResourceGroupName {
@@ -106,7 +107,53 @@ func (ob *ResourceObserver) checkResourceGroup() {
 				}
 
 				utils.AddNodesToCollectionsInRG(ob.meta, rgName, nodes...)
+
+				if overflowRG != "" && len(nodes) < lackNodeNum {
+					ob.borrowNodes(rgName, overflowRG)
+				}
 			}
 		}
+
+		if enableRGAutoRecover {
+			ob.returnBorrowedNodes(rgName)
+		}
+	}
+}
+
+// borrowNodes borrows nodes from the overflow resource group for the resource group still lack of nodes.
+func (ob *ResourceObserver) borrowNodes(rgName string, overflowRG string) {
+	nodes, err := ob.meta.ResourceManager.BorrowNodes(rgName, overflowRG)
+	if err != nil {
+		log.Warn("failed to borrow nodes from overflow resource group",
+			zap.String("rgName", rgName),
+			zap.String("overflowRG", overflowRG),
+			zap.Error(err),
+		)
+		return
+	}
+	if len(nodes) > 0 {
+		log.Info("borrowed nodes from overflow resource group",
+			zap.String("rgName", rgName),
+			zap.String("overflowRG", overflowRG),
+			zap.Int64s("nodes", nodes),
+		)
+	}
+	utils.AddNodesToCollectionsInRG(ob.meta, rgName, nodes...)
+}
+
+// returnBorrowedNodes returns the borrowed nodes to their lenders once the resource group doesn't need them,
+// the returned nodes are moved out of the replicas by the replica observer after the segments and channels are moved away.
+func (ob *ResourceObserver) returnBorrowedNodes(rgName string) {
+	filled, returned, err := ob.meta.ResourceManager.ReturnBorrowedNodes(rgName)
+	if err != nil {
+		log.Warn("failed to return borrowed nodes",
+			zap.String("rgName", rgName),
+			zap.Error(err),
+		)
+		return
+	}
+	utils.AddNodesToCollectionsInRG(ob.meta, rgName, filled...)
+	for node, lender := range returned {
+		utils.AddNodesToCollectionsInRG(ob.meta, lender, node)
 	}
 }
@@ -56,6 +56,8 @@ type TargetObserver struct {
 	mut                  sync.Mutex                // Guard readyNotifiers
 	readyNotifiers       map[int64][]chan struct{} // CollectionID -> Notifiers
 
+	promotionGate func(collectionID int64) bool
+
 	stopOnce sync.Once
 }
 
@@ -73,6 +75,12 @@ func NewTargetObserver(meta *meta.Meta, targetMgr *meta.TargetManager, distMgr *
 	}
 }
 
+// SetPromotionGate sets the extra condition to promote the next target to the current one,
+// which must be set before Start.
+func (ob *TargetObserver) SetPromotionGate(gate func(collectionID int64) bool) {
+	ob.promotionGate = gate
+}
+
 func (ob *TargetObserver) Start(ctx context.Context) {
 	ob.wg.Add(1)
 	go ob.schedule(ctx)
@@ -268,6 +276,10 @@ func (ob *TargetObserver) shouldUpdateCurrentTarget(collectionID int64) bool {
 		}
 	}
 
+	if ob.promotionGate != nil && !ob.promotionGate(collectionID) {
+		return false
+	}
+
 	return true
 }
 
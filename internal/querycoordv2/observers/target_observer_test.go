@@ -211,6 +211,35 @@ func (suite *TargetObserverSuite) TestTriggerUpdateTarget() {
 	}, 7*time.Second, 1*time.Second)
 }
 
+func (suite *TargetObserverSuite) TestPromotionGate() {
+	observer := NewTargetObserver(suite.meta, suite.targetMgr, suite.distMgr, suite.broker)
+	suite.NoError(suite.targetMgr.UpdateCollectionNextTarget(suite.collectionID))
+	suite.distMgr.LeaderViewManager.Update(2,
+		&meta.LeaderView{
+			ID:           2,
+			CollectionID: suite.collectionID,
+			Channel:      "channel-1",
+			Segments: map[int64]*querypb.SegmentDist{
+				11: {NodeID: 2},
+			},
+		},
+		&meta.LeaderView{
+			ID:           2,
+			CollectionID: suite.collectionID,
+			Channel:      "channel-2",
+			Segments: map[int64]*querypb.SegmentDist{
+				12: {NodeID: 2},
+			},
+		},
+	)
+	suite.True(observer.shouldUpdateCurrentTarget(suite.collectionID))
+
+	observer.SetPromotionGate(func(collectionID int64) bool {
+		return false
+	})
+	suite.False(observer.shouldUpdateCurrentTarget(suite.collectionID))
+}
+
 func (suite *TargetObserverSuite) TearDownSuite() {
 	suite.kv.Close()
 	suite.observer.Stop()
@@ -37,6 +37,7 @@ import (
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/metastore"
 	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/querycoordv2/balance"
 	"github.com/milvus-io/milvus/internal/querycoordv2/checkers"
 	"github.com/milvus-io/milvus/internal/querycoordv2/dist"
@@ -48,6 +49,8 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/task"
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/componentutil"
+	"github.com/milvus-io/milvus/internal/util/metastoreutil"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/pkg/common"
@@ -107,7 +110,9 @@ type Server struct {
 	leaderObserver     *observers.LeaderObserver
 	targetObserver     *observers.TargetObserver
 	replicaObserver    *observers.ReplicaObserver
+	autoScaleObserver  *observers.ReplicaAutoScaleObserver
 	resourceObserver   *observers.ResourceObserver
+	divergenceObserver *observers.LeaderDivergenceObserver
 
 	balancer    balance.Balance
 	balancerMap map[string]balance.Balance
@@ -118,6 +123,12 @@ type Server struct {
 
 	nodeUpEventChan chan int64
 	notifyNodeUp    chan struct{}
+
+	// metaStoreMonitor switches querycoord into read only mode when the meta store is degraded
+	metaStoreMonitor *metastoreutil.HealthMonitor
+
+	// readiness is the startup phase reported by the component states
+	readiness componentutil.Readiness
 }
 
 func NewQueryCoord(ctx context.Context) (*Server, error) {
@@ -230,9 +241,18 @@ func (s *Server) initQueryCoord() error {
 	if err != nil {
 		return err
 	}
+	s.readiness.Advance(componentutil.PhaseMetaRecovered)
 	// Init session
 	log.Info("init session")
-	s.cluster = session.NewCluster(s.nodeMgr, s.queryNodeCreator)
+	// the term of the fencing tokens increases across the failovers, as the allocated IDs do
+	term, err := s.idAllocator()
+	if err != nil {
+		log.Error("failed to allocate term", zap.Error(err))
+		return err
+	}
+	cluster := session.NewCluster(s.nodeMgr, s.queryNodeCreator)
+	cluster.SetTerm(term)
+	s.cluster = cluster
 
 	// Init schedulers
 	log.Info("init schedulers")
@@ -265,6 +285,8 @@ func (s *Server) initQueryCoord() error {
 		s.nodeMgr, s.dist, s.meta, s.targetMgr)
 	s.balancerMap[balance.ScoreBasedBalancerName] = balance.NewScoreBasedBalancer(s.taskScheduler,
 		s.nodeMgr, s.dist, s.meta, s.targetMgr)
+	s.balancerMap[balance.LoadAwareBalancerName] = balance.NewLoadAwareBalancer(s.taskScheduler,
+		s.nodeMgr, s.dist, s.meta, s.targetMgr)
 	if balancer, ok := s.balancerMap[params.Params.QueryCoordCfg.Balancer.GetValue()]; ok {
 		s.balancer = balancer
 		log.Info("use config balancer", zap.String("balancer", params.Params.QueryCoordCfg.Balancer.GetValue()))
@@ -283,7 +305,13 @@ func (s *Server) initQueryCoord() error {
 		s.nodeMgr,
 		s.taskScheduler,
 		s.broker,
+		s.store,
 	)
+	err = s.checkerController.Recover()
+	if err != nil {
+		log.Error("failed to recover checker activations", zap.Error(err))
+		return err
+	}
 
 	// Init observers
 	s.initObserver()
@@ -291,6 +319,8 @@ func (s *Server) initQueryCoord() error {
 	// Init load status cache
 	meta.GlobalFailedLoadCache = meta.NewFailedLoadCache()
 
+	s.metaStoreMonitor = metastoreutil.NewHealthMonitor(s.etcdCli, typeutil.QueryCoordRole)
+
 	log.Info("QueryCoord init success")
 	return err
 }
@@ -358,6 +388,7 @@ func (s *Server) initObserver() {
 		s.dist,
 		s.broker,
 	)
+	s.targetObserver.SetPromotionGate(s.checkerController.IsTargetIndexReady)
 	s.collectionObserver = observers.NewCollectionObserver(
 		s.dist,
 		s.meta,
@@ -373,6 +404,23 @@ func (s *Server) initObserver() {
 	)
 
 	s.resourceObserver = observers.NewResourceObserver(s.meta)
+
+	s.autoScaleObserver = observers.NewReplicaAutoScaleObserver(
+		s.meta,
+		s.dist,
+		s.nodeMgr,
+	)
+
+	s.divergenceObserver = observers.NewLeaderDivergenceObserver(
+		s.meta,
+		s.dist,
+		s.targetMgr,
+	)
+	s.divergenceObserver.RegisterRemediationHook(func(leader observers.DivergedLeader) {
+		if Params.QueryCoordCfg.LeaderDivergenceAutoRemediation.GetAsBool() {
+			s.leaderObserver.TriggerSync(leader.CollectionID)
+		}
+	})
 }
 
 func (s *Server) afterStart() {
@@ -395,7 +443,9 @@ func (s *Server) startQueryCoord() error {
 		return err
 	}
 	for _, node := range sessions {
-		s.nodeMgr.Add(session.NewNodeInfo(node.ServerID, node.Address))
+		nodeInfo := session.NewNodeInfo(node.ServerID, node.Address)
+		nodeInfo.UpdateStats(session.WithLabels(node.ServerLabels))
+		s.nodeMgr.Add(nodeInfo)
 		s.taskScheduler.AddExecutor(node.ServerID)
 	}
 	s.checkReplicas()
@@ -406,6 +456,7 @@ func (s *Server) startQueryCoord() error {
 	s.wg.Add(2)
 	go s.handleNodeUpLoop()
 	go s.watchNodes(revision)
+	s.readiness.Advance(componentutil.PhaseSessionsWatched)
 
 	log.Info("start recovering dist and target")
 	err = s.recover()
@@ -413,11 +464,52 @@ func (s *Server) startQueryCoord() error {
 		return err
 	}
 	s.startServerLoop()
+	s.readiness.Advance(componentutil.PhaseCheckersStarted)
+	s.metaStoreMonitor.Start()
 	s.afterStart()
 	s.UpdateStateCode(commonpb.StateCode_Healthy)
+
+	s.wg.Add(1)
+	go s.waitTargetsRecovered()
 	return nil
 }
 
+// waitTargetsRecovered advances the readiness to PhaseTargetsRecovered once the current targets of all the loaded
+// collections are recovered, which happens after the distribution catches up with the next targets recovered on start.
+// The phase is advanced after the timeout anyway, so that the components waiting for it are not blocked forever.
+func (s *Server) waitTargetsRecovered() {
+	defer s.wg.Done()
+
+	timeout := time.NewTimer(Params.QueryCoordCfg.TargetRecoveryTimeout.GetAsDuration(time.Second))
+	defer timeout.Stop()
+	ticker := time.NewTicker(200 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		pending := make([]int64, 0)
+		for _, collection := range s.meta.GetAllCollections() {
+			if collection.GetStatus() == querypb.LoadStatus_Loaded && !s.targetMgr.IsCurrentTargetExist(collection.GetCollectionID()) {
+				pending = append(pending, collection.GetCollectionID())
+			}
+		}
+		if len(pending) == 0 {
+			log.Info("QueryCoord targets recovered")
+			s.readiness.Advance(componentutil.PhaseTargetsRecovered)
+			return
+		}
+
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-timeout.C:
+			log.Warn("QueryCoord targets recovery timeout, some collections are not serviceable yet",
+				zap.Int64s("collections", pending))
+			s.readiness.Advance(componentutil.PhaseTargetsRecovered)
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
 func (s *Server) startServerLoop() {
 	log.Info("start cluster...")
 	s.cluster.Start(s.ctx)
@@ -437,6 +529,8 @@ func (s *Server) startServerLoop() {
 	s.targetObserver.Start(s.ctx)
 	s.replicaObserver.Start(s.ctx)
 	s.resourceObserver.Start(s.ctx)
+	s.autoScaleObserver.Start(s.ctx)
+	s.divergenceObserver.Start(s.ctx)
 }
 
 func (s *Server) Stop() error {
@@ -486,6 +580,15 @@ func (s *Server) Stop() error {
 	if s.resourceObserver != nil {
 		s.resourceObserver.Stop()
 	}
+	if s.autoScaleObserver != nil {
+		s.autoScaleObserver.Stop()
+	}
+	if s.divergenceObserver != nil {
+		s.divergenceObserver.Stop()
+	}
+	if s.metaStoreMonitor != nil {
+		s.metaStoreMonitor.Stop()
+	}
 
 	s.wg.Wait()
 	log.Info("QueryCoord stop successfully")
@@ -510,6 +613,7 @@ func (s *Server) GetComponentStates(ctx context.Context) (*milvuspb.ComponentSta
 		// NodeID:    Params.QueryCoordID, // will race with QueryCoord.Register()
 		NodeID:    nodeID,
 		StateCode: s.State(),
+		ExtraInfo: []*commonpb.KeyValuePair{s.readiness.KeyValuePair()},
 	}
 
 	return &milvuspb.ComponentStates{
@@ -636,8 +740,11 @@ func (s *Server) watchNodes(revision int64) {
 				log.Info("add node to NodeManager",
 					zap.Int64("nodeID", nodeID),
 					zap.String("nodeAddr", addr),
+					zap.Any("labels", event.Session.ServerLabels),
 				)
-				s.nodeMgr.Add(session.NewNodeInfo(nodeID, addr))
+				nodeInfo := session.NewNodeInfo(nodeID, addr)
+				nodeInfo.UpdateStats(session.WithLabels(event.Session.ServerLabels))
+				s.nodeMgr.Add(nodeInfo)
 				s.nodeUpEventChan <- nodeID
 				select {
 				case s.notifyNodeUp <- struct{}{}:
@@ -39,6 +39,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/params"
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
 	"github.com/milvus-io/milvus/internal/querycoordv2/task"
+	"github.com/milvus-io/milvus/internal/util/componentutil"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
@@ -146,6 +147,11 @@ func (suite *ServerSuite) TestRecover() {
 	for _, collection := range suite.collections {
 		suite.assertLoaded(collection)
 	}
+	suite.Eventually(func() bool {
+		states, err := suite.server.GetComponentStates(context.Background())
+		phase, ok := componentutil.GetReadinessPhase(states)
+		return err == nil && ok && phase == componentutil.PhaseTargetsRecovered
+	}, 5*time.Second, 100*time.Millisecond)
 }
 
 func (suite *ServerSuite) TestRecoverFailed() {
@@ -505,6 +511,7 @@ func (suite *ServerSuite) hackServer() {
 		suite.server.nodeMgr,
 		suite.server.taskScheduler,
 		suite.server.broker,
+		suite.server.store,
 	)
 	suite.server.targetObserver = observers.NewTargetObserver(
 		suite.server.meta,
@@ -523,6 +530,7 @@ func (suite *ServerSuite) hackServer() {
 
 	suite.broker.EXPECT().GetCollectionSchema(mock.Anything, mock.Anything).Return(&schemapb.CollectionSchema{}, nil).Maybe()
 	suite.broker.EXPECT().DescribeIndex(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+	suite.broker.EXPECT().GetCollectionProperties(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
 	for _, collection := range suite.collections {
 		suite.broker.EXPECT().GetPartitions(mock.Anything, collection).Return(suite.partitions[collection], nil).Maybe()
 		suite.expectGetRecoverInfo(collection)
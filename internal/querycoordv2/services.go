@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/samber/lo"
@@ -33,6 +34,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/querycoordv2/job"
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/querycoordv2/observers"
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
@@ -208,6 +210,12 @@ func (s *Server) LoadCollection(ctx context.Context, req *querypb.LoadCollection
 		metrics.QueryCoordLoadCount.WithLabelValues(metrics.FailLabel).Inc()
 		return merr.Status(errors.Wrap(err, msg)), nil
 	}
+	if err := s.metaStoreMonitor.CheckWritable(); err != nil {
+		msg := "failed to load collection"
+		log.Warn(msg, zap.Error(err))
+		metrics.QueryCoordLoadCount.WithLabelValues(metrics.FailLabel).Inc()
+		return merr.Status(errors.Wrap(err, msg)), nil
+	}
 
 	// If refresh mode is ON.
 	if req.GetRefresh() {
@@ -262,6 +270,12 @@ func (s *Server) ReleaseCollection(ctx context.Context, req *querypb.ReleaseColl
 		metrics.QueryCoordReleaseCount.WithLabelValues(metrics.FailLabel).Inc()
 		return merr.Status(errors.Wrap(err, msg)), nil
 	}
+	if err := s.metaStoreMonitor.CheckWritable(); err != nil {
+		msg := "failed to release collection"
+		log.Warn(msg, zap.Error(err))
+		metrics.QueryCoordReleaseCount.WithLabelValues(metrics.FailLabel).Inc()
+		return merr.Status(errors.Wrap(err, msg)), nil
+	}
 
 	releaseJob := job.NewReleaseCollectionJob(ctx,
 		req,
@@ -308,6 +322,12 @@ func (s *Server) LoadPartitions(ctx context.Context, req *querypb.LoadPartitions
 		metrics.QueryCoordLoadCount.WithLabelValues(metrics.FailLabel).Inc()
 		return merr.Status(errors.Wrap(err, msg)), nil
 	}
+	if err := s.metaStoreMonitor.CheckWritable(); err != nil {
+		msg := "failed to load partitions"
+		log.Warn(msg, zap.Error(err))
+		metrics.QueryCoordLoadCount.WithLabelValues(metrics.FailLabel).Inc()
+		return merr.Status(errors.Wrap(err, msg)), nil
+	}
 
 	// If refresh mode is ON.
 	if req.GetRefresh() {
@@ -379,6 +399,12 @@ func (s *Server) ReleasePartitions(ctx context.Context, req *querypb.ReleasePart
 		metrics.QueryCoordReleaseCount.WithLabelValues(metrics.FailLabel).Inc()
 		return merr.Status(errors.Wrap(err, msg)), nil
 	}
+	if err := s.metaStoreMonitor.CheckWritable(); err != nil {
+		msg := "failed to release partitions"
+		log.Warn(msg, zap.Error(err))
+		metrics.QueryCoordReleaseCount.WithLabelValues(metrics.FailLabel).Inc()
+		return merr.Status(errors.Wrap(err, msg)), nil
+	}
 
 	if len(req.GetPartitionIDs()) == 0 {
 		err := merr.WrapErrParameterInvalid("any parttiion", "empty partition list")
@@ -657,6 +683,11 @@ func (s *Server) LoadBalance(ctx context.Context, req *querypb.LoadBalanceReques
 		log.Warn(msg, zap.Error(err))
 		return merr.Status(errors.Wrap(err, msg)), nil
 	}
+	if err := s.metaStoreMonitor.CheckWritable(); err != nil {
+		msg := "failed to load balance"
+		log.Warn(msg, zap.Error(err))
+		return merr.Status(errors.Wrap(err, msg)), nil
+	}
 
 	// Verify request
 	if len(req.GetSourceNodeIDs()) != 1 {
@@ -973,7 +1004,8 @@ func (s *Server) GetShardLeaders(ctx context.Context, req *querypb.GetShardLeade
 
 func (s *Server) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	if err := merr.CheckHealthy(s.State()); err != nil {
-		reason := errorutil.UnHealthReason("querycoord", paramtable.GetNodeID(), "querycoord is unhealthy")
+		reason := errorutil.UnHealthReason("querycoord", paramtable.GetNodeID(),
+			fmt.Sprintf("querycoord is unhealthy, readiness phase: %s", s.readiness.Phase().String()))
 		return &milvuspb.CheckHealthResponse{IsHealthy: false, Reasons: []string{reason}}, nil
 	}
 
@@ -1019,6 +1051,10 @@ func (s *Server) CreateResourceGroup(ctx context.Context, req *milvuspb.CreateRe
 		log.Warn("failed to create resource group", zap.Error(err))
 		return merr.Status(err), nil
 	}
+	if err := s.metaStoreMonitor.CheckWritable(); err != nil {
+		log.Warn("failed to create resource group", zap.Error(err))
+		return merr.Status(err), nil
+	}
 
 	err := s.meta.ResourceManager.AddResourceGroup(req.GetResourceGroup())
 	if err != nil {
@@ -1038,6 +1074,10 @@ func (s *Server) DropResourceGroup(ctx context.Context, req *milvuspb.DropResour
 		log.Warn("failed to drop resource group", zap.Error(err))
 		return merr.Status(err), nil
 	}
+	if err := s.metaStoreMonitor.CheckWritable(); err != nil {
+		log.Warn("failed to drop resource group", zap.Error(err))
+		return merr.Status(err), nil
+	}
 
 	replicas := s.meta.ReplicaManager.GetByResourceGroup(req.GetResourceGroup())
 	if len(replicas) > 0 {
@@ -1066,6 +1106,10 @@ func (s *Server) TransferNode(ctx context.Context, req *milvuspb.TransferNodeReq
 		log.Warn("failed to transfer node between resource group", zap.Error(err))
 		return merr.Status(err), nil
 	}
+	if err := s.metaStoreMonitor.CheckWritable(); err != nil {
+		log.Warn("failed to transfer node between resource group", zap.Error(err))
+		return merr.Status(err), nil
+	}
 
 	if ok := s.meta.ResourceManager.ContainResourceGroup(req.GetSourceResourceGroup()); !ok {
 		err := merr.WrapErrParameterInvalid("valid resource group", req.GetSourceResourceGroup(), "source resource group not found")
@@ -1122,6 +1166,10 @@ func (s *Server) TransferReplica(ctx context.Context, req *querypb.TransferRepli
 		log.Warn("failed to transfer replica between resource group", zap.Error(err))
 		return merr.Status(err), nil
 	}
+	if err := s.metaStoreMonitor.CheckWritable(); err != nil {
+		log.Warn("failed to transfer replica between resource group", zap.Error(err))
+		return merr.Status(err), nil
+	}
 
 	if ok := s.meta.ResourceManager.ContainResourceGroup(req.GetSourceResourceGroup()); !ok {
 		err := merr.WrapErrResourceGroupNotFound(req.GetSourceResourceGroup())
@@ -1262,3 +1310,328 @@ func (s *Server) DescribeResourceGroup(ctx context.Context, req *querypb.Describ
 	}
 	return resp, nil
 }
+
+// ExtendLoadTimeout extends the load timeout of a loading collection,
+// so that a huge collection loading slowly is not canceled.
+func (s *Server) ExtendLoadTimeout(ctx context.Context, req *querypb.ExtendLoadTimeoutRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64("extensionSeconds", req.GetExtensionSeconds()),
+	)
+
+	log.Info("extend load timeout request received")
+	failedMsg := "failed to extend load timeout"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(failedMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	if req.GetExtensionSeconds() <= 0 {
+		err := merr.WrapErrParameterInvalid("positive extension seconds", fmt.Sprint(req.GetExtensionSeconds()))
+		log.Warn(failedMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	collection := s.meta.CollectionManager.GetCollection(req.GetCollectionID())
+	if collection == nil || collection.GetStatus() != querypb.LoadStatus_Loading {
+		err := merr.WrapErrCollectionNotLoaded(req.GetCollectionID(), "collection is not loading")
+		log.Warn(failedMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	extension := s.collectionObserver.ExtendLoadTimeout(req.GetCollectionID(),
+		time.Duration(req.GetExtensionSeconds())*time.Second)
+	log.Info("load timeout extended", zap.Duration("totalExtension", extension))
+	return merr.Status(nil), nil
+}
+
+// ListCheckers lists the checkers and whether they are activated.
+func (s *Server) ListCheckers(ctx context.Context, req *querypb.ListCheckersRequest) (*querypb.ListCheckersResponse, error) {
+	log := log.Ctx(ctx).With(zap.Strings("checkers", req.GetCheckerNames()))
+
+	log.Info("list checkers request received")
+	failedMsg := "failed to list checkers"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(failedMsg, zap.Error(err))
+		return &querypb.ListCheckersResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	infos, err := s.checkerController.ListCheckers(req.GetCheckerNames()...)
+	if err != nil {
+		log.Warn(failedMsg, zap.Error(err))
+		return &querypb.ListCheckersResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	return &querypb.ListCheckersResponse{
+		Status:       merr.Status(nil),
+		CheckerInfos: infos,
+	}, nil
+}
+
+// ActivateChecker activates the checker, the activation is persisted in meta.
+func (s *Server) ActivateChecker(ctx context.Context, req *querypb.ActivateCheckerRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(zap.String("checker", req.GetCheckerName()))
+
+	log.Info("activate checker request received")
+	failedMsg := "failed to activate checker"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(failedMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	if err := s.checkerController.Activate(req.GetCheckerName()); err != nil {
+		log.Warn(failedMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+	return merr.Status(nil), nil
+}
+
+// DeactivateChecker deactivates the checker, which generates no tasks until activated again,
+// so the operators could freeze the balance during the maintenance. The deactivation is persisted in meta.
+func (s *Server) DeactivateChecker(ctx context.Context, req *querypb.DeactivateCheckerRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(zap.String("checker", req.GetCheckerName()))
+
+	log.Info("deactivate checker request received")
+	failedMsg := "failed to deactivate checker"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(failedMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	if err := s.checkerController.Deactivate(req.GetCheckerName()); err != nil {
+		log.Warn(failedMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+	return merr.Status(nil), nil
+}
+
+// DryRunCheckers runs the checkers without submitting the generated tasks,
+// and returns the tasks for the operators to preview the actions to converge the cluster.
+func (s *Server) DryRunCheckers(ctx context.Context, req *querypb.DryRunCheckersRequest) (*querypb.DryRunCheckersResponse, error) {
+	log := log.Ctx(ctx).With(zap.Strings("checkers", req.GetCheckerNames()))
+
+	log.Info("dry run checkers request received")
+	failedMsg := "failed to dry run checkers"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(failedMsg, zap.Error(err))
+		return &querypb.DryRunCheckersResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	tasks, err := s.checkerController.DryRun(ctx, req.GetCheckerNames()...)
+	if err != nil {
+		log.Warn(failedMsg, zap.Error(err))
+		return &querypb.DryRunCheckersResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	log.Info("dry run checkers done", zap.Int("taskNum", len(tasks)))
+	return &querypb.DryRunCheckersResponse{
+		Status: merr.Status(nil),
+		Tasks:  tasks,
+	}, nil
+}
+
+// TriggerBalance runs the balance at once for the given collection and node, all the loaded collections
+// and all the nodes if not specified, submits the generated tasks and returns them with the task IDs.
+func (s *Server) TriggerBalance(ctx context.Context, req *querypb.TriggerBalanceRequest) (*querypb.TriggerBalanceResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64("nodeID", req.GetNodeID()),
+	)
+
+	log.Info("trigger balance request received")
+	failedMsg := "failed to trigger balance"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(failedMsg, zap.Error(err))
+		return &querypb.TriggerBalanceResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	if req.GetCollectionID() != 0 && s.meta.CollectionManager.GetCollection(req.GetCollectionID()) == nil {
+		err := merr.WrapErrCollectionNotLoaded(req.GetCollectionID())
+		log.Warn(failedMsg, zap.Error(err))
+		return &querypb.TriggerBalanceResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	if req.GetNodeID() != 0 && s.nodeMgr.Get(req.GetNodeID()) == nil {
+		err := merr.WrapErrNodeNotFound(req.GetNodeID())
+		log.Warn(failedMsg, zap.Error(err))
+		return &querypb.TriggerBalanceResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	tasks, err := s.checkerController.TriggerBalance(ctx, req.GetCollectionID(), req.GetNodeID())
+	if err != nil {
+		log.Warn(failedMsg, zap.Error(err))
+		return &querypb.TriggerBalanceResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	log.Info("balance triggered", zap.Int("taskNum", len(tasks)))
+	return &querypb.TriggerBalanceResponse{
+		Status: merr.Status(nil),
+		Tasks:  tasks,
+	}, nil
+}
+
+// SuspendCollectionBalance suspends or resumes the balance of the collection, the suspension is persisted in meta.
+// The segments and channels of the suspended collection are not moved by the balance except draining the stopping nodes,
+// so the operators could pin the placement of a latency-critical collection while still balancing others.
+func (s *Server) SuspendCollectionBalance(ctx context.Context, req *querypb.SuspendCollectionBalanceRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Bool("suspend", req.GetSuspend()),
+	)
+
+	log.Info("suspend collection balance request received")
+	failedMsg := "failed to suspend collection balance"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(failedMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	if s.meta.CollectionManager.GetCollection(req.GetCollectionID()) == nil {
+		err := merr.WrapErrCollectionNotLoaded(req.GetCollectionID())
+		log.Warn(failedMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+	if err := s.meta.CollectionManager.SetBalanceSuspended(req.GetCollectionID(), req.GetSuspend()); err != nil {
+		log.Warn(failedMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+	log.Info("collection balance suspension updated")
+	return merr.Status(nil), nil
+}
+
+// WatchLoadProgress streams the load progress events of the collection or the partitions periodically,
+// with the segments and channels loaded per node, instead of polling the coarse loading progress.
+// The stream ends once the load is done, or the collection is released.
+func (s *Server) WatchLoadProgress(req *querypb.WatchLoadProgressRequest, stream querypb.QueryCoord_WatchLoadProgressServer) error {
+	ctx := stream.Context()
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64s("partitionIDs", req.GetPartitionIDs()),
+	)
+
+	log.Info("watch load progress request received")
+	failedMsg := "failed to watch load progress"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(failedMsg, zap.Error(err))
+		return stream.Send(&querypb.LoadProgressEvent{
+			Status:       merr.Status(err),
+			CollectionID: req.GetCollectionID(),
+		})
+	}
+
+	interval := time.Duration(req.GetIntervalMs()) * time.Millisecond
+	if interval <= 0 {
+		interval = time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		event, err := s.getLoadProgressEvent(req.GetCollectionID(), req.GetPartitionIDs())
+		if err != nil {
+			log.Warn(failedMsg, zap.Error(err))
+			return stream.Send(&querypb.LoadProgressEvent{
+				Status:       merr.Status(err),
+				CollectionID: req.GetCollectionID(),
+			})
+		}
+		if err := stream.Send(event); err != nil {
+			log.Warn(failedMsg, zap.Error(err))
+			return err
+		}
+		if event.GetProgress() >= 100 {
+			log.Info("load done, watch load progress finished")
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Info("watch load progress canceled", zap.Error(ctx.Err()))
+			return ctx.Err()
+		case <-s.ctx.Done():
+			return s.ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// ListDivergedLeaders lists the shard leaders whose views have been out of sync
+// with the distribution or current target longer than queryCoord.leaderDivergence.threshold.
+func (s *Server) ListDivergedLeaders(ctx context.Context, req *querypb.ListDivergedLeadersRequest) (*querypb.ListDivergedLeadersResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+	)
+
+	log.Debug("list diverged leaders request received")
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn("failed to list diverged leaders", zap.Error(err))
+		return &querypb.ListDivergedLeadersResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	leaders := s.divergenceObserver.GetDivergedLeaders(req.GetCollectionID())
+	infos := lo.Map(leaders, func(leader observers.DivergedLeader, _ int) *querypb.DivergedLeaderInfo {
+		return &querypb.DivergedLeaderInfo{
+			CollectionID:  leader.CollectionID,
+			ReplicaID:     leader.ReplicaID,
+			Channel:       leader.Channel,
+			LeaderID:      leader.LeaderID,
+			Reason:        leader.Reason,
+			DivergedSince: leader.Since.UnixMilli(),
+		}
+	})
+	return &querypb.ListDivergedLeadersResponse{
+		Status:  merr.Status(nil),
+		Leaders: infos,
+	}, nil
+}
+
+// NotifyCompaction is called by DataCoord after a compaction is committed,
+// it refreshes the next target of the collection at once instead of waiting for the periodical update,
+// so the compacted segment gets loaded before the current target is updated and the old segments are released.
+func (s *Server) NotifyCompaction(ctx context.Context, req *querypb.NotifyCompactionRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64("partitionID", req.GetPartitionID()),
+		zap.String("channel", req.GetChannel()),
+		zap.Int64s("compactedFrom", req.GetCompactedFrom()),
+		zap.Int64("compactedTo", req.GetCompactedTo()),
+	)
+
+	log.Info("notify compaction request received")
+	failedMsg := "failed to handle compaction notification"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(failedMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	if s.meta.CollectionManager.GetPartition(req.GetPartitionID()) == nil {
+		log.Info("partition not loaded, skip the compaction notification")
+		return merr.Status(nil), nil
+	}
+	if s.targetMgr.GetHistoricalSegment(req.GetCollectionID(), req.GetCompactedTo(), meta.NextTarget) != nil {
+		log.Info("compacted segment already in next target, skip the compaction notification")
+		return merr.Status(nil), nil
+	}
+
+	changedSegments := append([]int64{req.GetCompactedTo()}, req.GetCompactedFrom()...)
+	s.targetMgr.NotifySegmentsChanged(req.GetCollectionID(), changedSegments...)
+	if _, err := s.targetObserver.UpdateNextTarget(req.GetCollectionID()); err != nil {
+		log.Warn(failedMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+	log.Info("next target updated for the compacted segment")
+	return merr.Status(nil), nil
+}
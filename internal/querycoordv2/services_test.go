@@ -26,6 +26,7 @@ import (
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
@@ -46,6 +47,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
 	"github.com/milvus-io/milvus/internal/querycoordv2/task"
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/internal/util/metastoreutil"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
@@ -194,6 +196,11 @@ func (suite *ServiceSuite) SetupTest() {
 		suite.server.leaderObserver,
 		&checkers.CheckerController{},
 	)
+	suite.server.divergenceObserver = observers.NewLeaderDivergenceObserver(
+		suite.server.meta,
+		suite.server.dist,
+		suite.server.targetMgr,
+	)
 
 	suite.server.UpdateStateCode(commonpb.StateCode_Healthy)
 }
@@ -1460,6 +1467,407 @@ func (suite *ServiceSuite) TestCheckHealth() {
 	suite.Empty(resp.Reasons)
 }
 
+func (suite *ServiceSuite) TestReadOnlyOnMetaStoreDegraded() {
+	ctx := context.Background()
+	server := suite.server
+
+	paramtable.Get().Save(paramtable.Get().EtcdCfg.DegradationCheckInterval.Key, "10")
+	paramtable.Get().Save(paramtable.Get().EtcdCfg.DegradationFailureThreshold.Key, "1")
+	defer paramtable.Get().Reset(paramtable.Get().EtcdCfg.DegradationCheckInterval.Key)
+	defer paramtable.Get().Reset(paramtable.Get().EtcdCfg.DegradationFailureThreshold.Key)
+
+	server.metaStoreMonitor = metastoreutil.NewHealthMonitorWithProbe(typeutil.QueryCoordRole, func(ctx context.Context) error {
+		return errors.New("etcdserver: no leader")
+	})
+	server.metaStoreMonitor.Start()
+	defer server.metaStoreMonitor.Stop()
+	suite.Eventually(server.metaStoreMonitor.IsReadOnly, time.Second, 10*time.Millisecond)
+
+	// operations changing the meta are rejected
+	status, err := server.LoadCollection(ctx, &querypb.LoadCollectionRequest{
+		CollectionID: suite.collections[0],
+	})
+	suite.NoError(err)
+	suite.Equal(merr.Code(merr.ErrServiceReadOnly), status.GetCode())
+
+	status, err = server.CreateResourceGroup(ctx, &milvuspb.CreateResourceGroupRequest{
+		ResourceGroup: "rg",
+	})
+	suite.NoError(err)
+	suite.Equal(merr.Code(merr.ErrServiceReadOnly), status.GetCode())
+
+	// reads are still served
+	resp, err := server.ShowCollections(ctx, &querypb.ShowCollectionsRequest{})
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+}
+
+func (suite *ServiceSuite) TestExtendLoadTimeout() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+	collection := suite.collections[0]
+
+	// Test for collection not loading
+	suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
+	req := &querypb.ExtendLoadTimeoutRequest{
+		CollectionID:     collection,
+		ExtensionSeconds: 60,
+	}
+	resp, err := server.ExtendLoadTimeout(ctx, req)
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrCollectionNotLoaded)
+
+	// Test for invalid extension
+	suite.updateCollectionStatus(collection, querypb.LoadStatus_Loading)
+	resp, err = server.ExtendLoadTimeout(ctx, &querypb.ExtendLoadTimeoutRequest{CollectionID: collection})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrParameterInvalid)
+
+	resp, err = server.ExtendLoadTimeout(ctx, req)
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, resp.GetErrorCode())
+	resp, err = server.ExtendLoadTimeout(ctx, req)
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, resp.GetErrorCode())
+	suite.Equal(2*time.Minute, server.collectionObserver.ExtendLoadTimeout(collection, 0))
+
+	// Test for server is not healthy
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	resp, err = server.ExtendLoadTimeout(ctx, req)
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrServiceNotReady)
+}
+
+func (suite *ServiceSuite) TestCheckers() {
+	ctx := context.Background()
+	server := suite.server
+	server.checkerController = checkers.NewCheckerController(suite.meta, suite.dist, suite.targetMgr,
+		suite.balancer, suite.nodeMgr, suite.taskScheduler, suite.broker, suite.store)
+
+	resp, err := server.ListCheckers(ctx, &querypb.ListCheckersRequest{})
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	suite.Len(resp.GetCheckerInfos(), 4)
+	for _, info := range resp.GetCheckerInfos() {
+		suite.True(info.GetActivated())
+	}
+
+	status, err := server.DeactivateChecker(ctx, &querypb.DeactivateCheckerRequest{CheckerName: checkers.Balance_Checker})
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, status.GetErrorCode())
+	resp, err = server.ListCheckers(ctx, &querypb.ListCheckersRequest{CheckerNames: []string{checkers.Balance_Checker}})
+	suite.NoError(err)
+	suite.Len(resp.GetCheckerInfos(), 1)
+	suite.False(resp.GetCheckerInfos()[0].GetActivated())
+
+	// the deactivation is persisted
+	controller := checkers.NewCheckerController(suite.meta, suite.dist, suite.targetMgr,
+		suite.balancer, suite.nodeMgr, suite.taskScheduler, suite.broker, suite.store)
+	suite.NoError(controller.Recover())
+	infos, err := controller.ListCheckers(checkers.Balance_Checker)
+	suite.NoError(err)
+	suite.False(infos[0].GetActivated())
+
+	status, err = server.ActivateChecker(ctx, &querypb.ActivateCheckerRequest{CheckerName: checkers.Balance_Checker})
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, status.GetErrorCode())
+	resp, err = server.ListCheckers(ctx, &querypb.ListCheckersRequest{CheckerNames: []string{checkers.Balance_Checker}})
+	suite.NoError(err)
+	suite.True(resp.GetCheckerInfos()[0].GetActivated())
+
+	// Test for unknown checker
+	status, err = server.DeactivateChecker(ctx, &querypb.DeactivateCheckerRequest{CheckerName: "unknown"})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(status), merr.ErrParameterInvalid)
+	resp, err = server.ListCheckers(ctx, &querypb.ListCheckersRequest{CheckerNames: []string{"unknown"}})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrParameterInvalid)
+
+	// Test for server is not healthy
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	resp, err = server.ListCheckers(ctx, &querypb.ListCheckersRequest{})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrServiceNotReady)
+	status, err = server.ActivateChecker(ctx, &querypb.ActivateCheckerRequest{CheckerName: checkers.Balance_Checker})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(status), merr.ErrServiceNotReady)
+	status, err = server.DeactivateChecker(ctx, &querypb.DeactivateCheckerRequest{CheckerName: checkers.Balance_Checker})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(status), merr.ErrServiceNotReady)
+}
+
+func (suite *ServiceSuite) TestDryRunCheckers() {
+	ctx := context.Background()
+	server := suite.server
+	server.checkerController = checkers.NewCheckerController(suite.meta, suite.dist, suite.targetMgr,
+		suite.balancer, suite.nodeMgr, suite.taskScheduler, suite.broker, suite.store)
+
+	// nothing loaded
+	resp, err := server.DryRunCheckers(ctx, &querypb.DryRunCheckersRequest{
+		CheckerNames: []string{checkers.Channel_Checker, checkers.Segment_Checker},
+	})
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	suite.Empty(resp.GetTasks())
+
+	// Test for unknown checker
+	resp, err = server.DryRunCheckers(ctx, &querypb.DryRunCheckersRequest{CheckerNames: []string{"unknown"}})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrParameterInvalid)
+
+	// Test for server is not healthy
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	resp, err = server.DryRunCheckers(ctx, &querypb.DryRunCheckersRequest{})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrServiceNotReady)
+}
+
+func (suite *ServiceSuite) TestTriggerBalance() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+	collection := suite.collections[0]
+	suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
+	replica := suite.meta.ReplicaManager.GetByCollection(collection)[0]
+	nodes := replica.GetNodes()
+
+	balancer := balance.NewMockBalancer(suite.T())
+	balancer.EXPECT().BalanceReplica(mock.Anything).Return([]balance.SegmentAssignPlan{
+		{
+			Segment:   &meta.Segment{SegmentInfo: &datapb.SegmentInfo{ID: 1, CollectionID: collection, InsertChannel: "1000-dmc0"}},
+			ReplicaID: replica.GetID(),
+			From:      nodes[0],
+			To:        nodes[1],
+		},
+		{
+			Segment:   &meta.Segment{SegmentInfo: &datapb.SegmentInfo{ID: 2, CollectionID: collection, InsertChannel: "1000-dmc1"}},
+			ReplicaID: replica.GetID(),
+			From:      nodes[1],
+			To:        nodes[2],
+		},
+	}, nil)
+	server.checkerController = checkers.NewCheckerController(suite.meta, suite.dist, suite.targetMgr,
+		balancer, suite.nodeMgr, suite.taskScheduler, suite.broker, suite.store)
+
+	// only the plans moving from or to the node are submitted
+	suite.taskScheduler.EXPECT().Add(mock.Anything).Run(func(t task.Task) {
+		t.SetID(100)
+		t.Cancel(nil)
+	}).Return(nil).Once()
+	resp, err := server.TriggerBalance(ctx, &querypb.TriggerBalanceRequest{
+		CollectionID: collection,
+		NodeID:       nodes[0],
+	})
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	suite.Len(resp.GetTasks(), 1)
+	suite.EqualValues(100, resp.GetTasks()[0].GetTaskID())
+	suite.EqualValues(1, resp.GetTasks()[0].GetSegmentID())
+	suite.Equal(checkers.Balance_Checker, resp.GetTasks()[0].GetCheckerName())
+
+	// Test for collection not loaded
+	resp, err = server.TriggerBalance(ctx, &querypb.TriggerBalanceRequest{CollectionID: 999})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrCollectionNotLoaded)
+
+	// Test for node not found
+	resp, err = server.TriggerBalance(ctx, &querypb.TriggerBalanceRequest{NodeID: 999})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrNodeNotFound)
+
+	// Test for server is not healthy
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	resp, err = server.TriggerBalance(ctx, &querypb.TriggerBalanceRequest{})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrServiceNotReady)
+}
+
+func (suite *ServiceSuite) TestSuspendCollectionBalance() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+	collection := suite.collections[0]
+
+	status, err := server.SuspendCollectionBalance(ctx, &querypb.SuspendCollectionBalanceRequest{
+		CollectionID: collection,
+		Suspend:      true,
+	})
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, status.GetErrorCode())
+	suite.True(suite.meta.IsBalanceSuspended(collection))
+
+	status, err = server.SuspendCollectionBalance(ctx, &querypb.SuspendCollectionBalanceRequest{
+		CollectionID: collection,
+		Suspend:      false,
+	})
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, status.GetErrorCode())
+	suite.False(suite.meta.IsBalanceSuspended(collection))
+
+	// Test for collection not loaded
+	status, err = server.SuspendCollectionBalance(ctx, &querypb.SuspendCollectionBalanceRequest{CollectionID: 999, Suspend: true})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(status), merr.ErrCollectionNotLoaded)
+
+	// Test for server is not healthy
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	status, err = server.SuspendCollectionBalance(ctx, &querypb.SuspendCollectionBalanceRequest{CollectionID: collection})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(status), merr.ErrServiceNotReady)
+}
+
+func (suite *ServiceSuite) TestListDivergedLeaders() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	resp, err := server.ListDivergedLeaders(ctx, &querypb.ListDivergedLeadersRequest{})
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	suite.Empty(resp.GetLeaders())
+
+	resp, err = server.ListDivergedLeaders(ctx, &querypb.ListDivergedLeadersRequest{CollectionID: suite.collections[0]})
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	suite.Empty(resp.GetLeaders())
+
+	// Test for server is not healthy
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	resp, err = server.ListDivergedLeaders(ctx, &querypb.ListDivergedLeadersRequest{})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrServiceNotReady)
+}
+
+type mockLoadProgressStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events []*querypb.LoadProgressEvent
+}
+
+func (s *mockLoadProgressStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *mockLoadProgressStream) Send(event *querypb.LoadProgressEvent) error {
+	s.events = append(s.events, event)
+	return nil
+}
+
+func (suite *ServiceSuite) TestWatchLoadProgress() {
+	suite.loadAll()
+	server := suite.server
+	collection := suite.collections[0]
+	suite.updateCollectionStatus(collection, querypb.LoadStatus_Loading)
+	suite.updateChannelDist(collection)
+	req := &querypb.WatchLoadProgressRequest{
+		CollectionID: collection,
+		IntervalMs:   10,
+	}
+
+	// the stream keeps sending events until canceled
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	stream := &mockLoadProgressStream{ctx: ctx}
+	err := server.WatchLoadProgress(req, stream)
+	suite.ErrorIs(err, context.DeadlineExceeded)
+	suite.Greater(len(stream.events), 1)
+	event := stream.events[0]
+	suite.Equal(commonpb.ErrorCode_Success, event.GetStatus().GetErrorCode())
+	suite.EqualValues(0, event.GetProgress())
+	suite.EqualValues(4, event.GetTotalSegments())
+	suite.EqualValues(4, event.GetLoadedSegments())
+	suite.EqualValues(2, event.GetTotalChannels())
+	suite.EqualValues(2, event.GetLoadedChannels())
+	suite.Len(event.GetNodes(), 2)
+	for _, node := range event.GetNodes() {
+		suite.EqualValues(1, node.GetLoadedChannels())
+	}
+
+	// the stream ends once loaded
+	suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
+	stream = &mockLoadProgressStream{ctx: context.Background()}
+	err = server.WatchLoadProgress(req, stream)
+	suite.NoError(err)
+	suite.Len(stream.events, 1)
+	suite.EqualValues(100, stream.events[0].GetProgress())
+
+	// Test for partition not loaded
+	stream = &mockLoadProgressStream{ctx: context.Background()}
+	err = server.WatchLoadProgress(&querypb.WatchLoadProgressRequest{
+		CollectionID: collection,
+		PartitionIDs: []int64{999},
+	}, stream)
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(stream.events[0].GetStatus()), merr.ErrPartitionNotLoaded)
+
+	// Test for collection not loaded
+	stream = &mockLoadProgressStream{ctx: context.Background()}
+	err = server.WatchLoadProgress(&querypb.WatchLoadProgressRequest{CollectionID: 999}, stream)
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(stream.events[0].GetStatus()), merr.ErrCollectionNotLoaded)
+
+	// Test for server is not healthy
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	stream = &mockLoadProgressStream{ctx: context.Background()}
+	err = server.WatchLoadProgress(req, stream)
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(stream.events[0].GetStatus()), merr.ErrServiceNotReady)
+}
+
+func (suite *ServiceSuite) TestNotifyCompaction() {
+	ctx := context.Background()
+	server := suite.server
+	collection := suite.collections[0]
+	req := &querypb.NotifyCompactionRequest{
+		CollectionID:  collection,
+		PartitionID:   suite.partitions[collection][0],
+		Channel:       suite.channels[collection][0],
+		CompactedFrom: suite.segments[collection][suite.partitions[collection][0]],
+		CompactedTo:   100,
+	}
+
+	// Test for partition not loaded
+	status, err := server.NotifyCompaction(ctx, req)
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, status.GetErrorCode())
+	suite.Nil(suite.targetMgr.GetHistoricalSegment(collection, req.GetCompactedTo(), meta.NextTarget))
+
+	suite.loadAll()
+	suite.broker.ExpectedCalls = nil
+	suite.broker.EXPECT().GetPartitions(mock.Anything, collection).Return(suite.partitions[collection], nil).Maybe()
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, collection, mock.Anything, mock.Anything).
+		Return([]*datapb.VchannelInfo{
+			{CollectionID: collection, ChannelName: suite.channels[collection][0]},
+			{CollectionID: collection, ChannelName: suite.channels[collection][1]},
+		}, []*datapb.SegmentInfo{
+			{
+				ID:             req.GetCompactedTo(),
+				InsertChannel:  req.GetChannel(),
+				PartitionID:    req.GetPartitionID(),
+				CollectionID:   collection,
+				CompactionFrom: req.GetCompactedFrom(),
+			},
+		}, nil)
+
+	// Test for the next target updated with the compacted segment
+	status, err = server.NotifyCompaction(ctx, req)
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, status.GetErrorCode())
+	suite.NotNil(suite.targetMgr.GetHistoricalSegment(collection, req.GetCompactedTo(), meta.NextTarget))
+
+	// Test for the compacted segment already in next target
+	status, err = server.NotifyCompaction(ctx, req)
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, status.GetErrorCode())
+
+	// Test for server is not healthy
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	status, err = server.NotifyCompaction(ctx, req)
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(status), merr.ErrServiceNotReady)
+}
+
 func (suite *ServiceSuite) TestGetShardLeaders() {
 	suite.loadAll()
 	ctx := context.Background()
@@ -1732,6 +2140,8 @@ func (suite *ServiceSuite) expectGetRecoverInfo(collection int64) {
 func (suite *ServiceSuite) expectLoadPartitions() {
 	suite.broker.EXPECT().GetCollectionSchema(mock.Anything, mock.Anything).
 		Return(nil, nil)
+	suite.broker.EXPECT().GetCollectionProperties(mock.Anything, mock.Anything).
+		Return(nil, nil).Maybe()
 	suite.broker.EXPECT().DescribeIndex(mock.Anything, mock.Anything).
 		Return(nil, nil)
 	suite.cluster.EXPECT().LoadPartitions(mock.Anything, mock.Anything, mock.Anything).
@@ -31,6 +31,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/pkg/log"
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 )
 
@@ -70,6 +71,11 @@ type QueryCluster struct {
 	wg          sync.WaitGroup
 	ch          chan struct{}
 	stopOnce    sync.Once
+
+	// term and fencingSeq make up the fencing tokens of the channel requests,
+	// the fencing is disabled if the term is not set
+	term       int64
+	fencingSeq atomic.Int64
 }
 
 type QueryNodeCreator func(ctx context.Context, addr string) (types.QueryNode, error)
@@ -87,6 +93,22 @@ func NewCluster(nodeManager *NodeManager, queryNodeCreator QueryNodeCreator) *Qu
 	return c
 }
 
+// SetTerm sets the term of the fencing tokens, which must increase across the QueryCoord failovers,
+// must be called before sending any request.
+func (c *QueryCluster) SetTerm(term int64) {
+	c.term = term
+}
+
+func (c *QueryCluster) nextFencingToken() *querypb.FencingToken {
+	if c.term == 0 {
+		return nil
+	}
+	return &querypb.FencingToken{
+		Term: c.term,
+		Seq:  c.fencingSeq.Inc(),
+	}
+}
+
 func (c *QueryCluster) Start(ctx context.Context) {
 	c.wg.Add(1)
 	go c.updateLoop()
@@ -140,6 +162,7 @@ func (c *QueryCluster) WatchDmChannels(ctx context.Context, nodeID int64, req *q
 	err1 := c.send(ctx, nodeID, func(cli types.QueryNode) {
 		req := proto.Clone(req).(*querypb.WatchDmChannelsRequest)
 		req.Base.TargetID = nodeID
+		req.FencingToken = c.nextFencingToken()
 		status, err = cli.WatchDmChannels(ctx, req)
 	})
 	if err1 != nil {
@@ -154,6 +177,7 @@ func (c *QueryCluster) UnsubDmChannel(ctx context.Context, nodeID int64, req *qu
 	err1 := c.send(ctx, nodeID, func(cli types.QueryNode) {
 		req := proto.Clone(req).(*querypb.UnsubDmChannelRequest)
 		req.Base.TargetID = nodeID
+		req.FencingToken = c.nextFencingToken()
 		status, err = cli.UnsubDmChannel(ctx, req)
 	})
 	if err1 != nil {
@@ -242,6 +266,7 @@ func (c *QueryCluster) SyncDistribution(ctx context.Context, nodeID int64, req *
 	err1 := c.send(ctx, nodeID, func(cli types.QueryNode) {
 		req := proto.Clone(req).(*querypb.SyncDistributionRequest)
 		req.Base.TargetID = nodeID
+		req.FencingToken = c.nextFencingToken()
 		resp, err = cli.SyncDistribution(ctx, req)
 	})
 	if err1 != nil {
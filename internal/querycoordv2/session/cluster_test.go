@@ -258,6 +258,19 @@ func (suite *ClusterTestSuite) TestWatchDmChannels() {
 	}, status)
 }
 
+func (suite *ClusterTestSuite) TestFencingToken() {
+	cluster := NewCluster(suite.nodeManager, DefaultQueryNodeCreator)
+	defer cluster.Stop()
+	suite.Nil(cluster.nextFencingToken())
+
+	cluster.SetTerm(100)
+	first := cluster.nextFencingToken()
+	second := cluster.nextFencingToken()
+	suite.EqualValues(100, first.GetTerm())
+	suite.EqualValues(100, second.GetTerm())
+	suite.Greater(second.GetSeq(), first.GetSeq())
+}
+
 func (suite *ClusterTestSuite) TestUnsubDmChannel() {
 	ctx := context.TODO()
 	status, err := suite.cluster.UnsubDmChannel(ctx, 0, &querypb.UnsubDmChannelRequest{
@@ -106,6 +106,7 @@ type NodeInfo struct {
 	id            int64
 	addr          string
 	state         State
+	labels        map[string]string
 	lastHeartbeat *atomic.Int64
 }
 
@@ -129,6 +130,68 @@ func (n *NodeInfo) ChannelCnt() int {
 	return n.stats.getChannelCnt()
 }
 
+// DiskCapacity returns the local disk size could be used for segments,
+// 0 if the node doesn't report it.
+func (n *NodeInfo) DiskCapacity() int64 {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.stats.getDiskCapacity()
+}
+
+// DiskUsage returns the local disk size used by the loaded segments and reserved by the loading ones.
+func (n *NodeInfo) DiskUsage() int64 {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.stats.getDiskUsage()
+}
+
+// CPUUsage returns the cpu usage of the node in percentage, 0 if the node doesn't report it.
+func (n *NodeInfo) CPUUsage() float64 {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.stats.getCPUUsage()
+}
+
+// MemoryUsage returns the ratio of the used memory of the node, 0 if the node doesn't report it.
+func (n *NodeInfo) MemoryUsage() float64 {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.stats.getMemoryUsage()
+}
+
+// SearchNQRate returns the searched nq per second of the node.
+func (n *NodeInfo) SearchNQRate() float64 {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.stats.getSearchNQRate()
+}
+
+// SearchLatency returns the average time in milliseconds the search requests wait in the queue of the node.
+func (n *NodeInfo) SearchLatency() float64 {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.stats.getSearchLatency()
+}
+
+// Labels returns the labels the node registered with, such as disk type or zone.
+func (n *NodeInfo) Labels() map[string]string {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.labels
+}
+
+// MatchLabels returns whether the node has all the given labels of the same values.
+func (n *NodeInfo) MatchLabels(labels map[string]string) bool {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	for key, value := range labels {
+		if v, ok := n.labels[key]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
+
 func (n *NodeInfo) SetLastHeartbeat(time time.Time) {
 	n.lastHeartbeat.Store(time.UnixNano())
 }
@@ -179,3 +242,27 @@ func WithChannelCnt(cnt int) StatsOption {
 		n.setChannelCnt(cnt)
 	}
 }
+
+func WithDiskUsage(capacity int64, usage int64) StatsOption {
+	return func(n *NodeInfo) {
+		n.setDiskUsage(capacity, usage)
+	}
+}
+
+func WithRuntimeLoad(cpuUsage float64, memoryUsed, memoryTotal int64, searchNQRate float64) StatsOption {
+	return func(n *NodeInfo) {
+		n.setRuntimeLoad(cpuUsage, memoryUsed, memoryTotal, searchNQRate)
+	}
+}
+
+func WithSearchLatency(latency float64) StatsOption {
+	return func(n *NodeInfo) {
+		n.setSearchLatency(latency)
+	}
+}
+
+func WithLabels(labels map[string]string) StatsOption {
+	return func(n *NodeInfo) {
+		n.labels = labels
+	}
+}
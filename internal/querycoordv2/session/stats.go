@@ -17,8 +17,16 @@
 package session
 
 type stats struct {
-	segmentCnt int
-	channelCnt int
+	segmentCnt   int
+	channelCnt   int
+	diskCapacity int64
+	diskUsage    int64
+	cpuUsage     float64
+	memoryUsed   int64
+	memoryTotal  int64
+	searchNQRate float64
+
+	searchLatency float64
 }
 
 func (s *stats) setSegmentCnt(cnt int) {
@@ -37,6 +45,49 @@ func (s *stats) getChannelCnt() int {
 	return s.channelCnt
 }
 
+func (s *stats) setDiskUsage(capacity int64, usage int64) {
+	s.diskCapacity = capacity
+	s.diskUsage = usage
+}
+
+func (s *stats) getDiskCapacity() int64 {
+	return s.diskCapacity
+}
+
+func (s *stats) getDiskUsage() int64 {
+	return s.diskUsage
+}
+
+func (s *stats) setRuntimeLoad(cpuUsage float64, memoryUsed, memoryTotal int64, searchNQRate float64) {
+	s.cpuUsage = cpuUsage
+	s.memoryUsed = memoryUsed
+	s.memoryTotal = memoryTotal
+	s.searchNQRate = searchNQRate
+}
+
+func (s *stats) getCPUUsage() float64 {
+	return s.cpuUsage
+}
+
+func (s *stats) getMemoryUsage() float64 {
+	if s.memoryTotal <= 0 {
+		return 0
+	}
+	return float64(s.memoryUsed) / float64(s.memoryTotal)
+}
+
+func (s *stats) getSearchNQRate() float64 {
+	return s.searchNQRate
+}
+
+func (s *stats) setSearchLatency(latency float64) {
+	s.searchLatency = latency
+}
+
+func (s *stats) getSearchLatency() float64 {
+	return s.searchLatency
+}
+
 func newStats() stats {
 	return stats{}
 }
@@ -30,6 +30,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/querycoordv2/params"
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
 	"github.com/milvus-io/milvus/pkg/log"
@@ -157,6 +158,8 @@ type taskScheduler struct {
 	channelTasks map[replicaChannelIndex]Task
 	processQueue *taskQueue
 	waitQueue    *taskQueue
+	// the time since when the segment loading tasks are delayed by the load watermarks
+	delayedTasks map[int64]time.Time
 }
 
 func NewScheduler(ctx context.Context,
@@ -187,6 +190,7 @@ func NewScheduler(ctx context.Context,
 		channelTasks: make(map[replicaChannelIndex]Task),
 		processQueue: newTaskQueue(),
 		waitQueue:    newTaskQueue(),
+		delayedTasks: make(map[int64]time.Time),
 	}
 }
 
@@ -244,6 +248,12 @@ func (scheduler *taskScheduler) Add(task Task) error {
 		return err
 	}
 
+	// the tasks of the loading collections are for the load triggered by users
+	if task.Class() != TaskClassBalance &&
+		scheduler.meta.CollectionManager.CalculateLoadStatus(task.CollectionID()) == querypb.LoadStatus_Loading {
+		task.SetClass(TaskClassLoad)
+	}
+
 	task.SetID(scheduler.idAllocator())
 	scheduler.waitQueue.Add(task)
 	scheduler.tasks.Insert(task.ID())
@@ -359,25 +369,43 @@ func (scheduler *taskScheduler) preAdd(task Task) error {
 }
 
 func (scheduler *taskScheduler) tryPromoteAll() {
-	// Promote waiting tasks
-	toPromote := make([]Task, 0, scheduler.waitQueue.Len())
-	toRemove := make([]Task, 0)
+	startedNum := make(map[Class]int, len(TaskClasses))
+	scheduler.processQueue.Range(func(task Task) bool {
+		startedNum[task.Class()]++
+		return true
+	})
+	waiting := make(map[Class][]Task, len(TaskClasses))
 	scheduler.waitQueue.Range(func(task Task) bool {
-		err := scheduler.promote(task)
-		if err != nil {
-			task.Cancel(err)
-			toRemove = append(toRemove, task)
-			log.Warn("failed to promote task",
-				zap.Int64("taskID", task.ID()),
-				zap.Error(err),
-			)
-		} else {
-			toPromote = append(toPromote, task)
-		}
-
+		waiting[task.Class()] = append(waiting[task.Class()], task)
 		return true
 	})
 
+	// Promote waiting tasks class by class from high to low,
+	// the tasks exceeding the cap of its class keep waiting
+	toPromote := make([]Task, 0, scheduler.waitQueue.Len())
+	toRemove := make([]Task, 0)
+	for i := len(TaskClasses) - 1; i >= 0; i-- {
+		class := TaskClasses[i]
+		taskCap := getTaskCap(class)
+		for _, task := range waiting[class] {
+			if startedNum[class] >= taskCap {
+				break
+			}
+			err := scheduler.promote(task)
+			if err != nil {
+				task.Cancel(err)
+				toRemove = append(toRemove, task)
+				log.Warn("failed to promote task",
+					zap.Int64("taskID", task.ID()),
+					zap.Error(err),
+				)
+			} else {
+				toPromote = append(toPromote, task)
+				startedNum[class]++
+			}
+		}
+	}
+
 	for _, task := range toPromote {
 		scheduler.waitQueue.Remove(task)
 	}
@@ -392,6 +420,18 @@ func (scheduler *taskScheduler) tryPromoteAll() {
 	}
 }
 
+// getTaskCap returns the max number of started tasks of the class
+func getTaskCap(class Class) int {
+	switch class {
+	case TaskClassLoad:
+		return params.Params.QueryCoordCfg.LoadTaskCap.GetAsInt()
+	case TaskClassRecovery:
+		return params.Params.QueryCoordCfg.RecoveryTaskCap.GetAsInt()
+	default:
+		return params.Params.QueryCoordCfg.BalanceTaskCap.GetAsInt()
+	}
+}
+
 func (scheduler *taskScheduler) promote(task Task) error {
 	log := log.With(
 		zap.Int64("taskID", task.ID()),
@@ -512,11 +552,13 @@ func (scheduler *taskScheduler) schedule(node int64) {
 	)
 
 	// Process tasks
-	toProcess := make([]Task, 0)
+	toProcess := make(map[Class][]Task, len(TaskClasses))
+	toProcessNum := 0
 	toRemove := make([]Task, 0)
 	scheduler.processQueue.Range(func(task Task) bool {
-		if scheduler.preProcess(task) && scheduler.isRelated(task, node) {
-			toProcess = append(toProcess, task)
+		if scheduler.preProcess(task) && scheduler.isRelated(task, node) && !scheduler.delay(task) {
+			toProcess[task.Class()] = append(toProcess[task.Class()], task)
+			toProcessNum++
 		}
 		if task.Status() != TaskStatusStarted {
 			toRemove = append(toRemove, task)
@@ -525,22 +567,26 @@ func (scheduler *taskScheduler) schedule(node int64) {
 		return true
 	})
 
-	// The scheduler doesn't limit the number of tasks,
-	// to commit tasks to executors as soon as possible, to reach higher merge possibility
+	// The scheduler doesn't limit the number of tasks of each class here,
+	// to commit tasks to executors as soon as possible, to reach higher merge possibility,
+	// but the tasks of higher class are committed first to take the execution slots
 	failCount := atomic.NewInt32(0)
-	funcutil.ProcessFuncParallel(len(toProcess), runtime.GOMAXPROCS(0), func(idx int) error {
-		if !scheduler.process(toProcess[idx]) {
-			failCount.Inc()
-		}
-		return nil
-	}, "process")
+	for i := len(TaskClasses) - 1; i >= 0; i-- {
+		tasks := toProcess[TaskClasses[i]]
+		funcutil.ProcessFuncParallel(len(tasks), runtime.GOMAXPROCS(0), func(idx int) error {
+			if !scheduler.process(tasks[idx]) {
+				failCount.Inc()
+			}
+			return nil
+		}, "process")
+	}
 
 	for _, task := range toRemove {
 		scheduler.remove(task)
 	}
 
 	log.Info("processed tasks",
-		zap.Int("toProcessNum", len(toProcess)),
+		zap.Int("toProcessNum", toProcessNum),
 		zap.Int32("failCount", failCount.Load()),
 		zap.Int("toRemoveNum", len(toRemove)),
 	)
@@ -611,6 +657,46 @@ func (scheduler *taskScheduler) preProcess(task Task) bool {
 	return task.Status() == TaskStatusStarted
 }
 
+// delay returns whether the segment loading task should wait,
+// as the memory or local disk usage of the target node reaches the watermark.
+// The task is canceled if it waits longer than queryCoord.loadDelayTimeout,
+// and the checkers reroute the segment to the nodes under the watermarks.
+func (scheduler *taskScheduler) delay(task Task) bool {
+	if _, ok := task.(*SegmentTask); !ok {
+		return false
+	}
+	action := task.Actions()[task.Step()]
+	if action.Type() != ActionTypeGrow {
+		return false
+	}
+	node := scheduler.nodeMgr.Get(action.Node())
+	if node == nil || !utils.ExceedLoadWatermark(node) {
+		delete(scheduler.delayedTasks, task.ID())
+		return false
+	}
+
+	log := log.With(
+		zap.Int64("taskID", task.ID()),
+		zap.Int64("collectionID", task.CollectionID()),
+		zap.Int64("nodeID", action.Node()),
+		zap.Float64("memoryUsage", node.MemoryUsage()),
+		zap.Int64("diskUsage", node.DiskUsage()),
+		zap.Int64("diskCapacity", node.DiskCapacity()),
+	)
+	since, ok := scheduler.delayedTasks[task.ID()]
+	if !ok {
+		since = time.Now()
+		scheduler.delayedTasks[task.ID()] = since
+		log.Info("delay the segment loading task, the node reaches the load watermark")
+	}
+	if time.Since(since) > params.Params.QueryCoordCfg.LoadDelayTimeout.GetAsDuration(time.Millisecond) {
+		log.Warn("cancel the segment loading task to reroute it, the node reaches the load watermark for too long",
+			zap.Duration("delayed", time.Since(since)))
+		task.Cancel(merr.WrapErrNodeNotAvailable(action.Node(), "memory or disk usage reaches the load watermark"))
+	}
+	return true
+}
+
 // process processes the given task,
 // return true if the task is started and succeeds to commit the current action
 func (scheduler *taskScheduler) process(task Task) bool {
@@ -695,6 +781,7 @@ func (scheduler *taskScheduler) remove(task Task) {
 	)
 	task.Cancel(nil)
 	scheduler.tasks.Remove(task.ID())
+	delete(scheduler.delayedTasks, task.ID())
 	scheduler.waitQueue.Remove(task)
 	scheduler.processQueue.Remove(task)
 
@@ -61,6 +61,32 @@ var (
 	TaskPriorities = []Priority{TaskPriorityLow, TaskPriorityNormal, TaskPriorityHigh}
 )
 
+// Class is the scheduling class of task, the number of started tasks of each class is limited,
+// and the tasks of higher class are always scheduled before the ones of lower class,
+// so that the background tasks never starve the load triggered by users.
+type Class int32
+
+const (
+	TaskClassBalance  Class = iota // for balancing the loaded collections
+	TaskClassRecovery              // for recovering the loaded collections
+	TaskClassLoad                  // for loading the collections triggered by users
+)
+
+var TaskClassName = map[Class]string{
+	TaskClassBalance:  "Balance",
+	TaskClassRecovery: "Recovery",
+	TaskClassLoad:     "Load",
+}
+
+func (c Class) String() string {
+	return TaskClassName[c]
+}
+
+var (
+	// All task classes from low to high
+	TaskClasses = []Class{TaskClassBalance, TaskClassRecovery, TaskClassLoad}
+)
+
 type Task interface {
 	Context() context.Context
 	SourceID() UniqueID
@@ -73,6 +99,8 @@ type Task interface {
 	Err() error
 	Priority() Priority
 	SetPriority(priority Priority)
+	Class() Class
+	SetClass(class Class)
 	Index() string // dedup indexing string
 
 	// cancel the task as we don't need to continue it
@@ -86,6 +114,7 @@ type Task interface {
 	StepUp() int
 	IsFinished(dist *meta.DistributionManager) bool
 	SetReason(reason string)
+	Reason() string
 	String() string
 }
 
@@ -104,6 +133,7 @@ type baseTask struct {
 
 	status   *atomic.Int32
 	priority Priority
+	class    Class
 	err      error
 	actions  []Action
 	step     int
@@ -121,6 +151,7 @@ func newBaseTask(ctx context.Context, sourceID, collectionID, replicaID UniqueID
 
 		status:   atomic.NewInt32(TaskStatusStarted),
 		priority: TaskPriorityNormal,
+		class:    TaskClassRecovery,
 		ctx:      ctx,
 		cancel:   cancel,
 		doneCh:   make(chan struct{}),
@@ -172,6 +203,14 @@ func (task *baseTask) SetPriority(priority Priority) {
 	task.priority = priority
 }
 
+func (task *baseTask) Class() Class {
+	return task.class
+}
+
+func (task *baseTask) SetClass(class Class) {
+	task.class = class
+}
+
 func (task *baseTask) Index() string {
 	return fmt.Sprintf("[replica=%d]", task.replicaID)
 }
@@ -236,6 +275,10 @@ func (task *baseTask) SetReason(reason string) {
 	task.reason = reason
 }
 
+func (task *baseTask) Reason() string {
+	return task.reason
+}
+
 func (task *baseTask) String() string {
 	var actionsStr string
 	for i, action := range task.actions {
@@ -249,13 +292,14 @@ func (task *baseTask) String() string {
 		}
 	}
 	return fmt.Sprintf(
-		"[id=%d] [type=%s] [reason=%s] [collectionID=%d] [replicaID=%d] [priority=%s] [actionsCount=%d] [actions=%s]",
+		"[id=%d] [type=%s] [reason=%s] [collectionID=%d] [replicaID=%d] [priority=%s] [class=%s] [actionsCount=%d] [actions=%s]",
 		task.id,
 		GetTaskType(task).String(),
 		task.reason,
 		task.collectionID,
 		task.replicaID,
 		task.priority.String(),
+		task.class.String(),
 		len(task.actions),
 		actionsStr,
 	)
@@ -43,6 +43,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
 	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
@@ -167,7 +168,8 @@ func (suite *TaskSuite) BeforeTest(suiteName, testName string) {
 		"TestMoveSegmentTask",
 		"TestSubmitDuplicateLoadSegmentTask",
 		"TestSubmitDuplicateSubscribeChannelTask",
-		"TestNoExecutor":
+		"TestNoExecutor",
+		"TestTaskClassCap":
 		suite.meta.PutCollection(&meta.Collection{
 			CollectionLoadInfo: &querypb.CollectionLoadInfo{
 				CollectionID:  suite.collection,
@@ -1267,6 +1269,118 @@ func (suite *TaskSuite) TestNoExecutor() {
 	suite.AssertTaskNum(0, 0, 0, 0)
 }
 
+func (suite *TaskSuite) TestTaskClassCap() {
+	ctx := context.Background()
+	timeout := 10 * time.Second
+
+	paramtable.Get().Save(Params.QueryCoordCfg.BalanceTaskCap.Key, "1")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.BalanceTaskCap.Key)
+
+	// The balance tasks keep their class
+	balanceTasks := make([]Task, 0, len(suite.unsubChannels))
+	for _, channel := range suite.unsubChannels {
+		task, err := NewChannelTask(
+			ctx,
+			timeout,
+			0,
+			suite.collection,
+			suite.replica,
+			NewChannelAction(1, ActionTypeReduce, channel),
+		)
+		suite.NoError(err)
+		task.SetClass(TaskClassBalance)
+		suite.NoError(suite.scheduler.Add(task))
+		suite.Equal(TaskClassBalance, task.Class())
+		balanceTasks = append(balanceTasks, task)
+	}
+
+	// The other tasks of the loading collection are for loading
+	for _, channel := range suite.moveChannels {
+		task, err := NewChannelTask(
+			ctx,
+			timeout,
+			0,
+			suite.collection,
+			suite.replica,
+			NewChannelAction(1, ActionTypeReduce, channel),
+		)
+		suite.NoError(err)
+		suite.NoError(suite.scheduler.Add(task))
+		suite.Equal(TaskClassLoad, task.Class())
+	}
+	channelNum := len(suite.unsubChannels) + len(suite.moveChannels)
+	suite.AssertTaskNum(0, channelNum, channelNum, 0)
+
+	// Only one balance task could be started
+	suite.scheduler.rwmutex.Lock()
+	suite.scheduler.tryPromoteAll()
+	suite.scheduler.rwmutex.Unlock()
+	suite.AssertTaskNum(channelNum-1, 1, channelNum, 0)
+	started := lo.CountBy(balanceTasks, func(task Task) bool {
+		return task.Status() == TaskStatusStarted
+	})
+	suite.Equal(1, started)
+
+	// Raise the cap of balance tasks
+	paramtable.Get().Save(Params.QueryCoordCfg.BalanceTaskCap.Key, "2")
+	suite.scheduler.rwmutex.Lock()
+	suite.scheduler.tryPromoteAll()
+	suite.scheduler.rwmutex.Unlock()
+	suite.AssertTaskNum(channelNum, 0, channelNum, 0)
+}
+
+func (suite *TaskSuite) TestSegmentTaskDelayedByWatermark() {
+	ctx := context.Background()
+	timeout := 10 * time.Second
+	targetNode := int64(3)
+
+	task, err := NewSegmentTask(
+		ctx,
+		timeout,
+		0,
+		suite.collection,
+		suite.replica,
+		NewSegmentAction(targetNode, ActionTypeGrow, "", suite.loadSegments[0]),
+	)
+	suite.NoError(err)
+	channelTask, err := NewChannelTask(
+		ctx,
+		timeout,
+		0,
+		suite.collection,
+		suite.replica,
+		NewChannelAction(targetNode, ActionTypeGrow, suite.subChannels[0]),
+	)
+	suite.NoError(err)
+
+	// The node under the watermarks
+	node := suite.nodeMgr.Get(targetNode)
+	node.UpdateStats(session.WithRuntimeLoad(0, 50, 100, 0))
+	suite.False(suite.scheduler.delay(task))
+
+	// The node reaches the memory watermark
+	node.UpdateStats(session.WithRuntimeLoad(0, 95, 100, 0))
+	suite.True(suite.scheduler.delay(task))
+	suite.Contains(suite.scheduler.delayedTasks, task.ID())
+	suite.Equal(TaskStatusStarted, task.Status())
+	suite.False(suite.scheduler.delay(channelTask))
+
+	// The node gets headroom
+	node.UpdateStats(session.WithRuntimeLoad(0, 50, 100, 0))
+	suite.False(suite.scheduler.delay(task))
+	suite.NotContains(suite.scheduler.delayedTasks, task.ID())
+
+	// The node reaches the disk watermark for too long
+	paramtable.Get().Save(Params.QueryCoordCfg.LoadDelayTimeout.Key, "0")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.LoadDelayTimeout.Key)
+	node.UpdateStats(session.WithDiskUsage(100, 95))
+	suite.True(suite.scheduler.delay(task))
+	time.Sleep(time.Millisecond)
+	suite.True(suite.scheduler.delay(task))
+	suite.Equal(TaskStatusCanceled, task.Status())
+	suite.ErrorIs(task.Err(), merr.ErrNodeNotAvailable)
+}
+
 func (suite *TaskSuite) AssertTaskNum(process, wait, channel, segment int) {
 	scheduler := suite.scheduler
 
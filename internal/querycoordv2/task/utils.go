@@ -62,6 +62,12 @@ func SetPriority(priority Priority, tasks ...Task) {
 	}
 }
 
+func SetClass(class Class, tasks ...Task) {
+	for i := range tasks {
+		tasks[i].SetClass(class)
+	}
+}
+
 func SetReason(reason string, tasks ...Task) {
 	for i := range tasks {
 		tasks[i].SetReason(reason)
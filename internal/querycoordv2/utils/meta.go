@@ -26,6 +26,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/querycoordv2/params"
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/merr"
@@ -51,6 +52,16 @@ func GetReplicaNodesInfo(replicaMgr *meta.ReplicaManager, nodeMgr *session.NodeM
 	return nodes
 }
 
+// ExceedLoadWatermark returns whether the memory or local disk usage ratio of the node reaches the watermark,
+// the node which doesn't report its usage is never considered exceeded.
+func ExceedLoadWatermark(node *session.NodeInfo) bool {
+	if node.MemoryUsage() >= params.Params.QueryCoordCfg.LoadMemoryWatermark.GetAsFloat() {
+		return true
+	}
+	return node.DiskCapacity() > 0 &&
+		float64(node.DiskUsage())/float64(node.DiskCapacity()) >= params.Params.QueryCoordCfg.LoadDiskWatermark.GetAsFloat()
+}
+
 func GetPartitions(collectionMgr *meta.CollectionManager, collectionID int64) ([]int64, error) {
 	collection := collectionMgr.GetCollection(collectionID)
 	if collection != nil {
@@ -109,7 +120,8 @@ func GroupSegmentsByReplica(replicaMgr *meta.ReplicaManager, collectionID int64,
 
 // AssignNodesToReplicas assigns nodes to the given replicas,
 // all given replicas must be the same collection,
-// the given replicas have to be not in ReplicaManager
+// the given replicas have to be not in ReplicaManager,
+// only the nodes matching the placement labels of the replicas are assigned
 func AssignNodesToReplicas(m *meta.Meta, rgName string, replicas ...*meta.Replica) error {
 	replicaIDs := lo.Map(replicas, func(r *meta.Replica, _ int) int64 { return r.GetID() })
 	log := log.With(zap.Int64("collectionID", replicas[0].GetCollectionID()),
@@ -125,6 +137,10 @@ func AssignNodesToReplicas(m *meta.Meta, rgName string, replicas ...*meta.Replic
 		log.Error("failed to get nodes", zap.Error(err))
 		return err
 	}
+	labels := replicas[0].GetPlacementLabels()
+	nodeGroup = lo.Filter(nodeGroup, func(node int64, _ int) bool {
+		return m.ResourceManager.MatchNodeLabels(node, labels)
+	})
 
 	if len(nodeGroup) < len(replicaIDs) {
 		log.Error(meta.ErrNodeNotEnough.Error())
@@ -163,6 +179,14 @@ func AddNodesToReplicas(m *meta.Meta, replicas []*meta.Replica, node int64) {
 	if len(replicas) == 0 {
 		return
 	}
+	if !m.ResourceManager.MatchNodeLabels(node, replicas[0].GetPlacementLabels()) {
+		log.Info("node doesn't match the placement labels of the collection, skip assigning it to replicas",
+			zap.Int64("collectionID", replicas[0].GetCollectionID()),
+			zap.Int64("nodeID", node),
+			zap.Any("placementLabels", replicas[0].GetPlacementLabels()),
+		)
+		return
+	}
 	sort.Slice(replicas, func(i, j int) bool {
 		return replicas[i].Len() < replicas[j].Len()
 	})
@@ -186,11 +210,12 @@ func AddNodesToReplicas(m *meta.Meta, replicas []*meta.Replica, node int64) {
 }
 
 // SpawnReplicas spawns replicas for given collection, assign nodes to them, and save them
-func SpawnAllReplicasInRG(m *meta.Meta, collection int64, replicaNumber int32, rgName string) ([]*meta.Replica, error) {
+func SpawnAllReplicasInRG(m *meta.Meta, collection int64, replicaNumber int32, rgName string, placementLabels map[string]string) ([]*meta.Replica, error) {
 	replicas, err := m.ReplicaManager.Spawn(collection, replicaNumber, rgName)
 	if err != nil {
 		return nil, err
 	}
+	setPlacementLabels(replicas, placementLabels)
 	err = AssignNodesToReplicas(m, rgName, replicas...)
 	if err != nil {
 		return nil, err
@@ -206,17 +231,19 @@ func checkResourceGroup(collectionID int64, replicaNumber int32, resourceGroups
 	return nil
 }
 
-func SpawnReplicasWithRG(m *meta.Meta, collection int64, resourceGroups []string, replicaNumber int32) ([]*meta.Replica, error) {
+// SpawnReplicasWithRG spawns replicas for given collection in the resource groups,
+// the nodes of the replicas must match the placement labels, if any.
+func SpawnReplicasWithRG(m *meta.Meta, collection int64, resourceGroups []string, replicaNumber int32, placementLabels map[string]string) ([]*meta.Replica, error) {
 	if err := checkResourceGroup(collection, replicaNumber, resourceGroups); err != nil {
 		return nil, err
 	}
 
 	if len(resourceGroups) == 0 {
-		return SpawnAllReplicasInRG(m, collection, replicaNumber, meta.DefaultResourceGroupName)
+		return SpawnAllReplicasInRG(m, collection, replicaNumber, meta.DefaultResourceGroupName, placementLabels)
 	}
 
 	if len(resourceGroups) == 1 {
-		return SpawnAllReplicasInRG(m, collection, replicaNumber, resourceGroups[0])
+		return SpawnAllReplicasInRG(m, collection, replicaNumber, resourceGroups[0], placementLabels)
 	}
 
 	replicaSet := make([]*meta.Replica, 0)
@@ -229,6 +256,7 @@ func SpawnReplicasWithRG(m *meta.Meta, collection int64, resourceGroups []string
 		if err != nil {
 			return nil, err
 		}
+		setPlacementLabels(replicas, placementLabels)
 
 		err = AssignNodesToReplicas(m, rgName, replicas...)
 		if err != nil {
@@ -239,3 +267,12 @@ func SpawnReplicasWithRG(m *meta.Meta, collection int64, resourceGroups []string
 
 	return replicaSet, m.ReplicaManager.Put(replicaSet...)
 }
+
+func setPlacementLabels(replicas []*meta.Replica, labels map[string]string) {
+	if len(labels) == 0 {
+		return
+	}
+	for _, replica := range replicas {
+		replica.PlacementLabels = labels
+	}
+}
@@ -54,8 +54,13 @@ func TestSpawnReplicasWithRG(t *testing.T) {
 	m.ResourceManager.AddResourceGroup("rg2")
 	m.ResourceManager.AddResourceGroup("rg3")
 
+	nvmeLabels := map[string]string{"disk": "nvme"}
 	for i := 1; i < 10; i++ {
-		nodeMgr.Add(session.NewNodeInfo(int64(i), "localhost"))
+		nodeInfo := session.NewNodeInfo(int64(i), "localhost")
+		if i <= 6 {
+			nodeInfo.UpdateStats(session.WithLabels(nvmeLabels))
+		}
+		nodeMgr.Add(nodeInfo)
 
 		if i%3 == 0 {
 			m.ResourceManager.AssignNode("rg1", int64(i))
@@ -69,10 +74,11 @@ func TestSpawnReplicasWithRG(t *testing.T) {
 	}
 
 	type args struct {
-		m              *meta.Meta
-		collection     int64
-		resourceGroups []string
-		replicaNumber  int32
+		m               *meta.Meta
+		collection      int64
+		resourceGroups  []string
+		replicaNumber   int32
+		placementLabels map[string]string
 	}
 
 	tests := []struct {
@@ -83,28 +89,42 @@ func TestSpawnReplicasWithRG(t *testing.T) {
 	}{
 		{
 			name:           "test 3 replica on 1 rg",
-			args:           args{m, 1000, []string{"rg1"}, 3},
+			args:           args{m, 1000, []string{"rg1"}, 3, nil},
 			wantReplicaNum: 3,
 			wantErr:        false,
 		},
 
+		{
+			name:           "test 2 replica on 1 rg with placement labels",
+			args:           args{m, 1001, []string{"rg1"}, 2, nvmeLabels},
+			wantReplicaNum: 2,
+			wantErr:        false,
+		},
+
+		{
+			name:           "test 3 replica on 1 rg without enough nodes matching placement labels",
+			args:           args{m, 1002, []string{"rg1"}, 3, nvmeLabels},
+			wantReplicaNum: 0,
+			wantErr:        true,
+		},
+
 		{
 			name:           "test 3 replica on 2 rg",
-			args:           args{m, 1000, []string{"rg1", "rg2"}, 3},
+			args:           args{m, 1000, []string{"rg1", "rg2"}, 3, nil},
 			wantReplicaNum: 0,
 			wantErr:        true,
 		},
 
 		{
 			name:           "test 3 replica on 3 rg",
-			args:           args{m, 1000, []string{"rg1", "rg2", "rg3"}, 3},
+			args:           args{m, 1000, []string{"rg1", "rg2", "rg3"}, 3, nil},
 			wantReplicaNum: 3,
 			wantErr:        false,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := SpawnReplicasWithRG(tt.args.m, tt.args.collection, tt.args.resourceGroups, tt.args.replicaNumber)
+			got, err := SpawnReplicasWithRG(tt.args.m, tt.args.collection, tt.args.resourceGroups, tt.args.replicaNumber, tt.args.placementLabels)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("SpawnReplicasWithRG() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -113,6 +133,13 @@ func TestSpawnReplicasWithRG(t *testing.T) {
 			if len(got) != tt.wantReplicaNum {
 				t.Errorf("SpawnReplicasWithRG() = %v, want %d replicas", got, tt.args.replicaNumber)
 			}
+			for _, replica := range got {
+				for _, node := range replica.GetNodes() {
+					if !nodeMgr.Get(node).MatchLabels(tt.args.placementLabels) {
+						t.Errorf("SpawnReplicasWithRG() assigned node %d mismatching placement labels %v", node, tt.args.placementLabels)
+					}
+				}
+			}
 		})
 	}
 }
@@ -238,3 +265,19 @@ func TestAddNodesToCollectionsInRG(t *testing.T) {
 	assert.Len(t, m.ReplicaManager.Get(3).GetNodes(), 2)
 	assert.Len(t, m.ReplicaManager.Get(4).GetNodes(), 2)
 }
+
+func TestExceedLoadWatermark(t *testing.T) {
+	Params.Init()
+	node := session.NewNodeInfo(1, "localhost")
+	// the node doesn't report its usage
+	assert.False(t, ExceedLoadWatermark(node))
+
+	node.UpdateStats(session.WithRuntimeLoad(0, 50, 100, 0), session.WithDiskUsage(100, 50))
+	assert.False(t, ExceedLoadWatermark(node))
+
+	node.UpdateStats(session.WithRuntimeLoad(0, 90, 100, 0))
+	assert.True(t, ExceedLoadWatermark(node))
+
+	node.UpdateStats(session.WithRuntimeLoad(0, 50, 100, 0), session.WithDiskUsage(100, 95))
+	assert.True(t, ExceedLoadWatermark(node))
+}
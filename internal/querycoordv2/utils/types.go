@@ -146,6 +146,21 @@ func calculateSegmentSize(segmentLoadInfo *querypb.SegmentLoadInfo) int64 {
 	return segmentSize
 }
 
+// CalculateSegmentInfoSize returns the total size of the binlogs, statslogs and deltalogs of the segment.
+func CalculateSegmentInfoSize(segment *datapb.SegmentInfo) int64 {
+	segmentSize := int64(0)
+	for _, fieldBinlog := range segment.GetBinlogs() {
+		segmentSize += getFieldSizeFromFieldBinlog(fieldBinlog)
+	}
+	for _, fieldBinlog := range segment.GetStatslogs() {
+		segmentSize += getFieldSizeFromFieldBinlog(fieldBinlog)
+	}
+	for _, fieldBinlog := range segment.GetDeltalogs() {
+		segmentSize += getFieldSizeFromFieldBinlog(fieldBinlog)
+	}
+	return segmentSize
+}
+
 func getFieldSizeFromFieldBinlog(fieldBinlog *datapb.FieldBinlog) int64 {
 	fieldSize := int64(0)
 	for _, binlog := range fieldBinlog.Binlogs {
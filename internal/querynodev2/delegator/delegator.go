@@ -134,6 +134,12 @@ type shardDelegator struct {
 	wg          sync.WaitGroup
 	tsCond      *sync.Cond
 	latestTsafe *atomic.Uint64
+
+	// deletes not after deleteTs have been applied to all the segments
+	deleteTs    *atomic.Uint64
+	deleteTsMut sync.Mutex
+	// segment id => ts of the first delete failed to apply on it
+	missedDeletes map[int64]uint64
 }
 
 // getLogger returns the zap logger with pre-defined shard attributes.
@@ -245,6 +251,11 @@ func (sd *shardDelegator) Search(ctx context.Context, req *querypb.SearchRequest
 		zap.Int("sealedNum", sealedNum),
 		zap.Int("growingNum", len(growing)),
 	)
+	cost := sd.estimateSearchCost(req, sealed, growing)
+	if err := checkSearchCost(cost); err != nil {
+		log.Warn("reject search as the estimated cost exceeds the ceiling", zap.Stringer("cost", cost), zap.Error(err))
+		return nil, err
+	}
 	tasks, err := organizeSubTask(req, sealed, growing, sd.workerManager, sd.modifySearchRequest)
 	if err != nil {
 		log.Warn("Search organizeSubTask failed", zap.Error(err))
@@ -482,11 +493,11 @@ func executeSubTasks[T any, R interface {
 func (sd *shardDelegator) waitTSafe(ctx context.Context, ts uint64) error {
 	log := sd.getLogger(ctx)
 	// already safe to search
-	if sd.latestTsafe.Load() >= ts {
+	if sd.serviceableTs() >= ts {
 		return nil
 	}
 	// check lag duration too large
-	st, _ := tsoutil.ParseTS(sd.latestTsafe.Load())
+	st, _ := tsoutil.ParseTS(sd.serviceableTs())
 	gt, _ := tsoutil.ParseTS(ts)
 	lag := gt.Sub(st)
 	maxLag := paramtable.Get().QueryNodeCfg.MaxTimestampLag.GetAsDuration(time.Second)
@@ -500,27 +511,51 @@ func (sd *shardDelegator) waitTSafe(ctx context.Context, ts uint64) error {
 		return WrapErrTsLagTooLarge(lag, maxLag)
 	}
 
+	// the wait is bounded by the consistency wait budget if configured
+	start := time.Now()
+	budget := paramtable.Get().QueryNodeCfg.ConsistencyWaitBudget.GetAsDuration(time.Millisecond)
+	waitCtx, cancel := ctx, context.CancelFunc(func() {})
+	if budget > 0 {
+		waitCtx, cancel = context.WithTimeout(ctx, budget)
+	}
+	defer cancel()
+
 	ch := make(chan struct{})
 	go func() {
 		sd.tsCond.L.Lock()
 		defer sd.tsCond.L.Unlock()
 
-		for sd.latestTsafe.Load() < ts && ctx.Err() == nil {
+		for sd.serviceableTs() < ts && waitCtx.Err() == nil {
 			sd.tsCond.Wait()
 		}
 		close(ch)
 	}()
 
-	for {
-		select {
-		// timeout
-		case <-ctx.Done():
-			// notify wait goroutine to quit
-			sd.tsCond.Broadcast()
+	select {
+	// timeout
+	case <-waitCtx.Done():
+		// notify wait goroutine to quit
+		sd.tsCond.Broadcast()
+		if ctx.Err() == context.Canceled {
 			return ctx.Err()
-		case <-ch:
-			return nil
 		}
+		// budget exceeded or deadline exceeded, report the lag blocking the read
+		st, _ := tsoutil.ParseTS(sd.serviceableTs())
+		lag := gt.Sub(st)
+		if ctx.Err() != nil {
+			budget = time.Since(start)
+		}
+		log.Warn("wait for serviceable ts exceeded consistency wait budget",
+			zap.Time("guaranteeTime", gt),
+			zap.Time("serviceableTime", st),
+			zap.Duration("lag", lag),
+			zap.Duration("budget", budget),
+		)
+		metrics.QueryNodeConsistencyWaitExceeded.WithLabelValues(
+			fmt.Sprint(paramtable.GetNodeID()), fmt.Sprint(sd.collectionID)).Inc()
+		return merr.WrapErrConsistencyWaitExceeded(sd.vchannelName, lag, budget)
+	case <-ch:
+		return nil
 	}
 }
 
@@ -556,16 +591,77 @@ func (sd *shardDelegator) updateTSafe() {
 	}
 	if tsafe > sd.latestTsafe.Load() {
 		sd.latestTsafe.Store(tsafe)
+		// deletes are processed before tsafe moves forward
+		sd.updateDeleteTs(tsafe)
 		sd.tsCond.Broadcast()
 	}
 	sd.tsCond.L.Unlock()
 }
 
+// serviceableTs returns the ts before which the data is ready to read,
+// it's held back by the deletes not applied if WaitDeleteApplied is enabled.
+func (sd *shardDelegator) serviceableTs() uint64 {
+	ts := sd.latestTsafe.Load()
+	if paramtable.Get().QueryNodeCfg.WaitDeleteApplied.GetAsBool() {
+		if deleteTs := sd.deleteTs.Load(); deleteTs < ts {
+			return deleteTs
+		}
+	}
+	return ts
+}
+
+// updateDeleteTs advances deleteTs to ts, or to the ts right before the earliest delete missed by some segment.
+func (sd *shardDelegator) updateDeleteTs(ts uint64) {
+	sd.deleteTsMut.Lock()
+	defer sd.deleteTsMut.Unlock()
+	for _, missedTs := range sd.missedDeletes {
+		if missedTs-1 < ts {
+			ts = missedTs - 1
+		}
+	}
+	if ts > sd.deleteTs.Load() {
+		sd.deleteTs.Store(ts)
+	}
+	metrics.QueryNodeDeleteAppliedLag.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), sd.vchannelName).
+		Set(float64(tsoutil.SubByNow(sd.deleteTs.Load())))
+}
+
+// addMissedDeletes records the segments failed to apply the delete records.
+func (sd *shardDelegator) addMissedDeletes(delRecords map[int64]DeleteData, segmentIDs ...int64) {
+	sd.deleteTsMut.Lock()
+	defer sd.deleteTsMut.Unlock()
+	for _, segmentID := range segmentIDs {
+		record, ok := delRecords[segmentID]
+		if !ok || len(record.Timestamps) == 0 {
+			continue
+		}
+		minTs := lo.Min(record.Timestamps)
+		if ts, ok := sd.missedDeletes[segmentID]; !ok || minTs < ts {
+			sd.missedDeletes[segmentID] = minTs
+		}
+	}
+}
+
+// removeMissedDeletes removes the segments which are released or reloaded with all the deletes,
+// deleteTs catches up with tsafe if no segment misses deletes anymore.
+func (sd *shardDelegator) removeMissedDeletes(segmentIDs ...int64) {
+	sd.tsCond.L.Lock()
+	defer sd.tsCond.L.Unlock()
+	sd.deleteTsMut.Lock()
+	for _, segmentID := range segmentIDs {
+		delete(sd.missedDeletes, segmentID)
+	}
+	sd.deleteTsMut.Unlock()
+	sd.updateDeleteTs(sd.latestTsafe.Load())
+	sd.tsCond.Broadcast()
+}
+
 // Close closes the delegator.
 func (sd *shardDelegator) Close() {
 	sd.lifetime.SetState(stopped)
 	sd.lifetime.Close()
 	sd.wg.Wait()
+	metrics.QueryNodeDeleteAppliedLag.DeleteLabelValues(fmt.Sprint(paramtable.GetNodeID()), sd.vchannelName)
 }
 
 // NewShardDelegator creates a new ShardDelegator instance with all fields initialized.
@@ -595,6 +691,8 @@ func NewShardDelegator(collectionID UniqueID, replicaID UniqueID, channel string
 		pkOracle:       pkoracle.NewPkOracle(),
 		tsafeManager:   tsafeManager,
 		latestTsafe:    atomic.NewUint64(0),
+		deleteTs:       atomic.NewUint64(0),
+		missedDeletes:  make(map[int64]uint64),
 		loader:         loader,
 		factory:        factory,
 	}
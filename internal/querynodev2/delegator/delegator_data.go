@@ -186,6 +186,8 @@ func (sd *shardDelegator) ProcessDelete(deleteData []*DeleteData, ts uint64) {
 	}
 
 	offlineSegments := typeutil.NewConcurrentSet[int64]()
+	// segments on unavailable workers
+	skippedSegments := typeutil.NewConcurrentSet[int64]()
 
 	sealed, growing, version := sd.distribution.GetSegments(false)
 
@@ -201,6 +203,7 @@ func (sd *shardDelegator) ProcessDelete(deleteData []*DeleteData, ts uint64) {
 				)
 				// skip if node down
 				// delete will be processed after loaded again
+				skippedSegments.Upsert(lo.Map(entry.Segments, func(segment SegmentEntry, _ int) int64 { return segment.SegmentID })...)
 				return nil
 			}
 			offlineSegments.Upsert(sd.applyDelete(ctx, entry.NodeID, worker, delRecords, entry.Segments)...)
@@ -232,6 +235,8 @@ func (sd *shardDelegator) ProcessDelete(deleteData []*DeleteData, ts uint64) {
 		log.Warn("failed to apply delete, mark segment offline", zap.Int64s("offlineSegments", offlineSegIDs))
 		sd.markSegmentOffline(offlineSegIDs...)
 	}
+	// deleteTs is held back until these segments are reloaded or released
+	sd.addMissedDeletes(delRecords, append(offlineSegIDs, skippedSegments.Collect()...)...)
 
 	metrics.QueryNodeProcessCost.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.DeleteLabel).
 		Observe(float64(tr.ElapseSpan().Milliseconds()))
@@ -371,6 +376,8 @@ func (sd *shardDelegator) LoadSegments(ctx context.Context, req *querypb.LoadSeg
 		return err
 	}
 	log.Info("load delete done")
+	// buffered deletes have been applied to the loaded segments
+	sd.removeMissedDeletes(lo.Map(infos, func(info *querypb.SegmentLoadInfo, _ int) int64 { return info.GetSegmentID() })...)
 	// alter distribution
 	entries := lo.Map(req.GetInfos(), func(info *querypb.SegmentLoadInfo, _ int) SegmentEntry {
 		return SegmentEntry{
@@ -379,6 +386,7 @@ func (sd *shardDelegator) LoadSegments(ctx context.Context, req *querypb.LoadSeg
 			NodeID:        req.GetDstNodeID(),
 			Version:       req.GetVersion(),
 			TargetVersion: info.GetReadableVersion(),
+			NumOfRows:     info.GetNumOfRows(),
 		}
 	})
 	sd.distribution.AddDistributions(entries...)
@@ -582,6 +590,7 @@ func (sd *shardDelegator) ReleaseSegments(ctx context.Context, req *querypb.Rele
 	signal := sd.distribution.RemoveDistributions(sealed, growing)
 	// wait cleared signal
 	<-signal
+	sd.removeMissedDeletes(req.GetSegmentIDs()...)
 	if len(sealed) > 0 {
 		sd.pkOracle.Remove(
 			pkoracle.WithSegmentIDs(lo.Map(sealed, func(entry SegmentEntry, _ int) int64 { return entry.SegmentID })...),
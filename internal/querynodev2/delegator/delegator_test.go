@@ -222,6 +222,7 @@ func (s *DelegatorSuite) TestSearch() {
 			SegmentID:   1000,
 			PartitionID: 500,
 			Version:     2001,
+			NumOfRows:   100,
 		},
 		SegmentEntry{
 			NodeID:      1,
@@ -291,6 +292,28 @@ func (s *DelegatorSuite) TestSearch() {
 		s.Equal(3, len(results))
 	})
 
+	s.Run("search_cost_exceeded", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		req := &querypb.SearchRequest{
+			Req:         &internalpb.SearchRequest{Base: commonpbutil.NewMsgBase(), Nq: 10, Topk: 100},
+			DmlChannels: []string{s.vchannelName},
+		}
+
+		// 4 sealed segments and 1 growing segment
+		paramtable.Get().Save(paramtable.Get().QueryNodeCfg.SearchCostMaxSegments.Key, "4")
+		_, err := s.delegator.Search(ctx, req)
+		s.ErrorIs(err, merr.ErrSearchCostExceeded)
+		s.ErrorContains(err, "constraint=segments estimate=5 ceiling=4")
+		paramtable.Get().Reset(paramtable.Get().QueryNodeCfg.SearchCostMaxSegments.Key)
+
+		paramtable.Get().Save(paramtable.Get().QueryNodeCfg.SearchCostMaxRows.Key, "50")
+		_, err = s.delegator.Search(ctx, req)
+		s.ErrorIs(err, merr.ErrSearchCostExceeded)
+		s.ErrorContains(err, "constraint=rows estimate=100 ceiling=50")
+		paramtable.Get().Reset(paramtable.Get().QueryNodeCfg.SearchCostMaxRows.Key)
+	})
+
 	s.Run("partition_not_loaded", func() {
 		defer func() {
 			s.workerManager.ExpectedCalls = nil
@@ -878,6 +901,7 @@ func TestDelegatorWatchTsafe(t *testing.T) {
 		vchannelName: channelName,
 		lifetime:     newLifetime(),
 		latestTsafe:  atomic.NewUint64(0),
+		deleteTs:     atomic.NewUint64(0),
 	}
 	defer sd.Close()
 
@@ -892,6 +916,62 @@ func TestDelegatorWatchTsafe(t *testing.T) {
 	assert.Eventually(t, func() bool {
 		return sd.latestTsafe.Load() == 200
 	}, time.Second*10, time.Millisecond*10)
+	assert.EqualValues(t, 200, sd.deleteTs.Load())
+}
+
+func TestDelegatorDeleteTs(t *testing.T) {
+	paramtable.Init()
+	sd := &shardDelegator{
+		vchannelName:  "default_dml_channel",
+		lifetime:      newLifetime(),
+		latestTsafe:   atomic.NewUint64(0),
+		deleteTs:      atomic.NewUint64(0),
+		missedDeletes: make(map[int64]uint64),
+	}
+	defer sd.Close()
+	sd.tsCond = sync.NewCond(&sync.Mutex{})
+
+	sd.latestTsafe.Store(100)
+	sd.updateDeleteTs(100)
+	assert.EqualValues(t, 100, sd.deleteTs.Load())
+
+	// segment 3 has no delete record
+	sd.addMissedDeletes(map[int64]DeleteData{
+		1: {Timestamps: []uint64{150, 120}},
+		2: {Timestamps: []uint64{130}},
+	}, 1, 3)
+	sd.latestTsafe.Store(200)
+	sd.updateDeleteTs(200)
+	assert.EqualValues(t, 119, sd.deleteTs.Load())
+
+	// reads are gated by deletes only if enabled
+	assert.EqualValues(t, 200, sd.serviceableTs())
+	params := paramtable.Get()
+	params.Save(params.QueryNodeCfg.WaitDeleteApplied.Key, "true")
+	defer params.Reset(params.QueryNodeCfg.WaitDeleteApplied.Key)
+	assert.EqualValues(t, 119, sd.serviceableTs())
+	assert.NoError(t, sd.waitTSafe(context.Background(), 110))
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := sd.waitTSafe(ctx, 150)
+	assert.ErrorIs(t, err, merr.ErrConsistencyWaitExceeded)
+
+	// bounded by the consistency wait budget
+	params.Save(params.QueryNodeCfg.ConsistencyWaitBudget.Key, "50")
+	err = sd.waitTSafe(context.Background(), 150)
+	params.Reset(params.QueryNodeCfg.ConsistencyWaitBudget.Key)
+	assert.ErrorIs(t, err, merr.ErrConsistencyWaitExceeded)
+	assert.ErrorContains(t, err, "default_dml_channel")
+
+	// canceled reads are not consistency errors
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, sd.waitTSafe(ctx, 150), context.Canceled)
+
+	// segment reloaded or released
+	sd.removeMissedDeletes(1)
+	assert.EqualValues(t, 200, sd.deleteTs.Load())
+	assert.NoError(t, sd.waitTSafe(context.Background(), 150))
 }
 
 func TestDelegatorTSafeListenerClosed(t *testing.T) {
@@ -904,6 +984,7 @@ func TestDelegatorTSafeListenerClosed(t *testing.T) {
 		vchannelName: channelName,
 		lifetime:     newLifetime(),
 		latestTsafe:  atomic.NewUint64(0),
+		deleteTs:     atomic.NewUint64(0),
 	}
 	defer sd.Close()
 
@@ -80,6 +80,7 @@ type SegmentEntry struct {
 	PartitionID   UniqueID
 	Version       int64
 	TargetVersion int64
+	NumOfRows     int64 // the row count when the sealed segment is loaded, 0 if unknown
 }
 
 // NewDistribution creates a new distribution instance with all field initialized.
@@ -0,0 +1,75 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package delegator
+
+import (
+	"fmt"
+
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// searchResultEntrySize is the size of an entry of the search results, the int64 primary key and the float32 score.
+const searchResultEntrySize = 12
+
+// searchCost is the cost of a search on the shard estimated before executing it.
+type searchCost struct {
+	segments int64
+	rows     int64 // the rows of the segments after the partition pruning
+	memory   int64 // the bytes of the search results of all the segments to reduce
+}
+
+func (c searchCost) String() string {
+	return fmt.Sprintf("segments=%d rows=%d memory=%dB", c.segments, c.rows, c.memory)
+}
+
+// estimateSearchCost estimates the cost of the search on the given segments,
+// the sealed segments whose row count is unknown count as empty.
+func (sd *shardDelegator) estimateSearchCost(req *querypb.SearchRequest, sealed []SnapshotItem, growing []SegmentEntry) searchCost {
+	var cost searchCost
+	for _, item := range sealed {
+		cost.segments += int64(len(item.Segments))
+		cost.rows += lo.SumBy(item.Segments, func(entry SegmentEntry) int64 { return entry.NumOfRows })
+	}
+	cost.segments += int64(len(growing))
+	for _, entry := range growing {
+		if segment := sd.segmentManager.GetGrowing(entry.SegmentID); segment != nil {
+			cost.rows += segment.RowNum()
+		}
+	}
+	cost.memory = req.GetReq().GetNq() * req.GetReq().GetTopk() * cost.segments * searchResultEntrySize
+	return cost
+}
+
+// checkSearchCost returns the error reporting the estimate and the violated constraint,
+// if the cost exceeds any ceiling of queryNode.searchCost.
+func checkSearchCost(cost searchCost) error {
+	params := paramtable.Get().QueryNodeCfg
+	if ceiling := params.SearchCostMaxSegments.GetAsInt64(); ceiling > 0 && cost.segments > ceiling {
+		return merr.WrapErrSearchCostExceeded("segments", cost.segments, ceiling, cost.String())
+	}
+	if ceiling := params.SearchCostMaxRows.GetAsInt64(); ceiling > 0 && cost.rows > ceiling {
+		return merr.WrapErrSearchCostExceeded("rows", cost.rows, ceiling, cost.String())
+	}
+	if ceiling := params.SearchCostMaxMemoryMB.GetAsInt64() * 1024 * 1024; ceiling > 0 && cost.memory > ceiling {
+		return merr.WrapErrSearchCostExceeded("memory", cost.memory, ceiling, cost.String())
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynodev2
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// channelFencer keeps the latest fencing token seen for each channel,
+// and rejects the channel requests with an older one,
+// so a delayed duplicate request from QueryCoord could not resurrect a released delegator.
+// The requests without token, e.g. from a QueryCoord of the older version, are always allowed.
+type channelFencer struct {
+	mu     sync.Mutex
+	tokens map[string]*querypb.FencingToken
+}
+
+func newChannelFencer() *channelFencer {
+	return &channelFencer{
+		tokens: make(map[string]*querypb.FencingToken),
+	}
+}
+
+// Advance records the token as the latest one of the channel,
+// returns ErrChannelFenced if it's older than the latest one.
+// The same token is allowed to make the retried requests idempotent.
+func (f *channelFencer) Advance(channel string, token *querypb.FencingToken) error {
+	if token == nil {
+		return nil
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	latest, ok := f.tokens[channel]
+	if ok && (token.GetTerm() < latest.GetTerm() ||
+		(token.GetTerm() == latest.GetTerm() && token.GetSeq() < latest.GetSeq())) {
+		return merr.WrapErrChannelFenced(channel, fmt.Sprintf("token (%d, %d) is older than the latest (%d, %d)",
+			token.GetTerm(), token.GetSeq(), latest.GetTerm(), latest.GetSeq()))
+	}
+	f.tokens[channel] = token
+	return nil
+}
+
+// CheckTerm returns ErrChannelFenced if the term of the token is older than the latest one of the channel.
+func (f *channelFencer) CheckTerm(channel string, token *querypb.FencingToken) error {
+	if token == nil {
+		return nil
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	latest, ok := f.tokens[channel]
+	if ok && token.GetTerm() < latest.GetTerm() {
+		return merr.WrapErrChannelFenced(channel, fmt.Sprintf("term %d is older than the latest %d",
+			token.GetTerm(), latest.GetTerm()))
+	}
+	return nil
+}
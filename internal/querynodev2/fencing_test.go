@@ -0,0 +1,53 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynodev2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+func TestChannelFencer(t *testing.T) {
+	fencer := newChannelFencer()
+	channel := "by-dev-rootcoord-dml_0_100v0"
+
+	// requests without token are always allowed
+	assert.NoError(t, fencer.Advance(channel, nil))
+	assert.NoError(t, fencer.CheckTerm(channel, nil))
+
+	assert.NoError(t, fencer.Advance(channel, &querypb.FencingToken{Term: 2, Seq: 5}))
+	// the same token is allowed for the retried requests
+	assert.NoError(t, fencer.Advance(channel, &querypb.FencingToken{Term: 2, Seq: 5}))
+	assert.ErrorIs(t, fencer.Advance(channel, &querypb.FencingToken{Term: 2, Seq: 4}), merr.ErrChannelFenced)
+	assert.ErrorIs(t, fencer.Advance(channel, &querypb.FencingToken{Term: 1, Seq: 100}), merr.ErrChannelFenced)
+	assert.NoError(t, fencer.Advance(channel, &querypb.FencingToken{Term: 2, Seq: 6}))
+
+	// only the term is checked
+	assert.NoError(t, fencer.CheckTerm(channel, &querypb.FencingToken{Term: 2, Seq: 1}))
+	assert.ErrorIs(t, fencer.CheckTerm(channel, &querypb.FencingToken{Term: 1, Seq: 100}), merr.ErrChannelFenced)
+
+	// a newer term resets the order
+	assert.NoError(t, fencer.Advance(channel, &querypb.FencingToken{Term: 3, Seq: 1}))
+	assert.ErrorIs(t, fencer.CheckTerm(channel, &querypb.FencingToken{Term: 2, Seq: 100}), merr.ErrChannelFenced)
+
+	// channels are fenced separately
+	assert.NoError(t, fencer.Advance("other", &querypb.FencingToken{Term: 1, Seq: 1}))
+}
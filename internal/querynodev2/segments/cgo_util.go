@@ -85,8 +85,7 @@ func GetCProtoBlob(cProto *C.CProto) []byte {
 }
 
 func GetLocalUsedSize(path string) (int64, error) {
-	var availableSize int64
-	cSize := C.int64_t(availableSize)
+	var cSize C.int64_t
 	cPath := C.CString(path)
 	defer C.free(unsafe.Pointer(cPath))
 
@@ -96,5 +95,5 @@ func GetLocalUsedSize(path string) (int64, error) {
 		return 0, err
 	}
 
-	return availableSize, nil
+	return int64(cSize), nil
 }
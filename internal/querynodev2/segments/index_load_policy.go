@@ -0,0 +1,85 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+var columnInfoName = proto.MessageReflect(&planpb.ColumnInfo{}).Descriptor().FullName()
+
+// isLazyIndex returns whether the index is loaded lazily, i.e. the raw data of the field is loaded with the segment,
+// and the index is loaded in background once the field is searched or filtered.
+// The index of primary key is always loaded eagerly, since segcore builds the primary key offsets from it.
+func isLazyIndex(indexInfo *querypb.FieldIndexInfo, isPrimaryKey bool) bool {
+	if isPrimaryKey {
+		return false
+	}
+	policy, err := funcutil.GetAttrByKeyFromRepeatedKV(common.IndexLoadPolicyKey, indexInfo.GetIndexParams())
+	if err != nil {
+		policy = paramtable.Get().QueryNodeCfg.IndexLoadPolicy.GetValue()
+	}
+	return strings.EqualFold(policy, common.IndexLoadPolicyLazy)
+}
+
+// planFieldIDs returns the IDs of the fields searched or filtered by the serialized plan,
+// which are the fields whose indexes are used to execute the plan.
+func planFieldIDs(expr []byte) ([]int64, error) {
+	plan := &planpb.PlanNode{}
+	if err := proto.Unmarshal(expr, plan); err != nil {
+		return nil, err
+	}
+
+	fieldIDs := typeutil.NewUniqueSet()
+	if anns := plan.GetVectorAnns(); anns != nil {
+		fieldIDs.Insert(anns.GetFieldId())
+	}
+	collectColumnFieldIDs(proto.MessageReflect(plan), fieldIDs)
+	return fieldIDs.Collect(), nil
+}
+
+// collectColumnFieldIDs collects the field IDs of all the columns referred by the expressions in the message.
+func collectColumnFieldIDs(msg protoreflect.Message, fieldIDs typeutil.UniqueSet) {
+	if msg.Descriptor().FullName() == columnInfoName {
+		fieldIDs.Insert(proto.MessageV1(msg.Interface()).(*planpb.ColumnInfo).GetFieldId())
+		return
+	}
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Kind() != protoreflect.MessageKind || fd.IsMap() {
+			return true
+		}
+		if fd.IsList() {
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				collectColumnFieldIDs(list.Get(i).Message(), fieldIDs)
+			}
+			return true
+		}
+		collectColumnFieldIDs(v.Message(), fieldIDs)
+		return true
+	})
+}
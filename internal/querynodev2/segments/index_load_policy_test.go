@@ -0,0 +1,94 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestIsLazyIndex(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+
+	lazy := &querypb.FieldIndexInfo{
+		IndexParams: []*commonpb.KeyValuePair{{Key: common.IndexLoadPolicyKey, Value: common.IndexLoadPolicyLazy}},
+	}
+	eager := &querypb.FieldIndexInfo{
+		IndexParams: []*commonpb.KeyValuePair{{Key: common.IndexLoadPolicyKey, Value: common.IndexLoadPolicyEager}},
+	}
+	unspecified := &querypb.FieldIndexInfo{}
+
+	assert.True(t, isLazyIndex(lazy, false))
+	assert.False(t, isLazyIndex(lazy, true))
+	assert.False(t, isLazyIndex(eager, false))
+	assert.False(t, isLazyIndex(unspecified, false))
+
+	// the default policy applies to the indexes without load policy
+	params.Save(params.QueryNodeCfg.IndexLoadPolicy.Key, common.IndexLoadPolicyLazy)
+	defer params.Reset(params.QueryNodeCfg.IndexLoadPolicy.Key)
+	assert.True(t, isLazyIndex(unspecified, false))
+	assert.False(t, isLazyIndex(eager, false))
+}
+
+func TestPlanFieldIDs(t *testing.T) {
+	plan := &planpb.PlanNode{
+		Node: &planpb.PlanNode_VectorAnns{
+			VectorAnns: &planpb.VectorANNS{
+				FieldId: 101,
+				Predicates: &planpb.Expr{
+					Expr: &planpb.Expr_BinaryExpr{
+						BinaryExpr: &planpb.BinaryExpr{
+							Left: &planpb.Expr{
+								Expr: &planpb.Expr_TermExpr{
+									TermExpr: &planpb.TermExpr{ColumnInfo: &planpb.ColumnInfo{FieldId: 102}},
+								},
+							},
+							Right: &planpb.Expr{
+								Expr: &planpb.Expr_CompareExpr{
+									CompareExpr: &planpb.CompareExpr{
+										LeftColumnInfo:  &planpb.ColumnInfo{FieldId: 103},
+										RightColumnInfo: &planpb.ColumnInfo{FieldId: 104},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		// the output fields are not counted
+		OutputFieldIds: []int64{105},
+	}
+	expr, err := proto.Marshal(plan)
+	assert.NoError(t, err)
+
+	fieldIDs, err := planFieldIDs(expr)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []int64{101, 102, 103, 104}, fieldIDs)
+
+	_, err = planFieldIDs([]byte("invalid plan"))
+	assert.Error(t, err)
+}
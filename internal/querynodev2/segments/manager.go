@@ -98,6 +98,10 @@ type SegmentManager interface {
 	Remove(segmentID UniqueID, scope querypb.DataScope) (int, int)
 	RemoveBy(filters ...SegmentFilter) (int, int)
 	Clear()
+
+	// SplitByGroup splits the sealed segments into the units searched concurrently,
+	// the segments of the same group form a single unit, see segmentGroups.
+	SplitByGroup(segmentIDs []UniqueID) [][]UniqueID
 }
 
 var _ SegmentManager = (*segmentManager)(nil)
@@ -108,12 +112,14 @@ type segmentManager struct {
 
 	growingSegments map[UniqueID]Segment
 	sealedSegments  map[UniqueID]Segment
+	groups          *segmentGroups
 }
 
 func NewSegmentManager() *segmentManager {
 	return &segmentManager{
 		growingSegments: make(map[int64]Segment),
 		sealedSegments:  make(map[int64]Segment),
+		groups:          newSegmentGroups(),
 	}
 }
 
@@ -137,6 +143,9 @@ func (mgr *segmentManager) Put(segmentType SegmentType, segments ...Segment) {
 		}
 		eventlog.Record(eventlog.NewRawEvt(eventlog.Level_Info, fmt.Sprintf("Segment %d[%d] loaded", segment.ID(), segment.Collection())))
 		targetMap[segment.ID()] = segment
+		if segmentType == SegmentTypeSealed {
+			mgr.groups.add(segment)
+		}
 		metrics.QueryNodeNumSegments.WithLabelValues(
 			fmt.Sprint(paramtable.GetNodeID()),
 			fmt.Sprint(segment.Collection()),
@@ -256,6 +265,7 @@ func (mgr *segmentManager) Remove(segmentID UniqueID, scope querypb.DataScope) (
 
 	case querypb.DataScope_Historical:
 		if remove(segmentID, mgr.sealedSegments) {
+			mgr.groups.remove(segmentID)
 			removeSealed = 1
 		}
 
@@ -264,6 +274,7 @@ func (mgr *segmentManager) Remove(segmentID UniqueID, scope querypb.DataScope) (
 			removeGrowing = 1
 		}
 		if remove(segmentID, mgr.sealedSegments) {
+			mgr.groups.remove(segmentID)
 			removeSealed = 1
 		}
 	}
@@ -285,6 +296,7 @@ func (mgr *segmentManager) RemoveBy(filters ...SegmentFilter) (int, int) {
 
 	for id, segment := range mgr.sealedSegments {
 		if filter(segment, filters...) && remove(id, mgr.sealedSegments) {
+			mgr.groups.remove(id)
 			removeSealed++
 		}
 	}
@@ -304,9 +316,17 @@ func (mgr *segmentManager) Clear() {
 	for id := range mgr.sealedSegments {
 		remove(id, mgr.sealedSegments)
 	}
+	mgr.groups.clear()
 	mgr.updateMetric()
 }
 
+func (mgr *segmentManager) SplitByGroup(segmentIDs []UniqueID) [][]UniqueID {
+	mgr.mu.RLock()
+	defer mgr.mu.RUnlock()
+
+	return mgr.groups.split(segmentIDs)
+}
+
 func (mgr *segmentManager) updateMetric() {
 	// update collection and partiation metric
 	var collections, partiations = make(Set[int64]), make(Set[int64])
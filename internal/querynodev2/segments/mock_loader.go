@@ -29,6 +29,56 @@ func (_m *MockLoader) EXPECT() *MockLoader_Expecter {
 	return &MockLoader_Expecter{mock: &_m.Mock}
 }
 
+// DiskUsage provides a mock function with given fields:
+func (_m *MockLoader) DiskUsage() (uint64, uint64, error) {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 uint64
+	if rf, ok := ret.Get(1).(func() uint64); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func() error); ok {
+		r2 = rf()
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockLoader_DiskUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiskUsage'
+type MockLoader_DiskUsage_Call struct {
+	*mock.Call
+}
+
+// DiskUsage is a helper method to define mock.On call
+func (_e *MockLoader_Expecter) DiskUsage() *MockLoader_DiskUsage_Call {
+	return &MockLoader_DiskUsage_Call{Call: _e.mock.On("DiskUsage")}
+}
+
+func (_c *MockLoader_DiskUsage_Call) Run(run func()) *MockLoader_DiskUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockLoader_DiskUsage_Call) Return(_a0 uint64, _a1 uint64, _a2 error) *MockLoader_DiskUsage_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
 // Load provides a mock function with given fields: ctx, collectionID, segmentType, version, segments
 func (_m *MockLoader) Load(ctx context.Context, collectionID int64, segmentType commonpb.SegmentState, version int64, segments ...*querypb.SegmentLoadInfo) ([]Segment, error) {
 	_va := make([]interface{}, len(segments))
@@ -495,6 +495,50 @@ func (_c *MockSegmentManager_RemoveBy_Call) RunAndReturn(run func(...SegmentFilt
 	return _c
 }
 
+// SplitByGroup provides a mock function with given fields: segmentIDs
+func (_m *MockSegmentManager) SplitByGroup(segmentIDs []int64) [][]int64 {
+	ret := _m.Called(segmentIDs)
+
+	var r0 [][]int64
+	if rf, ok := ret.Get(0).(func([]int64) [][]int64); ok {
+		r0 = rf(segmentIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([][]int64)
+		}
+	}
+
+	return r0
+}
+
+// MockSegmentManager_SplitByGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SplitByGroup'
+type MockSegmentManager_SplitByGroup_Call struct {
+	*mock.Call
+}
+
+// SplitByGroup is a helper method to define mock.On call
+//   - segmentIDs []int64
+func (_e *MockSegmentManager_Expecter) SplitByGroup(segmentIDs interface{}) *MockSegmentManager_SplitByGroup_Call {
+	return &MockSegmentManager_SplitByGroup_Call{Call: _e.mock.On("SplitByGroup", segmentIDs)}
+}
+
+func (_c *MockSegmentManager_SplitByGroup_Call) Run(run func(segmentIDs []int64)) *MockSegmentManager_SplitByGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]int64))
+	})
+	return _c
+}
+
+func (_c *MockSegmentManager_SplitByGroup_Call) Return(_a0 [][]int64) *MockSegmentManager_SplitByGroup_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSegmentManager_SplitByGroup_Call) RunAndReturn(run func([]int64) [][]int64) *MockSegmentManager_SplitByGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockSegmentManager creates a new instance of MockSegmentManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockSegmentManager(t interface {
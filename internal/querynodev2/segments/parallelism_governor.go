@@ -0,0 +1,159 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/hardware"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+var (
+	governor     *ParallelismGovernor
+	governorOnce sync.Once
+)
+
+// GetParallelismGovernor returns the singleton governor of search parallelism.
+func GetParallelismGovernor() *ParallelismGovernor {
+	governorOnce.Do(func() {
+		governor = newParallelismGovernor(hardware.GetCPUUsage)
+	})
+	return governor
+}
+
+// ParallelismGovernor decides how many segments a search request could search concurrently.
+// The parallelism is halved when the cpu is saturated or search tasks wait too long in queue,
+// and increased one by one when the node becomes idle again,
+// so heavy requests don't occupy all the cgo threads and starve light ones under mixed traffic.
+type ParallelismGovernor struct {
+	getCPUUsage func() float64
+	// 0 means unlimited
+	parallelism atomic.Int32
+
+	mu                sync.Mutex
+	queueLatencySum   time.Duration
+	queueLatencyCount int64
+}
+
+func newParallelismGovernor(getCPUUsage func() float64) *ParallelismGovernor {
+	return &ParallelismGovernor{
+		getCPUUsage: getCPUUsage,
+	}
+}
+
+// Start adjusts the parallelism periodically until the context is done.
+func (g *ParallelismGovernor) Start(ctx context.Context) {
+	interval := paramtable.Get().QueryNodeCfg.ParallelismGovernorInterval.GetAsDuration(time.Millisecond)
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info("parallelism governor quit")
+				return
+			case <-ticker.C:
+				g.adjust()
+			}
+		}
+	}()
+}
+
+// Parallelism returns the max number of segments searched concurrently by a request, 0 means unlimited.
+func (g *ParallelismGovernor) Parallelism() int {
+	return int(g.parallelism.Load())
+}
+
+// ObserveQueueLatency records the time a search task waited in the scheduler queue.
+func (g *ParallelismGovernor) ObserveQueueLatency(latency time.Duration) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.queueLatencySum += latency
+	g.queueLatencyCount++
+}
+
+// averageQueueLatency returns the average queue latency since last call.
+func (g *ParallelismGovernor) averageQueueLatency() time.Duration {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.queueLatencyCount == 0 {
+		return 0
+	}
+	avg := g.queueLatencySum / time.Duration(g.queueLatencyCount)
+	g.queueLatencySum = 0
+	g.queueLatencyCount = 0
+	return avg
+}
+
+func (g *ParallelismGovernor) adjust() {
+	params := &paramtable.Get().QueryNodeCfg
+	queueLatency := g.averageQueueLatency()
+	if !params.ParallelismGovernorEnabled.GetAsBool() {
+		g.setParallelism(0)
+		return
+	}
+
+	minParallelism := params.MinSearchParallelism.GetAsInt()
+	maxParallelism := params.MaxSearchParallelism.GetAsInt()
+	if maxParallelism < minParallelism {
+		maxParallelism = minParallelism
+	}
+	cpuUsage := g.getCPUUsage()
+	latencyThreshold := params.ParallelismQueueLatencyThreshold.GetAsDuration(time.Millisecond)
+
+	current := g.Parallelism()
+	if current == 0 {
+		// governor just enabled, start from the max parallelism
+		current = maxParallelism
+	}
+	next := current
+	switch {
+	case cpuUsage >= params.ParallelismCPUHighWatermark.GetAsFloat() || queueLatency >= latencyThreshold:
+		next = current / 2
+	case cpuUsage < params.ParallelismCPULowWatermark.GetAsFloat() && queueLatency < latencyThreshold/2:
+		next = current + 1
+	}
+	if next < minParallelism {
+		next = minParallelism
+	}
+	if next > maxParallelism {
+		next = maxParallelism
+	}
+
+	if next != g.Parallelism() {
+		log.Info("search parallelism adjusted",
+			zap.Int("from", g.Parallelism()),
+			zap.Int("to", next),
+			zap.Float64("cpuUsage", cpuUsage),
+			zap.Duration("queueLatency", queueLatency))
+	}
+	g.setParallelism(next)
+}
+
+func (g *ParallelismGovernor) setParallelism(parallelism int) {
+	g.parallelism.Store(int32(parallelism))
+	metrics.QueryNodeSearchParallelism.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Set(float64(parallelism))
+}
@@ -0,0 +1,110 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type ParallelismGovernorSuite struct {
+	suite.Suite
+	cpuUsage float64
+	governor *ParallelismGovernor
+}
+
+func (suite *ParallelismGovernorSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (suite *ParallelismGovernorSuite) SetupTest() {
+	params := paramtable.Get()
+	params.Save(params.QueryNodeCfg.ParallelismGovernorEnabled.Key, "true")
+	params.Save(params.QueryNodeCfg.MinSearchParallelism.Key, "2")
+	params.Save(params.QueryNodeCfg.MaxSearchParallelism.Key, "8")
+	suite.cpuUsage = 0
+	suite.governor = newParallelismGovernor(func() float64 { return suite.cpuUsage })
+}
+
+func (suite *ParallelismGovernorSuite) TearDownTest() {
+	params := paramtable.Get()
+	params.Reset(params.QueryNodeCfg.ParallelismGovernorEnabled.Key)
+	params.Reset(params.QueryNodeCfg.MinSearchParallelism.Key)
+	params.Reset(params.QueryNodeCfg.MaxSearchParallelism.Key)
+}
+
+func (suite *ParallelismGovernorSuite) TestAdjustByCPU() {
+	suite.Equal(0, suite.governor.Parallelism())
+
+	suite.cpuUsage = 50
+	suite.governor.adjust()
+	suite.Equal(8, suite.governor.Parallelism())
+
+	suite.cpuUsage = 95
+	suite.governor.adjust()
+	suite.Equal(4, suite.governor.Parallelism())
+	suite.governor.adjust()
+	suite.Equal(2, suite.governor.Parallelism())
+	suite.governor.adjust()
+	suite.Equal(2, suite.governor.Parallelism())
+
+	// keep the parallelism between the watermarks
+	suite.cpuUsage = 80
+	suite.governor.adjust()
+	suite.Equal(2, suite.governor.Parallelism())
+
+	suite.cpuUsage = 10
+	suite.governor.adjust()
+	suite.Equal(3, suite.governor.Parallelism())
+}
+
+func (suite *ParallelismGovernorSuite) TestAdjustByQueueLatency() {
+	suite.cpuUsage = 10
+	suite.governor.adjust()
+	suite.Equal(8, suite.governor.Parallelism())
+
+	suite.governor.ObserveQueueLatency(150 * time.Millisecond)
+	suite.governor.ObserveQueueLatency(100 * time.Millisecond)
+	suite.governor.adjust()
+	suite.Equal(4, suite.governor.Parallelism())
+
+	// the latency is reset after each adjustment
+	suite.governor.adjust()
+	suite.Equal(5, suite.governor.Parallelism())
+
+	suite.governor.ObserveQueueLatency(60 * time.Millisecond)
+	suite.governor.adjust()
+	suite.Equal(5, suite.governor.Parallelism())
+}
+
+func (suite *ParallelismGovernorSuite) TestDisabled() {
+	suite.governor.adjust()
+	suite.Equal(8, suite.governor.Parallelism())
+
+	params := paramtable.Get()
+	params.Save(params.QueryNodeCfg.ParallelismGovernorEnabled.Key, "false")
+	suite.governor.adjust()
+	suite.Equal(0, suite.governor.Parallelism())
+}
+
+func TestParallelismGovernor(t *testing.T) {
+	suite.Run(t, new(ParallelismGovernorSuite))
+}
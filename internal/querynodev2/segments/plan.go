@@ -90,6 +90,7 @@ type SearchRequest struct {
 	timestamp         Timestamp
 	msgID             UniqueID
 	searchFieldID     UniqueID
+	usedFieldIDs      []UniqueID // the fields searched or filtered by the request
 }
 
 func NewSearchRequest(collection *Collection, req *querypb.SearchRequest, placeholderGrp []byte) (*SearchRequest, error) {
@@ -124,12 +125,19 @@ func NewSearchRequest(collection *Collection, req *querypb.SearchRequest, placeh
 		return nil, err
 	}
 
+	usedFieldIDs, err := planFieldIDs(expr)
+	if err != nil {
+		plan.delete()
+		return nil, err
+	}
+
 	ret := &SearchRequest{
 		plan:              plan,
 		cPlaceholderGroup: cPlaceholderGroup,
 		timestamp:         req.Req.GetTravelTimestamp(),
 		msgID:             req.GetReq().GetBase().GetMsgID(),
 		searchFieldID:     int64(fieldID),
+		usedFieldIDs:      usedFieldIDs,
 	}
 
 	return ret, nil
@@ -172,7 +180,8 @@ func parseSearchRequest(plan *SearchPlan, searchRequestBlob []byte) (*SearchRequ
 type RetrievePlan struct {
 	cRetrievePlan C.CRetrievePlan
 	Timestamp     Timestamp
-	msgID         UniqueID // only used to debug.
+	msgID         UniqueID   // only used to debug.
+	usedFieldIDs  []UniqueID // the fields filtered by the plan
 }
 
 func NewRetrievePlan(col *Collection, expr []byte, timestamp Timestamp, msgID UniqueID) (*RetrievePlan, error) {
@@ -183,10 +192,15 @@ func NewRetrievePlan(col *Collection, expr []byte, timestamp Timestamp, msgID Un
 		return nil, merr.WrapErrCollectionNotFound(col.id, "collection released")
 	}
 
+	usedFieldIDs, err := planFieldIDs(expr)
+	if err != nil {
+		return nil, err
+	}
+
 	var cPlan C.CRetrievePlan
 	status := C.CreateRetrievePlanByExpr(col.collectionPtr, unsafe.Pointer(&expr[0]), (C.int64_t)(len(expr)), &cPlan)
 
-	err := HandleCStatus(&status, "Create retrieve plan by expr failed")
+	err = HandleCStatus(&status, "Create retrieve plan by expr failed")
 	if err != nil {
 		return nil, err
 	}
@@ -195,6 +209,7 @@ func NewRetrievePlan(col *Collection, expr []byte, timestamp Timestamp, msgID Un
 		cRetrievePlan: cPlan,
 		Timestamp:     timestamp,
 		msgID:         msgID,
+		usedFieldIDs:  usedFieldIDs,
 	}
 	return newPlan, nil
 }
@@ -35,6 +35,9 @@ var (
 	sqOnce  sync.Once
 	dp      atomic.Pointer[conc.Pool[any]]
 	dynOnce sync.Once
+	// the pool loading the lazy indexes in background
+	pp       atomic.Pointer[conc.Pool[any]]
+	promOnce sync.Once
 )
 
 // initSQPool initialize
@@ -65,6 +68,18 @@ func initDynamicPool() {
 	})
 }
 
+func initPromotionPool() {
+	promOnce.Do(func() {
+		pool := conc.NewPool[any](
+			paramtable.Get().QueryNodeCfg.IndexPromotionConcurrency.GetAsInt(),
+			conc.WithPreAlloc(false),
+			conc.WithDisablePurge(false),
+		)
+
+		pp.Store(pool)
+	})
+}
+
 // GetSQPool returns the singleton pool instance for search/query operations.
 func GetSQPool() *conc.Pool[any] {
 	initSQPool()
@@ -76,3 +91,9 @@ func GetDynamicPool() *conc.Pool[any] {
 	initDynamicPool()
 	return dp.Load()
 }
+
+// GetPromotionPool returns the singleton pool for loading the lazy indexes in background.
+func GetPromotionPool() *conc.Pool[any] {
+	initPromotionPool()
+	return pp.Load()
+}
@@ -226,9 +226,10 @@ func EncodeSearchResultData(searchResultData *schemapb.SearchResultData, nq int6
 	return
 }
 
-func MergeInternalRetrieveResult(ctx context.Context, retrieveResults []*internalpb.RetrieveResults, limit int64) (*internalpb.RetrieveResults, error) {
+func MergeInternalRetrieveResult(ctx context.Context, retrieveResults []*internalpb.RetrieveResults, limit int64, dedup bool) (*internalpb.RetrieveResults, error) {
 	log.Ctx(ctx).Debug("mergeInternelRetrieveResults",
 		zap.Int64("limit", limit),
+		zap.Bool("dedup", dedup),
 		zap.Int("resultNum", len(retrieveResults)),
 	)
 	var (
@@ -260,7 +261,8 @@ func MergeInternalRetrieveResult(ctx context.Context, retrieveResults []*interna
 	ret.FieldsData = make([]*schemapb.FieldData, len(validRetrieveResults[0].GetFieldsData()))
 	idTsMap := make(map[interface{}]uint64)
 	cursors := make([]int64, len(validRetrieveResults))
-	for j := 0; j < loopEnd; j++ {
+	// with dedup, duplicates are not counted into the limit
+	for j := 0; j < loopEnd; {
 		sel := typeutil.SelectMinPK(validRetrieveResults, cursors)
 		if sel == -1 {
 			break
@@ -272,6 +274,7 @@ func MergeInternalRetrieveResult(ctx context.Context, retrieveResults []*interna
 			typeutil.AppendPKs(ret.Ids, pk)
 			typeutil.AppendFieldData(ret.FieldsData, validRetrieveResults[sel].GetFieldsData(), cursors[sel])
 			idTsMap[pk] = ts
+			j++
 		} else {
 			// primary keys duplicate
 			skipDupCnt++
@@ -280,6 +283,9 @@ func MergeInternalRetrieveResult(ctx context.Context, retrieveResults []*interna
 				typeutil.DeleteFieldData(ret.FieldsData)
 				typeutil.AppendFieldData(ret.FieldsData, validRetrieveResults[sel].GetFieldsData(), cursors[sel])
 			}
+			if !dedup {
+				j++
+			}
 		}
 		cursors[sel]++
 	}
@@ -313,9 +319,10 @@ func getTS(i *internalpb.RetrieveResults, idx int64) uint64 {
 	return 0
 }
 
-func MergeSegcoreRetrieveResults(ctx context.Context, retrieveResults []*segcorepb.RetrieveResults, limit int64) (*segcorepb.RetrieveResults, error) {
+func MergeSegcoreRetrieveResults(ctx context.Context, retrieveResults []*segcorepb.RetrieveResults, limit int64, dedup bool) (*segcorepb.RetrieveResults, error) {
 	log.Ctx(ctx).Debug("mergeSegcoreRetrieveResults",
 		zap.Int64("limit", limit),
+		zap.Bool("dedup", dedup),
 		zap.Int("resultNum", len(retrieveResults)),
 	)
 	var (
@@ -349,7 +356,8 @@ func MergeSegcoreRetrieveResults(ctx context.Context, retrieveResults []*segcore
 	ret.FieldsData = make([]*schemapb.FieldData, len(validRetrieveResults[0].GetFieldsData()))
 	idSet := make(map[interface{}]struct{})
 	cursors := make([]int64, len(validRetrieveResults))
-	for j := 0; j < loopEnd; j++ {
+	// with dedup, duplicates are not counted into the limit
+	for j := 0; j < loopEnd; {
 		sel := typeutil.SelectMinPK(validRetrieveResults, cursors)
 		if sel == -1 {
 			break
@@ -360,9 +368,13 @@ func MergeSegcoreRetrieveResults(ctx context.Context, retrieveResults []*segcore
 			typeutil.AppendPKs(ret.Ids, pk)
 			typeutil.AppendFieldData(ret.FieldsData, validRetrieveResults[sel].GetFieldsData(), cursors[sel])
 			idSet[pk] = struct{}{}
+			j++
 		} else {
 			// primary keys duplicate
 			skipDupCnt++
+			if !dedup {
+				j++
+			}
 		}
 		cursors[sel]++
 	}
@@ -378,11 +390,12 @@ func mergeInternalRetrieveResultsAndFillIfEmpty(
 	ctx context.Context,
 	retrieveResults []*internalpb.RetrieveResults,
 	limit int64,
+	dedup bool,
 	outputFieldsID []int64,
 	schema *schemapb.CollectionSchema,
 ) (*internalpb.RetrieveResults, error) {
 
-	mergedResult, err := MergeInternalRetrieveResult(ctx, retrieveResults, limit)
+	mergedResult, err := MergeInternalRetrieveResult(ctx, retrieveResults, limit, dedup)
 	if err != nil {
 		return nil, err
 	}
@@ -398,11 +411,12 @@ func mergeSegcoreRetrieveResultsAndFillIfEmpty(
 	ctx context.Context,
 	retrieveResults []*segcorepb.RetrieveResults,
 	limit int64,
+	dedup bool,
 	outputFieldsID []int64,
 	schema *schemapb.CollectionSchema,
 ) (*segcorepb.RetrieveResults, error) {
 
-	mergedResult, err := MergeSegcoreRetrieveResults(ctx, retrieveResults, limit)
+	mergedResult, err := MergeSegcoreRetrieveResults(ctx, retrieveResults, limit, dedup)
 	if err != nil {
 		return nil, err
 	}
@@ -79,7 +79,7 @@ func (suite *ResultSuite) TestResult_MergeSegcoreRetrieveResults() {
 			FieldsData: fieldDataArray2,
 		}
 
-		result, err := MergeSegcoreRetrieveResults(context.Background(), []*segcorepb.RetrieveResults{result1, result2}, typeutil.Unlimited)
+		result, err := MergeSegcoreRetrieveResults(context.Background(), []*segcorepb.RetrieveResults{result1, result2}, typeutil.Unlimited, false)
 		suite.NoError(err)
 		suite.Equal(2, len(result.GetFieldsData()))
 		suite.Equal([]int64{0, 1}, result.GetIds().GetIntId().GetData())
@@ -87,8 +87,44 @@ func (suite *ResultSuite) TestResult_MergeSegcoreRetrieveResults() {
 		suite.InDeltaSlice(FloatVector, result.FieldsData[1].GetVectors().GetFloatVector().Data, 10e-10)
 	})
 
+	suite.Run("test limit with dupPK", func() {
+		result1 := &segcorepb.RetrieveResults{
+			Ids: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{
+					IntId: &schemapb.LongArray{
+						Data: []int64{0, 1},
+					},
+				},
+			},
+			Offset:     []int64{0, 1},
+			FieldsData: fieldDataArray1,
+		}
+		result2 := &segcorepb.RetrieveResults{
+			Ids: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{
+					IntId: &schemapb.LongArray{
+						Data: []int64{0, 1},
+					},
+				},
+			},
+			Offset:     []int64{0, 1},
+			FieldsData: fieldDataArray2,
+		}
+
+		// duplicated pk counts into the limit by default
+		result, err := MergeSegcoreRetrieveResults(context.Background(), []*segcorepb.RetrieveResults{result1, result2}, 2, false)
+		suite.NoError(err)
+		suite.Equal([]int64{0}, result.GetIds().GetIntId().GetData())
+
+		// duplicated pk doesn't count into the limit with dedup
+		result, err = MergeSegcoreRetrieveResults(context.Background(), []*segcorepb.RetrieveResults{result1, result2}, 2, true)
+		suite.NoError(err)
+		suite.Equal([]int64{0, 1}, result.GetIds().GetIntId().GetData())
+		suite.Equal(Int64Array, result.GetFieldsData()[0].GetScalars().GetLongData().Data)
+	})
+
 	suite.Run("test nil results", func() {
-		ret, err := MergeSegcoreRetrieveResults(context.Background(), nil, typeutil.Unlimited)
+		ret, err := MergeSegcoreRetrieveResults(context.Background(), nil, typeutil.Unlimited, false)
 		suite.NoError(err)
 		suite.Empty(ret.GetIds())
 		suite.Empty(ret.GetFieldsData())
@@ -106,7 +142,7 @@ func (suite *ResultSuite) TestResult_MergeSegcoreRetrieveResults() {
 			FieldsData: fieldDataArray1,
 		}
 
-		ret, err := MergeSegcoreRetrieveResults(context.Background(), []*segcorepb.RetrieveResults{r}, typeutil.Unlimited)
+		ret, err := MergeSegcoreRetrieveResults(context.Background(), []*segcorepb.RetrieveResults{r}, typeutil.Unlimited, false)
 		suite.NoError(err)
 		suite.Empty(ret.GetIds())
 		suite.Empty(ret.GetFieldsData())
@@ -156,7 +192,7 @@ func (suite *ResultSuite) TestResult_MergeSegcoreRetrieveResults() {
 			resultField0 := []int64{11, 11, 22, 22}
 			for _, test := range tests {
 				suite.Run(test.description, func() {
-					result, err := MergeSegcoreRetrieveResults(context.Background(), []*segcorepb.RetrieveResults{r1, r2}, test.limit)
+					result, err := MergeSegcoreRetrieveResults(context.Background(), []*segcorepb.RetrieveResults{r1, r2}, test.limit, false)
 					suite.Equal(2, len(result.GetFieldsData()))
 					suite.Equal(int(test.limit), len(result.GetIds().GetIntId().GetData()))
 					suite.Equal(resultIDs[0:test.limit], result.GetIds().GetIntId().GetData())
@@ -168,7 +204,7 @@ func (suite *ResultSuite) TestResult_MergeSegcoreRetrieveResults() {
 		})
 
 		suite.Run("test int ID", func() {
-			result, err := MergeSegcoreRetrieveResults(context.Background(), []*segcorepb.RetrieveResults{r1, r2}, typeutil.Unlimited)
+			result, err := MergeSegcoreRetrieveResults(context.Background(), []*segcorepb.RetrieveResults{r1, r2}, typeutil.Unlimited, false)
 			suite.Equal(2, len(result.GetFieldsData()))
 			suite.Equal([]int64{1, 2, 3, 4}, result.GetIds().GetIntId().GetData())
 			suite.Equal([]int64{11, 11, 22, 22}, result.GetFieldsData()[0].GetScalars().GetLongData().Data)
@@ -189,7 +225,7 @@ func (suite *ResultSuite) TestResult_MergeSegcoreRetrieveResults() {
 						Data: []string{"b", "d"},
 					}}}
 
-			result, err := MergeSegcoreRetrieveResults(context.Background(), []*segcorepb.RetrieveResults{r1, r2}, typeutil.Unlimited)
+			result, err := MergeSegcoreRetrieveResults(context.Background(), []*segcorepb.RetrieveResults{r1, r2}, typeutil.Unlimited, false)
 			suite.NoError(err)
 			suite.Equal(2, len(result.GetFieldsData()))
 			suite.Equal([]string{"a", "b", "c", "d"}, result.GetIds().GetStrId().GetData())
@@ -242,7 +278,7 @@ func (suite *ResultSuite) TestResult_MergeInternalRetrieveResults() {
 			FieldsData: fieldDataArray2,
 		}
 
-		result, err := MergeInternalRetrieveResult(context.Background(), []*internalpb.RetrieveResults{result1, result2}, typeutil.Unlimited)
+		result, err := MergeInternalRetrieveResult(context.Background(), []*internalpb.RetrieveResults{result1, result2}, typeutil.Unlimited, false)
 		suite.NoError(err)
 		suite.Equal(2, len(result.GetFieldsData()))
 		suite.Equal([]int64{0, 1}, result.GetIds().GetIntId().GetData())
@@ -250,8 +286,42 @@ func (suite *ResultSuite) TestResult_MergeInternalRetrieveResults() {
 		suite.InDeltaSlice(FloatVector, result.FieldsData[1].GetVectors().GetFloatVector().Data, 10e-10)
 	})
 
+	suite.Run("test limit with dupPK", func() {
+		result1 := &internalpb.RetrieveResults{
+			Ids: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{
+					IntId: &schemapb.LongArray{
+						Data: []int64{0, 1},
+					},
+				},
+			},
+			FieldsData: fieldDataArray1,
+		}
+		result2 := &internalpb.RetrieveResults{
+			Ids: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{
+					IntId: &schemapb.LongArray{
+						Data: []int64{0, 1},
+					},
+				},
+			},
+			FieldsData: fieldDataArray2,
+		}
+
+		// duplicated pk counts into the limit by default
+		result, err := MergeInternalRetrieveResult(context.Background(), []*internalpb.RetrieveResults{result1, result2}, 2, false)
+		suite.NoError(err)
+		suite.Equal([]int64{0}, result.GetIds().GetIntId().GetData())
+
+		// duplicated pk doesn't count into the limit with dedup
+		result, err = MergeInternalRetrieveResult(context.Background(), []*internalpb.RetrieveResults{result1, result2}, 2, true)
+		suite.NoError(err)
+		suite.Equal([]int64{0, 1}, result.GetIds().GetIntId().GetData())
+		suite.Equal(Int64Array, result.GetFieldsData()[0].GetScalars().GetLongData().Data)
+	})
+
 	suite.Run("test nil results", func() {
-		ret, err := MergeInternalRetrieveResult(context.Background(), nil, typeutil.Unlimited)
+		ret, err := MergeInternalRetrieveResult(context.Background(), nil, typeutil.Unlimited, false)
 		suite.NoError(err)
 		suite.Empty(ret.GetIds())
 		suite.Empty(ret.GetFieldsData())
@@ -286,7 +356,7 @@ func (suite *ResultSuite) TestResult_MergeInternalRetrieveResults() {
 					[]int64{7, 8}, 1),
 			},
 		}
-		result, err := MergeInternalRetrieveResult(context.Background(), []*internalpb.RetrieveResults{ret1, ret2}, typeutil.Unlimited)
+		result, err := MergeInternalRetrieveResult(context.Background(), []*internalpb.RetrieveResults{ret1, ret2}, typeutil.Unlimited, false)
 		suite.NoError(err)
 		suite.Equal(2, len(result.GetFieldsData()))
 		suite.Equal([]int64{0, 1}, result.GetIds().GetIntId().GetData())
@@ -335,7 +405,7 @@ func (suite *ResultSuite) TestResult_MergeInternalRetrieveResults() {
 			resultField0 := []int64{11, 11, 22, 22}
 			for _, test := range tests {
 				suite.Run(test.description, func() {
-					result, err := MergeInternalRetrieveResult(context.Background(), []*internalpb.RetrieveResults{r1, r2}, test.limit)
+					result, err := MergeInternalRetrieveResult(context.Background(), []*internalpb.RetrieveResults{r1, r2}, test.limit, false)
 					suite.Equal(2, len(result.GetFieldsData()))
 					suite.Equal(int(test.limit), len(result.GetIds().GetIntId().GetData()))
 					suite.Equal(resultIDs[0:test.limit], result.GetIds().GetIntId().GetData())
@@ -347,7 +417,7 @@ func (suite *ResultSuite) TestResult_MergeInternalRetrieveResults() {
 		})
 
 		suite.Run("test int ID", func() {
-			result, err := MergeInternalRetrieveResult(context.Background(), []*internalpb.RetrieveResults{r1, r2}, typeutil.Unlimited)
+			result, err := MergeInternalRetrieveResult(context.Background(), []*internalpb.RetrieveResults{r1, r2}, typeutil.Unlimited, false)
 			suite.Equal(2, len(result.GetFieldsData()))
 			suite.Equal([]int64{1, 2, 3, 4}, result.GetIds().GetIntId().GetData())
 			suite.Equal([]int64{11, 11, 22, 22}, result.GetFieldsData()[0].GetScalars().GetLongData().Data)
@@ -372,7 +442,7 @@ func (suite *ResultSuite) TestResult_MergeInternalRetrieveResults() {
 				},
 			}
 
-			result, err := MergeInternalRetrieveResult(context.Background(), []*internalpb.RetrieveResults{r1, r2}, typeutil.Unlimited)
+			result, err := MergeInternalRetrieveResult(context.Background(), []*internalpb.RetrieveResults{r1, r2}, typeutil.Unlimited, false)
 			suite.NoError(err)
 			suite.Equal(2, len(result.GetFieldsData()))
 			suite.Equal([]string{"a", "b", "c", "d"}, result.GetIds().GetStrId().GetData())
@@ -52,6 +52,7 @@ func retrieveOnSegments(ctx context.Context, manager *Manager, segType SegmentTy
 				errs[i] = nil
 				return
 			}
+			segment.touchFields(plan.usedFieldIDs)
 			tr := timerecord.NewTimeRecorder("retrieveOnSegments")
 			result, err := segment.Retrieve(ctx, plan)
 			if err != nil {
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
@@ -33,10 +34,16 @@ import (
 // searchOnSegments performs search on listed segments
 // all segment ids are validated before calling this function
 func searchSegments(ctx context.Context, manager *Manager, segType SegmentType, searchReq *SearchRequest, segIDs []int64) ([]*SearchResult, error) {
+	// the tiny sealed segments of a group are searched one by one as a single unit
+	units := lo.Map(segIDs, func(segID int64, _ int) []int64 { return []int64{segID} })
+	if segType == SegmentTypeSealed {
+		units = manager.Segment.SplitByGroup(segIDs)
+	}
+
 	var (
 		// results variables
 		resultCh = make(chan *SearchResult, len(segIDs))
-		errs     = make([]error, len(segIDs))
+		errs     = make([]error, len(units))
 		wg       sync.WaitGroup
 
 		// For log only
@@ -49,34 +56,56 @@ func searchSegments(ctx context.Context, manager *Manager, segType SegmentType,
 		searchLabel = metrics.GrowingSegmentLabel
 	}
 
+	// limit the units searched concurrently if the node is busy
+	var limiter chan struct{}
+	if parallelism := GetParallelismGovernor().Parallelism(); parallelism > 0 && parallelism < len(units) {
+		limiter = make(chan struct{}, parallelism)
+	}
+
+	searchSegment := func(segID int64) error {
+		seg, _ := manager.Segment.GetWithType(segID, segType).(*LocalSegment)
+		if seg == nil {
+			log.Warn("segment released while searching", zap.Int64("segmentID", segID))
+			return nil
+		}
+
+		seg.touchFields(searchReq.usedFieldIDs)
+		if !seg.ExistIndex(searchReq.searchFieldID) {
+			mu.Lock()
+			segmentsWithoutIndex = append(segmentsWithoutIndex, segID)
+			mu.Unlock()
+		}
+		// record search time
+		tr := timerecord.NewTimeRecorder("searchOnSegments")
+		searchResult, err := seg.Search(ctx, searchReq)
+		resultCh <- searchResult
+		// update metrics
+		elapsed := tr.ElapseSpan().Milliseconds()
+		metrics.QueryNodeSQSegmentLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()),
+			metrics.SearchLabel, searchLabel).Observe(float64(elapsed))
+		metrics.QueryNodeSegmentSearchLatencyPerVector.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()),
+			metrics.SearchLabel, searchLabel).Observe(float64(elapsed) / float64(searchReq.getNumOfQuery()))
+		return err
+	}
+
 	// calling segment search in goroutines
-	for i, segID := range segIDs {
+	for i, unit := range units {
+		if limiter != nil {
+			limiter <- struct{}{}
+		}
 		wg.Add(1)
-		go func(segID int64, i int) {
+		go func(unit []int64, i int) {
 			defer wg.Done()
-			seg, _ := manager.Segment.GetWithType(segID, segType).(*LocalSegment)
-			if seg == nil {
-				log.Warn("segment released while searching", zap.Int64("segmentID", segID))
-				return
+			if limiter != nil {
+				defer func() { <-limiter }()
 			}
-
-			if !seg.ExistIndex(searchReq.searchFieldID) {
-				mu.Lock()
-				segmentsWithoutIndex = append(segmentsWithoutIndex, segID)
-				mu.Unlock()
+			for _, segID := range unit {
+				if err := searchSegment(segID); err != nil {
+					errs[i] = err
+					return
+				}
 			}
-			// record search time
-			tr := timerecord.NewTimeRecorder("searchOnSegments")
-			searchResult, err := seg.Search(ctx, searchReq)
-			errs[i] = err
-			resultCh <- searchResult
-			// update metrics
-			elapsed := tr.ElapseSpan().Milliseconds()
-			metrics.QueryNodeSQSegmentLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()),
-				metrics.SearchLabel, searchLabel).Observe(float64(elapsed))
-			metrics.QueryNodeSegmentSearchLatencyPerVector.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()),
-				metrics.SearchLabel, searchLabel).Observe(float64(elapsed) / float64(searchReq.getNumOfQuery()))
-		}(segID, i)
+		}(unit, i)
 	}
 	wg.Wait()
 	close(resultCh)
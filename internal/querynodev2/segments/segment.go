@@ -74,6 +74,12 @@ type IndexedFieldInfo struct {
 	IndexInfo   *querypb.FieldIndexInfo
 }
 
+// lazyIndex is an index whose field is served by the raw data until the index is loaded on first use.
+type lazyIndex struct {
+	fieldType schemapb.DataType
+	promoting atomic.Bool
+}
+
 type Segment interface {
 	// Properties
 	ID() int64
@@ -183,6 +189,7 @@ type LocalSegment struct {
 	row                int64
 	lastDeltaTimestamp *atomic.Uint64
 	fieldIndexes       *typeutil.ConcurrentMap[int64, *IndexedFieldInfo]
+	lazyIndexes        *typeutil.ConcurrentMap[int64, *lazyIndex] // the indexes not loaded yet, fieldID -> index
 }
 
 func NewSegment(collection *Collection,
@@ -220,6 +227,7 @@ func NewSegment(collection *Collection,
 		ptr:                segmentPtr,
 		lastDeltaTimestamp: atomic.NewUint64(deltaPosition.GetTimestamp()),
 		fieldIndexes:       typeutil.NewConcurrentMap[int64, *IndexedFieldInfo](),
+		lazyIndexes:        typeutil.NewConcurrentMap[int64, *lazyIndex](),
 	}
 
 	return segment, nil
@@ -290,7 +298,17 @@ func (s *LocalSegment) GetIndex(fieldID int64) *IndexedFieldInfo {
 	return info
 }
 
+// AddLazyIndex adds an index whose field has its raw data loaded, the index is loaded in background
+// once the field is used. The index is reported as loaded, but ExistIndex returns false until it's actually loaded.
+func (s *LocalSegment) AddLazyIndex(fieldID int64, info *IndexedFieldInfo, fieldType schemapb.DataType) {
+	s.lazyIndexes.Insert(fieldID, &lazyIndex{fieldType: fieldType})
+	s.fieldIndexes.Insert(fieldID, info)
+}
+
 func (s *LocalSegment) ExistIndex(fieldID int64) bool {
+	if s.lazyIndexes.Contain(fieldID) {
+		return false
+	}
 	fieldInfo, ok := s.fieldIndexes.Get(fieldID)
 	if !ok {
 		return false
@@ -298,6 +316,37 @@ func (s *LocalSegment) ExistIndex(fieldID int64) bool {
 	return fieldInfo.IndexInfo != nil && fieldInfo.IndexInfo.EnableIndex
 }
 
+// IsLazyIndex returns whether the index of the field is added but not loaded yet.
+func (s *LocalSegment) IsLazyIndex(fieldID int64) bool {
+	return s.lazyIndexes.Contain(fieldID)
+}
+
+// touchFields loads the lazy indexes of the fields in background, the fields are served by the raw data
+// until the indexes are loaded.
+func (s *LocalSegment) touchFields(fieldIDs []int64) {
+	for _, fieldID := range fieldIDs {
+		index, ok := s.lazyIndexes.Get(fieldID)
+		if !ok || !index.promoting.CompareAndSwap(false, true) {
+			continue
+		}
+		fieldID := fieldID
+		GetPromotionPool().Submit(func() (any, error) {
+			err := s.promoteIndex(fieldID, index.fieldType)
+			if err != nil {
+				log.Warn("failed to load lazy index, retry on next use",
+					zap.Int64("collectionID", s.Collection()),
+					zap.Int64("segmentID", s.ID()),
+					zap.Int64("fieldID", fieldID),
+					zap.Error(err))
+				index.promoting.Store(false)
+				return nil, err
+			}
+			s.lazyIndexes.GetAndRemove(fieldID)
+			return nil, nil
+		})
+	}
+}
+
 func (s *LocalSegment) HasRawData(fieldID int64) bool {
 	s.mut.RLock()
 	defer s.mut.RUnlock()
@@ -855,6 +904,50 @@ func (s *LocalSegment) LoadIndex(indexInfo *querypb.FieldIndexInfo, fieldType sc
 	return s.LoadIndexInfo(indexInfo, loadIndexInfo)
 }
 
+// promoteIndex loads the lazy index of the field. Loading the index releases the raw data of the field,
+// so the segment is locked exclusively to wait for the running searches and retrievals.
+func (s *LocalSegment) promoteIndex(fieldID int64, fieldType schemapb.DataType) error {
+	index := s.GetIndex(fieldID)
+	if index == nil {
+		return merr.WrapErrIndexNotFound()
+	}
+	loadIndexInfo, err := newLoadIndexInfo()
+	defer deleteLoadIndexInfo(loadIndexInfo)
+	if err != nil {
+		return err
+	}
+	err = loadIndexInfo.appendLoadIndexInfo(index.IndexInfo, s.collectionID, s.partitionID, s.segmentID, fieldType)
+	if err != nil {
+		if loadIndexInfo.cleanLocalData() != nil {
+			log.Warn("failed to clean cached data on disk after append index failed",
+				zap.Int64("buildID", index.IndexInfo.GetBuildID()),
+				zap.Int64("index version", index.IndexInfo.GetIndexVersion()))
+		}
+		return err
+	}
+
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	if s.ptr == nil {
+		return merr.WrapErrSegmentNotLoaded(s.segmentID, "segment released")
+	}
+
+	var status C.CStatus
+	GetDynamicPool().Submit(func() (any, error) {
+		status = C.UpdateSealedSegmentIndex(s.ptr, loadIndexInfo.cLoadIndexInfo)
+		return nil, nil
+	}).Await()
+	if err := HandleCStatus(&status, "UpdateSealedSegmentIndex failed"); err != nil {
+		return err
+	}
+	log.Info("lazy index loaded",
+		zap.Int64("collectionID", s.Collection()),
+		zap.Int64("segmentID", s.ID()),
+		zap.Int64("fieldID", fieldID))
+	return nil
+}
+
 func (s *LocalSegment) LoadIndexInfo(indexInfo *querypb.FieldIndexInfo, info *LoadIndexInfo) error {
 	log := log.With(
 		zap.Int64("collectionID", s.Collection()),
@@ -0,0 +1,110 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// segmentGroups groups the tiny sealed segments of the same partition for the search scheduling,
+// which are produced massively by frequent flushes before the compaction catches up.
+// A group is searched as a single unit, the segments of the group are searched one by one
+// by a single worker, so a search request fans out to much fewer units.
+// It doesn't merge the segments, each segment is still loaded and stored separately in segcore
+// with its own vector data, merging the data is left to the compaction.
+//
+// The segments are grouped into the open group of the partition until it's full,
+// the groups are not guarded by itself but by the lock of the segment manager.
+type segmentGroups struct {
+	nextID   int64
+	groups   map[int64][]int64 // groupID -> segmentIDs
+	segments map[int64]int64   // segmentID -> groupID
+	open     map[int64]int64   // partitionID -> groupID accepting new segments
+}
+
+func newSegmentGroups() *segmentGroups {
+	return &segmentGroups{
+		groups:   make(map[int64][]int64),
+		segments: make(map[int64]int64),
+		open:     make(map[int64]int64),
+	}
+}
+
+// add puts the segment into the open group of its partition if the segment is tiny enough.
+func (g *segmentGroups) add(segment Segment) {
+	params := &paramtable.Get().QueryNodeCfg
+	if !params.SegmentGroupEnabled.GetAsBool() || segment.RowNum() > params.SegmentGroupMaxRowNum.GetAsInt64() {
+		return
+	}
+	if _, ok := g.segments[segment.ID()]; ok {
+		return
+	}
+	groupID, ok := g.open[segment.Partition()]
+	if !ok || len(g.groups[groupID]) >= params.SegmentGroupMaxSize.GetAsInt() {
+		g.nextID++
+		groupID = g.nextID
+		g.open[segment.Partition()] = groupID
+	}
+	g.groups[groupID] = append(g.groups[groupID], segment.ID())
+	g.segments[segment.ID()] = groupID
+}
+
+func (g *segmentGroups) remove(segmentID int64) {
+	groupID, ok := g.segments[segmentID]
+	if !ok {
+		return
+	}
+	delete(g.segments, segmentID)
+	members := lo.Without(g.groups[groupID], segmentID)
+	if len(members) > 0 {
+		g.groups[groupID] = members
+		return
+	}
+	delete(g.groups, groupID)
+	for partitionID, open := range g.open {
+		if open == groupID {
+			delete(g.open, partitionID)
+		}
+	}
+}
+
+func (g *segmentGroups) clear() {
+	*g = *newSegmentGroups()
+}
+
+// split splits the segments into the search units, the segments of the same group form a single unit,
+// other segments form a unit each. The units are ordered by their first segments.
+func (g *segmentGroups) split(segmentIDs []int64) [][]int64 {
+	units := make([][]int64, 0, len(segmentIDs))
+	unitOfGroup := make(map[int64]int) // groupID -> offset of the unit
+	for _, segmentID := range segmentIDs {
+		groupID, ok := g.segments[segmentID]
+		if !ok {
+			units = append(units, []int64{segmentID})
+			continue
+		}
+		if offset, ok := unitOfGroup[groupID]; ok {
+			units[offset] = append(units[offset], segmentID)
+			continue
+		}
+		unitOfGroup[groupID] = len(units)
+		units = append(units, []int64{segmentID})
+	}
+	return units
+}
@@ -0,0 +1,76 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestSegmentGroups(t *testing.T) {
+	paramtable.Init()
+	params := &paramtable.Get().QueryNodeCfg
+	paramtable.Get().Save(params.SegmentGroupEnabled.Key, "true")
+	defer paramtable.Get().Reset(params.SegmentGroupEnabled.Key)
+	paramtable.Get().Save(params.SegmentGroupMaxRowNum.Key, "100")
+	defer paramtable.Get().Reset(params.SegmentGroupMaxRowNum.Key)
+	paramtable.Get().Save(params.SegmentGroupMaxSize.Key, "2")
+	defer paramtable.Get().Reset(params.SegmentGroupMaxSize.Key)
+
+	newSegment := func(id, partitionID, rows int64) Segment {
+		segment := NewMockSegment(t)
+		segment.EXPECT().ID().Return(id).Maybe()
+		segment.EXPECT().Partition().Return(partitionID).Maybe()
+		segment.EXPECT().RowNum().Return(rows).Maybe()
+		return segment
+	}
+
+	groups := newSegmentGroups()
+	groups.add(newSegment(1, 10, 10))
+	groups.add(newSegment(2, 10, 10))
+	// the group of partition 10 is full
+	groups.add(newSegment(3, 10, 10))
+	groups.add(newSegment(4, 11, 10))
+	// too large to group
+	groups.add(newSegment(5, 10, 1000))
+
+	assert.Equal(t, [][]int64{{1, 2}, {3}, {4}, {5}}, groups.split([]int64{1, 2, 3, 4, 5}))
+	assert.Equal(t, [][]int64{{5}, {2, 1}, {6}}, groups.split([]int64{5, 2, 6, 1}))
+
+	groups.remove(2)
+	groups.add(newSegment(6, 10, 10))
+	// segment 6 joins the open group with segment 3
+	assert.Equal(t, [][]int64{{1}, {3, 6}}, groups.split([]int64{1, 3, 6}))
+
+	groups.remove(3)
+	groups.remove(6)
+	assert.NotContains(t, groups.open, int64(10))
+	groups.add(newSegment(7, 10, 10))
+	assert.Equal(t, [][]int64{{1}, {7}}, groups.split([]int64{1, 7}))
+
+	groups.clear()
+	assert.Equal(t, [][]int64{{1}, {4}}, groups.split([]int64{1, 4}))
+
+	// disabled
+	paramtable.Get().Save(params.SegmentGroupEnabled.Key, "false")
+	groups.add(newSegment(8, 12, 10))
+	groups.add(newSegment(9, 12, 10))
+	assert.Equal(t, [][]int64{{8}, {9}}, groups.split([]int64{8, 9}))
+}
@@ -55,6 +55,9 @@ import (
 
 const (
 	UsedDiskMemoryRatio = 4
+
+	// the local disk usage reported is cached for the interval to avoid walking the local storage on every heartbeat
+	diskUsageCacheInterval = 10 * time.Second
 )
 
 var (
@@ -73,6 +76,10 @@ type Loader interface {
 
 	// LoadIndex append index for segment and remove vector binlogs.
 	LoadIndex(ctx context.Context, segment *LocalSegment, info *querypb.SegmentLoadInfo) error
+
+	// DiskUsage returns the local disk size used by loaded segments,
+	// and the disk size committed to the segments being loaded.
+	DiskUsage() (uint64, uint64, error)
 }
 
 func NewLoader(
@@ -119,6 +126,11 @@ type segmentLoader struct {
 	loadingSegments   *typeutil.ConcurrentMap[int64, chan struct{}]
 	committedMemSize  uint64
 	committedDiskSize uint64
+
+	// the cached local disk usage, it's refreshed after the interval or once segments loaded
+	diskUsageMut       sync.Mutex
+	localDiskUsage     uint64
+	localDiskUsageTime time.Time
 }
 
 var _ Loader = (*segmentLoader)(nil)
@@ -145,6 +157,11 @@ func (loader *segmentLoader) Load(ctx context.Context,
 	// continue to wait other task done
 	log.Info("start loading...", zap.Int("segmentNum", len(segments)), zap.Int("afterFilter", len(infos)))
 
+	if err := checkIndexEngineVersion(infos...); err != nil {
+		log.Warn("failed to load segments", zap.Error(err))
+		return nil, err
+	}
+
 	// Check memory & storage limit
 	memUsage, diskUsage, concurrencyLevel, err := loader.requestResource(ctx, infos...)
 	if err != nil {
@@ -206,7 +223,7 @@ func (loader *segmentLoader) Load(ctx context.Context,
 		log.Info("load segment done", zap.Int64("segmentID", segmentID))
 		loader.notifyLoadFinish(loadInfo)
 
-		metrics.QueryNodeLoadSegmentLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Observe(float64(tr.ElapseSpan().Milliseconds()))
+		metrics.ObserveWithTrace(ctx, metrics.QueryNodeLoadSegmentLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())), float64(tr.ElapseSpan().Milliseconds()))
 		return nil
 	}
 
@@ -237,6 +254,21 @@ func (loader *segmentLoader) Load(ctx context.Context,
 	return loaded, nil
 }
 
+// checkIndexEngineVersion fails the load if any index is built by a newer version than the node supports,
+// which happens after partial upgrades, otherwise segcore fails opaquely after loading part of the index.
+func checkIndexEngineVersion(infos ...*querypb.SegmentLoadInfo) error {
+	for _, info := range infos {
+		for _, indexInfo := range info.GetIndexInfos() {
+			if indexInfo.GetIndexEngineVersion() > common.CurrentIndexEngineVersion {
+				return merr.WrapErrIndexEngineVersionNotSupport(info.GetSegmentID(),
+					indexInfo.GetIndexEngineVersion(), common.CurrentIndexEngineVersion,
+					fmt.Sprintf("fieldID=%d indexID=%d", indexInfo.GetFieldID(), indexInfo.GetIndexID()))
+			}
+		}
+	}
+	return nil
+}
+
 func (loader *segmentLoader) prepare(segmentType SegmentType, segments ...*querypb.SegmentLoadInfo) []*querypb.SegmentLoadInfo {
 	loader.mut.Lock()
 	defer loader.mut.Unlock()
@@ -334,6 +366,39 @@ func (loader *segmentLoader) freeRequest(memUsage, diskUsage uint64) {
 
 	loader.committedMemSize -= memUsage
 	loader.committedDiskSize -= diskUsage
+
+	// the loaded segments occupy the disk committed
+	loader.diskUsageMut.Lock()
+	loader.localDiskUsageTime = time.Time{}
+	loader.diskUsageMut.Unlock()
+}
+
+func (loader *segmentLoader) DiskUsage() (uint64, uint64, error) {
+	localDiskUsage, err := loader.cachedLocalDiskUsage()
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "get local used size failed")
+	}
+
+	loader.mut.Lock()
+	defer loader.mut.Unlock()
+	return localDiskUsage, loader.committedDiskSize, nil
+}
+
+// cachedLocalDiskUsage returns the cached local disk usage, and walks the local storage only if the cache is stale.
+func (loader *segmentLoader) cachedLocalDiskUsage() (uint64, error) {
+	loader.diskUsageMut.Lock()
+	defer loader.diskUsageMut.Unlock()
+
+	if time.Since(loader.localDiskUsageTime) < diskUsageCacheInterval {
+		return loader.localDiskUsage, nil
+	}
+	localDiskUsage, err := GetLocalUsedSize(paramtable.Get().LocalStorageCfg.Path.GetValue())
+	if err != nil {
+		return 0, err
+	}
+	loader.localDiskUsage = uint64(localDiskUsage)
+	loader.localDiskUsageTime = time.Now()
+	return loader.localDiskUsage, nil
 }
 
 func (loader *segmentLoader) waitSegmentLoadDone(ctx context.Context, segmentType SegmentType, segmentIDs ...int64) error {
@@ -453,6 +518,7 @@ func (loader *segmentLoader) loadSegment(ctx context.Context,
 		}
 
 		indexedFieldInfos := make(map[int64]*IndexedFieldInfo)
+		lazyIndexedFieldInfos := make(map[int64]*IndexedFieldInfo)
 		fieldBinlogs := make([]*datapb.FieldBinlog, 0, len(loadInfo.BinlogPaths))
 
 		for _, fieldBinlog := range loadInfo.BinlogPaths {
@@ -463,7 +529,13 @@ func (loader *segmentLoader) loadSegment(ctx context.Context,
 					FieldBinlog: fieldBinlog,
 					IndexInfo:   indexInfo,
 				}
-				indexedFieldInfos[fieldID] = fieldInfo
+				if isLazyIndex(indexInfo, fieldID == pkField.GetFieldID()) {
+					// the raw data serves the field until the index is loaded on first use
+					lazyIndexedFieldInfos[fieldID] = fieldInfo
+					fieldBinlogs = append(fieldBinlogs, fieldBinlog)
+				} else {
+					indexedFieldInfos[fieldID] = fieldInfo
+				}
 			} else {
 				fieldBinlogs = append(fieldBinlogs, fieldBinlog)
 			}
@@ -471,6 +543,7 @@ func (loader *segmentLoader) loadSegment(ctx context.Context,
 
 		log.Info("load fields...",
 			zap.Int64s("indexedFields", lo.Keys(indexedFieldInfos)),
+			zap.Int64s("lazyIndexedFields", lo.Keys(lazyIndexedFieldInfos)),
 		)
 		if err := loader.loadFieldsIndex(ctx, segment, indexedFieldInfos); err != nil {
 			return err
@@ -478,6 +551,11 @@ func (loader *segmentLoader) loadSegment(ctx context.Context,
 		if err := loader.loadSealedSegmentFields(ctx, segment, fieldBinlogs, loadInfo.GetNumOfRows()); err != nil {
 			return err
 		}
+		for _, fieldInfo := range lazyIndexedFieldInfos {
+			if err := loader.addLazyIndex(segment, fieldInfo); err != nil {
+				return err
+			}
+		}
 		// https://github.com/milvus-io/milvus/23654
 		// legacy entry num = 0
 		if err := loader.patchEntryNumber(ctx, segment, loadInfo); err != nil {
@@ -591,6 +669,28 @@ func (loader *segmentLoader) loadFieldsIndex(ctx context.Context, segment *Local
 	return nil
 }
 
+// addLazyIndex adds the index to the segment without loading it, the raw data of the field must be loaded,
+// and the index is loaded in background once the field is searched or filtered.
+func (loader *segmentLoader) addLazyIndex(segment *LocalSegment, fieldInfo *IndexedFieldInfo) error {
+	indexInfo := fieldInfo.IndexInfo
+	indexInfo.IndexFilePaths = lo.Filter(indexInfo.IndexFilePaths, func(indexPath string, _ int) bool {
+		return path.Base(indexPath) != storage.IndexParamsKey
+	})
+	fieldType, err := loader.getFieldType(segment.Collection(), indexInfo.FieldID)
+	if err != nil {
+		return err
+	}
+
+	segment.AddLazyIndex(indexInfo.FieldID, fieldInfo, fieldType)
+	log.Info("add lazy index for sealed segment",
+		zap.Int64("collection", segment.collectionID),
+		zap.Int64("segment", segment.segmentID),
+		zap.Int64("fieldID", indexInfo.FieldID),
+		zap.Int64("indexID", indexInfo.IndexID),
+	)
+	return nil
+}
+
 func (loader *segmentLoader) loadFieldIndex(ctx context.Context, segment *LocalSegment, indexInfo *querypb.FieldIndexInfo) error {
 	filteredPaths := make([]string, 0, len(indexInfo.IndexFilePaths))
 
@@ -978,6 +1078,11 @@ func (loader *segmentLoader) LoadIndex(ctx context.Context, segment *LocalSegmen
 	infos := loader.prepare(commonpb.SegmentState_SegmentStateNone, loadInfo)
 	defer loader.unregister(infos...)
 
+	if err := checkIndexEngineVersion(infos...); err != nil {
+		log.Warn("failed to load index", zap.Error(err))
+		return err
+	}
+
 	indexInfo := lo.Map(infos, func(info *querypb.SegmentLoadInfo, _ int) *querypb.SegmentLoadInfo {
 		info = typeutil.Clone(info)
 		info.BinlogPaths = nil
@@ -993,6 +1098,12 @@ func (loader *segmentLoader) LoadIndex(ctx context.Context, segment *LocalSegmen
 
 	log.Info("segment loader start to load index", zap.Int("segmentNumAfterFilter", len(infos)))
 
+	collection := loader.manager.Collection.Get(segment.Collection())
+	if collection == nil {
+		return merr.WrapErrCollectionNotFound(segment.Collection())
+	}
+	pkFieldID := GetPkField(collection.Schema()).GetFieldID()
+
 	for _, loadInfo := range infos {
 		fieldIDs := typeutil.NewSet(lo.Map(loadInfo.GetIndexInfos(), func(info *querypb.FieldIndexInfo, _ int) int64 { return info.GetFieldID() })...)
 		fieldInfos := lo.SliceToMap(lo.Filter(loadInfo.GetBinlogPaths(), func(info *datapb.FieldBinlog, _ int) bool { return fieldIDs.Contain(info.GetFieldID()) }),
@@ -1003,15 +1114,24 @@ func (loader *segmentLoader) LoadIndex(ctx context.Context, segment *LocalSegmen
 			if !ok {
 				return merr.WrapErrParameterInvalid("index info with corresponding  field info", "missing field info", strconv.FormatInt(fieldInfo.GetFieldID(), 10))
 			}
+			indexedFieldInfo := &IndexedFieldInfo{
+				IndexInfo:   info,
+				FieldBinlog: fieldInfo,
+			}
+			// the raw data of the field is loaded if the segment has no index on it
+			if !segment.ExistIndex(info.GetFieldID()) && isLazyIndex(info, info.GetFieldID() == pkFieldID) {
+				if err := loader.addLazyIndex(segment, indexedFieldInfo); err != nil {
+					log.Warn("failed to add lazy index for segment", zap.Error(err))
+					return err
+				}
+				continue
+			}
 			err := loader.loadFieldIndex(ctx, segment, info)
 			if err != nil {
 				log.Warn("failed to load index for segment", zap.Error(err))
 				return err
 			}
-			segment.AddIndex(info.FieldID, &IndexedFieldInfo{
-				IndexInfo:   info,
-				FieldBinlog: fieldInfo,
-			})
+			segment.AddIndex(info.FieldID, indexedFieldInfo)
 		}
 		loader.notifyLoadFinish(loadInfo)
 	}
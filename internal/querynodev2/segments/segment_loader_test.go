@@ -20,16 +20,21 @@ import (
 	"context"
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/initcore"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metric"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 type SegmentLoaderSuite struct {
@@ -252,6 +257,12 @@ func (suite *SegmentLoaderSuite) TestLoadWithIndex() {
 		})
 	}
 
+	// the index built by newer version is refused before loading anything
+	newer := typeutil.Clone(loadInfos[0])
+	newer.IndexInfos[0].IndexEngineVersion = common.CurrentIndexEngineVersion + 1
+	_, err := suite.loader.Load(ctx, suite.collectionID, SegmentTypeSealed, 0, newer)
+	suite.ErrorIs(err, merr.ErrIndexEngineVersionNotSupport)
+
 	segments, err := suite.loader.Load(ctx, suite.collectionID, SegmentTypeSealed, 0, loadInfos...)
 	suite.NoError(err)
 
@@ -261,6 +272,62 @@ func (suite *SegmentLoaderSuite) TestLoadWithIndex() {
 	}
 }
 
+func (suite *SegmentLoaderSuite) TestLoadWithLazyIndex() {
+	ctx := context.Background()
+	msgLength := 100
+	segmentID := suite.segmentID
+	binlogs, statsLogs, err := SaveBinLog(ctx,
+		suite.collectionID,
+		suite.partitionID,
+		segmentID,
+		msgLength,
+		suite.schema,
+		suite.chunkManager,
+	)
+	suite.NoError(err)
+
+	vecFields := funcutil.GetVecFieldIDs(suite.schema)
+	indexInfo, err := GenAndSaveIndex(
+		suite.collectionID,
+		suite.partitionID,
+		segmentID,
+		vecFields[0],
+		msgLength,
+		IndexFaissIVFFlat,
+		metric.L2,
+		suite.chunkManager,
+	)
+	suite.NoError(err)
+	indexInfo.IndexParams = append(indexInfo.IndexParams, &commonpb.KeyValuePair{
+		Key:   common.IndexLoadPolicyKey,
+		Value: common.IndexLoadPolicyLazy,
+	})
+
+	segments, err := suite.loader.Load(ctx, suite.collectionID, SegmentTypeSealed, 0, &querypb.SegmentLoadInfo{
+		SegmentID:    segmentID,
+		PartitionID:  suite.partitionID,
+		CollectionID: suite.collectionID,
+		BinlogPaths:  binlogs,
+		Statslogs:    statsLogs,
+		IndexInfos:   []*querypb.FieldIndexInfo{indexInfo},
+		NumOfRows:    int64(msgLength),
+	})
+	suite.NoError(err)
+	suite.Len(segments, 1)
+
+	// the index is reported, but served by the raw data until the field is used
+	segment := segments[0].(*LocalSegment)
+	suite.Len(segment.Indexes(), 1)
+	suite.True(segment.IsLazyIndex(vecFields[0]))
+	suite.False(segment.ExistIndex(vecFields[0]))
+
+	segment.touchFields([]int64{vecFields[0]})
+	suite.Eventually(func() bool {
+		return segment.ExistIndex(vecFields[0])
+	}, 10*time.Second, 100*time.Millisecond)
+	suite.False(segment.IsLazyIndex(vecFields[0]))
+}
+
 func (suite *SegmentLoaderSuite) TestLoadBloomFilter() {
 	ctx := context.Background()
 	loadInfos := make([]*querypb.SegmentLoadInfo, 0, suite.segmentNum)
@@ -438,6 +505,20 @@ func (suite *SegmentLoaderSuite) TestPatchEntryNum() {
 	}
 }
 
+func (suite *SegmentLoaderSuite) TestDiskUsageCached() {
+	loader := suite.loader.(*segmentLoader)
+	loader.localDiskUsage = 42
+	loader.localDiskUsageTime = time.Now()
+
+	used, _, err := loader.DiskUsage()
+	suite.NoError(err)
+	suite.EqualValues(42, used)
+
+	// loading segments invalidates the cache
+	loader.freeRequest(0, 0)
+	suite.True(loader.localDiskUsageTime.IsZero())
+}
+
 func (suite *SegmentLoaderSuite) TestRunOutMemory() {
 	ctx := context.Background()
 	paramtable.Get().Save(paramtable.Get().QueryNodeCfg.OverloadedMemoryThresholdPercentage.Key, "0")
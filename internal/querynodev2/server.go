@@ -41,6 +41,7 @@ import (
 	"time"
 	"unsafe"
 
+	"github.com/cockroachdb/errors"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/zap"
 
@@ -100,6 +101,7 @@ type QueryNode struct {
 	tSafeManager        tsafe.Manager
 	pipelineManager     pipeline.Manager
 	subscribingChannels *typeutil.ConcurrentSet[string]
+	channelFencer       *channelFencer
 	delegators          *typeutil.ConcurrentMap[string, delegator.ShardDelegator]
 
 	// segment loader
@@ -112,6 +114,9 @@ type QueryNode struct {
 	etcdCli *clientv3.Client
 	address string
 
+	// rootCoord verifies the credentials of the admin APIs
+	rootCoord types.RootCoord
+
 	dispClient msgdispatcher.Client
 	factory    dependency.Factory
 
@@ -224,6 +229,9 @@ func (node *QueryNode) InitSegcore() error {
 	}
 
 	initcore.InitTraceConfig(paramtable.Get())
+	if err := initcore.InitEncryption(paramtable.Get()); err != nil {
+		return err
+	}
 	return initcore.InitRemoteChunkManager(paramtable.Get())
 }
 
@@ -319,6 +327,7 @@ func (node *QueryNode) Init() error {
 		})
 		node.delegators = typeutil.NewConcurrentMap[string, delegator.ShardDelegator]()
 		node.subscribingChannels = typeutil.NewConcurrentSet[string]()
+		node.channelFencer = newChannelFencer()
 		node.manager = segments.NewManager()
 		node.loader = segments.NewLoader(node.manager, node.vectorStorage)
 		node.dispClient = msgdispatcher.NewClient(node.factory, typeutil.QueryNodeRole, paramtable.GetNodeID())
@@ -356,6 +365,7 @@ func (node *QueryNode) Init() error {
 func (node *QueryNode) Start() error {
 	node.startOnce.Do(func() {
 		node.scheduler.Start(node.ctx)
+		segments.GetParallelismGovernor().Start(node.ctx)
 
 		paramtable.SetCreateTime(time.Now())
 		paramtable.SetUpdateTime(time.Now())
@@ -454,6 +464,17 @@ func (node *QueryNode) SetEtcdClient(client *clientv3.Client) {
 	node.etcdCli = client
 }
 
+// SetRootCoord sets RootCoord's grpc client, error is returned if repeatedly set.
+func (node *QueryNode) SetRootCoord(rc types.RootCoord) error {
+	switch {
+	case rc == nil, node.rootCoord != nil:
+		return errors.New("nil parameter or repeatedly set")
+	default:
+		node.rootCoord = rc
+		return nil
+	}
+}
+
 func (node *QueryNode) GetAddress() string {
 	return node.address
 }
@@ -43,14 +43,17 @@ import (
 	"github.com/milvus-io/milvus/internal/querynodev2/tasks"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util"
+	internalfuncutil "github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/hardware"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/ratelimitutil"
 	"github.com/milvus-io/milvus/pkg/util/timerecord"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
@@ -247,6 +250,11 @@ func (node *QueryNode) WatchDmChannels(ctx context.Context, req *querypb.WatchDm
 		return merr.Status(err), nil
 	}
 
+	if err := node.channelFencer.Advance(channel.GetChannelName(), req.GetFencingToken()); err != nil {
+		log.Warn("reject stale watch channel request", zap.Error(err))
+		return merr.Status(err), nil
+	}
+
 	if !node.subscribingChannels.Insert(channel.GetChannelName()) {
 		msg := "channel subscribing..."
 		log.Warn(msg)
@@ -376,6 +384,11 @@ func (node *QueryNode) UnsubDmChannel(ctx context.Context, req *querypb.UnsubDmC
 		return status, nil
 	}
 
+	if err := node.channelFencer.Advance(req.GetChannelName(), req.GetFencingToken()); err != nil {
+		log.Warn("reject stale unsubscribe channel request", zap.Error(err))
+		return merr.Status(err), nil
+	}
+
 	delegator, ok := node.delegators.GetAndRemove(req.GetChannelName())
 	if ok {
 		// close the delegator first to block all coming query/search requests
@@ -1038,7 +1051,8 @@ func (node *QueryNode) SyncReplicaSegments(ctx context.Context, req *querypb.Syn
 // ShowConfigurations returns the configurations of queryNode matching req.Pattern
 func (node *QueryNode) ShowConfigurations(ctx context.Context, req *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error) {
 	if !node.lifetime.Add(commonpbutil.IsHealthy) {
-		err := merr.WrapErrServiceNotReady(fmt.Sprintf("node id: %d is unhealthy", paramtable.GetNodeID()))
+		msg := fmt.Sprintf("query node %d is not ready", paramtable.GetNodeID())
+		err := merr.WrapErrServiceNotReady(msg)
 		log.Warn("QueryNode.ShowConfigurations failed",
 			zap.Int64("nodeId", paramtable.GetNodeID()),
 			zap.String("req", req.Pattern),
@@ -1072,7 +1086,8 @@ func (node *QueryNode) ShowConfigurations(ctx context.Context, req *internalpb.S
 // GetMetrics return system infos of the query node, such as total memory, memory usage, cpu usage ...
 func (node *QueryNode) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	if !node.lifetime.Add(commonpbutil.IsHealthy) {
-		err := merr.WrapErrServiceNotReady(fmt.Sprintf("node id: %d is unhealthy", paramtable.GetNodeID()))
+		msg := fmt.Sprintf("query node %d is not ready", paramtable.GetNodeID())
+		err := merr.WrapErrServiceNotReady(msg)
 		log.Warn("QueryNode.GetMetrics failed",
 			zap.Int64("nodeId", paramtable.GetNodeID()),
 			zap.String("req", req.Request),
@@ -1144,7 +1159,8 @@ func (node *QueryNode) GetDataDistribution(ctx context.Context, req *querypb.Get
 		zap.Int64("nodeID", paramtable.GetNodeID()),
 	)
 	if !node.lifetime.Add(commonpbutil.IsHealthy) {
-		err := merr.WrapErrServiceNotReady(fmt.Sprintf("node id: %d is unhealthy", paramtable.GetNodeID()))
+		msg := fmt.Sprintf("query node %d is not ready", paramtable.GetNodeID())
+		err := merr.WrapErrServiceNotReady(msg)
 		log.Warn("QueryNode.GetMetrics failed",
 			zap.Error(err))
 
@@ -1224,13 +1240,37 @@ func (node *QueryNode) GetDataDistribution(ctx context.Context, req *querypb.Get
 		return true
 	})
 
-	return &querypb.GetDataDistributionResponse{
+	resp := &querypb.GetDataDistributionResponse{
 		Status:      &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
 		NodeID:      paramtable.GetNodeID(),
 		Segments:    segmentVersionInfos,
 		Channels:    channelVersionInfos,
 		LeaderViews: leaderViews,
-	}, nil
+	}
+
+	// report the disk usage for QueryCoord to avoid assigning segments to the node whose disk would overflow,
+	// leave it empty if failed, then QueryCoord doesn't limit the assignment by disk.
+	diskUsed, diskCommitted, err := node.loader.DiskUsage()
+	if err != nil {
+		log.Warn("failed to get disk usage", zap.Error(err))
+	} else {
+		resp.DiskCapacity = int64(float64(paramtable.Get().QueryNodeCfg.DiskCapacityLimit.GetAsInt64()) * paramtable.Get().QueryNodeCfg.MaxDiskUsagePercentage.GetAsFloat())
+		resp.DiskUsed = int64(diskUsed)
+		resp.DiskCommitted = int64(diskCommitted)
+	}
+
+	// report the runtime load for the load aware balancer of QueryCoord
+	resp.CpuUsage = hardware.GetCPUUsage()
+	resp.MemoryUsed = int64(hardware.GetUsedMemoryCount())
+	resp.MemoryTotal = int64(hardware.GetMemoryCount())
+	if nqRate, err := collector.Rate.Rate(metricsinfo.NQPerSecond, ratelimitutil.DefaultAvgDuration); err == nil {
+		resp.SearchNqRate = nqRate
+	}
+	// the average is reset by the quota metrics collection, only read it here
+	if latency, err := collector.Average.Average(metricsinfo.SearchQueueMetric); err == nil {
+		resp.SearchQueueLatency = latency / 1000
+	}
+	return resp, nil
 }
 
 func (node *QueryNode) SyncDistribution(ctx context.Context, req *querypb.SyncDistributionRequest) (*commonpb.Status, error) {
@@ -1253,6 +1293,11 @@ func (node *QueryNode) SyncDistribution(ctx context.Context, req *querypb.SyncDi
 		return status, nil
 	}
 
+	if err := node.channelFencer.CheckTerm(req.GetChannel(), req.GetFencingToken()); err != nil {
+		log.Warn("reject sync distribution request from stale term", zap.Error(err))
+		return merr.Status(err), nil
+	}
+
 	// get shard delegator
 	shardDelegator, ok := node.delegators.Get(req.GetChannel())
 	if !ok {
@@ -1396,3 +1441,84 @@ func (node *QueryNode) Delete(ctx context.Context, req *querypb.DeleteRequest) (
 		Reason:    "",
 	}, nil
 }
+
+// DumpDispatcherPositions returns the latest positions dispatched to the given vchannels,
+// or all the vchannels subscribed by the QueryNode if none given, admin only.
+func (node *QueryNode) DumpDispatcherPositions(ctx context.Context, req *internalpb.DumpDispatcherPositionsRequest) (*internalpb.DumpDispatcherPositionsResponse, error) {
+	log := log.Ctx(ctx).With(zap.Strings("vchannels", req.GetVchannels()))
+	if !node.lifetime.Add(commonpbutil.IsHealthy) {
+		msg := fmt.Sprintf("query node %d is not ready", paramtable.GetNodeID())
+		err := merr.WrapErrServiceNotReady(msg)
+		log.Warn("failed to dump dispatcher positions", zap.Error(err))
+		return &internalpb.DumpDispatcherPositionsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	defer node.lifetime.Done()
+	if err := internalfuncutil.CheckAdminPrivilege(ctx, node.rootCoord); err != nil {
+		log.Warn("failed to dump dispatcher positions", zap.Error(err))
+		return &internalpb.DumpDispatcherPositionsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	vchannels := req.GetVchannels()
+	if len(vchannels) == 0 {
+		node.delegators.Range(func(vchannel string, _ delegator.ShardDelegator) bool {
+			vchannels = append(vchannels, vchannel)
+			return true
+		})
+	}
+	positions := make([]*internalpb.DispatcherPosition, 0, len(vchannels))
+	for _, vchannel := range vchannels {
+		state := node.dispClient.GetState(vchannel)
+		if state == nil {
+			err := merr.WrapErrChannelNotFound(vchannel, "not consumed by the dispatcher")
+			log.Warn("failed to dump dispatcher positions", zap.Error(err))
+			return &internalpb.DumpDispatcherPositionsResponse{
+				Status: merr.Status(err),
+			}, nil
+		}
+		positions = append(positions, &internalpb.DispatcherPosition{
+			Vchannel: vchannel,
+			Pchannel: state.PChannel,
+			Position: state.Position,
+			IsMain:   state.IsMain,
+			CurTs:    state.CurTs,
+		})
+	}
+	return &internalpb.DumpDispatcherPositionsResponse{
+		Status:    merr.Status(nil),
+		Positions: positions,
+	}, nil
+}
+
+// SeekDispatcher makes the subscribed vchannel consume from the given position, admin only.
+// It's used to recover the vchannel whose checkpoint is wrong, the data may be replayed or skipped.
+func (node *QueryNode) SeekDispatcher(ctx context.Context, req *internalpb.SeekDispatcherRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(zap.String("vchannel", req.GetVchannel()),
+		zap.Uint64("ts", req.GetPosition().GetTimestamp()))
+	if !node.lifetime.Add(commonpbutil.IsHealthy) {
+		msg := fmt.Sprintf("query node %d is not ready", paramtable.GetNodeID())
+		err := merr.WrapErrServiceNotReady(msg)
+		log.Warn("failed to seek dispatcher", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	defer node.lifetime.Done()
+	if err := internalfuncutil.CheckAdminPrivilege(ctx, node.rootCoord); err != nil {
+		log.Warn("failed to seek dispatcher", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	if node.pipelineManager.Get(req.GetVchannel()) == nil {
+		err := merr.WrapErrChannelNotFound(req.GetVchannel())
+		log.Warn("failed to seek dispatcher", zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	log.Warn("seek dispatcher by admin, the data may be replayed or skipped")
+	if err := node.dispClient.Seek(req.GetVchannel(), req.GetPosition()); err != nil {
+		log.Warn("failed to seek dispatcher", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	return merr.Status(nil), nil
+}
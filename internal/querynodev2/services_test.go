@@ -41,6 +41,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querynodev2/segments"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/dependency"
+	"github.com/milvus-io/milvus/pkg/mq/msgdispatcher"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
 	"github.com/milvus-io/milvus/pkg/util/conc"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
@@ -427,6 +428,38 @@ func (suite *ServiceSuite) TestUnsubDmChannels_Normal() {
 	suite.Equal(commonpb.ErrorCode_Success, status.GetErrorCode())
 }
 
+func (suite *ServiceSuite) TestUnsubDmChannels_Fenced() {
+	ctx := context.Background()
+	// prepate
+	suite.TestWatchDmChannelsInt64()
+
+	// data
+	req := &querypb.UnsubDmChannelRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_UnsubDmChannel,
+			MsgID:    rand.Int63(),
+			TargetID: suite.node.session.ServerID,
+		},
+		NodeID:       suite.node.session.ServerID,
+		CollectionID: suite.collectionID,
+		ChannelName:  suite.vchannel,
+		FencingToken: &querypb.FencingToken{Term: 1, Seq: 10},
+	}
+	suite.NoError(suite.node.channelFencer.Advance(suite.vchannel, &querypb.FencingToken{Term: 2, Seq: 1}))
+
+	// request from the older term is rejected
+	status, err := suite.node.UnsubDmChannel(ctx, req)
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(status), merr.ErrChannelFenced)
+	_, exist := suite.node.delegators.Get(suite.vchannel)
+	suite.True(exist)
+
+	req.FencingToken = &querypb.FencingToken{Term: 2, Seq: 2}
+	status, err = suite.node.UnsubDmChannel(ctx, req)
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, status.GetErrorCode())
+}
+
 func (suite *ServiceSuite) TestUnsubDmChannels_Failed() {
 	ctx := context.Background()
 	// prepate
@@ -1419,6 +1452,40 @@ func (suite *ServiceSuite) TestGetDataDistribution_Normal() {
 	resp, err := suite.node.GetDataDistribution(ctx, req)
 	suite.NoError(err)
 	suite.Equal(commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	suite.Greater(resp.GetDiskCapacity(), int64(0))
+	suite.Greater(resp.GetMemoryTotal(), int64(0))
+}
+
+func (suite *ServiceSuite) TestGetDataDistribution_DiskUsage() {
+	ctx := context.Background()
+	loader := suite.node.loader
+	mockLoader := segments.NewMockLoader(suite.T())
+	suite.node.loader = mockLoader
+	defer func() {
+		suite.node.loader = loader
+	}()
+
+	req := &querypb.GetDataDistributionRequest{
+		Base: &commonpb.MsgBase{
+			MsgID:    rand.Int63(),
+			TargetID: suite.node.session.ServerID,
+		},
+	}
+
+	mockLoader.EXPECT().DiskUsage().Return(100, 20, nil).Once()
+	resp, err := suite.node.GetDataDistribution(ctx, req)
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	suite.Greater(resp.GetDiskCapacity(), int64(0))
+	suite.EqualValues(100, resp.GetDiskUsed())
+	suite.EqualValues(20, resp.GetDiskCommitted())
+
+	// failed to get disk usage, left empty
+	mockLoader.EXPECT().DiskUsage().Return(0, 0, errors.New("mocked error")).Once()
+	resp, err = suite.node.GetDataDistribution(ctx, req)
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	suite.EqualValues(0, resp.GetDiskCapacity())
 }
 
 func (suite *ServiceSuite) TestGetDataDistribution_Failed() {
@@ -1697,6 +1764,74 @@ func (suite *ServiceSuite) TestLoadPartition() {
 	suite.Equal(commonpb.ErrorCode_Success, status.ErrorCode)
 }
 
+func (suite *ServiceSuite) TestDispatcherPositions() {
+	ctx := context.Background()
+	// watch the channel to build the pipeline
+	suite.TestWatchDmChannelsInt64()
+
+	dispClient := msgdispatcher.NewMockClient(suite.T())
+	defer func(origin msgdispatcher.Client) {
+		suite.node.dispClient = origin
+	}(suite.node.dispClient)
+	suite.node.dispClient = dispClient
+
+	// dump all the vchannels
+	dispClient.EXPECT().GetState(suite.vchannel).Return(&msgdispatcher.DispatcherState{
+		PChannel: suite.pchannel,
+		IsMain:   true,
+		Position: suite.position,
+	}).Once()
+	resp, err := suite.node.DumpDispatcherPositions(ctx, &internalpb.DumpDispatcherPositionsRequest{})
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	suite.Require().Len(resp.GetPositions(), 1)
+	suite.Equal(suite.pchannel, resp.GetPositions()[0].GetPchannel())
+	suite.Equal(suite.position, resp.GetPositions()[0].GetPosition())
+
+	// vchannel not consumed
+	dispClient.EXPECT().GetState("not-exist").Return(nil).Once()
+	resp, err = suite.node.DumpDispatcherPositions(ctx, &internalpb.DumpDispatcherPositionsRequest{
+		Vchannels: []string{"not-exist"},
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrChannelNotFound)
+
+	// seek
+	dispClient.EXPECT().Seek(suite.vchannel, mock.Anything).Return(nil).Once()
+	status, err := suite.node.SeekDispatcher(ctx, &internalpb.SeekDispatcherRequest{
+		Vchannel: suite.vchannel,
+		Position: suite.position,
+	})
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, status.GetErrorCode())
+
+	status, err = suite.node.SeekDispatcher(ctx, &internalpb.SeekDispatcherRequest{
+		Vchannel: "not-exist",
+		Position: suite.position,
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(status), merr.ErrChannelNotFound)
+
+	// not admin
+	paramtable.Get().Save(paramtable.Get().CommonCfg.AuthorizationEnabled.Key, "true")
+	resp, err = suite.node.DumpDispatcherPositions(ctx, &internalpb.DumpDispatcherPositionsRequest{})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrServicePermissionDenied)
+	status, err = suite.node.SeekDispatcher(ctx, &internalpb.SeekDispatcherRequest{Vchannel: suite.vchannel})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(status), merr.ErrServicePermissionDenied)
+	paramtable.Get().Reset(paramtable.Get().CommonCfg.AuthorizationEnabled.Key)
+
+	// node not healthy
+	suite.node.UpdateStateCode(commonpb.StateCode_Abnormal)
+	resp, err = suite.node.DumpDispatcherPositions(ctx, &internalpb.DumpDispatcherPositionsRequest{})
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_NotReadyServe, resp.GetStatus().GetErrorCode())
+	status, err = suite.node.SeekDispatcher(ctx, &internalpb.SeekDispatcherRequest{Vchannel: suite.vchannel})
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_NotReadyServe, status.GetErrorCode())
+}
+
 func TestQueryNodeService(t *testing.T) {
 	suite.Run(t, new(ServiceSuite))
 }
@@ -97,6 +97,7 @@ func (t *SearchTask) PreExecute() error {
 
 	// Update collector for query node quota.
 	collector.Average.Add(metricsinfo.SearchQueueMetric, float64(inQueueDuration.Microseconds()))
+	segments.GetParallelismGovernor().ObserveQueueLatency(inQueueDuration)
 
 	// Execute merged task's PreExecute.
 	for _, subTask := range t.others {
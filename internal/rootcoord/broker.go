@@ -56,7 +56,9 @@ type Broker interface {
 	Flush(ctx context.Context, cID int64, segIDs []int64) error
 	Import(ctx context.Context, req *datapb.ImportTaskRequest) (*datapb.ImportTaskResponse, error)
 	UnsetIsImportingState(context.Context, *datapb.UnsetIsImportingStateRequest) (*commonpb.Status, error)
+	MarkSegmentsDropped(context.Context, *datapb.MarkSegmentsDroppedRequest) (*commonpb.Status, error)
 	GetSegmentStates(context.Context, *datapb.GetSegmentStatesRequest) (*datapb.GetSegmentStatesResponse, error)
+	CloneSegments(ctx context.Context, req *datapb.CloneSegmentsRequest) error
 	GcConfirm(ctx context.Context, collectionID, partitionID UniqueID) bool
 
 	DropCollectionIndex(ctx context.Context, collID UniqueID, partIDs []UniqueID) error
@@ -215,10 +217,26 @@ func (b *ServerBroker) UnsetIsImportingState(ctx context.Context, req *datapb.Un
 	return b.s.dataCoord.UnsetIsImportingState(ctx, req)
 }
 
+func (b *ServerBroker) MarkSegmentsDropped(ctx context.Context, req *datapb.MarkSegmentsDroppedRequest) (*commonpb.Status, error) {
+	return b.s.dataCoord.MarkSegmentsDropped(ctx, req)
+}
+
 func (b *ServerBroker) GetSegmentStates(ctx context.Context, req *datapb.GetSegmentStatesRequest) (*datapb.GetSegmentStatesResponse, error) {
 	return b.s.dataCoord.GetSegmentStates(ctx, req)
 }
 
+func (b *ServerBroker) CloneSegments(ctx context.Context, req *datapb.CloneSegmentsRequest) error {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", req.GetCollectionID()), zap.Int64("targetCollectionID", req.GetTargetCollectionID()))
+	log.Info("clone segments")
+	resp, err := b.s.dataCoord.CloneSegments(ctx, req)
+	if err = errorOf(resp, err); err != nil {
+		log.Warn("failed to clone segments", zap.Error(err))
+		return err
+	}
+	log.Info("done to clone segments")
+	return nil
+}
+
 func (b *ServerBroker) DropCollectionIndex(ctx context.Context, collID UniqueID, partIDs []UniqueID) error {
 	log.Ctx(ctx).Info("dropping collection index", zap.Int64("collection", collID), zap.Int64s("partitions", partIDs))
 
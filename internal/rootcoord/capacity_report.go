@@ -0,0 +1,257 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/tso"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	// capacitySampleInterval is the min interval between two usage samples of a resource.
+	capacitySampleInterval = time.Hour
+	// capacityGrowthWindow is how long the usage samples are kept to project the growth.
+	capacityGrowthWindow = 7 * 24 * time.Hour
+	// notGrowing is the days until full of the resources whose usage is not growing.
+	notGrowing = -1
+)
+
+// capacitySample is the usage of a resource at some time.
+type capacitySample struct {
+	ts   time.Time
+	used uint64
+}
+
+// capacityPlanner aggregates the cluster metrics into capacity reports,
+// and projects when the resources are full by the usage growth in the recent days.
+type capacityPlanner struct {
+	ctx          context.Context
+	queryCoord   types.QueryCoord
+	dataCoord    types.DataCoord
+	tsoAllocator tso.Allocator
+
+	mu      sync.Mutex
+	history map[string][]capacitySample // resource key -> usage samples in growth window, ordered by ts
+}
+
+func newCapacityPlanner(ctx context.Context, queryCoord types.QueryCoord, dataCoord types.DataCoord, tsoAllocator tso.Allocator) *capacityPlanner {
+	return &capacityPlanner{
+		ctx:          ctx,
+		queryCoord:   queryCoord,
+		dataCoord:    dataCoord,
+		tsoAllocator: tsoAllocator,
+		history:      make(map[string][]capacitySample),
+	}
+}
+
+// sampleLoop samples the usage periodically, so that the growth could be projected
+// even if no report is requested.
+func (p *capacityPlanner) sampleLoop(wg *sync.WaitGroup) {
+	defer wg.Done()
+	ticker := time.NewTicker(capacitySampleInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.ctx.Done():
+			log.Info("capacity planner sample loop quit")
+			return
+		case <-ticker.C:
+			if _, err := p.report(p.ctx); err != nil {
+				log.Warn("failed to sample cluster capacity", zap.Error(err))
+			}
+		}
+	}
+}
+
+// report collects the capacity of the cluster and records the usage for the projection.
+func (p *capacityPlanner) report(ctx context.Context) (*rootcoordpb.GetCapacityReportResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, GetMetricsTimeout)
+	defer cancel()
+
+	req, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.SystemInfoMetrics)
+	if err != nil {
+		return nil, err
+	}
+	queryTopology := &metricsinfo.QueryCoordTopology{}
+	dataTopology := &metricsinfo.DataCoordTopology{}
+	group := &errgroup.Group{}
+	group.Go(func() error {
+		rsp, err := p.queryCoord.GetMetrics(ctx, req)
+		if err != nil {
+			return err
+		}
+		if rsp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+			return fmt.Errorf("capacity planner get Query cluster failed, err = %s", rsp.GetStatus().GetReason())
+		}
+		return metricsinfo.UnmarshalTopology(rsp.GetResponse(), queryTopology)
+	})
+	group.Go(func() error {
+		rsp, err := p.dataCoord.GetMetrics(ctx, req)
+		if err != nil {
+			return err
+		}
+		if rsp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+			return fmt.Errorf("capacity planner get Data cluster failed, err = %s", rsp.GetStatus().GetReason())
+		}
+		return metricsinfo.UnmarshalTopology(rsp.GetResponse(), dataTopology)
+	})
+	if err := group.Wait(); err != nil {
+		return nil, err
+	}
+
+	ts, err := p.tsoAllocator.GenerateTSO(1)
+	if err != nil {
+		return nil, err
+	}
+	now, _ := tsoutil.ParseTS(ts)
+	return p.build(now, &queryTopology.Cluster, &dataTopology.Cluster), nil
+}
+
+// build aggregates the topologies into the report at time now.
+func (p *capacityPlanner) build(now time.Time, queryCluster *metricsinfo.QueryClusterTopology, dataCluster *metricsinfo.DataClusterTopology) *rootcoordpb.GetCapacityReportResponse {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	resp := &rootcoordpb.GetCapacityReportResponse{
+		ResourceGroups: make([]*rootcoordpb.ResourceGroupCapacity, 0),
+		NodeDisks:      make([]*rootcoordpb.NodeDiskCapacity, 0),
+		Backlogs:       make([]*rootcoordpb.ChannelBacklog, 0),
+	}
+	seen := typeutil.NewSet[string]()
+
+	groups := make(map[string]*rootcoordpb.ResourceGroupCapacity)
+	for _, node := range queryCluster.ConnectedNodes {
+		if node.HasError {
+			continue
+		}
+		group, ok := groups[node.ResourceGroup]
+		if !ok {
+			group = &rootcoordpb.ResourceGroupCapacity{Name: node.ResourceGroup}
+			groups[node.ResourceGroup] = group
+			resp.ResourceGroups = append(resp.ResourceGroups, group)
+		}
+		group.NumNodes++
+		group.MemoryUsed += node.HardwareInfos.MemoryUsage
+		group.MemoryTotal += node.HardwareInfos.Memory
+		if node.QuotaMetrics != nil {
+			resp.Backlogs = appendBacklog(resp.Backlogs, now, node.ID, typeutil.QueryNodeRole, node.QuotaMetrics.Fgm)
+		}
+	}
+	sort.Slice(resp.ResourceGroups, func(i, j int) bool {
+		return resp.ResourceGroups[i].GetName() < resp.ResourceGroups[j].GetName()
+	})
+	for _, group := range resp.ResourceGroups {
+		key := "rg/" + group.GetName()
+		seen.Insert(key)
+		group.DaysUntilFull = p.record(key, now, group.GetMemoryUsed(), group.GetMemoryTotal())
+	}
+
+	addNode := func(nodeID int64, role string, hms metricsinfo.HardwareMetrics) {
+		key := fmt.Sprintf("%s/%d", role, nodeID)
+		seen.Insert(key)
+		resp.NodeDisks = append(resp.NodeDisks, &rootcoordpb.NodeDiskCapacity{
+			NodeID:        nodeID,
+			Role:          role,
+			DiskUsed:      hms.DiskUsage,
+			DiskTotal:     hms.Disk,
+			DaysUntilFull: p.record(key, now, hms.DiskUsage, hms.Disk),
+		})
+	}
+	for _, node := range dataCluster.ConnectedDataNodes {
+		if node.HasError {
+			continue
+		}
+		addNode(node.ID, typeutil.DataNodeRole, node.HardwareInfos)
+		if node.QuotaMetrics != nil {
+			resp.Backlogs = appendBacklog(resp.Backlogs, now, node.ID, typeutil.DataNodeRole, node.QuotaMetrics.Fgm)
+		}
+	}
+	for _, node := range dataCluster.ConnectedIndexNodes {
+		if node.HasError {
+			continue
+		}
+		addNode(node.ID, typeutil.IndexNodeRole, node.HardwareInfos)
+	}
+
+	// forget the resources which are gone
+	for key := range p.history {
+		if !seen.Contain(key) {
+			delete(p.history, key)
+		}
+	}
+	return resp
+}
+
+// record adds the usage sample of the resource, and returns the days before it's full.
+func (p *capacityPlanner) record(key string, now time.Time, used, total uint64) float64 {
+	samples := p.history[key]
+	start := 0
+	for start < len(samples) && now.Sub(samples[start].ts) > capacityGrowthWindow {
+		start++
+	}
+	samples = samples[start:]
+	if len(samples) == 0 || now.Sub(samples[len(samples)-1].ts) >= capacitySampleInterval {
+		samples = append(samples, capacitySample{ts: now, used: used})
+	}
+	p.history[key] = samples
+	return daysUntilFull(samples[0], now, used, total)
+}
+
+// daysUntilFull projects the days before the usage reaches total,
+// by the linear growth since the oldest sample.
+func daysUntilFull(oldest capacitySample, now time.Time, used, total uint64) float64 {
+	if total == 0 {
+		return notGrowing
+	}
+	if used >= total {
+		return 0
+	}
+	elapsed := now.Sub(oldest.ts)
+	if elapsed <= 0 || used <= oldest.used {
+		return notGrowing
+	}
+	growthPerDay := float64(used-oldest.used) / elapsed.Hours() * 24
+	return float64(total-used) / growthPerDay
+}
+
+func appendBacklog(backlogs []*rootcoordpb.ChannelBacklog, now time.Time, nodeID int64, role string, fgm metricsinfo.FlowGraphMetric) []*rootcoordpb.ChannelBacklog {
+	if fgm.NumFlowGraph == 0 || fgm.MinFlowGraphChannel == "" {
+		return backlogs
+	}
+	tt, _ := tsoutil.ParseTS(fgm.MinFlowGraphTt)
+	return append(backlogs, &rootcoordpb.ChannelBacklog{
+		NodeID:      nodeID,
+		Role:        role,
+		ChannelName: fgm.MinFlowGraphChannel,
+		LagMs:       now.Sub(tt).Milliseconds(),
+	})
+}
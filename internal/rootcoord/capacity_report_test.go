@@ -0,0 +1,170 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func newTestQueryCluster(memoryUsed uint64) *metricsinfo.QueryClusterTopology {
+	return &metricsinfo.QueryClusterTopology{
+		ConnectedNodes: []metricsinfo.QueryNodeInfos{
+			{
+				BaseComponentInfos: metricsinfo.BaseComponentInfos{ID: 1, HardwareInfos: metricsinfo.HardwareMetrics{Memory: 100, MemoryUsage: memoryUsed}},
+				ResourceGroup:      "rg1",
+			},
+			{
+				BaseComponentInfos: metricsinfo.BaseComponentInfos{ID: 2, HardwareInfos: metricsinfo.HardwareMetrics{Memory: 100, MemoryUsage: memoryUsed}},
+				ResourceGroup:      "rg1",
+			},
+			{
+				BaseComponentInfos: metricsinfo.BaseComponentInfos{ID: 3, HardwareInfos: metricsinfo.HardwareMetrics{Memory: 100, MemoryUsage: 50}},
+				ResourceGroup:      "__default_resource_group",
+			},
+			{
+				BaseComponentInfos: metricsinfo.BaseComponentInfos{ID: 4, HasError: true},
+			},
+		},
+	}
+}
+
+func TestCapacityPlanner_Build(t *testing.T) {
+	p := newCapacityPlanner(context.Background(), nil, nil, nil)
+	start := time.Now()
+	dataCluster := &metricsinfo.DataClusterTopology{
+		ConnectedDataNodes: []metricsinfo.DataNodeInfos{
+			{
+				BaseComponentInfos: metricsinfo.BaseComponentInfos{ID: 5, HardwareInfos: metricsinfo.HardwareMetrics{Disk: 1000, DiskUsage: 100}},
+				QuotaMetrics: &metricsinfo.DataNodeQuotaMetrics{Fgm: metricsinfo.FlowGraphMetric{
+					MinFlowGraphChannel: "dml_0",
+					MinFlowGraphTt:      tsoutil.ComposeTSByTime(start.Add(-time.Second), 0),
+					NumFlowGraph:        1,
+				}},
+			},
+		},
+		ConnectedIndexNodes: []metricsinfo.IndexNodeInfos{
+			{BaseComponentInfos: metricsinfo.BaseComponentInfos{ID: 6, HardwareInfos: metricsinfo.HardwareMetrics{Disk: 1000, DiskUsage: 1000}}},
+		},
+	}
+
+	resp := p.build(start, newTestQueryCluster(20), dataCluster)
+	require.Equal(t, 2, len(resp.GetResourceGroups()))
+	assert.Equal(t, "__default_resource_group", resp.GetResourceGroups()[0].GetName())
+	rg := resp.GetResourceGroups()[1]
+	assert.Equal(t, "rg1", rg.GetName())
+	assert.EqualValues(t, 2, rg.GetNumNodes())
+	assert.EqualValues(t, 40, rg.GetMemoryUsed())
+	assert.EqualValues(t, 200, rg.GetMemoryTotal())
+	assert.EqualValues(t, notGrowing, rg.GetDaysUntilFull())
+
+	require.Equal(t, 2, len(resp.GetNodeDisks()))
+	assert.Equal(t, typeutil.DataNodeRole, resp.GetNodeDisks()[0].GetRole())
+	assert.EqualValues(t, notGrowing, resp.GetNodeDisks()[0].GetDaysUntilFull())
+	assert.Equal(t, typeutil.IndexNodeRole, resp.GetNodeDisks()[1].GetRole())
+	assert.EqualValues(t, 0, resp.GetNodeDisks()[1].GetDaysUntilFull())
+
+	require.Equal(t, 1, len(resp.GetBacklogs()))
+	assert.Equal(t, "dml_0", resp.GetBacklogs()[0].GetChannelName())
+	assert.EqualValues(t, 1000, resp.GetBacklogs()[0].GetLagMs())
+
+	// memory of rg1 grows 20 per day, 140 left
+	resp = p.build(start.Add(24*time.Hour), newTestQueryCluster(30), &metricsinfo.DataClusterTopology{})
+	assert.InDelta(t, 7, resp.GetResourceGroups()[1].GetDaysUntilFull(), 1e-9)
+	assert.EqualValues(t, notGrowing, resp.GetResourceGroups()[0].GetDaysUntilFull())
+	// the nodes which are gone are forgotten
+	assert.Equal(t, 2, len(p.history))
+
+	// samples out of the growth window are dropped
+	resp = p.build(start.Add(capacityGrowthWindow+25*time.Hour), newTestQueryCluster(30), &metricsinfo.DataClusterTopology{})
+	assert.EqualValues(t, notGrowing, resp.GetResourceGroups()[1].GetDaysUntilFull())
+	assert.Equal(t, 1, len(p.history["rg/rg1"]))
+}
+
+func TestDaysUntilFull(t *testing.T) {
+	now := time.Now()
+	oldest := capacitySample{ts: now.Add(-48 * time.Hour), used: 10}
+	assert.EqualValues(t, notGrowing, daysUntilFull(oldest, now, 10, 0))
+	assert.EqualValues(t, 0, daysUntilFull(oldest, now, 100, 100))
+	assert.EqualValues(t, notGrowing, daysUntilFull(oldest, now, 5, 100))
+	assert.EqualValues(t, notGrowing, daysUntilFull(capacitySample{ts: now, used: 10}, now, 20, 100))
+	assert.InDelta(t, 4, daysUntilFull(oldest, now, 30, 70), 1e-9)
+}
+
+func TestRootCoord_GetCapacityReport(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.GetCapacityReport(context.Background(), &rootcoordpb.GetCapacityReportRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrServiceNotReady)
+	})
+
+	queryTopology, err := metricsinfo.MarshalTopology(&metricsinfo.QueryCoordTopology{Cluster: *newTestQueryCluster(20)})
+	require.NoError(t, err)
+	dataTopology, err := metricsinfo.MarshalTopology(&metricsinfo.DataCoordTopology{})
+	require.NoError(t, err)
+
+	t.Run("failed to get metrics", func(t *testing.T) {
+		qc := mocks.NewMockQueryCoord(t)
+		qc.EXPECT().GetMetrics(mock.Anything, mock.Anything).Return(nil, fmt.Errorf("mock err"))
+		dc := mocks.NewMockDataCoord(t)
+		dc.EXPECT().GetMetrics(mock.Anything, mock.Anything).Return(&milvuspb.GetMetricsResponse{
+			Status:   merr.Status(nil),
+			Response: dataTopology,
+		}, nil)
+		c := newTestCore(withHealthyCode())
+		c.capacityPlanner = newCapacityPlanner(context.Background(), qc, dc, newMockTsoAllocator())
+		resp, err := c.GetCapacityReport(context.Background(), &rootcoordpb.GetCapacityReportRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		qc := mocks.NewMockQueryCoord(t)
+		qc.EXPECT().GetMetrics(mock.Anything, mock.Anything).Return(&milvuspb.GetMetricsResponse{
+			Status:   merr.Status(nil),
+			Response: queryTopology,
+		}, nil)
+		dc := mocks.NewMockDataCoord(t)
+		dc.EXPECT().GetMetrics(mock.Anything, mock.Anything).Return(&milvuspb.GetMetricsResponse{
+			Status:   merr.Status(nil),
+			Response: dataTopology,
+		}, nil)
+		c := newTestCore(withHealthyCode())
+		c.capacityPlanner = newCapacityPlanner(context.Background(), qc, dc, newMockTsoAllocator())
+		resp, err := c.GetCapacityReport(context.Background(), &rootcoordpb.GetCapacityReportRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, 2, len(resp.GetResourceGroups()))
+		assert.Empty(t, resp.GetNodeDisks())
+	})
+}
@@ -0,0 +1,316 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/tso"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+)
+
+// collectionStatsHistory samples the requests of each collection reported by the proxies and the binlog size
+// reported by DataCoord, the samples are kept in meta store for rootCoord.statsHistory.retention,
+// so that the capacity could be reviewed without external monitoring.
+type collectionStatsHistory struct {
+	ctx          context.Context
+	kv           kv.TxnKV
+	proxies      *proxyClientManager
+	dataCoord    types.DataCoord
+	tsoAllocator tso.Allocator
+
+	mu      sync.RWMutex
+	samples map[int64][]*rootcoordpb.CollectionStatsSample // collection id -> samples ordered by timestamp
+	// the accumulated requests reported by each proxy at the last sample,
+	// the requests during the interval are the differences between two samples
+	lastTime    time.Time
+	lastMetrics map[int64]map[int64]metricsinfo.CollectionRequestMetrics // proxy id -> collection id -> requests
+}
+
+func newCollectionStatsHistory(ctx context.Context, kv kv.TxnKV, proxies *proxyClientManager, dataCoord types.DataCoord, tsoAllocator tso.Allocator) *collectionStatsHistory {
+	return &collectionStatsHistory{
+		ctx:          ctx,
+		kv:           kv,
+		proxies:      proxies,
+		dataCoord:    dataCoord,
+		tsoAllocator: tsoAllocator,
+		samples:      make(map[int64][]*rootcoordpb.CollectionStatsSample),
+		lastMetrics:  make(map[int64]map[int64]metricsinfo.CollectionRequestMetrics),
+	}
+}
+
+// collectionStatsSampleKey returns the key of the sample, which is ordered by timestamp within the collection.
+func collectionStatsSampleKey(collectionID int64, sample *rootcoordpb.CollectionStatsSample) string {
+	return path.Join(common.CollectionStatsHistoryPrefix, strconv.FormatInt(collectionID, 10), fmt.Sprintf("%020d", sample.GetTimestamp()))
+}
+
+// load recovers the samples from meta store, the malformed ones are skipped.
+func (h *collectionStatsHistory) load() error {
+	keys, values, err := h.kv.LoadWithPrefix(common.CollectionStatsHistoryPrefix)
+	if err != nil {
+		return err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for i, key := range keys {
+		// the key is in the form of .../collection-stats-history/{collectionID}/{timestamp}
+		parts := strings.Split(key, "/")
+		if len(parts) < 2 {
+			log.Warn("skip malformed collection stats sample", zap.String("key", key))
+			continue
+		}
+		collectionID, err := strconv.ParseInt(parts[len(parts)-2], 10, 64)
+		if err != nil {
+			log.Warn("skip malformed collection stats sample", zap.String("key", key), zap.Error(err))
+			continue
+		}
+		sample := &rootcoordpb.CollectionStatsSample{}
+		if err := proto.Unmarshal([]byte(values[i]), sample); err != nil {
+			log.Warn("skip malformed collection stats sample", zap.String("key", key), zap.Error(err))
+			continue
+		}
+		h.samples[collectionID] = append(h.samples[collectionID], sample)
+	}
+	for _, samples := range h.samples {
+		sort.Slice(samples, func(i, j int) bool {
+			return samples[i].GetTimestamp() < samples[j].GetTimestamp()
+		})
+	}
+	log.Info("collection stats history loaded", zap.Int("collectionNum", len(h.samples)), zap.Int("sampleNum", len(keys)))
+	return nil
+}
+
+// sampleLoop samples the statistics of the collections every rootCoord.statsHistory.interval.
+func (h *collectionStatsHistory) sampleLoop(wg *sync.WaitGroup) {
+	defer wg.Done()
+	ticker := time.NewTicker(Params.RootCoordCfg.StatsHistoryInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-h.ctx.Done():
+			log.Info("collection stats history sample loop quit")
+			return
+		case <-ticker.C:
+			if err := h.sample(h.ctx); err != nil {
+				log.Warn("failed to sample collection stats", zap.Error(err))
+			}
+		}
+	}
+}
+
+// sample collects the metrics of the proxies and DataCoord, and records the statistics of the collections.
+func (h *collectionStatsHistory) sample(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, GetMetricsTimeout)
+	defer cancel()
+
+	req, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.SystemInfoMetrics)
+	if err != nil {
+		return err
+	}
+	proxyMetrics := make(map[int64]map[int64]metricsinfo.CollectionRequestMetrics)
+	binlogSize := make(map[int64]int64)
+	group := &errgroup.Group{}
+	group.Go(func() error {
+		rsps, err := h.proxies.GetProxyMetrics(ctx)
+		if err != nil {
+			return err
+		}
+		for _, rsp := range rsps {
+			proxyInfo := &metricsinfo.ProxyInfos{}
+			if err := metricsinfo.UnmarshalComponentInfos(rsp.GetResponse(), proxyInfo); err != nil {
+				return err
+			}
+			if proxyInfo.QuotaMetrics == nil {
+				continue
+			}
+			collections := make(map[int64]metricsinfo.CollectionRequestMetrics)
+			for _, metrics := range proxyInfo.QuotaMetrics.Collections {
+				collections[metrics.CollectionID] = metrics
+			}
+			proxyMetrics[proxyInfo.ID] = collections
+		}
+		return nil
+	})
+	group.Go(func() error {
+		rsp, err := h.dataCoord.GetMetrics(ctx, req)
+		if err != nil {
+			return err
+		}
+		if rsp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+			return fmt.Errorf("collection stats history get Data cluster failed, err = %s", rsp.GetStatus().GetReason())
+		}
+		dataTopology := &metricsinfo.DataCoordTopology{}
+		if err := metricsinfo.UnmarshalTopology(rsp.GetResponse(), dataTopology); err != nil {
+			return err
+		}
+		if dataTopology.Cluster.Self.QuotaMetrics != nil {
+			binlogSize = dataTopology.Cluster.Self.QuotaMetrics.CollectionBinlogSize
+		}
+		return nil
+	})
+	if err := group.Wait(); err != nil {
+		return err
+	}
+
+	ts, err := h.tsoAllocator.GenerateTSO(1)
+	if err != nil {
+		return err
+	}
+	now, _ := tsoutil.ParseTS(ts)
+	return h.record(now, proxyMetrics, binlogSize)
+}
+
+// record persists the statistics of the collections since the last sample, and prunes the expired samples.
+// The first sample after start only serves as the baseline of the requests.
+func (h *collectionStatsHistory) record(now time.Time, proxyMetrics map[int64]map[int64]metricsinfo.CollectionRequestMetrics, binlogSize map[int64]int64) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	lastTime, lastMetrics := h.lastTime, h.lastMetrics
+	h.lastTime, h.lastMetrics = now, proxyMetrics
+	if lastTime.IsZero() || !now.After(lastTime) {
+		return nil
+	}
+
+	// sum up the requests of each collection during the interval across the proxies
+	requests := make(map[int64]*metricsinfo.CollectionRequestMetrics)
+	for proxyID, collections := range proxyMetrics {
+		for collectionID, cur := range collections {
+			prev := lastMetrics[proxyID][collectionID]
+			if cur.SearchCount < prev.SearchCount || cur.QueryCount < prev.QueryCount {
+				// the counters are reset
+				prev = metricsinfo.CollectionRequestMetrics{}
+			}
+			sum, ok := requests[collectionID]
+			if !ok {
+				sum = &metricsinfo.CollectionRequestMetrics{CollectionID: collectionID}
+				requests[collectionID] = sum
+			}
+			sum.SearchCount += cur.SearchCount - prev.SearchCount
+			sum.QueryCount += cur.QueryCount - prev.QueryCount
+			sum.ReadLatencyMs += cur.ReadLatencyMs - prev.ReadLatencyMs
+			sum.InsertRows += cur.InsertRows - prev.InsertRows
+			sum.DeleteRows += cur.DeleteRows - prev.DeleteRows
+		}
+	}
+	for collectionID := range binlogSize {
+		if _, ok := requests[collectionID]; !ok {
+			requests[collectionID] = &metricsinfo.CollectionRequestMetrics{CollectionID: collectionID}
+		}
+	}
+
+	elapsed := now.Sub(lastTime).Seconds()
+	kvs := make(map[string]string, len(requests))
+	samples := make(map[int64]*rootcoordpb.CollectionStatsSample, len(requests))
+	for collectionID, sum := range requests {
+		reads := sum.SearchCount + sum.QueryCount
+		sample := &rootcoordpb.CollectionStatsSample{
+			Timestamp:    now.Unix(),
+			Qps:          float64(reads) / elapsed,
+			RowsInserted: sum.InsertRows,
+			RowsDeleted:  sum.DeleteRows,
+			BytesStored:  binlogSize[collectionID],
+		}
+		if reads > 0 {
+			sample.AvgLatencyMs = float64(sum.ReadLatencyMs) / float64(reads)
+		}
+		value, err := proto.Marshal(sample)
+		if err != nil {
+			return err
+		}
+		kvs[collectionStatsSampleKey(collectionID, sample)] = string(value)
+		samples[collectionID] = sample
+	}
+	if len(kvs) > 0 {
+		if err := h.kv.MultiSave(kvs); err != nil {
+			return err
+		}
+	}
+	for collectionID, sample := range samples {
+		h.samples[collectionID] = append(h.samples[collectionID], sample)
+	}
+
+	h.prune(now)
+	return nil
+}
+
+// prune removes the samples recorded before the retention, failures are ignored since they will be pruned next time.
+func (h *collectionStatsHistory) prune(now time.Time) {
+	expireBefore := now.Add(-Params.RootCoordCfg.StatsHistoryRetention.GetAsDuration(time.Second)).Unix()
+	expired := make([]string, 0)
+	remains := make(map[int64][]*rootcoordpb.CollectionStatsSample)
+	for collectionID, samples := range h.samples {
+		start := 0
+		for start < len(samples) && samples[start].GetTimestamp() < expireBefore {
+			expired = append(expired, collectionStatsSampleKey(collectionID, samples[start]))
+			start++
+		}
+		if start > 0 {
+			remains[collectionID] = samples[start:]
+		}
+	}
+	if len(expired) == 0 {
+		return
+	}
+	if err := h.kv.MultiRemove(expired); err != nil {
+		log.Warn("failed to prune collection stats history", zap.Int("num", len(expired)), zap.Error(err))
+		return
+	}
+	for collectionID, samples := range remains {
+		if len(samples) == 0 {
+			delete(h.samples, collectionID)
+			continue
+		}
+		h.samples[collectionID] = samples
+	}
+}
+
+// get returns the samples of the collection within [startTime, endTime] in unix seconds, 0 means unbounded.
+func (h *collectionStatsHistory) get(collectionID int64, startTime, endTime int64) []*rootcoordpb.CollectionStatsSample {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	ret := make([]*rootcoordpb.CollectionStatsSample, 0)
+	for _, sample := range h.samples[collectionID] {
+		if startTime > 0 && sample.GetTimestamp() < startTime {
+			continue
+		}
+		if endTime > 0 && sample.GetTimestamp() > endTime {
+			break
+		}
+		ret = append(ret, sample)
+	}
+	return ret
+}
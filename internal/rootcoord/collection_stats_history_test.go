@@ -0,0 +1,131 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
+)
+
+func TestCollectionStatsHistory(t *testing.T) {
+	kv := memkv.NewMemoryKV()
+	h := newCollectionStatsHistory(context.Background(), kv, nil, nil, newMockTsoAllocator())
+
+	start := time.Now().Add(-Params.RootCoordCfg.StatsHistoryRetention.GetAsDuration(time.Second) - time.Minute)
+	// the first sample is the baseline
+	err := h.record(start, map[int64]map[int64]metricsinfo.CollectionRequestMetrics{
+		1: {100: {CollectionID: 100, SearchCount: 10, ReadLatencyMs: 100, InsertRows: 10}},
+	}, map[int64]int64{100: 1024})
+	assert.NoError(t, err)
+	assert.Empty(t, h.get(100, 0, 0))
+
+	second := start.Add(10 * time.Second)
+	err = h.record(second, map[int64]map[int64]metricsinfo.CollectionRequestMetrics{
+		1: {100: {CollectionID: 100, SearchCount: 30, QueryCount: 20, ReadLatencyMs: 500, InsertRows: 110, DeleteRows: 5}},
+		2: {100: {CollectionID: 100, SearchCount: 50, ReadLatencyMs: 100}},
+	}, map[int64]int64{100: 2048, 200: 4096})
+	assert.NoError(t, err)
+	samples := h.get(100, 0, 0)
+	assert.Len(t, samples, 1)
+	assert.Equal(t, second.Unix(), samples[0].GetTimestamp())
+	// 20 searches and 20 queries from proxy 1, 50 searches from the new proxy 2
+	assert.InDelta(t, 9, samples[0].GetQps(), 1e-9)
+	assert.InDelta(t, 500.0/90, samples[0].GetAvgLatencyMs(), 1e-9)
+	assert.EqualValues(t, 100, samples[0].GetRowsInserted())
+	assert.EqualValues(t, 5, samples[0].GetRowsDeleted())
+	assert.EqualValues(t, 2048, samples[0].GetBytesStored())
+	samples = h.get(200, 0, 0)
+	assert.Len(t, samples, 1)
+	assert.Zero(t, samples[0].GetQps())
+	assert.EqualValues(t, 4096, samples[0].GetBytesStored())
+
+	// the samples survive restart
+	h2 := newCollectionStatsHistory(context.Background(), kv, nil, nil, newMockTsoAllocator())
+	assert.NoError(t, h2.load())
+	assert.Len(t, h2.get(100, 0, 0), 1)
+	assert.Len(t, h2.get(200, 0, 0), 1)
+
+	// the expired samples are pruned
+	third := time.Now()
+	err = h.record(third, map[int64]map[int64]metricsinfo.CollectionRequestMetrics{}, map[int64]int64{100: 4096})
+	assert.NoError(t, err)
+	samples = h.get(100, 0, 0)
+	assert.Len(t, samples, 1)
+	assert.Equal(t, third.Unix(), samples[0].GetTimestamp())
+	assert.Empty(t, h.get(200, 0, 0))
+	keys, _, err := kv.LoadWithPrefix(common.CollectionStatsHistoryPrefix)
+	assert.NoError(t, err)
+	assert.Len(t, keys, 1)
+
+	// filter by time range
+	assert.Empty(t, h.get(100, third.Unix()+1, 0))
+	assert.Empty(t, h.get(100, 0, third.Unix()-1))
+	assert.Len(t, h.get(100, third.Unix(), third.Unix()), 1)
+}
+
+func TestRootCoord_GetCollectionStatsHistory(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.GetCollectionStatsHistory(context.Background(), &rootcoordpb.GetCollectionStatsHistoryRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrServiceNotReady)
+	})
+
+	t.Run("collection not found", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(), withInvalidMeta())
+		resp, err := c.GetCollectionStatsHistory(context.Background(), &rootcoordpb.GetCollectionStatsHistoryRequest{
+			CollectionName: "coll",
+		})
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(resp.GetStatus()))
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		meta := newMockMetaTable()
+		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+			return &model.Collection{CollectionID: 100, Name: collectionName}, nil
+		}
+		c := newTestCore(withHealthyCode(), withMeta(meta))
+		c.statsHistory = newCollectionStatsHistory(context.Background(), memkv.NewMemoryKV(), nil, nil, newMockTsoAllocator())
+		c.statsHistory.samples[100] = []*rootcoordpb.CollectionStatsSample{{Timestamp: 1, Qps: 10}}
+
+		resp, err := c.GetCollectionStatsHistory(context.Background(), &rootcoordpb.GetCollectionStatsHistoryRequest{
+			CollectionName: "coll",
+		})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp.GetStatus()))
+		assert.EqualValues(t, 100, resp.GetCollectionID())
+		assert.Len(t, resp.GetSamples(), 1)
+
+		resp, err = c.GetCollectionStatsHistory(context.Background(), &rootcoordpb.GetCollectionStatsHistoryRequest{
+			CollectionID: 200,
+		})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp.GetStatus()))
+		assert.Empty(t, resp.GetSamples())
+	})
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
 )
 
 type createAliasTask struct {
@@ -37,7 +38,8 @@ func (t *createAliasTask) Prepare(ctx context.Context) error {
 }
 
 func (t *createAliasTask) Execute(ctx context.Context) error {
-	if err := t.core.ExpireMetaCache(ctx, t.Req.GetDbName(), []string{t.Req.GetAlias(), t.Req.GetCollectionName()}, InvalidCollectionID, t.GetTs()); err != nil {
+	if err := t.core.ExpireMetaCache(ctx, t.Req.GetDbName(), []string{t.Req.GetAlias(), t.Req.GetCollectionName()}, InvalidCollectionID, t.GetTs(),
+		expireCacheWithChangeType(proxypb.MetaCacheChangeType_AliasChange)); err != nil {
 		return err
 	}
 	// create alias is atomic enough.
@@ -498,6 +498,16 @@ func (t *createCollectionTask) Execute(ctx context.Context) error {
 		state:        pb.CollectionState_CollectionCreated,
 		ts:           ts,
 	}, &nullStep{}) // We'll remove the whole collection anyway.
+	undoTask.AddStep(&recordDDLEventStep{
+		baseStep: baseStep{core: t.core},
+		event: &ddlEvent{
+			Type:           ddlEventCreateCollection,
+			Timestamp:      ts,
+			DBName:         t.Req.GetDbName(),
+			CollectionName: t.Req.GetCollectionName(),
+			CollectionID:   collID,
+		},
+	}, &nullStep{})
 
 	return undoTask.Execute(ctx)
 }
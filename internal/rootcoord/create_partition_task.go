@@ -26,6 +26,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/pkg/log"
 )
 
@@ -82,6 +83,7 @@ func (t *createPartitionTask) Execute(ctx context.Context) error {
 		collectionNames: []string{t.collMeta.Name},
 		collectionID:    t.collMeta.CollectionID,
 		ts:              t.GetTs(),
+		opts:            []expireCacheOpt{expireCacheWithChangeType(proxypb.MetaCacheChangeType_PartitionChange)},
 	}, &nullStep{})
 
 	undoTask.AddStep(&addPartitionMetaStep{
@@ -115,5 +117,18 @@ func (t *createPartitionTask) Execute(ctx context.Context) error {
 		ts:           t.GetTs(),
 	}, &nullStep{})
 
+	undoTask.AddStep(&recordDDLEventStep{
+		baseStep: baseStep{core: t.core},
+		event: &ddlEvent{
+			Type:           ddlEventCreatePartition,
+			Timestamp:      t.GetTs(),
+			DBName:         t.Req.GetDbName(),
+			CollectionName: t.collMeta.Name,
+			CollectionID:   t.collMeta.CollectionID,
+			PartitionName:  t.Req.GetPartitionName(),
+			PartitionID:    partID,
+		},
+	}, &nullStep{})
+
 	return undoTask.Execute(ctx)
 }
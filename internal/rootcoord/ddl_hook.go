@@ -0,0 +1,297 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// Types of the DDL events notified to hooks.
+const (
+	ddlEventCreateCollection = "CreateCollection"
+	ddlEventDropCollection   = "DropCollection"
+	ddlEventCreatePartition  = "CreatePartition"
+	ddlEventDropPartition    = "DropPartition"
+)
+
+const (
+	// ddlOutboxPrefix is the prefix of the DDL events waiting to be delivered.
+	ddlOutboxPrefix = "ddl-outbox"
+	// ddlHookCheckInterval is the interval to check the outbox when no event is recorded.
+	ddlHookCheckInterval = 10 * time.Second
+	// ddlHookMaxBackoff is the max interval before retrying a failed event.
+	ddlHookMaxBackoff = time.Minute
+)
+
+// ddlEvent is the payload posted to DDL hooks.
+// Timestamp is the ts of the DDL, which is unique and increasing, consumers could use it to drop duplicated events.
+type ddlEvent struct {
+	Type           string `json:"type"`
+	Timestamp      uint64 `json:"timestamp"`
+	DBName         string `json:"db_name,omitempty"`
+	CollectionName string `json:"collection_name"`
+	CollectionID   int64  `json:"collection_id"`
+	PartitionName  string `json:"partition_name,omitempty"`
+	PartitionID    int64  `json:"partition_id,omitempty"`
+}
+
+// ddlHookSink delivers DDL events to an external system.
+type ddlHookSink interface {
+	Name() string
+	Notify(ctx context.Context, event *ddlEvent) error
+}
+
+// webhookSink posts DDL events as json to an url.
+type webhookSink struct {
+	url    string
+	client *http.Client
+}
+
+func newWebhookSink(url string) *webhookSink {
+	return &webhookSink{
+		url:    url,
+		client: &http.Client{},
+	}
+}
+
+func (s *webhookSink) Name() string {
+	return s.url
+}
+
+func (s *webhookSink) Notify(ctx context.Context, event *ddlEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, Params.RootCoordCfg.DDLHookTimeout.GetAsDuration(time.Millisecond))
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("webhook %s responded with status %d", s.url, resp.StatusCode)
+	}
+	return nil
+}
+
+// defaultDDLHookSinks creates the sinks of the configured webhooks.
+func defaultDDLHookSinks() []ddlHookSink {
+	sinks := make([]ddlHookSink, 0)
+	for _, url := range Params.RootCoordCfg.DDLHookWebhooks.GetAsStrings() {
+		url = strings.TrimSpace(url)
+		if url != "" {
+			sinks = append(sinks, newWebhookSink(url))
+		}
+	}
+	return sinks
+}
+
+// ddlDelivery is the delivery state of an event in outbox.
+type ddlDelivery struct {
+	failures  int
+	delivered map[string]struct{} // names of the sinks the event is delivered to
+}
+
+// ddlHookManager notifies hooks of DDL events with the outbox pattern:
+// events are persisted in the outbox by the DDL tasks before they finish,
+// then delivered to all the sinks in ts order and removed from the outbox.
+// An event may be delivered more than once if rootcoord restarts during delivery.
+type ddlHookManager struct {
+	ctx      context.Context
+	kv       kv.TxnKV
+	sinks    func() []ddlHookSink
+	notifyCh chan struct{}
+
+	// event ts -> delivery state, only accessed by the dispatch loop
+	deliveries map[uint64]*ddlDelivery
+}
+
+func newDDLHookManager(ctx context.Context, kv kv.TxnKV) *ddlHookManager {
+	return &ddlHookManager{
+		ctx:        ctx,
+		kv:         kv,
+		sinks:      defaultDDLHookSinks,
+		notifyCh:   make(chan struct{}, 1),
+		deliveries: make(map[uint64]*ddlDelivery),
+	}
+}
+
+func ddlOutboxKey(ts uint64) string {
+	return path.Join(ddlOutboxPrefix, strconv.FormatUint(ts, 10))
+}
+
+// record persists the event into outbox, it's a no-op if no hook is configured.
+func (m *ddlHookManager) record(ctx context.Context, event *ddlEvent) error {
+	if m == nil || len(m.sinks()) == 0 {
+		return nil
+	}
+	value, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	if err := m.kv.Save(ddlOutboxKey(event.Timestamp), string(value)); err != nil {
+		log.Warn("failed to record ddl event", zap.String("type", event.Type),
+			zap.Uint64("ts", event.Timestamp), zap.Error(err))
+		return err
+	}
+	select {
+	case m.notifyCh <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (m *ddlHookManager) dispatchLoop(wg *sync.WaitGroup) {
+	defer wg.Done()
+	wait := time.Duration(0)
+	for {
+		select {
+		case <-m.ctx.Done():
+			log.Info("ddl hook dispatch loop quit")
+			return
+		case <-m.notifyCh:
+		case <-time.After(wait):
+		}
+		wait = m.dispatch()
+	}
+}
+
+// loadEvents loads the events in outbox ordered by ts.
+func (m *ddlHookManager) loadEvents() ([]*ddlEvent, error) {
+	_, values, err := m.kv.LoadWithPrefix(ddlOutboxPrefix)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]*ddlEvent, 0, len(values))
+	for _, value := range values {
+		event := &ddlEvent{}
+		if err := json.Unmarshal([]byte(value), event); err != nil {
+			log.Warn("skip malformed ddl event", zap.String("value", value), zap.Error(err))
+			continue
+		}
+		events = append(events, event)
+	}
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].Timestamp < events[j].Timestamp
+	})
+	return events, nil
+}
+
+// dispatch delivers the events in outbox in order, and returns the interval before next dispatch.
+// It stops at the first event failed to deliver, so that the hooks always see events in order.
+func (m *ddlHookManager) dispatch() time.Duration {
+	sinks := m.sinks()
+	if len(sinks) == 0 {
+		return ddlHookCheckInterval
+	}
+	events, err := m.loadEvents()
+	if err != nil {
+		log.Warn("failed to load ddl events from outbox", zap.Error(err))
+		return Params.RootCoordCfg.DDLHookRetryInterval.GetAsDuration(time.Millisecond)
+	}
+
+	for _, event := range events {
+		err := m.deliver(sinks, event)
+		if err == nil {
+			if err := m.remove(event); err != nil {
+				return Params.RootCoordCfg.DDLHookRetryInterval.GetAsDuration(time.Millisecond)
+			}
+			continue
+		}
+
+		delivery := m.deliveries[event.Timestamp]
+		delivery.failures++
+		failures := delivery.failures
+
+		maxRetries := Params.RootCoordCfg.DDLHookMaxRetries.GetAsInt()
+		if maxRetries > 0 && failures > maxRetries {
+			log.Error("drop ddl event after too many retries", zap.String("type", event.Type),
+				zap.Uint64("ts", event.Timestamp), zap.Int("failures", failures), zap.Error(err))
+			if err := m.remove(event); err != nil {
+				return Params.RootCoordCfg.DDLHookRetryInterval.GetAsDuration(time.Millisecond)
+			}
+			continue
+		}
+		log.Warn("failed to deliver ddl event, will retry", zap.String("type", event.Type),
+			zap.Uint64("ts", event.Timestamp), zap.Int("failures", failures), zap.Error(err))
+		return ddlHookBackoff(failures)
+	}
+	return ddlHookCheckInterval
+}
+
+// deliver notifies the sinks which haven't received the event.
+func (m *ddlHookManager) deliver(sinks []ddlHookSink, event *ddlEvent) error {
+	delivery, ok := m.deliveries[event.Timestamp]
+	if !ok {
+		delivery = &ddlDelivery{delivered: make(map[string]struct{})}
+		m.deliveries[event.Timestamp] = delivery
+	}
+
+	for _, sink := range sinks {
+		if _, ok := delivery.delivered[sink.Name()]; ok {
+			continue
+		}
+		if err := sink.Notify(m.ctx, event); err != nil {
+			return fmt.Errorf("failed to notify %s: %w", sink.Name(), err)
+		}
+		delivery.delivered[sink.Name()] = struct{}{}
+	}
+	return nil
+}
+
+func (m *ddlHookManager) remove(event *ddlEvent) error {
+	if err := m.kv.Remove(ddlOutboxKey(event.Timestamp)); err != nil {
+		log.Warn("failed to remove ddl event from outbox", zap.Uint64("ts", event.Timestamp), zap.Error(err))
+		return err
+	}
+	delete(m.deliveries, event.Timestamp)
+	return nil
+}
+
+// ddlHookBackoff returns the interval before retrying an event failed for `failures` times.
+func ddlHookBackoff(failures int) time.Duration {
+	backoff := Params.RootCoordCfg.DDLHookRetryInterval.GetAsDuration(time.Millisecond)
+	for i := 1; i < failures && backoff < ddlHookMaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > ddlHookMaxBackoff {
+		backoff = ddlHookMaxBackoff
+	}
+	return backoff
+}
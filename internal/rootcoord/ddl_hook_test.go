@@ -0,0 +1,213 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+)
+
+type mockDDLHookSink struct {
+	name     string
+	failures int
+	events   []*ddlEvent
+}
+
+func (s *mockDDLHookSink) Name() string {
+	return s.name
+}
+
+func (s *mockDDLHookSink) Notify(ctx context.Context, event *ddlEvent) error {
+	if s.failures > 0 {
+		s.failures--
+		return errors.New("mock failure")
+	}
+	s.events = append(s.events, event)
+	return nil
+}
+
+func newTestDDLHookManager(sinks ...ddlHookSink) *ddlHookManager {
+	m := newDDLHookManager(context.Background(), memkv.NewMemoryKV())
+	m.sinks = func() []ddlHookSink { return sinks }
+	return m
+}
+
+func TestDDLHookManager_Record(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		m := newTestDDLHookManager()
+		err := m.record(context.TODO(), &ddlEvent{Type: ddlEventCreateCollection, Timestamp: 100})
+		assert.NoError(t, err)
+		events, err := m.loadEvents()
+		assert.NoError(t, err)
+		assert.Empty(t, events)
+
+		// nil manager is a no-op
+		var nilManager *ddlHookManager
+		assert.NoError(t, nilManager.record(context.TODO(), &ddlEvent{}))
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		m := newTestDDLHookManager(&mockDDLHookSink{name: "sink"})
+		err := m.record(context.TODO(), &ddlEvent{Type: ddlEventDropCollection, Timestamp: 200})
+		assert.NoError(t, err)
+		err = m.record(context.TODO(), &ddlEvent{Type: ddlEventCreateCollection, Timestamp: 100})
+		assert.NoError(t, err)
+
+		events, err := m.loadEvents()
+		assert.NoError(t, err)
+		require.Equal(t, 2, len(events))
+		assert.Equal(t, ddlEventCreateCollection, events[0].Type)
+		assert.Equal(t, ddlEventDropCollection, events[1].Type)
+		assert.Equal(t, 1, len(m.notifyCh))
+	})
+}
+
+func TestDDLHookManager_Dispatch(t *testing.T) {
+	t.Run("in order", func(t *testing.T) {
+		sink := &mockDDLHookSink{name: "sink"}
+		m := newTestDDLHookManager(sink)
+		assert.NoError(t, m.record(context.TODO(), &ddlEvent{Type: ddlEventDropPartition, Timestamp: 300}))
+		assert.NoError(t, m.record(context.TODO(), &ddlEvent{Type: ddlEventCreateCollection, Timestamp: 100}))
+		assert.NoError(t, m.record(context.TODO(), &ddlEvent{Type: ddlEventCreatePartition, Timestamp: 200}))
+
+		assert.Equal(t, ddlHookCheckInterval, m.dispatch())
+		require.Equal(t, 3, len(sink.events))
+		assert.Equal(t, uint64(100), sink.events[0].Timestamp)
+		assert.Equal(t, uint64(200), sink.events[1].Timestamp)
+		assert.Equal(t, uint64(300), sink.events[2].Timestamp)
+
+		events, err := m.loadEvents()
+		assert.NoError(t, err)
+		assert.Empty(t, events)
+	})
+
+	t.Run("retry", func(t *testing.T) {
+		ok, flaky := &mockDDLHookSink{name: "ok"}, &mockDDLHookSink{name: "flaky", failures: 2}
+		m := newTestDDLHookManager(ok, flaky)
+		assert.NoError(t, m.record(context.TODO(), &ddlEvent{Type: ddlEventCreateCollection, Timestamp: 100}))
+		assert.NoError(t, m.record(context.TODO(), &ddlEvent{Type: ddlEventDropCollection, Timestamp: 200}))
+
+		retryInterval := Params.RootCoordCfg.DDLHookRetryInterval.GetAsDuration(time.Millisecond)
+		assert.Equal(t, retryInterval, m.dispatch())
+		assert.Equal(t, 2*retryInterval, m.dispatch())
+		assert.Equal(t, ddlHookCheckInterval, m.dispatch())
+
+		// events are delivered in order, and only once to the healthy sink
+		require.Equal(t, 2, len(ok.events))
+		require.Equal(t, 2, len(flaky.events))
+		assert.Equal(t, uint64(100), ok.events[0].Timestamp)
+		assert.Equal(t, uint64(100), flaky.events[0].Timestamp)
+		events, err := m.loadEvents()
+		assert.NoError(t, err)
+		assert.Empty(t, events)
+	})
+
+	t.Run("drop after max retries", func(t *testing.T) {
+		Params.Save(Params.RootCoordCfg.DDLHookMaxRetries.Key, "1")
+		defer Params.Reset(Params.RootCoordCfg.DDLHookMaxRetries.Key)
+
+		sink := &mockDDLHookSink{name: "sink", failures: 2}
+		m := newTestDDLHookManager(sink)
+		assert.NoError(t, m.record(context.TODO(), &ddlEvent{Type: ddlEventCreateCollection, Timestamp: 100}))
+		assert.NoError(t, m.record(context.TODO(), &ddlEvent{Type: ddlEventDropCollection, Timestamp: 200}))
+
+		m.dispatch()
+		assert.Equal(t, ddlHookCheckInterval, m.dispatch())
+		require.Equal(t, 1, len(sink.events))
+		assert.Equal(t, uint64(200), sink.events[0].Timestamp)
+		assert.Empty(t, m.deliveries)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		m := newTestDDLHookManager()
+		assert.Equal(t, ddlHookCheckInterval, m.dispatch())
+	})
+}
+
+func TestDDLHookManager_DispatchLoop(t *testing.T) {
+	sink := &mockDDLHookSink{name: "sink"}
+	m := newTestDDLHookManager(sink)
+	ctx, cancel := context.WithCancel(context.Background())
+	m.ctx = ctx
+
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	go m.dispatchLoop(wg)
+	assert.NoError(t, m.record(context.TODO(), &ddlEvent{Type: ddlEventCreateCollection, Timestamp: 100}))
+	assert.Eventually(t, func() bool {
+		events, err := m.loadEvents()
+		return err == nil && len(events) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	cancel()
+	wg.Wait()
+	assert.Equal(t, 1, len(sink.events))
+}
+
+func TestWebhookSink(t *testing.T) {
+	var received *ddlEvent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		event := &ddlEvent{}
+		if err := json.NewDecoder(r.Body).Decode(event); err != nil || event.Type == "" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		received = event
+	}))
+	defer server.Close()
+
+	sink := newWebhookSink(server.URL)
+	assert.Equal(t, server.URL, sink.Name())
+	err := sink.Notify(context.TODO(), &ddlEvent{Type: ddlEventCreateCollection, Timestamp: 100, CollectionName: "coll"})
+	assert.NoError(t, err)
+	require.NotNil(t, received)
+	assert.Equal(t, "coll", received.CollectionName)
+
+	err = sink.Notify(context.TODO(), &ddlEvent{})
+	assert.Error(t, err)
+
+	err = newWebhookSink("http://invalid url").Notify(context.TODO(), &ddlEvent{})
+	assert.Error(t, err)
+}
+
+func TestDefaultDDLHookSinks(t *testing.T) {
+	assert.Empty(t, defaultDDLHookSinks())
+
+	Params.Save(Params.RootCoordCfg.DDLHookWebhooks.Key, "http://a:8080/hook, http://b:8080/hook,")
+	defer Params.Reset(Params.RootCoordCfg.DDLHookWebhooks.Key)
+	sinks := defaultDDLHookSinks()
+	require.Equal(t, 2, len(sinks))
+	assert.Equal(t, "http://a:8080/hook", sinks[0].Name())
+	assert.Equal(t, "http://b:8080/hook", sinks[1].Name())
+}
+
+func TestDDLHookBackoff(t *testing.T) {
+	retryInterval := Params.RootCoordCfg.DDLHookRetryInterval.GetAsDuration(time.Millisecond)
+	assert.Equal(t, retryInterval, ddlHookBackoff(1))
+	assert.Equal(t, 4*retryInterval, ddlHookBackoff(3))
+	assert.Equal(t, ddlHookMaxBackoff, ddlHookBackoff(100))
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
 )
 
 type dropAliasTask struct {
@@ -37,7 +38,8 @@ func (t *dropAliasTask) Prepare(ctx context.Context) error {
 
 func (t *dropAliasTask) Execute(ctx context.Context) error {
 	// drop alias is atomic enough.
-	if err := t.core.ExpireMetaCache(ctx, t.Req.GetDbName(), []string{t.Req.GetAlias()}, InvalidCollectionID, t.GetTs()); err != nil {
+	if err := t.core.ExpireMetaCache(ctx, t.Req.GetDbName(), []string{t.Req.GetAlias()}, InvalidCollectionID, t.GetTs(),
+		expireCacheWithChangeType(proxypb.MetaCacheChangeType_AliasChange)); err != nil {
 		return err
 	}
 	return t.core.meta.DropAlias(ctx, t.Req.GetDbName(), t.Req.GetAlias(), t.GetTs())
@@ -86,6 +86,16 @@ func (t *dropCollectionTask) Execute(ctx context.Context) error {
 		state:        pb.CollectionState_CollectionDropping,
 		ts:           ts,
 	})
+	redoTask.AddSyncStep(&recordDDLEventStep{
+		baseStep: baseStep{core: t.core},
+		event: &ddlEvent{
+			Type:           ddlEventDropCollection,
+			Timestamp:      ts,
+			DBName:         t.Req.GetDbName(),
+			CollectionName: collMeta.Name,
+			CollectionID:   collMeta.CollectionID,
+		},
+	})
 
 	redoTask.AddAsyncStep(&releaseCollectionStep{
 		baseStep:     baseStep{core: t.core},
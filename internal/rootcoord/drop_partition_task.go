@@ -26,6 +26,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 )
@@ -74,6 +75,7 @@ func (t *dropPartitionTask) Execute(ctx context.Context) error {
 		collectionNames: []string{t.collMeta.Name},
 		collectionID:    t.collMeta.CollectionID,
 		ts:              t.GetTs(),
+		opts:            []expireCacheOpt{expireCacheWithChangeType(proxypb.MetaCacheChangeType_PartitionChange)},
 	})
 	redoTask.AddSyncStep(&changePartitionStateStep{
 		baseStep:     baseStep{core: t.core},
@@ -82,6 +84,18 @@ func (t *dropPartitionTask) Execute(ctx context.Context) error {
 		state:        pb.PartitionState_PartitionDropping,
 		ts:           t.GetTs(),
 	})
+	redoTask.AddSyncStep(&recordDDLEventStep{
+		baseStep: baseStep{core: t.core},
+		event: &ddlEvent{
+			Type:           ddlEventDropPartition,
+			Timestamp:      t.GetTs(),
+			DBName:         t.Req.GetDbName(),
+			CollectionName: t.collMeta.Name,
+			CollectionID:   t.collMeta.CollectionID,
+			PartitionName:  t.Req.GetPartitionName(),
+			PartitionID:    partID,
+		},
+	})
 
 	redoTask.AddAsyncStep(&deletePartitionDataStep{
 		baseStep: baseStep{core: t.core},
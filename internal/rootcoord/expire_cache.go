@@ -27,9 +27,11 @@ import (
 
 type expireCacheConfig struct {
 	withDropFlag bool
+	changeType   proxypb.MetaCacheChangeType
 }
 
 func (c expireCacheConfig) apply(req *proxypb.InvalidateCollMetaCacheRequest) {
+	req.ChangeType = c.changeType
 	if !c.withDropFlag {
 		return
 	}
@@ -37,10 +39,11 @@ func (c expireCacheConfig) apply(req *proxypb.InvalidateCollMetaCacheRequest) {
 		req.Base = commonpbutil.NewMsgBase()
 	}
 	req.Base.MsgType = commonpb.MsgType_DropCollection
+	req.ChangeType = proxypb.MetaCacheChangeType_CollectionDropped
 }
 
 func defaultExpireCacheConfig() expireCacheConfig {
-	return expireCacheConfig{withDropFlag: false}
+	return expireCacheConfig{withDropFlag: false, changeType: proxypb.MetaCacheChangeType_SchemaChange}
 }
 
 type expireCacheOpt func(c *expireCacheConfig)
@@ -51,6 +54,12 @@ func expireCacheWithDropFlag() expireCacheOpt {
 	}
 }
 
+func expireCacheWithChangeType(changeType proxypb.MetaCacheChangeType) expireCacheOpt {
+	return func(c *expireCacheConfig) {
+		c.changeType = changeType
+	}
+}
+
 // ExpireMetaCache will call invalidate collection meta cache
 func (c *Core) ExpireMetaCache(ctx context.Context, dbName string, collNames []string, collectionID UniqueID, ts typeutil.Timestamp, opts ...expireCacheOpt) error {
 	// if collectionID is specified, invalidate all the collection meta cache with the specified collectionID and return
@@ -62,8 +71,9 @@ func (c *Core) ExpireMetaCache(ctx context.Context, dbName string, collNames []s
 			),
 			DbName:       dbName,
 			CollectionID: collectionID,
+			Revision:     ts,
 		}
-		return c.proxyClientManager.InvalidateCollectionMetaCache(ctx, &req, opts...)
+		return c.invalidateCollectionMetaCache(ctx, &req, opts...)
 	}
 
 	// if only collNames are specified, invalidate the collection meta cache with the specified collectionName
@@ -77,8 +87,9 @@ func (c *Core) ExpireMetaCache(ctx context.Context, dbName string, collNames []s
 			),
 			DbName:         dbName,
 			CollectionName: collName,
+			Revision:       ts,
 		}
-		err := c.proxyClientManager.InvalidateCollectionMetaCache(ctx, &req, opts...)
+		err := c.invalidateCollectionMetaCache(ctx, &req, opts...)
 		if err != nil {
 			// TODO: try to expire all or directly return err?
 			return err
@@ -86,3 +97,16 @@ func (c *Core) ExpireMetaCache(ctx context.Context, dbName string, collNames []s
 	}
 	return nil
 }
+
+// invalidateCollectionMetaCache publishes the invalidation for proxies to watch, then notifies the online proxies.
+func (c *Core) invalidateCollectionMetaCache(ctx context.Context, req *proxypb.InvalidateCollMetaCacheRequest, opts ...expireCacheOpt) error {
+	config := defaultExpireCacheConfig()
+	for _, opt := range opts {
+		opt(&config)
+	}
+	config.apply(req)
+	if err := c.metaCacheEventPublisher.publish(req); err != nil {
+		return err
+	}
+	return c.proxyClientManager.InvalidateCollectionMetaCache(ctx, req, opts...)
+}
@@ -30,8 +30,13 @@ func Test_expireCacheConfig_apply(t *testing.T) {
 	req := &proxypb.InvalidateCollMetaCacheRequest{}
 	c.apply(req)
 	assert.Nil(t, req.GetBase())
+	assert.Equal(t, proxypb.MetaCacheChangeType_SchemaChange, req.GetChangeType())
+	expireCacheWithChangeType(proxypb.MetaCacheChangeType_AliasChange)(&c)
+	c.apply(req)
+	assert.Equal(t, proxypb.MetaCacheChangeType_AliasChange, req.GetChangeType())
 	opt := expireCacheWithDropFlag()
 	opt(&c)
 	c.apply(req)
 	assert.Equal(t, commonpb.MsgType_DropCollection, req.GetBase().GetMsgType())
+	assert.Equal(t, proxypb.MetaCacheChangeType_CollectionDropped, req.GetChangeType())
 }
@@ -42,6 +42,8 @@ type GetSegmentIndexStateFunc func(ctx context.Context, collID UniqueID, indexNa
 
 type UnsetIsImportingStateFunc func(context.Context, *datapb.UnsetIsImportingStateRequest) (*commonpb.Status, error)
 
+type MarkSegmentsDroppedFunc func(context.Context, *datapb.MarkSegmentsDroppedRequest) (*commonpb.Status, error)
+
 type ImportFactory interface {
 	NewGetCollectionNameFunc() GetCollectionNameFunc
 	NewIDAllocator() IDAllocator
@@ -50,6 +52,7 @@ type ImportFactory interface {
 	NewDescribeIndexFunc() DescribeIndexFunc
 	NewGetSegmentIndexStateFunc() GetSegmentIndexStateFunc
 	NewUnsetIsImportingStateFunc() UnsetIsImportingStateFunc
+	NewMarkSegmentsDroppedFunc() MarkSegmentsDroppedFunc
 }
 
 type ImportFactoryImpl struct {
@@ -84,6 +87,10 @@ func (f ImportFactoryImpl) NewUnsetIsImportingStateFunc() UnsetIsImportingStateF
 	return UnsetIsImportingStateWithCore(f.c)
 }
 
+func (f ImportFactoryImpl) NewMarkSegmentsDroppedFunc() MarkSegmentsDroppedFunc {
+	return MarkSegmentsDroppedWithCore(f.c)
+}
+
 func NewImportFactory(c *Core) ImportFactory {
 	return &ImportFactoryImpl{c: c}
 }
@@ -140,3 +147,9 @@ func UnsetIsImportingStateWithCore(c *Core) UnsetIsImportingStateFunc {
 		return c.broker.UnsetIsImportingState(ctx, req)
 	}
 }
+
+func MarkSegmentsDroppedWithCore(c *Core) MarkSegmentsDroppedFunc {
+	return func(ctx context.Context, req *datapb.MarkSegmentsDroppedRequest) (*commonpb.Status, error) {
+		return c.broker.MarkSegmentsDropped(ctx, req)
+	}
+}
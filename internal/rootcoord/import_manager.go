@@ -19,6 +19,7 @@ package rootcoord
 import (
 	"context"
 	"fmt"
+	"path"
 	"sort"
 	"strconv"
 	"strings"
@@ -82,6 +83,7 @@ type importManager struct {
 	getCollectionName         func(dbName string, collID, partitionID typeutil.UniqueID) (string, string, error)
 	callGetSegmentStates      func(ctx context.Context, req *datapb.GetSegmentStatesRequest) (*datapb.GetSegmentStatesResponse, error)
 	callUnsetIsImportingState func(context.Context, *datapb.UnsetIsImportingStateRequest) (*commonpb.Status, error)
+	callMarkSegmentsDropped   func(context.Context, *datapb.MarkSegmentsDroppedRequest) (*commonpb.Status, error)
 }
 
 // newImportManager helper function to create a importManager
@@ -90,7 +92,8 @@ func newImportManager(ctx context.Context, client kv.TxnKV,
 	importService func(ctx context.Context, req *datapb.ImportTaskRequest) (*datapb.ImportTaskResponse, error),
 	getSegmentStates func(ctx context.Context, req *datapb.GetSegmentStatesRequest) (*datapb.GetSegmentStatesResponse, error),
 	getCollectionName func(dbName string, collID, partitionID typeutil.UniqueID) (string, string, error),
-	unsetIsImportingState func(context.Context, *datapb.UnsetIsImportingStateRequest) (*commonpb.Status, error)) *importManager {
+	unsetIsImportingState func(context.Context, *datapb.UnsetIsImportingStateRequest) (*commonpb.Status, error),
+	markSegmentsDropped func(context.Context, *datapb.MarkSegmentsDroppedRequest) (*commonpb.Status, error)) *importManager {
 	mgr := &importManager{
 		ctx:                       ctx,
 		taskStore:                 client,
@@ -106,6 +109,7 @@ func newImportManager(ctx context.Context, client kv.TxnKV,
 		callGetSegmentStates:      getSegmentStates,
 		getCollectionName:         getCollectionName,
 		callUnsetIsImportingState: unsetIsImportingState,
+		callMarkSegmentsDropped:   markSegmentsDropped,
 	}
 	return mgr
 }
@@ -205,7 +209,7 @@ func (m *importManager) sendOutTasks(ctx context.Context) error {
 			PartitionId:  task.GetPartitionId(),
 			ChannelNames: task.GetChannelNames(),
 			TaskId:       task.GetId(),
-			Files:        task.GetFiles(),
+			Files:        remainingFiles(task),
 			Infos:        task.GetInfos(),
 			DatabaseName: task.GetDatabaseName(),
 		}
@@ -295,7 +299,13 @@ func (m *importManager) loadAndFlipPersistedTasks(ctx context.Context) error {
 		return err
 	}
 
+	jobTasks := make(map[int64][]*datapb.ImportTaskInfo)
 	for _, task := range importTasks {
+		// Tasks of an import job are flipped together.
+		if task.GetJobId() != 0 {
+			jobTasks[task.GetJobId()] = append(jobTasks[task.GetJobId()], task)
+			continue
+		}
 		// Checking if ImportPersisted --> ImportCompleted ready.
 		if task.GetState().GetStateCode() == commonpb.ImportState_ImportPersisted {
 			log.Info("<ImportPersisted> task found, checking if it is eligible to become <ImportCompleted>",
@@ -303,7 +313,7 @@ func (m *importManager) loadAndFlipPersistedTasks(ctx context.Context) error {
 			importTask := m.getTaskState(task.GetId())
 
 			// if this method failed, skip this task, try again in next round
-			if err = m.flipTaskFlushedState(ctx, importTask, task.GetDatanodeId()); err != nil {
+			if err = m.flipTaskFlushedState(ctx, importTask, task.GetDatanodeId(), task.GetDiscardedSegments()); err != nil {
 				log.Error("failed to flip task flushed state",
 					zap.Int64("task ID", task.GetId()),
 					zap.Error(err))
@@ -313,10 +323,233 @@ func (m *importManager) loadAndFlipPersistedTasks(ctx context.Context) error {
 			}
 		}
 	}
+
+	for jobID, tasks := range jobTasks {
+		if err = m.flipJobFlushedState(ctx, jobID, tasks); err != nil {
+			log.Error("failed to flip job flushed state",
+				zap.Int64("job ID", jobID),
+				zap.Error(err))
+		}
+	}
 	return nil
 }
 
-func (m *importManager) flipTaskFlushedState(ctx context.Context, importTask *milvuspb.GetImportStateResponse, dataNodeID int64) error {
+// flipJobFlushedState flips all the tasks of an import job from `ImportPersisted` to `ImportCompleted` together.
+// It waits until every task of the job is persisted and all the segments are flushed, then unsets the isImporting
+// flag of all the segments in one call, so that the data of the job becomes visible atomically.
+func (m *importManager) flipJobFlushedState(ctx context.Context, jobID int64, tasks []*datapb.ImportTaskInfo) error {
+	persisted := make([]*datapb.ImportTaskInfo, 0, len(tasks))
+	flushed := make([]*datapb.ImportTaskInfo, 0, len(tasks))
+	segmentIDs := make([]int64, 0)
+	for _, task := range tasks {
+		switch task.GetState().GetStateCode() {
+		case commonpb.ImportState_ImportPersisted:
+			persisted = append(persisted, task)
+			segmentIDs = append(segmentIDs, task.GetState().GetSegments()...)
+		case commonpb.ImportState_ImportFlushed:
+			flushed = append(flushed, task)
+		case commonpb.ImportState_ImportCompleted:
+			// the job was interrupted while flipping task states, the segments had been visible.
+			segmentIDs = append(segmentIDs, task.GetState().GetSegments()...)
+		default:
+			// still working, or the job has failed.
+			return nil
+		}
+	}
+	log := log.With(zap.Int64("job ID", jobID))
+	if len(flushed) > 0 {
+		return m.flipJobConvertedState(ctx, jobID, flushed)
+	}
+	if len(persisted) == 0 {
+		return nil
+	}
+
+	log.Info("all tasks of the import job are persisted, checking if it is eligible to become <ImportCompleted>",
+		zap.Int("task number", len(tasks)))
+	ok, err := m.checkFlushDone(ctx, segmentIDs)
+	if err != nil {
+		log.Error("an error occurred while checking flush state of segments", zap.Error(err))
+		if errors.Is(err, errSegmentNotExist) {
+			for _, task := range persisted {
+				m.markTaskFailed(task)
+			}
+		}
+		return err
+	}
+	if !ok {
+		return nil
+	}
+
+	// The discarded segments of the retried tasks are dropped before the job becomes visible.
+	discarded := lo.FlatMap(persisted, func(task *datapb.ImportTaskInfo, _ int) []int64 {
+		return task.GetDiscardedSegments()
+	})
+	if err := m.dropSegments(ctx, discarded); err != nil {
+		log.Error("failed to drop the discarded segments of the import job, will retry later", zap.Error(err))
+		return err
+	}
+
+	if m.callUnsetIsImportingState == nil {
+		log.Error("callUnsetIsImportingState function of importManager is nil")
+		return fmt.Errorf("failed to unset importing state: method of import manager is nil")
+	}
+	status, err := m.callUnsetIsImportingState(ctx, &datapb.UnsetIsImportingStateRequest{
+		SegmentIds: segmentIDs,
+	})
+	if err == nil && status.GetErrorCode() != commonpb.ErrorCode_Success {
+		err = errors.New(status.GetReason())
+	}
+	if err != nil {
+		log.Error("failed to unset importing state of the import job, will retry later", zap.Error(err))
+		return err
+	}
+	// The segments registered on the external files are queryable after they're converted into binlogs,
+	// the job is completed once the conversion is done.
+	targetState := commonpb.ImportState_ImportCompleted
+	if importutil.IsExternal(persisted[0].GetInfos()) {
+		targetState = commonpb.ImportState_ImportFlushed
+	}
+	for _, task := range persisted {
+		if err := m.setImportTaskState(task.GetId(), targetState); err != nil {
+			log.Error("failed to set import task state",
+				zap.Int64("task ID", task.GetId()),
+				zap.Any("target state", targetState),
+				zap.Error(err))
+			return err
+		}
+	}
+	log.Info("import job flipped", zap.Int64s("segment IDs", segmentIDs), zap.Any("state", targetState))
+	return nil
+}
+
+// flipJobConvertedState flips the tasks of an import job registering the external files from `ImportFlushed` to
+// `ImportCompleted`, after all the registered segments are converted into binlogs. The registered segments are
+// dropped once they're replaced by the converted segments.
+func (m *importManager) flipJobConvertedState(ctx context.Context, jobID int64, tasks []*datapb.ImportTaskInfo) error {
+	log := log.With(zap.Int64("job ID", jobID))
+	segmentIDs := lo.FlatMap(tasks, func(task *datapb.ImportTaskInfo, _ int) []int64 {
+		return task.GetState().GetSegments()
+	})
+	resp, err := m.callGetSegmentStates(ctx, &datapb.GetSegmentStatesRequest{
+		SegmentIDs: segmentIDs,
+	})
+	if err == nil && resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		err = errors.New(resp.GetStatus().GetReason())
+	}
+	if err != nil {
+		log.Error("failed to get the segment states of the import job", zap.Int64s("segment IDs", segmentIDs), zap.Error(err))
+		return err
+	}
+	for _, state := range resp.GetStates() {
+		if state.GetState() != commonpb.SegmentState_NotExist {
+			log.Debug("the external segments of the import job are not converted yet",
+				zap.Int64("segment ID", state.GetSegmentID()))
+			return nil
+		}
+	}
+	for _, task := range tasks {
+		if err := m.setImportTaskState(task.GetId(), commonpb.ImportState_ImportCompleted); err != nil {
+			log.Error("failed to set import task state",
+				zap.Int64("task ID", task.GetId()),
+				zap.Any("target state", commonpb.ImportState_ImportCompleted),
+				zap.Error(err))
+			return err
+		}
+	}
+	log.Info("import job completed, the external segments are converted", zap.Int64s("segment IDs", segmentIDs))
+	return nil
+}
+
+// retryTask puts a failed working task back to the pending list if it has retries left, returns false if the task
+// is not retried. The retried task resumes from the files completed by the previous attempts, the segments generated
+// by the failed attempt for the uncompleted files never become visible, they are kept as discarded segments of the
+// task so that they are cleaned up along with the task.
+func (m *importManager) retryTask(taskID int64) (bool, error) {
+	m.pendingLock.Lock()
+	defer m.pendingLock.Unlock()
+	m.workingLock.Lock()
+	defer m.workingLock.Unlock()
+
+	task, ok := m.workingTasks[taskID]
+	if !ok || task.GetRetryCount() >= Params.RootCoordCfg.ImportTaskMaxRetries.GetAsInt64() {
+		return false, nil
+	}
+
+	// Meta persist should be done before memory objs change.
+	toPersistImportTaskInfo := cloneImportTaskInfo(task)
+	toPersistImportTaskInfo.RetryCount++
+	// The progress committed by the failed attempt is kept, the retried task resumes from it.
+	checkpoint := mergeCheckpoint(task.GetCheckpoint(), task.GetAttemptCheckpoint())
+	toPersistImportTaskInfo.Checkpoint = checkpoint
+	toPersistImportTaskInfo.AttemptCheckpoint = nil
+	discarded := lo.Without(task.GetState().GetSegments(), checkpoint.GetSegments()...)
+	toPersistImportTaskInfo.DiscardedSegments = lo.Flatten([][]int64{task.GetDiscardedSegments(), discarded})
+	toPersistImportTaskInfo.State = &datapb.ImportTaskState{
+		StateCode:    commonpb.ImportState_ImportPending,
+		Segments:     lo.Flatten([][]int64{checkpoint.GetSegments()}),
+		RowCount:     checkpoint.GetRowCount(),
+		RowIds:       lo.Flatten([][]int64{checkpoint.GetAutoIds()}),
+		ErrorMessage: task.GetState().GetErrorMessage(),
+	}
+	toPersistImportTaskInfo.Infos = lo.Filter(task.GetInfos(), func(kv *commonpb.KeyValuePair, _ int) bool {
+		return kv.GetKey() != importutil.ProgressPercent
+	})
+	if err := m.persistTaskInfo(toPersistImportTaskInfo); err != nil {
+		log.Error("failed to update import task",
+			zap.Int64("task ID", taskID),
+			zap.Error(err))
+		return false, err
+	}
+	delete(m.workingTasks, taskID)
+	m.pendingTasks = append(m.pendingTasks, toPersistImportTaskInfo)
+	log.Info("failed import task is put back to pending list",
+		zap.Int64("task ID", taskID),
+		zap.Int64("retry count", toPersistImportTaskInfo.GetRetryCount()),
+		zap.Int64s("discarded segment IDs", discarded))
+	return true, nil
+}
+
+// failJob marks the other unfinished tasks of the import job as failed after a task of the job failed permanently,
+// so that none of the segments of the job become visible.
+func (m *importManager) failJob(task *datapb.ImportTaskInfo) {
+	if task.GetJobId() == 0 {
+		return
+	}
+	tasks, err := m.loadFromTaskStore(false)
+	if err != nil {
+		log.Error("failed to load from task store", zap.Error(err))
+		return
+	}
+	for _, t := range tasks {
+		if t.GetJobId() != task.GetJobId() || t.GetId() == task.GetId() {
+			continue
+		}
+		switch t.GetState().GetStateCode() {
+		case commonpb.ImportState_ImportFailed, commonpb.ImportState_ImportFailedAndCleaned, commonpb.ImportState_ImportCompleted,
+			commonpb.ImportState_ImportFlushed:
+			continue
+		}
+		if err := m.setImportTaskStateAndReason(t.GetId(), commonpb.ImportState_ImportFailed,
+			fmt.Sprintf("task %d of the import job failed", task.GetId())); err != nil {
+			log.Warn("failed to set import task state",
+				zap.Int64("task ID", t.GetId()),
+				zap.Any("target state", commonpb.ImportState_ImportFailed),
+				zap.Error(err))
+			continue
+		}
+		// Pending tasks of the failed job are not sent out anymore.
+		m.pendingLock.Lock()
+		m.pendingTasks = lo.Filter(m.pendingTasks, func(pending *datapb.ImportTaskInfo, _ int) bool {
+			return pending.GetId() != t.GetId()
+		})
+		m.pendingLock.Unlock()
+	}
+	log.Info("import job failed",
+		zap.Int64("job ID", task.GetJobId()),
+		zap.Int64("failed task ID", task.GetId()))
+}
+
+func (m *importManager) flipTaskFlushedState(ctx context.Context, importTask *milvuspb.GetImportStateResponse, dataNodeID int64, discarded []int64) error {
 	ok, err := m.checkFlushDone(ctx, importTask.GetSegmentIds())
 	if err != nil {
 		log.Error("an error occurred while checking flush state of segments",
@@ -325,6 +558,13 @@ func (m *importManager) flipTaskFlushedState(ctx context.Context, importTask *mi
 		return err
 	}
 	if ok {
+		// The discarded segments of the previous attempts are dropped before the task becomes visible.
+		if err := m.dropSegments(ctx, discarded); err != nil {
+			log.Error("failed to drop the discarded segments of the import task, will retry later",
+				zap.Int64("task ID", importTask.GetId()),
+				zap.Error(err))
+			return err
+		}
 		// All segments are flushed. DataNode becomes available.
 		func() {
 			m.busyNodesLock.Lock()
@@ -415,6 +655,60 @@ func (m *importManager) isRowbased(files []string) (bool, error) {
 	return isRowBased, nil
 }
 
+// shardFiles splits the files of a column-based import request into groups, each group contains all the fields and
+// could be imported separately. Each parquet file contains all the fields and makes a group, numpy files are grouped
+// by directory if every directory holds the same set of fields. Nil is returned if the files could not be split.
+func (m *importManager) shardFiles(files []string) [][]string {
+	if len(files) == 0 {
+		return nil
+	}
+	isParquet := lo.EveryBy(files, func(filePath string) bool {
+		_, fileType := importutil.GetFileNameAndExt(filePath)
+		return fileType == importutil.ParquetFileExt
+	})
+	if isParquet {
+		return lo.Map(files, func(file string, _ int) []string {
+			return []string{file}
+		})
+	}
+
+	isNumpy := lo.EveryBy(files, func(filePath string) bool {
+		_, fileType := importutil.GetFileNameAndExt(filePath)
+		return fileType == importutil.NumpyFileExt
+	})
+	if !isNumpy {
+		return nil
+	}
+	dirs := make([]string, 0)
+	groups := make(map[string][]string)
+	for _, file := range files {
+		dir := path.Dir(file)
+		if _, ok := groups[dir]; !ok {
+			dirs = append(dirs, dir)
+		}
+		groups[dir] = append(groups[dir], file)
+	}
+	if len(dirs) < 2 {
+		return nil
+	}
+	fieldNames := func(group []string) string {
+		names := lo.Map(group, func(file string, _ int) string {
+			return path.Base(file)
+		})
+		sort.Strings(names)
+		return strings.Join(names, ",")
+	}
+	fields := fieldNames(groups[dirs[0]])
+	for _, dir := range dirs[1:] {
+		if fields != fieldNames(groups[dir]) {
+			return nil
+		}
+	}
+	return lo.Map(dirs, func(dir string, _ int) []string {
+		return groups[dir]
+	})
+}
+
 // importJob processes the import request, generates import tasks, sends these tasks to DataCoord, and returns
 // immediately.
 func (m *importManager) importJob(ctx context.Context, req *milvuspb.ImportRequest, cID int64, pID int64) *milvuspb.ImportResponse {
@@ -459,10 +753,18 @@ func (m *importManager) importJob(ctx context.Context, req *milvuspb.ImportReque
 			return err
 		}
 
-		taskCount := 1
+		// row-based files and the file groups containing all fields could be imported separately, each of them makes
+		// a task, so that the files of a job are imported by multiple DataNodes in parallel.
+		// for other column-based files, all files is a task
+		taskFiles := [][]string{req.GetFiles()}
 		if isRowBased {
-			taskCount = len(req.Files)
+			taskFiles = lo.Map(req.GetFiles(), func(file string, _ int) []string {
+				return []string{file}
+			})
+		} else if shards := m.shardFiles(req.GetFiles()); len(shards) > 0 {
+			taskFiles = shards
 		}
+		taskCount := len(taskFiles)
 
 		// task queue size has a limit, return error if import request contains too many data files, and skip entire job
 		if capacity-length < taskCount {
@@ -471,74 +773,41 @@ func (m *importManager) importJob(ctx context.Context, req *milvuspb.ImportReque
 			return err
 		}
 
-		// convert import request to import tasks
-		if isRowBased {
-			// For row-based importing, each file makes a task.
-			taskList := make([]int64, len(req.Files))
-			for i := 0; i < len(req.Files); i++ {
-				tID, _, err := m.idAllocator(1)
-				if err != nil {
-					log.Error("failed to allocate ID for import task", zap.Error(err))
-					return err
-				}
-				newTask := &datapb.ImportTaskInfo{
-					Id:           tID,
-					CollectionId: cID,
-					PartitionId:  pID,
-					ChannelNames: req.ChannelNames,
-					Files:        []string{req.GetFiles()[i]},
-					CreateTs:     time.Now().Unix(),
-					State: &datapb.ImportTaskState{
-						StateCode: commonpb.ImportState_ImportPending,
-					},
-					Infos:        req.Options,
-					DatabaseName: req.GetDbName(),
-				}
-
-				// Here no need to check error returned by setCollectionPartitionName(),
-				// since here we always return task list to client no matter something missed.
-				// We make the method setCollectionPartitionName() returns error
-				// because we need to make sure coverage all the code branch in unittest case.
-				_ = m.setCollectionPartitionName(req.GetDbName(), cID, pID, newTask)
-				resp.Tasks = append(resp.Tasks, newTask.GetId())
-				taskList[i] = newTask.GetId()
-				log.Info("new task created as pending task",
-					zap.Int64("task ID", newTask.GetId()))
-				if err := m.persistTaskInfo(newTask); err != nil {
-					log.Error("failed to update import task",
-						zap.Int64("task ID", newTask.GetId()),
-						zap.Error(err))
-					return err
-				}
-				m.pendingTasks = append(m.pendingTasks, newTask)
-			}
-			log.Info("row-based import request processed", zap.Any("task IDs", taskList))
-		} else {
-			// TODO: Merge duplicated code :(
-			// for column-based, all files is a task
+		// convert import request to import tasks, the ID of the first task is used as the job ID
+		jobID := int64(0)
+		taskList := make([]int64, 0, taskCount)
+		for _, files := range taskFiles {
 			tID, _, err := m.idAllocator(1)
 			if err != nil {
+				log.Error("failed to allocate ID for import task", zap.Error(err))
 				return err
 			}
+			if jobID == 0 {
+				jobID = tID
+			}
 			newTask := &datapb.ImportTaskInfo{
 				Id:           tID,
 				CollectionId: cID,
 				PartitionId:  pID,
 				ChannelNames: req.ChannelNames,
-				Files:        req.GetFiles(),
+				Files:        files,
 				CreateTs:     time.Now().Unix(),
 				State: &datapb.ImportTaskState{
 					StateCode: commonpb.ImportState_ImportPending,
 				},
-				Infos:        req.Options,
+				// tasks of a job update their own infos, don't share the slice
+				Infos:        append([]*commonpb.KeyValuePair{}, req.GetOptions()...),
 				DatabaseName: req.GetDbName(),
+				JobId:        jobID,
 			}
+
 			// Here no need to check error returned by setCollectionPartitionName(),
 			// since here we always return task list to client no matter something missed.
 			// We make the method setCollectionPartitionName() returns error
 			// because we need to make sure coverage all the code branch in unittest case.
 			_ = m.setCollectionPartitionName(req.GetDbName(), cID, pID, newTask)
 			resp.Tasks = append(resp.Tasks, newTask.GetId())
+			taskList = append(taskList, newTask.GetId())
 			log.Info("new task created as pending task",
 				zap.Int64("task ID", newTask.GetId()))
 			if err := m.persistTaskInfo(newTask); err != nil {
@@ -548,9 +817,11 @@ func (m *importManager) importJob(ctx context.Context, req *milvuspb.ImportReque
 				return err
 			}
 			m.pendingTasks = append(m.pendingTasks, newTask)
-			log.Info("column-based import request processed",
-				zap.Int64("task ID", newTask.GetId()))
 		}
+		log.Info("import request processed",
+			zap.Int64("job ID", jobID),
+			zap.Bool("row-based", isRowBased),
+			zap.Int64s("task IDs", taskList))
 		return nil
 	}()
 	if err != nil {
@@ -590,20 +861,33 @@ func (m *importManager) updateTaskInfo(ir *rootcoordpb.ImportResult) (*datapb.Im
 			// Meta persist should be done before memory objs change.
 			toPersistImportTaskInfo = cloneImportTaskInfo(v)
 			toPersistImportTaskInfo.State.StateCode = ir.GetState()
+			// the import result only covers the current attempt, the progress of the previous attempts is added
+			checkpoint := v.GetCheckpoint()
 			// if is started state, append the new created segment id
 			if v.GetState().GetStateCode() == commonpb.ImportState_ImportStarted {
 				toPersistImportTaskInfo.State.Segments = append(toPersistImportTaskInfo.State.Segments, ir.GetSegments()...)
 			} else {
-				toPersistImportTaskInfo.State.Segments = ir.GetSegments()
+				toPersistImportTaskInfo.State.Segments = lo.Flatten([][]int64{checkpoint.GetSegments(), ir.GetSegments()})
+			}
+			toPersistImportTaskInfo.State.RowCount = checkpoint.GetRowCount() + ir.GetRowCount()
+			toPersistImportTaskInfo.State.RowIds = lo.Flatten([][]int64{checkpoint.GetAutoIds(), ir.GetAutoIds()})
+			// a report carrying a new completed file is taken as the checkpoint of the current attempt
+			if len(ir.GetCompletedFiles()) > len(v.GetAttemptCheckpoint().GetFiles()) {
+				toPersistImportTaskInfo.AttemptCheckpoint = &datapb.ImportCheckpoint{
+					Files:    ir.GetCompletedFiles(),
+					Segments: ir.GetSegments(),
+					RowCount: ir.GetRowCount(),
+					AutoIds:  ir.GetAutoIds(),
+				}
 			}
-			toPersistImportTaskInfo.State.RowCount = ir.GetRowCount()
-			toPersistImportTaskInfo.State.RowIds = ir.GetAutoIds()
 			for _, kv := range ir.GetInfos() {
 				if kv.GetKey() == importutil.FailedReason {
 					toPersistImportTaskInfo.State.ErrorMessage = kv.GetValue()
 					break
 				} else if kv.GetKey() == importutil.PersistTimeCost ||
-					kv.GetKey() == importutil.ProgressPercent {
+					kv.GetKey() == importutil.ProgressPercent ||
+					kv.GetKey() == importutil.ErrorReportFile ||
+					kv.GetKey() == importutil.RejectedRows {
 					importutil.UpdateKVInfo(&toPersistImportTaskInfo.Infos, kv.GetKey(), kv.GetValue())
 				}
 			}
@@ -754,6 +1038,69 @@ func (m *importManager) copyTaskInfo(input *datapb.ImportTaskInfo, output *milvu
 		Value: input.GetState().GetErrorMessage(),
 	})
 	output.Infos = append(output.Infos, input.Infos...)
+	if input.GetJobId() != 0 {
+		output.Infos = append(output.Infos, &commonpb.KeyValuePair{Key: importutil.JobID, Value: strconv.FormatInt(input.GetJobId(), 10)})
+	}
+}
+
+// taskProgress returns the progress percent of an import task.
+func taskProgress(task *datapb.ImportTaskInfo) int64 {
+	if task.GetState().GetStateCode() == commonpb.ImportState_ImportCompleted {
+		return 100
+	}
+	for _, kv := range task.GetInfos() {
+		if kv.GetKey() == importutil.ProgressPercent {
+			percent, err := strconv.ParseInt(kv.GetValue(), 10, 64)
+			if err == nil {
+				return percent
+			}
+		}
+	}
+	return 0
+}
+
+// jobProgress aggregates the progress percent of all the tasks of each import job.
+func jobProgress(tasks []*datapb.ImportTaskInfo) map[int64]int64 {
+	sum := make(map[int64]int64)
+	count := make(map[int64]int64)
+	for _, task := range tasks {
+		if task.GetJobId() == 0 {
+			continue
+		}
+		sum[task.GetJobId()] += taskProgress(task)
+		count[task.GetJobId()]++
+	}
+	progress := make(map[int64]int64, len(sum))
+	for jobID, total := range sum {
+		progress[jobID] = total / count[jobID]
+	}
+	return progress
+}
+
+// fillTaskJobProgress appends the progress percent of the import job which the task belongs to.
+func (m *importManager) fillTaskJobProgress(resp *milvuspb.GetImportStateResponse) {
+	jobID := int64(0)
+	for _, kv := range resp.GetInfos() {
+		if kv.GetKey() == importutil.JobID {
+			jobID, _ = strconv.ParseInt(kv.GetValue(), 10, 64)
+		}
+	}
+	if jobID == 0 {
+		return
+	}
+	tasks, err := m.loadFromTaskStore(false)
+	if err != nil {
+		log.Warn("failed to load from task store", zap.Error(err))
+		return
+	}
+	fillJobProgress(jobID, jobProgress(tasks), resp)
+}
+
+// fillJobProgress appends the progress percent of the import job to the task state.
+func fillJobProgress(jobID int64, progress map[int64]int64, output *milvuspb.GetImportStateResponse) {
+	if percent, ok := progress[jobID]; ok {
+		output.Infos = append(output.Infos, &commonpb.KeyValuePair{Key: importutil.JobProgressPercent, Value: strconv.FormatInt(percent, 10)})
+	}
 }
 
 // getTaskState looks for task with the given ID and returns its import state.
@@ -842,9 +1189,11 @@ func (m *importManager) loadFromTaskStore(load2Mem bool) ([]*datapb.ImportTaskIn
 			} else {
 				// other non-failed and non-completed tasks should be marked failed, so the bad s egments
 				// can be cleaned up in `removeBadImportSegmentsLoop`.
+				// the segments of the flushed tasks are registered, they're only waiting for the conversion.
 				if ti.GetState().GetStateCode() != commonpb.ImportState_ImportFailed &&
 					ti.GetState().GetStateCode() != commonpb.ImportState_ImportFailedAndCleaned &&
-					ti.GetState().GetStateCode() != commonpb.ImportState_ImportCompleted {
+					ti.GetState().GetStateCode() != commonpb.ImportState_ImportCompleted &&
+					ti.GetState().GetStateCode() != commonpb.ImportState_ImportFlushed {
 					ti.State.StateCode = commonpb.ImportState_ImportFailed
 					if ti.GetState().GetErrorMessage() == "" {
 						ti.State.ErrorMessage = "task marked failed as service restarted"
@@ -907,12 +1256,14 @@ func (m *importManager) expireOldTasksFromMem() {
 	// let the sendOutTasksLoop() push pending tasks into datanodes.
 
 	// expire old working tasks.
+	expiredTasks := make([]*datapb.ImportTaskInfo, 0)
 	func() {
 		m.workingLock.Lock()
 		defer m.workingLock.Unlock()
 		for _, v := range m.workingTasks {
 			taskExpiredAndStateUpdated := false
-			if v.GetState().GetStateCode() != commonpb.ImportState_ImportCompleted && taskExpired(v) {
+			if v.GetState().GetStateCode() != commonpb.ImportState_ImportCompleted &&
+				v.GetState().GetStateCode() != commonpb.ImportState_ImportFlushed && taskExpired(v) {
 				log.Info("a working task has expired and will be marked as failed",
 					zap.Int64("task ID", v.GetId()),
 					zap.Int64("startTs", v.GetStartTs()),
@@ -927,6 +1278,7 @@ func (m *importManager) expireOldTasksFromMem() {
 						zap.Any("target state", commonpb.ImportState_ImportFailed))
 				} else {
 					taskExpiredAndStateUpdated = true
+					expiredTasks = append(expiredTasks, v)
 					// Remove DataNode from busy node list, so it can serve other tasks again.
 					// remove after set state failed, prevent double remove, remove the nodeID of another task.
 					m.busyNodesLock.Lock()
@@ -941,6 +1293,11 @@ func (m *importManager) expireOldTasksFromMem() {
 			}
 		}
 	}()
+
+	// the other tasks of the jobs could not complete either.
+	for _, task := range expiredTasks {
+		m.failJob(task)
+	}
 }
 
 // expireOldTasksFromEtcd removes tasks from Etcd that are over `ImportTaskRetention` seconds old.
@@ -1007,12 +1364,14 @@ func (m *importManager) listAllTasks(colID int64, limit int64) ([]*milvuspb.GetI
 	}
 
 	tasks := make([]*milvuspb.GetImportStateResponse, 0)
+	progress := jobProgress(importTasks)
 	// filter tasks by collection id
 	// if colID is negative, we will return all tasks
 	for _, task := range importTasks {
 		if colID < 0 || colID == task.GetCollectionId() {
 			currTask := &milvuspb.GetImportStateResponse{}
 			m.copyTaskInfo(task, currTask)
+			fillJobProgress(task.GetJobId(), progress, currTask)
 			tasks = append(tasks, currTask)
 		}
 	}
@@ -1029,7 +1388,30 @@ func (m *importManager) listAllTasks(colID int64, limit int64) ([]*milvuspb.GetI
 	return tasks[len(tasks)-int(limit):], nil
 }
 
-// removeBadImportSegments marks segments of a failed import task as `dropped`.
+// dropSegments marks the segments as `dropped` in DataCoord, dropping the segments dropped already is a no-op.
+func (m *importManager) dropSegments(ctx context.Context, segmentIDs []int64) error {
+	if len(segmentIDs) == 0 {
+		return nil
+	}
+	if m.callMarkSegmentsDropped == nil {
+		log.Error("callMarkSegmentsDropped function of importManager is nil")
+		return fmt.Errorf("failed to mark segments dropped: method of import manager is nil")
+	}
+	status, err := m.callMarkSegmentsDropped(ctx, &datapb.MarkSegmentsDroppedRequest{
+		SegmentIds: segmentIDs,
+	})
+	if err == nil && status.GetErrorCode() != commonpb.ErrorCode_Success {
+		err = errors.New(status.GetReason())
+	}
+	if err != nil {
+		return err
+	}
+	log.Info("import segments marked as dropped", zap.Int64s("segment IDs", segmentIDs))
+	return nil
+}
+
+// removeBadImportSegments marks segments of a failed import task as `dropped`, the task becomes
+// `ImportFailedAndCleaned` once its segments are dropped, otherwise it's retried in the next round.
 func (m *importManager) removeBadImportSegments(ctx context.Context) {
 	var taskList []*datapb.ImportTaskInfo
 	var err error
@@ -1045,8 +1427,13 @@ func (m *importManager) removeBadImportSegments(ctx context.Context) {
 		}
 		log.Info("trying to mark segments as dropped",
 			zap.Int64("task ID", t.GetId()),
-			zap.Int64s("segment IDs", t.GetState().GetSegments()))
+			zap.Int64s("segment IDs", t.GetState().GetSegments()),
+			zap.Int64s("discarded segment IDs", t.GetDiscardedSegments()))
 
+		if err = m.dropSegments(ctx, lo.Flatten([][]int64{t.GetState().GetSegments(), t.GetDiscardedSegments()})); err != nil {
+			log.Warn("failed to mark segments as dropped", zap.Int64("task ID", t.GetId()), zap.Error(err))
+			continue
+		}
 		if err = m.setImportTaskState(t.GetId(), commonpb.ImportState_ImportFailedAndCleaned); err != nil {
 			log.Warn("failed to set ", zap.Int64("task ID", t.GetId()), zap.Error(err))
 		}
@@ -1083,18 +1470,41 @@ func tryUpdateErrMsg(errReason string, toPersistImportTaskInfo *datapb.ImportTas
 
 func cloneImportTaskInfo(taskInfo *datapb.ImportTaskInfo) *datapb.ImportTaskInfo {
 	cloned := &datapb.ImportTaskInfo{
-		Id:             taskInfo.GetId(),
-		DatanodeId:     taskInfo.GetDatanodeId(),
-		CollectionId:   taskInfo.GetCollectionId(),
-		PartitionId:    taskInfo.GetPartitionId(),
-		ChannelNames:   taskInfo.GetChannelNames(),
-		Files:          taskInfo.GetFiles(),
-		CreateTs:       taskInfo.GetCreateTs(),
-		State:          taskInfo.GetState(),
-		CollectionName: taskInfo.GetCollectionName(),
-		PartitionName:  taskInfo.GetPartitionName(),
-		Infos:          taskInfo.GetInfos(),
-		StartTs:        taskInfo.GetStartTs(),
+		Id:                taskInfo.GetId(),
+		DatanodeId:        taskInfo.GetDatanodeId(),
+		CollectionId:      taskInfo.GetCollectionId(),
+		PartitionId:       taskInfo.GetPartitionId(),
+		ChannelNames:      taskInfo.GetChannelNames(),
+		Files:             taskInfo.GetFiles(),
+		CreateTs:          taskInfo.GetCreateTs(),
+		State:             taskInfo.GetState(),
+		CollectionName:    taskInfo.GetCollectionName(),
+		PartitionName:     taskInfo.GetPartitionName(),
+		Infos:             taskInfo.GetInfos(),
+		StartTs:           taskInfo.GetStartTs(),
+		JobId:             taskInfo.GetJobId(),
+		RetryCount:        taskInfo.GetRetryCount(),
+		Checkpoint:        taskInfo.GetCheckpoint(),
+		AttemptCheckpoint: taskInfo.GetAttemptCheckpoint(),
+		DiscardedSegments: taskInfo.GetDiscardedSegments(),
 	}
 	return cloned
 }
+
+// mergeCheckpoint merges the checkpoint of an attempt into the checkpoint of the previous attempts.
+func mergeCheckpoint(checkpoint *datapb.ImportCheckpoint, attempt *datapb.ImportCheckpoint) *datapb.ImportCheckpoint {
+	return &datapb.ImportCheckpoint{
+		Files:    lo.Flatten([][]string{checkpoint.GetFiles(), attempt.GetFiles()}),
+		Segments: lo.Flatten([][]int64{checkpoint.GetSegments(), attempt.GetSegments()}),
+		RowCount: checkpoint.GetRowCount() + attempt.GetRowCount(),
+		AutoIds:  lo.Flatten([][]int64{checkpoint.GetAutoIds(), attempt.GetAutoIds()}),
+	}
+}
+
+// remainingFiles returns the files of the task not completed by the previous attempts.
+func remainingFiles(task *datapb.ImportTaskInfo) []string {
+	if len(task.GetCheckpoint().GetFiles()) == 0 {
+		return task.GetFiles()
+	}
+	return lo.Without(task.GetFiles(), task.GetCheckpoint().GetFiles()...)
+}
@@ -18,6 +18,7 @@ package rootcoord
 
 import (
 	"context"
+	"strconv"
 	"strings"
 	"sync"
 	"testing"
@@ -115,7 +116,7 @@ func TestImportManager_NewImportManager(t *testing.T) {
 		defer wg.Done()
 		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 		defer cancel()
-		mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callGetSegmentStates, nil, nil)
+		mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callGetSegmentStates, nil, nil, nil)
 		assert.NotNil(t, mgr)
 
 		// there are 2 tasks read from store, one is pending, the other is persisted.
@@ -147,7 +148,7 @@ func TestImportManager_NewImportManager(t *testing.T) {
 		defer wg.Done()
 		ctx, cancel := context.WithTimeout(context.Background(), 1*time.Nanosecond)
 		defer cancel()
-		mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callGetSegmentStates, nil, nil)
+		mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callGetSegmentStates, nil, nil, nil)
 		assert.NotNil(t, mgr)
 		mgr.init(context.TODO())
 		var wgLoop sync.WaitGroup
@@ -166,7 +167,7 @@ func TestImportManager_NewImportManager(t *testing.T) {
 
 		ctx, cancel := context.WithTimeout(context.Background(), 1*time.Nanosecond)
 		defer cancel()
-		mgr := newImportManager(ctx, mockTxnKV, idAlloc, callImportServiceFn, callGetSegmentStates, nil, nil)
+		mgr := newImportManager(ctx, mockTxnKV, idAlloc, callImportServiceFn, callGetSegmentStates, nil, nil, nil)
 		assert.NotNil(t, mgr)
 		assert.Panics(t, func() {
 			mgr.init(context.TODO())
@@ -183,7 +184,7 @@ func TestImportManager_NewImportManager(t *testing.T) {
 
 		ctx, cancel := context.WithTimeout(context.Background(), 1*time.Nanosecond)
 		defer cancel()
-		mgr := newImportManager(ctx, mockTxnKV, idAlloc, callImportServiceFn, callGetSegmentStates, nil, nil)
+		mgr := newImportManager(ctx, mockTxnKV, idAlloc, callImportServiceFn, callGetSegmentStates, nil, nil, nil)
 		assert.NotNil(t, mgr)
 		mgr.init(context.TODO())
 	})
@@ -197,7 +198,7 @@ func TestImportManager_NewImportManager(t *testing.T) {
 
 		ctx, cancel := context.WithTimeout(context.Background(), 1*time.Nanosecond)
 		defer cancel()
-		mgr := newImportManager(ctx, mockTxnKV, idAlloc, callImportServiceFn, callGetSegmentStates, nil, nil)
+		mgr := newImportManager(ctx, mockTxnKV, idAlloc, callImportServiceFn, callGetSegmentStates, nil, nil, nil)
 		assert.NotNil(t, mgr)
 		mgr.init(context.TODO())
 		func() {
@@ -217,7 +218,7 @@ func TestImportManager_NewImportManager(t *testing.T) {
 		defer wg.Done()
 		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 		defer cancel()
-		mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callGetSegmentStates, nil, nil)
+		mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callGetSegmentStates, nil, nil, nil)
 		assert.NotNil(t, mgr)
 		mgr.init(ctx)
 		var wgLoop sync.WaitGroup
@@ -275,7 +276,7 @@ func TestImportManager_TestSetImportTaskState(t *testing.T) {
 		defer wg.Done()
 		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 		defer cancel()
-		mgr := newImportManager(ctx, mockKv, idAlloc, nil, nil, nil, nil)
+		mgr := newImportManager(ctx, mockKv, idAlloc, nil, nil, nil, nil, nil)
 		assert.NotNil(t, mgr)
 		_, err := mgr.loadFromTaskStore(true)
 		assert.NoError(t, err)
@@ -372,7 +373,7 @@ func TestImportManager_TestEtcdCleanUp(t *testing.T) {
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
-	mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callGetSegmentStates, nil, nil)
+	mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callGetSegmentStates, nil, nil, nil)
 	assert.NotNil(t, mgr)
 	_, err = mgr.loadFromTaskStore(true)
 	assert.NoError(t, err)
@@ -477,7 +478,7 @@ func TestImportManager_TestFlipTaskStateLoop(t *testing.T) {
 		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 		defer cancel()
 		mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn,
-			callGetSegmentStates, nil, callUnsetIsImportingState)
+			callGetSegmentStates, nil, callUnsetIsImportingState, nil)
 		assert.NotNil(t, mgr)
 		var wgLoop sync.WaitGroup
 		wgLoop.Add(1)
@@ -492,7 +493,7 @@ func TestImportManager_TestFlipTaskStateLoop(t *testing.T) {
 		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 		defer cancel()
 		mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn,
-			callGetSegmentStates, nil, callUnsetIsImportingState)
+			callGetSegmentStates, nil, callUnsetIsImportingState, nil)
 		assert.NotNil(t, mgr)
 		var wgLoop sync.WaitGroup
 		wgLoop.Add(1)
@@ -507,7 +508,7 @@ func TestImportManager_TestFlipTaskStateLoop(t *testing.T) {
 		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 		defer cancel()
 		mgr := newImportManager(ctx, mockKv, idAlloc, callImportServiceFn,
-			callGetSegmentStates, nil, callUnsetIsImportingState)
+			callGetSegmentStates, nil, callUnsetIsImportingState, nil)
 		assert.NotNil(t, mgr)
 		var wgLoop sync.WaitGroup
 		wgLoop.Add(1)
@@ -542,7 +543,7 @@ func TestImportManager_ImportJob(t *testing.T) {
 		}, nil
 	}
 	// nil request
-	mgr := newImportManager(context.TODO(), mockKv, idAlloc, nil, callGetSegmentStates, nil, nil)
+	mgr := newImportManager(context.TODO(), mockKv, idAlloc, nil, callGetSegmentStates, nil, nil, nil)
 	resp := mgr.importJob(context.TODO(), nil, colID, 0)
 	assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 
@@ -571,7 +572,7 @@ func TestImportManager_ImportJob(t *testing.T) {
 	// row-based case, task count equal to file count
 	// since the importServiceFunc return error, tasks will be kept in pending list
 	rowReq.Files = []string{"f1.json"}
-	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callGetSegmentStates, nil, nil)
+	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callGetSegmentStates, nil, nil, nil)
 	resp = mgr.importJob(context.TODO(), rowReq, colID, 0)
 	assert.Equal(t, len(rowReq.Files), len(mgr.pendingTasks))
 	assert.Equal(t, 0, len(mgr.workingTasks))
@@ -584,7 +585,7 @@ func TestImportManager_ImportJob(t *testing.T) {
 
 	// column-based case, one quest one task
 	// since the importServiceFunc return error, tasks will be kept in pending list
-	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callGetSegmentStates, nil, nil)
+	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callGetSegmentStates, nil, nil, nil)
 	resp = mgr.importJob(context.TODO(), colReq, colID, 0)
 	assert.Equal(t, 1, len(mgr.pendingTasks))
 	assert.Equal(t, 0, len(mgr.workingTasks))
@@ -598,13 +599,13 @@ func TestImportManager_ImportJob(t *testing.T) {
 	}
 
 	// row-based case, since the importServiceFunc return success, tasks will be sent to working list
-	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callGetSegmentStates, nil, nil)
+	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callGetSegmentStates, nil, nil, nil)
 	resp = mgr.importJob(context.TODO(), rowReq, colID, 0)
 	assert.Equal(t, 0, len(mgr.pendingTasks))
 	assert.Equal(t, len(rowReq.Files), len(mgr.workingTasks))
 
 	// column-based case, since the importServiceFunc return success, tasks will be sent to working list
-	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callGetSegmentStates, nil, nil)
+	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callGetSegmentStates, nil, nil, nil)
 	resp = mgr.importJob(context.TODO(), colReq, colID, 0)
 	assert.Equal(t, 0, len(mgr.pendingTasks))
 	assert.Equal(t, 1, len(mgr.workingTasks))
@@ -628,7 +629,7 @@ func TestImportManager_ImportJob(t *testing.T) {
 
 	// row-based case, since the importServiceFunc return success for 1 task
 	// the first task is sent to working list, and 1 task left in pending list
-	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callGetSegmentStates, nil, nil)
+	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callGetSegmentStates, nil, nil, nil)
 	resp = mgr.importJob(context.TODO(), rowReq, colID, 0)
 	assert.Equal(t, 0, len(mgr.pendingTasks))
 	assert.Equal(t, 1, len(mgr.workingTasks))
@@ -706,7 +707,7 @@ func TestImportManager_AllDataNodesBusy(t *testing.T) {
 	}
 
 	// each data node owns one task
-	mgr := newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callGetSegmentStates, nil, nil)
+	mgr := newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callGetSegmentStates, nil, nil, nil)
 	for i := 0; i < len(dnList); i++ {
 		resp := mgr.importJob(context.TODO(), rowReq, colID, 0)
 		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
@@ -715,7 +716,7 @@ func TestImportManager_AllDataNodesBusy(t *testing.T) {
 	}
 
 	// all data nodes are busy, new task waiting in pending list
-	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callGetSegmentStates, nil, nil)
+	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callGetSegmentStates, nil, nil, nil)
 	resp := mgr.importJob(context.TODO(), rowReq, colID, 0)
 	assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 	assert.Equal(t, len(rowReq.Files), len(mgr.pendingTasks))
@@ -723,7 +724,7 @@ func TestImportManager_AllDataNodesBusy(t *testing.T) {
 
 	// now all data nodes are free again, new task is executed instantly
 	count = 0
-	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callGetSegmentStates, nil, nil)
+	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callGetSegmentStates, nil, nil, nil)
 	resp = mgr.importJob(context.TODO(), colReq, colID, 0)
 	assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 	assert.Equal(t, 0, len(mgr.pendingTasks))
@@ -781,7 +782,7 @@ func TestImportManager_TaskState(t *testing.T) {
 	}
 
 	// add 3 tasks, their ID is 10000, 10001, 10002, make sure updateTaskInfo() works correctly
-	mgr := newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callGetSegmentStates, nil, nil)
+	mgr := newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callGetSegmentStates, nil, nil, nil)
 	mgr.importJob(context.TODO(), rowReq, colID, 0)
 	rowReq.Files = []string{"f2.json"}
 	mgr.importJob(context.TODO(), rowReq, colID, 0)
@@ -890,7 +891,7 @@ func TestImportManager_AllocFail(t *testing.T) {
 			},
 		}, nil
 	}
-	mgr := newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callGetSegmentStates, nil, nil)
+	mgr := newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callGetSegmentStates, nil, nil, nil)
 	resp := mgr.importJob(context.TODO(), rowReq, colID, 0)
 	assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 	assert.Equal(t, 0, len(mgr.pendingTasks))
@@ -953,7 +954,7 @@ func TestImportManager_ListAllTasks(t *testing.T) {
 	}
 
 	mockKv := memkv.NewMemoryKV()
-	mgr := newImportManager(context.TODO(), mockKv, idAlloc, fn, callGetSegmentStates, getCollectionName, nil)
+	mgr := newImportManager(context.TODO(), mockKv, idAlloc, fn, callGetSegmentStates, getCollectionName, nil, nil)
 
 	// add 10 tasks for collection1, id from 1 to 10
 	file1 := "f1.json"
@@ -1138,3 +1139,374 @@ func TestImportManager_isRowbased(t *testing.T) {
 	assert.NoError(t, err)
 	assert.False(t, rb)
 }
+
+func TestImportManager_shardFiles(t *testing.T) {
+	mgr := &importManager{}
+	assert.Nil(t, mgr.shardFiles([]string{}))
+	assert.Nil(t, mgr.shardFiles([]string{"1.npy", "2.npy"}))
+	assert.Nil(t, mgr.shardFiles([]string{"1.parquet", "2.npy"}))
+	assert.Equal(t, [][]string{{"1.parquet"}, {"2.parquet"}}, mgr.shardFiles([]string{"1.parquet", "2.parquet"}))
+
+	// numpy files are grouped by directory
+	assert.Equal(t, [][]string{{"a/id.npy", "a/vec.npy"}, {"b/vec.npy", "b/id.npy"}},
+		mgr.shardFiles([]string{"a/id.npy", "a/vec.npy", "b/vec.npy", "b/id.npy"}))
+	// directories holding different fields could not be imported separately
+	assert.Nil(t, mgr.shardFiles([]string{"a/id.npy", "a/vec.npy", "b/id.npy"}))
+	assert.Nil(t, mgr.shardFiles([]string{"a/id.npy", "a/vec.npy"}))
+}
+
+func newJobTestImportManager(t *testing.T) *importManager {
+	var countLock sync.RWMutex
+	var globalCount = typeutil.UniqueID(0)
+	var idAlloc = func(count uint32) (typeutil.UniqueID, typeutil.UniqueID, error) {
+		countLock.Lock()
+		defer countLock.Unlock()
+		globalCount++
+		return globalCount, 0, nil
+	}
+	// reject all tasks so that tasks are kept in pending list
+	importServiceFunc := func(ctx context.Context, req *datapb.ImportTaskRequest) (*datapb.ImportTaskResponse, error) {
+		return &datapb.ImportTaskResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			},
+		}, nil
+	}
+	callGetSegmentStates := func(ctx context.Context, req *datapb.GetSegmentStatesRequest) (*datapb.GetSegmentStatesResponse, error) {
+		return &datapb.GetSegmentStatesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			},
+		}, nil
+	}
+	paramtable.Get().Save(Params.RootCoordCfg.ImportTaskSubPath.Key, "test_import_task")
+	mgr := newImportManager(context.TODO(), memkv.NewMemoryKV(), idAlloc, importServiceFunc, callGetSegmentStates, nil, nil, nil)
+	req := &milvuspb.ImportRequest{
+		CollectionName: "c1",
+		PartitionName:  "p1",
+		Files:          []string{"f1.parquet", "f2.parquet", "f3.parquet"},
+	}
+	resp := mgr.importJob(context.TODO(), req, 100, 0)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.Equal(t, 3, len(resp.GetTasks()))
+	return mgr
+}
+
+func TestImportManager_ShardedImportJob(t *testing.T) {
+	mgr := newJobTestImportManager(t)
+	assert.Equal(t, 3, len(mgr.pendingTasks))
+	jobID := mgr.pendingTasks[0].GetId()
+	for i, task := range mgr.pendingTasks {
+		assert.Equal(t, jobID, task.GetJobId())
+		assert.Equal(t, 1, len(task.GetFiles()))
+		assert.Equal(t, i, int(task.GetId()-jobID))
+	}
+
+	resp := mgr.getTaskState(jobID + 1)
+	mgr.fillTaskJobProgress(resp)
+	infos := make(map[string]string)
+	for _, kv := range resp.GetInfos() {
+		infos[kv.GetKey()] = kv.GetValue()
+	}
+	assert.Equal(t, strconv.FormatInt(jobID, 10), infos[importutil2.JobID])
+	assert.Equal(t, "0", infos[importutil2.JobProgressPercent])
+}
+
+func TestImportManager_RetryTask(t *testing.T) {
+	mgr := newJobTestImportManager(t)
+	paramtable.Get().Save(Params.RootCoordCfg.ImportTaskMaxRetries.Key, "1")
+	defer paramtable.Get().Reset(Params.RootCoordCfg.ImportTaskMaxRetries.Key)
+
+	// move the first task to working list and fail it
+	task := mgr.pendingTasks[0]
+	mgr.pendingTasks = mgr.pendingTasks[1:]
+	task.State.StateCode = commonpb.ImportState_ImportFailed
+	mgr.workingTasks[task.GetId()] = task
+
+	retried, err := mgr.retryTask(task.GetId())
+	assert.NoError(t, err)
+	assert.True(t, retried)
+	assert.Equal(t, 0, len(mgr.workingTasks))
+	assert.Equal(t, 3, len(mgr.pendingTasks))
+	retriedTask := mgr.pendingTasks[2]
+	assert.Equal(t, task.GetId(), retriedTask.GetId())
+	assert.Equal(t, int64(1), retriedTask.GetRetryCount())
+	assert.Equal(t, commonpb.ImportState_ImportPending, retriedTask.GetState().GetStateCode())
+
+	// no retries left
+	mgr.pendingTasks = mgr.pendingTasks[:2]
+	retriedTask.State.StateCode = commonpb.ImportState_ImportFailed
+	mgr.workingTasks[retriedTask.GetId()] = retriedTask
+	retried, err = mgr.retryTask(retriedTask.GetId())
+	assert.NoError(t, err)
+	assert.False(t, retried)
+
+	// task not found
+	retried, err = mgr.retryTask(-1)
+	assert.NoError(t, err)
+	assert.False(t, retried)
+}
+
+func TestImportManager_ResumeTask(t *testing.T) {
+	mgr := newJobTestImportManager(t)
+	paramtable.Get().Save(Params.RootCoordCfg.ImportTaskMaxRetries.Key, "1")
+	defer paramtable.Get().Reset(Params.RootCoordCfg.ImportTaskMaxRetries.Key)
+
+	// move the first task to working list with 3 files
+	task := mgr.pendingTasks[0]
+	mgr.pendingTasks = nil
+	task.Files = []string{"f1.json", "f2.json", "f3.json"}
+	task.State.StateCode = commonpb.ImportState_ImportStarted
+	mgr.workingTasks[task.GetId()] = task
+
+	// the first file is completed
+	_, err := mgr.updateTaskInfo(&rootcoordpb.ImportResult{
+		TaskId:         task.GetId(),
+		State:          commonpb.ImportState_ImportStarted,
+		Segments:       []int64{1},
+		RowCount:       10,
+		AutoIds:        []int64{100, 110},
+		CompletedFiles: []string{"f1.json"},
+	})
+	assert.NoError(t, err)
+	// the progress of the second file is not taken as checkpoint
+	ti, err := mgr.updateTaskInfo(&rootcoordpb.ImportResult{
+		TaskId:         task.GetId(),
+		State:          commonpb.ImportState_ImportFailed,
+		Segments:       []int64{1, 2},
+		RowCount:       20,
+		AutoIds:        []int64{100, 120},
+		CompletedFiles: []string{"f1.json"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"f1.json"}, ti.GetAttemptCheckpoint().GetFiles())
+	assert.Equal(t, []int64{1}, ti.GetAttemptCheckpoint().GetSegments())
+	assert.Equal(t, int64(10), ti.GetAttemptCheckpoint().GetRowCount())
+
+	// the retried task resumes from the checkpoint
+	retried, err := mgr.retryTask(task.GetId())
+	assert.NoError(t, err)
+	assert.True(t, retried)
+	retriedTask := mgr.pendingTasks[0]
+	assert.Nil(t, retriedTask.GetAttemptCheckpoint())
+	assert.Equal(t, []string{"f1.json"}, retriedTask.GetCheckpoint().GetFiles())
+	assert.Equal(t, []int64{1}, retriedTask.GetState().GetSegments())
+	// the segment of the uncompleted file is carried as discarded
+	assert.Equal(t, []int64{2}, retriedTask.GetDiscardedSegments())
+	assert.Equal(t, int64(10), retriedTask.GetState().GetRowCount())
+	assert.Equal(t, []int64{100, 110}, retriedTask.GetState().GetRowIds())
+
+	// only the remaining files are sent out
+	var sentFiles []string
+	mgr.callImportService = func(ctx context.Context, req *datapb.ImportTaskRequest) (*datapb.ImportTaskResponse, error) {
+		sentFiles = req.GetImportTask().GetFiles()
+		return &datapb.ImportTaskResponse{
+			Status:     &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			DatanodeId: 1,
+		}, nil
+	}
+	assert.NoError(t, mgr.sendOutTasks(context.TODO()))
+	assert.Equal(t, []string{"f2.json", "f3.json"}, sentFiles)
+
+	// the result of the new attempt is added to the checkpoint
+	ti, err = mgr.updateTaskInfo(&rootcoordpb.ImportResult{
+		TaskId:   task.GetId(),
+		State:    commonpb.ImportState_ImportPersisted,
+		Segments: []int64{3},
+		RowCount: 20,
+		AutoIds:  []int64{200, 220},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(30), ti.GetState().GetRowCount())
+	assert.ElementsMatch(t, []int64{1, 3}, ti.GetState().GetSegments())
+	assert.Equal(t, []int64{100, 110, 200, 220}, ti.GetState().GetRowIds())
+}
+
+func TestImportManager_FailJob(t *testing.T) {
+	mgr := newJobTestImportManager(t)
+	failedTask := mgr.pendingTasks[0]
+	mgr.pendingTasks = mgr.pendingTasks[1:]
+	failedTask.State.StateCode = commonpb.ImportState_ImportFailed
+	mgr.workingTasks[failedTask.GetId()] = failedTask
+	assert.NoError(t, mgr.persistTaskInfo(failedTask))
+
+	mgr.failJob(failedTask)
+	assert.Equal(t, 0, len(mgr.pendingTasks))
+	tasks, err := mgr.loadFromTaskStore(false)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(tasks))
+	for _, task := range tasks {
+		assert.Equal(t, commonpb.ImportState_ImportFailed, task.GetState().GetStateCode())
+	}
+
+	// legacy task without job
+	mgr.failJob(&datapb.ImportTaskInfo{Id: 1000})
+}
+
+func TestImportManager_FlipJobFlushedState(t *testing.T) {
+	mgr := newJobTestImportManager(t)
+	var unsetSegments []int64
+	unsetCount := 0
+	mgr.callUnsetIsImportingState = func(ctx context.Context, req *datapb.UnsetIsImportingStateRequest) (*commonpb.Status, error) {
+		unsetCount++
+		unsetSegments = req.GetSegmentIds()
+		return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+	}
+	var droppedSegments []int64
+	dropErr := errors.New("mock error")
+	mgr.callMarkSegmentsDropped = func(ctx context.Context, req *datapb.MarkSegmentsDroppedRequest) (*commonpb.Status, error) {
+		if dropErr != nil {
+			return nil, dropErr
+		}
+		droppedSegments = req.GetSegmentIds()
+		return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+	}
+
+	persistTask := func(task *datapb.ImportTaskInfo, segmentID int64) {
+		task.State.StateCode = commonpb.ImportState_ImportPersisted
+		task.State.Segments = []int64{segmentID}
+		assert.NoError(t, mgr.persistTaskInfo(task))
+	}
+	tasks := mgr.pendingTasks
+	mgr.pendingTasks = nil
+	persistTask(tasks[0], 1001)
+	persistTask(tasks[1], 1002)
+
+	// the last task is still working
+	err := mgr.loadAndFlipPersistedTasks(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, unsetCount)
+
+	// the segment of a failed attempt of the last task is discarded
+	tasks[2].DiscardedSegments = []int64{2003}
+	persistTask(tasks[2], 1003)
+
+	// the job is not visible until the discarded segments are dropped
+	err = mgr.loadAndFlipPersistedTasks(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, unsetCount)
+
+	dropErr = nil
+	err = mgr.loadAndFlipPersistedTasks(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{2003}, droppedSegments)
+	assert.Equal(t, 1, unsetCount)
+	assert.ElementsMatch(t, []int64{1001, 1002, 1003}, unsetSegments)
+	for _, task := range tasks {
+		resp := mgr.getTaskState(task.GetId())
+		assert.Equal(t, commonpb.ImportState_ImportCompleted, resp.GetState())
+	}
+
+	// completed job is not flipped again
+	err = mgr.loadAndFlipPersistedTasks(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, unsetCount)
+}
+
+func TestImportManager_FlipExternalJobState(t *testing.T) {
+	mgr := newJobTestImportManager(t)
+	unsetCount := 0
+	mgr.callUnsetIsImportingState = func(ctx context.Context, req *datapb.UnsetIsImportingStateRequest) (*commonpb.Status, error) {
+		unsetCount++
+		return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+	}
+	converted := make(map[int64]bool)
+	mgr.callGetSegmentStates = func(ctx context.Context, req *datapb.GetSegmentStatesRequest) (*datapb.GetSegmentStatesResponse, error) {
+		resp := &datapb.GetSegmentStatesResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}
+		for _, segmentID := range req.GetSegmentIDs() {
+			state := commonpb.SegmentState_Flushed
+			if converted[segmentID] {
+				state = commonpb.SegmentState_NotExist
+			}
+			resp.States = append(resp.States, &datapb.SegmentStateInfo{SegmentID: segmentID, State: state})
+		}
+		return resp, nil
+	}
+
+	tasks := mgr.pendingTasks
+	mgr.pendingTasks = nil
+	for i, task := range tasks {
+		task.Infos = append(task.Infos, &commonpb.KeyValuePair{Key: importutil2.ExternalFlag, Value: "true"})
+		task.State.StateCode = commonpb.ImportState_ImportPersisted
+		task.State.Segments = []int64{int64(1001 + i)}
+		assert.NoError(t, mgr.persistTaskInfo(task))
+	}
+
+	// the registered segments are not queryable until they're converted
+	err := mgr.loadAndFlipPersistedTasks(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, unsetCount)
+	for _, task := range tasks {
+		resp := mgr.getTaskState(task.GetId())
+		assert.Equal(t, commonpb.ImportState_ImportFlushed, resp.GetState())
+	}
+
+	converted[1001] = true
+	converted[1002] = true
+	err = mgr.loadAndFlipPersistedTasks(context.TODO())
+	assert.NoError(t, err)
+	for _, task := range tasks {
+		resp := mgr.getTaskState(task.GetId())
+		assert.Equal(t, commonpb.ImportState_ImportFlushed, resp.GetState())
+	}
+
+	converted[1003] = true
+	err = mgr.loadAndFlipPersistedTasks(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, unsetCount)
+	for _, task := range tasks {
+		resp := mgr.getTaskState(task.GetId())
+		assert.Equal(t, commonpb.ImportState_ImportCompleted, resp.GetState())
+	}
+}
+
+func TestImportManager_RemoveBadImportSegments(t *testing.T) {
+	mgr := newJobTestImportManager(t)
+	var droppedSegments []int64
+	dropErr := errors.New("mock error")
+	mgr.callMarkSegmentsDropped = func(ctx context.Context, req *datapb.MarkSegmentsDroppedRequest) (*commonpb.Status, error) {
+		if dropErr != nil {
+			return nil, dropErr
+		}
+		droppedSegments = req.GetSegmentIds()
+		return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+	}
+
+	task := mgr.pendingTasks[0]
+	task.State.StateCode = commonpb.ImportState_ImportFailed
+	task.State.Segments = []int64{1001}
+	task.DiscardedSegments = []int64{2001}
+	assert.NoError(t, mgr.persistTaskInfo(task))
+
+	// the task is cleaned in the next round if failed to drop the segments
+	mgr.removeBadImportSegments(context.TODO())
+	assert.Equal(t, commonpb.ImportState_ImportFailed, mgr.getTaskState(task.GetId()).GetState())
+
+	dropErr = nil
+	mgr.removeBadImportSegments(context.TODO())
+	assert.ElementsMatch(t, []int64{1001, 2001}, droppedSegments)
+	assert.Equal(t, commonpb.ImportState_ImportFailedAndCleaned, mgr.getTaskState(task.GetId()).GetState())
+
+	// the cleaned task is not dropped again
+	droppedSegments = nil
+	mgr.removeBadImportSegments(context.TODO())
+	assert.Nil(t, droppedSegments)
+}
+
+func TestImportManager_jobProgress(t *testing.T) {
+	newTask := func(id int64, jobID int64, state commonpb.ImportState, percent string) *datapb.ImportTaskInfo {
+		return &datapb.ImportTaskInfo{
+			Id:    id,
+			JobId: jobID,
+			State: &datapb.ImportTaskState{StateCode: state},
+			Infos: []*commonpb.KeyValuePair{{Key: importutil2.ProgressPercent, Value: percent}},
+		}
+	}
+	progress := jobProgress([]*datapb.ImportTaskInfo{
+		newTask(1, 1, commonpb.ImportState_ImportCompleted, "90"),
+		newTask(2, 1, commonpb.ImportState_ImportStarted, "50"),
+		newTask(3, 3, commonpb.ImportState_ImportStarted, "30"),
+		newTask(4, 0, commonpb.ImportState_ImportStarted, "10"),
+	})
+	assert.Equal(t, map[int64]int64{1: 75, 3: 30}, progress)
+}
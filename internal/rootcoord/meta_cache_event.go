@@ -0,0 +1,136 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+)
+
+// metaCacheEventPruneInterval is the interval to prune the expired meta cache events.
+var metaCacheEventPruneInterval = time.Minute
+
+// metaCacheEventPublisher publishes the collection meta cache invalidations into meta store,
+// proxies watch them so that no invalidation is lost even if the rpc to a proxy fails.
+// The events are kept for rootCoord.metaCacheEventRetention and pruned periodically.
+type metaCacheEventPublisher struct {
+	ctx context.Context
+	kv  kv.TxnKV
+}
+
+func newMetaCacheEventPublisher(ctx context.Context, kv kv.TxnKV) *metaCacheEventPublisher {
+	return &metaCacheEventPublisher{ctx: ctx, kv: kv}
+}
+
+// metaCacheEventKey returns the key of the event, which is ordered by revision.
+func metaCacheEventKey(req *proxypb.InvalidateCollMetaCacheRequest) string {
+	target := req.GetCollectionName()
+	if req.GetCollectionID() != InvalidCollectionID {
+		target = strconv.FormatInt(req.GetCollectionID(), 10)
+	}
+	return path.Join(common.MetaCacheEventPrefix, fmt.Sprintf("%020d", req.GetRevision()), req.GetDbName(), target)
+}
+
+// parseMetaCacheEventRevision returns the revision of the event by its key,
+// the key may be prefixed with the root path of the meta store.
+func parseMetaCacheEventRevision(key string) (uint64, error) {
+	_, rest, ok := strings.Cut(key, common.MetaCacheEventPrefix+"/")
+	if !ok {
+		return 0, fmt.Errorf("invalid meta cache event key %s", key)
+	}
+	revision, _, _ := strings.Cut(rest, "/")
+	return strconv.ParseUint(revision, 10, 64)
+}
+
+// publish persists the invalidation, it's a no-op if the publisher is not initialized.
+func (p *metaCacheEventPublisher) publish(req *proxypb.InvalidateCollMetaCacheRequest) error {
+	if p == nil {
+		return nil
+	}
+	value, err := proto.Marshal(req)
+	if err != nil {
+		return err
+	}
+	if err := p.kv.Save(metaCacheEventKey(req), string(value)); err != nil {
+		log.Warn("failed to publish meta cache event", zap.String("db", req.GetDbName()),
+			zap.String("collection", req.GetCollectionName()), zap.Int64("collectionID", req.GetCollectionID()),
+			zap.Uint64("revision", req.GetRevision()), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (p *metaCacheEventPublisher) pruneLoop(wg *sync.WaitGroup) {
+	defer wg.Done()
+	if p == nil {
+		return
+	}
+	ticker := time.NewTicker(metaCacheEventPruneInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.ctx.Done():
+			log.Info("meta cache event prune loop quit")
+			return
+		case <-ticker.C:
+			p.prune(time.Now())
+		}
+	}
+}
+
+// prune removes the events published before the retention, failures are ignored since they will be pruned next time.
+func (p *metaCacheEventPublisher) prune(now time.Time) {
+	retention := Params.RootCoordCfg.MetaCacheEventRetention.GetAsDuration(time.Second)
+	expireBefore := tsoutil.ComposeTSByTime(now.Add(-retention), 0)
+
+	keys, _, err := p.kv.LoadWithPrefix(common.MetaCacheEventPrefix)
+	if err != nil {
+		log.Warn("failed to load meta cache events to prune", zap.Error(err))
+		return
+	}
+	expired := make([]string, 0)
+	for _, key := range keys {
+		revision, err := parseMetaCacheEventRevision(key)
+		if err != nil {
+			log.Warn("skip malformed meta cache event", zap.String("key", key), zap.Error(err))
+			continue
+		}
+		if revision < expireBefore {
+			expired = append(expired, key)
+		}
+	}
+	if len(expired) == 0 {
+		return
+	}
+	if err := p.kv.MultiRemove(expired); err != nil {
+		log.Warn("failed to prune meta cache events", zap.Int("num", len(expired)), zap.Error(err))
+	}
+}
@@ -0,0 +1,106 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+)
+
+func TestMetaCacheEventPublisher(t *testing.T) {
+	t.Run("nil publisher", func(t *testing.T) {
+		var p *metaCacheEventPublisher
+		assert.NoError(t, p.publish(&proxypb.InvalidateCollMetaCacheRequest{}))
+
+		wg := &sync.WaitGroup{}
+		wg.Add(1)
+		p.pruneLoop(wg)
+		wg.Wait()
+	})
+
+	t.Run("publish and prune", func(t *testing.T) {
+		kv := memkv.NewMemoryKV()
+		p := newMetaCacheEventPublisher(context.Background(), kv)
+
+		now := time.Now()
+		old := tsoutil.ComposeTSByTime(now.Add(-Params.RootCoordCfg.MetaCacheEventRetention.GetAsDuration(time.Second)-time.Minute), 0)
+		err := p.publish(&proxypb.InvalidateCollMetaCacheRequest{
+			DbName:         "db",
+			CollectionName: "coll",
+			CollectionID:   InvalidCollectionID,
+			Revision:       old,
+		})
+		assert.NoError(t, err)
+		_, values, err := kv.LoadWithPrefix(common.MetaCacheEventPrefix)
+		assert.NoError(t, err)
+		assert.Len(t, values, 1)
+
+		err = p.publish(&proxypb.InvalidateCollMetaCacheRequest{
+			DbName:       "db",
+			CollectionID: 100,
+			Revision:     tsoutil.ComposeTSByTime(now, 0),
+			ChangeType:   proxypb.MetaCacheChangeType_PartitionChange,
+		})
+		assert.NoError(t, err)
+		_, values, err = kv.LoadWithPrefix(common.MetaCacheEventPrefix)
+		assert.NoError(t, err)
+		assert.Len(t, values, 2)
+
+		p.prune(now)
+		_, values, err = kv.LoadWithPrefix(common.MetaCacheEventPrefix)
+		assert.NoError(t, err)
+		assert.Len(t, values, 1)
+
+		event := &proxypb.InvalidateCollMetaCacheRequest{}
+		assert.NoError(t, proto.Unmarshal([]byte(values[0]), event))
+		assert.EqualValues(t, 100, event.GetCollectionID())
+		assert.Equal(t, proxypb.MetaCacheChangeType_PartitionChange, event.GetChangeType())
+	})
+	t.Run("prune loop", func(t *testing.T) {
+		kv := memkv.NewMemoryKV()
+		ctx, cancel := context.WithCancel(context.Background())
+		p := newMetaCacheEventPublisher(ctx, kv)
+
+		interval := metaCacheEventPruneInterval
+		metaCacheEventPruneInterval = 10 * time.Millisecond
+		defer func() { metaCacheEventPruneInterval = interval }()
+
+		old := tsoutil.ComposeTSByTime(time.Now().Add(-Params.RootCoordCfg.MetaCacheEventRetention.GetAsDuration(time.Second)-time.Minute), 0)
+		assert.NoError(t, p.publish(&proxypb.InvalidateCollMetaCacheRequest{DbName: "db", CollectionID: 100, Revision: old}))
+		assert.NoError(t, kv.Save(common.MetaCacheEventPrefix+"/malformed", ""))
+
+		wg := &sync.WaitGroup{}
+		wg.Add(1)
+		go p.pruneLoop(wg)
+		assert.Eventually(t, func() bool {
+			keys, _, err := kv.LoadWithPrefix(common.MetaCacheEventPrefix)
+			return err == nil && len(keys) == 1
+		}, time.Second, 10*time.Millisecond)
+		cancel()
+		wg.Wait()
+	})
+}
@@ -806,6 +806,7 @@ type mockBroker struct {
 	ReleaseSegRefLockFunc func(ctx context.Context, taskID int64, segIDs []int64) error
 	FlushFunc             func(ctx context.Context, cID int64, segIDs []int64) error
 	ImportFunc            func(ctx context.Context, req *datapb.ImportTaskRequest) (*datapb.ImportTaskResponse, error)
+	CloneSegmentsFunc     func(ctx context.Context, req *datapb.CloneSegmentsRequest) error
 
 	DropCollectionIndexFunc  func(ctx context.Context, collID UniqueID, partIDs []UniqueID) error
 	DescribeIndexFunc        func(ctx context.Context, colID UniqueID) (*indexpb.DescribeIndexResponse, error)
@@ -840,6 +841,10 @@ func (b mockBroker) SyncNewCreatedPartition(ctx context.Context, collectionID Un
 	return b.SyncNewCreatedPartitionFunc(ctx, collectionID, partitionID)
 }
 
+func (b mockBroker) CloneSegments(ctx context.Context, req *datapb.CloneSegmentsRequest) error {
+	return b.CloneSegmentsFunc(ctx, req)
+}
+
 func (b mockBroker) DropCollectionIndex(ctx context.Context, collID UniqueID, partIDs []UniqueID) error {
 	return b.DropCollectionIndexFunc(ctx, collID, partIDs)
 }
@@ -294,6 +294,24 @@ func (q *QuotaCenter) forceDenyWriting(errorCode commonpb.ErrorCode, collections
 		zap.String("reason", errorCode.String()))
 }
 
+// forceDenyDeleting sets the delete and upsert rates to 0 to reject the deletes, the inserts are still allowed.
+func (q *QuotaCenter) forceDenyDeleting(errorCode commonpb.ErrorCode, collections ...int64) {
+	for _, collection := range collections {
+		if _, ok := q.currentRates[collection]; !ok {
+			q.currentRates[collection] = make(map[internalpb.RateType]Limit)
+			q.quotaStates[collection] = make(map[milvuspb.QuotaState]commonpb.ErrorCode)
+		}
+		q.currentRates[collection][internalpb.RateType_DMLUpsert] = 0
+		q.currentRates[collection][internalpb.RateType_DMLDelete] = 0
+		if _, ok := q.quotaStates[collection][milvuspb.QuotaState_DenyToWrite]; !ok {
+			q.quotaStates[collection][milvuspb.QuotaState_DenyToWrite] = errorCode
+		}
+	}
+	log.RatedWarn(10, "QuotaCenter force to deny deleting",
+		zap.Int64s("collectionIDs", collections),
+		zap.String("reason", errorCode.String()))
+}
+
 // forceDenyReading sets dql rates to 0 to reject all dql requests.
 func (q *QuotaCenter) forceDenyReading(errorCode commonpb.ErrorCode, collections ...int64) {
 	if len(collections) == 0 {
@@ -485,6 +503,7 @@ func (q *QuotaCenter) calculateWriteRates() error {
 			zap.Float64("factor", factor))
 	}
 
+	q.checkExternalSegments()
 	return nil
 }
 
@@ -796,6 +815,17 @@ func (q *QuotaCenter) checkDiskQuota() {
 	q.totalBinlogSize = total
 }
 
+// checkExternalSegments rejects the deletes on the collections having the segments registered on the external files,
+// the registered rows aren't tracked by the DataNodes until they're converted, so the deletes on them would be lost.
+func (q *QuotaCenter) checkExternalSegments() {
+	q.diskMu.Lock()
+	defer q.diskMu.Unlock()
+	if q.dataCoordMetrics == nil || len(q.dataCoordMetrics.ExternalCollections) == 0 {
+		return
+	}
+	q.forceDenyDeleting(commonpb.ErrorCode_NotReadyServe, q.dataCoordMetrics.ExternalCollections...)
+}
+
 // setRates notifies Proxies to set rates for different rate types.
 func (q *QuotaCenter) setRates() error {
 	ctx, cancel := context.WithTimeout(context.Background(), SetRatesTimeout)
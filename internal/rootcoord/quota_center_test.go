@@ -530,6 +530,24 @@ func TestQuotaCenter(t *testing.T) {
 		paramtable.Get().Save(Params.QuotaConfig.DiskQuotaPerCollection.Key, colQuotaBackup.GetValue())
 	})
 
+	t.Run("test checkExternalSegments", func(t *testing.T) {
+		qc := mocks.NewMockQueryCoord(t)
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByID(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, merr.ErrCollectionNotFound).Maybe()
+		quotaCenter := NewQuotaCenter(pcm, qc, &dataCoordMockForQuota{}, core.tsoAllocator, meta)
+		quotaCenter.checkExternalSegments()
+
+		quotaCenter.dataCoordMetrics = &metricsinfo.DataCoordQuotaMetrics{ExternalCollections: []int64{2}}
+		quotaCenter.writableCollections = []int64{1, 2}
+		quotaCenter.resetAllCurrentRates()
+		quotaCenter.checkExternalSegments()
+		assert.NotEqual(t, Limit(0), quotaCenter.currentRates[1][internalpb.RateType_DMLDelete])
+		assert.NotEqual(t, Limit(0), quotaCenter.currentRates[2][internalpb.RateType_DMLInsert])
+		assert.Equal(t, Limit(0), quotaCenter.currentRates[2][internalpb.RateType_DMLUpsert])
+		assert.Equal(t, Limit(0), quotaCenter.currentRates[2][internalpb.RateType_DMLDelete])
+		assert.Equal(t, commonpb.ErrorCode_NotReadyServe, quotaCenter.quotaStates[2][milvuspb.QuotaState_DenyToWrite])
+	})
+
 	t.Run("test setRates", func(t *testing.T) {
 		qc := mocks.NewMockQueryCoord(t)
 		p1 := mocks.NewMockProxy(t)
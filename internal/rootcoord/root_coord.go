@@ -27,6 +27,7 @@ import (
 	"time"
 
 	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
 	"github.com/samber/lo"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/zap"
@@ -44,6 +45,7 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore/db/rootcoord"
 	kvmetestore "github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
 	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
 	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
@@ -52,6 +54,7 @@ import (
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/internal/util/importutil"
+	"github.com/milvus-io/milvus/internal/util/metastoreutil"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	tsoutil2 "github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/pkg/common"
@@ -124,6 +127,10 @@ type Core struct {
 
 	quotaCenter *QuotaCenter
 
+	capacityPlanner *capacityPlanner
+
+	statsHistory *collectionStatsHistory
+
 	stateCode atomic.Value
 	initOnce  sync.Once
 	startOnce sync.Once
@@ -133,6 +140,13 @@ type Core struct {
 
 	importManager *importManager
 
+	ddlHookManager *ddlHookManager
+
+	metaCacheEventPublisher *metaCacheEventPublisher
+
+	// metaStoreMonitor switches rootcoord into read only mode when the meta store is degraded
+	metaStoreMonitor *metastoreutil.HealthMonitor
+
 	enableActiveStandBy bool
 	activateFunc        func() error
 }
@@ -413,12 +427,40 @@ func (c *Core) initImportManager() error {
 		f.NewGetSegmentStatesFunc(),
 		f.NewGetCollectionNameFunc(),
 		f.NewUnsetIsImportingStateFunc(),
+		f.NewMarkSegmentsDroppedFunc(),
 	)
 	c.importManager.init(c.ctx)
 
 	return nil
 }
 
+func (c *Core) initDDLHookManager() error {
+	outboxKv, err := c.metaKVCreator(Params.EtcdCfg.MetaRootPath.GetValue())
+	if err != nil {
+		return err
+	}
+	c.ddlHookManager = newDDLHookManager(c.ctx, outboxKv)
+	return nil
+}
+
+func (c *Core) initMetaCacheEventPublisher() error {
+	eventKv, err := c.metaKVCreator(Params.EtcdCfg.MetaRootPath.GetValue())
+	if err != nil {
+		return err
+	}
+	c.metaCacheEventPublisher = newMetaCacheEventPublisher(c.ctx, eventKv)
+	return nil
+}
+
+func (c *Core) initStatsHistory() error {
+	statsKv, err := c.metaKVCreator(Params.EtcdCfg.MetaRootPath.GetValue())
+	if err != nil {
+		return err
+	}
+	c.statsHistory = newCollectionStatsHistory(c.ctx, statsKv, c.proxyClientManager, c.dataCoord, c.tsoAllocator)
+	return c.statsHistory.load()
+}
+
 func (c *Core) initInternal() error {
 	c.UpdateStateCode(commonpb.StateCode_Initializing)
 	c.initKVCreator()
@@ -461,10 +503,26 @@ func (c *Core) initInternal() error {
 	c.quotaCenter = NewQuotaCenter(c.proxyClientManager, c.queryCoord, c.dataCoord, c.tsoAllocator, c.meta)
 	log.Debug("RootCoord init QuotaCenter done")
 
+	c.capacityPlanner = newCapacityPlanner(c.ctx, c.queryCoord, c.dataCoord, c.tsoAllocator)
+
+	c.metaStoreMonitor = metastoreutil.NewHealthMonitor(c.etcdCli, typeutil.RootCoordRole)
+
 	if err := c.initImportManager(); err != nil {
 		return err
 	}
 
+	if err := c.initDDLHookManager(); err != nil {
+		return err
+	}
+
+	if err := c.initMetaCacheEventPublisher(); err != nil {
+		return err
+	}
+
+	if err := c.initStatsHistory(); err != nil {
+		return err
+	}
+
 	if err := c.initCredentials(); err != nil {
 		return err
 	}
@@ -637,6 +695,7 @@ func (c *Core) startInternal() error {
 
 	c.scheduler.Start()
 	c.stepExecutor.Start()
+	c.metaStoreMonitor.Start()
 
 	c.startServerLoop()
 	c.UpdateStateCode(commonpb.StateCode_Healthy)
@@ -646,13 +705,17 @@ func (c *Core) startInternal() error {
 }
 
 func (c *Core) startServerLoop() {
-	c.wg.Add(6)
+	c.wg.Add(10)
 	go c.startTimeTickLoop()
 	go c.tsLoop()
 	go c.chanTimeTick.startWatch(&c.wg)
 	go c.importManager.cleanupLoop(&c.wg)
 	go c.importManager.sendOutTasksLoop(&c.wg)
 	go c.importManager.flipTaskStateLoop(&c.wg)
+	go c.ddlHookManager.dispatchLoop(&c.wg)
+	go c.capacityPlanner.sampleLoop(&c.wg)
+	go c.statsHistory.sampleLoop(&c.wg)
+	go c.metaCacheEventPublisher.pruneLoop(&c.wg)
 }
 
 // Start starts RootCoord.
@@ -705,6 +768,9 @@ func (c *Core) Stop() error {
 	if c.quotaCenter != nil {
 		c.quotaCenter.stop()
 	}
+	if c.metaStoreMonitor != nil {
+		c.metaStoreMonitor.Stop()
+	}
 	c.wg.Wait()
 	c.revokeSession()
 	return nil
@@ -759,6 +825,9 @@ func (c *Core) CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRe
 	if code, ok := c.checkHealthy(); !ok {
 		return merr.Status(merr.WrapErrServiceNotReady(code.String())), nil
 	}
+	if err := c.metaStoreMonitor.CheckWritable(); err != nil {
+		return merr.Status(err), nil
+	}
 
 	method := "CreateDatabase"
 	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
@@ -806,6 +875,9 @@ func (c *Core) DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseReques
 	if code, ok := c.checkHealthy(); !ok {
 		return merr.Status(merr.WrapErrServiceNotReady(code.String())), nil
 	}
+	if err := c.metaStoreMonitor.CheckWritable(); err != nil {
+		return merr.Status(err), nil
+	}
 
 	method := "DropDatabase"
 	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
@@ -892,6 +964,9 @@ func (c *Core) CreateCollection(ctx context.Context, in *milvuspb.CreateCollecti
 	if code, ok := c.checkHealthy(); !ok {
 		return merr.Status(merr.WrapErrServiceNotReady(code.String())), nil
 	}
+	if err := c.metaStoreMonitor.CheckWritable(); err != nil {
+		return merr.Status(err), nil
+	}
 
 	metrics.RootCoordDDLReqCounter.WithLabelValues("CreateCollection", metrics.TotalLabel).Inc()
 	tr := timerecord.NewTimeRecorder("CreateCollection")
@@ -944,6 +1019,9 @@ func (c *Core) DropCollection(ctx context.Context, in *milvuspb.DropCollectionRe
 	if code, ok := c.checkHealthy(); !ok {
 		return merr.Status(merr.WrapErrServiceNotReady(code.String())), nil
 	}
+	if err := c.metaStoreMonitor.CheckWritable(); err != nil {
+		return merr.Status(err), nil
+	}
 
 	metrics.RootCoordDDLReqCounter.WithLabelValues("DropCollection", metrics.TotalLabel).Inc()
 	tr := timerecord.NewTimeRecorder("DropCollection")
@@ -1198,6 +1276,9 @@ func (c *Core) AlterCollection(ctx context.Context, in *milvuspb.AlterCollection
 	if code, ok := c.checkHealthy(); !ok {
 		return merr.Status(merr.WrapErrServiceNotReady(code.String())), nil
 	}
+	if err := c.metaStoreMonitor.CheckWritable(); err != nil {
+		return merr.Status(err), nil
+	}
 
 	metrics.RootCoordDDLReqCounter.WithLabelValues("AlterCollection", metrics.TotalLabel).Inc()
 	tr := timerecord.NewTimeRecorder("AlterCollection")
@@ -1249,6 +1330,9 @@ func (c *Core) CreatePartition(ctx context.Context, in *milvuspb.CreatePartition
 	if code, ok := c.checkHealthy(); !ok {
 		return merr.Status(merr.WrapErrServiceNotReady(code.String())), nil
 	}
+	if err := c.metaStoreMonitor.CheckWritable(); err != nil {
+		return merr.Status(err), nil
+	}
 
 	metrics.RootCoordDDLReqCounter.WithLabelValues("CreatePartition", metrics.TotalLabel).Inc()
 	tr := timerecord.NewTimeRecorder("CreatePartition")
@@ -1303,6 +1387,9 @@ func (c *Core) DropPartition(ctx context.Context, in *milvuspb.DropPartitionRequ
 	if code, ok := c.checkHealthy(); !ok {
 		return merr.Status(merr.WrapErrServiceNotReady(code.String())), nil
 	}
+	if err := c.metaStoreMonitor.CheckWritable(); err != nil {
+		return merr.Status(err), nil
+	}
 
 	metrics.RootCoordDDLReqCounter.WithLabelValues("DropPartition", metrics.TotalLabel).Inc()
 	tr := timerecord.NewTimeRecorder("DropPartition")
@@ -1638,6 +1725,9 @@ func (c *Core) CreateAlias(ctx context.Context, in *milvuspb.CreateAliasRequest)
 	if code, ok := c.checkHealthy(); !ok {
 		return merr.Status(merr.WrapErrServiceNotReady(code.String())), nil
 	}
+	if err := c.metaStoreMonitor.CheckWritable(); err != nil {
+		return merr.Status(err), nil
+	}
 
 	metrics.RootCoordDDLReqCounter.WithLabelValues("CreateAlias", metrics.TotalLabel).Inc()
 	tr := timerecord.NewTimeRecorder("CreateAlias")
@@ -1692,6 +1782,9 @@ func (c *Core) DropAlias(ctx context.Context, in *milvuspb.DropAliasRequest) (*c
 	if code, ok := c.checkHealthy(); !ok {
 		return merr.Status(merr.WrapErrServiceNotReady(code.String())), nil
 	}
+	if err := c.metaStoreMonitor.CheckWritable(); err != nil {
+		return merr.Status(err), nil
+	}
 
 	metrics.RootCoordDDLReqCounter.WithLabelValues("DropAlias", metrics.TotalLabel).Inc()
 	tr := timerecord.NewTimeRecorder("DropAlias")
@@ -1742,6 +1835,9 @@ func (c *Core) AlterAlias(ctx context.Context, in *milvuspb.AlterAliasRequest) (
 	if code, ok := c.checkHealthy(); !ok {
 		return merr.Status(merr.WrapErrServiceNotReady(code.String())), nil
 	}
+	if err := c.metaStoreMonitor.CheckWritable(); err != nil {
+		return merr.Status(err), nil
+	}
 
 	metrics.RootCoordDDLReqCounter.WithLabelValues("DropAlias", metrics.TotalLabel).Inc()
 	tr := timerecord.NewTimeRecorder("AlterAlias")
@@ -1798,6 +1894,9 @@ func (c *Core) Import(ctx context.Context, req *milvuspb.ImportRequest) (*milvus
 			Status: merr.Status(merr.WrapErrServiceNotReady(code.String())),
 		}, nil
 	}
+	if err := c.metaStoreMonitor.CheckWritable(); err != nil {
+		return &milvuspb.ImportResponse{Status: merr.Status(err)}, nil
+	}
 
 	// Get collection/partition ID from collection/partition name.
 	var colInfo *model.Collection
@@ -1864,7 +1963,9 @@ func (c *Core) GetImportState(ctx context.Context, req *milvuspb.GetImportStateR
 			Status: merr.Status(merr.WrapErrServiceNotReady(code.String())),
 		}, nil
 	}
-	return c.importManager.getTaskState(req.GetTask()), nil
+	resp := c.importManager.getTaskState(req.GetTask())
+	c.importManager.fillTaskJobProgress(resp)
+	return resp, nil
 }
 
 // ListImportTasks returns id array of all import tasks.
@@ -1943,6 +2044,10 @@ func (c *Core) ReportImport(ctx context.Context, ir *rootcoordpb.ImportResult) (
 	// Upon receiving ReportImport request, update the related task's state in task store.
 	ti, err := c.importManager.updateTaskInfo(ir)
 	if err != nil {
+		// The task might have been failed along with its import job, the DataNode is done with it anyway.
+		if ir.GetState() == commonpb.ImportState_ImportFailed || ir.GetState() == commonpb.ImportState_ImportPersisted {
+			resendTaskFunc()
+		}
 		return &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_UpdateImportTaskFailure,
 			Reason:    err.Error(),
@@ -1955,6 +2060,13 @@ func (c *Core) ReportImport(ctx context.Context, ir *rootcoordpb.ImportResult) (
 		// When a DataNode failed importing, remove this DataNode from the busy node list and send out import tasks again.
 		log.Info("an import task has failed, marking DataNode available and resending import task",
 			zap.Int64("task ID", ir.GetTaskId()))
+		retried, err := c.importManager.retryTask(ir.GetTaskId())
+		if err != nil {
+			log.Warn("failed to retry import task", zap.Int64("task ID", ir.GetTaskId()), zap.Error(err))
+		}
+		if !retried {
+			c.importManager.failJob(ti)
+		}
 		resendTaskFunc()
 	} else if ir.GetState() == commonpb.ImportState_ImportCompleted {
 		// When a DataNode completes importing, remove this DataNode from the busy node list and send out import tasks again.
@@ -1971,6 +2083,11 @@ func (c *Core) ReportImport(ctx context.Context, ir *rootcoordpb.ImportResult) (
 				zap.Int64("task ID", ir.GetTaskId()))
 			return merr.Status(err), nil
 		}
+		// Segments of an import job become visible after all the tasks of the job are done,
+		// the DataNode could work on other tasks of the job meanwhile.
+		if ti.GetJobId() != 0 {
+			resendTaskFunc()
+		}
 	}
 
 	return &commonpb.Status{
@@ -2683,6 +2800,9 @@ func (c *Core) RenameCollection(ctx context.Context, req *milvuspb.RenameCollect
 	if code, ok := c.checkHealthy(); !ok {
 		return merr.Status(merr.WrapErrServiceNotReady(code.String())), nil
 	}
+	if err := c.metaStoreMonitor.CheckWritable(); err != nil {
+		return merr.Status(err), nil
+	}
 
 	log := log.Ctx(ctx).With(zap.String("oldCollectionName", req.GetOldName()), zap.String("newCollectionName", req.GetNewName()))
 	log.Info("received request to rename collection")
@@ -2713,6 +2833,199 @@ func (c *Core) RenameCollection(ctx context.Context, req *milvuspb.RenameCollect
 	return merr.Status(nil), nil
 }
 
+// CloneCollection creates a new collection with the schema, partitions and indexes of the source collection,
+// then asks datacoord to clone the segments of the source collection into the new one in background.
+func (c *Core) CloneCollection(ctx context.Context, req *rootcoordpb.CloneCollectionRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return merr.Status(merr.WrapErrServiceNotReady(code.String())), nil
+	}
+	if err := c.metaStoreMonitor.CheckWritable(); err != nil {
+		return merr.Status(err), nil
+	}
+
+	log := log.Ctx(ctx).With(zap.String("dbName", req.GetDbName()),
+		zap.String("collectionName", req.GetCollectionName()),
+		zap.String("newCollectionName", req.GetNewCollectionName()))
+	log.Info("received request to clone collection")
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("CloneCollection", metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder("CloneCollection")
+
+	if err := c.cloneCollection(ctx, req); err != nil {
+		log.Warn("failed to clone collection", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("CloneCollection", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("CloneCollection", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("CloneCollection").Observe(float64(tr.ElapseSpan().Milliseconds()))
+
+	log.Info("done to clone collection")
+	return merr.Status(nil), nil
+}
+
+// cloneCollection isn't a ddl task since it's composed of several ddl tasks, which are scheduled one by one.
+// The new collection is dropped if anything goes wrong after it's created.
+func (c *Core) cloneCollection(ctx context.Context, req *rootcoordpb.CloneCollectionRequest) error {
+	if req.GetNewCollectionName() == "" || req.GetNewCollectionName() == req.GetCollectionName() {
+		return merr.WrapErrParameterInvalid("a different collection name", req.GetNewCollectionName(), "invalid new collection name")
+	}
+
+	src, err := c.meta.GetCollectionByName(ctx, req.GetDbName(), req.GetCollectionName(), typeutil.MaxTimestamp)
+	if err != nil {
+		return err
+	}
+
+	schema := &schemapb.CollectionSchema{
+		Name:               req.GetNewCollectionName(),
+		Description:        src.Description,
+		AutoID:             src.AutoID,
+		EnableDynamicField: src.EnableDynamicField,
+	}
+	// system fields and dynamic field are appended again when creating the collection.
+	for _, field := range model.MarshalFieldModels(src.Fields) {
+		if field.GetFieldID() < StartOfUserFieldID || field.GetIsDynamic() {
+			continue
+		}
+		schema.Fields = append(schema.Fields, field)
+	}
+	_, partitionKeyErr := typeutil.GetPartitionKeyFieldSchema(schema)
+	hasPartitionKey := partitionKeyErr == nil
+
+	schemaBytes, err := proto.Marshal(schema)
+	if err != nil {
+		return err
+	}
+	createReq := &milvuspb.CreateCollectionRequest{
+		Base:             commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_CreateCollection)),
+		DbName:           req.GetDbName(),
+		CollectionName:   req.GetNewCollectionName(),
+		Schema:           schemaBytes,
+		ShardsNum:        int32(len(src.VirtualChannelNames)),
+		ConsistencyLevel: src.ConsistencyLevel,
+		Properties:       src.Properties,
+	}
+	if hasPartitionKey {
+		createReq.NumPartitions = int64(len(src.Partitions))
+	}
+	status, err := c.CreateCollection(ctx, createReq)
+	if err = errorOf(status, err); err != nil {
+		return err
+	}
+
+	if err = c.fillClonedCollection(ctx, req, src, hasPartitionKey); err != nil {
+		dropReq := &milvuspb.DropCollectionRequest{
+			Base:           commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_DropCollection)),
+			DbName:         req.GetDbName(),
+			CollectionName: req.GetNewCollectionName(),
+		}
+		status, dropErr := c.DropCollection(ctx, dropReq)
+		if dropErr = errorOf(status, dropErr); dropErr != nil {
+			log.Ctx(ctx).Warn("failed to drop the cloned collection", zap.String("collectionName", req.GetNewCollectionName()), zap.Error(dropErr))
+		}
+		return err
+	}
+	return nil
+}
+
+func (c *Core) fillClonedCollection(ctx context.Context, req *rootcoordpb.CloneCollectionRequest, src *model.Collection, hasPartitionKey bool) error {
+	dst, err := c.meta.GetCollectionByName(ctx, req.GetDbName(), req.GetNewCollectionName(), typeutil.MaxTimestamp)
+	if err != nil {
+		return err
+	}
+
+	if !hasPartitionKey {
+		dstPartitions := lo.SliceToMap(dst.Partitions, func(p *model.Partition) (string, struct{}) {
+			return p.PartitionName, struct{}{}
+		})
+		for _, partition := range src.Partitions {
+			if _, ok := dstPartitions[partition.PartitionName]; ok {
+				continue
+			}
+			createReq := &milvuspb.CreatePartitionRequest{
+				Base:           commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_CreatePartition)),
+				DbName:         req.GetDbName(),
+				CollectionName: req.GetNewCollectionName(),
+				PartitionName:  partition.PartitionName,
+			}
+			status, err := c.CreatePartition(ctx, createReq)
+			if err = errorOf(status, err); err != nil {
+				return err
+			}
+		}
+		dst, err = c.meta.GetCollectionByName(ctx, req.GetDbName(), req.GetNewCollectionName(), typeutil.MaxTimestamp)
+		if err != nil {
+			return err
+		}
+	}
+
+	// binlogs are organized by field id, so the fields must be identical.
+	dstFields := lo.SliceToMap(dst.Fields, func(f *model.Field) (string, int64) {
+		return f.Name, f.FieldID
+	})
+	for _, field := range src.Fields {
+		if id, ok := dstFields[field.Name]; !ok || id != field.FieldID {
+			return merr.WrapErrServiceInternal(fmt.Sprintf("field %s of the cloned collection mismatches", field.Name))
+		}
+	}
+	skipIndexFieldIDs := make([]int64, 0, len(req.GetSkipIndexFields()))
+	for _, name := range req.GetSkipIndexFields() {
+		id, ok := dstFields[name]
+		if !ok {
+			return merr.WrapErrFieldNotFound(name)
+		}
+		skipIndexFieldIDs = append(skipIndexFieldIDs, id)
+	}
+
+	dstPartitions := lo.SliceToMap(dst.Partitions, func(p *model.Partition) (string, int64) {
+		return p.PartitionName, p.PartitionID
+	})
+	partitionIDs := make(map[int64]int64, len(src.Partitions))
+	for _, partition := range src.Partitions {
+		id, ok := dstPartitions[partition.PartitionName]
+		if !ok {
+			return merr.WrapErrPartitionNotFound(partition.PartitionName)
+		}
+		partitionIDs[partition.PartitionID] = id
+	}
+
+	if len(src.VirtualChannelNames) != len(dst.VirtualChannelNames) {
+		return merr.WrapErrServiceInternal("shards number of the cloned collection mismatches")
+	}
+	channels := make(map[string]string, len(src.VirtualChannelNames))
+	for i, channel := range src.VirtualChannelNames {
+		channels[channel] = dst.VirtualChannelNames[i]
+	}
+
+	ts, err := c.tsoAllocator.GenerateTSO(1)
+	if err != nil {
+		return err
+	}
+	return c.broker.CloneSegments(ctx, &datapb.CloneSegmentsRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithTimeStamp(ts),
+			commonpbutil.WithSourceID(c.session.ServerID),
+		),
+		CollectionID:       src.CollectionID,
+		TargetCollectionID: dst.CollectionID,
+		PartitionIDs:       partitionIDs,
+		Channels:           channels,
+		SegmentIDs:         req.GetSegmentIDs(),
+		SkipIndexFieldIDs:  skipIndexFieldIDs,
+	})
+}
+
+// errorOf merges the rpc error and the returned status into one error.
+func errorOf(status *commonpb.Status, err error) error {
+	if err != nil {
+		return err
+	}
+	if status == nil {
+		return merr.WrapErrServiceInternal("got nil status")
+	}
+	return merr.Error(status)
+}
+
 func (c *Core) CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	if _, ok := c.checkHealthy(); !ok {
 		reason := errorutil.UnHealthReason("rootcoord", c.session.ServerID, "rootcoord is unhealthy")
@@ -2745,3 +3058,52 @@ func (c *Core) CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest)
 
 	return &milvuspb.CheckHealthResponse{IsHealthy: true, Reasons: errReasons}, nil
 }
+
+// GetCapacityReport reports the memory usage of each resource group, the disk usage of the data nodes and index nodes,
+// the backlog of the channels and the days before they are full, projected by the growth of the recent days.
+func (c *Core) GetCapacityReport(ctx context.Context, req *rootcoordpb.GetCapacityReportRequest) (*rootcoordpb.GetCapacityReportResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &rootcoordpb.GetCapacityReportResponse{
+			Status: merr.Status(merr.WrapErrServiceNotReady(code.String())),
+		}, nil
+	}
+
+	resp, err := c.capacityPlanner.report(ctx)
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to get capacity report", zap.Error(err))
+		return &rootcoordpb.GetCapacityReportResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	resp.Status = merr.Status(nil)
+	return resp, nil
+}
+
+// GetCollectionStatsHistory returns the statistics samples of the collection within the time range,
+// including the QPS, the average latency, the rows written and the bytes stored.
+func (c *Core) GetCollectionStatsHistory(ctx context.Context, req *rootcoordpb.GetCollectionStatsHistoryRequest) (*rootcoordpb.GetCollectionStatsHistoryResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &rootcoordpb.GetCollectionStatsHistoryResponse{
+			Status: merr.Status(merr.WrapErrServiceNotReady(code.String())),
+		}, nil
+	}
+
+	collectionID := req.GetCollectionID()
+	if collectionID == InvalidCollectionID {
+		coll, err := c.meta.GetCollectionByName(ctx, req.GetDbName(), req.GetCollectionName(), typeutil.MaxTimestamp)
+		if err != nil {
+			log.Ctx(ctx).Warn("failed to get collection stats history", zap.String("db", req.GetDbName()),
+				zap.String("collection", req.GetCollectionName()), zap.Error(err))
+			return &rootcoordpb.GetCollectionStatsHistoryResponse{
+				Status: merr.Status(err),
+			}, nil
+		}
+		collectionID = coll.CollectionID
+	}
+
+	return &rootcoordpb.GetCollectionStatsHistoryResponse{
+		Status:       merr.Status(nil),
+		CollectionID: collectionID,
+		Samples:      c.statsHistory.get(collectionID, req.GetStartTime(), req.GetEndTime()),
+	}, nil
+}
@@ -32,6 +32,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	memkv "github.com/milvus-io/milvus/internal/kv/mem"
 	"github.com/milvus-io/milvus/internal/kv/mocks"
 	"github.com/milvus-io/milvus/internal/metastore/model"
@@ -42,9 +43,11 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/internal/util/importutil"
+	"github.com/milvus-io/milvus/internal/util/metastoreutil"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
@@ -182,6 +185,26 @@ func TestRootCoord_CreateCollection(t *testing.T) {
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
 	})
 
+	t.Run("meta store degraded", func(t *testing.T) {
+		paramtable.Get().Save(Params.EtcdCfg.DegradationCheckInterval.Key, "10")
+		paramtable.Get().Save(Params.EtcdCfg.DegradationFailureThreshold.Key, "1")
+		defer paramtable.Get().Reset(Params.EtcdCfg.DegradationCheckInterval.Key)
+		defer paramtable.Get().Reset(Params.EtcdCfg.DegradationFailureThreshold.Key)
+
+		c := newTestCore(withHealthyCode())
+		c.metaStoreMonitor = metastoreutil.NewHealthMonitorWithProbe(typeutil.RootCoordRole, func(ctx context.Context) error {
+			return errors.New("etcdserver: no leader")
+		})
+		c.metaStoreMonitor.Start()
+		defer c.metaStoreMonitor.Stop()
+		assert.Eventually(t, c.metaStoreMonitor.IsReadOnly, time.Second, 10*time.Millisecond)
+
+		ctx := context.Background()
+		resp, err := c.CreateCollection(ctx, &milvuspb.CreateCollectionRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, merr.Code(merr.ErrServiceReadOnly), resp.GetCode())
+	})
+
 	t.Run("failed to add task", func(t *testing.T) {
 		c := newTestCore(withHealthyCode(),
 			withInvalidScheduler())
@@ -877,6 +900,113 @@ func TestRootCoord_RenameCollection(t *testing.T) {
 	})
 }
 
+func TestRootCoord_CloneCollection(t *testing.T) {
+	src := &model.Collection{
+		CollectionID: 100,
+		Name:         "src",
+		Fields: []*model.Field{
+			{FieldID: RowIDField, Name: RowIDFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: TimeStampField, Name: TimeStampFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: StartOfUserFieldID, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+		},
+		Partitions: []*model.Partition{
+			{PartitionID: 101, PartitionName: "_default"},
+			{PartitionID: 102, PartitionName: "p1"},
+		},
+		VirtualChannelNames: []string{"src_v0"},
+	}
+	dst := &model.Collection{
+		CollectionID: 200,
+		Name:         "dst",
+		Fields:       src.Fields,
+		Partitions: []*model.Partition{
+			{PartitionID: 201, PartitionName: "_default"},
+			{PartitionID: 202, PartitionName: "p1"},
+		},
+		VirtualChannelNames: []string{"dst_v0"},
+	}
+	newMeta := func() *mockMetaTable {
+		meta := newMockMetaTable()
+		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+			switch collectionName {
+			case src.Name:
+				return src, nil
+			case dst.Name:
+				return dst, nil
+			}
+			return nil, merr.WrapErrCollectionNotFound(collectionName)
+		}
+		return meta
+	}
+	req := &rootcoordpb.CloneCollectionRequest{CollectionName: src.Name, NewCollectionName: dst.Name}
+
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.CloneCollection(context.Background(), req)
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("invalid new name", func(t *testing.T) {
+		c := newTestCore(withHealthyCode())
+		resp, err := c.CloneCollection(context.Background(), &rootcoordpb.CloneCollectionRequest{CollectionName: src.Name, NewCollectionName: src.Name})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("source not found", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(), withMeta(newMeta()))
+		resp, err := c.CloneCollection(context.Background(), &rootcoordpb.CloneCollectionRequest{CollectionName: "unknown", NewCollectionName: dst.Name})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("create collection failed", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(), withMeta(newMeta()), withTaskFailScheduler())
+		resp, err := c.CloneCollection(context.Background(), req)
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("clone segments failed", func(t *testing.T) {
+		broker := newMockBroker()
+		broker.CloneSegmentsFunc = func(ctx context.Context, req *datapb.CloneSegmentsRequest) error {
+			return errors.New("error mock CloneSegments")
+		}
+		dropped := 0
+		sched := newMockScheduler()
+		sched.AddTaskFunc = func(t task) error {
+			if _, ok := t.(*dropCollectionTask); ok {
+				dropped++
+			}
+			t.NotifyDone(nil)
+			return nil
+		}
+		c := newTestCore(withHealthyCode(), withMeta(newMeta()), withScheduler(sched),
+			withTsoAllocator(newMockTsoAllocator()), withBroker(broker))
+		resp, err := c.CloneCollection(context.Background(), req)
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		assert.Equal(t, 1, dropped)
+	})
+
+	t.Run("run ok", func(t *testing.T) {
+		broker := newMockBroker()
+		broker.CloneSegmentsFunc = func(ctx context.Context, req *datapb.CloneSegmentsRequest) error {
+			assert.Equal(t, src.CollectionID, req.GetCollectionID())
+			assert.Equal(t, dst.CollectionID, req.GetTargetCollectionID())
+			assert.Equal(t, map[int64]int64{101: 201, 102: 202}, req.GetPartitionIDs())
+			assert.Equal(t, map[string]string{"src_v0": "dst_v0"}, req.GetChannels())
+			return nil
+		}
+		c := newTestCore(withHealthyCode(), withMeta(newMeta()), withValidScheduler(),
+			withTsoAllocator(newMockTsoAllocator()), withBroker(broker))
+		resp, err := c.CloneCollection(context.Background(), req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+}
+
 func TestRootCoord_ShowConfigurations(t *testing.T) {
 	t.Run("not healthy", func(t *testing.T) {
 		ctx := context.Background()
@@ -1150,7 +1280,7 @@ func TestCore_GetImportState(t *testing.T) {
 	t.Run("normal case", func(t *testing.T) {
 		ctx := context.Background()
 		c := newTestCore(withHealthyCode())
-		c.importManager = newImportManager(ctx, mockKv, nil, nil, nil, nil, nil)
+		c.importManager = newImportManager(ctx, mockKv, nil, nil, nil, nil, nil, nil)
 		resp, err := c.GetImportState(ctx, &milvuspb.GetImportStateRequest{
 			Task: 100,
 		})
@@ -1234,7 +1364,7 @@ func TestCore_ListImportTasks(t *testing.T) {
 
 		ctx := context.Background()
 		c := newTestCore(withHealthyCode(), withMeta(meta))
-		c.importManager = newImportManager(ctx, mockKv, nil, nil, nil, nil, nil)
+		c.importManager = newImportManager(ctx, mockKv, nil, nil, nil, nil, nil, nil)
 
 		// list all tasks
 		resp, err := c.ListImportTasks(ctx, &milvuspb.ListImportTasksRequest{})
@@ -1394,7 +1524,7 @@ func TestCore_ReportImport(t *testing.T) {
 	t.Run("report complete import with task not found", func(t *testing.T) {
 		ctx := context.Background()
 		c := newTestCore(withHealthyCode())
-		c.importManager = newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callGetSegmentStates, nil, nil)
+		c.importManager = newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callGetSegmentStates, nil, nil, nil)
 		resp, err := c.ReportImport(ctx, &rootcoordpb.ImportResult{
 			TaskId: 101,
 			State:  commonpb.ImportState_ImportCompleted,
@@ -1412,7 +1542,7 @@ func TestCore_ReportImport(t *testing.T) {
 			withTtSynchronizer(ticker),
 			withDataCoord(dc))
 		c.broker = newServerBroker(c)
-		c.importManager = newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callGetSegmentStates, nil, callUnsetIsImportingState)
+		c.importManager = newImportManager(ctx, mockKv, idAlloc, callImportServiceFn, callGetSegmentStates, nil, callUnsetIsImportingState, nil)
 		c.importManager.loadFromTaskStore(true)
 		c.importManager.sendOutTasks(ctx)
 
@@ -382,6 +382,21 @@ func (s *removePartitionMetaStep) Weight() stepPriority {
 	return stepPriorityNormal
 }
 
+type recordDDLEventStep struct {
+	baseStep
+	event *ddlEvent
+}
+
+func (s *recordDDLEventStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	err := s.core.ddlHookManager.record(ctx, s.event)
+	return nil, err
+}
+
+func (s *recordDDLEventStep) Desc() string {
+	return fmt.Sprintf("record ddl event, type: %s, collection: %s, partition: %s, ts: %d",
+		s.event.Type, s.event.CollectionName, s.event.PartitionName, s.event.Timestamp)
+}
+
 type nullStep struct {
 }
 
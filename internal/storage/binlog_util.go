@@ -11,9 +11,32 @@ import (
 // ParseSegmentIDByBinlog parse segment id from binlog paths
 // if path format is not expected, returns error
 func ParseSegmentIDByBinlog(rootPath, path string) (UniqueID, error) {
+	keyStr, err := splitBinlogPath(rootPath, path)
+	if err != nil {
+		return 0, err
+	}
+	if keyStr[0] == common.SegmentDeltaLogPath {
+		return strconv.ParseInt(keyStr[3], 10, 64)
+	}
+	return strconv.ParseInt(keyStr[len(keyStr)-3], 10, 64)
+}
+
+// ParseCollectionIDByBinlog parse collection id from binlog paths
+// if path format is not expected, returns error
+func ParseCollectionIDByBinlog(rootPath, path string) (UniqueID, error) {
+	keyStr, err := splitBinlogPath(rootPath, path)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(keyStr[1], 10, 64)
+}
+
+// splitBinlogPath splits the binlog path relative to the root path,
+// and checks the number of the elements by the log type.
+func splitBinlogPath(rootPath, path string) ([]string, error) {
 	// check path contains rootPath as prefix
 	if !strings.HasPrefix(path, rootPath) {
-		return 0, fmt.Errorf("path \"%s\" does not contains rootPath \"%s\"", path, rootPath)
+		return nil, fmt.Errorf("path \"%s\" does not contains rootPath \"%s\"", path, rootPath)
 	}
 	p := path[len(rootPath):]
 
@@ -28,14 +51,14 @@ func ParseSegmentIDByBinlog(rootPath, path string) (UniqueID, error) {
 	logType := keyStr[0]
 	if logType == common.SegmentDeltaLogPath {
 		if len(keyStr) == 5 {
-			return strconv.ParseInt(keyStr[3], 10, 64)
+			return keyStr, nil
 		}
-		return 0, fmt.Errorf("%s is not a valid delta log path", path)
+		return nil, fmt.Errorf("%s is not a valid delta log path", path)
 	}
 
 	// log type are binlog or statslog
 	if len(keyStr) == 6 {
-		return strconv.ParseInt(keyStr[len(keyStr)-3], 10, 64)
+		return keyStr, nil
 	}
-	return 0, fmt.Errorf("%s is not a valid binlog path", path)
+	return nil, fmt.Errorf("%s is not a valid binlog path", path)
 }
@@ -83,3 +83,19 @@ func TestParseSegmentIDByBinlog(t *testing.T) {
 		})
 	}
 }
+
+func TestParseCollectionIDByBinlog(t *testing.T) {
+	id, err := ParseCollectionIDByBinlog("files", "files/insertLog/123/456/1/101/10000001")
+	assert.NoError(t, err)
+	assert.Equal(t, UniqueID(123), id)
+
+	id, err = ParseCollectionIDByBinlog("file", "file/delta_log/436300346003230019/436300346003230020/436300346003230115/436300346003230216")
+	assert.NoError(t, err)
+	assert.Equal(t, UniqueID(436300346003230019), id)
+
+	_, err = ParseCollectionIDByBinlog("files", "files/123")
+	assert.Error(t, err)
+
+	_, err = ParseCollectionIDByBinlog("files", "files/insertLog/collection_id/456/1/101/10000001")
+	assert.Error(t, err)
+}
@@ -0,0 +1,32 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import "context"
+
+// CopyFile copies the file of @srcPath to @dstPath inside the storage if the ChunkManager supports it,
+// otherwise reads the content and writes it back.
+func CopyFile(ctx context.Context, cm ChunkManager, srcPath string, dstPath string) error {
+	if copier, ok := cm.(ObjectCopier); ok {
+		return copier.Copy(ctx, srcPath, dstPath)
+	}
+	content, err := cm.Read(ctx, srcPath)
+	if err != nil {
+		return err
+	}
+	return cm.Write(ctx, dstPath, content)
+}
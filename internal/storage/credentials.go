@@ -0,0 +1,204 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/signer"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+const defaultSessionDuration = time.Hour
+
+// roleCredentialOptions returns the options of the credentials scoped to the role by minio.roleCredentials,
+// the default credentials are used if nothing is configured for the role
+func roleCredentialOptions(cfg *paramtable.MinioConfig, role string) []Option {
+	opts := []Option{
+		STSEndpoint(cfg.STSEndpoint.GetValue()),
+		CredentialRole(role),
+	}
+
+	cred := cfg.GetRoleCredential(role)
+	if !cred.Scoped {
+		return opts
+	}
+	opts = append(opts,
+		AccessKeyID(cred.AccessKeyID),
+		SecretAccessKeyID(cred.SecretAccessKey),
+		RoleARN(cred.RoleARN),
+		SessionDuration(cred.SessionDuration),
+	)
+	log.Info("use the object storage credentials scoped to the role", zap.String("role", role),
+		zap.String("roleARN", cred.RoleARN))
+	return opts
+}
+
+// expirationReporter is implemented by the providers knowing the expiration of the retrieved credentials
+type expirationReporter interface {
+	Expiration() time.Time
+}
+
+// observedProvider observes the refreshes of the wrapped credentials provider by metrics
+type observedProvider struct {
+	credentials.Provider
+	role string
+}
+
+func (p *observedProvider) Retrieve() (credentials.Value, error) {
+	value, err := p.Provider.Retrieve()
+	if err != nil {
+		log.Warn("failed to refresh object storage credentials", zap.String("role", p.role), zap.Error(err))
+		metrics.PersistentDataCredentialRefreshCounter.WithLabelValues(p.role, metrics.FailLabel).Inc()
+		return value, err
+	}
+
+	metrics.PersistentDataCredentialRefreshCounter.WithLabelValues(p.role, metrics.SuccessLabel).Inc()
+	if reporter, ok := p.Provider.(expirationReporter); ok && !reporter.Expiration().IsZero() {
+		expiry := reporter.Expiration()
+		metrics.PersistentDataCredentialExpiry.WithLabelValues(p.role).Set(float64(expiry.Unix()))
+		log.Info("object storage credentials refreshed", zap.String("role", p.role), zap.Time("expiry", expiry))
+	}
+	return value, nil
+}
+
+// stsAssumeRole retrieves the credentials of the assumed role from STS, unlike credentials.STSAssumeRole,
+// it keeps the requested session duration and the expiration returned by STS
+type stsAssumeRole struct {
+	credentials.Expiry
+	client      *http.Client
+	stsEndpoint string
+	options     credentials.STSAssumeRoleOptions
+	expiration  time.Time
+}
+
+func (p *stsAssumeRole) Retrieve() (credentials.Value, error) {
+	v := url.Values{}
+	v.Set("Action", "AssumeRole")
+	v.Set("Version", credentials.STSVersion)
+	v.Set("RoleArn", p.options.RoleARN)
+	v.Set("RoleSessionName", p.options.RoleSessionName)
+	v.Set("DurationSeconds", strconv.Itoa(p.options.DurationSeconds))
+
+	u, err := url.Parse(p.stsEndpoint)
+	if err != nil {
+		return credentials.Value{}, err
+	}
+	u.Path = "/"
+
+	body := v.Encode()
+	hash := sha256.Sum256([]byte(body))
+	req, err := http.NewRequest(http.MethodPost, u.String(), strings.NewReader(body))
+	if err != nil {
+		return credentials.Value{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("X-Amz-Content-Sha256", hex.EncodeToString(hash[:]))
+	req = signer.SignV4STS(*req, p.options.AccessKey, p.options.SecretKey, p.options.Location)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return credentials.Value{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return credentials.Value{}, errors.Newf("failed to assume role %s: %s", p.options.RoleARN, resp.Status)
+	}
+
+	result := credentials.AssumeRoleResponse{}
+	if err := xml.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return credentials.Value{}, err
+	}
+	creds := result.Result.Credentials
+	if creds.Expiration.IsZero() {
+		return credentials.Value{}, errors.Newf("no expiration returned by STS for role %s", p.options.RoleARN)
+	}
+	p.expiration = creds.Expiration
+	p.SetExpiration(p.expiration, credentials.DefaultExpiryWindow)
+	return credentials.Value{
+		AccessKeyID:     creds.AccessKey,
+		SecretAccessKey: creds.SecretKey,
+		SessionToken:    creds.SessionToken,
+		SignerType:      credentials.SignatureV4,
+	}, nil
+}
+
+// Expiration returns the expiration of the credentials last retrieved from STS
+func (p *stsAssumeRole) Expiration() time.Time {
+	return p.expiration
+}
+
+// newIAMCredentials returns the credentials of the IAM role attached to the node
+func newIAMCredentials(c *config) *credentials.Credentials {
+	return credentials.New(&observedProvider{
+		Provider: &credentials.IAM{
+			Client: &http.Client{
+				Transport: http.DefaultTransport,
+			},
+		},
+		role: c.credentialRole,
+	})
+}
+
+// newAssumeRoleCredentials returns the credentials of the assumed role, which are refreshed through STS
+// before they expire
+func newAssumeRoleCredentials(c *config) (*credentials.Credentials, error) {
+	if c.stsEndpoint == "" {
+		return nil, errors.New("STS endpoint is required to assume role")
+	}
+	if c.accessKeyID == "" || c.secretAccessKeyID == "" {
+		return nil, errors.New("access key is required to assume role")
+	}
+
+	duration := c.sessionDuration
+	if duration <= 0 {
+		duration = defaultSessionDuration
+	}
+	sessionName := "milvus"
+	if c.credentialRole != "" {
+		sessionName = "milvus-" + c.credentialRole
+	}
+	return credentials.New(&observedProvider{
+		Provider: &stsAssumeRole{
+			client: &http.Client{
+				Transport: http.DefaultTransport,
+			},
+			stsEndpoint: c.stsEndpoint,
+			options: credentials.STSAssumeRoleOptions{
+				AccessKey:       c.accessKeyID,
+				SecretKey:       c.secretAccessKeyID,
+				DurationSeconds: int(duration.Seconds()),
+				RoleARN:         c.roleARN,
+				RoleSessionName: sessionName,
+			},
+		},
+		role: c.credentialRole,
+	}), nil
+}
@@ -0,0 +1,178 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+type mockCredentialProvider struct {
+	err error
+}
+
+func (p *mockCredentialProvider) Retrieve() (credentials.Value, error) {
+	if p.err != nil {
+		return credentials.Value{}, p.err
+	}
+	return credentials.Value{AccessKeyID: "ak", SecretAccessKey: "sk"}, nil
+}
+
+func (p *mockCredentialProvider) IsExpired() bool {
+	return true
+}
+
+func TestRoleCredentialOptions(t *testing.T) {
+	params := paramtable.Get()
+
+	applyOptions := func(opts []Option) *config {
+		c := newDefaultConfig()
+		for _, opt := range opts {
+			opt(c)
+		}
+		return c
+	}
+
+	t.Run("no scoped credentials", func(t *testing.T) {
+		c := applyOptions(roleCredentialOptions(&params.MinioCfg, typeutil.QueryNodeRole))
+		assert.Equal(t, params.MinioCfg.STSEndpoint.GetValue(), c.stsEndpoint)
+		assert.Equal(t, typeutil.QueryNodeRole, c.credentialRole)
+		assert.Empty(t, c.accessKeyID)
+		assert.Empty(t, c.roleARN)
+	})
+
+	t.Run("scoped credentials", func(t *testing.T) {
+		params.Save("minio.roleCredentials.querynode.accessKeyID", "query_ak")
+		params.Save("minio.roleCredentials.querynode.secretAccessKey", "query_sk")
+		params.Save("minio.roleCredentials.querynode.roleARN", "arn:aws:iam::1:role/querynode")
+		params.Save("minio.roleCredentials.querynode.sessionDuration", "900")
+		params.Save("minio.roleCredentials.datanode.accessKeyID", "data_ak")
+		defer func() {
+			params.Reset("minio.roleCredentials.querynode.accessKeyID")
+			params.Reset("minio.roleCredentials.querynode.secretAccessKey")
+			params.Reset("minio.roleCredentials.querynode.roleARN")
+			params.Reset("minio.roleCredentials.querynode.sessionDuration")
+			params.Reset("minio.roleCredentials.datanode.accessKeyID")
+		}()
+
+		c := applyOptions(roleCredentialOptions(&params.MinioCfg, typeutil.QueryNodeRole))
+		assert.Equal(t, "query_ak", c.accessKeyID)
+		assert.Equal(t, "query_sk", c.secretAccessKeyID)
+		assert.Equal(t, "arn:aws:iam::1:role/querynode", c.roleARN)
+		assert.Equal(t, 15*time.Minute, c.sessionDuration)
+
+		c = applyOptions(roleCredentialOptions(&params.MinioCfg, typeutil.DataNodeRole))
+		assert.Equal(t, "data_ak", c.accessKeyID)
+		assert.Empty(t, c.roleARN)
+		assert.Equal(t, time.Duration(0), c.sessionDuration)
+	})
+}
+
+func TestObservedProvider(t *testing.T) {
+	provider := &observedProvider{
+		Provider: &mockCredentialProvider{},
+		role:     typeutil.QueryNodeRole,
+	}
+	value, err := provider.Retrieve()
+	assert.NoError(t, err)
+	assert.Equal(t, "ak", value.AccessKeyID)
+	assert.True(t, provider.IsExpired())
+
+	provider.Provider = &mockCredentialProvider{err: errors.New("mock")}
+	_, err = provider.Retrieve()
+	assert.Error(t, err)
+}
+
+func TestSTSAssumeRole(t *testing.T) {
+	expiration := time.Now().Add(15 * time.Minute).UTC().Truncate(time.Second)
+	var form url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		form = r.PostForm
+		fmt.Fprintf(w, `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/"><AssumeRoleResult><Credentials>
+<AccessKeyId>tmp_ak</AccessKeyId><SecretAccessKey>tmp_sk</SecretAccessKey><SessionToken>token</SessionToken>
+<Expiration>%s</Expiration></Credentials></AssumeRoleResult></AssumeRoleResponse>`, expiration.Format(time.RFC3339))
+	}))
+	defer server.Close()
+
+	provider := &stsAssumeRole{
+		client:      server.Client(),
+		stsEndpoint: server.URL,
+		options: credentials.STSAssumeRoleOptions{
+			AccessKey:       "ak",
+			SecretKey:       "sk",
+			DurationSeconds: 900,
+			RoleARN:         "arn:aws:iam::1:role/querynode",
+			RoleSessionName: "milvus-querynode",
+		},
+	}
+	observed := &observedProvider{Provider: provider, role: typeutil.QueryNodeRole}
+	value, err := observed.Retrieve()
+	assert.NoError(t, err)
+	assert.Equal(t, "tmp_ak", value.AccessKeyID)
+	assert.Equal(t, "tmp_sk", value.SecretAccessKey)
+	assert.Equal(t, "token", value.SessionToken)
+	assert.Equal(t, "900", form.Get("DurationSeconds"))
+	assert.Equal(t, "arn:aws:iam::1:role/querynode", form.Get("RoleArn"))
+	assert.True(t, expiration.Equal(provider.Expiration()))
+	assert.False(t, provider.IsExpired())
+	assert.Equal(t, float64(expiration.Unix()),
+		testutil.ToFloat64(metrics.PersistentDataCredentialExpiry.WithLabelValues(typeutil.QueryNodeRole)))
+
+	// the expiration is required to refresh the credentials in time
+	expiration = time.Time{}
+	_, err = provider.Retrieve()
+	assert.Error(t, err)
+}
+
+func TestNewAssumeRoleCredentials(t *testing.T) {
+	c := &config{
+		roleARN:        "arn:aws:iam::1:role/querynode",
+		credentialRole: typeutil.QueryNodeRole,
+	}
+	_, err := newAssumeRoleCredentials(c)
+	assert.Error(t, err)
+
+	c.stsEndpoint = "https://sts.amazonaws.com"
+	_, err = newAssumeRoleCredentials(c)
+	assert.Error(t, err)
+
+	c.accessKeyID = "ak"
+	c.secretAccessKeyID = "sk"
+	creds, err := newAssumeRoleCredentials(c)
+	assert.NoError(t, err)
+	assert.NotNil(t, creds)
+
+	// assuming role is only supported by aws compatible providers
+	c.cloudProvider = CloudProviderGCP
+	_, err = newMinioChunkManagerWithConfig(context.Background(), c)
+	assert.Error(t, err)
+}
@@ -0,0 +1,332 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+
+	"github.com/cockroachdb/errors"
+	"golang.org/x/exp/mmap"
+
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+// The encrypted objects are laid out as
+//
+//	| magic (8 bytes) | collection ID (int64) | key version (int64) | nonce (12 bytes) | AES-256-GCM ciphertext and tag |
+//
+// The integers are little endian, the magic, collection ID and key version are authenticated as the additional data.
+// The data key of each collection and key version is HMAC-SHA256(master key, "<collection ID>/<key version>"),
+// so an object could be decrypted with the master key only, whichever key version it's written with.
+// segcore decrypts the objects in the same way, see internal/core/src/storage/Encryption.cpp.
+const (
+	encryptionMagic         = "\x00MVSENC\x01"
+	encryptionNonceSize     = 12
+	encryptionTagSize       = 16
+	encryptionAADSize       = len(encryptionMagic) + 8 + 8
+	encryptionHeaderSize    = encryptionAADSize + encryptionNonceSize
+	encryptionOverhead      = encryptionHeaderSize + encryptionTagSize
+	encryptionMasterKeySize = 32
+)
+
+// ErrEncryptionNotConfigured is returned if an encrypted object is read, or encryption is required,
+// without the master key configured.
+var ErrEncryptionNotConfigured = errors.New("encryption master key is not configured")
+
+// Keyring derives the data keys of the collections from the master key.
+type Keyring struct {
+	masterKey []byte
+}
+
+// NewKeyring creates a keyring with the base64 encoded 32 bytes master key.
+func NewKeyring(encodedMasterKey string) (*Keyring, error) {
+	masterKey, err := base64.StdEncoding.DecodeString(encodedMasterKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid encryption master key")
+	}
+	if len(masterKey) != encryptionMasterKeySize {
+		return nil, fmt.Errorf("invalid encryption master key, expected %d bytes, got %d", encryptionMasterKeySize, len(masterKey))
+	}
+	return &Keyring{masterKey: masterKey}, nil
+}
+
+func (k *Keyring) newAEAD(collectionID, keyVersion int64) (cipher.AEAD, error) {
+	mac := hmac.New(sha256.New, k.masterKey)
+	mac.Write([]byte(fmt.Sprintf("%d/%d", collectionID, keyVersion)))
+	block, err := aes.NewCipher(mac.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// Encrypt encrypts the content with the data key of the collection and key version.
+func (k *Keyring) Encrypt(collectionID, keyVersion int64, content []byte) ([]byte, error) {
+	aead, err := k.newAEAD(collectionID, keyVersion)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]byte, encryptionHeaderSize, encryptionOverhead+len(content))
+	copy(data, encryptionMagic)
+	common.Endian.PutUint64(data[len(encryptionMagic):], uint64(collectionID))
+	common.Endian.PutUint64(data[len(encryptionMagic)+8:], uint64(keyVersion))
+	if _, err := io.ReadFull(rand.Reader, data[encryptionAADSize:encryptionHeaderSize]); err != nil {
+		return nil, err
+	}
+	return aead.Seal(data, data[encryptionAADSize:encryptionHeaderSize], content, data[:encryptionAADSize]), nil
+}
+
+// Decrypt decrypts the encrypted object, the content is returned as is if it's not encrypted.
+func (k *Keyring) Decrypt(data []byte) ([]byte, error) {
+	collectionID, keyVersion, ok := ParseEncryptionHeader(data)
+	if !ok {
+		return data, nil
+	}
+	if len(data) < encryptionOverhead {
+		return nil, errors.New("encrypted object is truncated")
+	}
+	aead, err := k.newAEAD(collectionID, keyVersion)
+	if err != nil {
+		return nil, err
+	}
+	content, err := aead.Open(nil, data[encryptionAADSize:encryptionHeaderSize], data[encryptionHeaderSize:], data[:encryptionAADSize])
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decrypt object of collection %d with key version %d", collectionID, keyVersion)
+	}
+	return content, nil
+}
+
+// ParseEncryptionHeader returns the collection and key version which the object is encrypted with,
+// ok is false if the object isn't encrypted.
+func ParseEncryptionHeader(data []byte) (collectionID, keyVersion int64, ok bool) {
+	if len(data) < encryptionAADSize || !bytes.Equal(data[:len(encryptionMagic)], []byte(encryptionMagic)) {
+		return 0, 0, false
+	}
+	collectionID = int64(common.Endian.Uint64(data[len(encryptionMagic):]))
+	keyVersion = int64(common.Endian.Uint64(data[len(encryptionMagic)+8:]))
+	return collectionID, keyVersion, true
+}
+
+type encryptionCtxKey struct{}
+
+type encryptionKey struct {
+	collectionID int64
+	keyVersion   int64
+}
+
+// WithEncryptionKey returns a context which makes the EncryptedChunkManager encrypt the written objects
+// with the key version of the collection, the context is returned as is if the key version is 0.
+func WithEncryptionKey(ctx context.Context, collectionID, keyVersion int64) context.Context {
+	if keyVersion <= 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, encryptionCtxKey{}, encryptionKey{collectionID: collectionID, keyVersion: keyVersion})
+}
+
+func encryptionKeyFromContext(ctx context.Context) (encryptionKey, bool) {
+	key, ok := ctx.Value(encryptionCtxKey{}).(encryptionKey)
+	return key, ok
+}
+
+// EncryptedChunkManager encrypts the objects written with the key in the context, see WithEncryptionKey,
+// and decrypts the encrypted objects read transparently. The objects written without key are kept in plain text.
+type EncryptedChunkManager struct {
+	ChunkManager
+	keyring *Keyring
+}
+
+var _ ChunkManager = (*EncryptedChunkManager)(nil)
+
+// NewEncryptedChunkManager wraps the ChunkManager with the keyring.
+func NewEncryptedChunkManager(cm ChunkManager, keyring *Keyring) *EncryptedChunkManager {
+	return &EncryptedChunkManager{
+		ChunkManager: cm,
+		keyring:      keyring,
+	}
+}
+
+// SupportEncryption returns whether the objects written by the ChunkManager could be encrypted.
+func SupportEncryption(cm ChunkManager) bool {
+	_, ok := cm.(*EncryptedChunkManager)
+	return ok
+}
+
+func (cm *EncryptedChunkManager) encrypt(ctx context.Context, content []byte) ([]byte, error) {
+	key, ok := encryptionKeyFromContext(ctx)
+	if !ok {
+		return content, nil
+	}
+	return cm.keyring.Encrypt(key.collectionID, key.keyVersion, content)
+}
+
+// Write writes the content, encrypted if the context carries a key.
+func (cm *EncryptedChunkManager) Write(ctx context.Context, filePath string, content []byte) error {
+	data, err := cm.encrypt(ctx, content)
+	if err != nil {
+		return err
+	}
+	return cm.ChunkManager.Write(ctx, filePath, data)
+}
+
+// MultiWrite writes the contents, encrypted if the context carries a key.
+func (cm *EncryptedChunkManager) MultiWrite(ctx context.Context, contents map[string][]byte) error {
+	if _, ok := encryptionKeyFromContext(ctx); !ok {
+		return cm.ChunkManager.MultiWrite(ctx, contents)
+	}
+	encrypted := make(map[string][]byte, len(contents))
+	for filePath, content := range contents {
+		data, err := cm.encrypt(ctx, content)
+		if err != nil {
+			return err
+		}
+		encrypted[filePath] = data
+	}
+	return cm.ChunkManager.MultiWrite(ctx, encrypted)
+}
+
+// Read reads and decrypts the object.
+func (cm *EncryptedChunkManager) Read(ctx context.Context, filePath string) ([]byte, error) {
+	data, err := cm.ChunkManager.Read(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	return cm.keyring.Decrypt(data)
+}
+
+// MultiRead reads and decrypts the objects.
+func (cm *EncryptedChunkManager) MultiRead(ctx context.Context, filePaths []string) ([][]byte, error) {
+	datas, err := cm.ChunkManager.MultiRead(ctx, filePaths)
+	if err != nil {
+		return nil, err
+	}
+	for i, data := range datas {
+		if datas[i], err = cm.keyring.Decrypt(data); err != nil {
+			return nil, err
+		}
+	}
+	return datas, nil
+}
+
+// ReadWithPrefix reads and decrypts the objects with the prefix.
+func (cm *EncryptedChunkManager) ReadWithPrefix(ctx context.Context, prefix string) ([]string, [][]byte, error) {
+	filePaths, datas, err := cm.ChunkManager.ReadWithPrefix(ctx, prefix)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i, data := range datas {
+		if datas[i], err = cm.keyring.Decrypt(data); err != nil {
+			return nil, nil, err
+		}
+	}
+	return filePaths, datas, nil
+}
+
+// Reader returns a reader of the decrypted object, the whole object is read since it's authenticated as a whole.
+func (cm *EncryptedChunkManager) Reader(ctx context.Context, filePath string) (FileReader, error) {
+	content, err := cm.Read(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	return io.NopCloser(bytes.NewReader(content)), nil
+}
+
+// ReadAt reads the range of the decrypted object.
+func (cm *EncryptedChunkManager) ReadAt(ctx context.Context, filePath string, off int64, length int64) ([]byte, error) {
+	encrypted, err := cm.isEncrypted(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	if !encrypted {
+		return cm.ChunkManager.ReadAt(ctx, filePath, off, length)
+	}
+	content, err := cm.Read(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	if off < 0 || length < 0 || off+length > int64(len(content)) {
+		return nil, io.EOF
+	}
+	return content[off : off+length], nil
+}
+
+// Size returns the size of the decrypted object.
+func (cm *EncryptedChunkManager) Size(ctx context.Context, filePath string) (int64, error) {
+	size, err := cm.ChunkManager.Size(ctx, filePath)
+	if err != nil {
+		return 0, err
+	}
+	if size < int64(encryptionOverhead) {
+		return size, nil
+	}
+	encrypted, err := cm.isEncrypted(ctx, filePath)
+	if err != nil {
+		return 0, err
+	}
+	if encrypted {
+		return size - int64(encryptionOverhead), nil
+	}
+	return size, nil
+}
+
+// Mmap maps the object in plain text only, the encrypted ones have to be read.
+func (cm *EncryptedChunkManager) Mmap(ctx context.Context, filePath string) (*mmap.ReaderAt, error) {
+	encrypted, err := cm.isEncrypted(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	if encrypted {
+		return nil, errors.Newf("can't mmap encrypted object %s", filePath)
+	}
+	return cm.ChunkManager.Mmap(ctx, filePath)
+}
+
+// Copy copies the object as is, the copy is decrypted with the key of the source collection.
+func (cm *EncryptedChunkManager) Copy(ctx context.Context, srcPath string, dstPath string) error {
+	if copier, ok := cm.ChunkManager.(ObjectCopier); ok {
+		return copier.Copy(ctx, srcPath, dstPath)
+	}
+	data, err := cm.ChunkManager.Read(ctx, srcPath)
+	if err != nil {
+		return err
+	}
+	return cm.ChunkManager.Write(ctx, dstPath, data)
+}
+
+func (cm *EncryptedChunkManager) isEncrypted(ctx context.Context, filePath string) (bool, error) {
+	size, err := cm.ChunkManager.Size(ctx, filePath)
+	if err != nil {
+		return false, err
+	}
+	if size < int64(encryptionOverhead) {
+		return false, nil
+	}
+	header, err := cm.ChunkManager.ReadAt(ctx, filePath, 0, int64(encryptionAADSize))
+	if err != nil && !errors.Is(err, io.EOF) {
+		return false, err
+	}
+	_, _, ok := ParseEncryptionHeader(header)
+	return ok, nil
+}
@@ -0,0 +1,160 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptedChunkManager(t *testing.T) {
+	ctx := context.Background()
+	masterKey := base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef"))
+	keyring, err := NewKeyring(masterKey)
+	require.NoError(t, err)
+
+	root := path.Join(t.TempDir(), "encryption")
+	inner := NewLocalChunkManager(RootPath(root))
+	cm := NewEncryptedChunkManager(inner, keyring)
+	assert.True(t, SupportEncryption(cm))
+	assert.False(t, SupportEncryption(inner))
+
+	t.Run("invalid master key", func(t *testing.T) {
+		_, err := NewKeyring("not base64")
+		assert.Error(t, err)
+		_, err = NewKeyring(base64.StdEncoding.EncodeToString([]byte("short")))
+		assert.Error(t, err)
+	})
+
+	t.Run("write with key", func(t *testing.T) {
+		content := []byte("encrypted content")
+		filePath := path.Join(root, "encrypted")
+		err := cm.Write(WithEncryptionKey(ctx, 1, 2), filePath, content)
+		require.NoError(t, err)
+
+		raw, err := inner.Read(ctx, filePath)
+		require.NoError(t, err)
+		assert.NotContains(t, string(raw), string(content))
+		collectionID, keyVersion, ok := ParseEncryptionHeader(raw)
+		assert.True(t, ok)
+		assert.EqualValues(t, 1, collectionID)
+		assert.EqualValues(t, 2, keyVersion)
+
+		data, err := cm.Read(ctx, filePath)
+		assert.NoError(t, err)
+		assert.Equal(t, content, data)
+
+		size, err := cm.Size(ctx, filePath)
+		assert.NoError(t, err)
+		assert.EqualValues(t, len(content), size)
+
+		data, err = cm.ReadAt(ctx, filePath, 10, 7)
+		assert.NoError(t, err)
+		assert.Equal(t, content[10:], data)
+
+		reader, err := cm.Reader(ctx, filePath)
+		require.NoError(t, err)
+		data, err = io.ReadAll(reader)
+		assert.NoError(t, err)
+		assert.Equal(t, content, data)
+
+		_, err = cm.Mmap(ctx, filePath)
+		assert.Error(t, err)
+
+		// a different master key can't decrypt it
+		other, err := NewKeyring(base64.StdEncoding.EncodeToString([]byte("fedcba9876543210fedcba9876543210")))
+		require.NoError(t, err)
+		_, err = NewEncryptedChunkManager(inner, other).Read(ctx, filePath)
+		assert.Error(t, err)
+
+		// tampered
+		raw[len(raw)-1] ^= 0xff
+		require.NoError(t, inner.Write(ctx, filePath, raw))
+		_, err = cm.Read(ctx, filePath)
+		assert.Error(t, err)
+	})
+
+	t.Run("multi write and read", func(t *testing.T) {
+		contents := map[string][]byte{
+			path.Join(root, "multi", "a"): []byte("aaa"),
+			path.Join(root, "multi", "b"): []byte("bbb"),
+		}
+		err := cm.MultiWrite(WithEncryptionKey(ctx, 1, 1), contents)
+		require.NoError(t, err)
+
+		datas, err := cm.MultiRead(ctx, []string{path.Join(root, "multi", "a"), path.Join(root, "multi", "b")})
+		assert.NoError(t, err)
+		assert.Equal(t, [][]byte{[]byte("aaa"), []byte("bbb")}, datas)
+
+		filePaths, datas, err := cm.ReadWithPrefix(ctx, path.Join(root, "multi"))
+		assert.NoError(t, err)
+		assert.Len(t, filePaths, 2)
+		for i, filePath := range filePaths {
+			assert.Equal(t, contents[filePath], datas[i])
+		}
+	})
+
+	t.Run("plain text", func(t *testing.T) {
+		content := []byte("plain content which is long enough to hold the encryption header")
+		filePath := path.Join(root, "plain")
+		// key version 0 means not encrypted
+		err := cm.Write(WithEncryptionKey(ctx, 1, 0), filePath, content)
+		require.NoError(t, err)
+
+		raw, err := inner.Read(ctx, filePath)
+		assert.NoError(t, err)
+		assert.Equal(t, content, raw)
+
+		data, err := cm.Read(ctx, filePath)
+		assert.NoError(t, err)
+		assert.Equal(t, content, data)
+
+		size, err := cm.Size(ctx, filePath)
+		assert.NoError(t, err)
+		assert.EqualValues(t, len(content), size)
+
+		data, err = cm.ReadAt(ctx, filePath, 6, 7)
+		assert.NoError(t, err)
+		assert.Equal(t, content[6:13], data)
+
+		reader, err := cm.Mmap(ctx, filePath)
+		require.NoError(t, err)
+		reader.Close()
+	})
+
+	t.Run("factory", func(t *testing.T) {
+		factory := NewChunkManagerFactory("local", RootPath(root), EncryptionMasterKey(masterKey))
+		cm, err := factory.NewPersistentStorageChunkManager(ctx)
+		assert.NoError(t, err)
+		assert.True(t, SupportEncryption(cm))
+
+		factory = NewChunkManagerFactory("local", RootPath(root))
+		cm, err = factory.NewPersistentStorageChunkManager(ctx)
+		assert.NoError(t, err)
+		assert.False(t, SupportEncryption(cm))
+
+		factory = NewChunkManagerFactory("local", RootPath(root), EncryptionMasterKey("invalid"))
+		_, err = factory.NewPersistentStorageChunkManager(ctx)
+		assert.Error(t, err)
+	})
+}
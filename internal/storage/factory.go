@@ -15,9 +15,11 @@ type ChunkManagerFactory struct {
 
 func NewChunkManagerFactoryWithParam(params *paramtable.ComponentParam) *ChunkManagerFactory {
 	if params.CommonCfg.StorageType.GetValue() == "local" {
-		return NewChunkManagerFactory("local", RootPath(params.LocalStorageCfg.Path.GetValue()))
+		return NewChunkManagerFactory("local",
+			RootPath(params.LocalStorageCfg.Path.GetValue()),
+			EncryptionMasterKey(params.CommonCfg.EncryptionMasterKey.GetValue()))
 	}
-	return NewChunkManagerFactory("minio",
+	opts := []Option{
 		RootPath(params.MinioCfg.RootPath.GetValue()),
 		Address(params.MinioCfg.Address.GetValue()),
 		AccessKeyID(params.MinioCfg.AccessKeyID.GetValue()),
@@ -27,7 +29,10 @@ func NewChunkManagerFactoryWithParam(params *paramtable.ComponentParam) *ChunkMa
 		UseIAM(params.MinioCfg.UseIAM.GetAsBool()),
 		CloudProvider(params.MinioCfg.CloudProvider.GetValue()),
 		IAMEndpoint(params.MinioCfg.IAMEndpoint.GetValue()),
-		CreateBucket(true))
+		CreateBucket(true),
+		EncryptionMasterKey(params.CommonCfg.EncryptionMasterKey.GetValue())}
+	opts = append(opts, roleCredentialOptions(&params.MinioCfg, paramtable.GetRole())...)
+	return NewChunkManagerFactory("minio", opts...)
 }
 
 func NewChunkManagerFactory(persistentStorage string, opts ...Option) *ChunkManagerFactory {
@@ -42,14 +47,29 @@ func NewChunkManagerFactory(persistentStorage string, opts ...Option) *ChunkMana
 }
 
 func (f *ChunkManagerFactory) newChunkManager(ctx context.Context, engine string) (ChunkManager, error) {
+	var (
+		cm  ChunkManager
+		err error
+	)
 	switch engine {
 	case "local":
-		return NewLocalChunkManager(RootPath(f.config.rootPath)), nil
+		cm = NewLocalChunkManager(RootPath(f.config.rootPath))
 	case "minio":
-		return newMinioChunkManagerWithConfig(ctx, f.config)
+		cm, err = newMinioChunkManagerWithConfig(ctx, f.config)
 	default:
 		return nil, errors.New("no chunk manager implemented with engine: " + engine)
 	}
+	if err != nil {
+		return nil, err
+	}
+	if f.config.encryptionKey == "" {
+		return cm, nil
+	}
+	keyring, err := NewKeyring(f.config.encryptionKey)
+	if err != nil {
+		return nil, err
+	}
+	return NewEncryptedChunkManager(cm, keyring), nil
 }
 
 func (f *ChunkManagerFactory) NewPersistentStorageChunkManager(ctx context.Context) (ChunkManager, error) {
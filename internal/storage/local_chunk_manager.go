@@ -100,6 +100,27 @@ func (lcm *LocalChunkManager) Write(ctx context.Context, filePath string, conten
 	return ioutil.WriteFile(filePath, content, os.ModePerm)
 }
 
+// Copy links @dstPath to the file of @srcPath, as the files are never modified in place,
+// the file content is shared until both of them are removed.
+// It falls back to copy the content if failed to link, e.g. crossing devices.
+func (lcm *LocalChunkManager) Copy(ctx context.Context, srcPath string, dstPath string) error {
+	if err := os.MkdirAll(path.Dir(dstPath), os.ModePerm); err != nil {
+		return err
+	}
+	err := os.Link(srcPath, dstPath)
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, os.ErrNotExist) {
+		return WrapErrNoSuchKey(srcPath)
+	}
+	content, err := lcm.Read(ctx, srcPath)
+	if err != nil {
+		return err
+	}
+	return lcm.Write(ctx, dstPath, content)
+}
+
 // MultiWrite writes the data to local storage.
 func (lcm *LocalChunkManager) MultiWrite(ctx context.Context, contents map[string][]byte) error {
 	var el error
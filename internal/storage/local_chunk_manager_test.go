@@ -22,6 +22,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -416,6 +417,34 @@ func TestLocalCM(t *testing.T) {
 		assert.Equal(t, p, "")
 	})
 
+	t.Run("test Copy", func(t *testing.T) {
+		testCopyRoot := "copy"
+
+		testCM := NewLocalChunkManager(RootPath(localPath))
+		defer testCM.RemoveWithPrefix(ctx, testCM.RootPath())
+
+		src := path.Join(localPath, testCopyRoot, "src")
+		dst := path.Join(localPath, testCopyRoot, "a", "dst")
+		err := testCM.Write(ctx, src, []byte("value"))
+		require.NoError(t, err)
+
+		err = CopyFile(ctx, testCM, src, dst)
+		assert.NoError(t, err)
+		value, err := testCM.Read(ctx, dst)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("value"), value)
+
+		// removing the source doesn't affect the copy
+		err = testCM.Remove(ctx, src)
+		assert.NoError(t, err)
+		value, err = testCM.Read(ctx, dst)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("value"), value)
+
+		err = CopyFile(ctx, testCM, src, dst)
+		assert.True(t, errors.Is(err, ErrNoSuchKey))
+	})
+
 	t.Run("test Prefix", func(t *testing.T) {
 		testPrefix := "prefix"
 
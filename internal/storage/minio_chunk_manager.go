@@ -83,6 +83,10 @@ func newMinioChunkManagerWithConfig(ctx context.Context, c *config) (*MinioChunk
 	var newMinioFn = minio.New
 	var bucketLookupType = minio.BucketLookupAuto
 
+	if c.roleARN != "" && (c.cloudProvider == CloudProviderAliyun || c.cloudProvider == CloudProviderGCP) {
+		return nil, fmt.Errorf("assuming role is not supported by cloud provider %s", c.cloudProvider)
+	}
+
 	switch c.cloudProvider {
 	case CloudProviderAliyun:
 		// auto doesn't work for aliyun, so we set to dns deliberately
@@ -98,8 +102,14 @@ func newMinioChunkManagerWithConfig(ctx context.Context, c *config) (*MinioChunk
 			creds = credentials.NewStaticV2(c.accessKeyID, c.secretAccessKeyID, "")
 		}
 	default: // aws, minio
-		if c.useIAM {
-			creds = credentials.NewIAM("")
+		if c.roleARN != "" {
+			var err error
+			creds, err = newAssumeRoleCredentials(c)
+			if err != nil {
+				return nil, err
+			}
+		} else if c.useIAM {
+			creds = newIAMCredentials(c)
 		} else {
 			creds = credentials.NewStaticV4(c.accessKeyID, c.secretAccessKeyID, "")
 		}
@@ -226,6 +236,23 @@ func (mcm *MinioChunkManager) MultiWrite(ctx context.Context, kvs map[string][]b
 	return el
 }
 
+// Copy copies the object on the server side, the content isn't transferred through the client.
+func (mcm *MinioChunkManager) Copy(ctx context.Context, srcPath string, dstPath string) error {
+	_, err := mcm.copyMinioObject(ctx,
+		minio.CopyDestOptions{Bucket: mcm.bucketName, Object: dstPath},
+		minio.CopySrcOptions{Bucket: mcm.bucketName, Object: srcPath})
+	if err != nil {
+		log.Warn("failed to copy object", zap.String("bucket", mcm.bucketName),
+			zap.String("src", srcPath), zap.String("dst", dstPath), zap.Error(err))
+		errResponse := minio.ToErrorResponse(err)
+		if errResponse.Code == "NoSuchKey" {
+			return WrapErrNoSuchKey(srcPath)
+		}
+		return err
+	}
+	return nil
+}
+
 // Exist checks whether chunk is saved to minio storage.
 func (mcm *MinioChunkManager) Exist(ctx context.Context, filePath string) (bool, error) {
 	_, err := mcm.statMinioObject(ctx, mcm.bucketName, filePath, minio.StatObjectOptions{})
@@ -501,6 +528,22 @@ func (mcm *MinioChunkManager) putMinioObject(ctx context.Context, bucketName, ob
 	return info, err
 }
 
+func (mcm *MinioChunkManager) copyMinioObject(ctx context.Context, dst minio.CopyDestOptions,
+	src minio.CopySrcOptions) (minio.UploadInfo, error) {
+	start := timerecord.NewTimeRecorder("copyMinioObject")
+
+	info, err := mcm.Client.CopyObject(ctx, dst, src)
+	metrics.PersistentDataOpCounter.WithLabelValues(metrics.DataPutLabel, metrics.TotalLabel).Inc()
+	if err == nil {
+		metrics.PersistentDataRequestLatency.WithLabelValues(metrics.DataPutLabel).Observe(float64(start.ElapseSpan().Milliseconds()))
+		metrics.PersistentDataOpCounter.WithLabelValues(metrics.DataPutLabel, metrics.SuccessLabel).Inc()
+	} else {
+		metrics.PersistentDataOpCounter.WithLabelValues(metrics.DataPutLabel, metrics.FailLabel).Inc()
+	}
+
+	return info, err
+}
+
 func (mcm *MinioChunkManager) statMinioObject(ctx context.Context, bucketName, objectName string,
 	opts minio.StatObjectOptions) (minio.ObjectInfo, error) {
 	start := timerecord.NewTimeRecorder("statMinioObject")
@@ -531,6 +531,37 @@ func TestMinIOCM(t *testing.T) {
 		assert.Error(t, err)
 		assert.True(t, errors.Is(err, ErrNoSuchKey))
 	})
+
+	t.Run("test Copy", func(t *testing.T) {
+		testCopyRoot := path.Join(testMinIOKVRoot, "copy")
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		testCM, err := newMinIOChunkManager(ctx, testBucket, testCopyRoot)
+		require.NoError(t, err)
+		defer testCM.RemoveWithPrefix(ctx, testCopyRoot)
+
+		src := path.Join(testCopyRoot, "src")
+		dst := path.Join(testCopyRoot, "dst")
+		err = testCM.Write(ctx, src, []byte("value"))
+		require.NoError(t, err)
+
+		err = CopyFile(ctx, testCM, src, dst)
+		assert.NoError(t, err)
+		value, err := testCM.Read(ctx, dst)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("value"), value)
+
+		// removing the source doesn't affect the copy
+		err = testCM.Remove(ctx, src)
+		assert.NoError(t, err)
+		value, err = testCM.Read(ctx, dst)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("value"), value)
+
+		err = CopyFile(ctx, testCM, src, dst)
+		assert.True(t, errors.Is(err, ErrNoSuchKey))
+	})
 }
 
 func TestMinioChunkManager_normalizeRootPath(t *testing.T) {
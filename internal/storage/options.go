@@ -1,5 +1,7 @@
 package storage
 
+import "time"
+
 // Option for setting params used by chunk manager client.
 type config struct {
 	address           string
@@ -12,6 +14,11 @@ type config struct {
 	useIAM            bool
 	cloudProvider     string
 	iamEndpoint       string
+	stsEndpoint       string
+	roleARN           string
+	sessionDuration   time.Duration
+	credentialRole    string
+	encryptionKey     string
 }
 
 func newDefaultConfig() *config {
@@ -79,3 +86,37 @@ func IAMEndpoint(iamEndpoint string) Option {
 		c.iamEndpoint = iamEndpoint
 	}
 }
+
+func STSEndpoint(stsEndpoint string) Option {
+	return func(c *config) {
+		c.stsEndpoint = stsEndpoint
+	}
+}
+
+// RoleARN makes the chunk manager assume the role through STS, the credentials are refreshed automatically
+func RoleARN(roleARN string) Option {
+	return func(c *config) {
+		c.roleARN = roleARN
+	}
+}
+
+func SessionDuration(sessionDuration time.Duration) Option {
+	return func(c *config) {
+		c.sessionDuration = sessionDuration
+	}
+}
+
+// CredentialRole is the node role using the credentials, which labels the credential metrics
+func CredentialRole(role string) Option {
+	return func(c *config) {
+		c.credentialRole = role
+	}
+}
+
+// EncryptionMasterKey makes the chunk manager encrypt the binlogs of the collections with encryption enabled,
+// see EncryptedChunkManager
+func EncryptionMasterKey(key string) Option {
+	return func(c *config) {
+		c.encryptionKey = key
+	}
+}
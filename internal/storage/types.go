@@ -75,3 +75,10 @@ type ChunkManager interface {
 	// RemoveWithPrefix remove files with same @prefix.
 	RemoveWithPrefix(ctx context.Context, prefix string) error
 }
+
+// ObjectCopier is implemented by the ChunkManager which could copy files inside the storage,
+// without transferring the content through the client.
+type ObjectCopier interface {
+	// Copy copies @srcPath to @dstPath.
+	Copy(ctx context.Context, srcPath string, dstPath string) error
+}
@@ -43,6 +43,14 @@ type Limiter interface {
 	Check(collectionID int64, rt internalpb.RateType, n int) commonpb.ErrorCode
 }
 
+// Federation routes the requests of the databases or collections mapped to other Milvus clusters.
+type Federation interface {
+	// Intercept serves the request by the target cluster if the request is routed to one,
+	// otherwise by the handler of this cluster.
+	Intercept(ctx context.Context, fullMethod string, req interface{},
+		handler func(ctx context.Context, req interface{}) (interface{}, error)) (interface{}, error)
+}
+
 // Component is the interface all services implement
 type Component interface {
 	Init() error
@@ -83,6 +91,8 @@ type DataNode interface {
 	GetCompactionState(ctx context.Context, req *datapb.CompactionStateRequest) (*datapb.CompactionStateResponse, error)
 	// SyncSegments is called by DataCoord, to sync the segments meta when complete compaction
 	SyncSegments(ctx context.Context, req *datapb.SyncSegmentsRequest) (*commonpb.Status, error)
+	// CancelCompactionPlans is called by DataCoord, to interrupt the executing compaction plans
+	CancelCompactionPlans(ctx context.Context, req *datapb.CancelCompactionPlansRequest) (*commonpb.Status, error)
 
 	// Import data files(json, numpy, etc.) on MinIO/S3 storage, read and parse them into sealed segments
 	//
@@ -99,6 +109,12 @@ type DataNode interface {
 
 	// AddImportSegment puts the given import segment to current DataNode's flow graph.
 	AddImportSegment(ctx context.Context, req *datapb.AddImportSegmentRequest) (*datapb.AddImportSegmentResponse, error)
+
+	// DumpDispatcherPositions returns the latest positions dispatched to the vchannels, admin only.
+	DumpDispatcherPositions(ctx context.Context, req *internalpb.DumpDispatcherPositionsRequest) (*internalpb.DumpDispatcherPositionsResponse, error)
+	// SeekDispatcher makes the vchannel consume from the given position, admin only.
+	// It's used to recover the vchannel whose checkpoint is wrong, the data may be replayed or skipped.
+	SeekDispatcher(ctx context.Context, req *internalpb.SeekDispatcherRequest) (*commonpb.Status, error)
 }
 
 // DataNodeComponent is used by grpc server of DataNode
@@ -292,6 +308,12 @@ type DataCoord interface {
 	GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 	// ManualCompaction triggers a compaction for a collection
 	ManualCompaction(ctx context.Context, req *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error)
+	// ManualCompactionWithMode triggers a compaction for a collection on the segments selected by the mode
+	ManualCompactionWithMode(ctx context.Context, req *datapb.ManualCompactionWithModeRequest) (*datapb.ManualCompactionWithModeResponse, error)
+	// GetCompactionProgress gets the progress of a compaction by the plans finished
+	GetCompactionProgress(ctx context.Context, req *datapb.GetCompactionProgressRequest) (*datapb.GetCompactionProgressResponse, error)
+	// CancelCompaction cancels the unfinished plans of a compaction
+	CancelCompaction(ctx context.Context, req *datapb.CancelCompactionRequest) (*commonpb.Status, error)
 	// GetCompactionState gets the state of a compaction
 	GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error)
 	// GetCompactionStateWithPlans get the state of requested plan id
@@ -350,6 +372,86 @@ type DataCoord interface {
 
 	GcConfirm(ctx context.Context, request *datapb.GcConfirmRequest) (*datapb.GcConfirmResponse, error)
 
+	// GcDryRun reports the files and segments to be recycled by the garbage collection without deleting anything.
+	GcDryRun(ctx context.Context, req *datapb.GcDryRunRequest) (*datapb.GcDryRunResponse, error)
+
+	// PauseGC pauses the garbage collector, and returns after the in-flight removal stops.
+	PauseGC(ctx context.Context, req *datapb.PauseGCRequest) (*commonpb.Status, error)
+
+	// ResumeGC resumes the garbage collector paused by PauseGC.
+	ResumeGC(ctx context.Context, req *datapb.ResumeGCRequest) (*commonpb.Status, error)
+
+	// GetGCStatus returns the current phase, the pending files and the run statistics of the garbage collector.
+	GetGCStatus(ctx context.Context, req *datapb.GetGCStatusRequest) (*datapb.GetGCStatusResponse, error)
+
+	// DropSegmentsByTimeRange drops the flushed segments of a partition whose insert timestamps are within the time range.
+	DropSegmentsByTimeRange(ctx context.Context, req *datapb.DropSegmentsByTimeRangeRequest) (*datapb.DropSegmentsByTimeRangeResponse, error)
+
+	// GetTopologySnapshot returns the JSON serialized snapshot of the DataNodes, their channels and the buffer channels.
+	GetTopologySnapshot(ctx context.Context, req *datapb.GetTopologySnapshotRequest) (*datapb.GetTopologySnapshotResponse, error)
+
+	// RetryQuarantinedChannels releases the quarantined channels to be watched again.
+	RetryQuarantinedChannels(ctx context.Context, req *datapb.RetryQuarantinedChannelsRequest) (*datapb.RetryQuarantinedChannelsResponse, error)
+
+	// MigrateChannelWatchInfos rewrites the legacy channel watch infos into the newest schema.
+	MigrateChannelWatchInfos(ctx context.Context, req *datapb.MigrateChannelWatchInfosRequest) (*datapb.MigrateChannelWatchInfosResponse, error)
+
+	// ReCollectSegmentStats asks the DataNodes to resend the segment stats, and returns the result of each DataNode.
+	ReCollectSegmentStats(ctx context.Context, req *datapb.ReCollectSegmentStatsRequest) (*datapb.ReCollectSegmentStatsResponse, error)
+
+	// GetAuditEvents returns the events recorded in the audit log of DataCoord.
+	GetAuditEvents(ctx context.Context, req *datapb.GetAuditEventsRequest) (*datapb.GetAuditEventsResponse, error)
+
+	// GetShardWriteStats returns the write rates of the vchannels of the collection, collected from the DataNodes.
+	GetShardWriteStats(ctx context.Context, req *datapb.GetShardWriteStatsRequest) (*datapb.GetShardWriteStatsResponse, error)
+
+	// FlushAndSeal flushes all the channels of the collection up to the barrier timestamp,
+	// and returns the flushed segments as a consistent cut of the collection.
+	FlushAndSeal(ctx context.Context, req *datapb.FlushAndSealRequest) (*datapb.FlushAndSealResponse, error)
+
+	// GetStorageConsistencyReport returns the binlogs missing in the object storage and the orphaned ones
+	// found by the last reconciliation between the segment meta and the object storage.
+	GetStorageConsistencyReport(ctx context.Context, req *datapb.GetStorageConsistencyReportRequest) (*datapb.GetStorageConsistencyReportResponse, error)
+
+	// GetChannelWatchHistory returns the recent watch state transitions of a channel.
+	GetChannelWatchHistory(ctx context.Context, req *datapb.GetChannelWatchHistoryRequest) (*datapb.GetChannelWatchHistoryResponse, error)
+
+	// GetChannelWatchStates returns the watch states of the channels of a collection.
+	GetChannelWatchStates(ctx context.Context, req *datapb.GetChannelWatchStatesRequest) (*datapb.GetChannelWatchStatesResponse, error)
+
+	// SetNodeConfigs sets the config overrides scoped to a node.
+	SetNodeConfigs(ctx context.Context, req *datapb.SetNodeConfigsRequest) (*commonpb.Status, error)
+
+	// ListNodeConfigs lists the config overrides scoped to a node.
+	ListNodeConfigs(ctx context.Context, req *datapb.ListNodeConfigsRequest) (*datapb.ListNodeConfigsResponse, error)
+
+	// ClearNodeConfigs clears the config overrides scoped to a node.
+	ClearNodeConfigs(ctx context.Context, req *datapb.ClearNodeConfigsRequest) (*commonpb.Status, error)
+
+	// SetMaintenancePolicy pauses or schedules compaction and gc globally or of a collection.
+	SetMaintenancePolicy(ctx context.Context, req *datapb.SetMaintenancePolicyRequest) (*commonpb.Status, error)
+
+	// ListMaintenancePolicies lists the maintenance policies of compaction and gc.
+	ListMaintenancePolicies(ctx context.Context, req *datapb.ListMaintenancePoliciesRequest) (*datapb.ListMaintenancePoliciesResponse, error)
+
+	// CordonDataNode stops assigning new channels to the DataNode.
+	CordonDataNode(ctx context.Context, req *datapb.CordonDataNodeRequest) (*commonpb.Status, error)
+
+	// DrainDataNode cordons the DataNode and migrates its channels to other DataNodes gradually in background.
+	DrainDataNode(ctx context.Context, req *datapb.DrainDataNodeRequest) (*commonpb.Status, error)
+
+	// UncordonDataNode makes the DataNode schedulable again and stops draining it.
+	UncordonDataNode(ctx context.Context, req *datapb.UncordonDataNodeRequest) (*commonpb.Status, error)
+
+	// GetDataNodeDrainState returns the progress of draining the DataNode.
+	GetDataNodeDrainState(ctx context.Context, req *datapb.GetDataNodeDrainStateRequest) (*datapb.GetDataNodeDrainStateResponse, error)
+
+	// RotateEncryptionKey switches an encrypted collection to a new key, existing data is re-encrypted by compaction.
+	RotateEncryptionKey(ctx context.Context, req *datapb.RotateEncryptionKeyRequest) (*datapb.RotateEncryptionKeyResponse, error)
+
+	// GetEncryptionStatus returns the percentage of the data of a collection encrypted with the current key.
+	GetEncryptionStatus(ctx context.Context, req *datapb.GetEncryptionStatusRequest) (*datapb.GetEncryptionStatusResponse, error)
+
 	// CreateIndex create an index on collection.
 	// Index building is asynchronous, so when an index building request comes, an IndexID is assigned to the task and
 	// will get all flushed segments from DataCoord and record tasks with these segments. The background process
@@ -380,6 +482,26 @@ type DataCoord interface {
 	// divided into many segments, and each segment corresponds to an IndexBuildID. IndexCoord uses IndexBuildID to record
 	// index tasks. Therefore, when DropIndex is called, delete all tasks corresponding to IndexBuildID corresponding to IndexID.
 	DropIndex(ctx context.Context, req *indexpb.DropIndexRequest) (*commonpb.Status, error)
+	// AlterIndex alters the index params which only matter when building the index. The segment indexes are rebuilt
+	// in the background alongside the serving ones, and each of them replaces the serving one once finished.
+	AlterIndex(ctx context.Context, req *indexpb.AlterIndexRequest) (*commonpb.Status, error)
+
+	// CloneSegments clones the segments of a collection into another one with the same schema in background,
+	// the growing segments are sealed first and the binlogs are copied inside the object storage.
+	CloneSegments(ctx context.Context, req *datapb.CloneSegmentsRequest) (*commonpb.Status, error)
+
+	// GetCloneSegmentsState returns the progress of cloning the segments into the target collection.
+	GetCloneSegmentsState(ctx context.Context, req *datapb.GetCloneSegmentsStateRequest) (*datapb.GetCloneSegmentsStateResponse, error)
+}
+
+// DataCoordReplication is the interface DataCoord of a standby cluster implements,
+// it receives the flushed segments shipped from the primary cluster.
+type DataCoordReplication interface {
+	// ReplicateBinlog writes a binlog of the primary cluster into the staging area.
+	ReplicateBinlog(ctx context.Context, req *datapb.ReplicateBinlogRequest) (*commonpb.Status, error)
+	// ReplicateSegment adds the replica of a segment whose binlogs are replicated,
+	// or appends the new deltalogs to the replica if the segment is replicated already.
+	ReplicateSegment(ctx context.Context, req *datapb.ReplicateSegmentRequest) (*datapb.ReplicateSegmentResponse, error)
 }
 
 // DataCoordComponent defines the interface of DataCoord component.
@@ -387,6 +509,7 @@ type DataCoord interface {
 //go:generate mockery --name=DataCoordComponent --structname=MockDataCoord --output=../mocks  --filename=mock_datacoord.go --with-expecter
 type DataCoordComponent interface {
 	DataCoord
+	DataCoordReplication
 
 	SetAddress(address string)
 	// SetEtcdClient set EtcdClient for DataCoord
@@ -809,6 +932,18 @@ type RootCoord interface {
 	CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error)
 
 	RenameCollection(ctx context.Context, req *milvuspb.RenameCollectionRequest) (*commonpb.Status, error)
+
+	// CloneCollection creates a new collection with the schema, partitions and indexes of the source collection,
+	// and clones the segments of the source collection into it in background, the data isn't re-ingested.
+	CloneCollection(ctx context.Context, req *rootcoordpb.CloneCollectionRequest) (*commonpb.Status, error)
+
+	// GetCapacityReport reports the memory usage of each resource group, the disk usage of the data nodes and index nodes,
+	// the backlog of the channels and the days before they are full, projected by the growth of the recent days.
+	GetCapacityReport(ctx context.Context, req *rootcoordpb.GetCapacityReportRequest) (*rootcoordpb.GetCapacityReportResponse, error)
+
+	// GetCollectionStatsHistory returns the statistics samples of the collection within the time range,
+	// including the QPS, the average latency, the rows written and the bytes stored.
+	GetCollectionStatsHistory(ctx context.Context, req *rootcoordpb.GetCollectionStatsHistoryRequest) (*rootcoordpb.GetCollectionStatsHistoryResponse, error)
 }
 
 // RootCoordComponent is used by grpc server of RootCoord
@@ -922,6 +1057,9 @@ type ProxyComponent interface {
 	// GetRateLimiter returns the rateLimiter in Proxy
 	GetRateLimiter() (Limiter, error)
 
+	// GetFederation returns the federation router in Proxy
+	GetFederation() Federation
+
 	// UpdateStateCode updates state code for Proxy
 	//  `stateCode` is current statement of this proxy node, indicating whether it's healthy.
 	UpdateStateCode(stateCode commonpb.StateCode)
@@ -1410,6 +1548,22 @@ type ProxyComponent interface {
 	// RenameCollection rename collection from  old name to new name
 	RenameCollection(ctx context.Context, req *milvuspb.RenameCollectionRequest) (*commonpb.Status, error)
 
+	// CloneCollection clones a collection into a new one with its data, for snapshotting the collection,
+	// the segments are cloned in background, see GetCloneCollectionState.
+	CloneCollection(ctx context.Context, req *rootcoordpb.CloneCollectionRequest) (*commonpb.Status, error)
+
+	// GetCloneCollectionState returns the progress of cloning the segments into the new collection.
+	GetCloneCollectionState(ctx context.Context, req *datapb.GetCloneSegmentsStateRequest) (*datapb.GetCloneSegmentsStateResponse, error)
+
+	// EvaluateIndex starts to evaluate a candidate index of the vector field against the current one in background,
+	// the captured search requests are replayed on the sampled segments to compare the recall and latency.
+	EvaluateIndex(ctx context.Context, req *proxypb.EvaluateIndexRequest) (*proxypb.EvaluateIndexResponse, error)
+	// GetIndexEvaluation returns the progress and the result of the index evaluation.
+	GetIndexEvaluation(ctx context.Context, req *proxypb.GetIndexEvaluationRequest) (*proxypb.GetIndexEvaluationResponse, error)
+	// AlterIndex alters the index params which only matter when building the index, the segment indexes
+	// are rebuilt in background and the QueryNodes load the rebuilt ones.
+	AlterIndex(ctx context.Context, req *proxypb.AlterIndexRequest) (*commonpb.Status, error)
+
 	CreateResourceGroup(ctx context.Context, req *milvuspb.CreateResourceGroupRequest) (*commonpb.Status, error)
 	DropResourceGroup(ctx context.Context, req *milvuspb.DropResourceGroupRequest) (*commonpb.Status, error)
 	TransferNode(ctx context.Context, req *milvuspb.TransferNodeRequest) (*commonpb.Status, error)
@@ -1457,6 +1611,11 @@ type QueryNode interface {
 	GetDataDistribution(context.Context, *querypb.GetDataDistributionRequest) (*querypb.GetDataDistributionResponse, error)
 	SyncDistribution(context.Context, *querypb.SyncDistributionRequest) (*commonpb.Status, error)
 	Delete(context.Context, *querypb.DeleteRequest) (*commonpb.Status, error)
+
+	// DumpDispatcherPositions returns the latest positions dispatched to the vchannels, admin only.
+	DumpDispatcherPositions(ctx context.Context, req *internalpb.DumpDispatcherPositionsRequest) (*internalpb.DumpDispatcherPositionsResponse, error)
+	// SeekDispatcher makes the vchannel consume from the given position, admin only.
+	SeekDispatcher(ctx context.Context, req *internalpb.SeekDispatcherRequest) (*commonpb.Status, error)
 }
 
 // QueryNodeComponent is used by grpc server of QueryNode
@@ -1474,6 +1633,9 @@ type QueryNodeComponent interface {
 
 	// SetEtcdClient set etcd client for QueryNode
 	SetEtcdClient(etcdClient *clientv3.Client)
+
+	// SetRootCoord set RootCoord for QueryNode, which verifies the credentials of the admin APIs
+	SetRootCoord(rootCoord RootCoord) error
 }
 
 // QueryCoord is the interface `querycoord` package implements
@@ -1506,6 +1668,33 @@ type QueryCoord interface {
 	TransferReplica(ctx context.Context, req *querypb.TransferReplicaRequest) (*commonpb.Status, error)
 	ListResourceGroups(ctx context.Context, req *milvuspb.ListResourceGroupsRequest) (*milvuspb.ListResourceGroupsResponse, error)
 	DescribeResourceGroup(ctx context.Context, req *querypb.DescribeResourceGroupRequest) (*querypb.DescribeResourceGroupResponse, error)
+
+	// ExtendLoadTimeout extends the load timeout of a loading collection,
+	// so that a huge collection loading slowly is not canceled.
+	ExtendLoadTimeout(ctx context.Context, req *querypb.ExtendLoadTimeoutRequest) (*commonpb.Status, error)
+
+	// ListCheckers lists the checkers and whether they are activated.
+	ListCheckers(ctx context.Context, req *querypb.ListCheckersRequest) (*querypb.ListCheckersResponse, error)
+	// ActivateChecker activates the checker, the activation is persisted in meta.
+	ActivateChecker(ctx context.Context, req *querypb.ActivateCheckerRequest) (*commonpb.Status, error)
+	// DeactivateChecker deactivates the checker, which generates no tasks until activated again,
+	// e.g. freeze the balance during the maintenance. The deactivation is persisted in meta.
+	DeactivateChecker(ctx context.Context, req *querypb.DeactivateCheckerRequest) (*commonpb.Status, error)
+	// DryRunCheckers runs the checkers without submitting the generated tasks,
+	// and returns the tasks for the operators to preview the actions to converge the cluster.
+	DryRunCheckers(ctx context.Context, req *querypb.DryRunCheckersRequest) (*querypb.DryRunCheckersResponse, error)
+	// TriggerBalance runs the balance at once for the given collection and node,
+	// submits the generated tasks and returns them with the task IDs, for the on-demand rebalancing.
+	TriggerBalance(ctx context.Context, req *querypb.TriggerBalanceRequest) (*querypb.TriggerBalanceResponse, error)
+	// SuspendCollectionBalance suspends or resumes the balance of the collection, the suspension is persisted in meta,
+	// so the operators could pin the placement of a latency-critical collection while still balancing others.
+	SuspendCollectionBalance(ctx context.Context, req *querypb.SuspendCollectionBalanceRequest) (*commonpb.Status, error)
+	// ListDivergedLeaders lists the shard leaders whose views have been out of sync
+	// with the distribution or current target longer than the threshold.
+	ListDivergedLeaders(ctx context.Context, req *querypb.ListDivergedLeadersRequest) (*querypb.ListDivergedLeadersResponse, error)
+	// NotifyCompaction is called by DataCoord after a compaction is committed,
+	// QueryCoord refreshes the next target to load the compacted segment before the old ones are released.
+	NotifyCompaction(ctx context.Context, req *querypb.NotifyCompactionRequest) (*commonpb.Status, error)
 }
 
 // QueryCoordComponent is used by grpc server of QueryCoord
@@ -1516,6 +1705,10 @@ type QueryCoordComponent interface {
 
 	SetAddress(address string)
 
+	// WatchLoadProgress streams the load progress events of a collection or partition load job,
+	// it's served by QueryCoord only, the grpc client opens the stream by its own method.
+	WatchLoadProgress(req *querypb.WatchLoadProgressRequest, stream querypb.QueryCoord_WatchLoadProgressServer) error
+
 	// SetEtcdClient set etcd client for QueryCoord
 	SetEtcdClient(etcdClient *clientv3.Client)
 
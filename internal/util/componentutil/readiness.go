@@ -0,0 +1,143 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package componentutil
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/atomic"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/retry"
+)
+
+// ReadinessPhaseKey is the key of the readiness phase in the extra info of the component states.
+const ReadinessPhaseKey = "readiness_phase"
+
+// ReadinessPhase is the startup progress of a coordinator, the phases are reached in order.
+type ReadinessPhase int32
+
+const (
+	PhaseNotReady ReadinessPhase = iota
+	// PhaseMetaRecovered means the meta is recovered from the meta store
+	PhaseMetaRecovered
+	// PhaseSessionsWatched means the sessions of the nodes are listed and watched
+	PhaseSessionsWatched
+	// PhaseCheckersStarted means the schedulers, checkers and observers are started
+	PhaseCheckersStarted
+	// PhaseTargetsRecovered means the current targets of the loaded collections are recovered
+	PhaseTargetsRecovered
+)
+
+var readinessPhaseNames = []string{
+	PhaseNotReady:         "NotReady",
+	PhaseMetaRecovered:    "MetaRecovered",
+	PhaseSessionsWatched:  "SessionsWatched",
+	PhaseCheckersStarted:  "CheckersStarted",
+	PhaseTargetsRecovered: "TargetsRecovered",
+}
+
+func (p ReadinessPhase) String() string {
+	if p < 0 || int(p) >= len(readinessPhaseNames) {
+		return fmt.Sprintf("ReadinessPhase(%d)", p)
+	}
+	return readinessPhaseNames[p]
+}
+
+// ParseReadinessPhase parses the readiness phase from its name.
+func ParseReadinessPhase(name string) (ReadinessPhase, error) {
+	for phase, phaseName := range readinessPhaseNames {
+		if phaseName == name {
+			return ReadinessPhase(phase), nil
+		}
+	}
+	return PhaseNotReady, fmt.Errorf("unknown readiness phase %s", name)
+}
+
+// Readiness tracks the readiness phase of a component, the zero value is in PhaseNotReady.
+type Readiness struct {
+	phase atomic.Int32
+}
+
+// Advance moves the readiness forward to the phase, it never moves backward.
+func (r *Readiness) Advance(phase ReadinessPhase) {
+	for {
+		current := r.phase.Load()
+		if current >= int32(phase) || r.phase.CompareAndSwap(current, int32(phase)) {
+			return
+		}
+	}
+}
+
+// Reset moves the readiness back to PhaseNotReady.
+func (r *Readiness) Reset() {
+	r.phase.Store(int32(PhaseNotReady))
+}
+
+// Phase returns the current readiness phase.
+func (r *Readiness) Phase() ReadinessPhase {
+	return ReadinessPhase(r.phase.Load())
+}
+
+// KeyValuePair returns the readiness phase to report in the extra info of the component states.
+func (r *Readiness) KeyValuePair() *commonpb.KeyValuePair {
+	return &commonpb.KeyValuePair{Key: ReadinessPhaseKey, Value: r.Phase().String()}
+}
+
+// GetReadinessPhase returns the readiness phase reported by the component states,
+// the second return value is false if the component doesn't report it.
+func GetReadinessPhase(states *milvuspb.ComponentStates) (ReadinessPhase, bool) {
+	name, err := funcutil.GetAttrByKeyFromRepeatedKV(ReadinessPhaseKey, states.GetState().GetExtraInfo())
+	if err != nil {
+		return PhaseNotReady, false
+	}
+	phase, err := ParseReadinessPhase(name)
+	if err != nil {
+		return PhaseNotReady, false
+	}
+	return phase, true
+}
+
+// WaitForComponentPhase waits for the component to be healthy and reach the readiness phase,
+// the component not reporting its readiness phase is regarded ready once it's healthy.
+func WaitForComponentPhase(ctx context.Context, service types.Component, serviceName string, phase ReadinessPhase, attempts uint, sleep time.Duration) error {
+	checkFunc := func() error {
+		resp, err := service.GetComponentStates(ctx)
+		if err != nil {
+			return err
+		}
+		if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+			return errors.New(resp.GetStatus().GetReason())
+		}
+		if resp.GetState().GetStateCode() != commonpb.StateCode_Healthy {
+			return fmt.Errorf("WaitForComponentPhase, not meet, %s current state: %s",
+				serviceName, resp.GetState().GetStateCode().String())
+		}
+		if current, ok := GetReadinessPhase(resp); ok && current < phase {
+			return fmt.Errorf("WaitForComponentPhase, not meet, %s current phase: %s, expected phase: %s",
+				serviceName, current.String(), phase.String())
+		}
+		return nil
+	}
+	return retry.Do(ctx, checkFunc, retry.Attempts(attempts), retry.Sleep(sleep))
+}
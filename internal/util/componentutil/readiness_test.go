@@ -0,0 +1,83 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package componentutil
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+)
+
+func TestReadiness(t *testing.T) {
+	r := &Readiness{}
+	assert.Equal(t, PhaseNotReady, r.Phase())
+
+	r.Advance(PhaseSessionsWatched)
+	assert.Equal(t, PhaseSessionsWatched, r.Phase())
+	// never moves backward
+	r.Advance(PhaseMetaRecovered)
+	assert.Equal(t, PhaseSessionsWatched, r.Phase())
+
+	kv := r.KeyValuePair()
+	assert.Equal(t, ReadinessPhaseKey, kv.GetKey())
+	assert.Equal(t, "SessionsWatched", kv.GetValue())
+
+	r.Reset()
+	assert.Equal(t, PhaseNotReady, r.Phase())
+
+	for _, phase := range []ReadinessPhase{PhaseNotReady, PhaseMetaRecovered, PhaseSessionsWatched, PhaseCheckersStarted, PhaseTargetsRecovered} {
+		parsed, err := ParseReadinessPhase(phase.String())
+		assert.NoError(t, err)
+		assert.Equal(t, phase, parsed)
+	}
+	_, err := ParseReadinessPhase("unknown")
+	assert.Error(t, err)
+	assert.Equal(t, "ReadinessPhase(10)", ReadinessPhase(10).String())
+}
+
+func Test_WaitForComponentPhase(t *testing.T) {
+	r := &Readiness{}
+	r.Advance(PhaseCheckersStarted)
+
+	mc := buildMockComponent(commonpb.StateCode_Healthy)
+	mc.compState.State.ExtraInfo = []*commonpb.KeyValuePair{r.KeyValuePair()}
+	phase, ok := GetReadinessPhase(mc.compState)
+	assert.True(t, ok)
+	assert.Equal(t, PhaseCheckersStarted, phase)
+
+	err := WaitForComponentPhase(context.TODO(), mc, "mockService", PhaseCheckersStarted, 1, 10*time.Millisecond)
+	assert.NoError(t, err)
+	err = WaitForComponentPhase(context.TODO(), mc, "mockService", PhaseTargetsRecovered, 1, 10*time.Millisecond)
+	assert.Error(t, err)
+
+	// not healthy
+	mc = buildMockComponent(commonpb.StateCode_Initializing)
+	mc.compState.State.ExtraInfo = []*commonpb.KeyValuePair{r.KeyValuePair()}
+	err = WaitForComponentPhase(context.TODO(), mc, "mockService", PhaseMetaRecovered, 1, 10*time.Millisecond)
+	assert.Error(t, err)
+
+	// the component not reporting the phase is ready once it's healthy
+	mc = buildMockComponent(commonpb.StateCode_Healthy)
+	_, ok = GetReadinessPhase(mc.compState)
+	assert.False(t, ok)
+	err = WaitForComponentPhase(context.TODO(), mc, "mockService", PhaseTargetsRecovered, 1, 10*time.Millisecond)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,107 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configutil
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/pkg/config"
+)
+
+// NodeConfigManager persists the config overrides scoped to a single node.
+// The overrides are picked up by the remote config source of the node,
+// and take precedence over the cluster wide configs.
+type NodeConfigManager struct {
+	kv kv.TxnKV // kv rooted at the etcd root path shared with the remote config source.
+}
+
+// NewNodeConfigManager creates a NodeConfigManager with the kv rooted at the etcd root path.
+func NewNodeConfigManager(kv kv.TxnKV) *NodeConfigManager {
+	return &NodeConfigManager{kv: kv}
+}
+
+func nodeConfigPrefix(nodeID int64) string {
+	return config.NodeConfigPrefix("", nodeID)
+}
+
+// toPath converts a config key like `dataNode.compaction.concurrency` to the etcd layout `dataNode/compaction/concurrency`.
+func toPath(key string) string {
+	return strings.ReplaceAll(key, ".", "/")
+}
+
+// toKey converts an etcd path back to the config key.
+func toKey(p string) string {
+	return strings.ReplaceAll(p, "/", ".")
+}
+
+// Set saves the config overrides of the node.
+func (m *NodeConfigManager) Set(nodeID int64, configs map[string]string) error {
+	prefix := nodeConfigPrefix(nodeID)
+	kvs := make(map[string]string, len(configs))
+	for key, value := range configs {
+		if key == "" {
+			return fmt.Errorf("empty config key for node %d", nodeID)
+		}
+		kvs[path.Join(prefix, toPath(key))] = value
+	}
+	return m.kv.MultiSave(kvs)
+}
+
+// List returns all the config overrides of the node.
+func (m *NodeConfigManager) List(nodeID int64) (map[string]string, error) {
+	prefix := nodeConfigPrefix(nodeID)
+	keys, values, err := m.kv.LoadWithPrefix(prefix + "/")
+	if err != nil {
+		return nil, err
+	}
+	configs := make(map[string]string, len(keys))
+	for i, key := range keys {
+		// the kv may return keys either relative to or including its root path.
+		idx := strings.Index(key, prefix+"/")
+		if idx < 0 {
+			continue
+		}
+		configs[toKey(key[idx+len(prefix)+1:])] = values[i]
+	}
+	return configs, nil
+}
+
+// Clear removes the given config overrides of the node, removes all of them if no key provided.
+func (m *NodeConfigManager) Clear(nodeID int64, keys ...string) error {
+	if len(keys) == 0 {
+		// not removing with prefix, which would also remove the configs of node ids sharing the same prefix.
+		configs, err := m.List(nodeID)
+		if err != nil {
+			return err
+		}
+		if len(configs) == 0 {
+			return nil
+		}
+		for key := range configs {
+			keys = append(keys, key)
+		}
+	}
+	prefix := nodeConfigPrefix(nodeID)
+	paths := make([]string, 0, len(keys))
+	for _, key := range keys {
+		paths = append(paths, path.Join(prefix, toPath(key)))
+	}
+	return m.kv.MultiRemove(paths)
+}
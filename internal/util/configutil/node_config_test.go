@@ -0,0 +1,68 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+)
+
+func TestNodeConfigManager(t *testing.T) {
+	kv := memkv.NewMemoryKV()
+	m := NewNodeConfigManager(kv)
+
+	err := m.Set(1, map[string]string{
+		"dataNode.compaction.concurrency": "1",
+		"dataNode.dataSync.maxParallel":   "2",
+	})
+	assert.NoError(t, err)
+	err = m.Set(11, map[string]string{"dataNode.compaction.concurrency": "4"})
+	assert.NoError(t, err)
+	err = m.Set(1, map[string]string{"": "1"})
+	assert.Error(t, err)
+
+	v, err := kv.Load("node_config/1/dataNode/compaction/concurrency")
+	assert.NoError(t, err)
+	assert.Equal(t, "1", v)
+
+	configs, err := m.List(1)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"dataNode.compaction.concurrency": "1",
+		"dataNode.dataSync.maxParallel":   "2",
+	}, configs)
+
+	err = m.Clear(1, "dataNode.dataSync.maxParallel")
+	assert.NoError(t, err)
+	configs, err = m.List(1)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"dataNode.compaction.concurrency": "1"}, configs)
+
+	err = m.Clear(1)
+	assert.NoError(t, err)
+	configs, err = m.List(1)
+	assert.NoError(t, err)
+	assert.Empty(t, configs)
+
+	// overrides of other nodes are untouched
+	configs, err = m.List(11)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"dataNode.compaction.concurrency": "4"}, configs)
+}
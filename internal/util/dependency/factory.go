@@ -2,12 +2,14 @@ package dependency
 
 import (
 	"context"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	smsgstream "github.com/milvus-io/milvus/internal/mq/msgstream"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
+	"github.com/milvus-io/milvus/pkg/util/etcd"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"go.uber.org/zap"
 )
@@ -20,6 +22,8 @@ const (
 	mqTypePulsar  = "pulsar"
 )
 
+const migrationRecorderTimeout = 10 * time.Second
+
 type mqEnable struct {
 	Rocksmq bool
 	Natsmq  bool
@@ -82,18 +86,53 @@ func (f *DefaultFactory) initMQ(standalone bool, params *paramtable.ComponentPar
 	mqType := mustSelectMQType(standalone, params.MQCfg.Type.GetValue(), mqEnable{params.RocksmqEnable(), params.NatsmqEnable(), params.PulsarEnable(), params.KafkaEnable()})
 	log.Info("try to init mq", zap.Bool("standalone", standalone), zap.String("mqType", mqType))
 
+	f.msgStreamFactory = newMsgStreamFactory(mqType, params)
+	if f.msgStreamFactory == nil {
+		return errors.New("failed to create MQ: check the milvus log for initialization failures")
+	}
+
+	targetType := params.MQCfg.MigrationTargetType.GetValue()
+	if targetType == "" {
+		return nil
+	}
+	if targetType == mqType {
+		return errors.Newf("the mq to migrate to is the same as the mq %s", mqType)
+	}
+	if err := validateMQType(standalone, targetType); err != nil {
+		return err
+	}
+	target := newMsgStreamFactory(targetType, params)
+	if target == nil {
+		return errors.Newf("failed to create the target MQ %s of the migration", targetType)
+	}
+	etcdCli, err := etcd.GetEtcdClient(
+		params.EtcdCfg.UseEmbedEtcd.GetAsBool(),
+		params.EtcdCfg.EtcdUseSSL.GetAsBool(),
+		params.EtcdCfg.Endpoints.GetAsStrings(),
+		params.EtcdCfg.EtcdTLSCert.GetValue(),
+		params.EtcdCfg.EtcdTLSKey.GetValue(),
+		params.EtcdCfg.EtcdTLSCACert.GetValue(),
+		params.EtcdCfg.EtcdTLSMinVersion.GetValue())
+	if err != nil {
+		return errors.Wrap(err, "failed to connect etcd to record the mq migration")
+	}
+	recorder := newEtcdMigrationRecorder(etcdCli, params.EtcdCfg.MetaRootPath.GetValue())
+	log.Info("mq migration enabled", zap.String("mqType", mqType), zap.String("targetType", targetType),
+		zap.String("phase", params.MQCfg.MigrationPhase.GetValue()))
+	f.msgStreamFactory = msgstream.NewMigrationFactory(f.msgStreamFactory, target, recorder)
+	return nil
+}
+
+func newMsgStreamFactory(mqType string, params *paramtable.ComponentParam) msgstream.Factory {
 	switch mqType {
 	case mqTypeNatsmq:
-		f.msgStreamFactory = msgstream.NewNatsmqFactory()
+		return msgstream.NewNatsmqFactory()
 	case mqTypeRocksmq:
-		f.msgStreamFactory = smsgstream.NewRocksmqFactory(params.RocksmqCfg.Path.GetValue())
+		return smsgstream.NewRocksmqFactory(params.RocksmqCfg.Path.GetValue())
 	case mqTypePulsar:
-		f.msgStreamFactory = msgstream.NewPmsFactory(&params.PulsarCfg)
+		return msgstream.NewPmsFactory(&params.PulsarCfg)
 	case mqTypeKafka:
-		f.msgStreamFactory = msgstream.NewKmsFactory(&params.KafkaCfg)
-	}
-	if f.msgStreamFactory == nil {
-		return errors.New("failed to create MQ: check the milvus log for initialization failures")
+		return msgstream.NewKmsFactory(&params.KafkaCfg)
 	}
 	return nil
 }
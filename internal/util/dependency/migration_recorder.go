@@ -0,0 +1,89 @@
+package dependency
+
+import (
+	"context"
+	"path"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/milvus-io/milvus/pkg/mq/msgstream"
+)
+
+// migrationDualWriteStartPrefix is the prefix of the dual write start timestamps of the channels under meta root.
+const migrationDualWriteStartPrefix = "mq-migration/dual-write-start"
+
+// etcdMigrationRecorder records the dual write start timestamps of the mq migration in etcd,
+// so that all the nodes agree on when the target mq becomes complete for each channel.
+type etcdMigrationRecorder struct {
+	cli  *clientv3.Client
+	root string
+}
+
+var _ msgstream.MigrationRecorder = (*etcdMigrationRecorder)(nil)
+
+func newEtcdMigrationRecorder(cli *clientv3.Client, metaRoot string) *etcdMigrationRecorder {
+	return &etcdMigrationRecorder{
+		cli:  cli,
+		root: path.Join(metaRoot, migrationDualWriteStartPrefix),
+	}
+}
+
+func (r *etcdMigrationRecorder) key(channel string) string {
+	return path.Join(r.root, channel)
+}
+
+// RecordDualWriteStart keeps the max of the recorded and the given timestamp of the channel.
+func (r *etcdMigrationRecorder) RecordDualWriteStart(channel string, ts uint64) error {
+	ctx, cancel := context.WithTimeout(context.Background(), migrationRecorderTimeout)
+	defer cancel()
+
+	key := r.key(channel)
+	for {
+		resp, err := r.cli.Get(ctx, key)
+		if err != nil {
+			return err
+		}
+		var version int64
+		if len(resp.Kvs) > 0 {
+			recorded, err := strconv.ParseUint(string(resp.Kvs[0].Value), 10, 64)
+			if err != nil {
+				return errors.Wrapf(err, "invalid dual write start of channel %s", channel)
+			}
+			if recorded >= ts {
+				return nil
+			}
+			version = resp.Kvs[0].Version
+		}
+		txnResp, err := r.cli.Txn(ctx).
+			If(clientv3.Compare(clientv3.Version(key), "=", version)).
+			Then(clientv3.OpPut(key, strconv.FormatUint(ts, 10))).
+			Commit()
+		if err != nil {
+			return err
+		}
+		if txnResp.Succeeded {
+			return nil
+		}
+	}
+}
+
+// GetDualWriteStart returns 0 if the dual write start of the channel is not recorded.
+func (r *etcdMigrationRecorder) GetDualWriteStart(channel string) (uint64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), migrationRecorderTimeout)
+	defer cancel()
+
+	resp, err := r.cli.Get(ctx, r.key(channel))
+	if err != nil {
+		return 0, err
+	}
+	if len(resp.Kvs) == 0 {
+		return 0, nil
+	}
+	ts, err := strconv.ParseUint(string(resp.Kvs[0].Value), 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid dual write start of channel %s", channel)
+	}
+	return ts, nil
+}
@@ -93,6 +93,18 @@ func (fg *TimeTickedFlowGraph) Unblock() {
 	}
 }
 
+// QueueLengths returns the number of messages queued in front of each node, for diagnosis.
+// The input node is not included since it doesn't have an input queue.
+func (fg *TimeTickedFlowGraph) QueueLengths() map[string]int {
+	lengths := make(map[string]int, len(fg.nodeCtx))
+	for name, v := range fg.nodeCtx {
+		if v.inputChannel != nil {
+			lengths[name] = len(v.inputChannel)
+		}
+	}
+	return lengths
+}
+
 // Close closes all nodes in flowgraph
 func (fg *TimeTickedFlowGraph) Close() {
 	fg.stopOnce.Do(func() {
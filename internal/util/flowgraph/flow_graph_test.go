@@ -212,6 +212,14 @@ func TestTimeTickedFlowGraph_Start(t *testing.T) {
 	time.Sleep(50 * time.Millisecond)
 }
 
+func TestTimeTickedFlowGraph_QueueLengths(t *testing.T) {
+	fg, _, _, cancel, err := createExampleFlowGraph()
+	assert.NoError(t, err)
+	defer cancel()
+
+	assert.Equal(t, map[string]int{"NodeB": 0, "NodeC": 0}, fg.QueueLengths())
+}
+
 func TestTimeTickedFlowGraph_Close(t *testing.T) {
 	fg, _, _, cancel, err := createExampleFlowGraph()
 	assert.NoError(t, err)
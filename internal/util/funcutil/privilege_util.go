@@ -0,0 +1,100 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package funcutil
+
+import (
+	"context"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/util/crypto"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// CheckAdminPrivilege checks whether the caller of the admin only api is the root user or a super user,
+// who is carried by the authorization metadata the same way as the requests to Proxy.
+// The password is verified against the credential stored in RootCoord.
+// It always passes if the authorization is disabled.
+func CheckAdminPrivilege(ctx context.Context, rootCoord types.RootCoord) error {
+	if !paramtable.Get().CommonCfg.AuthorizationEnabled.GetAsBool() {
+		return nil
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return merr.WrapErrServicePermissionDenied("", "no metadata in the context")
+	}
+	authorization := md[strings.ToLower(util.HeaderAuthorize)]
+	if len(authorization) < 1 {
+		return merr.WrapErrServicePermissionDenied("", "no authorization in the metadata")
+	}
+	rawToken, err := crypto.Base64Decode(authorization[0])
+	if err != nil {
+		return merr.WrapErrServicePermissionDenied("", "failed to decode the token")
+	}
+	secrets := strings.SplitN(rawToken, util.CredentialSeperator, 2)
+	if len(secrets) < 2 {
+		return merr.WrapErrServicePermissionDenied("", "invalid token format")
+	}
+	username, password := secrets[0], secrets[1]
+	if !isAdmin(username) {
+		return merr.WrapErrServicePermissionDenied(username, "admin privilege required")
+	}
+	return verifyPassword(ctx, rootCoord, username, password)
+}
+
+func isAdmin(username string) bool {
+	if username == util.UserRoot {
+		return true
+	}
+	for _, superUser := range paramtable.Get().CommonCfg.SuperUsers.GetAsStrings() {
+		if username == superUser {
+			return true
+		}
+	}
+	return false
+}
+
+// verifyPassword checks the password against the encrypted one from RootCoord, like the auth interceptor of Proxy
+func verifyPassword(ctx context.Context, rootCoord types.RootCoord, username, password string) error {
+	if rootCoord == nil {
+		return merr.WrapErrServicePermissionDenied(username, "unable to verify the credential")
+	}
+	resp, err := rootCoord.GetCredential(ctx, &rootcoordpb.GetCredentialRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_GetCredential),
+		),
+		Username: username,
+	})
+	if err == nil {
+		err = merr.Error(resp.GetStatus())
+	}
+	if err != nil {
+		return merr.WrapErrServicePermissionDenied(username, "failed to get credential: "+err.Error())
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(resp.GetPassword()), []byte(password)); err != nil {
+		return merr.WrapErrServicePermissionDenied(username, "wrong password")
+	}
+	return nil
+}
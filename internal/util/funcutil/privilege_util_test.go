@@ -0,0 +1,94 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package funcutil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/crypto"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestCheckAdminPrivilege(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	withToken := func(token string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(util.HeaderAuthorize, crypto.Base64Encode(token)))
+	}
+	withUser := func(user, password string) context.Context {
+		return withToken(user + util.CredentialSeperator + password)
+	}
+
+	encrypted, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
+	assert.NoError(t, err)
+	rootCoord := mocks.NewRootCoord(t)
+	rootCoord.EXPECT().GetCredential(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, req *rootcoordpb.GetCredentialRequest) (*rootcoordpb.GetCredentialResponse, error) {
+			switch req.GetUsername() {
+			case util.UserRoot, "admin":
+				return &rootcoordpb.GetCredentialResponse{
+					Status:   merr.Status(nil),
+					Username: req.GetUsername(),
+					Password: string(encrypted),
+				}, nil
+			case "nobody":
+				return &rootcoordpb.GetCredentialResponse{
+					Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_GetCredentialFailure, Reason: "not found"},
+				}, nil
+			default:
+				return nil, errors.New("mock error")
+			}
+		}).Maybe()
+
+	// always pass if the authorization is disabled
+	params.Save(params.CommonCfg.AuthorizationEnabled.Key, "false")
+	assert.NoError(t, CheckAdminPrivilege(context.Background(), nil))
+
+	params.Save(params.CommonCfg.AuthorizationEnabled.Key, "true")
+	defer params.Reset(params.CommonCfg.AuthorizationEnabled.Key)
+	params.Save(params.CommonCfg.SuperUsers.Key, "admin,nobody,broken")
+	defer params.Reset(params.CommonCfg.SuperUsers.Key)
+
+	assert.ErrorIs(t, CheckAdminPrivilege(context.Background(), rootCoord), merr.ErrServicePermissionDenied)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(util.HeaderAuthorize, "invalid token"))
+	assert.ErrorIs(t, CheckAdminPrivilege(ctx, rootCoord), merr.ErrServicePermissionDenied)
+	assert.ErrorIs(t, CheckAdminPrivilege(withToken(util.UserRoot), rootCoord), merr.ErrServicePermissionDenied)
+	assert.ErrorIs(t, CheckAdminPrivilege(withUser("alice", "password"), rootCoord), merr.ErrServicePermissionDenied)
+	assert.NoError(t, CheckAdminPrivilege(withUser(util.UserRoot, "password"), rootCoord))
+	assert.NoError(t, CheckAdminPrivilege(withUser("admin", "password"), rootCoord))
+
+	// the username is right but the password is wrong
+	assert.ErrorIs(t, CheckAdminPrivilege(withUser(util.UserRoot, "anything"), rootCoord), merr.ErrServicePermissionDenied)
+	assert.ErrorIs(t, CheckAdminPrivilege(withUser("admin", ""), rootCoord), merr.ErrServicePermissionDenied)
+
+	// failed to get the credential
+	assert.ErrorIs(t, CheckAdminPrivilege(withUser("nobody", "password"), rootCoord), merr.ErrServicePermissionDenied)
+	assert.ErrorIs(t, CheckAdminPrivilege(withUser("broken", "password"), rootCoord), merr.ErrServicePermissionDenied)
+	assert.ErrorIs(t, CheckAdminPrivilege(withUser(util.UserRoot, "password"), nil), merr.ErrServicePermissionDenied)
+}
@@ -80,6 +80,13 @@ type ClientBase[T interface {
 	CompressionEnabled     bool
 	RetryServiceNameConfig string
 
+	// SelectiveCompression compresses the listed methods and the requests larger than the threshold only,
+	// it takes no effect if CompressionEnabled is true.
+	SelectiveCompression     bool
+	CompressionAlgorithm     string
+	CompressionMethods       []string
+	CompressionSizeThreshold int
+
 	DialTimeout      time.Duration
 	KeepAliveTime    time.Duration
 	KeepAliveTimeout time.Duration
@@ -185,6 +192,14 @@ func (c *ClientBase[T]) connect(ctx context.Context) error {
 	if c.CompressionEnabled {
 		compress = Zstd
 	}
+	unaryInterceptors := []grpc.UnaryClientInterceptor{
+		otelgrpc.UnaryClientInterceptor(opts...),
+		interceptor.ClusterInjectionUnaryClientInterceptor(),
+	}
+	if !c.CompressionEnabled && c.SelectiveCompression {
+		unaryInterceptors = append(unaryInterceptors,
+			selectiveCompressionUnaryClientInterceptor(c.CompressionAlgorithm, c.CompressionMethods, c.CompressionSizeThreshold))
+	}
 	if c.encryption {
 		conn, err = grpc.DialContext(
 			dialContext,
@@ -198,10 +213,7 @@ func (c *ClientBase[T]) connect(ctx context.Context) error {
 				grpc.MaxCallSendMsgSize(c.ClientMaxSendSize),
 				grpc.UseCompressor(compress),
 			),
-			grpc.WithUnaryInterceptor(grpc_middleware.ChainUnaryClient(
-				otelgrpc.UnaryClientInterceptor(opts...),
-				interceptor.ClusterInjectionUnaryClientInterceptor(),
-			)),
+			grpc.WithUnaryInterceptor(grpc_middleware.ChainUnaryClient(unaryInterceptors...)),
 			grpc.WithStreamInterceptor(grpc_middleware.ChainStreamClient(
 				otelgrpc.StreamClientInterceptor(opts...),
 				interceptor.ClusterInjectionStreamClientInterceptor(),
@@ -235,10 +247,7 @@ func (c *ClientBase[T]) connect(ctx context.Context) error {
 				grpc.MaxCallSendMsgSize(c.ClientMaxSendSize),
 				grpc.UseCompressor(compress),
 			),
-			grpc.WithUnaryInterceptor(grpc_middleware.ChainUnaryClient(
-				otelgrpc.UnaryClientInterceptor(opts...),
-				interceptor.ClusterInjectionUnaryClientInterceptor(),
-			)),
+			grpc.WithUnaryInterceptor(grpc_middleware.ChainUnaryClient(unaryInterceptors...)),
 			grpc.WithStreamInterceptor(grpc_middleware.ChainStreamClient(
 				otelgrpc.StreamClientInterceptor(opts...),
 				interceptor.ClusterInjectionStreamClientInterceptor(),
@@ -0,0 +1,55 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcclient
+
+import (
+	"context"
+	"path"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// selectiveCompressionUnaryClientInterceptor compresses the requests of the listed methods,
+// and the requests of any method whose size reaches the threshold, the threshold is ignored if it's not positive.
+// The server replies with the same compressor, so the responses of the listed methods are compressed as well.
+func selectiveCompressionUnaryClientInterceptor(compressor string, methods []string, threshold int) grpc.UnaryClientInterceptor {
+	if compressor == None {
+		compressor = Zstd
+	}
+	methodSet := typeutil.NewSet(methods...)
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if shouldCompress(methodSet, threshold, method, req) {
+			opts = append(opts, grpc.UseCompressor(compressor))
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+func shouldCompress(methods typeutil.Set[string], threshold int, method string, req any) bool {
+	// the full method is in the form of /service/method
+	if methods.Contain(path.Base(method)) {
+		return true
+	}
+	if threshold <= 0 {
+		return false
+	}
+	msg, ok := req.(proto.Message)
+	return ok && proto.Size(msg) >= threshold
+}
@@ -0,0 +1,57 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+)
+
+func TestSelectiveCompressionInterceptor(t *testing.T) {
+	small := &milvuspb.GetComponentStatesRequest{}
+	large := &milvuspb.StringResponse{Value: string(make([]byte, 1024))}
+
+	// returns the compressor picked by the interceptor
+	call := func(method string, req proto.Message, threshold int) string {
+		compressor := None
+		interceptor := selectiveCompressionUnaryClientInterceptor(Gzip, []string{"SyncDistribution"}, threshold)
+		err := interceptor(context.Background(), method, req, nil, nil,
+			func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+				for _, opt := range opts {
+					if c, ok := opt.(grpc.CompressorCallOption); ok {
+						compressor = c.CompressorType
+					}
+				}
+				return nil
+			})
+		assert.NoError(t, err)
+		return compressor
+	}
+
+	assert.Equal(t, Gzip, call("/milvus.proto.query.QueryNode/SyncDistribution", small, 0))
+	assert.Equal(t, None, call("/milvus.proto.query.QueryNode/GetComponentStates", small, 0))
+	assert.Equal(t, None, call("/milvus.proto.query.QueryNode/GetComponentStates", small, 512))
+	assert.Equal(t, Gzip, call("/milvus.proto.query.QueryNode/GetComponentStates", large, 512))
+	assert.Equal(t, None, call("/milvus.proto.query.QueryNode/GetComponentStates", large, 0))
+
+}
@@ -20,49 +20,96 @@ package grpcclient
 import (
 	"bytes"
 	"io"
+	"sync"
 
+	"github.com/klauspost/compress/gzip"
 	"github.com/klauspost/compress/zstd"
 	"google.golang.org/grpc/encoding"
+
+	"github.com/milvus-io/milvus/pkg/metrics"
 )
 
 const None = ""
 const Zstd = "zstd"
+const Gzip = "gzip"
 
+// grpcCompressor compresses the whole message at once, and records the compression ratio.
 type grpcCompressor struct {
-	encoder *zstd.Encoder
-	decoder *zstd.Decoder
+	name       string
+	compress   func(src []byte) ([]byte, error)
+	decompress func(src []byte) ([]byte, error)
 }
 
 func init() {
 	enc, _ := zstd.NewWriter(nil)
 	dec, _ := zstd.NewReader(nil)
-	c := &grpcCompressor{
-		encoder: enc,
-		decoder: dec,
+	encoding.RegisterCompressor(&grpcCompressor{
+		name: Zstd,
+		compress: func(src []byte) ([]byte, error) {
+			return enc.EncodeAll(src, nil), nil
+		},
+		decompress: func(src []byte) ([]byte, error) {
+			return dec.DecodeAll(src, nil)
+		},
+	})
+
+	writers := sync.Pool{
+		New: func() any {
+			return gzip.NewWriter(nil)
+		},
 	}
-	encoding.RegisterCompressor(c)
+	encoding.RegisterCompressor(&grpcCompressor{
+		name: Gzip,
+		compress: func(src []byte) ([]byte, error) {
+			var buf bytes.Buffer
+			w := writers.Get().(*gzip.Writer)
+			defer writers.Put(w)
+			w.Reset(&buf)
+			if _, err := w.Write(src); err != nil {
+				return nil, err
+			}
+			if err := w.Close(); err != nil {
+				return nil, err
+			}
+			return buf.Bytes(), nil
+		},
+		decompress: func(src []byte) ([]byte, error) {
+			r, err := gzip.NewReader(bytes.NewReader(src))
+			if err != nil {
+				return nil, err
+			}
+			defer r.Close()
+			return io.ReadAll(r)
+		},
+	})
 }
 
 func (c *grpcCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
-	return &zstdWriteCloser{
-		enc:    c.encoder,
-		writer: w,
+	return &bufferedWriteCloser{
+		compressor: c,
+		writer:     w,
 	}, nil
 }
 
-type zstdWriteCloser struct {
-	enc    *zstd.Encoder
-	writer io.Writer    // Compressed data will be written here.
-	buf    bytes.Buffer // Buffer uncompressed data here, compress on Close.
+type bufferedWriteCloser struct {
+	compressor *grpcCompressor
+	writer     io.Writer    // Compressed data will be written here.
+	buf        bytes.Buffer // Buffer uncompressed data here, compress on Close.
 }
 
-func (z *zstdWriteCloser) Write(p []byte) (int, error) {
+func (z *bufferedWriteCloser) Write(p []byte) (int, error) {
 	return z.buf.Write(p)
 }
 
-func (z *zstdWriteCloser) Close() error {
-	compressed := z.enc.EncodeAll(z.buf.Bytes(), nil)
-	_, err := io.Copy(z.writer, bytes.NewReader(compressed))
+func (z *bufferedWriteCloser) Close() error {
+	compressed, err := z.compressor.compress(z.buf.Bytes())
+	if err != nil {
+		return err
+	}
+	if len(compressed) > 0 && z.buf.Len() > 0 {
+		metrics.GrpcCompressionRatio.WithLabelValues(z.compressor.name).Observe(float64(z.buf.Len()) / float64(len(compressed)))
+	}
+	_, err = io.Copy(z.writer, bytes.NewReader(compressed))
 	return err
 }
 
@@ -72,7 +119,7 @@ func (c *grpcCompressor) Decompress(r io.Reader) (io.Reader, error) {
 		return nil, err
 	}
 
-	uncompressed, err := c.decoder.DecodeAll(compressed, nil)
+	uncompressed, err := c.decompress(compressed)
 	if err != nil {
 		return nil, err
 	}
@@ -80,5 +127,5 @@ func (c *grpcCompressor) Decompress(r io.Reader) (io.Reader, error) {
 }
 
 func (c *grpcCompressor) Name() string {
-	return Zstd
+	return c.name
 }
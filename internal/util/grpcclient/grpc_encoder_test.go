@@ -24,10 +24,19 @@ import (
 )
 
 func TestGrpcEncoder(t *testing.T) {
-	data := "hello zstd algorithm!"
+	for _, name := range []string{Zstd, Gzip} {
+		t.Run(name, func(t *testing.T) {
+			testGrpcEncoder(t, name)
+		})
+	}
+}
+
+func testGrpcEncoder(t *testing.T, name string) {
+	data := "hello " + name + " algorithm!"
 	var buf bytes.Buffer
 
-	compressor := encoding.GetCompressor(Zstd)
+	compressor := encoding.GetCompressor(name)
+	assert.Equal(t, name, compressor.Name())
 	writer, err := compressor.Compress(&buf)
 	assert.NoError(t, err)
 	written, err := writer.Write([]byte(data))
@@ -0,0 +1,161 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// ErrorReportDir is the sub-directory under the chunk manager root path to store the error reports
+const ErrorReportDir = "import_error_reports"
+
+// RejectedRow is a row rejected by the import task
+type RejectedRow struct {
+	File   string `json:"file"`            // the file which the row comes from
+	Line   int64  `json:"line"`            // 1-based line number where the row ends in the file
+	Row    int64  `json:"row"`             // 0-based index of the row in the rows list
+	Field  string `json:"field,omitempty"` // the field causing the rejection, empty if the row itself is illegal
+	Reason string `json:"reason"`          // why the row is rejected
+}
+
+// ErrorReport collects the rejected rows of an import task, the rows are persisted into object storage
+// as JSON lines so that users can fix and re-submit only the failing rows.
+type ErrorReport struct {
+	maxRejectedRows int64
+	rows            []*RejectedRow
+}
+
+// NewErrorReport creates an ErrorReport which tolerates at most maxRejectedRows rejected rows
+func NewErrorReport(maxRejectedRows int64) *ErrorReport {
+	return &ErrorReport{
+		maxRejectedRows: maxRejectedRows,
+		rows:            make([]*RejectedRow, 0),
+	}
+}
+
+// Reject records a rejected row, returns error if the count of rejected rows exceeds the limitation
+func (r *ErrorReport) Reject(row *RejectedRow) error {
+	if int64(len(r.rows)) >= r.maxRejectedRows {
+		return fmt.Errorf("the count of rejected rows exceeds the limitation %d, the last rejected row %d of file '%s': %s",
+			r.maxRejectedRows, row.Row, row.File, row.Reason)
+	}
+	r.rows = append(r.rows, row)
+	return nil
+}
+
+// Count returns the count of rejected rows
+func (r *ErrorReport) Count() int64 {
+	return int64(len(r.rows))
+}
+
+// Rows returns the rejected rows
+func (r *ErrorReport) Rows() []*RejectedRow {
+	return r.rows
+}
+
+// Persist writes the rejected rows into the file, one JSON object per line
+func (r *ErrorReport) Persist(ctx context.Context, cm storage.ChunkManager, filePath string) error {
+	content := make([]byte, 0)
+	for _, row := range r.rows {
+		bs, err := json.Marshal(row)
+		if err != nil {
+			return err
+		}
+		content = append(content, bs...)
+		content = append(content, '\n')
+	}
+
+	if err := cm.Write(ctx, filePath, content); err != nil {
+		log.Warn("import error report: failed to persist the error report", zap.String("path", filePath), zap.Error(err))
+		return fmt.Errorf("failed to persist the error report '%s', error: %w", filePath, err)
+	}
+	log.Info("import error report: error report persisted", zap.String("path", filePath), zap.Int64("rejectedRows", r.Count()))
+	return nil
+}
+
+// ErrorReportPath returns the path of the error report of an import task
+func ErrorReportPath(rootPath string, taskID int64) string {
+	return path.Join(rootPath, ErrorReportDir, fmt.Sprintf("%d.json", taskID))
+}
+
+// fieldError is a row error caused by the value of a field
+type fieldError struct {
+	field string
+	err   error
+}
+
+func newFieldError(field string, err error) error {
+	return &fieldError{field: field, err: err}
+}
+
+func (e *fieldError) Error() string {
+	return e.err.Error()
+}
+
+func (e *fieldError) Unwrap() error {
+	return e.err
+}
+
+// errorField returns the field causing the error, empty if the error is not caused by a field
+func errorField(err error) string {
+	var fe *fieldError
+	if errors.As(err, &fe) {
+		return fe.field
+	}
+	return ""
+}
+
+// lineCounter counts the lines of the content read through it, so that the offsets reported by
+// the json decoder can be translated into line numbers, the queried offsets must be non-decreasing
+type lineCounter struct {
+	r        io.Reader
+	read     int64   // how many bytes have been read
+	newlines []int64 // offsets of the newlines which are read but not passed yet
+	passed   int64   // how many newlines have been passed
+}
+
+func (c *lineCounter) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	for i := 0; i < n; i++ {
+		if p[i] == '\n' {
+			c.newlines = append(c.newlines, c.read+int64(i))
+		}
+	}
+	c.read += int64(n)
+	return n, err
+}
+
+// lineAt returns the 1-based line number of the byte before the offset
+func (c *lineCounter) lineAt(offset int64) int64 {
+	i := 0
+	for i < len(c.newlines) && c.newlines[i] < offset-1 {
+		i++
+	}
+	c.passed += int64(i)
+	c.newlines = c.newlines[i:]
+	return c.passed + 1
+}
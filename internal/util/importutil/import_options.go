@@ -17,6 +17,7 @@
 package importutil
 
 import (
+	"fmt"
 	"math"
 	"strconv"
 	"strings"
@@ -34,8 +35,12 @@ const (
 	StartTs      = "start_ts" // start timestamp to filter data, only data between StartTs and EndTs will be imported
 	EndTs        = "end_ts"   // end timestamp to filter data, only data between StartTs and EndTs will be imported
 	OptionFormat = "start_ts: 10-digit physical timestamp, e.g. 1665995420, default 0 \n" +
-		"end_ts: 10-digit physical timestamp, e.g. 1665995420, default math.MaxInt \n"
-	BackupFlag = "backup"
+		"end_ts: 10-digit physical timestamp, e.g. 1665995420, default math.MaxInt \n" +
+		"max_rejected_rows: max count of illegal rows rejected into the error report, default 0 \n" +
+		"external: register the parquet files as segments converted lazily, true or false, default false \n"
+	BackupFlag      = "backup"
+	MaxRejectedRows = "max_rejected_rows" // max count of illegal rows rejected into the error report instead of failing the task
+	ExternalFlag    = "external"          // register the parquet files as segments, which are converted into binlogs lazily
 )
 
 type ImportOptions struct {
@@ -43,6 +48,9 @@ type ImportOptions struct {
 	TsStartPoint uint64
 	TsEndPoint   uint64
 	IsBackup     bool // whether is triggered by backup tool
+
+	MaxRejectedRows int64 // max count of illegal rows rejected into the error report, 0 means fail on the first illegal row
+	External        bool  // register the files as segments instead of converting them into binlogs
 }
 
 func DefaultImportOptions() ImportOptions {
@@ -59,6 +67,8 @@ func DefaultImportOptions() ImportOptions {
 //
 //	start_ts: 10-digit physical timestamp, e.g. 1665995420
 //	end_ts: 10-digit physical timestamp, e.g. 1665995420
+//	max_rejected_rows: non-negative integer
+//	external: true or false
 func ValidateOptions(options []*commonpb.KeyValuePair) error {
 	optionMap := funcutil.KeyValuePair2Map(options)
 	// StartTs should be int
@@ -83,6 +93,24 @@ func ValidateOptions(options []*commonpb.KeyValuePair) error {
 	if startTs > endTs {
 		return errors.New("start_ts shouldn't be larger than end_ts")
 	}
+	// MaxRejectedRows should be non-negative int
+	value, ok := optionMap[MaxRejectedRows]
+	if ok {
+		maxRejectedRows, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return err
+		}
+		if maxRejectedRows < 0 {
+			return errors.New("max_rejected_rows shouldn't be negative")
+		}
+	}
+	// External should be bool
+	value, ok = optionMap[ExternalFlag]
+	if ok {
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("external should be true or false, error: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -121,3 +149,27 @@ func IsBackup(options []*commonpb.KeyValuePair) bool {
 	}
 	return true
 }
+
+// IsExternal returns if the files are registered as segments on the external files
+func IsExternal(options []*commonpb.KeyValuePair) bool {
+	value, err := funcutil.GetAttrByKeyFromRepeatedKV(ExternalFlag, options)
+	if err != nil {
+		return false
+	}
+	external, _ := strconv.ParseBool(value)
+	return external
+}
+
+// ParseMaxRejectedRows gets the max count of rejected rows from input options, returns 0 if not specified
+func ParseMaxRejectedRows(options []*commonpb.KeyValuePair) (int64, error) {
+	err := ValidateOptions(options)
+	if err != nil {
+		return 0, err
+	}
+	value, err := funcutil.GetAttrByKeyFromRepeatedKV(MaxRejectedRows, options)
+	if err != nil {
+		return 0, nil
+	}
+	maxRejectedRows, _ := strconv.ParseInt(value, 10, 64)
+	return maxRejectedRows, nil
+}
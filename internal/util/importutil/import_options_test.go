@@ -110,3 +110,42 @@ func Test_IsBackup(t *testing.T) {
 	})
 	assert.Equal(t, false, noBackup)
 }
+
+func Test_ParseMaxRejectedRows(t *testing.T) {
+	maxRejectedRows, err := ParseMaxRejectedRows([]*commonpb.KeyValuePair{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), maxRejectedRows)
+
+	maxRejectedRows, err = ParseMaxRejectedRows([]*commonpb.KeyValuePair{
+		{Key: MaxRejectedRows, Value: "100"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), maxRejectedRows)
+
+	_, err = ParseMaxRejectedRows([]*commonpb.KeyValuePair{
+		{Key: MaxRejectedRows, Value: "-1"},
+	})
+	assert.Error(t, err)
+
+	_, err = ParseMaxRejectedRows([]*commonpb.KeyValuePair{
+		{Key: MaxRejectedRows, Value: "a"},
+	})
+	assert.Error(t, err)
+}
+
+func Test_IsExternal(t *testing.T) {
+	assert.True(t, IsExternal([]*commonpb.KeyValuePair{
+		{Key: ExternalFlag, Value: "true"},
+	}))
+	assert.False(t, IsExternal([]*commonpb.KeyValuePair{
+		{Key: ExternalFlag, Value: "false"},
+	}))
+	assert.False(t, IsExternal([]*commonpb.KeyValuePair{}))
+
+	assert.NoError(t, ValidateOptions([]*commonpb.KeyValuePair{
+		{Key: ExternalFlag, Value: "True"},
+	}))
+	assert.Error(t, ValidateOptions([]*commonpb.KeyValuePair{
+		{Key: ExternalFlag, Value: "yes"},
+	}))
+}
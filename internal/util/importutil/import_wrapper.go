@@ -36,8 +36,9 @@ import (
 )
 
 const (
-	JSONFileExt  = ".json"
-	NumpyFileExt = ".npy"
+	JSONFileExt    = ".json"
+	NumpyFileExt   = ".npy"
+	ParquetFileExt = ".parquet"
 
 	// supposed size of a single block, to control a binlog file size, the max biglog file size is no more than 2*SingleBlockSize
 	SingleBlockSize = 16 * 1024 * 1024 // 16MB
@@ -63,6 +64,12 @@ const (
 	PartitionName   = "partition"
 	PersistTimeCost = "persist_cost"
 	ProgressPercent = "progress_percent"
+	ErrorReportFile = "error_report"  // path of the error report of rejected rows
+	RejectedRows    = "rejected_rows" // count of rejected rows
+
+	// keywords of import job informations
+	JobID              = "job_id"
+	JobProgressPercent = "job_progress_percent"
 )
 
 // ReportImportAttempts is the maximum # of attempts to retry when import fails.
@@ -73,6 +80,7 @@ type AssignSegmentFunc func(shardID int, partID int64) (int64, string, error)
 type CreateBinlogsFunc func(fields BlockData, segmentID int64, partID int64) ([]*datapb.FieldBinlog, []*datapb.FieldBinlog, error)
 type SaveSegmentFunc func(fieldsInsert []*datapb.FieldBinlog, fieldsStats []*datapb.FieldBinlog, segmentID int64, targetChName string, rowCount int64, partID int64) error
 type ReportFunc func(res *rootcoordpb.ImportResult) error
+type RegisterExternalFunc func(segmentID int64, targetChName string, rowCount int64, partID int64, source *datapb.ExternalSource) error
 
 type WorkingSegment struct {
 	segmentID    int64                 // segment ID
@@ -97,12 +105,15 @@ type ImportWrapper struct {
 	createBinlogsFunc CreateBinlogsFunc // function to create binlog for a segment
 	saveSegmentFunc   SaveSegmentFunc   // function to persist a segment
 
+	registerExternalFunc RegisterExternalFunc // function to persist a segment registered on the external files
+
 	importResult         *rootcoordpb.ImportResult // import result
 	reportFunc           ReportFunc                // report import state to rootcoord
 	reportImportAttempts uint                      // attempts count if report function get error
 
 	workingSegments map[int]map[int64]*WorkingSegment // two-level map shard id and partition id to working segments
 	progressPercent int64                             // working progress percent
+	errorReport     *ErrorReport                      // rejected rows of row-based files, nil if rejection is disabled
 }
 
 func NewImportWrapper(ctx context.Context, collectionInfo *CollectionInfo, segmentSize int64,
@@ -155,6 +166,12 @@ func (p *ImportWrapper) SetCallbackFunctions(assignSegmentFunc AssignSegmentFunc
 	return nil
 }
 
+// SetRegisterExternalFunc sets the function to persist the segments registered on the external files,
+// which is required to import with the external option
+func (p *ImportWrapper) SetRegisterExternalFunc(registerExternalFunc RegisterExternalFunc) {
+	p.registerExternalFunc = registerExternalFunc
+}
+
 // Cancel method can be used to cancel parse process
 func (p *ImportWrapper) Cancel() error {
 	p.cancel()
@@ -164,6 +181,7 @@ func (p *ImportWrapper) Cancel() error {
 // fileValidation verify the input paths
 // if all the files are json type, return true
 // if all the files are numpy type, return false, and not allow duplicate file name
+// if all the files are parquet type, return false, each parquet file contains all the fields
 func (p *ImportWrapper) fileValidation(filePaths []string) (bool, error) {
 	// use this map to check duplicate file name(only for numpy file)
 	fileNames := make(map[string]struct{})
@@ -174,8 +192,8 @@ func (p *ImportWrapper) fileValidation(filePaths []string) (bool, error) {
 		filePath := filePaths[i]
 		name, fileType := GetFileNameAndExt(filePath)
 
-		// only allow json file or numpy file
-		if fileType != JSONFileExt && fileType != NumpyFileExt {
+		// only allow json file, numpy file or parquet file
+		if fileType != JSONFileExt && fileType != NumpyFileExt && fileType != ParquetFileExt {
 			log.Warn("import wrapper: unsupported file type", zap.String("filePath", filePath))
 			return false, fmt.Errorf("unsupported file type: '%s'", filePath)
 		}
@@ -186,14 +204,15 @@ func (p *ImportWrapper) fileValidation(filePaths []string) (bool, error) {
 		}
 
 		// check file type
-		// row-based only support json type, column-based only support numpy type
+		// row-based only support json type, column-based support numpy type or parquet type, mixed types are not allowed
+		_, firstFileType := GetFileNameAndExt(filePaths[0])
 		if rowBased {
 			if fileType != JSONFileExt {
 				log.Warn("import wrapper: unsupported file type for row-based mode", zap.String("filePath", filePath))
 				return rowBased, fmt.Errorf("unsupported file type for row-based mode: '%s'", filePath)
 			}
 		} else {
-			if fileType != NumpyFileExt {
+			if fileType != firstFileType {
 				log.Warn("import wrapper: unsupported file type for column-based mode", zap.String("filePath", filePath))
 				return rowBased, fmt.Errorf("unsupported file type for column-based mode: '%s'", filePath)
 			}
@@ -250,11 +269,18 @@ func (p *ImportWrapper) Import(filePaths []string, options ImportOptions) error
 		return err
 	}
 
+	if options.External {
+		return p.registerExternal(filePaths)
+	}
+
 	tr := timerecord.NewTimeRecorder("Import task")
 	if rowBased {
 		// parse and consume row-based files
 		// for row-based files, the JSONRowConsumer will generate autoid for primary key, and split rows into segments
 		// according to shard number, so the flushFunc will be called in the JSONRowConsumer
+		if options.MaxRejectedRows > 0 {
+			p.errorReport = NewErrorReport(options.MaxRejectedRows)
+		}
 		for i := 0; i < len(filePaths); i++ {
 			filePath := filePaths[i]
 			_, fileType := GetFileNameAndExt(filePath)
@@ -264,10 +290,56 @@ func (p *ImportWrapper) Import(filePaths []string, options ImportOptions) error
 				err = p.parseRowBasedJSON(filePath, options.OnlyValidate)
 				if err != nil {
 					log.Warn("import wrapper: failed to parse row-based json file", zap.Error(err), zap.String("filePath", filePath))
+					// the report still helps to fix the rows even if the task failed
+					p.persistErrorReport()
 					return err
 				}
 			} // no need to check else, since the fileValidation() already do this
 
+			if !options.OnlyValidate {
+				err = p.reportCheckpoint(filePath, i == len(filePaths)-1)
+				if err != nil {
+					return err
+				}
+			}
+
+			// trigger gc after each file finished
+			triggerGC()
+		}
+
+		err = p.persistErrorReport()
+		if err != nil {
+			return err
+		}
+	} else if p.isParquetImport(filePaths) {
+		// parse and consume parquet files, each file contains all the fields and is parsed separately
+		// the ParquetParser converts the columns into binlog data directly, and split rows into segments
+		// according to shard number, so the flushFunc will be called in the ParquetParser
+		for i := 0; i < len(filePaths); i++ {
+			filePath := filePaths[i]
+			flushFunc := func(fields BlockData, shardID int, partitionID int64) error {
+				printFieldsDataInfo(fields, "import wrapper: prepare to flush binlog data", []string{filePath})
+				return p.flushFunc(fields, shardID, partitionID)
+			}
+			parser, err := NewParquetParser(p.ctx, p.collectionInfo, p.rowIDAllocator, SingleBlockSize,
+				p.chunkManager, flushFunc, p.updateProgressPercent)
+			if err != nil {
+				return err
+			}
+
+			err = parser.Parse(filePath)
+			if err != nil {
+				log.Warn("import wrapper: failed to parse parquet file", zap.Error(err), zap.String("filePath", filePath))
+				return err
+			}
+
+			p.importResult.AutoIds = append(p.importResult.AutoIds, parser.IDRange()...)
+
+			err = p.reportCheckpoint(filePath, i == len(filePaths)-1)
+			if err != nil {
+				return err
+			}
+
 			// trigger gc after each file finished
 			triggerGC()
 		}
@@ -299,6 +371,127 @@ func (p *ImportWrapper) Import(filePaths []string, options ImportOptions) error
 	return p.reportPersisted(p.reportImportAttempts, tr)
 }
 
+// registerExternal registers the parquet files as segments without converting them into binlogs. The rows of each file
+// are split into a segment for each shard by their primary keys, only the primary keys are read to count the rows.
+// The segments are invisible until DataCoord converts them into binlogs once the collection is indexed.
+func (p *ImportWrapper) registerExternal(filePaths []string) error {
+	if !p.isParquetImport(filePaths) {
+		log.Warn("import wrapper: only parquet files could be registered as external segments", zap.Strings("filePaths", filePaths))
+		return fmt.Errorf("only parquet files could be registered as external segments")
+	}
+	if p.collectionInfo.PrimaryKey.GetAutoID() {
+		log.Warn("import wrapper: the files can't be registered since the primary key is auto-generated")
+		return fmt.Errorf("the files can't be registered as external segments since the primary key '%s' is auto-generated",
+			p.collectionInfo.PrimaryKey.GetName())
+	}
+	if p.collectionInfo.PartitionKey != nil {
+		log.Warn("import wrapper: the files can't be registered since the collection has partition key")
+		return fmt.Errorf("the files can't be registered as external segments since the collection has partition key '%s'",
+			p.collectionInfo.PartitionKey.GetName())
+	}
+	if p.registerExternalFunc == nil {
+		log.Warn("import wrapper: callback function RegisterExternalFunc is nil")
+		return fmt.Errorf("callback function RegisterExternalFunc is nil")
+	}
+
+	tr := timerecord.NewTimeRecorder("Import task")
+	// the rows are never flushed by the parser, it only counts the rows of each shard
+	flushFunc := func(fields BlockData, shardID int, partitionID int64) error {
+		return fmt.Errorf("unexpected flush to register the external files")
+	}
+	partitionID := p.collectionInfo.PartitionIDs[0]
+	for i, filePath := range filePaths {
+		parser, err := NewParquetParser(p.ctx, p.collectionInfo, p.rowIDAllocator, SingleBlockSize,
+			p.chunkManager, flushFunc, p.updateProgressPercent)
+		if err != nil {
+			return err
+		}
+
+		counts, err := parser.CountShardRows(filePath)
+		if err != nil {
+			log.Warn("import wrapper: failed to count the rows of parquet file", zap.Error(err), zap.String("filePath", filePath))
+			return err
+		}
+
+		for shardID, rowCount := range counts {
+			if rowCount == 0 {
+				continue
+			}
+			segmentID, channelName, err := p.assignSegmentFunc(shardID, partitionID)
+			if err != nil {
+				log.Warn("import wrapper: failed to assign a new segment", zap.Int("shardID", shardID), zap.Error(err))
+				return fmt.Errorf("failed to assign a new segment for shard id %d, error: %w", shardID, err)
+			}
+			source := &datapb.ExternalSource{
+				Files:      []string{filePath},
+				ShardNum:   p.collectionInfo.ShardNum,
+				ShardIndex: int32(shardID),
+			}
+			err = p.registerExternalFunc(segmentID, channelName, rowCount, partitionID, source)
+			if err != nil {
+				log.Warn("import wrapper: failed to register external segment", zap.Int64("segmentID", segmentID),
+					zap.String("filePath", filePath), zap.Error(err))
+				return fmt.Errorf("failed to register external segment, shard id %d, segment id %d, channel '%s', error: %w",
+					shardID, segmentID, channelName, err)
+			}
+			log.Info("import wrapper: external segment registered", zap.Int64("segmentID", segmentID),
+				zap.String("filePath", filePath), zap.Int("shardID", shardID), zap.Int64("rowCount", rowCount))
+		}
+
+		err = p.reportCheckpoint(filePath, i == len(filePaths)-1)
+		if err != nil {
+			return err
+		}
+	}
+
+	return p.reportPersisted(p.reportImportAttempts, tr)
+}
+
+// persistErrorReport writes the rejected rows into object storage, and refers the report from the import result
+func (p *ImportWrapper) persistErrorReport() error {
+	if p.errorReport == nil || p.errorReport.Count() == 0 {
+		return nil
+	}
+
+	filePath := ErrorReportPath(p.chunkManager.RootPath(), p.importResult.GetTaskId())
+	err := p.errorReport.Persist(p.ctx, p.chunkManager, filePath)
+	if err != nil {
+		return err
+	}
+	UpdateKVInfo(&p.importResult.Infos, ErrorReportFile, filePath)
+	UpdateKVInfo(&p.importResult.Infos, RejectedRows, strconv.FormatInt(p.errorReport.Count(), 10))
+	return nil
+}
+
+// reportCheckpoint seals the working segments after a file is completely imported, and reports the file as
+// completed to rootcoord, so that a retried task can resume from the next file. The last file is not reported
+// since the whole task is persisted after it.
+func (p *ImportWrapper) reportCheckpoint(filePath string, last bool) error {
+	if last {
+		return nil
+	}
+
+	err := p.closeAllWorkingSegments()
+	if err != nil {
+		return err
+	}
+
+	// the progress reports of the next file carry the completed files too, rootcoord takes the checkpoint
+	// from the first report carrying a new completed file, so the checkpoint must be reported successfully
+	p.importResult.CompletedFiles = append(p.importResult.CompletedFiles, filePath)
+	err = retry.Do(p.ctx, func() error {
+		return p.reportFunc(p.importResult)
+	}, retry.Attempts(p.reportImportAttempts))
+	if err != nil {
+		log.Warn("import wrapper: fail to report import checkpoint to RootCoord", zap.String("filePath", filePath),
+			zap.Error(err))
+		return err
+	}
+	log.Info("import wrapper: report import checkpoint", zap.Strings("completedFiles", p.importResult.GetCompletedFiles()),
+		zap.Int64s("segments", p.importResult.GetSegments()))
+	return nil
+}
+
 // reportPersisted notify the rootcoord to mark the task state to be ImportPersisted
 func (p *ImportWrapper) reportPersisted(reportAttempts uint, tr *timerecord.TimeRecorder) error {
 	// force close all segments
@@ -330,6 +523,15 @@ func (p *ImportWrapper) reportPersisted(reportAttempts uint, tr *timerecord.Time
 	return nil
 }
 
+// isParquetImport is to judge whether the files are parquet files, the fileValidation() ensures all files have the same type
+func (p *ImportWrapper) isParquetImport(filePaths []string) bool {
+	if len(filePaths) == 0 {
+		return false
+	}
+	_, fileType := GetFileNameAndExt(filePaths[0])
+	return fileType == ParquetFileExt
+}
+
 // isBinlogImport is to judge whether it is binlog import operation
 // For internal usage by the restore tool: https://github.com/zilliztech/milvus-backup
 // This tool exports data from a milvus service, and call bulkload interface to import native data into another milvus service.
@@ -415,6 +617,9 @@ func (p *ImportWrapper) parseRowBasedJSON(filePath string, onlyValidate bool) er
 	// parse file
 	reader := bufio.NewReader(file)
 	parser := NewJSONParser(p.ctx, p.collectionInfo, p.updateProgressPercent)
+	if p.errorReport != nil {
+		parser.SetErrorReport(p.errorReport, filePath)
+	}
 
 	// if only validate, we input a empty flushFunc so that the consumer do nothing but only validation.
 	var flushFunc ImportFlushFunc
@@ -21,10 +21,12 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"io"
 	"math"
 	"os"
 	"path"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -40,6 +42,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/params"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/timerecord"
 )
 
@@ -116,7 +119,19 @@ func (mc *MockChunkManager) ReadWithPrefix(ctx context.Context, prefix string) (
 }
 
 func (mc *MockChunkManager) ReadAt(ctx context.Context, filePath string, off int64, length int64) ([]byte, error) {
-	return nil, nil
+	if mc.readErr != nil {
+		return nil, mc.readErr
+	}
+
+	val, ok := mc.readBuf[filePath]
+	if !ok {
+		return nil, errors.New("mock chunk manager: file path not found: " + filePath)
+	}
+	if off < 0 || length < 0 || off+length > int64(len(val)) {
+		return nil, io.EOF
+	}
+
+	return val[off : off+length], nil
 }
 
 func (mc *MockChunkManager) Mmap(ctx context.Context, filePath string) (*mmap.ReaderAt, error) {
@@ -323,6 +338,68 @@ func Test_ImportWrapperRowBased(t *testing.T) {
 		err = wrapper.Import(files, ImportOptions{OnlyValidate: true})
 		assert.Error(t, err)
 	})
+
+	t.Run("reject illegal rows", func(t *testing.T) {
+		content = []byte(`{
+			"rows":[
+				{"FieldBool": true, "FieldInt8": 10, "FieldInt16": 101, "FieldInt32": 1001, "FieldInt64": 10001, "FieldFloat": 3.14, "FieldDouble": 1.56, "FieldString": "hello world", "FieldJSON": {"x": 2}, "FieldBinaryVector": [254, 0], "FieldFloatVector": [1.1, 1.2, 1.3, 1.4]},
+				{"FieldBool": true, "FieldInt8": false, "FieldInt16": 102, "FieldInt32": 1002, "FieldInt64": 10002, "FieldFloat": 3.15, "FieldDouble": 2.56, "FieldString": "hello world", "FieldJSON": {"x": 3}, "FieldBinaryVector": [253, 0], "FieldFloatVector": [2.1, 2.2, 2.3, 2.4]},
+				{"FieldBool": true, "FieldInt8": 12, "FieldInt16": 103, "FieldInt32": 1003, "FieldInt64": 10003, "FieldFloat": 3.16, "FieldDouble": 3.56, "FieldString": "hello world", "FieldJSON": {"x": 4}, "FieldBinaryVector": [252, 0], "FieldFloatVector": [3.1, 3.2, 3.3]},
+				{"FieldBool": true, "FieldInt8": 13, "FieldInt16": 104, "FieldInt32": 1004, "FieldInt64": 10004, "FieldFloat": 3.17, "FieldDouble": 4.56, "FieldString": "hello world", "FieldJSON": {"x": 5}, "FieldBinaryVector": [251, 0], "FieldFloatVector": [4.1, 4.2, 4.3, 4.4]}
+			]
+		}`)
+
+		filePath = TempFilesPath + "rows_3.json"
+		err = cm.Write(ctx, filePath, content)
+		assert.NoError(t, err)
+		files := []string{filePath}
+
+		// too many illegal rows, the report is persisted even if the task failed
+		result := &rootcoordpb.ImportResult{TaskId: 2, State: commonpb.ImportState_ImportStarted}
+		wrapper := NewImportWrapper(ctx, collectionInfo, 1, idAllocator, cm, result, reportFunc)
+		wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+		err = wrapper.Import(files, ImportOptions{MaxRejectedRows: 1, TsEndPoint: math.MaxUint64})
+		assert.Error(t, err)
+		assert.NotEqual(t, commonpb.ImportState_ImportPersisted, result.State)
+		reportPath, err := funcutil.GetAttrByKeyFromRepeatedKV(ErrorReportFile, result.GetInfos())
+		assert.NoError(t, err)
+		assert.Equal(t, ErrorReportPath(cm.RootPath(), 2), reportPath)
+
+		// illegal rows are rejected
+		rowCounter.rowCount = 0
+		result = &rootcoordpb.ImportResult{TaskId: 3, State: commonpb.ImportState_ImportStarted}
+		wrapper = NewImportWrapper(ctx, collectionInfo, 1, idAllocator, cm, result, reportFunc)
+		wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+		err = wrapper.Import(files, ImportOptions{MaxRejectedRows: 2, TsEndPoint: math.MaxUint64})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, rowCounter.rowCount)
+		assert.Equal(t, commonpb.ImportState_ImportPersisted, result.State)
+
+		rejected, err := funcutil.GetAttrByKeyFromRepeatedKV(RejectedRows, result.GetInfos())
+		assert.NoError(t, err)
+		assert.Equal(t, "2", rejected)
+		reportPath, err = funcutil.GetAttrByKeyFromRepeatedKV(ErrorReportFile, result.GetInfos())
+		assert.NoError(t, err)
+		data, err := cm.Read(ctx, reportPath)
+		assert.NoError(t, err)
+		lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+		assert.Equal(t, 2, len(lines))
+
+		rows := make([]RejectedRow, 0)
+		for _, line := range lines {
+			row := RejectedRow{}
+			err = json.Unmarshal([]byte(line), &row)
+			assert.NoError(t, err)
+			rows = append(rows, row)
+		}
+		assert.Equal(t, filePath, rows[0].File)
+		assert.Equal(t, int64(4), rows[0].Line)
+		assert.Equal(t, int64(1), rows[0].Row)
+		assert.Equal(t, "FieldInt8", rows[0].Field)
+		assert.Equal(t, int64(5), rows[1].Line)
+		assert.Equal(t, int64(2), rows[1].Row)
+		assert.Equal(t, "FieldFloatVector", rows[1].Field)
+	})
 }
 
 func Test_ImportWrapperColumnBased_numpy(t *testing.T) {
@@ -398,6 +475,157 @@ func Test_ImportWrapperColumnBased_numpy(t *testing.T) {
 	})
 }
 
+func Test_ImportWrapperColumnBased_parquet(t *testing.T) {
+	err := os.MkdirAll(TempFilesPath, os.ModePerm)
+	assert.NoError(t, err)
+	defer os.RemoveAll(TempFilesPath)
+
+	f := storage.NewChunkManagerFactory("local", storage.RootPath(TempFilesPath))
+	ctx := context.Background()
+	cm, err := f.NewPersistentStorageChunkManager(ctx)
+	assert.NoError(t, err)
+	defer cm.RemoveWithPrefix(ctx, cm.RootPath())
+
+	idAllocator := newIDAllocator(ctx, t, nil)
+
+	rowCounter := &rowCounterTest{}
+	assignSegmentFunc, flushFunc, saveSegmentFunc := createMockCallbackFunctions(t, rowCounter)
+
+	importResult := &rootcoordpb.ImportResult{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		TaskId:     1,
+		DatanodeId: 1,
+		State:      commonpb.ImportState_ImportStarted,
+		Segments:   make([]int64, 0),
+		AutoIds:    make([]int64, 0),
+		RowCount:   0,
+	}
+	reportFunc := func(res *rootcoordpb.ImportResult) error {
+		return nil
+	}
+	collectionInfo, err := NewCollectionInfo(sampleSchema(), 2, []int64{1})
+	assert.NoError(t, err)
+
+	files := []string{path.Join(cm.RootPath(), "a.parquet"), path.Join(cm.RootPath(), "b.parquet")}
+	for _, filePath := range files {
+		err = cm.Write(ctx, filePath, createSampleParquetFile(t, 10))
+		assert.NoError(t, err)
+	}
+
+	t.Run("success case", func(t *testing.T) {
+		wrapper := NewImportWrapper(ctx, collectionInfo, 1, idAllocator, cm, importResult, reportFunc)
+		wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+
+		err = wrapper.Import(files, DefaultImportOptions())
+		assert.NoError(t, err)
+		assert.Equal(t, 20, rowCounter.rowCount)
+		assert.Equal(t, commonpb.ImportState_ImportPersisted, importResult.State)
+	})
+
+	t.Run("file doesn't exist", func(t *testing.T) {
+		importResult.State = commonpb.ImportState_ImportStarted
+		wrapper := NewImportWrapper(ctx, collectionInfo, 1, idAllocator, cm, importResult, reportFunc)
+		wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+
+		err = wrapper.Import([]string{"/dummy/dummy.parquet"}, DefaultImportOptions())
+		assert.Error(t, err)
+		assert.NotEqual(t, commonpb.ImportState_ImportPersisted, importResult.State)
+	})
+}
+
+func Test_ImportWrapperRegisterExternal(t *testing.T) {
+	err := os.MkdirAll(TempFilesPath, os.ModePerm)
+	assert.NoError(t, err)
+	defer os.RemoveAll(TempFilesPath)
+
+	f := storage.NewChunkManagerFactory("local", storage.RootPath(TempFilesPath))
+	ctx := context.Background()
+	cm, err := f.NewPersistentStorageChunkManager(ctx)
+	assert.NoError(t, err)
+	defer cm.RemoveWithPrefix(ctx, cm.RootPath())
+
+	idAllocator := newIDAllocator(ctx, t, nil)
+	rowCounter := &rowCounterTest{}
+	assignSegmentFunc, flushFunc, saveSegmentFunc := createMockCallbackFunctions(t, rowCounter)
+
+	importResult := &rootcoordpb.ImportResult{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		TaskId:     1,
+		DatanodeId: 1,
+		State:      commonpb.ImportState_ImportStarted,
+		Segments:   make([]int64, 0),
+		AutoIds:    make([]int64, 0),
+		RowCount:   0,
+	}
+	reportFunc := func(res *rootcoordpb.ImportResult) error {
+		return nil
+	}
+	collectionInfo, err := NewCollectionInfo(sampleSchema(), 2, []int64{1})
+	assert.NoError(t, err)
+
+	files := []string{path.Join(cm.RootPath(), "a.parquet"), path.Join(cm.RootPath(), "b.parquet")}
+	for _, filePath := range files {
+		err = cm.Write(ctx, filePath, createSampleParquetFile(t, 10))
+		assert.NoError(t, err)
+	}
+	options := DefaultImportOptions()
+	options.External = true
+
+	t.Run("success case", func(t *testing.T) {
+		registered := make(map[string]int64)
+		wrapper := NewImportWrapper(ctx, collectionInfo, 1, idAllocator, cm, importResult, reportFunc)
+		wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+		wrapper.SetRegisterExternalFunc(func(segmentID int64, targetChName string, rowCount int64, partID int64, source *datapb.ExternalSource) error {
+			assert.Equal(t, int64(1), partID)
+			assert.Equal(t, int32(2), source.GetShardNum())
+			assert.Len(t, source.GetFiles(), 1)
+			registered[source.GetFiles()[0]] += rowCount
+			return nil
+		})
+
+		err = wrapper.Import(files, options)
+		assert.NoError(t, err)
+		// the files are not converted into binlogs
+		assert.Equal(t, 0, rowCounter.rowCount)
+		assert.Equal(t, map[string]int64{files[0]: 10, files[1]: 10}, registered)
+		assert.Equal(t, []string{files[0]}, importResult.GetCompletedFiles())
+		assert.Equal(t, commonpb.ImportState_ImportPersisted, importResult.State)
+	})
+
+	t.Run("register error", func(t *testing.T) {
+		importResult.State = commonpb.ImportState_ImportStarted
+		wrapper := NewImportWrapper(ctx, collectionInfo, 1, idAllocator, cm, importResult, reportFunc)
+		wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+		err = wrapper.Import(files, options)
+		assert.Error(t, err)
+
+		wrapper.SetRegisterExternalFunc(func(segmentID int64, targetChName string, rowCount int64, partID int64, source *datapb.ExternalSource) error {
+			return errors.New("register error")
+		})
+		err = wrapper.Import(files, options)
+		assert.Error(t, err)
+		assert.NotEqual(t, commonpb.ImportState_ImportPersisted, importResult.State)
+	})
+
+	t.Run("unsupported collection", func(t *testing.T) {
+		schema := sampleSchema()
+		schema.Fields[4].AutoID = true
+		info, err := NewCollectionInfo(schema, 2, []int64{1})
+		assert.NoError(t, err)
+		wrapper := NewImportWrapper(ctx, info, 1, idAllocator, cm, importResult, reportFunc)
+		wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+		wrapper.SetRegisterExternalFunc(func(segmentID int64, targetChName string, rowCount int64, partID int64, source *datapb.ExternalSource) error {
+			return nil
+		})
+		err = wrapper.Import(files, options)
+		assert.Error(t, err)
+	})
+}
+
 func perfSchema(dim int) *schemapb.CollectionSchema {
 	schema := &schemapb.CollectionSchema{
 		Name:        "schema",
@@ -591,6 +819,16 @@ func Test_ImportWrapperFileValidation(t *testing.T) {
 		rowBased, err := wrapper.fileValidation(files)
 		assert.Error(t, err)
 		assert.False(t, rowBased)
+
+		files = []string{"a/uid.npy", "b/bol.parquet"}
+		rowBased, err = wrapper.fileValidation(files)
+		assert.Error(t, err)
+		assert.False(t, rowBased)
+
+		files = []string{"a/1.parquet", "b/bol.npy"}
+		rowBased, err = wrapper.fileValidation(files)
+		assert.Error(t, err)
+		assert.False(t, rowBased)
 	})
 
 	t.Run("valid cases", func(t *testing.T) {
@@ -603,6 +841,12 @@ func Test_ImportWrapperFileValidation(t *testing.T) {
 		rowBased, err = wrapper.fileValidation(files)
 		assert.NoError(t, err)
 		assert.False(t, rowBased)
+
+		files = []string{"a/1.parquet", "b/2.parquet"}
+		rowBased, err = wrapper.fileValidation(files)
+		assert.NoError(t, err)
+		assert.False(t, rowBased)
+		assert.True(t, wrapper.isParquetImport(files))
 	})
 
 	t.Run("empty file list", func(t *testing.T) {
@@ -941,6 +1185,73 @@ func Test_ImportWrapperReportPersisted(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func Test_ImportWrapperReportCheckpoint(t *testing.T) {
+	ctx := context.Background()
+
+	importResult := &rootcoordpb.ImportResult{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		TaskId:     1,
+		DatanodeId: 1,
+		State:      commonpb.ImportState_ImportStarted,
+		Segments:   make([]int64, 0),
+		AutoIds:    make([]int64, 0),
+		RowCount:   0,
+	}
+	reportCount := 0
+	reportFunc := func(res *rootcoordpb.ImportResult) error {
+		reportCount++
+		return nil
+	}
+	collectionInfo, err := NewCollectionInfo(sampleSchema(), 2, []int64{1})
+	assert.NoError(t, err)
+	wrapper := NewImportWrapper(ctx, collectionInfo, int64(1024), nil, nil, importResult, reportFunc)
+	assert.NotNil(t, wrapper)
+
+	rowCounter := &rowCounterTest{}
+	assignSegmentFunc, flushFunc, saveSegmentFunc := createMockCallbackFunctions(t, rowCounter)
+	err = wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	assert.NoError(t, err)
+
+	// the working segments are sealed and the file is reported as completed
+	wrapper.workingSegments[0] = map[int64]*WorkingSegment{
+		int64(1): {segmentID: 1, rowCount: 10},
+	}
+	err = wrapper.reportCheckpoint("f1.json", false)
+	assert.NoError(t, err)
+	assert.Empty(t, wrapper.workingSegments)
+	assert.Equal(t, []string{"f1.json"}, importResult.GetCompletedFiles())
+	assert.Equal(t, 1, reportCount)
+
+	// the last file is not reported
+	err = wrapper.reportCheckpoint("f2.json", true)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"f1.json"}, importResult.GetCompletedFiles())
+	assert.Equal(t, 1, reportCount)
+
+	// error when closing segments
+	wrapper.saveSegmentFunc = func(fieldsInsert []*datapb.FieldBinlog, fieldsStats []*datapb.FieldBinlog,
+		segmentID int64, targetChName string, rowCount int64, partID int64) error {
+		return errors.New("error")
+	}
+	wrapper.workingSegments[0] = map[int64]*WorkingSegment{
+		int64(1): {},
+	}
+	err = wrapper.reportCheckpoint("f2.json", false)
+	assert.Error(t, err)
+	assert.Equal(t, []string{"f1.json"}, importResult.GetCompletedFiles())
+
+	// failed to report
+	wrapper.saveSegmentFunc = saveSegmentFunc
+	wrapper.reportImportAttempts = 2
+	wrapper.reportFunc = func(res *rootcoordpb.ImportResult) error {
+		return errors.New("error")
+	}
+	err = wrapper.reportCheckpoint("f2.json", false)
+	assert.Error(t, err)
+}
+
 func Test_ImportWrapperUpdateProgressPercent(t *testing.T) {
 	ctx := context.Background()
 
@@ -33,11 +33,20 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
+// checkBlockRowCount is the max count of rows converted into the scratch block of JSONRowConsumer.CheckRow
+const checkBlockRowCount = 1024
+
 // JSONRowHandler is the interface to process rows data
 type JSONRowHandler interface {
 	Handle(rows []map[storage.FieldID]interface{}) error
 }
 
+// JSONRowChecker is implemented by the row handlers which are able to check a row before handling it,
+// the JSONParser uses it to reject the illegal rows instead of failing the whole file
+type JSONRowChecker interface {
+	CheckRow(row map[storage.FieldID]interface{}) error
+}
+
 func getKeyValue(obj interface{}, fieldName string, isString bool) (string, error) {
 	// varchar type primary field, the value must be a string
 	if isString {
@@ -64,6 +73,8 @@ type JSONRowConsumer struct {
 	shardsData     []ShardData                    // in-memory shards data
 	blockSize      int64                          // maximum size of a read block(unit:byte)
 	autoIDRange    []int64                        // auto-generated id range, for example: [1, 10, 20, 25] means id from 1 to 10 and 20 to 25
+	checkBlock     BlockData                      // scratch block to check rows, the converted values are dropped
+	checkedRows    int64                          // how many rows have been converted into the scratch block
 
 	callFlushFunc ImportFlushFunc // call back function to flush segment
 }
@@ -122,6 +133,30 @@ func (v *JSONRowConsumer) RowCount() int64 {
 	return v.rowCounter
 }
 
+// CheckRow converts the row values into a scratch block to find out the illegal values before the row is handled,
+// since a row failing halfway in Handle leaves partial values in the shards data
+func (v *JSONRowConsumer) CheckRow(row map[storage.FieldID]interface{}) error {
+	// renew the scratch block periodically to release the dropped values
+	if v.checkBlock == nil || v.checkedRows >= int64(checkBlockRowCount) {
+		v.checkBlock = initBlockData(v.collectionInfo.Schema)
+		if v.checkBlock == nil {
+			return errors.New("fail to initialize the block data to check rows")
+		}
+		v.checkedRows = 0
+	}
+	v.checkedRows++
+
+	for fieldID, validator := range v.validators {
+		if validator.primaryKey && validator.autoID {
+			continue
+		}
+		if err := validator.convertFunc(row[fieldID], v.checkBlock[fieldID]); err != nil {
+			return newFieldError(validator.fieldName, err)
+		}
+	}
+	return nil
+}
+
 func (v *JSONRowConsumer) Handle(rows []map[storage.FieldID]interface{}) error {
 	if v == nil || v.validators == nil || len(v.validators) == 0 {
 		log.Warn("JSON row consumer is not initialized")
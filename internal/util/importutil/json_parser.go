@@ -48,6 +48,8 @@ type JSONParser struct {
 	collectionInfo     *CollectionInfo     // collection details including schema
 	bufRowCount        int                 // max rows in a buffer
 	updateProgressFunc func(percent int64) // update working progress percent value
+	errorReport        *ErrorReport        // collect the illegal rows instead of failing the file, nil to fail fast
+	filePath           string              // file path recorded in the error report
 }
 
 // NewJSONParser helper function to create a JSONParser
@@ -63,6 +65,12 @@ func NewJSONParser(ctx context.Context, collectionInfo *CollectionInfo, updatePr
 	return parser
 }
 
+// SetErrorReport makes the parser reject the illegal rows of the file into the error report
+func (p *JSONParser) SetErrorReport(report *ErrorReport, filePath string) {
+	p.errorReport = report
+	p.filePath = filePath
+}
+
 func adjustBufSize(parser *JSONParser, collectionSchema *schemapb.CollectionSchema) {
 	sizePerRecord, _ := typeutil.EstimateSizePerRecord(collectionSchema)
 	if sizePerRecord <= 0 {
@@ -155,7 +163,7 @@ func (p *JSONParser) verifyRow(raw interface{}) (map[storage.FieldID]interface{}
 		if (fieldID == p.collectionInfo.PrimaryKey.GetFieldID()) && p.collectionInfo.PrimaryKey.GetAutoID() {
 			// primary key is auto-id, no need to provide
 			log.Warn("JSON parser: the primary key is auto-generated, no need to provide", zap.String("fieldName", k))
-			return nil, fmt.Errorf("the primary key '%s' is auto-generated, no need to provide", k)
+			return nil, newFieldError(k, fmt.Errorf("the primary key '%s' is auto-generated, no need to provide", k))
 		}
 
 		if ok {
@@ -166,7 +174,7 @@ func (p *JSONParser) verifyRow(raw interface{}) (map[storage.FieldID]interface{}
 		} else {
 			// no dynamic field. if user provided redundant field, return error
 			log.Warn("JSON parser: the field is not defined in collection schema", zap.String("fieldName", k))
-			return nil, fmt.Errorf("the field '%s' is not defined in collection schema", k)
+			return nil, newFieldError(k, fmt.Errorf("the field '%s' is not defined in collection schema", k))
 		}
 	}
 
@@ -187,7 +195,7 @@ func (p *JSONParser) verifyRow(raw interface{}) (map[storage.FieldID]interface{}
 			if !ok {
 				// not auto-id primary key, no dynamic field,  must provide value
 				log.Warn("JSON parser: a field value is missed", zap.String("fieldName", k))
-				return nil, fmt.Errorf("value of field '%s' is missed", k)
+				return nil, newFieldError(k, fmt.Errorf("value of field '%s' is missed", k))
 			}
 		}
 	}
@@ -208,7 +216,14 @@ func (p *JSONParser) ParseRows(reader *IOReader, handler JSONRowHandler) error {
 		return errors.New("JSON parse handler is nil")
 	}
 
-	dec := json.NewDecoder(reader.r)
+	// count lines only when the illegal rows are rejected, the line numbers are recorded in the error report
+	var counter *lineCounter
+	input := reader.r
+	if p.errorReport != nil {
+		counter = &lineCounter{r: reader.r}
+		input = counter
+	}
+	dec := json.NewDecoder(input)
 
 	oldPercent := int64(0)
 	updateProgress := func() {
@@ -268,16 +283,36 @@ func (p *JSONParser) ParseRows(reader *IOReader, handler JSONRowHandler) error {
 
 		// read buffer
 		buf := make([]map[storage.FieldID]interface{}, 0, p.bufRowCount)
+		rowIndex := int64(-1)
 		for dec.More() {
 			var value interface{}
 			if err := dec.Decode(&value); err != nil {
 				log.Warn("JSON parser: failed to parse row value", zap.Error(err))
 				return fmt.Errorf("failed to parse row value, error: %w", err)
 			}
+			rowIndex++
 
 			row, err := p.verifyRow(value)
+			if err == nil && p.errorReport != nil {
+				if checker, ok := handler.(JSONRowChecker); ok {
+					err = checker.CheckRow(row)
+				}
+			}
 			if err != nil {
-				return err
+				if p.errorReport == nil {
+					return err
+				}
+				if err = p.errorReport.Reject(&RejectedRow{
+					File:   p.filePath,
+					Line:   counter.lineAt(dec.InputOffset()),
+					Row:    rowIndex,
+					Field:  errorField(err),
+					Reason: err.Error(),
+				}); err != nil {
+					log.Warn("JSON parser: too many rejected rows", zap.Error(err))
+					return err
+				}
+				continue
 			}
 
 			updateProgress()
@@ -651,3 +651,61 @@ func Test_JSONParserVerifyRow(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func Test_JSONParserParseRows_RejectRows(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	schema := &schemapb.CollectionSchema{
+		Name:        "schema",
+		Description: "schema",
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:      106,
+				Name:         "FieldID",
+				IsPrimaryKey: true,
+				AutoID:       false,
+				DataType:     schemapb.DataType_Int64,
+			},
+		},
+	}
+	collectionInfo, err := NewCollectionInfo(schema, 2, []int64{1})
+	assert.NoError(t, err)
+
+	content := "{\n\"rows\": [\n{\"FieldID\": 1},\n{\"FieldID\": 2, \"FieldX\": 2},\n{\n\"FieldID\": 3\n},\n{\"FieldY\": 4}\n]\n}"
+
+	t.Run("reject rows", func(t *testing.T) {
+		parser := NewJSONParser(ctx, collectionInfo, nil)
+		report := NewErrorReport(2)
+		parser.SetErrorReport(report, "rows.json")
+		consumer := &mockJSONRowConsumer{}
+		err = parser.ParseRows(&IOReader{r: strings.NewReader(content), fileSize: int64(len(content))}, consumer)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(consumer.rows))
+
+		assert.Equal(t, int64(2), report.Count())
+		assert.Equal(t, RejectedRow{
+			File:   "rows.json",
+			Line:   4,
+			Row:    1,
+			Field:  "FieldX",
+			Reason: "the field 'FieldX' is not defined in collection schema",
+		}, *report.Rows()[0])
+		assert.Equal(t, int64(8), report.Rows()[1].Line)
+		assert.Equal(t, int64(3), report.Rows()[1].Row)
+		assert.Equal(t, "FieldY", report.Rows()[1].Field)
+	})
+
+	t.Run("too many rejected rows", func(t *testing.T) {
+		parser := NewJSONParser(ctx, collectionInfo, nil)
+		parser.SetErrorReport(NewErrorReport(1), "rows.json")
+		err = parser.ParseRows(&IOReader{r: strings.NewReader(content), fileSize: int64(len(content))}, &mockJSONRowConsumer{})
+		assert.Error(t, err)
+	})
+
+	t.Run("fail fast", func(t *testing.T) {
+		parser := NewJSONParser(ctx, collectionInfo, nil)
+		err = parser.ParseRows(&IOReader{r: strings.NewReader(content), fileSize: int64(len(content))}, &mockJSONRowConsumer{})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,520 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/apache/arrow/go/v8/arrow"
+	"github.com/apache/arrow/go/v8/arrow/array"
+	"github.com/apache/arrow/go/v8/arrow/memory"
+	"github.com/apache/arrow/go/v8/parquet/file"
+	"github.com/apache/arrow/go/v8/parquet/pqarrow"
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/allocator"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/timerecord"
+)
+
+// ParquetParser imports a parquet file which contains all the fields of a collection.
+// The file is read from the storage by ranges, only the footer and the column chunks of the row group
+// being parsed are fetched. Each column of a record batch is converted into a storage.FieldData directly,
+// no row-based intermediate data is generated. Like the other bulk insert parsers, the rows are written
+// into binlogs of new segments, the parquet file is not referenced after the import. Unless the file is
+// registered as external segments, then only the primary keys are read to count the rows of each shard,
+// and the file is parsed again to convert the segments into binlogs later.
+type ParquetParser struct {
+	ctx                context.Context      // for canceling parse process
+	collectionInfo     *CollectionInfo      // collection details including schema
+	blockSize          int64                // maximum size of a read block(unit:byte)
+	chunkManager       storage.ChunkManager // storage interfaces to browse/read the files
+	callFlushFunc      ImportFlushFunc      // call back function to flush segment
+	updateProgressFunc func(percent int64)  // update working progress percent value
+
+	// the column-based parser shares the same logic to split rows into shards and generate auto-id
+	columnParser *NumpyParser
+}
+
+// NewParquetParser is helper function to create a ParquetParser
+func NewParquetParser(ctx context.Context,
+	collectionInfo *CollectionInfo,
+	idAlloc *allocator.IDAllocator,
+	blockSize int64,
+	chunkManager storage.ChunkManager,
+	flushFunc ImportFlushFunc,
+	updateProgressFunc func(percent int64)) (*ParquetParser, error) {
+	columnParser, err := NewNumpyParser(ctx, collectionInfo, idAlloc, blockSize, chunkManager, flushFunc, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	parser := &ParquetParser{
+		ctx:                ctx,
+		collectionInfo:     collectionInfo,
+		blockSize:          blockSize,
+		chunkManager:       chunkManager,
+		callFlushFunc:      flushFunc,
+		updateProgressFunc: updateProgressFunc,
+		columnParser:       columnParser,
+	}
+
+	return parser, nil
+}
+
+func (p *ParquetParser) IDRange() []int64 {
+	return p.columnParser.IDRange()
+}
+
+// Parse is the function entry
+func (p *ParquetParser) Parse(filePath string) error {
+	rowCountPerBlock, err := p.columnParser.calcRowCountPerBlock()
+	if err != nil {
+		return err
+	}
+
+	pqReader, arrowReader, columnIndices, columnFields, err := p.open(filePath, rowCountPerBlock)
+	if err != nil {
+		return err
+	}
+	defer pqReader.Close()
+
+	recordReader, err := arrowReader.GetRecordReader(p.ctx, columnIndices, nil)
+	if err != nil {
+		log.Warn("Parquet parser: failed to create record reader", zap.String("filePath", filePath), zap.Error(err))
+		return fmt.Errorf("failed to create record reader for file '%s', error: %w", filePath, err)
+	}
+	defer recordReader.Release()
+
+	return p.consume(recordReader, columnFields, pqReader.NumRows())
+}
+
+// CountShardRows counts the rows of the file hashed to each shard, only the primary key column is read.
+// The columns of the file are validated against the collection schema as Parse does.
+func (p *ParquetParser) CountShardRows(filePath string) ([]int64, error) {
+	if p.collectionInfo.PrimaryKey.GetAutoID() {
+		log.Warn("Parquet parser: the rows can't be counted by shard since the primary key is auto-generated")
+		return nil, fmt.Errorf("the rows of file '%s' can't be counted by shard since the primary key '%s' is auto-generated",
+			filePath, p.collectionInfo.PrimaryKey.GetName())
+	}
+
+	rowCountPerBlock, err := p.columnParser.calcRowCountPerBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	pqReader, arrowReader, columnIndices, columnFields, err := p.open(filePath, rowCountPerBlock)
+	if err != nil {
+		return nil, err
+	}
+	defer pqReader.Close()
+
+	shardNum := p.collectionInfo.ShardNum
+	counts := make([]int64, shardNum)
+	if shardNum == 1 {
+		counts[0] = pqReader.NumRows()
+		return counts, nil
+	}
+
+	pkIndex := -1
+	for i, schema := range columnFields {
+		if schema.GetIsPrimaryKey() {
+			pkIndex = columnIndices[i]
+		}
+	}
+	if pkIndex < 0 {
+		// mapColumns ensures the column of the primary key is provided
+		return nil, fmt.Errorf("the column of primary key '%s' is not found", p.collectionInfo.PrimaryKey.GetName())
+	}
+
+	recordReader, err := arrowReader.GetRecordReader(p.ctx, []int{pkIndex}, nil)
+	if err != nil {
+		log.Warn("Parquet parser: failed to create record reader", zap.String("filePath", filePath), zap.Error(err))
+		return nil, fmt.Errorf("failed to create record reader for file '%s', error: %w", filePath, err)
+	}
+	defer recordReader.Release()
+
+	for recordReader.Next() {
+		if isCanceled(p.ctx) {
+			log.Warn("Parquet parser: import task was canceled")
+			return nil, errors.New("import task was canceled")
+		}
+
+		pkData, err := convertArrowArray(recordReader.Record().Column(0), p.collectionInfo.PrimaryKey)
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < pkData.RowNum(); i++ {
+			shard, err := pkToShard(pkData.GetRow(i), uint32(shardNum))
+			if err != nil {
+				return nil, err
+			}
+			counts[shard]++
+		}
+	}
+
+	return counts, nil
+}
+
+// open opens the parquet file and maps its columns to the fields, the returned file reader must be closed by the caller
+func (p *ParquetParser) open(filePath string, batchSize int64) (*file.Reader, *pqarrow.FileReader, []int, []*schemapb.FieldSchema, error) {
+	size, err := p.chunkManager.Size(p.ctx, filePath)
+	if err != nil {
+		log.Warn("Parquet parser: failed to get the file size", zap.String("filePath", filePath), zap.Error(err))
+		return nil, nil, nil, nil, fmt.Errorf("failed to get the size of file '%s', error: %w", filePath, err)
+	}
+
+	fileReader := &parquetFileReader{
+		ctx:          p.ctx,
+		chunkManager: p.chunkManager,
+		filePath:     filePath,
+		size:         size,
+	}
+	pqReader, err := file.NewParquetReader(fileReader)
+	if err != nil {
+		log.Warn("Parquet parser: failed to open the file", zap.String("filePath", filePath), zap.Error(err))
+		return nil, nil, nil, nil, fmt.Errorf("failed to open the parquet file '%s', error: %w", filePath, err)
+	}
+
+	arrowReader, err := pqarrow.NewFileReader(pqReader, pqarrow.ArrowReadProperties{BatchSize: batchSize}, memory.DefaultAllocator)
+	if err != nil {
+		pqReader.Close()
+		log.Warn("Parquet parser: failed to create arrow reader", zap.String("filePath", filePath), zap.Error(err))
+		return nil, nil, nil, nil, fmt.Errorf("failed to create arrow reader for file '%s', error: %w", filePath, err)
+	}
+
+	arrowSchema, err := arrowReader.Schema()
+	if err != nil {
+		pqReader.Close()
+		log.Warn("Parquet parser: failed to get file schema", zap.String("filePath", filePath), zap.Error(err))
+		return nil, nil, nil, nil, fmt.Errorf("failed to get schema of file '%s', error: %w", filePath, err)
+	}
+
+	columnIndices, columnFields, err := p.mapColumns(arrowSchema)
+	if err != nil {
+		pqReader.Close()
+		return nil, nil, nil, nil, err
+	}
+
+	return pqReader, arrowReader, columnIndices, columnFields, nil
+}
+
+// parquetFileReader implements parquet.ReaderAtSeeker on a file of the ChunkManager,
+// each ReadAt fetches the requested range only
+type parquetFileReader struct {
+	ctx          context.Context
+	chunkManager storage.ChunkManager
+	filePath     string
+	size         int64
+	offset       int64
+}
+
+func (r *parquetFileReader) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, fmt.Errorf("negative offset %d to read the file '%s'", off, r.filePath)
+	}
+	if off >= r.size {
+		return 0, io.EOF
+	}
+	length := int64(len(p))
+	if off+length > r.size {
+		length = r.size - off
+	}
+	if length == 0 {
+		return 0, nil
+	}
+
+	data, err := r.chunkManager.ReadAt(r.ctx, r.filePath, off, length)
+	if err != nil {
+		log.Warn("Parquet parser: failed to read the file", zap.String("filePath", r.filePath),
+			zap.Int64("offset", off), zap.Int64("length", length), zap.Error(err))
+		return 0, fmt.Errorf("failed to read the file '%s', error: %w", r.filePath, err)
+	}
+	n := copy(p, data)
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+func (r *parquetFileReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.offset
+	case io.SeekEnd:
+		offset += r.size
+	default:
+		return 0, fmt.Errorf("invalid whence %d to seek the file '%s'", whence, r.filePath)
+	}
+	if offset < 0 {
+		return 0, fmt.Errorf("negative position %d to seek the file '%s'", offset, r.filePath)
+	}
+	r.offset = offset
+	return offset, nil
+}
+
+// mapColumns finds the parquet column for each field of the collection schema
+// the auto-id primary key must not be provided, any redundant column is not allowed
+func (p *ParquetParser) mapColumns(arrowSchema *arrow.Schema) ([]int, []*schemapb.FieldSchema, error) {
+	name2Field := make(map[string]*schemapb.FieldSchema)
+	for _, schema := range p.collectionInfo.Schema.GetFields() {
+		name2Field[schema.GetName()] = schema
+	}
+
+	columnIndices := make([]int, 0, len(arrowSchema.Fields()))
+	columnFields := make([]*schemapb.FieldSchema, 0, len(arrowSchema.Fields()))
+	provided := make(map[string]struct{})
+	for i, arrowField := range arrowSchema.Fields() {
+		schema, ok := name2Field[arrowField.Name]
+		if !ok {
+			log.Warn("Parquet parser: the column is not found in collection schema", zap.String("columnName", arrowField.Name))
+			return nil, nil, fmt.Errorf("the column '%s' is not found in collection schema", arrowField.Name)
+		}
+		if schema.GetIsPrimaryKey() && schema.GetAutoID() {
+			log.Warn("Parquet parser: the primary key is auto-generated, no need to provide", zap.String("columnName", arrowField.Name))
+			return nil, nil, fmt.Errorf("the primary key '%s' is auto-generated, no need to provide", arrowField.Name)
+		}
+		columnIndices = append(columnIndices, i)
+		columnFields = append(columnFields, schema)
+		provided[arrowField.Name] = struct{}{}
+	}
+
+	for _, schema := range p.collectionInfo.Schema.GetFields() {
+		_, ok := provided[schema.GetName()]
+		if !ok && !(schema.GetIsPrimaryKey() && schema.GetAutoID()) && !schema.GetIsDynamic() {
+			log.Warn("Parquet parser: the column of field is not provided", zap.String("fieldName", schema.GetName()))
+			return nil, nil, fmt.Errorf("the column of field '%s' is not provided", schema.GetName())
+		}
+	}
+
+	return columnIndices, columnFields, nil
+}
+
+// consume reads the file batch by batch, splits the rows into shards and flushes the shards when they are big enough
+func (p *ParquetParser) consume(recordReader pqarrow.RecordReader, columnFields []*schemapb.FieldSchema, totalRows int64) error {
+	updateProgress := func(readRowCount int64) {
+		if p.updateProgressFunc != nil && totalRows > 0 {
+			percent := (readRowCount * ProgressValueForPersist) / totalRows
+			log.Debug("Parquet parser: working progress", zap.Int64("readRowCount", readRowCount),
+				zap.Int64("totalRowCount", totalRows), zap.Int64("percent", percent))
+			p.updateProgressFunc(percent)
+		}
+	}
+
+	// prepare shards
+	shards := make([]ShardData, 0, p.collectionInfo.ShardNum)
+	for i := 0; i < int(p.collectionInfo.ShardNum); i++ {
+		shardData := initShardData(p.collectionInfo.Schema, p.collectionInfo.PartitionIDs)
+		if shardData == nil {
+			log.Warn("Parquet parser: failed to initialize FieldData list")
+			return fmt.Errorf("failed to initialize FieldData list")
+		}
+		shards = append(shards, shardData)
+	}
+
+	tr := timerecord.NewTimeRecorder("consume performance")
+	defer tr.Elapse("end")
+	totalRead := int64(0)
+	for recordReader.Next() {
+		if isCanceled(p.ctx) {
+			log.Warn("Parquet parser: import task was canceled")
+			return errors.New("import task was canceled")
+		}
+
+		record := recordReader.Record()
+		segmentData := make(BlockData)
+		for i, schema := range columnFields {
+			fieldData, err := convertArrowArray(record.Column(i), schema)
+			if err != nil {
+				return err
+			}
+			segmentData[schema.GetFieldID()] = fieldData
+		}
+
+		totalRead += record.NumRows()
+		updateProgress(totalRead)
+		tr.Record("readData")
+		// split data to shards
+		err := p.columnParser.splitFieldsData(segmentData, shards)
+		if err != nil {
+			return err
+		}
+		tr.Record("splitFieldsData")
+		// when the estimated size is close to blockSize, save to binlog
+		err = tryFlushBlocks(p.ctx, shards, p.collectionInfo.Schema, p.callFlushFunc, p.blockSize, MaxTotalSizeInMemory, false)
+		if err != nil {
+			return err
+		}
+		tr.Record("tryFlushBlocks")
+	}
+
+	// force flush at the end
+	return tryFlushBlocks(p.ctx, shards, p.collectionInfo.Schema, p.callFlushFunc, p.blockSize, MaxTotalSizeInMemory, true)
+}
+
+// convertArrowArray converts a column of arrow record into storage.FieldData according to the field schema
+func convertArrowArray(column arrow.Array, schema *schemapb.FieldSchema) (storage.FieldData, error) {
+	if column.NullN() > 0 {
+		log.Warn("Parquet parser: null value is not supported", zap.String("fieldName", schema.GetName()))
+		return nil, fmt.Errorf("the column of field '%s' contains null value, which is not supported", schema.GetName())
+	}
+
+	typeErr := func() error {
+		log.Warn("Parquet parser: column type mismatch", zap.String("fieldName", schema.GetName()),
+			zap.String("columnType", column.DataType().Name()), zap.String("fieldType", getTypeName(schema.GetDataType())))
+		return fmt.Errorf("the column type '%s' doesn't match the type '%s' of field '%s'",
+			column.DataType().Name(), getTypeName(schema.GetDataType()), schema.GetName())
+	}
+
+	switch schema.GetDataType() {
+	case schemapb.DataType_Bool:
+		arr, ok := column.(*array.Boolean)
+		if !ok {
+			return nil, typeErr()
+		}
+		data := make([]bool, arr.Len())
+		for i := range data {
+			data[i] = arr.Value(i)
+		}
+		return &storage.BoolFieldData{Data: data}, nil
+	case schemapb.DataType_Int8:
+		arr, ok := column.(*array.Int8)
+		if !ok {
+			return nil, typeErr()
+		}
+		return &storage.Int8FieldData{Data: append([]int8{}, arr.Int8Values()...)}, nil
+	case schemapb.DataType_Int16:
+		arr, ok := column.(*array.Int16)
+		if !ok {
+			return nil, typeErr()
+		}
+		return &storage.Int16FieldData{Data: append([]int16{}, arr.Int16Values()...)}, nil
+	case schemapb.DataType_Int32:
+		arr, ok := column.(*array.Int32)
+		if !ok {
+			return nil, typeErr()
+		}
+		return &storage.Int32FieldData{Data: append([]int32{}, arr.Int32Values()...)}, nil
+	case schemapb.DataType_Int64:
+		arr, ok := column.(*array.Int64)
+		if !ok {
+			return nil, typeErr()
+		}
+		return &storage.Int64FieldData{Data: append([]int64{}, arr.Int64Values()...)}, nil
+	case schemapb.DataType_Float:
+		arr, ok := column.(*array.Float32)
+		if !ok {
+			return nil, typeErr()
+		}
+		return &storage.FloatFieldData{Data: append([]float32{}, arr.Float32Values()...)}, nil
+	case schemapb.DataType_Double:
+		arr, ok := column.(*array.Float64)
+		if !ok {
+			return nil, typeErr()
+		}
+		return &storage.DoubleFieldData{Data: append([]float64{}, arr.Float64Values()...)}, nil
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		arr, ok := column.(*array.String)
+		if !ok {
+			return nil, typeErr()
+		}
+		data := make([]string, arr.Len())
+		for i := range data {
+			data[i] = arr.Value(i)
+		}
+		return &storage.StringFieldData{Data: data}, nil
+	case schemapb.DataType_JSON:
+		data := make([][]byte, column.Len())
+		switch arr := column.(type) {
+		case *array.String:
+			for i := range data {
+				data[i] = []byte(arr.Value(i))
+			}
+		case *array.Binary:
+			for i := range data {
+				data[i] = append([]byte{}, arr.Value(i)...)
+			}
+		default:
+			return nil, typeErr()
+		}
+		return &storage.JSONFieldData{Data: data}, nil
+	case schemapb.DataType_FloatVector:
+		dim, err := getFieldDimension(schema)
+		if err != nil {
+			return nil, err
+		}
+		arr, ok := column.(*array.List)
+		if !ok {
+			return nil, typeErr()
+		}
+		values, ok := arr.ListValues().(*array.Float32)
+		if !ok {
+			return nil, typeErr()
+		}
+		offsets := arr.Offsets()
+		data := make([]float32, 0, arr.Len()*dim)
+		for i := 0; i < arr.Len(); i++ {
+			start, end := int(offsets[i]), int(offsets[i+1])
+			if end-start != dim {
+				log.Warn("Parquet parser: vector dimension mismatch", zap.String("fieldName", schema.GetName()),
+					zap.Int("dim", end-start), zap.Int("expectedDim", dim))
+				return nil, fmt.Errorf("the dimension %d of row %d doesn't equal to the dimension %d of field '%s'",
+					end-start, i, dim, schema.GetName())
+			}
+			data = append(data, values.Float32Values()[start:end]...)
+		}
+		return &storage.FloatVectorFieldData{Data: data, Dim: dim}, nil
+	case schemapb.DataType_BinaryVector:
+		dim, err := getFieldDimension(schema)
+		if err != nil {
+			return nil, err
+		}
+		byteLen := dim / 8
+		data := make([]byte, 0, column.Len()*byteLen)
+		switch arr := column.(type) {
+		case *array.Binary:
+			for i := 0; i < arr.Len(); i++ {
+				if len(arr.Value(i)) != byteLen {
+					return nil, fmt.Errorf("the byte length %d of row %d doesn't match the dimension %d of field '%s'",
+						len(arr.Value(i)), i, dim, schema.GetName())
+				}
+				data = append(data, arr.Value(i)...)
+			}
+		case *array.FixedSizeBinary:
+			for i := 0; i < arr.Len(); i++ {
+				if len(arr.Value(i)) != byteLen {
+					return nil, fmt.Errorf("the byte length %d of row %d doesn't match the dimension %d of field '%s'",
+						len(arr.Value(i)), i, dim, schema.GetName())
+				}
+				data = append(data, arr.Value(i)...)
+			}
+		default:
+			return nil, typeErr()
+		}
+		return &storage.BinaryVectorFieldData{Data: data, Dim: dim}, nil
+	default:
+		log.Warn("Parquet parser: unsupported data type", zap.String("fieldName", schema.GetName()),
+			zap.String("dataType", getTypeName(schema.GetDataType())))
+		return nil, fmt.Errorf("unsupported data type %s of field '%s'", getTypeName(schema.GetDataType()), schema.GetName())
+	}
+}
@@ -0,0 +1,389 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/apache/arrow/go/v8/arrow"
+	"github.com/apache/arrow/go/v8/arrow/array"
+	"github.com/apache/arrow/go/v8/arrow/memory"
+	"github.com/apache/arrow/go/v8/parquet/pqarrow"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+)
+
+// createSampleParquetFile writes a parquet file for sampleSchema(), the columns can be skipped by name
+func createSampleParquetFile(t *testing.T, rowCount int, skipColumns ...string) []byte {
+	skip := make(map[string]struct{})
+	for _, name := range skipColumns {
+		skip[name] = struct{}{}
+	}
+
+	mem := memory.NewGoAllocator()
+	fields := make([]arrow.Field, 0)
+	columns := make([]arrow.Array, 0)
+	addColumn := func(name string, dataType arrow.DataType, arr arrow.Array) {
+		if _, ok := skip[name]; ok {
+			return
+		}
+		fields = append(fields, arrow.Field{Name: name, Type: dataType})
+		columns = append(columns, arr)
+	}
+
+	boolBuilder := array.NewBooleanBuilder(mem)
+	int8Builder := array.NewInt8Builder(mem)
+	int16Builder := array.NewInt16Builder(mem)
+	int32Builder := array.NewInt32Builder(mem)
+	int64Builder := array.NewInt64Builder(mem)
+	floatBuilder := array.NewFloat32Builder(mem)
+	doubleBuilder := array.NewFloat64Builder(mem)
+	stringBuilder := array.NewStringBuilder(mem)
+	jsonBuilder := array.NewStringBuilder(mem)
+	binaryBuilder := array.NewBinaryBuilder(mem, arrow.BinaryTypes.Binary)
+	vectorBuilder := array.NewListBuilder(mem, arrow.PrimitiveTypes.Float32)
+	vectorValueBuilder := vectorBuilder.ValueBuilder().(*array.Float32Builder)
+	for i := 0; i < rowCount; i++ {
+		boolBuilder.Append(i%2 == 0)
+		int8Builder.Append(int8(i))
+		int16Builder.Append(int16(i))
+		int32Builder.Append(int32(i))
+		int64Builder.Append(int64(i))
+		floatBuilder.Append(float32(i) / 2)
+		doubleBuilder.Append(float64(i) / 3)
+		stringBuilder.Append(fmt.Sprintf("str_%d", i))
+		jsonBuilder.Append(fmt.Sprintf("{\"id\": %d}", i))
+		binaryBuilder.Append([]byte{byte(i), byte(i + 1)})
+		vectorBuilder.Append(true)
+		vectorValueBuilder.AppendValues([]float32{float32(i), 0.1, 0.2, 0.3}, nil)
+	}
+
+	addColumn("FieldBool", arrow.FixedWidthTypes.Boolean, boolBuilder.NewArray())
+	addColumn("FieldInt8", arrow.PrimitiveTypes.Int8, int8Builder.NewArray())
+	addColumn("FieldInt16", arrow.PrimitiveTypes.Int16, int16Builder.NewArray())
+	addColumn("FieldInt32", arrow.PrimitiveTypes.Int32, int32Builder.NewArray())
+	addColumn("FieldInt64", arrow.PrimitiveTypes.Int64, int64Builder.NewArray())
+	addColumn("FieldFloat", arrow.PrimitiveTypes.Float32, floatBuilder.NewArray())
+	addColumn("FieldDouble", arrow.PrimitiveTypes.Float64, doubleBuilder.NewArray())
+	addColumn("FieldString", arrow.BinaryTypes.String, stringBuilder.NewArray())
+	addColumn("FieldBinaryVector", arrow.BinaryTypes.Binary, binaryBuilder.NewArray())
+	addColumn("FieldFloatVector", arrow.ListOf(arrow.PrimitiveTypes.Float32), vectorBuilder.NewArray())
+	addColumn("FieldJSON", arrow.BinaryTypes.String, jsonBuilder.NewArray())
+
+	arrowSchema := arrow.NewSchema(fields, nil)
+	record := array.NewRecord(arrowSchema, columns, int64(rowCount))
+	defer record.Release()
+	table := array.NewTableFromRecords(arrowSchema, []arrow.Record{record})
+	defer table.Release()
+
+	buf := new(bytes.Buffer)
+	err := pqarrow.WriteTable(table, buf, 1024, nil, pqarrow.DefaultWriterProps())
+	assert.NoError(t, err)
+	return buf.Bytes()
+}
+
+func newParquetChunkManager(filePath string, content []byte) *MockChunkManager {
+	return &MockChunkManager{
+		readBuf: map[string][]byte{filePath: content},
+		size:    int64(len(content)),
+	}
+}
+
+func createParquetParser(t *testing.T, schema *schemapb.CollectionSchema, cm *MockChunkManager, flushFunc ImportFlushFunc) *ParquetParser {
+	ctx := context.Background()
+	idAllocator := newIDAllocator(ctx, t, nil)
+	collectionInfo, err := NewCollectionInfo(schema, 2, []int64{1})
+	assert.NoError(t, err)
+	parser, err := NewParquetParser(ctx, collectionInfo, idAllocator, 1024, cm, flushFunc, nil)
+	assert.NoError(t, err)
+	assert.NotNil(t, parser)
+	return parser
+}
+
+func Test_NewParquetParser(t *testing.T) {
+	ctx := context.Background()
+
+	parser, err := NewParquetParser(ctx, nil, nil, 100, nil, nil, nil)
+	assert.Error(t, err)
+	assert.Nil(t, parser)
+
+	collectionInfo, err := NewCollectionInfo(sampleSchema(), 2, []int64{1})
+	assert.NoError(t, err)
+	parser, err = NewParquetParser(ctx, collectionInfo, newIDAllocator(ctx, t, nil), 100, &MockChunkManager{},
+		func(fields BlockData, shardID int, partID int64) error { return nil }, nil)
+	assert.NoError(t, err)
+	assert.NotNil(t, parser)
+}
+
+func Test_ParquetParserParse(t *testing.T) {
+	rowCount := 100
+	filePath := "sample.parquet"
+
+	t.Run("parse all fields", func(t *testing.T) {
+		cm := newParquetChunkManager(filePath, createSampleParquetFile(t, rowCount))
+		flushedRows := 0
+		flushFunc := func(fields BlockData, shardID int, partID int64) error {
+			assert.Equal(t, int64(1), partID)
+			flushedRows += fields[106].RowNum()
+			for _, fieldData := range fields {
+				assert.Equal(t, fields[106].RowNum(), fieldData.RowNum())
+			}
+			return nil
+		}
+		parser := createParquetParser(t, sampleSchema(), cm, flushFunc)
+		err := parser.Parse(filePath)
+		assert.NoError(t, err)
+		assert.Equal(t, rowCount, flushedRows)
+		assert.Empty(t, parser.IDRange())
+	})
+
+	t.Run("auto-id primary key", func(t *testing.T) {
+		cm := newParquetChunkManager(filePath, createSampleParquetFile(t, rowCount, "FieldInt64"))
+		schema := sampleSchema()
+		schema.Fields[4].AutoID = true
+		flushedRows := 0
+		flushFunc := func(fields BlockData, shardID int, partID int64) error {
+			flushedRows += fields[106].RowNum()
+			return nil
+		}
+		parser := createParquetParser(t, schema, cm, flushFunc)
+		err := parser.Parse(filePath)
+		assert.NoError(t, err)
+		assert.Equal(t, rowCount, flushedRows)
+		assert.NotEmpty(t, parser.IDRange())
+
+		// auto-id primary key must not be provided
+		cm = newParquetChunkManager(filePath, createSampleParquetFile(t, rowCount))
+		parser = createParquetParser(t, schema, cm, flushFunc)
+		err = parser.Parse(filePath)
+		assert.Error(t, err)
+	})
+
+	t.Run("missing or redundant column", func(t *testing.T) {
+		cm := newParquetChunkManager(filePath, createSampleParquetFile(t, rowCount, "FieldDouble"))
+		flushFunc := func(fields BlockData, shardID int, partID int64) error {
+			return nil
+		}
+		parser := createParquetParser(t, sampleSchema(), cm, flushFunc)
+		err := parser.Parse(filePath)
+		assert.Error(t, err)
+
+		schema := sampleSchema()
+		schema.Fields = schema.Fields[:len(schema.Fields)-1]
+		cm = newParquetChunkManager(filePath, createSampleParquetFile(t, rowCount))
+		parser = createParquetParser(t, schema, cm, flushFunc)
+		err = parser.Parse(filePath)
+		assert.Error(t, err)
+	})
+
+	t.Run("type mismatch", func(t *testing.T) {
+		cm := newParquetChunkManager(filePath, createSampleParquetFile(t, rowCount))
+		flushFunc := func(fields BlockData, shardID int, partID int64) error {
+			return nil
+		}
+		schema := sampleSchema()
+		schema.Fields[1].DataType = schemapb.DataType_Int32
+		parser := createParquetParser(t, schema, cm, flushFunc)
+		err := parser.Parse(filePath)
+		assert.Error(t, err)
+	})
+
+	t.Run("read error", func(t *testing.T) {
+		cm := &MockChunkManager{
+			sizeErr: fmt.Errorf("size error"),
+		}
+		flushFunc := func(fields BlockData, shardID int, partID int64) error {
+			return nil
+		}
+		parser := createParquetParser(t, sampleSchema(), cm, flushFunc)
+		err := parser.Parse(filePath)
+		assert.Error(t, err)
+
+		cm = newParquetChunkManager(filePath, createSampleParquetFile(t, rowCount))
+		cm.readErr = fmt.Errorf("read error")
+		parser = createParquetParser(t, sampleSchema(), cm, flushFunc)
+		err = parser.Parse(filePath)
+		assert.Error(t, err)
+
+		// not a parquet file
+		cm = newParquetChunkManager(filePath, []byte("dummy"))
+		parser = createParquetParser(t, sampleSchema(), cm, flushFunc)
+		err = parser.Parse(filePath)
+		assert.Error(t, err)
+	})
+
+	t.Run("flush error", func(t *testing.T) {
+		cm := newParquetChunkManager(filePath, createSampleParquetFile(t, rowCount))
+		flushFunc := func(fields BlockData, shardID int, partID int64) error {
+			return fmt.Errorf("flush error")
+		}
+		parser := createParquetParser(t, sampleSchema(), cm, flushFunc)
+		err := parser.Parse(filePath)
+		assert.Error(t, err)
+	})
+}
+
+func Test_ParquetParserCountShardRows(t *testing.T) {
+	rowCount := 100
+	filePath := "sample.parquet"
+	flushFunc := func(fields BlockData, shardID int, partID int64) error {
+		return fmt.Errorf("unexpected flush")
+	}
+
+	t.Run("count by shard", func(t *testing.T) {
+		cm := newParquetChunkManager(filePath, createSampleParquetFile(t, rowCount))
+		parser := createParquetParser(t, sampleSchema(), cm, flushFunc)
+		counts, err := parser.CountShardRows(filePath)
+		assert.NoError(t, err)
+		assert.Len(t, counts, 2)
+
+		// the rows are counted as Parse splits them
+		flushed := make([]int64, 2)
+		parser = createParquetParser(t, sampleSchema(), cm, func(fields BlockData, shardID int, partID int64) error {
+			flushed[shardID] += int64(fields[106].RowNum())
+			return nil
+		})
+		err = parser.Parse(filePath)
+		assert.NoError(t, err)
+		assert.Equal(t, flushed, counts)
+		assert.Equal(t, int64(rowCount), counts[0]+counts[1])
+	})
+
+	t.Run("single shard", func(t *testing.T) {
+		cm := newParquetChunkManager(filePath, createSampleParquetFile(t, rowCount))
+		collectionInfo, err := NewCollectionInfo(sampleSchema(), 1, []int64{1})
+		assert.NoError(t, err)
+		parser, err := NewParquetParser(context.Background(), collectionInfo, newIDAllocator(context.Background(), t, nil),
+			1024, cm, flushFunc, nil)
+		assert.NoError(t, err)
+		counts, err := parser.CountShardRows(filePath)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{int64(rowCount)}, counts)
+	})
+
+	t.Run("invalid file", func(t *testing.T) {
+		schema := sampleSchema()
+		schema.Fields[4].AutoID = true
+		cm := newParquetChunkManager(filePath, createSampleParquetFile(t, rowCount, "FieldInt64"))
+		parser := createParquetParser(t, schema, cm, flushFunc)
+		_, err := parser.CountShardRows(filePath)
+		assert.Error(t, err)
+
+		cm = newParquetChunkManager(filePath, createSampleParquetFile(t, rowCount, "FieldDouble"))
+		parser = createParquetParser(t, sampleSchema(), cm, flushFunc)
+		_, err = parser.CountShardRows(filePath)
+		assert.Error(t, err)
+
+		cm = newParquetChunkManager(filePath, []byte("dummy"))
+		parser = createParquetParser(t, sampleSchema(), cm, flushFunc)
+		_, err = parser.CountShardRows(filePath)
+		assert.Error(t, err)
+	})
+}
+
+func Test_ParquetFileReader(t *testing.T) {
+	filePath := "sample.parquet"
+	content := []byte("0123456789")
+	reader := &parquetFileReader{
+		ctx:          context.Background(),
+		chunkManager: newParquetChunkManager(filePath, content),
+		filePath:     filePath,
+		size:         int64(len(content)),
+	}
+
+	buf := make([]byte, 4)
+	n, err := reader.ReadAt(buf, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, n)
+	assert.Equal(t, []byte("2345"), buf)
+
+	// read beyond the end of file
+	n, err = reader.ReadAt(buf, 8)
+	assert.ErrorIs(t, err, io.EOF)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, []byte("89"), buf[:n])
+	_, err = reader.ReadAt(buf, 10)
+	assert.ErrorIs(t, err, io.EOF)
+	_, err = reader.ReadAt(buf, -1)
+	assert.Error(t, err)
+
+	offset, err := reader.Seek(0, io.SeekEnd)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), offset)
+	offset, err = reader.Seek(-3, io.SeekCurrent)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), offset)
+	offset, err = reader.Seek(1, io.SeekStart)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), offset)
+	_, err = reader.Seek(-1, io.SeekStart)
+	assert.Error(t, err)
+	_, err = reader.Seek(0, 3)
+	assert.Error(t, err)
+
+	reader.chunkManager.(*MockChunkManager).readErr = fmt.Errorf("read error")
+	_, err = reader.ReadAt(buf, 0)
+	assert.Error(t, err)
+}
+
+func Test_ConvertArrowArray(t *testing.T) {
+	mem := memory.NewGoAllocator()
+
+	t.Run("null value", func(t *testing.T) {
+		builder := array.NewInt64Builder(mem)
+		builder.Append(1)
+		builder.AppendNull()
+		fieldData, err := convertArrowArray(builder.NewArray(), &schemapb.FieldSchema{Name: "a", DataType: schemapb.DataType_Int64})
+		assert.Error(t, err)
+		assert.Nil(t, fieldData)
+	})
+
+	t.Run("vector dimension mismatch", func(t *testing.T) {
+		builder := array.NewListBuilder(mem, arrow.PrimitiveTypes.Float32)
+		valueBuilder := builder.ValueBuilder().(*array.Float32Builder)
+		builder.Append(true)
+		valueBuilder.AppendValues([]float32{1, 2}, nil)
+		schema := sampleSchema().Fields[9]
+		fieldData, err := convertArrowArray(builder.NewArray(), schema)
+		assert.Error(t, err)
+		assert.Nil(t, fieldData)
+	})
+
+	t.Run("binary vector", func(t *testing.T) {
+		builder := array.NewFixedSizeBinaryBuilder(mem, &arrow.FixedSizeBinaryType{ByteWidth: 2})
+		builder.Append([]byte{1, 2})
+		builder.Append([]byte{3, 4})
+		schema := sampleSchema().Fields[8]
+		fieldData, err := convertArrowArray(builder.NewArray(), schema)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, fieldData.RowNum())
+		assert.Equal(t, []byte{3, 4}, fieldData.GetRow(1))
+	})
+
+	t.Run("unsupported type", func(t *testing.T) {
+		builder := array.NewInt64Builder(mem)
+		builder.Append(1)
+		fieldData, err := convertArrowArray(builder.NewArray(), &schemapb.FieldSchema{Name: "a", DataType: schemapb.DataType_None})
+		assert.Error(t, err)
+		assert.Nil(t, fieldData)
+	})
+}
@@ -49,6 +49,8 @@ func NewBuildIndexInfo(config *indexpb.StorageConfig) (*BuildIndexInfo, error) {
 	cRootPath := C.CString(config.RootPath)
 	cStorageType := C.CString(config.StorageType)
 	cIamEndPoint := C.CString(config.IAMEndpoint)
+	cRoleARN := C.CString(config.GetRoleArn())
+	cSTSEndpoint := C.CString(config.GetStsEndpoint())
 	defer C.free(unsafe.Pointer(cAddress))
 	defer C.free(unsafe.Pointer(cBucketName))
 	defer C.free(unsafe.Pointer(cAccessKey))
@@ -56,6 +58,8 @@ func NewBuildIndexInfo(config *indexpb.StorageConfig) (*BuildIndexInfo, error) {
 	defer C.free(unsafe.Pointer(cRootPath))
 	defer C.free(unsafe.Pointer(cStorageType))
 	defer C.free(unsafe.Pointer(cIamEndPoint))
+	defer C.free(unsafe.Pointer(cRoleARN))
+	defer C.free(unsafe.Pointer(cSTSEndpoint))
 	storageConfig := C.CStorageConfig{
 		address:          cAddress,
 		bucket_name:      cBucketName,
@@ -66,6 +70,9 @@ func NewBuildIndexInfo(config *indexpb.StorageConfig) (*BuildIndexInfo, error) {
 		iam_endpoint:     cIamEndPoint,
 		useSSL:           C.bool(config.UseSSL),
 		useIAM:           C.bool(config.UseIAM),
+		role_arn:         cRoleARN,
+		sts_endpoint:     cSTSEndpoint,
+		session_duration: C.int64_t(config.GetSessionDuration()),
 	}
 
 	status := C.NewBuildIndexInfo(&cBuildIndexInfo, storageConfig)
@@ -56,14 +56,18 @@ func InitTraceConfig(params *paramtable.ComponentParam) {
 }
 
 func InitRemoteChunkManager(params *paramtable.ComponentParam) error {
+	// segcore accesses the object storage with the credentials scoped to the role of the node as well
+	cred := params.MinioCfg.GetRoleCredential(paramtable.GetRole())
 	cAddress := C.CString(params.MinioCfg.Address.GetValue())
 	cBucketName := C.CString(params.MinioCfg.BucketName.GetValue())
-	cAccessKey := C.CString(params.MinioCfg.AccessKeyID.GetValue())
-	cAccessValue := C.CString(params.MinioCfg.SecretAccessKey.GetValue())
+	cAccessKey := C.CString(cred.AccessKeyID)
+	cAccessValue := C.CString(cred.SecretAccessKey)
 	cRootPath := C.CString(params.MinioCfg.RootPath.GetValue())
 	cStorageType := C.CString(params.CommonCfg.StorageType.GetValue())
 	cIamEndPoint := C.CString(params.MinioCfg.IAMEndpoint.GetValue())
 	cLogLevel := C.CString(params.MinioCfg.LogLevel.GetValue())
+	cRoleARN := C.CString(cred.RoleARN)
+	cSTSEndpoint := C.CString(params.MinioCfg.STSEndpoint.GetValue())
 	defer C.free(unsafe.Pointer(cAddress))
 	defer C.free(unsafe.Pointer(cBucketName))
 	defer C.free(unsafe.Pointer(cAccessKey))
@@ -72,6 +76,8 @@ func InitRemoteChunkManager(params *paramtable.ComponentParam) error {
 	defer C.free(unsafe.Pointer(cStorageType))
 	defer C.free(unsafe.Pointer(cIamEndPoint))
 	defer C.free(unsafe.Pointer(cLogLevel))
+	defer C.free(unsafe.Pointer(cRoleARN))
+	defer C.free(unsafe.Pointer(cSTSEndpoint))
 	storageConfig := C.CStorageConfig{
 		address:          cAddress,
 		bucket_name:      cBucketName,
@@ -83,12 +89,23 @@ func InitRemoteChunkManager(params *paramtable.ComponentParam) error {
 		useSSL:           C.bool(params.MinioCfg.UseSSL.GetAsBool()),
 		useIAM:           C.bool(params.MinioCfg.UseIAM.GetAsBool()),
 		log_level:        cLogLevel,
+		role_arn:         cRoleARN,
+		sts_endpoint:     cSTSEndpoint,
+		session_duration: C.int64_t(cred.SessionDuration.Seconds()),
 	}
 
 	status := C.InitRemoteChunkManagerSingleton(storageConfig)
 	return HandleCStatus(&status, "InitRemoteChunkManagerSingleton failed")
 }
 
+// InitEncryption sets the master key for segcore to decrypt the encrypted binlogs
+func InitEncryption(params *paramtable.ComponentParam) error {
+	cMasterKey := C.CString(params.CommonCfg.EncryptionMasterKey.GetValue())
+	defer C.free(unsafe.Pointer(cMasterKey))
+	status := C.InitEncryptionKeyring(cMasterKey)
+	return HandleCStatus(&status, "InitEncryptionKeyring failed")
+}
+
 func CleanRemoteChunkManager() {
 	C.CleanRemoteChunkManagerSingleton()
 }
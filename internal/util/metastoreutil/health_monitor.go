@@ -0,0 +1,154 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metastoreutil
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sync"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// healthProbeKey is the key read to probe the meta store, it doesn't need to exist.
+const healthProbeKey = "health-probe"
+
+// HealthMonitor probes the meta store periodically, and switches the coordinator into read only mode
+// if the meta store is degraded, i.e. etcd loses its quorum or responds slowly. In read only mode,
+// the operations changing the meta are rejected by ErrServiceReadOnly instead of timing out unpredictably.
+// The monitor recovers from read only mode automatically once the meta store heals.
+type HealthMonitor struct {
+	role  string
+	probe func(ctx context.Context) error
+
+	degraded atomic.Bool
+	reason   atomic.String
+	// the counters are only accessed by the probe loop
+	failures  int
+	successes int
+
+	closeCh   chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+// NewHealthMonitor creates a HealthMonitor probing etcd by a linearizable read, which requires the quorum.
+func NewHealthMonitor(etcdCli *clientv3.Client, role string) *HealthMonitor {
+	key := path.Join(paramtable.Get().EtcdCfg.MetaRootPath.GetValue(), healthProbeKey)
+	return NewHealthMonitorWithProbe(role, func(ctx context.Context) error {
+		_, err := etcdCli.Get(ctx, key, clientv3.WithCountOnly())
+		return err
+	})
+}
+
+// NewHealthMonitorWithProbe creates a HealthMonitor probing the meta store by the given function.
+func NewHealthMonitorWithProbe(role string, probe func(ctx context.Context) error) *HealthMonitor {
+	return &HealthMonitor{
+		role:    role,
+		probe:   probe,
+		closeCh: make(chan struct{}),
+	}
+}
+
+// Start starts probing the meta store, it's a no-op if read only mode is disabled.
+func (m *HealthMonitor) Start() {
+	params := &paramtable.Get().EtcdCfg
+	if !params.EnableReadOnlyOnDegradation.GetAsBool() {
+		log.Info("read only mode on meta store degradation is disabled", zap.String("role", m.role))
+		return
+	}
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		ticker := time.NewTicker(params.DegradationCheckInterval.GetAsDuration(time.Millisecond))
+		defer ticker.Stop()
+		for {
+			select {
+			case <-m.closeCh:
+				log.Info("meta store health monitor quit", zap.String("role", m.role))
+				return
+			case <-ticker.C:
+				m.check()
+			}
+		}
+	}()
+}
+
+// Stop stops probing the meta store.
+func (m *HealthMonitor) Stop() {
+	m.closeOnce.Do(func() {
+		close(m.closeCh)
+	})
+	m.wg.Wait()
+}
+
+// check probes the meta store once and updates the degraded state.
+func (m *HealthMonitor) check() {
+	params := &paramtable.Get().EtcdCfg
+	latencyThreshold := params.DegradationLatencyThreshold.GetAsDuration(time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), latencyThreshold)
+	defer cancel()
+	start := time.Now()
+	err := m.probe(ctx)
+	latency := time.Since(start)
+	if err == nil && latency > latencyThreshold {
+		err = fmt.Errorf("latency %v exceeds %v", latency, latencyThreshold)
+	}
+
+	if err != nil {
+		m.successes = 0
+		m.failures++
+		if !m.degraded.Load() && m.failures >= params.DegradationFailureThreshold.GetAsInt() {
+			m.reason.Store(err.Error())
+			m.degraded.Store(true)
+			log.Warn("meta store degraded, switch into read only mode", zap.String("role", m.role),
+				zap.Int("failures", m.failures), zap.Error(err))
+		}
+		return
+	}
+
+	m.failures = 0
+	m.successes++
+	if m.degraded.Load() && m.successes >= params.DegradationRecoveryThreshold.GetAsInt() {
+		m.degraded.Store(false)
+		log.Info("meta store healed, recover from read only mode", zap.String("role", m.role),
+			zap.Int("successes", m.successes), zap.Duration("latency", latency))
+	}
+}
+
+// IsReadOnly returns whether the coordinator is in read only mode.
+func (m *HealthMonitor) IsReadOnly() bool {
+	return m != nil && m.degraded.Load()
+}
+
+// CheckWritable returns ErrServiceReadOnly if the coordinator is in read only mode,
+// it always returns nil if the monitor is nil.
+func (m *HealthMonitor) CheckWritable() error {
+	if !m.IsReadOnly() {
+		return nil
+	}
+	return merr.WrapErrServiceReadOnly(m.reason.Load(), fmt.Sprintf("meta store of %s degraded", m.role))
+}
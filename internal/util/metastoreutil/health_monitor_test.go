@@ -0,0 +1,103 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metastoreutil
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestMain(m *testing.M) {
+	paramtable.Init()
+	m.Run()
+}
+
+func TestHealthMonitor_Check(t *testing.T) {
+	var probeErr error
+	m := NewHealthMonitorWithProbe("test", func(ctx context.Context) error {
+		return probeErr
+	})
+	assert.NoError(t, m.CheckWritable())
+
+	// switch into read only mode after consecutive failures
+	probeErr = errors.New("etcdserver: request timed out")
+	for i := 0; i < paramtable.Get().EtcdCfg.DegradationFailureThreshold.GetAsInt()-1; i++ {
+		m.check()
+		assert.False(t, m.IsReadOnly())
+	}
+	m.check()
+	assert.True(t, m.IsReadOnly())
+	err := m.CheckWritable()
+	assert.ErrorIs(t, err, merr.ErrServiceReadOnly)
+	assert.True(t, merr.IsRetriable(err))
+
+	// a single success doesn't recover
+	probeErr = nil
+	m.check()
+	assert.True(t, m.IsReadOnly())
+	probeErr = errors.New("etcdserver: request timed out")
+	m.check()
+	assert.True(t, m.IsReadOnly())
+
+	// recover after consecutive successes
+	probeErr = nil
+	for i := 0; i < paramtable.Get().EtcdCfg.DegradationRecoveryThreshold.GetAsInt(); i++ {
+		m.check()
+	}
+	assert.False(t, m.IsReadOnly())
+	assert.NoError(t, m.CheckWritable())
+}
+
+func TestHealthMonitor_HighLatency(t *testing.T) {
+	paramtable.Get().Save(paramtable.Get().EtcdCfg.DegradationLatencyThreshold.Key, "10")
+	paramtable.Get().Save(paramtable.Get().EtcdCfg.DegradationFailureThreshold.Key, "1")
+	defer paramtable.Get().Reset(paramtable.Get().EtcdCfg.DegradationLatencyThreshold.Key)
+	defer paramtable.Get().Reset(paramtable.Get().EtcdCfg.DegradationFailureThreshold.Key)
+
+	m := NewHealthMonitorWithProbe("test", func(ctx context.Context) error {
+		time.Sleep(20 * time.Millisecond)
+		return nil
+	})
+	m.check()
+	assert.True(t, m.IsReadOnly())
+}
+
+func TestHealthMonitor_StartStop(t *testing.T) {
+	paramtable.Get().Save(paramtable.Get().EtcdCfg.DegradationCheckInterval.Key, "10")
+	paramtable.Get().Save(paramtable.Get().EtcdCfg.DegradationFailureThreshold.Key, "1")
+	defer paramtable.Get().Reset(paramtable.Get().EtcdCfg.DegradationCheckInterval.Key)
+	defer paramtable.Get().Reset(paramtable.Get().EtcdCfg.DegradationFailureThreshold.Key)
+
+	m := NewHealthMonitorWithProbe("test", func(ctx context.Context) error {
+		return errors.New("etcdserver: no leader")
+	})
+	m.Start()
+	assert.Eventually(t, m.IsReadOnly, time.Second, 10*time.Millisecond)
+	m.Stop()
+	m.Stop()
+
+	var nilMonitor *HealthMonitor
+	assert.False(t, nilMonitor.IsReadOnly())
+	assert.NoError(t, nilMonitor.CheckWritable())
+}
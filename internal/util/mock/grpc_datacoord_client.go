@@ -40,6 +40,110 @@ func (m *GrpcDataCoordClient) GcConfirm(ctx context.Context, in *datapb.GcConfir
 	return &datapb.GcConfirmResponse{}, m.Err
 }
 
+func (m *GrpcDataCoordClient) GcDryRun(ctx context.Context, in *datapb.GcDryRunRequest, opts ...grpc.CallOption) (*datapb.GcDryRunResponse, error) {
+	return &datapb.GcDryRunResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) PauseGC(ctx context.Context, in *datapb.PauseGCRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) ResumeGC(ctx context.Context, in *datapb.ResumeGCRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) GetGCStatus(ctx context.Context, in *datapb.GetGCStatusRequest, opts ...grpc.CallOption) (*datapb.GetGCStatusResponse, error) {
+	return &datapb.GetGCStatusResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) DropSegmentsByTimeRange(ctx context.Context, in *datapb.DropSegmentsByTimeRangeRequest, opts ...grpc.CallOption) (*datapb.DropSegmentsByTimeRangeResponse, error) {
+	return &datapb.DropSegmentsByTimeRangeResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) GetTopologySnapshot(ctx context.Context, in *datapb.GetTopologySnapshotRequest, opts ...grpc.CallOption) (*datapb.GetTopologySnapshotResponse, error) {
+	return &datapb.GetTopologySnapshotResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) RetryQuarantinedChannels(ctx context.Context, in *datapb.RetryQuarantinedChannelsRequest, opts ...grpc.CallOption) (*datapb.RetryQuarantinedChannelsResponse, error) {
+	return &datapb.RetryQuarantinedChannelsResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) MigrateChannelWatchInfos(ctx context.Context, in *datapb.MigrateChannelWatchInfosRequest, opts ...grpc.CallOption) (*datapb.MigrateChannelWatchInfosResponse, error) {
+	return &datapb.MigrateChannelWatchInfosResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) ReCollectSegmentStats(ctx context.Context, in *datapb.ReCollectSegmentStatsRequest, opts ...grpc.CallOption) (*datapb.ReCollectSegmentStatsResponse, error) {
+	return &datapb.ReCollectSegmentStatsResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) FlushAndSeal(ctx context.Context, in *datapb.FlushAndSealRequest, opts ...grpc.CallOption) (*datapb.FlushAndSealResponse, error) {
+	return &datapb.FlushAndSealResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) GetStorageConsistencyReport(ctx context.Context, in *datapb.GetStorageConsistencyReportRequest, opts ...grpc.CallOption) (*datapb.GetStorageConsistencyReportResponse, error) {
+	return &datapb.GetStorageConsistencyReportResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) GetShardWriteStats(ctx context.Context, in *datapb.GetShardWriteStatsRequest, opts ...grpc.CallOption) (*datapb.GetShardWriteStatsResponse, error) {
+	return &datapb.GetShardWriteStatsResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) GetAuditEvents(ctx context.Context, in *datapb.GetAuditEventsRequest, opts ...grpc.CallOption) (*datapb.GetAuditEventsResponse, error) {
+	return &datapb.GetAuditEventsResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) GetChannelWatchHistory(ctx context.Context, in *datapb.GetChannelWatchHistoryRequest, opts ...grpc.CallOption) (*datapb.GetChannelWatchHistoryResponse, error) {
+	return &datapb.GetChannelWatchHistoryResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) GetChannelWatchStates(ctx context.Context, in *datapb.GetChannelWatchStatesRequest, opts ...grpc.CallOption) (*datapb.GetChannelWatchStatesResponse, error) {
+	return &datapb.GetChannelWatchStatesResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) SetNodeConfigs(ctx context.Context, in *datapb.SetNodeConfigsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) ListNodeConfigs(ctx context.Context, in *datapb.ListNodeConfigsRequest, opts ...grpc.CallOption) (*datapb.ListNodeConfigsResponse, error) {
+	return &datapb.ListNodeConfigsResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) ClearNodeConfigs(ctx context.Context, in *datapb.ClearNodeConfigsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) SetMaintenancePolicy(ctx context.Context, in *datapb.SetMaintenancePolicyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) ListMaintenancePolicies(ctx context.Context, in *datapb.ListMaintenancePoliciesRequest, opts ...grpc.CallOption) (*datapb.ListMaintenancePoliciesResponse, error) {
+	return &datapb.ListMaintenancePoliciesResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) CordonDataNode(ctx context.Context, in *datapb.CordonDataNodeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) DrainDataNode(ctx context.Context, in *datapb.DrainDataNodeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) UncordonDataNode(ctx context.Context, in *datapb.UncordonDataNodeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) GetDataNodeDrainState(ctx context.Context, in *datapb.GetDataNodeDrainStateRequest, opts ...grpc.CallOption) (*datapb.GetDataNodeDrainStateResponse, error) {
+	return &datapb.GetDataNodeDrainStateResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) RotateEncryptionKey(ctx context.Context, in *datapb.RotateEncryptionKeyRequest, opts ...grpc.CallOption) (*datapb.RotateEncryptionKeyResponse, error) {
+	return &datapb.RotateEncryptionKeyResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) GetEncryptionStatus(ctx context.Context, in *datapb.GetEncryptionStatusRequest, opts ...grpc.CallOption) (*datapb.GetEncryptionStatusResponse, error) {
+	return &datapb.GetEncryptionStatusResponse{}, m.Err
+}
+
 func (m *GrpcDataCoordClient) CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error) {
 	return &milvuspb.CheckHealthResponse{}, m.Err
 }
@@ -124,6 +228,18 @@ func (m *GrpcDataCoordClient) ManualCompaction(ctx context.Context, in *milvuspb
 	return &milvuspb.ManualCompactionResponse{}, m.Err
 }
 
+func (m *GrpcDataCoordClient) ManualCompactionWithMode(ctx context.Context, in *datapb.ManualCompactionWithModeRequest, opts ...grpc.CallOption) (*datapb.ManualCompactionWithModeResponse, error) {
+	return &datapb.ManualCompactionWithModeResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) GetCompactionProgress(ctx context.Context, in *datapb.GetCompactionProgressRequest, opts ...grpc.CallOption) (*datapb.GetCompactionProgressResponse, error) {
+	return &datapb.GetCompactionProgressResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) CancelCompaction(ctx context.Context, in *datapb.CancelCompactionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
 func (m *GrpcDataCoordClient) GetCompactionState(ctx context.Context, in *milvuspb.GetCompactionStateRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionStateResponse, error) {
 	return &milvuspb.GetCompactionStateResponse{}, m.Err
 }
@@ -189,6 +305,18 @@ func (m *GrpcDataCoordClient) DropIndex(ctx context.Context, req *indexpb.DropIn
 	return &commonpb.Status{}, m.Err
 }
 
+func (m *GrpcDataCoordClient) AlterIndex(ctx context.Context, req *indexpb.AlterIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) CloneSegments(ctx context.Context, req *datapb.CloneSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) GetCloneSegmentsState(ctx context.Context, req *datapb.GetCloneSegmentsStateRequest, opts ...grpc.CallOption) (*datapb.GetCloneSegmentsStateResponse, error) {
+	return &datapb.GetCloneSegmentsStateResponse{}, m.Err
+}
+
 func (m *GrpcDataCoordClient) GetIndexState(ctx context.Context, req *indexpb.GetIndexStateRequest, opts ...grpc.CallOption) (*indexpb.GetIndexStateResponse, error) {
 	return &indexpb.GetIndexStateResponse{}, m.Err
 }
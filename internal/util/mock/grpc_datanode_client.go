@@ -77,6 +77,18 @@ func (m *GrpcDataNodeClient) AddImportSegment(ctx context.Context, in *datapb.Ad
 	return &datapb.AddImportSegmentResponse{}, m.Err
 }
 
+func (m *GrpcDataNodeClient) DumpDispatcherPositions(ctx context.Context, in *internalpb.DumpDispatcherPositionsRequest, opts ...grpc.CallOption) (*internalpb.DumpDispatcherPositionsResponse, error) {
+	return &internalpb.DumpDispatcherPositionsResponse{}, m.Err
+}
+
+func (m *GrpcDataNodeClient) SeekDispatcher(ctx context.Context, in *internalpb.SeekDispatcherRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
 func (m *GrpcDataNodeClient) SyncSegments(ctx context.Context, in *datapb.SyncSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return &commonpb.Status{}, m.Err
 }
+
+func (m *GrpcDataNodeClient) CancelCompactionPlans(ctx context.Context, in *datapb.CancelCompactionPlansRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
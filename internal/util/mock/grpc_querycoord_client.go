@@ -129,3 +129,43 @@ func (m *GrpcQueryCoordClient) ListResourceGroups(ctx context.Context, req *milv
 func (m *GrpcQueryCoordClient) DescribeResourceGroup(ctx context.Context, req *querypb.DescribeResourceGroupRequest, opts ...grpc.CallOption) (*querypb.DescribeResourceGroupResponse, error) {
 	return &querypb.DescribeResourceGroupResponse{}, m.Err
 }
+
+func (m *GrpcQueryCoordClient) ExtendLoadTimeout(ctx context.Context, req *querypb.ExtendLoadTimeoutRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) ListCheckers(ctx context.Context, req *querypb.ListCheckersRequest, opts ...grpc.CallOption) (*querypb.ListCheckersResponse, error) {
+	return &querypb.ListCheckersResponse{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) ActivateChecker(ctx context.Context, req *querypb.ActivateCheckerRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) DeactivateChecker(ctx context.Context, req *querypb.DeactivateCheckerRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) DryRunCheckers(ctx context.Context, req *querypb.DryRunCheckersRequest, opts ...grpc.CallOption) (*querypb.DryRunCheckersResponse, error) {
+	return &querypb.DryRunCheckersResponse{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) TriggerBalance(ctx context.Context, req *querypb.TriggerBalanceRequest, opts ...grpc.CallOption) (*querypb.TriggerBalanceResponse, error) {
+	return &querypb.TriggerBalanceResponse{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) SuspendCollectionBalance(ctx context.Context, req *querypb.SuspendCollectionBalanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) WatchLoadProgress(ctx context.Context, req *querypb.WatchLoadProgressRequest, opts ...grpc.CallOption) (querypb.QueryCoord_WatchLoadProgressClient, error) {
+	return nil, m.Err
+}
+
+func (m *GrpcQueryCoordClient) ListDivergedLeaders(ctx context.Context, req *querypb.ListDivergedLeadersRequest, opts ...grpc.CallOption) (*querypb.ListDivergedLeadersResponse, error) {
+	return &querypb.ListDivergedLeadersResponse{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) NotifyCompaction(ctx context.Context, req *querypb.NotifyCompactionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
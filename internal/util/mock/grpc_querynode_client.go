@@ -120,3 +120,11 @@ func (m *GrpcQueryNodeClient) UnsubDmChannel(ctx context.Context, req *querypb.U
 func (m *GrpcQueryNodeClient) Delete(ctx context.Context, in *querypb.DeleteRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return &commonpb.Status{}, m.Err
 }
+
+func (m *GrpcQueryNodeClient) DumpDispatcherPositions(ctx context.Context, in *internalpb.DumpDispatcherPositionsRequest, opts ...grpc.CallOption) (*internalpb.DumpDispatcherPositionsResponse, error) {
+	return &internalpb.DumpDispatcherPositionsResponse{}, m.Err
+}
+
+func (m *GrpcQueryNodeClient) SeekDispatcher(ctx context.Context, in *internalpb.SeekDispatcherRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
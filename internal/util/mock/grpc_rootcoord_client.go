@@ -47,6 +47,18 @@ func (m *GrpcRootCoordClient) ListDatabases(ctx context.Context, in *milvuspb.Li
 	return &milvuspb.ListDatabasesResponse{}, m.Err
 }
 
+func (m *GrpcRootCoordClient) CloneCollection(ctx context.Context, in *rootcoordpb.CloneCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) GetCapacityReport(ctx context.Context, in *rootcoordpb.GetCapacityReportRequest, opts ...grpc.CallOption) (*rootcoordpb.GetCapacityReportResponse, error) {
+	return &rootcoordpb.GetCapacityReportResponse{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) GetCollectionStatsHistory(ctx context.Context, in *rootcoordpb.GetCollectionStatsHistoryRequest, opts ...grpc.CallOption) (*rootcoordpb.GetCollectionStatsHistoryResponse, error) {
+	return &rootcoordpb.GetCollectionStatsHistoryResponse{}, m.Err
+}
+
 func (m *GrpcRootCoordClient) RenameCollection(ctx context.Context, in *milvuspb.RenameCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
 }
@@ -136,3 +136,11 @@ func (q QueryNodeClient) SyncDistribution(ctx context.Context, req *querypb.Sync
 func (q QueryNodeClient) Delete(ctx context.Context, req *querypb.DeleteRequest) (*commonpb.Status, error) {
 	return q.grpcClient.Delete(ctx, req)
 }
+
+func (q QueryNodeClient) DumpDispatcherPositions(ctx context.Context, req *internalpb.DumpDispatcherPositionsRequest) (*internalpb.DumpDispatcherPositionsResponse, error) {
+	return q.grpcClient.DumpDispatcherPositions(ctx, req)
+}
+
+func (q QueryNodeClient) SeekDispatcher(ctx context.Context, req *internalpb.SeekDispatcherRequest) (*commonpb.Status, error) {
+	return q.grpcClient.SeekDispatcher(ctx, req)
+}
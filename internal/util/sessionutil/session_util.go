@@ -20,8 +20,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"os"
 	"path"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -35,6 +37,7 @@ import (
 
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/hardware"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/retry"
 )
@@ -44,6 +47,9 @@ const (
 	DefaultServiceRoot = "session/"
 	// DefaultIDKey default id key for Session
 	DefaultIDKey = "id"
+	// ServerLabelEnvPrefix is the prefix of environment variables which define the server labels,
+	// e.g. MILVUS_SERVER_LABEL_zone=az1 defines label zone with value az1
+	ServerLabelEnvPrefix = "MILVUS_SERVER_LABEL_"
 )
 
 // SessionEventType session event type
@@ -96,6 +102,11 @@ type Session struct {
 	Stopping    bool   `json:"Stopping,omitempty"`
 	TriggerKill bool
 	Version     semver.Version `json:"Version,omitempty"`
+	// ServerLabels describes the topology of the server, such as zone or rack
+	ServerLabels map[string]string `json:"ServerLabels,omitempty"`
+	// CPUNum and MemorySize are the capacity of the server, used for weighted scheduling
+	CPUNum     int    `json:"CPUNum,omitempty"`
+	MemorySize uint64 `json:"MemorySize,omitempty"`
 
 	liveChOnce        sync.Once
 	liveCh            chan bool
@@ -120,6 +131,26 @@ type Session struct {
 
 type SessionOption func(session *Session)
 
+// GetServerLabelsFromEnv collects the server labels from environment variables with prefix ServerLabelEnvPrefix.
+func GetServerLabelsFromEnv() map[string]string {
+	labels := make(map[string]string)
+	for _, env := range os.Environ() {
+		kv := strings.SplitN(env, "=", 2)
+		if len(kv) != 2 || !strings.HasPrefix(kv[0], ServerLabelEnvPrefix) {
+			continue
+		}
+		key := strings.TrimPrefix(kv[0], ServerLabelEnvPrefix)
+		if key == "" {
+			continue
+		}
+		labels[key] = kv[1]
+	}
+	if len(labels) == 0 {
+		return nil
+	}
+	return labels
+}
+
 func WithTTL(ttl int64) SessionOption {
 	return func(session *Session) { session.sessionTTL = ttl }
 }
@@ -141,13 +172,16 @@ func (s *Session) apply(opts ...SessionOption) {
 // UnmarshalJSON unmarshal bytes to Session.
 func (s *Session) UnmarshalJSON(data []byte) error {
 	var raw struct {
-		ServerID    int64  `json:"ServerID,omitempty"`
-		ServerName  string `json:"ServerName,omitempty"`
-		Address     string `json:"Address,omitempty"`
-		Exclusive   bool   `json:"Exclusive,omitempty"`
-		Stopping    bool   `json:"Stopping,omitempty"`
-		TriggerKill bool
-		Version     string `json:"Version"`
+		ServerID     int64  `json:"ServerID,omitempty"`
+		ServerName   string `json:"ServerName,omitempty"`
+		Address      string `json:"Address,omitempty"`
+		Exclusive    bool   `json:"Exclusive,omitempty"`
+		Stopping     bool   `json:"Stopping,omitempty"`
+		TriggerKill  bool
+		Version      string            `json:"Version"`
+		ServerLabels map[string]string `json:"ServerLabels,omitempty"`
+		CPUNum       int               `json:"CPUNum,omitempty"`
+		MemorySize   uint64            `json:"MemorySize,omitempty"`
 	}
 	err := json.Unmarshal(data, &raw)
 	if err != nil {
@@ -165,6 +199,9 @@ func (s *Session) UnmarshalJSON(data []byte) error {
 	s.ServerName = raw.ServerName
 	s.Address = raw.Address
 	s.Exclusive = raw.Exclusive
+	s.ServerLabels = raw.ServerLabels
+	s.CPUNum = raw.CPUNum
+	s.MemorySize = raw.MemorySize
 	s.Stopping = raw.Stopping
 	s.TriggerKill = raw.TriggerKill
 	return nil
@@ -175,21 +212,27 @@ func (s *Session) MarshalJSON() ([]byte, error) {
 
 	verStr := s.Version.String()
 	return json.Marshal(&struct {
-		ServerID    int64  `json:"ServerID,omitempty"`
-		ServerName  string `json:"ServerName,omitempty"`
-		Address     string `json:"Address,omitempty"`
-		Exclusive   bool   `json:"Exclusive,omitempty"`
-		Stopping    bool   `json:"Stopping,omitempty"`
-		TriggerKill bool
-		Version     string `json:"Version"`
+		ServerID     int64  `json:"ServerID,omitempty"`
+		ServerName   string `json:"ServerName,omitempty"`
+		Address      string `json:"Address,omitempty"`
+		Exclusive    bool   `json:"Exclusive,omitempty"`
+		Stopping     bool   `json:"Stopping,omitempty"`
+		TriggerKill  bool
+		Version      string            `json:"Version"`
+		ServerLabels map[string]string `json:"ServerLabels,omitempty"`
+		CPUNum       int               `json:"CPUNum,omitempty"`
+		MemorySize   uint64            `json:"MemorySize,omitempty"`
 	}{
-		ServerID:    s.ServerID,
-		ServerName:  s.ServerName,
-		Address:     s.Address,
-		Exclusive:   s.Exclusive,
-		Stopping:    s.Stopping,
-		TriggerKill: s.TriggerKill,
-		Version:     verStr,
+		ServerID:     s.ServerID,
+		ServerName:   s.ServerName,
+		Address:      s.Address,
+		Exclusive:    s.Exclusive,
+		Stopping:     s.Stopping,
+		TriggerKill:  s.TriggerKill,
+		Version:      verStr,
+		ServerLabels: s.ServerLabels,
+		CPUNum:       s.CPUNum,
+		MemorySize:   s.MemorySize,
 	})
 
 }
@@ -200,9 +243,12 @@ func (s *Session) MarshalJSON() ([]byte, error) {
 // etcdEndpoints is to init etcdCli when NewSession
 func NewSession(ctx context.Context, metaRoot string, client *clientv3.Client, opts ...SessionOption) *Session {
 	session := &Session{
-		ctx:      ctx,
-		metaRoot: metaRoot,
-		Version:  common.Version,
+		ctx:          ctx,
+		metaRoot:     metaRoot,
+		Version:      common.Version,
+		ServerLabels: GetServerLabelsFromEnv(),
+		CPUNum:       hardware.GetCPUNum(),
+		MemorySize:   hardware.GetMemoryCount(),
 
 		// options
 		sessionTTL:        paramtable.Get().CommonCfg.SessionTTL.GetAsInt64(),
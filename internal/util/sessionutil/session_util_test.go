@@ -389,6 +389,11 @@ func TestSesssionMarshal(t *testing.T) {
 		ServerName: "test",
 		Address:    "localhost",
 		Version:    common.Version,
+		ServerLabels: map[string]string{
+			"zone": "az1",
+		},
+		CPUNum:     8,
+		MemorySize: 1 << 34,
 	}
 
 	bs, err := json.Marshal(s)
@@ -401,6 +406,18 @@ func TestSesssionMarshal(t *testing.T) {
 	assert.Equal(t, s.ServerName, s2.ServerName)
 	assert.Equal(t, s.Address, s2.Address)
 	assert.Equal(t, s.Version.String(), s2.Version.String())
+	assert.Equal(t, s.ServerLabels, s2.ServerLabels)
+	assert.Equal(t, s.CPUNum, s2.CPUNum)
+	assert.Equal(t, s.MemorySize, s2.MemorySize)
+}
+
+func TestGetServerLabelsFromEnv(t *testing.T) {
+	assert.Nil(t, GetServerLabelsFromEnv())
+
+	t.Setenv(ServerLabelEnvPrefix+"zone", "az1")
+	t.Setenv(ServerLabelEnvPrefix+"rack", "r1")
+	t.Setenv(ServerLabelEnvPrefix, "invalid")
+	assert.Equal(t, map[string]string{"zone": "az1", "rack": "r1"}, GetServerLabelsFromEnv())
 }
 
 func TestSessionUnmarshal(t *testing.T) {
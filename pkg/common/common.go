@@ -61,6 +61,10 @@ const (
 
 	// InvalidNodeID indicates that node is not valid in querycoord replica or shard cluster.
 	InvalidNodeID = int64(-1)
+
+	// CurrentIndexEngineVersion is the version of the index file format built and loadable by this node,
+	// bump it once the format changes incompatibly, the nodes refuse to load the indexes built by newer versions.
+	CurrentIndexEngineVersion = int32(1)
 )
 
 // Endian is type alias of binary.LittleEndian.
@@ -79,6 +83,9 @@ const (
 
 	// SegmentIndexPath storage path const for segment index files.
 	SegmentIndexPath = `index_files`
+
+	// SidePayloadPath storage path const for the oversized values stored aside from the binlogs.
+	SidePayloadPath = `side_payload`
 )
 
 // Search, Index parameter keys
@@ -94,6 +101,16 @@ const (
 	MetricTypeKey  = "metric_type"
 	DimKey         = "dim"
 	MaxLengthKey   = "max_length"
+
+	// SidePayloadKey is the type param allowing the oversized values of a varchar/JSON field to be stored aside,
+	// such field can't be filtered or indexed since only the references are kept in the segments.
+	SidePayloadKey = "side_payload"
+
+	// IndexLoadPolicyKey is the index param deciding whether QueryNode loads the index eagerly with the segment,
+	// or lazily once the field is searched or filtered.
+	IndexLoadPolicyKey   = "load_policy"
+	IndexLoadPolicyEager = "eager"
+	IndexLoadPolicyLazy  = "lazy"
 )
 
 //  Collection properties key
@@ -101,6 +118,15 @@ const (
 const (
 	CollectionTTLConfigKey      = "collection.ttl.seconds"
 	CollectionAutoCompactionKey = "collection.autocompaction.enabled"
+	CollectionEncryptionKey     = "collection.encryption.enabled"
+	// the interval in seconds of the time boundaries to seal the growing segments on
+	CollectionSealIntervalKey = "collection.segment.sealInterval.seconds"
+	// the max number of growing segments of each channel
+	CollectionMaxGrowingSegmentsKey = "collection.segment.maxGrowingPerChannel"
+	// the validation profile of the inserted data, strict or lenient
+	CollectionValidationProfileKey = "collection.insert.validationProfile"
+	// the labels the QueryNodes must match to load the collection, e.g. "disk=nvme,zone=us-east-1a"
+	CollectionPlacementLabelsKey = "collection.placement.labels"
 
 	// rate limit
 	CollectionInsertRateMaxKey   = "collection.insertRate.max.mb"
@@ -123,6 +149,16 @@ const (
 	TraceIDKey    string = "uber-trace-id"
 )
 
+const (
+	// MetaCacheEventPrefix is the prefix of the collection meta cache invalidation events under meta root,
+	// which are published by rootcoord and watched by proxies.
+	MetaCacheEventPrefix = "meta-cache-events"
+
+	// CollectionStatsHistoryPrefix is the prefix of the statistics samples of collections under meta root,
+	// which are recorded by rootcoord.
+	CollectionStatsHistoryPrefix = "collection-stats-history"
+)
+
 func IsSystemField(fieldID int64) bool {
 	return fieldID < StartOfUserFieldID
 }
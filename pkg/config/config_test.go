@@ -128,6 +128,31 @@ func TestConfigFromRemote(t *testing.T) {
 		assert.Equal(t, "info", v)
 	})
 
+	t.Run("node scoped config", func(t *testing.T) {
+		client.KV.Put(ctx, "test/config/test/node", "global")
+		client.KV.Put(ctx, "test/node_config/1/test/node", "node1")
+		client.KV.Put(ctx, "test/node_config/11/test/node", "node11")
+		time.Sleep(100 * time.Millisecond)
+
+		v, _ := mgr.GetConfig("test.node")
+		assert.Equal(t, "global", v)
+
+		mgr.UpdateSourceOptions(WithNodeID(1))
+		time.Sleep(100 * time.Millisecond)
+
+		v, _ = mgr.GetConfig("test.node")
+		assert.Equal(t, "node1", v)
+
+		client.KV.Delete(ctx, "test/node_config/1/test/node")
+		time.Sleep(100 * time.Millisecond)
+
+		v, _ = mgr.GetConfig("test.node")
+		assert.Equal(t, "global", v)
+
+		client.KV.Delete(ctx, "test/config/test/node")
+		client.KV.Delete(ctx, "test/node_config/11/test/node")
+	})
+
 	t.Run("close manager", func(t *testing.T) {
 		mgr.Close()
 
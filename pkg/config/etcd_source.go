@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"path"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -31,14 +32,23 @@ import (
 
 const (
 	ReadConfigTimeout = 3 * time.Second
+
+	// NodeConfigSubPath is the sub path of node scoped configs, organized as: [prefix]/node_config/{node_id}/{key}
+	NodeConfigSubPath = "node_config"
 )
 
+// NodeConfigPrefix returns the etcd key prefix of the configs scoped to the node.
+func NodeConfigPrefix(keyPrefix string, nodeID int64) string {
+	return path.Join(keyPrefix, NodeConfigSubPath, strconv.FormatInt(nodeID, 10))
+}
+
 type EtcdSource struct {
 	sync.RWMutex
 	etcdCli       *clientv3.Client
 	ctx           context.Context
 	currentConfig map[string]string
 	keyPrefix     string
+	nodeInfo      *NodeInfo
 
 	configRefresher *refresher
 	eh              EventHandler
@@ -114,11 +124,14 @@ func (es *EtcdSource) SetEventHandler(eh EventHandler) {
 }
 
 func (es *EtcdSource) UpdateOptions(opts Options) {
+	es.Lock()
+	defer es.Unlock()
+	if opts.NodeInfo != nil {
+		es.nodeInfo = opts.NodeInfo
+	}
 	if opts.EtcdInfo == nil {
 		return
 	}
-	es.Lock()
-	defer es.Unlock()
 	es.keyPrefix = opts.EtcdInfo.KeyPrefix
 	if es.configRefresher.refreshInterval != opts.EtcdInfo.RefreshInterval {
 		es.configRefresher.stop()
@@ -129,28 +142,42 @@ func (es *EtcdSource) UpdateOptions(opts Options) {
 
 func (es *EtcdSource) refreshConfigurations() error {
 	es.RLock()
-	prefix := path.Join(es.keyPrefix, "config")
+	prefixes := []string{path.Join(es.keyPrefix, "config")}
+	if es.nodeInfo != nil {
+		// node scoped configs are loaded last to override the cluster wide ones
+		prefixes = append(prefixes, NodeConfigPrefix(es.keyPrefix, es.nodeInfo.NodeID))
+	}
 	es.RUnlock()
 
+	newConfig := make(map[string]string)
+	for _, prefix := range prefixes {
+		if err := es.loadConfigsWithPrefix(prefix, newConfig); err != nil {
+			return err
+		}
+	}
+	es.Lock()
+	defer es.Unlock()
+	err := es.configRefresher.fireEvents(es.GetSourceName(), es.currentConfig, newConfig)
+	if err != nil {
+		return err
+	}
+	es.currentConfig = newConfig
+	return nil
+}
+
+func (es *EtcdSource) loadConfigsWithPrefix(prefix string, configs map[string]string) error {
 	ctx, cancel := context.WithTimeout(es.ctx, ReadConfigTimeout)
 	defer cancel()
-	response, err := es.etcdCli.Get(ctx, prefix, clientv3.WithPrefix(), clientv3.WithSerializable())
+	// trailing slash to avoid matching node ids sharing the same prefix
+	response, err := es.etcdCli.Get(ctx, prefix+"/", clientv3.WithPrefix(), clientv3.WithSerializable())
 	if err != nil {
 		return err
 	}
-	newConfig := make(map[string]string, len(response.Kvs))
 	for _, kv := range response.Kvs {
 		key := string(kv.Key)
 		key = strings.TrimPrefix(key, prefix+"/")
-		newConfig[key] = string(kv.Value)
-		newConfig[formatKey(key)] = string(kv.Value)
+		configs[key] = string(kv.Value)
+		configs[formatKey(key)] = string(kv.Value)
 	}
-	es.Lock()
-	defer es.Unlock()
-	err = es.configRefresher.fireEvents(es.GetSourceName(), es.currentConfig, newConfig)
-	if err != nil {
-		return err
-	}
-	es.currentConfig = newConfig
 	return nil
 }
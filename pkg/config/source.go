@@ -48,6 +48,11 @@ type EtcdInfo struct {
 	RefreshInterval time.Duration
 }
 
+// NodeInfo has attribute for node scoped configs
+type NodeInfo struct {
+	NodeID int64
+}
+
 // FileInfo has attribute for file source
 type FileInfo struct {
 	Files           []string
@@ -58,6 +63,7 @@ type FileInfo struct {
 type Options struct {
 	FileInfo        *FileInfo
 	EtcdInfo        *EtcdInfo
+	NodeInfo        *NodeInfo
 	EnvKeyFormatter func(string) string
 }
 
@@ -78,6 +84,14 @@ func WithEtcdSource(ri *EtcdInfo) Option {
 	}
 }
 
+// WithNodeID tell the remote source to load the configs scoped to the node as well,
+// which override the cluster wide configs
+func WithNodeID(nodeID int64) Option {
+	return func(options *Options) {
+		options.NodeInfo = &NodeInfo{NodeID: nodeID}
+	}
+}
+
 // WithEnvSource enable env source
 // archaius will read ENV as key value
 func WithEnvSource(keyFormatter func(string) string) Option {
@@ -128,6 +128,115 @@ var (
 			nodeIDLabelName,
 		})
 
+	// DataCoordChannelCheckpointTime records the physical time of the checkpoint of each vchannel,
+	// the data before the checkpoint is flushed.
+	DataCoordChannelCheckpointTime = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataCoordRole,
+			Name:      "channel_checkpoint_unix_seconds",
+			Help:      "physical time of the channel checkpoint per virtual channel",
+		}, []string{
+			channelNameLabelName,
+		})
+
+	// DataCoordChannelUnflushedRows records the number of rows in the unflushed segments of each vchannel.
+	DataCoordChannelUnflushedRows = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataCoordRole,
+			Name:      "channel_unflushed_rows",
+			Help:      "num of rows in growing, sealed and flushing segments per virtual channel",
+		}, []string{
+			channelNameLabelName,
+		})
+
+	// DataCoordChannelConsumeLag records how far the consumer of each vchannel lags behind the head of its pchannel.
+	DataCoordChannelConsumeLag = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataCoordRole,
+			Name:      "channel_consume_lag_ms",
+			Help:      "now time minus the consumed tt per virtual channel",
+		}, []string{
+			channelNameLabelName,
+		})
+
+	// DataCoordChannelReassignCount counts the attempts to reassign released channels.
+	DataCoordChannelReassignCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataCoordRole,
+			Name:      "channel_reassign_count",
+			Help:      "number of attempts to reassign released dml channels",
+		}, []string{
+			statusLabelName,
+		})
+
+	// DataCoordMergedL0SegmentCount counts the L0 segments merged into the sealed segments.
+	DataCoordMergedL0SegmentCount = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataCoordRole,
+			Name:      "merged_l0_segment_count",
+			Help:      "number of L0 segments merged into the sealed segments",
+		})
+
+	// DataCoordRetentionPurgedRowCount counts the rows of the segments dropped for the expiry by the collection TTL.
+	DataCoordRetentionPurgedRowCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataCoordRole,
+			Name:      "retention_purged_row_count",
+			Help:      "number of expired rows purged by dropping the expired segments",
+		}, []string{collectionIDLabelName})
+
+	// DataCoordQuarantinedChannelNum records the number of channels quarantined for exhausting the retry budget.
+	DataCoordQuarantinedChannelNum = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataCoordRole,
+			Name:      "quarantined_channel_num",
+			Help:      "number of dml channels quarantined for exhausting the retry budget",
+		}, []string{})
+
+	// DataCoordReplicatedSegmentCount counts the segments shipped to the standby cluster.
+	DataCoordReplicatedSegmentCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataCoordRole,
+			Name:      "replicated_segment_count",
+			Help:      "number of segments shipped to the standby cluster",
+		}, []string{statusLabelName})
+
+	// DataCoordReplicatedBinlogSize counts the bytes of binlogs shipped to the standby cluster.
+	DataCoordReplicatedBinlogSize = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataCoordRole,
+			Name:      "replicated_binlog_size",
+			Help:      "bytes of binlogs shipped to the standby cluster",
+		})
+
+	// DataCoordInconsistentFileNum records the number of binlog files found inconsistent
+	// between the segment meta and the object storage by the last reconciliation.
+	DataCoordInconsistentFileNum = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataCoordRole,
+			Name:      "inconsistent_file_num",
+			Help:      "number of binlog files missing in the object storage or orphaned by the segment meta",
+		}, []string{inconsistencyLabelName})
+
+	// DataCoordStorageReconcileCount counts the reconciliations between the segment meta and the object storage.
+	DataCoordStorageReconcileCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataCoordRole,
+			Name:      "storage_reconcile_count",
+			Help:      "number of reconciliations between the segment meta and the object storage",
+		}, []string{statusLabelName})
+
 	DataCoordCompactedSegmentSize = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Namespace: milvusNamespace,
@@ -229,6 +338,17 @@ func RegisterDataCoord(registry *prometheus.Registry) {
 	registry.MustRegister(DataCoordStoredBinlogSize)
 	registry.MustRegister(DataCoordSegmentBinLogFileCount)
 	registry.MustRegister(DataCoordDmlChannelNum)
+	registry.MustRegister(DataCoordChannelCheckpointTime)
+	registry.MustRegister(DataCoordChannelUnflushedRows)
+	registry.MustRegister(DataCoordChannelConsumeLag)
+	registry.MustRegister(DataCoordChannelReassignCount)
+	registry.MustRegister(DataCoordQuarantinedChannelNum)
+	registry.MustRegister(DataCoordMergedL0SegmentCount)
+	registry.MustRegister(DataCoordRetentionPurgedRowCount)
+	registry.MustRegister(DataCoordReplicatedSegmentCount)
+	registry.MustRegister(DataCoordReplicatedBinlogSize)
+	registry.MustRegister(DataCoordInconsistentFileNum)
+	registry.MustRegister(DataCoordStorageReconcileCount)
 	registry.MustRegister(DataCoordCompactedSegmentSize)
 	registry.MustRegister(FlushedSegmentFileNum)
 	registry.MustRegister(IndexRequestCounter)
@@ -248,3 +368,11 @@ func CleanupDataCoordSegmentMetrics(collectionID int64, segmentID int64) {
 		segmentIDLabelName:    fmt.Sprint(segmentID),
 	})
 }
+
+// CleanupDataCoordChannelMetrics removes the metrics of the vchannel.
+func CleanupDataCoordChannelMetrics(channelName string) {
+	labels := prometheus.Labels{channelNameLabelName: channelName}
+	DataCoordChannelCheckpointTime.Delete(labels)
+	DataCoordChannelUnflushedRows.Delete(labels)
+	DataCoordChannelConsumeLag.Delete(labels)
+}
@@ -211,6 +211,28 @@ var (
 			collectionIDLabelName,
 		})
 
+	DataNodeStalledVChannelNum = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataNodeRole,
+			Name:      "stalled_vchannel_num",
+			Help:      "number of vchannels whose timetick hasn't advanced for the stall threshold",
+		}, []string{
+			nodeIDLabelName,
+		})
+
+	// DataNodeCompactionExpiredRowCount counts the expired rows purged by the compactions.
+	DataNodeCompactionExpiredRowCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataNodeRole,
+			Name:      "compaction_expired_row_count",
+			Help:      "number of expired rows purged by the compactions",
+		}, []string{
+			nodeIDLabelName,
+			collectionIDLabelName,
+		})
+
 	DataNodeMsgDispatcherTtLag = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: milvusNamespace,
@@ -243,6 +265,8 @@ func RegisterDataNode(registry *prometheus.Registry) {
 	registry.MustRegister(DataNodeMsgDispatcherTtLag)
 	registry.MustRegister(DataNodeCompactionLatencyInQueue)
 	registry.MustRegister(DataNodeFlowGraphBufferDataSize)
+	registry.MustRegister(DataNodeStalledVChannelNum)
+	registry.MustRegister(DataNodeCompactionExpiredRowCount)
 }
 
 func CleanupDataNodeCollectionMetrics(nodeID int64, collectionID int64, channel string) {
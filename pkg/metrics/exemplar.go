@@ -0,0 +1,40 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// traceIDLabelName is the exemplar label linking an observation to its trace.
+const traceIDLabelName = "trace_id"
+
+// ObserveWithTrace observes the value, and attaches the trace ID of ctx as an exemplar if the trace is sampled,
+// so that users could jump from a latency bucket to example traces.
+// Exemplars are only exposed when metrics are scraped in OpenMetrics format.
+func ObserveWithTrace(ctx context.Context, observer prometheus.Observer, value float64) {
+	spanCtx := trace.SpanContextFromContext(ctx)
+	exemplarObserver, ok := observer.(prometheus.ExemplarObserver)
+	if !ok || !spanCtx.IsValid() || !spanCtx.IsSampled() {
+		observer.Observe(value)
+		return
+	}
+	exemplarObserver.ObserveWithExemplar(value, prometheus.Labels{traceIDLabelName: spanCtx.TraceID().String()})
+}
@@ -26,7 +26,8 @@ const (
 	MetaRemoveLabel = "remove"
 	MetaTxnLabel    = "txn"
 
-	metaOpType = "meta_op_type"
+	metaOpType       = "meta_op_type"
+	metaSubsystemKey = "subsystem"
 )
 
 var (
@@ -55,6 +56,15 @@ var (
 			Name:      "op_count",
 			Help:      "count of meta operation",
 		}, []string{metaOpType, statusLabelName})
+
+	MetaClientQueueLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "meta",
+			Name:      "client_queue_latency",
+			Help:      "latency (ms) of the requests waiting for the rate limit of the meta client of the subsystem",
+			Buckets:   buckets,
+		}, []string{metaSubsystemKey})
 )
 
 // RegisterMetaMetrics registers meta metrics
@@ -62,4 +72,5 @@ func RegisterMetaMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(MetaKvSize)
 	registry.MustRegister(MetaRequestLatency)
 	registry.MustRegister(MetaOpCounter)
+	registry.MustRegister(MetaClientQueueLatency)
 }
@@ -31,6 +31,12 @@ const (
 	FailLabel    = "fail"
 	TotalLabel   = "total"
 
+	RejectedLabel = "rejected"
+	CoercedLabel  = "coerced"
+
+	MissingFileLabel  = "missing"
+	OrphanedFileLabel = "orphaned"
+
 	InsertLabel    = "insert"
 	DeleteLabel    = "delete"
 	UpsertLabel    = "upsert"
@@ -64,26 +70,31 @@ const (
 	ReduceSegments = "segments"
 	ReduceShards   = "shards"
 
-	nodeIDLabelName          = "node_id"
-	statusLabelName          = "status"
-	indexTaskStatusLabelName = "index_task_status"
-	msgTypeLabelName         = "msg_type"
-	collectionIDLabelName    = "collection_id"
-	partitionIDLabelName     = "partition_id"
-	channelNameLabelName     = "channel_name"
-	functionLabelName        = "function_name"
-	queryTypeLabelName       = "query_type"
-	collectionName           = "collection_name"
-	segmentStateLabelName    = "segment_state"
-	segmentIDLabelName       = "segment_id"
-	usernameLabelName        = "username"
-	roleNameLabelName        = "role_name"
-	cacheNameLabelName       = "cache_name"
-	cacheStateLabelName      = "cache_state"
-	indexCountLabelName      = "indexed_field_count"
-	requestScope             = "scope"
-	fullMethodLabelName      = "full_method"
-	reduceLevelName          = "reduce_level"
+	nodeIDLabelName           = "node_id"
+	statusLabelName           = "status"
+	indexTaskStatusLabelName  = "index_task_status"
+	msgTypeLabelName          = "msg_type"
+	collectionIDLabelName     = "collection_id"
+	partitionIDLabelName      = "partition_id"
+	channelNameLabelName      = "channel_name"
+	functionLabelName         = "function_name"
+	queryTypeLabelName        = "query_type"
+	collectionName            = "collection_name"
+	segmentStateLabelName     = "segment_state"
+	segmentIDLabelName        = "segment_id"
+	usernameLabelName         = "username"
+	roleNameLabelName         = "role_name"
+	cacheNameLabelName        = "cache_name"
+	cacheStateLabelName       = "cache_state"
+	indexCountLabelName       = "indexed_field_count"
+	requestScope              = "scope"
+	fullMethodLabelName       = "full_method"
+	federationTargetLabelName = "federation_target"
+	validationCheckLabelName  = "validation_check"
+	reduceLevelName           = "reduce_level"
+	fieldNameLabelName        = "field_name"
+	compressorLabelName       = "compressor"
+	inconsistencyLabelName    = "inconsistency"
 )
 
 var (
@@ -97,9 +108,19 @@ var (
 			Name:      "num_node",
 			Help:      "number of nodes and coordinates",
 		}, []string{nodeIDLabelName, roleNameLabelName})
+
+	// GrpcCompressionRatio records the ratio of the uncompressed size to the compressed size of the grpc messages.
+	GrpcCompressionRatio = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Name:      "grpc_compression_ratio",
+			Help:      "ratio of the uncompressed size to the compressed size of the grpc messages",
+			Buckets:   []float64{1, 1.5, 2, 3, 4, 6, 8, 12, 16, 32},
+		}, []string{compressorLabelName})
 )
 
 // Register serves prometheus http service
 func Register(r *prometheus.Registry) {
 	r.MustRegister(NumNodes)
+	r.MustRegister(GrpcCompressionRatio)
 }
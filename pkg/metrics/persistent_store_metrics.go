@@ -54,6 +54,22 @@ var (
 			Name:      "op_count",
 			Help:      "count of persistent data operation",
 		}, []string{persistentDataOpType, statusLabelName})
+
+	PersistentDataCredentialExpiry = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "storage",
+			Name:      "credential_expiry_timestamp",
+			Help:      "expiry unix timestamp of the refreshed object storage credentials returned by STS",
+		}, []string{roleNameLabelName})
+
+	PersistentDataCredentialRefreshCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "storage",
+			Name:      "credential_refresh_count",
+			Help:      "count of object storage credential refreshes",
+		}, []string{roleNameLabelName, statusLabelName})
 )
 
 // RegisterStorageMetrics registers storage metrics
@@ -61,4 +77,6 @@ func RegisterStorageMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(PersistentDataKvSize)
 	registry.MustRegister(PersistentDataRequestLatency)
 	registry.MustRegister(PersistentDataOpCounter)
+	registry.MustRegister(PersistentDataCredentialExpiry)
+	registry.MustRegister(PersistentDataCredentialRefreshCounter)
 }
@@ -279,6 +279,89 @@ var (
 			nodeIDLabelName,
 		})
 
+	// ProxyMirrorRequestCount record the number of mirrored search/query requests by status.
+	ProxyMirrorRequestCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "mirror_request_count",
+			Help:      "count of search/query requests mirrored to the shadow target",
+		}, []string{nodeIDLabelName, queryTypeLabelName, statusLabelName})
+
+	// ProxySearchRecall records the recall of the sampled searches against the exact search.
+	ProxySearchRecall = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "search_recall",
+			Help:      "recall of the sampled searches against the exact search",
+			Buckets:   prometheus.LinearBuckets(0.05, 0.05, 20),
+		}, []string{nodeIDLabelName, collectionName, fieldNameLabelName})
+
+	// ProxyRecallSampleCount records the number of the searches sampled to estimate the recall by status.
+	ProxyRecallSampleCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "recall_sample_count",
+			Help:      "count of the searches sampled to estimate the recall",
+		}, []string{nodeIDLabelName, statusLabelName})
+
+	// ProxyFederationTargetHealthy records whether the target clusters of federation are healthy.
+	ProxyFederationTargetHealthy = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "federation_target_healthy",
+			Help:      "whether the target cluster of federation is healthy, 1 for healthy and 0 for unhealthy",
+		}, []string{nodeIDLabelName, federationTargetLabelName})
+
+	// ProxyInsertValidationRowCount records the number of inserted rows rejected or coerced by the validation checks.
+	ProxyInsertValidationRowCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "insert_validation_row_count",
+			Help:      "count of inserted rows rejected or coerced by the validation checks",
+		}, []string{nodeIDLabelName, collectionName, validationCheckLabelName, statusLabelName})
+
+	// ProxyMethodInflightNum records the number of executing calls of the methods with the concurrency limit.
+	ProxyMethodInflightNum = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "method_inflight_num",
+			Help:      "number of executing calls of the method with the concurrency limit",
+		}, []string{nodeIDLabelName, functionLabelName})
+
+	// ProxyMethodQueueLength records the number of calls waiting for the methods with the concurrency limit.
+	ProxyMethodQueueLength = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "method_queue_length",
+			Help:      "number of calls waiting for the method with the concurrency limit",
+		}, []string{nodeIDLabelName, functionLabelName})
+
+	// ProxyMethodQueueLatency records the time the calls wait for the methods with the concurrency limit.
+	ProxyMethodQueueLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "method_queue_latency",
+			Help:      "latency which calls wait for the method with the concurrency limit",
+			Buckets:   buckets, // unit: ms
+		}, []string{nodeIDLabelName, functionLabelName})
+
+	// ProxyMethodRejectedCount records the number of calls rejected since the queue of the method is full.
+	ProxyMethodRejectedCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "method_rejected_count",
+			Help:      "count of calls rejected by the concurrency limit of the method",
+		}, []string{nodeIDLabelName, functionLabelName})
+
 	ProxyExecutingTotalNq = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: milvusNamespace,
@@ -330,6 +413,16 @@ func RegisterProxy(registry *prometheus.Registry) {
 	registry.MustRegister(UserRPCCounter)
 
 	registry.MustRegister(ProxyWorkLoadScore)
+	registry.MustRegister(ProxyMirrorRequestCount)
+	registry.MustRegister(ProxySearchRecall)
+	registry.MustRegister(ProxyRecallSampleCount)
+	registry.MustRegister(ProxyFederationTargetHealthy)
+	registry.MustRegister(ProxyInsertValidationRowCount)
+
+	registry.MustRegister(ProxyMethodInflightNum)
+	registry.MustRegister(ProxyMethodQueueLength)
+	registry.MustRegister(ProxyMethodQueueLatency)
+	registry.MustRegister(ProxyMethodRejectedCount)
 }
 
 func CleanupCollectionMetrics(nodeID int64, collection string) {
@@ -355,4 +448,8 @@ func CleanupCollectionMetrics(nodeID int64, collection string) {
 		msgTypeLabelName: DeleteLabel, collectionName: collection})
 	ProxyReceiveBytes.Delete(prometheus.Labels{nodeIDLabelName: strconv.FormatInt(nodeID, 10),
 		msgTypeLabelName: UpsertLabel, collectionName: collection})
+	ProxyInsertValidationRowCount.DeletePartialMatch(prometheus.Labels{nodeIDLabelName: strconv.FormatInt(nodeID, 10),
+		collectionName: collection})
+	ProxySearchRecall.DeletePartialMatch(prometheus.Labels{nodeIDLabelName: strconv.FormatInt(nodeID, 10),
+		collectionName: collection})
 }
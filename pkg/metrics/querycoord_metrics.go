@@ -104,6 +104,14 @@ var (
 			Name:      "querynode_num",
 			Help:      "number of QueryNodes managered by QueryCoord",
 		}, []string{})
+
+	QueryCoordDivergedLeaderNum = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryCoordRole,
+			Name:      "diverged_leader_num",
+			Help:      "number of shard leaders out of sync with the distribution or current target longer than the threshold",
+		}, []string{collectionIDLabelName})
 )
 
 // RegisterQueryCoord registers QueryCoord metrics
@@ -116,4 +124,5 @@ func RegisterQueryCoord(registry *prometheus.Registry) {
 	registry.MustRegister(QueryCoordReleaseLatency)
 	registry.MustRegister(QueryCoordTaskNum)
 	registry.MustRegister(QueryCoordNumQueryNodes)
+	registry.MustRegister(QueryCoordDivergedLeaderNum)
 }
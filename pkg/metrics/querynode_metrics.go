@@ -47,6 +47,17 @@ var (
 			collectionIDLabelName,
 		})
 
+	QueryNodeDeleteAppliedLag = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "delete_applied_lag_ms",
+			Help:      "now time minus the ts before which deletes have been applied per shard",
+		}, []string{
+			nodeIDLabelName,
+			channelNameLabelName,
+		})
+
 	QueryNodeProcessCost = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Namespace: milvusNamespace,
@@ -279,6 +290,27 @@ var (
 			nodeIDLabelName,
 		})
 
+	QueryNodeSearchParallelism = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "search_parallelism",
+			Help:      "number of segments searched concurrently by a search request, decided by the parallelism governor",
+		}, []string{
+			nodeIDLabelName,
+		})
+
+	QueryNodeConsistencyWaitExceeded = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "consistency_wait_exceeded_count",
+			Help:      "count of the reads failed as waiting for the serviceable time longer than the consistency wait budget",
+		}, []string{
+			nodeIDLabelName,
+			collectionIDLabelName,
+		})
+
 	QueryNodeSearchGroupNQ = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Namespace: milvusNamespace,
@@ -454,6 +486,8 @@ func RegisterQueryNode(registry *prometheus.Registry) {
 	registry.MustRegister(QueryNodeReadTaskReadyLen)
 	registry.MustRegister(QueryNodeReadTaskConcurrency)
 	registry.MustRegister(QueryNodeEstimateCPUUsage)
+	registry.MustRegister(QueryNodeSearchParallelism)
+	registry.MustRegister(QueryNodeConsistencyWaitExceeded)
 	registry.MustRegister(QueryNodeSearchGroupNQ)
 	registry.MustRegister(QueryNodeSearchNQ)
 	registry.MustRegister(QueryNodeSearchGroupSize)
@@ -467,6 +501,7 @@ func RegisterQueryNode(registry *prometheus.Registry) {
 	registry.MustRegister(QueryNodeExecuteCounter)
 	registry.MustRegister(QueryNodeConsumerMsgCount)
 	registry.MustRegister(QueryNodeConsumeTimeTickLag)
+	registry.MustRegister(QueryNodeDeleteAppliedLag)
 	registry.MustRegister(QueryNodeMsgDispatcherTtLag)
 	registry.MustRegister(QueryNodeSegmentSearchLatencyPerVector)
 	registry.MustRegister(QueryNodeWatchDmlChannelLatency)
@@ -475,6 +510,10 @@ func RegisterQueryNode(registry *prometheus.Registry) {
 }
 
 func CleanupQueryNodeCollectionMetrics(nodeID int64, collectionID int64) {
+	QueryNodeConsistencyWaitExceeded.Delete(prometheus.Labels{
+		nodeIDLabelName:       fmt.Sprint(nodeID),
+		collectionIDLabelName: fmt.Sprint(collectionID),
+	})
 	for _, label := range []string{DeleteLabel, InsertLabel} {
 		QueryNodeConsumerMsgCount.
 			Delete(
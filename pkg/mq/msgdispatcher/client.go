@@ -17,6 +17,8 @@
 package msgdispatcher
 
 import (
+	"fmt"
+
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"go.uber.org/zap"
 
@@ -36,6 +38,8 @@ type (
 type Client interface {
 	Register(vchannel string, pos *Pos, subPos SubPos) (<-chan *MsgPack, error)
 	Deregister(vchannel string)
+	GetState(vchannel string) *DispatcherState
+	Seek(vchannel string, pos *Pos) error
 	Close()
 }
 
@@ -94,6 +98,29 @@ func (c *client) Deregister(vchannel string) {
 	}
 }
 
+// GetState returns the state of the dispatcher consuming the vchannel, nil if the vchannel is not registered.
+func (c *client) GetState(vchannel string) *DispatcherState {
+	manager, ok := c.managers.Get(funcutil.ToPhysicalChannel(vchannel))
+	if !ok {
+		return nil
+	}
+	return manager.GetState(vchannel)
+}
+
+// Seek makes the registered vchannel consume from the given position.
+func (c *client) Seek(vchannel string, pos *Pos) error {
+	manager, ok := c.managers.Get(funcutil.ToPhysicalChannel(vchannel))
+	if !ok {
+		return fmt.Errorf("vchannel not registered, vchannel=%s", vchannel)
+	}
+	if err := manager.Seek(vchannel, pos); err != nil {
+		return err
+	}
+	log.Info("seek done", zap.String("role", c.role), zap.Int64("nodeID", c.nodeID),
+		zap.String("vchannel", vchannel), zap.Uint64("ts", pos.GetTimestamp()))
+	return nil
+}
+
 func (c *client) Close() {
 	log := log.With(zap.String("role", c.role),
 		zap.Int64("nodeID", c.nodeID))
@@ -34,9 +34,18 @@ func TestClient(t *testing.T) {
 	assert.NotNil(t, client)
 	_, err := client.Register("mock_vchannel_0", nil, mqwrapper.SubscriptionPositionUnknown)
 	assert.NoError(t, err)
+	state := client.GetState("mock_vchannel_0")
+	assert.NotNil(t, state)
+	assert.Equal(t, "mock_vchannel", state.PChannel)
+	assert.True(t, state.IsMain)
+	assert.Nil(t, client.GetState("mock_vchannel_1"))
+	assert.Nil(t, client.GetState("not_exist_vchannel_0"))
+	assert.Error(t, client.Seek("not_exist_vchannel_0", &Pos{MsgID: []byte{1}}))
+	assert.Error(t, client.Seek("mock_vchannel_0", nil))
 	assert.NotPanics(t, func() {
 		client.Deregister("mock_vchannel_0")
 	})
+	assert.Nil(t, client.GetState("mock_vchannel_0"))
 }
 
 func TestClient_Concurrency(t *testing.T) {
@@ -157,6 +157,17 @@ func (d *Dispatcher) CloseTarget(vchannel string) {
 	}
 }
 
+// DetachTarget removes the target without closing it, the caller takes over the target.
+func (d *Dispatcher) DetachTarget(vchannel string) (*target, error) {
+	t, err := d.GetTarget(vchannel)
+	if err != nil {
+		return nil, err
+	}
+	delete(d.targets, vchannel)
+	log.Info("detached target", zap.String("vchannel", vchannel), zap.Bool("isMain", d.isMain))
+	return t, nil
+}
+
 func (d *Dispatcher) TargetNum() int {
 	return len(d.targets)
 }
@@ -224,6 +235,8 @@ func (d *Dispatcher) work() {
 					}
 				}
 				if err != nil {
+					// the pack is not delivered, the target will consume the messages again after seeking
+					recycleExclusiveMsgs(p)
 					t.pos = pack.StartPositions[0]
 					// replace the pChannel with vChannel
 					t.pos.ChannelName = t.vchannel
@@ -231,6 +244,8 @@ func (d *Dispatcher) work() {
 					d.nonBlockingNotify()
 					delete(d.targets, vchannel)
 					log.Warn("lag target notified", zap.Error(err))
+				} else {
+					t.latestPos.Store(pack.EndPositions[0])
 				}
 			}
 		}
@@ -252,13 +267,7 @@ func (d *Dispatcher) groupingMsgs(pack *MsgPack) map[string]*MsgPack {
 	}
 	// group messages by vchannel
 	for _, msg := range pack.Msgs {
-		var vchannel string
-		switch msg.Type() {
-		case commonpb.MsgType_Insert:
-			vchannel = msg.(*msgstream.InsertMsg).GetShardName()
-		case commonpb.MsgType_Delete:
-			vchannel = msg.(*msgstream.DeleteMsg).GetShardName()
-		}
+		vchannel := getVChannel(msg)
 		if vchannel == "" {
 			// for non-dml msg, such as CreateCollection, DropCollection, ...
 			// we need to dispatch it to all the vchannels.
@@ -269,11 +278,35 @@ func (d *Dispatcher) groupingMsgs(pack *MsgPack) map[string]*MsgPack {
 		}
 		if _, ok := targetPacks[vchannel]; ok {
 			targetPacks[vchannel].Msgs = append(targetPacks[vchannel].Msgs, msg)
+		} else {
+			// no target cares about the dml msg, recycle it right away
+			msgstream.RecycleMsg(msg)
 		}
 	}
 	return targetPacks
 }
 
+// getVChannel returns the vchannel of the dml msg, or empty for the non-dml msg.
+func getVChannel(msg msgstream.TsMsg) string {
+	switch msg.Type() {
+	case commonpb.MsgType_Insert:
+		return msg.(*msgstream.InsertMsg).GetShardName()
+	case commonpb.MsgType_Delete:
+		return msg.(*msgstream.DeleteMsg).GetShardName()
+	}
+	return ""
+}
+
+// recycleExclusiveMsgs recycles the dml msgs of the pack, which are owned by its target only,
+// the non-dml msgs are shared with the other targets.
+func recycleExclusiveMsgs(pack *MsgPack) {
+	for _, msg := range pack.Msgs {
+		if getVChannel(msg) != "" {
+			msgstream.RecycleMsg(msg)
+		}
+	}
+}
+
 func (d *Dispatcher) nonBlockingNotify() {
 	select {
 	case d.lagNotifyChan <- struct{}{}:
@@ -21,6 +21,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
@@ -84,6 +86,43 @@ func TestDispatcher(t *testing.T) {
 		assert.Equal(t, 1, num)
 	})
 
+	t.Run("test grouping msgs", func(t *testing.T) {
+		unmarshal := func(msg msgstream.TsMsg) msgstream.TsMsg {
+			bytes, err := msg.Marshal(msg)
+			assert.NoError(t, err)
+			msg, err = msg.Unmarshal(bytes)
+			assert.NoError(t, err)
+			return msg
+		}
+		insert := func(vchannel string) msgstream.TsMsg {
+			return unmarshal(&msgstream.InsertMsg{InsertRequest: msgpb.InsertRequest{
+				Base:       &commonpb.MsgBase{MsgType: commonpb.MsgType_Insert},
+				ShardName:  vchannel,
+				Timestamps: []uint64{1},
+				RowIDs:     []int64{1},
+			}})
+		}
+		d := &Dispatcher{targets: map[string]*target{
+			"mock_vchannel_0": newTarget("mock_vchannel_0", nil),
+		}}
+		matched := insert("mock_vchannel_0")
+		unmatched := insert("mock_vchannel_1")
+		timeTick := &msgstream.TimeTickMsg{TimeTickMsg: msgpb.TimeTickMsg{
+			Base: &commonpb.MsgBase{MsgType: commonpb.MsgType_TimeTick},
+		}}
+		packs := d.groupingMsgs(&MsgPack{Msgs: []msgstream.TsMsg{matched, unmatched, timeTick}})
+		assert.Equal(t, 1, len(packs))
+		assert.Equal(t, []msgstream.TsMsg{matched, timeTick}, packs["mock_vchannel_0"].Msgs)
+		assert.Equal(t, "mock_vchannel_0", matched.(*msgstream.InsertMsg).GetShardName())
+		// the msg not dispatched is recycled
+		assert.Empty(t, unmatched.(*msgstream.InsertMsg).GetShardName())
+
+		// the non-dml msgs are not recycled
+		recycleExclusiveMsgs(packs["mock_vchannel_0"])
+		assert.Empty(t, matched.(*msgstream.InsertMsg).GetShardName())
+		assert.Equal(t, commonpb.MsgType_TimeTick, timeTick.Type())
+	})
+
 	t.Run("test concurrent send and close", func(t *testing.T) {
 		for i := 0; i < 100; i++ {
 			output := make(chan *msgstream.MsgPack, 1024)
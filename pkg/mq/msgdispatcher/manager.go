@@ -42,6 +42,8 @@ type DispatcherManager interface {
 	Add(vchannel string, pos *Pos, subPos SubPos) (<-chan *MsgPack, error)
 	Remove(vchannel string)
 	Num() int
+	GetState(vchannel string) *DispatcherState
+	Seek(vchannel string, pos *Pos) error
 	Run()
 	Close()
 }
@@ -145,6 +147,97 @@ func (c *dispatcherManager) Num() int {
 	return res + len(c.soloDispatchers)
 }
 
+// DispatcherState is the state of the dispatcher consuming a vchannel, for diagnosis.
+type DispatcherState struct {
+	PChannel  string
+	IsMain    bool   // whether the vchannel is consumed by the main dispatcher
+	CurTs     uint64 // the latest timetick the dispatcher consumed
+	Lagging   bool   // whether the vchannel is lagging and waiting to be split from the main dispatcher
+	BufferLen int    // the number of packs buffered for the vchannel
+	Position  *Pos   // the end position of the latest pack delivered to the vchannel, nil if nothing delivered
+}
+
+// GetState returns the state of the dispatcher consuming the vchannel, nil if the vchannel is not registered.
+func (c *dispatcherManager) GetState(vchannel string) *DispatcherState {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	state := &DispatcherState{PChannel: c.pchannel}
+	d, ok := c.soloDispatchers[vchannel]
+	if !ok {
+		if c.mainDispatcher == nil {
+			return nil
+		}
+		d = c.mainDispatcher
+		state.IsMain = true
+	}
+	t, err := d.GetTarget(vchannel)
+	if err != nil {
+		return nil
+	}
+	state.CurTs = d.CurTs()
+	state.Lagging = c.lagTargets.Contain(vchannel)
+	state.BufferLen = len(t.ch)
+	state.Position = t.latestPosition()
+	return state
+}
+
+// Seek makes the vchannel consume from the given position by a new solo dispatcher,
+// the packs are still delivered to the channel returned by Add. It's used to recover
+// the vchannel whose checkpoint is wrong, so the packs may be replayed or skipped.
+func (c *dispatcherManager) Seek(vchannel string, pos *Pos) error {
+	log := log.With(zap.String("role", c.role),
+		zap.Int64("nodeID", c.nodeID), zap.String("vchannel", vchannel))
+	if pos == nil || len(pos.GetMsgID()) == 0 {
+		return fmt.Errorf("invalid seek position, vchannel=%s", vchannel)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var t *target
+	if solo, ok := c.soloDispatchers[vchannel]; ok {
+		solo.Handle(terminate)
+		t, _ = solo.GetTarget(vchannel)
+		delete(c.soloDispatchers, vchannel)
+		c.deleteMetric(vchannel)
+	} else if c.mainDispatcher != nil {
+		c.mainDispatcher.Handle(pause)
+		t, _ = c.mainDispatcher.DetachTarget(vchannel)
+		c.mainDispatcher.Handle(resume)
+	}
+	// the lagging target has been removed from its dispatcher and waits to be split
+	if lagTarget, ok := c.lagTargets.GetAndRemove(vchannel); ok {
+		t = lagTarget
+	}
+	if t == nil {
+		return fmt.Errorf("cannot find target, vchannel=%s", vchannel)
+	}
+
+	seekPos := &Pos{
+		ChannelName: vchannel,
+		MsgID:       pos.GetMsgID(),
+		MsgGroup:    pos.GetMsgGroup(),
+		Timestamp:   pos.GetTimestamp(),
+	}
+	newSolo, err := NewDispatcher(c.factory, false, c.pchannel, seekPos,
+		c.constructSubName(vchannel, false), mqwrapper.SubscriptionPositionUnknown, c.lagNotifyChan, c.lagTargets)
+	if err != nil {
+		log.Warn("seek failed, resume from the latest delivered position", zap.Error(err))
+		if latest := t.latestPosition(); latest != nil {
+			t.pos = latest
+		}
+		c.split(t)
+		return err
+	}
+	seekPos.ChannelName = vchannel
+	t.pos = seekPos
+	newSolo.AddTarget(t)
+	c.soloDispatchers[vchannel] = newSolo
+	newSolo.Handle(start)
+	log.Info("seek done", zap.Time("posTime", tsoutil.PhysicalTime(pos.GetTimestamp())))
+	return nil
+}
+
 func (c *dispatcherManager) Close() {
 	c.closeOnce.Do(func() {
 		c.closeChan <- struct{}{}
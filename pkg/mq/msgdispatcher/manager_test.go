@@ -83,6 +83,21 @@ func TestManager(t *testing.T) {
 		assert.Equal(t, 2, c.Num())
 	})
 
+	t.Run("test seek invalid", func(t *testing.T) {
+		prefix := fmt.Sprintf("mock%d", time.Now().UnixNano())
+		c := NewDispatcherManager(prefix+"_pchannel_0", typeutil.ProxyRole, 1, newMockFactory())
+		assert.NotNil(t, c)
+		_, err := c.Add("mock_vchannel_0", nil, mqwrapper.SubscriptionPositionUnknown)
+		assert.NoError(t, err)
+
+		err = c.Seek("mock_vchannel_0", nil)
+		assert.Error(t, err)
+		err = c.Seek("mock_vchannel_1", &Pos{MsgID: []byte{1}})
+		assert.Error(t, err)
+		assert.Equal(t, 1, c.Num())
+		c.Remove("mock_vchannel_0")
+	})
+
 	t.Run("test run and close", func(t *testing.T) {
 		prefix := fmt.Sprintf("mock%d", time.Now().UnixNano())
 		c := NewDispatcherManager(prefix+"_pchannel_0", typeutil.ProxyRole, 1, newMockFactory())
@@ -337,6 +352,54 @@ func (suite *SimulationSuite) TestSplit() {
 	cancel()
 }
 
+func (suite *SimulationSuite) TestSeek() {
+	ctx, cancel := context.WithCancel(context.Background())
+	go suite.produceTimeTickOnly(ctx)
+
+	const vchannelNum = 3
+	suite.vchannels = make(map[string]*vchannelHelper, vchannelNum)
+	positions, err := getSeekPositions(suite.factory, suite.pchannel, 100)
+	assert.NoError(suite.T(), err)
+	assert.NotEqual(suite.T(), 0, len(positions))
+
+	for i := 0; i < vchannelNum; i++ {
+		vchannel := fmt.Sprintf("%s_vchannelv%d", suite.pchannel, i)
+		output, err := suite.manager.Add(vchannel, nil, mqwrapper.SubscriptionPositionEarliest)
+		assert.NoError(suite.T(), err)
+		suite.vchannels[vchannel] = &vchannelHelper{output: output}
+	}
+	seekVChannel := fmt.Sprintf("%s_vchannelv%d", suite.pchannel, 0)
+	var latest *Pos
+	suite.Eventually(func() bool {
+		for vchannel, helper := range suite.vchannels {
+			for len(helper.output) > 0 {
+				<-helper.output
+			}
+			if vchannel == seekVChannel {
+				latest = suite.manager.GetState(vchannel).Position
+			}
+		}
+		return latest != nil && latest.GetTimestamp() > positions[0].GetTimestamp()
+	}, 10*time.Second, 10*time.Millisecond)
+	suite.Equal(seekVChannel, latest.GetChannelName())
+
+	// replay from the first position through the same output channel
+	err = suite.manager.Seek(seekVChannel, positions[0])
+	suite.NoError(err)
+	suite.False(suite.manager.GetState(seekVChannel).IsMain)
+	output := suite.vchannels[seekVChannel].output
+	suite.Eventually(func() bool {
+		select {
+		case pack := <-output:
+			return pack.EndTs <= latest.GetTimestamp()
+		default:
+			return false
+		}
+	}, 10*time.Second, 10*time.Millisecond)
+
+	cancel()
+}
+
 func (suite *SimulationSuite) TearDownTest() {
 	for vchannel := range suite.vchannels {
 		suite.manager.Remove(vchannel)
@@ -79,6 +79,45 @@ func (_c *MockClient_Deregister_Call) Return() *MockClient_Deregister_Call {
 	return _c
 }
 
+// GetState provides a mock function with given fields: vchannel
+func (_m *MockClient) GetState(vchannel string) *DispatcherState {
+	ret := _m.Called(vchannel)
+
+	var r0 *DispatcherState
+	if rf, ok := ret.Get(0).(func(string) *DispatcherState); ok {
+		r0 = rf(vchannel)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*DispatcherState)
+		}
+	}
+
+	return r0
+}
+
+// MockClient_GetState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetState'
+type MockClient_GetState_Call struct {
+	*mock.Call
+}
+
+// GetState is a helper method to define mock.On call
+//  - vchannel string
+func (_e *MockClient_Expecter) GetState(vchannel interface{}) *MockClient_GetState_Call {
+	return &MockClient_GetState_Call{Call: _e.mock.On("GetState", vchannel)}
+}
+
+func (_c *MockClient_GetState_Call) Run(run func(vchannel string)) *MockClient_GetState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetState_Call) Return(_a0 *DispatcherState) *MockClient_GetState_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
 // Register provides a mock function with given fields: vchannel, pos, subPos
 func (_m *MockClient) Register(vchannel string, pos *msgpb.MsgPosition, subPos mqwrapper.SubscriptionInitialPosition) (<-chan *msgstream.MsgPack, error) {
 	ret := _m.Called(vchannel, pos, subPos)
@@ -127,6 +166,44 @@ func (_c *MockClient_Register_Call) Return(_a0 <-chan *msgstream.MsgPack, _a1 er
 	return _c
 }
 
+// Seek provides a mock function with given fields: vchannel, pos
+func (_m *MockClient) Seek(vchannel string, pos *msgpb.MsgPosition) error {
+	ret := _m.Called(vchannel, pos)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *msgpb.MsgPosition) error); ok {
+		r0 = rf(vchannel, pos)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockClient_Seek_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Seek'
+type MockClient_Seek_Call struct {
+	*mock.Call
+}
+
+// Seek is a helper method to define mock.On call
+//  - vchannel string
+//  - pos *msgpb.MsgPosition
+func (_e *MockClient_Expecter) Seek(vchannel interface{}, pos interface{}) *MockClient_Seek_Call {
+	return &MockClient_Seek_Call{Call: _e.mock.On("Seek", vchannel, pos)}
+}
+
+func (_c *MockClient_Seek_Call) Run(run func(vchannel string, pos *msgpb.MsgPosition)) *MockClient_Seek_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*msgpb.MsgPosition))
+	})
+	return _c
+}
+
+func (_c *MockClient_Seek_Call) Return(_a0 error) *MockClient_Seek_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
 type mockConstructorTestingTNewMockClient interface {
 	mock.TestingT
 	Cleanup(func())
@@ -20,6 +20,8 @@ import (
 	"fmt"
 	"sync"
 	"time"
+
+	"go.uber.org/atomic"
 )
 
 // TODO: dyh, move to config
@@ -33,6 +35,9 @@ type target struct {
 	ch       chan *MsgPack
 	pos      *Pos
 
+	// the end position of the latest pack delivered to the target
+	latestPos atomic.Pointer[Pos]
+
 	closeMu   sync.Mutex
 	closeOnce sync.Once
 	closed    bool
@@ -48,6 +53,21 @@ func newTarget(vchannel string, pos *Pos) *target {
 	return t
 }
 
+// latestPosition returns the end position of the latest pack delivered to the target
+// with the vchannel as channel name, nil if no pack delivered yet.
+func (t *target) latestPosition() *Pos {
+	pos := t.latestPos.Load()
+	if pos == nil {
+		return nil
+	}
+	return &Pos{
+		ChannelName: t.vchannel,
+		MsgID:       pos.GetMsgID(),
+		MsgGroup:    pos.GetMsgGroup(),
+		Timestamp:   pos.GetTimestamp(),
+	}
+}
+
 func (t *target) close() {
 	t.closeMu.Lock()
 	defer t.closeMu.Unlock()
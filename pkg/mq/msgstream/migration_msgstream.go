@@ -0,0 +1,389 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"context"
+	"math"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// Phases of the mq migration, see mq.migration.phase.
+const (
+	MigrationPhaseDualWrite = "dualWrite"
+	MigrationPhaseCutover   = "cutover"
+)
+
+var _ Factory = (*MigrationFactory)(nil)
+
+// MigrationRecorder records the time the dual write of the channels started, which must survive the restarts.
+type MigrationRecorder interface {
+	// RecordDualWriteStart records ts as the start of the dual write of the channel, unless a later one is recorded.
+	RecordDualWriteStart(channel string, ts uint64) error
+	// GetDualWriteStart returns the start of the dual write of the channel, 0 if not recorded.
+	GetDualWriteStart(channel string) (uint64, error)
+}
+
+// MigrationFactory creates the msgstreams migrating from the source mq to the target mq without stopping the ingestion.
+// The msgstreams write to both mqs, and read from the source mq in the dualWrite phase, from the target mq in the cutover phase.
+// The phase is applied to the newly created msgstreams, so the nodes shall be restarted to cut over.
+//
+// The positions of the source mq are meaningless to the target mq, so the consumers seek the target mq
+// from the earliest message and skip the messages before the timestamp of the positions, i.e. the channel checkpoints.
+// It's correct only if the target mq retains all the messages after the checkpoints, so the producers record
+// the time the dual write of each channel started, and the consumers keep reading from the source mq
+// until the checkpoint of the channel is later than the recorded time.
+type MigrationFactory struct {
+	source   Factory
+	target   Factory
+	recorder MigrationRecorder
+}
+
+// NewMigrationFactory creates a MigrationFactory from the source mq to the target mq.
+func NewMigrationFactory(source, target Factory, recorder MigrationRecorder) *MigrationFactory {
+	return &MigrationFactory{
+		source:   source,
+		target:   target,
+		recorder: recorder,
+	}
+}
+
+func (f *MigrationFactory) newMsgStream(ctx context.Context, newer func(Factory, context.Context) (MsgStream, error)) (MsgStream, error) {
+	phase := paramtable.Get().MQCfg.MigrationPhase.GetValue()
+	if phase != MigrationPhaseDualWrite && phase != MigrationPhaseCutover {
+		return nil, errors.Newf("invalid mq migration phase %s", phase)
+	}
+	source, err := newer(f.source, ctx)
+	if err != nil {
+		return nil, err
+	}
+	target, err := newer(f.target, ctx)
+	if err != nil {
+		source.Close()
+		return nil, errors.Wrap(err, "failed to create msgstream of the target mq")
+	}
+	return newMigrationMsgStream(source, target, f.recorder, phase == MigrationPhaseCutover), nil
+}
+
+// NewMsgStream is used to generate a new Msgstream object
+func (f *MigrationFactory) NewMsgStream(ctx context.Context) (MsgStream, error) {
+	return f.newMsgStream(ctx, Factory.NewMsgStream)
+}
+
+// NewTtMsgStream is used to generate a new TtMsgstream object
+func (f *MigrationFactory) NewTtMsgStream(ctx context.Context) (MsgStream, error) {
+	return f.newMsgStream(ctx, Factory.NewTtMsgStream)
+}
+
+// NewQueryMsgStream is used to generate a new QueryMsgstream object
+func (f *MigrationFactory) NewQueryMsgStream(ctx context.Context) (MsgStream, error) {
+	return f.newMsgStream(ctx, Factory.NewQueryMsgStream)
+}
+
+// NewMsgStreamDisposer disposes the subscriptions of both mqs.
+func (f *MigrationFactory) NewMsgStreamDisposer(ctx context.Context) func([]string, string) error {
+	sourceDisposer := f.source.NewMsgStreamDisposer(ctx)
+	targetDisposer := f.target.NewMsgStreamDisposer(ctx)
+	return func(channels []string, subName string) error {
+		if err := sourceDisposer(channels, subName); err != nil {
+			return err
+		}
+		return targetDisposer(channels, subName)
+	}
+}
+
+var _ MsgStream = (*migrationMsgStream)(nil)
+
+type consumerArgs struct {
+	channels []string
+	subName  string
+	position mqwrapper.SubscriptionInitialPosition
+}
+
+// migrationMsgStream writes to both the source and the target msgstreams, and reads from one of them.
+type migrationMsgStream struct {
+	source   MsgStream
+	target   MsgStream
+	recorder MigrationRecorder
+	cutover  bool
+
+	mu sync.Mutex
+	// the msgstream read from, which is decided by seeking in the cutover phase
+	reader  MsgStream
+	pending []consumerArgs // the subscriptions waiting for the reader
+	// whether the start of the dual write is recorded
+	recorded bool
+	// the pack written to the source mq but not to the target mq, and its message ids of the source mq if broadcast
+	partial    *MsgPack
+	partialIDs map[string][]MessageID
+}
+
+func newMigrationMsgStream(source, target MsgStream, recorder MigrationRecorder, cutover bool) *migrationMsgStream {
+	ms := &migrationMsgStream{
+		source:   source,
+		target:   target,
+		recorder: recorder,
+		cutover:  cutover,
+	}
+	if !cutover {
+		ms.reader = source
+	}
+	return ms
+}
+
+func (ms *migrationMsgStream) Close() {
+	ms.source.Close()
+	ms.target.Close()
+}
+
+func (ms *migrationMsgStream) AsProducer(channels []string) {
+	ms.source.AsProducer(channels)
+	ms.target.AsProducer(channels)
+}
+
+// Produce writes the messages to both mqs, the write fails if either mq fails,
+// so that the target mq never misses the messages of the source mq.
+// If it fails after written to the source mq, retrying to produce the same pack only writes the target mq,
+// so that the messages are not duplicated in the source mq.
+func (ms *migrationMsgStream) Produce(msgPack *MsgPack) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	if err := ms.settlePartial(msgPack); err != nil {
+		return err
+	}
+	if ms.partial == nil {
+		if err := ms.recordDualWriteStart(msgPack); err != nil {
+			return err
+		}
+		if err := ms.source.Produce(msgPack); err != nil {
+			return err
+		}
+	}
+	if err := ms.target.Produce(msgPack); err != nil {
+		ms.partial = msgPack
+		return errors.Wrap(err, "produced to the source mq but failed to produce to the target mq")
+	}
+	ms.partial = nil
+	return nil
+}
+
+func (ms *migrationMsgStream) SetRepackFunc(repackFunc RepackFunc) {
+	ms.source.SetRepackFunc(repackFunc)
+	ms.target.SetRepackFunc(repackFunc)
+}
+
+func (ms *migrationMsgStream) GetProduceChannels() []string {
+	return ms.source.GetProduceChannels()
+}
+
+// Broadcast writes the messages to both mqs, returns the message ids of the mq read from.
+// The partial write is handled the same as Produce.
+func (ms *migrationMsgStream) Broadcast(msgPack *MsgPack) (map[string][]MessageID, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	if err := ms.settlePartial(msgPack); err != nil {
+		return nil, err
+	}
+	sourceIDs := ms.partialIDs
+	if ms.partial == nil {
+		if err := ms.recordDualWriteStart(msgPack); err != nil {
+			return nil, err
+		}
+		var err error
+		if sourceIDs, err = ms.source.Broadcast(msgPack); err != nil {
+			return nil, err
+		}
+	}
+	targetIDs, err := ms.target.Broadcast(msgPack)
+	if err != nil {
+		ms.partial, ms.partialIDs = msgPack, sourceIDs
+		return nil, errors.Wrap(err, "broadcast to the source mq but failed to broadcast to the target mq")
+	}
+	ms.partial, ms.partialIDs = nil, nil
+	if ms.cutover {
+		return targetIDs, nil
+	}
+	return sourceIDs, nil
+}
+
+// settlePartial gives up the pack written to the source mq only if the caller writes another pack instead of retrying it.
+// The target mq misses the messages of the pack, so the dual write is recorded to start after the pack,
+// which defers the cutover of the channels until their checkpoints are later than the pack.
+func (ms *migrationMsgStream) settlePartial(msgPack *MsgPack) error {
+	if ms.partial == nil || ms.partial == msgPack {
+		return nil
+	}
+	_, endTs := packTsRange(ms.partial)
+	for _, channel := range ms.source.GetProduceChannels() {
+		if err := ms.recorder.RecordDualWriteStart(channel, endTs+1); err != nil {
+			return errors.Wrap(err, "failed to record the messages missed by the target mq")
+		}
+	}
+	log.Warn("the target mq misses the messages written to the source mq, the dual write is recorded to start after them",
+		zap.Strings("channels", ms.source.GetProduceChannels()), zap.Uint64("endTs", endTs))
+	ms.partial, ms.partialIDs = nil, nil
+	return nil
+}
+
+// recordDualWriteStart records the start of the dual write of the produce channels before the first write in the dualWrite phase,
+// the msgstreams created in the cutover phase were dual writing already.
+func (ms *migrationMsgStream) recordDualWriteStart(msgPack *MsgPack) error {
+	if ms.cutover || ms.recorded || len(msgPack.Msgs) == 0 {
+		return nil
+	}
+	beginTs, _ := packTsRange(msgPack)
+	for _, channel := range ms.source.GetProduceChannels() {
+		if err := ms.recorder.RecordDualWriteStart(channel, beginTs); err != nil {
+			return errors.Wrap(err, "failed to record the start of the dual write")
+		}
+	}
+	ms.recorded = true
+	return nil
+}
+
+// packTsRange returns the min begin timestamp and the max end timestamp of the messages.
+func packTsRange(msgPack *MsgPack) (uint64, uint64) {
+	beginTs, endTs := uint64(math.MaxUint64), uint64(0)
+	for _, msg := range msgPack.Msgs {
+		if msg.BeginTs() < beginTs {
+			beginTs = msg.BeginTs()
+		}
+		if msg.EndTs() > endTs {
+			endTs = msg.EndTs()
+		}
+	}
+	return beginTs, endTs
+}
+
+// AsConsumer subscribes the source mq in the dualWrite phase. In the cutover phase, the subscription waits
+// until seeking decides the mq to read from, or goes to the target mq once read without seeking.
+func (ms *migrationMsgStream) AsConsumer(channels []string, subName string, position mqwrapper.SubscriptionInitialPosition) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	if ms.reader != nil {
+		ms.reader.AsConsumer(channels, subName, position)
+		return
+	}
+	ms.pending = append(ms.pending, consumerArgs{channels: channels, subName: subName, position: position})
+}
+
+// getReader returns the msgstream read from, the target mq is subscribed if not decided yet.
+func (ms *migrationMsgStream) getReader() MsgStream {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	if ms.reader == nil {
+		ms.subscribe(ms.target)
+	}
+	return ms.reader
+}
+
+func (ms *migrationMsgStream) subscribe(reader MsgStream) {
+	ms.reader = reader
+	for _, args := range ms.pending {
+		reader.AsConsumer(args.channels, args.subName, args.position)
+	}
+	ms.pending = nil
+}
+
+func (ms *migrationMsgStream) Chan() <-chan *MsgPack {
+	return ms.getReader().Chan()
+}
+
+// Seek seeks the source mq to the positions in the dualWrite phase.
+// In the cutover phase, the target mq is sought from the earliest message, and the timetick msgstream skips the messages
+// before the timestamp of the positions. The cutover is deferred, i.e. the source mq is still read, if the target mq
+// may miss the messages after the positions, that is any of the positions is earlier than the start of the dual write.
+func (ms *migrationMsgStream) Seek(positions []*MsgPosition) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	if !ms.cutover {
+		return ms.source.Seek(positions)
+	}
+
+	deferred, err := ms.shouldDeferCutover(positions)
+	if err != nil {
+		return err
+	}
+	if deferred {
+		if ms.reader == ms.target {
+			return errors.New("the target mq is read already, but it misses the messages after the positions")
+		}
+		ms.subscribe(ms.source)
+		return ms.source.Seek(positions)
+	}
+
+	ttStream, ok := ms.target.(*MqTtMsgStream)
+	if !ok {
+		return errors.New("only the timetick msgstream could seek the target mq by timestamp in the cutover phase")
+	}
+	if ms.reader == ms.source {
+		return errors.New("the source mq is read already, the cutover is deferred")
+	}
+	ms.subscribe(ms.target)
+	earliest := ttStream.client.EarliestMessageID().Serialize()
+	for _, position := range positions {
+		log.Info("seek the target mq by the timestamp of the position in the mq migration",
+			zap.String("channel", position.GetChannelName()),
+			zap.Uint64("timestamp", position.GetTimestamp()))
+	}
+	return ms.target.Seek(rewritePositionsToEarliest(positions, earliest))
+}
+
+// shouldDeferCutover returns true if any of the positions is earlier than the start of the dual write of its channel.
+func (ms *migrationMsgStream) shouldDeferCutover(positions []*MsgPosition) (bool, error) {
+	for _, position := range positions {
+		start, err := ms.recorder.GetDualWriteStart(position.GetChannelName())
+		if err != nil {
+			return false, errors.Wrap(err, "failed to get the start of the dual write")
+		}
+		if start == 0 || position.GetTimestamp() < start {
+			log.Warn("the checkpoint is earlier than the start of the dual write, defer the cutover and read from the source mq",
+				zap.String("channel", position.GetChannelName()),
+				zap.Uint64("checkpoint", position.GetTimestamp()),
+				zap.Uint64("dualWriteStart", start))
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// rewritePositionsToEarliest returns the positions pointing to the earliest message, the timestamps are kept.
+func rewritePositionsToEarliest(positions []*MsgPosition, earliest []byte) []*MsgPosition {
+	rewritten := make([]*MsgPosition, 0, len(positions))
+	for _, position := range positions {
+		position = proto.Clone(position).(*MsgPosition)
+		position.MsgID = earliest
+		rewritten = append(rewritten, position)
+	}
+	return rewritten
+}
+
+func (ms *migrationMsgStream) GetLatestMsgID(channel string) (MessageID, error) {
+	return ms.getReader().GetLatestMsgID(channel)
+}
+
+func (ms *migrationMsgStream) CheckTopicValid(channel string) error {
+	return ms.getReader().CheckTopicValid(channel)
+}
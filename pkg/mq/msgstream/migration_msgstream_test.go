@@ -0,0 +1,218 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// memMigrationRecorder records the start of the dual write in memory.
+type memMigrationRecorder struct {
+	starts map[string]uint64
+	err    error
+}
+
+func newMemMigrationRecorder() *memMigrationRecorder {
+	return &memMigrationRecorder{starts: make(map[string]uint64)}
+}
+
+func (r *memMigrationRecorder) RecordDualWriteStart(channel string, ts uint64) error {
+	if r.err != nil {
+		return r.err
+	}
+	if ts > r.starts[channel] {
+		r.starts[channel] = ts
+	}
+	return nil
+}
+
+func (r *memMigrationRecorder) GetDualWriteStart(channel string) (uint64, error) {
+	return r.starts[channel], r.err
+}
+
+func TestMigrationFactory(t *testing.T) {
+	paramtable.Init()
+	key := paramtable.Get().MQCfg.MigrationPhase.Key
+	defer paramtable.Get().Reset(key)
+
+	newFactory := func(stream MsgStream, err error) *MockMqFactory {
+		f := NewMockMqFactory()
+		f.NewMsgStreamFunc = func(ctx context.Context) (MsgStream, error) { return stream, err }
+		return f
+	}
+	source, target := NewMockMsgStream(t), NewMockMsgStream(t)
+	recorder := newMemMigrationRecorder()
+
+	paramtable.Get().Save(key, MigrationPhaseCutover)
+	stream, err := NewMigrationFactory(newFactory(source, nil), newFactory(target, nil), recorder).NewMsgStream(context.Background())
+	require.NoError(t, err)
+	assert.True(t, stream.(*migrationMsgStream).cutover)
+
+	paramtable.Get().Save(key, "invalid")
+	_, err = NewMigrationFactory(newFactory(source, nil), newFactory(target, nil), recorder).NewMsgStream(context.Background())
+	assert.Error(t, err)
+
+	paramtable.Get().Save(key, MigrationPhaseDualWrite)
+	source.EXPECT().Close().Return().Once()
+	_, err = NewMigrationFactory(newFactory(source, nil), newFactory(nil, errors.New("mock")), recorder).NewMsgStream(context.Background())
+	assert.Error(t, err)
+}
+
+func TestMigrationMsgStream(t *testing.T) {
+	newPack := func(ts uint64) *MsgPack {
+		return &MsgPack{Msgs: []TsMsg{&TimeTickMsg{BaseMsg: BaseMsg{BeginTimestamp: ts, EndTimestamp: ts}}}}
+	}
+	pack := newPack(100)
+
+	t.Run("dual write", func(t *testing.T) {
+		source, target := NewMockMsgStream(t), NewMockMsgStream(t)
+		recorder := newMemMigrationRecorder()
+		ms := newMigrationMsgStream(source, target, recorder, false)
+
+		source.EXPECT().AsProducer([]string{"ch"}).Return()
+		target.EXPECT().AsProducer([]string{"ch"}).Return()
+		ms.AsProducer([]string{"ch"})
+		source.EXPECT().GetProduceChannels().Return([]string{"ch"})
+
+		// the start of the dual write is recorded before the first write
+		source.EXPECT().Produce(pack).Return(nil).Once()
+		target.EXPECT().Produce(pack).Return(nil).Once()
+		assert.NoError(t, ms.Produce(pack))
+		assert.EqualValues(t, 100, recorder.starts["ch"])
+
+		recorder.err = errors.New("mock")
+		ms.recorded = false
+		assert.Error(t, ms.Produce(pack))
+		recorder.err = nil
+		ms.recorded = true
+
+		// retrying the pack written to the source mq only writes the target mq
+		target.EXPECT().Produce(pack).Return(errors.New("mock")).Once()
+		source.EXPECT().Produce(pack).Return(nil).Once()
+		assert.Error(t, ms.Produce(pack))
+		target.EXPECT().Produce(pack).Return(nil).Once()
+		assert.NoError(t, ms.Produce(pack))
+		assert.Nil(t, ms.partial)
+
+		// the pack given up is missed by the target mq, the dual write starts after it
+		target.EXPECT().Produce(pack).Return(errors.New("mock")).Once()
+		source.EXPECT().Produce(pack).Return(nil).Once()
+		assert.Error(t, ms.Produce(pack))
+		next := newPack(200)
+		source.EXPECT().Produce(next).Return(nil).Once()
+		target.EXPECT().Produce(next).Return(nil).Once()
+		assert.NoError(t, ms.Produce(next))
+		assert.EqualValues(t, 101, recorder.starts["ch"])
+
+		sourceIDs := map[string][]MessageID{"ch": {}}
+		source.EXPECT().Broadcast(pack).Return(sourceIDs, nil).Once()
+		target.EXPECT().Broadcast(pack).Return(nil, errors.New("mock")).Once()
+		_, err := ms.Broadcast(pack)
+		assert.Error(t, err)
+		target.EXPECT().Broadcast(pack).Return(map[string][]MessageID{}, nil).Once()
+		ids, err := ms.Broadcast(pack)
+		assert.NoError(t, err)
+		assert.Equal(t, sourceIDs, ids)
+
+		// read from the source mq only
+		source.EXPECT().AsConsumer([]string{"ch"}, "sub", mqwrapper.SubscriptionPositionUnknown).Return()
+		ms.AsConsumer([]string{"ch"}, "sub", mqwrapper.SubscriptionPositionUnknown)
+		positions := []*MsgPosition{{ChannelName: "ch", MsgID: []byte{1}, Timestamp: 100}}
+		source.EXPECT().Seek(positions).Return(nil)
+		assert.NoError(t, ms.Seek(positions))
+
+		source.EXPECT().Close().Return()
+		target.EXPECT().Close().Return()
+		ms.Close()
+	})
+
+	t.Run("cutover", func(t *testing.T) {
+		source, target := NewMockMsgStream(t), NewMockMsgStream(t)
+		recorder := newMemMigrationRecorder()
+		recorder.starts["ch"] = 100
+		ms := newMigrationMsgStream(source, target, recorder, true)
+
+		targetIDs := map[string][]MessageID{"ch": {}}
+		source.EXPECT().Broadcast(pack).Return(map[string][]MessageID{}, nil)
+		target.EXPECT().Broadcast(pack).Return(targetIDs, nil)
+		ids, err := ms.Broadcast(pack)
+		assert.NoError(t, err)
+		assert.Equal(t, targetIDs, ids)
+		// the start of the dual write is recorded in the dualWrite phase only
+		assert.EqualValues(t, 100, recorder.starts["ch"])
+
+		// read from the target mq without seeking
+		ms.AsConsumer([]string{"ch"}, "sub", mqwrapper.SubscriptionPositionUnknown)
+		target.EXPECT().AsConsumer([]string{"ch"}, "sub", mqwrapper.SubscriptionPositionUnknown).Return()
+		target.EXPECT().CheckTopicValid("ch").Return(nil)
+		assert.NoError(t, ms.CheckTopicValid("ch"))
+
+		// only the timetick msgstream could seek by timestamp
+		err = ms.Seek([]*MsgPosition{{ChannelName: "ch", MsgID: []byte{1}, Timestamp: 100}})
+		assert.Error(t, err)
+		target.AssertNotCalled(t, "Seek", mock.Anything)
+
+		// the target mq misses the messages after the positions
+		err = ms.Seek([]*MsgPosition{{ChannelName: "ch", MsgID: []byte{1}, Timestamp: 99}})
+		assert.Error(t, err)
+		source.AssertNotCalled(t, "Seek", mock.Anything)
+	})
+
+	t.Run("deferred cutover", func(t *testing.T) {
+		source, target := NewMockMsgStream(t), NewMockMsgStream(t)
+		recorder := newMemMigrationRecorder()
+		recorder.starts["ch1"] = 100
+		ms := newMigrationMsgStream(source, target, recorder, true)
+
+		// the dual write of ch2 is not recorded
+		positions := []*MsgPosition{
+			{ChannelName: "ch1", MsgID: []byte{1}, Timestamp: 200},
+			{ChannelName: "ch2", MsgID: []byte{1}, Timestamp: 200},
+		}
+		ms.AsConsumer([]string{"ch1", "ch2"}, "sub", mqwrapper.SubscriptionPositionUnknown)
+		source.EXPECT().AsConsumer([]string{"ch1", "ch2"}, "sub", mqwrapper.SubscriptionPositionUnknown).Return()
+		source.EXPECT().Seek(positions).Return(nil)
+		assert.NoError(t, ms.Seek(positions))
+		ch := make(<-chan *MsgPack)
+		source.EXPECT().Chan().Return(ch)
+		assert.Equal(t, ch, ms.Chan())
+		target.AssertNotCalled(t, "AsConsumer", mock.Anything, mock.Anything, mock.Anything)
+
+		recorder.err = errors.New("mock")
+		assert.Error(t, ms.Seek(positions))
+	})
+}
+
+func TestRewritePositionsToEarliest(t *testing.T) {
+	positions := []*MsgPosition{{ChannelName: "ch", MsgID: []byte{1}, Timestamp: 100}}
+	rewritten := rewritePositionsToEarliest(positions, []byte{0})
+	require.Len(t, rewritten, 1)
+	assert.Equal(t, "ch", rewritten[0].GetChannelName())
+	assert.Equal(t, []byte{0}, rewritten[0].GetMsgID())
+	assert.EqualValues(t, 100, rewritten[0].GetTimestamp())
+	// the positions are untouched
+	assert.Equal(t, []byte{1}, positions[0].GetMsgID())
+}
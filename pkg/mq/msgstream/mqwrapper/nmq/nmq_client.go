@@ -106,9 +106,6 @@ func (nc *nmqClient) Subscribe(options mqwrapper.ConsumerOptions) (mqwrapper.Con
 		metrics.MsgStreamOpCounter.WithLabelValues(metrics.CreateConsumerLabel, metrics.FailLabel).Inc()
 		return nil, errors.Wrap(err, "failed to create jetstream context")
 	}
-	// TODO: do we allow passing in an existing natsChan from options?
-	// also, revisit the size or make it a user param
-	natsChan := make(chan *nats.Msg, options.BufSize)
 	// TODO: should we allow subscribe to a topic that doesn't exist yet? Current logic allows it.
 	_, err = js.AddStream(&nats.StreamConfig{
 		Name:     options.Topic,
@@ -128,9 +125,9 @@ func (nc *nmqClient) Subscribe(options mqwrapper.ConsumerOptions) (mqwrapper.Con
 	// TODO: should we only allow exclusive subscribe? Current logic allows double subscribe.
 	switch position {
 	case mqwrapper.SubscriptionPositionLatest:
-		sub, err = js.ChanSubscribe(options.Topic, natsChan, nats.DeliverNew())
+		sub, err = js.PullSubscribe(options.Topic, "", pullSubscribeOptions(nats.DeliverNew())...)
 	case mqwrapper.SubscriptionPositionEarliest:
-		sub, err = js.ChanSubscribe(options.Topic, natsChan, nats.DeliverAll())
+		sub, err = js.PullSubscribe(options.Topic, "", pullSubscribeOptions(nats.DeliverAll())...)
 	}
 	if err != nil {
 		metrics.MsgStreamOpCounter.WithLabelValues(metrics.CreateConsumerLabel, metrics.FailLabel).Inc()
@@ -146,7 +143,6 @@ func (nc *nmqClient) Subscribe(options mqwrapper.ConsumerOptions) (mqwrapper.Con
 		topic:     options.Topic,
 		groupName: options.SubscriptionName,
 		options:   options,
-		natsChan:  natsChan,
 		closeChan: closeChan,
 	}, nil
 }
@@ -17,8 +17,10 @@
 package nmq
 
 import (
+	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/nats-io/nats.go"
@@ -27,24 +29,38 @@ import (
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
 	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
-// Consumer is a client that used to consume messages from natsmq
+// Consumer is a client that used to consume messages from natsmq.
+// The messages are pulled from a JetStream pull consumer with explicit acks,
+// at most natsmq.consumer.maxInflight messages are delivered but not acked,
+// so that a slow consumer exerts back-pressure on the server rather than growing the pending messages.
 type Consumer struct {
 	options   mqwrapper.ConsumerOptions
 	js        nats.JetStreamContext
 	sub       *nats.Subscription
 	topic     string
 	groupName string
-	natsChan  chan *nats.Msg
 	msgChan   chan mqwrapper.Message
 	closeChan chan struct{}
 	once      sync.Once
 	closeOnce sync.Once
 	skip      bool
+	lastSeq   uint64 // the stream sequence of the last message sent to msgChan
 	wg        sync.WaitGroup
 }
 
+// pullSubscribeOptions returns the options of the pull consumers, with the flow control configured.
+func pullSubscribeOptions(opts ...nats.SubOpt) []nats.SubOpt {
+	cfg := &paramtable.Get().NatsmqCfg
+	return append([]nats.SubOpt{
+		nats.AckExplicit(),
+		nats.MaxAckPending(cfg.ConsumerMaxInflight.GetAsInt()),
+		nats.AckWait(cfg.ConsumerAckWait.GetAsDuration(time.Second)),
+	}, opts...)
+}
+
 // Subscription returns the subscription name of this consumer
 func (nc *Consumer) Subscription() string {
 	return nc.groupName
@@ -62,32 +78,70 @@ func (nc *Consumer) Chan() <-chan mqwrapper.Message {
 	}
 	if nc.msgChan == nil {
 		nc.once.Do(func() {
-			nc.msgChan = make(chan mqwrapper.Message, 256)
+			nc.msgChan = make(chan mqwrapper.Message, nc.options.BufSize)
 			nc.wg.Add(1)
-			go func() {
-				defer nc.wg.Done()
-				for {
-					select {
-					case msg := <-nc.natsChan:
-						if nc.skip {
-							nc.skip = false
-							continue
-						}
-						nc.msgChan <- &nmqMessage{
-							raw: msg,
-						}
-					case <-nc.closeChan:
-						log.Info("close nmq consumer ", zap.String("topic", nc.topic), zap.String("groupName", nc.groupName))
-						close(nc.msgChan)
-						return
-					}
-				}
-			}()
+			go nc.fetchLoop()
 		})
 	}
 	return nc.msgChan
 }
 
+// fetchLoop pulls the messages in batches and sends them to msgChan, the next batch is pulled
+// only after the previous one is sent, so no more messages are buffered than msgChan holds.
+func (nc *Consumer) fetchLoop() {
+	defer nc.wg.Done()
+	defer close(nc.msgChan)
+	cfg := &paramtable.Get().NatsmqCfg
+	for {
+		select {
+		case <-nc.closeChan:
+			log.Info("close nmq consumer ", zap.String("topic", nc.topic), zap.String("groupName", nc.groupName))
+			return
+		default:
+		}
+
+		batchSize := cfg.ConsumerFetchBatchSize.GetAsInt()
+		if batchSize < 1 {
+			batchSize = 1
+		}
+		maxWait := cfg.ConsumerFetchMaxWait.GetAsDuration(time.Millisecond)
+		msgs, err := nc.sub.Fetch(batchSize, nats.MaxWait(maxWait))
+		if err != nil {
+			if errors.Is(err, nats.ErrTimeout) || errors.Is(err, context.DeadlineExceeded) {
+				continue
+			}
+			log.Warn("failed to fetch messages from nmq", zap.String("topic", nc.topic), zap.Error(err))
+			select {
+			case <-nc.closeChan:
+			case <-time.After(maxWait):
+			}
+			continue
+		}
+
+		for _, msg := range msgs {
+			// the messages not acked in time are redelivered, they have been sent already
+			if meta, err := msg.Metadata(); err == nil {
+				if meta.Sequence.Stream <= nc.lastSeq {
+					nc.ackRaw(msg)
+					continue
+				}
+				nc.lastSeq = meta.Sequence.Stream
+			}
+			if nc.skip {
+				nc.skip = false
+				nc.ackRaw(msg)
+				continue
+			}
+			select {
+			case nc.msgChan <- &nmqMessage{raw: msg}:
+			case <-nc.closeChan:
+				log.Info("close nmq consumer ", zap.String("topic", nc.topic), zap.String("groupName", nc.groupName))
+				return
+			}
+		}
+	}
+}
+
 // Seek is used to seek the position in natsmq topic
 func (nc *Consumer) Seek(id mqwrapper.MessageID, inclusive bool) error {
 	if err := nc.closed(); err != nil {
@@ -104,7 +158,7 @@ func (nc *Consumer) Seek(id mqwrapper.MessageID, inclusive bool) error {
 	// skip the first message when consume
 	nc.skip = !inclusive
 	var err error
-	nc.sub, err = nc.js.ChanSubscribe(nc.topic, nc.natsChan, nats.StartSequence(msgID))
+	nc.sub, err = nc.js.PullSubscribe(nc.topic, "", pullSubscribeOptions(nats.StartSequence(msgID))...)
 	if err != nil {
 		log.Warn("fail to Seek", zap.Error(err))
 	}
@@ -118,14 +172,22 @@ func (nc *Consumer) Ack(message mqwrapper.Message) {
 	}
 }
 
+// ackRaw acks the message not sent to msgChan, so that it no longer counts in the in-flight messages.
+func (nc *Consumer) ackRaw(msg *nats.Msg) {
+	if err := msg.Ack(); err != nil {
+		log.Warn("failed to ack message of nmq", zap.String("topic", nc.topic), zap.Error(err))
+	}
+}
+
 // Close is used to free the resources of this consumer
 func (nc *Consumer) Close() {
 	nc.closeOnce.Do(func() {
+		// stop fetching before unsubscribing, a fetch returns in natsmq.consumer.fetchMaxWait
+		close(nc.closeChan)
+		nc.wg.Wait()
 		if err := nc.sub.Unsubscribe(); err != nil {
 			log.Warn("failed to unsubscribe subscription of nmq", zap.String("topic", nc.topic))
 		}
-		close(nc.closeChan)
-		nc.wg.Wait()
 	})
 }
 
@@ -27,6 +27,7 @@ import (
 
 	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
 	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
 func TestNatsConsumer_Subscription(t *testing.T) {
@@ -450,3 +451,45 @@ func TestNatsConsumer_ChanWithNoAssign(t *testing.T) {
 		<-consumer.Chan()
 	})
 }
+
+func TestNatsConsumer_FlowControl(t *testing.T) {
+	params := paramtable.Get()
+	params.Save(params.NatsmqCfg.ConsumerMaxInflight.Key, "2")
+	defer params.Reset(params.NatsmqCfg.ConsumerMaxInflight.Key)
+	params.Save(params.NatsmqCfg.ConsumerAckWait.Key, "1")
+	defer params.Reset(params.NatsmqCfg.ConsumerAckWait.Key)
+
+	topic := t.Name()
+	c, p := newProducer(t, topic)
+	defer c.Close()
+	defer p.Close()
+
+	msgs := []string{"111", "222", "333", "444", "555"}
+	process(t, msgs, p)
+
+	consumer, err := newTestConsumer(t, topic, mqwrapper.SubscriptionPositionEarliest)
+	assert.NoError(t, err)
+	defer consumer.Close()
+
+	msg1 := <-consumer.Chan()
+	assert.Equal(t, "111", string(msg1.Payload()))
+	msg2 := <-consumer.Chan()
+	assert.Equal(t, "222", string(msg2.Payload()))
+
+	// no more messages are delivered until the in-flight ones are acked
+	select {
+	case msg := <-consumer.Chan():
+		assert.Fail(t, "unexpected message delivered", string(msg.Payload()))
+	case <-time.After(500 * time.Millisecond):
+	}
+
+	// the redelivered messages after the ack wait are not sent again
+	time.Sleep(1500 * time.Millisecond)
+	consumer.Ack(msg1)
+	consumer.Ack(msg2)
+	for _, expected := range msgs[2:] {
+		msg := <-consumer.Chan()
+		assert.Equal(t, expected, string(msg.Payload()))
+		consumer.Ack(msg)
+	}
+}
@@ -114,6 +114,9 @@ func convertToByteArray(input interface{}) ([]byte, error) {
 type InsertMsg struct {
 	BaseMsg
 	msgpb.InsertRequest
+
+	// recyclable is true if the message is unmarshaled from the stream, see RecycleMsg
+	recyclable bool
 }
 
 // interface implementation validation
@@ -152,16 +155,19 @@ func (it *InsertMsg) Marshal(input TsMsg) (MarshalType, error) {
 
 // Unmarshal is used to deserialize a message pack from byte array
 func (it *InsertMsg) Unmarshal(input MarshalType) (TsMsg, error) {
-	insertRequest := msgpb.InsertRequest{}
 	in, err := convertToByteArray(input)
 	if err != nil {
 		return nil, err
 	}
-	err = proto.Unmarshal(in, &insertRequest)
+	insertMsg := allocInsertMsg()
+	// merge into the empty message to reuse the capacity of the recycled slices
+	err = proto.UnmarshalMerge(in, &insertMsg.InsertRequest)
 	if err != nil {
 		return nil, err
 	}
-	insertMsg := &InsertMsg{InsertRequest: insertRequest}
+	insertMsg.Timestamps = nilIfEmpty(insertMsg.Timestamps)
+	insertMsg.RowIDs = nilIfEmpty(insertMsg.RowIDs)
+	insertMsg.recyclable = true
 	for _, timestamp := range insertMsg.Timestamps {
 		insertMsg.BeginTimestamp = timestamp
 		insertMsg.EndTimestamp = timestamp
@@ -300,6 +306,9 @@ func (it *InsertMsg) Size() int {
 type DeleteMsg struct {
 	BaseMsg
 	msgpb.DeleteRequest
+
+	// recyclable is true if the message is unmarshaled from the stream, see RecycleMsg
+	recyclable bool
 }
 
 // interface implementation validation
@@ -339,15 +348,19 @@ func (dt *DeleteMsg) Marshal(input TsMsg) (MarshalType, error) {
 
 // Unmarshal is used to deserializing a message pack from byte array
 func (dt *DeleteMsg) Unmarshal(input MarshalType) (TsMsg, error) {
-	deleteRequest := msgpb.DeleteRequest{}
 	in, err := convertToByteArray(input)
 	if err != nil {
 		return nil, err
 	}
-	err = proto.Unmarshal(in, &deleteRequest)
+	deleteMsg := allocDeleteMsg()
+	// merge into the empty message to reuse the capacity of the recycled slices
+	err = proto.UnmarshalMerge(in, &deleteMsg.DeleteRequest)
 	if err != nil {
 		return nil, err
 	}
+	deleteMsg.Timestamps = nilIfEmpty(deleteMsg.Timestamps)
+	deleteMsg.recyclable = true
+	deleteRequest := &deleteMsg.DeleteRequest
 
 	// Compatible with primary keys that only support int64 type
 	if deleteRequest.PrimaryKeys == nil {
@@ -361,7 +374,6 @@ func (dt *DeleteMsg) Unmarshal(input MarshalType) (TsMsg, error) {
 		deleteRequest.NumRows = int64(len(deleteRequest.Int64PrimaryKeys))
 	}
 
-	deleteMsg := &DeleteMsg{DeleteRequest: deleteRequest}
 	for _, timestamp := range deleteMsg.Timestamps {
 		deleteMsg.BeginTimestamp = timestamp
 		deleteMsg.EndTimestamp = timestamp
@@ -0,0 +1,101 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"sync"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+)
+
+// maxRecycledSliceCap is the max capacity of the slices kept by a recycled message,
+// the larger ones are released to avoid pinning the memory of a few huge messages.
+const maxRecycledSliceCap = 64 * 1024
+
+// The insert and delete messages are the majority of the messages consumed by the DataNodes
+// and QueryNodes, pooling them relieves the GC pressure on high-throughput consumers.
+// A message gets into the pools only if its last owner recycles it explicitly, so a consumer
+// never recycling the messages just falls back to the plain allocation. The messages built
+// by the producers are never recycled, since their fields may be shared with the requests.
+var (
+	insertMsgPool = sync.Pool{New: func() any { return &InsertMsg{} }}
+	deleteMsgPool = sync.Pool{New: func() any { return &DeleteMsg{} }}
+)
+
+// reuseSlice truncates the slice to reuse its capacity, returns nil if the slice is too large to keep.
+func reuseSlice[T any](s []T) []T {
+	if cap(s) > maxRecycledSliceCap {
+		return nil
+	}
+	return s[:0]
+}
+
+// nilIfEmpty reverts the truncated slices not filled by the unmarshaling to nil,
+// so that the messages are the same as the ones unmarshaled from scratch.
+func nilIfEmpty[T any](s []T) []T {
+	if len(s) == 0 {
+		return nil
+	}
+	return s
+}
+
+// allocInsertMsg returns an empty InsertMsg, which may reuse the memory of a recycled one.
+func allocInsertMsg() *InsertMsg {
+	return insertMsgPool.Get().(*InsertMsg)
+}
+
+// allocDeleteMsg returns an empty DeleteMsg, which may reuse the memory of a recycled one.
+func allocDeleteMsg() *DeleteMsg {
+	return deleteMsgPool.Get().(*DeleteMsg)
+}
+
+// RecycleMsg puts the insert or delete message unmarshaled from the stream back into the pool
+// to reuse its memory, it's a no-op for the other messages.
+// The caller must be the last owner of the message, neither the message nor its fields
+// could be accessed after it's recycled.
+func RecycleMsg(msg TsMsg) {
+	switch m := msg.(type) {
+	case *InsertMsg:
+		if m == nil || !m.recyclable {
+			return
+		}
+		*m = InsertMsg{InsertRequest: msgpb.InsertRequest{
+			Timestamps: reuseSlice(m.Timestamps),
+			RowIDs:     reuseSlice(m.RowIDs),
+		}}
+		insertMsgPool.Put(m)
+	case *DeleteMsg:
+		if m == nil || !m.recyclable {
+			return
+		}
+		*m = DeleteMsg{DeleteRequest: msgpb.DeleteRequest{
+			Timestamps: reuseSlice(m.Timestamps),
+		}}
+		deleteMsgPool.Put(m)
+	}
+}
+
+// RecycleMsgPack recycles all the insert and delete messages of the pack, see RecycleMsg.
+func RecycleMsgPack(pack *MsgPack) {
+	if pack == nil {
+		return
+	}
+	for _, msg := range pack.Msgs {
+		RecycleMsg(msg)
+	}
+	pack.Msgs = nil
+}
@@ -0,0 +1,110 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"testing"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecycleMsg(t *testing.T) {
+	t.Run("insert msg", func(t *testing.T) {
+		insertMsg := &InsertMsg{InsertRequest: msgpb.InsertRequest{
+			Base:       &commonpb.MsgBase{MsgType: commonpb.MsgType_Insert},
+			ShardName:  "ch",
+			Timestamps: []uint64{2, 1, 3},
+			RowIDs:     []int64{1, 2, 3},
+		}}
+		bytes, err := insertMsg.Marshal(insertMsg)
+		assert.NoError(t, err)
+
+		// the msgs built by the producers are not recycled
+		RecycleMsg(insertMsg)
+		assert.Equal(t, "ch", insertMsg.GetShardName())
+
+		tsMsg, err := insertMsg.Unmarshal(bytes)
+		assert.NoError(t, err)
+		msg := tsMsg.(*InsertMsg)
+		assert.Equal(t, []uint64{2, 1, 3}, msg.GetTimestamps())
+		assert.Equal(t, uint64(1), msg.BeginTs())
+		assert.Equal(t, uint64(3), msg.EndTs())
+
+		RecycleMsg(msg)
+		assert.Empty(t, msg.GetShardName())
+		assert.Empty(t, msg.GetTimestamps())
+		assert.Nil(t, msg.GetBase())
+		// recycling again is a no-op
+		RecycleMsg(msg)
+
+		// the unmarshaled msg is the same no matter whether it reuses a recycled one
+		tsMsg, err = insertMsg.Unmarshal(bytes)
+		assert.NoError(t, err)
+		msg = tsMsg.(*InsertMsg)
+		assert.Equal(t, "ch", msg.GetShardName())
+		assert.Equal(t, []uint64{2, 1, 3}, msg.GetTimestamps())
+		assert.Equal(t, []int64{1, 2, 3}, msg.GetRowIDs())
+
+		empty := &InsertMsg{InsertRequest: msgpb.InsertRequest{Base: &commonpb.MsgBase{MsgType: commonpb.MsgType_Insert}}}
+		bytes, err = empty.Marshal(empty)
+		assert.NoError(t, err)
+		RecycleMsg(msg)
+		tsMsg, err = insertMsg.Unmarshal(bytes)
+		assert.NoError(t, err)
+		assert.Nil(t, tsMsg.(*InsertMsg).GetTimestamps())
+		assert.Nil(t, tsMsg.(*InsertMsg).GetRowIDs())
+	})
+
+	t.Run("delete msg", func(t *testing.T) {
+		deleteMsg := &DeleteMsg{DeleteRequest: msgpb.DeleteRequest{
+			Base:             &commonpb.MsgBase{MsgType: commonpb.MsgType_Delete},
+			ShardName:        "ch",
+			Timestamps:       []uint64{1, 2},
+			Int64PrimaryKeys: []int64{1, 2},
+		}}
+		bytes, err := deleteMsg.Marshal(deleteMsg)
+		assert.NoError(t, err)
+
+		tsMsg, err := deleteMsg.Unmarshal(bytes)
+		assert.NoError(t, err)
+		msg := tsMsg.(*DeleteMsg)
+		assert.Equal(t, []int64{1, 2}, msg.GetPrimaryKeys().GetIntId().GetData())
+		assert.EqualValues(t, 2, msg.GetNumRows())
+
+		RecycleMsgPack(&MsgPack{Msgs: []TsMsg{msg, &TimeTickMsg{}}})
+		assert.Empty(t, msg.GetShardName())
+		assert.Nil(t, msg.GetPrimaryKeys())
+
+		deleteMsg.PrimaryKeys = &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: []string{"a"}}}}
+		deleteMsg.Int64PrimaryKeys = nil
+		bytes, err = deleteMsg.Marshal(deleteMsg)
+		assert.NoError(t, err)
+		tsMsg, err = deleteMsg.Unmarshal(bytes)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a"}, tsMsg.(*DeleteMsg).GetPrimaryKeys().GetStrId().GetData())
+		assert.Equal(t, []uint64{1, 2}, tsMsg.(*DeleteMsg).GetTimestamps())
+	})
+
+	t.Run("large slices", func(t *testing.T) {
+		assert.Nil(t, reuseSlice(make([]int64, maxRecycledSliceCap+1)))
+		assert.Equal(t, 0, len(reuseSlice(make([]int64, 10))))
+		assert.Equal(t, 10, cap(reuseSlice(make([]int64, 10))))
+	})
+}
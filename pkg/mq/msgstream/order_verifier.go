@@ -0,0 +1,241 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+)
+
+// OrderViolationType is the kind of ordering assumption broken by a message.
+type OrderViolationType string
+
+const (
+	// TimetickRegression means a timetick is smaller than the previous one.
+	TimetickRegression OrderViolationType = "TimetickRegression"
+	// MessageBeforeTimetick means a message arrives after a timetick which is not smaller than its ts.
+	MessageBeforeTimetick OrderViolationType = "MessageBeforeTimetick"
+	// MessageAfterTimetick means a timetick arrives after a message whose ts is larger than the timetick.
+	MessageAfterTimetick OrderViolationType = "MessageAfterTimetick"
+	// DuplicateMQMessageID means the mq delivers a message id twice.
+	DuplicateMQMessageID OrderViolationType = "DuplicateMQMessageID"
+	// DuplicateMsgID means two messages share the same milvus msg id.
+	DuplicateMsgID OrderViolationType = "DuplicateMsgID"
+)
+
+// maxReportedViolations limits the violation details kept in a report, the counts are always accurate.
+const maxReportedViolations = 1000
+
+// OrderViolation records a message which breaks the ordering assumptions.
+type OrderViolation struct {
+	Type     OrderViolationType
+	MsgType  commonpb.MsgType
+	MsgID    UniqueID
+	Ts       Timestamp
+	Timetick Timestamp
+	Position *MsgPosition
+}
+
+func (v *OrderViolation) String() string {
+	var msgID []byte
+	if v.Position != nil {
+		msgID = v.Position.GetMsgID()
+	}
+	return fmt.Sprintf("%s: msgType=%s, msgID=%d, ts=%d, timetick=%d, mqMsgID=%x",
+		v.Type, v.MsgType.String(), v.MsgID, v.Ts, v.Timetick, msgID)
+}
+
+// OrderReport summarizes the verification of a vchannel.
+type OrderReport struct {
+	VChannel      string
+	NumMsgs       int
+	NumTimeticks  int
+	FirstTimetick Timestamp
+	LastTimetick  Timestamp
+	Counts        map[OrderViolationType]int
+	Violations    []*OrderViolation
+}
+
+// OK returns true if no violation is found.
+func (r *OrderReport) OK() bool {
+	return len(r.Counts) == 0
+}
+
+func (r *OrderReport) String() string {
+	sb := &strings.Builder{}
+	fmt.Fprintf(sb, "vchannel: %s\n", r.VChannel)
+	fmt.Fprintf(sb, "messages: %d, timeticks: %d, first timetick: %d, last timetick: %d\n",
+		r.NumMsgs, r.NumTimeticks, r.FirstTimetick, r.LastTimetick)
+	if r.OK() {
+		sb.WriteString("result: OK\n")
+		return sb.String()
+	}
+	sb.WriteString("result: FAILED\n")
+	for _, t := range []OrderViolationType{TimetickRegression, MessageBeforeTimetick, MessageAfterTimetick, DuplicateMQMessageID, DuplicateMsgID} {
+		if r.Counts[t] > 0 {
+			fmt.Fprintf(sb, "%s: %d\n", t, r.Counts[t])
+		}
+	}
+	for _, v := range r.Violations {
+		sb.WriteString(v.String())
+		sb.WriteString("\n")
+	}
+	if len(r.Violations) == maxReportedViolations {
+		sb.WriteString("...\n")
+	}
+	return sb.String()
+}
+
+// OrderVerifier checks the messages consumed from the physical channel of a vchannel against
+// the ordering assumptions of milvus: timeticks are monotonic, messages are delivered between the
+// two timeticks covering their ts, and no message is delivered twice.
+// It's used to validate mq implementations, the messages must be consumed without the timetick stream.
+type OrderVerifier struct {
+	vchannel     string
+	collectionID UniqueID
+
+	lastTimetick Timestamp
+	// max ts of the messages received since the last timetick
+	maxTs  Timestamp
+	mqIDs  map[string]struct{}
+	msgIDs map[UniqueID]struct{}
+	report *OrderReport
+}
+
+// NewOrderVerifier creates an OrderVerifier for the vchannel.
+func NewOrderVerifier(vchannel string) *OrderVerifier {
+	return &OrderVerifier{
+		vchannel:     vchannel,
+		collectionID: collectionIDOfVChannel(vchannel),
+		mqIDs:        make(map[string]struct{}),
+		msgIDs:       make(map[UniqueID]struct{}),
+		report: &OrderReport{
+			VChannel: vchannel,
+			Counts:   make(map[OrderViolationType]int),
+		},
+	}
+}
+
+// Verify checks the messages of the pack in order.
+func (v *OrderVerifier) Verify(pack *MsgPack) {
+	for _, msg := range pack.Msgs {
+		v.verify(msg)
+	}
+}
+
+// Report returns the report of messages verified so far.
+func (v *OrderVerifier) Report() *OrderReport {
+	return v.report
+}
+
+func (v *OrderVerifier) verify(msg TsMsg) {
+	if msg.Type() != commonpb.MsgType_TimeTick && !v.belongs(msg) {
+		return
+	}
+
+	if pos := msg.Position(); pos != nil && len(pos.GetMsgID()) > 0 {
+		key := string(pos.GetMsgID())
+		if _, ok := v.mqIDs[key]; ok {
+			v.addViolation(DuplicateMQMessageID, msg)
+			// the message is delivered twice, the ordering of it has been verified.
+			return
+		}
+		v.mqIDs[key] = struct{}{}
+	}
+
+	if msg.Type() == commonpb.MsgType_TimeTick {
+		v.verifyTimetick(msg)
+		return
+	}
+
+	v.report.NumMsgs++
+	if msg.ID() != 0 {
+		if _, ok := v.msgIDs[msg.ID()]; ok {
+			v.addViolation(DuplicateMsgID, msg)
+		}
+		v.msgIDs[msg.ID()] = struct{}{}
+	}
+	if v.report.NumTimeticks > 0 && msg.BeginTs() <= v.lastTimetick {
+		v.addViolation(MessageBeforeTimetick, msg)
+	}
+	if msg.EndTs() > v.maxTs {
+		v.maxTs = msg.EndTs()
+	}
+}
+
+func (v *OrderVerifier) verifyTimetick(msg TsMsg) {
+	ts := msg.EndTs()
+	if v.report.NumTimeticks == 0 {
+		v.report.FirstTimetick = ts
+	} else if ts < v.lastTimetick {
+		v.addViolation(TimetickRegression, msg)
+	}
+	if v.maxTs > ts {
+		v.addViolation(MessageAfterTimetick, msg)
+	}
+	v.report.NumTimeticks++
+	if ts > v.lastTimetick {
+		v.lastTimetick = ts
+	}
+	v.report.LastTimetick = v.lastTimetick
+	v.maxTs = 0
+}
+
+// belongs returns whether the message is sent to the vchannel,
+// messages without shard name are matched by the collection id of the vchannel.
+func (v *OrderVerifier) belongs(msg TsMsg) bool {
+	if m, ok := msg.(interface{ GetShardName() string }); ok {
+		return m.GetShardName() == v.vchannel
+	}
+	if m, ok := msg.(interface{ GetCollectionID() int64 }); ok {
+		return m.GetCollectionID() == v.collectionID
+	}
+	return false
+}
+
+// collectionIDOfVChannel parses the collection id from vchannel name like `{pchannel}_{collectionID}v{shardIdx}`,
+// returns -1 if the name is malformed.
+func collectionIDOfVChannel(vchannel string) UniqueID {
+	idx := strings.LastIndex(vchannel, "v")
+	if idx < 0 {
+		return -1
+	}
+	name := vchannel[:idx]
+	id, err := strconv.ParseInt(name[strings.LastIndex(name, "_")+1:], 10, 64)
+	if err != nil {
+		return -1
+	}
+	return id
+}
+
+func (v *OrderVerifier) addViolation(t OrderViolationType, msg TsMsg) {
+	v.report.Counts[t]++
+	if len(v.report.Violations) >= maxReportedViolations {
+		return
+	}
+	v.report.Violations = append(v.report.Violations, &OrderViolation{
+		Type:     t,
+		MsgType:  msg.Type(),
+		MsgID:    msg.ID(),
+		Ts:       msg.EndTs(),
+		Timetick: v.lastTimetick,
+		Position: msg.Position(),
+	})
+}
@@ -0,0 +1,116 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+)
+
+const testVChannel = "by-dev-rootcoord-dml_0_100v0"
+
+func newVerifyTimeTickMsg(ts Timestamp, mqID byte) TsMsg {
+	return &TimeTickMsg{
+		BaseMsg: BaseMsg{
+			BeginTimestamp: ts,
+			EndTimestamp:   ts,
+			MsgPosition:    &MsgPosition{MsgID: []byte{mqID}},
+		},
+		TimeTickMsg: msgpb.TimeTickMsg{
+			Base: &commonpb.MsgBase{MsgType: commonpb.MsgType_TimeTick, Timestamp: ts},
+		},
+	}
+}
+
+func newVerifyInsertMsg(shard string, msgID UniqueID, ts Timestamp, mqID byte) TsMsg {
+	return &InsertMsg{
+		BaseMsg: BaseMsg{
+			BeginTimestamp: ts,
+			EndTimestamp:   ts,
+			MsgPosition:    &MsgPosition{MsgID: []byte{mqID}},
+		},
+		InsertRequest: msgpb.InsertRequest{
+			Base:      &commonpb.MsgBase{MsgType: commonpb.MsgType_Insert, MsgID: msgID, Timestamp: ts},
+			ShardName: shard,
+		},
+	}
+}
+
+func TestOrderVerifier(t *testing.T) {
+	t.Run("ordered", func(t *testing.T) {
+		v := NewOrderVerifier(testVChannel)
+		v.Verify(&MsgPack{Msgs: []TsMsg{
+			newVerifyTimeTickMsg(10, 1),
+			newVerifyInsertMsg(testVChannel, 1, 11, 2),
+			newVerifyInsertMsg("by-dev-rootcoord-dml_0_100v1", 2, 5, 3),
+			newVerifyInsertMsg(testVChannel, 3, 20, 4),
+			newVerifyTimeTickMsg(20, 5),
+			newVerifyTimeTickMsg(20, 6),
+		}})
+		report := v.Report()
+		assert.True(t, report.OK())
+		assert.Equal(t, 2, report.NumMsgs)
+		assert.Equal(t, 3, report.NumTimeticks)
+		assert.EqualValues(t, 10, report.FirstTimetick)
+		assert.EqualValues(t, 20, report.LastTimetick)
+		assert.Contains(t, report.String(), "result: OK")
+	})
+
+	t.Run("violations", func(t *testing.T) {
+		v := NewOrderVerifier(testVChannel)
+		v.Verify(&MsgPack{Msgs: []TsMsg{
+			newVerifyTimeTickMsg(10, 1),
+			newVerifyInsertMsg(testVChannel, 1, 9, 2),
+			newVerifyInsertMsg(testVChannel, 1, 30, 3),
+			newVerifyTimeTickMsg(20, 4),
+			newVerifyTimeTickMsg(15, 5),
+			newVerifyInsertMsg(testVChannel, 2, 25, 5),
+		}})
+		report := v.Report()
+		assert.False(t, report.OK())
+		assert.Equal(t, 1, report.Counts[MessageBeforeTimetick])
+		assert.Equal(t, 1, report.Counts[DuplicateMsgID])
+		assert.Equal(t, 1, report.Counts[MessageAfterTimetick])
+		assert.Equal(t, 1, report.Counts[TimetickRegression])
+		assert.Equal(t, 1, report.Counts[DuplicateMQMessageID])
+		assert.Len(t, report.Violations, 5)
+		assert.Contains(t, report.String(), "result: FAILED")
+	})
+
+	t.Run("match by collection", func(t *testing.T) {
+		v := NewOrderVerifier(testVChannel)
+		assert.EqualValues(t, 100, v.collectionID)
+		v.Verify(&MsgPack{Msgs: []TsMsg{
+			&CreateCollectionMsg{CreateCollectionRequest: msgpb.CreateCollectionRequest{
+				Base:         &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateCollection},
+				CollectionID: 100,
+			}},
+			&CreateCollectionMsg{CreateCollectionRequest: msgpb.CreateCollectionRequest{
+				Base:         &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateCollection},
+				CollectionID: 101,
+			}},
+		}})
+		assert.Equal(t, 1, v.Report().NumMsgs)
+
+		assert.EqualValues(t, -1, collectionIDOfVChannel("invalid"))
+		assert.EqualValues(t, -1, collectionIDOfVChannel("dml_av0"))
+	})
+}
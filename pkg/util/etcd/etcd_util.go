@@ -28,6 +28,7 @@ import (
 	"github.com/cockroachdb/errors"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.etcd.io/etcd/server/v3/embed"
+	"google.golang.org/grpc"
 )
 
 var (
@@ -42,28 +43,31 @@ func GetEtcdClient(
 	certFile string,
 	keyFile string,
 	caCertFile string,
-	minVersion string) (*clientv3.Client, error) {
+	minVersion string,
+	dialOpts ...grpc.DialOption) (*clientv3.Client, error) {
 	if useEmbedEtcd {
 		return GetEmbedEtcdClient()
 	}
 	if useSSL {
-		return GetRemoteEtcdSSLClient(endpoints, certFile, keyFile, caCertFile, minVersion)
+		return GetRemoteEtcdSSLClient(endpoints, certFile, keyFile, caCertFile, minVersion, dialOpts...)
 	}
-	return GetRemoteEtcdClient(endpoints)
+	return GetRemoteEtcdClient(endpoints, dialOpts...)
 }
 
 // GetRemoteEtcdClient returns client of remote etcd by given endpoints
-func GetRemoteEtcdClient(endpoints []string) (*clientv3.Client, error) {
+func GetRemoteEtcdClient(endpoints []string, dialOpts ...grpc.DialOption) (*clientv3.Client, error) {
 	return clientv3.New(clientv3.Config{
 		Endpoints:   endpoints,
 		DialTimeout: 5 * time.Second,
+		DialOptions: dialOpts,
 	})
 }
 
-func GetRemoteEtcdSSLClient(endpoints []string, certFile string, keyFile string, caCertFile string, minVersion string) (*clientv3.Client, error) {
+func GetRemoteEtcdSSLClient(endpoints []string, certFile string, keyFile string, caCertFile string, minVersion string, dialOpts ...grpc.DialOption) (*clientv3.Client, error) {
 	var cfg clientv3.Config
 	cfg.Endpoints = endpoints
 	cfg.DialTimeout = 5 * time.Second
+	cfg.DialOptions = dialOpts
 	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
 	if err != nil {
 		return nil, errors.Wrap(err, "load etcd cert key pair error")
@@ -0,0 +1,83 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcd
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+)
+
+// RateLimitDialOptions returns the dial options limiting the requests of the etcd client to limit per second,
+// both the unary requests and the streams (e.g. watches) are limited,
+// the time spent in waiting for the limiter is passed to observe if it's not nil.
+// No limit is applied if limit is not positive.
+func RateLimitDialOptions(limit float64, burst int, observe func(wait time.Duration)) []grpc.DialOption {
+	if limit <= 0 {
+		return nil
+	}
+	l := newClientLimiter(limit, burst, observe)
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(l.unaryInterceptor),
+		grpc.WithChainStreamInterceptor(l.streamInterceptor),
+	}
+}
+
+// clientLimiter limits the requests of a grpc client.
+type clientLimiter struct {
+	limiter *rate.Limiter
+	observe func(wait time.Duration)
+}
+
+func newClientLimiter(limit float64, burst int, observe func(wait time.Duration)) *clientLimiter {
+	if burst <= 0 {
+		burst = 1
+	}
+	return &clientLimiter{
+		limiter: rate.NewLimiter(rate.Limit(limit), burst),
+		observe: observe,
+	}
+}
+
+func (l *clientLimiter) wait(ctx context.Context) error {
+	start := time.Now()
+	err := l.limiter.Wait(ctx)
+	if l.observe != nil {
+		l.observe(time.Since(start))
+	}
+	return err
+}
+
+func (l *clientLimiter) unaryInterceptor(ctx context.Context, method string, req, reply interface{},
+	cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption,
+) error {
+	if err := l.wait(ctx); err != nil {
+		return err
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+func (l *clientLimiter) streamInterceptor(ctx context.Context, desc *grpc.StreamDesc,
+	cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	if err := l.wait(ctx); err != nil {
+		return nil, err
+	}
+	return streamer(ctx, desc, cc, method, opts...)
+}
@@ -0,0 +1,55 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestRateLimitDialOptions(t *testing.T) {
+	assert.Empty(t, RateLimitDialOptions(0, 10, nil))
+	assert.Len(t, RateLimitDialOptions(10, 10, nil), 2)
+
+	var waits []time.Duration
+	l := newClientLimiter(1, 1, func(wait time.Duration) { waits = append(waits, wait) })
+	invoked := 0
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		invoked++
+		return nil
+	}
+
+	// the burst passes
+	assert.NoError(t, l.unaryInterceptor(context.Background(), "put", nil, nil, nil, invoker))
+	assert.Equal(t, 1, invoked)
+
+	// the next request queues for the limiter until the context is done
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := l.streamInterceptor(ctx, &grpc.StreamDesc{}, nil, "watch",
+		func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+			invoked++
+			return nil, nil
+		})
+	assert.Error(t, err)
+	assert.Equal(t, 1, invoked)
+	assert.Len(t, waits, 2)
+}
@@ -0,0 +1,185 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package featureflag gates the new behaviors by the flags backed by the dynamic config, so that
+// the risky features could be enabled incrementally at runtime, for all, a part of or none of the collections.
+//
+// The flag named by <name> is configured by:
+//
+//	featureFlags.<name>.enabled            master switch of the flag
+//	featureFlags.<name>.collections        comma separated IDs of the collections always gated in
+//	featureFlags.<name>.rolloutPercentage  percentage of the other collections gated in
+package featureflag
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+var (
+	mu    sync.RWMutex
+	flags = make(map[string]*Flag)
+)
+
+// Flag is a feature flag gating a new behavior.
+type Flag struct {
+	name             string
+	description      string
+	enabledByDefault bool
+
+	once   sync.Once
+	params paramtable.FeatureFlagConfig
+}
+
+// Register registers a feature flag, which is supposed to be called at the package initialization.
+// It panics if the name is invalid or registered already.
+func Register(name string, description string, enabledByDefault bool) *Flag {
+	if name == "" || strings.ContainsAny(name, "./") {
+		panic(fmt.Sprintf("invalid feature flag name %q", name))
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	key := strings.ToLower(name)
+	if _, ok := flags[key]; ok {
+		panic(fmt.Sprintf("feature flag %s registered more than once", name))
+	}
+	flag := &Flag{
+		name:             name,
+		description:      description,
+		enabledByDefault: enabledByDefault,
+	}
+	flags[key] = flag
+	return flag
+}
+
+// Get returns the registered flag by the name, which is case insensitive.
+func Get(name string) (*Flag, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	flag, ok := flags[strings.ToLower(name)]
+	return flag, ok
+}
+
+func (f *Flag) Name() string {
+	return f.name
+}
+
+// the params are initialized lazily since the flags are registered before paramtable is initialized.
+func (f *Flag) getParams() *paramtable.FeatureFlagConfig {
+	f.once.Do(func() {
+		f.params.Init(f.name, f.enabledByDefault, &paramtable.Get().BaseTable)
+	})
+	return &f.params
+}
+
+// IsEnabled returns whether the flag is switched on, for the features not bound to a collection.
+func (f *Flag) IsEnabled() bool {
+	return f.getParams().Enabled.GetAsBool()
+}
+
+// IsEnabledForCollection returns whether the feature is enabled for the collection.
+// The collections are rolled out by a hash of the flag and the collection ID, a collection
+// gated in keeps enabled as the percentage grows, and the flags pick different collections.
+func (f *Flag) IsEnabledForCollection(collectionID int64) bool {
+	params := f.getParams()
+	if !params.Enabled.GetAsBool() {
+		return false
+	}
+	if _, ok := f.collections()[collectionID]; ok {
+		return true
+	}
+	return f.bucket(collectionID) < params.RolloutPercentage.GetAsInt()
+}
+
+func (f *Flag) collections() map[int64]struct{} {
+	collections := make(map[int64]struct{})
+	for _, str := range strings.Split(f.getParams().Collections.GetValue(), ",") {
+		str = strings.TrimSpace(str)
+		if str == "" {
+			continue
+		}
+		collectionID, err := strconv.ParseInt(str, 10, 64)
+		if err != nil {
+			log.RatedWarn(60, "invalid collection ID of feature flag", zap.String("flag", f.name), zap.String("collectionID", str))
+			continue
+		}
+		collections[collectionID] = struct{}{}
+	}
+	return collections
+}
+
+// bucket maps the collection into [0, 100).
+func (f *Flag) bucket(collectionID int64) int {
+	h := fnv.New32a()
+	h.Write([]byte(strings.ToLower(f.name)))
+	h.Write([]byte(strconv.FormatInt(collectionID, 10)))
+	return int(h.Sum32() % 100)
+}
+
+// Status is the runtime status of a flag.
+type Status struct {
+	Name              string  `json:"name"`
+	Description       string  `json:"description"`
+	Enabled           bool    `json:"enabled"`
+	Collections       []int64 `json:"collections,omitempty"`
+	RolloutPercentage int     `json:"rollout_percentage"`
+}
+
+// GetStatus returns the status of the flag.
+func (f *Flag) GetStatus() *Status {
+	params := f.getParams()
+	status := &Status{
+		Name:              f.name,
+		Description:       f.description,
+		Enabled:           params.Enabled.GetAsBool(),
+		RolloutPercentage: params.RolloutPercentage.GetAsInt(),
+	}
+	for collectionID := range f.collections() {
+		status.Collections = append(status.Collections, collectionID)
+	}
+	sort.Slice(status.Collections, func(i, j int) bool {
+		return status.Collections[i] < status.Collections[j]
+	})
+	return status
+}
+
+// ListStatus returns the status of all the registered flags, ordered by name.
+func ListStatus() []*Status {
+	mu.RLock()
+	all := make([]*Flag, 0, len(flags))
+	for _, flag := range flags {
+		all = append(all, flag)
+	}
+	mu.RUnlock()
+
+	statuses := make([]*Status, 0, len(all))
+	for _, flag := range all {
+		statuses = append(statuses, flag.GetStatus())
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Name < statuses[j].Name
+	})
+	return statuses
+}
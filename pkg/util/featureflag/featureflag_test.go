@@ -0,0 +1,144 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package featureflag
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestMain(m *testing.M) {
+	paramtable.Init()
+	m.Run()
+}
+
+func TestRegister(t *testing.T) {
+	flag := Register("testRegister", "test", false)
+	assert.Equal(t, "testRegister", flag.Name())
+	got, ok := Get("testregister")
+	assert.True(t, ok)
+	assert.Same(t, flag, got)
+	_, ok = Get("notExist")
+	assert.False(t, ok)
+
+	assert.Panics(t, func() { Register("TestRegister", "duplicated", false) })
+	assert.Panics(t, func() { Register("", "empty", false) })
+	assert.Panics(t, func() { Register("a.b", "dotted", false) })
+}
+
+func TestFlag_IsEnabled(t *testing.T) {
+	params := paramtable.Get()
+	flag := Register("testEnabled", "test", false)
+	defaultOn := Register("testDefaultOn", "test", true)
+	assert.False(t, flag.IsEnabled())
+	assert.False(t, flag.IsEnabledForCollection(1))
+	assert.True(t, defaultOn.IsEnabled())
+	assert.True(t, defaultOn.IsEnabledForCollection(1))
+
+	params.Save("featureFlags.testEnabled.enabled", "true")
+	defer params.Reset("featureFlags.testEnabled.enabled")
+	assert.True(t, flag.IsEnabled())
+	for i := int64(0); i < 100; i++ {
+		assert.True(t, flag.IsEnabledForCollection(i))
+	}
+
+	// the listed collections only
+	params.Save("featureFlags.testEnabled.collections", "1, 2,invalid")
+	defer params.Reset("featureFlags.testEnabled.collections")
+	assert.True(t, flag.IsEnabledForCollection(1))
+	assert.True(t, flag.IsEnabledForCollection(2))
+	assert.False(t, flag.IsEnabledForCollection(3))
+
+	// the listed collections and a percentage of the others
+	params.Save("featureFlags.testEnabled.rolloutPercentage", "30")
+	defer params.Reset("featureFlags.testEnabled.rolloutPercentage")
+	enabled := make(map[int64]bool)
+	for i := int64(3); i < 1003; i++ {
+		enabled[i] = flag.IsEnabledForCollection(i)
+	}
+	count := 0
+	for _, ok := range enabled {
+		if ok {
+			count++
+		}
+	}
+	assert.InDelta(t, 300, count, 60)
+
+	// the gated in collections keep enabled as the percentage grows
+	params.Save("featureFlags.testEnabled.rolloutPercentage", "60")
+	for i, ok := range enabled {
+		if ok {
+			assert.True(t, flag.IsEnabledForCollection(i))
+		}
+	}
+
+	// the master switch
+	params.Save("featureFlags.testEnabled.enabled", "false")
+	assert.False(t, flag.IsEnabledForCollection(1))
+}
+
+func TestHandler(t *testing.T) {
+	params := paramtable.Get()
+	Register("testHandler", "test handler", false)
+	params.Save("featureFlags.testHandler.enabled", "true")
+	params.Save("featureFlags.testHandler.collections", "2,1")
+	defer params.Reset("featureFlags.testHandler.enabled")
+	defer params.Reset("featureFlags.testHandler.collections")
+
+	server := httptest.NewServer(Handler())
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "?name=testHandler")
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	status := &Status{}
+	assert.NoError(t, json.Unmarshal(body, status))
+	assert.Equal(t, &Status{
+		Name:              "testHandler",
+		Description:       "test handler",
+		Enabled:           true,
+		Collections:       []int64{1, 2},
+		RolloutPercentage: 0,
+	}, status)
+
+	resp, err = http.Get(server.URL)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	body, err = io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	var statuses []*Status
+	assert.NoError(t, json.Unmarshal(body, &statuses))
+	assert.NotEmpty(t, statuses)
+	for i := 1; i < len(statuses); i++ {
+		assert.Less(t, statuses[i-1].Name, statuses[i].Name)
+	}
+
+	resp, err = http.Get(server.URL + "?name=notExist")
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
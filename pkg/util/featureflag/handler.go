@@ -0,0 +1,54 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package featureflag
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// Handler returns the http handler to introspect the status of the flags,
+// the status of a single flag is returned if the name is given by the query.
+func Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var resp any
+		if name := r.URL.Query().Get("name"); name != "" {
+			flag, ok := Get(name)
+			if !ok {
+				http.Error(w, "feature flag not found: "+name, http.StatusNotFound)
+				return
+			}
+			resp = flag.GetStatus()
+		} else {
+			resp = ListStatus()
+		}
+
+		bs, err := json.Marshal(resp)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write(bs); err != nil {
+			log.Warn("failed to write feature flag status", zap.Error(err))
+		}
+	})
+}
@@ -26,4 +26,8 @@ const (
 	IndexFaissBinIvfFlat IndexType = "BIN_IVF_FLAT"
 	IndexHNSW            IndexType = "HNSW"
 	IndexDISKANN         IndexType = "DISKANN"
+
+	IndexSTLSORT IndexType = "STL_SORT"
+	IndexTrie    IndexType = "Trie"
+	IndexNGRAM   IndexType = "NGRAM"
 )
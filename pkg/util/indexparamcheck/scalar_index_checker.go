@@ -1,8 +1,16 @@
 package indexparamcheck
 
-import "github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+import (
+	"fmt"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
 
 // TODO: check index parameters according to the index type & data type.
 func CheckIndexValid(dType schemapb.DataType, indexType IndexType, indexParams map[string]string) error {
+	if indexType == IndexNGRAM && !typeutil.IsStringType(dType) {
+		return fmt.Errorf("%s index is only supported on varchar field, data type: %s", IndexNGRAM, dType.String())
+	}
 	return nil
 }
@@ -9,4 +9,6 @@ import (
 
 func TestCheckIndexValid(t *testing.T) {
 	assert.NoError(t, CheckIndexValid(schemapb.DataType_Int64, "inverted_index", nil))
+	assert.NoError(t, CheckIndexValid(schemapb.DataType_VarChar, IndexNGRAM, nil))
+	assert.Error(t, CheckIndexValid(schemapb.DataType_Int64, IndexNGRAM, nil))
 }
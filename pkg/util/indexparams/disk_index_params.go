@@ -22,9 +22,11 @@ import (
 	"strconv"
 	"unsafe"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/hardware"
+	"github.com/milvus-io/milvus/pkg/util/indexparamcheck"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
@@ -46,6 +48,9 @@ const (
 
 	MaxLoadThread = 64
 	MaxBeamWidth  = 16
+
+	// DiskBuildSizeRatio is the ratio of the local disk space taken to build a disk index to the size of the raw data
+	DiskBuildSizeRatio = 4.0
 )
 
 func getRowDataSizeOfFloatVector(numRows int64, dim int64) int64 {
@@ -222,6 +227,24 @@ func SetDiskIndexBuildParams(indexParams map[string]string, fieldDataSize int64)
 	return nil
 }
 
+// EstimateDiskBuildSize estimates the local disk space taken by IndexNode to build the index,
+// returns 0 if the index is not built on disk.
+func EstimateDiskBuildSize(indexParams []*commonpb.KeyValuePair, typeParams []*commonpb.KeyValuePair, numRows int64) int64 {
+	indexType, _ := funcutil.GetAttrByKeyFromRepeatedKV(common.IndexTypeKey, indexParams)
+	if indexType != indexparamcheck.IndexDISKANN {
+		return 0
+	}
+	dimStr, err := funcutil.GetAttrByKeyFromRepeatedKV(common.DimKey, typeParams)
+	if err != nil {
+		return 0
+	}
+	dim, err := strconv.ParseInt(dimStr, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return int64(float64(getRowDataSizeOfFloatVector(numRows, dim)) * DiskBuildSizeRatio)
+}
+
 // SetDiskIndexLoadParams set disk index load params with ratio params on queryNode
 // QueryNode cal load params with ratio params ans cpu count...
 func SetDiskIndexLoadParams(params *paramtable.ComponentParam, indexParams map[string]string, numRows int64) error {
@@ -22,6 +22,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util/hardware"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -287,6 +288,18 @@ func TestDiskIndexParams(t *testing.T) {
 	})
 }
 
+func TestEstimateDiskBuildSize(t *testing.T) {
+	typeParams := []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "128"}}
+	diskIndexParams := []*commonpb.KeyValuePair{{Key: common.IndexTypeKey, Value: "DISKANN"}}
+	assert.Equal(t, int64(128*4*1000*DiskBuildSizeRatio), EstimateDiskBuildSize(diskIndexParams, typeParams, 1000))
+
+	memIndexParams := []*commonpb.KeyValuePair{{Key: common.IndexTypeKey, Value: "HNSW"}}
+	assert.Equal(t, int64(0), EstimateDiskBuildSize(memIndexParams, typeParams, 1000))
+
+	invalidTypeParams := []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "invalid"}}
+	assert.Equal(t, int64(0), EstimateDiskBuildSize(diskIndexParams, invalidTypeParams, 1000))
+}
+
 func TestBigDataIndex_parse(t *testing.T) {
 	t.Run("parse normal", func(t *testing.T) {
 		mapString := make(map[string]string)
@@ -54,6 +54,8 @@ var (
 	ErrServiceRequestLimitExceeded = newMilvusError("request limit exceeded", 4, true)
 	ErrServiceInternal             = newMilvusError("service internal error", 5, false) // Never return this error out of Milvus
 	ErrCrossClusterRouting         = newMilvusError("cross cluster routing", 6, false)
+	ErrServiceReadOnly             = newMilvusError("service read only", 7, true) // The meta store is degraded, only reads are served
+	ErrServicePermissionDenied     = newMilvusError("permission denied", 8, false)
 
 	// Collection related
 	ErrCollectionNotFound         = newMilvusError("collection not found", 100, false)
@@ -78,6 +80,7 @@ var (
 	ErrChannelLack         = newMilvusError("channel lacks", 501, false)
 	ErrChannelReduplicate  = newMilvusError("channel reduplicates", 502, false)
 	ErrChannelNotAvailable = newMilvusError("channel not available", 503, false)
+	ErrChannelFenced       = newMilvusError("channel request fenced", 504, false)
 
 	// Segment related
 	ErrSegmentNotFound    = newMilvusError("segment not found", 600, false)
@@ -86,7 +89,8 @@ var (
 	ErrSegmentReduplicate = newMilvusError("segment reduplicates", 603, false)
 
 	// Index related
-	ErrIndexNotFound = newMilvusError("index not found", 700, false)
+	ErrIndexNotFound                = newMilvusError("index not found", 700, false)
+	ErrIndexEngineVersionNotSupport = newMilvusError("index built by newer version, rebuild required or upgrade node", 701, false)
 
 	// Database related
 	ErrDatabaseNotfound         = newMilvusError("database not found", 800, false)
@@ -120,6 +124,8 @@ var (
 	ErrShardDelegatorSearchFailed    = newMilvusError("fail to search on all shard leaders", 1502, true)
 	ErrShardDelegatorQueryFailed     = newMilvusError("fail to query on all shard leaders", 1503, true)
 	ErrShardDelegatorStatisticFailed = newMilvusError("get statistics on all shard leaders", 1504, true)
+	ErrConsistencyWaitExceeded       = newMilvusError("consistency wait exceeded budget", 1505, true)
+	ErrSearchCostExceeded            = newMilvusError("search cost exceeded ceiling", 1506, false)
 
 	// field related
 	ErrFieldNotFound = newMilvusError("field not found", 1700, false)
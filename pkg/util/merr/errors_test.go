@@ -19,6 +19,7 @@ package merr
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
@@ -66,6 +67,8 @@ func (s *ErrSuite) TestWrap() {
 	s.ErrorIs(WrapErrServiceRequestLimitExceeded(100, "too many requests"), ErrServiceRequestLimitExceeded)
 	s.ErrorIs(WrapErrServiceInternal("never throw out"), ErrServiceInternal)
 	s.ErrorIs(WrapErrCrossClusterRouting("ins-0", "ins-1"), ErrCrossClusterRouting)
+	s.ErrorIs(WrapErrServiceReadOnly("meta store degraded"), ErrServiceReadOnly)
+	s.ErrorIs(WrapErrServicePermissionDenied("alice", "admin only"), ErrServicePermissionDenied)
 
 	// Collection related
 	s.ErrorIs(WrapErrCollectionNotFound("test_collection", "failed to get collection"), ErrCollectionNotFound)
@@ -88,6 +91,7 @@ func (s *ErrSuite) TestWrap() {
 	s.ErrorIs(WrapErrChannelNotFound("test_Channel", "failed to get Channel"), ErrChannelNotFound)
 	s.ErrorIs(WrapErrChannelLack("test_Channel", "failed to get Channel"), ErrChannelLack)
 	s.ErrorIs(WrapErrChannelReduplicate("test_Channel", "failed to get Channel"), ErrChannelReduplicate)
+	s.ErrorIs(WrapErrChannelFenced("test_Channel", "stale request"), ErrChannelFenced)
 
 	// Segment related
 	s.ErrorIs(WrapErrSegmentNotFound(1, "failed to get Segment"), ErrSegmentNotFound)
@@ -97,6 +101,7 @@ func (s *ErrSuite) TestWrap() {
 
 	// Index related
 	s.ErrorIs(WrapErrIndexNotFound("failed to get Index"), ErrIndexNotFound)
+	s.ErrorIs(WrapErrIndexEngineVersionNotSupport(1, 2, 1), ErrIndexEngineVersionNotSupport)
 
 	// Node related
 	s.ErrorIs(WrapErrNodeNotFound(1, "failed to get node"), ErrNodeNotFound)
@@ -120,6 +125,8 @@ func (s *ErrSuite) TestWrap() {
 
 	// shard delegator related
 	s.ErrorIs(WrapErrShardDelegatorNotFound("unknown", "fail to get shard delegator"), ErrShardDelegatorNotFound)
+	s.ErrorIs(WrapErrConsistencyWaitExceeded("dml_0", time.Second, time.Second), ErrConsistencyWaitExceeded)
+	s.ErrorIs(WrapErrSearchCostExceeded("rows", 100, 10, "segments=1 rows=100"), ErrSearchCostExceeded)
 
 	// field related
 	s.ErrorIs(WrapErrFieldNotFound("meta", "failed to get field"), ErrFieldNotFound)
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
@@ -168,6 +169,22 @@ func WrapErrServiceInternal(msg string, others ...string) error {
 	return err
 }
 
+func WrapErrServiceReadOnly(reason string, msg ...string) error {
+	err := errors.Wrap(ErrServiceReadOnly, reason)
+	if len(msg) > 0 {
+		err = errors.Wrap(err, strings.Join(msg, "; "))
+	}
+	return err
+}
+
+func WrapErrServicePermissionDenied(user string, msg ...string) error {
+	err := errors.Wrapf(ErrServicePermissionDenied, "user=%s", user)
+	if len(msg) > 0 {
+		err = errors.Wrap(err, strings.Join(msg, "; "))
+	}
+	return err
+}
+
 func WrapErrCrossClusterRouting(expectedCluster, actualCluster string, msg ...string) error {
 	err := errors.Wrapf(ErrCrossClusterRouting, "expectedCluster=%s, actualCluster=%s", expectedCluster, actualCluster)
 	if len(msg) > 0 {
@@ -317,6 +334,14 @@ func WrapErrChannelNotAvailable(name string, msg ...string) error {
 	return err
 }
 
+func WrapErrChannelFenced(name string, msg ...string) error {
+	err := wrapWithField(ErrChannelFenced, "channel", name)
+	if len(msg) > 0 {
+		err = errors.Wrap(err, strings.Join(msg, "; "))
+	}
+	return err
+}
+
 // Segment related
 func WrapErrSegmentNotFound(id int64, msg ...string) error {
 	err := wrapWithField(ErrSegmentNotFound, "segment", id)
@@ -359,6 +384,14 @@ func WrapErrIndexNotFound(msg ...string) error {
 	return err
 }
 
+func WrapErrIndexEngineVersionNotSupport(segmentID int64, version, current int32, msg ...string) error {
+	err := errors.Wrapf(ErrIndexEngineVersionNotSupport, "segment=%d index engine version=%d current version=%d", segmentID, version, current)
+	if len(msg) > 0 {
+		err = errors.Wrap(err, strings.Join(msg, "; "))
+	}
+	return err
+}
+
 // Node related
 func WrapErrNodeNotFound(id int64, msg ...string) error {
 	err := wrapWithField(ErrNodeNotFound, "node", id)
@@ -509,6 +542,26 @@ func WrapErrShardDelegatorStatisticFailed(msg ...string) error {
 	return err
 }
 
+// WrapErrConsistencyWaitExceeded reports the read waited for the serviceable time of the channel longer than the budget,
+// lag is the gap between the guarantee timestamp and the serviceable time when the wait gave up.
+func WrapErrConsistencyWaitExceeded(channel string, lag, budget time.Duration, msg ...string) error {
+	err := errors.Wrapf(ErrConsistencyWaitExceeded, "channel=%s lag=%s budget=%s", channel, lag, budget)
+	if len(msg) > 0 {
+		err = errors.Wrap(err, strings.Join(msg, "; "))
+	}
+	return err
+}
+
+// WrapErrSearchCostExceeded reports the estimated cost of the search violates the constraint,
+// the client shall narrow the search, e.g. with more selective filters or partitions, rather than retrying.
+func WrapErrSearchCostExceeded(constraint string, estimate, ceiling int64, msg ...string) error {
+	err := errors.Wrapf(ErrSearchCostExceeded, "constraint=%s estimate=%d ceiling=%d", constraint, estimate, ceiling)
+	if len(msg) > 0 {
+		err = errors.Wrap(err, strings.Join(msg, "; "))
+	}
+	return err
+}
+
 // field related
 func WrapErrFieldNotFound[T any](field T, msg ...string) error {
 	err := errors.Wrapf(ErrFieldNotFound, "field=%v", field)
@@ -34,6 +34,17 @@ func BuildDeltaLogPath(rootPath string, collectionID, partitionID, segmentID, lo
 	return path.Join(rootPath, common.SegmentDeltaLogPath, k)
 }
 
+// BuildSidePayloadPath returns the path of the value of the row stored aside.
+func BuildSidePayloadPath(rootPath string, collectionID, fieldID, rowID typeutil.UniqueID) string {
+	return path.Join(rootPath, common.SidePayloadPath, JoinIDPath(collectionID, fieldID, rowID))
+}
+
+// BuildSidePayloadPrefix returns the prefix of the values stored aside of the collection,
+// or of the field if any field ID is given.
+func BuildSidePayloadPrefix(rootPath string, collectionID typeutil.UniqueID, fieldID ...typeutil.UniqueID) string {
+	return path.Join(rootPath, common.SidePayloadPath, JoinIDPath(append([]typeutil.UniqueID{collectionID}, fieldID...)...)) + pathSep
+}
+
 func GetSegmentIDFromDeltaLogPath(logPath string) typeutil.UniqueID {
 	return getSegmentIDFromPath(logPath, 2)
 }
@@ -100,6 +100,8 @@ type QueryNodeInfos struct {
 	BaseComponentInfos
 	SystemConfigurations QueryNodeConfiguration `json:"system_configurations"`
 	QuotaMetrics         *QueryNodeQuotaMetrics `json:"quota_metrics"`
+	// ResourceGroup is filled by QueryCoord, it's empty in the metrics reported by QueryNode
+	ResourceGroup string `json:"resource_group,omitempty"`
 }
 
 // QueryCoordConfiguration records the configuration of QueryCoord.
@@ -125,6 +127,16 @@ type ProxyInfos struct {
 	BaseComponentInfos
 	SystemConfigurations ProxyConfiguration `json:"system_configurations"`
 	QuotaMetrics         *ProxyQuotaMetrics `json:"quota_metrics"`
+	SlowQueries          []SlowQueryInfo    `json:"slow_queries,omitempty"`
+}
+
+// SlowQueryInfo records a search or query request which is slower than expected.
+type SlowQueryInfo struct {
+	Method     string `json:"method"`
+	Database   string `json:"database"`
+	Collection string `json:"collection"`
+	StartTime  string `json:"start_time"`
+	DurationMs int64  `json:"duration_ms"`
 }
 
 // IndexNodeConfiguration records the configuration of IndexNode.
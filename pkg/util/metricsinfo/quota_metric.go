@@ -51,6 +51,15 @@ type RateMetric struct {
 	Rate  float64
 }
 
+// ChannelWriteRate contains the write rates per second of a vchannel consumed by the DataNode.
+type ChannelWriteRate struct {
+	Channel         string
+	InsertRowsRate  float64
+	InsertBytesRate float64
+	DeleteRowsRate  float64
+	DeleteBytesRate float64
+}
+
 // FlowGraphMetric contains a minimal timestamp of flow graph and the number of flow graphs.
 type FlowGraphMetric struct {
 	MinFlowGraphChannel string
@@ -87,6 +96,8 @@ type QueryNodeQuotaMetrics struct {
 type DataCoordQuotaMetrics struct {
 	TotalBinlogSize      int64
 	CollectionBinlogSize map[int64]int64
+	// collections having the segments registered on the external files, which are not converted into binlogs yet
+	ExternalCollections []int64
 }
 
 // DataNodeQuotaMetrics are metrics of DataNode.
@@ -95,10 +106,25 @@ type DataNodeQuotaMetrics struct {
 	Rms    []RateMetric
 	Fgm    FlowGraphMetric
 	Effect NodeEffect
+	// write rates of each vchannel, for checking whether the writes are distributed evenly across the shards
+	ChannelRates []ChannelWriteRate
+}
+
+// CollectionRequestMetrics are the requests of a collection served by a Proxy since it started.
+type CollectionRequestMetrics struct {
+	CollectionID int64
+	SearchCount  int64
+	QueryCount   int64
+	// total latency in milliseconds of the search and query requests
+	ReadLatencyMs int64
+	InsertRows    int64
+	DeleteRows    int64
 }
 
 // ProxyQuotaMetrics are metrics of Proxy.
 type ProxyQuotaMetrics struct {
 	Hms HardwareMetrics
 	Rms []RateMetric
+	// accumulated requests of each collection, for RootCoord to maintain the statistics history of collections
+	Collections []CollectionRequestMetrics
 }
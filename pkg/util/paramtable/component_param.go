@@ -209,6 +209,7 @@ type commonConfig struct {
 
 	AuthorizationEnabled ParamItem `refreshable:"false"`
 	SuperUsers           ParamItem `refreshable:"true"`
+	EncryptionMasterKey  ParamItem `refreshable:"false"`
 
 	ClusterName ParamItem `refreshable:"false"`
 
@@ -590,6 +591,15 @@ like the old password verification when updating the credential`,
 	}
 	p.SuperUsers.Init(base.mgr)
 
+	p.EncryptionMasterKey = ParamItem{
+		Key:     "common.security.encryptionMasterKey",
+		Version: "2.3.0",
+		Doc: `The base64 encoded 32 bytes master key to encrypt the binlogs of the collections with encryption enabled,
+the data keys of the collections are derived from it, keep it the same across all the nodes`,
+		DefaultValue: "",
+	}
+	p.EncryptionMasterKey.Init(base.mgr)
+
 	p.ClusterName = ParamItem{
 		Key:          "common.cluster.name",
 		Version:      "2.0.0",
@@ -791,8 +801,16 @@ type rootCoordConfig struct {
 	ImportTaskRetention         ParamItem `refreshable:"true"`
 	ImportMaxPendingTaskCount   ParamItem `refreshable:"true"`
 	ImportTaskSubPath           ParamItem `refreshable:"true"`
+	ImportTaskMaxRetries        ParamItem `refreshable:"true"`
 	EnableActiveStandby         ParamItem `refreshable:"false"`
 	MaxDatabaseNum              ParamItem `refreshable:"false"`
+	DDLHookWebhooks             ParamItem `refreshable:"true"`
+	DDLHookTimeout              ParamItem `refreshable:"true"`
+	DDLHookRetryInterval        ParamItem `refreshable:"true"`
+	DDLHookMaxRetries           ParamItem `refreshable:"true"`
+	MetaCacheEventRetention     ParamItem `refreshable:"true"`
+	StatsHistoryInterval        ParamItem `refreshable:"false"`
+	StatsHistoryRetention       ParamItem `refreshable:"true"`
 }
 
 func (p *rootCoordConfig) init(base *BaseTable) {
@@ -856,6 +874,78 @@ func (p *rootCoordConfig) init(base *BaseTable) {
 	}
 	p.ImportMaxPendingTaskCount.Init(base.mgr)
 
+	p.ImportTaskMaxRetries = ParamItem{
+		Key:          "rootCoord.importTaskMaxRetries",
+		Version:      "2.3.0",
+		DefaultValue: "3",
+		Doc:          "Maximum times a failed import task is retried before the whole import job fails",
+		Export:       true,
+	}
+	p.ImportTaskMaxRetries.Init(base.mgr)
+
+	p.DDLHookWebhooks = ParamItem{
+		Key:          "rootCoord.ddlHook.webhooks",
+		Version:      "2.3.0",
+		DefaultValue: "",
+		Doc:          "Comma separated urls which DDL events are posted to, DDL hooks are disabled if empty",
+		Export:       true,
+	}
+	p.DDLHookWebhooks.Init(base.mgr)
+
+	p.DDLHookTimeout = ParamItem{
+		Key:          "rootCoord.ddlHook.timeout",
+		Version:      "2.3.0",
+		DefaultValue: "3000",
+		Doc:          "Timeout in milliseconds of posting a DDL event to a webhook",
+		Export:       true,
+	}
+	p.DDLHookTimeout.Init(base.mgr)
+
+	p.DDLHookRetryInterval = ParamItem{
+		Key:          "rootCoord.ddlHook.retryInterval",
+		Version:      "2.3.0",
+		DefaultValue: "1000",
+		Doc:          "Initial interval in milliseconds before retrying a failed DDL event, doubled on each failure",
+		Export:       true,
+	}
+	p.DDLHookRetryInterval.Init(base.mgr)
+
+	p.DDLHookMaxRetries = ParamItem{
+		Key:          "rootCoord.ddlHook.maxRetries",
+		Version:      "2.3.0",
+		DefaultValue: "10",
+		Doc:          "Maximum times a DDL event is retried before it is dropped, 0 means retry forever",
+		Export:       true,
+	}
+	p.DDLHookMaxRetries.Init(base.mgr)
+
+	p.MetaCacheEventRetention = ParamItem{
+		Key:          "rootCoord.metaCacheEventRetention",
+		Version:      "2.3.0",
+		DefaultValue: "600",
+		Doc:          "Seconds to keep the collection meta cache invalidation events for proxies to watch",
+		Export:       true,
+	}
+	p.MetaCacheEventRetention.Init(base.mgr)
+
+	p.StatsHistoryInterval = ParamItem{
+		Key:          "rootCoord.statsHistory.interval",
+		Version:      "2.3.0",
+		DefaultValue: "300",
+		Doc:          "Interval in seconds of sampling the query and DML statistics of each collection",
+		Export:       true,
+	}
+	p.StatsHistoryInterval.Init(base.mgr)
+
+	p.StatsHistoryRetention = ParamItem{
+		Key:          "rootCoord.statsHistory.retention",
+		Version:      "2.3.0",
+		DefaultValue: "604800",
+		Doc:          "Seconds to keep the statistics samples of each collection in meta store",
+		Export:       true,
+	}
+	p.StatsHistoryRetention.Init(base.mgr)
+
 	p.EnableActiveStandby = ParamItem{
 		Key:          "rootCoord.enableActiveStandby",
 		Version:      "2.2.0",
@@ -888,6 +978,39 @@ type AccessLogConfig struct {
 	RemoteMaxTime ParamItem `refreshable:"false"`
 }
 
+// MirrorConfig is the config of mirroring search/query requests to a shadow target.
+type MirrorConfig struct {
+	Enable           ParamItem `refreshable:"true"`
+	SampleRatio      ParamItem `refreshable:"true"`
+	CollectionSuffix ParamItem `refreshable:"true"`
+	Endpoint         ParamItem `refreshable:"false"`
+	Username         ParamItem `refreshable:"true"`
+	Password         ParamItem `refreshable:"true"`
+	MaxConcurrency   ParamItem `refreshable:"false"`
+	Timeout          ParamItem `refreshable:"true"`
+}
+
+type IndexEvaluationConfig struct {
+	SearchLogSampleRatio ParamItem `refreshable:"true"`
+	SearchLogCapacity    ParamItem `refreshable:"true"`
+	Timeout              ParamItem `refreshable:"true"`
+}
+
+// RecallSamplingConfig is the config of estimating the recall of the searches by re-executing them exactly.
+type RecallSamplingConfig struct {
+	SampleRatio    ParamItem `refreshable:"true"`
+	MaxConcurrency ParamItem `refreshable:"false"`
+	Timeout        ParamItem `refreshable:"true"`
+}
+
+// FederationConfig is the config of routing databases/collections to other Milvus clusters.
+type FederationConfig struct {
+	Enable             ParamItem `refreshable:"true"`
+	RefreshInterval    ParamItem `refreshable:"false"`
+	HealthCheckTimeout ParamItem `refreshable:"true"`
+	ForwardToken       ParamItem `refreshable:"true"`
+}
+
 type proxyConfig struct {
 	// Alias  string
 	SoPath ParamItem `refreshable:"false"`
@@ -907,10 +1030,26 @@ type proxyConfig struct {
 	MaxRoleNum                   ParamItem `refreshable:"true"`
 	MaxTaskNum                   ParamItem `refreshable:"false"`
 	AccessLog                    AccessLogConfig
+	Mirror                       MirrorConfig
+	IndexEvaluation              IndexEvaluationConfig
+	RecallSampling               RecallSamplingConfig
+	Federation                   FederationConfig
+	InsertValidationProfile      ParamItem `refreshable:"true"`
+	SidePayloadThreshold         ParamItem `refreshable:"true"`
 	ShardLeaderCacheInterval     ParamItem `refreshable:"false"`
 	ReplicaSelectionPolicy       ParamItem `refreshable:"false"`
 	CheckQueryNodeHealthInterval ParamItem `refreshable:"false"`
 	CostMetricsExpireTime        ParamItem `refreshable:"true"`
+	HealthScoredEWMAAlpha        ParamItem `refreshable:"true"`
+	HealthScoredErrorThreshold   ParamItem `refreshable:"true"`
+	HealthScoredExcludeDuration  ParamItem `refreshable:"true"`
+	WatchMetaCacheEvents         ParamItem `refreshable:"false"`
+	SegmentInfoPageSize          ParamItem `refreshable:"true"`
+	QueryCoordReadyPhase         ParamItem `refreshable:"false"`
+	EnableGrpcReflection         ParamItem `refreshable:"false"`
+	MethodConcurrencyLimits      ParamItem `refreshable:"false"`
+	MethodQueueLength            ParamItem `refreshable:"false"`
+	SearchLazyFetchThreshold     ParamItem `refreshable:"true"`
 }
 
 func (p *proxyConfig) init(base *BaseTable) {
@@ -934,6 +1073,70 @@ func (p *proxyConfig) init(base *BaseTable) {
 	}
 	p.HealthCheckTimetout.Init(base.mgr)
 
+	p.WatchMetaCacheEvents = ParamItem{
+		Key:          "proxy.watchMetaCacheEvents",
+		Version:      "2.3.0",
+		DefaultValue: "true",
+		Doc:          "Whether to watch the collection meta cache invalidation events published by rootcoord",
+		Export:       true,
+	}
+	p.WatchMetaCacheEvents.Init(base.mgr)
+
+	p.SegmentInfoPageSize = ParamItem{
+		Key:          "proxy.segmentInfoPageSize",
+		Version:      "2.3.0",
+		DefaultValue: "1000",
+		Doc:          "The max number of segments fetched from datacoord per request when listing the segment info of a collection",
+		Export:       true,
+	}
+	p.SegmentInfoPageSize.Init(base.mgr)
+
+	p.QueryCoordReadyPhase = ParamItem{
+		Key:          "proxy.queryCoordReadyPhase",
+		Version:      "2.3.0",
+		DefaultValue: "TargetsRecovered",
+		Doc:          "The readiness phase of querycoord the proxy waits for before serving, one of MetaRecovered, SessionsWatched, CheckersStarted and TargetsRecovered",
+		Export:       true,
+	}
+	p.QueryCoordReadyPhase.Init(base.mgr)
+
+	p.EnableGrpcReflection = ParamItem{
+		Key:          "proxy.enableGrpcReflection",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+		Doc:          "Whether to enable the gRPC server reflection on the proxy, which lets tools like grpcurl list the services and methods",
+		Export:       true,
+	}
+	p.EnableGrpcReflection.Init(base.mgr)
+
+	p.MethodConcurrencyLimits = ParamItem{
+		Key:          "proxy.methodConcurrency.limits",
+		Version:      "2.3.0",
+		DefaultValue: "{}",
+		Doc:          `The max number of concurrent calls of the methods in JSON, like {"Flush": 4, "CreateIndex": 4}, the methods not listed are unlimited`,
+		Export:       true,
+	}
+	p.MethodConcurrencyLimits.Init(base.mgr)
+
+	p.MethodQueueLength = ParamItem{
+		Key:          "proxy.methodConcurrency.maxQueueLength",
+		Version:      "2.3.0",
+		DefaultValue: "16",
+		Doc:          "The max number of calls waiting for a method with the concurrency limit, the calls beyond it are rejected",
+		Export:       true,
+	}
+	p.MethodQueueLength.Init(base.mgr)
+
+	p.SearchLazyFetchThreshold = ParamItem{
+		Key:          "proxy.searchLazyFetchThreshold",
+		Version:      "2.3.0",
+		DefaultValue: "524288",
+		Doc: `bytes, the searches whose estimated size of the output fields exceeds the threshold fetch the output fields
+in a second phase only for the merged top-K results, instead of returning them from every shard, 0 to fetch lazily only for vector output fields`,
+		Export: true,
+	}
+	p.SearchLazyFetchThreshold.Init(base.mgr)
+
 	p.MsgStreamTimeTickBufSize = ParamItem{
 		Key:          "proxy.msgStream.timeTick.bufSize",
 		Version:      "2.2.0",
@@ -1135,7 +1338,7 @@ please adjust in embedded Milvus: false`,
 		Key:          "proxy.replicaSelectionPolicy",
 		Version:      "2.3.0",
 		DefaultValue: "look_aside",
-		Doc:          "replica selection policy in multiple replicas load balancing, support round_robin and look_aside",
+		Doc:          "replica selection policy in multiple replicas load balancing, support round_robin, look_aside and health_scored",
 	}
 	p.ReplicaSelectionPolicy.Init(base.mgr)
 
@@ -1155,6 +1358,213 @@ please adjust in embedded Milvus: false`,
 	}
 	p.CostMetricsExpireTime.Init(base.mgr)
 
+	p.HealthScoredEWMAAlpha = ParamItem{
+		Key:          "proxy.healthScored.ewmaAlpha",
+		Version:      "2.3.0",
+		DefaultValue: "0.3",
+		Doc:          "smoothing factor of the latency and error rate EWMA kept per shard leader, in range (0, 1]",
+	}
+	p.HealthScoredEWMAAlpha.Init(base.mgr)
+
+	p.HealthScoredErrorThreshold = ParamItem{
+		Key:          "proxy.healthScored.errorRateThreshold",
+		Version:      "2.3.0",
+		DefaultValue: "0.5",
+		Doc:          "shard leader whose error rate EWMA reaches this value is excluded temporarily",
+	}
+	p.HealthScoredErrorThreshold.Init(base.mgr)
+
+	p.HealthScoredExcludeDuration = ParamItem{
+		Key:          "proxy.healthScored.excludeDuration",
+		Version:      "2.3.0",
+		DefaultValue: "10000",
+		Doc:          "how long a flapping shard leader is excluded from selection, in ms",
+	}
+	p.HealthScoredExcludeDuration.Init(base.mgr)
+
+	p.Mirror.Enable = ParamItem{
+		Key:          "proxy.mirror.enable",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+		Doc:          "whether to mirror sampled search/query requests to the shadow target",
+		Export:       true,
+	}
+	p.Mirror.Enable.Init(base.mgr)
+
+	p.Mirror.SampleRatio = ParamItem{
+		Key:          "proxy.mirror.sampleRatio",
+		Version:      "2.3.0",
+		DefaultValue: "0",
+		Doc:          "ratio of search/query requests to mirror, in range [0, 1]",
+		Export:       true,
+	}
+	p.Mirror.SampleRatio.Init(base.mgr)
+
+	p.Mirror.CollectionSuffix = ParamItem{
+		Key:          "proxy.mirror.collectionSuffix",
+		Version:      "2.3.0",
+		DefaultValue: "",
+		Doc:          "the shadow collection name is the origin collection name with this suffix, leave empty to use the origin name on the shadow endpoint",
+		Export:       true,
+	}
+	p.Mirror.CollectionSuffix.Init(base.mgr)
+
+	p.Mirror.Endpoint = ParamItem{
+		Key:          "proxy.mirror.endpoint",
+		Version:      "2.3.0",
+		DefaultValue: "",
+		Doc:          "address of the shadow cluster proxy, leave empty to mirror to the shadow collection in this cluster",
+		Export:       true,
+	}
+	p.Mirror.Endpoint.Init(base.mgr)
+
+	p.Mirror.Username = ParamItem{
+		Key:          "proxy.mirror.username",
+		Version:      "2.3.0",
+		DefaultValue: "",
+		Doc:          "user of the shadow target to send the mirrored requests as, the credentials of the origin requests are never passed on",
+		Export:       true,
+	}
+	p.Mirror.Username.Init(base.mgr)
+
+	p.Mirror.Password = ParamItem{
+		Key:          "proxy.mirror.password",
+		Version:      "2.3.0",
+		DefaultValue: "",
+		Doc:          "password of the mirror user",
+		Export:       true,
+	}
+	p.Mirror.Password.Init(base.mgr)
+
+	p.Mirror.MaxConcurrency = ParamItem{
+		Key:          "proxy.mirror.maxConcurrency",
+		Version:      "2.3.0",
+		DefaultValue: "16",
+		Doc:          "max number of in-flight mirrored requests, requests beyond it are dropped",
+		Export:       true,
+	}
+	p.Mirror.MaxConcurrency.Init(base.mgr)
+
+	p.Mirror.Timeout = ParamItem{
+		Key:          "proxy.mirror.timeout",
+		Version:      "2.3.0",
+		DefaultValue: "10",
+		Doc:          "timeout of a mirrored request, in seconds",
+		Export:       true,
+	}
+	p.Mirror.Timeout.Init(base.mgr)
+
+	p.IndexEvaluation.SearchLogSampleRatio = ParamItem{
+		Key:          "proxy.indexEvaluation.searchLogSampleRatio",
+		Version:      "2.3.0",
+		DefaultValue: "0",
+		Doc:          "ratio of search requests captured to be replayed by the index evaluation, in range [0, 1]",
+		Export:       true,
+	}
+	p.IndexEvaluation.SearchLogSampleRatio.Init(base.mgr)
+
+	p.IndexEvaluation.SearchLogCapacity = ParamItem{
+		Key:          "proxy.indexEvaluation.searchLogCapacity",
+		Version:      "2.3.0",
+		DefaultValue: "1000",
+		Doc:          "max number of search requests captured per collection, the oldest ones are discarded",
+		Export:       true,
+	}
+	p.IndexEvaluation.SearchLogCapacity.Init(base.mgr)
+
+	p.IndexEvaluation.Timeout = ParamItem{
+		Key:          "proxy.indexEvaluation.timeout",
+		Version:      "2.3.0",
+		DefaultValue: "3600",
+		Doc:          "timeout of an index evaluation, in seconds",
+		Export:       true,
+	}
+	p.IndexEvaluation.Timeout.Init(base.mgr)
+
+	p.RecallSampling.SampleRatio = ParamItem{
+		Key:          "proxy.recallSampling.sampleRatio",
+		Version:      "2.3.0",
+		DefaultValue: "0",
+		Doc:          "ratio of search requests re-executed by the exact search to estimate the recall, in range [0, 1]",
+		Export:       true,
+	}
+	p.RecallSampling.SampleRatio.Init(base.mgr)
+
+	p.RecallSampling.MaxConcurrency = ParamItem{
+		Key:          "proxy.recallSampling.maxConcurrency",
+		Version:      "2.3.0",
+		DefaultValue: "2",
+		Doc:          "max number of in-flight exact searches, samples beyond it are dropped",
+		Export:       true,
+	}
+	p.RecallSampling.MaxConcurrency.Init(base.mgr)
+
+	p.RecallSampling.Timeout = ParamItem{
+		Key:          "proxy.recallSampling.timeout",
+		Version:      "2.3.0",
+		DefaultValue: "60",
+		Doc:          "timeout of an exact search, in seconds",
+		Export:       true,
+	}
+	p.RecallSampling.Timeout.Init(base.mgr)
+
+	p.Federation.Enable = ParamItem{
+		Key:          "proxy.federation.enable",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+		Doc:          "whether to route the requests of the databases/collections mapped in meta to other Milvus clusters",
+		Export:       true,
+	}
+	p.Federation.Enable.Init(base.mgr)
+
+	p.Federation.RefreshInterval = ParamItem{
+		Key:          "proxy.federation.refreshInterval",
+		Version:      "2.3.0",
+		DefaultValue: "10",
+		Doc:          "interval to reload the federation routes from meta and check the health of the target clusters, in seconds",
+		Export:       true,
+	}
+	p.Federation.RefreshInterval.Init(base.mgr)
+
+	p.Federation.HealthCheckTimeout = ParamItem{
+		Key:          "proxy.federation.healthCheckTimeout",
+		Version:      "2.3.0",
+		DefaultValue: "3",
+		Doc:          "timeout of checking the health of a target cluster, in seconds",
+		Export:       true,
+	}
+	p.Federation.HealthCheckTimeout.Init(base.mgr)
+
+	p.Federation.ForwardToken = ParamItem{
+		Key:          "proxy.federation.forwardToken",
+		Version:      "2.3.0",
+		DefaultValue: "",
+		Doc:          "token shared by the federated clusters, a request is served as forwarded by another cluster only if it carries the token",
+		Export:       true,
+	}
+	p.Federation.ForwardToken.Init(base.mgr)
+
+	p.InsertValidationProfile = ParamItem{
+		Key:          "proxy.insertValidation.profile",
+		Version:      "2.3.0",
+		DefaultValue: "strict",
+		Doc: "validation profile of the inserted data, strict rejects the request with any invalid row, " +
+			"lenient coerces the invalid values where possible, e.g. replaces NaN/Inf in vectors by 0 and truncates over-length varchars. " +
+			"It's overridden by the collection property collection.insert.validationProfile",
+		Export: true,
+	}
+	p.InsertValidationProfile.Init(base.mgr)
+
+	p.SidePayloadThreshold = ParamItem{
+		Key:          "proxy.sidePayload.threshold",
+		Version:      "2.3.0",
+		DefaultValue: "0",
+		Doc: "bytes, the values larger than it of the varchar/JSON fields with the side_payload=true type param are stored " +
+			"as separate objects in the object storage and referenced by the inserted rows, such fields can't be filtered or indexed. " +
+			"0 to disable, it must be positive when the proxy starts to enable it",
+		Export: true,
+	}
+	p.SidePayloadThreshold.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -1166,6 +1576,13 @@ type queryCoordConfig struct {
 	RetryInterval    ParamItem `refreshable:"true"`
 	TaskMergeCap     ParamItem `refreshable:"false"`
 	TaskExecutionCap ParamItem `refreshable:"true"`
+	LoadTaskCap      ParamItem `refreshable:"true"`
+	RecoveryTaskCap  ParamItem `refreshable:"true"`
+	BalanceTaskCap   ParamItem `refreshable:"true"`
+
+	LoadMemoryWatermark ParamItem `refreshable:"true"`
+	LoadDiskWatermark   ParamItem `refreshable:"true"`
+	LoadDelayTimeout    ParamItem `refreshable:"true"`
 
 	//---- Handoff ---
 	//Deprecated: Since 2.2.2
@@ -1177,6 +1594,12 @@ type queryCoordConfig struct {
 	GlobalRowCountFactor                ParamItem `refreshable:"true"`
 	ScoreUnbalanceTolerationFactor      ParamItem `refreshable:"true"`
 	ReverseUnbalanceTolerationFactor    ParamItem `refreshable:"true"`
+	RowCountScoreWeight                 ParamItem `refreshable:"true"`
+	ChannelScoreWeight                  ParamItem `refreshable:"true"`
+	MaxChannelNumPerNode                ParamItem `refreshable:"true"`
+	LoadAwareCPUWeight                  ParamItem `refreshable:"true"`
+	LoadAwareMemoryWeight               ParamItem `refreshable:"true"`
+	LoadAwareSearchRateWeight           ParamItem `refreshable:"true"`
 	OverloadedMemoryThresholdPercentage ParamItem `refreshable:"true"`
 	BalanceIntervalSeconds              ParamItem `refreshable:"true"`
 	MemoryUsageMaxDifferencePercentage  ParamItem `refreshable:"true"`
@@ -1185,11 +1608,13 @@ type queryCoordConfig struct {
 	ChannelCheckInterval       ParamItem `refreshable:"true"`
 	BalanceCheckInterval       ParamItem `refreshable:"true"`
 	IndexCheckInterval         ParamItem `refreshable:"true"`
+	IndexAlterCheckInterval    ParamItem `refreshable:"true"`
 	ChannelTaskTimeout         ParamItem `refreshable:"true"`
 	SegmentTaskTimeout         ParamItem `refreshable:"true"`
 	DistPullInterval           ParamItem `refreshable:"false"`
 	HeartbeatAvailableInterval ParamItem `refreshable:"true"`
 	LoadTimeoutSeconds         ParamItem `refreshable:"true"`
+	ExpectedLoadThroughputMB   ParamItem `refreshable:"true"`
 
 	// Deprecated: Since 2.2.2, QueryCoord do not use HandOff logic anymore
 	CheckHandoffInterval ParamItem `refreshable:"true"`
@@ -1200,12 +1625,34 @@ type queryCoordConfig struct {
 
 	NextTargetSurviveTime      ParamItem `refreshable:"true"`
 	UpdateNextTargetInterval   ParamItem `refreshable:"false"`
+	TargetRecoveryTimeout      ParamItem `refreshable:"true"`
+	TargetCacheTTL             ParamItem `refreshable:"true"`
 	CheckNodeInReplicaInterval ParamItem `refreshable:"false"`
 	CheckResourceGroupInterval ParamItem `refreshable:"false"`
 	EnableRGAutoRecover        ParamItem `refreshable:"true"`
+	OverflowResourceGroup      ParamItem `refreshable:"true"`
 	CheckHealthInterval        ParamItem `refreshable:"false"`
 	CheckHealthRPCTimeout      ParamItem `refreshable:"true"`
 	BrokerTimeout              ParamItem `refreshable:"false"`
+
+	//---- Replica auto-scaling ---
+	ReplicaAutoScaleEnabled     ParamItem `refreshable:"true"`
+	ReplicaAutoScaleInterval    ParamItem `refreshable:"false"`
+	ReplicaAutoScaleMinReplicas ParamItem `refreshable:"true"`
+	ReplicaAutoScaleMaxReplicas ParamItem `refreshable:"true"`
+	ReplicaAutoScaleUpNQRate    ParamItem `refreshable:"true"`
+	ReplicaAutoScaleDownNQRate  ParamItem `refreshable:"true"`
+	ReplicaAutoScaleUpLatency   ParamItem `refreshable:"true"`
+	ReplicaAutoScaleCooldown    ParamItem `refreshable:"true"`
+
+	//---- Leader divergence ---
+	LeaderDivergenceCheckInterval   ParamItem `refreshable:"false"`
+	LeaderDivergenceThreshold       ParamItem `refreshable:"true"`
+	LeaderDivergenceAutoRemediation ParamItem `refreshable:"true"`
+
+	//---- Target index check ---
+	TargetIndexCheckInterval ParamItem `refreshable:"true"`
+	TargetIndexWaitTimeout   ParamItem `refreshable:"true"`
 }
 
 func (p *queryCoordConfig) init(base *BaseTable) {
@@ -1240,6 +1687,60 @@ func (p *queryCoordConfig) init(base *BaseTable) {
 	}
 	p.TaskExecutionCap.Init(base.mgr)
 
+	p.LoadTaskCap = ParamItem{
+		Key:          "queryCoord.loadTaskCap",
+		Version:      "2.3.0",
+		DefaultValue: "1024",
+		Doc:          "the max number of started tasks loading the collections triggered by users",
+		Export:       true,
+	}
+	p.LoadTaskCap.Init(base.mgr)
+
+	p.RecoveryTaskCap = ParamItem{
+		Key:          "queryCoord.recoveryTaskCap",
+		Version:      "2.3.0",
+		DefaultValue: "512",
+		Doc:          "the max number of started tasks recovering the loaded collections",
+		Export:       true,
+	}
+	p.RecoveryTaskCap.Init(base.mgr)
+
+	p.BalanceTaskCap = ParamItem{
+		Key:          "queryCoord.balanceTaskCap",
+		Version:      "2.3.0",
+		DefaultValue: "64",
+		Doc:          "the max number of started tasks balancing the loaded collections",
+		Export:       true,
+	}
+	p.BalanceTaskCap.Init(base.mgr)
+
+	p.LoadMemoryWatermark = ParamItem{
+		Key:          "queryCoord.loadMemoryWatermark",
+		Version:      "2.3.0",
+		DefaultValue: "0.9",
+		Doc:          "the segments are not dispatched to the QueryNode whose memory usage ratio reaches the watermark",
+		Export:       true,
+	}
+	p.LoadMemoryWatermark.Init(base.mgr)
+
+	p.LoadDiskWatermark = ParamItem{
+		Key:          "queryCoord.loadDiskWatermark",
+		Version:      "2.3.0",
+		DefaultValue: "0.9",
+		Doc:          "the segments are not dispatched to the QueryNode whose local disk usage ratio reaches the watermark",
+		Export:       true,
+	}
+	p.LoadDiskWatermark.Init(base.mgr)
+
+	p.LoadDelayTimeout = ParamItem{
+		Key:          "queryCoord.loadDelayTimeout",
+		Version:      "2.3.0",
+		DefaultValue: "30000",
+		Doc:          "milliseconds a segment loading task waits for the QueryNode under the watermarks, before it's canceled to be rerouted to other nodes",
+		Export:       true,
+	}
+	p.LoadDelayTimeout.Init(base.mgr)
+
 	p.AutoHandoff = ParamItem{
 		Key:          "queryCoord.autoHandoff",
 		Version:      "2.0.0",
@@ -1300,6 +1801,63 @@ func (p *queryCoordConfig) init(base *BaseTable) {
 	}
 	p.ReverseUnbalanceTolerationFactor.Init(base.mgr)
 
+	p.RowCountScoreWeight = ParamItem{
+		Key:          "queryCoord.rowCountScoreWeight",
+		Version:      "2.3.0",
+		DefaultValue: "1",
+		Doc:          "the weight of the row count in the node score of scoreBasedBalancer",
+		Export:       true,
+	}
+	p.RowCountScoreWeight.Init(base.mgr)
+
+	p.ChannelScoreWeight = ParamItem{
+		Key:          "queryCoord.channelScoreWeight",
+		Version:      "2.3.0",
+		DefaultValue: "0",
+		Doc:          "the weight of the channels in the node score of scoreBasedBalancer, each channel is scored as the average row count per channel of the collection",
+		Export:       true,
+	}
+	p.ChannelScoreWeight.Init(base.mgr)
+
+	p.MaxChannelNumPerNode = ParamItem{
+		Key:          "queryCoord.maxChannelNumPerNode",
+		Version:      "2.3.0",
+		DefaultValue: "0",
+		Doc:          "the max number of channels on a queryNode assigned by scoreBasedBalancer, 0 means unlimited",
+		Export:       true,
+	}
+	p.MaxChannelNumPerNode.Init(base.mgr)
+
+	p.LoadAwareCPUWeight = ParamItem{
+		Key:          "queryCoord.loadAwareBalancer.cpuWeight",
+		Version:      "2.3.0",
+		DefaultValue: "1",
+		PanicIfEmpty: true,
+		Doc:          "the weight of the cpu usage of queryNodes in the score, only used by LoadAwareBalancer",
+		Export:       true,
+	}
+	p.LoadAwareCPUWeight.Init(base.mgr)
+
+	p.LoadAwareMemoryWeight = ParamItem{
+		Key:          "queryCoord.loadAwareBalancer.memoryWeight",
+		Version:      "2.3.0",
+		DefaultValue: "1",
+		PanicIfEmpty: true,
+		Doc:          "the weight of the memory usage of queryNodes in the score, only used by LoadAwareBalancer",
+		Export:       true,
+	}
+	p.LoadAwareMemoryWeight.Init(base.mgr)
+
+	p.LoadAwareSearchRateWeight = ParamItem{
+		Key:          "queryCoord.loadAwareBalancer.searchRateWeight",
+		Version:      "2.3.0",
+		DefaultValue: "1",
+		PanicIfEmpty: true,
+		Doc:          "the weight of the search rate of queryNodes relative to the average in the score, only used by LoadAwareBalancer",
+		Export:       true,
+	}
+	p.LoadAwareSearchRateWeight.Init(base.mgr)
+
 	p.OverloadedMemoryThresholdPercentage = ParamItem{
 		Key:          "queryCoord.overloadedMemoryThresholdPercentage",
 		Version:      "2.0.0",
@@ -1373,8 +1931,18 @@ func (p *queryCoordConfig) init(base *BaseTable) {
 	}
 	p.IndexCheckInterval.Init(base.mgr)
 
-	p.ChannelTaskTimeout = ParamItem{
-		Key:          "queryCoord.channelTaskTimeout",
+	p.IndexAlterCheckInterval = ParamItem{
+		Key:          "queryCoord.checkIndexAlterInterval",
+		Version:      "2.3.0",
+		DefaultValue: "60000",
+		PanicIfEmpty: true,
+		Doc:          "the interval in milliseconds to refresh whether the indexes of the loaded collections are being altered",
+		Export:       true,
+	}
+	p.IndexAlterCheckInterval.Init(base.mgr)
+
+	p.ChannelTaskTimeout = ParamItem{
+		Key:          "queryCoord.channelTaskTimeout",
 		Version:      "2.0.0",
 		DefaultValue: "60000",
 		PanicIfEmpty: true,
@@ -1411,6 +1979,15 @@ func (p *queryCoordConfig) init(base *BaseTable) {
 	}
 	p.LoadTimeoutSeconds.Init(base.mgr)
 
+	p.ExpectedLoadThroughputMB = ParamItem{
+		Key:          "queryCoord.expectedLoadThroughputMB",
+		Version:      "2.3.0",
+		DefaultValue: "10",
+		Doc:          "The load throughput (in MB/s) of a QueryNode expected before any segment of a loading collection is loaded, used to extend the load timeout of huge collections",
+		Export:       true,
+	}
+	p.ExpectedLoadThroughputMB.Init(base.mgr)
+
 	p.HeartbeatAvailableInterval = ParamItem{
 		Key:          "queryCoord.heartbeatAvailableInterval",
 		Version:      "2.2.1",
@@ -1454,6 +2031,24 @@ func (p *queryCoordConfig) init(base *BaseTable) {
 	}
 	p.UpdateNextTargetInterval.Init(base.mgr)
 
+	p.TargetRecoveryTimeout = ParamItem{
+		Key:          "queryCoord.targetRecoveryTimeout",
+		Version:      "2.3.0",
+		DefaultValue: "60",
+		Doc:          "seconds, querycoord reports the targets recovered phase after the timeout even if the current targets of some loaded collections are not recovered",
+		Export:       true,
+	}
+	p.TargetRecoveryTimeout.Init(base.mgr)
+
+	p.TargetCacheTTL = ParamItem{
+		Key:          "queryCoord.targetCacheTTL",
+		Version:      "2.3.0",
+		DefaultValue: "0",
+		Doc:          "seconds, within the ttl the next target is updated incrementally by the notified segment changes instead of pulling the full recovery info from datacoord, 0 means disabled",
+		Export:       true,
+	}
+	p.TargetCacheTTL.Init(base.mgr)
+
 	p.CheckNodeInReplicaInterval = ParamItem{
 		Key:          "queryCoord.checkNodeInReplicaInterval",
 		Version:      "2.2.3",
@@ -1462,6 +2057,134 @@ func (p *queryCoordConfig) init(base *BaseTable) {
 	}
 	p.CheckNodeInReplicaInterval.Init(base.mgr)
 
+	p.ReplicaAutoScaleEnabled = ParamItem{
+		Key:          "queryCoord.replicaAutoScale.enabled",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+		Doc:          "whether to add or remove the replicas of the loaded collections by the search load",
+		Export:       true,
+	}
+	p.ReplicaAutoScaleEnabled.Init(base.mgr)
+
+	p.ReplicaAutoScaleInterval = ParamItem{
+		Key:          "queryCoord.replicaAutoScale.checkInterval",
+		Version:      "2.3.0",
+		DefaultValue: "60",
+		PanicIfEmpty: true,
+		Doc:          "the interval in seconds to check the search load of the replicas",
+		Export:       true,
+	}
+	p.ReplicaAutoScaleInterval.Init(base.mgr)
+
+	p.ReplicaAutoScaleMinReplicas = ParamItem{
+		Key:          "queryCoord.replicaAutoScale.minReplicas",
+		Version:      "2.3.0",
+		DefaultValue: "1",
+		PanicIfEmpty: true,
+		Doc:          "the minimum replica number of a collection kept by the auto-scaling",
+		Export:       true,
+	}
+	p.ReplicaAutoScaleMinReplicas.Init(base.mgr)
+
+	p.ReplicaAutoScaleMaxReplicas = ParamItem{
+		Key:          "queryCoord.replicaAutoScale.maxReplicas",
+		Version:      "2.3.0",
+		DefaultValue: "3",
+		PanicIfEmpty: true,
+		Doc:          "the maximum replica number of a collection kept by the auto-scaling",
+		Export:       true,
+	}
+	p.ReplicaAutoScaleMaxReplicas.Init(base.mgr)
+
+	p.ReplicaAutoScaleUpNQRate = ParamItem{
+		Key:          "queryCoord.replicaAutoScale.scaleUpNQRate",
+		Version:      "2.3.0",
+		DefaultValue: "1000",
+		PanicIfEmpty: true,
+		Doc:          "add a replica if the average searched nq per second per node of the replicas exceeds it",
+		Export:       true,
+	}
+	p.ReplicaAutoScaleUpNQRate.Init(base.mgr)
+
+	p.ReplicaAutoScaleDownNQRate = ParamItem{
+		Key:          "queryCoord.replicaAutoScale.scaleDownNQRate",
+		Version:      "2.3.0",
+		DefaultValue: "100",
+		PanicIfEmpty: true,
+		Doc:          "remove a replica if the average searched nq per second per node of the replicas is below it, and the latency is fine",
+		Export:       true,
+	}
+	p.ReplicaAutoScaleDownNQRate.Init(base.mgr)
+
+	p.ReplicaAutoScaleUpLatency = ParamItem{
+		Key:          "queryCoord.replicaAutoScale.scaleUpLatency",
+		Version:      "2.3.0",
+		DefaultValue: "100",
+		PanicIfEmpty: true,
+		Doc:          "add a replica if the average time in milliseconds the search requests wait in queue exceeds it",
+		Export:       true,
+	}
+	p.ReplicaAutoScaleUpLatency.Init(base.mgr)
+
+	p.ReplicaAutoScaleCooldown = ParamItem{
+		Key:          "queryCoord.replicaAutoScale.cooldown",
+		Version:      "2.3.0",
+		DefaultValue: "300",
+		PanicIfEmpty: true,
+		Doc:          "the minimum interval in seconds between two replica changes of a collection",
+		Export:       true,
+	}
+	p.ReplicaAutoScaleCooldown.Init(base.mgr)
+
+	p.LeaderDivergenceCheckInterval = ParamItem{
+		Key:          "queryCoord.leaderDivergence.checkInterval",
+		Version:      "2.3.0",
+		DefaultValue: "10",
+		PanicIfEmpty: true,
+		Doc:          "the interval in seconds to compare the leader views with the distribution and current target",
+		Export:       true,
+	}
+	p.LeaderDivergenceCheckInterval.Init(base.mgr)
+
+	p.LeaderDivergenceThreshold = ParamItem{
+		Key:          "queryCoord.leaderDivergence.threshold",
+		Version:      "2.3.0",
+		DefaultValue: "60",
+		PanicIfEmpty: true,
+		Doc:          "a shard leader is reported as diverged after its view is out of sync longer than the threshold in seconds",
+		Export:       true,
+	}
+	p.LeaderDivergenceThreshold.Init(base.mgr)
+
+	p.LeaderDivergenceAutoRemediation = ParamItem{
+		Key:          "queryCoord.leaderDivergence.autoRemediation",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+		Doc:          "whether to sync the distribution to the diverged shard leaders at once",
+		Export:       true,
+	}
+	p.LeaderDivergenceAutoRemediation.Init(base.mgr)
+
+	p.TargetIndexCheckInterval = ParamItem{
+		Key:          "queryCoord.targetIndexCheck.checkInterval",
+		Version:      "2.3.0",
+		DefaultValue: "3000",
+		PanicIfEmpty: true,
+		Doc:          "the interval in milliseconds to check whether the indexes of the sealed segments in the next targets are ready",
+		Export:       true,
+	}
+	p.TargetIndexCheckInterval.Init(base.mgr)
+
+	p.TargetIndexWaitTimeout = ParamItem{
+		Key:          "queryCoord.targetIndexCheck.waitTimeout",
+		Version:      "2.3.0",
+		DefaultValue: "600",
+		PanicIfEmpty: true,
+		Doc:          "the max seconds to hold the next target for the indexes, the target is promoted anyway after the timeout",
+		Export:       true,
+	}
+	p.TargetIndexWaitTimeout.Init(base.mgr)
+
 	p.CheckResourceGroupInterval = ParamItem{
 		Key:          "queryCoord.checkResourceGroupInterval",
 		Version:      "2.2.3",
@@ -1478,6 +2201,16 @@ func (p *queryCoordConfig) init(base *BaseTable) {
 	}
 	p.EnableRGAutoRecover.Init(base.mgr)
 
+	p.OverflowResourceGroup = ParamItem{
+		Key:          "queryCoord.overflowResourceGroup",
+		Version:      "2.3.0",
+		DefaultValue: "",
+		Doc: `the resource group to borrow nodes from, if a resource group is still lack of nodes after recovered from the default one,
+the borrowed nodes are returned once the resource group gets enough nodes again, empty means never borrow`,
+		Export: true,
+	}
+	p.OverflowResourceGroup.Init(base.mgr)
+
 	p.CheckHealthInterval = ParamItem{
 		Key:          "queryCoord.checkHealthInterval",
 		Version:      "2.2.7",
@@ -1542,15 +2275,17 @@ type queryNodeConfig struct {
 	CacheMemoryLimit ParamItem `refreshable:"false"`
 	MmapDirPath      ParamItem `refreshable:"false"`
 
-	GroupEnabled         ParamItem `refreshable:"true"`
-	MaxReceiveChanSize   ParamItem `refreshable:"false"`
-	MaxUnsolvedQueueSize ParamItem `refreshable:"true"`
-	MaxReadConcurrency   ParamItem `refreshable:"true"`
-	MaxGroupNQ           ParamItem `refreshable:"true"`
-	TopKMergeRatio       ParamItem `refreshable:"true"`
-	CPURatio             ParamItem `refreshable:"true"`
-	MaxTimestampLag      ParamItem `refreshable:"true"`
-	GCEnabled            ParamItem `refreshable:"true"`
+	GroupEnabled          ParamItem `refreshable:"true"`
+	MaxReceiveChanSize    ParamItem `refreshable:"false"`
+	MaxUnsolvedQueueSize  ParamItem `refreshable:"true"`
+	MaxReadConcurrency    ParamItem `refreshable:"true"`
+	MaxGroupNQ            ParamItem `refreshable:"true"`
+	TopKMergeRatio        ParamItem `refreshable:"true"`
+	CPURatio              ParamItem `refreshable:"true"`
+	MaxTimestampLag       ParamItem `refreshable:"true"`
+	WaitDeleteApplied     ParamItem `refreshable:"true"`
+	ConsistencyWaitBudget ParamItem `refreshable:"true"`
+	GCEnabled             ParamItem `refreshable:"true"`
 
 	GCHelperEnabled     ParamItem `refreshable:"false"`
 	MinimumGOGCConfig   ParamItem `refreshable:"false"`
@@ -1571,6 +2306,29 @@ type queryNodeConfig struct {
 
 	// CGOPoolSize ratio to MaxReadConcurrency
 	CGOPoolSizeRatio ParamItem `refreshable:"false"`
+
+	// search parallelism governor
+	ParallelismGovernorEnabled       ParamItem `refreshable:"true"`
+	ParallelismGovernorInterval      ParamItem `refreshable:"false"`
+	MinSearchParallelism             ParamItem `refreshable:"true"`
+	MaxSearchParallelism             ParamItem `refreshable:"true"`
+	ParallelismCPUHighWatermark      ParamItem `refreshable:"true"`
+	ParallelismCPULowWatermark       ParamItem `refreshable:"true"`
+	ParallelismQueueLatencyThreshold ParamItem `refreshable:"true"`
+
+	// index load policy
+	IndexLoadPolicy           ParamItem `refreshable:"true"`
+	IndexPromotionConcurrency ParamItem `refreshable:"false"`
+
+	// segment group
+	SegmentGroupEnabled   ParamItem `refreshable:"true"`
+	SegmentGroupMaxRowNum ParamItem `refreshable:"true"`
+	SegmentGroupMaxSize   ParamItem `refreshable:"true"`
+
+	// search cost ceilings
+	SearchCostMaxSegments ParamItem `refreshable:"true"`
+	SearchCostMaxRows     ParamItem `refreshable:"true"`
+	SearchCostMaxMemoryMB ParamItem `refreshable:"true"`
 }
 
 func (p *queryNodeConfig) init(base *BaseTable) {
@@ -1845,6 +2603,25 @@ Max read concurrency must greater than or equal to 1, and less than or equal to
 	}
 	p.MaxTimestampLag.Init(base.mgr)
 
+	p.WaitDeleteApplied = ParamItem{
+		Key:          "queryNode.scheduler.waitDeleteApplied",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+		Doc:          "Search and query wait until the deletes before the guarantee ts are applied to all the segments, not only the tsafe",
+		Export:       true,
+	}
+	p.WaitDeleteApplied.Init(base.mgr)
+
+	p.ConsistencyWaitBudget = ParamItem{
+		Key:          "queryNode.scheduler.consistencyWaitBudget",
+		Version:      "2.3.0",
+		DefaultValue: "0",
+		Doc: "Max time (milliseconds) a search or query waits for the serviceable time to reach the guarantee ts, " +
+			"the read fails with a consistency error reporting the lag and the blocking channel if exceeded, 0 means no limit",
+		Export: true,
+	}
+	p.ConsistencyWaitBudget.Init(base.mgr)
+
 	p.GCEnabled = ParamItem{
 		Key:          "queryNode.gcenabled",
 		Version:      "2.3.0",
@@ -1933,6 +2710,161 @@ Max read concurrency must greater than or equal to 1, and less than or equal to
 		Doc:          "cgo pool size ratio to max read concurrency",
 	}
 	p.CGOPoolSizeRatio.Init(base.mgr)
+
+	p.ParallelismGovernorEnabled = ParamItem{
+		Key:          "queryNode.scheduler.parallelismGovernor.enabled",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+		Doc:          "Adjust the number of segments searched concurrently by a request according to cpu usage and queue latency",
+		Export:       true,
+	}
+	p.ParallelismGovernorEnabled.Init(base.mgr)
+
+	p.ParallelismGovernorInterval = ParamItem{
+		Key:          "queryNode.scheduler.parallelismGovernor.interval",
+		Version:      "2.3.0",
+		DefaultValue: "1000",
+		Formatter: func(v string) string {
+			// the ticker panics on a non-positive interval
+			if getAsInt(v) <= 0 {
+				return "1000"
+			}
+			return v
+		},
+		Doc:    "Interval for the governor to adjust search parallelism (milliseconds)",
+		Export: true,
+	}
+	p.ParallelismGovernorInterval.Init(base.mgr)
+
+	p.MinSearchParallelism = ParamItem{
+		Key:          "queryNode.scheduler.parallelismGovernor.minParallelism",
+		Version:      "2.3.0",
+		DefaultValue: "1",
+		Formatter: func(v string) string {
+			if getAsInt(v) < 1 {
+				return "1"
+			}
+			return v
+		},
+		Doc:    "Min number of segments searched concurrently by a request",
+		Export: true,
+	}
+	p.MinSearchParallelism.Init(base.mgr)
+
+	p.MaxSearchParallelism = ParamItem{
+		Key:          "queryNode.scheduler.parallelismGovernor.maxParallelism",
+		Version:      "2.3.0",
+		DefaultValue: "0",
+		Formatter: func(v string) string {
+			if getAsInt(v) <= 0 {
+				return strconv.Itoa(runtime.GOMAXPROCS(0))
+			}
+			return v
+		},
+		Doc:    "Max number of segments searched concurrently by a request, non-positive value means the number of cpus",
+		Export: true,
+	}
+	p.MaxSearchParallelism.Init(base.mgr)
+
+	p.ParallelismCPUHighWatermark = ParamItem{
+		Key:          "queryNode.scheduler.parallelismGovernor.cpuHighWatermark",
+		Version:      "2.3.0",
+		DefaultValue: "90",
+		Doc:          "Halve the search parallelism if cpu usage (percent) reaches the watermark",
+		Export:       true,
+	}
+	p.ParallelismCPUHighWatermark.Init(base.mgr)
+
+	p.ParallelismCPULowWatermark = ParamItem{
+		Key:          "queryNode.scheduler.parallelismGovernor.cpuLowWatermark",
+		Version:      "2.3.0",
+		DefaultValue: "70",
+		Doc:          "Increase the search parallelism if cpu usage (percent) is below the watermark and the queue is not congested",
+		Export:       true,
+	}
+	p.ParallelismCPULowWatermark.Init(base.mgr)
+
+	p.ParallelismQueueLatencyThreshold = ParamItem{
+		Key:          "queryNode.scheduler.parallelismGovernor.queueLatencyThreshold",
+		Version:      "2.3.0",
+		DefaultValue: "100",
+		Doc:          "Halve the search parallelism if the average latency of search tasks in queue reaches the threshold (milliseconds)",
+		Export:       true,
+	}
+	p.ParallelismQueueLatencyThreshold.Init(base.mgr)
+
+	p.IndexLoadPolicy = ParamItem{
+		Key:          "queryNode.indexLoad.policy",
+		Version:      "2.3.0",
+		DefaultValue: "eager",
+		Doc: "The load policy of the indexes without the load_policy index param, eager: load the index with the segment, " +
+			"lazy: load the raw data with the segment, and load the index in background once the field is searched or filtered",
+		Export: true,
+	}
+	p.IndexLoadPolicy.Init(base.mgr)
+
+	p.IndexPromotionConcurrency = ParamItem{
+		Key:          "queryNode.indexLoad.promotionConcurrency",
+		Version:      "2.3.0",
+		DefaultValue: "2",
+		Doc:          "The max number of lazy indexes loaded concurrently in background",
+		Export:       true,
+	}
+	p.IndexPromotionConcurrency.Init(base.mgr)
+
+	p.SegmentGroupEnabled = ParamItem{
+		Key:          "queryNode.segmentGroup.enabled",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+		Doc:          "Group the tiny sealed segments of the same partition to reduce the search fan-out, a group is searched as a single unit. The segments are still loaded separately",
+		Export:       true,
+	}
+	p.SegmentGroupEnabled.Init(base.mgr)
+
+	p.SegmentGroupMaxRowNum = ParamItem{
+		Key:          "queryNode.segmentGroup.maxRowNum",
+		Version:      "2.3.0",
+		DefaultValue: "10000",
+		Doc:          "The sealed segments with no more rows than this are grouped",
+		Export:       true,
+	}
+	p.SegmentGroupMaxRowNum.Init(base.mgr)
+
+	p.SegmentGroupMaxSize = ParamItem{
+		Key:          "queryNode.segmentGroup.maxSize",
+		Version:      "2.3.0",
+		DefaultValue: "16",
+		Doc:          "The max number of segments in a group",
+		Export:       true,
+	}
+	p.SegmentGroupMaxSize.Init(base.mgr)
+
+	p.SearchCostMaxSegments = ParamItem{
+		Key:          "queryNode.searchCost.maxSegments",
+		Version:      "2.3.0",
+		DefaultValue: "0",
+		Doc:          "The search is rejected by the delegator if it touches more segments of the shard, 0 means no limit",
+		Export:       true,
+	}
+	p.SearchCostMaxSegments.Init(base.mgr)
+
+	p.SearchCostMaxRows = ParamItem{
+		Key:          "queryNode.searchCost.maxRows",
+		Version:      "2.3.0",
+		DefaultValue: "0",
+		Doc:          "The search is rejected by the delegator if it scans more rows of the shard after the partition pruning, 0 means no limit",
+		Export:       true,
+	}
+	p.SearchCostMaxRows.Init(base.mgr)
+
+	p.SearchCostMaxMemoryMB = ParamItem{
+		Key:          "queryNode.searchCost.maxMemoryMB",
+		Version:      "2.3.0",
+		DefaultValue: "0",
+		Doc:          "The search is rejected by the delegator if the expected memory of the results to reduce exceeds it, 0 means no limit",
+		Export:       true,
+	}
+	p.SearchCostMaxMemoryMB.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -1942,6 +2874,19 @@ type dataCoordConfig struct {
 	WatchTimeoutInterval         ParamItem `refreshable:"false"`
 	ChannelBalanceSilentDuration ParamItem `refreshable:"true"`
 	ChannelBalanceInterval       ParamItem `refreshable:"true"`
+	ChannelBalanceSkewThreshold  ParamItem `refreshable:"true"`
+	ChannelBalanceMaxPerRound    ParamItem `refreshable:"true"`
+	ChannelWatchRetryBackoffBase ParamItem `refreshable:"true"`
+	ChannelWatchRetryBackoffMax  ParamItem `refreshable:"true"`
+	ChannelWatchRetryBudget      ParamItem `refreshable:"true"`
+	ChannelWatchHistorySize      ParamItem `refreshable:"true"`
+	ChannelZoneLabel             ParamItem `refreshable:"false"`
+	ChannelCapacityWeighted      ParamItem `refreshable:"false"`
+
+	// --- SESSION ---
+	SessionProbeInterval         ParamItem `refreshable:"false"`
+	SessionProbeTimeout          ParamItem `refreshable:"true"`
+	SessionProbeFailureThreshold ParamItem `refreshable:"true"`
 
 	// --- SEGMENTS ---
 	SegmentMaxSize                 ParamItem `refreshable:"false"`
@@ -1953,6 +2898,14 @@ type dataCoordConfig struct {
 	SegmentMaxIdleTime             ParamItem `refreshable:"false"`
 	SegmentMinSizeFromIdleToSealed ParamItem `refreshable:"false"`
 	SegmentMaxBinlogFileNumber     ParamItem `refreshable:"false"`
+	SegmentAllocPolicy             ParamItem `refreshable:"false"`
+	SegmentMaxAge                  ParamItem `refreshable:"false"`
+	SegmentSealInterval            ParamItem `refreshable:"true"`
+	SegmentMaxGrowingPerChannel    ParamItem `refreshable:"true"`
+
+	// --- FLUSH ---
+	FlushMaxConcurrentPerCollection ParamItem `refreshable:"true"`
+	FlushMaxQPSPerCollection        ParamItem `refreshable:"true"`
 
 	// compaction
 	EnableCompaction     ParamItem `refreshable:"false"`
@@ -1961,7 +2914,9 @@ type dataCoordConfig struct {
 
 	CompactionRPCTimeout              ParamItem `refreshable:"true"`
 	CompactionMaxParallelTasks        ParamItem `refreshable:"true"`
+	CompactionIOBandwidthBudget       ParamItem `refreshable:"true"`
 	CompactionWorkerParalleTasks      ParamItem `refreshable:"true"`
+	CompactionNotifyQueryCoord        ParamItem `refreshable:"true"`
 	MinSegmentToMerge                 ParamItem `refreshable:"true"`
 	MaxSegmentToMerge                 ParamItem `refreshable:"true"`
 	SegmentSmallProportion            ParamItem `refreshable:"true"`
@@ -1973,7 +2928,11 @@ type dataCoordConfig struct {
 	SingleCompactionDeltaLogMaxSize   ParamItem `refreshable:"true"`
 	SingleCompactionExpiredLogMaxSize ParamItem `refreshable:"true"`
 	SingleCompactionDeltalogMaxNum    ParamItem `refreshable:"true"`
+	SingleCompactionDeleteRatioWeight ParamItem `refreshable:"true"`
 	GlobalCompactionInterval          ParamItem `refreshable:"false"`
+	LevelZeroCompactionInterval       ParamItem `refreshable:"false"`
+	ExternalConversionInterval        ParamItem `refreshable:"false"`
+	RetentionCheckInterval            ParamItem `refreshable:"false"`
 
 	// Garbage Collection
 	EnableGarbageCollection ParamItem `refreshable:"false"`
@@ -1981,6 +2940,20 @@ type dataCoordConfig struct {
 	GCMissingTolerance      ParamItem `refreshable:"false"`
 	GCDropTolerance         ParamItem `refreshable:"false"`
 	EnableActiveStandby     ParamItem `refreshable:"false"`
+	EnableHotStandby        ParamItem `refreshable:"false"`
+	HotStandbySyncInterval  ParamItem `refreshable:"false"`
+
+	// Segment replication to a standby cluster
+	ReplicationEnable        ParamItem `refreshable:"false"`
+	ReplicationRemoteAddress ParamItem `refreshable:"false"`
+	ReplicationInterval      ParamItem `refreshable:"false"`
+
+	AuditMaxEntries ParamItem `refreshable:"true"`
+
+	// Reconciliation between the segment meta and the object storage
+	StorageReconcileEnable           ParamItem `refreshable:"false"`
+	StorageReconcileInterval         ParamItem `refreshable:"false"`
+	StorageReconcileMaxReportedFiles ParamItem `refreshable:"true"`
 
 	BindIndexNodeMode          ParamItem `refreshable:"false"`
 	IndexNodeAddress           ParamItem `refreshable:"false"`
@@ -2019,6 +2992,105 @@ func (p *dataCoordConfig) init(base *BaseTable) {
 	}
 	p.ChannelBalanceInterval.Init(base.mgr)
 
+	p.ChannelBalanceSkewThreshold = ParamItem{
+		Key:          "dataCoord.channel.balanceSkewThreshold",
+		Version:      "2.3.0",
+		DefaultValue: "2",
+		Doc:          "The max difference of channel counts between DataNodes tolerated by the background channel balancer",
+		Export:       true,
+	}
+	p.ChannelBalanceSkewThreshold.Init(base.mgr)
+
+	p.ChannelBalanceMaxPerRound = ParamItem{
+		Key:          "dataCoord.channel.balanceMaxChannelsPerRound",
+		Version:      "2.3.0",
+		DefaultValue: "1",
+		Doc:          "The max number of channels reassigned in one round of background channel balance, 0 means no limit",
+		Export:       true,
+	}
+	p.ChannelBalanceMaxPerRound.Init(base.mgr)
+
+	p.ChannelWatchRetryBackoffBase = ParamItem{
+		Key:          "dataCoord.channel.watchRetryBackoffBase",
+		Version:      "2.3.0",
+		DefaultValue: "1",
+		Doc:          "The delay in seconds before the second retry of a failed channel watch, doubled for each further failure",
+		Export:       true,
+	}
+	p.ChannelWatchRetryBackoffBase.Init(base.mgr)
+
+	p.ChannelWatchRetryBackoffMax = ParamItem{
+		Key:          "dataCoord.channel.watchRetryBackoffMax",
+		Version:      "2.3.0",
+		DefaultValue: "60",
+		Doc:          "The max delay in seconds before retrying a failed channel watch",
+		Export:       true,
+	}
+	p.ChannelWatchRetryBackoffMax.Init(base.mgr)
+
+	p.ChannelWatchRetryBudget = ParamItem{
+		Key:          "dataCoord.channel.watchRetryBudget",
+		Version:      "2.3.0",
+		DefaultValue: "10",
+		Doc:          "The max number of consecutive failed watches and reassignments of a channel before it's quarantined, 0 means no limit",
+		Export:       true,
+	}
+	p.ChannelWatchRetryBudget.Init(base.mgr)
+
+	p.ChannelWatchHistorySize = ParamItem{
+		Key:          "dataCoord.channel.watchHistorySize",
+		Version:      "2.3.0",
+		DefaultValue: "10",
+		Doc:          "The number of latest watch state transitions kept for each channel",
+		Export:       true,
+	}
+	p.ChannelWatchHistorySize.Init(base.mgr)
+
+	p.ChannelZoneLabel = ParamItem{
+		Key:          "dataCoord.channel.zoneLabel",
+		Version:      "2.3.0",
+		DefaultValue: "",
+		Doc:          "The DataNode label used as failure domain, channels of one collection are spread across domains if set",
+		Export:       true,
+	}
+	p.ChannelZoneLabel.Init(base.mgr)
+
+	p.ChannelCapacityWeighted = ParamItem{
+		Key:          "dataCoord.channel.capacityWeighted",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+		Doc:          "Assign channels by consistent hashing weighted by the cpu and memory capacity of DataNodes, ignored if zoneLabel is set",
+		Export:       true,
+	}
+	p.ChannelCapacityWeighted.Init(base.mgr)
+
+	p.SessionProbeInterval = ParamItem{
+		Key:          "dataCoord.session.probeInterval",
+		Version:      "2.3.0",
+		DefaultValue: "10",
+		Doc:          "The interval in seconds to probe the liveness of DataNodes by their gRPC sessions, 0 disables probing",
+		Export:       true,
+	}
+	p.SessionProbeInterval.Init(base.mgr)
+
+	p.SessionProbeTimeout = ParamItem{
+		Key:          "dataCoord.session.probeTimeout",
+		Version:      "2.3.0",
+		DefaultValue: "5",
+		Doc:          "The timeout in seconds of a liveness probe",
+		Export:       true,
+	}
+	p.SessionProbeTimeout.Init(base.mgr)
+
+	p.SessionProbeFailureThreshold = ParamItem{
+		Key:          "dataCoord.session.probeFailureThreshold",
+		Version:      "2.3.0",
+		DefaultValue: "3",
+		Doc:          "The DataNode failing this number of probes in a row is evicted, and its channels are reassigned",
+		Export:       true,
+	}
+	p.SessionProbeFailureThreshold.Init(base.mgr)
+
 	p.SegmentMaxSize = ParamItem{
 		Key:          "dataCoord.segment.maxSize",
 		Version:      "2.0.0",
@@ -2102,6 +3174,67 @@ the number of binlog file reaches to max value.`,
 	}
 	p.SegmentMaxBinlogFileNumber.Init(base.mgr)
 
+	p.SegmentAllocPolicy = ParamItem{
+		Key:          "dataCoord.segment.allocPolicy",
+		Version:      "2.3.0",
+		DefaultValue: "default",
+		Doc: `The policy to allocate and seal the segments, options: default, timeBased.
+The timeBased policy seals the growing segments older than maxAge additionally, which keeps
+the low-throughput collections from accumulating tiny growing segments.`,
+		Export: true,
+	}
+	p.SegmentAllocPolicy.Init(base.mgr)
+
+	p.SegmentMaxAge = ParamItem{
+		Key:          "dataCoord.segment.maxAge",
+		Version:      "2.3.0",
+		DefaultValue: "3600",
+		Doc:          "The max age in seconds of a growing segment since its first record, works with the timeBased allocPolicy only",
+		Export:       true,
+	}
+	p.SegmentMaxAge.Init(base.mgr)
+
+	p.SegmentSealInterval = ParamItem{
+		Key:          "dataCoord.segment.sealInterval",
+		Version:      "2.3.0",
+		DefaultValue: "0",
+		Doc: `The interval in seconds of the time boundaries to seal the growing segments on, like 3600 for hourly,
+so that each segment holds the records of one time range only, 0 to disable.
+It's overridden by the collection property collection.segment.sealInterval.seconds`,
+		Export: true,
+	}
+	p.SegmentSealInterval.Init(base.mgr)
+
+	p.SegmentMaxGrowingPerChannel = ParamItem{
+		Key:          "dataCoord.segment.maxGrowingPerChannel",
+		Version:      "2.3.0",
+		DefaultValue: "0",
+		Doc: `The max number of growing segments of a channel, 0 means no limit.
+The oldest growing segments are sealed before opening new ones once the limit is reached,
+to bound the memory of DataNode and the growing segments to scan of QueryNode.
+It's overridden by the collection property collection.segment.maxGrowingPerChannel`,
+		Export: true,
+	}
+	p.SegmentMaxGrowingPerChannel.Init(base.mgr)
+
+	p.FlushMaxConcurrentPerCollection = ParamItem{
+		Key:          "dataCoord.flush.maxConcurrentPerCollection",
+		Version:      "2.3.0",
+		DefaultValue: "0",
+		Doc:          "The max number of flush requests of a collection in flight, 0 means no limit",
+		Export:       true,
+	}
+	p.FlushMaxConcurrentPerCollection.Init(base.mgr)
+
+	p.FlushMaxQPSPerCollection = ParamItem{
+		Key:          "dataCoord.flush.maxQPSPerCollection",
+		Version:      "2.3.0",
+		DefaultValue: "0",
+		Doc:          "The max number of flush requests of a collection sent per second, 0 means no limit",
+		Export:       true,
+	}
+	p.FlushMaxQPSPerCollection.Init(base.mgr)
+
 	p.EnableCompaction = ParamItem{
 		Key:          "dataCoord.enableCompaction",
 		Version:      "2.0.0",
@@ -2139,10 +3272,21 @@ the number of binlog file reaches to max value.`,
 		Key:          "dataCoord.compaction.maxParallelTaskNum",
 		Version:      "2.2.12",
 		DefaultValue: "100",
+		Doc:          "max parallel compaction task number, the running tasks are limited by dataCoord.compaction.ioBandwidthBudget as well",
 		Export:       true,
 	}
 	p.CompactionMaxParallelTasks.Init(base.mgr)
 
+	p.CompactionIOBandwidthBudget = ParamItem{
+		Key:          "dataCoord.compaction.ioBandwidthBudget",
+		Version:      "2.3.0",
+		DefaultValue: "256",
+		Doc: "Cluster-wide disk/network bandwidth budget of compaction in MB/s, each compaction task reserves the bandwidth " +
+			"required to read and write its segments within the compaction timeout",
+		Export: true,
+	}
+	p.CompactionIOBandwidthBudget.Init(base.mgr)
+
 	p.CompactionWorkerParalleTasks = ParamItem{
 		Key:          "dataCoord.compaction.workerMaxParallelTaskNum",
 		Version:      "2.3.0",
@@ -2151,6 +3295,16 @@ the number of binlog file reaches to max value.`,
 	}
 	p.CompactionWorkerParalleTasks.Init(base.mgr)
 
+	p.CompactionNotifyQueryCoord = ParamItem{
+		Key:          "dataCoord.compaction.notifyQueryCoord",
+		Version:      "2.3.0",
+		DefaultValue: "true",
+		Doc: "Notify QueryCoord after a compaction is committed, so that QueryNodes load the compacted segment " +
+			"before the old segments are released",
+		Export: true,
+	}
+	p.CompactionNotifyQueryCoord.Init(base.mgr)
+
 	p.MinSegmentToMerge = ParamItem{
 		Key:          "dataCoord.compaction.min.segment",
 		Version:      "2.0.0",
@@ -2237,6 +3391,14 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 	}
 	p.SingleCompactionDeltalogMaxNum.Init(base.mgr)
 
+	p.SingleCompactionDeleteRatioWeight = ParamItem{
+		Key:          "dataCoord.compaction.single.deleteRatio.weight",
+		Version:      "2.3.0",
+		DefaultValue: "1",
+		Doc:          "weight of the deleted-row ratio when scoring compaction candidates, 0 means ordering them by size only",
+	}
+	p.SingleCompactionDeleteRatioWeight.Init(base.mgr)
+
 	p.GlobalCompactionInterval = ParamItem{
 		Key:          "dataCoord.compaction.global.interval",
 		Version:      "2.0.0",
@@ -2244,6 +3406,33 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 	}
 	p.GlobalCompactionInterval.Init(base.mgr)
 
+	p.LevelZeroCompactionInterval = ParamItem{
+		Key:          "dataCoord.compaction.levelZero.interval",
+		Version:      "2.3.0",
+		DefaultValue: "10",
+		Doc:          "Interval in seconds to merge the deltas of the L0 segments into the sealed segments",
+		Export:       true,
+	}
+	p.LevelZeroCompactionInterval.Init(base.mgr)
+
+	p.ExternalConversionInterval = ParamItem{
+		Key:          "dataCoord.compaction.external.interval",
+		Version:      "2.3.0",
+		DefaultValue: "10",
+		Doc:          "Interval in seconds to convert the segments registered on external files into binlogs",
+		Export:       true,
+	}
+	p.ExternalConversionInterval.Init(base.mgr)
+
+	p.RetentionCheckInterval = ParamItem{
+		Key:          "dataCoord.compaction.retention.checkInterval",
+		Version:      "2.3.0",
+		DefaultValue: "600",
+		Doc:          "Interval in seconds to drop the segments whose entities are all expired by the collection TTL",
+		Export:       true,
+	}
+	p.RetentionCheckInterval.Init(base.mgr)
+
 	p.EnableGarbageCollection = ParamItem{
 		Key:          "dataCoord.enableGarbageCollection",
 		Version:      "2.0.0",
@@ -2289,6 +3478,87 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 	}
 	p.EnableActiveStandby.Init(base.mgr)
 
+	p.EnableHotStandby = ParamItem{
+		Key:          "dataCoord.enableHotStandby",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+		Doc:          "Keep the meta and channel assignments of a standby DataCoord synced from etcd, so that it takes over in seconds, only works with enableActiveStandby",
+		Export:       true,
+	}
+	p.EnableHotStandby.Init(base.mgr)
+
+	p.HotStandbySyncInterval = ParamItem{
+		Key:          "dataCoord.hotStandbySyncInterval",
+		Version:      "2.3.0",
+		DefaultValue: "1000",
+		Doc:          "Interval in milliseconds for a hot standby DataCoord to apply the meta changes from etcd",
+		Export:       true,
+	}
+	p.HotStandbySyncInterval.Init(base.mgr)
+
+	p.ReplicationEnable = ParamItem{
+		Key:          "dataCoord.replication.enable",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+		Doc:          "Ship the flushed segments to the DataCoord of a standby cluster, which keeps a warm replica of the data",
+		Export:       true,
+	}
+	p.ReplicationEnable.Init(base.mgr)
+
+	p.ReplicationRemoteAddress = ParamItem{
+		Key:          "dataCoord.replication.remoteAddress",
+		Version:      "2.3.0",
+		DefaultValue: "",
+		Doc:          "The address of the DataCoord of the standby cluster, e.g. standby-datacoord:13333",
+		Export:       true,
+	}
+	p.ReplicationRemoteAddress.Init(base.mgr)
+
+	p.ReplicationInterval = ParamItem{
+		Key:          "dataCoord.replication.interval",
+		Version:      "2.3.0",
+		DefaultValue: "10",
+		Doc:          "Interval in seconds to ship the newly flushed segments to the standby cluster",
+		Export:       true,
+	}
+	p.ReplicationInterval.Init(base.mgr)
+
+	p.AuditMaxEntries = ParamItem{
+		Key:          "dataCoord.audit.maxEntries",
+		Version:      "2.3.0",
+		DefaultValue: "10000",
+		Doc:          "Maximum number of events kept in the audit log, the oldest events are removed beyond it, 0 means no limit",
+		Export:       true,
+	}
+	p.AuditMaxEntries.Init(base.mgr)
+
+	p.StorageReconcileEnable = ParamItem{
+		Key:          "dataCoord.storageReconcile.enable",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+		Doc:          "Compare the binlogs referenced by the segment meta with the objects in storage periodically, to catch the missing and orphaned files",
+		Export:       true,
+	}
+	p.StorageReconcileEnable.Init(base.mgr)
+
+	p.StorageReconcileInterval = ParamItem{
+		Key:          "dataCoord.storageReconcile.interval",
+		Version:      "2.3.0",
+		DefaultValue: "86400",
+		Doc:          "Interval in seconds to reconcile the segment meta with the object storage, which lists all the binlogs in storage",
+		Export:       true,
+	}
+	p.StorageReconcileInterval.Init(base.mgr)
+
+	p.StorageReconcileMaxReportedFiles = ParamItem{
+		Key:          "dataCoord.storageReconcile.maxReportedFiles",
+		Version:      "2.3.0",
+		DefaultValue: "1000",
+		Doc:          "Maximum number of the missing and the orphaned files each kept in the report, the numbers of files are still counted beyond it",
+		Export:       true,
+	}
+	p.StorageReconcileMaxReportedFiles.Init(base.mgr)
+
 	p.MinSegmentNumRowsToEnableIndex = ParamItem{
 		Key:          "indexCoord.segment.minSegmentNumRowsToEnableIndex",
 		Version:      "2.0.0",
@@ -2349,6 +3619,7 @@ type dataNodeConfig struct {
 	FlushDeleteBufferBytes ParamItem `refreshable:"true"`
 	BinLogMaxSize          ParamItem `refreshable:"true"`
 	SyncPeriod             ParamItem `refreshable:"true"`
+	LevelZeroSegmentEnable ParamItem `refreshable:"false"`
 	CpLagPeriod            ParamItem `refreshable:"true"`
 	CpLagSyncLimit         ParamItem `refreshable:"true"`
 
@@ -2366,6 +3637,7 @@ type dataNodeConfig struct {
 	DataNodeTimeTickByRPC ParamItem `refreshable:"false"`
 	// DataNode send timetick interval per collection
 	DataNodeTimeTickInterval ParamItem `refreshable:"false"`
+	TimeTickStallThreshold   ParamItem `refreshable:"true"`
 
 	// timeout for bulkinsert
 	BulkInsertTimeoutSeconds ParamItem `refreshable:"true"`
@@ -2474,6 +3746,15 @@ func (p *dataNodeConfig) init(base *BaseTable) {
 	}
 	p.SyncPeriod.Init(base.mgr)
 
+	p.LevelZeroSegmentEnable = ParamItem{
+		Key:          "dataNode.segment.levelZero.enable",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+		Doc:          "Write the deletes on the flushed segments into the L0 segments once, instead of into the deltalogs of each segment",
+		Export:       true,
+	}
+	p.LevelZeroSegmentEnable.Init(base.mgr)
+
 	p.CpLagPeriod = ParamItem{
 		Key:          "datanode.segment.cpLagPeriod",
 		Version:      "2.2.0",
@@ -2522,6 +3803,15 @@ func (p *dataNodeConfig) init(base *BaseTable) {
 	}
 	p.DataNodeTimeTickInterval.Init(base.mgr)
 
+	p.TimeTickStallThreshold = ParamItem{
+		Key:          "dataNode.timetick.stallThreshold",
+		Version:      "2.3.0",
+		DefaultValue: "60",
+		Doc:          "Seconds the timetick of a vchannel is allowed to stay still, before alerting the vchannel as stalled. Non-positive value disables the alert",
+		Export:       true,
+	}
+	p.TimeTickStallThreshold.Init(base.mgr)
+
 	p.SkipBFStatsLoad = ParamItem{
 		Key:          "dataNode.skip.BFStats.Load",
 		Version:      "2.2.5",
@@ -142,6 +142,15 @@ func TestComponentParam(t *testing.T) {
 		t.Logf("master MinSegmentSizeToEnableIndex = %d", Params.MinSegmentSizeToEnableIndex.GetAsInt64())
 		assert.NotEqual(t, Params.ImportTaskExpiration.GetAsFloat(), 0)
 		t.Logf("master ImportTaskRetention = %f", Params.ImportTaskRetention.GetAsFloat())
+
+		assert.Equal(t, 3, Params.ImportTaskMaxRetries.GetAsInt())
+		assert.Equal(t, "", Params.DDLHookWebhooks.GetValue())
+		assert.Equal(t, 3*time.Second, Params.DDLHookTimeout.GetAsDuration(time.Millisecond))
+		assert.Equal(t, time.Second, Params.DDLHookRetryInterval.GetAsDuration(time.Millisecond))
+		assert.Equal(t, 10, Params.DDLHookMaxRetries.GetAsInt())
+		assert.Equal(t, 10*time.Minute, Params.MetaCacheEventRetention.GetAsDuration(time.Second))
+		assert.Equal(t, 5*time.Minute, Params.StatsHistoryInterval.GetAsDuration(time.Second))
+		assert.Equal(t, 7*24*time.Hour, Params.StatsHistoryRetention.GetAsDuration(time.Second))
 		assert.Equal(t, Params.EnableActiveStandby.GetAsBool(), false)
 		t.Logf("rootCoord EnableActiveStandby = %t", Params.EnableActiveStandby.GetAsBool())
 
@@ -185,6 +194,31 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, Params.ReplicaSelectionPolicy.GetValue(), "look_aside")
 		assert.Equal(t, Params.CheckQueryNodeHealthInterval.GetAsInt(), 1000)
 		assert.Equal(t, Params.CostMetricsExpireTime.GetAsInt(), 1000)
+		assert.Equal(t, 0.3, Params.HealthScoredEWMAAlpha.GetAsFloat())
+		assert.Equal(t, 0.5, Params.HealthScoredErrorThreshold.GetAsFloat())
+		assert.Equal(t, 10*time.Second, Params.HealthScoredExcludeDuration.GetAsDuration(time.Millisecond))
+		assert.True(t, Params.WatchMetaCacheEvents.GetAsBool())
+		assert.Equal(t, 1000, Params.SegmentInfoPageSize.GetAsInt())
+		assert.Equal(t, "TargetsRecovered", Params.QueryCoordReadyPhase.GetValue())
+		assert.False(t, Params.EnableGrpcReflection.GetAsBool())
+		assert.Equal(t, 16, Params.MethodQueueLength.GetAsInt())
+		assert.Equal(t, int64(512*1024), Params.SearchLazyFetchThreshold.GetAsInt64())
+		assert.Empty(t, Params.MethodConcurrencyLimits.GetAsJSONMap())
+		params.Save(Params.MethodConcurrencyLimits.Key, `{"Flush": 4}`)
+		assert.Equal(t, map[string]string{"Flush": "4"}, Params.MethodConcurrencyLimits.GetAsJSONMap())
+		params.Reset(Params.MethodConcurrencyLimits.Key)
+		assert.Equal(t, 0.0, Params.IndexEvaluation.SearchLogSampleRatio.GetAsFloat())
+		assert.Equal(t, 1000, Params.IndexEvaluation.SearchLogCapacity.GetAsInt())
+		assert.Equal(t, 3600, Params.IndexEvaluation.Timeout.GetAsInt())
+		assert.Equal(t, 0.0, Params.RecallSampling.SampleRatio.GetAsFloat())
+		assert.Equal(t, 2, Params.RecallSampling.MaxConcurrency.GetAsInt())
+		assert.Equal(t, 60, Params.RecallSampling.Timeout.GetAsInt())
+		assert.False(t, Params.Federation.Enable.GetAsBool())
+		assert.Equal(t, 10, Params.Federation.RefreshInterval.GetAsInt())
+		assert.Equal(t, 3, Params.Federation.HealthCheckTimeout.GetAsInt())
+		assert.Equal(t, "", Params.Federation.ForwardToken.GetValue())
+		assert.Equal(t, "strict", Params.InsertValidationProfile.GetValue())
+		assert.Equal(t, 0, Params.SidePayloadThreshold.GetAsInt())
 	})
 
 	// t.Run("test proxyConfig panic", func(t *testing.T) {
@@ -264,6 +298,15 @@ func TestComponentParam(t *testing.T) {
 		UpdateNextTargetInterval := Params.UpdateNextTargetInterval
 		assert.Equal(t, int64(100), UpdateNextTargetInterval.GetAsInt64())
 
+		assert.Equal(t, 60, Params.TargetRecoveryTimeout.GetAsInt())
+		assert.Equal(t, 0, Params.TargetCacheTTL.GetAsInt())
+		assert.Equal(t, 1024, Params.LoadTaskCap.GetAsInt())
+		assert.Equal(t, 512, Params.RecoveryTaskCap.GetAsInt())
+		assert.Equal(t, 64, Params.BalanceTaskCap.GetAsInt())
+		assert.Equal(t, 0.9, Params.LoadMemoryWatermark.GetAsFloat())
+		assert.Equal(t, 0.9, Params.LoadDiskWatermark.GetAsFloat())
+		assert.Equal(t, 30*time.Second, Params.LoadDelayTimeout.GetAsDuration(time.Millisecond))
+
 		params.Save("queryCoord.checkNodeInReplicaInterval", "100")
 		checkNodeInReplicaInterval := Params.CheckNodeInReplicaInterval
 		assert.Equal(t, 100, checkNodeInReplicaInterval.GetAsInt())
@@ -292,13 +335,38 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, 0.4, Params.ScoreUnbalanceTolerationFactor.GetAsFloat())
 
 		assert.Equal(t, 1.3, Params.ReverseUnbalanceTolerationFactor.GetAsFloat())
+		assert.Equal(t, 1.0, Params.RowCountScoreWeight.GetAsFloat())
+		assert.Equal(t, 0.0, Params.ChannelScoreWeight.GetAsFloat())
+		assert.Equal(t, 0, Params.MaxChannelNumPerNode.GetAsInt())
 		params.Save("queryCoord.reverseUnBalanceTolerationFactor", "1.5")
 		assert.Equal(t, 1.5, Params.ReverseUnbalanceTolerationFactor.GetAsFloat())
 
+		assert.Equal(t, 1.0, Params.LoadAwareCPUWeight.GetAsFloat())
+		assert.Equal(t, 1.0, Params.LoadAwareMemoryWeight.GetAsFloat())
+		assert.Equal(t, 1.0, Params.LoadAwareSearchRateWeight.GetAsFloat())
+
+		assert.Equal(t, "", Params.OverflowResourceGroup.GetValue())
+
+		assert.False(t, Params.ReplicaAutoScaleEnabled.GetAsBool())
+		assert.Equal(t, 60, Params.ReplicaAutoScaleInterval.GetAsInt())
+		assert.Equal(t, 1, Params.ReplicaAutoScaleMinReplicas.GetAsInt())
+		assert.Equal(t, 3, Params.ReplicaAutoScaleMaxReplicas.GetAsInt())
+		assert.Equal(t, 1000.0, Params.ReplicaAutoScaleUpNQRate.GetAsFloat())
+		assert.Equal(t, 100.0, Params.ReplicaAutoScaleDownNQRate.GetAsFloat())
+		assert.Equal(t, 100.0, Params.ReplicaAutoScaleUpLatency.GetAsFloat())
+		assert.Equal(t, 300, Params.ReplicaAutoScaleCooldown.GetAsInt())
+		assert.Equal(t, 10, Params.LeaderDivergenceCheckInterval.GetAsInt())
+		assert.Equal(t, 60, Params.LeaderDivergenceThreshold.GetAsInt())
+		assert.False(t, Params.LeaderDivergenceAutoRemediation.GetAsBool())
+		assert.Equal(t, 3000, Params.TargetIndexCheckInterval.GetAsInt())
+		assert.Equal(t, 600, Params.TargetIndexWaitTimeout.GetAsInt())
+
 		assert.Equal(t, 1000, Params.SegmentCheckInterval.GetAsInt())
 		assert.Equal(t, 1000, Params.ChannelCheckInterval.GetAsInt())
 		assert.Equal(t, 10000, Params.BalanceCheckInterval.GetAsInt())
 		assert.Equal(t, 10000, Params.IndexCheckInterval.GetAsInt())
+		assert.Equal(t, 60000, Params.IndexAlterCheckInterval.GetAsInt())
+		assert.Equal(t, 10.0, Params.ExpectedLoadThroughputMB.GetAsFloat())
 	})
 
 	t.Run("test queryNodeConfig", func(t *testing.T) {
@@ -329,6 +397,34 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, 10.0, Params.CPURatio.GetAsFloat())
 		assert.Equal(t, uint32(runtime.GOMAXPROCS(0)*4), Params.KnowhereThreadPoolSize.GetAsUint32())
 
+		assert.False(t, Params.WaitDeleteApplied.GetAsBool())
+		assert.Equal(t, time.Duration(0), Params.ConsistencyWaitBudget.GetAsDuration(time.Millisecond))
+
+		assert.False(t, Params.ParallelismGovernorEnabled.GetAsBool())
+		assert.Equal(t, time.Second, Params.ParallelismGovernorInterval.GetAsDuration(time.Millisecond))
+		params.Save("queryNode.scheduler.parallelismGovernor.interval", "0")
+		assert.Equal(t, time.Second, Params.ParallelismGovernorInterval.GetAsDuration(time.Millisecond))
+		assert.Equal(t, 1, Params.MinSearchParallelism.GetAsInt())
+		assert.Equal(t, runtime.GOMAXPROCS(0), Params.MaxSearchParallelism.GetAsInt())
+		params.Save("queryNode.scheduler.parallelismGovernor.minParallelism", "0")
+		assert.Equal(t, 1, Params.MinSearchParallelism.GetAsInt())
+		params.Save("queryNode.scheduler.parallelismGovernor.maxParallelism", "4")
+		assert.Equal(t, 4, Params.MaxSearchParallelism.GetAsInt())
+		assert.Equal(t, 90.0, Params.ParallelismCPUHighWatermark.GetAsFloat())
+		assert.Equal(t, 70.0, Params.ParallelismCPULowWatermark.GetAsFloat())
+		assert.Equal(t, 100*time.Millisecond, Params.ParallelismQueueLatencyThreshold.GetAsDuration(time.Millisecond))
+
+		assert.Equal(t, "eager", Params.IndexLoadPolicy.GetValue())
+		assert.Equal(t, 2, Params.IndexPromotionConcurrency.GetAsInt())
+
+		assert.False(t, Params.SegmentGroupEnabled.GetAsBool())
+		assert.Equal(t, int64(10000), Params.SegmentGroupMaxRowNum.GetAsInt64())
+		assert.Equal(t, 16, Params.SegmentGroupMaxSize.GetAsInt())
+
+		assert.Equal(t, int64(0), Params.SearchCostMaxSegments.GetAsInt64())
+		assert.Equal(t, int64(0), Params.SearchCostMaxRows.GetAsInt64())
+		assert.Equal(t, int64(0), Params.SearchCostMaxMemoryMB.GetAsInt64())
+
 		// test small indexNlist/NProbe default
 		params.Remove("queryNode.segcore.smallIndex.nlist")
 		params.Remove("queryNode.segcore.smallIndex.nprobe")
@@ -366,6 +462,38 @@ func TestComponentParam(t *testing.T) {
 		assert.True(t, Params.EnableGarbageCollection.GetAsBool())
 		assert.Equal(t, Params.EnableActiveStandby.GetAsBool(), false)
 		t.Logf("dataCoord EnableActiveStandby = %t", Params.EnableActiveStandby.GetAsBool())
+		assert.False(t, Params.EnableHotStandby.GetAsBool())
+		assert.Equal(t, time.Second, Params.HotStandbySyncInterval.GetAsDuration(time.Millisecond))
+		assert.False(t, Params.ReplicationEnable.GetAsBool())
+		assert.Equal(t, "", Params.ReplicationRemoteAddress.GetValue())
+		assert.Equal(t, 10*time.Second, Params.ReplicationInterval.GetAsDuration(time.Second))
+		assert.Equal(t, 10000, Params.AuditMaxEntries.GetAsInt())
+		assert.False(t, Params.StorageReconcileEnable.GetAsBool())
+		assert.Equal(t, 24*time.Hour, Params.StorageReconcileInterval.GetAsDuration(time.Second))
+		assert.Equal(t, 1000, Params.StorageReconcileMaxReportedFiles.GetAsInt())
+		assert.Equal(t, "", Params.ChannelZoneLabel.GetValue())
+		assert.False(t, Params.ChannelCapacityWeighted.GetAsBool())
+		assert.Equal(t, 10, Params.SessionProbeInterval.GetAsInt())
+		assert.Equal(t, 5, Params.SessionProbeTimeout.GetAsInt())
+		assert.Equal(t, 3, Params.SessionProbeFailureThreshold.GetAsInt())
+		assert.Equal(t, 2, Params.ChannelBalanceSkewThreshold.GetAsInt())
+		assert.Equal(t, 1, Params.ChannelBalanceMaxPerRound.GetAsInt())
+		assert.Equal(t, time.Second, Params.ChannelWatchRetryBackoffBase.GetAsDuration(time.Second))
+		assert.Equal(t, time.Minute, Params.ChannelWatchRetryBackoffMax.GetAsDuration(time.Second))
+		assert.Equal(t, 10, Params.ChannelWatchRetryBudget.GetAsInt())
+		assert.Equal(t, 0, Params.FlushMaxConcurrentPerCollection.GetAsInt())
+		assert.Equal(t, 0.0, Params.FlushMaxQPSPerCollection.GetAsFloat())
+		assert.Equal(t, 1.0, Params.SingleCompactionDeleteRatioWeight.GetAsFloat())
+		assert.Equal(t, 10*time.Second, Params.LevelZeroCompactionInterval.GetAsDuration(time.Second))
+		assert.Equal(t, 10*time.Second, Params.ExternalConversionInterval.GetAsDuration(time.Second))
+		assert.Equal(t, 10*time.Minute, Params.RetentionCheckInterval.GetAsDuration(time.Second))
+		assert.Equal(t, 100, Params.CompactionMaxParallelTasks.GetAsInt())
+		assert.Equal(t, 256.0, Params.CompactionIOBandwidthBudget.GetAsFloat())
+		assert.True(t, Params.CompactionNotifyQueryCoord.GetAsBool())
+		assert.Equal(t, "default", Params.SegmentAllocPolicy.GetValue())
+		assert.Equal(t, time.Hour, Params.SegmentMaxAge.GetAsDuration(time.Second))
+		assert.Equal(t, time.Duration(0), Params.SegmentSealInterval.GetAsDuration(time.Second))
+		assert.Equal(t, 0, Params.SegmentMaxGrowingPerChannel.GetAsInt())
 	})
 
 	t.Run("test dataNodeConfig", func(t *testing.T) {
@@ -391,10 +519,13 @@ func TestComponentParam(t *testing.T) {
 		period := Params.SyncPeriod
 		t.Logf("SyncPeriod: %v", period)
 		assert.Equal(t, 10*time.Minute, Params.SyncPeriod.GetAsDuration(time.Second))
+		assert.False(t, Params.LevelZeroSegmentEnable.GetAsBool())
 
 		bulkinsertTimeout := Params.BulkInsertTimeoutSeconds
 		t.Logf("BulkInsertTimeoutSeconds: %v", bulkinsertTimeout)
 		assert.Equal(t, "18000", Params.BulkInsertTimeoutSeconds.GetValue())
+
+		assert.Equal(t, time.Minute, Params.TimeTickStallThreshold.GetAsDuration(time.Second))
 	})
 
 	t.Run("test indexNodeConfig", func(t *testing.T) {
@@ -0,0 +1,68 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paramtable
+
+import "strconv"
+
+// FeatureFlagConfig is the params of a feature flag, which could be changed at runtime through the dynamic config.
+// A flag gated in for a part of the collections is enabled for the listed collections and a percentage of the others.
+type FeatureFlagConfig struct {
+	Name string
+
+	Enabled           ParamItem `refreshable:"true"`
+	Collections       ParamItem `refreshable:"true"`
+	RolloutPercentage ParamItem `refreshable:"true"`
+}
+
+// Init initializes the params of the flag with the keys prefixed by featureFlags.<name>.
+func (p *FeatureFlagConfig) Init(name string, enabledByDefault bool, base *BaseTable) {
+	p.Name = name
+	prefix := "featureFlags." + name + "."
+
+	p.Enabled = ParamItem{
+		Key:          prefix + "enabled",
+		Version:      "2.3.0",
+		DefaultValue: strconv.FormatBool(enabledByDefault),
+		Doc:          "Master switch of the feature flag",
+	}
+	p.Enabled.Init(base.mgr)
+
+	p.Collections = ParamItem{
+		Key:     prefix + "collections",
+		Version: "2.3.0",
+		Doc:     "Comma separated IDs of the collections the feature is always enabled for",
+	}
+	p.Collections.Init(base.mgr)
+
+	p.RolloutPercentage = ParamItem{
+		Key:     prefix + "rolloutPercentage",
+		Version: "2.3.0",
+		Formatter: func(v string) string {
+			// enabled for all the collections by default, unless some collections are picked
+			if v == "" {
+				if p.Collections.GetValue() == "" {
+					return "100"
+				}
+				return "0"
+			}
+			return v
+		},
+		Doc: `Percentage of the collections the feature is enabled for, in range [0, 100].
+100 by default if no collections are specified, otherwise 0`,
+	}
+	p.RolloutPercentage.Init(base.mgr)
+}
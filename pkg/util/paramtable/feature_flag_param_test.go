@@ -0,0 +1,48 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paramtable
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFeatureFlagConfig(t *testing.T) {
+	params := ComponentParam{}
+	params.Init()
+
+	flag := &FeatureFlagConfig{}
+	flag.Init("newBalancer", false, &params.BaseTable)
+	assert.Equal(t, "newBalancer", flag.Name)
+	assert.False(t, flag.Enabled.GetAsBool())
+	assert.Empty(t, flag.Collections.GetValue())
+	assert.Equal(t, 100, flag.RolloutPercentage.GetAsInt())
+
+	params.Save("featureFlags.newBalancer.enabled", "true")
+	params.Save("featureFlags.newBalancer.collections", "1,2")
+	assert.True(t, flag.Enabled.GetAsBool())
+	assert.Equal(t, []string{"1", "2"}, flag.Collections.GetAsStrings())
+	assert.Equal(t, 0, flag.RolloutPercentage.GetAsInt())
+
+	params.Save("featureFlags.newBalancer.rolloutPercentage", "10")
+	assert.Equal(t, 10, flag.RolloutPercentage.GetAsInt())
+
+	flag = &FeatureFlagConfig{}
+	flag.Init("newBinlogFormat", true, &params.BaseTable)
+	assert.True(t, flag.Enabled.GetAsBool())
+}
@@ -187,6 +187,11 @@ type GrpcClientConfig struct {
 
 	CompressionEnabled ParamItem `refreshable:"false"`
 
+	SelectiveCompressionEnabled       ParamItem `refreshable:"false"`
+	SelectiveCompressionAlgorithm     ParamItem `refreshable:"false"`
+	SelectiveCompressionSizeThreshold ParamItem `refreshable:"false"`
+	SelectiveCompressionMethods       ParamItem `refreshable:"false"`
+
 	ClientMaxSendSize ParamItem `refreshable:"false"`
 	ClientMaxRecvSize ParamItem `refreshable:"false"`
 
@@ -420,4 +425,47 @@ func (p *GrpcClientConfig) Init(domain string, base *BaseTable) {
 		Export: true,
 	}
 	p.CompressionEnabled.Init(base.mgr)
+
+	p.SelectiveCompressionEnabled = ParamItem{
+		Key:          "grpc.client.selectiveCompression.enabled",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+		Doc: `whether to compress the heavyweight internal rpcs only, i.e. the listed methods and the requests larger than the size threshold,
+it takes no effect if grpc.client.compressionEnabled is true, which compresses all the rpcs`,
+		Export: true,
+	}
+	p.SelectiveCompressionEnabled.Init(base.mgr)
+
+	p.SelectiveCompressionAlgorithm = ParamItem{
+		Key:          "grpc.client.selectiveCompression.algorithm",
+		Version:      "2.3.0",
+		DefaultValue: "zstd",
+		Formatter: func(v string) string {
+			if v != "zstd" && v != "gzip" {
+				return "zstd"
+			}
+			return v
+		},
+		Doc:    "the compression algorithm of the selective compression, zstd or gzip",
+		Export: true,
+	}
+	p.SelectiveCompressionAlgorithm.Init(base.mgr)
+
+	p.SelectiveCompressionSizeThreshold = ParamItem{
+		Key:          "grpc.client.selectiveCompression.sizeThreshold",
+		Version:      "2.3.0",
+		DefaultValue: "1048576",
+		Doc:          "the requests of any method larger than the threshold in bytes are compressed, 0 means no size based compression",
+		Export:       true,
+	}
+	p.SelectiveCompressionSizeThreshold.Init(base.mgr)
+
+	p.SelectiveCompressionMethods = ParamItem{
+		Key:          "grpc.client.selectiveCompression.methods",
+		Version:      "2.3.0",
+		DefaultValue: "SyncDistribution,GetRecoveryInfoV2,GetDataDistribution,LoadSegments,WatchDmChannels",
+		Doc:          "the comma separated methods whose requests and responses are always compressed",
+		Export:       true,
+	}
+	p.SelectiveCompressionMethods.Init(base.mgr)
 }
@@ -150,6 +150,15 @@ func TestGrpcClientParams(t *testing.T) {
 	base.Save("grpc.client.CompressionEnabled", "true")
 	assert.Equal(t, clientConfig.CompressionEnabled.GetAsBool(), true)
 
+	assert.False(t, clientConfig.SelectiveCompressionEnabled.GetAsBool())
+	assert.Equal(t, "zstd", clientConfig.SelectiveCompressionAlgorithm.GetValue())
+	base.Save("grpc.client.selectiveCompression.algorithm", "lz4")
+	assert.Equal(t, "zstd", clientConfig.SelectiveCompressionAlgorithm.GetValue())
+	base.Save("grpc.client.selectiveCompression.algorithm", "gzip")
+	assert.Equal(t, "gzip", clientConfig.SelectiveCompressionAlgorithm.GetValue())
+	assert.Equal(t, 1048576, clientConfig.SelectiveCompressionSizeThreshold.GetAsInt())
+	assert.Contains(t, clientConfig.SelectiveCompressionMethods.GetAsStrings(), "SyncDistribution")
+
 	base.Save("common.security.tlsMode", "1")
 	base.Save("tls.serverPemPath", "/pem")
 	base.Save("tls.serverKeyPath", "/key")
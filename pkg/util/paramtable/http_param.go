@@ -3,6 +3,8 @@ package paramtable
 type httpConfig struct {
 	Enabled   ParamItem `refreshable:"false"`
 	DebugMode ParamItem `refreshable:"false"`
+
+	EnableConsole ParamItem `refreshable:"false"`
 }
 
 func (p *httpConfig) init(base *BaseTable) {
@@ -23,4 +25,13 @@ func (p *httpConfig) init(base *BaseTable) {
 		Export:       true,
 	}
 	p.DebugMode.Init(base.mgr)
+
+	p.EnableConsole = ParamItem{
+		Key:          "proxy.http.enableConsole",
+		DefaultValue: "true",
+		Version:      "2.3.0",
+		Doc:          "Whether to serve the embedded web console at /console, which shows the collections, channel lag and slow queries",
+		Export:       true,
+	}
+	p.EnableConsole.Init(base.mgr)
 }
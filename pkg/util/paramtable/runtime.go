@@ -14,6 +14,8 @@ package paramtable
 import (
 	"strconv"
 	"time"
+
+	"github.com/milvus-io/milvus/pkg/config"
 )
 
 const (
@@ -35,6 +37,8 @@ func Get() *ComponentParam {
 
 func SetNodeID(newID UniqueID) {
 	params.Save(runtimeNodeIDKey, strconv.FormatInt(newID, 10))
+	// load the configs scoped to this node from now on
+	params.UpdateSourceOptions(config.WithNodeID(newID))
 }
 
 func GetNodeID() UniqueID {
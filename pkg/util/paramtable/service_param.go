@@ -23,6 +23,7 @@ import (
 	"path"
 	"strconv"
 	"strings"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -106,6 +107,18 @@ type EtcdConfig struct {
 	EtcdTLSCACert     ParamItem          `refreshable:"false"`
 	EtcdTLSMinVersion ParamItem          `refreshable:"false"`
 
+	// --- Degradation ---
+	EnableReadOnlyOnDegradation  ParamItem `refreshable:"false"`
+	DegradationCheckInterval     ParamItem `refreshable:"false"`
+	DegradationLatencyThreshold  ParamItem `refreshable:"true"`
+	DegradationFailureThreshold  ParamItem `refreshable:"true"`
+	DegradationRecoveryThreshold ParamItem `refreshable:"true"`
+
+	// --- Watch Client ---
+	WatchClientEnabled   ParamItem `refreshable:"false"`
+	WatchClientRateLimit ParamItem `refreshable:"false"`
+	WatchClientBurst     ParamItem `refreshable:"false"`
+
 	// --- Embed ETCD ---
 	UseEmbedEtcd ParamItem `refreshable:"false"`
 	ConfigPath   ParamItem `refreshable:"false"`
@@ -261,6 +274,80 @@ We recommend using version 1.2 and above.`,
 		Export: true,
 	}
 	p.EtcdTLSMinVersion.Init(base.mgr)
+
+	p.EnableReadOnlyOnDegradation = ParamItem{
+		Key:          "etcd.degradation.enableReadOnly",
+		DefaultValue: "true",
+		Version:      "2.3.0",
+		Doc: `Switch coordinators into read only mode when etcd is degraded, e.g. loses its quorum or responds slowly,
+the operations changing the meta are rejected until etcd heals`,
+		Export: true,
+	}
+	p.EnableReadOnlyOnDegradation.Init(base.mgr)
+
+	p.DegradationCheckInterval = ParamItem{
+		Key:          "etcd.degradation.checkInterval",
+		DefaultValue: "1000",
+		Version:      "2.3.0",
+		Doc:          "Interval in milliseconds to probe etcd",
+		Export:       true,
+	}
+	p.DegradationCheckInterval.Init(base.mgr)
+
+	p.DegradationLatencyThreshold = ParamItem{
+		Key:          "etcd.degradation.latencyThreshold",
+		DefaultValue: "1000",
+		Version:      "2.3.0",
+		Doc:          "A probe slower than this threshold in milliseconds fails",
+		Export:       true,
+	}
+	p.DegradationLatencyThreshold.Init(base.mgr)
+
+	p.DegradationFailureThreshold = ParamItem{
+		Key:          "etcd.degradation.failureThreshold",
+		DefaultValue: "3",
+		Version:      "2.3.0",
+		Doc:          "Number of consecutive failed probes to switch into read only mode",
+		Export:       true,
+	}
+	p.DegradationFailureThreshold.Init(base.mgr)
+
+	p.DegradationRecoveryThreshold = ParamItem{
+		Key:          "etcd.degradation.recoveryThreshold",
+		DefaultValue: "3",
+		Version:      "2.3.0",
+		Doc:          "Number of consecutive successful probes to recover from read only mode",
+		Export:       true,
+	}
+	p.DegradationRecoveryThreshold.Init(base.mgr)
+
+	p.WatchClientEnabled = ParamItem{
+		Key:          "etcd.watchClient.enabled",
+		DefaultValue: "false",
+		Version:      "2.3.0",
+		Doc: `Use a dedicated etcd client with its own connection for the watch-heavy subsystems, e.g. the channel watch of DataCoord and DataNode,
+so that their bursts never starve the session keepalives on the coordination client`,
+		Export: true,
+	}
+	p.WatchClientEnabled.Init(base.mgr)
+
+	p.WatchClientRateLimit = ParamItem{
+		Key:          "etcd.watchClient.rateLimit",
+		DefaultValue: "0",
+		Version:      "2.3.0",
+		Doc:          "Max requests per second of the dedicated watch client, 0 means no limit",
+		Export:       true,
+	}
+	p.WatchClientRateLimit.Init(base.mgr)
+
+	p.WatchClientBurst = ParamItem{
+		Key:          "etcd.watchClient.burst",
+		DefaultValue: "100",
+		Version:      "2.3.0",
+		Doc:          "Max burst of the requests of the dedicated watch client",
+		Export:       true,
+	}
+	p.WatchClientBurst.Init(base.mgr)
 }
 
 type LocalStorageConfig struct {
@@ -370,6 +457,10 @@ func (p *MetaDBConfig) Init(base *BaseTable) {
 // MQConfig represents the configuration settings for the message queue.
 type MQConfig struct {
 	Type ParamItem `refreshable:"false"`
+
+	// live migration to another mq
+	MigrationTargetType ParamItem `refreshable:"false"`
+	MigrationPhase      ParamItem `refreshable:"true"`
 }
 
 // Init initializes the MQConfig object with a BaseTable.
@@ -383,6 +474,29 @@ Valid values: [default, pulsar, kafka, rocksmq, natsmq]`,
 		Export: true,
 	}
 	p.Type.Init(base.mgr)
+
+	p.MigrationTargetType = ParamItem{
+		Key:          "mq.migration.targetType",
+		Version:      "2.3.0",
+		DefaultValue: "",
+		Doc: `The mq to migrate to, the migration is disabled if empty.
+Valid values: [pulsar, kafka, natsmq]`,
+		Export: true,
+	}
+	p.MigrationTargetType.Init(base.mgr)
+
+	p.MigrationPhase = ParamItem{
+		Key:          "mq.migration.phase",
+		Version:      "2.3.0",
+		DefaultValue: "dualWrite",
+		Doc: `Phase of the mq migration, the phase is applied to the newly created msgstreams.
+dualWrite: write to both the mq and the target mq, read from the mq, the dual write start is recorded per channel.
+cutover: write to both the mq and the target mq, read from the target mq, the consumers seek the target mq by the timestamp of the channel checkpoints,
+the channels whose checkpoints are not later than the recorded dual write start keep reading from the mq.
+All the nodes must be in dualWrite before switching to cutover.`,
+		Export: true,
+	}
+	p.MigrationPhase.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -680,6 +794,10 @@ type NatsmqConfig struct {
 	ServerRetentionMaxAge     ParamItem `refreshable:"true"`
 	ServerRetentionMaxBytes   ParamItem `refreshable:"true"`
 	ServerRetentionMaxMsgs    ParamItem `refreshable:"true"`
+	ConsumerMaxInflight       ParamItem `refreshable:"true"`
+	ConsumerFetchBatchSize    ParamItem `refreshable:"true"`
+	ConsumerFetchMaxWait      ParamItem `refreshable:"true"`
+	ConsumerAckWait           ParamItem `refreshable:"true"`
 }
 
 // Init sets up a new NatsmqConfig instance using the provided BaseTable
@@ -789,6 +907,39 @@ func (r *NatsmqConfig) Init(base *BaseTable) {
 		Export:       true,
 	}
 	r.ServerRetentionMaxMsgs.Init(base.mgr)
+
+	r.ConsumerMaxInflight = ParamItem{
+		Key:          "natsmq.consumer.maxInflight",
+		Version:      "2.3.0",
+		DefaultValue: "1024",
+		Doc:          `Maximum number of messages delivered to a consumer but not acked yet, the server stops delivering once reached`,
+		Export:       true,
+	}
+	r.ConsumerMaxInflight.Init(base.mgr)
+	r.ConsumerFetchBatchSize = ParamItem{
+		Key:          "natsmq.consumer.fetchBatchSize",
+		Version:      "2.3.0",
+		DefaultValue: "64",
+		Doc:          `Maximum number of messages pulled by a consumer in one fetch`,
+		Export:       true,
+	}
+	r.ConsumerFetchBatchSize.Init(base.mgr)
+	r.ConsumerFetchMaxWait = ParamItem{
+		Key:          "natsmq.consumer.fetchMaxWait",
+		Version:      "2.3.0",
+		DefaultValue: "100",
+		Doc:          `Maximum time in milliseconds a fetch waits for the messages`,
+		Export:       true,
+	}
+	r.ConsumerFetchMaxWait.Init(base.mgr)
+	r.ConsumerAckWait = ParamItem{
+		Key:          "natsmq.consumer.ackWait",
+		Version:      "2.3.0",
+		DefaultValue: "30",
+		Doc:          `Time in seconds the server waits for the ack of a delivered message before redelivering it`,
+		Export:       true,
+	}
+	r.ConsumerAckWait.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -805,6 +956,9 @@ type MinioConfig struct {
 	CloudProvider   ParamItem `refreshable:"false"`
 	IAMEndpoint     ParamItem `refreshable:"false"`
 	LogLevel        ParamItem `refreshable:"false"`
+	STSEndpoint     ParamItem `refreshable:"false"`
+
+	RoleCredentials ParamGroup `refreshable:"false"`
 }
 
 func (p *MinioConfig) Init(base *BaseTable) {
@@ -929,4 +1083,68 @@ Leave it empty if you want to use AWS default endpoint`,
 		Export:       true,
 	}
 	p.LogLevel.Init(base.mgr)
+
+	p.STSEndpoint = ParamItem{
+		Key:          "minio.stsEndpoint",
+		DefaultValue: "https://sts.amazonaws.com",
+		Version:      "2.3.0",
+		Doc:          "Endpoint of the STS service to assume the roles configured in minio.roleCredentials",
+		Export:       true,
+	}
+	p.STSEndpoint.Init(base.mgr)
+
+	p.RoleCredentials = ParamGroup{
+		KeyPrefix: "minio.roleCredentials.",
+		Version:   "2.3.0",
+		Doc: `Credentials scoped to a node role, e.g. minio.roleCredentials.datanode.accessKeyID,
+the keys of a role are accessKeyID, secretAccessKey, roleARN and sessionDuration(seconds).
+If roleARN is set, the node assumes the role through STS and refreshes the credentials automatically`,
+	}
+	p.RoleCredentials.Init(base.mgr)
+}
+
+// RoleCredential is the object storage credential of a node role
+type RoleCredential struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	RoleARN         string
+	SessionDuration time.Duration // 0 if not configured
+	Scoped          bool          // whether anything is configured for the role by minio.roleCredentials
+}
+
+// GetRoleCredential returns the credential scoped to the role by minio.roleCredentials,
+// the keys not configured for the role fall back to the default access key
+func (p *MinioConfig) GetRoleCredential(role string) RoleCredential {
+	cred := RoleCredential{
+		AccessKeyID:     p.AccessKeyID.GetValue(),
+		SecretAccessKey: p.SecretAccessKey.GetValue(),
+	}
+
+	// the config manager flattens the keys, so the keys of the role can't be filtered by the prefix of the group,
+	// look them up one by one instead
+	lookup := func(key string) (string, bool) {
+		value, err := p.RoleCredentials.manager.GetConfig(p.RoleCredentials.KeyPrefix + role + "." + key)
+		if err != nil {
+			return "", false
+		}
+		cred.Scoped = true
+		return value, true
+	}
+	if accessKeyID, ok := lookup("accessKeyID"); ok {
+		cred.AccessKeyID = accessKeyID
+	}
+	if secretAccessKey, ok := lookup("secretAccessKey"); ok {
+		cred.SecretAccessKey = secretAccessKey
+	}
+	cred.RoleARN, _ = lookup("roleARN")
+	if value, ok := lookup("sessionDuration"); ok {
+		seconds, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || seconds <= 0 {
+			log.Warn("invalid session duration of role credentials, use the default duration",
+				zap.String("role", role), zap.String("sessionDuration", value), zap.Error(err))
+		} else {
+			cred.SessionDuration = time.Duration(seconds) * time.Second
+		}
+	}
+	return cred
 }
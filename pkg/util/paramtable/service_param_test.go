@@ -13,6 +13,7 @@ package paramtable
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -51,6 +52,15 @@ func TestServiceParam(t *testing.T) {
 		assert.NotEmpty(t, Params.EtcdTLSMinVersion.GetValue())
 		t.Logf("tls minVersion = %s", Params.EtcdTLSMinVersion.GetValue())
 
+		assert.True(t, Params.EnableReadOnlyOnDegradation.GetAsBool())
+		assert.Equal(t, time.Second, Params.DegradationCheckInterval.GetAsDuration(time.Millisecond))
+		assert.Equal(t, time.Second, Params.DegradationLatencyThreshold.GetAsDuration(time.Millisecond))
+		assert.Equal(t, 3, Params.DegradationFailureThreshold.GetAsInt())
+		assert.Equal(t, 3, Params.DegradationRecoveryThreshold.GetAsInt())
+		assert.False(t, Params.WatchClientEnabled.GetAsBool())
+		assert.Equal(t, 0.0, Params.WatchClientRateLimit.GetAsFloat())
+		assert.Equal(t, 100, Params.WatchClientBurst.GetAsInt())
+
 		// test UseEmbedEtcd
 		t.Setenv("etcd.use.embed", "true")
 		t.Setenv(metricsinfo.DeployModeEnvKey, metricsinfo.ClusterDeployMode)
@@ -61,6 +71,12 @@ func TestServiceParam(t *testing.T) {
 		SParams.init()
 	})
 
+	t.Run("test mqConfig", func(t *testing.T) {
+		Params := &SParams.MQCfg
+		assert.Equal(t, "", Params.MigrationTargetType.GetValue())
+		assert.Equal(t, "dualWrite", Params.MigrationPhase.GetValue())
+	})
+
 	t.Run("test pulsarConfig", func(t *testing.T) {
 		// test default value
 		{
@@ -146,6 +162,14 @@ func TestServiceParam(t *testing.T) {
 		}
 	})
 
+	t.Run("test natsmqConfig", func(t *testing.T) {
+		Params := &SParams.NatsmqCfg
+		assert.Equal(t, 1024, Params.ConsumerMaxInflight.GetAsInt())
+		assert.Equal(t, 64, Params.ConsumerFetchBatchSize.GetAsInt())
+		assert.Equal(t, 100*time.Millisecond, Params.ConsumerFetchMaxWait.GetAsDuration(time.Millisecond))
+		assert.Equal(t, 30*time.Second, Params.ConsumerAckWait.GetAsDuration(time.Second))
+	})
+
 	t.Run("test minioConfig", func(t *testing.T) {
 		Params := &SParams.MinioCfg
 
@@ -166,6 +190,34 @@ func TestServiceParam(t *testing.T) {
 
 		assert.Equal(t, Params.IAMEndpoint.GetValue(), "")
 
+		assert.Equal(t, "https://sts.amazonaws.com", Params.STSEndpoint.GetValue())
+
+		assert.Equal(t, 0, len(Params.RoleCredentials.GetValue()))
+
+		cred := Params.GetRoleCredential("querynode")
+		assert.False(t, cred.Scoped)
+		assert.Equal(t, Params.AccessKeyID.GetValue(), cred.AccessKeyID)
+		assert.Equal(t, Params.SecretAccessKey.GetValue(), cred.SecretAccessKey)
+
+		SParams.BaseTable.Save("minio.roleCredentials.querynode.accessKeyID", "query_ak")
+		SParams.BaseTable.Save("minio.roleCredentials.querynode.roleARN", "arn:aws:iam::1:role/querynode")
+		SParams.BaseTable.Save("minio.roleCredentials.querynode.sessionDuration", "900")
+		SParams.BaseTable.Save("minio.roleCredentials.datanode.sessionDuration", "invalid")
+		cred = Params.GetRoleCredential("querynode")
+		assert.True(t, cred.Scoped)
+		assert.Equal(t, "query_ak", cred.AccessKeyID)
+		assert.Equal(t, Params.SecretAccessKey.GetValue(), cred.SecretAccessKey)
+		assert.Equal(t, "arn:aws:iam::1:role/querynode", cred.RoleARN)
+		assert.Equal(t, 15*time.Minute, cred.SessionDuration)
+		cred = Params.GetRoleCredential("datanode")
+		assert.True(t, cred.Scoped)
+		assert.Empty(t, cred.RoleARN)
+		assert.Equal(t, time.Duration(0), cred.SessionDuration)
+		SParams.BaseTable.Reset("minio.roleCredentials.querynode.accessKeyID")
+		SParams.BaseTable.Reset("minio.roleCredentials.querynode.roleARN")
+		SParams.BaseTable.Reset("minio.roleCredentials.querynode.sessionDuration")
+		SParams.BaseTable.Reset("minio.roleCredentials.datanode.sessionDuration")
+
 		t.Logf("Minio BucketName = %s", Params.BucketName.GetValue())
 
 		t.Logf("Minio rootpath = %s", Params.RootPath.GetValue())
@@ -331,6 +331,20 @@ func IsJSONType(dataType schemapb.DataType) bool {
 	return dataType == schemapb.DataType_JSON
 }
 
+// IsSidePayloadField returns true if the oversized values of the field could be stored aside
+func IsSidePayloadField(field *schemapb.FieldSchema) bool {
+	if !IsStringType(field.GetDataType()) && !IsJSONType(field.GetDataType()) {
+		return false
+	}
+	for _, param := range field.GetTypeParams() {
+		if param.GetKey() == common.SidePayloadKey {
+			enabled, err := strconv.ParseBool(param.GetValue())
+			return err == nil && enabled
+		}
+	}
+	return false
+}
+
 // IsFloatingType returns true if input is a floating type, otherwise false
 func IsFloatingType(dataType schemapb.DataType) bool {
 	switch dataType {
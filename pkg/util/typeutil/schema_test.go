@@ -801,6 +801,21 @@ func TestGetPrimaryFieldSchema(t *testing.T) {
 	assert.Equal(t, schemapb.DataType_Int64, primaryField.DataType)
 }
 
+func TestIsSidePayloadField(t *testing.T) {
+	withParam := func(dataType schemapb.DataType, value string) *schemapb.FieldSchema {
+		return &schemapb.FieldSchema{
+			DataType:   dataType,
+			TypeParams: []*commonpb.KeyValuePair{{Key: common.SidePayloadKey, Value: value}},
+		}
+	}
+	assert.True(t, IsSidePayloadField(withParam(schemapb.DataType_VarChar, "true")))
+	assert.True(t, IsSidePayloadField(withParam(schemapb.DataType_JSON, "true")))
+	assert.False(t, IsSidePayloadField(withParam(schemapb.DataType_VarChar, "false")))
+	assert.False(t, IsSidePayloadField(withParam(schemapb.DataType_VarChar, "invalid")))
+	assert.False(t, IsSidePayloadField(withParam(schemapb.DataType_Int64, "true")))
+	assert.False(t, IsSidePayloadField(&schemapb.FieldSchema{DataType: schemapb.DataType_VarChar}))
+}
+
 func TestGetPK(t *testing.T) {
 	type args struct {
 		data *schemapb.IDs